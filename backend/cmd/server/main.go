@@ -13,15 +13,19 @@ import (
 	"golang.org/x/net/http2/h2c"
 
 	// Infrastructure
+	"github.com/sogos/mirai-backend/internal/domain/links"
 	"github.com/sogos/mirai-backend/internal/infrastructure/cache"
 	"github.com/sogos/mirai-backend/internal/infrastructure/config"
 	"github.com/sogos/mirai-backend/internal/infrastructure/crypto"
+	"github.com/sogos/mirai-backend/internal/infrastructure/external/fakeai"
 	"github.com/sogos/mirai-backend/internal/infrastructure/external/gemini"
 	"github.com/sogos/mirai-backend/internal/infrastructure/external/kratos"
+	"github.com/sogos/mirai-backend/internal/infrastructure/external/lms"
 	"github.com/sogos/mirai-backend/internal/infrastructure/external/smtp"
 	"github.com/sogos/mirai-backend/internal/infrastructure/external/stripe"
 	"github.com/sogos/mirai-backend/internal/infrastructure/logging"
 	"github.com/sogos/mirai-backend/internal/infrastructure/persistence/postgres"
+	"github.com/sogos/mirai-backend/internal/infrastructure/promptguard"
 	"github.com/sogos/mirai-backend/internal/infrastructure/pubsub"
 	"github.com/sogos/mirai-backend/internal/infrastructure/storage"
 	"github.com/sogos/mirai-backend/internal/infrastructure/worker"
@@ -40,7 +44,7 @@ import (
 func main() {
 	// Initialize structured logger
 	logger := logging.New()
-	logger.Info("starting mirai backend")
+	logger.Info("starting mirai backend", "version", version, "commit", commit)
 
 	// Load configuration
 	cfg, err := config.Load()
@@ -48,6 +52,7 @@ func main() {
 		logger.Error("failed to load config", "error", err)
 		os.Exit(1)
 	}
+	links.SetRoutePrefix(cfg.FrontendRoutePrefix)
 
 	// Connect to database
 	db, err := postgres.NewDB(cfg.DatabaseURL)
@@ -66,6 +71,7 @@ func main() {
 	invitationRepo := postgres.NewInvitationRepository(db.DB)
 	pendingRegRepo := postgres.NewPendingRegistrationRepository(db.DB)
 	courseRepo := postgres.NewCourseRepository(db.DB)
+	courseCollaboratorRepo := postgres.NewCourseCollaboratorRepository(db.DB)
 	folderRepo := postgres.NewFolderRepository(db.DB)
 
 	// SME repositories
@@ -80,13 +86,27 @@ func main() {
 	// AI & Generation repositories
 	aiSettingsRepo := postgres.NewTenantAISettingsRepository(db.DB)
 	notificationRepo := postgres.NewNotificationRepository(db.DB)
+	emailLogRepo := postgres.NewEmailLogRepository(db.DB)
 	outlineRepo := postgres.NewCourseOutlineRepository(db.DB)
 	sectionRepo := postgres.NewOutlineSectionRepository(db.DB)
 	lessonRepo := postgres.NewOutlineLessonRepository(db.DB)
 	genLessonRepo := postgres.NewGeneratedLessonRepository(db.DB)
 	componentRepo := postgres.NewLessonComponentRepository(db.DB)
 	genInputRepo := postgres.NewCourseGenerationInputRepository(db.DB)
+	generationDraftRepo := postgres.NewGenerationDraftRepository(db.DB)
+	outlineCommentRepo := postgres.NewOutlineCommentRepository(db.DB)
 	generationJobRepo := postgres.NewGenerationJobRepository(db.DB, cfg.StaleJobTimeoutMinutes)
+	jobAnomalyRepo := postgres.NewJobAnomalyRepository(db.DB)
+	storageRefRepo := postgres.NewStorageReferenceRepository(db.DB)
+	courseAttachmentRepo := postgres.NewCourseAttachmentRepository(db.DB)
+	auditEventRepo := postgres.NewAuditEventRepository(db.DB)
+	apiTokenRepo := postgres.NewAPITokenRepository(db.DB)
+	taskHeartbeatRepo := postgres.NewTaskHeartbeatRepository(db.DB)
+
+	// LMS sync repositories
+	lmsConnectorRepo := postgres.NewLMSConnectorRepository(db.DB)
+	folderSyncSettingRepo := postgres.NewFolderSyncSettingRepository(db.DB)
+	courseSyncDeliveryRepo := postgres.NewCourseSyncDeliveryRepository(db.DB)
 
 	// Initialize shared HTTP client
 	httpClient := httputil.NewClient()
@@ -105,12 +125,28 @@ func main() {
 	// Initialize SMTP email client (only if configured)
 	var emailClient domainservice.EmailProvider
 	if cfg.SMTPHost != "" {
-		emailClient = smtp.NewClient(cfg.SMTPHost, cfg.SMTPPort, cfg.SMTPFrom, cfg.SMTPUsername, cfg.SMTPPassword, cfg.AdminEmail)
+		emailClient = smtp.NewClient(cfg.SMTPHost, cfg.SMTPPort, cfg.SMTPFrom, cfg.SMTPUsername, cfg.SMTPPassword, cfg.AdminEmail, emailLogRepo, logger)
 		logger.Info("email provider configured", "host", cfg.SMTPHost, "adminEmail", cfg.AdminEmail)
 	} else {
 		logger.Warn("email provider not configured, invitations will not send emails")
 	}
 
+	// Retention class per object type, applied as object tags on every write
+	retentionPolicy := storage.RetentionPolicy{}
+	for objectType, class := range map[storage.ObjectType]string{
+		storage.ObjectTypeCourseContent: cfg.StorageRetentionCourseContent,
+		storage.ObjectTypeExport:        cfg.StorageRetentionExport,
+		storage.ObjectTypeSubmission:    cfg.StorageRetentionSubmission,
+		storage.ObjectTypeThumbnail:     cfg.StorageRetentionThumbnail,
+	} {
+		retention, err := storage.ParseRetentionClass(class)
+		if err != nil {
+			logger.Error("invalid storage retention class", "objectType", objectType, "error", err)
+			os.Exit(1)
+		}
+		retentionPolicy[objectType] = retention
+	}
+
 	// Initialize storage for CourseService
 	// Use S3/MinIO in production, local filesystem for development
 	var baseStorage storage.StorageAdapter
@@ -122,20 +158,64 @@ func main() {
 			BasePath:        cfg.S3BasePath,
 			AccessKeyID:     cfg.S3AccessKey,
 			SecretAccessKey: cfg.S3SecretKey,
+			Retention:       retentionPolicy,
 		})
 		if err != nil {
 			logger.Error("failed to initialize S3 storage", "error", err)
 			os.Exit(1)
 		}
+		if cfg.StorageApplyLifecycleRules {
+			if err := s3Storage.ApplyLifecycleRules(context.Background(), int32(cfg.StorageIATransitionDays)); err != nil {
+				logger.Warn("failed to apply bucket lifecycle rules", "bucket", cfg.S3Bucket, "error", err)
+			} else {
+				logger.Info("bucket lifecycle rules applied", "bucket", cfg.S3Bucket, "iaTransitionDays", cfg.StorageIATransitionDays)
+			}
+		}
 		baseStorage = s3Storage
 		logger.Info("using S3/MinIO storage", "endpoint", cfg.S3Endpoint, "bucket", cfg.S3Bucket)
 	} else {
-		baseStorage = storage.NewLocalStorage("./data")
+		localStorage := storage.NewLocalStorage("./data")
+		localStorage.SetRetentionPolicy(retentionPolicy)
+		baseStorage = localStorage
 		logger.Warn("S3 credentials not configured, using local storage (not recommended for production)")
 	}
 
-	// Wrap storage with tenant-aware path prefixing
+	// Additional storage regions; tenants that chose one at signup keep their objects there
+	regionStorage := make(map[string]storage.StorageAdapter)
+	for name, bucket := range cfg.StorageRegions {
+		if bucket.AccessKey == "" || bucket.SecretKey == "" {
+			localStorage := storage.NewLocalStorage("./data-" + name)
+			localStorage.SetRetentionPolicy(retentionPolicy)
+			regionStorage[name] = localStorage
+			logger.Warn("S3 credentials not configured for storage region, using local storage", "region", name)
+			continue
+		}
+		s3Storage, err := storage.NewS3Storage(context.Background(), storage.S3Config{
+			Endpoint:        bucket.Endpoint,
+			Region:          bucket.Region,
+			Bucket:          bucket.Bucket,
+			BasePath:        bucket.BasePath,
+			AccessKeyID:     bucket.AccessKey,
+			SecretAccessKey: bucket.SecretKey,
+			Retention:       retentionPolicy,
+		})
+		if err != nil {
+			logger.Error("failed to initialize storage region", "region", name, "error", err)
+			os.Exit(1)
+		}
+		if cfg.StorageApplyLifecycleRules {
+			if err := s3Storage.ApplyLifecycleRules(context.Background(), int32(cfg.StorageIATransitionDays)); err != nil {
+				logger.Warn("failed to apply bucket lifecycle rules", "bucket", bucket.Bucket, "error", err)
+			}
+		}
+		regionStorage[name] = s3Storage
+		logger.Info("storage region configured", "region", name, "bucket", bucket.Bucket)
+	}
+
+	// Wrap storage with tenant-aware path prefixing, routed to each tenant's storage region
 	tenantStorage := storage.NewTenantAwareStorage(baseStorage)
+	storageRegions := service.NewTenantStorageRegions(tenantRepo)
+	tenantStorage.SetRegions(cfg.StoragePrimaryRegion, regionStorage, storageRegions)
 
 	// Initialize Redis cache
 	// Create two cache wrappers:
@@ -146,7 +226,7 @@ func main() {
 		redisCache, err := cache.NewRedisCache(cache.RedisConfig{
 			URL:        cfg.RedisURL,
 			DefaultTTL: 5 * time.Minute,
-		})
+		}, logger)
 		if err != nil {
 			logger.Warn("failed to initialize Redis cache, falling back to no-op cache", "error", err)
 			baseCache = cache.NewNoOpCache()
@@ -167,23 +247,34 @@ func main() {
 	// WARNING: Only use for non-tenant-specific data
 	globalCache := cache.NewGlobalCache(baseCache)
 
-	// Initialize Redis pub/sub for real-time notifications
+	// Per-tenant hit rates at debug level; counters are on /debug/vars and in diagnostics
+	go cache.RunHitRateLog(context.Background(), logger, cache.HitRateLogInterval)
+
+	// Initialize Redis pub/sub for real-time notifications and job cancellation
 	var notificationPubSub pubsub.Publisher
 	var notificationSubscriber pubsub.Subscriber
+	var jobCancelPublisher pubsub.JobCancelPublisher
+	var jobCancelSubscriber pubsub.JobCancelSubscriber
 	if cfg.RedisURL != "" {
 		redisPubSub, err := pubsub.NewRedisPubSub(pubsub.RedisConfig{URL: cfg.RedisURL}, logger)
 		if err != nil {
 			logger.Warn("failed to initialize Redis pub/sub, real-time notifications disabled", "error", err)
 			notificationPubSub = pubsub.NewNoOpPubSub()
 			notificationSubscriber = pubsub.NewNoOpPubSub()
+			jobCancelPublisher = pubsub.NewNoOpPubSub()
+			jobCancelSubscriber = pubsub.NewNoOpPubSub()
 		} else {
 			notificationPubSub = redisPubSub
 			notificationSubscriber = redisPubSub
+			jobCancelPublisher = redisPubSub
+			jobCancelSubscriber = redisPubSub
 			logger.Info("Redis pub/sub initialized for real-time notifications")
 		}
 	} else {
 		notificationPubSub = pubsub.NewNoOpPubSub()
 		notificationSubscriber = pubsub.NewNoOpPubSub()
+		jobCancelPublisher = pubsub.NewNoOpPubSub()
+		jobCancelSubscriber = pubsub.NewNoOpPubSub()
 		logger.Warn("Redis URL not configured, real-time notifications disabled")
 	}
 
@@ -201,21 +292,67 @@ func main() {
 		logger.Warn("ENCRYPTION_KEY not configured, AI features requiring API keys will not work")
 	}
 
+	// Course content can be encrypted with per-tenant keys wrapped by ENCRYPTION_KEY.
+	// Encrypted objects are readable whenever the key is set; content is written encrypted
+	// only with STORAGE_ENCRYPTION_ENABLED, so every pod can read the format first.
+	var tenantKeyring *crypto.TenantKeyring
+	if encryptor != nil {
+		tenantKeyring = crypto.NewTenantKeyring(postgres.NewTenantKeyRepository(db.DB), encryptor)
+		tenantStorage.SetContentEncryption(tenantKeyring, cfg.StorageEncryptionEnabled)
+		if cfg.StorageEncryptionEnabled {
+			logger.Info("course content encryption enabled")
+		}
+	} else if cfg.StorageEncryptionEnabled {
+		logger.Error("STORAGE_ENCRYPTION_ENABLED requires ENCRYPTION_KEY")
+		os.Exit(1)
+	}
+
 	// Initialize application services
+	auditService := service.NewAuditService(userRepo, auditEventRepo, postgres.NewTransactor(db.DB), logger)
 	authService := service.NewAuthService(userRepo, companyRepo, invitationRepo, pendingRegRepo, kratosClient, stripeClient, logger, cfg.FrontendURL, cfg.MarketingURL, cfg.BackendURL)
 	billingService := service.NewBillingService(userRepo, companyRepo, stripeClient, logger, cfg.FrontendURL)
-	userService := service.NewUserService(userRepo, companyRepo, kratosClient, stripeClient, logger, cfg.FrontendURL)
+	userService := service.NewUserService(userRepo, companyRepo, smeTaskRepo, generationJobRepo, kratosClient, stripeClient, logger, cfg.FrontendURL)
 	companyService := service.NewCompanyService(userRepo, companyRepo, logger)
-	teamService := service.NewTeamService(userRepo, companyRepo, teamRepo, folderRepo, kratosClient, logger)
 	invitationService := service.NewInvitationService(userRepo, companyRepo, invitationRepo, stripeClient, emailClient, logger, cfg.FrontendURL)
-	courseService := service.NewCourseService(courseRepo, folderRepo, userRepo, tenantStorage, tenantCache, logger)
+	billingService.SetAuditLogger(auditService)
+	userService.SetAuditLogger(auditService)
+	userService.SetOnboarding(postgres.NewOnboardingRepository(db.DB), tenantCache)
+	var apiTokenLimiter service.APITokenRateLimiter
+	if redisCache, ok := baseCache.(*cache.RedisCache); ok {
+		apiTokenLimiter = cache.NewRateLimiter(redisCache, "api_token", time.Minute)
+	}
+	userService.SetAPITokens(apiTokenRepo, apiTokenLimiter)
+	invitationService.SetAuditLogger(auditService)
+	invitationService.SetTeamRepository(teamRepo)
+	authService.SetTeamRepository(teamRepo)
+	authService.SetStorageRegions(tenantStorage)
 
 	// Notification service (created first for dependency injection)
 	notificationService := service.NewNotificationService(userRepo, notificationRepo, kratosClient, emailClient, notificationPubSub, cfg.FrontendURL, logger)
+	notificationService.SetEmailLog(emailLogRepo)
+	notificationService.SetWeeklySummary(postgres.NewWeeklySummaryRepository(db.DB), aiSettingsRepo)
+
+	// Signed links to finished exports, emailed to the requester and resolved by this server
+	exportDownloadService := service.NewExportDownloadService(postgres.NewExportDownloadLinkRepository(db.DB), tenantStorage, cfg.BackendURL, logger)
+	exportArtifactRepo := postgres.NewExportArtifactRepository(db.DB)
+
+	teamService := service.NewTeamService(userRepo, companyRepo, teamRepo, folderRepo, smeRepo, smeTaskRepo, notificationService, kratosClient, logger)
+	teamService.SetDashboard(generationJobRepo, courseRepo, invitationRepo, tenantCache)
+
+	courseService := service.NewCourseService(courseRepo, courseCollaboratorRepo, folderRepo, userRepo, teamRepo, targetAudienceRepo, tenantStorage, tenantCache, notificationService, cfg.MaxInlineDataURIBytes, logger)
+	courseService.SetAuditLogger(auditService)
+	courseService.SetContentSizeLimits(cfg.CourseContentSoftLimitBytes, cfg.CourseContentHardLimitBytes)
+	courseService.SetGenerationJobRepository(generationJobRepo)
+	courseService.SetExportArtifacts(exportArtifactRepo)
+	// Editor presence lives only in Redis; without it nobody else is reported on a course
+	if redisCache, ok := baseCache.(*cache.RedisCache); ok {
+		courseService.SetCoursePresence(cache.NewCoursePresence(redisCache))
+	}
 
 	// SME and Target Audience services
 	// Note: enhancer is nil initially, will be set when AI services are available
-	smeService := service.NewSMEService(userRepo, companyRepo, teamRepo, smeRepo, smeTaskRepo, smeSubmissionRepo, smeKnowledgeRepo, tenantStorage, notificationService, nil, logger)
+	smeService := service.NewSMEService(userRepo, companyRepo, teamRepo, smeRepo, smeTaskRepo, smeSubmissionRepo, smeKnowledgeRepo, tenantStorage, notificationService, nil, tenantCache, cfg.SMEMinKnowledgeChunks, logger)
+	smeService.SetAuditLogger(auditService)
 	targetAudienceService := service.NewTargetAudienceService(userRepo, targetAudienceRepo, logger)
 
 	// Initialize Asynq worker client for enqueueing tasks (needed by AI services)
@@ -225,19 +362,55 @@ func main() {
 	defer workerClient.Close()
 	logger.Info("Asynq worker client initialized", "redisAddr", redisAddr)
 
-	// AI services (require encryptor)
+	// Registry of running generation jobs, so cancellation can abort in-flight provider calls
+	jobRegistry := worker.NewJobRegistry(jobCancelSubscriber, logger)
+
+	// AI services (require encryptor, unless the fake provider is selected)
 	var tenantSettingsService *service.TenantSettingsService
-	var aiGenerationService *service.AIGenerationService
-	var smeIngestionService *service.SMEIngestionService
+	var aiProviderFactory service.AIProviderFactory
 	if encryptor != nil {
-		tenantSettingsService = service.NewTenantSettingsService(userRepo, aiSettingsRepo, encryptor, logger)
+		tenantSettingsService = service.NewTenantSettingsService(userRepo, aiSettingsRepo, folderRepo, encryptor, logger)
+		tenantSettingsService.SetAuditLogger(auditService)
+		notificationService.SetTenantLocaleProvider(tenantSettingsService)
+		invitationService.SetTenantLocaleProvider(tenantSettingsService)
+		courseService.SetCourseDefaultsProvider(tenantSettingsService)
 
 		// Create Gemini provider factory for per-tenant API key management
-		geminiProviderFactory := gemini.NewProviderFactory(tenantSettingsService, logger)
+		geminiFactory := gemini.NewProviderFactory(tenantSettingsService, logger)
+		geminiFactory.SetPromptCache(tenantCache)
+		aiProviderFactory = geminiFactory
+	}
+
+	// Export files are kept for each tenant's retention period; the default applies without tenant settings
+	var exportRetention service.ExportRetentionProvider
+	if tenantSettingsService != nil {
+		exportRetention = tenantSettingsService
+	}
+	exportDownloadService.SetExportArtifacts(exportArtifactRepo, tenantStorage, exportRetention)
+	if cfg.AIProvider == "fake" {
+		failOperations := make([]fakeai.Operation, len(cfg.FakeAIFailOperations))
+		for i, op := range cfg.FakeAIFailOperations {
+			failOperations[i] = fakeai.Operation(op)
+		}
+		aiProviderFactory = fakeai.NewProvider(fakeai.Options{
+			Latency:        time.Duration(cfg.FakeAILatencyMS) * time.Millisecond,
+			FailurePercent: cfg.FakeAIFailurePercent,
+			FailOperations: failOperations,
+		})
+		logger.Warn("using fake AI provider; generated content is placeholder text",
+			"latencyMs", cfg.FakeAILatencyMS,
+			"failurePercent", cfg.FakeAIFailurePercent,
+			"failOperations", cfg.FakeAIFailOperations,
+		)
+	}
 
+	var aiGenerationService *service.AIGenerationService
+	var smeIngestionService *service.SMEIngestionService
+	if aiProviderFactory != nil {
 		// AI Generation service
 		aiGenerationService = service.NewAIGenerationService(
 			userRepo,
+			courseRepo,
 			smeRepo,
 			smeKnowledgeRepo,
 			targetAudienceRepo,
@@ -249,13 +422,37 @@ func main() {
 			componentRepo,
 			genInputRepo,
 			aiSettingsRepo,
-			geminiProviderFactory,
+			aiProviderFactory,
+			courseService,       // For per-course edit permission checks
+			courseService,       // For mirroring generation preferences into assessment settings
 			notificationService, // For tenant-isolated job notifications
 			notificationService, // For course completion notifications (implements CourseCompletionNotifier)
 			notificationService, // For outline completion notifications (implements OutlineCompletionNotifier)
 			workerClient,        // For event-driven job processing (push)
 			logger,
 		)
+		aiGenerationService.SetJobCancellation(jobCancelPublisher, jobRegistry)
+		aiGenerationService.SetIdentityProvider(kratosClient)
+		aiGenerationService.SetGenerationInputLimits(cfg.GenerationMaxDesiredOutcomeChars, cfg.GenerationMaxAdditionalContextChars)
+		aiGenerationService.SetOutlineExportStorage(tenantStorage)
+		aiGenerationService.SetLessonExport(tenantStorage, courseService, notificationService)
+		aiGenerationService.SetExportArtifactRecorder(exportDownloadService)
+		notificationService.SetExportDownloadLinks(exportDownloadService)
+		aiGenerationService.SetComponentAssetStorage(tenantStorage)
+		aiGenerationService.SetStorageAudit(tenantStorage, storageRefRepo)
+		aiGenerationService.SetCourseTranslation(courseService)
+		aiGenerationService.SetOutlineComments(outlineCommentRepo, notificationService)
+		aiGenerationService.SetOutlineReviewNotifier(notificationService)
+		aiGenerationService.SetLessonOrphaning(postgres.NewTransactor(db.DB), notificationService)
+		aiGenerationService.SetCourseReferences(courseAttachmentRepo)
+		aiGenerationService.SetAuditLogger(auditService)
+		aiGenerationService.SetStatsCache(tenantCache)
+		aiGenerationService.SetCoursePlayerCache(tenantCache)
+		aiGenerationService.SetPublishedCourseReader(tenantStorage)
+		aiGenerationService.SetCourseDraftTracker(courseService)
+		courseService.SetPlayerSnapshotter(aiGenerationService)
+		aiGenerationService.SetQueueStatus(tenantCache, globalCache, worker.Concurrency)
+		aiGenerationService.SetGenerationDraftRepository(generationDraftRepo)
 
 		// SME Ingestion service
 		smeIngestionService = service.NewSMEIngestionService(
@@ -266,19 +463,88 @@ func main() {
 			generationJobRepo,
 			aiSettingsRepo,
 			tenantStorage,
-			geminiProviderFactory,
+			aiProviderFactory,
 			notificationService,
 			logger,
 		)
+		smeService.SetIngestionJobCreator(smeIngestionService)
+		smeService.SetIngestionJobRepository(generationJobRepo)
+		smeIngestionService.SetSubmissionProgressPublisher(notificationService)
+		smeService.SetKnowledgeArchiveJobCreator(smeIngestionService)
+		smeIngestionService.SetKnowledgeArchiveStorage(tenantStorage)
+		smeIngestionService.SetExportArtifactRecorder(exportDownloadService)
+		smeIngestionService.SetTopicReclusterEnqueuer(workerClient)
+		smeIngestionService.SetKnowledgeSummaryRefit(workerClient, emailClient)
+		smeIngestionService.SetPromptInjectionDetector(promptguard.NewHeuristicDetector())
+
+		targetAudienceService.SetAIProvider(aiProviderFactory, aiSettingsRepo)
+		smeService.SetAIProvider(aiProviderFactory, aiSettingsRepo)
 
 		logger.Info("AI services initialized")
 	} else {
 		logger.Warn("AI services not initialized (encryption key required)")
 	}
 
+	// LMS sync (requires encryptor for connector credentials and AI services for rendering exports)
+	var lmsSyncService *service.LMSSyncService
+	if encryptor != nil && aiGenerationService != nil {
+		lmsSyncService = service.NewLMSSyncService(
+			userRepo,
+			courseRepo,
+			folderRepo,
+			lmsConnectorRepo,
+			folderSyncSettingRepo,
+			courseSyncDeliveryRepo,
+			aiGenerationService, // Renders the lesson archive delivered to the LMS
+			tenantStorage,
+			lms.NewClient(httputil.NewPublicClientWithTimeout(5*time.Minute)), // Uploads can be large; endpoints are tenant-supplied
+			encryptor,
+			logger,
+		)
+		lmsSyncService.SetAuditLogger(auditService)
+		courseService.SetCoursePublishListener(lmsSyncService)
+	}
+
 	// Background services for deferred account provisioning
-	provisioningService := service.NewProvisioningService(pendingRegRepo, tenantRepo, userRepo, companyRepo, kratosClient, emailClient, logger, cfg.FrontendURL)
+	provisioningService := service.NewProvisioningService(pendingRegRepo, tenantRepo, userRepo, companyRepo, courseService, kratosClient, emailClient, logger, cfg.FrontendURL)
 	cleanupService := service.NewCleanupService(pendingRegRepo, logger)
+	cleanupService.SetUploadStorage(tenantStorage)
+	cleanupService.SetGenerationDraftRepository(generationDraftRepo)
+	cleanupService.SetExpiredExportPurger(exportDownloadService)
+
+	// Read-only maintenance flag shared by the API and worker through Redis
+	maintenanceService := service.NewMaintenanceService(globalCache, cfg.SuperAdminEmails, logger)
+
+	// Hourly sweep for generation jobs left inconsistent; anomalies are listed by superadmins,
+	// who can also queue the upgrade of quiz components to the current schema
+	if aiGenerationService != nil {
+		aiGenerationService.SetConsistencySweep(tenantRepo, jobAnomalyRepo, maintenanceService, emailClient)
+		aiGenerationService.SetQuizSchemaMigration(workerClient)
+	}
+
+	// Superadmin diagnostics of the worker, scheduled tasks and dependencies
+	workerInspector := worker.NewInspector(redisAddr)
+	defer workerInspector.Close()
+	diagnosticsService := service.NewDiagnosticsService(maintenanceService, db.DB, baseStorage, workerInspector, taskHeartbeatRepo, buildInfo(), logger)
+	diagnosticsService.SetCacheStats(cache.Snapshot)
+
+	// Superadmins can warm or bust a tenant's library cache after a flush or bulk import
+	courseService.SetCacheAdmin(maintenanceService, workerClient)
+	if tenantKeyring != nil {
+		courseService.SetStorageEncryption(tenantKeyring, tenantRepo, workerClient)
+	}
+	courseService.SetLessonComponentSearcher(componentRepo)
+	courseService.SetIdentityProvider(kratosClient)
+
+	// Superadmins can move a tenant's objects to another storage region
+	courseService.SetStorageRegionMigration(storageRegions, tenantRepo, workerClient)
+
+	// Reference files attached to courses are extracted by the worker for generation prompts
+	courseService.SetCourseAttachments(courseAttachmentRepo, workerClient, promptguard.NewHeuristicDetector())
+
+	// Superadmins can queue re-summarization of oversized SME knowledge summaries
+	smeService.SetKnowledgeSummaryBackfill(maintenanceService, workerClient)
+	teamService.SetLibraryCacheInvalidator(courseService)
 
 	// Create Connect server mux
 	mux := connectserver.NewServeMux(connectserver.ServerConfig{
@@ -294,6 +560,11 @@ func main() {
 		TenantSettingsService:  tenantSettingsService,
 		NotificationService:    notificationService,
 		AIGenerationService:    aiGenerationService,
+		LMSSyncService:         lmsSyncService,
+		MaintenanceService:     maintenanceService,
+		DiagnosticsService:     diagnosticsService,
+		AuditService:           auditService,
+		ExportDownloadService:  exportDownloadService,
 		PendingRegRepo:         pendingRegRepo,
 		UserRepo:               userRepo,               // For tenant context in auth interceptor
 		Cache:                  globalCache,            // For caching user tenant mappings (not tenant-scoped)
@@ -302,8 +573,13 @@ func main() {
 		Payments:               stripeClient,
 		WorkerClient:           workerClient, // For enqueueing background tasks
 		Logger:                 logger,
-		AllowedOrigin:          cfg.AllowedOrigin,
-		FrontendURL:            cfg.FrontendURL,
+		PayloadLimits: connectserver.PayloadLimits{
+			Default: cfg.RequestMaxBytes,
+			Large:   cfg.RequestMaxBytesLarge,
+			Small:   cfg.RequestMaxBytesSmall,
+		},
+		AllowedOrigin: cfg.AllowedOrigin,
+		FrontendURL:   cfg.FrontendURL,
 	})
 
 	// Wrap with CORS middleware
@@ -332,6 +608,12 @@ func main() {
 		cleanupService,
 		aiGenerationService,
 		smeIngestionService,
+		lmsSyncService,
+		courseService,
+		notificationService,
+		maintenanceService,
+		jobRegistry,
+		taskHeartbeatRepo,
 		workerClient,
 		logger,
 	)
@@ -360,7 +642,8 @@ func main() {
 
 	logger.Info("shutting down server")
 
-	// Shutdown Asynq worker server (stops processing new tasks, waits for current tasks)
+	// Shutdown Asynq worker server (stops processing new tasks, interrupts current tasks so
+	// they re-queue with progress preserved, and waits for them to checkpoint)
 	workerServer.Shutdown()
 	logger.Info("Asynq worker server stopped")
 
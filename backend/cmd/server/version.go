@@ -0,0 +1,34 @@
+package main
+
+import (
+	"runtime"
+	"runtime/debug"
+
+	"github.com/sogos/mirai-backend/internal/application/service"
+)
+
+// Set at build time, e.g. -ldflags "-X main.version=abc123 -X main.commit=abc123...".
+var (
+	version = "dev"
+	commit  = ""
+)
+
+// buildInfo identifies the running build for diagnostics. Without a commit from the
+// build flags, the VCS revision Go embeds in local builds is used.
+func buildInfo() service.BuildInfo {
+	info := service.BuildInfo{
+		Version:   version,
+		Commit:    commit,
+		GoVersion: runtime.Version(),
+	}
+	if info.Commit == "" {
+		if bi, ok := debug.ReadBuildInfo(); ok {
+			for _, setting := range bi.Settings {
+				if setting.Key == "vcs.revision" {
+					info.Commit = setting.Value
+				}
+			}
+		}
+	}
+	return info
+}
@@ -26,31 +26,46 @@ const (
 type GenerationJobType int32
 
 const (
-	GenerationJobType_GENERATION_JOB_TYPE_UNSPECIFIED     GenerationJobType = 0
-	GenerationJobType_GENERATION_JOB_TYPE_SME_INGESTION   GenerationJobType = 1 // Process SME content submissions
-	GenerationJobType_GENERATION_JOB_TYPE_COURSE_OUTLINE  GenerationJobType = 2 // Generate course outline
-	GenerationJobType_GENERATION_JOB_TYPE_LESSON_CONTENT  GenerationJobType = 3 // Generate content for a lesson
-	GenerationJobType_GENERATION_JOB_TYPE_COMPONENT_REGEN GenerationJobType = 4 // Regenerate single component
-	GenerationJobType_GENERATION_JOB_TYPE_FULL_COURSE     GenerationJobType = 5 // Parent job tracking all lesson generation
+	GenerationJobType_GENERATION_JOB_TYPE_UNSPECIFIED          GenerationJobType = 0
+	GenerationJobType_GENERATION_JOB_TYPE_SME_INGESTION        GenerationJobType = 1  // Process SME content submissions
+	GenerationJobType_GENERATION_JOB_TYPE_COURSE_OUTLINE       GenerationJobType = 2  // Generate course outline
+	GenerationJobType_GENERATION_JOB_TYPE_LESSON_CONTENT       GenerationJobType = 3  // Generate content for a lesson
+	GenerationJobType_GENERATION_JOB_TYPE_COMPONENT_REGEN      GenerationJobType = 4  // Regenerate single component
+	GenerationJobType_GENERATION_JOB_TYPE_FULL_COURSE          GenerationJobType = 5  // Parent job tracking all lesson generation
+	GenerationJobType_GENERATION_JOB_TYPE_LESSONS_EXPORT       GenerationJobType = 6  // Export all generated lessons as a ZIP
+	GenerationJobType_GENERATION_JOB_TYPE_SME_KNOWLEDGE_EXPORT GenerationJobType = 7  // Export an SME's knowledge to an archive
+	GenerationJobType_GENERATION_JOB_TYPE_SME_KNOWLEDGE_IMPORT GenerationJobType = 8  // Import an SME knowledge archive
+	GenerationJobType_GENERATION_JOB_TYPE_STORAGE_AUDIT        GenerationJobType = 9  // Report (and optionally delete) orphaned storage objects
+	GenerationJobType_GENERATION_JOB_TYPE_COURSE_TRANSLATION   GenerationJobType = 10 // Translate a course into a new course in another language
 )
 
 // Enum value maps for GenerationJobType.
 var (
 	GenerationJobType_name = map[int32]string{
-		0: "GENERATION_JOB_TYPE_UNSPECIFIED",
-		1: "GENERATION_JOB_TYPE_SME_INGESTION",
-		2: "GENERATION_JOB_TYPE_COURSE_OUTLINE",
-		3: "GENERATION_JOB_TYPE_LESSON_CONTENT",
-		4: "GENERATION_JOB_TYPE_COMPONENT_REGEN",
-		5: "GENERATION_JOB_TYPE_FULL_COURSE",
+		0:  "GENERATION_JOB_TYPE_UNSPECIFIED",
+		1:  "GENERATION_JOB_TYPE_SME_INGESTION",
+		2:  "GENERATION_JOB_TYPE_COURSE_OUTLINE",
+		3:  "GENERATION_JOB_TYPE_LESSON_CONTENT",
+		4:  "GENERATION_JOB_TYPE_COMPONENT_REGEN",
+		5:  "GENERATION_JOB_TYPE_FULL_COURSE",
+		6:  "GENERATION_JOB_TYPE_LESSONS_EXPORT",
+		7:  "GENERATION_JOB_TYPE_SME_KNOWLEDGE_EXPORT",
+		8:  "GENERATION_JOB_TYPE_SME_KNOWLEDGE_IMPORT",
+		9:  "GENERATION_JOB_TYPE_STORAGE_AUDIT",
+		10: "GENERATION_JOB_TYPE_COURSE_TRANSLATION",
 	}
 	GenerationJobType_value = map[string]int32{
-		"GENERATION_JOB_TYPE_UNSPECIFIED":     0,
-		"GENERATION_JOB_TYPE_SME_INGESTION":   1,
-		"GENERATION_JOB_TYPE_COURSE_OUTLINE":  2,
-		"GENERATION_JOB_TYPE_LESSON_CONTENT":  3,
-		"GENERATION_JOB_TYPE_COMPONENT_REGEN": 4,
-		"GENERATION_JOB_TYPE_FULL_COURSE":     5,
+		"GENERATION_JOB_TYPE_UNSPECIFIED":          0,
+		"GENERATION_JOB_TYPE_SME_INGESTION":        1,
+		"GENERATION_JOB_TYPE_COURSE_OUTLINE":       2,
+		"GENERATION_JOB_TYPE_LESSON_CONTENT":       3,
+		"GENERATION_JOB_TYPE_COMPONENT_REGEN":      4,
+		"GENERATION_JOB_TYPE_FULL_COURSE":          5,
+		"GENERATION_JOB_TYPE_LESSONS_EXPORT":       6,
+		"GENERATION_JOB_TYPE_SME_KNOWLEDGE_EXPORT": 7,
+		"GENERATION_JOB_TYPE_SME_KNOWLEDGE_IMPORT": 8,
+		"GENERATION_JOB_TYPE_STORAGE_AUDIT":        9,
+		"GENERATION_JOB_TYPE_COURSE_TRANSLATION":   10,
 	}
 )
 
@@ -197,7 +212,7 @@ func (OutlineApprovalStatus) EnumDescriptor() ([]byte, []int) {
 }
 
 // LessonComponentType - content block types for lessons.
-// MVP: Text, Heading, Image, Quiz. Expand later.
+// MVP: Text, Heading, Image, Quiz, Video. Expand later.
 type LessonComponentType int32
 
 const (
@@ -206,6 +221,7 @@ const (
 	LessonComponentType_LESSON_COMPONENT_TYPE_HEADING     LessonComponentType = 2
 	LessonComponentType_LESSON_COMPONENT_TYPE_IMAGE       LessonComponentType = 3
 	LessonComponentType_LESSON_COMPONENT_TYPE_QUIZ        LessonComponentType = 4
+	LessonComponentType_LESSON_COMPONENT_TYPE_VIDEO       LessonComponentType = 5 // Scripted placeholder; the file is attached later
 )
 
 // Enum value maps for LessonComponentType.
@@ -216,6 +232,7 @@ var (
 		2: "LESSON_COMPONENT_TYPE_HEADING",
 		3: "LESSON_COMPONENT_TYPE_IMAGE",
 		4: "LESSON_COMPONENT_TYPE_QUIZ",
+		5: "LESSON_COMPONENT_TYPE_VIDEO",
 	}
 	LessonComponentType_value = map[string]int32{
 		"LESSON_COMPONENT_TYPE_UNSPECIFIED": 0,
@@ -223,6 +240,7 @@ var (
 		"LESSON_COMPONENT_TYPE_HEADING":     2,
 		"LESSON_COMPONENT_TYPE_IMAGE":       3,
 		"LESSON_COMPONENT_TYPE_QUIZ":        4,
+		"LESSON_COMPONENT_TYPE_VIDEO":       5,
 	}
 )
 
@@ -253,6 +271,109 @@ func (LessonComponentType) EnumDescriptor() ([]byte, []int) {
 	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{3}
 }
 
+// OutlineExportFormat is the document format for outline exports.
+type OutlineExportFormat int32
+
+const (
+	OutlineExportFormat_OUTLINE_EXPORT_FORMAT_UNSPECIFIED OutlineExportFormat = 0
+	OutlineExportFormat_OUTLINE_EXPORT_FORMAT_CSV         OutlineExportFormat = 1 // One row per lesson
+	OutlineExportFormat_OUTLINE_EXPORT_FORMAT_DOCX        OutlineExportFormat = 2 // Word document with section and lesson headings
+)
+
+// Enum value maps for OutlineExportFormat.
+var (
+	OutlineExportFormat_name = map[int32]string{
+		0: "OUTLINE_EXPORT_FORMAT_UNSPECIFIED",
+		1: "OUTLINE_EXPORT_FORMAT_CSV",
+		2: "OUTLINE_EXPORT_FORMAT_DOCX",
+	}
+	OutlineExportFormat_value = map[string]int32{
+		"OUTLINE_EXPORT_FORMAT_UNSPECIFIED": 0,
+		"OUTLINE_EXPORT_FORMAT_CSV":         1,
+		"OUTLINE_EXPORT_FORMAT_DOCX":        2,
+	}
+)
+
+func (x OutlineExportFormat) Enum() *OutlineExportFormat {
+	p := new(OutlineExportFormat)
+	*p = x
+	return p
+}
+
+func (x OutlineExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OutlineExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_mirai_v1_ai_generation_proto_enumTypes[4].Descriptor()
+}
+
+func (OutlineExportFormat) Type() protoreflect.EnumType {
+	return &file_mirai_v1_ai_generation_proto_enumTypes[4]
+}
+
+func (x OutlineExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OutlineExportFormat.Descriptor instead.
+func (OutlineExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{4}
+}
+
+// JobAnomalyType identifies an inconsistency found by the generation consistency sweep.
+type JobAnomalyType int32
+
+const (
+	JobAnomalyType_JOB_ANOMALY_TYPE_UNSPECIFIED               JobAnomalyType = 0
+	JobAnomalyType_JOB_ANOMALY_TYPE_PARENT_NOT_FINALIZED      JobAnomalyType = 1 // Parent still open after all children ended
+	JobAnomalyType_JOB_ANOMALY_TYPE_PARENT_MISSING_CHILDREN   JobAnomalyType = 2 // Parent open with no child jobs, or fewer than it queued
+	JobAnomalyType_JOB_ANOMALY_TYPE_COMPLETED_WITHOUT_LESSONS JobAnomalyType = 3 // Parent completed but course has no lessons
+)
+
+// Enum value maps for JobAnomalyType.
+var (
+	JobAnomalyType_name = map[int32]string{
+		0: "JOB_ANOMALY_TYPE_UNSPECIFIED",
+		1: "JOB_ANOMALY_TYPE_PARENT_NOT_FINALIZED",
+		2: "JOB_ANOMALY_TYPE_PARENT_MISSING_CHILDREN",
+		3: "JOB_ANOMALY_TYPE_COMPLETED_WITHOUT_LESSONS",
+	}
+	JobAnomalyType_value = map[string]int32{
+		"JOB_ANOMALY_TYPE_UNSPECIFIED":               0,
+		"JOB_ANOMALY_TYPE_PARENT_NOT_FINALIZED":      1,
+		"JOB_ANOMALY_TYPE_PARENT_MISSING_CHILDREN":   2,
+		"JOB_ANOMALY_TYPE_COMPLETED_WITHOUT_LESSONS": 3,
+	}
+)
+
+func (x JobAnomalyType) Enum() *JobAnomalyType {
+	p := new(JobAnomalyType)
+	*p = x
+	return p
+}
+
+func (x JobAnomalyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JobAnomalyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_mirai_v1_ai_generation_proto_enumTypes[5].Descriptor()
+}
+
+func (JobAnomalyType) Type() protoreflect.EnumType {
+	return &file_mirai_v1_ai_generation_proto_enumTypes[5]
+}
+
+func (x JobAnomalyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JobAnomalyType.Descriptor instead.
+func (JobAnomalyType) EnumDescriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{5}
+}
+
 // HeadingLevel for heading components.
 type HeadingLevel int32
 
@@ -293,11 +414,11 @@ func (x HeadingLevel) String() string {
 }
 
 func (HeadingLevel) Descriptor() protoreflect.EnumDescriptor {
-	return file_mirai_v1_ai_generation_proto_enumTypes[4].Descriptor()
+	return file_mirai_v1_ai_generation_proto_enumTypes[6].Descriptor()
 }
 
 func (HeadingLevel) Type() protoreflect.EnumType {
-	return &file_mirai_v1_ai_generation_proto_enumTypes[4]
+	return &file_mirai_v1_ai_generation_proto_enumTypes[6]
 }
 
 func (x HeadingLevel) Number() protoreflect.EnumNumber {
@@ -306,7 +427,228 @@ func (x HeadingLevel) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use HeadingLevel.Descriptor instead.
 func (HeadingLevel) EnumDescriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{4}
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{6}
+}
+
+// JobFailureReason classifies why a generation job failed.
+type JobFailureReason int32
+
+const (
+	JobFailureReason_JOB_FAILURE_REASON_UNSPECIFIED         JobFailureReason = 0
+	JobFailureReason_JOB_FAILURE_REASON_PROVIDER_AUTH       JobFailureReason = 1 // API key missing, invalid or not permitted
+	JobFailureReason_JOB_FAILURE_REASON_PROVIDER_RATE_LIMIT JobFailureReason = 2 // Provider kept throttling or the key is out of quota
+	JobFailureReason_JOB_FAILURE_REASON_PROVIDER_TIMEOUT    JobFailureReason = 3 // Provider did not answer in time
+	JobFailureReason_JOB_FAILURE_REASON_INVALID_OUTPUT      JobFailureReason = 4 // AI response unusable even after repair
+	JobFailureReason_JOB_FAILURE_REASON_MISSING_KNOWLEDGE   JobFailureReason = 5 // No SME knowledge to generate from
+	JobFailureReason_JOB_FAILURE_REASON_BUDGET_EXCEEDED     JobFailureReason = 6 // Monthly token limit reached
+	JobFailureReason_JOB_FAILURE_REASON_INTERNAL            JobFailureReason = 7
+)
+
+// Enum value maps for JobFailureReason.
+var (
+	JobFailureReason_name = map[int32]string{
+		0: "JOB_FAILURE_REASON_UNSPECIFIED",
+		1: "JOB_FAILURE_REASON_PROVIDER_AUTH",
+		2: "JOB_FAILURE_REASON_PROVIDER_RATE_LIMIT",
+		3: "JOB_FAILURE_REASON_PROVIDER_TIMEOUT",
+		4: "JOB_FAILURE_REASON_INVALID_OUTPUT",
+		5: "JOB_FAILURE_REASON_MISSING_KNOWLEDGE",
+		6: "JOB_FAILURE_REASON_BUDGET_EXCEEDED",
+		7: "JOB_FAILURE_REASON_INTERNAL",
+	}
+	JobFailureReason_value = map[string]int32{
+		"JOB_FAILURE_REASON_UNSPECIFIED":         0,
+		"JOB_FAILURE_REASON_PROVIDER_AUTH":       1,
+		"JOB_FAILURE_REASON_PROVIDER_RATE_LIMIT": 2,
+		"JOB_FAILURE_REASON_PROVIDER_TIMEOUT":    3,
+		"JOB_FAILURE_REASON_INVALID_OUTPUT":      4,
+		"JOB_FAILURE_REASON_MISSING_KNOWLEDGE":   5,
+		"JOB_FAILURE_REASON_BUDGET_EXCEEDED":     6,
+		"JOB_FAILURE_REASON_INTERNAL":            7,
+	}
+)
+
+func (x JobFailureReason) Enum() *JobFailureReason {
+	p := new(JobFailureReason)
+	*p = x
+	return p
+}
+
+func (x JobFailureReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JobFailureReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_mirai_v1_ai_generation_proto_enumTypes[7].Descriptor()
+}
+
+func (JobFailureReason) Type() protoreflect.EnumType {
+	return &file_mirai_v1_ai_generation_proto_enumTypes[7]
+}
+
+func (x JobFailureReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JobFailureReason.Descriptor instead.
+func (JobFailureReason) EnumDescriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{7}
+}
+
+// QuizFrequency controls which lessons get a knowledge check quiz.
+type QuizFrequency int32
+
+const (
+	QuizFrequency_QUIZ_FREQUENCY_UNSPECIFIED    QuizFrequency = 0
+	QuizFrequency_QUIZ_FREQUENCY_EVERY_LESSON   QuizFrequency = 1
+	QuizFrequency_QUIZ_FREQUENCY_END_OF_SECTION QuizFrequency = 2 // Last lesson of each section
+	QuizFrequency_QUIZ_FREQUENCY_END_OF_COURSE  QuizFrequency = 3 // Final lesson only
+)
+
+// Enum value maps for QuizFrequency.
+var (
+	QuizFrequency_name = map[int32]string{
+		0: "QUIZ_FREQUENCY_UNSPECIFIED",
+		1: "QUIZ_FREQUENCY_EVERY_LESSON",
+		2: "QUIZ_FREQUENCY_END_OF_SECTION",
+		3: "QUIZ_FREQUENCY_END_OF_COURSE",
+	}
+	QuizFrequency_value = map[string]int32{
+		"QUIZ_FREQUENCY_UNSPECIFIED":    0,
+		"QUIZ_FREQUENCY_EVERY_LESSON":   1,
+		"QUIZ_FREQUENCY_END_OF_SECTION": 2,
+		"QUIZ_FREQUENCY_END_OF_COURSE":  3,
+	}
+)
+
+func (x QuizFrequency) Enum() *QuizFrequency {
+	p := new(QuizFrequency)
+	*p = x
+	return p
+}
+
+func (x QuizFrequency) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QuizFrequency) Descriptor() protoreflect.EnumDescriptor {
+	return file_mirai_v1_ai_generation_proto_enumTypes[8].Descriptor()
+}
+
+func (QuizFrequency) Type() protoreflect.EnumType {
+	return &file_mirai_v1_ai_generation_proto_enumTypes[8]
+}
+
+func (x QuizFrequency) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QuizFrequency.Descriptor instead.
+func (QuizFrequency) EnumDescriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{8}
+}
+
+// AccessibilityIssueType identifies an accessibility problem in a lesson component.
+type AccessibilityIssueType int32
+
+const (
+	AccessibilityIssueType_ACCESSIBILITY_ISSUE_TYPE_UNSPECIFIED               AccessibilityIssueType = 0
+	AccessibilityIssueType_ACCESSIBILITY_ISSUE_TYPE_MISSING_ALT_TEXT          AccessibilityIssueType = 1 // Image without alternative text
+	AccessibilityIssueType_ACCESSIBILITY_ISSUE_TYPE_HEADING_LEVEL_JUMP        AccessibilityIssueType = 2 // Heading more than one level below the one before it
+	AccessibilityIssueType_ACCESSIBILITY_ISSUE_TYPE_QUIZ_WITHOUT_INSTRUCTIONS AccessibilityIssueType = 3 // Quiz with no question telling learners what to answer
+)
+
+// Enum value maps for AccessibilityIssueType.
+var (
+	AccessibilityIssueType_name = map[int32]string{
+		0: "ACCESSIBILITY_ISSUE_TYPE_UNSPECIFIED",
+		1: "ACCESSIBILITY_ISSUE_TYPE_MISSING_ALT_TEXT",
+		2: "ACCESSIBILITY_ISSUE_TYPE_HEADING_LEVEL_JUMP",
+		3: "ACCESSIBILITY_ISSUE_TYPE_QUIZ_WITHOUT_INSTRUCTIONS",
+	}
+	AccessibilityIssueType_value = map[string]int32{
+		"ACCESSIBILITY_ISSUE_TYPE_UNSPECIFIED":               0,
+		"ACCESSIBILITY_ISSUE_TYPE_MISSING_ALT_TEXT":          1,
+		"ACCESSIBILITY_ISSUE_TYPE_HEADING_LEVEL_JUMP":        2,
+		"ACCESSIBILITY_ISSUE_TYPE_QUIZ_WITHOUT_INSTRUCTIONS": 3,
+	}
+)
+
+func (x AccessibilityIssueType) Enum() *AccessibilityIssueType {
+	p := new(AccessibilityIssueType)
+	*p = x
+	return p
+}
+
+func (x AccessibilityIssueType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AccessibilityIssueType) Descriptor() protoreflect.EnumDescriptor {
+	return file_mirai_v1_ai_generation_proto_enumTypes[9].Descriptor()
+}
+
+func (AccessibilityIssueType) Type() protoreflect.EnumType {
+	return &file_mirai_v1_ai_generation_proto_enumTypes[9]
+}
+
+func (x AccessibilityIssueType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AccessibilityIssueType.Descriptor instead.
+func (AccessibilityIssueType) EnumDescriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{9}
+}
+
+// OutlineBalanceChangeKind is how a balancing proposal restructures lessons.
+type OutlineBalanceChangeKind int32
+
+const (
+	OutlineBalanceChangeKind_OUTLINE_BALANCE_CHANGE_KIND_UNSPECIFIED OutlineBalanceChangeKind = 0
+	OutlineBalanceChangeKind_OUTLINE_BALANCE_CHANGE_KIND_SPLIT       OutlineBalanceChangeKind = 1 // One lesson split into two
+	OutlineBalanceChangeKind_OUTLINE_BALANCE_CHANGE_KIND_MERGE       OutlineBalanceChangeKind = 2 // Two adjacent lessons merged into one
+)
+
+// Enum value maps for OutlineBalanceChangeKind.
+var (
+	OutlineBalanceChangeKind_name = map[int32]string{
+		0: "OUTLINE_BALANCE_CHANGE_KIND_UNSPECIFIED",
+		1: "OUTLINE_BALANCE_CHANGE_KIND_SPLIT",
+		2: "OUTLINE_BALANCE_CHANGE_KIND_MERGE",
+	}
+	OutlineBalanceChangeKind_value = map[string]int32{
+		"OUTLINE_BALANCE_CHANGE_KIND_UNSPECIFIED": 0,
+		"OUTLINE_BALANCE_CHANGE_KIND_SPLIT":       1,
+		"OUTLINE_BALANCE_CHANGE_KIND_MERGE":       2,
+	}
+)
+
+func (x OutlineBalanceChangeKind) Enum() *OutlineBalanceChangeKind {
+	p := new(OutlineBalanceChangeKind)
+	*p = x
+	return p
+}
+
+func (x OutlineBalanceChangeKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OutlineBalanceChangeKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_mirai_v1_ai_generation_proto_enumTypes[10].Descriptor()
+}
+
+func (OutlineBalanceChangeKind) Type() protoreflect.EnumType {
+	return &file_mirai_v1_ai_generation_proto_enumTypes[10]
+}
+
+func (x OutlineBalanceChangeKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OutlineBalanceChangeKind.Descriptor instead.
+func (OutlineBalanceChangeKind) EnumDescriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{10}
 }
 
 // GenerationJob represents an AI generation job.
@@ -337,7 +679,16 @@ type GenerationJob struct {
 	StartedAt       *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=started_at,json=startedAt,proto3,oneof" json:"started_at,omitempty"`
 	CompletedAt     *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=completed_at,json=completedAt,proto3,oneof" json:"completed_at,omitempty"`
 	// Parent job ID - links child lesson jobs to parent full_course job
-	ParentJobId   *string `protobuf:"bytes,20,opt,name=parent_job_id,json=parentJobId,proto3,oneof" json:"parent_job_id,omitempty"`
+	ParentJobId *string `protobuf:"bytes,20,opt,name=parent_job_id,json=parentJobId,proto3,oneof" json:"parent_job_id,omitempty"`
+	// Number of AI responses re-requested because they failed schema validation
+	RepairAttempts int32 `protobuf:"varint,21,opt,name=repair_attempts,json=repairAttempts,proto3" json:"repair_attempts,omitempty"`
+	// Why a failed job failed; unset for other jobs and for jobs failed before reasons were recorded
+	FailureReason   *JobFailureReason `protobuf:"varint,22,opt,name=failure_reason,json=failureReason,proto3,enum=mirai.v1.JobFailureReason,oneof" json:"failure_reason,omitempty"`
+	SuggestedAction *string           `protobuf:"bytes,23,opt,name=suggested_action,json=suggestedAction,proto3,oneof" json:"suggested_action,omitempty"` // What the user can do about failure_reason
+	// Pictures generated for image components; billed separately from tokens_used
+	ImagesGenerated int32 `protobuf:"varint,24,opt,name=images_generated,json=imagesGenerated,proto3" json:"images_generated,omitempty"`
+	// The job's children by status; set only when listing with exclude_children
+	ChildCounts   *JobChildCounts `protobuf:"bytes,25,opt,name=child_counts,json=childCounts,proto3,oneof" json:"child_counts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -512,6 +863,41 @@ func (x *GenerationJob) GetParentJobId() string {
 	return ""
 }
 
+func (x *GenerationJob) GetRepairAttempts() int32 {
+	if x != nil {
+		return x.RepairAttempts
+	}
+	return 0
+}
+
+func (x *GenerationJob) GetFailureReason() JobFailureReason {
+	if x != nil && x.FailureReason != nil {
+		return *x.FailureReason
+	}
+	return JobFailureReason_JOB_FAILURE_REASON_UNSPECIFIED
+}
+
+func (x *GenerationJob) GetSuggestedAction() string {
+	if x != nil && x.SuggestedAction != nil {
+		return *x.SuggestedAction
+	}
+	return ""
+}
+
+func (x *GenerationJob) GetImagesGenerated() int32 {
+	if x != nil {
+		return x.ImagesGenerated
+	}
+	return 0
+}
+
+func (x *GenerationJob) GetChildCounts() *JobChildCounts {
+	if x != nil {
+		return x.ChildCounts
+	}
+	return nil
+}
+
 // CourseOutline represents the generated course structure.
 type CourseOutline struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
@@ -524,8 +910,17 @@ type CourseOutline struct {
 	GeneratedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
 	ApprovedAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=approved_at,json=approvedAt,proto3,oneof" json:"approved_at,omitempty"`
 	ApprovedByUserId *string                `protobuf:"bytes,9,opt,name=approved_by_user_id,json=approvedByUserId,proto3,oneof" json:"approved_by_user_id,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	Constraints      *OutlineConstraints    `protobuf:"bytes,10,opt,name=constraints,proto3,oneof" json:"constraints,omitempty"` // Constraints requested at generation time
+	// How lessons map to the version this one replaced; unset for a course's first outline
+	LessonChanges          *OutlineLessonChanges `protobuf:"bytes,11,opt,name=lesson_changes,json=lessonChanges,proto3,oneof" json:"lesson_changes,omitempty"`
+	UnresolvedCommentCount int32                 `protobuf:"varint,12,opt,name=unresolved_comment_count,json=unresolvedCommentCount,proto3" json:"unresolved_comment_count,omitempty"` // Unresolved reviewer comments on the outline's lessons
+	// Second review, when the tenant requires it: the generator can't approve, and the first
+	// reviewer's endorsement is cleared if the outline is edited or rejected
+	GeneratedByUserId *string                `protobuf:"bytes,13,opt,name=generated_by_user_id,json=generatedByUserId,proto3,oneof" json:"generated_by_user_id,omitempty"`
+	EndorsedAt        *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=endorsed_at,json=endorsedAt,proto3,oneof" json:"endorsed_at,omitempty"`
+	EndorsedByUserId  *string                `protobuf:"bytes,15,opt,name=endorsed_by_user_id,json=endorsedByUserId,proto3,oneof" json:"endorsed_by_user_id,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *CourseOutline) Reset() {
@@ -621,32 +1016,74 @@ func (x *CourseOutline) GetApprovedByUserId() string {
 	return ""
 }
 
-// OutlineSection represents a section in the outline.
-type OutlineSection struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Order         int32                  `protobuf:"varint,4,opt,name=order,proto3" json:"order,omitempty"`
-	Lessons       []*OutlineLesson       `protobuf:"bytes,5,rep,name=lessons,proto3" json:"lessons,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CourseOutline) GetConstraints() *OutlineConstraints {
+	if x != nil {
+		return x.Constraints
+	}
+	return nil
 }
 
-func (x *OutlineSection) Reset() {
-	*x = OutlineSection{}
+func (x *CourseOutline) GetLessonChanges() *OutlineLessonChanges {
+	if x != nil {
+		return x.LessonChanges
+	}
+	return nil
+}
+
+func (x *CourseOutline) GetUnresolvedCommentCount() int32 {
+	if x != nil {
+		return x.UnresolvedCommentCount
+	}
+	return 0
+}
+
+func (x *CourseOutline) GetGeneratedByUserId() string {
+	if x != nil && x.GeneratedByUserId != nil {
+		return *x.GeneratedByUserId
+	}
+	return ""
+}
+
+func (x *CourseOutline) GetEndorsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndorsedAt
+	}
+	return nil
+}
+
+func (x *CourseOutline) GetEndorsedByUserId() string {
+	if x != nil && x.EndorsedByUserId != nil {
+		return *x.EndorsedByUserId
+	}
+	return ""
+}
+
+// OutlineLessonChanges describes how a regenerated outline's lessons map to the
+// outline version it replaced.
+type OutlineLessonChanges struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PreviousOutlineId string                 `protobuf:"bytes,1,opt,name=previous_outline_id,json=previousOutlineId,proto3" json:"previous_outline_id,omitempty"`
+	Kept              []*OutlineLessonChange `protobuf:"bytes,2,rep,name=kept,proto3" json:"kept,omitempty"`       // Matched to a previous lesson, whose key they carry
+	Added             []*OutlineLessonChange `protobuf:"bytes,3,rep,name=added,proto3" json:"added,omitempty"`     // New lessons with no confident match
+	Removed           []*OutlineLessonChange `protobuf:"bytes,4,rep,name=removed,proto3" json:"removed,omitempty"` // Previous lessons nothing matched
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *OutlineLessonChanges) Reset() {
+	*x = OutlineLessonChanges{}
 	mi := &file_mirai_v1_ai_generation_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OutlineSection) String() string {
+func (x *OutlineLessonChanges) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OutlineSection) ProtoMessage() {}
+func (*OutlineLessonChanges) ProtoMessage() {}
 
-func (x *OutlineSection) ProtoReflect() protoreflect.Message {
+func (x *OutlineLessonChanges) ProtoReflect() protoreflect.Message {
 	mi := &file_mirai_v1_ai_generation_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -658,47 +1095,178 @@ func (x *OutlineSection) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OutlineSection.ProtoReflect.Descriptor instead.
-func (*OutlineSection) Descriptor() ([]byte, []int) {
+// Deprecated: Use OutlineLessonChanges.ProtoReflect.Descriptor instead.
+func (*OutlineLessonChanges) Descriptor() ([]byte, []int) {
 	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *OutlineSection) GetId() string {
+func (x *OutlineLessonChanges) GetPreviousOutlineId() string {
 	if x != nil {
-		return x.Id
+		return x.PreviousOutlineId
 	}
 	return ""
 }
 
-func (x *OutlineSection) GetTitle() string {
+func (x *OutlineLessonChanges) GetKept() []*OutlineLessonChange {
 	if x != nil {
-		return x.Title
+		return x.Kept
 	}
-	return ""
+	return nil
 }
 
-func (x *OutlineSection) GetDescription() string {
+func (x *OutlineLessonChanges) GetAdded() []*OutlineLessonChange {
 	if x != nil {
-		return x.Description
+		return x.Added
 	}
-	return ""
+	return nil
 }
 
-func (x *OutlineSection) GetOrder() int32 {
+func (x *OutlineLessonChanges) GetRemoved() []*OutlineLessonChange {
 	if x != nil {
-		return x.Order
+		return x.Removed
 	}
-	return 0
+	return nil
 }
 
-func (x *OutlineSection) GetLessons() []*OutlineLesson {
-	if x != nil {
-		return x.Lessons
-	}
-	return nil
+// OutlineLessonChange identifies one lesson in OutlineLessonChanges.
+type OutlineLessonChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LessonKey     string                 `protobuf:"bytes,1,opt,name=lesson_key,json=lessonKey,proto3" json:"lesson_key,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	PreviousTitle *string                `protobuf:"bytes,3,opt,name=previous_title,json=previousTitle,proto3,oneof" json:"previous_title,omitempty"` // Title in the previous version, for kept lessons
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-// OutlineLesson represents a lesson in the outline.
+func (x *OutlineLessonChange) Reset() {
+	*x = OutlineLessonChange{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OutlineLessonChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutlineLessonChange) ProtoMessage() {}
+
+func (x *OutlineLessonChange) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutlineLessonChange.ProtoReflect.Descriptor instead.
+func (*OutlineLessonChange) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *OutlineLessonChange) GetLessonKey() string {
+	if x != nil {
+		return x.LessonKey
+	}
+	return ""
+}
+
+func (x *OutlineLessonChange) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *OutlineLessonChange) GetPreviousTitle() string {
+	if x != nil && x.PreviousTitle != nil {
+		return *x.PreviousTitle
+	}
+	return ""
+}
+
+// OutlineSection represents a section in the outline.
+type OutlineSection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Order         int32                  `protobuf:"varint,4,opt,name=order,proto3" json:"order,omitempty"`
+	Lessons       []*OutlineLesson       `protobuf:"bytes,5,rep,name=lessons,proto3" json:"lessons,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OutlineSection) Reset() {
+	*x = OutlineSection{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OutlineSection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutlineSection) ProtoMessage() {}
+
+func (x *OutlineSection) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutlineSection.ProtoReflect.Descriptor instead.
+func (*OutlineSection) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *OutlineSection) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *OutlineSection) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *OutlineSection) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *OutlineSection) GetOrder() int32 {
+	if x != nil {
+		return x.Order
+	}
+	return 0
+}
+
+func (x *OutlineSection) GetLessons() []*OutlineLesson {
+	if x != nil {
+		return x.Lessons
+	}
+	return nil
+}
+
+// OutlineLesson represents a lesson in the outline.
 type OutlineLesson struct {
 	state                    protoimpl.MessageState `protogen:"open.v1"`
 	Id                       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -709,13 +1277,15 @@ type OutlineLesson struct {
 	LearningObjectives       []string               `protobuf:"bytes,6,rep,name=learning_objectives,json=learningObjectives,proto3" json:"learning_objectives,omitempty"`
 	IsLastInSection          bool                   `protobuf:"varint,7,opt,name=is_last_in_section,json=isLastInSection,proto3" json:"is_last_in_section,omitempty"` // Flag for segue generation
 	IsLastInCourse           bool                   `protobuf:"varint,8,opt,name=is_last_in_course,json=isLastInCourse,proto3" json:"is_last_in_course,omitempty"`    // Flag for course conclusion
+	TargetAudiences          []string               `protobuf:"bytes,9,rep,name=target_audiences,json=targetAudiences,proto3" json:"target_audiences,omitempty"`      // Audience names the lesson is specific to; empty means all (ignored by UpdateCourseOutline)
+	LessonKey                string                 `protobuf:"bytes,10,opt,name=lesson_key,json=lessonKey,proto3" json:"lesson_key,omitempty"`                       // Stable across outline versions: the ID the lesson was first generated with
 	unknownFields            protoimpl.UnknownFields
 	sizeCache                protoimpl.SizeCache
 }
 
 func (x *OutlineLesson) Reset() {
 	*x = OutlineLesson{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[3]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -727,7 +1297,7 @@ func (x *OutlineLesson) String() string {
 func (*OutlineLesson) ProtoMessage() {}
 
 func (x *OutlineLesson) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[3]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -740,7 +1310,7 @@ func (x *OutlineLesson) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OutlineLesson.ProtoReflect.Descriptor instead.
 func (*OutlineLesson) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{3}
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *OutlineLesson) GetId() string {
@@ -799,24 +1369,40 @@ func (x *OutlineLesson) GetIsLastInCourse() bool {
 	return false
 }
 
+func (x *OutlineLesson) GetTargetAudiences() []string {
+	if x != nil {
+		return x.TargetAudiences
+	}
+	return nil
+}
+
+func (x *OutlineLesson) GetLessonKey() string {
+	if x != nil {
+		return x.LessonKey
+	}
+	return ""
+}
+
 // GeneratedLesson contains full lesson content.
 type GeneratedLesson struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	CourseId        string                 `protobuf:"bytes,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
 	SectionId       string                 `protobuf:"bytes,3,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty"`
-	OutlineLessonId string                 `protobuf:"bytes,4,opt,name=outline_lesson_id,json=outlineLessonId,proto3" json:"outline_lesson_id,omitempty"`
+	OutlineLessonId string                 `protobuf:"bytes,4,opt,name=outline_lesson_id,json=outlineLessonId,proto3" json:"outline_lesson_id,omitempty"` // Empty once its outline lesson was removed
 	Title           string                 `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
 	Components      []*LessonComponent     `protobuf:"bytes,6,rep,name=components,proto3" json:"components,omitempty"`
 	SegueText       *string                `protobuf:"bytes,7,opt,name=segue_text,json=segueText,proto3,oneof" json:"segue_text,omitempty"` // Transition to next lesson
 	GeneratedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
+	OrphanedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=orphaned_at,json=orphanedAt,proto3,oneof" json:"orphaned_at,omitempty"`               // Set when its outline lesson was removed or superseded
+	ComponentCount  *int32                 `protobuf:"varint,10,opt,name=component_count,json=componentCount,proto3,oneof" json:"component_count,omitempty"` // Components generation produced; unset for lessons generated before it was recorded
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
 func (x *GeneratedLesson) Reset() {
 	*x = GeneratedLesson{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[4]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -828,7 +1414,7 @@ func (x *GeneratedLesson) String() string {
 func (*GeneratedLesson) ProtoMessage() {}
 
 func (x *GeneratedLesson) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[4]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -841,7 +1427,7 @@ func (x *GeneratedLesson) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GeneratedLesson.ProtoReflect.Descriptor instead.
 func (*GeneratedLesson) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{4}
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GeneratedLesson) GetId() string {
@@ -900,6 +1486,20 @@ func (x *GeneratedLesson) GetGeneratedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *GeneratedLesson) GetOrphanedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OrphanedAt
+	}
+	return nil
+}
+
+func (x *GeneratedLesson) GetComponentCount() int32 {
+	if x != nil && x.ComponentCount != nil {
+		return *x.ComponentCount
+	}
+	return 0
+}
+
 // LessonComponent represents a content component in a lesson.
 type LessonComponent struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -916,7 +1516,7 @@ type LessonComponent struct {
 
 func (x *LessonComponent) Reset() {
 	*x = LessonComponent{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[5]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -928,7 +1528,7 @@ func (x *LessonComponent) String() string {
 func (*LessonComponent) ProtoMessage() {}
 
 func (x *LessonComponent) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[5]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -941,7 +1541,7 @@ func (x *LessonComponent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LessonComponent.ProtoReflect.Descriptor instead.
 func (*LessonComponent) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{5}
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *LessonComponent) GetId() string {
@@ -990,7 +1590,7 @@ type ComponentAlignment struct {
 
 func (x *ComponentAlignment) Reset() {
 	*x = ComponentAlignment{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[6]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1002,7 +1602,7 @@ func (x *ComponentAlignment) String() string {
 func (*ComponentAlignment) ProtoMessage() {}
 
 func (x *ComponentAlignment) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[6]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1015,7 +1615,7 @@ func (x *ComponentAlignment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ComponentAlignment.ProtoReflect.Descriptor instead.
 func (*ComponentAlignment) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{6}
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ComponentAlignment) GetSmeChunkIds() []string {
@@ -1043,7 +1643,7 @@ type TextContent struct {
 
 func (x *TextContent) Reset() {
 	*x = TextContent{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[7]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1055,7 +1655,7 @@ func (x *TextContent) String() string {
 func (*TextContent) ProtoMessage() {}
 
 func (x *TextContent) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[7]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1068,7 +1668,7 @@ func (x *TextContent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TextContent.ProtoReflect.Descriptor instead.
 func (*TextContent) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{7}
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *TextContent) GetHtml() string {
@@ -1096,7 +1696,7 @@ type HeadingContent struct {
 
 func (x *HeadingContent) Reset() {
 	*x = HeadingContent{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[8]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1108,7 +1708,7 @@ func (x *HeadingContent) String() string {
 func (*HeadingContent) ProtoMessage() {}
 
 func (x *HeadingContent) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[8]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1121,7 +1721,7 @@ func (x *HeadingContent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HeadingContent.ProtoReflect.Descriptor instead.
 func (*HeadingContent) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{8}
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *HeadingContent) GetLevel() HeadingLevel {
@@ -1150,7 +1750,7 @@ type ImageContent struct {
 
 func (x *ImageContent) Reset() {
 	*x = ImageContent{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[9]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1162,7 +1762,7 @@ func (x *ImageContent) String() string {
 func (*ImageContent) ProtoMessage() {}
 
 func (x *ImageContent) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[9]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1175,7 +1775,7 @@ func (x *ImageContent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImageContent.ProtoReflect.Descriptor instead.
 func (*ImageContent) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{9}
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ImageContent) GetUrl() string {
@@ -1215,7 +1815,7 @@ type QuizContent struct {
 
 func (x *QuizContent) Reset() {
 	*x = QuizContent{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[10]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1227,7 +1827,7 @@ func (x *QuizContent) String() string {
 func (*QuizContent) ProtoMessage() {}
 
 func (x *QuizContent) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[10]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1240,7 +1840,7 @@ func (x *QuizContent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QuizContent.ProtoReflect.Descriptor instead.
 func (*QuizContent) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{10}
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *QuizContent) GetQuestion() string {
@@ -1303,7 +1903,7 @@ type QuizOption struct {
 
 func (x *QuizOption) Reset() {
 	*x = QuizOption{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[11]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1315,7 +1915,7 @@ func (x *QuizOption) String() string {
 func (*QuizOption) ProtoMessage() {}
 
 func (x *QuizOption) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[11]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1328,7 +1928,7 @@ func (x *QuizOption) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QuizOption.ProtoReflect.Descriptor instead.
 func (*QuizOption) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{11}
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *QuizOption) GetId() string {
@@ -1353,13 +1953,15 @@ type CourseGenerationInput struct {
 	TargetAudienceIds []string               `protobuf:"bytes,3,rep,name=target_audience_ids,json=targetAudienceIds,proto3" json:"target_audience_ids,omitempty"`     // Target audience templates
 	DesiredOutcome    string                 `protobuf:"bytes,4,opt,name=desired_outcome,json=desiredOutcome,proto3" json:"desired_outcome,omitempty"`                // What learners should achieve
 	AdditionalContext *string                `protobuf:"bytes,5,opt,name=additional_context,json=additionalContext,proto3,oneof" json:"additional_context,omitempty"` // Extra context/instructions
+	Constraints       *OutlineConstraints    `protobuf:"bytes,6,opt,name=constraints,proto3,oneof" json:"constraints,omitempty"`                                      // Optional limits on outline size
+	Preferences       *GenerationPreferences `protobuf:"bytes,7,opt,name=preferences,proto3,oneof" json:"preferences,omitempty"`                                      // Component mix; tenant defaults when unset
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
 
 func (x *CourseGenerationInput) Reset() {
 	*x = CourseGenerationInput{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[12]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1371,7 +1973,7 @@ func (x *CourseGenerationInput) String() string {
 func (*CourseGenerationInput) ProtoMessage() {}
 
 func (x *CourseGenerationInput) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[12]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1384,7 +1986,7 @@ func (x *CourseGenerationInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CourseGenerationInput.ProtoReflect.Descriptor instead.
 func (*CourseGenerationInput) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{12}
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *CourseGenerationInput) GetCourseId() string {
@@ -1422,74 +2024,49 @@ func (x *CourseGenerationInput) GetAdditionalContext() string {
 	return ""
 }
 
-// GenerateCourseOutlineRequest starts outline generation.
-type GenerateCourseOutlineRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Input         *CourseGenerationInput `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GenerateCourseOutlineRequest) Reset() {
-	*x = GenerateCourseOutlineRequest{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[13]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GenerateCourseOutlineRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GenerateCourseOutlineRequest) ProtoMessage() {}
-
-func (x *GenerateCourseOutlineRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[13]
+func (x *CourseGenerationInput) GetConstraints() *OutlineConstraints {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Constraints
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GenerateCourseOutlineRequest.ProtoReflect.Descriptor instead.
-func (*GenerateCourseOutlineRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{13}
+	return nil
 }
 
-func (x *GenerateCourseOutlineRequest) GetInput() *CourseGenerationInput {
+func (x *CourseGenerationInput) GetPreferences() *GenerationPreferences {
 	if x != nil {
-		return x.Input
+		return x.Preferences
 	}
 	return nil
 }
 
-// GenerateCourseOutlineResponse returns the job ID to track progress.
-type GenerateCourseOutlineResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Job           *GenerationJob         `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// GenerationPreferences controls which component types lesson generation produces.
+type GenerationPreferences struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	EnableQuizzes            bool                   `protobuf:"varint,1,opt,name=enable_quizzes,json=enableQuizzes,proto3" json:"enable_quizzes,omitempty"`
+	QuizFrequency            QuizFrequency          `protobuf:"varint,2,opt,name=quiz_frequency,json=quizFrequency,proto3,enum=mirai.v1.QuizFrequency" json:"quiz_frequency,omitempty"`
+	IncludeImages            bool                   `protobuf:"varint,3,opt,name=include_images,json=includeImages,proto3" json:"include_images,omitempty"`
+	IncludeReflectionPrompts bool                   `protobuf:"varint,4,opt,name=include_reflection_prompts,json=includeReflectionPrompts,proto3" json:"include_reflection_prompts,omitempty"`
+	GenerateImages           bool                   `protobuf:"varint,5,opt,name=generate_images,json=generateImages,proto3" json:"generate_images,omitempty"`                  // Generate a picture for each image component instead of only describing it
+	MinLessonComponents      int32                  `protobuf:"varint,6,opt,name=min_lesson_components,json=minLessonComponents,proto3" json:"min_lesson_components,omitempty"` // Target range for components per lesson (0-100); 0 is unbounded
+	MaxLessonComponents      int32                  `protobuf:"varint,7,opt,name=max_lesson_components,json=maxLessonComponents,proto3" json:"max_lesson_components,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
 }
 
-func (x *GenerateCourseOutlineResponse) Reset() {
-	*x = GenerateCourseOutlineResponse{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[14]
+func (x *GenerationPreferences) Reset() {
+	*x = GenerationPreferences{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GenerateCourseOutlineResponse) String() string {
+func (x *GenerationPreferences) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateCourseOutlineResponse) ProtoMessage() {}
+func (*GenerationPreferences) ProtoMessage() {}
 
-func (x *GenerateCourseOutlineResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[14]
+func (x *GenerationPreferences) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1500,42 +2077,4031 @@ func (x *GenerateCourseOutlineResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateCourseOutlineResponse.ProtoReflect.Descriptor instead.
-func (*GenerateCourseOutlineResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use GenerationPreferences.ProtoReflect.Descriptor instead.
+func (*GenerationPreferences) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GenerateCourseOutlineResponse) GetJob() *GenerationJob {
+func (x *GenerationPreferences) GetEnableQuizzes() bool {
 	if x != nil {
-		return x.Job
+		return x.EnableQuizzes
+	}
+	return false
+}
+
+func (x *GenerationPreferences) GetQuizFrequency() QuizFrequency {
+	if x != nil {
+		return x.QuizFrequency
+	}
+	return QuizFrequency_QUIZ_FREQUENCY_UNSPECIFIED
+}
+
+func (x *GenerationPreferences) GetIncludeImages() bool {
+	if x != nil {
+		return x.IncludeImages
+	}
+	return false
+}
+
+func (x *GenerationPreferences) GetIncludeReflectionPrompts() bool {
+	if x != nil {
+		return x.IncludeReflectionPrompts
+	}
+	return false
+}
+
+func (x *GenerationPreferences) GetGenerateImages() bool {
+	if x != nil {
+		return x.GenerateImages
+	}
+	return false
+}
+
+func (x *GenerationPreferences) GetMinLessonComponents() int32 {
+	if x != nil {
+		return x.MinLessonComponents
+	}
+	return 0
+}
+
+func (x *GenerationPreferences) GetMaxLessonComponents() int32 {
+	if x != nil {
+		return x.MaxLessonComponents
+	}
+	return 0
+}
+
+// OutlineConstraints bounds the size of a generated outline.
+// Unset fields are unconstrained.
+type OutlineConstraints struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	MaxSections           *int32                 `protobuf:"varint,1,opt,name=max_sections,json=maxSections,proto3,oneof" json:"max_sections,omitempty"`
+	MaxLessonsPerSection  *int32                 `protobuf:"varint,2,opt,name=max_lessons_per_section,json=maxLessonsPerSection,proto3,oneof" json:"max_lessons_per_section,omitempty"`
+	TargetDurationMinutes *int32                 `protobuf:"varint,3,opt,name=target_duration_minutes,json=targetDurationMinutes,proto3,oneof" json:"target_duration_minutes,omitempty"` // Target total course duration
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *OutlineConstraints) Reset() {
+	*x = OutlineConstraints{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OutlineConstraints) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutlineConstraints) ProtoMessage() {}
+
+func (x *OutlineConstraints) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutlineConstraints.ProtoReflect.Descriptor instead.
+func (*OutlineConstraints) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *OutlineConstraints) GetMaxSections() int32 {
+	if x != nil && x.MaxSections != nil {
+		return *x.MaxSections
+	}
+	return 0
+}
+
+func (x *OutlineConstraints) GetMaxLessonsPerSection() int32 {
+	if x != nil && x.MaxLessonsPerSection != nil {
+		return *x.MaxLessonsPerSection
+	}
+	return 0
+}
+
+func (x *OutlineConstraints) GetTargetDurationMinutes() int32 {
+	if x != nil && x.TargetDurationMinutes != nil {
+		return *x.TargetDurationMinutes
+	}
+	return 0
+}
+
+// GenerateCourseOutlineRequest starts outline generation.
+type GenerateCourseOutlineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Input         *CourseGenerationInput `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	AutoApprove   bool                   `protobuf:"varint,2,opt,name=auto_approve,json=autoApprove,proto3" json:"auto_approve,omitempty"` // Approve the outline and generate all lessons; requires the tenant setting
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateCourseOutlineRequest) Reset() {
+	*x = GenerateCourseOutlineRequest{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateCourseOutlineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateCourseOutlineRequest) ProtoMessage() {}
+
+func (x *GenerateCourseOutlineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateCourseOutlineRequest.ProtoReflect.Descriptor instead.
+func (*GenerateCourseOutlineRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GenerateCourseOutlineRequest) GetInput() *CourseGenerationInput {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *GenerateCourseOutlineRequest) GetAutoApprove() bool {
+	if x != nil {
+		return x.AutoApprove
+	}
+	return false
+}
+
+// GenerateCourseOutlineResponse returns the job ID to track progress.
+// With auto_approve the job is the full course job covering outline and lessons.
+type GenerateCourseOutlineResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *GenerationJob         `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	Coverage      *KnowledgeCoverage     `protobuf:"bytes,2,opt,name=coverage,proto3,oneof" json:"coverage,omitempty"` // Check coverage.sufficient to warn about thin knowledge
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateCourseOutlineResponse) Reset() {
+	*x = GenerateCourseOutlineResponse{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateCourseOutlineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateCourseOutlineResponse) ProtoMessage() {}
+
+func (x *GenerateCourseOutlineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateCourseOutlineResponse.ProtoReflect.Descriptor instead.
+func (*GenerateCourseOutlineResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GenerateCourseOutlineResponse) GetJob() *GenerationJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+func (x *GenerateCourseOutlineResponse) GetCoverage() *KnowledgeCoverage {
+	if x != nil {
+		return x.Coverage
+	}
+	return nil
+}
+
+// AnalyzeKnowledgeCoverageRequest checks selected SMEs against a course goal.
+type AnalyzeKnowledgeCoverageRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SmeIds         []string               `protobuf:"bytes,1,rep,name=sme_ids,json=smeIds,proto3" json:"sme_ids,omitempty"`
+	DesiredOutcome string                 `protobuf:"bytes,2,opt,name=desired_outcome,json=desiredOutcome,proto3" json:"desired_outcome,omitempty"`
+	CourseTitle    *string                `protobuf:"bytes,3,opt,name=course_title,json=courseTitle,proto3,oneof" json:"course_title,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AnalyzeKnowledgeCoverageRequest) Reset() {
+	*x = AnalyzeKnowledgeCoverageRequest{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnalyzeKnowledgeCoverageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyzeKnowledgeCoverageRequest) ProtoMessage() {}
+
+func (x *AnalyzeKnowledgeCoverageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyzeKnowledgeCoverageRequest.ProtoReflect.Descriptor instead.
+func (*AnalyzeKnowledgeCoverageRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AnalyzeKnowledgeCoverageRequest) GetSmeIds() []string {
+	if x != nil {
+		return x.SmeIds
+	}
+	return nil
+}
+
+func (x *AnalyzeKnowledgeCoverageRequest) GetDesiredOutcome() string {
+	if x != nil {
+		return x.DesiredOutcome
+	}
+	return ""
+}
+
+func (x *AnalyzeKnowledgeCoverageRequest) GetCourseTitle() string {
+	if x != nil && x.CourseTitle != nil {
+		return *x.CourseTitle
+	}
+	return ""
+}
+
+// AnalyzeKnowledgeCoverageResponse contains the analysis.
+type AnalyzeKnowledgeCoverageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Coverage      *KnowledgeCoverage     `protobuf:"bytes,1,opt,name=coverage,proto3" json:"coverage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnalyzeKnowledgeCoverageResponse) Reset() {
+	*x = AnalyzeKnowledgeCoverageResponse{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnalyzeKnowledgeCoverageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyzeKnowledgeCoverageResponse) ProtoMessage() {}
+
+func (x *AnalyzeKnowledgeCoverageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyzeKnowledgeCoverageResponse.ProtoReflect.Descriptor instead.
+func (*AnalyzeKnowledgeCoverageResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AnalyzeKnowledgeCoverageResponse) GetCoverage() *KnowledgeCoverage {
+	if x != nil {
+		return x.Coverage
+	}
+	return nil
+}
+
+// KnowledgeCoverage estimates how well the selected SMEs' knowledge covers a course goal,
+// by how many of the goal's key terms are mentioned in enough knowledge chunks.
+type KnowledgeCoverage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Score         float64                `protobuf:"fixed64,1,opt,name=score,proto3" json:"score,omitempty"`                            // Share of key terms that are covered, 0-1
+	Sufficient    bool                   `protobuf:"varint,2,opt,name=sufficient,proto3" json:"sufficient,omitempty"`                   // False when the outline is likely to be shallow
+	ChunkCount    int32                  `protobuf:"varint,3,opt,name=chunk_count,json=chunkCount,proto3" json:"chunk_count,omitempty"` // Chunks usable in generation across the selected SMEs
+	Terms         []*TermCoverage        `protobuf:"bytes,4,rep,name=terms,proto3" json:"terms,omitempty"`
+	ThinTopics    []string               `protobuf:"bytes,5,rep,name=thin_topics,json=thinTopics,proto3" json:"thin_topics,omitempty"` // Key terms with too little knowledge; candidates for SME tasks
+	Message       string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`                         // Warning and suggested next step; empty when sufficient
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KnowledgeCoverage) Reset() {
+	*x = KnowledgeCoverage{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KnowledgeCoverage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KnowledgeCoverage) ProtoMessage() {}
+
+func (x *KnowledgeCoverage) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KnowledgeCoverage.ProtoReflect.Descriptor instead.
+func (*KnowledgeCoverage) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *KnowledgeCoverage) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *KnowledgeCoverage) GetSufficient() bool {
+	if x != nil {
+		return x.Sufficient
+	}
+	return false
+}
+
+func (x *KnowledgeCoverage) GetChunkCount() int32 {
+	if x != nil {
+		return x.ChunkCount
+	}
+	return 0
+}
+
+func (x *KnowledgeCoverage) GetTerms() []*TermCoverage {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+func (x *KnowledgeCoverage) GetThinTopics() []string {
+	if x != nil {
+		return x.ThinTopics
+	}
+	return nil
+}
+
+func (x *KnowledgeCoverage) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// TermCoverage is how many knowledge chunks mention one key term.
+type TermCoverage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Term          string                 `protobuf:"bytes,1,opt,name=term,proto3" json:"term,omitempty"`
+	ChunkCount    int32                  `protobuf:"varint,2,opt,name=chunk_count,json=chunkCount,proto3" json:"chunk_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TermCoverage) Reset() {
+	*x = TermCoverage{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TermCoverage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TermCoverage) ProtoMessage() {}
+
+func (x *TermCoverage) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TermCoverage.ProtoReflect.Descriptor instead.
+func (*TermCoverage) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *TermCoverage) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+func (x *TermCoverage) GetChunkCount() int32 {
+	if x != nil {
+		return x.ChunkCount
+	}
+	return 0
+}
+
+// GetCourseOutlineRequest fetches the outline for a course.
+type GetCourseOutlineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Version       *int32                 `protobuf:"varint,2,opt,name=version,proto3,oneof" json:"version,omitempty"` // If not specified, returns latest
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCourseOutlineRequest) Reset() {
+	*x = GetCourseOutlineRequest{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourseOutlineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseOutlineRequest) ProtoMessage() {}
+
+func (x *GetCourseOutlineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseOutlineRequest.ProtoReflect.Descriptor instead.
+func (*GetCourseOutlineRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetCourseOutlineRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *GetCourseOutlineRequest) GetVersion() int32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+// GetCourseOutlineResponse contains the outline.
+type GetCourseOutlineResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Outline             *CourseOutline         `protobuf:"bytes,1,opt,name=outline,proto3" json:"outline,omitempty"`
+	ActiveGenerationJob *GenerationJob         `protobuf:"bytes,2,opt,name=active_generation_job,json=activeGenerationJob,proto3,oneof" json:"active_generation_job,omitempty"` // The course's queued or processing full course run
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GetCourseOutlineResponse) Reset() {
+	*x = GetCourseOutlineResponse{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourseOutlineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseOutlineResponse) ProtoMessage() {}
+
+func (x *GetCourseOutlineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseOutlineResponse.ProtoReflect.Descriptor instead.
+func (*GetCourseOutlineResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetCourseOutlineResponse) GetOutline() *CourseOutline {
+	if x != nil {
+		return x.Outline
+	}
+	return nil
+}
+
+func (x *GetCourseOutlineResponse) GetActiveGenerationJob() *GenerationJob {
+	if x != nil {
+		return x.ActiveGenerationJob
+	}
+	return nil
+}
+
+// ApproveCourseOutlineRequest approves an outline.
+type ApproveCourseOutlineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	OutlineId     string                 `protobuf:"bytes,2,opt,name=outline_id,json=outlineId,proto3" json:"outline_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveCourseOutlineRequest) Reset() {
+	*x = ApproveCourseOutlineRequest{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveCourseOutlineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveCourseOutlineRequest) ProtoMessage() {}
+
+func (x *ApproveCourseOutlineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveCourseOutlineRequest.ProtoReflect.Descriptor instead.
+func (*ApproveCourseOutlineRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ApproveCourseOutlineRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *ApproveCourseOutlineRequest) GetOutlineId() string {
+	if x != nil {
+		return x.OutlineId
+	}
+	return ""
+}
+
+// ApproveCourseOutlineResponse confirms approval.
+type ApproveCourseOutlineResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Outline       *CourseOutline         `protobuf:"bytes,1,opt,name=outline,proto3" json:"outline,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveCourseOutlineResponse) Reset() {
+	*x = ApproveCourseOutlineResponse{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveCourseOutlineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveCourseOutlineResponse) ProtoMessage() {}
+
+func (x *ApproveCourseOutlineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveCourseOutlineResponse.ProtoReflect.Descriptor instead.
+func (*ApproveCourseOutlineResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ApproveCourseOutlineResponse) GetOutline() *CourseOutline {
+	if x != nil {
+		return x.Outline
+	}
+	return nil
+}
+
+// RejectCourseOutlineRequest rejects an outline.
+type RejectCourseOutlineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	OutlineId     string                 `protobuf:"bytes,2,opt,name=outline_id,json=outlineId,proto3" json:"outline_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectCourseOutlineRequest) Reset() {
+	*x = RejectCourseOutlineRequest{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectCourseOutlineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectCourseOutlineRequest) ProtoMessage() {}
+
+func (x *RejectCourseOutlineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectCourseOutlineRequest.ProtoReflect.Descriptor instead.
+func (*RejectCourseOutlineRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RejectCourseOutlineRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *RejectCourseOutlineRequest) GetOutlineId() string {
+	if x != nil {
+		return x.OutlineId
+	}
+	return ""
+}
+
+func (x *RejectCourseOutlineRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// RejectCourseOutlineResponse confirms rejection.
+type RejectCourseOutlineResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Outline       *CourseOutline         `protobuf:"bytes,1,opt,name=outline,proto3" json:"outline,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectCourseOutlineResponse) Reset() {
+	*x = RejectCourseOutlineResponse{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectCourseOutlineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectCourseOutlineResponse) ProtoMessage() {}
+
+func (x *RejectCourseOutlineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectCourseOutlineResponse.ProtoReflect.Descriptor instead.
+func (*RejectCourseOutlineResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RejectCourseOutlineResponse) GetOutline() *CourseOutline {
+	if x != nil {
+		return x.Outline
+	}
+	return nil
+}
+
+// UpdateCourseOutlineRequest allows editing the outline.
+type UpdateCourseOutlineRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	CourseId         string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	OutlineId        string                 `protobuf:"bytes,2,opt,name=outline_id,json=outlineId,proto3" json:"outline_id,omitempty"`
+	Sections         []*OutlineSection      `protobuf:"bytes,3,rep,name=sections,proto3" json:"sections,omitempty"`                                           // Lessons with an empty id are added
+	RemovedLessonIds []string               `protobuf:"bytes,4,rep,name=removed_lesson_ids,json=removedLessonIds,proto3" json:"removed_lesson_ids,omitempty"` // Lessons to delete; fails for lessons with generated content
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateCourseOutlineRequest) Reset() {
+	*x = UpdateCourseOutlineRequest{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCourseOutlineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCourseOutlineRequest) ProtoMessage() {}
+
+func (x *UpdateCourseOutlineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCourseOutlineRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCourseOutlineRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UpdateCourseOutlineRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *UpdateCourseOutlineRequest) GetOutlineId() string {
+	if x != nil {
+		return x.OutlineId
+	}
+	return ""
+}
+
+func (x *UpdateCourseOutlineRequest) GetSections() []*OutlineSection {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+func (x *UpdateCourseOutlineRequest) GetRemovedLessonIds() []string {
+	if x != nil {
+		return x.RemovedLessonIds
+	}
+	return nil
+}
+
+// UpdateCourseOutlineResponse contains the updated outline.
+type UpdateCourseOutlineResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Outline       *CourseOutline         `protobuf:"bytes,1,opt,name=outline,proto3" json:"outline,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCourseOutlineResponse) Reset() {
+	*x = UpdateCourseOutlineResponse{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCourseOutlineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCourseOutlineResponse) ProtoMessage() {}
+
+func (x *UpdateCourseOutlineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCourseOutlineResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCourseOutlineResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UpdateCourseOutlineResponse) GetOutline() *CourseOutline {
+	if x != nil {
+		return x.Outline
+	}
+	return nil
+}
+
+// ExportOutlineRequest exports an outline to a document.
+type ExportOutlineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Format        OutlineExportFormat    `protobuf:"varint,2,opt,name=format,proto3,enum=mirai.v1.OutlineExportFormat" json:"format,omitempty"`
+	Version       *int32                 `protobuf:"varint,3,opt,name=version,proto3,oneof" json:"version,omitempty"` // If not specified, exports latest
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportOutlineRequest) Reset() {
+	*x = ExportOutlineRequest{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportOutlineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportOutlineRequest) ProtoMessage() {}
+
+func (x *ExportOutlineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportOutlineRequest.ProtoReflect.Descriptor instead.
+func (*ExportOutlineRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ExportOutlineRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *ExportOutlineRequest) GetFormat() OutlineExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return OutlineExportFormat_OUTLINE_EXPORT_FORMAT_UNSPECIFIED
+}
+
+func (x *ExportOutlineRequest) GetVersion() int32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+// ExportOutlineResponse contains a presigned download link for the export.
+type ExportOutlineResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DownloadUrl   string                 `protobuf:"bytes,1,opt,name=download_url,json=downloadUrl,proto3" json:"download_url,omitempty"`
+	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportOutlineResponse) Reset() {
+	*x = ExportOutlineResponse{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportOutlineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportOutlineResponse) ProtoMessage() {}
+
+func (x *ExportOutlineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportOutlineResponse.ProtoReflect.Descriptor instead.
+func (*ExportOutlineResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ExportOutlineResponse) GetDownloadUrl() string {
+	if x != nil {
+		return x.DownloadUrl
+	}
+	return ""
+}
+
+func (x *ExportOutlineResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ExportOutlineResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// GenerateLessonContentRequest generates content for one lesson.
+type GenerateLessonContentRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CourseId        string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	OutlineLessonId string                 `protobuf:"bytes,2,opt,name=outline_lesson_id,json=outlineLessonId,proto3" json:"outline_lesson_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GenerateLessonContentRequest) Reset() {
+	*x = GenerateLessonContentRequest{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateLessonContentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateLessonContentRequest) ProtoMessage() {}
+
+func (x *GenerateLessonContentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateLessonContentRequest.ProtoReflect.Descriptor instead.
+func (*GenerateLessonContentRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GenerateLessonContentRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *GenerateLessonContentRequest) GetOutlineLessonId() string {
+	if x != nil {
+		return x.OutlineLessonId
+	}
+	return ""
+}
+
+// GenerateLessonContentResponse returns the job ID.
+type GenerateLessonContentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *GenerationJob         `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateLessonContentResponse) Reset() {
+	*x = GenerateLessonContentResponse{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateLessonContentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateLessonContentResponse) ProtoMessage() {}
+
+func (x *GenerateLessonContentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateLessonContentResponse.ProtoReflect.Descriptor instead.
+func (*GenerateLessonContentResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GenerateLessonContentResponse) GetJob() *GenerationJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+// GenerateAllLessonsRequest generates all lessons for a course.
+type GenerateAllLessonsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Preferences   *GenerationPreferences `protobuf:"bytes,2,opt,name=preferences,proto3,oneof" json:"preferences,omitempty"` // Replaces the course's preferences when set
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateAllLessonsRequest) Reset() {
+	*x = GenerateAllLessonsRequest{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateAllLessonsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateAllLessonsRequest) ProtoMessage() {}
+
+func (x *GenerateAllLessonsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateAllLessonsRequest.ProtoReflect.Descriptor instead.
+func (*GenerateAllLessonsRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GenerateAllLessonsRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *GenerateAllLessonsRequest) GetPreferences() *GenerationPreferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+// GenerateAllLessonsResponse returns the job ID.
+// If the course already had a full course generation in progress, job is that run
+// and nothing new was started.
+type GenerateAllLessonsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Job            *GenerationJob         `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	AlreadyRunning bool                   `protobuf:"varint,2,opt,name=already_running,json=alreadyRunning,proto3" json:"already_running,omitempty"`
+	StartedByName  *string                `protobuf:"bytes,3,opt,name=started_by_name,json=startedByName,proto3,oneof" json:"started_by_name,omitempty"` // Who started the running job, when already_running
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GenerateAllLessonsResponse) Reset() {
+	*x = GenerateAllLessonsResponse{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateAllLessonsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateAllLessonsResponse) ProtoMessage() {}
+
+func (x *GenerateAllLessonsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateAllLessonsResponse.ProtoReflect.Descriptor instead.
+func (*GenerateAllLessonsResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GenerateAllLessonsResponse) GetJob() *GenerationJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+func (x *GenerateAllLessonsResponse) GetAlreadyRunning() bool {
+	if x != nil {
+		return x.AlreadyRunning
+	}
+	return false
+}
+
+func (x *GenerateAllLessonsResponse) GetStartedByName() string {
+	if x != nil && x.StartedByName != nil {
+		return *x.StartedByName
+	}
+	return ""
+}
+
+// ExportAllLessonsRequest identifies the course whose lessons are exported.
+type ExportAllLessonsRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	CourseId         string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	IncludeCitations bool                   `protobuf:"varint,2,opt,name=include_citations,json=includeCitations,proto3" json:"include_citations,omitempty"` // Footnote each component with the SME sources it was generated from
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ExportAllLessonsRequest) Reset() {
+	*x = ExportAllLessonsRequest{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportAllLessonsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportAllLessonsRequest) ProtoMessage() {}
+
+func (x *ExportAllLessonsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportAllLessonsRequest.ProtoReflect.Descriptor instead.
+func (*ExportAllLessonsRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ExportAllLessonsRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *ExportAllLessonsRequest) GetIncludeCitations() bool {
+	if x != nil {
+		return x.IncludeCitations
+	}
+	return false
+}
+
+// ExportAllLessonsResponse returns the export job.
+type ExportAllLessonsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *GenerationJob         `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportAllLessonsResponse) Reset() {
+	*x = ExportAllLessonsResponse{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportAllLessonsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportAllLessonsResponse) ProtoMessage() {}
+
+func (x *ExportAllLessonsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportAllLessonsResponse.ProtoReflect.Descriptor instead.
+func (*ExportAllLessonsResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ExportAllLessonsResponse) GetJob() *GenerationJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+// RetryFailedLessonsRequest identifies the full course run to retry.
+type RetryFailedLessonsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         *string                `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3,oneof" json:"job_id,omitempty"`          // Parent full course job
+	CourseId      *string                `protobuf:"bytes,2,opt,name=course_id,json=courseId,proto3,oneof" json:"course_id,omitempty"` // Used when job_id is unset: retries the course's latest full course run
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryFailedLessonsRequest) Reset() {
+	*x = RetryFailedLessonsRequest{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryFailedLessonsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryFailedLessonsRequest) ProtoMessage() {}
+
+func (x *RetryFailedLessonsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryFailedLessonsRequest.ProtoReflect.Descriptor instead.
+func (*RetryFailedLessonsRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RetryFailedLessonsRequest) GetJobId() string {
+	if x != nil && x.JobId != nil {
+		return *x.JobId
+	}
+	return ""
+}
+
+func (x *RetryFailedLessonsRequest) GetCourseId() string {
+	if x != nil && x.CourseId != nil {
+		return *x.CourseId
+	}
+	return ""
+}
+
+// RetryFailedLessonsResponse contains the reopened parent job.
+type RetryFailedLessonsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *GenerationJob         `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	RetriedCount  int32                  `protobuf:"varint,2,opt,name=retried_count,json=retriedCount,proto3" json:"retried_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryFailedLessonsResponse) Reset() {
+	*x = RetryFailedLessonsResponse{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryFailedLessonsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryFailedLessonsResponse) ProtoMessage() {}
+
+func (x *RetryFailedLessonsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryFailedLessonsResponse.ProtoReflect.Descriptor instead.
+func (*RetryFailedLessonsResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *RetryFailedLessonsResponse) GetJob() *GenerationJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+func (x *RetryFailedLessonsResponse) GetRetriedCount() int32 {
+	if x != nil {
+		return x.RetriedCount
+	}
+	return 0
+}
+
+// RegenerateComponentRequest regenerates a single component.
+type RegenerateComponentRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	CourseId           string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	LessonId           string                 `protobuf:"bytes,2,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"`
+	ComponentId        string                 `protobuf:"bytes,3,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	ModificationPrompt string                 `protobuf:"bytes,4,opt,name=modification_prompt,json=modificationPrompt,proto3" json:"modification_prompt,omitempty"` // Instructions for regeneration
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RegenerateComponentRequest) Reset() {
+	*x = RegenerateComponentRequest{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegenerateComponentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegenerateComponentRequest) ProtoMessage() {}
+
+func (x *RegenerateComponentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegenerateComponentRequest.ProtoReflect.Descriptor instead.
+func (*RegenerateComponentRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *RegenerateComponentRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *RegenerateComponentRequest) GetLessonId() string {
+	if x != nil {
+		return x.LessonId
+	}
+	return ""
+}
+
+func (x *RegenerateComponentRequest) GetComponentId() string {
+	if x != nil {
+		return x.ComponentId
+	}
+	return ""
+}
+
+func (x *RegenerateComponentRequest) GetModificationPrompt() string {
+	if x != nil {
+		return x.ModificationPrompt
+	}
+	return ""
+}
+
+// RegenerateComponentResponse returns the job ID.
+type RegenerateComponentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *GenerationJob         `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegenerateComponentResponse) Reset() {
+	*x = RegenerateComponentResponse{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegenerateComponentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegenerateComponentResponse) ProtoMessage() {}
+
+func (x *RegenerateComponentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegenerateComponentResponse.ProtoReflect.Descriptor instead.
+func (*RegenerateComponentResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *RegenerateComponentResponse) GetJob() *GenerationJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+// EditComponentTextRequest asks for an inline rewrite of a text or heading component.
+type EditComponentTextRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ComponentId   string                 `protobuf:"bytes,1,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	Instruction   string                 `protobuf:"bytes,2,opt,name=instruction,proto3" json:"instruction,omitempty"` // e.g. "shorten to two sentences"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditComponentTextRequest) Reset() {
+	*x = EditComponentTextRequest{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditComponentTextRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditComponentTextRequest) ProtoMessage() {}
+
+func (x *EditComponentTextRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditComponentTextRequest.ProtoReflect.Descriptor instead.
+func (*EditComponentTextRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *EditComponentTextRequest) GetComponentId() string {
+	if x != nil {
+		return x.ComponentId
+	}
+	return ""
+}
+
+func (x *EditComponentTextRequest) GetInstruction() string {
+	if x != nil {
+		return x.Instruction
+	}
+	return ""
+}
+
+// EditComponentTextResponse returns the proposed content (not saved).
+type EditComponentTextResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ComponentId   string                 `protobuf:"bytes,1,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	Type          LessonComponentType    `protobuf:"varint,2,opt,name=type,proto3,enum=mirai.v1.LessonComponentType" json:"type,omitempty"`
+	ContentJson   string                 `protobuf:"bytes,3,opt,name=content_json,json=contentJson,proto3" json:"content_json,omitempty"` // Proposed content, validated against the component schema
+	TokensUsed    int64                  `protobuf:"varint,4,opt,name=tokens_used,json=tokensUsed,proto3" json:"tokens_used,omitempty"`
+	CacheHit      bool                   `protobuf:"varint,5,opt,name=cache_hit,json=cacheHit,proto3" json:"cache_hit,omitempty"` // Reused a recent response to the same instruction; tokens_used is 0
+	Order         int32                  `protobuf:"varint,6,opt,name=order,proto3" json:"order,omitempty"`                       // Component's current position in the lesson
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditComponentTextResponse) Reset() {
+	*x = EditComponentTextResponse{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditComponentTextResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditComponentTextResponse) ProtoMessage() {}
+
+func (x *EditComponentTextResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditComponentTextResponse.ProtoReflect.Descriptor instead.
+func (*EditComponentTextResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *EditComponentTextResponse) GetComponentId() string {
+	if x != nil {
+		return x.ComponentId
+	}
+	return ""
+}
+
+func (x *EditComponentTextResponse) GetType() LessonComponentType {
+	if x != nil {
+		return x.Type
+	}
+	return LessonComponentType_LESSON_COMPONENT_TYPE_UNSPECIFIED
+}
+
+func (x *EditComponentTextResponse) GetContentJson() string {
+	if x != nil {
+		return x.ContentJson
+	}
+	return ""
+}
+
+func (x *EditComponentTextResponse) GetTokensUsed() int64 {
+	if x != nil {
+		return x.TokensUsed
+	}
+	return 0
+}
+
+func (x *EditComponentTextResponse) GetCacheHit() bool {
+	if x != nil {
+		return x.CacheHit
+	}
+	return false
+}
+
+func (x *EditComponentTextResponse) GetOrder() int32 {
+	if x != nil {
+		return x.Order
+	}
+	return 0
+}
+
+// GetComponentSourcesRequest fetches the sources cited by a component.
+type GetComponentSourcesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ComponentId   string                 `protobuf:"bytes,1,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetComponentSourcesRequest) Reset() {
+	*x = GetComponentSourcesRequest{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetComponentSourcesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetComponentSourcesRequest) ProtoMessage() {}
+
+func (x *GetComponentSourcesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetComponentSourcesRequest.ProtoReflect.Descriptor instead.
+func (*GetComponentSourcesRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetComponentSourcesRequest) GetComponentId() string {
+	if x != nil {
+		return x.ComponentId
+	}
+	return ""
+}
+
+// ComponentSource is an SME knowledge chunk cited by a component.
+type ComponentSource struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChunkId       string                 `protobuf:"bytes,1,opt,name=chunk_id,json=chunkId,proto3" json:"chunk_id,omitempty"`
+	SmeId         string                 `protobuf:"bytes,2,opt,name=sme_id,json=smeId,proto3" json:"sme_id,omitempty"`
+	SmeName       string                 `protobuf:"bytes,3,opt,name=sme_name,json=smeName,proto3" json:"sme_name,omitempty"`
+	Topic         string                 `protobuf:"bytes,4,opt,name=topic,proto3" json:"topic,omitempty"`
+	Excerpt       string                 `protobuf:"bytes,5,opt,name=excerpt,proto3" json:"excerpt,omitempty"` // Start of the chunk content
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ComponentSource) Reset() {
+	*x = ComponentSource{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ComponentSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComponentSource) ProtoMessage() {}
+
+func (x *ComponentSource) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComponentSource.ProtoReflect.Descriptor instead.
+func (*ComponentSource) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ComponentSource) GetChunkId() string {
+	if x != nil {
+		return x.ChunkId
+	}
+	return ""
+}
+
+func (x *ComponentSource) GetSmeId() string {
+	if x != nil {
+		return x.SmeId
+	}
+	return ""
+}
+
+func (x *ComponentSource) GetSmeName() string {
+	if x != nil {
+		return x.SmeName
+	}
+	return ""
+}
+
+func (x *ComponentSource) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *ComponentSource) GetExcerpt() string {
+	if x != nil {
+		return x.Excerpt
+	}
+	return ""
+}
+
+// GetComponentSourcesResponse lists the cited chunks. Empty for components generated
+// before citations were recorded.
+type GetComponentSourcesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sources       []*ComponentSource     `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetComponentSourcesResponse) Reset() {
+	*x = GetComponentSourcesResponse{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetComponentSourcesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetComponentSourcesResponse) ProtoMessage() {}
+
+func (x *GetComponentSourcesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetComponentSourcesResponse.ProtoReflect.Descriptor instead.
+func (*GetComponentSourcesResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetComponentSourcesResponse) GetSources() []*ComponentSource {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+// GetComponentAssetUploadURLRequest requests an upload slot for a component's file.
+type GetComponentAssetUploadURLRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ComponentId   string                 `protobuf:"bytes,1,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	FileName      string                 `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType   string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"` // e.g. "video/mp4"; must suit the component type
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetComponentAssetUploadURLRequest) Reset() {
+	*x = GetComponentAssetUploadURLRequest{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetComponentAssetUploadURLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetComponentAssetUploadURLRequest) ProtoMessage() {}
+
+func (x *GetComponentAssetUploadURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetComponentAssetUploadURLRequest.ProtoReflect.Descriptor instead.
+func (*GetComponentAssetUploadURLRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetComponentAssetUploadURLRequest) GetComponentId() string {
+	if x != nil {
+		return x.ComponentId
+	}
+	return ""
+}
+
+func (x *GetComponentAssetUploadURLRequest) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *GetComponentAssetUploadURLRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+// GetComponentAssetUploadURLResponse contains the presigned upload URL.
+type GetComponentAssetUploadURLResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UploadUrl     string                 `protobuf:"bytes,1,opt,name=upload_url,json=uploadUrl,proto3" json:"upload_url,omitempty"`
+	FilePath      string                 `protobuf:"bytes,2,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"` // Pass to ConfirmComponentAsset once uploaded
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetComponentAssetUploadURLResponse) Reset() {
+	*x = GetComponentAssetUploadURLResponse{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetComponentAssetUploadURLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetComponentAssetUploadURLResponse) ProtoMessage() {}
+
+func (x *GetComponentAssetUploadURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetComponentAssetUploadURLResponse.ProtoReflect.Descriptor instead.
+func (*GetComponentAssetUploadURLResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetComponentAssetUploadURLResponse) GetUploadUrl() string {
+	if x != nil {
+		return x.UploadUrl
+	}
+	return ""
+}
+
+func (x *GetComponentAssetUploadURLResponse) GetFilePath() string {
+	if x != nil {
+		return x.FilePath
+	}
+	return ""
+}
+
+// ConfirmComponentAssetRequest attaches an uploaded file to a component.
+type ConfirmComponentAssetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ComponentId   string                 `protobuf:"bytes,1,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	FilePath      string                 `protobuf:"bytes,2,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"` // Path from GetComponentAssetUploadURL
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmComponentAssetRequest) Reset() {
+	*x = ConfirmComponentAssetRequest{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmComponentAssetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmComponentAssetRequest) ProtoMessage() {}
+
+func (x *ConfirmComponentAssetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmComponentAssetRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmComponentAssetRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ConfirmComponentAssetRequest) GetComponentId() string {
+	if x != nil {
+		return x.ComponentId
+	}
+	return ""
+}
+
+func (x *ConfirmComponentAssetRequest) GetFilePath() string {
+	if x != nil {
+		return x.FilePath
+	}
+	return ""
+}
+
+// ConfirmComponentAssetResponse contains the updated component.
+type ConfirmComponentAssetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Component     *LessonComponent       `protobuf:"bytes,1,opt,name=component,proto3" json:"component,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmComponentAssetResponse) Reset() {
+	*x = ConfirmComponentAssetResponse{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmComponentAssetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmComponentAssetResponse) ProtoMessage() {}
+
+func (x *ConfirmComponentAssetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmComponentAssetResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmComponentAssetResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ConfirmComponentAssetResponse) GetComponent() *LessonComponent {
+	if x != nil {
+		return x.Component
+	}
+	return nil
+}
+
+// SuggestCourseTitlesRequest contains the course inputs chosen so far.
+// At least one SME or a desired outcome is required.
+type SuggestCourseTitlesRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	SmeIds            []string               `protobuf:"bytes,1,rep,name=sme_ids,json=smeIds,proto3" json:"sme_ids,omitempty"`
+	TargetAudienceIds []string               `protobuf:"bytes,2,rep,name=target_audience_ids,json=targetAudienceIds,proto3" json:"target_audience_ids,omitempty"`
+	DesiredOutcome    string                 `protobuf:"bytes,3,opt,name=desired_outcome,json=desiredOutcome,proto3" json:"desired_outcome,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SuggestCourseTitlesRequest) Reset() {
+	*x = SuggestCourseTitlesRequest{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestCourseTitlesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestCourseTitlesRequest) ProtoMessage() {}
+
+func (x *SuggestCourseTitlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestCourseTitlesRequest.ProtoReflect.Descriptor instead.
+func (*SuggestCourseTitlesRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *SuggestCourseTitlesRequest) GetSmeIds() []string {
+	if x != nil {
+		return x.SmeIds
+	}
+	return nil
+}
+
+func (x *SuggestCourseTitlesRequest) GetTargetAudienceIds() []string {
+	if x != nil {
+		return x.TargetAudienceIds
+	}
+	return nil
+}
+
+func (x *SuggestCourseTitlesRequest) GetDesiredOutcome() string {
+	if x != nil {
+		return x.DesiredOutcome
+	}
+	return ""
+}
+
+// CourseTitleSuggestion is a suggested title with a one-line rationale.
+type CourseTitleSuggestion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Rationale     string                 `protobuf:"bytes,2,opt,name=rationale,proto3" json:"rationale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CourseTitleSuggestion) Reset() {
+	*x = CourseTitleSuggestion{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CourseTitleSuggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourseTitleSuggestion) ProtoMessage() {}
+
+func (x *CourseTitleSuggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourseTitleSuggestion.ProtoReflect.Descriptor instead.
+func (*CourseTitleSuggestion) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *CourseTitleSuggestion) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CourseTitleSuggestion) GetRationale() string {
+	if x != nil {
+		return x.Rationale
+	}
+	return ""
+}
+
+// SuggestCourseTitlesResponse returns the suggestions.
+type SuggestCourseTitlesResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Suggestions   []*CourseTitleSuggestion `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	TokensUsed    int64                    `protobuf:"varint,2,opt,name=tokens_used,json=tokensUsed,proto3" json:"tokens_used,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestCourseTitlesResponse) Reset() {
+	*x = SuggestCourseTitlesResponse{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestCourseTitlesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestCourseTitlesResponse) ProtoMessage() {}
+
+func (x *SuggestCourseTitlesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestCourseTitlesResponse.ProtoReflect.Descriptor instead.
+func (*SuggestCourseTitlesResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *SuggestCourseTitlesResponse) GetSuggestions() []*CourseTitleSuggestion {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+func (x *SuggestCourseTitlesResponse) GetTokensUsed() int64 {
+	if x != nil {
+		return x.TokensUsed
+	}
+	return 0
+}
+
+// GetJobRequest fetches a job by ID.
+type GetJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJobRequest) Reset() {
+	*x = GetJobRequest{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobRequest) ProtoMessage() {}
+
+func (x *GetJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobRequest.ProtoReflect.Descriptor instead.
+func (*GetJobRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetJobRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+// GetJobResponse contains the job.
+type GetJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *GenerationJob         `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJobResponse) Reset() {
+	*x = GetJobResponse{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobResponse) ProtoMessage() {}
+
+func (x *GetJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobResponse.ProtoReflect.Descriptor instead.
+func (*GetJobResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetJobResponse) GetJob() *GenerationJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+// ListJobsRequest contains filters for jobs.
+type ListJobsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Type     *GenerationJobType     `protobuf:"varint,1,opt,name=type,proto3,enum=mirai.v1.GenerationJobType,oneof" json:"type,omitempty"`
+	Status   *GenerationJobStatus   `protobuf:"varint,2,opt,name=status,proto3,enum=mirai.v1.GenerationJobStatus,oneof" json:"status,omitempty"`
+	CourseId *string                `protobuf:"bytes,3,opt,name=course_id,json=courseId,proto3,oneof" json:"course_id,omitempty"`
+	// Return only parent and standalone jobs, each with child_counts. Defaults to true;
+	// ignored when parent_job_id is set.
+	ExcludeChildren *bool   `protobuf:"varint,4,opt,name=exclude_children,json=excludeChildren,proto3,oneof" json:"exclude_children,omitempty"`
+	ParentJobId     *string `protobuf:"bytes,5,opt,name=parent_job_id,json=parentJobId,proto3,oneof" json:"parent_job_id,omitempty"` // Return only the children of this job
+	Limit           int32   `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`                                       // Max results (default 25, max 100)
+	Cursor          *string `protobuf:"bytes,7,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`                                // For pagination
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListJobsRequest) Reset() {
+	*x = ListJobsRequest{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobsRequest) ProtoMessage() {}
+
+func (x *ListJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobsRequest.ProtoReflect.Descriptor instead.
+func (*ListJobsRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListJobsRequest) GetType() GenerationJobType {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return GenerationJobType_GENERATION_JOB_TYPE_UNSPECIFIED
+}
+
+func (x *ListJobsRequest) GetStatus() GenerationJobStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return GenerationJobStatus_GENERATION_JOB_STATUS_UNSPECIFIED
+}
+
+func (x *ListJobsRequest) GetCourseId() string {
+	if x != nil && x.CourseId != nil {
+		return *x.CourseId
+	}
+	return ""
+}
+
+func (x *ListJobsRequest) GetExcludeChildren() bool {
+	if x != nil && x.ExcludeChildren != nil {
+		return *x.ExcludeChildren
+	}
+	return false
+}
+
+func (x *ListJobsRequest) GetParentJobId() string {
+	if x != nil && x.ParentJobId != nil {
+		return *x.ParentJobId
+	}
+	return ""
+}
+
+func (x *ListJobsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListJobsRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+// ListJobsResponse contains a page of matching jobs, most recent first.
+type ListJobsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jobs          []*GenerationJob       `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	NextCursor    *string                `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3,oneof" json:"next_cursor,omitempty"` // For pagination
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJobsResponse) Reset() {
+	*x = ListJobsResponse{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobsResponse) ProtoMessage() {}
+
+func (x *ListJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobsResponse.ProtoReflect.Descriptor instead.
+func (*ListJobsResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListJobsResponse) GetJobs() []*GenerationJob {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+func (x *ListJobsResponse) GetNextCursor() string {
+	if x != nil && x.NextCursor != nil {
+		return *x.NextCursor
+	}
+	return ""
+}
+
+// CancelJobRequest cancels a job.
+type CancelJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelJobRequest) Reset() {
+	*x = CancelJobRequest{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelJobRequest) ProtoMessage() {}
+
+func (x *CancelJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelJobRequest.ProtoReflect.Descriptor instead.
+func (*CancelJobRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *CancelJobRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+// CancelJobResponse confirms cancellation.
+type CancelJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *GenerationJob         `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelJobResponse) Reset() {
+	*x = CancelJobResponse{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelJobResponse) ProtoMessage() {}
+
+func (x *CancelJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelJobResponse.ProtoReflect.Descriptor instead.
+func (*CancelJobResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *CancelJobResponse) GetJob() *GenerationJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+// GetGeneratedLessonRequest fetches generated lesson content.
+type GetGeneratedLessonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LessonId      string                 `protobuf:"bytes,1,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGeneratedLessonRequest) Reset() {
+	*x = GetGeneratedLessonRequest{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGeneratedLessonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGeneratedLessonRequest) ProtoMessage() {}
+
+func (x *GetGeneratedLessonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGeneratedLessonRequest.ProtoReflect.Descriptor instead.
+func (*GetGeneratedLessonRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetGeneratedLessonRequest) GetLessonId() string {
+	if x != nil {
+		return x.LessonId
+	}
+	return ""
+}
+
+// GetGeneratedLessonResponse contains the lesson.
+type GetGeneratedLessonResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lesson        *GeneratedLesson       `protobuf:"bytes,1,opt,name=lesson,proto3" json:"lesson,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGeneratedLessonResponse) Reset() {
+	*x = GetGeneratedLessonResponse{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGeneratedLessonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGeneratedLessonResponse) ProtoMessage() {}
+
+func (x *GetGeneratedLessonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGeneratedLessonResponse.ProtoReflect.Descriptor instead.
+func (*GetGeneratedLessonResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetGeneratedLessonResponse) GetLesson() *GeneratedLesson {
+	if x != nil {
+		return x.Lesson
+	}
+	return nil
+}
+
+// ListGeneratedLessonsRequest fetches all lessons for a course.
+type ListGeneratedLessonsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CourseId        string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	IncludeOrphaned bool                   `protobuf:"varint,2,opt,name=include_orphaned,json=includeOrphaned,proto3" json:"include_orphaned,omitempty"` // Also return lessons from removed or superseded outline lessons
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListGeneratedLessonsRequest) Reset() {
+	*x = ListGeneratedLessonsRequest{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGeneratedLessonsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGeneratedLessonsRequest) ProtoMessage() {}
+
+func (x *ListGeneratedLessonsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGeneratedLessonsRequest.ProtoReflect.Descriptor instead.
+func (*ListGeneratedLessonsRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListGeneratedLessonsRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *ListGeneratedLessonsRequest) GetIncludeOrphaned() bool {
+	if x != nil {
+		return x.IncludeOrphaned
+	}
+	return false
+}
+
+// ListGeneratedLessonsResponse contains the lessons.
+type ListGeneratedLessonsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lessons       []*GeneratedLesson     `protobuf:"bytes,1,rep,name=lessons,proto3" json:"lessons,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGeneratedLessonsResponse) Reset() {
+	*x = ListGeneratedLessonsResponse{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGeneratedLessonsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGeneratedLessonsResponse) ProtoMessage() {}
+
+func (x *ListGeneratedLessonsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGeneratedLessonsResponse.ProtoReflect.Descriptor instead.
+func (*ListGeneratedLessonsResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListGeneratedLessonsResponse) GetLessons() []*GeneratedLesson {
+	if x != nil {
+		return x.Lessons
+	}
+	return nil
+}
+
+// ContentStats summarizes generated lesson content.
+type ContentStats struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	LessonCount             int32                  `protobuf:"varint,1,opt,name=lesson_count,json=lessonCount,proto3" json:"lesson_count,omitempty"`
+	WordCount               int32                  `protobuf:"varint,2,opt,name=word_count,json=wordCount,proto3" json:"word_count,omitempty"`
+	AverageWordsPerLesson   float64                `protobuf:"fixed64,3,opt,name=average_words_per_lesson,json=averageWordsPerLesson,proto3" json:"average_words_per_lesson,omitempty"`
+	EstimatedReadingMinutes int32                  `protobuf:"varint,4,opt,name=estimated_reading_minutes,json=estimatedReadingMinutes,proto3" json:"estimated_reading_minutes,omitempty"`
+	QuizCount               int32                  `protobuf:"varint,5,opt,name=quiz_count,json=quizCount,proto3" json:"quiz_count,omitempty"`
+	ImageCount              int32                  `protobuf:"varint,6,opt,name=image_count,json=imageCount,proto3" json:"image_count,omitempty"`
+	MalformedComponents     int32                  `protobuf:"varint,7,opt,name=malformed_components,json=malformedComponents,proto3" json:"malformed_components,omitempty"` // Components whose content could not be parsed; counted as zero words
+	VideoCount              int32                  `protobuf:"varint,8,opt,name=video_count,json=videoCount,proto3" json:"video_count,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *ContentStats) Reset() {
+	*x = ContentStats{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContentStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContentStats) ProtoMessage() {}
+
+func (x *ContentStats) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContentStats.ProtoReflect.Descriptor instead.
+func (*ContentStats) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ContentStats) GetLessonCount() int32 {
+	if x != nil {
+		return x.LessonCount
+	}
+	return 0
+}
+
+func (x *ContentStats) GetWordCount() int32 {
+	if x != nil {
+		return x.WordCount
+	}
+	return 0
+}
+
+func (x *ContentStats) GetAverageWordsPerLesson() float64 {
+	if x != nil {
+		return x.AverageWordsPerLesson
+	}
+	return 0
+}
+
+func (x *ContentStats) GetEstimatedReadingMinutes() int32 {
+	if x != nil {
+		return x.EstimatedReadingMinutes
+	}
+	return 0
+}
+
+func (x *ContentStats) GetQuizCount() int32 {
+	if x != nil {
+		return x.QuizCount
+	}
+	return 0
+}
+
+func (x *ContentStats) GetImageCount() int32 {
+	if x != nil {
+		return x.ImageCount
+	}
+	return 0
+}
+
+func (x *ContentStats) GetMalformedComponents() int32 {
+	if x != nil {
+		return x.MalformedComponents
+	}
+	return 0
+}
+
+func (x *ContentStats) GetVideoCount() int32 {
+	if x != nil {
+		return x.VideoCount
+	}
+	return 0
+}
+
+// SectionStats is the content breakdown for one outline section.
+type SectionStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SectionId     string                 `protobuf:"bytes,1,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Stats         *ContentStats          `protobuf:"bytes,3,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SectionStats) Reset() {
+	*x = SectionStats{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SectionStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SectionStats) ProtoMessage() {}
+
+func (x *SectionStats) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SectionStats.ProtoReflect.Descriptor instead.
+func (*SectionStats) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *SectionStats) GetSectionId() string {
+	if x != nil {
+		return x.SectionId
+	}
+	return ""
+}
+
+func (x *SectionStats) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SectionStats) GetStats() *ContentStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+// GetCourseStatsRequest requests content statistics for a course.
+type GetCourseStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCourseStatsRequest) Reset() {
+	*x = GetCourseStatsRequest{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourseStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseStatsRequest) ProtoMessage() {}
+
+func (x *GetCourseStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetCourseStatsRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GetCourseStatsRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+// GetCourseStatsResponse contains course totals and per-section breakdowns.
+type GetCourseStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Totals        *ContentStats          `protobuf:"bytes,1,opt,name=totals,proto3" json:"totals,omitempty"`
+	Sections      []*SectionStats        `protobuf:"bytes,2,rep,name=sections,proto3" json:"sections,omitempty"` // In outline order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCourseStatsResponse) Reset() {
+	*x = GetCourseStatsResponse{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourseStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseStatsResponse) ProtoMessage() {}
+
+func (x *GetCourseStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetCourseStatsResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetCourseStatsResponse) GetTotals() *ContentStats {
+	if x != nil {
+		return x.Totals
+	}
+	return nil
+}
+
+func (x *GetCourseStatsResponse) GetSections() []*SectionStats {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+// GetCoursePlayerViewRequest requests the player view of a course.
+type GetCoursePlayerViewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Draft         bool                   `protobuf:"varint,2,opt,name=draft,proto3" json:"draft,omitempty"` // Show edits not yet published instead of the published version (editors only)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCoursePlayerViewRequest) Reset() {
+	*x = GetCoursePlayerViewRequest{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCoursePlayerViewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCoursePlayerViewRequest) ProtoMessage() {}
+
+func (x *GetCoursePlayerViewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCoursePlayerViewRequest.ProtoReflect.Descriptor instead.
+func (*GetCoursePlayerViewRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetCoursePlayerViewRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *GetCoursePlayerViewRequest) GetDraft() bool {
+	if x != nil {
+		return x.Draft
+	}
+	return false
+}
+
+// GetCoursePlayerViewResponse contains the player view.
+type GetCoursePlayerViewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	View          *CoursePlayerView      `protobuf:"bytes,1,opt,name=view,proto3" json:"view,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCoursePlayerViewResponse) Reset() {
+	*x = GetCoursePlayerViewResponse{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCoursePlayerViewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCoursePlayerViewResponse) ProtoMessage() {}
+
+func (x *GetCoursePlayerViewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCoursePlayerViewResponse.ProtoReflect.Descriptor instead.
+func (*GetCoursePlayerViewResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetCoursePlayerViewResponse) GetView() *CoursePlayerView {
+	if x != nil {
+		return x.View
+	}
+	return nil
+}
+
+// CoursePlayerView is the learner-facing content of a course. Lessons that haven't been
+// generated yet are left out.
+type CoursePlayerView struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	CourseId         string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Title            string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	OutlineVersion   int32                  `protobuf:"varint,3,opt,name=outline_version,json=outlineVersion,proto3" json:"outline_version,omitempty"`
+	LessonCount      int32                  `protobuf:"varint,4,opt,name=lesson_count,json=lessonCount,proto3" json:"lesson_count,omitempty"`
+	Sections         []*CoursePlayerSection `protobuf:"bytes,5,rep,name=sections,proto3" json:"sections,omitempty"`                                          // In display order
+	PublishedVersion int32                  `protobuf:"varint,6,opt,name=published_version,json=publishedVersion,proto3" json:"published_version,omitempty"` // Published version shown; 0 when showing the draft
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CoursePlayerView) Reset() {
+	*x = CoursePlayerView{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CoursePlayerView) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoursePlayerView) ProtoMessage() {}
+
+func (x *CoursePlayerView) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoursePlayerView.ProtoReflect.Descriptor instead.
+func (*CoursePlayerView) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *CoursePlayerView) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *CoursePlayerView) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CoursePlayerView) GetOutlineVersion() int32 {
+	if x != nil {
+		return x.OutlineVersion
+	}
+	return 0
+}
+
+func (x *CoursePlayerView) GetLessonCount() int32 {
+	if x != nil {
+		return x.LessonCount
+	}
+	return 0
+}
+
+func (x *CoursePlayerView) GetSections() []*CoursePlayerSection {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+func (x *CoursePlayerView) GetPublishedVersion() int32 {
+	if x != nil {
+		return x.PublishedVersion
+	}
+	return 0
+}
+
+// CoursePlayerSection is a section of a CoursePlayerView.
+type CoursePlayerSection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Lessons       []*CoursePlayerLesson  `protobuf:"bytes,4,rep,name=lessons,proto3" json:"lessons,omitempty"` // In display order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CoursePlayerSection) Reset() {
+	*x = CoursePlayerSection{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CoursePlayerSection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoursePlayerSection) ProtoMessage() {}
+
+func (x *CoursePlayerSection) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoursePlayerSection.ProtoReflect.Descriptor instead.
+func (*CoursePlayerSection) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *CoursePlayerSection) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CoursePlayerSection) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CoursePlayerSection) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CoursePlayerSection) GetLessons() []*CoursePlayerLesson {
+	if x != nil {
+		return x.Lessons
+	}
+	return nil
+}
+
+// CoursePlayerLesson is a lesson of a CoursePlayerView.
+type CoursePlayerLesson struct {
+	state                    protoimpl.MessageState   `protogen:"open.v1"`
+	Id                       string                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title                    string                   `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	EstimatedDurationMinutes *int32                   `protobuf:"varint,3,opt,name=estimated_duration_minutes,json=estimatedDurationMinutes,proto3,oneof" json:"estimated_duration_minutes,omitempty"`
+	Components               []*CoursePlayerComponent `protobuf:"bytes,4,rep,name=components,proto3" json:"components,omitempty"` // In display order
+	SegueText                *string                  `protobuf:"bytes,5,opt,name=segue_text,json=segueText,proto3,oneof" json:"segue_text,omitempty"`
+	PreviousLessonId         *string                  `protobuf:"bytes,6,opt,name=previous_lesson_id,json=previousLessonId,proto3,oneof" json:"previous_lesson_id,omitempty"` // Across sections; unset on the first lesson
+	NextLessonId             *string                  `protobuf:"bytes,7,opt,name=next_lesson_id,json=nextLessonId,proto3,oneof" json:"next_lesson_id,omitempty"`             // Across sections; unset on the last lesson
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *CoursePlayerLesson) Reset() {
+	*x = CoursePlayerLesson{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CoursePlayerLesson) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoursePlayerLesson) ProtoMessage() {}
+
+func (x *CoursePlayerLesson) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoursePlayerLesson.ProtoReflect.Descriptor instead.
+func (*CoursePlayerLesson) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *CoursePlayerLesson) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CoursePlayerLesson) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CoursePlayerLesson) GetEstimatedDurationMinutes() int32 {
+	if x != nil && x.EstimatedDurationMinutes != nil {
+		return *x.EstimatedDurationMinutes
+	}
+	return 0
+}
+
+func (x *CoursePlayerLesson) GetComponents() []*CoursePlayerComponent {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+func (x *CoursePlayerLesson) GetSegueText() string {
+	if x != nil && x.SegueText != nil {
+		return *x.SegueText
+	}
+	return ""
+}
+
+func (x *CoursePlayerLesson) GetPreviousLessonId() string {
+	if x != nil && x.PreviousLessonId != nil {
+		return *x.PreviousLessonId
+	}
+	return ""
+}
+
+func (x *CoursePlayerLesson) GetNextLessonId() string {
+	if x != nil && x.NextLessonId != nil {
+		return *x.NextLessonId
+	}
+	return ""
+}
+
+// CoursePlayerComponent is a lesson component as the player renders it.
+type CoursePlayerComponent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          LessonComponentType    `protobuf:"varint,2,opt,name=type,proto3,enum=mirai.v1.LessonComponentType" json:"type,omitempty"`
+	Order         int32                  `protobuf:"varint,3,opt,name=order,proto3" json:"order,omitempty"`
+	ContentJson   string                 `protobuf:"bytes,4,opt,name=content_json,json=contentJson,proto3" json:"content_json,omitempty"` // Uploaded assets are resolved to an asset_url
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CoursePlayerComponent) Reset() {
+	*x = CoursePlayerComponent{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CoursePlayerComponent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoursePlayerComponent) ProtoMessage() {}
+
+func (x *CoursePlayerComponent) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoursePlayerComponent.ProtoReflect.Descriptor instead.
+func (*CoursePlayerComponent) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *CoursePlayerComponent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CoursePlayerComponent) GetType() LessonComponentType {
+	if x != nil {
+		return x.Type
+	}
+	return LessonComponentType_LESSON_COMPONENT_TYPE_UNSPECIFIED
+}
+
+func (x *CoursePlayerComponent) GetOrder() int32 {
+	if x != nil {
+		return x.Order
+	}
+	return 0
+}
+
+func (x *CoursePlayerComponent) GetContentJson() string {
+	if x != nil {
+		return x.ContentJson
+	}
+	return ""
+}
+
+// GetQueueStatusRequest requests the generation queue status for the caller's tenant.
+type GetQueueStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQueueStatusRequest) Reset() {
+	*x = GetQueueStatusRequest{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQueueStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQueueStatusRequest) ProtoMessage() {}
+
+func (x *GetQueueStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQueueStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetQueueStatusRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{75}
+}
+
+// JobTypeQueueCount counts a tenant's active jobs of one type.
+type JobTypeQueueCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          GenerationJobType      `protobuf:"varint,1,opt,name=type,proto3,enum=mirai.v1.GenerationJobType" json:"type,omitempty"`
+	Queued        int32                  `protobuf:"varint,2,opt,name=queued,proto3" json:"queued,omitempty"`
+	Processing    int32                  `protobuf:"varint,3,opt,name=processing,proto3" json:"processing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobTypeQueueCount) Reset() {
+	*x = JobTypeQueueCount{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobTypeQueueCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobTypeQueueCount) ProtoMessage() {}
+
+func (x *JobTypeQueueCount) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobTypeQueueCount.ProtoReflect.Descriptor instead.
+func (*JobTypeQueueCount) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *JobTypeQueueCount) GetType() GenerationJobType {
+	if x != nil {
+		return x.Type
+	}
+	return GenerationJobType_GENERATION_JOB_TYPE_UNSPECIFIED
+}
+
+func (x *JobTypeQueueCount) GetQueued() int32 {
+	if x != nil {
+		return x.Queued
+	}
+	return 0
+}
+
+func (x *JobTypeQueueCount) GetProcessing() int32 {
+	if x != nil {
+		return x.Processing
+	}
+	return 0
+}
+
+// GetQueueStatusResponse describes where the tenant's jobs stand.
+type GetQueueStatusResponse struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Counts                []*JobTypeQueueCount   `protobuf:"bytes,1,rep,name=counts,proto3" json:"counts,omitempty"`                                                                 // Excludes full-course parent jobs
+	QueuePosition         *int32                 `protobuf:"varint,2,opt,name=queue_position,json=queuePosition,proto3,oneof" json:"queue_position,omitempty"`                       // 1-based position of the caller's oldest queued job among all tenants' queued jobs; unset if none queued
+	WorkerConcurrency     int32                  `protobuf:"varint,3,opt,name=worker_concurrency,json=workerConcurrency,proto3" json:"worker_concurrency,omitempty"`                 // Jobs processed at once per worker; jobs are not capped per tenant
+	AvgJobDurationSeconds int32                  `protobuf:"varint,4,opt,name=avg_job_duration_seconds,json=avgJobDurationSeconds,proto3" json:"avg_job_duration_seconds,omitempty"` // Across all tenants over the last 24 hours
+	ProviderDegraded      bool                   `protobuf:"varint,5,opt,name=provider_degraded,json=providerDegraded,proto3" json:"provider_degraded,omitempty"`                    // Recent jobs are failing on AI provider errors
+	GenerationPaused      bool                   `protobuf:"varint,6,opt,name=generation_paused,json=generationPaused,proto3" json:"generation_paused,omitempty"`                    // Support paused the organization's AI generation; queued jobs wait and queue_position is unset
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *GetQueueStatusResponse) Reset() {
+	*x = GetQueueStatusResponse{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQueueStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQueueStatusResponse) ProtoMessage() {}
+
+func (x *GetQueueStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQueueStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetQueueStatusResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *GetQueueStatusResponse) GetCounts() []*JobTypeQueueCount {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+func (x *GetQueueStatusResponse) GetQueuePosition() int32 {
+	if x != nil && x.QueuePosition != nil {
+		return *x.QueuePosition
+	}
+	return 0
+}
+
+func (x *GetQueueStatusResponse) GetWorkerConcurrency() int32 {
+	if x != nil {
+		return x.WorkerConcurrency
+	}
+	return 0
+}
+
+func (x *GetQueueStatusResponse) GetAvgJobDurationSeconds() int32 {
+	if x != nil {
+		return x.AvgJobDurationSeconds
+	}
+	return 0
+}
+
+func (x *GetQueueStatusResponse) GetProviderDegraded() bool {
+	if x != nil {
+		return x.ProviderDegraded
+	}
+	return false
+}
+
+func (x *GetQueueStatusResponse) GetGenerationPaused() bool {
+	if x != nil {
+		return x.GenerationPaused
+	}
+	return false
+}
+
+// JobAnomaly is an inconsistency between generation jobs and course content.
+type JobAnomaly struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	JobId         string                 `protobuf:"bytes,3,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	CourseId      *string                `protobuf:"bytes,4,opt,name=course_id,json=courseId,proto3,oneof" json:"course_id,omitempty"`
+	Type          JobAnomalyType         `protobuf:"varint,5,opt,name=type,proto3,enum=mirai.v1.JobAnomalyType" json:"type,omitempty"`
+	Details       string                 `protobuf:"bytes,6,opt,name=details,proto3" json:"details,omitempty"`
+	Resolved      bool                   `protobuf:"varint,7,opt,name=resolved,proto3" json:"resolved,omitempty"` // Fixed automatically when detected
+	DetectedAt    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=detected_at,json=detectedAt,proto3" json:"detected_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobAnomaly) Reset() {
+	*x = JobAnomaly{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobAnomaly) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobAnomaly) ProtoMessage() {}
+
+func (x *JobAnomaly) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobAnomaly.ProtoReflect.Descriptor instead.
+func (*JobAnomaly) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *JobAnomaly) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *JobAnomaly) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *JobAnomaly) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *JobAnomaly) GetCourseId() string {
+	if x != nil && x.CourseId != nil {
+		return *x.CourseId
+	}
+	return ""
+}
+
+func (x *JobAnomaly) GetType() JobAnomalyType {
+	if x != nil {
+		return x.Type
+	}
+	return JobAnomalyType_JOB_ANOMALY_TYPE_UNSPECIFIED
+}
+
+func (x *JobAnomaly) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
+func (x *JobAnomaly) GetResolved() bool {
+	if x != nil {
+		return x.Resolved
+	}
+	return false
+}
+
+func (x *JobAnomaly) GetDetectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DetectedAt
+	}
+	return nil
+}
+
+// ListAnomaliesRequest contains filters for anomalies.
+type ListAnomaliesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      *string                `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	Type          *JobAnomalyType        `protobuf:"varint,2,opt,name=type,proto3,enum=mirai.v1.JobAnomalyType,oneof" json:"type,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"` // Defaults to 100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAnomaliesRequest) Reset() {
+	*x = ListAnomaliesRequest{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAnomaliesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAnomaliesRequest) ProtoMessage() {}
+
+func (x *ListAnomaliesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAnomaliesRequest.ProtoReflect.Descriptor instead.
+func (*ListAnomaliesRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ListAnomaliesRequest) GetTenantId() string {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return ""
+}
+
+func (x *ListAnomaliesRequest) GetType() JobAnomalyType {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return JobAnomalyType_JOB_ANOMALY_TYPE_UNSPECIFIED
+}
+
+func (x *ListAnomaliesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// ListAnomaliesResponse contains matching anomalies, most recent first.
+type ListAnomaliesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Anomalies     []*JobAnomaly          `protobuf:"bytes,1,rep,name=anomalies,proto3" json:"anomalies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAnomaliesResponse) Reset() {
+	*x = ListAnomaliesResponse{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAnomaliesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAnomaliesResponse) ProtoMessage() {}
+
+func (x *ListAnomaliesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAnomaliesResponse.ProtoReflect.Descriptor instead.
+func (*ListAnomaliesResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ListAnomaliesResponse) GetAnomalies() []*JobAnomaly {
+	if x != nil {
+		return x.Anomalies
+	}
+	return nil
+}
+
+// GenerationDraft holds generation wizard selections that haven't been used to start
+// generation yet. Drafts untouched for 30 days are deleted.
+type GenerationDraft struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Input         *CourseGenerationInput `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"` // preferences are not stored
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerationDraft) Reset() {
+	*x = GenerationDraft{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerationDraft) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerationDraft) ProtoMessage() {}
+
+func (x *GenerationDraft) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerationDraft.ProtoReflect.Descriptor instead.
+func (*GenerationDraft) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *GenerationDraft) GetInput() *CourseGenerationInput {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *GenerationDraft) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// SaveGenerationDraftRequest saves wizard selections for input.course_id.
+type SaveGenerationDraftRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Input         *CourseGenerationInput `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveGenerationDraftRequest) Reset() {
+	*x = SaveGenerationDraftRequest{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveGenerationDraftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveGenerationDraftRequest) ProtoMessage() {}
+
+func (x *SaveGenerationDraftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveGenerationDraftRequest.ProtoReflect.Descriptor instead.
+func (*SaveGenerationDraftRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *SaveGenerationDraftRequest) GetInput() *CourseGenerationInput {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+// SaveGenerationDraftResponse returns the saved draft.
+type SaveGenerationDraftResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Draft         *GenerationDraft       `protobuf:"bytes,1,opt,name=draft,proto3" json:"draft,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveGenerationDraftResponse) Reset() {
+	*x = SaveGenerationDraftResponse{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveGenerationDraftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveGenerationDraftResponse) ProtoMessage() {}
+
+func (x *SaveGenerationDraftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveGenerationDraftResponse.ProtoReflect.Descriptor instead.
+func (*SaveGenerationDraftResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *SaveGenerationDraftResponse) GetDraft() *GenerationDraft {
+	if x != nil {
+		return x.Draft
 	}
 	return nil
 }
 
-// GetCourseOutlineRequest fetches the outline for a course.
-type GetCourseOutlineRequest struct {
+// GetGenerationDraftRequest fetches the draft for a course.
+type GetGenerationDraftRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGenerationDraftRequest) Reset() {
+	*x = GetGenerationDraftRequest{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGenerationDraftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGenerationDraftRequest) ProtoMessage() {}
+
+func (x *GetGenerationDraftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGenerationDraftRequest.ProtoReflect.Descriptor instead.
+func (*GetGenerationDraftRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *GetGenerationDraftRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+// GetGenerationDraftResponse contains the draft, unset if there is none.
+type GetGenerationDraftResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
-	Version       *int32                 `protobuf:"varint,2,opt,name=version,proto3,oneof" json:"version,omitempty"` // If not specified, returns latest
+	Draft         *GenerationDraft       `protobuf:"bytes,1,opt,name=draft,proto3,oneof" json:"draft,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCourseOutlineRequest) Reset() {
-	*x = GetCourseOutlineRequest{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[15]
+func (x *GetGenerationDraftResponse) Reset() {
+	*x = GetGenerationDraftResponse{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCourseOutlineRequest) String() string {
+func (x *GetGenerationDraftResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCourseOutlineRequest) ProtoMessage() {}
+func (*GetGenerationDraftResponse) ProtoMessage() {}
 
-func (x *GetCourseOutlineRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[15]
+func (x *GetGenerationDraftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1546,48 +6112,41 @@ func (x *GetCourseOutlineRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCourseOutlineRequest.ProtoReflect.Descriptor instead.
-func (*GetCourseOutlineRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use GetGenerationDraftResponse.ProtoReflect.Descriptor instead.
+func (*GetGenerationDraftResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *GetCourseOutlineRequest) GetCourseId() string {
+func (x *GetGenerationDraftResponse) GetDraft() *GenerationDraft {
 	if x != nil {
-		return x.CourseId
-	}
-	return ""
-}
-
-func (x *GetCourseOutlineRequest) GetVersion() int32 {
-	if x != nil && x.Version != nil {
-		return *x.Version
+		return x.Draft
 	}
-	return 0
+	return nil
 }
 
-// GetCourseOutlineResponse contains the outline.
-type GetCourseOutlineResponse struct {
+// StartStorageAuditRequest configures a storage audit.
+type StartStorageAuditRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Outline       *CourseOutline         `protobuf:"bytes,1,opt,name=outline,proto3" json:"outline,omitempty"`
+	PurgeOrphans  bool                   `protobuf:"varint,1,opt,name=purge_orphans,json=purgeOrphans,proto3" json:"purge_orphans,omitempty"` // Delete the orphaned objects found, not just report them
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCourseOutlineResponse) Reset() {
-	*x = GetCourseOutlineResponse{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[16]
+func (x *StartStorageAuditRequest) Reset() {
+	*x = StartStorageAuditRequest{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCourseOutlineResponse) String() string {
+func (x *StartStorageAuditRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCourseOutlineResponse) ProtoMessage() {}
+func (*StartStorageAuditRequest) ProtoMessage() {}
 
-func (x *GetCourseOutlineResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[16]
+func (x *StartStorageAuditRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1598,42 +6157,41 @@ func (x *GetCourseOutlineResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCourseOutlineResponse.ProtoReflect.Descriptor instead.
-func (*GetCourseOutlineResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use StartStorageAuditRequest.ProtoReflect.Descriptor instead.
+func (*StartStorageAuditRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *GetCourseOutlineResponse) GetOutline() *CourseOutline {
+func (x *StartStorageAuditRequest) GetPurgeOrphans() bool {
 	if x != nil {
-		return x.Outline
+		return x.PurgeOrphans
 	}
-	return nil
+	return false
 }
 
-// ApproveCourseOutlineRequest approves an outline.
-type ApproveCourseOutlineRequest struct {
+// StartStorageAuditResponse returns the audit job.
+type StartStorageAuditResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
-	OutlineId     string                 `protobuf:"bytes,2,opt,name=outline_id,json=outlineId,proto3" json:"outline_id,omitempty"`
+	Job           *GenerationJob         `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApproveCourseOutlineRequest) Reset() {
-	*x = ApproveCourseOutlineRequest{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[17]
+func (x *StartStorageAuditResponse) Reset() {
+	*x = StartStorageAuditResponse{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApproveCourseOutlineRequest) String() string {
+func (x *StartStorageAuditResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApproveCourseOutlineRequest) ProtoMessage() {}
+func (*StartStorageAuditResponse) ProtoMessage() {}
 
-func (x *ApproveCourseOutlineRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[17]
+func (x *StartStorageAuditResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1644,48 +6202,88 @@ func (x *ApproveCourseOutlineRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApproveCourseOutlineRequest.ProtoReflect.Descriptor instead.
-func (*ApproveCourseOutlineRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use StartStorageAuditResponse.ProtoReflect.Descriptor instead.
+func (*StartStorageAuditResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *ApproveCourseOutlineRequest) GetCourseId() string {
+func (x *StartStorageAuditResponse) GetJob() *GenerationJob {
 	if x != nil {
-		return x.CourseId
+		return x.Job
 	}
-	return ""
+	return nil
 }
 
-func (x *ApproveCourseOutlineRequest) GetOutlineId() string {
+// GetStorageAuditReportRequest identifies a storage audit job.
+type GetStorageAuditReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStorageAuditReportRequest) Reset() {
+	*x = GetStorageAuditReportRequest{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStorageAuditReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStorageAuditReportRequest) ProtoMessage() {}
+
+func (x *GetStorageAuditReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[88]
 	if x != nil {
-		return x.OutlineId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStorageAuditReportRequest.ProtoReflect.Descriptor instead.
+func (*GetStorageAuditReportRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *GetStorageAuditReportRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
 	}
 	return ""
 }
 
-// ApproveCourseOutlineResponse confirms approval.
-type ApproveCourseOutlineResponse struct {
+// GetStorageAuditReportResponse contains the audit job and its report link.
+type GetStorageAuditReportResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Outline       *CourseOutline         `protobuf:"bytes,1,opt,name=outline,proto3" json:"outline,omitempty"`
+	Job           *GenerationJob         `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	DownloadUrl   *string                `protobuf:"bytes,2,opt,name=download_url,json=downloadUrl,proto3,oneof" json:"download_url,omitempty"` // Set once the job has completed
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApproveCourseOutlineResponse) Reset() {
-	*x = ApproveCourseOutlineResponse{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[18]
+func (x *GetStorageAuditReportResponse) Reset() {
+	*x = GetStorageAuditReportResponse{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApproveCourseOutlineResponse) String() string {
+func (x *GetStorageAuditReportResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApproveCourseOutlineResponse) ProtoMessage() {}
+func (*GetStorageAuditReportResponse) ProtoMessage() {}
 
-func (x *ApproveCourseOutlineResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[18]
+func (x *GetStorageAuditReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1696,43 +6294,56 @@ func (x *ApproveCourseOutlineResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApproveCourseOutlineResponse.ProtoReflect.Descriptor instead.
-func (*ApproveCourseOutlineResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use GetStorageAuditReportResponse.ProtoReflect.Descriptor instead.
+func (*GetStorageAuditReportResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *ApproveCourseOutlineResponse) GetOutline() *CourseOutline {
+func (x *GetStorageAuditReportResponse) GetJob() *GenerationJob {
 	if x != nil {
-		return x.Outline
+		return x.Job
 	}
 	return nil
 }
 
-// RejectCourseOutlineRequest rejects an outline.
-type RejectCourseOutlineRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
-	OutlineId     string                 `protobuf:"bytes,2,opt,name=outline_id,json=outlineId,proto3" json:"outline_id,omitempty"`
-	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetStorageAuditReportResponse) GetDownloadUrl() string {
+	if x != nil && x.DownloadUrl != nil {
+		return *x.DownloadUrl
+	}
+	return ""
 }
 
-func (x *RejectCourseOutlineRequest) Reset() {
-	*x = RejectCourseOutlineRequest{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[19]
+func (x *GetStorageAuditReportResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// TranslateCourseRequest identifies the course to translate.
+type TranslateCourseRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CourseId       string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	TargetLanguage string                 `protobuf:"bytes,2,opt,name=target_language,json=targetLanguage,proto3" json:"target_language,omitempty"` // BCP 47 language tag, such as "es" or "pt-BR"
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TranslateCourseRequest) Reset() {
+	*x = TranslateCourseRequest{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RejectCourseOutlineRequest) String() string {
+func (x *TranslateCourseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RejectCourseOutlineRequest) ProtoMessage() {}
+func (*TranslateCourseRequest) ProtoMessage() {}
 
-func (x *RejectCourseOutlineRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[19]
+func (x *TranslateCourseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1743,55 +6354,110 @@ func (x *RejectCourseOutlineRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RejectCourseOutlineRequest.ProtoReflect.Descriptor instead.
-func (*RejectCourseOutlineRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use TranslateCourseRequest.ProtoReflect.Descriptor instead.
+func (*TranslateCourseRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *RejectCourseOutlineRequest) GetCourseId() string {
+func (x *TranslateCourseRequest) GetCourseId() string {
 	if x != nil {
 		return x.CourseId
 	}
 	return ""
 }
 
-func (x *RejectCourseOutlineRequest) GetOutlineId() string {
+func (x *TranslateCourseRequest) GetTargetLanguage() string {
 	if x != nil {
-		return x.OutlineId
+		return x.TargetLanguage
 	}
 	return ""
 }
 
-func (x *RejectCourseOutlineRequest) GetReason() string {
+// TranslateCourseResponse returns the translation job and the course it fills.
+type TranslateCourseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *GenerationJob         `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	CourseId      string                 `protobuf:"bytes,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"` // The new translated course
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TranslateCourseResponse) Reset() {
+	*x = TranslateCourseResponse{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TranslateCourseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TranslateCourseResponse) ProtoMessage() {}
+
+func (x *TranslateCourseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[91]
 	if x != nil {
-		return x.Reason
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TranslateCourseResponse.ProtoReflect.Descriptor instead.
+func (*TranslateCourseResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *TranslateCourseResponse) GetJob() *GenerationJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+func (x *TranslateCourseResponse) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
 	}
 	return ""
 }
 
-// RejectCourseOutlineResponse confirms rejection.
-type RejectCourseOutlineResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Outline       *CourseOutline         `protobuf:"bytes,1,opt,name=outline,proto3" json:"outline,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// OutlineComment is a reviewer comment on an outline lesson.
+type OutlineComment struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CourseId         string                 `protobuf:"bytes,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	LessonKey        string                 `protobuf:"bytes,3,opt,name=lesson_key,json=lessonKey,proto3" json:"lesson_key,omitempty"` // Matches OutlineLesson.lesson_key in every outline version
+	AuthorUserId     *string                `protobuf:"bytes,4,opt,name=author_user_id,json=authorUserId,proto3,oneof" json:"author_user_id,omitempty"`
+	AuthorName       string                 `protobuf:"bytes,5,opt,name=author_name,json=authorName,proto3" json:"author_name,omitempty"`
+	Body             string                 `protobuf:"bytes,6,opt,name=body,proto3" json:"body,omitempty"`
+	Resolved         bool                   `protobuf:"varint,7,opt,name=resolved,proto3" json:"resolved,omitempty"`
+	ResolvedByUserId *string                `protobuf:"bytes,8,opt,name=resolved_by_user_id,json=resolvedByUserId,proto3,oneof" json:"resolved_by_user_id,omitempty"`
+	ResolvedAt       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=resolved_at,json=resolvedAt,proto3,oneof" json:"resolved_at,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *RejectCourseOutlineResponse) Reset() {
-	*x = RejectCourseOutlineResponse{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[20]
+func (x *OutlineComment) Reset() {
+	*x = OutlineComment{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RejectCourseOutlineResponse) String() string {
+func (x *OutlineComment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RejectCourseOutlineResponse) ProtoMessage() {}
+func (*OutlineComment) ProtoMessage() {}
 
-func (x *RejectCourseOutlineResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[20]
+func (x *OutlineComment) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1802,43 +6468,106 @@ func (x *RejectCourseOutlineResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RejectCourseOutlineResponse.ProtoReflect.Descriptor instead.
-func (*RejectCourseOutlineResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use OutlineComment.ProtoReflect.Descriptor instead.
+func (*OutlineComment) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *RejectCourseOutlineResponse) GetOutline() *CourseOutline {
+func (x *OutlineComment) GetId() string {
 	if x != nil {
-		return x.Outline
+		return x.Id
+	}
+	return ""
+}
+
+func (x *OutlineComment) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *OutlineComment) GetLessonKey() string {
+	if x != nil {
+		return x.LessonKey
+	}
+	return ""
+}
+
+func (x *OutlineComment) GetAuthorUserId() string {
+	if x != nil && x.AuthorUserId != nil {
+		return *x.AuthorUserId
+	}
+	return ""
+}
+
+func (x *OutlineComment) GetAuthorName() string {
+	if x != nil {
+		return x.AuthorName
+	}
+	return ""
+}
+
+func (x *OutlineComment) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *OutlineComment) GetResolved() bool {
+	if x != nil {
+		return x.Resolved
+	}
+	return false
+}
+
+func (x *OutlineComment) GetResolvedByUserId() string {
+	if x != nil && x.ResolvedByUserId != nil {
+		return *x.ResolvedByUserId
+	}
+	return ""
+}
+
+func (x *OutlineComment) GetResolvedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResolvedAt
 	}
 	return nil
 }
 
-// UpdateCourseOutlineRequest allows editing the outline.
-type UpdateCourseOutlineRequest struct {
+func (x *OutlineComment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// CreateOutlineCommentRequest identifies the lesson to comment on.
+type CreateOutlineCommentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
-	OutlineId     string                 `protobuf:"bytes,2,opt,name=outline_id,json=outlineId,proto3" json:"outline_id,omitempty"`
-	Sections      []*OutlineSection      `protobuf:"bytes,3,rep,name=sections,proto3" json:"sections,omitempty"`
+	LessonId      string                 `protobuf:"bytes,2,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"` // Outline lesson ID
+	Body          string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateCourseOutlineRequest) Reset() {
-	*x = UpdateCourseOutlineRequest{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[21]
+func (x *CreateOutlineCommentRequest) Reset() {
+	*x = CreateOutlineCommentRequest{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCourseOutlineRequest) String() string {
+func (x *CreateOutlineCommentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCourseOutlineRequest) ProtoMessage() {}
+func (*CreateOutlineCommentRequest) ProtoMessage() {}
 
-func (x *UpdateCourseOutlineRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[21]
+func (x *CreateOutlineCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1849,55 +6578,55 @@ func (x *UpdateCourseOutlineRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCourseOutlineRequest.ProtoReflect.Descriptor instead.
-func (*UpdateCourseOutlineRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use CreateOutlineCommentRequest.ProtoReflect.Descriptor instead.
+func (*CreateOutlineCommentRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *UpdateCourseOutlineRequest) GetCourseId() string {
+func (x *CreateOutlineCommentRequest) GetCourseId() string {
 	if x != nil {
 		return x.CourseId
 	}
 	return ""
 }
 
-func (x *UpdateCourseOutlineRequest) GetOutlineId() string {
+func (x *CreateOutlineCommentRequest) GetLessonId() string {
 	if x != nil {
-		return x.OutlineId
+		return x.LessonId
 	}
 	return ""
 }
 
-func (x *UpdateCourseOutlineRequest) GetSections() []*OutlineSection {
+func (x *CreateOutlineCommentRequest) GetBody() string {
 	if x != nil {
-		return x.Sections
+		return x.Body
 	}
-	return nil
+	return ""
 }
 
-// UpdateCourseOutlineResponse contains the updated outline.
-type UpdateCourseOutlineResponse struct {
+// CreateOutlineCommentResponse returns the new comment.
+type CreateOutlineCommentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Outline       *CourseOutline         `protobuf:"bytes,1,opt,name=outline,proto3" json:"outline,omitempty"`
+	Comment       *OutlineComment        `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateCourseOutlineResponse) Reset() {
-	*x = UpdateCourseOutlineResponse{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[22]
+func (x *CreateOutlineCommentResponse) Reset() {
+	*x = CreateOutlineCommentResponse{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCourseOutlineResponse) String() string {
+func (x *CreateOutlineCommentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCourseOutlineResponse) ProtoMessage() {}
+func (*CreateOutlineCommentResponse) ProtoMessage() {}
 
-func (x *UpdateCourseOutlineResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[22]
+func (x *CreateOutlineCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1908,42 +6637,42 @@ func (x *UpdateCourseOutlineResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCourseOutlineResponse.ProtoReflect.Descriptor instead.
-func (*UpdateCourseOutlineResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use CreateOutlineCommentResponse.ProtoReflect.Descriptor instead.
+func (*CreateOutlineCommentResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *UpdateCourseOutlineResponse) GetOutline() *CourseOutline {
+func (x *CreateOutlineCommentResponse) GetComment() *OutlineComment {
 	if x != nil {
-		return x.Outline
+		return x.Comment
 	}
 	return nil
 }
 
-// GenerateLessonContentRequest generates content for one lesson.
-type GenerateLessonContentRequest struct {
+// ListOutlineCommentsRequest identifies the course whose comments to list.
+type ListOutlineCommentsRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	CourseId        string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
-	OutlineLessonId string                 `protobuf:"bytes,2,opt,name=outline_lesson_id,json=outlineLessonId,proto3" json:"outline_lesson_id,omitempty"`
+	IncludeResolved bool                   `protobuf:"varint,2,opt,name=include_resolved,json=includeResolved,proto3" json:"include_resolved,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
-func (x *GenerateLessonContentRequest) Reset() {
-	*x = GenerateLessonContentRequest{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[23]
+func (x *ListOutlineCommentsRequest) Reset() {
+	*x = ListOutlineCommentsRequest{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GenerateLessonContentRequest) String() string {
+func (x *ListOutlineCommentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateLessonContentRequest) ProtoMessage() {}
+func (*ListOutlineCommentsRequest) ProtoMessage() {}
 
-func (x *GenerateLessonContentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[23]
+func (x *ListOutlineCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1954,48 +6683,48 @@ func (x *GenerateLessonContentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateLessonContentRequest.ProtoReflect.Descriptor instead.
-func (*GenerateLessonContentRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use ListOutlineCommentsRequest.ProtoReflect.Descriptor instead.
+func (*ListOutlineCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *GenerateLessonContentRequest) GetCourseId() string {
+func (x *ListOutlineCommentsRequest) GetCourseId() string {
 	if x != nil {
 		return x.CourseId
 	}
 	return ""
 }
 
-func (x *GenerateLessonContentRequest) GetOutlineLessonId() string {
+func (x *ListOutlineCommentsRequest) GetIncludeResolved() bool {
 	if x != nil {
-		return x.OutlineLessonId
+		return x.IncludeResolved
 	}
-	return ""
+	return false
 }
 
-// GenerateLessonContentResponse returns the job ID.
-type GenerateLessonContentResponse struct {
+// ListOutlineCommentsResponse contains the comments, oldest first.
+type ListOutlineCommentsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Job           *GenerationJob         `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	Comments      []*OutlineComment      `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GenerateLessonContentResponse) Reset() {
-	*x = GenerateLessonContentResponse{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[24]
+func (x *ListOutlineCommentsResponse) Reset() {
+	*x = ListOutlineCommentsResponse{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GenerateLessonContentResponse) String() string {
+func (x *ListOutlineCommentsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateLessonContentResponse) ProtoMessage() {}
+func (*ListOutlineCommentsResponse) ProtoMessage() {}
 
-func (x *GenerateLessonContentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[24]
+func (x *ListOutlineCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2006,41 +6735,41 @@ func (x *GenerateLessonContentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateLessonContentResponse.ProtoReflect.Descriptor instead.
-func (*GenerateLessonContentResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use ListOutlineCommentsResponse.ProtoReflect.Descriptor instead.
+func (*ListOutlineCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *GenerateLessonContentResponse) GetJob() *GenerationJob {
+func (x *ListOutlineCommentsResponse) GetComments() []*OutlineComment {
 	if x != nil {
-		return x.Job
+		return x.Comments
 	}
 	return nil
 }
 
-// GenerateAllLessonsRequest generates all lessons for a course.
-type GenerateAllLessonsRequest struct {
+// ResolveOutlineCommentRequest identifies the comment to resolve.
+type ResolveOutlineCommentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	CommentId     string                 `protobuf:"bytes,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GenerateAllLessonsRequest) Reset() {
-	*x = GenerateAllLessonsRequest{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[25]
+func (x *ResolveOutlineCommentRequest) Reset() {
+	*x = ResolveOutlineCommentRequest{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GenerateAllLessonsRequest) String() string {
+func (x *ResolveOutlineCommentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateAllLessonsRequest) ProtoMessage() {}
+func (*ResolveOutlineCommentRequest) ProtoMessage() {}
 
-func (x *GenerateAllLessonsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[25]
+func (x *ResolveOutlineCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2051,41 +6780,41 @@ func (x *GenerateAllLessonsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateAllLessonsRequest.ProtoReflect.Descriptor instead.
-func (*GenerateAllLessonsRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use ResolveOutlineCommentRequest.ProtoReflect.Descriptor instead.
+func (*ResolveOutlineCommentRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *GenerateAllLessonsRequest) GetCourseId() string {
+func (x *ResolveOutlineCommentRequest) GetCommentId() string {
 	if x != nil {
-		return x.CourseId
+		return x.CommentId
 	}
 	return ""
 }
 
-// GenerateAllLessonsResponse returns the job ID.
-type GenerateAllLessonsResponse struct {
+// ResolveOutlineCommentResponse returns the resolved comment.
+type ResolveOutlineCommentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Job           *GenerationJob         `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	Comment       *OutlineComment        `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GenerateAllLessonsResponse) Reset() {
-	*x = GenerateAllLessonsResponse{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[26]
+func (x *ResolveOutlineCommentResponse) Reset() {
+	*x = ResolveOutlineCommentResponse{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GenerateAllLessonsResponse) String() string {
+func (x *ResolveOutlineCommentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateAllLessonsResponse) ProtoMessage() {}
+func (*ResolveOutlineCommentResponse) ProtoMessage() {}
 
-func (x *GenerateAllLessonsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[26]
+func (x *ResolveOutlineCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2096,44 +6825,43 @@ func (x *GenerateAllLessonsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateAllLessonsResponse.ProtoReflect.Descriptor instead.
-func (*GenerateAllLessonsResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use ResolveOutlineCommentResponse.ProtoReflect.Descriptor instead.
+func (*ResolveOutlineCommentResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *GenerateAllLessonsResponse) GetJob() *GenerationJob {
+func (x *ResolveOutlineCommentResponse) GetComment() *OutlineComment {
 	if x != nil {
-		return x.Job
+		return x.Comment
 	}
 	return nil
 }
 
-// RegenerateComponentRequest regenerates a single component.
-type RegenerateComponentRequest struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	CourseId           string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
-	LessonId           string                 `protobuf:"bytes,2,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"`
-	ComponentId        string                 `protobuf:"bytes,3,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
-	ModificationPrompt string                 `protobuf:"bytes,4,opt,name=modification_prompt,json=modificationPrompt,proto3" json:"modification_prompt,omitempty"` // Instructions for regeneration
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+// SetTenantAIEnabledRequest selects the tenant and whether it may generate.
+type SetTenantAIEnabledRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Enabled       bool                   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"` // Required to pause; recorded in the tenant's audit log
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RegenerateComponentRequest) Reset() {
-	*x = RegenerateComponentRequest{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[27]
+func (x *SetTenantAIEnabledRequest) Reset() {
+	*x = SetTenantAIEnabledRequest{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RegenerateComponentRequest) String() string {
+func (x *SetTenantAIEnabledRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegenerateComponentRequest) ProtoMessage() {}
+func (*SetTenantAIEnabledRequest) ProtoMessage() {}
 
-func (x *RegenerateComponentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[27]
+func (x *SetTenantAIEnabledRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2144,62 +6872,56 @@ func (x *RegenerateComponentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegenerateComponentRequest.ProtoReflect.Descriptor instead.
-func (*RegenerateComponentRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{27}
-}
-
-func (x *RegenerateComponentRequest) GetCourseId() string {
-	if x != nil {
-		return x.CourseId
-	}
-	return ""
+// Deprecated: Use SetTenantAIEnabledRequest.ProtoReflect.Descriptor instead.
+func (*SetTenantAIEnabledRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *RegenerateComponentRequest) GetLessonId() string {
+func (x *SetTenantAIEnabledRequest) GetTenantId() string {
 	if x != nil {
-		return x.LessonId
+		return x.TenantId
 	}
 	return ""
 }
 
-func (x *RegenerateComponentRequest) GetComponentId() string {
+func (x *SetTenantAIEnabledRequest) GetEnabled() bool {
 	if x != nil {
-		return x.ComponentId
+		return x.Enabled
 	}
-	return ""
+	return false
 }
 
-func (x *RegenerateComponentRequest) GetModificationPrompt() string {
+func (x *SetTenantAIEnabledRequest) GetReason() string {
 	if x != nil {
-		return x.ModificationPrompt
+		return x.Reason
 	}
 	return ""
 }
 
-// RegenerateComponentResponse returns the job ID.
-type RegenerateComponentResponse struct {
+// SetTenantAIEnabledResponse reports the tenant's new state.
+type SetTenantAIEnabledResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Job           *GenerationJob         `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	QueuedJobs    int32                  `protobuf:"varint,2,opt,name=queued_jobs,json=queuedJobs,proto3" json:"queued_jobs,omitempty"` // Jobs waiting while paused, or sent back to the worker on resume
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RegenerateComponentResponse) Reset() {
-	*x = RegenerateComponentResponse{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[28]
+func (x *SetTenantAIEnabledResponse) Reset() {
+	*x = SetTenantAIEnabledResponse{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RegenerateComponentResponse) String() string {
+func (x *SetTenantAIEnabledResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegenerateComponentResponse) ProtoMessage() {}
+func (*SetTenantAIEnabledResponse) ProtoMessage() {}
 
-func (x *RegenerateComponentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[28]
+func (x *SetTenantAIEnabledResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2210,41 +6932,54 @@ func (x *RegenerateComponentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegenerateComponentResponse.ProtoReflect.Descriptor instead.
-func (*RegenerateComponentResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use SetTenantAIEnabledResponse.ProtoReflect.Descriptor instead.
+func (*SetTenantAIEnabledResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *RegenerateComponentResponse) GetJob() *GenerationJob {
+func (x *SetTenantAIEnabledResponse) GetEnabled() bool {
 	if x != nil {
-		return x.Job
+		return x.Enabled
 	}
-	return nil
+	return false
 }
 
-// GetJobRequest fetches a job by ID.
-type GetJobRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SetTenantAIEnabledResponse) GetQueuedJobs() int32 {
+	if x != nil {
+		return x.QueuedJobs
+	}
+	return 0
 }
 
-func (x *GetJobRequest) Reset() {
-	*x = GetJobRequest{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[29]
+// AccessibilityIssue is an accessibility problem in one lesson component.
+type AccessibilityIssue struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Type                    AccessibilityIssueType `protobuf:"varint,1,opt,name=type,proto3,enum=mirai.v1.AccessibilityIssueType" json:"type,omitempty"`
+	LessonId                string                 `protobuf:"bytes,2,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"`
+	LessonTitle             string                 `protobuf:"bytes,3,opt,name=lesson_title,json=lessonTitle,proto3" json:"lesson_title,omitempty"`
+	ComponentId             string                 `protobuf:"bytes,4,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	Position                int32                  `protobuf:"varint,5,opt,name=position,proto3" json:"position,omitempty"`
+	Detail                  string                 `protobuf:"bytes,6,opt,name=detail,proto3" json:"detail,omitempty"`
+	AltTextGenerationFailed bool                   `protobuf:"varint,7,opt,name=alt_text_generation_failed,json=altTextGenerationFailed,proto3" json:"alt_text_generation_failed,omitempty"` // Generation already tried; the author has to write the alt text
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *AccessibilityIssue) Reset() {
+	*x = AccessibilityIssue{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetJobRequest) String() string {
+func (x *AccessibilityIssue) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetJobRequest) ProtoMessage() {}
+func (*AccessibilityIssue) ProtoMessage() {}
 
-func (x *GetJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[29]
+func (x *AccessibilityIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2255,88 +6990,83 @@ func (x *GetJobRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetJobRequest.ProtoReflect.Descriptor instead.
-func (*GetJobRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use AccessibilityIssue.ProtoReflect.Descriptor instead.
+func (*AccessibilityIssue) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *GetJobRequest) GetJobId() string {
+func (x *AccessibilityIssue) GetType() AccessibilityIssueType {
 	if x != nil {
-		return x.JobId
+		return x.Type
 	}
-	return ""
+	return AccessibilityIssueType_ACCESSIBILITY_ISSUE_TYPE_UNSPECIFIED
 }
 
-// GetJobResponse contains the job.
-type GetJobResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Job           *GenerationJob         `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *AccessibilityIssue) GetLessonId() string {
+	if x != nil {
+		return x.LessonId
+	}
+	return ""
 }
 
-func (x *GetJobResponse) Reset() {
-	*x = GetJobResponse{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[30]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *AccessibilityIssue) GetLessonTitle() string {
+	if x != nil {
+		return x.LessonTitle
+	}
+	return ""
 }
 
-func (x *GetJobResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AccessibilityIssue) GetComponentId() string {
+	if x != nil {
+		return x.ComponentId
+	}
+	return ""
 }
 
-func (*GetJobResponse) ProtoMessage() {}
-
-func (x *GetJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[30]
+func (x *AccessibilityIssue) GetPosition() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Position
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetJobResponse.ProtoReflect.Descriptor instead.
-func (*GetJobResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{30}
+func (x *AccessibilityIssue) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
 }
 
-func (x *GetJobResponse) GetJob() *GenerationJob {
+func (x *AccessibilityIssue) GetAltTextGenerationFailed() bool {
 	if x != nil {
-		return x.Job
+		return x.AltTextGenerationFailed
 	}
-	return nil
+	return false
 }
 
-// ListJobsRequest contains filters for jobs.
-type ListJobsRequest struct {
+// GetAccessibilityReportRequest identifies the course to check.
+type GetAccessibilityReportRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Type          *GenerationJobType     `protobuf:"varint,1,opt,name=type,proto3,enum=mirai.v1.GenerationJobType,oneof" json:"type,omitempty"`
-	Status        *GenerationJobStatus   `protobuf:"varint,2,opt,name=status,proto3,enum=mirai.v1.GenerationJobStatus,oneof" json:"status,omitempty"`
-	CourseId      *string                `protobuf:"bytes,3,opt,name=course_id,json=courseId,proto3,oneof" json:"course_id,omitempty"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListJobsRequest) Reset() {
-	*x = ListJobsRequest{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[31]
+func (x *GetAccessibilityReportRequest) Reset() {
+	*x = GetAccessibilityReportRequest{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListJobsRequest) String() string {
+func (x *GetAccessibilityReportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListJobsRequest) ProtoMessage() {}
+func (*GetAccessibilityReportRequest) ProtoMessage() {}
 
-func (x *ListJobsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[31]
+func (x *GetAccessibilityReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2347,55 +7077,43 @@ func (x *ListJobsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListJobsRequest.ProtoReflect.Descriptor instead.
-func (*ListJobsRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{31}
-}
-
-func (x *ListJobsRequest) GetType() GenerationJobType {
-	if x != nil && x.Type != nil {
-		return *x.Type
-	}
-	return GenerationJobType_GENERATION_JOB_TYPE_UNSPECIFIED
-}
-
-func (x *ListJobsRequest) GetStatus() GenerationJobStatus {
-	if x != nil && x.Status != nil {
-		return *x.Status
-	}
-	return GenerationJobStatus_GENERATION_JOB_STATUS_UNSPECIFIED
+// Deprecated: Use GetAccessibilityReportRequest.ProtoReflect.Descriptor instead.
+func (*GetAccessibilityReportRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *ListJobsRequest) GetCourseId() string {
-	if x != nil && x.CourseId != nil {
-		return *x.CourseId
+func (x *GetAccessibilityReportRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
 	}
 	return ""
 }
 
-// ListJobsResponse contains matching jobs.
-type ListJobsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Jobs          []*GenerationJob       `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// GetAccessibilityReportResponse lists the problems found in the course's draft lessons.
+type GetAccessibilityReportResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Issues            []*AccessibilityIssue  `protobuf:"bytes,1,rep,name=issues,proto3" json:"issues,omitempty"` // By lesson in display order, then component position
+	LessonsChecked    int32                  `protobuf:"varint,2,opt,name=lessons_checked,json=lessonsChecked,proto3" json:"lessons_checked,omitempty"`
+	ComponentsChecked int32                  `protobuf:"varint,3,opt,name=components_checked,json=componentsChecked,proto3" json:"components_checked,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *ListJobsResponse) Reset() {
-	*x = ListJobsResponse{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[32]
+func (x *GetAccessibilityReportResponse) Reset() {
+	*x = GetAccessibilityReportResponse{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListJobsResponse) String() string {
+func (x *GetAccessibilityReportResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListJobsResponse) ProtoMessage() {}
+func (*GetAccessibilityReportResponse) ProtoMessage() {}
 
-func (x *ListJobsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[32]
+func (x *GetAccessibilityReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2406,41 +7124,58 @@ func (x *ListJobsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListJobsResponse.ProtoReflect.Descriptor instead.
-func (*ListJobsResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use GetAccessibilityReportResponse.ProtoReflect.Descriptor instead.
+func (*GetAccessibilityReportResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{103}
 }
 
-func (x *ListJobsResponse) GetJobs() []*GenerationJob {
+func (x *GetAccessibilityReportResponse) GetIssues() []*AccessibilityIssue {
 	if x != nil {
-		return x.Jobs
+		return x.Issues
 	}
 	return nil
 }
 
-// CancelJobRequest cancels a job.
-type CancelJobRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+func (x *GetAccessibilityReportResponse) GetLessonsChecked() int32 {
+	if x != nil {
+		return x.LessonsChecked
+	}
+	return 0
+}
+
+func (x *GetAccessibilityReportResponse) GetComponentsChecked() int32 {
+	if x != nil {
+		return x.ComponentsChecked
+	}
+	return 0
+}
+
+// OutlineBalanceChange is one split or merge in a balancing proposal.
+type OutlineBalanceChange struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Kind          OutlineBalanceChangeKind `protobuf:"varint,1,opt,name=kind,proto3,enum=mirai.v1.OutlineBalanceChangeKind" json:"kind,omitempty"`
+	SectionId     string                   `protobuf:"bytes,2,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty"`
+	LessonIds     []string                 `protobuf:"bytes,3,rep,name=lesson_ids,json=lessonIds,proto3" json:"lesson_ids,omitempty"` // The lesson split, or the two lessons merged
+	Titles        []string                 `protobuf:"bytes,4,rep,name=titles,proto3" json:"titles,omitempty"`                        // Titles of the resulting lessons
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelJobRequest) Reset() {
-	*x = CancelJobRequest{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[33]
+func (x *OutlineBalanceChange) Reset() {
+	*x = OutlineBalanceChange{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelJobRequest) String() string {
+func (x *OutlineBalanceChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelJobRequest) ProtoMessage() {}
+func (*OutlineBalanceChange) ProtoMessage() {}
 
-func (x *CancelJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[33]
+func (x *OutlineBalanceChange) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2451,41 +7186,65 @@ func (x *CancelJobRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelJobRequest.ProtoReflect.Descriptor instead.
-func (*CancelJobRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use OutlineBalanceChange.ProtoReflect.Descriptor instead.
+func (*OutlineBalanceChange) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{104}
 }
 
-func (x *CancelJobRequest) GetJobId() string {
+func (x *OutlineBalanceChange) GetKind() OutlineBalanceChangeKind {
 	if x != nil {
-		return x.JobId
+		return x.Kind
+	}
+	return OutlineBalanceChangeKind_OUTLINE_BALANCE_CHANGE_KIND_UNSPECIFIED
+}
+
+func (x *OutlineBalanceChange) GetSectionId() string {
+	if x != nil {
+		return x.SectionId
 	}
 	return ""
 }
 
-// CancelJobResponse confirms cancellation.
-type CancelJobResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Job           *GenerationJob         `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *OutlineBalanceChange) GetLessonIds() []string {
+	if x != nil {
+		return x.LessonIds
+	}
+	return nil
 }
 
-func (x *CancelJobResponse) Reset() {
-	*x = CancelJobResponse{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[34]
+func (x *OutlineBalanceChange) GetTitles() []string {
+	if x != nil {
+		return x.Titles
+	}
+	return nil
+}
+
+// BalanceOutlineDurationsRequest identifies the outline and the target per-lesson range.
+type BalanceOutlineDurationsRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	CourseId         string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	OutlineId        string                 `protobuf:"bytes,2,opt,name=outline_id,json=outlineId,proto3" json:"outline_id,omitempty"`
+	MinLessonMinutes int32                  `protobuf:"varint,3,opt,name=min_lesson_minutes,json=minLessonMinutes,proto3" json:"min_lesson_minutes,omitempty"` // 0 = 10
+	MaxLessonMinutes int32                  `protobuf:"varint,4,opt,name=max_lesson_minutes,json=maxLessonMinutes,proto3" json:"max_lesson_minutes,omitempty"` // 0 = 30; must be at least twice the minimum
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *BalanceOutlineDurationsRequest) Reset() {
+	*x = BalanceOutlineDurationsRequest{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelJobResponse) String() string {
+func (x *BalanceOutlineDurationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelJobResponse) ProtoMessage() {}
+func (*BalanceOutlineDurationsRequest) ProtoMessage() {}
 
-func (x *CancelJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[34]
+func (x *BalanceOutlineDurationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2496,41 +7255,68 @@ func (x *CancelJobResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelJobResponse.ProtoReflect.Descriptor instead.
-func (*CancelJobResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use BalanceOutlineDurationsRequest.ProtoReflect.Descriptor instead.
+func (*BalanceOutlineDurationsRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *CancelJobResponse) GetJob() *GenerationJob {
+func (x *BalanceOutlineDurationsRequest) GetCourseId() string {
 	if x != nil {
-		return x.Job
+		return x.CourseId
 	}
-	return nil
+	return ""
 }
 
-// GetGeneratedLessonRequest fetches generated lesson content.
-type GetGeneratedLessonRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	LessonId      string                 `protobuf:"bytes,1,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *BalanceOutlineDurationsRequest) GetOutlineId() string {
+	if x != nil {
+		return x.OutlineId
+	}
+	return ""
 }
 
-func (x *GetGeneratedLessonRequest) Reset() {
-	*x = GetGeneratedLessonRequest{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[35]
+func (x *BalanceOutlineDurationsRequest) GetMinLessonMinutes() int32 {
+	if x != nil {
+		return x.MinLessonMinutes
+	}
+	return 0
+}
+
+func (x *BalanceOutlineDurationsRequest) GetMaxLessonMinutes() int32 {
+	if x != nil {
+		return x.MaxLessonMinutes
+	}
+	return 0
+}
+
+// BalanceOutlineDurationsResponse is the proposed revision. Split lessons keep their id for
+// the first half; the second half has an empty id. Merged lessons keep the first lesson's id.
+type BalanceOutlineDurationsResponse struct {
+	state            protoimpl.MessageState  `protogen:"open.v1"`
+	Sections         []*OutlineSection       `protobuf:"bytes,1,rep,name=sections,proto3" json:"sections,omitempty"` // The whole outline as revised
+	RemovedLessonIds []string                `protobuf:"bytes,2,rep,name=removed_lesson_ids,json=removedLessonIds,proto3" json:"removed_lesson_ids,omitempty"`
+	Changes          []*OutlineBalanceChange `protobuf:"bytes,3,rep,name=changes,proto3" json:"changes,omitempty"` // Empty when the outline is already balanced
+	MinLessonMinutes int32                   `protobuf:"varint,4,opt,name=min_lesson_minutes,json=minLessonMinutes,proto3" json:"min_lesson_minutes,omitempty"`
+	MaxLessonMinutes int32                   `protobuf:"varint,5,opt,name=max_lesson_minutes,json=maxLessonMinutes,proto3" json:"max_lesson_minutes,omitempty"`
+	TokensUsed       int64                   `protobuf:"varint,6,opt,name=tokens_used,json=tokensUsed,proto3" json:"tokens_used,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *BalanceOutlineDurationsResponse) Reset() {
+	*x = BalanceOutlineDurationsResponse{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGeneratedLessonRequest) String() string {
+func (x *BalanceOutlineDurationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGeneratedLessonRequest) ProtoMessage() {}
+func (*BalanceOutlineDurationsResponse) ProtoMessage() {}
 
-func (x *GetGeneratedLessonRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[35]
+func (x *BalanceOutlineDurationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2541,41 +7327,79 @@ func (x *GetGeneratedLessonRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGeneratedLessonRequest.ProtoReflect.Descriptor instead.
-func (*GetGeneratedLessonRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use BalanceOutlineDurationsResponse.ProtoReflect.Descriptor instead.
+func (*BalanceOutlineDurationsResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{106}
 }
 
-func (x *GetGeneratedLessonRequest) GetLessonId() string {
+func (x *BalanceOutlineDurationsResponse) GetSections() []*OutlineSection {
 	if x != nil {
-		return x.LessonId
+		return x.Sections
 	}
-	return ""
+	return nil
 }
 
-// GetGeneratedLessonResponse contains the lesson.
-type GetGeneratedLessonResponse struct {
+func (x *BalanceOutlineDurationsResponse) GetRemovedLessonIds() []string {
+	if x != nil {
+		return x.RemovedLessonIds
+	}
+	return nil
+}
+
+func (x *BalanceOutlineDurationsResponse) GetChanges() []*OutlineBalanceChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *BalanceOutlineDurationsResponse) GetMinLessonMinutes() int32 {
+	if x != nil {
+		return x.MinLessonMinutes
+	}
+	return 0
+}
+
+func (x *BalanceOutlineDurationsResponse) GetMaxLessonMinutes() int32 {
+	if x != nil {
+		return x.MaxLessonMinutes
+	}
+	return 0
+}
+
+func (x *BalanceOutlineDurationsResponse) GetTokensUsed() int64 {
+	if x != nil {
+		return x.TokensUsed
+	}
+	return 0
+}
+
+// JobChildCounts counts a parent job's child jobs by status.
+type JobChildCounts struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Lesson        *GeneratedLesson       `protobuf:"bytes,1,opt,name=lesson,proto3" json:"lesson,omitempty"`
+	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Completed     int32                  `protobuf:"varint,2,opt,name=completed,proto3" json:"completed,omitempty"`
+	Failed        int32                  `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	Processing    int32                  `protobuf:"varint,4,opt,name=processing,proto3" json:"processing,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGeneratedLessonResponse) Reset() {
-	*x = GetGeneratedLessonResponse{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[36]
+func (x *JobChildCounts) Reset() {
+	*x = JobChildCounts{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGeneratedLessonResponse) String() string {
+func (x *JobChildCounts) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGeneratedLessonResponse) ProtoMessage() {}
+func (*JobChildCounts) ProtoMessage() {}
 
-func (x *GetGeneratedLessonResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[36]
+func (x *JobChildCounts) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2586,41 +7410,61 @@ func (x *GetGeneratedLessonResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGeneratedLessonResponse.ProtoReflect.Descriptor instead.
-func (*GetGeneratedLessonResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use JobChildCounts.ProtoReflect.Descriptor instead.
+func (*JobChildCounts) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *GetGeneratedLessonResponse) GetLesson() *GeneratedLesson {
+func (x *JobChildCounts) GetTotal() int32 {
 	if x != nil {
-		return x.Lesson
+		return x.Total
 	}
-	return nil
+	return 0
 }
 
-// ListGeneratedLessonsRequest fetches all lessons for a course.
-type ListGeneratedLessonsRequest struct {
+func (x *JobChildCounts) GetCompleted() int32 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+func (x *JobChildCounts) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *JobChildCounts) GetProcessing() int32 {
+	if x != nil {
+		return x.Processing
+	}
+	return 0
+}
+
+// MigrateQuizSchemaRequest is empty.
+type MigrateQuizSchemaRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListGeneratedLessonsRequest) Reset() {
-	*x = ListGeneratedLessonsRequest{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[37]
+func (x *MigrateQuizSchemaRequest) Reset() {
+	*x = MigrateQuizSchemaRequest{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListGeneratedLessonsRequest) String() string {
+func (x *MigrateQuizSchemaRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListGeneratedLessonsRequest) ProtoMessage() {}
+func (*MigrateQuizSchemaRequest) ProtoMessage() {}
 
-func (x *ListGeneratedLessonsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[37]
+func (x *MigrateQuizSchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2631,41 +7475,33 @@ func (x *ListGeneratedLessonsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListGeneratedLessonsRequest.ProtoReflect.Descriptor instead.
-func (*ListGeneratedLessonsRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{37}
-}
-
-func (x *ListGeneratedLessonsRequest) GetCourseId() string {
-	if x != nil {
-		return x.CourseId
-	}
-	return ""
+// Deprecated: Use MigrateQuizSchemaRequest.ProtoReflect.Descriptor instead.
+func (*MigrateQuizSchemaRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{108}
 }
 
-// ListGeneratedLessonsResponse contains the lessons.
-type ListGeneratedLessonsResponse struct {
+// MigrateQuizSchemaResponse is empty; the migration runs on the worker.
+type MigrateQuizSchemaResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Lessons       []*GeneratedLesson     `protobuf:"bytes,1,rep,name=lessons,proto3" json:"lessons,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListGeneratedLessonsResponse) Reset() {
-	*x = ListGeneratedLessonsResponse{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[38]
+func (x *MigrateQuizSchemaResponse) Reset() {
+	*x = MigrateQuizSchemaResponse{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListGeneratedLessonsResponse) String() string {
+func (x *MigrateQuizSchemaResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListGeneratedLessonsResponse) ProtoMessage() {}
+func (*MigrateQuizSchemaResponse) ProtoMessage() {}
 
-func (x *ListGeneratedLessonsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[38]
+func (x *MigrateQuizSchemaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2676,23 +7512,17 @@ func (x *ListGeneratedLessonsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListGeneratedLessonsResponse.ProtoReflect.Descriptor instead.
-func (*ListGeneratedLessonsResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{38}
-}
-
-func (x *ListGeneratedLessonsResponse) GetLessons() []*GeneratedLesson {
-	if x != nil {
-		return x.Lessons
-	}
-	return nil
+// Deprecated: Use MigrateQuizSchemaResponse.ProtoReflect.Descriptor instead.
+func (*MigrateQuizSchemaResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{109}
 }
 
 var File_mirai_v1_ai_generation_proto protoreflect.FileDescriptor
 
 const file_mirai_v1_ai_generation_proto_rawDesc = "" +
 	"\n" +
-	"\x1cmirai/v1/ai_generation.proto\x12\bmirai.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x81\b\n" +
+	"\x1cmirai/v1/ai_generation.proto\x12\bmirai.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc8\n" +
+	"\n" +
 	"\rGenerationJob\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12/\n" +
@@ -2720,7 +7550,13 @@ const file_mirai_v1_ai_generation_proto_rawDesc = "" +
 	"\n" +
 	"started_at\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampH\aR\tstartedAt\x88\x01\x01\x12B\n" +
 	"\fcompleted_at\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampH\bR\vcompletedAt\x88\x01\x01\x12'\n" +
-	"\rparent_job_id\x18\x14 \x01(\tH\tR\vparentJobId\x88\x01\x01B\f\n" +
+	"\rparent_job_id\x18\x14 \x01(\tH\tR\vparentJobId\x88\x01\x01\x12'\n" +
+	"\x0frepair_attempts\x18\x15 \x01(\x05R\x0erepairAttempts\x12F\n" +
+	"\x0efailure_reason\x18\x16 \x01(\x0e2\x1a.mirai.v1.JobFailureReasonH\n" +
+	"R\rfailureReason\x88\x01\x01\x12.\n" +
+	"\x10suggested_action\x18\x17 \x01(\tH\vR\x0fsuggestedAction\x88\x01\x01\x12)\n" +
+	"\x10images_generated\x18\x18 \x01(\x05R\x0fimagesGenerated\x12@\n" +
+	"\fchild_counts\x18\x19 \x01(\v2\x18.mirai.v1.JobChildCountsH\fR\vchildCounts\x88\x01\x01B\f\n" +
 	"\n" +
 	"_course_idB\f\n" +
 	"\n" +
@@ -2732,7 +7568,10 @@ const file_mirai_v1_ai_generation_proto_rawDesc = "" +
 	"\x0e_error_messageB\r\n" +
 	"\v_started_atB\x0f\n" +
 	"\r_completed_atB\x10\n" +
-	"\x0e_parent_job_id\"\xf8\x03\n" +
+	"\x0e_parent_job_idB\x11\n" +
+	"\x0f_failure_reasonB\x13\n" +
+	"\x11_suggested_actionB\x0f\n" +
+	"\r_child_counts\"\xd3\a\n" +
 	"\rCourseOutline\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\tcourse_id\x18\x02 \x01(\tR\bcourseId\x12\x18\n" +
@@ -2743,16 +7582,40 @@ const file_mirai_v1_ai_generation_proto_rawDesc = "" +
 	"\fgenerated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAt\x12@\n" +
 	"\vapproved_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampH\x01R\n" +
 	"approvedAt\x88\x01\x01\x122\n" +
-	"\x13approved_by_user_id\x18\t \x01(\tH\x02R\x10approvedByUserId\x88\x01\x01B\x13\n" +
+	"\x13approved_by_user_id\x18\t \x01(\tH\x02R\x10approvedByUserId\x88\x01\x01\x12C\n" +
+	"\vconstraints\x18\n" +
+	" \x01(\v2\x1c.mirai.v1.OutlineConstraintsH\x03R\vconstraints\x88\x01\x01\x12J\n" +
+	"\x0elesson_changes\x18\v \x01(\v2\x1e.mirai.v1.OutlineLessonChangesH\x04R\rlessonChanges\x88\x01\x01\x128\n" +
+	"\x18unresolved_comment_count\x18\f \x01(\x05R\x16unresolvedCommentCount\x124\n" +
+	"\x14generated_by_user_id\x18\r \x01(\tH\x05R\x11generatedByUserId\x88\x01\x01\x12@\n" +
+	"\vendorsed_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampH\x06R\n" +
+	"endorsedAt\x88\x01\x01\x122\n" +
+	"\x13endorsed_by_user_id\x18\x0f \x01(\tH\aR\x10endorsedByUserId\x88\x01\x01B\x13\n" +
 	"\x11_rejection_reasonB\x0e\n" +
 	"\f_approved_atB\x16\n" +
-	"\x14_approved_by_user_id\"\xa1\x01\n" +
+	"\x14_approved_by_user_idB\x0e\n" +
+	"\f_constraintsB\x11\n" +
+	"\x0f_lesson_changesB\x17\n" +
+	"\x15_generated_by_user_idB\x0e\n" +
+	"\f_endorsed_atB\x16\n" +
+	"\x14_endorsed_by_user_id\"\xe7\x01\n" +
+	"\x14OutlineLessonChanges\x12.\n" +
+	"\x13previous_outline_id\x18\x01 \x01(\tR\x11previousOutlineId\x121\n" +
+	"\x04kept\x18\x02 \x03(\v2\x1d.mirai.v1.OutlineLessonChangeR\x04kept\x123\n" +
+	"\x05added\x18\x03 \x03(\v2\x1d.mirai.v1.OutlineLessonChangeR\x05added\x127\n" +
+	"\aremoved\x18\x04 \x03(\v2\x1d.mirai.v1.OutlineLessonChangeR\aremoved\"\x89\x01\n" +
+	"\x13OutlineLessonChange\x12\x1d\n" +
+	"\n" +
+	"lesson_key\x18\x01 \x01(\tR\tlessonKey\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12*\n" +
+	"\x0eprevious_title\x18\x03 \x01(\tH\x00R\rpreviousTitle\x88\x01\x01B\x11\n" +
+	"\x0f_previous_title\"\xa1\x01\n" +
 	"\x0eOutlineSection\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x14\n" +
 	"\x05order\x18\x04 \x01(\x05R\x05order\x121\n" +
-	"\alessons\x18\x05 \x03(\v2\x17.mirai.v1.OutlineLessonR\alessons\"\xb4\x02\n" +
+	"\alessons\x18\x05 \x03(\v2\x17.mirai.v1.OutlineLessonR\alessons\"\xfe\x02\n" +
 	"\rOutlineLesson\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
@@ -2761,7 +7624,11 @@ const file_mirai_v1_ai_generation_proto_rawDesc = "" +
 	"\x1aestimated_duration_minutes\x18\x05 \x01(\x05R\x18estimatedDurationMinutes\x12/\n" +
 	"\x13learning_objectives\x18\x06 \x03(\tR\x12learningObjectives\x12+\n" +
 	"\x12is_last_in_section\x18\a \x01(\bR\x0fisLastInSection\x12)\n" +
-	"\x11is_last_in_course\x18\b \x01(\bR\x0eisLastInCourse\"\xcc\x02\n" +
+	"\x11is_last_in_course\x18\b \x01(\bR\x0eisLastInCourse\x12)\n" +
+	"\x10target_audiences\x18\t \x03(\tR\x0ftargetAudiences\x12\x1d\n" +
+	"\n" +
+	"lesson_key\x18\n" +
+	" \x01(\tR\tlessonKey\"\xe0\x03\n" +
 	"\x0fGeneratedLesson\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\tcourse_id\x18\x02 \x01(\tR\bcourseId\x12\x1d\n" +
@@ -2774,8 +7641,14 @@ const file_mirai_v1_ai_generation_proto_rawDesc = "" +
 	"components\x12\"\n" +
 	"\n" +
 	"segue_text\x18\a \x01(\tH\x00R\tsegueText\x88\x01\x01\x12=\n" +
-	"\fgenerated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAtB\r\n" +
-	"\v_segue_text\"\xdc\x01\n" +
+	"\fgenerated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAt\x12@\n" +
+	"\vorphaned_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampH\x01R\n" +
+	"orphanedAt\x88\x01\x01\x12,\n" +
+	"\x0fcomponent_count\x18\n" +
+	" \x01(\x05H\x02R\x0ecomponentCount\x88\x01\x01B\r\n" +
+	"\v_segue_textB\x0e\n" +
+	"\f_orphaned_atB\x12\n" +
+	"\x10_component_count\"\xdc\x01\n" +
 	"\x0fLessonComponent\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x121\n" +
 	"\x04type\x18\x02 \x01(\x0e2\x1d.mirai.v1.LessonComponentTypeR\x04type\x12\x14\n" +
@@ -2812,25 +7685,71 @@ const file_mirai_v1_ai_generation_proto_rawDesc = "" +
 	"\n" +
 	"QuizOption\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04text\x18\x02 \x01(\tR\x04text\"\xf1\x01\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\"\x9e\x03\n" +
 	"\x15CourseGenerationInput\x12\x1b\n" +
 	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x17\n" +
 	"\asme_ids\x18\x02 \x03(\tR\x06smeIds\x12.\n" +
 	"\x13target_audience_ids\x18\x03 \x03(\tR\x11targetAudienceIds\x12'\n" +
 	"\x0fdesired_outcome\x18\x04 \x01(\tR\x0edesiredOutcome\x122\n" +
-	"\x12additional_context\x18\x05 \x01(\tH\x00R\x11additionalContext\x88\x01\x01B\x15\n" +
-	"\x13_additional_context\"U\n" +
+	"\x12additional_context\x18\x05 \x01(\tH\x00R\x11additionalContext\x88\x01\x01\x12C\n" +
+	"\vconstraints\x18\x06 \x01(\v2\x1c.mirai.v1.OutlineConstraintsH\x01R\vconstraints\x88\x01\x01\x12F\n" +
+	"\vpreferences\x18\a \x01(\v2\x1f.mirai.v1.GenerationPreferencesH\x02R\vpreferences\x88\x01\x01B\x15\n" +
+	"\x13_additional_contextB\x0e\n" +
+	"\f_constraintsB\x0e\n" +
+	"\f_preferences\"\xf4\x02\n" +
+	"\x15GenerationPreferences\x12%\n" +
+	"\x0eenable_quizzes\x18\x01 \x01(\bR\renableQuizzes\x12>\n" +
+	"\x0equiz_frequency\x18\x02 \x01(\x0e2\x17.mirai.v1.QuizFrequencyR\rquizFrequency\x12%\n" +
+	"\x0einclude_images\x18\x03 \x01(\bR\rincludeImages\x12<\n" +
+	"\x1ainclude_reflection_prompts\x18\x04 \x01(\bR\x18includeReflectionPrompts\x12'\n" +
+	"\x0fgenerate_images\x18\x05 \x01(\bR\x0egenerateImages\x122\n" +
+	"\x15min_lesson_components\x18\x06 \x01(\x05R\x13minLessonComponents\x122\n" +
+	"\x15max_lesson_components\x18\a \x01(\x05R\x13maxLessonComponents\"\xfe\x01\n" +
+	"\x12OutlineConstraints\x12&\n" +
+	"\fmax_sections\x18\x01 \x01(\x05H\x00R\vmaxSections\x88\x01\x01\x12:\n" +
+	"\x17max_lessons_per_section\x18\x02 \x01(\x05H\x01R\x14maxLessonsPerSection\x88\x01\x01\x12;\n" +
+	"\x17target_duration_minutes\x18\x03 \x01(\x05H\x02R\x15targetDurationMinutes\x88\x01\x01B\x0f\n" +
+	"\r_max_sectionsB\x1a\n" +
+	"\x18_max_lessons_per_sectionB\x1a\n" +
+	"\x18_target_duration_minutes\"x\n" +
 	"\x1cGenerateCourseOutlineRequest\x125\n" +
-	"\x05input\x18\x01 \x01(\v2\x1f.mirai.v1.CourseGenerationInputR\x05input\"J\n" +
+	"\x05input\x18\x01 \x01(\v2\x1f.mirai.v1.CourseGenerationInputR\x05input\x12!\n" +
+	"\fauto_approve\x18\x02 \x01(\bR\vautoApprove\"\x95\x01\n" +
 	"\x1dGenerateCourseOutlineResponse\x12)\n" +
-	"\x03job\x18\x01 \x01(\v2\x17.mirai.v1.GenerationJobR\x03job\"a\n" +
+	"\x03job\x18\x01 \x01(\v2\x17.mirai.v1.GenerationJobR\x03job\x12<\n" +
+	"\bcoverage\x18\x02 \x01(\v2\x1b.mirai.v1.KnowledgeCoverageH\x00R\bcoverage\x88\x01\x01B\v\n" +
+	"\t_coverage\"\x9c\x01\n" +
+	"\x1fAnalyzeKnowledgeCoverageRequest\x12\x17\n" +
+	"\asme_ids\x18\x01 \x03(\tR\x06smeIds\x12'\n" +
+	"\x0fdesired_outcome\x18\x02 \x01(\tR\x0edesiredOutcome\x12&\n" +
+	"\fcourse_title\x18\x03 \x01(\tH\x00R\vcourseTitle\x88\x01\x01B\x0f\n" +
+	"\r_course_title\"[\n" +
+	" AnalyzeKnowledgeCoverageResponse\x127\n" +
+	"\bcoverage\x18\x01 \x01(\v2\x1b.mirai.v1.KnowledgeCoverageR\bcoverage\"\xd3\x01\n" +
+	"\x11KnowledgeCoverage\x12\x14\n" +
+	"\x05score\x18\x01 \x01(\x01R\x05score\x12\x1e\n" +
+	"\n" +
+	"sufficient\x18\x02 \x01(\bR\n" +
+	"sufficient\x12\x1f\n" +
+	"\vchunk_count\x18\x03 \x01(\x05R\n" +
+	"chunkCount\x12,\n" +
+	"\x05terms\x18\x04 \x03(\v2\x16.mirai.v1.TermCoverageR\x05terms\x12\x1f\n" +
+	"\vthin_topics\x18\x05 \x03(\tR\n" +
+	"thinTopics\x12\x18\n" +
+	"\amessage\x18\x06 \x01(\tR\amessage\"C\n" +
+	"\fTermCoverage\x12\x12\n" +
+	"\x04term\x18\x01 \x01(\tR\x04term\x12\x1f\n" +
+	"\vchunk_count\x18\x02 \x01(\x05R\n" +
+	"chunkCount\"a\n" +
 	"\x17GetCourseOutlineRequest\x12\x1b\n" +
 	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x1d\n" +
 	"\aversion\x18\x02 \x01(\x05H\x00R\aversion\x88\x01\x01B\n" +
 	"\n" +
-	"\b_version\"M\n" +
+	"\b_version\"\xb9\x01\n" +
 	"\x18GetCourseOutlineResponse\x121\n" +
-	"\aoutline\x18\x01 \x01(\v2\x17.mirai.v1.CourseOutlineR\aoutline\"Y\n" +
+	"\aoutline\x18\x01 \x01(\v2\x17.mirai.v1.CourseOutlineR\aoutline\x12P\n" +
+	"\x15active_generation_job\x18\x02 \x01(\v2\x17.mirai.v1.GenerationJobH\x00R\x13activeGenerationJob\x88\x01\x01B\x18\n" +
+	"\x16_active_generation_job\"Y\n" +
 	"\x1bApproveCourseOutlineRequest\x12\x1b\n" +
 	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x1d\n" +
 	"\n" +
@@ -2843,44 +7762,130 @@ const file_mirai_v1_ai_generation_proto_rawDesc = "" +
 	"outline_id\x18\x02 \x01(\tR\toutlineId\x12\x16\n" +
 	"\x06reason\x18\x03 \x01(\tR\x06reason\"P\n" +
 	"\x1bRejectCourseOutlineResponse\x121\n" +
-	"\aoutline\x18\x01 \x01(\v2\x17.mirai.v1.CourseOutlineR\aoutline\"\x8e\x01\n" +
+	"\aoutline\x18\x01 \x01(\v2\x17.mirai.v1.CourseOutlineR\aoutline\"\xbc\x01\n" +
 	"\x1aUpdateCourseOutlineRequest\x12\x1b\n" +
 	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x1d\n" +
 	"\n" +
 	"outline_id\x18\x02 \x01(\tR\toutlineId\x124\n" +
-	"\bsections\x18\x03 \x03(\v2\x18.mirai.v1.OutlineSectionR\bsections\"P\n" +
+	"\bsections\x18\x03 \x03(\v2\x18.mirai.v1.OutlineSectionR\bsections\x12,\n" +
+	"\x12removed_lesson_ids\x18\x04 \x03(\tR\x10removedLessonIds\"P\n" +
 	"\x1bUpdateCourseOutlineResponse\x121\n" +
-	"\aoutline\x18\x01 \x01(\v2\x17.mirai.v1.CourseOutlineR\aoutline\"g\n" +
+	"\aoutline\x18\x01 \x01(\v2\x17.mirai.v1.CourseOutlineR\aoutline\"\x95\x01\n" +
+	"\x14ExportOutlineRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x125\n" +
+	"\x06format\x18\x02 \x01(\x0e2\x1d.mirai.v1.OutlineExportFormatR\x06format\x12\x1d\n" +
+	"\aversion\x18\x03 \x01(\x05H\x00R\aversion\x88\x01\x01B\n" +
+	"\n" +
+	"\b_version\"\x91\x01\n" +
+	"\x15ExportOutlineResponse\x12!\n" +
+	"\fdownload_url\x18\x01 \x01(\tR\vdownloadUrl\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"g\n" +
 	"\x1cGenerateLessonContentRequest\x12\x1b\n" +
 	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12*\n" +
 	"\x11outline_lesson_id\x18\x02 \x01(\tR\x0foutlineLessonId\"J\n" +
 	"\x1dGenerateLessonContentResponse\x12)\n" +
-	"\x03job\x18\x01 \x01(\v2\x17.mirai.v1.GenerationJobR\x03job\"8\n" +
+	"\x03job\x18\x01 \x01(\v2\x17.mirai.v1.GenerationJobR\x03job\"\x90\x01\n" +
 	"\x19GenerateAllLessonsRequest\x12\x1b\n" +
-	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\"G\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12F\n" +
+	"\vpreferences\x18\x02 \x01(\v2\x1f.mirai.v1.GenerationPreferencesH\x00R\vpreferences\x88\x01\x01B\x0e\n" +
+	"\f_preferences\"\xb1\x01\n" +
 	"\x1aGenerateAllLessonsResponse\x12)\n" +
-	"\x03job\x18\x01 \x01(\v2\x17.mirai.v1.GenerationJobR\x03job\"\xaa\x01\n" +
+	"\x03job\x18\x01 \x01(\v2\x17.mirai.v1.GenerationJobR\x03job\x12'\n" +
+	"\x0falready_running\x18\x02 \x01(\bR\x0ealreadyRunning\x12+\n" +
+	"\x0fstarted_by_name\x18\x03 \x01(\tH\x00R\rstartedByName\x88\x01\x01B\x12\n" +
+	"\x10_started_by_name\"c\n" +
+	"\x17ExportAllLessonsRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12+\n" +
+	"\x11include_citations\x18\x02 \x01(\bR\x10includeCitations\"E\n" +
+	"\x18ExportAllLessonsResponse\x12)\n" +
+	"\x03job\x18\x01 \x01(\v2\x17.mirai.v1.GenerationJobR\x03job\"r\n" +
+	"\x19RetryFailedLessonsRequest\x12\x1a\n" +
+	"\x06job_id\x18\x01 \x01(\tH\x00R\x05jobId\x88\x01\x01\x12 \n" +
+	"\tcourse_id\x18\x02 \x01(\tH\x01R\bcourseId\x88\x01\x01B\t\n" +
+	"\a_job_idB\f\n" +
+	"\n" +
+	"_course_id\"l\n" +
+	"\x1aRetryFailedLessonsResponse\x12)\n" +
+	"\x03job\x18\x01 \x01(\v2\x17.mirai.v1.GenerationJobR\x03job\x12#\n" +
+	"\rretried_count\x18\x02 \x01(\x05R\fretriedCount\"\xaa\x01\n" +
 	"\x1aRegenerateComponentRequest\x12\x1b\n" +
 	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x1b\n" +
 	"\tlesson_id\x18\x02 \x01(\tR\blessonId\x12!\n" +
 	"\fcomponent_id\x18\x03 \x01(\tR\vcomponentId\x12/\n" +
 	"\x13modification_prompt\x18\x04 \x01(\tR\x12modificationPrompt\"H\n" +
 	"\x1bRegenerateComponentResponse\x12)\n" +
-	"\x03job\x18\x01 \x01(\v2\x17.mirai.v1.GenerationJobR\x03job\"&\n" +
+	"\x03job\x18\x01 \x01(\v2\x17.mirai.v1.GenerationJobR\x03job\"_\n" +
+	"\x18EditComponentTextRequest\x12!\n" +
+	"\fcomponent_id\x18\x01 \x01(\tR\vcomponentId\x12 \n" +
+	"\vinstruction\x18\x02 \x01(\tR\vinstruction\"\xe8\x01\n" +
+	"\x19EditComponentTextResponse\x12!\n" +
+	"\fcomponent_id\x18\x01 \x01(\tR\vcomponentId\x121\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x1d.mirai.v1.LessonComponentTypeR\x04type\x12!\n" +
+	"\fcontent_json\x18\x03 \x01(\tR\vcontentJson\x12\x1f\n" +
+	"\vtokens_used\x18\x04 \x01(\x03R\n" +
+	"tokensUsed\x12\x1b\n" +
+	"\tcache_hit\x18\x05 \x01(\bR\bcacheHit\x12\x14\n" +
+	"\x05order\x18\x06 \x01(\x05R\x05order\"?\n" +
+	"\x1aGetComponentSourcesRequest\x12!\n" +
+	"\fcomponent_id\x18\x01 \x01(\tR\vcomponentId\"\x8e\x01\n" +
+	"\x0fComponentSource\x12\x19\n" +
+	"\bchunk_id\x18\x01 \x01(\tR\achunkId\x12\x15\n" +
+	"\x06sme_id\x18\x02 \x01(\tR\x05smeId\x12\x19\n" +
+	"\bsme_name\x18\x03 \x01(\tR\asmeName\x12\x14\n" +
+	"\x05topic\x18\x04 \x01(\tR\x05topic\x12\x18\n" +
+	"\aexcerpt\x18\x05 \x01(\tR\aexcerpt\"R\n" +
+	"\x1bGetComponentSourcesResponse\x123\n" +
+	"\asources\x18\x01 \x03(\v2\x19.mirai.v1.ComponentSourceR\asources\"\x86\x01\n" +
+	"!GetComponentAssetUploadURLRequest\x12!\n" +
+	"\fcomponent_id\x18\x01 \x01(\tR\vcomponentId\x12\x1b\n" +
+	"\tfile_name\x18\x02 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\"`\n" +
+	"\"GetComponentAssetUploadURLResponse\x12\x1d\n" +
+	"\n" +
+	"upload_url\x18\x01 \x01(\tR\tuploadUrl\x12\x1b\n" +
+	"\tfile_path\x18\x02 \x01(\tR\bfilePath\"^\n" +
+	"\x1cConfirmComponentAssetRequest\x12!\n" +
+	"\fcomponent_id\x18\x01 \x01(\tR\vcomponentId\x12\x1b\n" +
+	"\tfile_path\x18\x02 \x01(\tR\bfilePath\"X\n" +
+	"\x1dConfirmComponentAssetResponse\x127\n" +
+	"\tcomponent\x18\x01 \x01(\v2\x19.mirai.v1.LessonComponentR\tcomponent\"\x8e\x01\n" +
+	"\x1aSuggestCourseTitlesRequest\x12\x17\n" +
+	"\asme_ids\x18\x01 \x03(\tR\x06smeIds\x12.\n" +
+	"\x13target_audience_ids\x18\x02 \x03(\tR\x11targetAudienceIds\x12'\n" +
+	"\x0fdesired_outcome\x18\x03 \x01(\tR\x0edesiredOutcome\"K\n" +
+	"\x15CourseTitleSuggestion\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x1c\n" +
+	"\trationale\x18\x02 \x01(\tR\trationale\"\x81\x01\n" +
+	"\x1bSuggestCourseTitlesResponse\x12A\n" +
+	"\vsuggestions\x18\x01 \x03(\v2\x1f.mirai.v1.CourseTitleSuggestionR\vsuggestions\x12\x1f\n" +
+	"\vtokens_used\x18\x02 \x01(\x03R\n" +
+	"tokensUsed\"&\n" +
 	"\rGetJobRequest\x12\x15\n" +
 	"\x06job_id\x18\x01 \x01(\tR\x05jobId\";\n" +
 	"\x0eGetJobResponse\x12)\n" +
-	"\x03job\x18\x01 \x01(\v2\x17.mirai.v1.GenerationJobR\x03job\"\xc7\x01\n" +
+	"\x03job\x18\x01 \x01(\v2\x17.mirai.v1.GenerationJobR\x03job\"\x85\x03\n" +
 	"\x0fListJobsRequest\x124\n" +
 	"\x04type\x18\x01 \x01(\x0e2\x1b.mirai.v1.GenerationJobTypeH\x00R\x04type\x88\x01\x01\x12:\n" +
 	"\x06status\x18\x02 \x01(\x0e2\x1d.mirai.v1.GenerationJobStatusH\x01R\x06status\x88\x01\x01\x12 \n" +
-	"\tcourse_id\x18\x03 \x01(\tH\x02R\bcourseId\x88\x01\x01B\a\n" +
+	"\tcourse_id\x18\x03 \x01(\tH\x02R\bcourseId\x88\x01\x01\x12.\n" +
+	"\x10exclude_children\x18\x04 \x01(\bH\x03R\x0fexcludeChildren\x88\x01\x01\x12'\n" +
+	"\rparent_job_id\x18\x05 \x01(\tH\x04R\vparentJobId\x88\x01\x01\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\x05R\x05limit\x12\x1b\n" +
+	"\x06cursor\x18\a \x01(\tH\x05R\x06cursor\x88\x01\x01B\a\n" +
 	"\x05_typeB\t\n" +
 	"\a_statusB\f\n" +
 	"\n" +
-	"_course_id\"?\n" +
+	"_course_idB\x13\n" +
+	"\x11_exclude_childrenB\x10\n" +
+	"\x0e_parent_job_idB\t\n" +
+	"\a_cursor\"u\n" +
 	"\x10ListJobsResponse\x12+\n" +
-	"\x04jobs\x18\x01 \x03(\v2\x17.mirai.v1.GenerationJobR\x04jobs\")\n" +
+	"\x04jobs\x18\x01 \x03(\v2\x17.mirai.v1.GenerationJobR\x04jobs\x12$\n" +
+	"\vnext_cursor\x18\x02 \x01(\tH\x00R\n" +
+	"nextCursor\x88\x01\x01B\x0e\n" +
+	"\f_next_cursor\")\n" +
 	"\x10CancelJobRequest\x12\x15\n" +
 	"\x06job_id\x18\x01 \x01(\tR\x05jobId\">\n" +
 	"\x11CancelJobResponse\x12)\n" +
@@ -2888,18 +7893,241 @@ const file_mirai_v1_ai_generation_proto_rawDesc = "" +
 	"\x19GetGeneratedLessonRequest\x12\x1b\n" +
 	"\tlesson_id\x18\x01 \x01(\tR\blessonId\"O\n" +
 	"\x1aGetGeneratedLessonResponse\x121\n" +
-	"\x06lesson\x18\x01 \x01(\v2\x19.mirai.v1.GeneratedLessonR\x06lesson\":\n" +
+	"\x06lesson\x18\x01 \x01(\v2\x19.mirai.v1.GeneratedLessonR\x06lesson\"e\n" +
 	"\x1bListGeneratedLessonsRequest\x12\x1b\n" +
-	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\"S\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12)\n" +
+	"\x10include_orphaned\x18\x02 \x01(\bR\x0fincludeOrphaned\"S\n" +
 	"\x1cListGeneratedLessonsResponse\x123\n" +
-	"\alessons\x18\x01 \x03(\v2\x19.mirai.v1.GeneratedLessonR\alessons*\xfd\x01\n" +
+	"\alessons\x18\x01 \x03(\v2\x19.mirai.v1.GeneratedLessonR\alessons\"\xd9\x02\n" +
+	"\fContentStats\x12!\n" +
+	"\flesson_count\x18\x01 \x01(\x05R\vlessonCount\x12\x1d\n" +
+	"\n" +
+	"word_count\x18\x02 \x01(\x05R\twordCount\x127\n" +
+	"\x18average_words_per_lesson\x18\x03 \x01(\x01R\x15averageWordsPerLesson\x12:\n" +
+	"\x19estimated_reading_minutes\x18\x04 \x01(\x05R\x17estimatedReadingMinutes\x12\x1d\n" +
+	"\n" +
+	"quiz_count\x18\x05 \x01(\x05R\tquizCount\x12\x1f\n" +
+	"\vimage_count\x18\x06 \x01(\x05R\n" +
+	"imageCount\x121\n" +
+	"\x14malformed_components\x18\a \x01(\x05R\x13malformedComponents\x12\x1f\n" +
+	"\vvideo_count\x18\b \x01(\x05R\n" +
+	"videoCount\"q\n" +
+	"\fSectionStats\x12\x1d\n" +
+	"\n" +
+	"section_id\x18\x01 \x01(\tR\tsectionId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12,\n" +
+	"\x05stats\x18\x03 \x01(\v2\x16.mirai.v1.ContentStatsR\x05stats\"4\n" +
+	"\x15GetCourseStatsRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\"|\n" +
+	"\x16GetCourseStatsResponse\x12.\n" +
+	"\x06totals\x18\x01 \x01(\v2\x16.mirai.v1.ContentStatsR\x06totals\x122\n" +
+	"\bsections\x18\x02 \x03(\v2\x16.mirai.v1.SectionStatsR\bsections\"O\n" +
+	"\x1aGetCoursePlayerViewRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x14\n" +
+	"\x05draft\x18\x02 \x01(\bR\x05draft\"M\n" +
+	"\x1bGetCoursePlayerViewResponse\x12.\n" +
+	"\x04view\x18\x01 \x01(\v2\x1a.mirai.v1.CoursePlayerViewR\x04view\"\xf9\x01\n" +
+	"\x10CoursePlayerView\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12'\n" +
+	"\x0foutline_version\x18\x03 \x01(\x05R\x0eoutlineVersion\x12!\n" +
+	"\flesson_count\x18\x04 \x01(\x05R\vlessonCount\x129\n" +
+	"\bsections\x18\x05 \x03(\v2\x1d.mirai.v1.CoursePlayerSectionR\bsections\x12+\n" +
+	"\x11published_version\x18\x06 \x01(\x05R\x10publishedVersion\"\x95\x01\n" +
+	"\x13CoursePlayerSection\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x126\n" +
+	"\alessons\x18\x04 \x03(\v2\x1c.mirai.v1.CoursePlayerLessonR\alessons\"\x98\x03\n" +
+	"\x12CoursePlayerLesson\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12A\n" +
+	"\x1aestimated_duration_minutes\x18\x03 \x01(\x05H\x00R\x18estimatedDurationMinutes\x88\x01\x01\x12?\n" +
+	"\n" +
+	"components\x18\x04 \x03(\v2\x1f.mirai.v1.CoursePlayerComponentR\n" +
+	"components\x12\"\n" +
+	"\n" +
+	"segue_text\x18\x05 \x01(\tH\x01R\tsegueText\x88\x01\x01\x121\n" +
+	"\x12previous_lesson_id\x18\x06 \x01(\tH\x02R\x10previousLessonId\x88\x01\x01\x12)\n" +
+	"\x0enext_lesson_id\x18\a \x01(\tH\x03R\fnextLessonId\x88\x01\x01B\x1d\n" +
+	"\x1b_estimated_duration_minutesB\r\n" +
+	"\v_segue_textB\x15\n" +
+	"\x13_previous_lesson_idB\x11\n" +
+	"\x0f_next_lesson_id\"\x93\x01\n" +
+	"\x15CoursePlayerComponent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x121\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x1d.mirai.v1.LessonComponentTypeR\x04type\x12\x14\n" +
+	"\x05order\x18\x03 \x01(\x05R\x05order\x12!\n" +
+	"\fcontent_json\x18\x04 \x01(\tR\vcontentJson\"\x17\n" +
+	"\x15GetQueueStatusRequest\"|\n" +
+	"\x11JobTypeQueueCount\x12/\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1b.mirai.v1.GenerationJobTypeR\x04type\x12\x16\n" +
+	"\x06queued\x18\x02 \x01(\x05R\x06queued\x12\x1e\n" +
+	"\n" +
+	"processing\x18\x03 \x01(\x05R\n" +
+	"processing\"\xce\x02\n" +
+	"\x16GetQueueStatusResponse\x123\n" +
+	"\x06counts\x18\x01 \x03(\v2\x1b.mirai.v1.JobTypeQueueCountR\x06counts\x12*\n" +
+	"\x0equeue_position\x18\x02 \x01(\x05H\x00R\rqueuePosition\x88\x01\x01\x12-\n" +
+	"\x12worker_concurrency\x18\x03 \x01(\x05R\x11workerConcurrency\x127\n" +
+	"\x18avg_job_duration_seconds\x18\x04 \x01(\x05R\x15avgJobDurationSeconds\x12+\n" +
+	"\x11provider_degraded\x18\x05 \x01(\bR\x10providerDegraded\x12+\n" +
+	"\x11generation_paused\x18\x06 \x01(\bR\x10generationPausedB\x11\n" +
+	"\x0f_queue_position\"\xa1\x02\n" +
+	"\n" +
+	"JobAnomaly\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x15\n" +
+	"\x06job_id\x18\x03 \x01(\tR\x05jobId\x12 \n" +
+	"\tcourse_id\x18\x04 \x01(\tH\x00R\bcourseId\x88\x01\x01\x12,\n" +
+	"\x04type\x18\x05 \x01(\x0e2\x18.mirai.v1.JobAnomalyTypeR\x04type\x12\x18\n" +
+	"\adetails\x18\x06 \x01(\tR\adetails\x12\x1a\n" +
+	"\bresolved\x18\a \x01(\bR\bresolved\x12;\n" +
+	"\vdetected_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"detectedAtB\f\n" +
+	"\n" +
+	"_course_id\"\x98\x01\n" +
+	"\x14ListAnomaliesRequest\x12 \n" +
+	"\ttenant_id\x18\x01 \x01(\tH\x00R\btenantId\x88\x01\x01\x121\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x18.mirai.v1.JobAnomalyTypeH\x01R\x04type\x88\x01\x01\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limitB\f\n" +
+	"\n" +
+	"_tenant_idB\a\n" +
+	"\x05_type\"K\n" +
+	"\x15ListAnomaliesResponse\x122\n" +
+	"\tanomalies\x18\x01 \x03(\v2\x14.mirai.v1.JobAnomalyR\tanomalies\"\x83\x01\n" +
+	"\x0fGenerationDraft\x125\n" +
+	"\x05input\x18\x01 \x01(\v2\x1f.mirai.v1.CourseGenerationInputR\x05input\x129\n" +
+	"\n" +
+	"updated_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"S\n" +
+	"\x1aSaveGenerationDraftRequest\x125\n" +
+	"\x05input\x18\x01 \x01(\v2\x1f.mirai.v1.CourseGenerationInputR\x05input\"N\n" +
+	"\x1bSaveGenerationDraftResponse\x12/\n" +
+	"\x05draft\x18\x01 \x01(\v2\x19.mirai.v1.GenerationDraftR\x05draft\"8\n" +
+	"\x19GetGenerationDraftRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\"\\\n" +
+	"\x1aGetGenerationDraftResponse\x124\n" +
+	"\x05draft\x18\x01 \x01(\v2\x19.mirai.v1.GenerationDraftH\x00R\x05draft\x88\x01\x01B\b\n" +
+	"\x06_draft\"?\n" +
+	"\x18StartStorageAuditRequest\x12#\n" +
+	"\rpurge_orphans\x18\x01 \x01(\bR\fpurgeOrphans\"F\n" +
+	"\x19StartStorageAuditResponse\x12)\n" +
+	"\x03job\x18\x01 \x01(\v2\x17.mirai.v1.GenerationJobR\x03job\"5\n" +
+	"\x1cGetStorageAuditReportRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"\xd2\x01\n" +
+	"\x1dGetStorageAuditReportResponse\x12)\n" +
+	"\x03job\x18\x01 \x01(\v2\x17.mirai.v1.GenerationJobR\x03job\x12&\n" +
+	"\fdownload_url\x18\x02 \x01(\tH\x00R\vdownloadUrl\x88\x01\x01\x12>\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\texpiresAt\x88\x01\x01B\x0f\n" +
+	"\r_download_urlB\r\n" +
+	"\v_expires_at\"^\n" +
+	"\x16TranslateCourseRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12'\n" +
+	"\x0ftarget_language\x18\x02 \x01(\tR\x0etargetLanguage\"a\n" +
+	"\x17TranslateCourseResponse\x12)\n" +
+	"\x03job\x18\x01 \x01(\v2\x17.mirai.v1.GenerationJobR\x03job\x12\x1b\n" +
+	"\tcourse_id\x18\x02 \x01(\tR\bcourseId\"\xc4\x03\n" +
+	"\x0eOutlineComment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tcourse_id\x18\x02 \x01(\tR\bcourseId\x12\x1d\n" +
+	"\n" +
+	"lesson_key\x18\x03 \x01(\tR\tlessonKey\x12)\n" +
+	"\x0eauthor_user_id\x18\x04 \x01(\tH\x00R\fauthorUserId\x88\x01\x01\x12\x1f\n" +
+	"\vauthor_name\x18\x05 \x01(\tR\n" +
+	"authorName\x12\x12\n" +
+	"\x04body\x18\x06 \x01(\tR\x04body\x12\x1a\n" +
+	"\bresolved\x18\a \x01(\bR\bresolved\x122\n" +
+	"\x13resolved_by_user_id\x18\b \x01(\tH\x01R\x10resolvedByUserId\x88\x01\x01\x12@\n" +
+	"\vresolved_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampH\x02R\n" +
+	"resolvedAt\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\x11\n" +
+	"\x0f_author_user_idB\x16\n" +
+	"\x14_resolved_by_user_idB\x0e\n" +
+	"\f_resolved_at\"k\n" +
+	"\x1bCreateOutlineCommentRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x1b\n" +
+	"\tlesson_id\x18\x02 \x01(\tR\blessonId\x12\x12\n" +
+	"\x04body\x18\x03 \x01(\tR\x04body\"R\n" +
+	"\x1cCreateOutlineCommentResponse\x122\n" +
+	"\acomment\x18\x01 \x01(\v2\x18.mirai.v1.OutlineCommentR\acomment\"d\n" +
+	"\x1aListOutlineCommentsRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12)\n" +
+	"\x10include_resolved\x18\x02 \x01(\bR\x0fincludeResolved\"S\n" +
+	"\x1bListOutlineCommentsResponse\x124\n" +
+	"\bcomments\x18\x01 \x03(\v2\x18.mirai.v1.OutlineCommentR\bcomments\"=\n" +
+	"\x1cResolveOutlineCommentRequest\x12\x1d\n" +
+	"\n" +
+	"comment_id\x18\x01 \x01(\tR\tcommentId\"S\n" +
+	"\x1dResolveOutlineCommentResponse\x122\n" +
+	"\acomment\x18\x01 \x01(\v2\x18.mirai.v1.OutlineCommentR\acomment\"j\n" +
+	"\x19SetTenantAIEnabledRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"W\n" +
+	"\x1aSetTenantAIEnabledResponse\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x1f\n" +
+	"\vqueued_jobs\x18\x02 \x01(\x05R\n" +
+	"queuedJobs\"\x9e\x02\n" +
+	"\x12AccessibilityIssue\x124\n" +
+	"\x04type\x18\x01 \x01(\x0e2 .mirai.v1.AccessibilityIssueTypeR\x04type\x12\x1b\n" +
+	"\tlesson_id\x18\x02 \x01(\tR\blessonId\x12!\n" +
+	"\flesson_title\x18\x03 \x01(\tR\vlessonTitle\x12!\n" +
+	"\fcomponent_id\x18\x04 \x01(\tR\vcomponentId\x12\x1a\n" +
+	"\bposition\x18\x05 \x01(\x05R\bposition\x12\x16\n" +
+	"\x06detail\x18\x06 \x01(\tR\x06detail\x12;\n" +
+	"\x1aalt_text_generation_failed\x18\a \x01(\bR\x17altTextGenerationFailed\"<\n" +
+	"\x1dGetAccessibilityReportRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\"\xae\x01\n" +
+	"\x1eGetAccessibilityReportResponse\x124\n" +
+	"\x06issues\x18\x01 \x03(\v2\x1c.mirai.v1.AccessibilityIssueR\x06issues\x12'\n" +
+	"\x0flessons_checked\x18\x02 \x01(\x05R\x0elessonsChecked\x12-\n" +
+	"\x12components_checked\x18\x03 \x01(\x05R\x11componentsChecked\"\xa4\x01\n" +
+	"\x14OutlineBalanceChange\x126\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\".mirai.v1.OutlineBalanceChangeKindR\x04kind\x12\x1d\n" +
+	"\n" +
+	"section_id\x18\x02 \x01(\tR\tsectionId\x12\x1d\n" +
+	"\n" +
+	"lesson_ids\x18\x03 \x03(\tR\tlessonIds\x12\x16\n" +
+	"\x06titles\x18\x04 \x03(\tR\x06titles\"\xb8\x01\n" +
+	"\x1eBalanceOutlineDurationsRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x1d\n" +
+	"\n" +
+	"outline_id\x18\x02 \x01(\tR\toutlineId\x12,\n" +
+	"\x12min_lesson_minutes\x18\x03 \x01(\x05R\x10minLessonMinutes\x12,\n" +
+	"\x12max_lesson_minutes\x18\x04 \x01(\x05R\x10maxLessonMinutes\"\xbc\x02\n" +
+	"\x1fBalanceOutlineDurationsResponse\x124\n" +
+	"\bsections\x18\x01 \x03(\v2\x18.mirai.v1.OutlineSectionR\bsections\x12,\n" +
+	"\x12removed_lesson_ids\x18\x02 \x03(\tR\x10removedLessonIds\x128\n" +
+	"\achanges\x18\x03 \x03(\v2\x1e.mirai.v1.OutlineBalanceChangeR\achanges\x12,\n" +
+	"\x12min_lesson_minutes\x18\x04 \x01(\x05R\x10minLessonMinutes\x12,\n" +
+	"\x12max_lesson_minutes\x18\x05 \x01(\x05R\x10maxLessonMinutes\x12\x1f\n" +
+	"\vtokens_used\x18\x06 \x01(\x03R\n" +
+	"tokensUsed\"|\n" +
+	"\x0eJobChildCounts\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x12\x1c\n" +
+	"\tcompleted\x18\x02 \x01(\x05R\tcompleted\x12\x16\n" +
+	"\x06failed\x18\x03 \x01(\x05R\x06failed\x12\x1e\n" +
+	"\n" +
+	"processing\x18\x04 \x01(\x05R\n" +
+	"processing\"\x1a\n" +
+	"\x18MigrateQuizSchemaRequest\"\x1b\n" +
+	"\x19MigrateQuizSchemaResponse*\xd4\x03\n" +
 	"\x11GenerationJobType\x12#\n" +
 	"\x1fGENERATION_JOB_TYPE_UNSPECIFIED\x10\x00\x12%\n" +
 	"!GENERATION_JOB_TYPE_SME_INGESTION\x10\x01\x12&\n" +
 	"\"GENERATION_JOB_TYPE_COURSE_OUTLINE\x10\x02\x12&\n" +
 	"\"GENERATION_JOB_TYPE_LESSON_CONTENT\x10\x03\x12'\n" +
 	"#GENERATION_JOB_TYPE_COMPONENT_REGEN\x10\x04\x12#\n" +
-	"\x1fGENERATION_JOB_TYPE_FULL_COURSE\x10\x05*\xf0\x01\n" +
+	"\x1fGENERATION_JOB_TYPE_FULL_COURSE\x10\x05\x12&\n" +
+	"\"GENERATION_JOB_TYPE_LESSONS_EXPORT\x10\x06\x12,\n" +
+	"(GENERATION_JOB_TYPE_SME_KNOWLEDGE_EXPORT\x10\a\x12,\n" +
+	"(GENERATION_JOB_TYPE_SME_KNOWLEDGE_IMPORT\x10\b\x12%\n" +
+	"!GENERATION_JOB_TYPE_STORAGE_AUDIT\x10\t\x12*\n" +
+	"&GENERATION_JOB_TYPE_COURSE_TRANSLATION\x10\n" +
+	"*\xf0\x01\n" +
 	"\x13GenerationJobStatus\x12%\n" +
 	"!GENERATION_JOB_STATUS_UNSPECIFIED\x10\x00\x12 \n" +
 	"\x1cGENERATION_JOB_STATUS_QUEUED\x10\x01\x12$\n" +
@@ -2912,33 +8140,91 @@ const file_mirai_v1_ai_generation_proto_rawDesc = "" +
 	"&OUTLINE_APPROVAL_STATUS_PENDING_REVIEW\x10\x01\x12$\n" +
 	" OUTLINE_APPROVAL_STATUS_APPROVED\x10\x02\x12$\n" +
 	" OUTLINE_APPROVAL_STATUS_REJECTED\x10\x03\x12.\n" +
-	"*OUTLINE_APPROVAL_STATUS_REVISION_REQUESTED\x10\x04*\xc0\x01\n" +
+	"*OUTLINE_APPROVAL_STATUS_REVISION_REQUESTED\x10\x04*\xe1\x01\n" +
 	"\x13LessonComponentType\x12%\n" +
 	"!LESSON_COMPONENT_TYPE_UNSPECIFIED\x10\x00\x12\x1e\n" +
 	"\x1aLESSON_COMPONENT_TYPE_TEXT\x10\x01\x12!\n" +
 	"\x1dLESSON_COMPONENT_TYPE_HEADING\x10\x02\x12\x1f\n" +
 	"\x1bLESSON_COMPONENT_TYPE_IMAGE\x10\x03\x12\x1e\n" +
-	"\x1aLESSON_COMPONENT_TYPE_QUIZ\x10\x04*\x85\x01\n" +
+	"\x1aLESSON_COMPONENT_TYPE_QUIZ\x10\x04\x12\x1f\n" +
+	"\x1bLESSON_COMPONENT_TYPE_VIDEO\x10\x05*{\n" +
+	"\x13OutlineExportFormat\x12%\n" +
+	"!OUTLINE_EXPORT_FORMAT_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19OUTLINE_EXPORT_FORMAT_CSV\x10\x01\x12\x1e\n" +
+	"\x1aOUTLINE_EXPORT_FORMAT_DOCX\x10\x02*\xbb\x01\n" +
+	"\x0eJobAnomalyType\x12 \n" +
+	"\x1cJOB_ANOMALY_TYPE_UNSPECIFIED\x10\x00\x12)\n" +
+	"%JOB_ANOMALY_TYPE_PARENT_NOT_FINALIZED\x10\x01\x12,\n" +
+	"(JOB_ANOMALY_TYPE_PARENT_MISSING_CHILDREN\x10\x02\x12.\n" +
+	"*JOB_ANOMALY_TYPE_COMPLETED_WITHOUT_LESSONS\x10\x03*\x85\x01\n" +
 	"\fHeadingLevel\x12\x1d\n" +
 	"\x19HEADING_LEVEL_UNSPECIFIED\x10\x00\x12\x14\n" +
 	"\x10HEADING_LEVEL_H1\x10\x01\x12\x14\n" +
 	"\x10HEADING_LEVEL_H2\x10\x02\x12\x14\n" +
 	"\x10HEADING_LEVEL_H3\x10\x03\x12\x14\n" +
-	"\x10HEADING_LEVEL_H4\x10\x042\xc6\t\n" +
+	"\x10HEADING_LEVEL_H4\x10\x04*\xcb\x02\n" +
+	"\x10JobFailureReason\x12\"\n" +
+	"\x1eJOB_FAILURE_REASON_UNSPECIFIED\x10\x00\x12$\n" +
+	" JOB_FAILURE_REASON_PROVIDER_AUTH\x10\x01\x12*\n" +
+	"&JOB_FAILURE_REASON_PROVIDER_RATE_LIMIT\x10\x02\x12'\n" +
+	"#JOB_FAILURE_REASON_PROVIDER_TIMEOUT\x10\x03\x12%\n" +
+	"!JOB_FAILURE_REASON_INVALID_OUTPUT\x10\x04\x12(\n" +
+	"$JOB_FAILURE_REASON_MISSING_KNOWLEDGE\x10\x05\x12&\n" +
+	"\"JOB_FAILURE_REASON_BUDGET_EXCEEDED\x10\x06\x12\x1f\n" +
+	"\x1bJOB_FAILURE_REASON_INTERNAL\x10\a*\x95\x01\n" +
+	"\rQuizFrequency\x12\x1e\n" +
+	"\x1aQUIZ_FREQUENCY_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bQUIZ_FREQUENCY_EVERY_LESSON\x10\x01\x12!\n" +
+	"\x1dQUIZ_FREQUENCY_END_OF_SECTION\x10\x02\x12 \n" +
+	"\x1cQUIZ_FREQUENCY_END_OF_COURSE\x10\x03*\xda\x01\n" +
+	"\x16AccessibilityIssueType\x12(\n" +
+	"$ACCESSIBILITY_ISSUE_TYPE_UNSPECIFIED\x10\x00\x12-\n" +
+	")ACCESSIBILITY_ISSUE_TYPE_MISSING_ALT_TEXT\x10\x01\x12/\n" +
+	"+ACCESSIBILITY_ISSUE_TYPE_HEADING_LEVEL_JUMP\x10\x02\x126\n" +
+	"2ACCESSIBILITY_ISSUE_TYPE_QUIZ_WITHOUT_INSTRUCTIONS\x10\x03*\x95\x01\n" +
+	"\x18OutlineBalanceChangeKind\x12+\n" +
+	"'OUTLINE_BALANCE_CHANGE_KIND_UNSPECIFIED\x10\x00\x12%\n" +
+	"!OUTLINE_BALANCE_CHANGE_KIND_SPLIT\x10\x01\x12%\n" +
+	"!OUTLINE_BALANCE_CHANGE_KIND_MERGE\x10\x022\xe6\x1c\n" +
 	"\x13AIGenerationService\x12h\n" +
-	"\x15GenerateCourseOutline\x12&.mirai.v1.GenerateCourseOutlineRequest\x1a'.mirai.v1.GenerateCourseOutlineResponse\x12Y\n" +
+	"\x15GenerateCourseOutline\x12&.mirai.v1.GenerateCourseOutlineRequest\x1a'.mirai.v1.GenerateCourseOutlineResponse\x12q\n" +
+	"\x18AnalyzeKnowledgeCoverage\x12).mirai.v1.AnalyzeKnowledgeCoverageRequest\x1a*.mirai.v1.AnalyzeKnowledgeCoverageResponse\x12b\n" +
+	"\x13SaveGenerationDraft\x12$.mirai.v1.SaveGenerationDraftRequest\x1a%.mirai.v1.SaveGenerationDraftResponse\x12_\n" +
+	"\x12GetGenerationDraft\x12#.mirai.v1.GetGenerationDraftRequest\x1a$.mirai.v1.GetGenerationDraftResponse\x12Y\n" +
 	"\x10GetCourseOutline\x12!.mirai.v1.GetCourseOutlineRequest\x1a\".mirai.v1.GetCourseOutlineResponse\x12e\n" +
 	"\x14ApproveCourseOutline\x12%.mirai.v1.ApproveCourseOutlineRequest\x1a&.mirai.v1.ApproveCourseOutlineResponse\x12b\n" +
 	"\x13RejectCourseOutline\x12$.mirai.v1.RejectCourseOutlineRequest\x1a%.mirai.v1.RejectCourseOutlineResponse\x12b\n" +
-	"\x13UpdateCourseOutline\x12$.mirai.v1.UpdateCourseOutlineRequest\x1a%.mirai.v1.UpdateCourseOutlineResponse\x12h\n" +
+	"\x13UpdateCourseOutline\x12$.mirai.v1.UpdateCourseOutlineRequest\x1a%.mirai.v1.UpdateCourseOutlineResponse\x12P\n" +
+	"\rExportOutline\x12\x1e.mirai.v1.ExportOutlineRequest\x1a\x1f.mirai.v1.ExportOutlineResponse\x12n\n" +
+	"\x17BalanceOutlineDurations\x12(.mirai.v1.BalanceOutlineDurationsRequest\x1a).mirai.v1.BalanceOutlineDurationsResponse\x12h\n" +
 	"\x15GenerateLessonContent\x12&.mirai.v1.GenerateLessonContentRequest\x1a'.mirai.v1.GenerateLessonContentResponse\x12_\n" +
-	"\x12GenerateAllLessons\x12#.mirai.v1.GenerateAllLessonsRequest\x1a$.mirai.v1.GenerateAllLessonsResponse\x12b\n" +
-	"\x13RegenerateComponent\x12$.mirai.v1.RegenerateComponentRequest\x1a%.mirai.v1.RegenerateComponentResponse\x12;\n" +
+	"\x12GenerateAllLessons\x12#.mirai.v1.GenerateAllLessonsRequest\x1a$.mirai.v1.GenerateAllLessonsResponse\x12_\n" +
+	"\x12RetryFailedLessons\x12#.mirai.v1.RetryFailedLessonsRequest\x1a$.mirai.v1.RetryFailedLessonsResponse\x12Y\n" +
+	"\x10ExportAllLessons\x12!.mirai.v1.ExportAllLessonsRequest\x1a\".mirai.v1.ExportAllLessonsResponse\x12b\n" +
+	"\x13RegenerateComponent\x12$.mirai.v1.RegenerateComponentRequest\x1a%.mirai.v1.RegenerateComponentResponse\x12\\\n" +
+	"\x11EditComponentText\x12\".mirai.v1.EditComponentTextRequest\x1a#.mirai.v1.EditComponentTextResponse\x12b\n" +
+	"\x13GetComponentSources\x12$.mirai.v1.GetComponentSourcesRequest\x1a%.mirai.v1.GetComponentSourcesResponse\x12w\n" +
+	"\x1aGetComponentAssetUploadURL\x12+.mirai.v1.GetComponentAssetUploadURLRequest\x1a,.mirai.v1.GetComponentAssetUploadURLResponse\x12h\n" +
+	"\x15ConfirmComponentAsset\x12&.mirai.v1.ConfirmComponentAssetRequest\x1a'.mirai.v1.ConfirmComponentAssetResponse\x12b\n" +
+	"\x13SuggestCourseTitles\x12$.mirai.v1.SuggestCourseTitlesRequest\x1a%.mirai.v1.SuggestCourseTitlesResponse\x12;\n" +
 	"\x06GetJob\x12\x17.mirai.v1.GetJobRequest\x1a\x18.mirai.v1.GetJobResponse\x12A\n" +
 	"\bListJobs\x12\x19.mirai.v1.ListJobsRequest\x1a\x1a.mirai.v1.ListJobsResponse\x12D\n" +
 	"\tCancelJob\x12\x1a.mirai.v1.CancelJobRequest\x1a\x1b.mirai.v1.CancelJobResponse\x12_\n" +
 	"\x12GetGeneratedLesson\x12#.mirai.v1.GetGeneratedLessonRequest\x1a$.mirai.v1.GetGeneratedLessonResponse\x12e\n" +
-	"\x14ListGeneratedLessons\x12%.mirai.v1.ListGeneratedLessonsRequest\x1a&.mirai.v1.ListGeneratedLessonsResponseB\x97\x01\n" +
+	"\x14ListGeneratedLessons\x12%.mirai.v1.ListGeneratedLessonsRequest\x1a&.mirai.v1.ListGeneratedLessonsResponse\x12S\n" +
+	"\x0eGetCourseStats\x12\x1f.mirai.v1.GetCourseStatsRequest\x1a .mirai.v1.GetCourseStatsResponse\x12b\n" +
+	"\x13GetCoursePlayerView\x12$.mirai.v1.GetCoursePlayerViewRequest\x1a%.mirai.v1.GetCoursePlayerViewResponse\x12k\n" +
+	"\x16GetAccessibilityReport\x12'.mirai.v1.GetAccessibilityReportRequest\x1a(.mirai.v1.GetAccessibilityReportResponse\x12S\n" +
+	"\x0eGetQueueStatus\x12\x1f.mirai.v1.GetQueueStatusRequest\x1a .mirai.v1.GetQueueStatusResponse\x12P\n" +
+	"\rListAnomalies\x12\x1e.mirai.v1.ListAnomaliesRequest\x1a\x1f.mirai.v1.ListAnomaliesResponse\x12\\\n" +
+	"\x11StartStorageAudit\x12\".mirai.v1.StartStorageAuditRequest\x1a#.mirai.v1.StartStorageAuditResponse\x12h\n" +
+	"\x15GetStorageAuditReport\x12&.mirai.v1.GetStorageAuditReportRequest\x1a'.mirai.v1.GetStorageAuditReportResponse\x12V\n" +
+	"\x0fTranslateCourse\x12 .mirai.v1.TranslateCourseRequest\x1a!.mirai.v1.TranslateCourseResponse\x12e\n" +
+	"\x14CreateOutlineComment\x12%.mirai.v1.CreateOutlineCommentRequest\x1a&.mirai.v1.CreateOutlineCommentResponse\x12b\n" +
+	"\x13ListOutlineComments\x12$.mirai.v1.ListOutlineCommentsRequest\x1a%.mirai.v1.ListOutlineCommentsResponse\x12h\n" +
+	"\x15ResolveOutlineComment\x12&.mirai.v1.ResolveOutlineCommentRequest\x1a'.mirai.v1.ResolveOutlineCommentResponse\x12_\n" +
+	"\x12SetTenantAIEnabled\x12#.mirai.v1.SetTenantAIEnabledRequest\x1a$.mirai.v1.SetTenantAIEnabledResponse\x12\\\n" +
+	"\x11MigrateQuizSchema\x12\".mirai.v1.MigrateQuizSchemaRequest\x1a#.mirai.v1.MigrateQuizSchemaResponseB\x97\x01\n" +
 	"\fcom.mirai.v1B\x11AiGenerationProtoP\x01Z3github.com/sogos/mirai-backend/gen/mirai/v1;miraiv1\xa2\x02\x03MXX\xaa\x02\bMirai.V1\xca\x02\bMirai\\V1\xe2\x02\x14Mirai\\V1\\GPBMetadata\xea\x02\tMirai::V1b\x06proto3"
 
 var (
@@ -2953,120 +8239,305 @@ func file_mirai_v1_ai_generation_proto_rawDescGZIP() []byte {
 	return file_mirai_v1_ai_generation_proto_rawDescData
 }
 
-var file_mirai_v1_ai_generation_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_mirai_v1_ai_generation_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
+var file_mirai_v1_ai_generation_proto_enumTypes = make([]protoimpl.EnumInfo, 11)
+var file_mirai_v1_ai_generation_proto_msgTypes = make([]protoimpl.MessageInfo, 110)
 var file_mirai_v1_ai_generation_proto_goTypes = []any{
-	(GenerationJobType)(0),                // 0: mirai.v1.GenerationJobType
-	(GenerationJobStatus)(0),              // 1: mirai.v1.GenerationJobStatus
-	(OutlineApprovalStatus)(0),            // 2: mirai.v1.OutlineApprovalStatus
-	(LessonComponentType)(0),              // 3: mirai.v1.LessonComponentType
-	(HeadingLevel)(0),                     // 4: mirai.v1.HeadingLevel
-	(*GenerationJob)(nil),                 // 5: mirai.v1.GenerationJob
-	(*CourseOutline)(nil),                 // 6: mirai.v1.CourseOutline
-	(*OutlineSection)(nil),                // 7: mirai.v1.OutlineSection
-	(*OutlineLesson)(nil),                 // 8: mirai.v1.OutlineLesson
-	(*GeneratedLesson)(nil),               // 9: mirai.v1.GeneratedLesson
-	(*LessonComponent)(nil),               // 10: mirai.v1.LessonComponent
-	(*ComponentAlignment)(nil),            // 11: mirai.v1.ComponentAlignment
-	(*TextContent)(nil),                   // 12: mirai.v1.TextContent
-	(*HeadingContent)(nil),                // 13: mirai.v1.HeadingContent
-	(*ImageContent)(nil),                  // 14: mirai.v1.ImageContent
-	(*QuizContent)(nil),                   // 15: mirai.v1.QuizContent
-	(*QuizOption)(nil),                    // 16: mirai.v1.QuizOption
-	(*CourseGenerationInput)(nil),         // 17: mirai.v1.CourseGenerationInput
-	(*GenerateCourseOutlineRequest)(nil),  // 18: mirai.v1.GenerateCourseOutlineRequest
-	(*GenerateCourseOutlineResponse)(nil), // 19: mirai.v1.GenerateCourseOutlineResponse
-	(*GetCourseOutlineRequest)(nil),       // 20: mirai.v1.GetCourseOutlineRequest
-	(*GetCourseOutlineResponse)(nil),      // 21: mirai.v1.GetCourseOutlineResponse
-	(*ApproveCourseOutlineRequest)(nil),   // 22: mirai.v1.ApproveCourseOutlineRequest
-	(*ApproveCourseOutlineResponse)(nil),  // 23: mirai.v1.ApproveCourseOutlineResponse
-	(*RejectCourseOutlineRequest)(nil),    // 24: mirai.v1.RejectCourseOutlineRequest
-	(*RejectCourseOutlineResponse)(nil),   // 25: mirai.v1.RejectCourseOutlineResponse
-	(*UpdateCourseOutlineRequest)(nil),    // 26: mirai.v1.UpdateCourseOutlineRequest
-	(*UpdateCourseOutlineResponse)(nil),   // 27: mirai.v1.UpdateCourseOutlineResponse
-	(*GenerateLessonContentRequest)(nil),  // 28: mirai.v1.GenerateLessonContentRequest
-	(*GenerateLessonContentResponse)(nil), // 29: mirai.v1.GenerateLessonContentResponse
-	(*GenerateAllLessonsRequest)(nil),     // 30: mirai.v1.GenerateAllLessonsRequest
-	(*GenerateAllLessonsResponse)(nil),    // 31: mirai.v1.GenerateAllLessonsResponse
-	(*RegenerateComponentRequest)(nil),    // 32: mirai.v1.RegenerateComponentRequest
-	(*RegenerateComponentResponse)(nil),   // 33: mirai.v1.RegenerateComponentResponse
-	(*GetJobRequest)(nil),                 // 34: mirai.v1.GetJobRequest
-	(*GetJobResponse)(nil),                // 35: mirai.v1.GetJobResponse
-	(*ListJobsRequest)(nil),               // 36: mirai.v1.ListJobsRequest
-	(*ListJobsResponse)(nil),              // 37: mirai.v1.ListJobsResponse
-	(*CancelJobRequest)(nil),              // 38: mirai.v1.CancelJobRequest
-	(*CancelJobResponse)(nil),             // 39: mirai.v1.CancelJobResponse
-	(*GetGeneratedLessonRequest)(nil),     // 40: mirai.v1.GetGeneratedLessonRequest
-	(*GetGeneratedLessonResponse)(nil),    // 41: mirai.v1.GetGeneratedLessonResponse
-	(*ListGeneratedLessonsRequest)(nil),   // 42: mirai.v1.ListGeneratedLessonsRequest
-	(*ListGeneratedLessonsResponse)(nil),  // 43: mirai.v1.ListGeneratedLessonsResponse
-	(*timestamppb.Timestamp)(nil),         // 44: google.protobuf.Timestamp
+	(GenerationJobType)(0),                     // 0: mirai.v1.GenerationJobType
+	(GenerationJobStatus)(0),                   // 1: mirai.v1.GenerationJobStatus
+	(OutlineApprovalStatus)(0),                 // 2: mirai.v1.OutlineApprovalStatus
+	(LessonComponentType)(0),                   // 3: mirai.v1.LessonComponentType
+	(OutlineExportFormat)(0),                   // 4: mirai.v1.OutlineExportFormat
+	(JobAnomalyType)(0),                        // 5: mirai.v1.JobAnomalyType
+	(HeadingLevel)(0),                          // 6: mirai.v1.HeadingLevel
+	(JobFailureReason)(0),                      // 7: mirai.v1.JobFailureReason
+	(QuizFrequency)(0),                         // 8: mirai.v1.QuizFrequency
+	(AccessibilityIssueType)(0),                // 9: mirai.v1.AccessibilityIssueType
+	(OutlineBalanceChangeKind)(0),              // 10: mirai.v1.OutlineBalanceChangeKind
+	(*GenerationJob)(nil),                      // 11: mirai.v1.GenerationJob
+	(*CourseOutline)(nil),                      // 12: mirai.v1.CourseOutline
+	(*OutlineLessonChanges)(nil),               // 13: mirai.v1.OutlineLessonChanges
+	(*OutlineLessonChange)(nil),                // 14: mirai.v1.OutlineLessonChange
+	(*OutlineSection)(nil),                     // 15: mirai.v1.OutlineSection
+	(*OutlineLesson)(nil),                      // 16: mirai.v1.OutlineLesson
+	(*GeneratedLesson)(nil),                    // 17: mirai.v1.GeneratedLesson
+	(*LessonComponent)(nil),                    // 18: mirai.v1.LessonComponent
+	(*ComponentAlignment)(nil),                 // 19: mirai.v1.ComponentAlignment
+	(*TextContent)(nil),                        // 20: mirai.v1.TextContent
+	(*HeadingContent)(nil),                     // 21: mirai.v1.HeadingContent
+	(*ImageContent)(nil),                       // 22: mirai.v1.ImageContent
+	(*QuizContent)(nil),                        // 23: mirai.v1.QuizContent
+	(*QuizOption)(nil),                         // 24: mirai.v1.QuizOption
+	(*CourseGenerationInput)(nil),              // 25: mirai.v1.CourseGenerationInput
+	(*GenerationPreferences)(nil),              // 26: mirai.v1.GenerationPreferences
+	(*OutlineConstraints)(nil),                 // 27: mirai.v1.OutlineConstraints
+	(*GenerateCourseOutlineRequest)(nil),       // 28: mirai.v1.GenerateCourseOutlineRequest
+	(*GenerateCourseOutlineResponse)(nil),      // 29: mirai.v1.GenerateCourseOutlineResponse
+	(*AnalyzeKnowledgeCoverageRequest)(nil),    // 30: mirai.v1.AnalyzeKnowledgeCoverageRequest
+	(*AnalyzeKnowledgeCoverageResponse)(nil),   // 31: mirai.v1.AnalyzeKnowledgeCoverageResponse
+	(*KnowledgeCoverage)(nil),                  // 32: mirai.v1.KnowledgeCoverage
+	(*TermCoverage)(nil),                       // 33: mirai.v1.TermCoverage
+	(*GetCourseOutlineRequest)(nil),            // 34: mirai.v1.GetCourseOutlineRequest
+	(*GetCourseOutlineResponse)(nil),           // 35: mirai.v1.GetCourseOutlineResponse
+	(*ApproveCourseOutlineRequest)(nil),        // 36: mirai.v1.ApproveCourseOutlineRequest
+	(*ApproveCourseOutlineResponse)(nil),       // 37: mirai.v1.ApproveCourseOutlineResponse
+	(*RejectCourseOutlineRequest)(nil),         // 38: mirai.v1.RejectCourseOutlineRequest
+	(*RejectCourseOutlineResponse)(nil),        // 39: mirai.v1.RejectCourseOutlineResponse
+	(*UpdateCourseOutlineRequest)(nil),         // 40: mirai.v1.UpdateCourseOutlineRequest
+	(*UpdateCourseOutlineResponse)(nil),        // 41: mirai.v1.UpdateCourseOutlineResponse
+	(*ExportOutlineRequest)(nil),               // 42: mirai.v1.ExportOutlineRequest
+	(*ExportOutlineResponse)(nil),              // 43: mirai.v1.ExportOutlineResponse
+	(*GenerateLessonContentRequest)(nil),       // 44: mirai.v1.GenerateLessonContentRequest
+	(*GenerateLessonContentResponse)(nil),      // 45: mirai.v1.GenerateLessonContentResponse
+	(*GenerateAllLessonsRequest)(nil),          // 46: mirai.v1.GenerateAllLessonsRequest
+	(*GenerateAllLessonsResponse)(nil),         // 47: mirai.v1.GenerateAllLessonsResponse
+	(*ExportAllLessonsRequest)(nil),            // 48: mirai.v1.ExportAllLessonsRequest
+	(*ExportAllLessonsResponse)(nil),           // 49: mirai.v1.ExportAllLessonsResponse
+	(*RetryFailedLessonsRequest)(nil),          // 50: mirai.v1.RetryFailedLessonsRequest
+	(*RetryFailedLessonsResponse)(nil),         // 51: mirai.v1.RetryFailedLessonsResponse
+	(*RegenerateComponentRequest)(nil),         // 52: mirai.v1.RegenerateComponentRequest
+	(*RegenerateComponentResponse)(nil),        // 53: mirai.v1.RegenerateComponentResponse
+	(*EditComponentTextRequest)(nil),           // 54: mirai.v1.EditComponentTextRequest
+	(*EditComponentTextResponse)(nil),          // 55: mirai.v1.EditComponentTextResponse
+	(*GetComponentSourcesRequest)(nil),         // 56: mirai.v1.GetComponentSourcesRequest
+	(*ComponentSource)(nil),                    // 57: mirai.v1.ComponentSource
+	(*GetComponentSourcesResponse)(nil),        // 58: mirai.v1.GetComponentSourcesResponse
+	(*GetComponentAssetUploadURLRequest)(nil),  // 59: mirai.v1.GetComponentAssetUploadURLRequest
+	(*GetComponentAssetUploadURLResponse)(nil), // 60: mirai.v1.GetComponentAssetUploadURLResponse
+	(*ConfirmComponentAssetRequest)(nil),       // 61: mirai.v1.ConfirmComponentAssetRequest
+	(*ConfirmComponentAssetResponse)(nil),      // 62: mirai.v1.ConfirmComponentAssetResponse
+	(*SuggestCourseTitlesRequest)(nil),         // 63: mirai.v1.SuggestCourseTitlesRequest
+	(*CourseTitleSuggestion)(nil),              // 64: mirai.v1.CourseTitleSuggestion
+	(*SuggestCourseTitlesResponse)(nil),        // 65: mirai.v1.SuggestCourseTitlesResponse
+	(*GetJobRequest)(nil),                      // 66: mirai.v1.GetJobRequest
+	(*GetJobResponse)(nil),                     // 67: mirai.v1.GetJobResponse
+	(*ListJobsRequest)(nil),                    // 68: mirai.v1.ListJobsRequest
+	(*ListJobsResponse)(nil),                   // 69: mirai.v1.ListJobsResponse
+	(*CancelJobRequest)(nil),                   // 70: mirai.v1.CancelJobRequest
+	(*CancelJobResponse)(nil),                  // 71: mirai.v1.CancelJobResponse
+	(*GetGeneratedLessonRequest)(nil),          // 72: mirai.v1.GetGeneratedLessonRequest
+	(*GetGeneratedLessonResponse)(nil),         // 73: mirai.v1.GetGeneratedLessonResponse
+	(*ListGeneratedLessonsRequest)(nil),        // 74: mirai.v1.ListGeneratedLessonsRequest
+	(*ListGeneratedLessonsResponse)(nil),       // 75: mirai.v1.ListGeneratedLessonsResponse
+	(*ContentStats)(nil),                       // 76: mirai.v1.ContentStats
+	(*SectionStats)(nil),                       // 77: mirai.v1.SectionStats
+	(*GetCourseStatsRequest)(nil),              // 78: mirai.v1.GetCourseStatsRequest
+	(*GetCourseStatsResponse)(nil),             // 79: mirai.v1.GetCourseStatsResponse
+	(*GetCoursePlayerViewRequest)(nil),         // 80: mirai.v1.GetCoursePlayerViewRequest
+	(*GetCoursePlayerViewResponse)(nil),        // 81: mirai.v1.GetCoursePlayerViewResponse
+	(*CoursePlayerView)(nil),                   // 82: mirai.v1.CoursePlayerView
+	(*CoursePlayerSection)(nil),                // 83: mirai.v1.CoursePlayerSection
+	(*CoursePlayerLesson)(nil),                 // 84: mirai.v1.CoursePlayerLesson
+	(*CoursePlayerComponent)(nil),              // 85: mirai.v1.CoursePlayerComponent
+	(*GetQueueStatusRequest)(nil),              // 86: mirai.v1.GetQueueStatusRequest
+	(*JobTypeQueueCount)(nil),                  // 87: mirai.v1.JobTypeQueueCount
+	(*GetQueueStatusResponse)(nil),             // 88: mirai.v1.GetQueueStatusResponse
+	(*JobAnomaly)(nil),                         // 89: mirai.v1.JobAnomaly
+	(*ListAnomaliesRequest)(nil),               // 90: mirai.v1.ListAnomaliesRequest
+	(*ListAnomaliesResponse)(nil),              // 91: mirai.v1.ListAnomaliesResponse
+	(*GenerationDraft)(nil),                    // 92: mirai.v1.GenerationDraft
+	(*SaveGenerationDraftRequest)(nil),         // 93: mirai.v1.SaveGenerationDraftRequest
+	(*SaveGenerationDraftResponse)(nil),        // 94: mirai.v1.SaveGenerationDraftResponse
+	(*GetGenerationDraftRequest)(nil),          // 95: mirai.v1.GetGenerationDraftRequest
+	(*GetGenerationDraftResponse)(nil),         // 96: mirai.v1.GetGenerationDraftResponse
+	(*StartStorageAuditRequest)(nil),           // 97: mirai.v1.StartStorageAuditRequest
+	(*StartStorageAuditResponse)(nil),          // 98: mirai.v1.StartStorageAuditResponse
+	(*GetStorageAuditReportRequest)(nil),       // 99: mirai.v1.GetStorageAuditReportRequest
+	(*GetStorageAuditReportResponse)(nil),      // 100: mirai.v1.GetStorageAuditReportResponse
+	(*TranslateCourseRequest)(nil),             // 101: mirai.v1.TranslateCourseRequest
+	(*TranslateCourseResponse)(nil),            // 102: mirai.v1.TranslateCourseResponse
+	(*OutlineComment)(nil),                     // 103: mirai.v1.OutlineComment
+	(*CreateOutlineCommentRequest)(nil),        // 104: mirai.v1.CreateOutlineCommentRequest
+	(*CreateOutlineCommentResponse)(nil),       // 105: mirai.v1.CreateOutlineCommentResponse
+	(*ListOutlineCommentsRequest)(nil),         // 106: mirai.v1.ListOutlineCommentsRequest
+	(*ListOutlineCommentsResponse)(nil),        // 107: mirai.v1.ListOutlineCommentsResponse
+	(*ResolveOutlineCommentRequest)(nil),       // 108: mirai.v1.ResolveOutlineCommentRequest
+	(*ResolveOutlineCommentResponse)(nil),      // 109: mirai.v1.ResolveOutlineCommentResponse
+	(*SetTenantAIEnabledRequest)(nil),          // 110: mirai.v1.SetTenantAIEnabledRequest
+	(*SetTenantAIEnabledResponse)(nil),         // 111: mirai.v1.SetTenantAIEnabledResponse
+	(*AccessibilityIssue)(nil),                 // 112: mirai.v1.AccessibilityIssue
+	(*GetAccessibilityReportRequest)(nil),      // 113: mirai.v1.GetAccessibilityReportRequest
+	(*GetAccessibilityReportResponse)(nil),     // 114: mirai.v1.GetAccessibilityReportResponse
+	(*OutlineBalanceChange)(nil),               // 115: mirai.v1.OutlineBalanceChange
+	(*BalanceOutlineDurationsRequest)(nil),     // 116: mirai.v1.BalanceOutlineDurationsRequest
+	(*BalanceOutlineDurationsResponse)(nil),    // 117: mirai.v1.BalanceOutlineDurationsResponse
+	(*JobChildCounts)(nil),                     // 118: mirai.v1.JobChildCounts
+	(*MigrateQuizSchemaRequest)(nil),           // 119: mirai.v1.MigrateQuizSchemaRequest
+	(*MigrateQuizSchemaResponse)(nil),          // 120: mirai.v1.MigrateQuizSchemaResponse
+	(*timestamppb.Timestamp)(nil),              // 121: google.protobuf.Timestamp
 }
 var file_mirai_v1_ai_generation_proto_depIdxs = []int32{
-	0,  // 0: mirai.v1.GenerationJob.type:type_name -> mirai.v1.GenerationJobType
-	1,  // 1: mirai.v1.GenerationJob.status:type_name -> mirai.v1.GenerationJobStatus
-	44, // 2: mirai.v1.GenerationJob.created_at:type_name -> google.protobuf.Timestamp
-	44, // 3: mirai.v1.GenerationJob.started_at:type_name -> google.protobuf.Timestamp
-	44, // 4: mirai.v1.GenerationJob.completed_at:type_name -> google.protobuf.Timestamp
-	7,  // 5: mirai.v1.CourseOutline.sections:type_name -> mirai.v1.OutlineSection
-	2,  // 6: mirai.v1.CourseOutline.approval_status:type_name -> mirai.v1.OutlineApprovalStatus
-	44, // 7: mirai.v1.CourseOutline.generated_at:type_name -> google.protobuf.Timestamp
-	44, // 8: mirai.v1.CourseOutline.approved_at:type_name -> google.protobuf.Timestamp
-	8,  // 9: mirai.v1.OutlineSection.lessons:type_name -> mirai.v1.OutlineLesson
-	10, // 10: mirai.v1.GeneratedLesson.components:type_name -> mirai.v1.LessonComponent
-	44, // 11: mirai.v1.GeneratedLesson.generated_at:type_name -> google.protobuf.Timestamp
-	3,  // 12: mirai.v1.LessonComponent.type:type_name -> mirai.v1.LessonComponentType
-	11, // 13: mirai.v1.LessonComponent.alignment:type_name -> mirai.v1.ComponentAlignment
-	4,  // 14: mirai.v1.HeadingContent.level:type_name -> mirai.v1.HeadingLevel
-	16, // 15: mirai.v1.QuizContent.options:type_name -> mirai.v1.QuizOption
-	17, // 16: mirai.v1.GenerateCourseOutlineRequest.input:type_name -> mirai.v1.CourseGenerationInput
-	5,  // 17: mirai.v1.GenerateCourseOutlineResponse.job:type_name -> mirai.v1.GenerationJob
-	6,  // 18: mirai.v1.GetCourseOutlineResponse.outline:type_name -> mirai.v1.CourseOutline
-	6,  // 19: mirai.v1.ApproveCourseOutlineResponse.outline:type_name -> mirai.v1.CourseOutline
-	6,  // 20: mirai.v1.RejectCourseOutlineResponse.outline:type_name -> mirai.v1.CourseOutline
-	7,  // 21: mirai.v1.UpdateCourseOutlineRequest.sections:type_name -> mirai.v1.OutlineSection
-	6,  // 22: mirai.v1.UpdateCourseOutlineResponse.outline:type_name -> mirai.v1.CourseOutline
-	5,  // 23: mirai.v1.GenerateLessonContentResponse.job:type_name -> mirai.v1.GenerationJob
-	5,  // 24: mirai.v1.GenerateAllLessonsResponse.job:type_name -> mirai.v1.GenerationJob
-	5,  // 25: mirai.v1.RegenerateComponentResponse.job:type_name -> mirai.v1.GenerationJob
-	5,  // 26: mirai.v1.GetJobResponse.job:type_name -> mirai.v1.GenerationJob
-	0,  // 27: mirai.v1.ListJobsRequest.type:type_name -> mirai.v1.GenerationJobType
-	1,  // 28: mirai.v1.ListJobsRequest.status:type_name -> mirai.v1.GenerationJobStatus
-	5,  // 29: mirai.v1.ListJobsResponse.jobs:type_name -> mirai.v1.GenerationJob
-	5,  // 30: mirai.v1.CancelJobResponse.job:type_name -> mirai.v1.GenerationJob
-	9,  // 31: mirai.v1.GetGeneratedLessonResponse.lesson:type_name -> mirai.v1.GeneratedLesson
-	9,  // 32: mirai.v1.ListGeneratedLessonsResponse.lessons:type_name -> mirai.v1.GeneratedLesson
-	18, // 33: mirai.v1.AIGenerationService.GenerateCourseOutline:input_type -> mirai.v1.GenerateCourseOutlineRequest
-	20, // 34: mirai.v1.AIGenerationService.GetCourseOutline:input_type -> mirai.v1.GetCourseOutlineRequest
-	22, // 35: mirai.v1.AIGenerationService.ApproveCourseOutline:input_type -> mirai.v1.ApproveCourseOutlineRequest
-	24, // 36: mirai.v1.AIGenerationService.RejectCourseOutline:input_type -> mirai.v1.RejectCourseOutlineRequest
-	26, // 37: mirai.v1.AIGenerationService.UpdateCourseOutline:input_type -> mirai.v1.UpdateCourseOutlineRequest
-	28, // 38: mirai.v1.AIGenerationService.GenerateLessonContent:input_type -> mirai.v1.GenerateLessonContentRequest
-	30, // 39: mirai.v1.AIGenerationService.GenerateAllLessons:input_type -> mirai.v1.GenerateAllLessonsRequest
-	32, // 40: mirai.v1.AIGenerationService.RegenerateComponent:input_type -> mirai.v1.RegenerateComponentRequest
-	34, // 41: mirai.v1.AIGenerationService.GetJob:input_type -> mirai.v1.GetJobRequest
-	36, // 42: mirai.v1.AIGenerationService.ListJobs:input_type -> mirai.v1.ListJobsRequest
-	38, // 43: mirai.v1.AIGenerationService.CancelJob:input_type -> mirai.v1.CancelJobRequest
-	40, // 44: mirai.v1.AIGenerationService.GetGeneratedLesson:input_type -> mirai.v1.GetGeneratedLessonRequest
-	42, // 45: mirai.v1.AIGenerationService.ListGeneratedLessons:input_type -> mirai.v1.ListGeneratedLessonsRequest
-	19, // 46: mirai.v1.AIGenerationService.GenerateCourseOutline:output_type -> mirai.v1.GenerateCourseOutlineResponse
-	21, // 47: mirai.v1.AIGenerationService.GetCourseOutline:output_type -> mirai.v1.GetCourseOutlineResponse
-	23, // 48: mirai.v1.AIGenerationService.ApproveCourseOutline:output_type -> mirai.v1.ApproveCourseOutlineResponse
-	25, // 49: mirai.v1.AIGenerationService.RejectCourseOutline:output_type -> mirai.v1.RejectCourseOutlineResponse
-	27, // 50: mirai.v1.AIGenerationService.UpdateCourseOutline:output_type -> mirai.v1.UpdateCourseOutlineResponse
-	29, // 51: mirai.v1.AIGenerationService.GenerateLessonContent:output_type -> mirai.v1.GenerateLessonContentResponse
-	31, // 52: mirai.v1.AIGenerationService.GenerateAllLessons:output_type -> mirai.v1.GenerateAllLessonsResponse
-	33, // 53: mirai.v1.AIGenerationService.RegenerateComponent:output_type -> mirai.v1.RegenerateComponentResponse
-	35, // 54: mirai.v1.AIGenerationService.GetJob:output_type -> mirai.v1.GetJobResponse
-	37, // 55: mirai.v1.AIGenerationService.ListJobs:output_type -> mirai.v1.ListJobsResponse
-	39, // 56: mirai.v1.AIGenerationService.CancelJob:output_type -> mirai.v1.CancelJobResponse
-	41, // 57: mirai.v1.AIGenerationService.GetGeneratedLesson:output_type -> mirai.v1.GetGeneratedLessonResponse
-	43, // 58: mirai.v1.AIGenerationService.ListGeneratedLessons:output_type -> mirai.v1.ListGeneratedLessonsResponse
-	46, // [46:59] is the sub-list for method output_type
-	33, // [33:46] is the sub-list for method input_type
-	33, // [33:33] is the sub-list for extension type_name
-	33, // [33:33] is the sub-list for extension extendee
-	0,  // [0:33] is the sub-list for field type_name
+	0,   // 0: mirai.v1.GenerationJob.type:type_name -> mirai.v1.GenerationJobType
+	1,   // 1: mirai.v1.GenerationJob.status:type_name -> mirai.v1.GenerationJobStatus
+	121, // 2: mirai.v1.GenerationJob.created_at:type_name -> google.protobuf.Timestamp
+	121, // 3: mirai.v1.GenerationJob.started_at:type_name -> google.protobuf.Timestamp
+	121, // 4: mirai.v1.GenerationJob.completed_at:type_name -> google.protobuf.Timestamp
+	7,   // 5: mirai.v1.GenerationJob.failure_reason:type_name -> mirai.v1.JobFailureReason
+	118, // 6: mirai.v1.GenerationJob.child_counts:type_name -> mirai.v1.JobChildCounts
+	15,  // 7: mirai.v1.CourseOutline.sections:type_name -> mirai.v1.OutlineSection
+	2,   // 8: mirai.v1.CourseOutline.approval_status:type_name -> mirai.v1.OutlineApprovalStatus
+	121, // 9: mirai.v1.CourseOutline.generated_at:type_name -> google.protobuf.Timestamp
+	121, // 10: mirai.v1.CourseOutline.approved_at:type_name -> google.protobuf.Timestamp
+	27,  // 11: mirai.v1.CourseOutline.constraints:type_name -> mirai.v1.OutlineConstraints
+	13,  // 12: mirai.v1.CourseOutline.lesson_changes:type_name -> mirai.v1.OutlineLessonChanges
+	121, // 13: mirai.v1.CourseOutline.endorsed_at:type_name -> google.protobuf.Timestamp
+	14,  // 14: mirai.v1.OutlineLessonChanges.kept:type_name -> mirai.v1.OutlineLessonChange
+	14,  // 15: mirai.v1.OutlineLessonChanges.added:type_name -> mirai.v1.OutlineLessonChange
+	14,  // 16: mirai.v1.OutlineLessonChanges.removed:type_name -> mirai.v1.OutlineLessonChange
+	16,  // 17: mirai.v1.OutlineSection.lessons:type_name -> mirai.v1.OutlineLesson
+	18,  // 18: mirai.v1.GeneratedLesson.components:type_name -> mirai.v1.LessonComponent
+	121, // 19: mirai.v1.GeneratedLesson.generated_at:type_name -> google.protobuf.Timestamp
+	121, // 20: mirai.v1.GeneratedLesson.orphaned_at:type_name -> google.protobuf.Timestamp
+	3,   // 21: mirai.v1.LessonComponent.type:type_name -> mirai.v1.LessonComponentType
+	19,  // 22: mirai.v1.LessonComponent.alignment:type_name -> mirai.v1.ComponentAlignment
+	6,   // 23: mirai.v1.HeadingContent.level:type_name -> mirai.v1.HeadingLevel
+	24,  // 24: mirai.v1.QuizContent.options:type_name -> mirai.v1.QuizOption
+	27,  // 25: mirai.v1.CourseGenerationInput.constraints:type_name -> mirai.v1.OutlineConstraints
+	26,  // 26: mirai.v1.CourseGenerationInput.preferences:type_name -> mirai.v1.GenerationPreferences
+	8,   // 27: mirai.v1.GenerationPreferences.quiz_frequency:type_name -> mirai.v1.QuizFrequency
+	25,  // 28: mirai.v1.GenerateCourseOutlineRequest.input:type_name -> mirai.v1.CourseGenerationInput
+	11,  // 29: mirai.v1.GenerateCourseOutlineResponse.job:type_name -> mirai.v1.GenerationJob
+	32,  // 30: mirai.v1.GenerateCourseOutlineResponse.coverage:type_name -> mirai.v1.KnowledgeCoverage
+	32,  // 31: mirai.v1.AnalyzeKnowledgeCoverageResponse.coverage:type_name -> mirai.v1.KnowledgeCoverage
+	33,  // 32: mirai.v1.KnowledgeCoverage.terms:type_name -> mirai.v1.TermCoverage
+	12,  // 33: mirai.v1.GetCourseOutlineResponse.outline:type_name -> mirai.v1.CourseOutline
+	11,  // 34: mirai.v1.GetCourseOutlineResponse.active_generation_job:type_name -> mirai.v1.GenerationJob
+	12,  // 35: mirai.v1.ApproveCourseOutlineResponse.outline:type_name -> mirai.v1.CourseOutline
+	12,  // 36: mirai.v1.RejectCourseOutlineResponse.outline:type_name -> mirai.v1.CourseOutline
+	15,  // 37: mirai.v1.UpdateCourseOutlineRequest.sections:type_name -> mirai.v1.OutlineSection
+	12,  // 38: mirai.v1.UpdateCourseOutlineResponse.outline:type_name -> mirai.v1.CourseOutline
+	4,   // 39: mirai.v1.ExportOutlineRequest.format:type_name -> mirai.v1.OutlineExportFormat
+	121, // 40: mirai.v1.ExportOutlineResponse.expires_at:type_name -> google.protobuf.Timestamp
+	11,  // 41: mirai.v1.GenerateLessonContentResponse.job:type_name -> mirai.v1.GenerationJob
+	26,  // 42: mirai.v1.GenerateAllLessonsRequest.preferences:type_name -> mirai.v1.GenerationPreferences
+	11,  // 43: mirai.v1.GenerateAllLessonsResponse.job:type_name -> mirai.v1.GenerationJob
+	11,  // 44: mirai.v1.ExportAllLessonsResponse.job:type_name -> mirai.v1.GenerationJob
+	11,  // 45: mirai.v1.RetryFailedLessonsResponse.job:type_name -> mirai.v1.GenerationJob
+	11,  // 46: mirai.v1.RegenerateComponentResponse.job:type_name -> mirai.v1.GenerationJob
+	3,   // 47: mirai.v1.EditComponentTextResponse.type:type_name -> mirai.v1.LessonComponentType
+	57,  // 48: mirai.v1.GetComponentSourcesResponse.sources:type_name -> mirai.v1.ComponentSource
+	18,  // 49: mirai.v1.ConfirmComponentAssetResponse.component:type_name -> mirai.v1.LessonComponent
+	64,  // 50: mirai.v1.SuggestCourseTitlesResponse.suggestions:type_name -> mirai.v1.CourseTitleSuggestion
+	11,  // 51: mirai.v1.GetJobResponse.job:type_name -> mirai.v1.GenerationJob
+	0,   // 52: mirai.v1.ListJobsRequest.type:type_name -> mirai.v1.GenerationJobType
+	1,   // 53: mirai.v1.ListJobsRequest.status:type_name -> mirai.v1.GenerationJobStatus
+	11,  // 54: mirai.v1.ListJobsResponse.jobs:type_name -> mirai.v1.GenerationJob
+	11,  // 55: mirai.v1.CancelJobResponse.job:type_name -> mirai.v1.GenerationJob
+	17,  // 56: mirai.v1.GetGeneratedLessonResponse.lesson:type_name -> mirai.v1.GeneratedLesson
+	17,  // 57: mirai.v1.ListGeneratedLessonsResponse.lessons:type_name -> mirai.v1.GeneratedLesson
+	76,  // 58: mirai.v1.SectionStats.stats:type_name -> mirai.v1.ContentStats
+	76,  // 59: mirai.v1.GetCourseStatsResponse.totals:type_name -> mirai.v1.ContentStats
+	77,  // 60: mirai.v1.GetCourseStatsResponse.sections:type_name -> mirai.v1.SectionStats
+	82,  // 61: mirai.v1.GetCoursePlayerViewResponse.view:type_name -> mirai.v1.CoursePlayerView
+	83,  // 62: mirai.v1.CoursePlayerView.sections:type_name -> mirai.v1.CoursePlayerSection
+	84,  // 63: mirai.v1.CoursePlayerSection.lessons:type_name -> mirai.v1.CoursePlayerLesson
+	85,  // 64: mirai.v1.CoursePlayerLesson.components:type_name -> mirai.v1.CoursePlayerComponent
+	3,   // 65: mirai.v1.CoursePlayerComponent.type:type_name -> mirai.v1.LessonComponentType
+	0,   // 66: mirai.v1.JobTypeQueueCount.type:type_name -> mirai.v1.GenerationJobType
+	87,  // 67: mirai.v1.GetQueueStatusResponse.counts:type_name -> mirai.v1.JobTypeQueueCount
+	5,   // 68: mirai.v1.JobAnomaly.type:type_name -> mirai.v1.JobAnomalyType
+	121, // 69: mirai.v1.JobAnomaly.detected_at:type_name -> google.protobuf.Timestamp
+	5,   // 70: mirai.v1.ListAnomaliesRequest.type:type_name -> mirai.v1.JobAnomalyType
+	89,  // 71: mirai.v1.ListAnomaliesResponse.anomalies:type_name -> mirai.v1.JobAnomaly
+	25,  // 72: mirai.v1.GenerationDraft.input:type_name -> mirai.v1.CourseGenerationInput
+	121, // 73: mirai.v1.GenerationDraft.updated_at:type_name -> google.protobuf.Timestamp
+	25,  // 74: mirai.v1.SaveGenerationDraftRequest.input:type_name -> mirai.v1.CourseGenerationInput
+	92,  // 75: mirai.v1.SaveGenerationDraftResponse.draft:type_name -> mirai.v1.GenerationDraft
+	92,  // 76: mirai.v1.GetGenerationDraftResponse.draft:type_name -> mirai.v1.GenerationDraft
+	11,  // 77: mirai.v1.StartStorageAuditResponse.job:type_name -> mirai.v1.GenerationJob
+	11,  // 78: mirai.v1.GetStorageAuditReportResponse.job:type_name -> mirai.v1.GenerationJob
+	121, // 79: mirai.v1.GetStorageAuditReportResponse.expires_at:type_name -> google.protobuf.Timestamp
+	11,  // 80: mirai.v1.TranslateCourseResponse.job:type_name -> mirai.v1.GenerationJob
+	121, // 81: mirai.v1.OutlineComment.resolved_at:type_name -> google.protobuf.Timestamp
+	121, // 82: mirai.v1.OutlineComment.created_at:type_name -> google.protobuf.Timestamp
+	103, // 83: mirai.v1.CreateOutlineCommentResponse.comment:type_name -> mirai.v1.OutlineComment
+	103, // 84: mirai.v1.ListOutlineCommentsResponse.comments:type_name -> mirai.v1.OutlineComment
+	103, // 85: mirai.v1.ResolveOutlineCommentResponse.comment:type_name -> mirai.v1.OutlineComment
+	9,   // 86: mirai.v1.AccessibilityIssue.type:type_name -> mirai.v1.AccessibilityIssueType
+	112, // 87: mirai.v1.GetAccessibilityReportResponse.issues:type_name -> mirai.v1.AccessibilityIssue
+	10,  // 88: mirai.v1.OutlineBalanceChange.kind:type_name -> mirai.v1.OutlineBalanceChangeKind
+	15,  // 89: mirai.v1.BalanceOutlineDurationsResponse.sections:type_name -> mirai.v1.OutlineSection
+	115, // 90: mirai.v1.BalanceOutlineDurationsResponse.changes:type_name -> mirai.v1.OutlineBalanceChange
+	28,  // 91: mirai.v1.AIGenerationService.GenerateCourseOutline:input_type -> mirai.v1.GenerateCourseOutlineRequest
+	30,  // 92: mirai.v1.AIGenerationService.AnalyzeKnowledgeCoverage:input_type -> mirai.v1.AnalyzeKnowledgeCoverageRequest
+	93,  // 93: mirai.v1.AIGenerationService.SaveGenerationDraft:input_type -> mirai.v1.SaveGenerationDraftRequest
+	95,  // 94: mirai.v1.AIGenerationService.GetGenerationDraft:input_type -> mirai.v1.GetGenerationDraftRequest
+	34,  // 95: mirai.v1.AIGenerationService.GetCourseOutline:input_type -> mirai.v1.GetCourseOutlineRequest
+	36,  // 96: mirai.v1.AIGenerationService.ApproveCourseOutline:input_type -> mirai.v1.ApproveCourseOutlineRequest
+	38,  // 97: mirai.v1.AIGenerationService.RejectCourseOutline:input_type -> mirai.v1.RejectCourseOutlineRequest
+	40,  // 98: mirai.v1.AIGenerationService.UpdateCourseOutline:input_type -> mirai.v1.UpdateCourseOutlineRequest
+	42,  // 99: mirai.v1.AIGenerationService.ExportOutline:input_type -> mirai.v1.ExportOutlineRequest
+	116, // 100: mirai.v1.AIGenerationService.BalanceOutlineDurations:input_type -> mirai.v1.BalanceOutlineDurationsRequest
+	44,  // 101: mirai.v1.AIGenerationService.GenerateLessonContent:input_type -> mirai.v1.GenerateLessonContentRequest
+	46,  // 102: mirai.v1.AIGenerationService.GenerateAllLessons:input_type -> mirai.v1.GenerateAllLessonsRequest
+	50,  // 103: mirai.v1.AIGenerationService.RetryFailedLessons:input_type -> mirai.v1.RetryFailedLessonsRequest
+	48,  // 104: mirai.v1.AIGenerationService.ExportAllLessons:input_type -> mirai.v1.ExportAllLessonsRequest
+	52,  // 105: mirai.v1.AIGenerationService.RegenerateComponent:input_type -> mirai.v1.RegenerateComponentRequest
+	54,  // 106: mirai.v1.AIGenerationService.EditComponentText:input_type -> mirai.v1.EditComponentTextRequest
+	56,  // 107: mirai.v1.AIGenerationService.GetComponentSources:input_type -> mirai.v1.GetComponentSourcesRequest
+	59,  // 108: mirai.v1.AIGenerationService.GetComponentAssetUploadURL:input_type -> mirai.v1.GetComponentAssetUploadURLRequest
+	61,  // 109: mirai.v1.AIGenerationService.ConfirmComponentAsset:input_type -> mirai.v1.ConfirmComponentAssetRequest
+	63,  // 110: mirai.v1.AIGenerationService.SuggestCourseTitles:input_type -> mirai.v1.SuggestCourseTitlesRequest
+	66,  // 111: mirai.v1.AIGenerationService.GetJob:input_type -> mirai.v1.GetJobRequest
+	68,  // 112: mirai.v1.AIGenerationService.ListJobs:input_type -> mirai.v1.ListJobsRequest
+	70,  // 113: mirai.v1.AIGenerationService.CancelJob:input_type -> mirai.v1.CancelJobRequest
+	72,  // 114: mirai.v1.AIGenerationService.GetGeneratedLesson:input_type -> mirai.v1.GetGeneratedLessonRequest
+	74,  // 115: mirai.v1.AIGenerationService.ListGeneratedLessons:input_type -> mirai.v1.ListGeneratedLessonsRequest
+	78,  // 116: mirai.v1.AIGenerationService.GetCourseStats:input_type -> mirai.v1.GetCourseStatsRequest
+	80,  // 117: mirai.v1.AIGenerationService.GetCoursePlayerView:input_type -> mirai.v1.GetCoursePlayerViewRequest
+	113, // 118: mirai.v1.AIGenerationService.GetAccessibilityReport:input_type -> mirai.v1.GetAccessibilityReportRequest
+	86,  // 119: mirai.v1.AIGenerationService.GetQueueStatus:input_type -> mirai.v1.GetQueueStatusRequest
+	90,  // 120: mirai.v1.AIGenerationService.ListAnomalies:input_type -> mirai.v1.ListAnomaliesRequest
+	97,  // 121: mirai.v1.AIGenerationService.StartStorageAudit:input_type -> mirai.v1.StartStorageAuditRequest
+	99,  // 122: mirai.v1.AIGenerationService.GetStorageAuditReport:input_type -> mirai.v1.GetStorageAuditReportRequest
+	101, // 123: mirai.v1.AIGenerationService.TranslateCourse:input_type -> mirai.v1.TranslateCourseRequest
+	104, // 124: mirai.v1.AIGenerationService.CreateOutlineComment:input_type -> mirai.v1.CreateOutlineCommentRequest
+	106, // 125: mirai.v1.AIGenerationService.ListOutlineComments:input_type -> mirai.v1.ListOutlineCommentsRequest
+	108, // 126: mirai.v1.AIGenerationService.ResolveOutlineComment:input_type -> mirai.v1.ResolveOutlineCommentRequest
+	110, // 127: mirai.v1.AIGenerationService.SetTenantAIEnabled:input_type -> mirai.v1.SetTenantAIEnabledRequest
+	119, // 128: mirai.v1.AIGenerationService.MigrateQuizSchema:input_type -> mirai.v1.MigrateQuizSchemaRequest
+	29,  // 129: mirai.v1.AIGenerationService.GenerateCourseOutline:output_type -> mirai.v1.GenerateCourseOutlineResponse
+	31,  // 130: mirai.v1.AIGenerationService.AnalyzeKnowledgeCoverage:output_type -> mirai.v1.AnalyzeKnowledgeCoverageResponse
+	94,  // 131: mirai.v1.AIGenerationService.SaveGenerationDraft:output_type -> mirai.v1.SaveGenerationDraftResponse
+	96,  // 132: mirai.v1.AIGenerationService.GetGenerationDraft:output_type -> mirai.v1.GetGenerationDraftResponse
+	35,  // 133: mirai.v1.AIGenerationService.GetCourseOutline:output_type -> mirai.v1.GetCourseOutlineResponse
+	37,  // 134: mirai.v1.AIGenerationService.ApproveCourseOutline:output_type -> mirai.v1.ApproveCourseOutlineResponse
+	39,  // 135: mirai.v1.AIGenerationService.RejectCourseOutline:output_type -> mirai.v1.RejectCourseOutlineResponse
+	41,  // 136: mirai.v1.AIGenerationService.UpdateCourseOutline:output_type -> mirai.v1.UpdateCourseOutlineResponse
+	43,  // 137: mirai.v1.AIGenerationService.ExportOutline:output_type -> mirai.v1.ExportOutlineResponse
+	117, // 138: mirai.v1.AIGenerationService.BalanceOutlineDurations:output_type -> mirai.v1.BalanceOutlineDurationsResponse
+	45,  // 139: mirai.v1.AIGenerationService.GenerateLessonContent:output_type -> mirai.v1.GenerateLessonContentResponse
+	47,  // 140: mirai.v1.AIGenerationService.GenerateAllLessons:output_type -> mirai.v1.GenerateAllLessonsResponse
+	51,  // 141: mirai.v1.AIGenerationService.RetryFailedLessons:output_type -> mirai.v1.RetryFailedLessonsResponse
+	49,  // 142: mirai.v1.AIGenerationService.ExportAllLessons:output_type -> mirai.v1.ExportAllLessonsResponse
+	53,  // 143: mirai.v1.AIGenerationService.RegenerateComponent:output_type -> mirai.v1.RegenerateComponentResponse
+	55,  // 144: mirai.v1.AIGenerationService.EditComponentText:output_type -> mirai.v1.EditComponentTextResponse
+	58,  // 145: mirai.v1.AIGenerationService.GetComponentSources:output_type -> mirai.v1.GetComponentSourcesResponse
+	60,  // 146: mirai.v1.AIGenerationService.GetComponentAssetUploadURL:output_type -> mirai.v1.GetComponentAssetUploadURLResponse
+	62,  // 147: mirai.v1.AIGenerationService.ConfirmComponentAsset:output_type -> mirai.v1.ConfirmComponentAssetResponse
+	65,  // 148: mirai.v1.AIGenerationService.SuggestCourseTitles:output_type -> mirai.v1.SuggestCourseTitlesResponse
+	67,  // 149: mirai.v1.AIGenerationService.GetJob:output_type -> mirai.v1.GetJobResponse
+	69,  // 150: mirai.v1.AIGenerationService.ListJobs:output_type -> mirai.v1.ListJobsResponse
+	71,  // 151: mirai.v1.AIGenerationService.CancelJob:output_type -> mirai.v1.CancelJobResponse
+	73,  // 152: mirai.v1.AIGenerationService.GetGeneratedLesson:output_type -> mirai.v1.GetGeneratedLessonResponse
+	75,  // 153: mirai.v1.AIGenerationService.ListGeneratedLessons:output_type -> mirai.v1.ListGeneratedLessonsResponse
+	79,  // 154: mirai.v1.AIGenerationService.GetCourseStats:output_type -> mirai.v1.GetCourseStatsResponse
+	81,  // 155: mirai.v1.AIGenerationService.GetCoursePlayerView:output_type -> mirai.v1.GetCoursePlayerViewResponse
+	114, // 156: mirai.v1.AIGenerationService.GetAccessibilityReport:output_type -> mirai.v1.GetAccessibilityReportResponse
+	88,  // 157: mirai.v1.AIGenerationService.GetQueueStatus:output_type -> mirai.v1.GetQueueStatusResponse
+	91,  // 158: mirai.v1.AIGenerationService.ListAnomalies:output_type -> mirai.v1.ListAnomaliesResponse
+	98,  // 159: mirai.v1.AIGenerationService.StartStorageAudit:output_type -> mirai.v1.StartStorageAuditResponse
+	100, // 160: mirai.v1.AIGenerationService.GetStorageAuditReport:output_type -> mirai.v1.GetStorageAuditReportResponse
+	102, // 161: mirai.v1.AIGenerationService.TranslateCourse:output_type -> mirai.v1.TranslateCourseResponse
+	105, // 162: mirai.v1.AIGenerationService.CreateOutlineComment:output_type -> mirai.v1.CreateOutlineCommentResponse
+	107, // 163: mirai.v1.AIGenerationService.ListOutlineComments:output_type -> mirai.v1.ListOutlineCommentsResponse
+	109, // 164: mirai.v1.AIGenerationService.ResolveOutlineComment:output_type -> mirai.v1.ResolveOutlineCommentResponse
+	111, // 165: mirai.v1.AIGenerationService.SetTenantAIEnabled:output_type -> mirai.v1.SetTenantAIEnabledResponse
+	120, // 166: mirai.v1.AIGenerationService.MigrateQuizSchema:output_type -> mirai.v1.MigrateQuizSchemaResponse
+	129, // [129:167] is the sub-list for method output_type
+	91,  // [91:129] is the sub-list for method input_type
+	91,  // [91:91] is the sub-list for extension type_name
+	91,  // [91:91] is the sub-list for extension extendee
+	0,   // [0:91] is the sub-list for field type_name
 }
 
 func init() { file_mirai_v1_ai_generation_proto_init() }
@@ -3076,20 +8547,37 @@ func file_mirai_v1_ai_generation_proto_init() {
 	}
 	file_mirai_v1_ai_generation_proto_msgTypes[0].OneofWrappers = []any{}
 	file_mirai_v1_ai_generation_proto_msgTypes[1].OneofWrappers = []any{}
-	file_mirai_v1_ai_generation_proto_msgTypes[4].OneofWrappers = []any{}
-	file_mirai_v1_ai_generation_proto_msgTypes[5].OneofWrappers = []any{}
-	file_mirai_v1_ai_generation_proto_msgTypes[9].OneofWrappers = []any{}
-	file_mirai_v1_ai_generation_proto_msgTypes[10].OneofWrappers = []any{}
+	file_mirai_v1_ai_generation_proto_msgTypes[3].OneofWrappers = []any{}
+	file_mirai_v1_ai_generation_proto_msgTypes[6].OneofWrappers = []any{}
+	file_mirai_v1_ai_generation_proto_msgTypes[7].OneofWrappers = []any{}
+	file_mirai_v1_ai_generation_proto_msgTypes[11].OneofWrappers = []any{}
 	file_mirai_v1_ai_generation_proto_msgTypes[12].OneofWrappers = []any{}
-	file_mirai_v1_ai_generation_proto_msgTypes[15].OneofWrappers = []any{}
+	file_mirai_v1_ai_generation_proto_msgTypes[14].OneofWrappers = []any{}
+	file_mirai_v1_ai_generation_proto_msgTypes[16].OneofWrappers = []any{}
+	file_mirai_v1_ai_generation_proto_msgTypes[18].OneofWrappers = []any{}
+	file_mirai_v1_ai_generation_proto_msgTypes[19].OneofWrappers = []any{}
+	file_mirai_v1_ai_generation_proto_msgTypes[23].OneofWrappers = []any{}
+	file_mirai_v1_ai_generation_proto_msgTypes[24].OneofWrappers = []any{}
 	file_mirai_v1_ai_generation_proto_msgTypes[31].OneofWrappers = []any{}
+	file_mirai_v1_ai_generation_proto_msgTypes[35].OneofWrappers = []any{}
+	file_mirai_v1_ai_generation_proto_msgTypes[36].OneofWrappers = []any{}
+	file_mirai_v1_ai_generation_proto_msgTypes[39].OneofWrappers = []any{}
+	file_mirai_v1_ai_generation_proto_msgTypes[57].OneofWrappers = []any{}
+	file_mirai_v1_ai_generation_proto_msgTypes[58].OneofWrappers = []any{}
+	file_mirai_v1_ai_generation_proto_msgTypes[73].OneofWrappers = []any{}
+	file_mirai_v1_ai_generation_proto_msgTypes[77].OneofWrappers = []any{}
+	file_mirai_v1_ai_generation_proto_msgTypes[78].OneofWrappers = []any{}
+	file_mirai_v1_ai_generation_proto_msgTypes[79].OneofWrappers = []any{}
+	file_mirai_v1_ai_generation_proto_msgTypes[85].OneofWrappers = []any{}
+	file_mirai_v1_ai_generation_proto_msgTypes[89].OneofWrappers = []any{}
+	file_mirai_v1_ai_generation_proto_msgTypes[92].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_mirai_v1_ai_generation_proto_rawDesc), len(file_mirai_v1_ai_generation_proto_rawDesc)),
-			NumEnums:      5,
-			NumMessages:   39,
+			NumEnums:      11,
+			NumMessages:   110,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -124,8 +124,11 @@ type RegisterRequest struct {
 	Industry    *string `protobuf:"bytes,6,opt,name=industry,proto3,oneof" json:"industry,omitempty"`
 	TeamSize    *string `protobuf:"bytes,7,opt,name=team_size,json=teamSize,proto3,oneof" json:"team_size,omitempty"`
 	// Plan selection
-	Plan          Plan   `protobuf:"varint,8,opt,name=plan,proto3,enum=mirai.v1.Plan" json:"plan,omitempty"`
-	SeatCount     *int32 `protobuf:"varint,9,opt,name=seat_count,json=seatCount,proto3,oneof" json:"seat_count,omitempty"`
+	Plan      Plan   `protobuf:"varint,8,opt,name=plan,proto3,enum=mirai.v1.Plan" json:"plan,omitempty"`
+	SeatCount *int32 `protobuf:"varint,9,opt,name=seat_count,json=seatCount,proto3,oneof" json:"seat_count,omitempty"`
+	// Storage region for the organization's data; fixed once the account is provisioned.
+	// Unset uses the default region.
+	StorageRegion *string `protobuf:"bytes,10,opt,name=storage_region,json=storageRegion,proto3,oneof" json:"storage_region,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -223,6 +226,13 @@ func (x *RegisterRequest) GetSeatCount() int32 {
 	return 0
 }
 
+func (x *RegisterRequest) GetStorageRegion() string {
+	if x != nil && x.StorageRegion != nil {
+		return *x.StorageRegion
+	}
+	return ""
+}
+
 // RegisterResponse contains the result of registration.
 // For paid plans, only checkout_url is returned (account created after payment).
 // For other flows, user and company may be returned immediately.
@@ -707,6 +717,96 @@ func (x *RegisterWithInvitationResponse) GetSessionToken() string {
 	return ""
 }
 
+// ListStorageRegionsRequest is empty.
+type ListStorageRegionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStorageRegionsRequest) Reset() {
+	*x = ListStorageRegionsRequest{}
+	mi := &file_mirai_v1_auth_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStorageRegionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStorageRegionsRequest) ProtoMessage() {}
+
+func (x *ListStorageRegionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_auth_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStorageRegionsRequest.ProtoReflect.Descriptor instead.
+func (*ListStorageRegionsRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_auth_proto_rawDescGZIP(), []int{10}
+}
+
+// ListStorageRegionsResponse lists the storage regions, default first.
+type ListStorageRegionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Regions       []string               `protobuf:"bytes,1,rep,name=regions,proto3" json:"regions,omitempty"`
+	DefaultRegion string                 `protobuf:"bytes,2,opt,name=default_region,json=defaultRegion,proto3" json:"default_region,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStorageRegionsResponse) Reset() {
+	*x = ListStorageRegionsResponse{}
+	mi := &file_mirai_v1_auth_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStorageRegionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStorageRegionsResponse) ProtoMessage() {}
+
+func (x *ListStorageRegionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_auth_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStorageRegionsResponse.ProtoReflect.Descriptor instead.
+func (*ListStorageRegionsResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_auth_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListStorageRegionsResponse) GetRegions() []string {
+	if x != nil {
+		return x.Regions
+	}
+	return nil
+}
+
+func (x *ListStorageRegionsResponse) GetDefaultRegion() string {
+	if x != nil {
+		return x.DefaultRegion
+	}
+	return ""
+}
+
 var File_mirai_v1_auth_proto protoreflect.FileDescriptor
 
 const file_mirai_v1_auth_proto_rawDesc = "" +
@@ -715,7 +815,7 @@ const file_mirai_v1_auth_proto_rawDesc = "" +
 	"\x11CheckEmailRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\",\n" +
 	"\x12CheckEmailResponse\x12\x16\n" +
-	"\x06exists\x18\x01 \x01(\bR\x06exists\"\xd7\x02\n" +
+	"\x06exists\x18\x01 \x01(\bR\x06exists\"\x96\x03\n" +
 	"\x0fRegisterRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x1d\n" +
@@ -727,11 +827,14 @@ const file_mirai_v1_auth_proto_rawDesc = "" +
 	"\tteam_size\x18\a \x01(\tH\x01R\bteamSize\x88\x01\x01\x12\"\n" +
 	"\x04plan\x18\b \x01(\x0e2\x0e.mirai.v1.PlanR\x04plan\x12\"\n" +
 	"\n" +
-	"seat_count\x18\t \x01(\x05H\x02R\tseatCount\x88\x01\x01B\v\n" +
+	"seat_count\x18\t \x01(\x05H\x02R\tseatCount\x88\x01\x01\x12*\n" +
+	"\x0estorage_region\x18\n" +
+	" \x01(\tH\x03R\rstorageRegion\x88\x01\x01B\v\n" +
 	"\t_industryB\f\n" +
 	"\n" +
 	"_team_sizeB\r\n" +
-	"\v_seat_count\"\xe0\x01\n" +
+	"\v_seat_countB\x11\n" +
+	"\x0f_storage_region\"\xe0\x01\n" +
 	"\x10RegisterResponse\x12'\n" +
 	"\x04user\x18\x01 \x01(\v2\x0e.mirai.v1.UserH\x00R\x04user\x88\x01\x01\x120\n" +
 	"\acompany\x18\x02 \x01(\v2\x11.mirai.v1.CompanyH\x01R\acompany\x88\x01\x01\x12&\n" +
@@ -785,14 +888,19 @@ const file_mirai_v1_auth_proto_rawDesc = "" +
 	"\x1eRegisterWithInvitationResponse\x12\"\n" +
 	"\x04user\x18\x01 \x01(\v2\x0e.mirai.v1.UserR\x04user\x12+\n" +
 	"\acompany\x18\x02 \x01(\v2\x11.mirai.v1.CompanyR\acompany\x12#\n" +
-	"\rsession_token\x18\x03 \x01(\tR\fsessionToken2\xa4\x03\n" +
+	"\rsession_token\x18\x03 \x01(\tR\fsessionToken\"\x1b\n" +
+	"\x19ListStorageRegionsRequest\"]\n" +
+	"\x1aListStorageRegionsResponse\x12\x18\n" +
+	"\aregions\x18\x01 \x03(\tR\aregions\x12%\n" +
+	"\x0edefault_region\x18\x02 \x01(\tR\rdefaultRegion2\x85\x04\n" +
 	"\vAuthService\x12G\n" +
 	"\n" +
 	"CheckEmail\x12\x1b.mirai.v1.CheckEmailRequest\x1a\x1c.mirai.v1.CheckEmailResponse\x12A\n" +
 	"\bRegister\x12\x19.mirai.v1.RegisterRequest\x1a\x1a.mirai.v1.RegisterResponse\x12k\n" +
 	"\x16RegisterWithInvitation\x12'.mirai.v1.RegisterWithInvitationRequest\x1a(.mirai.v1.RegisterWithInvitationResponse\x12>\n" +
 	"\aOnboard\x12\x18.mirai.v1.OnboardRequest\x1a\x19.mirai.v1.OnboardResponse\x12\\\n" +
-	"\x11EnterpriseContact\x12\".mirai.v1.EnterpriseContactRequest\x1a#.mirai.v1.EnterpriseContactResponseB\x8f\x01\n" +
+	"\x11EnterpriseContact\x12\".mirai.v1.EnterpriseContactRequest\x1a#.mirai.v1.EnterpriseContactResponse\x12_\n" +
+	"\x12ListStorageRegions\x12#.mirai.v1.ListStorageRegionsRequest\x1a$.mirai.v1.ListStorageRegionsResponseB\x8f\x01\n" +
 	"\fcom.mirai.v1B\tAuthProtoP\x01Z3github.com/sogos/mirai-backend/gen/mirai/v1;miraiv1\xa2\x02\x03MXX\xaa\x02\bMirai.V1\xca\x02\bMirai\\V1\xe2\x02\x14Mirai\\V1\\GPBMetadata\xea\x02\tMirai::V1b\x06proto3"
 
 var (
@@ -807,7 +915,7 @@ func file_mirai_v1_auth_proto_rawDescGZIP() []byte {
 	return file_mirai_v1_auth_proto_rawDescData
 }
 
-var file_mirai_v1_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_mirai_v1_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_mirai_v1_auth_proto_goTypes = []any{
 	(*CheckEmailRequest)(nil),              // 0: mirai.v1.CheckEmailRequest
 	(*CheckEmailResponse)(nil),             // 1: mirai.v1.CheckEmailResponse
@@ -819,31 +927,35 @@ var file_mirai_v1_auth_proto_goTypes = []any{
 	(*EnterpriseContactResponse)(nil),      // 7: mirai.v1.EnterpriseContactResponse
 	(*RegisterWithInvitationRequest)(nil),  // 8: mirai.v1.RegisterWithInvitationRequest
 	(*RegisterWithInvitationResponse)(nil), // 9: mirai.v1.RegisterWithInvitationResponse
-	(Plan)(0),                              // 10: mirai.v1.Plan
-	(*User)(nil),                           // 11: mirai.v1.User
-	(*Company)(nil),                        // 12: mirai.v1.Company
+	(*ListStorageRegionsRequest)(nil),      // 10: mirai.v1.ListStorageRegionsRequest
+	(*ListStorageRegionsResponse)(nil),     // 11: mirai.v1.ListStorageRegionsResponse
+	(Plan)(0),                              // 12: mirai.v1.Plan
+	(*User)(nil),                           // 13: mirai.v1.User
+	(*Company)(nil),                        // 14: mirai.v1.Company
 }
 var file_mirai_v1_auth_proto_depIdxs = []int32{
-	10, // 0: mirai.v1.RegisterRequest.plan:type_name -> mirai.v1.Plan
-	11, // 1: mirai.v1.RegisterResponse.user:type_name -> mirai.v1.User
-	12, // 2: mirai.v1.RegisterResponse.company:type_name -> mirai.v1.Company
-	10, // 3: mirai.v1.OnboardRequest.plan:type_name -> mirai.v1.Plan
-	11, // 4: mirai.v1.OnboardResponse.user:type_name -> mirai.v1.User
-	12, // 5: mirai.v1.OnboardResponse.company:type_name -> mirai.v1.Company
-	11, // 6: mirai.v1.RegisterWithInvitationResponse.user:type_name -> mirai.v1.User
-	12, // 7: mirai.v1.RegisterWithInvitationResponse.company:type_name -> mirai.v1.Company
+	12, // 0: mirai.v1.RegisterRequest.plan:type_name -> mirai.v1.Plan
+	13, // 1: mirai.v1.RegisterResponse.user:type_name -> mirai.v1.User
+	14, // 2: mirai.v1.RegisterResponse.company:type_name -> mirai.v1.Company
+	12, // 3: mirai.v1.OnboardRequest.plan:type_name -> mirai.v1.Plan
+	13, // 4: mirai.v1.OnboardResponse.user:type_name -> mirai.v1.User
+	14, // 5: mirai.v1.OnboardResponse.company:type_name -> mirai.v1.Company
+	13, // 6: mirai.v1.RegisterWithInvitationResponse.user:type_name -> mirai.v1.User
+	14, // 7: mirai.v1.RegisterWithInvitationResponse.company:type_name -> mirai.v1.Company
 	0,  // 8: mirai.v1.AuthService.CheckEmail:input_type -> mirai.v1.CheckEmailRequest
 	2,  // 9: mirai.v1.AuthService.Register:input_type -> mirai.v1.RegisterRequest
 	8,  // 10: mirai.v1.AuthService.RegisterWithInvitation:input_type -> mirai.v1.RegisterWithInvitationRequest
 	4,  // 11: mirai.v1.AuthService.Onboard:input_type -> mirai.v1.OnboardRequest
 	6,  // 12: mirai.v1.AuthService.EnterpriseContact:input_type -> mirai.v1.EnterpriseContactRequest
-	1,  // 13: mirai.v1.AuthService.CheckEmail:output_type -> mirai.v1.CheckEmailResponse
-	3,  // 14: mirai.v1.AuthService.Register:output_type -> mirai.v1.RegisterResponse
-	9,  // 15: mirai.v1.AuthService.RegisterWithInvitation:output_type -> mirai.v1.RegisterWithInvitationResponse
-	5,  // 16: mirai.v1.AuthService.Onboard:output_type -> mirai.v1.OnboardResponse
-	7,  // 17: mirai.v1.AuthService.EnterpriseContact:output_type -> mirai.v1.EnterpriseContactResponse
-	13, // [13:18] is the sub-list for method output_type
-	8,  // [8:13] is the sub-list for method input_type
+	10, // 13: mirai.v1.AuthService.ListStorageRegions:input_type -> mirai.v1.ListStorageRegionsRequest
+	1,  // 14: mirai.v1.AuthService.CheckEmail:output_type -> mirai.v1.CheckEmailResponse
+	3,  // 15: mirai.v1.AuthService.Register:output_type -> mirai.v1.RegisterResponse
+	9,  // 16: mirai.v1.AuthService.RegisterWithInvitation:output_type -> mirai.v1.RegisterWithInvitationResponse
+	5,  // 17: mirai.v1.AuthService.Onboard:output_type -> mirai.v1.OnboardResponse
+	7,  // 18: mirai.v1.AuthService.EnterpriseContact:output_type -> mirai.v1.EnterpriseContactResponse
+	11, // 19: mirai.v1.AuthService.ListStorageRegions:output_type -> mirai.v1.ListStorageRegionsResponse
+	14, // [14:20] is the sub-list for method output_type
+	8,  // [8:14] is the sub-list for method input_type
 	8,  // [8:8] is the sub-list for extension type_name
 	8,  // [8:8] is the sub-list for extension extendee
 	0,  // [0:8] is the sub-list for field type_name
@@ -866,7 +978,7 @@ func file_mirai_v1_auth_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_mirai_v1_auth_proto_rawDesc), len(file_mirai_v1_auth_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -245,19 +245,24 @@ func (SubscriptionStatus) EnumDescriptor() ([]byte, []int) {
 
 // User represents a user in the system.
 type User struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	KratosId      string                 `protobuf:"bytes,2,opt,name=kratos_id,json=kratosId,proto3" json:"kratos_id,omitempty"`
-	CompanyId     *string                `protobuf:"bytes,3,opt,name=company_id,json=companyId,proto3,oneof" json:"company_id,omitempty"`
-	Role          Role                   `protobuf:"varint,4,opt,name=role,proto3,enum=mirai.v1.Role" json:"role,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	TenantId      *string                `protobuf:"bytes,7,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`    // Tenant for RLS isolation
-	Email         *string                `protobuf:"bytes,8,opt,name=email,proto3,oneof" json:"email,omitempty"`                          // From Kratos identity
-	FirstName     *string                `protobuf:"bytes,9,opt,name=first_name,json=firstName,proto3,oneof" json:"first_name,omitempty"` // From Kratos identity
-	LastName      *string                `protobuf:"bytes,10,opt,name=last_name,json=lastName,proto3,oneof" json:"last_name,omitempty"`   // From Kratos identity
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	KratosId            string                 `protobuf:"bytes,2,opt,name=kratos_id,json=kratosId,proto3" json:"kratos_id,omitempty"`
+	CompanyId           *string                `protobuf:"bytes,3,opt,name=company_id,json=companyId,proto3,oneof" json:"company_id,omitempty"`
+	Role                Role                   `protobuf:"varint,4,opt,name=role,proto3,enum=mirai.v1.Role" json:"role,omitempty"`
+	CreatedAt           *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt           *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	TenantId            *string                `protobuf:"bytes,7,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`              // Tenant for RLS isolation
+	Email               *string                `protobuf:"bytes,8,opt,name=email,proto3,oneof" json:"email,omitempty"`                                    // From Kratos identity
+	FirstName           *string                `protobuf:"bytes,9,opt,name=first_name,json=firstName,proto3,oneof" json:"first_name,omitempty"`           // From Kratos identity
+	LastName            *string                `protobuf:"bytes,10,opt,name=last_name,json=lastName,proto3,oneof" json:"last_name,omitempty"`             // From Kratos identity
+	Locale              *string                `protobuf:"bytes,11,opt,name=locale,proto3,oneof" json:"locale,omitempty"`                                 // Email language, synced from Kratos traits
+	IsActive            bool                   `protobuf:"varint,12,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`                  // False once deactivated; the user can no longer sign in
+	BouncedEmail        *string                `protobuf:"bytes,13,opt,name=bounced_email,json=bouncedEmail,proto3,oneof" json:"bounced_email,omitempty"` // Address whose recent emails hard-bounced; email is paused while it's current
+	EmailBouncedAt      *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=email_bounced_at,json=emailBouncedAt,proto3,oneof" json:"email_bounced_at,omitempty"`
+	WeeklySummaryOptOut bool                   `protobuf:"varint,15,opt,name=weekly_summary_opt_out,json=weeklySummaryOptOut,proto3" json:"weekly_summary_opt_out,omitempty"` // Admin chose not to receive the weekly summary email
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *User) Reset() {
@@ -360,6 +365,41 @@ func (x *User) GetLastName() string {
 	return ""
 }
 
+func (x *User) GetLocale() string {
+	if x != nil && x.Locale != nil {
+		return *x.Locale
+	}
+	return ""
+}
+
+func (x *User) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *User) GetBouncedEmail() string {
+	if x != nil && x.BouncedEmail != nil {
+		return *x.BouncedEmail
+	}
+	return ""
+}
+
+func (x *User) GetEmailBouncedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EmailBouncedAt
+	}
+	return nil
+}
+
+func (x *User) GetWeeklySummaryOptOut() bool {
+	if x != nil {
+		return x.WeeklySummaryOptOut
+	}
+	return false
+}
+
 // Company represents a company/organization within a tenant.
 type Company struct {
 	state                protoimpl.MessageState `protogen:"open.v1"`
@@ -683,7 +723,7 @@ var File_mirai_v1_common_proto protoreflect.FileDescriptor
 
 const file_mirai_v1_common_proto_rawDesc = "" +
 	"\n" +
-	"\x15mirai/v1/common.proto\x12\bmirai.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb8\x03\n" +
+	"\x15mirai/v1/common.proto\x12\bmirai.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xce\x05\n" +
 	"\x04User\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\tkratos_id\x18\x02 \x01(\tR\bkratosId\x12\"\n" +
@@ -699,14 +739,22 @@ const file_mirai_v1_common_proto_rawDesc = "" +
 	"\n" +
 	"first_name\x18\t \x01(\tH\x03R\tfirstName\x88\x01\x01\x12 \n" +
 	"\tlast_name\x18\n" +
-	" \x01(\tH\x04R\blastName\x88\x01\x01B\r\n" +
+	" \x01(\tH\x04R\blastName\x88\x01\x01\x12\x1b\n" +
+	"\x06locale\x18\v \x01(\tH\x05R\x06locale\x88\x01\x01\x12\x1b\n" +
+	"\tis_active\x18\f \x01(\bR\bisActive\x12(\n" +
+	"\rbounced_email\x18\r \x01(\tH\x06R\fbouncedEmail\x88\x01\x01\x12I\n" +
+	"\x10email_bounced_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampH\aR\x0eemailBouncedAt\x88\x01\x01\x123\n" +
+	"\x16weekly_summary_opt_out\x18\x0f \x01(\bR\x13weeklySummaryOptOutB\r\n" +
 	"\v_company_idB\f\n" +
 	"\n" +
 	"_tenant_idB\b\n" +
 	"\x06_emailB\r\n" +
 	"\v_first_nameB\f\n" +
 	"\n" +
-	"_last_name\"\xd0\x04\n" +
+	"_last_nameB\t\n" +
+	"\a_localeB\x10\n" +
+	"\x0e_bounced_emailB\x13\n" +
+	"\x11_email_bounced_at\"\xd0\x04\n" +
 	"\aCompany\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1f\n" +
@@ -811,20 +859,21 @@ var file_mirai_v1_common_proto_depIdxs = []int32{
 	1,  // 0: mirai.v1.User.role:type_name -> mirai.v1.Role
 	8,  // 1: mirai.v1.User.created_at:type_name -> google.protobuf.Timestamp
 	8,  // 2: mirai.v1.User.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 3: mirai.v1.Company.plan:type_name -> mirai.v1.Plan
-	3,  // 4: mirai.v1.Company.subscription_status:type_name -> mirai.v1.SubscriptionStatus
-	8,  // 5: mirai.v1.Company.created_at:type_name -> google.protobuf.Timestamp
-	8,  // 6: mirai.v1.Company.updated_at:type_name -> google.protobuf.Timestamp
-	8,  // 7: mirai.v1.Team.created_at:type_name -> google.protobuf.Timestamp
-	8,  // 8: mirai.v1.Team.updated_at:type_name -> google.protobuf.Timestamp
-	2,  // 9: mirai.v1.TeamMember.role:type_name -> mirai.v1.TeamRole
-	8,  // 10: mirai.v1.TeamMember.created_at:type_name -> google.protobuf.Timestamp
-	4,  // 11: mirai.v1.TeamMember.user:type_name -> mirai.v1.User
-	12, // [12:12] is the sub-list for method output_type
-	12, // [12:12] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	8,  // 3: mirai.v1.User.email_bounced_at:type_name -> google.protobuf.Timestamp
+	0,  // 4: mirai.v1.Company.plan:type_name -> mirai.v1.Plan
+	3,  // 5: mirai.v1.Company.subscription_status:type_name -> mirai.v1.SubscriptionStatus
+	8,  // 6: mirai.v1.Company.created_at:type_name -> google.protobuf.Timestamp
+	8,  // 7: mirai.v1.Company.updated_at:type_name -> google.protobuf.Timestamp
+	8,  // 8: mirai.v1.Team.created_at:type_name -> google.protobuf.Timestamp
+	8,  // 9: mirai.v1.Team.updated_at:type_name -> google.protobuf.Timestamp
+	2,  // 10: mirai.v1.TeamMember.role:type_name -> mirai.v1.TeamRole
+	8,  // 11: mirai.v1.TeamMember.created_at:type_name -> google.protobuf.Timestamp
+	4,  // 12: mirai.v1.TeamMember.user:type_name -> mirai.v1.User
+	13, // [13:13] is the sub-list for method output_type
+	13, // [13:13] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_mirai_v1_common_proto_init() }
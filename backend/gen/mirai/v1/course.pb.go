@@ -191,11 +191,12 @@ func (FolderType) EnumDescriptor() ([]byte, []int) {
 type ExportFormat int32
 
 const (
-	ExportFormat_EXPORT_FORMAT_UNSPECIFIED ExportFormat = 0
-	ExportFormat_EXPORT_FORMAT_SCORM_12    ExportFormat = 1
-	ExportFormat_EXPORT_FORMAT_SCORM_2004  ExportFormat = 2
-	ExportFormat_EXPORT_FORMAT_XAPI        ExportFormat = 3
-	ExportFormat_EXPORT_FORMAT_PDF         ExportFormat = 4
+	ExportFormat_EXPORT_FORMAT_UNSPECIFIED  ExportFormat = 0
+	ExportFormat_EXPORT_FORMAT_SCORM_12     ExportFormat = 1
+	ExportFormat_EXPORT_FORMAT_SCORM_2004   ExportFormat = 2
+	ExportFormat_EXPORT_FORMAT_XAPI         ExportFormat = 3
+	ExportFormat_EXPORT_FORMAT_PDF          ExportFormat = 4
+	ExportFormat_EXPORT_FORMAT_MARKDOWN_ZIP ExportFormat = 5 // ZIP of the course's generated lessons as Markdown
 )
 
 // Enum value maps for ExportFormat.
@@ -206,13 +207,15 @@ var (
 		2: "EXPORT_FORMAT_SCORM_2004",
 		3: "EXPORT_FORMAT_XAPI",
 		4: "EXPORT_FORMAT_PDF",
+		5: "EXPORT_FORMAT_MARKDOWN_ZIP",
 	}
 	ExportFormat_value = map[string]int32{
-		"EXPORT_FORMAT_UNSPECIFIED": 0,
-		"EXPORT_FORMAT_SCORM_12":    1,
-		"EXPORT_FORMAT_SCORM_2004":  2,
-		"EXPORT_FORMAT_XAPI":        3,
-		"EXPORT_FORMAT_PDF":         4,
+		"EXPORT_FORMAT_UNSPECIFIED":  0,
+		"EXPORT_FORMAT_SCORM_12":     1,
+		"EXPORT_FORMAT_SCORM_2004":   2,
+		"EXPORT_FORMAT_XAPI":         3,
+		"EXPORT_FORMAT_PDF":          4,
+		"EXPORT_FORMAT_MARKDOWN_ZIP": 5,
 	}
 )
 
@@ -252,6 +255,7 @@ const (
 	ExportStatus_EXPORT_STATUS_PROCESSING  ExportStatus = 2
 	ExportStatus_EXPORT_STATUS_COMPLETED   ExportStatus = 3
 	ExportStatus_EXPORT_STATUS_FAILED      ExportStatus = 4
+	ExportStatus_EXPORT_STATUS_PURGED      ExportStatus = 5 // The file was deleted; see purge_reason
 )
 
 // Enum value maps for ExportStatus.
@@ -262,6 +266,7 @@ var (
 		2: "EXPORT_STATUS_PROCESSING",
 		3: "EXPORT_STATUS_COMPLETED",
 		4: "EXPORT_STATUS_FAILED",
+		5: "EXPORT_STATUS_PURGED",
 	}
 	ExportStatus_value = map[string]int32{
 		"EXPORT_STATUS_UNSPECIFIED": 0,
@@ -269,6 +274,7 @@ var (
 		"EXPORT_STATUS_PROCESSING":  2,
 		"EXPORT_STATUS_COMPLETED":   3,
 		"EXPORT_STATUS_FAILED":      4,
+		"EXPORT_STATUS_PURGED":      5,
 	}
 )
 
@@ -299,6 +305,215 @@ func (ExportStatus) EnumDescriptor() ([]byte, []int) {
 	return file_mirai_v1_course_proto_rawDescGZIP(), []int{4}
 }
 
+// CourseRole represents a collaborator's role on a course.
+type CourseRole int32
+
+const (
+	CourseRole_COURSE_ROLE_UNSPECIFIED CourseRole = 0
+	CourseRole_COURSE_ROLE_OWNER       CourseRole = 1 // Can edit and manage collaborators
+	CourseRole_COURSE_ROLE_EDITOR      CourseRole = 2 // Can edit content and start generation
+	CourseRole_COURSE_ROLE_VIEWER      CourseRole = 3 // Read-only access
+)
+
+// Enum value maps for CourseRole.
+var (
+	CourseRole_name = map[int32]string{
+		0: "COURSE_ROLE_UNSPECIFIED",
+		1: "COURSE_ROLE_OWNER",
+		2: "COURSE_ROLE_EDITOR",
+		3: "COURSE_ROLE_VIEWER",
+	}
+	CourseRole_value = map[string]int32{
+		"COURSE_ROLE_UNSPECIFIED": 0,
+		"COURSE_ROLE_OWNER":       1,
+		"COURSE_ROLE_EDITOR":      2,
+		"COURSE_ROLE_VIEWER":      3,
+	}
+)
+
+func (x CourseRole) Enum() *CourseRole {
+	p := new(CourseRole)
+	*p = x
+	return p
+}
+
+func (x CourseRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CourseRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_mirai_v1_course_proto_enumTypes[5].Descriptor()
+}
+
+func (CourseRole) Type() protoreflect.EnumType {
+	return &file_mirai_v1_course_proto_enumTypes[5]
+}
+
+func (x CourseRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CourseRole.Descriptor instead.
+func (CourseRole) EnumDescriptor() ([]byte, []int) {
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{5}
+}
+
+// CourseSearchSource is the part of a course a search match was found in.
+type CourseSearchSource int32
+
+const (
+	CourseSearchSource_COURSE_SEARCH_SOURCE_UNSPECIFIED      CourseSearchSource = 0
+	CourseSearchSource_COURSE_SEARCH_SOURCE_AUTHORING        CourseSearchSource = 1 // A block of the course's authored content
+	CourseSearchSource_COURSE_SEARCH_SOURCE_LESSON_COMPONENT CourseSearchSource = 2 // A component of a generated lesson
+)
+
+// Enum value maps for CourseSearchSource.
+var (
+	CourseSearchSource_name = map[int32]string{
+		0: "COURSE_SEARCH_SOURCE_UNSPECIFIED",
+		1: "COURSE_SEARCH_SOURCE_AUTHORING",
+		2: "COURSE_SEARCH_SOURCE_LESSON_COMPONENT",
+	}
+	CourseSearchSource_value = map[string]int32{
+		"COURSE_SEARCH_SOURCE_UNSPECIFIED":      0,
+		"COURSE_SEARCH_SOURCE_AUTHORING":        1,
+		"COURSE_SEARCH_SOURCE_LESSON_COMPONENT": 2,
+	}
+)
+
+func (x CourseSearchSource) Enum() *CourseSearchSource {
+	p := new(CourseSearchSource)
+	*p = x
+	return p
+}
+
+func (x CourseSearchSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CourseSearchSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_mirai_v1_course_proto_enumTypes[6].Descriptor()
+}
+
+func (CourseSearchSource) Type() protoreflect.EnumType {
+	return &file_mirai_v1_course_proto_enumTypes[6]
+}
+
+func (x CourseSearchSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CourseSearchSource.Descriptor instead.
+func (CourseSearchSource) EnumDescriptor() ([]byte, []int) {
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{6}
+}
+
+// CourseAttachmentStatus is how far a reference file's text extraction has got.
+type CourseAttachmentStatus int32
+
+const (
+	CourseAttachmentStatus_COURSE_ATTACHMENT_STATUS_UNSPECIFIED CourseAttachmentStatus = 0
+	CourseAttachmentStatus_COURSE_ATTACHMENT_STATUS_PENDING     CourseAttachmentStatus = 1 // Uploaded, waiting for extraction
+	CourseAttachmentStatus_COURSE_ATTACHMENT_STATUS_PROCESSING  CourseAttachmentStatus = 2 // Text being extracted
+	CourseAttachmentStatus_COURSE_ATTACHMENT_STATUS_READY       CourseAttachmentStatus = 3 // Text available to generation
+	CourseAttachmentStatus_COURSE_ATTACHMENT_STATUS_FAILED      CourseAttachmentStatus = 4 // No usable text; see error_message
+)
+
+// Enum value maps for CourseAttachmentStatus.
+var (
+	CourseAttachmentStatus_name = map[int32]string{
+		0: "COURSE_ATTACHMENT_STATUS_UNSPECIFIED",
+		1: "COURSE_ATTACHMENT_STATUS_PENDING",
+		2: "COURSE_ATTACHMENT_STATUS_PROCESSING",
+		3: "COURSE_ATTACHMENT_STATUS_READY",
+		4: "COURSE_ATTACHMENT_STATUS_FAILED",
+	}
+	CourseAttachmentStatus_value = map[string]int32{
+		"COURSE_ATTACHMENT_STATUS_UNSPECIFIED": 0,
+		"COURSE_ATTACHMENT_STATUS_PENDING":     1,
+		"COURSE_ATTACHMENT_STATUS_PROCESSING":  2,
+		"COURSE_ATTACHMENT_STATUS_READY":       3,
+		"COURSE_ATTACHMENT_STATUS_FAILED":      4,
+	}
+)
+
+func (x CourseAttachmentStatus) Enum() *CourseAttachmentStatus {
+	p := new(CourseAttachmentStatus)
+	*p = x
+	return p
+}
+
+func (x CourseAttachmentStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CourseAttachmentStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_mirai_v1_course_proto_enumTypes[7].Descriptor()
+}
+
+func (CourseAttachmentStatus) Type() protoreflect.EnumType {
+	return &file_mirai_v1_course_proto_enumTypes[7]
+}
+
+func (x CourseAttachmentStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CourseAttachmentStatus.Descriptor instead.
+func (CourseAttachmentStatus) EnumDescriptor() ([]byte, []int) {
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{7}
+}
+
+// ExportPurgeReason records why an export's file was deleted.
+type ExportPurgeReason int32
+
+const (
+	ExportPurgeReason_EXPORT_PURGE_REASON_UNSPECIFIED ExportPurgeReason = 0
+	ExportPurgeReason_EXPORT_PURGE_REASON_EXPIRED     ExportPurgeReason = 1 // The organization's retention period ran out
+	ExportPurgeReason_EXPORT_PURGE_REASON_SUPERSEDED  ExportPurgeReason = 2 // A newer export of the same course replaced it
+)
+
+// Enum value maps for ExportPurgeReason.
+var (
+	ExportPurgeReason_name = map[int32]string{
+		0: "EXPORT_PURGE_REASON_UNSPECIFIED",
+		1: "EXPORT_PURGE_REASON_EXPIRED",
+		2: "EXPORT_PURGE_REASON_SUPERSEDED",
+	}
+	ExportPurgeReason_value = map[string]int32{
+		"EXPORT_PURGE_REASON_UNSPECIFIED": 0,
+		"EXPORT_PURGE_REASON_EXPIRED":     1,
+		"EXPORT_PURGE_REASON_SUPERSEDED":  2,
+	}
+)
+
+func (x ExportPurgeReason) Enum() *ExportPurgeReason {
+	p := new(ExportPurgeReason)
+	*p = x
+	return p
+}
+
+func (x ExportPurgeReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportPurgeReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_mirai_v1_course_proto_enumTypes[8].Descriptor()
+}
+
+func (ExportPurgeReason) Type() protoreflect.EnumType {
+	return &file_mirai_v1_course_proto_enumTypes[8]
+}
+
+func (x ExportPurgeReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportPurgeReason.Descriptor instead.
+func (ExportPurgeReason) EnumDescriptor() ([]byte, []int) {
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{8}
+}
+
 // LearningObjective represents a specific learning goal for the course.
 type LearningObjective struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -853,6 +1068,10 @@ type CourseExport struct {
 	FilePath      string                 `protobuf:"bytes,5,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"`
 	Status        ExportStatus           `protobuf:"varint,6,opt,name=status,proto3,enum=mirai.v1.ExportStatus" json:"status,omitempty"`
 	ErrorMessage  *string                `protobuf:"bytes,7,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // When the file is deleted, or was due to be
+	SizeBytes     int64                  `protobuf:"varint,9,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	PurgedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=purged_at,json=purgedAt,proto3,oneof" json:"purged_at,omitempty"` // Set once the file is deleted
+	PurgeReason   *ExportPurgeReason     `protobuf:"varint,11,opt,name=purge_reason,json=purgeReason,proto3,enum=mirai.v1.ExportPurgeReason,oneof" json:"purge_reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -936,6 +1155,34 @@ func (x *CourseExport) GetErrorMessage() string {
 	return ""
 }
 
+func (x *CourseExport) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *CourseExport) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *CourseExport) GetPurgedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PurgedAt
+	}
+	return nil
+}
+
+func (x *CourseExport) GetPurgeReason() ExportPurgeReason {
+	if x != nil && x.PurgeReason != nil {
+		return *x.PurgeReason
+	}
+	return ExportPurgeReason_EXPORT_PURGE_REASON_UNSPECIFIED
+}
+
 // CourseSettings contains the configurable settings for a course.
 type CourseSettings struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
@@ -1112,12 +1359,14 @@ type Course struct {
 	Content            *CourseContent         `protobuf:"bytes,9,opt,name=content,proto3" json:"content,omitempty"`
 	Exports            []*CourseExport        `protobuf:"bytes,10,rep,name=exports,proto3" json:"exports,omitempty"`
 	// Ownership fields for multi-tenancy
-	CompanyId       *string `protobuf:"bytes,11,opt,name=company_id,json=companyId,proto3,oneof" json:"company_id,omitempty"`
-	TenantId        *string `protobuf:"bytes,12,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
-	CreatedByUserId *string `protobuf:"bytes,13,opt,name=created_by_user_id,json=createdByUserId,proto3,oneof" json:"created_by_user_id,omitempty"`
-	TeamId          *string `protobuf:"bytes,14,opt,name=team_id,json=teamId,proto3,oneof" json:"team_id,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	CompanyId             *string `protobuf:"bytes,11,opt,name=company_id,json=companyId,proto3,oneof" json:"company_id,omitempty"`
+	TenantId              *string `protobuf:"bytes,12,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	CreatedByUserId       *string `protobuf:"bytes,13,opt,name=created_by_user_id,json=createdByUserId,proto3,oneof" json:"created_by_user_id,omitempty"`
+	TeamId                *string `protobuf:"bytes,14,opt,name=team_id,json=teamId,proto3,oneof" json:"team_id,omitempty"`
+	PublishedVersion      int32   `protobuf:"varint,15,opt,name=published_version,json=publishedVersion,proto3" json:"published_version,omitempty"`                  // Draft version learners see; 0 if none is stored yet
+	HasUnpublishedChanges bool    `protobuf:"varint,16,opt,name=has_unpublished_changes,json=hasUnpublishedChanges,proto3" json:"has_unpublished_changes,omitempty"` // Edited since the version learners see
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
 func (x *Course) Reset() {
@@ -1248,6 +1497,20 @@ func (x *Course) GetTeamId() string {
 	return ""
 }
 
+func (x *Course) GetPublishedVersion() int32 {
+	if x != nil {
+		return x.PublishedVersion
+	}
+	return 0
+}
+
+func (x *Course) GetHasUnpublishedChanges() bool {
+	if x != nil {
+		return x.HasUnpublishedChanges
+	}
+	return false
+}
+
 // LibraryEntry represents a course listing in the content library.
 type LibraryEntry struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1261,11 +1524,14 @@ type LibraryEntry struct {
 	CreatedBy     *string                `protobuf:"bytes,8,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
 	ThumbnailPath *string                `protobuf:"bytes,9,opt,name=thumbnail_path,json=thumbnailPath,proto3,oneof" json:"thumbnail_path,omitempty"`
 	// Ownership fields for multi-tenancy
-	CompanyId     *string `protobuf:"bytes,10,opt,name=company_id,json=companyId,proto3,oneof" json:"company_id,omitempty"`
-	TenantId      *string `protobuf:"bytes,11,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
-	TeamId        *string `protobuf:"bytes,12,opt,name=team_id,json=teamId,proto3,oneof" json:"team_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	CompanyId             *string     `protobuf:"bytes,10,opt,name=company_id,json=companyId,proto3,oneof" json:"company_id,omitempty"`
+	TenantId              *string     `protobuf:"bytes,11,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	TeamId                *string     `protobuf:"bytes,12,opt,name=team_id,json=teamId,proto3,oneof" json:"team_id,omitempty"`
+	CallerRole            *CourseRole `protobuf:"varint,13,opt,name=caller_role,json=callerRole,proto3,enum=mirai.v1.CourseRole,oneof" json:"caller_role,omitempty"`     // Caller's role on this course, if assigned
+	CreatedByActive       bool        `protobuf:"varint,14,opt,name=created_by_active,json=createdByActive,proto3" json:"created_by_active,omitempty"`                   // False when the creator has been deactivated
+	HasUnpublishedChanges bool        `protobuf:"varint,15,opt,name=has_unpublished_changes,json=hasUnpublishedChanges,proto3" json:"has_unpublished_changes,omitempty"` // Edited since the version learners see
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
 func (x *LibraryEntry) Reset() {
@@ -1382,6 +1648,112 @@ func (x *LibraryEntry) GetTeamId() string {
 	return ""
 }
 
+func (x *LibraryEntry) GetCallerRole() CourseRole {
+	if x != nil && x.CallerRole != nil {
+		return *x.CallerRole
+	}
+	return CourseRole_COURSE_ROLE_UNSPECIFIED
+}
+
+func (x *LibraryEntry) GetCreatedByActive() bool {
+	if x != nil {
+		return x.CreatedByActive
+	}
+	return false
+}
+
+func (x *LibraryEntry) GetHasUnpublishedChanges() bool {
+	if x != nil {
+		return x.HasUnpublishedChanges
+	}
+	return false
+}
+
+// CourseCollaborator represents a user's assignment to a course.
+type CourseCollaborator struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CourseId      string                 `protobuf:"bytes,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          CourseRole             `protobuf:"varint,4,opt,name=role,proto3,enum=mirai.v1.CourseRole" json:"role,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	AddedByUserId *string                `protobuf:"bytes,6,opt,name=added_by_user_id,json=addedByUserId,proto3,oneof" json:"added_by_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CourseCollaborator) Reset() {
+	*x = CourseCollaborator{}
+	mi := &file_mirai_v1_course_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CourseCollaborator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourseCollaborator) ProtoMessage() {}
+
+func (x *CourseCollaborator) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_course_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourseCollaborator.ProtoReflect.Descriptor instead.
+func (*CourseCollaborator) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CourseCollaborator) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CourseCollaborator) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *CourseCollaborator) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CourseCollaborator) GetRole() CourseRole {
+	if x != nil {
+		return x.Role
+	}
+	return CourseRole_COURSE_ROLE_UNSPECIFIED
+}
+
+func (x *CourseCollaborator) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CourseCollaborator) GetAddedByUserId() string {
+	if x != nil && x.AddedByUserId != nil {
+		return *x.AddedByUserId
+	}
+	return ""
+}
+
 // Folder represents a folder in the library hierarchy.
 type Folder struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1391,13 +1763,15 @@ type Folder struct {
 	Type          FolderType             `protobuf:"varint,4,opt,name=type,proto3,enum=mirai.v1.FolderType" json:"type,omitempty"`
 	Children      []*Folder              `protobuf:"bytes,5,rep,name=children,proto3" json:"children,omitempty"`
 	CourseCount   *int32                 `protobuf:"varint,6,opt,name=course_count,json=courseCount,proto3,oneof" json:"course_count,omitempty"`
+	IsProtected   bool                   `protobuf:"varint,7,opt,name=is_protected,json=isProtected,proto3" json:"is_protected,omitempty"` // System folder (library or personal): cannot be renamed or deleted
+	TeamId        *string                `protobuf:"bytes,8,opt,name=team_id,json=teamId,proto3,oneof" json:"team_id,omitempty"`           // Set for FOLDER_TYPE_TEAM
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Folder) Reset() {
 	*x = Folder{}
-	mi := &file_mirai_v1_course_proto_msgTypes[13]
+	mi := &file_mirai_v1_course_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1409,7 +1783,7 @@ func (x *Folder) String() string {
 func (*Folder) ProtoMessage() {}
 
 func (x *Folder) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_course_proto_msgTypes[13]
+	mi := &file_mirai_v1_course_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1422,7 +1796,7 @@ func (x *Folder) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Folder.ProtoReflect.Descriptor instead.
 func (*Folder) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_course_proto_rawDescGZIP(), []int{13}
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *Folder) GetId() string {
@@ -1467,6 +1841,20 @@ func (x *Folder) GetCourseCount() int32 {
 	return 0
 }
 
+func (x *Folder) GetIsProtected() bool {
+	if x != nil {
+		return x.IsProtected
+	}
+	return false
+}
+
+func (x *Folder) GetTeamId() string {
+	if x != nil && x.TeamId != nil {
+		return *x.TeamId
+	}
+	return ""
+}
+
 // Library represents the full library structure.
 type Library struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1480,7 +1868,7 @@ type Library struct {
 
 func (x *Library) Reset() {
 	*x = Library{}
-	mi := &file_mirai_v1_course_proto_msgTypes[14]
+	mi := &file_mirai_v1_course_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1492,7 +1880,7 @@ func (x *Library) String() string {
 func (*Library) ProtoMessage() {}
 
 func (x *Library) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_course_proto_msgTypes[14]
+	mi := &file_mirai_v1_course_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1505,7 +1893,7 @@ func (x *Library) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Library.ProtoReflect.Descriptor instead.
 func (*Library) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_course_proto_rawDescGZIP(), []int{14}
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *Library) GetVersion() string {
@@ -1542,15 +1930,16 @@ type ListCoursesRequest struct {
 	Status        *CourseStatus          `protobuf:"varint,1,opt,name=status,proto3,enum=mirai.v1.CourseStatus,oneof" json:"status,omitempty"`
 	Folder        *string                `protobuf:"bytes,2,opt,name=folder,proto3,oneof" json:"folder,omitempty"`
 	Tags          []string               `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
-	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`   // Max results per page (default 20, max 100)
-	Offset        int32                  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"` // Number of results to skip for pagination
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`     // Max results per page (default 20, max 100)
+	Offset        int32                  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`   // Number of results to skip for pagination
+	Mine          *bool                  `protobuf:"varint,6,opt,name=mine,proto3,oneof" json:"mine,omitempty"` // Only courses the caller created or collaborates on
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListCoursesRequest) Reset() {
 	*x = ListCoursesRequest{}
-	mi := &file_mirai_v1_course_proto_msgTypes[15]
+	mi := &file_mirai_v1_course_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1562,7 +1951,7 @@ func (x *ListCoursesRequest) String() string {
 func (*ListCoursesRequest) ProtoMessage() {}
 
 func (x *ListCoursesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_course_proto_msgTypes[15]
+	mi := &file_mirai_v1_course_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1575,7 +1964,7 @@ func (x *ListCoursesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCoursesRequest.ProtoReflect.Descriptor instead.
 func (*ListCoursesRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_course_proto_rawDescGZIP(), []int{15}
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ListCoursesRequest) GetStatus() CourseStatus {
@@ -1613,6 +2002,13 @@ func (x *ListCoursesRequest) GetOffset() int32 {
 	return 0
 }
 
+func (x *ListCoursesRequest) GetMine() bool {
+	if x != nil && x.Mine != nil {
+		return *x.Mine
+	}
+	return false
+}
+
 // ListCoursesResponse contains the list of matching courses.
 type ListCoursesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1625,7 +2021,7 @@ type ListCoursesResponse struct {
 
 func (x *ListCoursesResponse) Reset() {
 	*x = ListCoursesResponse{}
-	mi := &file_mirai_v1_course_proto_msgTypes[16]
+	mi := &file_mirai_v1_course_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1637,7 +2033,7 @@ func (x *ListCoursesResponse) String() string {
 func (*ListCoursesResponse) ProtoMessage() {}
 
 func (x *ListCoursesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_course_proto_msgTypes[16]
+	mi := &file_mirai_v1_course_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1650,7 +2046,7 @@ func (x *ListCoursesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCoursesResponse.ProtoReflect.Descriptor instead.
 func (*ListCoursesResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_course_proto_rawDescGZIP(), []int{16}
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ListCoursesResponse) GetCourses() []*LibraryEntry {
@@ -1684,7 +2080,7 @@ type GetCourseRequest struct {
 
 func (x *GetCourseRequest) Reset() {
 	*x = GetCourseRequest{}
-	mi := &file_mirai_v1_course_proto_msgTypes[17]
+	mi := &file_mirai_v1_course_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1696,7 +2092,7 @@ func (x *GetCourseRequest) String() string {
 func (*GetCourseRequest) ProtoMessage() {}
 
 func (x *GetCourseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_course_proto_msgTypes[17]
+	mi := &file_mirai_v1_course_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1709,7 +2105,7 @@ func (x *GetCourseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCourseRequest.ProtoReflect.Descriptor instead.
 func (*GetCourseRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_course_proto_rawDescGZIP(), []int{17}
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *GetCourseRequest) GetId() string {
@@ -1721,15 +2117,19 @@ func (x *GetCourseRequest) GetId() string {
 
 // GetCourseResponse contains the requested course.
 type GetCourseResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Course        *Course                `protobuf:"bytes,1,opt,name=course,proto3" json:"course,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Course                *Course                `protobuf:"bytes,1,opt,name=course,proto3" json:"course,omitempty"`
+	ActiveGenerationJobId *string                `protobuf:"bytes,2,opt,name=active_generation_job_id,json=activeGenerationJobId,proto3,oneof" json:"active_generation_job_id,omitempty"` // The course's queued or processing full course generation job
+	// Set while a full course run holds the course read-only. UpdateCourse and component
+	// edits fail with FAILED_PRECONDITION until it is released.
+	GenerationLock *CourseGenerationLock `protobuf:"bytes,3,opt,name=generation_lock,json=generationLock,proto3,oneof" json:"generation_lock,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *GetCourseResponse) Reset() {
 	*x = GetCourseResponse{}
-	mi := &file_mirai_v1_course_proto_msgTypes[18]
+	mi := &file_mirai_v1_course_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1741,7 +2141,7 @@ func (x *GetCourseResponse) String() string {
 func (*GetCourseResponse) ProtoMessage() {}
 
 func (x *GetCourseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_course_proto_msgTypes[18]
+	mi := &file_mirai_v1_course_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1754,7 +2154,7 @@ func (x *GetCourseResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCourseResponse.ProtoReflect.Descriptor instead.
 func (*GetCourseResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_course_proto_rawDescGZIP(), []int{18}
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *GetCourseResponse) GetCourse() *Course {
@@ -1764,6 +2164,20 @@ func (x *GetCourseResponse) GetCourse() *Course {
 	return nil
 }
 
+func (x *GetCourseResponse) GetActiveGenerationJobId() string {
+	if x != nil && x.ActiveGenerationJobId != nil {
+		return *x.ActiveGenerationJobId
+	}
+	return ""
+}
+
+func (x *GetCourseResponse) GetGenerationLock() *CourseGenerationLock {
+	if x != nil {
+		return x.GenerationLock
+	}
+	return nil
+}
+
 // CreateCourseRequest contains the data for creating a new course.
 type CreateCourseRequest struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
@@ -1779,7 +2193,7 @@ type CreateCourseRequest struct {
 
 func (x *CreateCourseRequest) Reset() {
 	*x = CreateCourseRequest{}
-	mi := &file_mirai_v1_course_proto_msgTypes[19]
+	mi := &file_mirai_v1_course_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1791,7 +2205,7 @@ func (x *CreateCourseRequest) String() string {
 func (*CreateCourseRequest) ProtoMessage() {}
 
 func (x *CreateCourseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_course_proto_msgTypes[19]
+	mi := &file_mirai_v1_course_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1804,7 +2218,7 @@ func (x *CreateCourseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCourseRequest.ProtoReflect.Descriptor instead.
 func (*CreateCourseRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_course_proto_rawDescGZIP(), []int{19}
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *CreateCourseRequest) GetId() string {
@@ -1851,15 +2265,18 @@ func (x *CreateCourseRequest) GetContent() *CourseContent {
 
 // CreateCourseResponse contains the newly created course.
 type CreateCourseResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Course        *Course                `protobuf:"bytes,1,opt,name=course,proto3" json:"course,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Course *Course                `protobuf:"bytes,1,opt,name=course,proto3" json:"course,omitempty"`
+	// Settings filled from the organization's course defaults: "destination_folder",
+	// "category_tags", "data_source" or "assessment_settings"
+	DefaultedFields []string `protobuf:"bytes,2,rep,name=defaulted_fields,json=defaultedFields,proto3" json:"defaulted_fields,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *CreateCourseResponse) Reset() {
 	*x = CreateCourseResponse{}
-	mi := &file_mirai_v1_course_proto_msgTypes[20]
+	mi := &file_mirai_v1_course_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1871,7 +2288,7 @@ func (x *CreateCourseResponse) String() string {
 func (*CreateCourseResponse) ProtoMessage() {}
 
 func (x *CreateCourseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_course_proto_msgTypes[20]
+	mi := &file_mirai_v1_course_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1884,7 +2301,7 @@ func (x *CreateCourseResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCourseResponse.ProtoReflect.Descriptor instead.
 func (*CreateCourseResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_course_proto_rawDescGZIP(), []int{20}
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *CreateCourseResponse) GetCourse() *Course {
@@ -1894,6 +2311,13 @@ func (x *CreateCourseResponse) GetCourse() *Course {
 	return nil
 }
 
+func (x *CreateCourseResponse) GetDefaultedFields() []string {
+	if x != nil {
+		return x.DefaultedFields
+	}
+	return nil
+}
+
 // UpdateCourseRequest contains the course ID and fields to update.
 type UpdateCourseRequest struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
@@ -1911,7 +2335,7 @@ type UpdateCourseRequest struct {
 
 func (x *UpdateCourseRequest) Reset() {
 	*x = UpdateCourseRequest{}
-	mi := &file_mirai_v1_course_proto_msgTypes[21]
+	mi := &file_mirai_v1_course_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1923,7 +2347,7 @@ func (x *UpdateCourseRequest) String() string {
 func (*UpdateCourseRequest) ProtoMessage() {}
 
 func (x *UpdateCourseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_course_proto_msgTypes[21]
+	mi := &file_mirai_v1_course_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1936,7 +2360,7 @@ func (x *UpdateCourseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateCourseRequest.ProtoReflect.Descriptor instead.
 func (*UpdateCourseRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_course_proto_rawDescGZIP(), []int{21}
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *UpdateCourseRequest) GetId() string {
@@ -1997,15 +2421,17 @@ func (x *UpdateCourseRequest) GetMetadata() *CourseMetadata {
 
 // UpdateCourseResponse contains the updated course.
 type UpdateCourseResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Course        *Course                `protobuf:"bytes,1,opt,name=course,proto3" json:"course,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Course           *Course                `protobuf:"bytes,1,opt,name=course,proto3" json:"course,omitempty"`
+	ContentSizeBytes int64                  `protobuf:"varint,2,opt,name=content_size_bytes,json=contentSizeBytes,proto3" json:"content_size_bytes,omitempty"` // Size of the stored course content
+	SizeWarning      *string                `protobuf:"bytes,3,opt,name=size_warning,json=sizeWarning,proto3,oneof" json:"size_warning,omitempty"`             // Set when the content is over the recommended size
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *UpdateCourseResponse) Reset() {
 	*x = UpdateCourseResponse{}
-	mi := &file_mirai_v1_course_proto_msgTypes[22]
+	mi := &file_mirai_v1_course_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2017,7 +2443,7 @@ func (x *UpdateCourseResponse) String() string {
 func (*UpdateCourseResponse) ProtoMessage() {}
 
 func (x *UpdateCourseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_course_proto_msgTypes[22]
+	mi := &file_mirai_v1_course_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2030,7 +2456,7 @@ func (x *UpdateCourseResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateCourseResponse.ProtoReflect.Descriptor instead.
 func (*UpdateCourseResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_course_proto_rawDescGZIP(), []int{22}
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *UpdateCourseResponse) GetCourse() *Course {
@@ -2040,6 +2466,20 @@ func (x *UpdateCourseResponse) GetCourse() *Course {
 	return nil
 }
 
+func (x *UpdateCourseResponse) GetContentSizeBytes() int64 {
+	if x != nil {
+		return x.ContentSizeBytes
+	}
+	return 0
+}
+
+func (x *UpdateCourseResponse) GetSizeWarning() string {
+	if x != nil && x.SizeWarning != nil {
+		return *x.SizeWarning
+	}
+	return ""
+}
+
 // DeleteCourseRequest contains the course ID to delete.
 type DeleteCourseRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -2050,7 +2490,7 @@ type DeleteCourseRequest struct {
 
 func (x *DeleteCourseRequest) Reset() {
 	*x = DeleteCourseRequest{}
-	mi := &file_mirai_v1_course_proto_msgTypes[23]
+	mi := &file_mirai_v1_course_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2062,7 +2502,7 @@ func (x *DeleteCourseRequest) String() string {
 func (*DeleteCourseRequest) ProtoMessage() {}
 
 func (x *DeleteCourseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_course_proto_msgTypes[23]
+	mi := &file_mirai_v1_course_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2075,7 +2515,7 @@ func (x *DeleteCourseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCourseRequest.ProtoReflect.Descriptor instead.
 func (*DeleteCourseRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_course_proto_rawDescGZIP(), []int{23}
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *DeleteCourseRequest) GetId() string {
@@ -2095,7 +2535,7 @@ type DeleteCourseResponse struct {
 
 func (x *DeleteCourseResponse) Reset() {
 	*x = DeleteCourseResponse{}
-	mi := &file_mirai_v1_course_proto_msgTypes[24]
+	mi := &file_mirai_v1_course_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2107,7 +2547,7 @@ func (x *DeleteCourseResponse) String() string {
 func (*DeleteCourseResponse) ProtoMessage() {}
 
 func (x *DeleteCourseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_course_proto_msgTypes[24]
+	mi := &file_mirai_v1_course_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2120,7 +2560,7 @@ func (x *DeleteCourseResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCourseResponse.ProtoReflect.Descriptor instead.
 func (*DeleteCourseResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_course_proto_rawDescGZIP(), []int{24}
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *DeleteCourseResponse) GetSuccess() bool {
@@ -2140,7 +2580,7 @@ type GetFolderHierarchyRequest struct {
 
 func (x *GetFolderHierarchyRequest) Reset() {
 	*x = GetFolderHierarchyRequest{}
-	mi := &file_mirai_v1_course_proto_msgTypes[25]
+	mi := &file_mirai_v1_course_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2152,7 +2592,7 @@ func (x *GetFolderHierarchyRequest) String() string {
 func (*GetFolderHierarchyRequest) ProtoMessage() {}
 
 func (x *GetFolderHierarchyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_course_proto_msgTypes[25]
+	mi := &file_mirai_v1_course_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2165,7 +2605,7 @@ func (x *GetFolderHierarchyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFolderHierarchyRequest.ProtoReflect.Descriptor instead.
 func (*GetFolderHierarchyRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_course_proto_rawDescGZIP(), []int{25}
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *GetFolderHierarchyRequest) GetIncludeCourseCounts() bool {
@@ -2185,7 +2625,7 @@ type GetFolderHierarchyResponse struct {
 
 func (x *GetFolderHierarchyResponse) Reset() {
 	*x = GetFolderHierarchyResponse{}
-	mi := &file_mirai_v1_course_proto_msgTypes[26]
+	mi := &file_mirai_v1_course_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2197,7 +2637,7 @@ func (x *GetFolderHierarchyResponse) String() string {
 func (*GetFolderHierarchyResponse) ProtoMessage() {}
 
 func (x *GetFolderHierarchyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_course_proto_msgTypes[26]
+	mi := &file_mirai_v1_course_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2210,7 +2650,7 @@ func (x *GetFolderHierarchyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFolderHierarchyResponse.ProtoReflect.Descriptor instead.
 func (*GetFolderHierarchyResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_course_proto_rawDescGZIP(), []int{26}
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *GetFolderHierarchyResponse) GetFolders() []*Folder {
@@ -2230,7 +2670,7 @@ type GetLibraryRequest struct {
 
 func (x *GetLibraryRequest) Reset() {
 	*x = GetLibraryRequest{}
-	mi := &file_mirai_v1_course_proto_msgTypes[27]
+	mi := &file_mirai_v1_course_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2242,7 +2682,7 @@ func (x *GetLibraryRequest) String() string {
 func (*GetLibraryRequest) ProtoMessage() {}
 
 func (x *GetLibraryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_course_proto_msgTypes[27]
+	mi := &file_mirai_v1_course_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2255,7 +2695,7 @@ func (x *GetLibraryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLibraryRequest.ProtoReflect.Descriptor instead.
 func (*GetLibraryRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_course_proto_rawDescGZIP(), []int{27}
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *GetLibraryRequest) GetIncludeCourseCounts() bool {
@@ -2275,7 +2715,7 @@ type GetLibraryResponse struct {
 
 func (x *GetLibraryResponse) Reset() {
 	*x = GetLibraryResponse{}
-	mi := &file_mirai_v1_course_proto_msgTypes[28]
+	mi := &file_mirai_v1_course_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2287,7 +2727,7 @@ func (x *GetLibraryResponse) String() string {
 func (*GetLibraryResponse) ProtoMessage() {}
 
 func (x *GetLibraryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_course_proto_msgTypes[28]
+	mi := &file_mirai_v1_course_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2300,7 +2740,7 @@ func (x *GetLibraryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLibraryResponse.ProtoReflect.Descriptor instead.
 func (*GetLibraryResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_course_proto_rawDescGZIP(), []int{28}
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *GetLibraryResponse) GetLibrary() *Library {
@@ -2315,14 +2755,15 @@ type CreateFolderRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	ParentId      *string                `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"` // null for root-level folders
-	Type          FolderType             `protobuf:"varint,3,opt,name=type,proto3,enum=mirai.v1.FolderType" json:"type,omitempty"`     // typically FOLDER_TYPE_FOLDER for user-created folders
+	Type          FolderType             `protobuf:"varint,3,opt,name=type,proto3,enum=mirai.v1.FolderType" json:"type,omitempty"`     // FOLDER_TYPE_FOLDER or FOLDER_TYPE_TEAM; system folders are created automatically
+	TeamId        *string                `protobuf:"bytes,4,opt,name=team_id,json=teamId,proto3,oneof" json:"team_id,omitempty"`       // Required for FOLDER_TYPE_TEAM
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateFolderRequest) Reset() {
 	*x = CreateFolderRequest{}
-	mi := &file_mirai_v1_course_proto_msgTypes[29]
+	mi := &file_mirai_v1_course_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2334,7 +2775,7 @@ func (x *CreateFolderRequest) String() string {
 func (*CreateFolderRequest) ProtoMessage() {}
 
 func (x *CreateFolderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_course_proto_msgTypes[29]
+	mi := &file_mirai_v1_course_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2347,7 +2788,7 @@ func (x *CreateFolderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateFolderRequest.ProtoReflect.Descriptor instead.
 func (*CreateFolderRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_course_proto_rawDescGZIP(), []int{29}
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *CreateFolderRequest) GetName() string {
@@ -2371,6 +2812,13 @@ func (x *CreateFolderRequest) GetType() FolderType {
 	return FolderType_FOLDER_TYPE_UNSPECIFIED
 }
 
+func (x *CreateFolderRequest) GetTeamId() string {
+	if x != nil && x.TeamId != nil {
+		return *x.TeamId
+	}
+	return ""
+}
+
 // CreateFolderResponse contains the newly created folder.
 type CreateFolderResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -2381,7 +2829,7 @@ type CreateFolderResponse struct {
 
 func (x *CreateFolderResponse) Reset() {
 	*x = CreateFolderResponse{}
-	mi := &file_mirai_v1_course_proto_msgTypes[30]
+	mi := &file_mirai_v1_course_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2393,7 +2841,7 @@ func (x *CreateFolderResponse) String() string {
 func (*CreateFolderResponse) ProtoMessage() {}
 
 func (x *CreateFolderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_course_proto_msgTypes[30]
+	mi := &file_mirai_v1_course_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2406,7 +2854,7 @@ func (x *CreateFolderResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateFolderResponse.ProtoReflect.Descriptor instead.
 func (*CreateFolderResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_course_proto_rawDescGZIP(), []int{30}
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *CreateFolderResponse) GetFolder() *Folder {
@@ -2416,6 +2864,120 @@ func (x *CreateFolderResponse) GetFolder() *Folder {
 	return nil
 }
 
+// UpdateFolderRequest contains the folder changes. Unset fields are left unchanged.
+type UpdateFolderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Type          *FolderType            `protobuf:"varint,3,opt,name=type,proto3,enum=mirai.v1.FolderType,oneof" json:"type,omitempty"` // FOLDER_TYPE_FOLDER or FOLDER_TYPE_TEAM
+	TeamId        *string                `protobuf:"bytes,4,opt,name=team_id,json=teamId,proto3,oneof" json:"team_id,omitempty"`         // Required when changing to FOLDER_TYPE_TEAM
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateFolderRequest) Reset() {
+	*x = UpdateFolderRequest{}
+	mi := &file_mirai_v1_course_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateFolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFolderRequest) ProtoMessage() {}
+
+func (x *UpdateFolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_course_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFolderRequest.ProtoReflect.Descriptor instead.
+func (*UpdateFolderRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UpdateFolderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateFolderRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateFolderRequest) GetType() FolderType {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return FolderType_FOLDER_TYPE_UNSPECIFIED
+}
+
+func (x *UpdateFolderRequest) GetTeamId() string {
+	if x != nil && x.TeamId != nil {
+		return *x.TeamId
+	}
+	return ""
+}
+
+// UpdateFolderResponse contains the updated folder.
+type UpdateFolderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Folder        *Folder                `protobuf:"bytes,1,opt,name=folder,proto3" json:"folder,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateFolderResponse) Reset() {
+	*x = UpdateFolderResponse{}
+	mi := &file_mirai_v1_course_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateFolderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFolderResponse) ProtoMessage() {}
+
+func (x *UpdateFolderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_course_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFolderResponse.ProtoReflect.Descriptor instead.
+func (*UpdateFolderResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UpdateFolderResponse) GetFolder() *Folder {
+	if x != nil {
+		return x.Folder
+	}
+	return nil
+}
+
 // DeleteFolderRequest contains the folder ID to delete.
 type DeleteFolderRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -2426,7 +2988,7 @@ type DeleteFolderRequest struct {
 
 func (x *DeleteFolderRequest) Reset() {
 	*x = DeleteFolderRequest{}
-	mi := &file_mirai_v1_course_proto_msgTypes[31]
+	mi := &file_mirai_v1_course_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2438,7 +3000,7 @@ func (x *DeleteFolderRequest) String() string {
 func (*DeleteFolderRequest) ProtoMessage() {}
 
 func (x *DeleteFolderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_course_proto_msgTypes[31]
+	mi := &file_mirai_v1_course_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2451,7 +3013,7 @@ func (x *DeleteFolderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteFolderRequest.ProtoReflect.Descriptor instead.
 func (*DeleteFolderRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_course_proto_rawDescGZIP(), []int{31}
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *DeleteFolderRequest) GetId() string {
@@ -2471,7 +3033,7 @@ type DeleteFolderResponse struct {
 
 func (x *DeleteFolderResponse) Reset() {
 	*x = DeleteFolderResponse{}
-	mi := &file_mirai_v1_course_proto_msgTypes[32]
+	mi := &file_mirai_v1_course_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2483,7 +3045,7 @@ func (x *DeleteFolderResponse) String() string {
 func (*DeleteFolderResponse) ProtoMessage() {}
 
 func (x *DeleteFolderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_course_proto_msgTypes[32]
+	mi := &file_mirai_v1_course_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2496,7 +3058,7 @@ func (x *DeleteFolderResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteFolderResponse.ProtoReflect.Descriptor instead.
 func (*DeleteFolderResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_course_proto_rawDescGZIP(), []int{32}
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *DeleteFolderResponse) GetSuccess() bool {
@@ -2517,7 +3079,7 @@ type ExportCourseRequest struct {
 
 func (x *ExportCourseRequest) Reset() {
 	*x = ExportCourseRequest{}
-	mi := &file_mirai_v1_course_proto_msgTypes[33]
+	mi := &file_mirai_v1_course_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2529,7 +3091,7 @@ func (x *ExportCourseRequest) String() string {
 func (*ExportCourseRequest) ProtoMessage() {}
 
 func (x *ExportCourseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_course_proto_msgTypes[33]
+	mi := &file_mirai_v1_course_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2542,7 +3104,7 @@ func (x *ExportCourseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExportCourseRequest.ProtoReflect.Descriptor instead.
 func (*ExportCourseRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_course_proto_rawDescGZIP(), []int{33}
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *ExportCourseRequest) GetCourseId() string {
@@ -2569,7 +3131,7 @@ type ExportCourseResponse struct {
 
 func (x *ExportCourseResponse) Reset() {
 	*x = ExportCourseResponse{}
-	mi := &file_mirai_v1_course_proto_msgTypes[34]
+	mi := &file_mirai_v1_course_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2581,7 +3143,7 @@ func (x *ExportCourseResponse) String() string {
 func (*ExportCourseResponse) ProtoMessage() {}
 
 func (x *ExportCourseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_course_proto_msgTypes[34]
+	mi := &file_mirai_v1_course_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2594,7 +3156,7 @@ func (x *ExportCourseResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExportCourseResponse.ProtoReflect.Descriptor instead.
 func (*ExportCourseResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_course_proto_rawDescGZIP(), []int{34}
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *ExportCourseResponse) GetExport() *CourseExport {
@@ -2614,7 +3176,7 @@ type GetExportStatusRequest struct {
 
 func (x *GetExportStatusRequest) Reset() {
 	*x = GetExportStatusRequest{}
-	mi := &file_mirai_v1_course_proto_msgTypes[35]
+	mi := &file_mirai_v1_course_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2626,7 +3188,7 @@ func (x *GetExportStatusRequest) String() string {
 func (*GetExportStatusRequest) ProtoMessage() {}
 
 func (x *GetExportStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_course_proto_msgTypes[35]
+	mi := &file_mirai_v1_course_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2639,7 +3201,7 @@ func (x *GetExportStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetExportStatusRequest.ProtoReflect.Descriptor instead.
 func (*GetExportStatusRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_course_proto_rawDescGZIP(), []int{35}
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *GetExportStatusRequest) GetExportId() string {
@@ -2659,7 +3221,7 @@ type GetExportStatusResponse struct {
 
 func (x *GetExportStatusResponse) Reset() {
 	*x = GetExportStatusResponse{}
-	mi := &file_mirai_v1_course_proto_msgTypes[36]
+	mi := &file_mirai_v1_course_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2671,7 +3233,7 @@ func (x *GetExportStatusResponse) String() string {
 func (*GetExportStatusResponse) ProtoMessage() {}
 
 func (x *GetExportStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_course_proto_msgTypes[36]
+	mi := &file_mirai_v1_course_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2684,7 +3246,7 @@ func (x *GetExportStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetExportStatusResponse.ProtoReflect.Descriptor instead.
 func (*GetExportStatusResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_course_proto_rawDescGZIP(), []int{36}
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *GetExportStatusResponse) GetExport() *CourseExport {
@@ -2704,7 +3266,7 @@ type DownloadExportRequest struct {
 
 func (x *DownloadExportRequest) Reset() {
 	*x = DownloadExportRequest{}
-	mi := &file_mirai_v1_course_proto_msgTypes[37]
+	mi := &file_mirai_v1_course_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2716,7 +3278,7 @@ func (x *DownloadExportRequest) String() string {
 func (*DownloadExportRequest) ProtoMessage() {}
 
 func (x *DownloadExportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_course_proto_msgTypes[37]
+	mi := &file_mirai_v1_course_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2729,7 +3291,7 @@ func (x *DownloadExportRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DownloadExportRequest.ProtoReflect.Descriptor instead.
 func (*DownloadExportRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_course_proto_rawDescGZIP(), []int{37}
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *DownloadExportRequest) GetExportId() string {
@@ -2750,7 +3312,7 @@ type DownloadExportResponse struct {
 
 func (x *DownloadExportResponse) Reset() {
 	*x = DownloadExportResponse{}
-	mi := &file_mirai_v1_course_proto_msgTypes[38]
+	mi := &file_mirai_v1_course_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2762,7 +3324,7 @@ func (x *DownloadExportResponse) String() string {
 func (*DownloadExportResponse) ProtoMessage() {}
 
 func (x *DownloadExportResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_course_proto_msgTypes[38]
+	mi := &file_mirai_v1_course_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2775,7 +3337,7 @@ func (x *DownloadExportResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DownloadExportResponse.ProtoReflect.Descriptor instead.
 func (*DownloadExportResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_course_proto_rawDescGZIP(), []int{38}
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *DownloadExportResponse) GetDownloadUrl() string {
@@ -2802,7 +3364,7 @@ type ListExportsRequest struct {
 
 func (x *ListExportsRequest) Reset() {
 	*x = ListExportsRequest{}
-	mi := &file_mirai_v1_course_proto_msgTypes[39]
+	mi := &file_mirai_v1_course_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2814,7 +3376,7 @@ func (x *ListExportsRequest) String() string {
 func (*ListExportsRequest) ProtoMessage() {}
 
 func (x *ListExportsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_course_proto_msgTypes[39]
+	mi := &file_mirai_v1_course_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2827,7 +3389,7 @@ func (x *ListExportsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListExportsRequest.ProtoReflect.Descriptor instead.
 func (*ListExportsRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_course_proto_rawDescGZIP(), []int{39}
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *ListExportsRequest) GetCourseId() string {
@@ -2847,7 +3409,7 @@ type ListExportsResponse struct {
 
 func (x *ListExportsResponse) Reset() {
 	*x = ListExportsResponse{}
-	mi := &file_mirai_v1_course_proto_msgTypes[40]
+	mi := &file_mirai_v1_course_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2859,7 +3421,7 @@ func (x *ListExportsResponse) String() string {
 func (*ListExportsResponse) ProtoMessage() {}
 
 func (x *ListExportsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_course_proto_msgTypes[40]
+	mi := &file_mirai_v1_course_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2872,7 +3434,7 @@ func (x *ListExportsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListExportsResponse.ProtoReflect.Descriptor instead.
 func (*ListExportsResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_course_proto_rawDescGZIP(), []int{40}
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *ListExportsResponse) GetExports() []*CourseExport {
@@ -2882,8 +3444,2308 @@ func (x *ListExportsResponse) GetExports() []*CourseExport {
 	return nil
 }
 
-var File_mirai_v1_course_proto protoreflect.FileDescriptor
-
+// ListCollaboratorsRequest contains the course ID.
+type ListCollaboratorsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollaboratorsRequest) Reset() {
+	*x = ListCollaboratorsRequest{}
+	mi := &file_mirai_v1_course_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollaboratorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollaboratorsRequest) ProtoMessage() {}
+
+func (x *ListCollaboratorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_course_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollaboratorsRequest.ProtoReflect.Descriptor instead.
+func (*ListCollaboratorsRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListCollaboratorsRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+// ListCollaboratorsResponse contains all collaborators on the course.
+type ListCollaboratorsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collaborators []*CourseCollaborator  `protobuf:"bytes,1,rep,name=collaborators,proto3" json:"collaborators,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollaboratorsResponse) Reset() {
+	*x = ListCollaboratorsResponse{}
+	mi := &file_mirai_v1_course_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollaboratorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollaboratorsResponse) ProtoMessage() {}
+
+func (x *ListCollaboratorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_course_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollaboratorsResponse.ProtoReflect.Descriptor instead.
+func (*ListCollaboratorsResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListCollaboratorsResponse) GetCollaborators() []*CourseCollaborator {
+	if x != nil {
+		return x.Collaborators
+	}
+	return nil
+}
+
+// AddCollaboratorRequest contains the user to assign and their role.
+type AddCollaboratorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          CourseRole             `protobuf:"varint,3,opt,name=role,proto3,enum=mirai.v1.CourseRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCollaboratorRequest) Reset() {
+	*x = AddCollaboratorRequest{}
+	mi := &file_mirai_v1_course_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCollaboratorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCollaboratorRequest) ProtoMessage() {}
+
+func (x *AddCollaboratorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_course_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCollaboratorRequest.ProtoReflect.Descriptor instead.
+func (*AddCollaboratorRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *AddCollaboratorRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *AddCollaboratorRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AddCollaboratorRequest) GetRole() CourseRole {
+	if x != nil {
+		return x.Role
+	}
+	return CourseRole_COURSE_ROLE_UNSPECIFIED
+}
+
+// AddCollaboratorResponse contains the created assignment.
+type AddCollaboratorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collaborator  *CourseCollaborator    `protobuf:"bytes,1,opt,name=collaborator,proto3" json:"collaborator,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCollaboratorResponse) Reset() {
+	*x = AddCollaboratorResponse{}
+	mi := &file_mirai_v1_course_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCollaboratorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCollaboratorResponse) ProtoMessage() {}
+
+func (x *AddCollaboratorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_course_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCollaboratorResponse.ProtoReflect.Descriptor instead.
+func (*AddCollaboratorResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *AddCollaboratorResponse) GetCollaborator() *CourseCollaborator {
+	if x != nil {
+		return x.Collaborator
+	}
+	return nil
+}
+
+// RemoveCollaboratorRequest contains the user to remove.
+type RemoveCollaboratorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCollaboratorRequest) Reset() {
+	*x = RemoveCollaboratorRequest{}
+	mi := &file_mirai_v1_course_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCollaboratorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCollaboratorRequest) ProtoMessage() {}
+
+func (x *RemoveCollaboratorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_course_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCollaboratorRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCollaboratorRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *RemoveCollaboratorRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *RemoveCollaboratorRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// RemoveCollaboratorResponse confirms removal.
+type RemoveCollaboratorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCollaboratorResponse) Reset() {
+	*x = RemoveCollaboratorResponse{}
+	mi := &file_mirai_v1_course_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCollaboratorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCollaboratorResponse) ProtoMessage() {}
+
+func (x *RemoveCollaboratorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_course_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCollaboratorResponse.ProtoReflect.Descriptor instead.
+func (*RemoveCollaboratorResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{49}
+}
+
+// RemoveSampleContentRequest is empty as the tenant is identified by auth context.
+type RemoveSampleContentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveSampleContentRequest) Reset() {
+	*x = RemoveSampleContentRequest{}
+	mi := &file_mirai_v1_course_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveSampleContentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveSampleContentRequest) ProtoMessage() {}
+
+func (x *RemoveSampleContentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_course_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveSampleContentRequest.ProtoReflect.Descriptor instead.
+func (*RemoveSampleContentRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{50}
+}
+
+// RemoveSampleContentResponse reports what was removed.
+type RemoveSampleContentResponse struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	CoursesRemoved         int32                  `protobuf:"varint,1,opt,name=courses_removed,json=coursesRemoved,proto3" json:"courses_removed,omitempty"`
+	FoldersRemoved         int32                  `protobuf:"varint,2,opt,name=folders_removed,json=foldersRemoved,proto3" json:"folders_removed,omitempty"`
+	FoldersKept            int32                  `protobuf:"varint,3,opt,name=folders_kept,json=foldersKept,proto3" json:"folders_kept,omitempty"` // Sample folders kept because they now hold other content
+	TargetAudiencesRemoved int32                  `protobuf:"varint,4,opt,name=target_audiences_removed,json=targetAudiencesRemoved,proto3" json:"target_audiences_removed,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *RemoveSampleContentResponse) Reset() {
+	*x = RemoveSampleContentResponse{}
+	mi := &file_mirai_v1_course_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveSampleContentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveSampleContentResponse) ProtoMessage() {}
+
+func (x *RemoveSampleContentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_course_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveSampleContentResponse.ProtoReflect.Descriptor instead.
+func (*RemoveSampleContentResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *RemoveSampleContentResponse) GetCoursesRemoved() int32 {
+	if x != nil {
+		return x.CoursesRemoved
+	}
+	return 0
+}
+
+func (x *RemoveSampleContentResponse) GetFoldersRemoved() int32 {
+	if x != nil {
+		return x.FoldersRemoved
+	}
+	return 0
+}
+
+func (x *RemoveSampleContentResponse) GetFoldersKept() int32 {
+	if x != nil {
+		return x.FoldersKept
+	}
+	return 0
+}
+
+func (x *RemoveSampleContentResponse) GetTargetAudiencesRemoved() int32 {
+	if x != nil {
+		return x.TargetAudiencesRemoved
+	}
+	return 0
+}
+
+// ListLargestCoursesRequest limits the report.
+type ListLargestCoursesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"` // Defaults to 20, max 100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLargestCoursesRequest) Reset() {
+	*x = ListLargestCoursesRequest{}
+	mi := &file_mirai_v1_course_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLargestCoursesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLargestCoursesRequest) ProtoMessage() {}
+
+func (x *ListLargestCoursesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_course_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLargestCoursesRequest.ProtoReflect.Descriptor instead.
+func (*ListLargestCoursesRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListLargestCoursesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// CourseSize is a course's stored content size.
+type CourseSize struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	CourseId         string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Title            string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	ContentSizeBytes int64                  `protobuf:"varint,3,opt,name=content_size_bytes,json=contentSizeBytes,proto3" json:"content_size_bytes,omitempty"`
+	ModifiedAt       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=modified_at,json=modifiedAt,proto3" json:"modified_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CourseSize) Reset() {
+	*x = CourseSize{}
+	mi := &file_mirai_v1_course_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CourseSize) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourseSize) ProtoMessage() {}
+
+func (x *CourseSize) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_course_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourseSize.ProtoReflect.Descriptor instead.
+func (*CourseSize) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *CourseSize) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *CourseSize) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CourseSize) GetContentSizeBytes() int64 {
+	if x != nil {
+		return x.ContentSizeBytes
+	}
+	return 0
+}
+
+func (x *CourseSize) GetModifiedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ModifiedAt
+	}
+	return nil
+}
+
+// ListLargestCoursesResponse lists courses largest first. Courses not saved since sizes
+// started being recorded are left out.
+type ListLargestCoursesResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Courses        []*CourseSize          `protobuf:"bytes,1,rep,name=courses,proto3" json:"courses,omitempty"`
+	SoftLimitBytes int64                  `protobuf:"varint,2,opt,name=soft_limit_bytes,json=softLimitBytes,proto3" json:"soft_limit_bytes,omitempty"` // Saves above this succeed with a warning; 0 if unset
+	HardLimitBytes int64                  `protobuf:"varint,3,opt,name=hard_limit_bytes,json=hardLimitBytes,proto3" json:"hard_limit_bytes,omitempty"` // Saves above this are rejected; 0 if unset
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListLargestCoursesResponse) Reset() {
+	*x = ListLargestCoursesResponse{}
+	mi := &file_mirai_v1_course_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLargestCoursesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLargestCoursesResponse) ProtoMessage() {}
+
+func (x *ListLargestCoursesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_course_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLargestCoursesResponse.ProtoReflect.Descriptor instead.
+func (*ListLargestCoursesResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListLargestCoursesResponse) GetCourses() []*CourseSize {
+	if x != nil {
+		return x.Courses
+	}
+	return nil
+}
+
+func (x *ListLargestCoursesResponse) GetSoftLimitBytes() int64 {
+	if x != nil {
+		return x.SoftLimitBytes
+	}
+	return 0
+}
+
+func (x *ListLargestCoursesResponse) GetHardLimitBytes() int64 {
+	if x != nil {
+		return x.HardLimitBytes
+	}
+	return 0
+}
+
+// PublishChangesRequest names the course whose edits to publish.
+type PublishChangesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishChangesRequest) Reset() {
+	*x = PublishChangesRequest{}
+	mi := &file_mirai_v1_course_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishChangesRequest) ProtoMessage() {}
+
+func (x *PublishChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_course_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishChangesRequest.ProtoReflect.Descriptor instead.
+func (*PublishChangesRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *PublishChangesRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+// PublishChangesResponse returns the course with its new published version.
+type PublishChangesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Course        *Course                `protobuf:"bytes,1,opt,name=course,proto3" json:"course,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishChangesResponse) Reset() {
+	*x = PublishChangesResponse{}
+	mi := &file_mirai_v1_course_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishChangesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishChangesResponse) ProtoMessage() {}
+
+func (x *PublishChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_course_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishChangesResponse.ProtoReflect.Descriptor instead.
+func (*PublishChangesResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *PublishChangesResponse) GetCourse() *Course {
+	if x != nil {
+		return x.Course
+	}
+	return nil
+}
+
+// DiscardDraftRequest names the course whose edits to discard.
+type DiscardDraftRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiscardDraftRequest) Reset() {
+	*x = DiscardDraftRequest{}
+	mi := &file_mirai_v1_course_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscardDraftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscardDraftRequest) ProtoMessage() {}
+
+func (x *DiscardDraftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_course_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscardDraftRequest.ProtoReflect.Descriptor instead.
+func (*DiscardDraftRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *DiscardDraftRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+// DiscardDraftResponse returns the course as restored. Outline changes and lessons added
+// since publishing are kept; the lessons still in the course are restored.
+type DiscardDraftResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Course          *Course                `protobuf:"bytes,1,opt,name=course,proto3" json:"course,omitempty"`
+	LessonsRestored int32                  `protobuf:"varint,2,opt,name=lessons_restored,json=lessonsRestored,proto3" json:"lessons_restored,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DiscardDraftResponse) Reset() {
+	*x = DiscardDraftResponse{}
+	mi := &file_mirai_v1_course_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscardDraftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscardDraftResponse) ProtoMessage() {}
+
+func (x *DiscardDraftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_course_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscardDraftResponse.ProtoReflect.Descriptor instead.
+func (*DiscardDraftResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *DiscardDraftResponse) GetCourse() *Course {
+	if x != nil {
+		return x.Course
+	}
+	return nil
+}
+
+func (x *DiscardDraftResponse) GetLessonsRestored() int32 {
+	if x != nil {
+		return x.LessonsRestored
+	}
+	return 0
+}
+
+// SearchWithinCourseRequest names the course to search and the phrase to find.
+type SearchWithinCourseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"` // Matched case-insensitively; max 200 characters
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchWithinCourseRequest) Reset() {
+	*x = SearchWithinCourseRequest{}
+	mi := &file_mirai_v1_course_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchWithinCourseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchWithinCourseRequest) ProtoMessage() {}
+
+func (x *SearchWithinCourseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_course_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchWithinCourseRequest.ProtoReflect.Descriptor instead.
+func (*SearchWithinCourseRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *SearchWithinCourseRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *SearchWithinCourseRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+// CourseSearchMatch is one block or component containing the phrase.
+type CourseSearchMatch struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Source          CourseSearchSource     `protobuf:"varint,1,opt,name=source,proto3,enum=mirai.v1.CourseSearchSource" json:"source,omitempty"`
+	SectionId       string                 `protobuf:"bytes,2,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty"` // Empty for course-level blocks
+	SectionTitle    string                 `protobuf:"bytes,3,opt,name=section_title,json=sectionTitle,proto3" json:"section_title,omitempty"`
+	LessonId        string                 `protobuf:"bytes,4,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"` // Empty for course-level blocks
+	LessonTitle     string                 `protobuf:"bytes,5,opt,name=lesson_title,json=lessonTitle,proto3" json:"lesson_title,omitempty"`
+	BlockId         string                 `protobuf:"bytes,6,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`                           // Set for authoring matches
+	ComponentId     string                 `protobuf:"bytes,7,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`               // Set for lesson component matches
+	Snippet         string                 `protobuf:"bytes,8,opt,name=snippet,proto3" json:"snippet,omitempty"`                                          // Text around the match
+	HighlightStart  int32                  `protobuf:"varint,9,opt,name=highlight_start,json=highlightStart,proto3" json:"highlight_start,omitempty"`     // Character offset of the match within the snippet
+	HighlightLength int32                  `protobuf:"varint,10,opt,name=highlight_length,json=highlightLength,proto3" json:"highlight_length,omitempty"` // Characters to highlight; 0 when the words matched apart
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CourseSearchMatch) Reset() {
+	*x = CourseSearchMatch{}
+	mi := &file_mirai_v1_course_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CourseSearchMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourseSearchMatch) ProtoMessage() {}
+
+func (x *CourseSearchMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_course_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourseSearchMatch.ProtoReflect.Descriptor instead.
+func (*CourseSearchMatch) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *CourseSearchMatch) GetSource() CourseSearchSource {
+	if x != nil {
+		return x.Source
+	}
+	return CourseSearchSource_COURSE_SEARCH_SOURCE_UNSPECIFIED
+}
+
+func (x *CourseSearchMatch) GetSectionId() string {
+	if x != nil {
+		return x.SectionId
+	}
+	return ""
+}
+
+func (x *CourseSearchMatch) GetSectionTitle() string {
+	if x != nil {
+		return x.SectionTitle
+	}
+	return ""
+}
+
+func (x *CourseSearchMatch) GetLessonId() string {
+	if x != nil {
+		return x.LessonId
+	}
+	return ""
+}
+
+func (x *CourseSearchMatch) GetLessonTitle() string {
+	if x != nil {
+		return x.LessonTitle
+	}
+	return ""
+}
+
+func (x *CourseSearchMatch) GetBlockId() string {
+	if x != nil {
+		return x.BlockId
+	}
+	return ""
+}
+
+func (x *CourseSearchMatch) GetComponentId() string {
+	if x != nil {
+		return x.ComponentId
+	}
+	return ""
+}
+
+func (x *CourseSearchMatch) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+func (x *CourseSearchMatch) GetHighlightStart() int32 {
+	if x != nil {
+		return x.HighlightStart
+	}
+	return 0
+}
+
+func (x *CourseSearchMatch) GetHighlightLength() int32 {
+	if x != nil {
+		return x.HighlightLength
+	}
+	return 0
+}
+
+// SearchWithinCourseResponse lists the matches in course order: authored content first,
+// then generated lessons, each by section, lesson and position.
+type SearchWithinCourseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Matches       []*CourseSearchMatch   `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	Truncated     bool                   `protobuf:"varint,2,opt,name=truncated,proto3" json:"truncated,omitempty"` // More than 50 matches; only the first 50 are returned
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchWithinCourseResponse) Reset() {
+	*x = SearchWithinCourseResponse{}
+	mi := &file_mirai_v1_course_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchWithinCourseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchWithinCourseResponse) ProtoMessage() {}
+
+func (x *SearchWithinCourseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_course_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchWithinCourseResponse.ProtoReflect.Descriptor instead.
+func (*SearchWithinCourseResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *SearchWithinCourseResponse) GetMatches() []*CourseSearchMatch {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+func (x *SearchWithinCourseResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+// CourseAttachment is a reference file, such as a style guide or product spec, whose
+// text is given to outline and lesson generation alongside SME knowledge.
+type CourseAttachment struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CourseId            string                 `protobuf:"bytes,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	FileName            string                 `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType         string                 `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	SizeBytes           int64                  `protobuf:"varint,5,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	Status              CourseAttachmentStatus `protobuf:"varint,6,opt,name=status,proto3,enum=mirai.v1.CourseAttachmentStatus" json:"status,omitempty"`
+	ErrorMessage        *string                `protobuf:"bytes,7,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message,omitempty"`
+	ChunkCount          int32                  `protobuf:"varint,8,opt,name=chunk_count,json=chunkCount,proto3" json:"chunk_count,omitempty"`                        // Chunks of text extracted
+	FlaggedChunkCount   int32                  `protobuf:"varint,9,opt,name=flagged_chunk_count,json=flaggedChunkCount,proto3" json:"flagged_chunk_count,omitempty"` // Chunks held back from prompts as possible prompt injection
+	ExcludedFromPrompts bool                   `protobuf:"varint,10,opt,name=excluded_from_prompts,json=excludedFromPrompts,proto3" json:"excluded_from_prompts,omitempty"`
+	CreatedAt           *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ProcessedAt         *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=processed_at,json=processedAt,proto3,oneof" json:"processed_at,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CourseAttachment) Reset() {
+	*x = CourseAttachment{}
+	mi := &file_mirai_v1_course_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CourseAttachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourseAttachment) ProtoMessage() {}
+
+func (x *CourseAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_course_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourseAttachment.ProtoReflect.Descriptor instead.
+func (*CourseAttachment) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *CourseAttachment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CourseAttachment) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *CourseAttachment) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *CourseAttachment) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *CourseAttachment) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *CourseAttachment) GetStatus() CourseAttachmentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CourseAttachmentStatus_COURSE_ATTACHMENT_STATUS_UNSPECIFIED
+}
+
+func (x *CourseAttachment) GetErrorMessage() string {
+	if x != nil && x.ErrorMessage != nil {
+		return *x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *CourseAttachment) GetChunkCount() int32 {
+	if x != nil {
+		return x.ChunkCount
+	}
+	return 0
+}
+
+func (x *CourseAttachment) GetFlaggedChunkCount() int32 {
+	if x != nil {
+		return x.FlaggedChunkCount
+	}
+	return 0
+}
+
+func (x *CourseAttachment) GetExcludedFromPrompts() bool {
+	if x != nil {
+		return x.ExcludedFromPrompts
+	}
+	return false
+}
+
+func (x *CourseAttachment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CourseAttachment) GetProcessedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ProcessedAt
+	}
+	return nil
+}
+
+// GetCourseAttachmentUploadURLRequest requests an upload slot for a reference file.
+type GetCourseAttachmentUploadURLRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	FileName      string                 `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"` // .pdf, .docx, .md, .markdown, .txt or .csv
+	ContentType   string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	SizeBytes     int64                  `protobuf:"varint,4,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"` // At most 20 MB
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCourseAttachmentUploadURLRequest) Reset() {
+	*x = GetCourseAttachmentUploadURLRequest{}
+	mi := &file_mirai_v1_course_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourseAttachmentUploadURLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseAttachmentUploadURLRequest) ProtoMessage() {}
+
+func (x *GetCourseAttachmentUploadURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_course_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseAttachmentUploadURLRequest.ProtoReflect.Descriptor instead.
+func (*GetCourseAttachmentUploadURLRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetCourseAttachmentUploadURLRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *GetCourseAttachmentUploadURLRequest) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *GetCourseAttachmentUploadURLRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *GetCourseAttachmentUploadURLRequest) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+// GetCourseAttachmentUploadURLResponse contains the presigned upload URL.
+type GetCourseAttachmentUploadURLResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UploadUrl     string                 `protobuf:"bytes,1,opt,name=upload_url,json=uploadUrl,proto3" json:"upload_url,omitempty"`
+	FilePath      string                 `protobuf:"bytes,2,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"` // Pass to ConfirmCourseAttachment once uploaded
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCourseAttachmentUploadURLResponse) Reset() {
+	*x = GetCourseAttachmentUploadURLResponse{}
+	mi := &file_mirai_v1_course_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourseAttachmentUploadURLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseAttachmentUploadURLResponse) ProtoMessage() {}
+
+func (x *GetCourseAttachmentUploadURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_course_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseAttachmentUploadURLResponse.ProtoReflect.Descriptor instead.
+func (*GetCourseAttachmentUploadURLResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetCourseAttachmentUploadURLResponse) GetUploadUrl() string {
+	if x != nil {
+		return x.UploadUrl
+	}
+	return ""
+}
+
+func (x *GetCourseAttachmentUploadURLResponse) GetFilePath() string {
+	if x != nil {
+		return x.FilePath
+	}
+	return ""
+}
+
+// ConfirmCourseAttachmentRequest records an uploaded reference file.
+type ConfirmCourseAttachmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	FilePath      string                 `protobuf:"bytes,2,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"` // Path from GetCourseAttachmentUploadURL
+	ContentType   string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmCourseAttachmentRequest) Reset() {
+	*x = ConfirmCourseAttachmentRequest{}
+	mi := &file_mirai_v1_course_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmCourseAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmCourseAttachmentRequest) ProtoMessage() {}
+
+func (x *ConfirmCourseAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_course_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmCourseAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmCourseAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ConfirmCourseAttachmentRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *ConfirmCourseAttachmentRequest) GetFilePath() string {
+	if x != nil {
+		return x.FilePath
+	}
+	return ""
+}
+
+func (x *ConfirmCourseAttachmentRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+// ConfirmCourseAttachmentResponse contains the new attachment, pending extraction.
+type ConfirmCourseAttachmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attachment    *CourseAttachment      `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmCourseAttachmentResponse) Reset() {
+	*x = ConfirmCourseAttachmentResponse{}
+	mi := &file_mirai_v1_course_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmCourseAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmCourseAttachmentResponse) ProtoMessage() {}
+
+func (x *ConfirmCourseAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_course_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmCourseAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmCourseAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ConfirmCourseAttachmentResponse) GetAttachment() *CourseAttachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+// ListCourseAttachmentsRequest names the course whose reference files to list.
+type ListCourseAttachmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCourseAttachmentsRequest) Reset() {
+	*x = ListCourseAttachmentsRequest{}
+	mi := &file_mirai_v1_course_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCourseAttachmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCourseAttachmentsRequest) ProtoMessage() {}
+
+func (x *ListCourseAttachmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_course_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCourseAttachmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListCourseAttachmentsRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ListCourseAttachmentsRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+// ListCourseAttachmentsResponse lists the course's reference files, oldest first.
+type ListCourseAttachmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attachments   []*CourseAttachment    `protobuf:"bytes,1,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCourseAttachmentsResponse) Reset() {
+	*x = ListCourseAttachmentsResponse{}
+	mi := &file_mirai_v1_course_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCourseAttachmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCourseAttachmentsResponse) ProtoMessage() {}
+
+func (x *ListCourseAttachmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_course_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCourseAttachmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListCourseAttachmentsResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ListCourseAttachmentsResponse) GetAttachments() []*CourseAttachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+// SetCourseAttachmentExcludedRequest includes or leaves out a reference file.
+type SetCourseAttachmentExcludedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AttachmentId  string                 `protobuf:"bytes,1,opt,name=attachment_id,json=attachmentId,proto3" json:"attachment_id,omitempty"`
+	Excluded      bool                   `protobuf:"varint,2,opt,name=excluded,proto3" json:"excluded,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCourseAttachmentExcludedRequest) Reset() {
+	*x = SetCourseAttachmentExcludedRequest{}
+	mi := &file_mirai_v1_course_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCourseAttachmentExcludedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCourseAttachmentExcludedRequest) ProtoMessage() {}
+
+func (x *SetCourseAttachmentExcludedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_course_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCourseAttachmentExcludedRequest.ProtoReflect.Descriptor instead.
+func (*SetCourseAttachmentExcludedRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *SetCourseAttachmentExcludedRequest) GetAttachmentId() string {
+	if x != nil {
+		return x.AttachmentId
+	}
+	return ""
+}
+
+func (x *SetCourseAttachmentExcludedRequest) GetExcluded() bool {
+	if x != nil {
+		return x.Excluded
+	}
+	return false
+}
+
+// SetCourseAttachmentExcludedResponse contains the updated attachment.
+type SetCourseAttachmentExcludedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attachment    *CourseAttachment      `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCourseAttachmentExcludedResponse) Reset() {
+	*x = SetCourseAttachmentExcludedResponse{}
+	mi := &file_mirai_v1_course_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCourseAttachmentExcludedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCourseAttachmentExcludedResponse) ProtoMessage() {}
+
+func (x *SetCourseAttachmentExcludedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_course_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCourseAttachmentExcludedResponse.ProtoReflect.Descriptor instead.
+func (*SetCourseAttachmentExcludedResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *SetCourseAttachmentExcludedResponse) GetAttachment() *CourseAttachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+// DeleteCourseAttachmentRequest names the reference file to remove.
+type DeleteCourseAttachmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AttachmentId  string                 `protobuf:"bytes,1,opt,name=attachment_id,json=attachmentId,proto3" json:"attachment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCourseAttachmentRequest) Reset() {
+	*x = DeleteCourseAttachmentRequest{}
+	mi := &file_mirai_v1_course_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCourseAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCourseAttachmentRequest) ProtoMessage() {}
+
+func (x *DeleteCourseAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_course_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCourseAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCourseAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *DeleteCourseAttachmentRequest) GetAttachmentId() string {
+	if x != nil {
+		return x.AttachmentId
+	}
+	return ""
+}
+
+// DeleteCourseAttachmentResponse is empty on success.
+type DeleteCourseAttachmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCourseAttachmentResponse) Reset() {
+	*x = DeleteCourseAttachmentResponse{}
+	mi := &file_mirai_v1_course_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCourseAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCourseAttachmentResponse) ProtoMessage() {}
+
+func (x *DeleteCourseAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_course_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCourseAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCourseAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{72}
+}
+
+// CourseGenerationLock names the full course generation run holding a course read-only.
+type CourseGenerationLock struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"` // The run's parent job
+	LockedAt      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=locked_at,json=lockedAt,proto3" json:"locked_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CourseGenerationLock) Reset() {
+	*x = CourseGenerationLock{}
+	mi := &file_mirai_v1_course_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CourseGenerationLock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourseGenerationLock) ProtoMessage() {}
+
+func (x *CourseGenerationLock) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_course_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourseGenerationLock.ProtoReflect.Descriptor instead.
+func (*CourseGenerationLock) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *CourseGenerationLock) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *CourseGenerationLock) GetLockedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LockedAt
+	}
+	return nil
+}
+
+// CourseCard is the minimum needed to lay out a course in the library.
+type CourseCard struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Status        CourseStatus           `protobuf:"varint,3,opt,name=status,proto3,enum=mirai.v1.CourseStatus" json:"status,omitempty"`
+	ModifiedAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=modified_at,json=modifiedAt,proto3" json:"modified_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CourseCard) Reset() {
+	*x = CourseCard{}
+	mi := &file_mirai_v1_course_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CourseCard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourseCard) ProtoMessage() {}
+
+func (x *CourseCard) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_course_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourseCard.ProtoReflect.Descriptor instead.
+func (*CourseCard) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *CourseCard) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CourseCard) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CourseCard) GetStatus() CourseStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CourseStatus_COURSE_STATUS_UNSPECIFIED
+}
+
+func (x *CourseCard) GetModifiedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ModifiedAt
+	}
+	return nil
+}
+
+// ListCourseCardsRequest contains optional filters for a page of course cards.
+type ListCourseCardsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *CourseStatus          `protobuf:"varint,1,opt,name=status,proto3,enum=mirai.v1.CourseStatus,oneof" json:"status,omitempty"`
+	Folder        *string                `protobuf:"bytes,2,opt,name=folder,proto3,oneof" json:"folder,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`        // Max results (default 48, max 100)
+	Cursor        *string                `protobuf:"bytes,4,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"` // For pagination
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCourseCardsRequest) Reset() {
+	*x = ListCourseCardsRequest{}
+	mi := &file_mirai_v1_course_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCourseCardsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCourseCardsRequest) ProtoMessage() {}
+
+func (x *ListCourseCardsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_course_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCourseCardsRequest.ProtoReflect.Descriptor instead.
+func (*ListCourseCardsRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ListCourseCardsRequest) GetStatus() CourseStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return CourseStatus_COURSE_STATUS_UNSPECIFIED
+}
+
+func (x *ListCourseCardsRequest) GetFolder() string {
+	if x != nil && x.Folder != nil {
+		return *x.Folder
+	}
+	return ""
+}
+
+func (x *ListCourseCardsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListCourseCardsRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+// ListCourseCardsResponse contains a page of course cards.
+type ListCourseCardsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cards         []*CourseCard          `protobuf:"bytes,1,rep,name=cards,proto3" json:"cards,omitempty"`
+	NextCursor    *string                `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3,oneof" json:"next_cursor,omitempty"` // For pagination
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCourseCardsResponse) Reset() {
+	*x = ListCourseCardsResponse{}
+	mi := &file_mirai_v1_course_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCourseCardsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCourseCardsResponse) ProtoMessage() {}
+
+func (x *ListCourseCardsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_course_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCourseCardsResponse.ProtoReflect.Descriptor instead.
+func (*ListCourseCardsResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ListCourseCardsResponse) GetCards() []*CourseCard {
+	if x != nil {
+		return x.Cards
+	}
+	return nil
+}
+
+func (x *ListCourseCardsResponse) GetNextCursor() string {
+	if x != nil && x.NextCursor != nil {
+		return *x.NextCursor
+	}
+	return ""
+}
+
+// CourseCardDetails is the part of a course card loaded only for cards on screen.
+type CourseCardDetails struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CourseId        string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Tags            []string               `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	ThumbnailUrl    *string                `protobuf:"bytes,3,opt,name=thumbnail_url,json=thumbnailUrl,proto3,oneof" json:"thumbnail_url,omitempty"` // Presigned; valid for 15 minutes
+	CreatedBy       string                 `protobuf:"bytes,4,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedByName   *string                `protobuf:"bytes,5,opt,name=created_by_name,json=createdByName,proto3,oneof" json:"created_by_name,omitempty"`  // Unset if the creator can't be looked up
+	CreatedByActive bool                   `protobuf:"varint,6,opt,name=created_by_active,json=createdByActive,proto3" json:"created_by_active,omitempty"` // False when the creator has been deactivated
+	DurationMinutes int32                  `protobuf:"varint,7,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`   // Estimated from the latest outline; 0 without one
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CourseCardDetails) Reset() {
+	*x = CourseCardDetails{}
+	mi := &file_mirai_v1_course_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CourseCardDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourseCardDetails) ProtoMessage() {}
+
+func (x *CourseCardDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_course_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourseCardDetails.ProtoReflect.Descriptor instead.
+func (*CourseCardDetails) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *CourseCardDetails) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *CourseCardDetails) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CourseCardDetails) GetThumbnailUrl() string {
+	if x != nil && x.ThumbnailUrl != nil {
+		return *x.ThumbnailUrl
+	}
+	return ""
+}
+
+func (x *CourseCardDetails) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *CourseCardDetails) GetCreatedByName() string {
+	if x != nil && x.CreatedByName != nil {
+		return *x.CreatedByName
+	}
+	return ""
+}
+
+func (x *CourseCardDetails) GetCreatedByActive() bool {
+	if x != nil {
+		return x.CreatedByActive
+	}
+	return false
+}
+
+func (x *CourseCardDetails) GetDurationMinutes() int32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
+// GetCourseCardDetailsRequest names the course cards to fill in.
+type GetCourseCardDetailsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseIds     []string               `protobuf:"bytes,1,rep,name=course_ids,json=courseIds,proto3" json:"course_ids,omitempty"` // At most 50
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCourseCardDetailsRequest) Reset() {
+	*x = GetCourseCardDetailsRequest{}
+	mi := &file_mirai_v1_course_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourseCardDetailsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseCardDetailsRequest) ProtoMessage() {}
+
+func (x *GetCourseCardDetailsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_course_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseCardDetailsRequest.ProtoReflect.Descriptor instead.
+func (*GetCourseCardDetailsRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GetCourseCardDetailsRequest) GetCourseIds() []string {
+	if x != nil {
+		return x.CourseIds
+	}
+	return nil
+}
+
+// GetCourseCardDetailsResponse contains the details of the requested courses that exist,
+// in the order requested.
+type GetCourseCardDetailsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Details       []*CourseCardDetails   `protobuf:"bytes,1,rep,name=details,proto3" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCourseCardDetailsResponse) Reset() {
+	*x = GetCourseCardDetailsResponse{}
+	mi := &file_mirai_v1_course_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourseCardDetailsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseCardDetailsResponse) ProtoMessage() {}
+
+func (x *GetCourseCardDetailsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_course_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseCardDetailsResponse.ProtoReflect.Descriptor instead.
+func (*GetCourseCardDetailsResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GetCourseCardDetailsResponse) GetDetails() []*CourseCardDetails {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+// CoursePresence is someone with a course open, as of their last heartbeat.
+type CoursePresence struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"` // Empty if their identity can't be looked up
+	Editing       bool                   `protobuf:"varint,3,opt,name=editing,proto3" json:"editing,omitempty"`                           // False when only viewing
+	LastSeenAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CoursePresence) Reset() {
+	*x = CoursePresence{}
+	mi := &file_mirai_v1_course_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CoursePresence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoursePresence) ProtoMessage() {}
+
+func (x *CoursePresence) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_course_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoursePresence.ProtoReflect.Descriptor instead.
+func (*CoursePresence) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *CoursePresence) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CoursePresence) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *CoursePresence) GetEditing() bool {
+	if x != nil {
+		return x.Editing
+	}
+	return false
+}
+
+func (x *CoursePresence) GetLastSeenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeenAt
+	}
+	return nil
+}
+
+// HeartbeatCoursePresenceRequest names the course the caller has open.
+type HeartbeatCoursePresenceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Editing       bool                   `protobuf:"varint,2,opt,name=editing,proto3" json:"editing,omitempty"` // False when only viewing
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HeartbeatCoursePresenceRequest) Reset() {
+	*x = HeartbeatCoursePresenceRequest{}
+	mi := &file_mirai_v1_course_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HeartbeatCoursePresenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeartbeatCoursePresenceRequest) ProtoMessage() {}
+
+func (x *HeartbeatCoursePresenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_course_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeartbeatCoursePresenceRequest.ProtoReflect.Descriptor instead.
+func (*HeartbeatCoursePresenceRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *HeartbeatCoursePresenceRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *HeartbeatCoursePresenceRequest) GetEditing() bool {
+	if x != nil {
+		return x.Editing
+	}
+	return false
+}
+
+// HeartbeatCoursePresenceResponse lists everyone else with the course open, editors first.
+// It is empty when presence is unavailable.
+type HeartbeatCoursePresenceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Others        []*CoursePresence      `protobuf:"bytes,1,rep,name=others,proto3" json:"others,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HeartbeatCoursePresenceResponse) Reset() {
+	*x = HeartbeatCoursePresenceResponse{}
+	mi := &file_mirai_v1_course_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HeartbeatCoursePresenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeartbeatCoursePresenceResponse) ProtoMessage() {}
+
+func (x *HeartbeatCoursePresenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_course_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeartbeatCoursePresenceResponse.ProtoReflect.Descriptor instead.
+func (*HeartbeatCoursePresenceResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *HeartbeatCoursePresenceResponse) GetOthers() []*CoursePresence {
+	if x != nil {
+		return x.Others
+	}
+	return nil
+}
+
+// GetCoursePresenceRequest names the course to check.
+type GetCoursePresenceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCoursePresenceRequest) Reset() {
+	*x = GetCoursePresenceRequest{}
+	mi := &file_mirai_v1_course_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCoursePresenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCoursePresenceRequest) ProtoMessage() {}
+
+func (x *GetCoursePresenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_course_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCoursePresenceRequest.ProtoReflect.Descriptor instead.
+func (*GetCoursePresenceRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *GetCoursePresenceRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+// GetCoursePresenceResponse lists everyone else with the course open, editors first.
+// It is empty when presence is unavailable.
+type GetCoursePresenceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Others        []*CoursePresence      `protobuf:"bytes,1,rep,name=others,proto3" json:"others,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCoursePresenceResponse) Reset() {
+	*x = GetCoursePresenceResponse{}
+	mi := &file_mirai_v1_course_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCoursePresenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCoursePresenceResponse) ProtoMessage() {}
+
+func (x *GetCoursePresenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_course_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCoursePresenceResponse.ProtoReflect.Descriptor instead.
+func (*GetCoursePresenceResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_course_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *GetCoursePresenceResponse) GetOthers() []*CoursePresence {
+	if x != nil {
+		return x.Others
+	}
+	return nil
+}
+
+var File_mirai_v1_course_proto protoreflect.FileDescriptor
+
 const file_mirai_v1_course_proto_rawDesc = "" +
 	"\n" +
 	"\x15mirai/v1/course.proto\x12\bmirai.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"7\n" +
@@ -2936,7 +5798,7 @@ const file_mirai_v1_course_proto_rawDesc = "" +
 	"\x11enable_final_exam\x18\x02 \x01(\bR\x0fenableFinalExam\"\x80\x01\n" +
 	"\rCourseContent\x123\n" +
 	"\bsections\x18\x01 \x03(\v2\x17.mirai.v1.CourseSectionR\bsections\x12:\n" +
-	"\rcourse_blocks\x18\x02 \x03(\v2\x15.mirai.v1.CourseBlockR\fcourseBlocks\"\xab\x02\n" +
+	"\rcourse_blocks\x18\x02 \x03(\v2\x15.mirai.v1.CourseBlockR\fcourseBlocks\"\xa7\x04\n" +
 	"\fCourseExport\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x128\n" +
 	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12.\n" +
@@ -2944,8 +5806,18 @@ const file_mirai_v1_course_proto_rawDesc = "" +
 	"\aversion\x18\x04 \x01(\x05R\aversion\x12\x1b\n" +
 	"\tfile_path\x18\x05 \x01(\tR\bfilePath\x12.\n" +
 	"\x06status\x18\x06 \x01(\x0e2\x16.mirai.v1.ExportStatusR\x06status\x12(\n" +
-	"\rerror_message\x18\a \x01(\tH\x00R\ferrorMessage\x88\x01\x01B\x10\n" +
-	"\x0e_error_message\"\xc4\x01\n" +
+	"\rerror_message\x18\a \x01(\tH\x00R\ferrorMessage\x88\x01\x01\x129\n" +
+	"\n" +
+	"expires_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\t \x01(\x03R\tsizeBytes\x12<\n" +
+	"\tpurged_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampH\x01R\bpurgedAt\x88\x01\x01\x12C\n" +
+	"\fpurge_reason\x18\v \x01(\x0e2\x1b.mirai.v1.ExportPurgeReasonH\x02R\vpurgeReason\x88\x01\x01B\x10\n" +
+	"\x0e_error_messageB\f\n" +
+	"\n" +
+	"_purged_atB\x0f\n" +
+	"\r_purge_reason\"\xc4\x01\n" +
 	"\x0eCourseSettings\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12'\n" +
 	"\x0fdesired_outcome\x18\x02 \x01(\tR\x0edesiredOutcome\x12-\n" +
@@ -2963,7 +5835,7 @@ const file_mirai_v1_course_proto_rawDesc = "" +
 	"modifiedAt\x12\"\n" +
 	"\n" +
 	"created_by\x18\x06 \x01(\tH\x00R\tcreatedBy\x88\x01\x01B\r\n" +
-	"\v_created_by\"\xd5\x05\n" +
+	"\v_created_by\"\xba\x06\n" +
 	"\x06Course\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
 	"\aversion\x18\x02 \x01(\x05R\aversion\x12.\n" +
@@ -2980,13 +5852,15 @@ const file_mirai_v1_course_proto_rawDesc = "" +
 	"company_id\x18\v \x01(\tH\x00R\tcompanyId\x88\x01\x01\x12 \n" +
 	"\ttenant_id\x18\f \x01(\tH\x01R\btenantId\x88\x01\x01\x120\n" +
 	"\x12created_by_user_id\x18\r \x01(\tH\x02R\x0fcreatedByUserId\x88\x01\x01\x12\x1c\n" +
-	"\ateam_id\x18\x0e \x01(\tH\x03R\x06teamId\x88\x01\x01B\r\n" +
+	"\ateam_id\x18\x0e \x01(\tH\x03R\x06teamId\x88\x01\x01\x12+\n" +
+	"\x11published_version\x18\x0f \x01(\x05R\x10publishedVersion\x126\n" +
+	"\x17has_unpublished_changes\x18\x10 \x01(\bR\x15hasUnpublishedChangesB\r\n" +
 	"\v_company_idB\f\n" +
 	"\n" +
 	"_tenant_idB\x15\n" +
 	"\x13_created_by_user_idB\n" +
 	"\n" +
-	"\b_team_id\"\x87\x04\n" +
+	"\b_team_id\"\xb7\x05\n" +
 	"\fLibraryEntry\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12.\n" +
@@ -3004,46 +5878,70 @@ const file_mirai_v1_course_proto_rawDesc = "" +
 	"company_id\x18\n" +
 	" \x01(\tH\x02R\tcompanyId\x88\x01\x01\x12 \n" +
 	"\ttenant_id\x18\v \x01(\tH\x03R\btenantId\x88\x01\x01\x12\x1c\n" +
-	"\ateam_id\x18\f \x01(\tH\x04R\x06teamId\x88\x01\x01B\r\n" +
+	"\ateam_id\x18\f \x01(\tH\x04R\x06teamId\x88\x01\x01\x12:\n" +
+	"\vcaller_role\x18\r \x01(\x0e2\x14.mirai.v1.CourseRoleH\x05R\n" +
+	"callerRole\x88\x01\x01\x12*\n" +
+	"\x11created_by_active\x18\x0e \x01(\bR\x0fcreatedByActive\x126\n" +
+	"\x17has_unpublished_changes\x18\x0f \x01(\bR\x15hasUnpublishedChangesB\r\n" +
 	"\v_created_byB\x11\n" +
 	"\x0f_thumbnail_pathB\r\n" +
 	"\v_company_idB\f\n" +
 	"\n" +
 	"_tenant_idB\n" +
 	"\n" +
-	"\b_team_id\"\xed\x01\n" +
+	"\b_team_idB\x0e\n" +
+	"\f_caller_role\"\x82\x02\n" +
+	"\x12CourseCollaborator\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tcourse_id\x18\x02 \x01(\tR\bcourseId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12(\n" +
+	"\x04role\x18\x04 \x01(\x0e2\x14.mirai.v1.CourseRoleR\x04role\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12,\n" +
+	"\x10added_by_user_id\x18\x06 \x01(\tH\x00R\raddedByUserId\x88\x01\x01B\x13\n" +
+	"\x11_added_by_user_id\"\xba\x02\n" +
 	"\x06Folder\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
 	"\tparent_id\x18\x03 \x01(\tH\x00R\bparentId\x88\x01\x01\x12(\n" +
 	"\x04type\x18\x04 \x01(\x0e2\x14.mirai.v1.FolderTypeR\x04type\x12,\n" +
 	"\bchildren\x18\x05 \x03(\v2\x10.mirai.v1.FolderR\bchildren\x12&\n" +
-	"\fcourse_count\x18\x06 \x01(\x05H\x01R\vcourseCount\x88\x01\x01B\f\n" +
+	"\fcourse_count\x18\x06 \x01(\x05H\x01R\vcourseCount\x88\x01\x01\x12!\n" +
+	"\fis_protected\x18\a \x01(\bR\visProtected\x12\x1c\n" +
+	"\ateam_id\x18\b \x01(\tH\x02R\x06teamId\x88\x01\x01B\f\n" +
 	"\n" +
 	"_parent_idB\x0f\n" +
-	"\r_course_count\"\xc0\x01\n" +
+	"\r_course_countB\n" +
+	"\n" +
+	"\b_team_id\"\xc0\x01\n" +
 	"\aLibrary\x12\x18\n" +
 	"\aversion\x18\x01 \x01(\tR\aversion\x12=\n" +
 	"\flast_updated\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vlastUpdated\x120\n" +
 	"\acourses\x18\x03 \x03(\v2\x16.mirai.v1.LibraryEntryR\acourses\x12*\n" +
-	"\afolders\x18\x04 \x03(\v2\x10.mirai.v1.FolderR\afolders\"\xbe\x01\n" +
+	"\afolders\x18\x04 \x03(\v2\x10.mirai.v1.FolderR\afolders\"\xe0\x01\n" +
 	"\x12ListCoursesRequest\x123\n" +
 	"\x06status\x18\x01 \x01(\x0e2\x16.mirai.v1.CourseStatusH\x00R\x06status\x88\x01\x01\x12\x1b\n" +
 	"\x06folder\x18\x02 \x01(\tH\x01R\x06folder\x88\x01\x01\x12\x12\n" +
 	"\x04tags\x18\x03 \x03(\tR\x04tags\x12\x14\n" +
 	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x05 \x01(\x05R\x06offsetB\t\n" +
+	"\x06offset\x18\x05 \x01(\x05R\x06offset\x12\x17\n" +
+	"\x04mine\x18\x06 \x01(\bH\x02R\x04mine\x88\x01\x01B\t\n" +
 	"\a_statusB\t\n" +
-	"\a_folder\"\x83\x01\n" +
+	"\a_folderB\a\n" +
+	"\x05_mine\"\x83\x01\n" +
 	"\x13ListCoursesResponse\x120\n" +
 	"\acourses\x18\x01 \x03(\v2\x16.mirai.v1.LibraryEntryR\acourses\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
 	"totalCount\x12\x19\n" +
 	"\bhas_more\x18\x03 \x01(\bR\ahasMore\"\"\n" +
 	"\x10GetCourseRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"=\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xfa\x01\n" +
 	"\x11GetCourseResponse\x12(\n" +
-	"\x06course\x18\x01 \x01(\v2\x10.mirai.v1.CourseR\x06course\"\xa6\x03\n" +
+	"\x06course\x18\x01 \x01(\v2\x10.mirai.v1.CourseR\x06course\x12<\n" +
+	"\x18active_generation_job_id\x18\x02 \x01(\tH\x00R\x15activeGenerationJobId\x88\x01\x01\x12L\n" +
+	"\x0fgeneration_lock\x18\x03 \x01(\v2\x1e.mirai.v1.CourseGenerationLockH\x01R\x0egenerationLock\x88\x01\x01B\x1b\n" +
+	"\x19_active_generation_job_idB\x12\n" +
+	"\x10_generation_lock\"\xa6\x03\n" +
 	"\x13CreateCourseRequest\x12\x13\n" +
 	"\x02id\x18\x01 \x01(\tH\x00R\x02id\x88\x01\x01\x129\n" +
 	"\bsettings\x18\x02 \x01(\v2\x18.mirai.v1.CourseSettingsH\x01R\bsettings\x88\x01\x01\x12-\n" +
@@ -3055,9 +5953,10 @@ const file_mirai_v1_course_proto_rawDesc = "" +
 	"\t_settingsB\x16\n" +
 	"\x14_assessment_settingsB\n" +
 	"\n" +
-	"\b_content\"@\n" +
+	"\b_content\"k\n" +
 	"\x14CreateCourseResponse\x12(\n" +
-	"\x06course\x18\x01 \x01(\v2\x10.mirai.v1.CourseR\x06course\"\xa2\x04\n" +
+	"\x06course\x18\x01 \x01(\v2\x10.mirai.v1.CourseR\x06course\x12)\n" +
+	"\x10defaulted_fields\x18\x02 \x03(\tR\x0fdefaultedFields\"\xa2\x04\n" +
 	"\x13UpdateCourseRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x129\n" +
 	"\bsettings\x18\x02 \x01(\v2\x18.mirai.v1.CourseSettingsH\x00R\bsettings\x88\x01\x01\x12-\n" +
@@ -3072,9 +5971,12 @@ const file_mirai_v1_course_proto_rawDesc = "" +
 	"\n" +
 	"\b_contentB\t\n" +
 	"\a_statusB\v\n" +
-	"\t_metadata\"@\n" +
+	"\t_metadata\"\xa7\x01\n" +
 	"\x14UpdateCourseResponse\x12(\n" +
-	"\x06course\x18\x01 \x01(\v2\x10.mirai.v1.CourseR\x06course\"%\n" +
+	"\x06course\x18\x01 \x01(\v2\x10.mirai.v1.CourseR\x06course\x12,\n" +
+	"\x12content_size_bytes\x18\x02 \x01(\x03R\x10contentSizeBytes\x12&\n" +
+	"\fsize_warning\x18\x03 \x01(\tH\x00R\vsizeWarning\x88\x01\x01B\x0f\n" +
+	"\r_size_warning\"%\n" +
 	"\x13DeleteCourseRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"0\n" +
 	"\x14DeleteCourseResponse\x12\x18\n" +
@@ -3086,14 +5988,28 @@ const file_mirai_v1_course_proto_rawDesc = "" +
 	"\x11GetLibraryRequest\x122\n" +
 	"\x15include_course_counts\x18\x01 \x01(\bR\x13includeCourseCounts\"A\n" +
 	"\x12GetLibraryResponse\x12+\n" +
-	"\alibrary\x18\x01 \x01(\v2\x11.mirai.v1.LibraryR\alibrary\"\x83\x01\n" +
+	"\alibrary\x18\x01 \x01(\v2\x11.mirai.v1.LibraryR\alibrary\"\xad\x01\n" +
 	"\x13CreateFolderRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
 	"\tparent_id\x18\x02 \x01(\tH\x00R\bparentId\x88\x01\x01\x12(\n" +
-	"\x04type\x18\x03 \x01(\x0e2\x14.mirai.v1.FolderTypeR\x04typeB\f\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x14.mirai.v1.FolderTypeR\x04type\x12\x1c\n" +
+	"\ateam_id\x18\x04 \x01(\tH\x01R\x06teamId\x88\x01\x01B\f\n" +
 	"\n" +
-	"_parent_id\"@\n" +
+	"_parent_idB\n" +
+	"\n" +
+	"\b_team_id\"@\n" +
 	"\x14CreateFolderResponse\x12(\n" +
+	"\x06folder\x18\x01 \x01(\v2\x10.mirai.v1.FolderR\x06folder\"\xa9\x01\n" +
+	"\x13UpdateFolderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12-\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x14.mirai.v1.FolderTypeH\x01R\x04type\x88\x01\x01\x12\x1c\n" +
+	"\ateam_id\x18\x04 \x01(\tH\x02R\x06teamId\x88\x01\x01B\a\n" +
+	"\x05_nameB\a\n" +
+	"\x05_typeB\n" +
+	"\n" +
+	"\b_team_id\"@\n" +
+	"\x14UpdateFolderResponse\x12(\n" +
 	"\x06folder\x18\x01 \x01(\v2\x10.mirai.v1.FolderR\x06folder\"%\n" +
 	"\x13DeleteFolderRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"0\n" +
@@ -3117,7 +6033,173 @@ const file_mirai_v1_course_proto_rawDesc = "" +
 	"\x12ListExportsRequest\x12\x1b\n" +
 	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\"G\n" +
 	"\x13ListExportsResponse\x120\n" +
-	"\aexports\x18\x01 \x03(\v2\x16.mirai.v1.CourseExportR\aexports*\x80\x01\n" +
+	"\aexports\x18\x01 \x03(\v2\x16.mirai.v1.CourseExportR\aexports\"7\n" +
+	"\x18ListCollaboratorsRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\"_\n" +
+	"\x19ListCollaboratorsResponse\x12B\n" +
+	"\rcollaborators\x18\x01 \x03(\v2\x1c.mirai.v1.CourseCollaboratorR\rcollaborators\"x\n" +
+	"\x16AddCollaboratorRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12(\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x14.mirai.v1.CourseRoleR\x04role\"[\n" +
+	"\x17AddCollaboratorResponse\x12@\n" +
+	"\fcollaborator\x18\x01 \x01(\v2\x1c.mirai.v1.CourseCollaboratorR\fcollaborator\"Q\n" +
+	"\x19RemoveCollaboratorRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x1c\n" +
+	"\x1aRemoveCollaboratorResponse\"\x1c\n" +
+	"\x1aRemoveSampleContentRequest\"\xcc\x01\n" +
+	"\x1bRemoveSampleContentResponse\x12'\n" +
+	"\x0fcourses_removed\x18\x01 \x01(\x05R\x0ecoursesRemoved\x12'\n" +
+	"\x0ffolders_removed\x18\x02 \x01(\x05R\x0efoldersRemoved\x12!\n" +
+	"\ffolders_kept\x18\x03 \x01(\x05R\vfoldersKept\x128\n" +
+	"\x18target_audiences_removed\x18\x04 \x01(\x05R\x16targetAudiencesRemoved\"1\n" +
+	"\x19ListLargestCoursesRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\"\xaa\x01\n" +
+	"\n" +
+	"CourseSize\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12,\n" +
+	"\x12content_size_bytes\x18\x03 \x01(\x03R\x10contentSizeBytes\x12;\n" +
+	"\vmodified_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"modifiedAt\"\xa0\x01\n" +
+	"\x1aListLargestCoursesResponse\x12.\n" +
+	"\acourses\x18\x01 \x03(\v2\x14.mirai.v1.CourseSizeR\acourses\x12(\n" +
+	"\x10soft_limit_bytes\x18\x02 \x01(\x03R\x0esoftLimitBytes\x12(\n" +
+	"\x10hard_limit_bytes\x18\x03 \x01(\x03R\x0ehardLimitBytes\"4\n" +
+	"\x15PublishChangesRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\"B\n" +
+	"\x16PublishChangesResponse\x12(\n" +
+	"\x06course\x18\x01 \x01(\v2\x10.mirai.v1.CourseR\x06course\"2\n" +
+	"\x13DiscardDraftRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\"k\n" +
+	"\x14DiscardDraftResponse\x12(\n" +
+	"\x06course\x18\x01 \x01(\v2\x10.mirai.v1.CourseR\x06course\x12)\n" +
+	"\x10lessons_restored\x18\x02 \x01(\x05R\x0flessonsRestored\"N\n" +
+	"\x19SearchWithinCourseRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\"\xf9\x02\n" +
+	"\x11CourseSearchMatch\x124\n" +
+	"\x06source\x18\x01 \x01(\x0e2\x1c.mirai.v1.CourseSearchSourceR\x06source\x12\x1d\n" +
+	"\n" +
+	"section_id\x18\x02 \x01(\tR\tsectionId\x12#\n" +
+	"\rsection_title\x18\x03 \x01(\tR\fsectionTitle\x12\x1b\n" +
+	"\tlesson_id\x18\x04 \x01(\tR\blessonId\x12!\n" +
+	"\flesson_title\x18\x05 \x01(\tR\vlessonTitle\x12\x19\n" +
+	"\bblock_id\x18\x06 \x01(\tR\ablockId\x12!\n" +
+	"\fcomponent_id\x18\a \x01(\tR\vcomponentId\x12\x18\n" +
+	"\asnippet\x18\b \x01(\tR\asnippet\x12'\n" +
+	"\x0fhighlight_start\x18\t \x01(\x05R\x0ehighlightStart\x12)\n" +
+	"\x10highlight_length\x18\n" +
+	" \x01(\x05R\x0fhighlightLength\"q\n" +
+	"\x1aSearchWithinCourseResponse\x125\n" +
+	"\amatches\x18\x01 \x03(\v2\x1b.mirai.v1.CourseSearchMatchR\amatches\x12\x1c\n" +
+	"\ttruncated\x18\x02 \x01(\bR\ttruncated\"\xa9\x04\n" +
+	"\x10CourseAttachment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tcourse_id\x18\x02 \x01(\tR\bcourseId\x12\x1b\n" +
+	"\tfile_name\x18\x03 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x05 \x01(\x03R\tsizeBytes\x128\n" +
+	"\x06status\x18\x06 \x01(\x0e2 .mirai.v1.CourseAttachmentStatusR\x06status\x12(\n" +
+	"\rerror_message\x18\a \x01(\tH\x00R\ferrorMessage\x88\x01\x01\x12\x1f\n" +
+	"\vchunk_count\x18\b \x01(\x05R\n" +
+	"chunkCount\x12.\n" +
+	"\x13flagged_chunk_count\x18\t \x01(\x05R\x11flaggedChunkCount\x122\n" +
+	"\x15excluded_from_prompts\x18\n" +
+	" \x01(\bR\x13excludedFromPrompts\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12B\n" +
+	"\fprocessed_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampH\x01R\vprocessedAt\x88\x01\x01B\x10\n" +
+	"\x0e_error_messageB\x0f\n" +
+	"\r_processed_at\"\xa1\x01\n" +
+	"#GetCourseAttachmentUploadURLRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x1b\n" +
+	"\tfile_name\x18\x02 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x04 \x01(\x03R\tsizeBytes\"b\n" +
+	"$GetCourseAttachmentUploadURLResponse\x12\x1d\n" +
+	"\n" +
+	"upload_url\x18\x01 \x01(\tR\tuploadUrl\x12\x1b\n" +
+	"\tfile_path\x18\x02 \x01(\tR\bfilePath\"}\n" +
+	"\x1eConfirmCourseAttachmentRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x1b\n" +
+	"\tfile_path\x18\x02 \x01(\tR\bfilePath\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\"]\n" +
+	"\x1fConfirmCourseAttachmentResponse\x12:\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x1a.mirai.v1.CourseAttachmentR\n" +
+	"attachment\";\n" +
+	"\x1cListCourseAttachmentsRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\"]\n" +
+	"\x1dListCourseAttachmentsResponse\x12<\n" +
+	"\vattachments\x18\x01 \x03(\v2\x1a.mirai.v1.CourseAttachmentR\vattachments\"e\n" +
+	"\"SetCourseAttachmentExcludedRequest\x12#\n" +
+	"\rattachment_id\x18\x01 \x01(\tR\fattachmentId\x12\x1a\n" +
+	"\bexcluded\x18\x02 \x01(\bR\bexcluded\"a\n" +
+	"#SetCourseAttachmentExcludedResponse\x12:\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x1a.mirai.v1.CourseAttachmentR\n" +
+	"attachment\"D\n" +
+	"\x1dDeleteCourseAttachmentRequest\x12#\n" +
+	"\rattachment_id\x18\x01 \x01(\tR\fattachmentId\" \n" +
+	"\x1eDeleteCourseAttachmentResponse\"f\n" +
+	"\x14CourseGenerationLock\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x127\n" +
+	"\tlocked_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\blockedAt\"\x9f\x01\n" +
+	"\n" +
+	"CourseCard\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12.\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x16.mirai.v1.CourseStatusR\x06status\x12;\n" +
+	"\vmodified_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"modifiedAt\"\xbe\x01\n" +
+	"\x16ListCourseCardsRequest\x123\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x16.mirai.v1.CourseStatusH\x00R\x06status\x88\x01\x01\x12\x1b\n" +
+	"\x06folder\x18\x02 \x01(\tH\x01R\x06folder\x88\x01\x01\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x1b\n" +
+	"\x06cursor\x18\x04 \x01(\tH\x02R\x06cursor\x88\x01\x01B\t\n" +
+	"\a_statusB\t\n" +
+	"\a_folderB\t\n" +
+	"\a_cursor\"{\n" +
+	"\x17ListCourseCardsResponse\x12*\n" +
+	"\x05cards\x18\x01 \x03(\v2\x14.mirai.v1.CourseCardR\x05cards\x12$\n" +
+	"\vnext_cursor\x18\x02 \x01(\tH\x00R\n" +
+	"nextCursor\x88\x01\x01B\x0e\n" +
+	"\f_next_cursor\"\xb7\x02\n" +
+	"\x11CourseCardDetails\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x12\n" +
+	"\x04tags\x18\x02 \x03(\tR\x04tags\x12(\n" +
+	"\rthumbnail_url\x18\x03 \x01(\tH\x00R\fthumbnailUrl\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x04 \x01(\tR\tcreatedBy\x12+\n" +
+	"\x0fcreated_by_name\x18\x05 \x01(\tH\x01R\rcreatedByName\x88\x01\x01\x12*\n" +
+	"\x11created_by_active\x18\x06 \x01(\bR\x0fcreatedByActive\x12)\n" +
+	"\x10duration_minutes\x18\a \x01(\x05R\x0fdurationMinutesB\x10\n" +
+	"\x0e_thumbnail_urlB\x12\n" +
+	"\x10_created_by_name\"<\n" +
+	"\x1bGetCourseCardDetailsRequest\x12\x1d\n" +
+	"\n" +
+	"course_ids\x18\x01 \x03(\tR\tcourseIds\"U\n" +
+	"\x1cGetCourseCardDetailsResponse\x125\n" +
+	"\adetails\x18\x01 \x03(\v2\x1b.mirai.v1.CourseCardDetailsR\adetails\"\xa4\x01\n" +
+	"\x0eCoursePresence\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\x18\n" +
+	"\aediting\x18\x03 \x01(\bR\aediting\x12<\n" +
+	"\flast_seen_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastSeenAt\"W\n" +
+	"\x1eHeartbeatCoursePresenceRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x18\n" +
+	"\aediting\x18\x02 \x01(\bR\aediting\"S\n" +
+	"\x1fHeartbeatCoursePresenceResponse\x120\n" +
+	"\x06others\x18\x01 \x03(\v2\x18.mirai.v1.CoursePresenceR\x06others\"7\n" +
+	"\x18GetCoursePresenceRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\"M\n" +
+	"\x19GetCoursePresenceResponse\x120\n" +
+	"\x06others\x18\x01 \x03(\v2\x18.mirai.v1.CoursePresenceR\x06others*\x80\x01\n" +
 	"\fCourseStatus\x12\x1d\n" +
 	"\x19COURSE_STATUS_UNSPECIFIED\x10\x00\x12\x17\n" +
 	"\x13COURSE_STATUS_DRAFT\x10\x01\x12\x1b\n" +
@@ -3135,19 +6217,41 @@ const file_mirai_v1_course_proto_rawDesc = "" +
 	"\x13FOLDER_TYPE_LIBRARY\x10\x01\x12\x14\n" +
 	"\x10FOLDER_TYPE_TEAM\x10\x02\x12\x18\n" +
 	"\x14FOLDER_TYPE_PERSONAL\x10\x03\x12\x16\n" +
-	"\x12FOLDER_TYPE_FOLDER\x10\x04*\x96\x01\n" +
+	"\x12FOLDER_TYPE_FOLDER\x10\x04*\xb6\x01\n" +
 	"\fExportFormat\x12\x1d\n" +
 	"\x19EXPORT_FORMAT_UNSPECIFIED\x10\x00\x12\x1a\n" +
 	"\x16EXPORT_FORMAT_SCORM_12\x10\x01\x12\x1c\n" +
 	"\x18EXPORT_FORMAT_SCORM_2004\x10\x02\x12\x16\n" +
 	"\x12EXPORT_FORMAT_XAPI\x10\x03\x12\x15\n" +
-	"\x11EXPORT_FORMAT_PDF\x10\x04*\x9d\x01\n" +
+	"\x11EXPORT_FORMAT_PDF\x10\x04\x12\x1e\n" +
+	"\x1aEXPORT_FORMAT_MARKDOWN_ZIP\x10\x05*\xb7\x01\n" +
 	"\fExportStatus\x12\x1d\n" +
 	"\x19EXPORT_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
 	"\x15EXPORT_STATUS_PENDING\x10\x01\x12\x1c\n" +
 	"\x18EXPORT_STATUS_PROCESSING\x10\x02\x12\x1b\n" +
 	"\x17EXPORT_STATUS_COMPLETED\x10\x03\x12\x18\n" +
-	"\x14EXPORT_STATUS_FAILED\x10\x042\x9e\b\n" +
+	"\x14EXPORT_STATUS_FAILED\x10\x04\x12\x18\n" +
+	"\x14EXPORT_STATUS_PURGED\x10\x05*p\n" +
+	"\n" +
+	"CourseRole\x12\x1b\n" +
+	"\x17COURSE_ROLE_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11COURSE_ROLE_OWNER\x10\x01\x12\x16\n" +
+	"\x12COURSE_ROLE_EDITOR\x10\x02\x12\x16\n" +
+	"\x12COURSE_ROLE_VIEWER\x10\x03*\x89\x01\n" +
+	"\x12CourseSearchSource\x12$\n" +
+	" COURSE_SEARCH_SOURCE_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1eCOURSE_SEARCH_SOURCE_AUTHORING\x10\x01\x12)\n" +
+	"%COURSE_SEARCH_SOURCE_LESSON_COMPONENT\x10\x02*\xda\x01\n" +
+	"\x16CourseAttachmentStatus\x12(\n" +
+	"$COURSE_ATTACHMENT_STATUS_UNSPECIFIED\x10\x00\x12$\n" +
+	" COURSE_ATTACHMENT_STATUS_PENDING\x10\x01\x12'\n" +
+	"#COURSE_ATTACHMENT_STATUS_PROCESSING\x10\x02\x12\"\n" +
+	"\x1eCOURSE_ATTACHMENT_STATUS_READY\x10\x03\x12#\n" +
+	"\x1fCOURSE_ATTACHMENT_STATUS_FAILED\x10\x04*}\n" +
+	"\x11ExportPurgeReason\x12#\n" +
+	"\x1fEXPORT_PURGE_REASON_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bEXPORT_PURGE_REASON_EXPIRED\x10\x01\x12\"\n" +
+	"\x1eEXPORT_PURGE_REASON_SUPERSEDED\x10\x022\x9d\x16\n" +
 	"\rCourseService\x12J\n" +
 	"\vListCourses\x12\x1c.mirai.v1.ListCoursesRequest\x1a\x1d.mirai.v1.ListCoursesResponse\x12D\n" +
 	"\tGetCourse\x12\x1a.mirai.v1.GetCourseRequest\x1a\x1b.mirai.v1.GetCourseResponse\x12M\n" +
@@ -3158,11 +6262,29 @@ const file_mirai_v1_course_proto_rawDesc = "" +
 	"\n" +
 	"GetLibrary\x12\x1b.mirai.v1.GetLibraryRequest\x1a\x1c.mirai.v1.GetLibraryResponse\x12M\n" +
 	"\fCreateFolder\x12\x1d.mirai.v1.CreateFolderRequest\x1a\x1e.mirai.v1.CreateFolderResponse\x12M\n" +
+	"\fUpdateFolder\x12\x1d.mirai.v1.UpdateFolderRequest\x1a\x1e.mirai.v1.UpdateFolderResponse\x12M\n" +
 	"\fDeleteFolder\x12\x1d.mirai.v1.DeleteFolderRequest\x1a\x1e.mirai.v1.DeleteFolderResponse\x12M\n" +
 	"\fExportCourse\x12\x1d.mirai.v1.ExportCourseRequest\x1a\x1e.mirai.v1.ExportCourseResponse\x12V\n" +
 	"\x0fGetExportStatus\x12 .mirai.v1.GetExportStatusRequest\x1a!.mirai.v1.GetExportStatusResponse\x12S\n" +
 	"\x0eDownloadExport\x12\x1f.mirai.v1.DownloadExportRequest\x1a .mirai.v1.DownloadExportResponse\x12J\n" +
-	"\vListExports\x12\x1c.mirai.v1.ListExportsRequest\x1a\x1d.mirai.v1.ListExportsResponseB\x91\x01\n" +
+	"\vListExports\x12\x1c.mirai.v1.ListExportsRequest\x1a\x1d.mirai.v1.ListExportsResponse\x12\\\n" +
+	"\x11ListCollaborators\x12\".mirai.v1.ListCollaboratorsRequest\x1a#.mirai.v1.ListCollaboratorsResponse\x12V\n" +
+	"\x0fAddCollaborator\x12 .mirai.v1.AddCollaboratorRequest\x1a!.mirai.v1.AddCollaboratorResponse\x12_\n" +
+	"\x12RemoveCollaborator\x12#.mirai.v1.RemoveCollaboratorRequest\x1a$.mirai.v1.RemoveCollaboratorResponse\x12b\n" +
+	"\x13RemoveSampleContent\x12$.mirai.v1.RemoveSampleContentRequest\x1a%.mirai.v1.RemoveSampleContentResponse\x12_\n" +
+	"\x12ListLargestCourses\x12#.mirai.v1.ListLargestCoursesRequest\x1a$.mirai.v1.ListLargestCoursesResponse\x12S\n" +
+	"\x0ePublishChanges\x12\x1f.mirai.v1.PublishChangesRequest\x1a .mirai.v1.PublishChangesResponse\x12M\n" +
+	"\fDiscardDraft\x12\x1d.mirai.v1.DiscardDraftRequest\x1a\x1e.mirai.v1.DiscardDraftResponse\x12_\n" +
+	"\x12SearchWithinCourse\x12#.mirai.v1.SearchWithinCourseRequest\x1a$.mirai.v1.SearchWithinCourseResponse\x12}\n" +
+	"\x1cGetCourseAttachmentUploadURL\x12-.mirai.v1.GetCourseAttachmentUploadURLRequest\x1a..mirai.v1.GetCourseAttachmentUploadURLResponse\x12n\n" +
+	"\x17ConfirmCourseAttachment\x12(.mirai.v1.ConfirmCourseAttachmentRequest\x1a).mirai.v1.ConfirmCourseAttachmentResponse\x12h\n" +
+	"\x15ListCourseAttachments\x12&.mirai.v1.ListCourseAttachmentsRequest\x1a'.mirai.v1.ListCourseAttachmentsResponse\x12z\n" +
+	"\x1bSetCourseAttachmentExcluded\x12,.mirai.v1.SetCourseAttachmentExcludedRequest\x1a-.mirai.v1.SetCourseAttachmentExcludedResponse\x12k\n" +
+	"\x16DeleteCourseAttachment\x12'.mirai.v1.DeleteCourseAttachmentRequest\x1a(.mirai.v1.DeleteCourseAttachmentResponse\x12V\n" +
+	"\x0fListCourseCards\x12 .mirai.v1.ListCourseCardsRequest\x1a!.mirai.v1.ListCourseCardsResponse\x12e\n" +
+	"\x14GetCourseCardDetails\x12%.mirai.v1.GetCourseCardDetailsRequest\x1a&.mirai.v1.GetCourseCardDetailsResponse\x12n\n" +
+	"\x17HeartbeatCoursePresence\x12(.mirai.v1.HeartbeatCoursePresenceRequest\x1a).mirai.v1.HeartbeatCoursePresenceResponse\x12\\\n" +
+	"\x11GetCoursePresence\x12\".mirai.v1.GetCoursePresenceRequest\x1a#.mirai.v1.GetCoursePresenceResponseB\x91\x01\n" +
 	"\fcom.mirai.v1B\vCourseProtoP\x01Z3github.com/sogos/mirai-backend/gen/mirai/v1;miraiv1\xa2\x02\x03MXX\xaa\x02\bMirai.V1\xca\x02\bMirai\\V1\xe2\x02\x14Mirai\\V1\\GPBMetadata\xea\x02\tMirai::V1b\x06proto3"
 
 var (
@@ -3177,144 +6299,261 @@ func file_mirai_v1_course_proto_rawDescGZIP() []byte {
 	return file_mirai_v1_course_proto_rawDescData
 }
 
-var file_mirai_v1_course_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_mirai_v1_course_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
+var file_mirai_v1_course_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_mirai_v1_course_proto_msgTypes = make([]protoimpl.MessageInfo, 85)
 var file_mirai_v1_course_proto_goTypes = []any{
-	(CourseStatus)(0),                  // 0: mirai.v1.CourseStatus
-	(BlockType)(0),                     // 1: mirai.v1.BlockType
-	(FolderType)(0),                    // 2: mirai.v1.FolderType
-	(ExportFormat)(0),                  // 3: mirai.v1.ExportFormat
-	(ExportStatus)(0),                  // 4: mirai.v1.ExportStatus
-	(*LearningObjective)(nil),          // 5: mirai.v1.LearningObjective
-	(*Persona)(nil),                    // 6: mirai.v1.Persona
-	(*BlockAlignment)(nil),             // 7: mirai.v1.BlockAlignment
-	(*CourseBlock)(nil),                // 8: mirai.v1.CourseBlock
-	(*Lesson)(nil),                     // 9: mirai.v1.Lesson
-	(*CourseSection)(nil),              // 10: mirai.v1.CourseSection
-	(*AssessmentSettings)(nil),         // 11: mirai.v1.AssessmentSettings
-	(*CourseContent)(nil),              // 12: mirai.v1.CourseContent
-	(*CourseExport)(nil),               // 13: mirai.v1.CourseExport
-	(*CourseSettings)(nil),             // 14: mirai.v1.CourseSettings
-	(*CourseMetadata)(nil),             // 15: mirai.v1.CourseMetadata
-	(*Course)(nil),                     // 16: mirai.v1.Course
-	(*LibraryEntry)(nil),               // 17: mirai.v1.LibraryEntry
-	(*Folder)(nil),                     // 18: mirai.v1.Folder
-	(*Library)(nil),                    // 19: mirai.v1.Library
-	(*ListCoursesRequest)(nil),         // 20: mirai.v1.ListCoursesRequest
-	(*ListCoursesResponse)(nil),        // 21: mirai.v1.ListCoursesResponse
-	(*GetCourseRequest)(nil),           // 22: mirai.v1.GetCourseRequest
-	(*GetCourseResponse)(nil),          // 23: mirai.v1.GetCourseResponse
-	(*CreateCourseRequest)(nil),        // 24: mirai.v1.CreateCourseRequest
-	(*CreateCourseResponse)(nil),       // 25: mirai.v1.CreateCourseResponse
-	(*UpdateCourseRequest)(nil),        // 26: mirai.v1.UpdateCourseRequest
-	(*UpdateCourseResponse)(nil),       // 27: mirai.v1.UpdateCourseResponse
-	(*DeleteCourseRequest)(nil),        // 28: mirai.v1.DeleteCourseRequest
-	(*DeleteCourseResponse)(nil),       // 29: mirai.v1.DeleteCourseResponse
-	(*GetFolderHierarchyRequest)(nil),  // 30: mirai.v1.GetFolderHierarchyRequest
-	(*GetFolderHierarchyResponse)(nil), // 31: mirai.v1.GetFolderHierarchyResponse
-	(*GetLibraryRequest)(nil),          // 32: mirai.v1.GetLibraryRequest
-	(*GetLibraryResponse)(nil),         // 33: mirai.v1.GetLibraryResponse
-	(*CreateFolderRequest)(nil),        // 34: mirai.v1.CreateFolderRequest
-	(*CreateFolderResponse)(nil),       // 35: mirai.v1.CreateFolderResponse
-	(*DeleteFolderRequest)(nil),        // 36: mirai.v1.DeleteFolderRequest
-	(*DeleteFolderResponse)(nil),       // 37: mirai.v1.DeleteFolderResponse
-	(*ExportCourseRequest)(nil),        // 38: mirai.v1.ExportCourseRequest
-	(*ExportCourseResponse)(nil),       // 39: mirai.v1.ExportCourseResponse
-	(*GetExportStatusRequest)(nil),     // 40: mirai.v1.GetExportStatusRequest
-	(*GetExportStatusResponse)(nil),    // 41: mirai.v1.GetExportStatusResponse
-	(*DownloadExportRequest)(nil),      // 42: mirai.v1.DownloadExportRequest
-	(*DownloadExportResponse)(nil),     // 43: mirai.v1.DownloadExportResponse
-	(*ListExportsRequest)(nil),         // 44: mirai.v1.ListExportsRequest
-	(*ListExportsResponse)(nil),        // 45: mirai.v1.ListExportsResponse
-	(*timestamppb.Timestamp)(nil),      // 46: google.protobuf.Timestamp
+	(CourseStatus)(0),                            // 0: mirai.v1.CourseStatus
+	(BlockType)(0),                               // 1: mirai.v1.BlockType
+	(FolderType)(0),                              // 2: mirai.v1.FolderType
+	(ExportFormat)(0),                            // 3: mirai.v1.ExportFormat
+	(ExportStatus)(0),                            // 4: mirai.v1.ExportStatus
+	(CourseRole)(0),                              // 5: mirai.v1.CourseRole
+	(CourseSearchSource)(0),                      // 6: mirai.v1.CourseSearchSource
+	(CourseAttachmentStatus)(0),                  // 7: mirai.v1.CourseAttachmentStatus
+	(ExportPurgeReason)(0),                       // 8: mirai.v1.ExportPurgeReason
+	(*LearningObjective)(nil),                    // 9: mirai.v1.LearningObjective
+	(*Persona)(nil),                              // 10: mirai.v1.Persona
+	(*BlockAlignment)(nil),                       // 11: mirai.v1.BlockAlignment
+	(*CourseBlock)(nil),                          // 12: mirai.v1.CourseBlock
+	(*Lesson)(nil),                               // 13: mirai.v1.Lesson
+	(*CourseSection)(nil),                        // 14: mirai.v1.CourseSection
+	(*AssessmentSettings)(nil),                   // 15: mirai.v1.AssessmentSettings
+	(*CourseContent)(nil),                        // 16: mirai.v1.CourseContent
+	(*CourseExport)(nil),                         // 17: mirai.v1.CourseExport
+	(*CourseSettings)(nil),                       // 18: mirai.v1.CourseSettings
+	(*CourseMetadata)(nil),                       // 19: mirai.v1.CourseMetadata
+	(*Course)(nil),                               // 20: mirai.v1.Course
+	(*LibraryEntry)(nil),                         // 21: mirai.v1.LibraryEntry
+	(*CourseCollaborator)(nil),                   // 22: mirai.v1.CourseCollaborator
+	(*Folder)(nil),                               // 23: mirai.v1.Folder
+	(*Library)(nil),                              // 24: mirai.v1.Library
+	(*ListCoursesRequest)(nil),                   // 25: mirai.v1.ListCoursesRequest
+	(*ListCoursesResponse)(nil),                  // 26: mirai.v1.ListCoursesResponse
+	(*GetCourseRequest)(nil),                     // 27: mirai.v1.GetCourseRequest
+	(*GetCourseResponse)(nil),                    // 28: mirai.v1.GetCourseResponse
+	(*CreateCourseRequest)(nil),                  // 29: mirai.v1.CreateCourseRequest
+	(*CreateCourseResponse)(nil),                 // 30: mirai.v1.CreateCourseResponse
+	(*UpdateCourseRequest)(nil),                  // 31: mirai.v1.UpdateCourseRequest
+	(*UpdateCourseResponse)(nil),                 // 32: mirai.v1.UpdateCourseResponse
+	(*DeleteCourseRequest)(nil),                  // 33: mirai.v1.DeleteCourseRequest
+	(*DeleteCourseResponse)(nil),                 // 34: mirai.v1.DeleteCourseResponse
+	(*GetFolderHierarchyRequest)(nil),            // 35: mirai.v1.GetFolderHierarchyRequest
+	(*GetFolderHierarchyResponse)(nil),           // 36: mirai.v1.GetFolderHierarchyResponse
+	(*GetLibraryRequest)(nil),                    // 37: mirai.v1.GetLibraryRequest
+	(*GetLibraryResponse)(nil),                   // 38: mirai.v1.GetLibraryResponse
+	(*CreateFolderRequest)(nil),                  // 39: mirai.v1.CreateFolderRequest
+	(*CreateFolderResponse)(nil),                 // 40: mirai.v1.CreateFolderResponse
+	(*UpdateFolderRequest)(nil),                  // 41: mirai.v1.UpdateFolderRequest
+	(*UpdateFolderResponse)(nil),                 // 42: mirai.v1.UpdateFolderResponse
+	(*DeleteFolderRequest)(nil),                  // 43: mirai.v1.DeleteFolderRequest
+	(*DeleteFolderResponse)(nil),                 // 44: mirai.v1.DeleteFolderResponse
+	(*ExportCourseRequest)(nil),                  // 45: mirai.v1.ExportCourseRequest
+	(*ExportCourseResponse)(nil),                 // 46: mirai.v1.ExportCourseResponse
+	(*GetExportStatusRequest)(nil),               // 47: mirai.v1.GetExportStatusRequest
+	(*GetExportStatusResponse)(nil),              // 48: mirai.v1.GetExportStatusResponse
+	(*DownloadExportRequest)(nil),                // 49: mirai.v1.DownloadExportRequest
+	(*DownloadExportResponse)(nil),               // 50: mirai.v1.DownloadExportResponse
+	(*ListExportsRequest)(nil),                   // 51: mirai.v1.ListExportsRequest
+	(*ListExportsResponse)(nil),                  // 52: mirai.v1.ListExportsResponse
+	(*ListCollaboratorsRequest)(nil),             // 53: mirai.v1.ListCollaboratorsRequest
+	(*ListCollaboratorsResponse)(nil),            // 54: mirai.v1.ListCollaboratorsResponse
+	(*AddCollaboratorRequest)(nil),               // 55: mirai.v1.AddCollaboratorRequest
+	(*AddCollaboratorResponse)(nil),              // 56: mirai.v1.AddCollaboratorResponse
+	(*RemoveCollaboratorRequest)(nil),            // 57: mirai.v1.RemoveCollaboratorRequest
+	(*RemoveCollaboratorResponse)(nil),           // 58: mirai.v1.RemoveCollaboratorResponse
+	(*RemoveSampleContentRequest)(nil),           // 59: mirai.v1.RemoveSampleContentRequest
+	(*RemoveSampleContentResponse)(nil),          // 60: mirai.v1.RemoveSampleContentResponse
+	(*ListLargestCoursesRequest)(nil),            // 61: mirai.v1.ListLargestCoursesRequest
+	(*CourseSize)(nil),                           // 62: mirai.v1.CourseSize
+	(*ListLargestCoursesResponse)(nil),           // 63: mirai.v1.ListLargestCoursesResponse
+	(*PublishChangesRequest)(nil),                // 64: mirai.v1.PublishChangesRequest
+	(*PublishChangesResponse)(nil),               // 65: mirai.v1.PublishChangesResponse
+	(*DiscardDraftRequest)(nil),                  // 66: mirai.v1.DiscardDraftRequest
+	(*DiscardDraftResponse)(nil),                 // 67: mirai.v1.DiscardDraftResponse
+	(*SearchWithinCourseRequest)(nil),            // 68: mirai.v1.SearchWithinCourseRequest
+	(*CourseSearchMatch)(nil),                    // 69: mirai.v1.CourseSearchMatch
+	(*SearchWithinCourseResponse)(nil),           // 70: mirai.v1.SearchWithinCourseResponse
+	(*CourseAttachment)(nil),                     // 71: mirai.v1.CourseAttachment
+	(*GetCourseAttachmentUploadURLRequest)(nil),  // 72: mirai.v1.GetCourseAttachmentUploadURLRequest
+	(*GetCourseAttachmentUploadURLResponse)(nil), // 73: mirai.v1.GetCourseAttachmentUploadURLResponse
+	(*ConfirmCourseAttachmentRequest)(nil),       // 74: mirai.v1.ConfirmCourseAttachmentRequest
+	(*ConfirmCourseAttachmentResponse)(nil),      // 75: mirai.v1.ConfirmCourseAttachmentResponse
+	(*ListCourseAttachmentsRequest)(nil),         // 76: mirai.v1.ListCourseAttachmentsRequest
+	(*ListCourseAttachmentsResponse)(nil),        // 77: mirai.v1.ListCourseAttachmentsResponse
+	(*SetCourseAttachmentExcludedRequest)(nil),   // 78: mirai.v1.SetCourseAttachmentExcludedRequest
+	(*SetCourseAttachmentExcludedResponse)(nil),  // 79: mirai.v1.SetCourseAttachmentExcludedResponse
+	(*DeleteCourseAttachmentRequest)(nil),        // 80: mirai.v1.DeleteCourseAttachmentRequest
+	(*DeleteCourseAttachmentResponse)(nil),       // 81: mirai.v1.DeleteCourseAttachmentResponse
+	(*CourseGenerationLock)(nil),                 // 82: mirai.v1.CourseGenerationLock
+	(*CourseCard)(nil),                           // 83: mirai.v1.CourseCard
+	(*ListCourseCardsRequest)(nil),               // 84: mirai.v1.ListCourseCardsRequest
+	(*ListCourseCardsResponse)(nil),              // 85: mirai.v1.ListCourseCardsResponse
+	(*CourseCardDetails)(nil),                    // 86: mirai.v1.CourseCardDetails
+	(*GetCourseCardDetailsRequest)(nil),          // 87: mirai.v1.GetCourseCardDetailsRequest
+	(*GetCourseCardDetailsResponse)(nil),         // 88: mirai.v1.GetCourseCardDetailsResponse
+	(*CoursePresence)(nil),                       // 89: mirai.v1.CoursePresence
+	(*HeartbeatCoursePresenceRequest)(nil),       // 90: mirai.v1.HeartbeatCoursePresenceRequest
+	(*HeartbeatCoursePresenceResponse)(nil),      // 91: mirai.v1.HeartbeatCoursePresenceResponse
+	(*GetCoursePresenceRequest)(nil),             // 92: mirai.v1.GetCoursePresenceRequest
+	(*GetCoursePresenceResponse)(nil),            // 93: mirai.v1.GetCoursePresenceResponse
+	(*timestamppb.Timestamp)(nil),                // 94: google.protobuf.Timestamp
 }
 var file_mirai_v1_course_proto_depIdxs = []int32{
-	5,  // 0: mirai.v1.Persona.learning_objectives:type_name -> mirai.v1.LearningObjective
-	1,  // 1: mirai.v1.CourseBlock.type:type_name -> mirai.v1.BlockType
-	7,  // 2: mirai.v1.CourseBlock.alignment:type_name -> mirai.v1.BlockAlignment
-	8,  // 3: mirai.v1.Lesson.blocks:type_name -> mirai.v1.CourseBlock
-	9,  // 4: mirai.v1.CourseSection.lessons:type_name -> mirai.v1.Lesson
-	10, // 5: mirai.v1.CourseContent.sections:type_name -> mirai.v1.CourseSection
-	8,  // 6: mirai.v1.CourseContent.course_blocks:type_name -> mirai.v1.CourseBlock
-	46, // 7: mirai.v1.CourseExport.timestamp:type_name -> google.protobuf.Timestamp
-	3,  // 8: mirai.v1.CourseExport.format:type_name -> mirai.v1.ExportFormat
-	4,  // 9: mirai.v1.CourseExport.status:type_name -> mirai.v1.ExportStatus
-	0,  // 10: mirai.v1.CourseMetadata.status:type_name -> mirai.v1.CourseStatus
-	46, // 11: mirai.v1.CourseMetadata.created_at:type_name -> google.protobuf.Timestamp
-	46, // 12: mirai.v1.CourseMetadata.modified_at:type_name -> google.protobuf.Timestamp
-	0,  // 13: mirai.v1.Course.status:type_name -> mirai.v1.CourseStatus
-	15, // 14: mirai.v1.Course.metadata:type_name -> mirai.v1.CourseMetadata
-	14, // 15: mirai.v1.Course.settings:type_name -> mirai.v1.CourseSettings
-	6,  // 16: mirai.v1.Course.personas:type_name -> mirai.v1.Persona
-	5,  // 17: mirai.v1.Course.learning_objectives:type_name -> mirai.v1.LearningObjective
-	11, // 18: mirai.v1.Course.assessment_settings:type_name -> mirai.v1.AssessmentSettings
-	12, // 19: mirai.v1.Course.content:type_name -> mirai.v1.CourseContent
-	13, // 20: mirai.v1.Course.exports:type_name -> mirai.v1.CourseExport
-	0,  // 21: mirai.v1.LibraryEntry.status:type_name -> mirai.v1.CourseStatus
-	46, // 22: mirai.v1.LibraryEntry.created_at:type_name -> google.protobuf.Timestamp
-	46, // 23: mirai.v1.LibraryEntry.modified_at:type_name -> google.protobuf.Timestamp
-	2,  // 24: mirai.v1.Folder.type:type_name -> mirai.v1.FolderType
-	18, // 25: mirai.v1.Folder.children:type_name -> mirai.v1.Folder
-	46, // 26: mirai.v1.Library.last_updated:type_name -> google.protobuf.Timestamp
-	17, // 27: mirai.v1.Library.courses:type_name -> mirai.v1.LibraryEntry
-	18, // 28: mirai.v1.Library.folders:type_name -> mirai.v1.Folder
-	0,  // 29: mirai.v1.ListCoursesRequest.status:type_name -> mirai.v1.CourseStatus
-	17, // 30: mirai.v1.ListCoursesResponse.courses:type_name -> mirai.v1.LibraryEntry
-	16, // 31: mirai.v1.GetCourseResponse.course:type_name -> mirai.v1.Course
-	14, // 32: mirai.v1.CreateCourseRequest.settings:type_name -> mirai.v1.CourseSettings
-	6,  // 33: mirai.v1.CreateCourseRequest.personas:type_name -> mirai.v1.Persona
-	5,  // 34: mirai.v1.CreateCourseRequest.learning_objectives:type_name -> mirai.v1.LearningObjective
-	11, // 35: mirai.v1.CreateCourseRequest.assessment_settings:type_name -> mirai.v1.AssessmentSettings
-	12, // 36: mirai.v1.CreateCourseRequest.content:type_name -> mirai.v1.CourseContent
-	16, // 37: mirai.v1.CreateCourseResponse.course:type_name -> mirai.v1.Course
-	14, // 38: mirai.v1.UpdateCourseRequest.settings:type_name -> mirai.v1.CourseSettings
-	6,  // 39: mirai.v1.UpdateCourseRequest.personas:type_name -> mirai.v1.Persona
-	5,  // 40: mirai.v1.UpdateCourseRequest.learning_objectives:type_name -> mirai.v1.LearningObjective
-	11, // 41: mirai.v1.UpdateCourseRequest.assessment_settings:type_name -> mirai.v1.AssessmentSettings
-	12, // 42: mirai.v1.UpdateCourseRequest.content:type_name -> mirai.v1.CourseContent
-	0,  // 43: mirai.v1.UpdateCourseRequest.status:type_name -> mirai.v1.CourseStatus
-	15, // 44: mirai.v1.UpdateCourseRequest.metadata:type_name -> mirai.v1.CourseMetadata
-	16, // 45: mirai.v1.UpdateCourseResponse.course:type_name -> mirai.v1.Course
-	18, // 46: mirai.v1.GetFolderHierarchyResponse.folders:type_name -> mirai.v1.Folder
-	19, // 47: mirai.v1.GetLibraryResponse.library:type_name -> mirai.v1.Library
-	2,  // 48: mirai.v1.CreateFolderRequest.type:type_name -> mirai.v1.FolderType
-	18, // 49: mirai.v1.CreateFolderResponse.folder:type_name -> mirai.v1.Folder
-	3,  // 50: mirai.v1.ExportCourseRequest.format:type_name -> mirai.v1.ExportFormat
-	13, // 51: mirai.v1.ExportCourseResponse.export:type_name -> mirai.v1.CourseExport
-	13, // 52: mirai.v1.GetExportStatusResponse.export:type_name -> mirai.v1.CourseExport
-	46, // 53: mirai.v1.DownloadExportResponse.expires_at:type_name -> google.protobuf.Timestamp
-	13, // 54: mirai.v1.ListExportsResponse.exports:type_name -> mirai.v1.CourseExport
-	20, // 55: mirai.v1.CourseService.ListCourses:input_type -> mirai.v1.ListCoursesRequest
-	22, // 56: mirai.v1.CourseService.GetCourse:input_type -> mirai.v1.GetCourseRequest
-	24, // 57: mirai.v1.CourseService.CreateCourse:input_type -> mirai.v1.CreateCourseRequest
-	26, // 58: mirai.v1.CourseService.UpdateCourse:input_type -> mirai.v1.UpdateCourseRequest
-	28, // 59: mirai.v1.CourseService.DeleteCourse:input_type -> mirai.v1.DeleteCourseRequest
-	30, // 60: mirai.v1.CourseService.GetFolderHierarchy:input_type -> mirai.v1.GetFolderHierarchyRequest
-	32, // 61: mirai.v1.CourseService.GetLibrary:input_type -> mirai.v1.GetLibraryRequest
-	34, // 62: mirai.v1.CourseService.CreateFolder:input_type -> mirai.v1.CreateFolderRequest
-	36, // 63: mirai.v1.CourseService.DeleteFolder:input_type -> mirai.v1.DeleteFolderRequest
-	38, // 64: mirai.v1.CourseService.ExportCourse:input_type -> mirai.v1.ExportCourseRequest
-	40, // 65: mirai.v1.CourseService.GetExportStatus:input_type -> mirai.v1.GetExportStatusRequest
-	42, // 66: mirai.v1.CourseService.DownloadExport:input_type -> mirai.v1.DownloadExportRequest
-	44, // 67: mirai.v1.CourseService.ListExports:input_type -> mirai.v1.ListExportsRequest
-	21, // 68: mirai.v1.CourseService.ListCourses:output_type -> mirai.v1.ListCoursesResponse
-	23, // 69: mirai.v1.CourseService.GetCourse:output_type -> mirai.v1.GetCourseResponse
-	25, // 70: mirai.v1.CourseService.CreateCourse:output_type -> mirai.v1.CreateCourseResponse
-	27, // 71: mirai.v1.CourseService.UpdateCourse:output_type -> mirai.v1.UpdateCourseResponse
-	29, // 72: mirai.v1.CourseService.DeleteCourse:output_type -> mirai.v1.DeleteCourseResponse
-	31, // 73: mirai.v1.CourseService.GetFolderHierarchy:output_type -> mirai.v1.GetFolderHierarchyResponse
-	33, // 74: mirai.v1.CourseService.GetLibrary:output_type -> mirai.v1.GetLibraryResponse
-	35, // 75: mirai.v1.CourseService.CreateFolder:output_type -> mirai.v1.CreateFolderResponse
-	37, // 76: mirai.v1.CourseService.DeleteFolder:output_type -> mirai.v1.DeleteFolderResponse
-	39, // 77: mirai.v1.CourseService.ExportCourse:output_type -> mirai.v1.ExportCourseResponse
-	41, // 78: mirai.v1.CourseService.GetExportStatus:output_type -> mirai.v1.GetExportStatusResponse
-	43, // 79: mirai.v1.CourseService.DownloadExport:output_type -> mirai.v1.DownloadExportResponse
-	45, // 80: mirai.v1.CourseService.ListExports:output_type -> mirai.v1.ListExportsResponse
-	68, // [68:81] is the sub-list for method output_type
-	55, // [55:68] is the sub-list for method input_type
-	55, // [55:55] is the sub-list for extension type_name
-	55, // [55:55] is the sub-list for extension extendee
-	0,  // [0:55] is the sub-list for field type_name
+	9,   // 0: mirai.v1.Persona.learning_objectives:type_name -> mirai.v1.LearningObjective
+	1,   // 1: mirai.v1.CourseBlock.type:type_name -> mirai.v1.BlockType
+	11,  // 2: mirai.v1.CourseBlock.alignment:type_name -> mirai.v1.BlockAlignment
+	12,  // 3: mirai.v1.Lesson.blocks:type_name -> mirai.v1.CourseBlock
+	13,  // 4: mirai.v1.CourseSection.lessons:type_name -> mirai.v1.Lesson
+	14,  // 5: mirai.v1.CourseContent.sections:type_name -> mirai.v1.CourseSection
+	12,  // 6: mirai.v1.CourseContent.course_blocks:type_name -> mirai.v1.CourseBlock
+	94,  // 7: mirai.v1.CourseExport.timestamp:type_name -> google.protobuf.Timestamp
+	3,   // 8: mirai.v1.CourseExport.format:type_name -> mirai.v1.ExportFormat
+	4,   // 9: mirai.v1.CourseExport.status:type_name -> mirai.v1.ExportStatus
+	94,  // 10: mirai.v1.CourseExport.expires_at:type_name -> google.protobuf.Timestamp
+	94,  // 11: mirai.v1.CourseExport.purged_at:type_name -> google.protobuf.Timestamp
+	8,   // 12: mirai.v1.CourseExport.purge_reason:type_name -> mirai.v1.ExportPurgeReason
+	0,   // 13: mirai.v1.CourseMetadata.status:type_name -> mirai.v1.CourseStatus
+	94,  // 14: mirai.v1.CourseMetadata.created_at:type_name -> google.protobuf.Timestamp
+	94,  // 15: mirai.v1.CourseMetadata.modified_at:type_name -> google.protobuf.Timestamp
+	0,   // 16: mirai.v1.Course.status:type_name -> mirai.v1.CourseStatus
+	19,  // 17: mirai.v1.Course.metadata:type_name -> mirai.v1.CourseMetadata
+	18,  // 18: mirai.v1.Course.settings:type_name -> mirai.v1.CourseSettings
+	10,  // 19: mirai.v1.Course.personas:type_name -> mirai.v1.Persona
+	9,   // 20: mirai.v1.Course.learning_objectives:type_name -> mirai.v1.LearningObjective
+	15,  // 21: mirai.v1.Course.assessment_settings:type_name -> mirai.v1.AssessmentSettings
+	16,  // 22: mirai.v1.Course.content:type_name -> mirai.v1.CourseContent
+	17,  // 23: mirai.v1.Course.exports:type_name -> mirai.v1.CourseExport
+	0,   // 24: mirai.v1.LibraryEntry.status:type_name -> mirai.v1.CourseStatus
+	94,  // 25: mirai.v1.LibraryEntry.created_at:type_name -> google.protobuf.Timestamp
+	94,  // 26: mirai.v1.LibraryEntry.modified_at:type_name -> google.protobuf.Timestamp
+	5,   // 27: mirai.v1.LibraryEntry.caller_role:type_name -> mirai.v1.CourseRole
+	5,   // 28: mirai.v1.CourseCollaborator.role:type_name -> mirai.v1.CourseRole
+	94,  // 29: mirai.v1.CourseCollaborator.created_at:type_name -> google.protobuf.Timestamp
+	2,   // 30: mirai.v1.Folder.type:type_name -> mirai.v1.FolderType
+	23,  // 31: mirai.v1.Folder.children:type_name -> mirai.v1.Folder
+	94,  // 32: mirai.v1.Library.last_updated:type_name -> google.protobuf.Timestamp
+	21,  // 33: mirai.v1.Library.courses:type_name -> mirai.v1.LibraryEntry
+	23,  // 34: mirai.v1.Library.folders:type_name -> mirai.v1.Folder
+	0,   // 35: mirai.v1.ListCoursesRequest.status:type_name -> mirai.v1.CourseStatus
+	21,  // 36: mirai.v1.ListCoursesResponse.courses:type_name -> mirai.v1.LibraryEntry
+	20,  // 37: mirai.v1.GetCourseResponse.course:type_name -> mirai.v1.Course
+	82,  // 38: mirai.v1.GetCourseResponse.generation_lock:type_name -> mirai.v1.CourseGenerationLock
+	18,  // 39: mirai.v1.CreateCourseRequest.settings:type_name -> mirai.v1.CourseSettings
+	10,  // 40: mirai.v1.CreateCourseRequest.personas:type_name -> mirai.v1.Persona
+	9,   // 41: mirai.v1.CreateCourseRequest.learning_objectives:type_name -> mirai.v1.LearningObjective
+	15,  // 42: mirai.v1.CreateCourseRequest.assessment_settings:type_name -> mirai.v1.AssessmentSettings
+	16,  // 43: mirai.v1.CreateCourseRequest.content:type_name -> mirai.v1.CourseContent
+	20,  // 44: mirai.v1.CreateCourseResponse.course:type_name -> mirai.v1.Course
+	18,  // 45: mirai.v1.UpdateCourseRequest.settings:type_name -> mirai.v1.CourseSettings
+	10,  // 46: mirai.v1.UpdateCourseRequest.personas:type_name -> mirai.v1.Persona
+	9,   // 47: mirai.v1.UpdateCourseRequest.learning_objectives:type_name -> mirai.v1.LearningObjective
+	15,  // 48: mirai.v1.UpdateCourseRequest.assessment_settings:type_name -> mirai.v1.AssessmentSettings
+	16,  // 49: mirai.v1.UpdateCourseRequest.content:type_name -> mirai.v1.CourseContent
+	0,   // 50: mirai.v1.UpdateCourseRequest.status:type_name -> mirai.v1.CourseStatus
+	19,  // 51: mirai.v1.UpdateCourseRequest.metadata:type_name -> mirai.v1.CourseMetadata
+	20,  // 52: mirai.v1.UpdateCourseResponse.course:type_name -> mirai.v1.Course
+	23,  // 53: mirai.v1.GetFolderHierarchyResponse.folders:type_name -> mirai.v1.Folder
+	24,  // 54: mirai.v1.GetLibraryResponse.library:type_name -> mirai.v1.Library
+	2,   // 55: mirai.v1.CreateFolderRequest.type:type_name -> mirai.v1.FolderType
+	23,  // 56: mirai.v1.CreateFolderResponse.folder:type_name -> mirai.v1.Folder
+	2,   // 57: mirai.v1.UpdateFolderRequest.type:type_name -> mirai.v1.FolderType
+	23,  // 58: mirai.v1.UpdateFolderResponse.folder:type_name -> mirai.v1.Folder
+	3,   // 59: mirai.v1.ExportCourseRequest.format:type_name -> mirai.v1.ExportFormat
+	17,  // 60: mirai.v1.ExportCourseResponse.export:type_name -> mirai.v1.CourseExport
+	17,  // 61: mirai.v1.GetExportStatusResponse.export:type_name -> mirai.v1.CourseExport
+	94,  // 62: mirai.v1.DownloadExportResponse.expires_at:type_name -> google.protobuf.Timestamp
+	17,  // 63: mirai.v1.ListExportsResponse.exports:type_name -> mirai.v1.CourseExport
+	22,  // 64: mirai.v1.ListCollaboratorsResponse.collaborators:type_name -> mirai.v1.CourseCollaborator
+	5,   // 65: mirai.v1.AddCollaboratorRequest.role:type_name -> mirai.v1.CourseRole
+	22,  // 66: mirai.v1.AddCollaboratorResponse.collaborator:type_name -> mirai.v1.CourseCollaborator
+	94,  // 67: mirai.v1.CourseSize.modified_at:type_name -> google.protobuf.Timestamp
+	62,  // 68: mirai.v1.ListLargestCoursesResponse.courses:type_name -> mirai.v1.CourseSize
+	20,  // 69: mirai.v1.PublishChangesResponse.course:type_name -> mirai.v1.Course
+	20,  // 70: mirai.v1.DiscardDraftResponse.course:type_name -> mirai.v1.Course
+	6,   // 71: mirai.v1.CourseSearchMatch.source:type_name -> mirai.v1.CourseSearchSource
+	69,  // 72: mirai.v1.SearchWithinCourseResponse.matches:type_name -> mirai.v1.CourseSearchMatch
+	7,   // 73: mirai.v1.CourseAttachment.status:type_name -> mirai.v1.CourseAttachmentStatus
+	94,  // 74: mirai.v1.CourseAttachment.created_at:type_name -> google.protobuf.Timestamp
+	94,  // 75: mirai.v1.CourseAttachment.processed_at:type_name -> google.protobuf.Timestamp
+	71,  // 76: mirai.v1.ConfirmCourseAttachmentResponse.attachment:type_name -> mirai.v1.CourseAttachment
+	71,  // 77: mirai.v1.ListCourseAttachmentsResponse.attachments:type_name -> mirai.v1.CourseAttachment
+	71,  // 78: mirai.v1.SetCourseAttachmentExcludedResponse.attachment:type_name -> mirai.v1.CourseAttachment
+	94,  // 79: mirai.v1.CourseGenerationLock.locked_at:type_name -> google.protobuf.Timestamp
+	0,   // 80: mirai.v1.CourseCard.status:type_name -> mirai.v1.CourseStatus
+	94,  // 81: mirai.v1.CourseCard.modified_at:type_name -> google.protobuf.Timestamp
+	0,   // 82: mirai.v1.ListCourseCardsRequest.status:type_name -> mirai.v1.CourseStatus
+	83,  // 83: mirai.v1.ListCourseCardsResponse.cards:type_name -> mirai.v1.CourseCard
+	86,  // 84: mirai.v1.GetCourseCardDetailsResponse.details:type_name -> mirai.v1.CourseCardDetails
+	94,  // 85: mirai.v1.CoursePresence.last_seen_at:type_name -> google.protobuf.Timestamp
+	89,  // 86: mirai.v1.HeartbeatCoursePresenceResponse.others:type_name -> mirai.v1.CoursePresence
+	89,  // 87: mirai.v1.GetCoursePresenceResponse.others:type_name -> mirai.v1.CoursePresence
+	25,  // 88: mirai.v1.CourseService.ListCourses:input_type -> mirai.v1.ListCoursesRequest
+	27,  // 89: mirai.v1.CourseService.GetCourse:input_type -> mirai.v1.GetCourseRequest
+	29,  // 90: mirai.v1.CourseService.CreateCourse:input_type -> mirai.v1.CreateCourseRequest
+	31,  // 91: mirai.v1.CourseService.UpdateCourse:input_type -> mirai.v1.UpdateCourseRequest
+	33,  // 92: mirai.v1.CourseService.DeleteCourse:input_type -> mirai.v1.DeleteCourseRequest
+	35,  // 93: mirai.v1.CourseService.GetFolderHierarchy:input_type -> mirai.v1.GetFolderHierarchyRequest
+	37,  // 94: mirai.v1.CourseService.GetLibrary:input_type -> mirai.v1.GetLibraryRequest
+	39,  // 95: mirai.v1.CourseService.CreateFolder:input_type -> mirai.v1.CreateFolderRequest
+	41,  // 96: mirai.v1.CourseService.UpdateFolder:input_type -> mirai.v1.UpdateFolderRequest
+	43,  // 97: mirai.v1.CourseService.DeleteFolder:input_type -> mirai.v1.DeleteFolderRequest
+	45,  // 98: mirai.v1.CourseService.ExportCourse:input_type -> mirai.v1.ExportCourseRequest
+	47,  // 99: mirai.v1.CourseService.GetExportStatus:input_type -> mirai.v1.GetExportStatusRequest
+	49,  // 100: mirai.v1.CourseService.DownloadExport:input_type -> mirai.v1.DownloadExportRequest
+	51,  // 101: mirai.v1.CourseService.ListExports:input_type -> mirai.v1.ListExportsRequest
+	53,  // 102: mirai.v1.CourseService.ListCollaborators:input_type -> mirai.v1.ListCollaboratorsRequest
+	55,  // 103: mirai.v1.CourseService.AddCollaborator:input_type -> mirai.v1.AddCollaboratorRequest
+	57,  // 104: mirai.v1.CourseService.RemoveCollaborator:input_type -> mirai.v1.RemoveCollaboratorRequest
+	59,  // 105: mirai.v1.CourseService.RemoveSampleContent:input_type -> mirai.v1.RemoveSampleContentRequest
+	61,  // 106: mirai.v1.CourseService.ListLargestCourses:input_type -> mirai.v1.ListLargestCoursesRequest
+	64,  // 107: mirai.v1.CourseService.PublishChanges:input_type -> mirai.v1.PublishChangesRequest
+	66,  // 108: mirai.v1.CourseService.DiscardDraft:input_type -> mirai.v1.DiscardDraftRequest
+	68,  // 109: mirai.v1.CourseService.SearchWithinCourse:input_type -> mirai.v1.SearchWithinCourseRequest
+	72,  // 110: mirai.v1.CourseService.GetCourseAttachmentUploadURL:input_type -> mirai.v1.GetCourseAttachmentUploadURLRequest
+	74,  // 111: mirai.v1.CourseService.ConfirmCourseAttachment:input_type -> mirai.v1.ConfirmCourseAttachmentRequest
+	76,  // 112: mirai.v1.CourseService.ListCourseAttachments:input_type -> mirai.v1.ListCourseAttachmentsRequest
+	78,  // 113: mirai.v1.CourseService.SetCourseAttachmentExcluded:input_type -> mirai.v1.SetCourseAttachmentExcludedRequest
+	80,  // 114: mirai.v1.CourseService.DeleteCourseAttachment:input_type -> mirai.v1.DeleteCourseAttachmentRequest
+	84,  // 115: mirai.v1.CourseService.ListCourseCards:input_type -> mirai.v1.ListCourseCardsRequest
+	87,  // 116: mirai.v1.CourseService.GetCourseCardDetails:input_type -> mirai.v1.GetCourseCardDetailsRequest
+	90,  // 117: mirai.v1.CourseService.HeartbeatCoursePresence:input_type -> mirai.v1.HeartbeatCoursePresenceRequest
+	92,  // 118: mirai.v1.CourseService.GetCoursePresence:input_type -> mirai.v1.GetCoursePresenceRequest
+	26,  // 119: mirai.v1.CourseService.ListCourses:output_type -> mirai.v1.ListCoursesResponse
+	28,  // 120: mirai.v1.CourseService.GetCourse:output_type -> mirai.v1.GetCourseResponse
+	30,  // 121: mirai.v1.CourseService.CreateCourse:output_type -> mirai.v1.CreateCourseResponse
+	32,  // 122: mirai.v1.CourseService.UpdateCourse:output_type -> mirai.v1.UpdateCourseResponse
+	34,  // 123: mirai.v1.CourseService.DeleteCourse:output_type -> mirai.v1.DeleteCourseResponse
+	36,  // 124: mirai.v1.CourseService.GetFolderHierarchy:output_type -> mirai.v1.GetFolderHierarchyResponse
+	38,  // 125: mirai.v1.CourseService.GetLibrary:output_type -> mirai.v1.GetLibraryResponse
+	40,  // 126: mirai.v1.CourseService.CreateFolder:output_type -> mirai.v1.CreateFolderResponse
+	42,  // 127: mirai.v1.CourseService.UpdateFolder:output_type -> mirai.v1.UpdateFolderResponse
+	44,  // 128: mirai.v1.CourseService.DeleteFolder:output_type -> mirai.v1.DeleteFolderResponse
+	46,  // 129: mirai.v1.CourseService.ExportCourse:output_type -> mirai.v1.ExportCourseResponse
+	48,  // 130: mirai.v1.CourseService.GetExportStatus:output_type -> mirai.v1.GetExportStatusResponse
+	50,  // 131: mirai.v1.CourseService.DownloadExport:output_type -> mirai.v1.DownloadExportResponse
+	52,  // 132: mirai.v1.CourseService.ListExports:output_type -> mirai.v1.ListExportsResponse
+	54,  // 133: mirai.v1.CourseService.ListCollaborators:output_type -> mirai.v1.ListCollaboratorsResponse
+	56,  // 134: mirai.v1.CourseService.AddCollaborator:output_type -> mirai.v1.AddCollaboratorResponse
+	58,  // 135: mirai.v1.CourseService.RemoveCollaborator:output_type -> mirai.v1.RemoveCollaboratorResponse
+	60,  // 136: mirai.v1.CourseService.RemoveSampleContent:output_type -> mirai.v1.RemoveSampleContentResponse
+	63,  // 137: mirai.v1.CourseService.ListLargestCourses:output_type -> mirai.v1.ListLargestCoursesResponse
+	65,  // 138: mirai.v1.CourseService.PublishChanges:output_type -> mirai.v1.PublishChangesResponse
+	67,  // 139: mirai.v1.CourseService.DiscardDraft:output_type -> mirai.v1.DiscardDraftResponse
+	70,  // 140: mirai.v1.CourseService.SearchWithinCourse:output_type -> mirai.v1.SearchWithinCourseResponse
+	73,  // 141: mirai.v1.CourseService.GetCourseAttachmentUploadURL:output_type -> mirai.v1.GetCourseAttachmentUploadURLResponse
+	75,  // 142: mirai.v1.CourseService.ConfirmCourseAttachment:output_type -> mirai.v1.ConfirmCourseAttachmentResponse
+	77,  // 143: mirai.v1.CourseService.ListCourseAttachments:output_type -> mirai.v1.ListCourseAttachmentsResponse
+	79,  // 144: mirai.v1.CourseService.SetCourseAttachmentExcluded:output_type -> mirai.v1.SetCourseAttachmentExcludedResponse
+	81,  // 145: mirai.v1.CourseService.DeleteCourseAttachment:output_type -> mirai.v1.DeleteCourseAttachmentResponse
+	85,  // 146: mirai.v1.CourseService.ListCourseCards:output_type -> mirai.v1.ListCourseCardsResponse
+	88,  // 147: mirai.v1.CourseService.GetCourseCardDetails:output_type -> mirai.v1.GetCourseCardDetailsResponse
+	91,  // 148: mirai.v1.CourseService.HeartbeatCoursePresence:output_type -> mirai.v1.HeartbeatCoursePresenceResponse
+	93,  // 149: mirai.v1.CourseService.GetCoursePresence:output_type -> mirai.v1.GetCoursePresenceResponse
+	119, // [119:150] is the sub-list for method output_type
+	88,  // [88:119] is the sub-list for method input_type
+	88,  // [88:88] is the sub-list for extension type_name
+	88,  // [88:88] is the sub-list for extension extendee
+	0,   // [0:88] is the sub-list for field type_name
 }
 
 func init() { file_mirai_v1_course_proto_init() }
@@ -3330,17 +6569,25 @@ func file_mirai_v1_course_proto_init() {
 	file_mirai_v1_course_proto_msgTypes[11].OneofWrappers = []any{}
 	file_mirai_v1_course_proto_msgTypes[12].OneofWrappers = []any{}
 	file_mirai_v1_course_proto_msgTypes[13].OneofWrappers = []any{}
-	file_mirai_v1_course_proto_msgTypes[15].OneofWrappers = []any{}
+	file_mirai_v1_course_proto_msgTypes[14].OneofWrappers = []any{}
+	file_mirai_v1_course_proto_msgTypes[16].OneofWrappers = []any{}
 	file_mirai_v1_course_proto_msgTypes[19].OneofWrappers = []any{}
-	file_mirai_v1_course_proto_msgTypes[21].OneofWrappers = []any{}
-	file_mirai_v1_course_proto_msgTypes[29].OneofWrappers = []any{}
+	file_mirai_v1_course_proto_msgTypes[20].OneofWrappers = []any{}
+	file_mirai_v1_course_proto_msgTypes[22].OneofWrappers = []any{}
+	file_mirai_v1_course_proto_msgTypes[23].OneofWrappers = []any{}
+	file_mirai_v1_course_proto_msgTypes[30].OneofWrappers = []any{}
+	file_mirai_v1_course_proto_msgTypes[32].OneofWrappers = []any{}
+	file_mirai_v1_course_proto_msgTypes[62].OneofWrappers = []any{}
+	file_mirai_v1_course_proto_msgTypes[75].OneofWrappers = []any{}
+	file_mirai_v1_course_proto_msgTypes[76].OneofWrappers = []any{}
+	file_mirai_v1_course_proto_msgTypes[77].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_mirai_v1_course_proto_rawDesc), len(file_mirai_v1_course_proto_rawDesc)),
-			NumEnums:      5,
-			NumMessages:   41,
+			NumEnums:      9,
+			NumMessages:   85,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -62,6 +62,7 @@ func (*CheckRequest) Descriptor() ([]byte, []int) {
 type CheckResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Maintenance   *MaintenanceStatus     `protobuf:"bytes,2,opt,name=maintenance,proto3" json:"maintenance,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -103,14 +104,22 @@ func (x *CheckResponse) GetStatus() string {
 	return ""
 }
 
+func (x *CheckResponse) GetMaintenance() *MaintenanceStatus {
+	if x != nil {
+		return x.Maintenance
+	}
+	return nil
+}
+
 var File_mirai_v1_health_proto protoreflect.FileDescriptor
 
 const file_mirai_v1_health_proto_rawDesc = "" +
 	"\n" +
-	"\x15mirai/v1/health.proto\x12\bmirai.v1\"\x0e\n" +
-	"\fCheckRequest\"'\n" +
+	"\x15mirai/v1/health.proto\x12\bmirai.v1\x1a\x1amirai/v1/maintenance.proto\"\x0e\n" +
+	"\fCheckRequest\"f\n" +
 	"\rCheckResponse\x12\x16\n" +
-	"\x06status\x18\x01 \x01(\tR\x06status2I\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12=\n" +
+	"\vmaintenance\x18\x02 \x01(\v2\x1b.mirai.v1.MaintenanceStatusR\vmaintenance2I\n" +
 	"\rHealthService\x128\n" +
 	"\x05Check\x12\x16.mirai.v1.CheckRequest\x1a\x17.mirai.v1.CheckResponseB\x91\x01\n" +
 	"\fcom.mirai.v1B\vHealthProtoP\x01Z3github.com/sogos/mirai-backend/gen/mirai/v1;miraiv1\xa2\x02\x03MXX\xaa\x02\bMirai.V1\xca\x02\bMirai\\V1\xe2\x02\x14Mirai\\V1\\GPBMetadata\xea\x02\tMirai::V1b\x06proto3"
@@ -129,17 +138,19 @@ func file_mirai_v1_health_proto_rawDescGZIP() []byte {
 
 var file_mirai_v1_health_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_mirai_v1_health_proto_goTypes = []any{
-	(*CheckRequest)(nil),  // 0: mirai.v1.CheckRequest
-	(*CheckResponse)(nil), // 1: mirai.v1.CheckResponse
+	(*CheckRequest)(nil),      // 0: mirai.v1.CheckRequest
+	(*CheckResponse)(nil),     // 1: mirai.v1.CheckResponse
+	(*MaintenanceStatus)(nil), // 2: mirai.v1.MaintenanceStatus
 }
 var file_mirai_v1_health_proto_depIdxs = []int32{
-	0, // 0: mirai.v1.HealthService.Check:input_type -> mirai.v1.CheckRequest
-	1, // 1: mirai.v1.HealthService.Check:output_type -> mirai.v1.CheckResponse
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	2, // 0: mirai.v1.CheckResponse.maintenance:type_name -> mirai.v1.MaintenanceStatus
+	0, // 1: mirai.v1.HealthService.Check:input_type -> mirai.v1.CheckRequest
+	1, // 2: mirai.v1.HealthService.Check:output_type -> mirai.v1.CheckResponse
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_mirai_v1_health_proto_init() }
@@ -147,6 +158,7 @@ func file_mirai_v1_health_proto_init() {
 	if File_mirai_v1_health_proto != nil {
 		return
 	}
+	file_mirai_v1_maintenance_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
@@ -91,6 +91,7 @@ type Invitation struct {
 	ExpiresAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	TeamId           *string                `protobuf:"bytes,11,opt,name=team_id,json=teamId,proto3,oneof" json:"team_id,omitempty"` // Team the invitee joins on acceptance
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -195,6 +196,13 @@ func (x *Invitation) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Invitation) GetTeamId() string {
+	if x != nil && x.TeamId != nil {
+		return *x.TeamId
+	}
+	return ""
+}
+
 // SeatInfo contains information about seat usage for a company.
 type SeatInfo struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
@@ -269,6 +277,7 @@ type CreateInvitationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
 	Role          Role                   `protobuf:"varint,2,opt,name=role,proto3,enum=mirai.v1.Role" json:"role,omitempty"`
+	TeamId        *string                `protobuf:"bytes,3,opt,name=team_id,json=teamId,proto3,oneof" json:"team_id,omitempty"` // Add the invitee to this team when they accept
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -317,6 +326,13 @@ func (x *CreateInvitationRequest) GetRole() Role {
 	return Role_ROLE_UNSPECIFIED
 }
 
+func (x *CreateInvitationRequest) GetTeamId() string {
+	if x != nil && x.TeamId != nil {
+		return *x.TeamId
+	}
+	return ""
+}
+
 // CreateInvitationResponse contains the created invitation.
 type CreateInvitationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1013,7 +1029,7 @@ var File_mirai_v1_invitation_proto protoreflect.FileDescriptor
 
 const file_mirai_v1_invitation_proto_rawDesc = "" +
 	"\n" +
-	"\x19mirai/v1/invitation.proto\x12\bmirai.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x15mirai/v1/common.proto\"\xd3\x03\n" +
+	"\x19mirai/v1/invitation.proto\x12\bmirai.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x15mirai/v1/common.proto\"\xfd\x03\n" +
 	"\n" +
 	"Invitation\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
@@ -1030,18 +1046,24 @@ const file_mirai_v1_invitation_proto_rawDesc = "" +
 	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
 	"updated_at\x18\n" +
-	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x16\n" +
-	"\x14_accepted_by_user_id\"\xa4\x01\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1c\n" +
+	"\ateam_id\x18\v \x01(\tH\x01R\x06teamId\x88\x01\x01B\x16\n" +
+	"\x14_accepted_by_user_idB\n" +
+	"\n" +
+	"\b_team_id\"\xa4\x01\n" +
 	"\bSeatInfo\x12\x1f\n" +
 	"\vtotal_seats\x18\x01 \x01(\x05R\n" +
 	"totalSeats\x12\x1d\n" +
 	"\n" +
 	"used_seats\x18\x02 \x01(\x05R\tusedSeats\x12/\n" +
 	"\x13pending_invitations\x18\x03 \x01(\x05R\x12pendingInvitations\x12'\n" +
-	"\x0favailable_seats\x18\x04 \x01(\x05R\x0eavailableSeats\"S\n" +
+	"\x0favailable_seats\x18\x04 \x01(\x05R\x0eavailableSeats\"}\n" +
 	"\x17CreateInvitationRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\"\n" +
-	"\x04role\x18\x02 \x01(\x0e2\x0e.mirai.v1.RoleR\x04role\"P\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x0e.mirai.v1.RoleR\x04role\x12\x1c\n" +
+	"\ateam_id\x18\x03 \x01(\tH\x00R\x06teamId\x88\x01\x01B\n" +
+	"\n" +
+	"\b_team_id\"P\n" +
 	"\x18CreateInvitationResponse\x124\n" +
 	"\n" +
 	"invitation\x18\x01 \x01(\v2\x14.mirai.v1.InvitationR\n" +
@@ -1191,6 +1213,7 @@ func file_mirai_v1_invitation_proto_init() {
 	}
 	file_mirai_v1_common_proto_init()
 	file_mirai_v1_invitation_proto_msgTypes[0].OneofWrappers = []any{}
+	file_mirai_v1_invitation_proto_msgTypes[2].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
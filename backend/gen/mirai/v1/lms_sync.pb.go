@@ -0,0 +1,1617 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: mirai/v1/lms_sync.proto
+
+package miraiv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// LMSConnectorType is how courses are delivered to an external LMS.
+type LMSConnectorType int32
+
+const (
+	LMSConnectorType_LMS_CONNECTOR_TYPE_UNSPECIFIED LMSConnectorType = 0
+	LMSConnectorType_LMS_CONNECTOR_TYPE_WEBHOOK     LMSConnectorType = 1 // Signed JSON notification with a download link
+	LMSConnectorType_LMS_CONNECTOR_TYPE_HTTP_PUSH   LMSConnectorType = 2 // Upload of the export with a bearer token
+)
+
+// Enum value maps for LMSConnectorType.
+var (
+	LMSConnectorType_name = map[int32]string{
+		0: "LMS_CONNECTOR_TYPE_UNSPECIFIED",
+		1: "LMS_CONNECTOR_TYPE_WEBHOOK",
+		2: "LMS_CONNECTOR_TYPE_HTTP_PUSH",
+	}
+	LMSConnectorType_value = map[string]int32{
+		"LMS_CONNECTOR_TYPE_UNSPECIFIED": 0,
+		"LMS_CONNECTOR_TYPE_WEBHOOK":     1,
+		"LMS_CONNECTOR_TYPE_HTTP_PUSH":   2,
+	}
+)
+
+func (x LMSConnectorType) Enum() *LMSConnectorType {
+	p := new(LMSConnectorType)
+	*p = x
+	return p
+}
+
+func (x LMSConnectorType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LMSConnectorType) Descriptor() protoreflect.EnumDescriptor {
+	return file_mirai_v1_lms_sync_proto_enumTypes[0].Descriptor()
+}
+
+func (LMSConnectorType) Type() protoreflect.EnumType {
+	return &file_mirai_v1_lms_sync_proto_enumTypes[0]
+}
+
+func (x LMSConnectorType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LMSConnectorType.Descriptor instead.
+func (LMSConnectorType) EnumDescriptor() ([]byte, []int) {
+	return file_mirai_v1_lms_sync_proto_rawDescGZIP(), []int{0}
+}
+
+// CourseSyncStatus is the state of a course delivery to an LMS connector.
+type CourseSyncStatus int32
+
+const (
+	CourseSyncStatus_COURSE_SYNC_STATUS_UNSPECIFIED CourseSyncStatus = 0
+	CourseSyncStatus_COURSE_SYNC_STATUS_PENDING     CourseSyncStatus = 1 // Waiting for its first attempt or a retry
+	CourseSyncStatus_COURSE_SYNC_STATUS_DELIVERING  CourseSyncStatus = 2
+	CourseSyncStatus_COURSE_SYNC_STATUS_DELIVERED   CourseSyncStatus = 3
+	CourseSyncStatus_COURSE_SYNC_STATUS_FAILED      CourseSyncStatus = 4 // Retries exhausted or rejected by the LMS
+)
+
+// Enum value maps for CourseSyncStatus.
+var (
+	CourseSyncStatus_name = map[int32]string{
+		0: "COURSE_SYNC_STATUS_UNSPECIFIED",
+		1: "COURSE_SYNC_STATUS_PENDING",
+		2: "COURSE_SYNC_STATUS_DELIVERING",
+		3: "COURSE_SYNC_STATUS_DELIVERED",
+		4: "COURSE_SYNC_STATUS_FAILED",
+	}
+	CourseSyncStatus_value = map[string]int32{
+		"COURSE_SYNC_STATUS_UNSPECIFIED": 0,
+		"COURSE_SYNC_STATUS_PENDING":     1,
+		"COURSE_SYNC_STATUS_DELIVERING":  2,
+		"COURSE_SYNC_STATUS_DELIVERED":   3,
+		"COURSE_SYNC_STATUS_FAILED":      4,
+	}
+)
+
+func (x CourseSyncStatus) Enum() *CourseSyncStatus {
+	p := new(CourseSyncStatus)
+	*p = x
+	return p
+}
+
+func (x CourseSyncStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CourseSyncStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_mirai_v1_lms_sync_proto_enumTypes[1].Descriptor()
+}
+
+func (CourseSyncStatus) Type() protoreflect.EnumType {
+	return &file_mirai_v1_lms_sync_proto_enumTypes[1]
+}
+
+func (x CourseSyncStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CourseSyncStatus.Descriptor instead.
+func (CourseSyncStatus) EnumDescriptor() ([]byte, []int) {
+	return file_mirai_v1_lms_sync_proto_rawDescGZIP(), []int{1}
+}
+
+// CourseSyncDeliveryKind tells course syncs apart from diagnostic webhook traffic.
+type CourseSyncDeliveryKind int32
+
+const (
+	CourseSyncDeliveryKind_COURSE_SYNC_DELIVERY_KIND_UNSPECIFIED CourseSyncDeliveryKind = 0
+	CourseSyncDeliveryKind_COURSE_SYNC_DELIVERY_KIND_SYNC        CourseSyncDeliveryKind = 1 // Delivery of a published course
+	CourseSyncDeliveryKind_COURSE_SYNC_DELIVERY_KIND_TEST        CourseSyncDeliveryKind = 2 // Synthetic event an admin sent to test a receiver
+	CourseSyncDeliveryKind_COURSE_SYNC_DELIVERY_KIND_REPLAY      CourseSyncDeliveryKind = 3 // Re-send of an earlier delivery
+)
+
+// Enum value maps for CourseSyncDeliveryKind.
+var (
+	CourseSyncDeliveryKind_name = map[int32]string{
+		0: "COURSE_SYNC_DELIVERY_KIND_UNSPECIFIED",
+		1: "COURSE_SYNC_DELIVERY_KIND_SYNC",
+		2: "COURSE_SYNC_DELIVERY_KIND_TEST",
+		3: "COURSE_SYNC_DELIVERY_KIND_REPLAY",
+	}
+	CourseSyncDeliveryKind_value = map[string]int32{
+		"COURSE_SYNC_DELIVERY_KIND_UNSPECIFIED": 0,
+		"COURSE_SYNC_DELIVERY_KIND_SYNC":        1,
+		"COURSE_SYNC_DELIVERY_KIND_TEST":        2,
+		"COURSE_SYNC_DELIVERY_KIND_REPLAY":      3,
+	}
+)
+
+func (x CourseSyncDeliveryKind) Enum() *CourseSyncDeliveryKind {
+	p := new(CourseSyncDeliveryKind)
+	*p = x
+	return p
+}
+
+func (x CourseSyncDeliveryKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CourseSyncDeliveryKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_mirai_v1_lms_sync_proto_enumTypes[2].Descriptor()
+}
+
+func (CourseSyncDeliveryKind) Type() protoreflect.EnumType {
+	return &file_mirai_v1_lms_sync_proto_enumTypes[2]
+}
+
+func (x CourseSyncDeliveryKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CourseSyncDeliveryKind.Descriptor instead.
+func (CourseSyncDeliveryKind) EnumDescriptor() ([]byte, []int) {
+	return file_mirai_v1_lms_sync_proto_rawDescGZIP(), []int{2}
+}
+
+// LMSConnector is an external LMS endpoint published courses are synced to.
+// Credentials are write-only; has_credentials reports whether one is stored.
+type LMSConnector struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type           LMSConnectorType       `protobuf:"varint,3,opt,name=type,proto3,enum=mirai.v1.LMSConnectorType" json:"type,omitempty"`
+	EndpointUrl    string                 `protobuf:"bytes,4,opt,name=endpoint_url,json=endpointUrl,proto3" json:"endpoint_url,omitempty"`
+	HasCredentials bool                   `protobuf:"varint,5,opt,name=has_credentials,json=hasCredentials,proto3" json:"has_credentials,omitempty"`
+	Enabled        bool                   `protobuf:"varint,6,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *LMSConnector) Reset() {
+	*x = LMSConnector{}
+	mi := &file_mirai_v1_lms_sync_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LMSConnector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LMSConnector) ProtoMessage() {}
+
+func (x *LMSConnector) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_lms_sync_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LMSConnector.ProtoReflect.Descriptor instead.
+func (*LMSConnector) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_lms_sync_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LMSConnector) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LMSConnector) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LMSConnector) GetType() LMSConnectorType {
+	if x != nil {
+		return x.Type
+	}
+	return LMSConnectorType_LMS_CONNECTOR_TYPE_UNSPECIFIED
+}
+
+func (x *LMSConnector) GetEndpointUrl() string {
+	if x != nil {
+		return x.EndpointUrl
+	}
+	return ""
+}
+
+func (x *LMSConnector) GetHasCredentials() bool {
+	if x != nil {
+		return x.HasCredentials
+	}
+	return false
+}
+
+func (x *LMSConnector) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *LMSConnector) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *LMSConnector) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// CourseSyncDelivery is a delivery to one connector: a course sync, or a test or replay
+// an admin sent.
+type CourseSyncDelivery struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ConnectorId    string                 `protobuf:"bytes,2,opt,name=connector_id,json=connectorId,proto3" json:"connector_id,omitempty"`
+	ConnectorName  string                 `protobuf:"bytes,3,opt,name=connector_name,json=connectorName,proto3" json:"connector_name,omitempty"` // Empty if the connector was removed
+	Status         CourseSyncStatus       `protobuf:"varint,4,opt,name=status,proto3,enum=mirai.v1.CourseSyncStatus" json:"status,omitempty"`
+	Attempts       int32                  `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastAttemptAt  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_attempt_at,json=lastAttemptAt,proto3,oneof" json:"last_attempt_at,omitempty"`
+	NextAttemptAt  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=next_attempt_at,json=nextAttemptAt,proto3,oneof" json:"next_attempt_at,omitempty"` // Set while a retry is pending
+	LastError      *string                `protobuf:"bytes,8,opt,name=last_error,json=lastError,proto3,oneof" json:"last_error,omitempty"`
+	ResponseStatus *int32                 `protobuf:"varint,9,opt,name=response_status,json=responseStatus,proto3,oneof" json:"response_status,omitempty"` // HTTP status the LMS answered with
+	RemoteId       *string                `protobuf:"bytes,10,opt,name=remote_id,json=remoteId,proto3,oneof" json:"remote_id,omitempty"`                   // Identifier the LMS assigned to the course
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Kind           CourseSyncDeliveryKind `protobuf:"varint,12,opt,name=kind,proto3,enum=mirai.v1.CourseSyncDeliveryKind" json:"kind,omitempty"`
+	ReplayOfId     *string                `protobuf:"bytes,13,opt,name=replay_of_id,json=replayOfId,proto3,oneof" json:"replay_of_id,omitempty"` // Delivery a replay re-sent
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CourseSyncDelivery) Reset() {
+	*x = CourseSyncDelivery{}
+	mi := &file_mirai_v1_lms_sync_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CourseSyncDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourseSyncDelivery) ProtoMessage() {}
+
+func (x *CourseSyncDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_lms_sync_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourseSyncDelivery.ProtoReflect.Descriptor instead.
+func (*CourseSyncDelivery) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_lms_sync_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CourseSyncDelivery) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CourseSyncDelivery) GetConnectorId() string {
+	if x != nil {
+		return x.ConnectorId
+	}
+	return ""
+}
+
+func (x *CourseSyncDelivery) GetConnectorName() string {
+	if x != nil {
+		return x.ConnectorName
+	}
+	return ""
+}
+
+func (x *CourseSyncDelivery) GetStatus() CourseSyncStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CourseSyncStatus_COURSE_SYNC_STATUS_UNSPECIFIED
+}
+
+func (x *CourseSyncDelivery) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *CourseSyncDelivery) GetLastAttemptAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAttemptAt
+	}
+	return nil
+}
+
+func (x *CourseSyncDelivery) GetNextAttemptAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return nil
+}
+
+func (x *CourseSyncDelivery) GetLastError() string {
+	if x != nil && x.LastError != nil {
+		return *x.LastError
+	}
+	return ""
+}
+
+func (x *CourseSyncDelivery) GetResponseStatus() int32 {
+	if x != nil && x.ResponseStatus != nil {
+		return *x.ResponseStatus
+	}
+	return 0
+}
+
+func (x *CourseSyncDelivery) GetRemoteId() string {
+	if x != nil && x.RemoteId != nil {
+		return *x.RemoteId
+	}
+	return ""
+}
+
+func (x *CourseSyncDelivery) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CourseSyncDelivery) GetKind() CourseSyncDeliveryKind {
+	if x != nil {
+		return x.Kind
+	}
+	return CourseSyncDeliveryKind_COURSE_SYNC_DELIVERY_KIND_UNSPECIFIED
+}
+
+func (x *CourseSyncDelivery) GetReplayOfId() string {
+	if x != nil && x.ReplayOfId != nil {
+		return *x.ReplayOfId
+	}
+	return ""
+}
+
+// CreateConnectorRequest describes a new connector.
+type CreateConnectorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type          LMSConnectorType       `protobuf:"varint,2,opt,name=type,proto3,enum=mirai.v1.LMSConnectorType" json:"type,omitempty"`
+	EndpointUrl   string                 `protobuf:"bytes,3,opt,name=endpoint_url,json=endpointUrl,proto3" json:"endpoint_url,omitempty"`
+	Credential    *string                `protobuf:"bytes,4,opt,name=credential,proto3,oneof" json:"credential,omitempty"` // Webhook signing secret or bearer token
+	Enabled       bool                   `protobuf:"varint,5,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateConnectorRequest) Reset() {
+	*x = CreateConnectorRequest{}
+	mi := &file_mirai_v1_lms_sync_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateConnectorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateConnectorRequest) ProtoMessage() {}
+
+func (x *CreateConnectorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_lms_sync_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateConnectorRequest.ProtoReflect.Descriptor instead.
+func (*CreateConnectorRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_lms_sync_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateConnectorRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateConnectorRequest) GetType() LMSConnectorType {
+	if x != nil {
+		return x.Type
+	}
+	return LMSConnectorType_LMS_CONNECTOR_TYPE_UNSPECIFIED
+}
+
+func (x *CreateConnectorRequest) GetEndpointUrl() string {
+	if x != nil {
+		return x.EndpointUrl
+	}
+	return ""
+}
+
+func (x *CreateConnectorRequest) GetCredential() string {
+	if x != nil && x.Credential != nil {
+		return *x.Credential
+	}
+	return ""
+}
+
+func (x *CreateConnectorRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+// CreateConnectorResponse contains the created connector.
+type CreateConnectorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Connector     *LMSConnector          `protobuf:"bytes,1,opt,name=connector,proto3" json:"connector,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateConnectorResponse) Reset() {
+	*x = CreateConnectorResponse{}
+	mi := &file_mirai_v1_lms_sync_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateConnectorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateConnectorResponse) ProtoMessage() {}
+
+func (x *CreateConnectorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_lms_sync_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateConnectorResponse.ProtoReflect.Descriptor instead.
+func (*CreateConnectorResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_lms_sync_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateConnectorResponse) GetConnector() *LMSConnector {
+	if x != nil {
+		return x.Connector
+	}
+	return nil
+}
+
+// ListConnectorsRequest is empty.
+type ListConnectorsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConnectorsRequest) Reset() {
+	*x = ListConnectorsRequest{}
+	mi := &file_mirai_v1_lms_sync_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConnectorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConnectorsRequest) ProtoMessage() {}
+
+func (x *ListConnectorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_lms_sync_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConnectorsRequest.ProtoReflect.Descriptor instead.
+func (*ListConnectorsRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_lms_sync_proto_rawDescGZIP(), []int{4}
+}
+
+// ListConnectorsResponse contains the organization's connectors.
+type ListConnectorsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Connectors    []*LMSConnector        `protobuf:"bytes,1,rep,name=connectors,proto3" json:"connectors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConnectorsResponse) Reset() {
+	*x = ListConnectorsResponse{}
+	mi := &file_mirai_v1_lms_sync_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConnectorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConnectorsResponse) ProtoMessage() {}
+
+func (x *ListConnectorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_lms_sync_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConnectorsResponse.ProtoReflect.Descriptor instead.
+func (*ListConnectorsResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_lms_sync_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListConnectorsResponse) GetConnectors() []*LMSConnector {
+	if x != nil {
+		return x.Connectors
+	}
+	return nil
+}
+
+// UpdateConnectorRequest replaces a connector's settings.
+type UpdateConnectorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConnectorId   string                 `protobuf:"bytes,1,opt,name=connector_id,json=connectorId,proto3" json:"connector_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type          LMSConnectorType       `protobuf:"varint,3,opt,name=type,proto3,enum=mirai.v1.LMSConnectorType" json:"type,omitempty"`
+	EndpointUrl   string                 `protobuf:"bytes,4,opt,name=endpoint_url,json=endpointUrl,proto3" json:"endpoint_url,omitempty"`
+	Credential    *string                `protobuf:"bytes,5,opt,name=credential,proto3,oneof" json:"credential,omitempty"` // Unset keeps the stored credential; empty removes it
+	Enabled       bool                   `protobuf:"varint,6,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateConnectorRequest) Reset() {
+	*x = UpdateConnectorRequest{}
+	mi := &file_mirai_v1_lms_sync_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateConnectorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateConnectorRequest) ProtoMessage() {}
+
+func (x *UpdateConnectorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_lms_sync_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateConnectorRequest.ProtoReflect.Descriptor instead.
+func (*UpdateConnectorRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_lms_sync_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateConnectorRequest) GetConnectorId() string {
+	if x != nil {
+		return x.ConnectorId
+	}
+	return ""
+}
+
+func (x *UpdateConnectorRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateConnectorRequest) GetType() LMSConnectorType {
+	if x != nil {
+		return x.Type
+	}
+	return LMSConnectorType_LMS_CONNECTOR_TYPE_UNSPECIFIED
+}
+
+func (x *UpdateConnectorRequest) GetEndpointUrl() string {
+	if x != nil {
+		return x.EndpointUrl
+	}
+	return ""
+}
+
+func (x *UpdateConnectorRequest) GetCredential() string {
+	if x != nil && x.Credential != nil {
+		return *x.Credential
+	}
+	return ""
+}
+
+func (x *UpdateConnectorRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+// UpdateConnectorResponse contains the updated connector.
+type UpdateConnectorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Connector     *LMSConnector          `protobuf:"bytes,1,opt,name=connector,proto3" json:"connector,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateConnectorResponse) Reset() {
+	*x = UpdateConnectorResponse{}
+	mi := &file_mirai_v1_lms_sync_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateConnectorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateConnectorResponse) ProtoMessage() {}
+
+func (x *UpdateConnectorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_lms_sync_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateConnectorResponse.ProtoReflect.Descriptor instead.
+func (*UpdateConnectorResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_lms_sync_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateConnectorResponse) GetConnector() *LMSConnector {
+	if x != nil {
+		return x.Connector
+	}
+	return nil
+}
+
+// DeleteConnectorRequest identifies the connector to remove.
+type DeleteConnectorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConnectorId   string                 `protobuf:"bytes,1,opt,name=connector_id,json=connectorId,proto3" json:"connector_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteConnectorRequest) Reset() {
+	*x = DeleteConnectorRequest{}
+	mi := &file_mirai_v1_lms_sync_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteConnectorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteConnectorRequest) ProtoMessage() {}
+
+func (x *DeleteConnectorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_lms_sync_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteConnectorRequest.ProtoReflect.Descriptor instead.
+func (*DeleteConnectorRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_lms_sync_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteConnectorRequest) GetConnectorId() string {
+	if x != nil {
+		return x.ConnectorId
+	}
+	return ""
+}
+
+// DeleteConnectorResponse is empty.
+type DeleteConnectorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteConnectorResponse) Reset() {
+	*x = DeleteConnectorResponse{}
+	mi := &file_mirai_v1_lms_sync_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteConnectorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteConnectorResponse) ProtoMessage() {}
+
+func (x *DeleteConnectorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_lms_sync_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteConnectorResponse.ProtoReflect.Descriptor instead.
+func (*DeleteConnectorResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_lms_sync_proto_rawDescGZIP(), []int{9}
+}
+
+// GetFolderSyncRequest identifies the folder.
+type GetFolderSyncRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FolderId      string                 `protobuf:"bytes,1,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFolderSyncRequest) Reset() {
+	*x = GetFolderSyncRequest{}
+	mi := &file_mirai_v1_lms_sync_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFolderSyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFolderSyncRequest) ProtoMessage() {}
+
+func (x *GetFolderSyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_lms_sync_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFolderSyncRequest.ProtoReflect.Descriptor instead.
+func (*GetFolderSyncRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_lms_sync_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetFolderSyncRequest) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
+}
+
+// GetFolderSyncResponse contains the folder's connector, if it syncs.
+type GetFolderSyncResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConnectorId   *string                `protobuf:"bytes,1,opt,name=connector_id,json=connectorId,proto3,oneof" json:"connector_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFolderSyncResponse) Reset() {
+	*x = GetFolderSyncResponse{}
+	mi := &file_mirai_v1_lms_sync_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFolderSyncResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFolderSyncResponse) ProtoMessage() {}
+
+func (x *GetFolderSyncResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_lms_sync_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFolderSyncResponse.ProtoReflect.Descriptor instead.
+func (*GetFolderSyncResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_lms_sync_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetFolderSyncResponse) GetConnectorId() string {
+	if x != nil && x.ConnectorId != nil {
+		return *x.ConnectorId
+	}
+	return ""
+}
+
+// SetFolderSyncRequest sets or clears a folder's connector.
+type SetFolderSyncRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FolderId      string                 `protobuf:"bytes,1,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	ConnectorId   *string                `protobuf:"bytes,2,opt,name=connector_id,json=connectorId,proto3,oneof" json:"connector_id,omitempty"` // Unset stops the folder from syncing
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetFolderSyncRequest) Reset() {
+	*x = SetFolderSyncRequest{}
+	mi := &file_mirai_v1_lms_sync_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFolderSyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFolderSyncRequest) ProtoMessage() {}
+
+func (x *SetFolderSyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_lms_sync_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFolderSyncRequest.ProtoReflect.Descriptor instead.
+func (*SetFolderSyncRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_lms_sync_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SetFolderSyncRequest) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
+}
+
+func (x *SetFolderSyncRequest) GetConnectorId() string {
+	if x != nil && x.ConnectorId != nil {
+		return *x.ConnectorId
+	}
+	return ""
+}
+
+// SetFolderSyncResponse contains the folder's connector after the change.
+type SetFolderSyncResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConnectorId   *string                `protobuf:"bytes,1,opt,name=connector_id,json=connectorId,proto3,oneof" json:"connector_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetFolderSyncResponse) Reset() {
+	*x = SetFolderSyncResponse{}
+	mi := &file_mirai_v1_lms_sync_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFolderSyncResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFolderSyncResponse) ProtoMessage() {}
+
+func (x *SetFolderSyncResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_lms_sync_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFolderSyncResponse.ProtoReflect.Descriptor instead.
+func (*SetFolderSyncResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_lms_sync_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SetFolderSyncResponse) GetConnectorId() string {
+	if x != nil && x.ConnectorId != nil {
+		return *x.ConnectorId
+	}
+	return ""
+}
+
+// GetSyncStatusRequest identifies the course.
+type GetSyncStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSyncStatusRequest) Reset() {
+	*x = GetSyncStatusRequest{}
+	mi := &file_mirai_v1_lms_sync_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSyncStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSyncStatusRequest) ProtoMessage() {}
+
+func (x *GetSyncStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_lms_sync_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSyncStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetSyncStatusRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_lms_sync_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetSyncStatusRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+// GetSyncStatusResponse contains the course's latest delivery per connector.
+type GetSyncStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deliveries    []*CourseSyncDelivery  `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSyncStatusResponse) Reset() {
+	*x = GetSyncStatusResponse{}
+	mi := &file_mirai_v1_lms_sync_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSyncStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSyncStatusResponse) ProtoMessage() {}
+
+func (x *GetSyncStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_lms_sync_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSyncStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetSyncStatusResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_lms_sync_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetSyncStatusResponse) GetDeliveries() []*CourseSyncDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+// TestWebhookRequest identifies the connector and the event to send.
+type TestWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConnectorId   string                 `protobuf:"bytes,1,opt,name=connector_id,json=connectorId,proto3" json:"connector_id,omitempty"`
+	Event         string                 `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"` // "course.published" (default) or "ping"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestWebhookRequest) Reset() {
+	*x = TestWebhookRequest{}
+	mi := &file_mirai_v1_lms_sync_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestWebhookRequest) ProtoMessage() {}
+
+func (x *TestWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_lms_sync_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestWebhookRequest.ProtoReflect.Descriptor instead.
+func (*TestWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_lms_sync_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *TestWebhookRequest) GetConnectorId() string {
+	if x != nil {
+		return x.ConnectorId
+	}
+	return ""
+}
+
+func (x *TestWebhookRequest) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+// TestWebhookResponse contains how the receiver answered.
+type TestWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attempt       *WebhookAttempt        `protobuf:"bytes,1,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestWebhookResponse) Reset() {
+	*x = TestWebhookResponse{}
+	mi := &file_mirai_v1_lms_sync_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestWebhookResponse) ProtoMessage() {}
+
+func (x *TestWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_lms_sync_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestWebhookResponse.ProtoReflect.Descriptor instead.
+func (*TestWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_lms_sync_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TestWebhookResponse) GetAttempt() *WebhookAttempt {
+	if x != nil {
+		return x.Attempt
+	}
+	return nil
+}
+
+// ReplayWebhookDeliveryRequest identifies the delivery to re-send.
+type ReplayWebhookDeliveryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeliveryId    string                 `protobuf:"bytes,1,opt,name=delivery_id,json=deliveryId,proto3" json:"delivery_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayWebhookDeliveryRequest) Reset() {
+	*x = ReplayWebhookDeliveryRequest{}
+	mi := &file_mirai_v1_lms_sync_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayWebhookDeliveryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayWebhookDeliveryRequest) ProtoMessage() {}
+
+func (x *ReplayWebhookDeliveryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_lms_sync_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayWebhookDeliveryRequest.ProtoReflect.Descriptor instead.
+func (*ReplayWebhookDeliveryRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_lms_sync_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ReplayWebhookDeliveryRequest) GetDeliveryId() string {
+	if x != nil {
+		return x.DeliveryId
+	}
+	return ""
+}
+
+// ReplayWebhookDeliveryResponse contains how the receiver answered.
+type ReplayWebhookDeliveryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attempt       *WebhookAttempt        `protobuf:"bytes,1,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayWebhookDeliveryResponse) Reset() {
+	*x = ReplayWebhookDeliveryResponse{}
+	mi := &file_mirai_v1_lms_sync_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayWebhookDeliveryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayWebhookDeliveryResponse) ProtoMessage() {}
+
+func (x *ReplayWebhookDeliveryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_lms_sync_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayWebhookDeliveryResponse.ProtoReflect.Descriptor instead.
+func (*ReplayWebhookDeliveryResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_lms_sync_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ReplayWebhookDeliveryResponse) GetAttempt() *WebhookAttempt {
+	if x != nil {
+		return x.Attempt
+	}
+	return nil
+}
+
+// WebhookAttempt is the outcome of a test or replay delivery. The delivery's
+// response_status and last_error say how the receiver answered.
+type WebhookAttempt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Delivery      *CourseSyncDelivery    `protobuf:"bytes,1,opt,name=delivery,proto3" json:"delivery,omitempty"`
+	LatencyMs     int64                  `protobuf:"varint,2,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`         // 0 when the receiver was never reached
+	ResponseBody  string                 `protobuf:"bytes,3,opt,name=response_body,json=responseBody,proto3" json:"response_body,omitempty"` // Start of the receiver's response body
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookAttempt) Reset() {
+	*x = WebhookAttempt{}
+	mi := &file_mirai_v1_lms_sync_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookAttempt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookAttempt) ProtoMessage() {}
+
+func (x *WebhookAttempt) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_lms_sync_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookAttempt.ProtoReflect.Descriptor instead.
+func (*WebhookAttempt) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_lms_sync_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *WebhookAttempt) GetDelivery() *CourseSyncDelivery {
+	if x != nil {
+		return x.Delivery
+	}
+	return nil
+}
+
+func (x *WebhookAttempt) GetLatencyMs() int64 {
+	if x != nil {
+		return x.LatencyMs
+	}
+	return 0
+}
+
+func (x *WebhookAttempt) GetResponseBody() string {
+	if x != nil {
+		return x.ResponseBody
+	}
+	return ""
+}
+
+var File_mirai_v1_lms_sync_proto protoreflect.FileDescriptor
+
+const file_mirai_v1_lms_sync_proto_rawDesc = "" +
+	"\n" +
+	"\x17mirai/v1/lms_sync.proto\x12\bmirai.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xbe\x02\n" +
+	"\fLMSConnector\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12.\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x1a.mirai.v1.LMSConnectorTypeR\x04type\x12!\n" +
+	"\fendpoint_url\x18\x04 \x01(\tR\vendpointUrl\x12'\n" +
+	"\x0fhas_credentials\x18\x05 \x01(\bR\x0ehasCredentials\x12\x18\n" +
+	"\aenabled\x18\x06 \x01(\bR\aenabled\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xc6\x05\n" +
+	"\x12CourseSyncDelivery\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\fconnector_id\x18\x02 \x01(\tR\vconnectorId\x12%\n" +
+	"\x0econnector_name\x18\x03 \x01(\tR\rconnectorName\x122\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x1a.mirai.v1.CourseSyncStatusR\x06status\x12\x1a\n" +
+	"\battempts\x18\x05 \x01(\x05R\battempts\x12G\n" +
+	"\x0flast_attempt_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\rlastAttemptAt\x88\x01\x01\x12G\n" +
+	"\x0fnext_attempt_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x01R\rnextAttemptAt\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"last_error\x18\b \x01(\tH\x02R\tlastError\x88\x01\x01\x12,\n" +
+	"\x0fresponse_status\x18\t \x01(\x05H\x03R\x0eresponseStatus\x88\x01\x01\x12 \n" +
+	"\tremote_id\x18\n" +
+	" \x01(\tH\x04R\bremoteId\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x124\n" +
+	"\x04kind\x18\f \x01(\x0e2 .mirai.v1.CourseSyncDeliveryKindR\x04kind\x12%\n" +
+	"\freplay_of_id\x18\r \x01(\tH\x05R\n" +
+	"replayOfId\x88\x01\x01B\x12\n" +
+	"\x10_last_attempt_atB\x12\n" +
+	"\x10_next_attempt_atB\r\n" +
+	"\v_last_errorB\x12\n" +
+	"\x10_response_statusB\f\n" +
+	"\n" +
+	"_remote_idB\x0f\n" +
+	"\r_replay_of_id\"\xcd\x01\n" +
+	"\x16CreateConnectorRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12.\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x1a.mirai.v1.LMSConnectorTypeR\x04type\x12!\n" +
+	"\fendpoint_url\x18\x03 \x01(\tR\vendpointUrl\x12#\n" +
+	"\n" +
+	"credential\x18\x04 \x01(\tH\x00R\n" +
+	"credential\x88\x01\x01\x12\x18\n" +
+	"\aenabled\x18\x05 \x01(\bR\aenabledB\r\n" +
+	"\v_credential\"O\n" +
+	"\x17CreateConnectorResponse\x124\n" +
+	"\tconnector\x18\x01 \x01(\v2\x16.mirai.v1.LMSConnectorR\tconnector\"\x17\n" +
+	"\x15ListConnectorsRequest\"P\n" +
+	"\x16ListConnectorsResponse\x126\n" +
+	"\n" +
+	"connectors\x18\x01 \x03(\v2\x16.mirai.v1.LMSConnectorR\n" +
+	"connectors\"\xf0\x01\n" +
+	"\x16UpdateConnectorRequest\x12!\n" +
+	"\fconnector_id\x18\x01 \x01(\tR\vconnectorId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12.\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x1a.mirai.v1.LMSConnectorTypeR\x04type\x12!\n" +
+	"\fendpoint_url\x18\x04 \x01(\tR\vendpointUrl\x12#\n" +
+	"\n" +
+	"credential\x18\x05 \x01(\tH\x00R\n" +
+	"credential\x88\x01\x01\x12\x18\n" +
+	"\aenabled\x18\x06 \x01(\bR\aenabledB\r\n" +
+	"\v_credential\"O\n" +
+	"\x17UpdateConnectorResponse\x124\n" +
+	"\tconnector\x18\x01 \x01(\v2\x16.mirai.v1.LMSConnectorR\tconnector\";\n" +
+	"\x16DeleteConnectorRequest\x12!\n" +
+	"\fconnector_id\x18\x01 \x01(\tR\vconnectorId\"\x19\n" +
+	"\x17DeleteConnectorResponse\"3\n" +
+	"\x14GetFolderSyncRequest\x12\x1b\n" +
+	"\tfolder_id\x18\x01 \x01(\tR\bfolderId\"P\n" +
+	"\x15GetFolderSyncResponse\x12&\n" +
+	"\fconnector_id\x18\x01 \x01(\tH\x00R\vconnectorId\x88\x01\x01B\x0f\n" +
+	"\r_connector_id\"l\n" +
+	"\x14SetFolderSyncRequest\x12\x1b\n" +
+	"\tfolder_id\x18\x01 \x01(\tR\bfolderId\x12&\n" +
+	"\fconnector_id\x18\x02 \x01(\tH\x00R\vconnectorId\x88\x01\x01B\x0f\n" +
+	"\r_connector_id\"P\n" +
+	"\x15SetFolderSyncResponse\x12&\n" +
+	"\fconnector_id\x18\x01 \x01(\tH\x00R\vconnectorId\x88\x01\x01B\x0f\n" +
+	"\r_connector_id\"3\n" +
+	"\x14GetSyncStatusRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\"U\n" +
+	"\x15GetSyncStatusResponse\x12<\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2\x1c.mirai.v1.CourseSyncDeliveryR\n" +
+	"deliveries\"M\n" +
+	"\x12TestWebhookRequest\x12!\n" +
+	"\fconnector_id\x18\x01 \x01(\tR\vconnectorId\x12\x14\n" +
+	"\x05event\x18\x02 \x01(\tR\x05event\"I\n" +
+	"\x13TestWebhookResponse\x122\n" +
+	"\aattempt\x18\x01 \x01(\v2\x18.mirai.v1.WebhookAttemptR\aattempt\"?\n" +
+	"\x1cReplayWebhookDeliveryRequest\x12\x1f\n" +
+	"\vdelivery_id\x18\x01 \x01(\tR\n" +
+	"deliveryId\"S\n" +
+	"\x1dReplayWebhookDeliveryResponse\x122\n" +
+	"\aattempt\x18\x01 \x01(\v2\x18.mirai.v1.WebhookAttemptR\aattempt\"\x8e\x01\n" +
+	"\x0eWebhookAttempt\x128\n" +
+	"\bdelivery\x18\x01 \x01(\v2\x1c.mirai.v1.CourseSyncDeliveryR\bdelivery\x12\x1d\n" +
+	"\n" +
+	"latency_ms\x18\x02 \x01(\x03R\tlatencyMs\x12#\n" +
+	"\rresponse_body\x18\x03 \x01(\tR\fresponseBody*x\n" +
+	"\x10LMSConnectorType\x12\"\n" +
+	"\x1eLMS_CONNECTOR_TYPE_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aLMS_CONNECTOR_TYPE_WEBHOOK\x10\x01\x12 \n" +
+	"\x1cLMS_CONNECTOR_TYPE_HTTP_PUSH\x10\x02*\xba\x01\n" +
+	"\x10CourseSyncStatus\x12\"\n" +
+	"\x1eCOURSE_SYNC_STATUS_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aCOURSE_SYNC_STATUS_PENDING\x10\x01\x12!\n" +
+	"\x1dCOURSE_SYNC_STATUS_DELIVERING\x10\x02\x12 \n" +
+	"\x1cCOURSE_SYNC_STATUS_DELIVERED\x10\x03\x12\x1d\n" +
+	"\x19COURSE_SYNC_STATUS_FAILED\x10\x04*\xb1\x01\n" +
+	"\x16CourseSyncDeliveryKind\x12)\n" +
+	"%COURSE_SYNC_DELIVERY_KIND_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1eCOURSE_SYNC_DELIVERY_KIND_SYNC\x10\x01\x12\"\n" +
+	"\x1eCOURSE_SYNC_DELIVERY_KIND_TEST\x10\x02\x12$\n" +
+	" COURSE_SYNC_DELIVERY_KIND_REPLAY\x10\x032\x99\x06\n" +
+	"\x0eLMSSyncService\x12V\n" +
+	"\x0fCreateConnector\x12 .mirai.v1.CreateConnectorRequest\x1a!.mirai.v1.CreateConnectorResponse\x12S\n" +
+	"\x0eListConnectors\x12\x1f.mirai.v1.ListConnectorsRequest\x1a .mirai.v1.ListConnectorsResponse\x12V\n" +
+	"\x0fUpdateConnector\x12 .mirai.v1.UpdateConnectorRequest\x1a!.mirai.v1.UpdateConnectorResponse\x12V\n" +
+	"\x0fDeleteConnector\x12 .mirai.v1.DeleteConnectorRequest\x1a!.mirai.v1.DeleteConnectorResponse\x12P\n" +
+	"\rGetFolderSync\x12\x1e.mirai.v1.GetFolderSyncRequest\x1a\x1f.mirai.v1.GetFolderSyncResponse\x12P\n" +
+	"\rSetFolderSync\x12\x1e.mirai.v1.SetFolderSyncRequest\x1a\x1f.mirai.v1.SetFolderSyncResponse\x12P\n" +
+	"\rGetSyncStatus\x12\x1e.mirai.v1.GetSyncStatusRequest\x1a\x1f.mirai.v1.GetSyncStatusResponse\x12J\n" +
+	"\vTestWebhook\x12\x1c.mirai.v1.TestWebhookRequest\x1a\x1d.mirai.v1.TestWebhookResponse\x12h\n" +
+	"\x15ReplayWebhookDelivery\x12&.mirai.v1.ReplayWebhookDeliveryRequest\x1a'.mirai.v1.ReplayWebhookDeliveryResponseB\x92\x01\n" +
+	"\fcom.mirai.v1B\fLmsSyncProtoP\x01Z3github.com/sogos/mirai-backend/gen/mirai/v1;miraiv1\xa2\x02\x03MXX\xaa\x02\bMirai.V1\xca\x02\bMirai\\V1\xe2\x02\x14Mirai\\V1\\GPBMetadata\xea\x02\tMirai::V1b\x06proto3"
+
+var (
+	file_mirai_v1_lms_sync_proto_rawDescOnce sync.Once
+	file_mirai_v1_lms_sync_proto_rawDescData []byte
+)
+
+func file_mirai_v1_lms_sync_proto_rawDescGZIP() []byte {
+	file_mirai_v1_lms_sync_proto_rawDescOnce.Do(func() {
+		file_mirai_v1_lms_sync_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_mirai_v1_lms_sync_proto_rawDesc), len(file_mirai_v1_lms_sync_proto_rawDesc)))
+	})
+	return file_mirai_v1_lms_sync_proto_rawDescData
+}
+
+var file_mirai_v1_lms_sync_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_mirai_v1_lms_sync_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_mirai_v1_lms_sync_proto_goTypes = []any{
+	(LMSConnectorType)(0),                 // 0: mirai.v1.LMSConnectorType
+	(CourseSyncStatus)(0),                 // 1: mirai.v1.CourseSyncStatus
+	(CourseSyncDeliveryKind)(0),           // 2: mirai.v1.CourseSyncDeliveryKind
+	(*LMSConnector)(nil),                  // 3: mirai.v1.LMSConnector
+	(*CourseSyncDelivery)(nil),            // 4: mirai.v1.CourseSyncDelivery
+	(*CreateConnectorRequest)(nil),        // 5: mirai.v1.CreateConnectorRequest
+	(*CreateConnectorResponse)(nil),       // 6: mirai.v1.CreateConnectorResponse
+	(*ListConnectorsRequest)(nil),         // 7: mirai.v1.ListConnectorsRequest
+	(*ListConnectorsResponse)(nil),        // 8: mirai.v1.ListConnectorsResponse
+	(*UpdateConnectorRequest)(nil),        // 9: mirai.v1.UpdateConnectorRequest
+	(*UpdateConnectorResponse)(nil),       // 10: mirai.v1.UpdateConnectorResponse
+	(*DeleteConnectorRequest)(nil),        // 11: mirai.v1.DeleteConnectorRequest
+	(*DeleteConnectorResponse)(nil),       // 12: mirai.v1.DeleteConnectorResponse
+	(*GetFolderSyncRequest)(nil),          // 13: mirai.v1.GetFolderSyncRequest
+	(*GetFolderSyncResponse)(nil),         // 14: mirai.v1.GetFolderSyncResponse
+	(*SetFolderSyncRequest)(nil),          // 15: mirai.v1.SetFolderSyncRequest
+	(*SetFolderSyncResponse)(nil),         // 16: mirai.v1.SetFolderSyncResponse
+	(*GetSyncStatusRequest)(nil),          // 17: mirai.v1.GetSyncStatusRequest
+	(*GetSyncStatusResponse)(nil),         // 18: mirai.v1.GetSyncStatusResponse
+	(*TestWebhookRequest)(nil),            // 19: mirai.v1.TestWebhookRequest
+	(*TestWebhookResponse)(nil),           // 20: mirai.v1.TestWebhookResponse
+	(*ReplayWebhookDeliveryRequest)(nil),  // 21: mirai.v1.ReplayWebhookDeliveryRequest
+	(*ReplayWebhookDeliveryResponse)(nil), // 22: mirai.v1.ReplayWebhookDeliveryResponse
+	(*WebhookAttempt)(nil),                // 23: mirai.v1.WebhookAttempt
+	(*timestamppb.Timestamp)(nil),         // 24: google.protobuf.Timestamp
+}
+var file_mirai_v1_lms_sync_proto_depIdxs = []int32{
+	0,  // 0: mirai.v1.LMSConnector.type:type_name -> mirai.v1.LMSConnectorType
+	24, // 1: mirai.v1.LMSConnector.created_at:type_name -> google.protobuf.Timestamp
+	24, // 2: mirai.v1.LMSConnector.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 3: mirai.v1.CourseSyncDelivery.status:type_name -> mirai.v1.CourseSyncStatus
+	24, // 4: mirai.v1.CourseSyncDelivery.last_attempt_at:type_name -> google.protobuf.Timestamp
+	24, // 5: mirai.v1.CourseSyncDelivery.next_attempt_at:type_name -> google.protobuf.Timestamp
+	24, // 6: mirai.v1.CourseSyncDelivery.created_at:type_name -> google.protobuf.Timestamp
+	2,  // 7: mirai.v1.CourseSyncDelivery.kind:type_name -> mirai.v1.CourseSyncDeliveryKind
+	0,  // 8: mirai.v1.CreateConnectorRequest.type:type_name -> mirai.v1.LMSConnectorType
+	3,  // 9: mirai.v1.CreateConnectorResponse.connector:type_name -> mirai.v1.LMSConnector
+	3,  // 10: mirai.v1.ListConnectorsResponse.connectors:type_name -> mirai.v1.LMSConnector
+	0,  // 11: mirai.v1.UpdateConnectorRequest.type:type_name -> mirai.v1.LMSConnectorType
+	3,  // 12: mirai.v1.UpdateConnectorResponse.connector:type_name -> mirai.v1.LMSConnector
+	4,  // 13: mirai.v1.GetSyncStatusResponse.deliveries:type_name -> mirai.v1.CourseSyncDelivery
+	23, // 14: mirai.v1.TestWebhookResponse.attempt:type_name -> mirai.v1.WebhookAttempt
+	23, // 15: mirai.v1.ReplayWebhookDeliveryResponse.attempt:type_name -> mirai.v1.WebhookAttempt
+	4,  // 16: mirai.v1.WebhookAttempt.delivery:type_name -> mirai.v1.CourseSyncDelivery
+	5,  // 17: mirai.v1.LMSSyncService.CreateConnector:input_type -> mirai.v1.CreateConnectorRequest
+	7,  // 18: mirai.v1.LMSSyncService.ListConnectors:input_type -> mirai.v1.ListConnectorsRequest
+	9,  // 19: mirai.v1.LMSSyncService.UpdateConnector:input_type -> mirai.v1.UpdateConnectorRequest
+	11, // 20: mirai.v1.LMSSyncService.DeleteConnector:input_type -> mirai.v1.DeleteConnectorRequest
+	13, // 21: mirai.v1.LMSSyncService.GetFolderSync:input_type -> mirai.v1.GetFolderSyncRequest
+	15, // 22: mirai.v1.LMSSyncService.SetFolderSync:input_type -> mirai.v1.SetFolderSyncRequest
+	17, // 23: mirai.v1.LMSSyncService.GetSyncStatus:input_type -> mirai.v1.GetSyncStatusRequest
+	19, // 24: mirai.v1.LMSSyncService.TestWebhook:input_type -> mirai.v1.TestWebhookRequest
+	21, // 25: mirai.v1.LMSSyncService.ReplayWebhookDelivery:input_type -> mirai.v1.ReplayWebhookDeliveryRequest
+	6,  // 26: mirai.v1.LMSSyncService.CreateConnector:output_type -> mirai.v1.CreateConnectorResponse
+	8,  // 27: mirai.v1.LMSSyncService.ListConnectors:output_type -> mirai.v1.ListConnectorsResponse
+	10, // 28: mirai.v1.LMSSyncService.UpdateConnector:output_type -> mirai.v1.UpdateConnectorResponse
+	12, // 29: mirai.v1.LMSSyncService.DeleteConnector:output_type -> mirai.v1.DeleteConnectorResponse
+	14, // 30: mirai.v1.LMSSyncService.GetFolderSync:output_type -> mirai.v1.GetFolderSyncResponse
+	16, // 31: mirai.v1.LMSSyncService.SetFolderSync:output_type -> mirai.v1.SetFolderSyncResponse
+	18, // 32: mirai.v1.LMSSyncService.GetSyncStatus:output_type -> mirai.v1.GetSyncStatusResponse
+	20, // 33: mirai.v1.LMSSyncService.TestWebhook:output_type -> mirai.v1.TestWebhookResponse
+	22, // 34: mirai.v1.LMSSyncService.ReplayWebhookDelivery:output_type -> mirai.v1.ReplayWebhookDeliveryResponse
+	26, // [26:35] is the sub-list for method output_type
+	17, // [17:26] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
+}
+
+func init() { file_mirai_v1_lms_sync_proto_init() }
+func file_mirai_v1_lms_sync_proto_init() {
+	if File_mirai_v1_lms_sync_proto != nil {
+		return
+	}
+	file_mirai_v1_lms_sync_proto_msgTypes[1].OneofWrappers = []any{}
+	file_mirai_v1_lms_sync_proto_msgTypes[2].OneofWrappers = []any{}
+	file_mirai_v1_lms_sync_proto_msgTypes[6].OneofWrappers = []any{}
+	file_mirai_v1_lms_sync_proto_msgTypes[11].OneofWrappers = []any{}
+	file_mirai_v1_lms_sync_proto_msgTypes[12].OneofWrappers = []any{}
+	file_mirai_v1_lms_sync_proto_msgTypes[13].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_mirai_v1_lms_sync_proto_rawDesc), len(file_mirai_v1_lms_sync_proto_rawDesc)),
+			NumEnums:      3,
+			NumMessages:   21,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_mirai_v1_lms_sync_proto_goTypes,
+		DependencyIndexes: file_mirai_v1_lms_sync_proto_depIdxs,
+		EnumInfos:         file_mirai_v1_lms_sync_proto_enumTypes,
+		MessageInfos:      file_mirai_v1_lms_sync_proto_msgTypes,
+	}.Build()
+	File_mirai_v1_lms_sync_proto = out.File
+	file_mirai_v1_lms_sync_proto_goTypes = nil
+	file_mirai_v1_lms_sync_proto_depIdxs = nil
+}
@@ -0,0 +1,1861 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: mirai/v1/maintenance.proto
+
+package miraiv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// MaintenanceStatus describes the read-only maintenance flag.
+// While enabled, mutating RPCs fail with UNAVAILABLE and background jobs pause.
+type MaintenanceStatus struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Enabled           bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Reason            string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	StartedAt         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	EndsAt            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`                                     // Flag clears itself at this time
+	RetryAfterSeconds int32                  `protobuf:"varint,5,opt,name=retry_after_seconds,json=retryAfterSeconds,proto3" json:"retry_after_seconds,omitempty"` // Suggested wait before retrying writes
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *MaintenanceStatus) Reset() {
+	*x = MaintenanceStatus{}
+	mi := &file_mirai_v1_maintenance_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaintenanceStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintenanceStatus) ProtoMessage() {}
+
+func (x *MaintenanceStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_maintenance_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintenanceStatus.ProtoReflect.Descriptor instead.
+func (*MaintenanceStatus) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_maintenance_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MaintenanceStatus) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *MaintenanceStatus) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *MaintenanceStatus) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *MaintenanceStatus) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *MaintenanceStatus) GetRetryAfterSeconds() int32 {
+	if x != nil {
+		return x.RetryAfterSeconds
+	}
+	return 0
+}
+
+// GetMaintenanceModeRequest is empty.
+type GetMaintenanceModeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMaintenanceModeRequest) Reset() {
+	*x = GetMaintenanceModeRequest{}
+	mi := &file_mirai_v1_maintenance_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMaintenanceModeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMaintenanceModeRequest) ProtoMessage() {}
+
+func (x *GetMaintenanceModeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_maintenance_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMaintenanceModeRequest.ProtoReflect.Descriptor instead.
+func (*GetMaintenanceModeRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_maintenance_proto_rawDescGZIP(), []int{1}
+}
+
+// GetMaintenanceModeResponse contains the maintenance flag.
+type GetMaintenanceModeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *MaintenanceStatus     `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMaintenanceModeResponse) Reset() {
+	*x = GetMaintenanceModeResponse{}
+	mi := &file_mirai_v1_maintenance_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMaintenanceModeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMaintenanceModeResponse) ProtoMessage() {}
+
+func (x *GetMaintenanceModeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_maintenance_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMaintenanceModeResponse.ProtoReflect.Descriptor instead.
+func (*GetMaintenanceModeResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_maintenance_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetMaintenanceModeResponse) GetStatus() *MaintenanceStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// SetMaintenanceModeRequest enables or disables maintenance mode.
+type SetMaintenanceModeRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Enabled         bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Reason          string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`                                           // Shown to users while enabled
+	DurationMinutes int32                  `protobuf:"varint,3,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"` // Defaults to 60, max 1440
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SetMaintenanceModeRequest) Reset() {
+	*x = SetMaintenanceModeRequest{}
+	mi := &file_mirai_v1_maintenance_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMaintenanceModeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMaintenanceModeRequest) ProtoMessage() {}
+
+func (x *SetMaintenanceModeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_maintenance_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMaintenanceModeRequest.ProtoReflect.Descriptor instead.
+func (*SetMaintenanceModeRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_maintenance_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SetMaintenanceModeRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SetMaintenanceModeRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SetMaintenanceModeRequest) GetDurationMinutes() int32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
+// SetMaintenanceModeResponse contains the updated maintenance flag.
+type SetMaintenanceModeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *MaintenanceStatus     `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMaintenanceModeResponse) Reset() {
+	*x = SetMaintenanceModeResponse{}
+	mi := &file_mirai_v1_maintenance_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMaintenanceModeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMaintenanceModeResponse) ProtoMessage() {}
+
+func (x *SetMaintenanceModeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_maintenance_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMaintenanceModeResponse.ProtoReflect.Descriptor instead.
+func (*SetMaintenanceModeResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_maintenance_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SetMaintenanceModeResponse) GetStatus() *MaintenanceStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// WarmTenantCacheRequest selects the tenant to warm.
+type WarmTenantCacheRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	RecentCourses int32                  `protobuf:"varint,2,opt,name=recent_courses,json=recentCourses,proto3" json:"recent_courses,omitempty"` // Course metadata entries to load; defaults to 50, max 500
+	Background    bool                   `protobuf:"varint,3,opt,name=background,proto3" json:"background,omitempty"`                            // Queue the warm for the worker instead of waiting for it
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WarmTenantCacheRequest) Reset() {
+	*x = WarmTenantCacheRequest{}
+	mi := &file_mirai_v1_maintenance_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WarmTenantCacheRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarmTenantCacheRequest) ProtoMessage() {}
+
+func (x *WarmTenantCacheRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_maintenance_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarmTenantCacheRequest.ProtoReflect.Descriptor instead.
+func (*WarmTenantCacheRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_maintenance_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *WarmTenantCacheRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *WarmTenantCacheRequest) GetRecentCourses() int32 {
+	if x != nil {
+		return x.RecentCourses
+	}
+	return 0
+}
+
+func (x *WarmTenantCacheRequest) GetBackground() bool {
+	if x != nil {
+		return x.Background
+	}
+	return false
+}
+
+// WarmTenantCacheResponse reports what the warm wrote.
+type WarmTenantCacheResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeysWritten   int32                  `protobuf:"varint,1,opt,name=keys_written,json=keysWritten,proto3" json:"keys_written,omitempty"`
+	DurationMs    int64                  `protobuf:"varint,2,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	Queued        bool                   `protobuf:"varint,3,opt,name=queued,proto3" json:"queued,omitempty"` // Handed to the worker; keys_written and duration_ms are zero
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WarmTenantCacheResponse) Reset() {
+	*x = WarmTenantCacheResponse{}
+	mi := &file_mirai_v1_maintenance_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WarmTenantCacheResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarmTenantCacheResponse) ProtoMessage() {}
+
+func (x *WarmTenantCacheResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_maintenance_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarmTenantCacheResponse.ProtoReflect.Descriptor instead.
+func (*WarmTenantCacheResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_maintenance_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *WarmTenantCacheResponse) GetKeysWritten() int32 {
+	if x != nil {
+		return x.KeysWritten
+	}
+	return 0
+}
+
+func (x *WarmTenantCacheResponse) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *WarmTenantCacheResponse) GetQueued() bool {
+	if x != nil {
+		return x.Queued
+	}
+	return false
+}
+
+// InvalidateTenantCacheRequest selects the tenant and entries to drop.
+type InvalidateTenantCacheRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Patterns      []string               `protobuf:"bytes,2,rep,name=patterns,proto3" json:"patterns,omitempty"` // Key patterns within the tenant, e.g. "course:*"; empty drops everything
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvalidateTenantCacheRequest) Reset() {
+	*x = InvalidateTenantCacheRequest{}
+	mi := &file_mirai_v1_maintenance_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvalidateTenantCacheRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidateTenantCacheRequest) ProtoMessage() {}
+
+func (x *InvalidateTenantCacheRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_maintenance_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvalidateTenantCacheRequest.ProtoReflect.Descriptor instead.
+func (*InvalidateTenantCacheRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_maintenance_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *InvalidateTenantCacheRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *InvalidateTenantCacheRequest) GetPatterns() []string {
+	if x != nil {
+		return x.Patterns
+	}
+	return nil
+}
+
+// InvalidateTenantCacheResponse is empty.
+type InvalidateTenantCacheResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvalidateTenantCacheResponse) Reset() {
+	*x = InvalidateTenantCacheResponse{}
+	mi := &file_mirai_v1_maintenance_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvalidateTenantCacheResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidateTenantCacheResponse) ProtoMessage() {}
+
+func (x *InvalidateTenantCacheResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_maintenance_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvalidateTenantCacheResponse.ProtoReflect.Descriptor instead.
+func (*InvalidateTenantCacheResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_maintenance_proto_rawDescGZIP(), []int{8}
+}
+
+// GetSystemDiagnosticsRequest is empty.
+type GetSystemDiagnosticsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSystemDiagnosticsRequest) Reset() {
+	*x = GetSystemDiagnosticsRequest{}
+	mi := &file_mirai_v1_maintenance_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSystemDiagnosticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSystemDiagnosticsRequest) ProtoMessage() {}
+
+func (x *GetSystemDiagnosticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_maintenance_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSystemDiagnosticsRequest.ProtoReflect.Descriptor instead.
+func (*GetSystemDiagnosticsRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_maintenance_proto_rawDescGZIP(), []int{9}
+}
+
+// ComponentHealth is the outcome of one dependency check.
+type ComponentHealth struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Healthy       bool                   `protobuf:"varint,1,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	LatencyMs     int64                  `protobuf:"varint,2,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"` // Set when unhealthy
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ComponentHealth) Reset() {
+	*x = ComponentHealth{}
+	mi := &file_mirai_v1_maintenance_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ComponentHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComponentHealth) ProtoMessage() {}
+
+func (x *ComponentHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_maintenance_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComponentHealth.ProtoReflect.Descriptor instead.
+func (*ComponentHealth) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_maintenance_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ComponentHealth) GetHealthy() bool {
+	if x != nil {
+		return x.Healthy
+	}
+	return false
+}
+
+func (x *ComponentHealth) GetLatencyMs() int64 {
+	if x != nil {
+		return x.LatencyMs
+	}
+	return 0
+}
+
+func (x *ComponentHealth) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// WorkerServer is a running worker server.
+type WorkerServer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Host          string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Pid           int32                  `protobuf:"varint,2,opt,name=pid,proto3" json:"pid,omitempty"`
+	Concurrency   int32                  `protobuf:"varint,3,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	ActiveWorkers int32                  `protobuf:"varint,4,opt,name=active_workers,json=activeWorkers,proto3" json:"active_workers,omitempty"` // Tasks being processed right now
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`                                     // "active" or "stopped"
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkerServer) Reset() {
+	*x = WorkerServer{}
+	mi := &file_mirai_v1_maintenance_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkerServer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkerServer) ProtoMessage() {}
+
+func (x *WorkerServer) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_maintenance_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkerServer.ProtoReflect.Descriptor instead.
+func (*WorkerServer) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_maintenance_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *WorkerServer) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *WorkerServer) GetPid() int32 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *WorkerServer) GetConcurrency() int32 {
+	if x != nil {
+		return x.Concurrency
+	}
+	return 0
+}
+
+func (x *WorkerServer) GetActiveWorkers() int32 {
+	if x != nil {
+		return x.ActiveWorkers
+	}
+	return 0
+}
+
+func (x *WorkerServer) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WorkerServer) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+// QueueDepth is the number of tasks in a queue, by state.
+type QueueDepth struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Queue         string                 `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	Pending       int32                  `protobuf:"varint,2,opt,name=pending,proto3" json:"pending,omitempty"`
+	Active        int32                  `protobuf:"varint,3,opt,name=active,proto3" json:"active,omitempty"`
+	Scheduled     int32                  `protobuf:"varint,4,opt,name=scheduled,proto3" json:"scheduled,omitempty"`
+	Retry         int32                  `protobuf:"varint,5,opt,name=retry,proto3" json:"retry,omitempty"`
+	Archived      int32                  `protobuf:"varint,6,opt,name=archived,proto3" json:"archived,omitempty"`
+	LatencyMs     int64                  `protobuf:"varint,7,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"` // Age of the oldest pending task
+	Paused        bool                   `protobuf:"varint,8,opt,name=paused,proto3" json:"paused,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueueDepth) Reset() {
+	*x = QueueDepth{}
+	mi := &file_mirai_v1_maintenance_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueueDepth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueDepth) ProtoMessage() {}
+
+func (x *QueueDepth) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_maintenance_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueDepth.ProtoReflect.Descriptor instead.
+func (*QueueDepth) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_maintenance_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *QueueDepth) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+func (x *QueueDepth) GetPending() int32 {
+	if x != nil {
+		return x.Pending
+	}
+	return 0
+}
+
+func (x *QueueDepth) GetActive() int32 {
+	if x != nil {
+		return x.Active
+	}
+	return 0
+}
+
+func (x *QueueDepth) GetScheduled() int32 {
+	if x != nil {
+		return x.Scheduled
+	}
+	return 0
+}
+
+func (x *QueueDepth) GetRetry() int32 {
+	if x != nil {
+		return x.Retry
+	}
+	return 0
+}
+
+func (x *QueueDepth) GetArchived() int32 {
+	if x != nil {
+		return x.Archived
+	}
+	return 0
+}
+
+func (x *QueueDepth) GetLatencyMs() int64 {
+	if x != nil {
+		return x.LatencyMs
+	}
+	return 0
+}
+
+func (x *QueueDepth) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+// ScheduledTaskStatus is a task's schedule and its last successful run.
+type ScheduledTaskStatus struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TaskType        string                 `protobuf:"bytes,1,opt,name=task_type,json=taskType,proto3" json:"task_type,omitempty"`
+	Schedule        string                 `protobuf:"bytes,2,opt,name=schedule,proto3" json:"schedule,omitempty"`                                  // Empty for tasks that aren't scheduled, e.g. provisioning
+	NextEnqueueAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=next_enqueue_at,json=nextEnqueueAt,proto3" json:"next_enqueue_at,omitempty"` // Unset when no running scheduler has the task
+	LastEnqueuedAt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_enqueued_at,json=lastEnqueuedAt,proto3" json:"last_enqueued_at,omitempty"`
+	LastSucceededAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_succeeded_at,json=lastSucceededAt,proto3" json:"last_succeeded_at,omitempty"` // Unset if the task never succeeded
+	LastDurationMs  int64                  `protobuf:"varint,6,opt,name=last_duration_ms,json=lastDurationMs,proto3" json:"last_duration_ms,omitempty"`
+	WorkerHost      string                 `protobuf:"bytes,7,opt,name=worker_host,json=workerHost,proto3" json:"worker_host,omitempty"` // Worker that ran the last successful run
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ScheduledTaskStatus) Reset() {
+	*x = ScheduledTaskStatus{}
+	mi := &file_mirai_v1_maintenance_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduledTaskStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledTaskStatus) ProtoMessage() {}
+
+func (x *ScheduledTaskStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_maintenance_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledTaskStatus.ProtoReflect.Descriptor instead.
+func (*ScheduledTaskStatus) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_maintenance_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ScheduledTaskStatus) GetTaskType() string {
+	if x != nil {
+		return x.TaskType
+	}
+	return ""
+}
+
+func (x *ScheduledTaskStatus) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *ScheduledTaskStatus) GetNextEnqueueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextEnqueueAt
+	}
+	return nil
+}
+
+func (x *ScheduledTaskStatus) GetLastEnqueuedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastEnqueuedAt
+	}
+	return nil
+}
+
+func (x *ScheduledTaskStatus) GetLastSucceededAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSucceededAt
+	}
+	return nil
+}
+
+func (x *ScheduledTaskStatus) GetLastDurationMs() int64 {
+	if x != nil {
+		return x.LastDurationMs
+	}
+	return 0
+}
+
+func (x *ScheduledTaskStatus) GetWorkerHost() string {
+	if x != nil {
+		return x.WorkerHost
+	}
+	return ""
+}
+
+// BuildInfo identifies the running build.
+type BuildInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       string                 `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Commit        string                 `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
+	GoVersion     string                 `protobuf:"bytes,3,opt,name=go_version,json=goVersion,proto3" json:"go_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildInfo) Reset() {
+	*x = BuildInfo{}
+	mi := &file_mirai_v1_maintenance_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildInfo) ProtoMessage() {}
+
+func (x *BuildInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_maintenance_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildInfo.ProtoReflect.Descriptor instead.
+func (*BuildInfo) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_maintenance_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *BuildInfo) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *BuildInfo) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+func (x *BuildInfo) GetGoVersion() string {
+	if x != nil {
+		return x.GoVersion
+	}
+	return ""
+}
+
+// GetSystemDiagnosticsResponse is a snapshot of the backend's background services and
+// dependencies. Sections that couldn't be read carry an error.
+type GetSystemDiagnosticsResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	CheckedAt           *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"`
+	Build               *BuildInfo             `protobuf:"bytes,2,opt,name=build,proto3" json:"build,omitempty"`
+	Database            *ComponentHealth       `protobuf:"bytes,3,opt,name=database,proto3" json:"database,omitempty"`
+	Redis               *ComponentHealth       `protobuf:"bytes,4,opt,name=redis,proto3" json:"redis,omitempty"`
+	Storage             *ComponentHealth       `protobuf:"bytes,5,opt,name=storage,proto3" json:"storage,omitempty"`
+	WorkerUp            bool                   `protobuf:"varint,6,opt,name=worker_up,json=workerUp,proto3" json:"worker_up,omitempty"` // At least one worker server is active
+	WorkerServers       []*WorkerServer        `protobuf:"bytes,7,rep,name=worker_servers,json=workerServers,proto3" json:"worker_servers,omitempty"`
+	WorkerError         string                 `protobuf:"bytes,8,opt,name=worker_error,json=workerError,proto3" json:"worker_error,omitempty"`
+	Queues              []*QueueDepth          `protobuf:"bytes,9,rep,name=queues,proto3" json:"queues,omitempty"`
+	QueuesError         string                 `protobuf:"bytes,10,opt,name=queues_error,json=queuesError,proto3" json:"queues_error,omitempty"`
+	ScheduledTasks      []*ScheduledTaskStatus `protobuf:"bytes,11,rep,name=scheduled_tasks,json=scheduledTasks,proto3" json:"scheduled_tasks,omitempty"`
+	ScheduledTasksError string                 `protobuf:"bytes,12,opt,name=scheduled_tasks_error,json=scheduledTasksError,proto3" json:"scheduled_tasks_error,omitempty"`
+	CacheStats          *CacheStats            `protobuf:"bytes,13,opt,name=cache_stats,json=cacheStats,proto3" json:"cache_stats,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GetSystemDiagnosticsResponse) Reset() {
+	*x = GetSystemDiagnosticsResponse{}
+	mi := &file_mirai_v1_maintenance_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSystemDiagnosticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSystemDiagnosticsResponse) ProtoMessage() {}
+
+func (x *GetSystemDiagnosticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_maintenance_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSystemDiagnosticsResponse.ProtoReflect.Descriptor instead.
+func (*GetSystemDiagnosticsResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_maintenance_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetSystemDiagnosticsResponse) GetCheckedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return nil
+}
+
+func (x *GetSystemDiagnosticsResponse) GetBuild() *BuildInfo {
+	if x != nil {
+		return x.Build
+	}
+	return nil
+}
+
+func (x *GetSystemDiagnosticsResponse) GetDatabase() *ComponentHealth {
+	if x != nil {
+		return x.Database
+	}
+	return nil
+}
+
+func (x *GetSystemDiagnosticsResponse) GetRedis() *ComponentHealth {
+	if x != nil {
+		return x.Redis
+	}
+	return nil
+}
+
+func (x *GetSystemDiagnosticsResponse) GetStorage() *ComponentHealth {
+	if x != nil {
+		return x.Storage
+	}
+	return nil
+}
+
+func (x *GetSystemDiagnosticsResponse) GetWorkerUp() bool {
+	if x != nil {
+		return x.WorkerUp
+	}
+	return false
+}
+
+func (x *GetSystemDiagnosticsResponse) GetWorkerServers() []*WorkerServer {
+	if x != nil {
+		return x.WorkerServers
+	}
+	return nil
+}
+
+func (x *GetSystemDiagnosticsResponse) GetWorkerError() string {
+	if x != nil {
+		return x.WorkerError
+	}
+	return ""
+}
+
+func (x *GetSystemDiagnosticsResponse) GetQueues() []*QueueDepth {
+	if x != nil {
+		return x.Queues
+	}
+	return nil
+}
+
+func (x *GetSystemDiagnosticsResponse) GetQueuesError() string {
+	if x != nil {
+		return x.QueuesError
+	}
+	return ""
+}
+
+func (x *GetSystemDiagnosticsResponse) GetScheduledTasks() []*ScheduledTaskStatus {
+	if x != nil {
+		return x.ScheduledTasks
+	}
+	return nil
+}
+
+func (x *GetSystemDiagnosticsResponse) GetScheduledTasksError() string {
+	if x != nil {
+		return x.ScheduledTasksError
+	}
+	return ""
+}
+
+func (x *GetSystemDiagnosticsResponse) GetCacheStats() *CacheStats {
+	if x != nil {
+		return x.CacheStats
+	}
+	return nil
+}
+
+// CacheNamespaceStats counts the cache operations on one key namespace.
+type CacheNamespaceStats struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Scope           string                 `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope,omitempty"`         // "tenant" or "global"
+	Namespace       string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"` // First key segment, e.g. "courses", or a global key's name, e.g. "user-tenant-mapping"
+	Hits            int64                  `protobuf:"varint,3,opt,name=hits,proto3" json:"hits,omitempty"`
+	Misses          int64                  `protobuf:"varint,4,opt,name=misses,proto3" json:"misses,omitempty"`
+	Errors          int64                  `protobuf:"varint,5,opt,name=errors,proto3" json:"errors,omitempty"` // Failed reads and writes
+	Writes          int64                  `protobuf:"varint,6,opt,name=writes,proto3" json:"writes,omitempty"`
+	HitRate         float64                `protobuf:"fixed64,7,opt,name=hit_rate,json=hitRate,proto3" json:"hit_rate,omitempty"` // Hits over hits and misses; 0 without reads
+	GetLatencyAvgMs float64                `protobuf:"fixed64,8,opt,name=get_latency_avg_ms,json=getLatencyAvgMs,proto3" json:"get_latency_avg_ms,omitempty"`
+	GetLatencyP95Ms float64                `protobuf:"fixed64,9,opt,name=get_latency_p95_ms,json=getLatencyP95Ms,proto3" json:"get_latency_p95_ms,omitempty"` // Upper bound of the histogram bucket holding the 95th percentile; -1 if over 1s
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CacheNamespaceStats) Reset() {
+	*x = CacheNamespaceStats{}
+	mi := &file_mirai_v1_maintenance_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CacheNamespaceStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CacheNamespaceStats) ProtoMessage() {}
+
+func (x *CacheNamespaceStats) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_maintenance_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CacheNamespaceStats.ProtoReflect.Descriptor instead.
+func (*CacheNamespaceStats) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_maintenance_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CacheNamespaceStats) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *CacheNamespaceStats) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *CacheNamespaceStats) GetHits() int64 {
+	if x != nil {
+		return x.Hits
+	}
+	return 0
+}
+
+func (x *CacheNamespaceStats) GetMisses() int64 {
+	if x != nil {
+		return x.Misses
+	}
+	return 0
+}
+
+func (x *CacheNamespaceStats) GetErrors() int64 {
+	if x != nil {
+		return x.Errors
+	}
+	return 0
+}
+
+func (x *CacheNamespaceStats) GetWrites() int64 {
+	if x != nil {
+		return x.Writes
+	}
+	return 0
+}
+
+func (x *CacheNamespaceStats) GetHitRate() float64 {
+	if x != nil {
+		return x.HitRate
+	}
+	return 0
+}
+
+func (x *CacheNamespaceStats) GetGetLatencyAvgMs() float64 {
+	if x != nil {
+		return x.GetLatencyAvgMs
+	}
+	return 0
+}
+
+func (x *CacheNamespaceStats) GetGetLatencyP95Ms() float64 {
+	if x != nil {
+		return x.GetLatencyP95Ms
+	}
+	return 0
+}
+
+// CacheStats is the cache's hit rates since the server process started. Each replica
+// counts its own operations.
+type CacheStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Since         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=since,proto3" json:"since,omitempty"`
+	Hits          int64                  `protobuf:"varint,2,opt,name=hits,proto3" json:"hits,omitempty"`
+	Misses        int64                  `protobuf:"varint,3,opt,name=misses,proto3" json:"misses,omitempty"`
+	Errors        int64                  `protobuf:"varint,4,opt,name=errors,proto3" json:"errors,omitempty"`
+	HitRate       float64                `protobuf:"fixed64,5,opt,name=hit_rate,json=hitRate,proto3" json:"hit_rate,omitempty"`
+	Namespaces    []*CacheNamespaceStats `protobuf:"bytes,6,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CacheStats) Reset() {
+	*x = CacheStats{}
+	mi := &file_mirai_v1_maintenance_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CacheStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CacheStats) ProtoMessage() {}
+
+func (x *CacheStats) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_maintenance_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CacheStats.ProtoReflect.Descriptor instead.
+func (*CacheStats) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_maintenance_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CacheStats) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *CacheStats) GetHits() int64 {
+	if x != nil {
+		return x.Hits
+	}
+	return 0
+}
+
+func (x *CacheStats) GetMisses() int64 {
+	if x != nil {
+		return x.Misses
+	}
+	return 0
+}
+
+func (x *CacheStats) GetErrors() int64 {
+	if x != nil {
+		return x.Errors
+	}
+	return 0
+}
+
+func (x *CacheStats) GetHitRate() float64 {
+	if x != nil {
+		return x.HitRate
+	}
+	return 0
+}
+
+func (x *CacheStats) GetNamespaces() []*CacheNamespaceStats {
+	if x != nil {
+		return x.Namespaces
+	}
+	return nil
+}
+
+// BackfillKnowledgeSummariesRequest is empty.
+type BackfillKnowledgeSummariesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BackfillKnowledgeSummariesRequest) Reset() {
+	*x = BackfillKnowledgeSummariesRequest{}
+	mi := &file_mirai_v1_maintenance_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BackfillKnowledgeSummariesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackfillKnowledgeSummariesRequest) ProtoMessage() {}
+
+func (x *BackfillKnowledgeSummariesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_maintenance_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackfillKnowledgeSummariesRequest.ProtoReflect.Descriptor instead.
+func (*BackfillKnowledgeSummariesRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_maintenance_proto_rawDescGZIP(), []int{18}
+}
+
+// BackfillKnowledgeSummariesResponse is empty; the backfill runs on the worker.
+type BackfillKnowledgeSummariesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BackfillKnowledgeSummariesResponse) Reset() {
+	*x = BackfillKnowledgeSummariesResponse{}
+	mi := &file_mirai_v1_maintenance_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BackfillKnowledgeSummariesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackfillKnowledgeSummariesResponse) ProtoMessage() {}
+
+func (x *BackfillKnowledgeSummariesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_maintenance_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackfillKnowledgeSummariesResponse.ProtoReflect.Descriptor instead.
+func (*BackfillKnowledgeSummariesResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_maintenance_proto_rawDescGZIP(), []int{19}
+}
+
+// RotateTenantStorageKeyRequest identifies the tenant whose key to rotate.
+type RotateTenantStorageKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateTenantStorageKeyRequest) Reset() {
+	*x = RotateTenantStorageKeyRequest{}
+	mi := &file_mirai_v1_maintenance_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateTenantStorageKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateTenantStorageKeyRequest) ProtoMessage() {}
+
+func (x *RotateTenantStorageKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_maintenance_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateTenantStorageKeyRequest.ProtoReflect.Descriptor instead.
+func (*RotateTenantStorageKeyRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_maintenance_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RotateTenantStorageKeyRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+// RotateTenantStorageKeyResponse reports the new key version.
+type RotateTenantStorageKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyVersion    int32                  `protobuf:"varint,1,opt,name=key_version,json=keyVersion,proto3" json:"key_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateTenantStorageKeyResponse) Reset() {
+	*x = RotateTenantStorageKeyResponse{}
+	mi := &file_mirai_v1_maintenance_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateTenantStorageKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateTenantStorageKeyResponse) ProtoMessage() {}
+
+func (x *RotateTenantStorageKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_maintenance_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateTenantStorageKeyResponse.ProtoReflect.Descriptor instead.
+func (*RotateTenantStorageKeyResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_maintenance_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RotateTenantStorageKeyResponse) GetKeyVersion() int32 {
+	if x != nil {
+		return x.KeyVersion
+	}
+	return 0
+}
+
+// EncryptTenantStorageRequest identifies the tenant to encrypt.
+type EncryptTenantStorageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"` // Empty encrypts every active tenant
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EncryptTenantStorageRequest) Reset() {
+	*x = EncryptTenantStorageRequest{}
+	mi := &file_mirai_v1_maintenance_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EncryptTenantStorageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EncryptTenantStorageRequest) ProtoMessage() {}
+
+func (x *EncryptTenantStorageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_maintenance_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EncryptTenantStorageRequest.ProtoReflect.Descriptor instead.
+func (*EncryptTenantStorageRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_maintenance_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *EncryptTenantStorageRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+// EncryptTenantStorageResponse is empty; the encryption runs on the worker.
+type EncryptTenantStorageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EncryptTenantStorageResponse) Reset() {
+	*x = EncryptTenantStorageResponse{}
+	mi := &file_mirai_v1_maintenance_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EncryptTenantStorageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EncryptTenantStorageResponse) ProtoMessage() {}
+
+func (x *EncryptTenantStorageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_maintenance_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EncryptTenantStorageResponse.ProtoReflect.Descriptor instead.
+func (*EncryptTenantStorageResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_maintenance_proto_rawDescGZIP(), []int{23}
+}
+
+// MigrateTenantStorageRegionRequest identifies the tenant and the region to move it to.
+type MigrateTenantStorageRegionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Region        string                 `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MigrateTenantStorageRegionRequest) Reset() {
+	*x = MigrateTenantStorageRegionRequest{}
+	mi := &file_mirai_v1_maintenance_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MigrateTenantStorageRegionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MigrateTenantStorageRegionRequest) ProtoMessage() {}
+
+func (x *MigrateTenantStorageRegionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_maintenance_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MigrateTenantStorageRegionRequest.ProtoReflect.Descriptor instead.
+func (*MigrateTenantStorageRegionRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_maintenance_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *MigrateTenantStorageRegionRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *MigrateTenantStorageRegionRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+// MigrateTenantStorageRegionResponse is empty; the migration runs on the worker.
+type MigrateTenantStorageRegionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MigrateTenantStorageRegionResponse) Reset() {
+	*x = MigrateTenantStorageRegionResponse{}
+	mi := &file_mirai_v1_maintenance_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MigrateTenantStorageRegionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MigrateTenantStorageRegionResponse) ProtoMessage() {}
+
+func (x *MigrateTenantStorageRegionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_maintenance_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MigrateTenantStorageRegionResponse.ProtoReflect.Descriptor instead.
+func (*MigrateTenantStorageRegionResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_maintenance_proto_rawDescGZIP(), []int{25}
+}
+
+var File_mirai_v1_maintenance_proto protoreflect.FileDescriptor
+
+const file_mirai_v1_maintenance_proto_rawDesc = "" +
+	"\n" +
+	"\x1amirai/v1/maintenance.proto\x12\bmirai.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe5\x01\n" +
+	"\x11MaintenanceStatus\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"started_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x123\n" +
+	"\aends_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x12.\n" +
+	"\x13retry_after_seconds\x18\x05 \x01(\x05R\x11retryAfterSeconds\"\x1b\n" +
+	"\x19GetMaintenanceModeRequest\"Q\n" +
+	"\x1aGetMaintenanceModeResponse\x123\n" +
+	"\x06status\x18\x01 \x01(\v2\x1b.mirai.v1.MaintenanceStatusR\x06status\"x\n" +
+	"\x19SetMaintenanceModeRequest\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12)\n" +
+	"\x10duration_minutes\x18\x03 \x01(\x05R\x0fdurationMinutes\"Q\n" +
+	"\x1aSetMaintenanceModeResponse\x123\n" +
+	"\x06status\x18\x01 \x01(\v2\x1b.mirai.v1.MaintenanceStatusR\x06status\"|\n" +
+	"\x16WarmTenantCacheRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12%\n" +
+	"\x0erecent_courses\x18\x02 \x01(\x05R\rrecentCourses\x12\x1e\n" +
+	"\n" +
+	"background\x18\x03 \x01(\bR\n" +
+	"background\"u\n" +
+	"\x17WarmTenantCacheResponse\x12!\n" +
+	"\fkeys_written\x18\x01 \x01(\x05R\vkeysWritten\x12\x1f\n" +
+	"\vduration_ms\x18\x02 \x01(\x03R\n" +
+	"durationMs\x12\x16\n" +
+	"\x06queued\x18\x03 \x01(\bR\x06queued\"W\n" +
+	"\x1cInvalidateTenantCacheRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x1a\n" +
+	"\bpatterns\x18\x02 \x03(\tR\bpatterns\"\x1f\n" +
+	"\x1dInvalidateTenantCacheResponse\"\x1d\n" +
+	"\x1bGetSystemDiagnosticsRequest\"`\n" +
+	"\x0fComponentHealth\x12\x18\n" +
+	"\ahealthy\x18\x01 \x01(\bR\ahealthy\x12\x1d\n" +
+	"\n" +
+	"latency_ms\x18\x02 \x01(\x03R\tlatencyMs\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"\xd0\x01\n" +
+	"\fWorkerServer\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\x12\x10\n" +
+	"\x03pid\x18\x02 \x01(\x05R\x03pid\x12 \n" +
+	"\vconcurrency\x18\x03 \x01(\x05R\vconcurrency\x12%\n" +
+	"\x0eactive_workers\x18\x04 \x01(\x05R\ractiveWorkers\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"started_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\"\xdb\x01\n" +
+	"\n" +
+	"QueueDepth\x12\x14\n" +
+	"\x05queue\x18\x01 \x01(\tR\x05queue\x12\x18\n" +
+	"\apending\x18\x02 \x01(\x05R\apending\x12\x16\n" +
+	"\x06active\x18\x03 \x01(\x05R\x06active\x12\x1c\n" +
+	"\tscheduled\x18\x04 \x01(\x05R\tscheduled\x12\x14\n" +
+	"\x05retry\x18\x05 \x01(\x05R\x05retry\x12\x1a\n" +
+	"\barchived\x18\x06 \x01(\x05R\barchived\x12\x1d\n" +
+	"\n" +
+	"latency_ms\x18\a \x01(\x03R\tlatencyMs\x12\x16\n" +
+	"\x06paused\x18\b \x01(\bR\x06paused\"\xeb\x02\n" +
+	"\x13ScheduledTaskStatus\x12\x1b\n" +
+	"\ttask_type\x18\x01 \x01(\tR\btaskType\x12\x1a\n" +
+	"\bschedule\x18\x02 \x01(\tR\bschedule\x12B\n" +
+	"\x0fnext_enqueue_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\rnextEnqueueAt\x12D\n" +
+	"\x10last_enqueued_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x0elastEnqueuedAt\x12F\n" +
+	"\x11last_succeeded_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x0flastSucceededAt\x12(\n" +
+	"\x10last_duration_ms\x18\x06 \x01(\x03R\x0elastDurationMs\x12\x1f\n" +
+	"\vworker_host\x18\a \x01(\tR\n" +
+	"workerHost\"\\\n" +
+	"\tBuildInfo\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\x12\x16\n" +
+	"\x06commit\x18\x02 \x01(\tR\x06commit\x12\x1d\n" +
+	"\n" +
+	"go_version\x18\x03 \x01(\tR\tgoVersion\"\xa4\x05\n" +
+	"\x1cGetSystemDiagnosticsResponse\x129\n" +
+	"\n" +
+	"checked_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tcheckedAt\x12)\n" +
+	"\x05build\x18\x02 \x01(\v2\x13.mirai.v1.BuildInfoR\x05build\x125\n" +
+	"\bdatabase\x18\x03 \x01(\v2\x19.mirai.v1.ComponentHealthR\bdatabase\x12/\n" +
+	"\x05redis\x18\x04 \x01(\v2\x19.mirai.v1.ComponentHealthR\x05redis\x123\n" +
+	"\astorage\x18\x05 \x01(\v2\x19.mirai.v1.ComponentHealthR\astorage\x12\x1b\n" +
+	"\tworker_up\x18\x06 \x01(\bR\bworkerUp\x12=\n" +
+	"\x0eworker_servers\x18\a \x03(\v2\x16.mirai.v1.WorkerServerR\rworkerServers\x12!\n" +
+	"\fworker_error\x18\b \x01(\tR\vworkerError\x12,\n" +
+	"\x06queues\x18\t \x03(\v2\x14.mirai.v1.QueueDepthR\x06queues\x12!\n" +
+	"\fqueues_error\x18\n" +
+	" \x01(\tR\vqueuesError\x12F\n" +
+	"\x0fscheduled_tasks\x18\v \x03(\v2\x1d.mirai.v1.ScheduledTaskStatusR\x0escheduledTasks\x122\n" +
+	"\x15scheduled_tasks_error\x18\f \x01(\tR\x13scheduledTasksError\x125\n" +
+	"\vcache_stats\x18\r \x01(\v2\x14.mirai.v1.CacheStatsR\n" +
+	"cacheStats\"\x9a\x02\n" +
+	"\x13CacheNamespaceStats\x12\x14\n" +
+	"\x05scope\x18\x01 \x01(\tR\x05scope\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04hits\x18\x03 \x01(\x03R\x04hits\x12\x16\n" +
+	"\x06misses\x18\x04 \x01(\x03R\x06misses\x12\x16\n" +
+	"\x06errors\x18\x05 \x01(\x03R\x06errors\x12\x16\n" +
+	"\x06writes\x18\x06 \x01(\x03R\x06writes\x12\x19\n" +
+	"\bhit_rate\x18\a \x01(\x01R\ahitRate\x12+\n" +
+	"\x12get_latency_avg_ms\x18\b \x01(\x01R\x0fgetLatencyAvgMs\x12+\n" +
+	"\x12get_latency_p95_ms\x18\t \x01(\x01R\x0fgetLatencyP95Ms\"\xdc\x01\n" +
+	"\n" +
+	"CacheStats\x120\n" +
+	"\x05since\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\x12\x12\n" +
+	"\x04hits\x18\x02 \x01(\x03R\x04hits\x12\x16\n" +
+	"\x06misses\x18\x03 \x01(\x03R\x06misses\x12\x16\n" +
+	"\x06errors\x18\x04 \x01(\x03R\x06errors\x12\x19\n" +
+	"\bhit_rate\x18\x05 \x01(\x01R\ahitRate\x12=\n" +
+	"\n" +
+	"namespaces\x18\x06 \x03(\v2\x1d.mirai.v1.CacheNamespaceStatsR\n" +
+	"namespaces\"#\n" +
+	"!BackfillKnowledgeSummariesRequest\"$\n" +
+	"\"BackfillKnowledgeSummariesResponse\"<\n" +
+	"\x1dRotateTenantStorageKeyRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\"A\n" +
+	"\x1eRotateTenantStorageKeyResponse\x12\x1f\n" +
+	"\vkey_version\x18\x01 \x01(\x05R\n" +
+	"keyVersion\":\n" +
+	"\x1bEncryptTenantStorageRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\"\x1e\n" +
+	"\x1cEncryptTenantStorageResponse\"X\n" +
+	"!MigrateTenantStorageRegionRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x16\n" +
+	"\x06region\x18\x02 \x01(\tR\x06region\"$\n" +
+	"\"MigrateTenantStorageRegionResponse2\xc5\a\n" +
+	"\x12MaintenanceService\x12_\n" +
+	"\x12GetMaintenanceMode\x12#.mirai.v1.GetMaintenanceModeRequest\x1a$.mirai.v1.GetMaintenanceModeResponse\x12_\n" +
+	"\x12SetMaintenanceMode\x12#.mirai.v1.SetMaintenanceModeRequest\x1a$.mirai.v1.SetMaintenanceModeResponse\x12V\n" +
+	"\x0fWarmTenantCache\x12 .mirai.v1.WarmTenantCacheRequest\x1a!.mirai.v1.WarmTenantCacheResponse\x12h\n" +
+	"\x15InvalidateTenantCache\x12&.mirai.v1.InvalidateTenantCacheRequest\x1a'.mirai.v1.InvalidateTenantCacheResponse\x12e\n" +
+	"\x14GetSystemDiagnostics\x12%.mirai.v1.GetSystemDiagnosticsRequest\x1a&.mirai.v1.GetSystemDiagnosticsResponse\x12w\n" +
+	"\x1aBackfillKnowledgeSummaries\x12+.mirai.v1.BackfillKnowledgeSummariesRequest\x1a,.mirai.v1.BackfillKnowledgeSummariesResponse\x12k\n" +
+	"\x16RotateTenantStorageKey\x12'.mirai.v1.RotateTenantStorageKeyRequest\x1a(.mirai.v1.RotateTenantStorageKeyResponse\x12e\n" +
+	"\x14EncryptTenantStorage\x12%.mirai.v1.EncryptTenantStorageRequest\x1a&.mirai.v1.EncryptTenantStorageResponse\x12w\n" +
+	"\x1aMigrateTenantStorageRegion\x12+.mirai.v1.MigrateTenantStorageRegionRequest\x1a,.mirai.v1.MigrateTenantStorageRegionResponseB\x96\x01\n" +
+	"\fcom.mirai.v1B\x10MaintenanceProtoP\x01Z3github.com/sogos/mirai-backend/gen/mirai/v1;miraiv1\xa2\x02\x03MXX\xaa\x02\bMirai.V1\xca\x02\bMirai\\V1\xe2\x02\x14Mirai\\V1\\GPBMetadata\xea\x02\tMirai::V1b\x06proto3"
+
+var (
+	file_mirai_v1_maintenance_proto_rawDescOnce sync.Once
+	file_mirai_v1_maintenance_proto_rawDescData []byte
+)
+
+func file_mirai_v1_maintenance_proto_rawDescGZIP() []byte {
+	file_mirai_v1_maintenance_proto_rawDescOnce.Do(func() {
+		file_mirai_v1_maintenance_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_mirai_v1_maintenance_proto_rawDesc), len(file_mirai_v1_maintenance_proto_rawDesc)))
+	})
+	return file_mirai_v1_maintenance_proto_rawDescData
+}
+
+var file_mirai_v1_maintenance_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_mirai_v1_maintenance_proto_goTypes = []any{
+	(*MaintenanceStatus)(nil),                  // 0: mirai.v1.MaintenanceStatus
+	(*GetMaintenanceModeRequest)(nil),          // 1: mirai.v1.GetMaintenanceModeRequest
+	(*GetMaintenanceModeResponse)(nil),         // 2: mirai.v1.GetMaintenanceModeResponse
+	(*SetMaintenanceModeRequest)(nil),          // 3: mirai.v1.SetMaintenanceModeRequest
+	(*SetMaintenanceModeResponse)(nil),         // 4: mirai.v1.SetMaintenanceModeResponse
+	(*WarmTenantCacheRequest)(nil),             // 5: mirai.v1.WarmTenantCacheRequest
+	(*WarmTenantCacheResponse)(nil),            // 6: mirai.v1.WarmTenantCacheResponse
+	(*InvalidateTenantCacheRequest)(nil),       // 7: mirai.v1.InvalidateTenantCacheRequest
+	(*InvalidateTenantCacheResponse)(nil),      // 8: mirai.v1.InvalidateTenantCacheResponse
+	(*GetSystemDiagnosticsRequest)(nil),        // 9: mirai.v1.GetSystemDiagnosticsRequest
+	(*ComponentHealth)(nil),                    // 10: mirai.v1.ComponentHealth
+	(*WorkerServer)(nil),                       // 11: mirai.v1.WorkerServer
+	(*QueueDepth)(nil),                         // 12: mirai.v1.QueueDepth
+	(*ScheduledTaskStatus)(nil),                // 13: mirai.v1.ScheduledTaskStatus
+	(*BuildInfo)(nil),                          // 14: mirai.v1.BuildInfo
+	(*GetSystemDiagnosticsResponse)(nil),       // 15: mirai.v1.GetSystemDiagnosticsResponse
+	(*CacheNamespaceStats)(nil),                // 16: mirai.v1.CacheNamespaceStats
+	(*CacheStats)(nil),                         // 17: mirai.v1.CacheStats
+	(*BackfillKnowledgeSummariesRequest)(nil),  // 18: mirai.v1.BackfillKnowledgeSummariesRequest
+	(*BackfillKnowledgeSummariesResponse)(nil), // 19: mirai.v1.BackfillKnowledgeSummariesResponse
+	(*RotateTenantStorageKeyRequest)(nil),      // 20: mirai.v1.RotateTenantStorageKeyRequest
+	(*RotateTenantStorageKeyResponse)(nil),     // 21: mirai.v1.RotateTenantStorageKeyResponse
+	(*EncryptTenantStorageRequest)(nil),        // 22: mirai.v1.EncryptTenantStorageRequest
+	(*EncryptTenantStorageResponse)(nil),       // 23: mirai.v1.EncryptTenantStorageResponse
+	(*MigrateTenantStorageRegionRequest)(nil),  // 24: mirai.v1.MigrateTenantStorageRegionRequest
+	(*MigrateTenantStorageRegionResponse)(nil), // 25: mirai.v1.MigrateTenantStorageRegionResponse
+	(*timestamppb.Timestamp)(nil),              // 26: google.protobuf.Timestamp
+}
+var file_mirai_v1_maintenance_proto_depIdxs = []int32{
+	26, // 0: mirai.v1.MaintenanceStatus.started_at:type_name -> google.protobuf.Timestamp
+	26, // 1: mirai.v1.MaintenanceStatus.ends_at:type_name -> google.protobuf.Timestamp
+	0,  // 2: mirai.v1.GetMaintenanceModeResponse.status:type_name -> mirai.v1.MaintenanceStatus
+	0,  // 3: mirai.v1.SetMaintenanceModeResponse.status:type_name -> mirai.v1.MaintenanceStatus
+	26, // 4: mirai.v1.WorkerServer.started_at:type_name -> google.protobuf.Timestamp
+	26, // 5: mirai.v1.ScheduledTaskStatus.next_enqueue_at:type_name -> google.protobuf.Timestamp
+	26, // 6: mirai.v1.ScheduledTaskStatus.last_enqueued_at:type_name -> google.protobuf.Timestamp
+	26, // 7: mirai.v1.ScheduledTaskStatus.last_succeeded_at:type_name -> google.protobuf.Timestamp
+	26, // 8: mirai.v1.GetSystemDiagnosticsResponse.checked_at:type_name -> google.protobuf.Timestamp
+	14, // 9: mirai.v1.GetSystemDiagnosticsResponse.build:type_name -> mirai.v1.BuildInfo
+	10, // 10: mirai.v1.GetSystemDiagnosticsResponse.database:type_name -> mirai.v1.ComponentHealth
+	10, // 11: mirai.v1.GetSystemDiagnosticsResponse.redis:type_name -> mirai.v1.ComponentHealth
+	10, // 12: mirai.v1.GetSystemDiagnosticsResponse.storage:type_name -> mirai.v1.ComponentHealth
+	11, // 13: mirai.v1.GetSystemDiagnosticsResponse.worker_servers:type_name -> mirai.v1.WorkerServer
+	12, // 14: mirai.v1.GetSystemDiagnosticsResponse.queues:type_name -> mirai.v1.QueueDepth
+	13, // 15: mirai.v1.GetSystemDiagnosticsResponse.scheduled_tasks:type_name -> mirai.v1.ScheduledTaskStatus
+	17, // 16: mirai.v1.GetSystemDiagnosticsResponse.cache_stats:type_name -> mirai.v1.CacheStats
+	26, // 17: mirai.v1.CacheStats.since:type_name -> google.protobuf.Timestamp
+	16, // 18: mirai.v1.CacheStats.namespaces:type_name -> mirai.v1.CacheNamespaceStats
+	1,  // 19: mirai.v1.MaintenanceService.GetMaintenanceMode:input_type -> mirai.v1.GetMaintenanceModeRequest
+	3,  // 20: mirai.v1.MaintenanceService.SetMaintenanceMode:input_type -> mirai.v1.SetMaintenanceModeRequest
+	5,  // 21: mirai.v1.MaintenanceService.WarmTenantCache:input_type -> mirai.v1.WarmTenantCacheRequest
+	7,  // 22: mirai.v1.MaintenanceService.InvalidateTenantCache:input_type -> mirai.v1.InvalidateTenantCacheRequest
+	9,  // 23: mirai.v1.MaintenanceService.GetSystemDiagnostics:input_type -> mirai.v1.GetSystemDiagnosticsRequest
+	18, // 24: mirai.v1.MaintenanceService.BackfillKnowledgeSummaries:input_type -> mirai.v1.BackfillKnowledgeSummariesRequest
+	20, // 25: mirai.v1.MaintenanceService.RotateTenantStorageKey:input_type -> mirai.v1.RotateTenantStorageKeyRequest
+	22, // 26: mirai.v1.MaintenanceService.EncryptTenantStorage:input_type -> mirai.v1.EncryptTenantStorageRequest
+	24, // 27: mirai.v1.MaintenanceService.MigrateTenantStorageRegion:input_type -> mirai.v1.MigrateTenantStorageRegionRequest
+	2,  // 28: mirai.v1.MaintenanceService.GetMaintenanceMode:output_type -> mirai.v1.GetMaintenanceModeResponse
+	4,  // 29: mirai.v1.MaintenanceService.SetMaintenanceMode:output_type -> mirai.v1.SetMaintenanceModeResponse
+	6,  // 30: mirai.v1.MaintenanceService.WarmTenantCache:output_type -> mirai.v1.WarmTenantCacheResponse
+	8,  // 31: mirai.v1.MaintenanceService.InvalidateTenantCache:output_type -> mirai.v1.InvalidateTenantCacheResponse
+	15, // 32: mirai.v1.MaintenanceService.GetSystemDiagnostics:output_type -> mirai.v1.GetSystemDiagnosticsResponse
+	19, // 33: mirai.v1.MaintenanceService.BackfillKnowledgeSummaries:output_type -> mirai.v1.BackfillKnowledgeSummariesResponse
+	21, // 34: mirai.v1.MaintenanceService.RotateTenantStorageKey:output_type -> mirai.v1.RotateTenantStorageKeyResponse
+	23, // 35: mirai.v1.MaintenanceService.EncryptTenantStorage:output_type -> mirai.v1.EncryptTenantStorageResponse
+	25, // 36: mirai.v1.MaintenanceService.MigrateTenantStorageRegion:output_type -> mirai.v1.MigrateTenantStorageRegionResponse
+	28, // [28:37] is the sub-list for method output_type
+	19, // [19:28] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
+}
+
+func init() { file_mirai_v1_maintenance_proto_init() }
+func file_mirai_v1_maintenance_proto_init() {
+	if File_mirai_v1_maintenance_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_mirai_v1_maintenance_proto_rawDesc), len(file_mirai_v1_maintenance_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   26,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_mirai_v1_maintenance_proto_goTypes,
+		DependencyIndexes: file_mirai_v1_maintenance_proto_depIdxs,
+		MessageInfos:      file_mirai_v1_maintenance_proto_msgTypes,
+	}.Build()
+	File_mirai_v1_maintenance_proto = out.File
+	file_mirai_v1_maintenance_proto_goTypes = nil
+	file_mirai_v1_maintenance_proto_depIdxs = nil
+}
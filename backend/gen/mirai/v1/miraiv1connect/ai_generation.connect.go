@@ -36,6 +36,15 @@ const (
 	// AIGenerationServiceGenerateCourseOutlineProcedure is the fully-qualified name of the
 	// AIGenerationService's GenerateCourseOutline RPC.
 	AIGenerationServiceGenerateCourseOutlineProcedure = "/mirai.v1.AIGenerationService/GenerateCourseOutline"
+	// AIGenerationServiceAnalyzeKnowledgeCoverageProcedure is the fully-qualified name of the
+	// AIGenerationService's AnalyzeKnowledgeCoverage RPC.
+	AIGenerationServiceAnalyzeKnowledgeCoverageProcedure = "/mirai.v1.AIGenerationService/AnalyzeKnowledgeCoverage"
+	// AIGenerationServiceSaveGenerationDraftProcedure is the fully-qualified name of the
+	// AIGenerationService's SaveGenerationDraft RPC.
+	AIGenerationServiceSaveGenerationDraftProcedure = "/mirai.v1.AIGenerationService/SaveGenerationDraft"
+	// AIGenerationServiceGetGenerationDraftProcedure is the fully-qualified name of the
+	// AIGenerationService's GetGenerationDraft RPC.
+	AIGenerationServiceGetGenerationDraftProcedure = "/mirai.v1.AIGenerationService/GetGenerationDraft"
 	// AIGenerationServiceGetCourseOutlineProcedure is the fully-qualified name of the
 	// AIGenerationService's GetCourseOutline RPC.
 	AIGenerationServiceGetCourseOutlineProcedure = "/mirai.v1.AIGenerationService/GetCourseOutline"
@@ -48,15 +57,42 @@ const (
 	// AIGenerationServiceUpdateCourseOutlineProcedure is the fully-qualified name of the
 	// AIGenerationService's UpdateCourseOutline RPC.
 	AIGenerationServiceUpdateCourseOutlineProcedure = "/mirai.v1.AIGenerationService/UpdateCourseOutline"
+	// AIGenerationServiceExportOutlineProcedure is the fully-qualified name of the
+	// AIGenerationService's ExportOutline RPC.
+	AIGenerationServiceExportOutlineProcedure = "/mirai.v1.AIGenerationService/ExportOutline"
+	// AIGenerationServiceBalanceOutlineDurationsProcedure is the fully-qualified name of the
+	// AIGenerationService's BalanceOutlineDurations RPC.
+	AIGenerationServiceBalanceOutlineDurationsProcedure = "/mirai.v1.AIGenerationService/BalanceOutlineDurations"
 	// AIGenerationServiceGenerateLessonContentProcedure is the fully-qualified name of the
 	// AIGenerationService's GenerateLessonContent RPC.
 	AIGenerationServiceGenerateLessonContentProcedure = "/mirai.v1.AIGenerationService/GenerateLessonContent"
 	// AIGenerationServiceGenerateAllLessonsProcedure is the fully-qualified name of the
 	// AIGenerationService's GenerateAllLessons RPC.
 	AIGenerationServiceGenerateAllLessonsProcedure = "/mirai.v1.AIGenerationService/GenerateAllLessons"
+	// AIGenerationServiceRetryFailedLessonsProcedure is the fully-qualified name of the
+	// AIGenerationService's RetryFailedLessons RPC.
+	AIGenerationServiceRetryFailedLessonsProcedure = "/mirai.v1.AIGenerationService/RetryFailedLessons"
+	// AIGenerationServiceExportAllLessonsProcedure is the fully-qualified name of the
+	// AIGenerationService's ExportAllLessons RPC.
+	AIGenerationServiceExportAllLessonsProcedure = "/mirai.v1.AIGenerationService/ExportAllLessons"
 	// AIGenerationServiceRegenerateComponentProcedure is the fully-qualified name of the
 	// AIGenerationService's RegenerateComponent RPC.
 	AIGenerationServiceRegenerateComponentProcedure = "/mirai.v1.AIGenerationService/RegenerateComponent"
+	// AIGenerationServiceEditComponentTextProcedure is the fully-qualified name of the
+	// AIGenerationService's EditComponentText RPC.
+	AIGenerationServiceEditComponentTextProcedure = "/mirai.v1.AIGenerationService/EditComponentText"
+	// AIGenerationServiceGetComponentSourcesProcedure is the fully-qualified name of the
+	// AIGenerationService's GetComponentSources RPC.
+	AIGenerationServiceGetComponentSourcesProcedure = "/mirai.v1.AIGenerationService/GetComponentSources"
+	// AIGenerationServiceGetComponentAssetUploadURLProcedure is the fully-qualified name of the
+	// AIGenerationService's GetComponentAssetUploadURL RPC.
+	AIGenerationServiceGetComponentAssetUploadURLProcedure = "/mirai.v1.AIGenerationService/GetComponentAssetUploadURL"
+	// AIGenerationServiceConfirmComponentAssetProcedure is the fully-qualified name of the
+	// AIGenerationService's ConfirmComponentAsset RPC.
+	AIGenerationServiceConfirmComponentAssetProcedure = "/mirai.v1.AIGenerationService/ConfirmComponentAsset"
+	// AIGenerationServiceSuggestCourseTitlesProcedure is the fully-qualified name of the
+	// AIGenerationService's SuggestCourseTitles RPC.
+	AIGenerationServiceSuggestCourseTitlesProcedure = "/mirai.v1.AIGenerationService/SuggestCourseTitles"
 	// AIGenerationServiceGetJobProcedure is the fully-qualified name of the AIGenerationService's
 	// GetJob RPC.
 	AIGenerationServiceGetJobProcedure = "/mirai.v1.AIGenerationService/GetJob"
@@ -72,26 +108,99 @@ const (
 	// AIGenerationServiceListGeneratedLessonsProcedure is the fully-qualified name of the
 	// AIGenerationService's ListGeneratedLessons RPC.
 	AIGenerationServiceListGeneratedLessonsProcedure = "/mirai.v1.AIGenerationService/ListGeneratedLessons"
+	// AIGenerationServiceGetCourseStatsProcedure is the fully-qualified name of the
+	// AIGenerationService's GetCourseStats RPC.
+	AIGenerationServiceGetCourseStatsProcedure = "/mirai.v1.AIGenerationService/GetCourseStats"
+	// AIGenerationServiceGetCoursePlayerViewProcedure is the fully-qualified name of the
+	// AIGenerationService's GetCoursePlayerView RPC.
+	AIGenerationServiceGetCoursePlayerViewProcedure = "/mirai.v1.AIGenerationService/GetCoursePlayerView"
+	// AIGenerationServiceGetAccessibilityReportProcedure is the fully-qualified name of the
+	// AIGenerationService's GetAccessibilityReport RPC.
+	AIGenerationServiceGetAccessibilityReportProcedure = "/mirai.v1.AIGenerationService/GetAccessibilityReport"
+	// AIGenerationServiceGetQueueStatusProcedure is the fully-qualified name of the
+	// AIGenerationService's GetQueueStatus RPC.
+	AIGenerationServiceGetQueueStatusProcedure = "/mirai.v1.AIGenerationService/GetQueueStatus"
+	// AIGenerationServiceListAnomaliesProcedure is the fully-qualified name of the
+	// AIGenerationService's ListAnomalies RPC.
+	AIGenerationServiceListAnomaliesProcedure = "/mirai.v1.AIGenerationService/ListAnomalies"
+	// AIGenerationServiceStartStorageAuditProcedure is the fully-qualified name of the
+	// AIGenerationService's StartStorageAudit RPC.
+	AIGenerationServiceStartStorageAuditProcedure = "/mirai.v1.AIGenerationService/StartStorageAudit"
+	// AIGenerationServiceGetStorageAuditReportProcedure is the fully-qualified name of the
+	// AIGenerationService's GetStorageAuditReport RPC.
+	AIGenerationServiceGetStorageAuditReportProcedure = "/mirai.v1.AIGenerationService/GetStorageAuditReport"
+	// AIGenerationServiceTranslateCourseProcedure is the fully-qualified name of the
+	// AIGenerationService's TranslateCourse RPC.
+	AIGenerationServiceTranslateCourseProcedure = "/mirai.v1.AIGenerationService/TranslateCourse"
+	// AIGenerationServiceCreateOutlineCommentProcedure is the fully-qualified name of the
+	// AIGenerationService's CreateOutlineComment RPC.
+	AIGenerationServiceCreateOutlineCommentProcedure = "/mirai.v1.AIGenerationService/CreateOutlineComment"
+	// AIGenerationServiceListOutlineCommentsProcedure is the fully-qualified name of the
+	// AIGenerationService's ListOutlineComments RPC.
+	AIGenerationServiceListOutlineCommentsProcedure = "/mirai.v1.AIGenerationService/ListOutlineComments"
+	// AIGenerationServiceResolveOutlineCommentProcedure is the fully-qualified name of the
+	// AIGenerationService's ResolveOutlineComment RPC.
+	AIGenerationServiceResolveOutlineCommentProcedure = "/mirai.v1.AIGenerationService/ResolveOutlineComment"
+	// AIGenerationServiceSetTenantAIEnabledProcedure is the fully-qualified name of the
+	// AIGenerationService's SetTenantAIEnabled RPC.
+	AIGenerationServiceSetTenantAIEnabledProcedure = "/mirai.v1.AIGenerationService/SetTenantAIEnabled"
+	// AIGenerationServiceMigrateQuizSchemaProcedure is the fully-qualified name of the
+	// AIGenerationService's MigrateQuizSchema RPC.
+	AIGenerationServiceMigrateQuizSchemaProcedure = "/mirai.v1.AIGenerationService/MigrateQuizSchema"
 )
 
 // AIGenerationServiceClient is a client for the mirai.v1.AIGenerationService service.
 type AIGenerationServiceClient interface {
 	// GenerateCourseOutline starts outline generation job.
 	GenerateCourseOutline(context.Context, *connect.Request[v1.GenerateCourseOutlineRequest]) (*connect.Response[v1.GenerateCourseOutlineResponse], error)
+	// AnalyzeKnowledgeCoverage checks whether the selected SMEs have enough material for a
+	// desired outcome, naming the topics that look thin. Nothing is saved.
+	AnalyzeKnowledgeCoverage(context.Context, *connect.Request[v1.AnalyzeKnowledgeCoverageRequest]) (*connect.Response[v1.AnalyzeKnowledgeCoverageResponse], error)
+	// SaveGenerationDraft stores the current user's generation wizard selections for a course,
+	// replacing any earlier draft. The draft is cleared when generation starts for the course.
+	SaveGenerationDraft(context.Context, *connect.Request[v1.SaveGenerationDraftRequest]) (*connect.Response[v1.SaveGenerationDraftResponse], error)
+	// GetGenerationDraft returns the current user's generation wizard draft for a course.
+	GetGenerationDraft(context.Context, *connect.Request[v1.GetGenerationDraftRequest]) (*connect.Response[v1.GetGenerationDraftResponse], error)
 	// GetCourseOutline returns the generated outline for a course.
 	GetCourseOutline(context.Context, *connect.Request[v1.GetCourseOutlineRequest]) (*connect.Response[v1.GetCourseOutlineResponse], error)
-	// ApproveCourseOutline approves an outline for content generation.
+	// ApproveCourseOutline approves an outline for content generation. When the organization
+	// requires a second reviewer, the first approval by someone other than the outline's
+	// generator is recorded as an endorsement and the outline stays pending review.
 	ApproveCourseOutline(context.Context, *connect.Request[v1.ApproveCourseOutlineRequest]) (*connect.Response[v1.ApproveCourseOutlineResponse], error)
 	// RejectCourseOutline rejects an outline with feedback.
 	RejectCourseOutline(context.Context, *connect.Request[v1.RejectCourseOutlineRequest]) (*connect.Response[v1.RejectCourseOutlineResponse], error)
 	// UpdateCourseOutline allows editing the outline before approval.
 	UpdateCourseOutline(context.Context, *connect.Request[v1.UpdateCourseOutlineRequest]) (*connect.Response[v1.UpdateCourseOutlineResponse], error)
+	// ExportOutline renders an outline version as CSV or DOCX for review outside Mirai.
+	ExportOutline(context.Context, *connect.Request[v1.ExportOutlineRequest]) (*connect.Response[v1.ExportOutlineResponse], error)
+	// BalanceOutlineDurations proposes a revision of a pending outline that splits over-long
+	// lessons and merges short adjacent ones toward a per-lesson duration range. Nothing is
+	// saved; accept the proposal by sending its sections and removed_lesson_ids to
+	// UpdateCourseOutline.
+	BalanceOutlineDurations(context.Context, *connect.Request[v1.BalanceOutlineDurationsRequest]) (*connect.Response[v1.BalanceOutlineDurationsResponse], error)
 	// GenerateLessonContent generates content for a specific lesson.
 	GenerateLessonContent(context.Context, *connect.Request[v1.GenerateLessonContentRequest]) (*connect.Response[v1.GenerateLessonContentResponse], error)
 	// GenerateAllLessons generates content for all lessons in outline.
+	// Returns the running job instead if the course's lessons are already being generated.
 	GenerateAllLessons(context.Context, *connect.Request[v1.GenerateAllLessonsRequest]) (*connect.Response[v1.GenerateAllLessonsResponse], error)
+	// RetryFailedLessons requeues the failed lessons of a failed full course run under the same parent job.
+	RetryFailedLessons(context.Context, *connect.Request[v1.RetryFailedLessonsRequest]) (*connect.Response[v1.RetryFailedLessonsResponse], error)
+	// ExportAllLessons starts a job that packages every generated lesson into a ZIP in tenant storage.
+	ExportAllLessons(context.Context, *connect.Request[v1.ExportAllLessonsRequest]) (*connect.Response[v1.ExportAllLessonsResponse], error)
 	// RegenerateComponent regenerates a single component with modifications.
 	RegenerateComponent(context.Context, *connect.Request[v1.RegenerateComponentRequest]) (*connect.Response[v1.RegenerateComponentResponse], error)
+	// EditComponentText rewrites a text component inline and returns the proposal without saving.
+	EditComponentText(context.Context, *connect.Request[v1.EditComponentTextRequest]) (*connect.Response[v1.EditComponentTextResponse], error)
+	// GetComponentSources returns the SME knowledge chunks a component was generated from.
+	GetComponentSources(context.Context, *connect.Request[v1.GetComponentSourcesRequest]) (*connect.Response[v1.GetComponentSourcesResponse], error)
+	// GetComponentAssetUploadURL returns a presigned URL for uploading the file for a
+	// placeholder component, such as the video for a scripted video block.
+	GetComponentAssetUploadURL(context.Context, *connect.Request[v1.GetComponentAssetUploadURLRequest]) (*connect.Response[v1.GetComponentAssetUploadURLResponse], error)
+	// ConfirmComponentAsset attaches an uploaded file to its component.
+	ConfirmComponentAsset(context.Context, *connect.Request[v1.ConfirmComponentAssetRequest]) (*connect.Response[v1.ConfirmComponentAssetResponse], error)
+	// SuggestCourseTitles suggests course titles from the selected SMEs, audiences and outcome.
+	// Nothing is saved; apply a chosen title with CourseService.UpdateCourse.
+	SuggestCourseTitles(context.Context, *connect.Request[v1.SuggestCourseTitlesRequest]) (*connect.Response[v1.SuggestCourseTitlesResponse], error)
 	// GetJob returns a generation job by ID.
 	GetJob(context.Context, *connect.Request[v1.GetJobRequest]) (*connect.Response[v1.GetJobResponse], error)
 	// ListJobs returns generation jobs for the current user.
@@ -102,6 +211,45 @@ type AIGenerationServiceClient interface {
 	GetGeneratedLesson(context.Context, *connect.Request[v1.GetGeneratedLessonRequest]) (*connect.Response[v1.GetGeneratedLessonResponse], error)
 	// ListGeneratedLessons returns all generated lessons for a course.
 	ListGeneratedLessons(context.Context, *connect.Request[v1.ListGeneratedLessonsRequest]) (*connect.Response[v1.ListGeneratedLessonsResponse], error)
+	// GetCourseStats returns word, quiz and image counts for a course's generated lessons.
+	GetCourseStats(context.Context, *connect.Request[v1.GetCourseStatsRequest]) (*connect.Response[v1.GetCourseStatsResponse], error)
+	// GetCoursePlayerView returns a course's lessons in display order for the learner-facing
+	// player, without authoring metadata. Published courses show their published version.
+	// Unpublished courses and drafts are only visible to their editors.
+	GetCoursePlayerView(context.Context, *connect.Request[v1.GetCoursePlayerViewRequest]) (*connect.Response[v1.GetCoursePlayerViewResponse], error)
+	// GetAccessibilityReport lists the accessibility problems left in a course's lessons, such
+	// as images without alt text, so authors can fix them before publishing. Editors only.
+	GetAccessibilityReport(context.Context, *connect.Request[v1.GetAccessibilityReportRequest]) (*connect.Response[v1.GetAccessibilityReportResponse], error)
+	// GetQueueStatus returns the tenant's active jobs and the state of the shared generation queue.
+	GetQueueStatus(context.Context, *connect.Request[v1.GetQueueStatusRequest]) (*connect.Response[v1.GetQueueStatusResponse], error)
+	// ListAnomalies returns generation anomalies across tenants.
+	// Requires a superadmin (SUPERADMIN_EMAILS).
+	ListAnomalies(context.Context, *connect.Request[v1.ListAnomaliesRequest]) (*connect.Response[v1.ListAnomaliesResponse], error)
+	// StartStorageAudit starts a job that reports stored objects of the caller's organization
+	// that no course, job, submission, SME or LMS delivery references. Admin only.
+	StartStorageAudit(context.Context, *connect.Request[v1.StartStorageAuditRequest]) (*connect.Response[v1.StartStorageAuditResponse], error)
+	// GetStorageAuditReport returns a storage audit job and, once it has completed, a link to
+	// its JSON report. The job's progress message summarizes the result. Admin only.
+	GetStorageAuditReport(context.Context, *connect.Request[v1.GetStorageAuditReportRequest]) (*connect.Response[v1.GetStorageAuditReportResponse], error)
+	// TranslateCourse copies a course into a new draft course in the target language and
+	// starts a job that translates its outline and generated lessons.
+	TranslateCourse(context.Context, *connect.Request[v1.TranslateCourseRequest]) (*connect.Response[v1.TranslateCourseResponse], error)
+	// CreateOutlineComment adds a reviewer comment to an outline lesson and notifies the
+	// course owner. Comments stay with the lesson across outline edits and versions.
+	CreateOutlineComment(context.Context, *connect.Request[v1.CreateOutlineCommentRequest]) (*connect.Response[v1.CreateOutlineCommentResponse], error)
+	// ListOutlineComments returns the comments on a course's outline lessons.
+	ListOutlineComments(context.Context, *connect.Request[v1.ListOutlineCommentsRequest]) (*connect.Response[v1.ListOutlineCommentsResponse], error)
+	// ResolveOutlineComment marks an outline comment resolved.
+	ResolveOutlineComment(context.Context, *connect.Request[v1.ResolveOutlineCommentRequest]) (*connect.Response[v1.ResolveOutlineCommentResponse], error)
+	// SetTenantAIEnabled pauses or resumes a tenant's AI generation. While paused, requests
+	// that start generation fail with FAILED_PRECONDITION and the tenant's queued jobs wait;
+	// resuming sends them back to the worker. Requires a superadmin (SUPERADMIN_EMAILS).
+	SetTenantAIEnabled(context.Context, *connect.Request[v1.SetTenantAIEnabledRequest]) (*connect.Response[v1.SetTenantAIEnabledResponse], error)
+	// MigrateQuizSchema queues an upgrade of quiz components in every tenant to the current
+	// quiz schema (schema_version in the content). Quizzes that can't be upgraded are left
+	// as they were. The counts are emailed to the operators. Requires a superadmin
+	// (SUPERADMIN_EMAILS).
+	MigrateQuizSchema(context.Context, *connect.Request[v1.MigrateQuizSchemaRequest]) (*connect.Response[v1.MigrateQuizSchemaResponse], error)
 }
 
 // NewAIGenerationServiceClient constructs a client for the mirai.v1.AIGenerationService service. By
@@ -121,6 +269,24 @@ func NewAIGenerationServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(aIGenerationServiceMethods.ByName("GenerateCourseOutline")),
 			connect.WithClientOptions(opts...),
 		),
+		analyzeKnowledgeCoverage: connect.NewClient[v1.AnalyzeKnowledgeCoverageRequest, v1.AnalyzeKnowledgeCoverageResponse](
+			httpClient,
+			baseURL+AIGenerationServiceAnalyzeKnowledgeCoverageProcedure,
+			connect.WithSchema(aIGenerationServiceMethods.ByName("AnalyzeKnowledgeCoverage")),
+			connect.WithClientOptions(opts...),
+		),
+		saveGenerationDraft: connect.NewClient[v1.SaveGenerationDraftRequest, v1.SaveGenerationDraftResponse](
+			httpClient,
+			baseURL+AIGenerationServiceSaveGenerationDraftProcedure,
+			connect.WithSchema(aIGenerationServiceMethods.ByName("SaveGenerationDraft")),
+			connect.WithClientOptions(opts...),
+		),
+		getGenerationDraft: connect.NewClient[v1.GetGenerationDraftRequest, v1.GetGenerationDraftResponse](
+			httpClient,
+			baseURL+AIGenerationServiceGetGenerationDraftProcedure,
+			connect.WithSchema(aIGenerationServiceMethods.ByName("GetGenerationDraft")),
+			connect.WithClientOptions(opts...),
+		),
 		getCourseOutline: connect.NewClient[v1.GetCourseOutlineRequest, v1.GetCourseOutlineResponse](
 			httpClient,
 			baseURL+AIGenerationServiceGetCourseOutlineProcedure,
@@ -145,6 +311,18 @@ func NewAIGenerationServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(aIGenerationServiceMethods.ByName("UpdateCourseOutline")),
 			connect.WithClientOptions(opts...),
 		),
+		exportOutline: connect.NewClient[v1.ExportOutlineRequest, v1.ExportOutlineResponse](
+			httpClient,
+			baseURL+AIGenerationServiceExportOutlineProcedure,
+			connect.WithSchema(aIGenerationServiceMethods.ByName("ExportOutline")),
+			connect.WithClientOptions(opts...),
+		),
+		balanceOutlineDurations: connect.NewClient[v1.BalanceOutlineDurationsRequest, v1.BalanceOutlineDurationsResponse](
+			httpClient,
+			baseURL+AIGenerationServiceBalanceOutlineDurationsProcedure,
+			connect.WithSchema(aIGenerationServiceMethods.ByName("BalanceOutlineDurations")),
+			connect.WithClientOptions(opts...),
+		),
 		generateLessonContent: connect.NewClient[v1.GenerateLessonContentRequest, v1.GenerateLessonContentResponse](
 			httpClient,
 			baseURL+AIGenerationServiceGenerateLessonContentProcedure,
@@ -157,12 +335,54 @@ func NewAIGenerationServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(aIGenerationServiceMethods.ByName("GenerateAllLessons")),
 			connect.WithClientOptions(opts...),
 		),
+		retryFailedLessons: connect.NewClient[v1.RetryFailedLessonsRequest, v1.RetryFailedLessonsResponse](
+			httpClient,
+			baseURL+AIGenerationServiceRetryFailedLessonsProcedure,
+			connect.WithSchema(aIGenerationServiceMethods.ByName("RetryFailedLessons")),
+			connect.WithClientOptions(opts...),
+		),
+		exportAllLessons: connect.NewClient[v1.ExportAllLessonsRequest, v1.ExportAllLessonsResponse](
+			httpClient,
+			baseURL+AIGenerationServiceExportAllLessonsProcedure,
+			connect.WithSchema(aIGenerationServiceMethods.ByName("ExportAllLessons")),
+			connect.WithClientOptions(opts...),
+		),
 		regenerateComponent: connect.NewClient[v1.RegenerateComponentRequest, v1.RegenerateComponentResponse](
 			httpClient,
 			baseURL+AIGenerationServiceRegenerateComponentProcedure,
 			connect.WithSchema(aIGenerationServiceMethods.ByName("RegenerateComponent")),
 			connect.WithClientOptions(opts...),
 		),
+		editComponentText: connect.NewClient[v1.EditComponentTextRequest, v1.EditComponentTextResponse](
+			httpClient,
+			baseURL+AIGenerationServiceEditComponentTextProcedure,
+			connect.WithSchema(aIGenerationServiceMethods.ByName("EditComponentText")),
+			connect.WithClientOptions(opts...),
+		),
+		getComponentSources: connect.NewClient[v1.GetComponentSourcesRequest, v1.GetComponentSourcesResponse](
+			httpClient,
+			baseURL+AIGenerationServiceGetComponentSourcesProcedure,
+			connect.WithSchema(aIGenerationServiceMethods.ByName("GetComponentSources")),
+			connect.WithClientOptions(opts...),
+		),
+		getComponentAssetUploadURL: connect.NewClient[v1.GetComponentAssetUploadURLRequest, v1.GetComponentAssetUploadURLResponse](
+			httpClient,
+			baseURL+AIGenerationServiceGetComponentAssetUploadURLProcedure,
+			connect.WithSchema(aIGenerationServiceMethods.ByName("GetComponentAssetUploadURL")),
+			connect.WithClientOptions(opts...),
+		),
+		confirmComponentAsset: connect.NewClient[v1.ConfirmComponentAssetRequest, v1.ConfirmComponentAssetResponse](
+			httpClient,
+			baseURL+AIGenerationServiceConfirmComponentAssetProcedure,
+			connect.WithSchema(aIGenerationServiceMethods.ByName("ConfirmComponentAsset")),
+			connect.WithClientOptions(opts...),
+		),
+		suggestCourseTitles: connect.NewClient[v1.SuggestCourseTitlesRequest, v1.SuggestCourseTitlesResponse](
+			httpClient,
+			baseURL+AIGenerationServiceSuggestCourseTitlesProcedure,
+			connect.WithSchema(aIGenerationServiceMethods.ByName("SuggestCourseTitles")),
+			connect.WithClientOptions(opts...),
+		),
 		getJob: connect.NewClient[v1.GetJobRequest, v1.GetJobResponse](
 			httpClient,
 			baseURL+AIGenerationServiceGetJobProcedure,
@@ -193,24 +413,127 @@ func NewAIGenerationServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(aIGenerationServiceMethods.ByName("ListGeneratedLessons")),
 			connect.WithClientOptions(opts...),
 		),
+		getCourseStats: connect.NewClient[v1.GetCourseStatsRequest, v1.GetCourseStatsResponse](
+			httpClient,
+			baseURL+AIGenerationServiceGetCourseStatsProcedure,
+			connect.WithSchema(aIGenerationServiceMethods.ByName("GetCourseStats")),
+			connect.WithClientOptions(opts...),
+		),
+		getCoursePlayerView: connect.NewClient[v1.GetCoursePlayerViewRequest, v1.GetCoursePlayerViewResponse](
+			httpClient,
+			baseURL+AIGenerationServiceGetCoursePlayerViewProcedure,
+			connect.WithSchema(aIGenerationServiceMethods.ByName("GetCoursePlayerView")),
+			connect.WithClientOptions(opts...),
+		),
+		getAccessibilityReport: connect.NewClient[v1.GetAccessibilityReportRequest, v1.GetAccessibilityReportResponse](
+			httpClient,
+			baseURL+AIGenerationServiceGetAccessibilityReportProcedure,
+			connect.WithSchema(aIGenerationServiceMethods.ByName("GetAccessibilityReport")),
+			connect.WithClientOptions(opts...),
+		),
+		getQueueStatus: connect.NewClient[v1.GetQueueStatusRequest, v1.GetQueueStatusResponse](
+			httpClient,
+			baseURL+AIGenerationServiceGetQueueStatusProcedure,
+			connect.WithSchema(aIGenerationServiceMethods.ByName("GetQueueStatus")),
+			connect.WithClientOptions(opts...),
+		),
+		listAnomalies: connect.NewClient[v1.ListAnomaliesRequest, v1.ListAnomaliesResponse](
+			httpClient,
+			baseURL+AIGenerationServiceListAnomaliesProcedure,
+			connect.WithSchema(aIGenerationServiceMethods.ByName("ListAnomalies")),
+			connect.WithClientOptions(opts...),
+		),
+		startStorageAudit: connect.NewClient[v1.StartStorageAuditRequest, v1.StartStorageAuditResponse](
+			httpClient,
+			baseURL+AIGenerationServiceStartStorageAuditProcedure,
+			connect.WithSchema(aIGenerationServiceMethods.ByName("StartStorageAudit")),
+			connect.WithClientOptions(opts...),
+		),
+		getStorageAuditReport: connect.NewClient[v1.GetStorageAuditReportRequest, v1.GetStorageAuditReportResponse](
+			httpClient,
+			baseURL+AIGenerationServiceGetStorageAuditReportProcedure,
+			connect.WithSchema(aIGenerationServiceMethods.ByName("GetStorageAuditReport")),
+			connect.WithClientOptions(opts...),
+		),
+		translateCourse: connect.NewClient[v1.TranslateCourseRequest, v1.TranslateCourseResponse](
+			httpClient,
+			baseURL+AIGenerationServiceTranslateCourseProcedure,
+			connect.WithSchema(aIGenerationServiceMethods.ByName("TranslateCourse")),
+			connect.WithClientOptions(opts...),
+		),
+		createOutlineComment: connect.NewClient[v1.CreateOutlineCommentRequest, v1.CreateOutlineCommentResponse](
+			httpClient,
+			baseURL+AIGenerationServiceCreateOutlineCommentProcedure,
+			connect.WithSchema(aIGenerationServiceMethods.ByName("CreateOutlineComment")),
+			connect.WithClientOptions(opts...),
+		),
+		listOutlineComments: connect.NewClient[v1.ListOutlineCommentsRequest, v1.ListOutlineCommentsResponse](
+			httpClient,
+			baseURL+AIGenerationServiceListOutlineCommentsProcedure,
+			connect.WithSchema(aIGenerationServiceMethods.ByName("ListOutlineComments")),
+			connect.WithClientOptions(opts...),
+		),
+		resolveOutlineComment: connect.NewClient[v1.ResolveOutlineCommentRequest, v1.ResolveOutlineCommentResponse](
+			httpClient,
+			baseURL+AIGenerationServiceResolveOutlineCommentProcedure,
+			connect.WithSchema(aIGenerationServiceMethods.ByName("ResolveOutlineComment")),
+			connect.WithClientOptions(opts...),
+		),
+		setTenantAIEnabled: connect.NewClient[v1.SetTenantAIEnabledRequest, v1.SetTenantAIEnabledResponse](
+			httpClient,
+			baseURL+AIGenerationServiceSetTenantAIEnabledProcedure,
+			connect.WithSchema(aIGenerationServiceMethods.ByName("SetTenantAIEnabled")),
+			connect.WithClientOptions(opts...),
+		),
+		migrateQuizSchema: connect.NewClient[v1.MigrateQuizSchemaRequest, v1.MigrateQuizSchemaResponse](
+			httpClient,
+			baseURL+AIGenerationServiceMigrateQuizSchemaProcedure,
+			connect.WithSchema(aIGenerationServiceMethods.ByName("MigrateQuizSchema")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // aIGenerationServiceClient implements AIGenerationServiceClient.
 type aIGenerationServiceClient struct {
-	generateCourseOutline *connect.Client[v1.GenerateCourseOutlineRequest, v1.GenerateCourseOutlineResponse]
-	getCourseOutline      *connect.Client[v1.GetCourseOutlineRequest, v1.GetCourseOutlineResponse]
-	approveCourseOutline  *connect.Client[v1.ApproveCourseOutlineRequest, v1.ApproveCourseOutlineResponse]
-	rejectCourseOutline   *connect.Client[v1.RejectCourseOutlineRequest, v1.RejectCourseOutlineResponse]
-	updateCourseOutline   *connect.Client[v1.UpdateCourseOutlineRequest, v1.UpdateCourseOutlineResponse]
-	generateLessonContent *connect.Client[v1.GenerateLessonContentRequest, v1.GenerateLessonContentResponse]
-	generateAllLessons    *connect.Client[v1.GenerateAllLessonsRequest, v1.GenerateAllLessonsResponse]
-	regenerateComponent   *connect.Client[v1.RegenerateComponentRequest, v1.RegenerateComponentResponse]
-	getJob                *connect.Client[v1.GetJobRequest, v1.GetJobResponse]
-	listJobs              *connect.Client[v1.ListJobsRequest, v1.ListJobsResponse]
-	cancelJob             *connect.Client[v1.CancelJobRequest, v1.CancelJobResponse]
-	getGeneratedLesson    *connect.Client[v1.GetGeneratedLessonRequest, v1.GetGeneratedLessonResponse]
-	listGeneratedLessons  *connect.Client[v1.ListGeneratedLessonsRequest, v1.ListGeneratedLessonsResponse]
+	generateCourseOutline      *connect.Client[v1.GenerateCourseOutlineRequest, v1.GenerateCourseOutlineResponse]
+	analyzeKnowledgeCoverage   *connect.Client[v1.AnalyzeKnowledgeCoverageRequest, v1.AnalyzeKnowledgeCoverageResponse]
+	saveGenerationDraft        *connect.Client[v1.SaveGenerationDraftRequest, v1.SaveGenerationDraftResponse]
+	getGenerationDraft         *connect.Client[v1.GetGenerationDraftRequest, v1.GetGenerationDraftResponse]
+	getCourseOutline           *connect.Client[v1.GetCourseOutlineRequest, v1.GetCourseOutlineResponse]
+	approveCourseOutline       *connect.Client[v1.ApproveCourseOutlineRequest, v1.ApproveCourseOutlineResponse]
+	rejectCourseOutline        *connect.Client[v1.RejectCourseOutlineRequest, v1.RejectCourseOutlineResponse]
+	updateCourseOutline        *connect.Client[v1.UpdateCourseOutlineRequest, v1.UpdateCourseOutlineResponse]
+	exportOutline              *connect.Client[v1.ExportOutlineRequest, v1.ExportOutlineResponse]
+	balanceOutlineDurations    *connect.Client[v1.BalanceOutlineDurationsRequest, v1.BalanceOutlineDurationsResponse]
+	generateLessonContent      *connect.Client[v1.GenerateLessonContentRequest, v1.GenerateLessonContentResponse]
+	generateAllLessons         *connect.Client[v1.GenerateAllLessonsRequest, v1.GenerateAllLessonsResponse]
+	retryFailedLessons         *connect.Client[v1.RetryFailedLessonsRequest, v1.RetryFailedLessonsResponse]
+	exportAllLessons           *connect.Client[v1.ExportAllLessonsRequest, v1.ExportAllLessonsResponse]
+	regenerateComponent        *connect.Client[v1.RegenerateComponentRequest, v1.RegenerateComponentResponse]
+	editComponentText          *connect.Client[v1.EditComponentTextRequest, v1.EditComponentTextResponse]
+	getComponentSources        *connect.Client[v1.GetComponentSourcesRequest, v1.GetComponentSourcesResponse]
+	getComponentAssetUploadURL *connect.Client[v1.GetComponentAssetUploadURLRequest, v1.GetComponentAssetUploadURLResponse]
+	confirmComponentAsset      *connect.Client[v1.ConfirmComponentAssetRequest, v1.ConfirmComponentAssetResponse]
+	suggestCourseTitles        *connect.Client[v1.SuggestCourseTitlesRequest, v1.SuggestCourseTitlesResponse]
+	getJob                     *connect.Client[v1.GetJobRequest, v1.GetJobResponse]
+	listJobs                   *connect.Client[v1.ListJobsRequest, v1.ListJobsResponse]
+	cancelJob                  *connect.Client[v1.CancelJobRequest, v1.CancelJobResponse]
+	getGeneratedLesson         *connect.Client[v1.GetGeneratedLessonRequest, v1.GetGeneratedLessonResponse]
+	listGeneratedLessons       *connect.Client[v1.ListGeneratedLessonsRequest, v1.ListGeneratedLessonsResponse]
+	getCourseStats             *connect.Client[v1.GetCourseStatsRequest, v1.GetCourseStatsResponse]
+	getCoursePlayerView        *connect.Client[v1.GetCoursePlayerViewRequest, v1.GetCoursePlayerViewResponse]
+	getAccessibilityReport     *connect.Client[v1.GetAccessibilityReportRequest, v1.GetAccessibilityReportResponse]
+	getQueueStatus             *connect.Client[v1.GetQueueStatusRequest, v1.GetQueueStatusResponse]
+	listAnomalies              *connect.Client[v1.ListAnomaliesRequest, v1.ListAnomaliesResponse]
+	startStorageAudit          *connect.Client[v1.StartStorageAuditRequest, v1.StartStorageAuditResponse]
+	getStorageAuditReport      *connect.Client[v1.GetStorageAuditReportRequest, v1.GetStorageAuditReportResponse]
+	translateCourse            *connect.Client[v1.TranslateCourseRequest, v1.TranslateCourseResponse]
+	createOutlineComment       *connect.Client[v1.CreateOutlineCommentRequest, v1.CreateOutlineCommentResponse]
+	listOutlineComments        *connect.Client[v1.ListOutlineCommentsRequest, v1.ListOutlineCommentsResponse]
+	resolveOutlineComment      *connect.Client[v1.ResolveOutlineCommentRequest, v1.ResolveOutlineCommentResponse]
+	setTenantAIEnabled         *connect.Client[v1.SetTenantAIEnabledRequest, v1.SetTenantAIEnabledResponse]
+	migrateQuizSchema          *connect.Client[v1.MigrateQuizSchemaRequest, v1.MigrateQuizSchemaResponse]
 }
 
 // GenerateCourseOutline calls mirai.v1.AIGenerationService.GenerateCourseOutline.
@@ -218,6 +541,21 @@ func (c *aIGenerationServiceClient) GenerateCourseOutline(ctx context.Context, r
 	return c.generateCourseOutline.CallUnary(ctx, req)
 }
 
+// AnalyzeKnowledgeCoverage calls mirai.v1.AIGenerationService.AnalyzeKnowledgeCoverage.
+func (c *aIGenerationServiceClient) AnalyzeKnowledgeCoverage(ctx context.Context, req *connect.Request[v1.AnalyzeKnowledgeCoverageRequest]) (*connect.Response[v1.AnalyzeKnowledgeCoverageResponse], error) {
+	return c.analyzeKnowledgeCoverage.CallUnary(ctx, req)
+}
+
+// SaveGenerationDraft calls mirai.v1.AIGenerationService.SaveGenerationDraft.
+func (c *aIGenerationServiceClient) SaveGenerationDraft(ctx context.Context, req *connect.Request[v1.SaveGenerationDraftRequest]) (*connect.Response[v1.SaveGenerationDraftResponse], error) {
+	return c.saveGenerationDraft.CallUnary(ctx, req)
+}
+
+// GetGenerationDraft calls mirai.v1.AIGenerationService.GetGenerationDraft.
+func (c *aIGenerationServiceClient) GetGenerationDraft(ctx context.Context, req *connect.Request[v1.GetGenerationDraftRequest]) (*connect.Response[v1.GetGenerationDraftResponse], error) {
+	return c.getGenerationDraft.CallUnary(ctx, req)
+}
+
 // GetCourseOutline calls mirai.v1.AIGenerationService.GetCourseOutline.
 func (c *aIGenerationServiceClient) GetCourseOutline(ctx context.Context, req *connect.Request[v1.GetCourseOutlineRequest]) (*connect.Response[v1.GetCourseOutlineResponse], error) {
 	return c.getCourseOutline.CallUnary(ctx, req)
@@ -238,6 +576,16 @@ func (c *aIGenerationServiceClient) UpdateCourseOutline(ctx context.Context, req
 	return c.updateCourseOutline.CallUnary(ctx, req)
 }
 
+// ExportOutline calls mirai.v1.AIGenerationService.ExportOutline.
+func (c *aIGenerationServiceClient) ExportOutline(ctx context.Context, req *connect.Request[v1.ExportOutlineRequest]) (*connect.Response[v1.ExportOutlineResponse], error) {
+	return c.exportOutline.CallUnary(ctx, req)
+}
+
+// BalanceOutlineDurations calls mirai.v1.AIGenerationService.BalanceOutlineDurations.
+func (c *aIGenerationServiceClient) BalanceOutlineDurations(ctx context.Context, req *connect.Request[v1.BalanceOutlineDurationsRequest]) (*connect.Response[v1.BalanceOutlineDurationsResponse], error) {
+	return c.balanceOutlineDurations.CallUnary(ctx, req)
+}
+
 // GenerateLessonContent calls mirai.v1.AIGenerationService.GenerateLessonContent.
 func (c *aIGenerationServiceClient) GenerateLessonContent(ctx context.Context, req *connect.Request[v1.GenerateLessonContentRequest]) (*connect.Response[v1.GenerateLessonContentResponse], error) {
 	return c.generateLessonContent.CallUnary(ctx, req)
@@ -248,11 +596,46 @@ func (c *aIGenerationServiceClient) GenerateAllLessons(ctx context.Context, req
 	return c.generateAllLessons.CallUnary(ctx, req)
 }
 
+// RetryFailedLessons calls mirai.v1.AIGenerationService.RetryFailedLessons.
+func (c *aIGenerationServiceClient) RetryFailedLessons(ctx context.Context, req *connect.Request[v1.RetryFailedLessonsRequest]) (*connect.Response[v1.RetryFailedLessonsResponse], error) {
+	return c.retryFailedLessons.CallUnary(ctx, req)
+}
+
+// ExportAllLessons calls mirai.v1.AIGenerationService.ExportAllLessons.
+func (c *aIGenerationServiceClient) ExportAllLessons(ctx context.Context, req *connect.Request[v1.ExportAllLessonsRequest]) (*connect.Response[v1.ExportAllLessonsResponse], error) {
+	return c.exportAllLessons.CallUnary(ctx, req)
+}
+
 // RegenerateComponent calls mirai.v1.AIGenerationService.RegenerateComponent.
 func (c *aIGenerationServiceClient) RegenerateComponent(ctx context.Context, req *connect.Request[v1.RegenerateComponentRequest]) (*connect.Response[v1.RegenerateComponentResponse], error) {
 	return c.regenerateComponent.CallUnary(ctx, req)
 }
 
+// EditComponentText calls mirai.v1.AIGenerationService.EditComponentText.
+func (c *aIGenerationServiceClient) EditComponentText(ctx context.Context, req *connect.Request[v1.EditComponentTextRequest]) (*connect.Response[v1.EditComponentTextResponse], error) {
+	return c.editComponentText.CallUnary(ctx, req)
+}
+
+// GetComponentSources calls mirai.v1.AIGenerationService.GetComponentSources.
+func (c *aIGenerationServiceClient) GetComponentSources(ctx context.Context, req *connect.Request[v1.GetComponentSourcesRequest]) (*connect.Response[v1.GetComponentSourcesResponse], error) {
+	return c.getComponentSources.CallUnary(ctx, req)
+}
+
+// GetComponentAssetUploadURL calls mirai.v1.AIGenerationService.GetComponentAssetUploadURL.
+func (c *aIGenerationServiceClient) GetComponentAssetUploadURL(ctx context.Context, req *connect.Request[v1.GetComponentAssetUploadURLRequest]) (*connect.Response[v1.GetComponentAssetUploadURLResponse], error) {
+	return c.getComponentAssetUploadURL.CallUnary(ctx, req)
+}
+
+// ConfirmComponentAsset calls mirai.v1.AIGenerationService.ConfirmComponentAsset.
+func (c *aIGenerationServiceClient) ConfirmComponentAsset(ctx context.Context, req *connect.Request[v1.ConfirmComponentAssetRequest]) (*connect.Response[v1.ConfirmComponentAssetResponse], error) {
+	return c.confirmComponentAsset.CallUnary(ctx, req)
+}
+
+// SuggestCourseTitles calls mirai.v1.AIGenerationService.SuggestCourseTitles.
+func (c *aIGenerationServiceClient) SuggestCourseTitles(ctx context.Context, req *connect.Request[v1.SuggestCourseTitlesRequest]) (*connect.Response[v1.SuggestCourseTitlesResponse], error) {
+	return c.suggestCourseTitles.CallUnary(ctx, req)
+}
+
 // GetJob calls mirai.v1.AIGenerationService.GetJob.
 func (c *aIGenerationServiceClient) GetJob(ctx context.Context, req *connect.Request[v1.GetJobRequest]) (*connect.Response[v1.GetJobResponse], error) {
 	return c.getJob.CallUnary(ctx, req)
@@ -278,24 +661,123 @@ func (c *aIGenerationServiceClient) ListGeneratedLessons(ctx context.Context, re
 	return c.listGeneratedLessons.CallUnary(ctx, req)
 }
 
+// GetCourseStats calls mirai.v1.AIGenerationService.GetCourseStats.
+func (c *aIGenerationServiceClient) GetCourseStats(ctx context.Context, req *connect.Request[v1.GetCourseStatsRequest]) (*connect.Response[v1.GetCourseStatsResponse], error) {
+	return c.getCourseStats.CallUnary(ctx, req)
+}
+
+// GetCoursePlayerView calls mirai.v1.AIGenerationService.GetCoursePlayerView.
+func (c *aIGenerationServiceClient) GetCoursePlayerView(ctx context.Context, req *connect.Request[v1.GetCoursePlayerViewRequest]) (*connect.Response[v1.GetCoursePlayerViewResponse], error) {
+	return c.getCoursePlayerView.CallUnary(ctx, req)
+}
+
+// GetAccessibilityReport calls mirai.v1.AIGenerationService.GetAccessibilityReport.
+func (c *aIGenerationServiceClient) GetAccessibilityReport(ctx context.Context, req *connect.Request[v1.GetAccessibilityReportRequest]) (*connect.Response[v1.GetAccessibilityReportResponse], error) {
+	return c.getAccessibilityReport.CallUnary(ctx, req)
+}
+
+// GetQueueStatus calls mirai.v1.AIGenerationService.GetQueueStatus.
+func (c *aIGenerationServiceClient) GetQueueStatus(ctx context.Context, req *connect.Request[v1.GetQueueStatusRequest]) (*connect.Response[v1.GetQueueStatusResponse], error) {
+	return c.getQueueStatus.CallUnary(ctx, req)
+}
+
+// ListAnomalies calls mirai.v1.AIGenerationService.ListAnomalies.
+func (c *aIGenerationServiceClient) ListAnomalies(ctx context.Context, req *connect.Request[v1.ListAnomaliesRequest]) (*connect.Response[v1.ListAnomaliesResponse], error) {
+	return c.listAnomalies.CallUnary(ctx, req)
+}
+
+// StartStorageAudit calls mirai.v1.AIGenerationService.StartStorageAudit.
+func (c *aIGenerationServiceClient) StartStorageAudit(ctx context.Context, req *connect.Request[v1.StartStorageAuditRequest]) (*connect.Response[v1.StartStorageAuditResponse], error) {
+	return c.startStorageAudit.CallUnary(ctx, req)
+}
+
+// GetStorageAuditReport calls mirai.v1.AIGenerationService.GetStorageAuditReport.
+func (c *aIGenerationServiceClient) GetStorageAuditReport(ctx context.Context, req *connect.Request[v1.GetStorageAuditReportRequest]) (*connect.Response[v1.GetStorageAuditReportResponse], error) {
+	return c.getStorageAuditReport.CallUnary(ctx, req)
+}
+
+// TranslateCourse calls mirai.v1.AIGenerationService.TranslateCourse.
+func (c *aIGenerationServiceClient) TranslateCourse(ctx context.Context, req *connect.Request[v1.TranslateCourseRequest]) (*connect.Response[v1.TranslateCourseResponse], error) {
+	return c.translateCourse.CallUnary(ctx, req)
+}
+
+// CreateOutlineComment calls mirai.v1.AIGenerationService.CreateOutlineComment.
+func (c *aIGenerationServiceClient) CreateOutlineComment(ctx context.Context, req *connect.Request[v1.CreateOutlineCommentRequest]) (*connect.Response[v1.CreateOutlineCommentResponse], error) {
+	return c.createOutlineComment.CallUnary(ctx, req)
+}
+
+// ListOutlineComments calls mirai.v1.AIGenerationService.ListOutlineComments.
+func (c *aIGenerationServiceClient) ListOutlineComments(ctx context.Context, req *connect.Request[v1.ListOutlineCommentsRequest]) (*connect.Response[v1.ListOutlineCommentsResponse], error) {
+	return c.listOutlineComments.CallUnary(ctx, req)
+}
+
+// ResolveOutlineComment calls mirai.v1.AIGenerationService.ResolveOutlineComment.
+func (c *aIGenerationServiceClient) ResolveOutlineComment(ctx context.Context, req *connect.Request[v1.ResolveOutlineCommentRequest]) (*connect.Response[v1.ResolveOutlineCommentResponse], error) {
+	return c.resolveOutlineComment.CallUnary(ctx, req)
+}
+
+// SetTenantAIEnabled calls mirai.v1.AIGenerationService.SetTenantAIEnabled.
+func (c *aIGenerationServiceClient) SetTenantAIEnabled(ctx context.Context, req *connect.Request[v1.SetTenantAIEnabledRequest]) (*connect.Response[v1.SetTenantAIEnabledResponse], error) {
+	return c.setTenantAIEnabled.CallUnary(ctx, req)
+}
+
+// MigrateQuizSchema calls mirai.v1.AIGenerationService.MigrateQuizSchema.
+func (c *aIGenerationServiceClient) MigrateQuizSchema(ctx context.Context, req *connect.Request[v1.MigrateQuizSchemaRequest]) (*connect.Response[v1.MigrateQuizSchemaResponse], error) {
+	return c.migrateQuizSchema.CallUnary(ctx, req)
+}
+
 // AIGenerationServiceHandler is an implementation of the mirai.v1.AIGenerationService service.
 type AIGenerationServiceHandler interface {
 	// GenerateCourseOutline starts outline generation job.
 	GenerateCourseOutline(context.Context, *connect.Request[v1.GenerateCourseOutlineRequest]) (*connect.Response[v1.GenerateCourseOutlineResponse], error)
+	// AnalyzeKnowledgeCoverage checks whether the selected SMEs have enough material for a
+	// desired outcome, naming the topics that look thin. Nothing is saved.
+	AnalyzeKnowledgeCoverage(context.Context, *connect.Request[v1.AnalyzeKnowledgeCoverageRequest]) (*connect.Response[v1.AnalyzeKnowledgeCoverageResponse], error)
+	// SaveGenerationDraft stores the current user's generation wizard selections for a course,
+	// replacing any earlier draft. The draft is cleared when generation starts for the course.
+	SaveGenerationDraft(context.Context, *connect.Request[v1.SaveGenerationDraftRequest]) (*connect.Response[v1.SaveGenerationDraftResponse], error)
+	// GetGenerationDraft returns the current user's generation wizard draft for a course.
+	GetGenerationDraft(context.Context, *connect.Request[v1.GetGenerationDraftRequest]) (*connect.Response[v1.GetGenerationDraftResponse], error)
 	// GetCourseOutline returns the generated outline for a course.
 	GetCourseOutline(context.Context, *connect.Request[v1.GetCourseOutlineRequest]) (*connect.Response[v1.GetCourseOutlineResponse], error)
-	// ApproveCourseOutline approves an outline for content generation.
+	// ApproveCourseOutline approves an outline for content generation. When the organization
+	// requires a second reviewer, the first approval by someone other than the outline's
+	// generator is recorded as an endorsement and the outline stays pending review.
 	ApproveCourseOutline(context.Context, *connect.Request[v1.ApproveCourseOutlineRequest]) (*connect.Response[v1.ApproveCourseOutlineResponse], error)
 	// RejectCourseOutline rejects an outline with feedback.
 	RejectCourseOutline(context.Context, *connect.Request[v1.RejectCourseOutlineRequest]) (*connect.Response[v1.RejectCourseOutlineResponse], error)
 	// UpdateCourseOutline allows editing the outline before approval.
 	UpdateCourseOutline(context.Context, *connect.Request[v1.UpdateCourseOutlineRequest]) (*connect.Response[v1.UpdateCourseOutlineResponse], error)
+	// ExportOutline renders an outline version as CSV or DOCX for review outside Mirai.
+	ExportOutline(context.Context, *connect.Request[v1.ExportOutlineRequest]) (*connect.Response[v1.ExportOutlineResponse], error)
+	// BalanceOutlineDurations proposes a revision of a pending outline that splits over-long
+	// lessons and merges short adjacent ones toward a per-lesson duration range. Nothing is
+	// saved; accept the proposal by sending its sections and removed_lesson_ids to
+	// UpdateCourseOutline.
+	BalanceOutlineDurations(context.Context, *connect.Request[v1.BalanceOutlineDurationsRequest]) (*connect.Response[v1.BalanceOutlineDurationsResponse], error)
 	// GenerateLessonContent generates content for a specific lesson.
 	GenerateLessonContent(context.Context, *connect.Request[v1.GenerateLessonContentRequest]) (*connect.Response[v1.GenerateLessonContentResponse], error)
 	// GenerateAllLessons generates content for all lessons in outline.
+	// Returns the running job instead if the course's lessons are already being generated.
 	GenerateAllLessons(context.Context, *connect.Request[v1.GenerateAllLessonsRequest]) (*connect.Response[v1.GenerateAllLessonsResponse], error)
+	// RetryFailedLessons requeues the failed lessons of a failed full course run under the same parent job.
+	RetryFailedLessons(context.Context, *connect.Request[v1.RetryFailedLessonsRequest]) (*connect.Response[v1.RetryFailedLessonsResponse], error)
+	// ExportAllLessons starts a job that packages every generated lesson into a ZIP in tenant storage.
+	ExportAllLessons(context.Context, *connect.Request[v1.ExportAllLessonsRequest]) (*connect.Response[v1.ExportAllLessonsResponse], error)
 	// RegenerateComponent regenerates a single component with modifications.
 	RegenerateComponent(context.Context, *connect.Request[v1.RegenerateComponentRequest]) (*connect.Response[v1.RegenerateComponentResponse], error)
+	// EditComponentText rewrites a text component inline and returns the proposal without saving.
+	EditComponentText(context.Context, *connect.Request[v1.EditComponentTextRequest]) (*connect.Response[v1.EditComponentTextResponse], error)
+	// GetComponentSources returns the SME knowledge chunks a component was generated from.
+	GetComponentSources(context.Context, *connect.Request[v1.GetComponentSourcesRequest]) (*connect.Response[v1.GetComponentSourcesResponse], error)
+	// GetComponentAssetUploadURL returns a presigned URL for uploading the file for a
+	// placeholder component, such as the video for a scripted video block.
+	GetComponentAssetUploadURL(context.Context, *connect.Request[v1.GetComponentAssetUploadURLRequest]) (*connect.Response[v1.GetComponentAssetUploadURLResponse], error)
+	// ConfirmComponentAsset attaches an uploaded file to its component.
+	ConfirmComponentAsset(context.Context, *connect.Request[v1.ConfirmComponentAssetRequest]) (*connect.Response[v1.ConfirmComponentAssetResponse], error)
+	// SuggestCourseTitles suggests course titles from the selected SMEs, audiences and outcome.
+	// Nothing is saved; apply a chosen title with CourseService.UpdateCourse.
+	SuggestCourseTitles(context.Context, *connect.Request[v1.SuggestCourseTitlesRequest]) (*connect.Response[v1.SuggestCourseTitlesResponse], error)
 	// GetJob returns a generation job by ID.
 	GetJob(context.Context, *connect.Request[v1.GetJobRequest]) (*connect.Response[v1.GetJobResponse], error)
 	// ListJobs returns generation jobs for the current user.
@@ -306,6 +788,45 @@ type AIGenerationServiceHandler interface {
 	GetGeneratedLesson(context.Context, *connect.Request[v1.GetGeneratedLessonRequest]) (*connect.Response[v1.GetGeneratedLessonResponse], error)
 	// ListGeneratedLessons returns all generated lessons for a course.
 	ListGeneratedLessons(context.Context, *connect.Request[v1.ListGeneratedLessonsRequest]) (*connect.Response[v1.ListGeneratedLessonsResponse], error)
+	// GetCourseStats returns word, quiz and image counts for a course's generated lessons.
+	GetCourseStats(context.Context, *connect.Request[v1.GetCourseStatsRequest]) (*connect.Response[v1.GetCourseStatsResponse], error)
+	// GetCoursePlayerView returns a course's lessons in display order for the learner-facing
+	// player, without authoring metadata. Published courses show their published version.
+	// Unpublished courses and drafts are only visible to their editors.
+	GetCoursePlayerView(context.Context, *connect.Request[v1.GetCoursePlayerViewRequest]) (*connect.Response[v1.GetCoursePlayerViewResponse], error)
+	// GetAccessibilityReport lists the accessibility problems left in a course's lessons, such
+	// as images without alt text, so authors can fix them before publishing. Editors only.
+	GetAccessibilityReport(context.Context, *connect.Request[v1.GetAccessibilityReportRequest]) (*connect.Response[v1.GetAccessibilityReportResponse], error)
+	// GetQueueStatus returns the tenant's active jobs and the state of the shared generation queue.
+	GetQueueStatus(context.Context, *connect.Request[v1.GetQueueStatusRequest]) (*connect.Response[v1.GetQueueStatusResponse], error)
+	// ListAnomalies returns generation anomalies across tenants.
+	// Requires a superadmin (SUPERADMIN_EMAILS).
+	ListAnomalies(context.Context, *connect.Request[v1.ListAnomaliesRequest]) (*connect.Response[v1.ListAnomaliesResponse], error)
+	// StartStorageAudit starts a job that reports stored objects of the caller's organization
+	// that no course, job, submission, SME or LMS delivery references. Admin only.
+	StartStorageAudit(context.Context, *connect.Request[v1.StartStorageAuditRequest]) (*connect.Response[v1.StartStorageAuditResponse], error)
+	// GetStorageAuditReport returns a storage audit job and, once it has completed, a link to
+	// its JSON report. The job's progress message summarizes the result. Admin only.
+	GetStorageAuditReport(context.Context, *connect.Request[v1.GetStorageAuditReportRequest]) (*connect.Response[v1.GetStorageAuditReportResponse], error)
+	// TranslateCourse copies a course into a new draft course in the target language and
+	// starts a job that translates its outline and generated lessons.
+	TranslateCourse(context.Context, *connect.Request[v1.TranslateCourseRequest]) (*connect.Response[v1.TranslateCourseResponse], error)
+	// CreateOutlineComment adds a reviewer comment to an outline lesson and notifies the
+	// course owner. Comments stay with the lesson across outline edits and versions.
+	CreateOutlineComment(context.Context, *connect.Request[v1.CreateOutlineCommentRequest]) (*connect.Response[v1.CreateOutlineCommentResponse], error)
+	// ListOutlineComments returns the comments on a course's outline lessons.
+	ListOutlineComments(context.Context, *connect.Request[v1.ListOutlineCommentsRequest]) (*connect.Response[v1.ListOutlineCommentsResponse], error)
+	// ResolveOutlineComment marks an outline comment resolved.
+	ResolveOutlineComment(context.Context, *connect.Request[v1.ResolveOutlineCommentRequest]) (*connect.Response[v1.ResolveOutlineCommentResponse], error)
+	// SetTenantAIEnabled pauses or resumes a tenant's AI generation. While paused, requests
+	// that start generation fail with FAILED_PRECONDITION and the tenant's queued jobs wait;
+	// resuming sends them back to the worker. Requires a superadmin (SUPERADMIN_EMAILS).
+	SetTenantAIEnabled(context.Context, *connect.Request[v1.SetTenantAIEnabledRequest]) (*connect.Response[v1.SetTenantAIEnabledResponse], error)
+	// MigrateQuizSchema queues an upgrade of quiz components in every tenant to the current
+	// quiz schema (schema_version in the content). Quizzes that can't be upgraded are left
+	// as they were. The counts are emailed to the operators. Requires a superadmin
+	// (SUPERADMIN_EMAILS).
+	MigrateQuizSchema(context.Context, *connect.Request[v1.MigrateQuizSchemaRequest]) (*connect.Response[v1.MigrateQuizSchemaResponse], error)
 }
 
 // NewAIGenerationServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -321,6 +842,24 @@ func NewAIGenerationServiceHandler(svc AIGenerationServiceHandler, opts ...conne
 		connect.WithSchema(aIGenerationServiceMethods.ByName("GenerateCourseOutline")),
 		connect.WithHandlerOptions(opts...),
 	)
+	aIGenerationServiceAnalyzeKnowledgeCoverageHandler := connect.NewUnaryHandler(
+		AIGenerationServiceAnalyzeKnowledgeCoverageProcedure,
+		svc.AnalyzeKnowledgeCoverage,
+		connect.WithSchema(aIGenerationServiceMethods.ByName("AnalyzeKnowledgeCoverage")),
+		connect.WithHandlerOptions(opts...),
+	)
+	aIGenerationServiceSaveGenerationDraftHandler := connect.NewUnaryHandler(
+		AIGenerationServiceSaveGenerationDraftProcedure,
+		svc.SaveGenerationDraft,
+		connect.WithSchema(aIGenerationServiceMethods.ByName("SaveGenerationDraft")),
+		connect.WithHandlerOptions(opts...),
+	)
+	aIGenerationServiceGetGenerationDraftHandler := connect.NewUnaryHandler(
+		AIGenerationServiceGetGenerationDraftProcedure,
+		svc.GetGenerationDraft,
+		connect.WithSchema(aIGenerationServiceMethods.ByName("GetGenerationDraft")),
+		connect.WithHandlerOptions(opts...),
+	)
 	aIGenerationServiceGetCourseOutlineHandler := connect.NewUnaryHandler(
 		AIGenerationServiceGetCourseOutlineProcedure,
 		svc.GetCourseOutline,
@@ -345,6 +884,18 @@ func NewAIGenerationServiceHandler(svc AIGenerationServiceHandler, opts ...conne
 		connect.WithSchema(aIGenerationServiceMethods.ByName("UpdateCourseOutline")),
 		connect.WithHandlerOptions(opts...),
 	)
+	aIGenerationServiceExportOutlineHandler := connect.NewUnaryHandler(
+		AIGenerationServiceExportOutlineProcedure,
+		svc.ExportOutline,
+		connect.WithSchema(aIGenerationServiceMethods.ByName("ExportOutline")),
+		connect.WithHandlerOptions(opts...),
+	)
+	aIGenerationServiceBalanceOutlineDurationsHandler := connect.NewUnaryHandler(
+		AIGenerationServiceBalanceOutlineDurationsProcedure,
+		svc.BalanceOutlineDurations,
+		connect.WithSchema(aIGenerationServiceMethods.ByName("BalanceOutlineDurations")),
+		connect.WithHandlerOptions(opts...),
+	)
 	aIGenerationServiceGenerateLessonContentHandler := connect.NewUnaryHandler(
 		AIGenerationServiceGenerateLessonContentProcedure,
 		svc.GenerateLessonContent,
@@ -357,12 +908,54 @@ func NewAIGenerationServiceHandler(svc AIGenerationServiceHandler, opts ...conne
 		connect.WithSchema(aIGenerationServiceMethods.ByName("GenerateAllLessons")),
 		connect.WithHandlerOptions(opts...),
 	)
+	aIGenerationServiceRetryFailedLessonsHandler := connect.NewUnaryHandler(
+		AIGenerationServiceRetryFailedLessonsProcedure,
+		svc.RetryFailedLessons,
+		connect.WithSchema(aIGenerationServiceMethods.ByName("RetryFailedLessons")),
+		connect.WithHandlerOptions(opts...),
+	)
+	aIGenerationServiceExportAllLessonsHandler := connect.NewUnaryHandler(
+		AIGenerationServiceExportAllLessonsProcedure,
+		svc.ExportAllLessons,
+		connect.WithSchema(aIGenerationServiceMethods.ByName("ExportAllLessons")),
+		connect.WithHandlerOptions(opts...),
+	)
 	aIGenerationServiceRegenerateComponentHandler := connect.NewUnaryHandler(
 		AIGenerationServiceRegenerateComponentProcedure,
 		svc.RegenerateComponent,
 		connect.WithSchema(aIGenerationServiceMethods.ByName("RegenerateComponent")),
 		connect.WithHandlerOptions(opts...),
 	)
+	aIGenerationServiceEditComponentTextHandler := connect.NewUnaryHandler(
+		AIGenerationServiceEditComponentTextProcedure,
+		svc.EditComponentText,
+		connect.WithSchema(aIGenerationServiceMethods.ByName("EditComponentText")),
+		connect.WithHandlerOptions(opts...),
+	)
+	aIGenerationServiceGetComponentSourcesHandler := connect.NewUnaryHandler(
+		AIGenerationServiceGetComponentSourcesProcedure,
+		svc.GetComponentSources,
+		connect.WithSchema(aIGenerationServiceMethods.ByName("GetComponentSources")),
+		connect.WithHandlerOptions(opts...),
+	)
+	aIGenerationServiceGetComponentAssetUploadURLHandler := connect.NewUnaryHandler(
+		AIGenerationServiceGetComponentAssetUploadURLProcedure,
+		svc.GetComponentAssetUploadURL,
+		connect.WithSchema(aIGenerationServiceMethods.ByName("GetComponentAssetUploadURL")),
+		connect.WithHandlerOptions(opts...),
+	)
+	aIGenerationServiceConfirmComponentAssetHandler := connect.NewUnaryHandler(
+		AIGenerationServiceConfirmComponentAssetProcedure,
+		svc.ConfirmComponentAsset,
+		connect.WithSchema(aIGenerationServiceMethods.ByName("ConfirmComponentAsset")),
+		connect.WithHandlerOptions(opts...),
+	)
+	aIGenerationServiceSuggestCourseTitlesHandler := connect.NewUnaryHandler(
+		AIGenerationServiceSuggestCourseTitlesProcedure,
+		svc.SuggestCourseTitles,
+		connect.WithSchema(aIGenerationServiceMethods.ByName("SuggestCourseTitles")),
+		connect.WithHandlerOptions(opts...),
+	)
 	aIGenerationServiceGetJobHandler := connect.NewUnaryHandler(
 		AIGenerationServiceGetJobProcedure,
 		svc.GetJob,
@@ -393,10 +986,94 @@ func NewAIGenerationServiceHandler(svc AIGenerationServiceHandler, opts ...conne
 		connect.WithSchema(aIGenerationServiceMethods.ByName("ListGeneratedLessons")),
 		connect.WithHandlerOptions(opts...),
 	)
+	aIGenerationServiceGetCourseStatsHandler := connect.NewUnaryHandler(
+		AIGenerationServiceGetCourseStatsProcedure,
+		svc.GetCourseStats,
+		connect.WithSchema(aIGenerationServiceMethods.ByName("GetCourseStats")),
+		connect.WithHandlerOptions(opts...),
+	)
+	aIGenerationServiceGetCoursePlayerViewHandler := connect.NewUnaryHandler(
+		AIGenerationServiceGetCoursePlayerViewProcedure,
+		svc.GetCoursePlayerView,
+		connect.WithSchema(aIGenerationServiceMethods.ByName("GetCoursePlayerView")),
+		connect.WithHandlerOptions(opts...),
+	)
+	aIGenerationServiceGetAccessibilityReportHandler := connect.NewUnaryHandler(
+		AIGenerationServiceGetAccessibilityReportProcedure,
+		svc.GetAccessibilityReport,
+		connect.WithSchema(aIGenerationServiceMethods.ByName("GetAccessibilityReport")),
+		connect.WithHandlerOptions(opts...),
+	)
+	aIGenerationServiceGetQueueStatusHandler := connect.NewUnaryHandler(
+		AIGenerationServiceGetQueueStatusProcedure,
+		svc.GetQueueStatus,
+		connect.WithSchema(aIGenerationServiceMethods.ByName("GetQueueStatus")),
+		connect.WithHandlerOptions(opts...),
+	)
+	aIGenerationServiceListAnomaliesHandler := connect.NewUnaryHandler(
+		AIGenerationServiceListAnomaliesProcedure,
+		svc.ListAnomalies,
+		connect.WithSchema(aIGenerationServiceMethods.ByName("ListAnomalies")),
+		connect.WithHandlerOptions(opts...),
+	)
+	aIGenerationServiceStartStorageAuditHandler := connect.NewUnaryHandler(
+		AIGenerationServiceStartStorageAuditProcedure,
+		svc.StartStorageAudit,
+		connect.WithSchema(aIGenerationServiceMethods.ByName("StartStorageAudit")),
+		connect.WithHandlerOptions(opts...),
+	)
+	aIGenerationServiceGetStorageAuditReportHandler := connect.NewUnaryHandler(
+		AIGenerationServiceGetStorageAuditReportProcedure,
+		svc.GetStorageAuditReport,
+		connect.WithSchema(aIGenerationServiceMethods.ByName("GetStorageAuditReport")),
+		connect.WithHandlerOptions(opts...),
+	)
+	aIGenerationServiceTranslateCourseHandler := connect.NewUnaryHandler(
+		AIGenerationServiceTranslateCourseProcedure,
+		svc.TranslateCourse,
+		connect.WithSchema(aIGenerationServiceMethods.ByName("TranslateCourse")),
+		connect.WithHandlerOptions(opts...),
+	)
+	aIGenerationServiceCreateOutlineCommentHandler := connect.NewUnaryHandler(
+		AIGenerationServiceCreateOutlineCommentProcedure,
+		svc.CreateOutlineComment,
+		connect.WithSchema(aIGenerationServiceMethods.ByName("CreateOutlineComment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	aIGenerationServiceListOutlineCommentsHandler := connect.NewUnaryHandler(
+		AIGenerationServiceListOutlineCommentsProcedure,
+		svc.ListOutlineComments,
+		connect.WithSchema(aIGenerationServiceMethods.ByName("ListOutlineComments")),
+		connect.WithHandlerOptions(opts...),
+	)
+	aIGenerationServiceResolveOutlineCommentHandler := connect.NewUnaryHandler(
+		AIGenerationServiceResolveOutlineCommentProcedure,
+		svc.ResolveOutlineComment,
+		connect.WithSchema(aIGenerationServiceMethods.ByName("ResolveOutlineComment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	aIGenerationServiceSetTenantAIEnabledHandler := connect.NewUnaryHandler(
+		AIGenerationServiceSetTenantAIEnabledProcedure,
+		svc.SetTenantAIEnabled,
+		connect.WithSchema(aIGenerationServiceMethods.ByName("SetTenantAIEnabled")),
+		connect.WithHandlerOptions(opts...),
+	)
+	aIGenerationServiceMigrateQuizSchemaHandler := connect.NewUnaryHandler(
+		AIGenerationServiceMigrateQuizSchemaProcedure,
+		svc.MigrateQuizSchema,
+		connect.WithSchema(aIGenerationServiceMethods.ByName("MigrateQuizSchema")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/mirai.v1.AIGenerationService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case AIGenerationServiceGenerateCourseOutlineProcedure:
 			aIGenerationServiceGenerateCourseOutlineHandler.ServeHTTP(w, r)
+		case AIGenerationServiceAnalyzeKnowledgeCoverageProcedure:
+			aIGenerationServiceAnalyzeKnowledgeCoverageHandler.ServeHTTP(w, r)
+		case AIGenerationServiceSaveGenerationDraftProcedure:
+			aIGenerationServiceSaveGenerationDraftHandler.ServeHTTP(w, r)
+		case AIGenerationServiceGetGenerationDraftProcedure:
+			aIGenerationServiceGetGenerationDraftHandler.ServeHTTP(w, r)
 		case AIGenerationServiceGetCourseOutlineProcedure:
 			aIGenerationServiceGetCourseOutlineHandler.ServeHTTP(w, r)
 		case AIGenerationServiceApproveCourseOutlineProcedure:
@@ -405,12 +1082,30 @@ func NewAIGenerationServiceHandler(svc AIGenerationServiceHandler, opts ...conne
 			aIGenerationServiceRejectCourseOutlineHandler.ServeHTTP(w, r)
 		case AIGenerationServiceUpdateCourseOutlineProcedure:
 			aIGenerationServiceUpdateCourseOutlineHandler.ServeHTTP(w, r)
+		case AIGenerationServiceExportOutlineProcedure:
+			aIGenerationServiceExportOutlineHandler.ServeHTTP(w, r)
+		case AIGenerationServiceBalanceOutlineDurationsProcedure:
+			aIGenerationServiceBalanceOutlineDurationsHandler.ServeHTTP(w, r)
 		case AIGenerationServiceGenerateLessonContentProcedure:
 			aIGenerationServiceGenerateLessonContentHandler.ServeHTTP(w, r)
 		case AIGenerationServiceGenerateAllLessonsProcedure:
 			aIGenerationServiceGenerateAllLessonsHandler.ServeHTTP(w, r)
+		case AIGenerationServiceRetryFailedLessonsProcedure:
+			aIGenerationServiceRetryFailedLessonsHandler.ServeHTTP(w, r)
+		case AIGenerationServiceExportAllLessonsProcedure:
+			aIGenerationServiceExportAllLessonsHandler.ServeHTTP(w, r)
 		case AIGenerationServiceRegenerateComponentProcedure:
 			aIGenerationServiceRegenerateComponentHandler.ServeHTTP(w, r)
+		case AIGenerationServiceEditComponentTextProcedure:
+			aIGenerationServiceEditComponentTextHandler.ServeHTTP(w, r)
+		case AIGenerationServiceGetComponentSourcesProcedure:
+			aIGenerationServiceGetComponentSourcesHandler.ServeHTTP(w, r)
+		case AIGenerationServiceGetComponentAssetUploadURLProcedure:
+			aIGenerationServiceGetComponentAssetUploadURLHandler.ServeHTTP(w, r)
+		case AIGenerationServiceConfirmComponentAssetProcedure:
+			aIGenerationServiceConfirmComponentAssetHandler.ServeHTTP(w, r)
+		case AIGenerationServiceSuggestCourseTitlesProcedure:
+			aIGenerationServiceSuggestCourseTitlesHandler.ServeHTTP(w, r)
 		case AIGenerationServiceGetJobProcedure:
 			aIGenerationServiceGetJobHandler.ServeHTTP(w, r)
 		case AIGenerationServiceListJobsProcedure:
@@ -421,6 +1116,32 @@ func NewAIGenerationServiceHandler(svc AIGenerationServiceHandler, opts ...conne
 			aIGenerationServiceGetGeneratedLessonHandler.ServeHTTP(w, r)
 		case AIGenerationServiceListGeneratedLessonsProcedure:
 			aIGenerationServiceListGeneratedLessonsHandler.ServeHTTP(w, r)
+		case AIGenerationServiceGetCourseStatsProcedure:
+			aIGenerationServiceGetCourseStatsHandler.ServeHTTP(w, r)
+		case AIGenerationServiceGetCoursePlayerViewProcedure:
+			aIGenerationServiceGetCoursePlayerViewHandler.ServeHTTP(w, r)
+		case AIGenerationServiceGetAccessibilityReportProcedure:
+			aIGenerationServiceGetAccessibilityReportHandler.ServeHTTP(w, r)
+		case AIGenerationServiceGetQueueStatusProcedure:
+			aIGenerationServiceGetQueueStatusHandler.ServeHTTP(w, r)
+		case AIGenerationServiceListAnomaliesProcedure:
+			aIGenerationServiceListAnomaliesHandler.ServeHTTP(w, r)
+		case AIGenerationServiceStartStorageAuditProcedure:
+			aIGenerationServiceStartStorageAuditHandler.ServeHTTP(w, r)
+		case AIGenerationServiceGetStorageAuditReportProcedure:
+			aIGenerationServiceGetStorageAuditReportHandler.ServeHTTP(w, r)
+		case AIGenerationServiceTranslateCourseProcedure:
+			aIGenerationServiceTranslateCourseHandler.ServeHTTP(w, r)
+		case AIGenerationServiceCreateOutlineCommentProcedure:
+			aIGenerationServiceCreateOutlineCommentHandler.ServeHTTP(w, r)
+		case AIGenerationServiceListOutlineCommentsProcedure:
+			aIGenerationServiceListOutlineCommentsHandler.ServeHTTP(w, r)
+		case AIGenerationServiceResolveOutlineCommentProcedure:
+			aIGenerationServiceResolveOutlineCommentHandler.ServeHTTP(w, r)
+		case AIGenerationServiceSetTenantAIEnabledProcedure:
+			aIGenerationServiceSetTenantAIEnabledHandler.ServeHTTP(w, r)
+		case AIGenerationServiceMigrateQuizSchemaProcedure:
+			aIGenerationServiceMigrateQuizSchemaHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -434,6 +1155,18 @@ func (UnimplementedAIGenerationServiceHandler) GenerateCourseOutline(context.Con
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.AIGenerationService.GenerateCourseOutline is not implemented"))
 }
 
+func (UnimplementedAIGenerationServiceHandler) AnalyzeKnowledgeCoverage(context.Context, *connect.Request[v1.AnalyzeKnowledgeCoverageRequest]) (*connect.Response[v1.AnalyzeKnowledgeCoverageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.AIGenerationService.AnalyzeKnowledgeCoverage is not implemented"))
+}
+
+func (UnimplementedAIGenerationServiceHandler) SaveGenerationDraft(context.Context, *connect.Request[v1.SaveGenerationDraftRequest]) (*connect.Response[v1.SaveGenerationDraftResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.AIGenerationService.SaveGenerationDraft is not implemented"))
+}
+
+func (UnimplementedAIGenerationServiceHandler) GetGenerationDraft(context.Context, *connect.Request[v1.GetGenerationDraftRequest]) (*connect.Response[v1.GetGenerationDraftResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.AIGenerationService.GetGenerationDraft is not implemented"))
+}
+
 func (UnimplementedAIGenerationServiceHandler) GetCourseOutline(context.Context, *connect.Request[v1.GetCourseOutlineRequest]) (*connect.Response[v1.GetCourseOutlineResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.AIGenerationService.GetCourseOutline is not implemented"))
 }
@@ -450,6 +1183,14 @@ func (UnimplementedAIGenerationServiceHandler) UpdateCourseOutline(context.Conte
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.AIGenerationService.UpdateCourseOutline is not implemented"))
 }
 
+func (UnimplementedAIGenerationServiceHandler) ExportOutline(context.Context, *connect.Request[v1.ExportOutlineRequest]) (*connect.Response[v1.ExportOutlineResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.AIGenerationService.ExportOutline is not implemented"))
+}
+
+func (UnimplementedAIGenerationServiceHandler) BalanceOutlineDurations(context.Context, *connect.Request[v1.BalanceOutlineDurationsRequest]) (*connect.Response[v1.BalanceOutlineDurationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.AIGenerationService.BalanceOutlineDurations is not implemented"))
+}
+
 func (UnimplementedAIGenerationServiceHandler) GenerateLessonContent(context.Context, *connect.Request[v1.GenerateLessonContentRequest]) (*connect.Response[v1.GenerateLessonContentResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.AIGenerationService.GenerateLessonContent is not implemented"))
 }
@@ -458,10 +1199,38 @@ func (UnimplementedAIGenerationServiceHandler) GenerateAllLessons(context.Contex
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.AIGenerationService.GenerateAllLessons is not implemented"))
 }
 
+func (UnimplementedAIGenerationServiceHandler) RetryFailedLessons(context.Context, *connect.Request[v1.RetryFailedLessonsRequest]) (*connect.Response[v1.RetryFailedLessonsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.AIGenerationService.RetryFailedLessons is not implemented"))
+}
+
+func (UnimplementedAIGenerationServiceHandler) ExportAllLessons(context.Context, *connect.Request[v1.ExportAllLessonsRequest]) (*connect.Response[v1.ExportAllLessonsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.AIGenerationService.ExportAllLessons is not implemented"))
+}
+
 func (UnimplementedAIGenerationServiceHandler) RegenerateComponent(context.Context, *connect.Request[v1.RegenerateComponentRequest]) (*connect.Response[v1.RegenerateComponentResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.AIGenerationService.RegenerateComponent is not implemented"))
 }
 
+func (UnimplementedAIGenerationServiceHandler) EditComponentText(context.Context, *connect.Request[v1.EditComponentTextRequest]) (*connect.Response[v1.EditComponentTextResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.AIGenerationService.EditComponentText is not implemented"))
+}
+
+func (UnimplementedAIGenerationServiceHandler) GetComponentSources(context.Context, *connect.Request[v1.GetComponentSourcesRequest]) (*connect.Response[v1.GetComponentSourcesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.AIGenerationService.GetComponentSources is not implemented"))
+}
+
+func (UnimplementedAIGenerationServiceHandler) GetComponentAssetUploadURL(context.Context, *connect.Request[v1.GetComponentAssetUploadURLRequest]) (*connect.Response[v1.GetComponentAssetUploadURLResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.AIGenerationService.GetComponentAssetUploadURL is not implemented"))
+}
+
+func (UnimplementedAIGenerationServiceHandler) ConfirmComponentAsset(context.Context, *connect.Request[v1.ConfirmComponentAssetRequest]) (*connect.Response[v1.ConfirmComponentAssetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.AIGenerationService.ConfirmComponentAsset is not implemented"))
+}
+
+func (UnimplementedAIGenerationServiceHandler) SuggestCourseTitles(context.Context, *connect.Request[v1.SuggestCourseTitlesRequest]) (*connect.Response[v1.SuggestCourseTitlesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.AIGenerationService.SuggestCourseTitles is not implemented"))
+}
+
 func (UnimplementedAIGenerationServiceHandler) GetJob(context.Context, *connect.Request[v1.GetJobRequest]) (*connect.Response[v1.GetJobResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.AIGenerationService.GetJob is not implemented"))
 }
@@ -481,3 +1250,55 @@ func (UnimplementedAIGenerationServiceHandler) GetGeneratedLesson(context.Contex
 func (UnimplementedAIGenerationServiceHandler) ListGeneratedLessons(context.Context, *connect.Request[v1.ListGeneratedLessonsRequest]) (*connect.Response[v1.ListGeneratedLessonsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.AIGenerationService.ListGeneratedLessons is not implemented"))
 }
+
+func (UnimplementedAIGenerationServiceHandler) GetCourseStats(context.Context, *connect.Request[v1.GetCourseStatsRequest]) (*connect.Response[v1.GetCourseStatsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.AIGenerationService.GetCourseStats is not implemented"))
+}
+
+func (UnimplementedAIGenerationServiceHandler) GetCoursePlayerView(context.Context, *connect.Request[v1.GetCoursePlayerViewRequest]) (*connect.Response[v1.GetCoursePlayerViewResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.AIGenerationService.GetCoursePlayerView is not implemented"))
+}
+
+func (UnimplementedAIGenerationServiceHandler) GetAccessibilityReport(context.Context, *connect.Request[v1.GetAccessibilityReportRequest]) (*connect.Response[v1.GetAccessibilityReportResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.AIGenerationService.GetAccessibilityReport is not implemented"))
+}
+
+func (UnimplementedAIGenerationServiceHandler) GetQueueStatus(context.Context, *connect.Request[v1.GetQueueStatusRequest]) (*connect.Response[v1.GetQueueStatusResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.AIGenerationService.GetQueueStatus is not implemented"))
+}
+
+func (UnimplementedAIGenerationServiceHandler) ListAnomalies(context.Context, *connect.Request[v1.ListAnomaliesRequest]) (*connect.Response[v1.ListAnomaliesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.AIGenerationService.ListAnomalies is not implemented"))
+}
+
+func (UnimplementedAIGenerationServiceHandler) StartStorageAudit(context.Context, *connect.Request[v1.StartStorageAuditRequest]) (*connect.Response[v1.StartStorageAuditResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.AIGenerationService.StartStorageAudit is not implemented"))
+}
+
+func (UnimplementedAIGenerationServiceHandler) GetStorageAuditReport(context.Context, *connect.Request[v1.GetStorageAuditReportRequest]) (*connect.Response[v1.GetStorageAuditReportResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.AIGenerationService.GetStorageAuditReport is not implemented"))
+}
+
+func (UnimplementedAIGenerationServiceHandler) TranslateCourse(context.Context, *connect.Request[v1.TranslateCourseRequest]) (*connect.Response[v1.TranslateCourseResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.AIGenerationService.TranslateCourse is not implemented"))
+}
+
+func (UnimplementedAIGenerationServiceHandler) CreateOutlineComment(context.Context, *connect.Request[v1.CreateOutlineCommentRequest]) (*connect.Response[v1.CreateOutlineCommentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.AIGenerationService.CreateOutlineComment is not implemented"))
+}
+
+func (UnimplementedAIGenerationServiceHandler) ListOutlineComments(context.Context, *connect.Request[v1.ListOutlineCommentsRequest]) (*connect.Response[v1.ListOutlineCommentsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.AIGenerationService.ListOutlineComments is not implemented"))
+}
+
+func (UnimplementedAIGenerationServiceHandler) ResolveOutlineComment(context.Context, *connect.Request[v1.ResolveOutlineCommentRequest]) (*connect.Response[v1.ResolveOutlineCommentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.AIGenerationService.ResolveOutlineComment is not implemented"))
+}
+
+func (UnimplementedAIGenerationServiceHandler) SetTenantAIEnabled(context.Context, *connect.Request[v1.SetTenantAIEnabledRequest]) (*connect.Response[v1.SetTenantAIEnabledResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.AIGenerationService.SetTenantAIEnabled is not implemented"))
+}
+
+func (UnimplementedAIGenerationServiceHandler) MigrateQuizSchema(context.Context, *connect.Request[v1.MigrateQuizSchemaRequest]) (*connect.Response[v1.MigrateQuizSchemaResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.AIGenerationService.MigrateQuizSchema is not implemented"))
+}
@@ -45,6 +45,9 @@ const (
 	// AuthServiceEnterpriseContactProcedure is the fully-qualified name of the AuthService's
 	// EnterpriseContact RPC.
 	AuthServiceEnterpriseContactProcedure = "/mirai.v1.AuthService/EnterpriseContact"
+	// AuthServiceListStorageRegionsProcedure is the fully-qualified name of the AuthService's
+	// ListStorageRegions RPC.
+	AuthServiceListStorageRegionsProcedure = "/mirai.v1.AuthService/ListStorageRegions"
 )
 
 // AuthServiceClient is a client for the mirai.v1.AuthService service.
@@ -61,6 +64,8 @@ type AuthServiceClient interface {
 	Onboard(context.Context, *connect.Request[v1.OnboardRequest]) (*connect.Response[v1.OnboardResponse], error)
 	// EnterpriseContact submits an enterprise sales inquiry.
 	EnterpriseContact(context.Context, *connect.Request[v1.EnterpriseContactRequest]) (*connect.Response[v1.EnterpriseContactResponse], error)
+	// ListStorageRegions lists the storage regions a new organization can keep its data in.
+	ListStorageRegions(context.Context, *connect.Request[v1.ListStorageRegionsRequest]) (*connect.Response[v1.ListStorageRegionsResponse], error)
 }
 
 // NewAuthServiceClient constructs a client for the mirai.v1.AuthService service. By default, it
@@ -104,6 +109,12 @@ func NewAuthServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(authServiceMethods.ByName("EnterpriseContact")),
 			connect.WithClientOptions(opts...),
 		),
+		listStorageRegions: connect.NewClient[v1.ListStorageRegionsRequest, v1.ListStorageRegionsResponse](
+			httpClient,
+			baseURL+AuthServiceListStorageRegionsProcedure,
+			connect.WithSchema(authServiceMethods.ByName("ListStorageRegions")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -114,6 +125,7 @@ type authServiceClient struct {
 	registerWithInvitation *connect.Client[v1.RegisterWithInvitationRequest, v1.RegisterWithInvitationResponse]
 	onboard                *connect.Client[v1.OnboardRequest, v1.OnboardResponse]
 	enterpriseContact      *connect.Client[v1.EnterpriseContactRequest, v1.EnterpriseContactResponse]
+	listStorageRegions     *connect.Client[v1.ListStorageRegionsRequest, v1.ListStorageRegionsResponse]
 }
 
 // CheckEmail calls mirai.v1.AuthService.CheckEmail.
@@ -141,6 +153,11 @@ func (c *authServiceClient) EnterpriseContact(ctx context.Context, req *connect.
 	return c.enterpriseContact.CallUnary(ctx, req)
 }
 
+// ListStorageRegions calls mirai.v1.AuthService.ListStorageRegions.
+func (c *authServiceClient) ListStorageRegions(ctx context.Context, req *connect.Request[v1.ListStorageRegionsRequest]) (*connect.Response[v1.ListStorageRegionsResponse], error) {
+	return c.listStorageRegions.CallUnary(ctx, req)
+}
+
 // AuthServiceHandler is an implementation of the mirai.v1.AuthService service.
 type AuthServiceHandler interface {
 	// CheckEmail checks if an email address is already registered.
@@ -155,6 +172,8 @@ type AuthServiceHandler interface {
 	Onboard(context.Context, *connect.Request[v1.OnboardRequest]) (*connect.Response[v1.OnboardResponse], error)
 	// EnterpriseContact submits an enterprise sales inquiry.
 	EnterpriseContact(context.Context, *connect.Request[v1.EnterpriseContactRequest]) (*connect.Response[v1.EnterpriseContactResponse], error)
+	// ListStorageRegions lists the storage regions a new organization can keep its data in.
+	ListStorageRegions(context.Context, *connect.Request[v1.ListStorageRegionsRequest]) (*connect.Response[v1.ListStorageRegionsResponse], error)
 }
 
 // NewAuthServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -194,6 +213,12 @@ func NewAuthServiceHandler(svc AuthServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(authServiceMethods.ByName("EnterpriseContact")),
 		connect.WithHandlerOptions(opts...),
 	)
+	authServiceListStorageRegionsHandler := connect.NewUnaryHandler(
+		AuthServiceListStorageRegionsProcedure,
+		svc.ListStorageRegions,
+		connect.WithSchema(authServiceMethods.ByName("ListStorageRegions")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/mirai.v1.AuthService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case AuthServiceCheckEmailProcedure:
@@ -206,6 +231,8 @@ func NewAuthServiceHandler(svc AuthServiceHandler, opts ...connect.HandlerOption
 			authServiceOnboardHandler.ServeHTTP(w, r)
 		case AuthServiceEnterpriseContactProcedure:
 			authServiceEnterpriseContactHandler.ServeHTTP(w, r)
+		case AuthServiceListStorageRegionsProcedure:
+			authServiceListStorageRegionsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -234,3 +261,7 @@ func (UnimplementedAuthServiceHandler) Onboard(context.Context, *connect.Request
 func (UnimplementedAuthServiceHandler) EnterpriseContact(context.Context, *connect.Request[v1.EnterpriseContactRequest]) (*connect.Response[v1.EnterpriseContactResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.AuthService.EnterpriseContact is not implemented"))
 }
+
+func (UnimplementedAuthServiceHandler) ListStorageRegions(context.Context, *connect.Request[v1.ListStorageRegionsRequest]) (*connect.Response[v1.ListStorageRegionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.AuthService.ListStorageRegions is not implemented"))
+}
@@ -56,6 +56,9 @@ const (
 	// CourseServiceCreateFolderProcedure is the fully-qualified name of the CourseService's
 	// CreateFolder RPC.
 	CourseServiceCreateFolderProcedure = "/mirai.v1.CourseService/CreateFolder"
+	// CourseServiceUpdateFolderProcedure is the fully-qualified name of the CourseService's
+	// UpdateFolder RPC.
+	CourseServiceUpdateFolderProcedure = "/mirai.v1.CourseService/UpdateFolder"
 	// CourseServiceDeleteFolderProcedure is the fully-qualified name of the CourseService's
 	// DeleteFolder RPC.
 	CourseServiceDeleteFolderProcedure = "/mirai.v1.CourseService/DeleteFolder"
@@ -71,6 +74,57 @@ const (
 	// CourseServiceListExportsProcedure is the fully-qualified name of the CourseService's ListExports
 	// RPC.
 	CourseServiceListExportsProcedure = "/mirai.v1.CourseService/ListExports"
+	// CourseServiceListCollaboratorsProcedure is the fully-qualified name of the CourseService's
+	// ListCollaborators RPC.
+	CourseServiceListCollaboratorsProcedure = "/mirai.v1.CourseService/ListCollaborators"
+	// CourseServiceAddCollaboratorProcedure is the fully-qualified name of the CourseService's
+	// AddCollaborator RPC.
+	CourseServiceAddCollaboratorProcedure = "/mirai.v1.CourseService/AddCollaborator"
+	// CourseServiceRemoveCollaboratorProcedure is the fully-qualified name of the CourseService's
+	// RemoveCollaborator RPC.
+	CourseServiceRemoveCollaboratorProcedure = "/mirai.v1.CourseService/RemoveCollaborator"
+	// CourseServiceRemoveSampleContentProcedure is the fully-qualified name of the CourseService's
+	// RemoveSampleContent RPC.
+	CourseServiceRemoveSampleContentProcedure = "/mirai.v1.CourseService/RemoveSampleContent"
+	// CourseServiceListLargestCoursesProcedure is the fully-qualified name of the CourseService's
+	// ListLargestCourses RPC.
+	CourseServiceListLargestCoursesProcedure = "/mirai.v1.CourseService/ListLargestCourses"
+	// CourseServicePublishChangesProcedure is the fully-qualified name of the CourseService's
+	// PublishChanges RPC.
+	CourseServicePublishChangesProcedure = "/mirai.v1.CourseService/PublishChanges"
+	// CourseServiceDiscardDraftProcedure is the fully-qualified name of the CourseService's
+	// DiscardDraft RPC.
+	CourseServiceDiscardDraftProcedure = "/mirai.v1.CourseService/DiscardDraft"
+	// CourseServiceSearchWithinCourseProcedure is the fully-qualified name of the CourseService's
+	// SearchWithinCourse RPC.
+	CourseServiceSearchWithinCourseProcedure = "/mirai.v1.CourseService/SearchWithinCourse"
+	// CourseServiceGetCourseAttachmentUploadURLProcedure is the fully-qualified name of the
+	// CourseService's GetCourseAttachmentUploadURL RPC.
+	CourseServiceGetCourseAttachmentUploadURLProcedure = "/mirai.v1.CourseService/GetCourseAttachmentUploadURL"
+	// CourseServiceConfirmCourseAttachmentProcedure is the fully-qualified name of the CourseService's
+	// ConfirmCourseAttachment RPC.
+	CourseServiceConfirmCourseAttachmentProcedure = "/mirai.v1.CourseService/ConfirmCourseAttachment"
+	// CourseServiceListCourseAttachmentsProcedure is the fully-qualified name of the CourseService's
+	// ListCourseAttachments RPC.
+	CourseServiceListCourseAttachmentsProcedure = "/mirai.v1.CourseService/ListCourseAttachments"
+	// CourseServiceSetCourseAttachmentExcludedProcedure is the fully-qualified name of the
+	// CourseService's SetCourseAttachmentExcluded RPC.
+	CourseServiceSetCourseAttachmentExcludedProcedure = "/mirai.v1.CourseService/SetCourseAttachmentExcluded"
+	// CourseServiceDeleteCourseAttachmentProcedure is the fully-qualified name of the CourseService's
+	// DeleteCourseAttachment RPC.
+	CourseServiceDeleteCourseAttachmentProcedure = "/mirai.v1.CourseService/DeleteCourseAttachment"
+	// CourseServiceListCourseCardsProcedure is the fully-qualified name of the CourseService's
+	// ListCourseCards RPC.
+	CourseServiceListCourseCardsProcedure = "/mirai.v1.CourseService/ListCourseCards"
+	// CourseServiceGetCourseCardDetailsProcedure is the fully-qualified name of the CourseService's
+	// GetCourseCardDetails RPC.
+	CourseServiceGetCourseCardDetailsProcedure = "/mirai.v1.CourseService/GetCourseCardDetails"
+	// CourseServiceHeartbeatCoursePresenceProcedure is the fully-qualified name of the CourseService's
+	// HeartbeatCoursePresence RPC.
+	CourseServiceHeartbeatCoursePresenceProcedure = "/mirai.v1.CourseService/HeartbeatCoursePresence"
+	// CourseServiceGetCoursePresenceProcedure is the fully-qualified name of the CourseService's
+	// GetCoursePresence RPC.
+	CourseServiceGetCoursePresenceProcedure = "/mirai.v1.CourseService/GetCoursePresence"
 )
 
 // CourseServiceClient is a client for the mirai.v1.CourseService service.
@@ -87,20 +141,66 @@ type CourseServiceClient interface {
 	DeleteCourse(context.Context, *connect.Request[v1.DeleteCourseRequest]) (*connect.Response[v1.DeleteCourseResponse], error)
 	// GetFolderHierarchy returns the folder structure with optional course counts.
 	GetFolderHierarchy(context.Context, *connect.Request[v1.GetFolderHierarchyRequest]) (*connect.Response[v1.GetFolderHierarchyResponse], error)
-	// GetLibrary returns the full library with courses and folders.
+	// GetLibrary returns the full library with courses and folders. Kept for compatibility;
+	// the library page should use ListCourseCards and GetCourseCardDetails instead.
 	GetLibrary(context.Context, *connect.Request[v1.GetLibraryRequest]) (*connect.Response[v1.GetLibraryResponse], error)
 	// CreateFolder creates a new folder in the library hierarchy (max 3 levels deep).
 	CreateFolder(context.Context, *connect.Request[v1.CreateFolderRequest]) (*connect.Response[v1.CreateFolderResponse], error)
+	// UpdateFolder renames a folder or changes it between regular and team folders.
+	UpdateFolder(context.Context, *connect.Request[v1.UpdateFolderRequest]) (*connect.Response[v1.UpdateFolderResponse], error)
 	// DeleteFolder deletes an empty folder from the library.
 	DeleteFolder(context.Context, *connect.Request[v1.DeleteFolderRequest]) (*connect.Response[v1.DeleteFolderResponse], error)
 	// ExportCourse initiates a course export job.
 	ExportCourse(context.Context, *connect.Request[v1.ExportCourseRequest]) (*connect.Response[v1.ExportCourseResponse], error)
 	// GetExportStatus returns the status of an export job.
 	GetExportStatus(context.Context, *connect.Request[v1.GetExportStatusRequest]) (*connect.Response[v1.GetExportStatusResponse], error)
-	// DownloadExport returns a presigned URL for downloading an export.
+	// DownloadExport returns a presigned URL for downloading an export. It fails with
+	// NOT_FOUND, explaining why, once the export's file has been deleted.
 	DownloadExport(context.Context, *connect.Request[v1.DownloadExportRequest]) (*connect.Response[v1.DownloadExportResponse], error)
-	// ListExports returns all exports for a course.
+	// ListExports returns all exports for a course, newest first, with when each file
+	// expires. Exports whose file was deleted are included with status PURGED.
 	ListExports(context.Context, *connect.Request[v1.ListExportsRequest]) (*connect.Response[v1.ListExportsResponse], error)
+	// ListCollaborators returns all collaborators assigned to a course.
+	ListCollaborators(context.Context, *connect.Request[v1.ListCollaboratorsRequest]) (*connect.Response[v1.ListCollaboratorsResponse], error)
+	// AddCollaborator assigns a user to a course (owners and admins only).
+	AddCollaborator(context.Context, *connect.Request[v1.AddCollaboratorRequest]) (*connect.Response[v1.AddCollaboratorResponse], error)
+	// RemoveCollaborator removes a user from a course (owners and admins only).
+	RemoveCollaborator(context.Context, *connect.Request[v1.RemoveCollaboratorRequest]) (*connect.Response[v1.RemoveCollaboratorResponse], error)
+	// RemoveSampleContent deletes the onboarding sample folders, course and target audience (admins only).
+	RemoveSampleContent(context.Context, *connect.Request[v1.RemoveSampleContentRequest]) (*connect.Response[v1.RemoveSampleContentResponse], error)
+	// ListLargestCourses returns the organization's largest courses by stored content size (admins only).
+	ListLargestCourses(context.Context, *connect.Request[v1.ListLargestCoursesRequest]) (*connect.Response[v1.ListLargestCoursesResponse], error)
+	// PublishChanges makes a published course's edits the version learners see (editors only).
+	PublishChanges(context.Context, *connect.Request[v1.PublishChangesRequest]) (*connect.Response[v1.PublishChangesResponse], error)
+	// DiscardDraft reverts a published course's edits to the version learners see (editors only).
+	DiscardDraft(context.Context, *connect.Request[v1.DiscardDraftRequest]) (*connect.Response[v1.DiscardDraftResponse], error)
+	// SearchWithinCourse finds the blocks and lesson components of a course that contain a
+	// phrase (editors only).
+	SearchWithinCourse(context.Context, *connect.Request[v1.SearchWithinCourseRequest]) (*connect.Response[v1.SearchWithinCourseResponse], error)
+	// GetCourseAttachmentUploadURL returns a presigned URL for uploading a reference file
+	// to a course (editors only).
+	GetCourseAttachmentUploadURL(context.Context, *connect.Request[v1.GetCourseAttachmentUploadURLRequest]) (*connect.Response[v1.GetCourseAttachmentUploadURLResponse], error)
+	// ConfirmCourseAttachment records an uploaded reference file and queues extraction of
+	// its text (editors only).
+	ConfirmCourseAttachment(context.Context, *connect.Request[v1.ConfirmCourseAttachmentRequest]) (*connect.Response[v1.ConfirmCourseAttachmentResponse], error)
+	// ListCourseAttachments lists a course's reference files with their extraction status.
+	ListCourseAttachments(context.Context, *connect.Request[v1.ListCourseAttachmentsRequest]) (*connect.Response[v1.ListCourseAttachmentsResponse], error)
+	// SetCourseAttachmentExcluded includes a reference file in generation prompts or leaves
+	// it out (editors only).
+	SetCourseAttachmentExcluded(context.Context, *connect.Request[v1.SetCourseAttachmentExcludedRequest]) (*connect.Response[v1.SetCourseAttachmentExcludedResponse], error)
+	// DeleteCourseAttachment removes a reference file and its extracted text (editors only).
+	DeleteCourseAttachment(context.Context, *connect.Request[v1.DeleteCourseAttachmentRequest]) (*connect.Response[v1.DeleteCourseAttachmentResponse], error)
+	// ListCourseCards returns a page of lightweight course cards, most recently modified
+	// first. This is the preferred way to load the library.
+	ListCourseCards(context.Context, *connect.Request[v1.ListCourseCardsRequest]) (*connect.Response[v1.ListCourseCardsResponse], error)
+	// GetCourseCardDetails returns tags, thumbnails, creators and durations for the course
+	// cards on screen (up to 50 at a time).
+	GetCourseCardDetails(context.Context, *connect.Request[v1.GetCourseCardDetailsRequest]) (*connect.Response[v1.GetCourseCardDetailsResponse], error)
+	// HeartbeatCoursePresence marks the caller as having the course open and returns who else
+	// does. The editor calls it every 20 seconds; presence lapses a minute after the last call.
+	HeartbeatCoursePresence(context.Context, *connect.Request[v1.HeartbeatCoursePresenceRequest]) (*connect.Response[v1.HeartbeatCoursePresenceResponse], error)
+	// GetCoursePresence returns who other than the caller has the course open.
+	GetCoursePresence(context.Context, *connect.Request[v1.GetCoursePresenceRequest]) (*connect.Response[v1.GetCoursePresenceResponse], error)
 }
 
 // NewCourseServiceClient constructs a client for the mirai.v1.CourseService service. By default, it
@@ -162,6 +262,12 @@ func NewCourseServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(courseServiceMethods.ByName("CreateFolder")),
 			connect.WithClientOptions(opts...),
 		),
+		updateFolder: connect.NewClient[v1.UpdateFolderRequest, v1.UpdateFolderResponse](
+			httpClient,
+			baseURL+CourseServiceUpdateFolderProcedure,
+			connect.WithSchema(courseServiceMethods.ByName("UpdateFolder")),
+			connect.WithClientOptions(opts...),
+		),
 		deleteFolder: connect.NewClient[v1.DeleteFolderRequest, v1.DeleteFolderResponse](
 			httpClient,
 			baseURL+CourseServiceDeleteFolderProcedure,
@@ -192,24 +298,144 @@ func NewCourseServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(courseServiceMethods.ByName("ListExports")),
 			connect.WithClientOptions(opts...),
 		),
+		listCollaborators: connect.NewClient[v1.ListCollaboratorsRequest, v1.ListCollaboratorsResponse](
+			httpClient,
+			baseURL+CourseServiceListCollaboratorsProcedure,
+			connect.WithSchema(courseServiceMethods.ByName("ListCollaborators")),
+			connect.WithClientOptions(opts...),
+		),
+		addCollaborator: connect.NewClient[v1.AddCollaboratorRequest, v1.AddCollaboratorResponse](
+			httpClient,
+			baseURL+CourseServiceAddCollaboratorProcedure,
+			connect.WithSchema(courseServiceMethods.ByName("AddCollaborator")),
+			connect.WithClientOptions(opts...),
+		),
+		removeCollaborator: connect.NewClient[v1.RemoveCollaboratorRequest, v1.RemoveCollaboratorResponse](
+			httpClient,
+			baseURL+CourseServiceRemoveCollaboratorProcedure,
+			connect.WithSchema(courseServiceMethods.ByName("RemoveCollaborator")),
+			connect.WithClientOptions(opts...),
+		),
+		removeSampleContent: connect.NewClient[v1.RemoveSampleContentRequest, v1.RemoveSampleContentResponse](
+			httpClient,
+			baseURL+CourseServiceRemoveSampleContentProcedure,
+			connect.WithSchema(courseServiceMethods.ByName("RemoveSampleContent")),
+			connect.WithClientOptions(opts...),
+		),
+		listLargestCourses: connect.NewClient[v1.ListLargestCoursesRequest, v1.ListLargestCoursesResponse](
+			httpClient,
+			baseURL+CourseServiceListLargestCoursesProcedure,
+			connect.WithSchema(courseServiceMethods.ByName("ListLargestCourses")),
+			connect.WithClientOptions(opts...),
+		),
+		publishChanges: connect.NewClient[v1.PublishChangesRequest, v1.PublishChangesResponse](
+			httpClient,
+			baseURL+CourseServicePublishChangesProcedure,
+			connect.WithSchema(courseServiceMethods.ByName("PublishChanges")),
+			connect.WithClientOptions(opts...),
+		),
+		discardDraft: connect.NewClient[v1.DiscardDraftRequest, v1.DiscardDraftResponse](
+			httpClient,
+			baseURL+CourseServiceDiscardDraftProcedure,
+			connect.WithSchema(courseServiceMethods.ByName("DiscardDraft")),
+			connect.WithClientOptions(opts...),
+		),
+		searchWithinCourse: connect.NewClient[v1.SearchWithinCourseRequest, v1.SearchWithinCourseResponse](
+			httpClient,
+			baseURL+CourseServiceSearchWithinCourseProcedure,
+			connect.WithSchema(courseServiceMethods.ByName("SearchWithinCourse")),
+			connect.WithClientOptions(opts...),
+		),
+		getCourseAttachmentUploadURL: connect.NewClient[v1.GetCourseAttachmentUploadURLRequest, v1.GetCourseAttachmentUploadURLResponse](
+			httpClient,
+			baseURL+CourseServiceGetCourseAttachmentUploadURLProcedure,
+			connect.WithSchema(courseServiceMethods.ByName("GetCourseAttachmentUploadURL")),
+			connect.WithClientOptions(opts...),
+		),
+		confirmCourseAttachment: connect.NewClient[v1.ConfirmCourseAttachmentRequest, v1.ConfirmCourseAttachmentResponse](
+			httpClient,
+			baseURL+CourseServiceConfirmCourseAttachmentProcedure,
+			connect.WithSchema(courseServiceMethods.ByName("ConfirmCourseAttachment")),
+			connect.WithClientOptions(opts...),
+		),
+		listCourseAttachments: connect.NewClient[v1.ListCourseAttachmentsRequest, v1.ListCourseAttachmentsResponse](
+			httpClient,
+			baseURL+CourseServiceListCourseAttachmentsProcedure,
+			connect.WithSchema(courseServiceMethods.ByName("ListCourseAttachments")),
+			connect.WithClientOptions(opts...),
+		),
+		setCourseAttachmentExcluded: connect.NewClient[v1.SetCourseAttachmentExcludedRequest, v1.SetCourseAttachmentExcludedResponse](
+			httpClient,
+			baseURL+CourseServiceSetCourseAttachmentExcludedProcedure,
+			connect.WithSchema(courseServiceMethods.ByName("SetCourseAttachmentExcluded")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteCourseAttachment: connect.NewClient[v1.DeleteCourseAttachmentRequest, v1.DeleteCourseAttachmentResponse](
+			httpClient,
+			baseURL+CourseServiceDeleteCourseAttachmentProcedure,
+			connect.WithSchema(courseServiceMethods.ByName("DeleteCourseAttachment")),
+			connect.WithClientOptions(opts...),
+		),
+		listCourseCards: connect.NewClient[v1.ListCourseCardsRequest, v1.ListCourseCardsResponse](
+			httpClient,
+			baseURL+CourseServiceListCourseCardsProcedure,
+			connect.WithSchema(courseServiceMethods.ByName("ListCourseCards")),
+			connect.WithClientOptions(opts...),
+		),
+		getCourseCardDetails: connect.NewClient[v1.GetCourseCardDetailsRequest, v1.GetCourseCardDetailsResponse](
+			httpClient,
+			baseURL+CourseServiceGetCourseCardDetailsProcedure,
+			connect.WithSchema(courseServiceMethods.ByName("GetCourseCardDetails")),
+			connect.WithClientOptions(opts...),
+		),
+		heartbeatCoursePresence: connect.NewClient[v1.HeartbeatCoursePresenceRequest, v1.HeartbeatCoursePresenceResponse](
+			httpClient,
+			baseURL+CourseServiceHeartbeatCoursePresenceProcedure,
+			connect.WithSchema(courseServiceMethods.ByName("HeartbeatCoursePresence")),
+			connect.WithClientOptions(opts...),
+		),
+		getCoursePresence: connect.NewClient[v1.GetCoursePresenceRequest, v1.GetCoursePresenceResponse](
+			httpClient,
+			baseURL+CourseServiceGetCoursePresenceProcedure,
+			connect.WithSchema(courseServiceMethods.ByName("GetCoursePresence")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // courseServiceClient implements CourseServiceClient.
 type courseServiceClient struct {
-	listCourses        *connect.Client[v1.ListCoursesRequest, v1.ListCoursesResponse]
-	getCourse          *connect.Client[v1.GetCourseRequest, v1.GetCourseResponse]
-	createCourse       *connect.Client[v1.CreateCourseRequest, v1.CreateCourseResponse]
-	updateCourse       *connect.Client[v1.UpdateCourseRequest, v1.UpdateCourseResponse]
-	deleteCourse       *connect.Client[v1.DeleteCourseRequest, v1.DeleteCourseResponse]
-	getFolderHierarchy *connect.Client[v1.GetFolderHierarchyRequest, v1.GetFolderHierarchyResponse]
-	getLibrary         *connect.Client[v1.GetLibraryRequest, v1.GetLibraryResponse]
-	createFolder       *connect.Client[v1.CreateFolderRequest, v1.CreateFolderResponse]
-	deleteFolder       *connect.Client[v1.DeleteFolderRequest, v1.DeleteFolderResponse]
-	exportCourse       *connect.Client[v1.ExportCourseRequest, v1.ExportCourseResponse]
-	getExportStatus    *connect.Client[v1.GetExportStatusRequest, v1.GetExportStatusResponse]
-	downloadExport     *connect.Client[v1.DownloadExportRequest, v1.DownloadExportResponse]
-	listExports        *connect.Client[v1.ListExportsRequest, v1.ListExportsResponse]
+	listCourses                  *connect.Client[v1.ListCoursesRequest, v1.ListCoursesResponse]
+	getCourse                    *connect.Client[v1.GetCourseRequest, v1.GetCourseResponse]
+	createCourse                 *connect.Client[v1.CreateCourseRequest, v1.CreateCourseResponse]
+	updateCourse                 *connect.Client[v1.UpdateCourseRequest, v1.UpdateCourseResponse]
+	deleteCourse                 *connect.Client[v1.DeleteCourseRequest, v1.DeleteCourseResponse]
+	getFolderHierarchy           *connect.Client[v1.GetFolderHierarchyRequest, v1.GetFolderHierarchyResponse]
+	getLibrary                   *connect.Client[v1.GetLibraryRequest, v1.GetLibraryResponse]
+	createFolder                 *connect.Client[v1.CreateFolderRequest, v1.CreateFolderResponse]
+	updateFolder                 *connect.Client[v1.UpdateFolderRequest, v1.UpdateFolderResponse]
+	deleteFolder                 *connect.Client[v1.DeleteFolderRequest, v1.DeleteFolderResponse]
+	exportCourse                 *connect.Client[v1.ExportCourseRequest, v1.ExportCourseResponse]
+	getExportStatus              *connect.Client[v1.GetExportStatusRequest, v1.GetExportStatusResponse]
+	downloadExport               *connect.Client[v1.DownloadExportRequest, v1.DownloadExportResponse]
+	listExports                  *connect.Client[v1.ListExportsRequest, v1.ListExportsResponse]
+	listCollaborators            *connect.Client[v1.ListCollaboratorsRequest, v1.ListCollaboratorsResponse]
+	addCollaborator              *connect.Client[v1.AddCollaboratorRequest, v1.AddCollaboratorResponse]
+	removeCollaborator           *connect.Client[v1.RemoveCollaboratorRequest, v1.RemoveCollaboratorResponse]
+	removeSampleContent          *connect.Client[v1.RemoveSampleContentRequest, v1.RemoveSampleContentResponse]
+	listLargestCourses           *connect.Client[v1.ListLargestCoursesRequest, v1.ListLargestCoursesResponse]
+	publishChanges               *connect.Client[v1.PublishChangesRequest, v1.PublishChangesResponse]
+	discardDraft                 *connect.Client[v1.DiscardDraftRequest, v1.DiscardDraftResponse]
+	searchWithinCourse           *connect.Client[v1.SearchWithinCourseRequest, v1.SearchWithinCourseResponse]
+	getCourseAttachmentUploadURL *connect.Client[v1.GetCourseAttachmentUploadURLRequest, v1.GetCourseAttachmentUploadURLResponse]
+	confirmCourseAttachment      *connect.Client[v1.ConfirmCourseAttachmentRequest, v1.ConfirmCourseAttachmentResponse]
+	listCourseAttachments        *connect.Client[v1.ListCourseAttachmentsRequest, v1.ListCourseAttachmentsResponse]
+	setCourseAttachmentExcluded  *connect.Client[v1.SetCourseAttachmentExcludedRequest, v1.SetCourseAttachmentExcludedResponse]
+	deleteCourseAttachment       *connect.Client[v1.DeleteCourseAttachmentRequest, v1.DeleteCourseAttachmentResponse]
+	listCourseCards              *connect.Client[v1.ListCourseCardsRequest, v1.ListCourseCardsResponse]
+	getCourseCardDetails         *connect.Client[v1.GetCourseCardDetailsRequest, v1.GetCourseCardDetailsResponse]
+	heartbeatCoursePresence      *connect.Client[v1.HeartbeatCoursePresenceRequest, v1.HeartbeatCoursePresenceResponse]
+	getCoursePresence            *connect.Client[v1.GetCoursePresenceRequest, v1.GetCoursePresenceResponse]
 }
 
 // ListCourses calls mirai.v1.CourseService.ListCourses.
@@ -252,6 +478,11 @@ func (c *courseServiceClient) CreateFolder(ctx context.Context, req *connect.Req
 	return c.createFolder.CallUnary(ctx, req)
 }
 
+// UpdateFolder calls mirai.v1.CourseService.UpdateFolder.
+func (c *courseServiceClient) UpdateFolder(ctx context.Context, req *connect.Request[v1.UpdateFolderRequest]) (*connect.Response[v1.UpdateFolderResponse], error) {
+	return c.updateFolder.CallUnary(ctx, req)
+}
+
 // DeleteFolder calls mirai.v1.CourseService.DeleteFolder.
 func (c *courseServiceClient) DeleteFolder(ctx context.Context, req *connect.Request[v1.DeleteFolderRequest]) (*connect.Response[v1.DeleteFolderResponse], error) {
 	return c.deleteFolder.CallUnary(ctx, req)
@@ -277,6 +508,91 @@ func (c *courseServiceClient) ListExports(ctx context.Context, req *connect.Requ
 	return c.listExports.CallUnary(ctx, req)
 }
 
+// ListCollaborators calls mirai.v1.CourseService.ListCollaborators.
+func (c *courseServiceClient) ListCollaborators(ctx context.Context, req *connect.Request[v1.ListCollaboratorsRequest]) (*connect.Response[v1.ListCollaboratorsResponse], error) {
+	return c.listCollaborators.CallUnary(ctx, req)
+}
+
+// AddCollaborator calls mirai.v1.CourseService.AddCollaborator.
+func (c *courseServiceClient) AddCollaborator(ctx context.Context, req *connect.Request[v1.AddCollaboratorRequest]) (*connect.Response[v1.AddCollaboratorResponse], error) {
+	return c.addCollaborator.CallUnary(ctx, req)
+}
+
+// RemoveCollaborator calls mirai.v1.CourseService.RemoveCollaborator.
+func (c *courseServiceClient) RemoveCollaborator(ctx context.Context, req *connect.Request[v1.RemoveCollaboratorRequest]) (*connect.Response[v1.RemoveCollaboratorResponse], error) {
+	return c.removeCollaborator.CallUnary(ctx, req)
+}
+
+// RemoveSampleContent calls mirai.v1.CourseService.RemoveSampleContent.
+func (c *courseServiceClient) RemoveSampleContent(ctx context.Context, req *connect.Request[v1.RemoveSampleContentRequest]) (*connect.Response[v1.RemoveSampleContentResponse], error) {
+	return c.removeSampleContent.CallUnary(ctx, req)
+}
+
+// ListLargestCourses calls mirai.v1.CourseService.ListLargestCourses.
+func (c *courseServiceClient) ListLargestCourses(ctx context.Context, req *connect.Request[v1.ListLargestCoursesRequest]) (*connect.Response[v1.ListLargestCoursesResponse], error) {
+	return c.listLargestCourses.CallUnary(ctx, req)
+}
+
+// PublishChanges calls mirai.v1.CourseService.PublishChanges.
+func (c *courseServiceClient) PublishChanges(ctx context.Context, req *connect.Request[v1.PublishChangesRequest]) (*connect.Response[v1.PublishChangesResponse], error) {
+	return c.publishChanges.CallUnary(ctx, req)
+}
+
+// DiscardDraft calls mirai.v1.CourseService.DiscardDraft.
+func (c *courseServiceClient) DiscardDraft(ctx context.Context, req *connect.Request[v1.DiscardDraftRequest]) (*connect.Response[v1.DiscardDraftResponse], error) {
+	return c.discardDraft.CallUnary(ctx, req)
+}
+
+// SearchWithinCourse calls mirai.v1.CourseService.SearchWithinCourse.
+func (c *courseServiceClient) SearchWithinCourse(ctx context.Context, req *connect.Request[v1.SearchWithinCourseRequest]) (*connect.Response[v1.SearchWithinCourseResponse], error) {
+	return c.searchWithinCourse.CallUnary(ctx, req)
+}
+
+// GetCourseAttachmentUploadURL calls mirai.v1.CourseService.GetCourseAttachmentUploadURL.
+func (c *courseServiceClient) GetCourseAttachmentUploadURL(ctx context.Context, req *connect.Request[v1.GetCourseAttachmentUploadURLRequest]) (*connect.Response[v1.GetCourseAttachmentUploadURLResponse], error) {
+	return c.getCourseAttachmentUploadURL.CallUnary(ctx, req)
+}
+
+// ConfirmCourseAttachment calls mirai.v1.CourseService.ConfirmCourseAttachment.
+func (c *courseServiceClient) ConfirmCourseAttachment(ctx context.Context, req *connect.Request[v1.ConfirmCourseAttachmentRequest]) (*connect.Response[v1.ConfirmCourseAttachmentResponse], error) {
+	return c.confirmCourseAttachment.CallUnary(ctx, req)
+}
+
+// ListCourseAttachments calls mirai.v1.CourseService.ListCourseAttachments.
+func (c *courseServiceClient) ListCourseAttachments(ctx context.Context, req *connect.Request[v1.ListCourseAttachmentsRequest]) (*connect.Response[v1.ListCourseAttachmentsResponse], error) {
+	return c.listCourseAttachments.CallUnary(ctx, req)
+}
+
+// SetCourseAttachmentExcluded calls mirai.v1.CourseService.SetCourseAttachmentExcluded.
+func (c *courseServiceClient) SetCourseAttachmentExcluded(ctx context.Context, req *connect.Request[v1.SetCourseAttachmentExcludedRequest]) (*connect.Response[v1.SetCourseAttachmentExcludedResponse], error) {
+	return c.setCourseAttachmentExcluded.CallUnary(ctx, req)
+}
+
+// DeleteCourseAttachment calls mirai.v1.CourseService.DeleteCourseAttachment.
+func (c *courseServiceClient) DeleteCourseAttachment(ctx context.Context, req *connect.Request[v1.DeleteCourseAttachmentRequest]) (*connect.Response[v1.DeleteCourseAttachmentResponse], error) {
+	return c.deleteCourseAttachment.CallUnary(ctx, req)
+}
+
+// ListCourseCards calls mirai.v1.CourseService.ListCourseCards.
+func (c *courseServiceClient) ListCourseCards(ctx context.Context, req *connect.Request[v1.ListCourseCardsRequest]) (*connect.Response[v1.ListCourseCardsResponse], error) {
+	return c.listCourseCards.CallUnary(ctx, req)
+}
+
+// GetCourseCardDetails calls mirai.v1.CourseService.GetCourseCardDetails.
+func (c *courseServiceClient) GetCourseCardDetails(ctx context.Context, req *connect.Request[v1.GetCourseCardDetailsRequest]) (*connect.Response[v1.GetCourseCardDetailsResponse], error) {
+	return c.getCourseCardDetails.CallUnary(ctx, req)
+}
+
+// HeartbeatCoursePresence calls mirai.v1.CourseService.HeartbeatCoursePresence.
+func (c *courseServiceClient) HeartbeatCoursePresence(ctx context.Context, req *connect.Request[v1.HeartbeatCoursePresenceRequest]) (*connect.Response[v1.HeartbeatCoursePresenceResponse], error) {
+	return c.heartbeatCoursePresence.CallUnary(ctx, req)
+}
+
+// GetCoursePresence calls mirai.v1.CourseService.GetCoursePresence.
+func (c *courseServiceClient) GetCoursePresence(ctx context.Context, req *connect.Request[v1.GetCoursePresenceRequest]) (*connect.Response[v1.GetCoursePresenceResponse], error) {
+	return c.getCoursePresence.CallUnary(ctx, req)
+}
+
 // CourseServiceHandler is an implementation of the mirai.v1.CourseService service.
 type CourseServiceHandler interface {
 	// ListCourses returns a filtered list of courses.
@@ -291,20 +607,66 @@ type CourseServiceHandler interface {
 	DeleteCourse(context.Context, *connect.Request[v1.DeleteCourseRequest]) (*connect.Response[v1.DeleteCourseResponse], error)
 	// GetFolderHierarchy returns the folder structure with optional course counts.
 	GetFolderHierarchy(context.Context, *connect.Request[v1.GetFolderHierarchyRequest]) (*connect.Response[v1.GetFolderHierarchyResponse], error)
-	// GetLibrary returns the full library with courses and folders.
+	// GetLibrary returns the full library with courses and folders. Kept for compatibility;
+	// the library page should use ListCourseCards and GetCourseCardDetails instead.
 	GetLibrary(context.Context, *connect.Request[v1.GetLibraryRequest]) (*connect.Response[v1.GetLibraryResponse], error)
 	// CreateFolder creates a new folder in the library hierarchy (max 3 levels deep).
 	CreateFolder(context.Context, *connect.Request[v1.CreateFolderRequest]) (*connect.Response[v1.CreateFolderResponse], error)
+	// UpdateFolder renames a folder or changes it between regular and team folders.
+	UpdateFolder(context.Context, *connect.Request[v1.UpdateFolderRequest]) (*connect.Response[v1.UpdateFolderResponse], error)
 	// DeleteFolder deletes an empty folder from the library.
 	DeleteFolder(context.Context, *connect.Request[v1.DeleteFolderRequest]) (*connect.Response[v1.DeleteFolderResponse], error)
 	// ExportCourse initiates a course export job.
 	ExportCourse(context.Context, *connect.Request[v1.ExportCourseRequest]) (*connect.Response[v1.ExportCourseResponse], error)
 	// GetExportStatus returns the status of an export job.
 	GetExportStatus(context.Context, *connect.Request[v1.GetExportStatusRequest]) (*connect.Response[v1.GetExportStatusResponse], error)
-	// DownloadExport returns a presigned URL for downloading an export.
+	// DownloadExport returns a presigned URL for downloading an export. It fails with
+	// NOT_FOUND, explaining why, once the export's file has been deleted.
 	DownloadExport(context.Context, *connect.Request[v1.DownloadExportRequest]) (*connect.Response[v1.DownloadExportResponse], error)
-	// ListExports returns all exports for a course.
+	// ListExports returns all exports for a course, newest first, with when each file
+	// expires. Exports whose file was deleted are included with status PURGED.
 	ListExports(context.Context, *connect.Request[v1.ListExportsRequest]) (*connect.Response[v1.ListExportsResponse], error)
+	// ListCollaborators returns all collaborators assigned to a course.
+	ListCollaborators(context.Context, *connect.Request[v1.ListCollaboratorsRequest]) (*connect.Response[v1.ListCollaboratorsResponse], error)
+	// AddCollaborator assigns a user to a course (owners and admins only).
+	AddCollaborator(context.Context, *connect.Request[v1.AddCollaboratorRequest]) (*connect.Response[v1.AddCollaboratorResponse], error)
+	// RemoveCollaborator removes a user from a course (owners and admins only).
+	RemoveCollaborator(context.Context, *connect.Request[v1.RemoveCollaboratorRequest]) (*connect.Response[v1.RemoveCollaboratorResponse], error)
+	// RemoveSampleContent deletes the onboarding sample folders, course and target audience (admins only).
+	RemoveSampleContent(context.Context, *connect.Request[v1.RemoveSampleContentRequest]) (*connect.Response[v1.RemoveSampleContentResponse], error)
+	// ListLargestCourses returns the organization's largest courses by stored content size (admins only).
+	ListLargestCourses(context.Context, *connect.Request[v1.ListLargestCoursesRequest]) (*connect.Response[v1.ListLargestCoursesResponse], error)
+	// PublishChanges makes a published course's edits the version learners see (editors only).
+	PublishChanges(context.Context, *connect.Request[v1.PublishChangesRequest]) (*connect.Response[v1.PublishChangesResponse], error)
+	// DiscardDraft reverts a published course's edits to the version learners see (editors only).
+	DiscardDraft(context.Context, *connect.Request[v1.DiscardDraftRequest]) (*connect.Response[v1.DiscardDraftResponse], error)
+	// SearchWithinCourse finds the blocks and lesson components of a course that contain a
+	// phrase (editors only).
+	SearchWithinCourse(context.Context, *connect.Request[v1.SearchWithinCourseRequest]) (*connect.Response[v1.SearchWithinCourseResponse], error)
+	// GetCourseAttachmentUploadURL returns a presigned URL for uploading a reference file
+	// to a course (editors only).
+	GetCourseAttachmentUploadURL(context.Context, *connect.Request[v1.GetCourseAttachmentUploadURLRequest]) (*connect.Response[v1.GetCourseAttachmentUploadURLResponse], error)
+	// ConfirmCourseAttachment records an uploaded reference file and queues extraction of
+	// its text (editors only).
+	ConfirmCourseAttachment(context.Context, *connect.Request[v1.ConfirmCourseAttachmentRequest]) (*connect.Response[v1.ConfirmCourseAttachmentResponse], error)
+	// ListCourseAttachments lists a course's reference files with their extraction status.
+	ListCourseAttachments(context.Context, *connect.Request[v1.ListCourseAttachmentsRequest]) (*connect.Response[v1.ListCourseAttachmentsResponse], error)
+	// SetCourseAttachmentExcluded includes a reference file in generation prompts or leaves
+	// it out (editors only).
+	SetCourseAttachmentExcluded(context.Context, *connect.Request[v1.SetCourseAttachmentExcludedRequest]) (*connect.Response[v1.SetCourseAttachmentExcludedResponse], error)
+	// DeleteCourseAttachment removes a reference file and its extracted text (editors only).
+	DeleteCourseAttachment(context.Context, *connect.Request[v1.DeleteCourseAttachmentRequest]) (*connect.Response[v1.DeleteCourseAttachmentResponse], error)
+	// ListCourseCards returns a page of lightweight course cards, most recently modified
+	// first. This is the preferred way to load the library.
+	ListCourseCards(context.Context, *connect.Request[v1.ListCourseCardsRequest]) (*connect.Response[v1.ListCourseCardsResponse], error)
+	// GetCourseCardDetails returns tags, thumbnails, creators and durations for the course
+	// cards on screen (up to 50 at a time).
+	GetCourseCardDetails(context.Context, *connect.Request[v1.GetCourseCardDetailsRequest]) (*connect.Response[v1.GetCourseCardDetailsResponse], error)
+	// HeartbeatCoursePresence marks the caller as having the course open and returns who else
+	// does. The editor calls it every 20 seconds; presence lapses a minute after the last call.
+	HeartbeatCoursePresence(context.Context, *connect.Request[v1.HeartbeatCoursePresenceRequest]) (*connect.Response[v1.HeartbeatCoursePresenceResponse], error)
+	// GetCoursePresence returns who other than the caller has the course open.
+	GetCoursePresence(context.Context, *connect.Request[v1.GetCoursePresenceRequest]) (*connect.Response[v1.GetCoursePresenceResponse], error)
 }
 
 // NewCourseServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -362,6 +724,12 @@ func NewCourseServiceHandler(svc CourseServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(courseServiceMethods.ByName("CreateFolder")),
 		connect.WithHandlerOptions(opts...),
 	)
+	courseServiceUpdateFolderHandler := connect.NewUnaryHandler(
+		CourseServiceUpdateFolderProcedure,
+		svc.UpdateFolder,
+		connect.WithSchema(courseServiceMethods.ByName("UpdateFolder")),
+		connect.WithHandlerOptions(opts...),
+	)
 	courseServiceDeleteFolderHandler := connect.NewUnaryHandler(
 		CourseServiceDeleteFolderProcedure,
 		svc.DeleteFolder,
@@ -392,6 +760,108 @@ func NewCourseServiceHandler(svc CourseServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(courseServiceMethods.ByName("ListExports")),
 		connect.WithHandlerOptions(opts...),
 	)
+	courseServiceListCollaboratorsHandler := connect.NewUnaryHandler(
+		CourseServiceListCollaboratorsProcedure,
+		svc.ListCollaborators,
+		connect.WithSchema(courseServiceMethods.ByName("ListCollaborators")),
+		connect.WithHandlerOptions(opts...),
+	)
+	courseServiceAddCollaboratorHandler := connect.NewUnaryHandler(
+		CourseServiceAddCollaboratorProcedure,
+		svc.AddCollaborator,
+		connect.WithSchema(courseServiceMethods.ByName("AddCollaborator")),
+		connect.WithHandlerOptions(opts...),
+	)
+	courseServiceRemoveCollaboratorHandler := connect.NewUnaryHandler(
+		CourseServiceRemoveCollaboratorProcedure,
+		svc.RemoveCollaborator,
+		connect.WithSchema(courseServiceMethods.ByName("RemoveCollaborator")),
+		connect.WithHandlerOptions(opts...),
+	)
+	courseServiceRemoveSampleContentHandler := connect.NewUnaryHandler(
+		CourseServiceRemoveSampleContentProcedure,
+		svc.RemoveSampleContent,
+		connect.WithSchema(courseServiceMethods.ByName("RemoveSampleContent")),
+		connect.WithHandlerOptions(opts...),
+	)
+	courseServiceListLargestCoursesHandler := connect.NewUnaryHandler(
+		CourseServiceListLargestCoursesProcedure,
+		svc.ListLargestCourses,
+		connect.WithSchema(courseServiceMethods.ByName("ListLargestCourses")),
+		connect.WithHandlerOptions(opts...),
+	)
+	courseServicePublishChangesHandler := connect.NewUnaryHandler(
+		CourseServicePublishChangesProcedure,
+		svc.PublishChanges,
+		connect.WithSchema(courseServiceMethods.ByName("PublishChanges")),
+		connect.WithHandlerOptions(opts...),
+	)
+	courseServiceDiscardDraftHandler := connect.NewUnaryHandler(
+		CourseServiceDiscardDraftProcedure,
+		svc.DiscardDraft,
+		connect.WithSchema(courseServiceMethods.ByName("DiscardDraft")),
+		connect.WithHandlerOptions(opts...),
+	)
+	courseServiceSearchWithinCourseHandler := connect.NewUnaryHandler(
+		CourseServiceSearchWithinCourseProcedure,
+		svc.SearchWithinCourse,
+		connect.WithSchema(courseServiceMethods.ByName("SearchWithinCourse")),
+		connect.WithHandlerOptions(opts...),
+	)
+	courseServiceGetCourseAttachmentUploadURLHandler := connect.NewUnaryHandler(
+		CourseServiceGetCourseAttachmentUploadURLProcedure,
+		svc.GetCourseAttachmentUploadURL,
+		connect.WithSchema(courseServiceMethods.ByName("GetCourseAttachmentUploadURL")),
+		connect.WithHandlerOptions(opts...),
+	)
+	courseServiceConfirmCourseAttachmentHandler := connect.NewUnaryHandler(
+		CourseServiceConfirmCourseAttachmentProcedure,
+		svc.ConfirmCourseAttachment,
+		connect.WithSchema(courseServiceMethods.ByName("ConfirmCourseAttachment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	courseServiceListCourseAttachmentsHandler := connect.NewUnaryHandler(
+		CourseServiceListCourseAttachmentsProcedure,
+		svc.ListCourseAttachments,
+		connect.WithSchema(courseServiceMethods.ByName("ListCourseAttachments")),
+		connect.WithHandlerOptions(opts...),
+	)
+	courseServiceSetCourseAttachmentExcludedHandler := connect.NewUnaryHandler(
+		CourseServiceSetCourseAttachmentExcludedProcedure,
+		svc.SetCourseAttachmentExcluded,
+		connect.WithSchema(courseServiceMethods.ByName("SetCourseAttachmentExcluded")),
+		connect.WithHandlerOptions(opts...),
+	)
+	courseServiceDeleteCourseAttachmentHandler := connect.NewUnaryHandler(
+		CourseServiceDeleteCourseAttachmentProcedure,
+		svc.DeleteCourseAttachment,
+		connect.WithSchema(courseServiceMethods.ByName("DeleteCourseAttachment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	courseServiceListCourseCardsHandler := connect.NewUnaryHandler(
+		CourseServiceListCourseCardsProcedure,
+		svc.ListCourseCards,
+		connect.WithSchema(courseServiceMethods.ByName("ListCourseCards")),
+		connect.WithHandlerOptions(opts...),
+	)
+	courseServiceGetCourseCardDetailsHandler := connect.NewUnaryHandler(
+		CourseServiceGetCourseCardDetailsProcedure,
+		svc.GetCourseCardDetails,
+		connect.WithSchema(courseServiceMethods.ByName("GetCourseCardDetails")),
+		connect.WithHandlerOptions(opts...),
+	)
+	courseServiceHeartbeatCoursePresenceHandler := connect.NewUnaryHandler(
+		CourseServiceHeartbeatCoursePresenceProcedure,
+		svc.HeartbeatCoursePresence,
+		connect.WithSchema(courseServiceMethods.ByName("HeartbeatCoursePresence")),
+		connect.WithHandlerOptions(opts...),
+	)
+	courseServiceGetCoursePresenceHandler := connect.NewUnaryHandler(
+		CourseServiceGetCoursePresenceProcedure,
+		svc.GetCoursePresence,
+		connect.WithSchema(courseServiceMethods.ByName("GetCoursePresence")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/mirai.v1.CourseService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case CourseServiceListCoursesProcedure:
@@ -410,6 +880,8 @@ func NewCourseServiceHandler(svc CourseServiceHandler, opts ...connect.HandlerOp
 			courseServiceGetLibraryHandler.ServeHTTP(w, r)
 		case CourseServiceCreateFolderProcedure:
 			courseServiceCreateFolderHandler.ServeHTTP(w, r)
+		case CourseServiceUpdateFolderProcedure:
+			courseServiceUpdateFolderHandler.ServeHTTP(w, r)
 		case CourseServiceDeleteFolderProcedure:
 			courseServiceDeleteFolderHandler.ServeHTTP(w, r)
 		case CourseServiceExportCourseProcedure:
@@ -420,6 +892,40 @@ func NewCourseServiceHandler(svc CourseServiceHandler, opts ...connect.HandlerOp
 			courseServiceDownloadExportHandler.ServeHTTP(w, r)
 		case CourseServiceListExportsProcedure:
 			courseServiceListExportsHandler.ServeHTTP(w, r)
+		case CourseServiceListCollaboratorsProcedure:
+			courseServiceListCollaboratorsHandler.ServeHTTP(w, r)
+		case CourseServiceAddCollaboratorProcedure:
+			courseServiceAddCollaboratorHandler.ServeHTTP(w, r)
+		case CourseServiceRemoveCollaboratorProcedure:
+			courseServiceRemoveCollaboratorHandler.ServeHTTP(w, r)
+		case CourseServiceRemoveSampleContentProcedure:
+			courseServiceRemoveSampleContentHandler.ServeHTTP(w, r)
+		case CourseServiceListLargestCoursesProcedure:
+			courseServiceListLargestCoursesHandler.ServeHTTP(w, r)
+		case CourseServicePublishChangesProcedure:
+			courseServicePublishChangesHandler.ServeHTTP(w, r)
+		case CourseServiceDiscardDraftProcedure:
+			courseServiceDiscardDraftHandler.ServeHTTP(w, r)
+		case CourseServiceSearchWithinCourseProcedure:
+			courseServiceSearchWithinCourseHandler.ServeHTTP(w, r)
+		case CourseServiceGetCourseAttachmentUploadURLProcedure:
+			courseServiceGetCourseAttachmentUploadURLHandler.ServeHTTP(w, r)
+		case CourseServiceConfirmCourseAttachmentProcedure:
+			courseServiceConfirmCourseAttachmentHandler.ServeHTTP(w, r)
+		case CourseServiceListCourseAttachmentsProcedure:
+			courseServiceListCourseAttachmentsHandler.ServeHTTP(w, r)
+		case CourseServiceSetCourseAttachmentExcludedProcedure:
+			courseServiceSetCourseAttachmentExcludedHandler.ServeHTTP(w, r)
+		case CourseServiceDeleteCourseAttachmentProcedure:
+			courseServiceDeleteCourseAttachmentHandler.ServeHTTP(w, r)
+		case CourseServiceListCourseCardsProcedure:
+			courseServiceListCourseCardsHandler.ServeHTTP(w, r)
+		case CourseServiceGetCourseCardDetailsProcedure:
+			courseServiceGetCourseCardDetailsHandler.ServeHTTP(w, r)
+		case CourseServiceHeartbeatCoursePresenceProcedure:
+			courseServiceHeartbeatCoursePresenceHandler.ServeHTTP(w, r)
+		case CourseServiceGetCoursePresenceProcedure:
+			courseServiceGetCoursePresenceHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -461,6 +967,10 @@ func (UnimplementedCourseServiceHandler) CreateFolder(context.Context, *connect.
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.CourseService.CreateFolder is not implemented"))
 }
 
+func (UnimplementedCourseServiceHandler) UpdateFolder(context.Context, *connect.Request[v1.UpdateFolderRequest]) (*connect.Response[v1.UpdateFolderResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.CourseService.UpdateFolder is not implemented"))
+}
+
 func (UnimplementedCourseServiceHandler) DeleteFolder(context.Context, *connect.Request[v1.DeleteFolderRequest]) (*connect.Response[v1.DeleteFolderResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.CourseService.DeleteFolder is not implemented"))
 }
@@ -480,3 +990,71 @@ func (UnimplementedCourseServiceHandler) DownloadExport(context.Context, *connec
 func (UnimplementedCourseServiceHandler) ListExports(context.Context, *connect.Request[v1.ListExportsRequest]) (*connect.Response[v1.ListExportsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.CourseService.ListExports is not implemented"))
 }
+
+func (UnimplementedCourseServiceHandler) ListCollaborators(context.Context, *connect.Request[v1.ListCollaboratorsRequest]) (*connect.Response[v1.ListCollaboratorsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.CourseService.ListCollaborators is not implemented"))
+}
+
+func (UnimplementedCourseServiceHandler) AddCollaborator(context.Context, *connect.Request[v1.AddCollaboratorRequest]) (*connect.Response[v1.AddCollaboratorResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.CourseService.AddCollaborator is not implemented"))
+}
+
+func (UnimplementedCourseServiceHandler) RemoveCollaborator(context.Context, *connect.Request[v1.RemoveCollaboratorRequest]) (*connect.Response[v1.RemoveCollaboratorResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.CourseService.RemoveCollaborator is not implemented"))
+}
+
+func (UnimplementedCourseServiceHandler) RemoveSampleContent(context.Context, *connect.Request[v1.RemoveSampleContentRequest]) (*connect.Response[v1.RemoveSampleContentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.CourseService.RemoveSampleContent is not implemented"))
+}
+
+func (UnimplementedCourseServiceHandler) ListLargestCourses(context.Context, *connect.Request[v1.ListLargestCoursesRequest]) (*connect.Response[v1.ListLargestCoursesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.CourseService.ListLargestCourses is not implemented"))
+}
+
+func (UnimplementedCourseServiceHandler) PublishChanges(context.Context, *connect.Request[v1.PublishChangesRequest]) (*connect.Response[v1.PublishChangesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.CourseService.PublishChanges is not implemented"))
+}
+
+func (UnimplementedCourseServiceHandler) DiscardDraft(context.Context, *connect.Request[v1.DiscardDraftRequest]) (*connect.Response[v1.DiscardDraftResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.CourseService.DiscardDraft is not implemented"))
+}
+
+func (UnimplementedCourseServiceHandler) SearchWithinCourse(context.Context, *connect.Request[v1.SearchWithinCourseRequest]) (*connect.Response[v1.SearchWithinCourseResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.CourseService.SearchWithinCourse is not implemented"))
+}
+
+func (UnimplementedCourseServiceHandler) GetCourseAttachmentUploadURL(context.Context, *connect.Request[v1.GetCourseAttachmentUploadURLRequest]) (*connect.Response[v1.GetCourseAttachmentUploadURLResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.CourseService.GetCourseAttachmentUploadURL is not implemented"))
+}
+
+func (UnimplementedCourseServiceHandler) ConfirmCourseAttachment(context.Context, *connect.Request[v1.ConfirmCourseAttachmentRequest]) (*connect.Response[v1.ConfirmCourseAttachmentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.CourseService.ConfirmCourseAttachment is not implemented"))
+}
+
+func (UnimplementedCourseServiceHandler) ListCourseAttachments(context.Context, *connect.Request[v1.ListCourseAttachmentsRequest]) (*connect.Response[v1.ListCourseAttachmentsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.CourseService.ListCourseAttachments is not implemented"))
+}
+
+func (UnimplementedCourseServiceHandler) SetCourseAttachmentExcluded(context.Context, *connect.Request[v1.SetCourseAttachmentExcludedRequest]) (*connect.Response[v1.SetCourseAttachmentExcludedResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.CourseService.SetCourseAttachmentExcluded is not implemented"))
+}
+
+func (UnimplementedCourseServiceHandler) DeleteCourseAttachment(context.Context, *connect.Request[v1.DeleteCourseAttachmentRequest]) (*connect.Response[v1.DeleteCourseAttachmentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.CourseService.DeleteCourseAttachment is not implemented"))
+}
+
+func (UnimplementedCourseServiceHandler) ListCourseCards(context.Context, *connect.Request[v1.ListCourseCardsRequest]) (*connect.Response[v1.ListCourseCardsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.CourseService.ListCourseCards is not implemented"))
+}
+
+func (UnimplementedCourseServiceHandler) GetCourseCardDetails(context.Context, *connect.Request[v1.GetCourseCardDetailsRequest]) (*connect.Response[v1.GetCourseCardDetailsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.CourseService.GetCourseCardDetails is not implemented"))
+}
+
+func (UnimplementedCourseServiceHandler) HeartbeatCoursePresence(context.Context, *connect.Request[v1.HeartbeatCoursePresenceRequest]) (*connect.Response[v1.HeartbeatCoursePresenceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.CourseService.HeartbeatCoursePresence is not implemented"))
+}
+
+func (UnimplementedCourseServiceHandler) GetCoursePresence(context.Context, *connect.Request[v1.GetCoursePresenceRequest]) (*connect.Response[v1.GetCoursePresenceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.CourseService.GetCoursePresence is not implemented"))
+}
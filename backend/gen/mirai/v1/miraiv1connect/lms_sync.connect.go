@@ -0,0 +1,363 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: mirai/v1/lms_sync.proto
+
+package miraiv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/sogos/mirai-backend/gen/mirai/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// LMSSyncServiceName is the fully-qualified name of the LMSSyncService service.
+	LMSSyncServiceName = "mirai.v1.LMSSyncService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// LMSSyncServiceCreateConnectorProcedure is the fully-qualified name of the LMSSyncService's
+	// CreateConnector RPC.
+	LMSSyncServiceCreateConnectorProcedure = "/mirai.v1.LMSSyncService/CreateConnector"
+	// LMSSyncServiceListConnectorsProcedure is the fully-qualified name of the LMSSyncService's
+	// ListConnectors RPC.
+	LMSSyncServiceListConnectorsProcedure = "/mirai.v1.LMSSyncService/ListConnectors"
+	// LMSSyncServiceUpdateConnectorProcedure is the fully-qualified name of the LMSSyncService's
+	// UpdateConnector RPC.
+	LMSSyncServiceUpdateConnectorProcedure = "/mirai.v1.LMSSyncService/UpdateConnector"
+	// LMSSyncServiceDeleteConnectorProcedure is the fully-qualified name of the LMSSyncService's
+	// DeleteConnector RPC.
+	LMSSyncServiceDeleteConnectorProcedure = "/mirai.v1.LMSSyncService/DeleteConnector"
+	// LMSSyncServiceGetFolderSyncProcedure is the fully-qualified name of the LMSSyncService's
+	// GetFolderSync RPC.
+	LMSSyncServiceGetFolderSyncProcedure = "/mirai.v1.LMSSyncService/GetFolderSync"
+	// LMSSyncServiceSetFolderSyncProcedure is the fully-qualified name of the LMSSyncService's
+	// SetFolderSync RPC.
+	LMSSyncServiceSetFolderSyncProcedure = "/mirai.v1.LMSSyncService/SetFolderSync"
+	// LMSSyncServiceGetSyncStatusProcedure is the fully-qualified name of the LMSSyncService's
+	// GetSyncStatus RPC.
+	LMSSyncServiceGetSyncStatusProcedure = "/mirai.v1.LMSSyncService/GetSyncStatus"
+	// LMSSyncServiceTestWebhookProcedure is the fully-qualified name of the LMSSyncService's
+	// TestWebhook RPC.
+	LMSSyncServiceTestWebhookProcedure = "/mirai.v1.LMSSyncService/TestWebhook"
+	// LMSSyncServiceReplayWebhookDeliveryProcedure is the fully-qualified name of the LMSSyncService's
+	// ReplayWebhookDelivery RPC.
+	LMSSyncServiceReplayWebhookDeliveryProcedure = "/mirai.v1.LMSSyncService/ReplayWebhookDelivery"
+)
+
+// LMSSyncServiceClient is a client for the mirai.v1.LMSSyncService service.
+type LMSSyncServiceClient interface {
+	// CreateConnector registers a connector. Admin only.
+	CreateConnector(context.Context, *connect.Request[v1.CreateConnectorRequest]) (*connect.Response[v1.CreateConnectorResponse], error)
+	// ListConnectors returns the organization's connectors. Admin only.
+	ListConnectors(context.Context, *connect.Request[v1.ListConnectorsRequest]) (*connect.Response[v1.ListConnectorsResponse], error)
+	// UpdateConnector changes a connector. Admin only.
+	UpdateConnector(context.Context, *connect.Request[v1.UpdateConnectorRequest]) (*connect.Response[v1.UpdateConnectorResponse], error)
+	// DeleteConnector removes a connector; folders using it stop syncing. Admin only.
+	DeleteConnector(context.Context, *connect.Request[v1.DeleteConnectorRequest]) (*connect.Response[v1.DeleteConnectorResponse], error)
+	// GetFolderSync returns the connector a folder's published courses sync to. Admin only.
+	GetFolderSync(context.Context, *connect.Request[v1.GetFolderSyncRequest]) (*connect.Response[v1.GetFolderSyncResponse], error)
+	// SetFolderSync makes courses published in a folder sync to a connector. Admin only.
+	SetFolderSync(context.Context, *connect.Request[v1.SetFolderSyncRequest]) (*connect.Response[v1.SetFolderSyncResponse], error)
+	// GetSyncStatus returns the latest delivery of a course to each connector.
+	GetSyncStatus(context.Context, *connect.Request[v1.GetSyncStatusRequest]) (*connect.Response[v1.GetSyncStatusResponse], error)
+	// TestWebhook sends a synthetic signed event to a webhook connector and returns how the
+	// receiver answered. Recorded as a test delivery. Admin only; rate limited.
+	TestWebhook(context.Context, *connect.Request[v1.TestWebhookRequest]) (*connect.Response[v1.TestWebhookResponse], error)
+	// ReplayWebhookDelivery re-sends an earlier delivery with a new timestamp and signature.
+	// Recorded as a replay delivery. Admin only; rate limited.
+	ReplayWebhookDelivery(context.Context, *connect.Request[v1.ReplayWebhookDeliveryRequest]) (*connect.Response[v1.ReplayWebhookDeliveryResponse], error)
+}
+
+// NewLMSSyncServiceClient constructs a client for the mirai.v1.LMSSyncService service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewLMSSyncServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) LMSSyncServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	lMSSyncServiceMethods := v1.File_mirai_v1_lms_sync_proto.Services().ByName("LMSSyncService").Methods()
+	return &lMSSyncServiceClient{
+		createConnector: connect.NewClient[v1.CreateConnectorRequest, v1.CreateConnectorResponse](
+			httpClient,
+			baseURL+LMSSyncServiceCreateConnectorProcedure,
+			connect.WithSchema(lMSSyncServiceMethods.ByName("CreateConnector")),
+			connect.WithClientOptions(opts...),
+		),
+		listConnectors: connect.NewClient[v1.ListConnectorsRequest, v1.ListConnectorsResponse](
+			httpClient,
+			baseURL+LMSSyncServiceListConnectorsProcedure,
+			connect.WithSchema(lMSSyncServiceMethods.ByName("ListConnectors")),
+			connect.WithClientOptions(opts...),
+		),
+		updateConnector: connect.NewClient[v1.UpdateConnectorRequest, v1.UpdateConnectorResponse](
+			httpClient,
+			baseURL+LMSSyncServiceUpdateConnectorProcedure,
+			connect.WithSchema(lMSSyncServiceMethods.ByName("UpdateConnector")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteConnector: connect.NewClient[v1.DeleteConnectorRequest, v1.DeleteConnectorResponse](
+			httpClient,
+			baseURL+LMSSyncServiceDeleteConnectorProcedure,
+			connect.WithSchema(lMSSyncServiceMethods.ByName("DeleteConnector")),
+			connect.WithClientOptions(opts...),
+		),
+		getFolderSync: connect.NewClient[v1.GetFolderSyncRequest, v1.GetFolderSyncResponse](
+			httpClient,
+			baseURL+LMSSyncServiceGetFolderSyncProcedure,
+			connect.WithSchema(lMSSyncServiceMethods.ByName("GetFolderSync")),
+			connect.WithClientOptions(opts...),
+		),
+		setFolderSync: connect.NewClient[v1.SetFolderSyncRequest, v1.SetFolderSyncResponse](
+			httpClient,
+			baseURL+LMSSyncServiceSetFolderSyncProcedure,
+			connect.WithSchema(lMSSyncServiceMethods.ByName("SetFolderSync")),
+			connect.WithClientOptions(opts...),
+		),
+		getSyncStatus: connect.NewClient[v1.GetSyncStatusRequest, v1.GetSyncStatusResponse](
+			httpClient,
+			baseURL+LMSSyncServiceGetSyncStatusProcedure,
+			connect.WithSchema(lMSSyncServiceMethods.ByName("GetSyncStatus")),
+			connect.WithClientOptions(opts...),
+		),
+		testWebhook: connect.NewClient[v1.TestWebhookRequest, v1.TestWebhookResponse](
+			httpClient,
+			baseURL+LMSSyncServiceTestWebhookProcedure,
+			connect.WithSchema(lMSSyncServiceMethods.ByName("TestWebhook")),
+			connect.WithClientOptions(opts...),
+		),
+		replayWebhookDelivery: connect.NewClient[v1.ReplayWebhookDeliveryRequest, v1.ReplayWebhookDeliveryResponse](
+			httpClient,
+			baseURL+LMSSyncServiceReplayWebhookDeliveryProcedure,
+			connect.WithSchema(lMSSyncServiceMethods.ByName("ReplayWebhookDelivery")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// lMSSyncServiceClient implements LMSSyncServiceClient.
+type lMSSyncServiceClient struct {
+	createConnector       *connect.Client[v1.CreateConnectorRequest, v1.CreateConnectorResponse]
+	listConnectors        *connect.Client[v1.ListConnectorsRequest, v1.ListConnectorsResponse]
+	updateConnector       *connect.Client[v1.UpdateConnectorRequest, v1.UpdateConnectorResponse]
+	deleteConnector       *connect.Client[v1.DeleteConnectorRequest, v1.DeleteConnectorResponse]
+	getFolderSync         *connect.Client[v1.GetFolderSyncRequest, v1.GetFolderSyncResponse]
+	setFolderSync         *connect.Client[v1.SetFolderSyncRequest, v1.SetFolderSyncResponse]
+	getSyncStatus         *connect.Client[v1.GetSyncStatusRequest, v1.GetSyncStatusResponse]
+	testWebhook           *connect.Client[v1.TestWebhookRequest, v1.TestWebhookResponse]
+	replayWebhookDelivery *connect.Client[v1.ReplayWebhookDeliveryRequest, v1.ReplayWebhookDeliveryResponse]
+}
+
+// CreateConnector calls mirai.v1.LMSSyncService.CreateConnector.
+func (c *lMSSyncServiceClient) CreateConnector(ctx context.Context, req *connect.Request[v1.CreateConnectorRequest]) (*connect.Response[v1.CreateConnectorResponse], error) {
+	return c.createConnector.CallUnary(ctx, req)
+}
+
+// ListConnectors calls mirai.v1.LMSSyncService.ListConnectors.
+func (c *lMSSyncServiceClient) ListConnectors(ctx context.Context, req *connect.Request[v1.ListConnectorsRequest]) (*connect.Response[v1.ListConnectorsResponse], error) {
+	return c.listConnectors.CallUnary(ctx, req)
+}
+
+// UpdateConnector calls mirai.v1.LMSSyncService.UpdateConnector.
+func (c *lMSSyncServiceClient) UpdateConnector(ctx context.Context, req *connect.Request[v1.UpdateConnectorRequest]) (*connect.Response[v1.UpdateConnectorResponse], error) {
+	return c.updateConnector.CallUnary(ctx, req)
+}
+
+// DeleteConnector calls mirai.v1.LMSSyncService.DeleteConnector.
+func (c *lMSSyncServiceClient) DeleteConnector(ctx context.Context, req *connect.Request[v1.DeleteConnectorRequest]) (*connect.Response[v1.DeleteConnectorResponse], error) {
+	return c.deleteConnector.CallUnary(ctx, req)
+}
+
+// GetFolderSync calls mirai.v1.LMSSyncService.GetFolderSync.
+func (c *lMSSyncServiceClient) GetFolderSync(ctx context.Context, req *connect.Request[v1.GetFolderSyncRequest]) (*connect.Response[v1.GetFolderSyncResponse], error) {
+	return c.getFolderSync.CallUnary(ctx, req)
+}
+
+// SetFolderSync calls mirai.v1.LMSSyncService.SetFolderSync.
+func (c *lMSSyncServiceClient) SetFolderSync(ctx context.Context, req *connect.Request[v1.SetFolderSyncRequest]) (*connect.Response[v1.SetFolderSyncResponse], error) {
+	return c.setFolderSync.CallUnary(ctx, req)
+}
+
+// GetSyncStatus calls mirai.v1.LMSSyncService.GetSyncStatus.
+func (c *lMSSyncServiceClient) GetSyncStatus(ctx context.Context, req *connect.Request[v1.GetSyncStatusRequest]) (*connect.Response[v1.GetSyncStatusResponse], error) {
+	return c.getSyncStatus.CallUnary(ctx, req)
+}
+
+// TestWebhook calls mirai.v1.LMSSyncService.TestWebhook.
+func (c *lMSSyncServiceClient) TestWebhook(ctx context.Context, req *connect.Request[v1.TestWebhookRequest]) (*connect.Response[v1.TestWebhookResponse], error) {
+	return c.testWebhook.CallUnary(ctx, req)
+}
+
+// ReplayWebhookDelivery calls mirai.v1.LMSSyncService.ReplayWebhookDelivery.
+func (c *lMSSyncServiceClient) ReplayWebhookDelivery(ctx context.Context, req *connect.Request[v1.ReplayWebhookDeliveryRequest]) (*connect.Response[v1.ReplayWebhookDeliveryResponse], error) {
+	return c.replayWebhookDelivery.CallUnary(ctx, req)
+}
+
+// LMSSyncServiceHandler is an implementation of the mirai.v1.LMSSyncService service.
+type LMSSyncServiceHandler interface {
+	// CreateConnector registers a connector. Admin only.
+	CreateConnector(context.Context, *connect.Request[v1.CreateConnectorRequest]) (*connect.Response[v1.CreateConnectorResponse], error)
+	// ListConnectors returns the organization's connectors. Admin only.
+	ListConnectors(context.Context, *connect.Request[v1.ListConnectorsRequest]) (*connect.Response[v1.ListConnectorsResponse], error)
+	// UpdateConnector changes a connector. Admin only.
+	UpdateConnector(context.Context, *connect.Request[v1.UpdateConnectorRequest]) (*connect.Response[v1.UpdateConnectorResponse], error)
+	// DeleteConnector removes a connector; folders using it stop syncing. Admin only.
+	DeleteConnector(context.Context, *connect.Request[v1.DeleteConnectorRequest]) (*connect.Response[v1.DeleteConnectorResponse], error)
+	// GetFolderSync returns the connector a folder's published courses sync to. Admin only.
+	GetFolderSync(context.Context, *connect.Request[v1.GetFolderSyncRequest]) (*connect.Response[v1.GetFolderSyncResponse], error)
+	// SetFolderSync makes courses published in a folder sync to a connector. Admin only.
+	SetFolderSync(context.Context, *connect.Request[v1.SetFolderSyncRequest]) (*connect.Response[v1.SetFolderSyncResponse], error)
+	// GetSyncStatus returns the latest delivery of a course to each connector.
+	GetSyncStatus(context.Context, *connect.Request[v1.GetSyncStatusRequest]) (*connect.Response[v1.GetSyncStatusResponse], error)
+	// TestWebhook sends a synthetic signed event to a webhook connector and returns how the
+	// receiver answered. Recorded as a test delivery. Admin only; rate limited.
+	TestWebhook(context.Context, *connect.Request[v1.TestWebhookRequest]) (*connect.Response[v1.TestWebhookResponse], error)
+	// ReplayWebhookDelivery re-sends an earlier delivery with a new timestamp and signature.
+	// Recorded as a replay delivery. Admin only; rate limited.
+	ReplayWebhookDelivery(context.Context, *connect.Request[v1.ReplayWebhookDeliveryRequest]) (*connect.Response[v1.ReplayWebhookDeliveryResponse], error)
+}
+
+// NewLMSSyncServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewLMSSyncServiceHandler(svc LMSSyncServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	lMSSyncServiceMethods := v1.File_mirai_v1_lms_sync_proto.Services().ByName("LMSSyncService").Methods()
+	lMSSyncServiceCreateConnectorHandler := connect.NewUnaryHandler(
+		LMSSyncServiceCreateConnectorProcedure,
+		svc.CreateConnector,
+		connect.WithSchema(lMSSyncServiceMethods.ByName("CreateConnector")),
+		connect.WithHandlerOptions(opts...),
+	)
+	lMSSyncServiceListConnectorsHandler := connect.NewUnaryHandler(
+		LMSSyncServiceListConnectorsProcedure,
+		svc.ListConnectors,
+		connect.WithSchema(lMSSyncServiceMethods.ByName("ListConnectors")),
+		connect.WithHandlerOptions(opts...),
+	)
+	lMSSyncServiceUpdateConnectorHandler := connect.NewUnaryHandler(
+		LMSSyncServiceUpdateConnectorProcedure,
+		svc.UpdateConnector,
+		connect.WithSchema(lMSSyncServiceMethods.ByName("UpdateConnector")),
+		connect.WithHandlerOptions(opts...),
+	)
+	lMSSyncServiceDeleteConnectorHandler := connect.NewUnaryHandler(
+		LMSSyncServiceDeleteConnectorProcedure,
+		svc.DeleteConnector,
+		connect.WithSchema(lMSSyncServiceMethods.ByName("DeleteConnector")),
+		connect.WithHandlerOptions(opts...),
+	)
+	lMSSyncServiceGetFolderSyncHandler := connect.NewUnaryHandler(
+		LMSSyncServiceGetFolderSyncProcedure,
+		svc.GetFolderSync,
+		connect.WithSchema(lMSSyncServiceMethods.ByName("GetFolderSync")),
+		connect.WithHandlerOptions(opts...),
+	)
+	lMSSyncServiceSetFolderSyncHandler := connect.NewUnaryHandler(
+		LMSSyncServiceSetFolderSyncProcedure,
+		svc.SetFolderSync,
+		connect.WithSchema(lMSSyncServiceMethods.ByName("SetFolderSync")),
+		connect.WithHandlerOptions(opts...),
+	)
+	lMSSyncServiceGetSyncStatusHandler := connect.NewUnaryHandler(
+		LMSSyncServiceGetSyncStatusProcedure,
+		svc.GetSyncStatus,
+		connect.WithSchema(lMSSyncServiceMethods.ByName("GetSyncStatus")),
+		connect.WithHandlerOptions(opts...),
+	)
+	lMSSyncServiceTestWebhookHandler := connect.NewUnaryHandler(
+		LMSSyncServiceTestWebhookProcedure,
+		svc.TestWebhook,
+		connect.WithSchema(lMSSyncServiceMethods.ByName("TestWebhook")),
+		connect.WithHandlerOptions(opts...),
+	)
+	lMSSyncServiceReplayWebhookDeliveryHandler := connect.NewUnaryHandler(
+		LMSSyncServiceReplayWebhookDeliveryProcedure,
+		svc.ReplayWebhookDelivery,
+		connect.WithSchema(lMSSyncServiceMethods.ByName("ReplayWebhookDelivery")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/mirai.v1.LMSSyncService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case LMSSyncServiceCreateConnectorProcedure:
+			lMSSyncServiceCreateConnectorHandler.ServeHTTP(w, r)
+		case LMSSyncServiceListConnectorsProcedure:
+			lMSSyncServiceListConnectorsHandler.ServeHTTP(w, r)
+		case LMSSyncServiceUpdateConnectorProcedure:
+			lMSSyncServiceUpdateConnectorHandler.ServeHTTP(w, r)
+		case LMSSyncServiceDeleteConnectorProcedure:
+			lMSSyncServiceDeleteConnectorHandler.ServeHTTP(w, r)
+		case LMSSyncServiceGetFolderSyncProcedure:
+			lMSSyncServiceGetFolderSyncHandler.ServeHTTP(w, r)
+		case LMSSyncServiceSetFolderSyncProcedure:
+			lMSSyncServiceSetFolderSyncHandler.ServeHTTP(w, r)
+		case LMSSyncServiceGetSyncStatusProcedure:
+			lMSSyncServiceGetSyncStatusHandler.ServeHTTP(w, r)
+		case LMSSyncServiceTestWebhookProcedure:
+			lMSSyncServiceTestWebhookHandler.ServeHTTP(w, r)
+		case LMSSyncServiceReplayWebhookDeliveryProcedure:
+			lMSSyncServiceReplayWebhookDeliveryHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedLMSSyncServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedLMSSyncServiceHandler struct{}
+
+func (UnimplementedLMSSyncServiceHandler) CreateConnector(context.Context, *connect.Request[v1.CreateConnectorRequest]) (*connect.Response[v1.CreateConnectorResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.LMSSyncService.CreateConnector is not implemented"))
+}
+
+func (UnimplementedLMSSyncServiceHandler) ListConnectors(context.Context, *connect.Request[v1.ListConnectorsRequest]) (*connect.Response[v1.ListConnectorsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.LMSSyncService.ListConnectors is not implemented"))
+}
+
+func (UnimplementedLMSSyncServiceHandler) UpdateConnector(context.Context, *connect.Request[v1.UpdateConnectorRequest]) (*connect.Response[v1.UpdateConnectorResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.LMSSyncService.UpdateConnector is not implemented"))
+}
+
+func (UnimplementedLMSSyncServiceHandler) DeleteConnector(context.Context, *connect.Request[v1.DeleteConnectorRequest]) (*connect.Response[v1.DeleteConnectorResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.LMSSyncService.DeleteConnector is not implemented"))
+}
+
+func (UnimplementedLMSSyncServiceHandler) GetFolderSync(context.Context, *connect.Request[v1.GetFolderSyncRequest]) (*connect.Response[v1.GetFolderSyncResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.LMSSyncService.GetFolderSync is not implemented"))
+}
+
+func (UnimplementedLMSSyncServiceHandler) SetFolderSync(context.Context, *connect.Request[v1.SetFolderSyncRequest]) (*connect.Response[v1.SetFolderSyncResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.LMSSyncService.SetFolderSync is not implemented"))
+}
+
+func (UnimplementedLMSSyncServiceHandler) GetSyncStatus(context.Context, *connect.Request[v1.GetSyncStatusRequest]) (*connect.Response[v1.GetSyncStatusResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.LMSSyncService.GetSyncStatus is not implemented"))
+}
+
+func (UnimplementedLMSSyncServiceHandler) TestWebhook(context.Context, *connect.Request[v1.TestWebhookRequest]) (*connect.Response[v1.TestWebhookResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.LMSSyncService.TestWebhook is not implemented"))
+}
+
+func (UnimplementedLMSSyncServiceHandler) ReplayWebhookDelivery(context.Context, *connect.Request[v1.ReplayWebhookDeliveryRequest]) (*connect.Response[v1.ReplayWebhookDeliveryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.LMSSyncService.ReplayWebhookDelivery is not implemented"))
+}
@@ -0,0 +1,377 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: mirai/v1/maintenance.proto
+
+package miraiv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/sogos/mirai-backend/gen/mirai/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// MaintenanceServiceName is the fully-qualified name of the MaintenanceService service.
+	MaintenanceServiceName = "mirai.v1.MaintenanceService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// MaintenanceServiceGetMaintenanceModeProcedure is the fully-qualified name of the
+	// MaintenanceService's GetMaintenanceMode RPC.
+	MaintenanceServiceGetMaintenanceModeProcedure = "/mirai.v1.MaintenanceService/GetMaintenanceMode"
+	// MaintenanceServiceSetMaintenanceModeProcedure is the fully-qualified name of the
+	// MaintenanceService's SetMaintenanceMode RPC.
+	MaintenanceServiceSetMaintenanceModeProcedure = "/mirai.v1.MaintenanceService/SetMaintenanceMode"
+	// MaintenanceServiceWarmTenantCacheProcedure is the fully-qualified name of the
+	// MaintenanceService's WarmTenantCache RPC.
+	MaintenanceServiceWarmTenantCacheProcedure = "/mirai.v1.MaintenanceService/WarmTenantCache"
+	// MaintenanceServiceInvalidateTenantCacheProcedure is the fully-qualified name of the
+	// MaintenanceService's InvalidateTenantCache RPC.
+	MaintenanceServiceInvalidateTenantCacheProcedure = "/mirai.v1.MaintenanceService/InvalidateTenantCache"
+	// MaintenanceServiceGetSystemDiagnosticsProcedure is the fully-qualified name of the
+	// MaintenanceService's GetSystemDiagnostics RPC.
+	MaintenanceServiceGetSystemDiagnosticsProcedure = "/mirai.v1.MaintenanceService/GetSystemDiagnostics"
+	// MaintenanceServiceBackfillKnowledgeSummariesProcedure is the fully-qualified name of the
+	// MaintenanceService's BackfillKnowledgeSummaries RPC.
+	MaintenanceServiceBackfillKnowledgeSummariesProcedure = "/mirai.v1.MaintenanceService/BackfillKnowledgeSummaries"
+	// MaintenanceServiceRotateTenantStorageKeyProcedure is the fully-qualified name of the
+	// MaintenanceService's RotateTenantStorageKey RPC.
+	MaintenanceServiceRotateTenantStorageKeyProcedure = "/mirai.v1.MaintenanceService/RotateTenantStorageKey"
+	// MaintenanceServiceEncryptTenantStorageProcedure is the fully-qualified name of the
+	// MaintenanceService's EncryptTenantStorage RPC.
+	MaintenanceServiceEncryptTenantStorageProcedure = "/mirai.v1.MaintenanceService/EncryptTenantStorage"
+	// MaintenanceServiceMigrateTenantStorageRegionProcedure is the fully-qualified name of the
+	// MaintenanceService's MigrateTenantStorageRegion RPC.
+	MaintenanceServiceMigrateTenantStorageRegionProcedure = "/mirai.v1.MaintenanceService/MigrateTenantStorageRegion"
+)
+
+// MaintenanceServiceClient is a client for the mirai.v1.MaintenanceService service.
+type MaintenanceServiceClient interface {
+	// GetMaintenanceMode returns the current maintenance flag.
+	GetMaintenanceMode(context.Context, *connect.Request[v1.GetMaintenanceModeRequest]) (*connect.Response[v1.GetMaintenanceModeResponse], error)
+	// SetMaintenanceMode enables or disables maintenance mode.
+	SetMaintenanceMode(context.Context, *connect.Request[v1.SetMaintenanceModeRequest]) (*connect.Response[v1.SetMaintenanceModeResponse], error)
+	// WarmTenantCache rebuilds a tenant's cached library listing, folder hierarchy with
+	// counts and most recently modified course metadata.
+	WarmTenantCache(context.Context, *connect.Request[v1.WarmTenantCacheRequest]) (*connect.Response[v1.WarmTenantCacheResponse], error)
+	// InvalidateTenantCache drops a tenant's cached entries.
+	InvalidateTenantCache(context.Context, *connect.Request[v1.InvalidateTenantCacheRequest]) (*connect.Response[v1.InvalidateTenantCacheResponse], error)
+	// GetSystemDiagnostics reports the state of the worker, queues, scheduled tasks and
+	// dependencies. Each check has a short timeout; failures are reported, not returned.
+	GetSystemDiagnostics(context.Context, *connect.Request[v1.GetSystemDiagnosticsRequest]) (*connect.Response[v1.GetSystemDiagnosticsResponse], error)
+	// BackfillKnowledgeSummaries queues re-summarization of every SME, in all tenants, whose
+	// knowledge summary is over the length cap. The counts are emailed to the operators.
+	BackfillKnowledgeSummaries(context.Context, *connect.Request[v1.BackfillKnowledgeSummariesRequest]) (*connect.Response[v1.BackfillKnowledgeSummariesResponse], error)
+	// RotateTenantStorageKey gives a tenant a new key for encrypting its course content and
+	// queues re-encryption of the content with it. Content written with older keys stays
+	// readable meanwhile. Requires STORAGE_ENCRYPTION_ENABLED.
+	RotateTenantStorageKey(context.Context, *connect.Request[v1.RotateTenantStorageKeyRequest]) (*connect.Response[v1.RotateTenantStorageKeyResponse], error)
+	// EncryptTenantStorage queues re-encryption of a tenant's course content, or every
+	// tenant's, rewriting plaintext objects and objects encrypted with a retired key.
+	// Requires STORAGE_ENCRYPTION_ENABLED.
+	EncryptTenantStorage(context.Context, *connect.Request[v1.EncryptTenantStorageRequest]) (*connect.Response[v1.EncryptTenantStorageResponse], error)
+	// MigrateTenantStorageRegion queues moving a tenant's stored objects to another storage
+	// region. The tenant switches over in one step once every object is copied, and its
+	// objects are then removed from the old region.
+	MigrateTenantStorageRegion(context.Context, *connect.Request[v1.MigrateTenantStorageRegionRequest]) (*connect.Response[v1.MigrateTenantStorageRegionResponse], error)
+}
+
+// NewMaintenanceServiceClient constructs a client for the mirai.v1.MaintenanceService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewMaintenanceServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) MaintenanceServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	maintenanceServiceMethods := v1.File_mirai_v1_maintenance_proto.Services().ByName("MaintenanceService").Methods()
+	return &maintenanceServiceClient{
+		getMaintenanceMode: connect.NewClient[v1.GetMaintenanceModeRequest, v1.GetMaintenanceModeResponse](
+			httpClient,
+			baseURL+MaintenanceServiceGetMaintenanceModeProcedure,
+			connect.WithSchema(maintenanceServiceMethods.ByName("GetMaintenanceMode")),
+			connect.WithClientOptions(opts...),
+		),
+		setMaintenanceMode: connect.NewClient[v1.SetMaintenanceModeRequest, v1.SetMaintenanceModeResponse](
+			httpClient,
+			baseURL+MaintenanceServiceSetMaintenanceModeProcedure,
+			connect.WithSchema(maintenanceServiceMethods.ByName("SetMaintenanceMode")),
+			connect.WithClientOptions(opts...),
+		),
+		warmTenantCache: connect.NewClient[v1.WarmTenantCacheRequest, v1.WarmTenantCacheResponse](
+			httpClient,
+			baseURL+MaintenanceServiceWarmTenantCacheProcedure,
+			connect.WithSchema(maintenanceServiceMethods.ByName("WarmTenantCache")),
+			connect.WithClientOptions(opts...),
+		),
+		invalidateTenantCache: connect.NewClient[v1.InvalidateTenantCacheRequest, v1.InvalidateTenantCacheResponse](
+			httpClient,
+			baseURL+MaintenanceServiceInvalidateTenantCacheProcedure,
+			connect.WithSchema(maintenanceServiceMethods.ByName("InvalidateTenantCache")),
+			connect.WithClientOptions(opts...),
+		),
+		getSystemDiagnostics: connect.NewClient[v1.GetSystemDiagnosticsRequest, v1.GetSystemDiagnosticsResponse](
+			httpClient,
+			baseURL+MaintenanceServiceGetSystemDiagnosticsProcedure,
+			connect.WithSchema(maintenanceServiceMethods.ByName("GetSystemDiagnostics")),
+			connect.WithClientOptions(opts...),
+		),
+		backfillKnowledgeSummaries: connect.NewClient[v1.BackfillKnowledgeSummariesRequest, v1.BackfillKnowledgeSummariesResponse](
+			httpClient,
+			baseURL+MaintenanceServiceBackfillKnowledgeSummariesProcedure,
+			connect.WithSchema(maintenanceServiceMethods.ByName("BackfillKnowledgeSummaries")),
+			connect.WithClientOptions(opts...),
+		),
+		rotateTenantStorageKey: connect.NewClient[v1.RotateTenantStorageKeyRequest, v1.RotateTenantStorageKeyResponse](
+			httpClient,
+			baseURL+MaintenanceServiceRotateTenantStorageKeyProcedure,
+			connect.WithSchema(maintenanceServiceMethods.ByName("RotateTenantStorageKey")),
+			connect.WithClientOptions(opts...),
+		),
+		encryptTenantStorage: connect.NewClient[v1.EncryptTenantStorageRequest, v1.EncryptTenantStorageResponse](
+			httpClient,
+			baseURL+MaintenanceServiceEncryptTenantStorageProcedure,
+			connect.WithSchema(maintenanceServiceMethods.ByName("EncryptTenantStorage")),
+			connect.WithClientOptions(opts...),
+		),
+		migrateTenantStorageRegion: connect.NewClient[v1.MigrateTenantStorageRegionRequest, v1.MigrateTenantStorageRegionResponse](
+			httpClient,
+			baseURL+MaintenanceServiceMigrateTenantStorageRegionProcedure,
+			connect.WithSchema(maintenanceServiceMethods.ByName("MigrateTenantStorageRegion")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// maintenanceServiceClient implements MaintenanceServiceClient.
+type maintenanceServiceClient struct {
+	getMaintenanceMode         *connect.Client[v1.GetMaintenanceModeRequest, v1.GetMaintenanceModeResponse]
+	setMaintenanceMode         *connect.Client[v1.SetMaintenanceModeRequest, v1.SetMaintenanceModeResponse]
+	warmTenantCache            *connect.Client[v1.WarmTenantCacheRequest, v1.WarmTenantCacheResponse]
+	invalidateTenantCache      *connect.Client[v1.InvalidateTenantCacheRequest, v1.InvalidateTenantCacheResponse]
+	getSystemDiagnostics       *connect.Client[v1.GetSystemDiagnosticsRequest, v1.GetSystemDiagnosticsResponse]
+	backfillKnowledgeSummaries *connect.Client[v1.BackfillKnowledgeSummariesRequest, v1.BackfillKnowledgeSummariesResponse]
+	rotateTenantStorageKey     *connect.Client[v1.RotateTenantStorageKeyRequest, v1.RotateTenantStorageKeyResponse]
+	encryptTenantStorage       *connect.Client[v1.EncryptTenantStorageRequest, v1.EncryptTenantStorageResponse]
+	migrateTenantStorageRegion *connect.Client[v1.MigrateTenantStorageRegionRequest, v1.MigrateTenantStorageRegionResponse]
+}
+
+// GetMaintenanceMode calls mirai.v1.MaintenanceService.GetMaintenanceMode.
+func (c *maintenanceServiceClient) GetMaintenanceMode(ctx context.Context, req *connect.Request[v1.GetMaintenanceModeRequest]) (*connect.Response[v1.GetMaintenanceModeResponse], error) {
+	return c.getMaintenanceMode.CallUnary(ctx, req)
+}
+
+// SetMaintenanceMode calls mirai.v1.MaintenanceService.SetMaintenanceMode.
+func (c *maintenanceServiceClient) SetMaintenanceMode(ctx context.Context, req *connect.Request[v1.SetMaintenanceModeRequest]) (*connect.Response[v1.SetMaintenanceModeResponse], error) {
+	return c.setMaintenanceMode.CallUnary(ctx, req)
+}
+
+// WarmTenantCache calls mirai.v1.MaintenanceService.WarmTenantCache.
+func (c *maintenanceServiceClient) WarmTenantCache(ctx context.Context, req *connect.Request[v1.WarmTenantCacheRequest]) (*connect.Response[v1.WarmTenantCacheResponse], error) {
+	return c.warmTenantCache.CallUnary(ctx, req)
+}
+
+// InvalidateTenantCache calls mirai.v1.MaintenanceService.InvalidateTenantCache.
+func (c *maintenanceServiceClient) InvalidateTenantCache(ctx context.Context, req *connect.Request[v1.InvalidateTenantCacheRequest]) (*connect.Response[v1.InvalidateTenantCacheResponse], error) {
+	return c.invalidateTenantCache.CallUnary(ctx, req)
+}
+
+// GetSystemDiagnostics calls mirai.v1.MaintenanceService.GetSystemDiagnostics.
+func (c *maintenanceServiceClient) GetSystemDiagnostics(ctx context.Context, req *connect.Request[v1.GetSystemDiagnosticsRequest]) (*connect.Response[v1.GetSystemDiagnosticsResponse], error) {
+	return c.getSystemDiagnostics.CallUnary(ctx, req)
+}
+
+// BackfillKnowledgeSummaries calls mirai.v1.MaintenanceService.BackfillKnowledgeSummaries.
+func (c *maintenanceServiceClient) BackfillKnowledgeSummaries(ctx context.Context, req *connect.Request[v1.BackfillKnowledgeSummariesRequest]) (*connect.Response[v1.BackfillKnowledgeSummariesResponse], error) {
+	return c.backfillKnowledgeSummaries.CallUnary(ctx, req)
+}
+
+// RotateTenantStorageKey calls mirai.v1.MaintenanceService.RotateTenantStorageKey.
+func (c *maintenanceServiceClient) RotateTenantStorageKey(ctx context.Context, req *connect.Request[v1.RotateTenantStorageKeyRequest]) (*connect.Response[v1.RotateTenantStorageKeyResponse], error) {
+	return c.rotateTenantStorageKey.CallUnary(ctx, req)
+}
+
+// EncryptTenantStorage calls mirai.v1.MaintenanceService.EncryptTenantStorage.
+func (c *maintenanceServiceClient) EncryptTenantStorage(ctx context.Context, req *connect.Request[v1.EncryptTenantStorageRequest]) (*connect.Response[v1.EncryptTenantStorageResponse], error) {
+	return c.encryptTenantStorage.CallUnary(ctx, req)
+}
+
+// MigrateTenantStorageRegion calls mirai.v1.MaintenanceService.MigrateTenantStorageRegion.
+func (c *maintenanceServiceClient) MigrateTenantStorageRegion(ctx context.Context, req *connect.Request[v1.MigrateTenantStorageRegionRequest]) (*connect.Response[v1.MigrateTenantStorageRegionResponse], error) {
+	return c.migrateTenantStorageRegion.CallUnary(ctx, req)
+}
+
+// MaintenanceServiceHandler is an implementation of the mirai.v1.MaintenanceService service.
+type MaintenanceServiceHandler interface {
+	// GetMaintenanceMode returns the current maintenance flag.
+	GetMaintenanceMode(context.Context, *connect.Request[v1.GetMaintenanceModeRequest]) (*connect.Response[v1.GetMaintenanceModeResponse], error)
+	// SetMaintenanceMode enables or disables maintenance mode.
+	SetMaintenanceMode(context.Context, *connect.Request[v1.SetMaintenanceModeRequest]) (*connect.Response[v1.SetMaintenanceModeResponse], error)
+	// WarmTenantCache rebuilds a tenant's cached library listing, folder hierarchy with
+	// counts and most recently modified course metadata.
+	WarmTenantCache(context.Context, *connect.Request[v1.WarmTenantCacheRequest]) (*connect.Response[v1.WarmTenantCacheResponse], error)
+	// InvalidateTenantCache drops a tenant's cached entries.
+	InvalidateTenantCache(context.Context, *connect.Request[v1.InvalidateTenantCacheRequest]) (*connect.Response[v1.InvalidateTenantCacheResponse], error)
+	// GetSystemDiagnostics reports the state of the worker, queues, scheduled tasks and
+	// dependencies. Each check has a short timeout; failures are reported, not returned.
+	GetSystemDiagnostics(context.Context, *connect.Request[v1.GetSystemDiagnosticsRequest]) (*connect.Response[v1.GetSystemDiagnosticsResponse], error)
+	// BackfillKnowledgeSummaries queues re-summarization of every SME, in all tenants, whose
+	// knowledge summary is over the length cap. The counts are emailed to the operators.
+	BackfillKnowledgeSummaries(context.Context, *connect.Request[v1.BackfillKnowledgeSummariesRequest]) (*connect.Response[v1.BackfillKnowledgeSummariesResponse], error)
+	// RotateTenantStorageKey gives a tenant a new key for encrypting its course content and
+	// queues re-encryption of the content with it. Content written with older keys stays
+	// readable meanwhile. Requires STORAGE_ENCRYPTION_ENABLED.
+	RotateTenantStorageKey(context.Context, *connect.Request[v1.RotateTenantStorageKeyRequest]) (*connect.Response[v1.RotateTenantStorageKeyResponse], error)
+	// EncryptTenantStorage queues re-encryption of a tenant's course content, or every
+	// tenant's, rewriting plaintext objects and objects encrypted with a retired key.
+	// Requires STORAGE_ENCRYPTION_ENABLED.
+	EncryptTenantStorage(context.Context, *connect.Request[v1.EncryptTenantStorageRequest]) (*connect.Response[v1.EncryptTenantStorageResponse], error)
+	// MigrateTenantStorageRegion queues moving a tenant's stored objects to another storage
+	// region. The tenant switches over in one step once every object is copied, and its
+	// objects are then removed from the old region.
+	MigrateTenantStorageRegion(context.Context, *connect.Request[v1.MigrateTenantStorageRegionRequest]) (*connect.Response[v1.MigrateTenantStorageRegionResponse], error)
+}
+
+// NewMaintenanceServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewMaintenanceServiceHandler(svc MaintenanceServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	maintenanceServiceMethods := v1.File_mirai_v1_maintenance_proto.Services().ByName("MaintenanceService").Methods()
+	maintenanceServiceGetMaintenanceModeHandler := connect.NewUnaryHandler(
+		MaintenanceServiceGetMaintenanceModeProcedure,
+		svc.GetMaintenanceMode,
+		connect.WithSchema(maintenanceServiceMethods.ByName("GetMaintenanceMode")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maintenanceServiceSetMaintenanceModeHandler := connect.NewUnaryHandler(
+		MaintenanceServiceSetMaintenanceModeProcedure,
+		svc.SetMaintenanceMode,
+		connect.WithSchema(maintenanceServiceMethods.ByName("SetMaintenanceMode")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maintenanceServiceWarmTenantCacheHandler := connect.NewUnaryHandler(
+		MaintenanceServiceWarmTenantCacheProcedure,
+		svc.WarmTenantCache,
+		connect.WithSchema(maintenanceServiceMethods.ByName("WarmTenantCache")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maintenanceServiceInvalidateTenantCacheHandler := connect.NewUnaryHandler(
+		MaintenanceServiceInvalidateTenantCacheProcedure,
+		svc.InvalidateTenantCache,
+		connect.WithSchema(maintenanceServiceMethods.ByName("InvalidateTenantCache")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maintenanceServiceGetSystemDiagnosticsHandler := connect.NewUnaryHandler(
+		MaintenanceServiceGetSystemDiagnosticsProcedure,
+		svc.GetSystemDiagnostics,
+		connect.WithSchema(maintenanceServiceMethods.ByName("GetSystemDiagnostics")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maintenanceServiceBackfillKnowledgeSummariesHandler := connect.NewUnaryHandler(
+		MaintenanceServiceBackfillKnowledgeSummariesProcedure,
+		svc.BackfillKnowledgeSummaries,
+		connect.WithSchema(maintenanceServiceMethods.ByName("BackfillKnowledgeSummaries")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maintenanceServiceRotateTenantStorageKeyHandler := connect.NewUnaryHandler(
+		MaintenanceServiceRotateTenantStorageKeyProcedure,
+		svc.RotateTenantStorageKey,
+		connect.WithSchema(maintenanceServiceMethods.ByName("RotateTenantStorageKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maintenanceServiceEncryptTenantStorageHandler := connect.NewUnaryHandler(
+		MaintenanceServiceEncryptTenantStorageProcedure,
+		svc.EncryptTenantStorage,
+		connect.WithSchema(maintenanceServiceMethods.ByName("EncryptTenantStorage")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maintenanceServiceMigrateTenantStorageRegionHandler := connect.NewUnaryHandler(
+		MaintenanceServiceMigrateTenantStorageRegionProcedure,
+		svc.MigrateTenantStorageRegion,
+		connect.WithSchema(maintenanceServiceMethods.ByName("MigrateTenantStorageRegion")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/mirai.v1.MaintenanceService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case MaintenanceServiceGetMaintenanceModeProcedure:
+			maintenanceServiceGetMaintenanceModeHandler.ServeHTTP(w, r)
+		case MaintenanceServiceSetMaintenanceModeProcedure:
+			maintenanceServiceSetMaintenanceModeHandler.ServeHTTP(w, r)
+		case MaintenanceServiceWarmTenantCacheProcedure:
+			maintenanceServiceWarmTenantCacheHandler.ServeHTTP(w, r)
+		case MaintenanceServiceInvalidateTenantCacheProcedure:
+			maintenanceServiceInvalidateTenantCacheHandler.ServeHTTP(w, r)
+		case MaintenanceServiceGetSystemDiagnosticsProcedure:
+			maintenanceServiceGetSystemDiagnosticsHandler.ServeHTTP(w, r)
+		case MaintenanceServiceBackfillKnowledgeSummariesProcedure:
+			maintenanceServiceBackfillKnowledgeSummariesHandler.ServeHTTP(w, r)
+		case MaintenanceServiceRotateTenantStorageKeyProcedure:
+			maintenanceServiceRotateTenantStorageKeyHandler.ServeHTTP(w, r)
+		case MaintenanceServiceEncryptTenantStorageProcedure:
+			maintenanceServiceEncryptTenantStorageHandler.ServeHTTP(w, r)
+		case MaintenanceServiceMigrateTenantStorageRegionProcedure:
+			maintenanceServiceMigrateTenantStorageRegionHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedMaintenanceServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedMaintenanceServiceHandler struct{}
+
+func (UnimplementedMaintenanceServiceHandler) GetMaintenanceMode(context.Context, *connect.Request[v1.GetMaintenanceModeRequest]) (*connect.Response[v1.GetMaintenanceModeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.MaintenanceService.GetMaintenanceMode is not implemented"))
+}
+
+func (UnimplementedMaintenanceServiceHandler) SetMaintenanceMode(context.Context, *connect.Request[v1.SetMaintenanceModeRequest]) (*connect.Response[v1.SetMaintenanceModeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.MaintenanceService.SetMaintenanceMode is not implemented"))
+}
+
+func (UnimplementedMaintenanceServiceHandler) WarmTenantCache(context.Context, *connect.Request[v1.WarmTenantCacheRequest]) (*connect.Response[v1.WarmTenantCacheResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.MaintenanceService.WarmTenantCache is not implemented"))
+}
+
+func (UnimplementedMaintenanceServiceHandler) InvalidateTenantCache(context.Context, *connect.Request[v1.InvalidateTenantCacheRequest]) (*connect.Response[v1.InvalidateTenantCacheResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.MaintenanceService.InvalidateTenantCache is not implemented"))
+}
+
+func (UnimplementedMaintenanceServiceHandler) GetSystemDiagnostics(context.Context, *connect.Request[v1.GetSystemDiagnosticsRequest]) (*connect.Response[v1.GetSystemDiagnosticsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.MaintenanceService.GetSystemDiagnostics is not implemented"))
+}
+
+func (UnimplementedMaintenanceServiceHandler) BackfillKnowledgeSummaries(context.Context, *connect.Request[v1.BackfillKnowledgeSummariesRequest]) (*connect.Response[v1.BackfillKnowledgeSummariesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.MaintenanceService.BackfillKnowledgeSummaries is not implemented"))
+}
+
+func (UnimplementedMaintenanceServiceHandler) RotateTenantStorageKey(context.Context, *connect.Request[v1.RotateTenantStorageKeyRequest]) (*connect.Response[v1.RotateTenantStorageKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.MaintenanceService.RotateTenantStorageKey is not implemented"))
+}
+
+func (UnimplementedMaintenanceServiceHandler) EncryptTenantStorage(context.Context, *connect.Request[v1.EncryptTenantStorageRequest]) (*connect.Response[v1.EncryptTenantStorageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.MaintenanceService.EncryptTenantStorage is not implemented"))
+}
+
+func (UnimplementedMaintenanceServiceHandler) MigrateTenantStorageRegion(context.Context, *connect.Request[v1.MigrateTenantStorageRegionRequest]) (*connect.Response[v1.MigrateTenantStorageRegionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.MaintenanceService.MigrateTenantStorageRegion is not implemented"))
+}
@@ -45,12 +45,18 @@ const (
 	// NotificationServiceMarkAllAsReadProcedure is the fully-qualified name of the
 	// NotificationService's MarkAllAsRead RPC.
 	NotificationServiceMarkAllAsReadProcedure = "/mirai.v1.NotificationService/MarkAllAsRead"
+	// NotificationServiceMarkAsReadByFilterProcedure is the fully-qualified name of the
+	// NotificationService's MarkAsReadByFilter RPC.
+	NotificationServiceMarkAsReadByFilterProcedure = "/mirai.v1.NotificationService/MarkAsReadByFilter"
 	// NotificationServiceDeleteNotificationProcedure is the fully-qualified name of the
 	// NotificationService's DeleteNotification RPC.
 	NotificationServiceDeleteNotificationProcedure = "/mirai.v1.NotificationService/DeleteNotification"
 	// NotificationServiceSubscribeNotificationsProcedure is the fully-qualified name of the
 	// NotificationService's SubscribeNotifications RPC.
 	NotificationServiceSubscribeNotificationsProcedure = "/mirai.v1.NotificationService/SubscribeNotifications"
+	// NotificationServiceListEmailLogProcedure is the fully-qualified name of the NotificationService's
+	// ListEmailLog RPC.
+	NotificationServiceListEmailLogProcedure = "/mirai.v1.NotificationService/ListEmailLog"
 )
 
 // NotificationServiceClient is a client for the mirai.v1.NotificationService service.
@@ -63,11 +69,16 @@ type NotificationServiceClient interface {
 	MarkAsRead(context.Context, *connect.Request[v1.MarkAsReadRequest]) (*connect.Response[v1.MarkAsReadResponse], error)
 	// MarkAllAsRead marks all notifications as read.
 	MarkAllAsRead(context.Context, *connect.Request[v1.MarkAllAsReadRequest]) (*connect.Response[v1.MarkAllAsReadResponse], error)
+	// MarkAsReadByFilter marks all notifications matching a filter as read.
+	MarkAsReadByFilter(context.Context, *connect.Request[v1.MarkAsReadByFilterRequest]) (*connect.Response[v1.MarkAsReadByFilterResponse], error)
 	// DeleteNotification deletes a notification.
 	DeleteNotification(context.Context, *connect.Request[v1.DeleteNotificationRequest]) (*connect.Response[v1.DeleteNotificationResponse], error)
 	// SubscribeNotifications opens a server-streaming connection for real-time notification events.
 	// Events are pushed when notifications are created, read, or deleted.
 	SubscribeNotifications(context.Context, *connect.Request[v1.SubscribeNotificationsRequest]) (*connect.ServerStreamForClient[v1.SubscribeNotificationsResponse], error)
+	// ListEmailLog returns the outcomes of emails sent for the tenant, most recent first.
+	// Requires ADMIN or OWNER role.
+	ListEmailLog(context.Context, *connect.Request[v1.ListEmailLogRequest]) (*connect.Response[v1.ListEmailLogResponse], error)
 }
 
 // NewNotificationServiceClient constructs a client for the mirai.v1.NotificationService service. By
@@ -105,6 +116,12 @@ func NewNotificationServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(notificationServiceMethods.ByName("MarkAllAsRead")),
 			connect.WithClientOptions(opts...),
 		),
+		markAsReadByFilter: connect.NewClient[v1.MarkAsReadByFilterRequest, v1.MarkAsReadByFilterResponse](
+			httpClient,
+			baseURL+NotificationServiceMarkAsReadByFilterProcedure,
+			connect.WithSchema(notificationServiceMethods.ByName("MarkAsReadByFilter")),
+			connect.WithClientOptions(opts...),
+		),
 		deleteNotification: connect.NewClient[v1.DeleteNotificationRequest, v1.DeleteNotificationResponse](
 			httpClient,
 			baseURL+NotificationServiceDeleteNotificationProcedure,
@@ -117,6 +134,12 @@ func NewNotificationServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(notificationServiceMethods.ByName("SubscribeNotifications")),
 			connect.WithClientOptions(opts...),
 		),
+		listEmailLog: connect.NewClient[v1.ListEmailLogRequest, v1.ListEmailLogResponse](
+			httpClient,
+			baseURL+NotificationServiceListEmailLogProcedure,
+			connect.WithSchema(notificationServiceMethods.ByName("ListEmailLog")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -126,8 +149,10 @@ type notificationServiceClient struct {
 	getUnreadCount         *connect.Client[v1.GetUnreadCountRequest, v1.GetUnreadCountResponse]
 	markAsRead             *connect.Client[v1.MarkAsReadRequest, v1.MarkAsReadResponse]
 	markAllAsRead          *connect.Client[v1.MarkAllAsReadRequest, v1.MarkAllAsReadResponse]
+	markAsReadByFilter     *connect.Client[v1.MarkAsReadByFilterRequest, v1.MarkAsReadByFilterResponse]
 	deleteNotification     *connect.Client[v1.DeleteNotificationRequest, v1.DeleteNotificationResponse]
 	subscribeNotifications *connect.Client[v1.SubscribeNotificationsRequest, v1.SubscribeNotificationsResponse]
+	listEmailLog           *connect.Client[v1.ListEmailLogRequest, v1.ListEmailLogResponse]
 }
 
 // ListNotifications calls mirai.v1.NotificationService.ListNotifications.
@@ -150,6 +175,11 @@ func (c *notificationServiceClient) MarkAllAsRead(ctx context.Context, req *conn
 	return c.markAllAsRead.CallUnary(ctx, req)
 }
 
+// MarkAsReadByFilter calls mirai.v1.NotificationService.MarkAsReadByFilter.
+func (c *notificationServiceClient) MarkAsReadByFilter(ctx context.Context, req *connect.Request[v1.MarkAsReadByFilterRequest]) (*connect.Response[v1.MarkAsReadByFilterResponse], error) {
+	return c.markAsReadByFilter.CallUnary(ctx, req)
+}
+
 // DeleteNotification calls mirai.v1.NotificationService.DeleteNotification.
 func (c *notificationServiceClient) DeleteNotification(ctx context.Context, req *connect.Request[v1.DeleteNotificationRequest]) (*connect.Response[v1.DeleteNotificationResponse], error) {
 	return c.deleteNotification.CallUnary(ctx, req)
@@ -160,6 +190,11 @@ func (c *notificationServiceClient) SubscribeNotifications(ctx context.Context,
 	return c.subscribeNotifications.CallServerStream(ctx, req)
 }
 
+// ListEmailLog calls mirai.v1.NotificationService.ListEmailLog.
+func (c *notificationServiceClient) ListEmailLog(ctx context.Context, req *connect.Request[v1.ListEmailLogRequest]) (*connect.Response[v1.ListEmailLogResponse], error) {
+	return c.listEmailLog.CallUnary(ctx, req)
+}
+
 // NotificationServiceHandler is an implementation of the mirai.v1.NotificationService service.
 type NotificationServiceHandler interface {
 	// ListNotifications returns notifications for the current user.
@@ -170,11 +205,16 @@ type NotificationServiceHandler interface {
 	MarkAsRead(context.Context, *connect.Request[v1.MarkAsReadRequest]) (*connect.Response[v1.MarkAsReadResponse], error)
 	// MarkAllAsRead marks all notifications as read.
 	MarkAllAsRead(context.Context, *connect.Request[v1.MarkAllAsReadRequest]) (*connect.Response[v1.MarkAllAsReadResponse], error)
+	// MarkAsReadByFilter marks all notifications matching a filter as read.
+	MarkAsReadByFilter(context.Context, *connect.Request[v1.MarkAsReadByFilterRequest]) (*connect.Response[v1.MarkAsReadByFilterResponse], error)
 	// DeleteNotification deletes a notification.
 	DeleteNotification(context.Context, *connect.Request[v1.DeleteNotificationRequest]) (*connect.Response[v1.DeleteNotificationResponse], error)
 	// SubscribeNotifications opens a server-streaming connection for real-time notification events.
 	// Events are pushed when notifications are created, read, or deleted.
 	SubscribeNotifications(context.Context, *connect.Request[v1.SubscribeNotificationsRequest], *connect.ServerStream[v1.SubscribeNotificationsResponse]) error
+	// ListEmailLog returns the outcomes of emails sent for the tenant, most recent first.
+	// Requires ADMIN or OWNER role.
+	ListEmailLog(context.Context, *connect.Request[v1.ListEmailLogRequest]) (*connect.Response[v1.ListEmailLogResponse], error)
 }
 
 // NewNotificationServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -208,6 +248,12 @@ func NewNotificationServiceHandler(svc NotificationServiceHandler, opts ...conne
 		connect.WithSchema(notificationServiceMethods.ByName("MarkAllAsRead")),
 		connect.WithHandlerOptions(opts...),
 	)
+	notificationServiceMarkAsReadByFilterHandler := connect.NewUnaryHandler(
+		NotificationServiceMarkAsReadByFilterProcedure,
+		svc.MarkAsReadByFilter,
+		connect.WithSchema(notificationServiceMethods.ByName("MarkAsReadByFilter")),
+		connect.WithHandlerOptions(opts...),
+	)
 	notificationServiceDeleteNotificationHandler := connect.NewUnaryHandler(
 		NotificationServiceDeleteNotificationProcedure,
 		svc.DeleteNotification,
@@ -220,6 +266,12 @@ func NewNotificationServiceHandler(svc NotificationServiceHandler, opts ...conne
 		connect.WithSchema(notificationServiceMethods.ByName("SubscribeNotifications")),
 		connect.WithHandlerOptions(opts...),
 	)
+	notificationServiceListEmailLogHandler := connect.NewUnaryHandler(
+		NotificationServiceListEmailLogProcedure,
+		svc.ListEmailLog,
+		connect.WithSchema(notificationServiceMethods.ByName("ListEmailLog")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/mirai.v1.NotificationService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case NotificationServiceListNotificationsProcedure:
@@ -230,10 +282,14 @@ func NewNotificationServiceHandler(svc NotificationServiceHandler, opts ...conne
 			notificationServiceMarkAsReadHandler.ServeHTTP(w, r)
 		case NotificationServiceMarkAllAsReadProcedure:
 			notificationServiceMarkAllAsReadHandler.ServeHTTP(w, r)
+		case NotificationServiceMarkAsReadByFilterProcedure:
+			notificationServiceMarkAsReadByFilterHandler.ServeHTTP(w, r)
 		case NotificationServiceDeleteNotificationProcedure:
 			notificationServiceDeleteNotificationHandler.ServeHTTP(w, r)
 		case NotificationServiceSubscribeNotificationsProcedure:
 			notificationServiceSubscribeNotificationsHandler.ServeHTTP(w, r)
+		case NotificationServiceListEmailLogProcedure:
+			notificationServiceListEmailLogHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -259,6 +315,10 @@ func (UnimplementedNotificationServiceHandler) MarkAllAsRead(context.Context, *c
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.NotificationService.MarkAllAsRead is not implemented"))
 }
 
+func (UnimplementedNotificationServiceHandler) MarkAsReadByFilter(context.Context, *connect.Request[v1.MarkAsReadByFilterRequest]) (*connect.Response[v1.MarkAsReadByFilterResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.NotificationService.MarkAsReadByFilter is not implemented"))
+}
+
 func (UnimplementedNotificationServiceHandler) DeleteNotification(context.Context, *connect.Request[v1.DeleteNotificationRequest]) (*connect.Response[v1.DeleteNotificationResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.NotificationService.DeleteNotification is not implemented"))
 }
@@ -266,3 +326,7 @@ func (UnimplementedNotificationServiceHandler) DeleteNotification(context.Contex
 func (UnimplementedNotificationServiceHandler) SubscribeNotifications(context.Context, *connect.Request[v1.SubscribeNotificationsRequest], *connect.ServerStream[v1.SubscribeNotificationsResponse]) error {
 	return connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.NotificationService.SubscribeNotifications is not implemented"))
 }
+
+func (UnimplementedNotificationServiceHandler) ListEmailLog(context.Context, *connect.Request[v1.ListEmailLogRequest]) (*connect.Response[v1.ListEmailLogResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.NotificationService.ListEmailLog is not implemented"))
+}
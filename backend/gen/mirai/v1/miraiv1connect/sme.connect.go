@@ -43,6 +43,9 @@ const (
 	SMEServiceUpdateSMEProcedure = "/mirai.v1.SMEService/UpdateSME"
 	// SMEServiceDeleteSMEProcedure is the fully-qualified name of the SMEService's DeleteSME RPC.
 	SMEServiceDeleteSMEProcedure = "/mirai.v1.SMEService/DeleteSME"
+	// SMEServiceGetSMEDeletionImpactProcedure is the fully-qualified name of the SMEService's
+	// GetSMEDeletionImpact RPC.
+	SMEServiceGetSMEDeletionImpactProcedure = "/mirai.v1.SMEService/GetSMEDeletionImpact"
 	// SMEServiceRestoreSMEProcedure is the fully-qualified name of the SMEService's RestoreSME RPC.
 	SMEServiceRestoreSMEProcedure = "/mirai.v1.SMEService/RestoreSME"
 	// SMEServiceCreateTaskProcedure is the fully-qualified name of the SMEService's CreateTask RPC.
@@ -57,17 +60,37 @@ const (
 	SMEServiceCancelTaskProcedure = "/mirai.v1.SMEService/CancelTask"
 	// SMEServiceGetUploadURLProcedure is the fully-qualified name of the SMEService's GetUploadURL RPC.
 	SMEServiceGetUploadURLProcedure = "/mirai.v1.SMEService/GetUploadURL"
+	// SMEServiceStartMultipartUploadProcedure is the fully-qualified name of the SMEService's
+	// StartMultipartUpload RPC.
+	SMEServiceStartMultipartUploadProcedure = "/mirai.v1.SMEService/StartMultipartUpload"
+	// SMEServiceGetPartUploadURLsProcedure is the fully-qualified name of the SMEService's
+	// GetPartUploadURLs RPC.
+	SMEServiceGetPartUploadURLsProcedure = "/mirai.v1.SMEService/GetPartUploadURLs"
+	// SMEServiceCompleteMultipartUploadProcedure is the fully-qualified name of the SMEService's
+	// CompleteMultipartUpload RPC.
+	SMEServiceCompleteMultipartUploadProcedure = "/mirai.v1.SMEService/CompleteMultipartUpload"
 	// SMEServiceSubmitContentProcedure is the fully-qualified name of the SMEService's SubmitContent
 	// RPC.
 	SMEServiceSubmitContentProcedure = "/mirai.v1.SMEService/SubmitContent"
+	// SMEServiceGenerateTaskQuestionsProcedure is the fully-qualified name of the SMEService's
+	// GenerateTaskQuestions RPC.
+	SMEServiceGenerateTaskQuestionsProcedure = "/mirai.v1.SMEService/GenerateTaskQuestions"
+	// SMEServiceSubmitAnswersProcedure is the fully-qualified name of the SMEService's SubmitAnswers
+	// RPC.
+	SMEServiceSubmitAnswersProcedure = "/mirai.v1.SMEService/SubmitAnswers"
 	// SMEServiceListSubmissionsProcedure is the fully-qualified name of the SMEService's
 	// ListSubmissions RPC.
 	SMEServiceListSubmissionsProcedure = "/mirai.v1.SMEService/ListSubmissions"
 	// SMEServiceGetKnowledgeProcedure is the fully-qualified name of the SMEService's GetKnowledge RPC.
 	SMEServiceGetKnowledgeProcedure = "/mirai.v1.SMEService/GetKnowledge"
+	// SMEServiceListKnowledgeTopicsProcedure is the fully-qualified name of the SMEService's
+	// ListKnowledgeTopics RPC.
+	SMEServiceListKnowledgeTopicsProcedure = "/mirai.v1.SMEService/ListKnowledgeTopics"
 	// SMEServiceSearchKnowledgeProcedure is the fully-qualified name of the SMEService's
 	// SearchKnowledge RPC.
 	SMEServiceSearchKnowledgeProcedure = "/mirai.v1.SMEService/SearchKnowledge"
+	// SMEServiceSuggestSMEsProcedure is the fully-qualified name of the SMEService's SuggestSMEs RPC.
+	SMEServiceSuggestSMEsProcedure = "/mirai.v1.SMEService/SuggestSMEs"
 	// SMEServiceGetSubmissionProcedure is the fully-qualified name of the SMEService's GetSubmission
 	// RPC.
 	SMEServiceGetSubmissionProcedure = "/mirai.v1.SMEService/GetSubmission"
@@ -80,14 +103,34 @@ const (
 	// SMEServiceEnhanceSubmissionContentProcedure is the fully-qualified name of the SMEService's
 	// EnhanceSubmissionContent RPC.
 	SMEServiceEnhanceSubmissionContentProcedure = "/mirai.v1.SMEService/EnhanceSubmissionContent"
+	// SMEServiceReprocessSubmissionProcedure is the fully-qualified name of the SMEService's
+	// ReprocessSubmission RPC.
+	SMEServiceReprocessSubmissionProcedure = "/mirai.v1.SMEService/ReprocessSubmission"
+	// SMEServiceGetSubmissionStatusProcedure is the fully-qualified name of the SMEService's
+	// GetSubmissionStatus RPC.
+	SMEServiceGetSubmissionStatusProcedure = "/mirai.v1.SMEService/GetSubmissionStatus"
 	// SMEServiceUpdateKnowledgeChunkProcedure is the fully-qualified name of the SMEService's
 	// UpdateKnowledgeChunk RPC.
 	SMEServiceUpdateKnowledgeChunkProcedure = "/mirai.v1.SMEService/UpdateKnowledgeChunk"
 	// SMEServiceDeleteKnowledgeChunkProcedure is the fully-qualified name of the SMEService's
 	// DeleteKnowledgeChunk RPC.
 	SMEServiceDeleteKnowledgeChunkProcedure = "/mirai.v1.SMEService/DeleteKnowledgeChunk"
+	// SMEServiceReviewFlaggedKnowledgeChunkProcedure is the fully-qualified name of the SMEService's
+	// ReviewFlaggedKnowledgeChunk RPC.
+	SMEServiceReviewFlaggedKnowledgeChunkProcedure = "/mirai.v1.SMEService/ReviewFlaggedKnowledgeChunk"
 	// SMEServiceDeleteTaskProcedure is the fully-qualified name of the SMEService's DeleteTask RPC.
 	SMEServiceDeleteTaskProcedure = "/mirai.v1.SMEService/DeleteTask"
+	// SMEServiceGetSMEStatsProcedure is the fully-qualified name of the SMEService's GetSMEStats RPC.
+	SMEServiceGetSMEStatsProcedure = "/mirai.v1.SMEService/GetSMEStats"
+	// SMEServiceExportSMEKnowledgeProcedure is the fully-qualified name of the SMEService's
+	// ExportSMEKnowledge RPC.
+	SMEServiceExportSMEKnowledgeProcedure = "/mirai.v1.SMEService/ExportSMEKnowledge"
+	// SMEServiceGetKnowledgeImportUploadURLProcedure is the fully-qualified name of the SMEService's
+	// GetKnowledgeImportUploadURL RPC.
+	SMEServiceGetKnowledgeImportUploadURLProcedure = "/mirai.v1.SMEService/GetKnowledgeImportUploadURL"
+	// SMEServiceImportSMEKnowledgeProcedure is the fully-qualified name of the SMEService's
+	// ImportSMEKnowledge RPC.
+	SMEServiceImportSMEKnowledgeProcedure = "/mirai.v1.SMEService/ImportSMEKnowledge"
 )
 
 // SMEServiceClient is a client for the mirai.v1.SMEService service.
@@ -100,8 +143,12 @@ type SMEServiceClient interface {
 	ListSMEs(context.Context, *connect.Request[v1.ListSMEsRequest]) (*connect.Response[v1.ListSMEsResponse], error)
 	// UpdateSME updates an SME entity.
 	UpdateSME(context.Context, *connect.Request[v1.UpdateSMERequest]) (*connect.Response[v1.UpdateSMEResponse], error)
-	// DeleteSME archives an SME entity (soft delete).
+	// DeleteSME archives an SME entity (soft delete). Requires a confirmation token
+	// from GetSMEDeletionImpact.
 	DeleteSME(context.Context, *connect.Request[v1.DeleteSMERequest]) (*connect.Response[v1.DeleteSMEResponse], error)
+	// GetSMEDeletionImpact counts what depends on an SME and issues a confirmation
+	// token for DeleteSME, valid for 10 minutes.
+	GetSMEDeletionImpact(context.Context, *connect.Request[v1.GetSMEDeletionImpactRequest]) (*connect.Response[v1.GetSMEDeletionImpactResponse], error)
 	// RestoreSME restores an archived SME entity.
 	RestoreSME(context.Context, *connect.Request[v1.RestoreSMERequest]) (*connect.Response[v1.RestoreSMEResponse], error)
 	// CreateTask creates a delegated task for content submission.
@@ -116,14 +163,31 @@ type SMEServiceClient interface {
 	CancelTask(context.Context, *connect.Request[v1.CancelTaskRequest]) (*connect.Response[v1.CancelTaskResponse], error)
 	// GetUploadURL returns a presigned URL for content upload.
 	GetUploadURL(context.Context, *connect.Request[v1.GetUploadURLRequest]) (*connect.Response[v1.GetUploadURLResponse], error)
+	// StartMultipartUpload starts a resumable upload for large files.
+	StartMultipartUpload(context.Context, *connect.Request[v1.StartMultipartUploadRequest]) (*connect.Response[v1.StartMultipartUploadResponse], error)
+	// GetPartUploadURLs returns presigned URLs for parts of a multipart upload.
+	GetPartUploadURLs(context.Context, *connect.Request[v1.GetPartUploadURLsRequest]) (*connect.Response[v1.GetPartUploadURLsResponse], error)
+	// CompleteMultipartUpload validates the uploaded parts and assembles the file.
+	CompleteMultipartUpload(context.Context, *connect.Request[v1.CompleteMultipartUploadRequest]) (*connect.Response[v1.CompleteMultipartUploadResponse], error)
 	// SubmitContent records a content submission for a task.
 	SubmitContent(context.Context, *connect.Request[v1.SubmitContentRequest]) (*connect.Response[v1.SubmitContentResponse], error)
-	// ListSubmissions returns all submissions for a task.
+	// GenerateTaskQuestions writes 8-12 interview questions for an SME about a course goal.
+	// Nothing is saved; send the edited questions with CreateTask.
+	GenerateTaskQuestions(context.Context, *connect.Request[v1.GenerateTaskQuestionsRequest]) (*connect.Response[v1.GenerateTaskQuestionsResponse], error)
+	// SubmitAnswers records answers to a task's interview questions as a text submission,
+	// which is reviewed and ingested like uploaded content.
+	SubmitAnswers(context.Context, *connect.Request[v1.SubmitAnswersRequest]) (*connect.Response[v1.SubmitAnswersResponse], error)
+	// ListSubmissions returns a task's submissions, newest first, with optional status filter.
 	ListSubmissions(context.Context, *connect.Request[v1.ListSubmissionsRequest]) (*connect.Response[v1.ListSubmissionsResponse], error)
-	// GetKnowledge returns distilled knowledge for an SME.
+	// GetKnowledge returns distilled knowledge for an SME, optionally for one topic.
 	GetKnowledge(context.Context, *connect.Request[v1.GetKnowledgeRequest]) (*connect.Response[v1.GetKnowledgeResponse], error)
+	// ListKnowledgeTopics returns an SME's knowledge topics with chunk counts.
+	ListKnowledgeTopics(context.Context, *connect.Request[v1.ListKnowledgeTopicsRequest]) (*connect.Response[v1.ListKnowledgeTopicsResponse], error)
 	// SearchKnowledge searches across SME knowledge.
 	SearchKnowledge(context.Context, *connect.Request[v1.SearchKnowledgeRequest]) (*connect.Response[v1.SearchKnowledgeResponse], error)
+	// SuggestSMEs suggests up to 5 active SMEs the user can access for a course goal, by
+	// how well their domain, summary and knowledge keywords match its key terms.
+	SuggestSMEs(context.Context, *connect.Request[v1.SuggestSMEsRequest]) (*connect.Response[v1.SuggestSMEsResponse], error)
 	// GetSubmission returns a specific submission by ID.
 	GetSubmission(context.Context, *connect.Request[v1.GetSubmissionRequest]) (*connect.Response[v1.GetSubmissionResponse], error)
 	// ApproveSubmission approves content and creates knowledge chunks.
@@ -132,12 +196,31 @@ type SMEServiceClient interface {
 	RequestSubmissionChanges(context.Context, *connect.Request[v1.RequestSubmissionChangesRequest]) (*connect.Response[v1.RequestSubmissionChangesResponse], error)
 	// EnhanceSubmissionContent uses AI to summarize or improve content.
 	EnhanceSubmissionContent(context.Context, *connect.Request[v1.EnhanceSubmissionContentRequest]) (*connect.Response[v1.EnhanceSubmissionContentResponse], error)
+	// ReprocessSubmission retries ingestion of a failed submission, optionally with a replacement file.
+	ReprocessSubmission(context.Context, *connect.Request[v1.ReprocessSubmissionRequest]) (*connect.Response[v1.ReprocessSubmissionResponse], error)
+	// GetSubmissionStatus returns how far a submission's ingestion has got. Progress is
+	// also pushed as SUBMISSION_PROGRESS events on SubscribeNotifications.
+	GetSubmissionStatus(context.Context, *connect.Request[v1.GetSubmissionStatusRequest]) (*connect.Response[v1.GetSubmissionStatusResponse], error)
 	// UpdateKnowledgeChunk updates a knowledge chunk's content.
 	UpdateKnowledgeChunk(context.Context, *connect.Request[v1.UpdateKnowledgeChunkRequest]) (*connect.Response[v1.UpdateKnowledgeChunkResponse], error)
 	// DeleteKnowledgeChunk removes a knowledge chunk.
 	DeleteKnowledgeChunk(context.Context, *connect.Request[v1.DeleteKnowledgeChunkRequest]) (*connect.Response[v1.DeleteKnowledgeChunkResponse], error)
+	// ReviewFlaggedKnowledgeChunk releases a chunk flagged as possible prompt injection
+	// into generation, or holds it back again. Admin only.
+	ReviewFlaggedKnowledgeChunk(context.Context, *connect.Request[v1.ReviewFlaggedKnowledgeChunkRequest]) (*connect.Response[v1.ReviewFlaggedKnowledgeChunkResponse], error)
 	// DeleteTask permanently removes a task.
 	DeleteTask(context.Context, *connect.Request[v1.DeleteTaskRequest]) (*connect.Response[v1.DeleteTaskResponse], error)
+	// GetSMEStats returns contribution and knowledge coverage stats per SME.
+	GetSMEStats(context.Context, *connect.Request[v1.GetSMEStatsRequest]) (*connect.Response[v1.GetSMEStatsResponse], error)
+	// ExportSMEKnowledge queues a job that writes the SME's profile, summary, knowledge
+	// chunks and submission provenance to a JSON Lines archive. The requester is sent a
+	// download link when it finishes.
+	ExportSMEKnowledge(context.Context, *connect.Request[v1.ExportSMEKnowledgeRequest]) (*connect.Response[v1.ExportSMEKnowledgeResponse], error)
+	// GetKnowledgeImportUploadURL returns a presigned URL for uploading an archive to import.
+	GetKnowledgeImportUploadURL(context.Context, *connect.Request[v1.GetKnowledgeImportUploadURLRequest]) (*connect.Response[v1.GetKnowledgeImportUploadURLResponse], error)
+	// ImportSMEKnowledge queues a job that imports an uploaded archive's chunks, skipping
+	// duplicates, and regenerates the SME's knowledge summary.
+	ImportSMEKnowledge(context.Context, *connect.Request[v1.ImportSMEKnowledgeRequest]) (*connect.Response[v1.ImportSMEKnowledgeResponse], error)
 }
 
 // NewSMEServiceClient constructs a client for the mirai.v1.SMEService service. By default, it uses
@@ -181,6 +264,12 @@ func NewSMEServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(sMEServiceMethods.ByName("DeleteSME")),
 			connect.WithClientOptions(opts...),
 		),
+		getSMEDeletionImpact: connect.NewClient[v1.GetSMEDeletionImpactRequest, v1.GetSMEDeletionImpactResponse](
+			httpClient,
+			baseURL+SMEServiceGetSMEDeletionImpactProcedure,
+			connect.WithSchema(sMEServiceMethods.ByName("GetSMEDeletionImpact")),
+			connect.WithClientOptions(opts...),
+		),
 		restoreSME: connect.NewClient[v1.RestoreSMERequest, v1.RestoreSMEResponse](
 			httpClient,
 			baseURL+SMEServiceRestoreSMEProcedure,
@@ -223,12 +312,42 @@ func NewSMEServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(sMEServiceMethods.ByName("GetUploadURL")),
 			connect.WithClientOptions(opts...),
 		),
+		startMultipartUpload: connect.NewClient[v1.StartMultipartUploadRequest, v1.StartMultipartUploadResponse](
+			httpClient,
+			baseURL+SMEServiceStartMultipartUploadProcedure,
+			connect.WithSchema(sMEServiceMethods.ByName("StartMultipartUpload")),
+			connect.WithClientOptions(opts...),
+		),
+		getPartUploadURLs: connect.NewClient[v1.GetPartUploadURLsRequest, v1.GetPartUploadURLsResponse](
+			httpClient,
+			baseURL+SMEServiceGetPartUploadURLsProcedure,
+			connect.WithSchema(sMEServiceMethods.ByName("GetPartUploadURLs")),
+			connect.WithClientOptions(opts...),
+		),
+		completeMultipartUpload: connect.NewClient[v1.CompleteMultipartUploadRequest, v1.CompleteMultipartUploadResponse](
+			httpClient,
+			baseURL+SMEServiceCompleteMultipartUploadProcedure,
+			connect.WithSchema(sMEServiceMethods.ByName("CompleteMultipartUpload")),
+			connect.WithClientOptions(opts...),
+		),
 		submitContent: connect.NewClient[v1.SubmitContentRequest, v1.SubmitContentResponse](
 			httpClient,
 			baseURL+SMEServiceSubmitContentProcedure,
 			connect.WithSchema(sMEServiceMethods.ByName("SubmitContent")),
 			connect.WithClientOptions(opts...),
 		),
+		generateTaskQuestions: connect.NewClient[v1.GenerateTaskQuestionsRequest, v1.GenerateTaskQuestionsResponse](
+			httpClient,
+			baseURL+SMEServiceGenerateTaskQuestionsProcedure,
+			connect.WithSchema(sMEServiceMethods.ByName("GenerateTaskQuestions")),
+			connect.WithClientOptions(opts...),
+		),
+		submitAnswers: connect.NewClient[v1.SubmitAnswersRequest, v1.SubmitAnswersResponse](
+			httpClient,
+			baseURL+SMEServiceSubmitAnswersProcedure,
+			connect.WithSchema(sMEServiceMethods.ByName("SubmitAnswers")),
+			connect.WithClientOptions(opts...),
+		),
 		listSubmissions: connect.NewClient[v1.ListSubmissionsRequest, v1.ListSubmissionsResponse](
 			httpClient,
 			baseURL+SMEServiceListSubmissionsProcedure,
@@ -241,12 +360,24 @@ func NewSMEServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(sMEServiceMethods.ByName("GetKnowledge")),
 			connect.WithClientOptions(opts...),
 		),
+		listKnowledgeTopics: connect.NewClient[v1.ListKnowledgeTopicsRequest, v1.ListKnowledgeTopicsResponse](
+			httpClient,
+			baseURL+SMEServiceListKnowledgeTopicsProcedure,
+			connect.WithSchema(sMEServiceMethods.ByName("ListKnowledgeTopics")),
+			connect.WithClientOptions(opts...),
+		),
 		searchKnowledge: connect.NewClient[v1.SearchKnowledgeRequest, v1.SearchKnowledgeResponse](
 			httpClient,
 			baseURL+SMEServiceSearchKnowledgeProcedure,
 			connect.WithSchema(sMEServiceMethods.ByName("SearchKnowledge")),
 			connect.WithClientOptions(opts...),
 		),
+		suggestSMEs: connect.NewClient[v1.SuggestSMEsRequest, v1.SuggestSMEsResponse](
+			httpClient,
+			baseURL+SMEServiceSuggestSMEsProcedure,
+			connect.WithSchema(sMEServiceMethods.ByName("SuggestSMEs")),
+			connect.WithClientOptions(opts...),
+		),
 		getSubmission: connect.NewClient[v1.GetSubmissionRequest, v1.GetSubmissionResponse](
 			httpClient,
 			baseURL+SMEServiceGetSubmissionProcedure,
@@ -271,6 +402,18 @@ func NewSMEServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(sMEServiceMethods.ByName("EnhanceSubmissionContent")),
 			connect.WithClientOptions(opts...),
 		),
+		reprocessSubmission: connect.NewClient[v1.ReprocessSubmissionRequest, v1.ReprocessSubmissionResponse](
+			httpClient,
+			baseURL+SMEServiceReprocessSubmissionProcedure,
+			connect.WithSchema(sMEServiceMethods.ByName("ReprocessSubmission")),
+			connect.WithClientOptions(opts...),
+		),
+		getSubmissionStatus: connect.NewClient[v1.GetSubmissionStatusRequest, v1.GetSubmissionStatusResponse](
+			httpClient,
+			baseURL+SMEServiceGetSubmissionStatusProcedure,
+			connect.WithSchema(sMEServiceMethods.ByName("GetSubmissionStatus")),
+			connect.WithClientOptions(opts...),
+		),
 		updateKnowledgeChunk: connect.NewClient[v1.UpdateKnowledgeChunkRequest, v1.UpdateKnowledgeChunkResponse](
 			httpClient,
 			baseURL+SMEServiceUpdateKnowledgeChunkProcedure,
@@ -283,40 +426,85 @@ func NewSMEServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(sMEServiceMethods.ByName("DeleteKnowledgeChunk")),
 			connect.WithClientOptions(opts...),
 		),
+		reviewFlaggedKnowledgeChunk: connect.NewClient[v1.ReviewFlaggedKnowledgeChunkRequest, v1.ReviewFlaggedKnowledgeChunkResponse](
+			httpClient,
+			baseURL+SMEServiceReviewFlaggedKnowledgeChunkProcedure,
+			connect.WithSchema(sMEServiceMethods.ByName("ReviewFlaggedKnowledgeChunk")),
+			connect.WithClientOptions(opts...),
+		),
 		deleteTask: connect.NewClient[v1.DeleteTaskRequest, v1.DeleteTaskResponse](
 			httpClient,
 			baseURL+SMEServiceDeleteTaskProcedure,
 			connect.WithSchema(sMEServiceMethods.ByName("DeleteTask")),
 			connect.WithClientOptions(opts...),
 		),
+		getSMEStats: connect.NewClient[v1.GetSMEStatsRequest, v1.GetSMEStatsResponse](
+			httpClient,
+			baseURL+SMEServiceGetSMEStatsProcedure,
+			connect.WithSchema(sMEServiceMethods.ByName("GetSMEStats")),
+			connect.WithClientOptions(opts...),
+		),
+		exportSMEKnowledge: connect.NewClient[v1.ExportSMEKnowledgeRequest, v1.ExportSMEKnowledgeResponse](
+			httpClient,
+			baseURL+SMEServiceExportSMEKnowledgeProcedure,
+			connect.WithSchema(sMEServiceMethods.ByName("ExportSMEKnowledge")),
+			connect.WithClientOptions(opts...),
+		),
+		getKnowledgeImportUploadURL: connect.NewClient[v1.GetKnowledgeImportUploadURLRequest, v1.GetKnowledgeImportUploadURLResponse](
+			httpClient,
+			baseURL+SMEServiceGetKnowledgeImportUploadURLProcedure,
+			connect.WithSchema(sMEServiceMethods.ByName("GetKnowledgeImportUploadURL")),
+			connect.WithClientOptions(opts...),
+		),
+		importSMEKnowledge: connect.NewClient[v1.ImportSMEKnowledgeRequest, v1.ImportSMEKnowledgeResponse](
+			httpClient,
+			baseURL+SMEServiceImportSMEKnowledgeProcedure,
+			connect.WithSchema(sMEServiceMethods.ByName("ImportSMEKnowledge")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // sMEServiceClient implements SMEServiceClient.
 type sMEServiceClient struct {
-	createSME                *connect.Client[v1.CreateSMERequest, v1.CreateSMEResponse]
-	getSME                   *connect.Client[v1.GetSMERequest, v1.GetSMEResponse]
-	listSMEs                 *connect.Client[v1.ListSMEsRequest, v1.ListSMEsResponse]
-	updateSME                *connect.Client[v1.UpdateSMERequest, v1.UpdateSMEResponse]
-	deleteSME                *connect.Client[v1.DeleteSMERequest, v1.DeleteSMEResponse]
-	restoreSME               *connect.Client[v1.RestoreSMERequest, v1.RestoreSMEResponse]
-	createTask               *connect.Client[v1.CreateTaskRequest, v1.CreateTaskResponse]
-	getTask                  *connect.Client[v1.GetTaskRequest, v1.GetTaskResponse]
-	listTasks                *connect.Client[v1.ListTasksRequest, v1.ListTasksResponse]
-	updateTask               *connect.Client[v1.UpdateTaskRequest, v1.UpdateTaskResponse]
-	cancelTask               *connect.Client[v1.CancelTaskRequest, v1.CancelTaskResponse]
-	getUploadURL             *connect.Client[v1.GetUploadURLRequest, v1.GetUploadURLResponse]
-	submitContent            *connect.Client[v1.SubmitContentRequest, v1.SubmitContentResponse]
-	listSubmissions          *connect.Client[v1.ListSubmissionsRequest, v1.ListSubmissionsResponse]
-	getKnowledge             *connect.Client[v1.GetKnowledgeRequest, v1.GetKnowledgeResponse]
-	searchKnowledge          *connect.Client[v1.SearchKnowledgeRequest, v1.SearchKnowledgeResponse]
-	getSubmission            *connect.Client[v1.GetSubmissionRequest, v1.GetSubmissionResponse]
-	approveSubmission        *connect.Client[v1.ApproveSubmissionRequest, v1.ApproveSubmissionResponse]
-	requestSubmissionChanges *connect.Client[v1.RequestSubmissionChangesRequest, v1.RequestSubmissionChangesResponse]
-	enhanceSubmissionContent *connect.Client[v1.EnhanceSubmissionContentRequest, v1.EnhanceSubmissionContentResponse]
-	updateKnowledgeChunk     *connect.Client[v1.UpdateKnowledgeChunkRequest, v1.UpdateKnowledgeChunkResponse]
-	deleteKnowledgeChunk     *connect.Client[v1.DeleteKnowledgeChunkRequest, v1.DeleteKnowledgeChunkResponse]
-	deleteTask               *connect.Client[v1.DeleteTaskRequest, v1.DeleteTaskResponse]
+	createSME                   *connect.Client[v1.CreateSMERequest, v1.CreateSMEResponse]
+	getSME                      *connect.Client[v1.GetSMERequest, v1.GetSMEResponse]
+	listSMEs                    *connect.Client[v1.ListSMEsRequest, v1.ListSMEsResponse]
+	updateSME                   *connect.Client[v1.UpdateSMERequest, v1.UpdateSMEResponse]
+	deleteSME                   *connect.Client[v1.DeleteSMERequest, v1.DeleteSMEResponse]
+	getSMEDeletionImpact        *connect.Client[v1.GetSMEDeletionImpactRequest, v1.GetSMEDeletionImpactResponse]
+	restoreSME                  *connect.Client[v1.RestoreSMERequest, v1.RestoreSMEResponse]
+	createTask                  *connect.Client[v1.CreateTaskRequest, v1.CreateTaskResponse]
+	getTask                     *connect.Client[v1.GetTaskRequest, v1.GetTaskResponse]
+	listTasks                   *connect.Client[v1.ListTasksRequest, v1.ListTasksResponse]
+	updateTask                  *connect.Client[v1.UpdateTaskRequest, v1.UpdateTaskResponse]
+	cancelTask                  *connect.Client[v1.CancelTaskRequest, v1.CancelTaskResponse]
+	getUploadURL                *connect.Client[v1.GetUploadURLRequest, v1.GetUploadURLResponse]
+	startMultipartUpload        *connect.Client[v1.StartMultipartUploadRequest, v1.StartMultipartUploadResponse]
+	getPartUploadURLs           *connect.Client[v1.GetPartUploadURLsRequest, v1.GetPartUploadURLsResponse]
+	completeMultipartUpload     *connect.Client[v1.CompleteMultipartUploadRequest, v1.CompleteMultipartUploadResponse]
+	submitContent               *connect.Client[v1.SubmitContentRequest, v1.SubmitContentResponse]
+	generateTaskQuestions       *connect.Client[v1.GenerateTaskQuestionsRequest, v1.GenerateTaskQuestionsResponse]
+	submitAnswers               *connect.Client[v1.SubmitAnswersRequest, v1.SubmitAnswersResponse]
+	listSubmissions             *connect.Client[v1.ListSubmissionsRequest, v1.ListSubmissionsResponse]
+	getKnowledge                *connect.Client[v1.GetKnowledgeRequest, v1.GetKnowledgeResponse]
+	listKnowledgeTopics         *connect.Client[v1.ListKnowledgeTopicsRequest, v1.ListKnowledgeTopicsResponse]
+	searchKnowledge             *connect.Client[v1.SearchKnowledgeRequest, v1.SearchKnowledgeResponse]
+	suggestSMEs                 *connect.Client[v1.SuggestSMEsRequest, v1.SuggestSMEsResponse]
+	getSubmission               *connect.Client[v1.GetSubmissionRequest, v1.GetSubmissionResponse]
+	approveSubmission           *connect.Client[v1.ApproveSubmissionRequest, v1.ApproveSubmissionResponse]
+	requestSubmissionChanges    *connect.Client[v1.RequestSubmissionChangesRequest, v1.RequestSubmissionChangesResponse]
+	enhanceSubmissionContent    *connect.Client[v1.EnhanceSubmissionContentRequest, v1.EnhanceSubmissionContentResponse]
+	reprocessSubmission         *connect.Client[v1.ReprocessSubmissionRequest, v1.ReprocessSubmissionResponse]
+	getSubmissionStatus         *connect.Client[v1.GetSubmissionStatusRequest, v1.GetSubmissionStatusResponse]
+	updateKnowledgeChunk        *connect.Client[v1.UpdateKnowledgeChunkRequest, v1.UpdateKnowledgeChunkResponse]
+	deleteKnowledgeChunk        *connect.Client[v1.DeleteKnowledgeChunkRequest, v1.DeleteKnowledgeChunkResponse]
+	reviewFlaggedKnowledgeChunk *connect.Client[v1.ReviewFlaggedKnowledgeChunkRequest, v1.ReviewFlaggedKnowledgeChunkResponse]
+	deleteTask                  *connect.Client[v1.DeleteTaskRequest, v1.DeleteTaskResponse]
+	getSMEStats                 *connect.Client[v1.GetSMEStatsRequest, v1.GetSMEStatsResponse]
+	exportSMEKnowledge          *connect.Client[v1.ExportSMEKnowledgeRequest, v1.ExportSMEKnowledgeResponse]
+	getKnowledgeImportUploadURL *connect.Client[v1.GetKnowledgeImportUploadURLRequest, v1.GetKnowledgeImportUploadURLResponse]
+	importSMEKnowledge          *connect.Client[v1.ImportSMEKnowledgeRequest, v1.ImportSMEKnowledgeResponse]
 }
 
 // CreateSME calls mirai.v1.SMEService.CreateSME.
@@ -344,6 +532,11 @@ func (c *sMEServiceClient) DeleteSME(ctx context.Context, req *connect.Request[v
 	return c.deleteSME.CallUnary(ctx, req)
 }
 
+// GetSMEDeletionImpact calls mirai.v1.SMEService.GetSMEDeletionImpact.
+func (c *sMEServiceClient) GetSMEDeletionImpact(ctx context.Context, req *connect.Request[v1.GetSMEDeletionImpactRequest]) (*connect.Response[v1.GetSMEDeletionImpactResponse], error) {
+	return c.getSMEDeletionImpact.CallUnary(ctx, req)
+}
+
 // RestoreSME calls mirai.v1.SMEService.RestoreSME.
 func (c *sMEServiceClient) RestoreSME(ctx context.Context, req *connect.Request[v1.RestoreSMERequest]) (*connect.Response[v1.RestoreSMEResponse], error) {
 	return c.restoreSME.CallUnary(ctx, req)
@@ -379,11 +572,36 @@ func (c *sMEServiceClient) GetUploadURL(ctx context.Context, req *connect.Reques
 	return c.getUploadURL.CallUnary(ctx, req)
 }
 
+// StartMultipartUpload calls mirai.v1.SMEService.StartMultipartUpload.
+func (c *sMEServiceClient) StartMultipartUpload(ctx context.Context, req *connect.Request[v1.StartMultipartUploadRequest]) (*connect.Response[v1.StartMultipartUploadResponse], error) {
+	return c.startMultipartUpload.CallUnary(ctx, req)
+}
+
+// GetPartUploadURLs calls mirai.v1.SMEService.GetPartUploadURLs.
+func (c *sMEServiceClient) GetPartUploadURLs(ctx context.Context, req *connect.Request[v1.GetPartUploadURLsRequest]) (*connect.Response[v1.GetPartUploadURLsResponse], error) {
+	return c.getPartUploadURLs.CallUnary(ctx, req)
+}
+
+// CompleteMultipartUpload calls mirai.v1.SMEService.CompleteMultipartUpload.
+func (c *sMEServiceClient) CompleteMultipartUpload(ctx context.Context, req *connect.Request[v1.CompleteMultipartUploadRequest]) (*connect.Response[v1.CompleteMultipartUploadResponse], error) {
+	return c.completeMultipartUpload.CallUnary(ctx, req)
+}
+
 // SubmitContent calls mirai.v1.SMEService.SubmitContent.
 func (c *sMEServiceClient) SubmitContent(ctx context.Context, req *connect.Request[v1.SubmitContentRequest]) (*connect.Response[v1.SubmitContentResponse], error) {
 	return c.submitContent.CallUnary(ctx, req)
 }
 
+// GenerateTaskQuestions calls mirai.v1.SMEService.GenerateTaskQuestions.
+func (c *sMEServiceClient) GenerateTaskQuestions(ctx context.Context, req *connect.Request[v1.GenerateTaskQuestionsRequest]) (*connect.Response[v1.GenerateTaskQuestionsResponse], error) {
+	return c.generateTaskQuestions.CallUnary(ctx, req)
+}
+
+// SubmitAnswers calls mirai.v1.SMEService.SubmitAnswers.
+func (c *sMEServiceClient) SubmitAnswers(ctx context.Context, req *connect.Request[v1.SubmitAnswersRequest]) (*connect.Response[v1.SubmitAnswersResponse], error) {
+	return c.submitAnswers.CallUnary(ctx, req)
+}
+
 // ListSubmissions calls mirai.v1.SMEService.ListSubmissions.
 func (c *sMEServiceClient) ListSubmissions(ctx context.Context, req *connect.Request[v1.ListSubmissionsRequest]) (*connect.Response[v1.ListSubmissionsResponse], error) {
 	return c.listSubmissions.CallUnary(ctx, req)
@@ -394,11 +612,21 @@ func (c *sMEServiceClient) GetKnowledge(ctx context.Context, req *connect.Reques
 	return c.getKnowledge.CallUnary(ctx, req)
 }
 
+// ListKnowledgeTopics calls mirai.v1.SMEService.ListKnowledgeTopics.
+func (c *sMEServiceClient) ListKnowledgeTopics(ctx context.Context, req *connect.Request[v1.ListKnowledgeTopicsRequest]) (*connect.Response[v1.ListKnowledgeTopicsResponse], error) {
+	return c.listKnowledgeTopics.CallUnary(ctx, req)
+}
+
 // SearchKnowledge calls mirai.v1.SMEService.SearchKnowledge.
 func (c *sMEServiceClient) SearchKnowledge(ctx context.Context, req *connect.Request[v1.SearchKnowledgeRequest]) (*connect.Response[v1.SearchKnowledgeResponse], error) {
 	return c.searchKnowledge.CallUnary(ctx, req)
 }
 
+// SuggestSMEs calls mirai.v1.SMEService.SuggestSMEs.
+func (c *sMEServiceClient) SuggestSMEs(ctx context.Context, req *connect.Request[v1.SuggestSMEsRequest]) (*connect.Response[v1.SuggestSMEsResponse], error) {
+	return c.suggestSMEs.CallUnary(ctx, req)
+}
+
 // GetSubmission calls mirai.v1.SMEService.GetSubmission.
 func (c *sMEServiceClient) GetSubmission(ctx context.Context, req *connect.Request[v1.GetSubmissionRequest]) (*connect.Response[v1.GetSubmissionResponse], error) {
 	return c.getSubmission.CallUnary(ctx, req)
@@ -419,6 +647,16 @@ func (c *sMEServiceClient) EnhanceSubmissionContent(ctx context.Context, req *co
 	return c.enhanceSubmissionContent.CallUnary(ctx, req)
 }
 
+// ReprocessSubmission calls mirai.v1.SMEService.ReprocessSubmission.
+func (c *sMEServiceClient) ReprocessSubmission(ctx context.Context, req *connect.Request[v1.ReprocessSubmissionRequest]) (*connect.Response[v1.ReprocessSubmissionResponse], error) {
+	return c.reprocessSubmission.CallUnary(ctx, req)
+}
+
+// GetSubmissionStatus calls mirai.v1.SMEService.GetSubmissionStatus.
+func (c *sMEServiceClient) GetSubmissionStatus(ctx context.Context, req *connect.Request[v1.GetSubmissionStatusRequest]) (*connect.Response[v1.GetSubmissionStatusResponse], error) {
+	return c.getSubmissionStatus.CallUnary(ctx, req)
+}
+
 // UpdateKnowledgeChunk calls mirai.v1.SMEService.UpdateKnowledgeChunk.
 func (c *sMEServiceClient) UpdateKnowledgeChunk(ctx context.Context, req *connect.Request[v1.UpdateKnowledgeChunkRequest]) (*connect.Response[v1.UpdateKnowledgeChunkResponse], error) {
 	return c.updateKnowledgeChunk.CallUnary(ctx, req)
@@ -429,11 +667,36 @@ func (c *sMEServiceClient) DeleteKnowledgeChunk(ctx context.Context, req *connec
 	return c.deleteKnowledgeChunk.CallUnary(ctx, req)
 }
 
+// ReviewFlaggedKnowledgeChunk calls mirai.v1.SMEService.ReviewFlaggedKnowledgeChunk.
+func (c *sMEServiceClient) ReviewFlaggedKnowledgeChunk(ctx context.Context, req *connect.Request[v1.ReviewFlaggedKnowledgeChunkRequest]) (*connect.Response[v1.ReviewFlaggedKnowledgeChunkResponse], error) {
+	return c.reviewFlaggedKnowledgeChunk.CallUnary(ctx, req)
+}
+
 // DeleteTask calls mirai.v1.SMEService.DeleteTask.
 func (c *sMEServiceClient) DeleteTask(ctx context.Context, req *connect.Request[v1.DeleteTaskRequest]) (*connect.Response[v1.DeleteTaskResponse], error) {
 	return c.deleteTask.CallUnary(ctx, req)
 }
 
+// GetSMEStats calls mirai.v1.SMEService.GetSMEStats.
+func (c *sMEServiceClient) GetSMEStats(ctx context.Context, req *connect.Request[v1.GetSMEStatsRequest]) (*connect.Response[v1.GetSMEStatsResponse], error) {
+	return c.getSMEStats.CallUnary(ctx, req)
+}
+
+// ExportSMEKnowledge calls mirai.v1.SMEService.ExportSMEKnowledge.
+func (c *sMEServiceClient) ExportSMEKnowledge(ctx context.Context, req *connect.Request[v1.ExportSMEKnowledgeRequest]) (*connect.Response[v1.ExportSMEKnowledgeResponse], error) {
+	return c.exportSMEKnowledge.CallUnary(ctx, req)
+}
+
+// GetKnowledgeImportUploadURL calls mirai.v1.SMEService.GetKnowledgeImportUploadURL.
+func (c *sMEServiceClient) GetKnowledgeImportUploadURL(ctx context.Context, req *connect.Request[v1.GetKnowledgeImportUploadURLRequest]) (*connect.Response[v1.GetKnowledgeImportUploadURLResponse], error) {
+	return c.getKnowledgeImportUploadURL.CallUnary(ctx, req)
+}
+
+// ImportSMEKnowledge calls mirai.v1.SMEService.ImportSMEKnowledge.
+func (c *sMEServiceClient) ImportSMEKnowledge(ctx context.Context, req *connect.Request[v1.ImportSMEKnowledgeRequest]) (*connect.Response[v1.ImportSMEKnowledgeResponse], error) {
+	return c.importSMEKnowledge.CallUnary(ctx, req)
+}
+
 // SMEServiceHandler is an implementation of the mirai.v1.SMEService service.
 type SMEServiceHandler interface {
 	// CreateSME creates a new subject matter expert entity.
@@ -444,8 +707,12 @@ type SMEServiceHandler interface {
 	ListSMEs(context.Context, *connect.Request[v1.ListSMEsRequest]) (*connect.Response[v1.ListSMEsResponse], error)
 	// UpdateSME updates an SME entity.
 	UpdateSME(context.Context, *connect.Request[v1.UpdateSMERequest]) (*connect.Response[v1.UpdateSMEResponse], error)
-	// DeleteSME archives an SME entity (soft delete).
+	// DeleteSME archives an SME entity (soft delete). Requires a confirmation token
+	// from GetSMEDeletionImpact.
 	DeleteSME(context.Context, *connect.Request[v1.DeleteSMERequest]) (*connect.Response[v1.DeleteSMEResponse], error)
+	// GetSMEDeletionImpact counts what depends on an SME and issues a confirmation
+	// token for DeleteSME, valid for 10 minutes.
+	GetSMEDeletionImpact(context.Context, *connect.Request[v1.GetSMEDeletionImpactRequest]) (*connect.Response[v1.GetSMEDeletionImpactResponse], error)
 	// RestoreSME restores an archived SME entity.
 	RestoreSME(context.Context, *connect.Request[v1.RestoreSMERequest]) (*connect.Response[v1.RestoreSMEResponse], error)
 	// CreateTask creates a delegated task for content submission.
@@ -460,14 +727,31 @@ type SMEServiceHandler interface {
 	CancelTask(context.Context, *connect.Request[v1.CancelTaskRequest]) (*connect.Response[v1.CancelTaskResponse], error)
 	// GetUploadURL returns a presigned URL for content upload.
 	GetUploadURL(context.Context, *connect.Request[v1.GetUploadURLRequest]) (*connect.Response[v1.GetUploadURLResponse], error)
+	// StartMultipartUpload starts a resumable upload for large files.
+	StartMultipartUpload(context.Context, *connect.Request[v1.StartMultipartUploadRequest]) (*connect.Response[v1.StartMultipartUploadResponse], error)
+	// GetPartUploadURLs returns presigned URLs for parts of a multipart upload.
+	GetPartUploadURLs(context.Context, *connect.Request[v1.GetPartUploadURLsRequest]) (*connect.Response[v1.GetPartUploadURLsResponse], error)
+	// CompleteMultipartUpload validates the uploaded parts and assembles the file.
+	CompleteMultipartUpload(context.Context, *connect.Request[v1.CompleteMultipartUploadRequest]) (*connect.Response[v1.CompleteMultipartUploadResponse], error)
 	// SubmitContent records a content submission for a task.
 	SubmitContent(context.Context, *connect.Request[v1.SubmitContentRequest]) (*connect.Response[v1.SubmitContentResponse], error)
-	// ListSubmissions returns all submissions for a task.
+	// GenerateTaskQuestions writes 8-12 interview questions for an SME about a course goal.
+	// Nothing is saved; send the edited questions with CreateTask.
+	GenerateTaskQuestions(context.Context, *connect.Request[v1.GenerateTaskQuestionsRequest]) (*connect.Response[v1.GenerateTaskQuestionsResponse], error)
+	// SubmitAnswers records answers to a task's interview questions as a text submission,
+	// which is reviewed and ingested like uploaded content.
+	SubmitAnswers(context.Context, *connect.Request[v1.SubmitAnswersRequest]) (*connect.Response[v1.SubmitAnswersResponse], error)
+	// ListSubmissions returns a task's submissions, newest first, with optional status filter.
 	ListSubmissions(context.Context, *connect.Request[v1.ListSubmissionsRequest]) (*connect.Response[v1.ListSubmissionsResponse], error)
-	// GetKnowledge returns distilled knowledge for an SME.
+	// GetKnowledge returns distilled knowledge for an SME, optionally for one topic.
 	GetKnowledge(context.Context, *connect.Request[v1.GetKnowledgeRequest]) (*connect.Response[v1.GetKnowledgeResponse], error)
+	// ListKnowledgeTopics returns an SME's knowledge topics with chunk counts.
+	ListKnowledgeTopics(context.Context, *connect.Request[v1.ListKnowledgeTopicsRequest]) (*connect.Response[v1.ListKnowledgeTopicsResponse], error)
 	// SearchKnowledge searches across SME knowledge.
 	SearchKnowledge(context.Context, *connect.Request[v1.SearchKnowledgeRequest]) (*connect.Response[v1.SearchKnowledgeResponse], error)
+	// SuggestSMEs suggests up to 5 active SMEs the user can access for a course goal, by
+	// how well their domain, summary and knowledge keywords match its key terms.
+	SuggestSMEs(context.Context, *connect.Request[v1.SuggestSMEsRequest]) (*connect.Response[v1.SuggestSMEsResponse], error)
 	// GetSubmission returns a specific submission by ID.
 	GetSubmission(context.Context, *connect.Request[v1.GetSubmissionRequest]) (*connect.Response[v1.GetSubmissionResponse], error)
 	// ApproveSubmission approves content and creates knowledge chunks.
@@ -476,12 +760,31 @@ type SMEServiceHandler interface {
 	RequestSubmissionChanges(context.Context, *connect.Request[v1.RequestSubmissionChangesRequest]) (*connect.Response[v1.RequestSubmissionChangesResponse], error)
 	// EnhanceSubmissionContent uses AI to summarize or improve content.
 	EnhanceSubmissionContent(context.Context, *connect.Request[v1.EnhanceSubmissionContentRequest]) (*connect.Response[v1.EnhanceSubmissionContentResponse], error)
+	// ReprocessSubmission retries ingestion of a failed submission, optionally with a replacement file.
+	ReprocessSubmission(context.Context, *connect.Request[v1.ReprocessSubmissionRequest]) (*connect.Response[v1.ReprocessSubmissionResponse], error)
+	// GetSubmissionStatus returns how far a submission's ingestion has got. Progress is
+	// also pushed as SUBMISSION_PROGRESS events on SubscribeNotifications.
+	GetSubmissionStatus(context.Context, *connect.Request[v1.GetSubmissionStatusRequest]) (*connect.Response[v1.GetSubmissionStatusResponse], error)
 	// UpdateKnowledgeChunk updates a knowledge chunk's content.
 	UpdateKnowledgeChunk(context.Context, *connect.Request[v1.UpdateKnowledgeChunkRequest]) (*connect.Response[v1.UpdateKnowledgeChunkResponse], error)
 	// DeleteKnowledgeChunk removes a knowledge chunk.
 	DeleteKnowledgeChunk(context.Context, *connect.Request[v1.DeleteKnowledgeChunkRequest]) (*connect.Response[v1.DeleteKnowledgeChunkResponse], error)
+	// ReviewFlaggedKnowledgeChunk releases a chunk flagged as possible prompt injection
+	// into generation, or holds it back again. Admin only.
+	ReviewFlaggedKnowledgeChunk(context.Context, *connect.Request[v1.ReviewFlaggedKnowledgeChunkRequest]) (*connect.Response[v1.ReviewFlaggedKnowledgeChunkResponse], error)
 	// DeleteTask permanently removes a task.
 	DeleteTask(context.Context, *connect.Request[v1.DeleteTaskRequest]) (*connect.Response[v1.DeleteTaskResponse], error)
+	// GetSMEStats returns contribution and knowledge coverage stats per SME.
+	GetSMEStats(context.Context, *connect.Request[v1.GetSMEStatsRequest]) (*connect.Response[v1.GetSMEStatsResponse], error)
+	// ExportSMEKnowledge queues a job that writes the SME's profile, summary, knowledge
+	// chunks and submission provenance to a JSON Lines archive. The requester is sent a
+	// download link when it finishes.
+	ExportSMEKnowledge(context.Context, *connect.Request[v1.ExportSMEKnowledgeRequest]) (*connect.Response[v1.ExportSMEKnowledgeResponse], error)
+	// GetKnowledgeImportUploadURL returns a presigned URL for uploading an archive to import.
+	GetKnowledgeImportUploadURL(context.Context, *connect.Request[v1.GetKnowledgeImportUploadURLRequest]) (*connect.Response[v1.GetKnowledgeImportUploadURLResponse], error)
+	// ImportSMEKnowledge queues a job that imports an uploaded archive's chunks, skipping
+	// duplicates, and regenerates the SME's knowledge summary.
+	ImportSMEKnowledge(context.Context, *connect.Request[v1.ImportSMEKnowledgeRequest]) (*connect.Response[v1.ImportSMEKnowledgeResponse], error)
 }
 
 // NewSMEServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -521,6 +824,12 @@ func NewSMEServiceHandler(svc SMEServiceHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(sMEServiceMethods.ByName("DeleteSME")),
 		connect.WithHandlerOptions(opts...),
 	)
+	sMEServiceGetSMEDeletionImpactHandler := connect.NewUnaryHandler(
+		SMEServiceGetSMEDeletionImpactProcedure,
+		svc.GetSMEDeletionImpact,
+		connect.WithSchema(sMEServiceMethods.ByName("GetSMEDeletionImpact")),
+		connect.WithHandlerOptions(opts...),
+	)
 	sMEServiceRestoreSMEHandler := connect.NewUnaryHandler(
 		SMEServiceRestoreSMEProcedure,
 		svc.RestoreSME,
@@ -563,12 +872,42 @@ func NewSMEServiceHandler(svc SMEServiceHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(sMEServiceMethods.ByName("GetUploadURL")),
 		connect.WithHandlerOptions(opts...),
 	)
+	sMEServiceStartMultipartUploadHandler := connect.NewUnaryHandler(
+		SMEServiceStartMultipartUploadProcedure,
+		svc.StartMultipartUpload,
+		connect.WithSchema(sMEServiceMethods.ByName("StartMultipartUpload")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sMEServiceGetPartUploadURLsHandler := connect.NewUnaryHandler(
+		SMEServiceGetPartUploadURLsProcedure,
+		svc.GetPartUploadURLs,
+		connect.WithSchema(sMEServiceMethods.ByName("GetPartUploadURLs")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sMEServiceCompleteMultipartUploadHandler := connect.NewUnaryHandler(
+		SMEServiceCompleteMultipartUploadProcedure,
+		svc.CompleteMultipartUpload,
+		connect.WithSchema(sMEServiceMethods.ByName("CompleteMultipartUpload")),
+		connect.WithHandlerOptions(opts...),
+	)
 	sMEServiceSubmitContentHandler := connect.NewUnaryHandler(
 		SMEServiceSubmitContentProcedure,
 		svc.SubmitContent,
 		connect.WithSchema(sMEServiceMethods.ByName("SubmitContent")),
 		connect.WithHandlerOptions(opts...),
 	)
+	sMEServiceGenerateTaskQuestionsHandler := connect.NewUnaryHandler(
+		SMEServiceGenerateTaskQuestionsProcedure,
+		svc.GenerateTaskQuestions,
+		connect.WithSchema(sMEServiceMethods.ByName("GenerateTaskQuestions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sMEServiceSubmitAnswersHandler := connect.NewUnaryHandler(
+		SMEServiceSubmitAnswersProcedure,
+		svc.SubmitAnswers,
+		connect.WithSchema(sMEServiceMethods.ByName("SubmitAnswers")),
+		connect.WithHandlerOptions(opts...),
+	)
 	sMEServiceListSubmissionsHandler := connect.NewUnaryHandler(
 		SMEServiceListSubmissionsProcedure,
 		svc.ListSubmissions,
@@ -581,12 +920,24 @@ func NewSMEServiceHandler(svc SMEServiceHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(sMEServiceMethods.ByName("GetKnowledge")),
 		connect.WithHandlerOptions(opts...),
 	)
+	sMEServiceListKnowledgeTopicsHandler := connect.NewUnaryHandler(
+		SMEServiceListKnowledgeTopicsProcedure,
+		svc.ListKnowledgeTopics,
+		connect.WithSchema(sMEServiceMethods.ByName("ListKnowledgeTopics")),
+		connect.WithHandlerOptions(opts...),
+	)
 	sMEServiceSearchKnowledgeHandler := connect.NewUnaryHandler(
 		SMEServiceSearchKnowledgeProcedure,
 		svc.SearchKnowledge,
 		connect.WithSchema(sMEServiceMethods.ByName("SearchKnowledge")),
 		connect.WithHandlerOptions(opts...),
 	)
+	sMEServiceSuggestSMEsHandler := connect.NewUnaryHandler(
+		SMEServiceSuggestSMEsProcedure,
+		svc.SuggestSMEs,
+		connect.WithSchema(sMEServiceMethods.ByName("SuggestSMEs")),
+		connect.WithHandlerOptions(opts...),
+	)
 	sMEServiceGetSubmissionHandler := connect.NewUnaryHandler(
 		SMEServiceGetSubmissionProcedure,
 		svc.GetSubmission,
@@ -611,6 +962,18 @@ func NewSMEServiceHandler(svc SMEServiceHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(sMEServiceMethods.ByName("EnhanceSubmissionContent")),
 		connect.WithHandlerOptions(opts...),
 	)
+	sMEServiceReprocessSubmissionHandler := connect.NewUnaryHandler(
+		SMEServiceReprocessSubmissionProcedure,
+		svc.ReprocessSubmission,
+		connect.WithSchema(sMEServiceMethods.ByName("ReprocessSubmission")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sMEServiceGetSubmissionStatusHandler := connect.NewUnaryHandler(
+		SMEServiceGetSubmissionStatusProcedure,
+		svc.GetSubmissionStatus,
+		connect.WithSchema(sMEServiceMethods.ByName("GetSubmissionStatus")),
+		connect.WithHandlerOptions(opts...),
+	)
 	sMEServiceUpdateKnowledgeChunkHandler := connect.NewUnaryHandler(
 		SMEServiceUpdateKnowledgeChunkProcedure,
 		svc.UpdateKnowledgeChunk,
@@ -623,12 +986,42 @@ func NewSMEServiceHandler(svc SMEServiceHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(sMEServiceMethods.ByName("DeleteKnowledgeChunk")),
 		connect.WithHandlerOptions(opts...),
 	)
+	sMEServiceReviewFlaggedKnowledgeChunkHandler := connect.NewUnaryHandler(
+		SMEServiceReviewFlaggedKnowledgeChunkProcedure,
+		svc.ReviewFlaggedKnowledgeChunk,
+		connect.WithSchema(sMEServiceMethods.ByName("ReviewFlaggedKnowledgeChunk")),
+		connect.WithHandlerOptions(opts...),
+	)
 	sMEServiceDeleteTaskHandler := connect.NewUnaryHandler(
 		SMEServiceDeleteTaskProcedure,
 		svc.DeleteTask,
 		connect.WithSchema(sMEServiceMethods.ByName("DeleteTask")),
 		connect.WithHandlerOptions(opts...),
 	)
+	sMEServiceGetSMEStatsHandler := connect.NewUnaryHandler(
+		SMEServiceGetSMEStatsProcedure,
+		svc.GetSMEStats,
+		connect.WithSchema(sMEServiceMethods.ByName("GetSMEStats")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sMEServiceExportSMEKnowledgeHandler := connect.NewUnaryHandler(
+		SMEServiceExportSMEKnowledgeProcedure,
+		svc.ExportSMEKnowledge,
+		connect.WithSchema(sMEServiceMethods.ByName("ExportSMEKnowledge")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sMEServiceGetKnowledgeImportUploadURLHandler := connect.NewUnaryHandler(
+		SMEServiceGetKnowledgeImportUploadURLProcedure,
+		svc.GetKnowledgeImportUploadURL,
+		connect.WithSchema(sMEServiceMethods.ByName("GetKnowledgeImportUploadURL")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sMEServiceImportSMEKnowledgeHandler := connect.NewUnaryHandler(
+		SMEServiceImportSMEKnowledgeProcedure,
+		svc.ImportSMEKnowledge,
+		connect.WithSchema(sMEServiceMethods.ByName("ImportSMEKnowledge")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/mirai.v1.SMEService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case SMEServiceCreateSMEProcedure:
@@ -641,6 +1034,8 @@ func NewSMEServiceHandler(svc SMEServiceHandler, opts ...connect.HandlerOption)
 			sMEServiceUpdateSMEHandler.ServeHTTP(w, r)
 		case SMEServiceDeleteSMEProcedure:
 			sMEServiceDeleteSMEHandler.ServeHTTP(w, r)
+		case SMEServiceGetSMEDeletionImpactProcedure:
+			sMEServiceGetSMEDeletionImpactHandler.ServeHTTP(w, r)
 		case SMEServiceRestoreSMEProcedure:
 			sMEServiceRestoreSMEHandler.ServeHTTP(w, r)
 		case SMEServiceCreateTaskProcedure:
@@ -655,14 +1050,28 @@ func NewSMEServiceHandler(svc SMEServiceHandler, opts ...connect.HandlerOption)
 			sMEServiceCancelTaskHandler.ServeHTTP(w, r)
 		case SMEServiceGetUploadURLProcedure:
 			sMEServiceGetUploadURLHandler.ServeHTTP(w, r)
+		case SMEServiceStartMultipartUploadProcedure:
+			sMEServiceStartMultipartUploadHandler.ServeHTTP(w, r)
+		case SMEServiceGetPartUploadURLsProcedure:
+			sMEServiceGetPartUploadURLsHandler.ServeHTTP(w, r)
+		case SMEServiceCompleteMultipartUploadProcedure:
+			sMEServiceCompleteMultipartUploadHandler.ServeHTTP(w, r)
 		case SMEServiceSubmitContentProcedure:
 			sMEServiceSubmitContentHandler.ServeHTTP(w, r)
+		case SMEServiceGenerateTaskQuestionsProcedure:
+			sMEServiceGenerateTaskQuestionsHandler.ServeHTTP(w, r)
+		case SMEServiceSubmitAnswersProcedure:
+			sMEServiceSubmitAnswersHandler.ServeHTTP(w, r)
 		case SMEServiceListSubmissionsProcedure:
 			sMEServiceListSubmissionsHandler.ServeHTTP(w, r)
 		case SMEServiceGetKnowledgeProcedure:
 			sMEServiceGetKnowledgeHandler.ServeHTTP(w, r)
+		case SMEServiceListKnowledgeTopicsProcedure:
+			sMEServiceListKnowledgeTopicsHandler.ServeHTTP(w, r)
 		case SMEServiceSearchKnowledgeProcedure:
 			sMEServiceSearchKnowledgeHandler.ServeHTTP(w, r)
+		case SMEServiceSuggestSMEsProcedure:
+			sMEServiceSuggestSMEsHandler.ServeHTTP(w, r)
 		case SMEServiceGetSubmissionProcedure:
 			sMEServiceGetSubmissionHandler.ServeHTTP(w, r)
 		case SMEServiceApproveSubmissionProcedure:
@@ -671,12 +1080,26 @@ func NewSMEServiceHandler(svc SMEServiceHandler, opts ...connect.HandlerOption)
 			sMEServiceRequestSubmissionChangesHandler.ServeHTTP(w, r)
 		case SMEServiceEnhanceSubmissionContentProcedure:
 			sMEServiceEnhanceSubmissionContentHandler.ServeHTTP(w, r)
+		case SMEServiceReprocessSubmissionProcedure:
+			sMEServiceReprocessSubmissionHandler.ServeHTTP(w, r)
+		case SMEServiceGetSubmissionStatusProcedure:
+			sMEServiceGetSubmissionStatusHandler.ServeHTTP(w, r)
 		case SMEServiceUpdateKnowledgeChunkProcedure:
 			sMEServiceUpdateKnowledgeChunkHandler.ServeHTTP(w, r)
 		case SMEServiceDeleteKnowledgeChunkProcedure:
 			sMEServiceDeleteKnowledgeChunkHandler.ServeHTTP(w, r)
+		case SMEServiceReviewFlaggedKnowledgeChunkProcedure:
+			sMEServiceReviewFlaggedKnowledgeChunkHandler.ServeHTTP(w, r)
 		case SMEServiceDeleteTaskProcedure:
 			sMEServiceDeleteTaskHandler.ServeHTTP(w, r)
+		case SMEServiceGetSMEStatsProcedure:
+			sMEServiceGetSMEStatsHandler.ServeHTTP(w, r)
+		case SMEServiceExportSMEKnowledgeProcedure:
+			sMEServiceExportSMEKnowledgeHandler.ServeHTTP(w, r)
+		case SMEServiceGetKnowledgeImportUploadURLProcedure:
+			sMEServiceGetKnowledgeImportUploadURLHandler.ServeHTTP(w, r)
+		case SMEServiceImportSMEKnowledgeProcedure:
+			sMEServiceImportSMEKnowledgeHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -706,6 +1129,10 @@ func (UnimplementedSMEServiceHandler) DeleteSME(context.Context, *connect.Reques
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.SMEService.DeleteSME is not implemented"))
 }
 
+func (UnimplementedSMEServiceHandler) GetSMEDeletionImpact(context.Context, *connect.Request[v1.GetSMEDeletionImpactRequest]) (*connect.Response[v1.GetSMEDeletionImpactResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.SMEService.GetSMEDeletionImpact is not implemented"))
+}
+
 func (UnimplementedSMEServiceHandler) RestoreSME(context.Context, *connect.Request[v1.RestoreSMERequest]) (*connect.Response[v1.RestoreSMEResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.SMEService.RestoreSME is not implemented"))
 }
@@ -734,10 +1161,30 @@ func (UnimplementedSMEServiceHandler) GetUploadURL(context.Context, *connect.Req
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.SMEService.GetUploadURL is not implemented"))
 }
 
+func (UnimplementedSMEServiceHandler) StartMultipartUpload(context.Context, *connect.Request[v1.StartMultipartUploadRequest]) (*connect.Response[v1.StartMultipartUploadResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.SMEService.StartMultipartUpload is not implemented"))
+}
+
+func (UnimplementedSMEServiceHandler) GetPartUploadURLs(context.Context, *connect.Request[v1.GetPartUploadURLsRequest]) (*connect.Response[v1.GetPartUploadURLsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.SMEService.GetPartUploadURLs is not implemented"))
+}
+
+func (UnimplementedSMEServiceHandler) CompleteMultipartUpload(context.Context, *connect.Request[v1.CompleteMultipartUploadRequest]) (*connect.Response[v1.CompleteMultipartUploadResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.SMEService.CompleteMultipartUpload is not implemented"))
+}
+
 func (UnimplementedSMEServiceHandler) SubmitContent(context.Context, *connect.Request[v1.SubmitContentRequest]) (*connect.Response[v1.SubmitContentResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.SMEService.SubmitContent is not implemented"))
 }
 
+func (UnimplementedSMEServiceHandler) GenerateTaskQuestions(context.Context, *connect.Request[v1.GenerateTaskQuestionsRequest]) (*connect.Response[v1.GenerateTaskQuestionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.SMEService.GenerateTaskQuestions is not implemented"))
+}
+
+func (UnimplementedSMEServiceHandler) SubmitAnswers(context.Context, *connect.Request[v1.SubmitAnswersRequest]) (*connect.Response[v1.SubmitAnswersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.SMEService.SubmitAnswers is not implemented"))
+}
+
 func (UnimplementedSMEServiceHandler) ListSubmissions(context.Context, *connect.Request[v1.ListSubmissionsRequest]) (*connect.Response[v1.ListSubmissionsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.SMEService.ListSubmissions is not implemented"))
 }
@@ -746,10 +1193,18 @@ func (UnimplementedSMEServiceHandler) GetKnowledge(context.Context, *connect.Req
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.SMEService.GetKnowledge is not implemented"))
 }
 
+func (UnimplementedSMEServiceHandler) ListKnowledgeTopics(context.Context, *connect.Request[v1.ListKnowledgeTopicsRequest]) (*connect.Response[v1.ListKnowledgeTopicsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.SMEService.ListKnowledgeTopics is not implemented"))
+}
+
 func (UnimplementedSMEServiceHandler) SearchKnowledge(context.Context, *connect.Request[v1.SearchKnowledgeRequest]) (*connect.Response[v1.SearchKnowledgeResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.SMEService.SearchKnowledge is not implemented"))
 }
 
+func (UnimplementedSMEServiceHandler) SuggestSMEs(context.Context, *connect.Request[v1.SuggestSMEsRequest]) (*connect.Response[v1.SuggestSMEsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.SMEService.SuggestSMEs is not implemented"))
+}
+
 func (UnimplementedSMEServiceHandler) GetSubmission(context.Context, *connect.Request[v1.GetSubmissionRequest]) (*connect.Response[v1.GetSubmissionResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.SMEService.GetSubmission is not implemented"))
 }
@@ -766,6 +1221,14 @@ func (UnimplementedSMEServiceHandler) EnhanceSubmissionContent(context.Context,
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.SMEService.EnhanceSubmissionContent is not implemented"))
 }
 
+func (UnimplementedSMEServiceHandler) ReprocessSubmission(context.Context, *connect.Request[v1.ReprocessSubmissionRequest]) (*connect.Response[v1.ReprocessSubmissionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.SMEService.ReprocessSubmission is not implemented"))
+}
+
+func (UnimplementedSMEServiceHandler) GetSubmissionStatus(context.Context, *connect.Request[v1.GetSubmissionStatusRequest]) (*connect.Response[v1.GetSubmissionStatusResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.SMEService.GetSubmissionStatus is not implemented"))
+}
+
 func (UnimplementedSMEServiceHandler) UpdateKnowledgeChunk(context.Context, *connect.Request[v1.UpdateKnowledgeChunkRequest]) (*connect.Response[v1.UpdateKnowledgeChunkResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.SMEService.UpdateKnowledgeChunk is not implemented"))
 }
@@ -774,6 +1237,26 @@ func (UnimplementedSMEServiceHandler) DeleteKnowledgeChunk(context.Context, *con
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.SMEService.DeleteKnowledgeChunk is not implemented"))
 }
 
+func (UnimplementedSMEServiceHandler) ReviewFlaggedKnowledgeChunk(context.Context, *connect.Request[v1.ReviewFlaggedKnowledgeChunkRequest]) (*connect.Response[v1.ReviewFlaggedKnowledgeChunkResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.SMEService.ReviewFlaggedKnowledgeChunk is not implemented"))
+}
+
 func (UnimplementedSMEServiceHandler) DeleteTask(context.Context, *connect.Request[v1.DeleteTaskRequest]) (*connect.Response[v1.DeleteTaskResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.SMEService.DeleteTask is not implemented"))
 }
+
+func (UnimplementedSMEServiceHandler) GetSMEStats(context.Context, *connect.Request[v1.GetSMEStatsRequest]) (*connect.Response[v1.GetSMEStatsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.SMEService.GetSMEStats is not implemented"))
+}
+
+func (UnimplementedSMEServiceHandler) ExportSMEKnowledge(context.Context, *connect.Request[v1.ExportSMEKnowledgeRequest]) (*connect.Response[v1.ExportSMEKnowledgeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.SMEService.ExportSMEKnowledge is not implemented"))
+}
+
+func (UnimplementedSMEServiceHandler) GetKnowledgeImportUploadURL(context.Context, *connect.Request[v1.GetKnowledgeImportUploadURLRequest]) (*connect.Response[v1.GetKnowledgeImportUploadURLResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.SMEService.GetKnowledgeImportUploadURL is not implemented"))
+}
+
+func (UnimplementedSMEServiceHandler) ImportSMEKnowledge(context.Context, *connect.Request[v1.ImportSMEKnowledgeRequest]) (*connect.Response[v1.ImportSMEKnowledgeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.SMEService.ImportSMEKnowledge is not implemented"))
+}
@@ -54,6 +54,9 @@ const (
 	// TargetAudienceServiceRestoreTemplateProcedure is the fully-qualified name of the
 	// TargetAudienceService's RestoreTemplate RPC.
 	TargetAudienceServiceRestoreTemplateProcedure = "/mirai.v1.TargetAudienceService/RestoreTemplate"
+	// TargetAudienceServiceGenerateTargetAudienceProcedure is the fully-qualified name of the
+	// TargetAudienceService's GenerateTargetAudience RPC.
+	TargetAudienceServiceGenerateTargetAudienceProcedure = "/mirai.v1.TargetAudienceService/GenerateTargetAudience"
 )
 
 // TargetAudienceServiceClient is a client for the mirai.v1.TargetAudienceService service.
@@ -72,6 +75,9 @@ type TargetAudienceServiceClient interface {
 	ArchiveTemplate(context.Context, *connect.Request[v1.ArchiveTemplateRequest]) (*connect.Response[v1.ArchiveTemplateResponse], error)
 	// RestoreTemplate restores an archived template.
 	RestoreTemplate(context.Context, *connect.Request[v1.RestoreTemplateRequest]) (*connect.Response[v1.RestoreTemplateResponse], error)
+	// GenerateTargetAudience drafts a template from a job description using the tenant's AI
+	// provider. Nothing is saved; the user edits the draft and saves it with CreateTemplate.
+	GenerateTargetAudience(context.Context, *connect.Request[v1.GenerateTargetAudienceRequest]) (*connect.Response[v1.GenerateTargetAudienceResponse], error)
 }
 
 // NewTargetAudienceServiceClient constructs a client for the mirai.v1.TargetAudienceService
@@ -127,18 +133,25 @@ func NewTargetAudienceServiceClient(httpClient connect.HTTPClient, baseURL strin
 			connect.WithSchema(targetAudienceServiceMethods.ByName("RestoreTemplate")),
 			connect.WithClientOptions(opts...),
 		),
+		generateTargetAudience: connect.NewClient[v1.GenerateTargetAudienceRequest, v1.GenerateTargetAudienceResponse](
+			httpClient,
+			baseURL+TargetAudienceServiceGenerateTargetAudienceProcedure,
+			connect.WithSchema(targetAudienceServiceMethods.ByName("GenerateTargetAudience")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // targetAudienceServiceClient implements TargetAudienceServiceClient.
 type targetAudienceServiceClient struct {
-	createTemplate  *connect.Client[v1.CreateTemplateRequest, v1.CreateTemplateResponse]
-	getTemplate     *connect.Client[v1.GetTemplateRequest, v1.GetTemplateResponse]
-	listTemplates   *connect.Client[v1.ListTemplatesRequest, v1.ListTemplatesResponse]
-	updateTemplate  *connect.Client[v1.UpdateTemplateRequest, v1.UpdateTemplateResponse]
-	deleteTemplate  *connect.Client[v1.DeleteTemplateRequest, v1.DeleteTemplateResponse]
-	archiveTemplate *connect.Client[v1.ArchiveTemplateRequest, v1.ArchiveTemplateResponse]
-	restoreTemplate *connect.Client[v1.RestoreTemplateRequest, v1.RestoreTemplateResponse]
+	createTemplate         *connect.Client[v1.CreateTemplateRequest, v1.CreateTemplateResponse]
+	getTemplate            *connect.Client[v1.GetTemplateRequest, v1.GetTemplateResponse]
+	listTemplates          *connect.Client[v1.ListTemplatesRequest, v1.ListTemplatesResponse]
+	updateTemplate         *connect.Client[v1.UpdateTemplateRequest, v1.UpdateTemplateResponse]
+	deleteTemplate         *connect.Client[v1.DeleteTemplateRequest, v1.DeleteTemplateResponse]
+	archiveTemplate        *connect.Client[v1.ArchiveTemplateRequest, v1.ArchiveTemplateResponse]
+	restoreTemplate        *connect.Client[v1.RestoreTemplateRequest, v1.RestoreTemplateResponse]
+	generateTargetAudience *connect.Client[v1.GenerateTargetAudienceRequest, v1.GenerateTargetAudienceResponse]
 }
 
 // CreateTemplate calls mirai.v1.TargetAudienceService.CreateTemplate.
@@ -176,6 +189,11 @@ func (c *targetAudienceServiceClient) RestoreTemplate(ctx context.Context, req *
 	return c.restoreTemplate.CallUnary(ctx, req)
 }
 
+// GenerateTargetAudience calls mirai.v1.TargetAudienceService.GenerateTargetAudience.
+func (c *targetAudienceServiceClient) GenerateTargetAudience(ctx context.Context, req *connect.Request[v1.GenerateTargetAudienceRequest]) (*connect.Response[v1.GenerateTargetAudienceResponse], error) {
+	return c.generateTargetAudience.CallUnary(ctx, req)
+}
+
 // TargetAudienceServiceHandler is an implementation of the mirai.v1.TargetAudienceService service.
 type TargetAudienceServiceHandler interface {
 	// CreateTemplate creates a new target audience template.
@@ -192,6 +210,9 @@ type TargetAudienceServiceHandler interface {
 	ArchiveTemplate(context.Context, *connect.Request[v1.ArchiveTemplateRequest]) (*connect.Response[v1.ArchiveTemplateResponse], error)
 	// RestoreTemplate restores an archived template.
 	RestoreTemplate(context.Context, *connect.Request[v1.RestoreTemplateRequest]) (*connect.Response[v1.RestoreTemplateResponse], error)
+	// GenerateTargetAudience drafts a template from a job description using the tenant's AI
+	// provider. Nothing is saved; the user edits the draft and saves it with CreateTemplate.
+	GenerateTargetAudience(context.Context, *connect.Request[v1.GenerateTargetAudienceRequest]) (*connect.Response[v1.GenerateTargetAudienceResponse], error)
 }
 
 // NewTargetAudienceServiceHandler builds an HTTP handler from the service implementation. It
@@ -243,6 +264,12 @@ func NewTargetAudienceServiceHandler(svc TargetAudienceServiceHandler, opts ...c
 		connect.WithSchema(targetAudienceServiceMethods.ByName("RestoreTemplate")),
 		connect.WithHandlerOptions(opts...),
 	)
+	targetAudienceServiceGenerateTargetAudienceHandler := connect.NewUnaryHandler(
+		TargetAudienceServiceGenerateTargetAudienceProcedure,
+		svc.GenerateTargetAudience,
+		connect.WithSchema(targetAudienceServiceMethods.ByName("GenerateTargetAudience")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/mirai.v1.TargetAudienceService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case TargetAudienceServiceCreateTemplateProcedure:
@@ -259,6 +286,8 @@ func NewTargetAudienceServiceHandler(svc TargetAudienceServiceHandler, opts ...c
 			targetAudienceServiceArchiveTemplateHandler.ServeHTTP(w, r)
 		case TargetAudienceServiceRestoreTemplateProcedure:
 			targetAudienceServiceRestoreTemplateHandler.ServeHTTP(w, r)
+		case TargetAudienceServiceGenerateTargetAudienceProcedure:
+			targetAudienceServiceGenerateTargetAudienceHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -295,3 +324,7 @@ func (UnimplementedTargetAudienceServiceHandler) ArchiveTemplate(context.Context
 func (UnimplementedTargetAudienceServiceHandler) RestoreTemplate(context.Context, *connect.Request[v1.RestoreTemplateRequest]) (*connect.Response[v1.RestoreTemplateResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.TargetAudienceService.RestoreTemplate is not implemented"))
 }
+
+func (UnimplementedTargetAudienceServiceHandler) GenerateTargetAudience(context.Context, *connect.Request[v1.GenerateTargetAudienceRequest]) (*connect.Response[v1.GenerateTargetAudienceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.TargetAudienceService.GenerateTargetAudience is not implemented"))
+}
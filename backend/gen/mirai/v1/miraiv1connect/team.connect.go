@@ -52,6 +52,9 @@ const (
 	// TeamServiceRemoveTeamMemberProcedure is the fully-qualified name of the TeamService's
 	// RemoveTeamMember RPC.
 	TeamServiceRemoveTeamMemberProcedure = "/mirai.v1.TeamService/RemoveTeamMember"
+	// TeamServiceGetTeamDashboardProcedure is the fully-qualified name of the TeamService's
+	// GetTeamDashboard RPC.
+	TeamServiceGetTeamDashboardProcedure = "/mirai.v1.TeamService/GetTeamDashboard"
 )
 
 // TeamServiceClient is a client for the mirai.v1.TeamService service.
@@ -64,7 +67,7 @@ type TeamServiceClient interface {
 	CreateTeam(context.Context, *connect.Request[v1.CreateTeamRequest]) (*connect.Response[v1.CreateTeamResponse], error)
 	// UpdateTeam updates team information.
 	UpdateTeam(context.Context, *connect.Request[v1.UpdateTeamRequest]) (*connect.Response[v1.UpdateTeamResponse], error)
-	// DeleteTeam deletes a team.
+	// DeleteTeam deletes a team, resolving team-scoped SMEs and open tasks that reference it.
 	DeleteTeam(context.Context, *connect.Request[v1.DeleteTeamRequest]) (*connect.Response[v1.DeleteTeamResponse], error)
 	// ListTeamMembers returns all members of a team.
 	ListTeamMembers(context.Context, *connect.Request[v1.ListTeamMembersRequest]) (*connect.Response[v1.ListTeamMembersResponse], error)
@@ -72,6 +75,9 @@ type TeamServiceClient interface {
 	AddTeamMember(context.Context, *connect.Request[v1.AddTeamMemberRequest]) (*connect.Response[v1.AddTeamMemberResponse], error)
 	// RemoveTeamMember removes a user from a team.
 	RemoveTeamMember(context.Context, *connect.Request[v1.RemoveTeamMemberRequest]) (*connect.Response[v1.RemoveTeamMemberResponse], error)
+	// GetTeamDashboard summarizes a team's workload: open tasks, active jobs, recent courses
+	// and pending invitations. Team members and admins only.
+	GetTeamDashboard(context.Context, *connect.Request[v1.GetTeamDashboardRequest]) (*connect.Response[v1.GetTeamDashboardResponse], error)
 }
 
 // NewTeamServiceClient constructs a client for the mirai.v1.TeamService service. By default, it
@@ -133,6 +139,12 @@ func NewTeamServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(teamServiceMethods.ByName("RemoveTeamMember")),
 			connect.WithClientOptions(opts...),
 		),
+		getTeamDashboard: connect.NewClient[v1.GetTeamDashboardRequest, v1.GetTeamDashboardResponse](
+			httpClient,
+			baseURL+TeamServiceGetTeamDashboardProcedure,
+			connect.WithSchema(teamServiceMethods.ByName("GetTeamDashboard")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -146,6 +158,7 @@ type teamServiceClient struct {
 	listTeamMembers  *connect.Client[v1.ListTeamMembersRequest, v1.ListTeamMembersResponse]
 	addTeamMember    *connect.Client[v1.AddTeamMemberRequest, v1.AddTeamMemberResponse]
 	removeTeamMember *connect.Client[v1.RemoveTeamMemberRequest, v1.RemoveTeamMemberResponse]
+	getTeamDashboard *connect.Client[v1.GetTeamDashboardRequest, v1.GetTeamDashboardResponse]
 }
 
 // ListTeams calls mirai.v1.TeamService.ListTeams.
@@ -188,6 +201,11 @@ func (c *teamServiceClient) RemoveTeamMember(ctx context.Context, req *connect.R
 	return c.removeTeamMember.CallUnary(ctx, req)
 }
 
+// GetTeamDashboard calls mirai.v1.TeamService.GetTeamDashboard.
+func (c *teamServiceClient) GetTeamDashboard(ctx context.Context, req *connect.Request[v1.GetTeamDashboardRequest]) (*connect.Response[v1.GetTeamDashboardResponse], error) {
+	return c.getTeamDashboard.CallUnary(ctx, req)
+}
+
 // TeamServiceHandler is an implementation of the mirai.v1.TeamService service.
 type TeamServiceHandler interface {
 	// ListTeams returns all teams for the current user's company.
@@ -198,7 +216,7 @@ type TeamServiceHandler interface {
 	CreateTeam(context.Context, *connect.Request[v1.CreateTeamRequest]) (*connect.Response[v1.CreateTeamResponse], error)
 	// UpdateTeam updates team information.
 	UpdateTeam(context.Context, *connect.Request[v1.UpdateTeamRequest]) (*connect.Response[v1.UpdateTeamResponse], error)
-	// DeleteTeam deletes a team.
+	// DeleteTeam deletes a team, resolving team-scoped SMEs and open tasks that reference it.
 	DeleteTeam(context.Context, *connect.Request[v1.DeleteTeamRequest]) (*connect.Response[v1.DeleteTeamResponse], error)
 	// ListTeamMembers returns all members of a team.
 	ListTeamMembers(context.Context, *connect.Request[v1.ListTeamMembersRequest]) (*connect.Response[v1.ListTeamMembersResponse], error)
@@ -206,6 +224,9 @@ type TeamServiceHandler interface {
 	AddTeamMember(context.Context, *connect.Request[v1.AddTeamMemberRequest]) (*connect.Response[v1.AddTeamMemberResponse], error)
 	// RemoveTeamMember removes a user from a team.
 	RemoveTeamMember(context.Context, *connect.Request[v1.RemoveTeamMemberRequest]) (*connect.Response[v1.RemoveTeamMemberResponse], error)
+	// GetTeamDashboard summarizes a team's workload: open tasks, active jobs, recent courses
+	// and pending invitations. Team members and admins only.
+	GetTeamDashboard(context.Context, *connect.Request[v1.GetTeamDashboardRequest]) (*connect.Response[v1.GetTeamDashboardResponse], error)
 }
 
 // NewTeamServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -263,6 +284,12 @@ func NewTeamServiceHandler(svc TeamServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(teamServiceMethods.ByName("RemoveTeamMember")),
 		connect.WithHandlerOptions(opts...),
 	)
+	teamServiceGetTeamDashboardHandler := connect.NewUnaryHandler(
+		TeamServiceGetTeamDashboardProcedure,
+		svc.GetTeamDashboard,
+		connect.WithSchema(teamServiceMethods.ByName("GetTeamDashboard")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/mirai.v1.TeamService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case TeamServiceListTeamsProcedure:
@@ -281,6 +308,8 @@ func NewTeamServiceHandler(svc TeamServiceHandler, opts ...connect.HandlerOption
 			teamServiceAddTeamMemberHandler.ServeHTTP(w, r)
 		case TeamServiceRemoveTeamMemberProcedure:
 			teamServiceRemoveTeamMemberHandler.ServeHTTP(w, r)
+		case TeamServiceGetTeamDashboardProcedure:
+			teamServiceGetTeamDashboardHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -321,3 +350,7 @@ func (UnimplementedTeamServiceHandler) AddTeamMember(context.Context, *connect.R
 func (UnimplementedTeamServiceHandler) RemoveTeamMember(context.Context, *connect.Request[v1.RemoveTeamMemberRequest]) (*connect.Response[v1.RemoveTeamMemberResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.TeamService.RemoveTeamMember is not implemented"))
 }
+
+func (UnimplementedTeamServiceHandler) GetTeamDashboard(context.Context, *connect.Request[v1.GetTeamDashboardRequest]) (*connect.Response[v1.GetTeamDashboardResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.TeamService.GetTeamDashboard is not implemented"))
+}
@@ -48,6 +48,33 @@ const (
 	// TenantSettingsServiceGetUsageStatsProcedure is the fully-qualified name of the
 	// TenantSettingsService's GetUsageStats RPC.
 	TenantSettingsServiceGetUsageStatsProcedure = "/mirai.v1.TenantSettingsService/GetUsageStats"
+	// TenantSettingsServiceUpdateGenerationDefaultsProcedure is the fully-qualified name of the
+	// TenantSettingsService's UpdateGenerationDefaults RPC.
+	TenantSettingsServiceUpdateGenerationDefaultsProcedure = "/mirai.v1.TenantSettingsService/UpdateGenerationDefaults"
+	// TenantSettingsServiceUpdateOutlineAutoApproveProcedure is the fully-qualified name of the
+	// TenantSettingsService's UpdateOutlineAutoApprove RPC.
+	TenantSettingsServiceUpdateOutlineAutoApproveProcedure = "/mirai.v1.TenantSettingsService/UpdateOutlineAutoApprove"
+	// TenantSettingsServiceUpdateLocaleProcedure is the fully-qualified name of the
+	// TenantSettingsService's UpdateLocale RPC.
+	TenantSettingsServiceUpdateLocaleProcedure = "/mirai.v1.TenantSettingsService/UpdateLocale"
+	// TenantSettingsServiceGetCourseDefaultsProcedure is the fully-qualified name of the
+	// TenantSettingsService's GetCourseDefaults RPC.
+	TenantSettingsServiceGetCourseDefaultsProcedure = "/mirai.v1.TenantSettingsService/GetCourseDefaults"
+	// TenantSettingsServiceUpdateCourseDefaultsProcedure is the fully-qualified name of the
+	// TenantSettingsService's UpdateCourseDefaults RPC.
+	TenantSettingsServiceUpdateCourseDefaultsProcedure = "/mirai.v1.TenantSettingsService/UpdateCourseDefaults"
+	// TenantSettingsServiceUpdatePromptCacheProcedure is the fully-qualified name of the
+	// TenantSettingsService's UpdatePromptCache RPC.
+	TenantSettingsServiceUpdatePromptCacheProcedure = "/mirai.v1.TenantSettingsService/UpdatePromptCache"
+	// TenantSettingsServiceUpdateWeeklySummaryProcedure is the fully-qualified name of the
+	// TenantSettingsService's UpdateWeeklySummary RPC.
+	TenantSettingsServiceUpdateWeeklySummaryProcedure = "/mirai.v1.TenantSettingsService/UpdateWeeklySummary"
+	// TenantSettingsServiceUpdateSecondReviewerPolicyProcedure is the fully-qualified name of the
+	// TenantSettingsService's UpdateSecondReviewerPolicy RPC.
+	TenantSettingsServiceUpdateSecondReviewerPolicyProcedure = "/mirai.v1.TenantSettingsService/UpdateSecondReviewerPolicy"
+	// TenantSettingsServiceUpdateExportRetentionProcedure is the fully-qualified name of the
+	// TenantSettingsService's UpdateExportRetention RPC.
+	TenantSettingsServiceUpdateExportRetentionProcedure = "/mirai.v1.TenantSettingsService/UpdateExportRetention"
 )
 
 // TenantSettingsServiceClient is a client for the mirai.v1.TenantSettingsService service.
@@ -62,6 +89,29 @@ type TenantSettingsServiceClient interface {
 	TestAPIKey(context.Context, *connect.Request[v1.TestAPIKeyRequest]) (*connect.Response[v1.TestAPIKeyResponse], error)
 	// GetUsageStats returns AI usage statistics.
 	GetUsageStats(context.Context, *connect.Request[v1.GetUsageStatsRequest]) (*connect.Response[v1.GetUsageStatsResponse], error)
+	// UpdateGenerationDefaults sets the default component mix for new courses.
+	UpdateGenerationDefaults(context.Context, *connect.Request[v1.UpdateGenerationDefaultsRequest]) (*connect.Response[v1.UpdateGenerationDefaultsResponse], error)
+	// UpdateOutlineAutoApprove sets whether outlines may be approved automatically.
+	UpdateOutlineAutoApprove(context.Context, *connect.Request[v1.UpdateOutlineAutoApproveRequest]) (*connect.Response[v1.UpdateOutlineAutoApproveResponse], error)
+	// UpdateLocale sets the locale dates, durations and numbers are formatted in for the
+	// organization's emails and exports.
+	UpdateLocale(context.Context, *connect.Request[v1.UpdateLocaleRequest]) (*connect.Response[v1.UpdateLocaleResponse], error)
+	// GetCourseDefaults returns the settings new courses start with.
+	// Unlike the other methods, any member of the organization may call it.
+	GetCourseDefaults(context.Context, *connect.Request[v1.GetCourseDefaultsRequest]) (*connect.Response[v1.GetCourseDefaultsResponse], error)
+	// UpdateCourseDefaults replaces the settings new courses start with.
+	UpdateCourseDefaults(context.Context, *connect.Request[v1.UpdateCourseDefaultsRequest]) (*connect.Response[v1.UpdateCourseDefaultsResponse], error)
+	// UpdatePromptCache sets whether identical regeneration prompts may reuse a recent response.
+	UpdatePromptCache(context.Context, *connect.Request[v1.UpdatePromptCacheRequest]) (*connect.Response[v1.UpdatePromptCacheResponse], error)
+	// UpdateWeeklySummary turns the weekly summary email on or off and sets when it is sent.
+	UpdateWeeklySummary(context.Context, *connect.Request[v1.UpdateWeeklySummaryRequest]) (*connect.Response[v1.UpdateWeeklySummaryResponse], error)
+	// UpdateSecondReviewerPolicy sets whether outline approval requires a second reviewer.
+	// While it does, the user who generated an outline can't approve it, the first other
+	// reviewer's approval is recorded as an endorsement, and outline auto-approval is off.
+	UpdateSecondReviewerPolicy(context.Context, *connect.Request[v1.UpdateSecondReviewerPolicyRequest]) (*connect.Response[v1.UpdateSecondReviewerPolicyResponse], error)
+	// UpdateExportRetention sets how many days export files are kept before they are
+	// deleted, from 1 to 365. It applies to exports made from then on.
+	UpdateExportRetention(context.Context, *connect.Request[v1.UpdateExportRetentionRequest]) (*connect.Response[v1.UpdateExportRetentionResponse], error)
 }
 
 // NewTenantSettingsServiceClient constructs a client for the mirai.v1.TenantSettingsService
@@ -105,16 +155,79 @@ func NewTenantSettingsServiceClient(httpClient connect.HTTPClient, baseURL strin
 			connect.WithSchema(tenantSettingsServiceMethods.ByName("GetUsageStats")),
 			connect.WithClientOptions(opts...),
 		),
+		updateGenerationDefaults: connect.NewClient[v1.UpdateGenerationDefaultsRequest, v1.UpdateGenerationDefaultsResponse](
+			httpClient,
+			baseURL+TenantSettingsServiceUpdateGenerationDefaultsProcedure,
+			connect.WithSchema(tenantSettingsServiceMethods.ByName("UpdateGenerationDefaults")),
+			connect.WithClientOptions(opts...),
+		),
+		updateOutlineAutoApprove: connect.NewClient[v1.UpdateOutlineAutoApproveRequest, v1.UpdateOutlineAutoApproveResponse](
+			httpClient,
+			baseURL+TenantSettingsServiceUpdateOutlineAutoApproveProcedure,
+			connect.WithSchema(tenantSettingsServiceMethods.ByName("UpdateOutlineAutoApprove")),
+			connect.WithClientOptions(opts...),
+		),
+		updateLocale: connect.NewClient[v1.UpdateLocaleRequest, v1.UpdateLocaleResponse](
+			httpClient,
+			baseURL+TenantSettingsServiceUpdateLocaleProcedure,
+			connect.WithSchema(tenantSettingsServiceMethods.ByName("UpdateLocale")),
+			connect.WithClientOptions(opts...),
+		),
+		getCourseDefaults: connect.NewClient[v1.GetCourseDefaultsRequest, v1.GetCourseDefaultsResponse](
+			httpClient,
+			baseURL+TenantSettingsServiceGetCourseDefaultsProcedure,
+			connect.WithSchema(tenantSettingsServiceMethods.ByName("GetCourseDefaults")),
+			connect.WithClientOptions(opts...),
+		),
+		updateCourseDefaults: connect.NewClient[v1.UpdateCourseDefaultsRequest, v1.UpdateCourseDefaultsResponse](
+			httpClient,
+			baseURL+TenantSettingsServiceUpdateCourseDefaultsProcedure,
+			connect.WithSchema(tenantSettingsServiceMethods.ByName("UpdateCourseDefaults")),
+			connect.WithClientOptions(opts...),
+		),
+		updatePromptCache: connect.NewClient[v1.UpdatePromptCacheRequest, v1.UpdatePromptCacheResponse](
+			httpClient,
+			baseURL+TenantSettingsServiceUpdatePromptCacheProcedure,
+			connect.WithSchema(tenantSettingsServiceMethods.ByName("UpdatePromptCache")),
+			connect.WithClientOptions(opts...),
+		),
+		updateWeeklySummary: connect.NewClient[v1.UpdateWeeklySummaryRequest, v1.UpdateWeeklySummaryResponse](
+			httpClient,
+			baseURL+TenantSettingsServiceUpdateWeeklySummaryProcedure,
+			connect.WithSchema(tenantSettingsServiceMethods.ByName("UpdateWeeklySummary")),
+			connect.WithClientOptions(opts...),
+		),
+		updateSecondReviewerPolicy: connect.NewClient[v1.UpdateSecondReviewerPolicyRequest, v1.UpdateSecondReviewerPolicyResponse](
+			httpClient,
+			baseURL+TenantSettingsServiceUpdateSecondReviewerPolicyProcedure,
+			connect.WithSchema(tenantSettingsServiceMethods.ByName("UpdateSecondReviewerPolicy")),
+			connect.WithClientOptions(opts...),
+		),
+		updateExportRetention: connect.NewClient[v1.UpdateExportRetentionRequest, v1.UpdateExportRetentionResponse](
+			httpClient,
+			baseURL+TenantSettingsServiceUpdateExportRetentionProcedure,
+			connect.WithSchema(tenantSettingsServiceMethods.ByName("UpdateExportRetention")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // tenantSettingsServiceClient implements TenantSettingsServiceClient.
 type tenantSettingsServiceClient struct {
-	getAISettings *connect.Client[v1.GetAISettingsRequest, v1.GetAISettingsResponse]
-	setAPIKey     *connect.Client[v1.SetAPIKeyRequest, v1.SetAPIKeyResponse]
-	removeAPIKey  *connect.Client[v1.RemoveAPIKeyRequest, v1.RemoveAPIKeyResponse]
-	testAPIKey    *connect.Client[v1.TestAPIKeyRequest, v1.TestAPIKeyResponse]
-	getUsageStats *connect.Client[v1.GetUsageStatsRequest, v1.GetUsageStatsResponse]
+	getAISettings              *connect.Client[v1.GetAISettingsRequest, v1.GetAISettingsResponse]
+	setAPIKey                  *connect.Client[v1.SetAPIKeyRequest, v1.SetAPIKeyResponse]
+	removeAPIKey               *connect.Client[v1.RemoveAPIKeyRequest, v1.RemoveAPIKeyResponse]
+	testAPIKey                 *connect.Client[v1.TestAPIKeyRequest, v1.TestAPIKeyResponse]
+	getUsageStats              *connect.Client[v1.GetUsageStatsRequest, v1.GetUsageStatsResponse]
+	updateGenerationDefaults   *connect.Client[v1.UpdateGenerationDefaultsRequest, v1.UpdateGenerationDefaultsResponse]
+	updateOutlineAutoApprove   *connect.Client[v1.UpdateOutlineAutoApproveRequest, v1.UpdateOutlineAutoApproveResponse]
+	updateLocale               *connect.Client[v1.UpdateLocaleRequest, v1.UpdateLocaleResponse]
+	getCourseDefaults          *connect.Client[v1.GetCourseDefaultsRequest, v1.GetCourseDefaultsResponse]
+	updateCourseDefaults       *connect.Client[v1.UpdateCourseDefaultsRequest, v1.UpdateCourseDefaultsResponse]
+	updatePromptCache          *connect.Client[v1.UpdatePromptCacheRequest, v1.UpdatePromptCacheResponse]
+	updateWeeklySummary        *connect.Client[v1.UpdateWeeklySummaryRequest, v1.UpdateWeeklySummaryResponse]
+	updateSecondReviewerPolicy *connect.Client[v1.UpdateSecondReviewerPolicyRequest, v1.UpdateSecondReviewerPolicyResponse]
+	updateExportRetention      *connect.Client[v1.UpdateExportRetentionRequest, v1.UpdateExportRetentionResponse]
 }
 
 // GetAISettings calls mirai.v1.TenantSettingsService.GetAISettings.
@@ -142,6 +255,51 @@ func (c *tenantSettingsServiceClient) GetUsageStats(ctx context.Context, req *co
 	return c.getUsageStats.CallUnary(ctx, req)
 }
 
+// UpdateGenerationDefaults calls mirai.v1.TenantSettingsService.UpdateGenerationDefaults.
+func (c *tenantSettingsServiceClient) UpdateGenerationDefaults(ctx context.Context, req *connect.Request[v1.UpdateGenerationDefaultsRequest]) (*connect.Response[v1.UpdateGenerationDefaultsResponse], error) {
+	return c.updateGenerationDefaults.CallUnary(ctx, req)
+}
+
+// UpdateOutlineAutoApprove calls mirai.v1.TenantSettingsService.UpdateOutlineAutoApprove.
+func (c *tenantSettingsServiceClient) UpdateOutlineAutoApprove(ctx context.Context, req *connect.Request[v1.UpdateOutlineAutoApproveRequest]) (*connect.Response[v1.UpdateOutlineAutoApproveResponse], error) {
+	return c.updateOutlineAutoApprove.CallUnary(ctx, req)
+}
+
+// UpdateLocale calls mirai.v1.TenantSettingsService.UpdateLocale.
+func (c *tenantSettingsServiceClient) UpdateLocale(ctx context.Context, req *connect.Request[v1.UpdateLocaleRequest]) (*connect.Response[v1.UpdateLocaleResponse], error) {
+	return c.updateLocale.CallUnary(ctx, req)
+}
+
+// GetCourseDefaults calls mirai.v1.TenantSettingsService.GetCourseDefaults.
+func (c *tenantSettingsServiceClient) GetCourseDefaults(ctx context.Context, req *connect.Request[v1.GetCourseDefaultsRequest]) (*connect.Response[v1.GetCourseDefaultsResponse], error) {
+	return c.getCourseDefaults.CallUnary(ctx, req)
+}
+
+// UpdateCourseDefaults calls mirai.v1.TenantSettingsService.UpdateCourseDefaults.
+func (c *tenantSettingsServiceClient) UpdateCourseDefaults(ctx context.Context, req *connect.Request[v1.UpdateCourseDefaultsRequest]) (*connect.Response[v1.UpdateCourseDefaultsResponse], error) {
+	return c.updateCourseDefaults.CallUnary(ctx, req)
+}
+
+// UpdatePromptCache calls mirai.v1.TenantSettingsService.UpdatePromptCache.
+func (c *tenantSettingsServiceClient) UpdatePromptCache(ctx context.Context, req *connect.Request[v1.UpdatePromptCacheRequest]) (*connect.Response[v1.UpdatePromptCacheResponse], error) {
+	return c.updatePromptCache.CallUnary(ctx, req)
+}
+
+// UpdateWeeklySummary calls mirai.v1.TenantSettingsService.UpdateWeeklySummary.
+func (c *tenantSettingsServiceClient) UpdateWeeklySummary(ctx context.Context, req *connect.Request[v1.UpdateWeeklySummaryRequest]) (*connect.Response[v1.UpdateWeeklySummaryResponse], error) {
+	return c.updateWeeklySummary.CallUnary(ctx, req)
+}
+
+// UpdateSecondReviewerPolicy calls mirai.v1.TenantSettingsService.UpdateSecondReviewerPolicy.
+func (c *tenantSettingsServiceClient) UpdateSecondReviewerPolicy(ctx context.Context, req *connect.Request[v1.UpdateSecondReviewerPolicyRequest]) (*connect.Response[v1.UpdateSecondReviewerPolicyResponse], error) {
+	return c.updateSecondReviewerPolicy.CallUnary(ctx, req)
+}
+
+// UpdateExportRetention calls mirai.v1.TenantSettingsService.UpdateExportRetention.
+func (c *tenantSettingsServiceClient) UpdateExportRetention(ctx context.Context, req *connect.Request[v1.UpdateExportRetentionRequest]) (*connect.Response[v1.UpdateExportRetentionResponse], error) {
+	return c.updateExportRetention.CallUnary(ctx, req)
+}
+
 // TenantSettingsServiceHandler is an implementation of the mirai.v1.TenantSettingsService service.
 type TenantSettingsServiceHandler interface {
 	// GetAISettings returns the current AI configuration.
@@ -154,6 +312,29 @@ type TenantSettingsServiceHandler interface {
 	TestAPIKey(context.Context, *connect.Request[v1.TestAPIKeyRequest]) (*connect.Response[v1.TestAPIKeyResponse], error)
 	// GetUsageStats returns AI usage statistics.
 	GetUsageStats(context.Context, *connect.Request[v1.GetUsageStatsRequest]) (*connect.Response[v1.GetUsageStatsResponse], error)
+	// UpdateGenerationDefaults sets the default component mix for new courses.
+	UpdateGenerationDefaults(context.Context, *connect.Request[v1.UpdateGenerationDefaultsRequest]) (*connect.Response[v1.UpdateGenerationDefaultsResponse], error)
+	// UpdateOutlineAutoApprove sets whether outlines may be approved automatically.
+	UpdateOutlineAutoApprove(context.Context, *connect.Request[v1.UpdateOutlineAutoApproveRequest]) (*connect.Response[v1.UpdateOutlineAutoApproveResponse], error)
+	// UpdateLocale sets the locale dates, durations and numbers are formatted in for the
+	// organization's emails and exports.
+	UpdateLocale(context.Context, *connect.Request[v1.UpdateLocaleRequest]) (*connect.Response[v1.UpdateLocaleResponse], error)
+	// GetCourseDefaults returns the settings new courses start with.
+	// Unlike the other methods, any member of the organization may call it.
+	GetCourseDefaults(context.Context, *connect.Request[v1.GetCourseDefaultsRequest]) (*connect.Response[v1.GetCourseDefaultsResponse], error)
+	// UpdateCourseDefaults replaces the settings new courses start with.
+	UpdateCourseDefaults(context.Context, *connect.Request[v1.UpdateCourseDefaultsRequest]) (*connect.Response[v1.UpdateCourseDefaultsResponse], error)
+	// UpdatePromptCache sets whether identical regeneration prompts may reuse a recent response.
+	UpdatePromptCache(context.Context, *connect.Request[v1.UpdatePromptCacheRequest]) (*connect.Response[v1.UpdatePromptCacheResponse], error)
+	// UpdateWeeklySummary turns the weekly summary email on or off and sets when it is sent.
+	UpdateWeeklySummary(context.Context, *connect.Request[v1.UpdateWeeklySummaryRequest]) (*connect.Response[v1.UpdateWeeklySummaryResponse], error)
+	// UpdateSecondReviewerPolicy sets whether outline approval requires a second reviewer.
+	// While it does, the user who generated an outline can't approve it, the first other
+	// reviewer's approval is recorded as an endorsement, and outline auto-approval is off.
+	UpdateSecondReviewerPolicy(context.Context, *connect.Request[v1.UpdateSecondReviewerPolicyRequest]) (*connect.Response[v1.UpdateSecondReviewerPolicyResponse], error)
+	// UpdateExportRetention sets how many days export files are kept before they are
+	// deleted, from 1 to 365. It applies to exports made from then on.
+	UpdateExportRetention(context.Context, *connect.Request[v1.UpdateExportRetentionRequest]) (*connect.Response[v1.UpdateExportRetentionResponse], error)
 }
 
 // NewTenantSettingsServiceHandler builds an HTTP handler from the service implementation. It
@@ -193,6 +374,60 @@ func NewTenantSettingsServiceHandler(svc TenantSettingsServiceHandler, opts ...c
 		connect.WithSchema(tenantSettingsServiceMethods.ByName("GetUsageStats")),
 		connect.WithHandlerOptions(opts...),
 	)
+	tenantSettingsServiceUpdateGenerationDefaultsHandler := connect.NewUnaryHandler(
+		TenantSettingsServiceUpdateGenerationDefaultsProcedure,
+		svc.UpdateGenerationDefaults,
+		connect.WithSchema(tenantSettingsServiceMethods.ByName("UpdateGenerationDefaults")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tenantSettingsServiceUpdateOutlineAutoApproveHandler := connect.NewUnaryHandler(
+		TenantSettingsServiceUpdateOutlineAutoApproveProcedure,
+		svc.UpdateOutlineAutoApprove,
+		connect.WithSchema(tenantSettingsServiceMethods.ByName("UpdateOutlineAutoApprove")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tenantSettingsServiceUpdateLocaleHandler := connect.NewUnaryHandler(
+		TenantSettingsServiceUpdateLocaleProcedure,
+		svc.UpdateLocale,
+		connect.WithSchema(tenantSettingsServiceMethods.ByName("UpdateLocale")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tenantSettingsServiceGetCourseDefaultsHandler := connect.NewUnaryHandler(
+		TenantSettingsServiceGetCourseDefaultsProcedure,
+		svc.GetCourseDefaults,
+		connect.WithSchema(tenantSettingsServiceMethods.ByName("GetCourseDefaults")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tenantSettingsServiceUpdateCourseDefaultsHandler := connect.NewUnaryHandler(
+		TenantSettingsServiceUpdateCourseDefaultsProcedure,
+		svc.UpdateCourseDefaults,
+		connect.WithSchema(tenantSettingsServiceMethods.ByName("UpdateCourseDefaults")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tenantSettingsServiceUpdatePromptCacheHandler := connect.NewUnaryHandler(
+		TenantSettingsServiceUpdatePromptCacheProcedure,
+		svc.UpdatePromptCache,
+		connect.WithSchema(tenantSettingsServiceMethods.ByName("UpdatePromptCache")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tenantSettingsServiceUpdateWeeklySummaryHandler := connect.NewUnaryHandler(
+		TenantSettingsServiceUpdateWeeklySummaryProcedure,
+		svc.UpdateWeeklySummary,
+		connect.WithSchema(tenantSettingsServiceMethods.ByName("UpdateWeeklySummary")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tenantSettingsServiceUpdateSecondReviewerPolicyHandler := connect.NewUnaryHandler(
+		TenantSettingsServiceUpdateSecondReviewerPolicyProcedure,
+		svc.UpdateSecondReviewerPolicy,
+		connect.WithSchema(tenantSettingsServiceMethods.ByName("UpdateSecondReviewerPolicy")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tenantSettingsServiceUpdateExportRetentionHandler := connect.NewUnaryHandler(
+		TenantSettingsServiceUpdateExportRetentionProcedure,
+		svc.UpdateExportRetention,
+		connect.WithSchema(tenantSettingsServiceMethods.ByName("UpdateExportRetention")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/mirai.v1.TenantSettingsService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case TenantSettingsServiceGetAISettingsProcedure:
@@ -205,6 +440,24 @@ func NewTenantSettingsServiceHandler(svc TenantSettingsServiceHandler, opts ...c
 			tenantSettingsServiceTestAPIKeyHandler.ServeHTTP(w, r)
 		case TenantSettingsServiceGetUsageStatsProcedure:
 			tenantSettingsServiceGetUsageStatsHandler.ServeHTTP(w, r)
+		case TenantSettingsServiceUpdateGenerationDefaultsProcedure:
+			tenantSettingsServiceUpdateGenerationDefaultsHandler.ServeHTTP(w, r)
+		case TenantSettingsServiceUpdateOutlineAutoApproveProcedure:
+			tenantSettingsServiceUpdateOutlineAutoApproveHandler.ServeHTTP(w, r)
+		case TenantSettingsServiceUpdateLocaleProcedure:
+			tenantSettingsServiceUpdateLocaleHandler.ServeHTTP(w, r)
+		case TenantSettingsServiceGetCourseDefaultsProcedure:
+			tenantSettingsServiceGetCourseDefaultsHandler.ServeHTTP(w, r)
+		case TenantSettingsServiceUpdateCourseDefaultsProcedure:
+			tenantSettingsServiceUpdateCourseDefaultsHandler.ServeHTTP(w, r)
+		case TenantSettingsServiceUpdatePromptCacheProcedure:
+			tenantSettingsServiceUpdatePromptCacheHandler.ServeHTTP(w, r)
+		case TenantSettingsServiceUpdateWeeklySummaryProcedure:
+			tenantSettingsServiceUpdateWeeklySummaryHandler.ServeHTTP(w, r)
+		case TenantSettingsServiceUpdateSecondReviewerPolicyProcedure:
+			tenantSettingsServiceUpdateSecondReviewerPolicyHandler.ServeHTTP(w, r)
+		case TenantSettingsServiceUpdateExportRetentionProcedure:
+			tenantSettingsServiceUpdateExportRetentionHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -233,3 +486,39 @@ func (UnimplementedTenantSettingsServiceHandler) TestAPIKey(context.Context, *co
 func (UnimplementedTenantSettingsServiceHandler) GetUsageStats(context.Context, *connect.Request[v1.GetUsageStatsRequest]) (*connect.Response[v1.GetUsageStatsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.TenantSettingsService.GetUsageStats is not implemented"))
 }
+
+func (UnimplementedTenantSettingsServiceHandler) UpdateGenerationDefaults(context.Context, *connect.Request[v1.UpdateGenerationDefaultsRequest]) (*connect.Response[v1.UpdateGenerationDefaultsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.TenantSettingsService.UpdateGenerationDefaults is not implemented"))
+}
+
+func (UnimplementedTenantSettingsServiceHandler) UpdateOutlineAutoApprove(context.Context, *connect.Request[v1.UpdateOutlineAutoApproveRequest]) (*connect.Response[v1.UpdateOutlineAutoApproveResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.TenantSettingsService.UpdateOutlineAutoApprove is not implemented"))
+}
+
+func (UnimplementedTenantSettingsServiceHandler) UpdateLocale(context.Context, *connect.Request[v1.UpdateLocaleRequest]) (*connect.Response[v1.UpdateLocaleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.TenantSettingsService.UpdateLocale is not implemented"))
+}
+
+func (UnimplementedTenantSettingsServiceHandler) GetCourseDefaults(context.Context, *connect.Request[v1.GetCourseDefaultsRequest]) (*connect.Response[v1.GetCourseDefaultsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.TenantSettingsService.GetCourseDefaults is not implemented"))
+}
+
+func (UnimplementedTenantSettingsServiceHandler) UpdateCourseDefaults(context.Context, *connect.Request[v1.UpdateCourseDefaultsRequest]) (*connect.Response[v1.UpdateCourseDefaultsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.TenantSettingsService.UpdateCourseDefaults is not implemented"))
+}
+
+func (UnimplementedTenantSettingsServiceHandler) UpdatePromptCache(context.Context, *connect.Request[v1.UpdatePromptCacheRequest]) (*connect.Response[v1.UpdatePromptCacheResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.TenantSettingsService.UpdatePromptCache is not implemented"))
+}
+
+func (UnimplementedTenantSettingsServiceHandler) UpdateWeeklySummary(context.Context, *connect.Request[v1.UpdateWeeklySummaryRequest]) (*connect.Response[v1.UpdateWeeklySummaryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.TenantSettingsService.UpdateWeeklySummary is not implemented"))
+}
+
+func (UnimplementedTenantSettingsServiceHandler) UpdateSecondReviewerPolicy(context.Context, *connect.Request[v1.UpdateSecondReviewerPolicyRequest]) (*connect.Response[v1.UpdateSecondReviewerPolicyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.TenantSettingsService.UpdateSecondReviewerPolicy is not implemented"))
+}
+
+func (UnimplementedTenantSettingsServiceHandler) UpdateExportRetention(context.Context, *connect.Request[v1.UpdateExportRetentionRequest]) (*connect.Response[v1.UpdateExportRetentionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.TenantSettingsService.UpdateExportRetention is not implemented"))
+}
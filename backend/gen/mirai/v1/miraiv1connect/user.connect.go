@@ -42,6 +42,30 @@ const (
 	// UserServiceListCompanyUsersProcedure is the fully-qualified name of the UserService's
 	// ListCompanyUsers RPC.
 	UserServiceListCompanyUsersProcedure = "/mirai.v1.UserService/ListCompanyUsers"
+	// UserServiceDeactivateUserProcedure is the fully-qualified name of the UserService's
+	// DeactivateUser RPC.
+	UserServiceDeactivateUserProcedure = "/mirai.v1.UserService/DeactivateUser"
+	// UserServiceReactivateUserProcedure is the fully-qualified name of the UserService's
+	// ReactivateUser RPC.
+	UserServiceReactivateUserProcedure = "/mirai.v1.UserService/ReactivateUser"
+	// UserServiceGetOnboardingStatusProcedure is the fully-qualified name of the UserService's
+	// GetOnboardingStatus RPC.
+	UserServiceGetOnboardingStatusProcedure = "/mirai.v1.UserService/GetOnboardingStatus"
+	// UserServiceDismissOnboardingChecklistProcedure is the fully-qualified name of the UserService's
+	// DismissOnboardingChecklist RPC.
+	UserServiceDismissOnboardingChecklistProcedure = "/mirai.v1.UserService/DismissOnboardingChecklist"
+	// UserServiceUpdateEmailPreferencesProcedure is the fully-qualified name of the UserService's
+	// UpdateEmailPreferences RPC.
+	UserServiceUpdateEmailPreferencesProcedure = "/mirai.v1.UserService/UpdateEmailPreferences"
+	// UserServiceCreateAPITokenProcedure is the fully-qualified name of the UserService's
+	// CreateAPIToken RPC.
+	UserServiceCreateAPITokenProcedure = "/mirai.v1.UserService/CreateAPIToken"
+	// UserServiceListAPITokensProcedure is the fully-qualified name of the UserService's ListAPITokens
+	// RPC.
+	UserServiceListAPITokensProcedure = "/mirai.v1.UserService/ListAPITokens"
+	// UserServiceRevokeAPITokenProcedure is the fully-qualified name of the UserService's
+	// RevokeAPIToken RPC.
+	UserServiceRevokeAPITokenProcedure = "/mirai.v1.UserService/RevokeAPIToken"
 )
 
 // UserServiceClient is a client for the mirai.v1.UserService service.
@@ -54,6 +78,25 @@ type UserServiceClient interface {
 	UpdateUser(context.Context, *connect.Request[v1.UpdateUserRequest]) (*connect.Response[v1.UpdateUserResponse], error)
 	// ListCompanyUsers returns all users in the current user's company.
 	ListCompanyUsers(context.Context, *connect.Request[v1.ListCompanyUsersRequest]) (*connect.Response[v1.ListCompanyUsersResponse], error)
+	// DeactivateUser blocks a user's sign-in and frees their seat. Their open SME tasks
+	// and in-flight generation jobs are reassigned; content they authored is kept.
+	DeactivateUser(context.Context, *connect.Request[v1.DeactivateUserRequest]) (*connect.Response[v1.DeactivateUserResponse], error)
+	// ReactivateUser restores sign-in for a deactivated user if a seat is available.
+	ReactivateUser(context.Context, *connect.Request[v1.ReactivateUserRequest]) (*connect.Response[v1.ReactivateUserResponse], error)
+	// GetOnboardingStatus returns the onboarding checklist, with each step's completion
+	// derived from the organization's data, and whether the caller has hidden it.
+	GetOnboardingStatus(context.Context, *connect.Request[v1.GetOnboardingStatusRequest]) (*connect.Response[v1.GetOnboardingStatusResponse], error)
+	// DismissOnboardingChecklist hides the onboarding checklist for the caller for good.
+	DismissOnboardingChecklist(context.Context, *connect.Request[v1.DismissOnboardingChecklistRequest]) (*connect.Response[v1.DismissOnboardingChecklistResponse], error)
+	// UpdateEmailPreferences sets which optional emails the caller receives.
+	UpdateEmailPreferences(context.Context, *connect.Request[v1.UpdateEmailPreferencesRequest]) (*connect.Response[v1.UpdateEmailPreferencesResponse], error)
+	// CreateAPIToken creates a personal API token acting as the caller. The secret is
+	// returned once and can't be retrieved again.
+	CreateAPIToken(context.Context, *connect.Request[v1.CreateAPITokenRequest]) (*connect.Response[v1.CreateAPITokenResponse], error)
+	// ListAPITokens lists the caller's API tokens, newest first.
+	ListAPITokens(context.Context, *connect.Request[v1.ListAPITokensRequest]) (*connect.Response[v1.ListAPITokensResponse], error)
+	// RevokeAPIToken revokes one of the caller's API tokens.
+	RevokeAPIToken(context.Context, *connect.Request[v1.RevokeAPITokenRequest]) (*connect.Response[v1.RevokeAPITokenResponse], error)
 }
 
 // NewUserServiceClient constructs a client for the mirai.v1.UserService service. By default, it
@@ -91,15 +134,71 @@ func NewUserServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(userServiceMethods.ByName("ListCompanyUsers")),
 			connect.WithClientOptions(opts...),
 		),
+		deactivateUser: connect.NewClient[v1.DeactivateUserRequest, v1.DeactivateUserResponse](
+			httpClient,
+			baseURL+UserServiceDeactivateUserProcedure,
+			connect.WithSchema(userServiceMethods.ByName("DeactivateUser")),
+			connect.WithClientOptions(opts...),
+		),
+		reactivateUser: connect.NewClient[v1.ReactivateUserRequest, v1.ReactivateUserResponse](
+			httpClient,
+			baseURL+UserServiceReactivateUserProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ReactivateUser")),
+			connect.WithClientOptions(opts...),
+		),
+		getOnboardingStatus: connect.NewClient[v1.GetOnboardingStatusRequest, v1.GetOnboardingStatusResponse](
+			httpClient,
+			baseURL+UserServiceGetOnboardingStatusProcedure,
+			connect.WithSchema(userServiceMethods.ByName("GetOnboardingStatus")),
+			connect.WithClientOptions(opts...),
+		),
+		dismissOnboardingChecklist: connect.NewClient[v1.DismissOnboardingChecklistRequest, v1.DismissOnboardingChecklistResponse](
+			httpClient,
+			baseURL+UserServiceDismissOnboardingChecklistProcedure,
+			connect.WithSchema(userServiceMethods.ByName("DismissOnboardingChecklist")),
+			connect.WithClientOptions(opts...),
+		),
+		updateEmailPreferences: connect.NewClient[v1.UpdateEmailPreferencesRequest, v1.UpdateEmailPreferencesResponse](
+			httpClient,
+			baseURL+UserServiceUpdateEmailPreferencesProcedure,
+			connect.WithSchema(userServiceMethods.ByName("UpdateEmailPreferences")),
+			connect.WithClientOptions(opts...),
+		),
+		createAPIToken: connect.NewClient[v1.CreateAPITokenRequest, v1.CreateAPITokenResponse](
+			httpClient,
+			baseURL+UserServiceCreateAPITokenProcedure,
+			connect.WithSchema(userServiceMethods.ByName("CreateAPIToken")),
+			connect.WithClientOptions(opts...),
+		),
+		listAPITokens: connect.NewClient[v1.ListAPITokensRequest, v1.ListAPITokensResponse](
+			httpClient,
+			baseURL+UserServiceListAPITokensProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ListAPITokens")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeAPIToken: connect.NewClient[v1.RevokeAPITokenRequest, v1.RevokeAPITokenResponse](
+			httpClient,
+			baseURL+UserServiceRevokeAPITokenProcedure,
+			connect.WithSchema(userServiceMethods.ByName("RevokeAPIToken")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // userServiceClient implements UserServiceClient.
 type userServiceClient struct {
-	getMe            *connect.Client[v1.GetMeRequest, v1.GetMeResponse]
-	getUser          *connect.Client[v1.GetUserRequest, v1.GetUserResponse]
-	updateUser       *connect.Client[v1.UpdateUserRequest, v1.UpdateUserResponse]
-	listCompanyUsers *connect.Client[v1.ListCompanyUsersRequest, v1.ListCompanyUsersResponse]
+	getMe                      *connect.Client[v1.GetMeRequest, v1.GetMeResponse]
+	getUser                    *connect.Client[v1.GetUserRequest, v1.GetUserResponse]
+	updateUser                 *connect.Client[v1.UpdateUserRequest, v1.UpdateUserResponse]
+	listCompanyUsers           *connect.Client[v1.ListCompanyUsersRequest, v1.ListCompanyUsersResponse]
+	deactivateUser             *connect.Client[v1.DeactivateUserRequest, v1.DeactivateUserResponse]
+	reactivateUser             *connect.Client[v1.ReactivateUserRequest, v1.ReactivateUserResponse]
+	getOnboardingStatus        *connect.Client[v1.GetOnboardingStatusRequest, v1.GetOnboardingStatusResponse]
+	dismissOnboardingChecklist *connect.Client[v1.DismissOnboardingChecklistRequest, v1.DismissOnboardingChecklistResponse]
+	updateEmailPreferences     *connect.Client[v1.UpdateEmailPreferencesRequest, v1.UpdateEmailPreferencesResponse]
+	createAPIToken             *connect.Client[v1.CreateAPITokenRequest, v1.CreateAPITokenResponse]
+	listAPITokens              *connect.Client[v1.ListAPITokensRequest, v1.ListAPITokensResponse]
+	revokeAPIToken             *connect.Client[v1.RevokeAPITokenRequest, v1.RevokeAPITokenResponse]
 }
 
 // GetMe calls mirai.v1.UserService.GetMe.
@@ -122,6 +221,46 @@ func (c *userServiceClient) ListCompanyUsers(ctx context.Context, req *connect.R
 	return c.listCompanyUsers.CallUnary(ctx, req)
 }
 
+// DeactivateUser calls mirai.v1.UserService.DeactivateUser.
+func (c *userServiceClient) DeactivateUser(ctx context.Context, req *connect.Request[v1.DeactivateUserRequest]) (*connect.Response[v1.DeactivateUserResponse], error) {
+	return c.deactivateUser.CallUnary(ctx, req)
+}
+
+// ReactivateUser calls mirai.v1.UserService.ReactivateUser.
+func (c *userServiceClient) ReactivateUser(ctx context.Context, req *connect.Request[v1.ReactivateUserRequest]) (*connect.Response[v1.ReactivateUserResponse], error) {
+	return c.reactivateUser.CallUnary(ctx, req)
+}
+
+// GetOnboardingStatus calls mirai.v1.UserService.GetOnboardingStatus.
+func (c *userServiceClient) GetOnboardingStatus(ctx context.Context, req *connect.Request[v1.GetOnboardingStatusRequest]) (*connect.Response[v1.GetOnboardingStatusResponse], error) {
+	return c.getOnboardingStatus.CallUnary(ctx, req)
+}
+
+// DismissOnboardingChecklist calls mirai.v1.UserService.DismissOnboardingChecklist.
+func (c *userServiceClient) DismissOnboardingChecklist(ctx context.Context, req *connect.Request[v1.DismissOnboardingChecklistRequest]) (*connect.Response[v1.DismissOnboardingChecklistResponse], error) {
+	return c.dismissOnboardingChecklist.CallUnary(ctx, req)
+}
+
+// UpdateEmailPreferences calls mirai.v1.UserService.UpdateEmailPreferences.
+func (c *userServiceClient) UpdateEmailPreferences(ctx context.Context, req *connect.Request[v1.UpdateEmailPreferencesRequest]) (*connect.Response[v1.UpdateEmailPreferencesResponse], error) {
+	return c.updateEmailPreferences.CallUnary(ctx, req)
+}
+
+// CreateAPIToken calls mirai.v1.UserService.CreateAPIToken.
+func (c *userServiceClient) CreateAPIToken(ctx context.Context, req *connect.Request[v1.CreateAPITokenRequest]) (*connect.Response[v1.CreateAPITokenResponse], error) {
+	return c.createAPIToken.CallUnary(ctx, req)
+}
+
+// ListAPITokens calls mirai.v1.UserService.ListAPITokens.
+func (c *userServiceClient) ListAPITokens(ctx context.Context, req *connect.Request[v1.ListAPITokensRequest]) (*connect.Response[v1.ListAPITokensResponse], error) {
+	return c.listAPITokens.CallUnary(ctx, req)
+}
+
+// RevokeAPIToken calls mirai.v1.UserService.RevokeAPIToken.
+func (c *userServiceClient) RevokeAPIToken(ctx context.Context, req *connect.Request[v1.RevokeAPITokenRequest]) (*connect.Response[v1.RevokeAPITokenResponse], error) {
+	return c.revokeAPIToken.CallUnary(ctx, req)
+}
+
 // UserServiceHandler is an implementation of the mirai.v1.UserService service.
 type UserServiceHandler interface {
 	// GetMe returns the currently authenticated user with their company.
@@ -132,6 +271,25 @@ type UserServiceHandler interface {
 	UpdateUser(context.Context, *connect.Request[v1.UpdateUserRequest]) (*connect.Response[v1.UpdateUserResponse], error)
 	// ListCompanyUsers returns all users in the current user's company.
 	ListCompanyUsers(context.Context, *connect.Request[v1.ListCompanyUsersRequest]) (*connect.Response[v1.ListCompanyUsersResponse], error)
+	// DeactivateUser blocks a user's sign-in and frees their seat. Their open SME tasks
+	// and in-flight generation jobs are reassigned; content they authored is kept.
+	DeactivateUser(context.Context, *connect.Request[v1.DeactivateUserRequest]) (*connect.Response[v1.DeactivateUserResponse], error)
+	// ReactivateUser restores sign-in for a deactivated user if a seat is available.
+	ReactivateUser(context.Context, *connect.Request[v1.ReactivateUserRequest]) (*connect.Response[v1.ReactivateUserResponse], error)
+	// GetOnboardingStatus returns the onboarding checklist, with each step's completion
+	// derived from the organization's data, and whether the caller has hidden it.
+	GetOnboardingStatus(context.Context, *connect.Request[v1.GetOnboardingStatusRequest]) (*connect.Response[v1.GetOnboardingStatusResponse], error)
+	// DismissOnboardingChecklist hides the onboarding checklist for the caller for good.
+	DismissOnboardingChecklist(context.Context, *connect.Request[v1.DismissOnboardingChecklistRequest]) (*connect.Response[v1.DismissOnboardingChecklistResponse], error)
+	// UpdateEmailPreferences sets which optional emails the caller receives.
+	UpdateEmailPreferences(context.Context, *connect.Request[v1.UpdateEmailPreferencesRequest]) (*connect.Response[v1.UpdateEmailPreferencesResponse], error)
+	// CreateAPIToken creates a personal API token acting as the caller. The secret is
+	// returned once and can't be retrieved again.
+	CreateAPIToken(context.Context, *connect.Request[v1.CreateAPITokenRequest]) (*connect.Response[v1.CreateAPITokenResponse], error)
+	// ListAPITokens lists the caller's API tokens, newest first.
+	ListAPITokens(context.Context, *connect.Request[v1.ListAPITokensRequest]) (*connect.Response[v1.ListAPITokensResponse], error)
+	// RevokeAPIToken revokes one of the caller's API tokens.
+	RevokeAPIToken(context.Context, *connect.Request[v1.RevokeAPITokenRequest]) (*connect.Response[v1.RevokeAPITokenResponse], error)
 }
 
 // NewUserServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -165,6 +323,54 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(userServiceMethods.ByName("ListCompanyUsers")),
 		connect.WithHandlerOptions(opts...),
 	)
+	userServiceDeactivateUserHandler := connect.NewUnaryHandler(
+		UserServiceDeactivateUserProcedure,
+		svc.DeactivateUser,
+		connect.WithSchema(userServiceMethods.ByName("DeactivateUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceReactivateUserHandler := connect.NewUnaryHandler(
+		UserServiceReactivateUserProcedure,
+		svc.ReactivateUser,
+		connect.WithSchema(userServiceMethods.ByName("ReactivateUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceGetOnboardingStatusHandler := connect.NewUnaryHandler(
+		UserServiceGetOnboardingStatusProcedure,
+		svc.GetOnboardingStatus,
+		connect.WithSchema(userServiceMethods.ByName("GetOnboardingStatus")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceDismissOnboardingChecklistHandler := connect.NewUnaryHandler(
+		UserServiceDismissOnboardingChecklistProcedure,
+		svc.DismissOnboardingChecklist,
+		connect.WithSchema(userServiceMethods.ByName("DismissOnboardingChecklist")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceUpdateEmailPreferencesHandler := connect.NewUnaryHandler(
+		UserServiceUpdateEmailPreferencesProcedure,
+		svc.UpdateEmailPreferences,
+		connect.WithSchema(userServiceMethods.ByName("UpdateEmailPreferences")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceCreateAPITokenHandler := connect.NewUnaryHandler(
+		UserServiceCreateAPITokenProcedure,
+		svc.CreateAPIToken,
+		connect.WithSchema(userServiceMethods.ByName("CreateAPIToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceListAPITokensHandler := connect.NewUnaryHandler(
+		UserServiceListAPITokensProcedure,
+		svc.ListAPITokens,
+		connect.WithSchema(userServiceMethods.ByName("ListAPITokens")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceRevokeAPITokenHandler := connect.NewUnaryHandler(
+		UserServiceRevokeAPITokenProcedure,
+		svc.RevokeAPIToken,
+		connect.WithSchema(userServiceMethods.ByName("RevokeAPIToken")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/mirai.v1.UserService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case UserServiceGetMeProcedure:
@@ -175,6 +381,22 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 			userServiceUpdateUserHandler.ServeHTTP(w, r)
 		case UserServiceListCompanyUsersProcedure:
 			userServiceListCompanyUsersHandler.ServeHTTP(w, r)
+		case UserServiceDeactivateUserProcedure:
+			userServiceDeactivateUserHandler.ServeHTTP(w, r)
+		case UserServiceReactivateUserProcedure:
+			userServiceReactivateUserHandler.ServeHTTP(w, r)
+		case UserServiceGetOnboardingStatusProcedure:
+			userServiceGetOnboardingStatusHandler.ServeHTTP(w, r)
+		case UserServiceDismissOnboardingChecklistProcedure:
+			userServiceDismissOnboardingChecklistHandler.ServeHTTP(w, r)
+		case UserServiceUpdateEmailPreferencesProcedure:
+			userServiceUpdateEmailPreferencesHandler.ServeHTTP(w, r)
+		case UserServiceCreateAPITokenProcedure:
+			userServiceCreateAPITokenHandler.ServeHTTP(w, r)
+		case UserServiceListAPITokensProcedure:
+			userServiceListAPITokensHandler.ServeHTTP(w, r)
+		case UserServiceRevokeAPITokenProcedure:
+			userServiceRevokeAPITokenHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -199,3 +421,35 @@ func (UnimplementedUserServiceHandler) UpdateUser(context.Context, *connect.Requ
 func (UnimplementedUserServiceHandler) ListCompanyUsers(context.Context, *connect.Request[v1.ListCompanyUsersRequest]) (*connect.Response[v1.ListCompanyUsersResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.UserService.ListCompanyUsers is not implemented"))
 }
+
+func (UnimplementedUserServiceHandler) DeactivateUser(context.Context, *connect.Request[v1.DeactivateUserRequest]) (*connect.Response[v1.DeactivateUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.UserService.DeactivateUser is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ReactivateUser(context.Context, *connect.Request[v1.ReactivateUserRequest]) (*connect.Response[v1.ReactivateUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.UserService.ReactivateUser is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) GetOnboardingStatus(context.Context, *connect.Request[v1.GetOnboardingStatusRequest]) (*connect.Response[v1.GetOnboardingStatusResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.UserService.GetOnboardingStatus is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) DismissOnboardingChecklist(context.Context, *connect.Request[v1.DismissOnboardingChecklistRequest]) (*connect.Response[v1.DismissOnboardingChecklistResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.UserService.DismissOnboardingChecklist is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) UpdateEmailPreferences(context.Context, *connect.Request[v1.UpdateEmailPreferencesRequest]) (*connect.Response[v1.UpdateEmailPreferencesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.UserService.UpdateEmailPreferences is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) CreateAPIToken(context.Context, *connect.Request[v1.CreateAPITokenRequest]) (*connect.Response[v1.CreateAPITokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.UserService.CreateAPIToken is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ListAPITokens(context.Context, *connect.Request[v1.ListAPITokensRequest]) (*connect.Response[v1.ListAPITokensResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.UserService.ListAPITokens is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) RevokeAPIToken(context.Context, *connect.Request[v1.RevokeAPITokenRequest]) (*connect.Response[v1.RevokeAPITokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.UserService.RevokeAPIToken is not implemented"))
+}
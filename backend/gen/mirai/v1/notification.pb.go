@@ -27,28 +27,36 @@ type NotificationType int32
 
 const (
 	NotificationType_NOTIFICATION_TYPE_UNSPECIFIED         NotificationType = 0
-	NotificationType_NOTIFICATION_TYPE_TASK_ASSIGNED       NotificationType = 1 // SME task assigned to user
-	NotificationType_NOTIFICATION_TYPE_TASK_DUE_SOON       NotificationType = 2 // Task due date approaching
-	NotificationType_NOTIFICATION_TYPE_INGESTION_COMPLETE  NotificationType = 3 // SME content ingestion finished
-	NotificationType_NOTIFICATION_TYPE_INGESTION_FAILED    NotificationType = 4 // SME content ingestion failed
-	NotificationType_NOTIFICATION_TYPE_OUTLINE_READY       NotificationType = 5 // Course outline generation complete
-	NotificationType_NOTIFICATION_TYPE_GENERATION_COMPLETE NotificationType = 6 // Course content generation complete
-	NotificationType_NOTIFICATION_TYPE_GENERATION_FAILED   NotificationType = 7 // Course generation failed
-	NotificationType_NOTIFICATION_TYPE_APPROVAL_REQUESTED  NotificationType = 8 // Content awaiting approval
+	NotificationType_NOTIFICATION_TYPE_TASK_ASSIGNED       NotificationType = 1  // SME task assigned to user
+	NotificationType_NOTIFICATION_TYPE_TASK_DUE_SOON       NotificationType = 2  // Task due date approaching
+	NotificationType_NOTIFICATION_TYPE_INGESTION_COMPLETE  NotificationType = 3  // SME content ingestion finished
+	NotificationType_NOTIFICATION_TYPE_INGESTION_FAILED    NotificationType = 4  // SME content ingestion failed
+	NotificationType_NOTIFICATION_TYPE_OUTLINE_READY       NotificationType = 5  // Course outline generation complete
+	NotificationType_NOTIFICATION_TYPE_GENERATION_COMPLETE NotificationType = 6  // Course content generation complete
+	NotificationType_NOTIFICATION_TYPE_GENERATION_FAILED   NotificationType = 7  // Course generation failed
+	NotificationType_NOTIFICATION_TYPE_APPROVAL_REQUESTED  NotificationType = 8  // Content awaiting approval
+	NotificationType_NOTIFICATION_TYPE_COLLABORATOR_ADDED  NotificationType = 9  // User added as a course collaborator
+	NotificationType_NOTIFICATION_TYPE_EXPORT_READY        NotificationType = 10 // Requested export is ready to download
+	NotificationType_NOTIFICATION_TYPE_OUTLINE_COMMENT     NotificationType = 11 // Someone commented on a course outline lesson
+	NotificationType_NOTIFICATION_TYPE_LESSONS_ORPHANED    NotificationType = 12 // Generated lessons were removed from a course with their outline lessons
 )
 
 // Enum value maps for NotificationType.
 var (
 	NotificationType_name = map[int32]string{
-		0: "NOTIFICATION_TYPE_UNSPECIFIED",
-		1: "NOTIFICATION_TYPE_TASK_ASSIGNED",
-		2: "NOTIFICATION_TYPE_TASK_DUE_SOON",
-		3: "NOTIFICATION_TYPE_INGESTION_COMPLETE",
-		4: "NOTIFICATION_TYPE_INGESTION_FAILED",
-		5: "NOTIFICATION_TYPE_OUTLINE_READY",
-		6: "NOTIFICATION_TYPE_GENERATION_COMPLETE",
-		7: "NOTIFICATION_TYPE_GENERATION_FAILED",
-		8: "NOTIFICATION_TYPE_APPROVAL_REQUESTED",
+		0:  "NOTIFICATION_TYPE_UNSPECIFIED",
+		1:  "NOTIFICATION_TYPE_TASK_ASSIGNED",
+		2:  "NOTIFICATION_TYPE_TASK_DUE_SOON",
+		3:  "NOTIFICATION_TYPE_INGESTION_COMPLETE",
+		4:  "NOTIFICATION_TYPE_INGESTION_FAILED",
+		5:  "NOTIFICATION_TYPE_OUTLINE_READY",
+		6:  "NOTIFICATION_TYPE_GENERATION_COMPLETE",
+		7:  "NOTIFICATION_TYPE_GENERATION_FAILED",
+		8:  "NOTIFICATION_TYPE_APPROVAL_REQUESTED",
+		9:  "NOTIFICATION_TYPE_COLLABORATOR_ADDED",
+		10: "NOTIFICATION_TYPE_EXPORT_READY",
+		11: "NOTIFICATION_TYPE_OUTLINE_COMMENT",
+		12: "NOTIFICATION_TYPE_LESSONS_ORPHANED",
 	}
 	NotificationType_value = map[string]int32{
 		"NOTIFICATION_TYPE_UNSPECIFIED":         0,
@@ -60,6 +68,10 @@ var (
 		"NOTIFICATION_TYPE_GENERATION_COMPLETE": 6,
 		"NOTIFICATION_TYPE_GENERATION_FAILED":   7,
 		"NOTIFICATION_TYPE_APPROVAL_REQUESTED":  8,
+		"NOTIFICATION_TYPE_COLLABORATOR_ADDED":  9,
+		"NOTIFICATION_TYPE_EXPORT_READY":        10,
+		"NOTIFICATION_TYPE_OUTLINE_COMMENT":     11,
+		"NOTIFICATION_TYPE_LESSONS_ORPHANED":    12,
 	}
 )
 
@@ -147,11 +159,12 @@ func (NotificationPriority) EnumDescriptor() ([]byte, []int) {
 type NotificationEventType int32
 
 const (
-	NotificationEventType_NOTIFICATION_EVENT_TYPE_UNSPECIFIED NotificationEventType = 0
-	NotificationEventType_NOTIFICATION_EVENT_TYPE_CREATED     NotificationEventType = 1 // New notification created
-	NotificationEventType_NOTIFICATION_EVENT_TYPE_READ        NotificationEventType = 2 // Notification marked as read
-	NotificationEventType_NOTIFICATION_EVENT_TYPE_DELETED     NotificationEventType = 3 // Notification deleted
-	NotificationEventType_NOTIFICATION_EVENT_TYPE_KEEPALIVE   NotificationEventType = 4 // Keepalive to prevent proxy timeout
+	NotificationEventType_NOTIFICATION_EVENT_TYPE_UNSPECIFIED         NotificationEventType = 0
+	NotificationEventType_NOTIFICATION_EVENT_TYPE_CREATED             NotificationEventType = 1 // New notification created
+	NotificationEventType_NOTIFICATION_EVENT_TYPE_READ                NotificationEventType = 2 // Notification marked as read
+	NotificationEventType_NOTIFICATION_EVENT_TYPE_DELETED             NotificationEventType = 3 // Notification deleted
+	NotificationEventType_NOTIFICATION_EVENT_TYPE_KEEPALIVE           NotificationEventType = 4 // Keepalive to prevent proxy timeout
+	NotificationEventType_NOTIFICATION_EVENT_TYPE_SUBMISSION_PROGRESS NotificationEventType = 5 // SME submission ingestion progressed
 )
 
 // Enum value maps for NotificationEventType.
@@ -162,13 +175,15 @@ var (
 		2: "NOTIFICATION_EVENT_TYPE_READ",
 		3: "NOTIFICATION_EVENT_TYPE_DELETED",
 		4: "NOTIFICATION_EVENT_TYPE_KEEPALIVE",
+		5: "NOTIFICATION_EVENT_TYPE_SUBMISSION_PROGRESS",
 	}
 	NotificationEventType_value = map[string]int32{
-		"NOTIFICATION_EVENT_TYPE_UNSPECIFIED": 0,
-		"NOTIFICATION_EVENT_TYPE_CREATED":     1,
-		"NOTIFICATION_EVENT_TYPE_READ":        2,
-		"NOTIFICATION_EVENT_TYPE_DELETED":     3,
-		"NOTIFICATION_EVENT_TYPE_KEEPALIVE":   4,
+		"NOTIFICATION_EVENT_TYPE_UNSPECIFIED":         0,
+		"NOTIFICATION_EVENT_TYPE_CREATED":             1,
+		"NOTIFICATION_EVENT_TYPE_READ":                2,
+		"NOTIFICATION_EVENT_TYPE_DELETED":             3,
+		"NOTIFICATION_EVENT_TYPE_KEEPALIVE":           4,
+		"NOTIFICATION_EVENT_TYPE_SUBMISSION_PROGRESS": 5,
 	}
 )
 
@@ -199,6 +214,68 @@ func (NotificationEventType) EnumDescriptor() ([]byte, []int) {
 	return file_mirai_v1_notification_proto_rawDescGZIP(), []int{2}
 }
 
+// EmailDeliveryStatus is the outcome of sending an email.
+type EmailDeliveryStatus int32
+
+const (
+	EmailDeliveryStatus_EMAIL_DELIVERY_STATUS_UNSPECIFIED EmailDeliveryStatus = 0
+	EmailDeliveryStatus_EMAIL_DELIVERY_STATUS_ACCEPTED    EmailDeliveryStatus = 1 // The mail server accepted the message
+	EmailDeliveryStatus_EMAIL_DELIVERY_STATUS_DEFERRED    EmailDeliveryStatus = 2 // Temporarily refused (SMTP 4xx)
+	EmailDeliveryStatus_EMAIL_DELIVERY_STATUS_REJECTED    EmailDeliveryStatus = 3 // Permanently refused (SMTP 5xx); a hard bounce
+	EmailDeliveryStatus_EMAIL_DELIVERY_STATUS_FAILED      EmailDeliveryStatus = 4 // No server could be reached
+	EmailDeliveryStatus_EMAIL_DELIVERY_STATUS_DELIVERED   EmailDeliveryStatus = 5 // Delivery confirmed later by the provider
+	EmailDeliveryStatus_EMAIL_DELIVERY_STATUS_BOUNCED     EmailDeliveryStatus = 6 // Bounced after acceptance, reported by the provider
+)
+
+// Enum value maps for EmailDeliveryStatus.
+var (
+	EmailDeliveryStatus_name = map[int32]string{
+		0: "EMAIL_DELIVERY_STATUS_UNSPECIFIED",
+		1: "EMAIL_DELIVERY_STATUS_ACCEPTED",
+		2: "EMAIL_DELIVERY_STATUS_DEFERRED",
+		3: "EMAIL_DELIVERY_STATUS_REJECTED",
+		4: "EMAIL_DELIVERY_STATUS_FAILED",
+		5: "EMAIL_DELIVERY_STATUS_DELIVERED",
+		6: "EMAIL_DELIVERY_STATUS_BOUNCED",
+	}
+	EmailDeliveryStatus_value = map[string]int32{
+		"EMAIL_DELIVERY_STATUS_UNSPECIFIED": 0,
+		"EMAIL_DELIVERY_STATUS_ACCEPTED":    1,
+		"EMAIL_DELIVERY_STATUS_DEFERRED":    2,
+		"EMAIL_DELIVERY_STATUS_REJECTED":    3,
+		"EMAIL_DELIVERY_STATUS_FAILED":      4,
+		"EMAIL_DELIVERY_STATUS_DELIVERED":   5,
+		"EMAIL_DELIVERY_STATUS_BOUNCED":     6,
+	}
+)
+
+func (x EmailDeliveryStatus) Enum() *EmailDeliveryStatus {
+	p := new(EmailDeliveryStatus)
+	*p = x
+	return p
+}
+
+func (x EmailDeliveryStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EmailDeliveryStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_mirai_v1_notification_proto_enumTypes[3].Descriptor()
+}
+
+func (EmailDeliveryStatus) Type() protoreflect.EnumType {
+	return &file_mirai_v1_notification_proto_enumTypes[3]
+}
+
+func (x EmailDeliveryStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EmailDeliveryStatus.Descriptor instead.
+func (EmailDeliveryStatus) EnumDescriptor() ([]byte, []int) {
+	return file_mirai_v1_notification_proto_rawDescGZIP(), []int{3}
+}
+
 // Notification represents a user notification.
 type Notification struct {
 	state    protoimpl.MessageState `protogen:"open.v1"`
@@ -405,13 +482,15 @@ func (*SubscribeNotificationsRequest) Descriptor() ([]byte, []int) {
 }
 
 // SubscribeNotificationsResponse represents a real-time notification event.
-// Each message in the stream contains an event type and the notification payload.
+// Each message in the stream contains an event type and the notification payload,
+// or for SUBMISSION_PROGRESS events, the submission's progress.
 type SubscribeNotificationsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	EventType     NotificationEventType  `protobuf:"varint,1,opt,name=event_type,json=eventType,proto3,enum=mirai.v1.NotificationEventType" json:"event_type,omitempty"`
-	Notification  *Notification          `protobuf:"bytes,2,opt,name=notification,proto3" json:"notification,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	EventType          NotificationEventType  `protobuf:"varint,1,opt,name=event_type,json=eventType,proto3,enum=mirai.v1.NotificationEventType" json:"event_type,omitempty"`
+	Notification       *Notification          `protobuf:"bytes,2,opt,name=notification,proto3" json:"notification,omitempty"`
+	SubmissionProgress *SubmissionProgress    `protobuf:"bytes,3,opt,name=submission_progress,json=submissionProgress,proto3" json:"submission_progress,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *SubscribeNotificationsResponse) Reset() {
@@ -458,6 +537,13 @@ func (x *SubscribeNotificationsResponse) GetNotification() *Notification {
 	return nil
 }
 
+func (x *SubscribeNotificationsResponse) GetSubmissionProgress() *SubmissionProgress {
+	if x != nil {
+		return x.SubmissionProgress
+	}
+	return nil
+}
+
 // ListNotificationsRequest contains filters.
 type ListNotificationsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -842,6 +928,112 @@ func (x *MarkAllAsReadResponse) GetMarkedCount() int32 {
 	return 0
 }
 
+// MarkAsReadByFilterRequest selects notifications to mark. Unset fields match all.
+type MarkAsReadByFilterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      *string                `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3,oneof" json:"course_id,omitempty"`
+	Type          *NotificationType      `protobuf:"varint,2,opt,name=type,proto3,enum=mirai.v1.NotificationType,oneof" json:"type,omitempty"`
+	OlderThan     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=older_than,json=olderThan,proto3,oneof" json:"older_than,omitempty"` // Only notifications created before this time
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkAsReadByFilterRequest) Reset() {
+	*x = MarkAsReadByFilterRequest{}
+	mi := &file_mirai_v1_notification_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkAsReadByFilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkAsReadByFilterRequest) ProtoMessage() {}
+
+func (x *MarkAsReadByFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_notification_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkAsReadByFilterRequest.ProtoReflect.Descriptor instead.
+func (*MarkAsReadByFilterRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_notification_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MarkAsReadByFilterRequest) GetCourseId() string {
+	if x != nil && x.CourseId != nil {
+		return *x.CourseId
+	}
+	return ""
+}
+
+func (x *MarkAsReadByFilterRequest) GetType() NotificationType {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return NotificationType_NOTIFICATION_TYPE_UNSPECIFIED
+}
+
+func (x *MarkAsReadByFilterRequest) GetOlderThan() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OlderThan
+	}
+	return nil
+}
+
+// MarkAsReadByFilterResponse confirms the operation.
+type MarkAsReadByFilterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MarkedCount   int32                  `protobuf:"varint,1,opt,name=marked_count,json=markedCount,proto3" json:"marked_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkAsReadByFilterResponse) Reset() {
+	*x = MarkAsReadByFilterResponse{}
+	mi := &file_mirai_v1_notification_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkAsReadByFilterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkAsReadByFilterResponse) ProtoMessage() {}
+
+func (x *MarkAsReadByFilterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_notification_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkAsReadByFilterResponse.ProtoReflect.Descriptor instead.
+func (*MarkAsReadByFilterResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_notification_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MarkAsReadByFilterResponse) GetMarkedCount() int32 {
+	if x != nil {
+		return x.MarkedCount
+	}
+	return 0
+}
+
 // DeleteNotificationRequest contains the ID to delete.
 type DeleteNotificationRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
@@ -852,7 +1044,7 @@ type DeleteNotificationRequest struct {
 
 func (x *DeleteNotificationRequest) Reset() {
 	*x = DeleteNotificationRequest{}
-	mi := &file_mirai_v1_notification_proto_msgTypes[11]
+	mi := &file_mirai_v1_notification_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -864,7 +1056,7 @@ func (x *DeleteNotificationRequest) String() string {
 func (*DeleteNotificationRequest) ProtoMessage() {}
 
 func (x *DeleteNotificationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_notification_proto_msgTypes[11]
+	mi := &file_mirai_v1_notification_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -877,7 +1069,7 @@ func (x *DeleteNotificationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteNotificationRequest.ProtoReflect.Descriptor instead.
 func (*DeleteNotificationRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_notification_proto_rawDescGZIP(), []int{11}
+	return file_mirai_v1_notification_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *DeleteNotificationRequest) GetNotificationId() string {
@@ -896,7 +1088,7 @@ type DeleteNotificationResponse struct {
 
 func (x *DeleteNotificationResponse) Reset() {
 	*x = DeleteNotificationResponse{}
-	mi := &file_mirai_v1_notification_proto_msgTypes[12]
+	mi := &file_mirai_v1_notification_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -908,7 +1100,7 @@ func (x *DeleteNotificationResponse) String() string {
 func (*DeleteNotificationResponse) ProtoMessage() {}
 
 func (x *DeleteNotificationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_notification_proto_msgTypes[12]
+	mi := &file_mirai_v1_notification_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -921,14 +1113,245 @@ func (x *DeleteNotificationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteNotificationResponse.ProtoReflect.Descriptor instead.
 func (*DeleteNotificationResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_notification_proto_rawDescGZIP(), []int{12}
+	return file_mirai_v1_notification_proto_rawDescGZIP(), []int{14}
+}
+
+// EmailLogEntry records the outcome of one email.
+type EmailLogEntry struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Recipient       string                 `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Template        string                 `protobuf:"bytes,3,opt,name=template,proto3" json:"template,omitempty"` // e.g. "invitation", "outline_ready"
+	MessageId       string                 `protobuf:"bytes,4,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Status          EmailDeliveryStatus    `protobuf:"varint,5,opt,name=status,proto3,enum=mirai.v1.EmailDeliveryStatus" json:"status,omitempty"`
+	SmtpCode        *int32                 `protobuf:"varint,6,opt,name=smtp_code,json=smtpCode,proto3,oneof" json:"smtp_code,omitempty"` // Unset when no server answered
+	SmtpResponse    string                 `protobuf:"bytes,7,opt,name=smtp_response,json=smtpResponse,proto3" json:"smtp_response,omitempty"`
+	SentAt          *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=sent_at,json=sentAt,proto3" json:"sent_at,omitempty"`
+	StatusUpdatedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=status_updated_at,json=statusUpdatedAt,proto3" json:"status_updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *EmailLogEntry) Reset() {
+	*x = EmailLogEntry{}
+	mi := &file_mirai_v1_notification_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmailLogEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmailLogEntry) ProtoMessage() {}
+
+func (x *EmailLogEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_notification_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmailLogEntry.ProtoReflect.Descriptor instead.
+func (*EmailLogEntry) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_notification_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *EmailLogEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EmailLogEntry) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *EmailLogEntry) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *EmailLogEntry) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *EmailLogEntry) GetStatus() EmailDeliveryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return EmailDeliveryStatus_EMAIL_DELIVERY_STATUS_UNSPECIFIED
+}
+
+func (x *EmailLogEntry) GetSmtpCode() int32 {
+	if x != nil && x.SmtpCode != nil {
+		return *x.SmtpCode
+	}
+	return 0
+}
+
+func (x *EmailLogEntry) GetSmtpResponse() string {
+	if x != nil {
+		return x.SmtpResponse
+	}
+	return ""
+}
+
+func (x *EmailLogEntry) GetSentAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SentAt
+	}
+	return nil
+}
+
+func (x *EmailLogEntry) GetStatusUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StatusUpdatedAt
+	}
+	return nil
+}
+
+// ListEmailLogRequest contains filters and pagination.
+type ListEmailLogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Recipient     *string                `protobuf:"bytes,1,opt,name=recipient,proto3,oneof" json:"recipient,omitempty"` // Case-insensitive exact match
+	Template      *string                `protobuf:"bytes,2,opt,name=template,proto3,oneof" json:"template,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`        // Max results (default 50, max 200)
+	Cursor        *string                `protobuf:"bytes,4,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"` // For pagination
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEmailLogRequest) Reset() {
+	*x = ListEmailLogRequest{}
+	mi := &file_mirai_v1_notification_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEmailLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEmailLogRequest) ProtoMessage() {}
+
+func (x *ListEmailLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_notification_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEmailLogRequest.ProtoReflect.Descriptor instead.
+func (*ListEmailLogRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_notification_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListEmailLogRequest) GetRecipient() string {
+	if x != nil && x.Recipient != nil {
+		return *x.Recipient
+	}
+	return ""
+}
+
+func (x *ListEmailLogRequest) GetTemplate() string {
+	if x != nil && x.Template != nil {
+		return *x.Template
+	}
+	return ""
+}
+
+func (x *ListEmailLogRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListEmailLogRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+// ListEmailLogResponse contains a page of the email log.
+type ListEmailLogResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*EmailLogEntry       `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	NextCursor    *string                `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3,oneof" json:"next_cursor,omitempty"` // For pagination
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEmailLogResponse) Reset() {
+	*x = ListEmailLogResponse{}
+	mi := &file_mirai_v1_notification_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEmailLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEmailLogResponse) ProtoMessage() {}
+
+func (x *ListEmailLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_notification_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEmailLogResponse.ProtoReflect.Descriptor instead.
+func (*ListEmailLogResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_notification_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListEmailLogResponse) GetEntries() []*EmailLogEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ListEmailLogResponse) GetNextCursor() string {
+	if x != nil && x.NextCursor != nil {
+		return *x.NextCursor
+	}
+	return ""
 }
 
 var File_mirai_v1_notification_proto protoreflect.FileDescriptor
 
 const file_mirai_v1_notification_proto_rawDesc = "" +
 	"\n" +
-	"\x1bmirai/v1/notification.proto\x12\bmirai.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xff\x04\n" +
+	"\x1bmirai/v1/notification.proto\x12\bmirai.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x12mirai/v1/sme.proto\"\xff\x04\n" +
 	"\fNotification\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x17\n" +
@@ -959,11 +1382,12 @@ const file_mirai_v1_notification_proto_rawDesc = "" +
 	"\v_action_urlB\n" +
 	"\n" +
 	"\b_read_at\"\x1f\n" +
-	"\x1dSubscribeNotificationsRequest\"\x9c\x01\n" +
+	"\x1dSubscribeNotificationsRequest\"\xeb\x01\n" +
 	"\x1eSubscribeNotificationsResponse\x12>\n" +
 	"\n" +
 	"event_type\x18\x01 \x01(\x0e2\x1f.mirai.v1.NotificationEventTypeR\teventType\x12:\n" +
-	"\fnotification\x18\x02 \x01(\v2\x16.mirai.v1.NotificationR\fnotification\"\xcc\x01\n" +
+	"\fnotification\x18\x02 \x01(\v2\x16.mirai.v1.NotificationR\fnotification\x12M\n" +
+	"\x13submission_progress\x18\x03 \x01(\v2\x1c.mirai.v1.SubmissionProgressR\x12submissionProgress\"\xcc\x01\n" +
 	"\x18ListNotificationsRequest\x12$\n" +
 	"\vunread_only\x18\x01 \x01(\bH\x00R\n" +
 	"unreadOnly\x88\x01\x01\x123\n" +
@@ -989,10 +1413,48 @@ const file_mirai_v1_notification_proto_rawDesc = "" +
 	"\fmarked_count\x18\x01 \x01(\x05R\vmarkedCount\"\x16\n" +
 	"\x14MarkAllAsReadRequest\":\n" +
 	"\x15MarkAllAsReadResponse\x12!\n" +
+	"\fmarked_count\x18\x01 \x01(\x05R\vmarkedCount\"\xd8\x01\n" +
+	"\x19MarkAsReadByFilterRequest\x12 \n" +
+	"\tcourse_id\x18\x01 \x01(\tH\x00R\bcourseId\x88\x01\x01\x123\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x1a.mirai.v1.NotificationTypeH\x01R\x04type\x88\x01\x01\x12>\n" +
+	"\n" +
+	"older_than\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\tolderThan\x88\x01\x01B\f\n" +
+	"\n" +
+	"_course_idB\a\n" +
+	"\x05_typeB\r\n" +
+	"\v_older_than\"?\n" +
+	"\x1aMarkAsReadByFilterResponse\x12!\n" +
 	"\fmarked_count\x18\x01 \x01(\x05R\vmarkedCount\"D\n" +
 	"\x19DeleteNotificationRequest\x12'\n" +
 	"\x0fnotification_id\x18\x01 \x01(\tR\x0enotificationId\"\x1c\n" +
-	"\x1aDeleteNotificationResponse*\xf4\x02\n" +
+	"\x1aDeleteNotificationResponse\"\x81\x03\n" +
+	"\rEmailLogEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\trecipient\x18\x02 \x01(\tR\trecipient\x12\x1a\n" +
+	"\btemplate\x18\x03 \x01(\tR\btemplate\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x04 \x01(\tR\tmessageId\x125\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x1d.mirai.v1.EmailDeliveryStatusR\x06status\x12 \n" +
+	"\tsmtp_code\x18\x06 \x01(\x05H\x00R\bsmtpCode\x88\x01\x01\x12#\n" +
+	"\rsmtp_response\x18\a \x01(\tR\fsmtpResponse\x123\n" +
+	"\asent_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x06sentAt\x12F\n" +
+	"\x11status_updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x0fstatusUpdatedAtB\f\n" +
+	"\n" +
+	"_smtp_code\"\xb2\x01\n" +
+	"\x13ListEmailLogRequest\x12!\n" +
+	"\trecipient\x18\x01 \x01(\tH\x00R\trecipient\x88\x01\x01\x12\x1f\n" +
+	"\btemplate\x18\x02 \x01(\tH\x01R\btemplate\x88\x01\x01\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x1b\n" +
+	"\x06cursor\x18\x04 \x01(\tH\x02R\x06cursor\x88\x01\x01B\f\n" +
+	"\n" +
+	"_recipientB\v\n" +
+	"\t_templateB\t\n" +
+	"\a_cursor\"\x7f\n" +
+	"\x14ListEmailLogResponse\x121\n" +
+	"\aentries\x18\x01 \x03(\v2\x17.mirai.v1.EmailLogEntryR\aentries\x12$\n" +
+	"\vnext_cursor\x18\x02 \x01(\tH\x00R\n" +
+	"nextCursor\x88\x01\x01B\x0e\n" +
+	"\f_next_cursor*\x91\x04\n" +
 	"\x10NotificationType\x12!\n" +
 	"\x1dNOTIFICATION_TYPE_UNSPECIFIED\x10\x00\x12#\n" +
 	"\x1fNOTIFICATION_TYPE_TASK_ASSIGNED\x10\x01\x12#\n" +
@@ -1002,26 +1464,42 @@ const file_mirai_v1_notification_proto_rawDesc = "" +
 	"\x1fNOTIFICATION_TYPE_OUTLINE_READY\x10\x05\x12)\n" +
 	"%NOTIFICATION_TYPE_GENERATION_COMPLETE\x10\x06\x12'\n" +
 	"#NOTIFICATION_TYPE_GENERATION_FAILED\x10\a\x12(\n" +
-	"$NOTIFICATION_TYPE_APPROVAL_REQUESTED\x10\b*\x9e\x01\n" +
+	"$NOTIFICATION_TYPE_APPROVAL_REQUESTED\x10\b\x12(\n" +
+	"$NOTIFICATION_TYPE_COLLABORATOR_ADDED\x10\t\x12\"\n" +
+	"\x1eNOTIFICATION_TYPE_EXPORT_READY\x10\n" +
+	"\x12%\n" +
+	"!NOTIFICATION_TYPE_OUTLINE_COMMENT\x10\v\x12&\n" +
+	"\"NOTIFICATION_TYPE_LESSONS_ORPHANED\x10\f*\x9e\x01\n" +
 	"\x14NotificationPriority\x12%\n" +
 	"!NOTIFICATION_PRIORITY_UNSPECIFIED\x10\x00\x12\x1d\n" +
 	"\x19NOTIFICATION_PRIORITY_LOW\x10\x01\x12 \n" +
 	"\x1cNOTIFICATION_PRIORITY_NORMAL\x10\x02\x12\x1e\n" +
-	"\x1aNOTIFICATION_PRIORITY_HIGH\x10\x03*\xd3\x01\n" +
+	"\x1aNOTIFICATION_PRIORITY_HIGH\x10\x03*\x84\x02\n" +
 	"\x15NotificationEventType\x12'\n" +
 	"#NOTIFICATION_EVENT_TYPE_UNSPECIFIED\x10\x00\x12#\n" +
 	"\x1fNOTIFICATION_EVENT_TYPE_CREATED\x10\x01\x12 \n" +
 	"\x1cNOTIFICATION_EVENT_TYPE_READ\x10\x02\x12#\n" +
 	"\x1fNOTIFICATION_EVENT_TYPE_DELETED\x10\x03\x12%\n" +
-	"!NOTIFICATION_EVENT_TYPE_KEEPALIVE\x10\x042\xb3\x04\n" +
+	"!NOTIFICATION_EVENT_TYPE_KEEPALIVE\x10\x04\x12/\n" +
+	"+NOTIFICATION_EVENT_TYPE_SUBMISSION_PROGRESS\x10\x05*\x92\x02\n" +
+	"\x13EmailDeliveryStatus\x12%\n" +
+	"!EMAIL_DELIVERY_STATUS_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1eEMAIL_DELIVERY_STATUS_ACCEPTED\x10\x01\x12\"\n" +
+	"\x1eEMAIL_DELIVERY_STATUS_DEFERRED\x10\x02\x12\"\n" +
+	"\x1eEMAIL_DELIVERY_STATUS_REJECTED\x10\x03\x12 \n" +
+	"\x1cEMAIL_DELIVERY_STATUS_FAILED\x10\x04\x12#\n" +
+	"\x1fEMAIL_DELIVERY_STATUS_DELIVERED\x10\x05\x12!\n" +
+	"\x1dEMAIL_DELIVERY_STATUS_BOUNCED\x10\x062\xe3\x05\n" +
 	"\x13NotificationService\x12\\\n" +
 	"\x11ListNotifications\x12\".mirai.v1.ListNotificationsRequest\x1a#.mirai.v1.ListNotificationsResponse\x12S\n" +
 	"\x0eGetUnreadCount\x12\x1f.mirai.v1.GetUnreadCountRequest\x1a .mirai.v1.GetUnreadCountResponse\x12G\n" +
 	"\n" +
 	"MarkAsRead\x12\x1b.mirai.v1.MarkAsReadRequest\x1a\x1c.mirai.v1.MarkAsReadResponse\x12P\n" +
 	"\rMarkAllAsRead\x12\x1e.mirai.v1.MarkAllAsReadRequest\x1a\x1f.mirai.v1.MarkAllAsReadResponse\x12_\n" +
+	"\x12MarkAsReadByFilter\x12#.mirai.v1.MarkAsReadByFilterRequest\x1a$.mirai.v1.MarkAsReadByFilterResponse\x12_\n" +
 	"\x12DeleteNotification\x12#.mirai.v1.DeleteNotificationRequest\x1a$.mirai.v1.DeleteNotificationResponse\x12m\n" +
-	"\x16SubscribeNotifications\x12'.mirai.v1.SubscribeNotificationsRequest\x1a(.mirai.v1.SubscribeNotificationsResponse0\x01B\x97\x01\n" +
+	"\x16SubscribeNotifications\x12'.mirai.v1.SubscribeNotificationsRequest\x1a(.mirai.v1.SubscribeNotificationsResponse0\x01\x12M\n" +
+	"\fListEmailLog\x12\x1d.mirai.v1.ListEmailLogRequest\x1a\x1e.mirai.v1.ListEmailLogResponseB\x97\x01\n" +
 	"\fcom.mirai.v1B\x11NotificationProtoP\x01Z3github.com/sogos/mirai-backend/gen/mirai/v1;miraiv1\xa2\x02\x03MXX\xaa\x02\bMirai.V1\xca\x02\bMirai\\V1\xe2\x02\x14Mirai\\V1\\GPBMetadata\xea\x02\tMirai::V1b\x06proto3"
 
 var (
@@ -1036,53 +1514,71 @@ func file_mirai_v1_notification_proto_rawDescGZIP() []byte {
 	return file_mirai_v1_notification_proto_rawDescData
 }
 
-var file_mirai_v1_notification_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_mirai_v1_notification_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_mirai_v1_notification_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_mirai_v1_notification_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_mirai_v1_notification_proto_goTypes = []any{
 	(NotificationType)(0),                  // 0: mirai.v1.NotificationType
 	(NotificationPriority)(0),              // 1: mirai.v1.NotificationPriority
 	(NotificationEventType)(0),             // 2: mirai.v1.NotificationEventType
-	(*Notification)(nil),                   // 3: mirai.v1.Notification
-	(*SubscribeNotificationsRequest)(nil),  // 4: mirai.v1.SubscribeNotificationsRequest
-	(*SubscribeNotificationsResponse)(nil), // 5: mirai.v1.SubscribeNotificationsResponse
-	(*ListNotificationsRequest)(nil),       // 6: mirai.v1.ListNotificationsRequest
-	(*ListNotificationsResponse)(nil),      // 7: mirai.v1.ListNotificationsResponse
-	(*GetUnreadCountRequest)(nil),          // 8: mirai.v1.GetUnreadCountRequest
-	(*GetUnreadCountResponse)(nil),         // 9: mirai.v1.GetUnreadCountResponse
-	(*MarkAsReadRequest)(nil),              // 10: mirai.v1.MarkAsReadRequest
-	(*MarkAsReadResponse)(nil),             // 11: mirai.v1.MarkAsReadResponse
-	(*MarkAllAsReadRequest)(nil),           // 12: mirai.v1.MarkAllAsReadRequest
-	(*MarkAllAsReadResponse)(nil),          // 13: mirai.v1.MarkAllAsReadResponse
-	(*DeleteNotificationRequest)(nil),      // 14: mirai.v1.DeleteNotificationRequest
-	(*DeleteNotificationResponse)(nil),     // 15: mirai.v1.DeleteNotificationResponse
-	(*timestamppb.Timestamp)(nil),          // 16: google.protobuf.Timestamp
+	(EmailDeliveryStatus)(0),               // 3: mirai.v1.EmailDeliveryStatus
+	(*Notification)(nil),                   // 4: mirai.v1.Notification
+	(*SubscribeNotificationsRequest)(nil),  // 5: mirai.v1.SubscribeNotificationsRequest
+	(*SubscribeNotificationsResponse)(nil), // 6: mirai.v1.SubscribeNotificationsResponse
+	(*ListNotificationsRequest)(nil),       // 7: mirai.v1.ListNotificationsRequest
+	(*ListNotificationsResponse)(nil),      // 8: mirai.v1.ListNotificationsResponse
+	(*GetUnreadCountRequest)(nil),          // 9: mirai.v1.GetUnreadCountRequest
+	(*GetUnreadCountResponse)(nil),         // 10: mirai.v1.GetUnreadCountResponse
+	(*MarkAsReadRequest)(nil),              // 11: mirai.v1.MarkAsReadRequest
+	(*MarkAsReadResponse)(nil),             // 12: mirai.v1.MarkAsReadResponse
+	(*MarkAllAsReadRequest)(nil),           // 13: mirai.v1.MarkAllAsReadRequest
+	(*MarkAllAsReadResponse)(nil),          // 14: mirai.v1.MarkAllAsReadResponse
+	(*MarkAsReadByFilterRequest)(nil),      // 15: mirai.v1.MarkAsReadByFilterRequest
+	(*MarkAsReadByFilterResponse)(nil),     // 16: mirai.v1.MarkAsReadByFilterResponse
+	(*DeleteNotificationRequest)(nil),      // 17: mirai.v1.DeleteNotificationRequest
+	(*DeleteNotificationResponse)(nil),     // 18: mirai.v1.DeleteNotificationResponse
+	(*EmailLogEntry)(nil),                  // 19: mirai.v1.EmailLogEntry
+	(*ListEmailLogRequest)(nil),            // 20: mirai.v1.ListEmailLogRequest
+	(*ListEmailLogResponse)(nil),           // 21: mirai.v1.ListEmailLogResponse
+	(*timestamppb.Timestamp)(nil),          // 22: google.protobuf.Timestamp
+	(*SubmissionProgress)(nil),             // 23: mirai.v1.SubmissionProgress
 }
 var file_mirai_v1_notification_proto_depIdxs = []int32{
 	0,  // 0: mirai.v1.Notification.type:type_name -> mirai.v1.NotificationType
 	1,  // 1: mirai.v1.Notification.priority:type_name -> mirai.v1.NotificationPriority
-	16, // 2: mirai.v1.Notification.created_at:type_name -> google.protobuf.Timestamp
-	16, // 3: mirai.v1.Notification.read_at:type_name -> google.protobuf.Timestamp
+	22, // 2: mirai.v1.Notification.created_at:type_name -> google.protobuf.Timestamp
+	22, // 3: mirai.v1.Notification.read_at:type_name -> google.protobuf.Timestamp
 	2,  // 4: mirai.v1.SubscribeNotificationsResponse.event_type:type_name -> mirai.v1.NotificationEventType
-	3,  // 5: mirai.v1.SubscribeNotificationsResponse.notification:type_name -> mirai.v1.Notification
-	0,  // 6: mirai.v1.ListNotificationsRequest.type:type_name -> mirai.v1.NotificationType
-	3,  // 7: mirai.v1.ListNotificationsResponse.notifications:type_name -> mirai.v1.Notification
-	6,  // 8: mirai.v1.NotificationService.ListNotifications:input_type -> mirai.v1.ListNotificationsRequest
-	8,  // 9: mirai.v1.NotificationService.GetUnreadCount:input_type -> mirai.v1.GetUnreadCountRequest
-	10, // 10: mirai.v1.NotificationService.MarkAsRead:input_type -> mirai.v1.MarkAsReadRequest
-	12, // 11: mirai.v1.NotificationService.MarkAllAsRead:input_type -> mirai.v1.MarkAllAsReadRequest
-	14, // 12: mirai.v1.NotificationService.DeleteNotification:input_type -> mirai.v1.DeleteNotificationRequest
-	4,  // 13: mirai.v1.NotificationService.SubscribeNotifications:input_type -> mirai.v1.SubscribeNotificationsRequest
-	7,  // 14: mirai.v1.NotificationService.ListNotifications:output_type -> mirai.v1.ListNotificationsResponse
-	9,  // 15: mirai.v1.NotificationService.GetUnreadCount:output_type -> mirai.v1.GetUnreadCountResponse
-	11, // 16: mirai.v1.NotificationService.MarkAsRead:output_type -> mirai.v1.MarkAsReadResponse
-	13, // 17: mirai.v1.NotificationService.MarkAllAsRead:output_type -> mirai.v1.MarkAllAsReadResponse
-	15, // 18: mirai.v1.NotificationService.DeleteNotification:output_type -> mirai.v1.DeleteNotificationResponse
-	5,  // 19: mirai.v1.NotificationService.SubscribeNotifications:output_type -> mirai.v1.SubscribeNotificationsResponse
-	14, // [14:20] is the sub-list for method output_type
-	8,  // [8:14] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	4,  // 5: mirai.v1.SubscribeNotificationsResponse.notification:type_name -> mirai.v1.Notification
+	23, // 6: mirai.v1.SubscribeNotificationsResponse.submission_progress:type_name -> mirai.v1.SubmissionProgress
+	0,  // 7: mirai.v1.ListNotificationsRequest.type:type_name -> mirai.v1.NotificationType
+	4,  // 8: mirai.v1.ListNotificationsResponse.notifications:type_name -> mirai.v1.Notification
+	0,  // 9: mirai.v1.MarkAsReadByFilterRequest.type:type_name -> mirai.v1.NotificationType
+	22, // 10: mirai.v1.MarkAsReadByFilterRequest.older_than:type_name -> google.protobuf.Timestamp
+	3,  // 11: mirai.v1.EmailLogEntry.status:type_name -> mirai.v1.EmailDeliveryStatus
+	22, // 12: mirai.v1.EmailLogEntry.sent_at:type_name -> google.protobuf.Timestamp
+	22, // 13: mirai.v1.EmailLogEntry.status_updated_at:type_name -> google.protobuf.Timestamp
+	19, // 14: mirai.v1.ListEmailLogResponse.entries:type_name -> mirai.v1.EmailLogEntry
+	7,  // 15: mirai.v1.NotificationService.ListNotifications:input_type -> mirai.v1.ListNotificationsRequest
+	9,  // 16: mirai.v1.NotificationService.GetUnreadCount:input_type -> mirai.v1.GetUnreadCountRequest
+	11, // 17: mirai.v1.NotificationService.MarkAsRead:input_type -> mirai.v1.MarkAsReadRequest
+	13, // 18: mirai.v1.NotificationService.MarkAllAsRead:input_type -> mirai.v1.MarkAllAsReadRequest
+	15, // 19: mirai.v1.NotificationService.MarkAsReadByFilter:input_type -> mirai.v1.MarkAsReadByFilterRequest
+	17, // 20: mirai.v1.NotificationService.DeleteNotification:input_type -> mirai.v1.DeleteNotificationRequest
+	5,  // 21: mirai.v1.NotificationService.SubscribeNotifications:input_type -> mirai.v1.SubscribeNotificationsRequest
+	20, // 22: mirai.v1.NotificationService.ListEmailLog:input_type -> mirai.v1.ListEmailLogRequest
+	8,  // 23: mirai.v1.NotificationService.ListNotifications:output_type -> mirai.v1.ListNotificationsResponse
+	10, // 24: mirai.v1.NotificationService.GetUnreadCount:output_type -> mirai.v1.GetUnreadCountResponse
+	12, // 25: mirai.v1.NotificationService.MarkAsRead:output_type -> mirai.v1.MarkAsReadResponse
+	14, // 26: mirai.v1.NotificationService.MarkAllAsRead:output_type -> mirai.v1.MarkAllAsReadResponse
+	16, // 27: mirai.v1.NotificationService.MarkAsReadByFilter:output_type -> mirai.v1.MarkAsReadByFilterResponse
+	18, // 28: mirai.v1.NotificationService.DeleteNotification:output_type -> mirai.v1.DeleteNotificationResponse
+	6,  // 29: mirai.v1.NotificationService.SubscribeNotifications:output_type -> mirai.v1.SubscribeNotificationsResponse
+	21, // 30: mirai.v1.NotificationService.ListEmailLog:output_type -> mirai.v1.ListEmailLogResponse
+	23, // [23:31] is the sub-list for method output_type
+	15, // [15:23] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_mirai_v1_notification_proto_init() }
@@ -1090,16 +1586,21 @@ func file_mirai_v1_notification_proto_init() {
 	if File_mirai_v1_notification_proto != nil {
 		return
 	}
+	file_mirai_v1_sme_proto_init()
 	file_mirai_v1_notification_proto_msgTypes[0].OneofWrappers = []any{}
 	file_mirai_v1_notification_proto_msgTypes[3].OneofWrappers = []any{}
 	file_mirai_v1_notification_proto_msgTypes[4].OneofWrappers = []any{}
+	file_mirai_v1_notification_proto_msgTypes[11].OneofWrappers = []any{}
+	file_mirai_v1_notification_proto_msgTypes[15].OneofWrappers = []any{}
+	file_mirai_v1_notification_proto_msgTypes[16].OneofWrappers = []any{}
+	file_mirai_v1_notification_proto_msgTypes[17].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_mirai_v1_notification_proto_rawDesc), len(file_mirai_v1_notification_proto_rawDesc)),
-			NumEnums:      3,
-			NumMessages:   13,
+			NumEnums:      4,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -196,6 +196,62 @@ func (SMETaskStatus) EnumDescriptor() ([]byte, []int) {
 	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{2}
 }
 
+// SubmissionStatus is where a submission stands, derived from its ingestion and review fields.
+type SubmissionStatus int32
+
+const (
+	SubmissionStatus_SUBMISSION_STATUS_UNSPECIFIED    SubmissionStatus = 0
+	SubmissionStatus_SUBMISSION_STATUS_PENDING_REVIEW SubmissionStatus = 1 // Submitted, not yet ingested or approved
+	SubmissionStatus_SUBMISSION_STATUS_PROCESSED      SubmissionStatus = 2 // Ingested successfully, not yet approved
+	SubmissionStatus_SUBMISSION_STATUS_FAILED         SubmissionStatus = 3 // Ingestion failed
+	SubmissionStatus_SUBMISSION_STATUS_APPROVED       SubmissionStatus = 4 // Approved by the reviewer
+)
+
+// Enum value maps for SubmissionStatus.
+var (
+	SubmissionStatus_name = map[int32]string{
+		0: "SUBMISSION_STATUS_UNSPECIFIED",
+		1: "SUBMISSION_STATUS_PENDING_REVIEW",
+		2: "SUBMISSION_STATUS_PROCESSED",
+		3: "SUBMISSION_STATUS_FAILED",
+		4: "SUBMISSION_STATUS_APPROVED",
+	}
+	SubmissionStatus_value = map[string]int32{
+		"SUBMISSION_STATUS_UNSPECIFIED":    0,
+		"SUBMISSION_STATUS_PENDING_REVIEW": 1,
+		"SUBMISSION_STATUS_PROCESSED":      2,
+		"SUBMISSION_STATUS_FAILED":         3,
+		"SUBMISSION_STATUS_APPROVED":       4,
+	}
+)
+
+func (x SubmissionStatus) Enum() *SubmissionStatus {
+	p := new(SubmissionStatus)
+	*p = x
+	return p
+}
+
+func (x SubmissionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SubmissionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_mirai_v1_sme_proto_enumTypes[3].Descriptor()
+}
+
+func (SubmissionStatus) Type() protoreflect.EnumType {
+	return &file_mirai_v1_sme_proto_enumTypes[3]
+}
+
+func (x SubmissionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SubmissionStatus.Descriptor instead.
+func (SubmissionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{3}
+}
+
 // EnhanceType for AI content enhancement operations.
 type EnhanceType int32
 
@@ -230,11 +286,11 @@ func (x EnhanceType) String() string {
 }
 
 func (EnhanceType) Descriptor() protoreflect.EnumDescriptor {
-	return file_mirai_v1_sme_proto_enumTypes[3].Descriptor()
+	return file_mirai_v1_sme_proto_enumTypes[4].Descriptor()
 }
 
 func (EnhanceType) Type() protoreflect.EnumType {
-	return &file_mirai_v1_sme_proto_enumTypes[3]
+	return &file_mirai_v1_sme_proto_enumTypes[4]
 }
 
 func (x EnhanceType) Number() protoreflect.EnumNumber {
@@ -243,7 +299,7 @@ func (x EnhanceType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use EnhanceType.Descriptor instead.
 func (EnhanceType) EnumDescriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{3}
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{4}
 }
 
 // ContentType for uploaded materials.
@@ -292,11 +348,11 @@ func (x ContentType) String() string {
 }
 
 func (ContentType) Descriptor() protoreflect.EnumDescriptor {
-	return file_mirai_v1_sme_proto_enumTypes[4].Descriptor()
+	return file_mirai_v1_sme_proto_enumTypes[5].Descriptor()
 }
 
 func (ContentType) Type() protoreflect.EnumType {
-	return &file_mirai_v1_sme_proto_enumTypes[4]
+	return &file_mirai_v1_sme_proto_enumTypes[5]
 }
 
 func (x ContentType) Number() protoreflect.EnumNumber {
@@ -305,7 +361,66 @@ func (x ContentType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ContentType.Descriptor instead.
 func (ContentType) EnumDescriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{4}
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{5}
+}
+
+// SubmissionProcessingStatus is where a submission's ingestion job stands.
+type SubmissionProcessingStatus int32
+
+const (
+	SubmissionProcessingStatus_SUBMISSION_PROCESSING_STATUS_UNSPECIFIED SubmissionProcessingStatus = 0
+	SubmissionProcessingStatus_SUBMISSION_PROCESSING_STATUS_NOT_STARTED SubmissionProcessingStatus = 1 // No ingestion job yet, e.g. awaiting review
+	SubmissionProcessingStatus_SUBMISSION_PROCESSING_STATUS_QUEUED      SubmissionProcessingStatus = 2
+	SubmissionProcessingStatus_SUBMISSION_PROCESSING_STATUS_PROCESSING  SubmissionProcessingStatus = 3
+	SubmissionProcessingStatus_SUBMISSION_PROCESSING_STATUS_COMPLETED   SubmissionProcessingStatus = 4
+	SubmissionProcessingStatus_SUBMISSION_PROCESSING_STATUS_FAILED      SubmissionProcessingStatus = 5
+)
+
+// Enum value maps for SubmissionProcessingStatus.
+var (
+	SubmissionProcessingStatus_name = map[int32]string{
+		0: "SUBMISSION_PROCESSING_STATUS_UNSPECIFIED",
+		1: "SUBMISSION_PROCESSING_STATUS_NOT_STARTED",
+		2: "SUBMISSION_PROCESSING_STATUS_QUEUED",
+		3: "SUBMISSION_PROCESSING_STATUS_PROCESSING",
+		4: "SUBMISSION_PROCESSING_STATUS_COMPLETED",
+		5: "SUBMISSION_PROCESSING_STATUS_FAILED",
+	}
+	SubmissionProcessingStatus_value = map[string]int32{
+		"SUBMISSION_PROCESSING_STATUS_UNSPECIFIED": 0,
+		"SUBMISSION_PROCESSING_STATUS_NOT_STARTED": 1,
+		"SUBMISSION_PROCESSING_STATUS_QUEUED":      2,
+		"SUBMISSION_PROCESSING_STATUS_PROCESSING":  3,
+		"SUBMISSION_PROCESSING_STATUS_COMPLETED":   4,
+		"SUBMISSION_PROCESSING_STATUS_FAILED":      5,
+	}
+)
+
+func (x SubmissionProcessingStatus) Enum() *SubmissionProcessingStatus {
+	p := new(SubmissionProcessingStatus)
+	*p = x
+	return p
+}
+
+func (x SubmissionProcessingStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SubmissionProcessingStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_mirai_v1_sme_proto_enumTypes[6].Descriptor()
+}
+
+func (SubmissionProcessingStatus) Type() protoreflect.EnumType {
+	return &file_mirai_v1_sme_proto_enumTypes[6]
+}
+
+func (x SubmissionProcessingStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SubmissionProcessingStatus.Descriptor instead.
+func (SubmissionProcessingStatus) EnumDescriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{6}
 }
 
 // SubjectMatterExpert represents a knowledge source entity.
@@ -326,6 +441,7 @@ type SubjectMatterExpert struct {
 	CreatedByUserId      string                 `protobuf:"bytes,12,opt,name=created_by_user_id,json=createdByUserId,proto3" json:"created_by_user_id,omitempty"`
 	CreatedAt            *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt            *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedByActive      bool                   `protobuf:"varint,15,opt,name=created_by_active,json=createdByActive,proto3" json:"created_by_active,omitempty"` // False when the creator has been deactivated
 	unknownFields        protoimpl.UnknownFields
 	sizeCache            protoimpl.SizeCache
 }
@@ -458,6 +574,13 @@ func (x *SubjectMatterExpert) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *SubjectMatterExpert) GetCreatedByActive() bool {
+	if x != nil {
+		return x.CreatedByActive
+	}
+	return false
+}
+
 // SMETask represents a delegated task for content submission.
 type SMETask struct {
 	state               protoimpl.MessageState `protogen:"open.v1"`
@@ -477,6 +600,7 @@ type SMETask struct {
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=completed_at,json=completedAt,proto3,oneof" json:"completed_at,omitempty"`
+	Questions     []string               `protobuf:"bytes,15,rep,name=questions,proto3" json:"questions,omitempty"` // Interview questions; answered with SubmitAnswers instead of uploading
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -609,6 +733,13 @@ func (x *SMETask) GetCompletedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *SMETask) GetQuestions() []string {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
 // SMETaskSubmission represents uploaded content for a task.
 type SMETaskSubmission struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -632,6 +763,7 @@ type SMETaskSubmission struct {
 	IsApproved       bool                   `protobuf:"varint,16,opt,name=is_approved,json=isApproved,proto3" json:"is_approved,omitempty"`                     // Whether submission is approved
 	ApprovedAt       *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=approved_at,json=approvedAt,proto3,oneof" json:"approved_at,omitempty"`
 	ApprovedByUserId *string                `protobuf:"bytes,18,opt,name=approved_by_user_id,json=approvedByUserId,proto3,oneof" json:"approved_by_user_id,omitempty"`
+	Status           SubmissionStatus       `protobuf:"varint,19,opt,name=status,proto3,enum=mirai.v1.SubmissionStatus" json:"status,omitempty"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -792,6 +924,13 @@ func (x *SMETaskSubmission) GetApprovedByUserId() string {
 	return ""
 }
 
+func (x *SMETaskSubmission) GetStatus() SubmissionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SubmissionStatus_SUBMISSION_STATUS_UNSPECIFIED
+}
+
 // SMEKnowledgeChunk represents a unit of distilled knowledge.
 type SMEKnowledgeChunk struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
@@ -803,8 +942,13 @@ type SMEKnowledgeChunk struct {
 	Keywords       []string               `protobuf:"bytes,6,rep,name=keywords,proto3" json:"keywords,omitempty"`                                     // Extracted keywords
 	RelevanceScore float32                `protobuf:"fixed32,7,opt,name=relevance_score,json=relevanceScore,proto3" json:"relevance_score,omitempty"` // For ranking in generation
 	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Prompt injection screening: flagged chunks are left out of generation
+	// until an admin releases them.
+	InjectionFlagged    bool                   `protobuf:"varint,9,opt,name=injection_flagged,json=injectionFlagged,proto3" json:"injection_flagged,omitempty"`
+	InjectionReason     *string                `protobuf:"bytes,10,opt,name=injection_reason,json=injectionReason,proto3,oneof" json:"injection_reason,omitempty"`
+	InjectionReleasedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=injection_released_at,json=injectionReleasedAt,proto3,oneof" json:"injection_released_at,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *SMEKnowledgeChunk) Reset() {
@@ -893,32 +1037,50 @@ func (x *SMEKnowledgeChunk) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
-// CreateSMERequest contains data for a new SME.
-type CreateSMERequest struct {
+func (x *SMEKnowledgeChunk) GetInjectionFlagged() bool {
+	if x != nil {
+		return x.InjectionFlagged
+	}
+	return false
+}
+
+func (x *SMEKnowledgeChunk) GetInjectionReason() string {
+	if x != nil && x.InjectionReason != nil {
+		return *x.InjectionReason
+	}
+	return ""
+}
+
+func (x *SMEKnowledgeChunk) GetInjectionReleasedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.InjectionReleasedAt
+	}
+	return nil
+}
+
+// SMETaskStatusCount is the number of an SME's tasks in a given status.
+type SMETaskStatusCount struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Domain        string                 `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`
-	Scope         SMEScope               `protobuf:"varint,4,opt,name=scope,proto3,enum=mirai.v1.SMEScope" json:"scope,omitempty"`
-	TeamIds       []string               `protobuf:"bytes,5,rep,name=team_ids,json=teamIds,proto3" json:"team_ids,omitempty"` // Required if scope is TEAM
+	Status        SMETaskStatus          `protobuf:"varint,1,opt,name=status,proto3,enum=mirai.v1.SMETaskStatus" json:"status,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateSMERequest) Reset() {
-	*x = CreateSMERequest{}
+func (x *SMETaskStatusCount) Reset() {
+	*x = SMETaskStatusCount{}
 	mi := &file_mirai_v1_sme_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateSMERequest) String() string {
+func (x *SMETaskStatusCount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSMERequest) ProtoMessage() {}
+func (*SMETaskStatusCount) ProtoMessage() {}
 
-func (x *CreateSMERequest) ProtoReflect() protoreflect.Message {
+func (x *SMETaskStatusCount) ProtoReflect() protoreflect.Message {
 	mi := &file_mirai_v1_sme_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -930,68 +1092,48 @@ func (x *CreateSMERequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSMERequest.ProtoReflect.Descriptor instead.
-func (*CreateSMERequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SMETaskStatusCount.ProtoReflect.Descriptor instead.
+func (*SMETaskStatusCount) Descriptor() ([]byte, []int) {
 	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *CreateSMERequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *CreateSMERequest) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *CreateSMERequest) GetDomain() string {
-	if x != nil {
-		return x.Domain
-	}
-	return ""
-}
-
-func (x *CreateSMERequest) GetScope() SMEScope {
+func (x *SMETaskStatusCount) GetStatus() SMETaskStatus {
 	if x != nil {
-		return x.Scope
+		return x.Status
 	}
-	return SMEScope_SME_SCOPE_UNSPECIFIED
+	return SMETaskStatus_SME_TASK_STATUS_UNSPECIFIED
 }
 
-func (x *CreateSMERequest) GetTeamIds() []string {
+func (x *SMETaskStatusCount) GetCount() int32 {
 	if x != nil {
-		return x.TeamIds
+		return x.Count
 	}
-	return nil
+	return 0
 }
 
-// CreateSMEResponse contains the created SME.
-type CreateSMEResponse struct {
+// SubmissionStatusCount is the number of a task's submissions in a given status.
+type SubmissionStatusCount struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Sme           *SubjectMatterExpert   `protobuf:"bytes,1,opt,name=sme,proto3" json:"sme,omitempty"`
+	Status        SubmissionStatus       `protobuf:"varint,1,opt,name=status,proto3,enum=mirai.v1.SubmissionStatus" json:"status,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateSMEResponse) Reset() {
-	*x = CreateSMEResponse{}
+func (x *SubmissionStatusCount) Reset() {
+	*x = SubmissionStatusCount{}
 	mi := &file_mirai_v1_sme_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateSMEResponse) String() string {
+func (x *SubmissionStatusCount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSMEResponse) ProtoMessage() {}
+func (*SubmissionStatusCount) ProtoMessage() {}
 
-func (x *CreateSMEResponse) ProtoReflect() protoreflect.Message {
+func (x *SubmissionStatusCount) ProtoReflect() protoreflect.Message {
 	mi := &file_mirai_v1_sme_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1003,40 +1145,49 @@ func (x *CreateSMEResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSMEResponse.ProtoReflect.Descriptor instead.
-func (*CreateSMEResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubmissionStatusCount.ProtoReflect.Descriptor instead.
+func (*SubmissionStatusCount) Descriptor() ([]byte, []int) {
 	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *CreateSMEResponse) GetSme() *SubjectMatterExpert {
+func (x *SubmissionStatusCount) GetStatus() SubmissionStatus {
 	if x != nil {
-		return x.Sme
+		return x.Status
 	}
-	return nil
+	return SubmissionStatus_SUBMISSION_STATUS_UNSPECIFIED
 }
 
-// GetSMERequest contains the SME ID to fetch.
-type GetSMERequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SmeId         string                 `protobuf:"bytes,1,opt,name=sme_id,json=smeId,proto3" json:"sme_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SubmissionStatusCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
 }
 
-func (x *GetSMERequest) Reset() {
-	*x = GetSMERequest{}
+// SubmissionSummary aggregates a task's submissions.
+type SubmissionSummary struct {
+	state             protoimpl.MessageState   `protogen:"open.v1"`
+	TotalCount        int32                    `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	StatusCounts      []*SubmissionStatusCount `protobuf:"bytes,2,rep,name=status_counts,json=statusCounts,proto3" json:"status_counts,omitempty"`
+	LatestSubmittedAt *timestamppb.Timestamp   `protobuf:"bytes,3,opt,name=latest_submitted_at,json=latestSubmittedAt,proto3,oneof" json:"latest_submitted_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SubmissionSummary) Reset() {
+	*x = SubmissionSummary{}
 	mi := &file_mirai_v1_sme_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSMERequest) String() string {
+func (x *SubmissionSummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSMERequest) ProtoMessage() {}
+func (*SubmissionSummary) ProtoMessage() {}
 
-func (x *GetSMERequest) ProtoReflect() protoreflect.Message {
+func (x *SubmissionSummary) ProtoReflect() protoreflect.Message {
 	mi := &file_mirai_v1_sme_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1048,40 +1199,64 @@ func (x *GetSMERequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSMERequest.ProtoReflect.Descriptor instead.
-func (*GetSMERequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubmissionSummary.ProtoReflect.Descriptor instead.
+func (*SubmissionSummary) Descriptor() ([]byte, []int) {
 	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetSMERequest) GetSmeId() string {
+func (x *SubmissionSummary) GetTotalCount() int32 {
 	if x != nil {
-		return x.SmeId
+		return x.TotalCount
 	}
-	return ""
+	return 0
 }
 
-// GetSMEResponse contains the requested SME.
-type GetSMEResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Sme           *SubjectMatterExpert   `protobuf:"bytes,1,opt,name=sme,proto3" json:"sme,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SubmissionSummary) GetStatusCounts() []*SubmissionStatusCount {
+	if x != nil {
+		return x.StatusCounts
+	}
+	return nil
 }
 
-func (x *GetSMEResponse) Reset() {
-	*x = GetSMEResponse{}
+func (x *SubmissionSummary) GetLatestSubmittedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LatestSubmittedAt
+	}
+	return nil
+}
+
+// SMEStats summarizes an SME's knowledge contributions.
+type SMEStats struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	SmeId                string                 `protobuf:"bytes,1,opt,name=sme_id,json=smeId,proto3" json:"sme_id,omitempty"`
+	SmeName              string                 `protobuf:"bytes,2,opt,name=sme_name,json=smeName,proto3" json:"sme_name,omitempty"`
+	SmeStatus            SMEStatus              `protobuf:"varint,3,opt,name=sme_status,json=smeStatus,proto3,enum=mirai.v1.SMEStatus" json:"sme_status,omitempty"`
+	TaskCounts           []*SMETaskStatusCount  `protobuf:"bytes,4,rep,name=task_counts,json=taskCounts,proto3" json:"task_counts,omitempty"`
+	SubmissionsProcessed int32                  `protobuf:"varint,5,opt,name=submissions_processed,json=submissionsProcessed,proto3" json:"submissions_processed,omitempty"`
+	SubmissionsFailed    int32                  `protobuf:"varint,6,opt,name=submissions_failed,json=submissionsFailed,proto3" json:"submissions_failed,omitempty"`
+	ChunkCount           int32                  `protobuf:"varint,7,opt,name=chunk_count,json=chunkCount,proto3" json:"chunk_count,omitempty"`
+	ExtractedCharacters  int64                  `protobuf:"varint,8,opt,name=extracted_characters,json=extractedCharacters,proto3" json:"extracted_characters,omitempty"`
+	LastIngestedAt       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_ingested_at,json=lastIngestedAt,proto3,oneof" json:"last_ingested_at,omitempty"`
+	CourseCount          int32                  `protobuf:"varint,10,opt,name=course_count,json=courseCount,proto3" json:"course_count,omitempty"` // Courses whose generation inputs reference this SME
+	LowCoverage          bool                   `protobuf:"varint,11,opt,name=low_coverage,json=lowCoverage,proto3" json:"low_coverage,omitempty"` // Active SME with fewer chunks than the configured minimum
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *SMEStats) Reset() {
+	*x = SMEStats{}
 	mi := &file_mirai_v1_sme_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSMEResponse) String() string {
+func (x *SMEStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSMEResponse) ProtoMessage() {}
+func (*SMEStats) ProtoMessage() {}
 
-func (x *GetSMEResponse) ProtoReflect() protoreflect.Message {
+func (x *SMEStats) ProtoReflect() protoreflect.Message {
 	mi := &file_mirai_v1_sme_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1093,109 +1268,187 @@ func (x *GetSMEResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSMEResponse.ProtoReflect.Descriptor instead.
-func (*GetSMEResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SMEStats.ProtoReflect.Descriptor instead.
+func (*SMEStats) Descriptor() ([]byte, []int) {
 	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GetSMEResponse) GetSme() *SubjectMatterExpert {
+func (x *SMEStats) GetSmeId() string {
 	if x != nil {
-		return x.Sme
+		return x.SmeId
 	}
-	return nil
+	return ""
 }
 
-// ListSMEsRequest contains optional filters.
-type ListSMEsRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Scope           *SMEScope              `protobuf:"varint,1,opt,name=scope,proto3,enum=mirai.v1.SMEScope,oneof" json:"scope,omitempty"`
-	Status          *SMEStatus             `protobuf:"varint,2,opt,name=status,proto3,enum=mirai.v1.SMEStatus,oneof" json:"status,omitempty"`
-	TeamId          *string                `protobuf:"bytes,3,opt,name=team_id,json=teamId,proto3,oneof" json:"team_id,omitempty"` // Filter by team access
-	IncludeArchived *bool                  `protobuf:"varint,4,opt,name=include_archived,json=includeArchived,proto3,oneof" json:"include_archived,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *SMEStats) GetSmeName() string {
+	if x != nil {
+		return x.SmeName
+	}
+	return ""
 }
 
-func (x *ListSMEsRequest) Reset() {
-	*x = ListSMEsRequest{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[8]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *SMEStats) GetSmeStatus() SMEStatus {
+	if x != nil {
+		return x.SmeStatus
+	}
+	return SMEStatus_SME_STATUS_UNSPECIFIED
 }
 
-func (x *ListSMEsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SMEStats) GetTaskCounts() []*SMETaskStatusCount {
+	if x != nil {
+		return x.TaskCounts
+	}
+	return nil
 }
 
-func (*ListSMEsRequest) ProtoMessage() {}
+func (x *SMEStats) GetSubmissionsProcessed() int32 {
+	if x != nil {
+		return x.SubmissionsProcessed
+	}
+	return 0
+}
 
-func (x *ListSMEsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[8]
+func (x *SMEStats) GetSubmissionsFailed() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.SubmissionsFailed
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use ListSMEsRequest.ProtoReflect.Descriptor instead.
-func (*ListSMEsRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{8}
+func (x *SMEStats) GetChunkCount() int32 {
+	if x != nil {
+		return x.ChunkCount
+	}
+	return 0
 }
 
-func (x *ListSMEsRequest) GetScope() SMEScope {
-	if x != nil && x.Scope != nil {
-		return *x.Scope
+func (x *SMEStats) GetExtractedCharacters() int64 {
+	if x != nil {
+		return x.ExtractedCharacters
 	}
-	return SMEScope_SME_SCOPE_UNSPECIFIED
+	return 0
 }
 
-func (x *ListSMEsRequest) GetStatus() SMEStatus {
-	if x != nil && x.Status != nil {
-		return *x.Status
+func (x *SMEStats) GetLastIngestedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastIngestedAt
 	}
-	return SMEStatus_SME_STATUS_UNSPECIFIED
+	return nil
 }
 
-func (x *ListSMEsRequest) GetTeamId() string {
-	if x != nil && x.TeamId != nil {
-		return *x.TeamId
+func (x *SMEStats) GetCourseCount() int32 {
+	if x != nil {
+		return x.CourseCount
 	}
-	return ""
+	return 0
 }
 
-func (x *ListSMEsRequest) GetIncludeArchived() bool {
-	if x != nil && x.IncludeArchived != nil {
-		return *x.IncludeArchived
+func (x *SMEStats) GetLowCoverage() bool {
+	if x != nil {
+		return x.LowCoverage
 	}
 	return false
 }
 
-// ListSMEsResponse contains SMEs matching the filters.
-type ListSMEsResponse struct {
+// CreateSMERequest contains data for a new SME.
+type CreateSMERequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Smes          []*SubjectMatterExpert `protobuf:"bytes,1,rep,name=smes,proto3" json:"smes,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Domain        string                 `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`
+	Scope         SMEScope               `protobuf:"varint,4,opt,name=scope,proto3,enum=mirai.v1.SMEScope" json:"scope,omitempty"`
+	TeamIds       []string               `protobuf:"bytes,5,rep,name=team_ids,json=teamIds,proto3" json:"team_ids,omitempty"` // Required if scope is TEAM
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListSMEsResponse) Reset() {
-	*x = ListSMEsResponse{}
+func (x *CreateSMERequest) Reset() {
+	*x = CreateSMERequest{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSMERequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSMERequest) ProtoMessage() {}
+
+func (x *CreateSMERequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSMERequest.ProtoReflect.Descriptor instead.
+func (*CreateSMERequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateSMERequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateSMERequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateSMERequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *CreateSMERequest) GetScope() SMEScope {
+	if x != nil {
+		return x.Scope
+	}
+	return SMEScope_SME_SCOPE_UNSPECIFIED
+}
+
+func (x *CreateSMERequest) GetTeamIds() []string {
+	if x != nil {
+		return x.TeamIds
+	}
+	return nil
+}
+
+// CreateSMEResponse contains the created SME.
+type CreateSMEResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sme           *SubjectMatterExpert   `protobuf:"bytes,1,opt,name=sme,proto3" json:"sme,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSMEResponse) Reset() {
+	*x = CreateSMEResponse{}
 	mi := &file_mirai_v1_sme_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListSMEsResponse) String() string {
+func (x *CreateSMEResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSMEsResponse) ProtoMessage() {}
+func (*CreateSMEResponse) ProtoMessage() {}
 
-func (x *ListSMEsResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateSMEResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_mirai_v1_sme_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1207,46 +1460,40 @@ func (x *ListSMEsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSMEsResponse.ProtoReflect.Descriptor instead.
-func (*ListSMEsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateSMEResponse.ProtoReflect.Descriptor instead.
+func (*CreateSMEResponse) Descriptor() ([]byte, []int) {
 	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *ListSMEsResponse) GetSmes() []*SubjectMatterExpert {
+func (x *CreateSMEResponse) GetSme() *SubjectMatterExpert {
 	if x != nil {
-		return x.Smes
+		return x.Sme
 	}
 	return nil
 }
 
-// UpdateSMERequest contains fields to update on an SME.
-type UpdateSMERequest struct {
+// GetSMERequest contains the SME ID to fetch.
+type GetSMERequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SmeId         string                 `protobuf:"bytes,1,opt,name=sme_id,json=smeId,proto3" json:"sme_id,omitempty"`
-	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	Domain        *string                `protobuf:"bytes,4,opt,name=domain,proto3,oneof" json:"domain,omitempty"`
-	Scope         *SMEScope              `protobuf:"varint,5,opt,name=scope,proto3,enum=mirai.v1.SMEScope,oneof" json:"scope,omitempty"`
-	TeamIds       []string               `protobuf:"bytes,6,rep,name=team_ids,json=teamIds,proto3" json:"team_ids,omitempty"`
-	Status        *SMEStatus             `protobuf:"varint,7,opt,name=status,proto3,enum=mirai.v1.SMEStatus,oneof" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateSMERequest) Reset() {
-	*x = UpdateSMERequest{}
+func (x *GetSMERequest) Reset() {
+	*x = GetSMERequest{}
 	mi := &file_mirai_v1_sme_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateSMERequest) String() string {
+func (x *GetSMERequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSMERequest) ProtoMessage() {}
+func (*GetSMERequest) ProtoMessage() {}
 
-func (x *UpdateSMERequest) ProtoReflect() protoreflect.Message {
+func (x *GetSMERequest) ProtoReflect() protoreflect.Message {
 	mi := &file_mirai_v1_sme_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1258,83 +1505,2210 @@ func (x *UpdateSMERequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSMERequest.ProtoReflect.Descriptor instead.
-func (*UpdateSMERequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSMERequest.ProtoReflect.Descriptor instead.
+func (*GetSMERequest) Descriptor() ([]byte, []int) {
 	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *UpdateSMERequest) GetSmeId() string {
+func (x *GetSMERequest) GetSmeId() string {
 	if x != nil {
 		return x.SmeId
 	}
 	return ""
 }
 
-func (x *UpdateSMERequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+// GetSMEResponse contains the requested SME.
+type GetSMEResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sme           *SubjectMatterExpert   `protobuf:"bytes,1,opt,name=sme,proto3" json:"sme,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSMEResponse) Reset() {
+	*x = GetSMEResponse{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSMEResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSMEResponse) ProtoMessage() {}
+
+func (x *GetSMEResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSMEResponse.ProtoReflect.Descriptor instead.
+func (*GetSMEResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetSMEResponse) GetSme() *SubjectMatterExpert {
+	if x != nil {
+		return x.Sme
+	}
+	return nil
+}
+
+// ListSMEsRequest contains optional filters.
+type ListSMEsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Scope           *SMEScope              `protobuf:"varint,1,opt,name=scope,proto3,enum=mirai.v1.SMEScope,oneof" json:"scope,omitempty"`
+	Status          *SMEStatus             `protobuf:"varint,2,opt,name=status,proto3,enum=mirai.v1.SMEStatus,oneof" json:"status,omitempty"`
+	TeamId          *string                `protobuf:"bytes,3,opt,name=team_id,json=teamId,proto3,oneof" json:"team_id,omitempty"` // Filter by team access
+	IncludeArchived *bool                  `protobuf:"varint,4,opt,name=include_archived,json=includeArchived,proto3,oneof" json:"include_archived,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListSMEsRequest) Reset() {
+	*x = ListSMEsRequest{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSMEsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSMEsRequest) ProtoMessage() {}
+
+func (x *ListSMEsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSMEsRequest.ProtoReflect.Descriptor instead.
+func (*ListSMEsRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListSMEsRequest) GetScope() SMEScope {
+	if x != nil && x.Scope != nil {
+		return *x.Scope
+	}
+	return SMEScope_SME_SCOPE_UNSPECIFIED
+}
+
+func (x *ListSMEsRequest) GetStatus() SMEStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return SMEStatus_SME_STATUS_UNSPECIFIED
+}
+
+func (x *ListSMEsRequest) GetTeamId() string {
+	if x != nil && x.TeamId != nil {
+		return *x.TeamId
 	}
 	return ""
 }
 
-func (x *UpdateSMERequest) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
+func (x *ListSMEsRequest) GetIncludeArchived() bool {
+	if x != nil && x.IncludeArchived != nil {
+		return *x.IncludeArchived
+	}
+	return false
+}
+
+// ListSMEsResponse contains SMEs matching the filters.
+type ListSMEsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Smes          []*SubjectMatterExpert `protobuf:"bytes,1,rep,name=smes,proto3" json:"smes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSMEsResponse) Reset() {
+	*x = ListSMEsResponse{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSMEsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSMEsResponse) ProtoMessage() {}
+
+func (x *ListSMEsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSMEsResponse.ProtoReflect.Descriptor instead.
+func (*ListSMEsResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListSMEsResponse) GetSmes() []*SubjectMatterExpert {
+	if x != nil {
+		return x.Smes
+	}
+	return nil
+}
+
+// UpdateSMERequest contains fields to update on an SME.
+type UpdateSMERequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SmeId         string                 `protobuf:"bytes,1,opt,name=sme_id,json=smeId,proto3" json:"sme_id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Domain        *string                `protobuf:"bytes,4,opt,name=domain,proto3,oneof" json:"domain,omitempty"`
+	Scope         *SMEScope              `protobuf:"varint,5,opt,name=scope,proto3,enum=mirai.v1.SMEScope,oneof" json:"scope,omitempty"`
+	TeamIds       []string               `protobuf:"bytes,6,rep,name=team_ids,json=teamIds,proto3" json:"team_ids,omitempty"`
+	Status        *SMEStatus             `protobuf:"varint,7,opt,name=status,proto3,enum=mirai.v1.SMEStatus,oneof" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSMERequest) Reset() {
+	*x = UpdateSMERequest{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSMERequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSMERequest) ProtoMessage() {}
+
+func (x *UpdateSMERequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSMERequest.ProtoReflect.Descriptor instead.
+func (*UpdateSMERequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateSMERequest) GetSmeId() string {
+	if x != nil {
+		return x.SmeId
+	}
+	return ""
+}
+
+func (x *UpdateSMERequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateSMERequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateSMERequest) GetDomain() string {
+	if x != nil && x.Domain != nil {
+		return *x.Domain
+	}
+	return ""
+}
+
+func (x *UpdateSMERequest) GetScope() SMEScope {
+	if x != nil && x.Scope != nil {
+		return *x.Scope
+	}
+	return SMEScope_SME_SCOPE_UNSPECIFIED
+}
+
+func (x *UpdateSMERequest) GetTeamIds() []string {
+	if x != nil {
+		return x.TeamIds
+	}
+	return nil
+}
+
+func (x *UpdateSMERequest) GetStatus() SMEStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return SMEStatus_SME_STATUS_UNSPECIFIED
+}
+
+// UpdateSMEResponse contains the updated SME.
+type UpdateSMEResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sme           *SubjectMatterExpert   `protobuf:"bytes,1,opt,name=sme,proto3" json:"sme,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSMEResponse) Reset() {
+	*x = UpdateSMEResponse{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSMEResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSMEResponse) ProtoMessage() {}
+
+func (x *UpdateSMEResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSMEResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSMEResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UpdateSMEResponse) GetSme() *SubjectMatterExpert {
+	if x != nil {
+		return x.Sme
+	}
+	return nil
+}
+
+// DeleteSMERequest contains the SME ID to delete.
+type DeleteSMERequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	SmeId             string                 `protobuf:"bytes,1,opt,name=sme_id,json=smeId,proto3" json:"sme_id,omitempty"`
+	ConfirmationToken string                 `protobuf:"bytes,2,opt,name=confirmation_token,json=confirmationToken,proto3" json:"confirmation_token,omitempty"` // From GetSMEDeletionImpact
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *DeleteSMERequest) Reset() {
+	*x = DeleteSMERequest{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSMERequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSMERequest) ProtoMessage() {}
+
+func (x *DeleteSMERequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSMERequest.ProtoReflect.Descriptor instead.
+func (*DeleteSMERequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DeleteSMERequest) GetSmeId() string {
+	if x != nil {
+		return x.SmeId
+	}
+	return ""
+}
+
+func (x *DeleteSMERequest) GetConfirmationToken() string {
+	if x != nil {
+		return x.ConfirmationToken
+	}
+	return ""
+}
+
+// DeleteSMEResponse confirms deletion.
+type DeleteSMEResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSMEResponse) Reset() {
+	*x = DeleteSMEResponse{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSMEResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSMEResponse) ProtoMessage() {}
+
+func (x *DeleteSMEResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSMEResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSMEResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{17}
+}
+
+// RestoreSMERequest contains the SME ID to restore.
+type RestoreSMERequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SmeId         string                 `protobuf:"bytes,1,opt,name=sme_id,json=smeId,proto3" json:"sme_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreSMERequest) Reset() {
+	*x = RestoreSMERequest{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreSMERequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreSMERequest) ProtoMessage() {}
+
+func (x *RestoreSMERequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreSMERequest.ProtoReflect.Descriptor instead.
+func (*RestoreSMERequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RestoreSMERequest) GetSmeId() string {
+	if x != nil {
+		return x.SmeId
+	}
+	return ""
+}
+
+// RestoreSMEResponse contains the restored SME.
+type RestoreSMEResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sme           *SubjectMatterExpert   `protobuf:"bytes,1,opt,name=sme,proto3" json:"sme,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreSMEResponse) Reset() {
+	*x = RestoreSMEResponse{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreSMEResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreSMEResponse) ProtoMessage() {}
+
+func (x *RestoreSMEResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreSMEResponse.ProtoReflect.Descriptor instead.
+func (*RestoreSMEResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RestoreSMEResponse) GetSme() *SubjectMatterExpert {
+	if x != nil {
+		return x.Sme
+	}
+	return nil
+}
+
+// CreateTaskRequest contains data for a new task.
+type CreateTaskRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	SmeId               string                 `protobuf:"bytes,1,opt,name=sme_id,json=smeId,proto3" json:"sme_id,omitempty"`
+	Title               string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description         string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	ExpectedContentType ContentType            `protobuf:"varint,4,opt,name=expected_content_type,json=expectedContentType,proto3,enum=mirai.v1.ContentType" json:"expected_content_type,omitempty"`
+	AssignedToUserId    string                 `protobuf:"bytes,5,opt,name=assigned_to_user_id,json=assignedToUserId,proto3" json:"assigned_to_user_id,omitempty"`
+	TeamId              *string                `protobuf:"bytes,6,opt,name=team_id,json=teamId,proto3,oneof" json:"team_id,omitempty"`
+	DueDate             *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=due_date,json=dueDate,proto3,oneof" json:"due_date,omitempty"`
+	Questions           []string               `protobuf:"bytes,8,rep,name=questions,proto3" json:"questions,omitempty"` // Interview questions, e.g. from GenerateTaskQuestions; at most 20
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CreateTaskRequest) Reset() {
+	*x = CreateTaskRequest{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTaskRequest) ProtoMessage() {}
+
+func (x *CreateTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTaskRequest.ProtoReflect.Descriptor instead.
+func (*CreateTaskRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CreateTaskRequest) GetSmeId() string {
+	if x != nil {
+		return x.SmeId
+	}
+	return ""
+}
+
+func (x *CreateTaskRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateTaskRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateTaskRequest) GetExpectedContentType() ContentType {
+	if x != nil {
+		return x.ExpectedContentType
+	}
+	return ContentType_CONTENT_TYPE_UNSPECIFIED
+}
+
+func (x *CreateTaskRequest) GetAssignedToUserId() string {
+	if x != nil {
+		return x.AssignedToUserId
+	}
+	return ""
+}
+
+func (x *CreateTaskRequest) GetTeamId() string {
+	if x != nil && x.TeamId != nil {
+		return *x.TeamId
+	}
+	return ""
+}
+
+func (x *CreateTaskRequest) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+func (x *CreateTaskRequest) GetQuestions() []string {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+// CreateTaskResponse contains the created task.
+type CreateTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *SMETask               `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTaskResponse) Reset() {
+	*x = CreateTaskResponse{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTaskResponse) ProtoMessage() {}
+
+func (x *CreateTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTaskResponse.ProtoReflect.Descriptor instead.
+func (*CreateTaskResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CreateTaskResponse) GetTask() *SMETask {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+// GetTaskRequest contains the task ID to fetch.
+type GetTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskRequest) Reset() {
+	*x = GetTaskRequest{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskRequest) ProtoMessage() {}
+
+func (x *GetTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskRequest.ProtoReflect.Descriptor instead.
+func (*GetTaskRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+// GetTaskResponse contains the requested task.
+type GetTaskResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Task              *SMETask               `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	SubmissionSummary *SubmissionSummary     `protobuf:"bytes,2,opt,name=submission_summary,json=submissionSummary,proto3" json:"submission_summary,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetTaskResponse) Reset() {
+	*x = GetTaskResponse{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskResponse) ProtoMessage() {}
+
+func (x *GetTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskResponse.ProtoReflect.Descriptor instead.
+func (*GetTaskResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetTaskResponse) GetTask() *SMETask {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *GetTaskResponse) GetSubmissionSummary() *SubmissionSummary {
+	if x != nil {
+		return x.SubmissionSummary
+	}
+	return nil
+}
+
+// ListTasksRequest contains filters for tasks.
+type ListTasksRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	SmeId            *string                `protobuf:"bytes,1,opt,name=sme_id,json=smeId,proto3,oneof" json:"sme_id,omitempty"`                                      // Filter by SME
+	AssignedToUserId *string                `protobuf:"bytes,2,opt,name=assigned_to_user_id,json=assignedToUserId,proto3,oneof" json:"assigned_to_user_id,omitempty"` // Filter by assignee
+	Status           *SMETaskStatus         `protobuf:"varint,3,opt,name=status,proto3,enum=mirai.v1.SMETaskStatus,oneof" json:"status,omitempty"`                    // Filter by status
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListTasksRequest) Reset() {
+	*x = ListTasksRequest{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTasksRequest) ProtoMessage() {}
+
+func (x *ListTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTasksRequest.ProtoReflect.Descriptor instead.
+func (*ListTasksRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListTasksRequest) GetSmeId() string {
+	if x != nil && x.SmeId != nil {
+		return *x.SmeId
+	}
+	return ""
+}
+
+func (x *ListTasksRequest) GetAssignedToUserId() string {
+	if x != nil && x.AssignedToUserId != nil {
+		return *x.AssignedToUserId
+	}
+	return ""
+}
+
+func (x *ListTasksRequest) GetStatus() SMETaskStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return SMETaskStatus_SME_TASK_STATUS_UNSPECIFIED
+}
+
+// ListTasksResponse contains tasks matching the filters.
+type ListTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*SMETask             `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTasksResponse) Reset() {
+	*x = ListTasksResponse{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTasksResponse) ProtoMessage() {}
+
+func (x *ListTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTasksResponse.ProtoReflect.Descriptor instead.
+func (*ListTasksResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListTasksResponse) GetTasks() []*SMETask {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+// UpdateTaskRequest contains fields to update on a task.
+type UpdateTaskRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	TaskId              string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Title               *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Description         *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	ExpectedContentType *ContentType           `protobuf:"varint,4,opt,name=expected_content_type,json=expectedContentType,proto3,enum=mirai.v1.ContentType,oneof" json:"expected_content_type,omitempty"`
+	DueDate             *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=due_date,json=dueDate,proto3,oneof" json:"due_date,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *UpdateTaskRequest) Reset() {
+	*x = UpdateTaskRequest{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaskRequest) ProtoMessage() {}
+
+func (x *UpdateTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaskRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTaskRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UpdateTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *UpdateTaskRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *UpdateTaskRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateTaskRequest) GetExpectedContentType() ContentType {
+	if x != nil && x.ExpectedContentType != nil {
+		return *x.ExpectedContentType
+	}
+	return ContentType_CONTENT_TYPE_UNSPECIFIED
+}
+
+func (x *UpdateTaskRequest) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+// UpdateTaskResponse contains the updated task.
+type UpdateTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *SMETask               `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTaskResponse) Reset() {
+	*x = UpdateTaskResponse{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaskResponse) ProtoMessage() {}
+
+func (x *UpdateTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaskResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTaskResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *UpdateTaskResponse) GetTask() *SMETask {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+// CancelTaskRequest contains the task ID to cancel.
+type CancelTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelTaskRequest) Reset() {
+	*x = CancelTaskRequest{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelTaskRequest) ProtoMessage() {}
+
+func (x *CancelTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelTaskRequest.ProtoReflect.Descriptor instead.
+func (*CancelTaskRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CancelTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+// CancelTaskResponse confirms cancellation.
+type CancelTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *SMETask               `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelTaskResponse) Reset() {
+	*x = CancelTaskResponse{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelTaskResponse) ProtoMessage() {}
+
+func (x *CancelTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelTaskResponse.ProtoReflect.Descriptor instead.
+func (*CancelTaskResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CancelTaskResponse) GetTask() *SMETask {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+// GetUploadURLRequest requests a presigned URL for upload.
+type GetUploadURLRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	FileName      string                 `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType   ContentType            `protobuf:"varint,3,opt,name=content_type,json=contentType,proto3,enum=mirai.v1.ContentType" json:"content_type,omitempty"`
+	FileSizeBytes int64                  `protobuf:"varint,4,opt,name=file_size_bytes,json=fileSizeBytes,proto3" json:"file_size_bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUploadURLRequest) Reset() {
+	*x = GetUploadURLRequest{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUploadURLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUploadURLRequest) ProtoMessage() {}
+
+func (x *GetUploadURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUploadURLRequest.ProtoReflect.Descriptor instead.
+func (*GetUploadURLRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetUploadURLRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *GetUploadURLRequest) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *GetUploadURLRequest) GetContentType() ContentType {
+	if x != nil {
+		return x.ContentType
+	}
+	return ContentType_CONTENT_TYPE_UNSPECIFIED
+}
+
+func (x *GetUploadURLRequest) GetFileSizeBytes() int64 {
+	if x != nil {
+		return x.FileSizeBytes
+	}
+	return 0
+}
+
+// GetUploadURLResponse contains the presigned upload URL.
+type GetUploadURLResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UploadUrl     string                 `protobuf:"bytes,1,opt,name=upload_url,json=uploadUrl,proto3" json:"upload_url,omitempty"`
+	FilePath      string                 `protobuf:"bytes,2,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"` // S3 path where file will be stored
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUploadURLResponse) Reset() {
+	*x = GetUploadURLResponse{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUploadURLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUploadURLResponse) ProtoMessage() {}
+
+func (x *GetUploadURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUploadURLResponse.ProtoReflect.Descriptor instead.
+func (*GetUploadURLResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetUploadURLResponse) GetUploadUrl() string {
+	if x != nil {
+		return x.UploadUrl
+	}
+	return ""
+}
+
+func (x *GetUploadURLResponse) GetFilePath() string {
+	if x != nil {
+		return x.FilePath
+	}
+	return ""
+}
+
+func (x *GetUploadURLResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// StartMultipartUploadRequest starts a resumable upload. Files over 100 MiB should
+// use this instead of GetUploadURL; files under 5 MiB cannot.
+type StartMultipartUploadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	FileName      string                 `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	FileSizeBytes int64                  `protobuf:"varint,3,opt,name=file_size_bytes,json=fileSizeBytes,proto3" json:"file_size_bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartMultipartUploadRequest) Reset() {
+	*x = StartMultipartUploadRequest{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartMultipartUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartMultipartUploadRequest) ProtoMessage() {}
+
+func (x *StartMultipartUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartMultipartUploadRequest.ProtoReflect.Descriptor instead.
+func (*StartMultipartUploadRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *StartMultipartUploadRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *StartMultipartUploadRequest) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *StartMultipartUploadRequest) GetFileSizeBytes() int64 {
+	if x != nil {
+		return x.FileSizeBytes
+	}
+	return 0
+}
+
+// StartMultipartUploadResponse describes how to split the file. Every part except
+// the last must be exactly part_size_bytes.
+type StartMultipartUploadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UploadId      string                 `protobuf:"bytes,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	FilePath      string                 `protobuf:"bytes,2,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"` // Pass to GetPartUploadURLs, CompleteMultipartUpload and SubmitContent
+	PartSizeBytes int64                  `protobuf:"varint,3,opt,name=part_size_bytes,json=partSizeBytes,proto3" json:"part_size_bytes,omitempty"`
+	PartCount     int32                  `protobuf:"varint,4,opt,name=part_count,json=partCount,proto3" json:"part_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartMultipartUploadResponse) Reset() {
+	*x = StartMultipartUploadResponse{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartMultipartUploadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartMultipartUploadResponse) ProtoMessage() {}
+
+func (x *StartMultipartUploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartMultipartUploadResponse.ProtoReflect.Descriptor instead.
+func (*StartMultipartUploadResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *StartMultipartUploadResponse) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+func (x *StartMultipartUploadResponse) GetFilePath() string {
+	if x != nil {
+		return x.FilePath
+	}
+	return ""
+}
+
+func (x *StartMultipartUploadResponse) GetPartSizeBytes() int64 {
+	if x != nil {
+		return x.PartSizeBytes
+	}
+	return 0
+}
+
+func (x *StartMultipartUploadResponse) GetPartCount() int32 {
+	if x != nil {
+		return x.PartCount
+	}
+	return 0
+}
+
+// GetPartUploadURLsRequest requests presigned URLs for up to 100 parts.
+type GetPartUploadURLsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	FilePath      string                 `protobuf:"bytes,2,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"`
+	UploadId      string                 `protobuf:"bytes,3,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	FileSizeBytes int64                  `protobuf:"varint,4,opt,name=file_size_bytes,json=fileSizeBytes,proto3" json:"file_size_bytes,omitempty"` // Same size as passed to StartMultipartUpload
+	PartNumbers   []int32                `protobuf:"varint,5,rep,packed,name=part_numbers,json=partNumbers,proto3" json:"part_numbers,omitempty"`  // 1-based
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPartUploadURLsRequest) Reset() {
+	*x = GetPartUploadURLsRequest{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPartUploadURLsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPartUploadURLsRequest) ProtoMessage() {}
+
+func (x *GetPartUploadURLsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPartUploadURLsRequest.ProtoReflect.Descriptor instead.
+func (*GetPartUploadURLsRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetPartUploadURLsRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *GetPartUploadURLsRequest) GetFilePath() string {
+	if x != nil {
+		return x.FilePath
+	}
+	return ""
+}
+
+func (x *GetPartUploadURLsRequest) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+func (x *GetPartUploadURLsRequest) GetFileSizeBytes() int64 {
+	if x != nil {
+		return x.FileSizeBytes
+	}
+	return 0
+}
+
+func (x *GetPartUploadURLsRequest) GetPartNumbers() []int32 {
+	if x != nil {
+		return x.PartNumbers
+	}
+	return nil
+}
+
+// PartUploadURL is a presigned PUT URL for one part.
+type PartUploadURL struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PartNumber    int32                  `protobuf:"varint,1,opt,name=part_number,json=partNumber,proto3" json:"part_number,omitempty"`
+	UploadUrl     string                 `protobuf:"bytes,2,opt,name=upload_url,json=uploadUrl,proto3" json:"upload_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartUploadURL) Reset() {
+	*x = PartUploadURL{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartUploadURL) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartUploadURL) ProtoMessage() {}
+
+func (x *PartUploadURL) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartUploadURL.ProtoReflect.Descriptor instead.
+func (*PartUploadURL) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *PartUploadURL) GetPartNumber() int32 {
+	if x != nil {
+		return x.PartNumber
+	}
+	return 0
+}
+
+func (x *PartUploadURL) GetUploadUrl() string {
+	if x != nil {
+		return x.UploadUrl
+	}
+	return ""
+}
+
+// GetPartUploadURLsResponse contains the part URLs and the parts already stored,
+// so a resumed upload can skip them.
+type GetPartUploadURLsResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Parts               []*PartUploadURL       `protobuf:"bytes,1,rep,name=parts,proto3" json:"parts,omitempty"`
+	UploadedPartNumbers []int32                `protobuf:"varint,2,rep,packed,name=uploaded_part_numbers,json=uploadedPartNumbers,proto3" json:"uploaded_part_numbers,omitempty"`
+	ExpiresAt           *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GetPartUploadURLsResponse) Reset() {
+	*x = GetPartUploadURLsResponse{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPartUploadURLsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPartUploadURLsResponse) ProtoMessage() {}
+
+func (x *GetPartUploadURLsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPartUploadURLsResponse.ProtoReflect.Descriptor instead.
+func (*GetPartUploadURLsResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetPartUploadURLsResponse) GetParts() []*PartUploadURL {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+func (x *GetPartUploadURLsResponse) GetUploadedPartNumbers() []int32 {
+	if x != nil {
+		return x.UploadedPartNumbers
+	}
+	return nil
+}
+
+func (x *GetPartUploadURLsResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// CompleteMultipartUploadRequest assembles an upload once every part is stored.
+type CompleteMultipartUploadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	FilePath      string                 `protobuf:"bytes,2,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"`
+	UploadId      string                 `protobuf:"bytes,3,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	FileSizeBytes int64                  `protobuf:"varint,4,opt,name=file_size_bytes,json=fileSizeBytes,proto3" json:"file_size_bytes,omitempty"` // Same size as passed to StartMultipartUpload
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteMultipartUploadRequest) Reset() {
+	*x = CompleteMultipartUploadRequest{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteMultipartUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteMultipartUploadRequest) ProtoMessage() {}
+
+func (x *CompleteMultipartUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteMultipartUploadRequest.ProtoReflect.Descriptor instead.
+func (*CompleteMultipartUploadRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CompleteMultipartUploadRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *CompleteMultipartUploadRequest) GetFilePath() string {
+	if x != nil {
+		return x.FilePath
+	}
+	return ""
+}
+
+func (x *CompleteMultipartUploadRequest) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+func (x *CompleteMultipartUploadRequest) GetFileSizeBytes() int64 {
+	if x != nil {
+		return x.FileSizeBytes
+	}
+	return 0
+}
+
+// CompleteMultipartUploadResponse contains the path of the assembled file.
+type CompleteMultipartUploadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FilePath      string                 `protobuf:"bytes,1,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteMultipartUploadResponse) Reset() {
+	*x = CompleteMultipartUploadResponse{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteMultipartUploadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteMultipartUploadResponse) ProtoMessage() {}
+
+func (x *CompleteMultipartUploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteMultipartUploadResponse.ProtoReflect.Descriptor instead.
+func (*CompleteMultipartUploadResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CompleteMultipartUploadResponse) GetFilePath() string {
+	if x != nil {
+		return x.FilePath
+	}
+	return ""
+}
+
+// SubmitContentRequest records a content submission.
+type SubmitContentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	FileName      string                 `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"` // Optional for text submissions
+	FilePath      string                 `protobuf:"bytes,3,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"` // S3 path from GetUploadURL (optional for text)
+	ContentType   ContentType            `protobuf:"varint,4,opt,name=content_type,json=contentType,proto3,enum=mirai.v1.ContentType" json:"content_type,omitempty"`
+	FileSizeBytes int64                  `protobuf:"varint,5,opt,name=file_size_bytes,json=fileSizeBytes,proto3" json:"file_size_bytes,omitempty"` // Optional for text submissions
+	TextContent   *string                `protobuf:"bytes,6,opt,name=text_content,json=textContent,proto3,oneof" json:"text_content,omitempty"`    // Direct text content (for CONTENT_TYPE_TEXT)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitContentRequest) Reset() {
+	*x = SubmitContentRequest{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitContentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitContentRequest) ProtoMessage() {}
+
+func (x *SubmitContentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitContentRequest.ProtoReflect.Descriptor instead.
+func (*SubmitContentRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SubmitContentRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *SubmitContentRequest) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *SubmitContentRequest) GetFilePath() string {
+	if x != nil {
+		return x.FilePath
+	}
+	return ""
+}
+
+func (x *SubmitContentRequest) GetContentType() ContentType {
+	if x != nil {
+		return x.ContentType
+	}
+	return ContentType_CONTENT_TYPE_UNSPECIFIED
+}
+
+func (x *SubmitContentRequest) GetFileSizeBytes() int64 {
+	if x != nil {
+		return x.FileSizeBytes
+	}
+	return 0
+}
+
+func (x *SubmitContentRequest) GetTextContent() string {
+	if x != nil && x.TextContent != nil {
+		return *x.TextContent
+	}
+	return ""
+}
+
+// SubmitContentResponse contains the created submission.
+type SubmitContentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Submission    *SMETaskSubmission     `protobuf:"bytes,1,opt,name=submission,proto3" json:"submission,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitContentResponse) Reset() {
+	*x = SubmitContentResponse{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitContentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitContentResponse) ProtoMessage() {}
+
+func (x *SubmitContentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitContentResponse.ProtoReflect.Descriptor instead.
+func (*SubmitContentResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SubmitContentResponse) GetSubmission() *SMETaskSubmission {
+	if x != nil {
+		return x.Submission
+	}
+	return nil
+}
+
+// ListSubmissionsRequest contains the task ID and filters.
+type ListSubmissionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Status        *SubmissionStatus      `protobuf:"varint,2,opt,name=status,proto3,enum=mirai.v1.SubmissionStatus,oneof" json:"status,omitempty"` // Filter by status
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`                                        // Max results (default 20, max 100)
+	Cursor        *string                `protobuf:"bytes,4,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`                                 // For pagination
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubmissionsRequest) Reset() {
+	*x = ListSubmissionsRequest{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubmissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubmissionsRequest) ProtoMessage() {}
+
+func (x *ListSubmissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubmissionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSubmissionsRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListSubmissionsRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *ListSubmissionsRequest) GetStatus() SubmissionStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return SubmissionStatus_SUBMISSION_STATUS_UNSPECIFIED
+}
+
+func (x *ListSubmissionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListSubmissionsRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+// ListSubmissionsResponse contains a page of the task's submissions, newest first.
+type ListSubmissionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Submissions   []*SMETaskSubmission   `protobuf:"bytes,1,rep,name=submissions,proto3" json:"submissions,omitempty"`
+	NextCursor    *string                `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3,oneof" json:"next_cursor,omitempty"` // For pagination
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubmissionsResponse) Reset() {
+	*x = ListSubmissionsResponse{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubmissionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubmissionsResponse) ProtoMessage() {}
+
+func (x *ListSubmissionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubmissionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSubmissionsResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListSubmissionsResponse) GetSubmissions() []*SMETaskSubmission {
+	if x != nil {
+		return x.Submissions
+	}
+	return nil
+}
+
+func (x *ListSubmissionsResponse) GetNextCursor() string {
+	if x != nil && x.NextCursor != nil {
+		return *x.NextCursor
+	}
+	return ""
+}
+
+// GetKnowledgeRequest requests knowledge for an SME.
+type GetKnowledgeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SmeId         string                 `protobuf:"bytes,1,opt,name=sme_id,json=smeId,proto3" json:"sme_id,omitempty"`
+	Topic         *string                `protobuf:"bytes,2,opt,name=topic,proto3,oneof" json:"topic,omitempty"` // Only chunks filed under this topic
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetKnowledgeRequest) Reset() {
+	*x = GetKnowledgeRequest{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKnowledgeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKnowledgeRequest) ProtoMessage() {}
+
+func (x *GetKnowledgeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKnowledgeRequest.ProtoReflect.Descriptor instead.
+func (*GetKnowledgeRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetKnowledgeRequest) GetSmeId() string {
+	if x != nil {
+		return x.SmeId
+	}
+	return ""
+}
+
+func (x *GetKnowledgeRequest) GetTopic() string {
+	if x != nil && x.Topic != nil {
+		return *x.Topic
+	}
+	return ""
+}
+
+// GetKnowledgeResponse contains the SME's knowledge.
+type GetKnowledgeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sme           *SubjectMatterExpert   `protobuf:"bytes,1,opt,name=sme,proto3" json:"sme,omitempty"`
+	Chunks        []*SMEKnowledgeChunk   `protobuf:"bytes,2,rep,name=chunks,proto3" json:"chunks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetKnowledgeResponse) Reset() {
+	*x = GetKnowledgeResponse{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKnowledgeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKnowledgeResponse) ProtoMessage() {}
+
+func (x *GetKnowledgeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKnowledgeResponse.ProtoReflect.Descriptor instead.
+func (*GetKnowledgeResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetKnowledgeResponse) GetSme() *SubjectMatterExpert {
+	if x != nil {
+		return x.Sme
+	}
+	return nil
+}
+
+func (x *GetKnowledgeResponse) GetChunks() []*SMEKnowledgeChunk {
+	if x != nil {
+		return x.Chunks
+	}
+	return nil
+}
+
+// ListKnowledgeTopicsRequest requests the topics of an SME's knowledge.
+type ListKnowledgeTopicsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SmeId         string                 `protobuf:"bytes,1,opt,name=sme_id,json=smeId,proto3" json:"sme_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListKnowledgeTopicsRequest) Reset() {
+	*x = ListKnowledgeTopicsRequest{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListKnowledgeTopicsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKnowledgeTopicsRequest) ProtoMessage() {}
+
+func (x *ListKnowledgeTopicsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKnowledgeTopicsRequest.ProtoReflect.Descriptor instead.
+func (*ListKnowledgeTopicsRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListKnowledgeTopicsRequest) GetSmeId() string {
+	if x != nil {
+		return x.SmeId
+	}
+	return ""
+}
+
+// KnowledgeTopic is a topic label and how many chunks are filed under it.
+type KnowledgeTopic struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Topic         string                 `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	ChunkCount    int32                  `protobuf:"varint,2,opt,name=chunk_count,json=chunkCount,proto3" json:"chunk_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KnowledgeTopic) Reset() {
+	*x = KnowledgeTopic{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KnowledgeTopic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KnowledgeTopic) ProtoMessage() {}
+
+func (x *KnowledgeTopic) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KnowledgeTopic.ProtoReflect.Descriptor instead.
+func (*KnowledgeTopic) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *KnowledgeTopic) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *KnowledgeTopic) GetChunkCount() int32 {
+	if x != nil {
+		return x.ChunkCount
+	}
+	return 0
+}
+
+// ListKnowledgeTopicsResponse contains the SME's topics, largest first.
+type ListKnowledgeTopicsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Topics        []*KnowledgeTopic      `protobuf:"bytes,1,rep,name=topics,proto3" json:"topics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListKnowledgeTopicsResponse) Reset() {
+	*x = ListKnowledgeTopicsResponse{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListKnowledgeTopicsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKnowledgeTopicsResponse) ProtoMessage() {}
+
+func (x *ListKnowledgeTopicsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKnowledgeTopicsResponse.ProtoReflect.Descriptor instead.
+func (*ListKnowledgeTopicsResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListKnowledgeTopicsResponse) GetTopics() []*KnowledgeTopic {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+// SearchKnowledgeRequest searches across SME knowledge.
+type SearchKnowledgeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SmeIds        []string               `protobuf:"bytes,1,rep,name=sme_ids,json=smeIds,proto3" json:"sme_ids,omitempty"` // SMEs to search within
+	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`                 // Search query
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`                // Max results
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchKnowledgeRequest) Reset() {
+	*x = SearchKnowledgeRequest{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchKnowledgeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchKnowledgeRequest) ProtoMessage() {}
+
+func (x *SearchKnowledgeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateSMERequest) GetDomain() string {
-	if x != nil && x.Domain != nil {
-		return *x.Domain
-	}
-	return ""
+// Deprecated: Use SearchKnowledgeRequest.ProtoReflect.Descriptor instead.
+func (*SearchKnowledgeRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *UpdateSMERequest) GetScope() SMEScope {
-	if x != nil && x.Scope != nil {
-		return *x.Scope
+func (x *SearchKnowledgeRequest) GetSmeIds() []string {
+	if x != nil {
+		return x.SmeIds
 	}
-	return SMEScope_SME_SCOPE_UNSPECIFIED
+	return nil
 }
 
-func (x *UpdateSMERequest) GetTeamIds() []string {
+func (x *SearchKnowledgeRequest) GetQuery() string {
 	if x != nil {
-		return x.TeamIds
+		return x.Query
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateSMERequest) GetStatus() SMEStatus {
-	if x != nil && x.Status != nil {
-		return *x.Status
+func (x *SearchKnowledgeRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
 	}
-	return SMEStatus_SME_STATUS_UNSPECIFIED
+	return 0
 }
 
-// UpdateSMEResponse contains the updated SME.
-type UpdateSMEResponse struct {
+// SearchKnowledgeResponse contains matching knowledge chunks.
+type SearchKnowledgeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Sme           *SubjectMatterExpert   `protobuf:"bytes,1,opt,name=sme,proto3" json:"sme,omitempty"`
+	Chunks        []*SMEKnowledgeChunk   `protobuf:"bytes,1,rep,name=chunks,proto3" json:"chunks,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateSMEResponse) Reset() {
-	*x = UpdateSMEResponse{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[11]
+func (x *SearchKnowledgeResponse) Reset() {
+	*x = SearchKnowledgeResponse{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateSMEResponse) String() string {
+func (x *SearchKnowledgeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSMEResponse) ProtoMessage() {}
+func (*SearchKnowledgeResponse) ProtoMessage() {}
 
-func (x *UpdateSMEResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[11]
+func (x *SearchKnowledgeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1345,41 +3719,41 @@ func (x *UpdateSMEResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSMEResponse.ProtoReflect.Descriptor instead.
-func (*UpdateSMEResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use SearchKnowledgeResponse.ProtoReflect.Descriptor instead.
+func (*SearchKnowledgeResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *UpdateSMEResponse) GetSme() *SubjectMatterExpert {
+func (x *SearchKnowledgeResponse) GetChunks() []*SMEKnowledgeChunk {
 	if x != nil {
-		return x.Sme
+		return x.Chunks
 	}
 	return nil
 }
 
-// DeleteSMERequest contains the SME ID to delete.
-type DeleteSMERequest struct {
+// GetSubmissionRequest requests a specific submission.
+type GetSubmissionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SmeId         string                 `protobuf:"bytes,1,opt,name=sme_id,json=smeId,proto3" json:"sme_id,omitempty"`
+	SubmissionId  string                 `protobuf:"bytes,1,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteSMERequest) Reset() {
-	*x = DeleteSMERequest{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[12]
+func (x *GetSubmissionRequest) Reset() {
+	*x = GetSubmissionRequest{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteSMERequest) String() string {
+func (x *GetSubmissionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteSMERequest) ProtoMessage() {}
+func (*GetSubmissionRequest) ProtoMessage() {}
 
-func (x *DeleteSMERequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[12]
+func (x *GetSubmissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1390,40 +3764,41 @@ func (x *DeleteSMERequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteSMERequest.ProtoReflect.Descriptor instead.
-func (*DeleteSMERequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use GetSubmissionRequest.ProtoReflect.Descriptor instead.
+func (*GetSubmissionRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *DeleteSMERequest) GetSmeId() string {
+func (x *GetSubmissionRequest) GetSubmissionId() string {
 	if x != nil {
-		return x.SmeId
+		return x.SubmissionId
 	}
 	return ""
 }
 
-// DeleteSMEResponse confirms deletion.
-type DeleteSMEResponse struct {
+// GetSubmissionResponse contains the requested submission.
+type GetSubmissionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Submission    *SMETaskSubmission     `protobuf:"bytes,1,opt,name=submission,proto3" json:"submission,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteSMEResponse) Reset() {
-	*x = DeleteSMEResponse{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[13]
+func (x *GetSubmissionResponse) Reset() {
+	*x = GetSubmissionResponse{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteSMEResponse) String() string {
+func (x *GetSubmissionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteSMEResponse) ProtoMessage() {}
+func (*GetSubmissionResponse) ProtoMessage() {}
 
-func (x *DeleteSMEResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[13]
+func (x *GetSubmissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1434,34 +3809,42 @@ func (x *DeleteSMEResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteSMEResponse.ProtoReflect.Descriptor instead.
-func (*DeleteSMEResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use GetSubmissionResponse.ProtoReflect.Descriptor instead.
+func (*GetSubmissionResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{51}
 }
 
-// RestoreSMERequest contains the SME ID to restore.
-type RestoreSMERequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SmeId         string                 `protobuf:"bytes,1,opt,name=sme_id,json=smeId,proto3" json:"sme_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetSubmissionResponse) GetSubmission() *SMETaskSubmission {
+	if x != nil {
+		return x.Submission
+	}
+	return nil
 }
 
-func (x *RestoreSMERequest) Reset() {
-	*x = RestoreSMERequest{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[14]
+// ReprocessSubmissionRequest retries a failed ingestion.
+type ReprocessSubmissionRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	SubmissionId        string                 `protobuf:"bytes,1,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+	ReplacementFilePath *string                `protobuf:"bytes,2,opt,name=replacement_file_path,json=replacementFilePath,proto3,oneof" json:"replacement_file_path,omitempty"` // Path from GetUploadURL; omit to re-run the original file
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ReprocessSubmissionRequest) Reset() {
+	*x = ReprocessSubmissionRequest{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RestoreSMERequest) String() string {
+func (x *ReprocessSubmissionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RestoreSMERequest) ProtoMessage() {}
+func (*ReprocessSubmissionRequest) ProtoMessage() {}
 
-func (x *RestoreSMERequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[14]
+func (x *ReprocessSubmissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1472,41 +3855,49 @@ func (x *RestoreSMERequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RestoreSMERequest.ProtoReflect.Descriptor instead.
-func (*RestoreSMERequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ReprocessSubmissionRequest.ProtoReflect.Descriptor instead.
+func (*ReprocessSubmissionRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *RestoreSMERequest) GetSmeId() string {
+func (x *ReprocessSubmissionRequest) GetSubmissionId() string {
 	if x != nil {
-		return x.SmeId
+		return x.SubmissionId
 	}
 	return ""
 }
 
-// RestoreSMEResponse contains the restored SME.
-type RestoreSMEResponse struct {
+func (x *ReprocessSubmissionRequest) GetReplacementFilePath() string {
+	if x != nil && x.ReplacementFilePath != nil {
+		return *x.ReplacementFilePath
+	}
+	return ""
+}
+
+// ReprocessSubmissionResponse contains the reset submission and the new ingestion job.
+type ReprocessSubmissionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Sme           *SubjectMatterExpert   `protobuf:"bytes,1,opt,name=sme,proto3" json:"sme,omitempty"`
+	Submission    *SMETaskSubmission     `protobuf:"bytes,1,opt,name=submission,proto3" json:"submission,omitempty"`
+	JobId         string                 `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RestoreSMEResponse) Reset() {
-	*x = RestoreSMEResponse{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[15]
+func (x *ReprocessSubmissionResponse) Reset() {
+	*x = ReprocessSubmissionResponse{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RestoreSMEResponse) String() string {
+func (x *ReprocessSubmissionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RestoreSMEResponse) ProtoMessage() {}
+func (*ReprocessSubmissionResponse) ProtoMessage() {}
 
-func (x *RestoreSMEResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[15]
+func (x *ReprocessSubmissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1517,47 +3908,49 @@ func (x *RestoreSMEResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RestoreSMEResponse.ProtoReflect.Descriptor instead.
-func (*RestoreSMEResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ReprocessSubmissionResponse.ProtoReflect.Descriptor instead.
+func (*ReprocessSubmissionResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *RestoreSMEResponse) GetSme() *SubjectMatterExpert {
+func (x *ReprocessSubmissionResponse) GetSubmission() *SMETaskSubmission {
 	if x != nil {
-		return x.Sme
+		return x.Submission
 	}
 	return nil
 }
 
-// CreateTaskRequest contains data for a new task.
-type CreateTaskRequest struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	SmeId               string                 `protobuf:"bytes,1,opt,name=sme_id,json=smeId,proto3" json:"sme_id,omitempty"`
-	Title               string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description         string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	ExpectedContentType ContentType            `protobuf:"varint,4,opt,name=expected_content_type,json=expectedContentType,proto3,enum=mirai.v1.ContentType" json:"expected_content_type,omitempty"`
-	AssignedToUserId    string                 `protobuf:"bytes,5,opt,name=assigned_to_user_id,json=assignedToUserId,proto3" json:"assigned_to_user_id,omitempty"`
-	TeamId              *string                `protobuf:"bytes,6,opt,name=team_id,json=teamId,proto3,oneof" json:"team_id,omitempty"`
-	DueDate             *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=due_date,json=dueDate,proto3,oneof" json:"due_date,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+func (x *ReprocessSubmissionResponse) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
 }
 
-func (x *CreateTaskRequest) Reset() {
-	*x = CreateTaskRequest{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[16]
+// ApproveSubmissionRequest approves a submission and creates knowledge.
+type ApproveSubmissionRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SubmissionId    string                 `protobuf:"bytes,1,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+	ApprovedContent string                 `protobuf:"bytes,2,opt,name=approved_content,json=approvedContent,proto3" json:"approved_content,omitempty"` // The final content to use (may be edited by reviewer)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ApproveSubmissionRequest) Reset() {
+	*x = ApproveSubmissionRequest{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTaskRequest) String() string {
+func (x *ApproveSubmissionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTaskRequest) ProtoMessage() {}
+func (*ApproveSubmissionRequest) ProtoMessage() {}
 
-func (x *CreateTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[16]
+func (x *ApproveSubmissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1568,83 +3961,49 @@ func (x *CreateTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTaskRequest.ProtoReflect.Descriptor instead.
-func (*CreateTaskRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *CreateTaskRequest) GetSmeId() string {
-	if x != nil {
-		return x.SmeId
-	}
-	return ""
-}
-
-func (x *CreateTaskRequest) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
+// Deprecated: Use ApproveSubmissionRequest.ProtoReflect.Descriptor instead.
+func (*ApproveSubmissionRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *CreateTaskRequest) GetDescription() string {
+func (x *ApproveSubmissionRequest) GetSubmissionId() string {
 	if x != nil {
-		return x.Description
+		return x.SubmissionId
 	}
 	return ""
 }
 
-func (x *CreateTaskRequest) GetExpectedContentType() ContentType {
-	if x != nil {
-		return x.ExpectedContentType
-	}
-	return ContentType_CONTENT_TYPE_UNSPECIFIED
-}
-
-func (x *CreateTaskRequest) GetAssignedToUserId() string {
+func (x *ApproveSubmissionRequest) GetApprovedContent() string {
 	if x != nil {
-		return x.AssignedToUserId
-	}
-	return ""
-}
-
-func (x *CreateTaskRequest) GetTeamId() string {
-	if x != nil && x.TeamId != nil {
-		return *x.TeamId
+		return x.ApprovedContent
 	}
 	return ""
 }
 
-func (x *CreateTaskRequest) GetDueDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.DueDate
-	}
-	return nil
-}
-
-// CreateTaskResponse contains the created task.
-type CreateTaskResponse struct {
+// ApproveSubmissionResponse contains the approved submission and created knowledge.
+type ApproveSubmissionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Task          *SMETask               `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	Submission    *SMETaskSubmission     `protobuf:"bytes,1,opt,name=submission,proto3" json:"submission,omitempty"`
+	CreatedChunks []*SMEKnowledgeChunk   `protobuf:"bytes,2,rep,name=created_chunks,json=createdChunks,proto3" json:"created_chunks,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTaskResponse) Reset() {
-	*x = CreateTaskResponse{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[17]
+func (x *ApproveSubmissionResponse) Reset() {
+	*x = ApproveSubmissionResponse{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTaskResponse) String() string {
+func (x *ApproveSubmissionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTaskResponse) ProtoMessage() {}
+func (*ApproveSubmissionResponse) ProtoMessage() {}
 
-func (x *CreateTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[17]
+func (x *ApproveSubmissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1655,41 +4014,49 @@ func (x *CreateTaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTaskResponse.ProtoReflect.Descriptor instead.
-func (*CreateTaskResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ApproveSubmissionResponse.ProtoReflect.Descriptor instead.
+func (*ApproveSubmissionResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *CreateTaskResponse) GetTask() *SMETask {
+func (x *ApproveSubmissionResponse) GetSubmission() *SMETaskSubmission {
 	if x != nil {
-		return x.Task
+		return x.Submission
 	}
 	return nil
 }
 
-// GetTaskRequest contains the task ID to fetch.
-type GetTaskRequest struct {
+func (x *ApproveSubmissionResponse) GetCreatedChunks() []*SMEKnowledgeChunk {
+	if x != nil {
+		return x.CreatedChunks
+	}
+	return nil
+}
+
+// RequestSubmissionChangesRequest sends submission back for revision.
+type RequestSubmissionChangesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	SubmissionId  string                 `protobuf:"bytes,1,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+	Feedback      string                 `protobuf:"bytes,2,opt,name=feedback,proto3" json:"feedback,omitempty"` // What needs to change
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTaskRequest) Reset() {
-	*x = GetTaskRequest{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[18]
+func (x *RequestSubmissionChangesRequest) Reset() {
+	*x = RequestSubmissionChangesRequest{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTaskRequest) String() string {
+func (x *RequestSubmissionChangesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTaskRequest) ProtoMessage() {}
+func (*RequestSubmissionChangesRequest) ProtoMessage() {}
 
-func (x *GetTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[18]
+func (x *RequestSubmissionChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1700,41 +4067,48 @@ func (x *GetTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTaskRequest.ProtoReflect.Descriptor instead.
-func (*GetTaskRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use RequestSubmissionChangesRequest.ProtoReflect.Descriptor instead.
+func (*RequestSubmissionChangesRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *GetTaskRequest) GetTaskId() string {
+func (x *RequestSubmissionChangesRequest) GetSubmissionId() string {
 	if x != nil {
-		return x.TaskId
+		return x.SubmissionId
 	}
 	return ""
 }
 
-// GetTaskResponse contains the requested task.
-type GetTaskResponse struct {
+func (x *RequestSubmissionChangesRequest) GetFeedback() string {
+	if x != nil {
+		return x.Feedback
+	}
+	return ""
+}
+
+// RequestSubmissionChangesResponse contains the updated submission.
+type RequestSubmissionChangesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Task          *SMETask               `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	Submission    *SMETaskSubmission     `protobuf:"bytes,1,opt,name=submission,proto3" json:"submission,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTaskResponse) Reset() {
-	*x = GetTaskResponse{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[19]
+func (x *RequestSubmissionChangesResponse) Reset() {
+	*x = RequestSubmissionChangesResponse{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTaskResponse) String() string {
+func (x *RequestSubmissionChangesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTaskResponse) ProtoMessage() {}
+func (*RequestSubmissionChangesResponse) ProtoMessage() {}
 
-func (x *GetTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[19]
+func (x *RequestSubmissionChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1745,43 +4119,42 @@ func (x *GetTaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTaskResponse.ProtoReflect.Descriptor instead.
-func (*GetTaskResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use RequestSubmissionChangesResponse.ProtoReflect.Descriptor instead.
+func (*RequestSubmissionChangesResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *GetTaskResponse) GetTask() *SMETask {
+func (x *RequestSubmissionChangesResponse) GetSubmission() *SMETaskSubmission {
 	if x != nil {
-		return x.Task
+		return x.Submission
 	}
 	return nil
 }
 
-// ListTasksRequest contains filters for tasks.
-type ListTasksRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	SmeId            *string                `protobuf:"bytes,1,opt,name=sme_id,json=smeId,proto3,oneof" json:"sme_id,omitempty"`                                      // Filter by SME
-	AssignedToUserId *string                `protobuf:"bytes,2,opt,name=assigned_to_user_id,json=assignedToUserId,proto3,oneof" json:"assigned_to_user_id,omitempty"` // Filter by assignee
-	Status           *SMETaskStatus         `protobuf:"varint,3,opt,name=status,proto3,enum=mirai.v1.SMETaskStatus,oneof" json:"status,omitempty"`                    // Filter by status
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+// EnhanceSubmissionContentRequest requests AI enhancement of content.
+type EnhanceSubmissionContentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SubmissionId  string                 `protobuf:"bytes,1,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+	EnhanceType   EnhanceType            `protobuf:"varint,2,opt,name=enhance_type,json=enhanceType,proto3,enum=mirai.v1.EnhanceType" json:"enhance_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTasksRequest) Reset() {
-	*x = ListTasksRequest{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[20]
+func (x *EnhanceSubmissionContentRequest) Reset() {
+	*x = EnhanceSubmissionContentRequest{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTasksRequest) String() string {
+func (x *EnhanceSubmissionContentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTasksRequest) ProtoMessage() {}
+func (*EnhanceSubmissionContentRequest) ProtoMessage() {}
 
-func (x *ListTasksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[20]
+func (x *EnhanceSubmissionContentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1792,55 +4165,49 @@ func (x *ListTasksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTasksRequest.ProtoReflect.Descriptor instead.
-func (*ListTasksRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{20}
-}
-
-func (x *ListTasksRequest) GetSmeId() string {
-	if x != nil && x.SmeId != nil {
-		return *x.SmeId
-	}
-	return ""
+// Deprecated: Use EnhanceSubmissionContentRequest.ProtoReflect.Descriptor instead.
+func (*EnhanceSubmissionContentRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *ListTasksRequest) GetAssignedToUserId() string {
-	if x != nil && x.AssignedToUserId != nil {
-		return *x.AssignedToUserId
+func (x *EnhanceSubmissionContentRequest) GetSubmissionId() string {
+	if x != nil {
+		return x.SubmissionId
 	}
 	return ""
 }
 
-func (x *ListTasksRequest) GetStatus() SMETaskStatus {
-	if x != nil && x.Status != nil {
-		return *x.Status
+func (x *EnhanceSubmissionContentRequest) GetEnhanceType() EnhanceType {
+	if x != nil {
+		return x.EnhanceType
 	}
-	return SMETaskStatus_SME_TASK_STATUS_UNSPECIFIED
+	return EnhanceType_ENHANCE_TYPE_UNSPECIFIED
 }
 
-// ListTasksResponse contains tasks matching the filters.
-type ListTasksResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tasks         []*SMETask             `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// EnhanceSubmissionContentResponse contains enhanced content.
+type EnhanceSubmissionContentResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	EnhancedContent string                 `protobuf:"bytes,1,opt,name=enhanced_content,json=enhancedContent,proto3" json:"enhanced_content,omitempty"`
+	OriginalContent string                 `protobuf:"bytes,2,opt,name=original_content,json=originalContent,proto3" json:"original_content,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *ListTasksResponse) Reset() {
-	*x = ListTasksResponse{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[21]
+func (x *EnhanceSubmissionContentResponse) Reset() {
+	*x = EnhanceSubmissionContentResponse{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTasksResponse) String() string {
+func (x *EnhanceSubmissionContentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTasksResponse) ProtoMessage() {}
+func (*EnhanceSubmissionContentResponse) ProtoMessage() {}
 
-func (x *ListTasksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[21]
+func (x *EnhanceSubmissionContentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1851,45 +4218,51 @@ func (x *ListTasksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTasksResponse.ProtoReflect.Descriptor instead.
-func (*ListTasksResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use EnhanceSubmissionContentResponse.ProtoReflect.Descriptor instead.
+func (*EnhanceSubmissionContentResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *ListTasksResponse) GetTasks() []*SMETask {
+func (x *EnhanceSubmissionContentResponse) GetEnhancedContent() string {
 	if x != nil {
-		return x.Tasks
+		return x.EnhancedContent
 	}
-	return nil
+	return ""
 }
 
-// UpdateTaskRequest contains fields to update on a task.
-type UpdateTaskRequest struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	TaskId              string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	Title               *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
-	Description         *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	ExpectedContentType *ContentType           `protobuf:"varint,4,opt,name=expected_content_type,json=expectedContentType,proto3,enum=mirai.v1.ContentType,oneof" json:"expected_content_type,omitempty"`
-	DueDate             *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=due_date,json=dueDate,proto3,oneof" json:"due_date,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+func (x *EnhanceSubmissionContentResponse) GetOriginalContent() string {
+	if x != nil {
+		return x.OriginalContent
+	}
+	return ""
 }
 
-func (x *UpdateTaskRequest) Reset() {
-	*x = UpdateTaskRequest{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[22]
+// UpdateKnowledgeChunkRequest updates a knowledge chunk.
+type UpdateKnowledgeChunkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChunkId       string                 `protobuf:"bytes,1,opt,name=chunk_id,json=chunkId,proto3" json:"chunk_id,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Topic         *string                `protobuf:"bytes,3,opt,name=topic,proto3,oneof" json:"topic,omitempty"`
+	Keywords      []string               `protobuf:"bytes,4,rep,name=keywords,proto3" json:"keywords,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateKnowledgeChunkRequest) Reset() {
+	*x = UpdateKnowledgeChunkRequest{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTaskRequest) String() string {
+func (x *UpdateKnowledgeChunkRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTaskRequest) ProtoMessage() {}
+func (*UpdateKnowledgeChunkRequest) ProtoMessage() {}
 
-func (x *UpdateTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[22]
+func (x *UpdateKnowledgeChunkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1900,69 +4273,62 @@ func (x *UpdateTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTaskRequest.ProtoReflect.Descriptor instead.
-func (*UpdateTaskRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use UpdateKnowledgeChunkRequest.ProtoReflect.Descriptor instead.
+func (*UpdateKnowledgeChunkRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *UpdateTaskRequest) GetTaskId() string {
+func (x *UpdateKnowledgeChunkRequest) GetChunkId() string {
 	if x != nil {
-		return x.TaskId
+		return x.ChunkId
 	}
 	return ""
 }
 
-func (x *UpdateTaskRequest) GetTitle() string {
-	if x != nil && x.Title != nil {
-		return *x.Title
+func (x *UpdateKnowledgeChunkRequest) GetContent() string {
+	if x != nil {
+		return x.Content
 	}
 	return ""
 }
 
-func (x *UpdateTaskRequest) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
+func (x *UpdateKnowledgeChunkRequest) GetTopic() string {
+	if x != nil && x.Topic != nil {
+		return *x.Topic
 	}
 	return ""
 }
 
-func (x *UpdateTaskRequest) GetExpectedContentType() ContentType {
-	if x != nil && x.ExpectedContentType != nil {
-		return *x.ExpectedContentType
-	}
-	return ContentType_CONTENT_TYPE_UNSPECIFIED
-}
-
-func (x *UpdateTaskRequest) GetDueDate() *timestamppb.Timestamp {
+func (x *UpdateKnowledgeChunkRequest) GetKeywords() []string {
 	if x != nil {
-		return x.DueDate
+		return x.Keywords
 	}
 	return nil
 }
 
-// UpdateTaskResponse contains the updated task.
-type UpdateTaskResponse struct {
+// UpdateKnowledgeChunkResponse contains the updated chunk.
+type UpdateKnowledgeChunkResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Task          *SMETask               `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	Chunk         *SMEKnowledgeChunk     `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
-}
-
-func (x *UpdateTaskResponse) Reset() {
-	*x = UpdateTaskResponse{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[23]
+}
+
+func (x *UpdateKnowledgeChunkResponse) Reset() {
+	*x = UpdateKnowledgeChunkResponse{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTaskResponse) String() string {
+func (x *UpdateKnowledgeChunkResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTaskResponse) ProtoMessage() {}
+func (*UpdateKnowledgeChunkResponse) ProtoMessage() {}
 
-func (x *UpdateTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[23]
+func (x *UpdateKnowledgeChunkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1973,41 +4339,41 @@ func (x *UpdateTaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTaskResponse.ProtoReflect.Descriptor instead.
-func (*UpdateTaskResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use UpdateKnowledgeChunkResponse.ProtoReflect.Descriptor instead.
+func (*UpdateKnowledgeChunkResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *UpdateTaskResponse) GetTask() *SMETask {
+func (x *UpdateKnowledgeChunkResponse) GetChunk() *SMEKnowledgeChunk {
 	if x != nil {
-		return x.Task
+		return x.Chunk
 	}
 	return nil
 }
 
-// CancelTaskRequest contains the task ID to cancel.
-type CancelTaskRequest struct {
+// DeleteKnowledgeChunkRequest deletes a knowledge chunk.
+type DeleteKnowledgeChunkRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	ChunkId       string                 `protobuf:"bytes,1,opt,name=chunk_id,json=chunkId,proto3" json:"chunk_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelTaskRequest) Reset() {
-	*x = CancelTaskRequest{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[24]
+func (x *DeleteKnowledgeChunkRequest) Reset() {
+	*x = DeleteKnowledgeChunkRequest{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelTaskRequest) String() string {
+func (x *DeleteKnowledgeChunkRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelTaskRequest) ProtoMessage() {}
+func (*DeleteKnowledgeChunkRequest) ProtoMessage() {}
 
-func (x *CancelTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[24]
+func (x *DeleteKnowledgeChunkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2018,41 +4384,40 @@ func (x *CancelTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelTaskRequest.ProtoReflect.Descriptor instead.
-func (*CancelTaskRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use DeleteKnowledgeChunkRequest.ProtoReflect.Descriptor instead.
+func (*DeleteKnowledgeChunkRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *CancelTaskRequest) GetTaskId() string {
+func (x *DeleteKnowledgeChunkRequest) GetChunkId() string {
 	if x != nil {
-		return x.TaskId
+		return x.ChunkId
 	}
 	return ""
 }
 
-// CancelTaskResponse confirms cancellation.
-type CancelTaskResponse struct {
+// DeleteKnowledgeChunkResponse confirms deletion.
+type DeleteKnowledgeChunkResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Task          *SMETask               `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelTaskResponse) Reset() {
-	*x = CancelTaskResponse{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[25]
+func (x *DeleteKnowledgeChunkResponse) Reset() {
+	*x = DeleteKnowledgeChunkResponse{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelTaskResponse) String() string {
+func (x *DeleteKnowledgeChunkResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelTaskResponse) ProtoMessage() {}
+func (*DeleteKnowledgeChunkResponse) ProtoMessage() {}
 
-func (x *CancelTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[25]
+func (x *DeleteKnowledgeChunkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2063,44 +4428,35 @@ func (x *CancelTaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelTaskResponse.ProtoReflect.Descriptor instead.
-func (*CancelTaskResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{25}
-}
-
-func (x *CancelTaskResponse) GetTask() *SMETask {
-	if x != nil {
-		return x.Task
-	}
-	return nil
+// Deprecated: Use DeleteKnowledgeChunkResponse.ProtoReflect.Descriptor instead.
+func (*DeleteKnowledgeChunkResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{63}
 }
 
-// GetUploadURLRequest requests a presigned URL for upload.
-type GetUploadURLRequest struct {
+// ReviewFlaggedKnowledgeChunkRequest records an admin's review of a flagged chunk.
+type ReviewFlaggedKnowledgeChunkRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	FileName      string                 `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
-	ContentType   ContentType            `protobuf:"varint,3,opt,name=content_type,json=contentType,proto3,enum=mirai.v1.ContentType" json:"content_type,omitempty"`
-	FileSizeBytes int64                  `protobuf:"varint,4,opt,name=file_size_bytes,json=fileSizeBytes,proto3" json:"file_size_bytes,omitempty"`
+	ChunkId       string                 `protobuf:"bytes,1,opt,name=chunk_id,json=chunkId,proto3" json:"chunk_id,omitempty"`
+	Release       bool                   `protobuf:"varint,2,opt,name=release,proto3" json:"release,omitempty"` // Include the chunk in generation; false holds it back
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUploadURLRequest) Reset() {
-	*x = GetUploadURLRequest{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[26]
+func (x *ReviewFlaggedKnowledgeChunkRequest) Reset() {
+	*x = ReviewFlaggedKnowledgeChunkRequest{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUploadURLRequest) String() string {
+func (x *ReviewFlaggedKnowledgeChunkRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUploadURLRequest) ProtoMessage() {}
+func (*ReviewFlaggedKnowledgeChunkRequest) ProtoMessage() {}
 
-func (x *GetUploadURLRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[26]
+func (x *ReviewFlaggedKnowledgeChunkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2111,64 +4467,48 @@ func (x *GetUploadURLRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUploadURLRequest.ProtoReflect.Descriptor instead.
-func (*GetUploadURLRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{26}
-}
-
-func (x *GetUploadURLRequest) GetTaskId() string {
-	if x != nil {
-		return x.TaskId
-	}
-	return ""
+// Deprecated: Use ReviewFlaggedKnowledgeChunkRequest.ProtoReflect.Descriptor instead.
+func (*ReviewFlaggedKnowledgeChunkRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *GetUploadURLRequest) GetFileName() string {
+func (x *ReviewFlaggedKnowledgeChunkRequest) GetChunkId() string {
 	if x != nil {
-		return x.FileName
+		return x.ChunkId
 	}
 	return ""
 }
 
-func (x *GetUploadURLRequest) GetContentType() ContentType {
-	if x != nil {
-		return x.ContentType
-	}
-	return ContentType_CONTENT_TYPE_UNSPECIFIED
-}
-
-func (x *GetUploadURLRequest) GetFileSizeBytes() int64 {
+func (x *ReviewFlaggedKnowledgeChunkRequest) GetRelease() bool {
 	if x != nil {
-		return x.FileSizeBytes
+		return x.Release
 	}
-	return 0
+	return false
 }
 
-// GetUploadURLResponse contains the presigned upload URL.
-type GetUploadURLResponse struct {
+// ReviewFlaggedKnowledgeChunkResponse contains the reviewed chunk.
+type ReviewFlaggedKnowledgeChunkResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UploadUrl     string                 `protobuf:"bytes,1,opt,name=upload_url,json=uploadUrl,proto3" json:"upload_url,omitempty"`
-	FilePath      string                 `protobuf:"bytes,2,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"` // S3 path where file will be stored
-	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Chunk         *SMEKnowledgeChunk     `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUploadURLResponse) Reset() {
-	*x = GetUploadURLResponse{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[27]
+func (x *ReviewFlaggedKnowledgeChunkResponse) Reset() {
+	*x = ReviewFlaggedKnowledgeChunkResponse{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUploadURLResponse) String() string {
+func (x *ReviewFlaggedKnowledgeChunkResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUploadURLResponse) ProtoMessage() {}
+func (*ReviewFlaggedKnowledgeChunkResponse) ProtoMessage() {}
 
-func (x *GetUploadURLResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[27]
+func (x *ReviewFlaggedKnowledgeChunkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2179,60 +4519,41 @@ func (x *GetUploadURLResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUploadURLResponse.ProtoReflect.Descriptor instead.
-func (*GetUploadURLResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{27}
-}
-
-func (x *GetUploadURLResponse) GetUploadUrl() string {
-	if x != nil {
-		return x.UploadUrl
-	}
-	return ""
-}
-
-func (x *GetUploadURLResponse) GetFilePath() string {
-	if x != nil {
-		return x.FilePath
-	}
-	return ""
+// Deprecated: Use ReviewFlaggedKnowledgeChunkResponse.ProtoReflect.Descriptor instead.
+func (*ReviewFlaggedKnowledgeChunkResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *GetUploadURLResponse) GetExpiresAt() *timestamppb.Timestamp {
+func (x *ReviewFlaggedKnowledgeChunkResponse) GetChunk() *SMEKnowledgeChunk {
 	if x != nil {
-		return x.ExpiresAt
+		return x.Chunk
 	}
 	return nil
 }
 
-// SubmitContentRequest records a content submission.
-type SubmitContentRequest struct {
+// DeleteTaskRequest permanently deletes a task.
+type DeleteTaskRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	FileName      string                 `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"` // Optional for text submissions
-	FilePath      string                 `protobuf:"bytes,3,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"` // S3 path from GetUploadURL (optional for text)
-	ContentType   ContentType            `protobuf:"varint,4,opt,name=content_type,json=contentType,proto3,enum=mirai.v1.ContentType" json:"content_type,omitempty"`
-	FileSizeBytes int64                  `protobuf:"varint,5,opt,name=file_size_bytes,json=fileSizeBytes,proto3" json:"file_size_bytes,omitempty"` // Optional for text submissions
-	TextContent   *string                `protobuf:"bytes,6,opt,name=text_content,json=textContent,proto3,oneof" json:"text_content,omitempty"`    // Direct text content (for CONTENT_TYPE_TEXT)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubmitContentRequest) Reset() {
-	*x = SubmitContentRequest{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[28]
+func (x *DeleteTaskRequest) Reset() {
+	*x = DeleteTaskRequest{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubmitContentRequest) String() string {
+func (x *DeleteTaskRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitContentRequest) ProtoMessage() {}
+func (*DeleteTaskRequest) ProtoMessage() {}
 
-func (x *SubmitContentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[28]
+func (x *DeleteTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2243,76 +4564,40 @@ func (x *SubmitContentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitContentRequest.ProtoReflect.Descriptor instead.
-func (*SubmitContentRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use DeleteTaskRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTaskRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *SubmitContentRequest) GetTaskId() string {
+func (x *DeleteTaskRequest) GetTaskId() string {
 	if x != nil {
 		return x.TaskId
 	}
 	return ""
 }
 
-func (x *SubmitContentRequest) GetFileName() string {
-	if x != nil {
-		return x.FileName
-	}
-	return ""
-}
-
-func (x *SubmitContentRequest) GetFilePath() string {
-	if x != nil {
-		return x.FilePath
-	}
-	return ""
-}
-
-func (x *SubmitContentRequest) GetContentType() ContentType {
-	if x != nil {
-		return x.ContentType
-	}
-	return ContentType_CONTENT_TYPE_UNSPECIFIED
-}
-
-func (x *SubmitContentRequest) GetFileSizeBytes() int64 {
-	if x != nil {
-		return x.FileSizeBytes
-	}
-	return 0
-}
-
-func (x *SubmitContentRequest) GetTextContent() string {
-	if x != nil && x.TextContent != nil {
-		return *x.TextContent
-	}
-	return ""
-}
-
-// SubmitContentResponse contains the created submission.
-type SubmitContentResponse struct {
+// DeleteTaskResponse confirms task deletion.
+type DeleteTaskResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Submission    *SMETaskSubmission     `protobuf:"bytes,1,opt,name=submission,proto3" json:"submission,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubmitContentResponse) Reset() {
-	*x = SubmitContentResponse{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[29]
+func (x *DeleteTaskResponse) Reset() {
+	*x = DeleteTaskResponse{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubmitContentResponse) String() string {
+func (x *DeleteTaskResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitContentResponse) ProtoMessage() {}
+func (*DeleteTaskResponse) ProtoMessage() {}
 
-func (x *SubmitContentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[29]
+func (x *DeleteTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2323,86 +4608,72 @@ func (x *SubmitContentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitContentResponse.ProtoReflect.Descriptor instead.
-func (*SubmitContentResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{29}
-}
-
-func (x *SubmitContentResponse) GetSubmission() *SMETaskSubmission {
-	if x != nil {
-		return x.Submission
-	}
-	return nil
+// Deprecated: Use DeleteTaskResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTaskResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{67}
 }
 
-// ListSubmissionsRequest contains the task ID.
-type ListSubmissionsRequest struct {
+// GetSMEStatsRequest requests contribution stats for accessible SMEs.
+type GetSMEStatsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListSubmissionsRequest) Reset() {
-	*x = ListSubmissionsRequest{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[30]
+func (x *GetSMEStatsRequest) Reset() {
+	*x = GetSMEStatsRequest{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListSubmissionsRequest) String() string {
+func (x *GetSMEStatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSubmissionsRequest) ProtoMessage() {}
+func (*GetSMEStatsRequest) ProtoMessage() {}
 
-func (x *ListSubmissionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[30]
+func (x *GetSMEStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListSubmissionsRequest.ProtoReflect.Descriptor instead.
-func (*ListSubmissionsRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{30}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *ListSubmissionsRequest) GetTaskId() string {
-	if x != nil {
-		return x.TaskId
-	}
-	return ""
+// Deprecated: Use GetSMEStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetSMEStatsRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{68}
 }
 
-// ListSubmissionsResponse contains all submissions for the task.
-type ListSubmissionsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Submissions   []*SMETaskSubmission   `protobuf:"bytes,1,rep,name=submissions,proto3" json:"submissions,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// GetSMEStatsResponse contains stats ordered by knowledge chunk count.
+type GetSMEStatsResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Stats              []*SMEStats            `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+	MinKnowledgeChunks int32                  `protobuf:"varint,2,opt,name=min_knowledge_chunks,json=minKnowledgeChunks,proto3" json:"min_knowledge_chunks,omitempty"` // Threshold used for low_coverage
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *ListSubmissionsResponse) Reset() {
-	*x = ListSubmissionsResponse{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[31]
+func (x *GetSMEStatsResponse) Reset() {
+	*x = GetSMEStatsResponse{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListSubmissionsResponse) String() string {
+func (x *GetSMEStatsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSubmissionsResponse) ProtoMessage() {}
+func (*GetSMEStatsResponse) ProtoMessage() {}
 
-func (x *ListSubmissionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[31]
+func (x *GetSMEStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2413,41 +4684,48 @@ func (x *ListSubmissionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSubmissionsResponse.ProtoReflect.Descriptor instead.
-func (*ListSubmissionsResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use GetSMEStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetSMEStatsResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *ListSubmissionsResponse) GetSubmissions() []*SMETaskSubmission {
+func (x *GetSMEStatsResponse) GetStats() []*SMEStats {
 	if x != nil {
-		return x.Submissions
+		return x.Stats
 	}
 	return nil
 }
 
-// GetKnowledgeRequest requests knowledge for an SME.
-type GetKnowledgeRequest struct {
+func (x *GetSMEStatsResponse) GetMinKnowledgeChunks() int32 {
+	if x != nil {
+		return x.MinKnowledgeChunks
+	}
+	return 0
+}
+
+// ExportSMEKnowledgeRequest exports one SME's knowledge.
+type ExportSMEKnowledgeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SmeId         string                 `protobuf:"bytes,1,opt,name=sme_id,json=smeId,proto3" json:"sme_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetKnowledgeRequest) Reset() {
-	*x = GetKnowledgeRequest{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[32]
+func (x *ExportSMEKnowledgeRequest) Reset() {
+	*x = ExportSMEKnowledgeRequest{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetKnowledgeRequest) String() string {
+func (x *ExportSMEKnowledgeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetKnowledgeRequest) ProtoMessage() {}
+func (*ExportSMEKnowledgeRequest) ProtoMessage() {}
 
-func (x *GetKnowledgeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[32]
+func (x *ExportSMEKnowledgeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2458,42 +4736,41 @@ func (x *GetKnowledgeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetKnowledgeRequest.ProtoReflect.Descriptor instead.
-func (*GetKnowledgeRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use ExportSMEKnowledgeRequest.ProtoReflect.Descriptor instead.
+func (*ExportSMEKnowledgeRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *GetKnowledgeRequest) GetSmeId() string {
+func (x *ExportSMEKnowledgeRequest) GetSmeId() string {
 	if x != nil {
 		return x.SmeId
 	}
 	return ""
 }
 
-// GetKnowledgeResponse contains the SME's knowledge.
-type GetKnowledgeResponse struct {
+// ExportSMEKnowledgeResponse contains the export job; track it with GetJob.
+type ExportSMEKnowledgeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Sme           *SubjectMatterExpert   `protobuf:"bytes,1,opt,name=sme,proto3" json:"sme,omitempty"`
-	Chunks        []*SMEKnowledgeChunk   `protobuf:"bytes,2,rep,name=chunks,proto3" json:"chunks,omitempty"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetKnowledgeResponse) Reset() {
-	*x = GetKnowledgeResponse{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[33]
+func (x *ExportSMEKnowledgeResponse) Reset() {
+	*x = ExportSMEKnowledgeResponse{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetKnowledgeResponse) String() string {
+func (x *ExportSMEKnowledgeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetKnowledgeResponse) ProtoMessage() {}
+func (*ExportSMEKnowledgeResponse) ProtoMessage() {}
 
-func (x *GetKnowledgeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[33]
+func (x *ExportSMEKnowledgeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2504,50 +4781,40 @@ func (x *GetKnowledgeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetKnowledgeResponse.ProtoReflect.Descriptor instead.
-func (*GetKnowledgeResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{33}
-}
-
-func (x *GetKnowledgeResponse) GetSme() *SubjectMatterExpert {
-	if x != nil {
-		return x.Sme
-	}
-	return nil
+// Deprecated: Use ExportSMEKnowledgeResponse.ProtoReflect.Descriptor instead.
+func (*ExportSMEKnowledgeResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *GetKnowledgeResponse) GetChunks() []*SMEKnowledgeChunk {
+func (x *ExportSMEKnowledgeResponse) GetJobId() string {
 	if x != nil {
-		return x.Chunks
+		return x.JobId
 	}
-	return nil
+	return ""
 }
 
-// SearchKnowledgeRequest searches across SME knowledge.
-type SearchKnowledgeRequest struct {
+// GetKnowledgeImportUploadURLRequest requests an upload slot for an archive.
+type GetKnowledgeImportUploadURLRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SmeIds        []string               `protobuf:"bytes,1,rep,name=sme_ids,json=smeIds,proto3" json:"sme_ids,omitempty"` // SMEs to search within
-	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`                 // Search query
-	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`                // Max results
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchKnowledgeRequest) Reset() {
-	*x = SearchKnowledgeRequest{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[34]
+func (x *GetKnowledgeImportUploadURLRequest) Reset() {
+	*x = GetKnowledgeImportUploadURLRequest{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchKnowledgeRequest) String() string {
+func (x *GetKnowledgeImportUploadURLRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchKnowledgeRequest) ProtoMessage() {}
+func (*GetKnowledgeImportUploadURLRequest) ProtoMessage() {}
 
-func (x *SearchKnowledgeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[34]
+func (x *GetKnowledgeImportUploadURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2558,55 +4825,35 @@ func (x *SearchKnowledgeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchKnowledgeRequest.ProtoReflect.Descriptor instead.
-func (*SearchKnowledgeRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{34}
-}
-
-func (x *SearchKnowledgeRequest) GetSmeIds() []string {
-	if x != nil {
-		return x.SmeIds
-	}
-	return nil
-}
-
-func (x *SearchKnowledgeRequest) GetQuery() string {
-	if x != nil {
-		return x.Query
-	}
-	return ""
-}
-
-func (x *SearchKnowledgeRequest) GetLimit() int32 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
+// Deprecated: Use GetKnowledgeImportUploadURLRequest.ProtoReflect.Descriptor instead.
+func (*GetKnowledgeImportUploadURLRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{72}
 }
 
-// SearchKnowledgeResponse contains matching knowledge chunks.
-type SearchKnowledgeResponse struct {
+// GetKnowledgeImportUploadURLResponse contains the presigned upload URL.
+type GetKnowledgeImportUploadURLResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Chunks        []*SMEKnowledgeChunk   `protobuf:"bytes,1,rep,name=chunks,proto3" json:"chunks,omitempty"`
+	UploadUrl     string                 `protobuf:"bytes,1,opt,name=upload_url,json=uploadUrl,proto3" json:"upload_url,omitempty"`
+	FilePath      string                 `protobuf:"bytes,2,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"` // Pass to ImportSMEKnowledge once uploaded
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchKnowledgeResponse) Reset() {
-	*x = SearchKnowledgeResponse{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[35]
+func (x *GetKnowledgeImportUploadURLResponse) Reset() {
+	*x = GetKnowledgeImportUploadURLResponse{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchKnowledgeResponse) String() string {
+func (x *GetKnowledgeImportUploadURLResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchKnowledgeResponse) ProtoMessage() {}
+func (*GetKnowledgeImportUploadURLResponse) ProtoMessage() {}
 
-func (x *SearchKnowledgeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[35]
+func (x *GetKnowledgeImportUploadURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2617,41 +4864,49 @@ func (x *SearchKnowledgeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchKnowledgeResponse.ProtoReflect.Descriptor instead.
-func (*SearchKnowledgeResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use GetKnowledgeImportUploadURLResponse.ProtoReflect.Descriptor instead.
+func (*GetKnowledgeImportUploadURLResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *SearchKnowledgeResponse) GetChunks() []*SMEKnowledgeChunk {
+func (x *GetKnowledgeImportUploadURLResponse) GetUploadUrl() string {
 	if x != nil {
-		return x.Chunks
+		return x.UploadUrl
 	}
-	return nil
+	return ""
 }
 
-// GetSubmissionRequest requests a specific submission.
-type GetSubmissionRequest struct {
+func (x *GetKnowledgeImportUploadURLResponse) GetFilePath() string {
+	if x != nil {
+		return x.FilePath
+	}
+	return ""
+}
+
+// ImportSMEKnowledgeRequest imports an uploaded archive.
+type ImportSMEKnowledgeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SubmissionId  string                 `protobuf:"bytes,1,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+	FilePath      string                 `protobuf:"bytes,1,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"`                  // Path from GetKnowledgeImportUploadURL
+	TargetSmeId   *string                `protobuf:"bytes,2,opt,name=target_sme_id,json=targetSmeId,proto3,oneof" json:"target_sme_id,omitempty"` // Import into this SME; omit to create one from the archive
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSubmissionRequest) Reset() {
-	*x = GetSubmissionRequest{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[36]
+func (x *ImportSMEKnowledgeRequest) Reset() {
+	*x = ImportSMEKnowledgeRequest{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSubmissionRequest) String() string {
+func (x *ImportSMEKnowledgeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSubmissionRequest) ProtoMessage() {}
+func (*ImportSMEKnowledgeRequest) ProtoMessage() {}
 
-func (x *GetSubmissionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[36]
+func (x *ImportSMEKnowledgeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2662,41 +4917,49 @@ func (x *GetSubmissionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSubmissionRequest.ProtoReflect.Descriptor instead.
-func (*GetSubmissionRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use ImportSMEKnowledgeRequest.ProtoReflect.Descriptor instead.
+func (*ImportSMEKnowledgeRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *GetSubmissionRequest) GetSubmissionId() string {
+func (x *ImportSMEKnowledgeRequest) GetFilePath() string {
 	if x != nil {
-		return x.SubmissionId
+		return x.FilePath
 	}
 	return ""
 }
 
-// GetSubmissionResponse contains the requested submission.
-type GetSubmissionResponse struct {
+func (x *ImportSMEKnowledgeRequest) GetTargetSmeId() string {
+	if x != nil && x.TargetSmeId != nil {
+		return *x.TargetSmeId
+	}
+	return ""
+}
+
+// ImportSMEKnowledgeResponse contains the SME receiving the knowledge and the import job.
+type ImportSMEKnowledgeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Submission    *SMETaskSubmission     `protobuf:"bytes,1,opt,name=submission,proto3" json:"submission,omitempty"`
+	Sme           *SubjectMatterExpert   `protobuf:"bytes,1,opt,name=sme,proto3" json:"sme,omitempty"`
+	JobId         string                 `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSubmissionResponse) Reset() {
-	*x = GetSubmissionResponse{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[37]
+func (x *ImportSMEKnowledgeResponse) Reset() {
+	*x = ImportSMEKnowledgeResponse{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSubmissionResponse) String() string {
+func (x *ImportSMEKnowledgeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSubmissionResponse) ProtoMessage() {}
+func (*ImportSMEKnowledgeResponse) ProtoMessage() {}
 
-func (x *GetSubmissionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[37]
+func (x *ImportSMEKnowledgeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2707,42 +4970,49 @@ func (x *GetSubmissionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSubmissionResponse.ProtoReflect.Descriptor instead.
-func (*GetSubmissionResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use ImportSMEKnowledgeResponse.ProtoReflect.Descriptor instead.
+func (*ImportSMEKnowledgeResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *GetSubmissionResponse) GetSubmission() *SMETaskSubmission {
+func (x *ImportSMEKnowledgeResponse) GetSme() *SubjectMatterExpert {
 	if x != nil {
-		return x.Submission
+		return x.Sme
 	}
 	return nil
 }
 
-// ApproveSubmissionRequest approves a submission and creates knowledge.
-type ApproveSubmissionRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	SubmissionId    string                 `protobuf:"bytes,1,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
-	ApprovedContent string                 `protobuf:"bytes,2,opt,name=approved_content,json=approvedContent,proto3" json:"approved_content,omitempty"` // The final content to use (may be edited by reviewer)
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *ImportSMEKnowledgeResponse) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
 }
 
-func (x *ApproveSubmissionRequest) Reset() {
-	*x = ApproveSubmissionRequest{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[38]
+// GenerateTaskQuestionsRequest names the SME to interview and the course goal.
+type GenerateTaskQuestionsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SmeId          string                 `protobuf:"bytes,1,opt,name=sme_id,json=smeId,proto3" json:"sme_id,omitempty"`
+	DesiredOutcome string                 `protobuf:"bytes,2,opt,name=desired_outcome,json=desiredOutcome,proto3" json:"desired_outcome,omitempty"` // What learners of the course should achieve
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GenerateTaskQuestionsRequest) Reset() {
+	*x = GenerateTaskQuestionsRequest{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApproveSubmissionRequest) String() string {
+func (x *GenerateTaskQuestionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApproveSubmissionRequest) ProtoMessage() {}
+func (*GenerateTaskQuestionsRequest) ProtoMessage() {}
 
-func (x *ApproveSubmissionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[38]
+func (x *GenerateTaskQuestionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2753,49 +5023,49 @@ func (x *ApproveSubmissionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApproveSubmissionRequest.ProtoReflect.Descriptor instead.
-func (*ApproveSubmissionRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use GenerateTaskQuestionsRequest.ProtoReflect.Descriptor instead.
+func (*GenerateTaskQuestionsRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *ApproveSubmissionRequest) GetSubmissionId() string {
+func (x *GenerateTaskQuestionsRequest) GetSmeId() string {
 	if x != nil {
-		return x.SubmissionId
+		return x.SmeId
 	}
 	return ""
 }
 
-func (x *ApproveSubmissionRequest) GetApprovedContent() string {
+func (x *GenerateTaskQuestionsRequest) GetDesiredOutcome() string {
 	if x != nil {
-		return x.ApprovedContent
+		return x.DesiredOutcome
 	}
 	return ""
 }
 
-// ApproveSubmissionResponse contains the approved submission and created knowledge.
-type ApproveSubmissionResponse struct {
+// GenerateTaskQuestionsResponse contains the generated questions.
+type GenerateTaskQuestionsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Submission    *SMETaskSubmission     `protobuf:"bytes,1,opt,name=submission,proto3" json:"submission,omitempty"`
-	CreatedChunks []*SMEKnowledgeChunk   `protobuf:"bytes,2,rep,name=created_chunks,json=createdChunks,proto3" json:"created_chunks,omitempty"`
+	Questions     []string               `protobuf:"bytes,1,rep,name=questions,proto3" json:"questions,omitempty"`
+	TokensUsed    int64                  `protobuf:"varint,2,opt,name=tokens_used,json=tokensUsed,proto3" json:"tokens_used,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApproveSubmissionResponse) Reset() {
-	*x = ApproveSubmissionResponse{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[39]
+func (x *GenerateTaskQuestionsResponse) Reset() {
+	*x = GenerateTaskQuestionsResponse{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApproveSubmissionResponse) String() string {
+func (x *GenerateTaskQuestionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApproveSubmissionResponse) ProtoMessage() {}
+func (*GenerateTaskQuestionsResponse) ProtoMessage() {}
 
-func (x *ApproveSubmissionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[39]
+func (x *GenerateTaskQuestionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2806,49 +5076,49 @@ func (x *ApproveSubmissionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApproveSubmissionResponse.ProtoReflect.Descriptor instead.
-func (*ApproveSubmissionResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use GenerateTaskQuestionsResponse.ProtoReflect.Descriptor instead.
+func (*GenerateTaskQuestionsResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *ApproveSubmissionResponse) GetSubmission() *SMETaskSubmission {
+func (x *GenerateTaskQuestionsResponse) GetQuestions() []string {
 	if x != nil {
-		return x.Submission
+		return x.Questions
 	}
 	return nil
 }
 
-func (x *ApproveSubmissionResponse) GetCreatedChunks() []*SMEKnowledgeChunk {
+func (x *GenerateTaskQuestionsResponse) GetTokensUsed() int64 {
 	if x != nil {
-		return x.CreatedChunks
+		return x.TokensUsed
 	}
-	return nil
+	return 0
 }
 
-// RequestSubmissionChangesRequest sends submission back for revision.
-type RequestSubmissionChangesRequest struct {
+// SubmitAnswersRequest contains one answer per task question, in order.
+type SubmitAnswersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SubmissionId  string                 `protobuf:"bytes,1,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
-	Feedback      string                 `protobuf:"bytes,2,opt,name=feedback,proto3" json:"feedback,omitempty"` // What needs to change
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Answers       []string               `protobuf:"bytes,2,rep,name=answers,proto3" json:"answers,omitempty"` // Blank answers are skipped
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RequestSubmissionChangesRequest) Reset() {
-	*x = RequestSubmissionChangesRequest{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[40]
+func (x *SubmitAnswersRequest) Reset() {
+	*x = SubmitAnswersRequest{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RequestSubmissionChangesRequest) String() string {
+func (x *SubmitAnswersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RequestSubmissionChangesRequest) ProtoMessage() {}
+func (*SubmitAnswersRequest) ProtoMessage() {}
 
-func (x *RequestSubmissionChangesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[40]
+func (x *SubmitAnswersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2859,48 +5129,48 @@ func (x *RequestSubmissionChangesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RequestSubmissionChangesRequest.ProtoReflect.Descriptor instead.
-func (*RequestSubmissionChangesRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use SubmitAnswersRequest.ProtoReflect.Descriptor instead.
+func (*SubmitAnswersRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *RequestSubmissionChangesRequest) GetSubmissionId() string {
+func (x *SubmitAnswersRequest) GetTaskId() string {
 	if x != nil {
-		return x.SubmissionId
+		return x.TaskId
 	}
 	return ""
 }
 
-func (x *RequestSubmissionChangesRequest) GetFeedback() string {
+func (x *SubmitAnswersRequest) GetAnswers() []string {
 	if x != nil {
-		return x.Feedback
+		return x.Answers
 	}
-	return ""
+	return nil
 }
 
-// RequestSubmissionChangesResponse contains the updated submission.
-type RequestSubmissionChangesResponse struct {
+// SubmitAnswersResponse contains the created submission.
+type SubmitAnswersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Submission    *SMETaskSubmission     `protobuf:"bytes,1,opt,name=submission,proto3" json:"submission,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RequestSubmissionChangesResponse) Reset() {
-	*x = RequestSubmissionChangesResponse{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[41]
+func (x *SubmitAnswersResponse) Reset() {
+	*x = SubmitAnswersResponse{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RequestSubmissionChangesResponse) String() string {
+func (x *SubmitAnswersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RequestSubmissionChangesResponse) ProtoMessage() {}
+func (*SubmitAnswersResponse) ProtoMessage() {}
 
-func (x *RequestSubmissionChangesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[41]
+func (x *SubmitAnswersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2911,42 +5181,41 @@ func (x *RequestSubmissionChangesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RequestSubmissionChangesResponse.ProtoReflect.Descriptor instead.
-func (*RequestSubmissionChangesResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use SubmitAnswersResponse.ProtoReflect.Descriptor instead.
+func (*SubmitAnswersResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *RequestSubmissionChangesResponse) GetSubmission() *SMETaskSubmission {
+func (x *SubmitAnswersResponse) GetSubmission() *SMETaskSubmission {
 	if x != nil {
 		return x.Submission
 	}
 	return nil
 }
 
-// EnhanceSubmissionContentRequest requests AI enhancement of content.
-type EnhanceSubmissionContentRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SubmissionId  string                 `protobuf:"bytes,1,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
-	EnhanceType   EnhanceType            `protobuf:"varint,2,opt,name=enhance_type,json=enhanceType,proto3,enum=mirai.v1.EnhanceType" json:"enhance_type,omitempty"`
+// GetSMEDeletionImpactRequest contains the SME ID about to be deleted.
+type GetSMEDeletionImpactRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SmeId         string                 `protobuf:"bytes,1,opt,name=sme_id,json=smeId,proto3" json:"sme_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EnhanceSubmissionContentRequest) Reset() {
-	*x = EnhanceSubmissionContentRequest{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[42]
+func (x *GetSMEDeletionImpactRequest) Reset() {
+	*x = GetSMEDeletionImpactRequest{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EnhanceSubmissionContentRequest) String() string {
+func (x *GetSMEDeletionImpactRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EnhanceSubmissionContentRequest) ProtoMessage() {}
+func (*GetSMEDeletionImpactRequest) ProtoMessage() {}
 
-func (x *EnhanceSubmissionContentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[42]
+func (x *GetSMEDeletionImpactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2957,49 +5226,47 @@ func (x *EnhanceSubmissionContentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EnhanceSubmissionContentRequest.ProtoReflect.Descriptor instead.
-func (*EnhanceSubmissionContentRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use GetSMEDeletionImpactRequest.ProtoReflect.Descriptor instead.
+func (*GetSMEDeletionImpactRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *EnhanceSubmissionContentRequest) GetSubmissionId() string {
+func (x *GetSMEDeletionImpactRequest) GetSmeId() string {
 	if x != nil {
-		return x.SubmissionId
+		return x.SmeId
 	}
 	return ""
 }
 
-func (x *EnhanceSubmissionContentRequest) GetEnhanceType() EnhanceType {
-	if x != nil {
-		return x.EnhanceType
-	}
-	return EnhanceType_ENHANCE_TYPE_UNSPECIFIED
-}
-
-// EnhanceSubmissionContentResponse contains enhanced content.
-type EnhanceSubmissionContentResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	EnhancedContent string                 `protobuf:"bytes,1,opt,name=enhanced_content,json=enhancedContent,proto3" json:"enhanced_content,omitempty"`
-	OriginalContent string                 `protobuf:"bytes,2,opt,name=original_content,json=originalContent,proto3" json:"original_content,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// GetSMEDeletionImpactResponse summarizes what depends on the SME.
+type GetSMEDeletionImpactResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	KnowledgeChunks    int32                  `protobuf:"varint,1,opt,name=knowledge_chunks,json=knowledgeChunks,proto3" json:"knowledge_chunks,omitempty"`
+	PendingTasks       int32                  `protobuf:"varint,2,opt,name=pending_tasks,json=pendingTasks,proto3" json:"pending_tasks,omitempty"` // Tasks not yet completed, failed, or cancelled
+	Submissions        int32                  `protobuf:"varint,3,opt,name=submissions,proto3" json:"submissions,omitempty"`
+	Courses            int32                  `protobuf:"varint,4,opt,name=courses,proto3" json:"courses,omitempty"`                                                  // Courses whose generation inputs reference the SME
+	RecentCourseTitles []string               `protobuf:"bytes,5,rep,name=recent_course_titles,json=recentCourseTitles,proto3" json:"recent_course_titles,omitempty"` // Most recently updated of those courses
+	ConfirmationToken  string                 `protobuf:"bytes,6,opt,name=confirmation_token,json=confirmationToken,proto3" json:"confirmation_token,omitempty"`      // Pass to DeleteSME
+	ExpiresAt          *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *EnhanceSubmissionContentResponse) Reset() {
-	*x = EnhanceSubmissionContentResponse{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[43]
+func (x *GetSMEDeletionImpactResponse) Reset() {
+	*x = GetSMEDeletionImpactResponse{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EnhanceSubmissionContentResponse) String() string {
+func (x *GetSMEDeletionImpactResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EnhanceSubmissionContentResponse) ProtoMessage() {}
+func (*GetSMEDeletionImpactResponse) ProtoMessage() {}
 
-func (x *EnhanceSubmissionContentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[43]
+func (x *GetSMEDeletionImpactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3010,51 +5277,90 @@ func (x *EnhanceSubmissionContentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EnhanceSubmissionContentResponse.ProtoReflect.Descriptor instead.
-func (*EnhanceSubmissionContentResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use GetSMEDeletionImpactResponse.ProtoReflect.Descriptor instead.
+func (*GetSMEDeletionImpactResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *EnhanceSubmissionContentResponse) GetEnhancedContent() string {
+func (x *GetSMEDeletionImpactResponse) GetKnowledgeChunks() int32 {
 	if x != nil {
-		return x.EnhancedContent
+		return x.KnowledgeChunks
 	}
-	return ""
+	return 0
 }
 
-func (x *EnhanceSubmissionContentResponse) GetOriginalContent() string {
+func (x *GetSMEDeletionImpactResponse) GetPendingTasks() int32 {
 	if x != nil {
-		return x.OriginalContent
+		return x.PendingTasks
+	}
+	return 0
+}
+
+func (x *GetSMEDeletionImpactResponse) GetSubmissions() int32 {
+	if x != nil {
+		return x.Submissions
+	}
+	return 0
+}
+
+func (x *GetSMEDeletionImpactResponse) GetCourses() int32 {
+	if x != nil {
+		return x.Courses
+	}
+	return 0
+}
+
+func (x *GetSMEDeletionImpactResponse) GetRecentCourseTitles() []string {
+	if x != nil {
+		return x.RecentCourseTitles
+	}
+	return nil
+}
+
+func (x *GetSMEDeletionImpactResponse) GetConfirmationToken() string {
+	if x != nil {
+		return x.ConfirmationToken
 	}
 	return ""
 }
 
-// UpdateKnowledgeChunkRequest updates a knowledge chunk.
-type UpdateKnowledgeChunkRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ChunkId       string                 `protobuf:"bytes,1,opt,name=chunk_id,json=chunkId,proto3" json:"chunk_id,omitempty"`
-	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
-	Topic         *string                `protobuf:"bytes,3,opt,name=topic,proto3,oneof" json:"topic,omitempty"`
-	Keywords      []string               `protobuf:"bytes,4,rep,name=keywords,proto3" json:"keywords,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetSMEDeletionImpactResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
 }
 
-func (x *UpdateKnowledgeChunkRequest) Reset() {
-	*x = UpdateKnowledgeChunkRequest{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[44]
+// SubmissionProgress reports a submission's ingestion progress.
+type SubmissionProgress struct {
+	state           protoimpl.MessageState     `protogen:"open.v1"`
+	SubmissionId    string                     `protobuf:"bytes,1,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+	TaskId          string                     `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	JobId           *string                    `protobuf:"bytes,3,opt,name=job_id,json=jobId,proto3,oneof" json:"job_id,omitempty"` // Latest ingestion job; unset if none has run
+	Status          SubmissionProcessingStatus `protobuf:"varint,4,opt,name=status,proto3,enum=mirai.v1.SubmissionProcessingStatus" json:"status,omitempty"`
+	ProgressPercent int32                      `protobuf:"varint,5,opt,name=progress_percent,json=progressPercent,proto3" json:"progress_percent,omitempty"`
+	ProgressMessage string                     `protobuf:"bytes,6,opt,name=progress_message,json=progressMessage,proto3" json:"progress_message,omitempty"`
+	Error           *string                    `protobuf:"bytes,7,opt,name=error,proto3,oneof" json:"error,omitempty"` // Set when ingestion failed
+	UpdatedAt       *timestamppb.Timestamp     `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SubmissionProgress) Reset() {
+	*x = SubmissionProgress{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateKnowledgeChunkRequest) String() string {
+func (x *SubmissionProgress) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateKnowledgeChunkRequest) ProtoMessage() {}
+func (*SubmissionProgress) ProtoMessage() {}
 
-func (x *UpdateKnowledgeChunkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[44]
+func (x *SubmissionProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3065,62 +5371,90 @@ func (x *UpdateKnowledgeChunkRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateKnowledgeChunkRequest.ProtoReflect.Descriptor instead.
-func (*UpdateKnowledgeChunkRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use SubmissionProgress.ProtoReflect.Descriptor instead.
+func (*SubmissionProgress) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *UpdateKnowledgeChunkRequest) GetChunkId() string {
+func (x *SubmissionProgress) GetSubmissionId() string {
 	if x != nil {
-		return x.ChunkId
+		return x.SubmissionId
 	}
 	return ""
 }
 
-func (x *UpdateKnowledgeChunkRequest) GetContent() string {
+func (x *SubmissionProgress) GetTaskId() string {
 	if x != nil {
-		return x.Content
+		return x.TaskId
 	}
 	return ""
 }
 
-func (x *UpdateKnowledgeChunkRequest) GetTopic() string {
-	if x != nil && x.Topic != nil {
-		return *x.Topic
+func (x *SubmissionProgress) GetJobId() string {
+	if x != nil && x.JobId != nil {
+		return *x.JobId
 	}
 	return ""
 }
 
-func (x *UpdateKnowledgeChunkRequest) GetKeywords() []string {
+func (x *SubmissionProgress) GetStatus() SubmissionProcessingStatus {
 	if x != nil {
-		return x.Keywords
+		return x.Status
+	}
+	return SubmissionProcessingStatus_SUBMISSION_PROCESSING_STATUS_UNSPECIFIED
+}
+
+func (x *SubmissionProgress) GetProgressPercent() int32 {
+	if x != nil {
+		return x.ProgressPercent
+	}
+	return 0
+}
+
+func (x *SubmissionProgress) GetProgressMessage() string {
+	if x != nil {
+		return x.ProgressMessage
+	}
+	return ""
+}
+
+func (x *SubmissionProgress) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+func (x *SubmissionProgress) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
 	}
 	return nil
 }
 
-// UpdateKnowledgeChunkResponse contains the updated chunk.
-type UpdateKnowledgeChunkResponse struct {
+// GetSubmissionStatusRequest requests a submission's ingestion progress.
+type GetSubmissionStatusRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Chunk         *SMEKnowledgeChunk     `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	SubmissionId  string                 `protobuf:"bytes,1,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateKnowledgeChunkResponse) Reset() {
-	*x = UpdateKnowledgeChunkResponse{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[45]
+func (x *GetSubmissionStatusRequest) Reset() {
+	*x = GetSubmissionStatusRequest{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateKnowledgeChunkResponse) String() string {
+func (x *GetSubmissionStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateKnowledgeChunkResponse) ProtoMessage() {}
+func (*GetSubmissionStatusRequest) ProtoMessage() {}
 
-func (x *UpdateKnowledgeChunkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[45]
+func (x *GetSubmissionStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3131,41 +5465,41 @@ func (x *UpdateKnowledgeChunkResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateKnowledgeChunkResponse.ProtoReflect.Descriptor instead.
-func (*UpdateKnowledgeChunkResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use GetSubmissionStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetSubmissionStatusRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *UpdateKnowledgeChunkResponse) GetChunk() *SMEKnowledgeChunk {
+func (x *GetSubmissionStatusRequest) GetSubmissionId() string {
 	if x != nil {
-		return x.Chunk
+		return x.SubmissionId
 	}
-	return nil
+	return ""
 }
 
-// DeleteKnowledgeChunkRequest deletes a knowledge chunk.
-type DeleteKnowledgeChunkRequest struct {
+// GetSubmissionStatusResponse contains the submission's ingestion progress.
+type GetSubmissionStatusResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ChunkId       string                 `protobuf:"bytes,1,opt,name=chunk_id,json=chunkId,proto3" json:"chunk_id,omitempty"`
+	Progress      *SubmissionProgress    `protobuf:"bytes,1,opt,name=progress,proto3" json:"progress,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteKnowledgeChunkRequest) Reset() {
-	*x = DeleteKnowledgeChunkRequest{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[46]
+func (x *GetSubmissionStatusResponse) Reset() {
+	*x = GetSubmissionStatusResponse{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteKnowledgeChunkRequest) String() string {
+func (x *GetSubmissionStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteKnowledgeChunkRequest) ProtoMessage() {}
+func (*GetSubmissionStatusResponse) ProtoMessage() {}
 
-func (x *DeleteKnowledgeChunkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[46]
+func (x *GetSubmissionStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3176,40 +5510,42 @@ func (x *DeleteKnowledgeChunkRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteKnowledgeChunkRequest.ProtoReflect.Descriptor instead.
-func (*DeleteKnowledgeChunkRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use GetSubmissionStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetSubmissionStatusResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *DeleteKnowledgeChunkRequest) GetChunkId() string {
+func (x *GetSubmissionStatusResponse) GetProgress() *SubmissionProgress {
 	if x != nil {
-		return x.ChunkId
+		return x.Progress
 	}
-	return ""
+	return nil
 }
 
-// DeleteKnowledgeChunkResponse confirms deletion.
-type DeleteKnowledgeChunkResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// SuggestSMEsRequest describes the course to suggest SMEs for. At least one field is required.
+type SuggestSMEsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CourseTitle    string                 `protobuf:"bytes,1,opt,name=course_title,json=courseTitle,proto3" json:"course_title,omitempty"`
+	DesiredOutcome string                 `protobuf:"bytes,2,opt,name=desired_outcome,json=desiredOutcome,proto3" json:"desired_outcome,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *DeleteKnowledgeChunkResponse) Reset() {
-	*x = DeleteKnowledgeChunkResponse{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[47]
+func (x *SuggestSMEsRequest) Reset() {
+	*x = SuggestSMEsRequest{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteKnowledgeChunkResponse) String() string {
+func (x *SuggestSMEsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteKnowledgeChunkResponse) ProtoMessage() {}
+func (*SuggestSMEsRequest) ProtoMessage() {}
 
-func (x *DeleteKnowledgeChunkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[47]
+func (x *SuggestSMEsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3220,34 +5556,50 @@ func (x *DeleteKnowledgeChunkResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteKnowledgeChunkResponse.ProtoReflect.Descriptor instead.
-func (*DeleteKnowledgeChunkResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use SuggestSMEsRequest.ProtoReflect.Descriptor instead.
+func (*SuggestSMEsRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{85}
 }
 
-// DeleteTaskRequest permanently deletes a task.
-type DeleteTaskRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SuggestSMEsRequest) GetCourseTitle() string {
+	if x != nil {
+		return x.CourseTitle
+	}
+	return ""
 }
 
-func (x *DeleteTaskRequest) Reset() {
-	*x = DeleteTaskRequest{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[48]
+func (x *SuggestSMEsRequest) GetDesiredOutcome() string {
+	if x != nil {
+		return x.DesiredOutcome
+	}
+	return ""
+}
+
+// SMESuggestion is an SME suggested for a course goal.
+type SMESuggestion struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Sme            *SubjectMatterExpert   `protobuf:"bytes,1,opt,name=sme,proto3" json:"sme,omitempty"`
+	RelevanceScore float32                `protobuf:"fixed32,2,opt,name=relevance_score,json=relevanceScore,proto3" json:"relevance_score,omitempty"` // Weighted share of the goal's key terms covered, 0-1
+	MatchedTerms   []string               `protobuf:"bytes,3,rep,name=matched_terms,json=matchedTerms,proto3" json:"matched_terms,omitempty"`         // Key terms of the goal the SME covers, strongest first
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SMESuggestion) Reset() {
+	*x = SMESuggestion{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTaskRequest) String() string {
+func (x *SMESuggestion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTaskRequest) ProtoMessage() {}
+func (*SMESuggestion) ProtoMessage() {}
 
-func (x *DeleteTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[48]
+func (x *SMESuggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3258,40 +5610,56 @@ func (x *DeleteTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTaskRequest.ProtoReflect.Descriptor instead.
-func (*DeleteTaskRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use SMESuggestion.ProtoReflect.Descriptor instead.
+func (*SMESuggestion) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *DeleteTaskRequest) GetTaskId() string {
+func (x *SMESuggestion) GetSme() *SubjectMatterExpert {
 	if x != nil {
-		return x.TaskId
+		return x.Sme
 	}
-	return ""
+	return nil
 }
 
-// DeleteTaskResponse confirms task deletion.
-type DeleteTaskResponse struct {
+func (x *SMESuggestion) GetRelevanceScore() float32 {
+	if x != nil {
+		return x.RelevanceScore
+	}
+	return 0
+}
+
+func (x *SMESuggestion) GetMatchedTerms() []string {
+	if x != nil {
+		return x.MatchedTerms
+	}
+	return nil
+}
+
+// SuggestSMEsResponse contains the suggestions, most relevant first. Empty when no SME
+// matches any key term.
+type SuggestSMEsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Suggestions   []*SMESuggestion       `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTaskResponse) Reset() {
-	*x = DeleteTaskResponse{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[49]
+func (x *SuggestSMEsResponse) Reset() {
+	*x = SuggestSMEsResponse{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTaskResponse) String() string {
+func (x *SuggestSMEsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTaskResponse) ProtoMessage() {}
+func (*SuggestSMEsResponse) ProtoMessage() {}
 
-func (x *DeleteTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[49]
+func (x *SuggestSMEsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3302,16 +5670,23 @@ func (x *DeleteTaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTaskResponse.ProtoReflect.Descriptor instead.
-func (*DeleteTaskResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use SuggestSMEsResponse.ProtoReflect.Descriptor instead.
+func (*SuggestSMEsResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *SuggestSMEsResponse) GetSuggestions() []*SMESuggestion {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
 }
 
 var File_mirai_v1_sme_proto protoreflect.FileDescriptor
 
 const file_mirai_v1_sme_proto_rawDesc = "" +
 	"\n" +
-	"\x12mirai/v1/sme.proto\x12\bmirai.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe2\x04\n" +
+	"\x12mirai/v1/sme.proto\x12\bmirai.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x8e\x05\n" +
 	"\x13SubjectMatterExpert\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x1d\n" +
@@ -3330,9 +5705,10 @@ const file_mirai_v1_sme_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x14\n" +
+	"updated_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12*\n" +
+	"\x11created_by_active\x18\x0f \x01(\bR\x0fcreatedByActiveB\x14\n" +
 	"\x12_knowledge_summaryB\x19\n" +
-	"\x17_knowledge_content_path\"\x9d\x05\n" +
+	"\x17_knowledge_content_path\"\xbb\x05\n" +
 	"\aSMETask\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x15\n" +
@@ -3350,11 +5726,12 @@ const file_mirai_v1_sme_proto_rawDesc = "" +
 	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
 	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12B\n" +
-	"\fcompleted_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampH\x02R\vcompletedAt\x88\x01\x01B\n" +
+	"\fcompleted_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampH\x02R\vcompletedAt\x88\x01\x01\x12\x1c\n" +
+	"\tquestions\x18\x0f \x03(\tR\tquestionsB\n" +
 	"\n" +
 	"\b_team_idB\v\n" +
 	"\t_due_dateB\x0f\n" +
-	"\r_completed_at\"\xb1\a\n" +
+	"\r_completed_at\"\xe5\a\n" +
 	"\x11SMETaskSubmission\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x17\n" +
@@ -3377,7 +5754,8 @@ const file_mirai_v1_sme_proto_rawDesc = "" +
 	"isApproved\x12@\n" +
 	"\vapproved_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampH\x06R\n" +
 	"approvedAt\x88\x01\x01\x122\n" +
-	"\x13approved_by_user_id\x18\x12 \x01(\tH\aR\x10approvedByUserId\x88\x01\x01B\x11\n" +
+	"\x13approved_by_user_id\x18\x12 \x01(\tH\aR\x10approvedByUserId\x88\x01\x01\x122\n" +
+	"\x06status\x18\x13 \x01(\x0e2\x1a.mirai.v1.SubmissionStatusR\x06statusB\x11\n" +
 	"\x0f_extracted_textB\r\n" +
 	"\v_ai_summaryB\x12\n" +
 	"\x10_ingestion_errorB\x0f\n" +
@@ -3385,7 +5763,7 @@ const file_mirai_v1_sme_proto_rawDesc = "" +
 	"\x0f_reviewer_notesB\x13\n" +
 	"\x11_approved_contentB\x0e\n" +
 	"\f_approved_atB\x16\n" +
-	"\x14_approved_by_user_id\"\xa6\x02\n" +
+	"\x14_approved_by_user_id\"\x87\x04\n" +
 	"\x11SMEKnowledgeChunk\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x15\n" +
 	"\x06sme_id\x18\x02 \x01(\tR\x05smeId\x12(\n" +
@@ -3395,8 +5773,43 @@ const file_mirai_v1_sme_proto_rawDesc = "" +
 	"\bkeywords\x18\x06 \x03(\tR\bkeywords\x12'\n" +
 	"\x0frelevance_score\x18\a \x01(\x02R\x0erelevanceScore\x129\n" +
 	"\n" +
-	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\x10\n" +
-	"\x0e_submission_id\"\xa5\x01\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12+\n" +
+	"\x11injection_flagged\x18\t \x01(\bR\x10injectionFlagged\x12.\n" +
+	"\x10injection_reason\x18\n" +
+	" \x01(\tH\x01R\x0finjectionReason\x88\x01\x01\x12S\n" +
+	"\x15injection_released_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampH\x02R\x13injectionReleasedAt\x88\x01\x01B\x10\n" +
+	"\x0e_submission_idB\x13\n" +
+	"\x11_injection_reasonB\x18\n" +
+	"\x16_injection_released_at\"[\n" +
+	"\x12SMETaskStatusCount\x12/\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x17.mirai.v1.SMETaskStatusR\x06status\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"a\n" +
+	"\x15SubmissionStatusCount\x122\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x1a.mirai.v1.SubmissionStatusR\x06status\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"\xe3\x01\n" +
+	"\x11SubmissionSummary\x12\x1f\n" +
+	"\vtotal_count\x18\x01 \x01(\x05R\n" +
+	"totalCount\x12D\n" +
+	"\rstatus_counts\x18\x02 \x03(\v2\x1f.mirai.v1.SubmissionStatusCountR\fstatusCounts\x12O\n" +
+	"\x13latest_submitted_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x11latestSubmittedAt\x88\x01\x01B\x16\n" +
+	"\x14_latest_submitted_at\"\x8d\x04\n" +
+	"\bSMEStats\x12\x15\n" +
+	"\x06sme_id\x18\x01 \x01(\tR\x05smeId\x12\x19\n" +
+	"\bsme_name\x18\x02 \x01(\tR\asmeName\x122\n" +
+	"\n" +
+	"sme_status\x18\x03 \x01(\x0e2\x13.mirai.v1.SMEStatusR\tsmeStatus\x12=\n" +
+	"\vtask_counts\x18\x04 \x03(\v2\x1c.mirai.v1.SMETaskStatusCountR\n" +
+	"taskCounts\x123\n" +
+	"\x15submissions_processed\x18\x05 \x01(\x05R\x14submissionsProcessed\x12-\n" +
+	"\x12submissions_failed\x18\x06 \x01(\x05R\x11submissionsFailed\x12\x1f\n" +
+	"\vchunk_count\x18\a \x01(\x05R\n" +
+	"chunkCount\x121\n" +
+	"\x14extracted_characters\x18\b \x01(\x03R\x13extractedCharacters\x12I\n" +
+	"\x10last_ingested_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x0elastIngestedAt\x88\x01\x01\x12!\n" +
+	"\fcourse_count\x18\n" +
+	" \x01(\x05R\vcourseCount\x12!\n" +
+	"\flow_coverage\x18\v \x01(\bR\vlowCoverageB\x13\n" +
+	"\x11_last_ingested_at\"\xa5\x01\n" +
 	"\x10CreateSMERequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x16\n" +
@@ -3435,14 +5848,15 @@ const file_mirai_v1_sme_proto_rawDesc = "" +
 	"\x06_scopeB\t\n" +
 	"\a_status\"D\n" +
 	"\x11UpdateSMEResponse\x12/\n" +
-	"\x03sme\x18\x01 \x01(\v2\x1d.mirai.v1.SubjectMatterExpertR\x03sme\")\n" +
+	"\x03sme\x18\x01 \x01(\v2\x1d.mirai.v1.SubjectMatterExpertR\x03sme\"X\n" +
 	"\x10DeleteSMERequest\x12\x15\n" +
-	"\x06sme_id\x18\x01 \x01(\tR\x05smeId\"\x13\n" +
+	"\x06sme_id\x18\x01 \x01(\tR\x05smeId\x12-\n" +
+	"\x12confirmation_token\x18\x02 \x01(\tR\x11confirmationToken\"\x13\n" +
 	"\x11DeleteSMEResponse\"*\n" +
 	"\x11RestoreSMERequest\x12\x15\n" +
 	"\x06sme_id\x18\x01 \x01(\tR\x05smeId\"E\n" +
 	"\x12RestoreSMEResponse\x12/\n" +
-	"\x03sme\x18\x01 \x01(\v2\x1d.mirai.v1.SubjectMatterExpertR\x03sme\"\xcf\x02\n" +
+	"\x03sme\x18\x01 \x01(\v2\x1d.mirai.v1.SubjectMatterExpertR\x03sme\"\xed\x02\n" +
 	"\x11CreateTaskRequest\x12\x15\n" +
 	"\x06sme_id\x18\x01 \x01(\tR\x05smeId\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
@@ -3450,16 +5864,18 @@ const file_mirai_v1_sme_proto_rawDesc = "" +
 	"\x15expected_content_type\x18\x04 \x01(\x0e2\x15.mirai.v1.ContentTypeR\x13expectedContentType\x12-\n" +
 	"\x13assigned_to_user_id\x18\x05 \x01(\tR\x10assignedToUserId\x12\x1c\n" +
 	"\ateam_id\x18\x06 \x01(\tH\x00R\x06teamId\x88\x01\x01\x12:\n" +
-	"\bdue_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x01R\adueDate\x88\x01\x01B\n" +
+	"\bdue_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x01R\adueDate\x88\x01\x01\x12\x1c\n" +
+	"\tquestions\x18\b \x03(\tR\tquestionsB\n" +
 	"\n" +
 	"\b_team_idB\v\n" +
 	"\t_due_date\";\n" +
 	"\x12CreateTaskResponse\x12%\n" +
 	"\x04task\x18\x01 \x01(\v2\x11.mirai.v1.SMETaskR\x04task\")\n" +
 	"\x0eGetTaskRequest\x12\x17\n" +
-	"\atask_id\x18\x01 \x01(\tR\x06taskId\"8\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\"\x84\x01\n" +
 	"\x0fGetTaskResponse\x12%\n" +
-	"\x04task\x18\x01 \x01(\v2\x11.mirai.v1.SMETaskR\x04task\"\xc6\x01\n" +
+	"\x04task\x18\x01 \x01(\v2\x11.mirai.v1.SMETaskR\x04task\x12J\n" +
+	"\x12submission_summary\x18\x02 \x01(\v2\x1b.mirai.v1.SubmissionSummaryR\x11submissionSummary\"\xc6\x01\n" +
 	"\x10ListTasksRequest\x12\x1a\n" +
 	"\x06sme_id\x18\x01 \x01(\tH\x00R\x05smeId\x88\x01\x01\x122\n" +
 	"\x13assigned_to_user_id\x18\x02 \x01(\tH\x01R\x10assignedToUserId\x88\x01\x01\x124\n" +
@@ -3495,7 +5911,40 @@ const file_mirai_v1_sme_proto_rawDesc = "" +
 	"upload_url\x18\x01 \x01(\tR\tuploadUrl\x12\x1b\n" +
 	"\tfile_path\x18\x02 \x01(\tR\bfilePath\x129\n" +
 	"\n" +
-	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\x84\x02\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"{\n" +
+	"\x1bStartMultipartUploadRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x1b\n" +
+	"\tfile_name\x18\x02 \x01(\tR\bfileName\x12&\n" +
+	"\x0ffile_size_bytes\x18\x03 \x01(\x03R\rfileSizeBytes\"\x9f\x01\n" +
+	"\x1cStartMultipartUploadResponse\x12\x1b\n" +
+	"\tupload_id\x18\x01 \x01(\tR\buploadId\x12\x1b\n" +
+	"\tfile_path\x18\x02 \x01(\tR\bfilePath\x12&\n" +
+	"\x0fpart_size_bytes\x18\x03 \x01(\x03R\rpartSizeBytes\x12\x1d\n" +
+	"\n" +
+	"part_count\x18\x04 \x01(\x05R\tpartCount\"\xb8\x01\n" +
+	"\x18GetPartUploadURLsRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x1b\n" +
+	"\tfile_path\x18\x02 \x01(\tR\bfilePath\x12\x1b\n" +
+	"\tupload_id\x18\x03 \x01(\tR\buploadId\x12&\n" +
+	"\x0ffile_size_bytes\x18\x04 \x01(\x03R\rfileSizeBytes\x12!\n" +
+	"\fpart_numbers\x18\x05 \x03(\x05R\vpartNumbers\"O\n" +
+	"\rPartUploadURL\x12\x1f\n" +
+	"\vpart_number\x18\x01 \x01(\x05R\n" +
+	"partNumber\x12\x1d\n" +
+	"\n" +
+	"upload_url\x18\x02 \x01(\tR\tuploadUrl\"\xb9\x01\n" +
+	"\x19GetPartUploadURLsResponse\x12-\n" +
+	"\x05parts\x18\x01 \x03(\v2\x17.mirai.v1.PartUploadURLR\x05parts\x122\n" +
+	"\x15uploaded_part_numbers\x18\x02 \x03(\x05R\x13uploadedPartNumbers\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\x9b\x01\n" +
+	"\x1eCompleteMultipartUploadRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x1b\n" +
+	"\tfile_path\x18\x02 \x01(\tR\bfilePath\x12\x1b\n" +
+	"\tupload_id\x18\x03 \x01(\tR\buploadId\x12&\n" +
+	"\x0ffile_size_bytes\x18\x04 \x01(\x03R\rfileSizeBytes\">\n" +
+	"\x1fCompleteMultipartUploadResponse\x12\x1b\n" +
+	"\tfile_path\x18\x01 \x01(\tR\bfilePath\"\x84\x02\n" +
 	"\x14SubmitContentRequest\x12\x17\n" +
 	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x1b\n" +
 	"\tfile_name\x18\x02 \x01(\tR\bfileName\x12\x1b\n" +
@@ -3507,16 +5956,34 @@ const file_mirai_v1_sme_proto_rawDesc = "" +
 	"\x15SubmitContentResponse\x12;\n" +
 	"\n" +
 	"submission\x18\x01 \x01(\v2\x1b.mirai.v1.SMETaskSubmissionR\n" +
-	"submission\"1\n" +
+	"submission\"\xb3\x01\n" +
 	"\x16ListSubmissionsRequest\x12\x17\n" +
-	"\atask_id\x18\x01 \x01(\tR\x06taskId\"X\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x127\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1a.mirai.v1.SubmissionStatusH\x00R\x06status\x88\x01\x01\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x1b\n" +
+	"\x06cursor\x18\x04 \x01(\tH\x01R\x06cursor\x88\x01\x01B\t\n" +
+	"\a_statusB\t\n" +
+	"\a_cursor\"\x8e\x01\n" +
 	"\x17ListSubmissionsResponse\x12=\n" +
-	"\vsubmissions\x18\x01 \x03(\v2\x1b.mirai.v1.SMETaskSubmissionR\vsubmissions\",\n" +
+	"\vsubmissions\x18\x01 \x03(\v2\x1b.mirai.v1.SMETaskSubmissionR\vsubmissions\x12$\n" +
+	"\vnext_cursor\x18\x02 \x01(\tH\x00R\n" +
+	"nextCursor\x88\x01\x01B\x0e\n" +
+	"\f_next_cursor\"Q\n" +
 	"\x13GetKnowledgeRequest\x12\x15\n" +
-	"\x06sme_id\x18\x01 \x01(\tR\x05smeId\"|\n" +
+	"\x06sme_id\x18\x01 \x01(\tR\x05smeId\x12\x19\n" +
+	"\x05topic\x18\x02 \x01(\tH\x00R\x05topic\x88\x01\x01B\b\n" +
+	"\x06_topic\"|\n" +
 	"\x14GetKnowledgeResponse\x12/\n" +
 	"\x03sme\x18\x01 \x01(\v2\x1d.mirai.v1.SubjectMatterExpertR\x03sme\x123\n" +
-	"\x06chunks\x18\x02 \x03(\v2\x1b.mirai.v1.SMEKnowledgeChunkR\x06chunks\"]\n" +
+	"\x06chunks\x18\x02 \x03(\v2\x1b.mirai.v1.SMEKnowledgeChunkR\x06chunks\"3\n" +
+	"\x1aListKnowledgeTopicsRequest\x12\x15\n" +
+	"\x06sme_id\x18\x01 \x01(\tR\x05smeId\"G\n" +
+	"\x0eKnowledgeTopic\x12\x14\n" +
+	"\x05topic\x18\x01 \x01(\tR\x05topic\x12\x1f\n" +
+	"\vchunk_count\x18\x02 \x01(\x05R\n" +
+	"chunkCount\"O\n" +
+	"\x1bListKnowledgeTopicsResponse\x120\n" +
+	"\x06topics\x18\x01 \x03(\v2\x18.mirai.v1.KnowledgeTopicR\x06topics\"]\n" +
 	"\x16SearchKnowledgeRequest\x12\x17\n" +
 	"\asme_ids\x18\x01 \x03(\tR\x06smeIds\x12\x14\n" +
 	"\x05query\x18\x02 \x01(\tR\x05query\x12\x14\n" +
@@ -3528,7 +5995,16 @@ const file_mirai_v1_sme_proto_rawDesc = "" +
 	"\x15GetSubmissionResponse\x12;\n" +
 	"\n" +
 	"submission\x18\x01 \x01(\v2\x1b.mirai.v1.SMETaskSubmissionR\n" +
-	"submission\"j\n" +
+	"submission\"\x94\x01\n" +
+	"\x1aReprocessSubmissionRequest\x12#\n" +
+	"\rsubmission_id\x18\x01 \x01(\tR\fsubmissionId\x127\n" +
+	"\x15replacement_file_path\x18\x02 \x01(\tH\x00R\x13replacementFilePath\x88\x01\x01B\x18\n" +
+	"\x16_replacement_file_path\"q\n" +
+	"\x1bReprocessSubmissionResponse\x12;\n" +
+	"\n" +
+	"submission\x18\x01 \x01(\v2\x1b.mirai.v1.SMETaskSubmissionR\n" +
+	"submission\x12\x15\n" +
+	"\x06job_id\x18\x02 \x01(\tR\x05jobId\"j\n" +
 	"\x18ApproveSubmissionRequest\x12#\n" +
 	"\rsubmission_id\x18\x01 \x01(\tR\fsubmissionId\x12)\n" +
 	"\x10approved_content\x18\x02 \x01(\tR\x0fapprovedContent\"\x9c\x01\n" +
@@ -3560,10 +6036,85 @@ const file_mirai_v1_sme_proto_rawDesc = "" +
 	"\x05chunk\x18\x01 \x01(\v2\x1b.mirai.v1.SMEKnowledgeChunkR\x05chunk\"8\n" +
 	"\x1bDeleteKnowledgeChunkRequest\x12\x19\n" +
 	"\bchunk_id\x18\x01 \x01(\tR\achunkId\"\x1e\n" +
-	"\x1cDeleteKnowledgeChunkResponse\",\n" +
+	"\x1cDeleteKnowledgeChunkResponse\"Y\n" +
+	"\"ReviewFlaggedKnowledgeChunkRequest\x12\x19\n" +
+	"\bchunk_id\x18\x01 \x01(\tR\achunkId\x12\x18\n" +
+	"\arelease\x18\x02 \x01(\bR\arelease\"X\n" +
+	"#ReviewFlaggedKnowledgeChunkResponse\x121\n" +
+	"\x05chunk\x18\x01 \x01(\v2\x1b.mirai.v1.SMEKnowledgeChunkR\x05chunk\",\n" +
 	"\x11DeleteTaskRequest\x12\x17\n" +
 	"\atask_id\x18\x01 \x01(\tR\x06taskId\"\x14\n" +
-	"\x12DeleteTaskResponse*O\n" +
+	"\x12DeleteTaskResponse\"\x14\n" +
+	"\x12GetSMEStatsRequest\"q\n" +
+	"\x13GetSMEStatsResponse\x12(\n" +
+	"\x05stats\x18\x01 \x03(\v2\x12.mirai.v1.SMEStatsR\x05stats\x120\n" +
+	"\x14min_knowledge_chunks\x18\x02 \x01(\x05R\x12minKnowledgeChunks\"2\n" +
+	"\x19ExportSMEKnowledgeRequest\x12\x15\n" +
+	"\x06sme_id\x18\x01 \x01(\tR\x05smeId\"3\n" +
+	"\x1aExportSMEKnowledgeResponse\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"$\n" +
+	"\"GetKnowledgeImportUploadURLRequest\"a\n" +
+	"#GetKnowledgeImportUploadURLResponse\x12\x1d\n" +
+	"\n" +
+	"upload_url\x18\x01 \x01(\tR\tuploadUrl\x12\x1b\n" +
+	"\tfile_path\x18\x02 \x01(\tR\bfilePath\"s\n" +
+	"\x19ImportSMEKnowledgeRequest\x12\x1b\n" +
+	"\tfile_path\x18\x01 \x01(\tR\bfilePath\x12'\n" +
+	"\rtarget_sme_id\x18\x02 \x01(\tH\x00R\vtargetSmeId\x88\x01\x01B\x10\n" +
+	"\x0e_target_sme_id\"d\n" +
+	"\x1aImportSMEKnowledgeResponse\x12/\n" +
+	"\x03sme\x18\x01 \x01(\v2\x1d.mirai.v1.SubjectMatterExpertR\x03sme\x12\x15\n" +
+	"\x06job_id\x18\x02 \x01(\tR\x05jobId\"^\n" +
+	"\x1cGenerateTaskQuestionsRequest\x12\x15\n" +
+	"\x06sme_id\x18\x01 \x01(\tR\x05smeId\x12'\n" +
+	"\x0fdesired_outcome\x18\x02 \x01(\tR\x0edesiredOutcome\"^\n" +
+	"\x1dGenerateTaskQuestionsResponse\x12\x1c\n" +
+	"\tquestions\x18\x01 \x03(\tR\tquestions\x12\x1f\n" +
+	"\vtokens_used\x18\x02 \x01(\x03R\n" +
+	"tokensUsed\"I\n" +
+	"\x14SubmitAnswersRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x18\n" +
+	"\aanswers\x18\x02 \x03(\tR\aanswers\"T\n" +
+	"\x15SubmitAnswersResponse\x12;\n" +
+	"\n" +
+	"submission\x18\x01 \x01(\v2\x1b.mirai.v1.SMETaskSubmissionR\n" +
+	"submission\"4\n" +
+	"\x1bGetSMEDeletionImpactRequest\x12\x15\n" +
+	"\x06sme_id\x18\x01 \x01(\tR\x05smeId\"\xc6\x02\n" +
+	"\x1cGetSMEDeletionImpactResponse\x12)\n" +
+	"\x10knowledge_chunks\x18\x01 \x01(\x05R\x0fknowledgeChunks\x12#\n" +
+	"\rpending_tasks\x18\x02 \x01(\x05R\fpendingTasks\x12 \n" +
+	"\vsubmissions\x18\x03 \x01(\x05R\vsubmissions\x12\x18\n" +
+	"\acourses\x18\x04 \x01(\x05R\acourses\x120\n" +
+	"\x14recent_course_titles\x18\x05 \x03(\tR\x12recentCourseTitles\x12-\n" +
+	"\x12confirmation_token\x18\x06 \x01(\tR\x11confirmationToken\x129\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\xed\x02\n" +
+	"\x12SubmissionProgress\x12#\n" +
+	"\rsubmission_id\x18\x01 \x01(\tR\fsubmissionId\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12\x1a\n" +
+	"\x06job_id\x18\x03 \x01(\tH\x00R\x05jobId\x88\x01\x01\x12<\n" +
+	"\x06status\x18\x04 \x01(\x0e2$.mirai.v1.SubmissionProcessingStatusR\x06status\x12)\n" +
+	"\x10progress_percent\x18\x05 \x01(\x05R\x0fprogressPercent\x12)\n" +
+	"\x10progress_message\x18\x06 \x01(\tR\x0fprogressMessage\x12\x19\n" +
+	"\x05error\x18\a \x01(\tH\x01R\x05error\x88\x01\x01\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\t\n" +
+	"\a_job_idB\b\n" +
+	"\x06_error\"A\n" +
+	"\x1aGetSubmissionStatusRequest\x12#\n" +
+	"\rsubmission_id\x18\x01 \x01(\tR\fsubmissionId\"W\n" +
+	"\x1bGetSubmissionStatusResponse\x128\n" +
+	"\bprogress\x18\x01 \x01(\v2\x1c.mirai.v1.SubmissionProgressR\bprogress\"`\n" +
+	"\x12SuggestSMEsRequest\x12!\n" +
+	"\fcourse_title\x18\x01 \x01(\tR\vcourseTitle\x12'\n" +
+	"\x0fdesired_outcome\x18\x02 \x01(\tR\x0edesiredOutcome\"\x8e\x01\n" +
+	"\rSMESuggestion\x12/\n" +
+	"\x03sme\x18\x01 \x01(\v2\x1d.mirai.v1.SubjectMatterExpertR\x03sme\x12'\n" +
+	"\x0frelevance_score\x18\x02 \x01(\x02R\x0erelevanceScore\x12#\n" +
+	"\rmatched_terms\x18\x03 \x03(\tR\fmatchedTerms\"P\n" +
+	"\x13SuggestSMEsResponse\x129\n" +
+	"\vsuggestions\x18\x01 \x03(\v2\x17.mirai.v1.SMESuggestionR\vsuggestions*O\n" +
 	"\bSMEScope\x12\x19\n" +
 	"\x15SME_SCOPE_UNSPECIFIED\x10\x00\x12\x14\n" +
 	"\x10SME_SCOPE_GLOBAL\x10\x01\x12\x12\n" +
@@ -3583,7 +6134,13 @@ const file_mirai_v1_sme_proto_rawDesc = "" +
 	"\x16SME_TASK_STATUS_FAILED\x10\x05\x12\x1d\n" +
 	"\x19SME_TASK_STATUS_CANCELLED\x10\x06\x12#\n" +
 	"\x1fSME_TASK_STATUS_AWAITING_REVIEW\x10\a\x12%\n" +
-	"!SME_TASK_STATUS_CHANGES_REQUESTED\x10\b*a\n" +
+	"!SME_TASK_STATUS_CHANGES_REQUESTED\x10\b*\xba\x01\n" +
+	"\x10SubmissionStatus\x12!\n" +
+	"\x1dSUBMISSION_STATUS_UNSPECIFIED\x10\x00\x12$\n" +
+	" SUBMISSION_STATUS_PENDING_REVIEW\x10\x01\x12\x1f\n" +
+	"\x1bSUBMISSION_STATUS_PROCESSED\x10\x02\x12\x1c\n" +
+	"\x18SUBMISSION_STATUS_FAILED\x10\x03\x12\x1e\n" +
+	"\x1aSUBMISSION_STATUS_APPROVED\x10\x04*a\n" +
 	"\vEnhanceType\x12\x1c\n" +
 	"\x18ENHANCE_TYPE_UNSPECIFIED\x10\x00\x12\x1a\n" +
 	"\x16ENHANCE_TYPE_SUMMARIZE\x10\x01\x12\x18\n" +
@@ -3595,14 +6152,22 @@ const file_mirai_v1_sme_proto_rawDesc = "" +
 	"\x12CONTENT_TYPE_VIDEO\x10\x03\x12\x16\n" +
 	"\x12CONTENT_TYPE_AUDIO\x10\x04\x12\x14\n" +
 	"\x10CONTENT_TYPE_URL\x10\x05\x12\x15\n" +
-	"\x11CONTENT_TYPE_TEXT\x10\x062\xd5\x0e\n" +
+	"\x11CONTENT_TYPE_TEXT\x10\x06*\xa3\x02\n" +
+	"\x1aSubmissionProcessingStatus\x12,\n" +
+	"(SUBMISSION_PROCESSING_STATUS_UNSPECIFIED\x10\x00\x12,\n" +
+	"(SUBMISSION_PROCESSING_STATUS_NOT_STARTED\x10\x01\x12'\n" +
+	"#SUBMISSION_PROCESSING_STATUS_QUEUED\x10\x02\x12+\n" +
+	"'SUBMISSION_PROCESSING_STATUS_PROCESSING\x10\x03\x12*\n" +
+	"&SUBMISSION_PROCESSING_STATUS_COMPLETED\x10\x04\x12'\n" +
+	"#SUBMISSION_PROCESSING_STATUS_FAILED\x10\x052\xab\x1a\n" +
 	"\n" +
 	"SMEService\x12D\n" +
 	"\tCreateSME\x12\x1a.mirai.v1.CreateSMERequest\x1a\x1b.mirai.v1.CreateSMEResponse\x12;\n" +
 	"\x06GetSME\x12\x17.mirai.v1.GetSMERequest\x1a\x18.mirai.v1.GetSMEResponse\x12A\n" +
 	"\bListSMEs\x12\x19.mirai.v1.ListSMEsRequest\x1a\x1a.mirai.v1.ListSMEsResponse\x12D\n" +
 	"\tUpdateSME\x12\x1a.mirai.v1.UpdateSMERequest\x1a\x1b.mirai.v1.UpdateSMEResponse\x12D\n" +
-	"\tDeleteSME\x12\x1a.mirai.v1.DeleteSMERequest\x1a\x1b.mirai.v1.DeleteSMEResponse\x12G\n" +
+	"\tDeleteSME\x12\x1a.mirai.v1.DeleteSMERequest\x1a\x1b.mirai.v1.DeleteSMEResponse\x12e\n" +
+	"\x14GetSMEDeletionImpact\x12%.mirai.v1.GetSMEDeletionImpactRequest\x1a&.mirai.v1.GetSMEDeletionImpactResponse\x12G\n" +
 	"\n" +
 	"RestoreSME\x12\x1b.mirai.v1.RestoreSMERequest\x1a\x1c.mirai.v1.RestoreSMEResponse\x12G\n" +
 	"\n" +
@@ -3613,19 +6178,33 @@ const file_mirai_v1_sme_proto_rawDesc = "" +
 	"UpdateTask\x12\x1b.mirai.v1.UpdateTaskRequest\x1a\x1c.mirai.v1.UpdateTaskResponse\x12G\n" +
 	"\n" +
 	"CancelTask\x12\x1b.mirai.v1.CancelTaskRequest\x1a\x1c.mirai.v1.CancelTaskResponse\x12M\n" +
-	"\fGetUploadURL\x12\x1d.mirai.v1.GetUploadURLRequest\x1a\x1e.mirai.v1.GetUploadURLResponse\x12P\n" +
-	"\rSubmitContent\x12\x1e.mirai.v1.SubmitContentRequest\x1a\x1f.mirai.v1.SubmitContentResponse\x12V\n" +
+	"\fGetUploadURL\x12\x1d.mirai.v1.GetUploadURLRequest\x1a\x1e.mirai.v1.GetUploadURLResponse\x12e\n" +
+	"\x14StartMultipartUpload\x12%.mirai.v1.StartMultipartUploadRequest\x1a&.mirai.v1.StartMultipartUploadResponse\x12\\\n" +
+	"\x11GetPartUploadURLs\x12\".mirai.v1.GetPartUploadURLsRequest\x1a#.mirai.v1.GetPartUploadURLsResponse\x12n\n" +
+	"\x17CompleteMultipartUpload\x12(.mirai.v1.CompleteMultipartUploadRequest\x1a).mirai.v1.CompleteMultipartUploadResponse\x12P\n" +
+	"\rSubmitContent\x12\x1e.mirai.v1.SubmitContentRequest\x1a\x1f.mirai.v1.SubmitContentResponse\x12h\n" +
+	"\x15GenerateTaskQuestions\x12&.mirai.v1.GenerateTaskQuestionsRequest\x1a'.mirai.v1.GenerateTaskQuestionsResponse\x12P\n" +
+	"\rSubmitAnswers\x12\x1e.mirai.v1.SubmitAnswersRequest\x1a\x1f.mirai.v1.SubmitAnswersResponse\x12V\n" +
 	"\x0fListSubmissions\x12 .mirai.v1.ListSubmissionsRequest\x1a!.mirai.v1.ListSubmissionsResponse\x12M\n" +
-	"\fGetKnowledge\x12\x1d.mirai.v1.GetKnowledgeRequest\x1a\x1e.mirai.v1.GetKnowledgeResponse\x12V\n" +
-	"\x0fSearchKnowledge\x12 .mirai.v1.SearchKnowledgeRequest\x1a!.mirai.v1.SearchKnowledgeResponse\x12P\n" +
+	"\fGetKnowledge\x12\x1d.mirai.v1.GetKnowledgeRequest\x1a\x1e.mirai.v1.GetKnowledgeResponse\x12b\n" +
+	"\x13ListKnowledgeTopics\x12$.mirai.v1.ListKnowledgeTopicsRequest\x1a%.mirai.v1.ListKnowledgeTopicsResponse\x12V\n" +
+	"\x0fSearchKnowledge\x12 .mirai.v1.SearchKnowledgeRequest\x1a!.mirai.v1.SearchKnowledgeResponse\x12J\n" +
+	"\vSuggestSMEs\x12\x1c.mirai.v1.SuggestSMEsRequest\x1a\x1d.mirai.v1.SuggestSMEsResponse\x12P\n" +
 	"\rGetSubmission\x12\x1e.mirai.v1.GetSubmissionRequest\x1a\x1f.mirai.v1.GetSubmissionResponse\x12\\\n" +
 	"\x11ApproveSubmission\x12\".mirai.v1.ApproveSubmissionRequest\x1a#.mirai.v1.ApproveSubmissionResponse\x12q\n" +
 	"\x18RequestSubmissionChanges\x12).mirai.v1.RequestSubmissionChangesRequest\x1a*.mirai.v1.RequestSubmissionChangesResponse\x12q\n" +
-	"\x18EnhanceSubmissionContent\x12).mirai.v1.EnhanceSubmissionContentRequest\x1a*.mirai.v1.EnhanceSubmissionContentResponse\x12e\n" +
+	"\x18EnhanceSubmissionContent\x12).mirai.v1.EnhanceSubmissionContentRequest\x1a*.mirai.v1.EnhanceSubmissionContentResponse\x12b\n" +
+	"\x13ReprocessSubmission\x12$.mirai.v1.ReprocessSubmissionRequest\x1a%.mirai.v1.ReprocessSubmissionResponse\x12b\n" +
+	"\x13GetSubmissionStatus\x12$.mirai.v1.GetSubmissionStatusRequest\x1a%.mirai.v1.GetSubmissionStatusResponse\x12e\n" +
 	"\x14UpdateKnowledgeChunk\x12%.mirai.v1.UpdateKnowledgeChunkRequest\x1a&.mirai.v1.UpdateKnowledgeChunkResponse\x12e\n" +
-	"\x14DeleteKnowledgeChunk\x12%.mirai.v1.DeleteKnowledgeChunkRequest\x1a&.mirai.v1.DeleteKnowledgeChunkResponse\x12G\n" +
+	"\x14DeleteKnowledgeChunk\x12%.mirai.v1.DeleteKnowledgeChunkRequest\x1a&.mirai.v1.DeleteKnowledgeChunkResponse\x12z\n" +
+	"\x1bReviewFlaggedKnowledgeChunk\x12,.mirai.v1.ReviewFlaggedKnowledgeChunkRequest\x1a-.mirai.v1.ReviewFlaggedKnowledgeChunkResponse\x12G\n" +
 	"\n" +
-	"DeleteTask\x12\x1b.mirai.v1.DeleteTaskRequest\x1a\x1c.mirai.v1.DeleteTaskResponseB\x8e\x01\n" +
+	"DeleteTask\x12\x1b.mirai.v1.DeleteTaskRequest\x1a\x1c.mirai.v1.DeleteTaskResponse\x12J\n" +
+	"\vGetSMEStats\x12\x1c.mirai.v1.GetSMEStatsRequest\x1a\x1d.mirai.v1.GetSMEStatsResponse\x12_\n" +
+	"\x12ExportSMEKnowledge\x12#.mirai.v1.ExportSMEKnowledgeRequest\x1a$.mirai.v1.ExportSMEKnowledgeResponse\x12z\n" +
+	"\x1bGetKnowledgeImportUploadURL\x12,.mirai.v1.GetKnowledgeImportUploadURLRequest\x1a-.mirai.v1.GetKnowledgeImportUploadURLResponse\x12_\n" +
+	"\x12ImportSMEKnowledge\x12#.mirai.v1.ImportSMEKnowledgeRequest\x1a$.mirai.v1.ImportSMEKnowledgeResponseB\x8e\x01\n" +
 	"\fcom.mirai.v1B\bSmeProtoP\x01Z3github.com/sogos/mirai-backend/gen/mirai/v1;miraiv1\xa2\x02\x03MXX\xaa\x02\bMirai.V1\xca\x02\bMirai\\V1\xe2\x02\x14Mirai\\V1\\GPBMetadata\xea\x02\tMirai::V1b\x06proto3"
 
 var (
@@ -3640,167 +6219,262 @@ func file_mirai_v1_sme_proto_rawDescGZIP() []byte {
 	return file_mirai_v1_sme_proto_rawDescData
 }
 
-var file_mirai_v1_sme_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_mirai_v1_sme_proto_msgTypes = make([]protoimpl.MessageInfo, 50)
+var file_mirai_v1_sme_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_mirai_v1_sme_proto_msgTypes = make([]protoimpl.MessageInfo, 88)
 var file_mirai_v1_sme_proto_goTypes = []any{
-	(SMEScope)(0),                            // 0: mirai.v1.SMEScope
-	(SMEStatus)(0),                           // 1: mirai.v1.SMEStatus
-	(SMETaskStatus)(0),                       // 2: mirai.v1.SMETaskStatus
-	(EnhanceType)(0),                         // 3: mirai.v1.EnhanceType
-	(ContentType)(0),                         // 4: mirai.v1.ContentType
-	(*SubjectMatterExpert)(nil),              // 5: mirai.v1.SubjectMatterExpert
-	(*SMETask)(nil),                          // 6: mirai.v1.SMETask
-	(*SMETaskSubmission)(nil),                // 7: mirai.v1.SMETaskSubmission
-	(*SMEKnowledgeChunk)(nil),                // 8: mirai.v1.SMEKnowledgeChunk
-	(*CreateSMERequest)(nil),                 // 9: mirai.v1.CreateSMERequest
-	(*CreateSMEResponse)(nil),                // 10: mirai.v1.CreateSMEResponse
-	(*GetSMERequest)(nil),                    // 11: mirai.v1.GetSMERequest
-	(*GetSMEResponse)(nil),                   // 12: mirai.v1.GetSMEResponse
-	(*ListSMEsRequest)(nil),                  // 13: mirai.v1.ListSMEsRequest
-	(*ListSMEsResponse)(nil),                 // 14: mirai.v1.ListSMEsResponse
-	(*UpdateSMERequest)(nil),                 // 15: mirai.v1.UpdateSMERequest
-	(*UpdateSMEResponse)(nil),                // 16: mirai.v1.UpdateSMEResponse
-	(*DeleteSMERequest)(nil),                 // 17: mirai.v1.DeleteSMERequest
-	(*DeleteSMEResponse)(nil),                // 18: mirai.v1.DeleteSMEResponse
-	(*RestoreSMERequest)(nil),                // 19: mirai.v1.RestoreSMERequest
-	(*RestoreSMEResponse)(nil),               // 20: mirai.v1.RestoreSMEResponse
-	(*CreateTaskRequest)(nil),                // 21: mirai.v1.CreateTaskRequest
-	(*CreateTaskResponse)(nil),               // 22: mirai.v1.CreateTaskResponse
-	(*GetTaskRequest)(nil),                   // 23: mirai.v1.GetTaskRequest
-	(*GetTaskResponse)(nil),                  // 24: mirai.v1.GetTaskResponse
-	(*ListTasksRequest)(nil),                 // 25: mirai.v1.ListTasksRequest
-	(*ListTasksResponse)(nil),                // 26: mirai.v1.ListTasksResponse
-	(*UpdateTaskRequest)(nil),                // 27: mirai.v1.UpdateTaskRequest
-	(*UpdateTaskResponse)(nil),               // 28: mirai.v1.UpdateTaskResponse
-	(*CancelTaskRequest)(nil),                // 29: mirai.v1.CancelTaskRequest
-	(*CancelTaskResponse)(nil),               // 30: mirai.v1.CancelTaskResponse
-	(*GetUploadURLRequest)(nil),              // 31: mirai.v1.GetUploadURLRequest
-	(*GetUploadURLResponse)(nil),             // 32: mirai.v1.GetUploadURLResponse
-	(*SubmitContentRequest)(nil),             // 33: mirai.v1.SubmitContentRequest
-	(*SubmitContentResponse)(nil),            // 34: mirai.v1.SubmitContentResponse
-	(*ListSubmissionsRequest)(nil),           // 35: mirai.v1.ListSubmissionsRequest
-	(*ListSubmissionsResponse)(nil),          // 36: mirai.v1.ListSubmissionsResponse
-	(*GetKnowledgeRequest)(nil),              // 37: mirai.v1.GetKnowledgeRequest
-	(*GetKnowledgeResponse)(nil),             // 38: mirai.v1.GetKnowledgeResponse
-	(*SearchKnowledgeRequest)(nil),           // 39: mirai.v1.SearchKnowledgeRequest
-	(*SearchKnowledgeResponse)(nil),          // 40: mirai.v1.SearchKnowledgeResponse
-	(*GetSubmissionRequest)(nil),             // 41: mirai.v1.GetSubmissionRequest
-	(*GetSubmissionResponse)(nil),            // 42: mirai.v1.GetSubmissionResponse
-	(*ApproveSubmissionRequest)(nil),         // 43: mirai.v1.ApproveSubmissionRequest
-	(*ApproveSubmissionResponse)(nil),        // 44: mirai.v1.ApproveSubmissionResponse
-	(*RequestSubmissionChangesRequest)(nil),  // 45: mirai.v1.RequestSubmissionChangesRequest
-	(*RequestSubmissionChangesResponse)(nil), // 46: mirai.v1.RequestSubmissionChangesResponse
-	(*EnhanceSubmissionContentRequest)(nil),  // 47: mirai.v1.EnhanceSubmissionContentRequest
-	(*EnhanceSubmissionContentResponse)(nil), // 48: mirai.v1.EnhanceSubmissionContentResponse
-	(*UpdateKnowledgeChunkRequest)(nil),      // 49: mirai.v1.UpdateKnowledgeChunkRequest
-	(*UpdateKnowledgeChunkResponse)(nil),     // 50: mirai.v1.UpdateKnowledgeChunkResponse
-	(*DeleteKnowledgeChunkRequest)(nil),      // 51: mirai.v1.DeleteKnowledgeChunkRequest
-	(*DeleteKnowledgeChunkResponse)(nil),     // 52: mirai.v1.DeleteKnowledgeChunkResponse
-	(*DeleteTaskRequest)(nil),                // 53: mirai.v1.DeleteTaskRequest
-	(*DeleteTaskResponse)(nil),               // 54: mirai.v1.DeleteTaskResponse
-	(*timestamppb.Timestamp)(nil),            // 55: google.protobuf.Timestamp
+	(SMEScope)(0),                               // 0: mirai.v1.SMEScope
+	(SMEStatus)(0),                              // 1: mirai.v1.SMEStatus
+	(SMETaskStatus)(0),                          // 2: mirai.v1.SMETaskStatus
+	(SubmissionStatus)(0),                       // 3: mirai.v1.SubmissionStatus
+	(EnhanceType)(0),                            // 4: mirai.v1.EnhanceType
+	(ContentType)(0),                            // 5: mirai.v1.ContentType
+	(SubmissionProcessingStatus)(0),             // 6: mirai.v1.SubmissionProcessingStatus
+	(*SubjectMatterExpert)(nil),                 // 7: mirai.v1.SubjectMatterExpert
+	(*SMETask)(nil),                             // 8: mirai.v1.SMETask
+	(*SMETaskSubmission)(nil),                   // 9: mirai.v1.SMETaskSubmission
+	(*SMEKnowledgeChunk)(nil),                   // 10: mirai.v1.SMEKnowledgeChunk
+	(*SMETaskStatusCount)(nil),                  // 11: mirai.v1.SMETaskStatusCount
+	(*SubmissionStatusCount)(nil),               // 12: mirai.v1.SubmissionStatusCount
+	(*SubmissionSummary)(nil),                   // 13: mirai.v1.SubmissionSummary
+	(*SMEStats)(nil),                            // 14: mirai.v1.SMEStats
+	(*CreateSMERequest)(nil),                    // 15: mirai.v1.CreateSMERequest
+	(*CreateSMEResponse)(nil),                   // 16: mirai.v1.CreateSMEResponse
+	(*GetSMERequest)(nil),                       // 17: mirai.v1.GetSMERequest
+	(*GetSMEResponse)(nil),                      // 18: mirai.v1.GetSMEResponse
+	(*ListSMEsRequest)(nil),                     // 19: mirai.v1.ListSMEsRequest
+	(*ListSMEsResponse)(nil),                    // 20: mirai.v1.ListSMEsResponse
+	(*UpdateSMERequest)(nil),                    // 21: mirai.v1.UpdateSMERequest
+	(*UpdateSMEResponse)(nil),                   // 22: mirai.v1.UpdateSMEResponse
+	(*DeleteSMERequest)(nil),                    // 23: mirai.v1.DeleteSMERequest
+	(*DeleteSMEResponse)(nil),                   // 24: mirai.v1.DeleteSMEResponse
+	(*RestoreSMERequest)(nil),                   // 25: mirai.v1.RestoreSMERequest
+	(*RestoreSMEResponse)(nil),                  // 26: mirai.v1.RestoreSMEResponse
+	(*CreateTaskRequest)(nil),                   // 27: mirai.v1.CreateTaskRequest
+	(*CreateTaskResponse)(nil),                  // 28: mirai.v1.CreateTaskResponse
+	(*GetTaskRequest)(nil),                      // 29: mirai.v1.GetTaskRequest
+	(*GetTaskResponse)(nil),                     // 30: mirai.v1.GetTaskResponse
+	(*ListTasksRequest)(nil),                    // 31: mirai.v1.ListTasksRequest
+	(*ListTasksResponse)(nil),                   // 32: mirai.v1.ListTasksResponse
+	(*UpdateTaskRequest)(nil),                   // 33: mirai.v1.UpdateTaskRequest
+	(*UpdateTaskResponse)(nil),                  // 34: mirai.v1.UpdateTaskResponse
+	(*CancelTaskRequest)(nil),                   // 35: mirai.v1.CancelTaskRequest
+	(*CancelTaskResponse)(nil),                  // 36: mirai.v1.CancelTaskResponse
+	(*GetUploadURLRequest)(nil),                 // 37: mirai.v1.GetUploadURLRequest
+	(*GetUploadURLResponse)(nil),                // 38: mirai.v1.GetUploadURLResponse
+	(*StartMultipartUploadRequest)(nil),         // 39: mirai.v1.StartMultipartUploadRequest
+	(*StartMultipartUploadResponse)(nil),        // 40: mirai.v1.StartMultipartUploadResponse
+	(*GetPartUploadURLsRequest)(nil),            // 41: mirai.v1.GetPartUploadURLsRequest
+	(*PartUploadURL)(nil),                       // 42: mirai.v1.PartUploadURL
+	(*GetPartUploadURLsResponse)(nil),           // 43: mirai.v1.GetPartUploadURLsResponse
+	(*CompleteMultipartUploadRequest)(nil),      // 44: mirai.v1.CompleteMultipartUploadRequest
+	(*CompleteMultipartUploadResponse)(nil),     // 45: mirai.v1.CompleteMultipartUploadResponse
+	(*SubmitContentRequest)(nil),                // 46: mirai.v1.SubmitContentRequest
+	(*SubmitContentResponse)(nil),               // 47: mirai.v1.SubmitContentResponse
+	(*ListSubmissionsRequest)(nil),              // 48: mirai.v1.ListSubmissionsRequest
+	(*ListSubmissionsResponse)(nil),             // 49: mirai.v1.ListSubmissionsResponse
+	(*GetKnowledgeRequest)(nil),                 // 50: mirai.v1.GetKnowledgeRequest
+	(*GetKnowledgeResponse)(nil),                // 51: mirai.v1.GetKnowledgeResponse
+	(*ListKnowledgeTopicsRequest)(nil),          // 52: mirai.v1.ListKnowledgeTopicsRequest
+	(*KnowledgeTopic)(nil),                      // 53: mirai.v1.KnowledgeTopic
+	(*ListKnowledgeTopicsResponse)(nil),         // 54: mirai.v1.ListKnowledgeTopicsResponse
+	(*SearchKnowledgeRequest)(nil),              // 55: mirai.v1.SearchKnowledgeRequest
+	(*SearchKnowledgeResponse)(nil),             // 56: mirai.v1.SearchKnowledgeResponse
+	(*GetSubmissionRequest)(nil),                // 57: mirai.v1.GetSubmissionRequest
+	(*GetSubmissionResponse)(nil),               // 58: mirai.v1.GetSubmissionResponse
+	(*ReprocessSubmissionRequest)(nil),          // 59: mirai.v1.ReprocessSubmissionRequest
+	(*ReprocessSubmissionResponse)(nil),         // 60: mirai.v1.ReprocessSubmissionResponse
+	(*ApproveSubmissionRequest)(nil),            // 61: mirai.v1.ApproveSubmissionRequest
+	(*ApproveSubmissionResponse)(nil),           // 62: mirai.v1.ApproveSubmissionResponse
+	(*RequestSubmissionChangesRequest)(nil),     // 63: mirai.v1.RequestSubmissionChangesRequest
+	(*RequestSubmissionChangesResponse)(nil),    // 64: mirai.v1.RequestSubmissionChangesResponse
+	(*EnhanceSubmissionContentRequest)(nil),     // 65: mirai.v1.EnhanceSubmissionContentRequest
+	(*EnhanceSubmissionContentResponse)(nil),    // 66: mirai.v1.EnhanceSubmissionContentResponse
+	(*UpdateKnowledgeChunkRequest)(nil),         // 67: mirai.v1.UpdateKnowledgeChunkRequest
+	(*UpdateKnowledgeChunkResponse)(nil),        // 68: mirai.v1.UpdateKnowledgeChunkResponse
+	(*DeleteKnowledgeChunkRequest)(nil),         // 69: mirai.v1.DeleteKnowledgeChunkRequest
+	(*DeleteKnowledgeChunkResponse)(nil),        // 70: mirai.v1.DeleteKnowledgeChunkResponse
+	(*ReviewFlaggedKnowledgeChunkRequest)(nil),  // 71: mirai.v1.ReviewFlaggedKnowledgeChunkRequest
+	(*ReviewFlaggedKnowledgeChunkResponse)(nil), // 72: mirai.v1.ReviewFlaggedKnowledgeChunkResponse
+	(*DeleteTaskRequest)(nil),                   // 73: mirai.v1.DeleteTaskRequest
+	(*DeleteTaskResponse)(nil),                  // 74: mirai.v1.DeleteTaskResponse
+	(*GetSMEStatsRequest)(nil),                  // 75: mirai.v1.GetSMEStatsRequest
+	(*GetSMEStatsResponse)(nil),                 // 76: mirai.v1.GetSMEStatsResponse
+	(*ExportSMEKnowledgeRequest)(nil),           // 77: mirai.v1.ExportSMEKnowledgeRequest
+	(*ExportSMEKnowledgeResponse)(nil),          // 78: mirai.v1.ExportSMEKnowledgeResponse
+	(*GetKnowledgeImportUploadURLRequest)(nil),  // 79: mirai.v1.GetKnowledgeImportUploadURLRequest
+	(*GetKnowledgeImportUploadURLResponse)(nil), // 80: mirai.v1.GetKnowledgeImportUploadURLResponse
+	(*ImportSMEKnowledgeRequest)(nil),           // 81: mirai.v1.ImportSMEKnowledgeRequest
+	(*ImportSMEKnowledgeResponse)(nil),          // 82: mirai.v1.ImportSMEKnowledgeResponse
+	(*GenerateTaskQuestionsRequest)(nil),        // 83: mirai.v1.GenerateTaskQuestionsRequest
+	(*GenerateTaskQuestionsResponse)(nil),       // 84: mirai.v1.GenerateTaskQuestionsResponse
+	(*SubmitAnswersRequest)(nil),                // 85: mirai.v1.SubmitAnswersRequest
+	(*SubmitAnswersResponse)(nil),               // 86: mirai.v1.SubmitAnswersResponse
+	(*GetSMEDeletionImpactRequest)(nil),         // 87: mirai.v1.GetSMEDeletionImpactRequest
+	(*GetSMEDeletionImpactResponse)(nil),        // 88: mirai.v1.GetSMEDeletionImpactResponse
+	(*SubmissionProgress)(nil),                  // 89: mirai.v1.SubmissionProgress
+	(*GetSubmissionStatusRequest)(nil),          // 90: mirai.v1.GetSubmissionStatusRequest
+	(*GetSubmissionStatusResponse)(nil),         // 91: mirai.v1.GetSubmissionStatusResponse
+	(*SuggestSMEsRequest)(nil),                  // 92: mirai.v1.SuggestSMEsRequest
+	(*SMESuggestion)(nil),                       // 93: mirai.v1.SMESuggestion
+	(*SuggestSMEsResponse)(nil),                 // 94: mirai.v1.SuggestSMEsResponse
+	(*timestamppb.Timestamp)(nil),               // 95: google.protobuf.Timestamp
 }
 var file_mirai_v1_sme_proto_depIdxs = []int32{
-	0,  // 0: mirai.v1.SubjectMatterExpert.scope:type_name -> mirai.v1.SMEScope
-	1,  // 1: mirai.v1.SubjectMatterExpert.status:type_name -> mirai.v1.SMEStatus
-	55, // 2: mirai.v1.SubjectMatterExpert.created_at:type_name -> google.protobuf.Timestamp
-	55, // 3: mirai.v1.SubjectMatterExpert.updated_at:type_name -> google.protobuf.Timestamp
-	4,  // 4: mirai.v1.SMETask.expected_content_type:type_name -> mirai.v1.ContentType
-	2,  // 5: mirai.v1.SMETask.status:type_name -> mirai.v1.SMETaskStatus
-	55, // 6: mirai.v1.SMETask.due_date:type_name -> google.protobuf.Timestamp
-	55, // 7: mirai.v1.SMETask.created_at:type_name -> google.protobuf.Timestamp
-	55, // 8: mirai.v1.SMETask.updated_at:type_name -> google.protobuf.Timestamp
-	55, // 9: mirai.v1.SMETask.completed_at:type_name -> google.protobuf.Timestamp
-	4,  // 10: mirai.v1.SMETaskSubmission.content_type:type_name -> mirai.v1.ContentType
-	55, // 11: mirai.v1.SMETaskSubmission.submitted_at:type_name -> google.protobuf.Timestamp
-	55, // 12: mirai.v1.SMETaskSubmission.processed_at:type_name -> google.protobuf.Timestamp
-	55, // 13: mirai.v1.SMETaskSubmission.approved_at:type_name -> google.protobuf.Timestamp
-	55, // 14: mirai.v1.SMEKnowledgeChunk.created_at:type_name -> google.protobuf.Timestamp
-	0,  // 15: mirai.v1.CreateSMERequest.scope:type_name -> mirai.v1.SMEScope
-	5,  // 16: mirai.v1.CreateSMEResponse.sme:type_name -> mirai.v1.SubjectMatterExpert
-	5,  // 17: mirai.v1.GetSMEResponse.sme:type_name -> mirai.v1.SubjectMatterExpert
-	0,  // 18: mirai.v1.ListSMEsRequest.scope:type_name -> mirai.v1.SMEScope
-	1,  // 19: mirai.v1.ListSMEsRequest.status:type_name -> mirai.v1.SMEStatus
-	5,  // 20: mirai.v1.ListSMEsResponse.smes:type_name -> mirai.v1.SubjectMatterExpert
-	0,  // 21: mirai.v1.UpdateSMERequest.scope:type_name -> mirai.v1.SMEScope
-	1,  // 22: mirai.v1.UpdateSMERequest.status:type_name -> mirai.v1.SMEStatus
-	5,  // 23: mirai.v1.UpdateSMEResponse.sme:type_name -> mirai.v1.SubjectMatterExpert
-	5,  // 24: mirai.v1.RestoreSMEResponse.sme:type_name -> mirai.v1.SubjectMatterExpert
-	4,  // 25: mirai.v1.CreateTaskRequest.expected_content_type:type_name -> mirai.v1.ContentType
-	55, // 26: mirai.v1.CreateTaskRequest.due_date:type_name -> google.protobuf.Timestamp
-	6,  // 27: mirai.v1.CreateTaskResponse.task:type_name -> mirai.v1.SMETask
-	6,  // 28: mirai.v1.GetTaskResponse.task:type_name -> mirai.v1.SMETask
-	2,  // 29: mirai.v1.ListTasksRequest.status:type_name -> mirai.v1.SMETaskStatus
-	6,  // 30: mirai.v1.ListTasksResponse.tasks:type_name -> mirai.v1.SMETask
-	4,  // 31: mirai.v1.UpdateTaskRequest.expected_content_type:type_name -> mirai.v1.ContentType
-	55, // 32: mirai.v1.UpdateTaskRequest.due_date:type_name -> google.protobuf.Timestamp
-	6,  // 33: mirai.v1.UpdateTaskResponse.task:type_name -> mirai.v1.SMETask
-	6,  // 34: mirai.v1.CancelTaskResponse.task:type_name -> mirai.v1.SMETask
-	4,  // 35: mirai.v1.GetUploadURLRequest.content_type:type_name -> mirai.v1.ContentType
-	55, // 36: mirai.v1.GetUploadURLResponse.expires_at:type_name -> google.protobuf.Timestamp
-	4,  // 37: mirai.v1.SubmitContentRequest.content_type:type_name -> mirai.v1.ContentType
-	7,  // 38: mirai.v1.SubmitContentResponse.submission:type_name -> mirai.v1.SMETaskSubmission
-	7,  // 39: mirai.v1.ListSubmissionsResponse.submissions:type_name -> mirai.v1.SMETaskSubmission
-	5,  // 40: mirai.v1.GetKnowledgeResponse.sme:type_name -> mirai.v1.SubjectMatterExpert
-	8,  // 41: mirai.v1.GetKnowledgeResponse.chunks:type_name -> mirai.v1.SMEKnowledgeChunk
-	8,  // 42: mirai.v1.SearchKnowledgeResponse.chunks:type_name -> mirai.v1.SMEKnowledgeChunk
-	7,  // 43: mirai.v1.GetSubmissionResponse.submission:type_name -> mirai.v1.SMETaskSubmission
-	7,  // 44: mirai.v1.ApproveSubmissionResponse.submission:type_name -> mirai.v1.SMETaskSubmission
-	8,  // 45: mirai.v1.ApproveSubmissionResponse.created_chunks:type_name -> mirai.v1.SMEKnowledgeChunk
-	7,  // 46: mirai.v1.RequestSubmissionChangesResponse.submission:type_name -> mirai.v1.SMETaskSubmission
-	3,  // 47: mirai.v1.EnhanceSubmissionContentRequest.enhance_type:type_name -> mirai.v1.EnhanceType
-	8,  // 48: mirai.v1.UpdateKnowledgeChunkResponse.chunk:type_name -> mirai.v1.SMEKnowledgeChunk
-	9,  // 49: mirai.v1.SMEService.CreateSME:input_type -> mirai.v1.CreateSMERequest
-	11, // 50: mirai.v1.SMEService.GetSME:input_type -> mirai.v1.GetSMERequest
-	13, // 51: mirai.v1.SMEService.ListSMEs:input_type -> mirai.v1.ListSMEsRequest
-	15, // 52: mirai.v1.SMEService.UpdateSME:input_type -> mirai.v1.UpdateSMERequest
-	17, // 53: mirai.v1.SMEService.DeleteSME:input_type -> mirai.v1.DeleteSMERequest
-	19, // 54: mirai.v1.SMEService.RestoreSME:input_type -> mirai.v1.RestoreSMERequest
-	21, // 55: mirai.v1.SMEService.CreateTask:input_type -> mirai.v1.CreateTaskRequest
-	23, // 56: mirai.v1.SMEService.GetTask:input_type -> mirai.v1.GetTaskRequest
-	25, // 57: mirai.v1.SMEService.ListTasks:input_type -> mirai.v1.ListTasksRequest
-	27, // 58: mirai.v1.SMEService.UpdateTask:input_type -> mirai.v1.UpdateTaskRequest
-	29, // 59: mirai.v1.SMEService.CancelTask:input_type -> mirai.v1.CancelTaskRequest
-	31, // 60: mirai.v1.SMEService.GetUploadURL:input_type -> mirai.v1.GetUploadURLRequest
-	33, // 61: mirai.v1.SMEService.SubmitContent:input_type -> mirai.v1.SubmitContentRequest
-	35, // 62: mirai.v1.SMEService.ListSubmissions:input_type -> mirai.v1.ListSubmissionsRequest
-	37, // 63: mirai.v1.SMEService.GetKnowledge:input_type -> mirai.v1.GetKnowledgeRequest
-	39, // 64: mirai.v1.SMEService.SearchKnowledge:input_type -> mirai.v1.SearchKnowledgeRequest
-	41, // 65: mirai.v1.SMEService.GetSubmission:input_type -> mirai.v1.GetSubmissionRequest
-	43, // 66: mirai.v1.SMEService.ApproveSubmission:input_type -> mirai.v1.ApproveSubmissionRequest
-	45, // 67: mirai.v1.SMEService.RequestSubmissionChanges:input_type -> mirai.v1.RequestSubmissionChangesRequest
-	47, // 68: mirai.v1.SMEService.EnhanceSubmissionContent:input_type -> mirai.v1.EnhanceSubmissionContentRequest
-	49, // 69: mirai.v1.SMEService.UpdateKnowledgeChunk:input_type -> mirai.v1.UpdateKnowledgeChunkRequest
-	51, // 70: mirai.v1.SMEService.DeleteKnowledgeChunk:input_type -> mirai.v1.DeleteKnowledgeChunkRequest
-	53, // 71: mirai.v1.SMEService.DeleteTask:input_type -> mirai.v1.DeleteTaskRequest
-	10, // 72: mirai.v1.SMEService.CreateSME:output_type -> mirai.v1.CreateSMEResponse
-	12, // 73: mirai.v1.SMEService.GetSME:output_type -> mirai.v1.GetSMEResponse
-	14, // 74: mirai.v1.SMEService.ListSMEs:output_type -> mirai.v1.ListSMEsResponse
-	16, // 75: mirai.v1.SMEService.UpdateSME:output_type -> mirai.v1.UpdateSMEResponse
-	18, // 76: mirai.v1.SMEService.DeleteSME:output_type -> mirai.v1.DeleteSMEResponse
-	20, // 77: mirai.v1.SMEService.RestoreSME:output_type -> mirai.v1.RestoreSMEResponse
-	22, // 78: mirai.v1.SMEService.CreateTask:output_type -> mirai.v1.CreateTaskResponse
-	24, // 79: mirai.v1.SMEService.GetTask:output_type -> mirai.v1.GetTaskResponse
-	26, // 80: mirai.v1.SMEService.ListTasks:output_type -> mirai.v1.ListTasksResponse
-	28, // 81: mirai.v1.SMEService.UpdateTask:output_type -> mirai.v1.UpdateTaskResponse
-	30, // 82: mirai.v1.SMEService.CancelTask:output_type -> mirai.v1.CancelTaskResponse
-	32, // 83: mirai.v1.SMEService.GetUploadURL:output_type -> mirai.v1.GetUploadURLResponse
-	34, // 84: mirai.v1.SMEService.SubmitContent:output_type -> mirai.v1.SubmitContentResponse
-	36, // 85: mirai.v1.SMEService.ListSubmissions:output_type -> mirai.v1.ListSubmissionsResponse
-	38, // 86: mirai.v1.SMEService.GetKnowledge:output_type -> mirai.v1.GetKnowledgeResponse
-	40, // 87: mirai.v1.SMEService.SearchKnowledge:output_type -> mirai.v1.SearchKnowledgeResponse
-	42, // 88: mirai.v1.SMEService.GetSubmission:output_type -> mirai.v1.GetSubmissionResponse
-	44, // 89: mirai.v1.SMEService.ApproveSubmission:output_type -> mirai.v1.ApproveSubmissionResponse
-	46, // 90: mirai.v1.SMEService.RequestSubmissionChanges:output_type -> mirai.v1.RequestSubmissionChangesResponse
-	48, // 91: mirai.v1.SMEService.EnhanceSubmissionContent:output_type -> mirai.v1.EnhanceSubmissionContentResponse
-	50, // 92: mirai.v1.SMEService.UpdateKnowledgeChunk:output_type -> mirai.v1.UpdateKnowledgeChunkResponse
-	52, // 93: mirai.v1.SMEService.DeleteKnowledgeChunk:output_type -> mirai.v1.DeleteKnowledgeChunkResponse
-	54, // 94: mirai.v1.SMEService.DeleteTask:output_type -> mirai.v1.DeleteTaskResponse
-	72, // [72:95] is the sub-list for method output_type
-	49, // [49:72] is the sub-list for method input_type
-	49, // [49:49] is the sub-list for extension type_name
-	49, // [49:49] is the sub-list for extension extendee
-	0,  // [0:49] is the sub-list for field type_name
+	0,   // 0: mirai.v1.SubjectMatterExpert.scope:type_name -> mirai.v1.SMEScope
+	1,   // 1: mirai.v1.SubjectMatterExpert.status:type_name -> mirai.v1.SMEStatus
+	95,  // 2: mirai.v1.SubjectMatterExpert.created_at:type_name -> google.protobuf.Timestamp
+	95,  // 3: mirai.v1.SubjectMatterExpert.updated_at:type_name -> google.protobuf.Timestamp
+	5,   // 4: mirai.v1.SMETask.expected_content_type:type_name -> mirai.v1.ContentType
+	2,   // 5: mirai.v1.SMETask.status:type_name -> mirai.v1.SMETaskStatus
+	95,  // 6: mirai.v1.SMETask.due_date:type_name -> google.protobuf.Timestamp
+	95,  // 7: mirai.v1.SMETask.created_at:type_name -> google.protobuf.Timestamp
+	95,  // 8: mirai.v1.SMETask.updated_at:type_name -> google.protobuf.Timestamp
+	95,  // 9: mirai.v1.SMETask.completed_at:type_name -> google.protobuf.Timestamp
+	5,   // 10: mirai.v1.SMETaskSubmission.content_type:type_name -> mirai.v1.ContentType
+	95,  // 11: mirai.v1.SMETaskSubmission.submitted_at:type_name -> google.protobuf.Timestamp
+	95,  // 12: mirai.v1.SMETaskSubmission.processed_at:type_name -> google.protobuf.Timestamp
+	95,  // 13: mirai.v1.SMETaskSubmission.approved_at:type_name -> google.protobuf.Timestamp
+	3,   // 14: mirai.v1.SMETaskSubmission.status:type_name -> mirai.v1.SubmissionStatus
+	95,  // 15: mirai.v1.SMEKnowledgeChunk.created_at:type_name -> google.protobuf.Timestamp
+	95,  // 16: mirai.v1.SMEKnowledgeChunk.injection_released_at:type_name -> google.protobuf.Timestamp
+	2,   // 17: mirai.v1.SMETaskStatusCount.status:type_name -> mirai.v1.SMETaskStatus
+	3,   // 18: mirai.v1.SubmissionStatusCount.status:type_name -> mirai.v1.SubmissionStatus
+	12,  // 19: mirai.v1.SubmissionSummary.status_counts:type_name -> mirai.v1.SubmissionStatusCount
+	95,  // 20: mirai.v1.SubmissionSummary.latest_submitted_at:type_name -> google.protobuf.Timestamp
+	1,   // 21: mirai.v1.SMEStats.sme_status:type_name -> mirai.v1.SMEStatus
+	11,  // 22: mirai.v1.SMEStats.task_counts:type_name -> mirai.v1.SMETaskStatusCount
+	95,  // 23: mirai.v1.SMEStats.last_ingested_at:type_name -> google.protobuf.Timestamp
+	0,   // 24: mirai.v1.CreateSMERequest.scope:type_name -> mirai.v1.SMEScope
+	7,   // 25: mirai.v1.CreateSMEResponse.sme:type_name -> mirai.v1.SubjectMatterExpert
+	7,   // 26: mirai.v1.GetSMEResponse.sme:type_name -> mirai.v1.SubjectMatterExpert
+	0,   // 27: mirai.v1.ListSMEsRequest.scope:type_name -> mirai.v1.SMEScope
+	1,   // 28: mirai.v1.ListSMEsRequest.status:type_name -> mirai.v1.SMEStatus
+	7,   // 29: mirai.v1.ListSMEsResponse.smes:type_name -> mirai.v1.SubjectMatterExpert
+	0,   // 30: mirai.v1.UpdateSMERequest.scope:type_name -> mirai.v1.SMEScope
+	1,   // 31: mirai.v1.UpdateSMERequest.status:type_name -> mirai.v1.SMEStatus
+	7,   // 32: mirai.v1.UpdateSMEResponse.sme:type_name -> mirai.v1.SubjectMatterExpert
+	7,   // 33: mirai.v1.RestoreSMEResponse.sme:type_name -> mirai.v1.SubjectMatterExpert
+	5,   // 34: mirai.v1.CreateTaskRequest.expected_content_type:type_name -> mirai.v1.ContentType
+	95,  // 35: mirai.v1.CreateTaskRequest.due_date:type_name -> google.protobuf.Timestamp
+	8,   // 36: mirai.v1.CreateTaskResponse.task:type_name -> mirai.v1.SMETask
+	8,   // 37: mirai.v1.GetTaskResponse.task:type_name -> mirai.v1.SMETask
+	13,  // 38: mirai.v1.GetTaskResponse.submission_summary:type_name -> mirai.v1.SubmissionSummary
+	2,   // 39: mirai.v1.ListTasksRequest.status:type_name -> mirai.v1.SMETaskStatus
+	8,   // 40: mirai.v1.ListTasksResponse.tasks:type_name -> mirai.v1.SMETask
+	5,   // 41: mirai.v1.UpdateTaskRequest.expected_content_type:type_name -> mirai.v1.ContentType
+	95,  // 42: mirai.v1.UpdateTaskRequest.due_date:type_name -> google.protobuf.Timestamp
+	8,   // 43: mirai.v1.UpdateTaskResponse.task:type_name -> mirai.v1.SMETask
+	8,   // 44: mirai.v1.CancelTaskResponse.task:type_name -> mirai.v1.SMETask
+	5,   // 45: mirai.v1.GetUploadURLRequest.content_type:type_name -> mirai.v1.ContentType
+	95,  // 46: mirai.v1.GetUploadURLResponse.expires_at:type_name -> google.protobuf.Timestamp
+	42,  // 47: mirai.v1.GetPartUploadURLsResponse.parts:type_name -> mirai.v1.PartUploadURL
+	95,  // 48: mirai.v1.GetPartUploadURLsResponse.expires_at:type_name -> google.protobuf.Timestamp
+	5,   // 49: mirai.v1.SubmitContentRequest.content_type:type_name -> mirai.v1.ContentType
+	9,   // 50: mirai.v1.SubmitContentResponse.submission:type_name -> mirai.v1.SMETaskSubmission
+	3,   // 51: mirai.v1.ListSubmissionsRequest.status:type_name -> mirai.v1.SubmissionStatus
+	9,   // 52: mirai.v1.ListSubmissionsResponse.submissions:type_name -> mirai.v1.SMETaskSubmission
+	7,   // 53: mirai.v1.GetKnowledgeResponse.sme:type_name -> mirai.v1.SubjectMatterExpert
+	10,  // 54: mirai.v1.GetKnowledgeResponse.chunks:type_name -> mirai.v1.SMEKnowledgeChunk
+	53,  // 55: mirai.v1.ListKnowledgeTopicsResponse.topics:type_name -> mirai.v1.KnowledgeTopic
+	10,  // 56: mirai.v1.SearchKnowledgeResponse.chunks:type_name -> mirai.v1.SMEKnowledgeChunk
+	9,   // 57: mirai.v1.GetSubmissionResponse.submission:type_name -> mirai.v1.SMETaskSubmission
+	9,   // 58: mirai.v1.ReprocessSubmissionResponse.submission:type_name -> mirai.v1.SMETaskSubmission
+	9,   // 59: mirai.v1.ApproveSubmissionResponse.submission:type_name -> mirai.v1.SMETaskSubmission
+	10,  // 60: mirai.v1.ApproveSubmissionResponse.created_chunks:type_name -> mirai.v1.SMEKnowledgeChunk
+	9,   // 61: mirai.v1.RequestSubmissionChangesResponse.submission:type_name -> mirai.v1.SMETaskSubmission
+	4,   // 62: mirai.v1.EnhanceSubmissionContentRequest.enhance_type:type_name -> mirai.v1.EnhanceType
+	10,  // 63: mirai.v1.UpdateKnowledgeChunkResponse.chunk:type_name -> mirai.v1.SMEKnowledgeChunk
+	10,  // 64: mirai.v1.ReviewFlaggedKnowledgeChunkResponse.chunk:type_name -> mirai.v1.SMEKnowledgeChunk
+	14,  // 65: mirai.v1.GetSMEStatsResponse.stats:type_name -> mirai.v1.SMEStats
+	7,   // 66: mirai.v1.ImportSMEKnowledgeResponse.sme:type_name -> mirai.v1.SubjectMatterExpert
+	9,   // 67: mirai.v1.SubmitAnswersResponse.submission:type_name -> mirai.v1.SMETaskSubmission
+	95,  // 68: mirai.v1.GetSMEDeletionImpactResponse.expires_at:type_name -> google.protobuf.Timestamp
+	6,   // 69: mirai.v1.SubmissionProgress.status:type_name -> mirai.v1.SubmissionProcessingStatus
+	95,  // 70: mirai.v1.SubmissionProgress.updated_at:type_name -> google.protobuf.Timestamp
+	89,  // 71: mirai.v1.GetSubmissionStatusResponse.progress:type_name -> mirai.v1.SubmissionProgress
+	7,   // 72: mirai.v1.SMESuggestion.sme:type_name -> mirai.v1.SubjectMatterExpert
+	93,  // 73: mirai.v1.SuggestSMEsResponse.suggestions:type_name -> mirai.v1.SMESuggestion
+	15,  // 74: mirai.v1.SMEService.CreateSME:input_type -> mirai.v1.CreateSMERequest
+	17,  // 75: mirai.v1.SMEService.GetSME:input_type -> mirai.v1.GetSMERequest
+	19,  // 76: mirai.v1.SMEService.ListSMEs:input_type -> mirai.v1.ListSMEsRequest
+	21,  // 77: mirai.v1.SMEService.UpdateSME:input_type -> mirai.v1.UpdateSMERequest
+	23,  // 78: mirai.v1.SMEService.DeleteSME:input_type -> mirai.v1.DeleteSMERequest
+	87,  // 79: mirai.v1.SMEService.GetSMEDeletionImpact:input_type -> mirai.v1.GetSMEDeletionImpactRequest
+	25,  // 80: mirai.v1.SMEService.RestoreSME:input_type -> mirai.v1.RestoreSMERequest
+	27,  // 81: mirai.v1.SMEService.CreateTask:input_type -> mirai.v1.CreateTaskRequest
+	29,  // 82: mirai.v1.SMEService.GetTask:input_type -> mirai.v1.GetTaskRequest
+	31,  // 83: mirai.v1.SMEService.ListTasks:input_type -> mirai.v1.ListTasksRequest
+	33,  // 84: mirai.v1.SMEService.UpdateTask:input_type -> mirai.v1.UpdateTaskRequest
+	35,  // 85: mirai.v1.SMEService.CancelTask:input_type -> mirai.v1.CancelTaskRequest
+	37,  // 86: mirai.v1.SMEService.GetUploadURL:input_type -> mirai.v1.GetUploadURLRequest
+	39,  // 87: mirai.v1.SMEService.StartMultipartUpload:input_type -> mirai.v1.StartMultipartUploadRequest
+	41,  // 88: mirai.v1.SMEService.GetPartUploadURLs:input_type -> mirai.v1.GetPartUploadURLsRequest
+	44,  // 89: mirai.v1.SMEService.CompleteMultipartUpload:input_type -> mirai.v1.CompleteMultipartUploadRequest
+	46,  // 90: mirai.v1.SMEService.SubmitContent:input_type -> mirai.v1.SubmitContentRequest
+	83,  // 91: mirai.v1.SMEService.GenerateTaskQuestions:input_type -> mirai.v1.GenerateTaskQuestionsRequest
+	85,  // 92: mirai.v1.SMEService.SubmitAnswers:input_type -> mirai.v1.SubmitAnswersRequest
+	48,  // 93: mirai.v1.SMEService.ListSubmissions:input_type -> mirai.v1.ListSubmissionsRequest
+	50,  // 94: mirai.v1.SMEService.GetKnowledge:input_type -> mirai.v1.GetKnowledgeRequest
+	52,  // 95: mirai.v1.SMEService.ListKnowledgeTopics:input_type -> mirai.v1.ListKnowledgeTopicsRequest
+	55,  // 96: mirai.v1.SMEService.SearchKnowledge:input_type -> mirai.v1.SearchKnowledgeRequest
+	92,  // 97: mirai.v1.SMEService.SuggestSMEs:input_type -> mirai.v1.SuggestSMEsRequest
+	57,  // 98: mirai.v1.SMEService.GetSubmission:input_type -> mirai.v1.GetSubmissionRequest
+	61,  // 99: mirai.v1.SMEService.ApproveSubmission:input_type -> mirai.v1.ApproveSubmissionRequest
+	63,  // 100: mirai.v1.SMEService.RequestSubmissionChanges:input_type -> mirai.v1.RequestSubmissionChangesRequest
+	65,  // 101: mirai.v1.SMEService.EnhanceSubmissionContent:input_type -> mirai.v1.EnhanceSubmissionContentRequest
+	59,  // 102: mirai.v1.SMEService.ReprocessSubmission:input_type -> mirai.v1.ReprocessSubmissionRequest
+	90,  // 103: mirai.v1.SMEService.GetSubmissionStatus:input_type -> mirai.v1.GetSubmissionStatusRequest
+	67,  // 104: mirai.v1.SMEService.UpdateKnowledgeChunk:input_type -> mirai.v1.UpdateKnowledgeChunkRequest
+	69,  // 105: mirai.v1.SMEService.DeleteKnowledgeChunk:input_type -> mirai.v1.DeleteKnowledgeChunkRequest
+	71,  // 106: mirai.v1.SMEService.ReviewFlaggedKnowledgeChunk:input_type -> mirai.v1.ReviewFlaggedKnowledgeChunkRequest
+	73,  // 107: mirai.v1.SMEService.DeleteTask:input_type -> mirai.v1.DeleteTaskRequest
+	75,  // 108: mirai.v1.SMEService.GetSMEStats:input_type -> mirai.v1.GetSMEStatsRequest
+	77,  // 109: mirai.v1.SMEService.ExportSMEKnowledge:input_type -> mirai.v1.ExportSMEKnowledgeRequest
+	79,  // 110: mirai.v1.SMEService.GetKnowledgeImportUploadURL:input_type -> mirai.v1.GetKnowledgeImportUploadURLRequest
+	81,  // 111: mirai.v1.SMEService.ImportSMEKnowledge:input_type -> mirai.v1.ImportSMEKnowledgeRequest
+	16,  // 112: mirai.v1.SMEService.CreateSME:output_type -> mirai.v1.CreateSMEResponse
+	18,  // 113: mirai.v1.SMEService.GetSME:output_type -> mirai.v1.GetSMEResponse
+	20,  // 114: mirai.v1.SMEService.ListSMEs:output_type -> mirai.v1.ListSMEsResponse
+	22,  // 115: mirai.v1.SMEService.UpdateSME:output_type -> mirai.v1.UpdateSMEResponse
+	24,  // 116: mirai.v1.SMEService.DeleteSME:output_type -> mirai.v1.DeleteSMEResponse
+	88,  // 117: mirai.v1.SMEService.GetSMEDeletionImpact:output_type -> mirai.v1.GetSMEDeletionImpactResponse
+	26,  // 118: mirai.v1.SMEService.RestoreSME:output_type -> mirai.v1.RestoreSMEResponse
+	28,  // 119: mirai.v1.SMEService.CreateTask:output_type -> mirai.v1.CreateTaskResponse
+	30,  // 120: mirai.v1.SMEService.GetTask:output_type -> mirai.v1.GetTaskResponse
+	32,  // 121: mirai.v1.SMEService.ListTasks:output_type -> mirai.v1.ListTasksResponse
+	34,  // 122: mirai.v1.SMEService.UpdateTask:output_type -> mirai.v1.UpdateTaskResponse
+	36,  // 123: mirai.v1.SMEService.CancelTask:output_type -> mirai.v1.CancelTaskResponse
+	38,  // 124: mirai.v1.SMEService.GetUploadURL:output_type -> mirai.v1.GetUploadURLResponse
+	40,  // 125: mirai.v1.SMEService.StartMultipartUpload:output_type -> mirai.v1.StartMultipartUploadResponse
+	43,  // 126: mirai.v1.SMEService.GetPartUploadURLs:output_type -> mirai.v1.GetPartUploadURLsResponse
+	45,  // 127: mirai.v1.SMEService.CompleteMultipartUpload:output_type -> mirai.v1.CompleteMultipartUploadResponse
+	47,  // 128: mirai.v1.SMEService.SubmitContent:output_type -> mirai.v1.SubmitContentResponse
+	84,  // 129: mirai.v1.SMEService.GenerateTaskQuestions:output_type -> mirai.v1.GenerateTaskQuestionsResponse
+	86,  // 130: mirai.v1.SMEService.SubmitAnswers:output_type -> mirai.v1.SubmitAnswersResponse
+	49,  // 131: mirai.v1.SMEService.ListSubmissions:output_type -> mirai.v1.ListSubmissionsResponse
+	51,  // 132: mirai.v1.SMEService.GetKnowledge:output_type -> mirai.v1.GetKnowledgeResponse
+	54,  // 133: mirai.v1.SMEService.ListKnowledgeTopics:output_type -> mirai.v1.ListKnowledgeTopicsResponse
+	56,  // 134: mirai.v1.SMEService.SearchKnowledge:output_type -> mirai.v1.SearchKnowledgeResponse
+	94,  // 135: mirai.v1.SMEService.SuggestSMEs:output_type -> mirai.v1.SuggestSMEsResponse
+	58,  // 136: mirai.v1.SMEService.GetSubmission:output_type -> mirai.v1.GetSubmissionResponse
+	62,  // 137: mirai.v1.SMEService.ApproveSubmission:output_type -> mirai.v1.ApproveSubmissionResponse
+	64,  // 138: mirai.v1.SMEService.RequestSubmissionChanges:output_type -> mirai.v1.RequestSubmissionChangesResponse
+	66,  // 139: mirai.v1.SMEService.EnhanceSubmissionContent:output_type -> mirai.v1.EnhanceSubmissionContentResponse
+	60,  // 140: mirai.v1.SMEService.ReprocessSubmission:output_type -> mirai.v1.ReprocessSubmissionResponse
+	91,  // 141: mirai.v1.SMEService.GetSubmissionStatus:output_type -> mirai.v1.GetSubmissionStatusResponse
+	68,  // 142: mirai.v1.SMEService.UpdateKnowledgeChunk:output_type -> mirai.v1.UpdateKnowledgeChunkResponse
+	70,  // 143: mirai.v1.SMEService.DeleteKnowledgeChunk:output_type -> mirai.v1.DeleteKnowledgeChunkResponse
+	72,  // 144: mirai.v1.SMEService.ReviewFlaggedKnowledgeChunk:output_type -> mirai.v1.ReviewFlaggedKnowledgeChunkResponse
+	74,  // 145: mirai.v1.SMEService.DeleteTask:output_type -> mirai.v1.DeleteTaskResponse
+	76,  // 146: mirai.v1.SMEService.GetSMEStats:output_type -> mirai.v1.GetSMEStatsResponse
+	78,  // 147: mirai.v1.SMEService.ExportSMEKnowledge:output_type -> mirai.v1.ExportSMEKnowledgeResponse
+	80,  // 148: mirai.v1.SMEService.GetKnowledgeImportUploadURL:output_type -> mirai.v1.GetKnowledgeImportUploadURLResponse
+	82,  // 149: mirai.v1.SMEService.ImportSMEKnowledge:output_type -> mirai.v1.ImportSMEKnowledgeResponse
+	112, // [112:150] is the sub-list for method output_type
+	74,  // [74:112] is the sub-list for method input_type
+	74,  // [74:74] is the sub-list for extension type_name
+	74,  // [74:74] is the sub-list for extension extendee
+	0,   // [0:74] is the sub-list for field type_name
 }
 
 func init() { file_mirai_v1_sme_proto_init() }
@@ -3812,20 +6486,28 @@ func file_mirai_v1_sme_proto_init() {
 	file_mirai_v1_sme_proto_msgTypes[1].OneofWrappers = []any{}
 	file_mirai_v1_sme_proto_msgTypes[2].OneofWrappers = []any{}
 	file_mirai_v1_sme_proto_msgTypes[3].OneofWrappers = []any{}
-	file_mirai_v1_sme_proto_msgTypes[8].OneofWrappers = []any{}
-	file_mirai_v1_sme_proto_msgTypes[10].OneofWrappers = []any{}
-	file_mirai_v1_sme_proto_msgTypes[16].OneofWrappers = []any{}
+	file_mirai_v1_sme_proto_msgTypes[6].OneofWrappers = []any{}
+	file_mirai_v1_sme_proto_msgTypes[7].OneofWrappers = []any{}
+	file_mirai_v1_sme_proto_msgTypes[12].OneofWrappers = []any{}
+	file_mirai_v1_sme_proto_msgTypes[14].OneofWrappers = []any{}
 	file_mirai_v1_sme_proto_msgTypes[20].OneofWrappers = []any{}
-	file_mirai_v1_sme_proto_msgTypes[22].OneofWrappers = []any{}
-	file_mirai_v1_sme_proto_msgTypes[28].OneofWrappers = []any{}
-	file_mirai_v1_sme_proto_msgTypes[44].OneofWrappers = []any{}
+	file_mirai_v1_sme_proto_msgTypes[24].OneofWrappers = []any{}
+	file_mirai_v1_sme_proto_msgTypes[26].OneofWrappers = []any{}
+	file_mirai_v1_sme_proto_msgTypes[39].OneofWrappers = []any{}
+	file_mirai_v1_sme_proto_msgTypes[41].OneofWrappers = []any{}
+	file_mirai_v1_sme_proto_msgTypes[42].OneofWrappers = []any{}
+	file_mirai_v1_sme_proto_msgTypes[43].OneofWrappers = []any{}
+	file_mirai_v1_sme_proto_msgTypes[52].OneofWrappers = []any{}
+	file_mirai_v1_sme_proto_msgTypes[60].OneofWrappers = []any{}
+	file_mirai_v1_sme_proto_msgTypes[74].OneofWrappers = []any{}
+	file_mirai_v1_sme_proto_msgTypes[82].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_mirai_v1_sme_proto_rawDesc), len(file_mirai_v1_sme_proto_rawDesc)),
-			NumEnums:      5,
-			NumMessages:   50,
+			NumEnums:      7,
+			NumMessages:   88,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -1076,6 +1076,104 @@ func (x *RestoreTemplateResponse) GetTemplate() *TargetAudienceTemplate {
 	return nil
 }
 
+// GenerateTargetAudienceRequest contains the job to draft an audience for.
+type GenerateTargetAudienceRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	JobDescription string                 `protobuf:"bytes,1,opt,name=job_description,json=jobDescription,proto3" json:"job_description,omitempty"` // Full job description or just a role title
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GenerateTargetAudienceRequest) Reset() {
+	*x = GenerateTargetAudienceRequest{}
+	mi := &file_mirai_v1_target_audience_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateTargetAudienceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateTargetAudienceRequest) ProtoMessage() {}
+
+func (x *GenerateTargetAudienceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_target_audience_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateTargetAudienceRequest.ProtoReflect.Descriptor instead.
+func (*GenerateTargetAudienceRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_target_audience_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GenerateTargetAudienceRequest) GetJobDescription() string {
+	if x != nil {
+		return x.JobDescription
+	}
+	return ""
+}
+
+// GenerateTargetAudienceResponse contains the unsaved draft.
+type GenerateTargetAudienceResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Draft         *TargetAudienceTemplate `protobuf:"bytes,1,opt,name=draft,proto3" json:"draft,omitempty"` // id, timestamps and created_by_user_id are empty
+	TokensUsed    int64                   `protobuf:"varint,2,opt,name=tokens_used,json=tokensUsed,proto3" json:"tokens_used,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateTargetAudienceResponse) Reset() {
+	*x = GenerateTargetAudienceResponse{}
+	mi := &file_mirai_v1_target_audience_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateTargetAudienceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateTargetAudienceResponse) ProtoMessage() {}
+
+func (x *GenerateTargetAudienceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_target_audience_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateTargetAudienceResponse.ProtoReflect.Descriptor instead.
+func (*GenerateTargetAudienceResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_target_audience_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GenerateTargetAudienceResponse) GetDraft() *TargetAudienceTemplate {
+	if x != nil {
+		return x.Draft
+	}
+	return nil
+}
+
+func (x *GenerateTargetAudienceResponse) GetTokensUsed() int64 {
+	if x != nil {
+		return x.TokensUsed
+	}
+	return 0
+}
+
 var File_mirai_v1_target_audience_proto protoreflect.FileDescriptor
 
 const file_mirai_v1_target_audience_proto_rawDesc = "" +
@@ -1172,7 +1270,13 @@ const file_mirai_v1_target_audience_proto_rawDesc = "" +
 	"\vtemplate_id\x18\x01 \x01(\tR\n" +
 	"templateId\"W\n" +
 	"\x17RestoreTemplateResponse\x12<\n" +
-	"\btemplate\x18\x01 \x01(\v2 .mirai.v1.TargetAudienceTemplateR\btemplate*\xb1\x01\n" +
+	"\btemplate\x18\x01 \x01(\v2 .mirai.v1.TargetAudienceTemplateR\btemplate\"H\n" +
+	"\x1dGenerateTargetAudienceRequest\x12'\n" +
+	"\x0fjob_description\x18\x01 \x01(\tR\x0ejobDescription\"y\n" +
+	"\x1eGenerateTargetAudienceResponse\x126\n" +
+	"\x05draft\x18\x01 \x01(\v2 .mirai.v1.TargetAudienceTemplateR\x05draft\x12\x1f\n" +
+	"\vtokens_used\x18\x02 \x01(\x03R\n" +
+	"tokensUsed*\xb1\x01\n" +
 	"\x0fExperienceLevel\x12 \n" +
 	"\x1cEXPERIENCE_LEVEL_UNSPECIFIED\x10\x00\x12\x1d\n" +
 	"\x19EXPERIENCE_LEVEL_BEGINNER\x10\x01\x12!\n" +
@@ -1182,7 +1286,7 @@ const file_mirai_v1_target_audience_proto_rawDesc = "" +
 	"\x14TargetAudienceStatus\x12&\n" +
 	"\"TARGET_AUDIENCE_STATUS_UNSPECIFIED\x10\x00\x12!\n" +
 	"\x1dTARGET_AUDIENCE_STATUS_ACTIVE\x10\x01\x12#\n" +
-	"\x1fTARGET_AUDIENCE_STATUS_ARCHIVED\x10\x022\xe4\x04\n" +
+	"\x1fTARGET_AUDIENCE_STATUS_ARCHIVED\x10\x022\xd1\x05\n" +
 	"\x15TargetAudienceService\x12S\n" +
 	"\x0eCreateTemplate\x12\x1f.mirai.v1.CreateTemplateRequest\x1a .mirai.v1.CreateTemplateResponse\x12J\n" +
 	"\vGetTemplate\x12\x1c.mirai.v1.GetTemplateRequest\x1a\x1d.mirai.v1.GetTemplateResponse\x12P\n" +
@@ -1190,7 +1294,8 @@ const file_mirai_v1_target_audience_proto_rawDesc = "" +
 	"\x0eUpdateTemplate\x12\x1f.mirai.v1.UpdateTemplateRequest\x1a .mirai.v1.UpdateTemplateResponse\x12S\n" +
 	"\x0eDeleteTemplate\x12\x1f.mirai.v1.DeleteTemplateRequest\x1a .mirai.v1.DeleteTemplateResponse\x12V\n" +
 	"\x0fArchiveTemplate\x12 .mirai.v1.ArchiveTemplateRequest\x1a!.mirai.v1.ArchiveTemplateResponse\x12V\n" +
-	"\x0fRestoreTemplate\x12 .mirai.v1.RestoreTemplateRequest\x1a!.mirai.v1.RestoreTemplateResponseB\x99\x01\n" +
+	"\x0fRestoreTemplate\x12 .mirai.v1.RestoreTemplateRequest\x1a!.mirai.v1.RestoreTemplateResponse\x12k\n" +
+	"\x16GenerateTargetAudience\x12'.mirai.v1.GenerateTargetAudienceRequest\x1a(.mirai.v1.GenerateTargetAudienceResponseB\x99\x01\n" +
 	"\fcom.mirai.v1B\x13TargetAudienceProtoP\x01Z3github.com/sogos/mirai-backend/gen/mirai/v1;miraiv1\xa2\x02\x03MXX\xaa\x02\bMirai.V1\xca\x02\bMirai\\V1\xe2\x02\x14Mirai\\V1\\GPBMetadata\xea\x02\tMirai::V1b\x06proto3"
 
 var (
@@ -1206,31 +1311,33 @@ func file_mirai_v1_target_audience_proto_rawDescGZIP() []byte {
 }
 
 var file_mirai_v1_target_audience_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_mirai_v1_target_audience_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_mirai_v1_target_audience_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_mirai_v1_target_audience_proto_goTypes = []any{
-	(ExperienceLevel)(0),            // 0: mirai.v1.ExperienceLevel
-	(TargetAudienceStatus)(0),       // 1: mirai.v1.TargetAudienceStatus
-	(*TargetAudienceTemplate)(nil),  // 2: mirai.v1.TargetAudienceTemplate
-	(*CreateTemplateRequest)(nil),   // 3: mirai.v1.CreateTemplateRequest
-	(*CreateTemplateResponse)(nil),  // 4: mirai.v1.CreateTemplateResponse
-	(*GetTemplateRequest)(nil),      // 5: mirai.v1.GetTemplateRequest
-	(*GetTemplateResponse)(nil),     // 6: mirai.v1.GetTemplateResponse
-	(*ListTemplatesRequest)(nil),    // 7: mirai.v1.ListTemplatesRequest
-	(*ListTemplatesResponse)(nil),   // 8: mirai.v1.ListTemplatesResponse
-	(*UpdateTemplateRequest)(nil),   // 9: mirai.v1.UpdateTemplateRequest
-	(*UpdateTemplateResponse)(nil),  // 10: mirai.v1.UpdateTemplateResponse
-	(*DeleteTemplateRequest)(nil),   // 11: mirai.v1.DeleteTemplateRequest
-	(*DeleteTemplateResponse)(nil),  // 12: mirai.v1.DeleteTemplateResponse
-	(*ArchiveTemplateRequest)(nil),  // 13: mirai.v1.ArchiveTemplateRequest
-	(*ArchiveTemplateResponse)(nil), // 14: mirai.v1.ArchiveTemplateResponse
-	(*RestoreTemplateRequest)(nil),  // 15: mirai.v1.RestoreTemplateRequest
-	(*RestoreTemplateResponse)(nil), // 16: mirai.v1.RestoreTemplateResponse
-	(*timestamppb.Timestamp)(nil),   // 17: google.protobuf.Timestamp
+	(ExperienceLevel)(0),                   // 0: mirai.v1.ExperienceLevel
+	(TargetAudienceStatus)(0),              // 1: mirai.v1.TargetAudienceStatus
+	(*TargetAudienceTemplate)(nil),         // 2: mirai.v1.TargetAudienceTemplate
+	(*CreateTemplateRequest)(nil),          // 3: mirai.v1.CreateTemplateRequest
+	(*CreateTemplateResponse)(nil),         // 4: mirai.v1.CreateTemplateResponse
+	(*GetTemplateRequest)(nil),             // 5: mirai.v1.GetTemplateRequest
+	(*GetTemplateResponse)(nil),            // 6: mirai.v1.GetTemplateResponse
+	(*ListTemplatesRequest)(nil),           // 7: mirai.v1.ListTemplatesRequest
+	(*ListTemplatesResponse)(nil),          // 8: mirai.v1.ListTemplatesResponse
+	(*UpdateTemplateRequest)(nil),          // 9: mirai.v1.UpdateTemplateRequest
+	(*UpdateTemplateResponse)(nil),         // 10: mirai.v1.UpdateTemplateResponse
+	(*DeleteTemplateRequest)(nil),          // 11: mirai.v1.DeleteTemplateRequest
+	(*DeleteTemplateResponse)(nil),         // 12: mirai.v1.DeleteTemplateResponse
+	(*ArchiveTemplateRequest)(nil),         // 13: mirai.v1.ArchiveTemplateRequest
+	(*ArchiveTemplateResponse)(nil),        // 14: mirai.v1.ArchiveTemplateResponse
+	(*RestoreTemplateRequest)(nil),         // 15: mirai.v1.RestoreTemplateRequest
+	(*RestoreTemplateResponse)(nil),        // 16: mirai.v1.RestoreTemplateResponse
+	(*GenerateTargetAudienceRequest)(nil),  // 17: mirai.v1.GenerateTargetAudienceRequest
+	(*GenerateTargetAudienceResponse)(nil), // 18: mirai.v1.GenerateTargetAudienceResponse
+	(*timestamppb.Timestamp)(nil),          // 19: google.protobuf.Timestamp
 }
 var file_mirai_v1_target_audience_proto_depIdxs = []int32{
 	0,  // 0: mirai.v1.TargetAudienceTemplate.experience_level:type_name -> mirai.v1.ExperienceLevel
-	17, // 1: mirai.v1.TargetAudienceTemplate.created_at:type_name -> google.protobuf.Timestamp
-	17, // 2: mirai.v1.TargetAudienceTemplate.updated_at:type_name -> google.protobuf.Timestamp
+	19, // 1: mirai.v1.TargetAudienceTemplate.created_at:type_name -> google.protobuf.Timestamp
+	19, // 2: mirai.v1.TargetAudienceTemplate.updated_at:type_name -> google.protobuf.Timestamp
 	1,  // 3: mirai.v1.TargetAudienceTemplate.status:type_name -> mirai.v1.TargetAudienceStatus
 	0,  // 4: mirai.v1.CreateTemplateRequest.experience_level:type_name -> mirai.v1.ExperienceLevel
 	2,  // 5: mirai.v1.CreateTemplateResponse.template:type_name -> mirai.v1.TargetAudienceTemplate
@@ -1240,25 +1347,28 @@ var file_mirai_v1_target_audience_proto_depIdxs = []int32{
 	2,  // 9: mirai.v1.UpdateTemplateResponse.template:type_name -> mirai.v1.TargetAudienceTemplate
 	2,  // 10: mirai.v1.ArchiveTemplateResponse.template:type_name -> mirai.v1.TargetAudienceTemplate
 	2,  // 11: mirai.v1.RestoreTemplateResponse.template:type_name -> mirai.v1.TargetAudienceTemplate
-	3,  // 12: mirai.v1.TargetAudienceService.CreateTemplate:input_type -> mirai.v1.CreateTemplateRequest
-	5,  // 13: mirai.v1.TargetAudienceService.GetTemplate:input_type -> mirai.v1.GetTemplateRequest
-	7,  // 14: mirai.v1.TargetAudienceService.ListTemplates:input_type -> mirai.v1.ListTemplatesRequest
-	9,  // 15: mirai.v1.TargetAudienceService.UpdateTemplate:input_type -> mirai.v1.UpdateTemplateRequest
-	11, // 16: mirai.v1.TargetAudienceService.DeleteTemplate:input_type -> mirai.v1.DeleteTemplateRequest
-	13, // 17: mirai.v1.TargetAudienceService.ArchiveTemplate:input_type -> mirai.v1.ArchiveTemplateRequest
-	15, // 18: mirai.v1.TargetAudienceService.RestoreTemplate:input_type -> mirai.v1.RestoreTemplateRequest
-	4,  // 19: mirai.v1.TargetAudienceService.CreateTemplate:output_type -> mirai.v1.CreateTemplateResponse
-	6,  // 20: mirai.v1.TargetAudienceService.GetTemplate:output_type -> mirai.v1.GetTemplateResponse
-	8,  // 21: mirai.v1.TargetAudienceService.ListTemplates:output_type -> mirai.v1.ListTemplatesResponse
-	10, // 22: mirai.v1.TargetAudienceService.UpdateTemplate:output_type -> mirai.v1.UpdateTemplateResponse
-	12, // 23: mirai.v1.TargetAudienceService.DeleteTemplate:output_type -> mirai.v1.DeleteTemplateResponse
-	14, // 24: mirai.v1.TargetAudienceService.ArchiveTemplate:output_type -> mirai.v1.ArchiveTemplateResponse
-	16, // 25: mirai.v1.TargetAudienceService.RestoreTemplate:output_type -> mirai.v1.RestoreTemplateResponse
-	19, // [19:26] is the sub-list for method output_type
-	12, // [12:19] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	2,  // 12: mirai.v1.GenerateTargetAudienceResponse.draft:type_name -> mirai.v1.TargetAudienceTemplate
+	3,  // 13: mirai.v1.TargetAudienceService.CreateTemplate:input_type -> mirai.v1.CreateTemplateRequest
+	5,  // 14: mirai.v1.TargetAudienceService.GetTemplate:input_type -> mirai.v1.GetTemplateRequest
+	7,  // 15: mirai.v1.TargetAudienceService.ListTemplates:input_type -> mirai.v1.ListTemplatesRequest
+	9,  // 16: mirai.v1.TargetAudienceService.UpdateTemplate:input_type -> mirai.v1.UpdateTemplateRequest
+	11, // 17: mirai.v1.TargetAudienceService.DeleteTemplate:input_type -> mirai.v1.DeleteTemplateRequest
+	13, // 18: mirai.v1.TargetAudienceService.ArchiveTemplate:input_type -> mirai.v1.ArchiveTemplateRequest
+	15, // 19: mirai.v1.TargetAudienceService.RestoreTemplate:input_type -> mirai.v1.RestoreTemplateRequest
+	17, // 20: mirai.v1.TargetAudienceService.GenerateTargetAudience:input_type -> mirai.v1.GenerateTargetAudienceRequest
+	4,  // 21: mirai.v1.TargetAudienceService.CreateTemplate:output_type -> mirai.v1.CreateTemplateResponse
+	6,  // 22: mirai.v1.TargetAudienceService.GetTemplate:output_type -> mirai.v1.GetTemplateResponse
+	8,  // 23: mirai.v1.TargetAudienceService.ListTemplates:output_type -> mirai.v1.ListTemplatesResponse
+	10, // 24: mirai.v1.TargetAudienceService.UpdateTemplate:output_type -> mirai.v1.UpdateTemplateResponse
+	12, // 25: mirai.v1.TargetAudienceService.DeleteTemplate:output_type -> mirai.v1.DeleteTemplateResponse
+	14, // 26: mirai.v1.TargetAudienceService.ArchiveTemplate:output_type -> mirai.v1.ArchiveTemplateResponse
+	16, // 27: mirai.v1.TargetAudienceService.RestoreTemplate:output_type -> mirai.v1.RestoreTemplateResponse
+	18, // 28: mirai.v1.TargetAudienceService.GenerateTargetAudience:output_type -> mirai.v1.GenerateTargetAudienceResponse
+	21, // [21:29] is the sub-list for method output_type
+	13, // [13:21] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_mirai_v1_target_audience_proto_init() }
@@ -1276,7 +1386,7 @@ func file_mirai_v1_target_audience_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_mirai_v1_target_audience_proto_rawDesc), len(file_mirai_v1_target_audience_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   15,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
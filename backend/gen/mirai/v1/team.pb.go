@@ -9,6 +9,7 @@ package miraiv1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -21,6 +22,65 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// TeamDeletionOutcome describes what happens to an SME or task when its team is deleted.
+type TeamDeletionOutcome int32
+
+const (
+	TeamDeletionOutcome_TEAM_DELETION_OUTCOME_UNSPECIFIED TeamDeletionOutcome = 0
+	TeamDeletionOutcome_TEAM_DELETION_OUTCOME_UNRESOLVED  TeamDeletionOutcome = 1 // Needs reassign_to_team_id or detach
+	TeamDeletionOutcome_TEAM_DELETION_OUTCOME_REASSIGNED  TeamDeletionOutcome = 2
+	TeamDeletionOutcome_TEAM_DELETION_OUTCOME_DETACHED    TeamDeletionOutcome = 3 // Other teams keep access
+	TeamDeletionOutcome_TEAM_DELETION_OUTCOME_MADE_GLOBAL TeamDeletionOutcome = 4
+	TeamDeletionOutcome_TEAM_DELETION_OUTCOME_CANCELLED   TeamDeletionOutcome = 5
+)
+
+// Enum value maps for TeamDeletionOutcome.
+var (
+	TeamDeletionOutcome_name = map[int32]string{
+		0: "TEAM_DELETION_OUTCOME_UNSPECIFIED",
+		1: "TEAM_DELETION_OUTCOME_UNRESOLVED",
+		2: "TEAM_DELETION_OUTCOME_REASSIGNED",
+		3: "TEAM_DELETION_OUTCOME_DETACHED",
+		4: "TEAM_DELETION_OUTCOME_MADE_GLOBAL",
+		5: "TEAM_DELETION_OUTCOME_CANCELLED",
+	}
+	TeamDeletionOutcome_value = map[string]int32{
+		"TEAM_DELETION_OUTCOME_UNSPECIFIED": 0,
+		"TEAM_DELETION_OUTCOME_UNRESOLVED":  1,
+		"TEAM_DELETION_OUTCOME_REASSIGNED":  2,
+		"TEAM_DELETION_OUTCOME_DETACHED":    3,
+		"TEAM_DELETION_OUTCOME_MADE_GLOBAL": 4,
+		"TEAM_DELETION_OUTCOME_CANCELLED":   5,
+	}
+)
+
+func (x TeamDeletionOutcome) Enum() *TeamDeletionOutcome {
+	p := new(TeamDeletionOutcome)
+	*p = x
+	return p
+}
+
+func (x TeamDeletionOutcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TeamDeletionOutcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_mirai_v1_team_proto_enumTypes[0].Descriptor()
+}
+
+func (TeamDeletionOutcome) Type() protoreflect.EnumType {
+	return &file_mirai_v1_team_proto_enumTypes[0]
+}
+
+func (x TeamDeletionOutcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TeamDeletionOutcome.Descriptor instead.
+func (TeamDeletionOutcome) EnumDescriptor() ([]byte, []int) {
+	return file_mirai_v1_team_proto_rawDescGZIP(), []int{0}
+}
+
 // ListTeamsRequest is empty as company is identified by auth context.
 type ListTeamsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -398,11 +458,16 @@ func (x *UpdateTeamResponse) GetTeam() *Team {
 }
 
 // DeleteTeamRequest contains the team ID to delete.
+// When team-scoped SMEs or open tasks reference the team, exactly one of
+// reassign_to_team_id or detach is required.
 type DeleteTeamRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TeamId        string                 `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	TeamId           string                 `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	ReassignToTeamId *string                `protobuf:"bytes,2,opt,name=reassign_to_team_id,json=reassignToTeamId,proto3,oneof" json:"reassign_to_team_id,omitempty"` // Move SME access and open tasks here
+	Detach           bool                   `protobuf:"varint,3,opt,name=detach,proto3" json:"detach,omitempty"`                                                      // Drop team access (SMEs left without a team become global) and cancel open tasks
+	DryRun           bool                   `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`                                        // Return the impact without deleting
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *DeleteTeamRequest) Reset() {
@@ -442,16 +507,170 @@ func (x *DeleteTeamRequest) GetTeamId() string {
 	return ""
 }
 
-// DeleteTeamResponse confirms deletion.
+func (x *DeleteTeamRequest) GetReassignToTeamId() string {
+	if x != nil && x.ReassignToTeamId != nil {
+		return *x.ReassignToTeamId
+	}
+	return ""
+}
+
+func (x *DeleteTeamRequest) GetDetach() bool {
+	if x != nil {
+		return x.Detach
+	}
+	return false
+}
+
+func (x *DeleteTeamRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// TeamDeletionSME is a team-scoped SME affected by the deletion.
+type TeamDeletionSME struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SmeId         string                 `protobuf:"bytes,1,opt,name=sme_id,json=smeId,proto3" json:"sme_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Outcome       TeamDeletionOutcome    `protobuf:"varint,3,opt,name=outcome,proto3,enum=mirai.v1.TeamDeletionOutcome" json:"outcome,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamDeletionSME) Reset() {
+	*x = TeamDeletionSME{}
+	mi := &file_mirai_v1_team_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamDeletionSME) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamDeletionSME) ProtoMessage() {}
+
+func (x *TeamDeletionSME) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_team_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamDeletionSME.ProtoReflect.Descriptor instead.
+func (*TeamDeletionSME) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_team_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TeamDeletionSME) GetSmeId() string {
+	if x != nil {
+		return x.SmeId
+	}
+	return ""
+}
+
+func (x *TeamDeletionSME) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TeamDeletionSME) GetOutcome() TeamDeletionOutcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return TeamDeletionOutcome_TEAM_DELETION_OUTCOME_UNSPECIFIED
+}
+
+// TeamDeletionTask is an open task affected by the deletion.
+type TeamDeletionTask struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	TaskId           string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Title            string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	AssignedToUserId string                 `protobuf:"bytes,3,opt,name=assigned_to_user_id,json=assignedToUserId,proto3" json:"assigned_to_user_id,omitempty"`
+	Outcome          TeamDeletionOutcome    `protobuf:"varint,4,opt,name=outcome,proto3,enum=mirai.v1.TeamDeletionOutcome" json:"outcome,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *TeamDeletionTask) Reset() {
+	*x = TeamDeletionTask{}
+	mi := &file_mirai_v1_team_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamDeletionTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamDeletionTask) ProtoMessage() {}
+
+func (x *TeamDeletionTask) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_team_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamDeletionTask.ProtoReflect.Descriptor instead.
+func (*TeamDeletionTask) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_team_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TeamDeletionTask) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *TeamDeletionTask) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *TeamDeletionTask) GetAssignedToUserId() string {
+	if x != nil {
+		return x.AssignedToUserId
+	}
+	return ""
+}
+
+func (x *TeamDeletionTask) GetOutcome() TeamDeletionOutcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return TeamDeletionOutcome_TEAM_DELETION_OUTCOME_UNSPECIFIED
+}
+
+// DeleteTeamResponse summarizes the impact of the deletion.
 type DeleteTeamResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deleted       bool                   `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"` // False for dry runs
+	Smes          []*TeamDeletionSME     `protobuf:"bytes,2,rep,name=smes,proto3" json:"smes,omitempty"`
+	Tasks         []*TeamDeletionTask    `protobuf:"bytes,3,rep,name=tasks,proto3" json:"tasks,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DeleteTeamResponse) Reset() {
 	*x = DeleteTeamResponse{}
-	mi := &file_mirai_v1_team_proto_msgTypes[9]
+	mi := &file_mirai_v1_team_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -463,7 +682,7 @@ func (x *DeleteTeamResponse) String() string {
 func (*DeleteTeamResponse) ProtoMessage() {}
 
 func (x *DeleteTeamResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_team_proto_msgTypes[9]
+	mi := &file_mirai_v1_team_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -476,7 +695,28 @@ func (x *DeleteTeamResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteTeamResponse.ProtoReflect.Descriptor instead.
 func (*DeleteTeamResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_team_proto_rawDescGZIP(), []int{9}
+	return file_mirai_v1_team_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteTeamResponse) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *DeleteTeamResponse) GetSmes() []*TeamDeletionSME {
+	if x != nil {
+		return x.Smes
+	}
+	return nil
+}
+
+func (x *DeleteTeamResponse) GetTasks() []*TeamDeletionTask {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
 }
 
 // ListTeamMembersRequest contains the team ID.
@@ -489,7 +729,7 @@ type ListTeamMembersRequest struct {
 
 func (x *ListTeamMembersRequest) Reset() {
 	*x = ListTeamMembersRequest{}
-	mi := &file_mirai_v1_team_proto_msgTypes[10]
+	mi := &file_mirai_v1_team_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -501,7 +741,7 @@ func (x *ListTeamMembersRequest) String() string {
 func (*ListTeamMembersRequest) ProtoMessage() {}
 
 func (x *ListTeamMembersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_team_proto_msgTypes[10]
+	mi := &file_mirai_v1_team_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -514,7 +754,7 @@ func (x *ListTeamMembersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTeamMembersRequest.ProtoReflect.Descriptor instead.
 func (*ListTeamMembersRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_team_proto_rawDescGZIP(), []int{10}
+	return file_mirai_v1_team_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ListTeamMembersRequest) GetTeamId() string {
@@ -534,7 +774,7 @@ type ListTeamMembersResponse struct {
 
 func (x *ListTeamMembersResponse) Reset() {
 	*x = ListTeamMembersResponse{}
-	mi := &file_mirai_v1_team_proto_msgTypes[11]
+	mi := &file_mirai_v1_team_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -546,7 +786,7 @@ func (x *ListTeamMembersResponse) String() string {
 func (*ListTeamMembersResponse) ProtoMessage() {}
 
 func (x *ListTeamMembersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_team_proto_msgTypes[11]
+	mi := &file_mirai_v1_team_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -559,7 +799,7 @@ func (x *ListTeamMembersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTeamMembersResponse.ProtoReflect.Descriptor instead.
 func (*ListTeamMembersResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_team_proto_rawDescGZIP(), []int{11}
+	return file_mirai_v1_team_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ListTeamMembersResponse) GetMembers() []*TeamMember {
@@ -581,7 +821,7 @@ type AddTeamMemberRequest struct {
 
 func (x *AddTeamMemberRequest) Reset() {
 	*x = AddTeamMemberRequest{}
-	mi := &file_mirai_v1_team_proto_msgTypes[12]
+	mi := &file_mirai_v1_team_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -593,7 +833,7 @@ func (x *AddTeamMemberRequest) String() string {
 func (*AddTeamMemberRequest) ProtoMessage() {}
 
 func (x *AddTeamMemberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_team_proto_msgTypes[12]
+	mi := &file_mirai_v1_team_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -606,7 +846,7 @@ func (x *AddTeamMemberRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddTeamMemberRequest.ProtoReflect.Descriptor instead.
 func (*AddTeamMemberRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_team_proto_rawDescGZIP(), []int{12}
+	return file_mirai_v1_team_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *AddTeamMemberRequest) GetTeamId() string {
@@ -640,7 +880,7 @@ type AddTeamMemberResponse struct {
 
 func (x *AddTeamMemberResponse) Reset() {
 	*x = AddTeamMemberResponse{}
-	mi := &file_mirai_v1_team_proto_msgTypes[13]
+	mi := &file_mirai_v1_team_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -652,7 +892,7 @@ func (x *AddTeamMemberResponse) String() string {
 func (*AddTeamMemberResponse) ProtoMessage() {}
 
 func (x *AddTeamMemberResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_team_proto_msgTypes[13]
+	mi := &file_mirai_v1_team_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -665,7 +905,7 @@ func (x *AddTeamMemberResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddTeamMemberResponse.ProtoReflect.Descriptor instead.
 func (*AddTeamMemberResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_team_proto_rawDescGZIP(), []int{13}
+	return file_mirai_v1_team_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *AddTeamMemberResponse) GetMember() *TeamMember {
@@ -686,7 +926,7 @@ type RemoveTeamMemberRequest struct {
 
 func (x *RemoveTeamMemberRequest) Reset() {
 	*x = RemoveTeamMemberRequest{}
-	mi := &file_mirai_v1_team_proto_msgTypes[14]
+	mi := &file_mirai_v1_team_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -698,7 +938,7 @@ func (x *RemoveTeamMemberRequest) String() string {
 func (*RemoveTeamMemberRequest) ProtoMessage() {}
 
 func (x *RemoveTeamMemberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_team_proto_msgTypes[14]
+	mi := &file_mirai_v1_team_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -711,7 +951,7 @@ func (x *RemoveTeamMemberRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveTeamMemberRequest.ProtoReflect.Descriptor instead.
 func (*RemoveTeamMemberRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_team_proto_rawDescGZIP(), []int{14}
+	return file_mirai_v1_team_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *RemoveTeamMemberRequest) GetTeamId() string {
@@ -737,7 +977,7 @@ type RemoveTeamMemberResponse struct {
 
 func (x *RemoveTeamMemberResponse) Reset() {
 	*x = RemoveTeamMemberResponse{}
-	mi := &file_mirai_v1_team_proto_msgTypes[15]
+	mi := &file_mirai_v1_team_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -749,7 +989,7 @@ func (x *RemoveTeamMemberResponse) String() string {
 func (*RemoveTeamMemberResponse) ProtoMessage() {}
 
 func (x *RemoveTeamMemberResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_team_proto_msgTypes[15]
+	mi := &file_mirai_v1_team_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -762,14 +1002,214 @@ func (x *RemoveTeamMemberResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveTeamMemberResponse.ProtoReflect.Descriptor instead.
 func (*RemoveTeamMemberResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_team_proto_rawDescGZIP(), []int{15}
+	return file_mirai_v1_team_proto_rawDescGZIP(), []int{17}
+}
+
+// GetTeamDashboardRequest contains the team ID.
+type GetTeamDashboardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamId        string                 `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamDashboardRequest) Reset() {
+	*x = GetTeamDashboardRequest{}
+	mi := &file_mirai_v1_team_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamDashboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamDashboardRequest) ProtoMessage() {}
+
+func (x *GetTeamDashboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_team_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamDashboardRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamDashboardRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_team_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetTeamDashboardRequest) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+// TeamTaskStatusCount counts the open SME tasks assigned to team members in one status.
+type TeamTaskStatusCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        SMETaskStatus          `protobuf:"varint,1,opt,name=status,proto3,enum=mirai.v1.SMETaskStatus" json:"status,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Overdue       int32                  `protobuf:"varint,3,opt,name=overdue,proto3" json:"overdue,omitempty"` // Past their due date
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamTaskStatusCount) Reset() {
+	*x = TeamTaskStatusCount{}
+	mi := &file_mirai_v1_team_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamTaskStatusCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamTaskStatusCount) ProtoMessage() {}
+
+func (x *TeamTaskStatusCount) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_team_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamTaskStatusCount.ProtoReflect.Descriptor instead.
+func (*TeamTaskStatusCount) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_team_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *TeamTaskStatusCount) GetStatus() SMETaskStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SMETaskStatus_SME_TASK_STATUS_UNSPECIFIED
+}
+
+func (x *TeamTaskStatusCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *TeamTaskStatusCount) GetOverdue() int32 {
+	if x != nil {
+		return x.Overdue
+	}
+	return 0
+}
+
+// GetTeamDashboardResponse summarizes the team's workload. Each list is capped on its own.
+// The figures are cached briefly, so they can lag changes by up to a minute.
+type GetTeamDashboardResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	TaskCounts         []*TeamTaskStatusCount `protobuf:"bytes,1,rep,name=task_counts,json=taskCounts,proto3" json:"task_counts,omitempty"` // Open tasks assigned to members, by status
+	OpenTasks          int32                  `protobuf:"varint,2,opt,name=open_tasks,json=openTasks,proto3" json:"open_tasks,omitempty"`
+	OverdueTasks       int32                  `protobuf:"varint,3,opt,name=overdue_tasks,json=overdueTasks,proto3" json:"overdue_tasks,omitempty"`
+	ActiveJobs         []*GenerationJob       `protobuf:"bytes,4,rep,name=active_jobs,json=activeJobs,proto3" json:"active_jobs,omitempty"`                         // Queued and processing jobs started by members, newest first
+	RecentCourses      []*LibraryEntry        `protobuf:"bytes,5,rep,name=recent_courses,json=recentCourses,proto3" json:"recent_courses,omitempty"`                // Most recently modified courses in the team's folders
+	PendingInvitations []*Invitation          `protobuf:"bytes,6,rep,name=pending_invitations,json=pendingInvitations,proto3" json:"pending_invitations,omitempty"` // Pending invitations to join the team, newest first
+	GeneratedAt        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetTeamDashboardResponse) Reset() {
+	*x = GetTeamDashboardResponse{}
+	mi := &file_mirai_v1_team_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamDashboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamDashboardResponse) ProtoMessage() {}
+
+func (x *GetTeamDashboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_team_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamDashboardResponse.ProtoReflect.Descriptor instead.
+func (*GetTeamDashboardResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_team_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetTeamDashboardResponse) GetTaskCounts() []*TeamTaskStatusCount {
+	if x != nil {
+		return x.TaskCounts
+	}
+	return nil
+}
+
+func (x *GetTeamDashboardResponse) GetOpenTasks() int32 {
+	if x != nil {
+		return x.OpenTasks
+	}
+	return 0
+}
+
+func (x *GetTeamDashboardResponse) GetOverdueTasks() int32 {
+	if x != nil {
+		return x.OverdueTasks
+	}
+	return 0
+}
+
+func (x *GetTeamDashboardResponse) GetActiveJobs() []*GenerationJob {
+	if x != nil {
+		return x.ActiveJobs
+	}
+	return nil
+}
+
+func (x *GetTeamDashboardResponse) GetRecentCourses() []*LibraryEntry {
+	if x != nil {
+		return x.RecentCourses
+	}
+	return nil
+}
+
+func (x *GetTeamDashboardResponse) GetPendingInvitations() []*Invitation {
+	if x != nil {
+		return x.PendingInvitations
+	}
+	return nil
+}
+
+func (x *GetTeamDashboardResponse) GetGeneratedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return nil
 }
 
 var File_mirai_v1_team_proto protoreflect.FileDescriptor
 
 const file_mirai_v1_team_proto_rawDesc = "" +
 	"\n" +
-	"\x13mirai/v1/team.proto\x12\bmirai.v1\x1a\x15mirai/v1/common.proto\"\x12\n" +
+	"\x13mirai/v1/team.proto\x12\bmirai.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cmirai/v1/ai_generation.proto\x1a\x15mirai/v1/common.proto\x1a\x15mirai/v1/course.proto\x1a\x19mirai/v1/invitation.proto\x1a\x12mirai/v1/sme.proto\"\x12\n" +
 	"\x10ListTeamsRequest\"9\n" +
 	"\x11ListTeamsResponse\x12$\n" +
 	"\x05teams\x18\x01 \x03(\v2\x0e.mirai.v1.TeamR\x05teams\")\n" +
@@ -790,10 +1230,26 @@ const file_mirai_v1_team_proto_rawDesc = "" +
 	"\x05_nameB\x0e\n" +
 	"\f_description\"8\n" +
 	"\x12UpdateTeamResponse\x12\"\n" +
-	"\x04team\x18\x01 \x01(\v2\x0e.mirai.v1.TeamR\x04team\",\n" +
+	"\x04team\x18\x01 \x01(\v2\x0e.mirai.v1.TeamR\x04team\"\xa9\x01\n" +
 	"\x11DeleteTeamRequest\x12\x17\n" +
-	"\ateam_id\x18\x01 \x01(\tR\x06teamId\"\x14\n" +
-	"\x12DeleteTeamResponse\"1\n" +
+	"\ateam_id\x18\x01 \x01(\tR\x06teamId\x122\n" +
+	"\x13reassign_to_team_id\x18\x02 \x01(\tH\x00R\x10reassignToTeamId\x88\x01\x01\x12\x16\n" +
+	"\x06detach\x18\x03 \x01(\bR\x06detach\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRunB\x16\n" +
+	"\x14_reassign_to_team_id\"u\n" +
+	"\x0fTeamDeletionSME\x12\x15\n" +
+	"\x06sme_id\x18\x01 \x01(\tR\x05smeId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x127\n" +
+	"\aoutcome\x18\x03 \x01(\x0e2\x1d.mirai.v1.TeamDeletionOutcomeR\aoutcome\"\xa9\x01\n" +
+	"\x10TeamDeletionTask\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12-\n" +
+	"\x13assigned_to_user_id\x18\x03 \x01(\tR\x10assignedToUserId\x127\n" +
+	"\aoutcome\x18\x04 \x01(\x0e2\x1d.mirai.v1.TeamDeletionOutcomeR\aoutcome\"\x8f\x01\n" +
+	"\x12DeleteTeamResponse\x12\x18\n" +
+	"\adeleted\x18\x01 \x01(\bR\adeleted\x12-\n" +
+	"\x04smes\x18\x02 \x03(\v2\x19.mirai.v1.TeamDeletionSMER\x04smes\x120\n" +
+	"\x05tasks\x18\x03 \x03(\v2\x1a.mirai.v1.TeamDeletionTaskR\x05tasks\"1\n" +
 	"\x16ListTeamMembersRequest\x12\x17\n" +
 	"\ateam_id\x18\x01 \x01(\tR\x06teamId\"I\n" +
 	"\x17ListTeamMembersResponse\x12.\n" +
@@ -807,7 +1263,31 @@ const file_mirai_v1_team_proto_rawDesc = "" +
 	"\x17RemoveTeamMemberRequest\x12\x17\n" +
 	"\ateam_id\x18\x01 \x01(\tR\x06teamId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x1a\n" +
-	"\x18RemoveTeamMemberResponse2\xf3\x04\n" +
+	"\x18RemoveTeamMemberResponse\"2\n" +
+	"\x17GetTeamDashboardRequest\x12\x17\n" +
+	"\ateam_id\x18\x01 \x01(\tR\x06teamId\"v\n" +
+	"\x13TeamTaskStatusCount\x12/\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x17.mirai.v1.SMETaskStatusR\x06status\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x12\x18\n" +
+	"\aoverdue\x18\x03 \x01(\x05R\aoverdue\"\x9d\x03\n" +
+	"\x18GetTeamDashboardResponse\x12>\n" +
+	"\vtask_counts\x18\x01 \x03(\v2\x1d.mirai.v1.TeamTaskStatusCountR\n" +
+	"taskCounts\x12\x1d\n" +
+	"\n" +
+	"open_tasks\x18\x02 \x01(\x05R\topenTasks\x12#\n" +
+	"\roverdue_tasks\x18\x03 \x01(\x05R\foverdueTasks\x128\n" +
+	"\vactive_jobs\x18\x04 \x03(\v2\x17.mirai.v1.GenerationJobR\n" +
+	"activeJobs\x12=\n" +
+	"\x0erecent_courses\x18\x05 \x03(\v2\x16.mirai.v1.LibraryEntryR\rrecentCourses\x12E\n" +
+	"\x13pending_invitations\x18\x06 \x03(\v2\x14.mirai.v1.InvitationR\x12pendingInvitations\x12=\n" +
+	"\fgenerated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAt*\xf8\x01\n" +
+	"\x13TeamDeletionOutcome\x12%\n" +
+	"!TEAM_DELETION_OUTCOME_UNSPECIFIED\x10\x00\x12$\n" +
+	" TEAM_DELETION_OUTCOME_UNRESOLVED\x10\x01\x12$\n" +
+	" TEAM_DELETION_OUTCOME_REASSIGNED\x10\x02\x12\"\n" +
+	"\x1eTEAM_DELETION_OUTCOME_DETACHED\x10\x03\x12%\n" +
+	"!TEAM_DELETION_OUTCOME_MADE_GLOBAL\x10\x04\x12#\n" +
+	"\x1fTEAM_DELETION_OUTCOME_CANCELLED\x10\x052\xce\x05\n" +
 	"\vTeamService\x12D\n" +
 	"\tListTeams\x12\x1a.mirai.v1.ListTeamsRequest\x1a\x1b.mirai.v1.ListTeamsResponse\x12>\n" +
 	"\aGetTeam\x12\x18.mirai.v1.GetTeamRequest\x1a\x19.mirai.v1.GetTeamResponse\x12G\n" +
@@ -819,7 +1299,8 @@ const file_mirai_v1_team_proto_rawDesc = "" +
 	"DeleteTeam\x12\x1b.mirai.v1.DeleteTeamRequest\x1a\x1c.mirai.v1.DeleteTeamResponse\x12V\n" +
 	"\x0fListTeamMembers\x12 .mirai.v1.ListTeamMembersRequest\x1a!.mirai.v1.ListTeamMembersResponse\x12P\n" +
 	"\rAddTeamMember\x12\x1e.mirai.v1.AddTeamMemberRequest\x1a\x1f.mirai.v1.AddTeamMemberResponse\x12Y\n" +
-	"\x10RemoveTeamMember\x12!.mirai.v1.RemoveTeamMemberRequest\x1a\".mirai.v1.RemoveTeamMemberResponseB\x8f\x01\n" +
+	"\x10RemoveTeamMember\x12!.mirai.v1.RemoveTeamMemberRequest\x1a\".mirai.v1.RemoveTeamMemberResponse\x12Y\n" +
+	"\x10GetTeamDashboard\x12!.mirai.v1.GetTeamDashboardRequest\x1a\".mirai.v1.GetTeamDashboardResponseB\x8f\x01\n" +
 	"\fcom.mirai.v1B\tTeamProtoP\x01Z3github.com/sogos/mirai-backend/gen/mirai/v1;miraiv1\xa2\x02\x03MXX\xaa\x02\bMirai.V1\xca\x02\bMirai\\V1\xe2\x02\x14Mirai\\V1\\GPBMetadata\xea\x02\tMirai::V1b\x06proto3"
 
 var (
@@ -834,57 +1315,81 @@ func file_mirai_v1_team_proto_rawDescGZIP() []byte {
 	return file_mirai_v1_team_proto_rawDescData
 }
 
-var file_mirai_v1_team_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_mirai_v1_team_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_mirai_v1_team_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_mirai_v1_team_proto_goTypes = []any{
-	(*ListTeamsRequest)(nil),         // 0: mirai.v1.ListTeamsRequest
-	(*ListTeamsResponse)(nil),        // 1: mirai.v1.ListTeamsResponse
-	(*GetTeamRequest)(nil),           // 2: mirai.v1.GetTeamRequest
-	(*GetTeamResponse)(nil),          // 3: mirai.v1.GetTeamResponse
-	(*CreateTeamRequest)(nil),        // 4: mirai.v1.CreateTeamRequest
-	(*CreateTeamResponse)(nil),       // 5: mirai.v1.CreateTeamResponse
-	(*UpdateTeamRequest)(nil),        // 6: mirai.v1.UpdateTeamRequest
-	(*UpdateTeamResponse)(nil),       // 7: mirai.v1.UpdateTeamResponse
-	(*DeleteTeamRequest)(nil),        // 8: mirai.v1.DeleteTeamRequest
-	(*DeleteTeamResponse)(nil),       // 9: mirai.v1.DeleteTeamResponse
-	(*ListTeamMembersRequest)(nil),   // 10: mirai.v1.ListTeamMembersRequest
-	(*ListTeamMembersResponse)(nil),  // 11: mirai.v1.ListTeamMembersResponse
-	(*AddTeamMemberRequest)(nil),     // 12: mirai.v1.AddTeamMemberRequest
-	(*AddTeamMemberResponse)(nil),    // 13: mirai.v1.AddTeamMemberResponse
-	(*RemoveTeamMemberRequest)(nil),  // 14: mirai.v1.RemoveTeamMemberRequest
-	(*RemoveTeamMemberResponse)(nil), // 15: mirai.v1.RemoveTeamMemberResponse
-	(*Team)(nil),                     // 16: mirai.v1.Team
-	(*TeamMember)(nil),               // 17: mirai.v1.TeamMember
-	(TeamRole)(0),                    // 18: mirai.v1.TeamRole
+	(TeamDeletionOutcome)(0),         // 0: mirai.v1.TeamDeletionOutcome
+	(*ListTeamsRequest)(nil),         // 1: mirai.v1.ListTeamsRequest
+	(*ListTeamsResponse)(nil),        // 2: mirai.v1.ListTeamsResponse
+	(*GetTeamRequest)(nil),           // 3: mirai.v1.GetTeamRequest
+	(*GetTeamResponse)(nil),          // 4: mirai.v1.GetTeamResponse
+	(*CreateTeamRequest)(nil),        // 5: mirai.v1.CreateTeamRequest
+	(*CreateTeamResponse)(nil),       // 6: mirai.v1.CreateTeamResponse
+	(*UpdateTeamRequest)(nil),        // 7: mirai.v1.UpdateTeamRequest
+	(*UpdateTeamResponse)(nil),       // 8: mirai.v1.UpdateTeamResponse
+	(*DeleteTeamRequest)(nil),        // 9: mirai.v1.DeleteTeamRequest
+	(*TeamDeletionSME)(nil),          // 10: mirai.v1.TeamDeletionSME
+	(*TeamDeletionTask)(nil),         // 11: mirai.v1.TeamDeletionTask
+	(*DeleteTeamResponse)(nil),       // 12: mirai.v1.DeleteTeamResponse
+	(*ListTeamMembersRequest)(nil),   // 13: mirai.v1.ListTeamMembersRequest
+	(*ListTeamMembersResponse)(nil),  // 14: mirai.v1.ListTeamMembersResponse
+	(*AddTeamMemberRequest)(nil),     // 15: mirai.v1.AddTeamMemberRequest
+	(*AddTeamMemberResponse)(nil),    // 16: mirai.v1.AddTeamMemberResponse
+	(*RemoveTeamMemberRequest)(nil),  // 17: mirai.v1.RemoveTeamMemberRequest
+	(*RemoveTeamMemberResponse)(nil), // 18: mirai.v1.RemoveTeamMemberResponse
+	(*GetTeamDashboardRequest)(nil),  // 19: mirai.v1.GetTeamDashboardRequest
+	(*TeamTaskStatusCount)(nil),      // 20: mirai.v1.TeamTaskStatusCount
+	(*GetTeamDashboardResponse)(nil), // 21: mirai.v1.GetTeamDashboardResponse
+	(*Team)(nil),                     // 22: mirai.v1.Team
+	(*TeamMember)(nil),               // 23: mirai.v1.TeamMember
+	(TeamRole)(0),                    // 24: mirai.v1.TeamRole
+	(SMETaskStatus)(0),               // 25: mirai.v1.SMETaskStatus
+	(*GenerationJob)(nil),            // 26: mirai.v1.GenerationJob
+	(*LibraryEntry)(nil),             // 27: mirai.v1.LibraryEntry
+	(*Invitation)(nil),               // 28: mirai.v1.Invitation
+	(*timestamppb.Timestamp)(nil),    // 29: google.protobuf.Timestamp
 }
 var file_mirai_v1_team_proto_depIdxs = []int32{
-	16, // 0: mirai.v1.ListTeamsResponse.teams:type_name -> mirai.v1.Team
-	16, // 1: mirai.v1.GetTeamResponse.team:type_name -> mirai.v1.Team
-	16, // 2: mirai.v1.CreateTeamResponse.team:type_name -> mirai.v1.Team
-	16, // 3: mirai.v1.UpdateTeamResponse.team:type_name -> mirai.v1.Team
-	17, // 4: mirai.v1.ListTeamMembersResponse.members:type_name -> mirai.v1.TeamMember
-	18, // 5: mirai.v1.AddTeamMemberRequest.role:type_name -> mirai.v1.TeamRole
-	17, // 6: mirai.v1.AddTeamMemberResponse.member:type_name -> mirai.v1.TeamMember
-	0,  // 7: mirai.v1.TeamService.ListTeams:input_type -> mirai.v1.ListTeamsRequest
-	2,  // 8: mirai.v1.TeamService.GetTeam:input_type -> mirai.v1.GetTeamRequest
-	4,  // 9: mirai.v1.TeamService.CreateTeam:input_type -> mirai.v1.CreateTeamRequest
-	6,  // 10: mirai.v1.TeamService.UpdateTeam:input_type -> mirai.v1.UpdateTeamRequest
-	8,  // 11: mirai.v1.TeamService.DeleteTeam:input_type -> mirai.v1.DeleteTeamRequest
-	10, // 12: mirai.v1.TeamService.ListTeamMembers:input_type -> mirai.v1.ListTeamMembersRequest
-	12, // 13: mirai.v1.TeamService.AddTeamMember:input_type -> mirai.v1.AddTeamMemberRequest
-	14, // 14: mirai.v1.TeamService.RemoveTeamMember:input_type -> mirai.v1.RemoveTeamMemberRequest
-	1,  // 15: mirai.v1.TeamService.ListTeams:output_type -> mirai.v1.ListTeamsResponse
-	3,  // 16: mirai.v1.TeamService.GetTeam:output_type -> mirai.v1.GetTeamResponse
-	5,  // 17: mirai.v1.TeamService.CreateTeam:output_type -> mirai.v1.CreateTeamResponse
-	7,  // 18: mirai.v1.TeamService.UpdateTeam:output_type -> mirai.v1.UpdateTeamResponse
-	9,  // 19: mirai.v1.TeamService.DeleteTeam:output_type -> mirai.v1.DeleteTeamResponse
-	11, // 20: mirai.v1.TeamService.ListTeamMembers:output_type -> mirai.v1.ListTeamMembersResponse
-	13, // 21: mirai.v1.TeamService.AddTeamMember:output_type -> mirai.v1.AddTeamMemberResponse
-	15, // 22: mirai.v1.TeamService.RemoveTeamMember:output_type -> mirai.v1.RemoveTeamMemberResponse
-	15, // [15:23] is the sub-list for method output_type
-	7,  // [7:15] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	22, // 0: mirai.v1.ListTeamsResponse.teams:type_name -> mirai.v1.Team
+	22, // 1: mirai.v1.GetTeamResponse.team:type_name -> mirai.v1.Team
+	22, // 2: mirai.v1.CreateTeamResponse.team:type_name -> mirai.v1.Team
+	22, // 3: mirai.v1.UpdateTeamResponse.team:type_name -> mirai.v1.Team
+	0,  // 4: mirai.v1.TeamDeletionSME.outcome:type_name -> mirai.v1.TeamDeletionOutcome
+	0,  // 5: mirai.v1.TeamDeletionTask.outcome:type_name -> mirai.v1.TeamDeletionOutcome
+	10, // 6: mirai.v1.DeleteTeamResponse.smes:type_name -> mirai.v1.TeamDeletionSME
+	11, // 7: mirai.v1.DeleteTeamResponse.tasks:type_name -> mirai.v1.TeamDeletionTask
+	23, // 8: mirai.v1.ListTeamMembersResponse.members:type_name -> mirai.v1.TeamMember
+	24, // 9: mirai.v1.AddTeamMemberRequest.role:type_name -> mirai.v1.TeamRole
+	23, // 10: mirai.v1.AddTeamMemberResponse.member:type_name -> mirai.v1.TeamMember
+	25, // 11: mirai.v1.TeamTaskStatusCount.status:type_name -> mirai.v1.SMETaskStatus
+	20, // 12: mirai.v1.GetTeamDashboardResponse.task_counts:type_name -> mirai.v1.TeamTaskStatusCount
+	26, // 13: mirai.v1.GetTeamDashboardResponse.active_jobs:type_name -> mirai.v1.GenerationJob
+	27, // 14: mirai.v1.GetTeamDashboardResponse.recent_courses:type_name -> mirai.v1.LibraryEntry
+	28, // 15: mirai.v1.GetTeamDashboardResponse.pending_invitations:type_name -> mirai.v1.Invitation
+	29, // 16: mirai.v1.GetTeamDashboardResponse.generated_at:type_name -> google.protobuf.Timestamp
+	1,  // 17: mirai.v1.TeamService.ListTeams:input_type -> mirai.v1.ListTeamsRequest
+	3,  // 18: mirai.v1.TeamService.GetTeam:input_type -> mirai.v1.GetTeamRequest
+	5,  // 19: mirai.v1.TeamService.CreateTeam:input_type -> mirai.v1.CreateTeamRequest
+	7,  // 20: mirai.v1.TeamService.UpdateTeam:input_type -> mirai.v1.UpdateTeamRequest
+	9,  // 21: mirai.v1.TeamService.DeleteTeam:input_type -> mirai.v1.DeleteTeamRequest
+	13, // 22: mirai.v1.TeamService.ListTeamMembers:input_type -> mirai.v1.ListTeamMembersRequest
+	15, // 23: mirai.v1.TeamService.AddTeamMember:input_type -> mirai.v1.AddTeamMemberRequest
+	17, // 24: mirai.v1.TeamService.RemoveTeamMember:input_type -> mirai.v1.RemoveTeamMemberRequest
+	19, // 25: mirai.v1.TeamService.GetTeamDashboard:input_type -> mirai.v1.GetTeamDashboardRequest
+	2,  // 26: mirai.v1.TeamService.ListTeams:output_type -> mirai.v1.ListTeamsResponse
+	4,  // 27: mirai.v1.TeamService.GetTeam:output_type -> mirai.v1.GetTeamResponse
+	6,  // 28: mirai.v1.TeamService.CreateTeam:output_type -> mirai.v1.CreateTeamResponse
+	8,  // 29: mirai.v1.TeamService.UpdateTeam:output_type -> mirai.v1.UpdateTeamResponse
+	12, // 30: mirai.v1.TeamService.DeleteTeam:output_type -> mirai.v1.DeleteTeamResponse
+	14, // 31: mirai.v1.TeamService.ListTeamMembers:output_type -> mirai.v1.ListTeamMembersResponse
+	16, // 32: mirai.v1.TeamService.AddTeamMember:output_type -> mirai.v1.AddTeamMemberResponse
+	18, // 33: mirai.v1.TeamService.RemoveTeamMember:output_type -> mirai.v1.RemoveTeamMemberResponse
+	21, // 34: mirai.v1.TeamService.GetTeamDashboard:output_type -> mirai.v1.GetTeamDashboardResponse
+	26, // [26:35] is the sub-list for method output_type
+	17, // [17:26] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_mirai_v1_team_proto_init() }
@@ -892,21 +1397,27 @@ func file_mirai_v1_team_proto_init() {
 	if File_mirai_v1_team_proto != nil {
 		return
 	}
+	file_mirai_v1_ai_generation_proto_init()
 	file_mirai_v1_common_proto_init()
+	file_mirai_v1_course_proto_init()
+	file_mirai_v1_invitation_proto_init()
+	file_mirai_v1_sme_proto_init()
 	file_mirai_v1_team_proto_msgTypes[4].OneofWrappers = []any{}
 	file_mirai_v1_team_proto_msgTypes[6].OneofWrappers = []any{}
+	file_mirai_v1_team_proto_msgTypes[8].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_mirai_v1_team_proto_rawDesc), len(file_mirai_v1_team_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   16,
+			NumEnums:      1,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_mirai_v1_team_proto_goTypes,
 		DependencyIndexes: file_mirai_v1_team_proto_depIdxs,
+		EnumInfos:         file_mirai_v1_team_proto_enumTypes,
 		MessageInfos:      file_mirai_v1_team_proto_msgTypes,
 	}.Build()
 	File_mirai_v1_team_proto = out.File
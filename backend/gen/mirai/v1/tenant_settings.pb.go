@@ -77,12 +77,19 @@ type TenantAISettings struct {
 	Provider         AIProvider             `protobuf:"varint,2,opt,name=provider,proto3,enum=mirai.v1.AIProvider" json:"provider,omitempty"`
 	ApiKeyConfigured bool                   `protobuf:"varint,3,opt,name=api_key_configured,json=apiKeyConfigured,proto3" json:"api_key_configured,omitempty"` // True if key is set (never expose actual key)
 	// Usage tracking
-	TotalTokensUsed   int64                  `protobuf:"varint,4,opt,name=total_tokens_used,json=totalTokensUsed,proto3" json:"total_tokens_used,omitempty"`
-	MonthlyTokenLimit *int64                 `protobuf:"varint,5,opt,name=monthly_token_limit,json=monthlyTokenLimit,proto3,oneof" json:"monthly_token_limit,omitempty"`
-	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	UpdatedByUserId   *string                `protobuf:"bytes,7,opt,name=updated_by_user_id,json=updatedByUserId,proto3,oneof" json:"updated_by_user_id,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	TotalTokensUsed         int64                  `protobuf:"varint,4,opt,name=total_tokens_used,json=totalTokensUsed,proto3" json:"total_tokens_used,omitempty"`
+	MonthlyTokenLimit       *int64                 `protobuf:"varint,5,opt,name=monthly_token_limit,json=monthlyTokenLimit,proto3,oneof" json:"monthly_token_limit,omitempty"`
+	UpdatedAt               *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UpdatedByUserId         *string                `protobuf:"bytes,7,opt,name=updated_by_user_id,json=updatedByUserId,proto3,oneof" json:"updated_by_user_id,omitempty"`
+	GenerationDefaults      *GenerationPreferences `protobuf:"bytes,8,opt,name=generation_defaults,json=generationDefaults,proto3" json:"generation_defaults,omitempty"`                     // Component mix for new courses
+	AllowOutlineAutoApprove bool                   `protobuf:"varint,9,opt,name=allow_outline_auto_approve,json=allowOutlineAutoApprove,proto3" json:"allow_outline_auto_approve,omitempty"` // Outline generation may skip review and generate lessons
+	Locale                  *string                `protobuf:"bytes,10,opt,name=locale,proto3,oneof" json:"locale,omitempty"`                                                                // Locale for emails and exports ("en", "de", "fr", "es", "pt"); unset uses each user's
+	DisablePromptCache      bool                   `protobuf:"varint,11,opt,name=disable_prompt_cache,json=disablePromptCache,proto3" json:"disable_prompt_cache,omitempty"`                 // Identical regeneration prompts always call the provider
+	WeeklySummary           *WeeklySummarySchedule `protobuf:"bytes,12,opt,name=weekly_summary,json=weeklySummary,proto3" json:"weekly_summary,omitempty"`                                   // When admins receive the weekly summary email
+	RequireSecondReviewer   bool                   `protobuf:"varint,13,opt,name=require_second_reviewer,json=requireSecondReviewer,proto3" json:"require_second_reviewer,omitempty"`        // Outline approval needs a reviewer other than its generator and endorser
+	ExportRetentionDays     int32                  `protobuf:"varint,14,opt,name=export_retention_days,json=exportRetentionDays,proto3" json:"export_retention_days,omitempty"`              // Days export files are kept before they are deleted
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
 }
 
 func (x *TenantAISettings) Reset() {
@@ -164,6 +171,125 @@ func (x *TenantAISettings) GetUpdatedByUserId() string {
 	return ""
 }
 
+func (x *TenantAISettings) GetGenerationDefaults() *GenerationPreferences {
+	if x != nil {
+		return x.GenerationDefaults
+	}
+	return nil
+}
+
+func (x *TenantAISettings) GetAllowOutlineAutoApprove() bool {
+	if x != nil {
+		return x.AllowOutlineAutoApprove
+	}
+	return false
+}
+
+func (x *TenantAISettings) GetLocale() string {
+	if x != nil && x.Locale != nil {
+		return *x.Locale
+	}
+	return ""
+}
+
+func (x *TenantAISettings) GetDisablePromptCache() bool {
+	if x != nil {
+		return x.DisablePromptCache
+	}
+	return false
+}
+
+func (x *TenantAISettings) GetWeeklySummary() *WeeklySummarySchedule {
+	if x != nil {
+		return x.WeeklySummary
+	}
+	return nil
+}
+
+func (x *TenantAISettings) GetRequireSecondReviewer() bool {
+	if x != nil {
+		return x.RequireSecondReviewer
+	}
+	return false
+}
+
+func (x *TenantAISettings) GetExportRetentionDays() int32 {
+	if x != nil {
+		return x.ExportRetentionDays
+	}
+	return 0
+}
+
+// CourseDefaults are the settings new courses start with. Each one applies only
+// when a course is created without its own value.
+type CourseDefaults struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	DestinationFolderId *string                `protobuf:"bytes,1,opt,name=destination_folder_id,json=destinationFolderId,proto3,oneof" json:"destination_folder_id,omitempty"` // Skipped at creation if the folder no longer exists
+	CategoryTags        []string               `protobuf:"bytes,2,rep,name=category_tags,json=categoryTags,proto3" json:"category_tags,omitempty"`
+	DataSource          *string                `protobuf:"bytes,3,opt,name=data_source,json=dataSource,proto3,oneof" json:"data_source,omitempty"`
+	AssessmentSettings  *AssessmentSettings    `protobuf:"bytes,4,opt,name=assessment_settings,json=assessmentSettings,proto3,oneof" json:"assessment_settings,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CourseDefaults) Reset() {
+	*x = CourseDefaults{}
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CourseDefaults) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourseDefaults) ProtoMessage() {}
+
+func (x *CourseDefaults) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourseDefaults.ProtoReflect.Descriptor instead.
+func (*CourseDefaults) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CourseDefaults) GetDestinationFolderId() string {
+	if x != nil && x.DestinationFolderId != nil {
+		return *x.DestinationFolderId
+	}
+	return ""
+}
+
+func (x *CourseDefaults) GetCategoryTags() []string {
+	if x != nil {
+		return x.CategoryTags
+	}
+	return nil
+}
+
+func (x *CourseDefaults) GetDataSource() string {
+	if x != nil && x.DataSource != nil {
+		return *x.DataSource
+	}
+	return ""
+}
+
+func (x *CourseDefaults) GetAssessmentSettings() *AssessmentSettings {
+	if x != nil {
+		return x.AssessmentSettings
+	}
+	return nil
+}
+
 // GetAISettingsRequest is empty as tenant is from auth context.
 type GetAISettingsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -173,7 +299,7 @@ type GetAISettingsRequest struct {
 
 func (x *GetAISettingsRequest) Reset() {
 	*x = GetAISettingsRequest{}
-	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[1]
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -185,7 +311,7 @@ func (x *GetAISettingsRequest) String() string {
 func (*GetAISettingsRequest) ProtoMessage() {}
 
 func (x *GetAISettingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[1]
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -198,7 +324,7 @@ func (x *GetAISettingsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAISettingsRequest.ProtoReflect.Descriptor instead.
 func (*GetAISettingsRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{1}
+	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{2}
 }
 
 // GetAISettingsResponse contains the AI settings.
@@ -211,7 +337,7 @@ type GetAISettingsResponse struct {
 
 func (x *GetAISettingsResponse) Reset() {
 	*x = GetAISettingsResponse{}
-	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[2]
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -223,7 +349,7 @@ func (x *GetAISettingsResponse) String() string {
 func (*GetAISettingsResponse) ProtoMessage() {}
 
 func (x *GetAISettingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[2]
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -236,7 +362,7 @@ func (x *GetAISettingsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAISettingsResponse.ProtoReflect.Descriptor instead.
 func (*GetAISettingsResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{2}
+	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GetAISettingsResponse) GetSettings() *TenantAISettings {
@@ -257,7 +383,7 @@ type SetAPIKeyRequest struct {
 
 func (x *SetAPIKeyRequest) Reset() {
 	*x = SetAPIKeyRequest{}
-	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[3]
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -269,7 +395,7 @@ func (x *SetAPIKeyRequest) String() string {
 func (*SetAPIKeyRequest) ProtoMessage() {}
 
 func (x *SetAPIKeyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[3]
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -282,7 +408,7 @@ func (x *SetAPIKeyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetAPIKeyRequest.ProtoReflect.Descriptor instead.
 func (*SetAPIKeyRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{3}
+	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SetAPIKeyRequest) GetProvider() AIProvider {
@@ -309,7 +435,7 @@ type SetAPIKeyResponse struct {
 
 func (x *SetAPIKeyResponse) Reset() {
 	*x = SetAPIKeyResponse{}
-	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[4]
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -321,7 +447,7 @@ func (x *SetAPIKeyResponse) String() string {
 func (*SetAPIKeyResponse) ProtoMessage() {}
 
 func (x *SetAPIKeyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[4]
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -334,7 +460,7 @@ func (x *SetAPIKeyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetAPIKeyResponse.ProtoReflect.Descriptor instead.
 func (*SetAPIKeyResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{4}
+	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *SetAPIKeyResponse) GetSettings() *TenantAISettings {
@@ -353,7 +479,7 @@ type RemoveAPIKeyRequest struct {
 
 func (x *RemoveAPIKeyRequest) Reset() {
 	*x = RemoveAPIKeyRequest{}
-	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[5]
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -365,7 +491,7 @@ func (x *RemoveAPIKeyRequest) String() string {
 func (*RemoveAPIKeyRequest) ProtoMessage() {}
 
 func (x *RemoveAPIKeyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[5]
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -378,7 +504,7 @@ func (x *RemoveAPIKeyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveAPIKeyRequest.ProtoReflect.Descriptor instead.
 func (*RemoveAPIKeyRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{5}
+	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{6}
 }
 
 // RemoveAPIKeyResponse confirms removal.
@@ -391,7 +517,7 @@ type RemoveAPIKeyResponse struct {
 
 func (x *RemoveAPIKeyResponse) Reset() {
 	*x = RemoveAPIKeyResponse{}
-	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[6]
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -403,7 +529,7 @@ func (x *RemoveAPIKeyResponse) String() string {
 func (*RemoveAPIKeyResponse) ProtoMessage() {}
 
 func (x *RemoveAPIKeyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[6]
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -416,7 +542,7 @@ func (x *RemoveAPIKeyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveAPIKeyResponse.ProtoReflect.Descriptor instead.
 func (*RemoveAPIKeyResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{6}
+	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *RemoveAPIKeyResponse) GetSettings() *TenantAISettings {
@@ -437,7 +563,7 @@ type TestAPIKeyRequest struct {
 
 func (x *TestAPIKeyRequest) Reset() {
 	*x = TestAPIKeyRequest{}
-	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[7]
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -449,7 +575,7 @@ func (x *TestAPIKeyRequest) String() string {
 func (*TestAPIKeyRequest) ProtoMessage() {}
 
 func (x *TestAPIKeyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[7]
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -462,7 +588,7 @@ func (x *TestAPIKeyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TestAPIKeyRequest.ProtoReflect.Descriptor instead.
 func (*TestAPIKeyRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{7}
+	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *TestAPIKeyRequest) GetProvider() AIProvider {
@@ -490,7 +616,7 @@ type TestAPIKeyResponse struct {
 
 func (x *TestAPIKeyResponse) Reset() {
 	*x = TestAPIKeyResponse{}
-	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[8]
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -502,7 +628,7 @@ func (x *TestAPIKeyResponse) String() string {
 func (*TestAPIKeyResponse) ProtoMessage() {}
 
 func (x *TestAPIKeyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[8]
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -515,7 +641,7 @@ func (x *TestAPIKeyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TestAPIKeyResponse.ProtoReflect.Descriptor instead.
 func (*TestAPIKeyResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{8}
+	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *TestAPIKeyResponse) GetValid() bool {
@@ -543,7 +669,7 @@ type GetUsageStatsRequest struct {
 
 func (x *GetUsageStatsRequest) Reset() {
 	*x = GetUsageStatsRequest{}
-	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[9]
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -555,7 +681,7 @@ func (x *GetUsageStatsRequest) String() string {
 func (*GetUsageStatsRequest) ProtoMessage() {}
 
 func (x *GetUsageStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[9]
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -568,7 +694,7 @@ func (x *GetUsageStatsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUsageStatsRequest.ProtoReflect.Descriptor instead.
 func (*GetUsageStatsRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{9}
+	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetUsageStatsRequest) GetFromDate() *timestamppb.Timestamp {
@@ -597,7 +723,7 @@ type UsageByType struct {
 
 func (x *UsageByType) Reset() {
 	*x = UsageByType{}
-	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[10]
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -609,7 +735,7 @@ func (x *UsageByType) String() string {
 func (*UsageByType) ProtoMessage() {}
 
 func (x *UsageByType) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[10]
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -622,7 +748,7 @@ func (x *UsageByType) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UsageByType.ProtoReflect.Descriptor instead.
 func (*UsageByType) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{10}
+	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *UsageByType) GetJobType() string {
@@ -646,20 +772,74 @@ func (x *UsageByType) GetJobCount() int32 {
 	return 0
 }
 
+// UsagePeriod is the token usage for one calendar month (UTC).
+type UsagePeriod struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	YearMonth     string                 `protobuf:"bytes,1,opt,name=year_month,json=yearMonth,proto3" json:"year_month,omitempty"` // e.g. "2026-10"
+	TokensUsed    int64                  `protobuf:"varint,2,opt,name=tokens_used,json=tokensUsed,proto3" json:"tokens_used,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UsagePeriod) Reset() {
+	*x = UsagePeriod{}
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UsagePeriod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsagePeriod) ProtoMessage() {}
+
+func (x *UsagePeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsagePeriod.ProtoReflect.Descriptor instead.
+func (*UsagePeriod) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UsagePeriod) GetYearMonth() string {
+	if x != nil {
+		return x.YearMonth
+	}
+	return ""
+}
+
+func (x *UsagePeriod) GetTokensUsed() int64 {
+	if x != nil {
+		return x.TokensUsed
+	}
+	return 0
+}
+
 // GetUsageStatsResponse contains usage statistics.
 type GetUsageStatsResponse struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	TotalTokensUsed int64                  `protobuf:"varint,1,opt,name=total_tokens_used,json=totalTokensUsed,proto3" json:"total_tokens_used,omitempty"`
-	TokensThisMonth int64                  `protobuf:"varint,2,opt,name=tokens_this_month,json=tokensThisMonth,proto3" json:"tokens_this_month,omitempty"`
+	TokensThisMonth int64                  `protobuf:"varint,2,opt,name=tokens_this_month,json=tokensThisMonth,proto3" json:"tokens_this_month,omitempty"` // Counted against monthly_limit; resets each month
 	MonthlyLimit    *int64                 `protobuf:"varint,3,opt,name=monthly_limit,json=monthlyLimit,proto3,oneof" json:"monthly_limit,omitempty"`
 	UsageByType     []*UsageByType         `protobuf:"bytes,4,rep,name=usage_by_type,json=usageByType,proto3" json:"usage_by_type,omitempty"`
+	Periods         []*UsagePeriod         `protobuf:"bytes,5,rep,name=periods,proto3" json:"periods,omitempty"` // Past months' usage, most recent first
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
 func (x *GetUsageStatsResponse) Reset() {
 	*x = GetUsageStatsResponse{}
-	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[11]
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -671,7 +851,7 @@ func (x *GetUsageStatsResponse) String() string {
 func (*GetUsageStatsResponse) ProtoMessage() {}
 
 func (x *GetUsageStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[11]
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -684,7 +864,7 @@ func (x *GetUsageStatsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUsageStatsResponse.ProtoReflect.Descriptor instead.
 func (*GetUsageStatsResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{11}
+	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetUsageStatsResponse) GetTotalTokensUsed() int64 {
@@ -715,69 +895,1013 @@ func (x *GetUsageStatsResponse) GetUsageByType() []*UsageByType {
 	return nil
 }
 
-var File_mirai_v1_tenant_settings_proto protoreflect.FileDescriptor
+func (x *GetUsageStatsResponse) GetPeriods() []*UsagePeriod {
+	if x != nil {
+		return x.Periods
+	}
+	return nil
+}
 
-const file_mirai_v1_tenant_settings_proto_rawDesc = "" +
-	"\n" +
-	"\x1emirai/v1/tenant_settings.proto\x12\bmirai.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x8c\x03\n" +
-	"\x10TenantAISettings\x12\x1b\n" +
-	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x120\n" +
-	"\bprovider\x18\x02 \x01(\x0e2\x14.mirai.v1.AIProviderR\bprovider\x12,\n" +
-	"\x12api_key_configured\x18\x03 \x01(\bR\x10apiKeyConfigured\x12*\n" +
-	"\x11total_tokens_used\x18\x04 \x01(\x03R\x0ftotalTokensUsed\x123\n" +
-	"\x13monthly_token_limit\x18\x05 \x01(\x03H\x00R\x11monthlyTokenLimit\x88\x01\x01\x129\n" +
-	"\n" +
-	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x120\n" +
-	"\x12updated_by_user_id\x18\a \x01(\tH\x01R\x0fupdatedByUserId\x88\x01\x01B\x16\n" +
-	"\x14_monthly_token_limitB\x15\n" +
-	"\x13_updated_by_user_id\"\x16\n" +
-	"\x14GetAISettingsRequest\"O\n" +
-	"\x15GetAISettingsResponse\x126\n" +
-	"\bsettings\x18\x01 \x01(\v2\x1a.mirai.v1.TenantAISettingsR\bsettings\"]\n" +
-	"\x10SetAPIKeyRequest\x120\n" +
-	"\bprovider\x18\x01 \x01(\x0e2\x14.mirai.v1.AIProviderR\bprovider\x12\x17\n" +
-	"\aapi_key\x18\x02 \x01(\tR\x06apiKey\"K\n" +
-	"\x11SetAPIKeyResponse\x126\n" +
-	"\bsettings\x18\x01 \x01(\v2\x1a.mirai.v1.TenantAISettingsR\bsettings\"\x15\n" +
-	"\x13RemoveAPIKeyRequest\"N\n" +
-	"\x14RemoveAPIKeyResponse\x126\n" +
-	"\bsettings\x18\x01 \x01(\v2\x1a.mirai.v1.TenantAISettingsR\bsettings\"^\n" +
-	"\x11TestAPIKeyRequest\x120\n" +
-	"\bprovider\x18\x01 \x01(\x0e2\x14.mirai.v1.AIProviderR\bprovider\x12\x17\n" +
-	"\aapi_key\x18\x02 \x01(\tR\x06apiKey\"f\n" +
-	"\x12TestAPIKeyResponse\x12\x14\n" +
-	"\x05valid\x18\x01 \x01(\bR\x05valid\x12(\n" +
-	"\rerror_message\x18\x02 \x01(\tH\x00R\ferrorMessage\x88\x01\x01B\x10\n" +
-	"\x0e_error_message\"\xa8\x01\n" +
-	"\x14GetUsageStatsRequest\x12<\n" +
-	"\tfrom_date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\bfromDate\x88\x01\x01\x128\n" +
-	"\ato_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\x06toDate\x88\x01\x01B\f\n" +
-	"\n" +
-	"_from_dateB\n" +
-	"\n" +
-	"\b_to_date\"f\n" +
-	"\vUsageByType\x12\x19\n" +
-	"\bjob_type\x18\x01 \x01(\tR\ajobType\x12\x1f\n" +
-	"\vtokens_used\x18\x02 \x01(\x03R\n" +
-	"tokensUsed\x12\x1b\n" +
-	"\tjob_count\x18\x03 \x01(\x05R\bjobCount\"\xe6\x01\n" +
-	"\x15GetUsageStatsResponse\x12*\n" +
-	"\x11total_tokens_used\x18\x01 \x01(\x03R\x0ftotalTokensUsed\x12*\n" +
-	"\x11tokens_this_month\x18\x02 \x01(\x03R\x0ftokensThisMonth\x12(\n" +
-	"\rmonthly_limit\x18\x03 \x01(\x03H\x00R\fmonthlyLimit\x88\x01\x01\x129\n" +
-	"\rusage_by_type\x18\x04 \x03(\v2\x15.mirai.v1.UsageByTypeR\vusageByTypeB\x10\n" +
-	"\x0e_monthly_limit*A\n" +
-	"\n" +
-	"AIProvider\x12\x1b\n" +
-	"\x17AI_PROVIDER_UNSPECIFIED\x10\x00\x12\x16\n" +
-	"\x12AI_PROVIDER_GEMINI\x10\x012\x99\x03\n" +
+// UpdateGenerationDefaultsRequest contains the new defaults.
+type UpdateGenerationDefaultsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Defaults      *GenerationPreferences `protobuf:"bytes,1,opt,name=defaults,proto3" json:"defaults,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGenerationDefaultsRequest) Reset() {
+	*x = UpdateGenerationDefaultsRequest{}
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGenerationDefaultsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGenerationDefaultsRequest) ProtoMessage() {}
+
+func (x *UpdateGenerationDefaultsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGenerationDefaultsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGenerationDefaultsRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateGenerationDefaultsRequest) GetDefaults() *GenerationPreferences {
+	if x != nil {
+		return x.Defaults
+	}
+	return nil
+}
+
+// UpdateGenerationDefaultsResponse returns the updated settings.
+type UpdateGenerationDefaultsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *TenantAISettings      `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGenerationDefaultsResponse) Reset() {
+	*x = UpdateGenerationDefaultsResponse{}
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGenerationDefaultsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGenerationDefaultsResponse) ProtoMessage() {}
+
+func (x *UpdateGenerationDefaultsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGenerationDefaultsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateGenerationDefaultsResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UpdateGenerationDefaultsResponse) GetSettings() *TenantAISettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+// UpdateOutlineAutoApproveRequest contains the new auto-approval setting.
+type UpdateOutlineAutoApproveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Allowed       bool                   `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOutlineAutoApproveRequest) Reset() {
+	*x = UpdateOutlineAutoApproveRequest{}
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOutlineAutoApproveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOutlineAutoApproveRequest) ProtoMessage() {}
+
+func (x *UpdateOutlineAutoApproveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOutlineAutoApproveRequest.ProtoReflect.Descriptor instead.
+func (*UpdateOutlineAutoApproveRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UpdateOutlineAutoApproveRequest) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+// UpdateOutlineAutoApproveResponse returns the updated settings.
+type UpdateOutlineAutoApproveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *TenantAISettings      `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOutlineAutoApproveResponse) Reset() {
+	*x = UpdateOutlineAutoApproveResponse{}
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOutlineAutoApproveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOutlineAutoApproveResponse) ProtoMessage() {}
+
+func (x *UpdateOutlineAutoApproveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOutlineAutoApproveResponse.ProtoReflect.Descriptor instead.
+func (*UpdateOutlineAutoApproveResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UpdateOutlineAutoApproveResponse) GetSettings() *TenantAISettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+// UpdateLocaleRequest contains the new organization locale.
+type UpdateLocaleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Locale        string                 `protobuf:"bytes,1,opt,name=locale,proto3" json:"locale,omitempty"` // Empty clears it, so each user's own locale applies
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateLocaleRequest) Reset() {
+	*x = UpdateLocaleRequest{}
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLocaleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLocaleRequest) ProtoMessage() {}
+
+func (x *UpdateLocaleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLocaleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateLocaleRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UpdateLocaleRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+// UpdateLocaleResponse returns the updated settings.
+type UpdateLocaleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *TenantAISettings      `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateLocaleResponse) Reset() {
+	*x = UpdateLocaleResponse{}
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLocaleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLocaleResponse) ProtoMessage() {}
+
+func (x *UpdateLocaleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLocaleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateLocaleResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpdateLocaleResponse) GetSettings() *TenantAISettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+// GetCourseDefaultsRequest is empty as tenant is from auth context.
+type GetCourseDefaultsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCourseDefaultsRequest) Reset() {
+	*x = GetCourseDefaultsRequest{}
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourseDefaultsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseDefaultsRequest) ProtoMessage() {}
+
+func (x *GetCourseDefaultsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseDefaultsRequest.ProtoReflect.Descriptor instead.
+func (*GetCourseDefaultsRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{20}
+}
+
+// GetCourseDefaultsResponse contains the course defaults.
+type GetCourseDefaultsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Defaults      *CourseDefaults        `protobuf:"bytes,1,opt,name=defaults,proto3" json:"defaults,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCourseDefaultsResponse) Reset() {
+	*x = GetCourseDefaultsResponse{}
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourseDefaultsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseDefaultsResponse) ProtoMessage() {}
+
+func (x *GetCourseDefaultsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseDefaultsResponse.ProtoReflect.Descriptor instead.
+func (*GetCourseDefaultsResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetCourseDefaultsResponse) GetDefaults() *CourseDefaults {
+	if x != nil {
+		return x.Defaults
+	}
+	return nil
+}
+
+// UpdateCourseDefaultsRequest contains the new course defaults.
+type UpdateCourseDefaultsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Defaults      *CourseDefaults        `protobuf:"bytes,1,opt,name=defaults,proto3" json:"defaults,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCourseDefaultsRequest) Reset() {
+	*x = UpdateCourseDefaultsRequest{}
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCourseDefaultsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCourseDefaultsRequest) ProtoMessage() {}
+
+func (x *UpdateCourseDefaultsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCourseDefaultsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCourseDefaultsRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UpdateCourseDefaultsRequest) GetDefaults() *CourseDefaults {
+	if x != nil {
+		return x.Defaults
+	}
+	return nil
+}
+
+// UpdateCourseDefaultsResponse returns the saved course defaults.
+type UpdateCourseDefaultsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Defaults      *CourseDefaults        `protobuf:"bytes,1,opt,name=defaults,proto3" json:"defaults,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCourseDefaultsResponse) Reset() {
+	*x = UpdateCourseDefaultsResponse{}
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCourseDefaultsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCourseDefaultsResponse) ProtoMessage() {}
+
+func (x *UpdateCourseDefaultsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCourseDefaultsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCourseDefaultsResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UpdateCourseDefaultsResponse) GetDefaults() *CourseDefaults {
+	if x != nil {
+		return x.Defaults
+	}
+	return nil
+}
+
+// UpdatePromptCacheRequest contains the new prompt cache setting.
+type UpdatePromptCacheRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Disabled      bool                   `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePromptCacheRequest) Reset() {
+	*x = UpdatePromptCacheRequest{}
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePromptCacheRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePromptCacheRequest) ProtoMessage() {}
+
+func (x *UpdatePromptCacheRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePromptCacheRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePromptCacheRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UpdatePromptCacheRequest) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+// UpdatePromptCacheResponse returns the updated settings.
+type UpdatePromptCacheResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *TenantAISettings      `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePromptCacheResponse) Reset() {
+	*x = UpdatePromptCacheResponse{}
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePromptCacheResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePromptCacheResponse) ProtoMessage() {}
+
+func (x *UpdatePromptCacheResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePromptCacheResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePromptCacheResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UpdatePromptCacheResponse) GetSettings() *TenantAISettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+// UpdateWeeklySummaryRequest contains the new weekly summary schedule.
+type UpdateWeeklySummaryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedule      *WeeklySummarySchedule `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWeeklySummaryRequest) Reset() {
+	*x = UpdateWeeklySummaryRequest{}
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWeeklySummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWeeklySummaryRequest) ProtoMessage() {}
+
+func (x *UpdateWeeklySummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWeeklySummaryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWeeklySummaryRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UpdateWeeklySummaryRequest) GetSchedule() *WeeklySummarySchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+// UpdateWeeklySummaryResponse returns the updated settings.
+type UpdateWeeklySummaryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *TenantAISettings      `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWeeklySummaryResponse) Reset() {
+	*x = UpdateWeeklySummaryResponse{}
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWeeklySummaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWeeklySummaryResponse) ProtoMessage() {}
+
+func (x *UpdateWeeklySummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWeeklySummaryResponse.ProtoReflect.Descriptor instead.
+func (*UpdateWeeklySummaryResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *UpdateWeeklySummaryResponse) GetSettings() *TenantAISettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+// WeeklySummarySchedule is when admins receive the weekly summary email, which reports
+// on the previous Monday-to-Sunday week. Weeks without activity send nothing.
+type WeeklySummarySchedule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Day           int32                  `protobuf:"varint,2,opt,name=day,proto3" json:"day,omitempty"`   // Day of week, 0 = Sunday ... 6 = Saturday
+	Hour          int32                  `protobuf:"varint,3,opt,name=hour,proto3" json:"hour,omitempty"` // Hour of day in UTC, 0-23
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WeeklySummarySchedule) Reset() {
+	*x = WeeklySummarySchedule{}
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WeeklySummarySchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeeklySummarySchedule) ProtoMessage() {}
+
+func (x *WeeklySummarySchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeeklySummarySchedule.ProtoReflect.Descriptor instead.
+func (*WeeklySummarySchedule) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *WeeklySummarySchedule) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *WeeklySummarySchedule) GetDay() int32 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+func (x *WeeklySummarySchedule) GetHour() int32 {
+	if x != nil {
+		return x.Hour
+	}
+	return 0
+}
+
+// UpdateSecondReviewerPolicyRequest contains the new second reviewer policy.
+type UpdateSecondReviewerPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Required      bool                   `protobuf:"varint,1,opt,name=required,proto3" json:"required,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSecondReviewerPolicyRequest) Reset() {
+	*x = UpdateSecondReviewerPolicyRequest{}
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSecondReviewerPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSecondReviewerPolicyRequest) ProtoMessage() {}
+
+func (x *UpdateSecondReviewerPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSecondReviewerPolicyRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSecondReviewerPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UpdateSecondReviewerPolicyRequest) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+// UpdateSecondReviewerPolicyResponse returns the updated settings.
+type UpdateSecondReviewerPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *TenantAISettings      `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSecondReviewerPolicyResponse) Reset() {
+	*x = UpdateSecondReviewerPolicyResponse{}
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSecondReviewerPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSecondReviewerPolicyResponse) ProtoMessage() {}
+
+func (x *UpdateSecondReviewerPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSecondReviewerPolicyResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSecondReviewerPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UpdateSecondReviewerPolicyResponse) GetSettings() *TenantAISettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+// UpdateExportRetentionRequest contains the new export retention period.
+type UpdateExportRetentionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Days          int32                  `protobuf:"varint,1,opt,name=days,proto3" json:"days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateExportRetentionRequest) Reset() {
+	*x = UpdateExportRetentionRequest{}
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateExportRetentionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateExportRetentionRequest) ProtoMessage() {}
+
+func (x *UpdateExportRetentionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateExportRetentionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateExportRetentionRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UpdateExportRetentionRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+// UpdateExportRetentionResponse returns the updated settings.
+type UpdateExportRetentionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *TenantAISettings      `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateExportRetentionResponse) Reset() {
+	*x = UpdateExportRetentionResponse{}
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateExportRetentionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateExportRetentionResponse) ProtoMessage() {}
+
+func (x *UpdateExportRetentionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateExportRetentionResponse.ProtoReflect.Descriptor instead.
+func (*UpdateExportRetentionResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UpdateExportRetentionResponse) GetSettings() *TenantAISettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+var File_mirai_v1_tenant_settings_proto protoreflect.FileDescriptor
+
+const file_mirai_v1_tenant_settings_proto_rawDesc = "" +
+	"\n" +
+	"\x1emirai/v1/tenant_settings.proto\x12\bmirai.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cmirai/v1/ai_generation.proto\x1a\x15mirai/v1/course.proto\"\xa9\x06\n" +
+	"\x10TenantAISettings\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x120\n" +
+	"\bprovider\x18\x02 \x01(\x0e2\x14.mirai.v1.AIProviderR\bprovider\x12,\n" +
+	"\x12api_key_configured\x18\x03 \x01(\bR\x10apiKeyConfigured\x12*\n" +
+	"\x11total_tokens_used\x18\x04 \x01(\x03R\x0ftotalTokensUsed\x123\n" +
+	"\x13monthly_token_limit\x18\x05 \x01(\x03H\x00R\x11monthlyTokenLimit\x88\x01\x01\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x120\n" +
+	"\x12updated_by_user_id\x18\a \x01(\tH\x01R\x0fupdatedByUserId\x88\x01\x01\x12P\n" +
+	"\x13generation_defaults\x18\b \x01(\v2\x1f.mirai.v1.GenerationPreferencesR\x12generationDefaults\x12;\n" +
+	"\x1aallow_outline_auto_approve\x18\t \x01(\bR\x17allowOutlineAutoApprove\x12\x1b\n" +
+	"\x06locale\x18\n" +
+	" \x01(\tH\x02R\x06locale\x88\x01\x01\x120\n" +
+	"\x14disable_prompt_cache\x18\v \x01(\bR\x12disablePromptCache\x12F\n" +
+	"\x0eweekly_summary\x18\f \x01(\v2\x1f.mirai.v1.WeeklySummaryScheduleR\rweeklySummary\x126\n" +
+	"\x17require_second_reviewer\x18\r \x01(\bR\x15requireSecondReviewer\x122\n" +
+	"\x15export_retention_days\x18\x0e \x01(\x05R\x13exportRetentionDaysB\x16\n" +
+	"\x14_monthly_token_limitB\x15\n" +
+	"\x13_updated_by_user_idB\t\n" +
+	"\a_locale\"\xaa\x02\n" +
+	"\x0eCourseDefaults\x127\n" +
+	"\x15destination_folder_id\x18\x01 \x01(\tH\x00R\x13destinationFolderId\x88\x01\x01\x12#\n" +
+	"\rcategory_tags\x18\x02 \x03(\tR\fcategoryTags\x12$\n" +
+	"\vdata_source\x18\x03 \x01(\tH\x01R\n" +
+	"dataSource\x88\x01\x01\x12R\n" +
+	"\x13assessment_settings\x18\x04 \x01(\v2\x1c.mirai.v1.AssessmentSettingsH\x02R\x12assessmentSettings\x88\x01\x01B\x18\n" +
+	"\x16_destination_folder_idB\x0e\n" +
+	"\f_data_sourceB\x16\n" +
+	"\x14_assessment_settings\"\x16\n" +
+	"\x14GetAISettingsRequest\"O\n" +
+	"\x15GetAISettingsResponse\x126\n" +
+	"\bsettings\x18\x01 \x01(\v2\x1a.mirai.v1.TenantAISettingsR\bsettings\"]\n" +
+	"\x10SetAPIKeyRequest\x120\n" +
+	"\bprovider\x18\x01 \x01(\x0e2\x14.mirai.v1.AIProviderR\bprovider\x12\x17\n" +
+	"\aapi_key\x18\x02 \x01(\tR\x06apiKey\"K\n" +
+	"\x11SetAPIKeyResponse\x126\n" +
+	"\bsettings\x18\x01 \x01(\v2\x1a.mirai.v1.TenantAISettingsR\bsettings\"\x15\n" +
+	"\x13RemoveAPIKeyRequest\"N\n" +
+	"\x14RemoveAPIKeyResponse\x126\n" +
+	"\bsettings\x18\x01 \x01(\v2\x1a.mirai.v1.TenantAISettingsR\bsettings\"^\n" +
+	"\x11TestAPIKeyRequest\x120\n" +
+	"\bprovider\x18\x01 \x01(\x0e2\x14.mirai.v1.AIProviderR\bprovider\x12\x17\n" +
+	"\aapi_key\x18\x02 \x01(\tR\x06apiKey\"f\n" +
+	"\x12TestAPIKeyResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12(\n" +
+	"\rerror_message\x18\x02 \x01(\tH\x00R\ferrorMessage\x88\x01\x01B\x10\n" +
+	"\x0e_error_message\"\xa8\x01\n" +
+	"\x14GetUsageStatsRequest\x12<\n" +
+	"\tfrom_date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\bfromDate\x88\x01\x01\x128\n" +
+	"\ato_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\x06toDate\x88\x01\x01B\f\n" +
+	"\n" +
+	"_from_dateB\n" +
+	"\n" +
+	"\b_to_date\"f\n" +
+	"\vUsageByType\x12\x19\n" +
+	"\bjob_type\x18\x01 \x01(\tR\ajobType\x12\x1f\n" +
+	"\vtokens_used\x18\x02 \x01(\x03R\n" +
+	"tokensUsed\x12\x1b\n" +
+	"\tjob_count\x18\x03 \x01(\x05R\bjobCount\"M\n" +
+	"\vUsagePeriod\x12\x1d\n" +
+	"\n" +
+	"year_month\x18\x01 \x01(\tR\tyearMonth\x12\x1f\n" +
+	"\vtokens_used\x18\x02 \x01(\x03R\n" +
+	"tokensUsed\"\x97\x02\n" +
+	"\x15GetUsageStatsResponse\x12*\n" +
+	"\x11total_tokens_used\x18\x01 \x01(\x03R\x0ftotalTokensUsed\x12*\n" +
+	"\x11tokens_this_month\x18\x02 \x01(\x03R\x0ftokensThisMonth\x12(\n" +
+	"\rmonthly_limit\x18\x03 \x01(\x03H\x00R\fmonthlyLimit\x88\x01\x01\x129\n" +
+	"\rusage_by_type\x18\x04 \x03(\v2\x15.mirai.v1.UsageByTypeR\vusageByType\x12/\n" +
+	"\aperiods\x18\x05 \x03(\v2\x15.mirai.v1.UsagePeriodR\aperiodsB\x10\n" +
+	"\x0e_monthly_limit\"^\n" +
+	"\x1fUpdateGenerationDefaultsRequest\x12;\n" +
+	"\bdefaults\x18\x01 \x01(\v2\x1f.mirai.v1.GenerationPreferencesR\bdefaults\"Z\n" +
+	" UpdateGenerationDefaultsResponse\x126\n" +
+	"\bsettings\x18\x01 \x01(\v2\x1a.mirai.v1.TenantAISettingsR\bsettings\";\n" +
+	"\x1fUpdateOutlineAutoApproveRequest\x12\x18\n" +
+	"\aallowed\x18\x01 \x01(\bR\aallowed\"Z\n" +
+	" UpdateOutlineAutoApproveResponse\x126\n" +
+	"\bsettings\x18\x01 \x01(\v2\x1a.mirai.v1.TenantAISettingsR\bsettings\"-\n" +
+	"\x13UpdateLocaleRequest\x12\x16\n" +
+	"\x06locale\x18\x01 \x01(\tR\x06locale\"N\n" +
+	"\x14UpdateLocaleResponse\x126\n" +
+	"\bsettings\x18\x01 \x01(\v2\x1a.mirai.v1.TenantAISettingsR\bsettings\"\x1a\n" +
+	"\x18GetCourseDefaultsRequest\"Q\n" +
+	"\x19GetCourseDefaultsResponse\x124\n" +
+	"\bdefaults\x18\x01 \x01(\v2\x18.mirai.v1.CourseDefaultsR\bdefaults\"S\n" +
+	"\x1bUpdateCourseDefaultsRequest\x124\n" +
+	"\bdefaults\x18\x01 \x01(\v2\x18.mirai.v1.CourseDefaultsR\bdefaults\"T\n" +
+	"\x1cUpdateCourseDefaultsResponse\x124\n" +
+	"\bdefaults\x18\x01 \x01(\v2\x18.mirai.v1.CourseDefaultsR\bdefaults\"6\n" +
+	"\x18UpdatePromptCacheRequest\x12\x1a\n" +
+	"\bdisabled\x18\x01 \x01(\bR\bdisabled\"S\n" +
+	"\x19UpdatePromptCacheResponse\x126\n" +
+	"\bsettings\x18\x01 \x01(\v2\x1a.mirai.v1.TenantAISettingsR\bsettings\"Y\n" +
+	"\x1aUpdateWeeklySummaryRequest\x12;\n" +
+	"\bschedule\x18\x01 \x01(\v2\x1f.mirai.v1.WeeklySummaryScheduleR\bschedule\"U\n" +
+	"\x1bUpdateWeeklySummaryResponse\x126\n" +
+	"\bsettings\x18\x01 \x01(\v2\x1a.mirai.v1.TenantAISettingsR\bsettings\"W\n" +
+	"\x15WeeklySummarySchedule\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x10\n" +
+	"\x03day\x18\x02 \x01(\x05R\x03day\x12\x12\n" +
+	"\x04hour\x18\x03 \x01(\x05R\x04hour\"?\n" +
+	"!UpdateSecondReviewerPolicyRequest\x12\x1a\n" +
+	"\brequired\x18\x01 \x01(\bR\brequired\"\\\n" +
+	"\"UpdateSecondReviewerPolicyResponse\x126\n" +
+	"\bsettings\x18\x01 \x01(\v2\x1a.mirai.v1.TenantAISettingsR\bsettings\"2\n" +
+	"\x1cUpdateExportRetentionRequest\x12\x12\n" +
+	"\x04days\x18\x01 \x01(\x05R\x04days\"W\n" +
+	"\x1dUpdateExportRetentionResponse\x126\n" +
+	"\bsettings\x18\x01 \x01(\v2\x1a.mirai.v1.TenantAISettingsR\bsettings*A\n" +
+	"\n" +
+	"AIProvider\x12\x1b\n" +
+	"\x17AI_PROVIDER_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12AI_PROVIDER_GEMINI\x10\x012\xb8\n" +
+	"\n" +
 	"\x15TenantSettingsService\x12P\n" +
 	"\rGetAISettings\x12\x1e.mirai.v1.GetAISettingsRequest\x1a\x1f.mirai.v1.GetAISettingsResponse\x12D\n" +
 	"\tSetAPIKey\x12\x1a.mirai.v1.SetAPIKeyRequest\x1a\x1b.mirai.v1.SetAPIKeyResponse\x12M\n" +
 	"\fRemoveAPIKey\x12\x1d.mirai.v1.RemoveAPIKeyRequest\x1a\x1e.mirai.v1.RemoveAPIKeyResponse\x12G\n" +
 	"\n" +
 	"TestAPIKey\x12\x1b.mirai.v1.TestAPIKeyRequest\x1a\x1c.mirai.v1.TestAPIKeyResponse\x12P\n" +
-	"\rGetUsageStats\x12\x1e.mirai.v1.GetUsageStatsRequest\x1a\x1f.mirai.v1.GetUsageStatsResponseB\x99\x01\n" +
+	"\rGetUsageStats\x12\x1e.mirai.v1.GetUsageStatsRequest\x1a\x1f.mirai.v1.GetUsageStatsResponse\x12q\n" +
+	"\x18UpdateGenerationDefaults\x12).mirai.v1.UpdateGenerationDefaultsRequest\x1a*.mirai.v1.UpdateGenerationDefaultsResponse\x12q\n" +
+	"\x18UpdateOutlineAutoApprove\x12).mirai.v1.UpdateOutlineAutoApproveRequest\x1a*.mirai.v1.UpdateOutlineAutoApproveResponse\x12M\n" +
+	"\fUpdateLocale\x12\x1d.mirai.v1.UpdateLocaleRequest\x1a\x1e.mirai.v1.UpdateLocaleResponse\x12\\\n" +
+	"\x11GetCourseDefaults\x12\".mirai.v1.GetCourseDefaultsRequest\x1a#.mirai.v1.GetCourseDefaultsResponse\x12e\n" +
+	"\x14UpdateCourseDefaults\x12%.mirai.v1.UpdateCourseDefaultsRequest\x1a&.mirai.v1.UpdateCourseDefaultsResponse\x12\\\n" +
+	"\x11UpdatePromptCache\x12\".mirai.v1.UpdatePromptCacheRequest\x1a#.mirai.v1.UpdatePromptCacheResponse\x12b\n" +
+	"\x13UpdateWeeklySummary\x12$.mirai.v1.UpdateWeeklySummaryRequest\x1a%.mirai.v1.UpdateWeeklySummaryResponse\x12w\n" +
+	"\x1aUpdateSecondReviewerPolicy\x12+.mirai.v1.UpdateSecondReviewerPolicyRequest\x1a,.mirai.v1.UpdateSecondReviewerPolicyResponse\x12h\n" +
+	"\x15UpdateExportRetention\x12&.mirai.v1.UpdateExportRetentionRequest\x1a'.mirai.v1.UpdateExportRetentionResponseB\x99\x01\n" +
 	"\fcom.mirai.v1B\x13TenantSettingsProtoP\x01Z3github.com/sogos/mirai-backend/gen/mirai/v1;miraiv1\xa2\x02\x03MXX\xaa\x02\bMirai.V1\xca\x02\bMirai\\V1\xe2\x02\x14Mirai\\V1\\GPBMetadata\xea\x02\tMirai::V1b\x06proto3"
 
 var (
@@ -793,49 +1917,106 @@ func file_mirai_v1_tenant_settings_proto_rawDescGZIP() []byte {
 }
 
 var file_mirai_v1_tenant_settings_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_mirai_v1_tenant_settings_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_mirai_v1_tenant_settings_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
 var file_mirai_v1_tenant_settings_proto_goTypes = []any{
-	(AIProvider)(0),               // 0: mirai.v1.AIProvider
-	(*TenantAISettings)(nil),      // 1: mirai.v1.TenantAISettings
-	(*GetAISettingsRequest)(nil),  // 2: mirai.v1.GetAISettingsRequest
-	(*GetAISettingsResponse)(nil), // 3: mirai.v1.GetAISettingsResponse
-	(*SetAPIKeyRequest)(nil),      // 4: mirai.v1.SetAPIKeyRequest
-	(*SetAPIKeyResponse)(nil),     // 5: mirai.v1.SetAPIKeyResponse
-	(*RemoveAPIKeyRequest)(nil),   // 6: mirai.v1.RemoveAPIKeyRequest
-	(*RemoveAPIKeyResponse)(nil),  // 7: mirai.v1.RemoveAPIKeyResponse
-	(*TestAPIKeyRequest)(nil),     // 8: mirai.v1.TestAPIKeyRequest
-	(*TestAPIKeyResponse)(nil),    // 9: mirai.v1.TestAPIKeyResponse
-	(*GetUsageStatsRequest)(nil),  // 10: mirai.v1.GetUsageStatsRequest
-	(*UsageByType)(nil),           // 11: mirai.v1.UsageByType
-	(*GetUsageStatsResponse)(nil), // 12: mirai.v1.GetUsageStatsResponse
-	(*timestamppb.Timestamp)(nil), // 13: google.protobuf.Timestamp
+	(AIProvider)(0),                            // 0: mirai.v1.AIProvider
+	(*TenantAISettings)(nil),                   // 1: mirai.v1.TenantAISettings
+	(*CourseDefaults)(nil),                     // 2: mirai.v1.CourseDefaults
+	(*GetAISettingsRequest)(nil),               // 3: mirai.v1.GetAISettingsRequest
+	(*GetAISettingsResponse)(nil),              // 4: mirai.v1.GetAISettingsResponse
+	(*SetAPIKeyRequest)(nil),                   // 5: mirai.v1.SetAPIKeyRequest
+	(*SetAPIKeyResponse)(nil),                  // 6: mirai.v1.SetAPIKeyResponse
+	(*RemoveAPIKeyRequest)(nil),                // 7: mirai.v1.RemoveAPIKeyRequest
+	(*RemoveAPIKeyResponse)(nil),               // 8: mirai.v1.RemoveAPIKeyResponse
+	(*TestAPIKeyRequest)(nil),                  // 9: mirai.v1.TestAPIKeyRequest
+	(*TestAPIKeyResponse)(nil),                 // 10: mirai.v1.TestAPIKeyResponse
+	(*GetUsageStatsRequest)(nil),               // 11: mirai.v1.GetUsageStatsRequest
+	(*UsageByType)(nil),                        // 12: mirai.v1.UsageByType
+	(*UsagePeriod)(nil),                        // 13: mirai.v1.UsagePeriod
+	(*GetUsageStatsResponse)(nil),              // 14: mirai.v1.GetUsageStatsResponse
+	(*UpdateGenerationDefaultsRequest)(nil),    // 15: mirai.v1.UpdateGenerationDefaultsRequest
+	(*UpdateGenerationDefaultsResponse)(nil),   // 16: mirai.v1.UpdateGenerationDefaultsResponse
+	(*UpdateOutlineAutoApproveRequest)(nil),    // 17: mirai.v1.UpdateOutlineAutoApproveRequest
+	(*UpdateOutlineAutoApproveResponse)(nil),   // 18: mirai.v1.UpdateOutlineAutoApproveResponse
+	(*UpdateLocaleRequest)(nil),                // 19: mirai.v1.UpdateLocaleRequest
+	(*UpdateLocaleResponse)(nil),               // 20: mirai.v1.UpdateLocaleResponse
+	(*GetCourseDefaultsRequest)(nil),           // 21: mirai.v1.GetCourseDefaultsRequest
+	(*GetCourseDefaultsResponse)(nil),          // 22: mirai.v1.GetCourseDefaultsResponse
+	(*UpdateCourseDefaultsRequest)(nil),        // 23: mirai.v1.UpdateCourseDefaultsRequest
+	(*UpdateCourseDefaultsResponse)(nil),       // 24: mirai.v1.UpdateCourseDefaultsResponse
+	(*UpdatePromptCacheRequest)(nil),           // 25: mirai.v1.UpdatePromptCacheRequest
+	(*UpdatePromptCacheResponse)(nil),          // 26: mirai.v1.UpdatePromptCacheResponse
+	(*UpdateWeeklySummaryRequest)(nil),         // 27: mirai.v1.UpdateWeeklySummaryRequest
+	(*UpdateWeeklySummaryResponse)(nil),        // 28: mirai.v1.UpdateWeeklySummaryResponse
+	(*WeeklySummarySchedule)(nil),              // 29: mirai.v1.WeeklySummarySchedule
+	(*UpdateSecondReviewerPolicyRequest)(nil),  // 30: mirai.v1.UpdateSecondReviewerPolicyRequest
+	(*UpdateSecondReviewerPolicyResponse)(nil), // 31: mirai.v1.UpdateSecondReviewerPolicyResponse
+	(*UpdateExportRetentionRequest)(nil),       // 32: mirai.v1.UpdateExportRetentionRequest
+	(*UpdateExportRetentionResponse)(nil),      // 33: mirai.v1.UpdateExportRetentionResponse
+	(*timestamppb.Timestamp)(nil),              // 34: google.protobuf.Timestamp
+	(*GenerationPreferences)(nil),              // 35: mirai.v1.GenerationPreferences
+	(*AssessmentSettings)(nil),                 // 36: mirai.v1.AssessmentSettings
 }
 var file_mirai_v1_tenant_settings_proto_depIdxs = []int32{
 	0,  // 0: mirai.v1.TenantAISettings.provider:type_name -> mirai.v1.AIProvider
-	13, // 1: mirai.v1.TenantAISettings.updated_at:type_name -> google.protobuf.Timestamp
-	1,  // 2: mirai.v1.GetAISettingsResponse.settings:type_name -> mirai.v1.TenantAISettings
-	0,  // 3: mirai.v1.SetAPIKeyRequest.provider:type_name -> mirai.v1.AIProvider
-	1,  // 4: mirai.v1.SetAPIKeyResponse.settings:type_name -> mirai.v1.TenantAISettings
-	1,  // 5: mirai.v1.RemoveAPIKeyResponse.settings:type_name -> mirai.v1.TenantAISettings
-	0,  // 6: mirai.v1.TestAPIKeyRequest.provider:type_name -> mirai.v1.AIProvider
-	13, // 7: mirai.v1.GetUsageStatsRequest.from_date:type_name -> google.protobuf.Timestamp
-	13, // 8: mirai.v1.GetUsageStatsRequest.to_date:type_name -> google.protobuf.Timestamp
-	11, // 9: mirai.v1.GetUsageStatsResponse.usage_by_type:type_name -> mirai.v1.UsageByType
-	2,  // 10: mirai.v1.TenantSettingsService.GetAISettings:input_type -> mirai.v1.GetAISettingsRequest
-	4,  // 11: mirai.v1.TenantSettingsService.SetAPIKey:input_type -> mirai.v1.SetAPIKeyRequest
-	6,  // 12: mirai.v1.TenantSettingsService.RemoveAPIKey:input_type -> mirai.v1.RemoveAPIKeyRequest
-	8,  // 13: mirai.v1.TenantSettingsService.TestAPIKey:input_type -> mirai.v1.TestAPIKeyRequest
-	10, // 14: mirai.v1.TenantSettingsService.GetUsageStats:input_type -> mirai.v1.GetUsageStatsRequest
-	3,  // 15: mirai.v1.TenantSettingsService.GetAISettings:output_type -> mirai.v1.GetAISettingsResponse
-	5,  // 16: mirai.v1.TenantSettingsService.SetAPIKey:output_type -> mirai.v1.SetAPIKeyResponse
-	7,  // 17: mirai.v1.TenantSettingsService.RemoveAPIKey:output_type -> mirai.v1.RemoveAPIKeyResponse
-	9,  // 18: mirai.v1.TenantSettingsService.TestAPIKey:output_type -> mirai.v1.TestAPIKeyResponse
-	12, // 19: mirai.v1.TenantSettingsService.GetUsageStats:output_type -> mirai.v1.GetUsageStatsResponse
-	15, // [15:20] is the sub-list for method output_type
-	10, // [10:15] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	34, // 1: mirai.v1.TenantAISettings.updated_at:type_name -> google.protobuf.Timestamp
+	35, // 2: mirai.v1.TenantAISettings.generation_defaults:type_name -> mirai.v1.GenerationPreferences
+	29, // 3: mirai.v1.TenantAISettings.weekly_summary:type_name -> mirai.v1.WeeklySummarySchedule
+	36, // 4: mirai.v1.CourseDefaults.assessment_settings:type_name -> mirai.v1.AssessmentSettings
+	1,  // 5: mirai.v1.GetAISettingsResponse.settings:type_name -> mirai.v1.TenantAISettings
+	0,  // 6: mirai.v1.SetAPIKeyRequest.provider:type_name -> mirai.v1.AIProvider
+	1,  // 7: mirai.v1.SetAPIKeyResponse.settings:type_name -> mirai.v1.TenantAISettings
+	1,  // 8: mirai.v1.RemoveAPIKeyResponse.settings:type_name -> mirai.v1.TenantAISettings
+	0,  // 9: mirai.v1.TestAPIKeyRequest.provider:type_name -> mirai.v1.AIProvider
+	34, // 10: mirai.v1.GetUsageStatsRequest.from_date:type_name -> google.protobuf.Timestamp
+	34, // 11: mirai.v1.GetUsageStatsRequest.to_date:type_name -> google.protobuf.Timestamp
+	12, // 12: mirai.v1.GetUsageStatsResponse.usage_by_type:type_name -> mirai.v1.UsageByType
+	13, // 13: mirai.v1.GetUsageStatsResponse.periods:type_name -> mirai.v1.UsagePeriod
+	35, // 14: mirai.v1.UpdateGenerationDefaultsRequest.defaults:type_name -> mirai.v1.GenerationPreferences
+	1,  // 15: mirai.v1.UpdateGenerationDefaultsResponse.settings:type_name -> mirai.v1.TenantAISettings
+	1,  // 16: mirai.v1.UpdateOutlineAutoApproveResponse.settings:type_name -> mirai.v1.TenantAISettings
+	1,  // 17: mirai.v1.UpdateLocaleResponse.settings:type_name -> mirai.v1.TenantAISettings
+	2,  // 18: mirai.v1.GetCourseDefaultsResponse.defaults:type_name -> mirai.v1.CourseDefaults
+	2,  // 19: mirai.v1.UpdateCourseDefaultsRequest.defaults:type_name -> mirai.v1.CourseDefaults
+	2,  // 20: mirai.v1.UpdateCourseDefaultsResponse.defaults:type_name -> mirai.v1.CourseDefaults
+	1,  // 21: mirai.v1.UpdatePromptCacheResponse.settings:type_name -> mirai.v1.TenantAISettings
+	29, // 22: mirai.v1.UpdateWeeklySummaryRequest.schedule:type_name -> mirai.v1.WeeklySummarySchedule
+	1,  // 23: mirai.v1.UpdateWeeklySummaryResponse.settings:type_name -> mirai.v1.TenantAISettings
+	1,  // 24: mirai.v1.UpdateSecondReviewerPolicyResponse.settings:type_name -> mirai.v1.TenantAISettings
+	1,  // 25: mirai.v1.UpdateExportRetentionResponse.settings:type_name -> mirai.v1.TenantAISettings
+	3,  // 26: mirai.v1.TenantSettingsService.GetAISettings:input_type -> mirai.v1.GetAISettingsRequest
+	5,  // 27: mirai.v1.TenantSettingsService.SetAPIKey:input_type -> mirai.v1.SetAPIKeyRequest
+	7,  // 28: mirai.v1.TenantSettingsService.RemoveAPIKey:input_type -> mirai.v1.RemoveAPIKeyRequest
+	9,  // 29: mirai.v1.TenantSettingsService.TestAPIKey:input_type -> mirai.v1.TestAPIKeyRequest
+	11, // 30: mirai.v1.TenantSettingsService.GetUsageStats:input_type -> mirai.v1.GetUsageStatsRequest
+	15, // 31: mirai.v1.TenantSettingsService.UpdateGenerationDefaults:input_type -> mirai.v1.UpdateGenerationDefaultsRequest
+	17, // 32: mirai.v1.TenantSettingsService.UpdateOutlineAutoApprove:input_type -> mirai.v1.UpdateOutlineAutoApproveRequest
+	19, // 33: mirai.v1.TenantSettingsService.UpdateLocale:input_type -> mirai.v1.UpdateLocaleRequest
+	21, // 34: mirai.v1.TenantSettingsService.GetCourseDefaults:input_type -> mirai.v1.GetCourseDefaultsRequest
+	23, // 35: mirai.v1.TenantSettingsService.UpdateCourseDefaults:input_type -> mirai.v1.UpdateCourseDefaultsRequest
+	25, // 36: mirai.v1.TenantSettingsService.UpdatePromptCache:input_type -> mirai.v1.UpdatePromptCacheRequest
+	27, // 37: mirai.v1.TenantSettingsService.UpdateWeeklySummary:input_type -> mirai.v1.UpdateWeeklySummaryRequest
+	30, // 38: mirai.v1.TenantSettingsService.UpdateSecondReviewerPolicy:input_type -> mirai.v1.UpdateSecondReviewerPolicyRequest
+	32, // 39: mirai.v1.TenantSettingsService.UpdateExportRetention:input_type -> mirai.v1.UpdateExportRetentionRequest
+	4,  // 40: mirai.v1.TenantSettingsService.GetAISettings:output_type -> mirai.v1.GetAISettingsResponse
+	6,  // 41: mirai.v1.TenantSettingsService.SetAPIKey:output_type -> mirai.v1.SetAPIKeyResponse
+	8,  // 42: mirai.v1.TenantSettingsService.RemoveAPIKey:output_type -> mirai.v1.RemoveAPIKeyResponse
+	10, // 43: mirai.v1.TenantSettingsService.TestAPIKey:output_type -> mirai.v1.TestAPIKeyResponse
+	14, // 44: mirai.v1.TenantSettingsService.GetUsageStats:output_type -> mirai.v1.GetUsageStatsResponse
+	16, // 45: mirai.v1.TenantSettingsService.UpdateGenerationDefaults:output_type -> mirai.v1.UpdateGenerationDefaultsResponse
+	18, // 46: mirai.v1.TenantSettingsService.UpdateOutlineAutoApprove:output_type -> mirai.v1.UpdateOutlineAutoApproveResponse
+	20, // 47: mirai.v1.TenantSettingsService.UpdateLocale:output_type -> mirai.v1.UpdateLocaleResponse
+	22, // 48: mirai.v1.TenantSettingsService.GetCourseDefaults:output_type -> mirai.v1.GetCourseDefaultsResponse
+	24, // 49: mirai.v1.TenantSettingsService.UpdateCourseDefaults:output_type -> mirai.v1.UpdateCourseDefaultsResponse
+	26, // 50: mirai.v1.TenantSettingsService.UpdatePromptCache:output_type -> mirai.v1.UpdatePromptCacheResponse
+	28, // 51: mirai.v1.TenantSettingsService.UpdateWeeklySummary:output_type -> mirai.v1.UpdateWeeklySummaryResponse
+	31, // 52: mirai.v1.TenantSettingsService.UpdateSecondReviewerPolicy:output_type -> mirai.v1.UpdateSecondReviewerPolicyResponse
+	33, // 53: mirai.v1.TenantSettingsService.UpdateExportRetention:output_type -> mirai.v1.UpdateExportRetentionResponse
+	40, // [40:54] is the sub-list for method output_type
+	26, // [26:40] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_mirai_v1_tenant_settings_proto_init() }
@@ -843,17 +2024,20 @@ func file_mirai_v1_tenant_settings_proto_init() {
 	if File_mirai_v1_tenant_settings_proto != nil {
 		return
 	}
+	file_mirai_v1_ai_generation_proto_init()
+	file_mirai_v1_course_proto_init()
 	file_mirai_v1_tenant_settings_proto_msgTypes[0].OneofWrappers = []any{}
-	file_mirai_v1_tenant_settings_proto_msgTypes[8].OneofWrappers = []any{}
+	file_mirai_v1_tenant_settings_proto_msgTypes[1].OneofWrappers = []any{}
 	file_mirai_v1_tenant_settings_proto_msgTypes[9].OneofWrappers = []any{}
-	file_mirai_v1_tenant_settings_proto_msgTypes[11].OneofWrappers = []any{}
+	file_mirai_v1_tenant_settings_proto_msgTypes[10].OneofWrappers = []any{}
+	file_mirai_v1_tenant_settings_proto_msgTypes[13].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_mirai_v1_tenant_settings_proto_rawDesc), len(file_mirai_v1_tenant_settings_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   12,
+			NumMessages:   33,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -9,6 +9,7 @@ package miraiv1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -381,11 +382,942 @@ func (x *ListCompanyUsersResponse) GetUsers() []*User {
 	return nil
 }
 
+// DeactivateUserRequest identifies the user to deactivate.
+type DeactivateUserRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ReassignToUserId *string                `protobuf:"bytes,2,opt,name=reassign_to_user_id,json=reassignToUserId,proto3,oneof" json:"reassign_to_user_id,omitempty"` // Defaults to the requesting admin
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DeactivateUserRequest) Reset() {
+	*x = DeactivateUserRequest{}
+	mi := &file_mirai_v1_user_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateUserRequest) ProtoMessage() {}
+
+func (x *DeactivateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_user_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateUserRequest.ProtoReflect.Descriptor instead.
+func (*DeactivateUserRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_user_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeactivateUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeactivateUserRequest) GetReassignToUserId() string {
+	if x != nil && x.ReassignToUserId != nil {
+		return *x.ReassignToUserId
+	}
+	return ""
+}
+
+// DeactivateUserResponse contains the deactivated user and the work moved off them.
+type DeactivateUserResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	User               *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	ReassignedToUserId string                 `protobuf:"bytes,2,opt,name=reassigned_to_user_id,json=reassignedToUserId,proto3" json:"reassigned_to_user_id,omitempty"`
+	ReassignedTaskIds  []string               `protobuf:"bytes,3,rep,name=reassigned_task_ids,json=reassignedTaskIds,proto3" json:"reassigned_task_ids,omitempty"`
+	ReassignedJobIds   []string               `protobuf:"bytes,4,rep,name=reassigned_job_ids,json=reassignedJobIds,proto3" json:"reassigned_job_ids,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *DeactivateUserResponse) Reset() {
+	*x = DeactivateUserResponse{}
+	mi := &file_mirai_v1_user_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateUserResponse) ProtoMessage() {}
+
+func (x *DeactivateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_user_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateUserResponse.ProtoReflect.Descriptor instead.
+func (*DeactivateUserResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_user_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeactivateUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *DeactivateUserResponse) GetReassignedToUserId() string {
+	if x != nil {
+		return x.ReassignedToUserId
+	}
+	return ""
+}
+
+func (x *DeactivateUserResponse) GetReassignedTaskIds() []string {
+	if x != nil {
+		return x.ReassignedTaskIds
+	}
+	return nil
+}
+
+func (x *DeactivateUserResponse) GetReassignedJobIds() []string {
+	if x != nil {
+		return x.ReassignedJobIds
+	}
+	return nil
+}
+
+// ReactivateUserRequest identifies the user to reactivate.
+type ReactivateUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactivateUserRequest) Reset() {
+	*x = ReactivateUserRequest{}
+	mi := &file_mirai_v1_user_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactivateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactivateUserRequest) ProtoMessage() {}
+
+func (x *ReactivateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_user_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactivateUserRequest.ProtoReflect.Descriptor instead.
+func (*ReactivateUserRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_user_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ReactivateUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// ReactivateUserResponse contains the reactivated user.
+type ReactivateUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactivateUserResponse) Reset() {
+	*x = ReactivateUserResponse{}
+	mi := &file_mirai_v1_user_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactivateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactivateUserResponse) ProtoMessage() {}
+
+func (x *ReactivateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_user_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactivateUserResponse.ProtoReflect.Descriptor instead.
+func (*ReactivateUserResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_user_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ReactivateUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// OnboardingChecklistItem is one step of the onboarding checklist.
+type OnboardingChecklistItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"` // Stable identifier, e.g. "ai_settings"
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Completed     bool                   `protobuf:"varint,3,opt,name=completed,proto3" json:"completed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OnboardingChecklistItem) Reset() {
+	*x = OnboardingChecklistItem{}
+	mi := &file_mirai_v1_user_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OnboardingChecklistItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnboardingChecklistItem) ProtoMessage() {}
+
+func (x *OnboardingChecklistItem) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_user_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnboardingChecklistItem.ProtoReflect.Descriptor instead.
+func (*OnboardingChecklistItem) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_user_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *OnboardingChecklistItem) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *OnboardingChecklistItem) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *OnboardingChecklistItem) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+// GetOnboardingStatusRequest is empty as the user is identified by auth context.
+type GetOnboardingStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOnboardingStatusRequest) Reset() {
+	*x = GetOnboardingStatusRequest{}
+	mi := &file_mirai_v1_user_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOnboardingStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOnboardingStatusRequest) ProtoMessage() {}
+
+func (x *GetOnboardingStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_user_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOnboardingStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetOnboardingStatusRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_user_proto_rawDescGZIP(), []int{13}
+}
+
+// GetOnboardingStatusResponse contains the checklist steps in order.
+type GetOnboardingStatusResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Items         []*OnboardingChecklistItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Dismissed     bool                       `protobuf:"varint,2,opt,name=dismissed,proto3" json:"dismissed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOnboardingStatusResponse) Reset() {
+	*x = GetOnboardingStatusResponse{}
+	mi := &file_mirai_v1_user_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOnboardingStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOnboardingStatusResponse) ProtoMessage() {}
+
+func (x *GetOnboardingStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_user_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOnboardingStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetOnboardingStatusResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_user_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetOnboardingStatusResponse) GetItems() []*OnboardingChecklistItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *GetOnboardingStatusResponse) GetDismissed() bool {
+	if x != nil {
+		return x.Dismissed
+	}
+	return false
+}
+
+// DismissOnboardingChecklistRequest is empty as the user is identified by auth context.
+type DismissOnboardingChecklistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DismissOnboardingChecklistRequest) Reset() {
+	*x = DismissOnboardingChecklistRequest{}
+	mi := &file_mirai_v1_user_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DismissOnboardingChecklistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DismissOnboardingChecklistRequest) ProtoMessage() {}
+
+func (x *DismissOnboardingChecklistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_user_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DismissOnboardingChecklistRequest.ProtoReflect.Descriptor instead.
+func (*DismissOnboardingChecklistRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_user_proto_rawDescGZIP(), []int{15}
+}
+
+// DismissOnboardingChecklistResponse confirms the checklist is hidden.
+type DismissOnboardingChecklistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DismissOnboardingChecklistResponse) Reset() {
+	*x = DismissOnboardingChecklistResponse{}
+	mi := &file_mirai_v1_user_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DismissOnboardingChecklistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DismissOnboardingChecklistResponse) ProtoMessage() {}
+
+func (x *DismissOnboardingChecklistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_user_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DismissOnboardingChecklistResponse.ProtoReflect.Descriptor instead.
+func (*DismissOnboardingChecklistResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_user_proto_rawDescGZIP(), []int{16}
+}
+
+// UpdateEmailPreferencesRequest contains the caller's optional email choices.
+type UpdateEmailPreferencesRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	WeeklySummaryOptOut bool                   `protobuf:"varint,1,opt,name=weekly_summary_opt_out,json=weeklySummaryOptOut,proto3" json:"weekly_summary_opt_out,omitempty"` // Stop receiving the weekly summary sent to admins
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *UpdateEmailPreferencesRequest) Reset() {
+	*x = UpdateEmailPreferencesRequest{}
+	mi := &file_mirai_v1_user_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEmailPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEmailPreferencesRequest) ProtoMessage() {}
+
+func (x *UpdateEmailPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_user_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEmailPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEmailPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_user_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UpdateEmailPreferencesRequest) GetWeeklySummaryOptOut() bool {
+	if x != nil {
+		return x.WeeklySummaryOptOut
+	}
+	return false
+}
+
+// UpdateEmailPreferencesResponse contains the updated user.
+type UpdateEmailPreferencesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEmailPreferencesResponse) Reset() {
+	*x = UpdateEmailPreferencesResponse{}
+	mi := &file_mirai_v1_user_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEmailPreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEmailPreferencesResponse) ProtoMessage() {}
+
+func (x *UpdateEmailPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_user_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEmailPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*UpdateEmailPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_user_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UpdateEmailPreferencesResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// APIToken is a personal API token. Requests send its secret as
+// "Authorization: Bearer <secret>" and act as the token's user, limited to its scopes.
+type APIToken struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name               string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	TokenPrefix        string                 `protobuf:"bytes,3,opt,name=token_prefix,json=tokenPrefix,proto3" json:"token_prefix,omitempty"` // Leading characters of the secret, e.g. "mirai_Ab3xYz"
+	Scopes             []string               `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`                              // "course:read", "course:write"
+	RateLimitPerMinute int32                  `protobuf:"varint,5,opt,name=rate_limit_per_minute,json=rateLimitPerMinute,proto3" json:"rate_limit_per_minute,omitempty"`
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt          *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	LastUsedAt         *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_used_at,json=lastUsedAt,proto3,oneof" json:"last_used_at,omitempty"` // Recorded at most once a minute
+	RevokedAt          *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=revoked_at,json=revokedAt,proto3,oneof" json:"revoked_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *APIToken) Reset() {
+	*x = APIToken{}
+	mi := &file_mirai_v1_user_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *APIToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIToken) ProtoMessage() {}
+
+func (x *APIToken) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_user_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIToken.ProtoReflect.Descriptor instead.
+func (*APIToken) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_user_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *APIToken) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *APIToken) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *APIToken) GetTokenPrefix() string {
+	if x != nil {
+		return x.TokenPrefix
+	}
+	return ""
+}
+
+func (x *APIToken) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *APIToken) GetRateLimitPerMinute() int32 {
+	if x != nil {
+		return x.RateLimitPerMinute
+	}
+	return 0
+}
+
+func (x *APIToken) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *APIToken) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *APIToken) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+func (x *APIToken) GetRevokedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return nil
+}
+
+// CreateAPITokenRequest contains the new token's settings.
+type CreateAPITokenRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Name               string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes             []string               `protobuf:"bytes,2,rep,name=scopes,proto3" json:"scopes,omitempty"`                                                        // At least one; course:write implies course:read
+	ExpiresInDays      int32                  `protobuf:"varint,3,opt,name=expires_in_days,json=expiresInDays,proto3" json:"expires_in_days,omitempty"`                  // 1 to 365; 0 for 90
+	RateLimitPerMinute int32                  `protobuf:"varint,4,opt,name=rate_limit_per_minute,json=rateLimitPerMinute,proto3" json:"rate_limit_per_minute,omitempty"` // 1 to 600; 0 for 60
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CreateAPITokenRequest) Reset() {
+	*x = CreateAPITokenRequest{}
+	mi := &file_mirai_v1_user_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAPITokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAPITokenRequest) ProtoMessage() {}
+
+func (x *CreateAPITokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_user_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAPITokenRequest.ProtoReflect.Descriptor instead.
+func (*CreateAPITokenRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_user_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CreateAPITokenRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateAPITokenRequest) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *CreateAPITokenRequest) GetExpiresInDays() int32 {
+	if x != nil {
+		return x.ExpiresInDays
+	}
+	return 0
+}
+
+func (x *CreateAPITokenRequest) GetRateLimitPerMinute() int32 {
+	if x != nil {
+		return x.RateLimitPerMinute
+	}
+	return 0
+}
+
+// CreateAPITokenResponse contains the token and its secret.
+type CreateAPITokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         *APIToken              `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Secret        string                 `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"` // Shown once; only a hash is stored
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAPITokenResponse) Reset() {
+	*x = CreateAPITokenResponse{}
+	mi := &file_mirai_v1_user_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAPITokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAPITokenResponse) ProtoMessage() {}
+
+func (x *CreateAPITokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_user_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAPITokenResponse.ProtoReflect.Descriptor instead.
+func (*CreateAPITokenResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_user_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CreateAPITokenResponse) GetToken() *APIToken {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *CreateAPITokenResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+// ListAPITokensRequest is empty as the caller is identified by auth context.
+type ListAPITokensRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAPITokensRequest) Reset() {
+	*x = ListAPITokensRequest{}
+	mi := &file_mirai_v1_user_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAPITokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAPITokensRequest) ProtoMessage() {}
+
+func (x *ListAPITokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_user_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAPITokensRequest.ProtoReflect.Descriptor instead.
+func (*ListAPITokensRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_user_proto_rawDescGZIP(), []int{22}
+}
+
+// ListAPITokensResponse lists the caller's tokens, revoked and expired ones included.
+type ListAPITokensResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tokens        []*APIToken            `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAPITokensResponse) Reset() {
+	*x = ListAPITokensResponse{}
+	mi := &file_mirai_v1_user_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAPITokensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAPITokensResponse) ProtoMessage() {}
+
+func (x *ListAPITokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_user_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAPITokensResponse.ProtoReflect.Descriptor instead.
+func (*ListAPITokensResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_user_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListAPITokensResponse) GetTokens() []*APIToken {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+// RevokeAPITokenRequest names the token to revoke.
+type RevokeAPITokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TokenId       string                 `protobuf:"bytes,1,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAPITokenRequest) Reset() {
+	*x = RevokeAPITokenRequest{}
+	mi := &file_mirai_v1_user_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAPITokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAPITokenRequest) ProtoMessage() {}
+
+func (x *RevokeAPITokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_user_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAPITokenRequest.ProtoReflect.Descriptor instead.
+func (*RevokeAPITokenRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_user_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RevokeAPITokenRequest) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+// RevokeAPITokenResponse contains the revoked token.
+type RevokeAPITokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         *APIToken              `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAPITokenResponse) Reset() {
+	*x = RevokeAPITokenResponse{}
+	mi := &file_mirai_v1_user_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAPITokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAPITokenResponse) ProtoMessage() {}
+
+func (x *RevokeAPITokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_user_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAPITokenResponse.ProtoReflect.Descriptor instead.
+func (*RevokeAPITokenResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_user_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RevokeAPITokenResponse) GetToken() *APIToken {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
 var File_mirai_v1_user_proto protoreflect.FileDescriptor
 
 const file_mirai_v1_user_proto_rawDesc = "" +
 	"\n" +
-	"\x13mirai/v1/user.proto\x12\bmirai.v1\x1a\x15mirai/v1/common.proto\"\x0e\n" +
+	"\x13mirai/v1/user.proto\x12\bmirai.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x15mirai/v1/common.proto\"\x0e\n" +
 	"\fGetMeRequest\"q\n" +
 	"\rGetMeResponse\x12\"\n" +
 	"\x04user\x18\x01 \x01(\v2\x0e.mirai.v1.UserR\x04user\x120\n" +
@@ -404,13 +1336,79 @@ const file_mirai_v1_user_proto_rawDesc = "" +
 	"\x04user\x18\x01 \x01(\v2\x0e.mirai.v1.UserR\x04user\"\x19\n" +
 	"\x17ListCompanyUsersRequest\"@\n" +
 	"\x18ListCompanyUsersResponse\x12$\n" +
-	"\x05users\x18\x01 \x03(\v2\x0e.mirai.v1.UserR\x05users2\xab\x02\n" +
+	"\x05users\x18\x01 \x03(\v2\x0e.mirai.v1.UserR\x05users\"|\n" +
+	"\x15DeactivateUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x122\n" +
+	"\x13reassign_to_user_id\x18\x02 \x01(\tH\x00R\x10reassignToUserId\x88\x01\x01B\x16\n" +
+	"\x14_reassign_to_user_id\"\xcd\x01\n" +
+	"\x16DeactivateUserResponse\x12\"\n" +
+	"\x04user\x18\x01 \x01(\v2\x0e.mirai.v1.UserR\x04user\x121\n" +
+	"\x15reassigned_to_user_id\x18\x02 \x01(\tR\x12reassignedToUserId\x12.\n" +
+	"\x13reassigned_task_ids\x18\x03 \x03(\tR\x11reassignedTaskIds\x12,\n" +
+	"\x12reassigned_job_ids\x18\x04 \x03(\tR\x10reassignedJobIds\"0\n" +
+	"\x15ReactivateUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"<\n" +
+	"\x16ReactivateUserResponse\x12\"\n" +
+	"\x04user\x18\x01 \x01(\v2\x0e.mirai.v1.UserR\x04user\"_\n" +
+	"\x17OnboardingChecklistItem\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1c\n" +
+	"\tcompleted\x18\x03 \x01(\bR\tcompleted\"\x1c\n" +
+	"\x1aGetOnboardingStatusRequest\"t\n" +
+	"\x1bGetOnboardingStatusResponse\x127\n" +
+	"\x05items\x18\x01 \x03(\v2!.mirai.v1.OnboardingChecklistItemR\x05items\x12\x1c\n" +
+	"\tdismissed\x18\x02 \x01(\bR\tdismissed\"#\n" +
+	"!DismissOnboardingChecklistRequest\"$\n" +
+	"\"DismissOnboardingChecklistResponse\"T\n" +
+	"\x1dUpdateEmailPreferencesRequest\x123\n" +
+	"\x16weekly_summary_opt_out\x18\x01 \x01(\bR\x13weeklySummaryOptOut\"D\n" +
+	"\x1eUpdateEmailPreferencesResponse\x12\"\n" +
+	"\x04user\x18\x01 \x01(\v2\x0e.mirai.v1.UserR\x04user\"\xb5\x03\n" +
+	"\bAPIToken\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12!\n" +
+	"\ftoken_prefix\x18\x03 \x01(\tR\vtokenPrefix\x12\x16\n" +
+	"\x06scopes\x18\x04 \x03(\tR\x06scopes\x121\n" +
+	"\x15rate_limit_per_minute\x18\x05 \x01(\x05R\x12rateLimitPerMinute\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12A\n" +
+	"\flast_used_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampH\x00R\n" +
+	"lastUsedAt\x88\x01\x01\x12>\n" +
+	"\n" +
+	"revoked_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampH\x01R\trevokedAt\x88\x01\x01B\x0f\n" +
+	"\r_last_used_atB\r\n" +
+	"\v_revoked_at\"\x9e\x01\n" +
+	"\x15CreateAPITokenRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06scopes\x18\x02 \x03(\tR\x06scopes\x12&\n" +
+	"\x0fexpires_in_days\x18\x03 \x01(\x05R\rexpiresInDays\x121\n" +
+	"\x15rate_limit_per_minute\x18\x04 \x01(\x05R\x12rateLimitPerMinute\"Z\n" +
+	"\x16CreateAPITokenResponse\x12(\n" +
+	"\x05token\x18\x01 \x01(\v2\x12.mirai.v1.APITokenR\x05token\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\"\x16\n" +
+	"\x14ListAPITokensRequest\"C\n" +
+	"\x15ListAPITokensResponse\x12*\n" +
+	"\x06tokens\x18\x01 \x03(\v2\x12.mirai.v1.APITokenR\x06tokens\"2\n" +
+	"\x15RevokeAPITokenRequest\x12\x19\n" +
+	"\btoken_id\x18\x01 \x01(\tR\atokenId\"B\n" +
+	"\x16RevokeAPITokenResponse\x12(\n" +
+	"\x05token\x18\x01 \x01(\v2\x12.mirai.v1.APITokenR\x05token2\x9b\b\n" +
 	"\vUserService\x128\n" +
 	"\x05GetMe\x12\x16.mirai.v1.GetMeRequest\x1a\x17.mirai.v1.GetMeResponse\x12>\n" +
 	"\aGetUser\x12\x18.mirai.v1.GetUserRequest\x1a\x19.mirai.v1.GetUserResponse\x12G\n" +
 	"\n" +
 	"UpdateUser\x12\x1b.mirai.v1.UpdateUserRequest\x1a\x1c.mirai.v1.UpdateUserResponse\x12Y\n" +
-	"\x10ListCompanyUsers\x12!.mirai.v1.ListCompanyUsersRequest\x1a\".mirai.v1.ListCompanyUsersResponseB\x8f\x01\n" +
+	"\x10ListCompanyUsers\x12!.mirai.v1.ListCompanyUsersRequest\x1a\".mirai.v1.ListCompanyUsersResponse\x12S\n" +
+	"\x0eDeactivateUser\x12\x1f.mirai.v1.DeactivateUserRequest\x1a .mirai.v1.DeactivateUserResponse\x12S\n" +
+	"\x0eReactivateUser\x12\x1f.mirai.v1.ReactivateUserRequest\x1a .mirai.v1.ReactivateUserResponse\x12b\n" +
+	"\x13GetOnboardingStatus\x12$.mirai.v1.GetOnboardingStatusRequest\x1a%.mirai.v1.GetOnboardingStatusResponse\x12w\n" +
+	"\x1aDismissOnboardingChecklist\x12+.mirai.v1.DismissOnboardingChecklistRequest\x1a,.mirai.v1.DismissOnboardingChecklistResponse\x12k\n" +
+	"\x16UpdateEmailPreferences\x12'.mirai.v1.UpdateEmailPreferencesRequest\x1a(.mirai.v1.UpdateEmailPreferencesResponse\x12S\n" +
+	"\x0eCreateAPIToken\x12\x1f.mirai.v1.CreateAPITokenRequest\x1a .mirai.v1.CreateAPITokenResponse\x12P\n" +
+	"\rListAPITokens\x12\x1e.mirai.v1.ListAPITokensRequest\x1a\x1f.mirai.v1.ListAPITokensResponse\x12S\n" +
+	"\x0eRevokeAPIToken\x12\x1f.mirai.v1.RevokeAPITokenRequest\x1a .mirai.v1.RevokeAPITokenResponseB\x8f\x01\n" +
 	"\fcom.mirai.v1B\tUserProtoP\x01Z3github.com/sogos/mirai-backend/gen/mirai/v1;miraiv1\xa2\x02\x03MXX\xaa\x02\bMirai.V1\xca\x02\bMirai\\V1\xe2\x02\x14Mirai\\V1\\GPBMetadata\xea\x02\tMirai::V1b\x06proto3"
 
 var (
@@ -425,40 +1423,86 @@ func file_mirai_v1_user_proto_rawDescGZIP() []byte {
 	return file_mirai_v1_user_proto_rawDescData
 }
 
-var file_mirai_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_mirai_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
 var file_mirai_v1_user_proto_goTypes = []any{
-	(*GetMeRequest)(nil),             // 0: mirai.v1.GetMeRequest
-	(*GetMeResponse)(nil),            // 1: mirai.v1.GetMeResponse
-	(*GetUserRequest)(nil),           // 2: mirai.v1.GetUserRequest
-	(*GetUserResponse)(nil),          // 3: mirai.v1.GetUserResponse
-	(*UpdateUserRequest)(nil),        // 4: mirai.v1.UpdateUserRequest
-	(*UpdateUserResponse)(nil),       // 5: mirai.v1.UpdateUserResponse
-	(*ListCompanyUsersRequest)(nil),  // 6: mirai.v1.ListCompanyUsersRequest
-	(*ListCompanyUsersResponse)(nil), // 7: mirai.v1.ListCompanyUsersResponse
-	(*User)(nil),                     // 8: mirai.v1.User
-	(*Company)(nil),                  // 9: mirai.v1.Company
-	(Role)(0),                        // 10: mirai.v1.Role
+	(*GetMeRequest)(nil),                       // 0: mirai.v1.GetMeRequest
+	(*GetMeResponse)(nil),                      // 1: mirai.v1.GetMeResponse
+	(*GetUserRequest)(nil),                     // 2: mirai.v1.GetUserRequest
+	(*GetUserResponse)(nil),                    // 3: mirai.v1.GetUserResponse
+	(*UpdateUserRequest)(nil),                  // 4: mirai.v1.UpdateUserRequest
+	(*UpdateUserResponse)(nil),                 // 5: mirai.v1.UpdateUserResponse
+	(*ListCompanyUsersRequest)(nil),            // 6: mirai.v1.ListCompanyUsersRequest
+	(*ListCompanyUsersResponse)(nil),           // 7: mirai.v1.ListCompanyUsersResponse
+	(*DeactivateUserRequest)(nil),              // 8: mirai.v1.DeactivateUserRequest
+	(*DeactivateUserResponse)(nil),             // 9: mirai.v1.DeactivateUserResponse
+	(*ReactivateUserRequest)(nil),              // 10: mirai.v1.ReactivateUserRequest
+	(*ReactivateUserResponse)(nil),             // 11: mirai.v1.ReactivateUserResponse
+	(*OnboardingChecklistItem)(nil),            // 12: mirai.v1.OnboardingChecklistItem
+	(*GetOnboardingStatusRequest)(nil),         // 13: mirai.v1.GetOnboardingStatusRequest
+	(*GetOnboardingStatusResponse)(nil),        // 14: mirai.v1.GetOnboardingStatusResponse
+	(*DismissOnboardingChecklistRequest)(nil),  // 15: mirai.v1.DismissOnboardingChecklistRequest
+	(*DismissOnboardingChecklistResponse)(nil), // 16: mirai.v1.DismissOnboardingChecklistResponse
+	(*UpdateEmailPreferencesRequest)(nil),      // 17: mirai.v1.UpdateEmailPreferencesRequest
+	(*UpdateEmailPreferencesResponse)(nil),     // 18: mirai.v1.UpdateEmailPreferencesResponse
+	(*APIToken)(nil),                           // 19: mirai.v1.APIToken
+	(*CreateAPITokenRequest)(nil),              // 20: mirai.v1.CreateAPITokenRequest
+	(*CreateAPITokenResponse)(nil),             // 21: mirai.v1.CreateAPITokenResponse
+	(*ListAPITokensRequest)(nil),               // 22: mirai.v1.ListAPITokensRequest
+	(*ListAPITokensResponse)(nil),              // 23: mirai.v1.ListAPITokensResponse
+	(*RevokeAPITokenRequest)(nil),              // 24: mirai.v1.RevokeAPITokenRequest
+	(*RevokeAPITokenResponse)(nil),             // 25: mirai.v1.RevokeAPITokenResponse
+	(*User)(nil),                               // 26: mirai.v1.User
+	(*Company)(nil),                            // 27: mirai.v1.Company
+	(Role)(0),                                  // 28: mirai.v1.Role
+	(*timestamppb.Timestamp)(nil),              // 29: google.protobuf.Timestamp
 }
 var file_mirai_v1_user_proto_depIdxs = []int32{
-	8,  // 0: mirai.v1.GetMeResponse.user:type_name -> mirai.v1.User
-	9,  // 1: mirai.v1.GetMeResponse.company:type_name -> mirai.v1.Company
-	8,  // 2: mirai.v1.GetUserResponse.user:type_name -> mirai.v1.User
-	10, // 3: mirai.v1.UpdateUserRequest.role:type_name -> mirai.v1.Role
-	8,  // 4: mirai.v1.UpdateUserResponse.user:type_name -> mirai.v1.User
-	8,  // 5: mirai.v1.ListCompanyUsersResponse.users:type_name -> mirai.v1.User
-	0,  // 6: mirai.v1.UserService.GetMe:input_type -> mirai.v1.GetMeRequest
-	2,  // 7: mirai.v1.UserService.GetUser:input_type -> mirai.v1.GetUserRequest
-	4,  // 8: mirai.v1.UserService.UpdateUser:input_type -> mirai.v1.UpdateUserRequest
-	6,  // 9: mirai.v1.UserService.ListCompanyUsers:input_type -> mirai.v1.ListCompanyUsersRequest
-	1,  // 10: mirai.v1.UserService.GetMe:output_type -> mirai.v1.GetMeResponse
-	3,  // 11: mirai.v1.UserService.GetUser:output_type -> mirai.v1.GetUserResponse
-	5,  // 12: mirai.v1.UserService.UpdateUser:output_type -> mirai.v1.UpdateUserResponse
-	7,  // 13: mirai.v1.UserService.ListCompanyUsers:output_type -> mirai.v1.ListCompanyUsersResponse
-	10, // [10:14] is the sub-list for method output_type
-	6,  // [6:10] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	26, // 0: mirai.v1.GetMeResponse.user:type_name -> mirai.v1.User
+	27, // 1: mirai.v1.GetMeResponse.company:type_name -> mirai.v1.Company
+	26, // 2: mirai.v1.GetUserResponse.user:type_name -> mirai.v1.User
+	28, // 3: mirai.v1.UpdateUserRequest.role:type_name -> mirai.v1.Role
+	26, // 4: mirai.v1.UpdateUserResponse.user:type_name -> mirai.v1.User
+	26, // 5: mirai.v1.ListCompanyUsersResponse.users:type_name -> mirai.v1.User
+	26, // 6: mirai.v1.DeactivateUserResponse.user:type_name -> mirai.v1.User
+	26, // 7: mirai.v1.ReactivateUserResponse.user:type_name -> mirai.v1.User
+	12, // 8: mirai.v1.GetOnboardingStatusResponse.items:type_name -> mirai.v1.OnboardingChecklistItem
+	26, // 9: mirai.v1.UpdateEmailPreferencesResponse.user:type_name -> mirai.v1.User
+	29, // 10: mirai.v1.APIToken.created_at:type_name -> google.protobuf.Timestamp
+	29, // 11: mirai.v1.APIToken.expires_at:type_name -> google.protobuf.Timestamp
+	29, // 12: mirai.v1.APIToken.last_used_at:type_name -> google.protobuf.Timestamp
+	29, // 13: mirai.v1.APIToken.revoked_at:type_name -> google.protobuf.Timestamp
+	19, // 14: mirai.v1.CreateAPITokenResponse.token:type_name -> mirai.v1.APIToken
+	19, // 15: mirai.v1.ListAPITokensResponse.tokens:type_name -> mirai.v1.APIToken
+	19, // 16: mirai.v1.RevokeAPITokenResponse.token:type_name -> mirai.v1.APIToken
+	0,  // 17: mirai.v1.UserService.GetMe:input_type -> mirai.v1.GetMeRequest
+	2,  // 18: mirai.v1.UserService.GetUser:input_type -> mirai.v1.GetUserRequest
+	4,  // 19: mirai.v1.UserService.UpdateUser:input_type -> mirai.v1.UpdateUserRequest
+	6,  // 20: mirai.v1.UserService.ListCompanyUsers:input_type -> mirai.v1.ListCompanyUsersRequest
+	8,  // 21: mirai.v1.UserService.DeactivateUser:input_type -> mirai.v1.DeactivateUserRequest
+	10, // 22: mirai.v1.UserService.ReactivateUser:input_type -> mirai.v1.ReactivateUserRequest
+	13, // 23: mirai.v1.UserService.GetOnboardingStatus:input_type -> mirai.v1.GetOnboardingStatusRequest
+	15, // 24: mirai.v1.UserService.DismissOnboardingChecklist:input_type -> mirai.v1.DismissOnboardingChecklistRequest
+	17, // 25: mirai.v1.UserService.UpdateEmailPreferences:input_type -> mirai.v1.UpdateEmailPreferencesRequest
+	20, // 26: mirai.v1.UserService.CreateAPIToken:input_type -> mirai.v1.CreateAPITokenRequest
+	22, // 27: mirai.v1.UserService.ListAPITokens:input_type -> mirai.v1.ListAPITokensRequest
+	24, // 28: mirai.v1.UserService.RevokeAPIToken:input_type -> mirai.v1.RevokeAPITokenRequest
+	1,  // 29: mirai.v1.UserService.GetMe:output_type -> mirai.v1.GetMeResponse
+	3,  // 30: mirai.v1.UserService.GetUser:output_type -> mirai.v1.GetUserResponse
+	5,  // 31: mirai.v1.UserService.UpdateUser:output_type -> mirai.v1.UpdateUserResponse
+	7,  // 32: mirai.v1.UserService.ListCompanyUsers:output_type -> mirai.v1.ListCompanyUsersResponse
+	9,  // 33: mirai.v1.UserService.DeactivateUser:output_type -> mirai.v1.DeactivateUserResponse
+	11, // 34: mirai.v1.UserService.ReactivateUser:output_type -> mirai.v1.ReactivateUserResponse
+	14, // 35: mirai.v1.UserService.GetOnboardingStatus:output_type -> mirai.v1.GetOnboardingStatusResponse
+	16, // 36: mirai.v1.UserService.DismissOnboardingChecklist:output_type -> mirai.v1.DismissOnboardingChecklistResponse
+	18, // 37: mirai.v1.UserService.UpdateEmailPreferences:output_type -> mirai.v1.UpdateEmailPreferencesResponse
+	21, // 38: mirai.v1.UserService.CreateAPIToken:output_type -> mirai.v1.CreateAPITokenResponse
+	23, // 39: mirai.v1.UserService.ListAPITokens:output_type -> mirai.v1.ListAPITokensResponse
+	25, // 40: mirai.v1.UserService.RevokeAPIToken:output_type -> mirai.v1.RevokeAPITokenResponse
+	29, // [29:41] is the sub-list for method output_type
+	17, // [17:29] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_mirai_v1_user_proto_init() }
@@ -469,13 +1513,15 @@ func file_mirai_v1_user_proto_init() {
 	file_mirai_v1_common_proto_init()
 	file_mirai_v1_user_proto_msgTypes[1].OneofWrappers = []any{}
 	file_mirai_v1_user_proto_msgTypes[4].OneofWrappers = []any{}
+	file_mirai_v1_user_proto_msgTypes[8].OneofWrappers = []any{}
+	file_mirai_v1_user_proto_msgTypes[19].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_mirai_v1_user_proto_rawDesc), len(file_mirai_v1_user_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -21,6 +21,9 @@ type RegisterRequest struct {
 	// Plan selection
 	Plan      valueobject.Plan `json:"plan" binding:"required"`
 	SeatCount int              `json:"seat_count,omitempty"`
+
+	// Storage region for the tenant's data; empty uses the primary region
+	StorageRegion string `json:"storage_region,omitempty"`
 }
 
 // OnboardRequest represents the onboarding payload.
@@ -49,6 +52,14 @@ type UpdateTeamRequest struct {
 	Description string `json:"description,omitempty"`
 }
 
+// DeleteTeamRequest controls what happens to SMEs and tasks that reference the team.
+// Exactly one of ReassignToTeamID or Detach is required when anything references it.
+type DeleteTeamRequest struct {
+	ReassignToTeamID *uuid.UUID `json:"reassign_to_team_id,omitempty"`
+	Detach           bool       `json:"detach,omitempty"`  // Drop team access; SMEs left without a team become global
+	DryRun           bool       `json:"dry_run,omitempty"` // Report the impact without deleting
+}
+
 // AddTeamMemberRequest represents adding a member to a team.
 type AddTeamMemberRequest struct {
 	UserID uuid.UUID            `json:"user_id" binding:"required"`
@@ -75,8 +86,9 @@ type EnterpriseContactRequest struct {
 
 // CreateInvitationRequest represents the invitation creation payload.
 type CreateInvitationRequest struct {
-	Email string           `json:"email" binding:"required,email"`
-	Role  valueobject.Role `json:"role" binding:"required"`
+	Email  string           `json:"email" binding:"required,email"`
+	Role   valueobject.Role `json:"role" binding:"required"`
+	TeamID *uuid.UUID       `json:"team_id,omitempty"` // Team the invitee joins on acceptance
 }
 
 // AcceptInvitationRequest represents the invitation acceptance payload.
@@ -19,6 +19,13 @@ type UserResponse struct {
 	Email     string           `json:"email,omitempty"`
 	FirstName string           `json:"first_name,omitempty"`
 	LastName  string           `json:"last_name,omitempty"`
+	Locale    string           `json:"locale,omitempty"`
+	IsActive  bool             `json:"is_active"`
+
+	BouncedEmail   *string    `json:"bounced_email,omitempty"`
+	EmailBouncedAt *time.Time `json:"email_bounced_at,omitempty"`
+
+	WeeklySummaryOptOut bool `json:"weekly_summary_opt_out"`
 }
 
 // FromUser converts a domain entity to a response DTO.
@@ -33,6 +40,13 @@ func FromUser(u *entity.User) *UserResponse {
 		Role:      u.Role,
 		CreatedAt: u.CreatedAt,
 		UpdatedAt: u.UpdatedAt,
+		Locale:    u.Locale.String(),
+		IsActive:  u.IsActive,
+
+		BouncedEmail:   u.BouncedEmail,
+		EmailBouncedAt: u.EmailBouncedAt,
+
+		WeeklySummaryOptOut: u.WeeklySummaryOptOut,
 	}
 }
 
@@ -51,6 +65,13 @@ func FromUserWithIdentity(u *entity.User, email, firstName, lastName string) *Us
 		Email:     email,
 		FirstName: firstName,
 		LastName:  lastName,
+		Locale:    u.Locale.String(),
+		IsActive:  u.IsActive,
+
+		BouncedEmail:   u.BouncedEmail,
+		EmailBouncedAt: u.EmailBouncedAt,
+
+		WeeklySummaryOptOut: u.WeeklySummaryOptOut,
 	}
 }
 
@@ -114,6 +135,38 @@ func FromTeam(t *entity.Team) *TeamResponse {
 	}
 }
 
+// Outcomes reported for SMEs and tasks affected by a team deletion.
+const (
+	TeamDeletionOutcomeReassigned = "reassigned" // Moved to the reassignment team
+	TeamDeletionOutcomeDetached   = "detached"   // Team access removed; other teams keep access
+	TeamDeletionOutcomeMadeGlobal = "made_global"
+	TeamDeletionOutcomeCancelled  = "cancelled"
+	TeamDeletionOutcomeUnresolved = "unresolved" // No reassignment target or detach flag given
+)
+
+// TeamDeletionSME describes a team-scoped SME affected by a team deletion.
+type TeamDeletionSME struct {
+	SMEID   uuid.UUID `json:"sme_id"`
+	Name    string    `json:"name"`
+	Outcome string    `json:"outcome"`
+}
+
+// TeamDeletionTask describes an open task affected by a team deletion.
+type TeamDeletionTask struct {
+	TaskID           uuid.UUID `json:"task_id"`
+	Title            string    `json:"title"`
+	AssignedToUserID uuid.UUID `json:"assigned_to_user_id"`
+	Outcome          string    `json:"outcome"`
+}
+
+// DeleteTeamResponse summarizes the impact of deleting a team.
+type DeleteTeamResponse struct {
+	TeamID  uuid.UUID          `json:"team_id"`
+	Deleted bool               `json:"deleted"` // False for dry runs
+	SMEs    []TeamDeletionSME  `json:"smes"`
+	Tasks   []TeamDeletionTask `json:"tasks"`
+}
+
 // TeamMemberResponse represents a team member in API responses.
 type TeamMemberResponse struct {
 	ID        uuid.UUID            `json:"id"`
@@ -189,6 +242,7 @@ type EmailExistsResponse struct {
 type InvitationResponse struct {
 	ID               uuid.UUID                    `json:"id"`
 	CompanyID        uuid.UUID                    `json:"company_id"`
+	TeamID           *uuid.UUID                   `json:"team_id,omitempty"`
 	Email            string                       `json:"email"`
 	Role             valueobject.Role             `json:"role"`
 	Status           valueobject.InvitationStatus `json:"status"`
@@ -207,6 +261,7 @@ func FromInvitation(i *entity.Invitation) *InvitationResponse {
 	return &InvitationResponse{
 		ID:               i.ID,
 		CompanyID:        i.CompanyID,
+		TeamID:           i.TeamID,
 		Email:            i.Email,
 		Role:             i.Role,
 		Status:           i.Status,
@@ -1,19 +1,25 @@
 package service
 
 import (
+	"cmp"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 
+	"github.com/sogos/mirai-backend/internal/domain/audit"
 	"github.com/sogos/mirai-backend/internal/domain/entity"
 	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
 	"github.com/sogos/mirai-backend/internal/domain/repository"
 	"github.com/sogos/mirai-backend/internal/domain/service"
 	"github.com/sogos/mirai-backend/internal/domain/tenant"
 	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+	"github.com/sogos/mirai-backend/internal/infrastructure/cache"
 )
 
 // AIProviderFactory creates AIProvider instances per-tenant.
@@ -25,17 +31,24 @@ type AIProviderFactory interface {
 
 // JobNotifier sends notifications about generation job status changes.
 type JobNotifier interface {
-	NotifyJobProgress(ctx context.Context, userID uuid.UUID, jobID uuid.UUID, jobType string, status string, progress int) error
+	NotifyJobProgress(ctx context.Context, userID uuid.UUID, jobID uuid.UUID, courseID *uuid.UUID, jobType string, status string, progress int) error
 }
 
 // CourseCompletionNotifier sends notifications when full course generation completes.
 type CourseCompletionNotifier interface {
 	// NotifyCourseComplete sends notification when all lessons are generated.
-	NotifyCourseComplete(ctx context.Context, userID uuid.UUID, courseID uuid.UUID, courseTitle string) error
+	NotifyCourseComplete(ctx context.Context, userID uuid.UUID, courseID uuid.UUID, courseTitle string, summary CourseSummary) error
 	// NotifyCourseFailed sends notification when course generation fails.
 	NotifyCourseFailed(ctx context.Context, userID uuid.UUID, courseID uuid.UUID, courseTitle string, errorMsg string) error
 }
 
+// CourseSummary describes a generated course in its completion notification.
+type CourseSummary struct {
+	SectionCount         int
+	LessonCount          int
+	TotalDurationMinutes int
+}
+
 // OutlineCompletionNotifier sends notifications when outline generation completes.
 type OutlineCompletionNotifier interface {
 	// NotifyOutlineReady sends notification when course outline is generated and ready for review.
@@ -44,6 +57,17 @@ type OutlineCompletionNotifier interface {
 	NotifyOutlineFailed(ctx context.Context, userID uuid.UUID, courseID uuid.UUID, courseTitle string, errorMsg string) error
 }
 
+// CourseAccessChecker verifies a user may see or modify a course.
+type CourseAccessChecker interface {
+	CheckCourseEditAccess(ctx context.Context, user *entity.User, courseID uuid.UUID) error
+	CheckCourseViewAccess(ctx context.Context, user *entity.User, courseID uuid.UUID) error
+}
+
+// CoursePreferencesRecorder mirrors generation preferences into a course's assessment settings.
+type CoursePreferencesRecorder interface {
+	RecordGenerationPreferences(ctx context.Context, courseID uuid.UUID, prefs entity.GenerationPreferences) error
+}
+
 // TaskEnqueuer enqueues background tasks for processing.
 // This enables event-driven job processing (push) in addition to polling (sweep).
 type TaskEnqueuer interface {
@@ -51,9 +75,22 @@ type TaskEnqueuer interface {
 	EnqueueAIGeneration(jobID, jobType string) error
 }
 
+// JobCancelPublisher broadcasts job cancellations to every worker.
+type JobCancelPublisher interface {
+	PublishJobCancel(ctx context.Context, jobID uuid.UUID) error
+}
+
+// JobTracker registers in-flight jobs so a cancellation can abort them mid provider call.
+type JobTracker interface {
+	// Track returns a context that is cancelled when the job is cancelled, and a
+	// release func to call once processing ends.
+	Track(ctx context.Context, jobID uuid.UUID) (context.Context, func())
+}
+
 // AIGenerationService handles AI-powered content generation.
 type AIGenerationService struct {
 	userRepo            repository.UserRepository
+	courseRepo          repository.CourseRepository
 	smeRepo             repository.SMERepository
 	smeKnowledgeRepo    repository.SMEKnowledgeRepository
 	audienceRepo        repository.TargetAudienceRepository
@@ -66,16 +103,55 @@ type AIGenerationService struct {
 	genInputRepo        repository.CourseGenerationInputRepository
 	aiSettingsRepo      repository.TenantAISettingsRepository
 	aiProviderFactory   AIProviderFactory
+	courseAccess        CourseAccessChecker
+	coursePrefs         CoursePreferencesRecorder
 	notifier            JobNotifier
 	completionNotifier  CourseCompletionNotifier
 	outlineNotifier     OutlineCompletionNotifier
 	taskEnqueuer        TaskEnqueuer // For event-driven job processing (optional, falls back to polling)
+	cancelPublisher     JobCancelPublisher
+	jobTracker          JobTracker
+	exportStorage       OutlineExportStorage
+	lessonExportStorage LessonExportStorage
+	assetStorage        ComponentAssetStorage
+	exportRecorder      CourseExportRecorder
+	exportNotifier      ExportNotifier
+	exportArtifacts     ExportArtifactRecorder
+	tenantRepo          repository.TenantRepository
+	anomalyRepo         repository.JobAnomalyRepository
+	superAdmins         SuperAdminChecker
+	auditLog            AuditLogger
+	alertEmail          service.EmailProvider
+	statsCache          cache.Cache
+	playerCache         cache.Cache
+	publishedCourses    PublishedCourseReader
+	draftTracker        CourseDraftTracker
+	queueStatusCache    cache.Cache
+	jobOutcomeCache     cache.Cache
+	draftRepo           repository.GenerationDraftRepository
+	auditStorage        StorageAuditStorage
+	storageRefRepo      repository.StorageReferenceRepository
+	courseDuplicator    CourseDuplicator
+	commentRepo         repository.OutlineCommentRepository
+	commentNotifier     OutlineCommentNotifier
+	reviewNotifier      OutlineReviewNotifier
+	tx                  repository.Transactor
+	orphanNotifier      LessonOrphanNotifier
+	courseReferences    CourseReferenceLister
+	quizMigration       QuizSchemaMigrationEnqueuer
+	identity            service.IdentityProvider
+	maxOutcomeChars     int
+	maxContextChars     int
+	workerConcurrency   int
+	inlineEditLimiter   *userRateLimiter
+	suggestionLimiter   *userRateLimiter
 	logger              service.Logger
 }
 
 // NewAIGenerationService creates a new AI generation service.
 func NewAIGenerationService(
 	userRepo repository.UserRepository,
+	courseRepo repository.CourseRepository,
 	smeRepo repository.SMERepository,
 	smeKnowledgeRepo repository.SMEKnowledgeRepository,
 	audienceRepo repository.TargetAudienceRepository,
@@ -88,6 +164,8 @@ func NewAIGenerationService(
 	genInputRepo repository.CourseGenerationInputRepository,
 	aiSettingsRepo repository.TenantAISettingsRepository,
 	aiProviderFactory AIProviderFactory,
+	courseAccess CourseAccessChecker,
+	coursePrefs CoursePreferencesRecorder,
 	notifier JobNotifier,
 	completionNotifier CourseCompletionNotifier,
 	outlineNotifier OutlineCompletionNotifier,
@@ -96,6 +174,7 @@ func NewAIGenerationService(
 ) *AIGenerationService {
 	return &AIGenerationService{
 		userRepo:            userRepo,
+		courseRepo:          courseRepo,
 		smeRepo:             smeRepo,
 		smeKnowledgeRepo:    smeKnowledgeRepo,
 		audienceRepo:        audienceRepo,
@@ -108,14 +187,31 @@ func NewAIGenerationService(
 		genInputRepo:        genInputRepo,
 		aiSettingsRepo:      aiSettingsRepo,
 		aiProviderFactory:   aiProviderFactory,
+		courseAccess:        courseAccess,
+		coursePrefs:         coursePrefs,
 		notifier:            notifier,
 		completionNotifier:  completionNotifier,
 		outlineNotifier:     outlineNotifier,
 		taskEnqueuer:        taskEnqueuer,
+		inlineEditLimiter:   newUserRateLimiter(inlineEditRateLimit, inlineEditRateWindow),
+		suggestionLimiter:   newUserRateLimiter(suggestionRateLimit, suggestionRateWindow),
 		logger:              logger,
 	}
 }
 
+// SetJobCancellation wires in cancel propagation so CancelJob aborts jobs that are
+// already inside a provider call. Without it, cancelled jobs stop at the next checkpoint.
+func (s *AIGenerationService) SetJobCancellation(publisher JobCancelPublisher, tracker JobTracker) {
+	s.cancelPublisher = publisher
+	s.jobTracker = tracker
+}
+
+// SetIdentityProvider enables naming users in results: who started a course generation
+// that is already running, and who wrote outline comments.
+func (s *AIGenerationService) SetIdentityProvider(identity service.IdentityProvider) {
+	s.identity = identity
+}
+
 // GenerateCourseOutlineRequest contains the inputs for outline generation.
 type GenerateCourseOutlineRequest struct {
 	CourseID          uuid.UUID
@@ -124,11 +220,16 @@ type GenerateCourseOutlineRequest struct {
 	TargetAudienceIDs []uuid.UUID
 	DesiredOutcome    string
 	AdditionalContext string
+	Constraints       entity.OutlineConstraints
+	Preferences       *entity.GenerationPreferences // nil uses the tenant defaults
+	AutoApprove       bool                          // Approve the outline and generate all lessons; needs the tenant setting
 }
 
-// GenerateCourseOutlineResult contains the created job.
+// GenerateCourseOutlineResult contains the created job: the outline job, or with
+// AutoApprove the full course job that covers the outline and its lessons.
 type GenerateCourseOutlineResult struct {
-	Job *entity.GenerationJob
+	Job      *entity.GenerationJob
+	Coverage *KnowledgeCoverage // nil if the analysis failed; generation goes ahead either way
 }
 
 // GenerateCourseOutline starts a course outline generation job.
@@ -144,6 +245,33 @@ func (s *AIGenerationService) GenerateCourseOutline(ctx context.Context, kratosI
 		return nil, domainerrors.ErrUserHasNoCompany
 	}
 
+	if err := s.checkCourseAccess(ctx, user, req.CourseID); err != nil {
+		return nil, err
+	}
+
+	if err := validateOutlineConstraints(req.Constraints); err != nil {
+		return nil, err
+	}
+
+	if err := s.normalizeGenerationInput(&req); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkAIProvider(ctx, *user.TenantID); err != nil {
+		return nil, err
+	}
+
+	if req.AutoApprove {
+		if err := s.checkOutlineAutoApprove(ctx, *user.TenantID); err != nil {
+			return nil, err
+		}
+	}
+
+	prefs, err := s.resolveGenerationPreferences(ctx, *user.TenantID, req.Preferences)
+	if err != nil {
+		return nil, err
+	}
+
 	// Validate SMEs exist and user has access
 	for _, smeID := range req.SMEIDs {
 		sme, err := s.smeRepo.GetByID(ctx, smeID)
@@ -168,17 +296,45 @@ func (s *AIGenerationService) GenerateCourseOutline(ctx context.Context, kratosI
 		SMEIDs:            req.SMEIDs,
 		TargetAudienceIDs: req.TargetAudienceIDs,
 		DesiredOutcome:    req.DesiredOutcome,
+		Constraints:       req.Constraints,
+		Preferences:       prefs,
 		CreatedAt:         time.Now(),
 		UpdatedAt:         time.Now(),
 	}
 	if req.AdditionalContext != "" {
 		genInput.AdditionalContext = &req.AdditionalContext
 	}
+	// Snapshot the title so notifications can still name the course if it's deleted mid-generation
+	courseTitle := req.CourseTitle
+	if courseTitle == "" && s.courseRepo != nil {
+		if course, err := s.courseRepo.GetByID(ctx, req.CourseID); err == nil && course != nil {
+			courseTitle = course.Title
+		}
+	}
+	if courseTitle != "" {
+		genInput.CourseTitle = &courseTitle
+	}
+
+	// Thin knowledge is reported with the job rather than blocking it
+	var coverage *KnowledgeCoverage
+	if len(req.SMEIDs) > 0 {
+		coverage, err = s.knowledgeCoverage(ctx, AnalyzeKnowledgeCoverageRequest{
+			SMEIDs:         req.SMEIDs,
+			DesiredOutcome: req.DesiredOutcome,
+			CourseTitle:    courseTitle,
+		})
+		if err != nil {
+			log.Warn("failed to analyze knowledge coverage", "error", err)
+		} else if !coverage.Sufficient {
+			log.Info("selected knowledge looks thin", "score", coverage.Score, "thinTopics", coverage.ThinTopics)
+		}
+	}
 
 	if err := s.genInputRepo.Create(ctx, genInput); err != nil {
 		log.Error("failed to store generation input", "error", err)
 		return nil, domainerrors.ErrInternal.WithCause(err)
 	}
+	s.recordCoursePreferences(ctx, req.CourseID, prefs)
 
 	// Create the job
 	job := &entity.GenerationJob{
@@ -193,12 +349,42 @@ func (s *AIGenerationService) GenerateCourseOutline(ctx context.Context, kratosI
 		CreatedAt:       time.Now(),
 	}
 
+	// Auto-approval runs the outline job under a full course parent that the lesson jobs
+	// join once the outline is stored, so the run is tracked and cancelled as one job
+	var parentJob *entity.GenerationJob
+	if req.AutoApprove {
+		now := time.Now()
+		progressMsg := "Generating course outline..."
+		parentJob = &entity.GenerationJob{
+			ID:              uuid.New(),
+			TenantID:        *user.TenantID,
+			Type:            valueobject.GenerationJobTypeFullCourse,
+			Status:          valueobject.GenerationJobStatusProcessing,
+			CourseID:        &req.CourseID,
+			ProgressPercent: 0,
+			ProgressMessage: &progressMsg,
+			MaxRetries:      0, // Parent job doesn't retry
+			CreatedByUserID: user.ID,
+			CreatedAt:       now,
+			StartedAt:       &now,
+		}
+		if err := s.jobRepo.Create(ctx, parentJob); err != nil {
+			log.Error("failed to create parent job", "error", err)
+			return nil, domainerrors.ErrInternal.WithCause(err)
+		}
+		job.ParentJobID = &parentJob.ID
+	}
+
 	if err := s.jobRepo.Create(ctx, job); err != nil {
 		log.Error("failed to create generation job", "error", err)
+		if parentJob != nil {
+			_ = s.failJob(ctx, parentJob, "failed to queue outline generation")
+		}
 		return nil, domainerrors.ErrInternal.WithCause(err)
 	}
 
-	log.Info("course outline generation job created", "jobID", job.ID)
+	log.Info("course outline generation job created", "jobID", job.ID, "autoApprove", req.AutoApprove)
+	s.clearGenerationDraft(ctx, user.ID, req.CourseID)
 
 	// Push: Enqueue for immediate processing (if task enqueuer available)
 	// Sweep: Poll task will pick it up if enqueue fails or enqueuer is nil
@@ -208,7 +394,10 @@ func (s *AIGenerationService) GenerateCourseOutline(ctx context.Context, kratosI
 		}
 	}
 
-	return &GenerateCourseOutlineResult{Job: job}, nil
+	if parentJob != nil {
+		return &GenerateCourseOutlineResult{Job: parentJob, Coverage: coverage}, nil
+	}
+	return &GenerateCourseOutlineResult{Job: job, Coverage: coverage}, nil
 }
 
 // ProcessOutlineGenerationJob processes an outline generation job.
@@ -217,6 +406,11 @@ func (s *AIGenerationService) GenerateCourseOutline(ctx context.Context, kratosI
 func (s *AIGenerationService) ProcessOutlineGenerationJob(ctx context.Context, job *entity.GenerationJob) error {
 	log := s.logger.With("jobID", job.ID, "courseID", job.CourseID)
 
+	// Worker is draining - hand the job back before doing any work
+	if ctx.Err() != nil {
+		return s.requeueInterruptedJob(ctx, job)
+	}
+
 	// Check for cancellation at start
 	if s.checkJobCancelled(ctx, job.ID) {
 		log.Info("job already cancelled, skipping processing")
@@ -250,6 +444,7 @@ func (s *AIGenerationService) ProcessOutlineGenerationJob(ctx context.Context, j
 			log.Warn("failed to get SME knowledge chunks", "smeID", smeID, "error", err)
 			continue
 		}
+		chunks = generationChunks(chunks)
 
 		chunkTexts := make([]string, len(chunks))
 		keywords := make([]string, 0)
@@ -258,10 +453,7 @@ func (s *AIGenerationService) ProcessOutlineGenerationJob(ctx context.Context, j
 			keywords = append(keywords, chunk.Keywords...)
 		}
 
-		summary := ""
-		if sme.KnowledgeSummary != nil {
-			summary = *sme.KnowledgeSummary
-		}
+		summary := s.promptKnowledgeSummary(sme)
 
 		smeKnowledge = append(smeKnowledge, service.SMEKnowledgeInput{
 			SMEName:  sme.Name,
@@ -272,8 +464,11 @@ func (s *AIGenerationService) ProcessOutlineGenerationJob(ctx context.Context, j
 		})
 	}
 
-	if len(smeKnowledge) == 0 {
-		return s.failJob(ctx, job, "no SME knowledge available")
+	// Reference files attached to the course can stand in for SME knowledge
+	references := s.courseReferenceInputs(ctx, *job.CourseID, genInput.DesiredOutcome, outlineReferenceExcerpts)
+
+	if len(smeKnowledge) == 0 && len(references) == 0 {
+		return s.failJobWithReason(ctx, job, valueobject.JobFailureMissingKnowledge, "no SME knowledge or course reference attachments available")
 	}
 
 	// Update progress
@@ -284,27 +479,8 @@ func (s *AIGenerationService) ProcessOutlineGenerationJob(ctx context.Context, j
 		log.Error("failed to update job progress", "progress", 20, "error", err)
 	}
 
-	// Get target audience
-	var targetAudience service.TargetAudienceInput
-	if len(genInput.TargetAudienceIDs) > 0 {
-		audience, err := s.audienceRepo.GetByID(ctx, genInput.TargetAudienceIDs[0])
-		if err == nil && audience != nil {
-			targetAudience = service.TargetAudienceInput{
-				Role:            audience.Role,
-				ExperienceLevel: string(audience.ExperienceLevel),
-				LearningGoals:   audience.LearningGoals,
-				Prerequisites:   audience.Prerequisites,
-				Challenges:      audience.Challenges,
-				Motivations:     audience.Motivations,
-			}
-			if audience.IndustryContext != nil {
-				targetAudience.IndustryContext = *audience.IndustryContext
-			}
-			if audience.TypicalBackground != nil {
-				targetAudience.TypicalBackground = *audience.TypicalBackground
-			}
-		}
-	}
+	// Get target audiences
+	targetAudiences := s.loadTargetAudiences(ctx, genInput.TargetAudienceIDs)
 
 	// Update progress
 	job.ProgressPercent = 40
@@ -314,7 +490,10 @@ func (s *AIGenerationService) ProcessOutlineGenerationJob(ctx context.Context, j
 		log.Error("failed to update job progress", "progress", 40, "error", err)
 	}
 
-	// Check for cancellation before expensive AI call
+	// Check for worker shutdown or cancellation before expensive AI call
+	if ctx.Err() != nil {
+		return s.requeueInterruptedJob(ctx, job)
+	}
 	if s.checkJobCancelled(ctx, job.ID) {
 		log.Info("job cancelled before AI generation")
 		return s.markJobCancelled(ctx, job)
@@ -326,34 +505,89 @@ func (s *AIGenerationService) ProcessOutlineGenerationJob(ctx context.Context, j
 		additionalContext = *genInput.AdditionalContext
 	}
 
+	// The tenant may have reached its monthly token limit since the job was queued
+	if err := s.checkTokenBudget(ctx, job.TenantID); err != nil {
+		log.Warn("token budget check failed", "error", err)
+		return s.failJobWithError(ctx, job, "AI generation not started", err)
+	}
+
 	// Get tenant-specific AI provider
 	aiProvider, err := s.aiProviderFactory.GetProvider(ctx, job.TenantID)
 	if err != nil {
 		log.Error("failed to get AI provider", "error", err)
-		return s.failJob(ctx, job, fmt.Sprintf("failed to get AI provider: %v", err))
+		return s.failJobWithError(ctx, job, "failed to get AI provider", err)
 	}
 
-	outlineResult, err := aiProvider.GenerateCourseOutline(ctx, service.GenerateOutlineRequest{
+	outlineReq := service.GenerateOutlineRequest{
 		CourseTitle:       "", // Will be fetched or passed
 		DesiredOutcome:    genInput.DesiredOutcome,
 		SMEKnowledge:      smeKnowledge,
-		TargetAudience:    targetAudience,
+		CourseReferences:  references,
+		TargetAudiences:   targetAudiences,
 		AdditionalContext: additionalContext,
-	})
+		OnProgress:        s.providerProgress(ctx, job, 40, 70),
+	}
+	outlineConstraintsToRequest(&outlineReq, genInput.Constraints)
+
+	outlineResult, err := aiProvider.GenerateCourseOutline(ctx, outlineReq)
 	if err != nil {
+		if ctx.Err() != nil {
+			log.Info("outline generation interrupted")
+			return s.handleInterruptedJob(ctx, job, tokensUsedBeforeAbort(err))
+		}
 		log.Error("AI outline generation failed", "error", err)
-		return s.failJob(ctx, job, fmt.Sprintf("AI generation failed: %v", err))
+		return s.failJobWithError(ctx, job, "AI generation failed", err)
+	}
+
+	// Enforce the requested size: one corrective regeneration, then trim whatever is still over
+	if violation := outlineConstraintViolation(outlineResult.Sections, genInput.Constraints); violation != "" {
+		log.Info("outline exceeds constraints, regenerating", "violation", violation)
+
+		correctiveReq := outlineReq
+		correctiveReq.AdditionalContext = strings.TrimSpace(additionalContext + "\n\nA previous attempt was rejected because " + violation + ". Stay within the constraints.")
+
+		corrected, err := aiProvider.GenerateCourseOutline(ctx, correctiveReq)
+		if err != nil {
+			if ctx.Err() != nil {
+				log.Info("outline generation interrupted")
+				return s.handleInterruptedJob(ctx, job, outlineResult.TokensUsed+tokensUsedBeforeAbort(err))
+			}
+			log.Warn("corrective outline regeneration failed, trimming original", "error", err)
+		} else {
+			corrected.TokensUsed += outlineResult.TokensUsed
+			corrected.RepairAttempts += outlineResult.RepairAttempts
+			outlineResult = corrected
+		}
+
+		if violation := outlineConstraintViolation(outlineResult.Sections, genInput.Constraints); violation != "" {
+			log.Info("trimming outline to constraints", "violation", violation)
+			outlineResult.Sections = trimOutline(outlineResult.Sections, genInput.Constraints)
+		}
 	}
 
+	// The provider call has been paid for; persist the result even if a drain starts now
+	ctx = context.WithoutCancel(ctx)
+
 	// Update progress
 	job.ProgressPercent = 70
 	progressMsg = "Storing outline..."
 	job.ProgressMessage = &progressMsg
 	job.TokensUsed = outlineResult.TokensUsed
+	job.RepairAttempts += int32(outlineResult.RepairAttempts)
 	if err := s.jobRepo.Update(ctx, job); err != nil {
 		log.Error("failed to update job progress", "progress", 70, "error", err)
 	}
 
+	// The version this one replaces, if any, so matching lessons keep their identity
+	previous, err := s.outlineRepo.GetByCourseID(ctx, *job.CourseID)
+	if err == nil && previous != nil {
+		err = s.loadOutlineSections(ctx, previous)
+	}
+	if err != nil {
+		log.Warn("failed to load previous outline, lessons get new keys", "error", err)
+		previous = nil
+	}
+
 	// Get next version number for this course (handles regeneration after rejection)
 	nextVersion, err := s.outlineRepo.GetNextVersion(ctx, *job.CourseID)
 	if err != nil {
@@ -363,12 +597,13 @@ func (s *AIGenerationService) ProcessOutlineGenerationJob(ctx context.Context, j
 
 	// Build all entities first with pre-generated UUIDs for atomic creation
 	outline := &entity.CourseOutline{
-		ID:             uuid.New(),
-		TenantID:       job.TenantID,
-		CourseID:       *job.CourseID,
-		Version:        nextVersion,
-		ApprovalStatus: valueobject.OutlineApprovalStatusPendingReview,
-		GeneratedAt:    time.Now(),
+		ID:                uuid.New(),
+		TenantID:          job.TenantID,
+		CourseID:          *job.CourseID,
+		Version:           nextVersion,
+		ApprovalStatus:    valueobject.OutlineApprovalStatusPendingReview,
+		GeneratedAt:       time.Now(),
+		GeneratedByUserID: &job.CreatedByUserID,
 	}
 
 	var sections []entity.OutlineSection
@@ -399,18 +634,22 @@ func (s *AIGenerationService) ProcessOutlineGenerationJob(ctx context.Context, j
 				LearningObjectives:       lessonResult.LearningObjectives,
 				IsLastInSection:          lessonResult.IsLastInSection,
 				IsLastInCourse:           lessonResult.IsLastInCourse,
+				TargetAudiences:          lessonResult.TargetAudiences,
 				CreatedAt:                time.Now(),
 			}
 			lessons = append(lessons, lesson)
 		}
 	}
 
+	outline.LessonChanges = carryForwardLessonKeys(previous, lessons)
+
 	// Atomically create outline with all sections and lessons
 	// If any part fails, the entire operation is rolled back
 	if err := s.outlineRepo.CreateCompleteOutline(ctx, outline, sections, lessons); err != nil {
 		log.Error("failed to create outline atomically", "error", err)
 		return s.failJob(ctx, job, "failed to store outline")
 	}
+	s.invalidateCourseCaches(ctx, outline.CourseID)
 
 	// Update token usage
 	_ = s.aiSettingsRepo.IncrementTokenUsage(ctx, job.TenantID, outlineResult.TokensUsed)
@@ -422,28 +661,49 @@ func (s *AIGenerationService) ProcessOutlineGenerationJob(ctx context.Context, j
 		lessonCount += len(section.Lessons)
 	}
 
+	// Only outline jobs started with auto-approval have a parent
+	autoApprove := job.ParentJobID != nil
+	if autoApprove {
+		if s.checkJobCancelled(ctx, *job.ParentJobID) {
+			log.Info("full course job cancelled, leaving outline for review")
+			return s.markJobCancelled(ctx, job)
+		}
+		if err := s.startAutoApprovedLessons(ctx, job, outline, lessons); err != nil {
+			log.Error("failed to start lesson generation for auto-approved outline", "error", err)
+			return s.failJob(ctx, job, err.Error())
+		}
+	}
+
 	// Complete the job
 	job.Status = valueobject.GenerationJobStatusCompleted
 	job.ProgressPercent = 100
 	completedAt := time.Now()
 	job.CompletedAt = &completedAt
 	progressMsg = "Outline generation complete"
+	if changes := outline.LessonChanges; changes != nil {
+		progressMsg = fmt.Sprintf("Outline regenerated: %d lessons kept, %d new, %d removed",
+			len(changes.Kept), len(changes.Added), len(changes.Removed))
+	}
+	if autoApprove {
+		progressMsg = "Outline approved automatically"
+	}
 	job.ProgressMessage = &progressMsg
 	if err := s.jobRepo.Update(ctx, job); err != nil {
 		log.Error("failed to mark job as completed", "error", err)
 	}
 
-	// Send outline ready notification with email (tenant-isolated via user lookup)
-	if s.outlineNotifier != nil {
-		courseTitle := genInput.DesiredOutcome // Use desired outcome as course context
-		if len(courseTitle) > 50 {
-			courseTitle = courseTitle[:47] + "..."
-		}
+	// Send outline ready notification with email (tenant-isolated via user lookup).
+	// Auto-approved runs notify once, when the parent job finishes.
+	if s.outlineNotifier != nil && !autoApprove {
+		courseTitle := s.resolveCourseTitle(ctx, *job.CourseID, genInput)
 		if err := s.outlineNotifier.NotifyOutlineReady(ctx, job.CreatedByUserID, *job.CourseID, courseTitle, sectionCount, lessonCount); err != nil {
 			log.Error("failed to send outline ready notification", "error", err)
 		}
 	}
 
+	if changes := outline.LessonChanges; changes != nil {
+		log.Info("lessons matched to previous outline", "kept", len(changes.Kept), "new", len(changes.Added), "removed", len(changes.Removed))
+	}
 	log.Info("outline generation completed", "tokensUsed", outlineResult.TokensUsed, "sections", sectionCount, "lessons", lessonCount)
 	return nil
 }
@@ -460,10 +720,35 @@ func (s *AIGenerationService) GetCourseOutline(ctx context.Context, kratosID uui
 		return nil, domainerrors.ErrNotFound.WithMessage("outline not found")
 	}
 
-	// Load sections and lessons
+	if err := s.loadOutlineSections(ctx, outline); err != nil {
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	// Surface what was asked for so reviewers can judge the outline against it
+	if genInput, err := s.genInputRepo.GetByCourseID(ctx, courseID); err == nil && genInput != nil && !genInput.Constraints.IsEmpty() {
+		constraints := genInput.Constraints
+		outline.Constraints = &constraints
+	}
+
+	// Let the course page follow a running generation without listing jobs
+	activeRun, err := s.jobRepo.GetActiveFullCourseRun(ctx, courseID)
+	if err != nil {
+		s.logger.Warn("failed to look up active course generation", "courseID", courseID, "error", err)
+	}
+	outline.ActiveGenerationJob = activeRun
+
+	// Lets the approve button warn about open review threads
+	outline.UnresolvedComments = s.countUnresolvedComments(ctx, outline)
+
+	return outline, nil
+}
+
+// loadOutlineSections populates the outline's sections and their lessons.
+// Sections whose lessons fail to load are returned without lessons.
+func (s *AIGenerationService) loadOutlineSections(ctx context.Context, outline *entity.CourseOutline) error {
 	sections, err := s.sectionRepo.ListByOutlineID(ctx, outline.ID)
 	if err != nil {
-		return nil, domainerrors.ErrInternal.WithCause(err)
+		return err
 	}
 
 	for _, section := range sections {
@@ -481,11 +766,44 @@ func (s *AIGenerationService) GetCourseOutline(ctx context.Context, kratosID uui
 	for i, s := range sections {
 		outline.Sections[i] = *s
 	}
+	return nil
+}
 
-	return outline, nil
+// loadTargetAudiences resolves the selected audience profiles in the order they were
+// chosen. Audiences that no longer exist are skipped.
+func (s *AIGenerationService) loadTargetAudiences(ctx context.Context, ids []uuid.UUID) []service.TargetAudienceInput {
+	var audiences []service.TargetAudienceInput
+	for _, id := range ids {
+		audience, err := s.audienceRepo.GetByID(ctx, id)
+		if err != nil || audience == nil {
+			s.logger.Warn("target audience not found", "audienceID", id, "error", err)
+			continue
+		}
+
+		input := service.TargetAudienceInput{
+			Name:            audience.Name,
+			Role:            audience.Role,
+			ExperienceLevel: string(audience.ExperienceLevel),
+			LearningGoals:   audience.LearningGoals,
+			Prerequisites:   audience.Prerequisites,
+			Challenges:      audience.Challenges,
+			Motivations:     audience.Motivations,
+		}
+		if audience.IndustryContext != nil {
+			input.IndustryContext = *audience.IndustryContext
+		}
+		if audience.TypicalBackground != nil {
+			input.TypicalBackground = *audience.TypicalBackground
+		}
+		audiences = append(audiences, input)
+	}
+	return audiences
 }
 
-// ApproveCourseOutline approves an outline for content generation.
+// ApproveCourseOutline approves an outline for content generation. When the tenant
+// requires a second reviewer, the user who generated the outline can't approve it, and
+// the first other reviewer's approval is recorded as an endorsement; the outline is only
+// approved by a reviewer other than the endorser.
 func (s *AIGenerationService) ApproveCourseOutline(ctx context.Context, kratosID uuid.UUID, outlineID uuid.UUID) (*entity.CourseOutline, error) {
 	log := s.logger.With("kratosID", kratosID, "outlineID", outlineID)
 
@@ -498,16 +816,53 @@ func (s *AIGenerationService) ApproveCourseOutline(ctx context.Context, kratosID
 	if err != nil || outline == nil {
 		return nil, domainerrors.ErrNotFound.WithMessage("outline not found")
 	}
+	if err := s.checkCourseAccess(ctx, user, outline.CourseID); err != nil {
+		return nil, err
+	}
+
+	secondReview, err := s.secondReviewRequired(ctx, outline.TenantID)
+	if err != nil {
+		log.Error("failed to get AI settings", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	if secondReview {
+		completes, err := checkSecondReview(user, outline)
+		if err != nil {
+			log.Warn("rejected outline approval", "error", err)
+			return nil, err
+		}
+		if !completes {
+			return s.endorseCourseOutline(ctx, user, outline)
+		}
+	}
 
 	now := time.Now()
 	outline.ApprovalStatus = valueobject.OutlineApprovalStatusApproved
 	outline.ApprovedAt = &now
 	outline.ApprovedByUserID = &user.ID
 
-	if err := s.outlineRepo.Update(ctx, outline); err != nil {
+	var orphaned int
+	err = s.withinTx(ctx, func(ctx context.Context) error {
+		if err := s.outlineRepo.Update(ctx, outline); err != nil {
+			return fmt.Errorf("failed to approve outline: %w", err)
+		}
+		// Lessons generated for matched lessons of earlier outline versions follow them;
+		// the rest no longer belong in the course
+		orphaned, err = s.genLessonRepo.SyncOrphansWithOutline(ctx, outline.CourseID, outline.ID)
+		if err != nil {
+			return fmt.Errorf("failed to orphan superseded lessons: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
 		log.Error("failed to approve outline", "error", err)
 		return nil, domainerrors.ErrInternal.WithCause(err)
 	}
+	s.invalidateCourseCaches(ctx, outline.CourseID)
+	if orphaned > 0 {
+		log.Info("orphaned superseded lessons", "count", orphaned)
+		s.notifyLessonsOrphaned(ctx, user.ID, outline.CourseID, orphaned)
+	}
 
 	// Load sections and lessons to return complete outline
 	sections, err := s.sectionRepo.ListByOutlineID(ctx, outline.ID)
@@ -532,7 +887,7 @@ func (s *AIGenerationService) ApproveCourseOutline(ctx context.Context, kratosID
 		outline.Sections[i] = *sec
 	}
 
-	log.Info("outline approved", "sectionCount", len(outline.Sections))
+	log.Info("outline approved", "sectionCount", len(outline.Sections), "secondReview", secondReview)
 	return outline, nil
 }
 
@@ -549,9 +904,14 @@ func (s *AIGenerationService) RejectCourseOutline(ctx context.Context, kratosID
 	if err != nil || outline == nil {
 		return nil, domainerrors.ErrNotFound.WithMessage("outline not found")
 	}
+	if err := s.checkCourseAccess(ctx, user, outline.CourseID); err != nil {
+		return nil, err
+	}
 
 	outline.ApprovalStatus = valueobject.OutlineApprovalStatusRejected
 	outline.RejectionReason = &reason
+	outline.EndorsedAt = nil
+	outline.EndorsedByUserID = nil
 
 	if err := s.outlineRepo.Update(ctx, outline); err != nil {
 		log.Error("failed to reject outline", "error", err)
@@ -571,7 +931,8 @@ type UpdateCourseOutlineSection struct {
 	Lessons     []UpdateCourseOutlineLesson
 }
 
-// UpdateCourseOutlineLesson represents a lesson in the update request.
+// UpdateCourseOutlineLesson represents a lesson in the update request. A lesson with no
+// ID is added to its section.
 type UpdateCourseOutlineLesson struct {
 	ID                       uuid.UUID
 	Title                    string
@@ -581,8 +942,10 @@ type UpdateCourseOutlineLesson struct {
 	LearningObjectives       []string
 }
 
-// UpdateCourseOutline updates an existing outline before approval.
-func (s *AIGenerationService) UpdateCourseOutline(ctx context.Context, kratosID uuid.UUID, courseID, outlineID uuid.UUID, sections []UpdateCourseOutlineSection) (*entity.CourseOutline, error) {
+// UpdateCourseOutline updates an existing outline before approval. Lessons sent without an
+// ID are added; lessons in removedLessonIDs are deleted, orphaning any lessons already
+// generated for them.
+func (s *AIGenerationService) UpdateCourseOutline(ctx context.Context, kratosID uuid.UUID, courseID, outlineID uuid.UUID, sections []UpdateCourseOutlineSection, removedLessonIDs []uuid.UUID) (*entity.CourseOutline, error) {
 	log := s.logger.With("kratosID", kratosID, "outlineID", outlineID)
 
 	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
@@ -591,9 +954,12 @@ func (s *AIGenerationService) UpdateCourseOutline(ctx context.Context, kratosID
 	}
 
 	outline, err := s.outlineRepo.GetByID(ctx, outlineID)
-	if err != nil || outline == nil {
+	if err != nil || outline == nil || outline.CourseID != courseID {
 		return nil, domainerrors.ErrNotFound.WithMessage("outline not found")
 	}
+	if err := s.checkCourseAccess(ctx, user, outline.CourseID); err != nil {
+		return nil, err
+	}
 
 	// Only allow editing pending/revision-requested outlines
 	if outline.ApprovalStatus != valueobject.OutlineApprovalStatusPendingReview &&
@@ -601,40 +967,36 @@ func (s *AIGenerationService) UpdateCourseOutline(ctx context.Context, kratosID
 		return nil, domainerrors.ErrForbidden.WithMessage("can only edit pending or revision-requested outlines")
 	}
 
-	// Update sections and lessons
-	for _, sectionReq := range sections {
-		section, err := s.sectionRepo.GetByID(ctx, sectionReq.ID)
-		if err != nil {
-			continue // Skip if not found
-		}
-
-		section.Title = sectionReq.Title
-		section.Description = sectionReq.Description
-		section.Position = sectionReq.Order
-
-		if err := s.sectionRepo.Update(ctx, section); err != nil {
-			log.Error("failed to update section", "sectionID", section.ID, "error", err)
-			return nil, domainerrors.ErrInternal.WithCause(err)
-		}
+	// A request may only touch this outline's sections and their own lessons
+	if err := s.loadOutlineSections(ctx, outline); err != nil {
+		log.Error("failed to load outline sections", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	update, err := applyOutlineUpdate(outline, sections, removedLessonIDs)
+	if err != nil {
+		log.Warn("rejected outline update", "error", err)
+		return nil, err
+	}
 
-		// Update lessons within the section
-		for _, lessonReq := range sectionReq.Lessons {
-			lesson, err := s.lessonRepo.GetByID(ctx, lessonReq.ID)
+	// Content generated for removed lessons is orphaned rather than deleted with them
+	var orphaned int
+	err = s.withinTx(ctx, func(ctx context.Context) error {
+		if len(update.RemovedLessonIDs) > 0 {
+			orphaned, err = s.genLessonRepo.OrphanByOutlineLessonIDs(ctx, update.RemovedLessonIDs)
 			if err != nil {
-				continue // Skip if not found
-			}
-
-			lesson.Title = lessonReq.Title
-			lesson.Description = lessonReq.Description
-			lesson.Position = lessonReq.Order
-			lesson.EstimatedDurationMinutes = lessonReq.EstimatedDurationMinutes
-			lesson.LearningObjectives = lessonReq.LearningObjectives
-
-			if err := s.lessonRepo.Update(ctx, lesson); err != nil {
-				log.Error("failed to update lesson", "lessonID", lesson.ID, "error", err)
-				return nil, domainerrors.ErrInternal.WithCause(err)
+				return fmt.Errorf("failed to orphan removed lessons: %w", err)
 			}
 		}
+		return s.outlineRepo.UpdateOutlineContent(ctx, outline.ID, *update)
+	})
+	if err != nil {
+		log.Error("failed to update outline", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	s.invalidateCourseCaches(ctx, courseID)
+	if orphaned > 0 {
+		log.Info("orphaned lessons of removed outline lessons", "count", orphaned)
+		s.notifyLessonsOrphaned(ctx, user.ID, courseID, orphaned)
 	}
 
 	// Reload the outline
@@ -660,10 +1022,203 @@ func (s *AIGenerationService) UpdateCourseOutline(ctx context.Context, kratosID
 		outline.Sections[i] = *section
 	}
 
-	log.Info("outline updated", "sectionsCount", len(sections))
+	log.Info("outline updated", "sectionsCount", len(sections), "lessonsAdded", len(update.AddedLessons), "lessonsRemoved", len(update.RemovedLessonIDs))
 	return outline, nil
 }
 
+// applyOutlineUpdate applies the requested changes to copies of the outline's loaded
+// sections and lessons. It returns ErrInvalidInput naming every requested section that
+// isn't in the outline, every lesson that isn't in the section it was sent with, every
+// removed lesson that isn't in the outline or was also sent, and every section the
+// removals would leave without lessons.
+func applyOutlineUpdate(outline *entity.CourseOutline, sections []UpdateCourseOutlineSection, removedLessonIDs []uuid.UUID) (*entity.OutlineContentUpdate, error) {
+	existingSections := make(map[uuid.UUID]*entity.OutlineSection, len(outline.Sections))
+	lessonSections := make(map[uuid.UUID]uuid.UUID)
+	for i := range outline.Sections {
+		existingSections[outline.Sections[i].ID] = &outline.Sections[i]
+		for _, lesson := range outline.Sections[i].Lessons {
+			lessonSections[lesson.ID] = outline.Sections[i].ID
+		}
+	}
+
+	update := &entity.OutlineContentUpdate{}
+	var foreignSections, foreignLessons, foreignRemovals, keptRemovals []string
+
+	removed := make(map[uuid.UUID]bool, len(removedLessonIDs))
+	for _, id := range removedLessonIDs {
+		if _, ok := lessonSections[id]; !ok {
+			foreignRemovals = append(foreignRemovals, id.String())
+			continue
+		}
+		if !removed[id] {
+			removed[id] = true
+			update.RemovedLessonIDs = append(update.RemovedLessonIDs, id)
+		}
+	}
+
+	for _, sectionReq := range sections {
+		existing, ok := existingSections[sectionReq.ID]
+		if !ok {
+			foreignSections = append(foreignSections, sectionReq.ID.String())
+			continue
+		}
+
+		section := *existing
+		section.Title = sectionReq.Title
+		section.Description = sectionReq.Description
+		section.Position = sectionReq.Order
+		update.Sections = append(update.Sections, section)
+
+		existingLessons := make(map[uuid.UUID]entity.OutlineLesson, len(existing.Lessons))
+		for _, lesson := range existing.Lessons {
+			existingLessons[lesson.ID] = lesson
+		}
+		for _, lessonReq := range sectionReq.Lessons {
+			if lessonReq.ID == uuid.Nil {
+				id := uuid.New()
+				update.AddedLessons = append(update.AddedLessons, entity.OutlineLesson{
+					ID:                       id,
+					TenantID:                 existing.TenantID,
+					SectionID:                existing.ID,
+					LessonKey:                id,
+					Title:                    lessonReq.Title,
+					Description:              lessonReq.Description,
+					Position:                 lessonReq.Order,
+					EstimatedDurationMinutes: lessonReq.EstimatedDurationMinutes,
+					LearningObjectives:       lessonReq.LearningObjectives,
+				})
+				continue
+			}
+
+			lesson, ok := existingLessons[lessonReq.ID]
+			if !ok {
+				foreignLessons = append(foreignLessons, lessonReq.ID.String())
+				continue
+			}
+			if removed[lesson.ID] {
+				keptRemovals = append(keptRemovals, lesson.ID.String())
+				continue
+			}
+
+			lesson.Title = lessonReq.Title
+			lesson.Description = lessonReq.Description
+			lesson.Position = lessonReq.Order
+			lesson.EstimatedDurationMinutes = lessonReq.EstimatedDurationMinutes
+			lesson.LearningObjectives = lessonReq.LearningObjectives
+			update.Lessons = append(update.Lessons, lesson)
+		}
+	}
+
+	var problems []string
+	if len(foreignSections) > 0 {
+		problems = append(problems, "sections not in this outline: "+strings.Join(foreignSections, ", "))
+	}
+	if len(foreignLessons) > 0 {
+		problems = append(problems, "lessons not in their section: "+strings.Join(foreignLessons, ", "))
+	}
+	if len(foreignRemovals) > 0 {
+		problems = append(problems, "removed lessons not in this outline: "+strings.Join(foreignRemovals, ", "))
+	}
+	if len(keptRemovals) > 0 {
+		problems = append(problems, "lessons both removed and updated: "+strings.Join(keptRemovals, ", "))
+	}
+	if len(problems) == 0 {
+		problems = append(problems, markLastOutlineLessons(outline, update)...)
+	}
+	if len(problems) > 0 {
+		return nil, domainerrors.ErrInvalidInput.WithMessage(strings.Join(problems, "; "))
+	}
+	return update, nil
+}
+
+// markLastOutlineLessons sets the last-in-section and last-in-course flags of the outline
+// as it will be after the update, adding untouched lessons whose flags change to the
+// update. It returns a problem for every section the update would leave without lessons.
+func markLastOutlineLessons(outline *entity.CourseOutline, update *entity.OutlineContentUpdate) []string {
+	sections := make([]entity.OutlineSection, len(outline.Sections))
+	copy(sections, outline.Sections)
+	for _, updated := range update.Sections {
+		for i := range sections {
+			if sections[i].ID == updated.ID {
+				sections[i].Title, sections[i].Position = updated.Title, updated.Position
+			}
+		}
+	}
+	slices.SortStableFunc(sections, func(a, b entity.OutlineSection) int { return cmp.Compare(a.Position, b.Position) })
+
+	updated := make(map[uuid.UUID]int, len(update.Lessons))
+	for i, lesson := range update.Lessons {
+		updated[lesson.ID] = i
+	}
+	removed := make(map[uuid.UUID]bool, len(update.RemovedLessonIDs))
+	for _, id := range update.RemovedLessonIDs {
+		removed[id] = true
+	}
+
+	// Each lesson after the update: an index into update.Lessons or update.AddedLessons,
+	// or the loaded lesson itself
+	type finalLesson struct {
+		lesson  entity.OutlineLesson
+		updated int // -1 unless in update.Lessons
+		added   int // -1 unless in update.AddedLessons
+	}
+	var problems []string
+	var lastInCourse *finalLesson
+	var finals [][]finalLesson
+	for _, section := range sections {
+		var lessons []finalLesson
+		for _, lesson := range section.Lessons {
+			if removed[lesson.ID] {
+				continue
+			}
+			final := finalLesson{lesson: lesson, updated: -1, added: -1}
+			if i, ok := updated[lesson.ID]; ok {
+				final.lesson, final.updated = update.Lessons[i], i
+			}
+			lessons = append(lessons, final)
+		}
+		for i, lesson := range update.AddedLessons {
+			if lesson.SectionID == section.ID {
+				lessons = append(lessons, finalLesson{lesson: lesson, updated: -1, added: i})
+			}
+		}
+		if len(lessons) == 0 {
+			if len(section.Lessons) > 0 {
+				problems = append(problems, fmt.Sprintf("section %q would have no lessons left", section.Title))
+			}
+			continue
+		}
+		slices.SortStableFunc(lessons, func(a, b finalLesson) int { return cmp.Compare(a.lesson.Position, b.lesson.Position) })
+		finals = append(finals, lessons)
+		lastInCourse = &lessons[len(lessons)-1]
+	}
+	if len(problems) > 0 {
+		return problems
+	}
+
+	for _, lessons := range finals {
+		for i := range lessons {
+			final := &lessons[i]
+			lastInSection := i == len(lessons)-1
+			last := final == lastInCourse
+			switch {
+			case final.added >= 0:
+				update.AddedLessons[final.added].IsLastInSection = lastInSection
+				update.AddedLessons[final.added].IsLastInCourse = last
+			case final.updated >= 0:
+				update.Lessons[final.updated].IsLastInSection = lastInSection
+				update.Lessons[final.updated].IsLastInCourse = last
+			case final.lesson.IsLastInSection != lastInSection || final.lesson.IsLastInCourse != last:
+				lesson := final.lesson
+				lesson.IsLastInSection = lastInSection
+				lesson.IsLastInCourse = last
+				update.Lessons = append(update.Lessons, lesson)
+			}
+		}
+	}
+	return nil
+}
+
 // GenerateLessonContentRequest contains inputs for lesson content generation.
 type GenerateLessonContentRequest struct {
 	CourseID        uuid.UUID
@@ -688,6 +1243,10 @@ func (s *AIGenerationService) GenerateLessonContent(ctx context.Context, kratosI
 		return nil, domainerrors.ErrUserHasNoCompany
 	}
 
+	if err := s.checkCourseAccess(ctx, user, req.CourseID); err != nil {
+		return nil, err
+	}
+
 	// Verify outline is approved
 	outline, err := s.outlineRepo.GetByCourseID(ctx, req.CourseID)
 	if err != nil || outline == nil {
@@ -698,6 +1257,10 @@ func (s *AIGenerationService) GenerateLessonContent(ctx context.Context, kratosI
 		return nil, domainerrors.ErrInvalidInput.WithMessage("outline must be approved before generating content")
 	}
 
+	if err := s.checkAIProvider(ctx, *user.TenantID); err != nil {
+		return nil, err
+	}
+
 	// Create the job
 	job := &entity.GenerationJob{
 		ID:              uuid.New(),
@@ -735,6 +1298,11 @@ func (s *AIGenerationService) GenerateLessonContent(ctx context.Context, kratosI
 func (s *AIGenerationService) ProcessLessonGenerationJob(ctx context.Context, job *entity.GenerationJob) error {
 	log := s.logger.With("jobID", job.ID, "outlineLessonID", job.OutlineLessonID)
 
+	// Worker is draining - hand the job back before doing any work
+	if ctx.Err() != nil {
+		return s.requeueInterruptedJob(ctx, job)
+	}
+
 	// Check for cancellation at start (e.g., parent job was cancelled)
 	if s.checkJobCancelled(ctx, job.ID) {
 		log.Info("job already cancelled, skipping processing")
@@ -770,8 +1338,10 @@ func (s *AIGenerationService) ProcessLessonGenerationJob(ctx context.Context, jo
 		return s.failJob(ctx, job, "generation input not found")
 	}
 
-	// Gather SME knowledge (similar to outline generation)
+	// Gather SME knowledge (similar to outline generation). chunkIDs lines up with the
+	// chunks in the order the provider indexes them for citations.
 	smeKnowledge := make([]service.SMEKnowledgeInput, 0)
+	var chunkIDs []uuid.UUID
 	for _, smeID := range genInput.SMEIDs {
 		sme, err := s.smeRepo.GetByID(ctx, smeID)
 		if err != nil || sme == nil {
@@ -779,15 +1349,14 @@ func (s *AIGenerationService) ProcessLessonGenerationJob(ctx context.Context, jo
 		}
 
 		chunks, _ := s.smeKnowledgeRepo.ListBySMEID(ctx, smeID)
+		chunks = generationChunks(chunks)
 		chunkTexts := make([]string, len(chunks))
 		for i, chunk := range chunks {
 			chunkTexts[i] = chunk.Content
+			chunkIDs = append(chunkIDs, chunk.ID)
 		}
 
-		summary := ""
-		if sme.KnowledgeSummary != nil {
-			summary = *sme.KnowledgeSummary
-		}
+		summary := s.promptKnowledgeSummary(sme)
 
 		smeKnowledge = append(smeKnowledge, service.SMEKnowledgeInput{
 			SMEName: sme.Name,
@@ -797,21 +1366,11 @@ func (s *AIGenerationService) ProcessLessonGenerationJob(ctx context.Context, jo
 		})
 	}
 
-	// Get target audience
-	var targetAudience service.TargetAudienceInput
-	if len(genInput.TargetAudienceIDs) > 0 {
-		audience, _ := s.audienceRepo.GetByID(ctx, genInput.TargetAudienceIDs[0])
-		if audience != nil {
-			targetAudience = service.TargetAudienceInput{
-				Role:            audience.Role,
-				ExperienceLevel: string(audience.ExperienceLevel),
-				LearningGoals:   audience.LearningGoals,
-				Prerequisites:   audience.Prerequisites,
-				Challenges:      audience.Challenges,
-				Motivations:     audience.Motivations,
-			}
-		}
-	}
+	topic := strings.Join(append([]string{outlineLesson.Title, outlineLesson.Description}, outlineLesson.LearningObjectives...), " ")
+	references := s.courseReferenceInputs(ctx, *job.CourseID, topic, lessonReferenceExcerpts)
+
+	// Get target audiences
+	targetAudiences := s.loadTargetAudiences(ctx, genInput.TargetAudienceIDs)
 
 	// Update progress
 	job.ProgressPercent = 30
@@ -821,17 +1380,26 @@ func (s *AIGenerationService) ProcessLessonGenerationJob(ctx context.Context, jo
 		log.Error("failed to update job progress", "progress", 30, "error", err)
 	}
 
-	// Check for cancellation before expensive AI call
+	// Check for worker shutdown or cancellation before expensive AI call
+	if ctx.Err() != nil {
+		return s.requeueInterruptedJob(ctx, job)
+	}
 	if s.checkJobCancelled(ctx, job.ID) {
 		log.Info("job cancelled before AI generation")
 		return s.markJobCancelled(ctx, job)
 	}
 
+	// The tenant may have reached its monthly token limit since the job was queued
+	if err := s.checkTokenBudget(ctx, job.TenantID); err != nil {
+		log.Warn("token budget check failed", "error", err)
+		return s.failJobWithError(ctx, job, "AI generation not started", err)
+	}
+
 	// Get tenant-specific AI provider
 	aiProvider, err := s.aiProviderFactory.GetProvider(ctx, job.TenantID)
 	if err != nil {
 		log.Error("failed to get AI provider", "error", err)
-		return s.failJob(ctx, job, fmt.Sprintf("failed to get AI provider: %v", err))
+		return s.failJobWithError(ctx, job, "failed to get AI provider", err)
 	}
 
 	// Generate lesson content
@@ -842,24 +1410,55 @@ func (s *AIGenerationService) ProcessLessonGenerationJob(ctx context.Context, jo
 		LessonDescription:  outlineLesson.Description,
 		LearningObjectives: outlineLesson.LearningObjectives,
 		SMEKnowledge:       smeKnowledge,
-		TargetAudience:     targetAudience,
+		CourseReferences:   references,
+		TargetAudiences:    targetAudiences,
+		LessonAudiences:    outlineLesson.TargetAudiences,
 		IsLastInSection:    outlineLesson.IsLastInSection,
 		IsLastInCourse:     outlineLesson.IsLastInCourse,
+
+		IncludeQuiz:              genInput.Preferences.QuizAllowed(outlineLesson.IsLastInSection, outlineLesson.IsLastInCourse),
+		IncludeImages:            genInput.Preferences.IncludeImages,
+		IncludeReflectionPrompts: genInput.Preferences.IncludeReflectionPrompts,
+		MinComponents:            int(genInput.Preferences.MinComponents),
+		MaxComponents:            int(genInput.Preferences.MaxComponents),
+		OnProgress:               s.providerProgress(ctx, job, 30, 70),
 	})
 	if err != nil {
+		if ctx.Err() != nil {
+			log.Info("lesson generation interrupted")
+			return s.handleInterruptedJob(ctx, job, tokensUsedBeforeAbort(err))
+		}
 		log.Error("AI lesson generation failed", "error", err)
-		return s.failJob(ctx, job, fmt.Sprintf("AI generation failed: %v", err))
+		return s.failJobWithError(ctx, job, "AI generation failed", err)
 	}
 
+	// The provider call has been paid for; persist the result even if a drain starts now.
+	// Images are optional, so generating them still stops when the worker does.
+	workerCtx := ctx
+	ctx = context.WithoutCancel(ctx)
+
 	// Update progress
 	job.ProgressPercent = 70
 	progressMsg = "Storing lesson content..."
 	job.ProgressMessage = &progressMsg
 	job.TokensUsed = lessonResult.TokensUsed
+	job.RepairAttempts += int32(lessonResult.RepairAttempts)
 	if err := s.jobRepo.Update(ctx, job); err != nil {
 		log.Error("failed to update job progress", "progress", 70, "error", err)
 	}
 
+	// The provider may ignore the component plan; drop anything the course doesn't allow
+	components, removed := filterLessonComponents(lessonResult.Components, genInput.Preferences, outlineLesson.IsLastInSection, outlineLesson.IsLastInCourse)
+	if removed > 0 {
+		log.Info("removed disallowed or invalid lesson components", "removed", removed)
+	}
+
+	// The provider may also miss the target component range; one more call fits it
+	if workerCtx.Err() == nil {
+		components = s.fitLessonComponentCount(workerCtx, aiProvider, job, outlineLesson, components, genInput.Preferences)
+	}
+	componentCount := int32(len(components))
+
 	// Create generated lesson
 	genLesson := &entity.GeneratedLesson{
 		ID:              uuid.New(),
@@ -868,6 +1467,7 @@ func (s *AIGenerationService) ProcessLessonGenerationJob(ctx context.Context, jo
 		SectionID:       section.ID,
 		OutlineLessonID: outlineLesson.ID,
 		Title:           outlineLesson.Title,
+		ComponentCount:  &componentCount,
 		GeneratedAt:     time.Now(),
 	}
 	if lessonResult.SegueText != "" {
@@ -879,9 +1479,23 @@ func (s *AIGenerationService) ProcessLessonGenerationJob(ctx context.Context, jo
 		return s.failJob(ctx, job, "failed to store lesson")
 	}
 
+	generateImages := genInput.Preferences.GenerateImages && s.assetStorage != nil
+	if generateImages && slices.ContainsFunc(components, func(c service.LessonComponentResult) bool {
+		return c.Type == valueobject.LessonComponentTypeImage.String()
+	}) {
+		progressMsg = "Generating images..."
+		if _, err := s.jobRepo.UpdateProgress(ctx, job.ID, job.ProgressPercent, progressMsg); err != nil {
+			log.Error("failed to update job progress", "error", err)
+		}
+	}
+
 	// Create components
-	for _, compResult := range lessonResult.Components {
+	for _, compResult := range components {
 		compType, _ := valueobject.ParseLessonComponentType(compResult.Type)
+		sourceIDs, invalid := sourceChunkIDs(compResult.SourceChunkIndices, chunkIDs)
+		if invalid > 0 {
+			log.Warn("dropped invalid source chunk citations", "order", compResult.Order, "invalid", invalid)
+		}
 		component := &entity.LessonComponent{
 			ID:          uuid.New(),
 			TenantID:    job.TenantID,
@@ -889,17 +1503,37 @@ func (s *AIGenerationService) ProcessLessonGenerationJob(ctx context.Context, jo
 			Type:        compType,
 			Position:    int32(compResult.Order),
 			ContentJSON: json.RawMessage(compResult.ContentJSON),
+			SMEChunkIDs: sourceIDs,
 			CreatedAt:   time.Now(),
 			UpdatedAt:   time.Now(),
 		}
 
+		// A missing image never fails the lesson; the description stays as a placeholder
+		if generateImages && compType == valueobject.LessonComponentTypeImage && workerCtx.Err() == nil {
+			if err := s.generateComponentImage(workerCtx, aiProvider, genLesson.CourseID, component); err != nil {
+				log.Warn("image generation failed, keeping description", "componentID", component.ID, "error", err)
+			} else {
+				job.ImagesGenerated++
+			}
+		}
+
+		// Runs after image generation so the alt text describes the picture actually stored
+		if compType == valueobject.LessonComponentTypeImage && workerCtx.Err() == nil {
+			tokens, err := s.generateComponentAltText(workerCtx, aiProvider, genLesson.Title, component)
+			if err != nil {
+				log.Warn("alt text generation failed, flagged for the author", "componentID", component.ID, "error", err)
+			}
+			job.TokensUsed += tokens
+		}
+
 		if err := s.componentRepo.Create(ctx, component); err != nil {
 			log.Error("failed to create component", "error", err)
 		}
 	}
+	s.invalidateCourseCaches(ctx, genLesson.CourseID)
 
-	// Update token usage
-	_ = s.aiSettingsRepo.IncrementTokenUsage(ctx, job.TenantID, lessonResult.TokensUsed)
+	// Update token usage, including alt text
+	_ = s.aiSettingsRepo.IncrementTokenUsage(ctx, job.TenantID, job.TokensUsed)
 
 	// Complete the job
 	job.Status = valueobject.GenerationJobStatusCompleted
@@ -915,12 +1549,12 @@ func (s *AIGenerationService) ProcessLessonGenerationJob(ctx context.Context, jo
 	// Only notify for standalone lesson generation (not part of full course generation)
 	// Full course generation sends ONE notification when all lessons are done
 	if s.notifier != nil && job.ParentJobID == nil {
-		if err := s.notifier.NotifyJobProgress(ctx, job.CreatedByUserID, job.ID, "Lesson Content", "completed", 100); err != nil {
+		if err := s.notifier.NotifyJobProgress(ctx, job.CreatedByUserID, job.ID, job.CourseID, "Lesson Content", "completed", 100); err != nil {
 			log.Error("failed to send completion notification", "error", err)
 		}
 	}
 
-	log.Info("lesson generation completed", "tokensUsed", lessonResult.TokensUsed)
+	log.Info("lesson generation completed", "tokensUsed", job.TokensUsed, "imagesGenerated", job.ImagesGenerated)
 
 	// Check if this job has a parent and if all siblings are complete
 	if job.ParentJobID != nil {
@@ -981,6 +1615,7 @@ func (s *AIGenerationService) checkAndCompleteParentJob(ctx context.Context, par
 		parentJob.ProgressPercent = progressPercent
 		parentJob.ProgressMessage = &progressMsg
 		parentJob.TokensUsed = result.TotalTokens
+		parentJob.ImagesGenerated = result.TotalImages
 
 		if err := s.jobRepo.Update(ctx, parentJob); err != nil {
 			log.Error("failed to update parent job progress", "progress", progressPercent, "error", err)
@@ -1001,9 +1636,9 @@ func (s *AIGenerationService) checkAndCompleteParentJob(ctx context.Context, par
 	}
 
 	// Get course title for notification
-	courseTitle := "Course"
+	courseTitle := defaultCourseTitle
 	if parentJob.CourseID != nil {
-		courseTitle = "Your Course"
+		courseTitle = s.resolveCourseTitle(ctx, *parentJob.CourseID, nil)
 	}
 
 	// Send appropriate notification based on result
@@ -1017,7 +1652,8 @@ func (s *AIGenerationService) checkAndCompleteParentJob(ctx context.Context, par
 		log.Info("parent job marked as failed", "failedCount", result.FailedCount)
 	} else {
 		if s.completionNotifier != nil && parentJob.CourseID != nil {
-			if err := s.completionNotifier.NotifyCourseComplete(ctx, parentJob.CreatedByUserID, *parentJob.CourseID, courseTitle); err != nil {
+			summary := s.courseSummary(ctx, *parentJob.CourseID)
+			if err := s.completionNotifier.NotifyCourseComplete(ctx, parentJob.CreatedByUserID, *parentJob.CourseID, courseTitle, summary); err != nil {
 				log.Error("failed to send course completion notification", "error", err)
 			}
 		}
@@ -1027,14 +1663,36 @@ func (s *AIGenerationService) checkAndCompleteParentJob(ctx context.Context, par
 	return nil
 }
 
-// GenerateAllLessonsResult contains the created job.
-type GenerateAllLessonsResult struct {
-	Job *entity.GenerationJob
+// courseSummary rolls up the course's latest outline for its completion notification.
+// A course whose outline can't be loaded gets an empty summary.
+func (s *AIGenerationService) courseSummary(ctx context.Context, courseID uuid.UUID) CourseSummary {
+	outline, err := s.outlineRepo.GetByCourseID(ctx, courseID)
+	if err != nil || outline == nil {
+		return CourseSummary{}
+	}
+	if err := s.loadOutlineSections(ctx, outline); err != nil {
+		s.logger.Warn("failed to load outline for course summary", "courseID", courseID, "error", err)
+		return CourseSummary{}
+	}
+	return CourseSummary{
+		SectionCount:         len(outline.Sections),
+		LessonCount:          outline.LessonCount(),
+		TotalDurationMinutes: outline.TotalDurationMinutes(),
+	}
+}
+
+// GenerateAllLessonsResult contains the created job.
+type GenerateAllLessonsResult struct {
+	Job            *entity.GenerationJob
+	AlreadyRunning bool   // Job is a run that was already in progress; nothing new was started
+	StartedByName  string // Who started Job when AlreadyRunning, if known
 }
 
 // GenerateAllLessons starts lesson content generation jobs for all lessons in the course.
-// Creates a FULL_COURSE parent job to track overall completion.
-func (s *AIGenerationService) GenerateAllLessons(ctx context.Context, kratosID uuid.UUID, courseID uuid.UUID) (*GenerateAllLessonsResult, error) {
+// Creates a FULL_COURSE parent job to track overall completion. If the course already has
+// a full course run in progress, that run is returned instead of starting another.
+// A non-nil prefs replaces the course's generation preferences before the jobs are queued.
+func (s *AIGenerationService) GenerateAllLessons(ctx context.Context, kratosID uuid.UUID, courseID uuid.UUID, prefs *entity.GenerationPreferences) (*GenerateAllLessonsResult, error) {
 	log := s.logger.With("kratosID", kratosID, "courseID", courseID)
 
 	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
@@ -1046,6 +1704,20 @@ func (s *AIGenerationService) GenerateAllLessons(ctx context.Context, kratosID u
 		return nil, domainerrors.ErrUserHasNoCompany
 	}
 
+	if err := s.checkCourseAccess(ctx, user, courseID); err != nil {
+		return nil, err
+	}
+
+	// Checked before preferences are saved so a running run's settings aren't changed under it
+	activeRun, err := s.jobRepo.GetActiveFullCourseRun(ctx, courseID)
+	if err != nil {
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	if activeRun != nil {
+		log.Info("course generation already running", "parentJobID", activeRun.ID)
+		return s.runningCourseGeneration(ctx, activeRun), nil
+	}
+
 	// Get the approved outline for the course
 	outline, err := s.outlineRepo.GetByCourseID(ctx, courseID)
 	if err != nil || outline == nil {
@@ -1056,6 +1728,29 @@ func (s *AIGenerationService) GenerateAllLessons(ctx context.Context, kratosID u
 		return nil, domainerrors.ErrForbidden.WithMessage("outline must be approved before generating lessons")
 	}
 
+	if err := s.checkAIProvider(ctx, *user.TenantID); err != nil {
+		return nil, err
+	}
+
+	if prefs != nil {
+		if !prefs.QuizFrequency.IsValid() {
+			return nil, domainerrors.ErrInvalidInput.WithMessage("invalid quiz frequency")
+		}
+		if !prefs.ComponentBoundsValid() {
+			return nil, domainerrors.ErrInvalidInput.WithMessage(componentBoundsMessage)
+		}
+		genInput, err := s.genInputRepo.GetByCourseID(ctx, courseID)
+		if err != nil || genInput == nil {
+			return nil, domainerrors.ErrNotFound.WithMessage("generation input not found")
+		}
+		genInput.Preferences = *prefs
+		if err := s.genInputRepo.Update(ctx, genInput); err != nil {
+			log.Error("failed to update generation preferences", "error", err)
+			return nil, domainerrors.ErrInternal.WithCause(err)
+		}
+		s.recordCoursePreferences(ctx, courseID, *prefs)
+	}
+
 	// Load sections with lessons
 	loadedSections, err := s.sectionRepo.ListByOutlineID(ctx, outline.ID)
 	if err != nil {
@@ -1100,46 +1795,195 @@ func (s *AIGenerationService) GenerateAllLessons(ctx context.Context, kratosID u
 	progressMsg := fmt.Sprintf("Generating %d lessons...", totalLessons)
 	parentJob.ProgressMessage = &progressMsg
 
-	if err := s.jobRepo.Create(ctx, parentJob); err != nil {
-		log.Error("failed to create parent job", "error", err)
-		return nil, domainerrors.ErrInternal.WithCause(err)
-	}
-
 	// Build all child jobs first with pre-generated UUIDs
-	var childJobs []*entity.GenerationJob
+	var outlineLessons []entity.OutlineLesson
 	for _, section := range outline.Sections {
-		for _, lesson := range section.Lessons {
-			outlineLessonID := lesson.ID
-			job := &entity.GenerationJob{
-				ID:              uuid.New(),
-				TenantID:        *user.TenantID,
-				Type:            valueobject.GenerationJobTypeLessonContent,
-				Status:          valueobject.GenerationJobStatusQueued,
-				CourseID:        &courseID,
-				OutlineLessonID: &outlineLessonID, // References outline_lessons table
-				ParentJobID:     &parentJob.ID,    // Link to parent job
-				ProgressPercent: 0,
-				MaxRetries:      3,
-				CreatedByUserID: user.ID,
-				CreatedAt:       time.Now(),
-			}
-			childJobs = append(childJobs, job)
-		}
+		outlineLessons = append(outlineLessons, section.Lessons...)
 	}
+	childJobs := newLessonJobs(parentJob, outlineLessons)
 
-	// Atomically create all child jobs in a single transaction
-	// If any fails, all are rolled back and we fail the parent job
-	if err := s.jobRepo.CreateBatch(ctx, childJobs); err != nil {
-		log.Error("failed to create lesson jobs atomically", "error", err)
-		// Fail the parent job since no children were created
-		_ = s.failJob(ctx, parentJob, fmt.Sprintf("failed to queue lesson jobs: %v", err))
+	// Create the parent and all child jobs in a single transaction, recording how many
+	// children the parent expects, unless a run that started since the check above got
+	// there first
+	run, err := s.jobRepo.CreateFullCourseRun(ctx, parentJob, childJobs)
+	if err != nil {
+		log.Error("failed to create course generation jobs", "error", err)
 		return nil, domainerrors.ErrInternal.WithMessage("failed to queue lesson generation jobs")
 	}
+	if run.ID != parentJob.ID {
+		log.Info("course generation already running", "parentJobID", run.ID)
+		return s.runningCourseGeneration(ctx, run), nil
+	}
+
+	// Push after commit; children whose enqueue fails are swept up and enqueued later
+	s.enqueueChildJobs(ctx, childJobs)
 
 	log.Info("queued all lesson generation jobs", "totalLessons", totalLessons, "parentJobID", parentJob.ID)
 	return &GenerateAllLessonsResult{Job: parentJob}, nil
 }
 
+// runningCourseGeneration describes a full course run that was already in progress when
+// another was requested, naming who started it when that can be looked up.
+func (s *AIGenerationService) runningCourseGeneration(ctx context.Context, run *entity.GenerationJob) *GenerateAllLessonsResult {
+	return &GenerateAllLessonsResult{
+		Job:            run,
+		AlreadyRunning: true,
+		StartedByName:  s.userDisplayName(ctx, run.CreatedByUserID),
+	}
+}
+
+// userDisplayName returns a user's full name, or their email when they have no name.
+// Returns "" when the user or their identity cannot be looked up.
+func (s *AIGenerationService) userDisplayName(ctx context.Context, userID uuid.UUID) string {
+	if s.identity == nil {
+		return ""
+	}
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil || user == nil {
+		return ""
+	}
+	identity, err := s.identity.GetIdentity(ctx, user.KratosID.String())
+	if err != nil || identity == nil {
+		s.logger.Warn("failed to get user identity", "userID", user.ID, "error", err)
+		return ""
+	}
+	if name := strings.TrimSpace(identity.FirstName + " " + identity.LastName); name != "" {
+		return name
+	}
+	return identity.Email
+}
+
+// newLessonJobs builds a queued lesson content job under parent for each outline lesson.
+func newLessonJobs(parent *entity.GenerationJob, lessons []entity.OutlineLesson) []*entity.GenerationJob {
+	jobs := make([]*entity.GenerationJob, 0, len(lessons))
+	for _, lesson := range lessons {
+		outlineLessonID := lesson.ID
+		jobs = append(jobs, &entity.GenerationJob{
+			ID:              uuid.New(),
+			TenantID:        parent.TenantID,
+			Type:            valueobject.GenerationJobTypeLessonContent,
+			Status:          valueobject.GenerationJobStatusQueued,
+			CourseID:        parent.CourseID,
+			OutlineLessonID: &outlineLessonID, // References outline_lessons table
+			ParentJobID:     &parent.ID,       // Link to parent job
+			ProgressPercent: 0,
+			MaxRetries:      3,
+			CreatedByUserID: parent.CreatedByUserID,
+			CreatedAt:       time.Now(),
+		})
+	}
+	return jobs
+}
+
+// RetryFailedLessonsRequest identifies the full course run to retry.
+type RetryFailedLessonsRequest struct {
+	JobID    *uuid.UUID // Parent full_course job
+	CourseID *uuid.UUID // Used when JobID is nil: the course's latest full course run
+}
+
+// RetryFailedLessonsResult contains the reopened parent job.
+type RetryFailedLessonsResult struct {
+	Job          *entity.GenerationJob
+	RetriedCount int
+}
+
+// RetryFailedLessons requeues the failed lesson jobs of a failed full course run under the
+// same parent and reopens the parent, which is finalized again once the retries end.
+func (s *AIGenerationService) RetryFailedLessons(ctx context.Context, kratosID uuid.UUID, req RetryFailedLessonsRequest) (*RetryFailedLessonsResult, error) {
+	log := s.logger.With("kratosID", kratosID)
+
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, domainerrors.ErrUserNotFound
+	}
+
+	if user.TenantID == nil {
+		return nil, domainerrors.ErrUserHasNoCompany
+	}
+
+	fullCourse := valueobject.GenerationJobTypeFullCourse
+	var parentJob *entity.GenerationJob
+	switch {
+	case req.JobID != nil:
+		parentJob, err = s.jobRepo.GetByID(ctx, *req.JobID)
+		if err != nil || parentJob == nil {
+			return nil, domainerrors.ErrGenerationJobNotFound
+		}
+		if parentJob.Type != fullCourse || parentJob.CourseID == nil {
+			return nil, domainerrors.ErrInvalidInput.WithMessage("job is not a full course generation")
+		}
+	case req.CourseID != nil:
+		runs, err := s.jobRepo.List(ctx, entity.GenerationJobListOptions{Type: &fullCourse, CourseID: req.CourseID})
+		if err != nil {
+			return nil, domainerrors.ErrInternal.WithCause(err)
+		}
+		if len(runs) == 0 {
+			return nil, domainerrors.ErrGenerationJobNotFound.WithMessage("no full course generation found for course")
+		}
+		parentJob = runs[0] // Most recent first
+	default:
+		return nil, domainerrors.ErrInvalidInput.WithMessage("job ID or course ID is required")
+	}
+
+	courseID := *parentJob.CourseID
+	log = log.With("parentJobID", parentJob.ID, "courseID", courseID)
+
+	if err := s.checkCourseAccess(ctx, user, courseID); err != nil {
+		return nil, err
+	}
+
+	runs, err := s.jobRepo.List(ctx, entity.GenerationJobListOptions{Type: &fullCourse, CourseID: &courseID})
+	if err != nil {
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	for _, run := range runs {
+		if run.Status == valueobject.GenerationJobStatusQueued || run.Status == valueobject.GenerationJobStatusProcessing {
+			return nil, domainerrors.ErrBadRequest.WithMessage("a full course generation is already running for this course")
+		}
+	}
+
+	if parentJob.Status != valueobject.GenerationJobStatusFailed {
+		return nil, domainerrors.ErrBadRequest.WithMessage("only failed course generations can be retried")
+	}
+
+	if err := s.checkAIProvider(ctx, *user.TenantID); err != nil {
+		return nil, err
+	}
+
+	children, err := s.jobRepo.ListByParentID(ctx, parentJob.ID)
+	if err != nil {
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	requeued, err := s.jobRepo.RequeueFailedChildren(ctx, parentJob.ID)
+	if err != nil {
+		log.Error("failed to requeue failed lesson jobs", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	if len(requeued) == 0 {
+		return nil, domainerrors.ErrBadRequest.WithMessage("no failed lessons to retry")
+	}
+
+	// Reflect the lessons still to generate; finalization updates progress from here on
+	parentJob, err = s.jobRepo.GetByID(ctx, parentJob.ID)
+	if err != nil || parentJob == nil {
+		return nil, domainerrors.ErrInternal.WithMessage("failed to reload parent job")
+	}
+	progressMsg := fmt.Sprintf("Retrying %d failed lesson(s)...", len(requeued))
+	parentJob.ProgressMessage = &progressMsg
+	if len(children) > 0 {
+		parentJob.ProgressPercent = int32(10 + 90*(len(children)-len(requeued))/len(children))
+	}
+	if err := s.jobRepo.Update(ctx, parentJob); err != nil {
+		log.Error("failed to update parent job progress", "error", err)
+	}
+
+	s.enqueueChildJobs(ctx, requeued)
+
+	log.Info("retrying failed lesson jobs", "count", len(requeued))
+	return &RetryFailedLessonsResult{Job: parentJob, RetriedCount: len(requeued)}, nil
+}
+
 // RegenerateComponentRequest contains inputs for component regeneration.
 type RegenerateComponentRequest struct {
 	CourseID           uuid.UUID
@@ -1166,6 +2010,13 @@ func (s *AIGenerationService) RegenerateComponent(ctx context.Context, kratosID
 		return nil, domainerrors.ErrUserHasNoCompany
 	}
 
+	if err := s.checkCourseAccess(ctx, user, req.CourseID); err != nil {
+		return nil, err
+	}
+	if err := s.checkCourseUnlocked(ctx, req.CourseID); err != nil {
+		return nil, err
+	}
+
 	// Verify the component exists
 	component, err := s.componentRepo.GetByID(ctx, req.ComponentID)
 	if err != nil || component == nil {
@@ -1178,6 +2029,10 @@ func (s *AIGenerationService) RegenerateComponent(ctx context.Context, kratosID
 		return nil, domainerrors.ErrNotFound.WithMessage("lesson not found")
 	}
 
+	if err := s.checkAIProvider(ctx, *user.TenantID); err != nil {
+		return nil, err
+	}
+
 	// Create the regeneration job
 	job := &entity.GenerationJob{
 		ID:              uuid.New(),
@@ -1221,6 +2076,243 @@ func (s *AIGenerationService) RegenerateComponent(ctx context.Context, kratosID
 	return &RegenerateComponentResult{Job: job}, nil
 }
 
+// Inline edit limits. Inline edits run synchronously inside the request, so they
+// are bounded tightly and rate-limited per user.
+const (
+	inlineEditTimeout    = 20 * time.Second
+	inlineEditRateLimit  = 10
+	inlineEditRateWindow = time.Minute
+)
+
+// EditComponentTextRequest contains inputs for an inline component edit.
+type EditComponentTextRequest struct {
+	ComponentID uuid.UUID
+	Instruction string // e.g. "shorten to two sentences"
+}
+
+// EditComponentTextResult contains the proposed component content.
+// Nothing is saved; the client persists the content if the user accepts it.
+type EditComponentTextResult struct {
+	Component   *entity.LessonComponent
+	ContentJSON string
+	TokensUsed  int64
+	CacheHit    bool // Reused a recent response to the same prompt; no tokens were spent
+}
+
+// EditComponentText rewrites a text or heading component inline, without a background job.
+// The provider call is bounded by inlineEditTimeout; on timeout the caller should fall back
+// to RegenerateComponent.
+func (s *AIGenerationService) EditComponentText(ctx context.Context, kratosID uuid.UUID, req EditComponentTextRequest) (*EditComponentTextResult, error) {
+	log := s.logger.With("kratosID", kratosID, "componentID", req.ComponentID)
+
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, domainerrors.ErrUserNotFound
+	}
+
+	if user.TenantID == nil {
+		return nil, domainerrors.ErrUserHasNoCompany
+	}
+
+	instruction := strings.TrimSpace(req.Instruction)
+	if instruction == "" {
+		return nil, domainerrors.ErrMissingRequired.WithMessage("instruction is required")
+	}
+
+	component, err := s.componentRepo.GetByID(ctx, req.ComponentID)
+	if err != nil || component == nil {
+		return nil, domainerrors.ErrNotFound.WithMessage("component not found")
+	}
+
+	if component.Type != valueobject.LessonComponentTypeText && component.Type != valueobject.LessonComponentTypeHeading {
+		return nil, domainerrors.ErrInvalidInput.WithMessage("inline edit supports text and heading components only")
+	}
+
+	lesson, err := s.genLessonRepo.GetByID(ctx, component.LessonID)
+	if err != nil || lesson == nil {
+		return nil, domainerrors.ErrNotFound.WithMessage("lesson not found")
+	}
+
+	if err := s.checkCourseAccess(ctx, user, lesson.CourseID); err != nil {
+		return nil, err
+	}
+	if err := s.checkCourseUnlocked(ctx, lesson.CourseID); err != nil {
+		return nil, err
+	}
+
+	if !s.inlineEditLimiter.Allow(user.ID) {
+		return nil, domainerrors.ErrRateLimited.WithMessage("too many inline edits - please wait a moment and try again")
+	}
+
+	if err := checkAIGenerationEnabled(ctx, s.aiSettingsRepo, *user.TenantID); err != nil {
+		return nil, err
+	}
+	if err := s.checkTokenBudget(ctx, *user.TenantID); err != nil {
+		return nil, err
+	}
+
+	aiProvider, err := s.aiProviderFactory.GetProvider(ctx, *user.TenantID)
+	if err != nil {
+		log.Error("failed to get AI provider", "error", err)
+		if domainerrors.IsDomainError(err) {
+			return nil, err
+		}
+		return nil, domainerrors.ErrExternalService.WithCause(err)
+	}
+
+	editCtx, cancel := context.WithTimeout(ctx, inlineEditTimeout)
+	defer cancel()
+
+	result, err := aiProvider.RegenerateComponent(editCtx, service.RegenerateComponentRequest{
+		ComponentType:      component.Type.String(),
+		CurrentContentJSON: string(component.ContentJSON),
+		ModificationPrompt: instruction,
+		LessonContext:      lesson.Title,
+	})
+	if err != nil {
+		if errors.Is(editCtx.Err(), context.DeadlineExceeded) {
+			log.Warn("inline component edit timed out", "timeout", inlineEditTimeout)
+			return nil, domainerrors.ErrInlineEditTimeout
+		}
+		log.Error("inline component edit failed", "error", err)
+		return nil, domainerrors.ErrExternalService.WithCause(err)
+	}
+
+	// Tokens are spent even if the output is rejected below
+	if !result.CacheHit {
+		if err := s.aiSettingsRepo.IncrementTokenUsage(ctx, *user.TenantID, result.TokensUsed); err != nil {
+			log.Warn("failed to record token usage", "error", err)
+		}
+	}
+	recordAudit(ctx, s.auditLog, log, audit.Entry{
+		TenantID:    *user.TenantID,
+		ActorUserID: &user.ID,
+		Action:      audit.ActionComponentEditGenerated,
+		TargetType:  audit.TargetComponent,
+		TargetID:    component.ID.String(),
+		Changes:     audit.Changes{}.Field("cache_hit", "", result.CacheHit).Field("tokens_used", "", result.TokensUsed),
+	})
+
+	if err := validateComponentContent(component.Type, result.ContentJSON); err != nil {
+		log.Warn("inline component edit returned invalid content", "error", err)
+		return nil, domainerrors.ErrExternalService.WithMessage("AI returned invalid content - please try again")
+	}
+
+	log.Info("inline component edit completed", "tokensUsed", result.TokensUsed, "cacheHit", result.CacheHit)
+
+	return &EditComponentTextResult{
+		Component:   component,
+		ContentJSON: result.ContentJSON,
+		TokensUsed:  result.TokensUsed,
+		CacheHit:    result.CacheHit,
+	}, nil
+}
+
+// checkAIProvider verifies the tenant's AI generation isn't paused and its AI provider can
+// be created before a job is queued, so a missing or unreadable API key is reported to the
+// caller instead of failing the job in the worker. The worker still handles the error if the key is removed in between.
+func (s *AIGenerationService) checkAIProvider(ctx context.Context, tenantID uuid.UUID) error {
+	if err := checkAIGenerationEnabled(ctx, s.aiSettingsRepo, tenantID); err != nil {
+		return err
+	}
+	if _, err := s.aiProviderFactory.GetProvider(ctx, tenantID); err != nil {
+		if domainerrors.IsDomainError(err) {
+			return err
+		}
+		return domainerrors.ErrExternalService.WithCause(err)
+	}
+	return nil
+}
+
+// checkTokenBudget returns ErrTokenLimitExceeded when the tenant has used up this month's token limit.
+func (s *AIGenerationService) checkTokenBudget(ctx context.Context, tenantID uuid.UUID) error {
+	return checkTenantTokenBudget(ctx, s.aiSettingsRepo, tenantID)
+}
+
+// checkTenantTokenBudget is checkTokenBudget for services that share the AI settings repository.
+func checkTenantTokenBudget(ctx context.Context, aiSettingsRepo repository.TenantAISettingsRepository, tenantID uuid.UUID) error {
+	settings, err := aiSettingsRepo.Get(ctx, tenantID)
+	if err != nil {
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+	if settings == nil || settings.MonthlyTokenLimit == nil {
+		return nil
+	}
+	used, err := aiSettingsRepo.GetCurrentPeriodUsage(ctx, tenantID)
+	if err != nil {
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+	if used >= *settings.MonthlyTokenLimit {
+		return domainerrors.ErrTokenLimitExceeded
+	}
+	return nil
+}
+
+// validateComponentContent checks AI output against the component's content schema.
+func validateComponentContent(componentType valueobject.LessonComponentType, contentJSON string) error {
+	switch componentType {
+	case valueobject.LessonComponentTypeText:
+		var content struct {
+			HTML      *string `json:"html"`
+			Plaintext *string `json:"plaintext"`
+		}
+		if err := json.Unmarshal([]byte(contentJSON), &content); err != nil {
+			return fmt.Errorf("invalid text component JSON: %w", err)
+		}
+		if content.HTML == nil || strings.TrimSpace(*content.HTML) == "" || content.Plaintext == nil {
+			return fmt.Errorf("text component requires html and plaintext")
+		}
+	case valueobject.LessonComponentTypeHeading:
+		var content struct {
+			Level *int    `json:"level"`
+			Text  *string `json:"text"`
+		}
+		if err := json.Unmarshal([]byte(contentJSON), &content); err != nil {
+			return fmt.Errorf("invalid heading component JSON: %w", err)
+		}
+		if content.Level == nil || *content.Level < 1 || *content.Level > 4 {
+			return fmt.Errorf("heading level must be between 1 and 4")
+		}
+		if content.Text == nil || strings.TrimSpace(*content.Text) == "" {
+			return fmt.Errorf("heading component requires text")
+		}
+	case valueobject.LessonComponentTypeVideo:
+		// The thumbnail description is optional; the frontend shows a generic placeholder without it
+		var content struct {
+			Title           *string `json:"title"`
+			Script          *string `json:"script"`
+			DurationSeconds *int    `json:"suggested_duration_seconds"`
+		}
+		if err := json.Unmarshal([]byte(contentJSON), &content); err != nil {
+			return fmt.Errorf("invalid video component JSON: %w", err)
+		}
+		if content.Title == nil || strings.TrimSpace(*content.Title) == "" {
+			return fmt.Errorf("video component requires a title")
+		}
+		if content.Script == nil || strings.TrimSpace(*content.Script) == "" {
+			return fmt.Errorf("video component requires a script")
+		}
+		if content.DurationSeconds == nil || *content.DurationSeconds <= 0 {
+			return fmt.Errorf("video component requires a suggested duration")
+		}
+	case valueobject.LessonComponentTypeQuiz:
+		// Only the current schema is accepted; older quizzes are upgraded by the migration job
+		quiz, upgraded, err := entity.ParseQuizContent([]byte(contentJSON))
+		if err != nil {
+			return err
+		}
+		if upgraded {
+			return fmt.Errorf("quiz component requires schema version %d", entity.QuizSchemaVersion)
+		}
+		if err := quiz.Validate(); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unsupported component type for inline edit: %s", componentType)
+	}
+	return nil
+}
+
 // GetJob retrieves a generation job by ID.
 func (s *AIGenerationService) GetJob(ctx context.Context, kratosID uuid.UUID, jobID uuid.UUID) (*entity.GenerationJob, error) {
 	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
@@ -1236,19 +2328,49 @@ func (s *AIGenerationService) GetJob(ctx context.Context, kratosID uuid.UUID, jo
 	return job, nil
 }
 
-// ListJobs retrieves generation jobs with optional filtering.
-func (s *AIGenerationService) ListJobs(ctx context.Context, kratosID uuid.UUID, opts entity.GenerationJobListOptions) ([]*entity.GenerationJob, error) {
+// Job list page sizes. A full course run has a child job per lesson, so pages are
+// small and callers usually list parents with child counts instead.
+const (
+	DefaultJobPageSize = 25
+	MaxJobPageSize     = 100
+)
+
+// ListJobsResult contains a page of generation jobs.
+type ListJobsResult struct {
+	Jobs       []*entity.GenerationJob
+	NextCursor string // Empty on the last page
+}
+
+// ListJobs retrieves a page of generation jobs with optional filtering, most recent
+// first. A limit of 0 uses DefaultJobPageSize.
+func (s *AIGenerationService) ListJobs(ctx context.Context, kratosID uuid.UUID, opts entity.GenerationJobListOptions) (*ListJobsResult, error) {
 	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
 	if err != nil || user == nil {
 		return nil, domainerrors.ErrUserNotFound
 	}
 
+	if opts.Limit <= 0 {
+		opts.Limit = DefaultJobPageSize
+	}
+	if opts.Limit > MaxJobPageSize {
+		opts.Limit = MaxJobPageSize
+	}
+	limit := opts.Limit
+	// Fetch one extra job to know whether there is another page
+	opts.Limit++
+
 	jobs, err := s.jobRepo.List(ctx, opts)
 	if err != nil {
 		return nil, domainerrors.ErrInternal.WithCause(err)
 	}
 
-	return jobs, nil
+	result := &ListJobsResult{Jobs: jobs}
+	if len(jobs) > limit {
+		result.Jobs = jobs[:limit]
+		last := result.Jobs[limit-1]
+		result.NextCursor = fmt.Sprintf("%s|%s", last.CreatedAt.Format(time.RFC3339Nano), last.ID.String())
+	}
+	return result, nil
 }
 
 // CancelJob cancels a queued or processing job.
@@ -1266,16 +2388,24 @@ func (s *AIGenerationService) CancelJob(ctx context.Context, kratosID uuid.UUID,
 		return nil, domainerrors.ErrNotFound.WithMessage("job not found")
 	}
 
+	// An auto-approved outline belongs to its full course run, which is cancelled as a unit
+	if job.Type == valueobject.GenerationJobTypeCourseOutline && job.ParentJobID != nil {
+		if parentJob, err := s.jobRepo.GetByID(ctx, *job.ParentJobID); err == nil && parentJob != nil {
+			job = parentJob
+		}
+	}
+
 	if job.Status != valueobject.GenerationJobStatusQueued && job.Status != valueobject.GenerationJobStatusProcessing {
 		return nil, domainerrors.ErrInvalidInput.WithMessage("can only cancel queued or processing jobs")
 	}
 
 	now := time.Now()
 	cancelMsg := "Cancelled by user"
+	var cancelledIDs []uuid.UUID
 
 	// If this is a parent job (full_course), cancel all child jobs first
 	if job.Type == valueobject.GenerationJobTypeFullCourse {
-		children, err := s.jobRepo.ListByParentID(ctx, jobID)
+		children, err := s.jobRepo.ListByParentID(ctx, job.ID)
 		if err == nil {
 			cancelledChildren := 0
 			for _, child := range children {
@@ -1290,6 +2420,7 @@ func (s *AIGenerationService) CancelJob(ctx context.Context, kratosID uuid.UUID,
 						log.Warn("failed to cancel child job", "childJobID", child.ID, "error", err)
 					} else {
 						cancelledChildren++
+						cancelledIDs = append(cancelledIDs, child.ID)
 					}
 				}
 			}
@@ -1306,6 +2437,16 @@ func (s *AIGenerationService) CancelJob(ctx context.Context, kratosID uuid.UUID,
 		log.Error("failed to cancel job", "error", err)
 		return nil, domainerrors.ErrInternal.WithCause(err)
 	}
+	cancelledIDs = append(cancelledIDs, job.ID)
+
+	// Abort any provider call already in flight for these jobs
+	if s.cancelPublisher != nil {
+		for _, id := range cancelledIDs {
+			if err := s.cancelPublisher.PublishJobCancel(ctx, id); err != nil {
+				log.Warn("failed to publish job cancel, job will stop at next checkpoint", "cancelledJobID", id, "error", err)
+			}
+		}
+	}
 
 	log.Info("job cancelled")
 	return job, nil
@@ -1337,14 +2478,15 @@ func (s *AIGenerationService) GetGeneratedLesson(ctx context.Context, kratosID u
 	return lesson, nil
 }
 
-// ListGeneratedLessons retrieves all generated lessons for a course.
-func (s *AIGenerationService) ListGeneratedLessons(ctx context.Context, kratosID uuid.UUID, courseID uuid.UUID) ([]*entity.GeneratedLesson, error) {
+// ListGeneratedLessons retrieves the generated lessons for a course.
+// Orphaned lessons are only returned when includeOrphaned is set, for recovery.
+func (s *AIGenerationService) ListGeneratedLessons(ctx context.Context, kratosID uuid.UUID, courseID uuid.UUID, includeOrphaned bool) ([]*entity.GeneratedLesson, error) {
 	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
 	if err != nil || user == nil {
 		return nil, domainerrors.ErrUserNotFound
 	}
 
-	lessons, err := s.genLessonRepo.ListByCourseID(ctx, courseID)
+	lessons, err := s.genLessonRepo.ListByCourseID(ctx, courseID, includeOrphaned)
 	if err != nil {
 		return nil, domainerrors.ErrInternal.WithCause(err)
 	}
@@ -1364,10 +2506,112 @@ func (s *AIGenerationService) ListGeneratedLessons(ctx context.Context, kratosID
 	return lessons, nil
 }
 
-// Helper to fail a job with an error message.
+// resolveGenerationPreferences returns the requested preferences, falling back to the tenant defaults.
+func (s *AIGenerationService) resolveGenerationPreferences(ctx context.Context, tenantID uuid.UUID, override *entity.GenerationPreferences) (entity.GenerationPreferences, error) {
+	if override != nil {
+		if !override.QuizFrequency.IsValid() {
+			return entity.GenerationPreferences{}, domainerrors.ErrInvalidInput.WithMessage("invalid quiz frequency")
+		}
+		if !override.ComponentBoundsValid() {
+			return entity.GenerationPreferences{}, domainerrors.ErrInvalidInput.WithMessage(componentBoundsMessage)
+		}
+		return *override, nil
+	}
+
+	settings, err := s.aiSettingsRepo.Get(ctx, tenantID)
+	if err != nil || settings == nil || !settings.GenerationDefaults.QuizFrequency.IsValid() {
+		return entity.DefaultGenerationPreferences(), nil
+	}
+	return settings.GenerationDefaults, nil
+}
+
+// recordCoursePreferences mirrors preferences into the course's assessment settings (best effort).
+func (s *AIGenerationService) recordCoursePreferences(ctx context.Context, courseID uuid.UUID, prefs entity.GenerationPreferences) {
+	if s.coursePrefs == nil {
+		return
+	}
+	if err := s.coursePrefs.RecordGenerationPreferences(ctx, courseID, prefs); err != nil {
+		s.logger.Warn("failed to record generation preferences on course", "courseID", courseID, "error", err)
+	}
+}
+
+// filterLessonComponents strips component types the preferences don't allow for this lesson,
+// quizzes that can't be graded, and video placeholders without a usable script, and renumbers
+// the rest. Returns the kept components and how many were removed.
+func filterLessonComponents(components []service.LessonComponentResult, prefs entity.GenerationPreferences, isLastInSection, isLastInCourse bool) ([]service.LessonComponentResult, int) {
+	allowQuiz := prefs.QuizAllowed(isLastInSection, isLastInCourse)
+
+	kept := make([]service.LessonComponentResult, 0, len(components))
+	for _, comp := range components {
+		switch valueobject.LessonComponentType(comp.Type) {
+		case valueobject.LessonComponentTypeQuiz:
+			if !allowQuiz || validateComponentContent(valueobject.LessonComponentTypeQuiz, comp.ContentJSON) != nil {
+				continue
+			}
+		case valueobject.LessonComponentTypeImage:
+			if !prefs.IncludeImages {
+				continue
+			}
+		case valueobject.LessonComponentTypeVideo:
+			if validateComponentContent(valueobject.LessonComponentTypeVideo, comp.ContentJSON) != nil {
+				continue
+			}
+		}
+		comp.Order = len(kept) + 1
+		kept = append(kept, comp)
+	}
+	return kept, len(components) - len(kept)
+}
+
+// checkCourseAccess verifies the user may modify the course before starting generation.
+func (s *AIGenerationService) checkCourseAccess(ctx context.Context, user *entity.User, courseID uuid.UUID) error {
+	if s.courseAccess == nil {
+		return nil
+	}
+	return s.courseAccess.CheckCourseEditAccess(ctx, user, courseID)
+}
+
+// defaultCourseTitle is used in notifications when no course title can be found.
+const defaultCourseTitle = "Untitled Course"
+
+// resolveCourseTitle returns the course's current title for notifications.
+// If the course was deleted mid-generation it falls back to the title stored with
+// the generation input (pass genInput when already loaded to avoid a lookup).
+func (s *AIGenerationService) resolveCourseTitle(ctx context.Context, courseID uuid.UUID, genInput *entity.CourseGenerationInput) string {
+	if s.courseRepo != nil {
+		course, err := s.courseRepo.GetByID(ctx, courseID)
+		if err != nil {
+			s.logger.Warn("failed to get course for notification title", "courseID", courseID, "error", err)
+		} else if course != nil && course.Title != "" {
+			return course.Title
+		}
+	}
+
+	if genInput == nil {
+		genInput, _ = s.genInputRepo.GetByCourseID(ctx, courseID)
+	}
+	if genInput != nil && genInput.CourseTitle != nil && *genInput.CourseTitle != "" {
+		return *genInput.CourseTitle
+	}
+
+	return defaultCourseTitle
+}
+
+// Helper to fail a job with an error message, for failures the user can't fix.
 func (s *AIGenerationService) failJob(ctx context.Context, job *entity.GenerationJob, errMsg string) error {
+	return s.failJobWithReason(ctx, job, valueobject.JobFailureInternal, errMsg)
+}
+
+// failJobWithError fails a job with err, classified into a failure reason, prefixed by what failed.
+func (s *AIGenerationService) failJobWithError(ctx context.Context, job *entity.GenerationJob, prefix string, err error) error {
+	return s.failJobWithReason(ctx, job, classifyJobFailure(err), fmt.Sprintf("%s: %v", prefix, err))
+}
+
+// failJobWithReason marks a job as failed and notifies its creator.
+func (s *AIGenerationService) failJobWithReason(ctx context.Context, job *entity.GenerationJob, reason valueobject.JobFailureReason, errMsg string) error {
 	job.Status = valueobject.GenerationJobStatusFailed
 	job.ErrorMessage = &errMsg
+	job.FailureReason = &reason
 	now := time.Now()
 	job.CompletedAt = &now
 
@@ -1375,6 +2619,21 @@ func (s *AIGenerationService) failJob(ctx context.Context, job *entity.Generatio
 		s.logger.Error("failed to mark job as failed", "jobID", job.ID, "error", err)
 	}
 
+	// An auto-approved run fails with its outline and notifies once, from the parent job
+	if job.Type == valueobject.GenerationJobTypeCourseOutline && job.ParentJobID != nil {
+		s.failAutoApprovedRun(ctx, job, errMsg)
+		return fmt.Errorf("%s", errMsg)
+	}
+
+	// Outline failures get a dedicated notification naming the course
+	if job.Type == valueobject.GenerationJobTypeCourseOutline && job.CourseID != nil && s.outlineNotifier != nil {
+		courseTitle := s.resolveCourseTitle(ctx, *job.CourseID, nil)
+		if err := s.outlineNotifier.NotifyOutlineFailed(ctx, job.CreatedByUserID, *job.CourseID, courseTitle, errMsg); err != nil {
+			s.logger.Error("failed to send outline failure notification", "jobID", job.ID, "error", err)
+		}
+		return fmt.Errorf("%s", errMsg)
+	}
+
 	// Notify user of failure (tenant-isolated via user lookup)
 	if s.notifier != nil {
 		jobType := "Generation"
@@ -1385,8 +2644,12 @@ func (s *AIGenerationService) failJob(ctx context.Context, job *entity.Generatio
 			jobType = "Lesson Content"
 		case valueobject.GenerationJobTypeComponentRegen:
 			jobType = "Component Regeneration"
+		case valueobject.GenerationJobTypeLessonsExport:
+			jobType = "Lesson Export"
+		case valueobject.GenerationJobTypeCourseTranslation:
+			jobType = "Course Translation"
 		}
-		if err := s.notifier.NotifyJobProgress(ctx, job.CreatedByUserID, job.ID, jobType, "failed", 0); err != nil {
+		if err := s.notifier.NotifyJobProgress(ctx, job.CreatedByUserID, job.ID, job.CourseID, jobType, "failed", 0); err != nil {
 			s.logger.Error("failed to send failure notification", "jobID", job.ID, "error", err)
 		}
 	}
@@ -1397,22 +2660,105 @@ func (s *AIGenerationService) failJob(ctx context.Context, job *entity.Generatio
 // checkJobCancelled checks if a job has been cancelled by re-fetching its status from the database.
 // Returns true if the job was cancelled, false otherwise.
 // This should be called at key points during long-running operations to allow early termination.
+// A cancelled context alone is ambiguous - the worker may be draining or the job tracker may
+// have aborted a cancelled job - so handleInterruptedJob uses this to tell them apart.
 func (s *AIGenerationService) checkJobCancelled(ctx context.Context, jobID uuid.UUID) bool {
-	// Check context cancellation first
-	select {
-	case <-ctx.Done():
-		return true
-	default:
-	}
-
 	// Check job status in database
 	currentJob, err := s.jobRepo.GetByID(ctx, jobID)
-	if err != nil {
+	if err != nil || currentJob == nil {
 		return false // Can't determine, assume not cancelled
 	}
 	return currentJob.Status == valueobject.GenerationJobStatusCancelled
 }
 
+// interruptedJobMessage is the progress message shown on jobs checkpointed during a worker drain.
+const interruptedJobMessage = "Re-queued due to deploy"
+
+// requeueInterruptedJob checkpoints a job whose worker is shutting down.
+// The job goes back to 'queued' with its progress preserved and is pushed onto the
+// task queue so the next available worker picks it up instead of waiting for the stale sweep.
+func (s *AIGenerationService) requeueInterruptedJob(ctx context.Context, job *entity.GenerationJob) error {
+	// The worker context is already cancelled; persist the checkpoint with a detached one
+	ctx = context.WithoutCancel(ctx)
+	log := s.logger.With("jobID", job.ID, "type", job.Type)
+
+	// Don't resurrect a job the user cancelled while it was running
+	if s.checkJobCancelled(ctx, job.ID) {
+		log.Info("job cancelled during shutdown, not re-queuing")
+		return nil
+	}
+
+	job.Status = valueobject.GenerationJobStatusQueued
+	job.StartedAt = nil
+	msg := interruptedJobMessage
+	job.ProgressMessage = &msg
+	if err := s.jobRepo.Update(ctx, job); err != nil {
+		log.Error("failed to checkpoint interrupted job", "error", err)
+		return err
+	}
+
+	// Push: Enqueue for the next worker (the poll sweep remains the fallback)
+	if s.taskEnqueuer != nil {
+		if err := s.taskEnqueuer.EnqueueAIGeneration(job.ID.String(), string(job.Type)); err != nil {
+			log.Warn("failed to enqueue interrupted job, will be picked up by poll", "error", err)
+		}
+	}
+
+	log.Info("job re-queued due to worker shutdown", "progress", job.ProgressPercent)
+	return nil
+}
+
+// cancelledDuringGenerationMessage is the progress message on jobs aborted mid provider call.
+const cancelledDuringGenerationMessage = "Cancelled during AI generation"
+
+// handleInterruptedJob handles a provider call aborted by context cancellation. If the
+// user cancelled the job it ends as cancelled, recording the tokens already spent;
+// otherwise the worker is draining and the job is re-queued.
+func (s *AIGenerationService) handleInterruptedJob(ctx context.Context, job *entity.GenerationJob, tokensUsed int64) error {
+	detached := context.WithoutCancel(ctx)
+	if !s.checkJobCancelled(detached, job.ID) {
+		return s.requeueInterruptedJob(ctx, job)
+	}
+
+	if tokensUsed > 0 {
+		job.TokensUsed += tokensUsed
+		if err := s.aiSettingsRepo.IncrementTokenUsage(detached, job.TenantID, tokensUsed); err != nil {
+			s.logger.Warn("failed to record token usage for cancelled job", "jobID", job.ID, "error", err)
+		}
+	}
+
+	job.Status = valueobject.GenerationJobStatusCancelled
+	now := time.Now()
+	job.CompletedAt = &now
+	msg := cancelledDuringGenerationMessage
+	job.ProgressMessage = &msg
+
+	if err := s.jobRepo.Update(detached, job); err != nil {
+		s.logger.Error("failed to mark job as cancelled", "jobID", job.ID, "error", err)
+		return err
+	}
+
+	s.logger.Info("job aborted during AI generation", "jobID", job.ID, "tokensUsed", job.TokensUsed)
+	return nil
+}
+
+// tokensUsedBeforeAbort returns the tokens a provider reported spending before it was aborted.
+func tokensUsedBeforeAbort(err error) int64 {
+	var partial *service.PartialUsageError
+	if errors.As(err, &partial) {
+		return partial.TokensUsed
+	}
+	return 0
+}
+
+// trackJob registers the job with the job tracker so CancelJob can abort it.
+func (s *AIGenerationService) trackJob(ctx context.Context, jobID uuid.UUID) (context.Context, func()) {
+	if s.jobTracker == nil {
+		return ctx, func() {}
+	}
+	return s.jobTracker.Track(ctx, jobID)
+}
+
 // markJobCancelled marks a job as cancelled if it was cancelled during processing.
 func (s *AIGenerationService) markJobCancelled(ctx context.Context, job *entity.GenerationJob) error {
 	job.Status = valueobject.GenerationJobStatusCancelled
@@ -1479,6 +2825,8 @@ func (s *AIGenerationService) processNextJob(ctx context.Context) error {
 	// All subsequent operations will be scoped to this tenant
 	// IMPORTANT: Build from adminCtx to preserve superadmin flag for worker operations
 	tenantCtx := tenant.WithTenantID(adminCtx, job.TenantID)
+	tenantCtx, release := s.trackJob(tenantCtx, job.ID)
+	defer release()
 
 	s.logger.Info("processing AI generation job", "jobID", job.ID, "type", job.Type, "tenantID", job.TenantID)
 
@@ -1488,6 +2836,12 @@ func (s *AIGenerationService) processNextJob(ctx context.Context) error {
 		return s.ProcessOutlineGenerationJob(tenantCtx, job)
 	case valueobject.GenerationJobTypeLessonContent:
 		return s.ProcessLessonGenerationJob(tenantCtx, job)
+	case valueobject.GenerationJobTypeLessonsExport:
+		return s.ProcessLessonsExportJob(tenantCtx, job)
+	case valueobject.GenerationJobTypeStorageAudit:
+		return s.ProcessStorageAuditJob(tenantCtx, job)
+	case valueobject.GenerationJobTypeCourseTranslation:
+		return s.ProcessCourseTranslationJob(tenantCtx, job)
 	default:
 		// Unknown/unsupported job type - fail it so it doesn't stay stuck in 'processing'
 		// This handles bad data in DB or enum parse failures from repository
@@ -1532,6 +2886,8 @@ func (s *AIGenerationService) ProcessJobByID(ctx context.Context, jobID string)
 	// All subsequent operations will be scoped to this tenant
 	// IMPORTANT: Build from adminCtx to preserve superadmin flag for worker operations
 	tenantCtx := tenant.WithTenantID(adminCtx, job.TenantID)
+	tenantCtx, release := s.trackJob(tenantCtx, job.ID)
+	defer release()
 
 	// Process based on job type
 	switch job.Type {
@@ -1539,6 +2895,12 @@ func (s *AIGenerationService) ProcessJobByID(ctx context.Context, jobID string)
 		return s.ProcessOutlineGenerationJob(tenantCtx, job)
 	case valueobject.GenerationJobTypeLessonContent:
 		return s.ProcessLessonGenerationJob(tenantCtx, job)
+	case valueobject.GenerationJobTypeLessonsExport:
+		return s.ProcessLessonsExportJob(tenantCtx, job)
+	case valueobject.GenerationJobTypeStorageAudit:
+		return s.ProcessStorageAuditJob(tenantCtx, job)
+	case valueobject.GenerationJobTypeCourseTranslation:
+		return s.ProcessCourseTranslationJob(tenantCtx, job)
 	default:
 		// Unknown/unsupported job type - fail it so it doesn't stay stuck in 'processing'
 		// This handles bad data in DB or enum parse failures from repository
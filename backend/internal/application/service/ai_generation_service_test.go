@@ -0,0 +1,297 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/audit"
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+	"github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/domain/tenant"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+// testOutline builds an outline with two sections of two lessons each.
+func testOutline() *entity.CourseOutline {
+	outline := &entity.CourseOutline{ID: uuid.New(), TenantID: uuid.New(), CourseID: uuid.New()}
+	for s := range 2 {
+		section := entity.OutlineSection{
+			ID:        uuid.New(),
+			TenantID:  outline.TenantID,
+			OutlineID: outline.ID,
+			Title:     "Section " + string(rune('A'+s)),
+			Position:  int32(s + 1),
+		}
+		for l := range 2 {
+			section.Lessons = append(section.Lessons, entity.OutlineLesson{
+				ID:              uuid.New(),
+				TenantID:        outline.TenantID,
+				SectionID:       section.ID,
+				Title:           section.Title + " lesson " + string(rune('1'+l)),
+				Position:        int32(l + 1),
+				IsLastInSection: l == 1,
+				IsLastInCourse:  s == 1 && l == 1,
+			})
+		}
+		outline.Sections = append(outline.Sections, section)
+	}
+	return outline
+}
+
+// sectionUpdate requests the section as it is, with the given lessons.
+func sectionUpdate(section entity.OutlineSection, lessons ...entity.OutlineLesson) UpdateCourseOutlineSection {
+	req := UpdateCourseOutlineSection{ID: section.ID, Title: section.Title, Order: section.Position}
+	for _, lesson := range lessons {
+		req.Lessons = append(req.Lessons, UpdateCourseOutlineLesson{ID: lesson.ID, Title: lesson.Title, Order: lesson.Position})
+	}
+	return req
+}
+
+func TestApplyOutlineUpdate(t *testing.T) {
+	outline := testOutline()
+	other := testOutline() // A second outline, whose IDs the first must refuse
+	sectionA, sectionB := outline.Sections[0], outline.Sections[1]
+	otherSection := other.Sections[0]
+
+	tests := []struct {
+		name     string
+		sections []UpdateCourseOutlineSection
+		removed  []uuid.UUID
+		wantErr  []string // IDs or phrases the error must name; nil for success
+	}{
+		{
+			name:     "own section and lessons",
+			sections: []UpdateCourseOutlineSection{sectionUpdate(sectionA, sectionA.Lessons...)},
+		},
+		{
+			name:     "section from another outline",
+			sections: []UpdateCourseOutlineSection{sectionUpdate(otherSection, otherSection.Lessons...)},
+			wantErr:  []string{"sections not in this outline", otherSection.ID.String()},
+		},
+		{
+			name:     "lesson from another outline in own section",
+			sections: []UpdateCourseOutlineSection{sectionUpdate(sectionA, sectionA.Lessons[0], otherSection.Lessons[1])},
+			wantErr:  []string{"lessons not in their section", otherSection.Lessons[1].ID.String()},
+		},
+		{
+			name:     "lesson moved to another section of the same outline",
+			sections: []UpdateCourseOutlineSection{sectionUpdate(sectionA, sectionB.Lessons[0])},
+			wantErr:  []string{"lessons not in their section", sectionB.Lessons[0].ID.String()},
+		},
+		{
+			name:    "removing a lesson of another outline",
+			removed: []uuid.UUID{other.Sections[1].Lessons[0].ID},
+			wantErr: []string{"removed lessons not in this outline", other.Sections[1].Lessons[0].ID.String()},
+		},
+		{
+			name:     "every foreign ID is named",
+			sections: []UpdateCourseOutlineSection{sectionUpdate(otherSection), sectionUpdate(sectionA, otherSection.Lessons[0])},
+			removed:  []uuid.UUID{other.Sections[1].Lessons[1].ID},
+			wantErr:  []string{otherSection.ID.String(), otherSection.Lessons[0].ID.String(), other.Sections[1].Lessons[1].ID.String()},
+		},
+		{
+			name:     "lesson both removed and updated",
+			sections: []UpdateCourseOutlineSection{sectionUpdate(sectionA, sectionA.Lessons[0])},
+			removed:  []uuid.UUID{sectionA.Lessons[0].ID},
+			wantErr:  []string{"lessons both removed and updated", sectionA.Lessons[0].ID.String()},
+		},
+		{
+			name:    "removing every lesson of a section",
+			removed: []uuid.UUID{sectionB.Lessons[0].ID, sectionB.Lessons[1].ID},
+			wantErr: []string{`section "Section B" would have no lessons left`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			update, err := applyOutlineUpdate(outline, tt.sections, tt.removed)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if update == nil {
+					t.Fatal("no update returned")
+				}
+				return
+			}
+
+			if !errors.Is(err, domainerrors.ErrInvalidInput) {
+				t.Fatalf("error = %v, want ErrInvalidInput", err)
+			}
+			if update != nil {
+				t.Error("an update was returned with the error")
+			}
+			for _, want := range tt.wantErr {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("error %q does not name %q", err, want)
+				}
+			}
+		})
+	}
+}
+
+func TestApplyOutlineUpdateLeavesOtherOutlineUntouched(t *testing.T) {
+	outline := testOutline()
+	other := testOutline()
+
+	_, err := applyOutlineUpdate(outline, []UpdateCourseOutlineSection{sectionUpdate(other.Sections[0], other.Sections[0].Lessons...)}, nil)
+	if err == nil {
+		t.Fatal("update of another outline's section was accepted")
+	}
+
+	// A valid update may only return this outline's sections and lessons
+	update, err := applyOutlineUpdate(outline, []UpdateCourseOutlineSection{sectionUpdate(outline.Sections[1], outline.Sections[1].Lessons...)}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	own := make(map[uuid.UUID]bool)
+	for _, section := range outline.Sections {
+		own[section.ID] = true
+		for _, lesson := range section.Lessons {
+			own[lesson.ID] = true
+		}
+	}
+	for _, section := range update.Sections {
+		if !own[section.ID] {
+			t.Errorf("update includes foreign section %s", section.ID)
+		}
+	}
+	for _, lesson := range update.Lessons {
+		if !own[lesson.ID] {
+			t.Errorf("update includes foreign lesson %s", lesson.ID)
+		}
+	}
+}
+
+func TestApplyOutlineUpdateMarksLastLessons(t *testing.T) {
+	outline := testOutline()
+	sectionB := outline.Sections[1]
+
+	// Adding a lesson at the end of the last section makes it the course's last lesson
+	req := sectionUpdate(sectionB, sectionB.Lessons...)
+	req.Lessons = append(req.Lessons, UpdateCourseOutlineLesson{Title: "New lesson", Order: 3})
+	update, err := applyOutlineUpdate(outline, []UpdateCourseOutlineSection{req}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(update.AddedLessons) != 1 {
+		t.Fatalf("added %d lessons, want 1", len(update.AddedLessons))
+	}
+	added := update.AddedLessons[0]
+	if added.SectionID != sectionB.ID || !added.IsLastInSection || !added.IsLastInCourse {
+		t.Errorf("added lesson = section %s, last in section %v, last in course %v; want section %s, true, true",
+			added.SectionID, added.IsLastInSection, added.IsLastInCourse, sectionB.ID)
+	}
+	for _, lesson := range update.Lessons {
+		if lesson.ID == sectionB.Lessons[1].ID && (lesson.IsLastInSection || lesson.IsLastInCourse) {
+			t.Error("previous last lesson is still marked last")
+		}
+	}
+}
+
+type fakeComponentRepo struct {
+	repository.LessonComponentRepository
+	component *entity.LessonComponent
+}
+
+func (r *fakeComponentRepo) GetByID(_ context.Context, id uuid.UUID) (*entity.LessonComponent, error) {
+	if r.component.ID != id {
+		return nil, nil
+	}
+	return r.component, nil
+}
+
+// fakeRegenProvider answers component regenerations with a fixed result.
+type fakeRegenProvider struct {
+	service.AIProvider
+	result *service.RegenerateComponentResult
+}
+
+func (p fakeRegenProvider) RegenerateComponent(context.Context, service.RegenerateComponentRequest) (*service.RegenerateComponentResult, error) {
+	return p.result, nil
+}
+
+type fakeProviderFactory struct {
+	provider service.AIProvider
+}
+
+func (f fakeProviderFactory) GetProvider(context.Context, uuid.UUID) (service.AIProvider, error) {
+	return f.provider, nil
+}
+
+type fakeAuditLogger struct {
+	AuditLogger
+	entries []audit.Entry
+}
+
+func (l *fakeAuditLogger) Record(_ context.Context, entry audit.Entry) error {
+	l.entries = append(l.entries, entry)
+	return nil
+}
+
+func TestEditComponentTextAuditsCacheHit(t *testing.T) {
+	const contentJSON = `{"html":"<p>Short</p>","plaintext":"Short"}`
+	tests := []struct {
+		name   string
+		result service.RegenerateComponentResult
+		want   audit.Changes
+	}{
+		{
+			"cache hit",
+			service.RegenerateComponentResult{ContentJSON: contentJSON, CacheHit: true},
+			audit.Changes{{Field: "cache_hit", After: "true"}, {Field: "tokens_used", After: "0"}},
+		},
+		{
+			"provider call",
+			service.RegenerateComponentResult{ContentJSON: contentJSON, TokensUsed: 120},
+			audit.Changes{{Field: "cache_hit", After: "false"}, {Field: "tokens_used", After: "120"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tenantID := uuid.New()
+			user := &entity.User{ID: uuid.New(), KratosID: uuid.New(), TenantID: &tenantID}
+			lesson := &entity.GeneratedLesson{ID: uuid.New(), TenantID: tenantID, CourseID: uuid.New(), Title: "Lesson"}
+			component := &entity.LessonComponent{ID: uuid.New(), TenantID: tenantID, LessonID: lesson.ID, Type: valueobject.LessonComponentTypeText}
+			auditLog := &fakeAuditLogger{}
+			svc := &AIGenerationService{
+				userRepo:          &fakeUserRepo{users: []*entity.User{user}},
+				componentRepo:     &fakeComponentRepo{component: component},
+				genLessonRepo:     &fakeGeneratedLessonRepo{lessons: []*entity.GeneratedLesson{lesson}},
+				aiSettingsRepo:    fakeAISettingsRepo{},
+				aiProviderFactory: fakeProviderFactory{provider: fakeRegenProvider{result: &tt.result}},
+				auditLog:          auditLog,
+				inlineEditLimiter: newUserRateLimiter(inlineEditRateLimit, inlineEditRateWindow),
+				logger:            nopLogger{},
+			}
+
+			ctx := tenant.WithTenantID(context.Background(), tenantID)
+			result, err := svc.EditComponentText(ctx, user.KratosID, EditComponentTextRequest{ComponentID: component.ID, Instruction: "shorten"})
+			if err != nil {
+				t.Fatalf("EditComponentText() error = %v", err)
+			}
+			if result.CacheHit != tt.result.CacheHit {
+				t.Errorf("CacheHit = %v, want %v", result.CacheHit, tt.result.CacheHit)
+			}
+
+			if len(auditLog.entries) != 1 {
+				t.Fatalf("recorded %d audit entries, want 1", len(auditLog.entries))
+			}
+			entry := auditLog.entries[0]
+			if entry.Action != audit.ActionComponentEditGenerated || entry.TargetID != component.ID.String() {
+				t.Errorf("audit entry = %s on %s, want %s on the component", entry.Action, entry.TargetID, audit.ActionComponentEditGenerated)
+			}
+			if !slices.Equal(entry.Changes, tt.want) {
+				t.Errorf("audit changes = %+v, want %+v", entry.Changes, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,297 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sogos/mirai-backend/internal/domain/audit"
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+	"github.com/sogos/mirai-backend/internal/domain/service"
+)
+
+const (
+	// defaultAuditPageSize and maxAuditPageSize bound a ListAuditEvents page.
+	defaultAuditPageSize = 50
+	maxAuditPageSize     = 200
+
+	// maxAuditExportEvents caps a CSV export; larger exports must be split by date range.
+	maxAuditExportEvents = 10000
+)
+
+// AuditLogger records administrative actions in the tenant's audit log.
+type AuditLogger interface {
+	// Record appends an entry for a change already made outside the database,
+	// such as in Stripe or the identity provider.
+	Record(ctx context.Context, entry audit.Entry) error
+
+	// RecordWith runs mutate and appends the entry in the same database transaction,
+	// so the change is never kept without its entry. The entry is read after mutate
+	// returns, letting mutate fill in IDs assigned on insert.
+	RecordWith(ctx context.Context, entry *audit.Entry, mutate func(ctx context.Context) error) error
+}
+
+// audited runs mutate, recording the entry with it when audit logging is enabled.
+func audited(ctx context.Context, logger AuditLogger, entry *audit.Entry, mutate func(ctx context.Context) error) error {
+	if logger == nil {
+		return mutate(ctx)
+	}
+	return logger.RecordWith(ctx, entry, mutate)
+}
+
+// recordAudit records an entry for a change that has already been made. The change
+// can't be rolled back at this point, so a failure is logged instead of returned.
+func recordAudit(ctx context.Context, logger AuditLogger, log service.Logger, entry audit.Entry) {
+	if logger == nil {
+		return
+	}
+	if err := logger.Record(ctx, entry); err != nil {
+		log.Error("failed to record audit event", "action", entry.Action, "targetID", entry.TargetID, "error", err)
+	}
+}
+
+// AuditService writes the audit log and lets admins review and export it.
+type AuditService struct {
+	userRepo  repository.UserRepository
+	eventRepo repository.AuditEventRepository
+	tx        repository.Transactor
+	logger    service.Logger
+}
+
+// NewAuditService creates a new audit service.
+func NewAuditService(
+	userRepo repository.UserRepository,
+	eventRepo repository.AuditEventRepository,
+	tx repository.Transactor,
+	logger service.Logger,
+) *AuditService {
+	return &AuditService{
+		userRepo:  userRepo,
+		eventRepo: eventRepo,
+		tx:        tx,
+		logger:    logger,
+	}
+}
+
+// Record appends an entry to the audit log.
+func (s *AuditService) Record(ctx context.Context, entry audit.Entry) error {
+	return s.eventRepo.Create(ctx, newAuditEvent(ctx, entry))
+}
+
+// RecordWith runs mutate and appends the entry in one transaction.
+func (s *AuditService) RecordWith(ctx context.Context, entry *audit.Entry, mutate func(ctx context.Context) error) error {
+	return s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := mutate(ctx); err != nil {
+			return err
+		}
+		return s.eventRepo.Create(ctx, newAuditEvent(ctx, *entry))
+	})
+}
+
+// newAuditEvent builds the stored event for an entry, taking the client address and any
+// API token from the request.
+func newAuditEvent(ctx context.Context, entry audit.Entry) *entity.AuditEvent {
+	event := &entity.AuditEvent{
+		TenantID:    entry.TenantID,
+		ActorUserID: entry.ActorUserID,
+		Action:      entry.Action,
+		TargetType:  entry.TargetType,
+		TargetID:    entry.TargetID,
+		Changes:     entry.Changes,
+	}
+	if ip := audit.ClientIPFromContext(ctx); ip != "" {
+		event.IPAddress = &ip
+	}
+	event.APITokenID = audit.APITokenFromContext(ctx)
+	return event
+}
+
+// AuditEventFilter selects audit events. Nil fields match all events.
+type AuditEventFilter struct {
+	Action      *audit.Action
+	ActorUserID *uuid.UUID
+	TargetType  *audit.TargetType
+	TargetID    *string
+	Since       *time.Time
+	Until       *time.Time
+}
+
+// ListAuditEventsResult contains a page of audit events.
+type ListAuditEventsResult struct {
+	Events     []*entity.AuditEvent
+	NextCursor string
+}
+
+// ListAuditEvents returns the caller's tenant audit log, most recent first. Admins and owners only.
+func (s *AuditService) ListAuditEvents(ctx context.Context, kratosID uuid.UUID, filter AuditEventFilter, cursor string, limit int) (*ListAuditEventsResult, error) {
+	if err := s.checkAuditAccess(ctx, kratosID); err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 {
+		limit = defaultAuditPageSize
+	}
+	if limit > maxAuditPageSize {
+		limit = maxAuditPageSize
+	}
+
+	opts := auditListOptions(filter)
+	opts.Limit = limit
+	if cursor != "" {
+		opts.Cursor = &cursor
+	}
+
+	events, err := s.eventRepo.List(ctx, opts)
+	if err != nil {
+		s.logger.Error("failed to list audit events", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	var nextCursor string
+	if len(events) == limit {
+		nextCursor = auditCursor(events[len(events)-1])
+	}
+
+	return &ListAuditEventsResult{Events: events, NextCursor: nextCursor}, nil
+}
+
+// AuditExport is a CSV rendering of audit events.
+type AuditExport struct {
+	Filename string
+	Content  []byte
+}
+
+// ExportAuditEvents renders the events matching the filter as CSV. Admins and owners only.
+func (s *AuditService) ExportAuditEvents(ctx context.Context, kratosID uuid.UUID, filter AuditEventFilter) (*AuditExport, error) {
+	if err := s.checkAuditAccess(ctx, kratosID); err != nil {
+		return nil, err
+	}
+
+	opts := auditListOptions(filter)
+	opts.Limit = maxAuditPageSize
+
+	var events []*entity.AuditEvent
+	for {
+		page, err := s.eventRepo.List(ctx, opts)
+		if err != nil {
+			s.logger.Error("failed to list audit events for export", "error", err)
+			return nil, domainerrors.ErrInternal.WithCause(err)
+		}
+		events = append(events, page...)
+		if len(events) > maxAuditExportEvents {
+			return nil, domainerrors.ErrInvalidInput.WithMessage(
+				fmt.Sprintf("export is limited to %d events; narrow the date range", maxAuditExportEvents))
+		}
+		if len(page) < opts.Limit {
+			break
+		}
+		cursor := auditCursor(page[len(page)-1])
+		opts.Cursor = &cursor
+	}
+
+	content, err := renderAuditCSV(events)
+	if err != nil {
+		s.logger.Error("failed to render audit export", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	return &AuditExport{
+		Filename: fmt.Sprintf("audit-log-%s.csv", time.Now().UTC().Format("2006-01-02")),
+		Content:  content,
+	}, nil
+}
+
+// checkAuditAccess verifies the caller may review their tenant's audit log.
+func (s *AuditService) checkAuditAccess(ctx context.Context, kratosID uuid.UUID) error {
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return domainerrors.ErrUserNotFound
+	}
+
+	if !user.CanManageSettings() {
+		return domainerrors.ErrForbidden.WithMessage("only admins and owners can view the audit log")
+	}
+
+	if user.TenantID == nil {
+		return domainerrors.ErrUserHasNoCompany
+	}
+	return nil
+}
+
+func auditListOptions(filter AuditEventFilter) entity.AuditEventListOptions {
+	return entity.AuditEventListOptions{
+		Action:      filter.Action,
+		ActorUserID: filter.ActorUserID,
+		TargetType:  filter.TargetType,
+		TargetID:    filter.TargetID,
+		Since:       filter.Since,
+		Until:       filter.Until,
+	}
+}
+
+// auditCursor returns the "timestamp|id" cursor of the page ending at event.
+func auditCursor(event *entity.AuditEvent) string {
+	return fmt.Sprintf("%s|%s", event.CreatedAt.Format(time.RFC3339Nano), event.ID.String())
+}
+
+// renderAuditCSV writes one row per event, with the changes flattened into one column.
+func renderAuditCSV(events []*entity.AuditEvent) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	if err := w.Write([]string{"Time", "Actor User ID", "Action", "Target Type", "Target ID", "Changes", "IP Address", "API Token ID"}); err != nil {
+		return nil, err
+	}
+	for _, event := range events {
+		actor := "system"
+		if event.ActorUserID != nil {
+			actor = event.ActorUserID.String()
+		}
+		ip := ""
+		if event.IPAddress != nil {
+			ip = *event.IPAddress
+		}
+		apiToken := ""
+		if event.APITokenID != nil {
+			apiToken = event.APITokenID.String()
+		}
+		row := []string{
+			event.CreatedAt.UTC().Format(time.RFC3339),
+			actor,
+			string(event.Action),
+			string(event.TargetType),
+			event.TargetID,
+			formatAuditChanges(event.Changes),
+			ip,
+			apiToken,
+		}
+		if err := w.Write(row); err != nil {
+			return nil, err
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// formatAuditChanges renders changes as "field: before -> after", naming sensitive fields only.
+func formatAuditChanges(changes audit.Changes) string {
+	parts := make([]string, 0, len(changes))
+	for _, c := range changes {
+		if c.Sensitive {
+			parts = append(parts, c.Field+" (changed)")
+			continue
+		}
+		parts = append(parts, fmt.Sprintf("%s: %s -> %s", c.Field, c.Before, c.After))
+	}
+	return strings.Join(parts, "; ")
+}
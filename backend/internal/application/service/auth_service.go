@@ -12,6 +12,7 @@ import (
 	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
 	"github.com/sogos/mirai-backend/internal/domain/repository"
 	"github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/domain/tenant"
 	"github.com/sogos/mirai-backend/internal/domain/valueobject"
 )
 
@@ -20,7 +21,9 @@ type AuthService struct {
 	userRepo              repository.UserRepository
 	companyRepo           repository.CompanyRepository
 	invitationRepo        repository.InvitationRepository
+	teamRepo              repository.TeamRepository
 	pendingRegRepo        repository.PendingRegistrationRepository
+	storageRegions        StorageRegionCatalog
 	identity              service.IdentityProvider
 	payments              service.PaymentProvider
 	logger                service.Logger
@@ -54,6 +57,31 @@ func NewAuthService(
 	}
 }
 
+// SetTeamRepository adds users who register through a team invitation to that team.
+func (s *AuthService) SetTeamRepository(teamRepo repository.TeamRepository) {
+	s.teamRepo = teamRepo
+}
+
+// StorageRegionCatalog lists the storage regions a new tenant can keep its data in.
+type StorageRegionCatalog interface {
+	PrimaryRegion() string
+	Regions() []string
+	HasRegion(name string) bool
+}
+
+// SetStorageRegions lets registrations choose a storage region.
+func (s *AuthService) SetStorageRegions(regions StorageRegionCatalog) {
+	s.storageRegions = regions
+}
+
+// ListStorageRegions returns the storage regions a registration can choose, primary first.
+func (s *AuthService) ListStorageRegions() []string {
+	if s.storageRegions == nil {
+		return nil
+	}
+	return s.storageRegions.Regions()
+}
+
 // CheckEmailExists checks if an email is already registered in Kratos
 // or has a pending registration awaiting payment.
 func (s *AuthService) CheckEmailExists(ctx context.Context, email string) (bool, error) {
@@ -108,6 +136,11 @@ func (s *AuthService) Register(ctx context.Context, req dto.RegisterRequest) (*d
 		return nil, domainerrors.ErrInvalidPlan.WithMessage("this registration flow requires a paid plan")
 	}
 
+	// The storage region can't be changed after provisioning; "" keeps the primary region
+	if req.StorageRegion != "" && (s.storageRegions == nil || !s.storageRegions.HasRegion(req.StorageRegion)) {
+		return nil, domainerrors.ErrInvalidInput.WithMessage("unknown storage region: " + req.StorageRegion)
+	}
+
 	// Step 4: Hash password with bcrypt
 	passwordHash, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
 	if err != nil {
@@ -156,6 +189,7 @@ func (s *AuthService) Register(ctx context.Context, req dto.RegisterRequest) (*d
 		TeamSize:          teamSize,
 		Plan:              req.Plan,
 		SeatCount:         seatCount,
+		StorageRegion:     req.StorageRegion,
 		Status:            valueobject.PendingRegistrationStatusPending,
 		ExpiresAt:         time.Now().Add(24 * time.Hour),
 	}
@@ -354,21 +388,22 @@ func (s *AuthService) RegisterWithInvitation(ctx context.Context, req dto.Regist
 		return nil, domainerrors.ErrInvitationNotFound
 	}
 
-	// Step 2: Check invitation status
-	if !invitation.CanBeAccepted() {
-		if invitation.IsExpired() {
-			return nil, domainerrors.ErrInvitationExpired
-		}
-		if invitation.Status == valueobject.InvitationStatusRevoked {
-			return nil, domainerrors.ErrInvitationRevoked
-		}
-		if invitation.Status == valueobject.InvitationStatusAccepted {
-			return nil, domainerrors.ErrInvitationAlreadyAccepted
-		}
-		return nil, domainerrors.ErrInvitationInvalid
+	log = log.With("email", invitation.Email)
+
+	// Step 2: An email that already has an account can't register again
+	existing, err := s.identity.GetIdentityByEmail(ctx, invitation.Email)
+	if err != nil {
+		log.Error("failed to look up identity", "error", err)
+		return nil, domainerrors.ErrExternalService.WithMessage(err.Error())
+	}
+	if existing != nil {
+		return s.registerExistingInvitee(ctx, invitation, existing, req.Password)
 	}
 
-	log = log.With("email", invitation.Email)
+	// Step 2b: Check invitation status
+	if !invitation.CanBeAccepted() {
+		return nil, invitationStateError(invitation)
+	}
 
 	// Step 3: Create identity in Kratos
 	identity, err := s.identity.CreateIdentity(ctx, service.CreateIdentityRequest{
@@ -397,6 +432,7 @@ func (s *AuthService) RegisterWithInvitation(ctx context.Context, req dto.Regist
 		KratosID:  kratosID,
 		CompanyID: &invitation.CompanyID,
 		Role:      invitation.Role,
+		Locale:    valueobject.ParseLocale(identity.Locale),
 	}
 
 	if err := s.userRepo.Create(ctx, user); err != nil {
@@ -405,11 +441,12 @@ func (s *AuthService) RegisterWithInvitation(ctx context.Context, req dto.Regist
 	}
 
 	// Step 5: Mark invitation as accepted
-	invitation.Accept(user.ID)
-	if err := s.invitationRepo.Update(ctx, invitation); err != nil {
+	if _, err := s.invitationRepo.MarkAccepted(ctx, invitation.ID, user.ID); err != nil {
 		log.Error("failed to update invitation", "error", err)
 		// Don't fail - user is created, just log the error
 	}
+	invitation.Accept(user.ID)
+	joinInvitedTeam(ctx, s.teamRepo, invitation, user.ID, log)
 
 	// Step 6: Get company details
 	company, err := s.companyRepo.GetByID(ctx, invitation.CompanyID)
@@ -433,3 +470,69 @@ func (s *AuthService) RegisterWithInvitation(ctx context.Context, req dto.Regist
 		SessionToken: sessionToken.Token,
 	}, nil
 }
+
+// registerExistingInvitee handles an invitation registration for an email that already
+// has an account. Users belong to a single company, so an account in another company
+// is rejected and the invitation stays pending for a different email. An account that
+// already joined the inviting company is a repeated submission (e.g. a double-click):
+// if the password signs in, it succeeds again without consuming another seat.
+func (s *AuthService) registerExistingInvitee(
+	ctx context.Context,
+	invitation *entity.Invitation,
+	identity *service.Identity,
+	password string,
+) (*dto.RegisterWithInvitationResponse, error) {
+	log := s.logger.With("email", invitation.Email, "kratosID", identity.ID)
+
+	kratosID, err := uuid.Parse(identity.ID)
+	if err != nil {
+		log.Error("failed to parse Kratos ID", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	// Registration is public, so the account's tenant isn't known yet
+	user, err := s.userRepo.GetByKratosID(tenant.WithSuperAdmin(ctx, true), kratosID)
+	if err != nil {
+		log.Error("failed to get user", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	if user == nil || user.CompanyID == nil {
+		return nil, domainerrors.ErrEmailAlreadyExists.WithMessage(
+			"an account with this email already exists - sign in to accept the invitation")
+	}
+	if *user.CompanyID != invitation.CompanyID {
+		log.Info("invited email belongs to another company", "userID", user.ID)
+		return nil, domainerrors.ErrInvitationEmailInOtherCompany
+	}
+	if !invitation.CanBeAccepted() && !acceptedBy(invitation, user.ID) {
+		return nil, invitationStateError(invitation)
+	}
+
+	sessionToken, err := s.identity.PerformLogin(ctx, invitation.Email, password)
+	if err != nil {
+		log.Info("repeated invitation registration failed to sign in", "error", err)
+		return nil, domainerrors.ErrEmailAlreadyExists.WithMessage(
+			"an account with this email already exists - sign in instead")
+	}
+
+	if invitation.Status.IsPending() {
+		if _, err := s.invitationRepo.MarkAccepted(ctx, invitation.ID, user.ID); err != nil {
+			log.Error("failed to update invitation", "error", err)
+		}
+		invitation.Accept(user.ID)
+	}
+	joinInvitedTeam(ctx, s.teamRepo, invitation, user.ID, log)
+
+	company, err := s.companyRepo.GetByID(ctx, invitation.CompanyID)
+	if err != nil {
+		log.Error("failed to get company", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	log.Info("invited user already registered, signed in", "userID", user.ID)
+	return &dto.RegisterWithInvitationResponse{
+		User:         dto.FromUser(user),
+		Company:      dto.FromCompany(company),
+		SessionToken: sessionToken.Token,
+	}, nil
+}
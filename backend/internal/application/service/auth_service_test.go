@@ -0,0 +1,327 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/application/dto"
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+	"github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/domain/tenant"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+// fakeUserRepo keeps users in memory. Methods the tests don't use panic through the
+// embedded nil interface.
+type fakeUserRepo struct {
+	repository.UserRepository
+	users   []*entity.User
+	created []*entity.User
+}
+
+func (r *fakeUserRepo) GetByKratosID(ctx context.Context, kratosID uuid.UUID) (*entity.User, error) {
+	for _, u := range r.users {
+		if u.KratosID != kratosID {
+			continue
+		}
+		// Like RLS, a tenant-scoped lookup only sees the tenant's users
+		if id, ok := tenant.FromContext(ctx); !tenant.IsSuperAdmin(ctx) && (!ok || u.TenantID == nil || *u.TenantID != id) {
+			return nil, nil
+		}
+		return u, nil
+	}
+	return nil, nil
+}
+
+func (r *fakeUserRepo) GetByID(_ context.Context, id uuid.UUID) (*entity.User, error) {
+	for _, u := range r.users {
+		if u.ID == id {
+			return u, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeUserRepo) Create(_ context.Context, u *entity.User) error {
+	u.ID = uuid.New()
+	r.users = append(r.users, u)
+	r.created = append(r.created, u)
+	return nil
+}
+
+type fakeCompanyRepo struct {
+	repository.CompanyRepository
+	companies map[uuid.UUID]*entity.Company
+}
+
+func (r *fakeCompanyRepo) GetByID(_ context.Context, id uuid.UUID) (*entity.Company, error) {
+	return r.companies[id], nil
+}
+
+type fakeInvitationRepo struct {
+	repository.InvitationRepository
+	invitations map[string]*entity.Invitation
+	accepted    []uuid.UUID // Users MarkAccepted was called with
+}
+
+func (r *fakeInvitationRepo) GetByToken(_ context.Context, token string) (*entity.Invitation, error) {
+	if inv, ok := r.invitations[token]; ok {
+		copied := *inv
+		return &copied, nil
+	}
+	return nil, nil
+}
+
+func (r *fakeInvitationRepo) MarkAccepted(_ context.Context, id, userID uuid.UUID) (bool, error) {
+	for _, inv := range r.invitations {
+		if inv.ID == id && inv.Status.IsPending() {
+			inv.Accept(userID)
+			r.accepted = append(r.accepted, userID)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// fakeIdentityProvider is an identity service holding identities and their passwords.
+type fakeIdentityProvider struct {
+	service.IdentityProvider
+	identities map[string]*service.Identity // By email
+	passwords  map[string]string            // By email
+	created    int
+	logins     int
+}
+
+func (p *fakeIdentityProvider) GetIdentityByEmail(_ context.Context, email string) (*service.Identity, error) {
+	return p.identities[email], nil
+}
+
+func (p *fakeIdentityProvider) CreateIdentity(_ context.Context, req service.CreateIdentityRequest) (*service.Identity, error) {
+	if _, exists := p.identities[req.Email]; exists {
+		return nil, errors.New("an account with this email already exists")
+	}
+	identity := &service.Identity{ID: uuid.NewString(), Email: req.Email, FirstName: req.FirstName, LastName: req.LastName}
+	p.identities[req.Email] = identity
+	p.passwords[req.Email] = req.Password
+	p.created++
+	return identity, nil
+}
+
+func (p *fakeIdentityProvider) PerformLogin(_ context.Context, email, password string) (*service.SessionToken, error) {
+	if pw, ok := p.passwords[email]; !ok || pw != password {
+		return nil, errors.New("the provided credentials are invalid")
+	}
+	p.logins++
+	return &service.SessionToken{Token: "session-" + email, ExpiresAt: time.Now().Add(time.Hour).Unix()}, nil
+}
+
+// invitationFixture is an AuthService with one pending invitation from Acme.
+type invitationFixture struct {
+	svc         *AuthService
+	users       *fakeUserRepo
+	invitations *fakeInvitationRepo
+	identity    *fakeIdentityProvider
+	invitation  *entity.Invitation
+	company     *entity.Company
+	other       *entity.Company
+}
+
+const (
+	testInviteToken = "invite-token-0123456789"
+	testInviteEmail = "sam@example.com"
+	testPassword    = "correct horse battery"
+)
+
+func newInvitationFixture() *invitationFixture {
+	company := &entity.Company{ID: uuid.New(), TenantID: uuid.New(), Name: "Acme"}
+	other := &entity.Company{ID: uuid.New(), TenantID: uuid.New(), Name: "Globex"}
+	invitation := entity.NewInvitation(company.TenantID, company.ID, testInviteEmail, valueobject.RoleMember,
+		testInviteToken, uuid.New(), 7*24*time.Hour)
+	invitation.ID = uuid.New()
+
+	f := &invitationFixture{
+		users:       &fakeUserRepo{},
+		invitations: &fakeInvitationRepo{invitations: map[string]*entity.Invitation{testInviteToken: invitation}},
+		identity: &fakeIdentityProvider{
+			identities: make(map[string]*service.Identity),
+			passwords:  make(map[string]string),
+		},
+		invitation: invitation,
+		company:    company,
+		other:      other,
+	}
+	companies := &fakeCompanyRepo{companies: map[uuid.UUID]*entity.Company{company.ID: company, other.ID: other}}
+	f.svc = NewAuthService(f.users, companies, f.invitations, nil, f.identity, nil, nopLogger{}, "", "", "")
+	return f
+}
+
+// addAccount registers an existing account for the invited email in company.
+func (f *invitationFixture) addAccount(company *entity.Company) *entity.User {
+	identity := &service.Identity{ID: uuid.NewString(), Email: testInviteEmail}
+	f.identity.identities[testInviteEmail] = identity
+	f.identity.passwords[testInviteEmail] = testPassword
+	user := &entity.User{
+		ID:        uuid.New(),
+		TenantID:  &company.TenantID,
+		KratosID:  uuid.MustParse(identity.ID),
+		CompanyID: &company.ID,
+		Role:      valueobject.RoleMember,
+		IsActive:  true,
+	}
+	f.users.users = append(f.users.users, user)
+	return user
+}
+
+func (f *invitationFixture) register(password string) (*dto.RegisterWithInvitationResponse, error) {
+	return f.svc.RegisterWithInvitation(context.Background(), dto.RegisterWithInvitationRequest{
+		Token:     testInviteToken,
+		Password:  password,
+		FirstName: "Sam",
+		LastName:  "Lee",
+	})
+}
+
+func TestRegisterWithInvitationNewUser(t *testing.T) {
+	f := newInvitationFixture()
+
+	resp, err := f.register(testPassword)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if f.identity.created != 1 || len(f.users.created) != 1 {
+		t.Fatalf("created %d identities and %d users, want 1 each", f.identity.created, len(f.users.created))
+	}
+	user := f.users.created[0]
+	if user.CompanyID == nil || *user.CompanyID != f.company.ID || user.TenantID == nil || *user.TenantID != f.company.TenantID {
+		t.Errorf("user joined company %v tenant %v, want %s %s", user.CompanyID, user.TenantID, f.company.ID, f.company.TenantID)
+	}
+	if user.Role != valueobject.RoleMember {
+		t.Errorf("role = %s, want the invitation's %s", user.Role, valueobject.RoleMember)
+	}
+	if f.invitation.Status != valueobject.InvitationStatusAccepted || *f.invitation.AcceptedByUserID != user.ID {
+		t.Errorf("invitation = %s by %v, want accepted by %s", f.invitation.Status, f.invitation.AcceptedByUserID, user.ID)
+	}
+	if resp.User.ID != user.ID || resp.Company.ID != f.company.ID || resp.SessionToken == "" {
+		t.Errorf("response = user %s, company %s, session %q", resp.User.ID, resp.Company.ID, resp.SessionToken)
+	}
+}
+
+func TestRegisterWithInvitationExistingUserInSameCompany(t *testing.T) {
+	f := newInvitationFixture()
+	user := f.addAccount(f.company)
+
+	resp, err := f.register(testPassword)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if f.identity.created != 0 || len(f.users.created) != 0 {
+		t.Errorf("created %d identities and %d users for an existing account", f.identity.created, len(f.users.created))
+	}
+	if resp.User.ID != user.ID || resp.Company.ID != f.company.ID || resp.SessionToken == "" {
+		t.Errorf("response = user %s, company %s, session %q; want the existing user signed in", resp.User.ID, resp.Company.ID, resp.SessionToken)
+	}
+	if f.invitation.Status != valueobject.InvitationStatusAccepted || *f.invitation.AcceptedByUserID != user.ID {
+		t.Errorf("invitation = %s by %v, want accepted by %s", f.invitation.Status, f.invitation.AcceptedByUserID, user.ID)
+	}
+
+	// A repeated submission signs in again without accepting the invitation twice
+	if _, err := f.register(testPassword); err != nil {
+		t.Fatalf("repeated registration: %v", err)
+	}
+	if len(f.invitations.accepted) != 1 {
+		t.Errorf("invitation accepted %d times, want once", len(f.invitations.accepted))
+	}
+}
+
+func TestRegisterWithInvitationExistingUserWrongPassword(t *testing.T) {
+	f := newInvitationFixture()
+	f.addAccount(f.company)
+
+	_, err := f.register("not the password")
+	if !errors.Is(err, domainerrors.ErrEmailAlreadyExists) {
+		t.Fatalf("error = %v, want ErrEmailAlreadyExists", err)
+	}
+	if !f.invitation.Status.IsPending() {
+		t.Errorf("invitation = %s, want it still pending", f.invitation.Status)
+	}
+}
+
+func TestRegisterWithInvitationExistingUserInOtherCompany(t *testing.T) {
+	f := newInvitationFixture()
+	user := f.addAccount(f.other)
+
+	_, err := f.register(testPassword)
+	if !errors.Is(err, domainerrors.ErrInvitationEmailInOtherCompany) {
+		t.Fatalf("error = %v, want ErrInvitationEmailInOtherCompany", err)
+	}
+	if f.identity.logins != 0 {
+		t.Error("signed in to an account in another company")
+	}
+	if f.identity.created != 0 || len(f.users.created) != 0 {
+		t.Errorf("created %d identities and %d users", f.identity.created, len(f.users.created))
+	}
+	if !f.invitation.Status.IsPending() {
+		t.Errorf("invitation = %s, want it still pending for another email", f.invitation.Status)
+	}
+	if *user.CompanyID != f.other.ID {
+		t.Error("existing user was moved to the inviting company")
+	}
+}
+
+func TestRegisterWithInvitationIdentityWithoutUser(t *testing.T) {
+	f := newInvitationFixture()
+	f.identity.identities[testInviteEmail] = &service.Identity{ID: uuid.NewString(), Email: testInviteEmail}
+	f.identity.passwords[testInviteEmail] = testPassword
+
+	_, err := f.register(testPassword)
+	if !errors.Is(err, domainerrors.ErrEmailAlreadyExists) {
+		t.Fatalf("error = %v, want ErrEmailAlreadyExists", err)
+	}
+	if f.identity.logins != 0 || !f.invitation.Status.IsPending() {
+		t.Errorf("logins = %d, invitation = %s; want no sign-in and a pending invitation", f.identity.logins, f.invitation.Status)
+	}
+}
+
+func TestRegisterWithInvitationUnusableInvitation(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(*entity.Invitation)
+		wantErr error
+	}{
+		{"revoked", func(i *entity.Invitation) { i.Revoke() }, domainerrors.ErrInvitationRevoked},
+		{"expired", func(i *entity.Invitation) { i.ExpiresAt = time.Now().Add(-time.Hour) }, domainerrors.ErrInvitationExpired},
+		{"accepted by someone else", func(i *entity.Invitation) { i.Accept(uuid.New()) }, domainerrors.ErrInvitationAlreadyAccepted},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Neither a new nor an existing account may use the invitation
+			for _, existing := range []bool{false, true} {
+				f := newInvitationFixture()
+				tt.modify(f.invitation)
+				if existing {
+					f.addAccount(f.company)
+				}
+				if _, err := f.register(testPassword); !errors.Is(err, tt.wantErr) {
+					t.Errorf("existing account %v: error = %v, want %v", existing, err, tt.wantErr)
+				}
+				if f.identity.created != 0 || len(f.users.created) != 0 {
+					t.Errorf("existing account %v: an account was created", existing)
+				}
+			}
+		})
+	}
+
+	f := newInvitationFixture()
+	f.invitations.invitations = nil
+	if _, err := f.register(testPassword); !errors.Is(err, domainerrors.ErrInvitationNotFound) {
+		t.Errorf("unknown token: error = %v, want ErrInvitationNotFound", err)
+	}
+}
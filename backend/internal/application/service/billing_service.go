@@ -5,6 +5,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/sogos/mirai-backend/internal/application/dto"
+	"github.com/sogos/mirai-backend/internal/domain/audit"
 	"github.com/sogos/mirai-backend/internal/domain/entity"
 	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
 	"github.com/sogos/mirai-backend/internal/domain/repository"
@@ -17,6 +18,7 @@ type BillingService struct {
 	userRepo    repository.UserRepository
 	companyRepo repository.CompanyRepository
 	payments    service.PaymentProvider
+	auditLog    AuditLogger
 	logger      service.Logger
 	frontendURL string
 }
@@ -38,6 +40,31 @@ func NewBillingService(
 	}
 }
 
+// SetAuditLogger enables audit logging of billing changes.
+func (s *BillingService) SetAuditLogger(logger AuditLogger) {
+	s.auditLog = logger
+}
+
+// billingAuditEntry describes a change to a company's billing. actorUserID is nil for Stripe webhooks.
+func billingAuditEntry(company *entity.Company, actorUserID *uuid.UUID, action audit.Action, changes audit.Changes) audit.Entry {
+	return audit.Entry{
+		TenantID:    company.TenantID,
+		ActorUserID: actorUserID,
+		Action:      action,
+		TargetType:  audit.TargetCompany,
+		TargetID:    company.ID.String(),
+		Changes:     changes,
+	}
+}
+
+// subscriptionChanges summarizes how applying fields changes the company's subscription.
+func subscriptionChanges(company *entity.Company, fields entity.StripeFields) audit.Changes {
+	return audit.Changes{}.
+		Field("plan", company.Plan, fields.Plan).
+		Field("subscription_status", company.SubscriptionStatus, fields.Status).
+		Field("seat_count", company.SeatCount, fields.SeatCount)
+}
+
 // GetBillingInfo retrieves the current billing status for a user's company.
 func (s *BillingService) GetBillingInfo(ctx context.Context, kratosID uuid.UUID) (*dto.BillingInfoResponse, error) {
 	user, company, err := s.getUserAndCompany(ctx, kratosID)
@@ -133,6 +160,9 @@ func (s *BillingService) CreateCheckoutSession(ctx context.Context, kratosID uui
 		}
 	}
 
+	recordAudit(ctx, s.auditLog, log, billingAuditEntry(company, &user.ID, audit.ActionCheckoutStarted,
+		audit.Changes{}.Field("plan", company.Plan, plan).Field("seat_count", "", seatCount)))
+
 	return &dto.CheckoutResponse{URL: sess.URL}, nil
 }
 
@@ -189,12 +219,22 @@ func (s *BillingService) HandleCheckoutCompleted(ctx context.Context, companyIDS
 		}
 	}
 
-	err = s.companyRepo.UpdateStripeFields(ctx, companyID, entity.StripeFields{
+	company, err := s.companyRepo.GetByID(ctx, companyID)
+	if err != nil || company == nil {
+		log.Error("company not found for checkout", "error", err)
+		return domainerrors.ErrCompanyNotFound
+	}
+
+	fields := entity.StripeFields{
 		CustomerID:     &customerID,
 		SubscriptionID: &subscriptionID,
 		Status:         valueobject.SubscriptionStatusActive,
 		Plan:           parsedPlan,
 		SeatCount:      seatCount,
+	}
+	entry := billingAuditEntry(company, nil, audit.ActionSubscriptionActivated, subscriptionChanges(company, fields))
+	err = audited(ctx, s.auditLog, &entry, func(ctx context.Context) error {
+		return s.companyRepo.UpdateStripeFields(ctx, companyID, fields)
 	})
 	if err != nil {
 		log.Error("failed to update company stripe fields", "error", err)
@@ -221,12 +261,16 @@ func (s *BillingService) HandleSubscriptionUpdated(ctx context.Context, customer
 	}
 
 	subID := sub.ID
-	err = s.companyRepo.UpdateStripeFields(ctx, company.ID, entity.StripeFields{
+	fields := entity.StripeFields{
 		CustomerID:     &customerID,
 		SubscriptionID: &subID,
 		Status:         sub.Status,
 		Plan:           plan,
 		SeatCount:      sub.SeatCount,
+	}
+	entry := billingAuditEntry(company, nil, audit.ActionSubscriptionUpdated, subscriptionChanges(company, fields))
+	err = audited(ctx, s.auditLog, &entry, func(ctx context.Context) error {
+		return s.companyRepo.UpdateStripeFields(ctx, company.ID, fields)
 	})
 	if err != nil {
 		log.Error("failed to update subscription", "error", err)
@@ -248,11 +292,15 @@ func (s *BillingService) HandleSubscriptionDeleted(ctx context.Context, customer
 	}
 
 	// Reset to starter plan and clear seat count
-	err = s.companyRepo.UpdateStripeFields(ctx, company.ID, entity.StripeFields{
+	fields := entity.StripeFields{
 		CustomerID: &customerID,
 		Status:     valueobject.SubscriptionStatusCanceled,
 		Plan:       valueobject.PlanStarter,
 		SeatCount:  0,
+	}
+	entry := billingAuditEntry(company, nil, audit.ActionSubscriptionCanceled, subscriptionChanges(company, fields))
+	err = audited(ctx, s.auditLog, &entry, func(ctx context.Context) error {
+		return s.companyRepo.UpdateStripeFields(ctx, company.ID, fields)
 	})
 	if err != nil {
 		log.Error("failed to handle subscription deletion", "error", err)
@@ -281,6 +329,9 @@ func (s *BillingService) UpdateSeatCount(ctx context.Context, companyID uuid.UUI
 		return domainerrors.ErrExternalService.WithCause(err)
 	}
 
+	recordAudit(ctx, s.auditLog, s.logger, billingAuditEntry(company, nil, audit.ActionSeatCountUpdated,
+		audit.Changes{}.Field("seat_count", company.SeatCount, newCount)))
+
 	s.logger.Info("updated seat count", "companyID", companyID, "newCount", newCount)
 	return nil
 }
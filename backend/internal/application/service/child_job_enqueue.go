@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/tenant"
+)
+
+const (
+	// unenqueuedChildGrace leaves time for the request that queued child jobs to enqueue
+	// their tasks itself before the sweeper steps in.
+	unenqueuedChildGrace = time.Minute
+
+	// unenqueuedChildBatchSize is how many child jobs one sweep enqueues. The rest wait
+	// for the next sweep.
+	unenqueuedChildBatchSize = 200
+)
+
+// enqueueChildJobs enqueues a worker task for each child job after the transaction that
+// created or requeued them has committed, and records which were enqueued. A job whose
+// enqueue fails is left for EnqueueUnenqueuedChildJobs. Enqueueing a job twice is harmless:
+// only the first task to claim it processes it.
+func (s *AIGenerationService) enqueueChildJobs(ctx context.Context, jobs []*entity.GenerationJob) {
+	if s.taskEnqueuer == nil || len(jobs) == 0 {
+		return
+	}
+
+	enqueued := make([]uuid.UUID, 0, len(jobs))
+	for _, job := range jobs {
+		if err := s.taskEnqueuer.EnqueueAIGeneration(job.ID.String(), string(job.Type)); err != nil {
+			s.logger.Warn("failed to enqueue child job, the sweeper will retry", "jobID", job.ID, "parentJobID", job.ParentJobID, "error", err)
+			continue
+		}
+		enqueued = append(enqueued, job.ID)
+	}
+	if err := s.jobRepo.MarkEnqueued(ctx, enqueued); err != nil {
+		s.logger.Warn("failed to record enqueued child jobs", "count", len(enqueued), "error", err)
+	}
+	if len(enqueued) < len(jobs) {
+		s.logger.Warn("some child jobs were not enqueued", "enqueued", len(enqueued), "total", len(jobs))
+	}
+}
+
+// EnqueueUnenqueuedChildJobs enqueues worker tasks for queued child jobs, in every tenant,
+// whose enqueue failed or never ran, such as after a crash between commit and enqueue.
+// Returns how many were enqueued.
+func (s *AIGenerationService) EnqueueUnenqueuedChildJobs(ctx context.Context) (int, error) {
+	if s.taskEnqueuer == nil {
+		return 0, nil
+	}
+
+	// Child jobs belong to every tenant; the sweep runs without a user session
+	adminCtx := tenant.WithSuperAdmin(ctx, true)
+	jobs, err := s.jobRepo.ListUnenqueuedChildren(adminCtx, time.Now().Add(-unenqueuedChildGrace), unenqueuedChildBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	enqueued := make([]uuid.UUID, 0, len(jobs))
+	for _, job := range jobs {
+		if err := s.taskEnqueuer.EnqueueAIGeneration(job.ID.String(), string(job.Type)); err != nil {
+			s.logger.Warn("failed to enqueue child job", "jobID", job.ID, "tenantID", job.TenantID, "error", err)
+			continue
+		}
+		enqueued = append(enqueued, job.ID)
+	}
+	if err := s.jobRepo.MarkEnqueued(adminCtx, enqueued); err != nil {
+		return len(enqueued), err
+	}
+	return len(enqueued), nil
+}
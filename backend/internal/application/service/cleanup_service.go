@@ -6,11 +6,29 @@ import (
 
 	"github.com/sogos/mirai-backend/internal/domain/repository"
 	"github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/domain/tenant"
 )
 
-// CleanupService handles cleanup of expired pending registrations.
+// GenerationDraftMaxAge is how long an untouched generation wizard draft is kept.
+const GenerationDraftMaxAge = 30 * 24 * time.Hour
+
+// StaleUploadAborter aborts multipart uploads that were never completed.
+type StaleUploadAborter interface {
+	AbortStaleMultipartUploads(ctx context.Context, cutoff time.Time) (int, error)
+}
+
+// ExpiredExportPurger deletes export artifacts past their retention period.
+type ExpiredExportPurger interface {
+	PurgeExpiredArtifacts(ctx context.Context) (int, error)
+}
+
+// CleanupService handles cleanup of expired pending registrations, stale generation
+// drafts, abandoned uploads and expired exports.
 type CleanupService struct {
 	pendingRegRepo repository.PendingRegistrationRepository
+	draftRepo      repository.GenerationDraftRepository
+	uploads        StaleUploadAborter
+	exports        ExpiredExportPurger
 	logger         service.Logger
 }
 
@@ -25,7 +43,61 @@ func NewCleanupService(
 	}
 }
 
-// CleanupExpired removes all expired pending registrations.
+// SetUploadStorage enables AbortAbandonedUploads.
+func (s *CleanupService) SetUploadStorage(uploads StaleUploadAborter) {
+	s.uploads = uploads
+}
+
+// SetGenerationDraftRepository enables cleanup of stale generation drafts in CleanupExpired.
+func (s *CleanupService) SetGenerationDraftRepository(draftRepo repository.GenerationDraftRepository) {
+	s.draftRepo = draftRepo
+}
+
+// SetExpiredExportPurger enables PurgeExpiredExports.
+func (s *CleanupService) SetExpiredExportPurger(exports ExpiredExportPurger) {
+	s.exports = exports
+}
+
+// PurgeExpiredExports deletes the files of export artifacts whose tenant's retention
+// period has ended.
+func (s *CleanupService) PurgeExpiredExports(ctx context.Context) error {
+	if s.exports == nil {
+		return nil
+	}
+	log := s.logger.With("job", "purge-expired-exports")
+
+	purged, err := s.exports.PurgeExpiredArtifacts(ctx)
+	if purged > 0 {
+		log.Info("purged expired export artifacts", "count", purged)
+	}
+	if err != nil {
+		log.Error("failed to purge expired export artifacts", "error", err)
+		return err
+	}
+	return nil
+}
+
+// AbortAbandonedUploads aborts multipart uploads older than AbandonedUploadAge.
+// Storage bills for the parts of incomplete uploads until they are aborted.
+func (s *CleanupService) AbortAbandonedUploads(ctx context.Context) error {
+	if s.uploads == nil {
+		return nil
+	}
+	log := s.logger.With("job", "abort-abandoned-uploads")
+
+	aborted, err := s.uploads.AbortStaleMultipartUploads(ctx, time.Now().Add(-AbandonedUploadAge))
+	if aborted > 0 {
+		log.Info("aborted abandoned multipart uploads", "count", aborted)
+	}
+	if err != nil {
+		log.Error("failed to abort abandoned multipart uploads", "error", err)
+		return err
+	}
+	return nil
+}
+
+// CleanupExpired removes all expired pending registrations and generation drafts older
+// than GenerationDraftMaxAge.
 // This should be called periodically (e.g., every hour) by a background job.
 func (s *CleanupService) CleanupExpired(ctx context.Context) error {
 	log := s.logger.With("job", "cleanup")
@@ -40,6 +112,19 @@ func (s *CleanupService) CleanupExpired(ctx context.Context) error {
 		log.Info("deleted expired pending registrations", "count", deleted)
 	}
 
+	if s.draftRepo != nil {
+		// Drafts belong to every tenant; the job runs without a user session
+		adminCtx := tenant.WithSuperAdmin(ctx, true)
+		deleted, err := s.draftRepo.DeleteOlderThan(adminCtx, time.Now().Add(-GenerationDraftMaxAge))
+		if err != nil {
+			log.Error("failed to delete stale generation drafts", "error", err)
+			return err
+		}
+		if deleted > 0 {
+			log.Info("deleted stale generation drafts", "count", deleted)
+		}
+	}
+
 	return nil
 }
 
@@ -0,0 +1,188 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+// componentAssetUploadExpiry is how long the presigned upload URL for a component asset stays valid.
+// Videos are large, so this is longer than for SME submissions.
+const componentAssetUploadExpiry = time.Hour
+
+// componentAssetTypes are the component types whose placeholder can be replaced by an
+// uploaded file, with the MIME type prefix the file must have.
+var componentAssetTypes = map[valueobject.LessonComponentType]string{
+	valueobject.LessonComponentTypeVideo: "video/",
+}
+
+// ComponentAssetStorage presigns component asset uploads and downloads, checks uploads
+// on confirmation, and stores generated images.
+type ComponentAssetStorage interface {
+	BuildPath(tenantID uuid.UUID, subpath string) string
+	GenerateUploadURL(ctx context.Context, tenantID uuid.UUID, subpath string, expiry time.Duration) (string, error)
+	GenerateDownloadURL(ctx context.Context, tenantID uuid.UUID, subpath string, expiry time.Duration) (string, error)
+	TagObject(ctx context.Context, tenantID uuid.UUID, subpath string) error
+	OpenContent(ctx context.Context, tenantID uuid.UUID, subpath string) (io.ReadCloser, error)
+	PutContent(ctx context.Context, path string, content []byte, contentType string) error
+}
+
+// SetComponentAssetStorage enables uploading files for placeholder components.
+func (s *AIGenerationService) SetComponentAssetStorage(storage ComponentAssetStorage) {
+	s.assetStorage = storage
+}
+
+// GetComponentAssetUploadRequest contains the parameters for presigning a component asset upload.
+type GetComponentAssetUploadRequest struct {
+	ComponentID uuid.UUID
+	FileName    string
+	ContentType string
+}
+
+// GetComponentAssetUploadURL returns a presigned URL for uploading the file for a
+// placeholder component, and the path to pass to ConfirmComponentAsset once the upload
+// finishes. The component is unchanged until then.
+func (s *AIGenerationService) GetComponentAssetUploadURL(ctx context.Context, kratosID uuid.UUID, req GetComponentAssetUploadRequest) (string, string, error) {
+	if s.assetStorage == nil {
+		return "", "", domainerrors.ErrInternal.WithMessage("component asset uploads are not configured")
+	}
+
+	user, _, component, err := s.componentForAsset(ctx, kratosID, req.ComponentID)
+	if err != nil {
+		return "", "", err
+	}
+
+	fileName := path.Base(strings.ReplaceAll(strings.TrimSpace(req.FileName), "\\", "/"))
+	if fileName == "" || fileName == "." || fileName == "/" {
+		return "", "", domainerrors.ErrMissingRequired.WithMessage("file name is required")
+	}
+	if !strings.HasPrefix(strings.ToLower(req.ContentType), componentAssetTypes[component.Type]) {
+		return "", "", domainerrors.ErrInvalidInput.WithMessage(fmt.Sprintf("%s components accept %s* files only", component.Type, componentAssetTypes[component.Type]))
+	}
+
+	assetPath := componentAssetPrefix(component) + uuid.New().String() + "/" + fileName
+	url, err := s.assetStorage.GenerateUploadURL(ctx, *user.TenantID, assetPath, componentAssetUploadExpiry)
+	if err != nil {
+		s.logger.Error("failed to generate component asset upload URL", "componentID", component.ID, "error", err)
+		return "", "", domainerrors.ErrInternal.WithCause(err)
+	}
+
+	return url, assetPath, nil
+}
+
+// ConfirmComponentAsset attaches an uploaded file to its component. The placeholder
+// content, such as a video's script, is kept alongside the asset.
+func (s *AIGenerationService) ConfirmComponentAsset(ctx context.Context, kratosID, componentID uuid.UUID, assetPath string) (*entity.LessonComponent, error) {
+	log := s.logger.With("kratosID", kratosID, "componentID", componentID, "assetPath", assetPath)
+
+	if s.assetStorage == nil {
+		return nil, domainerrors.ErrInternal.WithMessage("component asset uploads are not configured")
+	}
+
+	user, lesson, component, err := s.componentForAsset(ctx, kratosID, componentID)
+	if err != nil {
+		return nil, err
+	}
+
+	fileName, ok := componentAssetFileName(component, assetPath)
+	if !ok {
+		return nil, domainerrors.ErrInvalidInput.WithMessage("asset must be uploaded with GetComponentAssetUploadURL")
+	}
+
+	r, err := s.assetStorage.OpenContent(ctx, *user.TenantID, assetPath)
+	if err != nil {
+		log.Warn("failed to open component asset", "error", err)
+		return nil, domainerrors.ErrInvalidInput.WithMessage("asset has not been uploaded")
+	}
+	_ = r.Close()
+	if err := s.assetStorage.TagObject(ctx, *user.TenantID, assetPath); err != nil {
+		log.Warn("failed to tag component asset", "error", err)
+	}
+
+	var content map[string]any
+	if err := json.Unmarshal(component.ContentJSON, &content); err != nil || content == nil {
+		content = map[string]any{}
+	}
+	content["asset_path"] = assetPath
+	content["asset_file_name"] = fileName
+	content["asset_uploaded_at"] = time.Now().UTC().Format(time.RFC3339)
+	data, err := json.Marshal(content)
+	if err != nil {
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	component.ContentJSON = data
+	if err := s.componentRepo.Update(ctx, component); err != nil {
+		log.Error("failed to attach component asset", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	s.invalidateCourseCaches(ctx, lesson.CourseID)
+
+	log.Info("component asset attached")
+	return component, nil
+}
+
+// componentForAsset loads a component the user may edit and that accepts an uploaded
+// file, along with its lesson.
+func (s *AIGenerationService) componentForAsset(ctx context.Context, kratosID, componentID uuid.UUID) (*entity.User, *entity.GeneratedLesson, *entity.LessonComponent, error) {
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, nil, nil, domainerrors.ErrUserNotFound
+	}
+	if user.TenantID == nil {
+		return nil, nil, nil, domainerrors.ErrUserHasNoCompany
+	}
+
+	component, err := s.componentRepo.GetByID(ctx, componentID)
+	if err != nil || component == nil {
+		return nil, nil, nil, domainerrors.ErrNotFound.WithMessage("component not found")
+	}
+	if _, ok := componentAssetTypes[component.Type]; !ok {
+		return nil, nil, nil, domainerrors.ErrInvalidInput.WithMessage(fmt.Sprintf("%s components do not accept uploaded files", component.Type))
+	}
+
+	lesson, err := s.genLessonRepo.GetByID(ctx, component.LessonID)
+	if err != nil || lesson == nil {
+		return nil, nil, nil, domainerrors.ErrNotFound.WithMessage("lesson not found")
+	}
+	if err := s.checkCourseAccess(ctx, user, lesson.CourseID); err != nil {
+		return nil, nil, nil, err
+	}
+	if err := s.checkCourseUnlocked(ctx, lesson.CourseID); err != nil {
+		return nil, nil, nil, err
+	}
+
+	return user, lesson, component, nil
+}
+
+// componentAssetPrefix returns the tenant-relative folder that holds a component's uploads.
+func componentAssetPrefix(component *entity.LessonComponent) string {
+	return "lessons/" + component.LessonID.String() + "/components/" + component.ID.String() + "/"
+}
+
+// componentAssetFileName returns the file name of an upload slot handed out for the
+// component, or false if the path points anywhere else.
+func componentAssetFileName(component *entity.LessonComponent, assetPath string) (string, bool) {
+	rest, ok := strings.CutPrefix(assetPath, componentAssetPrefix(component))
+	if !ok {
+		return "", false
+	}
+	id, name, _ := strings.Cut(rest, "/")
+	if _, err := uuid.Parse(id); err != nil {
+		return "", false
+	}
+	if name == "" || strings.Contains(name, "/") {
+		return "", false
+	}
+	return name, true
+}
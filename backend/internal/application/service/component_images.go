@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/service"
+)
+
+// componentImageAspectRatio matches the width lesson images are shown at in the player.
+const componentImageAspectRatio = "16:9"
+
+// componentImageExtensions maps the image types providers return to file extensions.
+var componentImageExtensions = map[string]string{
+	"image/png":  ".png",
+	"image/jpeg": ".jpg",
+	"image/webp": ".webp",
+}
+
+// generateComponentImage renders a picture for an image component from its description,
+// stores it under the course's assets and points the component's asset_path at it.
+// On error the component is left unchanged, keeping its description as a placeholder.
+func (s *AIGenerationService) generateComponentImage(ctx context.Context, aiProvider service.AIProvider, courseID uuid.UUID, component *entity.LessonComponent) error {
+	var content map[string]any
+	if err := json.Unmarshal(component.ContentJSON, &content); err != nil || content == nil {
+		return errors.New("image component content is not an object")
+	}
+	description, _ := content["image_description"].(string)
+	altText, _ := content["alt_text"].(string)
+	if strings.TrimSpace(description) == "" {
+		description = altText
+	}
+	if strings.TrimSpace(description) == "" {
+		return errors.New("image component has no description")
+	}
+
+	result, err := aiProvider.GenerateImage(ctx, service.GenerateImageRequest{
+		Description: description,
+		AltText:     altText,
+		AspectRatio: componentImageAspectRatio,
+	})
+	if err != nil {
+		return err
+	}
+	ext, ok := componentImageExtensions[result.MIMEType]
+	if !ok {
+		return fmt.Errorf("unsupported image type %q", result.MIMEType)
+	}
+
+	assetPath := courseImagePath(courseID, component.ID, ext)
+	if err := s.assetStorage.PutContent(ctx, s.assetStorage.BuildPath(component.TenantID, assetPath), result.Data, result.MIMEType); err != nil {
+		return fmt.Errorf("failed to store image: %w", err)
+	}
+
+	content["asset_path"] = assetPath
+	content["asset_file_name"] = path.Base(assetPath)
+	content["asset_generated_at"] = time.Now().UTC().Format(time.RFC3339)
+	data, err := json.Marshal(content)
+	if err != nil {
+		return err
+	}
+	component.ContentJSON = data
+	return nil
+}
+
+// courseImagePath returns the tenant-relative path of a component's generated image.
+// It sits under the course's folder so it is kept exactly as long as the course.
+func courseImagePath(courseID, componentID uuid.UUID, ext string) string {
+	return "courses/" + courseID.String() + "/assets/" + componentID.String() + ext
+}
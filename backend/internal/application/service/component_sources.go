@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+)
+
+// sourceExcerptRunes bounds the chunk excerpt returned for a component source.
+const sourceExcerptRunes = 280
+
+// ComponentSource is an SME knowledge chunk cited by a lesson component.
+type ComponentSource struct {
+	ChunkID uuid.UUID
+	SMEID   uuid.UUID
+	SMEName string
+	Topic   string
+	Excerpt string
+}
+
+// GetComponentSources returns the SME knowledge chunks a component was generated from.
+// Components generated before citations were recorded, and chunks deleted since, have no sources.
+func (s *AIGenerationService) GetComponentSources(ctx context.Context, kratosID, componentID uuid.UUID) ([]ComponentSource, error) {
+	log := s.logger.With("kratosID", kratosID, "componentID", componentID)
+
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, domainerrors.ErrUserNotFound
+	}
+
+	if user.TenantID == nil {
+		return nil, domainerrors.ErrUserHasNoCompany
+	}
+
+	component, err := s.componentRepo.GetByID(ctx, componentID)
+	if err != nil || component == nil {
+		return nil, domainerrors.ErrNotFound.WithMessage("component not found")
+	}
+
+	lesson, err := s.genLessonRepo.GetByID(ctx, component.LessonID)
+	if err != nil || lesson == nil {
+		return nil, domainerrors.ErrNotFound.WithMessage("lesson not found")
+	}
+
+	if err := s.checkCourseAccess(ctx, user, lesson.CourseID); err != nil {
+		return nil, err
+	}
+
+	sources := make([]ComponentSource, 0, len(component.SMEChunkIDs))
+	smes := make(map[uuid.UUID]*entity.SubjectMatterExpert)
+	for _, chunkID := range component.SMEChunkIDs {
+		chunk, err := s.smeKnowledgeRepo.GetByID(ctx, chunkID)
+		if err != nil {
+			log.Error("failed to get knowledge chunk", "chunkID", chunkID, "error", err)
+			return nil, domainerrors.ErrInternal.WithCause(err)
+		}
+		if chunk == nil {
+			// Reprocessing a submission replaces its chunks
+			continue
+		}
+
+		sme, ok := smes[chunk.SMEID]
+		if !ok {
+			sme, err = s.smeRepo.GetByID(ctx, chunk.SMEID)
+			if err != nil {
+				log.Error("failed to get SME", "smeID", chunk.SMEID, "error", err)
+				return nil, domainerrors.ErrInternal.WithCause(err)
+			}
+			smes[chunk.SMEID] = sme
+		}
+
+		source := ComponentSource{
+			ChunkID: chunk.ID,
+			SMEID:   chunk.SMEID,
+			Topic:   chunk.Topic,
+			Excerpt: excerpt(chunk.Content, sourceExcerptRunes),
+		}
+		if sme != nil {
+			source.SMEName = sme.Name
+		}
+		sources = append(sources, source)
+	}
+
+	return sources, nil
+}
+
+// sourceChunkIDs maps the chunk indices a provider cited for a component to chunk IDs.
+// Indices outside chunkIDs are dropped, as are repeats; the number dropped as invalid is returned.
+func sourceChunkIDs(indices []int, chunkIDs []uuid.UUID) ([]uuid.UUID, int) {
+	if len(indices) == 0 {
+		return nil, 0
+	}
+
+	ids := make([]uuid.UUID, 0, len(indices))
+	seen := make(map[int]bool, len(indices))
+	invalid := 0
+	for _, i := range indices {
+		if i < 0 || i >= len(chunkIDs) {
+			invalid++
+			continue
+		}
+		if seen[i] {
+			continue
+		}
+		seen[i] = true
+		ids = append(ids, chunkIDs[i])
+	}
+	return ids, invalid
+}
+
+// excerpt shortens text to at most maxRunes runes, breaking at a word boundary where possible.
+func excerpt(text string, maxRunes int) string {
+	text = strings.TrimSpace(text)
+	if utf8.RuneCountInString(text) <= maxRunes {
+		return text
+	}
+
+	cut := string([]rune(text)[:maxRunes])
+	if i := strings.LastIndexAny(cut, " \n\t"); i > len(cut)/2 {
+		cut = cut[:i]
+	}
+	return strings.TrimRight(cut, " \n\t.,;:") + "…"
+}
@@ -0,0 +1,46 @@
+package service
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestSourceChunkIDs(t *testing.T) {
+	chunkIDs := []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}
+
+	tests := []struct {
+		name        string
+		indices     []int
+		chunkIDs    []uuid.UUID
+		want        []uuid.UUID
+		wantInvalid int
+	}{
+		{"no citations", nil, chunkIDs, nil, 0},
+		{"empty citations", []int{}, chunkIDs, nil, 0},
+		{"valid", []int{2, 0}, chunkIDs, []uuid.UUID{chunkIDs[2], chunkIDs[0]}, 0},
+		{"last chunk", []int{2}, chunkIDs, []uuid.UUID{chunkIDs[2]}, 0},
+		{"one past the end", []int{3}, chunkIDs, []uuid.UUID{}, 1},
+		{"far past the end", []int{1, 99}, chunkIDs, []uuid.UUID{chunkIDs[1]}, 1},
+		{"negative", []int{-1, 0}, chunkIDs, []uuid.UUID{chunkIDs[0]}, 1},
+		{"extremes", []int{math.MinInt, math.MaxInt}, chunkIDs, []uuid.UUID{}, 2},
+		{"one-based off by one", []int{1, 2, 3}, chunkIDs, []uuid.UUID{chunkIDs[1], chunkIDs[2]}, 1},
+		{"repeats", []int{1, 1, 0, 1}, chunkIDs, []uuid.UUID{chunkIDs[1], chunkIDs[0]}, 0},
+		{"repeated invalid index counts each time", []int{5, 5}, chunkIDs, []uuid.UUID{}, 2},
+		{"no chunks to cite", []int{0}, nil, []uuid.UUID{}, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, invalid := sourceChunkIDs(tt.indices, tt.chunkIDs)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("ids = %v, want %v", got, tt.want)
+			}
+			if invalid != tt.wantInvalid {
+				t.Errorf("invalid = %d, want %d", invalid, tt.wantInvalid)
+			}
+		})
+	}
+}
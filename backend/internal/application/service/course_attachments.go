@@ -0,0 +1,548 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+	"github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/domain/tenant"
+	"github.com/sogos/mirai-backend/internal/infrastructure/storage"
+	"github.com/sogos/mirai-backend/pkg/docx"
+	"github.com/sogos/mirai-backend/pkg/pdftext"
+)
+
+const (
+	// courseAttachmentUploadExpiry is how long the presigned upload URL for an attachment stays valid.
+	courseAttachmentUploadExpiry = 15 * time.Minute
+
+	// MaxCourseAttachmentBytes is the largest reference file that can be attached to a course.
+	MaxCourseAttachmentBytes = 20 << 20
+
+	// MaxCourseAttachments is the most reference files one course can have.
+	MaxCourseAttachments = 20
+
+	// courseAttachmentChunkChars is the most characters in one chunk of extracted text.
+	courseAttachmentChunkChars = 1500
+
+	// courseAttachmentMaxChunks caps the chunks kept per attachment; later text is ignored.
+	courseAttachmentMaxChunks = 200
+)
+
+// courseAttachmentFormat is how an attachment's text is extracted.
+type courseAttachmentFormat string
+
+const (
+	courseAttachmentText courseAttachmentFormat = "text"
+	courseAttachmentPDF  courseAttachmentFormat = "pdf"
+	courseAttachmentDOCX courseAttachmentFormat = "docx"
+)
+
+// courseAttachmentFormats maps the accepted file extensions to their format.
+var courseAttachmentFormats = map[string]courseAttachmentFormat{
+	".txt":      courseAttachmentText,
+	".md":       courseAttachmentText,
+	".markdown": courseAttachmentText,
+	".csv":      courseAttachmentText,
+	".pdf":      courseAttachmentPDF,
+	".docx":     courseAttachmentDOCX,
+}
+
+// CourseAttachmentEnqueuer enqueues text extraction from an uploaded course attachment.
+type CourseAttachmentEnqueuer interface {
+	EnqueueCourseAttachment(tenantID, attachmentID string) error
+}
+
+// SetCourseAttachments enables reference attachments on courses. Extracted chunks are
+// screened with detector when it is set.
+func (s *CourseService) SetCourseAttachments(repo repository.CourseAttachmentRepository, enqueuer CourseAttachmentEnqueuer, detector service.PromptInjectionDetector) {
+	s.attachmentRepo = repo
+	s.attachmentQueue = enqueuer
+	s.attachmentScreen = detector
+}
+
+// GetCourseAttachmentUploadRequest contains the parameters for presigning an attachment upload.
+type GetCourseAttachmentUploadRequest struct {
+	CourseID    uuid.UUID
+	FileName    string
+	ContentType string
+	SizeBytes   int64 // As reported by the client; checked again on confirmation
+}
+
+// GetCourseAttachmentUploadURL returns a presigned URL for uploading a reference file to
+// a course, and the path to pass to ConfirmCourseAttachment once the upload finishes.
+func (s *CourseService) GetCourseAttachmentUploadURL(ctx context.Context, kratosID uuid.UUID, req GetCourseAttachmentUploadRequest) (string, string, error) {
+	if s.attachmentRepo == nil {
+		return "", "", domainerrors.ErrInternal.WithMessage("course attachments are not configured")
+	}
+
+	_, course, err := s.courseForAttachments(ctx, kratosID, req.CourseID, true)
+	if err != nil {
+		return "", "", err
+	}
+
+	fileName := path.Base(strings.ReplaceAll(strings.TrimSpace(req.FileName), "\\", "/"))
+	if fileName == "" || fileName == "." || fileName == "/" {
+		return "", "", domainerrors.ErrMissingRequired.WithMessage("file name is required")
+	}
+	if _, ok := courseAttachmentFormatOf(fileName); !ok {
+		return "", "", domainerrors.ErrInvalidInput.WithMessage("attachments must be PDF, Word (.docx), Markdown or plain text files")
+	}
+	if req.SizeBytes > MaxCourseAttachmentBytes {
+		return "", "", domainerrors.ErrInvalidInput.WithMessage(fmt.Sprintf("attachments can be at most %d MB", MaxCourseAttachmentBytes>>20))
+	}
+	if err := s.checkAttachmentLimit(ctx, course.ID); err != nil {
+		return "", "", err
+	}
+
+	filePath := storage.CourseAttachmentPrefix(course.ID) + uuid.New().String() + "/" + fileName
+	url, err := s.storage.GenerateUploadURL(ctx, course.TenantID, filePath, courseAttachmentUploadExpiry)
+	if err != nil {
+		s.logger.Error("failed to generate course attachment upload URL", "courseID", course.ID, "error", err)
+		return "", "", domainerrors.ErrInternal.WithCause(err)
+	}
+
+	return url, filePath, nil
+}
+
+// ConfirmCourseAttachment records an uploaded reference file and queues extraction of
+// its text. The attachment is usable in prompts once its status is ready.
+func (s *CourseService) ConfirmCourseAttachment(ctx context.Context, kratosID, courseID uuid.UUID, filePath, contentType string) (*entity.CourseAttachment, error) {
+	log := s.logger.With("kratosID", kratosID, "courseID", courseID, "filePath", filePath)
+
+	if s.attachmentRepo == nil || s.attachmentQueue == nil {
+		return nil, domainerrors.ErrInternal.WithMessage("course attachments are not configured")
+	}
+
+	user, course, err := s.courseForAttachments(ctx, kratosID, courseID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	fileName, ok := courseAttachmentFileName(courseID, filePath)
+	if !ok {
+		return nil, domainerrors.ErrInvalidInput.WithMessage("attachment must be uploaded with GetCourseAttachmentUploadURL")
+	}
+	if err := s.checkAttachmentLimit(ctx, courseID); err != nil {
+		return nil, err
+	}
+
+	r, err := s.storage.OpenContent(ctx, course.TenantID, filePath)
+	if err != nil {
+		log.Warn("failed to open course attachment", "error", err)
+		return nil, domainerrors.ErrInvalidInput.WithMessage("attachment has not been uploaded")
+	}
+	size, err := io.Copy(io.Discard, io.LimitReader(r, MaxCourseAttachmentBytes+1))
+	_ = r.Close()
+	if err != nil {
+		log.Error("failed to read course attachment", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	if size > MaxCourseAttachmentBytes {
+		if err := s.storage.DeleteTenantObject(ctx, course.TenantID, s.storage.BuildPath(course.TenantID, filePath)); err != nil {
+			log.Warn("failed to delete oversized course attachment", "error", err)
+		}
+		return nil, domainerrors.ErrInvalidInput.WithMessage(fmt.Sprintf("attachments can be at most %d MB", MaxCourseAttachmentBytes>>20))
+	}
+	if err := s.storage.TagObject(ctx, course.TenantID, filePath); err != nil {
+		log.Warn("failed to tag course attachment", "error", err)
+	}
+
+	attachment := &entity.CourseAttachment{
+		TenantID:         course.TenantID,
+		CourseID:         courseID,
+		FileName:         fileName,
+		ContentType:      contentType,
+		FilePath:         filePath,
+		SizeBytes:        size,
+		Status:           entity.CourseAttachmentStatusPending,
+		UploadedByUserID: &user.ID,
+	}
+	if err := s.attachmentRepo.Create(ctx, attachment); err != nil {
+		log.Error("failed to create course attachment", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	if err := s.attachmentQueue.EnqueueCourseAttachment(course.TenantID.String(), attachment.ID.String()); err != nil {
+		log.Error("failed to enqueue course attachment", "attachmentID", attachment.ID, "error", err)
+		s.failCourseAttachment(ctx, attachment, "The file could not be queued for processing. Delete it and upload it again.")
+	}
+
+	log.Info("course attachment uploaded", "attachmentID", attachment.ID, "sizeBytes", size)
+	return attachment, nil
+}
+
+// ListCourseAttachments returns a course's reference attachments, oldest first.
+func (s *CourseService) ListCourseAttachments(ctx context.Context, kratosID, courseID uuid.UUID) ([]*entity.CourseAttachment, error) {
+	if s.attachmentRepo == nil {
+		return nil, domainerrors.ErrInternal.WithMessage("course attachments are not configured")
+	}
+	if _, _, err := s.courseForAttachments(ctx, kratosID, courseID, false); err != nil {
+		return nil, err
+	}
+
+	attachments, err := s.attachmentRepo.ListByCourseID(ctx, courseID)
+	if err != nil {
+		s.logger.Error("failed to list course attachments", "courseID", courseID, "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	return attachments, nil
+}
+
+// SetCourseAttachmentExcluded includes an attachment in generation prompts or leaves it
+// out, keeping the file and its extracted text either way.
+func (s *CourseService) SetCourseAttachmentExcluded(ctx context.Context, kratosID, attachmentID uuid.UUID, excluded bool) (*entity.CourseAttachment, error) {
+	attachment, err := s.attachmentForEdit(ctx, kratosID, attachmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.attachmentRepo.SetExcluded(ctx, attachment.ID, excluded); err != nil {
+		s.logger.Error("failed to update course attachment", "attachmentID", attachment.ID, "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	attachment.ExcludedFromPrompts = excluded
+
+	s.logger.Info("course attachment prompt inclusion changed", "attachmentID", attachment.ID, "excluded", excluded)
+	return attachment, nil
+}
+
+// DeleteCourseAttachment removes an attachment, its extracted text and its file.
+func (s *CourseService) DeleteCourseAttachment(ctx context.Context, kratosID, attachmentID uuid.UUID) error {
+	attachment, err := s.attachmentForEdit(ctx, kratosID, attachmentID)
+	if err != nil {
+		return err
+	}
+	log := s.logger.With("attachmentID", attachment.ID, "courseID", attachment.CourseID)
+
+	if err := s.attachmentRepo.Delete(ctx, attachment.ID); err != nil {
+		log.Error("failed to delete course attachment", "error", err)
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+	if err := s.storage.DeleteTenantObject(ctx, attachment.TenantID, s.storage.BuildPath(attachment.TenantID, attachment.FilePath)); err != nil {
+		// The row is gone; the file goes with the course or the next storage audit
+		log.Warn("failed to delete course attachment file", "error", err)
+	}
+
+	log.Info("course attachment deleted")
+	return nil
+}
+
+// IngestCourseAttachment extracts an uploaded attachment's text into chunks for
+// generation prompts. Files without usable text leave the attachment failed with a
+// message for the author; only storage errors are returned, so the task is retried.
+func (s *CourseService) IngestCourseAttachment(ctx context.Context, tenantID, attachmentID uuid.UUID) error {
+	if s.attachmentRepo == nil {
+		return domainerrors.ErrInternal.WithMessage("course attachments are not configured")
+	}
+	ctx = tenant.WithTenantID(ctx, tenantID)
+	log := s.logger.With("tenantID", tenantID, "attachmentID", attachmentID)
+
+	attachment, err := s.attachmentRepo.GetByID(ctx, attachmentID)
+	if err != nil {
+		return err
+	}
+	if attachment == nil {
+		log.Info("course attachment deleted before processing")
+		return nil
+	}
+
+	attachment.Status = entity.CourseAttachmentStatusProcessing
+	attachment.ErrorMessage = nil
+	if err := s.attachmentRepo.UpdateStatus(ctx, attachment); err != nil {
+		return err
+	}
+
+	data, err := s.readCourseAttachment(ctx, attachment)
+	if err != nil {
+		s.failCourseAttachment(ctx, attachment, "The file could not be read. It will be retried automatically.")
+		return err
+	}
+
+	text, err := extractCourseAttachmentText(attachment.FileName, data)
+	if err != nil {
+		log.Info("course attachment has no usable text", "error", err)
+		s.failCourseAttachment(ctx, attachment, courseAttachmentErrorMessage(err))
+		return nil
+	}
+
+	pieces := chunkCourseAttachmentText(text)
+	if len(pieces) == 0 {
+		s.failCourseAttachment(ctx, attachment, "No text was found in the file.")
+		return nil
+	}
+
+	chunks := make([]entity.CourseAttachmentChunk, len(pieces))
+	flagged := 0
+	for i, piece := range pieces {
+		chunks[i].Content = piece
+		if s.attachmentScreen != nil && s.attachmentScreen.Detect(piece).Flagged {
+			chunks[i].InjectionFlagged = true
+			flagged++
+		}
+	}
+	if err := s.attachmentRepo.ReplaceChunks(ctx, attachment, chunks); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	attachment.Status = entity.CourseAttachmentStatusReady
+	attachment.ChunkCount = len(chunks)
+	attachment.FlaggedChunks = flagged
+	attachment.ProcessedAt = &now
+	if err := s.attachmentRepo.UpdateStatus(ctx, attachment); err != nil {
+		return err
+	}
+
+	log.Info("course attachment processed", "chunks", len(chunks), "flagged", flagged)
+	return nil
+}
+
+// copyCourseAttachments copies the source course's attachments, files and extracted
+// text to a duplicate of it. Attachments that fail to copy are logged and skipped.
+func (s *CourseService) copyCourseAttachments(ctx context.Context, source, course *entity.Course) {
+	if s.attachmentRepo == nil {
+		return
+	}
+	log := s.logger.With("sourceCourseID", source.ID, "courseID", course.ID)
+
+	attachments, err := s.attachmentRepo.ListByCourseID(ctx, source.ID)
+	if err != nil {
+		log.Error("failed to list course attachments to copy", "error", err)
+		return
+	}
+	for _, attachment := range attachments {
+		copied := *attachment
+		copied.CourseID = course.ID
+		copied.FilePath = storage.CourseAttachmentPrefix(course.ID) + uuid.New().String() + "/" + attachment.FileName
+		if err := s.storage.CopyTenantObject(ctx, source.TenantID, attachment.FilePath, copied.FilePath); err != nil {
+			log.Error("failed to copy course attachment file", "attachmentID", attachment.ID, "error", err)
+			continue
+		}
+		if err := s.attachmentRepo.Copy(ctx, attachment.ID, &copied); err != nil {
+			log.Error("failed to copy course attachment", "attachmentID", attachment.ID, "error", err)
+			_ = s.storage.DeleteTenantObject(ctx, course.TenantID, s.storage.BuildPath(course.TenantID, copied.FilePath))
+		}
+	}
+}
+
+// courseForAttachments loads the user and a course whose attachments they may edit, or
+// only see when edit is false.
+func (s *CourseService) courseForAttachments(ctx context.Context, kratosID, courseID uuid.UUID, edit bool) (*entity.User, *entity.Course, error) {
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, nil, domainerrors.ErrUserNotFound
+	}
+	if user.TenantID == nil {
+		return nil, nil, domainerrors.ErrUserHasNoCompany
+	}
+
+	course, err := s.courseMetadata(ctx, courseID)
+	if err != nil {
+		s.logger.Error("failed to get course", "courseID", courseID, "error", err)
+		return nil, nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	if course == nil {
+		return nil, nil, domainerrors.ErrNotFound.WithMessage("course not found")
+	}
+	if edit {
+		err = s.checkCourseEdit(ctx, user, course)
+	} else {
+		err = s.CheckCourseViewAccess(ctx, user, courseID)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return user, course, nil
+}
+
+// attachmentForEdit loads an attachment on a course the user may edit.
+func (s *CourseService) attachmentForEdit(ctx context.Context, kratosID, attachmentID uuid.UUID) (*entity.CourseAttachment, error) {
+	if s.attachmentRepo == nil {
+		return nil, domainerrors.ErrInternal.WithMessage("course attachments are not configured")
+	}
+	attachment, err := s.attachmentRepo.GetByID(ctx, attachmentID)
+	if err != nil {
+		s.logger.Error("failed to get course attachment", "attachmentID", attachmentID, "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	if attachment == nil {
+		return nil, domainerrors.ErrNotFound.WithMessage("attachment not found")
+	}
+	if _, _, err := s.courseForAttachments(ctx, kratosID, attachment.CourseID, true); err != nil {
+		return nil, err
+	}
+	return attachment, nil
+}
+
+// checkAttachmentLimit rejects another attachment on a course that has the most allowed.
+func (s *CourseService) checkAttachmentLimit(ctx context.Context, courseID uuid.UUID) error {
+	attachments, err := s.attachmentRepo.ListByCourseID(ctx, courseID)
+	if err != nil {
+		s.logger.Error("failed to list course attachments", "courseID", courseID, "error", err)
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+	if len(attachments) >= MaxCourseAttachments {
+		return domainerrors.ErrInvalidInput.WithMessage(fmt.Sprintf("a course can have at most %d attachments", MaxCourseAttachments))
+	}
+	return nil
+}
+
+// readCourseAttachment reads an attachment's stored file.
+func (s *CourseService) readCourseAttachment(ctx context.Context, attachment *entity.CourseAttachment) ([]byte, error) {
+	r, err := s.storage.OpenContent(ctx, attachment.TenantID, attachment.FilePath)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return io.ReadAll(io.LimitReader(r, MaxCourseAttachmentBytes))
+}
+
+// failCourseAttachment marks an attachment failed with a message for its author.
+func (s *CourseService) failCourseAttachment(ctx context.Context, attachment *entity.CourseAttachment, message string) {
+	now := time.Now()
+	attachment.Status = entity.CourseAttachmentStatusFailed
+	attachment.ErrorMessage = &message
+	attachment.ProcessedAt = &now
+	if err := s.attachmentRepo.UpdateStatus(ctx, attachment); err != nil {
+		s.logger.Error("failed to mark course attachment failed", "attachmentID", attachment.ID, "error", err)
+	}
+}
+
+// courseAttachmentFormatOf returns how a file's text is extracted, from its extension.
+func courseAttachmentFormatOf(fileName string) (courseAttachmentFormat, bool) {
+	format, ok := courseAttachmentFormats[strings.ToLower(path.Ext(fileName))]
+	return format, ok
+}
+
+// courseAttachmentFileName returns the file name of an upload slot handed out for the
+// course, or false if the path points anywhere else.
+func courseAttachmentFileName(courseID uuid.UUID, filePath string) (string, bool) {
+	rest, ok := strings.CutPrefix(filePath, storage.CourseAttachmentPrefix(courseID))
+	if !ok {
+		return "", false
+	}
+	id, name, _ := strings.Cut(rest, "/")
+	if _, err := uuid.Parse(id); err != nil {
+		return "", false
+	}
+	if name == "" || strings.Contains(name, "/") {
+		return "", false
+	}
+	if _, ok := courseAttachmentFormatOf(name); !ok {
+		return "", false
+	}
+	return name, true
+}
+
+// extractCourseAttachmentText returns the text of an attachment's file.
+func extractCourseAttachmentText(fileName string, data []byte) (string, error) {
+	format, _ := courseAttachmentFormatOf(fileName)
+	switch format {
+	case courseAttachmentPDF:
+		return pdftext.ExtractText(data)
+	case courseAttachmentDOCX:
+		return docx.ExtractText(data)
+	default:
+		return strings.ToValidUTF8(string(data), ""), nil
+	}
+}
+
+// courseAttachmentErrorMessage explains to the author why no text came out of a file.
+func courseAttachmentErrorMessage(err error) string {
+	if errors.Is(err, pdftext.ErrNoText) {
+		return "No text could be read from this PDF. Scanned PDFs aren't supported; try exporting it again from the original document, or upload it as Word or text."
+	}
+	return "The file could not be read. Check that it is a valid PDF, Word (.docx), Markdown or text file."
+}
+
+// chunkCourseAttachmentText splits text into chunks of whole paragraphs of at most
+// courseAttachmentChunkChars characters. Paragraphs longer than that are split between
+// words. At most courseAttachmentMaxChunks chunks are returned.
+func chunkCourseAttachmentText(text string) []string {
+	var chunks []string
+	var current strings.Builder
+	flush := func() {
+		if current.Len() > 0 {
+			chunks = append(chunks, current.String())
+			current.Reset()
+		}
+	}
+
+	for _, paragraph := range attachmentParagraphs(text) {
+		for _, piece := range splitLongParagraph(paragraph) {
+			if current.Len() > 0 && current.Len()+2+len(piece) > courseAttachmentChunkChars {
+				flush()
+				if len(chunks) == courseAttachmentMaxChunks {
+					return chunks
+				}
+			}
+			if current.Len() > 0 {
+				current.WriteString("\n\n")
+			}
+			current.WriteString(piece)
+		}
+	}
+	flush()
+	if len(chunks) > courseAttachmentMaxChunks {
+		chunks = chunks[:courseAttachmentMaxChunks]
+	}
+	return chunks
+}
+
+// attachmentParagraphs splits text on blank lines, trimming each line and dropping
+// empty paragraphs.
+func attachmentParagraphs(text string) []string {
+	text = strings.ReplaceAll(strings.ReplaceAll(text, "\r\n", "\n"), "\r", "\n")
+
+	var paragraphs []string
+	var lines []string
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			if len(lines) > 0 {
+				paragraphs = append(paragraphs, strings.Join(lines, "\n"))
+				lines = nil
+			}
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if len(lines) > 0 {
+		paragraphs = append(paragraphs, strings.Join(lines, "\n"))
+	}
+	return paragraphs
+}
+
+// splitLongParagraph splits a paragraph longer than a chunk into pieces between words.
+func splitLongParagraph(paragraph string) []string {
+	if len(paragraph) <= courseAttachmentChunkChars {
+		return []string{paragraph}
+	}
+
+	var pieces []string
+	var current strings.Builder
+	for _, word := range strings.Fields(paragraph) {
+		if current.Len() > 0 && current.Len()+1+len(word) > courseAttachmentChunkChars {
+			pieces = append(pieces, current.String())
+			current.Reset()
+		}
+		if current.Len() > 0 {
+			current.WriteByte(' ')
+		}
+		current.WriteString(word)
+	}
+	if current.Len() > 0 {
+		pieces = append(pieces, current.String())
+	}
+	return pieces
+}
@@ -0,0 +1,319 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/audit"
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/tenant"
+	"github.com/sogos/mirai-backend/internal/infrastructure/cache"
+)
+
+const (
+	// libraryCacheTTL bounds how long cached library data can stay stale after a change
+	// CourseService doesn't see, such as a course creator being deactivated.
+	libraryCacheTTL = 5 * time.Minute
+
+	// libraryCourseLimit is the most courses the library lists.
+	libraryCourseLimit = 1000
+
+	// DefaultWarmRecentCourses is how many course metadata entries a cache warm loads
+	// when the caller doesn't say.
+	DefaultWarmRecentCourses = 50
+
+	// maxWarmRecentCourses caps the course metadata entries one warm loads.
+	maxWarmRecentCourses = 500
+)
+
+// TenantCacheWarmEnqueuer enqueues background warming of a tenant's cache.
+type TenantCacheWarmEnqueuer interface {
+	EnqueueTenantCacheWarm(tenantID string, recentCourses int) error
+}
+
+// LibraryCacheInvalidator drops a tenant's cached library after folders change elsewhere.
+type LibraryCacheInvalidator interface {
+	InvalidateLibraryCache(ctx context.Context)
+}
+
+// SetCacheAdmin enables the superadmin tenant cache operations. The enqueuer may be nil,
+// in which case warming always runs inline.
+func (s *CourseService) SetCacheAdmin(admins SuperAdminChecker, enqueuer TenantCacheWarmEnqueuer) {
+	s.superAdmins = admins
+	s.cacheWarmer = enqueuer
+}
+
+// folderSnapshot is the cached folder hierarchy shared by a tenant's users: every folder
+// except personal ones, plus the number of courses directly in each folder, personal
+// folders included.
+type folderSnapshot struct {
+	Folders      []*entity.Folder  `json:"folders"`
+	CourseCounts map[uuid.UUID]int `json:"courseCounts"`
+}
+
+// libraryCourses returns the courses the library lists, most recently modified first.
+func (s *CourseService) libraryCourses(ctx context.Context) ([]*entity.Course, error) {
+	var courses []*entity.Course
+	if entry, err := s.cache.Get(ctx, cache.TenantCacheKeys.Library(), &courses); err == nil && entry != nil {
+		return courses, nil
+	}
+
+	courses, err := s.courseRepo.List(ctx, entity.CourseListOptions{Limit: libraryCourseLimit})
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.cache.Set(ctx, cache.TenantCacheKeys.Library(), courses, "", libraryCacheTTL); err != nil {
+		s.logger.Warn("failed to cache library", "error", err)
+	}
+	return courses, nil
+}
+
+// folderHierarchy returns the tenant's shared folder hierarchy with course counts.
+func (s *CourseService) folderHierarchy(ctx context.Context) (*folderSnapshot, error) {
+	var snapshot folderSnapshot
+	if entry, err := s.cache.Get(ctx, cache.TenantCacheKeys.Folders(), &snapshot); err == nil && entry != nil {
+		return &snapshot, nil
+	}
+
+	// No user has the nil ID, so this leaves out every personal folder
+	folders, err := s.folderRepo.GetHierarchy(ctx, uuid.Nil)
+	if err != nil {
+		return nil, err
+	}
+	counts, err := s.courseRepo.CountPerFolder(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot = folderSnapshot{Folders: folders, CourseCounts: counts}
+	if _, err := s.cache.Set(ctx, cache.TenantCacheKeys.Folders(), snapshot, "", libraryCacheTTL); err != nil {
+		s.logger.Warn("failed to cache folder hierarchy", "error", err)
+	}
+	return &snapshot, nil
+}
+
+// userFolders returns the folders user can see, in the same order as
+// FolderRepository.GetHierarchy, with course counts when includeCounts is set.
+func (s *CourseService) userFolders(ctx context.Context, user *entity.User, includeCounts bool) ([]Folder, error) {
+	snapshot, err := s.folderHierarchy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	personal, err := s.folderRepo.GetByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	folders := make([]*entity.Folder, 0, len(snapshot.Folders)+1)
+	for _, f := range snapshot.Folders {
+		// The personal folder sorts after library and team folders
+		if personal != nil && f.Type != entity.FolderTypeLibrary && f.Type != entity.FolderTypeTeam {
+			folders = append(folders, personal)
+			personal = nil
+		}
+		folders = append(folders, f)
+	}
+	if personal != nil {
+		folders = append(folders, personal)
+	}
+
+	childrenMap := make(map[string][]string)
+	for _, f := range folders {
+		if f.ParentID != nil {
+			parentStr := f.ParentID.String()
+			childrenMap[parentStr] = append(childrenMap[parentStr], f.ID.String())
+		}
+	}
+
+	result := make([]Folder, 0, len(folders))
+	for _, f := range folders {
+		folder := folderFromEntity(f, childrenMap[f.ID.String()])
+		if includeCounts {
+			count := snapshot.CourseCounts[f.ID]
+			folder.CourseCount = &count
+		}
+		result = append(result, folder)
+	}
+	return result, nil
+}
+
+// courseMetadata returns a course's metadata, or nil if it doesn't exist.
+func (s *CourseService) courseMetadata(ctx context.Context, courseID uuid.UUID) (*entity.Course, error) {
+	key := cache.TenantCacheKeys.Course(courseID.String())
+
+	var course entity.Course
+	if entry, err := s.cache.Get(ctx, key, &course); err == nil && entry != nil {
+		return &course, nil
+	}
+
+	found, err := s.courseRepo.GetByID(ctx, courseID)
+	if err != nil || found == nil {
+		return found, err
+	}
+	if _, err := s.cache.Set(ctx, key, found, "", libraryCacheTTL); err != nil {
+		s.logger.Warn("failed to cache course metadata", "courseID", courseID, "error", err)
+	}
+	return found, nil
+}
+
+// InvalidateLibraryCache drops the tenant's cached library listing and folder hierarchy.
+func (s *CourseService) InvalidateLibraryCache(ctx context.Context) {
+	_ = s.cache.Delete(ctx, cache.TenantCacheKeys.Library())
+	_ = s.cache.Delete(ctx, cache.TenantCacheKeys.Folders())
+}
+
+// TenantCacheWarmResult reports what a cache warm wrote.
+type TenantCacheWarmResult struct {
+	KeysWritten int
+	Duration    time.Duration
+	Queued      bool // Warming was handed to the worker; nothing has been written yet
+}
+
+// WarmTenantCache lets a superadmin rebuild a tenant's library listing, folder hierarchy
+// and most recently modified course metadata in the cache, so the first user after a
+// flush or bulk import doesn't take the cold path. With background set, the warm is
+// queued for the worker instead of run inline.
+func (s *CourseService) WarmTenantCache(ctx context.Context, kratosID uuid.UUID, email string, tenantID uuid.UUID, recentCourses int, background bool) (*TenantCacheWarmResult, error) {
+	actor, err := s.cacheAdmin(ctx, kratosID, email)
+	if err != nil {
+		return nil, err
+	}
+	recentCourses = clampWarmRecentCourses(recentCourses)
+	log := s.logger.With("tenantID", tenantID, "actor", email)
+
+	result := &TenantCacheWarmResult{}
+	if background && s.cacheWarmer != nil {
+		if err := s.cacheWarmer.EnqueueTenantCacheWarm(tenantID.String(), recentCourses); err != nil {
+			log.Error("failed to enqueue cache warm", "error", err)
+			return nil, domainerrors.ErrInternal.WithCause(err)
+		}
+		result.Queued = true
+	} else {
+		result, err = s.RebuildTenantCache(ctx, tenantID, recentCourses)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	changes := audit.Changes{}.Field("recent_courses", "", recentCourses)
+	if result.Queued {
+		changes = changes.Field("queued", "", true)
+	} else {
+		changes = changes.Field("keys_written", "", result.KeysWritten)
+	}
+	recordAudit(tenant.WithTenantID(ctx, tenantID), s.auditLog, log, audit.Entry{
+		TenantID:    tenantID,
+		ActorUserID: &actor.ID,
+		Action:      audit.ActionCacheWarmed,
+		TargetType:  audit.TargetTenant,
+		TargetID:    tenantID.String(),
+		Changes:     changes,
+	})
+
+	log.Info("tenant cache warm requested", "queued", result.Queued, "keysWritten", result.KeysWritten)
+	return result, nil
+}
+
+// RebuildTenantCache writes a tenant's library listing, folder hierarchy and the metadata
+// of its recentCourses most recently modified courses to the cache. Stale entries are
+// dropped first so the rebuild always reads from the database.
+func (s *CourseService) RebuildTenantCache(ctx context.Context, tenantID uuid.UUID, recentCourses int) (*TenantCacheWarmResult, error) {
+	start := time.Now()
+	ctx = tenant.WithTenantID(ctx, tenantID)
+	log := s.logger.With("tenantID", tenantID)
+	recentCourses = clampWarmRecentCourses(recentCourses)
+
+	s.InvalidateLibraryCache(ctx)
+	courses, err := s.libraryCourses(ctx)
+	if err != nil {
+		log.Error("failed to warm library", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	if _, err := s.folderHierarchy(ctx); err != nil {
+		log.Error("failed to warm folder hierarchy", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	result := &TenantCacheWarmResult{KeysWritten: 2}
+
+	// The library is already ordered by modification time, newest first
+	if len(courses) > recentCourses {
+		courses = courses[:recentCourses]
+	}
+	for _, course := range courses {
+		if _, err := s.cache.Set(ctx, cache.TenantCacheKeys.Course(course.ID.String()), course, "", libraryCacheTTL); err != nil {
+			log.Warn("failed to cache course metadata", "courseID", course.ID, "error", err)
+			continue
+		}
+		result.KeysWritten++
+	}
+
+	result.Duration = time.Since(start)
+	log.Info("tenant cache warmed", "keysWritten", result.KeysWritten, "duration", result.Duration)
+	return result, nil
+}
+
+// InvalidateTenantCache lets a superadmin drop a tenant's cached entries. Each pattern is
+// matched against keys within the tenant's namespace, such as "course:*"; with no
+// patterns, everything cached for the tenant is dropped.
+func (s *CourseService) InvalidateTenantCache(ctx context.Context, kratosID uuid.UUID, email string, tenantID uuid.UUID, patterns []string) error {
+	actor, err := s.cacheAdmin(ctx, kratosID, email)
+	if err != nil {
+		return err
+	}
+	for _, pattern := range patterns {
+		if strings.TrimSpace(pattern) == "" {
+			return domainerrors.ErrInvalidInput.WithMessage("cache patterns cannot be empty")
+		}
+	}
+	if len(patterns) == 0 {
+		patterns = []string{"*"}
+	}
+	log := s.logger.With("tenantID", tenantID, "actor", email)
+
+	tenantCtx := tenant.WithTenantID(ctx, tenantID)
+	for _, pattern := range patterns {
+		if err := s.cache.InvalidatePattern(tenantCtx, pattern); err != nil {
+			log.Error("failed to invalidate tenant cache", "pattern", pattern, "error", err)
+			return domainerrors.ErrInternal.WithCause(err)
+		}
+	}
+
+	recordAudit(tenantCtx, s.auditLog, log, audit.Entry{
+		TenantID:    tenantID,
+		ActorUserID: &actor.ID,
+		Action:      audit.ActionCacheInvalidated,
+		TargetType:  audit.TargetTenant,
+		TargetID:    tenantID.String(),
+		Changes:     audit.Changes{}.Field("patterns", "", strings.Join(patterns, ", ")),
+	})
+
+	log.Info("tenant cache invalidated", "patterns", patterns)
+	return nil
+}
+
+// cacheAdmin returns the calling superadmin, or an error if the caller isn't one.
+func (s *CourseService) cacheAdmin(ctx context.Context, kratosID uuid.UUID, email string) (*entity.User, error) {
+	if s.superAdmins == nil || !s.superAdmins.IsSuperAdmin(email) {
+		return nil, domainerrors.ErrForbidden.WithMessage("superadmin access required")
+	}
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, domainerrors.ErrUserNotFound
+	}
+	return user, nil
+}
+
+// clampWarmRecentCourses applies the default and maximum to a requested course count.
+func clampWarmRecentCourses(n int) int {
+	if n <= 0 {
+		return DefaultWarmRecentCourses
+	}
+	if n > maxWarmRecentCourses {
+		return maxWarmRecentCourses
+	}
+	return n
+}
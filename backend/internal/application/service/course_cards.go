@@ -0,0 +1,249 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/service"
+)
+
+// Course card limits. The library lays out a page of cards first and then loads the
+// details of the cards on screen.
+const (
+	DefaultCourseCardPageSize = 48
+	MaxCourseCardPageSize     = 100
+	MaxCourseCardDetails      = 50
+
+	// courseCardThumbnailExpiry is how long presigned thumbnail URLs stay valid.
+	courseCardThumbnailExpiry = 15 * time.Minute
+
+	// creatorNameRefresh is how long a process reuses a creator's display name before
+	// looking it up in Kratos again.
+	creatorNameRefresh = 10 * time.Minute
+
+	// maxCachedCreatorNames bounds the creator name cache; it is emptied when full.
+	maxCachedCreatorNames = 10000
+)
+
+// creatorNameCache caches creators' display names by Kratos identity ID.
+type creatorNameCache struct {
+	mu    sync.Mutex
+	names map[uuid.UUID]cachedCreatorName
+}
+
+type cachedCreatorName struct {
+	name      string
+	fetchedAt time.Time
+}
+
+// SetIdentityProvider enables creator display names on course card details.
+func (s *CourseService) SetIdentityProvider(identity service.IdentityProvider) {
+	s.identity = identity
+	s.creatorNames = &creatorNameCache{names: make(map[uuid.UUID]cachedCreatorName)}
+}
+
+// ListCourseCardsFilter filters a page of course cards.
+type ListCourseCardsFilter struct {
+	Status *CourseStatus
+	Folder *string
+	Limit  int
+	Cursor *string
+}
+
+// ListCourseCardsResult contains a page of course cards.
+type ListCourseCardsResult struct {
+	Cards      []*entity.CourseCard
+	NextCursor string // Empty on the last page
+}
+
+// ListCourseCards returns a page of lightweight course cards, most recently modified
+// first. A limit of 0 uses DefaultCourseCardPageSize.
+func (s *CourseService) ListCourseCards(ctx context.Context, kratosID uuid.UUID, filter ListCourseCardsFilter) (*ListCourseCardsResult, error) {
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, domainerrors.ErrUserNotFound
+	}
+
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = DefaultCourseCardPageSize
+	}
+	if limit > MaxCourseCardPageSize {
+		limit = MaxCourseCardPageSize
+	}
+
+	// Fetch one extra card to know whether there is another page
+	opts := entity.CourseCardListOptions{Limit: limit + 1, Cursor: filter.Cursor}
+	if filter.Status != nil {
+		status := entity.ParseCourseStatus(string(*filter.Status))
+		opts.Status = &status
+	}
+	if filter.Folder != nil && *filter.Folder != "" {
+		folderID, err := uuid.Parse(*filter.Folder)
+		if err != nil {
+			return nil, domainerrors.ErrInvalidInput.WithMessage("invalid folder ID")
+		}
+		opts.FolderID = &folderID
+	}
+
+	cards, err := s.courseRepo.ListCards(ctx, opts)
+	if err != nil {
+		s.logger.Error("failed to list course cards", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	result := &ListCourseCardsResult{Cards: cards}
+	if len(cards) > limit {
+		result.Cards = cards[:limit]
+		last := result.Cards[limit-1]
+		result.NextCursor = fmt.Sprintf("%s|%s", last.UpdatedAt.Format(time.RFC3339Nano), last.ID.String())
+	}
+	return result, nil
+}
+
+// CourseCardDetails is the part of a course card loaded for cards on screen.
+type CourseCardDetails struct {
+	CourseID        string
+	Tags            []string
+	ThumbnailURL    string // Presigned when the thumbnail is stored with the tenant's objects
+	CreatedBy       string
+	CreatedByName   string // Empty if the creator's identity can't be looked up
+	CreatedByActive bool
+	DurationMinutes int
+}
+
+// GetCourseCardDetails returns the details of up to MaxCourseCardDetails course cards,
+// in the order requested. Courses that don't exist are left out.
+func (s *CourseService) GetCourseCardDetails(ctx context.Context, kratosID uuid.UUID, ids []string) ([]CourseCardDetails, error) {
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, domainerrors.ErrUserNotFound
+	}
+	if len(ids) > MaxCourseCardDetails {
+		return nil, domainerrors.ErrInvalidInput.WithMessage(fmt.Sprintf("at most %d courses can be requested at once", MaxCourseCardDetails))
+	}
+
+	courseIDs := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		courseID, err := uuid.Parse(id)
+		if err != nil {
+			return nil, domainerrors.ErrInvalidInput.WithMessage("invalid course ID: " + id)
+		}
+		courseIDs = append(courseIDs, courseID)
+	}
+
+	found, err := s.courseRepo.GetCardDetails(ctx, courseIDs)
+	if err != nil {
+		s.logger.Error("failed to get course card details", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	byID := make(map[uuid.UUID]*entity.CourseCardDetails, len(found))
+	var creators []uuid.UUID
+	for _, d := range found {
+		byID[d.CourseID] = d
+		if d.CreatorKratosID != nil {
+			creators = append(creators, *d.CreatorKratosID)
+		}
+	}
+	names := s.creatorDisplayNames(ctx, creators)
+
+	details := make([]CourseCardDetails, 0, len(found))
+	for _, id := range courseIDs {
+		d, ok := byID[id]
+		if !ok {
+			continue
+		}
+		delete(byID, id) // A course requested twice is returned once
+		card := CourseCardDetails{
+			CourseID:        d.CourseID.String(),
+			Tags:            d.Tags,
+			CreatedBy:       d.CreatedByUserID.String(),
+			CreatedByActive: d.CreatorActive,
+			DurationMinutes: d.DurationMinutes,
+		}
+		if d.CreatorKratosID != nil {
+			card.CreatedByName = names[*d.CreatorKratosID]
+		}
+		if d.ThumbnailPath != nil && user.TenantID != nil {
+			card.ThumbnailURL = s.thumbnailURL(ctx, *user.TenantID, *d.ThumbnailPath)
+		}
+		details = append(details, card)
+	}
+	return details, nil
+}
+
+// thumbnailURL returns a URL the browser can load a course thumbnail from. Thumbnails
+// stored with the tenant's objects are presigned; external URLs are returned as they are.
+func (s *CourseService) thumbnailURL(ctx context.Context, tenantID uuid.UUID, thumbnailPath string) string {
+	if strings.HasPrefix(thumbnailPath, "https://") || strings.HasPrefix(thumbnailPath, "http://") {
+		return thumbnailPath
+	}
+	url, err := s.storage.GenerateDownloadURL(ctx, tenantID, thumbnailPath, courseCardThumbnailExpiry)
+	if err != nil {
+		s.logger.Warn("failed to presign course thumbnail", "thumbnailPath", thumbnailPath, "error", err)
+		return ""
+	}
+	return url
+}
+
+// creatorDisplayNames returns the display names of the given Kratos identities, looking
+// up the ones not cached in a single batched call. Identities that can't be looked up
+// are left out.
+func (s *CourseService) creatorDisplayNames(ctx context.Context, kratosIDs []uuid.UUID) map[uuid.UUID]string {
+	names := make(map[uuid.UUID]string, len(kratosIDs))
+	if s.identity == nil || len(kratosIDs) == 0 {
+		return names
+	}
+
+	var missing []string
+	s.creatorNames.mu.Lock()
+	for _, id := range kratosIDs {
+		if _, seen := names[id]; seen {
+			continue
+		}
+		if cached, ok := s.creatorNames.names[id]; ok && time.Since(cached.fetchedAt) < creatorNameRefresh {
+			names[id] = cached.name
+			continue
+		}
+		names[id] = ""
+		missing = append(missing, id.String())
+	}
+	s.creatorNames.mu.Unlock()
+	if len(missing) == 0 {
+		return names
+	}
+
+	identities, err := s.identity.GetIdentitiesByIDs(ctx, missing)
+	if err != nil {
+		s.logger.Warn("failed to look up course creators", "count", len(missing), "error", err)
+		return names
+	}
+
+	now := time.Now()
+	s.creatorNames.mu.Lock()
+	defer s.creatorNames.mu.Unlock()
+	if len(s.creatorNames.names)+len(identities) > maxCachedCreatorNames {
+		s.creatorNames.names = make(map[uuid.UUID]cachedCreatorName)
+	}
+	for _, identity := range identities {
+		id, err := uuid.Parse(identity.ID)
+		if err != nil {
+			continue
+		}
+		name := strings.TrimSpace(identity.FirstName + " " + identity.LastName)
+		if name == "" {
+			name = identity.Email
+		}
+		names[id] = name
+		s.creatorNames.names[id] = cachedCreatorName{name: name, fetchedAt: now}
+	}
+	return names
+}
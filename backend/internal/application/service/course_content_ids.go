@@ -0,0 +1,92 @@
+package service
+
+import (
+	"github.com/google/uuid"
+)
+
+// remapContentIDs gives every section, lesson and block in content a new ID and rewrites
+// every reference to an old ID, such as a block's lessonId, to match. New IDs are derived
+// from courseID and the old ID, so remapping the same content for the same course always
+// yields the same IDs. Returns the mapping from old to new IDs.
+func remapContentIDs(content *CourseContent, courseID uuid.UUID) map[string]string {
+	ids := make(map[string]string)
+	assign := func(item map[string]any) {
+		id, ok := item["id"].(string)
+		if !ok || id == "" {
+			return
+		}
+		if _, seen := ids[id]; !seen {
+			ids[id] = uuid.NewSHA1(courseID, []byte(id)).String()
+		}
+	}
+
+	for _, section := range content.Sections {
+		assign(section)
+		for _, lesson := range contentMaps(section["lessons"]) {
+			assign(lesson)
+			for _, block := range contentMaps(lesson["blocks"]) {
+				assign(block)
+			}
+		}
+	}
+	for _, block := range content.CourseBlocks {
+		assign(block)
+	}
+
+	for _, section := range content.Sections {
+		rewriteContentIDs(section, ids)
+	}
+	for _, block := range content.CourseBlocks {
+		rewriteContentIDs(block, ids)
+	}
+	return ids
+}
+
+// rewriteContentIDs replaces, in place, every string in v that is a key of ids.
+// Only whole values are replaced; text that merely contains an ID is left alone.
+func rewriteContentIDs(v any, ids map[string]string) {
+	switch v := v.(type) {
+	case map[string]any:
+		for key, value := range v {
+			if s, ok := value.(string); ok {
+				if id, ok := ids[s]; ok {
+					v[key] = id
+				}
+				continue
+			}
+			rewriteContentIDs(value, ids)
+		}
+	case []any:
+		for i, value := range v {
+			if s, ok := value.(string); ok {
+				if id, ok := ids[s]; ok {
+					v[i] = id
+				}
+				continue
+			}
+			rewriteContentIDs(value, ids)
+		}
+	case []map[string]any:
+		for _, value := range v {
+			rewriteContentIDs(value, ids)
+		}
+	}
+}
+
+// contentMaps returns the objects in a decoded JSON array, which is []any when read
+// from storage and []map[string]any when built in code.
+func contentMaps(v any) []map[string]any {
+	switch v := v.(type) {
+	case []map[string]any:
+		return v
+	case []any:
+		maps := make([]map[string]any, 0, len(v))
+		for _, item := range v {
+			if m, ok := item.(map[string]any); ok {
+				maps = append(maps, m)
+			}
+		}
+		return maps
+	}
+	return nil
+}
@@ -0,0 +1,209 @@
+package service
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+// contentStrings returns every string value in v, keyed by the key or index path it is at.
+func contentStrings(v any, path string, out map[string]string) {
+	switch v := v.(type) {
+	case string:
+		out[path] = v
+	case map[string]any:
+		for key, value := range v {
+			contentStrings(value, path+"."+key, out)
+		}
+	case []any:
+		for i, value := range v {
+			contentStrings(value, path+"["+strconv.Itoa(i)+"]", out)
+		}
+	case []map[string]any:
+		for i, value := range v {
+			contentStrings(value, path+"["+strconv.Itoa(i)+"]", out)
+		}
+	}
+}
+
+// contentIDs returns the values of every "id" key in v.
+func contentIDs(v any, out map[string]bool) {
+	switch v := v.(type) {
+	case map[string]any:
+		for key, value := range v {
+			if s, ok := value.(string); ok && key == "id" {
+				out[s] = true
+			}
+			contentIDs(value, out)
+		}
+	case []any:
+		for _, value := range v {
+			contentIDs(value, out)
+		}
+	case []map[string]any:
+		for _, value := range v {
+			contentIDs(value, out)
+		}
+	}
+}
+
+func decodeContent(t *testing.T, data string) *CourseContent {
+	t.Helper()
+	var content CourseContent
+	if err := json.Unmarshal([]byte(data), &content); err != nil {
+		t.Fatalf("invalid test content: %v", err)
+	}
+	return &content
+}
+
+func TestRemapContentIDs(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		refs    []string // Paths holding references that must follow their target
+	}{
+		{
+			name: "sections, lessons and blocks",
+			content: `{
+				"sections": [{"id": "s1", "lessons": [{"id": "l1", "blocks": [{"id": "b1"}, {"id": "b2"}]}]}],
+				"courseBlocks": [{"id": "cb1"}]
+			}`,
+		},
+		{
+			name: "block references to lessons and sections",
+			content: `{
+				"sections": [{"id": "s1", "lessons": [{"id": "l1", "blocks": [{"id": "b1"}]}, {"id": "l2"}]}],
+				"courseBlocks": [{"id": "cb1", "lessonId": "l2", "sectionId": "s1"}]
+			}`,
+			refs: []string{".courseBlocks[0].lessonId", ".courseBlocks[0].sectionId"},
+		},
+		{
+			name: "references nested in arrays and objects",
+			content: `{
+				"sections": [{"id": "s1", "lessons": [{"id": "l1", "blocks": [
+					{"id": "b1", "content": {"links": [{"target": "l2"}, {"target": "b3"}], "prerequisites": ["l1", "s2"]}},
+					{"id": "b2", "meta": {"source": {"blockId": "cb1"}}}
+				]}]}, {"id": "s2", "lessons": [{"id": "l2", "blocks": [{"id": "b3", "replyTo": "b1"}]}]}],
+				"courseBlocks": [{"id": "cb1", "order": ["s2", "s1"]}]
+			}`,
+			refs: []string{
+				".sections[0].lessons[0].blocks[0].content.links[0].target",
+				".sections[0].lessons[0].blocks[0].content.links[1].target",
+				".sections[0].lessons[0].blocks[0].content.prerequisites[0]",
+				".sections[0].lessons[0].blocks[0].content.prerequisites[1]",
+				".sections[0].lessons[0].blocks[1].meta.source.blockId",
+				".sections[1].lessons[0].blocks[0].replyTo",
+				".courseBlocks[0].order[0]",
+				".courseBlocks[0].order[1]",
+			},
+		},
+		{
+			name: "ID repeated across items",
+			content: `{
+				"sections": [{"id": "dup", "lessons": [{"id": "dup"}]}],
+				"courseBlocks": [{"id": "cb1", "lessonId": "dup"}]
+			}`,
+			refs: []string{".courseBlocks[0].lessonId"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content := decodeContent(t, tt.content)
+			before := make(map[string]string)
+			contentStrings(content.Sections, ".sections", before)
+			contentStrings(content.CourseBlocks, ".courseBlocks", before)
+
+			courseID := uuid.New()
+			ids := remapContentIDs(content, courseID)
+
+			after := make(map[string]string)
+			contentStrings(content.Sections, ".sections", after)
+			contentStrings(content.CourseBlocks, ".courseBlocks", after)
+			newIDs := make(map[string]bool)
+			contentIDs(content.Sections, newIDs)
+			contentIDs(content.CourseBlocks, newIDs)
+
+			// No old ID is left anywhere in the content
+			for path, value := range after {
+				if _, old := ids[value]; old {
+					t.Errorf("%s still holds old ID %q", path, value)
+				}
+			}
+			// Every ID was replaced by its mapping
+			for old, replacement := range ids {
+				if !newIDs[replacement] {
+					t.Errorf("new ID %s for %q is not in the content", replacement, old)
+				}
+			}
+			// Every reference points at the remapped item it pointed at before
+			for _, path := range tt.refs {
+				want, ok := ids[before[path]]
+				if !ok {
+					t.Fatalf("test reference %s = %q is not an ID", path, before[path])
+				}
+				if after[path] != want {
+					t.Errorf("%s = %q, want %q", path, after[path], want)
+				}
+				if !newIDs[after[path]] {
+					t.Errorf("%s dangles: %q is not an item ID", path, after[path])
+				}
+			}
+		})
+	}
+}
+
+func TestRemapContentIDsIsDeterministicPerCourse(t *testing.T) {
+	const data = `{"sections": [{"id": "s1", "lessons": [{"id": "l1", "blocks": [{"id": "b1"}]}]}], "courseBlocks": [{"id": "cb1"}]}`
+	courseID := uuid.New()
+
+	first := remapContentIDs(decodeContent(t, data), courseID)
+	second := remapContentIDs(decodeContent(t, data), courseID)
+	other := remapContentIDs(decodeContent(t, data), uuid.New())
+
+	for old, id := range first {
+		if second[old] != id {
+			t.Errorf("%q remapped to %s, then %s", old, id, second[old])
+		}
+		if other[old] == id {
+			t.Errorf("%q remapped to the same ID %s for two courses", old, id)
+		}
+	}
+}
+
+func TestRemapContentIDsLeavesTextAlone(t *testing.T) {
+	content := decodeContent(t, `{
+		"sections": [{"id": "s1", "title": "Intro to s1", "lessons": [{"id": "l1", "blocks": [{"id": "b1", "text": "see l1"}]}]}],
+		"courseBlocks": []
+	}`)
+	remapContentIDs(content, uuid.New())
+
+	section := content.Sections[0]
+	if section["title"] != "Intro to s1" {
+		t.Errorf("title = %q, want it unchanged", section["title"])
+	}
+	block := contentMaps(contentMaps(section["lessons"])[0]["blocks"])[0]
+	if text, _ := block["text"].(string); !strings.HasPrefix(text, "see l1") {
+		t.Errorf("text = %q, want it unchanged", text)
+	}
+}
+
+func TestRemapContentIDsBuiltInCode(t *testing.T) {
+	// Content built in code holds []map[string]any rather than []any
+	content := &CourseContent{
+		Sections: []map[string]any{{
+			"id":      "s1",
+			"lessons": []map[string]any{{"id": "l1", "blocks": []map[string]any{{"id": "b1", "lessonId": "l1"}}}},
+		}},
+	}
+	ids := remapContentIDs(content, uuid.New())
+
+	lesson := content.Sections[0]["lessons"].([]map[string]any)[0]
+	block := lesson["blocks"].([]map[string]any)[0]
+	if lesson["id"] != ids["l1"] || block["id"] != ids["b1"] || block["lessonId"] != ids["l1"] {
+		t.Errorf("lesson %v, block %v not remapped with %v", lesson["id"], block, ids)
+	}
+}
@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+)
+
+const (
+	// defaultLargestCourses and maxLargestCourses bound the largest courses report.
+	defaultLargestCourses = 20
+	maxLargestCourses     = 100
+)
+
+// SetContentSizeLimits sets the course content size limits, in bytes of stored JSON.
+// Saves over the soft limit succeed with a warning; saves over the hard limit are
+// rejected before anything is written. Zero disables a limit.
+func (s *CourseService) SetContentSizeLimits(softBytes, hardBytes int) {
+	s.contentSoftLimit = softBytes
+	s.contentHardLimit = hardBytes
+}
+
+// contentSizeCheck is the size of course content about to be written.
+type contentSizeCheck struct {
+	Bytes   int64
+	Warning string // Set when the content is over the soft limit
+}
+
+// checkContentSize measures content as it will be stored and applies the size limits.
+// previous is the size of the content being replaced, if known. Content over the hard
+// limit is still accepted when it is no larger than before, so a course that grew past
+// the limit before it existed can be trimmed down in steps.
+func (s *CourseService) checkContentSize(content *S3CourseContent, previous *int64) (*contentSizeCheck, error) {
+	size, err := s.storage.CourseContentSize(content)
+	if err != nil {
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	grew := previous == nil || size > *previous
+	if s.contentHardLimit > 0 && size > int64(s.contentHardLimit) && grew {
+		return nil, domainerrors.ErrInvalidInput.WithMessage(fmt.Sprintf(
+			"course content would be %s, over the %s limit; split the course into smaller courses, or upload images and files through an upload URL and reference them by URL instead",
+			formatContentSize(size), formatContentSize(int64(s.contentHardLimit))))
+	}
+
+	check := &contentSizeCheck{Bytes: size}
+	if s.contentSoftLimit > 0 && size > int64(s.contentSoftLimit) {
+		check.Warning = fmt.Sprintf(
+			"course content is %s, over the recommended %s, and will be slow to open and save; consider splitting the course or moving images and files to uploads",
+			formatContentSize(size), formatContentSize(int64(s.contentSoftLimit)))
+	}
+	return check, nil
+}
+
+// recordContentSize stores the size of content just written for a course. Failures are
+// only logged; the next write records the size again.
+func (s *CourseService) recordContentSize(ctx context.Context, courseID uuid.UUID, sizeBytes int64) {
+	if err := s.courseRepo.SetContentSize(ctx, courseID, sizeBytes); err != nil {
+		s.logger.Warn("failed to record course content size", "courseID", courseID, "error", err)
+	}
+}
+
+// measureContentSize records the size of content written by an internal update, such as
+// recording an export, which the size limits don't apply to.
+func (s *CourseService) measureContentSize(ctx context.Context, courseID uuid.UUID, content *S3CourseContent) {
+	size, err := s.storage.CourseContentSize(content)
+	if err != nil {
+		s.logger.Warn("failed to measure course content size", "courseID", courseID, "error", err)
+		return
+	}
+	s.recordContentSize(ctx, courseID, size)
+}
+
+// LargestCoursesReport lists an organization's largest courses by stored content size.
+type LargestCoursesReport struct {
+	Courses        []*entity.Course // Largest first; courses not written since sizes were recorded are left out
+	SoftLimitBytes int
+	HardLimitBytes int
+}
+
+// ListLargestCourses returns the organization's largest courses, so admins can find the
+// ones to split or clean up. Admin only.
+func (s *CourseService) ListLargestCourses(ctx context.Context, kratosID uuid.UUID, limit int) (*LargestCoursesReport, error) {
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, domainerrors.ErrUserNotFound
+	}
+	if user.TenantID == nil {
+		return nil, domainerrors.ErrUserHasNoCompany
+	}
+	if !user.IsAdmin() {
+		return nil, domainerrors.ErrForbidden.WithMessage("only admins can view course sizes")
+	}
+
+	if limit <= 0 {
+		limit = defaultLargestCourses
+	}
+	if limit > maxLargestCourses {
+		limit = maxLargestCourses
+	}
+
+	courses, err := s.courseRepo.List(ctx, entity.CourseListOptions{LargestFirst: true, Limit: limit})
+	if err != nil {
+		s.logger.Error("failed to list largest courses", "userID", user.ID, "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	return &LargestCoursesReport{
+		Courses:        courses,
+		SoftLimitBytes: s.contentSoftLimit,
+		HardLimitBytes: s.contentHardLimit,
+	}, nil
+}
+
+// formatContentSize renders a content size for messages, e.g. "12.3 MB".
+func formatContentSize(n int64) string {
+	return fmt.Sprintf("%.1f MB", float64(n)/(1<<20))
+}
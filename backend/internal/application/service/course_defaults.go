@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+)
+
+// CourseDefaultsProvider looks up the settings an organization's new courses start with.
+type CourseDefaultsProvider interface {
+	CourseDefaults(ctx context.Context, tenantID uuid.UUID) entity.CourseDefaults
+}
+
+// Course settings that can be filled from the organization's course defaults, as
+// reported in StoredCourse.DefaultedFields.
+const (
+	DefaultedDestinationFolder  = "destination_folder"
+	DefaultedCategoryTags       = "category_tags"
+	DefaultedDataSource         = "data_source"
+	DefaultedAssessmentSettings = "assessment_settings"
+)
+
+// SetCourseDefaultsProvider enables applying the organization's course defaults to new courses.
+func (s *CourseService) SetCourseDefaultsProvider(provider CourseDefaultsProvider) {
+	s.courseDefaults = provider
+}
+
+// applyCourseDefaults fills the settings input leaves empty from the organization's
+// course defaults and returns the names of the fields it filled. A default folder that
+// no longer exists is skipped, leaving the course unfiled.
+func (s *CourseService) applyCourseDefaults(ctx context.Context, tenantID uuid.UUID, input *StoredCourse) []string {
+	if s.courseDefaults == nil {
+		return nil
+	}
+	defaults := s.courseDefaults.CourseDefaults(ctx, tenantID)
+
+	var filled []string
+	if input.Settings.DestinationFolder == "" && defaults.DestinationFolderID != nil {
+		folder, err := s.folderRepo.GetByID(ctx, *defaults.DestinationFolderID)
+		switch {
+		case err != nil:
+			s.logger.Warn("failed to get default course folder", "folderID", defaults.DestinationFolderID, "error", err)
+		case folder == nil:
+			s.logger.Warn("default course folder no longer exists", "folderID", defaults.DestinationFolderID)
+		default:
+			input.Settings.DestinationFolder = folder.ID.String()
+			filled = append(filled, DefaultedDestinationFolder)
+		}
+	}
+	if len(input.Settings.CategoryTags) == 0 && len(defaults.CategoryTags) > 0 {
+		input.Settings.CategoryTags = append([]string(nil), defaults.CategoryTags...)
+		filled = append(filled, DefaultedCategoryTags)
+	}
+	if input.Settings.DataSource == "" && defaults.DataSource != "" {
+		input.Settings.DataSource = defaults.DataSource
+		filled = append(filled, DefaultedDataSource)
+	}
+	if input.AssessmentSettings == nil && defaults.Assessment != nil {
+		input.AssessmentSettings = map[string]any{
+			"enableEmbeddedKnowledgeChecks": defaults.Assessment.EnableEmbeddedKnowledgeChecks,
+			"enableFinalExam":               defaults.Assessment.EnableFinalExam,
+		}
+		filled = append(filled, DefaultedAssessmentSettings)
+	}
+	return filled
+}
@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+)
+
+// courseExportURLExpiry is how long the presigned URL returned by DownloadExport is valid.
+const courseExportURLExpiry = 15 * time.Minute
+
+// SetExportArtifacts enables ListExports and DownloadExport.
+func (s *CourseService) SetExportArtifacts(artifacts repository.ExportArtifactRepository) {
+	s.exportArtifacts = artifacts
+}
+
+// ListExports returns a course's export artifacts, newest first. Purged artifacts are
+// included so users can see when and why each file was deleted.
+func (s *CourseService) ListExports(ctx context.Context, kratosID, courseID uuid.UUID) ([]*entity.ExportArtifact, error) {
+	if s.exportArtifacts == nil {
+		return nil, domainerrors.ErrInternal.WithMessage("course exports are not configured")
+	}
+	if _, _, err := s.courseForAttachments(ctx, kratosID, courseID, false); err != nil {
+		return nil, err
+	}
+
+	artifacts, err := s.exportArtifacts.ListByCourseID(ctx, courseID)
+	if err != nil {
+		s.logger.Error("failed to list export artifacts", "courseID", courseID, "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	return artifacts, nil
+}
+
+// DownloadExport returns a presigned URL for a course export artifact and when the URL
+// expires. A purged artifact fails with ErrExportArtifactPurged saying why it is gone.
+func (s *CourseService) DownloadExport(ctx context.Context, kratosID, artifactID uuid.UUID) (string, time.Time, error) {
+	if s.exportArtifacts == nil {
+		return "", time.Time{}, domainerrors.ErrInternal.WithMessage("course exports are not configured")
+	}
+
+	artifact, err := s.exportArtifacts.GetByID(ctx, artifactID)
+	if err != nil {
+		s.logger.Error("failed to get export artifact", "artifactID", artifactID, "error", err)
+		return "", time.Time{}, domainerrors.ErrInternal.WithCause(err)
+	}
+	if artifact == nil || artifact.CourseID == nil {
+		return "", time.Time{}, domainerrors.ErrNotFound.WithMessage("export not found")
+	}
+	if _, _, err := s.courseForAttachments(ctx, kratosID, *artifact.CourseID, false); err != nil {
+		return "", time.Time{}, err
+	}
+	if artifact.IsPurged() {
+		return "", time.Time{}, purgedExportError(artifact)
+	}
+
+	expiresAt := time.Now().Add(courseExportURLExpiry)
+	url, err := s.storage.GenerateDownloadURL(ctx, artifact.TenantID, artifact.FilePath, courseExportURLExpiry)
+	if err != nil {
+		s.logger.Error("failed to generate export download URL", "artifactID", artifactID, "error", err)
+		return "", time.Time{}, domainerrors.ErrInternal.WithCause(err)
+	}
+	return url, expiresAt, nil
+}
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+)
+
+// checkGenerationLock rejects writes to a course a full course run is generating, naming
+// the run's job so the caller can follow or cancel it.
+func checkGenerationLock(lock *entity.CourseGenerationLock) error {
+	if lock == nil {
+		return nil
+	}
+	return domainerrors.ErrCourseGenerationLocked.WithMessage(
+		"the course is read-only while generation job " + lock.JobID.String() + " is running")
+}
+
+// checkCourseUnlocked rejects component writes to a course a full course run is generating.
+func (s *AIGenerationService) checkCourseUnlocked(ctx context.Context, courseID uuid.UUID) error {
+	if s.courseRepo == nil {
+		return nil
+	}
+	lock, err := s.courseRepo.GetGenerationLock(ctx, courseID)
+	if err != nil {
+		s.logger.Error("failed to check course generation lock", "courseID", courseID, "error", err)
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+	return checkGenerationLock(lock)
+}
+
+// releaseStaleGenerationLocks clears course locks left by full course runs that ended
+// without releasing them, so their courses become editable again.
+func (s *AIGenerationService) releaseStaleGenerationLocks(ctx context.Context, tenantID uuid.UUID) {
+	if s.courseRepo == nil {
+		return
+	}
+	released, err := s.courseRepo.ReleaseStaleGenerationLocks(ctx)
+	if err != nil {
+		s.logger.Error("failed to release stale course generation locks", "tenantID", tenantID, "error", err)
+		return
+	}
+	if len(released) > 0 {
+		s.logger.Warn("released stale course generation locks", "tenantID", tenantID, "courseIDs", released)
+	}
+}
@@ -0,0 +1,409 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+	"github.com/sogos/mirai-backend/internal/infrastructure/cache"
+	"github.com/sogos/mirai-backend/internal/infrastructure/storage"
+)
+
+const (
+	// coursePlayerCacheTTL bounds how long an assembled player view is served from cache.
+	// Views are also invalidated whenever lessons or the outline change.
+	coursePlayerCacheTTL = time.Hour
+
+	// coursePlayerAssetURLExpiry is how long asset URLs in a player view stay valid. It
+	// outlasts the cache TTL so a cached view never hands out an expired link.
+	coursePlayerAssetURLExpiry = 6 * time.Hour
+)
+
+// coursePlayerHiddenFields are component content fields that only matter while authoring.
+// Uploaded asset paths are replaced by an asset_url rather than dropped.
+var coursePlayerHiddenFields = []string{"needs_review", "internal_notes", "asset_path", "alt_text_generation_failed"}
+
+// CoursePlayerView is the learner-facing content of a course: its sections, lessons and
+// components in display order, without authoring metadata.
+type CoursePlayerView struct {
+	CourseID         uuid.UUID
+	Title            string
+	OutlineVersion   int32
+	PublishedVersion int32 // Published version served; 0 for the draft
+	LessonCount      int
+	Sections         []CoursePlayerSection
+}
+
+// CoursePlayerSection is a section of a CoursePlayerView.
+type CoursePlayerSection struct {
+	ID          uuid.UUID
+	Title       string
+	Description string
+	Lessons     []CoursePlayerLesson
+}
+
+// CoursePlayerLesson is a generated lesson of a CoursePlayerView. The previous and next
+// lesson IDs follow display order across sections, so the player can page through the
+// course one lesson at a time.
+type CoursePlayerLesson struct {
+	ID                       uuid.UUID
+	Title                    string
+	EstimatedDurationMinutes *int32
+	Components               []CoursePlayerComponent
+	SegueText                *string
+	PreviousLessonID         *uuid.UUID
+	NextLessonID             *uuid.UUID
+}
+
+// CoursePlayerComponent is a lesson component as the player renders it. Uploaded assets
+// are resolved to an asset_url in the content.
+type CoursePlayerComponent struct {
+	ID          uuid.UUID
+	Type        valueobject.LessonComponentType
+	Position    int32
+	ContentJSON json.RawMessage
+}
+
+// PublishedCourseReader reads the files of a course's published versions.
+type PublishedCourseReader interface {
+	ReadPublishedCourse(ctx context.Context, tenantID, courseID uuid.UUID, version int32, filename string, v interface{}) error
+}
+
+// CourseDraftTracker records that a course's draft changed, so the course shows as
+// having unpublished changes.
+type CourseDraftTracker interface {
+	CourseDraftChanged(ctx context.Context, courseID uuid.UUID)
+}
+
+// SetCoursePlayerCache enables caching of assembled course player views.
+func (s *AIGenerationService) SetCoursePlayerCache(c cache.Cache) {
+	s.playerCache = c
+}
+
+// SetPublishedCourseReader serves published courses from their stored published version.
+// Without it, learners see the draft.
+func (s *AIGenerationService) SetPublishedCourseReader(r PublishedCourseReader) {
+	s.publishedCourses = r
+}
+
+// SetCourseDraftTracker reports lesson and outline changes as draft changes.
+func (s *AIGenerationService) SetCourseDraftTracker(t CourseDraftTracker) {
+	s.draftTracker = t
+}
+
+// GetCoursePlayerView returns a course's generated lessons as the course player shows them.
+// Published courses are served their published version, so edits made since publishing
+// don't reach learners until they are published; with draft set, editors see the edits.
+// Courses that aren't published are reported as not found unless the user may edit them,
+// so authors can preview a course before publishing it. Lessons not yet generated and
+// lessons the outline no longer places are left out.
+func (s *AIGenerationService) GetCoursePlayerView(ctx context.Context, kratosID uuid.UUID, courseID uuid.UUID, draft bool) (*CoursePlayerView, error) {
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, domainerrors.ErrUserNotFound
+	}
+	if user.TenantID == nil {
+		return nil, domainerrors.ErrUserHasNoCompany
+	}
+
+	course, err := s.courseRepo.GetByID(ctx, courseID)
+	if err != nil {
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	if course == nil {
+		return nil, domainerrors.ErrNotFound.WithMessage("course not found")
+	}
+	if course.Status != entity.CourseStatusPublished || draft {
+		if err := s.checkCourseAccess(ctx, user, courseID); err != nil {
+			return nil, domainerrors.ErrNotFound.WithMessage("course not found")
+		}
+	}
+
+	if course.Status == entity.CourseStatusPublished && course.HasPublishedVersion() && !draft && s.publishedCourses != nil {
+		return s.publishedCoursePlayerView(ctx, course)
+	}
+
+	key := cache.TenantCacheKeys.CoursePlayer(courseID.String())
+	if s.playerCache != nil {
+		var cached CoursePlayerView
+		if entry, err := s.playerCache.Get(ctx, key, &cached); err == nil && entry != nil {
+			cached.Title = course.Title
+			return &cached, nil
+		}
+	}
+
+	view, err := s.assembleCoursePlayerView(ctx, courseID)
+	if err != nil {
+		return nil, err
+	}
+	s.presentCoursePlayerView(ctx, *user.TenantID, view)
+
+	if s.playerCache != nil {
+		if _, err := s.playerCache.Set(ctx, key, view, "", coursePlayerCacheTTL); err != nil {
+			s.logger.Warn("failed to cache course player view", "courseID", courseID, "error", err)
+		}
+	}
+
+	view.Title = course.Title
+	return view, nil
+}
+
+// publishedCoursePlayerView serves the course's published version, which keeps the title
+// it had when it was published.
+func (s *AIGenerationService) publishedCoursePlayerView(ctx context.Context, course *entity.Course) (*CoursePlayerView, error) {
+	key := cache.TenantCacheKeys.PublishedPlayer(course.ID.String(), course.PublishedVersion)
+	if s.playerCache != nil {
+		var cached CoursePlayerView
+		if entry, err := s.playerCache.Get(ctx, key, &cached); err == nil && entry != nil {
+			return &cached, nil
+		}
+	}
+
+	var view CoursePlayerView
+	if err := s.publishedCourses.ReadPublishedCourse(ctx, course.TenantID, course.ID, course.PublishedVersion, storage.PublishedPlayerFile, &view); err != nil {
+		s.logger.Error("failed to read published course player view",
+			"courseID", course.ID, "publishedVersion", course.PublishedVersion, "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	view.PublishedVersion = course.PublishedVersion
+	s.presentCoursePlayerView(ctx, course.TenantID, &view)
+
+	if s.playerCache != nil {
+		if _, err := s.playerCache.Set(ctx, key, &view, "", coursePlayerCacheTTL); err != nil {
+			s.logger.Warn("failed to cache course player view", "courseID", course.ID, "error", err)
+		}
+	}
+	return &view, nil
+}
+
+// SnapshotCoursePlayerView assembles a course's player view as its lessons stand now, to
+// be stored as a published version. The title is left for the caller. Component content is kept as authored; it is only
+// stripped and its assets resolved when the version is served, since asset URLs expire.
+func (s *AIGenerationService) SnapshotCoursePlayerView(ctx context.Context, courseID uuid.UUID) (*CoursePlayerView, error) {
+	return s.assembleCoursePlayerView(ctx, courseID)
+}
+
+// assembleCoursePlayerView assembles the view from the latest outline and its generated
+// lessons, with component content as stored. The course title is left for the caller,
+// since it can change without the content changing.
+func (s *AIGenerationService) assembleCoursePlayerView(ctx context.Context, courseID uuid.UUID) (*CoursePlayerView, error) {
+	log := s.logger.With("courseID", courseID)
+
+	outline, err := s.outlineRepo.GetByCourseID(ctx, courseID)
+	if err != nil {
+		log.Error("failed to get course outline", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	if outline == nil {
+		return nil, domainerrors.ErrNotFound.WithMessage("course has no outline")
+	}
+	if err := s.loadOutlineSections(ctx, outline); err != nil {
+		log.Error("failed to load outline sections", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	lessons, err := s.genLessonRepo.ListByCourseID(ctx, courseID, false)
+	if err != nil {
+		log.Error("failed to list generated lessons", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	byOutlineLesson := make(map[uuid.UUID]*entity.GeneratedLesson, len(lessons))
+	for _, lesson := range lessons {
+		byOutlineLesson[lesson.OutlineLessonID] = lesson
+	}
+
+	view := &CoursePlayerView{
+		CourseID:       courseID,
+		OutlineVersion: outline.Version,
+		Sections:       []CoursePlayerSection{},
+	}
+	var previous *CoursePlayerLesson
+	for _, section := range outline.Sections {
+		playerSection := CoursePlayerSection{
+			ID:          section.ID,
+			Title:       section.Title,
+			Description: section.Description,
+			Lessons:     []CoursePlayerLesson{},
+		}
+		for _, outlineLesson := range section.Lessons {
+			lesson, ok := byOutlineLesson[outlineLesson.ID]
+			if !ok {
+				continue
+			}
+			components, err := s.componentRepo.ListByLessonID(ctx, lesson.ID)
+			if err != nil {
+				log.Error("failed to list lesson components", "lessonID", lesson.ID, "error", err)
+				return nil, domainerrors.ErrInternal.WithCause(err)
+			}
+
+			playerLesson := CoursePlayerLesson{
+				ID:                       lesson.ID,
+				Title:                    lesson.Title,
+				EstimatedDurationMinutes: outlineLesson.EstimatedDurationMinutes,
+				Components:               make([]CoursePlayerComponent, 0, len(components)),
+				SegueText:                lesson.SegueText,
+			}
+			for _, component := range components {
+				playerLesson.Components = append(playerLesson.Components, CoursePlayerComponent{
+					ID:          component.ID,
+					Type:        component.Type,
+					Position:    component.Position,
+					ContentJSON: component.ContentJSON,
+				})
+			}
+			playerSection.Lessons = append(playerSection.Lessons, playerLesson)
+			view.LessonCount++
+		}
+		if len(playerSection.Lessons) == 0 {
+			continue
+		}
+		view.Sections = append(view.Sections, playerSection)
+
+		// Link lessons only once they have their final place in the view
+		lessons := view.Sections[len(view.Sections)-1].Lessons
+		for i := range lessons {
+			if previous != nil {
+				previous.NextLessonID = &lessons[i].ID
+				lessons[i].PreviousLessonID = &previous.ID
+			}
+			previous = &lessons[i]
+		}
+	}
+
+	return view, nil
+}
+
+// RestoreCoursePlayerView puts the lessons of a stored player view back the way the view
+// has them: each lesson's title, segue and components. Lessons deleted since are skipped,
+// and lessons added since are left alone, as is the outline. Components deleted since
+// are recreated with new IDs and without their alignment metadata. Returns the number of
+// lessons restored.
+func (s *AIGenerationService) RestoreCoursePlayerView(ctx context.Context, view *CoursePlayerView) (int, error) {
+	restored := 0
+	for _, section := range view.Sections {
+		for _, lesson := range section.Lessons {
+			ok, err := s.restoreLesson(ctx, view.CourseID, lesson)
+			if err != nil {
+				return restored, err
+			}
+			if ok {
+				restored++
+			}
+		}
+	}
+	if restored > 0 {
+		s.invalidateCourseCaches(ctx, view.CourseID)
+	}
+	return restored, nil
+}
+
+// restoreLesson restores one lesson of a stored player view, reporting false if the
+// lesson no longer exists.
+func (s *AIGenerationService) restoreLesson(ctx context.Context, courseID uuid.UUID, stored CoursePlayerLesson) (bool, error) {
+	lesson, err := s.genLessonRepo.GetByID(ctx, stored.ID)
+	if err != nil {
+		return false, err
+	}
+	if lesson == nil || lesson.CourseID != courseID {
+		return false, nil
+	}
+
+	lesson.Title = stored.Title
+	lesson.SegueText = stored.SegueText
+	if err := s.genLessonRepo.Update(ctx, lesson); err != nil {
+		return false, err
+	}
+
+	components, err := s.componentRepo.ListByLessonID(ctx, lesson.ID)
+	if err != nil {
+		return false, err
+	}
+	keep := make(map[uuid.UUID]bool, len(stored.Components))
+	for _, c := range stored.Components {
+		keep[c.ID] = true
+	}
+	current := make(map[uuid.UUID]*entity.LessonComponent, len(components))
+	for _, c := range components {
+		if !keep[c.ID] {
+			if err := s.componentRepo.Delete(ctx, c.ID); err != nil {
+				return false, err
+			}
+			continue
+		}
+		current[c.ID] = c
+	}
+
+	// Placing the stored components in order, each at its final position, leaves the
+	// ones already placed ahead of everything moved or created after them
+	for i, c := range stored.Components {
+		position := int32(i + 1)
+		if component, ok := current[c.ID]; ok {
+			component.Type = c.Type
+			component.ContentJSON = c.ContentJSON
+			component.Position = position
+			if err := s.componentRepo.Update(ctx, component); err != nil {
+				return false, err
+			}
+			continue
+		}
+		if err := s.componentRepo.Create(ctx, &entity.LessonComponent{
+			TenantID:    lesson.TenantID,
+			LessonID:    lesson.ID,
+			Type:        c.Type,
+			Position:    position,
+			ContentJSON: c.ContentJSON,
+		}); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// presentCoursePlayerView prepares every component's content for learners in place,
+// upgrading quizzes stored in an older schema.
+func (s *AIGenerationService) presentCoursePlayerView(ctx context.Context, tenantID uuid.UUID, view *CoursePlayerView) {
+	for i := range view.Sections {
+		for j := range view.Sections[i].Lessons {
+			components := view.Sections[i].Lessons[j].Components
+			for k := range components {
+				if components[k].Type == valueobject.LessonComponentTypeQuiz {
+					components[k].ContentJSON = playerQuizContent(components[k].ContentJSON)
+				}
+				components[k].ContentJSON = s.playerComponentContent(ctx, tenantID, components[k].ID, components[k].ContentJSON)
+			}
+		}
+	}
+}
+
+// playerComponentContent strips authoring-only fields from a component's content and
+// resolves an uploaded asset to a presigned URL. Content that isn't a JSON object is
+// passed through unchanged.
+func (s *AIGenerationService) playerComponentContent(ctx context.Context, tenantID, componentID uuid.UUID, raw json.RawMessage) json.RawMessage {
+	var content map[string]any
+	if err := json.Unmarshal(raw, &content); err != nil || content == nil {
+		return raw
+	}
+
+	if assetPath, ok := content["asset_path"].(string); ok && assetPath != "" && s.assetStorage != nil {
+		url, err := s.assetStorage.GenerateDownloadURL(ctx, tenantID, assetPath, coursePlayerAssetURLExpiry)
+		if err != nil {
+			s.logger.Warn("failed to presign component asset", "componentID", componentID, "error", err)
+		} else {
+			content["asset_url"] = url
+		}
+	}
+	for _, field := range coursePlayerHiddenFields {
+		delete(content, field)
+	}
+
+	data, err := json.Marshal(content)
+	if err != nil {
+		return raw
+	}
+	return data
+}
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+)
+
+// coursePresenceTTL is how long a heartbeat keeps someone present. Editors send one
+// every 20 seconds, so a late heartbeat doesn't make them flicker out.
+const coursePresenceTTL = time.Minute
+
+// CoursePresenceStore records who has a course open. Presence is best effort: a store
+// that can't be reached drops heartbeats and reports nobody present rather than failing.
+type CoursePresenceStore interface {
+	Heartbeat(ctx context.Context, tenantID, courseID uuid.UUID, presence entity.CoursePresence, ttl time.Duration) error
+	List(ctx context.Context, tenantID, courseID uuid.UUID) ([]entity.CoursePresence, error)
+}
+
+// SetCoursePresence enables editor presence. Without it, heartbeats are ignored and
+// nobody else is ever reported on a course.
+func (s *CourseService) SetCoursePresence(store CoursePresenceStore) {
+	s.presence = store
+}
+
+// HeartbeatCoursePresence marks the user as having the course open, viewing or editing,
+// and returns who else has it open.
+func (s *CourseService) HeartbeatCoursePresence(ctx context.Context, kratosID, courseID uuid.UUID, editing bool) ([]entity.CoursePresence, error) {
+	user, _, err := s.courseForAttachments(ctx, kratosID, courseID, false)
+	if err != nil {
+		return nil, err
+	}
+	if s.presence == nil {
+		return nil, nil
+	}
+
+	presence := entity.CoursePresence{
+		UserID:      user.ID,
+		DisplayName: s.creatorDisplayNames(ctx, []uuid.UUID{kratosID})[kratosID],
+		Editing:     editing,
+		LastSeenAt:  time.Now(),
+	}
+	if err := s.presence.Heartbeat(ctx, *user.TenantID, courseID, presence, coursePresenceTTL); err != nil {
+		s.logger.Warn("failed to record course presence", "courseID", courseID, "error", err)
+	}
+	return s.otherPresence(ctx, user, courseID), nil
+}
+
+// GetCoursePresence returns who other than the user has the course open.
+func (s *CourseService) GetCoursePresence(ctx context.Context, kratosID, courseID uuid.UUID) ([]entity.CoursePresence, error) {
+	user, _, err := s.courseForAttachments(ctx, kratosID, courseID, false)
+	if err != nil {
+		return nil, err
+	}
+	if s.presence == nil {
+		return nil, nil
+	}
+	return s.otherPresence(ctx, user, courseID), nil
+}
+
+// otherPresence lists everyone but user present on the course, editors first and then
+// by name. It is empty when presence can't be read.
+func (s *CourseService) otherPresence(ctx context.Context, user *entity.User, courseID uuid.UUID) []entity.CoursePresence {
+	present, err := s.presence.List(ctx, *user.TenantID, courseID)
+	if err != nil {
+		s.logger.Warn("failed to list course presence", "courseID", courseID, "error", err)
+		return nil
+	}
+
+	others := slices.DeleteFunc(present, func(p entity.CoursePresence) bool {
+		return p.UserID == user.ID
+	})
+	slices.SortFunc(others, func(a, b entity.CoursePresence) int {
+		if a.Editing != b.Editing {
+			if a.Editing {
+				return -1
+			}
+			return 1
+		}
+		return cmp.Compare(a.DisplayName, b.DisplayName)
+	})
+	return others
+}
@@ -0,0 +1,323 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/audit"
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/tenant"
+	"github.com/sogos/mirai-backend/internal/infrastructure/cache"
+	"github.com/sogos/mirai-backend/internal/infrastructure/storage"
+)
+
+// publishedVersionBackfillBatchSize is how many courses the published version backfill
+// reads at a time.
+const publishedVersionBackfillBatchSize = 50
+
+// CoursePlayerSnapshotter assembles the lessons stored with each published version of a
+// course, and puts them back when a draft is discarded.
+type CoursePlayerSnapshotter interface {
+	SnapshotCoursePlayerView(ctx context.Context, courseID uuid.UUID) (*CoursePlayerView, error)
+	RestoreCoursePlayerView(ctx context.Context, view *CoursePlayerView) (int, error)
+}
+
+// SetPlayerSnapshotter enables publishing versions of courses. Without it, learners see
+// the draft of every course.
+func (s *CourseService) SetPlayerSnapshotter(snapshots CoursePlayerSnapshotter) {
+	s.playerSnapshots = snapshots
+}
+
+// CourseDraftChanged records an edit to a course's draft made outside CourseService, such
+// as a lesson being edited or regenerated. A failure only hides the unpublished changes
+// badge until the next edit, so it is logged rather than returned.
+func (s *CourseService) CourseDraftChanged(ctx context.Context, courseID uuid.UUID) {
+	if _, err := s.courseRepo.BumpDraftVersion(ctx, courseID); err != nil {
+		s.logger.Warn("failed to record course draft change", "courseID", courseID, "error", err)
+		return
+	}
+	_ = s.cache.Delete(ctx, cache.TenantCacheKeys.Course(courseID.String()))
+	s.InvalidateLibraryCache(ctx)
+}
+
+// PublishChanges makes a published course's draft the version learners see. Publishing a
+// course that has no unpublished changes does nothing. Editors only.
+func (s *CourseService) PublishChanges(ctx context.Context, kratosID uuid.UUID, id string) (*StoredCourse, error) {
+	user, course, err := s.courseForVersioning(ctx, kratosID, id)
+	if err != nil {
+		return nil, err
+	}
+	if course.Status != entity.CourseStatusPublished {
+		return nil, domainerrors.ErrBadRequest.WithMessage("only published courses have changes to publish")
+	}
+
+	if !course.HasPublishedVersion() || course.HasUnpublishedChanges() {
+		if err := s.publishVersion(ctx, user, course); err != nil {
+			return nil, err
+		}
+		if s.publishListener != nil {
+			s.publishListener.CoursePublished(ctx, course)
+		}
+	}
+	return s.GetCourse(ctx, kratosID, id)
+}
+
+// DiscardDraft reverts a published course's draft to its published version. The course
+// content is restored whole; of the lessons, those still in the course get back their
+// title, segue and components, while outline changes and lessons added since publishing
+// are kept. When anything was kept the draft still differs from what learners see, so
+// the course keeps showing unpublished changes. Returns the course and the number of
+// lessons restored. Editors only.
+func (s *CourseService) DiscardDraft(ctx context.Context, kratosID uuid.UUID, id string) (*StoredCourse, int, error) {
+	user, course, err := s.courseForVersioning(ctx, kratosID, id)
+	if err != nil {
+		return nil, 0, err
+	}
+	if !course.HasPublishedVersion() {
+		return nil, 0, domainerrors.ErrBadRequest.WithMessage("course has no published version to revert to")
+	}
+	if !course.HasUnpublishedChanges() {
+		stored, err := s.GetCourse(ctx, kratosID, id)
+		return stored, 0, err
+	}
+	if s.playerSnapshots == nil {
+		return nil, 0, domainerrors.ErrInternal.WithMessage("course publishing not configured")
+	}
+
+	log := s.logger.With("courseID", course.ID, "publishedVersion", course.PublishedVersion)
+
+	var view CoursePlayerView
+	if err := s.storage.ReadPublishedCourse(ctx, course.TenantID, course.ID, course.PublishedVersion, storage.PublishedPlayerFile, &view); err != nil {
+		log.Error("failed to read published lessons", "error", err)
+		return nil, 0, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	// Lessons go first: restoring them counts as a draft change, which the reset below undoes
+	restored, err := s.playerSnapshots.RestoreCoursePlayerView(ctx, &view)
+	if err != nil {
+		log.Error("failed to restore published lessons", "lessonsRestored", restored, "error", err)
+		return nil, 0, domainerrors.ErrInternal.WithCause(err)
+	}
+	if err := s.storage.RestoreCourseContent(ctx, course.TenantID, course.ID, course.PublishedVersion); err != nil {
+		log.Error("failed to restore published course content", "error", err)
+		return nil, 0, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	current, err := s.playerSnapshots.SnapshotCoursePlayerView(ctx, course.ID)
+	if errors.Is(err, domainerrors.ErrNotFound) {
+		current, err = &CoursePlayerView{CourseID: course.ID}, nil
+	}
+	if err != nil {
+		log.Error("failed to assemble restored course lessons", "error", err)
+		return nil, 0, domainerrors.ErrInternal.WithCause(err)
+	}
+	kept := !sameCourseLessons(&view, current)
+
+	draftVersion := course.PublishedVersion
+	if kept {
+		draftVersion = course.DraftVersion
+	}
+	entry := courseAuditEntry(user, course.TenantID, audit.ActionCourseDraftDiscarded, audit.TargetCourse, course.ID,
+		audit.Changes{}.Field("draftVersion", course.DraftVersion, draftVersion))
+	if err := audited(ctx, s.auditLog, &entry, func(ctx context.Context) error {
+		if kept {
+			return nil
+		}
+		return s.courseRepo.ResetDraftVersion(ctx, course.ID)
+	}); err != nil {
+		log.Error("failed to reset course draft version", "error", err)
+		return nil, 0, domainerrors.ErrInternal.WithCause(err)
+	}
+	s.invalidateCourseVersions(ctx, course.ID)
+
+	log.Info("course draft discarded", "lessonsRestored", restored, "changesKept", kept)
+
+	stored, err := s.GetCourse(ctx, kratosID, id)
+	return stored, restored, err
+}
+
+// sameCourseLessons reports whether a restored draft has the sections and lessons of the
+// published version it was restored from, in the same order. Restored lessons match the
+// version's titles, segues and components, so what can still differ is the outline and
+// the lessons added or deleted since publishing.
+func sameCourseLessons(published, draft *CoursePlayerView) bool {
+	if published.OutlineVersion != draft.OutlineVersion || len(published.Sections) != len(draft.Sections) {
+		return false
+	}
+	for i, section := range published.Sections {
+		other := draft.Sections[i]
+		if section.ID != other.ID || section.Title != other.Title || section.Description != other.Description ||
+			len(section.Lessons) != len(other.Lessons) {
+			return false
+		}
+		for j, lesson := range section.Lessons {
+			a, b := lesson.EstimatedDurationMinutes, other.Lessons[j].EstimatedDurationMinutes
+			if lesson.ID != other.Lessons[j].ID || (a == nil) != (b == nil) || (a != nil && *a != *b) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// BackfillPublishedVersions stores a published version for courses published before
+// versions were stored, whose learners still see the draft. Each gets its draft as it
+// is now, which is what its learners see. Courses a full course run is generating are
+// left for a later run, as are ones that fail. Returns the number backfilled.
+func (s *CourseService) BackfillPublishedVersions(ctx context.Context) (int, error) {
+	if s.playerSnapshots == nil {
+		return 0, nil
+	}
+	log := s.logger.With("job", "published-version-backfill")
+	adminCtx := tenant.WithSuperAdmin(ctx, true)
+	status := entity.CourseStatusPublished
+
+	backfilled, skipped := 0, 0
+	for {
+		if err := ctx.Err(); err != nil {
+			return backfilled, err
+		}
+		// Backfilled courses drop out of the listing, so only skipped ones are paged past
+		courses, err := s.courseRepo.List(adminCtx, entity.CourseListOptions{
+			Status:      &status,
+			Unversioned: true,
+			Limit:       publishedVersionBackfillBatchSize,
+			Offset:      skipped,
+		})
+		if err != nil {
+			return backfilled, fmt.Errorf("failed to list unversioned published courses: %w", err)
+		}
+
+		for _, course := range courses {
+			tenantCtx := tenant.WithTenantID(ctx, course.TenantID)
+			lock, err := s.courseRepo.GetGenerationLock(tenantCtx, course.ID)
+			if err == nil && lock == nil {
+				err = s.publishVersion(tenantCtx, nil, course)
+			}
+			if err != nil || lock != nil {
+				log.Warn("published version not backfilled", "courseID", course.ID, "locked", lock != nil, "error", err)
+				skipped++
+				continue
+			}
+			backfilled++
+		}
+
+		if len(courses) < publishedVersionBackfillBatchSize {
+			break
+		}
+	}
+
+	if backfilled > 0 || skipped > 0 {
+		log.Info("published versions backfilled", "backfilled", backfilled, "skipped", skipped)
+	}
+	return backfilled, nil
+}
+
+// courseForVersioning loads a course the user may edit. Publishing snapshots the lessons
+// and discarding rewrites them, so neither may run while a full course run is generating.
+func (s *CourseService) courseForVersioning(ctx context.Context, kratosID uuid.UUID, id string) (*entity.User, *entity.Course, error) {
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, nil, domainerrors.ErrUserNotFound
+	}
+
+	courseID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, nil, domainerrors.ErrInvalidInput.WithMessage("invalid course ID")
+	}
+
+	course, err := s.courseRepo.GetByID(ctx, courseID)
+	if err != nil {
+		s.logger.Error("failed to get course", "courseID", id, "error", err)
+		return nil, nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	if course == nil {
+		return nil, nil, domainerrors.ErrNotFound.WithMessage("course not found")
+	}
+	if err := s.checkCourseEdit(ctx, user, course); err != nil {
+		return nil, nil, err
+	}
+	if err := checkGenerationLock(course.GenerationLock); err != nil {
+		return nil, nil, err
+	}
+	return user, course, nil
+}
+
+// publishVersion stores the course's draft as a published version and points learners at
+// it. The version's files are all written before the course switches to them, so learners
+// see either the old version or the new one. The old version's files are then deleted.
+func (s *CourseService) publishVersion(ctx context.Context, user *entity.User, course *entity.Course) error {
+	if s.playerSnapshots == nil {
+		return domainerrors.ErrInternal.WithMessage("course publishing not configured")
+	}
+
+	version := course.DraftVersion
+	previous := course.PublishedVersion
+	log := s.logger.With("courseID", course.ID, "version", version)
+
+	view, err := s.playerSnapshots.SnapshotCoursePlayerView(ctx, course.ID)
+	if errors.Is(err, domainerrors.ErrNotFound) {
+		// Nothing generated yet, so the version has no lessons
+		view, err = &CoursePlayerView{CourseID: course.ID, Sections: []CoursePlayerSection{}}, nil
+	}
+	if err != nil {
+		log.Error("failed to assemble course lessons for publishing", "error", err)
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+	view.Title = course.Title
+
+	if err := s.storage.PublishCourseContent(ctx, course.TenantID, course.ID, version); err != nil {
+		log.Error("failed to copy course content for publishing", "error", err)
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+	if err := s.storage.WritePublishedCourse(ctx, course.TenantID, course.ID, version, storage.PublishedPlayerFile, view); err != nil {
+		log.Error("failed to store published course lessons", "error", err)
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+
+	entry := courseAuditEntry(user, course.TenantID, audit.ActionCourseChangesPublished, audit.TargetCourse, course.ID,
+		audit.Changes{}.Field("publishedVersion", previous, version))
+	var switched bool
+	if err := audited(ctx, s.auditLog, &entry, func(ctx context.Context) error {
+		var err error
+		switched, err = s.courseRepo.SetPublishedVersion(ctx, course.ID, previous, version)
+		if err == nil && !switched {
+			err = domainerrors.ErrBadRequest.WithMessage("the course was published by someone else at the same time; reload and try again")
+		}
+		return err
+	}); err != nil {
+		if !switched {
+			var domainErr *domainerrors.DomainError
+			if errors.As(err, &domainErr) {
+				return domainErr
+			}
+		}
+		log.Error("failed to switch course to its new published version", "error", err)
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+	course.PublishedVersion = version
+
+	if previous > 0 && previous != version {
+		if err := s.storage.DeletePublishedCourse(ctx, course.TenantID, course.ID, previous); err != nil {
+			// Learners no longer see this version, so its files only cost storage
+			log.Warn("failed to delete previous published version", "previousVersion", previous, "error", err)
+		}
+		_ = s.cache.Delete(ctx, cache.TenantCacheKeys.PublishedPlayer(course.ID.String(), previous))
+	}
+	s.invalidateCourseVersions(ctx, course.ID)
+
+	log.Info("course version published", "previousVersion", previous)
+	return nil
+}
+
+// invalidateCourseVersions drops the cached course metadata and library listing after a
+// course's versions change.
+func (s *CourseService) invalidateCourseVersions(ctx context.Context, courseID uuid.UUID) {
+	_ = s.cache.Delete(ctx, cache.TenantCacheKeys.Course(courseID.String()))
+	_ = s.cache.InvalidateNamespace(ctx, cache.NamespaceCourses)
+	s.InvalidateLibraryCache(ctx)
+}
@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/service"
+)
+
+const (
+	// outlineReferenceExcerpts is how many reference attachment excerpts an outline prompt gets.
+	outlineReferenceExcerpts = 8
+
+	// lessonReferenceExcerpts is how many reference attachment excerpts a lesson prompt gets.
+	lessonReferenceExcerpts = 4
+)
+
+// CourseReferenceLister lists a course's reference attachments that prompts should use.
+type CourseReferenceLister interface {
+	ListForPrompts(ctx context.Context, courseID uuid.UUID) ([]*entity.CourseAttachment, error)
+}
+
+// SetCourseReferences includes the text of reference files attached to a course in its
+// outline and lesson prompts, alongside SME knowledge.
+func (s *AIGenerationService) SetCourseReferences(references CourseReferenceLister) {
+	s.courseReferences = references
+}
+
+// courseReferenceInputs returns up to limit excerpts of the course's reference
+// attachments, the ones sharing the most words with topic first, grouped by attachment.
+// Failures are logged and generation goes on without references.
+func (s *AIGenerationService) courseReferenceInputs(ctx context.Context, courseID uuid.UUID, topic string, limit int) []service.CourseReferenceInput {
+	if s.courseReferences == nil {
+		return nil
+	}
+	attachments, err := s.courseReferences.ListForPrompts(ctx, courseID)
+	if err != nil {
+		s.logger.Warn("failed to load course reference attachments", "courseID", courseID, "error", err)
+		return nil
+	}
+	return selectReferenceExcerpts(attachments, topic, limit)
+}
+
+// selectReferenceExcerpts picks up to limit chunks across attachments, scoring each by
+// how many of topic's words it contains. Ties keep document order, so with no overlap
+// the opening chunks of each attachment are used, taking turns between attachments.
+func selectReferenceExcerpts(attachments []*entity.CourseAttachment, topic string, limit int) []service.CourseReferenceInput {
+	type candidate struct {
+		attachment int
+		chunk      int
+		score      int
+	}
+
+	words := referenceWords(topic)
+	var candidates []candidate
+	for a, attachment := range attachments {
+		if !attachment.UsableInPrompts() {
+			continue
+		}
+		for c, chunk := range attachment.Chunks {
+			score := 0
+			for word := range referenceWords(chunk) {
+				if words[word] {
+					score++
+				}
+			}
+			candidates = append(candidates, candidate{attachment: a, chunk: c, score: score})
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].score != candidates[j].score {
+			return candidates[i].score > candidates[j].score
+		}
+		return candidates[i].chunk < candidates[j].chunk
+	})
+	if len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].attachment != candidates[j].attachment {
+			return candidates[i].attachment < candidates[j].attachment
+		}
+		return candidates[i].chunk < candidates[j].chunk
+	})
+
+	var inputs []service.CourseReferenceInput
+	for i, c := range candidates {
+		attachment := attachments[c.attachment]
+		if i == 0 || candidates[i-1].attachment != c.attachment {
+			inputs = append(inputs, service.CourseReferenceInput{FileName: attachment.FileName})
+		}
+		last := &inputs[len(inputs)-1]
+		last.Excerpts = append(last.Excerpts, attachment.Chunks[c.chunk])
+	}
+	return inputs
+}
+
+// referenceWords returns the distinct lowercase words of text longer than three letters.
+func referenceWords(text string) map[string]bool {
+	words := make(map[string]bool)
+	for _, word := range strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		if len([]rune(word)) > 3 {
+			words[word] = true
+		}
+	}
+	return words
+}
@@ -0,0 +1,361 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/infrastructure/cache"
+)
+
+const (
+	// CourseSearchMaxMatches is the most matches a search within a course returns.
+	CourseSearchMaxMatches = 50
+
+	// courseSearchMaxQuery is the longest phrase, in characters, that can be searched for.
+	courseSearchMaxQuery = 200
+
+	// courseSearchIndexTTL is how long a course's parsed content is cached for searching.
+	// The key includes the course's modification time, so edits are never served stale.
+	courseSearchIndexTTL = 2 * time.Minute
+
+	// courseSearchSnippetContext is the characters of text kept either side of a match.
+	courseSearchSnippetContext = 60
+)
+
+// CourseSearchSource is the part of a course a search match was found in.
+type CourseSearchSource string
+
+const (
+	CourseSearchSourceAuthoring       CourseSearchSource = "authoring"
+	CourseSearchSourceLessonComponent CourseSearchSource = "lesson_component"
+)
+
+// LessonComponentSearcher finds a course's generated lesson components containing a phrase.
+type LessonComponentSearcher interface {
+	SearchByCourse(ctx context.Context, courseID uuid.UUID, phrase string, limit int) ([]*entity.LessonComponentMatch, error)
+}
+
+// SetLessonComponentSearcher includes generated lesson components in searches within a
+// course. Without it, only the authored content is searched.
+func (s *CourseService) SetLessonComponentSearcher(searcher LessonComponentSearcher) {
+	s.componentSearch = searcher
+}
+
+// CourseSearchMatch is a block or lesson component that contains the searched phrase.
+type CourseSearchMatch struct {
+	Source       CourseSearchSource
+	SectionID    string
+	SectionTitle string
+	LessonID     string
+	LessonTitle  string
+	BlockID      string // Set for authoring matches
+	ComponentID  string // Set for lesson component matches
+
+	// Snippet is the text around the match. The match is the HighlightLength characters
+	// starting HighlightStart characters in; the length is 0 when the phrase's words
+	// matched a component but not side by side.
+	Snippet         string
+	HighlightStart  int
+	HighlightLength int
+}
+
+// CourseSearchResult is the outcome of a search within a course.
+type CourseSearchResult struct {
+	Matches   []CourseSearchMatch
+	Truncated bool // More than CourseSearchMaxMatches matched
+}
+
+// courseSearchEntry is one block of a course's authored content as plain text. A course's
+// entries, in course order, are what's cached between searches.
+type courseSearchEntry struct {
+	SectionID    string `json:"sectionId,omitempty"`
+	SectionTitle string `json:"sectionTitle,omitempty"`
+	LessonID     string `json:"lessonId,omitempty"`
+	LessonTitle  string `json:"lessonTitle,omitempty"`
+	BlockID      string `json:"blockId"`
+	Text         string `json:"text"`
+}
+
+// SearchWithinCourse finds the blocks of a course's authored content, and the components
+// of its generated lessons, that contain query, ignoring case. Authored matches come first,
+// then lesson components, each in course order. At most CourseSearchMaxMatches are
+// returned. Only users who may edit the course can search it.
+func (s *CourseService) SearchWithinCourse(ctx context.Context, kratosID uuid.UUID, courseID uuid.UUID, query string) (*CourseSearchResult, error) {
+	query = strings.Join(strings.Fields(query), " ")
+	if query == "" {
+		return nil, domainerrors.ErrInvalidInput.WithMessage("search query is required")
+	}
+	if utf8.RuneCountInString(query) > courseSearchMaxQuery {
+		return nil, domainerrors.ErrInvalidInput.WithMessage("search query is too long")
+	}
+
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, domainerrors.ErrUserNotFound
+	}
+	course, err := s.courseMetadata(ctx, courseID)
+	if err != nil {
+		s.logger.Error("failed to get course", "courseID", courseID, "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	if course == nil {
+		return nil, domainerrors.ErrNotFound.WithMessage("course not found")
+	}
+	if err := s.checkCourseEdit(ctx, user, course); err != nil {
+		return nil, err
+	}
+
+	entries, err := s.courseSearchEntries(ctx, course)
+	if err != nil {
+		return nil, err
+	}
+
+	// One more than the limit is collected to tell whether the results were truncated
+	limit := CourseSearchMaxMatches + 1
+	needle := []rune(query)
+	for i, r := range needle {
+		needle[i] = unicode.ToLower(r)
+	}
+	var matches []CourseSearchMatch
+	for _, entry := range entries {
+		if len(matches) == limit {
+			break
+		}
+		snippet, start, ok := courseSearchSnippet(entry.Text, needle)
+		if !ok {
+			continue
+		}
+		matches = append(matches, CourseSearchMatch{
+			Source:          CourseSearchSourceAuthoring,
+			SectionID:       entry.SectionID,
+			SectionTitle:    entry.SectionTitle,
+			LessonID:        entry.LessonID,
+			LessonTitle:     entry.LessonTitle,
+			BlockID:         entry.BlockID,
+			Snippet:         snippet,
+			HighlightStart:  start,
+			HighlightLength: len(needle),
+		})
+	}
+
+	if s.componentSearch != nil && len(matches) < limit {
+		components, err := s.componentSearch.SearchByCourse(ctx, course.ID, query, limit-len(matches))
+		if err != nil {
+			s.logger.Error("failed to search lesson components", "courseID", courseID, "error", err)
+			return nil, domainerrors.ErrInternal.WithCause(err)
+		}
+		for _, found := range components {
+			match := CourseSearchMatch{
+				Source:       CourseSearchSourceLessonComponent,
+				SectionID:    found.SectionID.String(),
+				SectionTitle: found.SectionTitle,
+				LessonID:     found.Component.LessonID.String(),
+				LessonTitle:  found.LessonTitle,
+				ComponentID:  found.Component.ID.String(),
+			}
+			text := componentSearchText(found.Component.ContentJSON)
+			if snippet, start, ok := courseSearchSnippet(text, needle); ok {
+				match.Snippet, match.HighlightStart, match.HighlightLength = snippet, start, len(needle)
+			} else {
+				// The index matched the words with punctuation or markup between them
+				match.Snippet, _, _ = courseSearchSnippet(text, nil)
+			}
+			matches = append(matches, match)
+		}
+	}
+
+	result := &CourseSearchResult{Matches: matches}
+	if len(matches) > CourseSearchMaxMatches {
+		result.Matches = matches[:CourseSearchMaxMatches]
+		result.Truncated = true
+	}
+	return result, nil
+}
+
+// courseSearchEntries returns the course's authored blocks as plain text, parsing the
+// stored content once and caching the entries briefly.
+func (s *CourseService) courseSearchEntries(ctx context.Context, course *entity.Course) ([]courseSearchEntry, error) {
+	key := cache.TenantCacheKeys.CourseSearch(course.ID.String(), course.UpdatedAt.UnixNano())
+
+	var entries []courseSearchEntry
+	if entry, err := s.cache.Get(ctx, key, &entries); err == nil && entry != nil {
+		return entries, nil
+	}
+
+	var content S3CourseContent
+	if err := s.storage.ReadCourseContent(ctx, course.TenantID, course.ID, &content); err != nil {
+		s.logger.Error("failed to read course content for search", "courseID", course.ID, "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	entries = []courseSearchEntry{}
+	for _, section := range content.Content.Sections {
+		sectionID, _ := section["id"].(string)
+		sectionTitle, _ := section["name"].(string)
+		for _, lesson := range contentMaps(section["lessons"]) {
+			lessonID, _ := lesson["id"].(string)
+			lessonTitle, _ := lesson["title"].(string)
+			for _, block := range contentMaps(lesson["blocks"]) {
+				entries = appendSearchEntry(entries, courseSearchEntry{
+					SectionID:    sectionID,
+					SectionTitle: sectionTitle,
+					LessonID:     lessonID,
+					LessonTitle:  lessonTitle,
+				}, block)
+			}
+		}
+	}
+	for _, block := range content.Content.CourseBlocks {
+		entries = appendSearchEntry(entries, courseSearchEntry{}, block)
+	}
+
+	if _, err := s.cache.Set(ctx, key, entries, "", courseSearchIndexTTL); err != nil {
+		s.logger.Warn("failed to cache course search entries", "courseID", course.ID, "error", err)
+	}
+	return entries, nil
+}
+
+// appendSearchEntry adds the block's text to entries under the given section and lesson.
+// Blocks without an ID or text are skipped.
+func appendSearchEntry(entries []courseSearchEntry, entry courseSearchEntry, block map[string]any) []courseSearchEntry {
+	entry.BlockID, _ = block["id"].(string)
+	if entry.BlockID == "" {
+		return entries
+	}
+	var parts []string
+	for key, value := range block {
+		switch key {
+		case "id", "type", "order", "lessonId":
+			continue
+		}
+		parts = appendSearchText(parts, value)
+	}
+	entry.Text = strings.Join(parts, " ")
+	if entry.Text == "" {
+		return entries
+	}
+	return append(entries, entry)
+}
+
+// componentSearchText returns the text of a lesson component's content: its string
+// values with markup removed.
+func componentSearchText(raw json.RawMessage) string {
+	var content any
+	if err := json.Unmarshal(raw, &content); err != nil {
+		return ""
+	}
+	return strings.Join(appendSearchText(nil, content), " ")
+}
+
+// appendSearchText appends every string in a decoded JSON value to parts, with markup
+// removed and whitespace collapsed. Object keys are visited in sorted order, so the text
+// is the same every time.
+func appendSearchText(parts []string, v any) []string {
+	switch v := v.(type) {
+	case string:
+		if text := strings.Join(strings.Fields(stripMarkup(v)), " "); text != "" {
+			parts = append(parts, text)
+		}
+	case map[string]any:
+		for _, key := range slices.Sorted(maps.Keys(v)) {
+			parts = appendSearchText(parts, v[key])
+		}
+	case []any:
+		for _, item := range v {
+			parts = appendSearchText(parts, item)
+		}
+	case []map[string]any:
+		for _, item := range v {
+			parts = appendSearchText(parts, item)
+		}
+	}
+	return parts
+}
+
+// stripMarkup removes HTML tags, leaving a space where each was so words on either
+// side stay apart.
+func stripMarkup(text string) string {
+	if !strings.ContainsRune(text, '<') {
+		return text
+	}
+	var b strings.Builder
+	inTag := false
+	for _, r := range text {
+		switch {
+		case r == '<':
+			inTag = true
+		case r == '>' && inTag:
+			inTag = false
+			b.WriteRune(' ')
+		case !inTag:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// courseSearchSnippet finds needle, which must be lower case, in text ignoring case and
+// returns the text around it and where the match starts within that snippet, in
+// characters. A nil needle returns the start of the text. Cut ends are marked with "…".
+func courseSearchSnippet(text string, needle []rune) (string, int, bool) {
+	runes := []rune(text)
+	at := 0
+	if needle != nil {
+		at = indexFold(runes, needle)
+		if at < 0 {
+			return "", 0, false
+		}
+	}
+
+	start := max(0, at-courseSearchSnippetContext)
+	end := min(len(runes), at+len(needle)+courseSearchSnippetContext)
+	// Don't start or end in the middle of a word
+	for start > 0 && start < at && !unicode.IsSpace(runes[start-1]) {
+		start++
+	}
+	for end < len(runes) && end > at+len(needle) && !unicode.IsSpace(runes[end]) {
+		end--
+	}
+
+	snippet := string(runes[start:end])
+	highlight := at - start
+	if start > 0 {
+		snippet = "…" + snippet
+		highlight++
+	}
+	if end < len(runes) {
+		snippet += "…"
+	}
+	return snippet, highlight, true
+}
+
+// indexFold returns the index, in characters, of the first occurrence of needle in text
+// ignoring case, or -1. needle must be lower case.
+func indexFold(text, needle []rune) int {
+	if len(needle) == 0 {
+		return 0
+	}
+	for i := 0; i+len(needle) <= len(text); i++ {
+		match := true
+		for j, r := range needle {
+			if unicode.ToLower(text[i+j]) != r {
+				match = false
+				break
+			}
+		}
+		if match {
+			return i
+		}
+	}
+	return -1
+}
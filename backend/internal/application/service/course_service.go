@@ -1,47 +1,120 @@
 package service
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/sogos/mirai-backend/internal/domain/audit"
 	"github.com/sogos/mirai-backend/internal/domain/entity"
 	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
 	"github.com/sogos/mirai-backend/internal/domain/repository"
 	"github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
 	"github.com/sogos/mirai-backend/internal/infrastructure/cache"
 	"github.com/sogos/mirai-backend/internal/infrastructure/storage"
 )
 
+// CollaboratorNotifier sends notifications when users are assigned to courses.
+type CollaboratorNotifier interface {
+	// NotifyCollaboratorAdded sends notification when a user is added to a course.
+	NotifyCollaboratorAdded(ctx context.Context, userID uuid.UUID, courseID uuid.UUID, courseTitle string, role valueobject.CourseRole) error
+}
+
 // CourseService handles course and library operations.
 // Uses a hybrid model: metadata in PostgreSQL, content in S3.
 type CourseService struct {
-	courseRepo repository.CourseRepository
-	folderRepo repository.FolderRepository
-	userRepo   repository.UserRepository
-	storage    *storage.TenantAwareStorage
-	cache      cache.Cache
-	logger     service.Logger
+	courseRepo       repository.CourseRepository
+	collaboratorRepo repository.CourseCollaboratorRepository
+	folderRepo       repository.FolderRepository
+	userRepo         repository.UserRepository
+	teamRepo         repository.TeamRepository
+	audienceRepo     repository.TargetAudienceRepository
+	storage          *storage.TenantAwareStorage
+	cache            cache.Cache
+	notifier         CollaboratorNotifier
+	maxDataURIBytes  int // Largest inline data: URI accepted in course content; 0 disables the check
+	contentSoftLimit int // Stored content size above which saves warn; 0 disables
+	contentHardLimit int // Stored content size above which saves are rejected; 0 disables
+	auditLog         AuditLogger
+	courseDefaults   CourseDefaultsProvider
+	publishListener  CoursePublishListener
+	superAdmins      SuperAdminChecker
+	cacheWarmer      TenantCacheWarmEnqueuer
+	jobRepo          repository.GenerationJobRepository
+	playerSnapshots  CoursePlayerSnapshotter
+	componentSearch  LessonComponentSearcher
+	storageKeys      TenantKeyRotator
+	tenantRepo       repository.TenantRepository
+	encryptionQueue  StorageEncryptionEnqueuer
+	storageRegions   *TenantStorageRegions
+	regionQueue      StorageRegionMigrationEnqueuer
+	attachmentRepo   repository.CourseAttachmentRepository
+	attachmentQueue  CourseAttachmentEnqueuer
+	attachmentScreen service.PromptInjectionDetector
+	identity         service.IdentityProvider
+	creatorNames     *creatorNameCache
+	exportArtifacts  repository.ExportArtifactRepository
+	presence         CoursePresenceStore
+	logger           service.Logger
 }
 
 // NewCourseService creates a new course service.
 func NewCourseService(
 	courseRepo repository.CourseRepository,
+	collaboratorRepo repository.CourseCollaboratorRepository,
 	folderRepo repository.FolderRepository,
 	userRepo repository.UserRepository,
+	teamRepo repository.TeamRepository,
+	audienceRepo repository.TargetAudienceRepository,
 	storage *storage.TenantAwareStorage,
 	cache cache.Cache,
+	notifier CollaboratorNotifier,
+	maxDataURIBytes int,
 	logger service.Logger,
 ) *CourseService {
 	return &CourseService{
-		courseRepo: courseRepo,
-		folderRepo: folderRepo,
-		userRepo:   userRepo,
-		storage:    storage,
-		cache:      cache,
-		logger:     logger,
+		courseRepo:       courseRepo,
+		collaboratorRepo: collaboratorRepo,
+		folderRepo:       folderRepo,
+		userRepo:         userRepo,
+		teamRepo:         teamRepo,
+		audienceRepo:     audienceRepo,
+		storage:          storage,
+		cache:            cache,
+		notifier:         notifier,
+		maxDataURIBytes:  maxDataURIBytes,
+		logger:           logger,
+	}
+}
+
+// SetAuditLogger enables audit logging of course and folder deletion.
+func (s *CourseService) SetAuditLogger(logger AuditLogger) {
+	s.auditLog = logger
+}
+
+// SetGenerationJobRepository enables reporting a course's running generation on GetCourse.
+func (s *CourseService) SetGenerationJobRepository(jobRepo repository.GenerationJobRepository) {
+	s.jobRepo = jobRepo
+}
+
+// courseAuditEntry describes a change made by user, or by the system when user is nil.
+func courseAuditEntry(user *entity.User, tenantID uuid.UUID, action audit.Action, targetType audit.TargetType, targetID uuid.UUID, changes audit.Changes) audit.Entry {
+	entry := audit.Entry{
+		TenantID:   tenantID,
+		Action:     action,
+		TargetType: targetType,
+		TargetID:   targetID.String(),
+		Changes:    changes,
 	}
+	if user != nil {
+		entry.ActorUserID = &user.ID
+	}
+	return entry
 }
 
 // CourseStatus represents the publication state.
@@ -56,16 +129,23 @@ const (
 // StoredCourse represents the full course data returned to clients.
 // Combines metadata from PostgreSQL and content from S3.
 type StoredCourse struct {
-	ID                 string                 `json:"id"`
-	Version            int                    `json:"version"`
-	Status             CourseStatus           `json:"status"`
-	Metadata           CourseMetadata         `json:"metadata"`
-	Settings           CourseSettings         `json:"settings"`
-	Personas           []map[string]any       `json:"personas"`
-	LearningObjectives []map[string]any       `json:"learningObjectives"`
-	AssessmentSettings map[string]any         `json:"assessmentSettings"`
-	Content            CourseContent          `json:"content"`
-	Exports            []map[string]any       `json:"exports,omitempty"`
+	ID                 string                       `json:"id"`
+	Version            int                          `json:"version"`
+	Status             CourseStatus                 `json:"status"`
+	Metadata           CourseMetadata               `json:"metadata"`
+	Settings           CourseSettings               `json:"settings"`
+	Personas           []map[string]any             `json:"personas"`
+	LearningObjectives []map[string]any             `json:"learningObjectives"`
+	AssessmentSettings map[string]any               `json:"assessmentSettings"`
+	Content            CourseContent                `json:"content"`
+	Exports            []map[string]any             `json:"exports,omitempty"`
+	DefaultedFields    []string                     `json:"defaultedFields,omitempty"`       // Settings CreateCourse filled from the organization's course defaults
+	ActiveJobID        string                       `json:"activeGenerationJobId,omitempty"` // The course's queued or processing full course run, set by GetCourse
+	GenerationLock     *entity.CourseGenerationLock `json:"generationLock,omitempty"`        // Set by GetCourse while a full course run holds the course read-only
+	ContentSizeBytes   int64                        `json:"contentSizeBytes,omitempty"`      // Size of the stored content, set by UpdateCourse
+	SizeWarning        string                       `json:"sizeWarning,omitempty"`           // Set by UpdateCourse when the content is over the soft size limit
+	PublishedVersion   int                          `json:"publishedVersion,omitempty"`      // Draft version learners are served; 0 if none is stored
+	HasUnpublished     bool                         `json:"hasUnpublishedChanges,omitempty"` // Edited since the published version
 }
 
 // CourseMetadata contains metadata about the course.
@@ -101,19 +181,26 @@ type S3CourseContent struct {
 	AssessmentSettings map[string]any   `json:"assessmentSettings"`
 	Content            CourseContent    `json:"content"`
 	Exports            []map[string]any `json:"exports,omitempty"`
+
+	// SourceIDMap maps the section, lesson and block IDs of the course this one was
+	// copied from to their IDs in this course; empty for courses that are not copies
+	SourceIDMap map[string]string `json:"sourceIdMap,omitempty"`
 }
 
 // LibraryEntry represents a course listing (metadata only).
 type LibraryEntry struct {
-	ID            string       `json:"id"`
-	Title         string       `json:"title"`
-	Status        CourseStatus `json:"status"`
-	Folder        string       `json:"folder"`
-	Tags          []string     `json:"tags"`
-	CreatedAt     time.Time    `json:"createdAt"`
-	ModifiedAt    time.Time    `json:"modifiedAt"`
-	CreatedBy     string       `json:"createdBy,omitempty"`
-	ThumbnailPath string       `json:"thumbnailPath,omitempty"`
+	ID              string                 `json:"id"`
+	Title           string                 `json:"title"`
+	Status          CourseStatus           `json:"status"`
+	Folder          string                 `json:"folder"`
+	Tags            []string               `json:"tags"`
+	CreatedAt       time.Time              `json:"createdAt"`
+	ModifiedAt      time.Time              `json:"modifiedAt"`
+	CreatedBy       string                 `json:"createdBy,omitempty"`
+	CreatedByActive bool                   `json:"createdByActive"` // False once the creator is deactivated
+	ThumbnailPath   string                 `json:"thumbnailPath,omitempty"`
+	CallerRole      valueobject.CourseRole `json:"callerRole,omitempty"`            // Requesting user's role on the course, if any
+	HasUnpublished  bool                   `json:"hasUnpublishedChanges,omitempty"` // Edited since the version learners see
 }
 
 // Library represents the library response.
@@ -126,11 +213,14 @@ type Library struct {
 
 // Folder represents a folder in the hierarchy.
 type Folder struct {
-	ID       string   `json:"id"`
-	Name     string   `json:"name"`
-	Parent   string   `json:"parent,omitempty"`
-	Type     string   `json:"type,omitempty"`
-	Children []string `json:"children,omitempty"`
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Parent      string   `json:"parent,omitempty"`
+	Type        string   `json:"type,omitempty"`
+	TeamID      string   `json:"teamId,omitempty"`
+	IsProtected bool     `json:"isProtected,omitempty"`
+	Children    []string `json:"children,omitempty"`
+	CourseCount *int     `json:"courseCount,omitempty"` // Courses directly in the folder; set when counts are requested
 }
 
 // ListCoursesFilter contains filter options for listing courses.
@@ -138,6 +228,7 @@ type ListCoursesFilter struct {
 	Status *CourseStatus
 	Folder *string
 	Tags   []string
+	Mine   bool // Only courses the user created or collaborates on
 	Limit  int
 	Offset int
 }
@@ -190,6 +281,10 @@ func (s *CourseService) ListCourses(ctx context.Context, kratosID uuid.UUID, fil
 		opts.Tags = filter.Tags
 	}
 
+	if filter.Mine {
+		opts.CollaboratorUserID = &user.ID
+	}
+
 	// Get total count for pagination
 	totalCount, err := s.courseRepo.Count(ctx, opts)
 	if err != nil {
@@ -203,6 +298,12 @@ func (s *CourseService) ListCourses(ctx context.Context, kratosID uuid.UUID, fil
 		return ListCoursesResult{}, domainerrors.ErrInternal.WithCause(err)
 	}
 
+	roles, err := s.courseRolesForUser(ctx, user.ID)
+	if err != nil {
+		s.logger.Error("failed to list course assignments", "error", err)
+		return ListCoursesResult{}, domainerrors.ErrInternal.WithCause(err)
+	}
+
 	entries := make([]LibraryEntry, 0, len(courses))
 	for _, c := range courses {
 		var folderStr string
@@ -215,15 +316,18 @@ func (s *CourseService) ListCourses(ctx context.Context, kratosID uuid.UUID, fil
 		}
 
 		entries = append(entries, LibraryEntry{
-			ID:            c.ID.String(),
-			Title:         c.Title,
-			Status:        CourseStatus(c.Status.String()),
-			Folder:        folderStr,
-			Tags:          c.CategoryTags,
-			CreatedAt:     c.CreatedAt,
-			ModifiedAt:    c.UpdatedAt,
-			CreatedBy:     c.CreatedByUserID.String(),
-			ThumbnailPath: thumbPath,
+			ID:              c.ID.String(),
+			Title:           c.Title,
+			Status:          CourseStatus(c.Status.String()),
+			Folder:          folderStr,
+			Tags:            c.CategoryTags,
+			CreatedAt:       c.CreatedAt,
+			ModifiedAt:      c.UpdatedAt,
+			CreatedBy:       c.CreatedByUserID.String(),
+			CreatedByActive: c.CreatorActive,
+			HasUnpublished:  c.HasUnpublishedChanges(),
+			ThumbnailPath:   thumbPath,
+			CallerRole:      callerRole(user.ID, c, roles),
 		})
 	}
 
@@ -247,7 +351,7 @@ func (s *CourseService) GetCourse(ctx context.Context, kratosID uuid.UUID, id st
 	}
 
 	// Get metadata from PostgreSQL
-	course, err := s.courseRepo.GetByID(ctx, courseID)
+	course, err := s.courseMetadata(ctx, courseID)
 	if err != nil {
 		s.logger.Error("failed to get course", "courseID", id, "error", err)
 		return nil, domainerrors.ErrInternal.WithCause(err)
@@ -286,6 +390,22 @@ func (s *CourseService) GetCourse(ctx context.Context, kratosID uuid.UUID, id st
 		folderStr = course.FolderID.String()
 	}
 
+	// The cached metadata may predate the lock, so it is read fresh
+	generationLock, err := s.courseRepo.GetGenerationLock(ctx, course.ID)
+	if err != nil {
+		s.logger.Warn("failed to look up course generation lock", "courseID", id, "error", err)
+	}
+
+	var activeGenerationJobID string
+	if s.jobRepo != nil {
+		activeRun, err := s.jobRepo.GetActiveFullCourseRun(ctx, course.ID)
+		if err != nil {
+			s.logger.Warn("failed to look up active course generation", "courseID", id, "error", err)
+		} else if activeRun != nil {
+			activeGenerationJobID = activeRun.ID.String()
+		}
+	}
+
 	return &StoredCourse{
 		ID:      course.ID.String(),
 		Version: int(course.Version),
@@ -310,6 +430,10 @@ func (s *CourseService) GetCourse(ctx context.Context, kratosID uuid.UUID, id st
 		AssessmentSettings: s3Content.AssessmentSettings,
 		Content:            s3Content.Content,
 		Exports:            s3Content.Exports,
+		ActiveJobID:        activeGenerationJobID,
+		GenerationLock:     generationLock,
+		PublishedVersion:   int(course.PublishedVersion),
+		HasUnpublished:     course.HasUnpublishedChanges(),
 	}, nil
 }
 
@@ -329,6 +453,12 @@ func (s *CourseService) CreateCourse(ctx context.Context, kratosID uuid.UUID, in
 		return nil, domainerrors.ErrUserHasNoCompany
 	}
 
+	if err := s.validateCourseContent(input.Content); err != nil {
+		return nil, err
+	}
+
+	defaulted := s.applyCourseDefaults(ctx, *user.TenantID, input)
+
 	now := time.Now()
 	courseID := uuid.New()
 
@@ -401,6 +531,13 @@ func (s *CourseService) CreateCourse(ctx context.Context, kratosID uuid.UUID, in
 		s3Content.Settings.DataSource = "open-web"
 	}
 
+	// Check the size before anything is stored
+	size, err := s.checkContentSize(&s3Content, nil)
+	if err != nil {
+		return nil, err
+	}
+	course.ContentSizeBytes = &size.Bytes
+
 	// Write content to S3 first
 	if err := s.storage.WriteCourseContent(ctx, *user.TenantID, courseID, &s3Content); err != nil {
 		log.Error("failed to write course content to storage", "error", err)
@@ -420,7 +557,8 @@ func (s *CourseService) CreateCourse(ctx context.Context, kratosID uuid.UUID, in
 	}
 
 	// Invalidate cache
-	_ = s.cache.InvalidatePattern(ctx, "courses:*")
+	_ = s.cache.InvalidateNamespace(ctx, cache.NamespaceCourses)
+	s.InvalidateLibraryCache(ctx)
 
 	log.Info("course created", "courseID", course.ID)
 
@@ -442,6 +580,7 @@ func (s *CourseService) CreateCourse(ctx context.Context, kratosID uuid.UUID, in
 		AssessmentSettings: s3Content.AssessmentSettings,
 		Content:            s3Content.Content,
 		Exports:            s3Content.Exports,
+		DefaultedFields:    defaulted,
 	}, nil
 }
 
@@ -459,6 +598,10 @@ func (s *CourseService) UpdateCourse(ctx context.Context, kratosID uuid.UUID, id
 		return nil, domainerrors.ErrInvalidInput.WithMessage("invalid course ID")
 	}
 
+	if err := s.validateCourseContent(updates.Content); err != nil {
+		return nil, err
+	}
+
 	// Get existing course
 	course, err := s.courseRepo.GetByID(ctx, courseID)
 	if err != nil {
@@ -469,6 +612,13 @@ func (s *CourseService) UpdateCourse(ctx context.Context, kratosID uuid.UUID, id
 		return nil, domainerrors.ErrNotFound.WithMessage("course not found")
 	}
 
+	if err := s.checkCourseEdit(ctx, user, course); err != nil {
+		return nil, err
+	}
+	if err := checkGenerationLock(course.GenerationLock); err != nil {
+		return nil, err
+	}
+
 	// Check if content exists in MinIO/S3 before attempting to read
 	exists, err := s.storage.CourseContentExists(ctx, course.TenantID, course.ID)
 	if err != nil {
@@ -489,6 +639,28 @@ func (s *CourseService) UpdateCourse(ctx context.Context, kratosID uuid.UUID, id
 		return nil, domainerrors.ErrInternal.WithCause(err)
 	}
 
+	// Learners of a course published before versions were stored see its draft; freeze
+	// it as the published version before this edit changes it
+	if course.Status == entity.CourseStatusPublished && !course.HasPublishedVersion() && s.playerSnapshots != nil {
+		if err := s.publishVersion(ctx, nil, course); err != nil {
+			log.Warn("failed to store published version before editing", "error", err)
+		}
+	}
+
+	// The draft only counts as edited if the content actually changes
+	original, err := json.Marshal(&s3Content)
+	if err != nil {
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	// Courses not written since sizes were recorded are measured as loaded
+	previousSize := course.ContentSizeBytes
+	if previousSize == nil {
+		if size, err := s.storage.CourseContentSize(&s3Content); err == nil {
+			previousSize = &size
+		}
+	}
+
 	// Apply updates to metadata
 	if updates.Settings.Title != "" {
 		course.Title = updates.Settings.Title
@@ -523,10 +695,25 @@ func (s *CourseService) UpdateCourse(ctx context.Context, kratosID uuid.UUID, id
 	if updates.Content.Sections != nil || updates.Content.CourseBlocks != nil {
 		s3Content.Content = updates.Content
 	}
+	wasPublished := course.Status == entity.CourseStatusPublished
 	if updates.Status != "" {
 		course.Status = entity.ParseCourseStatus(string(updates.Status))
 	}
 
+	// Check the size before anything is stored, so nothing is written that would then
+	// be refused on load
+	size, err := s.checkContentSize(&s3Content, previousSize)
+	if err != nil {
+		log.Warn("course content over size limit", "error", err)
+		return nil, err
+	}
+
+	updated, err := json.Marshal(&s3Content)
+	if err != nil {
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	draftChanged := !bytes.Equal(original, updated)
+
 	course.Version++
 
 	// Update S3 content
@@ -534,19 +721,40 @@ func (s *CourseService) UpdateCourse(ctx context.Context, kratosID uuid.UUID, id
 		log.Error("failed to update course content in S3", "error", err)
 		return nil, domainerrors.ErrInternal.WithCause(err)
 	}
+	s.recordContentSize(ctx, course.ID, size.Bytes)
 
 	// Update PostgreSQL metadata
 	if err := s.courseRepo.Update(ctx, course); err != nil {
 		log.Error("failed to update course in database", "error", err)
 		return nil, domainerrors.ErrInternal.WithCause(err)
 	}
+	if draftChanged {
+		if version, err := s.courseRepo.BumpDraftVersion(ctx, course.ID); err != nil {
+			log.Warn("failed to record course draft change", "error", err)
+		} else {
+			course.DraftVersion = version
+		}
+	}
 
 	// Invalidate cache (TenantCache automatically prefixes keys with tenant:{id}:)
 	_ = s.cache.Delete(ctx, cache.TenantCacheKeys.Course(id))
-	_ = s.cache.InvalidatePattern(ctx, "courses:*")
+	_ = s.cache.InvalidateNamespace(ctx, cache.NamespaceCourses)
+	s.InvalidateLibraryCache(ctx)
 
 	log.Info("course updated")
 
+	if !wasPublished && course.Status == entity.CourseStatusPublished {
+		// Learners get the course as it is now; later edits wait for PublishChanges
+		if s.playerSnapshots != nil {
+			if err := s.publishVersion(ctx, user, course); err != nil {
+				log.Error("failed to publish course version", "error", err)
+			}
+		}
+		if s.publishListener != nil {
+			s.publishListener.CoursePublished(ctx, course)
+		}
+	}
+
 	var folderStr string
 	if course.FolderID != nil {
 		folderStr = course.FolderID.String()
@@ -576,6 +784,10 @@ func (s *CourseService) UpdateCourse(ctx context.Context, kratosID uuid.UUID, id
 		AssessmentSettings: s3Content.AssessmentSettings,
 		Content:            s3Content.Content,
 		Exports:            s3Content.Exports,
+		ContentSizeBytes:   size.Bytes,
+		SizeWarning:        size.Warning,
+		PublishedVersion:   int(course.PublishedVersion),
+		HasUnpublished:     course.HasUnpublishedChanges(),
 	}, nil
 }
 
@@ -604,7 +816,11 @@ func (s *CourseService) DeleteCourse(ctx context.Context, kratosID uuid.UUID, id
 	}
 
 	// Delete from PostgreSQL
-	if err := s.courseRepo.Delete(ctx, courseID); err != nil {
+	entry := courseAuditEntry(user, course.TenantID, audit.ActionCourseDeleted, audit.TargetCourse, course.ID,
+		audit.Changes{}.Field("title", course.Title, ""))
+	if err := audited(ctx, s.auditLog, &entry, func(ctx context.Context) error {
+		return s.courseRepo.Delete(ctx, courseID)
+	}); err != nil {
 		log.Error("failed to delete course from database", "error", err)
 		return domainerrors.ErrInternal.WithCause(err)
 	}
@@ -614,11 +830,20 @@ func (s *CourseService) DeleteCourse(ctx context.Context, kratosID uuid.UUID, id
 		log.Error("failed to delete course content from S3", "error", err)
 		// Don't fail the operation - the DB record is already deleted
 	}
+	if course.HasPublishedVersion() {
+		if err := s.storage.DeletePublishedCourse(ctx, course.TenantID, course.ID, course.PublishedVersion); err != nil {
+			log.Error("failed to delete published course version", "error", err)
+		}
+	}
+	if err := s.storage.DeleteCourseAttachments(ctx, course.TenantID, course.ID); err != nil {
+		log.Error("failed to delete course attachment files", "error", err)
+	}
 
 	// Invalidate cache (TenantCache automatically prefixes keys with tenant:{id}:)
 	_ = s.cache.Delete(ctx, cache.TenantCacheKeys.Course(id))
-	_ = s.cache.InvalidatePattern(ctx, "courses:*")
-	_ = s.cache.InvalidatePattern(ctx, "folder:*")
+	_ = s.cache.InvalidateNamespace(ctx, cache.NamespaceCourses)
+	_ = s.cache.InvalidateNamespace(ctx, cache.NamespaceFolderCourses)
+	s.InvalidateLibraryCache(ctx)
 
 	log.Info("course deleted")
 	return nil
@@ -641,39 +866,32 @@ func (s *CourseService) GetFolderHierarchy(ctx context.Context, kratosID uuid.UU
 		// Continue even if folder creation fails
 	}
 
-	// Pass user ID to filter PERSONAL folders - users only see their own private folder
-	folders, err := s.folderRepo.GetHierarchy(ctx, user.ID)
+	// Users only see their own private folder
+	folders, err := s.userFolders(ctx, user, includeCounts)
 	if err != nil {
 		s.logger.Error("failed to get folder hierarchy", "error", err)
 		return nil, domainerrors.ErrInternal.WithCause(err)
 	}
 
-	// Build parent-child map
-	childrenMap := make(map[string][]string)
-	for _, f := range folders {
-		if f.ParentID != nil {
-			parentStr := f.ParentID.String()
-			childrenMap[parentStr] = append(childrenMap[parentStr], f.ID.String())
-		}
-	}
-
-	result := make([]Folder, 0, len(folders))
-	for _, f := range folders {
-		var parentStr string
-		if f.ParentID != nil {
-			parentStr = f.ParentID.String()
-		}
+	return folders, nil
+}
 
-		result = append(result, Folder{
-			ID:       f.ID.String(),
-			Name:     f.Name,
-			Parent:   parentStr,
-			Type:     f.Type.String(),
-			Children: childrenMap[f.ID.String()],
-		})
+// folderFromEntity converts a folder for the hierarchy and library views.
+func folderFromEntity(f *entity.Folder, children []string) Folder {
+	folder := Folder{
+		ID:          f.ID.String(),
+		Name:        f.Name,
+		Type:        f.Type.String(),
+		IsProtected: f.IsProtected(),
+		Children:    children,
 	}
-
-	return result, nil
+	if f.ParentID != nil {
+		folder.Parent = f.ParentID.String()
+	}
+	if f.TeamID != nil {
+		folder.TeamID = f.TeamID.String()
+	}
+	return folder
 }
 
 // ensureDefaultFolders creates default folders (Shared and user's Private) if they don't exist.
@@ -698,6 +916,7 @@ func (s *CourseService) ensureDefaultFolders(ctx context.Context, user *entity.U
 		if err := s.folderRepo.Create(ctx, sharedFolder); err != nil {
 			s.logger.Error("failed to create shared folder", "error", err)
 		} else {
+			s.InvalidateLibraryCache(ctx)
 			s.logger.Info("created shared folder", "folderID", sharedFolder.ID)
 		}
 	}
@@ -734,14 +953,20 @@ func (s *CourseService) GetLibrary(ctx context.Context, kratosID uuid.UUID, incl
 	}
 
 	// Get courses
-	courses, err := s.courseRepo.List(ctx, entity.CourseListOptions{Limit: 1000})
+	courses, err := s.libraryCourses(ctx)
 	if err != nil {
 		s.logger.Error("failed to list courses", "error", err)
 		return nil, domainerrors.ErrInternal.WithCause(err)
 	}
 
-	// Get folders - pass user ID to filter PERSONAL folders
-	folders, err := s.folderRepo.GetHierarchy(ctx, user.ID)
+	roles, err := s.courseRolesForUser(ctx, user.ID)
+	if err != nil {
+		s.logger.Error("failed to list course assignments", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	// Get folders - users only see their own private folder
+	folderList, err := s.userFolders(ctx, user, includeCounts)
 	if err != nil {
 		s.logger.Error("failed to get folder hierarchy", "error", err)
 		return nil, domainerrors.ErrInternal.WithCause(err)
@@ -760,41 +985,18 @@ func (s *CourseService) GetLibrary(ctx context.Context, kratosID uuid.UUID, incl
 		}
 
 		entries = append(entries, LibraryEntry{
-			ID:            c.ID.String(),
-			Title:         c.Title,
-			Status:        CourseStatus(c.Status.String()),
-			Folder:        folderStr,
-			Tags:          c.CategoryTags,
-			CreatedAt:     c.CreatedAt,
-			ModifiedAt:    c.UpdatedAt,
-			CreatedBy:     c.CreatedByUserID.String(),
-			ThumbnailPath: thumbPath,
-		})
-	}
-
-	// Build parent-child map for folders
-	childrenMap := make(map[string][]string)
-	for _, f := range folders {
-		if f.ParentID != nil {
-			parentStr := f.ParentID.String()
-			childrenMap[parentStr] = append(childrenMap[parentStr], f.ID.String())
-		}
-	}
-
-	// Convert folders
-	folderList := make([]Folder, 0, len(folders))
-	for _, f := range folders {
-		var parentStr string
-		if f.ParentID != nil {
-			parentStr = f.ParentID.String()
-		}
-
-		folderList = append(folderList, Folder{
-			ID:       f.ID.String(),
-			Name:     f.Name,
-			Parent:   parentStr,
-			Type:     f.Type.String(),
-			Children: childrenMap[f.ID.String()],
+			ID:              c.ID.String(),
+			Title:           c.Title,
+			Status:          CourseStatus(c.Status.String()),
+			Folder:          folderStr,
+			Tags:            c.CategoryTags,
+			CreatedAt:       c.CreatedAt,
+			ModifiedAt:      c.UpdatedAt,
+			CreatedBy:       c.CreatedByUserID.String(),
+			CreatedByActive: c.CreatorActive,
+			HasUnpublished:  c.HasUnpublishedChanges(),
+			ThumbnailPath:   thumbPath,
+			CallerRole:      callerRole(user.ID, c, roles),
 		})
 	}
 
@@ -806,8 +1008,9 @@ func (s *CourseService) GetLibrary(ctx context.Context, kratosID uuid.UUID, incl
 	}, nil
 }
 
-// CreateFolder creates a new folder.
-func (s *CourseService) CreateFolder(ctx context.Context, kratosID uuid.UUID, name string, parentID *string, folderType string) (*entity.Folder, error) {
+// CreateFolder creates a new folder. Only regular and team folders can be created;
+// library and personal folders are created automatically.
+func (s *CourseService) CreateFolder(ctx context.Context, kratosID uuid.UUID, name string, parentID *string, folderType entity.FolderType, teamID *string) (*entity.Folder, error) {
 	log := s.logger.With("kratosID", kratosID, "folderName", name)
 
 	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
@@ -822,7 +1025,6 @@ func (s *CourseService) CreateFolder(ctx context.Context, kratosID uuid.UUID, na
 	folder := &entity.Folder{
 		TenantID: *user.TenantID,
 		Name:     name,
-		Type:     entity.ParseFolderType(folderType),
 	}
 
 	if parentID != nil && *parentID != "" {
@@ -832,16 +1034,75 @@ func (s *CourseService) CreateFolder(ctx context.Context, kratosID uuid.UUID, na
 		}
 	}
 
+	if err := s.setFolderType(ctx, folder, folderType, teamID); err != nil {
+		return nil, err
+	}
+
 	if err := s.folderRepo.Create(ctx, folder); err != nil {
 		log.Error("failed to create folder", "error", err)
 		return nil, domainerrors.ErrInternal.WithCause(err)
 	}
+	s.InvalidateLibraryCache(ctx)
+
+	log.Info("folder created", "folderID", folder.ID, "type", folder.Type)
+	return folder, nil
+}
+
+// UpdateFolderRequest contains the folder changes. Nil fields are left unchanged.
+type UpdateFolderRequest struct {
+	Name   *string
+	Type   *entity.FolderType
+	TeamID *string
+}
+
+// UpdateFolder renames a folder or changes it between a regular and a team folder.
+// System folders cannot be changed.
+func (s *CourseService) UpdateFolder(ctx context.Context, kratosID uuid.UUID, id string, req UpdateFolderRequest) (*entity.Folder, error) {
+	log := s.logger.With("kratosID", kratosID, "folderID", id)
+
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, domainerrors.ErrUserNotFound
+	}
+
+	folder, err := s.getFolder(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if folder.IsProtected() {
+		return nil, domainerrors.ErrForbidden.WithMessage("system folders cannot be renamed or changed")
+	}
+
+	if req.Name != nil {
+		name := strings.TrimSpace(*req.Name)
+		if name == "" {
+			return nil, domainerrors.ErrInvalidInput.WithMessage("folder name is required")
+		}
+		folder.Name = name
+	}
+
+	if req.Type != nil || req.TeamID != nil {
+		folderType := folder.Type
+		if req.Type != nil {
+			folderType = *req.Type
+		}
+		if err := s.setFolderType(ctx, folder, folderType, req.TeamID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.folderRepo.Update(ctx, folder); err != nil {
+		log.Error("failed to update folder", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	s.InvalidateLibraryCache(ctx)
 
-	log.Info("folder created", "folderID", folder.ID)
+	log.Info("folder updated", "type", folder.Type)
 	return folder, nil
 }
 
-// DeleteFolder deletes a folder.
+// DeleteFolder deletes an empty folder. System folders cannot be deleted.
 func (s *CourseService) DeleteFolder(ctx context.Context, kratosID uuid.UUID, id string) error {
 	log := s.logger.With("kratosID", kratosID, "folderID", id)
 
@@ -850,9 +1111,14 @@ func (s *CourseService) DeleteFolder(ctx context.Context, kratosID uuid.UUID, id
 		return domainerrors.ErrUserNotFound
 	}
 
-	folderID, err := uuid.Parse(id)
+	folder, err := s.getFolder(ctx, id)
 	if err != nil {
-		return domainerrors.ErrInvalidInput.WithMessage("invalid folder ID")
+		return err
+	}
+	folderID := folder.ID
+
+	if folder.IsProtected() {
+		return domainerrors.ErrForbidden.WithMessage("system folders cannot be deleted")
 	}
 
 	// Check if folder has courses
@@ -875,11 +1141,619 @@ func (s *CourseService) DeleteFolder(ctx context.Context, kratosID uuid.UUID, id
 		return domainerrors.ErrBadRequest.WithMessage("folder contains subfolders, delete them first")
 	}
 
-	if err := s.folderRepo.Delete(ctx, folderID); err != nil {
+	entry := courseAuditEntry(user, folder.TenantID, audit.ActionFolderDeleted, audit.TargetFolder, folder.ID,
+		audit.Changes{}.Field("name", folder.Name, ""))
+	if err := audited(ctx, s.auditLog, &entry, func(ctx context.Context) error {
+		return s.folderRepo.Delete(ctx, folderID)
+	}); err != nil {
 		log.Error("failed to delete folder", "error", err)
 		return domainerrors.ErrInternal.WithCause(err)
 	}
+	s.InvalidateLibraryCache(ctx)
 
 	log.Info("folder deleted")
 	return nil
 }
+
+// getFolder loads a folder by its string ID.
+func (s *CourseService) getFolder(ctx context.Context, id string) (*entity.Folder, error) {
+	folderID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, domainerrors.ErrInvalidInput.WithMessage("invalid folder ID")
+	}
+
+	folder, err := s.folderRepo.GetByID(ctx, folderID)
+	if err != nil {
+		s.logger.Error("failed to get folder", "folderID", id, "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	if folder == nil {
+		return nil, domainerrors.ErrFolderNotFound
+	}
+	return folder, nil
+}
+
+// setFolderType applies a user-requested folder type. Team folders must be linked to a
+// team that has no other folder; regular folders carry no team. System types are rejected.
+func (s *CourseService) setFolderType(ctx context.Context, folder *entity.Folder, folderType entity.FolderType, teamID *string) error {
+	hasTeamID := teamID != nil && *teamID != ""
+
+	switch folderType {
+	case entity.FolderTypeFolder:
+		if hasTeamID {
+			return domainerrors.ErrInvalidInput.WithMessage("team ID is only valid for team folders")
+		}
+		folder.Type = entity.FolderTypeFolder
+		folder.TeamID = nil
+		return nil
+
+	case entity.FolderTypeTeam:
+		id := folder.TeamID
+		if hasTeamID {
+			parsed, err := uuid.Parse(*teamID)
+			if err != nil {
+				return domainerrors.ErrInvalidInput.WithMessage("invalid team ID")
+			}
+			id = &parsed
+		}
+		if id == nil {
+			return domainerrors.ErrInvalidInput.WithMessage("team folders require a team")
+		}
+
+		team, err := s.teamRepo.GetByID(ctx, *id)
+		if err != nil {
+			s.logger.Error("failed to get team", "teamID", *id, "error", err)
+			return domainerrors.ErrInternal.WithCause(err)
+		}
+		if team == nil {
+			return domainerrors.ErrTeamNotFound
+		}
+
+		existing, err := s.folderRepo.GetByTeamID(ctx, *id)
+		if err != nil {
+			s.logger.Error("failed to get team folder", "teamID", *id, "error", err)
+			return domainerrors.ErrInternal.WithCause(err)
+		}
+		if existing != nil && existing.ID != folder.ID {
+			return domainerrors.ErrBadRequest.WithMessage("team already has a folder")
+		}
+
+		folder.Type = entity.FolderTypeTeam
+		folder.TeamID = id
+		return nil
+
+	default:
+		return domainerrors.ErrInvalidInput.WithMessage("library and personal folders are created automatically")
+	}
+}
+
+// ListCollaborators returns the users explicitly assigned to a course.
+func (s *CourseService) ListCollaborators(ctx context.Context, kratosID uuid.UUID, courseID uuid.UUID) ([]*entity.CourseCollaborator, error) {
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, domainerrors.ErrUserNotFound
+	}
+
+	course, err := s.courseRepo.GetByID(ctx, courseID)
+	if err != nil {
+		s.logger.Error("failed to get course", "courseID", courseID, "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	if course == nil {
+		return nil, domainerrors.ErrNotFound.WithMessage("course not found")
+	}
+
+	collaborators, err := s.collaboratorRepo.ListByCourseID(ctx, courseID)
+	if err != nil {
+		s.logger.Error("failed to list course collaborators", "courseID", courseID, "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	return collaborators, nil
+}
+
+// AddCollaborator assigns a user to a course with the given role.
+// Only course owners and company admins can manage collaborators.
+func (s *CourseService) AddCollaborator(ctx context.Context, kratosID uuid.UUID, courseID, userID uuid.UUID, role valueobject.CourseRole) (*entity.CourseCollaborator, error) {
+	log := s.logger.With("kratosID", kratosID, "courseID", courseID, "userID", userID)
+
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, domainerrors.ErrUserNotFound
+	}
+
+	if !role.IsValid() {
+		return nil, domainerrors.ErrInvalidInput.WithMessage("invalid collaborator role")
+	}
+
+	course, err := s.courseRepo.GetByID(ctx, courseID)
+	if err != nil {
+		log.Error("failed to get course", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	if course == nil {
+		return nil, domainerrors.ErrNotFound.WithMessage("course not found")
+	}
+
+	if err := s.checkManageCollaborators(ctx, user, course); err != nil {
+		return nil, err
+	}
+
+	if userID == course.CreatedByUserID {
+		return nil, domainerrors.ErrBadRequest.WithMessage("course creator is already the owner")
+	}
+
+	// Verify target user exists and is in the course's company
+	targetUser, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil || targetUser == nil {
+		return nil, domainerrors.ErrUserNotFound
+	}
+	if targetUser.CompanyID == nil || *targetUser.CompanyID != course.CompanyID {
+		return nil, domainerrors.ErrUserNotInCompany
+	}
+
+	existing, err := s.collaboratorRepo.Get(ctx, courseID, userID)
+	if err != nil {
+		log.Error("failed to check existing collaborator", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	if existing != nil {
+		return nil, domainerrors.ErrUserAlreadyCollaborator
+	}
+
+	collaborator := &entity.CourseCollaborator{
+		TenantID:      course.TenantID, // Use course's tenant for RLS
+		CourseID:      courseID,
+		UserID:        userID,
+		Role:          role,
+		AddedByUserID: &user.ID,
+	}
+
+	if err := s.collaboratorRepo.Add(ctx, collaborator); err != nil {
+		log.Error("failed to add course collaborator", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	_ = s.cache.InvalidateNamespace(ctx, cache.NamespaceCourses)
+
+	log.Info("course collaborator added", "role", role.String())
+
+	// Notify the new collaborator (don't fail the operation if this fails)
+	if s.notifier != nil && userID != user.ID {
+		if err := s.notifier.NotifyCollaboratorAdded(ctx, userID, courseID, course.Title, role); err != nil {
+			log.Warn("failed to send collaborator notification", "error", err)
+		}
+	}
+
+	return collaborator, nil
+}
+
+// RemoveCollaborator removes a user's assignment from a course.
+// Only course owners and company admins can manage collaborators.
+func (s *CourseService) RemoveCollaborator(ctx context.Context, kratosID uuid.UUID, courseID, userID uuid.UUID) error {
+	log := s.logger.With("kratosID", kratosID, "courseID", courseID, "userID", userID)
+
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return domainerrors.ErrUserNotFound
+	}
+
+	course, err := s.courseRepo.GetByID(ctx, courseID)
+	if err != nil {
+		log.Error("failed to get course", "error", err)
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+	if course == nil {
+		return domainerrors.ErrNotFound.WithMessage("course not found")
+	}
+
+	if err := s.checkManageCollaborators(ctx, user, course); err != nil {
+		return err
+	}
+
+	existing, err := s.collaboratorRepo.Get(ctx, courseID, userID)
+	if err != nil {
+		log.Error("failed to get course collaborator", "error", err)
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+	if existing == nil {
+		return domainerrors.ErrCollaboratorNotFound
+	}
+
+	entry := courseAuditEntry(user, course.TenantID, audit.ActionCollaboratorRemoved, audit.TargetCourse, course.ID,
+		audit.Changes{}.
+			Field("collaborator_user_id", userID, "").
+			Field("collaborator_role", existing.Role, ""))
+	if err := audited(ctx, s.auditLog, &entry, func(ctx context.Context) error {
+		return s.collaboratorRepo.Remove(ctx, courseID, userID)
+	}); err != nil {
+		log.Error("failed to remove course collaborator", "error", err)
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+
+	_ = s.cache.InvalidateNamespace(ctx, cache.NamespaceCourses)
+
+	log.Info("course collaborator removed")
+	return nil
+}
+
+// CheckCourseEditAccess verifies the user may modify the course or start generation for it.
+// Implements CourseAccessChecker interface for AIGenerationService.
+func (s *CourseService) CheckCourseEditAccess(ctx context.Context, user *entity.User, courseID uuid.UUID) error {
+	course, err := s.courseRepo.GetByID(ctx, courseID)
+	if err != nil {
+		s.logger.Error("failed to get course", "courseID", courseID, "error", err)
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+	if course == nil {
+		return domainerrors.ErrNotFound.WithMessage("course not found")
+	}
+	return s.checkCourseEdit(ctx, user, course)
+}
+
+// CheckCourseViewAccess verifies the user may see the course: any course role grants it,
+// and courses without collaborators are open to the whole tenant.
+// Implements CourseAccessChecker interface for AIGenerationService.
+func (s *CourseService) CheckCourseViewAccess(ctx context.Context, user *entity.User, courseID uuid.UUID) error {
+	course, err := s.courseRepo.GetByID(ctx, courseID)
+	if err != nil {
+		s.logger.Error("failed to get course", "courseID", courseID, "error", err)
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+	if course == nil {
+		return domainerrors.ErrNotFound.WithMessage("course not found")
+	}
+	if user.IsAdmin() {
+		return nil
+	}
+
+	role, err := s.courseRole(ctx, user.ID, course)
+	if err != nil {
+		return err
+	}
+	if role != "" {
+		return nil
+	}
+
+	collaborators, err := s.collaboratorRepo.ListByCourseID(ctx, course.ID)
+	if err != nil {
+		s.logger.Error("failed to list course collaborators", "courseID", course.ID, "error", err)
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+	if len(collaborators) == 0 {
+		return nil
+	}
+
+	return domainerrors.ErrForbidden.WithMessage("you do not have access to this course")
+}
+
+// RecordGenerationPreferences stores the course's generation preferences in its assessment settings.
+// Callers are expected to have checked edit access already.
+// Implements CoursePreferencesRecorder interface for AIGenerationService.
+func (s *CourseService) RecordGenerationPreferences(ctx context.Context, courseID uuid.UUID, prefs entity.GenerationPreferences) error {
+	course, err := s.courseRepo.GetByID(ctx, courseID)
+	if err != nil {
+		return err
+	}
+	if course == nil {
+		return domainerrors.ErrCourseNotFound
+	}
+
+	exists, err := s.storage.CourseContentExists(ctx, course.TenantID, course.ID)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil // Nothing to annotate until the course has content
+	}
+
+	var s3Content S3CourseContent
+	if err := s.storage.ReadCourseContent(ctx, course.TenantID, course.ID, &s3Content); err != nil {
+		return err
+	}
+
+	if s3Content.AssessmentSettings == nil {
+		s3Content.AssessmentSettings = map[string]any{}
+	}
+	s3Content.AssessmentSettings["enableEmbeddedKnowledgeChecks"] = prefs.EnableQuizzes
+	s3Content.AssessmentSettings["generationPreferences"] = map[string]any{
+		"enableQuizzes":            prefs.EnableQuizzes,
+		"quizFrequency":            prefs.QuizFrequency.String(),
+		"includeImages":            prefs.IncludeImages,
+		"includeReflectionPrompts": prefs.IncludeReflectionPrompts,
+		"generateImages":           prefs.GenerateImages,
+		"minLessonComponents":      prefs.MinComponents,
+		"maxLessonComponents":      prefs.MaxComponents,
+	}
+
+	if err := s.storage.WriteCourseContent(ctx, course.TenantID, course.ID, &s3Content); err != nil {
+		return err
+	}
+	s.measureContentSize(ctx, course.ID, &s3Content)
+
+	_ = s.cache.Delete(ctx, cache.TenantCacheKeys.Course(courseID.String()))
+	return nil
+}
+
+// RecordCourseExport appends an export artifact to the course's exports list.
+// Implements CourseExportRecorder interface for AIGenerationService.
+func (s *CourseService) RecordCourseExport(ctx context.Context, courseID uuid.UUID, export CourseExportRecord) error {
+	course, err := s.courseRepo.GetByID(ctx, courseID)
+	if err != nil {
+		return err
+	}
+	if course == nil {
+		return domainerrors.ErrCourseNotFound
+	}
+
+	exists, err := s.storage.CourseContentExists(ctx, course.TenantID, course.ID)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return domainerrors.ErrNotFound.WithMessage("course content not found")
+	}
+
+	var s3Content S3CourseContent
+	if err := s.storage.ReadCourseContent(ctx, course.TenantID, course.ID, &s3Content); err != nil {
+		return err
+	}
+
+	s3Content.Exports = append(s3Content.Exports, map[string]any{
+		"id":             export.ID.String(),
+		"timestamp":      export.CreatedAt.UTC().Format(time.RFC3339),
+		"format":         export.Format,
+		"version":        course.Version,
+		"filePath":       export.FilePath,
+		"lessonCount":    export.LessonCount,
+		"skippedLessons": export.SkippedLessons,
+	})
+
+	if err := s.storage.WriteCourseContent(ctx, course.TenantID, course.ID, &s3Content); err != nil {
+		return err
+	}
+	s.measureContentSize(ctx, course.ID, &s3Content)
+
+	_ = s.cache.Delete(ctx, cache.TenantCacheKeys.Course(courseID.String()))
+	return nil
+}
+
+// DuplicateCourse copies a course's metadata and stored content into a new draft course
+// owned by user, in the same folder. The copy records sourceID as its source course and
+// language as its content language (nil when unknown). Section, lesson and block IDs
+// are remapped so edits synced by ID never reach the source course. Reference
+// attachments are copied with their extracted text; exports, collaborators and generated
+// content are not.
+// Implements CourseDuplicator interface for AIGenerationService.
+func (s *CourseService) DuplicateCourse(ctx context.Context, user *entity.User, sourceID uuid.UUID, title string, language *string) (*entity.Course, error) {
+	if user.CompanyID == nil {
+		return nil, domainerrors.ErrUserHasNoCompany
+	}
+
+	source, err := s.courseRepo.GetByID(ctx, sourceID)
+	if err != nil {
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	if source == nil {
+		return nil, domainerrors.ErrCourseNotFound
+	}
+
+	var s3Content S3CourseContent
+	exists, err := s.storage.CourseContentExists(ctx, source.TenantID, source.ID)
+	if err != nil {
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	if exists {
+		if err := s.storage.ReadCourseContent(ctx, source.TenantID, source.ID, &s3Content); err != nil {
+			return nil, domainerrors.ErrInternal.WithCause(err)
+		}
+	}
+
+	courseID := uuid.New()
+	course := &entity.Course{
+		ID:              courseID,
+		TenantID:        source.TenantID,
+		CompanyID:       *user.CompanyID,
+		CreatedByUserID: user.ID,
+		TeamID:          source.TeamID,
+		Title:           title,
+		Status:          entity.CourseStatusDraft,
+		Version:         1,
+		FolderID:        source.FolderID,
+		CategoryTags:    source.CategoryTags,
+		ThumbnailPath:   source.ThumbnailPath,
+		ContentPath:     s.storage.CoursePath(source.TenantID, courseID),
+		SourceCourseID:  &source.ID,
+		Language:        language,
+	}
+	if course.CategoryTags == nil {
+		course.CategoryTags = []string{}
+	}
+
+	s3Content.Settings.Title = title
+	s3Content.Exports = []map[string]any{}
+	s3Content.SourceIDMap = remapContentIDs(&s3Content.Content, courseID)
+	if size, err := s.storage.CourseContentSize(&s3Content); err == nil {
+		course.ContentSizeBytes = &size
+	}
+
+	if err := s.storage.WriteCourseContent(ctx, course.TenantID, courseID, &s3Content); err != nil {
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	if err := s.courseRepo.Create(ctx, course); err != nil {
+		_ = s.storage.DeleteCourseContent(ctx, course.TenantID, courseID)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	s.copyCourseAttachments(ctx, source, course)
+
+	_ = s.cache.InvalidateNamespace(ctx, cache.NamespaceCourses)
+	s.InvalidateLibraryCache(ctx)
+
+	s.logger.Info("course duplicated", "sourceCourseID", source.ID, "courseID", course.ID, "language", language)
+	return course, nil
+}
+
+// RenameCourse sets a course's title in its metadata and stored settings.
+// Implements CourseDuplicator interface for AIGenerationService.
+func (s *CourseService) RenameCourse(ctx context.Context, courseID uuid.UUID, title string) error {
+	course, err := s.courseRepo.GetByID(ctx, courseID)
+	if err != nil {
+		return err
+	}
+	if course == nil {
+		return domainerrors.ErrCourseNotFound
+	}
+
+	course.Title = title
+	if err := s.courseRepo.Update(ctx, course); err != nil {
+		return err
+	}
+
+	exists, err := s.storage.CourseContentExists(ctx, course.TenantID, course.ID)
+	if err != nil {
+		return err
+	}
+	if exists {
+		var s3Content S3CourseContent
+		if err := s.storage.ReadCourseContent(ctx, course.TenantID, course.ID, &s3Content); err != nil {
+			return err
+		}
+		s3Content.Settings.Title = title
+		if err := s.storage.WriteCourseContent(ctx, course.TenantID, course.ID, &s3Content); err != nil {
+			return err
+		}
+		s.measureContentSize(ctx, course.ID, &s3Content)
+	}
+
+	_ = s.cache.Delete(ctx, cache.TenantCacheKeys.Course(courseID.String()))
+	s.InvalidateLibraryCache(ctx)
+	return nil
+}
+
+// validateCourseContent rejects content that embeds files as large data: URIs. Such files
+// bloat the stored course and slow every load, so they must be uploaded separately.
+func (s *CourseService) validateCourseContent(content CourseContent) error {
+	if s.maxDataURIBytes <= 0 {
+		return nil
+	}
+
+	largest := 0
+	for _, blocks := range [][]map[string]any{content.Sections, content.CourseBlocks} {
+		for _, block := range blocks {
+			largest = max(largest, largestDataURI(block))
+		}
+	}
+	if largest > s.maxDataURIBytes {
+		return domainerrors.ErrInvalidInput.WithMessage(fmt.Sprintf(
+			"course content embeds a %d KB data URI, over the %d KB limit; upload the file through an upload URL and reference it by URL instead",
+			largest>>10, s.maxDataURIBytes>>10))
+	}
+	return nil
+}
+
+// largestDataURI returns the length of the longest data: URI string anywhere in v.
+func largestDataURI(v any) int {
+	largest := 0
+	switch val := v.(type) {
+	case string:
+		if len(val) > 5 && strings.EqualFold(val[:5], "data:") {
+			largest = len(val)
+		}
+	case map[string]any:
+		for _, child := range val {
+			largest = max(largest, largestDataURI(child))
+		}
+	case []any:
+		for _, child := range val {
+			largest = max(largest, largestDataURI(child))
+		}
+	}
+	return largest
+}
+
+// checkCourseEdit verifies the user may modify the course.
+// Admins always can. Otherwise the user's course role decides, and courses
+// without any collaborators fall back to the user's company role.
+func (s *CourseService) checkCourseEdit(ctx context.Context, user *entity.User, course *entity.Course) error {
+	if user.IsAdmin() {
+		return nil
+	}
+
+	role, err := s.courseRole(ctx, user.ID, course)
+	if err != nil {
+		return err
+	}
+	if role != "" {
+		if role.CanEditCourse() {
+			return nil
+		}
+		return domainerrors.ErrForbidden.WithMessage("insufficient permissions to edit this course")
+	}
+
+	collaborators, err := s.collaboratorRepo.ListByCourseID(ctx, course.ID)
+	if err != nil {
+		s.logger.Error("failed to list course collaborators", "courseID", course.ID, "error", err)
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+	if len(collaborators) == 0 && user.CanEditCourses() {
+		return nil
+	}
+
+	return domainerrors.ErrForbidden.WithMessage("insufficient permissions to edit this course")
+}
+
+// checkManageCollaborators verifies the user is a company admin or a course owner.
+func (s *CourseService) checkManageCollaborators(ctx context.Context, user *entity.User, course *entity.Course) error {
+	if user.IsAdmin() {
+		return nil
+	}
+
+	role, err := s.courseRole(ctx, user.ID, course)
+	if err != nil {
+		return err
+	}
+	if !role.CanManageCollaborators() {
+		return domainerrors.ErrForbidden.WithMessage("only course owners and admins can manage collaborators")
+	}
+	return nil
+}
+
+// courseRole returns the user's role on a course, or an empty role if unassigned.
+// The course creator is implicitly the owner.
+func (s *CourseService) courseRole(ctx context.Context, userID uuid.UUID, course *entity.Course) (valueobject.CourseRole, error) {
+	if course.CreatedByUserID == userID {
+		return valueobject.CourseRoleOwner, nil
+	}
+
+	collaborator, err := s.collaboratorRepo.Get(ctx, course.ID, userID)
+	if err != nil {
+		s.logger.Error("failed to get course collaborator", "courseID", course.ID, "error", err)
+		return "", domainerrors.ErrInternal.WithCause(err)
+	}
+	if collaborator == nil {
+		return "", nil
+	}
+	return collaborator.Role, nil
+}
+
+// courseRolesForUser returns the user's explicit collaborator roles keyed by course ID.
+func (s *CourseService) courseRolesForUser(ctx context.Context, userID uuid.UUID) (map[uuid.UUID]valueobject.CourseRole, error) {
+	collaborators, err := s.collaboratorRepo.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	roles := make(map[uuid.UUID]valueobject.CourseRole, len(collaborators))
+	for _, c := range collaborators {
+		roles[c.CourseID] = c.Role
+	}
+	return roles, nil
+}
+
+// callerRole resolves a user's role on a course from their preloaded assignments.
+func callerRole(userID uuid.UUID, course *entity.Course, roles map[uuid.UUID]valueobject.CourseRole) valueobject.CourseRole {
+	if course.CreatedByUserID == userID {
+		return valueobject.CourseRoleOwner
+	}
+	return roles[course.ID]
+}
@@ -0,0 +1,213 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"html"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+	"github.com/sogos/mirai-backend/internal/infrastructure/cache"
+)
+
+// courseStatsCacheTTL bounds how long course stats are served from cache. Stats are
+// also invalidated whenever lessons or the outline change, so this is only a backstop.
+const courseStatsCacheTTL = time.Hour
+
+var htmlTagPattern = regexp.MustCompile(`<[^>]*>`)
+
+// SetStatsCache enables caching of course stats.
+func (s *AIGenerationService) SetStatsCache(c cache.Cache) {
+	s.statsCache = c
+}
+
+// GetCourseStats returns word, quiz, image and video counts for a course's generated lessons,
+// in total and per outline section.
+func (s *AIGenerationService) GetCourseStats(ctx context.Context, kratosID uuid.UUID, courseID uuid.UUID) (*entity.CourseStats, error) {
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, domainerrors.ErrUserNotFound
+	}
+
+	course, err := s.courseRepo.GetByID(ctx, courseID)
+	if err != nil {
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	if course == nil {
+		return nil, domainerrors.ErrNotFound.WithMessage("course not found")
+	}
+
+	if s.statsCache != nil {
+		var cached entity.CourseStats
+		if entry, err := s.statsCache.Get(ctx, cache.TenantCacheKeys.CourseStats(courseID.String()), &cached); err == nil && entry != nil {
+			return &cached, nil
+		}
+	}
+
+	stats, err := s.computeCourseStats(ctx, courseID)
+	if err != nil {
+		s.logger.Error("failed to compute course stats", "courseID", courseID, "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	if s.statsCache != nil {
+		if _, err := s.statsCache.Set(ctx, cache.TenantCacheKeys.CourseStats(courseID.String()), stats, "", courseStatsCacheTTL); err != nil {
+			s.logger.Warn("failed to cache course stats", "courseID", courseID, "error", err)
+		}
+	}
+
+	return stats, nil
+}
+
+// computeCourseStats walks every component of the course's current lessons once.
+// Sections follow the latest outline; lessons whose section is not in it are grouped
+// under their own untitled entries at the end.
+func (s *AIGenerationService) computeCourseStats(ctx context.Context, courseID uuid.UUID) (*entity.CourseStats, error) {
+	stats := &entity.CourseStats{CourseID: courseID}
+	sectionIndex := make(map[uuid.UUID]int)
+
+	outline, err := s.outlineRepo.GetByCourseID(ctx, courseID)
+	if err != nil {
+		return nil, err
+	}
+	if outline != nil {
+		sections, err := s.sectionRepo.ListByOutlineID(ctx, outline.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, section := range sections {
+			sectionIndex[section.ID] = len(stats.Sections)
+			stats.Sections = append(stats.Sections, entity.SectionContentStats{
+				SectionID: section.ID,
+				Title:     section.Title,
+			})
+		}
+	}
+
+	lessons, err := s.genLessonRepo.ListByCourseID(ctx, courseID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, lesson := range lessons {
+		components, err := s.componentRepo.ListByLessonID(ctx, lesson.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		lessonStats := entity.ContentStats{LessonCount: 1}
+		for _, component := range components {
+			lessonStats.Add(componentStats(component))
+		}
+
+		idx, ok := sectionIndex[lesson.SectionID]
+		if !ok {
+			idx = len(stats.Sections)
+			sectionIndex[lesson.SectionID] = idx
+			stats.Sections = append(stats.Sections, entity.SectionContentStats{SectionID: lesson.SectionID})
+		}
+		stats.Sections[idx].Stats.Add(lessonStats)
+		stats.Totals.Add(lessonStats)
+	}
+
+	return stats, nil
+}
+
+// componentStats counts the words in a component's learner-facing text. Components whose
+// content cannot be parsed count as zero words and are reported as malformed.
+func componentStats(component *entity.LessonComponent) entity.ContentStats {
+	var stats entity.ContentStats
+	var words []string
+	var err error
+
+	switch component.Type {
+	case valueobject.LessonComponentTypeText:
+		var content struct {
+			HTML      string `json:"html"`
+			Plaintext string `json:"plaintext"`
+		}
+		if err = json.Unmarshal(component.ContentJSON, &content); err == nil {
+			text := content.Plaintext
+			if strings.TrimSpace(text) == "" {
+				text = html.UnescapeString(htmlTagPattern.ReplaceAllString(content.HTML, " "))
+			}
+			words = append(words, text)
+		}
+
+	case valueobject.LessonComponentTypeHeading:
+		var content struct {
+			Text string `json:"text"`
+		}
+		if err = json.Unmarshal(component.ContentJSON, &content); err == nil {
+			words = append(words, content.Text)
+		}
+
+	case valueobject.LessonComponentTypeImage:
+		stats.ImageCount = 1
+		var content struct {
+			Caption string `json:"caption"`
+		}
+		if err = json.Unmarshal(component.ContentJSON, &content); err == nil {
+			words = append(words, content.Caption)
+		}
+
+	case valueobject.LessonComponentTypeVideo:
+		// The script is narration, so only the on-screen title counts as words
+		stats.VideoCount = 1
+		var content struct {
+			Title string `json:"title"`
+		}
+		if err = json.Unmarshal(component.ContentJSON, &content); err == nil {
+			words = append(words, content.Title)
+		}
+
+	case valueobject.LessonComponentTypeQuiz:
+		stats.QuizCount = 1
+		var content struct {
+			Question string `json:"question"`
+			Options  []struct {
+				Text string `json:"text"`
+			} `json:"options"`
+			Explanation string `json:"explanation"`
+		}
+		if err = json.Unmarshal(component.ContentJSON, &content); err == nil {
+			words = append(words, content.Question, content.Explanation)
+			for _, option := range content.Options {
+				words = append(words, option.Text)
+			}
+		}
+	}
+
+	if err != nil {
+		stats.MalformedComponents = 1
+		return stats
+	}
+	for _, text := range words {
+		stats.WordCount += len(strings.Fields(text))
+	}
+	return stats
+}
+
+// invalidateCourseCaches drops cached stats and player views after a course's lessons or
+// outline change, and records the change to the course's draft.
+func (s *AIGenerationService) invalidateCourseCaches(ctx context.Context, courseID uuid.UUID) {
+	if s.draftTracker != nil {
+		s.draftTracker.CourseDraftChanged(ctx, courseID)
+	}
+	if s.statsCache != nil {
+		if err := s.statsCache.Delete(ctx, cache.TenantCacheKeys.CourseStats(courseID.String())); err != nil {
+			s.logger.Warn("failed to invalidate course stats", "courseID", courseID, "error", err)
+		}
+	}
+	if s.playerCache != nil {
+		if err := s.playerCache.Delete(ctx, cache.TenantCacheKeys.CoursePlayer(courseID.String())); err != nil {
+			s.logger.Warn("failed to invalidate course player view", "courseID", courseID, "error", err)
+		}
+	}
+}
@@ -0,0 +1,194 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/audit"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+	"github.com/sogos/mirai-backend/internal/domain/tenant"
+	"github.com/sogos/mirai-backend/internal/infrastructure/storage"
+)
+
+// TenantKeyRotator rotates the per-tenant keys course content is encrypted with.
+type TenantKeyRotator interface {
+	Rotate(ctx context.Context, tenantID uuid.UUID) (int32, error)
+}
+
+// StorageEncryptionEnqueuer enqueues background re-encryption of stored course content.
+type StorageEncryptionEnqueuer interface {
+	// EnqueueStorageEncryption queues one tenant, or every tenant when tenantID is empty.
+	EnqueueStorageEncryption(tenantID string) error
+}
+
+// StorageEncryptionResult reports what re-encrypting a tenant's course content did.
+type StorageEncryptionResult struct {
+	Scanned   int // Content objects found
+	Rewritten int // Objects that were plaintext or used an older key
+	Failed    int // Objects that couldn't be rewritten; picked up by the next run
+	Duration  time.Duration
+}
+
+// SetStorageEncryption enables the superadmin storage encryption operations. Requires
+// SetCacheAdmin for the superadmin check.
+func (s *CourseService) SetStorageEncryption(keys TenantKeyRotator, tenantRepo repository.TenantRepository, enqueuer StorageEncryptionEnqueuer) {
+	s.storageKeys = keys
+	s.tenantRepo = tenantRepo
+	s.encryptionQueue = enqueuer
+}
+
+// RotateTenantStorageKey lets a superadmin give a tenant a new content encryption key and
+// returns its version. The tenant's content is queued for re-encryption with the new key;
+// until that finishes, content written with older keys stays readable.
+func (s *CourseService) RotateTenantStorageKey(ctx context.Context, kratosID uuid.UUID, email string, tenantID uuid.UUID) (int32, error) {
+	actor, err := s.cacheAdmin(ctx, kratosID, email)
+	if err != nil {
+		return 0, err
+	}
+	if err := s.checkStorageEncryption(); err != nil {
+		return 0, err
+	}
+	log := s.logger.With("tenantID", tenantID, "actor", email)
+
+	version, err := s.storageKeys.Rotate(ctx, tenantID)
+	if err != nil {
+		log.Error("failed to rotate tenant storage key", "error", err)
+		return 0, domainerrors.ErrInternal.WithCause(err)
+	}
+	if err := s.encryptionQueue.EnqueueStorageEncryption(tenantID.String()); err != nil {
+		log.Error("failed to enqueue storage re-encryption after key rotation", "error", err)
+		return 0, domainerrors.ErrInternal.WithMessage("key rotated, but re-encryption could not be queued - start it again").WithCause(err)
+	}
+
+	recordAudit(tenant.WithTenantID(ctx, tenantID), s.auditLog, log, audit.Entry{
+		TenantID:    tenantID,
+		ActorUserID: &actor.ID,
+		Action:      audit.ActionStorageKeyRotated,
+		TargetType:  audit.TargetTenant,
+		TargetID:    tenantID.String(),
+		Changes:     audit.Changes{}.Field("key_version", version-1, version),
+	})
+
+	log.Info("tenant storage key rotated", "keyVersion", version)
+	return version, nil
+}
+
+// StartStorageEncryption lets a superadmin queue re-encryption of a tenant's course
+// content, or of every tenant's when tenantID is nil. Plaintext objects and objects
+// encrypted with a retired key are rewritten with the tenant's active key.
+func (s *CourseService) StartStorageEncryption(ctx context.Context, kratosID uuid.UUID, email string, tenantID *uuid.UUID) error {
+	actor, err := s.cacheAdmin(ctx, kratosID, email)
+	if err != nil {
+		return err
+	}
+	if err := s.checkStorageEncryption(); err != nil {
+		return err
+	}
+	log := s.logger.With("actor", email)
+
+	target := ""
+	if tenantID != nil {
+		target = tenantID.String()
+		log = log.With("tenantID", target)
+	}
+	if err := s.encryptionQueue.EnqueueStorageEncryption(target); err != nil {
+		log.Error("failed to enqueue storage encryption", "error", err)
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+
+	if tenantID != nil {
+		recordAudit(tenant.WithTenantID(ctx, *tenantID), s.auditLog, log, audit.Entry{
+			TenantID:    *tenantID,
+			ActorUserID: &actor.ID,
+			Action:      audit.ActionStorageEncryptionStarted,
+			TargetType:  audit.TargetTenant,
+			TargetID:    target,
+		})
+	}
+
+	log.Info("storage encryption requested", "allTenants", tenantID == nil)
+	return nil
+}
+
+// EncryptTenantStorage rewrites a tenant's course content that is plaintext or encrypted
+// with a retired key, one object at a time. Objects that fail are counted and left for
+// the next run; the first failure is returned with the result.
+func (s *CourseService) EncryptTenantStorage(ctx context.Context, tenantID uuid.UUID) (*StorageEncryptionResult, error) {
+	start := time.Now()
+	log := s.logger.With("tenantID", tenantID, "job", "storage-encryption")
+	ctx = tenant.WithTenantID(ctx, tenantID)
+
+	result := &StorageEncryptionResult{}
+	var firstErr error
+	err := s.storage.WalkTenantObjects(ctx, tenantID, func(obj storage.ObjectInfo) error {
+		if !storage.IsContentObject(obj.Path) {
+			return nil
+		}
+		result.Scanned++
+		rewritten, err := s.storage.ReencryptContent(ctx, tenantID, obj.Path)
+		if err != nil {
+			log.Warn("failed to re-encrypt course content", "path", obj.Path, "error", err)
+			result.Failed++
+			if firstErr == nil {
+				firstErr = err
+			}
+			return ctx.Err()
+		}
+		if rewritten {
+			result.Rewritten++
+		}
+		return nil
+	})
+	result.Duration = time.Since(start)
+	if err != nil {
+		log.Error("storage encryption stopped", "error", err, "scanned", result.Scanned, "rewritten", result.Rewritten)
+		return result, err
+	}
+
+	log.Info("tenant storage encrypted",
+		"scanned", result.Scanned,
+		"rewritten", result.Rewritten,
+		"failed", result.Failed,
+		"duration", result.Duration)
+	return result, firstErr
+}
+
+// EncryptAllTenantStorage queues re-encryption of every active tenant's course content
+// and returns how many tenants were queued.
+func (s *CourseService) EncryptAllTenantStorage(ctx context.Context) (int, error) {
+	if err := s.checkStorageEncryption(); err != nil {
+		return 0, err
+	}
+	tenantIDs, err := s.tenantRepo.ListActiveIDs(tenant.WithSuperAdmin(ctx, true))
+	if err != nil {
+		return 0, err
+	}
+
+	queued := 0
+	var errs []error
+	for _, tenantID := range tenantIDs {
+		if err := s.encryptionQueue.EnqueueStorageEncryption(tenantID.String()); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		queued++
+	}
+	s.logger.Info("storage encryption queued for all tenants", "tenants", len(tenantIDs), "queued", queued)
+	return queued, errors.Join(errs...)
+}
+
+// checkStorageEncryption returns an error unless course content is written encrypted and
+// the superadmin operations are configured.
+func (s *CourseService) checkStorageEncryption() error {
+	if !s.storage.ContentEncryptionEnabled() {
+		return domainerrors.ErrInvalidInput.WithMessage("storage encryption is not enabled (STORAGE_ENCRYPTION_ENABLED)")
+	}
+	if s.storageKeys == nil || s.encryptionQueue == nil || s.tenantRepo == nil {
+		return domainerrors.ErrInternal.WithMessage("storage encryption is not configured")
+	}
+	return nil
+}
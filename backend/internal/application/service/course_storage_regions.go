@@ -0,0 +1,237 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/audit"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+	"github.com/sogos/mirai-backend/internal/domain/tenant"
+)
+
+// tenantRegionRefresh is how long a process keeps routing a tenant's objects to a cached
+// storage region before looking it up again. Every process sees a completed storage
+// migration within this long.
+const tenantRegionRefresh = 30 * time.Second
+
+// TenantStorageRegions looks up which storage region holds each tenant's objects, for
+// routing storage calls. Lookups are cached per process for tenantRegionRefresh.
+type TenantStorageRegions struct {
+	tenantRepo repository.TenantRepository
+
+	mu     sync.Mutex
+	cached map[uuid.UUID]cachedTenantRegion
+}
+
+type cachedTenantRegion struct {
+	region    string
+	fetchedAt time.Time
+}
+
+// NewTenantStorageRegions creates a region lookup backed by the tenants table.
+func NewTenantStorageRegions(tenantRepo repository.TenantRepository) *TenantStorageRegions {
+	return &TenantStorageRegions{
+		tenantRepo: tenantRepo,
+		cached:     make(map[uuid.UUID]cachedTenantRegion),
+	}
+}
+
+// TenantStorageRegion returns the tenant's storage region, or "" for the primary region.
+// Implements storage.TenantRegionResolver.
+func (r *TenantStorageRegions) TenantStorageRegion(ctx context.Context, tenantID uuid.UUID) (string, error) {
+	r.mu.Lock()
+	cached, ok := r.cached[tenantID]
+	r.mu.Unlock()
+	if ok && time.Since(cached.fetchedAt) < tenantRegionRefresh {
+		return cached.region, nil
+	}
+
+	t, err := r.tenantRepo.GetByID(tenant.WithTenantID(ctx, tenantID), tenantID)
+	if err != nil {
+		return "", err
+	}
+	region := ""
+	if t != nil {
+		region = t.StorageRegion
+	}
+
+	r.mu.Lock()
+	r.cached[tenantID] = cachedTenantRegion{region: region, fetchedAt: time.Now()}
+	r.mu.Unlock()
+	return region, nil
+}
+
+// Forget drops the tenant's cached region, so the next storage call looks it up again.
+func (r *TenantStorageRegions) Forget(tenantID uuid.UUID) {
+	r.mu.Lock()
+	delete(r.cached, tenantID)
+	r.mu.Unlock()
+}
+
+// StorageRegionMigrationEnqueuer enqueues background migration of a tenant's objects.
+type StorageRegionMigrationEnqueuer interface {
+	EnqueueStorageRegionMigration(tenantID string) error
+}
+
+// StorageRegionMigrationResult reports what migrating a tenant's objects did.
+type StorageRegionMigrationResult struct {
+	From     string // Region the objects were in; "" is the primary region
+	To       string
+	Copied   int // Objects copied before the switch
+	CaughtUp int // Objects written to the old region while other processes still used it
+	Deleted  int // Objects removed from the old region
+	Duration time.Duration
+}
+
+// SetStorageRegionMigration enables the superadmin storage region migration. Requires
+// SetCacheAdmin for the superadmin check.
+func (s *CourseService) SetStorageRegionMigration(regions *TenantStorageRegions, tenantRepo repository.TenantRepository, enqueuer StorageRegionMigrationEnqueuer) {
+	s.storageRegions = regions
+	s.tenantRepo = tenantRepo
+	s.regionQueue = enqueuer
+}
+
+// StartStorageRegionMigration lets a superadmin move a tenant's objects to another
+// storage region. The tenant is marked as migrating and the copy is queued; the tenant
+// keeps using its current region until the copy is done.
+func (s *CourseService) StartStorageRegionMigration(ctx context.Context, kratosID uuid.UUID, email string, tenantID uuid.UUID, region string) error {
+	actor, err := s.cacheAdmin(ctx, kratosID, email)
+	if err != nil {
+		return err
+	}
+	if s.storageRegions == nil || s.regionQueue == nil || s.tenantRepo == nil {
+		return domainerrors.ErrInternal.WithMessage("storage region migration is not configured")
+	}
+	if !s.storage.HasRegion(region) {
+		return domainerrors.ErrInvalidInput.WithMessage("unknown storage region: " + region)
+	}
+	log := s.logger.With("tenantID", tenantID, "region", region, "actor", email)
+
+	ctx = tenant.WithSuperAdmin(ctx, true)
+	t, err := s.tenantRepo.GetByID(ctx, tenantID)
+	if err != nil {
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+	if t == nil {
+		return domainerrors.ErrNotFound.WithMessage("tenant not found")
+	}
+	if s.sameRegion(t.StorageRegion, region) {
+		return domainerrors.ErrInvalidInput.WithMessage("the tenant's storage is already in " + region)
+	}
+
+	started, err := s.tenantRepo.StartStorageMigration(ctx, tenantID, region)
+	if err != nil {
+		log.Error("failed to start storage region migration", "error", err)
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+	if !started {
+		return domainerrors.ErrInvalidInput.WithMessage("the tenant is already migrating to " + t.StorageMigrationRegion)
+	}
+	if err := s.regionQueue.EnqueueStorageRegionMigration(tenantID.String()); err != nil {
+		log.Error("failed to enqueue storage region migration", "error", err)
+		return domainerrors.ErrInternal.WithMessage("migration recorded, but the copy could not be queued - start it again").WithCause(err)
+	}
+
+	recordAudit(tenant.WithTenantID(ctx, tenantID), s.auditLog, log, audit.Entry{
+		TenantID:    tenantID,
+		ActorUserID: &actor.ID,
+		Action:      audit.ActionStorageMigrationStarted,
+		TargetType:  audit.TargetTenant,
+		TargetID:    tenantID.String(),
+		Changes:     audit.Changes{}.Field("storage_region", s.regionName(t.StorageRegion), region),
+	})
+
+	log.Info("storage region migration requested", "from", s.regionName(t.StorageRegion))
+	return nil
+}
+
+// MigrateTenantStorageRegion copies a migrating tenant's objects to the region it is
+// migrating to, then switches the tenant over in one update. Processes keep routing to
+// the old region until their cached lookup expires, so after waiting that out, objects
+// written to the old region meanwhile are copied again and the old region is emptied.
+// A failed copy leaves the tenant in its old region, still marked as migrating, and is
+// safe to retry.
+func (s *CourseService) MigrateTenantStorageRegion(ctx context.Context, tenantID uuid.UUID) (*StorageRegionMigrationResult, error) {
+	start := time.Now()
+	log := s.logger.With("tenantID", tenantID, "job", "storage-region-migration")
+	if s.storageRegions == nil || s.tenantRepo == nil {
+		return nil, domainerrors.ErrInternal.WithMessage("storage region migration is not configured")
+	}
+
+	ctx = tenant.WithSuperAdmin(ctx, true)
+	t, err := s.tenantRepo.GetByID(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if t == nil || t.StorageMigrationRegion == "" {
+		log.Info("tenant is not migrating storage, skipping")
+		return &StorageRegionMigrationResult{}, nil
+	}
+	result := &StorageRegionMigrationResult{From: t.StorageRegion, To: t.StorageMigrationRegion}
+	log = log.With("from", s.regionName(result.From), "to", result.To)
+
+	copyStart := time.Now()
+	result.Copied, err = s.storage.CopyTenantObjectsBetweenRegions(ctx, tenantID, result.From, result.To, time.Time{})
+	if err != nil {
+		log.Error("storage region copy failed", "error", err, "copied", result.Copied)
+		return result, err
+	}
+
+	if err := s.tenantRepo.CompleteStorageMigration(ctx, tenantID, result.From, result.To); err != nil {
+		log.Error("failed to switch tenant storage region", "error", err)
+		return result, err
+	}
+	s.storageRegions.Forget(tenantID)
+	log.Info("tenant storage region switched", "copied", result.Copied)
+
+	recordAudit(tenant.WithTenantID(ctx, tenantID), s.auditLog, log, audit.Entry{
+		TenantID:   tenantID,
+		Action:     audit.ActionStorageRegionChanged,
+		TargetType: audit.TargetTenant,
+		TargetID:   tenantID.String(),
+		Changes:    audit.Changes{}.Field("storage_region", s.regionName(result.From), result.To),
+	})
+
+	// Wait until no process can still be writing to the old region
+	select {
+	case <-time.After(tenantRegionRefresh + 5*time.Second):
+	case <-ctx.Done():
+		return result, ctx.Err()
+	}
+
+	result.CaughtUp, err = s.storage.CopyTenantObjectsBetweenRegions(ctx, tenantID, result.From, result.To, copyStart)
+	if err != nil {
+		// The old region keeps its objects so the catch-up can be redone by hand
+		log.Error("storage region catch-up copy failed; old region left in place", "error", err, "caughtUp", result.CaughtUp)
+		return result, nil
+	}
+	result.Deleted, err = s.storage.DeleteTenantObjectsInRegion(ctx, tenantID, result.From)
+	if err != nil {
+		log.Warn("failed to delete some objects from the old storage region", "error", err, "deleted", result.Deleted)
+	}
+
+	result.Duration = time.Since(start)
+	log.Info("tenant storage region migrated",
+		"copied", result.Copied,
+		"caughtUp", result.CaughtUp,
+		"deleted", result.Deleted,
+		"duration", result.Duration)
+	return result, nil
+}
+
+// sameRegion reports whether two region names are the same region; "" is the primary.
+func (s *CourseService) sameRegion(a, b string) bool {
+	return s.regionName(a) == s.regionName(b)
+}
+
+// regionName names a region, turning "" into the primary region's name.
+func (s *CourseService) regionName(region string) string {
+	if region == "" {
+		return s.storage.PrimaryRegion()
+	}
+	return region
+}
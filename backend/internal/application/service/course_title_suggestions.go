@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/service"
+)
+
+// Suggestion limits. Like inline edits, suggestions run inside the request.
+const (
+	suggestionTimeout    = 15 * time.Second
+	suggestionRateLimit  = 5
+	suggestionRateWindow = time.Minute
+
+	courseTitleSuggestionCount = 5
+)
+
+// SuggestCourseTitlesRequest contains the course inputs chosen so far.
+type SuggestCourseTitlesRequest struct {
+	SMEIDs            []uuid.UUID
+	TargetAudienceIDs []uuid.UUID
+	DesiredOutcome    string
+}
+
+// SuggestCourseTitlesResult contains the suggested titles.
+type SuggestCourseTitlesResult struct {
+	Suggestions []service.Suggestion
+	TokensUsed  int64
+}
+
+// SuggestCourseTitles suggests course titles from the selected SMEs, audiences and outcome.
+// Nothing is saved; the client applies a chosen title through the normal course update.
+func (s *AIGenerationService) SuggestCourseTitles(ctx context.Context, kratosID uuid.UUID, req SuggestCourseTitlesRequest) (*SuggestCourseTitlesResult, error) {
+	log := s.logger.With("kratosID", kratosID)
+
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, domainerrors.ErrUserNotFound
+	}
+
+	if user.TenantID == nil {
+		return nil, domainerrors.ErrUserHasNoCompany
+	}
+
+	desiredOutcome := strings.TrimSpace(req.DesiredOutcome)
+	if desiredOutcome == "" && len(req.SMEIDs) == 0 {
+		return nil, domainerrors.ErrMissingRequired.WithMessage("select an SME or describe the desired outcome")
+	}
+
+	// Summaries are enough to name a course, which keeps the call short
+	smeKnowledge := make([]service.SMEKnowledgeInput, 0, len(req.SMEIDs))
+	for _, smeID := range req.SMEIDs {
+		sme, err := s.smeRepo.GetByID(ctx, smeID)
+		if err != nil || sme == nil {
+			return nil, domainerrors.ErrSMENotFound
+		}
+		smeKnowledge = append(smeKnowledge, service.SMEKnowledgeInput{
+			SMEName: sme.Name,
+			Domain:  sme.Domain,
+			Summary: s.promptKnowledgeSummary(sme),
+		})
+	}
+
+	for _, audienceID := range req.TargetAudienceIDs {
+		audience, err := s.audienceRepo.GetByID(ctx, audienceID)
+		if err != nil || audience == nil {
+			return nil, domainerrors.ErrTargetAudienceNotFound
+		}
+	}
+	targetAudiences := s.loadTargetAudiences(ctx, req.TargetAudienceIDs)
+
+	if !s.suggestionLimiter.Allow(user.ID) {
+		return nil, domainerrors.ErrRateLimited.WithMessage("too many title suggestions - please wait a moment and try again")
+	}
+
+	if err := checkAIGenerationEnabled(ctx, s.aiSettingsRepo, *user.TenantID); err != nil {
+		return nil, err
+	}
+	if err := s.checkTokenBudget(ctx, *user.TenantID); err != nil {
+		return nil, err
+	}
+
+	aiProvider, err := s.aiProviderFactory.GetProvider(ctx, *user.TenantID)
+	if err != nil {
+		log.Error("failed to get AI provider", "error", err)
+		if domainerrors.IsDomainError(err) {
+			return nil, err
+		}
+		return nil, domainerrors.ErrExternalService.WithCause(err)
+	}
+
+	suggestCtx, cancel := context.WithTimeout(ctx, suggestionTimeout)
+	defer cancel()
+
+	result, err := aiProvider.GenerateSuggestions(suggestCtx, service.GenerateSuggestionsRequest{
+		Kind:            service.SuggestionKindCourseTitle,
+		Count:           courseTitleSuggestionCount,
+		DesiredOutcome:  desiredOutcome,
+		SMEKnowledge:    smeKnowledge,
+		TargetAudiences: targetAudiences,
+	})
+	if err != nil {
+		if errors.Is(suggestCtx.Err(), context.DeadlineExceeded) {
+			log.Warn("course title suggestions timed out", "timeout", suggestionTimeout)
+			return nil, domainerrors.ErrSuggestionTimeout
+		}
+		log.Error("course title suggestions failed", "error", err)
+		return nil, domainerrors.ErrExternalService.WithCause(err)
+	}
+
+	if err := s.aiSettingsRepo.IncrementTokenUsage(ctx, *user.TenantID, result.TokensUsed); err != nil {
+		log.Warn("failed to record token usage", "error", err)
+	}
+
+	// Drop blanks and cap the count in case the provider returned extras
+	suggestions := make([]service.Suggestion, 0, courseTitleSuggestionCount)
+	for _, suggestion := range result.Suggestions {
+		if suggestion.Text == "" || len(suggestions) == courseTitleSuggestionCount {
+			continue
+		}
+		suggestions = append(suggestions, suggestion)
+	}
+	if len(suggestions) == 0 {
+		log.Warn("course title suggestions returned no titles")
+		return nil, domainerrors.ErrExternalService.WithMessage("AI returned no suggestions - please try again")
+	}
+
+	// The log line is the audit record; suggestions are not stored
+	log.Info("course titles suggested",
+		"userID", user.ID,
+		"tenantID", *user.TenantID,
+		"smeCount", len(req.SMEIDs),
+		"audienceCount", len(req.TargetAudienceIDs),
+		"suggestions", len(suggestions),
+		"tokensUsed", result.TokensUsed)
+
+	return &SuggestCourseTitlesResult{
+		Suggestions: suggestions,
+		TokensUsed:  result.TokensUsed,
+	}, nil
+}
@@ -0,0 +1,728 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+const (
+	// translationBatchSize is the most strings sent to the provider in one call.
+	translationBatchSize = 40
+
+	// translationLessonAttempts is how many times a lesson is translated before a quiz
+	// whose answer key didn't survive translation fails the job.
+	translationLessonAttempts = 2
+)
+
+// languageTagPattern matches BCP 47 language tags such as "es", "pt-BR" or "zh-Hant".
+var languageTagPattern = regexp.MustCompile(`^[a-zA-Z]{2,3}(-[a-zA-Z0-9]{2,8})*$`)
+
+// errQuizIntegrity reports a quiz whose options or answer key changed in translation.
+var errQuizIntegrity = errors.New("quiz answer key changed in translation")
+
+// quizGradingFields are the quiz fields that decide how answers are graded, which
+// translation must leave as they were.
+var quizGradingFields = []string{"schema_version", "question_type", "points", "shuffle"}
+
+// componentTranslatableFields lists the fields of each component type that hold learner
+// facing text. Everything else, such as levels, durations and asset paths, is copied as is.
+// Quiz option texts are handled separately so the options keep their IDs and order.
+var componentTranslatableFields = map[valueobject.LessonComponentType][]string{
+	valueobject.LessonComponentTypeText:    {"html", "plaintext"},
+	valueobject.LessonComponentTypeHeading: {"text"},
+	valueobject.LessonComponentTypeImage:   {"image_description", "alt_text", "caption"},
+	valueobject.LessonComponentTypeVideo:   {"title", "script", "thumbnail_description"},
+	valueobject.LessonComponentTypeQuiz:    {"question", "explanation", "correct_feedback", "incorrect_feedback"},
+}
+
+// CourseDuplicator copies courses for translation.
+type CourseDuplicator interface {
+	DuplicateCourse(ctx context.Context, user *entity.User, sourceID uuid.UUID, title string, language *string) (*entity.Course, error)
+	RenameCourse(ctx context.Context, courseID uuid.UUID, title string) error
+}
+
+// SetCourseTranslation enables translating courses. Without a duplicator, TranslateCourse fails.
+func (s *AIGenerationService) SetCourseTranslation(duplicator CourseDuplicator) {
+	s.courseDuplicator = duplicator
+}
+
+// TranslateCourseResult contains the queued translation job and the course it fills.
+type TranslateCourseResult struct {
+	Job    *entity.GenerationJob
+	Course *entity.Course
+}
+
+// TranslateCourse copies a course into a new draft course marked with the target
+// language and its source, then queues a job that translates the outline and generated
+// lessons into it. The source course is not changed.
+func (s *AIGenerationService) TranslateCourse(ctx context.Context, kratosID uuid.UUID, courseID uuid.UUID, targetLanguage string) (*TranslateCourseResult, error) {
+	log := s.logger.With("kratosID", kratosID, "courseID", courseID)
+
+	if s.courseDuplicator == nil {
+		return nil, domainerrors.ErrInternal.WithMessage("course translation is not configured")
+	}
+
+	targetLanguage = strings.TrimSpace(targetLanguage)
+	if !languageTagPattern.MatchString(targetLanguage) {
+		return nil, domainerrors.ErrInvalidInput.WithMessage(`target language must be a language tag such as "es" or "pt-BR"`)
+	}
+
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, domainerrors.ErrUserNotFound
+	}
+	if user.TenantID == nil {
+		return nil, domainerrors.ErrUserHasNoCompany
+	}
+
+	if err := s.checkCourseAccess(ctx, user, courseID); err != nil {
+		return nil, err
+	}
+
+	source, err := s.courseRepo.GetByID(ctx, courseID)
+	if err != nil {
+		log.Error("failed to get course", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	if source == nil {
+		return nil, domainerrors.ErrCourseNotFound
+	}
+	if source.Language != nil && strings.EqualFold(*source.Language, targetLanguage) {
+		return nil, domainerrors.ErrInvalidInput.WithMessage("course is already in that language")
+	}
+
+	outline, err := s.outlineRepo.GetByCourseID(ctx, courseID)
+	if err != nil {
+		log.Error("failed to get course outline", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	if outline == nil {
+		return nil, domainerrors.ErrInvalidInput.WithMessage("course has no outline to translate")
+	}
+
+	lessons, err := s.genLessonRepo.ListByCourseID(ctx, courseID, false)
+	if err != nil {
+		log.Error("failed to list generated lessons", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	if len(lessons) == 0 {
+		return nil, domainerrors.ErrInvalidInput.WithMessage("course has no generated lessons to translate")
+	}
+
+	if err := s.checkAIProvider(ctx, *user.TenantID); err != nil {
+		return nil, err
+	}
+	if err := s.checkTokenBudget(ctx, *user.TenantID); err != nil {
+		return nil, err
+	}
+
+	title := fmt.Sprintf("%s (%s)", source.Title, targetLanguage)
+	course, err := s.courseDuplicator.DuplicateCourse(ctx, user, source.ID, title, &targetLanguage)
+	if err != nil {
+		log.Error("failed to duplicate course", "error", err)
+		return nil, err
+	}
+
+	job := &entity.GenerationJob{
+		ID:              uuid.New(),
+		TenantID:        *user.TenantID,
+		Type:            valueobject.GenerationJobTypeCourseTranslation,
+		Status:          valueobject.GenerationJobStatusQueued,
+		CourseID:        &course.ID,
+		ProgressPercent: 0,
+		MaxRetries:      1,
+		CreatedByUserID: user.ID,
+		CreatedAt:       time.Now(),
+	}
+
+	if err := s.jobRepo.Create(ctx, job); err != nil {
+		log.Error("failed to create course translation job", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	log.Info("course translation job created", "jobID", job.ID, "translatedCourseID", course.ID, "language", targetLanguage, "lessons", len(lessons))
+
+	if s.taskEnqueuer != nil {
+		if err := s.taskEnqueuer.EnqueueAIGeneration(job.ID.String(), string(job.Type)); err != nil {
+			log.Warn("failed to enqueue job for immediate processing, will be picked up by poll", "error", err)
+		}
+	}
+
+	return &TranslateCourseResult{Job: job, Course: course}, nil
+}
+
+// ProcessCourseTranslationJob translates the source course's outline onto the job's
+// course, then translates and stores the source's generated lessons one at a time in
+// outline order. Lessons the course already has are skipped, so a re-queued job resumes
+// where it stopped.
+func (s *AIGenerationService) ProcessCourseTranslationJob(ctx context.Context, job *entity.GenerationJob) error {
+	log := s.logger.With("jobID", job.ID, "courseID", job.CourseID)
+
+	if ctx.Err() != nil {
+		return s.requeueInterruptedJob(ctx, job)
+	}
+	if s.checkJobCancelled(ctx, job.ID) {
+		log.Info("job already cancelled, skipping processing")
+		return nil
+	}
+	if job.CourseID == nil {
+		return s.failJob(ctx, job, "translation job has no course")
+	}
+
+	course, err := s.courseRepo.GetByID(ctx, *job.CourseID)
+	if err != nil || course == nil {
+		return s.failJob(ctx, job, "course not found")
+	}
+	if course.SourceCourseID == nil || course.Language == nil {
+		return s.failJob(ctx, job, "course is not a translation")
+	}
+	sourceID := *course.SourceCourseID
+
+	sourceOutline, err := s.outlineRepo.GetByCourseID(ctx, sourceID)
+	if err != nil || sourceOutline == nil {
+		return s.failJob(ctx, job, "source course outline not found")
+	}
+	if err := s.loadOutlineSections(ctx, sourceOutline); err != nil {
+		log.Error("failed to load source outline sections", "error", err)
+		return s.failJob(ctx, job, "failed to load source course outline")
+	}
+
+	sourceLessons, err := s.genLessonRepo.ListByCourseID(ctx, sourceID, false)
+	if err != nil {
+		log.Error("failed to list source lessons", "error", err)
+		return s.failJob(ctx, job, "failed to list source lessons")
+	}
+	sourceByOutlineLesson := make(map[uuid.UUID]*entity.GeneratedLesson, len(sourceLessons))
+	for _, lesson := range sourceLessons {
+		sourceByOutlineLesson[lesson.OutlineLessonID] = lesson
+	}
+
+	if err := s.checkTokenBudget(ctx, job.TenantID); err != nil {
+		log.Warn("token budget check failed", "error", err)
+		return s.failJobWithError(ctx, job, "translation not started", err)
+	}
+
+	aiProvider, err := s.aiProviderFactory.GetProvider(ctx, job.TenantID)
+	if err != nil {
+		log.Error("failed to get AI provider", "error", err)
+		return s.failJobWithError(ctx, job, "failed to get AI provider", err)
+	}
+
+	translator := &textTranslator{
+		provider: aiProvider,
+		language: *course.Language,
+		context:  "Course: " + s.resolveCourseTitle(ctx, sourceID, nil),
+	}
+
+	// translationFailed ends the job after a provider error, telling a drain or a user
+	// cancellation apart from a failed call.
+	translationFailed := func(prefix string, err error) error {
+		if ctx.Err() != nil {
+			log.Info("course translation interrupted")
+			return s.handleInterruptedJob(ctx, job, tokensUsedBeforeAbort(err))
+		}
+		log.Error(prefix, "error", err)
+		return s.failJobWithError(ctx, job, prefix, err)
+	}
+
+	outline, err := s.outlineRepo.GetByCourseID(ctx, course.ID)
+	if err != nil {
+		log.Error("failed to get course outline", "error", err)
+		return s.failJob(ctx, job, "failed to get course outline")
+	}
+	if outline == nil {
+		progressMsg := "Translating outline..."
+		job.ProgressMessage = &progressMsg
+		job.ProgressPercent = 5
+		if _, err := s.jobRepo.UpdateProgress(ctx, job.ID, job.ProgressPercent, progressMsg); err != nil {
+			log.Error("failed to update job progress", "error", err)
+		}
+
+		outline, err = s.translateOutline(ctx, job, translator, course, sourceOutline)
+		s.recordTranslationTokens(ctx, job, translator)
+		if err != nil {
+			return translationFailed("failed to translate outline", err)
+		}
+	} else if err := s.loadOutlineSections(ctx, outline); err != nil {
+		log.Error("failed to load outline sections", "error", err)
+		return s.failJob(ctx, job, "failed to load course outline")
+	}
+
+	if len(outline.Sections) != len(sourceOutline.Sections) {
+		return s.failJob(ctx, job, "translated outline does not match its source")
+	}
+
+	existing, err := s.genLessonRepo.ListByCourseID(ctx, course.ID, false)
+	if err != nil {
+		log.Error("failed to list translated lessons", "error", err)
+		return s.failJob(ctx, job, "failed to list translated lessons")
+	}
+	translated := make(map[uuid.UUID]bool, len(existing))
+	for _, lesson := range existing {
+		translated[lesson.OutlineLessonID] = true
+	}
+
+	total := len(sourceLessons)
+	done := 0
+	for i, sourceSection := range sourceOutline.Sections {
+		section := outline.Sections[i]
+		if len(section.Lessons) != len(sourceSection.Lessons) {
+			return s.failJob(ctx, job, "translated outline does not match its source")
+		}
+
+		for j, sourceOutlineLesson := range sourceSection.Lessons {
+			sourceLesson := sourceByOutlineLesson[sourceOutlineLesson.ID]
+			if sourceLesson == nil {
+				continue // Never generated; nothing to translate
+			}
+			outlineLesson := section.Lessons[j]
+			if translated[outlineLesson.ID] {
+				done++
+				continue
+			}
+
+			if ctx.Err() != nil {
+				return s.requeueInterruptedJob(ctx, job)
+			}
+			if err := s.checkTokenBudget(ctx, job.TenantID); err != nil {
+				log.Warn("token budget check failed", "error", err)
+				return s.failJobWithError(ctx, job, "translation stopped", err)
+			}
+
+			components, err := s.componentRepo.ListByLessonID(ctx, sourceLesson.ID)
+			if err != nil {
+				log.Error("failed to list lesson components", "lessonID", sourceLesson.ID, "error", err)
+				return s.failJob(ctx, job, "failed to load lesson components")
+			}
+
+			var result *translatedLesson
+			for attempt := 1; ; attempt++ {
+				result, err = translateLesson(ctx, translator, sourceLesson, components)
+				s.recordTranslationTokens(ctx, job, translator)
+				if err == nil || !errors.Is(err, errQuizIntegrity) || attempt >= translationLessonAttempts {
+					break
+				}
+				log.Warn("quiz changed in translation, retrying lesson", "lessonID", sourceLesson.ID, "error", err)
+			}
+			if err != nil {
+				return translationFailed(fmt.Sprintf("failed to translate lesson %q", outlineLesson.Title), err)
+			}
+
+			// The translation has been paid for; store it even if a drain starts now
+			if err := s.storeTranslatedLesson(context.WithoutCancel(ctx), job, section.ID, outlineLesson, result); err != nil {
+				log.Error("failed to store translated lesson", "lessonID", sourceLesson.ID, "error", err)
+				return s.failJob(ctx, job, "failed to store translated lesson")
+			}
+			s.invalidateCourseCaches(ctx, course.ID)
+
+			done++
+			percent := int32(10 + 85*done/total)
+			progressMsg := fmt.Sprintf("Translated %d of %d lessons", done, total)
+			job.ProgressPercent = percent
+			job.ProgressMessage = &progressMsg
+			processing, err := s.jobRepo.UpdateProgress(ctx, job.ID, percent, progressMsg)
+			if err != nil {
+				log.Warn("failed to update job progress", "progress", percent, "error", err)
+			} else if !processing {
+				detached := context.WithoutCancel(ctx)
+				if s.checkJobCancelled(detached, job.ID) {
+					log.Info("course translation cancelled", "lessons", done)
+					return s.markJobCancelled(detached, job)
+				}
+				return s.requeueInterruptedJob(ctx, job)
+			}
+		}
+	}
+
+	ctx = context.WithoutCancel(ctx)
+
+	job.Status = valueobject.GenerationJobStatusCompleted
+	job.ProgressPercent = 100
+	completedAt := time.Now()
+	job.CompletedAt = &completedAt
+	progressMsg := fmt.Sprintf("Translated %d lessons into %s", done, *course.Language)
+	job.ProgressMessage = &progressMsg
+	if err := s.jobRepo.Update(ctx, job); err != nil {
+		log.Error("failed to mark job as completed", "error", err)
+	}
+	s.invalidateCourseCaches(ctx, course.ID)
+
+	if s.notifier != nil {
+		if err := s.notifier.NotifyJobProgress(ctx, job.CreatedByUserID, job.ID, job.CourseID, "Course Translation", "completed", 100); err != nil {
+			log.Error("failed to send completion notification", "error", err)
+		}
+	}
+
+	log.Info("course translation completed", "lessons", done, "language", *course.Language, "tokensUsed", job.TokensUsed)
+	return nil
+}
+
+// translateOutline stores a translated copy of source as the course's outline, keeping
+// its structure and approval, and gives the course the translated title. It also copies
+// the source's generation input so the translated course's lessons can be regenerated.
+func (s *AIGenerationService) translateOutline(ctx context.Context, job *entity.GenerationJob, translator *textTranslator, course *entity.Course, source *entity.CourseOutline) (*entity.CourseOutline, error) {
+	log := s.logger.With("jobID", job.ID, "courseID", course.ID)
+
+	now := time.Now()
+	outline := &entity.CourseOutline{
+		ID:                uuid.New(),
+		TenantID:          job.TenantID,
+		CourseID:          course.ID,
+		Version:           1,
+		ApprovalStatus:    source.ApprovalStatus,
+		GeneratedAt:       now,
+		GeneratedByUserID: &job.CreatedByUserID,
+	}
+
+	sourceTitle := s.resolveCourseTitle(ctx, *course.SourceCourseID, nil)
+	title := sourceTitle
+	slots := []translationSlot{{text: sourceTitle, set: func(v string) { title = v }}}
+
+	outline.Sections = make([]entity.OutlineSection, len(source.Sections))
+	for i, sourceSection := range source.Sections {
+		section := &outline.Sections[i]
+		*section = entity.OutlineSection{
+			ID:          uuid.New(),
+			TenantID:    job.TenantID,
+			OutlineID:   outline.ID,
+			Title:       sourceSection.Title,
+			Description: sourceSection.Description,
+			Position:    sourceSection.Position,
+			CreatedAt:   now,
+		}
+		slots = append(slots,
+			translationSlot{text: section.Title, set: func(v string) { section.Title = v }},
+			translationSlot{text: section.Description, set: func(v string) { section.Description = v }},
+		)
+
+		section.Lessons = make([]entity.OutlineLesson, len(sourceSection.Lessons))
+		for j, sourceLesson := range sourceSection.Lessons {
+			lesson := &section.Lessons[j]
+			*lesson = entity.OutlineLesson{
+				ID:                       uuid.New(),
+				TenantID:                 job.TenantID,
+				SectionID:                section.ID,
+				Title:                    sourceLesson.Title,
+				Description:              sourceLesson.Description,
+				Position:                 sourceLesson.Position,
+				EstimatedDurationMinutes: sourceLesson.EstimatedDurationMinutes,
+				LearningObjectives:       append([]string(nil), sourceLesson.LearningObjectives...),
+				IsLastInSection:          sourceLesson.IsLastInSection,
+				IsLastInCourse:           sourceLesson.IsLastInCourse,
+				TargetAudiences:          append([]string(nil), sourceLesson.TargetAudiences...),
+				CreatedAt:                now,
+			}
+			slots = append(slots,
+				translationSlot{text: lesson.Title, set: func(v string) { lesson.Title = v }},
+				translationSlot{text: lesson.Description, set: func(v string) { lesson.Description = v }},
+			)
+			for k := range lesson.LearningObjectives {
+				slots = append(slots, translationSlot{text: lesson.LearningObjectives[k], set: func(v string) { lesson.LearningObjectives[k] = v }})
+			}
+		}
+	}
+
+	if err := translator.translate(ctx, slots); err != nil {
+		return nil, err
+	}
+
+	// The translation has been paid for; store it even if a drain starts now
+	ctx = context.WithoutCancel(ctx)
+
+	var sections []entity.OutlineSection
+	var lessons []entity.OutlineLesson
+	for _, section := range outline.Sections {
+		sections = append(sections, section)
+		lessons = append(lessons, section.Lessons...)
+	}
+	if err := s.outlineRepo.CreateCompleteOutline(ctx, outline, sections, lessons); err != nil {
+		return nil, fmt.Errorf("failed to store translated outline: %w", err)
+	}
+
+	if outline.ApprovalStatus == valueobject.OutlineApprovalStatusApproved {
+		outline.ApprovedAt = &now
+		outline.ApprovedByUserID = &job.CreatedByUserID
+		if err := s.outlineRepo.Update(ctx, outline); err != nil {
+			log.Warn("failed to record translated outline approval", "error", err)
+		}
+	}
+
+	if err := s.courseDuplicator.RenameCourse(ctx, course.ID, title); err != nil {
+		log.Warn("failed to set translated course title", "error", err)
+	}
+
+	if genInput, err := s.genInputRepo.GetByCourseID(ctx, *course.SourceCourseID); err != nil {
+		log.Warn("failed to get source generation input", "error", err)
+	} else if genInput != nil {
+		copied := *genInput
+		copied.CourseID = course.ID
+		copied.CourseTitle = &title
+		if err := s.genInputRepo.Create(ctx, &copied); err != nil {
+			log.Warn("failed to copy generation input", "error", err)
+		}
+	}
+
+	return outline, nil
+}
+
+// storeTranslatedLesson creates the generated lesson for outlineLesson and its
+// translated components.
+func (s *AIGenerationService) storeTranslatedLesson(ctx context.Context, job *entity.GenerationJob, sectionID uuid.UUID, outlineLesson entity.OutlineLesson, result *translatedLesson) error {
+	genLesson := &entity.GeneratedLesson{
+		ID:              uuid.New(),
+		TenantID:        job.TenantID,
+		CourseID:        *job.CourseID,
+		SectionID:       sectionID,
+		OutlineLessonID: outlineLesson.ID,
+		Title:           outlineLesson.Title,
+		SegueText:       result.segue,
+		GeneratedAt:     time.Now(),
+	}
+	if err := s.genLessonRepo.Create(ctx, genLesson); err != nil {
+		return fmt.Errorf("failed to create lesson: %w", err)
+	}
+
+	for _, component := range result.components {
+		component.TenantID = job.TenantID
+		component.LessonID = genLesson.ID
+		if err := s.componentRepo.Create(ctx, component); err != nil {
+			return fmt.Errorf("failed to create component: %w", err)
+		}
+	}
+	return nil
+}
+
+// recordTranslationTokens adds the tokens the translator has used since the last call
+// to the job and the tenant's usage.
+func (s *AIGenerationService) recordTranslationTokens(ctx context.Context, job *entity.GenerationJob, translator *textTranslator) {
+	tokens := translator.takeTokens()
+	if tokens == 0 {
+		return
+	}
+	job.TokensUsed += tokens
+	if err := s.aiSettingsRepo.IncrementTokenUsage(context.WithoutCancel(ctx), job.TenantID, tokens); err != nil {
+		s.logger.Warn("failed to record translation token usage", "jobID", job.ID, "error", err)
+	}
+}
+
+// textTranslator translates strings in provider-sized batches and totals the tokens used.
+type textTranslator struct {
+	provider   service.AIProvider
+	language   string
+	context    string
+	tokensUsed int64
+}
+
+// translationSlot is a string to translate and where its translation goes.
+type translationSlot struct {
+	text string
+	set  func(string)
+}
+
+// translate translates every slot's text and stores the results through the slots.
+// Blank texts are left as they are.
+func (t *textTranslator) translate(ctx context.Context, slots []translationSlot) error {
+	pending := make([]translationSlot, 0, len(slots))
+	for _, slot := range slots {
+		if strings.TrimSpace(slot.text) != "" {
+			pending = append(pending, slot)
+		}
+	}
+
+	for start := 0; start < len(pending); start += translationBatchSize {
+		batch := pending[start:min(start+translationBatchSize, len(pending))]
+		texts := make([]string, len(batch))
+		for i, slot := range batch {
+			texts[i] = slot.text
+		}
+
+		result, err := t.provider.TranslateTexts(ctx, service.TranslateTextsRequest{
+			TargetLanguage: t.language,
+			Context:        t.context,
+			Texts:          texts,
+		})
+		if err != nil {
+			return err
+		}
+		t.tokensUsed += result.TokensUsed
+		if len(result.Texts) != len(batch) {
+			return fmt.Errorf("provider returned %d translations for %d texts", len(result.Texts), len(batch))
+		}
+		for i, slot := range batch {
+			slot.set(result.Texts[i])
+		}
+	}
+	return nil
+}
+
+// takeTokens returns the tokens used since it was last called.
+func (t *textTranslator) takeTokens() int64 {
+	tokens := t.tokensUsed
+	t.tokensUsed = 0
+	return tokens
+}
+
+// translatedLesson holds a lesson's translated components, not yet stored, and segue.
+type translatedLesson struct {
+	components []*entity.LessonComponent
+	segue      *string
+}
+
+// translateLesson translates the text of a lesson's components and its segue. The
+// components keep their type, position, content fields and alignment metadata; a quiz
+// whose answer key doesn't survive translation returns errQuizIntegrity.
+func translateLesson(ctx context.Context, translator *textTranslator, lesson *entity.GeneratedLesson, components []*entity.LessonComponent) (*translatedLesson, error) {
+	result := &translatedLesson{}
+	contents := make([]map[string]any, len(components))
+	var slots []translationSlot
+	for i, component := range components {
+		content, err := decodeComponentContent(component.ContentJSON)
+		if err != nil {
+			return nil, fmt.Errorf("component %s: %w", component.ID, err)
+		}
+		contents[i] = content
+		slots = append(slots, componentTranslationSlots(component.Type, content)...)
+	}
+	if lesson.SegueText != nil {
+		segue := *lesson.SegueText
+		result.segue = &segue
+		slots = append(slots, translationSlot{text: segue, set: func(v string) { *result.segue = v }})
+	}
+
+	if err := translator.translate(ctx, slots); err != nil {
+		return nil, err
+	}
+
+	for i, component := range components {
+		if component.Type == valueobject.LessonComponentTypeQuiz {
+			source, _ := decodeComponentContent(component.ContentJSON)
+			if err := checkQuizIntegrity(source, contents[i]); err != nil {
+				return nil, err
+			}
+		}
+		contentJSON, err := json.Marshal(contents[i])
+		if err != nil {
+			return nil, fmt.Errorf("component %s: %w", component.ID, err)
+		}
+		result.components = append(result.components, &entity.LessonComponent{
+			ID:                   uuid.New(),
+			Type:                 component.Type,
+			Position:             component.Position,
+			ContentJSON:          contentJSON,
+			SMEChunkIDs:          component.SMEChunkIDs,
+			LearningObjectiveIDs: component.LearningObjectiveIDs,
+			CreatedAt:            time.Now(),
+			UpdatedAt:            time.Now(),
+		})
+	}
+	return result, nil
+}
+
+// decodeComponentContent decodes a component's content, keeping numbers as written.
+func decodeComponentContent(contentJSON json.RawMessage) (map[string]any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(contentJSON))
+	decoder.UseNumber()
+	var content map[string]any
+	if err := decoder.Decode(&content); err != nil {
+		return nil, fmt.Errorf("invalid component JSON: %w", err)
+	}
+	return content, nil
+}
+
+// componentTranslationSlots returns the text fields of a component's decoded content,
+// including each quiz option's text.
+func componentTranslationSlots(componentType valueobject.LessonComponentType, content map[string]any) []translationSlot {
+	var slots []translationSlot
+	for _, field := range componentTranslatableFields[componentType] {
+		if text, ok := content[field].(string); ok {
+			slots = append(slots, translationSlot{text: text, set: func(v string) { content[field] = v }})
+		}
+	}
+
+	if componentType == valueobject.LessonComponentTypeQuiz {
+		options, _ := content["options"].([]any)
+		for _, o := range options {
+			option, ok := o.(map[string]any)
+			if !ok {
+				continue
+			}
+			if text, ok := option["text"].(string); ok {
+				slots = append(slots, translationSlot{text: text, set: func(v string) { option["text"] = v }})
+			}
+		}
+	}
+	return slots
+}
+
+// checkQuizIntegrity verifies a translated quiz kept its answer key: a question, the same
+// grading settings, the same options with the same IDs and correct flags in the same
+// order, the correct answer among them, and option texts that are not blank and no more
+// alike than they were before translation.
+func checkQuizIntegrity(source, translated map[string]any) error {
+	if question, _ := translated["question"].(string); strings.TrimSpace(question) == "" {
+		return fmt.Errorf("%w: question is blank", errQuizIntegrity)
+	}
+
+	for _, field := range quizGradingFields {
+		if fmt.Sprint(translated[field]) != fmt.Sprint(source[field]) {
+			return fmt.Errorf("%w: %s changed", errQuizIntegrity, field)
+		}
+	}
+
+	correctID, _ := source["correct_answer_id"].(string)
+	if translatedID, _ := translated["correct_answer_id"].(string); translatedID != correctID {
+		return fmt.Errorf("%w: correct answer changed", errQuizIntegrity)
+	}
+
+	sourceOptions, _ := source["options"].([]any)
+	translatedOptions, _ := translated["options"].([]any)
+	if len(translatedOptions) != len(sourceOptions) {
+		return fmt.Errorf("%w: %d options became %d", errQuizIntegrity, len(sourceOptions), len(translatedOptions))
+	}
+
+	correctFound := false
+	seen := make(map[string]string, len(translatedOptions)) // Translated text to source text
+	for i := range sourceOptions {
+		sourceOption, _ := sourceOptions[i].(map[string]any)
+		translatedOption, _ := translatedOptions[i].(map[string]any)
+		sourceID, _ := sourceOption["id"].(string)
+		translatedID, _ := translatedOption["id"].(string)
+		if translatedID != sourceID {
+			return fmt.Errorf("%w: option %d changed ID", errQuizIntegrity, i+1)
+		}
+		if translatedID == correctID {
+			correctFound = true
+		}
+		if fmt.Sprint(translatedOption["correct"]) != fmt.Sprint(sourceOption["correct"]) {
+			return fmt.Errorf("%w: option %d changed correctness", errQuizIntegrity, i+1)
+		}
+
+		sourceText, _ := sourceOption["text"].(string)
+		text, _ := translatedOption["text"].(string)
+		key := strings.ToLower(strings.TrimSpace(text))
+		if key == "" {
+			return fmt.Errorf("%w: option %d is blank", errQuizIntegrity, i+1)
+		}
+		if other, ok := seen[key]; ok && other != sourceText {
+			return fmt.Errorf("%w: option %d translates to the same text as another option", errQuizIntegrity, i+1)
+		}
+		seen[key] = sourceText
+	}
+	if correctID != "" && !correctFound {
+		return fmt.Errorf("%w: correct answer is not an option", errQuizIntegrity)
+	}
+	return nil
+}
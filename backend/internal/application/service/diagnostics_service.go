@@ -0,0 +1,352 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+	"github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/domain/tenant"
+	"github.com/sogos/mirai-backend/internal/infrastructure/cache"
+)
+
+// diagnosticsProbeTimeout bounds each dependency check, so a dead dependency shows up as
+// a failed check instead of hanging the diagnostics call. Checks run in parallel, so the
+// whole call takes about this long at worst.
+const diagnosticsProbeTimeout = 2 * time.Second
+
+// storageProbePath is looked up to check the object store is reachable. It doesn't
+// need to exist; only errors count.
+const storageProbePath = "diagnostics/probe.json"
+
+// Pinger checks that a dependency is reachable, e.g. *sql.DB.
+type Pinger interface {
+	PingContext(ctx context.Context) error
+}
+
+// StorageProber is the part of the storage adapter the storage check uses.
+type StorageProber interface {
+	Exists(ctx context.Context, path string) (bool, error)
+}
+
+// WorkerInspector reads the state of the background worker fleet from Redis.
+// Implementations don't take a context, so calls are bounded by the caller.
+type WorkerInspector interface {
+	// PingRedis checks Redis is reachable.
+	PingRedis(ctx context.Context) error
+
+	// Servers lists the worker servers that have reported in recently.
+	Servers() ([]WorkerServerInfo, error)
+
+	// QueueDepths reports the size of each task queue.
+	QueueDepths() ([]QueueDepth, error)
+
+	// ScheduledTasks lists the periodic tasks registered with a running scheduler.
+	ScheduledTasks() ([]ScheduledTaskEntry, error)
+}
+
+// WorkerServerInfo describes a running worker server.
+type WorkerServerInfo struct {
+	Host          string
+	PID           int
+	Concurrency   int
+	ActiveWorkers int // Tasks being processed right now
+	Status        string
+	StartedAt     time.Time
+}
+
+// QueueDepth is the number of tasks in a queue, by state.
+type QueueDepth struct {
+	Queue     string
+	Pending   int
+	Active    int
+	Scheduled int
+	Retry     int
+	Archived  int
+	Latency   time.Duration // Age of the oldest pending task
+	Paused    bool
+}
+
+// ScheduledTaskEntry is a periodic task registered with the scheduler.
+type ScheduledTaskEntry struct {
+	TaskType       string
+	Schedule       string // e.g. "@every 5m"
+	NextEnqueueAt  time.Time
+	LastEnqueuedAt time.Time // Zero if not enqueued since the scheduler started
+}
+
+// BuildInfo identifies the running build.
+type BuildInfo struct {
+	Version   string
+	Commit    string
+	GoVersion string
+}
+
+// ComponentHealth is the outcome of one dependency check.
+type ComponentHealth struct {
+	Healthy bool
+	Latency time.Duration
+	Error   string
+}
+
+// ScheduledTaskStatus combines a task's schedule with its last successful run. Tasks
+// that ran but aren't scheduled, such as provisioning, have no schedule.
+type ScheduledTaskStatus struct {
+	TaskType        string
+	Schedule        string
+	NextEnqueueAt   *time.Time
+	LastEnqueuedAt  *time.Time
+	LastSucceededAt *time.Time
+	LastDuration    time.Duration
+	WorkerHost      string // Worker that ran the last successful run
+}
+
+// SystemDiagnostics is a snapshot of the backend's dependencies and background services.
+// Sections that couldn't be read carry an error instead of failing the whole report.
+type SystemDiagnostics struct {
+	CheckedAt time.Time
+	Build     BuildInfo
+
+	Database ComponentHealth
+	Redis    ComponentHealth
+	Storage  ComponentHealth
+
+	WorkerUp      bool // At least one worker server is active
+	WorkerServers []WorkerServerInfo
+	WorkerError   string
+
+	Queues      []QueueDepth
+	QueuesError string
+
+	ScheduledTasks      []ScheduledTaskStatus
+	ScheduledTasksError string
+
+	CacheStats *cache.StatsSnapshot // Hit rates counted by this server process; nil if not configured
+}
+
+// DiagnosticsService reports on the backend's dependencies and background services, so
+// support can tell whether the worker and its sweeps are running without shell access.
+type DiagnosticsService struct {
+	superAdmins SuperAdminChecker
+	database    Pinger
+	storage     StorageProber
+	workers     WorkerInspector
+	heartbeats  repository.TaskHeartbeatRepository
+	cacheStats  func() cache.StatsSnapshot
+	build       BuildInfo
+	logger      service.Logger
+}
+
+// NewDiagnosticsService creates a new diagnostics service.
+func NewDiagnosticsService(
+	superAdmins SuperAdminChecker,
+	database Pinger,
+	storage StorageProber,
+	workers WorkerInspector,
+	heartbeats repository.TaskHeartbeatRepository,
+	build BuildInfo,
+	logger service.Logger,
+) *DiagnosticsService {
+	return &DiagnosticsService{
+		superAdmins: superAdmins,
+		database:    database,
+		storage:     storage,
+		workers:     workers,
+		heartbeats:  heartbeats,
+		build:       build,
+		logger:      logger,
+	}
+}
+
+// SetCacheStats adds the cache hit rates returned by stats to diagnostics.
+func (s *DiagnosticsService) SetCacheStats(stats func() cache.StatsSnapshot) {
+	s.cacheStats = stats
+}
+
+// GetSystemDiagnostics checks every dependency in parallel, each with a short timeout, and
+// reports what it found. A failing dependency is reported, not returned as an error.
+// Superadmin only.
+func (s *DiagnosticsService) GetSystemDiagnostics(ctx context.Context, email string) (*SystemDiagnostics, error) {
+	if s.superAdmins == nil || !s.superAdmins.IsSuperAdmin(email) {
+		return nil, domainerrors.ErrForbidden.WithMessage("superadmin access required")
+	}
+
+	report := &SystemDiagnostics{
+		CheckedAt: time.Now(),
+		Build:     s.build,
+	}
+
+	var (
+		wg        sync.WaitGroup
+		schedules []ScheduledTaskEntry
+		schedErr  error
+		beats     []*entity.TaskHeartbeat
+		beatsErr  error
+	)
+	run := func(fn func()) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			fn()
+		}()
+	}
+
+	run(func() {
+		report.Database = checkComponent(ctx, s.database.PingContext)
+	})
+	run(func() {
+		report.Redis = checkComponent(ctx, s.workers.PingRedis)
+	})
+	run(func() {
+		report.Storage = checkComponent(ctx, func(ctx context.Context) error {
+			_, err := s.storage.Exists(ctx, storageProbePath)
+			return err
+		})
+	})
+	run(func() {
+		servers, err := withProbeTimeout(ctx, func(context.Context) ([]WorkerServerInfo, error) {
+			return s.workers.Servers()
+		})
+		if err != nil {
+			report.WorkerError = err.Error()
+			return
+		}
+		report.WorkerServers = servers
+		for _, server := range servers {
+			if server.Status == "active" {
+				report.WorkerUp = true
+			}
+		}
+	})
+	run(func() {
+		queues, err := withProbeTimeout(ctx, func(context.Context) ([]QueueDepth, error) {
+			return s.workers.QueueDepths()
+		})
+		if err != nil {
+			report.QueuesError = err.Error()
+			return
+		}
+		report.Queues = queues
+	})
+	run(func() {
+		schedules, schedErr = withProbeTimeout(ctx, func(context.Context) ([]ScheduledTaskEntry, error) {
+			return s.workers.ScheduledTasks()
+		})
+	})
+	run(func() {
+		beats, beatsErr = withProbeTimeout(ctx, s.listHeartbeats)
+	})
+	wg.Wait()
+
+	report.ScheduledTasks = mergeScheduledTasks(schedules, beats)
+	switch {
+	case schedErr != nil && beatsErr != nil:
+		report.ScheduledTasksError = fmt.Sprintf("scheduler: %v; heartbeats: %v", schedErr, beatsErr)
+	case schedErr != nil:
+		report.ScheduledTasksError = "scheduler: " + schedErr.Error()
+	case beatsErr != nil:
+		report.ScheduledTasksError = "heartbeats: " + beatsErr.Error()
+	}
+
+	if s.cacheStats != nil {
+		snapshot := s.cacheStats()
+		report.CacheStats = &snapshot
+	}
+
+	s.logger.Info("system diagnostics requested",
+		"actor", email,
+		"database", report.Database.Healthy,
+		"redis", report.Redis.Healthy,
+		"storage", report.Storage.Healthy,
+		"workerUp", report.WorkerUp,
+	)
+	return report, nil
+}
+
+// listHeartbeats reads every task's last successful run. Heartbeats are system-wide rows.
+func (s *DiagnosticsService) listHeartbeats(ctx context.Context) ([]*entity.TaskHeartbeat, error) {
+	return s.heartbeats.List(tenant.WithSuperAdmin(ctx, true))
+}
+
+// mergeScheduledTasks joins scheduler entries and heartbeats by task type, sorted by type.
+func mergeScheduledTasks(schedules []ScheduledTaskEntry, beats []*entity.TaskHeartbeat) []ScheduledTaskStatus {
+	byType := make(map[string]*ScheduledTaskStatus)
+	status := func(taskType string) *ScheduledTaskStatus {
+		if st, ok := byType[taskType]; ok {
+			return st
+		}
+		st := &ScheduledTaskStatus{TaskType: taskType}
+		byType[taskType] = st
+		return st
+	}
+
+	for _, entry := range schedules {
+		st := status(entry.TaskType)
+		st.Schedule = entry.Schedule
+		if !entry.NextEnqueueAt.IsZero() {
+			next := entry.NextEnqueueAt
+			st.NextEnqueueAt = &next
+		}
+		if !entry.LastEnqueuedAt.IsZero() {
+			last := entry.LastEnqueuedAt
+			st.LastEnqueuedAt = &last
+		}
+	}
+	for _, beat := range beats {
+		st := status(beat.TaskType)
+		succeededAt := beat.LastSucceededAt
+		st.LastSucceededAt = &succeededAt
+		st.LastDuration = beat.LastDuration
+		st.WorkerHost = beat.WorkerHost
+	}
+
+	tasks := make([]ScheduledTaskStatus, 0, len(byType))
+	for _, st := range byType {
+		tasks = append(tasks, *st)
+	}
+	sort.Slice(tasks, func(i, j int) bool { return tasks[i].TaskType < tasks[j].TaskType })
+	return tasks
+}
+
+// checkComponent runs a dependency check with the probe timeout and times it.
+func checkComponent(ctx context.Context, check func(ctx context.Context) error) ComponentHealth {
+	start := time.Now()
+	_, err := withProbeTimeout(ctx, func(ctx context.Context) (struct{}, error) {
+		return struct{}{}, check(ctx)
+	})
+	health := ComponentHealth{Healthy: err == nil, Latency: time.Since(start)}
+	if err != nil {
+		health.Error = err.Error()
+	}
+	return health
+}
+
+// withProbeTimeout runs fn with the probe timeout. If fn ignores its context, the caller
+// stops waiting at the deadline and fn finishes in the background.
+func withProbeTimeout[T any](ctx context.Context, fn func(ctx context.Context) (T, error)) (T, error) {
+	ctx, cancel := context.WithTimeout(ctx, diagnosticsProbeTimeout)
+	defer cancel()
+
+	type result struct {
+		value T
+		err   error
+	}
+	done := make(chan result, 1)
+	go func() {
+		value, err := fn(ctx)
+		done <- result{value, err}
+	}()
+
+	select {
+	case r := <-done:
+		return r.value, r.err
+	case <-ctx.Done():
+		var zero T
+		return zero, fmt.Errorf("timed out after %s", diagnosticsProbeTimeout)
+	}
+}
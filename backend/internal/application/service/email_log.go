@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+)
+
+const (
+	// emailBounceThreshold is how many hard bounces in a row flag a user's address.
+	emailBounceThreshold = 3
+
+	// defaultEmailLogPageSize and maxEmailLogPageSize bound a ListEmailLog page.
+	defaultEmailLogPageSize = 50
+	maxEmailLogPageSize     = 200
+)
+
+// errEmailBouncing is returned instead of sending to an address flagged as hard-bouncing.
+var errEmailBouncing = errors.New("email address is flagged as bouncing")
+
+// SetEmailLog enables bounce tracking from the email log and listing it.
+func (s *NotificationService) SetEmailLog(repo repository.EmailLogRepository) {
+	s.emailLogRepo = repo
+}
+
+// sendUserEmail calls send unless the user's address is flagged as hard-bouncing, then
+// updates the flag from the address's latest outcomes in the email log. Without a user
+// the email is sent untracked.
+func (s *NotificationService) sendUserEmail(ctx context.Context, user *entity.User, address string, send func() error) error {
+	if user == nil {
+		return send()
+	}
+	if user.BouncedEmail != nil && strings.EqualFold(*user.BouncedEmail, address) {
+		return errEmailBouncing
+	}
+
+	err := send()
+	s.updateEmailBounce(ctx, user, address)
+	return err
+}
+
+// updateEmailBounce flags the user when the last emailBounceThreshold emails to address
+// all hard-bounced, and clears an existing flag otherwise.
+func (s *NotificationService) updateEmailBounce(ctx context.Context, user *entity.User, address string) {
+	if s.emailLogRepo == nil {
+		return
+	}
+	log := s.logger.With("userID", user.ID)
+
+	entries, err := s.emailLogRepo.ListRecentByRecipient(ctx, address, emailBounceThreshold)
+	if err != nil {
+		log.Warn("failed to read email log", "error", err)
+		return
+	}
+	bouncing := len(entries) == emailBounceThreshold
+	for _, entry := range entries {
+		if !entry.Status.IsHardBounce() {
+			bouncing = false
+		}
+	}
+
+	switch {
+	case bouncing:
+		if err := s.userRepo.SetEmailBounce(ctx, user.ID, &address); err != nil {
+			log.Error("failed to flag bouncing email", "error", err)
+			return
+		}
+		log.Warn("email address flagged as bouncing; email notifications stopped", "emails", emailBounceThreshold)
+	case !bouncing && user.BouncedEmail != nil:
+		if err := s.userRepo.SetEmailBounce(ctx, user.ID, nil); err != nil {
+			log.Error("failed to clear bouncing email flag", "error", err)
+			return
+		}
+		log.Info("bouncing email flag cleared")
+	}
+}
+
+// EmailLogFilter selects email log entries. Nil fields match all entries.
+type EmailLogFilter struct {
+	Recipient *string
+	Template  *string
+}
+
+// ListEmailLogResult contains a page of email log entries.
+type ListEmailLogResult struct {
+	Entries    []*entity.EmailLogEntry
+	NextCursor string
+}
+
+// ListEmailLog returns the outcomes of emails sent for the caller's tenant, most recent
+// first. Admins and owners only.
+func (s *NotificationService) ListEmailLog(ctx context.Context, kratosID uuid.UUID, filter EmailLogFilter, cursor string, limit int) (*ListEmailLogResult, error) {
+	if s.emailLogRepo == nil {
+		return nil, domainerrors.ErrInternal.WithMessage("email log is not configured")
+	}
+
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, domainerrors.ErrUserNotFound
+	}
+	if !user.CanManageSettings() {
+		return nil, domainerrors.ErrForbidden.WithMessage("only admins and owners can view the email log")
+	}
+	if user.TenantID == nil {
+		return nil, domainerrors.ErrUserHasNoCompany
+	}
+
+	if limit <= 0 {
+		limit = defaultEmailLogPageSize
+	}
+	if limit > maxEmailLogPageSize {
+		limit = maxEmailLogPageSize
+	}
+
+	opts := entity.EmailLogListOptions{
+		Recipient: filter.Recipient,
+		Template:  filter.Template,
+		Limit:     limit,
+	}
+	if cursor != "" {
+		opts.Cursor = &cursor
+	}
+
+	entries, err := s.emailLogRepo.List(ctx, opts)
+	if err != nil {
+		s.logger.Error("failed to list email log", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	var nextCursor string
+	if len(entries) == limit {
+		last := entries[len(entries)-1]
+		nextCursor = fmt.Sprintf("%s|%s", last.SentAt.Format(time.RFC3339Nano), last.ID.String())
+	}
+
+	return &ListEmailLogResult{Entries: entries, NextCursor: nextCursor}, nil
+}
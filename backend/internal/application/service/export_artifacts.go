@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+	"github.com/sogos/mirai-backend/internal/domain/tenant"
+)
+
+// exportPurgeBatchSize is how many expired artifacts one cleanup run deletes. The rest
+// wait for the next run.
+const exportPurgeBatchSize = 500
+
+// ExportArtifactStorage deletes export files from tenant storage.
+type ExportArtifactStorage interface {
+	BuildPath(tenantID uuid.UUID, subpath string) string
+	DeleteTenantObject(ctx context.Context, tenantID uuid.UUID, objectPath string) error
+}
+
+// ExportRetentionProvider reports how many days a tenant keeps its export artifacts.
+type ExportRetentionProvider interface {
+	ExportRetentionDays(ctx context.Context, tenantID uuid.UUID) int
+}
+
+// ExportArtifactRecorder records the file a finished export job produced.
+type ExportArtifactRecorder interface {
+	RecordExportArtifact(ctx context.Context, artifact *entity.ExportArtifact) error
+}
+
+// SetExportArtifacts enables export artifact retention: finished exports are recorded
+// with an expiry, superseded and expired files are deleted, and download links to a
+// deleted file say why. Without a retention provider, every tenant keeps artifacts for
+// entity.DefaultExportRetentionDays.
+func (s *ExportDownloadService) SetExportArtifacts(artifacts repository.ExportArtifactRepository, storage ExportArtifactStorage, retention ExportRetentionProvider) {
+	s.artifacts = artifacts
+	s.artifactStorage = storage
+	s.retention = retention
+}
+
+// RecordExportArtifact stores a finished export's file with an expiry from the tenant's
+// retention period, then purges the earlier unpurged artifacts of the same type for the
+// same course or SME. Changing the retention period doesn't move existing expiries.
+func (s *ExportDownloadService) RecordExportArtifact(ctx context.Context, artifact *entity.ExportArtifact) error {
+	if s.artifacts == nil {
+		return nil
+	}
+	log := s.logger.With("jobID", artifact.JobID, "tenantID", artifact.TenantID, "type", artifact.Type)
+	ctx = tenant.WithTenantID(ctx, artifact.TenantID)
+
+	days := entity.DefaultExportRetentionDays
+	if s.retention != nil {
+		days = s.retention.ExportRetentionDays(ctx, artifact.TenantID)
+	}
+	artifact.ExpiresAt = time.Now().AddDate(0, 0, days)
+	if err := s.artifacts.Create(ctx, artifact); err != nil {
+		return err
+	}
+
+	superseded, err := s.artifacts.ListSuperseded(ctx, artifact)
+	if err != nil {
+		log.Warn("failed to list superseded export artifacts", "error", err)
+		return nil
+	}
+	for _, previous := range superseded {
+		if err := s.purgeArtifact(ctx, previous, entity.ExportPurgeReasonSuperseded); err != nil {
+			log.Warn("failed to purge superseded export artifact", "artifactID", previous.ID, "error", err)
+		}
+	}
+	if len(superseded) > 0 {
+		log.Info("superseded earlier export artifacts", "count", len(superseded))
+	}
+	return nil
+}
+
+// PurgeExpiredArtifacts deletes the files of artifacts whose retention period has ended,
+// in every tenant, and marks them purged. Files that fail to delete are left for the
+// next run. Returns how many were purged.
+func (s *ExportDownloadService) PurgeExpiredArtifacts(ctx context.Context) (int, error) {
+	if s.artifacts == nil {
+		return 0, nil
+	}
+
+	// Artifacts belong to every tenant; the job runs without a user session
+	expired, err := s.artifacts.ListExpired(tenant.WithSuperAdmin(ctx, true), time.Now(), exportPurgeBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	for _, artifact := range expired {
+		if ctx.Err() != nil {
+			return purged, ctx.Err()
+		}
+		if err := s.purgeArtifact(tenant.WithTenantID(ctx, artifact.TenantID), artifact, entity.ExportPurgeReasonExpired); err != nil {
+			s.logger.Warn("failed to purge expired export artifact", "artifactID", artifact.ID, "tenantID", artifact.TenantID, "error", err)
+			continue
+		}
+		purged++
+	}
+	return purged, nil
+}
+
+// purgeArtifact deletes an artifact's file and marks it purged. The file is deleted
+// first, so an artifact is never marked purged while its file remains.
+func (s *ExportDownloadService) purgeArtifact(ctx context.Context, artifact *entity.ExportArtifact, reason entity.ExportPurgeReason) error {
+	if s.artifactStorage == nil {
+		return domainerrors.ErrInternal.WithMessage("export artifact storage is not configured")
+	}
+	objectPath := s.artifactStorage.BuildPath(artifact.TenantID, artifact.FilePath)
+	if err := s.artifactStorage.DeleteTenantObject(ctx, artifact.TenantID, objectPath); err != nil {
+		return err
+	}
+	return s.artifacts.MarkPurged(ctx, artifact.ID, reason)
+}
+
+// purgedExportError explains why a purged artifact can't be downloaded.
+func purgedExportError(artifact *entity.ExportArtifact) error {
+	if artifact.PurgeReason != nil && *artifact.PurgeReason == entity.ExportPurgeReasonSuperseded {
+		return domainerrors.ErrExportArtifactPurged.WithMessage(
+			"This export was replaced by a newer export and its file has been deleted. Download the latest export in Mirai instead.")
+	}
+	return domainerrors.ErrExportArtifactPurged.WithMessage(
+		"This export's file was deleted when its retention period ended on " + artifact.ExpiresAt.UTC().Format("January 2, 2006") +
+			". Export it again from Mirai to download it.")
+}
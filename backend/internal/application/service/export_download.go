@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+	"github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/domain/tenant"
+)
+
+// exportRedirectURLExpiry is how long the presigned URL behind a download link is valid.
+// It only has to last until the browser follows the redirect.
+const exportRedirectURLExpiry = 5 * time.Minute
+
+// ExportDownloadPath is the backend route that resolves emailed export download links.
+const ExportDownloadPath = "/api/v1/exports/download/"
+
+// ExportDownloadStorage presigns export files for download.
+type ExportDownloadStorage interface {
+	GenerateDownloadURL(ctx context.Context, tenantID uuid.UUID, subpath string, expiry time.Duration) (string, error)
+}
+
+// ExportDownloadService issues the signed download links sent in export emails and resolves
+// them. A link points at the backend rather than at storage, so every download is recorded
+// in the export's access log before the browser is sent on to a short-lived presigned URL.
+type ExportDownloadService struct {
+	links      repository.ExportDownloadLinkRepository
+	storage    ExportDownloadStorage
+	backendURL string
+	logger     service.Logger
+
+	// Optional: export artifact retention
+	artifacts       repository.ExportArtifactRepository
+	artifactStorage ExportArtifactStorage
+	retention       ExportRetentionProvider
+}
+
+// NewExportDownloadService creates a new export download service. backendURL is the public
+// address of this server, which the links point at.
+func NewExportDownloadService(
+	links repository.ExportDownloadLinkRepository,
+	storage ExportDownloadStorage,
+	backendURL string,
+	logger service.Logger,
+) *ExportDownloadService {
+	return &ExportDownloadService{
+		links:      links,
+		storage:    storage,
+		backendURL: strings.TrimSuffix(backendURL, "/"),
+		logger:     logger,
+	}
+}
+
+// IssueDownloadLink creates a link to an export file for the user, valid for
+// entity.ExportDownloadLinkTTL from now. Returns the link's URL and expiry.
+func (s *ExportDownloadService) IssueDownloadLink(ctx context.Context, tenantID, jobID, userID uuid.UUID, filePath string) (string, time.Time, error) {
+	token, err := generateSecureToken()
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to generate download token: %w", err)
+	}
+
+	link := &entity.ExportDownloadLink{
+		Token:     token,
+		TenantID:  tenantID,
+		JobID:     jobID,
+		UserID:    userID,
+		FilePath:  filePath,
+		ExpiresAt: time.Now().Add(entity.ExportDownloadLinkTTL),
+	}
+	if err := s.links.Create(tenant.WithTenantID(ctx, tenantID), link); err != nil {
+		return "", time.Time{}, err
+	}
+	return s.backendURL + ExportDownloadPath + token, link.ExpiresAt, nil
+}
+
+// ResolveDownloadLink records a download through an emailed link and returns a presigned
+// URL for the export file. Links carry no session, so the token is looked up across
+// tenants; the access is logged under the link's tenant. A link to a purged artifact
+// fails with ErrExportArtifactPurged saying why the file is gone.
+func (s *ExportDownloadService) ResolveDownloadLink(ctx context.Context, token, ipAddress, userAgent string) (string, error) {
+	if token == "" {
+		return "", domainerrors.ErrNotFound.WithMessage("download link not found")
+	}
+
+	link, err := s.links.GetByToken(tenant.WithSuperAdmin(ctx, true), token)
+	if err != nil {
+		s.logger.Error("failed to get export download link", "error", err)
+		return "", domainerrors.ErrInternal.WithCause(err)
+	}
+	if link == nil {
+		return "", domainerrors.ErrNotFound.WithMessage("download link not found")
+	}
+	if link.IsExpired() {
+		return "", domainerrors.ErrForbidden.WithMessage("download link has expired")
+	}
+
+	log := s.logger.With("jobID", link.JobID, "tenantID", link.TenantID)
+	ctx = tenant.WithTenantID(ctx, link.TenantID)
+
+	if s.artifacts != nil {
+		artifact, err := s.artifacts.GetByJobID(ctx, link.JobID)
+		if err != nil {
+			log.Error("failed to get export artifact", "error", err)
+			return "", domainerrors.ErrInternal.WithCause(err)
+		}
+		if artifact != nil && artifact.IsPurged() {
+			return "", purgedExportError(artifact)
+		}
+	}
+
+	// The download isn't handed out unless it was logged
+	access := &entity.ExportAccess{
+		TenantID:  link.TenantID,
+		JobID:     link.JobID,
+		Token:     link.Token,
+		IPAddress: ipAddress,
+		UserAgent: userAgent,
+	}
+	if err := s.links.RecordAccess(ctx, access); err != nil {
+		log.Error("failed to record export access", "error", err)
+		return "", domainerrors.ErrInternal.WithCause(err)
+	}
+
+	url, err := s.storage.GenerateDownloadURL(ctx, link.TenantID, link.FilePath, exportRedirectURLExpiry)
+	if err != nil {
+		log.Error("failed to generate export download URL", "error", err)
+		return "", domainerrors.ErrInternal.WithCause(err)
+	}
+
+	log.Info("export downloaded through emailed link", "ip", ipAddress)
+	return url, nil
+}
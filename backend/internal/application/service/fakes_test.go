@@ -0,0 +1,17 @@
+package service
+
+import (
+	"context"
+
+	"github.com/sogos/mirai-backend/internal/domain/service"
+)
+
+// nopLogger discards everything logged to it.
+type nopLogger struct{}
+
+func (nopLogger) Debug(string, ...any)                         {}
+func (nopLogger) Info(string, ...any)                          {}
+func (nopLogger) Warn(string, ...any)                          {}
+func (nopLogger) Error(string, ...any)                         {}
+func (l nopLogger) With(...any) service.Logger                 { return l }
+func (l nopLogger) WithContext(context.Context) service.Logger { return l }
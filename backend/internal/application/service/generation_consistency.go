@@ -0,0 +1,267 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+	"github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/domain/tenant"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+const (
+	// unfinalizedParentGrace leaves time for the worker that ran the last child job to
+	// finalize the parent itself before the sweeper steps in.
+	unfinalizedParentGrace = 10 * time.Minute
+
+	// childlessParentMinAge gives GenerateAllLessons time to queue child jobs before
+	// an open parent without children is treated as broken.
+	childlessParentMinAge = time.Hour
+
+	// completedParentLookback bounds how far back completed parents are checked for
+	// missing lessons, keeping each hourly run cheap. It overlaps the schedule so a
+	// missed run does not leave a gap.
+	completedParentLookback = 24 * time.Hour
+)
+
+// SuperAdminChecker reports whether an email belongs to a platform superadmin.
+type SuperAdminChecker interface {
+	IsSuperAdmin(email string) bool
+}
+
+// SetConsistencySweep enables the generation consistency sweeper and the anomaly list.
+func (s *AIGenerationService) SetConsistencySweep(
+	tenantRepo repository.TenantRepository,
+	anomalyRepo repository.JobAnomalyRepository,
+	admins SuperAdminChecker,
+	email service.EmailProvider,
+) {
+	s.tenantRepo = tenantRepo
+	s.anomalyRepo = anomalyRepo
+	s.superAdmins = admins
+	s.alertEmail = email
+}
+
+// GenerationConsistencyResult contains the outcome of a consistency sweep.
+type GenerationConsistencyResult struct {
+	// TenantsChecked is the number of tenants swept.
+	TenantsChecked int
+	// NewAnomalies are anomalies first detected in this run.
+	NewAnomalies []*entity.JobAnomaly
+	// FailedTenants is the number of tenants whose checks could not complete.
+	FailedTenants int
+}
+
+// SweepGenerationConsistency cross-checks generation jobs against course content for every
+// active tenant. Parents whose children have all ended are finalized, parents that never got
+// child jobs or lost some of them are failed, and completed parents whose course has no
+// lessons are flagged.
+// Each finding is recorded once as a job anomaly. Course generation locks left by runs
+// that have ended are released.
+func (s *AIGenerationService) SweepGenerationConsistency(ctx context.Context) (*GenerationConsistencyResult, error) {
+	log := s.logger.With("job", "generation-consistency")
+
+	if s.tenantRepo == nil || s.anomalyRepo == nil {
+		log.Warn("consistency sweep not configured, skipping")
+		return &GenerationConsistencyResult{}, nil
+	}
+
+	tenantIDs, err := s.tenantRepo.ListActiveIDs(tenant.WithSuperAdmin(ctx, true))
+	if err != nil {
+		log.Error("failed to list tenants", "error", err)
+		return nil, err
+	}
+
+	result := &GenerationConsistencyResult{}
+	for _, tenantID := range tenantIDs {
+		anomalies, err := s.sweepTenant(ctx, tenantID)
+		result.TenantsChecked++
+		result.NewAnomalies = append(result.NewAnomalies, anomalies...)
+		if err != nil {
+			log.Error("consistency sweep failed for tenant", "tenantID", tenantID, "error", err)
+			result.FailedTenants++
+		}
+	}
+
+	if len(result.NewAnomalies) > 0 {
+		log.Warn("generation anomalies detected", "count", len(result.NewAnomalies), "tenants", result.TenantsChecked)
+	}
+
+	return result, nil
+}
+
+// sweepTenant runs each check for a single tenant. Detection queries run with only the
+// tenant in context so RLS limits them to its rows; repairs reuse the worker's context.
+func (s *AIGenerationService) sweepTenant(ctx context.Context, tenantID uuid.UUID) ([]*entity.JobAnomaly, error) {
+	scopedCtx := tenant.WithTenantID(ctx, tenantID)
+	workerCtx := tenant.WithTenantID(tenant.WithSuperAdmin(ctx, true), tenantID)
+	log := s.logger.With("job", "generation-consistency", "tenantID", tenantID)
+
+	var found []*entity.JobAnomaly
+	record := func(job *entity.GenerationJob, anomalyType valueobject.JobAnomalyType, details string, resolved bool) {
+		anomaly := &entity.JobAnomaly{
+			TenantID: tenantID,
+			JobID:    job.ID,
+			CourseID: job.CourseID,
+			Type:     anomalyType,
+			Details:  details,
+			Resolved: resolved,
+		}
+		isNew, err := s.anomalyRepo.Record(scopedCtx, anomaly)
+		if err != nil {
+			log.Error("failed to record job anomaly", "jobID", job.ID, "type", anomalyType, "error", err)
+			return
+		}
+		if isNew {
+			found = append(found, anomaly)
+		}
+	}
+
+	// Parents left open after every child ended, e.g. the finalizing worker crashed
+	parents, err := s.jobRepo.ListUnfinalizedParents(scopedCtx, time.Now().Add(-unfinalizedParentGrace))
+	if err != nil {
+		return found, fmt.Errorf("failed to list unfinalized parents: %w", err)
+	}
+	for _, job := range parents {
+		err := s.checkAndCompleteParentJob(workerCtx, job.ID)
+		if err != nil {
+			log.Error("failed to finalize parent job", "jobID", job.ID, "error", err)
+		}
+		record(job, valueobject.JobAnomalyParentNotFinalized,
+			fmt.Sprintf("Parent job was still %s after all child jobs ended", job.Status), err == nil)
+	}
+
+	// Parents whose child jobs were never queued or have disappeared
+	childless, err := s.jobRepo.ListParentsWithoutChildren(scopedCtx, time.Now().Add(-childlessParentMinAge))
+	if err != nil {
+		return found, fmt.Errorf("failed to list parents without children: %w", err)
+	}
+	for _, job := range childless {
+		_ = s.failJob(workerCtx, job, "No lesson jobs were queued for this course")
+		record(job, valueobject.JobAnomalyParentMissingChildren,
+			fmt.Sprintf("Parent job created %s had no child jobs and was marked failed", job.CreatedAt.UTC().Format(time.RFC3339)), true)
+	}
+
+	// Parents with fewer lesson jobs than they queued; finalization waits for the missing
+	// ones, so the parent is failed once the rest have ended
+	missing, err := s.jobRepo.ListParentsMissingChildren(scopedCtx, time.Now().Add(-unfinalizedParentGrace))
+	if err != nil {
+		return found, fmt.Errorf("failed to list parents missing children: %w", err)
+	}
+	for _, job := range missing {
+		result, err := s.jobRepo.TryFinalizeParentJob(workerCtx, job.ID)
+		if err != nil || result == nil {
+			log.Error("failed to count parent job children", "jobID", job.ID, "error", err)
+			continue
+		}
+		if result.MissingCount == 0 {
+			continue
+		}
+		details := fmt.Sprintf("Parent job queued %d lesson jobs but %d were missing, and was marked failed", result.TotalCount, result.MissingCount)
+		_ = s.failJob(workerCtx, job, fmt.Sprintf("%d of %d lesson jobs were lost before they ran", result.MissingCount, result.TotalCount))
+		record(job, valueobject.JobAnomalyParentMissingChildren, details, true)
+	}
+
+	// Courses reported as generated that have nothing to show
+	empty, err := s.jobRepo.ListCompletedParentsWithoutLessons(scopedCtx, time.Now().Add(-completedParentLookback))
+	if err != nil {
+		return found, fmt.Errorf("failed to list completed parents without lessons: %w", err)
+	}
+	for _, job := range empty {
+		record(job, valueobject.JobAnomalyCompletedWithoutLessons,
+			"Full course generation completed but the course has no generated lessons", false)
+	}
+
+	// Courses still read-only after the run that locked them ended
+	s.releaseStaleGenerationLocks(scopedCtx, tenantID)
+
+	return found, nil
+}
+
+// SendGenerationAnomalyAlert emails admins the anomalies first detected by a sweep.
+func (s *AIGenerationService) SendGenerationAnomalyAlert(ctx context.Context, result *GenerationConsistencyResult) error {
+	if s.alertEmail == nil {
+		s.logger.Warn("email provider not configured, cannot send generation anomaly alert")
+		return nil
+	}
+
+	if result == nil || len(result.NewAnomalies) == 0 {
+		return nil
+	}
+
+	log := s.logger.With("job", "generation-anomaly-alert")
+	log.Info("sending generation anomaly alert", "count", len(result.NewAnomalies))
+
+	var details []string
+	unresolved := 0
+	for _, anomaly := range result.NewAnomalies {
+		status := "fixed automatically"
+		if !anomaly.Resolved {
+			status = "needs attention"
+			unresolved++
+		}
+		detail := "Type: " + anomaly.Type.String() + " (" + status + ")\n" +
+			"  Tenant: " + anomaly.TenantID.String() + "\n" +
+			"  Job: " + anomaly.JobID.String()
+		if anomaly.CourseID != nil {
+			detail += "\n  Course: " + anomaly.CourseID.String()
+		}
+		detail += "\n  Details: " + anomaly.Details
+		details = append(details, detail)
+	}
+
+	subject := "[INFO] Mirai: Generation Anomalies Repaired"
+	if unresolved > 0 {
+		subject = "[WARNING] Mirai: Generation Anomalies Need Attention"
+	}
+
+	err := s.alertEmail.SendAlert(ctx, service.SendAlertRequest{
+		Subject: subject,
+		Body: fmt.Sprintf("The generation consistency sweep found %d new anomalies.\n\n", len(result.NewAnomalies)) +
+			strings.Join(details, "\n\n") +
+			"\n\n" +
+			"Anomalies are reported once. Use ListAnomalies to review earlier findings.",
+	})
+	if err != nil {
+		log.Error("failed to send generation anomaly alert", "error", err)
+		return err
+	}
+
+	log.Info("generation anomaly alert sent")
+	return nil
+}
+
+// ListJobAnomaliesRequest contains the filters for listing job anomalies.
+type ListJobAnomaliesRequest struct {
+	TenantID *uuid.UUID
+	Type     *valueobject.JobAnomalyType
+	Limit    int
+}
+
+// ListJobAnomalies returns recorded anomalies across tenants. Superadmin only.
+func (s *AIGenerationService) ListJobAnomalies(ctx context.Context, email string, req ListJobAnomaliesRequest) ([]*entity.JobAnomaly, error) {
+	if s.superAdmins == nil || !s.superAdmins.IsSuperAdmin(email) {
+		return nil, domainerrors.ErrForbidden.WithMessage("superadmin access required")
+	}
+	if s.anomalyRepo == nil {
+		return nil, nil
+	}
+
+	anomalies, err := s.anomalyRepo.List(tenant.WithSuperAdmin(ctx, true), entity.JobAnomalyListOptions{
+		TenantID: req.TenantID,
+		Type:     req.Type,
+		Limit:    req.Limit,
+	})
+	if err != nil {
+		s.logger.Error("failed to list job anomalies", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	return anomalies, nil
+}
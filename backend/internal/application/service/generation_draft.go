@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+)
+
+// SetGenerationDraftRepository enables saving generation wizard drafts.
+func (s *AIGenerationService) SetGenerationDraftRepository(draftRepo repository.GenerationDraftRepository) {
+	s.draftRepo = draftRepo
+}
+
+// SaveGenerationDraftRequest contains the wizard selections to keep for a course.
+type SaveGenerationDraftRequest struct {
+	CourseID          uuid.UUID
+	SMEIDs            []uuid.UUID
+	TargetAudienceIDs []uuid.UUID
+	DesiredOutcome    string
+	AdditionalContext string
+	Constraints       entity.OutlineConstraints
+}
+
+// SaveGenerationDraft stores the user's generation wizard selections for a course,
+// replacing any earlier draft. Selections aren't validated until generation starts.
+func (s *AIGenerationService) SaveGenerationDraft(ctx context.Context, kratosID uuid.UUID, req SaveGenerationDraftRequest) (*entity.GenerationDraft, error) {
+	if s.draftRepo == nil {
+		return nil, domainerrors.ErrInternal.WithMessage("generation drafts are not available")
+	}
+
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, domainerrors.ErrUserNotFound
+	}
+	if user.TenantID == nil {
+		return nil, domainerrors.ErrUserHasNoCompany
+	}
+
+	if err := s.checkCourseAccess(ctx, user, req.CourseID); err != nil {
+		return nil, err
+	}
+
+	draft := &entity.GenerationDraft{
+		TenantID:          *user.TenantID,
+		UserID:            user.ID,
+		CourseID:          req.CourseID,
+		SMEIDs:            req.SMEIDs,
+		TargetAudienceIDs: req.TargetAudienceIDs,
+		DesiredOutcome:    req.DesiredOutcome,
+		Constraints:       req.Constraints,
+	}
+	if req.AdditionalContext != "" {
+		draft.AdditionalContext = &req.AdditionalContext
+	}
+
+	if err := s.draftRepo.Upsert(ctx, draft); err != nil {
+		s.logger.Error("failed to save generation draft", "courseID", req.CourseID, "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	return draft, nil
+}
+
+// GetGenerationDraft returns the user's generation wizard draft for a course, or nil if
+// there is none.
+func (s *AIGenerationService) GetGenerationDraft(ctx context.Context, kratosID uuid.UUID, courseID uuid.UUID) (*entity.GenerationDraft, error) {
+	if s.draftRepo == nil {
+		return nil, nil
+	}
+
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, domainerrors.ErrUserNotFound
+	}
+
+	if err := s.checkCourseAccess(ctx, user, courseID); err != nil {
+		return nil, err
+	}
+
+	draft, err := s.draftRepo.Get(ctx, user.ID, courseID)
+	if err != nil {
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	return draft, nil
+}
+
+// clearGenerationDraft deletes the user's draft once a generation job has started from
+// it. A draft left behind is harmless, so failures are only logged.
+func (s *AIGenerationService) clearGenerationDraft(ctx context.Context, userID, courseID uuid.UUID) {
+	if s.draftRepo == nil {
+		return
+	}
+	if err := s.draftRepo.Delete(ctx, userID, courseID); err != nil {
+		s.logger.Warn("failed to clear generation draft", "courseID", courseID, "error", err)
+	}
+}
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+)
+
+// longInputHint points users with long source material at the flow built for it.
+const longInputHint = "For long documents, submit them to an SME through an SME task and select that SME instead."
+
+var (
+	horizontalSpaceRun = regexp.MustCompile(`[ \t\f\v\r]+`)
+	blankLineRun       = regexp.MustCompile(`\n{3,}`)
+)
+
+// SetGenerationInputLimits sets the longest desired outcome and additional context, in
+// characters, accepted for outline generation. Zero disables a limit.
+func (s *AIGenerationService) SetGenerationInputLimits(outcomeChars, contextChars int) {
+	s.maxOutcomeChars = outcomeChars
+	s.maxContextChars = contextChars
+}
+
+// normalizeGenerationInput trims and collapses whitespace in the free-text outline
+// inputs, then checks them against the configured limits. The desired outcome becomes
+// a single line; the additional context keeps its paragraph breaks.
+func (s *AIGenerationService) normalizeGenerationInput(req *GenerateCourseOutlineRequest) error {
+	req.DesiredOutcome = strings.Join(strings.Fields(req.DesiredOutcome), " ")
+	req.AdditionalContext = collapseWhitespace(req.AdditionalContext)
+
+	if err := checkInputLength("desired outcome", req.DesiredOutcome, s.maxOutcomeChars); err != nil {
+		return err
+	}
+	return checkInputLength("additional context", req.AdditionalContext, s.maxContextChars)
+}
+
+// collapseWhitespace trims text, collapses runs of spaces within lines, and keeps at most
+// one blank line between paragraphs.
+func collapseWhitespace(text string) string {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(horizontalSpaceRun.ReplaceAllString(line, " "))
+	}
+	return strings.TrimSpace(blankLineRun.ReplaceAllString(strings.Join(lines, "\n"), "\n\n"))
+}
+
+// checkInputLength rejects text longer than limit characters, naming both lengths.
+func checkInputLength(field, text string, limit int) error {
+	if limit <= 0 {
+		return nil
+	}
+	length := utf8.RuneCountInString(text)
+	if length <= limit {
+		return nil
+	}
+	return domainerrors.ErrInvalidInput.WithMessage(fmt.Sprintf(
+		"%s is %d characters; the limit is %d. %s", field, length, limit, longInputHint))
+}
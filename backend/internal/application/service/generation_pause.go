@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/audit"
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+	"github.com/sogos/mirai-backend/internal/domain/tenant"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+	"github.com/sogos/mirai-backend/internal/infrastructure/cache"
+)
+
+// SetAuditLogger enables audit logging of superadmin changes to a tenant's AI generation.
+func (s *AIGenerationService) SetAuditLogger(logger AuditLogger) {
+	s.auditLog = logger
+}
+
+// TenantAIGenerationStatus reports whether a tenant may generate and how many of its
+// jobs are waiting in the queue.
+type TenantAIGenerationStatus struct {
+	TenantID   uuid.UUID
+	Enabled    bool
+	QueuedJobs int // Paused while generation is disabled; re-enqueued when it is enabled
+}
+
+// SetTenantAIEnabled lets a superadmin pause or resume a tenant's AI generation, for
+// instance when its API key starts failing on billing or it disputes token charges.
+// While paused, requests that start generation fail with ErrAIGenerationDisabled and
+// the worker leaves the tenant's queued jobs queued; resuming pushes them to the worker
+// again. A reason is required to pause and is recorded in the audit log.
+func (s *AIGenerationService) SetTenantAIEnabled(ctx context.Context, kratosID uuid.UUID, email string, tenantID uuid.UUID, enabled bool, reason string) (*TenantAIGenerationStatus, error) {
+	if s.superAdmins == nil || !s.superAdmins.IsSuperAdmin(email) {
+		return nil, domainerrors.ErrForbidden.WithMessage("superadmin access required")
+	}
+	actor, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || actor == nil {
+		return nil, domainerrors.ErrUserNotFound
+	}
+
+	reason = strings.TrimSpace(reason)
+	if !enabled && reason == "" {
+		return nil, domainerrors.ErrInvalidInput.WithMessage("a reason is required to pause AI generation")
+	}
+
+	if s.tenantRepo != nil {
+		t, err := s.tenantRepo.GetByID(tenant.WithSuperAdmin(ctx, true), tenantID)
+		if err != nil {
+			return nil, domainerrors.ErrInternal.WithCause(err)
+		}
+		if t == nil {
+			return nil, domainerrors.ErrNotFound.WithMessage("tenant not found")
+		}
+	}
+
+	tenantCtx := tenant.WithTenantID(ctx, tenantID)
+	log := s.logger.With("tenantID", tenantID, "actor", email)
+
+	settings, err := s.aiSettingsRepo.Get(tenantCtx, tenantID)
+	if err != nil {
+		log.Error("failed to get AI settings", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	before := settings == nil || settings.AIGenerationEnabled
+
+	if err := s.aiSettingsRepo.SetAIGenerationEnabled(tenantCtx, tenantID, enabled, actor.ID); err != nil {
+		log.Error("failed to set AI generation enabled", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	if before != enabled {
+		recordAudit(tenantCtx, s.auditLog, log, audit.Entry{
+			TenantID:    tenantID,
+			ActorUserID: &actor.ID,
+			Action:      audit.ActionAIGenerationToggled,
+			TargetType:  audit.TargetAISettings,
+			TargetID:    tenantID.String(),
+			Changes: audit.Changes{}.
+				Field("ai_generation_enabled", before, enabled).
+				Field("reason", "", reason),
+		})
+	}
+
+	// Cached queue statuses would keep showing the old state for a few seconds
+	if s.queueStatusCache != nil {
+		if err := s.queueStatusCache.InvalidatePattern(tenantCtx, cache.TenantCacheKeys.QueueStatus("*")); err != nil {
+			log.Warn("failed to invalidate queue status cache", "error", err)
+		}
+	}
+
+	queued, err := s.queuedWorkerJobs(tenantCtx)
+	if err != nil {
+		log.Error("failed to list queued jobs", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	// Pushes delivered while paused were dropped without claiming the job, so resend
+	// them, oldest first. The sweep would find the jobs eventually, one per poll.
+	if enabled && s.taskEnqueuer != nil {
+		for i := len(queued) - 1; i >= 0; i-- {
+			job := queued[i]
+			if err := s.taskEnqueuer.EnqueueAIGeneration(job.ID.String(), string(job.Type)); err != nil {
+				log.Warn("failed to enqueue resumed job, will be picked up by poll", "jobID", job.ID, "error", err)
+			}
+		}
+	}
+
+	if enabled {
+		log.Warn("AI generation resumed", "queuedJobs", len(queued))
+	} else {
+		log.Warn("AI generation paused", "reason", reason, "queuedJobs", len(queued))
+	}
+
+	return &TenantAIGenerationStatus{
+		TenantID:   tenantID,
+		Enabled:    enabled,
+		QueuedJobs: len(queued),
+	}, nil
+}
+
+// queuedWorkerJobs returns the tenant's queued jobs the worker processes, newest first,
+// leaving out full-course parents, which only track their lesson jobs.
+func (s *AIGenerationService) queuedWorkerJobs(ctx context.Context) ([]*entity.GenerationJob, error) {
+	status := valueobject.GenerationJobStatusQueued
+	jobs, err := s.jobRepo.List(ctx, entity.GenerationJobListOptions{Status: &status})
+	if err != nil {
+		return nil, err
+	}
+	kept := jobs[:0]
+	for _, job := range jobs {
+		if job.Type != valueobject.GenerationJobTypeFullCourse {
+			kept = append(kept, job)
+		}
+	}
+	return kept, nil
+}
+
+// checkAIGenerationEnabled returns ErrAIGenerationDisabled when support has paused the
+// tenant's AI generation. Tenants without AI settings are enabled.
+func checkAIGenerationEnabled(ctx context.Context, aiSettingsRepo repository.TenantAISettingsRepository, tenantID uuid.UUID) error {
+	enabled, err := aiGenerationEnabled(ctx, aiSettingsRepo, tenantID)
+	if err != nil {
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+	if !enabled {
+		return domainerrors.ErrAIGenerationDisabled
+	}
+	return nil
+}
+
+// aiGenerationEnabled reports whether the tenant's AI generation is enabled.
+func aiGenerationEnabled(ctx context.Context, aiSettingsRepo repository.TenantAISettingsRepository, tenantID uuid.UUID) (bool, error) {
+	settings, err := aiSettingsRepo.Get(ctx, tenantID)
+	if err != nil {
+		return false, err
+	}
+	return settings == nil || settings.AIGenerationEnabled, nil
+}
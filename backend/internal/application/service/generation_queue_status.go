@@ -0,0 +1,147 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+	"github.com/sogos/mirai-backend/internal/domain/tenant"
+	"github.com/sogos/mirai-backend/internal/infrastructure/cache"
+)
+
+const (
+	// queueStatusCacheTTL keeps dashboard polling off the database without letting
+	// counts lag visibly behind job progress.
+	queueStatusCacheTTL = 15 * time.Second
+
+	// jobOutcomeStatsCacheTTL applies to the cross-tenant aggregates, which are the
+	// same for every caller.
+	jobOutcomeStatsCacheTTL = time.Minute
+
+	// avgDurationWindow and providerErrorWindow bound the jobs the aggregates cover.
+	avgDurationWindow   = 24 * time.Hour
+	providerErrorWindow = 15 * time.Minute
+
+	// The provider counts as degraded once at least providerErrorMinJobs jobs finished
+	// in the window and at least providerErrorThreshold of them failed on provider errors.
+	providerErrorMinJobs   = 5
+	providerErrorThreshold = 0.5
+)
+
+// providerErrorPatterns match the error messages failJob records when the AI provider
+// could not be created or a provider call failed.
+var providerErrorPatterns = []string{
+	"AI generation failed:%",
+	"failed to get AI provider:%",
+}
+
+// SetQueueStatus enables GetQueueStatus caching and reports the worker concurrency.
+// tenantCache holds per-user results; globalCache holds the cross-tenant aggregates.
+func (s *AIGenerationService) SetQueueStatus(tenantCache, globalCache cache.Cache, workerConcurrency int) {
+	s.queueStatusCache = tenantCache
+	s.jobOutcomeCache = globalCache
+	s.workerConcurrency = workerConcurrency
+}
+
+// GetQueueStatus reports the tenant's active jobs, where the caller's oldest queued
+// job sits in the shared queue, recent job durations, whether the AI provider is
+// currently failing and whether support has paused the tenant's AI generation.
+func (s *AIGenerationService) GetQueueStatus(ctx context.Context, kratosID uuid.UUID) (*entity.GenerationQueueStatus, error) {
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, domainerrors.ErrUserNotFound
+	}
+	if user.TenantID == nil {
+		return nil, domainerrors.ErrUserHasNoCompany
+	}
+	log := s.logger.With("userID", user.ID)
+
+	cacheKey := cache.TenantCacheKeys.QueueStatus(user.ID.String())
+	if s.queueStatusCache != nil {
+		var cached entity.GenerationQueueStatus
+		if entry, err := s.queueStatusCache.Get(ctx, cacheKey, &cached); err == nil && entry != nil {
+			return &cached, nil
+		}
+	}
+
+	counts, err := s.jobRepo.CountActiveByType(ctx)
+	if err != nil {
+		log.Error("failed to count active jobs", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	enabled, err := aiGenerationEnabled(ctx, s.aiSettingsRepo, *user.TenantID)
+	if err != nil {
+		log.Error("failed to check whether AI generation is enabled", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	status := &entity.GenerationQueueStatus{
+		Counts:            counts,
+		WorkerConcurrency: s.workerConcurrency,
+		GenerationPaused:  !enabled,
+	}
+
+	oldest, err := s.jobRepo.GetOldestQueuedCreatedAt(ctx, user.ID)
+	if err != nil {
+		log.Error("failed to get oldest queued job", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	// Paused jobs are not moving, so they have no place in the queue
+	if oldest != nil && enabled {
+		// The queue is shared, so jobs ahead include other tenants'
+		ahead, err := s.jobRepo.CountQueuedBefore(tenant.WithSuperAdmin(ctx, true), *oldest)
+		if err != nil {
+			log.Error("failed to count queued jobs ahead", "error", err)
+			return nil, domainerrors.ErrInternal.WithCause(err)
+		}
+		position := ahead + 1
+		status.QueuePosition = &position
+	}
+
+	outcomes, err := s.getJobOutcomeStats(ctx)
+	if err != nil {
+		log.Error("failed to get job outcome stats", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	status.AvgJobDuration = outcomes.AvgDuration
+	status.ProviderDegraded = outcomes.FinishedCount >= providerErrorMinJobs &&
+		float64(outcomes.MatchedFailureCount) >= providerErrorThreshold*float64(outcomes.FinishedCount)
+
+	if s.queueStatusCache != nil {
+		if _, err := s.queueStatusCache.Set(ctx, cacheKey, status, "", queueStatusCacheTTL); err != nil {
+			log.Warn("failed to cache queue status", "error", err)
+		}
+	}
+
+	return status, nil
+}
+
+// getJobOutcomeStats returns the cross-tenant job aggregates, cached for every caller.
+func (s *AIGenerationService) getJobOutcomeStats(ctx context.Context) (*repository.JobOutcomeStats, error) {
+	cacheKey := cache.GlobalCacheKeys.JobOutcomeStats()
+	if s.jobOutcomeCache != nil {
+		var cached repository.JobOutcomeStats
+		if entry, err := s.jobOutcomeCache.Get(ctx, cacheKey, &cached); err == nil && entry != nil {
+			return &cached, nil
+		}
+	}
+
+	now := time.Now()
+	stats, err := s.jobRepo.GetJobOutcomeStats(tenant.WithSuperAdmin(ctx, true),
+		now.Add(-avgDurationWindow), now.Add(-providerErrorWindow), providerErrorPatterns)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.jobOutcomeCache != nil {
+		if _, err := s.jobOutcomeCache.Set(ctx, cacheKey, stats, "", jobOutcomeStatsCacheTTL); err != nil {
+			s.logger.Warn("failed to cache job outcome stats", "error", err)
+		}
+	}
+	return stats, nil
+}
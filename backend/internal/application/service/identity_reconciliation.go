@@ -0,0 +1,221 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+const (
+	// orphanedIdentityMinAge gives in-flight provisioning time to finish before an
+	// identity without a user row is treated as orphaned.
+	orphanedIdentityMinAge = time.Hour
+
+	// identityPageSize is the number of Kratos identities fetched per request.
+	identityPageSize = 250
+)
+
+// OrphanedIdentity describes a Kratos identity that had no matching user row.
+type OrphanedIdentity struct {
+	IdentityID string
+	Email      string
+	CreatedAt  time.Time
+	Error      string // Set when the identity could not be resolved
+}
+
+// IdentityReconciliationResult contains the outcome of an orphaned identity reconciliation run.
+type IdentityReconciliationResult struct {
+	// Scanned is the number of identities old enough to be checked.
+	Scanned int
+	// Provisioned contains identities whose paid registration was completed.
+	Provisioned []OrphanedIdentity
+	// Deleted contains identities removed because no paid registration remained.
+	Deleted []OrphanedIdentity
+	// Failed contains identities that could not be provisioned or deleted.
+	Failed []OrphanedIdentity
+}
+
+// HasActions reports whether the run found any orphaned identities.
+func (r *IdentityReconciliationResult) HasActions() bool {
+	return len(r.Provisioned) > 0 || len(r.Deleted) > 0 || len(r.Failed) > 0
+}
+
+// ReconcileOrphanedIdentities finds Kratos identities older than an hour that have no user row.
+// These are left behind when provisioning fails after the identity is created, and they block
+// the email from registering again. If a paid registration still exists for the email the
+// account is provisioned against the existing identity; otherwise the identity is deleted.
+func (s *ProvisioningService) ReconcileOrphanedIdentities(ctx context.Context) (*IdentityReconciliationResult, error) {
+	log := s.logger.With("job", "identity-reconciliation")
+
+	result := &IdentityReconciliationResult{}
+	cutoff := time.Now().Add(-orphanedIdentityMinAge)
+	pageToken := ""
+
+	for {
+		page, err := s.identity.ListIdentities(ctx, identityPageSize, pageToken)
+		if err != nil {
+			log.Error("failed to list Kratos identities", "error", err)
+			return nil, err
+		}
+
+		for _, identity := range page.Identities {
+			if identity.CreatedAt.IsZero() || identity.CreatedAt.After(cutoff) {
+				continue
+			}
+			result.Scanned++
+
+			kratosID, err := uuid.Parse(identity.ID)
+			if err != nil {
+				log.Warn("skipping identity with invalid ID", "kratosID", identity.ID, "error", err)
+				continue
+			}
+
+			user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+			if err != nil {
+				log.Error("failed to look up user for identity", "kratosID", kratosID, "error", err)
+				continue
+			}
+			if user != nil {
+				continue
+			}
+
+			s.resolveOrphanedIdentity(ctx, identity, kratosID, result)
+		}
+
+		if page.NextPageToken == "" {
+			break
+		}
+		pageToken = page.NextPageToken
+	}
+
+	if result.HasActions() {
+		log.Warn("reconciled orphaned identities",
+			"scanned", result.Scanned,
+			"provisioned", len(result.Provisioned),
+			"deleted", len(result.Deleted),
+			"failed", len(result.Failed),
+		)
+	}
+
+	return result, nil
+}
+
+// resolveOrphanedIdentity completes provisioning for an orphaned identity, or deletes it
+// when there is no paid registration left to provision, and records the outcome.
+func (s *ProvisioningService) resolveOrphanedIdentity(ctx context.Context, identity *service.Identity, kratosID uuid.UUID, result *IdentityReconciliationResult) {
+	log := s.logger.With("kratosID", kratosID, "email", identity.Email)
+	orphan := OrphanedIdentity{
+		IdentityID: identity.ID,
+		Email:      identity.Email,
+		CreatedAt:  identity.CreatedAt,
+	}
+
+	reg, err := s.pendingRegRepo.GetPaidByEmail(ctx, identity.Email)
+	if err != nil {
+		log.Error("failed to look up pending registration", "error", err)
+		orphan.Error = err.Error()
+		result.Failed = append(result.Failed, orphan)
+		return
+	}
+
+	if reg == nil {
+		if err := s.identity.DeleteIdentity(ctx, identity.ID); err != nil {
+			log.Error("failed to delete orphaned identity", "error", err)
+			orphan.Error = err.Error()
+			result.Failed = append(result.Failed, orphan)
+			return
+		}
+		log.Info("deleted orphaned identity")
+		result.Deleted = append(result.Deleted, orphan)
+		return
+	}
+
+	reg.MarkAsProvisioning()
+	if err := s.pendingRegRepo.Update(ctx, reg); err != nil {
+		log.Error("failed to mark registration as provisioning", "error", err)
+		orphan.Error = err.Error()
+		result.Failed = append(result.Failed, orphan)
+		return
+	}
+
+	if err := s.completeProvisioning(ctx, reg, kratosID, valueobject.ParseLocale(identity.Locale)); err != nil {
+		log.Error("failed to complete provisioning for orphaned identity", "error", err)
+		reg.MarkAsFailed(err.Error())
+		if updateErr := s.pendingRegRepo.Update(ctx, reg); updateErr != nil {
+			log.Error("failed to mark registration as failed", "error", updateErr)
+		}
+		orphan.Error = err.Error()
+		result.Failed = append(result.Failed, orphan)
+		return
+	}
+
+	result.Provisioned = append(result.Provisioned, orphan)
+}
+
+// SendIdentityReconciliationAlert emails admins a summary of orphaned identities that were
+// provisioned, deleted or could not be resolved.
+func (s *ProvisioningService) SendIdentityReconciliationAlert(ctx context.Context, result *IdentityReconciliationResult) error {
+	if s.email == nil {
+		s.logger.Warn("email provider not configured, cannot send identity reconciliation alert")
+		return nil
+	}
+
+	if result == nil || !result.HasActions() {
+		return nil
+	}
+
+	log := s.logger.With("job", "identity-reconciliation-alert")
+	log.Info("sending identity reconciliation alert")
+
+	subject := "[INFO] Mirai: Orphaned Identities Reconciled"
+	if len(result.Failed) > 0 {
+		subject = "[WARNING] Mirai: Orphaned Identities Need Attention"
+	}
+
+	var sections []string
+	if len(result.Provisioned) > 0 {
+		sections = append(sections, "Provisioned from a paid registration:\n\n"+formatOrphanedIdentities(result.Provisioned))
+	}
+	if len(result.Deleted) > 0 {
+		sections = append(sections, "Deleted (no paid registration remained):\n\n"+formatOrphanedIdentities(result.Deleted))
+	}
+	if len(result.Failed) > 0 {
+		sections = append(sections, "Could not be resolved:\n\n"+formatOrphanedIdentities(result.Failed))
+	}
+
+	err := s.email.SendAlert(ctx, service.SendAlertRequest{
+		Subject: subject,
+		Body: "Kratos identities older than " + orphanedIdentityMinAge.String() + " without a user account were found.\n\n" +
+			strings.Join(sections, "\n\n") +
+			"\n\n" +
+			"Identities that could not be resolved will be retried on the next run.\n" +
+			"If failures persist, check backend logs and Kratos health.",
+	})
+	if err != nil {
+		log.Error("failed to send identity reconciliation alert", "error", err)
+		return err
+	}
+
+	log.Info("identity reconciliation alert sent")
+	return nil
+}
+
+// formatOrphanedIdentities renders orphaned identities for an alert email body.
+func formatOrphanedIdentities(identities []OrphanedIdentity) string {
+	var details []string
+	for _, identity := range identities {
+		detail := "Identity ID: " + identity.IdentityID + "\n" +
+			"  Email: " + identity.Email + "\n" +
+			"  Created: " + identity.CreatedAt.UTC().Format(time.RFC3339)
+		if identity.Error != "" {
+			detail += "\n  Error: " + identity.Error
+		}
+		details = append(details, detail)
+	}
+	return strings.Join(details, "\n\n")
+}
@@ -8,10 +8,13 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/sogos/mirai-backend/internal/application/dto"
+	"github.com/sogos/mirai-backend/internal/domain/audit"
 	"github.com/sogos/mirai-backend/internal/domain/entity"
 	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/links"
 	"github.com/sogos/mirai-backend/internal/domain/repository"
 	"github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/domain/tenant"
 	"github.com/sogos/mirai-backend/internal/domain/valueobject"
 )
 
@@ -25,8 +28,11 @@ type InvitationService struct {
 	userRepo       repository.UserRepository
 	companyRepo    repository.CompanyRepository
 	invitationRepo repository.InvitationRepository
+	teamRepo       repository.TeamRepository
 	payments       service.PaymentProvider
 	email          service.EmailProvider
+	auditLog       AuditLogger
+	locales        TenantLocaleProvider
 	logger         service.Logger
 	frontendURL    string
 }
@@ -52,6 +58,33 @@ func NewInvitationService(
 	}
 }
 
+// SetAuditLogger enables audit logging of invitation changes.
+func (s *InvitationService) SetAuditLogger(logger AuditLogger) {
+	s.auditLog = logger
+}
+
+// SetTeamRepository lets invitations name a team the invitee joins on acceptance.
+func (s *InvitationService) SetTeamRepository(teamRepo repository.TeamRepository) {
+	s.teamRepo = teamRepo
+}
+
+// SetTenantLocaleProvider makes invitation emails follow the organization's locale.
+func (s *InvitationService) SetTenantLocaleProvider(provider TenantLocaleProvider) {
+	s.locales = provider
+}
+
+// invitationAuditEntry describes an action user took on an invitation.
+func invitationAuditEntry(user *entity.User, invitation *entity.Invitation, action audit.Action, changes audit.Changes) audit.Entry {
+	return audit.Entry{
+		TenantID:    invitation.TenantID,
+		ActorUserID: &user.ID,
+		Action:      action,
+		TargetType:  audit.TargetInvitation,
+		TargetID:    invitation.ID.String(),
+		Changes:     changes,
+	}
+}
+
 // CreateInvitation creates a new invitation and sends an email.
 func (s *InvitationService) CreateInvitation(
 	ctx context.Context,
@@ -111,7 +144,22 @@ func (s *InvitationService) CreateInvitation(
 		return nil, domainerrors.ErrEmailAlreadyInvited
 	}
 
-	// 5. Generate invitation token
+	// 5. Check the team, if the invitee is to join one
+	if req.TeamID != nil {
+		if s.teamRepo == nil {
+			return nil, domainerrors.ErrInvalidInput.WithMessage("team invitations are not enabled")
+		}
+		team, err := s.teamRepo.GetByID(ctx, *req.TeamID)
+		if err != nil {
+			log.Error("failed to get team", "teamID", req.TeamID, "error", err)
+			return nil, domainerrors.ErrInternal.WithCause(err)
+		}
+		if team == nil || team.CompanyID != companyID {
+			return nil, domainerrors.ErrTeamNotFound
+		}
+	}
+
+	// 6. Generate invitation token
 	// Note: Email duplicate check would need to be done via Kratos identity provider
 	token, err := generateSecureToken()
 	if err != nil {
@@ -129,21 +177,36 @@ func (s *InvitationService) CreateInvitation(
 		user.ID,
 		InvitationExpiryDuration,
 	)
-
-	if err := s.invitationRepo.Create(ctx, invitation); err != nil {
+	invitation.TeamID = req.TeamID
+
+	changes := audit.Changes{}.
+		Field("email", "", invitation.Email).
+		Field("role", "", invitation.Role)
+	if invitation.TeamID != nil {
+		changes = changes.Field("team", "", invitation.TeamID.String())
+	}
+	entry := invitationAuditEntry(user, invitation, audit.ActionInvitationCreated, changes)
+	if err := audited(ctx, s.auditLog, &entry, func(ctx context.Context) error {
+		if err := s.invitationRepo.Create(ctx, invitation); err != nil {
+			return err
+		}
+		entry.TargetID = invitation.ID.String()
+		return nil
+	}); err != nil {
 		log.Error("failed to create invitation", "error", err)
 		return nil, domainerrors.ErrInternal.WithCause(err)
 	}
 
 	// 8. Send invitation email (if email provider is configured)
 	if s.email != nil {
-		inviteURL := s.frontendURL + "/auth/accept-invite?token=" + token
+		inviteURL := s.frontendURL + links.AcceptInviteLink(token)
 		if err := s.email.SendInvitation(ctx, service.SendInvitationRequest{
 			To:          req.Email,
-			InviterName: "Team Admin", // Could be enhanced to use actual name from Kratos
+			Locale:      resolveLocale(ctx, s.locales, user), // Invitee has no account yet; use the organization's or inviter's locale
+			InviterName: "Team Admin",                        // Could be enhanced to use actual name from Kratos
 			CompanyName: company.Name,
 			InviteURL:   inviteURL,
-			ExpiresAt:   invitation.ExpiresAt.Format("January 2, 2006"),
+			ExpiresAt:   invitation.ExpiresAt,
 		}); err != nil {
 			log.Warn("failed to send invitation email", "error", err)
 			// Don't fail the request - invitation is created
@@ -294,8 +357,13 @@ func (s *InvitationService) RevokeInvitation(
 		return nil, domainerrors.ErrInvitationAlreadyAccepted
 	}
 
+	previousStatus := invitation.Status
 	invitation.Revoke()
-	if err := s.invitationRepo.Update(ctx, invitation); err != nil {
+	entry := invitationAuditEntry(user, invitation, audit.ActionInvitationRevoked,
+		audit.Changes{}.Field("status", previousStatus, invitation.Status))
+	if err := audited(ctx, s.auditLog, &entry, func(ctx context.Context) error {
+		return s.invitationRepo.Update(ctx, invitation)
+	}); err != nil {
 		log.Error("failed to revoke invitation", "error", err)
 		return nil, domainerrors.ErrInternal.WithCause(err)
 	}
@@ -323,20 +391,12 @@ func (s *InvitationService) AcceptInvitation(
 		return nil, domainerrors.ErrInvitationNotFound
 	}
 
-	// 2. Validate invitation state
-	if !invitation.CanBeAccepted() {
-		if invitation.IsExpired() {
-			return nil, domainerrors.ErrInvitationExpired
-		}
-		return nil, domainerrors.ErrInvitationAlreadyAccepted
-	}
-
-	// 3. Verify email matches
+	// 2. Verify email matches
 	if userEmail != invitation.Email {
 		return nil, domainerrors.ErrInvitationEmailMismatch
 	}
 
-	// 4. Get accepting user
+	// 3. Get accepting user
 	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
 	if err != nil {
 		log.Error("failed to get user", "error", err)
@@ -346,25 +406,39 @@ func (s *InvitationService) AcceptInvitation(
 		return nil, domainerrors.ErrUserNotFound
 	}
 
-	// 5. Check user doesn't already belong to a company
-	if user.CompanyID != nil {
-		return nil, domainerrors.ErrForbidden.WithMessage("user already belongs to a company")
+	// 4. Validate invitation state. A repeated accept by the same user succeeds again.
+	if !invitation.CanBeAccepted() && !acceptedBy(invitation, user.ID) {
+		return nil, invitationStateError(invitation)
+	}
+
+	// 5. Check user doesn't already belong to another company
+	if user.CompanyID != nil && *user.CompanyID != invitation.CompanyID {
+		return nil, domainerrors.ErrInvitationEmailInOtherCompany
 	}
 
 	// 6. Update user with company and role
-	user.CompanyID = &invitation.CompanyID
-	user.Role = invitation.Role
-	if err := s.userRepo.Update(ctx, user); err != nil {
-		log.Error("failed to update user", "error", err)
-		return nil, domainerrors.ErrInternal.WithCause(err)
+	if user.CompanyID == nil {
+		user.CompanyID = &invitation.CompanyID
+		user.Role = invitation.Role
+		if err := s.userRepo.Update(ctx, user); err != nil {
+			log.Error("failed to update user", "error", err)
+			return nil, domainerrors.ErrInternal.WithCause(err)
+		}
 	}
 
-	// 7. Mark invitation as accepted
-	invitation.Accept(user.ID)
-	if err := s.invitationRepo.Update(ctx, invitation); err != nil {
-		log.Error("failed to update invitation", "error", err)
-		return nil, domainerrors.ErrInternal.WithCause(err)
+	// 7. Mark invitation as accepted, unless a concurrent request already did
+	if invitation.Status.IsPending() {
+		accepted, err := s.invitationRepo.MarkAccepted(ctx, invitation.ID, user.ID)
+		if err != nil {
+			log.Error("failed to accept invitation", "error", err)
+			return nil, domainerrors.ErrInternal.WithCause(err)
+		}
+		if !accepted {
+			log.Warn("invitation was no longer pending when accepted")
+		}
+		invitation.Accept(user.ID)
 	}
+	joinInvitedTeam(ctx, s.teamRepo, invitation, user.ID, log)
 
 	// 8. Get company details
 	company, err := s.companyRepo.GetByID(ctx, invitation.CompanyID)
@@ -381,6 +455,56 @@ func (s *InvitationService) AcceptInvitation(
 	}, nil
 }
 
+// joinInvitedTeam adds the user who accepted an invitation to the team it names, if any.
+// Failures are only logged: the user has joined the organization either way, and an
+// admin can still add them to the team.
+func joinInvitedTeam(ctx context.Context, teams repository.TeamRepository, invitation *entity.Invitation, userID uuid.UUID, log service.Logger) {
+	if teams == nil || invitation.TeamID == nil {
+		return
+	}
+	ctx = tenant.WithTenantID(ctx, invitation.TenantID)
+
+	existing, err := teams.GetMember(ctx, *invitation.TeamID, userID)
+	if err != nil {
+		log.Warn("failed to check invited team membership", "teamID", invitation.TeamID, "error", err)
+		return
+	}
+	if existing != nil {
+		return
+	}
+	member := &entity.TeamMember{
+		TenantID: invitation.TenantID,
+		TeamID:   *invitation.TeamID,
+		UserID:   userID,
+		Role:     valueobject.TeamRoleMember,
+	}
+	if err := teams.AddMember(ctx, member); err != nil {
+		log.Warn("failed to add invitee to team", "teamID", invitation.TeamID, "error", err)
+		return
+	}
+	log.Info("invitee joined team", "teamID", invitation.TeamID, "userID", userID)
+}
+
+// acceptedBy reports whether the invitation was already accepted by the user.
+func acceptedBy(invitation *entity.Invitation, userID uuid.UUID) bool {
+	return invitation.Status == valueobject.InvitationStatusAccepted &&
+		invitation.AcceptedByUserID != nil && *invitation.AcceptedByUserID == userID
+}
+
+// invitationStateError returns the error explaining why an invitation can't be accepted.
+func invitationStateError(invitation *entity.Invitation) error {
+	switch {
+	case invitation.Status == valueobject.InvitationStatusAccepted:
+		return domainerrors.ErrInvitationAlreadyAccepted
+	case invitation.Status == valueobject.InvitationStatusRevoked:
+		return domainerrors.ErrInvitationRevoked
+	case invitation.IsExpired():
+		return domainerrors.ErrInvitationExpired
+	default:
+		return domainerrors.ErrInvitationInvalid
+	}
+}
+
 // ResendInvitation resends an invitation email.
 func (s *InvitationService) ResendInvitation(
 	ctx context.Context,
@@ -423,19 +547,22 @@ func (s *InvitationService) ResendInvitation(
 
 	// Send email
 	if s.email != nil {
-		inviteURL := s.frontendURL + "/auth/accept-invite?token=" + invitation.Token
+		inviteURL := s.frontendURL + links.AcceptInviteLink(invitation.Token)
 		if err := s.email.SendInvitation(ctx, service.SendInvitationRequest{
 			To:          invitation.Email,
+			Locale:      resolveLocale(ctx, s.locales, user),
 			InviterName: "Team Admin",
 			CompanyName: company.Name,
 			InviteURL:   inviteURL,
-			ExpiresAt:   invitation.ExpiresAt.Format("January 2, 2006"),
+			ExpiresAt:   invitation.ExpiresAt,
 		}); err != nil {
 			log.Error("failed to resend invitation email", "error", err)
 			return nil, domainerrors.ErrExternalService.WithCause(err)
 		}
 	}
 
+	recordAudit(ctx, s.auditLog, log, invitationAuditEntry(user, invitation, audit.ActionInvitationResent, nil))
+
 	log.Info("invitation resent")
 	return dto.FromInvitation(invitation), nil
 }
@@ -467,8 +594,8 @@ func (s *InvitationService) getSeatInfo(
 	ctx context.Context,
 	company *entity.Company,
 ) (*dto.SeatInfoResponse, error) {
-	// Count active users
-	users, err := s.userRepo.ListByCompanyID(ctx, company.ID)
+	// Count active users; deactivated users free their seat
+	usedSeats, err := s.companyRepo.CountUsersByCompanyID(ctx, company.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -483,7 +610,6 @@ func (s *InvitationService) getSeatInfo(
 	// Falls back to plan default if seat_count is 0
 	totalSeats := company.EffectiveSeatCount()
 
-	usedSeats := len(users)
 	availableSeats := totalSeats - usedSeats - pendingCount
 	if availableSeats < 0 {
 		availableSeats = 0
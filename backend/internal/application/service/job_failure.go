@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+// Substrings of provider errors, lowercased, that identify a failure reason. The Gemini
+// SDK formats API errors as "Error <code>, Message: ..., Status: <STATUS>, ...", and
+// the client wraps them with the operation, e.g. "failed to generate sections: Error 403, ...".
+var (
+	providerAuthPatterns = []string{
+		"error 401,", "error 403,", "unauthenticated", "permission_denied",
+		"api key not valid", "api_key_invalid", "api key expired",
+	}
+	providerRateLimitPatterns = []string{
+		"error 429,", "resource_exhausted", "resourceexhausted", "rate limit", "quota",
+	}
+	providerTimeoutPatterns = []string{
+		"error 504,", "deadline_exceeded", "deadline exceeded", "client.timeout", "timeout", "timed out",
+	}
+)
+
+// classifyJobFailure maps the error that failed a job to the reason shown to the user.
+// Errors the provider wraps are matched on their text, everything unrecognized is internal.
+func classifyJobFailure(err error) valueobject.JobFailureReason {
+	switch {
+	case err == nil:
+		return valueobject.JobFailureInternal
+	case errors.Is(err, domainerrors.ErrTokenLimitExceeded):
+		return valueobject.JobFailureBudgetExceeded
+	case errors.Is(err, domainerrors.ErrAIProviderNotConfigured), errors.Is(err, domainerrors.ErrAIKeyInvalid):
+		return valueobject.JobFailureProviderAuth
+	case errors.Is(err, service.ErrInvalidAIResponse):
+		return valueobject.JobFailureInvalidOutput
+	case errors.Is(err, context.DeadlineExceeded):
+		return valueobject.JobFailureProviderTimeout
+	}
+
+	msg := strings.ToLower(err.Error())
+	switch {
+	case containsAny(msg, providerAuthPatterns):
+		return valueobject.JobFailureProviderAuth
+	case containsAny(msg, providerRateLimitPatterns):
+		return valueobject.JobFailureProviderRateLimit
+	case containsAny(msg, providerTimeoutPatterns):
+		return valueobject.JobFailureProviderTimeout
+	}
+	return valueobject.JobFailureInternal
+}
+
+func containsAny(s string, substrs []string) bool {
+	for _, sub := range substrs {
+		if strings.Contains(s, sub) {
+			return true
+		}
+	}
+	return false
+}
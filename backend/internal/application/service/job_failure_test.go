@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"google.golang.org/genai"
+
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+// wrapAsGeminiClient wraps err the way the Gemini client and its retry loop do.
+func wrapAsGeminiClient(err error) error {
+	return fmt.Errorf("failed to generate sections: %w",
+		fmt.Errorf("generate sections failed after 3 retries: %w", err))
+}
+
+// httpClientTimeoutError returns the error a real HTTP client gives when its timeout expires.
+func httpClientTimeoutError(t *testing.T) error {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	}))
+	defer server.Close()
+
+	client := &http.Client{Timeout: 20 * time.Millisecond}
+	resp, err := client.Get(server.URL)
+	if err == nil {
+		resp.Body.Close()
+		t.Fatal("request did not time out")
+	}
+	return err
+}
+
+func TestClassifyJobFailure(t *testing.T) {
+	deadlineCtx, cancel := context.WithTimeout(context.Background(), 0)
+	defer cancel()
+	<-deadlineCtx.Done()
+
+	tests := []struct {
+		name string
+		err  error
+		want valueobject.JobFailureReason
+	}{
+		{"nil", nil, valueobject.JobFailureInternal},
+
+		// Errors the Gemini SDK returns, wrapped as the client wraps them
+		{"invalid API key", wrapAsGeminiClient(genai.APIError{Code: 400, Message: "API key not valid. Please pass a valid API key.", Status: "INVALID_ARGUMENT"}), valueobject.JobFailureProviderAuth},
+		{"unauthenticated", wrapAsGeminiClient(genai.APIError{Code: 401, Message: "Request had invalid authentication credentials.", Status: "UNAUTHENTICATED"}), valueobject.JobFailureProviderAuth},
+		{"permission denied", wrapAsGeminiClient(genai.APIError{Code: 403, Message: "The caller does not have permission", Status: "PERMISSION_DENIED"}), valueobject.JobFailureProviderAuth},
+		{"rate limited", wrapAsGeminiClient(genai.APIError{Code: 429, Message: "Resource has been exhausted (e.g. check quota).", Status: "RESOURCE_EXHAUSTED"}), valueobject.JobFailureProviderRateLimit},
+		{"gateway timeout", wrapAsGeminiClient(genai.APIError{Code: 504, Message: "Deadline expired before operation could complete.", Status: "DEADLINE_EXCEEDED"}), valueobject.JobFailureProviderTimeout},
+		{"server error", wrapAsGeminiClient(genai.APIError{Code: 500, Message: "An internal error has occurred.", Status: "INTERNAL"}), valueobject.JobFailureInternal},
+		{"unavailable", wrapAsGeminiClient(genai.APIError{Code: 503, Message: "The model is overloaded.", Status: "UNAVAILABLE"}), valueobject.JobFailureInternal},
+
+		// Transport and context errors
+		{"HTTP client timeout", wrapAsGeminiClient(httpClientTimeoutError(t)), valueobject.JobFailureProviderTimeout},
+		{"context deadline", fmt.Errorf("outline generation cancelled: %w", deadlineCtx.Err()), valueobject.JobFailureProviderTimeout},
+
+		// Errors raised before or after the provider call
+		{"token budget", fmt.Errorf("failed to check budget: %w", domainerrors.ErrTokenLimitExceeded), valueobject.JobFailureBudgetExceeded},
+		{"no provider", domainerrors.ErrAIProviderNotConfigured, valueobject.JobFailureProviderAuth},
+		{"key rejected", domainerrors.ErrAIKeyInvalid.WithMessage("stored key was rejected"), valueobject.JobFailureProviderAuth},
+		{"invalid output", &service.PartialUsageError{TokensUsed: 1200, Err: fmt.Errorf("failed to resize lesson content: %w", service.ErrInvalidAIResponse)}, valueobject.JobFailureInvalidOutput},
+		{"unrecognized", errors.New("failed to save lesson: connection reset by peer"), valueobject.JobFailureInternal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := classifyJobFailure(tt.err); got != tt.want {
+				t.Errorf("classifyJobFailure(%v) = %s, want %s", tt.err, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/service"
+)
+
+// providerProgressInterval throttles the progress writes made during a provider call,
+// which may report progress many times a second while streaming.
+const providerProgressInterval = 5 * time.Second
+
+// providerProgress returns a callback that maps the provider's progress onto the job's
+// percent range [from, to) and saves it at most once per providerProgressInterval.
+// Providers that don't report progress leave the job at from until the call returns.
+func (s *AIGenerationService) providerProgress(ctx context.Context, job *entity.GenerationJob, from, to int32) service.ProgressFunc {
+	var lastWrite time.Time
+	return func(fraction float64, message string) {
+		percent := from + int32(min(max(fraction, 0), 1)*float64(to-from))
+		if percent >= to {
+			percent = to - 1
+		}
+		if percent <= job.ProgressPercent || time.Since(lastWrite) < providerProgressInterval {
+			return
+		}
+		lastWrite = time.Now()
+
+		job.ProgressPercent = percent
+		job.ProgressMessage = &message
+		if _, err := s.jobRepo.UpdateProgress(ctx, job.ID, percent, message); err != nil {
+			s.logger.Warn("failed to update job progress", "jobID", job.ID, "progress", percent, "error", err)
+		}
+	}
+}
@@ -0,0 +1,199 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/google/uuid"
+
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+)
+
+const (
+	// coverageMinTermChunks is how many chunks must mention a key term for it to count as covered.
+	coverageMinTermChunks = 2
+
+	// coverageSufficientScore is the share of key terms that must be covered before the
+	// selected knowledge is considered enough for a useful outline.
+	coverageSufficientScore = 0.6
+
+	// coverageMaxTerms bounds how many key terms are taken from the outcome and title.
+	coverageMaxTerms = 12
+
+	// coverageMinTermLength skips short words, which are rarely topics.
+	coverageMinTermLength = 3
+)
+
+// coverageStopWords are left out of key terms. Besides filler words, this covers the
+// phrasing typical of desired outcomes ("learners will be able to understand ...").
+var coverageStopWords = map[string]bool{
+	"about": true, "after": true, "all": true, "also": true, "and": true, "any": true,
+	"are": true, "be": true, "able": true, "been": true, "before": true, "being": true,
+	"between": true, "both": true, "but": true, "can": true, "course": true, "could": true,
+	"each": true, "for": true, "from": true, "get": true, "has": true, "have": true,
+	"how": true, "into": true, "its": true, "know": true, "learn": true, "learner": true,
+	"learners": true, "learning": true, "make": true, "more": true, "most": true,
+	"new": true, "not": true, "our": true, "out": true, "should": true, "such": true,
+	"than": true, "that": true, "the": true, "their": true, "them": true, "then": true,
+	"these": true, "they": true, "this": true, "those": true, "through": true, "use": true,
+	"using": true, "understand": true, "understanding": true, "want": true, "was": true,
+	"way": true, "ways": true, "well": true, "were": true, "what": true, "when": true,
+	"where": true, "which": true, "while": true, "who": true, "why": true, "will": true,
+	"with": true, "within": true, "without": true, "would": true, "you": true, "your": true,
+	"team": true, "teams": true, "staff": true, "employee": true, "employees": true,
+}
+
+// AnalyzeKnowledgeCoverageRequest contains the course inputs to check against SME knowledge.
+type AnalyzeKnowledgeCoverageRequest struct {
+	SMEIDs         []uuid.UUID
+	DesiredOutcome string
+	CourseTitle    string
+}
+
+// TermCoverage is how many knowledge chunks mention one key term of the course goal.
+type TermCoverage struct {
+	Term       string
+	ChunkCount int
+}
+
+// KnowledgeCoverage estimates how well the selected SMEs' knowledge covers a course goal,
+// by how many of its key terms enough chunks mention.
+type KnowledgeCoverage struct {
+	Score      float64 // Share of key terms that are covered, 0-1
+	Sufficient bool
+	ChunkCount int // Chunks usable in generation across the selected SMEs
+	Terms      []TermCoverage
+	ThinTopics []string // Key terms with too few chunks, in outcome order
+	Message    string   // Warning and suggested next step; empty when sufficient
+}
+
+// AnalyzeKnowledgeCoverage scores how well the selected SMEs' knowledge covers the desired
+// outcome and course title, before an outline is generated from it. It is a keyword
+// heuristic: a covered term means chunks mention it, not that they explain it well.
+func (s *AIGenerationService) AnalyzeKnowledgeCoverage(ctx context.Context, kratosID uuid.UUID, req AnalyzeKnowledgeCoverageRequest) (*KnowledgeCoverage, error) {
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, domainerrors.ErrUserNotFound
+	}
+	if user.TenantID == nil {
+		return nil, domainerrors.ErrUserHasNoCompany
+	}
+
+	if len(req.SMEIDs) == 0 {
+		return nil, domainerrors.ErrMissingRequired.WithMessage("at least one SME is required")
+	}
+	if strings.TrimSpace(req.DesiredOutcome) == "" && strings.TrimSpace(req.CourseTitle) == "" {
+		return nil, domainerrors.ErrMissingRequired.WithMessage("desired outcome or course title is required")
+	}
+
+	return s.knowledgeCoverage(ctx, req)
+}
+
+// knowledgeCoverage runs the analysis for already validated inputs.
+func (s *AIGenerationService) knowledgeCoverage(ctx context.Context, req AnalyzeKnowledgeCoverageRequest) (*KnowledgeCoverage, error) {
+	terms := coverageTerms(req.CourseTitle + " " + req.DesiredOutcome)
+	counts := make([]int, len(terms))
+
+	result := &KnowledgeCoverage{}
+	for _, smeID := range req.SMEIDs {
+		sme, err := s.smeRepo.GetByID(ctx, smeID)
+		if err != nil || sme == nil {
+			return nil, domainerrors.ErrSMENotFound
+		}
+
+		chunks, err := s.smeKnowledgeRepo.ListBySMEID(ctx, smeID)
+		if err != nil {
+			return nil, domainerrors.ErrInternal.WithCause(err)
+		}
+		for _, chunk := range generationChunks(chunks) {
+			result.ChunkCount++
+			words := coverageWords(chunk.Topic + " " + chunk.Content + " " + strings.Join(chunk.Keywords, " "))
+			for i, term := range terms {
+				if words[coverageStem(term)] {
+					counts[i]++
+				}
+			}
+		}
+	}
+
+	covered := 0
+	result.Terms = make([]TermCoverage, len(terms))
+	for i, term := range terms {
+		result.Terms[i] = TermCoverage{Term: term, ChunkCount: counts[i]}
+		if counts[i] >= coverageMinTermChunks {
+			covered++
+		} else {
+			result.ThinTopics = append(result.ThinTopics, term)
+		}
+	}
+	if len(terms) > 0 {
+		result.Score = float64(covered) / float64(len(terms))
+	} else if result.ChunkCount > 0 {
+		// Nothing to check against; only the presence of knowledge can be judged
+		result.Score = 1
+	}
+	result.Sufficient = result.ChunkCount > 0 && result.Score >= coverageSufficientScore
+
+	switch {
+	case result.ChunkCount == 0:
+		result.Message = "The selected SMEs have no knowledge yet. Create SME tasks to collect material before generating an outline."
+	case !result.Sufficient:
+		result.Message = fmt.Sprintf(
+			"The selected SMEs have little material on %s, so the outline may be shallow there. Consider creating SME tasks for these topics before generating.",
+			strings.Join(result.ThinTopics, ", "))
+	}
+	return result, nil
+}
+
+// coverageTerms returns the distinct key terms of a course goal, lowercased, in order.
+func coverageTerms(text string) []string {
+	var terms []string
+	seen := make(map[string]bool)
+	for _, word := range splitCoverageWords(text) {
+		if len(word) < coverageMinTermLength || coverageStopWords[word] {
+			continue
+		}
+		stem := coverageStem(word)
+		if seen[stem] {
+			continue
+		}
+		seen[stem] = true
+		terms = append(terms, word)
+		if len(terms) == coverageMaxTerms {
+			break
+		}
+	}
+	return terms
+}
+
+// coverageWords returns the stems of the words in a chunk.
+func coverageWords(text string) map[string]bool {
+	words := make(map[string]bool)
+	for _, word := range splitCoverageWords(text) {
+		words[coverageStem(word)] = true
+	}
+	return words
+}
+
+// splitCoverageWords lowercases text and splits it into words of letters and digits.
+func splitCoverageWords(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// coverageStem strips common English suffixes so plurals and verb forms mostly match
+// their base word, e.g. "hazards" and "hazard". Short words are left alone.
+func coverageStem(word string) string {
+	for _, suffix := range []string{"ing", "ies", "ed", "s"} {
+		if len(word) > len(suffix)+3 && strings.HasSuffix(word, suffix) {
+			if suffix == "ies" {
+				return strings.TrimSuffix(word, suffix) + "y"
+			}
+			return strings.TrimSuffix(word, suffix)
+		}
+	}
+	return word
+}
@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+// altTextMaxLength keeps generated alt text short enough for a screen reader to read in
+// one go.
+const altTextMaxLength = 125
+
+// generateComponentAltText writes alt text for an image component that has none, from its
+// stored picture when there is one and otherwise from its description. If that fails, the
+// component is flagged with alt_text_generation_failed so the editor asks the author for
+// it. Returns the tokens used, which are spent even on failure.
+func (s *AIGenerationService) generateComponentAltText(ctx context.Context, aiProvider service.AIProvider, lessonTitle string, component *entity.LessonComponent) (int64, error) {
+	var content map[string]any
+	if err := json.Unmarshal(component.ContentJSON, &content); err != nil || content == nil {
+		return 0, errors.New("image component content is not an object")
+	}
+	if altText, _ := content["alt_text"].(string); strings.TrimSpace(altText) != "" {
+		return 0, nil
+	}
+
+	req := service.GenerateAltTextRequest{LessonTitle: lessonTitle, MaxLength: altTextMaxLength}
+	req.Description, _ = content["image_description"].(string)
+	req.Caption, _ = content["caption"].(string)
+	if assetPath, _ := content["asset_path"].(string); assetPath != "" && s.assetStorage != nil {
+		// Without the picture, the description still gives usable alt text
+		if data, err := s.readComponentAsset(ctx, component.TenantID, assetPath); err == nil {
+			if mimeType := http.DetectContentType(data); strings.HasPrefix(mimeType, "image/") {
+				req.Image, req.ImageMIMEType = data, mimeType
+			}
+		}
+	}
+
+	var tokensUsed int64
+	err := errors.New("image component has no picture or description")
+	if strings.TrimSpace(req.Description) != "" || len(req.Image) > 0 {
+		var result *service.GenerateAltTextResult
+		result, err = aiProvider.GenerateAltText(ctx, req)
+		if err != nil {
+			tokensUsed = tokensUsedBeforeAbort(err)
+		} else {
+			tokensUsed = result.TokensUsed
+			if altText := truncateAltText(result.AltText); altText != "" {
+				content["alt_text"] = altText
+				content["alt_text_generated_at"] = time.Now().UTC().Format(time.RFC3339)
+				delete(content, "alt_text_generation_failed")
+			} else {
+				err = errors.New("provider returned empty alt text")
+			}
+		}
+	}
+	if err != nil {
+		content["alt_text_generation_failed"] = true
+	}
+
+	data, marshalErr := json.Marshal(content)
+	if marshalErr != nil {
+		return tokensUsed, marshalErr
+	}
+	component.ContentJSON = data
+	return tokensUsed, err
+}
+
+// truncateAltText trims alt text and cuts it to altTextMaxLength characters at a word
+// boundary where possible.
+func truncateAltText(altText string) string {
+	altText = strings.TrimSpace(altText)
+	runes := []rune(altText)
+	if len(runes) <= altTextMaxLength {
+		return altText
+	}
+	cut := string(runes[:altTextMaxLength])
+	if i := strings.LastIndex(cut, " "); i > altTextMaxLength/2 {
+		cut = cut[:i]
+	}
+	return strings.TrimSpace(cut)
+}
+
+// GetAccessibilityReport lists the accessibility problems left in a course's lessons:
+// images without alt text, headings that skip a level and quizzes without a question.
+// It checks the draft, in display order, from the stored component content. Editors only.
+func (s *AIGenerationService) GetAccessibilityReport(ctx context.Context, kratosID uuid.UUID, courseID uuid.UUID) (*entity.AccessibilityReport, error) {
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, domainerrors.ErrUserNotFound
+	}
+
+	if err := s.checkCourseAccess(ctx, user, courseID); err != nil {
+		return nil, err
+	}
+
+	report := &entity.AccessibilityReport{CourseID: courseID, Issues: []entity.AccessibilityIssue{}}
+
+	view, err := s.assembleCoursePlayerView(ctx, courseID)
+	if errors.Is(err, domainerrors.ErrNotFound) {
+		// No outline yet, so nothing to check
+		return report, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, section := range view.Sections {
+		for _, lesson := range section.Lessons {
+			report.LessonsChecked++
+			report.ComponentsChecked += len(lesson.Components)
+			report.Issues = append(report.Issues, lessonAccessibilityIssues(lesson)...)
+		}
+	}
+	return report, nil
+}
+
+// lessonAccessibilityIssues checks a lesson's components in order. The lesson title counts
+// as the level 1 heading, so the first heading may be at level 1 or 2.
+func lessonAccessibilityIssues(lesson CoursePlayerLesson) []entity.AccessibilityIssue {
+	var issues []entity.AccessibilityIssue
+	issue := func(component CoursePlayerComponent, t valueobject.AccessibilityIssueType, detail string) *entity.AccessibilityIssue {
+		issues = append(issues, entity.AccessibilityIssue{
+			Type:        t,
+			LessonID:    lesson.ID,
+			LessonTitle: lesson.Title,
+			ComponentID: component.ID,
+			Position:    component.Position,
+			Detail:      detail,
+		})
+		return &issues[len(issues)-1]
+	}
+
+	previousLevel := 1
+	for _, component := range lesson.Components {
+		switch component.Type {
+		case valueobject.LessonComponentTypeImage:
+			var content struct {
+				AltText          string `json:"alt_text"`
+				GenerationFailed bool   `json:"alt_text_generation_failed"`
+			}
+			if json.Unmarshal(component.ContentJSON, &content) != nil || strings.TrimSpace(content.AltText) == "" {
+				found := issue(component, valueobject.AccessibilityIssueMissingAltText, "Image has no alt text")
+				found.AltTextGenerationFailed = content.GenerationFailed
+			}
+
+		case valueobject.LessonComponentTypeHeading:
+			var content struct {
+				Level int `json:"level"`
+			}
+			if json.Unmarshal(component.ContentJSON, &content) != nil || content.Level < 1 {
+				continue
+			}
+			if content.Level > previousLevel+1 {
+				issue(component, valueobject.AccessibilityIssueHeadingLevelJump,
+					fmt.Sprintf("Heading level %d follows level %d", content.Level, previousLevel))
+			}
+			previousLevel = content.Level
+
+		case valueobject.LessonComponentTypeQuiz:
+			var content struct {
+				Question string `json:"question"`
+			}
+			if json.Unmarshal(component.ContentJSON, &content) != nil || strings.TrimSpace(content.Question) == "" {
+				issue(component, valueobject.AccessibilityIssueQuizWithoutInstructions, "Quiz has no question telling learners what to answer")
+			}
+		}
+	}
+	return issues
+}
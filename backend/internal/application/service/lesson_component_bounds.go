@@ -0,0 +1,207 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+// maxThinLessonAreas is how many thin parts of a lesson one expansion goes deeper into.
+const maxThinLessonAreas = 3
+
+// componentBoundsMessage explains why lesson component count bounds were rejected.
+var componentBoundsMessage = fmt.Sprintf(
+	"invalid lesson component count bounds: each must be between 0 (unbounded) and %d, and the minimum can't exceed the maximum",
+	entity.MaxLessonComponentBound)
+
+// fitLessonComponentCount brings a generated lesson within the course's component count
+// bounds with at most one extra provider call: a lesson under the minimum gets new
+// components after its thinnest text, and one over the maximum has runs of adjacent text
+// components consolidated. The call's tokens are added to the job. When the call fails or
+// the lesson has nothing to expand or consolidate, it is kept as generated.
+func (s *AIGenerationService) fitLessonComponentCount(ctx context.Context, aiProvider service.AIProvider, job *entity.GenerationJob, lesson *entity.OutlineLesson, components []service.LessonComponentResult, prefs entity.GenerationPreferences) []service.LessonComponentResult {
+	log := s.logger.With("jobID", job.ID, "components", len(components), "min", prefs.MinComponents, "max", prefs.MaxComponents)
+
+	req := service.ResizeLessonContentRequest{
+		LessonTitle:        lesson.Title,
+		LearningObjectives: lesson.LearningObjectives,
+		Components:         components,
+	}
+	switch count := len(components); {
+	case prefs.MinComponents > 0 && count < int(prefs.MinComponents):
+		req.Expansions = planLessonExpansions(components, int(prefs.MinComponents)-count)
+	case prefs.MaxComponents > 0 && count > int(prefs.MaxComponents):
+		req.Consolidations = planLessonConsolidations(components, count-int(prefs.MaxComponents))
+	default:
+		return components
+	}
+	if len(req.Expansions) == 0 && len(req.Consolidations) == 0 {
+		log.Info("lesson component count is out of bounds, but there is no text to expand or consolidate")
+		return components
+	}
+
+	if err := s.checkTokenBudget(ctx, job.TenantID); err != nil {
+		log.Warn("skipping lesson resize, token budget check failed", "error", err)
+		return components
+	}
+
+	result, err := aiProvider.ResizeLessonContent(ctx, req)
+	if err != nil {
+		job.TokensUsed += tokensUsedBeforeAbort(err)
+		log.Warn("lesson resize failed, keeping the lesson as generated", "error", err)
+		return components
+	}
+	job.TokensUsed += result.TokensUsed
+	job.RepairAttempts += int32(result.RepairAttempts)
+	if len(result.Expansions) != len(req.Expansions) || len(result.Consolidations) != len(req.Consolidations) {
+		log.Warn("lesson resize returned the wrong number of changes, keeping the lesson as generated",
+			"expansions", len(result.Expansions),
+			"consolidations", len(result.Consolidations))
+		return components
+	}
+
+	resized := applyLessonResize(components, req, result)
+	log.Info("lesson component count fitted",
+		"expansions", len(req.Expansions),
+		"consolidations", len(req.Consolidations),
+		"after", len(resized),
+		"tokensUsed", result.TokensUsed)
+	return resized
+}
+
+// planLessonExpansions picks the text components with the least text, up to
+// maxThinLessonAreas, and spreads need new components across them, in lesson order.
+func planLessonExpansions(components []service.LessonComponentResult, need int) []service.LessonExpansion {
+	var thin []int
+	for i, c := range components {
+		if c.Type == valueobject.LessonComponentTypeText.String() {
+			thin = append(thin, i)
+		}
+	}
+	if len(thin) == 0 || need <= 0 {
+		return nil
+	}
+	slices.SortStableFunc(thin, func(a, b int) int {
+		return cmp.Compare(componentTextLength(components[a]), componentTextLength(components[b]))
+	})
+	thin = thin[:min(len(thin), need, maxThinLessonAreas)]
+	slices.Sort(thin)
+
+	expansions := make([]service.LessonExpansion, len(thin))
+	for i, index := range thin {
+		expansions[i] = service.LessonExpansion{After: index, Count: need / len(thin)}
+		if i < need%len(thin) {
+			expansions[i].Count++
+		}
+	}
+	return expansions
+}
+
+// planLessonConsolidations picks runs of adjacent text components, in lesson order, whose
+// consolidation removes excess components; the last run is cut short once enough are
+// removed. Fewer are removed when the lesson doesn't have enough adjacent text.
+func planLessonConsolidations(components []service.LessonComponentResult, excess int) [][]int {
+	text := valueobject.LessonComponentTypeText.String()
+	var runs [][]int
+	for i := 0; i < len(components) && excess > 0; {
+		if components[i].Type != text {
+			i++
+			continue
+		}
+		end := i
+		for end+1 < len(components) && components[end+1].Type == text && end-i < excess {
+			end++
+		}
+		if end > i {
+			run := make([]int, 0, end-i+1)
+			for index := i; index <= end; index++ {
+				run = append(run, index)
+			}
+			runs = append(runs, run)
+			excess -= end - i
+		}
+		i = end + 1
+	}
+	return runs
+}
+
+// applyLessonResize splices the provider's new components in after each thin component
+// and replaces each consolidated run with its text component, then renumbers the lesson.
+// The provider doesn't see the SME knowledge, so new components cite the sources of the
+// component they expand, and a consolidated component cites those of its whole run.
+// Empty components are dropped, and a run whose consolidation came back empty is kept.
+func applyLessonResize(components []service.LessonComponentResult, req service.ResizeLessonContentRequest, result *service.ResizeLessonContentResult) []service.LessonComponentResult {
+	text := valueobject.LessonComponentTypeText.String()
+	heading := valueobject.LessonComponentTypeHeading.String()
+
+	added := make(map[int][]service.LessonComponentResult, len(req.Expansions))
+	for i, expansion := range req.Expansions {
+		for _, c := range result.Expansions[i] {
+			if (c.Type != text && c.Type != heading) || componentTextLength(c) == 0 {
+				continue
+			}
+			c.SourceChunkIndices = components[expansion.After].SourceChunkIndices
+			added[expansion.After] = append(added[expansion.After], c)
+		}
+	}
+
+	runs := make(map[int]int, len(req.Consolidations)) // First index of a run -> its consolidation
+	for i, run := range req.Consolidations {
+		if componentTextLength(result.Consolidations[i]) > 0 {
+			runs[run[0]] = i
+		}
+	}
+
+	resized := make([]service.LessonComponentResult, 0, len(components))
+	for i := 0; i < len(components); i++ {
+		if c, ok := runs[i]; ok {
+			run := req.Consolidations[c]
+			merged := result.Consolidations[c]
+			merged.Type = text
+			merged.SourceChunkIndices = nil
+			for _, index := range run {
+				for _, source := range components[index].SourceChunkIndices {
+					if !slices.Contains(merged.SourceChunkIndices, source) {
+						merged.SourceChunkIndices = append(merged.SourceChunkIndices, source)
+					}
+				}
+			}
+			resized = append(resized, merged)
+			i = run[len(run)-1]
+			continue
+		}
+		resized = append(resized, components[i])
+		resized = append(resized, added[i]...)
+	}
+
+	for i := range resized {
+		resized[i].Order = i + 1
+	}
+	return resized
+}
+
+// componentTextLength is how much text a text or heading component holds.
+func componentTextLength(c service.LessonComponentResult) int {
+	var content struct {
+		Plaintext string `json:"plaintext"`
+		HTML      string `json:"html"`
+		Text      string `json:"text"`
+	}
+	if err := json.Unmarshal([]byte(c.ContentJSON), &content); err != nil {
+		return 0
+	}
+	if content.Plaintext != "" {
+		return len(strings.TrimSpace(content.Plaintext))
+	}
+	if content.HTML != "" {
+		return len(strings.TrimSpace(content.HTML))
+	}
+	return len(strings.TrimSpace(content.Text))
+}
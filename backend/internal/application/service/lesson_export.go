@@ -0,0 +1,799 @@
+package service
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+const (
+	// lessonExportProgressEvery is how many lessons are archived between progress writes.
+	lessonExportProgressEvery = 10
+
+	// lessonExportFormat identifies lesson archives in a course's exports list.
+	lessonExportFormat = "markdown-zip"
+)
+
+// errLessonExportCancelled stops archiving once the export job is no longer processing.
+var errLessonExportCancelled = errors.New("lesson export cancelled")
+
+// LessonExportStorage streams lesson archives into tenant storage.
+type LessonExportStorage interface {
+	BuildPath(tenantID uuid.UUID, subpath string) string
+	StreamContent(ctx context.Context, tenantID uuid.UUID, subpath, contentType string, write func(w io.Writer) error) (int64, error)
+}
+
+// CourseExportRecord describes an export artifact stored for a course.
+type CourseExportRecord struct {
+	ID             uuid.UUID
+	Format         string
+	FilePath       string // Tenant-relative path of the artifact
+	LessonCount    int
+	SkippedLessons int
+	CreatedAt      time.Time
+}
+
+// CourseExportRecorder adds export artifacts to a course's exports list.
+type CourseExportRecorder interface {
+	RecordCourseExport(ctx context.Context, courseID uuid.UUID, export CourseExportRecord) error
+}
+
+// ExportReadyNotice describes a finished export for its ready notification.
+type ExportReadyNotice struct {
+	UserID       uuid.UUID
+	TenantID     uuid.UUID
+	JobID        uuid.UUID
+	CourseID     uuid.UUID
+	CourseTitle  string
+	FilePath     string // Tenant-relative path of the export file
+	Format       string
+	SizeBytes    int64
+	LessonCount  int
+	SkippedCount int
+}
+
+// ExportNotifier tells the requester an export is ready to download. Download links are
+// created when the notification is sent, so their expiry counts from then.
+type ExportNotifier interface {
+	NotifyExportReady(ctx context.Context, notice ExportReadyNotice) error
+}
+
+// SetLessonExport enables bulk lesson exports. Without storage, ExportAllLessons fails;
+// the recorder and notifier are optional.
+func (s *AIGenerationService) SetLessonExport(storage LessonExportStorage, recorder CourseExportRecorder, notifier ExportNotifier) {
+	s.lessonExportStorage = storage
+	s.exportRecorder = recorder
+	s.exportNotifier = notifier
+}
+
+// SetExportArtifactRecorder enables retention of lesson export files: each is recorded
+// with an expiry and supersedes the course's previous lesson export.
+func (s *AIGenerationService) SetExportArtifactRecorder(recorder ExportArtifactRecorder) {
+	s.exportArtifacts = recorder
+}
+
+// ExportAllLessonsResult contains the queued export job.
+type ExportAllLessonsResult struct {
+	Job *entity.GenerationJob
+}
+
+// ExportAllLessons queues a job that packages every generated lesson of a course into
+// a ZIP of Markdown files in tenant storage. With includeCitations, each component is
+// followed by footnotes naming the SME sources it was generated from.
+func (s *AIGenerationService) ExportAllLessons(ctx context.Context, kratosID uuid.UUID, courseID uuid.UUID, includeCitations bool) (*ExportAllLessonsResult, error) {
+	log := s.logger.With("kratosID", kratosID, "courseID", courseID)
+
+	if s.lessonExportStorage == nil {
+		return nil, domainerrors.ErrInternal.WithMessage("lesson export is not configured")
+	}
+
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, domainerrors.ErrUserNotFound
+	}
+	if user.TenantID == nil {
+		return nil, domainerrors.ErrUserHasNoCompany
+	}
+
+	if err := s.checkCourseAccess(ctx, user, courseID); err != nil {
+		return nil, err
+	}
+
+	lessons, err := s.genLessonRepo.ListByCourseID(ctx, courseID, false)
+	if err != nil {
+		log.Error("failed to list generated lessons", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	if len(lessons) == 0 {
+		return nil, domainerrors.ErrInvalidInput.WithMessage("course has no generated lessons to export")
+	}
+
+	job := &entity.GenerationJob{
+		ID:               uuid.New(),
+		TenantID:         *user.TenantID,
+		Type:             valueobject.GenerationJobTypeLessonsExport,
+		Status:           valueobject.GenerationJobStatusQueued,
+		CourseID:         &courseID,
+		IncludeCitations: includeCitations,
+		ProgressPercent:  0,
+		MaxRetries:       1,
+		CreatedByUserID:  user.ID,
+		CreatedAt:        time.Now(),
+	}
+
+	if err := s.jobRepo.Create(ctx, job); err != nil {
+		log.Error("failed to create lesson export job", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	log.Info("lesson export job created", "jobID", job.ID, "lessons", len(lessons), "citations", includeCitations)
+
+	if s.taskEnqueuer != nil {
+		if err := s.taskEnqueuer.EnqueueAIGeneration(job.ID.String(), string(job.Type)); err != nil {
+			log.Warn("failed to enqueue job for immediate processing, will be picked up by poll", "error", err)
+		}
+	}
+
+	return &ExportAllLessonsResult{Job: job}, nil
+}
+
+// ProcessLessonsExportJob writes the course's generated lessons, its outline and a
+// manifest into a ZIP streamed to tenant storage, then records and announces it.
+// Lessons whose components can't be rendered are left out and listed in the manifest.
+func (s *AIGenerationService) ProcessLessonsExportJob(ctx context.Context, job *entity.GenerationJob) error {
+	log := s.logger.With("jobID", job.ID, "courseID", job.CourseID)
+
+	if ctx.Err() != nil {
+		return s.requeueInterruptedJob(ctx, job)
+	}
+	if s.checkJobCancelled(ctx, job.ID) {
+		log.Info("job already cancelled, skipping processing")
+		return nil
+	}
+	if s.lessonExportStorage == nil {
+		return s.failJob(ctx, job, "lesson export is not configured")
+	}
+	if job.CourseID == nil {
+		return s.failJob(ctx, job, "export job has no course")
+	}
+	courseID := *job.CourseID
+
+	outline, err := s.outlineRepo.GetByCourseID(ctx, courseID)
+	if err != nil || outline == nil {
+		return s.failJob(ctx, job, "failed to get course outline")
+	}
+	if err := s.loadOutlineSections(ctx, outline); err != nil {
+		log.Error("failed to load outline sections", "error", err)
+		return s.failJob(ctx, job, "failed to load course outline")
+	}
+
+	lessons, err := s.genLessonRepo.ListByCourseID(ctx, courseID, false)
+	if err != nil {
+		log.Error("failed to list generated lessons", "error", err)
+		return s.failJob(ctx, job, "failed to list generated lessons")
+	}
+	if len(lessons) == 0 {
+		return s.failJob(ctx, job, "course has no generated lessons to export")
+	}
+
+	courseTitle := s.resolveCourseTitle(ctx, courseID, nil)
+	subpath := path.Join("exports", job.ID.String(), exportFileSlug(courseTitle)+"-lessons.zip")
+
+	manifest := &lessonExportManifest{
+		CourseID:       courseID.String(),
+		CourseTitle:    courseTitle,
+		OutlineVersion: outline.Version,
+		ExportedAt:     time.Now().UTC(),
+		Lessons:        []lessonExportEntry{},
+		Skipped:        []lessonExportSkipped{},
+	}
+
+	size, err := s.lessonExportStorage.StreamContent(ctx, job.TenantID, subpath, "application/zip", func(w io.Writer) error {
+		return s.writeLessonsArchive(ctx, job, w, outline, lessons, manifest)
+	})
+	if err != nil {
+		if ctx.Err() != nil || errors.Is(err, errLessonExportCancelled) {
+			detached := context.WithoutCancel(ctx)
+			if s.checkJobCancelled(detached, job.ID) {
+				log.Info("lesson export cancelled")
+				return s.markJobCancelled(detached, job)
+			}
+			return s.requeueInterruptedJob(ctx, job)
+		}
+		log.Error("failed to write lesson archive", "error", err)
+		return s.failJob(ctx, job, "failed to write lesson archive")
+	}
+
+	exported, skipped := len(manifest.Lessons), len(manifest.Skipped)
+	if s.exportArtifacts != nil {
+		artifact := &entity.ExportArtifact{
+			TenantID:        job.TenantID,
+			JobID:           job.ID,
+			CourseID:        &courseID,
+			Type:            entity.ExportArtifactTypeLessons,
+			FilePath:        subpath,
+			SizeBytes:       size,
+			CreatedByUserID: &job.CreatedByUserID,
+		}
+		if err := s.exportArtifacts.RecordExportArtifact(ctx, artifact); err != nil {
+			log.Warn("failed to record export artifact", "error", err)
+		}
+	}
+	if s.exportRecorder != nil {
+		record := CourseExportRecord{
+			ID:             job.ID,
+			Format:         lessonExportFormat,
+			FilePath:       subpath,
+			LessonCount:    exported,
+			SkippedLessons: skipped,
+			CreatedAt:      manifest.ExportedAt,
+		}
+		if err := s.exportRecorder.RecordCourseExport(ctx, courseID, record); err != nil {
+			log.Warn("failed to record course export", "error", err)
+		}
+	}
+
+	resultPath := s.lessonExportStorage.BuildPath(job.TenantID, subpath)
+	job.ResultPath = &resultPath
+	job.Status = valueobject.GenerationJobStatusCompleted
+	job.ProgressPercent = 100
+	completedAt := time.Now()
+	job.CompletedAt = &completedAt
+	progressMsg := fmt.Sprintf("Exported %d lessons", exported)
+	if skipped > 0 {
+		progressMsg = fmt.Sprintf("Exported %d lessons, skipped %d", exported, skipped)
+	}
+	job.ProgressMessage = &progressMsg
+	if err := s.jobRepo.Update(ctx, job); err != nil {
+		log.Error("failed to mark job as completed", "error", err)
+	}
+
+	if s.exportNotifier != nil {
+		notice := ExportReadyNotice{
+			UserID:       job.CreatedByUserID,
+			TenantID:     job.TenantID,
+			JobID:        job.ID,
+			CourseID:     courseID,
+			CourseTitle:  courseTitle,
+			FilePath:     subpath,
+			Format:       lessonExportFormat,
+			SizeBytes:    size,
+			LessonCount:  exported,
+			SkippedCount: skipped,
+		}
+		if err := s.exportNotifier.NotifyExportReady(ctx, notice); err != nil {
+			log.Error("failed to send export ready notification", "error", err)
+		}
+	}
+
+	log.Info("lesson export completed", "lessons", exported, "skipped", skipped, "bytes", size)
+	return nil
+}
+
+// writeLessonsArchive writes one Markdown file per lesson in outline order, followed by
+// outline.json and manifest.json. Lessons are loaded and rendered one at a time so the
+// archive's size doesn't depend on how many lessons the course has. Progress is written
+// to job when one is given, and the job decides whether sources are cited.
+func (s *AIGenerationService) writeLessonsArchive(ctx context.Context, job *entity.GenerationJob, w io.Writer, outline *entity.CourseOutline, lessons []*entity.GeneratedLesson, manifest *lessonExportManifest) error {
+	zw := zip.NewWriter(w)
+
+	var citations *exportCitations
+	if job != nil && job.IncludeCitations {
+		citations = newExportCitations(s.smeKnowledgeRepo)
+		manifest.Citations = true
+	}
+
+	byOutlineLesson := make(map[uuid.UUID]*entity.GeneratedLesson, len(lessons))
+	for _, lesson := range lessons {
+		byOutlineLesson[lesson.OutlineLessonID] = lesson
+	}
+
+	archived := make(map[uuid.UUID]bool, len(lessons))
+	done := 0
+	add := func(lesson *entity.GeneratedLesson, sectionTitle, dir string, position int) error {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		archived[lesson.ID] = true
+
+		if err := s.addLessonFile(ctx, zw, lesson, sectionTitle, dir, position, manifest, citations); err != nil {
+			return err
+		}
+
+		done++
+		if job != nil && done%lessonExportProgressEvery == 0 {
+			percent := int32(5 + 90*done/len(lessons))
+			msg := fmt.Sprintf("Exported %d of %d lessons", done, len(lessons))
+			job.ProgressPercent = percent
+			job.ProgressMessage = &msg
+			processing, err := s.jobRepo.UpdateProgress(ctx, job.ID, percent, msg)
+			if err != nil {
+				s.logger.Warn("failed to update job progress", "jobID", job.ID, "progress", percent, "error", err)
+			} else if !processing {
+				return errLessonExportCancelled
+			}
+		}
+		return nil
+	}
+
+	for i, section := range outline.Sections {
+		dir := path.Join("lessons", fmt.Sprintf("%02d-%s", i+1, exportFileSlug(section.Title)))
+		for j, outlineLesson := range section.Lessons {
+			lesson, ok := byOutlineLesson[outlineLesson.ID]
+			if !ok {
+				continue
+			}
+			if err := add(lesson, section.Title, dir, j+1); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Lessons the outline no longer places still belong to the course; keep them rather than drop them
+	position := 0
+	for _, lesson := range lessons {
+		if archived[lesson.ID] {
+			continue
+		}
+		position++
+		if err := add(lesson, "", path.Join("lessons", "unassigned"), position); err != nil {
+			return err
+		}
+	}
+
+	outlineJSON, err := json.MarshalIndent(newOutlineExportJSON(outline), "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := writeZipFile(zw, "outline.json", outlineJSON); err != nil {
+		return err
+	}
+
+	manifestJSON, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := writeZipFile(zw, "manifest.json", manifestJSON); err != nil {
+		return err
+	}
+
+	return zw.Close()
+}
+
+// RenderCourseArchive writes the same lesson archive as a lesson export to subpath in
+// tenant storage, without an export job, and returns the course title. It is used to
+// deliver courses to other systems.
+func (s *AIGenerationService) RenderCourseArchive(ctx context.Context, tenantID, courseID uuid.UUID, subpath string) (string, error) {
+	if s.lessonExportStorage == nil {
+		return "", errors.New("lesson export is not configured")
+	}
+
+	outline, err := s.outlineRepo.GetByCourseID(ctx, courseID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get course outline: %w", err)
+	}
+	if outline == nil {
+		return "", errors.New("course has no outline")
+	}
+	if err := s.loadOutlineSections(ctx, outline); err != nil {
+		return "", fmt.Errorf("failed to load outline sections: %w", err)
+	}
+
+	lessons, err := s.genLessonRepo.ListByCourseID(ctx, courseID, false)
+	if err != nil {
+		return "", fmt.Errorf("failed to list generated lessons: %w", err)
+	}
+	if len(lessons) == 0 {
+		return "", errors.New("course has no generated lessons")
+	}
+
+	courseTitle := s.resolveCourseTitle(ctx, courseID, nil)
+	manifest := &lessonExportManifest{
+		CourseID:       courseID.String(),
+		CourseTitle:    courseTitle,
+		OutlineVersion: outline.Version,
+		ExportedAt:     time.Now().UTC(),
+		Lessons:        []lessonExportEntry{},
+		Skipped:        []lessonExportSkipped{},
+	}
+
+	_, err = s.lessonExportStorage.StreamContent(ctx, tenantID, subpath, "application/zip", func(w io.Writer) error {
+		return s.writeLessonsArchive(ctx, nil, w, outline, lessons, manifest)
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to write lesson archive: %w", err)
+	}
+	return courseTitle, nil
+}
+
+// addLessonFile renders a lesson into the archive, or records it as skipped when its
+// components can't be loaded or rendered. Sources are cited when citations is set; if
+// they can't be looked up the lesson is still exported, citing what is already known.
+// Stored images are copied next to the lesson and linked from its Markdown.
+func (s *AIGenerationService) addLessonFile(ctx context.Context, zw *zip.Writer, lesson *entity.GeneratedLesson, sectionTitle, dir string, position int, manifest *lessonExportManifest, citations *exportCitations) error {
+	skip := func(reason string) {
+		s.logger.Warn("skipping lesson in export", "lessonID", lesson.ID, "reason", reason)
+		manifest.Skipped = append(manifest.Skipped, lessonExportSkipped{
+			ID:      lesson.ID.String(),
+			Section: sectionTitle,
+			Title:   lesson.Title,
+			Error:   reason,
+		})
+	}
+
+	components, err := s.componentRepo.ListByLessonID(ctx, lesson.ID)
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		skip("failed to load components")
+		return nil
+	}
+
+	var sources map[uuid.UUID]*entity.SMEKnowledgeSource
+	if citations != nil {
+		if err := citations.load(ctx, components); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			s.logger.Warn("failed to look up citation sources", "lessonID", lesson.ID, "error", err)
+		}
+		sources = citations.sources
+	}
+
+	images, err := s.addLessonImages(ctx, zw, lesson, components, dir)
+	if err != nil {
+		return err
+	}
+
+	content, err := renderLessonMarkdown(lesson, components, sources, images)
+	if err != nil {
+		skip(err.Error())
+		return nil
+	}
+
+	name := path.Join(dir, fmt.Sprintf("%02d-%s.md", position, exportFileSlug(lesson.Title)))
+	if err := writeZipFile(zw, name, content); err != nil {
+		return err
+	}
+	manifest.Lessons = append(manifest.Lessons, lessonExportEntry{
+		ID:      lesson.ID.String(),
+		Section: sectionTitle,
+		Title:   lesson.Title,
+		File:    name,
+	})
+	return nil
+}
+
+// addLessonImages copies the stored file of each image component into the archive's
+// assets folder beside the lesson and returns the paths to link them by, relative to the
+// lesson file. An image that can't be read is left out, so its description is exported instead.
+func (s *AIGenerationService) addLessonImages(ctx context.Context, zw *zip.Writer, lesson *entity.GeneratedLesson, components []*entity.LessonComponent, dir string) (map[uuid.UUID]string, error) {
+	if s.assetStorage == nil {
+		return nil, nil
+	}
+
+	images := make(map[uuid.UUID]string)
+	for _, component := range components {
+		if component.Type != valueobject.LessonComponentTypeImage {
+			continue
+		}
+		var content struct {
+			AssetPath string `json:"asset_path"`
+		}
+		if err := json.Unmarshal(component.ContentJSON, &content); err != nil || content.AssetPath == "" {
+			continue
+		}
+
+		data, err := s.readComponentAsset(ctx, lesson.TenantID, content.AssetPath)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			s.logger.Warn("failed to read image for export", "componentID", component.ID, "error", err)
+			continue
+		}
+		rel := path.Join("assets", component.ID.String()+path.Ext(content.AssetPath))
+		if err := writeZipFile(zw, path.Join(dir, rel), data); err != nil {
+			return nil, err
+		}
+		images[component.ID] = rel
+	}
+	return images, nil
+}
+
+// readComponentAsset reads a component's stored file.
+func (s *AIGenerationService) readComponentAsset(ctx context.Context, tenantID uuid.UUID, assetPath string) ([]byte, error) {
+	r, err := s.assetStorage.OpenContent(ctx, tenantID, assetPath)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return io.ReadAll(r)
+}
+
+// writeZipFile adds a compressed file to the archive.
+func writeZipFile(zw *zip.Writer, name string, content []byte) error {
+	f, err := zw.CreateHeader(&zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(content)
+	return err
+}
+
+// lessonExportManifest is written to manifest.json and describes the archive's contents.
+type lessonExportManifest struct {
+	CourseID       string                `json:"courseId"`
+	CourseTitle    string                `json:"courseTitle"`
+	OutlineVersion int32                 `json:"outlineVersion"`
+	ExportedAt     time.Time             `json:"exportedAt"`
+	Citations      bool                  `json:"citations"`
+	Lessons        []lessonExportEntry   `json:"lessons"`
+	Skipped        []lessonExportSkipped `json:"skippedLessons"`
+}
+
+type lessonExportEntry struct {
+	ID      string `json:"id"`
+	Section string `json:"section,omitempty"`
+	Title   string `json:"title"`
+	File    string `json:"file"`
+}
+
+type lessonExportSkipped struct {
+	ID      string `json:"id"`
+	Section string `json:"section,omitempty"`
+	Title   string `json:"title"`
+	Error   string `json:"error"`
+}
+
+// outlineExportJSON is the outline as written to outline.json.
+type outlineExportJSON struct {
+	Version  int32                      `json:"version"`
+	Sections []outlineExportJSONSection `json:"sections"`
+}
+
+type outlineExportJSONSection struct {
+	Title       string                    `json:"title"`
+	Description string                    `json:"description,omitempty"`
+	Lessons     []outlineExportJSONLesson `json:"lessons"`
+}
+
+type outlineExportJSONLesson struct {
+	Title                    string   `json:"title"`
+	Description              string   `json:"description,omitempty"`
+	EstimatedDurationMinutes *int32   `json:"estimatedDurationMinutes,omitempty"`
+	LearningObjectives       []string `json:"learningObjectives,omitempty"`
+	TargetAudiences          []string `json:"targetAudiences,omitempty"`
+}
+
+func newOutlineExportJSON(outline *entity.CourseOutline) outlineExportJSON {
+	out := outlineExportJSON{
+		Version:  outline.Version,
+		Sections: make([]outlineExportJSONSection, len(outline.Sections)),
+	}
+	for i, section := range outline.Sections {
+		lessons := make([]outlineExportJSONLesson, len(section.Lessons))
+		for j, lesson := range section.Lessons {
+			lessons[j] = outlineExportJSONLesson{
+				Title:                    lesson.Title,
+				Description:              lesson.Description,
+				EstimatedDurationMinutes: lesson.EstimatedDurationMinutes,
+				LearningObjectives:       lesson.LearningObjectives,
+				TargetAudiences:          lesson.TargetAudiences,
+			}
+		}
+		out.Sections[i] = outlineExportJSONSection{
+			Title:       section.Title,
+			Description: section.Description,
+			Lessons:     lessons,
+		}
+	}
+	return out
+}
+
+// renderLessonMarkdown renders a lesson as Markdown with its title as the top heading
+// and its components in position order. Any component that can't be read fails the lesson.
+// When sources is non-nil, components are followed by footnote references to the sources
+// of their chunks, defined in a Sources section at the end; uncited components and chunks
+// without a known source get none. Image components listed in images link to that path.
+func renderLessonMarkdown(lesson *entity.GeneratedLesson, components []*entity.LessonComponent, sources map[uuid.UUID]*entity.SMEKnowledgeSource, images map[uuid.UUID]string) ([]byte, error) {
+	components = slices.Clone(components)
+	slices.SortStableFunc(components, func(a, b *entity.LessonComponent) int {
+		return int(a.Position - b.Position)
+	})
+
+	var citations *lessonCitations
+	if sources != nil {
+		citations = newLessonCitations(sources)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n", lesson.Title)
+	for _, component := range components {
+		block, err := renderComponentMarkdown(component, images[component.ID])
+		if err != nil {
+			return nil, fmt.Errorf("component %d (%s): %w", component.Position, component.Type, err)
+		}
+		b.WriteString("\n")
+		b.WriteString(block)
+		if citations != nil {
+			if markers := citations.markers(component); markers != "" {
+				fmt.Fprintf(&b, "\n*Sources:* %s\n", markers)
+			}
+		}
+	}
+	if lesson.SegueText != nil && strings.TrimSpace(*lesson.SegueText) != "" {
+		fmt.Fprintf(&b, "\n---\n\n%s\n", strings.TrimSpace(*lesson.SegueText))
+	}
+	if citations != nil {
+		if bibliography := citations.bibliography(); bibliography != "" {
+			b.WriteString("\n")
+			b.WriteString(bibliography)
+		}
+	}
+	return []byte(b.String()), nil
+}
+
+// renderComponentMarkdown renders one component as a Markdown block ending in a newline.
+// Component headings sit below the lesson title, so a level 1 heading becomes "##".
+// imagePath is where an image component's stored file was written, if anywhere.
+func renderComponentMarkdown(component *entity.LessonComponent, imagePath string) (string, error) {
+	switch component.Type {
+	case valueobject.LessonComponentTypeText:
+		var content struct {
+			HTML      string `json:"html"`
+			Plaintext string `json:"plaintext"`
+		}
+		if err := json.Unmarshal(component.ContentJSON, &content); err != nil {
+			return "", fmt.Errorf("invalid content: %w", err)
+		}
+		text := strings.TrimSpace(content.Plaintext)
+		if text == "" {
+			text = strings.TrimSpace(content.HTML)
+		}
+		if text == "" {
+			return "", errors.New("text component is empty")
+		}
+		return text + "\n", nil
+
+	case valueobject.LessonComponentTypeHeading:
+		var content struct {
+			Level int    `json:"level"`
+			Text  string `json:"text"`
+		}
+		if err := json.Unmarshal(component.ContentJSON, &content); err != nil {
+			return "", fmt.Errorf("invalid content: %w", err)
+		}
+		if strings.TrimSpace(content.Text) == "" {
+			return "", errors.New("heading component has no text")
+		}
+		level := min(max(content.Level, 1), 5) + 1
+		return strings.Repeat("#", level) + " " + strings.TrimSpace(content.Text) + "\n", nil
+
+	case valueobject.LessonComponentTypeImage:
+		var content struct {
+			URL              string `json:"url"`
+			ImageDescription string `json:"image_description"`
+			AltText          string `json:"alt_text"`
+			Caption          string `json:"caption"`
+		}
+		if err := json.Unmarshal(component.ContentJSON, &content); err != nil {
+			return "", fmt.Errorf("invalid content: %w", err)
+		}
+		var b strings.Builder
+		switch {
+		case imagePath != "":
+			alt := content.AltText
+			if alt == "" {
+				alt = content.ImageDescription
+			}
+			fmt.Fprintf(&b, "![%s](%s)\n", alt, imagePath)
+		case content.URL != "":
+			fmt.Fprintf(&b, "![%s](%s)\n", content.AltText, content.URL)
+		case content.ImageDescription != "":
+			fmt.Fprintf(&b, "> **Image:** %s\n", content.ImageDescription)
+		case content.AltText != "":
+			fmt.Fprintf(&b, "> **Image:** %s\n", content.AltText)
+		default:
+			return "", errors.New("image component has no image or description")
+		}
+		if content.Caption != "" {
+			fmt.Fprintf(&b, "\n*%s*\n", content.Caption)
+		}
+		return b.String(), nil
+
+	case valueobject.LessonComponentTypeQuiz:
+		// Quizzes not yet migrated are upgraded on the fly, so both formats export the same way
+		quiz, _, err := entity.ParseQuizContent(component.ContentJSON)
+		if err != nil {
+			return "", err
+		}
+		if strings.TrimSpace(quiz.Question) == "" || len(quiz.Options) == 0 {
+			return "", errors.New("quiz component needs a question and options")
+		}
+		var b strings.Builder
+		points := "points"
+		if quiz.Points == 1 {
+			points = "point"
+		}
+		fmt.Fprintf(&b, "**Quiz (%s, %d %s):** %s\n\n", quizTypeLabel(quiz.QuestionType), quiz.Points, points, strings.TrimSpace(quiz.Question))
+		for _, option := range quiz.Options {
+			mark := " "
+			if option.Correct {
+				mark = "x"
+			}
+			fmt.Fprintf(&b, "- [%s] %s\n", mark, option.Text)
+		}
+		if explanation := strings.TrimSpace(quiz.Explanation); explanation != "" {
+			fmt.Fprintf(&b, "\n*Explanation:* %s\n", explanation)
+		}
+		return b.String(), nil
+
+	case valueobject.LessonComponentTypeVideo:
+		// Rendered as the script whether or not a file was attached, since exports don't
+		// carry uploaded assets
+		var content struct {
+			Title           string `json:"title"`
+			Script          string `json:"script"`
+			DurationSeconds int    `json:"suggested_duration_seconds"`
+			AssetFileName   string `json:"asset_file_name"`
+		}
+		if err := json.Unmarshal(component.ContentJSON, &content); err != nil {
+			return "", fmt.Errorf("invalid content: %w", err)
+		}
+		if strings.TrimSpace(content.Script) == "" {
+			return "", errors.New("video component has no script")
+		}
+		title := strings.TrimSpace(content.Title)
+		if title == "" {
+			title = "Untitled video"
+		}
+		var b strings.Builder
+		fmt.Fprintf(&b, "> **Video:** %s", title)
+		if content.DurationSeconds > 0 {
+			fmt.Fprintf(&b, " (%d:%02d)", content.DurationSeconds/60, content.DurationSeconds%60)
+		}
+		b.WriteString("\n")
+		if content.AssetFileName != "" {
+			fmt.Fprintf(&b, ">\n> *File: %s*\n", content.AssetFileName)
+		}
+		b.WriteString(">\n")
+		for _, line := range strings.Split(strings.TrimSpace(content.Script), "\n") {
+			b.WriteString(strings.TrimRight("> "+line, " ") + "\n")
+		}
+		return b.String(), nil
+
+	default:
+		return "", fmt.Errorf("unsupported component type %q", component.Type)
+	}
+}
+
+// quizTypeLabel describes how a quiz question is answered, for exports.
+func quizTypeLabel(t valueobject.QuizQuestionType) string {
+	switch t {
+	case valueobject.QuizQuestionTypeMulti:
+		return "select all that apply"
+	case valueobject.QuizQuestionTypeTrueFalse:
+		return "true or false"
+	default:
+		return "single answer"
+	}
+}
@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+)
+
+// exportCitations looks up the sources of cited chunks for one export run. Lessons
+// often cite the same chunks, so each chunk is looked up at most once per run.
+type exportCitations struct {
+	repo    repository.SMEKnowledgeRepository
+	sources map[uuid.UUID]*entity.SMEKnowledgeSource // nil entries are chunks deleted since generation
+}
+
+func newExportCitations(repo repository.SMEKnowledgeRepository) *exportCitations {
+	return &exportCitations{
+		repo:    repo,
+		sources: make(map[uuid.UUID]*entity.SMEKnowledgeSource),
+	}
+}
+
+// load looks up the chunks cited by components that haven't been seen this run.
+func (c *exportCitations) load(ctx context.Context, components []*entity.LessonComponent) error {
+	var missing []uuid.UUID
+	for _, component := range components {
+		for _, chunkID := range component.SMEChunkIDs {
+			if _, ok := c.sources[chunkID]; !ok {
+				c.sources[chunkID] = nil
+				missing = append(missing, chunkID)
+			}
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	sources, err := c.repo.ListSources(ctx, missing)
+	if err != nil {
+		// Forget the misses so a later lesson citing the same chunks tries again
+		for _, chunkID := range missing {
+			delete(c.sources, chunkID)
+		}
+		return err
+	}
+	for _, source := range sources {
+		c.sources[source.ChunkID] = source
+	}
+	return nil
+}
+
+// lessonCitations numbers a lesson's sources in the order they are first cited. Chunks
+// from the same SME submission share a number.
+type lessonCitations struct {
+	sources map[uuid.UUID]*entity.SMEKnowledgeSource
+	numbers map[string]int
+	notes   []string
+}
+
+func newLessonCitations(sources map[uuid.UUID]*entity.SMEKnowledgeSource) *lessonCitations {
+	return &lessonCitations{sources: sources, numbers: make(map[string]int)}
+}
+
+// markers returns the footnote references for a component's chunks, or "" when none of
+// them can be traced to a source.
+func (c *lessonCitations) markers(component *entity.LessonComponent) string {
+	var refs []string
+	seen := make(map[int]bool)
+	for _, chunkID := range component.SMEChunkIDs {
+		source := c.sources[chunkID]
+		if source == nil {
+			continue
+		}
+		note := citationNote(source)
+		n, ok := c.numbers[note]
+		if !ok {
+			c.notes = append(c.notes, note)
+			n = len(c.notes)
+			c.numbers[note] = n
+		}
+		if !seen[n] {
+			seen[n] = true
+			refs = append(refs, fmt.Sprintf("[^%d]", n))
+		}
+	}
+	return strings.Join(refs, " ")
+}
+
+// bibliography returns the lesson's footnote definitions under a Sources heading.
+func (c *lessonCitations) bibliography() string {
+	if len(c.notes) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteString("## Sources\n\n")
+	for i, note := range c.notes {
+		fmt.Fprintf(&b, "[^%d]: %s\n", i+1, note)
+	}
+	return b.String()
+}
+
+// citationNote describes a source as "SME name, file name (task title)", leaving out
+// whatever isn't known.
+func citationNote(source *entity.SMEKnowledgeSource) string {
+	note := source.SMEName
+	if source.FileName != nil && *source.FileName != "" {
+		note += ", " + *source.FileName
+	}
+	if source.TaskTitle != nil && *source.TaskTitle != "" {
+		note += " (" + *source.TaskTitle + ")"
+	}
+	return note
+}
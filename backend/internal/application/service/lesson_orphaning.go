@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+)
+
+// LessonOrphanNotifier tells a course owner an outline change removed generated lessons
+// from their course.
+type LessonOrphanNotifier interface {
+	NotifyLessonsOrphaned(ctx context.Context, userID uuid.UUID, courseID uuid.UUID, courseTitle string, count int) error
+}
+
+// SetLessonOrphaning makes outline approvals and lesson removals orphan generated
+// lessons in the same transaction as the outline change, and notifies the course owner
+// of lessons orphaned by someone else. Without a transactor the changes are made one
+// after the other.
+func (s *AIGenerationService) SetLessonOrphaning(tx repository.Transactor, notifier LessonOrphanNotifier) {
+	s.tx = tx
+	s.orphanNotifier = notifier
+}
+
+// withinTx calls fn in a single transaction, or directly when no transactor is set.
+func (s *AIGenerationService) withinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if s.tx == nil {
+		return fn(ctx)
+	}
+	return s.tx.WithinTx(ctx, fn)
+}
+
+// notifyLessonsOrphaned tells the course owner that count generated lessons were
+// orphaned, unless they made the change themselves.
+func (s *AIGenerationService) notifyLessonsOrphaned(ctx context.Context, actorID uuid.UUID, courseID uuid.UUID, count int) {
+	if s.orphanNotifier == nil || count == 0 {
+		return
+	}
+	course, err := s.courseRepo.GetByID(ctx, courseID)
+	if err != nil || course == nil {
+		s.logger.Warn("failed to get course for orphaned lessons notification", "courseID", courseID, "error", err)
+		return
+	}
+	if course.CreatedByUserID == actorID {
+		return
+	}
+
+	courseTitle := course.Title
+	if courseTitle == "" {
+		courseTitle = defaultCourseTitle
+	}
+	if err := s.orphanNotifier.NotifyLessonsOrphaned(ctx, course.CreatedByUserID, course.ID, courseTitle, count); err != nil {
+		s.logger.Warn("failed to send orphaned lessons notification", "courseID", course.ID, "error", err)
+	}
+}
@@ -0,0 +1,265 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+	"github.com/sogos/mirai-backend/internal/domain/tenant"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+type inTxKey struct{}
+
+// fakeTransactor marks the context it passes on, so fakes can tell whether they were
+// called inside the transaction.
+type fakeTransactor struct{}
+
+func (fakeTransactor) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(context.WithValue(ctx, inTxKey{}, true))
+}
+
+func inTx(ctx context.Context) bool {
+	return ctx.Value(inTxKey{}) != nil
+}
+
+type fakeOutlineRepo struct {
+	repository.CourseOutlineRepository
+	outline       *entity.CourseOutline
+	updatedInTx   bool
+	contentInTx   bool
+	contentUpdate *entity.OutlineContentUpdate
+}
+
+func (r *fakeOutlineRepo) GetByID(_ context.Context, id uuid.UUID) (*entity.CourseOutline, error) {
+	if r.outline.ID != id {
+		return nil, nil
+	}
+	outline := *r.outline
+	outline.Sections = nil
+	return &outline, nil
+}
+
+func (r *fakeOutlineRepo) Update(ctx context.Context, outline *entity.CourseOutline) error {
+	r.updatedInTx = inTx(ctx)
+	r.outline.ApprovalStatus = outline.ApprovalStatus
+	return nil
+}
+
+func (r *fakeOutlineRepo) UpdateOutlineContent(ctx context.Context, _ uuid.UUID, update entity.OutlineContentUpdate) error {
+	r.contentInTx = inTx(ctx)
+	r.contentUpdate = &update
+	return nil
+}
+
+type fakeOutlineSectionRepo struct {
+	repository.OutlineSectionRepository
+	outline *entity.CourseOutline
+}
+
+func (r *fakeOutlineSectionRepo) ListByOutlineID(_ context.Context, _ uuid.UUID) ([]*entity.OutlineSection, error) {
+	sections := make([]*entity.OutlineSection, len(r.outline.Sections))
+	for i := range r.outline.Sections {
+		section := r.outline.Sections[i]
+		section.Lessons = nil
+		sections[i] = &section
+	}
+	return sections, nil
+}
+
+type fakeOutlineLessonRepo struct {
+	repository.OutlineLessonRepository
+	outline *entity.CourseOutline
+}
+
+func (r *fakeOutlineLessonRepo) ListBySectionID(_ context.Context, sectionID uuid.UUID) ([]*entity.OutlineLesson, error) {
+	var lessons []*entity.OutlineLesson
+	for _, section := range r.outline.Sections {
+		if section.ID != sectionID {
+			continue
+		}
+		for i := range section.Lessons {
+			lessons = append(lessons, &section.Lessons[i])
+		}
+	}
+	return lessons, nil
+}
+
+type fakeGeneratedLessonRepo struct {
+	repository.GeneratedLessonRepository
+	lessons    []*entity.GeneratedLesson
+	syncErr    error
+	syncedInTx bool
+	orphaned   []uuid.UUID
+	orphanInTx bool
+}
+
+func (r *fakeGeneratedLessonRepo) GetByID(_ context.Context, id uuid.UUID) (*entity.GeneratedLesson, error) {
+	for _, lesson := range r.lessons {
+		if lesson.ID == id {
+			return lesson, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeGeneratedLessonRepo) SyncOrphansWithOutline(ctx context.Context, _, _ uuid.UUID) (int, error) {
+	r.syncedInTx = inTx(ctx)
+	if r.syncErr != nil {
+		return 0, r.syncErr
+	}
+	return 2, nil
+}
+
+func (r *fakeGeneratedLessonRepo) OrphanByOutlineLessonIDs(ctx context.Context, outlineLessonIDs []uuid.UUID) (int, error) {
+	r.orphanInTx = inTx(ctx)
+	r.orphaned = append(r.orphaned, outlineLessonIDs...)
+	return len(outlineLessonIDs), nil
+}
+
+type fakeAISettingsRepo struct {
+	repository.TenantAISettingsRepository
+}
+
+func (fakeAISettingsRepo) Get(context.Context, uuid.UUID) (*entity.TenantAISettings, error) {
+	return nil, nil
+}
+
+func (fakeAISettingsRepo) IncrementTokenUsage(context.Context, uuid.UUID, int64) error {
+	return nil
+}
+
+type fakeCourseRepo struct {
+	repository.CourseRepository
+	course *entity.Course
+}
+
+func (r *fakeCourseRepo) GetByID(_ context.Context, id uuid.UUID) (*entity.Course, error) {
+	if r.course.ID != id {
+		return nil, nil
+	}
+	return r.course, nil
+}
+
+type orphanNotification struct {
+	userID uuid.UUID
+	count  int
+}
+
+type fakeOrphanNotifier struct {
+	sent []orphanNotification
+}
+
+func (n *fakeOrphanNotifier) NotifyLessonsOrphaned(_ context.Context, userID uuid.UUID, _ uuid.UUID, _ string, count int) error {
+	n.sent = append(n.sent, orphanNotification{userID: userID, count: count})
+	return nil
+}
+
+type orphaningFixture struct {
+	ctx        context.Context
+	editor     *entity.User
+	owner      *entity.User
+	outline    *entity.CourseOutline
+	outlines   *fakeOutlineRepo
+	genLessons *fakeGeneratedLessonRepo
+	notifier   *fakeOrphanNotifier
+	svc        *AIGenerationService
+}
+
+// newOrphaningFixture sets up a pending outline of a course owned by someone other than
+// the editor.
+func newOrphaningFixture() *orphaningFixture {
+	outline := testOutline()
+	outline.ApprovalStatus = valueobject.OutlineApprovalStatusPendingReview
+	f := &orphaningFixture{
+		ctx:        tenant.WithTenantID(context.Background(), outline.TenantID),
+		editor:     &entity.User{ID: uuid.New(), KratosID: uuid.New(), TenantID: &outline.TenantID},
+		owner:      &entity.User{ID: uuid.New(), KratosID: uuid.New(), TenantID: &outline.TenantID},
+		outline:    outline,
+		outlines:   &fakeOutlineRepo{outline: outline},
+		genLessons: &fakeGeneratedLessonRepo{},
+		notifier:   &fakeOrphanNotifier{},
+	}
+	courses := &fakeCourseRepo{course: &entity.Course{ID: outline.CourseID, Title: "Course", CreatedByUserID: f.owner.ID}}
+	f.svc = &AIGenerationService{
+		userRepo:       &fakeUserRepo{users: []*entity.User{f.editor, f.owner}},
+		courseRepo:     courses,
+		outlineRepo:    f.outlines,
+		sectionRepo:    &fakeOutlineSectionRepo{outline: outline},
+		lessonRepo:     &fakeOutlineLessonRepo{outline: outline},
+		genLessonRepo:  f.genLessons,
+		aiSettingsRepo: fakeAISettingsRepo{},
+		logger:         nopLogger{},
+	}
+	f.svc.SetLessonOrphaning(fakeTransactor{}, f.notifier)
+	return f
+}
+
+func TestUpdateCourseOutlineOrphansRemovedLessons(t *testing.T) {
+	f := newOrphaningFixture()
+	first, second := f.outline.Sections[0], f.outline.Sections[1]
+	removed := first.Lessons[1].ID
+
+	_, err := f.svc.UpdateCourseOutline(f.ctx, f.editor.KratosID, f.outline.CourseID, f.outline.ID,
+		[]UpdateCourseOutlineSection{sectionUpdate(first, first.Lessons[0]), sectionUpdate(second, second.Lessons...)},
+		[]uuid.UUID{removed})
+	if err != nil {
+		t.Fatalf("UpdateCourseOutline() error = %v", err)
+	}
+
+	if len(f.genLessons.orphaned) != 1 || f.genLessons.orphaned[0] != removed {
+		t.Errorf("orphaned lessons of %v, want %v", f.genLessons.orphaned, removed)
+	}
+	if !f.genLessons.orphanInTx || !f.outlines.contentInTx {
+		t.Error("orphaning and the outline update didn't share a transaction")
+	}
+	if f.outlines.contentUpdate == nil || len(f.outlines.contentUpdate.RemovedLessonIDs) != 1 {
+		t.Error("outline lesson wasn't removed")
+	}
+	if len(f.notifier.sent) != 1 || f.notifier.sent[0] != (orphanNotification{userID: f.owner.ID, count: 1}) {
+		t.Errorf("notifications = %+v, want one to the course owner for 1 lesson", f.notifier.sent)
+	}
+}
+
+func TestApproveCourseOutlineOrphansInTransaction(t *testing.T) {
+	f := newOrphaningFixture()
+
+	if _, err := f.svc.ApproveCourseOutline(f.ctx, f.editor.KratosID, f.outline.ID); err != nil {
+		t.Fatalf("ApproveCourseOutline() error = %v", err)
+	}
+	if !f.outlines.updatedInTx || !f.genLessons.syncedInTx {
+		t.Error("approval and orphaning didn't share a transaction")
+	}
+	if len(f.notifier.sent) != 1 || f.notifier.sent[0] != (orphanNotification{userID: f.owner.ID, count: 2}) {
+		t.Errorf("notifications = %+v, want one to the course owner for 2 lessons", f.notifier.sent)
+	}
+}
+
+func TestApproveCourseOutlineFailsWhenOrphaningFails(t *testing.T) {
+	f := newOrphaningFixture()
+	f.genLessons.syncErr = errors.New("connection reset")
+
+	_, err := f.svc.ApproveCourseOutline(f.ctx, f.editor.KratosID, f.outline.ID)
+	if !errors.Is(err, domainerrors.ErrInternal) {
+		t.Fatalf("ApproveCourseOutline() error = %v, want ErrInternal", err)
+	}
+	if len(f.notifier.sent) != 0 {
+		t.Errorf("sent %d notifications for a failed approval", len(f.notifier.sent))
+	}
+}
+
+func TestOrphanedLessonsNotNotifiedToTheirOwnEditor(t *testing.T) {
+	f := newOrphaningFixture()
+
+	if _, err := f.svc.ApproveCourseOutline(f.ctx, f.owner.KratosID, f.outline.ID); err != nil {
+		t.Fatalf("ApproveCourseOutline() error = %v", err)
+	}
+	if len(f.notifier.sent) != 0 {
+		t.Errorf("course owner was notified of their own approval")
+	}
+}
@@ -0,0 +1,639 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sogos/mirai-backend/internal/domain/audit"
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+	"github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/domain/tenant"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+	"github.com/sogos/mirai-backend/internal/infrastructure/crypto"
+	"github.com/sogos/mirai-backend/pkg/httputil"
+)
+
+const (
+	// courseSyncMaxAttempts is how many times a delivery is tried before it is marked failed.
+	courseSyncMaxAttempts = 5
+
+	// courseSyncArtifactURLExpiry is how long the download link sent to a webhook stays valid.
+	courseSyncArtifactURLExpiry = 24 * time.Hour
+
+	// courseSyncBatchSize is how many due deliveries one worker tick processes at most.
+	courseSyncBatchSize = 10
+)
+
+// errCourseSyncPermanent marks delivery failures that retrying can't fix.
+var errCourseSyncPermanent = errors.New("delivery cannot be retried")
+
+// courseSyncRetryDelays is how long to wait before each retry; the last delay repeats.
+var courseSyncRetryDelays = []time.Duration{time.Minute, 5 * time.Minute, 15 * time.Minute, time.Hour}
+
+// CoursePublishListener is told when a course is published.
+type CoursePublishListener interface {
+	CoursePublished(ctx context.Context, course *entity.Course)
+}
+
+// SetCoursePublishListener enables reacting to courses being published, such as syncing
+// them to an LMS.
+func (s *CourseService) SetCoursePublishListener(listener CoursePublishListener) {
+	s.publishListener = listener
+}
+
+// CourseArchiveRenderer writes a course's export artifact to tenant storage.
+type CourseArchiveRenderer interface {
+	RenderCourseArchive(ctx context.Context, tenantID, courseID uuid.UUID, subpath string) (string, error)
+}
+
+// CourseSyncStorage reads rendered course artifacts back from tenant storage.
+type CourseSyncStorage interface {
+	OpenContent(ctx context.Context, tenantID uuid.UUID, subpath string) (io.ReadCloser, error)
+	GenerateDownloadURL(ctx context.Context, tenantID uuid.UUID, subpath string, expiry time.Duration) (string, error)
+}
+
+// LMSSyncService manages LMS connectors and delivers courses published in synced folders.
+type LMSSyncService struct {
+	userRepo      repository.UserRepository
+	courseRepo    repository.CourseRepository
+	folderRepo    repository.FolderRepository
+	connectorRepo repository.LMSConnectorRepository
+	settingRepo   repository.FolderSyncSettingRepository
+	deliveryRepo  repository.CourseSyncDeliveryRepository
+	renderer      CourseArchiveRenderer
+	storage       CourseSyncStorage
+	pusher        service.LMSPusher
+	encryptor     *crypto.Encryptor
+	auditLog      AuditLogger
+	debugLimiter  *userRateLimiter
+	logger        service.Logger
+}
+
+// NewLMSSyncService creates a new LMS sync service.
+func NewLMSSyncService(
+	userRepo repository.UserRepository,
+	courseRepo repository.CourseRepository,
+	folderRepo repository.FolderRepository,
+	connectorRepo repository.LMSConnectorRepository,
+	settingRepo repository.FolderSyncSettingRepository,
+	deliveryRepo repository.CourseSyncDeliveryRepository,
+	renderer CourseArchiveRenderer,
+	storage CourseSyncStorage,
+	pusher service.LMSPusher,
+	encryptor *crypto.Encryptor,
+	logger service.Logger,
+) *LMSSyncService {
+	return &LMSSyncService{
+		userRepo:      userRepo,
+		courseRepo:    courseRepo,
+		folderRepo:    folderRepo,
+		connectorRepo: connectorRepo,
+		settingRepo:   settingRepo,
+		deliveryRepo:  deliveryRepo,
+		renderer:      renderer,
+		storage:       storage,
+		pusher:        pusher,
+		encryptor:     encryptor,
+		debugLimiter:  newUserRateLimiter(webhookDebugRateLimit, webhookDebugRateWindow),
+		logger:        logger,
+	}
+}
+
+// SetAuditLogger enables audit logging of connector and folder sync changes.
+func (s *LMSSyncService) SetAuditLogger(logger AuditLogger) {
+	s.auditLog = logger
+}
+
+// lmsSyncAuditEntry describes a sync configuration change made by user.
+func lmsSyncAuditEntry(user *entity.User, action audit.Action, targetType audit.TargetType, targetID uuid.UUID, changes audit.Changes) audit.Entry {
+	return audit.Entry{
+		TenantID:    *user.TenantID,
+		ActorUserID: &user.ID,
+		Action:      action,
+		TargetType:  targetType,
+		TargetID:    targetID.String(),
+		Changes:     changes,
+	}
+}
+
+// getSettingsAdmin loads the user and checks they may manage the tenant's connectors.
+func (s *LMSSyncService) getSettingsAdmin(ctx context.Context, kratosID uuid.UUID) (*entity.User, error) {
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, domainerrors.ErrUserNotFound
+	}
+	if !user.CanManageSettings() {
+		return nil, domainerrors.ErrForbidden.WithMessage("only admins and owners can manage LMS connectors")
+	}
+	if user.TenantID == nil {
+		return nil, domainerrors.ErrUserHasNoCompany
+	}
+	return user, nil
+}
+
+// validateConnectorEndpoint checks the endpoint is an absolute HTTP(S) URL on a public
+// host. Loopback, private and link-local hosts are rejected so connectors can't be
+// pointed at the cluster or cloud metadata; the push client checks again when it connects.
+func validateConnectorEndpoint(ctx context.Context, endpoint string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Host == "" || (u.Scheme != "https" && u.Scheme != "http") {
+		return domainerrors.ErrInvalidInput.WithMessage("endpoint URL must be an absolute http or https URL")
+	}
+	if err := httputil.CheckPublicURL(ctx, u); err != nil {
+		if errors.Is(err, httputil.ErrNonPublicAddress) {
+			return domainerrors.ErrInvalidInput.WithMessage("endpoint URL must point to a public internet address")
+		}
+		return domainerrors.ErrInvalidInput.WithMessage("endpoint host could not be resolved")
+	}
+	return nil
+}
+
+// ConnectorInput contains the parameters for creating or updating an LMS connector.
+type ConnectorInput struct {
+	Name        string
+	Type        valueobject.LMSConnectorType
+	EndpointURL string
+	// Credential replaces the stored secret or token when non-nil; an empty string removes it.
+	Credential *string
+	Enabled    bool
+}
+
+// validate checks the input describes a usable connector.
+func (in ConnectorInput) validate(ctx context.Context) error {
+	if strings.TrimSpace(in.Name) == "" {
+		return domainerrors.ErrInvalidInput.WithMessage("connector name is required")
+	}
+	if !in.Type.IsValid() {
+		return domainerrors.ErrInvalidInput.WithMessage("invalid connector type")
+	}
+	return validateConnectorEndpoint(ctx, in.EndpointURL)
+}
+
+// CreateConnector registers a new LMS connector for the user's tenant.
+func (s *LMSSyncService) CreateConnector(ctx context.Context, kratosID uuid.UUID, in ConnectorInput) (*entity.LMSConnector, error) {
+	log := s.logger.With("kratosID", kratosID, "connectorName", in.Name)
+
+	user, err := s.getSettingsAdmin(ctx, kratosID)
+	if err != nil {
+		return nil, err
+	}
+	if err := in.validate(ctx); err != nil {
+		return nil, err
+	}
+
+	connector := &entity.LMSConnector{
+		TenantID:        *user.TenantID,
+		Name:            strings.TrimSpace(in.Name),
+		Type:            in.Type,
+		EndpointURL:     in.EndpointURL,
+		Enabled:         in.Enabled,
+		CreatedByUserID: user.ID,
+	}
+	if in.Credential != nil && *in.Credential != "" {
+		if connector.EncryptedCredentials, err = s.encryptor.EncryptString(*in.Credential); err != nil {
+			log.Error("failed to encrypt connector credentials", "error", err)
+			return nil, domainerrors.ErrInternal.WithCause(err)
+		}
+	}
+
+	changes := audit.Changes{}.
+		Field("name", "", connector.Name).
+		Field("type", "", connector.Type).
+		Field("endpoint_url", "", connector.EndpointURL)
+	if connector.HasCredentials() {
+		changes = changes.Sensitive("credentials")
+	}
+	entry := lmsSyncAuditEntry(user, audit.ActionLMSConnectorCreated, audit.TargetLMSConnector, uuid.Nil, changes)
+	if err := audited(ctx, s.auditLog, &entry, func(ctx context.Context) error {
+		if err := s.connectorRepo.Create(ctx, connector); err != nil {
+			return err
+		}
+		entry.TargetID = connector.ID.String()
+		return nil
+	}); err != nil {
+		log.Error("failed to create LMS connector", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	log.Info("LMS connector created", "connectorID", connector.ID)
+	return connector, nil
+}
+
+// ListConnectors returns the tenant's LMS connectors.
+func (s *LMSSyncService) ListConnectors(ctx context.Context, kratosID uuid.UUID) ([]*entity.LMSConnector, error) {
+	if _, err := s.getSettingsAdmin(ctx, kratosID); err != nil {
+		return nil, err
+	}
+	connectors, err := s.connectorRepo.List(ctx)
+	if err != nil {
+		s.logger.Error("failed to list LMS connectors", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	return connectors, nil
+}
+
+// getConnector loads a connector, returning not found for missing ones.
+func (s *LMSSyncService) getConnector(ctx context.Context, connectorID uuid.UUID) (*entity.LMSConnector, error) {
+	connector, err := s.connectorRepo.GetByID(ctx, connectorID)
+	if err != nil {
+		s.logger.Error("failed to get LMS connector", "connectorID", connectorID, "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	if connector == nil {
+		return nil, domainerrors.ErrNotFound.WithMessage("LMS connector not found")
+	}
+	return connector, nil
+}
+
+// UpdateConnector changes an LMS connector. The stored credential is kept unless
+// in.Credential is set.
+func (s *LMSSyncService) UpdateConnector(ctx context.Context, kratosID uuid.UUID, connectorID uuid.UUID, in ConnectorInput) (*entity.LMSConnector, error) {
+	log := s.logger.With("kratosID", kratosID, "connectorID", connectorID)
+
+	user, err := s.getSettingsAdmin(ctx, kratosID)
+	if err != nil {
+		return nil, err
+	}
+	if err := in.validate(ctx); err != nil {
+		return nil, err
+	}
+	connector, err := s.getConnector(ctx, connectorID)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := audit.Changes{}.
+		Field("name", connector.Name, strings.TrimSpace(in.Name)).
+		Field("type", connector.Type, in.Type).
+		Field("endpoint_url", connector.EndpointURL, in.EndpointURL).
+		Field("enabled", connector.Enabled, in.Enabled)
+
+	connector.Name = strings.TrimSpace(in.Name)
+	connector.Type = in.Type
+	connector.EndpointURL = in.EndpointURL
+	connector.Enabled = in.Enabled
+	if in.Credential != nil {
+		connector.EncryptedCredentials = nil
+		if *in.Credential != "" {
+			if connector.EncryptedCredentials, err = s.encryptor.EncryptString(*in.Credential); err != nil {
+				log.Error("failed to encrypt connector credentials", "error", err)
+				return nil, domainerrors.ErrInternal.WithCause(err)
+			}
+		}
+		changes = changes.Sensitive("credentials")
+	}
+
+	entry := lmsSyncAuditEntry(user, audit.ActionLMSConnectorUpdated, audit.TargetLMSConnector, connector.ID, changes)
+	if err := audited(ctx, s.auditLog, &entry, func(ctx context.Context) error {
+		return s.connectorRepo.Update(ctx, connector)
+	}); err != nil {
+		log.Error("failed to update LMS connector", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	log.Info("LMS connector updated")
+	return connector, nil
+}
+
+// DeleteConnector removes an LMS connector. Folders that synced to it stop syncing and
+// its delivery history is removed.
+func (s *LMSSyncService) DeleteConnector(ctx context.Context, kratosID uuid.UUID, connectorID uuid.UUID) error {
+	log := s.logger.With("kratosID", kratosID, "connectorID", connectorID)
+
+	user, err := s.getSettingsAdmin(ctx, kratosID)
+	if err != nil {
+		return err
+	}
+	connector, err := s.getConnector(ctx, connectorID)
+	if err != nil {
+		return err
+	}
+
+	entry := lmsSyncAuditEntry(user, audit.ActionLMSConnectorDeleted, audit.TargetLMSConnector, connector.ID,
+		audit.Changes{}.Field("name", connector.Name, ""))
+	if err := audited(ctx, s.auditLog, &entry, func(ctx context.Context) error {
+		return s.connectorRepo.Delete(ctx, connectorID)
+	}); err != nil {
+		log.Error("failed to delete LMS connector", "error", err)
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+
+	log.Info("LMS connector deleted")
+	return nil
+}
+
+// GetFolderSync returns the connector a folder syncs to, or nil if it doesn't sync.
+func (s *LMSSyncService) GetFolderSync(ctx context.Context, kratosID uuid.UUID, folderID uuid.UUID) (*entity.FolderSyncSetting, error) {
+	if _, err := s.getSettingsAdmin(ctx, kratosID); err != nil {
+		return nil, err
+	}
+	setting, err := s.settingRepo.GetByFolderID(ctx, folderID)
+	if err != nil {
+		s.logger.Error("failed to get folder sync setting", "folderID", folderID, "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	return setting, nil
+}
+
+// SetFolderSync makes courses published in a folder sync to a connector. A nil
+// connectorID stops the folder from syncing.
+func (s *LMSSyncService) SetFolderSync(ctx context.Context, kratosID uuid.UUID, folderID uuid.UUID, connectorID *uuid.UUID) (*entity.FolderSyncSetting, error) {
+	log := s.logger.With("kratosID", kratosID, "folderID", folderID)
+
+	user, err := s.getSettingsAdmin(ctx, kratosID)
+	if err != nil {
+		return nil, err
+	}
+
+	folder, err := s.folderRepo.GetByID(ctx, folderID)
+	if err != nil {
+		log.Error("failed to get folder", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	if folder == nil {
+		return nil, domainerrors.ErrNotFound.WithMessage("folder not found")
+	}
+
+	current, err := s.settingRepo.GetByFolderID(ctx, folderID)
+	if err != nil {
+		log.Error("failed to get folder sync setting", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	var before string
+	if current != nil {
+		before = current.ConnectorID.String()
+	}
+
+	if connectorID == nil {
+		entry := lmsSyncAuditEntry(user, audit.ActionFolderSyncUpdated, audit.TargetFolder, folder.ID,
+			audit.Changes{}.Field("sync_connector_id", before, ""))
+		if err := audited(ctx, s.auditLog, &entry, func(ctx context.Context) error {
+			return s.settingRepo.Delete(ctx, folderID)
+		}); err != nil {
+			log.Error("failed to clear folder sync setting", "error", err)
+			return nil, domainerrors.ErrInternal.WithCause(err)
+		}
+		log.Info("folder sync disabled")
+		return nil, nil
+	}
+
+	if _, err := s.getConnector(ctx, *connectorID); err != nil {
+		return nil, err
+	}
+
+	setting := &entity.FolderSyncSetting{
+		FolderID:    folder.ID,
+		TenantID:    folder.TenantID,
+		ConnectorID: *connectorID,
+	}
+	entry := lmsSyncAuditEntry(user, audit.ActionFolderSyncUpdated, audit.TargetFolder, folder.ID,
+		audit.Changes{}.Field("sync_connector_id", before, connectorID.String()))
+	if err := audited(ctx, s.auditLog, &entry, func(ctx context.Context) error {
+		return s.settingRepo.Upsert(ctx, setting)
+	}); err != nil {
+		log.Error("failed to set folder sync setting", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	log.Info("folder sync enabled", "connectorID", connectorID)
+	return setting, nil
+}
+
+// CoursePublished queues a delivery when the course's folder syncs to an enabled
+// connector. Failures are logged; publishing the course has already succeeded.
+func (s *LMSSyncService) CoursePublished(ctx context.Context, course *entity.Course) {
+	if course.FolderID == nil {
+		return
+	}
+	log := s.logger.With("courseID", course.ID, "folderID", course.FolderID)
+
+	setting, err := s.settingRepo.GetByFolderID(ctx, *course.FolderID)
+	if err != nil {
+		log.Error("failed to get folder sync setting", "error", err)
+		return
+	}
+	if setting == nil {
+		return
+	}
+
+	connector, err := s.connectorRepo.GetByID(ctx, setting.ConnectorID)
+	if err != nil {
+		log.Error("failed to get LMS connector", "connectorID", setting.ConnectorID, "error", err)
+		return
+	}
+	if connector == nil || !connector.Enabled {
+		return
+	}
+
+	delivery := &entity.CourseSyncDelivery{
+		TenantID:      course.TenantID,
+		ConnectorID:   connector.ID,
+		CourseID:      &course.ID,
+		Kind:          valueobject.CourseSyncDeliveryKindSync,
+		Event:         service.LMSEventCoursePublished,
+		Status:        valueobject.CourseSyncStatusPending,
+		MaxAttempts:   courseSyncMaxAttempts,
+		NextAttemptAt: time.Now(),
+	}
+	if err := s.deliveryRepo.Create(ctx, delivery); err != nil {
+		log.Error("failed to queue course sync delivery", "connectorID", connector.ID, "error", err)
+		return
+	}
+
+	log.Info("course sync delivery queued", "deliveryID", delivery.ID, "connectorID", connector.ID)
+}
+
+// CourseSyncStatus is the latest delivery of a course to one connector.
+type CourseSyncStatus struct {
+	Connector *entity.LMSConnector // nil if the connector has since been removed
+	Delivery  *entity.CourseSyncDelivery
+}
+
+// GetSyncStatus returns the latest delivery of a course to each connector it was synced to.
+func (s *LMSSyncService) GetSyncStatus(ctx context.Context, kratosID uuid.UUID, courseID uuid.UUID) ([]CourseSyncStatus, error) {
+	log := s.logger.With("kratosID", kratosID, "courseID", courseID)
+
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, domainerrors.ErrUserNotFound
+	}
+	if user.TenantID == nil {
+		return nil, domainerrors.ErrUserHasNoCompany
+	}
+
+	course, err := s.courseRepo.GetByID(ctx, courseID)
+	if err != nil {
+		log.Error("failed to get course", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	if course == nil {
+		return nil, domainerrors.ErrNotFound.WithMessage("course not found")
+	}
+
+	deliveries, err := s.deliveryRepo.ListByCourseID(ctx, courseID)
+	if err != nil {
+		log.Error("failed to list course sync deliveries", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	statuses := []CourseSyncStatus{}
+	seen := make(map[uuid.UUID]bool)
+	for _, delivery := range deliveries {
+		if seen[delivery.ConnectorID] {
+			continue
+		}
+		seen[delivery.ConnectorID] = true
+
+		connector, err := s.connectorRepo.GetByID(ctx, delivery.ConnectorID)
+		if err != nil {
+			log.Warn("failed to get LMS connector", "connectorID", delivery.ConnectorID, "error", err)
+		}
+		statuses = append(statuses, CourseSyncStatus{Connector: connector, Delivery: delivery})
+	}
+	return statuses, nil
+}
+
+// ProcessDueDeliveries attempts up to courseSyncBatchSize deliveries whose next attempt
+// is due and returns how many it attempted.
+func (s *LMSSyncService) ProcessDueDeliveries(ctx context.Context) (int, error) {
+	processed := 0
+	for processed < courseSyncBatchSize {
+		if ctx.Err() != nil {
+			return processed, nil
+		}
+		delivery, err := s.deliveryRepo.ClaimNextDue(tenant.WithSuperAdmin(ctx, true))
+		if err != nil {
+			return processed, fmt.Errorf("failed to claim course sync delivery: %w", err)
+		}
+		if delivery == nil {
+			return processed, nil
+		}
+		processed++
+		s.deliver(tenant.WithTenantID(ctx, delivery.TenantID), delivery)
+	}
+	return processed, nil
+}
+
+// deliver renders the course on the first attempt, pushes it to the connector and records
+// the outcome, scheduling a retry with backoff when the failure may be temporary.
+func (s *LMSSyncService) deliver(ctx context.Context, delivery *entity.CourseSyncDelivery) {
+	log := s.logger.With("deliveryID", delivery.ID, "courseID", delivery.CourseID, "connectorID", delivery.ConnectorID, "attempt", delivery.Attempts)
+
+	result, err := s.push(ctx, delivery)
+	if result != nil && result.StatusCode != 0 {
+		delivery.ResponseStatus = &result.StatusCode
+	}
+	if err == nil {
+		delivery.Status = valueobject.CourseSyncStatusDelivered
+		delivery.LastError = nil
+		if result != nil && result.RemoteID != "" {
+			delivery.RemoteID = &result.RemoteID
+		}
+		log.Info("course delivered to LMS", "remoteID", delivery.RemoteID)
+	} else {
+		msg := err.Error()
+		delivery.LastError = &msg
+		// Endpoints that resolve to a non-public address stay blocked on retry
+		retryable := !errors.Is(err, errCourseSyncPermanent) && !errors.Is(err, httputil.ErrNonPublicAddress) &&
+			(result == nil || result.Retryable())
+		if retryable && delivery.CanRetry() {
+			delivery.Status = valueobject.CourseSyncStatusPending
+			delivery.NextAttemptAt = time.Now().Add(courseSyncRetryDelay(delivery.Attempts))
+			log.Warn("course delivery failed, will retry", "nextAttemptAt", delivery.NextAttemptAt, "error", err)
+		} else {
+			delivery.Status = valueobject.CourseSyncStatusFailed
+			log.Error("course delivery failed", "error", err)
+		}
+	}
+
+	if err := s.deliveryRepo.Update(context.WithoutCancel(ctx), delivery); err != nil {
+		log.Error("failed to record course delivery result", "error", err)
+	}
+}
+
+// courseSyncRetryDelay returns the wait before the retry that follows attempt.
+func courseSyncRetryDelay(attempt int) time.Duration {
+	i := min(max(attempt-1, 0), len(courseSyncRetryDelays)-1)
+	return courseSyncRetryDelays[i]
+}
+
+// push renders the artifact if needed and sends it. Errors without a result mean the
+// LMS never answered and are retried unless they wrap errCourseSyncPermanent. A replay
+// is sent under the ID of the delivery it re-sends, so receivers see the same delivery.
+func (s *LMSSyncService) push(ctx context.Context, delivery *entity.CourseSyncDelivery) (*service.LMSPushResult, error) {
+	connector, err := s.connectorRepo.GetByID(ctx, delivery.ConnectorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get LMS connector: %w", err)
+	}
+	if connector == nil {
+		return nil, fmt.Errorf("LMS connector no longer exists: %w", errCourseSyncPermanent)
+	}
+
+	var credential string
+	if connector.HasCredentials() {
+		if credential, err = s.encryptor.DecryptString(connector.EncryptedCredentials); err != nil {
+			return nil, fmt.Errorf("failed to decrypt connector credentials: %w", errors.Join(errCourseSyncPermanent, err))
+		}
+	}
+
+	if delivery.CourseID == nil {
+		return nil, fmt.Errorf("delivery has no course: %w", errCourseSyncPermanent)
+	}
+	course, err := s.courseRepo.GetByID(ctx, *delivery.CourseID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get course: %w", err)
+	}
+	if course == nil {
+		return nil, fmt.Errorf("course no longer exists: %w", errCourseSyncPermanent)
+	}
+
+	fileName := exportFileSlug(course.Title) + "-lessons.zip"
+	if delivery.ArtifactPath == nil {
+		subpath := path.Join("exports", "sync", delivery.ID.String(), fileName)
+		if _, err := s.renderer.RenderCourseArchive(ctx, delivery.TenantID, course.ID, subpath); err != nil {
+			return nil, fmt.Errorf("failed to render course export: %w", err)
+		}
+		delivery.ArtifactPath = &subpath
+	}
+
+	req := service.LMSPushRequest{
+		Type:        connector.Type,
+		EndpointURL: connector.EndpointURL,
+		Credential:  credential,
+		Event:       delivery.Event,
+		Kind:        delivery.Kind,
+		DeliveryID:  delivery.ID,
+		CourseID:    course.ID,
+		CourseTitle: course.Title,
+		FileName:    fileName,
+	}
+	if delivery.ReplayOfID != nil {
+		req.DeliveryID = *delivery.ReplayOfID
+	}
+
+	switch connector.Type {
+	case valueobject.LMSConnectorTypeWebhook:
+		req.DownloadURL, err = s.storage.GenerateDownloadURL(ctx, delivery.TenantID, *delivery.ArtifactPath, courseSyncArtifactURLExpiry)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate export download URL: %w", err)
+		}
+		return s.pusher.Push(ctx, req)
+	default:
+		artifact, err := s.storage.OpenContent(ctx, delivery.TenantID, *delivery.ArtifactPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open course export: %w", err)
+		}
+		defer artifact.Close()
+		req.Artifact = artifact
+		return s.pusher.Push(ctx, req)
+	}
+}
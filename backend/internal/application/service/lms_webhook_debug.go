@@ -0,0 +1,215 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+const (
+	// webhookDebugRateLimit is how many test and replay deliveries an admin can send per
+	// webhookDebugRateWindow, together.
+	webhookDebugRateLimit  = 10
+	webhookDebugRateWindow = time.Minute
+
+	// webhookTestCourseTitle and webhookTestFileName fill the synthetic course.published event.
+	webhookTestCourseTitle = "Mirai test course"
+	webhookTestFileName    = "mirai-test-course-lessons.zip"
+)
+
+// WebhookAttempt is the outcome of a test or replay delivery.
+type WebhookAttempt struct {
+	Connector *entity.LMSConnector
+	Delivery  *entity.CourseSyncDelivery // Recorded delivery; ResponseStatus and LastError describe the answer
+	Latency   time.Duration              // Zero when the receiver was never reached
+	Body      string                     // Start of the receiver's response body; only public endpoints are reached
+}
+
+// TestWebhook sends a synthetic, signed event of the given type to a webhook connector so
+// admins can check their receiver. The attempt is recorded as a test delivery and tried
+// once. Disabled connectors can be tested.
+func (s *LMSSyncService) TestWebhook(ctx context.Context, kratosID uuid.UUID, connectorID uuid.UUID, event string) (*WebhookAttempt, error) {
+	log := s.logger.With("kratosID", kratosID, "connectorID", connectorID, "event", event)
+
+	if event == "" {
+		event = service.LMSEventCoursePublished
+	}
+	if event != service.LMSEventCoursePublished && event != service.LMSEventPing {
+		return nil, domainerrors.ErrInvalidInput.WithMessage("unsupported webhook event type")
+	}
+
+	user, err := s.getSettingsAdmin(ctx, kratosID)
+	if err != nil {
+		return nil, err
+	}
+	connector, err := s.getWebhookConnector(ctx, connectorID)
+	if err != nil {
+		return nil, err
+	}
+	if !s.debugLimiter.Allow(user.ID) {
+		return nil, domainerrors.ErrRateLimited.WithMessage("too many webhook tests, try again in a minute")
+	}
+
+	credential, err := s.connectorCredential(connector)
+	if err != nil {
+		log.Error("failed to decrypt connector credentials", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	now := time.Now()
+	delivery := &entity.CourseSyncDelivery{
+		TenantID:      connector.TenantID,
+		ConnectorID:   connector.ID,
+		Kind:          valueobject.CourseSyncDeliveryKindTest,
+		Event:         event,
+		Status:        valueobject.CourseSyncStatusDelivering,
+		Attempts:      1,
+		MaxAttempts:   1,
+		NextAttemptAt: now,
+		LastAttemptAt: &now,
+	}
+	if err := s.deliveryRepo.Create(ctx, delivery); err != nil {
+		log.Error("failed to record webhook test delivery", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	result, err := s.pusher.Push(ctx, service.LMSPushRequest{
+		Type:        connector.Type,
+		EndpointURL: connector.EndpointURL,
+		Credential:  credential,
+		Event:       event,
+		Kind:        delivery.Kind,
+		DeliveryID:  delivery.ID,
+		CourseTitle: webhookTestCourseTitle,
+		FileName:    webhookTestFileName,
+	})
+	attempt := s.recordDiagnosticAttempt(ctx, connector, delivery, result, err)
+	log.Info("webhook test sent", "deliveryID", delivery.ID, "status", delivery.Status, "responseStatus", delivery.ResponseStatus)
+	return attempt, nil
+}
+
+// ReplayWebhookDelivery re-sends an earlier delivery to its webhook connector, for
+// receivers that were down. The event is rebuilt for the same course, export and
+// delivery ID, with a new timestamp, signature and download link. The attempt is
+// recorded as a replay delivery and tried once.
+func (s *LMSSyncService) ReplayWebhookDelivery(ctx context.Context, kratosID uuid.UUID, deliveryID uuid.UUID) (*WebhookAttempt, error) {
+	log := s.logger.With("kratosID", kratosID, "deliveryID", deliveryID)
+
+	user, err := s.getSettingsAdmin(ctx, kratosID)
+	if err != nil {
+		return nil, err
+	}
+
+	original, err := s.deliveryRepo.GetByID(ctx, deliveryID)
+	if err != nil {
+		log.Error("failed to get course sync delivery", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	if original == nil {
+		return nil, domainerrors.ErrNotFound.WithMessage("delivery not found")
+	}
+	switch {
+	case original.Kind == valueobject.CourseSyncDeliveryKindTest:
+		return nil, domainerrors.ErrInvalidInput.WithMessage("test deliveries can't be replayed; send a new test instead")
+	case original.Status == valueobject.CourseSyncStatusPending || original.Status == valueobject.CourseSyncStatusDelivering:
+		return nil, domainerrors.ErrInvalidInput.WithMessage("delivery is still being retried")
+	case original.CourseID == nil || original.ArtifactPath == nil:
+		return nil, domainerrors.ErrInvalidInput.WithMessage("delivery was never sent, so there is nothing to replay")
+	}
+
+	connector, err := s.getWebhookConnector(ctx, original.ConnectorID)
+	if err != nil {
+		return nil, err
+	}
+	if !s.debugLimiter.Allow(user.ID) {
+		return nil, domainerrors.ErrRateLimited.WithMessage("too many webhook replays, try again in a minute")
+	}
+
+	// A replay of a replay re-sends the same original delivery
+	replayOf := original.ID
+	if original.ReplayOfID != nil {
+		replayOf = *original.ReplayOfID
+	}
+	now := time.Now()
+	delivery := &entity.CourseSyncDelivery{
+		TenantID:      original.TenantID,
+		ConnectorID:   original.ConnectorID,
+		CourseID:      original.CourseID,
+		Kind:          valueobject.CourseSyncDeliveryKindReplay,
+		Event:         original.Event,
+		ReplayOfID:    &replayOf,
+		Status:        valueobject.CourseSyncStatusDelivering,
+		Attempts:      1,
+		MaxAttempts:   1,
+		NextAttemptAt: now,
+		LastAttemptAt: &now,
+		ArtifactPath:  original.ArtifactPath,
+	}
+	if err := s.deliveryRepo.Create(ctx, delivery); err != nil {
+		log.Error("failed to record webhook replay delivery", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	result, err := s.push(ctx, delivery)
+	attempt := s.recordDiagnosticAttempt(ctx, connector, delivery, result, err)
+	log.Info("webhook delivery replayed", "replayID", delivery.ID, "status", delivery.Status, "responseStatus", delivery.ResponseStatus)
+	return attempt, nil
+}
+
+// getWebhookConnector loads a connector, rejecting ones that aren't webhooks. The
+// endpoint is checked again, since tests and replays return the receiver's response
+// and connectors saved before endpoints were checked may point at internal hosts.
+func (s *LMSSyncService) getWebhookConnector(ctx context.Context, connectorID uuid.UUID) (*entity.LMSConnector, error) {
+	connector, err := s.getConnector(ctx, connectorID)
+	if err != nil {
+		return nil, err
+	}
+	if connector.Type != valueobject.LMSConnectorTypeWebhook {
+		return nil, domainerrors.ErrInvalidInput.WithMessage("only webhook connectors can be tested or replayed")
+	}
+	if err := validateConnectorEndpoint(ctx, connector.EndpointURL); err != nil {
+		return nil, err
+	}
+	return connector, nil
+}
+
+// connectorCredential returns the connector's decrypted secret or token, or "" if none is set.
+func (s *LMSSyncService) connectorCredential(connector *entity.LMSConnector) (string, error) {
+	if !connector.HasCredentials() {
+		return "", nil
+	}
+	return s.encryptor.DecryptString(connector.EncryptedCredentials)
+}
+
+// recordDiagnosticAttempt stores the outcome of a test or replay delivery. Diagnostic
+// deliveries are never retried, so any failure is final.
+func (s *LMSSyncService) recordDiagnosticAttempt(ctx context.Context, connector *entity.LMSConnector, delivery *entity.CourseSyncDelivery, result *service.LMSPushResult, pushErr error) *WebhookAttempt {
+	attempt := &WebhookAttempt{Connector: connector, Delivery: delivery}
+	if result != nil {
+		attempt.Latency = result.Latency
+		attempt.Body = result.Body
+		if result.StatusCode != 0 {
+			delivery.ResponseStatus = &result.StatusCode
+		}
+	}
+	if pushErr == nil {
+		delivery.Status = valueobject.CourseSyncStatusDelivered
+		if result != nil && result.RemoteID != "" {
+			delivery.RemoteID = &result.RemoteID
+		}
+	} else {
+		msg := pushErr.Error()
+		delivery.Status = valueobject.CourseSyncStatusFailed
+		delivery.LastError = &msg
+	}
+
+	if err := s.deliveryRepo.Update(context.WithoutCancel(ctx), delivery); err != nil {
+		s.logger.Error("failed to record webhook delivery result", "deliveryID", delivery.ID, "error", err)
+	}
+	return attempt
+}
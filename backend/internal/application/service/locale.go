@@ -0,0 +1,26 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+// TenantLocaleProvider looks up the locale a tenant has picked for its emails and exports.
+type TenantLocaleProvider interface {
+	// TenantLocale returns the tenant's locale, or nil if it hasn't picked one.
+	TenantLocale(ctx context.Context, tenantID uuid.UUID) *valueobject.Locale
+}
+
+// resolveLocale returns the locale to write to user in: the organization's when an
+// admin has picked one, otherwise the user's own.
+func resolveLocale(ctx context.Context, provider TenantLocaleProvider, user *entity.User) valueobject.Locale {
+	if provider != nil && user.TenantID != nil {
+		if locale := provider.TenantLocale(ctx, *user.TenantID); locale != nil {
+			return *locale
+		}
+	}
+	return user.Locale
+}
@@ -0,0 +1,187 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/infrastructure/cache"
+)
+
+const (
+	// defaultMaintenanceDuration is used when maintenance is enabled without a duration.
+	defaultMaintenanceDuration = time.Hour
+
+	// maxMaintenanceDuration caps how long the flag stays set so a forgotten
+	// maintenance window cannot leave the API read-only indefinitely.
+	maxMaintenanceDuration = 24 * time.Hour
+
+	// maintenanceStatusRefresh is how long a process reuses the last flag read
+	// before checking Redis again. Every API pod and worker reads the flag.
+	maintenanceStatusRefresh = 5 * time.Second
+)
+
+// MaintenanceStatus describes the read-only maintenance flag.
+type MaintenanceStatus struct {
+	Enabled   bool      `json:"enabled"`
+	Reason    string    `json:"reason,omitempty"`
+	EnabledBy string    `json:"enabled_by,omitempty"`
+	StartedAt time.Time `json:"started_at"`
+	EndsAt    time.Time `json:"ends_at"`
+}
+
+// RetryAfter returns how long clients should wait before retrying a write.
+func (s *MaintenanceStatus) RetryAfter() time.Duration {
+	if s == nil || !s.Enabled {
+		return 0
+	}
+	remaining := time.Until(s.EndsAt)
+	if remaining <= 0 {
+		return 0
+	}
+	// Clients poll again well before the window ends in case it is cleared early.
+	if remaining > 5*time.Minute {
+		return 5 * time.Minute
+	}
+	return remaining.Round(time.Second)
+}
+
+// MaintenanceService manages the read-only maintenance flag shared through Redis.
+type MaintenanceService struct {
+	cache            cache.Cache
+	superAdminEmails map[string]bool
+	logger           service.Logger
+
+	mu        sync.Mutex
+	current   *MaintenanceStatus
+	fetchedAt time.Time
+}
+
+// NewMaintenanceService creates a new maintenance service.
+func NewMaintenanceService(
+	cache cache.Cache,
+	superAdminEmails []string,
+	logger service.Logger,
+) *MaintenanceService {
+	admins := make(map[string]bool, len(superAdminEmails))
+	for _, email := range superAdminEmails {
+		admins[strings.ToLower(email)] = true
+	}
+	return &MaintenanceService{
+		cache:            cache,
+		superAdminEmails: admins,
+		logger:           logger,
+	}
+}
+
+// Status returns the current maintenance flag. Reads are memoized for a few seconds;
+// if Redis is unreachable the last known state is returned.
+func (s *MaintenanceService) Status(ctx context.Context) *MaintenanceStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.current != nil && time.Since(s.fetchedAt) < maintenanceStatusRefresh {
+		return s.current
+	}
+
+	status, err := s.load(ctx)
+	if err != nil {
+		s.logger.Warn("failed to read maintenance flag", "error", err)
+		if s.current != nil {
+			return s.current
+		}
+		return &MaintenanceStatus{}
+	}
+
+	s.current = status
+	s.fetchedAt = time.Now()
+	return status
+}
+
+// IsEnabled reports whether the API and worker should refuse new writes.
+func (s *MaintenanceService) IsEnabled(ctx context.Context) bool {
+	return s.Status(ctx).Enabled
+}
+
+// Enable turns on read-only maintenance mode for the given duration. Superadmin only.
+func (s *MaintenanceService) Enable(ctx context.Context, email, reason string, duration time.Duration) (*MaintenanceStatus, error) {
+	if !s.IsSuperAdmin(email) {
+		return nil, domainerrors.ErrForbidden.WithMessage("only superadmins can change maintenance mode")
+	}
+
+	if duration <= 0 {
+		duration = defaultMaintenanceDuration
+	}
+	if duration > maxMaintenanceDuration {
+		return nil, domainerrors.ErrInvalidInput.WithMessage("maintenance duration cannot exceed 24 hours")
+	}
+
+	now := time.Now()
+	status := &MaintenanceStatus{
+		Enabled:   true,
+		Reason:    reason,
+		EnabledBy: email,
+		StartedAt: now,
+		EndsAt:    now.Add(duration),
+	}
+
+	if _, err := s.cache.Set(ctx, cache.GlobalCacheKeys.MaintenanceMode(), status, "", duration); err != nil {
+		s.logger.Error("failed to enable maintenance mode", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	// The cache drops writes while Redis is down, which would leave other pods unaware
+	if stored, err := s.load(ctx); err != nil || !stored.Enabled {
+		s.logger.Error("maintenance mode was not stored", "error", err)
+		return nil, domainerrors.ErrExternalService.WithMessage("maintenance mode could not be saved - the shared cache is unavailable")
+	}
+
+	s.remember(status)
+	s.logger.Warn("maintenance mode enabled", "by", email, "reason", reason, "endsAt", status.EndsAt)
+	return status, nil
+}
+
+// Disable turns off maintenance mode. Superadmin only.
+func (s *MaintenanceService) Disable(ctx context.Context, email string) (*MaintenanceStatus, error) {
+	if !s.IsSuperAdmin(email) {
+		return nil, domainerrors.ErrForbidden.WithMessage("only superadmins can change maintenance mode")
+	}
+
+	if err := s.cache.Delete(ctx, cache.GlobalCacheKeys.MaintenanceMode()); err != nil {
+		s.logger.Error("failed to disable maintenance mode", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	status := &MaintenanceStatus{}
+	s.remember(status)
+	s.logger.Warn("maintenance mode disabled", "by", email)
+	return status, nil
+}
+
+// load reads the flag from the shared cache. A missing or expired key means maintenance is off.
+func (s *MaintenanceService) load(ctx context.Context) (*MaintenanceStatus, error) {
+	var status MaintenanceStatus
+	entry, err := s.cache.Get(ctx, cache.GlobalCacheKeys.MaintenanceMode(), &status)
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil || !status.Enabled || time.Now().After(status.EndsAt) {
+		return &MaintenanceStatus{}, nil
+	}
+	return &status, nil
+}
+
+func (s *MaintenanceService) remember(status *MaintenanceStatus) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.current = status
+	s.fetchedAt = time.Now()
+}
+
+// IsSuperAdmin reports whether the email is listed in SUPERADMIN_EMAILS.
+func (s *MaintenanceService) IsSuperAdmin(email string) bool {
+	return email != "" && s.superAdminEmails[strings.ToLower(email)]
+}
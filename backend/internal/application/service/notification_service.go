@@ -9,6 +9,7 @@ import (
 	v1 "github.com/sogos/mirai-backend/gen/mirai/v1"
 	"github.com/sogos/mirai-backend/internal/domain/entity"
 	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/links"
 	"github.com/sogos/mirai-backend/internal/domain/repository"
 	"github.com/sogos/mirai-backend/internal/domain/service"
 	"github.com/sogos/mirai-backend/internal/domain/valueobject"
@@ -22,7 +23,12 @@ type NotificationService struct {
 	notificationRepo repository.NotificationRepository
 	identityProvider service.IdentityProvider
 	emailProvider    service.EmailProvider
+	emailLogRepo     repository.EmailLogRepository
 	publisher        pubsub.Publisher
+	locales          TenantLocaleProvider
+	summaryRepo      repository.WeeklySummaryRepository
+	settingsRepo     repository.TenantAISettingsRepository
+	exportLinks      ExportLinkIssuer
 	baseURL          string
 	logger           service.Logger
 }
@@ -48,6 +54,22 @@ func NewNotificationService(
 	}
 }
 
+// SetTenantLocaleProvider makes notification emails follow the organization's locale.
+func (s *NotificationService) SetTenantLocaleProvider(provider TenantLocaleProvider) {
+	s.locales = provider
+}
+
+// ExportLinkIssuer creates the signed download links sent in export ready emails.
+type ExportLinkIssuer interface {
+	IssueDownloadLink(ctx context.Context, tenantID, jobID, userID uuid.UUID, filePath string) (string, time.Time, error)
+}
+
+// SetExportDownloadLinks enables export ready emails. Without it, finished exports only
+// get an in-app notification.
+func (s *NotificationService) SetExportDownloadLinks(links ExportLinkIssuer) {
+	s.exportLinks = links
+}
+
 // CreateNotificationRequest contains the parameters for creating a notification.
 type CreateNotificationRequest struct {
 	UserID    uuid.UUID
@@ -248,6 +270,35 @@ func (s *NotificationService) MarkAllAsRead(ctx context.Context, kratosID uuid.U
 	return count, nil
 }
 
+// MarkAsReadByFilter marks all of the current user's notifications matching the filter as read.
+// Unlike MarkAllAsRead, no per-notification READ events are published since the match can be
+// arbitrarily large - clients should refresh their unread count from the returned total.
+func (s *NotificationService) MarkAsReadByFilter(ctx context.Context, kratosID uuid.UUID, filter entity.NotificationReadFilter) (int, error) {
+	log := s.logger.With("kratosID", kratosID)
+
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return 0, domainerrors.ErrUserNotFound
+	}
+
+	if user.TenantID == nil {
+		return 0, domainerrors.ErrUserHasNoCompany
+	}
+
+	if filter.Type != nil && !filter.Type.IsValid() {
+		return 0, domainerrors.ErrInvalidInput.WithMessage("invalid notification type")
+	}
+
+	count, err := s.notificationRepo.MarkAsReadByFilter(ctx, *user.TenantID, user.ID, filter)
+	if err != nil {
+		log.Error("failed to mark notifications as read by filter", "error", err)
+		return 0, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	log.Info("notifications marked as read by filter", "markedCount", count)
+	return count, nil
+}
+
 // DeleteNotification deletes a notification.
 func (s *NotificationService) DeleteNotification(ctx context.Context, kratosID uuid.UUID, notificationID uuid.UUID) error {
 	log := s.logger.With("kratosID", kratosID, "notificationID", notificationID)
@@ -280,7 +331,8 @@ func (s *NotificationService) DeleteNotification(ctx context.Context, kratosID u
 }
 
 // NotifyJobProgress sends a notification about a generation job's progress.
-func (s *NotificationService) NotifyJobProgress(ctx context.Context, userID uuid.UUID, jobID uuid.UUID, jobType string, status string, progress int) error {
+// courseID is set on the notification so it can be filtered by course.
+func (s *NotificationService) NotifyJobProgress(ctx context.Context, userID uuid.UUID, jobID uuid.UUID, courseID *uuid.UUID, jobType string, status string, progress int) error {
 	var notifType valueobject.NotificationType
 	var priority valueobject.NotificationPriority
 	var title, message string
@@ -307,6 +359,7 @@ func (s *NotificationService) NotifyJobProgress(ctx context.Context, userID uuid
 		Priority: priority,
 		Title:    title,
 		Message:  message,
+		CourseID: courseID,
 		JobID:    &jobID,
 	}
 
@@ -342,10 +395,15 @@ type NotifyGenerationCompleteRequest struct {
 	UserID      uuid.UUID
 	UserEmail   string // Email for sending notification
 	UserName    string // First name for email personalization
+	UserLocale  valueobject.Locale
 	CourseID    uuid.UUID
 	CourseTitle string
-	ActionURL   string // Relative URL like /content-library?courseId={id}
+	ActionURL   string // Relative URL from the links package
 	SendEmail   bool
+
+	// Summary of a fully generated course; when set, the email lists its sections,
+	// lessons and estimated duration
+	Summary *CourseSummary
 }
 
 // NotifyGenerationComplete creates an in-app notification and optionally sends an email
@@ -375,15 +433,31 @@ func (s *NotificationService) NotifyGenerationComplete(ctx context.Context, req
 
 	// 2. Send email if requested
 	if req.SendEmail && s.emailProvider != nil && req.UserEmail != "" {
-		emailReq := service.SendGenerationCompleteRequest{
-			To:          req.UserEmail,
-			UserName:    req.UserName,
-			CourseTitle: req.CourseTitle,
-			ContentType: "course",
-			CourseURL:   s.baseURL + req.ActionURL,
-		}
+		user, _ := s.userRepo.GetByID(ctx, req.UserID)
+		err := s.sendUserEmail(ctx, user, req.UserEmail, func() error {
+			if req.Summary != nil {
+				return s.emailProvider.SendCourseComplete(ctx, service.SendCourseCompleteRequest{
+					To:                   req.UserEmail,
+					Locale:               req.UserLocale,
+					UserName:             req.UserName,
+					CourseTitle:          req.CourseTitle,
+					SectionCount:         req.Summary.SectionCount,
+					LessonCount:          req.Summary.LessonCount,
+					TotalDurationMinutes: req.Summary.TotalDurationMinutes,
+					CourseURL:            s.baseURL + req.ActionURL,
+				})
+			}
+			return s.emailProvider.SendGenerationComplete(ctx, service.SendGenerationCompleteRequest{
+				To:          req.UserEmail,
+				Locale:      req.UserLocale,
+				UserName:    req.UserName,
+				CourseTitle: req.CourseTitle,
+				ContentType: "course",
+				CourseURL:   s.baseURL + req.ActionURL,
+			})
+		})
 
-		if err := s.emailProvider.SendGenerationComplete(ctx, emailReq); err != nil {
+		if err != nil {
 			log.Error("failed to send completion email", "error", err)
 			// Don't fail the whole operation if email fails
 		} else {
@@ -399,10 +473,11 @@ type NotifyGenerationFailedRequest struct {
 	UserID       uuid.UUID
 	UserEmail    string // Email for sending notification
 	UserName     string // First name for email personalization
+	UserLocale   valueobject.Locale
 	CourseID     uuid.UUID
 	CourseTitle  string
 	ErrorMessage string
-	ActionURL    string // Relative URL like /courses/{id}
+	ActionURL    string // Relative URL from the links package
 	SendEmail    bool
 }
 
@@ -434,6 +509,7 @@ func (s *NotificationService) NotifyGenerationFailed(ctx context.Context, req No
 	if req.SendEmail && s.emailProvider != nil && req.UserEmail != "" {
 		emailReq := service.SendGenerationFailedRequest{
 			To:           req.UserEmail,
+			Locale:       req.UserLocale,
 			UserName:     req.UserName,
 			CourseTitle:  req.CourseTitle,
 			ContentType:  "course",
@@ -441,7 +517,11 @@ func (s *NotificationService) NotifyGenerationFailed(ctx context.Context, req No
 			CourseURL:    s.baseURL + req.ActionURL,
 		}
 
-		if err := s.emailProvider.SendGenerationFailed(ctx, emailReq); err != nil {
+		user, _ := s.userRepo.GetByID(ctx, req.UserID)
+		err := s.sendUserEmail(ctx, user, req.UserEmail, func() error {
+			return s.emailProvider.SendGenerationFailed(ctx, emailReq)
+		})
+		if err != nil {
 			log.Error("failed to send failure email", "error", err)
 		} else {
 			log.Info("failure email sent", "to", req.UserEmail)
@@ -454,7 +534,7 @@ func (s *NotificationService) NotifyGenerationFailed(ctx context.Context, req No
 // NotifyCourseComplete sends both in-app notification and email when all lessons are generated.
 // This method looks up the user's email from Kratos using their KratosID.
 // Implements CourseCompletionNotifier interface for AIGenerationService.
-func (s *NotificationService) NotifyCourseComplete(ctx context.Context, userID uuid.UUID, courseID uuid.UUID, courseTitle string) error {
+func (s *NotificationService) NotifyCourseComplete(ctx context.Context, userID uuid.UUID, courseID uuid.UUID, courseTitle string, summary CourseSummary) error {
 	log := s.logger.With("userID", userID, "courseID", courseID)
 
 	// Look up user to get KratosID
@@ -477,17 +557,19 @@ func (s *NotificationService) NotifyCourseComplete(ctx context.Context, userID u
 	}
 
 	// Link to course preview page where user can view the generated course
-	actionURL := fmt.Sprintf("/course/%s/preview", courseID.String())
+	actionURL := links.CoursePreviewLink(courseID)
 
 	// Send notification with email if we have it
 	return s.NotifyGenerationComplete(ctx, NotifyGenerationCompleteRequest{
 		UserID:      userID,
 		UserEmail:   userEmail,
 		UserName:    userName,
+		UserLocale:  resolveLocale(ctx, s.locales, user),
 		CourseID:    courseID,
 		CourseTitle: courseTitle,
 		ActionURL:   actionURL,
 		SendEmail:   userEmail != "",
+		Summary:     &summary,
 	})
 }
 
@@ -516,13 +598,14 @@ func (s *NotificationService) NotifyCourseFailed(ctx context.Context, userID uui
 		}
 	}
 
-	actionURL := fmt.Sprintf("/courses/%s", courseID.String())
+	actionURL := links.CourseLink(courseID)
 
 	// Send notification with email if we have it
 	return s.NotifyGenerationFailed(ctx, NotifyGenerationFailedRequest{
 		UserID:       userID,
 		UserEmail:    userEmail,
 		UserName:     userName,
+		UserLocale:   resolveLocale(ctx, s.locales, user),
 		CourseID:     courseID,
 		CourseTitle:  courseTitle,
 		ErrorMessage: errorMsg,
@@ -531,7 +614,8 @@ func (s *NotificationService) NotifyCourseFailed(ctx context.Context, userID uui
 	})
 }
 
-// NotifyTaskAssigned sends both in-app notification and email when a task is assigned.
+// NotifyTaskAssigned sends both in-app notification and email when a task is assigned or
+// its due date changes.
 func (s *NotificationService) NotifyTaskAssigned(ctx context.Context, req NotifyTaskAssignedRequest) error {
 	log := s.logger.With("assigneeUserID", req.AssigneeUserID, "taskID", req.TaskID)
 
@@ -577,16 +661,22 @@ func (s *NotificationService) NotifyTaskAssigned(ctx context.Context, req Notify
 	}
 
 	// Build action URL to view the SME with task context
-	actionURL := fmt.Sprintf("/smes?sme=%s&task=%s", req.SMEID.String(), req.TaskID.String())
+	actionURL := links.TaskLink(req.SMEID, req.TaskID)
 
 	// Create in-app notification
+	title := "New Task Assigned"
+	message := fmt.Sprintf("You've been assigned a task: %s for %s", req.TaskTitle, req.SMEName)
+	if req.DueDateChanged && req.DueDate != nil {
+		title = "Task Due Date Changed"
+		message = fmt.Sprintf("The task %s for %s is now due %s", req.TaskTitle, req.SMEName, valueobject.DefaultLocale.FormatDate(*req.DueDate))
+	}
 	notification := &entity.Notification{
 		TenantID:  *assignee.TenantID,
 		UserID:    req.AssigneeUserID,
 		Type:      valueobject.NotificationTypeTaskAssigned,
 		Priority:  valueobject.NotificationPriorityNormal,
-		Title:     "New Task Assigned",
-		Message:   fmt.Sprintf("You've been assigned a task: %s for %s", req.TaskTitle, req.SMEName),
+		Title:     title,
+		Message:   message,
 		ActionURL: &actionURL,
 		TaskID:    &req.TaskID,
 		SMEID:     &req.SMEID,
@@ -604,26 +694,27 @@ func (s *NotificationService) NotifyTaskAssigned(ctx context.Context, req Notify
 
 	// Send email if we have the email address
 	if assigneeEmail != "" && s.emailProvider != nil {
-		// Format due date if present
-		dueDate := ""
-		if req.DueDate != nil {
-			dueDate = req.DueDate.Format("January 2, 2006")
-		}
-
 		// Build full task URL
 		taskURL := s.baseURL + actionURL
 
 		emailReq := service.SendTaskAssignmentRequest{
-			To:           assigneeEmail,
-			AssigneeName: assigneeName,
-			AssignerName: assignerName,
-			TaskTitle:    req.TaskTitle,
-			SMEName:      req.SMEName,
-			TaskURL:      taskURL,
-			DueDate:      dueDate,
+			To:             assigneeEmail,
+			Locale:         resolveLocale(ctx, s.locales, assignee),
+			AssigneeName:   assigneeName,
+			AssignerName:   assignerName,
+			TaskID:         req.TaskID,
+			TaskTitle:      req.TaskTitle,
+			SMEName:        req.SMEName,
+			TaskURL:        taskURL,
+			DueDate:        req.DueDate,
+			DueDateChanged: req.DueDateChanged,
+			Questions:      req.Questions,
 		}
 
-		if err := s.emailProvider.SendTaskAssignment(ctx, emailReq); err != nil {
+		err := s.sendUserEmail(ctx, assignee, assigneeEmail, func() error {
+			return s.emailProvider.SendTaskAssignment(ctx, emailReq)
+		})
+		if err != nil {
 			log.Error("failed to send task assignment email", "error", err)
 			// Don't fail the whole operation if email fails
 		} else {
@@ -659,7 +750,7 @@ func (s *NotificationService) NotifyOutlineReady(ctx context.Context, userID uui
 	}
 
 	// Link to dashboard with edit param to auto-open course modal
-	actionURL := fmt.Sprintf("/dashboard?edit=%s", courseID.String())
+	actionURL := links.OutlineReviewLink(courseID)
 
 	// Create in-app notification
 	notifReq := CreateNotificationRequest{
@@ -683,6 +774,7 @@ func (s *NotificationService) NotifyOutlineReady(ctx context.Context, userID uui
 	if userEmail != "" && s.emailProvider != nil {
 		emailReq := service.SendOutlineReadyRequest{
 			To:           userEmail,
+			Locale:       resolveLocale(ctx, s.locales, user),
 			UserName:     userName,
 			CourseTitle:  courseTitle,
 			SectionCount: sectionCount,
@@ -690,7 +782,10 @@ func (s *NotificationService) NotifyOutlineReady(ctx context.Context, userID uui
 			ReviewURL:    s.baseURL + actionURL,
 		}
 
-		if err := s.emailProvider.SendOutlineReady(ctx, emailReq); err != nil {
+		err := s.sendUserEmail(ctx, user, userEmail, func() error {
+			return s.emailProvider.SendOutlineReady(ctx, emailReq)
+		})
+		if err != nil {
 			log.Error("failed to send outline ready email", "error", err)
 		} else {
 			log.Info("outline ready email sent", "to", userEmail)
@@ -724,7 +819,7 @@ func (s *NotificationService) NotifyOutlineFailed(ctx context.Context, userID uu
 		}
 	}
 
-	actionURL := fmt.Sprintf("/dashboard?edit=%s", courseID.String())
+	actionURL := links.OutlineReviewLink(courseID)
 
 	// Create in-app notification
 	notifReq := CreateNotificationRequest{
@@ -748,6 +843,7 @@ func (s *NotificationService) NotifyOutlineFailed(ctx context.Context, userID uu
 	if userEmail != "" && s.emailProvider != nil {
 		emailReq := service.SendGenerationFailedRequest{
 			To:           userEmail,
+			Locale:       resolveLocale(ctx, s.locales, user),
 			UserName:     userName,
 			CourseTitle:  courseTitle,
 			ContentType:  "outline",
@@ -755,7 +851,10 @@ func (s *NotificationService) NotifyOutlineFailed(ctx context.Context, userID uu
 			CourseURL:    s.baseURL + actionURL,
 		}
 
-		if err := s.emailProvider.SendGenerationFailed(ctx, emailReq); err != nil {
+		err := s.sendUserEmail(ctx, user, userEmail, func() error {
+			return s.emailProvider.SendGenerationFailed(ctx, emailReq)
+		})
+		if err != nil {
 			log.Error("failed to send outline failure email", "error", err)
 		} else {
 			log.Info("outline failure email sent", "to", userEmail)
@@ -765,6 +864,196 @@ func (s *NotificationService) NotifyOutlineFailed(ctx context.Context, userID uu
 	return nil
 }
 
+// NotifyCollaboratorAdded sends an in-app notification when a user is assigned to a course.
+// Implements CollaboratorNotifier interface for CourseService.
+func (s *NotificationService) NotifyCollaboratorAdded(ctx context.Context, userID uuid.UUID, courseID uuid.UUID, courseTitle string, role valueobject.CourseRole) error {
+	log := s.logger.With("userID", userID, "courseID", courseID)
+
+	if courseTitle == "" {
+		courseTitle = "a course"
+	}
+
+	actionURL := links.CourseLink(courseID)
+
+	_, err := s.CreateNotification(ctx, CreateNotificationRequest{
+		UserID:    userID,
+		Type:      valueobject.NotificationTypeCollaboratorAdded,
+		Priority:  valueobject.NotificationPriorityNormal,
+		Title:     "Added to Course",
+		Message:   fmt.Sprintf("You've been added as %s on %s.", role.String(), courseTitle),
+		ActionURL: &actionURL,
+		CourseID:  &courseID,
+	})
+	if err != nil {
+		log.Error("failed to create collaborator notification", "error", err)
+		return err
+	}
+
+	return nil
+}
+
+// NotifyExportReady sends an in-app notification linking to the exported course, and an
+// email with a signed download link, the file's size and format, and a link back to the
+// course. The download link is created here, so its expiry counts from when the email is sent.
+// Implements ExportNotifier interface for AIGenerationService.
+func (s *NotificationService) NotifyExportReady(ctx context.Context, notice ExportReadyNotice) error {
+	log := s.logger.With("userID", notice.UserID, "courseID", notice.CourseID, "jobID", notice.JobID)
+
+	message := fmt.Sprintf("%d lessons from %s are ready to download.", notice.LessonCount, notice.CourseTitle)
+	if notice.SkippedCount > 0 {
+		message = fmt.Sprintf("%d lessons from %s are ready to download; %d couldn't be exported and are listed in the manifest.", notice.LessonCount, notice.CourseTitle, notice.SkippedCount)
+	}
+
+	actionURL := links.CourseLink(notice.CourseID)
+
+	_, err := s.CreateNotification(ctx, CreateNotificationRequest{
+		UserID:    notice.UserID,
+		Type:      valueobject.NotificationTypeExportReady,
+		Priority:  valueobject.NotificationPriorityNormal,
+		Title:     "Lesson Export Ready",
+		Message:   message,
+		ActionURL: &actionURL,
+		CourseID:  &notice.CourseID,
+	})
+	if err != nil {
+		log.Error("failed to create export notification", "error", err)
+		// Continue to try email even if in-app fails
+	}
+
+	if s.emailProvider == nil || s.exportLinks == nil || s.identityProvider == nil {
+		return err
+	}
+
+	user, userErr := s.userRepo.GetByID(ctx, notice.UserID)
+	if userErr != nil || user == nil {
+		log.Error("failed to get user for export email", "error", userErr)
+		return err
+	}
+	identity, idErr := s.identityProvider.GetIdentity(ctx, user.KratosID.String())
+	if idErr != nil || identity == nil || identity.Email == "" {
+		log.Warn("failed to get identity for export email", "error", idErr)
+		return err
+	}
+
+	downloadURL, expiresAt, linkErr := s.exportLinks.IssueDownloadLink(ctx, notice.TenantID, notice.JobID, notice.UserID, notice.FilePath)
+	if linkErr != nil {
+		log.Error("failed to create export download link", "error", linkErr)
+		return err
+	}
+
+	emailErr := s.sendUserEmail(ctx, user, identity.Email, func() error {
+		return s.emailProvider.SendExportReady(ctx, service.SendExportReadyRequest{
+			To:           identity.Email,
+			Locale:       resolveLocale(ctx, s.locales, user),
+			UserName:     identity.FirstName,
+			CourseTitle:  notice.CourseTitle,
+			Format:       exportFormatLabel(notice.Format),
+			FileSize:     formatByteSize(notice.SizeBytes),
+			LessonCount:  notice.LessonCount,
+			SkippedCount: notice.SkippedCount,
+			DownloadURL:  downloadURL,
+			ExpiresAt:    expiresAt,
+			AppURL:       s.baseURL + actionURL,
+		})
+	})
+	if emailErr != nil {
+		log.Error("failed to send export email", "error", emailErr)
+		// Don't fail the whole operation if email fails
+	} else {
+		log.Info("export email sent", "to", identity.Email)
+	}
+
+	return err
+}
+
+// exportFormatLabel names an export format for people.
+func exportFormatLabel(format string) string {
+	switch format {
+	case lessonExportFormat:
+		return "Markdown (ZIP)"
+	default:
+		return format
+	}
+}
+
+// NotifyOutlineComment sends an in-app notification when someone comments on a course outline.
+// Implements OutlineCommentNotifier interface for AIGenerationService.
+func (s *NotificationService) NotifyOutlineComment(ctx context.Context, userID uuid.UUID, courseID uuid.UUID, courseTitle, lessonTitle, authorName string) error {
+	log := s.logger.With("userID", userID, "courseID", courseID)
+
+	actionURL := links.OutlineReviewLink(courseID)
+
+	_, err := s.CreateNotification(ctx, CreateNotificationRequest{
+		UserID:    userID,
+		Type:      valueobject.NotificationTypeOutlineComment,
+		Priority:  valueobject.NotificationPriorityNormal,
+		Title:     "New Outline Comment",
+		Message:   fmt.Sprintf("%s commented on \"%s\" in %s.", authorName, lessonTitle, courseTitle),
+		ActionURL: &actionURL,
+		CourseID:  &courseID,
+	})
+	if err != nil {
+		log.Error("failed to create outline comment notification", "error", err)
+		return err
+	}
+
+	return nil
+}
+
+// NotifyOutlineAwaitingSecondReview sends an in-app notification when an outline has been
+// endorsed and needs a second reviewer's approval.
+// Implements OutlineReviewNotifier interface for AIGenerationService.
+func (s *NotificationService) NotifyOutlineAwaitingSecondReview(ctx context.Context, userID uuid.UUID, courseID uuid.UUID, courseTitle, endorserName string) error {
+	log := s.logger.With("userID", userID, "courseID", courseID)
+
+	actionURL := links.OutlineReviewLink(courseID)
+
+	_, err := s.CreateNotification(ctx, CreateNotificationRequest{
+		UserID:    userID,
+		Type:      valueobject.NotificationTypeApprovalRequested,
+		Priority:  valueobject.NotificationPriorityNormal,
+		Title:     "Outline Awaiting Second Review",
+		Message:   fmt.Sprintf("%s endorsed the outline for %s. It needs a second reviewer's approval.", endorserName, courseTitle),
+		ActionURL: &actionURL,
+		CourseID:  &courseID,
+	})
+	if err != nil {
+		log.Error("failed to create second review notification", "error", err)
+		return err
+	}
+
+	return nil
+}
+
+// NotifyLessonsOrphaned sends an in-app notification when an outline change removed
+// generated lessons from a course.
+// Implements LessonOrphanNotifier interface for AIGenerationService.
+func (s *NotificationService) NotifyLessonsOrphaned(ctx context.Context, userID uuid.UUID, courseID uuid.UUID, courseTitle string, count int) error {
+	log := s.logger.With("userID", userID, "courseID", courseID)
+
+	actionURL := links.CourseLink(courseID)
+
+	lessons := "lessons were"
+	if count == 1 {
+		lessons = "lesson was"
+	}
+	_, err := s.CreateNotification(ctx, CreateNotificationRequest{
+		UserID:    userID,
+		Type:      valueobject.NotificationTypeLessonsOrphaned,
+		Priority:  valueobject.NotificationPriorityNormal,
+		Title:     "Lessons Removed From Course",
+		Message:   fmt.Sprintf("%d generated %s removed from %s because the outline no longer includes them.", count, lessons, courseTitle),
+		ActionURL: &actionURL,
+		CourseID:  &courseID,
+	})
+	if err != nil {
+		log.Error("failed to create orphaned lessons notification", "error", err)
+		return err
+	}
+
+	return nil
+}
+
 // publishNotificationEvent publishes a notification event to Redis for real-time delivery.
 // This is fire-and-forget - errors are logged but don't fail the operation.
 func (s *NotificationService) publishNotificationEvent(ctx context.Context, userID uuid.UUID, eventType v1.NotificationEventType, notification *entity.Notification) {
@@ -786,6 +1075,65 @@ func (s *NotificationService) publishNotificationEvent(ctx context.Context, user
 	}
 }
 
+// PublishSubmissionProgress pushes a submission's ingestion progress to each user's
+// notification stream. Nothing is stored; users who aren't connected miss the update.
+// Implements SubmissionProgressPublisher for SMEIngestionService.
+func (s *NotificationService) PublishSubmissionProgress(ctx context.Context, userIDs []uuid.UUID, progress *entity.SubmissionProgress) {
+	if s.publisher == nil {
+		return
+	}
+
+	event := &pubsub.NotificationEvent{
+		EventType:          v1.NotificationEventType_NOTIFICATION_EVENT_TYPE_SUBMISSION_PROGRESS,
+		SubmissionProgress: submissionProgressToProto(progress),
+	}
+	for _, userID := range userIDs {
+		if err := s.publisher.PublishNotificationEvent(ctx, userID, event); err != nil {
+			s.logger.Warn("failed to publish submission progress",
+				"error", err,
+				"userID", userID,
+				"submissionID", progress.SubmissionID,
+			)
+		}
+	}
+}
+
+// submissionProgressToProto converts an entity.SubmissionProgress to a v1.SubmissionProgress proto.
+func submissionProgressToProto(p *entity.SubmissionProgress) *v1.SubmissionProgress {
+	proto := &v1.SubmissionProgress{
+		SubmissionId:    p.SubmissionID.String(),
+		TaskId:          p.TaskID.String(),
+		Status:          submissionProcessingStatusToProto(p.Status),
+		ProgressPercent: p.ProgressPercent,
+		ProgressMessage: p.ProgressMessage,
+		Error:           p.Error,
+		UpdatedAt:       timestamppb.New(p.UpdatedAt),
+	}
+	if p.JobID != nil {
+		s := p.JobID.String()
+		proto.JobId = &s
+	}
+	return proto
+}
+
+// submissionProcessingStatusToProto converts a domain SubmissionProcessingStatus to proto.
+func submissionProcessingStatusToProto(status valueobject.SubmissionProcessingStatus) v1.SubmissionProcessingStatus {
+	switch status {
+	case valueobject.SubmissionProcessingNotStarted:
+		return v1.SubmissionProcessingStatus_SUBMISSION_PROCESSING_STATUS_NOT_STARTED
+	case valueobject.SubmissionProcessingQueued:
+		return v1.SubmissionProcessingStatus_SUBMISSION_PROCESSING_STATUS_QUEUED
+	case valueobject.SubmissionProcessingProcessing:
+		return v1.SubmissionProcessingStatus_SUBMISSION_PROCESSING_STATUS_PROCESSING
+	case valueobject.SubmissionProcessingCompleted:
+		return v1.SubmissionProcessingStatus_SUBMISSION_PROCESSING_STATUS_COMPLETED
+	case valueobject.SubmissionProcessingFailed:
+		return v1.SubmissionProcessingStatus_SUBMISSION_PROCESSING_STATUS_FAILED
+	default:
+		return v1.SubmissionProcessingStatus_SUBMISSION_PROCESSING_STATUS_UNSPECIFIED
+	}
+}
+
 // notificationToProto converts an entity.Notification to a v1.Notification proto.
 func notificationToProto(n *entity.Notification) *v1.Notification {
 	if n == nil {
@@ -852,6 +1200,10 @@ func notificationTypeToProto(t valueobject.NotificationType) v1.NotificationType
 		return v1.NotificationType_NOTIFICATION_TYPE_GENERATION_FAILED
 	case valueobject.NotificationTypeApprovalRequested:
 		return v1.NotificationType_NOTIFICATION_TYPE_APPROVAL_REQUESTED
+	case valueobject.NotificationTypeCollaboratorAdded:
+		return v1.NotificationType_NOTIFICATION_TYPE_COLLABORATOR_ADDED
+	case valueobject.NotificationTypeExportReady:
+		return v1.NotificationType_NOTIFICATION_TYPE_EXPORT_READY
 	default:
 		return v1.NotificationType_NOTIFICATION_TYPE_UNSPECIFIED
 	}
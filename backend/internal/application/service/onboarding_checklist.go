@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+	"github.com/sogos/mirai-backend/internal/infrastructure/cache"
+)
+
+// onboardingCacheTTL is how long a tenant's onboarding progress is served from cache,
+// so a finished step can take this long to show as done.
+const onboardingCacheTTL = 5 * time.Minute
+
+// OnboardingChecklistItem is one step of the onboarding checklist.
+type OnboardingChecklistItem struct {
+	Key       string
+	Title     string
+	Completed bool
+}
+
+// OnboardingStatus is the caller's onboarding checklist.
+type OnboardingStatus struct {
+	Items     []OnboardingChecklistItem
+	Dismissed bool
+}
+
+// SetOnboarding enables the onboarding checklist. The cache may be nil.
+func (s *UserService) SetOnboarding(onboarding repository.OnboardingRepository, c cache.Cache) {
+	s.onboarding = onboarding
+	s.cache = c
+}
+
+// GetOnboardingStatus returns the onboarding checklist with each step's completion
+// derived from the tenant's data, and whether the caller has hidden it.
+func (s *UserService) GetOnboardingStatus(ctx context.Context, kratosID uuid.UUID) (*OnboardingStatus, error) {
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, domainerrors.ErrUserNotFound
+	}
+	if user.TenantID == nil {
+		return nil, domainerrors.ErrUserHasNoCompany
+	}
+	if s.onboarding == nil {
+		return nil, domainerrors.ErrInternal.WithMessage("onboarding checklist not configured")
+	}
+
+	var progress *entity.OnboardingProgress
+	if s.cache != nil {
+		var cached entity.OnboardingProgress
+		if entry, err := s.cache.Get(ctx, cache.TenantCacheKeys.Onboarding(), &cached); err == nil && entry != nil {
+			progress = &cached
+		}
+	}
+	if progress == nil {
+		progress, err = s.onboarding.GetProgress(ctx, *user.TenantID)
+		if err != nil {
+			s.logger.Error("failed to get onboarding progress", "tenantID", user.TenantID, "error", err)
+			return nil, domainerrors.ErrInternal.WithCause(err)
+		}
+		if s.cache != nil {
+			if _, err := s.cache.Set(ctx, cache.TenantCacheKeys.Onboarding(), progress, "", onboardingCacheTTL); err != nil {
+				s.logger.Warn("failed to cache onboarding progress", "tenantID", user.TenantID, "error", err)
+			}
+		}
+	}
+
+	return &OnboardingStatus{
+		Items: []OnboardingChecklistItem{
+			{Key: "ai_settings", Title: "Add an API key", Completed: progress.AISettingsConfigured},
+			{Key: "sme_knowledge", Title: "Create your first SME", Completed: progress.SMEWithKnowledge},
+			{Key: "target_audience", Title: "Define a target audience", Completed: progress.TargetAudience},
+			{Key: "first_course", Title: "Create your first course", Completed: progress.CourseCreated},
+			{Key: "outline_approved", Title: "Approve a course outline", Completed: progress.OutlineApproved},
+			{Key: "course_generated", Title: "Generate your first course", Completed: progress.CourseGenerated},
+		},
+		Dismissed: user.OnboardingDismissedAt != nil,
+	}, nil
+}
+
+// DismissOnboardingChecklist hides the onboarding checklist for the caller for good.
+func (s *UserService) DismissOnboardingChecklist(ctx context.Context, kratosID uuid.UUID) error {
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return domainerrors.ErrUserNotFound
+	}
+	if user.OnboardingDismissedAt != nil {
+		return nil
+	}
+
+	if err := s.userRepo.DismissOnboarding(ctx, user.ID); err != nil {
+		s.logger.Error("failed to dismiss onboarding checklist", "userID", user.ID, "error", err)
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+// checkOutlineAutoApprove rejects auto-approval unless the tenant has allowed it and
+// doesn't require a second reviewer for outlines.
+func (s *AIGenerationService) checkOutlineAutoApprove(ctx context.Context, tenantID uuid.UUID) error {
+	settings, err := s.aiSettingsRepo.Get(ctx, tenantID)
+	if err != nil {
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+	if settings == nil || !settings.AllowOutlineAutoApprove {
+		return domainerrors.ErrForbidden.WithMessage("outline auto-approval is not enabled for this organization; an admin can turn it on in Settings > AI Settings")
+	}
+	if settings.RequireSecondReviewer {
+		return domainerrors.ErrForbidden.WithMessage("outline auto-approval is unavailable while your organization requires a second reviewer for outlines")
+	}
+	return nil
+}
+
+// startAutoApprovedLessons approves a freshly stored outline and queues a lesson job for
+// each of its lessons under the outline job's parent. The approval, the lesson jobs and
+// the orphaning of superseded lessons share one transaction, so a failed run never
+// leaves an approved outline without lessons.
+func (s *AIGenerationService) startAutoApprovedLessons(ctx context.Context, job *entity.GenerationJob, outline *entity.CourseOutline, lessons []entity.OutlineLesson) error {
+	log := s.logger.With("jobID", job.ID, "parentJobID", job.ParentJobID, "outlineID", outline.ID)
+
+	if len(lessons) == 0 {
+		return errors.New("outline has no lessons to generate")
+	}
+
+	// The policy may have been turned on after the run was queued
+	if required, err := s.secondReviewRequired(ctx, outline.TenantID); err != nil {
+		return fmt.Errorf("failed to check second reviewer policy: %w", err)
+	} else if required {
+		return errors.New("outline needs a second reviewer and was left for review")
+	}
+
+	parentJob, err := s.jobRepo.GetByID(ctx, *job.ParentJobID)
+	if err != nil || parentJob == nil {
+		return errors.New("full course job not found")
+	}
+
+	now := time.Now()
+	outline.ApprovalStatus = valueobject.OutlineApprovalStatusApproved
+	outline.ApprovedAt = &now
+	outline.ApprovedByUserID = &job.CreatedByUserID
+
+	lessonJobs := newLessonJobs(parentJob, lessons)
+	var orphaned int
+	err = s.withinTx(ctx, func(ctx context.Context) error {
+		if err := s.outlineRepo.Update(ctx, outline); err != nil {
+			return fmt.Errorf("failed to approve outline: %w", err)
+		}
+		if err := s.jobRepo.CreateChildJobs(ctx, parentJob.ID, lessonJobs); err != nil {
+			return fmt.Errorf("failed to queue lesson jobs: %w", err)
+		}
+		// Lessons generated for matched lessons of earlier outline versions follow them;
+		// the rest no longer belong in the course
+		orphaned, err = s.genLessonRepo.SyncOrphansWithOutline(ctx, outline.CourseID, outline.ID)
+		if err != nil {
+			return fmt.Errorf("failed to orphan superseded lessons: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		outline.ApprovalStatus = valueobject.OutlineApprovalStatusPendingReview
+		outline.ApprovedAt = nil
+		outline.ApprovedByUserID = nil
+		return err
+	}
+	s.enqueueChildJobs(ctx, lessonJobs)
+	s.invalidateCourseCaches(ctx, outline.CourseID)
+	if orphaned > 0 {
+		log.Info("orphaned superseded lessons", "count", orphaned)
+		s.notifyLessonsOrphaned(ctx, job.CreatedByUserID, outline.CourseID, orphaned)
+	}
+
+	progressMsg := fmt.Sprintf("Generating %d lessons...", len(lessons))
+	if _, err := s.jobRepo.UpdateProgress(ctx, parentJob.ID, 10, progressMsg); err != nil {
+		log.Warn("failed to update parent job progress", "error", err)
+	}
+
+	log.Info("outline approved automatically, queued lesson generation", "lessons", len(lessons))
+	return nil
+}
+
+// failAutoApprovedRun fails the full course job of an auto-approved run whose outline
+// failed, sending the run's single failure notification.
+func (s *AIGenerationService) failAutoApprovedRun(ctx context.Context, outlineJob *entity.GenerationJob, errMsg string) {
+	log := s.logger.With("jobID", outlineJob.ID, "parentJobID", outlineJob.ParentJobID)
+
+	parentJob, err := s.jobRepo.GetByID(ctx, *outlineJob.ParentJobID)
+	if err != nil || parentJob == nil {
+		log.Error("failed to get full course job", "error", err)
+		return
+	}
+	if parentJob.Status != valueobject.GenerationJobStatusQueued && parentJob.Status != valueobject.GenerationJobStatusProcessing {
+		return
+	}
+
+	parentJob.Status = valueobject.GenerationJobStatusFailed
+	parentErr := "Outline generation failed: " + errMsg
+	parentJob.ErrorMessage = &parentErr
+	parentJob.FailureReason = outlineJob.FailureReason
+	now := time.Now()
+	parentJob.CompletedAt = &now
+	if err := s.jobRepo.Update(ctx, parentJob); err != nil {
+		log.Error("failed to mark full course job as failed", "error", err)
+	}
+
+	if s.completionNotifier != nil && parentJob.CourseID != nil {
+		courseTitle := s.resolveCourseTitle(ctx, *parentJob.CourseID, nil)
+		if err := s.completionNotifier.NotifyCourseFailed(ctx, parentJob.CreatedByUserID, *parentJob.CourseID, courseTitle, parentErr); err != nil {
+			log.Error("failed to send course failure notification", "error", err)
+		}
+	}
+}
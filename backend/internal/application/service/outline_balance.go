@@ -0,0 +1,397 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+const (
+	// Default per-lesson duration range outlines are balanced toward, in minutes.
+	outlineBalanceDefaultMinMinutes = 10
+	outlineBalanceDefaultMaxMinutes = 30
+
+	// outlineBalanceTimeout bounds the provider call, which runs inside the request.
+	outlineBalanceTimeout = 30 * time.Second
+)
+
+// BalanceOutlineDurationsRequest identifies the outline and the per-lesson duration range
+// to balance it toward. Zero limits use the defaults.
+type BalanceOutlineDurationsRequest struct {
+	CourseID         uuid.UUID
+	OutlineID        uuid.UUID
+	MinLessonMinutes int32
+	MaxLessonMinutes int32
+}
+
+// OutlineBalanceChangeKind is how a balancing proposal restructures lessons.
+type OutlineBalanceChangeKind string
+
+const (
+	OutlineBalanceChangeSplit OutlineBalanceChangeKind = "split"
+	OutlineBalanceChangeMerge OutlineBalanceChangeKind = "merge"
+)
+
+// OutlineBalanceChange is one split or merge in a balancing proposal.
+type OutlineBalanceChange struct {
+	Kind      OutlineBalanceChangeKind
+	SectionID uuid.UUID
+	LessonIDs []uuid.UUID // The lesson split, or the two lessons merged
+	Titles    []string    // Titles of the resulting lessons
+}
+
+// OutlineBalanceProposal is a revision of an outline with more even lesson durations.
+// Nothing is saved; the reviewer accepts it by sending Sections and RemovedLessonIDs to
+// UpdateCourseOutline.
+type OutlineBalanceProposal struct {
+	Sections         []UpdateCourseOutlineSection // The whole outline as revised; added lessons have no ID
+	RemovedLessonIDs []uuid.UUID                  // Lessons merged into the lesson before them
+	Changes          []OutlineBalanceChange
+	MinLessonMinutes int32
+	MaxLessonMinutes int32
+	TokensUsed       int64
+}
+
+// outlineBalancePlan is the splits and merges chosen for one outline.
+type outlineBalancePlan struct {
+	splits []entity.OutlineLesson
+	merges [][2]entity.OutlineLesson
+}
+
+// BalanceOutlineDurations proposes a revision of an outline that evens out lesson
+// durations: the provider splits lessons longer than the range in two and merges short
+// lessons with the next lesson when together they fit. Learning objectives are kept
+// across every split and merge, and no section is given more lessons than the course's
+// max lessons per section. Lessons specific to some audiences are left alone.
+func (s *AIGenerationService) BalanceOutlineDurations(ctx context.Context, kratosID uuid.UUID, req BalanceOutlineDurationsRequest) (*OutlineBalanceProposal, error) {
+	log := s.logger.With("kratosID", kratosID, "outlineID", req.OutlineID)
+
+	minMinutes, maxMinutes := req.MinLessonMinutes, req.MaxLessonMinutes
+	if minMinutes == 0 {
+		minMinutes = outlineBalanceDefaultMinMinutes
+	}
+	if maxMinutes == 0 {
+		maxMinutes = outlineBalanceDefaultMaxMinutes
+	}
+	if minMinutes < 1 || maxMinutes < 2*minMinutes {
+		return nil, domainerrors.ErrInvalidInput.WithMessage("max lesson minutes must be at least twice the min lesson minutes")
+	}
+
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, domainerrors.ErrUserNotFound
+	}
+	if user.TenantID == nil {
+		return nil, domainerrors.ErrUserHasNoCompany
+	}
+
+	outline, err := s.outlineRepo.GetByID(ctx, req.OutlineID)
+	if err != nil || outline == nil || outline.CourseID != req.CourseID {
+		return nil, domainerrors.ErrNotFound.WithMessage("outline not found")
+	}
+	if outline.ApprovalStatus != valueobject.OutlineApprovalStatusPendingReview &&
+		outline.ApprovalStatus != valueobject.OutlineApprovalStatusRevisionRequested {
+		return nil, domainerrors.ErrForbidden.WithMessage("can only balance pending or revision-requested outlines")
+	}
+	if err := s.checkCourseAccess(ctx, user, outline.CourseID); err != nil {
+		return nil, err
+	}
+	if err := s.loadOutlineSections(ctx, outline); err != nil {
+		log.Error("failed to load outline sections", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	maxLessons := 0
+	genInput, err := s.genInputRepo.GetByCourseID(ctx, outline.CourseID)
+	if err != nil {
+		log.Warn("failed to get generation input for constraints", "error", err)
+	} else if genInput != nil && genInput.Constraints.MaxLessonsPerSection != nil {
+		maxLessons = int(*genInput.Constraints.MaxLessonsPerSection)
+	}
+
+	plan := planOutlineBalance(outline, int(minMinutes), int(maxMinutes), maxLessons)
+	proposal := &OutlineBalanceProposal{MinLessonMinutes: minMinutes, MaxLessonMinutes: maxMinutes}
+	if len(plan.splits) == 0 && len(plan.merges) == 0 {
+		proposal.Sections = proposeOutlineSections(outline, nil, nil, proposal)
+		return proposal, nil
+	}
+
+	if !s.suggestionLimiter.Allow(user.ID) {
+		return nil, domainerrors.ErrRateLimited.WithMessage("too many AI requests - please wait a moment and try again")
+	}
+	if err := checkAIGenerationEnabled(ctx, s.aiSettingsRepo, *user.TenantID); err != nil {
+		return nil, err
+	}
+	if err := s.checkTokenBudget(ctx, *user.TenantID); err != nil {
+		return nil, err
+	}
+
+	aiProvider, err := s.aiProviderFactory.GetProvider(ctx, *user.TenantID)
+	if err != nil {
+		log.Error("failed to get AI provider", "error", err)
+		if domainerrors.IsDomainError(err) {
+			return nil, err
+		}
+		return nil, domainerrors.ErrExternalService.WithCause(err)
+	}
+
+	providerReq := service.RestructureOutlineLessonsRequest{
+		CourseTitle: s.resolveCourseTitle(ctx, outline.CourseID, genInput),
+	}
+	for _, lesson := range plan.splits {
+		providerReq.Splits = append(providerReq.Splits, outlineLessonDraft(lesson))
+	}
+	for _, pair := range plan.merges {
+		providerReq.Merges = append(providerReq.Merges, [2]service.OutlineLessonDraft{outlineLessonDraft(pair[0]), outlineLessonDraft(pair[1])})
+	}
+
+	balanceCtx, cancel := context.WithTimeout(ctx, outlineBalanceTimeout)
+	defer cancel()
+
+	result, err := aiProvider.RestructureOutlineLessons(balanceCtx, providerReq)
+	if err != nil {
+		var partial *service.PartialUsageError
+		if errors.As(err, &partial) {
+			_ = s.aiSettingsRepo.IncrementTokenUsage(ctx, *user.TenantID, partial.TokensUsed)
+		}
+		if errors.Is(balanceCtx.Err(), context.DeadlineExceeded) {
+			log.Warn("outline balancing timed out", "timeout", outlineBalanceTimeout)
+			return nil, domainerrors.ErrSuggestionTimeout.WithMessage("outline balancing timed out - please try again")
+		}
+		log.Error("outline balancing failed", "error", err)
+		return nil, domainerrors.ErrExternalService.WithCause(err)
+	}
+	if len(result.Splits) != len(plan.splits) || len(result.Merges) != len(plan.merges) {
+		log.Error("outline balancing returned the wrong number of lessons", "splits", len(result.Splits), "merges", len(result.Merges))
+		return nil, domainerrors.ErrExternalService.WithMessage("AI returned an incomplete proposal - please try again")
+	}
+	if err := s.aiSettingsRepo.IncrementTokenUsage(ctx, *user.TenantID, result.TokensUsed); err != nil {
+		log.Warn("failed to record token usage", "error", err)
+	}
+	proposal.TokensUsed = result.TokensUsed
+
+	splits := make(map[uuid.UUID][2]service.OutlineLessonDraft, len(plan.splits))
+	for i, lesson := range plan.splits {
+		splits[lesson.ID] = fitSplitLesson(outlineLessonDraft(lesson), result.Splits[i])
+	}
+	merges := make(map[uuid.UUID]service.OutlineLessonDraft, len(plan.merges))
+	for i, pair := range plan.merges {
+		merges[pair[0].ID] = fitMergedLesson(outlineLessonDraft(pair[0]), outlineLessonDraft(pair[1]), result.Merges[i])
+	}
+	proposal.Sections = proposeOutlineSections(outline, splits, merges, proposal)
+
+	log.Info("outline balance proposed",
+		"splits", len(plan.splits),
+		"merges", len(plan.merges),
+		"minMinutes", minMinutes,
+		"maxMinutes", maxMinutes,
+		"tokensUsed", result.TokensUsed)
+	return proposal, nil
+}
+
+// planOutlineBalance picks the lessons to split and merge. In each section, a lesson
+// shorter than minMinutes is merged with the next lesson when together they fit within
+// maxMinutes; then the longest lessons over maxMinutes are split while the section stays
+// within maxLessons (0 = unconstrained). Lessons without a duration or specific to some
+// audiences are never chosen.
+func planOutlineBalance(outline *entity.CourseOutline, minMinutes, maxMinutes, maxLessons int) outlineBalancePlan {
+	var plan outlineBalancePlan
+	for _, section := range outline.Sections {
+		lessons := section.Lessons
+		merged := make(map[uuid.UUID]bool)
+		for i := 0; i+1 < len(lessons); i++ {
+			a, b := lessons[i], lessons[i+1]
+			if !balanceable(a) || !balanceable(b) {
+				continue
+			}
+			da, db := int(*a.EstimatedDurationMinutes), int(*b.EstimatedDurationMinutes)
+			if (da < minMinutes || db < minMinutes) && da+db <= maxMinutes {
+				plan.merges = append(plan.merges, [2]entity.OutlineLesson{a, b})
+				merged[a.ID], merged[b.ID] = true, true
+				i++
+			}
+		}
+
+		var long []entity.OutlineLesson
+		for _, lesson := range lessons {
+			if !merged[lesson.ID] && balanceable(lesson) && int(*lesson.EstimatedDurationMinutes) > maxMinutes {
+				long = append(long, lesson)
+			}
+		}
+		slices.SortStableFunc(long, func(a, b entity.OutlineLesson) int {
+			return cmp.Compare(*b.EstimatedDurationMinutes, *a.EstimatedDurationMinutes)
+		})
+		count := len(lessons) - len(merged)/2
+		for _, lesson := range long {
+			if maxLessons > 0 && count >= maxLessons {
+				break
+			}
+			plan.splits = append(plan.splits, lesson)
+			count++
+		}
+	}
+	return plan
+}
+
+// balanceable reports whether a lesson may be split or merged.
+func balanceable(lesson entity.OutlineLesson) bool {
+	return lesson.EstimatedDurationMinutes != nil && *lesson.EstimatedDurationMinutes > 0 && len(lesson.TargetAudiences) == 0
+}
+
+// outlineLessonDraft converts an outline lesson for the provider.
+func outlineLessonDraft(lesson entity.OutlineLesson) service.OutlineLessonDraft {
+	draft := service.OutlineLessonDraft{
+		Title:              lesson.Title,
+		Description:        lesson.Description,
+		LearningObjectives: lesson.LearningObjectives,
+	}
+	if lesson.EstimatedDurationMinutes != nil {
+		draft.DurationMinutes = int(*lesson.EstimatedDurationMinutes)
+	}
+	return draft
+}
+
+// fitSplitLesson makes the provider's halves of a split lesson keep every objective of the
+// original exactly once, word for word, and add up to its duration.
+func fitSplitLesson(original service.OutlineLessonDraft, halves [2]service.OutlineLessonDraft) [2]service.OutlineLessonDraft {
+	remaining := make(map[string]bool, len(original.LearningObjectives))
+	for _, objective := range original.LearningObjectives {
+		remaining[objective] = true
+	}
+	for i := range halves {
+		var kept []string
+		for _, objective := range halves[i].LearningObjectives {
+			if objective = strings.TrimSpace(objective); remaining[objective] {
+				kept = append(kept, objective)
+				delete(remaining, objective)
+			}
+		}
+		halves[i].LearningObjectives = kept
+	}
+	// Objectives the provider dropped or reworded go to the half with fewer
+	for _, objective := range original.LearningObjectives {
+		if remaining[objective] {
+			i := 0
+			if len(halves[1].LearningObjectives) < len(halves[0].LearningObjectives) {
+				i = 1
+			}
+			halves[i].LearningObjectives = append(halves[i].LearningObjectives, objective)
+			delete(remaining, objective)
+		}
+	}
+	for i := range halves {
+		other := &halves[1-i].LearningObjectives
+		if len(halves[i].LearningObjectives) == 0 && len(*other) > 1 {
+			halves[i].LearningObjectives = []string{(*other)[len(*other)-1]}
+			*other = (*other)[:len(*other)-1]
+		}
+	}
+
+	first, second := halves[0].DurationMinutes, halves[1].DurationMinutes
+	if first <= 0 || second <= 0 || first+second != original.DurationMinutes {
+		halves[0].DurationMinutes = original.DurationMinutes / 2
+		halves[1].DurationMinutes = original.DurationMinutes - original.DurationMinutes/2
+	}
+	for i, part := range []string{" (Part 1)", " (Part 2)"} {
+		if halves[i].Title == "" {
+			halves[i].Title = original.Title + part
+		}
+		if halves[i].Description == "" {
+			halves[i].Description = original.Description
+		}
+	}
+	return halves
+}
+
+// fitMergedLesson gives the provider's merged lesson the objectives of both lessons, word
+// for word and in order, and their combined duration.
+func fitMergedLesson(first, second, merged service.OutlineLessonDraft) service.OutlineLessonDraft {
+	var objectives []string
+	for _, objective := range append(slices.Clone(first.LearningObjectives), second.LearningObjectives...) {
+		if !slices.Contains(objectives, objective) {
+			objectives = append(objectives, objective)
+		}
+	}
+	merged.LearningObjectives = objectives
+	merged.DurationMinutes = first.DurationMinutes + second.DurationMinutes
+	if merged.Title == "" {
+		merged.Title = first.Title + " and " + second.Title
+	}
+	if merged.Description == "" {
+		merged.Description = strings.TrimSpace(first.Description + " " + second.Description)
+	}
+	return merged
+}
+
+// proposeOutlineSections writes the outline with the splits and merges applied,
+// renumbering lessons, and records each change and removed lesson in the proposal.
+// A split lesson keeps its ID for the first half; a merge keeps the first lesson's ID.
+func proposeOutlineSections(outline *entity.CourseOutline, splits map[uuid.UUID][2]service.OutlineLessonDraft, merges map[uuid.UUID]service.OutlineLessonDraft, proposal *OutlineBalanceProposal) []UpdateCourseOutlineSection {
+	sections := make([]UpdateCourseOutlineSection, 0, len(outline.Sections))
+	for _, section := range outline.Sections {
+		proposed := UpdateCourseOutlineSection{
+			ID:          section.ID,
+			Title:       section.Title,
+			Description: section.Description,
+			Order:       section.Position,
+		}
+		add := func(id uuid.UUID, draft service.OutlineLessonDraft) {
+			duration := int32(draft.DurationMinutes)
+			proposed.Lessons = append(proposed.Lessons, UpdateCourseOutlineLesson{
+				ID:                       id,
+				Title:                    draft.Title,
+				Description:              draft.Description,
+				Order:                    int32(len(proposed.Lessons) + 1),
+				EstimatedDurationMinutes: &duration,
+				LearningObjectives:       draft.LearningObjectives,
+			})
+		}
+
+		for i := 0; i < len(section.Lessons); i++ {
+			lesson := section.Lessons[i]
+			if halves, ok := splits[lesson.ID]; ok {
+				add(lesson.ID, halves[0])
+				add(uuid.Nil, halves[1])
+				proposal.Changes = append(proposal.Changes, OutlineBalanceChange{
+					Kind:      OutlineBalanceChangeSplit,
+					SectionID: section.ID,
+					LessonIDs: []uuid.UUID{lesson.ID},
+					Titles:    []string{halves[0].Title, halves[1].Title},
+				})
+				continue
+			}
+			if merged, ok := merges[lesson.ID]; ok && i+1 < len(section.Lessons) {
+				next := section.Lessons[i+1]
+				add(lesson.ID, merged)
+				proposal.RemovedLessonIDs = append(proposal.RemovedLessonIDs, next.ID)
+				proposal.Changes = append(proposal.Changes, OutlineBalanceChange{
+					Kind:      OutlineBalanceChangeMerge,
+					SectionID: section.ID,
+					LessonIDs: []uuid.UUID{lesson.ID, next.ID},
+					Titles:    []string{merged.Title},
+				})
+				i++
+				continue
+			}
+			proposed.Lessons = append(proposed.Lessons, UpdateCourseOutlineLesson{
+				ID:                       lesson.ID,
+				Title:                    lesson.Title,
+				Description:              lesson.Description,
+				Order:                    int32(len(proposed.Lessons) + 1),
+				EstimatedDurationMinutes: lesson.EstimatedDurationMinutes,
+				LearningObjectives:       lesson.LearningObjectives,
+			})
+		}
+		sections = append(sections, proposed)
+	}
+	return sections
+}
@@ -0,0 +1,263 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+	"github.com/sogos/mirai-backend/pkg/docx"
+)
+
+// maxOutlineCommentLength caps the length of an outline comment, in characters.
+const maxOutlineCommentLength = 4000
+
+// OutlineCommentNotifier tells a course owner someone commented on their outline.
+type OutlineCommentNotifier interface {
+	NotifyOutlineComment(ctx context.Context, userID uuid.UUID, courseID uuid.UUID, courseTitle, lessonTitle, authorName string) error
+}
+
+// SetOutlineComments enables comments on outline lessons. Without the repository the
+// comment RPCs fail; the notifier is optional.
+func (s *AIGenerationService) SetOutlineComments(commentRepo repository.OutlineCommentRepository, notifier OutlineCommentNotifier) {
+	s.commentRepo = commentRepo
+	s.commentNotifier = notifier
+}
+
+// CreateOutlineCommentRequest contains the parameters for commenting on an outline lesson.
+type CreateOutlineCommentRequest struct {
+	CourseID uuid.UUID
+	LessonID uuid.UUID // Outline lesson ID in any version of the course's outline
+	Body     string
+}
+
+// CreateOutlineComment adds a comment to an outline lesson and notifies the course owner.
+// The comment is stored against the lesson key, so later outline versions keep it.
+func (s *AIGenerationService) CreateOutlineComment(ctx context.Context, kratosID uuid.UUID, req CreateOutlineCommentRequest) (*entity.OutlineComment, error) {
+	log := s.logger.With("kratosID", kratosID, "courseID", req.CourseID, "lessonID", req.LessonID)
+
+	body := strings.TrimSpace(req.Body)
+	if body == "" {
+		return nil, domainerrors.ErrInvalidInput.WithMessage("comment cannot be empty")
+	}
+	if utf8.RuneCountInString(body) > maxOutlineCommentLength {
+		return nil, domainerrors.ErrInvalidInput.WithMessage("comment is too long")
+	}
+
+	user, err := s.outlineCommentUser(ctx, kratosID, req.CourseID)
+	if err != nil {
+		return nil, err
+	}
+
+	lesson, err := s.lessonRepo.GetByID(ctx, req.LessonID)
+	if err != nil || lesson == nil {
+		return nil, domainerrors.ErrNotFound.WithMessage("lesson not found")
+	}
+	section, err := s.sectionRepo.GetByID(ctx, lesson.SectionID)
+	if err != nil || section == nil {
+		return nil, domainerrors.ErrNotFound.WithMessage("lesson not found")
+	}
+	outline, err := s.outlineRepo.GetByID(ctx, section.OutlineID)
+	if err != nil || outline == nil || outline.CourseID != req.CourseID {
+		return nil, domainerrors.ErrNotFound.WithMessage("lesson not found")
+	}
+
+	comment := &entity.OutlineComment{
+		TenantID:     *user.TenantID,
+		CourseID:     req.CourseID,
+		LessonKey:    lesson.LessonKey,
+		AuthorUserID: &user.ID,
+		Body:         body,
+	}
+	if err := s.commentRepo.Create(ctx, comment); err != nil {
+		log.Error("failed to create outline comment", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	comment.AuthorName = s.userDisplayName(ctx, user.ID)
+
+	log.Info("outline comment created", "commentID", comment.ID)
+
+	s.notifyOutlineComment(ctx, user, comment, lesson.Title)
+
+	return comment, nil
+}
+
+// notifyOutlineComment tells the course owner about a new comment, unless they wrote it.
+func (s *AIGenerationService) notifyOutlineComment(ctx context.Context, author *entity.User, comment *entity.OutlineComment, lessonTitle string) {
+	if s.commentNotifier == nil {
+		return
+	}
+	course, err := s.courseRepo.GetByID(ctx, comment.CourseID)
+	if err != nil || course == nil {
+		s.logger.Warn("failed to get course for comment notification", "courseID", comment.CourseID, "error", err)
+		return
+	}
+	if course.CreatedByUserID == author.ID {
+		return
+	}
+
+	authorName := comment.AuthorName
+	if authorName == "" {
+		authorName = "A reviewer"
+	}
+	courseTitle := course.Title
+	if courseTitle == "" {
+		courseTitle = defaultCourseTitle
+	}
+	if err := s.commentNotifier.NotifyOutlineComment(ctx, course.CreatedByUserID, course.ID, courseTitle, lessonTitle, authorName); err != nil {
+		s.logger.Warn("failed to send outline comment notification", "courseID", course.ID, "error", err)
+	}
+}
+
+// ListOutlineComments returns the comments on a course's outline lessons, oldest first.
+// Resolved comments are only included when asked for.
+func (s *AIGenerationService) ListOutlineComments(ctx context.Context, kratosID uuid.UUID, courseID uuid.UUID, includeResolved bool) ([]*entity.OutlineComment, error) {
+	if _, err := s.outlineCommentUser(ctx, kratosID, courseID); err != nil {
+		return nil, err
+	}
+
+	comments, err := s.commentRepo.ListByCourseID(ctx, courseID)
+	if err != nil {
+		s.logger.Error("failed to list outline comments", "courseID", courseID, "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	kept := make([]*entity.OutlineComment, 0, len(comments))
+	for _, c := range comments {
+		if c.Resolved && !includeResolved {
+			continue
+		}
+		kept = append(kept, c)
+	}
+	s.nameCommentAuthors(ctx, kept)
+	return kept, nil
+}
+
+// ResolveOutlineComment marks a comment resolved. Resolving a resolved comment is a no-op.
+func (s *AIGenerationService) ResolveOutlineComment(ctx context.Context, kratosID uuid.UUID, commentID uuid.UUID) (*entity.OutlineComment, error) {
+	if s.commentRepo == nil {
+		return nil, domainerrors.ErrInternal.WithMessage("outline comments are not configured")
+	}
+
+	comment, err := s.commentRepo.GetByID(ctx, commentID)
+	if err != nil {
+		s.logger.Error("failed to get outline comment", "commentID", commentID, "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	if comment == nil {
+		return nil, domainerrors.ErrNotFound.WithMessage("comment not found")
+	}
+
+	user, err := s.outlineCommentUser(ctx, kratosID, comment.CourseID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !comment.Resolved {
+		if err := s.commentRepo.Resolve(ctx, comment.ID, user.ID); err != nil {
+			s.logger.Error("failed to resolve outline comment", "commentID", commentID, "error", err)
+			return nil, domainerrors.ErrInternal.WithCause(err)
+		}
+		if comment, err = s.commentRepo.GetByID(ctx, commentID); err != nil || comment == nil {
+			return nil, domainerrors.ErrInternal.WithMessage("failed to reload comment")
+		}
+	}
+
+	s.nameCommentAuthors(ctx, []*entity.OutlineComment{comment})
+	return comment, nil
+}
+
+// outlineCommentUser resolves the caller and checks they can see the course.
+func (s *AIGenerationService) outlineCommentUser(ctx context.Context, kratosID uuid.UUID, courseID uuid.UUID) (*entity.User, error) {
+	if s.commentRepo == nil {
+		return nil, domainerrors.ErrInternal.WithMessage("outline comments are not configured")
+	}
+
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, domainerrors.ErrUserNotFound
+	}
+	if user.TenantID == nil {
+		return nil, domainerrors.ErrUserHasNoCompany
+	}
+
+	if s.courseAccess != nil {
+		if err := s.courseAccess.CheckCourseViewAccess(ctx, user, courseID); err != nil {
+			return nil, err
+		}
+	}
+	return user, nil
+}
+
+// nameCommentAuthors fills in AuthorName, looking each author up once.
+func (s *AIGenerationService) nameCommentAuthors(ctx context.Context, comments []*entity.OutlineComment) {
+	names := make(map[uuid.UUID]string)
+	for _, c := range comments {
+		if c.AuthorUserID == nil {
+			continue
+		}
+		name, ok := names[*c.AuthorUserID]
+		if !ok {
+			name = s.userDisplayName(ctx, *c.AuthorUserID)
+			names[*c.AuthorUserID] = name
+		}
+		c.AuthorName = name
+	}
+}
+
+// countUnresolvedComments counts unresolved comments on the lessons still in the outline.
+// Comments on lessons a regeneration removed don't count.
+func (s *AIGenerationService) countUnresolvedComments(ctx context.Context, outline *entity.CourseOutline) int {
+	if s.commentRepo == nil {
+		return 0
+	}
+
+	var lessonKeys []uuid.UUID
+	for _, section := range outline.Sections {
+		for _, lesson := range section.Lessons {
+			lessonKeys = append(lessonKeys, lesson.LessonKey)
+		}
+	}
+	if len(lessonKeys) == 0 {
+		return 0
+	}
+
+	count, err := s.commentRepo.CountUnresolved(ctx, outline.CourseID, lessonKeys)
+	if err != nil {
+		s.logger.Warn("failed to count unresolved outline comments", "courseID", outline.CourseID, "error", err)
+		return 0
+	}
+	return count
+}
+
+// outlineExportComments returns the course's comments as DOCX margin notes keyed by
+// lesson key. Resolved comments are included and marked as such.
+func (s *AIGenerationService) outlineExportComments(ctx context.Context, courseID uuid.UUID) map[uuid.UUID][]docx.Comment {
+	if s.commentRepo == nil {
+		return nil
+	}
+
+	comments, err := s.commentRepo.ListByCourseID(ctx, courseID)
+	if err != nil {
+		s.logger.Warn("failed to list outline comments for export", "courseID", courseID, "error", err)
+		return nil
+	}
+	s.nameCommentAuthors(ctx, comments)
+
+	notes := make(map[uuid.UUID][]docx.Comment)
+	for _, c := range comments {
+		text := c.Body
+		if c.Resolved {
+			text = "[Resolved] " + text
+		}
+		notes[c.LessonKey] = append(notes[c.LessonKey], docx.Comment{
+			Author: c.AuthorName,
+			Date:   c.CreatedAt,
+			Text:   text,
+		})
+	}
+	return notes
+}
@@ -0,0 +1,125 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/service"
+)
+
+// outlineConstraintTolerance is how far (as a fraction) a generated outline may
+// exceed its constraints before it is corrected.
+const outlineConstraintTolerance = 0.2
+
+// validateOutlineConstraints rejects non-positive constraint values.
+func validateOutlineConstraints(c entity.OutlineConstraints) error {
+	if c.MaxSections != nil && *c.MaxSections <= 0 {
+		return domainerrors.ErrInvalidInput.WithMessage("max sections must be positive")
+	}
+	if c.MaxLessonsPerSection != nil && *c.MaxLessonsPerSection <= 0 {
+		return domainerrors.ErrInvalidInput.WithMessage("max lessons per section must be positive")
+	}
+	if c.TargetDurationMinutes != nil && *c.TargetDurationMinutes <= 0 {
+		return domainerrors.ErrInvalidInput.WithMessage("target duration minutes must be positive")
+	}
+	return nil
+}
+
+// withTolerance returns the largest value still accepted for a limit.
+func withTolerance(limit int32) int {
+	return int(float64(limit) * (1 + outlineConstraintTolerance))
+}
+
+// outlineDuration sums the estimated lesson durations of an outline.
+func outlineDuration(sections []service.OutlineSectionResult) int {
+	total := 0
+	for _, section := range sections {
+		for _, lesson := range section.Lessons {
+			total += lesson.EstimatedDurationMinutes
+		}
+	}
+	return total
+}
+
+// outlineConstraintViolation describes how the outline exceeds its constraints
+// beyond the tolerance, or returns "" if it is acceptable.
+func outlineConstraintViolation(sections []service.OutlineSectionResult, c entity.OutlineConstraints) string {
+	var problems []string
+
+	if c.MaxSections != nil && len(sections) > withTolerance(*c.MaxSections) {
+		problems = append(problems, fmt.Sprintf("the outline has %d sections but at most %d are allowed", len(sections), *c.MaxSections))
+	}
+	if c.MaxLessonsPerSection != nil {
+		for _, section := range sections {
+			if len(section.Lessons) > withTolerance(*c.MaxLessonsPerSection) {
+				problems = append(problems, fmt.Sprintf("section %q has %d lessons but at most %d per section are allowed", section.Title, len(section.Lessons), *c.MaxLessonsPerSection))
+			}
+		}
+	}
+	if c.TargetDurationMinutes != nil {
+		if total := outlineDuration(sections); total > withTolerance(*c.TargetDurationMinutes) {
+			problems = append(problems, fmt.Sprintf("the lessons total %d minutes but the course should take about %d minutes", total, *c.TargetDurationMinutes))
+		}
+	}
+
+	return strings.Join(problems, "; ")
+}
+
+// trimOutline cuts an outline down to its constraints by dropping the lowest-priority
+// content: trailing sections, then trailing lessons in each section, then trailing
+// lessons of the longest sections until the duration fits. A section is never emptied.
+func trimOutline(sections []service.OutlineSectionResult, c entity.OutlineConstraints) []service.OutlineSectionResult {
+	if c.MaxSections != nil && len(sections) > int(*c.MaxSections) {
+		sections = sections[:*c.MaxSections]
+	}
+
+	if c.MaxLessonsPerSection != nil {
+		for i := range sections {
+			if len(sections[i].Lessons) > int(*c.MaxLessonsPerSection) {
+				sections[i].Lessons = sections[i].Lessons[:*c.MaxLessonsPerSection]
+			}
+		}
+	}
+
+	if c.TargetDurationMinutes != nil {
+		for outlineDuration(sections) > int(*c.TargetDurationMinutes) {
+			longest := -1
+			for i, section := range sections {
+				if len(section.Lessons) > 1 && (longest < 0 || len(section.Lessons) > len(sections[longest].Lessons)) {
+					longest = i
+				}
+			}
+			if longest < 0 {
+				break // Every section is down to a single lesson
+			}
+			sections[longest].Lessons = sections[longest].Lessons[:len(sections[longest].Lessons)-1]
+		}
+	}
+
+	// Renumber and reset the position flags after trimming
+	for i := range sections {
+		sections[i].Order = i + 1
+		for j := range sections[i].Lessons {
+			sections[i].Lessons[j].Order = j + 1
+			sections[i].Lessons[j].IsLastInSection = j == len(sections[i].Lessons)-1
+			sections[i].Lessons[j].IsLastInCourse = i == len(sections)-1 && j == len(sections[i].Lessons)-1
+		}
+	}
+
+	return sections
+}
+
+// outlineConstraintsToRequest converts constraints to provider request limits (0 = unconstrained).
+func outlineConstraintsToRequest(req *service.GenerateOutlineRequest, c entity.OutlineConstraints) {
+	if c.MaxSections != nil {
+		req.MaxSections = int(*c.MaxSections)
+	}
+	if c.MaxLessonsPerSection != nil {
+		req.MaxLessonsPerSection = int(*c.MaxLessonsPerSection)
+	}
+	if c.TargetDurationMinutes != nil {
+		req.TargetDurationMinutes = int(*c.TargetDurationMinutes)
+	}
+}
@@ -0,0 +1,222 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+	"github.com/sogos/mirai-backend/pkg/docx"
+)
+
+// outlineExportURLExpiry is how long the download link of an outline export stays valid.
+const outlineExportURLExpiry = time.Hour
+
+// OutlineExportStorage stores exported outline files in tenant storage.
+type OutlineExportStorage interface {
+	BuildPath(tenantID uuid.UUID, subpath string) string
+	PutContent(ctx context.Context, path string, content []byte, contentType string) error
+	GenerateDownloadURL(ctx context.Context, tenantID uuid.UUID, subpath string, expiry time.Duration) (string, error)
+}
+
+// SetOutlineExportStorage enables outline exports. Without it, ExportOutline fails.
+func (s *AIGenerationService) SetOutlineExportStorage(storage OutlineExportStorage) {
+	s.exportStorage = storage
+}
+
+// ExportOutlineRequest contains the parameters for exporting a course outline.
+type ExportOutlineRequest struct {
+	CourseID uuid.UUID
+	Format   valueobject.OutlineExportFormat
+	Version  *int32 // nil exports the latest outline version
+}
+
+// ExportOutlineResult contains the location of an exported outline.
+type ExportOutlineResult struct {
+	DownloadURL string
+	Filename    string
+	ExpiresAt   time.Time
+}
+
+// ExportOutline renders a course outline as CSV or DOCX, stores it in tenant
+// storage and returns a time-limited download link.
+func (s *AIGenerationService) ExportOutline(ctx context.Context, kratosID uuid.UUID, req ExportOutlineRequest) (*ExportOutlineResult, error) {
+	log := s.logger.With("kratosID", kratosID, "courseID", req.CourseID, "format", req.Format)
+
+	if !req.Format.IsValid() {
+		return nil, domainerrors.ErrInvalidInput.WithMessage("unsupported export format")
+	}
+	if s.exportStorage == nil {
+		return nil, domainerrors.ErrInternal.WithMessage("outline export is not configured")
+	}
+
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, domainerrors.ErrUserNotFound
+	}
+	if user.TenantID == nil {
+		return nil, domainerrors.ErrUserHasNoCompany
+	}
+
+	var outline *entity.CourseOutline
+	if req.Version != nil {
+		outline, err = s.outlineRepo.GetByCourseIDAndVersion(ctx, req.CourseID, *req.Version)
+	} else {
+		outline, err = s.outlineRepo.GetByCourseID(ctx, req.CourseID)
+	}
+	if err != nil || outline == nil {
+		return nil, domainerrors.ErrNotFound.WithMessage("outline not found")
+	}
+
+	if err := s.loadOutlineSections(ctx, outline); err != nil {
+		log.Error("failed to load outline sections", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	courseTitle := s.resolveCourseTitle(ctx, req.CourseID, nil)
+	locale := s.exportLocale(ctx, user)
+
+	var content []byte
+	switch req.Format {
+	case valueobject.OutlineExportFormatDOCX:
+		content, err = renderOutlineDOCX(courseTitle, outline, locale, s.outlineExportComments(ctx, req.CourseID))
+	default:
+		content, err = renderOutlineCSV(outline, locale)
+	}
+	if err != nil {
+		log.Error("failed to render outline export", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	filename := fmt.Sprintf("%s-outline-v%d.%s", exportFileSlug(courseTitle), outline.Version, req.Format)
+	subpath := path.Join("exports", uuid.New().String(), filename)
+
+	if err := s.exportStorage.PutContent(ctx, s.exportStorage.BuildPath(*user.TenantID, subpath), content, req.Format.ContentType()); err != nil {
+		log.Error("failed to store outline export", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	url, err := s.exportStorage.GenerateDownloadURL(ctx, *user.TenantID, subpath, outlineExportURLExpiry)
+	if err != nil {
+		log.Error("failed to generate export download URL", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	log.Info("outline exported", "version", outline.Version, "bytes", len(content))
+
+	return &ExportOutlineResult{
+		DownloadURL: url,
+		Filename:    filename,
+		ExpiresAt:   time.Now().Add(outlineExportURLExpiry),
+	}, nil
+}
+
+// exportLocale returns the locale exports are formatted in: the tenant's when an admin
+// has picked one, otherwise the requesting user's.
+func (s *AIGenerationService) exportLocale(ctx context.Context, user *entity.User) valueobject.Locale {
+	settings, err := s.aiSettingsRepo.Get(ctx, *user.TenantID)
+	if err != nil {
+		s.logger.Warn("failed to get tenant locale for export", "error", err)
+	} else if settings != nil && settings.Locale != nil {
+		return *settings.Locale
+	}
+	return user.Locale
+}
+
+// renderOutlineCSV writes one row per lesson. Objectives and audiences are joined
+// with "; " so each lesson stays on a single row. Fields are separated the way
+// spreadsheets in the locale expect.
+func renderOutlineCSV(outline *entity.CourseOutline, locale valueobject.Locale) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.Comma = locale.CSVSeparator()
+
+	if err := w.Write([]string{"Section", "Lesson", "Description", "Duration (minutes)", "Learning Objectives", "Target Audiences"}); err != nil {
+		return nil, err
+	}
+	for _, section := range outline.Sections {
+		for _, lesson := range section.Lessons {
+			duration := ""
+			if lesson.EstimatedDurationMinutes != nil {
+				duration = strconv.Itoa(int(*lesson.EstimatedDurationMinutes))
+			}
+			row := []string{
+				section.Title,
+				lesson.Title,
+				lesson.Description,
+				duration,
+				strings.Join(lesson.LearningObjectives, "; "),
+				strings.Join(lesson.TargetAudiences, "; "),
+			}
+			if err := w.Write(row); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// renderOutlineDOCX lays out sections as headings and lessons as sub-headings
+// with their objectives as bullet lists. Dates and durations follow the locale.
+// Reviewer comments, keyed by lesson key, become margin notes on the lesson heading.
+func renderOutlineDOCX(courseTitle string, outline *entity.CourseOutline, locale valueobject.Locale, comments map[uuid.UUID][]docx.Comment) ([]byte, error) {
+	doc := docx.New()
+	doc.Title(courseTitle)
+	doc.Paragraph(fmt.Sprintf("Course outline, version %d, generated %s", outline.Version, locale.FormatDate(outline.GeneratedAt)))
+	if total := outline.TotalDurationMinutes(); total > 0 {
+		doc.Paragraph(fmt.Sprintf("%s lessons, estimated duration %s", locale.FormatNumber(int64(outline.LessonCount())), locale.FormatDuration(total)))
+	}
+
+	for _, section := range outline.Sections {
+		doc.Heading(1, section.Title)
+		if section.Description != "" {
+			doc.Paragraph(section.Description)
+		}
+
+		for _, lesson := range section.Lessons {
+			doc.CommentedHeading(2, lesson.Title, comments[lesson.LessonKey])
+			if lesson.Description != "" {
+				doc.Paragraph(lesson.Description)
+			}
+			if lesson.EstimatedDurationMinutes != nil {
+				doc.Paragraph("Duration: " + locale.FormatDuration(int(*lesson.EstimatedDurationMinutes)))
+			}
+			if len(lesson.TargetAudiences) > 0 {
+				doc.Paragraph("For: " + strings.Join(lesson.TargetAudiences, ", "))
+			}
+			for _, objective := range lesson.LearningObjectives {
+				doc.Bullet(objective)
+			}
+		}
+	}
+
+	return doc.Bytes()
+}
+
+var exportFileSlugPattern = regexp.MustCompile(`[^a-z0-9]+`)
+
+// exportFileSlug turns a course title into a file-name-safe slug.
+func exportFileSlug(title string) string {
+	slug := strings.Trim(exportFileSlugPattern.ReplaceAllString(strings.ToLower(title), "-"), "-")
+	if len(slug) > 50 {
+		slug = strings.TrimRight(slug[:50], "-")
+	}
+	if slug == "" {
+		return "course"
+	}
+	return slug
+}
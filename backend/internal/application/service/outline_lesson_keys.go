@@ -0,0 +1,173 @@
+package service
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+)
+
+const (
+	// lessonMatchTitleSimilarity is the title similarity at which a regenerated lesson
+	// matches a previous one wherever it sits in the outline.
+	lessonMatchTitleSimilarity = 0.6
+
+	// lessonMatchNearbyTitleSimilarity is the lower similarity accepted for lessons in
+	// about the same place, where a reworded title is the likely explanation.
+	lessonMatchNearbyTitleSimilarity = 0.35
+
+	// lessonMatchNearbyDistance is how far apart two lessons can be, as a fraction of
+	// the outline's length, and still count as in about the same place.
+	lessonMatchNearbyDistance = 0.1
+
+	// lessonMatchDistanceWeight lets position break ties between equally similar titles,
+	// such as two lessons both called "Summary".
+	lessonMatchDistanceWeight = 0.25
+)
+
+// titleStopWords are ignored when comparing lesson titles.
+var titleStopWords = map[string]bool{
+	"a": true, "an": true, "and": true, "the": true, "of": true, "to": true,
+	"in": true, "on": true, "for": true, "with": true, "your": true, "how": true,
+}
+
+// carryForwardLessonKeys keys the lessons of a regenerated outline. A lesson that
+// confidently matches one from the previous version carries that lesson's key, so
+// content generated for it stays attached; the rest are new and keyed by their own ID.
+// Returns how the lessons map to the previous version, or nil if there was none.
+func carryForwardLessonKeys(previous *entity.CourseOutline, lessons []entity.OutlineLesson) *entity.OutlineLessonChanges {
+	for i := range lessons {
+		lessons[i].LessonKey = lessons[i].ID
+	}
+	if previous == nil {
+		return nil
+	}
+
+	var prevLessons []entity.OutlineLesson
+	for _, section := range previous.Sections {
+		prevLessons = append(prevLessons, section.Lessons...)
+	}
+
+	type candidate struct {
+		lesson, prev int
+		score        float64
+	}
+	var candidates []candidate
+	for i, lesson := range lessons {
+		for j, prev := range prevLessons {
+			similarity := titleSimilarity(lesson.Title, prev.Title)
+			distance := relativeDistance(i, len(lessons), j, len(prevLessons))
+			if similarity < lessonMatchTitleSimilarity &&
+				(similarity < lessonMatchNearbyTitleSimilarity || distance > lessonMatchNearbyDistance) {
+				continue
+			}
+			candidates = append(candidates, candidate{
+				lesson: i,
+				prev:   j,
+				score:  similarity - lessonMatchDistanceWeight*distance,
+			})
+		}
+	}
+
+	// Best matches first; each lesson on either side is matched at most once
+	sort.SliceStable(candidates, func(a, b int) bool {
+		return candidates[a].score > candidates[b].score
+	})
+	matchOf := make(map[int]int, len(candidates))
+	prevMatched := make(map[int]bool, len(candidates))
+	for _, c := range candidates {
+		if _, ok := matchOf[c.lesson]; ok || prevMatched[c.prev] {
+			continue
+		}
+		matchOf[c.lesson] = c.prev
+		prevMatched[c.prev] = true
+	}
+
+	changes := &entity.OutlineLessonChanges{
+		PreviousOutlineID: previous.ID,
+		Kept:              []entity.OutlineLessonChange{},
+		Added:             []entity.OutlineLessonChange{},
+		Removed:           []entity.OutlineLessonChange{},
+	}
+	for i := range lessons {
+		j, ok := matchOf[i]
+		if !ok {
+			changes.Added = append(changes.Added, entity.OutlineLessonChange{
+				LessonKey: lessons[i].LessonKey,
+				Title:     lessons[i].Title,
+			})
+			continue
+		}
+		lessons[i].LessonKey = lessonKeyOf(prevLessons[j])
+		changes.Kept = append(changes.Kept, entity.OutlineLessonChange{
+			LessonKey:     lessons[i].LessonKey,
+			Title:         lessons[i].Title,
+			PreviousTitle: prevLessons[j].Title,
+		})
+	}
+	for j, prev := range prevLessons {
+		if !prevMatched[j] {
+			changes.Removed = append(changes.Removed, entity.OutlineLessonChange{
+				LessonKey: lessonKeyOf(prev),
+				Title:     prev.Title,
+			})
+		}
+	}
+	return changes
+}
+
+// lessonKeyOf returns the lesson's key, falling back to its ID.
+func lessonKeyOf(lesson entity.OutlineLesson) uuid.UUID {
+	if lesson.LessonKey == uuid.Nil {
+		return lesson.ID
+	}
+	return lesson.LessonKey
+}
+
+// relativeDistance compares the positions of lesson i of n and lesson j of m as
+// fractions of their outlines, so lessons near the end of both count as close even
+// when the outlines differ in length.
+func relativeDistance(i, n, j, m int) float64 {
+	a := (float64(i) + 0.5) / float64(n)
+	b := (float64(j) + 0.5) / float64(m)
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
+
+// titleSimilarity scores two lesson titles from 0 to 1 by the overlap of their words
+// (Dice coefficient), ignoring case, punctuation and common filler words.
+func titleSimilarity(a, b string) float64 {
+	wordsA, wordsB := titleWords(a), titleWords(b)
+	if len(wordsA) == 0 || len(wordsB) == 0 {
+		if strings.EqualFold(strings.TrimSpace(a), strings.TrimSpace(b)) {
+			return 1
+		}
+		return 0
+	}
+
+	shared := 0
+	for word := range wordsA {
+		if wordsB[word] {
+			shared++
+		}
+	}
+	return 2 * float64(shared) / float64(len(wordsA)+len(wordsB))
+}
+
+// titleWords returns the distinct significant words of a title, lowercased.
+func titleWords(title string) map[string]bool {
+	words := make(map[string]bool)
+	for _, word := range strings.FieldsFunc(strings.ToLower(title), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		if !titleStopWords[word] {
+			words[word] = true
+		}
+	}
+	return words
+}
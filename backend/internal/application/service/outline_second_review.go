@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+// OutlineReviewNotifier tells admins an endorsed outline is waiting for a second reviewer.
+type OutlineReviewNotifier interface {
+	NotifyOutlineAwaitingSecondReview(ctx context.Context, userID uuid.UUID, courseID uuid.UUID, courseTitle, endorserName string) error
+}
+
+// SetOutlineReviewNotifier enables notifying admins when an outline needs a second
+// reviewer. Without it, endorsements are still recorded.
+func (s *AIGenerationService) SetOutlineReviewNotifier(notifier OutlineReviewNotifier) {
+	s.reviewNotifier = notifier
+}
+
+// secondReviewRequired reports whether the tenant requires a second reviewer for
+// outline approval.
+func (s *AIGenerationService) secondReviewRequired(ctx context.Context, tenantID uuid.UUID) (bool, error) {
+	settings, err := s.aiSettingsRepo.Get(ctx, tenantID)
+	if err != nil {
+		return false, err
+	}
+	return settings != nil && settings.RequireSecondReviewer, nil
+}
+
+// checkSecondReview applies the second reviewer policy to an approval of outline by
+// user. It returns true if the approval completes the review, and false if it is the
+// first reviewer's and should be recorded as an endorsement.
+func checkSecondReview(user *entity.User, outline *entity.CourseOutline) (bool, error) {
+	if outline.ApprovalStatus == valueobject.OutlineApprovalStatusApproved {
+		return false, domainerrors.ErrInvalidInput.WithMessage("outline is already approved")
+	}
+	if outline.GeneratedByUserID != nil && *outline.GeneratedByUserID == user.ID {
+		return false, domainerrors.ErrForbidden.WithMessage("your organization requires a second reviewer: you generated this outline, so someone else must review it")
+	}
+	if outline.EndorsedByUserID == nil {
+		return false, nil
+	}
+	if *outline.EndorsedByUserID == user.ID {
+		return false, domainerrors.ErrForbidden.WithMessage("your organization requires a second reviewer: you already endorsed this outline, so a different reviewer must approve it")
+	}
+	return true, nil
+}
+
+// endorseCourseOutline records user as the outline's first reviewer, leaving it pending
+// review, and tells the other admins it is waiting for a second reviewer.
+func (s *AIGenerationService) endorseCourseOutline(ctx context.Context, user *entity.User, outline *entity.CourseOutline) (*entity.CourseOutline, error) {
+	log := s.logger.With("userID", user.ID, "outlineID", outline.ID)
+
+	now := time.Now()
+	outline.EndorsedAt = &now
+	outline.EndorsedByUserID = &user.ID
+	if err := s.outlineRepo.Update(ctx, outline); err != nil {
+		log.Error("failed to endorse outline", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	s.invalidateCourseCaches(ctx, outline.CourseID)
+
+	if err := s.loadOutlineSections(ctx, outline); err != nil {
+		log.Error("failed to load sections", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	log.Info("outline endorsed, awaiting second review")
+
+	s.notifySecondReviewers(ctx, user, outline)
+
+	return outline, nil
+}
+
+// notifySecondReviewers tells the tenant's admins who can still approve an endorsed
+// outline, i.e. everyone but its generator and endorser, that it awaits their review.
+func (s *AIGenerationService) notifySecondReviewers(ctx context.Context, endorser *entity.User, outline *entity.CourseOutline) {
+	if s.reviewNotifier == nil {
+		return
+	}
+	log := s.logger.With("outlineID", outline.ID, "courseID", outline.CourseID)
+
+	admins, err := s.userRepo.ListActiveAdminsByTenantID(ctx, outline.TenantID)
+	if err != nil {
+		log.Warn("failed to list admins for second review notification", "error", err)
+		return
+	}
+
+	endorserName := s.userDisplayName(ctx, endorser.ID)
+	if endorserName == "" {
+		endorserName = "A reviewer"
+	}
+	courseTitle := s.resolveCourseTitle(ctx, outline.CourseID, nil)
+
+	for _, admin := range admins {
+		if admin.ID == endorser.ID || (outline.GeneratedByUserID != nil && admin.ID == *outline.GeneratedByUserID) {
+			continue
+		}
+		if err := s.reviewNotifier.NotifyOutlineAwaitingSecondReview(ctx, admin.ID, outline.CourseID, courseTitle, endorserName); err != nil {
+			log.Warn("failed to send second review notification", "userID", admin.ID, "error", err)
+		}
+	}
+}
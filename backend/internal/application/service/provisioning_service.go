@@ -9,17 +9,24 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/links"
 	"github.com/sogos/mirai-backend/internal/domain/repository"
 	"github.com/sogos/mirai-backend/internal/domain/service"
 	"github.com/sogos/mirai-backend/internal/domain/valueobject"
 )
 
+// SampleContentProvisioner creates onboarding sample content for a new tenant.
+type SampleContentProvisioner interface {
+	ProvisionSampleContent(ctx context.Context, tenantID, companyID, userID uuid.UUID) error
+}
+
 // ProvisioningService handles background provisioning of paid registrations.
 type ProvisioningService struct {
 	pendingRegRepo repository.PendingRegistrationRepository
 	tenantRepo     repository.TenantRepository
 	userRepo       repository.UserRepository
 	companyRepo    repository.CompanyRepository
+	samples        SampleContentProvisioner
 	identity       service.IdentityProvider
 	email          service.EmailProvider
 	logger         service.Logger
@@ -32,6 +39,7 @@ func NewProvisioningService(
 	tenantRepo repository.TenantRepository,
 	userRepo repository.UserRepository,
 	companyRepo repository.CompanyRepository,
+	samples SampleContentProvisioner,
 	identity service.IdentityProvider,
 	email service.EmailProvider,
 	logger service.Logger,
@@ -42,6 +50,7 @@ func NewProvisioningService(
 		tenantRepo:     tenantRepo,
 		userRepo:       userRepo,
 		companyRepo:    companyRepo,
+		samples:        samples,
 		identity:       identity,
 		email:          email,
 		logger:         logger,
@@ -323,11 +332,25 @@ func (s *ProvisioningService) provisionAccount(ctx context.Context, reg *entity.
 
 	log.Info("created Kratos identity", "kratosID", kratosID)
 
+	return s.completeProvisioning(ctx, reg, kratosID, valueobject.ParseLocale(identity.Locale))
+}
+
+// completeProvisioning creates the tenant, company and user for a registration whose
+// Kratos identity already exists, then removes the pending registration. The locale comes
+// from the identity traits and selects the welcome email language.
+func (s *ProvisioningService) completeProvisioning(ctx context.Context, reg *entity.PendingRegistration, kratosID uuid.UUID, locale valueobject.Locale) error {
+	log := s.logger.With(
+		"checkoutSessionID", reg.CheckoutSessionID,
+		"email", reg.Email,
+		"kratosID", kratosID,
+	)
+
 	// Step 2: Create tenant for this organization
 	tenant := &entity.Tenant{
-		Name:   reg.CompanyName,
-		Slug:   generateTenantSlug(reg.CompanyName),
-		Status: entity.TenantStatusActive,
+		Name:          reg.CompanyName,
+		Slug:          generateTenantSlug(reg.CompanyName),
+		Status:        entity.TenantStatusActive,
+		StorageRegion: reg.StorageRegion,
 	}
 
 	if err := s.tenantRepo.Create(ctx, tenant); err != nil {
@@ -335,7 +358,7 @@ func (s *ProvisioningService) provisionAccount(ctx context.Context, reg *entity.
 		return err
 	}
 
-	log.Info("created tenant", "tenantID", tenant.ID, "slug", tenant.Slug)
+	log.Info("created tenant", "tenantID", tenant.ID, "slug", tenant.Slug, "storageRegion", tenant.StorageRegion)
 
 	// Step 3: Create company with subscription details and tenant reference
 	company := &entity.Company{
@@ -363,6 +386,7 @@ func (s *ProvisioningService) provisionAccount(ctx context.Context, reg *entity.
 		KratosID:  kratosID,
 		CompanyID: &company.ID,
 		Role:      valueobject.RoleAdmin,
+		Locale:    locale,
 	}
 
 	if err := s.userRepo.Create(ctx, user); err != nil {
@@ -372,15 +396,22 @@ func (s *ProvisioningService) provisionAccount(ctx context.Context, reg *entity.
 
 	log.Info("created user", "userID", user.ID)
 
-	// Step 5: Delete the pending registration (successful provisioning)
+	// Step 5: Create onboarding sample content (never fails provisioning)
+	if s.samples != nil {
+		if err := s.samples.ProvisionSampleContent(ctx, tenant.ID, company.ID, user.ID); err != nil {
+			log.Warn("failed to provision sample content", "error", err)
+		}
+	}
+
+	// Step 6: Delete the pending registration (successful provisioning)
 	if err := s.pendingRegRepo.Delete(ctx, reg.ID); err != nil {
 		log.Warn("failed to delete pending registration", "error", err)
 		// Don't fail - account is created successfully
 	}
 
-	// Step 6: Send welcome email (async, don't fail if email fails)
+	// Step 7: Send welcome email (async, don't fail if email fails)
 	if s.email != nil {
-		go s.sendWelcomeEmail(reg.Email, reg.FirstName, reg.CompanyName)
+		go s.sendWelcomeEmail(reg.Email, locale, reg.FirstName, reg.CompanyName)
 	}
 
 	log.Info("account provisioned successfully",
@@ -393,7 +424,7 @@ func (s *ProvisioningService) provisionAccount(ctx context.Context, reg *entity.
 }
 
 // sendWelcomeEmail sends a welcome email to the new user.
-func (s *ProvisioningService) sendWelcomeEmail(email, firstName, companyName string) {
+func (s *ProvisioningService) sendWelcomeEmail(email string, locale valueobject.Locale, firstName, companyName string) {
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
@@ -401,9 +432,10 @@ func (s *ProvisioningService) sendWelcomeEmail(email, firstName, companyName str
 
 	err := s.email.SendWelcome(ctx, service.SendWelcomeRequest{
 		To:          email,
+		Locale:      locale,
 		FirstName:   firstName,
 		CompanyName: companyName,
-		LoginURL:    s.frontendURL + "/auth/login",
+		LoginURL:    s.frontendURL + links.LoginLink(),
 	})
 
 	if err != nil {
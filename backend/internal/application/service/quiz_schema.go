@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/domain/tenant"
+	"github.com/sogos/mirai-backend/internal/infrastructure/cache"
+)
+
+// quizMigrationPageSize is how many quiz components the migration reads at a time.
+const quizMigrationPageSize = 200
+
+// QuizSchemaMigrationEnqueuer enqueues the quiz schema migration.
+type QuizSchemaMigrationEnqueuer interface {
+	EnqueueQuizSchemaMigration() error
+}
+
+// SetQuizSchemaMigration lets superadmins start the quiz schema migration. Superadmins
+// are recognized and the counts emailed through SetConsistencySweep's checker and provider.
+func (s *AIGenerationService) SetQuizSchemaMigration(enqueuer QuizSchemaMigrationEnqueuer) {
+	s.quizMigration = enqueuer
+}
+
+// StartQuizSchemaMigration lets a superadmin queue the migration that upgrades quiz
+// components in every tenant to the current quiz schema.
+func (s *AIGenerationService) StartQuizSchemaMigration(ctx context.Context, email string) error {
+	if s.superAdmins == nil || !s.superAdmins.IsSuperAdmin(email) {
+		return domainerrors.ErrForbidden.WithMessage("superadmin access required")
+	}
+	if s.quizMigration == nil {
+		return domainerrors.ErrInternal.WithMessage("quiz schema migration is not configured")
+	}
+	if err := s.quizMigration.EnqueueQuizSchemaMigration(); err != nil {
+		s.logger.Error("failed to enqueue quiz schema migration", "error", err)
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+	s.logger.Info("quiz schema migration requested", "actor", email)
+	return nil
+}
+
+// QuizSchemaMigrationResult reports what a quiz schema migration did.
+type QuizSchemaMigrationResult struct {
+	Outdated int // Quiz components below the current schema version
+	Migrated int // Upgraded to the current schema
+	Changed  int // Edited while the migration ran; left for the next run
+	Failed   int // Couldn't be turned into a gradable quiz; left as they were
+}
+
+// MigrateQuizSchema upgrades quiz components in every tenant whose content is below the
+// current quiz schema version. Each is converted as ParseQuizContent describes and stored
+// only if the result passes validation and nobody edited the component meanwhile. Quizzes
+// that fail are left untouched for an author to fix; the player and exports still read
+// them. The counts are logged and sent to the operators.
+func (s *AIGenerationService) MigrateQuizSchema(ctx context.Context) (*QuizSchemaMigrationResult, error) {
+	log := s.logger.With("job", "quiz-schema-migration")
+	adminCtx := tenant.WithSuperAdmin(ctx, true)
+
+	result := &QuizSchemaMigrationResult{}
+	lessons := make(map[uuid.UUID]uuid.UUID) // Lessons with migrated quizzes, to their tenant
+	after := uuid.Nil
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		components, err := s.componentRepo.ListQuizzesBelowSchemaVersion(adminCtx, entity.QuizSchemaVersion, after, quizMigrationPageSize)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list outdated quiz components: %w", err)
+		}
+
+		for _, component := range components {
+			after = component.ID
+			result.Outdated++
+
+			quiz, _, err := entity.ParseQuizContent(component.ContentJSON)
+			if err == nil {
+				err = quiz.Validate()
+			}
+			if err != nil {
+				log.Warn("quiz component could not be migrated", "componentID", component.ID, "tenantID", component.TenantID, "error", err)
+				result.Failed++
+				continue
+			}
+			data, err := json.Marshal(quiz)
+			if err != nil {
+				result.Failed++
+				continue
+			}
+
+			replaced, err := s.componentRepo.ReplaceContent(adminCtx, component.ID, data, component.UpdatedAt)
+			if err != nil {
+				log.Warn("failed to store migrated quiz component", "componentID", component.ID, "error", err)
+				result.Failed++
+				continue
+			}
+			if !replaced {
+				result.Changed++
+				continue
+			}
+			result.Migrated++
+			lessons[component.LessonID] = component.TenantID
+		}
+
+		if len(components) < quizMigrationPageSize {
+			break
+		}
+	}
+
+	s.invalidateMigratedQuizCourses(adminCtx, lessons)
+
+	log.Info("quiz schema migration finished",
+		"outdated", result.Outdated, "migrated", result.Migrated, "changed", result.Changed, "failed", result.Failed)
+
+	if s.alertEmail != nil && result.Outdated > 0 {
+		subject := "[INFO] Mirai: Quiz Schema Migration Finished"
+		if result.Failed > 0 || result.Changed > 0 {
+			subject = "[WARNING] Mirai: Quiz Schema Migration Incomplete"
+		}
+		err := s.alertEmail.SendAlert(ctx, service.SendAlertRequest{
+			Subject: subject,
+			Body: fmt.Sprintf("The quiz schema migration found %d quiz components below schema version %d.\n\n", result.Outdated, entity.QuizSchemaVersion) +
+				fmt.Sprintf("Migrated: %d\nEdited during the migration: %d\nCould not be migrated: %d\n\n", result.Migrated, result.Changed, result.Failed) +
+				"Quizzes that could not be migrated have no usable correct answer or options and are logged by component ID; " +
+				"they keep working in the player until an author fixes them. Run the migration again to pick up edited ones.",
+		})
+		if err != nil {
+			log.Error("failed to send quiz schema migration alert", "error", err)
+		}
+	}
+	return result, nil
+}
+
+// invalidateMigratedQuizCourses drops the cached player view of each course with a
+// migrated quiz, so learners get the upgraded content.
+func (s *AIGenerationService) invalidateMigratedQuizCourses(ctx context.Context, lessons map[uuid.UUID]uuid.UUID) {
+	if s.playerCache == nil {
+		return
+	}
+	courses := make(map[uuid.UUID]uuid.UUID)
+	for lessonID, tenantID := range lessons {
+		lesson, err := s.genLessonRepo.GetByID(ctx, lessonID)
+		if err != nil || lesson == nil {
+			s.logger.Warn("failed to get lesson of migrated quiz", "lessonID", lessonID, "error", err)
+			continue
+		}
+		courses[lesson.CourseID] = tenantID
+	}
+	for courseID, tenantID := range courses {
+		tenantCtx := tenant.WithTenantID(ctx, tenantID)
+		if err := s.playerCache.Delete(tenantCtx, cache.TenantCacheKeys.CoursePlayer(courseID.String())); err != nil {
+			s.logger.Warn("failed to invalidate course player view", "courseID", courseID, "error", err)
+		}
+	}
+}
+
+// playerQuizContent returns quiz content in the current schema, upgrading content written
+// before it, such as quizzes in versions published before the migration. Content that
+// can't be upgraded is returned as it is.
+func playerQuizContent(raw json.RawMessage) json.RawMessage {
+	quiz, upgraded, err := entity.ParseQuizContent(raw)
+	if err != nil || !upgraded || quiz.Validate() != nil {
+		return raw
+	}
+	data, err := json.Marshal(quiz)
+	if err != nil {
+		return raw
+	}
+	return data
+}
@@ -0,0 +1,77 @@
+package service
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// userRateLimiter is an in-memory sliding-window rate limiter keyed by user.
+// Limits apply per process, which is enough to guard expensive synchronous calls.
+type userRateLimiter struct {
+	mu     sync.Mutex
+	limit  int
+	window time.Duration
+	hits   map[uuid.UUID][]time.Time
+	// When keys without recent calls were last removed from hits
+	lastPrune time.Time
+}
+
+// newUserRateLimiter creates a limiter allowing limit calls per window for each user.
+func newUserRateLimiter(limit int, window time.Duration) *userRateLimiter {
+	return &userRateLimiter{
+		limit:  limit,
+		window: window,
+		hits:   make(map[uuid.UUID][]time.Time),
+	}
+}
+
+// Allow records a call for the user and reports whether it is within the limit.
+func (l *userRateLimiter) Allow(userID uuid.UUID) bool {
+	return l.AllowUpTo(userID, l.limit)
+}
+
+// AllowUpTo is Allow with a limit of its own, for keys such as API tokens that each
+// carry their own limit.
+func (l *userRateLimiter) AllowUpTo(userID uuid.UUID, limit int) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	cutoff := now.Add(-l.window)
+	if now.Sub(l.lastPrune) >= l.window {
+		l.prune(cutoff)
+		l.lastPrune = now
+	}
+
+	// Drop calls that have aged out of the window
+	recent := l.hits[userID][:0]
+	for _, t := range l.hits[userID] {
+		if t.After(cutoff) {
+			recent = append(recent, t)
+		}
+	}
+
+	if len(recent) >= limit {
+		if len(recent) == 0 {
+			delete(l.hits, userID)
+		} else {
+			l.hits[userID] = recent
+		}
+		return false
+	}
+
+	l.hits[userID] = append(recent, now)
+	return true
+}
+
+// prune removes the keys with no calls after cutoff, so users and tokens that stop
+// calling don't stay in memory. Calls are appended in order, so the last is the newest.
+func (l *userRateLimiter) prune(cutoff time.Time) {
+	for key, times := range l.hits {
+		if len(times) == 0 || !times[len(times)-1].After(cutoff) {
+			delete(l.hits, key)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestUserRateLimiterAllow(t *testing.T) {
+	l := newUserRateLimiter(3, time.Hour)
+	user, other := uuid.New(), uuid.New()
+
+	for i := range 3 {
+		if !l.Allow(user) {
+			t.Fatalf("call %d denied, want allowed", i+1)
+		}
+	}
+	if l.Allow(user) {
+		t.Error("call over the limit allowed")
+	}
+	if !l.Allow(other) {
+		t.Error("another user's call denied")
+	}
+	if !l.AllowUpTo(user, 5) {
+		t.Error("call within a higher limit denied")
+	}
+}
+
+func TestUserRateLimiterForgetsIdleKeys(t *testing.T) {
+	l := newUserRateLimiter(3, time.Minute)
+	idle, active := uuid.New(), uuid.New()
+
+	// Calls from before the window, and a key emptied by an earlier call
+	l.hits[idle] = []time.Time{time.Now().Add(-2 * time.Minute), time.Now().Add(-90 * time.Second)}
+	l.hits[uuid.New()] = nil
+	l.hits[active] = []time.Time{time.Now().Add(-time.Second)}
+
+	if !l.Allow(uuid.New()) {
+		t.Fatal("call denied")
+	}
+	if _, ok := l.hits[idle]; ok || len(l.hits) != 2 {
+		t.Errorf("limiter kept %d keys, want the active user and the caller", len(l.hits))
+	}
+	if len(l.hits[active]) != 1 {
+		t.Errorf("active user has %d recent calls, want 1", len(l.hits[active]))
+	}
+
+	// A denied caller with no recent calls isn't kept either
+	caller := uuid.New()
+	if l.AllowUpTo(caller, 0) {
+		t.Error("call allowed with a limit of 0")
+	}
+	if _, ok := l.hits[caller]; ok {
+		t.Error("denied caller without calls was kept")
+	}
+}
@@ -0,0 +1,337 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/tenant"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+	"github.com/sogos/mirai-backend/internal/infrastructure/cache"
+)
+
+// sampleFolderNames are the default library folders created for new tenants.
+var sampleFolderNames = []string{"Drafts", "Published", "Archive"}
+
+const (
+	sampleAudienceName = "New Team Members (Sample)"
+	sampleCourseTitle  = "Welcome to Mirai (Sample Course)"
+)
+
+// ProvisionSampleContent creates the onboarding folders, target audience and draft course
+// for a new tenant. Each item is skipped if its sample already exists, so it is safe to re-run.
+func (s *CourseService) ProvisionSampleContent(ctx context.Context, tenantID, companyID, userID uuid.UUID) error {
+	ctx = tenant.WithTenantID(ctx, tenantID)
+	log := s.logger.With("tenantID", tenantID)
+	defer s.InvalidateLibraryCache(ctx)
+
+	folders, err := s.folderRepo.ListByParent(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list root folders: %w", err)
+	}
+	existing := make(map[string]*entity.Folder)
+	for _, f := range folders {
+		if f.IsSample {
+			existing[f.Name] = f
+		}
+	}
+
+	for _, name := range sampleFolderNames {
+		if existing[name] != nil {
+			continue
+		}
+		folder := &entity.Folder{
+			TenantID: tenantID,
+			Name:     name,
+			Type:     entity.FolderTypeFolder,
+			IsSample: true,
+		}
+		if err := s.folderRepo.Create(ctx, folder); err != nil {
+			return fmt.Errorf("failed to create sample folder %q: %w", name, err)
+		}
+		existing[name] = folder
+	}
+
+	if s.audienceRepo != nil {
+		audiences, err := s.audienceRepo.List(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list target audiences: %w", err)
+		}
+		hasSample := false
+		for _, a := range audiences {
+			if a.IsSample {
+				hasSample = true
+				break
+			}
+		}
+		if !hasSample {
+			if err := s.audienceRepo.Create(ctx, sampleTargetAudience(tenantID, companyID, userID)); err != nil {
+				return fmt.Errorf("failed to create sample target audience: %w", err)
+			}
+		}
+	}
+
+	sampleCount, err := s.courseRepo.Count(ctx, entity.CourseListOptions{SampleOnly: true})
+	if err != nil {
+		return fmt.Errorf("failed to count sample courses: %w", err)
+	}
+	if sampleCount == 0 {
+		draftsID := existing["Drafts"].ID
+		if err := s.createSampleCourse(ctx, tenantID, companyID, userID, draftsID); err != nil {
+			return err
+		}
+	}
+
+	_ = s.cache.InvalidateNamespace(ctx, cache.NamespaceCourses)
+	_ = s.cache.InvalidateNamespace(ctx, cache.NamespaceFolderCourses)
+
+	log.Info("sample content provisioned")
+	return nil
+}
+
+// createSampleCourse writes the sample course content to storage and its metadata to the database.
+func (s *CourseService) createSampleCourse(ctx context.Context, tenantID, companyID, userID, folderID uuid.UUID) error {
+	courseID := uuid.New()
+	course := &entity.Course{
+		ID:              courseID,
+		TenantID:        tenantID,
+		CompanyID:       companyID,
+		CreatedByUserID: userID,
+		Title:           sampleCourseTitle,
+		Status:          entity.CourseStatusDraft,
+		Version:         1,
+		FolderID:        &folderID,
+		CategoryTags:    []string{"sample", "onboarding"},
+		ContentPath:     s.storage.CoursePath(tenantID, courseID),
+		IsSample:        true,
+	}
+
+	content := sampleCourseContent(folderID)
+	if size, err := s.storage.CourseContentSize(content); err == nil {
+		course.ContentSizeBytes = &size
+	}
+	if err := s.storage.WriteCourseContent(ctx, tenantID, courseID, content); err != nil {
+		return fmt.Errorf("failed to write sample course content: %w", err)
+	}
+
+	if err := s.courseRepo.Create(ctx, course); err != nil {
+		_ = s.storage.DeleteCourseContent(ctx, tenantID, courseID)
+		return fmt.Errorf("failed to create sample course: %w", err)
+	}
+	return nil
+}
+
+// RemoveSampleContentResult reports what RemoveSampleContent deleted.
+type RemoveSampleContentResult struct {
+	CoursesRemoved         int
+	FoldersRemoved         int
+	FoldersKept            int // Sample folders that now hold the user's own courses or folders
+	TargetAudiencesRemoved int
+}
+
+// RemoveSampleContent deletes all onboarding sample content for the caller's tenant.
+// Sample folders that now contain non-sample courses or subfolders are kept.
+func (s *CourseService) RemoveSampleContent(ctx context.Context, kratosID uuid.UUID) (*RemoveSampleContentResult, error) {
+	log := s.logger.With("kratosID", kratosID)
+
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, domainerrors.ErrUserNotFound
+	}
+
+	if !user.IsAdmin() {
+		return nil, domainerrors.ErrForbidden.WithMessage("only admins can remove sample content")
+	}
+
+	result := &RemoveSampleContentResult{}
+	defer s.InvalidateLibraryCache(ctx) // Removal can stop part way
+
+	courses, err := s.courseRepo.List(ctx, entity.CourseListOptions{SampleOnly: true})
+	if err != nil {
+		log.Error("failed to list sample courses", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	for _, course := range courses {
+		if err := s.courseRepo.Delete(ctx, course.ID); err != nil {
+			log.Error("failed to delete sample course", "courseID", course.ID, "error", err)
+			return nil, domainerrors.ErrInternal.WithCause(err)
+		}
+		if err := s.storage.DeleteCourseContent(ctx, course.TenantID, course.ID); err != nil {
+			log.Error("failed to delete sample course content", "courseID", course.ID, "error", err)
+		}
+		_ = s.cache.Delete(ctx, cache.TenantCacheKeys.Course(course.ID.String()))
+		result.CoursesRemoved++
+	}
+
+	folders, err := s.folderRepo.ListByParent(ctx, nil)
+	if err != nil {
+		log.Error("failed to list root folders", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	for _, folder := range folders {
+		if !folder.IsSample {
+			continue
+		}
+		count, err := s.courseRepo.CountByFolder(ctx, folder.ID)
+		if err != nil {
+			log.Error("failed to count courses in sample folder", "folderID", folder.ID, "error", err)
+			return nil, domainerrors.ErrInternal.WithCause(err)
+		}
+		children, err := s.folderRepo.ListByParent(ctx, &folder.ID)
+		if err != nil {
+			log.Error("failed to list sample folder children", "folderID", folder.ID, "error", err)
+			return nil, domainerrors.ErrInternal.WithCause(err)
+		}
+		if count > 0 || len(children) > 0 {
+			result.FoldersKept++
+			continue
+		}
+		if err := s.folderRepo.Delete(ctx, folder.ID); err != nil {
+			log.Error("failed to delete sample folder", "folderID", folder.ID, "error", err)
+			return nil, domainerrors.ErrInternal.WithCause(err)
+		}
+		result.FoldersRemoved++
+	}
+
+	if s.audienceRepo != nil {
+		audiences, err := s.audienceRepo.List(ctx)
+		if err != nil {
+			log.Error("failed to list target audiences", "error", err)
+			return nil, domainerrors.ErrInternal.WithCause(err)
+		}
+		for _, audience := range audiences {
+			if !audience.IsSample {
+				continue
+			}
+			if err := s.audienceRepo.Delete(ctx, audience.ID); err != nil {
+				log.Error("failed to delete sample target audience", "audienceID", audience.ID, "error", err)
+				return nil, domainerrors.ErrInternal.WithCause(err)
+			}
+			result.TargetAudiencesRemoved++
+		}
+	}
+
+	_ = s.cache.InvalidateNamespace(ctx, cache.NamespaceCourses)
+	_ = s.cache.InvalidateNamespace(ctx, cache.NamespaceFolderCourses)
+
+	log.Info("sample content removed",
+		"courses", result.CoursesRemoved,
+		"folders", result.FoldersRemoved,
+		"foldersKept", result.FoldersKept,
+		"targetAudiences", result.TargetAudiencesRemoved,
+	)
+	return result, nil
+}
+
+func sampleTargetAudience(tenantID, companyID, userID uuid.UUID) *entity.TargetAudienceTemplate {
+	background := "Recently joined the company and is learning its products, tools and processes."
+	return &entity.TargetAudienceTemplate{
+		TenantID:          tenantID,
+		CompanyID:         companyID,
+		Name:              sampleAudienceName,
+		Description:       "An example audience. Edit it to describe your own learners, or remove it with the sample content.",
+		Role:              "New Hire",
+		ExperienceLevel:   valueobject.ExperienceLevelBeginner,
+		LearningGoals:     []string{"Understand how the team works", "Get productive in the first month"},
+		Prerequisites:     []string{},
+		Challenges:        []string{"Lots of new information at once", "Not knowing who to ask"},
+		Motivations:       []string{"Make a good first impression", "Contribute quickly"},
+		TypicalBackground: &background,
+		Status:            valueobject.TargetAudienceStatusActive,
+		IsSample:          true,
+		CreatedByUserID:   userID,
+	}
+}
+
+// sampleCourseContent builds a small course showing sections, lessons and each block type.
+func sampleCourseContent(folderID uuid.UUID) *S3CourseContent {
+	objectiveID := uuid.NewString()
+	personaID := uuid.NewString()
+	lessonID := uuid.NewString()
+	alignment := map[string]any{
+		"personas":           []string{personaID},
+		"learningObjectives": []string{objectiveID},
+		"kpis":               []string{},
+	}
+
+	return &S3CourseContent{
+		Settings: CourseSettings{
+			Title:             sampleCourseTitle,
+			DesiredOutcome:    "Learners know how to build, review and publish a course in Mirai.",
+			DestinationFolder: folderID.String(),
+			CategoryTags:      []string{"sample", "onboarding"},
+			DataSource:        "open-web",
+		},
+		Personas: []map[string]any{{
+			"id":               personaID,
+			"name":             "Sam",
+			"role":             "New Hire",
+			"kpis":             "Time to first contribution",
+			"responsibilities": "Learning the team's tools and processes",
+		}},
+		LearningObjectives: []map[string]any{{
+			"id":   objectiveID,
+			"text": "Describe the steps for building a course in Mirai",
+		}},
+		AssessmentSettings: map[string]any{
+			"enableEmbeddedKnowledgeChecks": true,
+			"enableFinalExam":               false,
+		},
+		Content: CourseContent{
+			Sections: []map[string]any{
+				{
+					"id":   uuid.NewString(),
+					"name": "Getting Started",
+					"lessons": []map[string]any{
+						{"id": lessonID, "title": "How courses are built"},
+					},
+				},
+				{
+					"id":   uuid.NewString(),
+					"name": "Next Steps",
+					"lessons": []map[string]any{
+						{"id": uuid.NewString(), "title": "Make this course your own"},
+					},
+				},
+			},
+			CourseBlocks: []map[string]any{
+				{
+					"id":        uuid.NewString(),
+					"type":      "heading",
+					"content":   "How courses are built",
+					"order":     0,
+					"lessonId":  lessonID,
+					"alignment": alignment,
+				},
+				{
+					"id":        uuid.NewString(),
+					"type":      "text",
+					"content":   "Courses are organized into sections and lessons. Each lesson is made of blocks such as headings, text, interactive exercises and knowledge checks.",
+					"order":     1,
+					"lessonId":  lessonID,
+					"alignment": alignment,
+				},
+				{
+					"id":        uuid.NewString(),
+					"type":      "interactive",
+					"content":   "Try it: rename this lesson and reorder the blocks below.",
+					"prompt":    "Invite learners to practice editing a lesson",
+					"order":     2,
+					"lessonId":  lessonID,
+					"alignment": alignment,
+				},
+				{
+					"id":        uuid.NewString(),
+					"type":      "knowledgeCheck",
+					"content":   "What is a lesson made of? (a) Blocks (b) Folders (c) Teams",
+					"order":     3,
+					"lessonId":  lessonID,
+					"alignment": alignment,
+				},
+			},
+		},
+		Exports: []map[string]any{},
+	}
+}
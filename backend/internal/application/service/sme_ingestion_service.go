@@ -26,6 +26,13 @@ type SMEIngestionService struct {
 	storage           ContentStorage
 	aiProviderFactory AIProviderFactory
 	notifier          NotificationSender
+	topicRecluster    TopicReclusterEnqueuer
+	injectionDetector service.PromptInjectionDetector
+	archiveStorage    KnowledgeArchiveStorage
+	exportArtifacts   ExportArtifactRecorder
+	summaryRefit      KnowledgeSummaryRefitEnqueuer
+	alertEmail        service.EmailProvider
+	progress          SubmissionProgressPublisher
 	logger            service.Logger
 }
 
@@ -87,13 +94,13 @@ func (s *SMEIngestionService) ProcessNextJob(ctx context.Context) (bool, error)
 		return false, nil
 	}
 
-	// Only process SME ingestion jobs
-	if job.Type != valueobject.GenerationJobTypeSMEIngestion {
+	// Only process SME jobs
+	if !isSMEJobType(job.Type) {
 		return false, nil
 	}
 
 	// Process the job
-	if err := s.processIngestionJob(ctx, job); err != nil {
+	if err := s.processJob(ctx, job); err != nil {
 		s.logger.Error("failed to process ingestion job", "jobID", job.ID, "error", err)
 		return true, err
 	}
@@ -129,14 +136,33 @@ func (s *SMEIngestionService) ProcessJobByID(ctx context.Context, jobID string)
 		return nil
 	}
 
-	// Only process SME ingestion jobs
-	if job.Type != valueobject.GenerationJobTypeSMEIngestion {
-		log.Info("job is not SME ingestion type, skipping", "type", job.Type)
+	// Only process SME jobs
+	if !isSMEJobType(job.Type) {
+		log.Info("job is not an SME job type, skipping", "type", job.Type)
 		return nil
 	}
 
 	// Process the job
-	return s.processIngestionJob(ctx, job)
+	return s.processJob(ctx, job)
+}
+
+// isSMEJobType reports whether jobs of the type are processed by this service.
+func isSMEJobType(t valueobject.GenerationJobType) bool {
+	switch t {
+	case valueobject.GenerationJobTypeSMEIngestion,
+		valueobject.GenerationJobTypeSMEKnowledgeExport,
+		valueobject.GenerationJobTypeSMEKnowledgeImport:
+		return true
+	}
+	return false
+}
+
+// processJob processes an SME job according to its type.
+func (s *SMEIngestionService) processJob(ctx context.Context, job *entity.GenerationJob) error {
+	if job.Type == valueobject.GenerationJobTypeSMEIngestion {
+		return s.processIngestionJob(ctx, job)
+	}
+	return s.processKnowledgeArchiveJob(ctx, job)
 }
 
 // processIngestionJob processes a single SME content ingestion job.
@@ -175,11 +201,7 @@ func (s *SMEIngestionService) processIngestionJob(ctx context.Context, job *enti
 		return s.failJob(ctx, job, "SME not found")
 	}
 
-	// Update progress
-	job.ProgressPercent = 10
-	progressMsg = "Extracting content..."
-	job.ProgressMessage = &progressMsg
-	_ = s.jobRepo.Update(ctx, job)
+	s.setProgress(ctx, job, submission, task, 10, "Upload verified, extracting text...")
 
 	// Check if text is already extracted (e.g., for text submissions)
 	var extractedText string
@@ -209,19 +231,23 @@ func (s *SMEIngestionService) processIngestionJob(ctx context.Context, job *enti
 		}
 	}
 
-	// Update progress
-	job.ProgressPercent = 30
-	progressMsg = "Processing with AI..."
-	job.ProgressMessage = &progressMsg
-	_ = s.jobRepo.Update(ctx, job)
+	s.setProgress(ctx, job, submission, task, 25, "Text extracted")
 
 	// Get tenant-specific AI provider
 	aiProvider, err := s.aiProviderFactory.GetProvider(ctx, job.TenantID)
 	if err != nil {
 		log.Error("failed to get AI provider", "error", err)
-		return s.failJob(ctx, job, fmt.Sprintf("failed to get AI provider: %v", err))
+		return s.failJobWithError(ctx, job, "failed to get AI provider", err)
+	}
+
+	// Worker is draining - hand the job back before the provider call
+	if ctx.Err() != nil {
+		return s.requeueInterruptedJob(ctx, job)
 	}
 
+	// One provider call splits the text into chunks and summarizes them
+	s.setProgress(ctx, job, submission, task, 40, "Chunking content...")
+
 	// Process with AI
 	result, err := aiProvider.ProcessSMEContent(ctx, service.ProcessSMEContentRequest{
 		SMEName:       sme.Name,
@@ -229,17 +255,21 @@ func (s *SMEIngestionService) processIngestionJob(ctx context.Context, job *enti
 		ExtractedText: extractedText,
 	})
 	if err != nil {
+		if ctx.Err() != nil {
+			log.Info("ingestion interrupted by worker shutdown")
+			return s.requeueInterruptedJob(ctx, job)
+		}
 		log.Error("AI processing failed", "error", err)
-		return s.failJob(ctx, job, fmt.Sprintf("AI processing failed: %v", err))
+		return s.failJobWithError(ctx, job, "AI processing failed", err)
 	}
 
+	// The provider call has been paid for; persist the result even if a drain starts now
+	ctx = context.WithoutCancel(ctx)
+
 	job.TokensUsed = result.TokensUsed
+	job.RepairAttempts += int32(result.RepairAttempts)
 
-	// Update progress
-	job.ProgressPercent = 70
-	progressMsg = "Storing knowledge..."
-	job.ProgressMessage = &progressMsg
-	_ = s.jobRepo.Update(ctx, job)
+	s.setProgress(ctx, job, submission, task, 80, "Summarizing knowledge...")
 
 	// Update submission with AI summary
 	submission.AISummary = &result.Summary
@@ -249,7 +279,10 @@ func (s *SMEIngestionService) processIngestionJob(ctx context.Context, job *enti
 		log.Warn("failed to save AI summary", "error", err)
 	}
 
-	// Create knowledge chunks
+	// Create knowledge chunks, filing them under the SME's existing topics where they match
+	// and holding back any that look like prompt injection attempts
+	topics := s.newTopicIndex(ctx, sme.ID)
+	flaggedChunks := 0
 	for _, chunkResult := range result.Chunks {
 		chunk := &entity.SMEKnowledgeChunk{
 			ID:             uuid.New(),
@@ -257,19 +290,28 @@ func (s *SMEIngestionService) processIngestionJob(ctx context.Context, job *enti
 			SMEID:          sme.ID,
 			SubmissionID:   &submission.ID,
 			Content:        chunkResult.Content,
-			Topic:          chunkResult.Topic,
+			Topic:          topics.label(chunkResult.Topic),
 			Keywords:       chunkResult.Keywords,
 			RelevanceScore: chunkResult.RelevanceScore,
 			CreatedAt:      time.Now(),
 		}
+		if s.screenChunk(chunk) {
+			log.Warn("knowledge chunk flagged as possible prompt injection", "reason", *chunk.InjectionReason)
+		}
 
 		if err := s.knowledgeRepo.Create(ctx, chunk); err != nil {
 			log.Warn("failed to create knowledge chunk", "error", err)
+			continue
+		}
+		if chunk.InjectionFlagged {
+			flaggedChunks++
 		}
 	}
 
-	// Update SME with aggregated knowledge summary
-	if err := s.updateSMEKnowledge(ctx, sme, result.Summary); err != nil {
+	// Update SME with aggregated knowledge summary, which is also sent to generation
+	if verdict := s.detectInjection(result.Summary); verdict.Flagged {
+		log.Warn("submission summary flagged as possible prompt injection, not added to SME knowledge", "reason", verdict.Reason)
+	} else if err := s.updateSMEKnowledge(ctx, job, sme, result.Summary); err != nil {
 		log.Warn("failed to update SME knowledge", "error", err)
 	}
 
@@ -299,11 +341,14 @@ func (s *SMEIngestionService) processIngestionJob(ctx context.Context, job *enti
 	if err := s.jobRepo.Update(ctx, job); err != nil {
 		log.Error("failed to mark job as completed", "error", err)
 	}
+	s.publishProgress(ctx, job, submission, task)
 
 	// Send notification
-	s.sendCompletionNotification(ctx, job, sme, task)
+	s.sendCompletionNotification(ctx, job, sme, task, flaggedChunks)
 
-	log.Info("ingestion completed", "tokensUsed", result.TokensUsed, "chunksCreated", len(result.Chunks))
+	s.enqueueTopicReclusterIfNeeded(sme, len(topics))
+
+	log.Info("ingestion completed", "tokensUsed", result.TokensUsed, "chunksCreated", len(result.Chunks), "chunksFlagged", flaggedChunks)
 	return nil
 }
 
@@ -366,35 +411,45 @@ func (s *SMEIngestionService) extractPDFText(content []byte) (string, error) {
 	return "", fmt.Errorf("PDF text extraction requires additional implementation")
 }
 
-// updateSMEKnowledge updates the SME's aggregated knowledge summary.
-func (s *SMEIngestionService) updateSMEKnowledge(ctx context.Context, sme *entity.SubjectMatterExpert, newSummary string) error {
+// updateSMEKnowledge updates the SME's aggregated knowledge summary, re-summarizing it
+// when the new summary takes it over the cap. Tokens spent doing so go on the job.
+func (s *SMEIngestionService) updateSMEKnowledge(ctx context.Context, job *entity.GenerationJob, sme *entity.SubjectMatterExpert, newSummary string) error {
 	// For MVP, just append the new summary
-	if sme.KnowledgeSummary == nil {
-		sme.KnowledgeSummary = &newSummary
-	} else {
-		combined := *sme.KnowledgeSummary + "\n\n---\n\n" + newSummary
-		sme.KnowledgeSummary = &combined
+	combined := newSummary
+	if sme.KnowledgeSummary != nil {
+		combined = *sme.KnowledgeSummary + "\n\n---\n\n" + newSummary
 	}
+	fitted, tokensUsed := s.fitKnowledgeSummary(ctx, sme, combined)
+	job.TokensUsed += tokensUsed
+	sme.KnowledgeSummary = &fitted
 
 	sme.UpdatedAt = time.Now()
 	return s.smeRepo.Update(ctx, sme)
 }
 
-// sendCompletionNotification sends notifications when ingestion completes.
-func (s *SMEIngestionService) sendCompletionNotification(ctx context.Context, job *entity.GenerationJob, sme *entity.SubjectMatterExpert, task *entity.SMETask) {
+// sendCompletionNotification sends notifications when ingestion completes, warning the
+// assigner when chunks were held back from generation for review.
+func (s *SMEIngestionService) sendCompletionNotification(ctx context.Context, job *entity.GenerationJob, sme *entity.SubjectMatterExpert, task *entity.SMETask, flaggedChunks int) {
 	if s.notifier == nil {
 		return
 	}
 
+	message := fmt.Sprintf("Content for '%s' has been processed and added to %s.", task.Title, sme.Name)
+	priority := valueobject.NotificationPriorityNormal
+	if flaggedChunks > 0 {
+		message += fmt.Sprintf(" %d knowledge chunk(s) were flagged as possible prompt injection and will be left out of generation until an admin reviews them.", flaggedChunks)
+		priority = valueobject.NotificationPriorityHigh
+	}
+
 	// Create in-app notification
 	notification := &entity.Notification{
 		ID:       uuid.New(),
 		TenantID: job.TenantID,
 		UserID:   task.AssignedByUserID,
 		Type:     valueobject.NotificationTypeIngestionComplete,
-		Priority: valueobject.NotificationPriorityNormal,
+		Priority: priority,
 		Title:    "Content Ingestion Complete",
-		Message:  fmt.Sprintf("Content for '%s' has been processed and added to %s.", task.Title, sme.Name),
+		Message:  message,
 		SMEID:    &sme.ID,
 		TaskID:   &task.ID,
 		Read:     false,
@@ -408,8 +463,19 @@ func (s *SMEIngestionService) sendCompletionNotification(ctx context.Context, jo
 
 // failJob marks a job as failed with an error message.
 func (s *SMEIngestionService) failJob(ctx context.Context, job *entity.GenerationJob, errMsg string) error {
+	return s.failJobWithReason(ctx, job, valueobject.JobFailureInternal, errMsg)
+}
+
+// failJobWithError fails a job with err, classified into a failure reason, prefixed by what failed.
+func (s *SMEIngestionService) failJobWithError(ctx context.Context, job *entity.GenerationJob, prefix string, err error) error {
+	return s.failJobWithReason(ctx, job, classifyJobFailure(err), fmt.Sprintf("%s: %v", prefix, err))
+}
+
+// failJobWithReason marks a job as failed, or re-queues it while it has retries left.
+func (s *SMEIngestionService) failJobWithReason(ctx context.Context, job *entity.GenerationJob, reason valueobject.JobFailureReason, errMsg string) error {
 	job.Status = valueobject.GenerationJobStatusFailed
 	job.ErrorMessage = &errMsg
+	job.FailureReason = &reason
 	now := time.Now()
 	job.CompletedAt = &now
 
@@ -419,6 +485,7 @@ func (s *SMEIngestionService) failJob(ctx context.Context, job *entity.Generatio
 		job.Status = valueobject.GenerationJobStatusQueued
 		job.StartedAt = nil
 		job.CompletedAt = nil
+		job.FailureReason = nil
 		retryMsg := fmt.Sprintf("Retry %d/%d: %s", job.RetryCount, job.MaxRetries, errMsg)
 		job.ProgressMessage = &retryMsg
 		s.logger.Info("retrying failed job", "jobID", job.ID, "retry", job.RetryCount)
@@ -428,29 +495,88 @@ func (s *SMEIngestionService) failJob(ctx context.Context, job *entity.Generatio
 		s.logger.Error("failed to update job status", "jobID", job.ID, "error", err)
 	}
 
-	// If final failure, send notification
+	// If final failure, record it on the submission and send notification
 	if job.Status == valueobject.GenerationJobStatusFailed {
+		s.recordSubmissionFailure(ctx, job, errMsg)
 		s.sendFailureNotification(ctx, job, errMsg)
 	}
+	s.publishProgress(ctx, job, nil, nil)
 
 	return fmt.Errorf("%s", errMsg)
 }
 
+// recordSubmissionFailure stores the ingestion error on the submission and fails its task,
+// so the submitter can fix the file and reprocess it.
+func (s *SMEIngestionService) recordSubmissionFailure(ctx context.Context, job *entity.GenerationJob, errMsg string) {
+	if job.SubmissionID == nil {
+		return
+	}
+
+	submission, err := s.submissionRepo.GetByID(ctx, *job.SubmissionID)
+	if err != nil || submission == nil {
+		s.logger.Warn("failed to load submission to record ingestion error", "submissionID", job.SubmissionID, "error", err)
+		return
+	}
+
+	submission.IngestionError = &errMsg
+	if err := s.submissionRepo.Update(ctx, submission); err != nil {
+		s.logger.Warn("failed to record ingestion error", "submissionID", submission.ID, "error", err)
+	}
+
+	task, err := s.taskRepo.GetByID(ctx, submission.TaskID)
+	if err != nil || task == nil {
+		return
+	}
+	task.Status = valueobject.SMETaskStatusFailed
+	if err := s.taskRepo.Update(ctx, task); err != nil {
+		s.logger.Warn("failed to mark task as failed", "taskID", task.ID, "error", err)
+	}
+}
+
+// requeueInterruptedJob checkpoints an ingestion job whose worker is shutting down.
+// The job returns to 'queued' with progress preserved; the 5s ingestion poll on the
+// next worker picks it up without waiting for the stale sweep.
+func (s *SMEIngestionService) requeueInterruptedJob(ctx context.Context, job *entity.GenerationJob) error {
+	// The worker context is already cancelled; persist the checkpoint with a detached one
+	ctx = context.WithoutCancel(ctx)
+
+	job.Status = valueobject.GenerationJobStatusQueued
+	job.StartedAt = nil
+	msg := interruptedJobMessage
+	job.ProgressMessage = &msg
+	if err := s.jobRepo.Update(ctx, job); err != nil {
+		s.logger.Error("failed to checkpoint interrupted job", "jobID", job.ID, "error", err)
+		return err
+	}
+
+	s.logger.Info("ingestion job re-queued due to worker shutdown", "jobID", job.ID, "progress", job.ProgressPercent)
+	return nil
+}
+
 // sendFailureNotification sends notifications when ingestion fails.
 func (s *SMEIngestionService) sendFailureNotification(ctx context.Context, job *entity.GenerationJob, errMsg string) {
 	if s.notifier == nil {
 		return
 	}
 
+	title, operation := "Content Ingestion Failed", "Content ingestion"
+	switch job.Type {
+	case valueobject.GenerationJobTypeSMEKnowledgeExport:
+		title, operation = "Knowledge Export Failed", "Knowledge export"
+	case valueobject.GenerationJobTypeSMEKnowledgeImport:
+		title, operation = "Knowledge Import Failed", "Knowledge import"
+	}
+
 	notification := &entity.Notification{
 		ID:       uuid.New(),
 		TenantID: job.TenantID,
 		UserID:   job.CreatedByUserID,
 		Type:     valueobject.NotificationTypeIngestionFailed,
 		Priority: valueobject.NotificationPriorityHigh,
-		Title:    "Content Ingestion Failed",
-		Message:  fmt.Sprintf("Content ingestion failed: %s", errMsg),
+		Title:    title,
+		Message:  fmt.Sprintf("%s failed: %s", operation, errMsg),
 		JobID:    &job.ID,
+		SMEID:    job.SMEID,
 		Read:     false,
 		CreatedAt: time.Now(),
 	}
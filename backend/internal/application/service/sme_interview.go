@@ -0,0 +1,222 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+	"github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+// Interview task limits. Questions are generated inside the request, like title suggestions.
+const (
+	minInterviewQuestions    = 8
+	maxInterviewQuestions    = 12
+	maxTaskQuestions         = 20 // Managers may add their own to a generated set
+	maxTaskQuestionChars     = 500
+	maxInterviewOutcomeChars = 2000
+	maxInterviewKnownTopics  = 20
+)
+
+// SetAIProvider enables generating interview questions with the tenant's AI provider.
+func (s *SMEService) SetAIProvider(factory AIProviderFactory, aiSettingsRepo repository.TenantAISettingsRepository) {
+	s.aiProviderFactory = factory
+	s.aiSettingsRepo = aiSettingsRepo
+	s.questionLimiter = newUserRateLimiter(suggestionRateLimit, suggestionRateWindow)
+}
+
+// GenerateTaskQuestionsResult contains generated interview questions.
+type GenerateTaskQuestionsResult struct {
+	Questions  []string
+	TokensUsed int64
+}
+
+// GenerateTaskQuestions writes interview questions for an SME about a course goal.
+// Nothing is saved; the client edits the questions and sends them with CreateTask.
+func (s *SMEService) GenerateTaskQuestions(ctx context.Context, kratosID uuid.UUID, smeID uuid.UUID, desiredOutcome string) (*GenerateTaskQuestionsResult, error) {
+	log := s.logger.With("kratosID", kratosID, "smeID", smeID)
+
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, domainerrors.ErrUserNotFound
+	}
+
+	if !user.CanManageSME() {
+		return nil, domainerrors.ErrForbidden.WithMessage("insufficient permissions to create tasks")
+	}
+
+	if user.TenantID == nil {
+		return nil, domainerrors.ErrUserHasNoCompany
+	}
+
+	sme, err := s.smeRepo.GetByID(ctx, smeID)
+	if err != nil || sme == nil {
+		return nil, domainerrors.ErrSMENotFound
+	}
+	if !s.userHasSMEAccess(ctx, user, sme) {
+		return nil, domainerrors.ErrSMENoAccess
+	}
+
+	desiredOutcome = strings.TrimSpace(desiredOutcome)
+	if desiredOutcome == "" {
+		return nil, domainerrors.ErrMissingRequired.WithMessage("describe what learners of the course should achieve")
+	}
+	if utf8.RuneCountInString(desiredOutcome) > maxInterviewOutcomeChars {
+		return nil, domainerrors.ErrInvalidInput.WithMessage("course outcome is too long")
+	}
+
+	if s.aiProviderFactory == nil {
+		return nil, domainerrors.ErrAIProviderNotConfigured
+	}
+
+	if !s.questionLimiter.Allow(user.ID) {
+		return nil, domainerrors.ErrRateLimited.WithMessage("too many question sets - please wait a moment and try again")
+	}
+
+	if err := checkAIGenerationEnabled(ctx, s.aiSettingsRepo, *user.TenantID); err != nil {
+		return nil, err
+	}
+	if err := checkTenantTokenBudget(ctx, s.aiSettingsRepo, *user.TenantID); err != nil {
+		return nil, err
+	}
+
+	aiProvider, err := s.aiProviderFactory.GetProvider(ctx, *user.TenantID)
+	if err != nil {
+		log.Error("failed to get AI provider", "error", err)
+		if domainerrors.IsDomainError(err) {
+			return nil, err
+		}
+		return nil, domainerrors.ErrExternalService.WithCause(err)
+	}
+
+	// Known topics steer the questions towards gaps; they're optional
+	var knownTopics []string
+	if topics, err := s.knowledgeRepo.ListTopics(ctx, smeID); err != nil {
+		log.Warn("failed to list knowledge topics", "error", err)
+	} else {
+		for _, topic := range topics[:min(len(topics), maxInterviewKnownTopics)] {
+			knownTopics = append(knownTopics, topic.Topic)
+		}
+	}
+
+	questionCtx, cancel := context.WithTimeout(ctx, suggestionTimeout)
+	defer cancel()
+
+	result, err := aiProvider.GenerateInterviewQuestions(questionCtx, service.GenerateInterviewQuestionsRequest{
+		SMEName:        sme.Name,
+		SMEDomain:      sme.Domain,
+		SMEDescription: sme.Description,
+		DesiredOutcome: desiredOutcome,
+		KnownTopics:    knownTopics,
+		MinQuestions:   minInterviewQuestions,
+		MaxQuestions:   maxInterviewQuestions,
+	})
+	if err != nil {
+		if errors.Is(questionCtx.Err(), context.DeadlineExceeded) {
+			log.Warn("interview questions timed out", "timeout", suggestionTimeout)
+			return nil, domainerrors.ErrSuggestionTimeout
+		}
+		log.Error("interview question generation failed", "error", err)
+		return nil, domainerrors.ErrExternalService.WithCause(err)
+	}
+
+	// Tokens were spent even if the questions turn out unusable
+	if err := s.aiSettingsRepo.IncrementTokenUsage(ctx, *user.TenantID, result.TokensUsed); err != nil {
+		log.Warn("failed to record token usage", "error", err)
+	}
+
+	questions := make([]string, 0, maxInterviewQuestions)
+	for _, q := range result.Questions {
+		if q = capTaskQuestion(q); q != "" && len(questions) < maxInterviewQuestions {
+			questions = append(questions, q)
+		}
+	}
+	if len(questions) < minInterviewQuestions {
+		log.Warn("too few interview questions generated", "count", len(questions))
+		return nil, domainerrors.ErrExternalService.WithMessage("AI returned too few questions - please try again")
+	}
+
+	log.Info("interview questions generated", "count", len(questions), "tokensUsed", result.TokensUsed)
+
+	return &GenerateTaskQuestionsResult{
+		Questions:  questions,
+		TokensUsed: result.TokensUsed,
+	}, nil
+}
+
+// SubmitAnswers records an SME's answers to a task's interview questions as a text
+// submission, which is reviewed and ingested like any other. answers must line up with
+// the task's questions; blank answers are left out.
+func (s *SMEService) SubmitAnswers(ctx context.Context, kratosID uuid.UUID, taskID uuid.UUID, answers []string) (*entity.SMETaskSubmission, error) {
+	task, err := s.taskRepo.GetByID(ctx, taskID)
+	if err != nil || task == nil {
+		return nil, domainerrors.ErrSMETaskNotFound
+	}
+
+	if len(task.Questions) == 0 {
+		return nil, domainerrors.ErrInvalidInput.WithMessage("this task has no interview questions")
+	}
+	if len(answers) != len(task.Questions) {
+		return nil, domainerrors.ErrInvalidInput.WithMessage("send one answer per question; leave an answer blank to skip it")
+	}
+
+	var sb strings.Builder
+	sb.WriteString("# " + task.Title + "\n")
+	answered := 0
+	for i, question := range task.Questions {
+		answer := strings.TrimSpace(answers[i])
+		if answer == "" {
+			continue
+		}
+		answered++
+		sb.WriteString("\n## " + question + "\n\n")
+		sb.WriteString(answer + "\n")
+	}
+	if answered == 0 {
+		return nil, domainerrors.ErrMissingRequired.WithMessage("answer at least one question")
+	}
+
+	text := sb.String()
+	return s.SubmitContent(ctx, kratosID, SubmitContentRequest{
+		TaskID:      taskID,
+		FileName:    "interview-answers.md",
+		ContentType: valueobject.ContentTypeText,
+		TextContent: &text,
+	})
+}
+
+// normalizeTaskQuestions trims questions and drops blank ones, rejecting sets that are
+// too long to send to an SME.
+func normalizeTaskQuestions(questions []string) ([]string, error) {
+	normalized := make([]string, 0, len(questions))
+	for _, q := range questions {
+		q = strings.TrimSpace(q)
+		if q == "" {
+			continue
+		}
+		if utf8.RuneCountInString(q) > maxTaskQuestionChars {
+			return nil, domainerrors.ErrInvalidInput.WithMessage(fmt.Sprintf("interview questions must be at most %d characters", maxTaskQuestionChars))
+		}
+		normalized = append(normalized, q)
+	}
+	if len(normalized) > maxTaskQuestions {
+		return nil, domainerrors.ErrInvalidInput.WithMessage(fmt.Sprintf("a task can have at most %d interview questions", maxTaskQuestions))
+	}
+	return normalized, nil
+}
+
+// capTaskQuestion trims a generated question and shortens it to maxTaskQuestionChars.
+func capTaskQuestion(question string) string {
+	question = strings.TrimSpace(question)
+	if utf8.RuneCountInString(question) > maxTaskQuestionChars {
+		question = strings.TrimSpace(string([]rune(question)[:maxTaskQuestionChars]))
+	}
+	return question
+}
@@ -0,0 +1,962 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/domain/tenant"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+// Knowledge archives are JSON Lines files: a header record with the SME's profile and
+// knowledge summary, then one record per source submission, then one per chunk.
+const (
+	knowledgeArchiveFormat  = "mirai-sme-knowledge"
+	knowledgeArchiveVersion = 1
+
+	knowledgeArchiveRecordHeader     = "header"
+	knowledgeArchiveRecordSubmission = "submission"
+	knowledgeArchiveRecordChunk      = "chunk"
+
+	// knowledgeArchiveURLExpiry is how long the download link sent for an export stays valid.
+	knowledgeArchiveURLExpiry = 7 * 24 * time.Hour
+
+	// knowledgeImportUploadExpiry is how long the presigned upload URL for an import stays valid.
+	knowledgeImportUploadExpiry = 15 * time.Minute
+
+	// knowledgeImportPrefix is the tenant-relative folder archives are uploaded to for import.
+	knowledgeImportPrefix = "sme/imports/"
+
+	// Import limits. Exports aren't limited, so an archive from an SME beyond these can't
+	// be imported in one piece.
+	knowledgeArchiveMaxBytes       = 256 << 20
+	knowledgeArchiveMaxLineBytes   = 1 << 20
+	knowledgeArchiveMaxChunks      = 50000
+	knowledgeArchiveMaxChunkLength = 20000 // Characters of chunk content
+	knowledgeArchiveMaxKeywords    = 50
+
+	// knowledgeArchiveProgressEvery is how many chunks are written or imported between progress writes.
+	knowledgeArchiveProgressEvery = 250
+
+	// knowledgeSummaryInputLimit bounds the chunk text sent to the provider when an
+	// import regenerates the SME's knowledge summary.
+	knowledgeSummaryInputLimit = 200000
+)
+
+var (
+	// errKnowledgeArchiveCancelled stops an archive job once it is no longer processing.
+	errKnowledgeArchiveCancelled = errors.New("knowledge archive job cancelled")
+
+	errKnowledgeArchiveTooLarge = fmt.Errorf("archive is larger than %d MB", knowledgeArchiveMaxBytes>>20)
+)
+
+// KnowledgeArchiveJobCreator creates background jobs that export and import SME knowledge.
+type KnowledgeArchiveJobCreator interface {
+	CreateKnowledgeExportJob(ctx context.Context, tenantID, smeID, userID uuid.UUID) (*entity.GenerationJob, error)
+	CreateKnowledgeImportJob(ctx context.Context, tenantID, smeID, userID uuid.UUID, archivePath string) (*entity.GenerationJob, error)
+}
+
+// KnowledgeArchiveStorage reads and writes knowledge archives in tenant storage.
+type KnowledgeArchiveStorage interface {
+	BuildPath(tenantID uuid.UUID, subpath string) string
+	OpenContent(ctx context.Context, tenantID uuid.UUID, subpath string) (io.ReadCloser, error)
+	StreamContent(ctx context.Context, tenantID uuid.UUID, subpath, contentType string, write func(w io.Writer) error) (int64, error)
+	GenerateDownloadURL(ctx context.Context, tenantID uuid.UUID, subpath string, expiry time.Duration) (string, error)
+}
+
+// SetKnowledgeArchiveJobCreator enables knowledge export and import, which run on the
+// ingestion service.
+func (s *SMEService) SetKnowledgeArchiveJobCreator(creator KnowledgeArchiveJobCreator) {
+	s.knowledgeArchives = creator
+}
+
+// SetKnowledgeArchiveStorage enables processing of knowledge export and import jobs.
+func (s *SMEIngestionService) SetKnowledgeArchiveStorage(storage KnowledgeArchiveStorage) {
+	s.archiveStorage = storage
+}
+
+// SetExportArtifactRecorder enables retention of knowledge export files: each is recorded
+// with an expiry and supersedes the SME's previous knowledge export.
+func (s *SMEIngestionService) SetExportArtifactRecorder(recorder ExportArtifactRecorder) {
+	s.exportArtifacts = recorder
+}
+
+// ExportSMEKnowledge queues a job that writes the SME's profile, knowledge summary,
+// chunks and the submissions they came from to an archive in tenant storage.
+func (s *SMEService) ExportSMEKnowledge(ctx context.Context, kratosID uuid.UUID, smeID uuid.UUID) (*entity.GenerationJob, error) {
+	if s.knowledgeArchives == nil {
+		return nil, domainerrors.ErrInternal.WithMessage("knowledge export is not configured")
+	}
+
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, domainerrors.ErrUserNotFound
+	}
+	if !user.CanManageSME() {
+		return nil, domainerrors.ErrForbidden.WithMessage("insufficient permissions to export SME knowledge")
+	}
+	if user.TenantID == nil {
+		return nil, domainerrors.ErrUserHasNoCompany
+	}
+
+	sme, err := s.smeRepo.GetByID(ctx, smeID)
+	if err != nil || sme == nil {
+		return nil, domainerrors.ErrSMENotFound
+	}
+	if !s.userHasSMEAccess(ctx, user, sme) {
+		return nil, domainerrors.ErrSMENoAccess
+	}
+
+	return s.knowledgeArchives.CreateKnowledgeExportJob(ctx, *user.TenantID, sme.ID, user.ID)
+}
+
+// GetKnowledgeImportUploadURL returns a presigned URL for uploading an archive to
+// import, and the path to pass to ImportSMEKnowledge once the upload finishes.
+func (s *SMEService) GetKnowledgeImportUploadURL(ctx context.Context, kratosID uuid.UUID) (string, string, error) {
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return "", "", domainerrors.ErrUserNotFound
+	}
+	if !user.CanManageSME() {
+		return "", "", domainerrors.ErrForbidden.WithMessage("insufficient permissions to import SME knowledge")
+	}
+	if user.TenantID == nil {
+		return "", "", domainerrors.ErrUserHasNoCompany
+	}
+
+	archivePath := knowledgeImportPrefix + uuid.New().String() + "/archive.jsonl"
+	url, err := s.storage.GenerateUploadURL(ctx, *user.TenantID, archivePath, knowledgeImportUploadExpiry)
+	if err != nil {
+		s.logger.Error("failed to generate import upload URL", "error", err)
+		return "", "", domainerrors.ErrInternal.WithCause(err)
+	}
+
+	return url, archivePath, nil
+}
+
+// ImportSMEKnowledgeRequest contains the parameters for importing a knowledge archive.
+type ImportSMEKnowledgeRequest struct {
+	ArchivePath string     // Path from GetKnowledgeImportUploadURL
+	TargetSMEID *uuid.UUID // Import into this SME instead of creating one from the archive
+}
+
+// ImportSMEKnowledge checks the uploaded archive's header and queues a job that imports
+// its chunks. Without a target SME, a new one is created from the archive's profile and
+// stays ingesting until the job finishes.
+func (s *SMEService) ImportSMEKnowledge(ctx context.Context, kratosID uuid.UUID, req ImportSMEKnowledgeRequest) (*entity.SubjectMatterExpert, *entity.GenerationJob, error) {
+	log := s.logger.With("kratosID", kratosID, "archivePath", req.ArchivePath)
+
+	if s.knowledgeArchives == nil {
+		return nil, nil, domainerrors.ErrInternal.WithMessage("knowledge import is not configured")
+	}
+
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, nil, domainerrors.ErrUserNotFound
+	}
+	if !user.CanManageSME() {
+		return nil, nil, domainerrors.ErrForbidden.WithMessage("insufficient permissions to import SME knowledge")
+	}
+	if user.TenantID == nil || user.CompanyID == nil {
+		return nil, nil, domainerrors.ErrUserHasNoCompany
+	}
+
+	if !isKnowledgeImportPath(req.ArchivePath) {
+		return nil, nil, domainerrors.ErrInvalidInput.WithMessage("archive must be uploaded with GetKnowledgeImportUploadURL")
+	}
+
+	var sme *entity.SubjectMatterExpert
+	if req.TargetSMEID != nil {
+		sme, err = s.smeRepo.GetByID(ctx, *req.TargetSMEID)
+		if err != nil || sme == nil {
+			return nil, nil, domainerrors.ErrSMENotFound
+		}
+		if !s.userHasSMEAccess(ctx, user, sme) {
+			return nil, nil, domainerrors.ErrSMENoAccess
+		}
+	}
+
+	header, err := s.readKnowledgeArchiveHeader(ctx, *user.TenantID, req.ArchivePath)
+	if err != nil {
+		return nil, nil, err
+	}
+	s.tagUpload(ctx, *user.TenantID, req.ArchivePath)
+
+	if sme == nil {
+		sme = &entity.SubjectMatterExpert{
+			TenantID:        *user.TenantID,
+			CompanyID:       *user.CompanyID,
+			Name:            header.SME.Name,
+			Description:     header.SME.Description,
+			Domain:          header.SME.Domain,
+			Scope:           valueobject.SMEScopeGlobal,
+			Status:          valueobject.SMEStatusIngesting,
+			CreatedByUserID: user.ID,
+		}
+		if err := s.smeRepo.Create(ctx, sme); err != nil {
+			log.Error("failed to create SME for import", "error", err)
+			return nil, nil, domainerrors.ErrInternal.WithCause(err)
+		}
+	}
+
+	job, err := s.knowledgeArchives.CreateKnowledgeImportJob(ctx, *user.TenantID, sme.ID, user.ID, req.ArchivePath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	log.Info("knowledge import queued", "smeID", sme.ID, "jobID", job.ID, "archiveChunks", header.ChunkCount)
+	return sme, job, nil
+}
+
+// isKnowledgeImportPath reports whether a path is an upload slot handed out by
+// GetKnowledgeImportUploadURL.
+func isKnowledgeImportPath(archivePath string) bool {
+	rest, ok := strings.CutPrefix(archivePath, knowledgeImportPrefix)
+	if !ok {
+		return false
+	}
+	id, name, _ := strings.Cut(rest, "/")
+	if _, err := uuid.Parse(id); err != nil {
+		return false
+	}
+	return name == "archive.jsonl"
+}
+
+// readKnowledgeArchiveHeader reads and validates the first record of an uploaded archive,
+// so a wrong or missing file is rejected before an import is queued.
+func (s *SMEService) readKnowledgeArchiveHeader(ctx context.Context, tenantID uuid.UUID, archivePath string) (*knowledgeArchiveHeader, error) {
+	r, err := s.storage.OpenContent(ctx, tenantID, archivePath)
+	if err != nil {
+		s.logger.Warn("failed to open knowledge archive", "path", archivePath, "error", err)
+		return nil, domainerrors.ErrInvalidInput.WithMessage("archive has not been uploaded")
+	}
+	defer r.Close()
+
+	header, err := newKnowledgeArchiveReader(r).readHeader()
+	if err != nil {
+		return nil, domainerrors.ErrInvalidInput.WithMessage(fmt.Sprintf("invalid knowledge archive: %v", err))
+	}
+	return header, nil
+}
+
+// CreateKnowledgeExportJob creates a job that exports an SME's knowledge to an archive.
+func (s *SMEIngestionService) CreateKnowledgeExportJob(ctx context.Context, tenantID, smeID, userID uuid.UUID) (*entity.GenerationJob, error) {
+	return s.createKnowledgeArchiveJob(ctx, &entity.GenerationJob{
+		TenantID:        tenantID,
+		Type:            valueobject.GenerationJobTypeSMEKnowledgeExport,
+		SMEID:           &smeID,
+		MaxRetries:      1,
+		CreatedByUserID: userID,
+	})
+}
+
+// CreateKnowledgeImportJob creates a job that imports an uploaded archive into an SME.
+// Imports skip chunks the SME already has, so a retried job picks up where it stopped.
+func (s *SMEIngestionService) CreateKnowledgeImportJob(ctx context.Context, tenantID, smeID, userID uuid.UUID, archivePath string) (*entity.GenerationJob, error) {
+	return s.createKnowledgeArchiveJob(ctx, &entity.GenerationJob{
+		TenantID:        tenantID,
+		Type:            valueobject.GenerationJobTypeSMEKnowledgeImport,
+		SMEID:           &smeID,
+		SourcePath:      &archivePath,
+		MaxRetries:      2,
+		CreatedByUserID: userID,
+	})
+}
+
+func (s *SMEIngestionService) createKnowledgeArchiveJob(ctx context.Context, job *entity.GenerationJob) (*entity.GenerationJob, error) {
+	job.ID = uuid.New()
+	job.Status = valueobject.GenerationJobStatusQueued
+	job.CreatedAt = time.Now()
+
+	if err := s.jobRepo.Create(ctx, job); err != nil {
+		s.logger.Error("failed to create knowledge archive job", "type", job.Type, "smeID", job.SMEID, "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	s.logger.Info("knowledge archive job created", "jobID", job.ID, "type", job.Type, "smeID", job.SMEID)
+	return job, nil
+}
+
+// processKnowledgeArchiveJob runs an export or import job scoped to the job's tenant.
+func (s *SMEIngestionService) processKnowledgeArchiveJob(ctx context.Context, job *entity.GenerationJob) error {
+	ctx = tenant.WithTenantID(ctx, job.TenantID)
+
+	if ctx.Err() != nil {
+		return s.requeueInterruptedJob(ctx, job)
+	}
+	if s.archiveStorage == nil {
+		return s.failJob(ctx, job, "knowledge archives are not configured")
+	}
+	if job.SMEID == nil {
+		return s.failJob(ctx, job, "job has no SME")
+	}
+
+	now := time.Now()
+	job.Status = valueobject.GenerationJobStatusProcessing
+	job.StartedAt = &now
+	progressMsg := "Loading knowledge..."
+	job.ProgressMessage = &progressMsg
+	if err := s.jobRepo.Update(ctx, job); err != nil {
+		return fmt.Errorf("failed to update job status: %w", err)
+	}
+
+	if job.Type == valueobject.GenerationJobTypeSMEKnowledgeExport {
+		return s.processKnowledgeExportJob(ctx, job)
+	}
+	return s.processKnowledgeImportJob(ctx, job)
+}
+
+// processKnowledgeExportJob streams the SME's knowledge archive to tenant storage and
+// sends the requester a download link.
+func (s *SMEIngestionService) processKnowledgeExportJob(ctx context.Context, job *entity.GenerationJob) error {
+	log := s.logger.With("jobID", job.ID, "smeID", job.SMEID)
+
+	sme, err := s.smeRepo.GetByID(ctx, *job.SMEID)
+	if err != nil || sme == nil {
+		return s.failJob(ctx, job, "SME not found")
+	}
+
+	chunks, err := s.knowledgeRepo.ListBySMEID(ctx, sme.ID)
+	if err != nil {
+		log.Error("failed to list knowledge chunks", "error", err)
+		return s.failJob(ctx, job, "failed to list knowledge chunks")
+	}
+	submissions := s.archiveSubmissions(ctx, chunks)
+
+	subpath := path.Join("exports", job.ID.String(), exportFileSlug(sme.Name)+"-knowledge.jsonl")
+	size, err := s.archiveStorage.StreamContent(ctx, job.TenantID, subpath, "application/x-ndjson", func(w io.Writer) error {
+		return s.writeKnowledgeArchive(ctx, job, w, sme, submissions, chunks)
+	})
+	if err != nil {
+		if errors.Is(err, errKnowledgeArchiveCancelled) {
+			log.Info("knowledge export cancelled")
+			return nil
+		}
+		if ctx.Err() != nil {
+			return s.requeueInterruptedJob(ctx, job)
+		}
+		log.Error("failed to write knowledge archive", "error", err)
+		return s.failJob(ctx, job, "failed to write knowledge archive")
+	}
+
+	downloadURL, err := s.archiveStorage.GenerateDownloadURL(ctx, job.TenantID, subpath, knowledgeArchiveURLExpiry)
+	if err != nil {
+		log.Error("failed to generate export download URL", "error", err)
+		return s.failJob(ctx, job, "failed to generate download link")
+	}
+
+	if s.exportArtifacts != nil {
+		artifact := &entity.ExportArtifact{
+			TenantID:        job.TenantID,
+			JobID:           job.ID,
+			SMEID:           &sme.ID,
+			Type:            entity.ExportArtifactTypeSMEKnowledge,
+			FilePath:        subpath,
+			SizeBytes:       size,
+			CreatedByUserID: &job.CreatedByUserID,
+		}
+		if err := s.exportArtifacts.RecordExportArtifact(ctx, artifact); err != nil {
+			log.Warn("failed to record export artifact", "error", err)
+		}
+	}
+
+	resultPath := s.archiveStorage.BuildPath(job.TenantID, subpath)
+	job.ResultPath = &resultPath
+	s.completeKnowledgeArchiveJob(ctx, job, fmt.Sprintf("Exported %d knowledge chunks", len(chunks)))
+
+	s.sendKnowledgeArchiveNotification(ctx, job, &entity.Notification{
+		Type:      valueobject.NotificationTypeExportReady,
+		Priority:  valueobject.NotificationPriorityNormal,
+		Title:     "Knowledge Export Ready",
+		Message:   fmt.Sprintf("%d knowledge chunks from %s are ready to download.", len(chunks), sme.Name),
+		ActionURL: &downloadURL,
+	})
+
+	log.Info("knowledge export completed", "chunks", len(chunks), "submissions", len(submissions), "bytes", size)
+	return nil
+}
+
+// archiveSubmissions describes the submissions the chunks were distilled from. A
+// submission or task that can't be read is left out; its chunks keep their reference.
+func (s *SMEIngestionService) archiveSubmissions(ctx context.Context, chunks []*entity.SMEKnowledgeChunk) []knowledgeArchiveSubmission {
+	seen := make(map[uuid.UUID]bool)
+	taskTitles := make(map[uuid.UUID]string)
+	var out []knowledgeArchiveSubmission
+	for _, chunk := range chunks {
+		if chunk.SubmissionID == nil || seen[*chunk.SubmissionID] {
+			continue
+		}
+		seen[*chunk.SubmissionID] = true
+
+		submission, err := s.submissionRepo.GetByID(ctx, *chunk.SubmissionID)
+		if err != nil || submission == nil {
+			s.logger.Warn("failed to load submission for knowledge export", "submissionID", chunk.SubmissionID, "error", err)
+			continue
+		}
+		title, ok := taskTitles[submission.TaskID]
+		if !ok {
+			if task, err := s.taskRepo.GetByID(ctx, submission.TaskID); err == nil && task != nil {
+				title = task.Title
+			}
+			taskTitles[submission.TaskID] = title
+		}
+
+		out = append(out, knowledgeArchiveSubmission{
+			Type:          knowledgeArchiveRecordSubmission,
+			ID:            submission.ID.String(),
+			TaskID:        submission.TaskID.String(),
+			TaskTitle:     title,
+			FileName:      submission.FileName,
+			ContentType:   submission.ContentType.String(),
+			FileSizeBytes: submission.FileSizeBytes,
+			SubmittedAt:   submission.SubmittedAt,
+			ProcessedAt:   submission.ProcessedAt,
+			Approved:      submission.IsApproved,
+			ApprovedAt:    submission.ApprovedAt,
+		})
+	}
+	return out
+}
+
+// writeKnowledgeArchive writes the header, submission and chunk records, one per line.
+func (s *SMEIngestionService) writeKnowledgeArchive(ctx context.Context, job *entity.GenerationJob, w io.Writer, sme *entity.SubjectMatterExpert, submissions []knowledgeArchiveSubmission, chunks []*entity.SMEKnowledgeChunk) error {
+	enc := json.NewEncoder(w)
+
+	header := knowledgeArchiveHeader{
+		Type:       knowledgeArchiveRecordHeader,
+		Format:     knowledgeArchiveFormat,
+		Version:    knowledgeArchiveVersion,
+		ExportedAt: time.Now().UTC(),
+		SME: knowledgeArchiveSME{
+			ID:          sme.ID.String(),
+			Name:        sme.Name,
+			Description: sme.Description,
+			Domain:      sme.Domain,
+			Scope:       sme.Scope.String(),
+			CreatedAt:   sme.CreatedAt,
+		},
+		SubmissionCount: len(submissions),
+		ChunkCount:      len(chunks),
+	}
+	if sme.KnowledgeSummary != nil {
+		header.KnowledgeSummary = *sme.KnowledgeSummary
+	}
+	if err := enc.Encode(header); err != nil {
+		return err
+	}
+
+	for _, submission := range submissions {
+		if err := enc.Encode(submission); err != nil {
+			return err
+		}
+	}
+
+	for i, chunk := range chunks {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		record := knowledgeArchiveChunk{
+			Type:              knowledgeArchiveRecordChunk,
+			ID:                chunk.ID.String(),
+			Content:           chunk.Content,
+			Topic:             chunk.Topic,
+			Keywords:          chunk.Keywords,
+			RelevanceScore:    chunk.RelevanceScore,
+			InjectionFlagged:  chunk.InjectionFlagged,
+			InjectionReleased: chunk.InjectionReleasedAt != nil,
+			CreatedAt:         chunk.CreatedAt,
+		}
+		if chunk.SubmissionID != nil {
+			record.SubmissionID = chunk.SubmissionID.String()
+		}
+		if chunk.InjectionReason != nil {
+			record.InjectionReason = *chunk.InjectionReason
+		}
+		if err := enc.Encode(record); err != nil {
+			return err
+		}
+
+		if done := i + 1; done%knowledgeArchiveProgressEvery == 0 {
+			if err := s.reportArchiveProgress(ctx, job, done, len(chunks), "Exported %d of %d knowledge chunks"); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// processKnowledgeImportJob reads an uploaded archive into the job's SME. Chunks are
+// screened for prompt injection like ingested ones, and chunks the SME already has are
+// skipped. Submissions describe where chunks came from in the exporting workspace; they
+// are checked but not recreated, since the tasks they belonged to don't exist here.
+// Once the chunks are in, the knowledge summary is regenerated from them.
+func (s *SMEIngestionService) processKnowledgeImportJob(ctx context.Context, job *entity.GenerationJob) error {
+	log := s.logger.With("jobID", job.ID, "smeID", job.SMEID)
+
+	if job.SourcePath == nil {
+		return s.failJob(ctx, job, "import job has no archive")
+	}
+
+	sme, err := s.smeRepo.GetByID(ctx, *job.SMEID)
+	if err != nil || sme == nil {
+		return s.failJob(ctx, job, "SME not found")
+	}
+
+	existing, err := s.knowledgeRepo.ListBySMEID(ctx, sme.ID)
+	if err != nil {
+		log.Error("failed to list knowledge chunks", "error", err)
+		return s.failJob(ctx, job, "failed to list knowledge chunks")
+	}
+	seen := make(map[[sha256.Size]byte]bool, len(existing))
+	for _, chunk := range existing {
+		seen[knowledgeContentKey(chunk.Content)] = true
+	}
+
+	r, err := s.archiveStorage.OpenContent(ctx, job.TenantID, *job.SourcePath)
+	if err != nil {
+		log.Error("failed to open knowledge archive", "path", *job.SourcePath, "error", err)
+		return s.failJob(ctx, job, "failed to open archive")
+	}
+	defer r.Close()
+
+	archive := newKnowledgeArchiveReader(r)
+	header, err := archive.readHeader()
+	if err != nil {
+		return s.failJob(ctx, job, fmt.Sprintf("invalid knowledge archive: %v", err))
+	}
+
+	topics := s.newTopicIndex(ctx, sme.ID)
+	var imported, duplicates, flagged, read int
+	for {
+		record, err := archive.next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return s.requeueInterruptedJob(ctx, job)
+			}
+			return s.failJob(ctx, job, fmt.Sprintf("invalid knowledge archive: %v", err))
+		}
+		if record.chunk == nil {
+			continue
+		}
+
+		read++
+		if read > knowledgeArchiveMaxChunks {
+			return s.failJob(ctx, job, fmt.Sprintf("archive has more than %d knowledge chunks", knowledgeArchiveMaxChunks))
+		}
+
+		key := knowledgeContentKey(record.chunk.Content)
+		if seen[key] {
+			duplicates++
+		} else {
+			seen[key] = true
+			chunk := record.chunk.toEntity(job.TenantID, sme.ID, topics)
+			if s.screenChunk(chunk) {
+				log.Warn("imported knowledge chunk flagged as possible prompt injection", "reason", *chunk.InjectionReason)
+			}
+			if err := s.knowledgeRepo.Create(ctx, chunk); err != nil {
+				if ctx.Err() != nil {
+					return s.requeueInterruptedJob(ctx, job)
+				}
+				log.Error("failed to create knowledge chunk", "error", err)
+				return s.failJob(ctx, job, "failed to store knowledge chunks")
+			}
+			imported++
+			if chunk.InjectionFlagged {
+				flagged++
+			}
+		}
+
+		if read%knowledgeArchiveProgressEvery == 0 {
+			if err := s.reportArchiveProgress(ctx, job, read, max(header.ChunkCount, read), "Imported %d of %d knowledge chunks"); err != nil {
+				if errors.Is(err, errKnowledgeArchiveCancelled) {
+					log.Info("knowledge import cancelled", "imported", imported)
+					return nil
+				}
+				return err
+			}
+		}
+	}
+
+	// The chunks are stored; finish the summary and status even if a drain starts now
+	ctx = context.WithoutCancel(ctx)
+
+	msg := "Regenerating knowledge summary..."
+	job.ProgressPercent = 95
+	job.ProgressMessage = &msg
+	if _, err := s.jobRepo.UpdateProgress(ctx, job.ID, job.ProgressPercent, msg); err != nil {
+		log.Warn("failed to update job progress", "error", err)
+	}
+
+	if summary := s.regenerateKnowledgeSummary(ctx, job, sme, header.KnowledgeSummary); summary != "" {
+		sme.KnowledgeSummary = &summary
+	}
+	sme.Status = valueobject.SMEStatusActive
+	sme.UpdatedAt = time.Now()
+	if err := s.smeRepo.Update(ctx, sme); err != nil {
+		log.Warn("failed to update imported SME", "error", err)
+	}
+
+	result := fmt.Sprintf("Imported %d knowledge chunks", imported)
+	if duplicates > 0 {
+		result = fmt.Sprintf("Imported %d knowledge chunks, skipped %d duplicates", imported, duplicates)
+	}
+	s.completeKnowledgeArchiveJob(ctx, job, result)
+
+	message := fmt.Sprintf("%s into %s.", result, sme.Name)
+	priority := valueobject.NotificationPriorityNormal
+	if flagged > 0 {
+		message += fmt.Sprintf(" %d knowledge chunk(s) were flagged as possible prompt injection and will be left out of generation until an admin reviews them.", flagged)
+		priority = valueobject.NotificationPriorityHigh
+	}
+	s.sendKnowledgeArchiveNotification(ctx, job, &entity.Notification{
+		Type:     valueobject.NotificationTypeIngestionComplete,
+		Priority: priority,
+		Title:    "Knowledge Import Complete",
+		Message:  message,
+	})
+
+	s.enqueueTopicReclusterIfNeeded(sme, len(topics))
+
+	log.Info("knowledge import completed", "imported", imported, "duplicates", duplicates, "flagged", flagged, "tokensUsed", job.TokensUsed)
+	return nil
+}
+
+// regenerateKnowledgeSummary summarizes the SME's usable chunks with the tenant's
+// provider; the chunks it distills along the way are discarded. If that fails, the
+// archive's summary is kept unless it looks like prompt injection. Returns "" when
+// there is nothing to set.
+func (s *SMEIngestionService) regenerateKnowledgeSummary(ctx context.Context, job *entity.GenerationJob, sme *entity.SubjectMatterExpert, archiveSummary string) string {
+	log := s.logger.With("jobID", job.ID, "smeID", sme.ID)
+
+	fallback := func() string {
+		if archiveSummary == "" || s.detectInjection(archiveSummary).Flagged {
+			return ""
+		}
+		summary, tokensUsed := s.fitKnowledgeSummary(ctx, sme, archiveSummary)
+		job.TokensUsed += tokensUsed
+		return summary
+	}
+
+	chunks, err := s.knowledgeRepo.ListBySMEID(ctx, sme.ID)
+	if err != nil {
+		log.Warn("failed to list chunks for summary", "error", err)
+		return fallback()
+	}
+	var text strings.Builder
+	for _, chunk := range generationChunks(chunks) {
+		if text.Len()+len(chunk.Content) > knowledgeSummaryInputLimit {
+			break
+		}
+		text.WriteString(chunk.Content)
+		text.WriteString("\n\n")
+	}
+	if text.Len() == 0 {
+		return fallback()
+	}
+
+	aiProvider, err := s.aiProviderFactory.GetProvider(ctx, job.TenantID)
+	if err != nil {
+		log.Warn("failed to get AI provider for summary", "error", err)
+		return fallback()
+	}
+	result, err := aiProvider.ProcessSMEContent(ctx, service.ProcessSMEContentRequest{
+		SMEName:       sme.Name,
+		SMEDomain:     sme.Domain,
+		ExtractedText: text.String(),
+	})
+	if err != nil {
+		log.Warn("failed to regenerate knowledge summary", "error", err)
+		return fallback()
+	}
+
+	job.TokensUsed += result.TokensUsed
+	job.RepairAttempts += int32(result.RepairAttempts)
+	_ = s.aiSettingsRepo.IncrementTokenUsage(ctx, job.TenantID, result.TokensUsed)
+
+	if verdict := s.detectInjection(result.Summary); verdict.Flagged {
+		log.Warn("regenerated summary flagged as possible prompt injection, not added to SME knowledge", "reason", verdict.Reason)
+		return ""
+	}
+	summary, tokensUsed := s.fitKnowledgeSummary(ctx, sme, result.Summary)
+	job.TokensUsed += tokensUsed
+	return summary
+}
+
+// reportArchiveProgress records progress between 5% and 90%, returning
+// errKnowledgeArchiveCancelled once the job is no longer processing.
+func (s *SMEIngestionService) reportArchiveProgress(ctx context.Context, job *entity.GenerationJob, done, total int, format string) error {
+	percent := int32(5 + 85*done/total)
+	msg := fmt.Sprintf(format, done, total)
+	job.ProgressPercent = percent
+	job.ProgressMessage = &msg
+	processing, err := s.jobRepo.UpdateProgress(ctx, job.ID, percent, msg)
+	if err != nil {
+		s.logger.Warn("failed to update job progress", "jobID", job.ID, "progress", percent, "error", err)
+		return nil
+	}
+	if !processing {
+		return errKnowledgeArchiveCancelled
+	}
+	return nil
+}
+
+func (s *SMEIngestionService) completeKnowledgeArchiveJob(ctx context.Context, job *entity.GenerationJob, result string) {
+	job.Status = valueobject.GenerationJobStatusCompleted
+	job.ProgressPercent = 100
+	completedAt := time.Now()
+	job.CompletedAt = &completedAt
+	job.ProgressMessage = &result
+	if err := s.jobRepo.Update(ctx, job); err != nil {
+		s.logger.Error("failed to mark job as completed", "jobID", job.ID, "error", err)
+	}
+}
+
+// sendKnowledgeArchiveNotification tells the job's creator about the finished job.
+func (s *SMEIngestionService) sendKnowledgeArchiveNotification(ctx context.Context, job *entity.GenerationJob, notification *entity.Notification) {
+	if s.notifier == nil {
+		return
+	}
+
+	notification.ID = uuid.New()
+	notification.TenantID = job.TenantID
+	notification.UserID = job.CreatedByUserID
+	notification.SMEID = job.SMEID
+	notification.JobID = &job.ID
+	notification.CreatedAt = time.Now()
+
+	if err := s.notifier.SendNotification(ctx, notification); err != nil {
+		s.logger.Warn("failed to send knowledge archive notification", "jobID", job.ID, "error", err)
+	}
+}
+
+// knowledgeContentKey identifies chunks with the same text, ignoring case and spacing.
+func knowledgeContentKey(content string) [sha256.Size]byte {
+	return sha256.Sum256([]byte(strings.ToLower(strings.Join(strings.Fields(content), " "))))
+}
+
+// knowledgeArchiveHeader is the first record of an archive.
+type knowledgeArchiveHeader struct {
+	Type             string              `json:"type"`
+	Format           string              `json:"format"`
+	Version          int                 `json:"version"`
+	ExportedAt       time.Time           `json:"exportedAt"`
+	SME              knowledgeArchiveSME `json:"sme"`
+	KnowledgeSummary string              `json:"knowledgeSummary,omitempty"`
+	SubmissionCount  int                 `json:"submissionCount"`
+	ChunkCount       int                 `json:"chunkCount"`
+}
+
+type knowledgeArchiveSME struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description,omitempty"`
+	Domain      string    `json:"domain"`
+	Scope       string    `json:"scope"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+// knowledgeArchiveSubmission records where chunks came from.
+type knowledgeArchiveSubmission struct {
+	Type          string     `json:"type"`
+	ID            string     `json:"id"`
+	TaskID        string     `json:"taskId"`
+	TaskTitle     string     `json:"taskTitle,omitempty"`
+	FileName      string     `json:"fileName"`
+	ContentType   string     `json:"contentType"`
+	FileSizeBytes int64      `json:"fileSizeBytes"`
+	SubmittedAt   time.Time  `json:"submittedAt"`
+	ProcessedAt   *time.Time `json:"processedAt,omitempty"`
+	Approved      bool       `json:"approved"`
+	ApprovedAt    *time.Time `json:"approvedAt,omitempty"`
+}
+
+type knowledgeArchiveChunk struct {
+	Type              string    `json:"type"`
+	ID                string    `json:"id"`
+	SubmissionID      string    `json:"submissionId,omitempty"`
+	Content           string    `json:"content"`
+	Topic             string    `json:"topic"`
+	Keywords          []string  `json:"keywords,omitempty"`
+	RelevanceScore    float32   `json:"relevanceScore"`
+	InjectionFlagged  bool      `json:"injectionFlagged,omitempty"`
+	InjectionReason   string    `json:"injectionReason,omitempty"`
+	InjectionReleased bool      `json:"injectionReleased,omitempty"`
+	CreatedAt         time.Time `json:"createdAt"`
+}
+
+func (c *knowledgeArchiveChunk) validate() error {
+	if strings.TrimSpace(c.Content) == "" {
+		return errors.New("chunk has no content")
+	}
+	if utf8.RuneCountInString(c.Content) > knowledgeArchiveMaxChunkLength {
+		return fmt.Errorf("chunk content is longer than %d characters", knowledgeArchiveMaxChunkLength)
+	}
+	if len(c.Keywords) > knowledgeArchiveMaxKeywords {
+		return fmt.Errorf("chunk has more than %d keywords", knowledgeArchiveMaxKeywords)
+	}
+	return nil
+}
+
+// toEntity builds the chunk to store. A chunk still held back in the exporting workspace
+// stays held back here; releases aren't carried over, so an admin reviews it again.
+func (c *knowledgeArchiveChunk) toEntity(tenantID, smeID uuid.UUID, topics topicIndex) *entity.SMEKnowledgeChunk {
+	chunk := &entity.SMEKnowledgeChunk{
+		ID:             uuid.New(),
+		TenantID:       tenantID,
+		SMEID:          smeID,
+		Content:        c.Content,
+		Topic:          topics.label(c.Topic),
+		Keywords:       c.Keywords,
+		RelevanceScore: min(max(c.RelevanceScore, 0), 1),
+		CreatedAt:      time.Now(),
+	}
+	if c.InjectionFlagged && !c.InjectionReleased {
+		reason := "flagged in the exporting workspace"
+		if c.InjectionReason != "" {
+			reason += ": " + c.InjectionReason
+		}
+		chunk.InjectionFlagged = true
+		chunk.InjectionReason = &reason
+	}
+	return chunk
+}
+
+// knowledgeArchiveReader reads an archive's records in order, enforcing the import
+// size limits.
+type knowledgeArchiveReader struct {
+	scanner *bufio.Scanner
+	line    int
+}
+
+// knowledgeArchiveRecord is one record after the header; only chunks are returned in full.
+type knowledgeArchiveRecord struct {
+	chunk *knowledgeArchiveChunk
+}
+
+func newKnowledgeArchiveReader(r io.Reader) *knowledgeArchiveReader {
+	scanner := bufio.NewScanner(&archiveSizeLimiter{r: r, remaining: knowledgeArchiveMaxBytes})
+	scanner.Buffer(make([]byte, 0, 64*1024), knowledgeArchiveMaxLineBytes)
+	return &knowledgeArchiveReader{scanner: scanner}
+}
+
+// scan advances to the next non-empty line, returning io.EOF at the end of the archive.
+func (ar *knowledgeArchiveReader) scan() ([]byte, error) {
+	for ar.scanner.Scan() {
+		ar.line++
+		if line := ar.scanner.Bytes(); len(bytes.TrimSpace(line)) > 0 {
+			return line, nil
+		}
+	}
+	switch err := ar.scanner.Err(); {
+	case err == nil:
+		return nil, io.EOF
+	case errors.Is(err, bufio.ErrTooLong):
+		return nil, fmt.Errorf("line %d is longer than %d KB", ar.line+1, knowledgeArchiveMaxLineBytes>>10)
+	default:
+		return nil, err
+	}
+}
+
+// readHeader reads the archive's first record and checks it's a supported version.
+func (ar *knowledgeArchiveReader) readHeader() (*knowledgeArchiveHeader, error) {
+	line, err := ar.scan()
+	if errors.Is(err, io.EOF) {
+		return nil, errors.New("archive is empty")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var header knowledgeArchiveHeader
+	if err := json.Unmarshal(line, &header); err != nil || header.Type != knowledgeArchiveRecordHeader {
+		return nil, errors.New("archive does not start with a header record")
+	}
+	switch {
+	case header.Format != knowledgeArchiveFormat:
+		return nil, fmt.Errorf("unknown archive format %q", header.Format)
+	case header.Version < 1 || header.Version > knowledgeArchiveVersion:
+		return nil, fmt.Errorf("unsupported archive version %d", header.Version)
+	case strings.TrimSpace(header.SME.Name) == "" || strings.TrimSpace(header.SME.Domain) == "":
+		return nil, errors.New("archive SME has no name or domain")
+	case utf8.RuneCountInString(header.SME.Name) > 255 || utf8.RuneCountInString(header.SME.Domain) > 255:
+		return nil, errors.New("archive SME name or domain is longer than 255 characters")
+	case header.ChunkCount > knowledgeArchiveMaxChunks:
+		return nil, fmt.Errorf("archive has more than %d knowledge chunks", knowledgeArchiveMaxChunks)
+	}
+	return &header, nil
+}
+
+// next reads the record after the last one read, returning io.EOF at the end.
+func (ar *knowledgeArchiveReader) next() (knowledgeArchiveRecord, error) {
+	line, err := ar.scan()
+	if err != nil {
+		return knowledgeArchiveRecord{}, err
+	}
+
+	var kind struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(line, &kind); err != nil {
+		return knowledgeArchiveRecord{}, fmt.Errorf("line %d is not valid JSON", ar.line)
+	}
+
+	switch kind.Type {
+	case knowledgeArchiveRecordSubmission:
+		var submission knowledgeArchiveSubmission
+		if err := json.Unmarshal(line, &submission); err != nil {
+			return knowledgeArchiveRecord{}, fmt.Errorf("line %d: invalid submission record", ar.line)
+		}
+		return knowledgeArchiveRecord{}, nil
+	case knowledgeArchiveRecordChunk:
+		var chunk knowledgeArchiveChunk
+		if err := json.Unmarshal(line, &chunk); err != nil {
+			return knowledgeArchiveRecord{}, fmt.Errorf("line %d: invalid chunk record", ar.line)
+		}
+		if err := chunk.validate(); err != nil {
+			return knowledgeArchiveRecord{}, fmt.Errorf("line %d: %w", ar.line, err)
+		}
+		return knowledgeArchiveRecord{chunk: &chunk}, nil
+	default:
+		return knowledgeArchiveRecord{}, fmt.Errorf("line %d has unknown record type %q", ar.line, kind.Type)
+	}
+}
+
+// archiveSizeLimiter fails reads once more than the limit has been read, so an
+// oversized archive is rejected without being read to the end.
+type archiveSizeLimiter struct {
+	r         io.Reader
+	remaining int64
+}
+
+func (l *archiveSizeLimiter) Read(p []byte) (int, error) {
+	if l.remaining < 0 {
+		return 0, errKnowledgeArchiveTooLarge
+	}
+	if int64(len(p)) > l.remaining+1 {
+		p = p[:l.remaining+1]
+	}
+	n, err := l.r.Read(p)
+	l.remaining -= int64(n)
+	if l.remaining < 0 {
+		return n, errKnowledgeArchiveTooLarge
+	}
+	return n, err
+}
@@ -0,0 +1,42 @@
+package service
+
+import (
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/service"
+)
+
+// SetPromptInjectionDetector enables screening of ingested knowledge for prompt injection.
+func (s *SMEIngestionService) SetPromptInjectionDetector(detector service.PromptInjectionDetector) {
+	s.injectionDetector = detector
+}
+
+// detectInjection screens content, treating everything as clean when no detector is set.
+func (s *SMEIngestionService) detectInjection(content string) service.PromptInjectionVerdict {
+	if s.injectionDetector == nil {
+		return service.PromptInjectionVerdict{}
+	}
+	return s.injectionDetector.Detect(content)
+}
+
+// screenChunk flags the chunk if its content looks like a prompt injection attempt,
+// reporting whether it was flagged.
+func (s *SMEIngestionService) screenChunk(chunk *entity.SMEKnowledgeChunk) bool {
+	verdict := s.detectInjection(chunk.Content)
+	if !verdict.Flagged {
+		return false
+	}
+	chunk.InjectionFlagged = true
+	chunk.InjectionReason = &verdict.Reason
+	return true
+}
+
+// generationChunks drops the chunks that are held back from generation prompts.
+func generationChunks(chunks []*entity.SMEKnowledgeChunk) []*entity.SMEKnowledgeChunk {
+	usable := make([]*entity.SMEKnowledgeChunk, 0, len(chunks))
+	for _, chunk := range chunks {
+		if chunk.UsableInGeneration() {
+			usable = append(usable, chunk)
+		}
+	}
+	return usable
+}
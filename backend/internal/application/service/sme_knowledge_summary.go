@@ -0,0 +1,238 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/domain/tenant"
+)
+
+const (
+	// knowledgeSummaryMaxChars caps an SME's knowledge summary. The summary is sent whole
+	// with every outline and lesson prompt, so it has to stay small next to the chunks.
+	knowledgeSummaryMaxChars = 6000
+
+	// knowledgeSummaryTrimMarker ends a summary that was cut short for a prompt.
+	knowledgeSummaryTrimMarker = " […]"
+)
+
+// KnowledgeSummaryRefitEnqueuer enqueues background re-summarization of SME knowledge
+// summaries.
+type KnowledgeSummaryRefitEnqueuer interface {
+	EnqueueSMESummaryRefit(tenantID, smeID string) error
+}
+
+// KnowledgeSummaryBackfillEnqueuer enqueues the knowledge summary backfill.
+type KnowledgeSummaryBackfillEnqueuer interface {
+	EnqueueSMESummaryBackfill() error
+}
+
+// SetKnowledgeSummaryBackfill lets superadmins start the knowledge summary backfill.
+func (s *SMEService) SetKnowledgeSummaryBackfill(admins SuperAdminChecker, enqueuer KnowledgeSummaryBackfillEnqueuer) {
+	s.superAdmins = admins
+	s.summaryBackfill = enqueuer
+}
+
+// StartKnowledgeSummaryBackfill lets a superadmin queue the backfill that re-summarizes
+// every SME, in all tenants, whose knowledge summary is over the cap.
+func (s *SMEService) StartKnowledgeSummaryBackfill(ctx context.Context, email string) error {
+	if s.superAdmins == nil || !s.superAdmins.IsSuperAdmin(email) {
+		return domainerrors.ErrForbidden.WithMessage("superadmin access required")
+	}
+	if s.summaryBackfill == nil {
+		return domainerrors.ErrInternal.WithMessage("knowledge summary backfill is not configured")
+	}
+	if err := s.summaryBackfill.EnqueueSMESummaryBackfill(); err != nil {
+		s.logger.Error("failed to enqueue knowledge summary backfill", "error", err)
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+	s.logger.Info("knowledge summary backfill requested", "actor", email)
+	return nil
+}
+
+// SetKnowledgeSummaryRefit enables the knowledge summary backfill. alertEmail reports its
+// counts to the operators and may be nil.
+func (s *SMEIngestionService) SetKnowledgeSummaryRefit(enqueuer KnowledgeSummaryRefitEnqueuer, alertEmail service.EmailProvider) {
+	s.summaryRefit = enqueuer
+	s.alertEmail = alertEmail
+}
+
+// knowledgeSummaryTooLong reports whether a summary is over knowledgeSummaryMaxChars.
+func knowledgeSummaryTooLong(summary string) bool {
+	return utf8.RuneCountInString(summary) > knowledgeSummaryMaxChars
+}
+
+// fitKnowledgeSummary returns the summary unchanged if it is within the cap. Otherwise the
+// tenant's provider re-summarizes it to fit; if that fails, or the result still doesn't fit,
+// the summary is cut after the last whole sentence that does. Tokens spent are added to
+// the tenant's usage and returned.
+func (s *SMEIngestionService) fitKnowledgeSummary(ctx context.Context, sme *entity.SubjectMatterExpert, summary string) (string, int64) {
+	if !knowledgeSummaryTooLong(summary) {
+		return summary, 0
+	}
+	log := s.logger.With("smeID", sme.ID, "chars", utf8.RuneCountInString(summary))
+
+	aiProvider, err := s.aiProviderFactory.GetProvider(ctx, sme.TenantID)
+	if err != nil {
+		log.Warn("failed to get AI provider to shorten knowledge summary", "error", err)
+		return cutAtSentence(summary, knowledgeSummaryMaxChars), 0
+	}
+
+	result, err := aiProvider.ProcessSMEContent(ctx, service.ProcessSMEContentRequest{
+		SMEName:         sme.Name,
+		SMEDomain:       sme.Domain,
+		ExtractedText:   cutAtSentence(summary, knowledgeSummaryInputLimit),
+		MaxSummaryChars: knowledgeSummaryMaxChars,
+	})
+	if err != nil {
+		log.Warn("failed to re-summarize knowledge summary", "error", err)
+		return cutAtSentence(summary, knowledgeSummaryMaxChars), 0
+	}
+	_ = s.aiSettingsRepo.IncrementTokenUsage(ctx, sme.TenantID, result.TokensUsed)
+
+	shortened := strings.TrimSpace(result.Summary)
+	switch {
+	case shortened == "":
+		log.Warn("re-summarized knowledge summary is empty")
+		shortened = summary
+	case s.detectInjection(shortened).Flagged:
+		log.Warn("re-summarized knowledge summary flagged as possible prompt injection, keeping the original")
+		shortened = summary
+	case knowledgeSummaryTooLong(shortened):
+		log.Warn("re-summarized knowledge summary is still over the cap", "shortenedChars", utf8.RuneCountInString(shortened))
+	}
+
+	fitted := cutAtSentence(shortened, knowledgeSummaryMaxChars)
+	log.Info("knowledge summary shortened", "newChars", utf8.RuneCountInString(fitted), "tokensUsed", result.TokensUsed)
+	return fitted, result.TokensUsed
+}
+
+// RefitKnowledgeSummary re-summarizes the SME's knowledge summary if it is over the cap.
+// SMEs within the cap are left alone.
+func (s *SMEIngestionService) RefitKnowledgeSummary(ctx context.Context, smeID uuid.UUID) error {
+	log := s.logger.With("smeID", smeID)
+
+	sme, err := s.smeRepo.GetByID(ctx, smeID)
+	if err != nil {
+		return fmt.Errorf("failed to get SME: %w", err)
+	}
+	if sme == nil {
+		log.Info("SME not found, skipping knowledge summary refit")
+		return nil
+	}
+	if sme.KnowledgeSummary == nil || !knowledgeSummaryTooLong(*sme.KnowledgeSummary) {
+		log.Info("knowledge summary within cap, nothing to refit")
+		return nil
+	}
+
+	summary, _ := s.fitKnowledgeSummary(ctx, sme, *sme.KnowledgeSummary)
+	sme.KnowledgeSummary = &summary
+	if err := s.smeRepo.Update(ctx, sme); err != nil {
+		return fmt.Errorf("failed to update SME: %w", err)
+	}
+	return nil
+}
+
+// KnowledgeSummaryBackfillResult reports what a knowledge summary backfill found.
+type KnowledgeSummaryBackfillResult struct {
+	Oversized int // SMEs whose summary is over the cap
+	Queued    int // Refits queued
+	Failed    int // Refits that couldn't be queued
+}
+
+// BackfillKnowledgeSummaries finds SMEs in every tenant whose knowledge summary is over
+// the cap and queues a refit for each. The counts are logged and sent to the operators.
+func (s *SMEIngestionService) BackfillKnowledgeSummaries(ctx context.Context) (*KnowledgeSummaryBackfillResult, error) {
+	log := s.logger.With("job", "knowledge-summary-backfill")
+	if s.summaryRefit == nil {
+		return nil, fmt.Errorf("knowledge summary refit is not configured")
+	}
+
+	smes, err := s.smeRepo.List(tenant.WithSuperAdmin(ctx, true), entity.SMEListOptions{
+		SummaryLongerThan: knowledgeSummaryMaxChars,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list SMEs with oversized summaries: %w", err)
+	}
+
+	result := &KnowledgeSummaryBackfillResult{Oversized: len(smes)}
+	for _, sme := range smes {
+		if err := s.summaryRefit.EnqueueSMESummaryRefit(sme.TenantID.String(), sme.ID.String()); err != nil {
+			log.Warn("failed to queue knowledge summary refit", "smeID", sme.ID, "error", err)
+			result.Failed++
+			continue
+		}
+		result.Queued++
+	}
+
+	log.Info("knowledge summary backfill finished", "oversized", result.Oversized, "queued", result.Queued, "failed", result.Failed)
+
+	if s.alertEmail != nil && result.Oversized > 0 {
+		subject := "[INFO] Mirai: Knowledge Summary Backfill Finished"
+		if result.Failed > 0 {
+			subject = "[WARNING] Mirai: Knowledge Summary Backfill Incomplete"
+		}
+		err := s.alertEmail.SendAlert(ctx, service.SendAlertRequest{
+			Subject: subject,
+			Body: fmt.Sprintf("The knowledge summary backfill found %d SMEs with a summary over %d characters.\n\n", result.Oversized, knowledgeSummaryMaxChars) +
+				fmt.Sprintf("Refits queued: %d\nCould not be queued: %d\n\n", result.Queued, result.Failed) +
+				"Each refit re-summarizes one SME in the background. Run the backfill again to pick up any that failed.",
+		})
+		if err != nil {
+			log.Error("failed to send knowledge summary backfill alert", "error", err)
+		}
+	}
+	return result, nil
+}
+
+// promptKnowledgeSummary returns the SME's knowledge summary for a prompt. Summaries are
+// capped when written, so one over the cap is cut short with a marker and logged.
+func (s *AIGenerationService) promptKnowledgeSummary(sme *entity.SubjectMatterExpert) string {
+	if sme.KnowledgeSummary == nil {
+		return ""
+	}
+	summary := *sme.KnowledgeSummary
+	if !knowledgeSummaryTooLong(summary) {
+		return summary
+	}
+	s.logger.Warn("SME knowledge summary over the cap, trimming it for the prompt",
+		"smeID", sme.ID, "chars", utf8.RuneCountInString(summary), "maxChars", knowledgeSummaryMaxChars)
+	return cutAtWord(summary, knowledgeSummaryMaxChars-utf8.RuneCountInString(knowledgeSummaryTrimMarker)) + knowledgeSummaryTrimMarker
+}
+
+// cutAtSentence cuts text to at most maxChars characters, ending after the last whole
+// sentence that fits. Text with no sentence end in its second half is cut at a word.
+func cutAtSentence(text string, maxChars int) string {
+	runes := []rune(text)
+	if len(runes) <= maxChars {
+		return text
+	}
+	cut := runes[:maxChars]
+	for i := len(cut) - 1; i >= maxChars/2; i-- {
+		if strings.ContainsRune(".!?", cut[i]) && (i+1 == len(runes) || unicode.IsSpace(runes[i+1])) {
+			return strings.TrimSpace(string(cut[:i+1]))
+		}
+	}
+	return cutAtWord(text, maxChars)
+}
+
+// cutAtWord cuts text to at most maxChars characters at a word boundary where possible.
+func cutAtWord(text string, maxChars int) string {
+	runes := []rune(text)
+	if len(runes) <= maxChars {
+		return text
+	}
+	cut := string(runes[:maxChars])
+	if i := strings.LastIndexFunc(cut, unicode.IsSpace); i > len(cut)/2 {
+		cut = cut[:i]
+	}
+	return strings.TrimSpace(cut)
+}
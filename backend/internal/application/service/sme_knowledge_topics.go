@@ -0,0 +1,176 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/service"
+)
+
+const (
+	// topicReclusterThreshold is the number of distinct topics above which an SME's
+	// topics are sent to the provider to merge near-duplicates.
+	topicReclusterThreshold = 40
+
+	// topicClusterTarget is the number of distinct topics the provider is asked to aim for.
+	topicClusterTarget = 20
+
+	// topicRenameBatchSize bounds how many chunks one update moves to a merged topic.
+	topicRenameBatchSize = 200
+)
+
+// TopicReclusterEnqueuer enqueues background reclustering of an SME's topics.
+type TopicReclusterEnqueuer interface {
+	EnqueueSMETopicRecluster(tenantID, smeID string) error
+}
+
+// SetTopicReclusterEnqueuer enables reclustering of topics once an SME has too many.
+func (s *SMEIngestionService) SetTopicReclusterEnqueuer(enqueuer TopicReclusterEnqueuer) {
+	s.topicRecluster = enqueuer
+}
+
+// topicIndex maps topics to the label already used for them, ignoring case, so new
+// chunks join an SME's existing topics instead of starting near-duplicates.
+type topicIndex map[string]string
+
+// newTopicIndex indexes the SME's existing topics. An SME whose topics can't be read
+// starts from an empty index; its topics are still normalized.
+func (s *SMEIngestionService) newTopicIndex(ctx context.Context, smeID uuid.UUID) topicIndex {
+	index := topicIndex{}
+	topics, err := s.knowledgeRepo.ListTopics(ctx, smeID)
+	if err != nil {
+		s.logger.Warn("failed to list SME topics", "smeID", smeID, "error", err)
+		return index
+	}
+	for _, t := range topics {
+		index.label(t.Topic)
+	}
+	return index
+}
+
+// label normalizes a topic and returns the label to file it under, adding it if new.
+func (idx topicIndex) label(topic string) string {
+	topic = entity.NormalizeKnowledgeTopic(topic)
+	key := strings.ToLower(topic)
+	if existing, ok := idx[key]; ok {
+		return existing
+	}
+	idx[key] = topic
+	return topic
+}
+
+// enqueueTopicReclusterIfNeeded schedules reclustering when the SME has more distinct
+// topics than browsing by topic handles well.
+func (s *SMEIngestionService) enqueueTopicReclusterIfNeeded(sme *entity.SubjectMatterExpert, topicCount int) {
+	if s.topicRecluster == nil || topicCount <= topicReclusterThreshold {
+		return
+	}
+	if err := s.topicRecluster.EnqueueSMETopicRecluster(sme.TenantID.String(), sme.ID.String()); err != nil {
+		s.logger.Warn("failed to enqueue topic recluster", "smeID", sme.ID, "topics", topicCount, "error", err)
+	}
+}
+
+// ReclusterTopics asks the provider to group the SME's near-duplicate topics and moves
+// their chunks to the shared labels in batches. SMEs at or below the threshold are left alone.
+func (s *SMEIngestionService) ReclusterTopics(ctx context.Context, smeID uuid.UUID) error {
+	log := s.logger.With("smeID", smeID)
+
+	sme, err := s.smeRepo.GetByID(ctx, smeID)
+	if err != nil {
+		return fmt.Errorf("failed to get SME: %w", err)
+	}
+	if sme == nil {
+		log.Info("SME not found, skipping topic recluster")
+		return nil
+	}
+
+	topics, err := s.knowledgeRepo.ListTopics(ctx, smeID)
+	if err != nil {
+		return fmt.Errorf("failed to list topics: %w", err)
+	}
+	if len(topics) <= topicReclusterThreshold {
+		log.Info("SME topics below recluster threshold", "topics", len(topics))
+		return nil
+	}
+
+	aiProvider, err := s.aiProviderFactory.GetProvider(ctx, sme.TenantID)
+	if err != nil {
+		return fmt.Errorf("failed to get AI provider: %w", err)
+	}
+
+	labels := make([]string, len(topics))
+	for i, t := range topics {
+		labels[i] = t.Topic
+	}
+
+	result, err := aiProvider.ClusterTopics(ctx, service.ClusterTopicsRequest{
+		SMEName:     sme.Name,
+		SMEDomain:   sme.Domain,
+		Topics:      labels,
+		MaxClusters: topicClusterTarget,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to cluster topics: %w", err)
+	}
+	_ = s.aiSettingsRepo.IncrementTokenUsage(ctx, sme.TenantID, result.TokensUsed)
+
+	merges := topicMerges(labels, result.Clusters)
+	moved := 0
+	for from, to := range merges {
+		for {
+			n, err := s.knowledgeRepo.RenameTopic(ctx, smeID, from, to, topicRenameBatchSize)
+			if err != nil {
+				return fmt.Errorf("failed to move chunks from topic %q: %w", from, err)
+			}
+			moved += n
+			if n < topicRenameBatchSize {
+				break
+			}
+		}
+	}
+
+	log.Info("SME topics reclustered",
+		"topicsBefore", len(topics),
+		"topicsMerged", len(merges),
+		"chunksMoved", moved,
+		"tokensUsed", result.TokensUsed,
+	)
+	return nil
+}
+
+// topicMerges maps each existing topic the provider grouped to its cluster's label.
+// Topics the provider invented are ignored, a topic in several clusters joins the
+// first, and a topic that is itself a cluster's label stays put, so no chunk moves twice.
+func topicMerges(topics []string, clusters []service.TopicCluster) map[string]string {
+	index := topicIndex{}
+	existing := make(map[string]bool, len(topics))
+	for _, t := range topics {
+		index.label(t)
+		existing[t] = true
+	}
+
+	clusterLabels := make([]string, len(clusters))
+	isLabel := map[string]bool{}
+	for i, cl := range clusters {
+		clusterLabels[i] = index.label(cl.Label)
+		isLabel[clusterLabels[i]] = true
+	}
+
+	merges := map[string]string{}
+	for i, cl := range clusters {
+		for _, topic := range cl.Topics {
+			if !existing[topic] || isLabel[topic] {
+				continue
+			}
+			if _, ok := merges[topic]; ok {
+				continue
+			}
+			merges[topic] = clusterLabels[i]
+		}
+	}
+	return merges
+}
@@ -0,0 +1,283 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/infrastructure/storage"
+)
+
+// Multipart uploads let large SME submissions (mainly video recordings) be uploaded
+// in parts that can be retried individually, so a dropped connection only loses the
+// part in flight. Clients start an upload, request presigned URLs for the parts
+// they still need, upload them directly to storage, then complete the upload and
+// pass the returned file path to SubmitContent.
+const (
+	// MultipartUploadThreshold is the file size above which clients should upload in parts.
+	MultipartUploadThreshold int64 = 100 << 20
+
+	// MaxMultipartUploadBytes caps the size of a single submission.
+	MaxMultipartUploadBytes int64 = 50 << 30
+
+	// defaultPartSize is used unless the file needs bigger parts to stay within
+	// storage.MultipartMaxParts.
+	defaultPartSize int64 = 16 << 20
+
+	// maxPartURLsPerRequest bounds how many part URLs GetPartUploadURLs presigns at once.
+	maxPartURLsPerRequest = 100
+
+	// partURLExpiry leaves time for a slow connection to finish a part.
+	partURLExpiry = time.Hour
+
+	// AbandonedUploadAge is how long an incomplete upload may sit before the cleanup
+	// task aborts it. Storage keeps billing for uploaded parts until then.
+	AbandonedUploadAge = 24 * time.Hour
+)
+
+// MultipartPartSize returns the part size for a file. Every part except the last
+// is exactly this size, which lets CompleteMultipartUpload validate the stored
+// parts against the declared file size without keeping upload state.
+func MultipartPartSize(fileSizeBytes int64) int64 {
+	partSize := defaultPartSize
+	if minSize := ceilDiv(fileSizeBytes, int64(storage.MultipartMaxParts)); minSize > partSize {
+		// Round up to a whole MiB
+		partSize = ceilDiv(minSize, 1<<20) << 20
+	}
+	return partSize
+}
+
+// MultipartPartCount returns how many parts a file is split into.
+func MultipartPartCount(fileSizeBytes int64) int32 {
+	return int32(ceilDiv(fileSizeBytes, MultipartPartSize(fileSizeBytes)))
+}
+
+func ceilDiv(a, b int64) int64 {
+	return (a + b - 1) / b
+}
+
+// validateMultipartFileSize checks a declared file size is suitable for a multipart upload.
+func validateMultipartFileSize(fileSizeBytes int64) error {
+	if fileSizeBytes < storage.MultipartMinPartSize {
+		return domainerrors.ErrInvalidInput.WithMessage(
+			fmt.Sprintf("files smaller than %d bytes must use GetUploadURL", storage.MultipartMinPartSize))
+	}
+	if fileSizeBytes > MaxMultipartUploadBytes {
+		return domainerrors.ErrInvalidInput.WithMessage(
+			fmt.Sprintf("file exceeds the %d GiB upload limit", MaxMultipartUploadBytes>>30))
+	}
+	return nil
+}
+
+// MultipartUpload describes a started multipart upload.
+type MultipartUpload struct {
+	UploadID      string
+	FilePath      string
+	PartSizeBytes int64
+	PartCount     int32
+}
+
+// StartMultipartUploadRequest contains the parameters for starting a multipart upload.
+type StartMultipartUploadRequest struct {
+	TaskID        uuid.UUID
+	FileName      string
+	FileSizeBytes int64
+}
+
+// StartMultipartUpload starts a multipart upload into the task's submission folder.
+func (s *SMEService) StartMultipartUpload(ctx context.Context, kratosID uuid.UUID, req StartMultipartUploadRequest) (*MultipartUpload, error) {
+	log := s.logger.With("kratosID", kratosID, "taskID", req.TaskID)
+
+	if req.FileName == "" || strings.Contains(req.FileName, "/") {
+		return nil, domainerrors.ErrInvalidInput.WithMessage("file_name must be a plain file name")
+	}
+	if err := validateMultipartFileSize(req.FileSizeBytes); err != nil {
+		return nil, err
+	}
+
+	user, task, err := s.getUploadTask(ctx, kratosID, req.TaskID)
+	if err != nil {
+		return nil, err
+	}
+
+	filePath := taskUploadPrefix(task) + req.FileName
+	contentType := mime.TypeByExtension(path.Ext(req.FileName))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	uploadID, err := s.storage.CreateMultipartUpload(ctx, *user.TenantID, filePath, contentType)
+	if err != nil {
+		log.Error("failed to start multipart upload", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	upload := &MultipartUpload{
+		UploadID:      uploadID,
+		FilePath:      filePath,
+		PartSizeBytes: MultipartPartSize(req.FileSizeBytes),
+		PartCount:     MultipartPartCount(req.FileSizeBytes),
+	}
+	log.Info("multipart upload started", "filePath", filePath, "sizeBytes", req.FileSizeBytes, "parts", upload.PartCount)
+	return upload, nil
+}
+
+// GetPartUploadURLsRequest contains the parameters for presigning part uploads.
+type GetPartUploadURLsRequest struct {
+	TaskID        uuid.UUID
+	FilePath      string
+	UploadID      string
+	FileSizeBytes int64   // As passed to StartMultipartUpload
+	PartNumbers   []int32 // 1-based; at most maxPartURLsPerRequest
+}
+
+// PartUploadURLs contains presigned part URLs and the parts already stored.
+type PartUploadURLs struct {
+	URLs          map[int32]string
+	UploadedParts []int32 // Lets a resumed client skip parts it already sent
+	ExpiresAt     time.Time
+}
+
+// GetPartUploadURLs presigns upload URLs for the requested parts.
+func (s *SMEService) GetPartUploadURLs(ctx context.Context, kratosID uuid.UUID, req GetPartUploadURLsRequest) (*PartUploadURLs, error) {
+	if err := validateMultipartFileSize(req.FileSizeBytes); err != nil {
+		return nil, err
+	}
+	if len(req.PartNumbers) > maxPartURLsPerRequest {
+		return nil, domainerrors.ErrInvalidInput.WithMessage(
+			fmt.Sprintf("at most %d part URLs can be requested at once", maxPartURLsPerRequest))
+	}
+	partCount := MultipartPartCount(req.FileSizeBytes)
+	for _, n := range req.PartNumbers {
+		if n < 1 || n > partCount {
+			return nil, domainerrors.ErrInvalidInput.WithMessage(
+				fmt.Sprintf("part number %d is outside 1-%d", n, partCount))
+		}
+	}
+
+	user, task, err := s.getUploadTask(ctx, kratosID, req.TaskID)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := taskUploadFileName(task, req.FilePath); !ok {
+		return nil, domainerrors.ErrInvalidInput.WithMessage("file_path must be in this task's upload folder")
+	}
+
+	uploaded, err := s.storage.ListUploadedParts(ctx, *user.TenantID, req.FilePath, req.UploadID)
+	if err != nil {
+		// Most likely an unknown or already aborted upload ID
+		s.logger.Warn("failed to list uploaded parts", "filePath", req.FilePath, "error", err)
+		return nil, domainerrors.ErrNotFound.WithMessage("upload not found; start a new upload")
+	}
+
+	result := &PartUploadURLs{
+		URLs:          make(map[int32]string, len(req.PartNumbers)),
+		UploadedParts: make([]int32, 0, len(uploaded)),
+		ExpiresAt:     time.Now().Add(partURLExpiry),
+	}
+	for _, part := range uploaded {
+		result.UploadedParts = append(result.UploadedParts, part.PartNumber)
+	}
+	for _, n := range req.PartNumbers {
+		url, err := s.storage.GeneratePartUploadURL(ctx, *user.TenantID, req.FilePath, req.UploadID, n, partURLExpiry)
+		if err != nil {
+			s.logger.Error("failed to generate part upload URL", "filePath", req.FilePath, "part", n, "error", err)
+			return nil, domainerrors.ErrInternal.WithCause(err)
+		}
+		result.URLs[n] = url
+	}
+	return result, nil
+}
+
+// CompleteMultipartUploadRequest contains the parameters for completing a multipart upload.
+type CompleteMultipartUploadRequest struct {
+	TaskID        uuid.UUID
+	FilePath      string
+	UploadID      string
+	FileSizeBytes int64 // As passed to StartMultipartUpload
+}
+
+// CompleteMultipartUpload checks every part was stored with the expected size and
+// assembles the file. Missing parts leave the upload open so the client can resume.
+func (s *SMEService) CompleteMultipartUpload(ctx context.Context, kratosID uuid.UUID, req CompleteMultipartUploadRequest) (string, error) {
+	log := s.logger.With("kratosID", kratosID, "taskID", req.TaskID, "filePath", req.FilePath)
+
+	if err := validateMultipartFileSize(req.FileSizeBytes); err != nil {
+		return "", err
+	}
+
+	user, task, err := s.getUploadTask(ctx, kratosID, req.TaskID)
+	if err != nil {
+		return "", err
+	}
+	if _, ok := taskUploadFileName(task, req.FilePath); !ok {
+		return "", domainerrors.ErrInvalidInput.WithMessage("file_path must be in this task's upload folder")
+	}
+
+	parts, err := s.storage.ListUploadedParts(ctx, *user.TenantID, req.FilePath, req.UploadID)
+	if err != nil {
+		log.Warn("failed to list uploaded parts", "error", err)
+		return "", domainerrors.ErrNotFound.WithMessage("upload not found; start a new upload")
+	}
+	if err := validateUploadedParts(parts, req.FileSizeBytes); err != nil {
+		return "", err
+	}
+
+	if err := s.storage.CompleteMultipartUpload(ctx, *user.TenantID, req.FilePath, req.UploadID, parts); err != nil {
+		log.Error("failed to complete multipart upload", "error", err)
+		return "", domainerrors.ErrInternal.WithCause(err)
+	}
+
+	log.Info("multipart upload completed", "parts", len(parts), "sizeBytes", req.FileSizeBytes)
+	return req.FilePath, nil
+}
+
+// validateUploadedParts checks the stored parts are numbered 1..N with every part
+// but the last exactly the expected part size, adding up to the declared file size.
+func validateUploadedParts(parts []storage.UploadedPart, fileSizeBytes int64) error {
+	partSize := MultipartPartSize(fileSizeBytes)
+	partCount := MultipartPartCount(fileSizeBytes)
+
+	if int32(len(parts)) != partCount {
+		return domainerrors.ErrInvalidInput.WithMessage(
+			fmt.Sprintf("upload incomplete: %d of %d parts uploaded", len(parts), partCount))
+	}
+	for i, part := range parts {
+		n := int32(i + 1)
+		if part.PartNumber != n {
+			return domainerrors.ErrInvalidInput.WithMessage(fmt.Sprintf("part %d is missing", n))
+		}
+		want := partSize
+		if n == partCount {
+			want = fileSizeBytes - partSize*int64(partCount-1)
+		}
+		if part.Size != want {
+			return domainerrors.ErrInvalidInput.WithMessage(
+				fmt.Sprintf("part %d is %d bytes, expected %d", n, part.Size, want))
+		}
+	}
+	return nil
+}
+
+// getUploadTask loads the caller and the task they are uploading for.
+func (s *SMEService) getUploadTask(ctx context.Context, kratosID, taskID uuid.UUID) (*entity.User, *entity.SMETask, error) {
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, nil, domainerrors.ErrUserNotFound
+	}
+	if user.TenantID == nil {
+		return nil, nil, domainerrors.ErrUserHasNoCompany
+	}
+
+	task, err := s.taskRepo.GetByID(ctx, taskID)
+	if err != nil || task == nil {
+		return nil, nil, domainerrors.ErrSMETaskNotFound
+	}
+	return user, task, nil
+}
@@ -3,26 +3,44 @@ package service
 import (
 	"context"
 	"fmt"
+	"io"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/sogos/mirai-backend/internal/domain/audit"
 	"github.com/sogos/mirai-backend/internal/domain/entity"
 	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/links"
 	"github.com/sogos/mirai-backend/internal/domain/repository"
 	"github.com/sogos/mirai-backend/internal/domain/service"
 	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+	"github.com/sogos/mirai-backend/internal/infrastructure/cache"
+	"github.com/sogos/mirai-backend/internal/infrastructure/storage"
 )
 
 // TenantStorageAdapter interface for storage operations.
 type TenantStorageAdapter interface {
 	GenerateUploadURL(ctx context.Context, tenantID uuid.UUID, subpath string, expiry time.Duration) (string, error)
+	// TagObject applies lifecycle tags to a file uploaded through a presigned URL.
+	TagObject(ctx context.Context, tenantID uuid.UUID, subpath string) error
+
+	// Multipart uploads for large submissions (see sme_multipart_upload.go)
+	CreateMultipartUpload(ctx context.Context, tenantID uuid.UUID, subpath, contentType string) (string, error)
+	GeneratePartUploadURL(ctx context.Context, tenantID uuid.UUID, subpath, uploadID string, partNumber int32, expiry time.Duration) (string, error)
+	ListUploadedParts(ctx context.Context, tenantID uuid.UUID, subpath, uploadID string) ([]storage.UploadedPart, error)
+	CompleteMultipartUpload(ctx context.Context, tenantID uuid.UUID, subpath, uploadID string, parts []storage.UploadedPart) error
+
+	// OpenContent reads an uploaded knowledge archive (see sme_knowledge_archive.go)
+	OpenContent(ctx context.Context, tenantID uuid.UUID, subpath string) (io.ReadCloser, error)
 }
 
 // TaskNotifier interface for sending notifications about task events.
 type TaskNotifier interface {
 	CreateNotification(ctx context.Context, req CreateNotificationRequest) (*entity.Notification, error)
-	// NotifyTaskAssigned sends both in-app notification and email when a task is assigned.
+	// NotifyTaskAssigned sends both in-app notification and email when a task is assigned
+	// or its due date changes.
 	NotifyTaskAssigned(ctx context.Context, req NotifyTaskAssignedRequest) error
 }
 
@@ -35,6 +53,13 @@ type NotifyTaskAssignedRequest struct {
 	SMEID          uuid.UUID
 	SMEName        string
 	DueDate        *time.Time
+	DueDateChanged bool     // The task was already assigned and only its due date changed
+	Questions      []string // Interview questions, shown so the SME can prepare
+}
+
+// IngestionJobCreator creates background jobs that ingest SME submissions.
+type IngestionJobCreator interface {
+	CreateIngestionJob(ctx context.Context, tenantID, submissionID, taskID, userID uuid.UUID) (*entity.GenerationJob, error)
 }
 
 // ContentEnhancer interface for AI content enhancement operations.
@@ -45,17 +70,30 @@ type ContentEnhancer interface {
 
 // SMEService handles Subject Matter Expert related business logic.
 type SMEService struct {
-	userRepo       repository.UserRepository
-	companyRepo    repository.CompanyRepository
-	teamRepo       repository.TeamRepository
-	smeRepo        repository.SMERepository
-	taskRepo       repository.SMETaskRepository
-	submissionRepo repository.SMESubmissionRepository
-	knowledgeRepo  repository.SMEKnowledgeRepository
-	storage        TenantStorageAdapter
-	notifier       TaskNotifier
-	enhancer       ContentEnhancer
-	logger         service.Logger
+	userRepo          repository.UserRepository
+	companyRepo       repository.CompanyRepository
+	teamRepo          repository.TeamRepository
+	smeRepo           repository.SMERepository
+	taskRepo          repository.SMETaskRepository
+	submissionRepo    repository.SMESubmissionRepository
+	knowledgeRepo     repository.SMEKnowledgeRepository
+	storage           TenantStorageAdapter
+	notifier          TaskNotifier
+	enhancer          ContentEnhancer
+	ingestion         IngestionJobCreator // Set once AI services are available
+	jobRepo           repository.GenerationJobRepository
+	knowledgeArchives KnowledgeArchiveJobCreator
+	superAdmins       SuperAdminChecker
+	summaryBackfill   KnowledgeSummaryBackfillEnqueuer
+	cache             cache.Cache
+	minChunks         int // Active SMEs below this chunk count are flagged as low coverage
+	auditLog          AuditLogger
+	logger            service.Logger
+
+	// Optional: generating interview questions with AI (see SetAIProvider)
+	aiProviderFactory AIProviderFactory
+	aiSettingsRepo    repository.TenantAISettingsRepository
+	questionLimiter   *userRateLimiter
 }
 
 // NewSMEService creates a new SME service.
@@ -70,6 +108,8 @@ func NewSMEService(
 	storage TenantStorageAdapter,
 	notifier TaskNotifier,
 	enhancer ContentEnhancer,
+	cache cache.Cache,
+	minChunks int,
 	logger service.Logger,
 ) *SMEService {
 	return &SMEService{
@@ -83,10 +123,23 @@ func NewSMEService(
 		storage:        storage,
 		notifier:       notifier,
 		enhancer:       enhancer,
+		cache:          cache,
+		minChunks:      minChunks,
 		logger:         logger,
 	}
 }
 
+// SetIngestionJobCreator wires in the ingestion service, which is only available
+// when AI services are configured.
+func (s *SMEService) SetIngestionJobCreator(ingestion IngestionJobCreator) {
+	s.ingestion = ingestion
+}
+
+// SetAuditLogger enables audit logging of knowledge chunk reviews.
+func (s *SMEService) SetAuditLogger(logger AuditLogger) {
+	s.auditLog = logger
+}
+
 // CreateSMERequest contains the parameters for creating an SME.
 type CreateSMERequest struct {
 	Name        string
@@ -259,8 +312,56 @@ func (s *SMEService) UpdateSME(ctx context.Context, kratosID uuid.UUID, smeID uu
 	return sme, nil
 }
 
-// DeleteSME deletes an SME entity.
-func (s *SMEService) DeleteSME(ctx context.Context, kratosID uuid.UUID, smeID uuid.UUID) error {
+// smeDeletionRecentCourses is how many course titles a deletion impact lists.
+const smeDeletionRecentCourses = 5
+
+// GetSMEDeletionImpact counts what depends on an SME and issues a token that confirms
+// its deletion. The token is valid for entity.SMEDeletionTokenTTL and only for this user.
+func (s *SMEService) GetSMEDeletionImpact(ctx context.Context, kratosID uuid.UUID, smeID uuid.UUID) (*entity.SMEDeletionImpact, *entity.SMEDeletionToken, error) {
+	log := s.logger.With("kratosID", kratosID, "smeID", smeID)
+
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, nil, domainerrors.ErrUserNotFound
+	}
+
+	if !user.CanManageSettings() {
+		return nil, nil, domainerrors.ErrForbidden.WithMessage("only admins can delete SMEs")
+	}
+
+	sme, err := s.smeRepo.GetByID(ctx, smeID)
+	if err != nil || sme == nil {
+		return nil, nil, domainerrors.ErrSMENotFound
+	}
+
+	impact, err := s.smeRepo.GetDeletionImpact(ctx, sme.ID, smeDeletionRecentCourses)
+	if err != nil {
+		log.Error("failed to get SME deletion impact", "error", err)
+		return nil, nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	tokenValue, err := generateSecureToken()
+	if err != nil {
+		return nil, nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	token := &entity.SMEDeletionToken{
+		Token:     tokenValue,
+		TenantID:  sme.TenantID,
+		SMEID:     sme.ID,
+		UserID:    user.ID,
+		ExpiresAt: time.Now().Add(entity.SMEDeletionTokenTTL),
+	}
+	if err := s.smeRepo.CreateDeletionToken(ctx, token); err != nil {
+		log.Error("failed to create SME deletion token", "error", err)
+		return nil, nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	return impact, token, nil
+}
+
+// DeleteSME deletes an SME entity. confirmationToken must come from GetSMEDeletionImpact
+// for the same SME and user, and is consumed whether or not the deletion succeeds.
+func (s *SMEService) DeleteSME(ctx context.Context, kratosID uuid.UUID, smeID uuid.UUID, confirmationToken string) error {
 	log := s.logger.With("kratosID", kratosID, "smeID", smeID)
 
 	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
@@ -268,8 +369,12 @@ func (s *SMEService) DeleteSME(ctx context.Context, kratosID uuid.UUID, smeID uu
 		return domainerrors.ErrUserNotFound
 	}
 
-	if !user.CanManageSME() {
-		return domainerrors.ErrForbidden.WithMessage("insufficient permissions to delete SME")
+	if !user.CanManageSettings() {
+		return domainerrors.ErrForbidden.WithMessage("only admins can delete SMEs")
+	}
+
+	if confirmationToken == "" {
+		return domainerrors.ErrInvalidInput.WithMessage("confirmation token is required; request the deletion impact first")
 	}
 
 	sme, err := s.smeRepo.GetByID(ctx, smeID)
@@ -277,6 +382,18 @@ func (s *SMEService) DeleteSME(ctx context.Context, kratosID uuid.UUID, smeID uu
 		return domainerrors.ErrSMENotFound
 	}
 
+	token, err := s.smeRepo.ConsumeDeletionToken(ctx, confirmationToken)
+	if err != nil {
+		log.Error("failed to consume SME deletion token", "error", err)
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+	if token == nil || token.SMEID != sme.ID || token.UserID != user.ID {
+		return domainerrors.ErrInvalidInput.WithMessage("invalid confirmation token")
+	}
+	if token.IsExpired() {
+		return domainerrors.ErrInvalidInput.WithMessage("confirmation token has expired; review the deletion impact again")
+	}
+
 	// Archive instead of hard delete
 	sme.Status = valueobject.SMEStatusArchived
 	if err := s.smeRepo.Update(ctx, sme); err != nil {
@@ -330,6 +447,7 @@ type CreateTaskRequest struct {
 	DueDate             *time.Time
 	AssignedToUserID    uuid.UUID
 	TeamID              *uuid.UUID
+	Questions           []string // Interview questions; the SME answers them instead of uploading
 }
 
 // CreateTask creates a delegated task for content submission.
@@ -354,6 +472,11 @@ func (s *SMEService) CreateTask(ctx context.Context, kratosID uuid.UUID, req Cre
 		return nil, domainerrors.ErrUserHasNoCompany
 	}
 
+	questions, err := normalizeTaskQuestions(req.Questions)
+	if err != nil {
+		return nil, err
+	}
+
 	task := &entity.SMETask{
 		TenantID:            *user.TenantID,
 		SMEID:               req.SMEID,
@@ -365,6 +488,7 @@ func (s *SMEService) CreateTask(ctx context.Context, kratosID uuid.UUID, req Cre
 		AssignedToUserID:    req.AssignedToUserID,
 		AssignedByUserID:    user.ID,
 		TeamID:              req.TeamID,
+		Questions:           questions,
 	}
 
 	if err := s.taskRepo.Create(ctx, task); err != nil {
@@ -382,6 +506,7 @@ func (s *SMEService) CreateTask(ctx context.Context, kratosID uuid.UUID, req Cre
 			SMEID:          task.SMEID,
 			SMEName:        sme.Name,
 			DueDate:        task.DueDate,
+			Questions:      task.Questions,
 		})
 		if err != nil {
 			log.Error("failed to send task notification", "error", err)
@@ -389,23 +514,30 @@ func (s *SMEService) CreateTask(ctx context.Context, kratosID uuid.UUID, req Cre
 		}
 	}
 
-	log.Info("task created", "taskID", task.ID)
+	log.Info("task created", "taskID", task.ID, "questions", len(task.Questions))
 	return task, nil
 }
 
-// GetTask retrieves a task by ID.
-func (s *SMEService) GetTask(ctx context.Context, kratosID uuid.UUID, taskID uuid.UUID) (*entity.SMETask, error) {
+// GetTask retrieves a task by ID along with a summary of its submissions.
+// The summary is nil if it could not be loaded.
+func (s *SMEService) GetTask(ctx context.Context, kratosID uuid.UUID, taskID uuid.UUID) (*entity.SMETask, *entity.SMESubmissionSummary, error) {
 	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
 	if err != nil || user == nil {
-		return nil, domainerrors.ErrUserNotFound
+		return nil, nil, domainerrors.ErrUserNotFound
 	}
 
 	task, err := s.taskRepo.GetByID(ctx, taskID)
 	if err != nil || task == nil {
-		return nil, domainerrors.ErrSMETaskNotFound
+		return nil, nil, domainerrors.ErrSMETaskNotFound
 	}
 
-	return task, nil
+	summary, err := s.submissionRepo.GetSummary(ctx, taskID)
+	if err != nil {
+		s.logger.Warn("failed to summarize task submissions", "taskID", taskID, "error", err)
+		summary = nil
+	}
+
+	return task, summary, nil
 }
 
 // ListTasks retrieves tasks based on filters.
@@ -474,7 +606,7 @@ func (s *SMEService) GetUploadURL(ctx context.Context, kratosID uuid.UUID, taskI
 	}
 
 	// Generate S3 path: tenants/{tenant_id}/sme/{sme_id}/submissions/{task_id}/{filename}
-	path := "sme/" + task.SMEID.String() + "/submissions/" + task.ID.String() + "/" + filename
+	path := taskUploadPrefix(task) + filename
 	url, err := s.storage.GenerateUploadURL(ctx, *user.TenantID, path, 15*time.Minute)
 	if err != nil {
 		s.logger.Error("failed to generate upload URL", "error", err)
@@ -484,6 +616,33 @@ func (s *SMEService) GetUploadURL(ctx context.Context, kratosID uuid.UUID, taskI
 	return url, path, nil
 }
 
+// taskUploadPrefix returns the tenant-relative folder that holds a task's uploads.
+func taskUploadPrefix(task *entity.SMETask) string {
+	return "sme/" + task.SMEID.String() + "/submissions/" + task.ID.String() + "/"
+}
+
+// taskUploadFileName returns the file name of a path in the task's upload folder,
+// or false if the path points anywhere else.
+func taskUploadFileName(task *entity.SMETask, filePath string) (string, bool) {
+	filename, ok := strings.CutPrefix(filePath, taskUploadPrefix(task))
+	if !ok || filename == "" || strings.Contains(filename, "/") {
+		return "", false
+	}
+	return filename, true
+}
+
+// tagUpload tags a presigned upload for storage lifecycle rules. Uploads bypass the
+// storage adapter, so this is the first point the server sees the object. Failures
+// only affect storage class, so they are logged rather than returned.
+func (s *SMEService) tagUpload(ctx context.Context, tenantID uuid.UUID, filePath string) {
+	if filePath == "" {
+		return
+	}
+	if err := s.storage.TagObject(ctx, tenantID, filePath); err != nil {
+		s.logger.Warn("failed to tag uploaded file", "path", filePath, "error", err)
+	}
+}
+
 // SubmitContentRequest contains the parameters for submitting content.
 type SubmitContentRequest struct {
 	TaskID        uuid.UUID
@@ -543,6 +702,10 @@ func (s *SMEService) SubmitContent(ctx context.Context, kratosID uuid.UUID, req
 		return nil, domainerrors.ErrInternal.WithCause(err)
 	}
 
+	if submission.ExtractedText == nil {
+		s.tagUpload(ctx, submission.TenantID, submission.FilePath)
+	}
+
 	// Update task status to awaiting review (human approval required)
 	task.Status = valueobject.SMETaskStatusAwaitingReview
 	if err := s.taskRepo.Update(ctx, task); err != nil {
@@ -551,7 +714,7 @@ func (s *SMEService) SubmitContent(ctx context.Context, kratosID uuid.UUID, req
 
 	// Notify the assigner that content has been submitted
 	if s.notifier != nil {
-		actionURL := "/smes?sme=" + task.SMEID.String() + "&task=" + task.ID.String()
+		actionURL := links.TaskLink(task.SMEID, task.ID)
 		_, err := s.notifier.CreateNotification(ctx, CreateNotificationRequest{
 			UserID:    task.AssignedByUserID,
 			Type:      valueobject.NotificationTypeSubmissionReadyForReview,
@@ -569,24 +732,69 @@ func (s *SMEService) SubmitContent(ctx context.Context, kratosID uuid.UUID, req
 	return submission, nil
 }
 
-// ListSubmissions retrieves all submissions for a task.
-func (s *SMEService) ListSubmissions(ctx context.Context, kratosID uuid.UUID, taskID uuid.UUID) ([]*entity.SMETaskSubmission, error) {
+// ListSubmissionsRequest contains the filters for listing a task's submissions.
+type ListSubmissionsRequest struct {
+	TaskID uuid.UUID
+	Status *valueobject.SubmissionStatus
+	Limit  int
+	Cursor string
+}
+
+// ListSubmissionsResult contains a page of submissions.
+type ListSubmissionsResult struct {
+	Submissions []*entity.SMETaskSubmission
+	NextCursor  string
+}
+
+// ListSubmissions retrieves a page of a task's submissions, newest first.
+func (s *SMEService) ListSubmissions(ctx context.Context, kratosID uuid.UUID, req ListSubmissionsRequest) (*ListSubmissionsResult, error) {
 	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
 	if err != nil || user == nil {
 		return nil, domainerrors.ErrUserNotFound
 	}
 
-	submissions, err := s.submissionRepo.ListByTaskID(ctx, taskID)
+	if req.Status != nil && !req.Status.IsValid() {
+		return nil, domainerrors.ErrInvalidInput.WithMessage("invalid submission status")
+	}
+
+	limit := req.Limit
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+
+	var cursor *string
+	if req.Cursor != "" {
+		cursor = &req.Cursor
+	}
+
+	submissions, err := s.submissionRepo.List(ctx, entity.SMESubmissionListOptions{
+		TaskID: req.TaskID,
+		Status: req.Status,
+		Limit:  limit,
+		Cursor: cursor,
+	})
 	if err != nil {
 		s.logger.Error("failed to list submissions", "error", err)
 		return nil, domainerrors.ErrInternal.WithCause(err)
 	}
 
-	return submissions, nil
+	var nextCursor string
+	if len(submissions) == limit {
+		last := submissions[len(submissions)-1]
+		nextCursor = fmt.Sprintf("%s|%s",
+			last.SubmittedAt.Format(time.RFC3339Nano),
+			last.ID.String())
+	}
+
+	return &ListSubmissionsResult{
+		Submissions: submissions,
+		NextCursor:  nextCursor,
+	}, nil
 }
 
-// GetKnowledge retrieves distilled knowledge for an SME.
-func (s *SMEService) GetKnowledge(ctx context.Context, kratosID uuid.UUID, smeID uuid.UUID) ([]*entity.SMEKnowledgeChunk, error) {
+// GetKnowledge retrieves distilled knowledge for an SME, only the chunks filed under
+// topic when it is set.
+func (s *SMEService) GetKnowledge(ctx context.Context, kratosID uuid.UUID, smeID uuid.UUID, topic *string) ([]*entity.SMEKnowledgeChunk, error) {
 	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
 	if err != nil || user == nil {
 		return nil, domainerrors.ErrUserNotFound
@@ -601,7 +809,12 @@ func (s *SMEService) GetKnowledge(ctx context.Context, kratosID uuid.UUID, smeID
 		return nil, domainerrors.ErrSMENoAccess
 	}
 
-	chunks, err := s.knowledgeRepo.ListBySMEID(ctx, smeID)
+	var chunks []*entity.SMEKnowledgeChunk
+	if topic != nil {
+		chunks, err = s.knowledgeRepo.ListBySMEIDAndTopic(ctx, smeID, *topic)
+	} else {
+		chunks, err = s.knowledgeRepo.ListBySMEID(ctx, smeID)
+	}
 	if err != nil {
 		s.logger.Error("failed to get knowledge", "error", err)
 		return nil, domainerrors.ErrInternal.WithCause(err)
@@ -610,6 +823,31 @@ func (s *SMEService) GetKnowledge(ctx context.Context, kratosID uuid.UUID, smeID
 	return chunks, nil
 }
 
+// ListKnowledgeTopics retrieves an SME's knowledge topics with chunk counts, largest first.
+func (s *SMEService) ListKnowledgeTopics(ctx context.Context, kratosID uuid.UUID, smeID uuid.UUID) ([]*entity.SMEKnowledgeTopic, error) {
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, domainerrors.ErrUserNotFound
+	}
+
+	sme, err := s.smeRepo.GetByID(ctx, smeID)
+	if err != nil || sme == nil {
+		return nil, domainerrors.ErrSMENotFound
+	}
+
+	if !s.userHasSMEAccess(ctx, user, sme) {
+		return nil, domainerrors.ErrSMENoAccess
+	}
+
+	topics, err := s.knowledgeRepo.ListTopics(ctx, smeID)
+	if err != nil {
+		s.logger.Error("failed to list knowledge topics", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	return topics, nil
+}
+
 // userHasSMEAccess checks if a user has access to an SME.
 func (s *SMEService) userHasSMEAccess(ctx context.Context, user *entity.User, sme *entity.SubjectMatterExpert) bool {
 	// Admins have access to all
@@ -647,6 +885,92 @@ func (s *SMEService) GetSubmission(ctx context.Context, kratosID uuid.UUID, subm
 	return submission, nil
 }
 
+// ReprocessSubmissionRequest contains the parameters for retrying a failed ingestion.
+type ReprocessSubmissionRequest struct {
+	SubmissionID        uuid.UUID
+	ReplacementFilePath *string // Fixed file uploaded via GetUploadURL; nil re-runs the original file
+}
+
+// ReprocessSubmission retries ingestion of a submission that previously failed.
+// Partial knowledge from the failed attempt is discarded before the new job is queued.
+func (s *SMEService) ReprocessSubmission(ctx context.Context, kratosID uuid.UUID, req ReprocessSubmissionRequest) (*entity.SMETaskSubmission, *entity.GenerationJob, error) {
+	log := s.logger.With("kratosID", kratosID, "submissionID", req.SubmissionID)
+
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, nil, domainerrors.ErrUserNotFound
+	}
+
+	if user.TenantID == nil {
+		return nil, nil, domainerrors.ErrUserHasNoCompany
+	}
+
+	submission, err := s.submissionRepo.GetByID(ctx, req.SubmissionID)
+	if err != nil || submission == nil {
+		return nil, nil, domainerrors.ErrSMESubmissionNotFound
+	}
+
+	task, err := s.taskRepo.GetByID(ctx, submission.TaskID)
+	if err != nil || task == nil {
+		return nil, nil, domainerrors.ErrSMETaskNotFound
+	}
+
+	// Only the assigner, the submitter, or an admin can retry
+	if !user.IsAdmin() && user.ID != task.AssignedByUserID && user.ID != submission.SubmittedByUserID {
+		return nil, nil, domainerrors.ErrForbidden
+	}
+
+	if submission.IngestionError == nil {
+		return nil, nil, domainerrors.ErrInvalidInput.WithMessage("only submissions with a failed ingestion can be reprocessed")
+	}
+
+	if s.ingestion == nil {
+		return nil, nil, domainerrors.ErrExternalService.WithMessage("content ingestion is not available")
+	}
+
+	if req.ReplacementFilePath != nil {
+		// Replacement must be uploaded to this task's submission folder (see GetUploadURL)
+		filename, ok := taskUploadFileName(task, *req.ReplacementFilePath)
+		if !ok {
+			return nil, nil, domainerrors.ErrInvalidInput.WithMessage("replacement file must be uploaded for this task")
+		}
+		submission.FilePath = *req.ReplacementFilePath
+		submission.FileName = filename
+		submission.ExtractedText = nil // Force extraction from the new file
+		s.tagUpload(ctx, submission.TenantID, submission.FilePath)
+	} else if submission.ContentType != valueobject.ContentTypeText {
+		submission.ExtractedText = nil // Re-extract in case extraction produced bad text
+	}
+
+	// Discard anything the failed attempt left behind
+	if err := s.knowledgeRepo.DeleteBySubmissionID(ctx, submission.ID); err != nil {
+		log.Error("failed to delete partial knowledge chunks", "error", err)
+		return nil, nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	submission.IngestionError = nil
+	submission.AISummary = nil
+	submission.ProcessedAt = nil
+	if err := s.submissionRepo.Update(ctx, submission); err != nil {
+		log.Error("failed to reset submission", "error", err)
+		return nil, nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	job, err := s.ingestion.CreateIngestionJob(ctx, *user.TenantID, submission.ID, task.ID, user.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	task.Status = valueobject.SMETaskStatusProcessing
+	task.CompletedAt = nil
+	if err := s.taskRepo.Update(ctx, task); err != nil {
+		log.Error("failed to update task status", "error", err)
+	}
+
+	log.Info("submission queued for reprocessing", "jobID", job.ID, "replacedFile", req.ReplacementFilePath != nil)
+	return submission, job, nil
+}
+
 // ApproveSubmissionRequest contains the parameters for approving a submission.
 type ApproveSubmissionRequest struct {
 	SubmissionID    uuid.UUID
@@ -728,8 +1052,9 @@ func (s *SMEService) ApproveSubmission(ctx context.Context, kratosID uuid.UUID,
 		smeNeedsUpdate = true
 	}
 
-	// Aggregate all knowledge chunks into a summary
+	// Aggregate the knowledge chunks generation may use into a summary
 	allChunks, err := s.knowledgeRepo.ListBySMEID(ctx, task.SMEID)
+	allChunks = generationChunks(allChunks)
 	if err != nil {
 		log.Error("failed to list knowledge chunks for summary", "error", err)
 	} else if len(allChunks) > 0 {
@@ -826,7 +1151,7 @@ func (s *SMEService) RequestSubmissionChanges(ctx context.Context, kratosID uuid
 
 	// Notify the submitter
 	if s.notifier != nil {
-		actionURL := "/smes?sme=" + task.SMEID.String() + "&task=" + task.ID.String()
+		actionURL := links.TaskLink(task.SMEID, task.ID)
 		_, err := s.notifier.CreateNotification(ctx, CreateNotificationRequest{
 			UserID:    submission.SubmittedByUserID,
 			Type:      valueobject.NotificationTypeChangesRequested,
@@ -881,7 +1206,9 @@ func (s *SMEService) UpdateTask(ctx context.Context, kratosID uuid.UUID, taskID
 	if req.ExpectedContentType != nil {
 		task.ExpectedContentType = req.ExpectedContentType
 	}
+	dueDateChanged := false
 	if req.DueDate != nil {
+		dueDateChanged = task.DueDate == nil || !task.DueDate.Equal(*req.DueDate)
 		task.DueDate = req.DueDate
 	}
 
@@ -890,7 +1217,27 @@ func (s *SMEService) UpdateTask(ctx context.Context, kratosID uuid.UUID, taskID
 		return nil, domainerrors.ErrInternal.WithCause(err)
 	}
 
-	log.Info("task updated")
+	// Resend the assignment so the calendar invite moves to the new due date
+	if dueDateChanged && s.notifier != nil && task.Status.AwaitsAssignee() {
+		sme, err := s.smeRepo.GetByID(ctx, task.SMEID)
+		if err != nil || sme == nil {
+			log.Warn("failed to get SME for due date notification", "error", err)
+		} else if err := s.notifier.NotifyTaskAssigned(ctx, NotifyTaskAssignedRequest{
+			AssigneeUserID: task.AssignedToUserID,
+			AssignerUserID: user.ID,
+			TaskID:         task.ID,
+			TaskTitle:      task.Title,
+			SMEID:          task.SMEID,
+			SMEName:        sme.Name,
+			DueDate:        task.DueDate,
+			DueDateChanged: true,
+			Questions:      task.Questions,
+		}); err != nil {
+			log.Error("failed to send due date notification", "error", err)
+		}
+	}
+
+	log.Info("task updated", "dueDateChanged", dueDateChanged)
 	return task, nil
 }
 
@@ -951,7 +1298,7 @@ func (s *SMEService) UpdateKnowledgeChunk(ctx context.Context, kratosID uuid.UUI
 	// Apply updates
 	chunk.Content = req.Content
 	if req.Topic != nil {
-		chunk.Topic = *req.Topic
+		chunk.Topic = entity.NormalizeKnowledgeTopic(*req.Topic)
 	}
 	if req.Keywords != nil {
 		chunk.Keywords = req.Keywords
@@ -988,6 +1335,69 @@ func (s *SMEService) DeleteKnowledgeChunk(ctx context.Context, kratosID uuid.UUI
 	return nil
 }
 
+// ReviewFlaggedKnowledgeChunkRequest contains the parameters for reviewing a chunk flagged
+// as a possible prompt injection.
+type ReviewFlaggedKnowledgeChunkRequest struct {
+	ChunkID uuid.UUID
+	Release bool // Let the chunk into generation prompts; false holds it back again
+}
+
+// ReviewFlaggedKnowledgeChunk lets an admin release a flagged knowledge chunk into
+// generation after reviewing it, or withdraw an earlier release.
+func (s *SMEService) ReviewFlaggedKnowledgeChunk(ctx context.Context, kratosID uuid.UUID, req ReviewFlaggedKnowledgeChunkRequest) (*entity.SMEKnowledgeChunk, error) {
+	log := s.logger.With("kratosID", kratosID, "chunkID", req.ChunkID, "release", req.Release)
+
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, domainerrors.ErrUserNotFound
+	}
+
+	if !user.IsAdmin() {
+		return nil, domainerrors.ErrForbidden.WithMessage("only admins can review flagged knowledge")
+	}
+
+	chunk, err := s.knowledgeRepo.GetByID(ctx, req.ChunkID)
+	if err != nil || chunk == nil {
+		return nil, domainerrors.ErrNotFound.WithMessage("knowledge chunk not found")
+	}
+
+	if !chunk.InjectionFlagged {
+		return nil, domainerrors.ErrBadRequest.WithMessage("knowledge chunk is not flagged")
+	}
+
+	wasReleased := chunk.InjectionReleasedAt != nil
+	if wasReleased == req.Release {
+		return chunk, nil
+	}
+
+	if req.Release {
+		now := time.Now()
+		chunk.InjectionReleasedByUserID = &user.ID
+		chunk.InjectionReleasedAt = &now
+	} else {
+		chunk.InjectionReleasedByUserID = nil
+		chunk.InjectionReleasedAt = nil
+	}
+
+	entry := audit.Entry{
+		TenantID:    chunk.TenantID,
+		ActorUserID: &user.ID,
+		Action:      audit.ActionKnowledgeInjectionReviewed,
+		TargetType:  audit.TargetKnowledgeChunk,
+		TargetID:    chunk.ID.String(),
+		Changes:     audit.Changes{}.Field("released", wasReleased, req.Release),
+	}
+	if err := audited(ctx, s.auditLog, &entry, func(ctx context.Context) error {
+		return s.knowledgeRepo.SetInjectionRelease(ctx, chunk)
+	}); err != nil {
+		log.Error("failed to update flagged knowledge chunk", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	log.Info("flagged knowledge chunk reviewed")
+	return chunk, nil
+}
+
 // EnhanceSubmissionContentRequest contains the parameters for AI enhancement.
 type EnhanceSubmissionContentRequest struct {
 	SubmissionID uuid.UUID
@@ -1056,3 +1466,94 @@ func (s *SMEService) EnhanceSubmissionContent(ctx context.Context, kratosID uuid
 		OriginalContent: originalContent,
 	}, nil
 }
+
+// smeStatsCacheTTL is how long aggregated SME stats are cached per tenant.
+const smeStatsCacheTTL = 15 * time.Minute
+
+// SMEStatsEntry pairs an SME with its contribution stats.
+type SMEStatsEntry struct {
+	SME         *entity.SubjectMatterExpert
+	Stats       *entity.SMEStats
+	LowCoverage bool // Active SME with fewer chunks than the configured minimum
+}
+
+// GetSMEStats returns contribution stats for all accessible SMEs, ordered by knowledge chunk count.
+func (s *SMEService) GetSMEStats(ctx context.Context, kratosID uuid.UUID) ([]SMEStatsEntry, int, error) {
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, 0, domainerrors.ErrUserNotFound
+	}
+
+	if user.CompanyID == nil {
+		return nil, 0, domainerrors.ErrUserHasNoCompany
+	}
+
+	if !user.CanManageSME() {
+		return nil, 0, domainerrors.ErrForbidden.WithMessage("insufficient permissions to view SME stats")
+	}
+
+	smes, err := s.smeRepo.List(ctx, entity.SMEListOptions{})
+	if err != nil {
+		s.logger.Error("failed to list SMEs", "error", err)
+		return nil, 0, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	stats, err := s.loadSMEStats(ctx)
+	if err != nil {
+		s.logger.Error("failed to get SME stats", "error", err)
+		return nil, 0, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	statsByID := make(map[uuid.UUID]*entity.SMEStats, len(stats))
+	for _, st := range stats {
+		statsByID[st.SMEID] = st
+	}
+
+	entries := make([]SMEStatsEntry, 0, len(smes))
+	for _, sme := range smes {
+		if sme.Status == valueobject.SMEStatusArchived || !s.userHasSMEAccess(ctx, user, sme) {
+			continue
+		}
+		st, ok := statsByID[sme.ID]
+		if !ok {
+			st = &entity.SMEStats{SMEID: sme.ID, TaskCounts: map[valueobject.SMETaskStatus]int{}}
+		}
+		entries = append(entries, SMEStatsEntry{
+			SME:         sme,
+			Stats:       st,
+			LowCoverage: sme.Status == valueobject.SMEStatusActive && st.ChunkCount < s.minChunks,
+		})
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].Stats.ChunkCount != entries[j].Stats.ChunkCount {
+			return entries[i].Stats.ChunkCount > entries[j].Stats.ChunkCount
+		}
+		return entries[i].SME.Name < entries[j].SME.Name
+	})
+
+	return entries, s.minChunks, nil
+}
+
+// loadSMEStats returns the tenant's aggregated SME stats, served from cache when fresh.
+func (s *SMEService) loadSMEStats(ctx context.Context) ([]*entity.SMEStats, error) {
+	if s.cache != nil {
+		var cached []*entity.SMEStats
+		if entry, err := s.cache.Get(ctx, cache.TenantCacheKeys.SMEStats(), &cached); err == nil && entry != nil {
+			return cached, nil
+		}
+	}
+
+	stats, err := s.smeRepo.GetStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.cache != nil {
+		if _, err := s.cache.Set(ctx, cache.TenantCacheKeys.SMEStats(), stats, "", smeStatsCacheTTL); err != nil {
+			s.logger.Warn("failed to cache SME stats", "error", err)
+		}
+	}
+
+	return stats, nil
+}
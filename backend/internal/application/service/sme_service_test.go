@@ -0,0 +1,278 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+	"github.com/sogos/mirai-backend/internal/domain/tenant"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+// fakeSMERepo keeps SMEs and deletion tokens in memory.
+type fakeSMERepo struct {
+	repository.SMERepository
+	smes   map[uuid.UUID]*entity.SubjectMatterExpert
+	tokens map[string]*entity.SMEDeletionToken
+}
+
+func (r *fakeSMERepo) GetByID(_ context.Context, id uuid.UUID) (*entity.SubjectMatterExpert, error) {
+	if sme, ok := r.smes[id]; ok {
+		copied := *sme
+		return &copied, nil
+	}
+	return nil, nil
+}
+
+func (r *fakeSMERepo) Update(_ context.Context, sme *entity.SubjectMatterExpert) error {
+	copied := *sme
+	r.smes[sme.ID] = &copied
+	return nil
+}
+
+func (r *fakeSMERepo) GetDeletionImpact(_ context.Context, _ uuid.UUID, _ int) (*entity.SMEDeletionImpact, error) {
+	return &entity.SMEDeletionImpact{KnowledgeChunks: 12, Courses: 1, RecentCourseTitles: []string{"Food safety"}}, nil
+}
+
+func (r *fakeSMERepo) CreateDeletionToken(_ context.Context, token *entity.SMEDeletionToken) error {
+	copied := *token
+	r.tokens[token.Token] = &copied
+	return nil
+}
+
+func (r *fakeSMERepo) ConsumeDeletionToken(_ context.Context, token string) (*entity.SMEDeletionToken, error) {
+	t, ok := r.tokens[token]
+	if !ok {
+		return nil, nil
+	}
+	delete(r.tokens, token)
+	return t, nil
+}
+
+// smeDeletionFixture is an SMEService with one active SME in a company with a user of
+// each role.
+type smeDeletionFixture struct {
+	ctx   context.Context
+	svc   *SMEService
+	smes  *fakeSMERepo
+	sme   *entity.SubjectMatterExpert
+	other *entity.SubjectMatterExpert
+	users map[valueobject.Role]*entity.User
+}
+
+func newSMEDeletionFixture() *smeDeletionFixture {
+	tenantID, companyID := uuid.New(), uuid.New()
+	newSME := func(name string) *entity.SubjectMatterExpert {
+		return &entity.SubjectMatterExpert{ID: uuid.New(), TenantID: tenantID, CompanyID: companyID, Name: name, Status: valueobject.SMEStatusActive}
+	}
+	f := &smeDeletionFixture{
+		ctx:   tenant.WithTenantID(context.Background(), tenantID),
+		sme:   newSME("Food safety"),
+		other: newSME("Forklifts"),
+		users: make(map[valueobject.Role]*entity.User),
+	}
+	f.smes = &fakeSMERepo{
+		smes:   map[uuid.UUID]*entity.SubjectMatterExpert{f.sme.ID: f.sme, f.other.ID: f.other},
+		tokens: make(map[string]*entity.SMEDeletionToken),
+	}
+
+	users := &fakeUserRepo{}
+	for _, role := range []valueobject.Role{valueobject.RoleAdmin, valueobject.RoleOwner, valueobject.RoleInstructor, valueobject.RoleSME, valueobject.RoleMember} {
+		user := &entity.User{ID: uuid.New(), TenantID: &tenantID, KratosID: uuid.New(), CompanyID: &companyID, Role: role, IsActive: true}
+		users.users = append(users.users, user)
+		f.users[role] = user
+	}
+
+	f.svc = NewSMEService(users, nil, nil, f.smes, nil, nil, nil, nil, nil, nil, nil, 0, nopLogger{})
+	return f
+}
+
+// status returns the stored status of the fixture's SME.
+func (f *smeDeletionFixture) status() valueobject.SMEStatus {
+	return f.smes.smes[f.sme.ID].Status
+}
+
+func TestSMEDeletionPermissions(t *testing.T) {
+	tests := []struct {
+		role    valueobject.Role
+		allowed bool
+	}{
+		{valueobject.RoleAdmin, true},
+		{valueobject.RoleOwner, true},
+		{valueobject.RoleInstructor, false},
+		{valueobject.RoleSME, false},
+		{valueobject.RoleMember, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.role), func(t *testing.T) {
+			f := newSMEDeletionFixture()
+			user := f.users[tt.role]
+
+			impact, token, err := f.svc.GetSMEDeletionImpact(f.ctx, user.KratosID, f.sme.ID)
+			if !tt.allowed {
+				if !errors.Is(err, domainerrors.ErrForbidden) {
+					t.Errorf("impact error = %v, want ErrForbidden", err)
+				}
+				if token != nil || len(f.smes.tokens) != 0 {
+					t.Error("a deletion token was issued")
+				}
+
+				// Nor may the role delete with a token issued to an admin
+				_, adminToken, err := f.svc.GetSMEDeletionImpact(f.ctx, f.users[valueobject.RoleAdmin].KratosID, f.sme.ID)
+				if err != nil {
+					t.Fatalf("admin impact: %v", err)
+				}
+				if err := f.svc.DeleteSME(f.ctx, user.KratosID, f.sme.ID, adminToken.Token); !errors.Is(err, domainerrors.ErrForbidden) {
+					t.Errorf("delete error = %v, want ErrForbidden", err)
+				}
+				if f.status() != valueobject.SMEStatusActive {
+					t.Errorf("SME status = %s, want it still active", f.status())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("impact: %v", err)
+			}
+			if impact.KnowledgeChunks != 12 || token.UserID != user.ID || token.SMEID != f.sme.ID {
+				t.Errorf("impact %+v, token for user %s SME %s; want the repo's impact and a token for %s, %s",
+					impact, token.UserID, token.SMEID, user.ID, f.sme.ID)
+			}
+			if ttl := time.Until(token.ExpiresAt); ttl <= 0 || ttl > time.Duration(float64(entity.SMEDeletionTokenTTL)*1.01) {
+				t.Errorf("token expires in %s, want about %s", ttl, entity.SMEDeletionTokenTTL)
+			}
+			if err := f.svc.DeleteSME(f.ctx, user.KratosID, f.sme.ID, token.Token); err != nil {
+				t.Fatalf("delete: %v", err)
+			}
+			if f.status() != valueobject.SMEStatusArchived {
+				t.Errorf("SME status = %s, want archived", f.status())
+			}
+		})
+	}
+}
+
+func TestDeleteSMEConfirmationToken(t *testing.T) {
+	tests := []struct {
+		name string
+		// token returns the confirmation token to delete the fixture's SME with as admin
+		token   func(t *testing.T, f *smeDeletionFixture) string
+		wantErr string // Message of the ErrInvalidInput returned; empty for success
+	}{
+		{
+			name:  "fresh token",
+			token: impactToken(valueobject.RoleAdmin, nil),
+		},
+		{
+			name:    "no token",
+			token:   func(*testing.T, *smeDeletionFixture) string { return "" },
+			wantErr: "confirmation token is required",
+		},
+		{
+			name:    "unknown token",
+			token:   func(*testing.T, *smeDeletionFixture) string { return "not-a-token" },
+			wantErr: "invalid confirmation token",
+		},
+		{
+			name: "expired token",
+			token: impactToken(valueobject.RoleAdmin, func(token *entity.SMEDeletionToken) {
+				token.ExpiresAt = time.Now().Add(-time.Second)
+			}),
+			wantErr: "confirmation token has expired",
+		},
+		{
+			name: "token about to expire",
+			token: impactToken(valueobject.RoleAdmin, func(token *entity.SMEDeletionToken) {
+				token.ExpiresAt = time.Now().Add(time.Minute)
+			}),
+		},
+		{
+			name:    "token issued to another admin",
+			token:   impactToken(valueobject.RoleOwner, nil),
+			wantErr: "invalid confirmation token",
+		},
+		{
+			name: "token for another SME",
+			token: func(t *testing.T, f *smeDeletionFixture) string {
+				_, token, err := f.svc.GetSMEDeletionImpact(f.ctx, f.users[valueobject.RoleAdmin].KratosID, f.other.ID)
+				if err != nil {
+					t.Fatalf("impact: %v", err)
+				}
+				return token.Token
+			},
+			wantErr: "invalid confirmation token",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newSMEDeletionFixture()
+			token := tt.token(t, f)
+
+			err := f.svc.DeleteSME(f.ctx, f.users[valueobject.RoleAdmin].KratosID, f.sme.ID, token)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if f.status() != valueobject.SMEStatusArchived {
+					t.Errorf("SME status = %s, want archived", f.status())
+				}
+				return
+			}
+
+			if !errors.Is(err, domainerrors.ErrInvalidInput) || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("error = %v, want ErrInvalidInput %q", err, tt.wantErr)
+			}
+			if f.status() != valueobject.SMEStatusActive {
+				t.Errorf("SME status = %s, want it still active", f.status())
+			}
+			if token != "" && f.smes.tokens[token] != nil {
+				t.Error("rejected token was not consumed")
+			}
+		})
+	}
+}
+
+func TestDeleteSMETokenIsSingleUse(t *testing.T) {
+	f := newSMEDeletionFixture()
+	admin := f.users[valueobject.RoleAdmin]
+	_, token, err := f.svc.GetSMEDeletionImpact(f.ctx, admin.KratosID, f.sme.ID)
+	if err != nil {
+		t.Fatalf("impact: %v", err)
+	}
+	if err := f.svc.DeleteSME(f.ctx, admin.KratosID, f.sme.ID, token.Token); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+
+	// Restored, the SME can't be deleted again with the same confirmation
+	f.smes.smes[f.sme.ID].Status = valueobject.SMEStatusActive
+	if err := f.svc.DeleteSME(f.ctx, admin.KratosID, f.sme.ID, token.Token); !errors.Is(err, domainerrors.ErrInvalidInput) {
+		t.Errorf("second delete error = %v, want ErrInvalidInput", err)
+	}
+	if f.status() != valueobject.SMEStatusActive {
+		t.Errorf("SME status = %s, want it still active", f.status())
+	}
+}
+
+// impactToken returns a token source that requests the deletion impact as role and
+// applies modify to the stored token.
+func impactToken(role valueobject.Role, modify func(*entity.SMEDeletionToken)) func(*testing.T, *smeDeletionFixture) string {
+	return func(t *testing.T, f *smeDeletionFixture) string {
+		t.Helper()
+		_, token, err := f.svc.GetSMEDeletionImpact(f.ctx, f.users[role].KratosID, f.sme.ID)
+		if err != nil {
+			t.Fatalf("impact: %v", err)
+		}
+		if modify != nil {
+			modify(f.smes.tokens[token.Token])
+		}
+		return token.Token
+	}
+}
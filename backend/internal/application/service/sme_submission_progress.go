@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+)
+
+// SubmissionProgressPublisher pushes ingestion progress to users' notification streams.
+type SubmissionProgressPublisher interface {
+	PublishSubmissionProgress(ctx context.Context, userIDs []uuid.UUID, progress *entity.SubmissionProgress)
+}
+
+// SetSubmissionProgressPublisher enables pushing ingestion progress to the submitter and
+// the task's assigner as each stage starts. Without it, progress is only recorded on the
+// job for GetSubmissionStatus.
+func (s *SMEIngestionService) SetSubmissionProgressPublisher(publisher SubmissionProgressPublisher) {
+	s.progress = publisher
+}
+
+// setProgress records an ingestion stage on the job and pushes it to the submission's
+// followers.
+func (s *SMEIngestionService) setProgress(ctx context.Context, job *entity.GenerationJob, submission *entity.SMETaskSubmission, task *entity.SMETask, percent int32, message string) {
+	job.ProgressPercent = percent
+	job.ProgressMessage = &message
+	if err := s.jobRepo.Update(ctx, job); err != nil {
+		s.logger.Warn("failed to record ingestion progress", "jobID", job.ID, "error", err)
+	}
+	s.publishProgress(ctx, job, submission, task)
+}
+
+// publishProgress pushes the job's progress to the submitter, the task's assigner, and
+// whoever started the job. The submission and task are loaded when nil.
+func (s *SMEIngestionService) publishProgress(ctx context.Context, job *entity.GenerationJob, submission *entity.SMETaskSubmission, task *entity.SMETask) {
+	if s.progress == nil || job.SubmissionID == nil {
+		return
+	}
+	if submission == nil {
+		loaded, err := s.submissionRepo.GetByID(ctx, *job.SubmissionID)
+		if err != nil || loaded == nil {
+			return
+		}
+		submission = loaded
+	}
+	if task == nil {
+		loaded, err := s.taskRepo.GetByID(ctx, submission.TaskID)
+		if err != nil || loaded == nil {
+			return
+		}
+		task = loaded
+	}
+
+	progress := entity.NewSubmissionProgress(submission, job)
+	progress.UpdatedAt = time.Now()
+
+	recipients := []uuid.UUID{submission.SubmittedByUserID}
+	for _, userID := range []uuid.UUID{task.AssignedByUserID, job.CreatedByUserID} {
+		if !slices.Contains(recipients, userID) {
+			recipients = append(recipients, userID)
+		}
+	}
+	s.progress.PublishSubmissionProgress(ctx, recipients, progress)
+}
+
+// SetIngestionJobRepository enables GetSubmissionStatus to report ingestion job progress.
+// Without it, every submission reports that ingestion hasn't started.
+func (s *SMEService) SetIngestionJobRepository(jobRepo repository.GenerationJobRepository) {
+	s.jobRepo = jobRepo
+}
+
+// GetSubmissionStatus returns how far a submission's latest ingestion job has got.
+func (s *SMEService) GetSubmissionStatus(ctx context.Context, kratosID uuid.UUID, submissionID uuid.UUID) (*entity.SubmissionProgress, error) {
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, domainerrors.ErrUserNotFound
+	}
+
+	submission, err := s.submissionRepo.GetByID(ctx, submissionID)
+	if err != nil || submission == nil {
+		return nil, domainerrors.ErrSMESubmissionNotFound
+	}
+
+	var job *entity.GenerationJob
+	if s.jobRepo != nil {
+		job, err = s.jobRepo.GetLatestBySubmissionID(ctx, submissionID)
+		if err != nil {
+			s.logger.Error("failed to get submission ingestion job", "submissionID", submissionID, "error", err)
+			return nil, domainerrors.ErrInternal.WithCause(err)
+		}
+	}
+
+	return entity.NewSubmissionProgress(submission, job), nil
+}
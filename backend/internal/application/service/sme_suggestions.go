@@ -0,0 +1,260 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+	"github.com/sogos/mirai-backend/internal/infrastructure/cache"
+)
+
+const (
+	// SMESuggestionLimit is the most SMEs a suggestion returns.
+	SMESuggestionLimit = 5
+
+	// smeSuggestionMaxMatchedTerms bounds the matched terms reported for each suggestion.
+	smeSuggestionMaxMatchedTerms = 5
+
+	// smeKeywordSetTTL is how long an SME's keyword set is cached. The key includes the
+	// SME's modification time and chunk count, so edited profiles and added or removed
+	// knowledge are picked up at once; keywords edited on existing chunks within the TTL.
+	smeKeywordSetTTL = time.Hour
+)
+
+// Weights of the places an SME's terms come from. The name and domain say most
+// directly what the SME covers; the summary and description mention far more in passing.
+const (
+	smeTermWeightDomain  = 1.0
+	smeTermWeightKeyword = 0.8
+	smeTermWeightSummary = 0.5
+)
+
+// SMESuggestion is an SME suggested for a course goal.
+type SMESuggestion struct {
+	SME          *entity.SubjectMatterExpert
+	Relevance    float64  // Weighted share of the goal's key terms the SME covers, 0-1
+	MatchedTerms []string // Key terms of the goal the SME covers, strongest first
+}
+
+// smeKeywordSet is an SME's vocabulary, precomputed from its profile and knowledge:
+// word stems mapped to the weight of the strongest place each appears.
+type smeKeywordSet struct {
+	Terms map[string]float64 `json:"terms"`
+}
+
+// SuggestSMEs suggests the active SMEs the user can access whose domain, summary and
+// knowledge keywords best match the course title and desired outcome, most relevant
+// first. At most SMESuggestionLimit are returned, and none that match no key term.
+// Like the coverage check it is a keyword heuristic, so SMEs that use different words
+// for the same subject are missed.
+func (s *SMEService) SuggestSMEs(ctx context.Context, kratosID uuid.UUID, courseTitle, desiredOutcome string) ([]SMESuggestion, error) {
+	if strings.TrimSpace(desiredOutcome) == "" && strings.TrimSpace(courseTitle) == "" {
+		return nil, domainerrors.ErrMissingRequired.WithMessage("desired outcome or course title is required")
+	}
+
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, domainerrors.ErrUserNotFound
+	}
+	if user.CompanyID == nil {
+		return nil, domainerrors.ErrUserHasNoCompany
+	}
+
+	terms := coverageTerms(courseTitle + " " + desiredOutcome)
+	if len(terms) == 0 {
+		return nil, nil
+	}
+
+	candidates, err := s.suggestableSMEs(ctx, user)
+	if err != nil {
+		s.logger.Error("failed to list SMEs for suggestions", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	chunkCounts := make(map[uuid.UUID]int)
+	stats, err := s.loadSMEStats(ctx)
+	if err != nil {
+		s.logger.Error("failed to get SME stats for suggestions", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	for _, st := range stats {
+		chunkCounts[st.SMEID] = st.ChunkCount
+	}
+
+	sets := make([]smeKeywordSet, len(candidates))
+	for i, sme := range candidates {
+		set, err := s.smeKeywordSet(ctx, sme, chunkCounts[sme.ID])
+		if err != nil {
+			s.logger.Error("failed to build SME keyword set", "smeID", sme.ID, "error", err)
+			return nil, domainerrors.ErrInternal.WithCause(err)
+		}
+		sets[i] = set
+	}
+
+	// Terms most candidates share say little about which of them to pick
+	stems := make([]string, len(terms))
+	idf := make([]float64, len(terms))
+	total := 0.0
+	for i, term := range terms {
+		stems[i] = coverageStem(term)
+		df := 0
+		for _, set := range sets {
+			if set.Terms[stems[i]] > 0 {
+				df++
+			}
+		}
+		idf[i] = math.Log(1 + float64(len(sets)+1)/float64(df+1))
+		total += idf[i]
+	}
+
+	type termMatch struct {
+		term   string
+		weight float64
+	}
+	var suggestions []SMESuggestion
+	for i, sme := range candidates {
+		var score float64
+		var matches []termMatch
+		for j, term := range terms {
+			if w := sets[i].Terms[stems[j]]; w > 0 {
+				score += w * idf[j]
+				matches = append(matches, termMatch{term: term, weight: w * idf[j]})
+			}
+		}
+		if len(matches) == 0 {
+			continue
+		}
+
+		slices.SortStableFunc(matches, func(a, b termMatch) int {
+			return cmp.Compare(b.weight, a.weight)
+		})
+		matched := make([]string, 0, min(len(matches), smeSuggestionMaxMatchedTerms))
+		for _, m := range matches[:min(len(matches), smeSuggestionMaxMatchedTerms)] {
+			matched = append(matched, m.term)
+		}
+		suggestions = append(suggestions, SMESuggestion{
+			SME:          sme,
+			Relevance:    score / total,
+			MatchedTerms: matched,
+		})
+	}
+
+	slices.SortFunc(suggestions, func(a, b SMESuggestion) int {
+		if c := cmp.Compare(b.Relevance, a.Relevance); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.SME.Name, b.SME.Name)
+	})
+	if len(suggestions) > SMESuggestionLimit {
+		suggestions = suggestions[:SMESuggestionLimit]
+	}
+	return suggestions, nil
+}
+
+// suggestableSMEs returns the active SMEs the user can access. Team-scoped SMEs are
+// left out unless the user belongs to one of their teams; admins see every SME.
+func (s *SMEService) suggestableSMEs(ctx context.Context, user *entity.User) ([]*entity.SubjectMatterExpert, error) {
+	status := valueobject.SMEStatusActive
+	smes, err := s.smeRepo.List(ctx, entity.SMEListOptions{Status: &status})
+	if err != nil {
+		return nil, err
+	}
+
+	// Team memberships already looked up, by team
+	member := make(map[uuid.UUID]bool)
+	accessible := make([]*entity.SubjectMatterExpert, 0, len(smes))
+	for _, sme := range smes {
+		if sme.Status != valueobject.SMEStatusActive || !s.userHasSMEAccess(ctx, user, sme) {
+			continue
+		}
+		if sme.Scope == valueobject.SMEScopeTeam && !user.IsAdmin() {
+			ok, err := s.userInSMETeam(ctx, user, sme, member)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+		}
+		accessible = append(accessible, sme)
+	}
+	return accessible, nil
+}
+
+// userInSMETeam reports whether the user belongs to one of the teams given access to
+// a team-scoped SME, recording each membership looked up in member.
+func (s *SMEService) userInSMETeam(ctx context.Context, user *entity.User, sme *entity.SubjectMatterExpert, member map[uuid.UUID]bool) (bool, error) {
+	access, err := s.smeRepo.ListTeamAccess(ctx, sme.ID)
+	if err != nil {
+		return false, fmt.Errorf("list team access for SME %s: %w", sme.ID, err)
+	}
+	for _, a := range access {
+		in, seen := member[a.TeamID]
+		if !seen {
+			m, err := s.teamRepo.GetMember(ctx, a.TeamID, user.ID)
+			if err != nil {
+				return false, fmt.Errorf("get membership of team %s: %w", a.TeamID, err)
+			}
+			in = m != nil
+			member[a.TeamID] = in
+		}
+		if in {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// smeKeywordSet returns the SME's keyword set, building it from its profile and the
+// topics and keywords of its knowledge when it isn't cached.
+func (s *SMEService) smeKeywordSet(ctx context.Context, sme *entity.SubjectMatterExpert, chunkCount int) (smeKeywordSet, error) {
+	key := cache.TenantCacheKeys.SMEKeywords(sme.ID.String(), fmt.Sprintf("%d-%d", sme.UpdatedAt.UnixNano(), chunkCount))
+
+	var set smeKeywordSet
+	if s.cache != nil {
+		if entry, err := s.cache.Get(ctx, key, &set); err == nil && entry != nil && set.Terms != nil {
+			return set, nil
+		}
+	}
+
+	keywords, err := s.knowledgeRepo.ListKeywords(ctx, sme.ID)
+	if err != nil {
+		return smeKeywordSet{}, err
+	}
+
+	set = smeKeywordSet{Terms: make(map[string]float64)}
+	add := func(text string, weight float64) {
+		for _, word := range splitCoverageWords(text) {
+			if len(word) < coverageMinTermLength || coverageStopWords[word] {
+				continue
+			}
+			stem := coverageStem(word)
+			set.Terms[stem] = max(set.Terms[stem], weight)
+		}
+	}
+	add(sme.Name+" "+sme.Domain, smeTermWeightDomain)
+	add(strings.Join(keywords, " "), smeTermWeightKeyword)
+	add(sme.Description, smeTermWeightSummary)
+	if sme.KnowledgeSummary != nil {
+		add(*sme.KnowledgeSummary, smeTermWeightSummary)
+	}
+
+	if s.cache != nil {
+		if _, err := s.cache.Set(ctx, key, set, "", smeKeywordSetTTL); err != nil {
+			s.logger.Warn("failed to cache SME keyword set", "smeID", sme.ID, "error", err)
+		}
+	}
+	return set, nil
+}
@@ -0,0 +1,482 @@
+package service
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+	"github.com/sogos/mirai-backend/internal/infrastructure/storage"
+)
+
+const (
+	// storageAuditGracePeriod keeps recently written objects out of audits: uploads that
+	// may still be submitted and exports whose download links are still valid.
+	storageAuditGracePeriod = 7 * 24 * time.Hour
+
+	// storageAuditProgressEvery is how many objects are scanned between progress writes.
+	storageAuditProgressEvery = 1000
+
+	// storageAuditURLExpiry is how long a report download link stays valid.
+	storageAuditURLExpiry = time.Hour
+)
+
+// errStorageAuditCancelled stops scanning once the audit job is no longer processing.
+var errStorageAuditCancelled = errors.New("storage audit cancelled")
+
+// StorageAuditStorage lists, deletes and reports on a tenant's stored objects.
+type StorageAuditStorage interface {
+	BuildPath(tenantID uuid.UUID, subpath string) string
+	WalkTenantObjects(ctx context.Context, tenantID uuid.UUID, fn func(storage.ObjectInfo) error) error
+	DeleteTenantObject(ctx context.Context, tenantID uuid.UUID, objectPath string) error
+	StreamContent(ctx context.Context, tenantID uuid.UUID, subpath, contentType string, write func(w io.Writer) error) (int64, error)
+	GenerateDownloadURL(ctx context.Context, tenantID uuid.UUID, subpath string, expiry time.Duration) (string, error)
+	ReadPublishedCourse(ctx context.Context, tenantID, courseID uuid.UUID, version int32, filename string, v interface{}) error
+}
+
+// SetStorageAudit enables storage audits. Without it, StartStorageAudit fails.
+func (s *AIGenerationService) SetStorageAudit(storage StorageAuditStorage, refRepo repository.StorageReferenceRepository) {
+	s.auditStorage = storage
+	s.storageRefRepo = refRepo
+}
+
+// StartStorageAudit queues a job that reports the tenant's stored objects no database
+// row references any more. With purgeOrphans the job also deletes them. Admin only.
+func (s *AIGenerationService) StartStorageAudit(ctx context.Context, kratosID uuid.UUID, purgeOrphans bool) (*entity.GenerationJob, error) {
+	log := s.logger.With("kratosID", kratosID, "purge", purgeOrphans)
+
+	if s.auditStorage == nil || s.storageRefRepo == nil {
+		return nil, domainerrors.ErrInternal.WithMessage("storage audits are not configured")
+	}
+
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, domainerrors.ErrUserNotFound
+	}
+	if !user.IsAdmin() {
+		return nil, domainerrors.ErrForbidden.WithMessage("only admins can audit storage")
+	}
+	if user.TenantID == nil {
+		return nil, domainerrors.ErrUserHasNoCompany
+	}
+
+	progressMsg := "Queued for storage audit"
+	job := &entity.GenerationJob{
+		TenantID:        *user.TenantID,
+		Type:            valueobject.GenerationJobTypeStorageAudit,
+		Status:          valueobject.GenerationJobStatusQueued,
+		PurgeOrphans:    purgeOrphans,
+		ProgressMessage: &progressMsg,
+		MaxRetries:      1,
+		CreatedByUserID: user.ID,
+		CreatedAt:       time.Now(),
+	}
+
+	if err := s.jobRepo.Create(ctx, job); err != nil {
+		log.Error("failed to create storage audit job", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	log.Info("storage audit job created", "jobID", job.ID)
+
+	if s.taskEnqueuer != nil {
+		if err := s.taskEnqueuer.EnqueueAIGeneration(job.ID.String(), string(job.Type)); err != nil {
+			log.Warn("failed to enqueue job for immediate processing, will be picked up by poll", "error", err)
+		}
+	}
+
+	return job, nil
+}
+
+// StorageAuditReportResult contains a storage audit job and, once it has completed, a
+// link to download its report.
+type StorageAuditReportResult struct {
+	Job         *entity.GenerationJob
+	DownloadURL string
+	ExpiresAt   time.Time
+}
+
+// GetStorageAuditReport returns a storage audit job of the admin's tenant and a link to
+// its JSON report once the job has completed.
+func (s *AIGenerationService) GetStorageAuditReport(ctx context.Context, kratosID uuid.UUID, jobID uuid.UUID) (*StorageAuditReportResult, error) {
+	if s.auditStorage == nil {
+		return nil, domainerrors.ErrInternal.WithMessage("storage audits are not configured")
+	}
+
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, domainerrors.ErrUserNotFound
+	}
+	if !user.IsAdmin() {
+		return nil, domainerrors.ErrForbidden.WithMessage("only admins can audit storage")
+	}
+	if user.TenantID == nil {
+		return nil, domainerrors.ErrUserHasNoCompany
+	}
+
+	job, err := s.jobRepo.GetByID(ctx, jobID)
+	if err != nil || job == nil || job.TenantID != *user.TenantID || job.Type != valueobject.GenerationJobTypeStorageAudit {
+		return nil, domainerrors.ErrNotFound.WithMessage("storage audit not found")
+	}
+
+	result := &StorageAuditReportResult{Job: job}
+	if job.Status != valueobject.GenerationJobStatusCompleted || job.ResultPath == nil {
+		return result, nil
+	}
+
+	subpath := strings.TrimPrefix(*job.ResultPath, s.auditStorage.BuildPath(job.TenantID, "")+"/")
+	url, err := s.auditStorage.GenerateDownloadURL(ctx, job.TenantID, subpath, storageAuditURLExpiry)
+	if err != nil {
+		s.logger.Error("failed to generate storage audit download URL", "jobID", job.ID, "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	result.DownloadURL = url
+	result.ExpiresAt = time.Now().Add(storageAuditURLExpiry)
+	return result, nil
+}
+
+// storageAuditOrphan is one orphaned object in a storage audit report.
+type storageAuditOrphan struct {
+	Path         string    `json:"path"` // Tenant-relative
+	Size         int64     `json:"size"`
+	ObjectType   string    `json:"object_type"`
+	LastModified time.Time `json:"last_modified"`
+	Reason       string    `json:"reason"`
+	Deleted      bool      `json:"deleted,omitempty"`
+	DeleteError  string    `json:"delete_error,omitempty"`
+}
+
+// storageAuditSummary totals a storage audit.
+type storageAuditSummary struct {
+	ScannedObjects      int              `json:"scanned_objects"`
+	ScannedBytes        int64            `json:"scanned_bytes"`
+	RecentObjects       int              `json:"recent_objects"`       // Within the grace period, not checked
+	UnrecognizedObjects int              `json:"unrecognized_objects"` // Unknown layout, never reported
+	OrphanedObjects     int              `json:"orphaned_objects"`
+	OrphanedBytes       int64            `json:"orphaned_bytes"`
+	OrphanedBytesByType map[string]int64 `json:"orphaned_bytes_by_type"`
+	DeletedObjects      int              `json:"deleted_objects"`
+	DeletedBytes        int64            `json:"deleted_bytes"`
+	DeleteFailures      int              `json:"delete_failures"`
+}
+
+// ProcessStorageAuditJob scans every object under the job tenant's prefix, checks it
+// against the paths the tenant's database rows reference, and streams a JSON report of
+// the orphans to tenant storage. Orphans are deleted as they are found when the job
+// asks for a purge. Objects of other tenants are never listed or deleted.
+func (s *AIGenerationService) ProcessStorageAuditJob(ctx context.Context, job *entity.GenerationJob) error {
+	log := s.logger.With("jobID", job.ID, "tenantID", job.TenantID, "purge", job.PurgeOrphans)
+
+	if ctx.Err() != nil {
+		return s.requeueInterruptedJob(ctx, job)
+	}
+	if s.checkJobCancelled(ctx, job.ID) {
+		log.Info("job already cancelled, skipping processing")
+		return nil
+	}
+	if s.auditStorage == nil || s.storageRefRepo == nil {
+		return s.failJob(ctx, job, "storage audits are not configured")
+	}
+
+	refs, err := s.storageRefRepo.ListReferences(ctx, job.TenantID)
+	if err != nil {
+		log.Error("failed to list storage references", "error", err)
+		return s.failJob(ctx, job, "failed to load referenced storage paths")
+	}
+	if err := s.addPublishedAssetReferences(ctx, job.TenantID, refs); err != nil {
+		log.Error("failed to read published course versions", "error", err)
+		return s.failJob(ctx, job, "failed to load assets of published courses")
+	}
+	index := newStorageAuditIndex(s.auditStorage.BuildPath(job.TenantID, ""), refs)
+
+	startedAt := time.Now().UTC()
+	summary := &storageAuditSummary{OrphanedBytesByType: map[string]int64{}}
+	subpath := path.Join("exports", job.ID.String(), "storage-audit.json")
+
+	size, err := s.auditStorage.StreamContent(ctx, job.TenantID, subpath, "application/json", func(w io.Writer) error {
+		return s.writeStorageAuditReport(ctx, job, w, index, startedAt, summary)
+	})
+	if err != nil {
+		if ctx.Err() != nil || errors.Is(err, errStorageAuditCancelled) {
+			detached := context.WithoutCancel(ctx)
+			if s.checkJobCancelled(detached, job.ID) {
+				log.Info("storage audit cancelled", "deleted", summary.DeletedObjects)
+				return s.markJobCancelled(detached, job)
+			}
+			return s.requeueInterruptedJob(ctx, job)
+		}
+		log.Error("failed to write storage audit report", "error", err)
+		return s.failJob(ctx, job, "failed to write storage audit report")
+	}
+
+	progressMsg := fmt.Sprintf("Found %d orphaned objects (%s reclaimable) among %d scanned",
+		summary.OrphanedObjects, formatByteSize(summary.OrphanedBytes), summary.ScannedObjects)
+	if job.PurgeOrphans {
+		progressMsg = fmt.Sprintf("Deleted %d of %d orphaned objects (%s reclaimed) among %d scanned",
+			summary.DeletedObjects, summary.OrphanedObjects, formatByteSize(summary.DeletedBytes), summary.ScannedObjects)
+	}
+
+	resultPath := s.auditStorage.BuildPath(job.TenantID, subpath)
+	job.ResultPath = &resultPath
+	job.Status = valueobject.GenerationJobStatusCompleted
+	job.ProgressPercent = 100
+	job.ProgressMessage = &progressMsg
+	completedAt := time.Now()
+	job.CompletedAt = &completedAt
+	if err := s.jobRepo.Update(ctx, job); err != nil {
+		log.Error("failed to mark job as completed", "error", err)
+	}
+
+	log.Info("storage audit completed",
+		"scanned", summary.ScannedObjects,
+		"orphaned", summary.OrphanedObjects,
+		"orphanedBytes", summary.OrphanedBytes,
+		"deleted", summary.DeletedObjects,
+		"deleteFailures", summary.DeleteFailures,
+		"reportBytes", size,
+	)
+	return nil
+}
+
+// writeStorageAuditReport walks the tenant's objects page by page, writing each orphan
+// to the report as it is found so neither the listing nor the report is held in memory.
+// The summary is written last, once the totals are known.
+func (s *AIGenerationService) writeStorageAuditReport(ctx context.Context, job *entity.GenerationJob, w io.Writer, index *storageAuditIndex, startedAt time.Time, summary *storageAuditSummary) error {
+	bw := bufio.NewWriter(w)
+	enc := json.NewEncoder(bw)
+
+	header, err := json.Marshal(map[string]any{
+		"job_id":            job.ID.String(),
+		"tenant_id":         job.TenantID.String(),
+		"purge":             job.PurgeOrphans,
+		"started_at":        startedAt,
+		"grace_period_days": int(storageAuditGracePeriod / (24 * time.Hour)),
+	})
+	if err != nil {
+		return err
+	}
+	// Splice the orphans array into the header object
+	if _, err := bw.Write(header[:len(header)-1]); err != nil {
+		return err
+	}
+	if _, err := bw.WriteString(`,"orphans":[`); err != nil {
+		return err
+	}
+
+	cutoff := time.Now().Add(-storageAuditGracePeriod)
+	err = s.auditStorage.WalkTenantObjects(ctx, job.TenantID, func(obj storage.ObjectInfo) error {
+		subpath, ok := index.subpath(obj.Path)
+		if !ok {
+			// Never act on anything outside the tenant's prefix
+			return nil
+		}
+
+		summary.ScannedObjects++
+		summary.ScannedBytes += obj.Size
+		if summary.ScannedObjects%storageAuditProgressEvery == 0 {
+			msg := fmt.Sprintf("Scanned %d objects, %d orphaned", summary.ScannedObjects, summary.OrphanedObjects)
+			processing, err := s.jobRepo.UpdateProgress(ctx, job.ID, 50, msg)
+			if err != nil {
+				s.logger.Warn("failed to update job progress", "jobID", job.ID, "error", err)
+			} else if !processing {
+				return errStorageAuditCancelled
+			}
+		}
+
+		if obj.LastModified.After(cutoff) {
+			summary.RecentObjects++
+			return nil
+		}
+		reason, known := index.orphanReason(subpath)
+		if !known {
+			summary.UnrecognizedObjects++
+			return nil
+		}
+		if reason == "" {
+			return nil
+		}
+
+		orphan := storageAuditOrphan{
+			Path:         subpath,
+			Size:         obj.Size,
+			ObjectType:   string(obj.ObjectType),
+			LastModified: obj.LastModified.UTC(),
+			Reason:       reason,
+		}
+		summary.OrphanedObjects++
+		summary.OrphanedBytes += obj.Size
+		summary.OrphanedBytesByType[orphan.ObjectType] += obj.Size
+
+		if job.PurgeOrphans {
+			if err := s.auditStorage.DeleteTenantObject(ctx, job.TenantID, obj.Path); err != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				s.logger.Warn("failed to delete orphaned object", "jobID", job.ID, "path", obj.Path, "error", err)
+				orphan.DeleteError = err.Error()
+				summary.DeleteFailures++
+			} else {
+				orphan.Deleted = true
+				summary.DeletedObjects++
+				summary.DeletedBytes += obj.Size
+			}
+		}
+
+		if summary.OrphanedObjects > 1 {
+			if err := bw.WriteByte(','); err != nil {
+				return err
+			}
+		}
+		return enc.Encode(orphan)
+	})
+	if err != nil {
+		return err
+	}
+
+	if _, err := bw.WriteString(`],"summary":`); err != nil {
+		return err
+	}
+	if err := enc.Encode(summary); err != nil {
+		return err
+	}
+	if _, err := bw.WriteString("}\n"); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+// storageAuditIndex decides which of a tenant's objects are still referenced.
+type storageAuditIndex struct {
+	prefix  string // "tenants/{tenant_id}"
+	courses map[string]bool
+	paths   map[string]bool // Tenant-relative
+}
+
+// addPublishedAssetReferences adds the assets used by published course versions to refs.
+// A version that can't be read fails the audit rather than risk purging its assets.
+func (s *AIGenerationService) addPublishedAssetReferences(ctx context.Context, tenantID uuid.UUID, refs *entity.StorageReferences) error {
+	for courseID, version := range refs.PublishedVersions {
+		var view CoursePlayerView
+		if err := s.auditStorage.ReadPublishedCourse(ctx, tenantID, courseID, version, storage.PublishedPlayerFile, &view); err != nil {
+			return fmt.Errorf("course %s version %d: %w", courseID, version, err)
+		}
+		for _, section := range view.Sections {
+			for _, lesson := range section.Lessons {
+				for _, component := range lesson.Components {
+					var content struct {
+						AssetPath string `json:"asset_path"`
+					}
+					if json.Unmarshal(component.ContentJSON, &content) == nil && content.AssetPath != "" {
+						refs.Paths = append(refs.Paths, content.AssetPath)
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func newStorageAuditIndex(tenantPrefix string, refs *entity.StorageReferences) *storageAuditIndex {
+	index := &storageAuditIndex{
+		prefix:  tenantPrefix,
+		courses: make(map[string]bool, len(refs.CourseIDs)),
+		paths:   make(map[string]bool, len(refs.Paths)),
+	}
+	for _, id := range refs.CourseIDs {
+		index.courses[id.String()] = true
+	}
+	for _, p := range refs.Paths {
+		p = strings.TrimPrefix(p, "/")
+		if strings.HasPrefix(p, "tenants/") {
+			var ok bool
+			if p, ok = index.subpath(p); !ok {
+				continue
+			}
+		}
+		index.paths[p] = true
+	}
+	return index
+}
+
+// subpath returns the tenant-relative part of a full object path, or false if the
+// path belongs to another tenant.
+func (idx *storageAuditIndex) subpath(objectPath string) (string, bool) {
+	rest, ok := strings.CutPrefix(objectPath, idx.prefix+"/")
+	if !ok || rest == "" {
+		return "", false
+	}
+	return rest, true
+}
+
+// orphanReason explains why an object at a tenant-relative path is orphaned, or returns
+// "" if it is still referenced. known is false for paths outside the layouts the app
+// writes; those are never reported, since nothing is known about who owns them.
+func (idx *storageAuditIndex) orphanReason(subpath string) (reason string, known bool) {
+	parts := strings.Split(subpath, "/")
+	referenced := idx.paths[subpath]
+
+	switch {
+	case parts[0] == "courses" && len(parts) >= 3:
+		// Everything under a live course's folder belongs to it
+		if idx.courses[parts[1]] {
+			return "", true
+		}
+		return "course no longer exists", true
+	case parts[0] == "exports":
+		if referenced {
+			return "", true
+		}
+		return "export not referenced by any job or LMS delivery", true
+	case parts[0] == "sme" && len(parts) >= 3 && parts[1] == "imports":
+		if referenced {
+			return "", true
+		}
+		return "import archive not referenced by any job", true
+	case parts[0] == "sme" && len(parts) >= 4 && parts[2] == "submissions":
+		if referenced {
+			return "", true
+		}
+		return "upload not referenced by any submission", true
+	case parts[0] == "sme" && len(parts) >= 4 && parts[2] == "processed":
+		if referenced {
+			return "", true
+		}
+		return "knowledge file not referenced by any SME", true
+	case parts[0] == "lessons" && len(parts) >= 5 && parts[2] == "components":
+		if referenced {
+			return "", true
+		}
+		return "asset not attached to any lesson component", true
+	case storage.ObjectTypeForPath(subpath) == storage.ObjectTypeThumbnail:
+		if referenced {
+			return "", true
+		}
+		return "thumbnail not used by any course", true
+	}
+	return "", false
+}
+
+// formatByteSize formats a byte count for messages, e.g. "12.3 MB".
+func formatByteSize(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %cB", float64(n)/float64(div), "KMGTPE"[exp])
+}
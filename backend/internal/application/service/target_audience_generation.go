@@ -0,0 +1,178 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+	"github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+// Persona draft limits. Drafts run inside the request, like title suggestions.
+const (
+	maxJobDescriptionChars = 8000
+	maxPersonaListItems    = 5
+	maxPersonaItemChars    = 200
+)
+
+// SetAIProvider enables drafting target audiences with the tenant's AI provider.
+func (s *TargetAudienceService) SetAIProvider(factory AIProviderFactory, aiSettingsRepo repository.TenantAISettingsRepository) {
+	s.aiProviderFactory = factory
+	s.aiSettingsRepo = aiSettingsRepo
+	s.draftLimiter = newUserRateLimiter(suggestionRateLimit, suggestionRateWindow)
+}
+
+// GenerateTargetAudienceResult contains a drafted target audience.
+type GenerateTargetAudienceResult struct {
+	Draft      *entity.TargetAudienceTemplate // Not saved; ID, creator and timestamps are unset
+	TokensUsed int64
+}
+
+// GenerateTargetAudience drafts a target audience from a job description or role title.
+// Nothing is saved; the client edits the draft and creates it through CreateTargetAudience.
+func (s *TargetAudienceService) GenerateTargetAudience(ctx context.Context, kratosID uuid.UUID, jobDescription string) (*GenerateTargetAudienceResult, error) {
+	log := s.logger.With("kratosID", kratosID)
+
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, domainerrors.ErrUserNotFound
+	}
+
+	if user.TenantID == nil || user.CompanyID == nil {
+		return nil, domainerrors.ErrUserHasNoCompany
+	}
+
+	jobDescription = strings.TrimSpace(jobDescription)
+	if jobDescription == "" {
+		return nil, domainerrors.ErrMissingRequired.WithMessage("describe the job or enter a role title")
+	}
+	if utf8.RuneCountInString(jobDescription) > maxJobDescriptionChars {
+		return nil, domainerrors.ErrInvalidInput.WithMessage("job description is too long")
+	}
+
+	if s.aiProviderFactory == nil {
+		return nil, domainerrors.ErrAIProviderNotConfigured
+	}
+
+	if !s.draftLimiter.Allow(user.ID) {
+		return nil, domainerrors.ErrRateLimited.WithMessage("too many audience drafts - please wait a moment and try again")
+	}
+
+	if err := checkAIGenerationEnabled(ctx, s.aiSettingsRepo, *user.TenantID); err != nil {
+		return nil, err
+	}
+	if err := checkTenantTokenBudget(ctx, s.aiSettingsRepo, *user.TenantID); err != nil {
+		return nil, err
+	}
+
+	aiProvider, err := s.aiProviderFactory.GetProvider(ctx, *user.TenantID)
+	if err != nil {
+		log.Error("failed to get AI provider", "error", err)
+		if domainerrors.IsDomainError(err) {
+			return nil, err
+		}
+		return nil, domainerrors.ErrExternalService.WithCause(err)
+	}
+
+	draftCtx, cancel := context.WithTimeout(ctx, suggestionTimeout)
+	defer cancel()
+
+	result, err := aiProvider.GenerateTargetAudience(draftCtx, service.GenerateTargetAudienceRequest{
+		JobDescription: jobDescription,
+		MaxListItems:   maxPersonaListItems,
+	})
+	if err != nil {
+		if errors.Is(draftCtx.Err(), context.DeadlineExceeded) {
+			log.Warn("target audience draft timed out", "timeout", suggestionTimeout)
+			return nil, domainerrors.ErrSuggestionTimeout
+		}
+		log.Error("target audience draft failed", "error", err)
+		return nil, domainerrors.ErrExternalService.WithCause(err)
+	}
+
+	// Tokens were spent even if the draft turns out unusable
+	if err := s.aiSettingsRepo.IncrementTokenUsage(ctx, *user.TenantID, result.TokensUsed); err != nil {
+		log.Warn("failed to record token usage", "error", err)
+	}
+
+	persona := result.Persona
+	level, err := valueobject.ParseExperienceLevel(strings.ToLower(strings.TrimSpace(persona.ExperienceLevel)))
+	if err != nil {
+		log.Warn("target audience draft has an unknown experience level", "experienceLevel", persona.ExperienceLevel)
+		return nil, domainerrors.ErrExternalService.WithMessage("AI returned an invalid audience - please try again")
+	}
+	if strings.TrimSpace(persona.Role) == "" {
+		log.Warn("target audience draft has no role")
+		return nil, domainerrors.ErrExternalService.WithMessage("AI returned an invalid audience - please try again")
+	}
+
+	name := persona.Name
+	if strings.TrimSpace(name) == "" {
+		name = persona.Role
+	}
+
+	draft := &entity.TargetAudienceTemplate{
+		TenantID:          *user.TenantID,
+		CompanyID:         *user.CompanyID,
+		Name:              capPersonaText(name),
+		Role:              capPersonaText(persona.Role),
+		ExperienceLevel:   level,
+		LearningGoals:     capPersonaList(persona.LearningGoals),
+		Prerequisites:     capPersonaList(persona.Prerequisites),
+		Challenges:        capPersonaList(persona.Challenges),
+		Motivations:       capPersonaList(persona.Motivations),
+		IndustryContext:   optionalPersonaText(persona.IndustryContext),
+		TypicalBackground: optionalPersonaText(persona.TypicalBackground),
+		Status:            valueobject.TargetAudienceStatusActive,
+	}
+
+	// The log line is the audit record; drafts are not stored
+	log.Info("target audience drafted",
+		"userID", user.ID,
+		"tenantID", *user.TenantID,
+		"experienceLevel", level,
+		"tokensUsed", result.TokensUsed)
+
+	return &GenerateTargetAudienceResult{
+		Draft:      draft,
+		TokensUsed: result.TokensUsed,
+	}, nil
+}
+
+// capPersonaList drops blank entries and keeps at most maxPersonaListItems, each
+// shortened to maxPersonaItemChars.
+func capPersonaList(items []string) []string {
+	capped := make([]string, 0, maxPersonaListItems)
+	for _, item := range items {
+		item = capPersonaText(item)
+		if item == "" || len(capped) == maxPersonaListItems {
+			continue
+		}
+		capped = append(capped, item)
+	}
+	return capped
+}
+
+// capPersonaText trims text and shortens it to maxPersonaItemChars.
+func capPersonaText(text string) string {
+	text = strings.TrimSpace(text)
+	if utf8.RuneCountInString(text) > maxPersonaItemChars {
+		text = strings.TrimSpace(string([]rune(text)[:maxPersonaItemChars]))
+	}
+	return text
+}
+
+// optionalPersonaText returns nil for blank text.
+func optionalPersonaText(text string) *string {
+	text = capPersonaText(text)
+	if text == "" {
+		return nil
+	}
+	return &text
+}
@@ -16,6 +16,11 @@ type TargetAudienceService struct {
 	userRepo     repository.UserRepository
 	audienceRepo repository.TargetAudienceRepository
 	logger       service.Logger
+
+	// Optional: drafting audiences with AI (see SetAIProvider)
+	aiProviderFactory AIProviderFactory
+	aiSettingsRepo    repository.TenantAISettingsRepository
+	draftLimiter      *userRateLimiter
 }
 
 // NewTargetAudienceService creates a new target audience service.
@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/application/dto"
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+	"github.com/sogos/mirai-backend/internal/infrastructure/cache"
+)
+
+const (
+	// teamDashboardCacheTTL is how long a team dashboard is served from cache, so its
+	// figures can lag changes by this long.
+	teamDashboardCacheTTL = time.Minute
+
+	// Each dashboard list is capped on its own.
+	teamDashboardJobLimit        = 10
+	teamDashboardCourseLimit     = 5
+	teamDashboardInvitationLimit = 10
+)
+
+// TeamDashboard summarizes a team's workload.
+type TeamDashboard struct {
+	TaskCounts         []entity.SMETaskStatusCount // Open tasks assigned to members, by status
+	OpenTasks          int
+	OverdueTasks       int
+	ActiveJobs         []*entity.GenerationJob   // Queued and processing jobs started by members, newest first
+	RecentCourses      []LibraryEntry            // Most recently modified courses in the team's folders
+	PendingInvitations []*dto.InvitationResponse // Pending invitations to join the team, newest first
+	GeneratedAt        time.Time
+}
+
+// SetDashboard enables the team dashboard. The cache may be nil.
+func (s *TeamService) SetDashboard(
+	jobs repository.GenerationJobRepository,
+	courses repository.CourseRepository,
+	invitations repository.InvitationRepository,
+	c cache.Cache,
+) {
+	s.jobRepo = jobs
+	s.courseRepo = courses
+	s.invitationRepo = invitations
+	s.cache = c
+}
+
+// GetTeamDashboard returns the team's open tasks, active jobs, recent courses and pending
+// invitations. Each block is read with its own capped query and the result is cached
+// briefly. Team members, owners and admins only.
+func (s *TeamService) GetTeamDashboard(ctx context.Context, kratosID, teamID uuid.UUID) (*TeamDashboard, error) {
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, domainerrors.ErrUserNotFound
+	}
+
+	team, err := s.teamRepo.GetByID(ctx, teamID)
+	if err != nil || team == nil {
+		return nil, domainerrors.ErrTeamNotFound
+	}
+	if user.CompanyID == nil || team.CompanyID != *user.CompanyID {
+		return nil, domainerrors.ErrForbidden
+	}
+	if !user.CanManageTeams() {
+		member, _ := s.teamRepo.GetMember(ctx, teamID, user.ID)
+		if member == nil {
+			return nil, domainerrors.ErrForbidden.WithMessage("only team members and admins can view the team dashboard")
+		}
+	}
+
+	if s.jobRepo == nil {
+		return nil, domainerrors.ErrInternal.WithMessage("team dashboard not configured")
+	}
+
+	key := cache.TenantCacheKeys.TeamDashboard(teamID.String())
+	if s.cache != nil {
+		var cached TeamDashboard
+		if entry, err := s.cache.Get(ctx, key, &cached); err == nil && entry != nil {
+			return &cached, nil
+		}
+	}
+
+	dashboard, err := s.buildTeamDashboard(ctx, teamID)
+	if err != nil {
+		s.logger.Error("failed to build team dashboard", "teamID", teamID, "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	if s.cache != nil {
+		if _, err := s.cache.Set(ctx, key, dashboard, "", teamDashboardCacheTTL); err != nil {
+			s.logger.Warn("failed to cache team dashboard", "teamID", teamID, "error", err)
+		}
+	}
+	return dashboard, nil
+}
+
+// buildTeamDashboard reads each dashboard block from the database.
+func (s *TeamService) buildTeamDashboard(ctx context.Context, teamID uuid.UUID) (*TeamDashboard, error) {
+	dashboard := &TeamDashboard{GeneratedAt: time.Now()}
+
+	counts, err := s.taskRepo.CountOpenByTeamMembers(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	dashboard.TaskCounts = counts
+	for _, c := range counts {
+		dashboard.OpenTasks += c.Count
+		dashboard.OverdueTasks += c.Overdue
+	}
+
+	dashboard.ActiveJobs, err = s.jobRepo.ListActiveByTeamMembers(ctx, teamID, teamDashboardJobLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	courses, err := s.courseRepo.List(ctx, entity.CourseListOptions{TeamFoldersOf: &teamID, Limit: teamDashboardCourseLimit})
+	if err != nil {
+		return nil, err
+	}
+	dashboard.RecentCourses = make([]LibraryEntry, 0, len(courses))
+	for _, c := range courses {
+		entry := LibraryEntry{
+			ID:              c.ID.String(),
+			Title:           c.Title,
+			Status:          CourseStatus(c.Status.String()),
+			Tags:            c.CategoryTags,
+			CreatedAt:       c.CreatedAt,
+			ModifiedAt:      c.UpdatedAt,
+			CreatedBy:       c.CreatedByUserID.String(),
+			CreatedByActive: c.CreatorActive,
+			HasUnpublished:  c.HasUnpublishedChanges(),
+		}
+		if c.FolderID != nil {
+			entry.Folder = c.FolderID.String()
+		}
+		if c.ThumbnailPath != nil {
+			entry.ThumbnailPath = *c.ThumbnailPath
+		}
+		dashboard.RecentCourses = append(dashboard.RecentCourses, entry)
+	}
+
+	invitations, err := s.invitationRepo.ListPendingByTeamID(ctx, teamID, teamDashboardInvitationLimit)
+	if err != nil {
+		return nil, err
+	}
+	dashboard.PendingInvitations = make([]*dto.InvitationResponse, len(invitations))
+	for i, inv := range invitations {
+		dashboard.PendingInvitations[i] = dto.FromInvitation(inv)
+	}
+
+	return dashboard, nil
+}
@@ -7,8 +7,11 @@ import (
 	"github.com/sogos/mirai-backend/internal/application/dto"
 	"github.com/sogos/mirai-backend/internal/domain/entity"
 	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/links"
 	"github.com/sogos/mirai-backend/internal/domain/repository"
 	"github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+	"github.com/sogos/mirai-backend/internal/infrastructure/cache"
 )
 
 // TeamService handles team-related business logic.
@@ -17,8 +20,18 @@ type TeamService struct {
 	companyRepo repository.CompanyRepository
 	teamRepo    repository.TeamRepository
 	folderRepo  repository.FolderRepository
+	smeRepo     repository.SMERepository
+	taskRepo    repository.SMETaskRepository
+	notifier    TaskNotifier
 	identity    service.IdentityProvider
+	library     LibraryCacheInvalidator
 	logger      service.Logger
+
+	// Team dashboard; see SetDashboard
+	jobRepo        repository.GenerationJobRepository
+	courseRepo     repository.CourseRepository
+	invitationRepo repository.InvitationRepository
+	cache          cache.Cache
 }
 
 // NewTeamService creates a new team service.
@@ -27,6 +40,9 @@ func NewTeamService(
 	companyRepo repository.CompanyRepository,
 	teamRepo repository.TeamRepository,
 	folderRepo repository.FolderRepository,
+	smeRepo repository.SMERepository,
+	taskRepo repository.SMETaskRepository,
+	notifier TaskNotifier,
 	identity service.IdentityProvider,
 	logger service.Logger,
 ) *TeamService {
@@ -35,11 +51,26 @@ func NewTeamService(
 		companyRepo: companyRepo,
 		teamRepo:    teamRepo,
 		folderRepo:  folderRepo,
+		smeRepo:     smeRepo,
+		taskRepo:    taskRepo,
+		notifier:    notifier,
 		identity:    identity,
 		logger:      logger,
 	}
 }
 
+// SetLibraryCacheInvalidator drops the cached folder hierarchy when team folders change.
+func (s *TeamService) SetLibraryCacheInvalidator(library LibraryCacheInvalidator) {
+	s.library = library
+}
+
+// invalidateLibraryCache drops the cached folder hierarchy, if one is kept.
+func (s *TeamService) invalidateLibraryCache(ctx context.Context) {
+	if s.library != nil {
+		s.library.InvalidateLibraryCache(ctx)
+	}
+}
+
 // ListTeams retrieves all teams for the current user's company.
 func (s *TeamService) ListTeams(ctx context.Context, kratosID uuid.UUID) ([]*dto.TeamResponse, error) {
 	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
@@ -116,6 +147,7 @@ func (s *TeamService) CreateTeam(ctx context.Context, kratosID uuid.UUID, req dt
 		log.Error("failed to create team folder", "error", err)
 		// Don't fail team creation if folder creation fails, but log it
 	} else {
+		s.invalidateLibraryCache(ctx)
 		log.Info("team folder created", "folderID", teamFolder.ID)
 	}
 
@@ -184,39 +216,242 @@ func (s *TeamService) UpdateTeam(ctx context.Context, kratosID uuid.UUID, teamID
 	return dto.FromTeam(team), nil
 }
 
-// DeleteTeam deletes a team.
-func (s *TeamService) DeleteTeam(ctx context.Context, kratosID uuid.UUID, teamID uuid.UUID) error {
+// DeleteTeam deletes a team after resolving the team-scoped SMEs and open tasks that
+// reference it. With req.DryRun the impact is returned without changing anything.
+func (s *TeamService) DeleteTeam(ctx context.Context, kratosID uuid.UUID, teamID uuid.UUID, req dto.DeleteTeamRequest) (*dto.DeleteTeamResponse, error) {
 	log := s.logger.With("kratosID", kratosID, "teamID", teamID)
 
 	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
 	if err != nil || user == nil {
-		return domainerrors.ErrUserNotFound
+		return nil, domainerrors.ErrUserNotFound
 	}
 
 	// Check permissions
 	if !user.CanManageTeams() {
-		return domainerrors.ErrForbidden.WithMessage("only owners and admins can delete teams")
+		return nil, domainerrors.ErrForbidden.WithMessage("only owners and admins can delete teams")
 	}
 
 	team, err := s.teamRepo.GetByID(ctx, teamID)
 	if err != nil || team == nil {
-		return domainerrors.ErrTeamNotFound
+		return nil, domainerrors.ErrTeamNotFound
 	}
 
 	// Verify team belongs to user's company
 	if user.CompanyID == nil || team.CompanyID != *user.CompanyID {
-		return domainerrors.ErrForbidden
+		return nil, domainerrors.ErrForbidden
+	}
+
+	if req.ReassignToTeamID != nil && req.Detach {
+		return nil, domainerrors.ErrInvalidInput.WithMessage("choose either a reassignment team or detach, not both")
+	}
+	if req.ReassignToTeamID != nil {
+		if *req.ReassignToTeamID == teamID {
+			return nil, domainerrors.ErrInvalidInput.WithMessage("cannot reassign to the team being deleted")
+		}
+		target, err := s.teamRepo.GetByID(ctx, *req.ReassignToTeamID)
+		if err != nil || target == nil {
+			return nil, domainerrors.ErrTeamNotFound.WithMessage("reassignment team not found")
+		}
+		if target.CompanyID != team.CompanyID {
+			return nil, domainerrors.ErrForbidden
+		}
+	}
+
+	smes, tasks, err := s.listTeamDependents(ctx, teamID)
+	if err != nil {
+		log.Error("failed to load team dependents", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	resp, err := s.planTeamDeletion(ctx, teamID, req, smes, tasks)
+	if err != nil {
+		log.Error("failed to plan team deletion", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	if req.DryRun {
+		return resp, nil
+	}
+
+	if req.ReassignToTeamID == nil && !req.Detach && (len(smes) > 0 || len(tasks) > 0) {
+		return nil, domainerrors.ErrInvalidInput.WithMessage("team has team-scoped SMEs or open tasks; choose a reassignment team or detach")
+	}
+
+	for i, sme := range smes {
+		if err := s.resolveTeamSME(ctx, teamID, sme, resp.SMEs[i].Outcome, req.ReassignToTeamID); err != nil {
+			log.Error("failed to resolve team SME", "smeID", sme.ID, "error", err)
+			return nil, domainerrors.ErrInternal.WithCause(err)
+		}
+	}
+
+	for _, task := range tasks {
+		if req.ReassignToTeamID != nil {
+			task.TeamID = req.ReassignToTeamID
+		} else {
+			task.Status = valueobject.SMETaskStatusCancelled
+		}
+		if err := s.taskRepo.Update(ctx, task); err != nil {
+			log.Error("failed to resolve team task", "taskID", task.ID, "error", err)
+			return nil, domainerrors.ErrInternal.WithCause(err)
+		}
 	}
 
 	if err := s.teamRepo.Delete(ctx, teamID); err != nil {
 		log.Error("failed to delete team", "error", err)
-		return domainerrors.ErrInternal.WithCause(err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	resp.Deleted = true
+	s.invalidateLibraryCache(ctx) // The team's folder is deleted with it
+
+	s.notifyTeamTaskAssignees(ctx, team, tasks, req.ReassignToTeamID != nil)
+
+	log.Info("team deleted", "smes", len(smes), "tasks", len(tasks))
+	return resp, nil
+}
+
+// listTeamDependents returns the team-scoped SMEs with access through the team and
+// the team's tasks that are still open.
+func (s *TeamService) listTeamDependents(ctx context.Context, teamID uuid.UUID) ([]*entity.SubjectMatterExpert, []*entity.SMETask, error) {
+	scope := valueobject.SMEScopeTeam
+	smes, err := s.smeRepo.List(ctx, entity.SMEListOptions{
+		Scope:           &scope,
+		TeamID:          &teamID,
+		IncludeArchived: true,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	allTasks, err := s.taskRepo.List(ctx, entity.SMETaskListOptions{TeamID: &teamID})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var tasks []*entity.SMETask
+	for _, task := range allTasks {
+		if task.Status != valueobject.SMETaskStatusCompleted && task.Status != valueobject.SMETaskStatusCancelled {
+			tasks = append(tasks, task)
+		}
+	}
+	return smes, tasks, nil
+}
+
+// planTeamDeletion builds the deletion summary, deciding each SME's and task's outcome.
+func (s *TeamService) planTeamDeletion(ctx context.Context, teamID uuid.UUID, req dto.DeleteTeamRequest, smes []*entity.SubjectMatterExpert, tasks []*entity.SMETask) (*dto.DeleteTeamResponse, error) {
+	resp := &dto.DeleteTeamResponse{
+		TeamID: teamID,
+		SMEs:   make([]dto.TeamDeletionSME, len(smes)),
+		Tasks:  make([]dto.TeamDeletionTask, len(tasks)),
+	}
+
+	for i, sme := range smes {
+		outcome := dto.TeamDeletionOutcomeUnresolved
+		switch {
+		case req.ReassignToTeamID != nil:
+			outcome = dto.TeamDeletionOutcomeReassigned
+		case req.Detach:
+			access, err := s.smeRepo.ListTeamAccess(ctx, sme.ID)
+			if err != nil {
+				return nil, err
+			}
+			outcome = dto.TeamDeletionOutcomeMadeGlobal
+			for _, a := range access {
+				if a.TeamID != teamID {
+					outcome = dto.TeamDeletionOutcomeDetached // Still reachable through another team
+					break
+				}
+			}
+		}
+		resp.SMEs[i] = dto.TeamDeletionSME{SMEID: sme.ID, Name: sme.Name, Outcome: outcome}
+	}
+
+	for i, task := range tasks {
+		outcome := dto.TeamDeletionOutcomeUnresolved
+		switch {
+		case req.ReassignToTeamID != nil:
+			outcome = dto.TeamDeletionOutcomeReassigned
+		case req.Detach:
+			outcome = dto.TeamDeletionOutcomeCancelled
+		}
+		resp.Tasks[i] = dto.TeamDeletionTask{
+			TaskID:           task.ID,
+			Title:            task.Title,
+			AssignedToUserID: task.AssignedToUserID,
+			Outcome:          outcome,
+		}
 	}
 
-	log.Info("team deleted")
+	return resp, nil
+}
+
+// resolveTeamSME moves an SME's access off the team according to its planned outcome.
+func (s *TeamService) resolveTeamSME(ctx context.Context, teamID uuid.UUID, sme *entity.SubjectMatterExpert, outcome string, reassignTo *uuid.UUID) error {
+	if outcome == dto.TeamDeletionOutcomeReassigned {
+		access, err := s.smeRepo.ListTeamAccess(ctx, sme.ID)
+		if err != nil {
+			return err
+		}
+		hasTarget := false
+		for _, a := range access {
+			if a.TeamID == *reassignTo {
+				hasTarget = true
+				break
+			}
+		}
+		if !hasTarget {
+			if err := s.smeRepo.AddTeamAccess(ctx, &entity.SMETeamAccess{
+				TenantID: sme.TenantID,
+				SMEID:    sme.ID,
+				TeamID:   *reassignTo,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := s.smeRepo.RemoveTeamAccess(ctx, sme.ID, teamID); err != nil {
+		return err
+	}
+
+	if outcome == dto.TeamDeletionOutcomeMadeGlobal {
+		sme.Scope = valueobject.SMEScopeGlobal
+		return s.smeRepo.Update(ctx, sme)
+	}
 	return nil
 }
 
+// notifyTeamTaskAssignees tells assignees their open tasks were moved or cancelled.
+func (s *TeamService) notifyTeamTaskAssignees(ctx context.Context, team *entity.Team, tasks []*entity.SMETask, reassigned bool) {
+	if s.notifier == nil {
+		return
+	}
+
+	for _, task := range tasks {
+		title := "Task cancelled"
+		message := "The team \"" + team.Name + "\" was deleted, so the task \"" + task.Title + "\" has been cancelled."
+		if reassigned {
+			title = "Task moved to another team"
+			message = "The team \"" + team.Name + "\" was deleted, so the task \"" + task.Title + "\" has been moved to another team."
+		}
+
+		actionURL := links.TaskLink(task.SMEID, task.ID)
+		taskID := task.ID
+		smeID := task.SMEID
+		if _, err := s.notifier.CreateNotification(ctx, CreateNotificationRequest{
+			UserID:    task.AssignedToUserID,
+			Type:      valueobject.NotificationTypeTaskAssigned,
+			Priority:  valueobject.NotificationPriorityNormal,
+			Title:     title,
+			Message:   message,
+			ActionURL: &actionURL,
+			TaskID:    &taskID,
+			SMEID:     &smeID,
+		}); err != nil {
+			s.logger.Error("failed to notify task assignee", "taskID", task.ID, "error", err)
+		}
+	}
+}
+
 // ListMembers retrieves all members of a team.
 func (s *TeamService) ListMembers(ctx context.Context, kratosID uuid.UUID, teamID uuid.UUID) ([]*dto.TeamMemberResponse, error) {
 	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
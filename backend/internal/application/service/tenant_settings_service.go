@@ -2,8 +2,12 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/sogos/mirai-backend/internal/domain/audit"
 	"github.com/sogos/mirai-backend/internal/domain/entity"
 	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
 	"github.com/sogos/mirai-backend/internal/domain/repository"
@@ -16,7 +20,9 @@ import (
 type TenantSettingsService struct {
 	userRepo     repository.UserRepository
 	settingsRepo repository.TenantAISettingsRepository
+	folderRepo   repository.FolderRepository
 	encryptor    *crypto.Encryptor
+	auditLog     AuditLogger
 	logger       service.Logger
 }
 
@@ -24,17 +30,36 @@ type TenantSettingsService struct {
 func NewTenantSettingsService(
 	userRepo repository.UserRepository,
 	settingsRepo repository.TenantAISettingsRepository,
+	folderRepo repository.FolderRepository,
 	encryptor *crypto.Encryptor,
 	logger service.Logger,
 ) *TenantSettingsService {
 	return &TenantSettingsService{
 		userRepo:     userRepo,
 		settingsRepo: settingsRepo,
+		folderRepo:   folderRepo,
 		encryptor:    encryptor,
 		logger:       logger,
 	}
 }
 
+// SetAuditLogger enables audit logging of settings changes.
+func (s *TenantSettingsService) SetAuditLogger(logger AuditLogger) {
+	s.auditLog = logger
+}
+
+// settingsAuditEntry describes a change to the tenant's AI settings made by user.
+func settingsAuditEntry(user *entity.User, action audit.Action, changes audit.Changes) audit.Entry {
+	return audit.Entry{
+		TenantID:    *user.TenantID,
+		ActorUserID: &user.ID,
+		Action:      action,
+		TargetType:  audit.TargetAISettings,
+		TargetID:    user.TenantID.String(),
+		Changes:     changes,
+	}
+}
+
 // GetAISettingsResult contains the AI settings response.
 type GetAISettingsResult struct {
 	Settings *entity.TenantAISettings
@@ -65,8 +90,11 @@ func (s *TenantSettingsService) GetAISettings(ctx context.Context, kratosID uuid
 	// Return default settings if none exist yet
 	if settings == nil {
 		settings = &entity.TenantAISettings{
-			TenantID: *user.TenantID,
-			Provider: valueobject.AIProviderGemini,
+			TenantID:            *user.TenantID,
+			Provider:            valueobject.AIProviderGemini,
+			GenerationDefaults:  entity.DefaultGenerationPreferences(),
+			WeeklySummary:       entity.DefaultWeeklySummarySchedule(),
+			ExportRetentionDays: entity.DefaultExportRetentionDays,
 		}
 	}
 
@@ -108,22 +136,32 @@ func (s *TenantSettingsService) SetAPIKey(ctx context.Context, kratosID uuid.UUI
 	if settings == nil {
 		// Create new settings
 		settings = &entity.TenantAISettings{
-			TenantID:        *user.TenantID,
-			Provider:        provider,
-			EncryptedAPIKey: encryptedKey,
-			UpdatedByUserID: &user.ID,
+			TenantID:           *user.TenantID,
+			Provider:           provider,
+			EncryptedAPIKey:    encryptedKey,
+			UpdatedByUserID:    &user.ID,
+			GenerationDefaults: entity.DefaultGenerationPreferences(),
+			WeeklySummary:      entity.DefaultWeeklySummarySchedule(),
 		}
-		if err := s.settingsRepo.Create(ctx, settings); err != nil {
+		entry := settingsAuditEntry(user, audit.ActionAPIKeySet,
+			audit.Changes{}.Field("provider", "", provider.String()).Sensitive("api_key"))
+		if err := audited(ctx, s.auditLog, &entry, func(ctx context.Context) error {
+			return s.settingsRepo.Create(ctx, settings)
+		}); err != nil {
 			log.Error("failed to create AI settings", "error", err)
 			return domainerrors.ErrInternal.WithCause(err)
 		}
 	} else {
 		// Update existing settings
+		entry := settingsAuditEntry(user, audit.ActionAPIKeySet,
+			audit.Changes{}.Field("provider", settings.Provider.String(), provider.String()).Sensitive("api_key"))
 		settings.Provider = provider
 		settings.EncryptedAPIKey = encryptedKey
 		settings.UpdatedByUserID = &user.ID
 
-		if err := s.settingsRepo.Update(ctx, settings); err != nil {
+		if err := audited(ctx, s.auditLog, &entry, func(ctx context.Context) error {
+			return s.settingsRepo.Update(ctx, settings)
+		}); err != nil {
 			log.Error("failed to update AI settings", "error", err)
 			return domainerrors.ErrInternal.WithCause(err)
 		}
@@ -165,7 +203,10 @@ func (s *TenantSettingsService) RemoveAPIKey(ctx context.Context, kratosID uuid.
 	settings.EncryptedAPIKey = nil
 	settings.UpdatedByUserID = &user.ID
 
-	if err := s.settingsRepo.Update(ctx, settings); err != nil {
+	entry := settingsAuditEntry(user, audit.ActionAPIKeyRemoved, audit.Changes{}.Sensitive("api_key"))
+	if err := audited(ctx, s.auditLog, &entry, func(ctx context.Context) error {
+		return s.settingsRepo.Update(ctx, settings)
+	}); err != nil {
 		log.Error("failed to update AI settings", "error", err)
 		return domainerrors.ErrInternal.WithCause(err)
 	}
@@ -174,6 +215,612 @@ func (s *TenantSettingsService) RemoveAPIKey(ctx context.Context, kratosID uuid.
 	return nil
 }
 
+// UpdateGenerationDefaults sets the tenant's default component mix for new courses.
+func (s *TenantSettingsService) UpdateGenerationDefaults(ctx context.Context, kratosID uuid.UUID, prefs entity.GenerationPreferences) error {
+	log := s.logger.With("kratosID", kratosID)
+
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return domainerrors.ErrUserNotFound
+	}
+
+	if !user.CanManageSettings() {
+		return domainerrors.ErrForbidden.WithMessage("only admins and owners can change generation defaults")
+	}
+
+	if user.TenantID == nil {
+		return domainerrors.ErrUserHasNoCompany
+	}
+
+	if !prefs.QuizFrequency.IsValid() {
+		return domainerrors.ErrInvalidInput.WithMessage("invalid quiz frequency")
+	}
+	if !prefs.ComponentBoundsValid() {
+		return domainerrors.ErrInvalidInput.WithMessage(componentBoundsMessage)
+	}
+
+	settings, err := s.settingsRepo.Get(ctx, *user.TenantID)
+	if err != nil {
+		log.Error("failed to get AI settings", "error", err)
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+
+	before := entity.DefaultGenerationPreferences()
+	if settings != nil {
+		before = settings.GenerationDefaults
+	}
+	entry := settingsAuditEntry(user, audit.ActionGenerationDefaultsUpdated, audit.Changes{}.
+		Field("enable_quizzes", before.EnableQuizzes, prefs.EnableQuizzes).
+		Field("quiz_frequency", before.QuizFrequency, prefs.QuizFrequency).
+		Field("include_images", before.IncludeImages, prefs.IncludeImages).
+		Field("include_reflection_prompts", before.IncludeReflectionPrompts, prefs.IncludeReflectionPrompts).
+		Field("generate_images", before.GenerateImages, prefs.GenerateImages).
+		Field("min_lesson_components", before.MinComponents, prefs.MinComponents).
+		Field("max_lesson_components", before.MaxComponents, prefs.MaxComponents))
+
+	if settings == nil {
+		settings = &entity.TenantAISettings{
+			TenantID:           *user.TenantID,
+			Provider:           valueobject.AIProviderGemini,
+			UpdatedByUserID:    &user.ID,
+			GenerationDefaults: prefs,
+			WeeklySummary:      entity.DefaultWeeklySummarySchedule(),
+		}
+		if err := audited(ctx, s.auditLog, &entry, func(ctx context.Context) error {
+			return s.settingsRepo.Create(ctx, settings)
+		}); err != nil {
+			log.Error("failed to create AI settings", "error", err)
+			return domainerrors.ErrInternal.WithCause(err)
+		}
+	} else {
+		settings.GenerationDefaults = prefs
+		settings.UpdatedByUserID = &user.ID
+		if err := audited(ctx, s.auditLog, &entry, func(ctx context.Context) error {
+			return s.settingsRepo.Update(ctx, settings)
+		}); err != nil {
+			log.Error("failed to update AI settings", "error", err)
+			return domainerrors.ErrInternal.WithCause(err)
+		}
+	}
+
+	log.Info("generation defaults updated")
+	return nil
+}
+
+// UpdateOutlineAutoApprove sets whether outline generation may skip review and go
+// straight to generating lessons.
+func (s *TenantSettingsService) UpdateOutlineAutoApprove(ctx context.Context, kratosID uuid.UUID, allowed bool) error {
+	log := s.logger.With("kratosID", kratosID)
+
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return domainerrors.ErrUserNotFound
+	}
+
+	if !user.CanManageSettings() {
+		return domainerrors.ErrForbidden.WithMessage("only admins and owners can change outline auto-approval")
+	}
+
+	if user.TenantID == nil {
+		return domainerrors.ErrUserHasNoCompany
+	}
+
+	settings, err := s.settingsRepo.Get(ctx, *user.TenantID)
+	if err != nil {
+		log.Error("failed to get AI settings", "error", err)
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+
+	before := settings != nil && settings.AllowOutlineAutoApprove
+	entry := settingsAuditEntry(user, audit.ActionOutlineAutoApproveUpdated, audit.Changes{}.
+		Field("allow_outline_auto_approve", before, allowed))
+
+	if settings == nil {
+		settings = &entity.TenantAISettings{
+			TenantID:                *user.TenantID,
+			Provider:                valueobject.AIProviderGemini,
+			UpdatedByUserID:         &user.ID,
+			GenerationDefaults:      entity.DefaultGenerationPreferences(),
+			WeeklySummary:           entity.DefaultWeeklySummarySchedule(),
+			AllowOutlineAutoApprove: allowed,
+		}
+		if err := audited(ctx, s.auditLog, &entry, func(ctx context.Context) error {
+			return s.settingsRepo.Create(ctx, settings)
+		}); err != nil {
+			log.Error("failed to create AI settings", "error", err)
+			return domainerrors.ErrInternal.WithCause(err)
+		}
+	} else {
+		settings.AllowOutlineAutoApprove = allowed
+		settings.UpdatedByUserID = &user.ID
+		if err := audited(ctx, s.auditLog, &entry, func(ctx context.Context) error {
+			return s.settingsRepo.Update(ctx, settings)
+		}); err != nil {
+			log.Error("failed to update AI settings", "error", err)
+			return domainerrors.ErrInternal.WithCause(err)
+		}
+	}
+
+	log.Info("outline auto-approval updated", "allowed", allowed)
+	return nil
+}
+
+// UpdateSecondReviewerPolicy sets whether outline approval requires a second reviewer:
+// the user who generated an outline can't approve it, and a first reviewer's endorsement
+// must be followed by a different reviewer's approval.
+func (s *TenantSettingsService) UpdateSecondReviewerPolicy(ctx context.Context, kratosID uuid.UUID, required bool) error {
+	log := s.logger.With("kratosID", kratosID)
+
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return domainerrors.ErrUserNotFound
+	}
+
+	if !user.CanManageSettings() {
+		return domainerrors.ErrForbidden.WithMessage("only admins and owners can change the second reviewer policy")
+	}
+
+	if user.TenantID == nil {
+		return domainerrors.ErrUserHasNoCompany
+	}
+
+	settings, err := s.settingsRepo.Get(ctx, *user.TenantID)
+	if err != nil {
+		log.Error("failed to get AI settings", "error", err)
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+
+	before := settings != nil && settings.RequireSecondReviewer
+	entry := settingsAuditEntry(user, audit.ActionSecondReviewerUpdated, audit.Changes{}.
+		Field("require_second_reviewer", before, required))
+
+	if settings == nil {
+		settings = &entity.TenantAISettings{
+			TenantID:              *user.TenantID,
+			Provider:              valueobject.AIProviderGemini,
+			UpdatedByUserID:       &user.ID,
+			GenerationDefaults:    entity.DefaultGenerationPreferences(),
+			WeeklySummary:         entity.DefaultWeeklySummarySchedule(),
+			RequireSecondReviewer: required,
+		}
+		if err := audited(ctx, s.auditLog, &entry, func(ctx context.Context) error {
+			return s.settingsRepo.Create(ctx, settings)
+		}); err != nil {
+			log.Error("failed to create AI settings", "error", err)
+			return domainerrors.ErrInternal.WithCause(err)
+		}
+	} else {
+		settings.RequireSecondReviewer = required
+		settings.UpdatedByUserID = &user.ID
+		if err := audited(ctx, s.auditLog, &entry, func(ctx context.Context) error {
+			return s.settingsRepo.Update(ctx, settings)
+		}); err != nil {
+			log.Error("failed to update AI settings", "error", err)
+			return domainerrors.ErrInternal.WithCause(err)
+		}
+	}
+
+	log.Info("second reviewer policy updated", "required", required)
+	return nil
+}
+
+// UpdateExportRetention sets how many days the organization's export files are kept
+// before they are deleted. It applies to exports made from now on.
+func (s *TenantSettingsService) UpdateExportRetention(ctx context.Context, kratosID uuid.UUID, days int) error {
+	log := s.logger.With("kratosID", kratosID)
+
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return domainerrors.ErrUserNotFound
+	}
+
+	if !user.CanManageSettings() {
+		return domainerrors.ErrForbidden.WithMessage("only admins and owners can change export retention")
+	}
+
+	if user.TenantID == nil {
+		return domainerrors.ErrUserHasNoCompany
+	}
+
+	if days < 1 || days > entity.MaxExportRetentionDays {
+		return domainerrors.ErrInvalidInput.WithMessage(fmt.Sprintf("export retention must be between 1 and %d days", entity.MaxExportRetentionDays))
+	}
+
+	settings, err := s.settingsRepo.Get(ctx, *user.TenantID)
+	if err != nil {
+		log.Error("failed to get AI settings", "error", err)
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+
+	before := entity.DefaultExportRetentionDays
+	if settings != nil {
+		before = settings.ExportRetentionDays
+	}
+	entry := settingsAuditEntry(user, audit.ActionExportRetentionUpdated, audit.Changes{}.
+		Field("export_retention_days", before, days))
+
+	if err := audited(ctx, s.auditLog, &entry, func(ctx context.Context) error {
+		return s.settingsRepo.SetExportRetentionDays(ctx, *user.TenantID, days, user.ID)
+	}); err != nil {
+		log.Error("failed to update export retention", "error", err)
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+
+	log.Info("export retention updated", "days", days)
+	return nil
+}
+
+// ExportRetentionDays returns how many days a tenant keeps its export files. It is
+// called by other services and does not check permissions.
+func (s *TenantSettingsService) ExportRetentionDays(ctx context.Context, tenantID uuid.UUID) int {
+	settings, err := s.settingsRepo.Get(ctx, tenantID)
+	if err != nil {
+		s.logger.Warn("failed to get export retention", "tenantID", tenantID, "error", err)
+		return entity.DefaultExportRetentionDays
+	}
+	if settings == nil || settings.ExportRetentionDays <= 0 {
+		return entity.DefaultExportRetentionDays
+	}
+	return settings.ExportRetentionDays
+}
+
+// UpdatePromptCache sets whether identical component regeneration prompts may reuse a
+// response from the last few minutes instead of calling the provider again.
+func (s *TenantSettingsService) UpdatePromptCache(ctx context.Context, kratosID uuid.UUID, disabled bool) error {
+	log := s.logger.With("kratosID", kratosID)
+
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return domainerrors.ErrUserNotFound
+	}
+
+	if !user.CanManageSettings() {
+		return domainerrors.ErrForbidden.WithMessage("only admins and owners can change prompt caching")
+	}
+
+	if user.TenantID == nil {
+		return domainerrors.ErrUserHasNoCompany
+	}
+
+	settings, err := s.settingsRepo.Get(ctx, *user.TenantID)
+	if err != nil {
+		log.Error("failed to get AI settings", "error", err)
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+
+	before := settings != nil && settings.DisablePromptCache
+	entry := settingsAuditEntry(user, audit.ActionPromptCacheUpdated, audit.Changes{}.
+		Field("disable_prompt_cache", before, disabled))
+
+	if settings == nil {
+		settings = &entity.TenantAISettings{
+			TenantID:           *user.TenantID,
+			Provider:           valueobject.AIProviderGemini,
+			UpdatedByUserID:    &user.ID,
+			GenerationDefaults: entity.DefaultGenerationPreferences(),
+			WeeklySummary:      entity.DefaultWeeklySummarySchedule(),
+			DisablePromptCache: disabled,
+		}
+		if err := audited(ctx, s.auditLog, &entry, func(ctx context.Context) error {
+			return s.settingsRepo.Create(ctx, settings)
+		}); err != nil {
+			log.Error("failed to create AI settings", "error", err)
+			return domainerrors.ErrInternal.WithCause(err)
+		}
+	} else {
+		settings.DisablePromptCache = disabled
+		settings.UpdatedByUserID = &user.ID
+		if err := audited(ctx, s.auditLog, &entry, func(ctx context.Context) error {
+			return s.settingsRepo.Update(ctx, settings)
+		}); err != nil {
+			log.Error("failed to update AI settings", "error", err)
+			return domainerrors.ErrInternal.WithCause(err)
+		}
+	}
+
+	log.Info("prompt cache setting updated", "disabled", disabled)
+	return nil
+}
+
+// UpdateWeeklySummary turns the weekly summary email to admins on or off and sets the
+// day and hour (UTC) it is sent.
+func (s *TenantSettingsService) UpdateWeeklySummary(ctx context.Context, kratosID uuid.UUID, schedule entity.WeeklySummarySchedule) error {
+	log := s.logger.With("kratosID", kratosID)
+
+	if schedule.Day < time.Sunday || schedule.Day > time.Saturday {
+		return domainerrors.ErrInvalidInput.WithMessage("invalid weekly summary day")
+	}
+	if schedule.Hour < 0 || schedule.Hour > 23 {
+		return domainerrors.ErrInvalidInput.WithMessage("weekly summary hour must be between 0 and 23")
+	}
+
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return domainerrors.ErrUserNotFound
+	}
+
+	if !user.CanManageSettings() {
+		return domainerrors.ErrForbidden.WithMessage("only admins and owners can change the weekly summary")
+	}
+
+	if user.TenantID == nil {
+		return domainerrors.ErrUserHasNoCompany
+	}
+
+	settings, err := s.settingsRepo.Get(ctx, *user.TenantID)
+	if err != nil {
+		log.Error("failed to get AI settings", "error", err)
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+
+	before := entity.DefaultWeeklySummarySchedule()
+	if settings != nil {
+		before = settings.WeeklySummary
+	}
+	entry := settingsAuditEntry(user, audit.ActionWeeklySummaryUpdated, audit.Changes{}.
+		Field("weekly_summary_enabled", before.Enabled, schedule.Enabled).
+		Field("weekly_summary_day", before.Day, schedule.Day).
+		Field("weekly_summary_hour", before.Hour, schedule.Hour))
+
+	if settings == nil {
+		settings = &entity.TenantAISettings{
+			TenantID:           *user.TenantID,
+			Provider:           valueobject.AIProviderGemini,
+			UpdatedByUserID:    &user.ID,
+			GenerationDefaults: entity.DefaultGenerationPreferences(),
+			WeeklySummary:      schedule,
+		}
+		if err := audited(ctx, s.auditLog, &entry, func(ctx context.Context) error {
+			return s.settingsRepo.Create(ctx, settings)
+		}); err != nil {
+			log.Error("failed to create AI settings", "error", err)
+			return domainerrors.ErrInternal.WithCause(err)
+		}
+	} else {
+		settings.WeeklySummary = schedule
+		settings.UpdatedByUserID = &user.ID
+		if err := audited(ctx, s.auditLog, &entry, func(ctx context.Context) error {
+			return s.settingsRepo.Update(ctx, settings)
+		}); err != nil {
+			log.Error("failed to update AI settings", "error", err)
+			return domainerrors.ErrInternal.WithCause(err)
+		}
+	}
+
+	log.Info("weekly summary updated", "enabled", schedule.Enabled, "day", schedule.Day, "hour", schedule.Hour)
+	return nil
+}
+
+// UpdateLocale sets the locale the organization's emails and exports are formatted in.
+// An empty locale clears it, so each user's own locale applies again.
+func (s *TenantSettingsService) UpdateLocale(ctx context.Context, kratosID uuid.UUID, locale string) error {
+	log := s.logger.With("kratosID", kratosID, "locale", locale)
+
+	var newLocale *valueobject.Locale
+	if locale != "" {
+		l := valueobject.Locale(locale)
+		if !l.IsValid() {
+			return domainerrors.ErrInvalidInput.WithMessage("unsupported locale")
+		}
+		newLocale = &l
+	}
+
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return domainerrors.ErrUserNotFound
+	}
+
+	if !user.CanManageSettings() {
+		return domainerrors.ErrForbidden.WithMessage("only admins and owners can change the organization locale")
+	}
+
+	if user.TenantID == nil {
+		return domainerrors.ErrUserHasNoCompany
+	}
+
+	settings, err := s.settingsRepo.Get(ctx, *user.TenantID)
+	if err != nil {
+		log.Error("failed to get AI settings", "error", err)
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+
+	before := ""
+	if settings != nil && settings.Locale != nil {
+		before = settings.Locale.String()
+	}
+	entry := settingsAuditEntry(user, audit.ActionLocaleUpdated, audit.Changes{}.
+		Field("locale", before, locale))
+
+	if settings == nil {
+		settings = &entity.TenantAISettings{
+			TenantID:           *user.TenantID,
+			Provider:           valueobject.AIProviderGemini,
+			UpdatedByUserID:    &user.ID,
+			GenerationDefaults: entity.DefaultGenerationPreferences(),
+			WeeklySummary:      entity.DefaultWeeklySummarySchedule(),
+			Locale:             newLocale,
+		}
+		if err := audited(ctx, s.auditLog, &entry, func(ctx context.Context) error {
+			return s.settingsRepo.Create(ctx, settings)
+		}); err != nil {
+			log.Error("failed to create AI settings", "error", err)
+			return domainerrors.ErrInternal.WithCause(err)
+		}
+	} else {
+		settings.Locale = newLocale
+		settings.UpdatedByUserID = &user.ID
+		if err := audited(ctx, s.auditLog, &entry, func(ctx context.Context) error {
+			return s.settingsRepo.Update(ctx, settings)
+		}); err != nil {
+			log.Error("failed to update AI settings", "error", err)
+			return domainerrors.ErrInternal.WithCause(err)
+		}
+	}
+
+	log.Info("organization locale updated")
+	return nil
+}
+
+// TenantLocale returns the locale a tenant has picked, or nil if it hasn't picked one.
+// It is called by other services and does not check permissions.
+func (s *TenantSettingsService) TenantLocale(ctx context.Context, tenantID uuid.UUID) *valueobject.Locale {
+	settings, err := s.settingsRepo.Get(ctx, tenantID)
+	if err != nil {
+		s.logger.Warn("failed to get tenant locale", "tenantID", tenantID, "error", err)
+		return nil
+	}
+	if settings == nil {
+		return nil
+	}
+	return settings.Locale
+}
+
+// GetCourseDefaults returns the settings the organization's new courses start with.
+// Any member can read them, so course creation forms can show what will be filled in.
+func (s *TenantSettingsService) GetCourseDefaults(ctx context.Context, kratosID uuid.UUID) (entity.CourseDefaults, error) {
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return entity.CourseDefaults{}, domainerrors.ErrUserNotFound
+	}
+
+	if user.TenantID == nil {
+		return entity.CourseDefaults{}, domainerrors.ErrUserHasNoCompany
+	}
+
+	settings, err := s.settingsRepo.Get(ctx, *user.TenantID)
+	if err != nil {
+		s.logger.Error("failed to get AI settings", "tenantID", user.TenantID, "error", err)
+		return entity.CourseDefaults{}, domainerrors.ErrInternal.WithCause(err)
+	}
+	if settings == nil {
+		return entity.CourseDefaults{}, nil
+	}
+	return settings.CourseDefaults, nil
+}
+
+// UpdateCourseDefaults replaces the settings the organization's new courses start with.
+func (s *TenantSettingsService) UpdateCourseDefaults(ctx context.Context, kratosID uuid.UUID, defaults entity.CourseDefaults) error {
+	log := s.logger.With("kratosID", kratosID)
+
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return domainerrors.ErrUserNotFound
+	}
+
+	if !user.CanManageSettings() {
+		return domainerrors.ErrForbidden.WithMessage("only admins and owners can change course defaults")
+	}
+
+	if user.TenantID == nil {
+		return domainerrors.ErrUserHasNoCompany
+	}
+
+	defaults.CategoryTags = normalizeTags(defaults.CategoryTags)
+	defaults.DataSource = strings.TrimSpace(defaults.DataSource)
+	if defaults.DestinationFolderID != nil {
+		folder, err := s.folderRepo.GetByID(ctx, *defaults.DestinationFolderID)
+		if err != nil {
+			log.Error("failed to get default folder", "folderID", defaults.DestinationFolderID, "error", err)
+			return domainerrors.ErrInternal.WithCause(err)
+		}
+		if folder == nil {
+			return domainerrors.ErrFolderNotFound
+		}
+	}
+
+	settings, err := s.settingsRepo.Get(ctx, *user.TenantID)
+	if err != nil {
+		log.Error("failed to get AI settings", "error", err)
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+
+	var before entity.CourseDefaults
+	if settings != nil {
+		before = settings.CourseDefaults
+	}
+	entry := settingsAuditEntry(user, audit.ActionCourseDefaultsUpdated, courseDefaultsChanges(before, defaults))
+
+	if settings == nil {
+		settings = &entity.TenantAISettings{
+			TenantID:           *user.TenantID,
+			Provider:           valueobject.AIProviderGemini,
+			UpdatedByUserID:    &user.ID,
+			GenerationDefaults: entity.DefaultGenerationPreferences(),
+			WeeklySummary:      entity.DefaultWeeklySummarySchedule(),
+			CourseDefaults:     defaults,
+		}
+		if err := audited(ctx, s.auditLog, &entry, func(ctx context.Context) error {
+			return s.settingsRepo.Create(ctx, settings)
+		}); err != nil {
+			log.Error("failed to create AI settings", "error", err)
+			return domainerrors.ErrInternal.WithCause(err)
+		}
+	} else {
+		settings.CourseDefaults = defaults
+		settings.UpdatedByUserID = &user.ID
+		if err := audited(ctx, s.auditLog, &entry, func(ctx context.Context) error {
+			return s.settingsRepo.Update(ctx, settings)
+		}); err != nil {
+			log.Error("failed to update AI settings", "error", err)
+			return domainerrors.ErrInternal.WithCause(err)
+		}
+	}
+
+	log.Info("course defaults updated")
+	return nil
+}
+
+// CourseDefaults returns the settings a tenant's new courses start with.
+// It is called by other services and does not check permissions.
+func (s *TenantSettingsService) CourseDefaults(ctx context.Context, tenantID uuid.UUID) entity.CourseDefaults {
+	settings, err := s.settingsRepo.Get(ctx, tenantID)
+	if err != nil {
+		s.logger.Warn("failed to get course defaults", "tenantID", tenantID, "error", err)
+		return entity.CourseDefaults{}
+	}
+	if settings == nil {
+		return entity.CourseDefaults{}
+	}
+	return settings.CourseDefaults
+}
+
+// normalizeTags trims tags and drops empty and repeated ones.
+func normalizeTags(tags []string) []string {
+	seen := make(map[string]bool, len(tags))
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+	return normalized
+}
+
+// courseDefaultsChanges describes a change to the course defaults for the audit log.
+func courseDefaultsChanges(before, after entity.CourseDefaults) audit.Changes {
+	folder := func(d entity.CourseDefaults) string {
+		if d.DestinationFolderID == nil {
+			return ""
+		}
+		return d.DestinationFolderID.String()
+	}
+	assessment := func(d entity.CourseDefaults) string {
+		if d.Assessment == nil {
+			return ""
+		}
+		return fmt.Sprintf("knowledge_checks=%t final_exam=%t",
+			d.Assessment.EnableEmbeddedKnowledgeChecks, d.Assessment.EnableFinalExam)
+	}
+	return audit.Changes{}.
+		Field("destination_folder", folder(before), folder(after)).
+		Field("category_tags", strings.Join(before.CategoryTags, ","), strings.Join(after.CategoryTags, ",")).
+		Field("data_source", before.DataSource, after.DataSource).
+		Field("assessment_settings", assessment(before), assessment(after))
+}
+
 // TestAPIKeyResult contains the API key test result.
 type TestAPIKeyResult struct {
 	Valid   bool
@@ -222,11 +869,16 @@ func (s *TenantSettingsService) TestAPIKey(ctx context.Context, kratosID uuid.UU
 	return &TestAPIKeyResult{Valid: true, Message: "API key is valid"}, nil
 }
 
+// usageReportPeriods is how many months of usage history GetUsageStats returns.
+const usageReportPeriods = 12
+
 // GetUsageStatsResult contains usage statistics.
 type GetUsageStatsResult struct {
 	TotalTokensUsed   int64
+	TokensThisMonth   int64
 	MonthlyTokenLimit *int64
 	Provider          valueobject.AIProvider
+	Periods           []*entity.TokenUsagePeriod // Most recent first
 }
 
 // GetUsageStats retrieves AI usage statistics.
@@ -249,19 +901,33 @@ func (s *TenantSettingsService) GetUsageStats(ctx context.Context, kratosID uuid
 		return nil, domainerrors.ErrInternal.WithCause(err)
 	}
 
+	thisMonth, err := s.settingsRepo.GetCurrentPeriodUsage(ctx, *user.TenantID)
+	if err != nil {
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	periods, err := s.settingsRepo.ListUsagePeriods(ctx, *user.TenantID, usageReportPeriods)
+	if err != nil {
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
 	// Return default stats if no settings exist yet
 	if settings == nil {
 		return &GetUsageStatsResult{
 			TotalTokensUsed:   0,
+			TokensThisMonth:   thisMonth,
 			MonthlyTokenLimit: nil,
 			Provider:          valueobject.AIProviderGemini,
+			Periods:           periods,
 		}, nil
 	}
 
 	return &GetUsageStatsResult{
 		TotalTokensUsed:   settings.TotalTokensUsed,
+		TokensThisMonth:   thisMonth,
 		MonthlyTokenLimit: settings.MonthlyTokenLimit,
 		Provider:          settings.Provider,
+		Periods:           periods,
 	}, nil
 }
 
@@ -273,13 +939,29 @@ func (s *TenantSettingsService) GetDecryptedAPIKey(ctx context.Context, tenantID
 	}
 
 	if settings == nil || settings.EncryptedAPIKey == nil {
-		return "", domainerrors.ErrAIKeyNotConfigured
+		return "", domainerrors.ErrAIProviderNotConfigured
 	}
 
 	key, err := s.encryptor.DecryptString(settings.EncryptedAPIKey)
 	if err != nil {
-		return "", domainerrors.ErrInternal.WithCause(err)
+		// Usually a rotated encryption key; the stored API key has to be entered again
+		s.logger.Error("failed to decrypt AI API key", "tenantID", tenantID, "error", err)
+		return "", domainerrors.ErrAIProviderNotConfigured.WithMessage(
+			"the saved Gemini API key can no longer be read - an admin can re-enter it in Settings > AI Settings")
 	}
 
 	return key, nil
 }
+
+// PromptCacheEnabled reports whether a tenant's component regenerations may be served
+// from the prompt cache. It is called by the AI provider factory and does not check
+// permissions. Errors disable the cache, so a tenant that opted out never gets a
+// cached response.
+func (s *TenantSettingsService) PromptCacheEnabled(ctx context.Context, tenantID uuid.UUID) bool {
+	settings, err := s.settingsRepo.Get(ctx, tenantID)
+	if err != nil {
+		s.logger.Warn("failed to get prompt cache setting", "tenantID", tenantID, "error", err)
+		return false
+	}
+	return settings != nil && !settings.DisablePromptCache
+}
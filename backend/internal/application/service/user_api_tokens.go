@@ -0,0 +1,340 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/audit"
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+	"github.com/sogos/mirai-backend/internal/domain/tenant"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+const (
+	// APITokenSecretPrefix starts every API token secret, so leaked tokens are easy to spot.
+	APITokenSecretPrefix = "mirai_"
+
+	// apiTokenPrefixChars is how much of the secret is kept to tell tokens apart.
+	apiTokenPrefixChars = len(APITokenSecretPrefix) + 6
+
+	// MaxAPITokensPerUser is the most unrevoked, unexpired tokens one user can have.
+	MaxAPITokensPerUser = 10
+
+	// defaultAPITokenLifetimeDays applies when a token is created without an expiry.
+	defaultAPITokenLifetimeDays = 90
+
+	// maxAPITokenLifetimeDays is the longest a token can be valid for.
+	maxAPITokenLifetimeDays = 365
+
+	// DefaultAPITokenRateLimit is the requests per minute a token gets unless it asks for fewer.
+	DefaultAPITokenRateLimit = 60
+
+	// MaxAPITokenRateLimit is the most requests per minute a token can be allowed.
+	MaxAPITokenRateLimit = 600
+
+	// maxAPITokenNameLength caps a token's name.
+	maxAPITokenNameLength = 100
+)
+
+// APITokenRateLimiter counts API token requests per minute across every process.
+type APITokenRateLimiter interface {
+	// Allow records a request for key and reports whether it is within limit requests
+	// this minute. An error means the count couldn't be checked.
+	Allow(ctx context.Context, key string, limit int) (bool, error)
+}
+
+// SetAPITokens enables personal API tokens. Rate limits are shared through limiter; when
+// it is nil or can't be reached, each process enforces them on its own.
+func (s *UserService) SetAPITokens(repo repository.APITokenRepository, limiter APITokenRateLimiter) {
+	s.apiTokenRepo = repo
+	s.apiTokenLimiter = limiter
+	s.apiTokenLocalLimiter = newUserRateLimiter(DefaultAPITokenRateLimit, time.Minute)
+}
+
+// CreateAPITokenRequest contains the parameters for creating an API token.
+type CreateAPITokenRequest struct {
+	Name               string
+	Scopes             []string
+	ExpiresInDays      int // 0 for the default of 90 days
+	RateLimitPerMinute int // 0 for the default of 60
+}
+
+// CreateAPIToken creates an API token acting as the caller and returns it with its
+// secret. The secret is not stored and can't be shown again.
+func (s *UserService) CreateAPIToken(ctx context.Context, kratosID uuid.UUID, req CreateAPITokenRequest) (*entity.APIToken, string, error) {
+	if s.apiTokenRepo == nil {
+		return nil, "", domainerrors.ErrInternal.WithMessage("API tokens are not configured")
+	}
+
+	user, err := s.apiTokenUser(ctx, kratosID)
+	if err != nil {
+		return nil, "", err
+	}
+	log := s.logger.With("userID", user.ID)
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, "", domainerrors.ErrMissingRequired.WithMessage("token name is required")
+	}
+	if len(name) > maxAPITokenNameLength {
+		return nil, "", domainerrors.ErrInvalidInput.WithMessage(fmt.Sprintf("token name can be at most %d characters", maxAPITokenNameLength))
+	}
+	scopes, err := parseAPITokenScopes(req.Scopes)
+	if err != nil {
+		return nil, "", err
+	}
+
+	days := req.ExpiresInDays
+	if days == 0 {
+		days = defaultAPITokenLifetimeDays
+	}
+	if days < 1 || days > maxAPITokenLifetimeDays {
+		return nil, "", domainerrors.ErrInvalidInput.WithMessage(fmt.Sprintf("tokens can be valid for 1 to %d days", maxAPITokenLifetimeDays))
+	}
+	rateLimit := req.RateLimitPerMinute
+	if rateLimit == 0 {
+		rateLimit = DefaultAPITokenRateLimit
+	}
+	if rateLimit < 1 || rateLimit > MaxAPITokenRateLimit {
+		return nil, "", domainerrors.ErrInvalidInput.WithMessage(fmt.Sprintf("rate limit must be 1 to %d requests per minute", MaxAPITokenRateLimit))
+	}
+
+	active, err := s.apiTokenRepo.CountActiveByUserID(ctx, user.ID)
+	if err != nil {
+		log.Error("failed to count API tokens", "error", err)
+		return nil, "", domainerrors.ErrInternal.WithCause(err)
+	}
+	if active >= MaxAPITokensPerUser {
+		return nil, "", domainerrors.ErrInvalidInput.WithMessage(fmt.Sprintf("you can have at most %d active API tokens - revoke one first", MaxAPITokensPerUser))
+	}
+
+	secret, err := generateAPITokenSecret()
+	if err != nil {
+		log.Error("failed to generate API token secret", "error", err)
+		return nil, "", domainerrors.ErrInternal.WithCause(err)
+	}
+
+	token := &entity.APIToken{
+		TenantID:           *user.TenantID,
+		UserID:             user.ID,
+		Name:               name,
+		TokenPrefix:        secret[:apiTokenPrefixChars],
+		SecretHash:         hashAPITokenSecret(secret),
+		Scopes:             scopes,
+		RateLimitPerMinute: rateLimit,
+		ExpiresAt:          time.Now().AddDate(0, 0, days),
+		CreatedByUserID:    &user.ID,
+	}
+	scopeNames := make([]string, len(scopes))
+	for i, scope := range scopes {
+		scopeNames[i] = scope.String()
+	}
+	entry := apiTokenAuditEntry(user, audit.ActionAPITokenCreated, audit.Changes{}.
+		Field("name", "", name).
+		Field("scopes", "", strings.Join(scopeNames, " ")).
+		Field("expires_at", "", token.ExpiresAt.UTC().Format(time.RFC3339)))
+	if err := audited(ctx, s.auditLog, &entry, func(ctx context.Context) error {
+		if err := s.apiTokenRepo.Create(ctx, token); err != nil {
+			return err
+		}
+		entry.TargetID = token.ID.String()
+		return nil
+	}); err != nil {
+		log.Error("failed to create API token", "error", err)
+		return nil, "", domainerrors.ErrInternal.WithCause(err)
+	}
+
+	log.Info("API token created", "tokenID", token.ID, "scopes", scopeNames)
+	return token, secret, nil
+}
+
+// ListAPITokens returns the caller's API tokens, revoked and expired ones included,
+// newest first.
+func (s *UserService) ListAPITokens(ctx context.Context, kratosID uuid.UUID) ([]*entity.APIToken, error) {
+	if s.apiTokenRepo == nil {
+		return nil, domainerrors.ErrInternal.WithMessage("API tokens are not configured")
+	}
+
+	user, err := s.apiTokenUser(ctx, kratosID)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := s.apiTokenRepo.ListByUserID(ctx, user.ID)
+	if err != nil {
+		s.logger.Error("failed to list API tokens", "userID", user.ID, "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	return tokens, nil
+}
+
+// RevokeAPIToken revokes one of the caller's API tokens. Requests bearing it are
+// rejected from then on.
+func (s *UserService) RevokeAPIToken(ctx context.Context, kratosID, tokenID uuid.UUID) (*entity.APIToken, error) {
+	if s.apiTokenRepo == nil {
+		return nil, domainerrors.ErrInternal.WithMessage("API tokens are not configured")
+	}
+
+	user, err := s.apiTokenUser(ctx, kratosID)
+	if err != nil {
+		return nil, err
+	}
+	log := s.logger.With("userID", user.ID, "tokenID", tokenID)
+
+	token, err := s.apiTokenRepo.GetByID(ctx, tokenID)
+	if err != nil {
+		log.Error("failed to get API token", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	if token == nil || token.UserID != user.ID {
+		return nil, domainerrors.ErrNotFound.WithMessage("API token not found")
+	}
+	if token.RevokedAt != nil {
+		return token, nil
+	}
+
+	entry := apiTokenAuditEntry(user, audit.ActionAPITokenRevoked, audit.Changes{}.Field("revoked", false, true))
+	entry.TargetID = token.ID.String()
+	if err := audited(ctx, s.auditLog, &entry, func(ctx context.Context) error {
+		return s.apiTokenRepo.Revoke(ctx, token.ID)
+	}); err != nil {
+		log.Error("failed to revoke API token", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	now := time.Now()
+	token.RevokedAt = &now
+
+	log.Info("API token revoked")
+	return token, nil
+}
+
+// APITokenIdentity is who a request bearing an API token acts as.
+type APITokenIdentity struct {
+	Token *entity.APIToken
+	User  *entity.User
+}
+
+// AuthenticateAPIToken resolves an API token secret to its token and user, checks the
+// token grants the required scope, and only then counts the request against the token's
+// rate limit and records its use. Unknown, revoked and expired tokens, and tokens of
+// deactivated users, are all rejected alike.
+func (s *UserService) AuthenticateAPIToken(ctx context.Context, secret string, required valueobject.APITokenScope) (*APITokenIdentity, error) {
+	if s.apiTokenRepo == nil || !strings.HasPrefix(secret, APITokenSecretPrefix) {
+		return nil, domainerrors.ErrUnauthorized
+	}
+	adminCtx := tenant.WithSuperAdmin(ctx, true)
+
+	token, err := s.apiTokenRepo.GetBySecretHash(adminCtx, hashAPITokenSecret(secret))
+	if err != nil {
+		s.logger.Error("failed to look up API token", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	if token == nil || !token.IsActive() {
+		return nil, domainerrors.ErrUnauthorized
+	}
+
+	user, err := s.userRepo.GetByID(adminCtx, token.UserID)
+	if err != nil {
+		s.logger.Error("failed to get API token user", "tokenID", token.ID, "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	if user == nil || !user.IsActive || user.TenantID == nil || *user.TenantID != token.TenantID {
+		return nil, domainerrors.ErrUnauthorized
+	}
+
+	if !token.HasScope(required) {
+		return nil, domainerrors.ErrForbidden.WithMessage(fmt.Sprintf("API token lacks the %s scope", required))
+	}
+
+	if !s.allowAPITokenRequest(ctx, token) {
+		return nil, domainerrors.ErrRateLimited.WithMessage(fmt.Sprintf("API token rate limit of %d requests per minute exceeded", token.RateLimitPerMinute))
+	}
+
+	if err := s.apiTokenRepo.TouchLastUsed(adminCtx, token.ID); err != nil {
+		s.logger.Warn("failed to record API token use", "tokenID", token.ID, "error", err)
+	}
+
+	return &APITokenIdentity{Token: token, User: user}, nil
+}
+
+// allowAPITokenRequest counts a request against the token's rate limit. While the shared
+// limiter can't be reached, the limit is enforced per process rather than not at all.
+func (s *UserService) allowAPITokenRequest(ctx context.Context, token *entity.APIToken) bool {
+	if s.apiTokenLimiter != nil {
+		allowed, err := s.apiTokenLimiter.Allow(ctx, token.ID.String(), token.RateLimitPerMinute)
+		if err == nil {
+			return allowed
+		}
+		s.logger.Warn("shared API token rate limit unavailable, limiting per process", "tokenID", token.ID, "error", err)
+	}
+	return s.apiTokenLocalLimiter.AllowUpTo(token.ID, token.RateLimitPerMinute)
+}
+
+// apiTokenUser loads the caller, who must belong to a company to hold API tokens.
+func (s *UserService) apiTokenUser(ctx context.Context, kratosID uuid.UUID) (*entity.User, error) {
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, domainerrors.ErrUserNotFound
+	}
+	if user.CompanyID == nil || user.TenantID == nil {
+		return nil, domainerrors.ErrUserHasNoCompany
+	}
+	return user, nil
+}
+
+// parseAPITokenScopes validates and deduplicates requested scopes. At least one is required.
+func parseAPITokenScopes(names []string) ([]valueobject.APITokenScope, error) {
+	var scopes []valueobject.APITokenScope
+	seen := make(map[valueobject.APITokenScope]bool, len(names))
+	for _, name := range names {
+		scope, err := valueobject.ParseAPITokenScope(strings.TrimSpace(name))
+		if err != nil {
+			return nil, domainerrors.ErrInvalidInput.WithMessage(fmt.Sprintf("unknown scope %q; use course:read or course:write", name))
+		}
+		if !seen[scope] {
+			seen[scope] = true
+			scopes = append(scopes, scope)
+		}
+	}
+	if len(scopes) == 0 {
+		return nil, domainerrors.ErrMissingRequired.WithMessage("at least one scope is required")
+	}
+	return scopes, nil
+}
+
+// generateAPITokenSecret returns a new random secret starting with APITokenSecretPrefix.
+func generateAPITokenSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return APITokenSecretPrefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hashAPITokenSecret returns the hex SHA-256 of a secret, as stored. Secrets are random
+// and long, so an unsalted fast hash is enough.
+func hashAPITokenSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// apiTokenAuditEntry describes an action a user took on one of their API tokens.
+func apiTokenAuditEntry(user *entity.User, action audit.Action, changes audit.Changes) audit.Entry {
+	return audit.Entry{
+		TenantID:    *user.TenantID,
+		ActorUserID: &user.ID,
+		Action:      action,
+		TargetType:  audit.TargetAPIToken,
+		Changes:     changes,
+	}
+}
@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+// fakeAPITokenRepo holds one token and records its uses.
+type fakeAPITokenRepo struct {
+	repository.APITokenRepository
+	token   *entity.APIToken
+	touched int
+}
+
+func (r *fakeAPITokenRepo) GetBySecretHash(_ context.Context, secretHash string) (*entity.APIToken, error) {
+	if r.token.SecretHash != secretHash {
+		return nil, nil
+	}
+	copied := *r.token
+	return &copied, nil
+}
+
+func (r *fakeAPITokenRepo) TouchLastUsed(context.Context, uuid.UUID) error {
+	r.touched++
+	return nil
+}
+
+// fakeAPITokenLimiter counts calls per key as a shared limiter would, or fails with err.
+type fakeAPITokenLimiter struct {
+	counts map[string]int
+	err    error
+}
+
+func (l *fakeAPITokenLimiter) Allow(_ context.Context, key string, limit int) (bool, error) {
+	if l.err != nil {
+		return false, l.err
+	}
+	l.counts[key]++
+	return l.counts[key] <= limit, nil
+}
+
+// apiTokenFixture is a UserService with one active course:read token limited to two
+// requests per minute.
+type apiTokenFixture struct {
+	svc     *UserService
+	tokens  *fakeAPITokenRepo
+	limiter *fakeAPITokenLimiter
+	secret  string
+}
+
+func newAPITokenFixture(t *testing.T) *apiTokenFixture {
+	t.Helper()
+	secret, err := generateAPITokenSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tenantID := uuid.New()
+	user := &entity.User{ID: uuid.New(), TenantID: &tenantID, KratosID: uuid.New(), IsActive: true}
+	f := &apiTokenFixture{
+		tokens: &fakeAPITokenRepo{token: &entity.APIToken{
+			ID:                 uuid.New(),
+			TenantID:           tenantID,
+			UserID:             user.ID,
+			SecretHash:         hashAPITokenSecret(secret),
+			Scopes:             []valueobject.APITokenScope{valueobject.APITokenScopeCourseRead},
+			RateLimitPerMinute: 2,
+			ExpiresAt:          time.Now().Add(time.Hour),
+		}},
+		limiter: &fakeAPITokenLimiter{counts: make(map[string]int)},
+		secret:  secret,
+	}
+	f.svc = NewUserService(&fakeUserRepo{users: []*entity.User{user}}, nil, nil, nil, nil, nil, nopLogger{}, "")
+	f.svc.SetAPITokens(f.tokens, f.limiter)
+	return f
+}
+
+func TestAuthenticateAPITokenChecksScopeBeforeCountingUse(t *testing.T) {
+	f := newAPITokenFixture(t)
+
+	for range 5 {
+		_, err := f.svc.AuthenticateAPIToken(context.Background(), f.secret, valueobject.APITokenScopeCourseWrite)
+		if !errors.Is(err, domainerrors.ErrForbidden) {
+			t.Fatalf("error = %v, want ErrForbidden", err)
+		}
+	}
+	if f.tokens.touched != 0 || len(f.limiter.counts) != 0 {
+		t.Errorf("denied requests recorded %d uses and counted %v against the limit", f.tokens.touched, f.limiter.counts)
+	}
+
+	identity, err := f.svc.AuthenticateAPIToken(context.Background(), f.secret, valueobject.APITokenScopeCourseRead)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if identity.Token.ID != f.tokens.token.ID || f.tokens.touched != 1 {
+		t.Errorf("identity for token %s with %d uses recorded, want %s with 1", identity.Token.ID, f.tokens.touched, f.tokens.token.ID)
+	}
+}
+
+func TestAuthenticateAPITokenRateLimit(t *testing.T) {
+	tests := []struct {
+		name       string
+		limiterErr error
+		// Requests other processes already made this minute, seen only by a shared limiter
+		elsewhere int
+		allowed   int
+	}{
+		{name: "shared limiter", allowed: 2},
+		{name: "shared limiter counts other processes", elsewhere: 1, allowed: 1},
+		{name: "shared limiter unavailable", limiterErr: errors.New("redis down"), elsewhere: 1, allowed: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newAPITokenFixture(t)
+			f.limiter.err = tt.limiterErr
+			f.limiter.counts[f.tokens.token.ID.String()] = tt.elsewhere
+
+			for i := range 4 {
+				_, err := f.svc.AuthenticateAPIToken(context.Background(), f.secret, valueobject.APITokenScopeCourseRead)
+				if i < tt.allowed && err != nil {
+					t.Fatalf("request %d: unexpected error: %v", i+1, err)
+				}
+				if i >= tt.allowed && !errors.Is(err, domainerrors.ErrRateLimited) {
+					t.Fatalf("request %d: error = %v, want ErrRateLimited", i+1, err)
+				}
+			}
+			if f.tokens.touched != tt.allowed {
+				t.Errorf("recorded %d uses, want %d", f.tokens.touched, tt.allowed)
+			}
+		})
+	}
+}
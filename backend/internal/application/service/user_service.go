@@ -2,30 +2,46 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/sogos/mirai-backend/internal/application/dto"
+	"github.com/sogos/mirai-backend/internal/domain/audit"
 	"github.com/sogos/mirai-backend/internal/domain/entity"
 	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
 	"github.com/sogos/mirai-backend/internal/domain/repository"
 	"github.com/sogos/mirai-backend/internal/domain/service"
 	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+	"github.com/sogos/mirai-backend/internal/infrastructure/cache"
 )
 
 // UserService handles user-related business logic.
 type UserService struct {
 	userRepo    repository.UserRepository
 	companyRepo repository.CompanyRepository
+	taskRepo    repository.SMETaskRepository
+	jobRepo     repository.GenerationJobRepository
 	identity    service.IdentityProvider
 	payments    service.PaymentProvider
+	auditLog    AuditLogger
+	onboarding  repository.OnboardingRepository
+	cache       cache.Cache
 	logger      service.Logger
 	frontendURL string
+
+	// Personal API tokens; nil until SetAPITokens
+	apiTokenRepo    repository.APITokenRepository
+	apiTokenLimiter APITokenRateLimiter
+	// Enforces rate limits per process when apiTokenLimiter is nil or unavailable
+	apiTokenLocalLimiter *userRateLimiter
 }
 
 // NewUserService creates a new user service.
 func NewUserService(
 	userRepo repository.UserRepository,
 	companyRepo repository.CompanyRepository,
+	taskRepo repository.SMETaskRepository,
+	jobRepo repository.GenerationJobRepository,
 	identity service.IdentityProvider,
 	payments service.PaymentProvider,
 	logger service.Logger,
@@ -34,6 +50,8 @@ func NewUserService(
 	return &UserService{
 		userRepo:    userRepo,
 		companyRepo: companyRepo,
+		taskRepo:    taskRepo,
+		jobRepo:     jobRepo,
 		identity:    identity,
 		payments:    payments,
 		logger:      logger,
@@ -41,6 +59,11 @@ func NewUserService(
 	}
 }
 
+// SetAuditLogger enables audit logging of user deactivation and reactivation.
+func (s *UserService) SetAuditLogger(logger AuditLogger) {
+	s.auditLog = logger
+}
+
 // GetCurrentUser retrieves the current user with their company.
 func (s *UserService) GetCurrentUser(ctx context.Context, kratosID uuid.UUID) (*dto.UserWithCompanyResponse, error) {
 	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
@@ -61,6 +84,7 @@ func (s *UserService) GetCurrentUser(ctx context.Context, kratosID uuid.UUID) (*
 		email = identity.Email
 		firstName = identity.FirstName
 		lastName = identity.LastName
+		s.syncLocale(ctx, user, identity.Locale)
 	}
 
 	response := &dto.UserWithCompanyResponse{
@@ -80,6 +104,23 @@ func (s *UserService) GetCurrentUser(ctx context.Context, kratosID uuid.UUID) (*
 	return response, nil
 }
 
+// syncLocale copies the locale trait from Kratos onto the user so background emails
+// can be localized without a Kratos lookup. Failures are logged and ignored.
+func (s *UserService) syncLocale(ctx context.Context, user *entity.User, trait string) {
+	if trait == "" {
+		return
+	}
+	locale := valueobject.ParseLocale(trait)
+	if locale == user.Locale {
+		return
+	}
+
+	user.Locale = locale
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		s.logger.Warn("failed to sync user locale", "userID", user.ID, "locale", locale, "error", err)
+	}
+}
+
 // Onboard handles user onboarding (for users who registered but need to set up company).
 func (s *UserService) Onboard(ctx context.Context, kratosID uuid.UUID, req dto.OnboardRequest, email string) (*dto.OnboardResponse, error) {
 	log := s.logger.With("kratosID", kratosID, "company", req.CompanyName)
@@ -214,3 +255,211 @@ func (s *UserService) ListUsersByCompany(ctx context.Context, kratosID uuid.UUID
 	}
 	return responses, nil
 }
+
+// DeactivateUserRequest contains the parameters for deactivating a user.
+type DeactivateUserRequest struct {
+	UserID           uuid.UUID
+	ReassignToUserID *uuid.UUID // Receives the user's open work; defaults to the requesting admin
+}
+
+// DeactivateUserResult reports the deactivated user and the work moved off them.
+type DeactivateUserResult struct {
+	User            *dto.UserResponse
+	ReassignedTo    uuid.UUID
+	ReassignedTasks []*entity.SMETask
+	ReassignedJobs  []*entity.GenerationJob
+}
+
+// DeactivateUser disables a user's sign-in and frees their seat while keeping the
+// user row, so courses and SMEs they authored keep their creator. SME tasks waiting
+// on the user and their in-flight generation jobs are moved to another active user.
+func (s *UserService) DeactivateUser(ctx context.Context, kratosID uuid.UUID, req DeactivateUserRequest) (*DeactivateUserResult, error) {
+	log := s.logger.With("kratosID", kratosID, "userID", req.UserID)
+
+	admin, target, err := s.getManagedUser(ctx, kratosID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if target.ID == admin.ID {
+		return nil, domainerrors.ErrInvalidInput.WithMessage("you cannot deactivate yourself")
+	}
+	if !target.IsActive {
+		return nil, domainerrors.ErrInvalidInput.WithMessage("user is already deactivated")
+	}
+
+	assignee := admin
+	if req.ReassignToUserID != nil && *req.ReassignToUserID != admin.ID {
+		assignee, err = s.userRepo.GetByID(ctx, *req.ReassignToUserID)
+		if err != nil {
+			log.Error("failed to get reassignment target", "error", err)
+			return nil, domainerrors.ErrInternal.WithCause(err)
+		}
+		if assignee == nil || assignee.CompanyID == nil || *assignee.CompanyID != *admin.CompanyID {
+			return nil, domainerrors.ErrUserNotFound.WithMessage("reassignment target not found")
+		}
+	}
+	if assignee.ID == target.ID || !assignee.IsActive {
+		return nil, domainerrors.ErrInvalidInput.WithMessage("open work must be reassigned to another active user")
+	}
+
+	// Block sign-in first; the remaining steps can be retried if one of them fails
+	if err := s.identity.SetIdentityActive(ctx, target.KratosID.String(), false); err != nil {
+		log.Error("failed to disable identity", "error", err)
+		return nil, domainerrors.ErrExternalService.WithCause(err)
+	}
+
+	tasks, err := s.taskRepo.List(ctx, entity.SMETaskListOptions{AssignedToUserID: &target.ID})
+	if err != nil {
+		log.Error("failed to list assigned tasks", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	reassignedTasks := make([]*entity.SMETask, 0, len(tasks))
+	for _, task := range tasks {
+		if !task.Status.AwaitsAssignee() {
+			continue
+		}
+		task.AssignedToUserID = assignee.ID
+		if err := s.taskRepo.Update(ctx, task); err != nil {
+			log.Error("failed to reassign task", "taskID", task.ID, "error", err)
+			return nil, domainerrors.ErrInternal.WithCause(err)
+		}
+		reassignedTasks = append(reassignedTasks, task)
+	}
+
+	reassignedJobs, err := s.jobRepo.ReassignActiveJobs(ctx, target.ID, assignee.ID)
+	if err != nil {
+		log.Error("failed to reassign generation jobs", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	now := time.Now()
+	target.IsActive = false
+	target.DeactivatedAt = &now
+	entry := userAuditEntry(admin, target, audit.ActionUserDeactivated, audit.Changes{}.
+		Field("is_active", true, false).
+		Field("open_work_reassigned_to", "", assignee.ID))
+	if err := audited(ctx, s.auditLog, &entry, func(ctx context.Context) error {
+		return s.userRepo.Update(ctx, target)
+	}); err != nil {
+		log.Error("failed to mark user inactive", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	log.Info("user deactivated",
+		"reassignedTo", assignee.ID,
+		"tasks", len(reassignedTasks),
+		"jobs", len(reassignedJobs),
+	)
+
+	return &DeactivateUserResult{
+		User:            dto.FromUser(target),
+		ReassignedTo:    assignee.ID,
+		ReassignedTasks: reassignedTasks,
+		ReassignedJobs:  reassignedJobs,
+	}, nil
+}
+
+// ReactivateUser restores sign-in for a deactivated user. The user takes a seat
+// again, so this fails when the company has none left.
+func (s *UserService) ReactivateUser(ctx context.Context, kratosID uuid.UUID, userID uuid.UUID) (*dto.UserResponse, error) {
+	log := s.logger.With("kratosID", kratosID, "userID", userID)
+
+	admin, target, err := s.getManagedUser(ctx, kratosID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if target.IsActive {
+		return nil, domainerrors.ErrInvalidInput.WithMessage("user is already active")
+	}
+
+	company, err := s.companyRepo.GetByID(ctx, *admin.CompanyID)
+	if err != nil {
+		log.Error("failed to get company", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	if company == nil {
+		return nil, domainerrors.ErrCompanyNotFound
+	}
+	usedSeats, err := s.companyRepo.CountUsersByCompanyID(ctx, company.ID)
+	if err != nil {
+		log.Error("failed to count seats", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	if usedSeats >= company.EffectiveSeatCount() {
+		return nil, domainerrors.ErrSeatLimitExceeded
+	}
+
+	if err := s.identity.SetIdentityActive(ctx, target.KratosID.String(), true); err != nil {
+		log.Error("failed to enable identity", "error", err)
+		return nil, domainerrors.ErrExternalService.WithCause(err)
+	}
+
+	target.IsActive = true
+	target.DeactivatedAt = nil
+	entry := userAuditEntry(admin, target, audit.ActionUserReactivated, audit.Changes{}.Field("is_active", false, true))
+	if err := audited(ctx, s.auditLog, &entry, func(ctx context.Context) error {
+		return s.userRepo.Update(ctx, target)
+	}); err != nil {
+		log.Error("failed to mark user active", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	log.Info("user reactivated")
+	return dto.FromUser(target), nil
+}
+
+// UpdateEmailPreferences sets which optional emails the caller receives. Only the weekly
+// summary, sent to admins, can be turned off.
+func (s *UserService) UpdateEmailPreferences(ctx context.Context, kratosID uuid.UUID, weeklySummaryOptOut bool) (*dto.UserResponse, error) {
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, domainerrors.ErrUserNotFound
+	}
+
+	if user.WeeklySummaryOptOut != weeklySummaryOptOut {
+		if err := s.userRepo.SetWeeklySummaryOptOut(ctx, user.ID, weeklySummaryOptOut); err != nil {
+			s.logger.Error("failed to update email preferences", "userID", user.ID, "error", err)
+			return nil, domainerrors.ErrInternal.WithCause(err)
+		}
+		user.WeeklySummaryOptOut = weeklySummaryOptOut
+	}
+
+	return dto.FromUser(user), nil
+}
+
+// getManagedUser loads the requesting admin and a user in the admin's company.
+func (s *UserService) getManagedUser(ctx context.Context, kratosID uuid.UUID, userID uuid.UUID) (*entity.User, *entity.User, error) {
+	admin, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || admin == nil {
+		return nil, nil, domainerrors.ErrUserNotFound
+	}
+	if admin.CompanyID == nil || admin.TenantID == nil {
+		return nil, nil, domainerrors.ErrUserHasNoCompany
+	}
+	if !admin.CanManageCompany() {
+		return nil, nil, domainerrors.ErrForbidden.WithMessage("only admins can deactivate or reactivate users")
+	}
+
+	target, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		s.logger.Error("failed to get user", "userID", userID, "error", err)
+		return nil, nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	if target == nil || target.CompanyID == nil || *target.CompanyID != *admin.CompanyID {
+		return nil, nil, domainerrors.ErrUserNotFound
+	}
+
+	return admin, target, nil
+}
+
+// userAuditEntry describes an action an admin took on a user in their company.
+func userAuditEntry(admin, target *entity.User, action audit.Action, changes audit.Changes) audit.Entry {
+	return audit.Entry{
+		TenantID:    *admin.TenantID,
+		ActorUserID: &admin.ID,
+		Action:      action,
+		TargetType:  audit.TargetUser,
+		TargetID:    target.ID.String(),
+		Changes:     changes,
+	}
+}
@@ -0,0 +1,165 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/links"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+	"github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/domain/tenant"
+)
+
+// SetWeeklySummary enables the weekly summary email to tenant admins.
+func (s *NotificationService) SetWeeklySummary(summaryRepo repository.WeeklySummaryRepository, settingsRepo repository.TenantAISettingsRepository) {
+	s.summaryRepo = summaryRepo
+	s.settingsRepo = settingsRepo
+}
+
+// SendDueWeeklySummaries sends last week's summary for every tenant whose scheduled
+// day and hour this week have passed. Each week is claimed before sending, so a tenant
+// is mailed at most once per week even across worker restarts. Returns how many
+// tenants' summaries were sent.
+func (s *NotificationService) SendDueWeeklySummaries(ctx context.Context, now time.Time) (int, error) {
+	if s.summaryRepo == nil || s.settingsRepo == nil || s.emailProvider == nil {
+		return 0, nil
+	}
+
+	tenantIDs, err := s.summaryRepo.ListEnabledTenantIDs(tenant.WithSuperAdmin(ctx, true))
+	if err != nil {
+		return 0, fmt.Errorf("failed to list weekly summary tenants: %w", err)
+	}
+
+	sent := 0
+	for _, tenantID := range tenantIDs {
+		if ctx.Err() != nil {
+			return sent, nil
+		}
+		ok, err := s.sendWeeklySummary(tenant.WithTenantID(ctx, tenantID), tenantID, now)
+		if err != nil {
+			s.logger.Error("failed to send weekly summary", "tenantID", tenantID, "error", err)
+			continue
+		}
+		if ok {
+			sent++
+		}
+	}
+	return sent, nil
+}
+
+// sendWeeklySummary mails the tenant's admins if its summary is due and unsent. Weeks
+// without activity are recorded as sent to nobody so they aren't checked again.
+func (s *NotificationService) sendWeeklySummary(ctx context.Context, tenantID uuid.UUID, now time.Time) (bool, error) {
+	log := s.logger.With("tenantID", tenantID)
+
+	settings, err := s.settingsRepo.Get(ctx, tenantID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get AI settings: %w", err)
+	}
+	if settings == nil || !settings.WeeklySummary.Enabled {
+		return false, nil
+	}
+	weekStart, due := settings.WeeklySummary.ReportingWeek(now)
+	if !due {
+		return false, nil
+	}
+
+	claimed, err := s.summaryRepo.ClaimSend(ctx, tenantID, weekStart)
+	if err != nil || !claimed {
+		return false, err
+	}
+
+	recipients, err := s.deliverWeeklySummary(ctx, tenantID, weekStart, settings.MonthlyTokenLimit)
+	if err != nil {
+		if releaseErr := s.summaryRepo.ReleaseSend(ctx, tenantID, weekStart); releaseErr != nil {
+			log.Warn("failed to release weekly summary claim", "error", releaseErr)
+		}
+		return false, err
+	}
+
+	if err := s.summaryRepo.CompleteSend(ctx, tenantID, weekStart, recipients); err != nil {
+		log.Warn("failed to record weekly summary send", "error", err)
+	}
+	if recipients > 0 {
+		log.Info("weekly summary sent", "weekStart", weekStart.Format(time.DateOnly), "recipients", recipients)
+	}
+	return recipients > 0, nil
+}
+
+// deliverWeeklySummary gathers the week's figures and emails them to every active admin
+// who hasn't opted out or bounced, returning how many were sent. It fails only when admins were
+// eligible and none of the emails went out, so the week can be retried.
+func (s *NotificationService) deliverWeeklySummary(ctx context.Context, tenantID uuid.UUID, weekStart time.Time, monthlyLimit *int64) (int, error) {
+	log := s.logger.With("tenantID", tenantID)
+
+	summary, err := s.summaryRepo.GetFigures(ctx, tenantID, weekStart, weekStart.AddDate(0, 0, 7))
+	if err != nil {
+		return 0, err
+	}
+	if summary.IsEmpty() {
+		log.Info("no activity last week, skipping weekly summary", "weekStart", weekStart.Format(time.DateOnly))
+		return 0, nil
+	}
+
+	summary.MonthlyTokenLimit = monthlyLimit
+	summary.TokensThisMonth, err = s.settingsRepo.GetCurrentPeriodUsage(ctx, tenantID)
+	if err != nil {
+		return 0, err
+	}
+
+	admins, err := s.userRepo.ListActiveAdminsByTenantID(ctx, tenantID)
+	if err != nil {
+		return 0, err
+	}
+
+	sent, attempted := 0, 0
+	var lastErr error
+	for _, admin := range admins {
+		if admin.WeeklySummaryOptOut || s.identityProvider == nil {
+			continue
+		}
+		identity, err := s.identityProvider.GetIdentity(ctx, admin.KratosID.String())
+		if err != nil || identity == nil || identity.Email == "" {
+			log.Warn("failed to get admin identity for weekly summary", "userID", admin.ID, "error", err)
+			continue
+		}
+
+		req := service.SendWeeklySummaryRequest{
+			To:                 identity.Email,
+			Locale:             resolveLocale(ctx, s.locales, admin),
+			UserName:           identity.FirstName,
+			WeekStart:          summary.WeekStart,
+			WeekEnd:            summary.WeekEnd.AddDate(0, 0, -1),
+			CoursesGenerated:   summary.CoursesGenerated,
+			TokensUsed:         summary.TokensUsed,
+			TokensThisMonth:    summary.TokensThisMonth,
+			MonthlyTokenLimit:  summary.MonthlyTokenLimit,
+			FailedJobs:         summary.FailedJobs,
+			OverdueSMETasks:    summary.OverdueSMETasks,
+			PendingInvitations: summary.PendingInvitations,
+			DashboardURL:       s.baseURL + links.DashboardLink(),
+		}
+		err = s.sendUserEmail(ctx, admin, identity.Email, func() error {
+			return s.emailProvider.SendWeeklySummary(ctx, req)
+		})
+		if errors.Is(err, errEmailBouncing) {
+			continue
+		}
+		attempted++
+		if err != nil {
+			log.Warn("failed to send weekly summary email", "userID", admin.ID, "error", err)
+			lastErr = err
+			continue
+		}
+		sent++
+	}
+
+	if sent == 0 && attempted > 0 {
+		return 0, fmt.Errorf("weekly summary could not be sent to any admin: %w", lastErr)
+	}
+	return sent, nil
+}
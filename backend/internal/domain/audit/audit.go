@@ -0,0 +1,156 @@
+// Package audit describes the administrative actions recorded in the audit log.
+package audit
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// Action identifies what an audited actor did.
+type Action string
+
+const (
+	ActionUserDeactivated Action = "user.deactivated"
+	ActionUserReactivated Action = "user.reactivated"
+
+	ActionInvitationCreated Action = "invitation.created"
+	ActionInvitationRevoked Action = "invitation.revoked"
+	ActionInvitationResent  Action = "invitation.resent"
+
+	ActionCheckoutStarted       Action = "billing.checkout_started"
+	ActionSubscriptionActivated Action = "billing.subscription_activated"
+	ActionSubscriptionUpdated   Action = "billing.subscription_updated"
+	ActionSubscriptionCanceled  Action = "billing.subscription_canceled"
+	ActionSeatCountUpdated      Action = "billing.seat_count_updated"
+
+	ActionAPIKeySet                 Action = "ai_settings.api_key_set"
+	ActionAPIKeyRemoved             Action = "ai_settings.api_key_removed"
+	ActionGenerationDefaultsUpdated Action = "ai_settings.generation_defaults_updated"
+	ActionOutlineAutoApproveUpdated Action = "ai_settings.outline_auto_approve_updated"
+	ActionLocaleUpdated             Action = "ai_settings.locale_updated"
+	ActionCourseDefaultsUpdated     Action = "ai_settings.course_defaults_updated"
+	ActionPromptCacheUpdated        Action = "ai_settings.prompt_cache_updated"
+	ActionWeeklySummaryUpdated      Action = "ai_settings.weekly_summary_updated"
+	ActionAIGenerationToggled       Action = "ai_settings.generation_toggled"
+	ActionSecondReviewerUpdated     Action = "ai_settings.second_reviewer_updated"
+	ActionExportRetentionUpdated    Action = "ai_settings.export_retention_updated"
+
+	ActionCourseDeleted       Action = "course.deleted"
+	ActionFolderDeleted       Action = "folder.deleted"
+	ActionCollaboratorRemoved Action = "course.collaborator_removed"
+
+	ActionCourseChangesPublished Action = "course.changes_published"
+	ActionCourseDraftDiscarded   Action = "course.draft_discarded"
+
+	ActionKnowledgeInjectionReviewed Action = "sme_knowledge.injection_reviewed"
+
+	ActionLMSConnectorCreated Action = "lms_connector.created"
+	ActionLMSConnectorUpdated Action = "lms_connector.updated"
+	ActionLMSConnectorDeleted Action = "lms_connector.deleted"
+	ActionFolderSyncUpdated   Action = "folder.sync_updated"
+
+	ActionCacheWarmed      Action = "cache.warmed"
+	ActionCacheInvalidated Action = "cache.invalidated"
+
+	ActionStorageKeyRotated        Action = "storage.key_rotated"
+	ActionStorageEncryptionStarted Action = "storage.encryption_started"
+	ActionStorageMigrationStarted  Action = "storage.region_migration_started"
+	ActionStorageRegionChanged     Action = "storage.region_changed"
+
+	ActionAPITokenCreated Action = "api_token.created"
+	ActionAPITokenRevoked Action = "api_token.revoked"
+
+	// An inline AI edit proposed new component content; records whether the prompt
+	// cache served it and the tokens it spent
+	ActionComponentEditGenerated Action = "lesson_component.edit_generated"
+)
+
+// TargetType identifies the kind of resource an action applied to.
+type TargetType string
+
+const (
+	TargetUser       TargetType = "user"
+	TargetInvitation TargetType = "invitation"
+	TargetCompany    TargetType = "company"
+	TargetAISettings TargetType = "ai_settings"
+	TargetCourse     TargetType = "course"
+	TargetFolder     TargetType = "folder"
+
+	TargetKnowledgeChunk TargetType = "sme_knowledge_chunk"
+	TargetLMSConnector   TargetType = "lms_connector"
+	TargetTenant         TargetType = "tenant"
+	TargetAPIToken       TargetType = "api_token"
+	TargetComponent      TargetType = "lesson_component"
+)
+
+// Change records one field an action changed. Sensitive fields, such as API keys,
+// are recorded by name only.
+type Change struct {
+	Field     string `json:"field"`
+	Before    string `json:"before,omitempty"`
+	After     string `json:"after,omitempty"`
+	Sensitive bool   `json:"sensitive,omitempty"`
+}
+
+// Changes summarizes what an action changed.
+type Changes []Change
+
+// Field records a field whose value went from before to after, formatted with fmt.Sprint.
+// Unchanged fields are skipped.
+func (c Changes) Field(name string, before, after any) Changes {
+	b, a := fmt.Sprint(before), fmt.Sprint(after)
+	if b == a {
+		return c
+	}
+	return append(c, Change{Field: name, Before: b, After: a})
+}
+
+// Sensitive records that a sensitive field changed without recording its values.
+func (c Changes) Sensitive(name string) Changes {
+	return append(c, Change{Field: name, Sensitive: true})
+}
+
+// Entry describes an audited action.
+type Entry struct {
+	TenantID    uuid.UUID
+	ActorUserID *uuid.UUID // nil for system actions such as billing webhooks
+	Action      Action
+	TargetType  TargetType
+	TargetID    string
+	Changes     Changes
+}
+
+// Context key for the client address of the current request
+type clientIPKey struct{}
+
+// WithClientIP adds the address of the client making the current request to the context.
+func WithClientIP(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, clientIPKey{}, ip)
+}
+
+// ClientIPFromContext returns the client address of the current request, or "" outside a request.
+func ClientIPFromContext(ctx context.Context) string {
+	ip, _ := ctx.Value(clientIPKey{}).(string)
+	return ip
+}
+
+// Context key for the API token that authenticated the current request
+type apiTokenKey struct{}
+
+// WithAPIToken records that the current request was authenticated with an API token
+// rather than a browser session.
+func WithAPIToken(ctx context.Context, tokenID uuid.UUID) context.Context {
+	return context.WithValue(ctx, apiTokenKey{}, tokenID)
+}
+
+// APITokenFromContext returns the API token that authenticated the current request,
+// or nil for browser sessions and background work.
+func APITokenFromContext(ctx context.Context) *uuid.UUID {
+	tokenID, ok := ctx.Value(apiTokenKey{}).(uuid.UUID)
+	if !ok {
+		return nil
+	}
+	return &tokenID
+}
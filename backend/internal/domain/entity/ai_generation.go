@@ -20,14 +20,64 @@ type TenantAISettings struct {
 	// Stored as: nonce (12 bytes) || ciphertext || auth tag (16 bytes)
 	EncryptedAPIKey []byte
 
-	// Usage tracking
+	// Usage tracking. TotalTokensUsed is the sum of all usage periods; the limit applies
+	// to the current period.
 	TotalTokensUsed   int64
 	MonthlyTokenLimit *int64
 
+	// Component mix applied to new courses unless overridden
+	GenerationDefaults GenerationPreferences
+
+	// Whether GenerateCourseOutline may skip outline review and go straight to lessons
+	AllowOutlineAutoApprove bool
+
+	// Whether outline approval needs two reviewers other than the user who generated it:
+	// the first endorses, a different one approves
+	RequireSecondReviewer bool
+
+	// Organization locale for emails and exports; nil until an admin picks one
+	Locale *valueobject.Locale
+
+	// Settings new courses start with when created without their own
+	CourseDefaults CourseDefaults
+
+	// Whether identical component regeneration prompts must always call the provider
+	// instead of reusing a recent response
+	DisablePromptCache bool
+
+	// When admins receive the weekly summary email
+	WeeklySummary WeeklySummarySchedule
+
+	// Days export artifacts are kept before the cleanup deletes them. Only
+	// TenantAISettingsRepository.SetExportRetentionDays changes it.
+	ExportRetentionDays int
+
+	// Whether the tenant may generate at all. Only superadmins change it, through
+	// TenantAISettingsRepository.SetAIGenerationEnabled; Create and Update leave it alone.
+	AIGenerationEnabled bool
+
 	UpdatedAt       time.Time
 	UpdatedByUserID *uuid.UUID
 }
 
+// TokenUsagePeriod is a tenant's AI token usage for one calendar month (UTC).
+type TokenUsagePeriod struct {
+	TenantID   uuid.UUID
+	YearMonth  string // e.g. "2026-10"
+	TokensUsed int64
+	UpdatedAt  time.Time
+}
+
+// LegacyUsagePeriod holds the usage counted before usage was tracked per month, which
+// can't be attributed to one. It counts towards the all-time total but isn't a month of
+// the usage report.
+const LegacyUsagePeriod = "0000-00"
+
+// UsagePeriodOf returns the usage period t falls in.
+func UsagePeriodOf(t time.Time) string {
+	return t.UTC().Format("2006-01")
+}
+
 // HasAPIKey returns true if an API key is configured.
 func (s *TenantAISettings) HasAPIKey() bool {
 	return len(s.EncryptedAPIKey) > 0
@@ -47,6 +97,14 @@ type GenerationJob struct {
 	OutlineLessonID *uuid.UUID // References outline_lessons (set before generation)
 	SMETaskID       *uuid.UUID
 	SubmissionID    *uuid.UUID
+	SMEID           *uuid.UUID // SME a knowledge archive job exports, or the one an import creates
+	SourcePath      *string    // Tenant-relative path of an uploaded input, e.g. an archive to import
+
+	// IncludeCitations makes a lesson export cite the SME sources of each component
+	IncludeCitations bool
+
+	// PurgeOrphans makes a storage audit delete the orphaned objects it reports
+	PurgeOrphans bool
 
 	// Parent job ID - links child lesson jobs to parent full_course job
 	ParentJobID *uuid.UUID
@@ -59,9 +117,18 @@ type GenerationJob struct {
 	ResultPath   *string // S3 path to result JSON
 	ErrorMessage *string
 
+	// FailureReason classifies ErrorMessage; nil unless failed, and for jobs failed before it was recorded
+	FailureReason *valueobject.JobFailureReason
+
 	// Token usage for billing
 	TokensUsed int64
 
+	// Responses re-requested because they failed schema validation
+	RepairAttempts int32
+
+	// Images generated for image components, billed separately from tokens
+	ImagesGenerated int32
+
 	// Retry tracking
 	RetryCount int32
 	MaxRetries int32
@@ -70,6 +137,17 @@ type GenerationJob struct {
 	CreatedAt       time.Time
 	StartedAt       *time.Time
 	CompletedAt     *time.Time
+
+	// ChildCounts summarizes the job's child jobs; set only by lists that exclude children
+	ChildCounts *JobChildCounts
+}
+
+// JobChildCounts counts a parent job's children by status.
+type JobChildCounts struct {
+	Total      int
+	Completed  int
+	Failed     int
+	Processing int
 }
 
 // GenerationJobListOptions provides filtering options for listing jobs.
@@ -77,6 +155,82 @@ type GenerationJobListOptions struct {
 	Type     *valueobject.GenerationJobType
 	Status   *valueobject.GenerationJobStatus
 	CourseID *uuid.UUID
+
+	ParentJobID     *uuid.UUID // Only the children of this job
+	ExcludeChildren bool       // Only parent and standalone jobs, with ChildCounts set
+	Limit           int        // 0 for no limit
+	Cursor          *string    // "timestamp|id" of the last job of the previous page
+}
+
+// JobTypeQueueCount counts active jobs of one type.
+type JobTypeQueueCount struct {
+	Type       valueobject.GenerationJobType
+	Queued     int
+	Processing int
+}
+
+// GenerationQueueStatus describes where a tenant's generation jobs stand in the shared queue.
+type GenerationQueueStatus struct {
+	Counts []JobTypeQueueCount // Tenant's active jobs by type
+
+	// QueuePosition is the 1-based position of the caller's oldest queued job among
+	// all queued jobs, by creation time. Nil if the caller has nothing queued.
+	QueuePosition *int
+
+	// WorkerConcurrency is how many jobs each worker pod processes at once. Jobs are
+	// not capped per tenant; every tenant shares these slots.
+	WorkerConcurrency int
+
+	AvgJobDuration   time.Duration // Across all tenants over the last 24 hours
+	ProviderDegraded bool          // Recent jobs are failing on AI provider errors
+
+	// GenerationPaused is set while support has paused the tenant's AI generation. Its
+	// queued jobs wait, without a queue position, until generation is re-enabled.
+	GenerationPaused bool
+}
+
+// JobAnomaly records an inconsistency between generation jobs and course content
+// found by the consistency sweeper.
+type JobAnomaly struct {
+	ID       uuid.UUID
+	TenantID uuid.UUID
+	JobID    uuid.UUID
+	CourseID *uuid.UUID
+
+	Type     valueobject.JobAnomalyType
+	Details  string
+	Resolved bool // Fixed automatically when it was detected
+
+	DetectedAt time.Time
+}
+
+// AccessibilityIssue is an accessibility problem in one lesson component.
+type AccessibilityIssue struct {
+	Type        valueobject.AccessibilityIssueType
+	LessonID    uuid.UUID
+	LessonTitle string
+	ComponentID uuid.UUID
+	Position    int32
+	Detail      string
+
+	// AltTextGenerationFailed is set on missing alt text that generation already tried
+	// and failed to write, so only the author can fix it.
+	AltTextGenerationFailed bool
+}
+
+// AccessibilityReport lists the accessibility problems in a course's lessons.
+type AccessibilityReport struct {
+	CourseID          uuid.UUID
+	LessonsChecked    int
+	ComponentsChecked int
+	Issues            []AccessibilityIssue // By lesson, then component position
+}
+
+// JobAnomalyListOptions provides filtering options for listing anomalies.
+type JobAnomalyListOptions struct {
+	TenantID *uuid.UUID
+	Type     *valueobject.JobAnomalyType
+	Limit    int
 }
 
 // CourseOutline represents the generated course structure.
@@ -89,12 +243,84 @@ type CourseOutline struct {
 
 	Sections []OutlineSection // Loaded separately or populated
 
-	ApprovalStatus   valueobject.OutlineApprovalStatus
-	RejectionReason  *string
+	Constraints *OutlineConstraints // Constraints requested at generation time (populated on read)
+
+	ActiveGenerationJob *GenerationJob // The course's queued or processing full_course run (populated on read)
+
+	LessonChanges *OutlineLessonChanges // How lessons map to the previous version; nil for a course's first outline
+
+	UnresolvedComments int // Unresolved reviewer comments on the outline's lessons (populated on read)
+
+	ApprovalStatus  valueobject.OutlineApprovalStatus
+	RejectionReason *string
+
+	GeneratedAt       time.Time
+	GeneratedByUserID *uuid.UUID // Creator of the outline job; nil if unknown
+	ApprovedAt        *time.Time
+	ApprovedByUserID  *uuid.UUID
+
+	// First reviewer's sign-off when the tenant requires a second reviewer; cleared when
+	// the outline is edited or rejected
+	EndorsedAt       *time.Time
+	EndorsedByUserID *uuid.UUID
+}
+
+// LessonCount returns the number of lessons across the outline's loaded sections.
+func (o *CourseOutline) LessonCount() int {
+	count := 0
+	for _, section := range o.Sections {
+		count += len(section.Lessons)
+	}
+	return count
+}
+
+// TotalDurationMinutes sums the estimated durations of the outline's loaded lessons.
+// Lessons without an estimate count as zero.
+func (o *CourseOutline) TotalDurationMinutes() int {
+	total := 0
+	for _, section := range o.Sections {
+		for _, lesson := range section.Lessons {
+			if lesson.EstimatedDurationMinutes != nil {
+				total += int(*lesson.EstimatedDurationMinutes)
+			}
+		}
+	}
+	return total
+}
+
+// OutlineLessonChanges describes how a regenerated outline's lessons map to the
+// outline version it replaced.
+type OutlineLessonChanges struct {
+	PreviousOutlineID uuid.UUID             `json:"previousOutlineId"`
+	Kept              []OutlineLessonChange `json:"kept"`    // Matched to a previous lesson, whose key they carry
+	Added             []OutlineLessonChange `json:"added"`   // New lessons with no confident match; keyed by their own ID
+	Removed           []OutlineLessonChange `json:"removed"` // Previous lessons nothing matched
+}
 
-	GeneratedAt      time.Time
-	ApprovedAt       *time.Time
-	ApprovedByUserID *uuid.UUID
+// OutlineLessonChange identifies one lesson in OutlineLessonChanges.
+type OutlineLessonChange struct {
+	LessonKey     uuid.UUID `json:"lessonKey"`
+	Title         string    `json:"title"`
+	PreviousTitle string    `json:"previousTitle,omitempty"` // Title in the previous version, for kept lessons
+}
+
+// OutlineComment is a reviewer comment on an outline lesson. It is keyed by the
+// lesson key, so it stays on the lesson across outline edits and versions.
+type OutlineComment struct {
+	ID        uuid.UUID
+	TenantID  uuid.UUID
+	CourseID  uuid.UUID
+	LessonKey uuid.UUID
+
+	AuthorUserID *uuid.UUID // nil once the author's account is deleted
+	AuthorName   string     // Display name of the author (populated on read)
+	Body         string
+
+	Resolved         bool
+	ResolvedByUserID *uuid.UUID
+	ResolvedAt       *time.Time
+
+	CreatedAt time.Time
 }
 
 // OutlineSection represents a section in the outline.
@@ -118,6 +344,10 @@ type OutlineLesson struct {
 	TenantID  uuid.UUID
 	SectionID uuid.UUID
 
+	// LessonKey identifies the lesson across outline versions: the ID it was first
+	// generated with, carried forward whenever a regenerated outline matches it.
+	LessonKey uuid.UUID
+
 	Title                    string
 	Description              string
 	Position                 int32
@@ -128,16 +358,27 @@ type OutlineLesson struct {
 	IsLastInSection bool
 	IsLastInCourse  bool
 
+	// Names of the course audiences the lesson is specific to; empty means all of them
+	TargetAudiences []string
+
 	CreatedAt time.Time
 }
 
+// OutlineContentUpdate is a reviewer's edit of an outline's sections and lessons.
+type OutlineContentUpdate struct {
+	Sections         []OutlineSection // Existing sections to update
+	Lessons          []OutlineLesson  // Existing lessons to update
+	AddedLessons     []OutlineLesson  // New lessons, with their IDs already assigned
+	RemovedLessonIDs []uuid.UUID
+}
+
 // GeneratedLesson contains full lesson content.
 type GeneratedLesson struct {
 	ID              uuid.UUID
 	TenantID        uuid.UUID
 	CourseID        uuid.UUID
 	SectionID       uuid.UUID
-	OutlineLessonID uuid.UUID
+	OutlineLessonID uuid.UUID // uuid.Nil once its outline lesson was removed; the lesson is then orphaned
 
 	Title string
 
@@ -145,7 +386,12 @@ type GeneratedLesson struct {
 
 	SegueText *string // Transition to next lesson
 
+	// Components generation produced, after component count bounds were applied; later
+	// edits don't change it. Nil for lessons generated before it was recorded.
+	ComponentCount *int32
+
 	GeneratedAt time.Time
+	OrphanedAt  *time.Time // Set when its outline lesson was removed or superseded
 }
 
 // LessonComponent represents a content component in a lesson.
@@ -168,12 +414,24 @@ type LessonComponent struct {
 	UpdatedAt time.Time
 }
 
+// LessonComponentMatch is a lesson component found by a search within a course, with the
+// section and lesson it belongs to.
+type LessonComponentMatch struct {
+	Component    *LessonComponent
+	SectionID    uuid.UUID
+	SectionTitle string
+	LessonTitle  string
+}
+
 // CourseGenerationInput captures inputs for AI course generation.
 type CourseGenerationInput struct {
 	ID       uuid.UUID
 	TenantID uuid.UUID
 	CourseID uuid.UUID
 
+	// Course title at generation time (fallback if the course is gone when notifying)
+	CourseTitle *string
+
 	// SMEs to use as knowledge sources
 	SMEIDs []uuid.UUID
 
@@ -186,10 +444,97 @@ type CourseGenerationInput struct {
 	// Extra context/instructions
 	AdditionalContext *string
 
+	// Optional limits on the generated outline
+	Constraints OutlineConstraints
+
+	// Component mix for lesson generation
+	Preferences GenerationPreferences
+
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
 
+// GenerationDraft holds the generation wizard selections a user has made for a course
+// but not yet started a generation job from.
+type GenerationDraft struct {
+	TenantID uuid.UUID
+	UserID   uuid.UUID
+	CourseID uuid.UUID
+
+	SMEIDs            []uuid.UUID
+	TargetAudienceIDs []uuid.UUID
+	DesiredOutcome    string
+	AdditionalContext *string
+	Constraints       OutlineConstraints
+
+	UpdatedAt time.Time
+}
+
+// GenerationPreferences controls which component types lesson generation produces.
+type GenerationPreferences struct {
+	EnableQuizzes            bool
+	QuizFrequency            valueobject.QuizFrequency
+	IncludeImages            bool
+	IncludeReflectionPrompts bool
+
+	// Whether image components get an AI-generated picture instead of only a description
+	GenerateImages bool
+
+	// Target range for the number of components in each lesson; zero is unbounded
+	MinComponents int32
+	MaxComponents int32
+}
+
+// MaxLessonComponentBound is the highest minimum or maximum component count a lesson may be given.
+const MaxLessonComponentBound = 100
+
+// DefaultGenerationPreferences returns the preferences used when a tenant has none configured.
+func DefaultGenerationPreferences() GenerationPreferences {
+	return GenerationPreferences{
+		EnableQuizzes: true,
+		QuizFrequency: valueobject.QuizFrequencyEveryLesson,
+		IncludeImages: true,
+	}
+}
+
+// ComponentBoundsValid reports whether the lesson component count bounds are within
+// range and, when both are set, the minimum doesn't exceed the maximum.
+func (p GenerationPreferences) ComponentBoundsValid() bool {
+	if p.MinComponents < 0 || p.MaxComponents < 0 ||
+		p.MinComponents > MaxLessonComponentBound || p.MaxComponents > MaxLessonComponentBound {
+		return false
+	}
+	return p.MaxComponents == 0 || p.MinComponents <= p.MaxComponents
+}
+
+// QuizAllowed reports whether a lesson at the given position should get a quiz.
+func (p GenerationPreferences) QuizAllowed(isLastInSection, isLastInCourse bool) bool {
+	if !p.EnableQuizzes {
+		return false
+	}
+	switch p.QuizFrequency {
+	case valueobject.QuizFrequencyEndOfSection:
+		return isLastInSection || isLastInCourse
+	case valueobject.QuizFrequencyEndOfCourse:
+		return isLastInCourse
+	default:
+		return true
+	}
+}
+
+// OutlineConstraints bounds the size of a generated outline.
+// Nil fields are unconstrained.
+type OutlineConstraints struct {
+	MaxSections           *int32
+	MaxLessonsPerSection  *int32
+	TargetDurationMinutes *int32 // Target total course duration
+}
+
+// IsEmpty returns true if no constraint is set.
+func (c OutlineConstraints) IsEmpty() bool {
+	return c.MaxSections == nil && c.MaxLessonsPerSection == nil && c.TargetDurationMinutes == nil
+}
+
 // TextContent for text components.
 type TextContent struct {
 	HTML      string `json:"html"`
@@ -208,20 +553,3 @@ type ImageContent struct {
 	AltText string  `json:"alt_text"`
 	Caption *string `json:"caption,omitempty"`
 }
-
-// QuizContent for quiz/knowledge check components.
-type QuizContent struct {
-	Question          string       `json:"question"`
-	QuestionType      string       `json:"question_type"` // multiple_choice, true_false
-	Options           []QuizOption `json:"options"`
-	CorrectAnswerID   string       `json:"correct_answer_id"`
-	Explanation       string       `json:"explanation"`
-	CorrectFeedback   *string      `json:"correct_feedback,omitempty"`
-	IncorrectFeedback *string      `json:"incorrect_feedback,omitempty"`
-}
-
-// QuizOption represents an answer option.
-type QuizOption struct {
-	ID   string `json:"id"`
-	Text string `json:"text"`
-}
@@ -0,0 +1,41 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+// APIToken is a personal API token. Requests bearing it act as its user, limited to
+// its scopes. Only a hash of the secret is stored.
+type APIToken struct {
+	ID                 uuid.UUID
+	TenantID           uuid.UUID // Tenant for RLS isolation
+	UserID             uuid.UUID // User the token acts as
+	Name               string
+	TokenPrefix        string // Leading characters of the secret, shown to tell tokens apart
+	SecretHash         string
+	Scopes             []valueobject.APITokenScope
+	RateLimitPerMinute int
+	ExpiresAt          time.Time
+	LastUsedAt         *time.Time
+	RevokedAt          *time.Time
+	CreatedByUserID    *uuid.UUID
+	CreatedAt          time.Time
+}
+
+// IsActive returns true if the token is neither revoked nor expired.
+func (t *APIToken) IsActive() bool {
+	return t.RevokedAt == nil && time.Now().Before(t.ExpiresAt)
+}
+
+// HasScope returns true if one of the token's scopes grants the required scope.
+func (t *APIToken) HasScope(required valueobject.APITokenScope) bool {
+	for _, scope := range t.Scopes {
+		if scope.Grants(required) {
+			return true
+		}
+	}
+	return false
+}
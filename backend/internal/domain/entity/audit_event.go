@@ -0,0 +1,37 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sogos/mirai-backend/internal/domain/audit"
+)
+
+// AuditEvent is an entry in a tenant's append-only audit log.
+type AuditEvent struct {
+	ID          uuid.UUID
+	TenantID    uuid.UUID
+	ActorUserID *uuid.UUID // nil for system actions
+
+	Action     audit.Action
+	TargetType audit.TargetType
+	TargetID   string
+	Changes    audit.Changes
+
+	IPAddress  *string    // Client address of the request that made the change
+	APITokenID *uuid.UUID // Set when the request was authenticated with an API token
+
+	CreatedAt time.Time
+}
+
+// AuditEventListOptions provides filtering options for listing audit events.
+type AuditEventListOptions struct {
+	Action      *audit.Action
+	ActorUserID *uuid.UUID
+	TargetType  *audit.TargetType
+	TargetID    *string
+	Since       *time.Time // Inclusive
+	Until       *time.Time // Exclusive
+	Limit       int
+	Cursor      *string // For pagination
+}
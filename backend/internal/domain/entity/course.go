@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
 )
 
 // CourseStatus represents the status of a course.
@@ -41,6 +42,7 @@ type Course struct {
 	TenantID        uuid.UUID  // Tenant for RLS isolation
 	CompanyID       uuid.UUID  // Company that owns this course
 	CreatedByUserID uuid.UUID  // User who created the course
+	CreatorActive   bool       // Whether the creator can still sign in; loaded by List only
 	TeamID          *uuid.UUID // Optional team assignment
 
 	// Metadata
@@ -52,18 +54,224 @@ type Course struct {
 	ThumbnailPath *string
 
 	// S3 reference
-	ContentPath string // Path to content JSON in S3, e.g., "tenants/{tenant_id}/courses/{id}/content.json"
+	ContentPath      string // Path to content JSON in S3, e.g., "tenants/{tenant_id}/courses/{id}/content.json"
+	ContentSizeBytes *int64 // Size of the content JSON as last written; nil for courses not written since sizes were recorded
+
+	IsSample bool // Created during onboarding; removed by RemoveSampleContent
+
+	// Content versions. Edits bump the draft version; publishing freezes the draft for
+	// learners and records its version as the published one.
+	DraftVersion     int32
+	PublishedVersion int32 // 0 until a published version has been stored
+
+	// Translation
+	SourceCourseID *uuid.UUID // Course this one was translated from
+	Language       *string    // BCP 47 tag of a translated course's language
+
+	GenerationLock *CourseGenerationLock // Set while a full course run holds the course read-only; loaded by GetByID only
 
 	// Timestamps
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
 
+// CourseGenerationLock records the full course run holding a course read-only while it
+// generates. It is taken when the run's parent job starts and released when it ends.
+type CourseGenerationLock struct {
+	JobID    uuid.UUID // The run's parent job
+	LockedAt time.Time
+}
+
+// HasPublishedVersion reports whether learners are served a frozen published version
+// rather than the draft. Courses published before versions were stored have none until
+// the published version backfill snapshots them.
+func (c *Course) HasPublishedVersion() bool {
+	return c.PublishedVersion > 0
+}
+
+// HasUnpublishedChanges reports whether the draft was edited after its published version.
+func (c *Course) HasUnpublishedChanges() bool {
+	return c.HasPublishedVersion() && c.DraftVersion > c.PublishedVersion
+}
+
+// CourseDefaults are the settings an organization's new courses start with. Each one
+// only applies when the course is created without a value of its own. Stored as a
+// JSON blob on the tenant's settings.
+type CourseDefaults struct {
+	DestinationFolderID *uuid.UUID          `json:"destinationFolderId,omitempty"`
+	CategoryTags        []string            `json:"categoryTags,omitempty"`
+	DataSource          string              `json:"dataSource,omitempty"`
+	Assessment          *AssessmentDefaults `json:"assessment,omitempty"`
+}
+
+// AssessmentDefaults are the default assessment settings for new courses.
+type AssessmentDefaults struct {
+	EnableEmbeddedKnowledgeChecks bool `json:"enableEmbeddedKnowledgeChecks"`
+	EnableFinalExam               bool `json:"enableFinalExam"`
+}
+
+// CourseCollaborator represents a user's assignment to a course.
+// The course creator is implicitly the owner and does not need a row.
+type CourseCollaborator struct {
+	ID            uuid.UUID
+	TenantID      uuid.UUID // Tenant for RLS isolation
+	CourseID      uuid.UUID
+	UserID        uuid.UUID
+	Role          valueobject.CourseRole
+	AddedByUserID *uuid.UUID
+	CreatedAt     time.Time
+}
+
+// CourseAttachmentStatus is how far a course attachment's text extraction has got.
+type CourseAttachmentStatus string
+
+const (
+	CourseAttachmentStatusPending    CourseAttachmentStatus = "pending"    // Uploaded, waiting for extraction
+	CourseAttachmentStatusProcessing CourseAttachmentStatus = "processing" // Text being extracted
+	CourseAttachmentStatusReady      CourseAttachmentStatus = "ready"      // Chunks available to prompts
+	CourseAttachmentStatusFailed     CourseAttachmentStatus = "failed"     // No usable text; see ErrorMessage
+)
+
+// CourseAttachment is a reference file attached directly to a course, such as a style
+// guide or product spec. Its extracted text is given to generation alongside SME
+// knowledge unless the attachment is excluded from prompts.
+type CourseAttachment struct {
+	ID                  uuid.UUID
+	TenantID            uuid.UUID // Tenant for RLS isolation
+	CourseID            uuid.UUID
+	FileName            string
+	ContentType         string
+	FilePath            string // Tenant-relative path under the course's storage prefix
+	SizeBytes           int64
+	Status              CourseAttachmentStatus
+	ErrorMessage        *string
+	ChunkCount          int // Chunks extracted, including flagged ones
+	FlaggedChunks       int // Chunks held back from prompts as possible prompt injection
+	ExcludedFromPrompts bool
+	UploadedByUserID    *uuid.UUID
+	CreatedAt           time.Time
+	ProcessedAt         *time.Time
+
+	Chunks []string // Usable chunk texts in document order; loaded by ListForPrompts only
+}
+
+// UsableInPrompts reports whether generation prompts should include the attachment.
+func (a *CourseAttachment) UsableInPrompts() bool {
+	return a.Status == CourseAttachmentStatusReady && !a.ExcludedFromPrompts
+}
+
+// CourseAttachmentChunk is one piece of an attachment's extracted text.
+type CourseAttachmentChunk struct {
+	Content          string
+	InjectionFlagged bool // Looks like a prompt injection attempt; never sent to a prompt
+}
+
 // CourseListOptions provides filtering options for listing courses.
 type CourseListOptions struct {
+	Status             *CourseStatus
+	FolderID           *uuid.UUID
+	Tags               []string
+	CollaboratorUserID *uuid.UUID // Courses the user created or collaborates on
+	TeamFoldersOf      *uuid.UUID // Courses anywhere under the team's folders
+	SampleOnly         bool       // Only onboarding sample courses
+	Unversioned        bool       // Only courses with no published version stored
+	LargestFirst       bool       // Only courses with a recorded content size, largest first
+	Limit              int
+	Offset             int
+}
+
+// CourseCard is the minimum needed to lay out a course in the library: a card's title
+// and status, in modification order. CourseCardDetails fills in the rest.
+type CourseCard struct {
+	ID        uuid.UUID
+	Title     string
+	Status    CourseStatus
+	UpdatedAt time.Time
+}
+
+// CourseCardListOptions filters a page of course cards, most recently modified first.
+type CourseCardListOptions struct {
 	Status   *CourseStatus
 	FolderID *uuid.UUID
-	Tags     []string
 	Limit    int
-	Offset   int
+	Cursor   *string // "updated_at|id" of the last card of the previous page
+}
+
+// CourseCardDetails is the part of a course card loaded only for cards on screen.
+type CourseCardDetails struct {
+	CourseID        uuid.UUID
+	Tags            []string
+	ThumbnailPath   *string
+	CreatedByUserID uuid.UUID
+	CreatorKratosID *uuid.UUID // nil if the creator's user row is gone
+	CreatorActive   bool
+	DurationMinutes int // Estimated duration of the latest outline's lessons; 0 without an outline
+}
+
+// CoursePresence is someone with a course open, as of their last heartbeat.
+type CoursePresence struct {
+	UserID      uuid.UUID `json:"userId"`
+	DisplayName string    `json:"displayName"` // Empty if their identity can't be looked up
+	Editing     bool      `json:"editing"`     // False when only viewing
+	LastSeenAt  time.Time `json:"lastSeenAt"`
+}
+
+// readingWordsPerMinute is the reading speed used to estimate reading time.
+const readingWordsPerMinute = 200
+
+// ContentStats summarizes the generated lesson content of a course or section.
+type ContentStats struct {
+	LessonCount         int
+	WordCount           int
+	QuizCount           int
+	ImageCount          int
+	VideoCount          int
+	MalformedComponents int // Components whose content JSON could not be parsed
+}
+
+// Add accumulates other into s.
+func (s *ContentStats) Add(other ContentStats) {
+	s.LessonCount += other.LessonCount
+	s.WordCount += other.WordCount
+	s.QuizCount += other.QuizCount
+	s.ImageCount += other.ImageCount
+	s.VideoCount += other.VideoCount
+	s.MalformedComponents += other.MalformedComponents
+}
+
+// AverageWordsPerLesson returns the mean lesson length, or 0 without lessons.
+func (s ContentStats) AverageWordsPerLesson() float64 {
+	if s.LessonCount == 0 {
+		return 0
+	}
+	return float64(s.WordCount) / float64(s.LessonCount)
+}
+
+// ReadingMinutes estimates the time needed to read the content, rounded up.
+func (s ContentStats) ReadingMinutes() int {
+	return (s.WordCount + readingWordsPerMinute - 1) / readingWordsPerMinute
+}
+
+// SectionContentStats is the content breakdown for one outline section.
+type SectionContentStats struct {
+	SectionID uuid.UUID
+	Title     string
+	Stats     ContentStats
+}
+
+// CourseStats summarizes a course's generated lessons, in total and per section.
+type CourseStats struct {
+	CourseID uuid.UUID
+	Totals   ContentStats
+	Sections []SectionContentStats // In outline order
+}
+
+// StorageReferences lists what a tenant's database still points to in object storage.
+type StorageReferences struct {
+	CourseIDs []uuid.UUID // Objects under a live course's folder are kept
+	Paths     []string    // Referenced object paths, either tenant-relative or full
+
+	// PublishedVersions maps each course with a stored published version to that version.
+	// Assets its lessons use stay referenced after the draft stops using them.
+	PublishedVersions map[uuid.UUID]int32
 }
@@ -0,0 +1,33 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+// EmailLogEntry records the outcome of one email sent on behalf of a tenant.
+type EmailLogEntry struct {
+	ID       uuid.UUID
+	TenantID uuid.UUID
+
+	Recipient string
+	Template  string // e.g. "invitation", "outline_ready"
+	MessageID string // Message-ID header; providers report later delivery status by it
+
+	Status       valueobject.EmailDeliveryStatus
+	SMTPCode     *int // Unset when no server answered
+	SMTPResponse string
+
+	SentAt          time.Time
+	StatusUpdatedAt time.Time
+}
+
+// EmailLogListOptions provides filtering options for listing the email log.
+type EmailLogListOptions struct {
+	Recipient *string // Case-insensitive exact match
+	Template  *string
+	Limit     int
+	Cursor    *string // "sent_at|id" of the last entry on the previous page
+}
@@ -0,0 +1,85 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ExportDownloadLinkTTL is how long the download link emailed for an export stays valid,
+// counted from when the email is sent.
+const ExportDownloadLinkTTL = 72 * time.Hour
+
+// ExportDownloadLink is a signed link to an export's file, sent in the export ready email.
+type ExportDownloadLink struct {
+	Token     string
+	TenantID  uuid.UUID
+	JobID     uuid.UUID
+	UserID    uuid.UUID // User the link was sent to
+	FilePath  string    // Tenant-relative path of the export file
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+// IsExpired returns true if the link can no longer be used to download the export.
+func (l *ExportDownloadLink) IsExpired() bool {
+	return time.Now().After(l.ExpiresAt)
+}
+
+// ExportAccess records one download of an export through an emailed link.
+type ExportAccess struct {
+	ID         uuid.UUID
+	TenantID   uuid.UUID
+	JobID      uuid.UUID
+	Token      string
+	IPAddress  string
+	UserAgent  string
+	AccessedAt time.Time
+}
+
+// DefaultExportRetentionDays is how long export artifacts are kept for tenants that
+// haven't set their own retention period.
+const DefaultExportRetentionDays = 30
+
+// MaxExportRetentionDays is the longest retention period a tenant can set.
+const MaxExportRetentionDays = 365
+
+// ExportArtifactType identifies what an export artifact contains.
+type ExportArtifactType string
+
+const (
+	ExportArtifactTypeLessons      ExportArtifactType = "lessons_export"       // ZIP of a course's lessons
+	ExportArtifactTypeSMEKnowledge ExportArtifactType = "sme_knowledge_export" // JSONL archive of an SME's knowledge
+)
+
+// ExportPurgeReason records why an export artifact's file was deleted.
+type ExportPurgeReason string
+
+const (
+	ExportPurgeReasonExpired    ExportPurgeReason = "expired"    // Retention period ran out
+	ExportPurgeReasonSuperseded ExportPurgeReason = "superseded" // A newer export of the same type replaced it
+)
+
+// ExportArtifact is a file produced by an export job, kept in tenant storage until it
+// expires or a newer export of the same type for its course or SME supersedes it. The
+// record outlives the file so downloads can say why it is gone.
+type ExportArtifact struct {
+	ID              uuid.UUID
+	TenantID        uuid.UUID
+	JobID           uuid.UUID
+	CourseID        *uuid.UUID // Set for course exports
+	SMEID           *uuid.UUID // Set for SME exports
+	Type            ExportArtifactType
+	FilePath        string // Tenant-relative path of the file
+	SizeBytes       int64
+	CreatedByUserID *uuid.UUID
+	CreatedAt       time.Time
+	ExpiresAt       time.Time
+	PurgedAt        *time.Time
+	PurgeReason     *ExportPurgeReason
+}
+
+// IsPurged returns true if the artifact's file has been deleted.
+func (a *ExportArtifact) IsPurged() bool {
+	return a.PurgedAt != nil
+}
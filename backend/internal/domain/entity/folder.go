@@ -46,6 +46,18 @@ type Folder struct {
 	Type      FolderType
 	TeamID    *uuid.UUID // For TEAM folders - associates with a team
 	UserID    *uuid.UUID // For PERSONAL folders - associates with a user
+	IsSample  bool       // Created during onboarding; removed by RemoveSampleContent
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
+
+// IsSystem reports whether folders of this type are created and managed by Mirai:
+// the tenant's shared library folder and each user's personal folder.
+func (t FolderType) IsSystem() bool {
+	return t == FolderTypeLibrary || t == FolderTypePersonal
+}
+
+// IsProtected reports whether users are prevented from renaming or deleting the folder.
+func (f *Folder) IsProtected() bool {
+	return f.Type.IsSystem()
+}
@@ -12,6 +12,7 @@ type Invitation struct {
 	ID               uuid.UUID
 	TenantID         uuid.UUID // Tenant for RLS isolation
 	CompanyID        uuid.UUID
+	TeamID           *uuid.UUID // Team the invitee joins on acceptance, if any
 	Email            string
 	Role             valueobject.Role
 	Status           valueobject.InvitationStatus
@@ -0,0 +1,64 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+// LMSConnector is an external LMS endpoint a tenant syncs published courses to.
+type LMSConnector struct {
+	ID                   uuid.UUID
+	TenantID             uuid.UUID
+	Name                 string
+	Type                 valueobject.LMSConnectorType
+	EndpointURL          string
+	EncryptedCredentials []byte // Webhook signing secret or bearer token; nil when none is set
+	Enabled              bool
+	CreatedByUserID      uuid.UUID
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+}
+
+// HasCredentials reports whether the connector has a secret or token stored.
+func (c *LMSConnector) HasCredentials() bool {
+	return len(c.EncryptedCredentials) > 0
+}
+
+// FolderSyncSetting links a folder to the connector its published courses are synced to.
+type FolderSyncSetting struct {
+	FolderID    uuid.UUID
+	TenantID    uuid.UUID
+	ConnectorID uuid.UUID
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// CourseSyncDelivery is one attempt, with retries, to deliver a published course to a connector.
+// Test and replay deliveries are diagnostic sends made by an admin; they are tried once.
+type CourseSyncDelivery struct {
+	ID             uuid.UUID
+	TenantID       uuid.UUID
+	ConnectorID    uuid.UUID
+	CourseID       *uuid.UUID // nil for test deliveries
+	Kind           valueobject.CourseSyncDeliveryKind
+	Event          string     // Webhook event type sent
+	ReplayOfID     *uuid.UUID // Delivery a replay re-sent; nil once that delivery is removed
+	Status         valueobject.CourseSyncStatus
+	Attempts       int
+	MaxAttempts    int
+	NextAttemptAt  time.Time
+	LastAttemptAt  *time.Time
+	LastError      *string
+	ResponseStatus *int
+	RemoteID       *string // Identifier the LMS assigned to the course, when it returned one
+	ArtifactPath   *string // Tenant-relative path of the rendered export
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// CanRetry reports whether another delivery attempt is allowed.
+func (d *CourseSyncDelivery) CanRetry() bool {
+	return d.Attempts < d.MaxAttempts
+}
@@ -42,3 +42,11 @@ type NotificationListOptions struct {
 	Limit      int
 	Cursor     *string // For pagination
 }
+
+// NotificationReadFilter selects notifications to mark as read in bulk.
+// Nil fields match all notifications.
+type NotificationReadFilter struct {
+	CourseID  *uuid.UUID
+	Type      *valueobject.NotificationType
+	OlderThan *time.Time // Only notifications created before this time
+}
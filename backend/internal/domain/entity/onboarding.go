@@ -0,0 +1,11 @@
+package entity
+
+// OnboardingProgress records which onboarding milestones a tenant has reached.
+type OnboardingProgress struct {
+	AISettingsConfigured bool `json:"aiSettingsConfigured"` // An API key is stored
+	SMEWithKnowledge     bool `json:"smeWithKnowledge"`     // Some SME has knowledge chunks
+	TargetAudience       bool `json:"targetAudience"`
+	CourseCreated        bool `json:"courseCreated"`
+	OutlineApproved      bool `json:"outlineApproved"`
+	CourseGenerated      bool `json:"courseGenerated"` // A full course generation job completed
+}
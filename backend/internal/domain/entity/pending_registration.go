@@ -21,6 +21,7 @@ type PendingRegistration struct {
 	TeamSize             *string
 	Plan                 valueobject.Plan
 	SeatCount            int
+	StorageRegion        string // Storage region for the tenant; "" is the primary region
 	Status               valueobject.PendingRegistrationStatus
 	StripeCustomerID     *string
 	StripeSubscriptionID *string
@@ -0,0 +1,233 @@
+package entity
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+// QuizSchemaVersion is the version of the quiz component content format written today.
+// Version 1 content has no schema_version field and marks a single correct option by ID.
+const QuizSchemaVersion = 2
+
+// Quiz content limits.
+const (
+	QuizMinOptions    = 2
+	QuizMaxOptions    = 6
+	QuizDefaultPoints = 1
+	QuizMaxPoints     = 10
+)
+
+// QuizContent for quiz/knowledge check components.
+type QuizContent struct {
+	SchemaVersion     int                          `json:"schema_version"`
+	Question          string                       `json:"question"`
+	QuestionType      valueobject.QuizQuestionType `json:"question_type"`
+	Options           []QuizOption                 `json:"options"`
+	Points            int                          `json:"points"`  // Awarded for a fully correct answer
+	Shuffle           bool                         `json:"shuffle"` // Whether the player may reorder the options
+	Explanation       string                       `json:"explanation"`
+	CorrectFeedback   *string                      `json:"correct_feedback,omitempty"`
+	IncorrectFeedback *string                      `json:"incorrect_feedback,omitempty"`
+}
+
+// QuizOption represents an answer option.
+type QuizOption struct {
+	ID      string `json:"id"`
+	Text    string `json:"text"`
+	Correct bool   `json:"correct"`
+}
+
+// CorrectOptions returns the options marked correct, in order.
+func (q *QuizContent) CorrectOptions() []QuizOption {
+	var correct []QuizOption
+	for _, o := range q.Options {
+		if o.Correct {
+			correct = append(correct, o)
+		}
+	}
+	return correct
+}
+
+// Validate checks the quiz is gradable: a question, a known question type, unique
+// non-blank options within the limits, the right number of correct options for the
+// type, and points within the limits.
+func (q *QuizContent) Validate() error {
+	if q.SchemaVersion != QuizSchemaVersion {
+		return fmt.Errorf("quiz schema version %d is not %d", q.SchemaVersion, QuizSchemaVersion)
+	}
+	if strings.TrimSpace(q.Question) == "" {
+		return errors.New("quiz requires a question")
+	}
+	if !q.QuestionType.IsValid() {
+		return fmt.Errorf("invalid quiz question type: %s", q.QuestionType)
+	}
+
+	if q.QuestionType == valueobject.QuizQuestionTypeTrueFalse {
+		if len(q.Options) != 2 {
+			return fmt.Errorf("true/false quiz requires 2 options, got %d", len(q.Options))
+		}
+	} else if len(q.Options) < QuizMinOptions || len(q.Options) > QuizMaxOptions {
+		return fmt.Errorf("quiz requires %d to %d options, got %d", QuizMinOptions, QuizMaxOptions, len(q.Options))
+	}
+
+	ids := make(map[string]bool, len(q.Options))
+	texts := make(map[string]bool, len(q.Options))
+	for i, o := range q.Options {
+		if strings.TrimSpace(o.ID) == "" {
+			return fmt.Errorf("quiz option %d has no ID", i+1)
+		}
+		if ids[o.ID] {
+			return fmt.Errorf("quiz option ID %q is repeated", o.ID)
+		}
+		ids[o.ID] = true
+
+		text := strings.ToLower(strings.TrimSpace(o.Text))
+		if text == "" {
+			return fmt.Errorf("quiz option %d is blank", i+1)
+		}
+		if texts[text] {
+			return fmt.Errorf("quiz option %d repeats another option", i+1)
+		}
+		texts[text] = true
+	}
+
+	correct := len(q.CorrectOptions())
+	switch q.QuestionType {
+	case valueobject.QuizQuestionTypeMulti:
+		if correct == 0 {
+			return errors.New("multi-select quiz requires at least one correct option")
+		}
+	default:
+		if correct != 1 {
+			return fmt.Errorf("%s quiz requires exactly one correct option, got %d", q.QuestionType, correct)
+		}
+	}
+
+	if q.Points < 1 || q.Points > QuizMaxPoints {
+		return fmt.Errorf("quiz points must be between 1 and %d", QuizMaxPoints)
+	}
+	return nil
+}
+
+// legacyQuizContent is version 1 quiz content. Generated quizzes used snake_case keys
+// and option objects; quizzes saved from the editor used camelCase keys; knowledge
+// check blocks held plain option strings and the index of the correct one.
+type legacyQuizContent struct {
+	Question             string          `json:"question"`
+	QuestionType         string          `json:"question_type"`
+	QuestionTypeCamel    string          `json:"questionType"`
+	Options              json.RawMessage `json:"options"`
+	CorrectAnswerID      string          `json:"correct_answer_id"`
+	CorrectAnswerIDCamel string          `json:"correctAnswerId"`
+	CorrectAnswer        *int            `json:"correctAnswer"`
+	Explanation          string          `json:"explanation"`
+	CorrectFeedback      *string         `json:"correct_feedback,omitempty"`
+	IncorrectFeedback    *string         `json:"incorrect_feedback,omitempty"`
+}
+
+// ParseQuizContent decodes quiz component content, upgrading version 1 content to the
+// current schema. upgraded reports whether it was version 1. Current content is returned
+// as stored; call Validate to check it is gradable.
+//
+// Version 1 quizzes become single-answer questions worth QuizDefaultPoints, or true/false
+// ones when they were true/false with two options. They are not shuffled, since their
+// options were written to be read in order ("All of the above").
+func ParseQuizContent(data []byte) (quiz *QuizContent, upgraded bool, err error) {
+	var probe struct {
+		SchemaVersion int `json:"schema_version"`
+	}
+	if err := json.Unmarshal(data, &probe); err != nil {
+		return nil, false, fmt.Errorf("invalid quiz JSON: %w", err)
+	}
+	if probe.SchemaVersion > QuizSchemaVersion {
+		return nil, false, fmt.Errorf("unsupported quiz schema version %d", probe.SchemaVersion)
+	}
+	if probe.SchemaVersion == QuizSchemaVersion {
+		var q QuizContent
+		if err := json.Unmarshal(data, &q); err != nil {
+			return nil, false, fmt.Errorf("invalid quiz JSON: %w", err)
+		}
+		return &q, false, nil
+	}
+
+	var legacy legacyQuizContent
+	if err := json.Unmarshal(data, &legacy); err != nil {
+		return nil, false, fmt.Errorf("invalid quiz JSON: %w", err)
+	}
+	options, err := legacyQuizOptions(legacy.Options)
+	if err != nil {
+		return nil, false, err
+	}
+
+	correctID := legacy.CorrectAnswerID
+	if correctID == "" {
+		correctID = legacy.CorrectAnswerIDCamel
+	}
+	if correctID == "" && legacy.CorrectAnswer != nil && *legacy.CorrectAnswer >= 0 && *legacy.CorrectAnswer < len(options) {
+		correctID = options[*legacy.CorrectAnswer].ID
+	}
+	found := false
+	for i := range options {
+		if options[i].ID == correctID {
+			options[i].Correct = true
+			found = true
+		}
+	}
+	if !found {
+		return nil, false, errors.New("legacy quiz has no correct option")
+	}
+
+	questionType := valueobject.QuizQuestionTypeSingle
+	legacyType := legacy.QuestionType
+	if legacyType == "" {
+		legacyType = legacy.QuestionTypeCamel
+	}
+	if legacyType == "true_false" && len(options) == 2 {
+		questionType = valueobject.QuizQuestionTypeTrueFalse
+	}
+
+	return &QuizContent{
+		SchemaVersion:     QuizSchemaVersion,
+		Question:          legacy.Question,
+		QuestionType:      questionType,
+		Options:           options,
+		Points:            QuizDefaultPoints,
+		Explanation:       legacy.Explanation,
+		CorrectFeedback:   legacy.CorrectFeedback,
+		IncorrectFeedback: legacy.IncorrectFeedback,
+	}, true, nil
+}
+
+// legacyQuizOptions decodes version 1 options, either {id, text} objects or plain
+// strings. Plain strings get the IDs a, b, c... the generator used.
+func legacyQuizOptions(raw json.RawMessage) ([]QuizOption, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, errors.New("legacy quiz has no options")
+	}
+
+	var objects []struct {
+		ID   string `json:"id"`
+		Text string `json:"text"`
+	}
+	if err := json.Unmarshal(raw, &objects); err == nil {
+		options := make([]QuizOption, len(objects))
+		for i, o := range objects {
+			options[i] = QuizOption{ID: o.ID, Text: o.Text}
+		}
+		return options, nil
+	}
+
+	var texts []string
+	if err := json.Unmarshal(raw, &texts); err != nil {
+		return nil, fmt.Errorf("invalid legacy quiz options: %w", err)
+	}
+	options := make([]QuizOption, len(texts))
+	for i, text := range texts {
+		options[i] = QuizOption{ID: string(rune('a' + i)), Text: text}
+	}
+	return options, nil
+}
@@ -1,7 +1,9 @@
 package entity
 
 import (
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
 	"github.com/sogos/mirai-backend/internal/domain/valueobject"
@@ -27,6 +29,7 @@ type SubjectMatterExpert struct {
 	KnowledgeContentPath *string // S3 path to full distilled knowledge JSON
 
 	CreatedByUserID uuid.UUID
+	CreatorActive   bool // Whether the creator can still sign in; read-only, joined from users
 	CreatedAt       time.Time
 	UpdatedAt       time.Time
 }
@@ -50,6 +53,9 @@ type SMETask struct {
 	Description         string
 	ExpectedContentType *valueobject.ContentType // Hint for what to upload
 
+	// Interview questions the SME answers instead of uploading content; empty for upload tasks
+	Questions []string
+
 	// Assignment
 	AssignedToUserID uuid.UUID
 	AssignedByUserID uuid.UUID
@@ -93,6 +99,93 @@ type SMETaskSubmission struct {
 	ApprovedByUserID *uuid.UUID // Who approved the submission
 }
 
+// Status derives the submission's status. Approval wins over an earlier ingestion
+// failure, and a failure wins over a stale processed time.
+func (s *SMETaskSubmission) Status() valueobject.SubmissionStatus {
+	switch {
+	case s.IsApproved:
+		return valueobject.SubmissionStatusApproved
+	case s.IngestionError != nil:
+		return valueobject.SubmissionStatusFailed
+	case s.ProcessedAt != nil:
+		return valueobject.SubmissionStatusProcessed
+	default:
+		return valueobject.SubmissionStatusPendingReview
+	}
+}
+
+// SubmissionProgress is how far a submission's ingestion has got.
+type SubmissionProgress struct {
+	SubmissionID    uuid.UUID
+	TaskID          uuid.UUID
+	JobID           *uuid.UUID // Latest ingestion job; nil if none has run
+	Status          valueobject.SubmissionProcessingStatus
+	ProgressPercent int32
+	ProgressMessage string
+	Error           *string
+	UpdatedAt       time.Time
+}
+
+// NewSubmissionProgress derives a submission's progress from its latest ingestion job,
+// which is nil if none has run.
+func NewSubmissionProgress(submission *SMETaskSubmission, job *GenerationJob) *SubmissionProgress {
+	progress := &SubmissionProgress{
+		SubmissionID: submission.ID,
+		TaskID:       submission.TaskID,
+		Status:       valueobject.SubmissionProcessingNotStarted,
+		Error:        submission.IngestionError,
+		UpdatedAt:    submission.SubmittedAt,
+	}
+	if job == nil {
+		return progress
+	}
+
+	progress.JobID = &job.ID
+	progress.ProgressPercent = job.ProgressPercent
+	if job.ProgressMessage != nil {
+		progress.ProgressMessage = *job.ProgressMessage
+	}
+	switch {
+	case job.CompletedAt != nil:
+		progress.UpdatedAt = *job.CompletedAt
+	case job.StartedAt != nil:
+		progress.UpdatedAt = *job.StartedAt
+	default:
+		progress.UpdatedAt = job.CreatedAt
+	}
+
+	switch job.Status {
+	case valueobject.GenerationJobStatusQueued:
+		progress.Status = valueobject.SubmissionProcessingQueued
+	case valueobject.GenerationJobStatusProcessing:
+		progress.Status = valueobject.SubmissionProcessingProcessing
+	case valueobject.GenerationJobStatusCompleted:
+		progress.Status = valueobject.SubmissionProcessingCompleted
+	default:
+		progress.Status = valueobject.SubmissionProcessingFailed
+		if progress.Error == nil {
+			progress.Error = job.ErrorMessage
+		}
+	}
+	return progress
+}
+
+// SMESubmissionListOptions provides filtering options for listing a task's submissions.
+type SMESubmissionListOptions struct {
+	TaskID uuid.UUID
+	Status *valueobject.SubmissionStatus
+	Limit  int
+	Cursor *string // For pagination
+}
+
+// SMESubmissionSummary aggregates a task's submissions.
+type SMESubmissionSummary struct {
+	TaskID            uuid.UUID
+	TotalCount        int
+	StatusCounts      map[valueobject.SubmissionStatus]int
+	LatestSubmittedAt *time.Time
+}
+
 // SMEKnowledgeChunk represents a unit of distilled knowledge.
 type SMEKnowledgeChunk struct {
 	ID           uuid.UUID
@@ -105,20 +198,114 @@ type SMEKnowledgeChunk struct {
 	Keywords       []string // Extracted keywords
 	RelevanceScore float32  // For ranking in generation
 
+	// Prompt injection screening; flagged chunks stay out of generation until an admin
+	// reviews them and lets them back in
+	InjectionFlagged          bool
+	InjectionReason           *string
+	InjectionReleasedByUserID *uuid.UUID
+	InjectionReleasedAt       *time.Time
+
 	CreatedAt time.Time
 }
 
+// UsableInGeneration reports whether the chunk may be included in generation prompts.
+func (c *SMEKnowledgeChunk) UsableInGeneration() bool {
+	return !c.InjectionFlagged || c.InjectionReleasedAt != nil
+}
+
+// DefaultKnowledgeTopic labels chunks the provider returned without a topic.
+const DefaultKnowledgeTopic = "General"
+
+// maxKnowledgeTopicLength matches the sme_knowledge_chunks.topic column.
+const maxKnowledgeTopicLength = 255
+
+// NormalizeKnowledgeTopic collapses whitespace and trims surrounding punctuation from a
+// provider-assigned topic, so that labels differing only in formatting group together.
+func NormalizeKnowledgeTopic(topic string) string {
+	topic = strings.Join(strings.Fields(topic), " ")
+	topic = strings.Trim(topic, " .,:;-–—*#\"'`")
+	if topic == "" {
+		return DefaultKnowledgeTopic
+	}
+	if utf8.RuneCountInString(topic) > maxKnowledgeTopicLength {
+		topic = strings.TrimSpace(string([]rune(topic)[:maxKnowledgeTopicLength]))
+	}
+	return topic
+}
+
+// SMEKnowledgeTopic is a topic label and the number of an SME's chunks filed under it.
+type SMEKnowledgeTopic struct {
+	Topic      string
+	ChunkCount int
+}
+
+// SMEKnowledgeSource is where a knowledge chunk came from, for citing it in exports.
+type SMEKnowledgeSource struct {
+	ChunkID   uuid.UUID
+	SMEID     uuid.UUID
+	SMEName   string
+	FileName  *string // Submission file; nil for chunks not from a submission, e.g. imported ones
+	TaskTitle *string // Task the submission answered
+}
+
+// SMEStats aggregates an SME's knowledge contributions.
+type SMEStats struct {
+	SMEID                uuid.UUID
+	TaskCounts           map[valueobject.SMETaskStatus]int // Task count by status
+	SubmissionsProcessed int
+	SubmissionsFailed    int
+	ChunkCount           int
+	ExtractedCharacters  int64
+	LastIngestedAt       *time.Time
+	CourseCount          int // Courses whose generation inputs reference this SME
+}
+
+// SMEDeletionImpact summarizes what depends on an SME, shown before it is deleted.
+type SMEDeletionImpact struct {
+	KnowledgeChunks    int
+	PendingTasks       int // Tasks not yet completed, failed, or cancelled
+	Submissions        int
+	Courses            int      // Courses whose generation inputs reference the SME
+	RecentCourseTitles []string // Most recently updated of those courses, newest first
+}
+
+// SMEDeletionTokenTTL is how long a deletion confirmation token stays valid.
+const SMEDeletionTokenTTL = 10 * time.Minute
+
+// SMEDeletionToken confirms that a user has seen an SME's deletion impact.
+type SMEDeletionToken struct {
+	Token     string
+	TenantID  uuid.UUID
+	SMEID     uuid.UUID
+	UserID    uuid.UUID
+	ExpiresAt time.Time
+}
+
+// IsExpired returns true if the token can no longer confirm a deletion.
+func (t *SMEDeletionToken) IsExpired() bool {
+	return time.Now().After(t.ExpiresAt)
+}
+
 // SMEListOptions provides filtering options for listing SMEs.
 type SMEListOptions struct {
-	Scope           *valueobject.SMEScope
-	Status          *valueobject.SMEStatus
-	TeamID          *uuid.UUID // Filter by team access
-	IncludeArchived bool       // Include archived SMEs in results
+	Scope             *valueobject.SMEScope
+	Status            *valueobject.SMEStatus
+	TeamID            *uuid.UUID // Filter by team access
+	IncludeArchived   bool       // Include archived SMEs in results
+	SummaryLongerThan int        // Only SMEs whose knowledge summary is longer than this many characters
+}
+
+// SMETaskStatusCount counts open tasks in one status.
+type SMETaskStatusCount struct {
+	Status  valueobject.SMETaskStatus
+	Count   int
+	Overdue int // Past their due date
 }
 
 // SMETaskListOptions provides filtering options for listing tasks.
 type SMETaskListOptions struct {
 	SMEID            *uuid.UUID
 	AssignedToUserID *uuid.UUID
+	TeamID           *uuid.UUID
 	Status           *valueobject.SMETaskStatus
 }
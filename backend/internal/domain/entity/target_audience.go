@@ -29,6 +29,8 @@ type TargetAudienceTemplate struct {
 
 	Status valueobject.TargetAudienceStatus
 
+	IsSample bool // Created during onboarding; removed by RemoveSampleContent
+
 	CreatedByUserID uuid.UUID
 	CreatedAt       time.Time
 	UpdatedAt       time.Time
@@ -0,0 +1,11 @@
+package entity
+
+import "time"
+
+// TaskHeartbeat records the last successful run of a background task type.
+type TaskHeartbeat struct {
+	TaskType        string
+	LastSucceededAt time.Time
+	LastDuration    time.Duration
+	WorkerHost      string // Host of the worker that ran it
+}
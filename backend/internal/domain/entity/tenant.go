@@ -31,12 +31,18 @@ func (s TenantStatus) String() string {
 // Tenant represents a top-level organizational boundary.
 // Multiple companies can belong to a single tenant.
 type Tenant struct {
-	ID        uuid.UUID
-	Name      string
-	Slug      string
-	Status    TenantStatus
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID     uuid.UUID
+	Name   string
+	Slug   string
+	Status TenantStatus
+	// StorageRegion is the storage region holding the tenant's objects, chosen at signup;
+	// "" is the primary region. Only a storage migration changes it.
+	StorageRegion string
+	// StorageMigrationRegion is the region a running storage migration is copying the
+	// tenant's objects to, or "" when none is running.
+	StorageMigrationRegion string
+	CreatedAt              time.Time
+	UpdatedAt              time.Time
 }
 
 // IsActive returns true if the tenant is active.
@@ -48,3 +54,15 @@ func (t *Tenant) IsActive() bool {
 func (t *Tenant) IsSuspended() bool {
 	return t.Status == TenantStatusSuspended
 }
+
+// TenantKey is a tenant's data key for encrypting stored content, wrapped with the
+// master encryption key. Versions count up from 1; only the newest is active and used
+// for writes, retired ones are kept for reading objects written with them.
+type TenantKey struct {
+	ID         uuid.UUID
+	TenantID   uuid.UUID
+	Version    int32
+	WrappedKey []byte
+	CreatedAt  time.Time
+	RetiredAt  *time.Time
+}
@@ -14,6 +14,23 @@ type User struct {
 	KratosID  uuid.UUID
 	CompanyID *uuid.UUID
 	Role      valueobject.Role
+	Locale    valueobject.Locale // Email language, synced from the Kratos identity
+
+	// Deactivated users keep their row (and authorship of content) but cannot sign in
+	IsActive      bool
+	DeactivatedAt *time.Time
+
+	// Set when the last emails to BouncedEmail hard-bounced; email is not attempted
+	// while the user's address is still the bounced one
+	BouncedEmail   *string
+	EmailBouncedAt *time.Time
+
+	// Set once the user hides the onboarding checklist
+	OnboardingDismissedAt *time.Time
+
+	// Whether the user (an admin) chose not to receive the weekly summary email
+	WeeklySummaryOptOut bool
+
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
@@ -0,0 +1,57 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WeeklySummarySchedule is when a tenant's admins receive the weekly summary email.
+// Day and Hour are in UTC.
+type WeeklySummarySchedule struct {
+	Enabled bool
+	Day     time.Weekday
+	Hour    int
+}
+
+// DefaultWeeklySummarySchedule is Monday 08:00 UTC, disabled until an admin turns it on.
+func DefaultWeeklySummarySchedule() WeeklySummarySchedule {
+	return WeeklySummarySchedule{Day: time.Monday, Hour: 8}
+}
+
+// ReportingWeek returns the start of the week (Monday 00:00 UTC) the summary sent
+// during now's week reports on: the previous full week. due is false until the
+// scheduled day and hour of now's week have been reached.
+func (s WeeklySummarySchedule) ReportingWeek(now time.Time) (weekStart time.Time, due bool) {
+	now = now.UTC()
+	daysSinceMonday := (int(now.Weekday()) + 6) % 7
+	thisWeek := time.Date(now.Year(), now.Month(), now.Day()-daysSinceMonday, 0, 0, 0, 0, time.UTC)
+
+	sendAt := thisWeek.AddDate(0, 0, (int(s.Day)+6)%7).Add(time.Duration(s.Hour) * time.Hour)
+	return thisWeek.AddDate(0, 0, -7), !now.Before(sendAt)
+}
+
+// WeeklySummary holds a tenant's figures for one week, Monday to Monday (UTC).
+type WeeklySummary struct {
+	TenantID  uuid.UUID
+	WeekStart time.Time
+	WeekEnd   time.Time
+
+	// Activity during the week
+	CoursesGenerated int   // Full course generations that completed
+	TokensUsed       int64 // Tokens used by jobs that finished
+	FailedJobs       int   // Top-level jobs that failed
+
+	// Usage against the budget for the current month
+	TokensThisMonth   int64
+	MonthlyTokenLimit *int64
+
+	// Open items as of the time of sending
+	OverdueSMETasks    int
+	PendingInvitations int
+}
+
+// IsEmpty reports whether nothing happened during the week, in which case no email is sent.
+func (s *WeeklySummary) IsEmpty() bool {
+	return s.CoursesGenerated == 0 && s.TokensUsed == 0 && s.FailedJobs == 0
+}
@@ -229,6 +229,12 @@ var (
 		Message:    "invitation is not valid",
 		HTTPStatus: http.StatusBadRequest,
 	}
+
+	ErrInvitationEmailInOtherCompany = &DomainError{
+		Code:       "INVITATION_EMAIL_IN_OTHER_COMPANY",
+		Message:    "this email already has an account in another organization - ask to be invited with a different email address",
+		HTTPStatus: http.StatusConflict,
+	}
 )
 
 // Validation errors
@@ -259,6 +265,12 @@ var (
 		Message:    "bad request",
 		HTTPStatus: http.StatusBadRequest,
 	}
+
+	ErrRateLimited = &DomainError{
+		Code:       "RATE_LIMITED",
+		Message:    "too many requests - please wait and try again",
+		HTTPStatus: http.StatusTooManyRequests,
+	}
 )
 
 // Internal errors
@@ -274,6 +286,12 @@ var (
 		Message:    "external service unavailable",
 		HTTPStatus: http.StatusBadGateway,
 	}
+
+	ErrMaintenanceMode = &DomainError{
+		Code:       "MAINTENANCE_MODE",
+		Message:    "the service is in read-only maintenance mode - please try again later",
+		HTTPStatus: http.StatusServiceUnavailable,
+	}
 )
 
 // SME errors
@@ -314,10 +332,16 @@ var (
 
 // AI Generation errors
 var (
-	ErrAIKeyNotConfigured = &DomainError{
-		Code:       "AI_KEY_NOT_CONFIGURED",
-		Message:    "AI API key not configured for this tenant",
-		HTTPStatus: http.StatusBadRequest,
+	ErrAIProviderNotConfigured = &DomainError{
+		Code:       "AI_PROVIDER_NOT_CONFIGURED",
+		Message:    "AI generation is not set up for your organization - an admin can add a Gemini API key in Settings > AI Settings",
+		HTTPStatus: http.StatusPreconditionFailed,
+	}
+
+	ErrAIGenerationDisabled = &DomainError{
+		Code:       "AI_GENERATION_DISABLED",
+		Message:    "AI generation is paused for your organization - please contact support to re-enable it",
+		HTTPStatus: http.StatusPreconditionFailed,
 	}
 
 	ErrAIKeyInvalid = &DomainError{
@@ -349,6 +373,18 @@ var (
 		Message:    "monthly token limit exceeded",
 		HTTPStatus: http.StatusForbidden,
 	}
+
+	ErrInlineEditTimeout = &DomainError{
+		Code:       "AI_INLINE_EDIT_TIMEOUT",
+		Message:    "AI edit timed out - use background regeneration for this component",
+		HTTPStatus: http.StatusGatewayTimeout,
+	}
+
+	ErrSuggestionTimeout = &DomainError{
+		Code:       "AI_SUGGESTION_TIMEOUT",
+		Message:    "AI suggestions timed out - please try again",
+		HTTPStatus: http.StatusGatewayTimeout,
+	}
 )
 
 // Notification errors
@@ -373,6 +409,33 @@ var (
 		Message:    "folder not found",
 		HTTPStatus: http.StatusNotFound,
 	}
+
+	ErrCollaboratorNotFound = &DomainError{
+		Code:       "COURSE_COLLABORATOR_NOT_FOUND",
+		Message:    "course collaborator not found",
+		HTTPStatus: http.StatusNotFound,
+	}
+
+	ErrUserAlreadyCollaborator = &DomainError{
+		Code:       "COURSE_USER_ALREADY_COLLABORATOR",
+		Message:    "user is already a collaborator on this course",
+		HTTPStatus: http.StatusConflict,
+	}
+
+	ErrCourseGenerationLocked = &DomainError{
+		Code:       "COURSE_GENERATION_LOCKED",
+		Message:    "the course is read-only while it is being generated",
+		HTTPStatus: http.StatusPreconditionFailed,
+	}
+)
+
+// Export errors
+var (
+	ErrExportArtifactPurged = &DomainError{
+		Code:       "EXPORT_ARTIFACT_PURGED",
+		Message:    "the export file has been deleted",
+		HTTPStatus: http.StatusGone,
+	}
 )
 
 // IsDomainError checks if an error is a DomainError.
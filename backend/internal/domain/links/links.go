@@ -0,0 +1,64 @@
+// Package links builds paths to frontend pages for notifications and emails. The
+// routes belong to the frontend (frontend/src/app); every link the backend sends
+// should be built here so a route change is made in one place.
+package links
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// routePrefix is prepended to every path, for frontends served below the site root.
+var routePrefix string
+
+// SetRoutePrefix sets the base path the frontend is served under, such as "/app".
+// Call it once at startup, before any links are built.
+func SetRoutePrefix(prefix string) {
+	prefix = strings.TrimRight(prefix, "/")
+	if prefix != "" && !strings.HasPrefix(prefix, "/") {
+		prefix = "/" + prefix
+	}
+	routePrefix = prefix
+}
+
+// CoursePreviewLink is the read-only preview of a generated course.
+func CoursePreviewLink(courseID uuid.UUID) string {
+	return withPrefix("/course/" + courseID.String() + "/preview")
+}
+
+// CourseLink opens a course's generation modal on the dashboard, where its outline,
+// lessons and exports are managed.
+func CourseLink(courseID uuid.UUID) string {
+	return withPrefix("/dashboard?edit=" + courseID.String())
+}
+
+// OutlineReviewLink opens a course's outline for review.
+func OutlineReviewLink(courseID uuid.UUID) string {
+	return CourseLink(courseID)
+}
+
+// TaskLink opens an SME with one of its tasks.
+func TaskLink(smeID, taskID uuid.UUID) string {
+	return withPrefix("/smes?" + url.Values{"sme": {smeID.String()}, "task": {taskID.String()}}.Encode())
+}
+
+// DashboardLink is the dashboard.
+func DashboardLink() string {
+	return withPrefix("/dashboard")
+}
+
+// AcceptInviteLink accepts an invitation to join a company.
+func AcceptInviteLink(token string) string {
+	return withPrefix("/auth/accept-invite?token=" + url.QueryEscape(token))
+}
+
+// LoginLink is the login page.
+func LoginLink() string {
+	return withPrefix("/auth/login")
+}
+
+func withPrefix(route string) string {
+	return routePrefix + route
+}
@@ -0,0 +1,142 @@
+package links
+
+import (
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+var (
+	testCourseID = uuid.MustParse("11111111-1111-1111-1111-111111111111")
+	testSMEID    = uuid.MustParse("22222222-2222-2222-2222-222222222222")
+	testTaskID   = uuid.MustParse("33333333-3333-3333-3333-333333333333")
+)
+
+// builtLinks returns every link builder's output for the test IDs.
+func builtLinks() map[string]string {
+	return map[string]string{
+		"CoursePreviewLink": CoursePreviewLink(testCourseID),
+		"CourseLink":        CourseLink(testCourseID),
+		"OutlineReviewLink": OutlineReviewLink(testCourseID),
+		"TaskLink":          TaskLink(testSMEID, testTaskID),
+		"DashboardLink":     DashboardLink(),
+		"AcceptInviteLink":  AcceptInviteLink("tok+en/1=="),
+		"LoginLink":         LoginLink(),
+	}
+}
+
+// wantLinks pins the route shapes the frontend serves, below prefix.
+func wantLinks(prefix string) map[string]string {
+	return map[string]string{
+		"CoursePreviewLink": prefix + "/course/11111111-1111-1111-1111-111111111111/preview",
+		"CourseLink":        prefix + "/dashboard?edit=11111111-1111-1111-1111-111111111111",
+		"OutlineReviewLink": prefix + "/dashboard?edit=11111111-1111-1111-1111-111111111111",
+		"TaskLink":          prefix + "/smes?sme=22222222-2222-2222-2222-222222222222&task=33333333-3333-3333-3333-333333333333",
+		"DashboardLink":     prefix + "/dashboard",
+		"AcceptInviteLink":  prefix + "/auth/accept-invite?token=tok%2Ben%2F1%3D%3D",
+		"LoginLink":         prefix + "/auth/login",
+	}
+}
+
+func TestLinks(t *testing.T) {
+	SetRoutePrefix("")
+	for name, got := range builtLinks() {
+		if want := wantLinks("")[name]; got != want {
+			t.Errorf("%s = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestSetRoutePrefix(t *testing.T) {
+	t.Cleanup(func() { SetRoutePrefix("") })
+
+	tests := []struct {
+		prefix string
+		want   string
+	}{
+		{"", ""},
+		{"/", ""},
+		{"/app", "/app"},
+		{"/app/", "/app"},
+		{"app", "/app"},
+		{"/learn/app//", "/learn/app"},
+	}
+	for _, tt := range tests {
+		SetRoutePrefix(tt.prefix)
+		for name, got := range builtLinks() {
+			if want := wantLinks(tt.want)[name]; got != want {
+				t.Errorf("with prefix %q, %s = %q, want %q", tt.prefix, name, got, want)
+			}
+		}
+	}
+}
+
+func TestAcceptInviteLinkRoundTripsToken(t *testing.T) {
+	SetRoutePrefix("")
+	token := "a b&c=d?e#f"
+	u, err := url.Parse(AcceptInviteLink(token))
+	if err != nil {
+		t.Fatalf("invalid link: %v", err)
+	}
+	if got := u.Query().Get("token"); got != token {
+		t.Errorf("token = %q, want %q", got, token)
+	}
+}
+
+// TestLinksMatchFrontendRoutes checks every link's path is a page in the frontend's app
+// directory, when the frontend is checked out next to the backend.
+func TestLinksMatchFrontendRoutes(t *testing.T) {
+	appDir := filepath.Join("..", "..", "..", "..", "frontend", "src", "app")
+	if _, err := os.Stat(appDir); err != nil {
+		t.Skip("frontend app directory not available")
+	}
+
+	SetRoutePrefix("")
+	for name, link := range builtLinks() {
+		u, err := url.Parse(link)
+		if err != nil {
+			t.Fatalf("%s: invalid link %q: %v", name, link, err)
+		}
+		segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+		if !hasPage(t, appDir, segments) {
+			t.Errorf("%s: no frontend page serves %s", name, u.Path)
+		}
+	}
+}
+
+// hasPage reports whether dir has a page.tsx for the path segments. Route groups such as
+// "(main)" add no segment, and dynamic segments such as "[courseId]" match any value.
+func hasPage(t *testing.T, dir string, segments []string) bool {
+	t.Helper()
+	if len(segments) == 0 {
+		if _, err := os.Stat(filepath.Join(dir, "page.tsx")); err == nil {
+			return true
+		}
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return false
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		name := entry.Name()
+		switch {
+		case strings.HasPrefix(name, "(") && strings.HasSuffix(name, ")"):
+			if hasPage(t, filepath.Join(dir, name), segments) {
+				return true
+			}
+		case len(segments) == 0:
+		case name == segments[0] || (strings.HasPrefix(name, "[") && strings.HasSuffix(name, "]")):
+			if hasPage(t, filepath.Join(dir, name), segments[1:]) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/sogos/mirai-backend/internal/domain/entity"
@@ -19,8 +20,24 @@ type TenantAISettingsRepository interface {
 	// Update updates AI settings.
 	Update(ctx context.Context, settings *entity.TenantAISettings) error
 
-	// IncrementTokenUsage increments the token usage counter.
+	// SetAIGenerationEnabled turns the tenant's AI generation on or off, creating the
+	// settings if they don't exist yet. Tenants without settings are enabled.
+	SetAIGenerationEnabled(ctx context.Context, tenantID uuid.UUID, enabled bool, updatedByUserID uuid.UUID) error
+
+	// SetExportRetentionDays sets how many days the tenant's export artifacts are kept,
+	// creating the settings if they don't exist yet.
+	SetExportRetentionDays(ctx context.Context, tenantID uuid.UUID, days int, updatedByUserID uuid.UUID) error
+
+	// IncrementTokenUsage atomically adds tokens to the tenant's usage for the current period.
+	// Safe to call concurrently; increments are never lost.
 	IncrementTokenUsage(ctx context.Context, tenantID uuid.UUID, tokens int64) error
+
+	// GetCurrentPeriodUsage returns the tokens the tenant has used in the current period.
+	GetCurrentPeriodUsage(ctx context.Context, tenantID uuid.UUID) (int64, error)
+
+	// ListUsagePeriods returns the tenant's usage per month, most recent first, without
+	// the legacy period.
+	ListUsagePeriods(ctx context.Context, tenantID uuid.UUID, limit int) ([]*entity.TokenUsagePeriod, error)
 }
 
 // GenerationJobRepository defines the interface for generation job data access.
@@ -32,22 +49,52 @@ type GenerationJobRepository interface {
 	// If any job fails to create, all jobs are rolled back.
 	CreateBatch(ctx context.Context, jobs []*entity.GenerationJob) error
 
+	// CreateFullCourseRun atomically creates a full_course parent job and its lesson jobs,
+	// pointing the lesson jobs at the parent and recording their number on it. If the course
+	// already has a queued or processing full_course job, nothing is created and that job is
+	// returned; otherwise parent is returned.
+	CreateFullCourseRun(ctx context.Context, parent *entity.GenerationJob, children []*entity.GenerationJob) (*entity.GenerationJob, error)
+
+	// CreateChildJobs atomically creates lesson jobs under an existing full_course parent and
+	// records their number on it.
+	CreateChildJobs(ctx context.Context, parentID uuid.UUID, children []*entity.GenerationJob) error
+
+	// MarkEnqueued records that worker tasks were enqueued for the jobs.
+	MarkEnqueued(ctx context.Context, ids []uuid.UUID) error
+
+	// ListUnenqueuedChildren retrieves up to limit queued child jobs created before the cutoff
+	// that never had a worker task enqueued, oldest first, skipping tenants whose AI
+	// generation is paused.
+	ListUnenqueuedChildren(ctx context.Context, createdBefore time.Time, limit int) ([]*entity.GenerationJob, error)
+
+	// GetActiveFullCourseRun returns the course's queued or processing full_course job, or nil if none.
+	GetActiveFullCourseRun(ctx context.Context, courseID uuid.UUID) (*entity.GenerationJob, error)
+
 	// GetByID retrieves a job by its ID.
 	GetByID(ctx context.Context, id uuid.UUID) (*entity.GenerationJob, error)
 
-	// List retrieves jobs with optional filtering.
+	// GetLatestBySubmissionID returns the most recent ingestion job for an SME submission, or nil if none.
+	GetLatestBySubmissionID(ctx context.Context, submissionID uuid.UUID) (*entity.GenerationJob, error)
+
+	// List retrieves jobs with optional filtering, most recent first.
 	List(ctx context.Context, opts entity.GenerationJobListOptions) ([]*entity.GenerationJob, error)
 
 	// Update updates a job.
 	Update(ctx context.Context, job *entity.GenerationJob) error
 
-	// GetNextQueued atomically claims the next queued job for processing.
-	// Updates status to 'processing' and sets started_at in one atomic operation.
+	// UpdateProgress sets the progress of a job that is still processing, leaving every other
+	// field alone so a concurrent cancellation isn't overwritten. Returns false if the job is
+	// no longer processing.
+	UpdateProgress(ctx context.Context, id uuid.UUID, percent int32, message string) (bool, error)
+
+	// GetNextQueued atomically claims the next queued job for processing, skipping tenants
+	// whose AI generation is paused. Updates status to 'processing' and sets started_at in
+	// one atomic operation.
 	GetNextQueued(ctx context.Context) (*entity.GenerationJob, error)
 
 	// ClaimJobByID atomically claims a specific job by ID for processing.
-	// Returns the job if successfully claimed, nil if already processed/claimed.
-	// Updates status to 'processing' and sets started_at in one atomic operation.
+	// Returns the job if successfully claimed, nil if already processed/claimed or its
+	// tenant's AI generation is paused. Updates status to 'processing' and sets started_at in one atomic operation.
 	ClaimJobByID(ctx context.Context, id uuid.UUID) (*entity.GenerationJob, error)
 
 	// ListByParentID retrieves all child jobs for a parent job.
@@ -59,6 +106,7 @@ type GenerationJobRepository interface {
 	// TryFinalizeParentJob atomically checks if all children are complete and optionally finalizes the parent job.
 	// If allComplete is true and WasFinalized is true, the parent status has been updated atomically.
 	// Returns the finalization result (completed count, failed count, total tokens) or nil if parent not found.
+	// Children are counted against the number the parent queued, so a missing child keeps it incomplete.
 	// Uses SELECT FOR UPDATE to prevent race conditions when multiple children complete simultaneously.
 	TryFinalizeParentJob(ctx context.Context, parentID uuid.UUID) (*ParentJobFinalizationResult, error)
 
@@ -66,6 +114,100 @@ type GenerationJobRepository interface {
 	// This is the preferred method as it ensures the status update is inside the atomic lock.
 	// Returns nil if parent was already finalized or not found.
 	FinalizeParentJob(ctx context.Context, parentID uuid.UUID, completedStatus, failedStatus string, progressMessage string) (*ParentJobFinalizationResult, error)
+
+	// RequeueFailedChildren resets the failed children of a failed parent to queued and reopens
+	// the parent as processing, under the parent's row lock. Returns the requeued children,
+	// or none if the parent is not failed or another run of its course is active.
+	RequeueFailedChildren(ctx context.Context, parentID uuid.UUID) ([]*entity.GenerationJob, error)
+
+	// ReassignActiveJobs moves queued and processing jobs created by one user to another,
+	// so progress notifications reach someone who can still sign in. Returns the moved jobs.
+	ReassignActiveJobs(ctx context.Context, fromUserID, toUserID uuid.UUID) ([]*entity.GenerationJob, error)
+
+	// ListActiveByTeamMembers retrieves queued and processing top-level jobs created by a
+	// team's members, newest first.
+	ListActiveByTeamMembers(ctx context.Context, teamID uuid.UUID, limit int) ([]*entity.GenerationJob, error)
+
+	// CountActiveByType counts queued and processing jobs per type, excluding full_course parents.
+	CountActiveByType(ctx context.Context) ([]entity.JobTypeQueueCount, error)
+
+	// GetOldestQueuedCreatedAt returns when the user's oldest queued job was created, or nil if none are queued.
+	GetOldestQueuedCreatedAt(ctx context.Context, userID uuid.UUID) (*time.Time, error)
+
+	// CountQueuedBefore counts queued jobs created before the given time, excluding full_course
+	// parents and jobs of tenants whose AI generation is paused.
+	CountQueuedBefore(ctx context.Context, createdBefore time.Time) (int, error)
+
+	// GetJobOutcomeStats aggregates finished jobs: the average run time of jobs completed since
+	// durationSince, and how many jobs finished (and failed with an error matching any of
+	// failurePatterns, as SQL LIKE patterns) since outcomesSince.
+	GetJobOutcomeStats(ctx context.Context, durationSince, outcomesSince time.Time, failurePatterns []string) (*JobOutcomeStats, error)
+
+	// ListUnfinalizedParents retrieves open full_course parents whose children all ended before the cutoff.
+	// Parents missing some of the children they queued are left to ListParentsMissingChildren.
+	ListUnfinalizedParents(ctx context.Context, childrenEndedBefore time.Time) ([]*entity.GenerationJob, error)
+
+	// ListParentsMissingChildren retrieves open full_course parents with fewer lesson jobs than
+	// they queued, once their existing children all ended before the cutoff.
+	ListParentsMissingChildren(ctx context.Context, childrenEndedBefore time.Time) ([]*entity.GenerationJob, error)
+
+	// ListParentsWithoutChildren retrieves open full_course parents created before the cutoff that have no child jobs.
+	ListParentsWithoutChildren(ctx context.Context, createdBefore time.Time) ([]*entity.GenerationJob, error)
+
+	// ListCompletedParentsWithoutLessons retrieves full_course parents completed since the cutoff
+	// whose course has no active generated lessons.
+	ListCompletedParentsWithoutLessons(ctx context.Context, completedSince time.Time) ([]*entity.GenerationJob, error)
+}
+
+// JobAnomalyRepository defines the interface for generation job anomaly data access.
+type JobAnomalyRepository interface {
+	// Record stores an anomaly unless one of the same type is already recorded for the job.
+	// Returns true if the anomaly is new.
+	Record(ctx context.Context, anomaly *entity.JobAnomaly) (bool, error)
+
+	// List retrieves anomalies, most recent first.
+	List(ctx context.Context, opts entity.JobAnomalyListOptions) ([]*entity.JobAnomaly, error)
+}
+
+// ExportDownloadLinkRepository defines the interface for export download link data access.
+type ExportDownloadLinkRepository interface {
+	// Create stores a download link.
+	Create(ctx context.Context, link *entity.ExportDownloadLink) error
+
+	// GetByToken retrieves a download link by its token.
+	// Returns nil if the token is unknown.
+	GetByToken(ctx context.Context, token string) (*entity.ExportDownloadLink, error)
+
+	// RecordAccess adds an entry to the export's access log.
+	RecordAccess(ctx context.Context, access *entity.ExportAccess) error
+}
+
+// ExportArtifactRepository defines the interface for export artifact data access.
+type ExportArtifactRepository interface {
+	// Create stores an artifact.
+	Create(ctx context.Context, artifact *entity.ExportArtifact) error
+
+	// GetByID retrieves an artifact by ID.
+	// Returns nil if not found.
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.ExportArtifact, error)
+
+	// GetByJobID retrieves the artifact an export job produced.
+	// Returns nil if the job has none.
+	GetByJobID(ctx context.Context, jobID uuid.UUID) (*entity.ExportArtifact, error)
+
+	// ListByCourseID retrieves a course's artifacts, purged ones included, newest first.
+	ListByCourseID(ctx context.Context, courseID uuid.UUID) ([]*entity.ExportArtifact, error)
+
+	// ListSuperseded retrieves the unpurged artifacts of the same type and course or SME
+	// as the given one, other than it.
+	ListSuperseded(ctx context.Context, artifact *entity.ExportArtifact) ([]*entity.ExportArtifact, error)
+
+	// ListExpired retrieves up to limit unpurged artifacts that expired before the cutoff,
+	// oldest first.
+	ListExpired(ctx context.Context, before time.Time, limit int) ([]*entity.ExportArtifact, error)
+
+	// MarkPurged records that an artifact's file was deleted.
+	MarkPurged(ctx context.Context, id uuid.UUID, reason entity.ExportPurgeReason) error
 }
 
 // ParentJobFinalizationResult contains the result of trying to finalize a parent job.
@@ -78,10 +220,26 @@ type ParentJobFinalizationResult struct {
 	CompletedCount int
 	// FailedCount is the number of failed children
 	FailedCount int
-	// TotalCount is the total number of children
+	// TotalCount is the number of lesson jobs the parent queued, or of its child rows for
+	// parents created before that was recorded
 	TotalCount int
+	// MissingCount is how many of TotalCount have no job row; the parent isn't complete
+	// while any are missing
+	MissingCount int
 	// TotalTokens is the sum of tokens used by all children
 	TotalTokens int64
+	// TotalImages is the sum of images generated by all children
+	TotalImages int32
+}
+
+// JobOutcomeStats summarizes recently finished generation jobs.
+type JobOutcomeStats struct {
+	// AvgDuration is the mean time from start to completion, zero if none completed
+	AvgDuration time.Duration
+	// FinishedCount is the number of jobs that completed or failed
+	FinishedCount int
+	// MatchedFailureCount is the number of failed jobs whose error matched the patterns
+	MatchedFailureCount int
 }
 
 // CourseOutlineRepository defines the interface for course outline data access.
@@ -93,6 +251,12 @@ type CourseOutlineRepository interface {
 	// If any part fails, the entire operation is rolled back.
 	CreateCompleteOutline(ctx context.Context, outline *entity.CourseOutline, sections []entity.OutlineSection, lessons []entity.OutlineLesson) error
 
+	// UpdateOutlineContent atomically applies an edit to an outline: it updates the given
+	// sections and lessons, adds the new lessons and removes the removed ones, and clears
+	// any endorsement. If a section is not in the outline or a lesson is not in its
+	// section, nothing is changed.
+	UpdateOutlineContent(ctx context.Context, outlineID uuid.UUID, update entity.OutlineContentUpdate) error
+
 	// GetByID retrieves an outline by its ID.
 	GetByID(ctx context.Context, id uuid.UUID) (*entity.CourseOutline, error)
 
@@ -156,8 +320,18 @@ type GeneratedLessonRepository interface {
 	// GetByOutlineLessonID retrieves by outline lesson reference.
 	GetByOutlineLessonID(ctx context.Context, outlineLessonID uuid.UUID) (*entity.GeneratedLesson, error)
 
-	// ListByCourseID retrieves all lessons for a course.
-	ListByCourseID(ctx context.Context, courseID uuid.UUID) ([]*entity.GeneratedLesson, error)
+	// ListByCourseID retrieves the lessons for a course, optionally including orphaned ones.
+	ListByCourseID(ctx context.Context, courseID uuid.UUID, includeOrphaned bool) ([]*entity.GeneratedLesson, error)
+
+	// SyncOrphansWithOutline moves lessons generated for an earlier version of one of the
+	// outline's lessons (same lesson key) onto it, then marks lessons not backed by the
+	// outline's lessons as orphaned and restores those that are, in a single transaction.
+	// Returns the number newly orphaned.
+	SyncOrphansWithOutline(ctx context.Context, courseID, outlineID uuid.UUID) (int, error)
+
+	// OrphanByOutlineLessonIDs marks the lessons generated for the given outline lessons
+	// as orphaned, so the outline lessons can be removed. Returns the number newly orphaned.
+	OrphanByOutlineLessonIDs(ctx context.Context, outlineLessonIDs []uuid.UUID) (int, error)
 
 	// Update updates a lesson.
 	Update(ctx context.Context, lesson *entity.GeneratedLesson) error
@@ -165,20 +339,42 @@ type GeneratedLessonRepository interface {
 
 // LessonComponentRepository defines the interface for lesson component data access.
 type LessonComponentRepository interface {
-	// Create creates a new component.
+	// Create creates a new component at component.Position, shifting later components down.
+	// A position of zero or past the end appends. Sets component.Position to the final position.
 	Create(ctx context.Context, component *entity.LessonComponent) error
 
 	// GetByID retrieves a component by its ID.
 	GetByID(ctx context.Context, id uuid.UUID) (*entity.LessonComponent, error)
 
-	// ListByLessonID retrieves all components for a lesson.
+	// ListByLessonID retrieves all components for a lesson, ordered by position then id.
+	// Positions that aren't 1..n are repaired before returning.
 	ListByLessonID(ctx context.Context, lessonID uuid.UUID) ([]*entity.LessonComponent, error)
 
-	// Update updates a component.
+	// Update updates a component, moving it if component.Position changed.
+	// Sets component.Position to the final position.
 	Update(ctx context.Context, component *entity.LessonComponent) error
 
-	// Delete deletes a component.
+	// Delete deletes a component and closes the gap it leaves.
 	Delete(ctx context.Context, id uuid.UUID) error
+
+	// RepairPositions renumbers a lesson's components 1..n in their current order.
+	// Returns the number of components whose position changed.
+	RepairPositions(ctx context.Context, lessonID uuid.UUID) (int, error)
+
+	// SearchByCourse finds the course's components whose text contains phrase, matched
+	// word by word without stemming. Components of orphaned lessons are skipped. Matches
+	// are in course order: section, then lesson, then component position. Returns at most
+	// limit matches.
+	SearchByCourse(ctx context.Context, courseID uuid.UUID, phrase string, limit int) ([]*entity.LessonComponentMatch, error)
+
+	// ListQuizzesBelowSchemaVersion returns quiz components whose content has no numeric
+	// schema_version or one below version, ordered by id and starting after afterID (use
+	// uuid.Nil to start at the beginning). Returns at most limit components.
+	ListQuizzesBelowSchemaVersion(ctx context.Context, version int, afterID uuid.UUID, limit int) ([]*entity.LessonComponent, error)
+
+	// ReplaceContent sets a component's content, leaving its position alone, unless it was
+	// updated after updatedAt. Reports whether the content was replaced.
+	ReplaceContent(ctx context.Context, id uuid.UUID, contentJSON []byte, updatedAt time.Time) (bool, error)
 }
 
 // CourseGenerationInputRepository defines the interface for course generation input data access.
@@ -192,3 +388,36 @@ type CourseGenerationInputRepository interface {
 	// Update updates generation inputs.
 	Update(ctx context.Context, input *entity.CourseGenerationInput) error
 }
+
+// GenerationDraftRepository defines the interface for generation wizard draft data access.
+type GenerationDraftRepository interface {
+	// Upsert creates or replaces the user's draft for a course.
+	Upsert(ctx context.Context, draft *entity.GenerationDraft) error
+
+	// Get retrieves the user's draft for a course, or nil if there is none.
+	Get(ctx context.Context, userID, courseID uuid.UUID) (*entity.GenerationDraft, error)
+
+	// Delete deletes the user's draft for a course.
+	Delete(ctx context.Context, userID, courseID uuid.UUID) error
+
+	// DeleteOlderThan deletes drafts last saved before cutoff and returns how many were deleted.
+	DeleteOlderThan(ctx context.Context, cutoff time.Time) (int64, error)
+}
+
+// OutlineCommentRepository defines the interface for outline lesson comment data access.
+type OutlineCommentRepository interface {
+	// Create creates a new comment.
+	Create(ctx context.Context, comment *entity.OutlineComment) error
+
+	// GetByID retrieves a comment by its ID, or nil if there is none.
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.OutlineComment, error)
+
+	// ListByCourseID retrieves all comments on a course's outline lessons, oldest first.
+	ListByCourseID(ctx context.Context, courseID uuid.UUID) ([]*entity.OutlineComment, error)
+
+	// Resolve marks a comment resolved by the given user.
+	Resolve(ctx context.Context, id uuid.UUID, userID uuid.UUID) error
+
+	// CountUnresolved counts the course's unresolved comments on the given lesson keys.
+	CountUnresolved(ctx context.Context, courseID uuid.UUID, lessonKeys []uuid.UUID) (int, error)
+}
@@ -19,6 +19,33 @@ type CourseRepository interface {
 	// Update updates a course.
 	Update(ctx context.Context, course *entity.Course) error
 
+	// SetContentSize records the size of the course's content as just written.
+	SetContentSize(ctx context.Context, id uuid.UUID, sizeBytes int64) error
+
+	// BumpDraftVersion records an edit to the course's draft and returns the new draft version.
+	BumpDraftVersion(ctx context.Context, id uuid.UUID) (int32, error)
+
+	// SetPublishedVersion changes the published version from one version to another.
+	// It reports false, changing nothing, if the published version is no longer from.
+	SetPublishedVersion(ctx context.Context, id uuid.UUID, from, to int32) (bool, error)
+
+	// ResetDraftVersion sets the draft version back to the published version.
+	ResetDraftVersion(ctx context.Context, id uuid.UUID) error
+
+	// ListCards retrieves a page of course cards, most recently modified first.
+	ListCards(ctx context.Context, opts entity.CourseCardListOptions) ([]*entity.CourseCard, error)
+
+	// GetCardDetails retrieves the card details of the given courses. Courses that don't
+	// exist or aren't visible are left out.
+	GetCardDetails(ctx context.Context, ids []uuid.UUID) ([]*entity.CourseCardDetails, error)
+
+	// GetGenerationLock returns the course's generation lock, or nil if it isn't locked.
+	GetGenerationLock(ctx context.Context, id uuid.UUID) (*entity.CourseGenerationLock, error)
+
+	// ReleaseStaleGenerationLocks clears generation locks held by jobs that have ended and
+	// returns the courses released.
+	ReleaseStaleGenerationLocks(ctx context.Context) ([]uuid.UUID, error)
+
 	// Delete deletes a course.
 	Delete(ctx context.Context, id uuid.UUID) error
 
@@ -30,6 +57,58 @@ type CourseRepository interface {
 
 	// CountByFolder counts courses in a folder.
 	CountByFolder(ctx context.Context, folderID uuid.UUID) (int, error)
+
+	// CountPerFolder counts courses in every folder that has any.
+	CountPerFolder(ctx context.Context) (map[uuid.UUID]int, error)
+}
+
+// CourseCollaboratorRepository defines the interface for course collaborator data access.
+type CourseCollaboratorRepository interface {
+	// Add assigns a user to a course with a role.
+	Add(ctx context.Context, collaborator *entity.CourseCollaborator) error
+
+	// Remove removes a user's assignment from a course.
+	Remove(ctx context.Context, courseID, userID uuid.UUID) error
+
+	// Get retrieves a specific collaborator assignment.
+	Get(ctx context.Context, courseID, userID uuid.UUID) (*entity.CourseCollaborator, error)
+
+	// ListByCourseID retrieves all collaborators on a course.
+	ListByCourseID(ctx context.Context, courseID uuid.UUID) ([]*entity.CourseCollaborator, error)
+
+	// ListByUserID retrieves all course assignments for a user.
+	ListByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.CourseCollaborator, error)
+}
+
+// CourseAttachmentRepository defines the interface for course reference attachment data access.
+type CourseAttachmentRepository interface {
+	// Create creates a new attachment.
+	Create(ctx context.Context, attachment *entity.CourseAttachment) error
+
+	// GetByID retrieves an attachment by its ID, or nil if there is none.
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.CourseAttachment, error)
+
+	// ListByCourseID retrieves a course's attachments, oldest first.
+	ListByCourseID(ctx context.Context, courseID uuid.UUID) ([]*entity.CourseAttachment, error)
+
+	// ListForPrompts retrieves a course's ready, included attachments, oldest first, with
+	// their unflagged chunks loaded.
+	ListForPrompts(ctx context.Context, courseID uuid.UUID) ([]*entity.CourseAttachment, error)
+
+	// UpdateStatus records an attachment's extraction status, error and chunk counts.
+	UpdateStatus(ctx context.Context, attachment *entity.CourseAttachment) error
+
+	// SetExcluded sets whether the attachment is left out of generation prompts.
+	SetExcluded(ctx context.Context, id uuid.UUID, excluded bool) error
+
+	// ReplaceChunks replaces an attachment's chunks with the given ones, in order.
+	ReplaceChunks(ctx context.Context, attachment *entity.CourseAttachment, chunks []entity.CourseAttachmentChunk) error
+
+	// Copy creates attachment as a copy of the source attachment, chunks included.
+	Copy(ctx context.Context, sourceID uuid.UUID, attachment *entity.CourseAttachment) error
+
+	// Delete deletes an attachment and its chunks.
+	Delete(ctx context.Context, id uuid.UUID) error
 }
 
 // FolderRepository defines the interface for folder data access.
@@ -63,3 +142,10 @@ type FolderRepository interface {
 	// Filters PERSONAL folders to only show the user's own private folder.
 	GetHierarchy(ctx context.Context, userID uuid.UUID) ([]*entity.Folder, error)
 }
+
+// StorageReferenceRepository finds the storage objects a tenant's database rows point to.
+type StorageReferenceRepository interface {
+	// ListReferences returns the tenant's course IDs and every stored object path its
+	// courses, jobs, submissions, SMEs, LMS deliveries and lesson components reference.
+	ListReferences(ctx context.Context, tenantID uuid.UUID) (*entity.StorageReferences, error)
+}
@@ -25,6 +25,37 @@ type TenantRepository interface {
 
 	// Delete deletes a tenant.
 	Delete(ctx context.Context, id uuid.UUID) error
+
+	// ListActiveIDs retrieves the IDs of all active tenants.
+	ListActiveIDs(ctx context.Context) ([]uuid.UUID, error)
+
+	// StartStorageMigration records that the tenant's objects are being copied to region.
+	// It returns false if a migration to another region is already running.
+	StartStorageMigration(ctx context.Context, id uuid.UUID, region string) (bool, error)
+
+	// CompleteStorageMigration moves the tenant from its current region, from, to the
+	// region its migration copied to, in one update. It fails if the tenant is no longer
+	// in from or is not migrating to to.
+	CompleteStorageMigration(ctx context.Context, id uuid.UUID, from, to string) error
+
+	// CancelStorageMigration clears a running storage migration, leaving the region as it is.
+	CancelStorageMigration(ctx context.Context, id uuid.UUID) error
+}
+
+// TenantKeyRepository defines the interface for tenant data key access.
+type TenantKeyRepository interface {
+	// GetActive retrieves the tenant's active key, or nil if it has none yet.
+	GetActive(ctx context.Context, tenantID uuid.UUID) (*entity.TenantKey, error)
+
+	// GetByVersion retrieves one version of the tenant's key, active or retired.
+	GetByVersion(ctx context.Context, tenantID uuid.UUID, version int32) (*entity.TenantKey, error)
+
+	// CreateFirst stores the tenant's first key as version 1. It returns false, storing
+	// nothing, if the tenant already has a key.
+	CreateFirst(ctx context.Context, key *entity.TenantKey) (bool, error)
+
+	// Rotate retires the tenant's active key, if any, and stores key as the next version.
+	Rotate(ctx context.Context, key *entity.TenantKey) error
 }
 
 // UserRepository defines the interface for user data access.
@@ -44,8 +75,27 @@ type UserRepository interface {
 	// ListByCompanyID retrieves all users in a company.
 	ListByCompanyID(ctx context.Context, companyID uuid.UUID) ([]*entity.User, error)
 
+	// ListActiveAdminsByTenantID retrieves the active admins of a tenant.
+	ListActiveAdminsByTenantID(ctx context.Context, tenantID uuid.UUID) ([]*entity.User, error)
+
 	// Update updates a user.
 	Update(ctx context.Context, user *entity.User) error
+
+	// SetEmailBounce flags the user's address as hard-bouncing, or clears the flag when
+	// bouncedEmail is nil.
+	SetEmailBounce(ctx context.Context, userID uuid.UUID, bouncedEmail *string) error
+
+	// DismissOnboarding records that the user hid the onboarding checklist.
+	DismissOnboarding(ctx context.Context, userID uuid.UUID) error
+
+	// SetWeeklySummaryOptOut sets whether the user is left out of the weekly summary email.
+	SetWeeklySummaryOptOut(ctx context.Context, userID uuid.UUID, optOut bool) error
+}
+
+// OnboardingRepository reports which onboarding milestones a tenant has reached.
+type OnboardingRepository interface {
+	// GetProgress checks each milestone with a cheap EXISTS query.
+	GetProgress(ctx context.Context, tenantID uuid.UUID) (*entity.OnboardingProgress, error)
 }
 
 // CompanyRepository defines the interface for company data access.
@@ -65,7 +115,7 @@ type CompanyRepository interface {
 	// UpdateStripeFields updates only Stripe-related fields.
 	UpdateStripeFields(ctx context.Context, id uuid.UUID, fields entity.StripeFields) error
 
-	// CountUsersByCompanyID counts the number of users in a company.
+	// CountUsersByCompanyID counts the active users in a company, i.e. the seats in use.
 	CountUsersByCompanyID(ctx context.Context, companyID uuid.UUID) (int, error)
 
 	// Delete deletes a company.
@@ -122,6 +172,13 @@ type InvitationRepository interface {
 	// Update updates an invitation.
 	Update(ctx context.Context, invitation *entity.Invitation) error
 
+	// MarkAccepted marks a pending invitation as accepted by the user.
+	// Returns false if the invitation was no longer pending, so it is only consumed once.
+	MarkAccepted(ctx context.Context, id, userID uuid.UUID) (bool, error)
+
+	// ListPendingByTeamID retrieves a team's pending, unexpired invitations, newest first.
+	ListPendingByTeamID(ctx context.Context, teamID uuid.UUID, limit int) ([]*entity.Invitation, error)
+
 	// CountPendingByCompanyID counts pending invitations for a company.
 	CountPendingByCompanyID(ctx context.Context, companyID uuid.UUID) (int, error)
 }
@@ -140,6 +197,10 @@ type PendingRegistrationRepository interface {
 	// GetByEmail retrieves a pending registration by email.
 	GetByEmail(ctx context.Context, email string) (*entity.PendingRegistration, error)
 
+	// GetPaidByEmail retrieves the most recent registration for an email whose payment
+	// was received (paid, provisioning or failed), or nil if there is none.
+	GetPaidByEmail(ctx context.Context, email string) (*entity.PendingRegistration, error)
+
 	// ListByStatus retrieves all pending registrations with a given status.
 	ListByStatus(ctx context.Context, status valueobject.PendingRegistrationStatus) ([]*entity.PendingRegistration, error)
 
@@ -159,3 +220,57 @@ type PendingRegistrationRepository interface {
 	// ExistsByEmail checks if a pending registration exists for the given email.
 	ExistsByEmail(ctx context.Context, email string) (bool, error)
 }
+
+// AuditEventRepository defines the interface for audit log data access.
+// Events are append-only; there is no update or delete.
+type AuditEventRepository interface {
+	// Create appends an event to the audit log.
+	Create(ctx context.Context, event *entity.AuditEvent) error
+
+	// List retrieves events, most recent first.
+	List(ctx context.Context, opts entity.AuditEventListOptions) ([]*entity.AuditEvent, error)
+}
+
+// APITokenRepository defines the interface for API token data access.
+type APITokenRepository interface {
+	// Create creates a new token.
+	Create(ctx context.Context, token *entity.APIToken) error
+
+	// GetByID retrieves a token by its ID, or nil if there is none.
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.APIToken, error)
+
+	// GetBySecretHash retrieves a token by the hash of its secret, or nil if there is none.
+	// Called before the tenant is known, so callers need a superadmin context.
+	GetBySecretHash(ctx context.Context, secretHash string) (*entity.APIToken, error)
+
+	// ListByUserID retrieves a user's tokens, revoked and expired ones included, newest first.
+	ListByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.APIToken, error)
+
+	// CountActiveByUserID counts a user's unrevoked, unexpired tokens.
+	CountActiveByUserID(ctx context.Context, userID uuid.UUID) (int, error)
+
+	// Revoke marks a token revoked. Revoking a revoked token keeps the first revocation time.
+	Revoke(ctx context.Context, id uuid.UUID) error
+
+	// TouchLastUsed records a use of the token. Uses within a minute of the last
+	// recorded one are not written.
+	TouchLastUsed(ctx context.Context, id uuid.UUID) error
+}
+
+// TaskHeartbeatRepository records when each background task last succeeded.
+// Rows are system-wide, so callers need a superadmin context.
+type TaskHeartbeatRepository interface {
+	// Record stores a successful run of a task, replacing the previous one.
+	Record(ctx context.Context, heartbeat *entity.TaskHeartbeat) error
+
+	// List retrieves the latest heartbeat of every task that has succeeded at least once.
+	List(ctx context.Context) ([]*entity.TaskHeartbeat, error)
+}
+
+// Transactor runs repository calls in a single database transaction.
+type Transactor interface {
+	// WithinTx calls fn with a context whose repository calls share one transaction,
+	// committed if fn returns nil and rolled back otherwise. Calls made from inside
+	// another WithinTx join the outer transaction.
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
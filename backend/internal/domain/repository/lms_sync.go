@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+)
+
+// LMSConnectorRepository defines the interface for LMS connector data access.
+type LMSConnectorRepository interface {
+	// Create creates a new connector.
+	Create(ctx context.Context, connector *entity.LMSConnector) error
+
+	// GetByID retrieves a connector by its ID.
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.LMSConnector, error)
+
+	// List retrieves all connectors for the current tenant.
+	List(ctx context.Context) ([]*entity.LMSConnector, error)
+
+	// Update updates a connector.
+	Update(ctx context.Context, connector *entity.LMSConnector) error
+
+	// Delete deletes a connector. Folder settings and deliveries that use it are removed with it.
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// FolderSyncSettingRepository defines the interface for folder sync setting data access.
+type FolderSyncSettingRepository interface {
+	// Upsert sets the connector a folder syncs to.
+	Upsert(ctx context.Context, setting *entity.FolderSyncSetting) error
+
+	// GetByFolderID retrieves a folder's sync setting, or nil if the folder doesn't sync.
+	GetByFolderID(ctx context.Context, folderID uuid.UUID) (*entity.FolderSyncSetting, error)
+
+	// Delete stops a folder from syncing.
+	Delete(ctx context.Context, folderID uuid.UUID) error
+}
+
+// CourseSyncDeliveryRepository defines the interface for course sync delivery data access.
+type CourseSyncDeliveryRepository interface {
+	// Create creates a new delivery.
+	Create(ctx context.Context, delivery *entity.CourseSyncDelivery) error
+
+	// ClaimNextDue atomically claims the next course sync whose attempt is due, across all
+	// tenants, marking it delivering and counting the attempt. Deliveries left delivering
+	// by a crashed worker are reclaimed. Returns nil if none are due.
+	ClaimNextDue(ctx context.Context) (*entity.CourseSyncDelivery, error)
+
+	// Update updates a delivery.
+	Update(ctx context.Context, delivery *entity.CourseSyncDelivery) error
+
+	// GetByID retrieves a delivery by its ID. Returns nil if not found.
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.CourseSyncDelivery, error)
+
+	// ListByCourseID retrieves a course's deliveries, newest first.
+	ListByCourseID(ctx context.Context, courseID uuid.UUID) ([]*entity.CourseSyncDelivery, error)
+}
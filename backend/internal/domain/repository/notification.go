@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/sogos/mirai-backend/internal/domain/entity"
@@ -27,6 +28,42 @@ type NotificationRepository interface {
 	// MarkAllAsRead marks all notifications as read for a user.
 	MarkAllAsRead(ctx context.Context, userID uuid.UUID) (int, error)
 
+	// MarkAsReadByFilter marks all of a user's notifications matching the filter as read.
+	MarkAsReadByFilter(ctx context.Context, tenantID, userID uuid.UUID, filter entity.NotificationReadFilter) (int, error)
+
 	// Delete deletes a notification.
 	Delete(ctx context.Context, id uuid.UUID) error
 }
+
+// EmailLogRepository defines the interface for email log data access.
+type EmailLogRepository interface {
+	// Create records an email send.
+	Create(ctx context.Context, entry *entity.EmailLogEntry) error
+
+	// List retrieves log entries, most recent first.
+	List(ctx context.Context, opts entity.EmailLogListOptions) ([]*entity.EmailLogEntry, error)
+
+	// ListRecentByRecipient retrieves the most recent entries for an address, newest first.
+	ListRecentByRecipient(ctx context.Context, recipient string, limit int) ([]*entity.EmailLogEntry, error)
+}
+
+// WeeklySummaryRepository defines the interface for weekly summary email data access.
+type WeeklySummaryRepository interface {
+	// ListEnabledTenantIDs returns every tenant that has the weekly summary turned on.
+	ListEnabledTenantIDs(ctx context.Context) ([]uuid.UUID, error)
+
+	// GetFigures aggregates the tenant's activity between weekStart and weekEnd, and its
+	// currently open items, in one query. Monthly token usage is left to the caller.
+	GetFigures(ctx context.Context, tenantID uuid.UUID, weekStart, weekEnd time.Time) (*entity.WeeklySummary, error)
+
+	// ClaimSend records that the summary for weekStart is being sent. Returns false if it
+	// was already claimed, so each week is sent at most once.
+	ClaimSend(ctx context.Context, tenantID uuid.UUID, weekStart time.Time) (bool, error)
+
+	// CompleteSend records how many admins the summary for weekStart was sent to.
+	CompleteSend(ctx context.Context, tenantID uuid.UUID, weekStart time.Time, recipients int) error
+
+	// ReleaseSend drops a claim whose summary could not be sent to anyone, so the next
+	// run tries again.
+	ReleaseSend(ctx context.Context, tenantID uuid.UUID, weekStart time.Time) error
+}
@@ -32,6 +32,19 @@ type SMERepository interface {
 
 	// ListTeamAccess lists team access for an SME.
 	ListTeamAccess(ctx context.Context, smeID uuid.UUID) ([]*entity.SMETeamAccess, error)
+
+	// GetStats retrieves aggregate contribution stats for all SMEs in the tenant.
+	GetStats(ctx context.Context) ([]*entity.SMEStats, error)
+
+	// GetDeletionImpact counts what depends on an SME, listing up to recentLimit course titles.
+	GetDeletionImpact(ctx context.Context, smeID uuid.UUID, recentLimit int) (*entity.SMEDeletionImpact, error)
+
+	// CreateDeletionToken stores a deletion confirmation token.
+	CreateDeletionToken(ctx context.Context, token *entity.SMEDeletionToken) error
+
+	// ConsumeDeletionToken removes and returns a deletion confirmation token.
+	// Returns nil if the token does not exist.
+	ConsumeDeletionToken(ctx context.Context, token string) (*entity.SMEDeletionToken, error)
 }
 
 // SMETaskRepository defines the interface for SME task data access.
@@ -51,6 +64,9 @@ type SMETaskRepository interface {
 	// Cancel cancels a pending task.
 	Cancel(ctx context.Context, id uuid.UUID) error
 
+	// CountOpenByTeamMembers counts the open tasks assigned to a team's members, by status.
+	CountOpenByTeamMembers(ctx context.Context, teamID uuid.UUID) ([]entity.SMETaskStatusCount, error)
+
 	// Delete permanently deletes a task.
 	Delete(ctx context.Context, id uuid.UUID) error
 }
@@ -63,8 +79,11 @@ type SMESubmissionRepository interface {
 	// GetByID retrieves a submission by its ID.
 	GetByID(ctx context.Context, id uuid.UUID) (*entity.SMETaskSubmission, error)
 
-	// ListByTaskID retrieves all submissions for a task.
-	ListByTaskID(ctx context.Context, taskID uuid.UUID) ([]*entity.SMETaskSubmission, error)
+	// List retrieves a page of a task's submissions, newest first.
+	List(ctx context.Context, opts entity.SMESubmissionListOptions) ([]*entity.SMETaskSubmission, error)
+
+	// GetSummary aggregates a task's submissions by status.
+	GetSummary(ctx context.Context, taskID uuid.UUID) (*entity.SMESubmissionSummary, error)
 
 	// Update updates a submission (e.g., after processing).
 	Update(ctx context.Context, submission *entity.SMETaskSubmission) error
@@ -81,15 +100,39 @@ type SMEKnowledgeRepository interface {
 	// ListBySMEID retrieves all chunks for an SME.
 	ListBySMEID(ctx context.Context, smeID uuid.UUID) ([]*entity.SMEKnowledgeChunk, error)
 
+	// ListBySMEIDAndTopic retrieves an SME's chunks filed under a topic.
+	ListBySMEIDAndTopic(ctx context.Context, smeID uuid.UUID, topic string) ([]*entity.SMEKnowledgeChunk, error)
+
+	// ListTopics returns an SME's distinct topics with chunk counts, largest first.
+	ListTopics(ctx context.Context, smeID uuid.UUID) ([]*entity.SMEKnowledgeTopic, error)
+
+	// ListKeywords returns the distinct topics and keywords of an SME's chunks that are
+	// usable in generation.
+	ListKeywords(ctx context.Context, smeID uuid.UUID) ([]string, error)
+
+	// ListSources returns the SME, submission and task behind each of the given chunks.
+	// Chunks that no longer exist are left out.
+	ListSources(ctx context.Context, chunkIDs []uuid.UUID) ([]*entity.SMEKnowledgeSource, error)
+
+	// RenameTopic moves up to limit of an SME's chunks from one topic to another,
+	// returning how many were moved.
+	RenameTopic(ctx context.Context, smeID uuid.UUID, from, to string, limit int) (int, error)
+
 	// Search searches knowledge across SMEs.
 	Search(ctx context.Context, smeIDs []uuid.UUID, query string, limit int) ([]*entity.SMEKnowledgeChunk, error)
 
 	// Update updates a knowledge chunk.
 	Update(ctx context.Context, chunk *entity.SMEKnowledgeChunk) error
 
+	// SetInjectionRelease records whether a flagged chunk has been released for generation.
+	SetInjectionRelease(ctx context.Context, chunk *entity.SMEKnowledgeChunk) error
+
 	// Delete deletes a knowledge chunk by ID.
 	Delete(ctx context.Context, id uuid.UUID) error
 
 	// DeleteBySMEID deletes all chunks for an SME.
 	DeleteBySMEID(ctx context.Context, smeID uuid.UUID) error
+
+	// DeleteBySubmissionID deletes all knowledge chunks created from a submission.
+	DeleteBySubmissionID(ctx context.Context, submissionID uuid.UUID) error
 }
@@ -2,7 +2,9 @@ package service
 
 import (
 	"context"
+	"errors"
 	"net/http"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/sogos/mirai-backend/internal/domain/entity"
@@ -21,9 +23,28 @@ type IdentityProvider interface {
 	// GetIdentity retrieves an identity by its ID.
 	GetIdentity(ctx context.Context, identityID string) (*Identity, error)
 
+	// GetIdentitiesByIDs retrieves several identities in one call. Identities that don't
+	// exist are left out.
+	GetIdentitiesByIDs(ctx context.Context, identityIDs []string) ([]*Identity, error)
+
+	// ListIdentities returns one page of identities using Kratos keyset pagination.
+	// Pass an empty pageToken for the first page; NextPageToken is empty on the last page.
+	ListIdentities(ctx context.Context, pageSize int, pageToken string) (*IdentityPage, error)
+
+	// DeleteIdentity permanently deletes an identity. Deleting a missing identity is not an error.
+	DeleteIdentity(ctx context.Context, identityID string) error
+
+	// SetIdentityActive enables or disables sign-in for an identity. Disabling also
+	// revokes the identity's existing sessions.
+	SetIdentityActive(ctx context.Context, identityID string, active bool) error
+
 	// CheckEmailExists checks if an email is already registered.
 	CheckEmailExists(ctx context.Context, email string) (bool, error)
 
+	// GetIdentityByEmail retrieves the identity registered with an email.
+	// Returns (nil, nil) if there is none.
+	GetIdentityByEmail(ctx context.Context, email string) (*Identity, error)
+
 	// PerformLogin performs a self-service login and returns a session token.
 	// This uses the Kratos API flow (not browser flow) to get a session token.
 	PerformLogin(ctx context.Context, email, password string) (*SessionToken, error)
@@ -58,6 +79,14 @@ type Identity struct {
 	Email     string
 	FirstName string
 	LastName  string
+	Locale    string // Optional "locale" trait, e.g. "de" or "fr-FR"
+	CreatedAt time.Time
+}
+
+// IdentityPage is a single page of identities returned by ListIdentities.
+type IdentityPage struct {
+	Identities    []*Identity
+	NextPageToken string
 }
 
 // Session represents a Kratos session.
@@ -229,22 +258,32 @@ type EmailProvider interface {
 	// SendCourseComplete sends a notification when full course generation is complete.
 	SendCourseComplete(ctx context.Context, req SendCourseCompleteRequest) error
 
+	// SendExportReady sends a signed download link for a finished export.
+	SendExportReady(ctx context.Context, req SendExportReadyRequest) error
+
+	// SendWeeklySummary sends a tenant admin the summary of the organization's past week.
+	SendWeeklySummary(ctx context.Context, req SendWeeklySummaryRequest) error
+
 	// SendAlert sends an administrative alert email (e.g., for orphaned payments).
 	SendAlert(ctx context.Context, req SendAlertRequest) error
 }
 
 // SendInvitationRequest contains data for sending an invitation email.
+// Locale on each Send*Request selects the template language and date format;
+// unsupported or empty locales fall back to English.
 type SendInvitationRequest struct {
 	To          string
+	Locale      valueobject.Locale
 	InviterName string
 	CompanyName string
 	InviteURL   string
-	ExpiresAt   string
+	ExpiresAt   time.Time
 }
 
 // SendWelcomeRequest contains data for sending a welcome email.
 type SendWelcomeRequest struct {
 	To          string
+	Locale      valueobject.Locale
 	FirstName   string
 	CompanyName string
 	LoginURL    string
@@ -252,18 +291,23 @@ type SendWelcomeRequest struct {
 
 // SendTaskAssignmentRequest contains data for task assignment email.
 type SendTaskAssignmentRequest struct {
-	To           string
-	AssigneeName string
-	AssignerName string
-	TaskTitle    string
-	SMEName      string
-	TaskURL      string
-	DueDate      string
+	To             string
+	Locale         valueobject.Locale
+	AssigneeName   string
+	AssignerName   string
+	TaskID         uuid.UUID // Identifies the task's calendar entry across updates
+	TaskTitle      string
+	SMEName        string
+	TaskURL        string
+	DueDate        *time.Time // When set, a calendar invite for the due date is attached
+	DueDateChanged bool       // The task was already assigned and only its due date changed
+	Questions      []string   // Interview questions to prepare for; empty for upload tasks
 }
 
 // SendIngestionCompleteRequest contains data for ingestion complete email.
 type SendIngestionCompleteRequest struct {
 	To        string
+	Locale    valueobject.Locale
 	UserName  string
 	SMEName   string
 	TaskTitle string
@@ -273,6 +317,7 @@ type SendIngestionCompleteRequest struct {
 // SendIngestionFailedRequest contains data for ingestion failed email.
 type SendIngestionFailedRequest struct {
 	To           string
+	Locale       valueobject.Locale
 	UserName     string
 	SMEName      string
 	TaskTitle    string
@@ -283,6 +328,7 @@ type SendIngestionFailedRequest struct {
 // SendGenerationCompleteRequest contains data for generation complete email.
 type SendGenerationCompleteRequest struct {
 	To          string
+	Locale      valueobject.Locale
 	UserName    string
 	CourseTitle string
 	ContentType string // "outline" or "lesson"
@@ -292,6 +338,7 @@ type SendGenerationCompleteRequest struct {
 // SendGenerationFailedRequest contains data for generation failed email.
 type SendGenerationFailedRequest struct {
 	To           string
+	Locale       valueobject.Locale
 	UserName     string
 	CourseTitle  string
 	ContentType  string // "outline" or "lesson"
@@ -302,6 +349,7 @@ type SendGenerationFailedRequest struct {
 // SendOutlineReadyRequest contains data for outline ready notification email.
 type SendOutlineReadyRequest struct {
 	To           string
+	Locale       valueobject.Locale
 	UserName     string
 	CourseTitle  string
 	SectionCount int
@@ -312,6 +360,7 @@ type SendOutlineReadyRequest struct {
 // SendCourseCompleteRequest contains data for full course completion email with summary.
 type SendCourseCompleteRequest struct {
 	To                   string
+	Locale               valueobject.Locale
 	UserName             string
 	CourseTitle          string
 	SectionCount         int
@@ -320,6 +369,41 @@ type SendCourseCompleteRequest struct {
 	CourseURL            string
 }
 
+// SendExportReadyRequest contains data for the export ready email. DownloadURL works until
+// ExpiresAt without signing in; AppURL opens the course in Mirai.
+type SendExportReadyRequest struct {
+	To           string
+	Locale       valueobject.Locale
+	UserName     string
+	CourseTitle  string
+	Format       string // e.g. "Markdown (ZIP)"
+	FileSize     string // e.g. "2.4 MB"
+	LessonCount  int
+	SkippedCount int
+	DownloadURL  string
+	ExpiresAt    time.Time
+	AppURL       string
+}
+
+// SendWeeklySummaryRequest contains data for the weekly admin summary email.
+// WeekEnd is the last day of the week; MonthlyTokenLimit is nil when the organization
+// has no budget.
+type SendWeeklySummaryRequest struct {
+	To                 string
+	Locale             valueobject.Locale
+	UserName           string
+	WeekStart          time.Time
+	WeekEnd            time.Time
+	CoursesGenerated   int
+	TokensUsed         int64
+	TokensThisMonth    int64
+	MonthlyTokenLimit  *int64
+	FailedJobs         int
+	OverdueSMETasks    int
+	PendingInvitations int
+	DashboardURL       string
+}
+
 // SendAlertRequest contains data for administrative alert emails.
 type SendAlertRequest struct {
 	Subject string
@@ -340,17 +424,81 @@ type AIProvider interface {
 	// ProcessSMEContent processes and distills knowledge from SME submission.
 	ProcessSMEContent(ctx context.Context, req ProcessSMEContentRequest) (*ProcessSMEContentResult, error)
 
+	// GenerateSuggestions makes a single short call returning a few options, such as course
+	// titles. It is meant for synchronous requests, unlike the multi-call outline generation.
+	GenerateSuggestions(ctx context.Context, req GenerateSuggestionsRequest) (*GenerateSuggestionsResult, error)
+
+	// ClusterTopics groups near-duplicate knowledge topic labels under a shared label.
+	ClusterTopics(ctx context.Context, req ClusterTopicsRequest) (*ClusterTopicsResult, error)
+
+	// GenerateTargetAudience drafts a learner persona from a job description in one call.
+	GenerateTargetAudience(ctx context.Context, req GenerateTargetAudienceRequest) (*GenerateTargetAudienceResult, error)
+
+	// GenerateInterviewQuestions writes the questions for an SME interview about a course goal in one call.
+	GenerateInterviewQuestions(ctx context.Context, req GenerateInterviewQuestionsRequest) (*GenerateInterviewQuestionsResult, error)
+
+	// GenerateImage renders a picture for an image component from its description in one call.
+	GenerateImage(ctx context.Context, req GenerateImageRequest) (*GenerateImageResult, error)
+
+	// GenerateAltText writes alternative text for an image component in one call, from the
+	// picture when there is one and otherwise from its description.
+	GenerateAltText(ctx context.Context, req GenerateAltTextRequest) (*GenerateAltTextResult, error)
+
+	// TranslateTexts translates a batch of strings in one call, keeping their order.
+	TranslateTexts(ctx context.Context, req TranslateTextsRequest) (*TranslateTextsResult, error)
+
+	// RestructureOutlineLessons splits long outline lessons in two and merges short adjacent
+	// ones in one call, so lesson durations even out.
+	RestructureOutlineLessons(ctx context.Context, req RestructureOutlineLessonsRequest) (*RestructureOutlineLessonsResult, error)
+
+	// ResizeLessonContent adds components after thin parts of a generated lesson and
+	// consolidates runs of adjacent text components in one call, so the lesson's
+	// component count fits its bounds.
+	ResizeLessonContent(ctx context.Context, req ResizeLessonContentRequest) (*ResizeLessonContentResult, error)
+
 	// TestConnection tests if the API key is valid.
 	TestConnection(ctx context.Context) error
 }
 
+// ErrInvalidAIResponse is matched by errors an AIProvider returns when the model's
+// response could not be used, even after asking it to repair the response.
+var ErrInvalidAIResponse = errors.New("AI response did not match the expected format")
+
+// PartialUsageError is returned by AIProvider methods that make several provider calls
+// and were aborted part-way through. TokensUsed counts the calls that had completed.
+type PartialUsageError struct {
+	TokensUsed int64
+	Err        error
+}
+
+func (e *PartialUsageError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *PartialUsageError) Unwrap() error {
+	return e.Err
+}
+
+// ProgressFunc receives progress from within a long provider call: the share of the
+// call done so far (0 to 1) and a short user-facing description. Providers call it
+// synchronously and may never call it if they can't measure progress.
+type ProgressFunc func(fraction float64, message string)
+
 // GenerateOutlineRequest contains inputs for outline generation.
 type GenerateOutlineRequest struct {
 	CourseTitle       string
 	DesiredOutcome    string
 	SMEKnowledge      []SMEKnowledgeInput // Knowledge from selected SMEs
-	TargetAudience    TargetAudienceInput // Target audience profile
+	CourseReferences  []CourseReferenceInput // Excerpts of reference files attached to the course
+	TargetAudiences   []TargetAudienceInput // Target audience profiles; the course serves all of them
 	AdditionalContext string
+
+	// Optional size constraints (0 means unconstrained)
+	MaxSections           int
+	MaxLessonsPerSection  int
+	TargetDurationMinutes int
+
+	OnProgress ProgressFunc // Optional
 }
 
 // SMEKnowledgeInput represents knowledge from an SME.
@@ -362,8 +510,16 @@ type SMEKnowledgeInput struct {
 	Keywords   []string // Combined keywords
 }
 
+// CourseReferenceInput is a reference file attached to the course, such as a style
+// guide or product spec, with the excerpts chosen for one prompt.
+type CourseReferenceInput struct {
+	FileName string
+	Excerpts []string
+}
+
 // TargetAudienceInput represents the target audience profile.
 type TargetAudienceInput struct {
+	Name              string
 	Role              string
 	ExperienceLevel   string
 	LearningGoals     []string
@@ -376,8 +532,9 @@ type TargetAudienceInput struct {
 
 // GenerateOutlineResult contains the generated outline.
 type GenerateOutlineResult struct {
-	Sections    []OutlineSectionResult
-	TokensUsed  int64
+	Sections       []OutlineSectionResult
+	TokensUsed     int64
+	RepairAttempts int // Responses re-requested because they failed validation
 }
 
 // OutlineSectionResult represents a generated section.
@@ -397,6 +554,7 @@ type OutlineLessonResult struct {
 	LearningObjectives       []string
 	IsLastInSection          bool
 	IsLastInCourse           bool
+	TargetAudiences          []string // Audience names the lesson is specific to; empty means all
 }
 
 // GenerateLessonRequest contains inputs for lesson content generation.
@@ -407,18 +565,32 @@ type GenerateLessonRequest struct {
 	LessonDescription  string
 	LearningObjectives []string
 	SMEKnowledge       []SMEKnowledgeInput
-	TargetAudience     TargetAudienceInput
+	CourseReferences   []CourseReferenceInput // Not indexed for citations
+	TargetAudiences    []TargetAudienceInput
+	LessonAudiences    []string // Audience names this lesson is specific to; empty means all
 	PreviousLessonTitle string // For continuity
 	NextLessonTitle    string  // For segue
 	IsLastInSection    bool
 	IsLastInCourse     bool
+
+	// Component mix
+	IncludeQuiz              bool // Whether this lesson gets a knowledge check
+	IncludeImages            bool
+	IncludeReflectionPrompts bool
+
+	// Target range for the number of components; zero is unbounded
+	MinComponents int
+	MaxComponents int
+
+	OnProgress ProgressFunc // Optional
 }
 
 // GenerateLessonResult contains the generated lesson content.
 type GenerateLessonResult struct {
-	Components []LessonComponentResult
-	SegueText  string // Transition to next lesson
-	TokensUsed int64
+	Components     []LessonComponentResult
+	SegueText      string // Transition to next lesson
+	TokensUsed     int64
+	RepairAttempts int // Responses re-requested because they failed validation
 }
 
 // LessonComponentResult represents a generated component.
@@ -426,6 +598,10 @@ type LessonComponentResult struct {
 	Type        string // text, heading, image, quiz
 	Order       int
 	ContentJSON string // JSON-encoded content based on type
+
+	// SourceChunkIndices cites the SME knowledge chunks that informed the component, as
+	// positions in the request's SMEKnowledge chunks taken in order across all SMEs.
+	SourceChunkIndices []int
 }
 
 // RegenerateComponentRequest contains inputs for component regeneration.
@@ -441,20 +617,23 @@ type RegenerateComponentRequest struct {
 type RegenerateComponentResult struct {
 	ContentJSON string
 	TokensUsed  int64
+	CacheHit    bool // Served from the prompt cache; TokensUsed is zero
 }
 
 // ProcessSMEContentRequest contains inputs for SME content processing.
 type ProcessSMEContentRequest struct {
-	SMEName       string
-	SMEDomain     string
-	ExtractedText string // Raw text from uploaded document
+	SMEName         string
+	SMEDomain       string
+	ExtractedText   string // Raw text from uploaded document
+	MaxSummaryChars int    // Upper bound on the summary's length; 0 leaves it to the provider
 }
 
 // ProcessSMEContentResult contains the processed SME knowledge.
 type ProcessSMEContentResult struct {
-	Summary    string
-	Chunks     []SMEChunkResult
-	TokensUsed int64
+	Summary        string
+	Chunks         []SMEChunkResult
+	TokensUsed     int64
+	RepairAttempts int // Responses re-requested because they failed validation
 }
 
 // SMEChunkResult represents a distilled knowledge chunk.
@@ -465,6 +644,178 @@ type SMEChunkResult struct {
 	RelevanceScore float32
 }
 
+// SuggestionKind names what GenerateSuggestions suggests.
+type SuggestionKind string
+
+const (
+	SuggestionKindCourseTitle SuggestionKind = "course_title"
+)
+
+// GenerateSuggestionsRequest contains inputs for generating suggestions.
+type GenerateSuggestionsRequest struct {
+	Kind            SuggestionKind
+	Count           int
+	DesiredOutcome  string
+	SMEKnowledge    []SMEKnowledgeInput // Summaries are enough; chunks may be omitted
+	TargetAudiences []TargetAudienceInput
+}
+
+// GenerateSuggestionsResult contains the generated suggestions.
+type GenerateSuggestionsResult struct {
+	Suggestions []Suggestion
+	TokensUsed  int64
+}
+
+// Suggestion is one suggested option with a short rationale.
+type Suggestion struct {
+	Text      string
+	Rationale string
+}
+
+// ClusterTopicsRequest contains an SME's knowledge topics to consolidate.
+type ClusterTopicsRequest struct {
+	SMEName     string
+	SMEDomain   string
+	Topics      []string
+	MaxClusters int // Target number of distinct topics after merging
+}
+
+// ClusterTopicsResult contains the consolidated topics.
+type ClusterTopicsResult struct {
+	Clusters   []TopicCluster
+	TokensUsed int64
+}
+
+// TopicCluster is a shared label and the original topics it replaces.
+type TopicCluster struct {
+	Label  string
+	Topics []string
+}
+
+// GenerateTargetAudienceRequest contains the description a persona is drafted from.
+type GenerateTargetAudienceRequest struct {
+	JobDescription string // A full job description or just a role title
+	MaxListItems   int    // Most entries to return for each list field
+}
+
+// GenerateTargetAudienceResult contains the drafted persona. ExperienceLevel is one of
+// the valueobject.ExperienceLevel values when the provider follows the schema.
+type GenerateTargetAudienceResult struct {
+	Persona    TargetAudienceInput
+	TokensUsed int64
+}
+
+// GenerateInterviewQuestionsRequest describes the SME and the course goal an interview is for.
+type GenerateInterviewQuestionsRequest struct {
+	SMEName        string
+	SMEDomain      string
+	SMEDescription string
+	DesiredOutcome string   // What learners of the course should achieve
+	KnownTopics    []string // Topics the SME's knowledge already covers, to ask about gaps instead
+	MinQuestions   int
+	MaxQuestions   int
+}
+
+// GenerateInterviewQuestionsResult contains the interview questions in the order to ask them.
+type GenerateInterviewQuestionsResult struct {
+	Questions  []string
+	TokensUsed int64
+}
+
+// GenerateImageRequest describes the picture for an image component.
+type GenerateImageRequest struct {
+	Description string // What the image should show
+	AltText     string
+	AspectRatio string // Such as "16:9"; empty uses the provider's default
+}
+
+// GenerateImageResult contains the encoded image. Image generation is billed per
+// image rather than per token.
+type GenerateImageResult struct {
+	Data     []byte
+	MIMEType string
+}
+
+// GenerateAltTextRequest describes the image to write alternative text for.
+type GenerateAltTextRequest struct {
+	Description   string // What the image shows, as planned when the lesson was written
+	Caption       string
+	LessonTitle   string // For context; alt text shouldn't repeat it
+	Image         []byte // The stored picture, if any
+	ImageMIMEType string
+	MaxLength     int // In characters
+}
+
+// GenerateAltTextResult contains the alternative text.
+type GenerateAltTextResult struct {
+	AltText    string
+	TokensUsed int64
+}
+
+// TranslateTextsRequest contains the strings to translate.
+type TranslateTextsRequest struct {
+	TargetLanguage string   // BCP 47 language tag, such as "es" or "pt-BR"
+	Context        string   // What the texts belong to, such as the course title, for consistent terminology
+	Texts          []string // May contain HTML; markup is kept as is
+}
+
+// TranslateTextsResult contains the translations in the same order as the request.
+// Providers return an error rather than a result of a different length.
+type TranslateTextsResult struct {
+	Texts      []string
+	TokensUsed int64
+}
+
+// OutlineLessonDraft is an outline lesson going into or coming out of a restructuring.
+type OutlineLessonDraft struct {
+	Title              string
+	Description        string
+	DurationMinutes    int
+	LearningObjectives []string
+}
+
+// RestructureOutlineLessonsRequest lists the lessons to split and the adjacent pairs to merge.
+type RestructureOutlineLessonsRequest struct {
+	CourseTitle string
+	Splits      []OutlineLessonDraft    // Each is split into two lessons
+	Merges      [][2]OutlineLessonDraft // Each pair, in course order, becomes one lesson
+}
+
+// RestructureOutlineLessonsResult contains one pair per requested split and one lesson per
+// requested merge, in request order. Providers return an error rather than a result of a
+// different length.
+type RestructureOutlineLessonsResult struct {
+	Splits     [][2]OutlineLessonDraft
+	Merges     []OutlineLessonDraft
+	TokensUsed int64
+}
+
+// ResizeLessonContentRequest lists the parts of a generated lesson to expand and the runs
+// of adjacent text components to consolidate. Indices are positions in Components.
+type ResizeLessonContentRequest struct {
+	LessonTitle        string
+	LearningObjectives []string
+	Components         []LessonComponentResult // The lesson as generated
+	Expansions         []LessonExpansion
+	Consolidations     [][]int // Each run of adjacent text components becomes one text component
+}
+
+// LessonExpansion asks for new components that go deeper into a thin part of a lesson.
+type LessonExpansion struct {
+	After int // Index of the thin component; the new components follow it
+	Count int // How many components to add
+}
+
+// ResizeLessonContentResult contains the new components for each requested expansion and
+// one text component per requested consolidation, in request order. Providers return an
+// error rather than a result of a different length.
+type ResizeLessonContentResult struct {
+	Expansions     [][]LessonComponentResult
+	Consolidations []LessonComponentResult
+	TokensUsed     int64
+	RepairAttempts int
+}
+
 // ContentEnhancer abstracts AI content enhancement operations.
 type ContentEnhancer interface {
 	// SummarizeContent creates a concise summary of the provided content.
@@ -473,3 +824,16 @@ type ContentEnhancer interface {
 	// ImproveContent improves content by cleaning up, clarifying, and structuring.
 	ImproveContent(ctx context.Context, content string) (string, error)
 }
+
+// PromptInjectionDetector screens SME content for text that tries to instruct the model
+// instead of informing it, before that content is used in generation prompts.
+type PromptInjectionDetector interface {
+	// Detect reports whether content looks like a prompt injection attempt.
+	Detect(content string) PromptInjectionVerdict
+}
+
+// PromptInjectionVerdict is the outcome of screening one piece of content.
+type PromptInjectionVerdict struct {
+	Flagged bool
+	Reason  string // Why the content was flagged, for reviewers
+}
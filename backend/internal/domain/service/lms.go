@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+// Webhook event types sent to webhook connectors.
+const (
+	// LMSEventCoursePublished announces a published course and links to its export.
+	LMSEventCoursePublished = "course.published"
+	// LMSEventPing carries no course; it only checks the receiver answers and verifies signatures.
+	LMSEventPing = "ping"
+)
+
+// LMSPusher delivers course artifacts to external LMS endpoints.
+type LMSPusher interface {
+	// Push sends one delivery. A non-nil error with a result means the LMS answered
+	// with a status other than success; without a result it was never reached.
+	Push(ctx context.Context, req LMSPushRequest) (*LMSPushResult, error)
+}
+
+// LMSPushRequest describes one delivery of a course to an LMS connector.
+type LMSPushRequest struct {
+	Type        valueobject.LMSConnectorType
+	EndpointURL string
+	Credential  string // Webhook signing secret or bearer token; empty when none is set
+	Event       string // Webhook event type; empty means LMSEventCoursePublished
+	Kind        valueobject.CourseSyncDeliveryKind
+	DeliveryID  uuid.UUID // For replays, the ID of the delivery being re-sent
+	CourseID    uuid.UUID
+	CourseTitle string
+	FileName    string
+	DownloadURL string    // Set for webhook connectors
+	Artifact    io.Reader // Set for HTTP push connectors
+}
+
+// LMSPushResult is what the LMS answered.
+type LMSPushResult struct {
+	StatusCode int
+	RemoteID   string
+	Latency    time.Duration // From sending the request to reading the response
+	Body       string        // Start of the response body
+}
+
+// Retryable reports whether the LMS answered with a status that may succeed if tried again later.
+func (r *LMSPushResult) Retryable() bool {
+	return r.StatusCode == 429 || r.StatusCode >= 500
+}
@@ -38,6 +38,14 @@ const (
 	GenerationJobTypeLessonContent  GenerationJobType = "lesson_content"
 	GenerationJobTypeComponentRegen GenerationJobType = "component_regen"
 	GenerationJobTypeFullCourse     GenerationJobType = "full_course"
+	GenerationJobTypeLessonsExport  GenerationJobType = "lessons_export"
+
+	GenerationJobTypeSMEKnowledgeExport GenerationJobType = "sme_knowledge_export"
+	GenerationJobTypeSMEKnowledgeImport GenerationJobType = "sme_knowledge_import"
+
+	GenerationJobTypeStorageAudit GenerationJobType = "storage_audit"
+
+	GenerationJobTypeCourseTranslation GenerationJobType = "course_translation"
 )
 
 func (t GenerationJobType) String() string {
@@ -48,7 +56,9 @@ func (t GenerationJobType) IsValid() bool {
 	switch t {
 	case GenerationJobTypeSMEIngestion, GenerationJobTypeCourseOutline,
 		GenerationJobTypeLessonContent, GenerationJobTypeComponentRegen,
-		GenerationJobTypeFullCourse:
+		GenerationJobTypeFullCourse, GenerationJobTypeLessonsExport,
+		GenerationJobTypeSMEKnowledgeExport, GenerationJobTypeSMEKnowledgeImport,
+		GenerationJobTypeStorageAudit, GenerationJobTypeCourseTranslation:
 		return true
 	}
 	return false
@@ -86,6 +96,11 @@ func (s GenerationJobStatus) IsValid() bool {
 	return false
 }
 
+// IsTerminal returns true if the job has ended and will not run again on its own.
+func (s GenerationJobStatus) IsTerminal() bool {
+	return s == GenerationJobStatusCompleted || s == GenerationJobStatusFailed || s == GenerationJobStatusCancelled
+}
+
 func ParseGenerationJobStatus(str string) (GenerationJobStatus, error) {
 	s := GenerationJobStatus(str)
 	if !s.IsValid() {
@@ -126,7 +141,7 @@ func ParseOutlineApprovalStatus(str string) (OutlineApprovalStatus, error) {
 }
 
 // LessonComponentType represents content block types for lessons.
-// MVP: Text, Heading, Image, Quiz.
+// MVP: Text, Heading, Image, Quiz, Video.
 type LessonComponentType string
 
 const (
@@ -134,6 +149,7 @@ const (
 	LessonComponentTypeHeading LessonComponentType = "heading"
 	LessonComponentTypeImage   LessonComponentType = "image"
 	LessonComponentTypeQuiz    LessonComponentType = "quiz"
+	LessonComponentTypeVideo   LessonComponentType = "video"
 )
 
 func (t LessonComponentType) String() string {
@@ -143,7 +159,7 @@ func (t LessonComponentType) String() string {
 func (t LessonComponentType) IsValid() bool {
 	switch t {
 	case LessonComponentTypeText, LessonComponentTypeHeading,
-		LessonComponentTypeImage, LessonComponentTypeQuiz:
+		LessonComponentTypeImage, LessonComponentTypeQuiz, LessonComponentTypeVideo:
 		return true
 	}
 	return false
@@ -157,6 +173,64 @@ func ParseLessonComponentType(str string) (LessonComponentType, error) {
 	return t, nil
 }
 
+// QuizFrequency controls which lessons get a knowledge check quiz.
+type QuizFrequency string
+
+const (
+	QuizFrequencyEveryLesson  QuizFrequency = "every_lesson"
+	QuizFrequencyEndOfSection QuizFrequency = "end_of_section"
+	QuizFrequencyEndOfCourse  QuizFrequency = "end_of_course"
+)
+
+func (f QuizFrequency) String() string {
+	return string(f)
+}
+
+func (f QuizFrequency) IsValid() bool {
+	switch f {
+	case QuizFrequencyEveryLesson, QuizFrequencyEndOfSection, QuizFrequencyEndOfCourse:
+		return true
+	}
+	return false
+}
+
+func ParseQuizFrequency(str string) (QuizFrequency, error) {
+	f := QuizFrequency(str)
+	if !f.IsValid() {
+		return "", fmt.Errorf("invalid quiz frequency: %s", str)
+	}
+	return f, nil
+}
+
+// QuizQuestionType is how a quiz question is answered.
+type QuizQuestionType string
+
+const (
+	QuizQuestionTypeSingle    QuizQuestionType = "single"     // Exactly one correct option
+	QuizQuestionTypeMulti     QuizQuestionType = "multi"      // One or more correct options, all must be chosen
+	QuizQuestionTypeTrueFalse QuizQuestionType = "true_false" // Two options, one correct
+)
+
+func (t QuizQuestionType) String() string {
+	return string(t)
+}
+
+func (t QuizQuestionType) IsValid() bool {
+	switch t {
+	case QuizQuestionTypeSingle, QuizQuestionTypeMulti, QuizQuestionTypeTrueFalse:
+		return true
+	}
+	return false
+}
+
+func ParseQuizQuestionType(str string) (QuizQuestionType, error) {
+	t := QuizQuestionType(str)
+	if !t.IsValid() {
+		return "", fmt.Errorf("invalid quiz question type: %s", str)
+	}
+	return t, nil
+}
+
 // HeadingLevel for heading components.
 type HeadingLevel string
 
@@ -186,3 +260,129 @@ func ParseHeadingLevel(str string) (HeadingLevel, error) {
 	}
 	return l, nil
 }
+
+// OutlineExportFormat is the file format a course outline can be exported to.
+type OutlineExportFormat string
+
+const (
+	OutlineExportFormatCSV  OutlineExportFormat = "csv"
+	OutlineExportFormatDOCX OutlineExportFormat = "docx"
+)
+
+func (f OutlineExportFormat) String() string {
+	return string(f)
+}
+
+func (f OutlineExportFormat) IsValid() bool {
+	switch f {
+	case OutlineExportFormatCSV, OutlineExportFormatDOCX:
+		return true
+	}
+	return false
+}
+
+// ContentType returns the MIME type of files in this format.
+func (f OutlineExportFormat) ContentType() string {
+	if f == OutlineExportFormatDOCX {
+		return "application/vnd.openxmlformats-officedocument.wordprocessingml.document"
+	}
+	return "text/csv"
+}
+
+func ParseOutlineExportFormat(str string) (OutlineExportFormat, error) {
+	f := OutlineExportFormat(str)
+	if !f.IsValid() {
+		return "", fmt.Errorf("invalid outline export format: %s", str)
+	}
+	return f, nil
+}
+
+// JobAnomalyType identifies an inconsistency between generation jobs and course content.
+type JobAnomalyType string
+
+const (
+	// JobAnomalyParentNotFinalized is a full_course parent left open after all its children ended.
+	JobAnomalyParentNotFinalized JobAnomalyType = "parent_not_finalized"
+	// JobAnomalyParentMissingChildren is a full_course parent still open with no child jobs,
+	// or with fewer than it queued.
+	JobAnomalyParentMissingChildren JobAnomalyType = "parent_missing_children"
+	// JobAnomalyCompletedWithoutLessons is a completed full_course parent whose course has no generated lessons.
+	JobAnomalyCompletedWithoutLessons JobAnomalyType = "completed_without_lessons"
+)
+
+func (t JobAnomalyType) String() string {
+	return string(t)
+}
+
+func (t JobAnomalyType) IsValid() bool {
+	switch t {
+	case JobAnomalyParentNotFinalized, JobAnomalyParentMissingChildren, JobAnomalyCompletedWithoutLessons:
+		return true
+	}
+	return false
+}
+
+func ParseJobAnomalyType(str string) (JobAnomalyType, error) {
+	t := JobAnomalyType(str)
+	if !t.IsValid() {
+		return "", fmt.Errorf("invalid job anomaly type: %s", str)
+	}
+	return t, nil
+}
+
+// JobFailureReason classifies why a generation job failed, so clients can suggest a fix.
+type JobFailureReason string
+
+const (
+	// JobFailureProviderAuth means the AI provider rejected or is missing the tenant's API key.
+	JobFailureProviderAuth JobFailureReason = "provider_auth"
+	// JobFailureProviderRateLimit means the AI provider kept throttling requests or the key ran out of quota.
+	JobFailureProviderRateLimit JobFailureReason = "provider_rate_limit"
+	// JobFailureProviderTimeout means the AI provider did not answer in time.
+	JobFailureProviderTimeout JobFailureReason = "provider_timeout"
+	// JobFailureInvalidOutput means the AI response was unusable even after a repair attempt.
+	JobFailureInvalidOutput JobFailureReason = "invalid_output"
+	// JobFailureMissingKnowledge means there was no SME knowledge to generate from.
+	JobFailureMissingKnowledge JobFailureReason = "missing_knowledge"
+	// JobFailureBudgetExceeded means the tenant has used up its monthly token limit.
+	JobFailureBudgetExceeded JobFailureReason = "budget_exceeded"
+	// JobFailureInternal covers every other failure.
+	JobFailureInternal JobFailureReason = "internal"
+)
+
+func (r JobFailureReason) String() string {
+	return string(r)
+}
+
+func (r JobFailureReason) IsValid() bool {
+	switch r {
+	case JobFailureProviderAuth, JobFailureProviderRateLimit, JobFailureProviderTimeout,
+		JobFailureInvalidOutput, JobFailureMissingKnowledge, JobFailureBudgetExceeded, JobFailureInternal:
+		return true
+	}
+	return false
+}
+
+func ParseJobFailureReason(str string) (JobFailureReason, error) {
+	r := JobFailureReason(str)
+	if !r.IsValid() {
+		return "", fmt.Errorf("invalid job failure reason: %s", str)
+	}
+	return r, nil
+}
+
+// AccessibilityIssueType identifies an accessibility problem in a lesson component.
+type AccessibilityIssueType string
+
+const (
+	// AccessibilityIssueMissingAltText is an image component without alternative text.
+	AccessibilityIssueMissingAltText AccessibilityIssueType = "missing_alt_text"
+	// AccessibilityIssueHeadingLevelJump is a heading more than one level below the heading before it.
+	AccessibilityIssueHeadingLevelJump AccessibilityIssueType = "heading_level_jump"
+	// AccessibilityIssueQuizWithoutInstructions is a quiz component with no question telling learners what to answer.
+	AccessibilityIssueQuizWithoutInstructions AccessibilityIssueType = "quiz_without_instructions"
+)
+
+func (t AccessibilityIssueType) String() string {
+	return string(t)
+}
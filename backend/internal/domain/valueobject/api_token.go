@@ -0,0 +1,38 @@
+package valueobject
+
+import "fmt"
+
+// APITokenScope is a group of RPCs an API token may call.
+type APITokenScope string
+
+const (
+	// APITokenScopeCourseRead allows reading courses, folders and the library.
+	APITokenScopeCourseRead APITokenScope = "course:read"
+	// APITokenScopeCourseWrite allows creating, changing and deleting courses. It implies course:read.
+	APITokenScopeCourseWrite APITokenScope = "course:write"
+)
+
+func (s APITokenScope) String() string {
+	return string(s)
+}
+
+func (s APITokenScope) IsValid() bool {
+	switch s {
+	case APITokenScopeCourseRead, APITokenScopeCourseWrite:
+		return true
+	}
+	return false
+}
+
+// Grants returns true if a token with scope s may call RPCs that need scope required.
+func (s APITokenScope) Grants(required APITokenScope) bool {
+	return s == required || (s == APITokenScopeCourseWrite && required == APITokenScopeCourseRead)
+}
+
+func ParseAPITokenScope(str string) (APITokenScope, error) {
+	s := APITokenScope(str)
+	if !s.IsValid() {
+		return "", fmt.Errorf("invalid API token scope: %s", str)
+	}
+	return s, nil
+}
@@ -0,0 +1,47 @@
+package valueobject
+
+import "fmt"
+
+// EmailDeliveryStatus is the outcome of sending an email.
+type EmailDeliveryStatus string
+
+const (
+	// EmailDeliveryStatusAccepted means the mail server accepted the message for delivery.
+	EmailDeliveryStatusAccepted EmailDeliveryStatus = "accepted"
+	// EmailDeliveryStatusDeferred means the server refused the message temporarily (4xx).
+	EmailDeliveryStatusDeferred EmailDeliveryStatus = "deferred"
+	// EmailDeliveryStatusRejected means the server refused the message permanently (5xx).
+	EmailDeliveryStatusRejected EmailDeliveryStatus = "rejected"
+	// EmailDeliveryStatusFailed means the message never reached a server, e.g. it was unreachable.
+	EmailDeliveryStatusFailed EmailDeliveryStatus = "failed"
+	// EmailDeliveryStatusDelivered is reported later by providers that confirm delivery.
+	EmailDeliveryStatusDelivered EmailDeliveryStatus = "delivered"
+	// EmailDeliveryStatusBounced is reported later by providers when an accepted message bounces.
+	EmailDeliveryStatusBounced EmailDeliveryStatus = "bounced"
+)
+
+func (s EmailDeliveryStatus) String() string {
+	return string(s)
+}
+
+func (s EmailDeliveryStatus) IsValid() bool {
+	switch s {
+	case EmailDeliveryStatusAccepted, EmailDeliveryStatusDeferred, EmailDeliveryStatusRejected,
+		EmailDeliveryStatusFailed, EmailDeliveryStatusDelivered, EmailDeliveryStatusBounced:
+		return true
+	}
+	return false
+}
+
+// IsHardBounce returns true if the address permanently refused the message.
+func (s EmailDeliveryStatus) IsHardBounce() bool {
+	return s == EmailDeliveryStatusRejected || s == EmailDeliveryStatusBounced
+}
+
+func ParseEmailDeliveryStatus(str string) (EmailDeliveryStatus, error) {
+	s := EmailDeliveryStatus(str)
+	if !s.IsValid() {
+		return "", fmt.Errorf("invalid email delivery status: %s", str)
+	}
+	return s, nil
+}
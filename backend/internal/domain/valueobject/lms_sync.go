@@ -0,0 +1,96 @@
+package valueobject
+
+import "fmt"
+
+// LMSConnectorType is how courses are delivered to an external LMS.
+type LMSConnectorType string
+
+const (
+	// LMSConnectorTypeWebhook posts a signed JSON notification with a download link to the export.
+	LMSConnectorTypeWebhook LMSConnectorType = "webhook"
+	// LMSConnectorTypeHTTPPush uploads the export itself with a bearer token.
+	LMSConnectorTypeHTTPPush LMSConnectorType = "http_push"
+)
+
+func (t LMSConnectorType) String() string {
+	return string(t)
+}
+
+func (t LMSConnectorType) IsValid() bool {
+	switch t {
+	case LMSConnectorTypeWebhook, LMSConnectorTypeHTTPPush:
+		return true
+	}
+	return false
+}
+
+func ParseLMSConnectorType(str string) (LMSConnectorType, error) {
+	t := LMSConnectorType(str)
+	if !t.IsValid() {
+		return "", fmt.Errorf("invalid LMS connector type: %s", str)
+	}
+	return t, nil
+}
+
+// CourseSyncStatus is the state of a course delivery to an LMS connector.
+type CourseSyncStatus string
+
+const (
+	CourseSyncStatusPending    CourseSyncStatus = "pending"
+	CourseSyncStatusDelivering CourseSyncStatus = "delivering"
+	CourseSyncStatusDelivered  CourseSyncStatus = "delivered"
+	CourseSyncStatusFailed     CourseSyncStatus = "failed"
+)
+
+func (s CourseSyncStatus) String() string {
+	return string(s)
+}
+
+func (s CourseSyncStatus) IsValid() bool {
+	switch s {
+	case CourseSyncStatusPending, CourseSyncStatusDelivering,
+		CourseSyncStatusDelivered, CourseSyncStatusFailed:
+		return true
+	}
+	return false
+}
+
+func ParseCourseSyncStatus(str string) (CourseSyncStatus, error) {
+	s := CourseSyncStatus(str)
+	if !s.IsValid() {
+		return "", fmt.Errorf("invalid course sync status: %s", str)
+	}
+	return s, nil
+}
+
+// CourseSyncDeliveryKind tells normal course syncs apart from diagnostic webhook traffic.
+type CourseSyncDeliveryKind string
+
+const (
+	// CourseSyncDeliveryKindSync delivers a published course.
+	CourseSyncDeliveryKindSync CourseSyncDeliveryKind = "sync"
+	// CourseSyncDeliveryKindTest is a synthetic event an admin sent to test a receiver.
+	CourseSyncDeliveryKindTest CourseSyncDeliveryKind = "test"
+	// CourseSyncDeliveryKindReplay re-sends an earlier delivery.
+	CourseSyncDeliveryKindReplay CourseSyncDeliveryKind = "replay"
+)
+
+func (k CourseSyncDeliveryKind) String() string {
+	return string(k)
+}
+
+func (k CourseSyncDeliveryKind) IsValid() bool {
+	switch k {
+	case CourseSyncDeliveryKindSync, CourseSyncDeliveryKindTest, CourseSyncDeliveryKindReplay:
+		return true
+	}
+	return false
+}
+
+func ParseCourseSyncDeliveryKind(str string) (CourseSyncDeliveryKind, error) {
+	k := CourseSyncDeliveryKind(str)
+	if !k.IsValid() {
+		return "", fmt.Errorf("invalid course sync delivery kind: %s", str)
+	}
+	return k, nil
+}
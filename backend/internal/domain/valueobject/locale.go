@@ -0,0 +1,50 @@
+package valueobject
+
+import "strings"
+
+// Locale is the language used for emails and exports, and the conventions for the
+// dates, durations and numbers formatted in them.
+type Locale string
+
+const (
+	// LocaleEnglish is the default locale and the fallback for unsupported languages.
+	LocaleEnglish Locale = "en"
+	// LocaleGerman is German.
+	LocaleGerman Locale = "de"
+	// LocaleFrench is French.
+	LocaleFrench Locale = "fr"
+	// LocaleSpanish is Spanish.
+	LocaleSpanish Locale = "es"
+	// LocalePortuguese is Portuguese.
+	LocalePortuguese Locale = "pt"
+)
+
+// DefaultLocale is used when a user has no supported locale.
+const DefaultLocale = LocaleEnglish
+
+// String returns the string representation of the locale.
+func (l Locale) String() string {
+	return string(l)
+}
+
+// IsValid checks if the locale is supported.
+func (l Locale) IsValid() bool {
+	switch l {
+	case LocaleEnglish, LocaleGerman, LocaleFrench, LocaleSpanish, LocalePortuguese:
+		return true
+	}
+	return false
+}
+
+// ParseLocale normalizes a language tag such as "de-AT" or "fr_FR" to a supported
+// locale, falling back to DefaultLocale.
+func ParseLocale(tag string) Locale {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if i := strings.IndexAny(tag, "-_"); i >= 0 {
+		tag = tag[:i]
+	}
+	if l := Locale(tag); l.IsValid() {
+		return l
+	}
+	return DefaultLocale
+}
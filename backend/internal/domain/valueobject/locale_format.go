@@ -0,0 +1,153 @@
+package valueobject
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// localeFormat holds the conventions a locale writes dates, durations and numbers with.
+type localeFormat struct {
+	months       [12]string
+	date         func(f *localeFormat, t time.Time) string
+	hours        string // Duration unit after the hours, e.g. "h" or " Std."
+	minutes      string // Duration unit after the minutes
+	thousands    string
+	decimal      string
+	minGrouping  int  // Digits a number needs before it is grouped
+	csvSeparator rune // Spreadsheets in locales with a decimal comma expect ';'
+}
+
+var localeFormats = map[Locale]*localeFormat{
+	LocaleEnglish: {
+		months: [12]string{"January", "February", "March", "April", "May", "June",
+			"July", "August", "September", "October", "November", "December"},
+		date: func(f *localeFormat, t time.Time) string {
+			return fmt.Sprintf("%s %d, %d", f.months[t.Month()-1], t.Day(), t.Year())
+		},
+		hours: "h", minutes: "m",
+		thousands: ",", decimal: ".", minGrouping: 4, csvSeparator: ',',
+	},
+	LocaleGerman: {
+		months: [12]string{"Januar", "Februar", "März", "April", "Mai", "Juni",
+			"Juli", "August", "September", "Oktober", "November", "Dezember"},
+		date: func(f *localeFormat, t time.Time) string {
+			return fmt.Sprintf("%d. %s %d", t.Day(), f.months[t.Month()-1], t.Year())
+		},
+		hours: " Std.", minutes: " Min.",
+		thousands: ".", decimal: ",", minGrouping: 4, csvSeparator: ';',
+	},
+	LocaleFrench: {
+		months: [12]string{"janvier", "février", "mars", "avril", "mai", "juin",
+			"juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+		date: func(f *localeFormat, t time.Time) string {
+			day := strconv.Itoa(t.Day())
+			if t.Day() == 1 {
+				day = "1er"
+			}
+			return fmt.Sprintf("%s %s %d", day, f.months[t.Month()-1], t.Year())
+		},
+		hours: " h", minutes: " min",
+		thousands: "\u202f", decimal: ",", minGrouping: 4, csvSeparator: ';',
+	},
+	LocaleSpanish: {
+		months: [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio",
+			"julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+		date: func(f *localeFormat, t time.Time) string {
+			return fmt.Sprintf("%d de %s de %d", t.Day(), f.months[t.Month()-1], t.Year())
+		},
+		hours: " h", minutes: " min",
+		thousands: ".", decimal: ",", minGrouping: 5, csvSeparator: ';',
+	},
+	LocalePortuguese: {
+		months: [12]string{"janeiro", "fevereiro", "março", "abril", "maio", "junho",
+			"julho", "agosto", "setembro", "outubro", "novembro", "dezembro"},
+		date: func(f *localeFormat, t time.Time) string {
+			return fmt.Sprintf("%d de %s de %d", t.Day(), f.months[t.Month()-1], t.Year())
+		},
+		hours: " h", minutes: " min",
+		thousands: ".", decimal: ",", minGrouping: 4, csvSeparator: ';',
+	},
+}
+
+// format returns the locale's conventions, falling back to the default locale's.
+func (l Locale) format() *localeFormat {
+	if f, ok := localeFormats[ParseLocale(string(l))]; ok {
+		return f
+	}
+	return localeFormats[DefaultLocale]
+}
+
+// FormatDate formats a calendar date the way it is usually written in the locale,
+// e.g. "January 2, 2006" or "2. Januar 2006".
+func (l Locale) FormatDate(t time.Time) string {
+	f := l.format()
+	return f.date(f, t)
+}
+
+// FormatDuration formats a number of minutes as hours and minutes, e.g. "3h 20m" or
+// "3 Std. 20 Min.", leaving out a part that is zero.
+func (l Locale) FormatDuration(minutes int) string {
+	f := l.format()
+	if minutes < 0 {
+		minutes = 0
+	}
+	h, m := minutes/60, minutes%60
+	switch {
+	case h == 0:
+		return strconv.Itoa(m) + f.minutes
+	case m == 0:
+		return strconv.Itoa(h) + f.hours
+	default:
+		return strconv.Itoa(h) + f.hours + " " + strconv.Itoa(m) + f.minutes
+	}
+}
+
+// FormatNumber formats an integer with the locale's thousands separator.
+func (l Locale) FormatNumber(n int64) string {
+	f := l.format()
+	sign := ""
+	if n < 0 {
+		sign = "-"
+		n = -n
+	}
+	return sign + groupDigits(strconv.FormatInt(n, 10), f)
+}
+
+// FormatDecimal formats a number with the given number of decimal places, using the
+// locale's thousands and decimal separators.
+func (l Locale) FormatDecimal(v float64, places int) string {
+	f := l.format()
+	s := strconv.FormatFloat(v, 'f', places, 64)
+	sign := ""
+	if strings.HasPrefix(s, "-") {
+		sign, s = "-", s[1:]
+	}
+	whole, frac, _ := strings.Cut(s, ".")
+	s = sign + groupDigits(whole, f)
+	if frac != "" {
+		s += f.decimal + frac
+	}
+	return s
+}
+
+// CSVSeparator returns the field separator spreadsheets in the locale expect.
+func (l Locale) CSVSeparator() rune {
+	return l.format().csvSeparator
+}
+
+// groupDigits inserts the thousands separator into a string of digits.
+func groupDigits(digits string, f *localeFormat) string {
+	if len(digits) < f.minGrouping {
+		return digits
+	}
+	var b strings.Builder
+	for i, d := range digits {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			b.WriteString(f.thousands)
+		}
+		b.WriteRune(d)
+	}
+	return b.String()
+}
@@ -0,0 +1,119 @@
+package valueobject
+
+import (
+	"testing"
+	"time"
+)
+
+// localeCase is what one locale must render for the shared inputs in TestLocaleFormats.
+type localeCase struct {
+	date, firstOfMonth           string // 2025-03-14 and 2025-08-01
+	hoursMinutes, hours, minutes string // 200, 120 and 45 minutes
+	small, grouped, large        string // 999, 1234 and -12345678
+	fiveDigits                   string // 12345
+	decimal, negativeDecimal     string // 1234.567 at 2 places, -9876543.21 at 1 place
+	csvSeparator                 rune
+}
+
+func TestLocaleFormats(t *testing.T) {
+	tests := map[Locale]localeCase{
+		LocaleEnglish: {
+			date: "March 14, 2025", firstOfMonth: "August 1, 2025",
+			hoursMinutes: "3h 20m", hours: "2h", minutes: "45m",
+			small: "999", grouped: "1,234", large: "-12,345,678", fiveDigits: "12,345",
+			decimal: "1,234.57", negativeDecimal: "-9,876,543.2",
+			csvSeparator: ',',
+		},
+		LocaleGerman: {
+			date: "14. März 2025", firstOfMonth: "1. August 2025",
+			hoursMinutes: "3 Std. 20 Min.", hours: "2 Std.", minutes: "45 Min.",
+			small: "999", grouped: "1.234", large: "-12.345.678", fiveDigits: "12.345",
+			decimal: "1.234,57", negativeDecimal: "-9.876.543,2",
+			csvSeparator: ';',
+		},
+		LocaleFrench: {
+			date: "14 mars 2025", firstOfMonth: "1er août 2025",
+			hoursMinutes: "3 h 20 min", hours: "2 h", minutes: "45 min",
+			small: "999", grouped: "1\u202f234", large: "-12\u202f345\u202f678", fiveDigits: "12\u202f345",
+			decimal: "1\u202f234,57", negativeDecimal: "-9\u202f876\u202f543,2",
+			csvSeparator: ';',
+		},
+		LocaleSpanish: {
+			date: "14 de marzo de 2025", firstOfMonth: "1 de agosto de 2025",
+			hoursMinutes: "3 h 20 min", hours: "2 h", minutes: "45 min",
+			// Spanish only groups numbers of five digits or more
+			small: "999", grouped: "1234", large: "-12.345.678", fiveDigits: "12.345",
+			decimal: "1234,57", negativeDecimal: "-9.876.543,2",
+			csvSeparator: ';',
+		},
+		LocalePortuguese: {
+			date: "14 de março de 2025", firstOfMonth: "1 de agosto de 2025",
+			hoursMinutes: "3 h 20 min", hours: "2 h", minutes: "45 min",
+			small: "999", grouped: "1.234", large: "-12.345.678", fiveDigits: "12.345",
+			decimal: "1.234,57", negativeDecimal: "-9.876.543,2",
+			csvSeparator: ';',
+		},
+	}
+
+	for locale, want := range tests {
+		t.Run(string(locale), func(t *testing.T) {
+			check := func(what, got, want string) {
+				t.Helper()
+				if got != want {
+					t.Errorf("%s = %q, want %q", what, got, want)
+				}
+			}
+			check("FormatDate(2025-03-14)", locale.FormatDate(time.Date(2025, time.March, 14, 0, 0, 0, 0, time.UTC)), want.date)
+			check("FormatDate(2025-08-01)", locale.FormatDate(time.Date(2025, time.August, 1, 0, 0, 0, 0, time.UTC)), want.firstOfMonth)
+			check("FormatDuration(200)", locale.FormatDuration(200), want.hoursMinutes)
+			check("FormatDuration(120)", locale.FormatDuration(120), want.hours)
+			check("FormatDuration(45)", locale.FormatDuration(45), want.minutes)
+			check("FormatNumber(999)", locale.FormatNumber(999), want.small)
+			check("FormatNumber(1234)", locale.FormatNumber(1234), want.grouped)
+			check("FormatNumber(12345)", locale.FormatNumber(12345), want.fiveDigits)
+			check("FormatNumber(-12345678)", locale.FormatNumber(-12345678), want.large)
+			check("FormatDecimal(1234.567, 2)", locale.FormatDecimal(1234.567, 2), want.decimal)
+			check("FormatDecimal(-9876543.21, 1)", locale.FormatDecimal(-9876543.21, 1), want.negativeDecimal)
+			if got := locale.CSVSeparator(); got != want.csvSeparator {
+				t.Errorf("CSVSeparator() = %q, want %q", got, want.csvSeparator)
+			}
+		})
+	}
+}
+
+func TestLocaleFormatsCoverEverySupportedLocale(t *testing.T) {
+	for _, locale := range []Locale{LocaleEnglish, LocaleGerman, LocaleFrench, LocaleSpanish, LocalePortuguese} {
+		f, ok := localeFormats[locale]
+		if !ok {
+			t.Errorf("no format for %s", locale)
+			continue
+		}
+		for i, month := range f.months {
+			if month == "" {
+				t.Errorf("%s has no name for month %d", locale, i+1)
+			}
+		}
+	}
+}
+
+func TestLocaleFormatEdgeCases(t *testing.T) {
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"zero duration", LocaleEnglish.FormatDuration(0), "0m"},
+		{"negative duration", LocaleGerman.FormatDuration(-30), "0 Min."},
+		{"zero", LocaleFrench.FormatNumber(0), "0"},
+		{"no decimal places", LocaleGerman.FormatDecimal(1234.5, 0), "1.234"},
+		{"small negative decimal", LocalePortuguese.FormatDecimal(-0.25, 2), "-0,25"},
+		{"regional tag", Locale("de-AT").FormatDate(time.Date(2025, time.January, 2, 0, 0, 0, 0, time.UTC)), "2. Januar 2025"},
+		{"unsupported locale falls back", Locale("ja").FormatNumber(1234), "1,234"},
+		{"empty locale falls back", Locale("").FormatDuration(90), "1h 30m"},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s: got %q, want %q", tt.name, tt.got, tt.want)
+		}
+	}
+}
@@ -17,6 +17,10 @@ const (
 	NotificationTypeSubmissionReadyForReview NotificationType = "submission_ready_for_review"
 	NotificationTypeSubmissionApproved       NotificationType = "submission_approved"
 	NotificationTypeChangesRequested         NotificationType = "changes_requested"
+	NotificationTypeCollaboratorAdded        NotificationType = "collaborator_added"
+	NotificationTypeExportReady              NotificationType = "export_ready"
+	NotificationTypeOutlineComment           NotificationType = "outline_comment"
+	NotificationTypeLessonsOrphaned          NotificationType = "lessons_orphaned"
 )
 
 func (t NotificationType) String() string {
@@ -30,7 +34,9 @@ func (t NotificationType) IsValid() bool {
 		NotificationTypeOutlineReady, NotificationTypeGenerationComplete,
 		NotificationTypeGenerationFailed, NotificationTypeApprovalRequested,
 		NotificationTypeSubmissionReadyForReview, NotificationTypeSubmissionApproved,
-		NotificationTypeChangesRequested:
+		NotificationTypeChangesRequested, NotificationTypeCollaboratorAdded,
+		NotificationTypeExportReady, NotificationTypeOutlineComment,
+		NotificationTypeLessonsOrphaned:
 		return true
 	}
 	return false
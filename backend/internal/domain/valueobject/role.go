@@ -153,3 +153,45 @@ func ParseTeamRole(s string) (TeamRole, error) {
 	}
 	return r, nil
 }
+
+// CourseRole represents a collaborator's role on a specific course.
+type CourseRole string
+
+const (
+	CourseRoleOwner  CourseRole = "owner"
+	CourseRoleEditor CourseRole = "editor"
+	CourseRoleViewer CourseRole = "viewer"
+)
+
+// String returns the string representation of the course role.
+func (r CourseRole) String() string {
+	return string(r)
+}
+
+// IsValid checks if the course role is a valid value.
+func (r CourseRole) IsValid() bool {
+	switch r {
+	case CourseRoleOwner, CourseRoleEditor, CourseRoleViewer:
+		return true
+	}
+	return false
+}
+
+// CanEditCourse returns true if this role can edit course content and start generation.
+func (r CourseRole) CanEditCourse() bool {
+	return r == CourseRoleOwner || r == CourseRoleEditor
+}
+
+// CanManageCollaborators returns true if this role can add and remove collaborators.
+func (r CourseRole) CanManageCollaborators() bool {
+	return r == CourseRoleOwner
+}
+
+// ParseCourseRole converts a string to a CourseRole, returning an error if invalid.
+func ParseCourseRole(s string) (CourseRole, error) {
+	r := CourseRole(s)
+	if !r.IsValid() {
+		return "", fmt.Errorf("invalid course role: %s", s)
+	}
+	return r, nil
+}
@@ -88,6 +88,11 @@ func (s SMETaskStatus) IsValid() bool {
 	return false
 }
 
+// AwaitsAssignee returns true while the task is waiting on its assignee to submit content.
+func (s SMETaskStatus) AwaitsAssignee() bool {
+	return s == SMETaskStatusPending || s == SMETaskStatusChangesRequested
+}
+
 func ParseSMETaskStatus(str string) (SMETaskStatus, error) {
 	s := SMETaskStatus(str)
 	if !s.IsValid() {
@@ -96,6 +101,52 @@ func ParseSMETaskStatus(str string) (SMETaskStatus, error) {
 	return s, nil
 }
 
+// SubmissionStatus is where a task submission stands. It is derived from the
+// submission's ingestion and approval fields rather than stored.
+type SubmissionStatus string
+
+const (
+	SubmissionStatusPendingReview SubmissionStatus = "pending_review"
+	SubmissionStatusProcessed     SubmissionStatus = "processed"
+	SubmissionStatusFailed        SubmissionStatus = "failed"
+	SubmissionStatusApproved      SubmissionStatus = "approved"
+)
+
+func (s SubmissionStatus) String() string {
+	return string(s)
+}
+
+func (s SubmissionStatus) IsValid() bool {
+	switch s {
+	case SubmissionStatusPendingReview, SubmissionStatusProcessed, SubmissionStatusFailed, SubmissionStatusApproved:
+		return true
+	}
+	return false
+}
+
+func ParseSubmissionStatus(str string) (SubmissionStatus, error) {
+	s := SubmissionStatus(str)
+	if !s.IsValid() {
+		return "", fmt.Errorf("invalid submission status: %s", str)
+	}
+	return s, nil
+}
+
+// SubmissionProcessingStatus is where a submission's latest ingestion job stands.
+type SubmissionProcessingStatus string
+
+const (
+	SubmissionProcessingNotStarted SubmissionProcessingStatus = "not_started"
+	SubmissionProcessingQueued     SubmissionProcessingStatus = "queued"
+	SubmissionProcessingProcessing SubmissionProcessingStatus = "processing"
+	SubmissionProcessingCompleted  SubmissionProcessingStatus = "completed"
+	SubmissionProcessingFailed     SubmissionProcessingStatus = "failed"
+)
+
+func (s SubmissionProcessingStatus) String() string {
+	return string(s)
+}
+
 // ContentType for uploaded materials.
 type ContentType string
 
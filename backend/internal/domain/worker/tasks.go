@@ -2,19 +2,35 @@ package worker
 
 import (
 	"encoding/json"
+	"time"
 
 	"github.com/hibiken/asynq"
 )
 
 // Task type constants
 const (
-	TypeStripeProvision  = "stripe:provision"
-	TypeStripeReconcile  = "stripe:reconcile" // Scheduled reconciliation for orphaned payments
-	TypeCleanupExpired   = "cleanup:expired"
-	TypeAIGeneration     = "ai:generation"
-	TypeSMEIngestion     = "sme:ingestion"
-	TypeAIGenerationPoll = "ai:generation:poll" // Scheduled polling task
-	TypeSMEIngestionPoll = "sme:ingestion:poll" // Scheduled polling task
+	TypeStripeProvision       = "stripe:provision"
+	TypeStripeReconcile       = "stripe:reconcile"   // Scheduled reconciliation for orphaned payments
+	TypeIdentityReconcile     = "identity:reconcile" // Scheduled reconciliation for orphaned Kratos identities
+	TypeCleanupExpired        = "cleanup:expired"
+	TypeAIGeneration          = "ai:generation"
+	TypeSMEIngestion          = "sme:ingestion"
+	TypeSMETopicRecluster     = "sme:topics:recluster"
+	TypeSMESummaryRefit       = "sme:summary:refit"
+	TypeSMESummaryBackfill    = "sme:summary:backfill" // Superadmin-requested refit of oversized SME knowledge summaries
+	TypeAIGenerationPoll      = "ai:generation:poll"   // Scheduled polling task
+	TypeSMEIngestionPoll      = "sme:ingestion:poll"   // Scheduled polling task
+	TypeGenerationConsistency = "ai:generation:sweep"  // Scheduled consistency check of generation state
+	TypeAbandonedUploads      = "cleanup:uploads"      // Scheduled abort of incomplete multipart uploads
+	TypeExpiredExports        = "cleanup:exports"      // Scheduled deletion of export artifacts past their retention period
+	TypeLMSSyncPoll           = "lms:sync:poll"        // Scheduled delivery of published courses to LMS connectors
+	TypeTenantCacheWarm       = "cache:warm"           // Superadmin-requested rebuild of a tenant's library cache
+	TypeWeeklySummary         = "notify:weekly"        // Scheduled weekly summary email to tenant admins
+	TypeStorageEncryption     = "storage:encrypt"      // Superadmin-requested re-encryption of course content with tenant keys
+	TypeCourseAttachment      = "course:attachment"    // Text extraction from an uploaded course reference attachment
+	TypeStorageRegionMigrate  = "storage:migrate"      // Superadmin-requested move of a tenant's objects to another storage region
+	TypeQuizSchemaMigration   = "quiz:schema:migrate"  // Superadmin-requested upgrade of quiz components to the current schema
+	TypeCourseVersionBackfill = "course:versions"      // Scheduled snapshot of courses published before versions were stored
 )
 
 // Queue names for priority handling
@@ -42,6 +58,42 @@ type SMEIngestionPayload struct {
 	JobID string `json:"job_id"`
 }
 
+// SMETopicReclusterPayload contains data for merging an SME's near-duplicate topics
+type SMETopicReclusterPayload struct {
+	TenantID string `json:"tenant_id"`
+	SMEID    string `json:"sme_id"`
+}
+
+// SMESummaryRefitPayload contains data for re-summarizing an SME's oversized knowledge summary
+type SMESummaryRefitPayload struct {
+	TenantID string `json:"tenant_id"`
+	SMEID    string `json:"sme_id"`
+}
+
+// TenantCacheWarmPayload contains data for rebuilding a tenant's library cache
+type TenantCacheWarmPayload struct {
+	TenantID      string `json:"tenant_id"`
+	RecentCourses int    `json:"recent_courses"`
+}
+
+// StorageEncryptionPayload contains data for re-encrypting stored course content.
+// An empty TenantID fans out to every active tenant.
+type StorageEncryptionPayload struct {
+	TenantID string `json:"tenant_id,omitempty"`
+}
+
+// StorageRegionMigrationPayload contains data for moving a tenant's objects to the
+// storage region it is migrating to
+type StorageRegionMigrationPayload struct {
+	TenantID string `json:"tenant_id"`
+}
+
+// CourseAttachmentPayload contains data for extracting a course attachment's text
+type CourseAttachmentPayload struct {
+	TenantID     string `json:"tenant_id"`
+	AttachmentID string `json:"attachment_id"`
+}
+
 // NewStripeProvisionTask creates a new Stripe provisioning task
 func NewStripeProvisionTask(sessionID, customer, subscriptionID string) (*asynq.Task, error) {
 	payload, err := json.Marshal(StripeProvisionPayload{
@@ -60,6 +112,16 @@ func NewStripeReconcileTask() *asynq.Task {
 	return asynq.NewTask(TypeStripeReconcile, nil, asynq.Queue(QueueCritical), asynq.MaxRetry(1))
 }
 
+// NewIdentityReconcileTask creates a new orphaned identity reconciliation task (scheduled)
+func NewIdentityReconcileTask() *asynq.Task {
+	return asynq.NewTask(TypeIdentityReconcile, nil, asynq.Queue(QueueLow), asynq.MaxRetry(1))
+}
+
+// NewGenerationConsistencyTask creates a new generation consistency sweep task (scheduled)
+func NewGenerationConsistencyTask() *asynq.Task {
+	return asynq.NewTask(TypeGenerationConsistency, nil, asynq.Queue(QueueLow), asynq.MaxRetry(1))
+}
+
 // NewAIGenerationTask creates a new AI generation task
 func NewAIGenerationTask(jobID, jobType string) (*asynq.Task, error) {
 	payload, err := json.Marshal(AIGenerationPayload{
@@ -83,16 +145,125 @@ func NewSMEIngestionTask(jobID string) (*asynq.Task, error) {
 	return asynq.NewTask(TypeSMEIngestion, payload, asynq.Queue(QueueDefault), asynq.MaxRetry(3)), nil
 }
 
+// NewSMETopicReclusterTask creates a new SME topic reclustering task.
+// Unique for an hour, so a burst of ingestions re-clusters the SME once.
+func NewSMETopicReclusterTask(tenantID, smeID string) (*asynq.Task, error) {
+	payload, err := json.Marshal(SMETopicReclusterPayload{
+		TenantID: tenantID,
+		SMEID:    smeID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return asynq.NewTask(TypeSMETopicRecluster, payload, asynq.Queue(QueueLow), asynq.MaxRetry(2), asynq.Unique(time.Hour)), nil
+}
+
+// NewSMESummaryRefitTask creates a new SME knowledge summary refit task.
+// Unique for an hour, so a repeated backfill refits the SME once.
+func NewSMESummaryRefitTask(tenantID, smeID string) (*asynq.Task, error) {
+	payload, err := json.Marshal(SMESummaryRefitPayload{
+		TenantID: tenantID,
+		SMEID:    smeID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return asynq.NewTask(TypeSMESummaryRefit, payload, asynq.Queue(QueueLow), asynq.MaxRetry(2), asynq.Unique(time.Hour)), nil
+}
+
+// NewSMESummaryBackfillTask creates a new SME knowledge summary backfill task.
+// Unique for ten minutes, so repeated requests run one backfill.
+func NewSMESummaryBackfillTask() *asynq.Task {
+	return asynq.NewTask(TypeSMESummaryBackfill, nil, asynq.Queue(QueueLow), asynq.MaxRetry(1), asynq.Unique(10*time.Minute))
+}
+
+// NewQuizSchemaMigrationTask creates a new quiz schema migration task.
+// Unique for ten minutes, so repeated requests run one migration.
+func NewQuizSchemaMigrationTask() *asynq.Task {
+	return asynq.NewTask(TypeQuizSchemaMigration, nil, asynq.Queue(QueueLow), asynq.MaxRetry(1), asynq.Unique(10*time.Minute))
+}
+
+// NewTenantCacheWarmTask creates a new tenant cache warming task.
+// Unique for a minute, so repeated requests for a tenant warm it once.
+func NewTenantCacheWarmTask(tenantID string, recentCourses int) (*asynq.Task, error) {
+	payload, err := json.Marshal(TenantCacheWarmPayload{
+		TenantID:      tenantID,
+		RecentCourses: recentCourses,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return asynq.NewTask(TypeTenantCacheWarm, payload, asynq.Queue(QueueLow), asynq.MaxRetry(2), asynq.Unique(time.Minute)), nil
+}
+
+// NewStorageEncryptionTask creates a new storage encryption task.
+// Unique for ten minutes, so repeated requests for a tenant run one pass.
+func NewStorageEncryptionTask(tenantID string) (*asynq.Task, error) {
+	payload, err := json.Marshal(StorageEncryptionPayload{TenantID: tenantID})
+	if err != nil {
+		return nil, err
+	}
+	return asynq.NewTask(TypeStorageEncryption, payload, asynq.Queue(QueueLow), asynq.MaxRetry(3), asynq.Timeout(time.Hour), asynq.Unique(10*time.Minute)), nil
+}
+
+// NewStorageRegionMigrationTask creates a new storage region migration task.
+// Unique for ten minutes, so a repeated request copies the tenant's objects once.
+func NewStorageRegionMigrationTask(tenantID string) (*asynq.Task, error) {
+	payload, err := json.Marshal(StorageRegionMigrationPayload{TenantID: tenantID})
+	if err != nil {
+		return nil, err
+	}
+	return asynq.NewTask(TypeStorageRegionMigrate, payload, asynq.Queue(QueueLow), asynq.MaxRetry(3), asynq.Timeout(6*time.Hour), asynq.Unique(10*time.Minute)), nil
+}
+
+// NewCourseAttachmentTask creates a new course attachment text extraction task.
+// Unique for ten minutes, so a repeated confirmation extracts the file once.
+func NewCourseAttachmentTask(tenantID, attachmentID string) (*asynq.Task, error) {
+	payload, err := json.Marshal(CourseAttachmentPayload{
+		TenantID:     tenantID,
+		AttachmentID: attachmentID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return asynq.NewTask(TypeCourseAttachment, payload, asynq.Queue(QueueDefault), asynq.MaxRetry(3), asynq.Unique(10*time.Minute)), nil
+}
+
 // NewCleanupExpiredTask creates a new cleanup task (no payload needed)
 func NewCleanupExpiredTask() *asynq.Task {
 	return asynq.NewTask(TypeCleanupExpired, nil, asynq.Queue(QueueLow), asynq.MaxRetry(1))
 }
 
+// NewAbandonedUploadsTask creates a new abandoned multipart upload cleanup task (scheduled)
+func NewAbandonedUploadsTask() *asynq.Task {
+	return asynq.NewTask(TypeAbandonedUploads, nil, asynq.Queue(QueueLow), asynq.MaxRetry(1))
+}
+
+// NewExpiredExportsTask creates a new expired export artifact cleanup task (scheduled)
+func NewExpiredExportsTask() *asynq.Task {
+	return asynq.NewTask(TypeExpiredExports, nil, asynq.Queue(QueueLow), asynq.MaxRetry(1))
+}
+
+// NewCourseVersionBackfillTask creates a new published course version backfill task (scheduled)
+func NewCourseVersionBackfillTask() *asynq.Task {
+	return asynq.NewTask(TypeCourseVersionBackfill, nil, asynq.Queue(QueueLow), asynq.MaxRetry(1))
+}
+
 // NewAIGenerationPollTask creates a new AI generation polling task (scheduled)
 func NewAIGenerationPollTask() *asynq.Task {
 	return asynq.NewTask(TypeAIGenerationPoll, nil, asynq.Queue(QueueDefault), asynq.MaxRetry(1))
 }
 
+// NewLMSSyncPollTask creates a scheduled task that delivers due course syncs.
+func NewLMSSyncPollTask() *asynq.Task {
+	return asynq.NewTask(TypeLMSSyncPoll, nil, asynq.Queue(QueueDefault), asynq.MaxRetry(1))
+}
+
+// NewWeeklySummaryTask creates a scheduled task that sends due weekly summary emails.
+func NewWeeklySummaryTask() *asynq.Task {
+	return asynq.NewTask(TypeWeeklySummary, nil, asynq.Queue(QueueLow), asynq.MaxRetry(1))
+}
+
 // NewSMEIngestionPollTask creates a new SME ingestion polling task (scheduled)
 func NewSMEIngestionPollTask() *asynq.Task {
 	return asynq.NewTask(TypeSMEIngestionPoll, nil, asynq.Queue(QueueDefault), asynq.MaxRetry(1))
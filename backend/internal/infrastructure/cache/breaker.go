@@ -0,0 +1,75 @@
+package cache
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrUnavailable is returned by cache operations that can't degrade to a miss or no-op,
+// such as locks and invalidation, while Redis is unreachable.
+var ErrUnavailable = errors.New("cache unavailable")
+
+const (
+	// breakerThreshold is how many Redis failures in a row open the breaker.
+	breakerThreshold = 3
+
+	// breakerCooldown is how long an open breaker skips Redis before trying it again.
+	breakerCooldown = 30 * time.Second
+
+	// breakerWarnInterval limits how often degraded Redis errors are logged.
+	breakerWarnInterval = time.Minute
+)
+
+// circuitBreaker stops calls to Redis for a cooldown after repeated failures, so an
+// outage costs each request nothing instead of a connection timeout. After the cooldown
+// one failure is enough to open it again.
+type circuitBreaker struct {
+	mu         sync.Mutex
+	failures   int
+	openUntil  time.Time
+	lastWarn   time.Time
+	suppressed int
+}
+
+// allow reports whether Redis should be called.
+func (b *circuitBreaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return !time.Now().Before(b.openUntil)
+}
+
+// success records a call that reached Redis.
+func (b *circuitBreaker) success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failures = 0
+}
+
+// failure records a call that couldn't reach Redis and reports whether it opened the breaker.
+func (b *circuitBreaker) failure() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failures++
+	if b.failures < breakerThreshold {
+		return false
+	}
+	b.openUntil = time.Now().Add(breakerCooldown)
+	b.failures = breakerThreshold - 1
+	return true
+}
+
+// shouldWarn reports whether a degraded error should be logged now, and how many were
+// skipped since the last warning.
+func (b *circuitBreaker) shouldWarn() (bool, int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if time.Since(b.lastWarn) < breakerWarnInterval {
+		b.suppressed++
+		return false, 0
+	}
+	suppressed := b.suppressed
+	b.lastWarn = time.Now()
+	b.suppressed = 0
+	return true, suppressed
+}
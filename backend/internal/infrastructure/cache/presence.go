@@ -0,0 +1,104 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+)
+
+// CoursePresence records who has a course open in short-lived Redis keys, one per user:
+//
+//	tenant:{tenant_id}:course:{course_id}:presence:{user_id}
+//
+// A key expires when its heartbeats stop, so nothing has to clean up after a closed tab.
+// A set of the course's user IDs, expiring with the latest heartbeat, avoids scanning
+// the keyspace to list them.
+//
+// Presence is best effort, like the cache: while Redis is unavailable heartbeats are
+// dropped and nobody is reported present.
+type CoursePresence struct {
+	cache *RedisCache
+}
+
+// NewCoursePresence creates a presence tracker on the cache's Redis connection.
+func NewCoursePresence(cache *RedisCache) *CoursePresence {
+	return &CoursePresence{cache: cache}
+}
+
+// presenceIndexKey is the set of user IDs with a presence key on the course.
+func presenceIndexKey(tenantID, courseID uuid.UUID) string {
+	return fmt.Sprintf("tenant:%s:course:%s:presence", tenantID, courseID)
+}
+
+// presenceKey holds one user's presence on the course.
+func presenceKey(tenantID, courseID, userID uuid.UUID) string {
+	return presenceIndexKey(tenantID, courseID) + ":" + userID.String()
+}
+
+// Heartbeat marks the user present on the course until ttl passes without another heartbeat.
+func (p *CoursePresence) Heartbeat(ctx context.Context, tenantID, courseID uuid.UUID, presence entity.CoursePresence, ttl time.Duration) error {
+	if !p.cache.breaker.allow() {
+		return nil
+	}
+
+	data, err := json.Marshal(presence)
+	if err != nil {
+		return err
+	}
+
+	indexKey := presenceIndexKey(tenantID, courseID)
+	pipe := p.cache.client.TxPipeline()
+	pipe.Set(ctx, presenceKey(tenantID, courseID, presence.UserID), data, ttl)
+	pipe.SAdd(ctx, indexKey, presence.UserID.String())
+	pipe.Expire(ctx, indexKey, ttl)
+	_, err = pipe.Exec(ctx)
+	p.cache.unavailable(ctx, "presence heartbeat", err)
+	return nil
+}
+
+// List returns who is present on the course. Users whose presence expired are removed
+// from the course's set as they are found.
+func (p *CoursePresence) List(ctx context.Context, tenantID, courseID uuid.UUID) ([]entity.CoursePresence, error) {
+	if !p.cache.breaker.allow() {
+		return nil, nil
+	}
+
+	indexKey := presenceIndexKey(tenantID, courseID)
+	userIDs, err := p.cache.client.SMembers(ctx, indexKey).Result()
+	if p.cache.unavailable(ctx, "presence list", err) || len(userIDs) == 0 {
+		return nil, nil
+	}
+
+	keys := make([]string, len(userIDs))
+	for i, userID := range userIDs {
+		keys[i] = indexKey + ":" + userID
+	}
+	values, err := p.cache.client.MGet(ctx, keys...).Result()
+	if p.cache.unavailable(ctx, "presence list", err) {
+		return nil, nil
+	}
+
+	var present []entity.CoursePresence
+	var expired []interface{}
+	for i, value := range values {
+		data, ok := value.(string)
+		if !ok {
+			expired = append(expired, userIDs[i])
+			continue
+		}
+		var presence entity.CoursePresence
+		if err := json.Unmarshal([]byte(data), &presence); err != nil {
+			continue
+		}
+		present = append(present, presence)
+	}
+	if len(expired) > 0 {
+		p.cache.unavailable(ctx, "presence cleanup", p.cache.client.SRem(ctx, indexKey, expired...).Err())
+	}
+	return present, nil
+}
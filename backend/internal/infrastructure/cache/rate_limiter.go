@@ -0,0 +1,50 @@
+package cache
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RateLimiter counts calls per key in fixed windows shared by every process, one Redis
+// counter per key and window:
+//
+//	ratelimit:{name}:{key}:{window_start_unix}
+//
+// Each counter expires with its window, so nothing has to clean up idle keys.
+type RateLimiter struct {
+	cache  *RedisCache
+	name   string
+	window time.Duration
+}
+
+// NewRateLimiter creates a rate limiter on the cache's Redis connection. The name keeps
+// the counters of different limits apart.
+func NewRateLimiter(cache *RedisCache, name string, window time.Duration) *RateLimiter {
+	return &RateLimiter{cache: cache, name: name, window: window}
+}
+
+// Allow records a call for key and reports whether it is within limit calls in the
+// current window. It returns ErrUnavailable, without counting the call, while Redis
+// can't be reached, so the caller can decide whether to fail open or closed.
+func (l *RateLimiter) Allow(ctx context.Context, key string, limit int) (bool, error) {
+	if !l.cache.breaker.allow() {
+		return false, ErrUnavailable
+	}
+
+	start := time.Now().Truncate(l.window)
+	counterKey := "ratelimit:" + l.name + ":" + key + ":" + strconv.FormatInt(start.Unix(), 10)
+
+	var count *redis.IntCmd
+	_, err := l.cache.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		count = pipe.Incr(ctx, counterKey)
+		pipe.ExpireAt(ctx, counterKey, start.Add(l.window))
+		return nil
+	})
+	if l.cache.unavailable(ctx, "rate limit", err) {
+		return false, unavailableError(err)
+	}
+	return count.Val() <= int64(limit), nil
+}
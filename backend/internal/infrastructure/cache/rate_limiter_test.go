@@ -0,0 +1,57 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestRateLimiterReportsUnavailable(t *testing.T) {
+	c, _ := closedRedisCache(t)
+	l := NewRateLimiter(c, "test", time.Minute)
+
+	// Before and after the breaker opens
+	for i := range breakerThreshold + 1 {
+		if allowed, err := l.Allow(context.Background(), "k", 10); allowed || !errors.Is(err, ErrUnavailable) {
+			t.Fatalf("call %d = %v, %v; want ErrUnavailable", i+1, allowed, err)
+		}
+	}
+}
+
+func TestRateLimiterSharesCountsAcrossLimiters(t *testing.T) {
+	url := os.Getenv(testRedisURLEnv)
+	if url == "" {
+		t.Skipf("%s not set", testRedisURLEnv)
+	}
+	redisCache, err := NewRedisCache(RedisConfig{URL: url}, nopLogger{})
+	if err != nil {
+		t.Fatalf("failed to connect to test redis: %v", err)
+	}
+	t.Cleanup(func() { redisCache.Close() })
+
+	// Two limiters stand in for two processes
+	name := "test-" + uuid.NewString()
+	a, b := NewRateLimiter(redisCache, name, time.Minute), NewRateLimiter(redisCache, name, time.Minute)
+	ctx := context.Background()
+
+	// A window boundary between calls would reset the count, so start early in one
+	if time.Until(time.Now().Truncate(time.Minute).Add(time.Minute)) < 5*time.Second {
+		time.Sleep(5 * time.Second)
+	}
+	for i, l := range []*RateLimiter{a, b, a} {
+		allowed, err := l.Allow(ctx, "token", 2)
+		if err != nil {
+			t.Fatalf("call %d: %v", i+1, err)
+		}
+		if want := i < 2; allowed != want {
+			t.Errorf("call %d allowed = %v, want %v", i+1, allowed, want)
+		}
+	}
+	if allowed, err := b.Allow(ctx, "other-token", 2); err != nil || !allowed {
+		t.Errorf("another key's call = %v, %v; want allowed", allowed, err)
+	}
+}
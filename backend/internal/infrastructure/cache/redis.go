@@ -10,6 +10,8 @@ import (
 	"time"
 
 	"github.com/redis/go-redis/v9"
+
+	"github.com/sogos/mirai-backend/internal/domain/service"
 )
 
 // CacheEntry represents a cached value with metadata.
@@ -26,14 +28,23 @@ type Cache interface {
 	Set(ctx context.Context, key string, v interface{}, etag string, ttl time.Duration) (string, error)
 	Delete(ctx context.Context, key string) error
 	InvalidatePattern(ctx context.Context, pattern string) error
+	Generation(ctx context.Context, namespace string) (int64, error)
+	InvalidateNamespace(ctx context.Context, namespace string) error
 	AcquireLock(ctx context.Context, key string, ttl time.Duration) (string, error)
 	ReleaseLock(ctx context.Context, key string, lockID string) error
 }
 
 // RedisCache implements Cache using Redis.
+//
+// The cache is an optimization, so Redis failures don't fail requests: reads degrade to
+// misses and writes to no-ops, and a circuit breaker skips Redis entirely for a while
+// after repeated failures. Locks and invalidation return ErrUnavailable instead, since
+// pretending they succeeded could hide a problem from the caller.
 type RedisCache struct {
 	client     *redis.Client
 	defaultTTL time.Duration
+	breaker    circuitBreaker
+	logger     service.Logger
 }
 
 // RedisConfig holds Redis configuration.
@@ -43,7 +54,7 @@ type RedisConfig struct {
 }
 
 // NewRedisCache creates a new Redis cache.
-func NewRedisCache(cfg RedisConfig) (*RedisCache, error) {
+func NewRedisCache(cfg RedisConfig, logger service.Logger) (*RedisCache, error) {
 	opts, err := redis.ParseURL(cfg.URL)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse redis URL: %w", err)
@@ -67,17 +78,44 @@ func NewRedisCache(cfg RedisConfig) (*RedisCache, error) {
 	return &RedisCache{
 		client:     client,
 		defaultTTL: defaultTTL,
+		logger:     logger,
 	}, nil
 }
 
-// Get retrieves a cached value.
+// unavailable records the outcome of a Redis call and reports whether err means Redis
+// couldn't be reached. Misses count as successes, and errors after the caller gave up
+// don't count against Redis.
+func (c *RedisCache) unavailable(ctx context.Context, op string, err error) bool {
+	if err == nil || errors.Is(err, redis.Nil) {
+		c.breaker.success()
+		return false
+	}
+	if ctx.Err() != nil {
+		return true
+	}
+
+	if c.breaker.failure() {
+		c.logger.Warn("redis unavailable, bypassing cache", "op", op, "cooldown", breakerCooldown, "error", err)
+	} else if warn, suppressed := c.breaker.shouldWarn(); warn {
+		c.logger.Warn("redis cache error, degrading to a miss", "op", op, "suppressedWarnings", suppressed, "error", err)
+	}
+	return true
+}
+
+// unavailableError wraps a connection failure for operations that can't degrade.
+func unavailableError(err error) error {
+	return fmt.Errorf("%w: %v", ErrUnavailable, err)
+}
+
+// Get retrieves a cached value. It reports a miss while Redis is unavailable.
 func (c *RedisCache) Get(ctx context.Context, key string, v interface{}) (*CacheEntry, error) {
+	if !c.breaker.allow() {
+		return nil, nil
+	}
+
 	data, err := c.client.Get(ctx, key).Bytes()
-	if err != nil {
-		if errors.Is(err, redis.Nil) {
-			return nil, nil // Cache miss
-		}
-		return nil, err
+	if c.unavailable(ctx, "get", err) || errors.Is(err, redis.Nil) {
+		return nil, nil // Cache miss
 	}
 
 	var entry CacheEntry
@@ -101,13 +139,18 @@ func (c *RedisCache) Get(ctx context.Context, key string, v interface{}) (*Cache
 }
 
 // Set stores a value in cache with optimistic locking.
-// Returns the new etag, or an error if the provided etag doesn't match.
+// Returns the new etag, or an error if the provided etag doesn't match. Nothing is
+// stored while Redis is unavailable, and no error is returned.
 func (c *RedisCache) Set(ctx context.Context, key string, v interface{}, etag string, ttl time.Duration) (string, error) {
+	if !c.breaker.allow() {
+		return "", nil
+	}
+
 	// Check current etag if provided
 	if etag != "" {
 		current, err := c.client.Get(ctx, key).Bytes()
-		if err != nil && !errors.Is(err, redis.Nil) {
-			return "", err
+		if c.unavailable(ctx, "set", err) {
+			return "", nil
 		}
 		if current != nil {
 			var currentEntry CacheEntry
@@ -156,8 +199,8 @@ func (c *RedisCache) Set(ctx context.Context, key string, v interface{}, etag st
 		ttl = c.defaultTTL
 	}
 
-	if err := c.client.Set(ctx, key, entryData, ttl).Err(); err != nil {
-		return "", err
+	if err := c.client.Set(ctx, key, entryData, ttl).Err(); c.unavailable(ctx, "set", err) {
+		return "", nil
 	}
 
 	return newETag, nil
@@ -165,35 +208,102 @@ func (c *RedisCache) Set(ctx context.Context, key string, v interface{}, etag st
 
 // Delete removes a cached value.
 func (c *RedisCache) Delete(ctx context.Context, key string) error {
-	return c.client.Del(ctx, key).Err()
+	if !c.breaker.allow() {
+		return ErrUnavailable
+	}
+	if err := c.client.Del(ctx, key).Err(); c.unavailable(ctx, "delete", err) {
+		return unavailableError(err)
+	}
+	return nil
 }
 
 // InvalidatePattern removes all keys matching a pattern.
 func (c *RedisCache) InvalidatePattern(ctx context.Context, pattern string) error {
+	if !c.breaker.allow() {
+		return ErrUnavailable
+	}
+
 	iter := c.client.Scan(ctx, 0, pattern, 0).Iterator()
 	var keys []string
 	for iter.Next(ctx) {
 		keys = append(keys, iter.Val())
 	}
-	if err := iter.Err(); err != nil {
-		return err
+	if err := iter.Err(); c.unavailable(ctx, "invalidate pattern", err) {
+		return unavailableError(err)
 	}
 
 	if len(keys) > 0 {
-		return c.client.Del(ctx, keys...).Err()
+		if err := c.client.Del(ctx, keys...).Err(); c.unavailable(ctx, "invalidate pattern", err) {
+			return unavailableError(err)
+		}
 	}
 	return nil
 }
 
+// Generation returns the current generation of a namespace. A namespace without one
+// starts at the current Unix time in milliseconds rather than zero, so deleting the
+// counter can never make entries written under an earlier generation visible again.
+func (c *RedisCache) Generation(ctx context.Context, namespace string) (int64, error) {
+	if !c.breaker.allow() {
+		return 0, ErrUnavailable
+	}
+
+	key := generationKey(namespace)
+	gen, err := c.client.Get(ctx, key).Int64()
+	if c.unavailable(ctx, "generation", err) {
+		return 0, unavailableError(err)
+	}
+	if err == nil {
+		return gen, nil
+	}
+
+	if err := c.client.SetNX(ctx, key, time.Now().UnixMilli(), 0).Err(); c.unavailable(ctx, "generation", err) {
+		return 0, unavailableError(err)
+	}
+	gen, err = c.client.Get(ctx, key).Int64()
+	if c.unavailable(ctx, "generation", err) {
+		return 0, unavailableError(err)
+	}
+	return gen, err
+}
+
+// InvalidateNamespace drops every entry in a namespace by moving it to a new generation.
+// Unlike InvalidatePattern, this is O(1) however many entries the namespace holds; the
+// old entries are left to expire.
+func (c *RedisCache) InvalidateNamespace(ctx context.Context, namespace string) error {
+	if !c.breaker.allow() {
+		return ErrUnavailable
+	}
+
+	key := generationKey(namespace)
+	_, err := c.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.SetNX(ctx, key, time.Now().UnixMilli(), 0)
+		pipe.Incr(ctx, key)
+		return nil
+	})
+	if c.unavailable(ctx, "invalidate namespace", err) {
+		return unavailableError(err)
+	}
+	return nil
+}
+
+// generationKey returns the key holding a namespace's generation counter.
+func generationKey(namespace string) string {
+	return namespace + ":gen"
+}
+
 // AcquireLock acquires a distributed lock.
 // Returns the lock ID if successful, or an error if the lock is held.
 func (c *RedisCache) AcquireLock(ctx context.Context, key string, ttl time.Duration) (string, error) {
 	lockID := fmt.Sprintf("%d-%s", time.Now().UnixNano(), randomString(9))
 	lockKey := "lock:" + key
 
+	if !c.breaker.allow() {
+		return "", ErrUnavailable
+	}
 	ok, err := c.client.SetNX(ctx, lockKey, lockID, ttl).Result()
-	if err != nil {
-		return "", err
+	if c.unavailable(ctx, "acquire lock", err) {
+		return "", unavailableError(err)
 	}
 	if !ok {
 		return "", fmt.Errorf("lock already held")
@@ -215,9 +325,12 @@ func (c *RedisCache) ReleaseLock(ctx context.Context, key string, lockID string)
 		end
 	`
 
+	if !c.breaker.allow() {
+		return ErrUnavailable
+	}
 	result, err := c.client.Eval(ctx, script, []string{lockKey}, lockID).Int()
-	if err != nil {
-		return err
+	if c.unavailable(ctx, "release lock", err) {
+		return unavailableError(err)
 	}
 	if result != 1 {
 		return fmt.Errorf("lock not held by this process")
@@ -290,6 +403,14 @@ func (c *NoOpCache) InvalidatePattern(ctx context.Context, pattern string) error
 	return nil
 }
 
+func (c *NoOpCache) Generation(ctx context.Context, namespace string) (int64, error) {
+	return 0, nil
+}
+
+func (c *NoOpCache) InvalidateNamespace(ctx context.Context, namespace string) error {
+	return nil
+}
+
 func (c *NoOpCache) AcquireLock(ctx context.Context, key string, ttl time.Duration) (string, error) {
 	return "no-op", nil
 }
@@ -0,0 +1,182 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/domain/tenant"
+)
+
+// recordingLogger keeps the warnings logged to it.
+type recordingLogger struct {
+	nopLogger
+	mu       sync.Mutex
+	warnings []string
+}
+
+func (l *recordingLogger) Warn(msg string, _ ...any) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.warnings = append(l.warnings, msg)
+}
+
+func (l *recordingLogger) With(...any) service.Logger { return l }
+
+func (l *recordingLogger) warned(msg string) int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	n := 0
+	for _, w := range l.warnings {
+		if strings.Contains(w, msg) {
+			n++
+		}
+	}
+	return n
+}
+
+// closedRedisCache returns a RedisCache whose client is closed, so every command fails
+// at once the way it would with Redis down, without waiting for a connection timeout.
+func closedRedisCache(t *testing.T) (*RedisCache, *recordingLogger) {
+	t.Helper()
+	client := redis.NewClient(&redis.Options{Addr: "127.0.0.1:1"})
+	if err := client.Close(); err != nil {
+		t.Fatalf("failed to close client: %v", err)
+	}
+	logger := &recordingLogger{}
+	return &RedisCache{client: client, defaultTTL: time.Minute, logger: logger}, logger
+}
+
+func TestRedisCacheDegradesWhenUnavailable(t *testing.T) {
+	c, _ := closedRedisCache(t)
+	ctx := context.Background()
+
+	var v string
+	entry, err := c.Get(ctx, "k", &v)
+	if entry != nil || err != nil {
+		t.Errorf("Get = %v, %v; want a miss", entry, err)
+	}
+	etag, err := c.Set(ctx, "k", "value", "", 0)
+	if etag != "" || err != nil {
+		t.Errorf("Set = %q, %v; want a no-op", etag, err)
+	}
+	etag, err = c.Set(ctx, "k", "value", `W/"1-2"`, 0)
+	if etag != "" || err != nil {
+		t.Errorf("Set with etag = %q, %v; want a no-op", etag, err)
+	}
+}
+
+func TestRedisCacheReportsUnavailableForOperationsThatCantDegrade(t *testing.T) {
+	ops := map[string]func(c *RedisCache, ctx context.Context) error{
+		"Delete":              func(c *RedisCache, ctx context.Context) error { return c.Delete(ctx, "k") },
+		"InvalidatePattern":   func(c *RedisCache, ctx context.Context) error { return c.InvalidatePattern(ctx, "k:*") },
+		"InvalidateNamespace": func(c *RedisCache, ctx context.Context) error { return c.InvalidateNamespace(ctx, "courses") },
+		"Generation": func(c *RedisCache, ctx context.Context) error {
+			_, err := c.Generation(ctx, "courses")
+			return err
+		},
+		"AcquireLock": func(c *RedisCache, ctx context.Context) error {
+			_, err := c.AcquireLock(ctx, "k", time.Second)
+			return err
+		},
+		"ReleaseLock": func(c *RedisCache, ctx context.Context) error { return c.ReleaseLock(ctx, "k", "id") },
+	}
+
+	for name, op := range ops {
+		t.Run(name, func(t *testing.T) {
+			c, _ := closedRedisCache(t)
+			// Before and after the breaker opens
+			for i := range breakerThreshold + 1 {
+				if err := op(c, context.Background()); !errors.Is(err, ErrUnavailable) {
+					t.Fatalf("call %d: error = %v, want ErrUnavailable", i+1, err)
+				}
+			}
+		})
+	}
+}
+
+func TestRedisCacheBreakerOpensAfterRepeatedFailures(t *testing.T) {
+	c, logger := closedRedisCache(t)
+	ctx := context.Background()
+
+	var v string
+	for i := range breakerThreshold - 1 {
+		c.Get(ctx, "k", &v)
+		if !c.breaker.allow() {
+			t.Fatalf("breaker opened after %d failures, want %d", i+1, breakerThreshold)
+		}
+	}
+	c.Get(ctx, "k", &v)
+	if c.breaker.allow() {
+		t.Fatalf("breaker still closed after %d failures", breakerThreshold)
+	}
+	if logger.warned("bypassing cache") != 1 {
+		t.Errorf("warnings = %v, want one about bypassing the cache", logger.warnings)
+	}
+
+	// While open, Redis isn't called and nothing more is logged
+	warnings := len(logger.warnings)
+	for range 10 {
+		if entry, err := c.Get(ctx, "k", &v); entry != nil || err != nil {
+			t.Fatalf("Get with breaker open = %v, %v; want a miss", entry, err)
+		}
+	}
+	if len(logger.warnings) != warnings {
+		t.Errorf("logged %d more warnings while the breaker was open", len(logger.warnings)-warnings)
+	}
+
+	// After the cooldown one call is let through, and one failure opens it again
+	c.breaker.mu.Lock()
+	c.breaker.openUntil = time.Now().Add(-time.Second)
+	c.breaker.mu.Unlock()
+	if !c.breaker.allow() {
+		t.Fatal("breaker still open after the cooldown")
+	}
+	c.Get(ctx, "k", &v)
+	if c.breaker.allow() {
+		t.Error("breaker closed after a failure following the cooldown")
+	}
+}
+
+func TestRedisCacheIgnoresCancelledCalls(t *testing.T) {
+	c, logger := closedRedisCache(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var v string
+	for range breakerThreshold * 2 {
+		if entry, err := c.Get(ctx, "k", &v); entry != nil || err != nil {
+			t.Fatalf("Get = %v, %v; want a miss", entry, err)
+		}
+	}
+	if !c.breaker.allow() || len(logger.warnings) != 0 {
+		t.Errorf("calls the caller gave up on opened the breaker or logged %v", logger.warnings)
+	}
+}
+
+func TestTenantCacheDegradesWhenRedisUnavailable(t *testing.T) {
+	c, _ := closedRedisCache(t)
+	tc := NewTenantCache(c)
+	ctx := tenant.WithTenantID(context.Background(), uuid.New())
+
+	// Versioned keys need the namespace generation first, which fails too
+	for _, key := range []string{TenantCacheKeys.AllCourses(), TenantCacheKeys.Library()} {
+		var v []string
+		if entry, err := tc.Get(ctx, key, &v); entry != nil || err != nil {
+			t.Errorf("Get(%s) = %v, %v; want a miss", key, entry, err)
+		}
+		if etag, err := tc.Set(ctx, key, []string{"a"}, "", 0); etag != "" || err != nil {
+			t.Errorf("Set(%s) = %q, %v; want a no-op", key, etag, err)
+		}
+	}
+	if err := tc.InvalidateNamespace(ctx, NamespaceCourses); !errors.Is(err, ErrUnavailable) {
+		t.Errorf("InvalidateNamespace = %v, want ErrUnavailable", err)
+	}
+}
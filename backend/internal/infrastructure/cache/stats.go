@@ -0,0 +1,316 @@
+package cache
+
+import (
+	"context"
+	"expvar"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/service"
+)
+
+// Cache scopes, the wrapper a key was used through.
+const (
+	ScopeTenant = "tenant"
+	ScopeGlobal = "global"
+)
+
+// latencyBuckets are the upper bounds of the cache latency histogram buckets. Anything
+// slower lands in a last, unbounded bucket.
+var latencyBuckets = []time.Duration{
+	500 * time.Microsecond,
+	time.Millisecond,
+	2 * time.Millisecond,
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	25 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	time.Second,
+}
+
+// HitRateLogInterval is how often RunHitRateLog logs tenant hit rates.
+const HitRateLogInterval = 10 * time.Minute
+
+// globalNamespaces names the GlobalCacheKeys namespaces, matched by key prefix.
+var globalNamespaces = []struct{ prefix, name string }{
+	{"user:tenant:", "user-tenant-mapping"},
+	{"system:maintenance", "maintenance"},
+	{"system:jobs:", "job-outcomes"},
+}
+
+// stats counts the reads and writes made through TenantCache and GlobalCache in this
+// process. It is published on /debug/vars as "cache".
+var stats = newCacheStats()
+
+func init() {
+	expvar.Publish("cache", expvar.Func(func() any { return Snapshot() }))
+}
+
+// cacheStats counts cache operations by scope and key namespace, and tenant cache reads
+// by tenant since the last hit rate summary.
+type cacheStats struct {
+	mu         sync.Mutex
+	since      time.Time
+	namespaces map[namespaceKey]*namespaceCounts
+	tenants    map[uuid.UUID]*hitCounts
+}
+
+type namespaceKey struct {
+	scope, namespace string
+}
+
+type namespaceCounts struct {
+	hitCounts
+	errors     int64
+	writes     int64
+	getLatency latencyHistogram
+	setLatency latencyHistogram
+}
+
+type hitCounts struct {
+	hits, misses int64
+}
+
+// latencyHistogram counts operations into latencyBuckets.
+type latencyHistogram struct {
+	counts []int64 // One per bucket, plus the unbounded bucket
+	total  time.Duration
+	n      int64
+}
+
+func newCacheStats() *cacheStats {
+	return &cacheStats{
+		since:      time.Now(),
+		namespaces: make(map[namespaceKey]*namespaceCounts),
+		tenants:    make(map[uuid.UUID]*hitCounts),
+	}
+}
+
+// namespace returns the counters for a namespace, creating them. Callers hold mu.
+func (s *cacheStats) namespace(scope, namespace string) *namespaceCounts {
+	k := namespaceKey{scope, namespace}
+	c, ok := s.namespaces[k]
+	if !ok {
+		c = &namespaceCounts{}
+		s.namespaces[k] = c
+	}
+	return c
+}
+
+// recordGet counts a read. A read that failed counts as an error, not a miss. tenantID
+// is uuid.Nil for global reads.
+func (s *cacheStats) recordGet(scope, namespace string, tenantID uuid.UUID, elapsed time.Duration, hit bool, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c := s.namespace(scope, namespace)
+	c.getLatency.observe(elapsed)
+	if err != nil {
+		c.errors++
+		return
+	}
+
+	var t *hitCounts
+	if tenantID != uuid.Nil {
+		if t = s.tenants[tenantID]; t == nil {
+			t = &hitCounts{}
+			s.tenants[tenantID] = t
+		}
+	}
+	if hit {
+		c.hits++
+		if t != nil {
+			t.hits++
+		}
+	} else {
+		c.misses++
+		if t != nil {
+			t.misses++
+		}
+	}
+}
+
+// recordSet counts a write.
+func (s *cacheStats) recordSet(scope, namespace string, elapsed time.Duration, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c := s.namespace(scope, namespace)
+	c.setLatency.observe(elapsed)
+	c.writes++
+	if err != nil {
+		c.errors++
+	}
+}
+
+// takeTenantHits returns the tenant reads counted since the last call and starts over.
+func (s *cacheStats) takeTenantHits() map[uuid.UUID]*hitCounts {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	tenants := s.tenants
+	s.tenants = make(map[uuid.UUID]*hitCounts)
+	return tenants
+}
+
+func (h *latencyHistogram) observe(d time.Duration) {
+	if h.counts == nil {
+		h.counts = make([]int64, len(latencyBuckets)+1)
+	}
+	i := sort.Search(len(latencyBuckets), func(i int) bool { return d <= latencyBuckets[i] })
+	h.counts[i]++
+	h.total += d
+	h.n++
+}
+
+// LatencySummary summarizes a latency histogram.
+type LatencySummary struct {
+	Count   int64            `json:"count"`
+	Mean    time.Duration    `json:"mean"`
+	P95     time.Duration    `json:"p95"`     // Upper bound of the bucket holding the 95th percentile; -1 if beyond the last bound
+	Buckets map[string]int64 `json:"buckets"` // Operations per bucket, keyed by upper bound ("+Inf" for the last)
+}
+
+func (h *latencyHistogram) summary() LatencySummary {
+	sum := LatencySummary{Count: h.n, Buckets: make(map[string]int64, len(h.counts))}
+	if h.n == 0 {
+		return sum
+	}
+	sum.Mean = h.total / time.Duration(h.n)
+
+	target := (h.n*95 + 99) / 100 // Rounded up
+	var seen int64
+	found := false
+	sum.P95 = -1
+	for i, n := range h.counts {
+		label := "+Inf"
+		if i < len(latencyBuckets) {
+			label = latencyBuckets[i].String()
+		}
+		sum.Buckets[label] = n
+
+		seen += n
+		if !found && seen >= target {
+			found = true
+			if i < len(latencyBuckets) {
+				sum.P95 = latencyBuckets[i]
+			}
+		}
+	}
+	return sum
+}
+
+// NamespaceStats counts the operations on one key namespace since the process started.
+type NamespaceStats struct {
+	Scope      string         `json:"scope"`
+	Namespace  string         `json:"namespace"`
+	Hits       int64          `json:"hits"`
+	Misses     int64          `json:"misses"` // Includes reads skipped while Redis is unavailable
+	Errors     int64          `json:"errors"`
+	Writes     int64          `json:"writes"`
+	HitRate    float64        `json:"hitRate"` // Hits over hits and misses; 0 without reads
+	GetLatency LatencySummary `json:"getLatency"`
+	SetLatency LatencySummary `json:"setLatency"`
+}
+
+// StatsSnapshot is the cache's hit rates since the process started. Each server process
+// counts its own operations.
+type StatsSnapshot struct {
+	Since      time.Time        `json:"since"`
+	Hits       int64            `json:"hits"`
+	Misses     int64            `json:"misses"`
+	Errors     int64            `json:"errors"`
+	HitRate    float64          `json:"hitRate"`
+	Namespaces []NamespaceStats `json:"namespaces"` // Sorted by scope, then namespace
+}
+
+// Snapshot returns the operations counted through TenantCache and GlobalCache so far.
+func Snapshot() StatsSnapshot {
+	return stats.snapshot()
+}
+
+func (s *cacheStats) snapshot() StatsSnapshot {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	snap := StatsSnapshot{Since: s.since, Namespaces: make([]NamespaceStats, 0, len(s.namespaces))}
+	for k, c := range s.namespaces {
+		snap.Hits += c.hits
+		snap.Misses += c.misses
+		snap.Errors += c.errors
+		snap.Namespaces = append(snap.Namespaces, NamespaceStats{
+			Scope:      k.scope,
+			Namespace:  k.namespace,
+			Hits:       c.hits,
+			Misses:     c.misses,
+			Errors:     c.errors,
+			Writes:     c.writes,
+			HitRate:    hitRate(c.hits, c.misses),
+			GetLatency: c.getLatency.summary(),
+			SetLatency: c.setLatency.summary(),
+		})
+	}
+	snap.HitRate = hitRate(snap.Hits, snap.Misses)
+	sort.Slice(snap.Namespaces, func(i, j int) bool {
+		a, b := snap.Namespaces[i], snap.Namespaces[j]
+		if a.Scope != b.Scope {
+			return a.Scope < b.Scope
+		}
+		return a.Namespace < b.Namespace
+	})
+	return snap
+}
+
+func hitRate(hits, misses int64) float64 {
+	if hits+misses == 0 {
+		return 0
+	}
+	return float64(hits) / float64(hits+misses)
+}
+
+// tenantNamespace returns the namespace of a TenantCacheKeys key: its first segment,
+// e.g. "courses" for "courses:all".
+func tenantNamespace(key string) string {
+	namespace, _, _ := strings.Cut(key, ":")
+	return namespace
+}
+
+// globalNamespace returns the namespace of a GlobalCacheKeys key.
+func globalNamespace(key string) string {
+	for _, ns := range globalNamespaces {
+		if strings.HasPrefix(key, ns.prefix) {
+			return ns.name
+		}
+	}
+	namespace, _, _ := strings.Cut(key, ":")
+	return namespace
+}
+
+// RunHitRateLog logs each tenant's cache hit rate over the last interval at debug level,
+// for tenants that read from the cache. This should be called as a goroutine.
+func RunHitRateLog(ctx context.Context, logger service.Logger, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for tenantID, t := range stats.takeTenantHits() {
+				logger.Debug("tenant cache hit rate",
+					"tenantID", tenantID,
+					"interval", interval,
+					"hits", t.hits,
+					"misses", t.misses,
+					"hitRate", hitRate(t.hits, t.misses),
+				)
+			}
+		}
+	}
+}
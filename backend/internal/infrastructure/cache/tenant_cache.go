@@ -2,7 +2,10 @@ package cache
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -20,8 +23,14 @@ import (
 //
 // Example:
 //
+//	Input key:  "library:index"
+//	Output key: "tenant:40010dd3-3332-4fbd-b217-3bd5d28968f6:library:index"
+//
+// Keys in a versioned namespace (see VersionedNamespaces) also carry the namespace's
+// current generation, so the whole namespace can be invalidated with one INCR:
+//
 //	Input key:  "courses:all"
-//	Output key: "tenant:40010dd3-3332-4fbd-b217-3bd5d28968f6:courses:all"
+//	Output key: "tenant:40010dd3-3332-4fbd-b217-3bd5d28968f6:courses:g1739450000123:all"
 type TenantCache struct {
 	inner Cache
 }
@@ -42,6 +51,22 @@ func (c *TenantCache) keyWithTenant(ctx context.Context, key string) string {
 	return fmt.Sprintf("tenant:%s:%s", tenantID.String(), key)
 }
 
+// versionedKey prefixes the key with the tenant ID and, if the key belongs to a versioned
+// namespace, the namespace's current generation.
+func (c *TenantCache) versionedKey(ctx context.Context, key string) (string, error) {
+	secureKey := c.keyWithTenant(ctx, key)
+	namespace, rest, ok := strings.Cut(key, ":")
+	if !ok || !VersionedNamespaces[namespace] {
+		return secureKey, nil
+	}
+
+	gen, err := c.inner.Generation(ctx, c.keyWithTenant(ctx, namespace))
+	if err != nil {
+		return "", err
+	}
+	return c.keyWithTenant(ctx, namespace+":g"+strconv.FormatInt(gen, 10)+":"+rest), nil
+}
+
 // patternWithTenant prefixes a pattern with the tenant ID from context.
 // Used for InvalidatePattern operations.
 func (c *TenantCache) patternWithTenant(ctx context.Context, pattern string) string {
@@ -52,31 +77,80 @@ func (c *TenantCache) patternWithTenant(ctx context.Context, pattern string) str
 	return fmt.Sprintf("tenant:%s:%s", tenantID.String(), pattern)
 }
 
-// Get retrieves a cached value with tenant isolation.
+// Get retrieves a cached value with tenant isolation. Like the underlying cache, it
+// reports a miss if the namespace generation can't be read. Reads are counted in the
+// cache stats by namespace and tenant.
 func (c *TenantCache) Get(ctx context.Context, key string, v interface{}) (*CacheEntry, error) {
-	secureKey := c.keyWithTenant(ctx, key)
+	start := time.Now()
+	entry, err := c.get(ctx, key, v)
+	tenantID, _ := tenant.FromContext(ctx)
+	stats.recordGet(ScopeTenant, tenantNamespace(key), tenantID, time.Since(start), entry != nil, err)
+
+	if errors.Is(err, ErrUnavailable) {
+		return nil, nil
+	}
+	return entry, err
+}
+
+func (c *TenantCache) get(ctx context.Context, key string, v interface{}) (*CacheEntry, error) {
+	secureKey, err := c.versionedKey(ctx, key)
+	if err != nil {
+		return nil, err
+	}
 	return c.inner.Get(ctx, secureKey, v)
 }
 
-// Set stores a value in cache with tenant isolation.
+// Set stores a value in cache with tenant isolation. Nothing is stored if the namespace
+// generation can't be read.
 func (c *TenantCache) Set(ctx context.Context, key string, v interface{}, etag string, ttl time.Duration) (string, error) {
-	secureKey := c.keyWithTenant(ctx, key)
+	start := time.Now()
+	newETag, err := c.set(ctx, key, v, etag, ttl)
+	stats.recordSet(ScopeTenant, tenantNamespace(key), time.Since(start), err)
+
+	if errors.Is(err, ErrUnavailable) {
+		return "", nil
+	}
+	return newETag, err
+}
+
+func (c *TenantCache) set(ctx context.Context, key string, v interface{}, etag string, ttl time.Duration) (string, error) {
+	secureKey, err := c.versionedKey(ctx, key)
+	if err != nil {
+		return "", err
+	}
 	return c.inner.Set(ctx, secureKey, v, etag, ttl)
 }
 
 // Delete removes a cached value with tenant isolation.
 func (c *TenantCache) Delete(ctx context.Context, key string) error {
-	secureKey := c.keyWithTenant(ctx, key)
+	secureKey, err := c.versionedKey(ctx, key)
+	if err != nil {
+		return err
+	}
 	return c.inner.Delete(ctx, secureKey)
 }
 
 // InvalidatePattern removes all keys matching a pattern within the tenant's namespace.
 // Example: InvalidatePattern(ctx, "courses:*") invalidates tenant:{id}:courses:*
+//
+// This scans the keyspace; prefer InvalidateNamespace for versioned namespaces.
 func (c *TenantCache) InvalidatePattern(ctx context.Context, pattern string) error {
 	securePattern := c.patternWithTenant(ctx, pattern)
 	return c.inner.InvalidatePattern(ctx, securePattern)
 }
 
+// Generation returns the current generation of one of the tenant's namespaces.
+func (c *TenantCache) Generation(ctx context.Context, namespace string) (int64, error) {
+	return c.inner.Generation(ctx, c.keyWithTenant(ctx, namespace))
+}
+
+// InvalidateNamespace invalidates every key in one of the tenant's versioned namespaces
+// in O(1) by moving it to a new generation.
+// Example: InvalidateNamespace(ctx, NamespaceCourses) invalidates every "courses:..." key
+func (c *TenantCache) InvalidateNamespace(ctx context.Context, namespace string) error {
+	return c.inner.InvalidateNamespace(ctx, c.keyWithTenant(ctx, namespace))
+}
+
 // AcquireLock acquires a distributed lock with tenant isolation.
 func (c *TenantCache) AcquireLock(ctx context.Context, key string, ttl time.Duration) (string, error) {
 	secureKey := c.keyWithTenant(ctx, key)
@@ -89,6 +163,23 @@ func (c *TenantCache) ReleaseLock(ctx context.Context, key string, lockID string
 	return c.inner.ReleaseLock(ctx, secureKey, lockID)
 }
 
+// Versioned cache namespaces. Keys are in a namespace when their first segment is its
+// name, e.g. TenantCacheKeys.AllCourses() is in NamespaceCourses.
+const (
+	// NamespaceCourses holds the course listings, invalidated on every course write.
+	NamespaceCourses = "courses"
+
+	// NamespaceFolderCourses holds the per-folder course listings.
+	NamespaceFolderCourses = "folder"
+)
+
+// VersionedNamespaces are the namespaces TenantCache composes keys for with a
+// generation number.
+var VersionedNamespaces = map[string]bool{
+	NamespaceCourses:       true,
+	NamespaceFolderCourses: true,
+}
+
 // TenantCacheKeys provides standardized cache key generators.
 // These keys are designed to be used with TenantCache, which will
 // automatically prefix them with tenant:{id}:.
@@ -96,23 +187,43 @@ func (c *TenantCache) ReleaseLock(ctx context.Context, key string, lockID string
 // Usage:
 //
 //	key := TenantCacheKeys.AllCourses()  // Returns "courses:all"
-//	cache.Get(ctx, key, &courses)        // Actually queries "tenant:{id}:courses:all"
+//	cache.Get(ctx, key, &courses)        // Actually queries "tenant:{id}:courses:g{gen}:all"
 var TenantCacheKeys = struct {
 	Library         func() string
 	Folders         func() string
 	Course          func(id string) string
+	CourseStats     func(id string) string
+	CoursePlayer    func(id string) string
+	CourseSearch    func(id string, modifiedAt int64) string
+	PublishedPlayer func(id string, version int32) string
 	FolderCourses   func(folderID string) string
 	AllCourses      func() string
 	CoursesByStatus func(status string) string
 	CoursesByTag    func(tag string) string
+	SMEStats        func() string
+	SMEKeywords     func(id, version string) string
+	QueueStatus     func(userID string) string
+	PromptResult    func(hash string) string
+	Onboarding      func() string
+	TeamDashboard   func(teamID string) string
 }{
 	Library:         func() string { return "library:index" },
 	Folders:         func() string { return "folders:hierarchy" },
 	Course:          func(id string) string { return "course:" + id },
+	CourseStats:     func(id string) string { return "course:" + id + ":stats" },
+	CoursePlayer:    func(id string) string { return "course:" + id + ":player" },
+	CourseSearch:    func(id string, modifiedAt int64) string { return fmt.Sprintf("course:%s:search:%d", id, modifiedAt) },
+	PublishedPlayer: func(id string, version int32) string { return fmt.Sprintf("course:%s:player:v%d", id, version) },
 	FolderCourses:   func(folderID string) string { return "folder:" + folderID + ":courses" },
 	AllCourses:      func() string { return "courses:all" },
 	CoursesByStatus: func(status string) string { return "courses:status:" + status },
 	CoursesByTag:    func(tag string) string { return "courses:tag:" + tag },
+	SMEStats:        func() string { return "sme:stats" },
+	SMEKeywords:     func(id, version string) string { return "sme:" + id + ":keywords:" + version },
+	QueueStatus:     func(userID string) string { return "queue:status:" + userID },
+	PromptResult:    func(hash string) string { return "prompt:" + hash },
+	Onboarding:      func() string { return "onboarding:progress" },
+	TeamDashboard:   func(teamID string) string { return "team:" + teamID + ":dashboard" },
 }
 
 // GlobalCache provides access to cache operations that are NOT tenant-scoped.
@@ -131,12 +242,18 @@ func NewGlobalCache(inner Cache) *GlobalCache {
 
 // Get retrieves a cached value (no tenant prefix).
 func (c *GlobalCache) Get(ctx context.Context, key string, v interface{}) (*CacheEntry, error) {
-	return c.inner.Get(ctx, key, v)
+	start := time.Now()
+	entry, err := c.inner.Get(ctx, key, v)
+	stats.recordGet(ScopeGlobal, globalNamespace(key), uuid.Nil, time.Since(start), entry != nil, err)
+	return entry, err
 }
 
 // Set stores a value in cache (no tenant prefix).
 func (c *GlobalCache) Set(ctx context.Context, key string, v interface{}, etag string, ttl time.Duration) (string, error) {
-	return c.inner.Set(ctx, key, v, etag, ttl)
+	start := time.Now()
+	newETag, err := c.inner.Set(ctx, key, v, etag, ttl)
+	stats.recordSet(ScopeGlobal, globalNamespace(key), time.Since(start), err)
+	return newETag, err
 }
 
 // Delete removes a cached value (no tenant prefix).
@@ -149,6 +266,16 @@ func (c *GlobalCache) InvalidatePattern(ctx context.Context, pattern string) err
 	return c.inner.InvalidatePattern(ctx, pattern)
 }
 
+// Generation returns the current generation of a namespace (no tenant prefix).
+func (c *GlobalCache) Generation(ctx context.Context, namespace string) (int64, error) {
+	return c.inner.Generation(ctx, namespace)
+}
+
+// InvalidateNamespace moves a namespace to a new generation (no tenant prefix).
+func (c *GlobalCache) InvalidateNamespace(ctx context.Context, namespace string) error {
+	return c.inner.InvalidateNamespace(ctx, namespace)
+}
+
 // AcquireLock acquires a distributed lock (no tenant prefix).
 func (c *GlobalCache) AcquireLock(ctx context.Context, key string, ttl time.Duration) (string, error) {
 	return c.inner.AcquireLock(ctx, key, ttl)
@@ -163,6 +290,10 @@ func (c *GlobalCache) ReleaseLock(ctx context.Context, key string, lockID string
 // These keys are NOT tenant-scoped and should only be used for cross-tenant mappings.
 var GlobalCacheKeys = struct {
 	UserTenantMapping func(kratosID string) string
+	MaintenanceMode   func() string
+	JobOutcomeStats   func() string
 }{
 	UserTenantMapping: func(kratosID string) string { return "user:tenant:" + kratosID },
+	MaintenanceMode:   func() string { return "system:maintenance" },
+	JobOutcomeStats:   func() string { return "system:jobs:outcomes" },
 }
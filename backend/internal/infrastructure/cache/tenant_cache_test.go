@@ -0,0 +1,251 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/domain/tenant"
+)
+
+// testRedisURLEnv names a Redis the benchmarks also run against. They write under
+// random tenant IDs, but it should still be a Redis meant for testing.
+const testRedisURLEnv = "MIRAI_TEST_REDIS_URL"
+
+// memoryCache is an in-process Cache, so the benchmarks measure TenantCache rather than
+// the network.
+type memoryCache struct {
+	mu      sync.Mutex
+	entries map[string][]byte
+	gens    map[string]int64
+}
+
+func newMemoryCache() *memoryCache {
+	return &memoryCache{entries: make(map[string][]byte), gens: make(map[string]int64)}
+}
+
+func (c *memoryCache) Get(_ context.Context, key string, v interface{}) (*CacheEntry, error) {
+	c.mu.Lock()
+	data, ok := c.entries[key]
+	c.mu.Unlock()
+	if !ok {
+		return nil, nil
+	}
+	var entry CacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, err
+	}
+	return &entry, json.Unmarshal(entry.Data, v)
+}
+
+func (c *memoryCache) Set(_ context.Context, key string, v interface{}, _ string, _ time.Duration) (string, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	entry, err := json.Marshal(CacheEntry{Data: data, ETag: generateETag(data), Timestamp: time.Now().UnixMilli(), Version: 1})
+	if err != nil {
+		return "", err
+	}
+	c.mu.Lock()
+	c.entries[key] = entry
+	c.mu.Unlock()
+	return "", nil
+}
+
+func (c *memoryCache) Delete(_ context.Context, key string) error {
+	c.mu.Lock()
+	delete(c.entries, key)
+	c.mu.Unlock()
+	return nil
+}
+
+// InvalidatePattern walks every key, as a Redis SCAN does.
+func (c *memoryCache) InvalidatePattern(_ context.Context, pattern string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key := range c.entries {
+		if ok, _ := path.Match(pattern, key); ok {
+			delete(c.entries, key)
+		}
+	}
+	return nil
+}
+
+func (c *memoryCache) Generation(_ context.Context, namespace string) (int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.gens[namespace]; !ok {
+		c.gens[namespace] = time.Now().UnixMilli()
+	}
+	return c.gens[namespace], nil
+}
+
+func (c *memoryCache) InvalidateNamespace(ctx context.Context, namespace string) error {
+	if _, err := c.Generation(ctx, namespace); err != nil {
+		return err
+	}
+	c.mu.Lock()
+	c.gens[namespace]++
+	c.mu.Unlock()
+	return nil
+}
+
+func (c *memoryCache) AcquireLock(context.Context, string, time.Duration) (string, error) {
+	return "lock", nil
+}
+
+func (c *memoryCache) ReleaseLock(context.Context, string, string) error {
+	return nil
+}
+
+type nopLogger struct{}
+
+func (nopLogger) Debug(string, ...any)                         {}
+func (nopLogger) Info(string, ...any)                          {}
+func (nopLogger) Warn(string, ...any)                          {}
+func (nopLogger) Error(string, ...any)                         {}
+func (l nopLogger) With(...any) service.Logger                 { return l }
+func (l nopLogger) WithContext(context.Context) service.Logger { return l }
+
+// benchCaches returns the caches to benchmark: always memory, and Redis when
+// testRedisURLEnv is set.
+func benchCaches(b *testing.B) map[string]Cache {
+	b.Helper()
+	caches := map[string]Cache{"memory": newMemoryCache()}
+	if url := os.Getenv(testRedisURLEnv); url != "" {
+		redisCache, err := NewRedisCache(RedisConfig{URL: url}, nopLogger{})
+		if err != nil {
+			b.Fatalf("failed to connect to test redis: %v", err)
+		}
+		b.Cleanup(func() { redisCache.Close() })
+		caches["redis"] = redisCache
+	}
+	return caches
+}
+
+// testCourses is a course listing of a realistic size.
+type testCourses []struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+}
+
+func newTestCourses(n int) testCourses {
+	courses := make(testCourses, n)
+	for i := range courses {
+		courses[i].ID = uuid.NewString()
+		courses[i].Title = "Course " + strconv.Itoa(i)
+	}
+	return courses
+}
+
+func TestTenantCacheInvalidateNamespace(t *testing.T) {
+	inner := newMemoryCache()
+	c := NewTenantCache(inner)
+	ctx := tenant.WithTenantID(context.Background(), uuid.New())
+	otherCtx := tenant.WithTenantID(context.Background(), uuid.New())
+
+	courses := newTestCourses(3)
+	for _, ctx := range []context.Context{ctx, otherCtx} {
+		for _, key := range []string{TenantCacheKeys.AllCourses(), TenantCacheKeys.CoursesByStatus("draft"), TenantCacheKeys.Library()} {
+			if _, err := c.Set(ctx, key, courses, "", 0); err != nil {
+				t.Fatalf("set %s: %v", key, err)
+			}
+		}
+	}
+
+	if err := c.InvalidateNamespace(ctx, NamespaceCourses); err != nil {
+		t.Fatalf("invalidate: %v", err)
+	}
+
+	var got testCourses
+	for _, key := range []string{TenantCacheKeys.AllCourses(), TenantCacheKeys.CoursesByStatus("draft")} {
+		if entry, _ := c.Get(ctx, key, &got); entry != nil {
+			t.Errorf("%s still cached after invalidating its namespace", key)
+		}
+		if entry, _ := c.Get(otherCtx, key, &got); entry == nil {
+			t.Errorf("%s of another tenant was invalidated", key)
+		}
+	}
+	if entry, _ := c.Get(ctx, TenantCacheKeys.Library(), &got); entry == nil {
+		t.Error("key outside the namespace was invalidated")
+	}
+}
+
+// BenchmarkTenantCacheGet compares reads of a plain key with reads of a key in a
+// versioned namespace, which also read the namespace's generation.
+func BenchmarkTenantCacheGet(b *testing.B) {
+	for name, inner := range benchCaches(b) {
+		c := NewTenantCache(inner)
+		ctx := tenant.WithTenantID(context.Background(), uuid.New())
+		courses := newTestCourses(50)
+
+		for _, key := range []string{TenantCacheKeys.Library(), TenantCacheKeys.AllCourses()} {
+			if _, err := c.Set(ctx, key, courses, "", time.Minute); err != nil {
+				b.Fatalf("set %s: %v", key, err)
+			}
+		}
+		b.Run(name+"/unversioned", func(b *testing.B) {
+			var got testCourses
+			for b.Loop() {
+				if entry, err := c.Get(ctx, TenantCacheKeys.Library(), &got); err != nil || entry == nil {
+					b.Fatalf("get = %v, %v", entry, err)
+				}
+			}
+		})
+		b.Run(name+"/versioned", func(b *testing.B) {
+			var got testCourses
+			for b.Loop() {
+				if entry, err := c.Get(ctx, TenantCacheKeys.AllCourses(), &got); err != nil || entry == nil {
+					b.Fatalf("get = %v, %v", entry, err)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkTenantCacheInvalidate compares invalidating the course listings by namespace
+// generation with invalidating them by pattern, as the tenant's cache grows.
+func BenchmarkTenantCacheInvalidate(b *testing.B) {
+	for name, inner := range benchCaches(b) {
+		for _, keys := range []int{10, 100, 1000} {
+			c := NewTenantCache(inner)
+			ctx := tenant.WithTenantID(context.Background(), uuid.New())
+			fill := func(b *testing.B) {
+				for i := range keys {
+					if _, err := c.Set(ctx, TenantCacheKeys.CoursesByTag(strconv.Itoa(i)), i, "", time.Minute); err != nil {
+						b.Fatalf("set: %v", err)
+					}
+				}
+			}
+
+			b.Run(fmt.Sprintf("%s/namespace/%d", name, keys), func(b *testing.B) {
+				fill(b)
+				for b.Loop() {
+					if err := c.InvalidateNamespace(ctx, NamespaceCourses); err != nil {
+						b.Fatalf("invalidate: %v", err)
+					}
+				}
+			})
+			b.Run(fmt.Sprintf("%s/pattern/%d", name, keys), func(b *testing.B) {
+				for b.Loop() {
+					b.StopTimer()
+					fill(b)
+					b.StartTimer()
+					if err := c.InvalidatePattern(ctx, NamespaceCourses+":*"); err != nil {
+						b.Fatalf("invalidate: %v", err)
+					}
+				}
+			})
+		}
+	}
+}
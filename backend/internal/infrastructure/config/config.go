@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 )
 
 // Config holds application configuration.
@@ -12,6 +13,20 @@ type Config struct {
 	Port      string
 	EnableH2C bool // Enable HTTP/2 cleartext for local dev (Envoy upstream)
 
+	// Request size limits, in bytes
+	RequestMaxBytes       int // Default per-RPC request limit (default: 4 MiB)
+	RequestMaxBytesLarge  int // RPCs that carry whole courses or documents (default: 32 MiB)
+	RequestMaxBytesSmall  int // Lookup and delete RPCs (default: 64 KiB)
+	MaxInlineDataURIBytes int // Largest data: URI allowed inside course content (default: 256 KiB)
+
+	// Course content size, in bytes of stored JSON; 0 disables the limit
+	CourseContentSoftLimitBytes int // Saves above this succeed with a warning (default: 10 MiB)
+	CourseContentHardLimitBytes int // Saves above this are rejected (default: 25 MiB)
+
+	// Outline generation free-text inputs, in characters; 0 disables the limit
+	GenerationMaxDesiredOutcomeChars    int // default: 1,000
+	GenerationMaxAdditionalContextChars int // default: 10,000
+
 	// Database
 	DatabaseURL string
 
@@ -30,6 +45,7 @@ type Config struct {
 
 	// URLs
 	FrontendURL  string
+	FrontendRoutePrefix string // Base path the frontend is served under, e.g. "/app"; links to its pages start with it
 	MarketingURL string // Marketing site URL for checkout success redirects
 	BackendURL   string
 	CookieDomain string // Domain for session cookies (e.g., ".sogos.io" for cross-subdomain)
@@ -43,6 +59,20 @@ type Config struct {
 	S3AccessKey string
 	S3SecretKey string
 
+	// Storage regions: tenants choose one at signup and their objects stay in its bucket.
+	// The S3_* settings above are the primary region, which existing tenants use.
+	StoragePrimaryRegion string                    // Name of the primary region (default: "us")
+	StorageRegions       map[string]S3BucketConfig // Additional regions by name, from STORAGE_REGIONS
+
+	// Storage lifecycle: default retention class per object type ("standard" or
+	// "infrequent-access"). Objects are tagged with their class on write.
+	StorageRetentionCourseContent string
+	StorageRetentionExport        string
+	StorageRetentionSubmission    string
+	StorageRetentionThumbnail     string
+	StorageApplyLifecycleRules    bool // Install the bucket lifecycle rule at startup (AWS S3 only)
+	StorageIATransitionDays       int  // Days before infrequent-access objects move to STANDARD_IA
+
 	// Cache
 	EnableRedisCache bool
 	RedisURL         string
@@ -55,11 +85,35 @@ type Config struct {
 	SMTPPassword string
 	AdminEmail   string // Email address for system alerts (e.g., orphaned payments)
 
+	// Operations
+	SuperAdminEmails []string // Emails allowed to toggle maintenance mode
+
 	// Encryption
 	EncryptionKey string // 32-byte hex-encoded key for AES-256-GCM (API keys, etc.)
+	StorageEncryptionEnabled bool // Write course content encrypted with per-tenant keys wrapped by EncryptionKey
+
+	// AI provider
+	AIProvider           string   // "gemini" (per-tenant API keys) or "fake" (deterministic, for local development)
+	FakeAILatencyMS      int      // Base latency of fake provider calls in milliseconds
+	FakeAIFailurePercent int      // Share of fake provider calls that fail (0-100)
+	FakeAIFailOperations []string // Fake provider operations that always fail (outline, lesson, regenerate, sme_processing, suggestions)
 
 	// Worker
 	StaleJobTimeoutMinutes int // Timeout in minutes before a processing job is considered stale (default: 30)
+
+	// SME
+	SMEMinKnowledgeChunks int // Active SMEs with fewer chunks are flagged as low coverage (default: 5)
+}
+
+// S3BucketConfig is the bucket of one additional storage region. Credentials left empty
+// fall back to the primary region's.
+type S3BucketConfig struct {
+	Endpoint  string
+	Region    string
+	Bucket    string
+	BasePath  string
+	AccessKey string
+	SecretKey string
 }
 
 // Load loads configuration from environment variables.
@@ -69,9 +123,25 @@ func Load() (*Config, error) {
 		return nil, fmt.Errorf("DATABASE_URL environment variable is required")
 	}
 
+	storageRegions, err := loadStorageRegions()
+	if err != nil {
+		return nil, err
+	}
+
 	return &Config{
 		Port:                 getEnv("PORT", "8080"),
 		EnableH2C:            getEnv("ENABLE_H2C", "false") == "true",
+		// Request size limits
+		RequestMaxBytes:       getEnvInt("REQUEST_MAX_BYTES", 4<<20),
+		RequestMaxBytesLarge:  getEnvInt("REQUEST_MAX_BYTES_LARGE", 32<<20),
+		RequestMaxBytesSmall:  getEnvInt("REQUEST_MAX_BYTES_SMALL", 64<<10),
+		MaxInlineDataURIBytes: getEnvInt("MAX_INLINE_DATA_URI_BYTES", 256<<10),
+		// Course content size limits
+		CourseContentSoftLimitBytes: getEnvInt("COURSE_CONTENT_SOFT_LIMIT_BYTES", 10<<20),
+		CourseContentHardLimitBytes: getEnvInt("COURSE_CONTENT_HARD_LIMIT_BYTES", 25<<20),
+		// Outline generation input limits
+		GenerationMaxDesiredOutcomeChars:    getEnvInt("GENERATION_MAX_DESIRED_OUTCOME_CHARS", 1000),
+		GenerationMaxAdditionalContextChars: getEnvInt("GENERATION_MAX_ADDITIONAL_CONTEXT_CHARS", 10000),
 		DatabaseURL:          databaseURL,
 		KratosURL:            getEnv("KRATOS_URL", "http://kratos-public.kratos.svc.cluster.local"),
 		KratosAdminURL:       getEnv("KRATOS_ADMIN_URL", "http://kratos-admin.kratos.svc.cluster.local"),
@@ -81,6 +151,7 @@ func Load() (*Config, error) {
 		StripeStarterPriceID: getEnv("STRIPE_STARTER_PRICE_ID", ""),
 		StripeProPriceID:     getEnv("STRIPE_PRO_PRICE_ID", ""),
 		FrontendURL:  getEnv("FRONTEND_URL", "https://mirai.sogos.io"),
+		FrontendRoutePrefix: getEnv("FRONTEND_ROUTE_PREFIX", ""),
 		MarketingURL: getEnv("MARKETING_URL", getEnv("FRONTEND_URL", "https://get-mirai.sogos.io")), // Falls back to FRONTEND_URL for local-dev
 		BackendURL:   getEnv("BACKEND_URL", "http://localhost:8080"),
 		CookieDomain: getEnv("COOKIE_DOMAIN", ""),                       // Empty uses request domain; set to ".sogos.io" for cross-subdomain
@@ -92,6 +163,16 @@ func Load() (*Config, error) {
 		S3BasePath:  getEnv("S3_BASE_PATH", "data"),
 		S3AccessKey: getEnv("S3_ACCESS_KEY", ""),
 		S3SecretKey: getEnv("S3_SECRET_KEY", ""),
+		// Storage regions
+		StoragePrimaryRegion: getEnv("STORAGE_PRIMARY_REGION", "us"),
+		StorageRegions:       storageRegions,
+		// Storage lifecycle
+		StorageRetentionCourseContent: getEnv("STORAGE_RETENTION_COURSE_CONTENT", "standard"),
+		StorageRetentionExport:        getEnv("STORAGE_RETENTION_EXPORT", "infrequent-access"),
+		StorageRetentionSubmission:    getEnv("STORAGE_RETENTION_SUBMISSION", "standard"),
+		StorageRetentionThumbnail:     getEnv("STORAGE_RETENTION_THUMBNAIL", "standard"),
+		StorageApplyLifecycleRules:    getEnv("STORAGE_APPLY_LIFECYCLE_RULES", "false") == "true",
+		StorageIATransitionDays:       getEnvInt("STORAGE_IA_TRANSITION_DAYS", 30),
 		// Cache
 		EnableRedisCache: getEnv("ENABLE_REDIS_CACHE", "true") != "false",
 		RedisURL:         getEnv("REDIS_URL", "redis://redis.redis.svc.cluster.local:6379"),
@@ -102,13 +183,52 @@ func Load() (*Config, error) {
 		SMTPUsername: getEnv("SMTP_USERNAME", ""),
 		SMTPPassword: getEnv("SMTP_PASSWORD", ""),
 		AdminEmail:   getEnv("ADMIN_EMAIL", "john@sogos.io"),
+		// Operations
+		SuperAdminEmails: getEnvList("SUPERADMIN_EMAILS"),
 		// Encryption
 		EncryptionKey: getEnv("ENCRYPTION_KEY", ""),
+		StorageEncryptionEnabled: getEnv("STORAGE_ENCRYPTION_ENABLED", "false") == "true",
+		// AI provider
+		AIProvider:           getEnv("AI_PROVIDER", "gemini"),
+		FakeAILatencyMS:      getEnvInt("AI_FAKE_LATENCY_MS", 1500),
+		FakeAIFailurePercent: getEnvInt("AI_FAKE_FAILURE_PERCENT", 0),
+		FakeAIFailOperations: getEnvList("AI_FAKE_FAIL_OPERATIONS"),
 		// Worker
 		StaleJobTimeoutMinutes: getEnvInt("STALE_JOB_TIMEOUT_MINUTES", 30),
+		// SME
+		SMEMinKnowledgeChunks: getEnvInt("SME_MIN_KNOWLEDGE_CHUNKS", 5),
 	}, nil
 }
 
+// loadStorageRegions reads the additional storage regions named in STORAGE_REGIONS
+// (e.g. "eu"). Each region is configured by S3_{REGION}_BUCKET, S3_{REGION}_REGION,
+// S3_{REGION}_ENDPOINT, S3_{REGION}_BASE_PATH, S3_{REGION}_ACCESS_KEY and
+// S3_{REGION}_SECRET_KEY; the bucket is required.
+func loadStorageRegions() (map[string]S3BucketConfig, error) {
+	regions := make(map[string]S3BucketConfig)
+	primary := getEnv("STORAGE_PRIMARY_REGION", "us")
+	for _, name := range getEnvList("STORAGE_REGIONS") {
+		name = strings.ToLower(name)
+		if name == primary {
+			return nil, fmt.Errorf("STORAGE_REGIONS must not list the primary region %q", primary)
+		}
+		prefix := "S3_" + strings.ToUpper(strings.ReplaceAll(name, "-", "_")) + "_"
+		bucket := getEnv(prefix+"BUCKET", "")
+		if bucket == "" {
+			return nil, fmt.Errorf("%sBUCKET is required for storage region %q", prefix, name)
+		}
+		regions[name] = S3BucketConfig{
+			Endpoint:  getEnv(prefix+"ENDPOINT", ""),
+			Region:    getEnv(prefix+"REGION", "us-east-1"),
+			Bucket:    bucket,
+			BasePath:  getEnv(prefix+"BASE_PATH", getEnv("S3_BASE_PATH", "data")),
+			AccessKey: getEnv(prefix+"ACCESS_KEY", getEnv("S3_ACCESS_KEY", "")),
+			SecretKey: getEnv(prefix+"SECRET_KEY", getEnv("S3_SECRET_KEY", "")),
+		}
+	}
+	return regions, nil
+}
+
 func getEnv(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
 		return value
@@ -116,6 +236,16 @@ func getEnv(key, defaultValue string) string {
 	return defaultValue
 }
 
+func getEnvList(key string) []string {
+	var values []string
+	for _, value := range strings.Split(os.Getenv(key), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
 func getEnvInt(key string, defaultValue int) int {
 	if value := os.Getenv(key); value != "" {
 		if i, err := strconv.Atoi(value); err == nil {
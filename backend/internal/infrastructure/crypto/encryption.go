@@ -26,6 +26,15 @@ func NewEncryptor(hexKey string) (*Encryptor, error) {
 	return &Encryptor{key: key}, nil
 }
 
+// NewEncryptorFromKey creates a new encryptor with a raw 32-byte key, such as a data key
+// from GenerateDataKey.
+func NewEncryptorFromKey(key []byte) (*Encryptor, error) {
+	if len(key) != 32 {
+		return nil, errors.New("invalid encryption key: must be 32 bytes")
+	}
+	return &Encryptor{key: key}, nil
+}
+
 // Encrypt encrypts plaintext using AES-256-GCM.
 // Returns nonce (12 bytes) + ciphertext + tag.
 func (e *Encryptor) Encrypt(plaintext []byte) ([]byte, error) {
@@ -100,3 +109,12 @@ func GenerateKey() (string, error) {
 	}
 	return hex.EncodeToString(key), nil
 }
+
+// GenerateDataKey generates a random raw 32-byte key for NewEncryptorFromKey.
+func GenerateDataKey() ([]byte, error) {
+	key := make([]byte, 32)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
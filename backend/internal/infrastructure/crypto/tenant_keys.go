@@ -0,0 +1,171 @@
+package crypto
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+	"github.com/sogos/mirai-backend/internal/domain/tenant"
+)
+
+// activeKeyRefresh is how long a process keeps using a tenant's active key version
+// before checking for a rotation made by another process.
+const activeKeyRefresh = time.Minute
+
+// TenantKeyring hands out per-tenant data keys for envelope encryption. Data keys are
+// stored wrapped with the master key and unwrapped on first use; each version is
+// immutable, so unwrapped keys are kept for the life of the process.
+type TenantKeyring struct {
+	repo   repository.TenantKeyRepository
+	master *Encryptor
+
+	mu     sync.Mutex
+	keys   map[tenantKeyVersion]*Encryptor
+	active map[uuid.UUID]activeTenantKey
+}
+
+type tenantKeyVersion struct {
+	tenantID uuid.UUID
+	version  int32
+}
+
+type activeTenantKey struct {
+	version   int32
+	fetchedAt time.Time
+}
+
+// NewTenantKeyring creates a keyring whose data keys are wrapped with master.
+func NewTenantKeyring(repo repository.TenantKeyRepository, master *Encryptor) *TenantKeyring {
+	return &TenantKeyring{
+		repo:   repo,
+		master: master,
+		keys:   make(map[tenantKeyVersion]*Encryptor),
+		active: make(map[uuid.UUID]activeTenantKey),
+	}
+}
+
+// ActiveKey returns the tenant's current key and its version, creating the tenant's
+// first key if it has none.
+func (k *TenantKeyring) ActiveKey(ctx context.Context, tenantID uuid.UUID) (int32, *Encryptor, error) {
+	k.mu.Lock()
+	active, ok := k.active[tenantID]
+	k.mu.Unlock()
+	if ok && time.Since(active.fetchedAt) < activeKeyRefresh {
+		key, err := k.Key(ctx, tenantID, active.version)
+		return active.version, key, err
+	}
+
+	ctx = tenant.WithTenantID(ctx, tenantID)
+	stored, err := k.repo.GetActive(ctx, tenantID)
+	if err != nil {
+		return 0, nil, err
+	}
+	if stored == nil {
+		stored, err = k.createFirst(ctx, tenantID)
+		if err != nil {
+			return 0, nil, err
+		}
+	}
+	key, err := k.unwrap(stored)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	k.mu.Lock()
+	k.active[tenantID] = activeTenantKey{version: stored.Version, fetchedAt: time.Now()}
+	k.mu.Unlock()
+	return stored.Version, key, nil
+}
+
+// Key returns one version of the tenant's key, active or retired.
+func (k *TenantKeyring) Key(ctx context.Context, tenantID uuid.UUID, version int32) (*Encryptor, error) {
+	k.mu.Lock()
+	key, ok := k.keys[tenantKeyVersion{tenantID, version}]
+	k.mu.Unlock()
+	if ok {
+		return key, nil
+	}
+
+	stored, err := k.repo.GetByVersion(tenant.WithTenantID(ctx, tenantID), tenantID, version)
+	if err != nil {
+		return nil, err
+	}
+	if stored == nil {
+		return nil, fmt.Errorf("tenant %s has no key version %d", tenantID, version)
+	}
+	return k.unwrap(stored)
+}
+
+// Rotate makes a new key the tenant's active one and returns its version. Content
+// written with earlier versions stays readable until it is re-encrypted.
+func (k *TenantKeyring) Rotate(ctx context.Context, tenantID uuid.UUID) (int32, error) {
+	wrapped, err := k.newWrappedKey()
+	if err != nil {
+		return 0, err
+	}
+	stored := &entity.TenantKey{TenantID: tenantID, WrappedKey: wrapped}
+	if err := k.repo.Rotate(tenant.WithTenantID(ctx, tenantID), stored); err != nil {
+		return 0, err
+	}
+
+	k.mu.Lock()
+	k.active[tenantID] = activeTenantKey{version: stored.Version, fetchedAt: time.Now()}
+	k.mu.Unlock()
+	return stored.Version, nil
+}
+
+// createFirst stores a first key for the tenant, or loads the one another process
+// created first.
+func (k *TenantKeyring) createFirst(ctx context.Context, tenantID uuid.UUID) (*entity.TenantKey, error) {
+	wrapped, err := k.newWrappedKey()
+	if err != nil {
+		return nil, err
+	}
+	stored := &entity.TenantKey{TenantID: tenantID, WrappedKey: wrapped}
+	created, err := k.repo.CreateFirst(ctx, stored)
+	if err != nil {
+		return nil, err
+	}
+	if created {
+		return stored, nil
+	}
+	stored, err = k.repo.GetActive(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if stored == nil {
+		return nil, fmt.Errorf("tenant %s has keys but none is active", tenantID)
+	}
+	return stored, nil
+}
+
+// newWrappedKey generates a data key and wraps it with the master key.
+func (k *TenantKeyring) newWrappedKey() ([]byte, error) {
+	dataKey, err := GenerateDataKey()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate data key: %w", err)
+	}
+	return k.master.Encrypt(dataKey)
+}
+
+// unwrap decrypts a stored key with the master key and caches it.
+func (k *TenantKeyring) unwrap(stored *entity.TenantKey) (*Encryptor, error) {
+	dataKey, err := k.master.Decrypt(stored.WrappedKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap key version %d of tenant %s: %w", stored.Version, stored.TenantID, err)
+	}
+	key, err := NewEncryptorFromKey(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	k.mu.Lock()
+	k.keys[tenantKeyVersion{stored.TenantID, stored.Version}] = key
+	k.mu.Unlock()
+	return key, nil
+}
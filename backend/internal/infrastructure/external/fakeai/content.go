@@ -0,0 +1,587 @@
+package fakeai
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"sort"
+	"strings"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/service"
+)
+
+// Section and lesson counts used when the request does not constrain them.
+const (
+	defaultMinSections          = 3
+	defaultMinLessonsPerSection = 2
+	wordsPerChunk               = 80
+	maxChunks                   = 20
+)
+
+var sectionThemes = []string{"Foundations of", "Working with", "Applying", "Troubleshooting", "Mastering", "Leading with"}
+var lessonThemes = []string{"Key Concepts", "Common Scenarios", "Step-by-Step Practice", "Pitfalls to Avoid", "Case Study", "Putting It Together"}
+
+// titlePatterns pair a course title format with the rationale given for it.
+var titlePatterns = [][2]string{
+	{"%s Essentials", "Short and broad, suited to an introductory course."},
+	{"Mastering %s", "Signals depth for learners who already know the basics."},
+	{"%s in Practice", "Emphasizes hands-on application over theory."},
+	{"A Practical Guide to %s", "Approachable and task-focused."},
+	{"%s: From Basics to Confidence", "Sets expectations for a complete learning path."},
+	{"Getting Results with %s", "Leads with the outcome learners care about."},
+}
+
+// buildOutline lays out sections and lessons within the requested size limits. Lesson
+// titles prefer SME chunk topics so the outline visibly reflects the selected sources.
+func buildOutline(req service.GenerateOutlineRequest, seed uint64) []service.OutlineSectionResult {
+	sectionCount := defaultMinSections + int(seed%2)
+	if req.MaxSections > 0 && sectionCount > req.MaxSections {
+		sectionCount = req.MaxSections
+	}
+	lessonsPerSection := defaultMinLessonsPerSection + int(seed>>4%2)
+	if req.MaxLessonsPerSection > 0 && lessonsPerSection > req.MaxLessonsPerSection {
+		lessonsPerSection = req.MaxLessonsPerSection
+	}
+
+	totalLessons := sectionCount * lessonsPerSection
+	duration := 10 + int(seed>>12%11)
+	if req.TargetDurationMinutes > 0 {
+		duration = max(req.TargetDurationMinutes/totalLessons, 1)
+	}
+
+	topics := knowledgeTopics(req.SMEKnowledge)
+	audiences := make([]string, 0, len(req.TargetAudiences))
+	for _, a := range req.TargetAudiences {
+		audiences = append(audiences, a.Name)
+	}
+
+	subject := strings.TrimSpace(req.CourseTitle)
+	if subject == "" {
+		subject = "the Course Topic"
+	}
+
+	sections := make([]service.OutlineSectionResult, sectionCount)
+	lessonIndex := 0
+	for s := range sections {
+		theme := sectionThemes[(int(seed%uint64(len(sectionThemes)))+s)%len(sectionThemes)]
+		section := service.OutlineSectionResult{
+			Title:       fmt.Sprintf("%s %s", theme, subject),
+			Description: fmt.Sprintf("Part %d of %s, building toward: %s", s+1, subject, fallback(req.DesiredOutcome, "confident day-to-day use")),
+			Order:       s + 1,
+			Lessons:     make([]service.OutlineLessonResult, lessonsPerSection),
+		}
+
+		for l := range section.Lessons {
+			title := lessonThemes[(s+l)%len(lessonThemes)]
+			if lessonIndex < len(topics) {
+				title = topics[lessonIndex]
+			}
+			lesson := service.OutlineLessonResult{
+				Title:                    title,
+				Description:              fmt.Sprintf("Covers %s as part of %s.", strings.ToLower(title), section.Title),
+				Order:                    l + 1,
+				EstimatedDurationMinutes: duration,
+				LearningObjectives: []string{
+					fmt.Sprintf("Explain %s", strings.ToLower(title)),
+					fmt.Sprintf("Apply %s in a realistic situation", strings.ToLower(title)),
+				},
+				IsLastInSection: l == lessonsPerSection-1,
+				IsLastInCourse:  s == sectionCount-1 && l == lessonsPerSection-1,
+			}
+			// Every third lesson targets a single audience when several are present
+			if len(audiences) > 1 && lessonIndex%3 == 2 {
+				lesson.TargetAudiences = []string{audiences[lessonIndex%len(audiences)]}
+			}
+			section.Lessons[l] = lesson
+			lessonIndex++
+		}
+		sections[s] = section
+	}
+	return sections
+}
+
+// buildLesson returns the lesson's components in display order and its segue text.
+func buildLesson(req service.GenerateLessonRequest, seed uint64) ([]service.LessonComponentResult, string, error) {
+	var contents []struct {
+		componentType string
+		content       map[string]any
+		sources       []int
+	}
+	add := func(componentType string, content map[string]any, sources ...int) {
+		contents = append(contents, struct {
+			componentType string
+			content       map[string]any
+			sources       []int
+		}{componentType, content, sources})
+	}
+
+	add("heading", map[string]any{"level": 2, "text": req.LessonTitle})
+
+	paragraphs := []string{
+		fallback(req.LessonDescription, fmt.Sprintf("This lesson introduces %s.", req.LessonTitle)),
+	}
+	if len(req.LearningObjectives) > 0 {
+		paragraphs = append(paragraphs, "By the end of this lesson you will be able to "+strings.ToLower(strings.Join(req.LearningObjectives, "; "))+".")
+	}
+	var sources []int
+	if excerpt, index := knowledgeExcerpt(req.SMEKnowledge, seed); excerpt != "" {
+		paragraphs = append(paragraphs, excerpt)
+		sources = append(sources, index)
+	}
+	if req.PreviousLessonTitle != "" {
+		paragraphs = append(paragraphs, fmt.Sprintf("This builds on %s.", req.PreviousLessonTitle))
+	}
+	add("text", textContent(paragraphs), sources...)
+
+	if req.IncludeImages {
+		add("image", map[string]any{
+			"image_description": fmt.Sprintf("An illustration of %s in the context of %s", req.LessonTitle, req.CourseTitle),
+			"alt_text":          req.LessonTitle,
+			"caption":           fmt.Sprintf("%s at a glance", req.LessonTitle),
+		})
+	}
+
+	// Every third lesson proposes a short video segment
+	if seed%3 == 0 {
+		add("video", map[string]any{
+			"title":                      fmt.Sprintf("%s in practice", req.LessonTitle),
+			"script":                     fmt.Sprintf("In this short video we walk through %s step by step, showing what good looks like and the mistakes to avoid.", strings.ToLower(req.LessonTitle)),
+			"suggested_duration_seconds": 90,
+			"thumbnail_description":      fmt.Sprintf("A presenter demonstrating %s", strings.ToLower(req.LessonTitle)),
+		})
+	}
+
+	if req.IncludeReflectionPrompts {
+		add("text", textContent([]string{
+			fmt.Sprintf("Reflect: where have you encountered %s in your own work, and what would you do differently now?", strings.ToLower(req.LessonTitle)),
+		}))
+	}
+
+	if req.IncludeQuiz {
+		add("quiz", quizContent(req.LessonTitle, seed))
+	}
+
+	components := make([]service.LessonComponentResult, len(contents))
+	for i, c := range contents {
+		data, err := json.Marshal(c.content)
+		if err != nil {
+			return nil, "", err
+		}
+		components[i] = service.LessonComponentResult{
+			Type:               c.componentType,
+			Order:              i + 1,
+			ContentJSON:        string(data),
+			SourceChunkIndices: c.sources,
+		}
+	}
+
+	var segue string
+	switch {
+	case req.IsLastInCourse:
+		segue = fmt.Sprintf("That completes %s. Well done!", req.CourseTitle)
+	case req.NextLessonTitle != "":
+		segue = fmt.Sprintf("Next, we will look at %s.", req.NextLessonTitle)
+	case req.IsLastInSection:
+		segue = fmt.Sprintf("That wraps up %s.", req.SectionTitle)
+	}
+	return components, segue, nil
+}
+
+// expandLesson returns the text components that follow a thin part of a lesson.
+func expandLesson(req service.ResizeLessonContentRequest, expansion service.LessonExpansion) ([]service.LessonComponentResult, error) {
+	components := make([]service.LessonComponentResult, expansion.Count)
+	for i := range components {
+		data, err := json.Marshal(textContent([]string{
+			fmt.Sprintf("Going further with %s (%d of %d): a worked example that applies the ideas above step by step.", req.LessonTitle, i+1, expansion.Count),
+		}))
+		if err != nil {
+			return nil, err
+		}
+		components[i] = service.LessonComponentResult{Type: "text", ContentJSON: string(data)}
+	}
+	return components, nil
+}
+
+// consolidateLessonText joins the paragraphs of a run of text components into one.
+func consolidateLessonText(req service.ResizeLessonContentRequest, run []int) (service.LessonComponentResult, error) {
+	var paragraphs []string
+	for _, index := range run {
+		var content struct {
+			Plaintext string `json:"plaintext"`
+		}
+		if err := json.Unmarshal([]byte(req.Components[index].ContentJSON), &content); err != nil {
+			return service.LessonComponentResult{}, err
+		}
+		paragraphs = append(paragraphs, content.Plaintext)
+	}
+	data, err := json.Marshal(textContent(paragraphs))
+	if err != nil {
+		return service.LessonComponentResult{}, err
+	}
+	return service.LessonComponentResult{Type: "text", ContentJSON: string(data)}, nil
+}
+
+// buildSuggestions returns req.Count title options about the desired outcome, falling
+// back to the SME domains.
+func buildSuggestions(req service.GenerateSuggestionsRequest, seed uint64) []service.Suggestion {
+	subject := titleCase(strings.Fields(req.DesiredOutcome), 4)
+	if subject == "" {
+		for _, k := range req.SMEKnowledge {
+			if subject = titleCase(strings.Fields(k.Domain), 4); subject != "" {
+				break
+			}
+		}
+	}
+	subject = fallback(subject, "Your Team's Expertise")
+
+	count := min(req.Count, len(titlePatterns))
+	suggestions := make([]service.Suggestion, count)
+	for i := range suggestions {
+		pattern := titlePatterns[(int(seed%uint64(len(titlePatterns)))+i)%len(titlePatterns)]
+		suggestions[i] = service.Suggestion{
+			Text:      fmt.Sprintf(pattern[0], subject),
+			Rationale: pattern[1],
+		}
+	}
+	return suggestions
+}
+
+// personaLevels are the experience levels buildPersona picks from.
+var personaLevels = []string{"beginner", "intermediate", "advanced"}
+
+// buildPersona describes someone in the role named by the first words of the job
+// description, with req.MaxListItems entries in each list.
+func buildPersona(req service.GenerateTargetAudienceRequest, seed uint64) service.TargetAudienceInput {
+	role := fallback(titleCase(strings.Fields(req.JobDescription), 4), "Team Member")
+	count := max(req.MaxListItems, 1)
+
+	list := func(format string) []string {
+		items := make([]string, count)
+		for i := range items {
+			items[i] = fmt.Sprintf(format, role, i+1)
+		}
+		return items
+	}
+	return service.TargetAudienceInput{
+		Name:              "New " + role + "s",
+		Role:              role,
+		ExperienceLevel:   personaLevels[seed%uint64(len(personaLevels))],
+		LearningGoals:     list("Work confidently as a %s (goal %d)"),
+		Prerequisites:     list("Basic %s knowledge (prerequisite %d)"),
+		Challenges:        list("Common %s challenge %d"),
+		Motivations:       list("Grow as a %s (motivation %d)"),
+		TypicalBackground: fmt.Sprintf("Recently started working as a %s.", role),
+	}
+}
+
+// interviewPatterns are the questions buildInterviewQuestions fills in with the subject.
+var interviewPatterns = []string{
+	"What does someone need to understand first about %s?",
+	"Walk us through how you approach %s from start to finish.",
+	"What mistakes do newcomers most often make with %s?",
+	"Which tools or resources do you rely on for %s?",
+	"How do you know %s has been done well?",
+	"What is a recent example where %s went wrong, and what did you learn?",
+	"Which parts of %s are hardest to explain to others?",
+	"What shortcuts or rules of thumb do you use for %s?",
+	"How has the way you handle %s changed over time?",
+	"What questions do colleagues ask you most about %s?",
+	"Which policies or standards apply to %s?",
+	"If you had ten minutes to teach %s, what would you cover?",
+}
+
+// buildInterviewQuestions asks about the subject named by the first words of the desired
+// outcome, returning between req.MinQuestions and req.MaxQuestions questions.
+func buildInterviewQuestions(req service.GenerateInterviewQuestionsRequest, seed uint64) []string {
+	subject := strings.ToLower(titleCase(strings.Fields(req.DesiredOutcome), 4))
+	subject = fallback(subject, fallback(strings.ToLower(req.SMEDomain), "your work"))
+
+	count := min(max(req.MinQuestions, 1), len(interviewPatterns))
+	if spread := min(req.MaxQuestions, len(interviewPatterns)) - count; spread > 0 {
+		count += int(seed % uint64(spread+1))
+	}
+	questions := make([]string, count)
+	for i := range questions {
+		questions[i] = fmt.Sprintf(interviewPatterns[i], subject)
+	}
+	return questions
+}
+
+// clusterTopics groups topics by their first word, lowercased and without a plural "s",
+// labelling each group with its shortest topic. Topics alone in their group are left out.
+func clusterTopics(topics []string) []service.TopicCluster {
+	groups := map[string][]string{}
+	var keys []string
+	for _, topic := range topics {
+		words := strings.Fields(strings.ToLower(topic))
+		if len(words) == 0 {
+			continue
+		}
+		key := strings.TrimSuffix(words[0], "s")
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], topic)
+	}
+
+	var clusters []service.TopicCluster
+	for _, key := range keys {
+		members := groups[key]
+		if len(members) < 2 {
+			continue
+		}
+		label := members[0]
+		for _, m := range members[1:] {
+			if len(m) < len(label) || (len(m) == len(label) && m < label) {
+				label = m
+			}
+		}
+		clusters = append(clusters, service.TopicCluster{Label: label, Topics: members})
+	}
+	return clusters
+}
+
+// regenerateContent applies the modification prompt to the component's text fields.
+// Components without text fields are returned unchanged.
+func regenerateContent(req service.RegenerateComponentRequest) (string, error) {
+	var content map[string]any
+	if err := json.Unmarshal([]byte(req.CurrentContentJSON), &content); err != nil {
+		return "", fmt.Errorf("fakeai: invalid component content: %w", err)
+	}
+
+	note := strings.TrimSpace(req.ModificationPrompt)
+	if note == "" {
+		note = "clarity"
+	}
+
+	switch {
+	case content["html"] != nil:
+		plaintext, _ := content["plaintext"].(string)
+		revised := textContent([]string{strings.TrimSpace(plaintext), fmt.Sprintf("(Revised for: %s)", note)})
+		content["html"] = revised["html"]
+		content["plaintext"] = revised["plaintext"]
+	case content["text"] != nil:
+		text, _ := content["text"].(string)
+		content["text"] = fmt.Sprintf("%s (%s)", text, note)
+	case content["question"] != nil:
+		question, _ := content["question"].(string)
+		content["question"] = fmt.Sprintf("%s (%s)", question, note)
+	case content["script"] != nil:
+		script, _ := content["script"].(string)
+		content["script"] = fmt.Sprintf("%s (Revised for: %s)", script, note)
+	}
+
+	data, err := json.Marshal(content)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// buildKnowledge splits extracted text into chunks of about wordsPerChunk words.
+func buildKnowledge(req service.ProcessSMEContentRequest, seed uint64) (string, []service.SMEChunkResult) {
+	words := strings.Fields(req.ExtractedText)
+
+	var chunks []service.SMEChunkResult
+	for start := 0; start < len(words) && len(chunks) < maxChunks; start += wordsPerChunk {
+		end := min(start+wordsPerChunk, len(words))
+		chunkWords := words[start:end]
+		chunkSeed := hashOf(fmt.Sprint(seed), fmt.Sprint(start))
+		chunks = append(chunks, service.SMEChunkResult{
+			Content:        strings.Join(chunkWords, " "),
+			Topic:          titleCase(chunkWords, 5),
+			Keywords:       keywords(chunkWords, 3),
+			RelevanceScore: 0.6 + float32(chunkSeed%36)/100,
+		})
+	}
+
+	summary := fmt.Sprintf("Knowledge from %s", fallback(req.SMEName, "this expert"))
+	if req.SMEDomain != "" {
+		summary += " on " + req.SMEDomain
+	}
+	summary += fmt.Sprintf(", distilled into %d chunks.", len(chunks))
+	if len(words) > 0 {
+		summary += " " + strings.Join(words[:min(len(words), 30)], " ")
+	}
+	return summary, chunks
+}
+
+// knowledgeTopics collects the SME chunks as short title-cased topics, in input order.
+func knowledgeTopics(knowledge []service.SMEKnowledgeInput) []string {
+	var topics []string
+	seen := make(map[string]bool)
+	for _, k := range knowledge {
+		for _, chunk := range k.Chunks {
+			topic := titleCase(strings.Fields(chunk), 5)
+			if topic != "" && !seen[topic] {
+				seen[topic] = true
+				topics = append(topics, topic)
+			}
+		}
+	}
+	return topics
+}
+
+// knowledgeExcerpt picks one SME chunk to quote in a lesson and returns its index,
+// or -1 if there are no chunks.
+func knowledgeExcerpt(knowledge []service.SMEKnowledgeInput, seed uint64) (string, int) {
+	var chunks []string
+	for _, k := range knowledge {
+		chunks = append(chunks, k.Chunks...)
+	}
+	if len(chunks) == 0 {
+		return "", -1
+	}
+	index := int(seed % uint64(len(chunks)))
+	return "From the subject matter experts: " + chunks[index], index
+}
+
+// textContent builds text component content from paragraphs.
+func textContent(paragraphs []string) map[string]any {
+	var sb strings.Builder
+	for _, p := range paragraphs {
+		if p == "" {
+			continue
+		}
+		sb.WriteString("<p>")
+		sb.WriteString(html.EscapeString(p))
+		sb.WriteString("</p>")
+	}
+	return map[string]any{
+		"html":      sb.String(),
+		"plaintext": strings.Join(paragraphs, "\n\n"),
+	}
+}
+
+// quizContent builds a quiz on the lesson, a select-all-that-apply question for odd
+// seeds and a single-answer one otherwise, with the correct options placed by the seed.
+func quizContent(lessonTitle string, seed uint64) map[string]any {
+	topic := strings.ToLower(lessonTitle)
+	options := []map[string]any{
+		{"id": "a", "text": fmt.Sprintf("Apply %s as described in this lesson", topic), "correct": true},
+		{"id": "b", "text": "Skip the preparation and improvise", "correct": false},
+		{"id": "c", "text": "Wait for someone else to decide", "correct": false},
+		{"id": "d", "text": "Ignore it unless something goes wrong", "correct": false},
+	}
+	question := fmt.Sprintf("Which approach best reflects %s?", lessonTitle)
+	questionType := "single"
+	points := 1
+	if seed%2 == 1 {
+		options[1] = map[string]any{"id": "b", "text": fmt.Sprintf("Check the result of %s before moving on", topic), "correct": true}
+		question = fmt.Sprintf("Which of these are part of %s? Select all that apply.", lessonTitle)
+		questionType = "multi"
+		points = 2
+	}
+
+	// Move the first correct answer to a position derived from the seed
+	correct := int(seed % uint64(len(options)))
+	options[0], options[correct] = options[correct], options[0]
+	for i, o := range options {
+		o["id"] = string(rune('a' + i))
+	}
+
+	return map[string]any{
+		"schema_version": entity.QuizSchemaVersion,
+		"question":       question,
+		"question_type":  questionType,
+		"options":        options,
+		"points":         points,
+		"shuffle":        true,
+		"explanation":    fmt.Sprintf("The lesson shows how %s leads to better outcomes.", topic),
+	}
+}
+
+// titleCase joins up to n words, trimming punctuation and capitalizing each word.
+func titleCase(words []string, n int) string {
+	out := make([]string, 0, n)
+	for _, w := range words {
+		w = strings.Trim(w, ".,;:!?\"'()[]")
+		if w == "" {
+			continue
+		}
+		out = append(out, strings.ToUpper(w[:1])+w[1:])
+		if len(out) == n {
+			break
+		}
+	}
+	return strings.Join(out, " ")
+}
+
+// keywords returns the n longest distinct words, longest first.
+func keywords(words []string, n int) []string {
+	seen := make(map[string]bool)
+	var candidates []string
+	for _, w := range words {
+		w = strings.ToLower(strings.Trim(w, ".,;:!?\"'()[]"))
+		if len(w) < 4 || seen[w] {
+			continue
+		}
+		seen[w] = true
+		candidates = append(candidates, w)
+	}
+	sort.SliceStable(candidates, func(i, j int) bool { return len(candidates[i]) > len(candidates[j]) })
+	return candidates[:min(n, len(candidates))]
+}
+
+func fallback(value, def string) string {
+	if strings.TrimSpace(value) == "" {
+		return def
+	}
+	return value
+}
+
+// outlinePromptSize approximates the size of the prompt the real provider would send.
+func outlinePromptSize(req service.GenerateOutlineRequest) int {
+	size := len(req.CourseTitle) + len(req.DesiredOutcome) + len(req.AdditionalContext) + 2000
+	for _, k := range req.SMEKnowledge {
+		size += len(k.Summary)
+		for _, c := range k.Chunks {
+			size += len(c)
+		}
+	}
+	return size
+}
+
+func outlineSize(sections []service.OutlineSectionResult) int {
+	size := 0
+	for _, s := range sections {
+		size += len(s.Title) + len(s.Description)
+		for _, l := range s.Lessons {
+			size += len(l.Title) + len(l.Description)
+			for _, o := range l.LearningObjectives {
+				size += len(o)
+			}
+		}
+	}
+	return size
+}
+
+func lessonPromptSize(req service.GenerateLessonRequest) int {
+	size := len(req.CourseTitle) + len(req.SectionTitle) + len(req.LessonTitle) + len(req.LessonDescription) + 3000
+	for _, k := range req.SMEKnowledge {
+		size += len(k.Summary)
+		for _, c := range k.Chunks {
+			size += len(c)
+		}
+	}
+	return size
+}
+
+// placeholderImage encodes a 160x90 PNG filled with a color derived from seed.
+func placeholderImage(seed uint64) ([]byte, error) {
+	fill := color.RGBA{R: uint8(seed), G: uint8(seed >> 8), B: uint8(seed >> 16), A: 255}
+	img := image.NewRGBA(image.Rect(0, 0, 160, 90))
+	draw.Draw(img, img.Bounds(), &image.Uniform{C: fill}, image.Point{}, draw.Src)
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, fmt.Errorf("fakeai: failed to encode image: %w", err)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,462 @@
+// Package fakeai provides a deterministic stand-in for the Gemini provider, so the
+// generation pipeline can run locally without an API key and in integration tests.
+package fakeai
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/service"
+)
+
+// ErrSimulatedFailure is returned by calls that Options selected to fail.
+var ErrSimulatedFailure = errors.New("fakeai: simulated provider failure")
+
+// Operation names a provider call, for targeting simulated failures.
+type Operation string
+
+const (
+	OperationOutline       Operation = "outline"
+	OperationLesson        Operation = "lesson"
+	OperationRegenerate    Operation = "regenerate"
+	OperationSMEProcessing Operation = "sme_processing"
+	OperationSuggestions   Operation = "suggestions"
+	OperationTopics        Operation = "topics"
+	OperationAudience      Operation = "audience"
+	OperationInterview     Operation = "interview"
+	OperationImage         Operation = "image"
+	OperationTranslate     Operation = "translate"
+	OperationAltText       Operation = "alt_text"
+	OperationRestructure   Operation = "restructure"
+	OperationResize        Operation = "resize"
+)
+
+// latencyFactor scales Options.Latency so outlines take longer than single components,
+// roughly matching the relative cost of the real calls.
+var latencyFactor = map[Operation]float64{
+	OperationOutline:       2,
+	OperationLesson:        1,
+	OperationRegenerate:    0.4,
+	OperationSMEProcessing: 1.5,
+	OperationSuggestions:   0.2,
+	OperationTopics:        0.2,
+	OperationAudience:      0.3,
+	OperationInterview:     0.3,
+	OperationImage:         0.5,
+	OperationTranslate:     0.5,
+	OperationAltText:       0.2,
+	OperationRestructure:   0.4,
+	OperationResize:        0.4,
+}
+
+// progressSteps is how many times a call reports progress while waiting out its latency.
+const progressSteps = 4
+
+// progressMessages describe the operations that report progress.
+var progressMessages = map[Operation]string{
+	OperationOutline: "Planning course outline...",
+	OperationLesson:  "Writing lesson content...",
+}
+
+// Options controls the fake provider's timing and failures.
+type Options struct {
+	// Latency is the base delay of a call. Each call waits between 1x and 1.25x its
+	// operation's share of it, derived from the input. Zero returns immediately.
+	Latency time.Duration
+
+	// FailurePercent fails that share of calls (0-100). Whether a call fails depends
+	// only on its operation and input, so a retry of the same input fails again.
+	FailurePercent int
+
+	// FailOperations always fail.
+	FailOperations []Operation
+
+	// Err is returned for simulated failures. Defaults to ErrSimulatedFailure.
+	Err error
+}
+
+// Provider implements service.AIProvider with content derived from hashes of the
+// request, so the same request always produces the same response.
+type Provider struct {
+	opts Options
+}
+
+// NewProvider creates a fake AI provider.
+func NewProvider(opts Options) *Provider {
+	if opts.Err == nil {
+		opts.Err = ErrSimulatedFailure
+	}
+	return &Provider{opts: opts}
+}
+
+// GetProvider returns the fake provider for any tenant, so a Provider can stand in
+// for the Gemini ProviderFactory.
+func (p *Provider) GetProvider(ctx context.Context, tenantID uuid.UUID) (service.AIProvider, error) {
+	return p, nil
+}
+
+// GenerateCourseOutline returns sections and lessons built from the course title and SME chunks.
+func (p *Provider) GenerateCourseOutline(ctx context.Context, req service.GenerateOutlineRequest) (*service.GenerateOutlineResult, error) {
+	seed := hashOf("outline", req.CourseTitle, req.DesiredOutcome, smeSeed(req.SMEKnowledge))
+	if err := p.simulate(ctx, OperationOutline, seed, req.OnProgress); err != nil {
+		return nil, err
+	}
+
+	sections := buildOutline(req, seed)
+	return &service.GenerateOutlineResult{
+		Sections:   sections,
+		TokensUsed: estimateTokens(outlinePromptSize(req)) + estimateTokens(outlineSize(sections)),
+	}, nil
+}
+
+// GenerateLessonContent returns a heading, body text and the requested optional components.
+func (p *Provider) GenerateLessonContent(ctx context.Context, req service.GenerateLessonRequest) (*service.GenerateLessonResult, error) {
+	seed := hashOf("lesson", req.CourseTitle, req.SectionTitle, req.LessonTitle, smeSeed(req.SMEKnowledge))
+	if err := p.simulate(ctx, OperationLesson, seed, req.OnProgress); err != nil {
+		return nil, err
+	}
+
+	components, segue, err := buildLesson(req, seed)
+	if err != nil {
+		return nil, err
+	}
+
+	outputSize := len(segue)
+	for _, c := range components {
+		outputSize += len(c.ContentJSON)
+	}
+	return &service.GenerateLessonResult{
+		Components: components,
+		SegueText:  segue,
+		TokensUsed: estimateTokens(lessonPromptSize(req)) + estimateTokens(outputSize),
+	}, nil
+}
+
+// RegenerateComponent rewrites the component's text fields to reflect the modification prompt.
+func (p *Provider) RegenerateComponent(ctx context.Context, req service.RegenerateComponentRequest) (*service.RegenerateComponentResult, error) {
+	seed := hashOf("regenerate", req.ComponentType, req.CurrentContentJSON, req.ModificationPrompt)
+	if err := p.simulate(ctx, OperationRegenerate, seed, nil); err != nil {
+		return nil, err
+	}
+
+	contentJSON, err := regenerateContent(req)
+	if err != nil {
+		return nil, err
+	}
+	return &service.RegenerateComponentResult{
+		ContentJSON: contentJSON,
+		TokensUsed:  estimateTokens(len(req.CurrentContentJSON)+len(req.ModificationPrompt)+len(req.LessonContext)) + estimateTokens(len(contentJSON)),
+	}, nil
+}
+
+// ProcessSMEContent splits the extracted text into chunks with topics and keywords.
+func (p *Provider) ProcessSMEContent(ctx context.Context, req service.ProcessSMEContentRequest) (*service.ProcessSMEContentResult, error) {
+	seed := hashOf("sme", req.SMEName, req.SMEDomain, req.ExtractedText)
+	if err := p.simulate(ctx, OperationSMEProcessing, seed, nil); err != nil {
+		return nil, err
+	}
+
+	summary, chunks := buildKnowledge(req, seed)
+	outputSize := len(summary)
+	for _, c := range chunks {
+		outputSize += len(c.Content) + len(c.Topic)
+	}
+	return &service.ProcessSMEContentResult{
+		Summary:    summary,
+		Chunks:     chunks,
+		TokensUsed: estimateTokens(len(req.ExtractedText)) + estimateTokens(outputSize),
+	}, nil
+}
+
+// GenerateSuggestions returns title options built from the desired outcome and SME domains.
+func (p *Provider) GenerateSuggestions(ctx context.Context, req service.GenerateSuggestionsRequest) (*service.GenerateSuggestionsResult, error) {
+	seed := hashOf("suggestions", string(req.Kind), req.DesiredOutcome, smeSeed(req.SMEKnowledge))
+	if err := p.simulate(ctx, OperationSuggestions, seed, nil); err != nil {
+		return nil, err
+	}
+
+	suggestions := buildSuggestions(req, seed)
+	outputSize := 0
+	for _, s := range suggestions {
+		outputSize += len(s.Text) + len(s.Rationale)
+	}
+	return &service.GenerateSuggestionsResult{
+		Suggestions: suggestions,
+		TokensUsed:  estimateTokens(len(req.DesiredOutcome)+500) + estimateTokens(outputSize),
+	}, nil
+}
+
+// ClusterTopics groups topics that share their first word, ignoring case and plurals.
+func (p *Provider) ClusterTopics(ctx context.Context, req service.ClusterTopicsRequest) (*service.ClusterTopicsResult, error) {
+	seed := hashOf(append([]string{"topics", req.SMEName}, req.Topics...)...)
+	if err := p.simulate(ctx, OperationTopics, seed, nil); err != nil {
+		return nil, err
+	}
+
+	clusters := clusterTopics(req.Topics)
+	inputSize := 0
+	for _, t := range req.Topics {
+		inputSize += len(t)
+	}
+	return &service.ClusterTopicsResult{
+		Clusters:   clusters,
+		TokensUsed: estimateTokens(inputSize+300) + estimateTokens(inputSize),
+	}, nil
+}
+
+// GenerateTargetAudience returns a persona built from the words of the job description.
+func (p *Provider) GenerateTargetAudience(ctx context.Context, req service.GenerateTargetAudienceRequest) (*service.GenerateTargetAudienceResult, error) {
+	seed := hashOf("audience", req.JobDescription)
+	if err := p.simulate(ctx, OperationAudience, seed, nil); err != nil {
+		return nil, err
+	}
+
+	persona := buildPersona(req, seed)
+	outputSize := len(persona.Name) + len(persona.Role) + len(persona.TypicalBackground)
+	for _, list := range [][]string{persona.LearningGoals, persona.Prerequisites, persona.Challenges, persona.Motivations} {
+		for _, item := range list {
+			outputSize += len(item)
+		}
+	}
+	return &service.GenerateTargetAudienceResult{
+		Persona:    persona,
+		TokensUsed: estimateTokens(len(req.JobDescription)+400) + estimateTokens(outputSize),
+	}, nil
+}
+
+// GenerateInterviewQuestions returns questions built from the words of the desired outcome.
+func (p *Provider) GenerateInterviewQuestions(ctx context.Context, req service.GenerateInterviewQuestionsRequest) (*service.GenerateInterviewQuestionsResult, error) {
+	seed := hashOf("interview", req.SMEName, req.DesiredOutcome)
+	if err := p.simulate(ctx, OperationInterview, seed, nil); err != nil {
+		return nil, err
+	}
+
+	questions := buildInterviewQuestions(req, seed)
+	outputSize := 0
+	for _, q := range questions {
+		outputSize += len(q)
+	}
+	promptSize := len(req.SMEDescription) + len(req.DesiredOutcome) + 600
+	for _, topic := range req.KnownTopics {
+		promptSize += len(topic)
+	}
+	return &service.GenerateInterviewQuestionsResult{
+		Questions:  questions,
+		TokensUsed: estimateTokens(promptSize) + estimateTokens(outputSize),
+	}, nil
+}
+
+// GenerateImage returns a small solid-color PNG whose color comes from the description.
+func (p *Provider) GenerateImage(ctx context.Context, req service.GenerateImageRequest) (*service.GenerateImageResult, error) {
+	seed := hashOf("image", req.Description)
+	if err := p.simulate(ctx, OperationImage, seed, nil); err != nil {
+		return nil, err
+	}
+
+	data, err := placeholderImage(seed)
+	if err != nil {
+		return nil, err
+	}
+	return &service.GenerateImageResult{Data: data, MIMEType: "image/png"}, nil
+}
+
+// GenerateAltText returns the description, cut to the maximum length.
+func (p *Provider) GenerateAltText(ctx context.Context, req service.GenerateAltTextRequest) (*service.GenerateAltTextResult, error) {
+	seed := hashOf("alt_text", req.Description, req.LessonTitle)
+	if err := p.simulate(ctx, OperationAltText, seed, nil); err != nil {
+		return nil, err
+	}
+
+	altText := strings.TrimSpace(req.Description)
+	if altText == "" {
+		altText = "Illustration for " + req.LessonTitle
+	}
+	if runes := []rune(altText); req.MaxLength > 0 && len(runes) > req.MaxLength {
+		altText = strings.TrimSpace(string(runes[:req.MaxLength]))
+	}
+	return &service.GenerateAltTextResult{
+		AltText:    altText,
+		TokensUsed: estimateTokens(len(req.Description)+len(req.Image)/4+300) + estimateTokens(len(altText)),
+	}, nil
+}
+
+// RestructureOutlineLessons splits each lesson into "(Part 1)" and "(Part 2)" halves and
+// joins each merged pair's titles with "and".
+func (p *Provider) RestructureOutlineLessons(ctx context.Context, req service.RestructureOutlineLessonsRequest) (*service.RestructureOutlineLessonsResult, error) {
+	parts := []string{"restructure", req.CourseTitle}
+	for _, lesson := range req.Splits {
+		parts = append(parts, lesson.Title)
+	}
+	for _, pair := range req.Merges {
+		parts = append(parts, pair[0].Title, pair[1].Title)
+	}
+	if err := p.simulate(ctx, OperationRestructure, hashOf(parts...), nil); err != nil {
+		return nil, err
+	}
+
+	result := &service.RestructureOutlineLessonsResult{}
+	size := 0
+	for _, lesson := range req.Splits {
+		half := len(lesson.LearningObjectives) / 2
+		first := service.OutlineLessonDraft{
+			Title:              lesson.Title + " (Part 1)",
+			Description:        lesson.Description,
+			DurationMinutes:    lesson.DurationMinutes / 2,
+			LearningObjectives: lesson.LearningObjectives[:half],
+		}
+		second := service.OutlineLessonDraft{
+			Title:              lesson.Title + " (Part 2)",
+			Description:        lesson.Description,
+			DurationMinutes:    lesson.DurationMinutes - lesson.DurationMinutes/2,
+			LearningObjectives: lesson.LearningObjectives[half:],
+		}
+		result.Splits = append(result.Splits, [2]service.OutlineLessonDraft{first, second})
+		size += 2 * (len(lesson.Title) + len(lesson.Description))
+	}
+	for _, pair := range req.Merges {
+		merged := service.OutlineLessonDraft{
+			Title:              pair[0].Title + " and " + pair[1].Title,
+			Description:        strings.TrimSpace(pair[0].Description + " " + pair[1].Description),
+			DurationMinutes:    pair[0].DurationMinutes + pair[1].DurationMinutes,
+			LearningObjectives: append(append([]string{}, pair[0].LearningObjectives...), pair[1].LearningObjectives...),
+		}
+		result.Merges = append(result.Merges, merged)
+		size += len(merged.Title) + len(merged.Description)
+	}
+	result.TokensUsed = estimateTokens(size+400) + estimateTokens(size)
+	return result, nil
+}
+
+// ResizeLessonContent follows each thin part with numbered "Going further" text
+// components and joins the paragraphs of each consolidated run.
+func (p *Provider) ResizeLessonContent(ctx context.Context, req service.ResizeLessonContentRequest) (*service.ResizeLessonContentResult, error) {
+	parts := []string{"resize", req.LessonTitle}
+	for _, c := range req.Components {
+		parts = append(parts, c.ContentJSON)
+	}
+	if err := p.simulate(ctx, OperationResize, hashOf(parts...), nil); err != nil {
+		return nil, err
+	}
+
+	result := &service.ResizeLessonContentResult{}
+	size := 0
+	for _, expansion := range req.Expansions {
+		components, err := expandLesson(req, expansion)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range components {
+			size += len(c.ContentJSON)
+		}
+		result.Expansions = append(result.Expansions, components)
+	}
+	for _, run := range req.Consolidations {
+		component, err := consolidateLessonText(req, run)
+		if err != nil {
+			return nil, err
+		}
+		size += len(component.ContentJSON)
+		result.Consolidations = append(result.Consolidations, component)
+	}
+	promptSize := 400
+	for _, c := range req.Components {
+		promptSize += len(c.ContentJSON)
+	}
+	result.TokensUsed = estimateTokens(promptSize) + estimateTokens(size)
+	return result, nil
+}
+
+// TranslateTexts returns each text prefixed with the target language tag, e.g. "[es] ".
+func (p *Provider) TranslateTexts(ctx context.Context, req service.TranslateTextsRequest) (*service.TranslateTextsResult, error) {
+	seed := hashOf(append([]string{"translate", req.TargetLanguage}, req.Texts...)...)
+	if err := p.simulate(ctx, OperationTranslate, seed, nil); err != nil {
+		return nil, err
+	}
+
+	prefix := "[" + req.TargetLanguage + "] "
+	texts := make([]string, len(req.Texts))
+	size := 0
+	for i, text := range req.Texts {
+		texts[i] = prefix + text
+		size += len(text)
+	}
+	return &service.TranslateTextsResult{
+		Texts:      texts,
+		TokensUsed: estimateTokens(size+300) + estimateTokens(size),
+	}, nil
+}
+
+// TestConnection always succeeds.
+func (p *Provider) TestConnection(ctx context.Context) error {
+	return nil
+}
+
+// simulate waits out the call's latency, reporting progress along the way if onProgress
+// is set, and reports whether the call should fail.
+func (p *Provider) simulate(ctx context.Context, op Operation, seed uint64, onProgress service.ProgressFunc) error {
+	if p.opts.Latency > 0 {
+		delay := time.Duration(float64(p.opts.Latency) * latencyFactor[op])
+		delay += time.Duration(seed % uint64(delay/4+1))
+
+		steps := 1
+		if onProgress != nil {
+			steps = progressSteps
+		}
+		for step := 1; step <= steps; step++ {
+			timer := time.NewTimer(delay / time.Duration(steps))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return ctx.Err()
+			case <-timer.C:
+			}
+			if onProgress != nil && step < steps {
+				onProgress(float64(step)/float64(steps), progressMessages[op])
+			}
+		}
+	}
+
+	for _, failing := range p.opts.FailOperations {
+		if failing == op {
+			return fmt.Errorf("%s: %w", op, p.opts.Err)
+		}
+	}
+	if p.opts.FailurePercent > 0 && int(seed>>8%100) < p.opts.FailurePercent {
+		return fmt.Errorf("%s: %w", op, p.opts.Err)
+	}
+	return nil
+}
+
+// hashOf returns a stable 64-bit hash of the given parts.
+func hashOf(parts ...string) uint64 {
+	h := sha256.New()
+	for _, part := range parts {
+		h.Write([]byte(part))
+		h.Write([]byte{0})
+	}
+	return binary.BigEndian.Uint64(h.Sum(nil))
+}
+
+// smeSeed folds SME knowledge into a hash input, so different sources give different content.
+func smeSeed(knowledge []service.SMEKnowledgeInput) string {
+	var seed uint64
+	for _, k := range knowledge {
+		seed ^= hashOf(k.SMEName, k.Summary)
+		for _, chunk := range k.Chunks {
+			seed ^= hashOf(chunk)
+		}
+	}
+	return fmt.Sprintf("%x", seed)
+}
+
+// estimateTokens approximates token usage at four characters per token.
+func estimateTokens(chars int) int64 {
+	return int64(chars/4 + 1)
+}
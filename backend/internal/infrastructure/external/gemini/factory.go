@@ -6,12 +6,14 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/infrastructure/cache"
 )
 
 // SettingsProvider provides access to tenant AI settings for API key retrieval.
 // This interface is implemented by TenantSettingsService.
 type SettingsProvider interface {
 	GetDecryptedAPIKey(ctx context.Context, tenantID uuid.UUID) (string, error)
+	PromptCacheEnabled(ctx context.Context, tenantID uuid.UUID) bool
 }
 
 // ProviderFactory creates AIProvider instances per-tenant.
@@ -19,6 +21,7 @@ type SettingsProvider interface {
 // this factory creates a fresh client for each request using the tenant's decrypted API key.
 type ProviderFactory struct {
 	settingsProvider SettingsProvider
+	promptCache      cache.Cache
 	logger           service.Logger
 }
 
@@ -30,6 +33,12 @@ func NewProviderFactory(settingsProvider SettingsProvider, logger service.Logger
 	}
 }
 
+// SetPromptCache enables reusing responses to identical prompts for tenants that
+// haven't disabled it. c must be a tenant-scoped cache.
+func (f *ProviderFactory) SetPromptCache(c cache.Cache) {
+	f.promptCache = c
+}
+
 // GetProvider creates an AIProvider for the specified tenant.
 // It retrieves the tenant's decrypted API key and creates a new Gemini client.
 func (f *ProviderFactory) GetProvider(ctx context.Context, tenantID uuid.UUID) (service.AIProvider, error) {
@@ -49,6 +58,10 @@ func (f *ProviderFactory) GetProvider(ctx context.Context, tenantID uuid.UUID) (
 		return nil, err
 	}
 
+	if f.promptCache != nil && f.settingsProvider.PromptCacheEnabled(ctx, tenantID) {
+		client.promptCache = &promptCache{cache: f.promptCache, tenantID: tenantID}
+	}
+
 	log.Debug("created Gemini provider for tenant")
 	return client, nil
 }
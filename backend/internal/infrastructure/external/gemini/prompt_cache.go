@@ -0,0 +1,62 @@
+package gemini
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/tenant"
+	"github.com/sogos/mirai-backend/internal/infrastructure/cache"
+)
+
+// promptCacheTTL is how long a response is reused for an identical prompt. It is kept
+// short so users comparing outputs don't pay twice, without serving stale content later.
+const promptCacheTTL = 15 * time.Minute
+
+// promptCache stores successful responses in a tenant's cache, keyed by a hash of the
+// model, prompt and response schema. Cache errors are treated as misses.
+type promptCache struct {
+	cache    cache.Cache
+	tenantID uuid.UUID
+}
+
+// cachedResponse is what the prompt cache stores for a response.
+type cachedResponse struct {
+	Text string `json:"text"`
+}
+
+// promptCacheKey hashes everything that determines a response, so any change to the
+// model, prompt or schema misses the cache.
+func promptCacheKey(model, prompt string, schema map[string]any) string {
+	schemaJSON, _ := json.Marshal(schema)
+	h := sha256.New()
+	h.Write([]byte(model))
+	h.Write([]byte{0})
+	h.Write([]byte(prompt))
+	h.Write([]byte{0})
+	h.Write(schemaJSON)
+	return cache.TenantCacheKeys.PromptResult(hex.EncodeToString(h.Sum(nil)))
+}
+
+// get returns the cached response text for key, if any.
+func (p *promptCache) get(ctx context.Context, key string) (string, bool) {
+	var cached cachedResponse
+	entry, err := p.cache.Get(tenant.WithTenantID(ctx, p.tenantID), key, &cached)
+	if err != nil || entry == nil || cached.Text == "" {
+		return "", false
+	}
+	return cached.Text, true
+}
+
+// set stores a successful response. Nothing is stored once ctx is done, so a response
+// that arrives as the request is cancelled is never reused.
+func (p *promptCache) set(ctx context.Context, key, text string) {
+	if ctx.Err() != nil || text == "" {
+		return
+	}
+	_, _ = p.cache.Set(tenant.WithTenantID(ctx, p.tenantID), key, cachedResponse{Text: text}, "", promptCacheTTL)
+}
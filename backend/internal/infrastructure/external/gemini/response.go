@@ -0,0 +1,267 @@
+package gemini
+
+import (
+	"encoding/json"
+	"expvar"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/sogos/mirai-backend/internal/domain/service"
+)
+
+// maxSchemaProblems caps how many validation problems are reported back to the model.
+const maxSchemaProblems = 20
+
+// maxRepairEchoLength caps how much of an invalid response is quoted in the repair prompt.
+const maxRepairEchoLength = 20000
+
+// responseStats counts structured responses per model and outcome, e.g.
+// "gemini-2.0-flash.repaired". Outcomes:
+//   - valid: parsed and matched the schema as returned
+//   - cleaned: needed local cleanup (code fences, trailing commas, ...)
+//   - repaired: needed a repair prompt
+//   - failed: still invalid after the repair prompt
+var responseStats = expvar.NewMap("gemini_structured_responses")
+
+// invalidResponseError reports a response that could not be parsed or did not match its schema.
+type invalidResponseError struct {
+	Problems []string
+}
+
+func (e *invalidResponseError) Error() string {
+	return "response still invalid after repair: " + strings.Join(e.Problems, "; ")
+}
+
+func (e *invalidResponseError) Is(target error) bool {
+	return target == service.ErrInvalidAIResponse
+}
+
+// decodeResponse cleans up a model response, decodes it into out and validates it
+// against schema. It returns the cleaned JSON text, or the problems found.
+func decodeResponse(raw string, schema map[string]any, out any) (string, []string) {
+	text := cleanJSONText(raw)
+
+	var value any
+	if err := json.Unmarshal([]byte(text), &value); err != nil {
+		return text, []string{"response is not valid JSON: " + err.Error()}
+	}
+
+	if problems := validateSchema(schema, value, "$"); len(problems) > 0 {
+		if len(problems) > maxSchemaProblems {
+			problems = append(problems[:maxSchemaProblems], fmt.Sprintf("... and %d more", len(problems)-maxSchemaProblems))
+		}
+		return text, problems
+	}
+
+	if out != nil {
+		if err := json.Unmarshal([]byte(text), out); err != nil {
+			return text, []string{"response does not match the expected structure: " + err.Error()}
+		}
+	}
+	return text, nil
+}
+
+// cleanJSONText strips markdown code fences and any prose around the JSON value,
+// then fixes trailing commas and raw control characters inside strings.
+func cleanJSONText(raw string) string {
+	text := strings.TrimSpace(strings.TrimPrefix(raw, "\ufeff"))
+
+	if strings.HasPrefix(text, "```") {
+		text = strings.TrimPrefix(text, "```")
+		// Drop a language tag such as "json" on the opening fence line
+		if nl := strings.IndexByte(text, '\n'); nl >= 0 && !strings.ContainsAny(text[:nl], "{[") {
+			text = text[nl+1:]
+		}
+		text = strings.TrimSpace(text)
+		text = strings.TrimSpace(strings.TrimSuffix(text, "```"))
+	}
+
+	start := strings.IndexAny(text, "{[")
+	end := strings.LastIndexAny(text, "}]")
+	if start >= 0 && end > start {
+		text = text[start : end+1]
+	}
+
+	return fixJSONSyntax(text)
+}
+
+// fixJSONSyntax removes commas directly before a closing bracket and escapes
+// newlines, carriage returns and tabs that appear unescaped inside strings.
+func fixJSONSyntax(text string) string {
+	var sb strings.Builder
+	sb.Grow(len(text))
+
+	inString := false
+	escaped := false
+	for i := 0; i < len(text); i++ {
+		ch := text[i]
+
+		if inString {
+			switch {
+			case escaped:
+				escaped = false
+			case ch == '\\':
+				escaped = true
+			case ch == '"':
+				inString = false
+			case ch == '\n':
+				sb.WriteString(`\n`)
+				continue
+			case ch == '\r':
+				sb.WriteString(`\r`)
+				continue
+			case ch == '\t':
+				sb.WriteString(`\t`)
+				continue
+			}
+			sb.WriteByte(ch)
+			continue
+		}
+
+		switch ch {
+		case '"':
+			inString = true
+		case ',':
+			next := i + 1
+			for next < len(text) && strings.IndexByte(" \t\r\n", text[next]) >= 0 {
+				next++
+			}
+			if next < len(text) && (text[next] == '}' || text[next] == ']') {
+				continue
+			}
+		}
+		sb.WriteByte(ch)
+	}
+
+	return sb.String()
+}
+
+// validateSchema checks a decoded JSON value against the subset of JSON Schema used by
+// the response schemas in this package: type, properties, required, items, enum,
+// minimum, maximum, minItems and maxItems.
+func validateSchema(schema map[string]any, value any, path string) []string {
+	if schema == nil {
+		return nil
+	}
+
+	var problems []string
+	switch schema["type"] {
+	case "object":
+		obj, ok := value.(map[string]any)
+		if !ok {
+			return []string{path + ": expected an object"}
+		}
+		if required, ok := schema["required"].([]string); ok {
+			for _, name := range required {
+				if v, present := obj[name]; !present || v == nil {
+					problems = append(problems, path+"."+name+": required field is missing")
+				}
+			}
+		}
+		if properties, ok := schema["properties"].(map[string]any); ok {
+			names := make([]string, 0, len(properties))
+			for name := range properties {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			for _, name := range names {
+				propSchema, _ := properties[name].(map[string]any)
+				if v, present := obj[name]; present && v != nil {
+					problems = append(problems, validateSchema(propSchema, v, path+"."+name)...)
+				}
+			}
+		}
+
+	case "array":
+		arr, ok := value.([]any)
+		if !ok {
+			return []string{path + ": expected an array"}
+		}
+		if minItems, ok := schemaNumber(schema["minItems"]); ok && float64(len(arr)) < minItems {
+			problems = append(problems, fmt.Sprintf("%s: expected at least %v items, got %d", path, minItems, len(arr)))
+		}
+		if maxItems, ok := schemaNumber(schema["maxItems"]); ok && float64(len(arr)) > maxItems {
+			problems = append(problems, fmt.Sprintf("%s: expected at most %v items, got %d", path, maxItems, len(arr)))
+		}
+		if items, ok := schema["items"].(map[string]any); ok {
+			for i, item := range arr {
+				problems = append(problems, validateSchema(items, item, fmt.Sprintf("%s[%d]", path, i))...)
+			}
+		}
+
+	case "string":
+		str, ok := value.(string)
+		if !ok {
+			return []string{path + ": expected a string"}
+		}
+		if enum, ok := schema["enum"].([]string); ok {
+			found := false
+			for _, allowed := range enum {
+				if str == allowed {
+					found = true
+					break
+				}
+			}
+			if !found {
+				problems = append(problems, fmt.Sprintf("%s: %q is not one of %s", path, str, strings.Join(enum, ", ")))
+			}
+		}
+
+	case "integer", "number":
+		num, ok := value.(float64)
+		if !ok {
+			return []string{path + ": expected a number"}
+		}
+		if schema["type"] == "integer" && num != math.Trunc(num) {
+			problems = append(problems, fmt.Sprintf("%s: expected an integer, got %v", path, num))
+		}
+		if minimum, ok := schemaNumber(schema["minimum"]); ok && num < minimum {
+			problems = append(problems, fmt.Sprintf("%s: %v is below the minimum of %v", path, num, minimum))
+		}
+		if maximum, ok := schemaNumber(schema["maximum"]); ok && num > maximum {
+			problems = append(problems, fmt.Sprintf("%s: %v is above the maximum of %v", path, num, maximum))
+		}
+
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return []string{path + ": expected a boolean"}
+		}
+	}
+
+	return problems
+}
+
+// schemaNumber reads a numeric schema keyword, which the schemas write as int or float64.
+func schemaNumber(v any) (float64, bool) {
+	switch n := v.(type) {
+	case int:
+		return float64(n), true
+	case float64:
+		return n, true
+	}
+	return 0, false
+}
+
+// buildRepairPrompt asks the model to correct its previous response.
+func buildRepairPrompt(prompt, response string, problems []string) string {
+	var sb strings.Builder
+
+	sb.WriteString(prompt)
+	sb.WriteString("\n\n## Your Previous Response\n")
+	if len(response) > maxRepairEchoLength {
+		response = response[:maxRepairEchoLength] + "\n... (truncated)"
+	}
+	sb.WriteString(response)
+	sb.WriteString("\n\n## Problems With That Response\n")
+	for _, problem := range problems {
+		sb.WriteString(fmt.Sprintf("- %s\n", problem))
+	}
+	sb.WriteString("\n## Repair Instructions\n")
+	sb.WriteString("Return the corrected response as a single JSON value that matches the response schema.\n")
+	sb.WriteString("Keep all content that was valid and fix only the problems listed above.\n")
+	sb.WriteString("Do not wrap the JSON in markdown code fences or add any other text.\n")
+
+	return sb.String()
+}
@@ -7,6 +7,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -31,13 +34,15 @@ func NewClient(httpClient *http.Client, publicURL, adminURL string) service.Iden
 
 // kratosIdentityResponse represents the Kratos identity response.
 type kratosIdentityResponse struct {
-	ID     string `json:"id"`
-	Traits struct {
+	ID        string    `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	Traits    struct {
 		Email string `json:"email"`
 		Name  struct {
 			First string `json:"first"`
 			Last  string `json:"last"`
 		} `json:"name"`
+		Locale string `json:"locale"`
 	} `json:"traits"`
 }
 
@@ -133,6 +138,7 @@ func (c *Client) CreateIdentity(ctx context.Context, req service.CreateIdentityR
 		Email:     identity.Traits.Email,
 		FirstName: identity.Traits.Name.First,
 		LastName:  identity.Traits.Name.Last,
+		Locale:    identity.Traits.Locale,
 	}, nil
 }
 
@@ -211,6 +217,7 @@ func (c *Client) CreateIdentityWithHash(ctx context.Context, req service.CreateI
 		Email:     identity.Traits.Email,
 		FirstName: identity.Traits.Name.First,
 		LastName:  identity.Traits.Name.Last,
+		Locale:    identity.Traits.Locale,
 	}, nil
 }
 
@@ -248,9 +255,233 @@ func (c *Client) GetIdentity(ctx context.Context, identityID string) (*service.I
 		Email:     identity.Traits.Email,
 		FirstName: identity.Traits.Name.First,
 		LastName:  identity.Traits.Name.Last,
+		Locale:    identity.Traits.Locale,
+		CreatedAt: identity.CreatedAt,
 	}, nil
 }
 
+// ListIdentities returns one page of identities using the Kratos admin API.
+// Kratos uses keyset pagination: the token for the next page is read from the Link header.
+func (c *Client) ListIdentities(ctx context.Context, pageSize int, pageToken string) (*service.IdentityPage, error) {
+	query := url.Values{}
+	if pageSize > 0 {
+		query.Set("page_size", strconv.Itoa(pageSize))
+	}
+	if pageToken != "" {
+		query.Set("page_token", pageToken)
+	}
+	reqURL := fmt.Sprintf("%s/admin/identities?%s", c.adminURL, query.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, "GET", reqURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call Kratos: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("Kratos returned status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var identities []kratosIdentityResponse
+	if err := json.NewDecoder(resp.Body).Decode(&identities); err != nil {
+		return nil, fmt.Errorf("failed to parse identities: %w", err)
+	}
+
+	page := &service.IdentityPage{
+		Identities:    make([]*service.Identity, 0, len(identities)),
+		NextPageToken: nextPageToken(resp.Header.Get("Link")),
+	}
+	for _, identity := range identities {
+		page.Identities = append(page.Identities, &service.Identity{
+			ID:        identity.ID,
+			Email:     identity.Traits.Email,
+			FirstName: identity.Traits.Name.First,
+			LastName:  identity.Traits.Name.Last,
+			Locale:    identity.Traits.Locale,
+			CreatedAt: identity.CreatedAt,
+		})
+	}
+	// An empty page means there is nothing left, even if Kratos still sent a next link.
+	if len(page.Identities) == 0 {
+		page.NextPageToken = ""
+	}
+	return page, nil
+}
+
+// GetIdentitiesByIDs retrieves several identities in one call using the ids filter of the
+// admin list endpoint. Identities that don't exist are left out.
+func (c *Client) GetIdentitiesByIDs(ctx context.Context, identityIDs []string) ([]*service.Identity, error) {
+	if len(identityIDs) == 0 {
+		return nil, nil
+	}
+	query := url.Values{}
+	for _, id := range identityIDs {
+		query.Add("ids", id)
+	}
+	query.Set("page_size", strconv.Itoa(len(identityIDs)))
+	reqURL := fmt.Sprintf("%s/admin/identities?%s", c.adminURL, query.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, "GET", reqURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call Kratos: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("Kratos returned status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var identities []kratosIdentityResponse
+	if err := json.NewDecoder(resp.Body).Decode(&identities); err != nil {
+		return nil, fmt.Errorf("failed to parse identities: %w", err)
+	}
+
+	result := make([]*service.Identity, 0, len(identities))
+	for _, identity := range identities {
+		result = append(result, &service.Identity{
+			ID:        identity.ID,
+			Email:     identity.Traits.Email,
+			FirstName: identity.Traits.Name.First,
+			LastName:  identity.Traits.Name.Last,
+			Locale:    identity.Traits.Locale,
+			CreatedAt: identity.CreatedAt,
+		})
+	}
+	return result, nil
+}
+
+// nextPageToken extracts the page_token of the rel="next" entry from a Link header.
+func nextPageToken(linkHeader string) string {
+	for _, link := range strings.Split(linkHeader, ",") {
+		parts := strings.Split(link, ";")
+		if len(parts) < 2 {
+			continue
+		}
+		isNext := false
+		for _, param := range parts[1:] {
+			if strings.TrimSpace(param) == `rel="next"` {
+				isNext = true
+				break
+			}
+		}
+		if !isNext {
+			continue
+		}
+		target := strings.Trim(strings.TrimSpace(parts[0]), "<>")
+		u, err := url.Parse(target)
+		if err != nil {
+			return ""
+		}
+		return u.Query().Get("page_token")
+	}
+	return ""
+}
+
+// DeleteIdentity permanently deletes an identity using the Kratos admin API.
+func (c *Client) DeleteIdentity(ctx context.Context, identityID string) error {
+	reqURL := fmt.Sprintf("%s/admin/identities/%s", c.adminURL, identityID)
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", reqURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call Kratos: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Already gone is the outcome we want
+	if resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("Kratos returned status %d: %s", resp.StatusCode, string(body))
+	}
+
+	return nil
+}
+
+// SetIdentityActive sets an identity's state to active or inactive using the Kratos admin API.
+// Kratos refuses to create sessions for inactive identities, but existing sessions stay
+// valid until revoked, so they are revoked here when deactivating.
+func (c *Client) SetIdentityActive(ctx context.Context, identityID string, active bool) error {
+	state := "active"
+	if !active {
+		state = "inactive"
+	}
+
+	patch := []map[string]interface{}{
+		{"op": "replace", "path": "/state", "value": state},
+	}
+	body, err := json.Marshal(patch)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	reqURL := fmt.Sprintf("%s/admin/identities/%s", c.adminURL, identityID)
+	req, err := http.NewRequestWithContext(ctx, "PATCH", reqURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call Kratos: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("Kratos returned status %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	if active {
+		return nil
+	}
+	return c.revokeSessions(ctx, identityID)
+}
+
+// revokeSessions deletes every session of an identity.
+func (c *Client) revokeSessions(ctx context.Context, identityID string) error {
+	reqURL := fmt.Sprintf("%s/admin/identities/%s/sessions", c.adminURL, identityID)
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", reqURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call Kratos: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Kratos answers 404 when the identity has no sessions
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusNotFound {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("Kratos returned status %d: %s", resp.StatusCode, string(body))
+	}
+
+	return nil
+}
+
 // CheckEmailExists checks if an email is already registered.
 func (c *Client) CheckEmailExists(ctx context.Context, email string) (bool, error) {
 	url := fmt.Sprintf("%s/admin/identities?credentials_identifier=%s", c.adminURL, email)
@@ -278,6 +509,48 @@ func (c *Client) CheckEmailExists(ctx context.Context, email string) (bool, erro
 	return len(identities) > 0, nil
 }
 
+// GetIdentityByEmail retrieves the identity whose login identifier is the email.
+// Returns (nil, nil) if there is none.
+func (c *Client) GetIdentityByEmail(ctx context.Context, email string) (*service.Identity, error) {
+	query := url.Values{}
+	query.Set("credentials_identifier", email)
+	reqURL := fmt.Sprintf("%s/admin/identities?%s", c.adminURL, query.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, "GET", reqURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call Kratos: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("Kratos returned status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var identities []kratosIdentityResponse
+	if err := json.NewDecoder(resp.Body).Decode(&identities); err != nil {
+		return nil, fmt.Errorf("failed to parse identities: %w", err)
+	}
+	if len(identities) == 0 {
+		return nil, nil
+	}
+
+	identity := identities[0]
+	return &service.Identity{
+		ID:        identity.ID,
+		Email:     identity.Traits.Email,
+		FirstName: identity.Traits.Name.First,
+		LastName:  identity.Traits.Name.Last,
+		Locale:    identity.Traits.Locale,
+		CreatedAt: identity.CreatedAt,
+	}, nil
+}
+
 // PerformLogin performs a self-service login via Kratos API flow.
 // This creates a session by going through the login flow with credentials.
 // Returns a session token that can be used to authenticate requests.
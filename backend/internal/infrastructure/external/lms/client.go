@@ -0,0 +1,211 @@
+package lms
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+const (
+	// SignatureHeader carries the hex HMAC-SHA256 of "timestamp.body" keyed with the webhook secret.
+	SignatureHeader = "X-Mirai-Signature"
+	// TimestampHeader carries the Unix time the webhook was signed at.
+	TimestampHeader = "X-Mirai-Timestamp"
+	// DeliveryHeader carries the delivery ID so receivers can ignore repeats.
+	DeliveryHeader = "X-Mirai-Delivery"
+	// KindHeader is "test" or "replay" on diagnostic deliveries an admin sent; it is
+	// absent on normal syncs.
+	KindHeader = "X-Mirai-Delivery-Kind"
+
+	// maxResponseBytes bounds how much of an LMS response is read for the remote ID.
+	maxResponseBytes = 64 << 10
+
+	// maxBodyExcerptBytes bounds the response body returned to callers.
+	maxBodyExcerptBytes = 1 << 10
+)
+
+// Client implements service.LMSPusher over HTTP.
+type Client struct {
+	httpClient *http.Client
+}
+
+// NewClient creates a new LMS push client. Endpoints are set by tenant admins, so
+// httpClient should refuse non-public addresses, as httputil.NewPublicClientWithTimeout's does.
+func NewClient(httpClient *http.Client) service.LMSPusher {
+	return &Client{httpClient: httpClient}
+}
+
+// webhookPayload is the JSON body sent to webhook connectors.
+type webhookPayload struct {
+	Event       string    `json:"event"`
+	Kind        string    `json:"kind,omitempty"` // Set on test and replay deliveries
+	DeliveryID  string    `json:"deliveryId"`
+	CourseID    string    `json:"courseId,omitempty"`
+	CourseTitle string    `json:"courseTitle,omitempty"`
+	FileName    string    `json:"fileName,omitempty"`
+	Format      string    `json:"format,omitempty"`
+	DownloadURL string    `json:"downloadUrl,omitempty"`
+	SentAt      time.Time `json:"sentAt"`
+}
+
+// Push delivers a course to the connector's endpoint. Webhook connectors get a signed
+// JSON notification with a download link; HTTP push connectors get the ZIP itself.
+func (c *Client) Push(ctx context.Context, req service.LMSPushRequest) (*service.LMSPushResult, error) {
+	var httpReq *http.Request
+	var err error
+
+	switch req.Type {
+	case valueobject.LMSConnectorTypeWebhook:
+		httpReq, err = c.webhookRequest(ctx, req)
+	case valueobject.LMSConnectorTypeHTTPPush:
+		httpReq, err = c.pushRequest(ctx, req)
+	default:
+		return nil, fmt.Errorf("unsupported connector type: %s", req.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set(DeliveryHeader, req.DeliveryID.String())
+	if isDiagnostic(req.Kind) {
+		httpReq.Header.Set(KindHeader, req.Kind.String())
+	}
+
+	start := time.Now()
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reach LMS: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBytes))
+	result := &service.LMSPushResult{
+		StatusCode: resp.StatusCode,
+		Latency:    time.Since(start),
+		Body:       bodyExcerpt(body),
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return result, fmt.Errorf("LMS responded with %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	result.RemoteID = remoteID(resp, body)
+	return result, nil
+}
+
+// webhookRequest builds the signed JSON notification.
+func (c *Client) webhookRequest(ctx context.Context, req service.LMSPushRequest) (*http.Request, error) {
+	payload := webhookPayload{
+		Event:      req.Event,
+		DeliveryID: req.DeliveryID.String(),
+		SentAt:     time.Now().UTC(),
+	}
+	if payload.Event == "" {
+		payload.Event = service.LMSEventCoursePublished
+	}
+	if isDiagnostic(req.Kind) {
+		payload.Kind = req.Kind.String()
+	}
+	if payload.Event == service.LMSEventCoursePublished {
+		if req.CourseID != uuid.Nil {
+			payload.CourseID = req.CourseID.String()
+		}
+		payload.CourseTitle = req.CourseTitle
+		payload.FileName = req.FileName
+		payload.Format = "markdown-zip"
+		payload.DownloadURL = req.DownloadURL
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal webhook payload: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, req.EndpointURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	if req.Credential != "" {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		mac := hmac.New(sha256.New, []byte(req.Credential))
+		mac.Write([]byte(timestamp + "."))
+		mac.Write(body)
+		httpReq.Header.Set(TimestampHeader, timestamp)
+		httpReq.Header.Set(SignatureHeader, "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	}
+	return httpReq, nil
+}
+
+// pushRequest builds the upload of the course ZIP.
+func (c *Client) pushRequest(ctx context.Context, req service.LMSPushRequest) (*http.Request, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, req.EndpointURL, req.Artifact)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/zip")
+	httpReq.Header.Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", req.FileName))
+	httpReq.Header.Set("X-Mirai-Course-Id", req.CourseID.String())
+	if req.Credential != "" {
+		httpReq.Header.Set("Authorization", "Bearer "+req.Credential)
+	}
+	return httpReq, nil
+}
+
+// isDiagnostic reports whether the delivery was sent by an admin rather than by a sync.
+func isDiagnostic(kind valueobject.CourseSyncDeliveryKind) bool {
+	return kind == valueobject.CourseSyncDeliveryKindTest || kind == valueobject.CourseSyncDeliveryKindReplay
+}
+
+// bodyExcerpt returns the start of a response body as text. Invalid UTF-8, including a
+// character split by the cut, is dropped.
+func bodyExcerpt(body []byte) string {
+	if len(body) > maxBodyExcerptBytes {
+		body = body[:maxBodyExcerptBytes]
+	}
+	return strings.TrimSpace(strings.ToValidUTF8(string(body), ""))
+}
+
+// remoteID finds the identifier the LMS gave the course: an "id" or "remoteId" field in
+// a JSON response, or else the Location header.
+func remoteID(resp *http.Response, body []byte) string {
+	var parsed struct {
+		ID       json.RawMessage `json:"id"`
+		RemoteID json.RawMessage `json:"remoteId"`
+	}
+	if json.Unmarshal(body, &parsed) == nil {
+		for _, raw := range []json.RawMessage{parsed.RemoteID, parsed.ID} {
+			if id := rawID(raw); id != "" {
+				return id
+			}
+		}
+	}
+	return resp.Header.Get("Location")
+}
+
+// rawID returns a JSON string or number as text.
+func rawID(raw json.RawMessage) string {
+	if len(raw) == 0 {
+		return ""
+	}
+	var s string
+	if json.Unmarshal(raw, &s) == nil {
+		return s
+	}
+	var n json.Number
+	if json.Unmarshal(raw, &n) == nil {
+		return n.String()
+	}
+	return ""
+}
@@ -0,0 +1,135 @@
+package smtp
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/sogos/mirai-backend/internal/domain/service"
+)
+
+// icsMaxLineOctets is the longest content line RFC 5545 allows before it must be folded.
+const icsMaxLineOctets = 75
+
+// sequenceEpoch is where invite sequence numbers start counting from (see calendarEvent).
+var sequenceEpoch = time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// calendarEvent is an all-day event sent as an ICS attachment.
+//
+// Calendars match an event to the one already on the calendar by UID, and replace it
+// when the new copy has a higher SEQUENCE. The UID must therefore stay the same for as
+// long as the event exists. Nothing records how often an event was re-sent, so the
+// sequence is the number of minutes from sequenceEpoch to the stamp: each later send
+// outranks the ones before it.
+type calendarEvent struct {
+	UID         string
+	Stamp       time.Time // When the invite was generated
+	Date        time.Time // Only the calendar date is used
+	Summary     string
+	Description string
+	URL         string
+}
+
+// ics renders the event as an iCalendar object with CRLF line endings.
+func (e calendarEvent) ics() []byte {
+	start := time.Date(e.Date.Year(), e.Date.Month(), e.Date.Day(), 0, 0, 0, 0, time.UTC)
+
+	lines := []string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//Mirai//SME Tasks//EN",
+		"CALSCALE:GREGORIAN",
+		"METHOD:PUBLISH",
+		"BEGIN:VEVENT",
+		"UID:" + icsEscape(e.UID),
+		fmt.Sprintf("SEQUENCE:%d", icsSequence(e.Stamp)),
+		"DTSTAMP:" + e.Stamp.UTC().Format("20060102T150405Z"),
+		"DTSTART;VALUE=DATE:" + start.Format("20060102"),
+		"DTEND;VALUE=DATE:" + start.AddDate(0, 0, 1).Format("20060102"),
+		"SUMMARY:" + icsEscape(e.Summary),
+	}
+	if e.Description != "" {
+		lines = append(lines, "DESCRIPTION:"+icsEscape(e.Description))
+	}
+	if e.URL != "" {
+		// URL is a URI value, not text, so it isn't escaped; line breaks are dropped
+		lines = append(lines, "URL:"+strings.NewReplacer("\r", "", "\n", "").Replace(e.URL))
+	}
+	lines = append(lines,
+		"TRANSP:TRANSPARENT", // A due date doesn't block the day
+		"END:VEVENT",
+		"END:VCALENDAR",
+	)
+
+	var b strings.Builder
+	for _, line := range lines {
+		b.WriteString(icsFold(line))
+		b.WriteString("\r\n")
+	}
+	return []byte(b.String())
+}
+
+// icsSequence returns the event's SEQUENCE for an invite generated at stamp.
+func icsSequence(stamp time.Time) int {
+	minutes := int(stamp.Sub(sequenceEpoch) / time.Minute)
+	if minutes < 0 {
+		return 0
+	}
+	return minutes
+}
+
+// icsEscape escapes a TEXT value per RFC 5545 section 3.3.11.
+func icsEscape(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", `\n`,
+	).Replace(s)
+}
+
+// icsFold splits a content line longer than 75 octets into a line and continuation
+// lines starting with a space, per RFC 5545 section 3.1. UTF-8 sequences are never split.
+func icsFold(line string) string {
+	if len(line) <= icsMaxLineOctets {
+		return line
+	}
+
+	var b strings.Builder
+	limit := icsMaxLineOctets
+	n := 0
+	for len(line) > 0 {
+		_, size := utf8.DecodeRuneInString(line)
+		if n+size > limit {
+			b.WriteString("\r\n ")
+			// The leading space counts towards the continuation line's length
+			limit = icsMaxLineOctets - 1
+			n = 0
+		}
+		b.WriteString(line[:size])
+		line = line[size:]
+		n += size
+	}
+	return b.String()
+}
+
+// taskDueDateEvent builds the calendar event for an SME task's due date. The UID is
+// derived from the task ID, so re-sending it after the due date changes moves the
+// existing entry instead of adding a second one.
+func (c *Client) taskDueDateEvent(req service.SendTaskAssignmentRequest) calendarEvent {
+	description := "SME: " + req.SMEName
+	if req.TaskURL != "" {
+		description += "\n\n" + req.TaskURL
+	}
+	return calendarEvent{
+		UID:         fmt.Sprintf("sme-task-%s@%s", req.TaskID, c.senderDomain()),
+		Stamp:       time.Now(),
+		Date:        *req.DueDate,
+		Summary:     fmt.Sprintf("%s (%s)", req.TaskTitle, req.SMEName),
+		Description: description,
+		URL:         req.TaskURL,
+	}
+}
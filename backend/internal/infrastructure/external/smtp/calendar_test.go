@@ -0,0 +1,341 @@
+package smtp
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/service"
+)
+
+// icsProperty is one unfolded content line of an iCalendar object.
+type icsProperty struct {
+	Name   string
+	Params map[string]string
+	Value  string
+}
+
+// parseICS parses data per RFC 5545 section 3.1: it checks every physical line ends in
+// CRLF, is at most 75 octets and is valid UTF-8, unfolds continuation lines, and splits
+// each content line into name, parameters and value. BEGIN and END must nest.
+func parseICS(t *testing.T, data []byte) []icsProperty {
+	t.Helper()
+	text := string(data)
+	if !strings.HasSuffix(text, "\r\n") {
+		t.Fatal("object does not end with CRLF")
+	}
+
+	var logical []string
+	for i, line := range strings.Split(strings.TrimSuffix(text, "\r\n"), "\r\n") {
+		if strings.ContainsAny(line, "\r\n") {
+			t.Fatalf("line %d has a bare CR or LF: %q", i+1, line)
+		}
+		if len(line) > icsMaxLineOctets {
+			t.Errorf("line %d is %d octets, longer than %d", i+1, len(line), icsMaxLineOctets)
+		}
+		if !utf8.ValidString(line) {
+			t.Errorf("line %d splits a UTF-8 sequence: %q", i+1, line)
+		}
+		if strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t") {
+			if len(logical) == 0 {
+				t.Fatal("object starts with a continuation line")
+			}
+			logical[len(logical)-1] += line[1:]
+			continue
+		}
+		logical = append(logical, line)
+	}
+
+	var props []icsProperty
+	var open []string
+	for _, line := range logical {
+		head, value, ok := strings.Cut(line, ":")
+		if !ok {
+			t.Fatalf("content line has no value: %q", line)
+		}
+		parts := strings.Split(head, ";")
+		prop := icsProperty{Name: parts[0], Params: make(map[string]string), Value: value}
+		for _, param := range parts[1:] {
+			key, val, ok := strings.Cut(param, "=")
+			if !ok {
+				t.Fatalf("malformed parameter %q in %q", param, line)
+			}
+			prop.Params[key] = val
+		}
+
+		switch prop.Name {
+		case "BEGIN":
+			open = append(open, value)
+		case "END":
+			if len(open) == 0 || open[len(open)-1] != value {
+				t.Fatalf("END:%s does not match the open components %v", value, open)
+			}
+			open = open[:len(open)-1]
+		}
+		props = append(props, prop)
+	}
+	if len(open) != 0 {
+		t.Fatalf("components left open: %v", open)
+	}
+	return props
+}
+
+// eventProperties returns the VEVENT's properties by name. Each may appear only once.
+func eventProperties(t *testing.T, props []icsProperty) map[string]icsProperty {
+	t.Helper()
+	event := make(map[string]icsProperty)
+	inEvent := false
+	for _, prop := range props {
+		switch {
+		case prop.Name == "BEGIN" && prop.Value == "VEVENT":
+			inEvent = true
+		case prop.Name == "END" && prop.Value == "VEVENT":
+			inEvent = false
+		case inEvent:
+			if _, dup := event[prop.Name]; dup {
+				t.Errorf("property %s appears more than once", prop.Name)
+			}
+			event[prop.Name] = prop
+		}
+	}
+	return event
+}
+
+// icsUnescape reverses a TEXT value's escaping per RFC 5545 section 3.3.11.
+func icsUnescape(t *testing.T, s string) string {
+	t.Helper()
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '\\':
+			if i+1 == len(s) {
+				t.Fatalf("value ends in a lone backslash: %q", s)
+			}
+			i++
+			switch s[i] {
+			case 'n', 'N':
+				b.WriteByte('\n')
+			case '\\', ';', ',':
+				b.WriteByte(s[i])
+			default:
+				t.Fatalf("invalid escape \\%c in %q", s[i], s)
+			}
+		case c == ';' || c == ',':
+			t.Fatalf("unescaped %q in TEXT value %q", c, s)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+func TestICSEscape(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"", ""},
+		{"Review slides", "Review slides"},
+		{"Q1; Q2, Q3", `Q1\; Q2\, Q3`},
+		{`C:\docs`, `C:\\docs`},
+		{`\;`, `\\\;`},
+		{"one\ntwo", `one\ntwo`},
+		{"one\r\ntwo", `one\ntwo`},
+		{"one\rtwo", `one\ntwo`},
+		{"Prüfung: Ärzte", "Prüfung: Ärzte"},
+	}
+	for _, tt := range tests {
+		if got := icsEscape(tt.in); got != tt.want {
+			t.Errorf("icsEscape(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestICSFold(t *testing.T) {
+	tests := []struct {
+		name string
+		line string
+	}{
+		{"short", "SUMMARY:Review"},
+		{"exactly 75 octets", "SUMMARY:" + strings.Repeat("a", 67)},
+		{"76 octets", "SUMMARY:" + strings.Repeat("a", 68)},
+		{"several continuations", "DESCRIPTION:" + strings.Repeat("abcdefghij", 30)},
+		{"two-byte runes", "SUMMARY:" + strings.Repeat("é", 80)},
+		{"three-byte runes", "SUMMARY:" + strings.Repeat("日本", 40)},
+		{"four-byte runes", "SUMMARY:" + strings.Repeat("🎓", 40)},
+		{"mixed runes across the boundary", "SUMMARY:" + strings.Repeat("a", 66) + "日本語のテキスト"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			folded := icsFold(tt.line)
+			physical := strings.Split(folded, "\r\n")
+			for i, line := range physical {
+				if len(line) > icsMaxLineOctets {
+					t.Errorf("line %d is %d octets", i, len(line))
+				}
+				if !utf8.ValidString(line) {
+					t.Errorf("line %d splits a UTF-8 sequence: %q", i, line)
+				}
+				if i > 0 && !strings.HasPrefix(line, " ") {
+					t.Errorf("continuation line %d does not start with a space: %q", i, line)
+				}
+			}
+			if len(tt.line) <= icsMaxLineOctets && len(physical) != 1 {
+				t.Errorf("line of %d octets was folded", len(tt.line))
+			}
+			if unfolded := strings.ReplaceAll(folded, "\r\n ", ""); unfolded != tt.line {
+				t.Errorf("unfolding gives %q, want %q", unfolded, tt.line)
+			}
+		})
+	}
+}
+
+func TestCalendarEventICS(t *testing.T) {
+	event := calendarEvent{
+		UID:         "sme-task-1@example.com",
+		Stamp:       time.Date(2025, time.March, 4, 15, 30, 0, 0, time.FixedZone("CET", 3600)),
+		Date:        time.Date(2025, time.March, 31, 23, 0, 0, 0, time.FixedZone("PDT", -7*3600)),
+		Summary:     "Interview: safety, hygiene; and C:\\notes (Dr. Müller)",
+		Description: "SME: Dr. Müller\n\n" + strings.Repeat("Prepare the onboarding questions. ", 6),
+		URL:         "https://app.example.com/smes?sme=a&task=b,c;d",
+	}
+	props := parseICS(t, event.ics())
+
+	if first, last := props[0], props[len(props)-1]; first.Name != "BEGIN" || first.Value != "VCALENDAR" ||
+		last.Name != "END" || last.Value != "VCALENDAR" {
+		t.Fatalf("object is not a VCALENDAR: starts %s:%s, ends %s:%s", first.Name, first.Value, last.Name, last.Value)
+	}
+	calendar := make(map[string]string)
+	for _, prop := range props {
+		calendar[prop.Name] = prop.Value
+	}
+	if calendar["VERSION"] != "2.0" || calendar["PRODID"] == "" {
+		t.Errorf("VERSION = %q, PRODID = %q; want 2.0 and a product ID", calendar["VERSION"], calendar["PRODID"])
+	}
+
+	vevent := eventProperties(t, props)
+	for _, name := range []string{"UID", "DTSTAMP", "DTSTART", "DTEND", "SEQUENCE", "SUMMARY"} {
+		if _, ok := vevent[name]; !ok {
+			t.Errorf("VEVENT has no %s", name)
+		}
+	}
+
+	if got := icsUnescape(t, vevent["UID"].Value); got != event.UID {
+		t.Errorf("UID = %q, want %q", got, event.UID)
+	}
+	if got := icsUnescape(t, vevent["SUMMARY"].Value); got != event.Summary {
+		t.Errorf("SUMMARY = %q, want %q", got, event.Summary)
+	}
+	if got := icsUnescape(t, vevent["DESCRIPTION"].Value); got != event.Description {
+		t.Errorf("DESCRIPTION = %q, want %q", got, event.Description)
+	}
+	if got := vevent["URL"].Value; got != event.URL {
+		t.Errorf("URL = %q, want %q", got, event.URL)
+	}
+
+	if got, want := vevent["DTSTAMP"].Value, "20250304T143000Z"; got != want {
+		t.Errorf("DTSTAMP = %q, want %q in UTC", got, want)
+	}
+	// The date is taken as written, not shifted to the date it falls on in UTC
+	for name, want := range map[string]string{"DTSTART": "20250331", "DTEND": "20250401"} {
+		prop := vevent[name]
+		if prop.Params["VALUE"] != "DATE" || prop.Value != want {
+			t.Errorf("%s = %v %q, want VALUE=DATE %q", name, prop.Params, prop.Value, want)
+		}
+	}
+	if got, want := vevent["SEQUENCE"].Value, strconv.Itoa(icsSequence(event.Stamp)); got != want {
+		t.Errorf("SEQUENCE = %q, want %q", got, want)
+	}
+}
+
+func TestCalendarEventICSOmitsEmptyOptionalProperties(t *testing.T) {
+	event := calendarEvent{UID: "u@example.com", Stamp: time.Now(), Date: time.Now(), Summary: "Task"}
+	vevent := eventProperties(t, parseICS(t, event.ics()))
+	for _, name := range []string{"DESCRIPTION", "URL"} {
+		if _, ok := vevent[name]; ok {
+			t.Errorf("VEVENT has an empty %s", name)
+		}
+	}
+}
+
+func TestCalendarEventICSDropsLineBreaksFromURL(t *testing.T) {
+	event := calendarEvent{UID: "u@example.com", Stamp: time.Now(), Date: time.Now(), Summary: "Task",
+		URL: "https://example.com/a\r\nATTACH:https://evil.example/x"}
+	vevent := eventProperties(t, parseICS(t, event.ics()))
+	if _, injected := vevent["ATTACH"]; injected {
+		t.Error("line break in URL injected a property")
+	}
+	if got := vevent["URL"].Value; strings.ContainsAny(got, "\r\n") {
+		t.Errorf("URL = %q still has a line break", got)
+	}
+}
+
+func TestTaskDueDateEventUIDIsStableAcrossUpdates(t *testing.T) {
+	client := &Client{from: "Mirai <notifications@mirai.example.com>"}
+	taskID := uuid.New()
+	firstDue := time.Date(2025, time.May, 1, 0, 0, 0, 0, time.UTC)
+	movedDue := time.Date(2025, time.May, 9, 0, 0, 0, 0, time.UTC)
+
+	req := service.SendTaskAssignmentRequest{
+		TaskID:    taskID,
+		TaskTitle: "Record the onboarding walkthrough",
+		SMEName:   "Ana",
+		TaskURL:   "https://app.example.com/smes?sme=1&task=2",
+		DueDate:   &firstDue,
+	}
+	first := client.taskDueDateEvent(req)
+	first.Stamp = time.Date(2025, time.April, 1, 9, 0, 0, 0, time.UTC)
+
+	req.DueDate = &movedDue
+	req.DueDateChanged = true
+	req.TaskTitle = "Record the onboarding walkthrough (v2)"
+	moved := client.taskDueDateEvent(req)
+	moved.Stamp = first.Stamp.Add(3 * 24 * time.Hour)
+
+	firstEvent := eventProperties(t, parseICS(t, first.ics()))
+	movedEvent := eventProperties(t, parseICS(t, moved.ics()))
+
+	wantUID := "sme-task-" + taskID.String() + "@mirai.example.com"
+	for name, event := range map[string]map[string]icsProperty{"first": firstEvent, "moved": movedEvent} {
+		if got := icsUnescape(t, event["UID"].Value); got != wantUID {
+			t.Errorf("%s invite UID = %q, want %q", name, got, wantUID)
+		}
+	}
+	if firstEvent["DTSTART"].Value == movedEvent["DTSTART"].Value {
+		t.Error("moved invite kept the old date")
+	}
+
+	firstSeq, _ := strconv.Atoi(firstEvent["SEQUENCE"].Value)
+	movedSeq, _ := strconv.Atoi(movedEvent["SEQUENCE"].Value)
+	if movedSeq <= firstSeq {
+		t.Errorf("moved invite SEQUENCE = %d, want more than %d", movedSeq, firstSeq)
+	}
+
+	other := client.taskDueDateEvent(service.SendTaskAssignmentRequest{TaskID: uuid.New(), DueDate: &firstDue})
+	if other.UID == first.UID {
+		t.Error("two tasks share a UID")
+	}
+}
+
+func TestICSSequence(t *testing.T) {
+	tests := []struct {
+		stamp time.Time
+		want  int
+	}{
+		{sequenceEpoch.Add(-time.Hour), 0},
+		{sequenceEpoch, 0},
+		{sequenceEpoch.Add(59 * time.Second), 0},
+		{sequenceEpoch.Add(time.Minute), 1},
+		{sequenceEpoch.Add(24 * time.Hour), 1440},
+	}
+	for _, tt := range tests {
+		if got := icsSequence(tt.stamp); got != tt.want {
+			t.Errorf("icsSequence(%s) = %d, want %d", tt.stamp, got, tt.want)
+		}
+	}
+}
@@ -3,11 +3,24 @@ package smtp
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
+	"errors"
 	"fmt"
-	"html/template"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
 	"net/smtp"
+	"net/textproto"
+	"strings"
 
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
 	"github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/domain/tenant"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
 )
 
 // Client implements service.EmailProvider using SMTP.
@@ -18,10 +31,13 @@ type Client struct {
 	username   string
 	password   string
 	adminEmail string
+	emailLog   repository.EmailLogRepository
+	logger     service.Logger
 }
 
-// NewClient creates a new SMTP client.
-func NewClient(host, port, from, username, password, adminEmail string) service.EmailProvider {
+// NewClient creates a new SMTP client. Sends made with a tenant context are recorded in
+// emailLog; pass nil to disable recording.
+func NewClient(host, port, from, username, password, adminEmail string, emailLog repository.EmailLogRepository, logger service.Logger) service.EmailProvider {
 	return &Client{
 		host:       host,
 		port:       port,
@@ -29,45 +45,61 @@ func NewClient(host, port, from, username, password, adminEmail string) service.
 		username:   username,
 		password:   password,
 		adminEmail: adminEmail,
+		emailLog:   emailLog,
+		logger:     logger,
 	}
 }
 
 // SendInvitation sends an invitation email.
 func (c *Client) SendInvitation(ctx context.Context, req service.SendInvitationRequest) error {
-	subject := fmt.Sprintf("You've been invited to join %s on Mirai", req.CompanyName)
-
-	body, err := c.renderInvitationEmail(req)
-	if err != nil {
-		return fmt.Errorf("failed to render email template: %w", err)
-	}
-
-	return c.sendEmail(req.To, subject, body)
+	return c.send(ctx, req.To, templateInvitation, req.Locale, req)
 }
 
 // SendWelcome sends a welcome email after account provisioning.
 func (c *Client) SendWelcome(ctx context.Context, req service.SendWelcomeRequest) error {
-	subject := "Welcome to Mirai! Your account is ready"
+	return c.send(ctx, req.To, templateWelcome, req.Locale, req)
+}
+
+// attachment is a file sent along with an email.
+type attachment struct {
+	Filename    string
+	ContentType string // Full media type, including parameters
+	Data        []byte
+}
 
-	body, err := c.renderWelcomeEmail(req)
+// send renders an email template in the recipient's locale and sends it.
+func (c *Client) send(ctx context.Context, to, name string, locale valueobject.Locale, data any, attachments ...attachment) error {
+	subject, body, err := renderTemplate(name, locale, data)
 	if err != nil {
 		return fmt.Errorf("failed to render email template: %w", err)
 	}
 
-	return c.sendEmail(req.To, subject, body)
+	return c.sendEmail(ctx, to, name, subject, body, attachments...)
 }
 
-// sendEmail sends an email via SMTP.
-func (c *Client) sendEmail(to, subject, body string) error {
+// sendEmail sends an email via SMTP and records the server's answer in the email log.
+// With attachments, the HTML body becomes the first part of a multipart/mixed message.
+func (c *Client) sendEmail(ctx context.Context, to, name, subject, body string, attachments ...attachment) error {
 	addr := fmt.Sprintf("%s:%s", c.host, c.port)
+	messageID := c.newMessageID()
 
 	// Build email headers and body
+	contentType, content := "text/html; charset=\"UTF-8\"", body
+	if len(attachments) > 0 {
+		var err error
+		contentType, content, err = buildMixedBody(body, attachments)
+		if err != nil {
+			return fmt.Errorf("failed to build email: %w", err)
+		}
+	}
 	msg := fmt.Sprintf("From: %s\r\n"+
 		"To: %s\r\n"+
 		"Subject: %s\r\n"+
+		"Message-ID: %s\r\n"+
 		"MIME-Version: 1.0\r\n"+
-		"Content-Type: text/html; charset=\"UTF-8\"\r\n"+
+		"Content-Type: %s\r\n"+
 		"\r\n"+
-		"%s", c.from, to, subject, body)
+		"%s", c.from, to, mime.QEncoding.Encode("utf-8", subject), messageID, contentType, content)
 
 	// Use auth only if username is provided
 	var auth smtp.Auth
@@ -76,6 +108,7 @@ func (c *Client) sendEmail(to, subject, body string) error {
 	}
 
 	err := smtp.SendMail(addr, auth, c.from, []string{to}, []byte(msg))
+	c.recordOutcome(ctx, to, name, messageID, err)
 	if err != nil {
 		return fmt.Errorf("failed to send email: %w", err)
 	}
@@ -83,781 +116,178 @@ func (c *Client) sendEmail(to, subject, body string) error {
 	return nil
 }
 
-// renderInvitationEmail renders the invitation email HTML template.
-func (c *Client) renderInvitationEmail(req service.SendInvitationRequest) (string, error) {
-	const emailTemplate = `<!DOCTYPE html>
-<html>
-<head>
-    <meta charset="UTF-8">
-    <meta name="viewport" content="width=device-width, initial-scale=1.0">
-    <title>Team Invitation</title>
-</head>
-<body style="margin: 0; padding: 0; font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', Roboto, Oxygen, Ubuntu, sans-serif; background-color: #f5f5f5;">
-    <table role="presentation" cellspacing="0" cellpadding="0" border="0" width="100%" style="background-color: #f5f5f5;">
-        <tr>
-            <td style="padding: 40px 20px;">
-                <table role="presentation" cellspacing="0" cellpadding="0" border="0" width="100%" style="max-width: 600px; margin: 0 auto; background-color: #ffffff; border-radius: 8px; box-shadow: 0 2px 8px rgba(0,0,0,0.1);">
-                    <!-- Header -->
-                    <tr>
-                        <td style="padding: 40px 40px 20px 40px; text-align: center;">
-                            <h1 style="margin: 0; color: #7c3aed; font-size: 28px; font-weight: 700;">Mirai</h1>
-                        </td>
-                    </tr>
-                    <!-- Content -->
-                    <tr>
-                        <td style="padding: 20px 40px;">
-                            <h2 style="margin: 0 0 20px 0; color: #1f2937; font-size: 24px; font-weight: 600;">You're invited to join {{.CompanyName}}</h2>
-                            <p style="margin: 0 0 20px 0; color: #4b5563; font-size: 16px; line-height: 1.6;">
-                                {{.InviterName}} has invited you to join their team on Mirai. Click the button below to accept the invitation and get started.
-                            </p>
-                            <table role="presentation" cellspacing="0" cellpadding="0" border="0" width="100%">
-                                <tr>
-                                    <td style="padding: 20px 0; text-align: center;">
-                                        <a href="{{.InviteURL}}" style="display: inline-block; padding: 14px 32px; background-color: #7c3aed; color: #ffffff; text-decoration: none; font-size: 16px; font-weight: 600; border-radius: 8px;">Accept Invitation</a>
-                                    </td>
-                                </tr>
-                            </table>
-                            <p style="margin: 20px 0 0 0; color: #6b7280; font-size: 14px;">
-                                This invitation expires on {{.ExpiresAt}}.
-                            </p>
-                        </td>
-                    </tr>
-                    <!-- Footer -->
-                    <tr>
-                        <td style="padding: 20px 40px 40px 40px; border-top: 1px solid #e5e7eb;">
-                            <p style="margin: 0; color: #9ca3af; font-size: 12px; text-align: center;">
-                                If you didn't expect this invitation, you can safely ignore this email.
-                            </p>
-                        </td>
-                    </tr>
-                </table>
-            </td>
-        </tr>
-    </table>
-</body>
-</html>`
-
-	tmpl, err := template.New("invitation").Parse(emailTemplate)
-	if err != nil {
-		return "", err
-	}
-
-	var buf bytes.Buffer
-	if err := tmpl.Execute(&buf, req); err != nil {
-		return "", err
+// recordOutcome writes a send to the email log. Only sends on behalf of a tenant are
+// recorded, and a failure to record never fails the send.
+func (c *Client) recordOutcome(ctx context.Context, to, name, messageID string, sendErr error) {
+	if c.emailLog == nil {
+		return
+	}
+	tenantID, ok := tenant.FromContext(ctx)
+	if !ok || tenantID == uuid.Nil {
+		return
+	}
+
+	entry := &entity.EmailLogEntry{
+		TenantID:  tenantID,
+		Recipient: to,
+		Template:  name,
+		MessageID: messageID,
+	}
+	var protoErr *textproto.Error
+	switch {
+	case sendErr == nil:
+		// SendMail doesn't expose the server's text for the final reply, only that it was 2xx
+		code := 250
+		entry.Status = valueobject.EmailDeliveryStatusAccepted
+		entry.SMTPCode = &code
+	case errors.As(sendErr, &protoErr):
+		entry.Status = valueobject.EmailDeliveryStatusRejected
+		if protoErr.Code < 500 {
+			entry.Status = valueobject.EmailDeliveryStatusDeferred
+		}
+		entry.SMTPCode = &protoErr.Code
+		entry.SMTPResponse = protoErr.Msg
+	default:
+		entry.Status = valueobject.EmailDeliveryStatusFailed
+		entry.SMTPResponse = sendErr.Error()
+	}
+
+	// The send already happened; record it even if the request was cancelled meanwhile
+	if err := c.emailLog.Create(context.WithoutCancel(ctx), entry); err != nil && c.logger != nil {
+		c.logger.Warn("failed to record email send", "template", name, "messageID", messageID, "error", err)
 	}
-
-	return buf.String(), nil
 }
 
-// SendTaskAssignment sends a task assignment notification email.
-func (c *Client) SendTaskAssignment(ctx context.Context, req service.SendTaskAssignmentRequest) error {
-	subject := fmt.Sprintf("New Task Assigned: %s", req.TaskTitle)
+// buildMixedBody wraps an HTML body and its attachments in a multipart/mixed body and
+// returns the body with its Content-Type. Attachments are base64 encoded.
+func buildMixedBody(html string, attachments []attachment) (contentType, body string, err error) {
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
 
-	body, err := c.renderTaskAssignmentEmail(req)
+	part, err := w.CreatePart(textproto.MIMEHeader{
+		"Content-Type": {`text/html; charset="UTF-8"`},
+	})
 	if err != nil {
-		return fmt.Errorf("failed to render email template: %w", err)
+		return "", "", err
 	}
-
-	return c.sendEmail(req.To, subject, body)
-}
-
-// SendIngestionComplete sends an ingestion completion notification email.
-func (c *Client) SendIngestionComplete(ctx context.Context, req service.SendIngestionCompleteRequest) error {
-	subject := fmt.Sprintf("Content Processed: %s", req.SMEName)
-
-	body, err := c.renderIngestionCompleteEmail(req)
-	if err != nil {
-		return fmt.Errorf("failed to render email template: %w", err)
+	if _, err := part.Write([]byte(html)); err != nil {
+		return "", "", err
 	}
 
-	return c.sendEmail(req.To, subject, body)
-}
-
-// SendIngestionFailed sends an ingestion failure notification email.
-func (c *Client) SendIngestionFailed(ctx context.Context, req service.SendIngestionFailedRequest) error {
-	subject := fmt.Sprintf("Content Processing Failed: %s", req.TaskTitle)
-
-	body, err := c.renderIngestionFailedEmail(req)
-	if err != nil {
-		return fmt.Errorf("failed to render email template: %w", err)
+	for _, a := range attachments {
+		part, err := w.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {a.ContentType},
+			"Content-Disposition":       {mime.FormatMediaType("attachment", map[string]string{"filename": a.Filename})},
+			"Content-Transfer-Encoding": {"base64"},
+		})
+		if err != nil {
+			return "", "", err
+		}
+		if err := writeBase64Lines(part, a.Data); err != nil {
+			return "", "", err
+		}
 	}
 
-	return c.sendEmail(req.To, subject, body)
-}
-
-// SendGenerationComplete sends a generation completion notification email.
-func (c *Client) SendGenerationComplete(ctx context.Context, req service.SendGenerationCompleteRequest) error {
-	subject := fmt.Sprintf("AI Generation Complete: %s", req.CourseTitle)
-
-	body, err := c.renderGenerationCompleteEmail(req)
-	if err != nil {
-		return fmt.Errorf("failed to render email template: %w", err)
+	if err := w.Close(); err != nil {
+		return "", "", err
 	}
-
-	return c.sendEmail(req.To, subject, body)
+	return mime.FormatMediaType("multipart/mixed", map[string]string{"boundary": w.Boundary()}), buf.String(), nil
 }
 
-// SendGenerationFailed sends a generation failure notification email.
-func (c *Client) SendGenerationFailed(ctx context.Context, req service.SendGenerationFailedRequest) error {
-	subject := fmt.Sprintf("AI Generation Failed: %s", req.CourseTitle)
-
-	body, err := c.renderGenerationFailedEmail(req)
-	if err != nil {
-		return fmt.Errorf("failed to render email template: %w", err)
+// writeBase64Lines writes data base64 encoded in lines of 76 characters, the most MIME
+// allows.
+func writeBase64Lines(w io.Writer, data []byte) error {
+	encoded := base64.StdEncoding.EncodeToString(data)
+	for len(encoded) > 0 {
+		n := min(76, len(encoded))
+		if _, err := io.WriteString(w, encoded[:n]+"\r\n"); err != nil {
+			return err
+		}
+		encoded = encoded[n:]
 	}
-
-	return c.sendEmail(req.To, subject, body)
+	return nil
 }
 
-// renderWelcomeEmail renders the welcome email HTML template.
-func (c *Client) renderWelcomeEmail(req service.SendWelcomeRequest) (string, error) {
-	const emailTemplate = `<!DOCTYPE html>
-<html>
-<head>
-    <meta charset="UTF-8">
-    <meta name="viewport" content="width=device-width, initial-scale=1.0">
-    <title>Welcome to Mirai</title>
-</head>
-<body style="margin: 0; padding: 0; font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', Roboto, Oxygen, Ubuntu, sans-serif; background-color: #f5f5f5;">
-    <table role="presentation" cellspacing="0" cellpadding="0" border="0" width="100%" style="background-color: #f5f5f5;">
-        <tr>
-            <td style="padding: 40px 20px;">
-                <table role="presentation" cellspacing="0" cellpadding="0" border="0" width="100%" style="max-width: 600px; margin: 0 auto; background-color: #ffffff; border-radius: 8px; box-shadow: 0 2px 8px rgba(0,0,0,0.1);">
-                    <!-- Header -->
-                    <tr>
-                        <td style="padding: 40px 40px 20px 40px; text-align: center;">
-                            <h1 style="margin: 0; color: #7c3aed; font-size: 28px; font-weight: 700;">Mirai</h1>
-                        </td>
-                    </tr>
-                    <!-- Content -->
-                    <tr>
-                        <td style="padding: 20px 40px;">
-                            <h2 style="margin: 0 0 20px 0; color: #1f2937; font-size: 24px; font-weight: 600;">Welcome to Mirai, {{.FirstName}}!</h2>
-                            <p style="margin: 0 0 20px 0; color: #4b5563; font-size: 16px; line-height: 1.6;">
-                                Your account for <strong>{{.CompanyName}}</strong> has been successfully created. You can now log in and start building amazing courses with AI assistance.
-                            </p>
-                            <table role="presentation" cellspacing="0" cellpadding="0" border="0" width="100%">
-                                <tr>
-                                    <td style="padding: 20px 0; text-align: center;">
-                                        <a href="{{.LoginURL}}" style="display: inline-block; padding: 14px 32px; background-color: #7c3aed; color: #ffffff; text-decoration: none; font-size: 16px; font-weight: 600; border-radius: 8px;">Log In to Mirai</a>
-                                    </td>
-                                </tr>
-                            </table>
-                            <h3 style="margin: 30px 0 15px 0; color: #1f2937; font-size: 18px; font-weight: 600;">What's next?</h3>
-                            <ul style="margin: 0 0 20px 0; padding-left: 20px; color: #4b5563; font-size: 15px; line-height: 1.8;">
-                                <li>Create your first course with AI assistance</li>
-                                <li>Invite team members to collaborate</li>
-                                <li>Explore our course templates</li>
-                            </ul>
-                        </td>
-                    </tr>
-                    <!-- Footer -->
-                    <tr>
-                        <td style="padding: 20px 40px 40px 40px; border-top: 1px solid #e5e7eb;">
-                            <p style="margin: 0; color: #9ca3af; font-size: 12px; text-align: center;">
-                                If you have any questions, reply to this email or visit our help center.
-                            </p>
-                        </td>
-                    </tr>
-                </table>
-            </td>
-        </tr>
-    </table>
-</body>
-</html>`
-
-	tmpl, err := template.New("welcome").Parse(emailTemplate)
-	if err != nil {
-		return "", err
-	}
-
-	var buf bytes.Buffer
-	if err := tmpl.Execute(&buf, req); err != nil {
-		return "", err
-	}
-
-	return buf.String(), nil
+// newMessageID returns a unique Message-ID in the sender's domain.
+func (c *Client) newMessageID() string {
+	return fmt.Sprintf("<%s@%s>", uuid.New().String(), c.senderDomain())
 }
 
-// renderTaskAssignmentEmail renders the task assignment email template.
-func (c *Client) renderTaskAssignmentEmail(req service.SendTaskAssignmentRequest) (string, error) {
-	const emailTemplate = `<!DOCTYPE html>
-<html>
-<head>
-    <meta charset="UTF-8">
-    <meta name="viewport" content="width=device-width, initial-scale=1.0">
-    <title>Task Assignment</title>
-</head>
-<body style="margin: 0; padding: 0; font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', Roboto, Oxygen, Ubuntu, sans-serif; background-color: #f5f5f5;">
-    <table role="presentation" cellspacing="0" cellpadding="0" border="0" width="100%" style="background-color: #f5f5f5;">
-        <tr>
-            <td style="padding: 40px 20px;">
-                <table role="presentation" cellspacing="0" cellpadding="0" border="0" width="100%" style="max-width: 600px; margin: 0 auto; background-color: #ffffff; border-radius: 8px; box-shadow: 0 2px 8px rgba(0,0,0,0.1);">
-                    <tr>
-                        <td style="padding: 40px 40px 20px 40px; text-align: center;">
-                            <h1 style="margin: 0; color: #7c3aed; font-size: 28px; font-weight: 700;">Mirai</h1>
-                        </td>
-                    </tr>
-                    <tr>
-                        <td style="padding: 20px 40px;">
-                            <h2 style="margin: 0 0 20px 0; color: #1f2937; font-size: 24px; font-weight: 600;">New Task Assigned</h2>
-                            <p style="margin: 0 0 20px 0; color: #4b5563; font-size: 16px; line-height: 1.6;">
-                                Hi {{.AssigneeName}},<br><br>
-                                {{.AssignerName}} has assigned you a new task for <strong>{{.SMEName}}</strong>:
-                            </p>
-                            <div style="background-color: #f3f4f6; padding: 20px; border-radius: 8px; margin: 20px 0;">
-                                <h3 style="margin: 0 0 10px 0; color: #1f2937; font-size: 18px;">{{.TaskTitle}}</h3>
-                                {{if .DueDate}}<p style="margin: 0; color: #6b7280; font-size: 14px;">Due: {{.DueDate}}</p>{{end}}
-                            </div>
-                            <table role="presentation" cellspacing="0" cellpadding="0" border="0" width="100%">
-                                <tr>
-                                    <td style="padding: 20px 0; text-align: center;">
-                                        <a href="{{.TaskURL}}" style="display: inline-block; padding: 14px 32px; background-color: #7c3aed; color: #ffffff; text-decoration: none; font-size: 16px; font-weight: 600; border-radius: 8px;">View Task</a>
-                                    </td>
-                                </tr>
-                            </table>
-                        </td>
-                    </tr>
-                    <tr>
-                        <td style="padding: 20px 40px 40px 40px; border-top: 1px solid #e5e7eb;">
-                            <p style="margin: 0; color: #9ca3af; font-size: 12px; text-align: center;">
-                                You received this email because a task was assigned to you on Mirai.
-                            </p>
-                        </td>
-                    </tr>
-                </table>
-            </td>
-        </tr>
-    </table>
-</body>
-</html>`
-
-	tmpl, err := template.New("task_assignment").Parse(emailTemplate)
-	if err != nil {
-		return "", err
+// senderDomain returns the domain of the From address, or localhost if it has none.
+func (c *Client) senderDomain() string {
+	if addr, err := mail.ParseAddress(c.from); err == nil {
+		if _, d, ok := strings.Cut(addr.Address, "@"); ok && d != "" {
+			return d
+		}
 	}
-
-	var buf bytes.Buffer
-	if err := tmpl.Execute(&buf, req); err != nil {
-		return "", err
-	}
-
-	return buf.String(), nil
+	return "localhost"
 }
 
-// renderIngestionCompleteEmail renders the ingestion complete email template.
-func (c *Client) renderIngestionCompleteEmail(req service.SendIngestionCompleteRequest) (string, error) {
-	const emailTemplate = `<!DOCTYPE html>
-<html>
-<head>
-    <meta charset="UTF-8">
-    <meta name="viewport" content="width=device-width, initial-scale=1.0">
-    <title>Content Processed</title>
-</head>
-<body style="margin: 0; padding: 0; font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', Roboto, Oxygen, Ubuntu, sans-serif; background-color: #f5f5f5;">
-    <table role="presentation" cellspacing="0" cellpadding="0" border="0" width="100%" style="background-color: #f5f5f5;">
-        <tr>
-            <td style="padding: 40px 20px;">
-                <table role="presentation" cellspacing="0" cellpadding="0" border="0" width="100%" style="max-width: 600px; margin: 0 auto; background-color: #ffffff; border-radius: 8px; box-shadow: 0 2px 8px rgba(0,0,0,0.1);">
-                    <tr>
-                        <td style="padding: 40px 40px 20px 40px; text-align: center;">
-                            <h1 style="margin: 0; color: #7c3aed; font-size: 28px; font-weight: 700;">Mirai</h1>
-                        </td>
-                    </tr>
-                    <tr>
-                        <td style="padding: 20px 40px;">
-                            <h2 style="margin: 0 0 20px 0; color: #1f2937; font-size: 24px; font-weight: 600; text-align: center;">Content Processed Successfully</h2>
-                            <p style="margin: 0 0 20px 0; color: #4b5563; font-size: 16px; line-height: 1.6;">
-                                Hi {{.UserName}},<br><br>
-                                The content for <strong>{{.TaskTitle}}</strong> has been processed and added to <strong>{{.SMEName}}</strong>. The knowledge is now available for AI course generation.
-                            </p>
-                            <table role="presentation" cellspacing="0" cellpadding="0" border="0" width="100%">
-                                <tr>
-                                    <td style="padding: 20px 0; text-align: center;">
-                                        <a href="{{.SMEURL}}" style="display: inline-block; padding: 14px 32px; background-color: #7c3aed; color: #ffffff; text-decoration: none; font-size: 16px; font-weight: 600; border-radius: 8px;">View SME Knowledge</a>
-                                    </td>
-                                </tr>
-                            </table>
-                        </td>
-                    </tr>
-                    <tr>
-                        <td style="padding: 20px 40px 40px 40px; border-top: 1px solid #e5e7eb;">
-                            <p style="margin: 0; color: #9ca3af; font-size: 12px; text-align: center;">
-                                This is an automated notification from Mirai.
-                            </p>
-                        </td>
-                    </tr>
-                </table>
-            </td>
-        </tr>
-    </table>
-</body>
-</html>`
-
-	tmpl, err := template.New("ingestion_complete").Parse(emailTemplate)
-	if err != nil {
-		return "", err
-	}
-
-	var buf bytes.Buffer
-	if err := tmpl.Execute(&buf, req); err != nil {
-		return "", err
-	}
-
-	return buf.String(), nil
+// SendTaskAssignment sends a task assignment notification email. When the task has a due
+// date, a calendar invite for it is attached.
+func (c *Client) SendTaskAssignment(ctx context.Context, req service.SendTaskAssignmentRequest) error {
+	var attachments []attachment
+	if req.DueDate != nil && req.TaskID != uuid.Nil {
+		attachments = append(attachments, attachment{
+			Filename:    "task-due-date.ics",
+			ContentType: `text/calendar; charset="UTF-8"; method=PUBLISH`,
+			Data:        c.taskDueDateEvent(req).ics(),
+		})
+	}
+	return c.send(ctx, req.To, templateTaskAssignment, req.Locale, req, attachments...)
 }
 
-// renderIngestionFailedEmail renders the ingestion failed email template.
-func (c *Client) renderIngestionFailedEmail(req service.SendIngestionFailedRequest) (string, error) {
-	const emailTemplate = `<!DOCTYPE html>
-<html>
-<head>
-    <meta charset="UTF-8">
-    <meta name="viewport" content="width=device-width, initial-scale=1.0">
-    <title>Content Processing Failed</title>
-</head>
-<body style="margin: 0; padding: 0; font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', Roboto, Oxygen, Ubuntu, sans-serif; background-color: #f5f5f5;">
-    <table role="presentation" cellspacing="0" cellpadding="0" border="0" width="100%" style="background-color: #f5f5f5;">
-        <tr>
-            <td style="padding: 40px 20px;">
-                <table role="presentation" cellspacing="0" cellpadding="0" border="0" width="100%" style="max-width: 600px; margin: 0 auto; background-color: #ffffff; border-radius: 8px; box-shadow: 0 2px 8px rgba(0,0,0,0.1);">
-                    <tr>
-                        <td style="padding: 40px 40px 20px 40px; text-align: center;">
-                            <h1 style="margin: 0; color: #7c3aed; font-size: 28px; font-weight: 700;">Mirai</h1>
-                        </td>
-                    </tr>
-                    <tr>
-                        <td style="padding: 20px 40px;">
-                            <h2 style="margin: 0 0 20px 0; color: #1f2937; font-size: 24px; font-weight: 600; text-align: center;">Content Processing Failed</h2>
-                            <p style="margin: 0 0 20px 0; color: #4b5563; font-size: 16px; line-height: 1.6;">
-                                Hi {{.UserName}},<br><br>
-                                Unfortunately, we were unable to process the content for <strong>{{.TaskTitle}}</strong> in <strong>{{.SMEName}}</strong>.
-                            </p>
-                            <div style="background-color: #fef2f2; padding: 15px; border-radius: 8px; border-left: 4px solid #ef4444; margin: 20px 0;">
-                                <p style="margin: 0; color: #991b1b; font-size: 14px;">{{.ErrorMessage}}</p>
-                            </div>
-                            <p style="margin: 20px 0; color: #4b5563; font-size: 14px;">
-                                Please try uploading the content again or contact support if the problem persists.
-                            </p>
-                            <table role="presentation" cellspacing="0" cellpadding="0" border="0" width="100%">
-                                <tr>
-                                    <td style="padding: 20px 0; text-align: center;">
-                                        <a href="{{.TaskURL}}" style="display: inline-block; padding: 14px 32px; background-color: #7c3aed; color: #ffffff; text-decoration: none; font-size: 16px; font-weight: 600; border-radius: 8px;">View Task</a>
-                                    </td>
-                                </tr>
-                            </table>
-                        </td>
-                    </tr>
-                    <tr>
-                        <td style="padding: 20px 40px 40px 40px; border-top: 1px solid #e5e7eb;">
-                            <p style="margin: 0; color: #9ca3af; font-size: 12px; text-align: center;">
-                                This is an automated notification from Mirai.
-                            </p>
-                        </td>
-                    </tr>
-                </table>
-            </td>
-        </tr>
-    </table>
-</body>
-</html>`
-
-	tmpl, err := template.New("ingestion_failed").Parse(emailTemplate)
-	if err != nil {
-		return "", err
-	}
-
-	var buf bytes.Buffer
-	if err := tmpl.Execute(&buf, req); err != nil {
-		return "", err
-	}
-
-	return buf.String(), nil
+// SendIngestionComplete sends an ingestion completion notification email.
+func (c *Client) SendIngestionComplete(ctx context.Context, req service.SendIngestionCompleteRequest) error {
+	return c.send(ctx, req.To, templateIngestionComplete, req.Locale, req)
 }
 
-// renderGenerationCompleteEmail renders the generation complete email template.
-func (c *Client) renderGenerationCompleteEmail(req service.SendGenerationCompleteRequest) (string, error) {
-	const emailTemplate = `<!DOCTYPE html>
-<html>
-<head>
-    <meta charset="UTF-8">
-    <meta name="viewport" content="width=device-width, initial-scale=1.0">
-    <title>AI Generation Complete</title>
-</head>
-<body style="margin: 0; padding: 0; font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', Roboto, Oxygen, Ubuntu, sans-serif; background-color: #f5f5f5;">
-    <table role="presentation" cellspacing="0" cellpadding="0" border="0" width="100%" style="background-color: #f5f5f5;">
-        <tr>
-            <td style="padding: 40px 20px;">
-                <table role="presentation" cellspacing="0" cellpadding="0" border="0" width="100%" style="max-width: 600px; margin: 0 auto; background-color: #ffffff; border-radius: 8px; box-shadow: 0 2px 8px rgba(0,0,0,0.1);">
-                    <tr>
-                        <td style="padding: 40px 40px 20px 40px; text-align: center;">
-                            <h1 style="margin: 0; color: #7c3aed; font-size: 28px; font-weight: 700;">Mirai</h1>
-                        </td>
-                    </tr>
-                    <tr>
-                        <td style="padding: 20px 40px;">
-                            <h2 style="margin: 0 0 20px 0; color: #1f2937; font-size: 24px; font-weight: 600; text-align: center;">AI Generation Complete</h2>
-                            <p style="margin: 0 0 20px 0; color: #4b5563; font-size: 16px; line-height: 1.6;">
-                                Hi {{.UserName}},<br><br>
-                                Great news! The AI has finished generating the {{.ContentType}} for <strong>{{.CourseTitle}}</strong>. Your content is ready for review.
-                            </p>
-                            <table role="presentation" cellspacing="0" cellpadding="0" border="0" width="100%">
-                                <tr>
-                                    <td style="padding: 20px 0; text-align: center;">
-                                        <a href="{{.CourseURL}}" style="display: inline-block; padding: 14px 32px; background-color: #7c3aed; color: #ffffff; text-decoration: none; font-size: 16px; font-weight: 600; border-radius: 8px;">Review Content</a>
-                                    </td>
-                                </tr>
-                            </table>
-                        </td>
-                    </tr>
-                    <tr>
-                        <td style="padding: 20px 40px 40px 40px; border-top: 1px solid #e5e7eb;">
-                            <p style="margin: 0; color: #9ca3af; font-size: 12px; text-align: center;">
-                                This is an automated notification from Mirai.
-                            </p>
-                        </td>
-                    </tr>
-                </table>
-            </td>
-        </tr>
-    </table>
-</body>
-</html>`
-
-	tmpl, err := template.New("generation_complete").Parse(emailTemplate)
-	if err != nil {
-		return "", err
-	}
-
-	var buf bytes.Buffer
-	if err := tmpl.Execute(&buf, req); err != nil {
-		return "", err
-	}
-
-	return buf.String(), nil
+// SendIngestionFailed sends an ingestion failure notification email.
+func (c *Client) SendIngestionFailed(ctx context.Context, req service.SendIngestionFailedRequest) error {
+	return c.send(ctx, req.To, templateIngestionFailed, req.Locale, req)
 }
 
-// renderGenerationFailedEmail renders the generation failed email template.
-func (c *Client) renderGenerationFailedEmail(req service.SendGenerationFailedRequest) (string, error) {
-	const emailTemplate = `<!DOCTYPE html>
-<html>
-<head>
-    <meta charset="UTF-8">
-    <meta name="viewport" content="width=device-width, initial-scale=1.0">
-    <title>AI Generation Failed</title>
-</head>
-<body style="margin: 0; padding: 0; font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', Roboto, Oxygen, Ubuntu, sans-serif; background-color: #f5f5f5;">
-    <table role="presentation" cellspacing="0" cellpadding="0" border="0" width="100%" style="background-color: #f5f5f5;">
-        <tr>
-            <td style="padding: 40px 20px;">
-                <table role="presentation" cellspacing="0" cellpadding="0" border="0" width="100%" style="max-width: 600px; margin: 0 auto; background-color: #ffffff; border-radius: 8px; box-shadow: 0 2px 8px rgba(0,0,0,0.1);">
-                    <tr>
-                        <td style="padding: 40px 40px 20px 40px; text-align: center;">
-                            <h1 style="margin: 0; color: #7c3aed; font-size: 28px; font-weight: 700;">Mirai</h1>
-                        </td>
-                    </tr>
-                    <tr>
-                        <td style="padding: 20px 40px;">
-                            <h2 style="margin: 0 0 20px 0; color: #1f2937; font-size: 24px; font-weight: 600; text-align: center;">AI Generation Failed</h2>
-                            <p style="margin: 0 0 20px 0; color: #4b5563; font-size: 16px; line-height: 1.6;">
-                                Hi {{.UserName}},<br><br>
-                                Unfortunately, we encountered an issue while generating the {{.ContentType}} for <strong>{{.CourseTitle}}</strong>.
-                            </p>
-                            <div style="background-color: #fef2f2; padding: 15px; border-radius: 8px; border-left: 4px solid #ef4444; margin: 20px 0;">
-                                <p style="margin: 0; color: #991b1b; font-size: 14px;">{{.ErrorMessage}}</p>
-                            </div>
-                            <p style="margin: 20px 0; color: #4b5563; font-size: 14px;">
-                                Please try again or contact support if the problem persists.
-                            </p>
-                            <table role="presentation" cellspacing="0" cellpadding="0" border="0" width="100%">
-                                <tr>
-                                    <td style="padding: 20px 0; text-align: center;">
-                                        <a href="{{.CourseURL}}" style="display: inline-block; padding: 14px 32px; background-color: #7c3aed; color: #ffffff; text-decoration: none; font-size: 16px; font-weight: 600; border-radius: 8px;">View Course</a>
-                                    </td>
-                                </tr>
-                            </table>
-                        </td>
-                    </tr>
-                    <tr>
-                        <td style="padding: 20px 40px 40px 40px; border-top: 1px solid #e5e7eb;">
-                            <p style="margin: 0; color: #9ca3af; font-size: 12px; text-align: center;">
-                                This is an automated notification from Mirai.
-                            </p>
-                        </td>
-                    </tr>
-                </table>
-            </td>
-        </tr>
-    </table>
-</body>
-</html>`
-
-	tmpl, err := template.New("generation_failed").Parse(emailTemplate)
-	if err != nil {
-		return "", err
-	}
-
-	var buf bytes.Buffer
-	if err := tmpl.Execute(&buf, req); err != nil {
-		return "", err
-	}
+// SendGenerationComplete sends a generation completion notification email.
+func (c *Client) SendGenerationComplete(ctx context.Context, req service.SendGenerationCompleteRequest) error {
+	return c.send(ctx, req.To, templateGenerationComplete, req.Locale, req)
+}
 
-	return buf.String(), nil
+// SendGenerationFailed sends a generation failure notification email.
+func (c *Client) SendGenerationFailed(ctx context.Context, req service.SendGenerationFailedRequest) error {
+	return c.send(ctx, req.To, templateGenerationFailed, req.Locale, req)
 }
 
 // SendOutlineReady sends a notification when course outline is ready for review.
 func (c *Client) SendOutlineReady(ctx context.Context, req service.SendOutlineReadyRequest) error {
-	subject := fmt.Sprintf("Outline Ready for Review: %s", req.CourseTitle)
-
-	body, err := c.renderOutlineReadyEmail(req)
-	if err != nil {
-		return fmt.Errorf("failed to render email template: %w", err)
-	}
-
-	return c.sendEmail(req.To, subject, body)
-}
-
-// renderOutlineReadyEmail renders the outline ready email template.
-func (c *Client) renderOutlineReadyEmail(req service.SendOutlineReadyRequest) (string, error) {
-	const emailTemplate = `<!DOCTYPE html>
-<html>
-<head>
-    <meta charset="UTF-8">
-    <meta name="viewport" content="width=device-width, initial-scale=1.0">
-    <title>Course Outline Ready</title>
-</head>
-<body style="margin: 0; padding: 0; font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', Roboto, Oxygen, Ubuntu, sans-serif; background-color: #f5f5f5;">
-    <table role="presentation" cellspacing="0" cellpadding="0" border="0" width="100%" style="background-color: #f5f5f5;">
-        <tr>
-            <td style="padding: 40px 20px;">
-                <table role="presentation" cellspacing="0" cellpadding="0" border="0" width="100%" style="max-width: 600px; margin: 0 auto; background-color: #ffffff; border-radius: 8px; box-shadow: 0 2px 8px rgba(0,0,0,0.1);">
-                    <tr>
-                        <td style="padding: 40px 40px 20px 40px; text-align: center;">
-                            <h1 style="margin: 0; color: #7c3aed; font-size: 28px; font-weight: 700;">Mirai</h1>
-                        </td>
-                    </tr>
-                    <tr>
-                        <td style="padding: 20px 40px;">
-                            <h2 style="margin: 0 0 20px 0; color: #1f2937; font-size: 24px; font-weight: 600; text-align: center;">Course Outline Ready for Review</h2>
-                            <p style="margin: 0 0 20px 0; color: #4b5563; font-size: 16px; line-height: 1.6;">
-                                Hi {{.UserName}},<br><br>
-                                The AI has generated an outline for <strong>{{.CourseTitle}}</strong>. Please review it before we generate the full course content.
-                            </p>
-                            <div style="background-color: #f3f4f6; padding: 20px; border-radius: 8px; margin: 20px 0;">
-                                <h3 style="margin: 0 0 15px 0; color: #1f2937; font-size: 16px; font-weight: 600;">Outline Summary</h3>
-                                <table cellspacing="0" cellpadding="0" style="width: 100%;">
-                                    <tr>
-                                        <td style="padding: 8px 0; color: #4b5563; font-size: 14px;">Sections</td>
-                                        <td style="padding: 8px 0; color: #1f2937; font-size: 14px; font-weight: 600; text-align: right;">{{.SectionCount}}</td>
-                                    </tr>
-                                    <tr>
-                                        <td style="padding: 8px 0; color: #4b5563; font-size: 14px;">Lessons</td>
-                                        <td style="padding: 8px 0; color: #1f2937; font-size: 14px; font-weight: 600; text-align: right;">{{.LessonCount}}</td>
-                                    </tr>
-                                </table>
-                            </div>
-                            <table role="presentation" cellspacing="0" cellpadding="0" border="0" width="100%">
-                                <tr>
-                                    <td style="padding: 20px 0; text-align: center;">
-                                        <a href="{{.ReviewURL}}" style="display: inline-block; padding: 14px 32px; background-color: #7c3aed; color: #ffffff; text-decoration: none; font-size: 16px; font-weight: 600; border-radius: 8px;">Review Outline</a>
-                                    </td>
-                                </tr>
-                            </table>
-                            <p style="margin: 20px 0 0 0; color: #6b7280; font-size: 14px; text-align: center;">
-                                You can edit the outline before approving it for full content generation.
-                            </p>
-                        </td>
-                    </tr>
-                    <tr>
-                        <td style="padding: 20px 40px 40px 40px; border-top: 1px solid #e5e7eb;">
-                            <p style="margin: 0; color: #9ca3af; font-size: 12px; text-align: center;">
-                                This is an automated notification from Mirai.
-                            </p>
-                        </td>
-                    </tr>
-                </table>
-            </td>
-        </tr>
-    </table>
-</body>
-</html>`
-
-	tmpl, err := template.New("outline_ready").Parse(emailTemplate)
-	if err != nil {
-		return "", err
-	}
-
-	var buf bytes.Buffer
-	if err := tmpl.Execute(&buf, req); err != nil {
-		return "", err
-	}
-
-	return buf.String(), nil
+	return c.send(ctx, req.To, templateOutlineReady, req.Locale, req)
 }
 
 // SendCourseComplete sends a notification when full course generation is complete.
 func (c *Client) SendCourseComplete(ctx context.Context, req service.SendCourseCompleteRequest) error {
-	subject := fmt.Sprintf("Course Ready: %s", req.CourseTitle)
-
-	body, err := c.renderCourseCompleteEmail(req)
-	if err != nil {
-		return fmt.Errorf("failed to render email template: %w", err)
-	}
-
-	return c.sendEmail(req.To, subject, body)
+	return c.send(ctx, req.To, templateCourseComplete, req.Locale, req)
 }
 
-// renderCourseCompleteEmail renders the course complete email template with summary.
-func (c *Client) renderCourseCompleteEmail(req service.SendCourseCompleteRequest) (string, error) {
-	// Calculate hours and minutes for display
-	hours := req.TotalDurationMinutes / 60
-	minutes := req.TotalDurationMinutes % 60
-
-	type templateData struct {
-		service.SendCourseCompleteRequest
-		DurationHours   int
-		DurationMinutes int
-	}
-
-	data := templateData{
-		SendCourseCompleteRequest: req,
-		DurationHours:             hours,
-		DurationMinutes:           minutes,
-	}
-
-	const emailTemplate = `<!DOCTYPE html>
-<html>
-<head>
-    <meta charset="UTF-8">
-    <meta name="viewport" content="width=device-width, initial-scale=1.0">
-    <title>Course Ready</title>
-</head>
-<body style="margin: 0; padding: 0; font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', Roboto, Oxygen, Ubuntu, sans-serif; background-color: #f5f5f5;">
-    <table role="presentation" cellspacing="0" cellpadding="0" border="0" width="100%" style="background-color: #f5f5f5;">
-        <tr>
-            <td style="padding: 40px 20px;">
-                <table role="presentation" cellspacing="0" cellpadding="0" border="0" width="100%" style="max-width: 600px; margin: 0 auto; background-color: #ffffff; border-radius: 8px; box-shadow: 0 2px 8px rgba(0,0,0,0.1);">
-                    <tr>
-                        <td style="padding: 40px 40px 20px 40px; text-align: center;">
-                            <h1 style="margin: 0; color: #7c3aed; font-size: 28px; font-weight: 700;">Mirai</h1>
-                        </td>
-                    </tr>
-                    <tr>
-                        <td style="padding: 20px 40px;">
-                            <div style="text-align: center; margin-bottom: 20px;">
-                                <span style="display: inline-block; background-color: #ecfdf5; color: #059669; padding: 8px 16px; border-radius: 20px; font-size: 14px; font-weight: 600;">Course Complete</span>
-                            </div>
-                            <h2 style="margin: 0 0 20px 0; color: #1f2937; font-size: 24px; font-weight: 600; text-align: center;">{{.CourseTitle}}</h2>
-                            <p style="margin: 0 0 20px 0; color: #4b5563; font-size: 16px; line-height: 1.6; text-align: center;">
-                                Hi {{.UserName}},<br><br>
-                                Your course has been fully generated and is ready for review!
-                            </p>
-                            <div style="background-color: #f3f4f6; padding: 20px; border-radius: 8px; margin: 20px 0;">
-                                <h3 style="margin: 0 0 15px 0; color: #1f2937; font-size: 16px; font-weight: 600;">Course Summary</h3>
-                                <table cellspacing="0" cellpadding="0" style="width: 100%;">
-                                    <tr>
-                                        <td style="padding: 8px 0; color: #4b5563; font-size: 14px;">Sections</td>
-                                        <td style="padding: 8px 0; color: #1f2937; font-size: 14px; font-weight: 600; text-align: right;">{{.SectionCount}}</td>
-                                    </tr>
-                                    <tr>
-                                        <td style="padding: 8px 0; color: #4b5563; font-size: 14px;">Lessons</td>
-                                        <td style="padding: 8px 0; color: #1f2937; font-size: 14px; font-weight: 600; text-align: right;">{{.LessonCount}}</td>
-                                    </tr>
-                                    <tr>
-                                        <td style="padding: 8px 0; color: #4b5563; font-size: 14px;">Estimated Duration</td>
-                                        <td style="padding: 8px 0; color: #1f2937; font-size: 14px; font-weight: 600; text-align: right;">{{if .DurationHours}}{{.DurationHours}}h {{end}}{{.DurationMinutes}}m</td>
-                                    </tr>
-                                </table>
-                            </div>
-                            <table role="presentation" cellspacing="0" cellpadding="0" border="0" width="100%">
-                                <tr>
-                                    <td style="padding: 20px 0; text-align: center;">
-                                        <a href="{{.CourseURL}}" style="display: inline-block; padding: 14px 32px; background-color: #7c3aed; color: #ffffff; text-decoration: none; font-size: 16px; font-weight: 600; border-radius: 8px;">Preview Course</a>
-                                    </td>
-                                </tr>
-                            </table>
-                            <p style="margin: 20px 0 0 0; color: #6b7280; font-size: 14px; text-align: center;">
-                                You can edit any content before publishing your course.
-                            </p>
-                        </td>
-                    </tr>
-                    <tr>
-                        <td style="padding: 20px 40px 40px 40px; border-top: 1px solid #e5e7eb;">
-                            <p style="margin: 0; color: #9ca3af; font-size: 12px; text-align: center;">
-                                This is an automated notification from Mirai.
-                            </p>
-                        </td>
-                    </tr>
-                </table>
-            </td>
-        </tr>
-    </table>
-</body>
-</html>`
-
-	tmpl, err := template.New("course_complete").Parse(emailTemplate)
-	if err != nil {
-		return "", err
-	}
-
-	var buf bytes.Buffer
-	if err := tmpl.Execute(&buf, data); err != nil {
-		return "", err
-	}
+// SendExportReady sends a signed download link for a finished export.
+func (c *Client) SendExportReady(ctx context.Context, req service.SendExportReadyRequest) error {
+	return c.send(ctx, req.To, templateExportReady, req.Locale, req)
+}
 
-	return buf.String(), nil
+// SendWeeklySummary sends a tenant admin the summary of the organization's past week.
+func (c *Client) SendWeeklySummary(ctx context.Context, req service.SendWeeklySummaryRequest) error {
+	return c.send(ctx, req.To, templateWeeklySummary, req.Locale, req)
 }
 
 // SendAlert sends an administrative alert email to the configured admin address.
+// Alerts go to operators and are always rendered in English.
 func (c *Client) SendAlert(ctx context.Context, req service.SendAlertRequest) error {
 	if c.adminEmail == "" {
 		return fmt.Errorf("admin email not configured")
 	}
 
-	body := c.renderAlertEmail(req)
-	return c.sendEmail(c.adminEmail, req.Subject, body)
-}
+	_, body, err := renderTemplate(templateAlert, valueobject.DefaultLocale, req)
+	if err != nil {
+		return fmt.Errorf("failed to render email template: %w", err)
+	}
 
-// renderAlertEmail renders the alert email HTML template.
-func (c *Client) renderAlertEmail(req service.SendAlertRequest) string {
-	// Simple HTML template for alerts - no need for template parsing since body is plain text
-	return fmt.Sprintf(`<!DOCTYPE html>
-<html>
-<head>
-    <meta charset="UTF-8">
-    <meta name="viewport" content="width=device-width, initial-scale=1.0">
-    <title>%s</title>
-</head>
-<body style="margin: 0; padding: 0; font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', Roboto, Oxygen, Ubuntu, sans-serif; background-color: #f5f5f5;">
-    <table role="presentation" cellspacing="0" cellpadding="0" border="0" width="100%%" style="background-color: #f5f5f5;">
-        <tr>
-            <td style="padding: 40px 20px;">
-                <table role="presentation" cellspacing="0" cellpadding="0" border="0" width="100%%" style="max-width: 600px; margin: 0 auto; background-color: #ffffff; border-radius: 8px; box-shadow: 0 2px 8px rgba(0,0,0,0.1);">
-                    <tr>
-                        <td style="padding: 40px 40px 20px 40px; text-align: center;">
-                            <h1 style="margin: 0; color: #dc2626; font-size: 28px; font-weight: 700;">Mirai Alert</h1>
-                        </td>
-                    </tr>
-                    <tr>
-                        <td style="padding: 20px 40px;">
-                            <h2 style="margin: 0 0 20px 0; color: #1f2937; font-size: 20px; font-weight: 600;">%s</h2>
-                            <div style="background-color: #fef2f2; padding: 20px; border-radius: 8px; border-left: 4px solid #dc2626; margin: 20px 0;">
-                                <pre style="margin: 0; color: #991b1b; font-size: 14px; white-space: pre-wrap; font-family: monospace;">%s</pre>
-                            </div>
-                        </td>
-                    </tr>
-                    <tr>
-                        <td style="padding: 20px 40px 40px 40px; border-top: 1px solid #e5e7eb;">
-                            <p style="margin: 0; color: #9ca3af; font-size: 12px; text-align: center;">
-                                This is an automated system alert from Mirai.
-                            </p>
-                        </td>
-                    </tr>
-                </table>
-            </td>
-        </tr>
-    </table>
-</body>
-</html>`, req.Subject, req.Subject, req.Body)
+	return c.sendEmail(ctx, c.adminEmail, templateAlert, req.Subject, body)
 }
@@ -0,0 +1,146 @@
+package smtp
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"html/template"
+	"io/fs"
+	"path"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+// templateFS holds the email templates. The layout is shared; each locale
+// directory has one HTML file per email plus subjects.txt with the subject lines.
+//
+//go:embed templates
+var templateFS embed.FS
+
+// Email template names, matching the file names under templates/<locale>/.
+const (
+	templateInvitation         = "invitation"
+	templateWelcome            = "welcome"
+	templateTaskAssignment     = "task_assignment"
+	templateIngestionComplete  = "ingestion_complete"
+	templateIngestionFailed    = "ingestion_failed"
+	templateGenerationComplete = "generation_complete"
+	templateGenerationFailed   = "generation_failed"
+	templateOutlineReady       = "outline_ready"
+	templateCourseComplete     = "course_complete"
+	templateExportReady        = "export_ready"
+	templateWeeklySummary      = "weekly_summary"
+	templateAlert              = "alert"
+)
+
+// localeTemplates are the parsed templates for a single locale.
+type localeTemplates struct {
+	bodies   map[string]*template.Template
+	subjects *texttemplate.Template
+}
+
+// emailTemplates maps each locale found under templates/ to its parsed templates.
+var emailTemplates = mustLoadTemplates()
+
+// mustLoadTemplates parses every locale directory. Templates are embedded in the
+// binary, so a parse error is a programming mistake and panics at startup.
+func mustLoadTemplates() map[valueobject.Locale]*localeTemplates {
+	layout, err := fs.ReadFile(templateFS, "templates/layout.html")
+	if err != nil {
+		panic(fmt.Sprintf("smtp: failed to read email layout: %v", err))
+	}
+
+	entries, err := fs.ReadDir(templateFS, "templates")
+	if err != nil {
+		panic(fmt.Sprintf("smtp: failed to read email templates: %v", err))
+	}
+
+	loaded := make(map[valueobject.Locale]*localeTemplates)
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		locale := valueobject.Locale(entry.Name())
+		lt, err := loadLocale(locale, string(layout))
+		if err != nil {
+			panic(fmt.Sprintf("smtp: failed to load %s email templates: %v", locale, err))
+		}
+		loaded[locale] = lt
+	}
+
+	if loaded[valueobject.DefaultLocale] == nil {
+		panic("smtp: default locale email templates are missing")
+	}
+	return loaded
+}
+
+// loadLocale parses the subject lines and each email body for one locale.
+func loadLocale(locale valueobject.Locale, layout string) (*localeTemplates, error) {
+	dir := path.Join("templates", locale.String())
+
+	funcs := template.FuncMap{
+		"lang":     func() string { return locale.String() },
+		"date":     locale.FormatDate,
+		"duration": locale.FormatDuration,
+	}
+
+	lt := &localeTemplates{bodies: make(map[string]*template.Template)}
+
+	files, err := fs.ReadDir(templateFS, dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range files {
+		name := file.Name()
+		switch {
+		case name == "subjects.txt":
+			subjects, err := texttemplate.New(name).Funcs(texttemplate.FuncMap(funcs)).ParseFS(templateFS, path.Join(dir, name))
+			if err != nil {
+				return nil, err
+			}
+			lt.subjects = subjects
+		case strings.HasSuffix(name, ".html"):
+			tmpl, err := template.New("layout").Funcs(funcs).Parse(layout)
+			if err != nil {
+				return nil, err
+			}
+			if _, err := tmpl.ParseFS(templateFS, path.Join(dir, name)); err != nil {
+				return nil, err
+			}
+			lt.bodies[strings.TrimSuffix(name, ".html")] = tmpl
+		}
+	}
+	return lt, nil
+}
+
+// renderTemplate renders the subject and HTML body of an email in the requested
+// locale. Emails missing from a locale fall back to English.
+func renderTemplate(name string, locale valueobject.Locale, data any) (subject, body string, err error) {
+	lt := emailTemplates[valueobject.ParseLocale(locale.String())]
+	if lt == nil || lt.bodies[name] == nil {
+		lt = emailTemplates[valueobject.DefaultLocale]
+	}
+
+	tmpl := lt.bodies[name]
+	if tmpl == nil {
+		return "", "", fmt.Errorf("email template %q not found", name)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&buf, "layout", data); err != nil {
+		return "", "", err
+	}
+	body = buf.String()
+
+	if lt.subjects != nil && lt.subjects.Lookup(name) != nil {
+		buf.Reset()
+		if err := lt.subjects.ExecuteTemplate(&buf, name, data); err != nil {
+			return "", "", err
+		}
+		subject = strings.TrimSpace(buf.String())
+	}
+
+	return subject, body, nil
+}
@@ -3,7 +3,9 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/sogos/mirai-backend/internal/domain/entity"
@@ -26,12 +28,21 @@ func NewTenantAISettingsRepository(db *sql.DB) repository.TenantAISettingsReposi
 func (r *TenantAISettingsRepository) Get(ctx context.Context, tenantID uuid.UUID) (*entity.TenantAISettings, error) {
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.TenantAISettings, error) {
 		query := `
-			SELECT id, tenant_id, provider, encrypted_api_key, total_tokens_used, monthly_token_limit, updated_at, updated_by_user_id
+			SELECT id, tenant_id, provider, encrypted_api_key,
+			       (SELECT COALESCE(SUM(p.tokens_used), 0) FROM token_usage_periods p WHERE p.tenant_id = tenant_ai_settings.tenant_id),
+			       monthly_token_limit, updated_at, updated_by_user_id,
+			       default_enable_quizzes, default_quiz_frequency, default_include_images, default_include_reflection_prompts,
+			       allow_outline_auto_approve, locale, course_defaults, disable_prompt_cache, default_generate_images,
+			       weekly_summary_enabled, weekly_summary_day, weekly_summary_hour, ai_generation_enabled, require_second_reviewer,
+			       default_min_lesson_components, default_max_lesson_components, export_retention_days
 			FROM tenant_ai_settings
 			WHERE tenant_id = $1
 		`
 		settings := &entity.TenantAISettings{}
-		var providerStr string
+		var providerStr, quizFrequencyStr string
+		var localeStr *string
+		var courseDefaultsJSON []byte
+		var weeklySummaryDay int
 		err := tx.QueryRowContext(ctx, query, tenantID).Scan(
 			&settings.ID,
 			&settings.TenantID,
@@ -41,6 +52,23 @@ func (r *TenantAISettingsRepository) Get(ctx context.Context, tenantID uuid.UUID
 			&settings.MonthlyTokenLimit,
 			&settings.UpdatedAt,
 			&settings.UpdatedByUserID,
+			&settings.GenerationDefaults.EnableQuizzes,
+			&quizFrequencyStr,
+			&settings.GenerationDefaults.IncludeImages,
+			&settings.GenerationDefaults.IncludeReflectionPrompts,
+			&settings.AllowOutlineAutoApprove,
+			&localeStr,
+			&courseDefaultsJSON,
+			&settings.DisablePromptCache,
+			&settings.GenerationDefaults.GenerateImages,
+			&settings.WeeklySummary.Enabled,
+			&weeklySummaryDay,
+			&settings.WeeklySummary.Hour,
+			&settings.AIGenerationEnabled,
+			&settings.RequireSecondReviewer,
+			&settings.GenerationDefaults.MinComponents,
+			&settings.GenerationDefaults.MaxComponents,
+			&settings.ExportRetentionDays,
 		)
 		if err == sql.ErrNoRows {
 			return nil, nil // No settings exist yet
@@ -49,17 +77,35 @@ func (r *TenantAISettingsRepository) Get(ctx context.Context, tenantID uuid.UUID
 			return nil, fmt.Errorf("failed to get AI settings: %w", err)
 		}
 		settings.Provider, _ = valueobject.ParseAIProvider(providerStr)
+		settings.GenerationDefaults.QuizFrequency, _ = valueobject.ParseQuizFrequency(quizFrequencyStr)
+		settings.WeeklySummary.Day = time.Weekday(weeklySummaryDay)
+		if localeStr != nil {
+			locale := valueobject.ParseLocale(*localeStr)
+			settings.Locale = &locale
+		}
+		if err := json.Unmarshal(courseDefaultsJSON, &settings.CourseDefaults); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal course defaults: %w", err)
+		}
 		return settings, nil
 	})
 }
 
 // Create creates new AI settings for a tenant.
 func (r *TenantAISettingsRepository) Create(ctx context.Context, settings *entity.TenantAISettings) error {
+	courseDefaultsJSON, err := json.Marshal(settings.CourseDefaults)
+	if err != nil {
+		return fmt.Errorf("failed to marshal course defaults: %w", err)
+	}
+
 	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
 		query := `
-			INSERT INTO tenant_ai_settings (tenant_id, provider, encrypted_api_key, monthly_token_limit, updated_by_user_id)
-			VALUES ($1, $2, $3, $4, $5)
-			RETURNING id, total_tokens_used, updated_at
+			INSERT INTO tenant_ai_settings (tenant_id, provider, encrypted_api_key, monthly_token_limit, updated_by_user_id,
+			                                default_enable_quizzes, default_quiz_frequency, default_include_images, default_include_reflection_prompts,
+			                                allow_outline_auto_approve, locale, course_defaults, disable_prompt_cache, default_generate_images,
+			                                weekly_summary_enabled, weekly_summary_day, weekly_summary_hour, require_second_reviewer,
+			                                default_min_lesson_components, default_max_lesson_components)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20)
+			RETURNING id, updated_at
 		`
 		return tx.QueryRowContext(ctx, query,
 			settings.TenantID,
@@ -67,17 +113,41 @@ func (r *TenantAISettingsRepository) Create(ctx context.Context, settings *entit
 			settings.EncryptedAPIKey,
 			settings.MonthlyTokenLimit,
 			settings.UpdatedByUserID,
-		).Scan(&settings.ID, &settings.TotalTokensUsed, &settings.UpdatedAt)
+			settings.GenerationDefaults.EnableQuizzes,
+			settings.GenerationDefaults.QuizFrequency.String(),
+			settings.GenerationDefaults.IncludeImages,
+			settings.GenerationDefaults.IncludeReflectionPrompts,
+			settings.AllowOutlineAutoApprove,
+			settings.Locale,
+			courseDefaultsJSON,
+			settings.DisablePromptCache,
+			settings.GenerationDefaults.GenerateImages,
+			settings.WeeklySummary.Enabled,
+			int(settings.WeeklySummary.Day),
+			settings.WeeklySummary.Hour,
+			settings.RequireSecondReviewer,
+			settings.GenerationDefaults.MinComponents,
+			settings.GenerationDefaults.MaxComponents,
+		).Scan(&settings.ID, &settings.UpdatedAt)
 	})
 }
 
 // Update updates AI settings.
 func (r *TenantAISettingsRepository) Update(ctx context.Context, settings *entity.TenantAISettings) error {
+	courseDefaultsJSON, err := json.Marshal(settings.CourseDefaults)
+	if err != nil {
+		return fmt.Errorf("failed to marshal course defaults: %w", err)
+	}
+
 	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
 		query := `
 			UPDATE tenant_ai_settings
-			SET provider = $1, encrypted_api_key = $2, monthly_token_limit = $3, updated_at = NOW(), updated_by_user_id = $4
-			WHERE tenant_id = $5
+			SET provider = $1, encrypted_api_key = $2, monthly_token_limit = $3, updated_at = NOW(), updated_by_user_id = $4,
+			    default_enable_quizzes = $5, default_quiz_frequency = $6, default_include_images = $7, default_include_reflection_prompts = $8,
+			    allow_outline_auto_approve = $9, locale = $10, course_defaults = $11, disable_prompt_cache = $12,
+			    default_generate_images = $13, weekly_summary_enabled = $14, weekly_summary_day = $15, weekly_summary_hour = $16,
+			    require_second_reviewer = $17, default_min_lesson_components = $18, default_max_lesson_components = $19
+			WHERE tenant_id = $20
 			RETURNING updated_at
 		`
 		return tx.QueryRowContext(ctx, query,
@@ -85,20 +155,127 @@ func (r *TenantAISettingsRepository) Update(ctx context.Context, settings *entit
 			settings.EncryptedAPIKey,
 			settings.MonthlyTokenLimit,
 			settings.UpdatedByUserID,
+			settings.GenerationDefaults.EnableQuizzes,
+			settings.GenerationDefaults.QuizFrequency.String(),
+			settings.GenerationDefaults.IncludeImages,
+			settings.GenerationDefaults.IncludeReflectionPrompts,
+			settings.AllowOutlineAutoApprove,
+			settings.Locale,
+			courseDefaultsJSON,
+			settings.DisablePromptCache,
+			settings.GenerationDefaults.GenerateImages,
+			settings.WeeklySummary.Enabled,
+			int(settings.WeeklySummary.Day),
+			settings.WeeklySummary.Hour,
+			settings.RequireSecondReviewer,
+			settings.GenerationDefaults.MinComponents,
+			settings.GenerationDefaults.MaxComponents,
 			settings.TenantID,
 		).Scan(&settings.UpdatedAt)
 	})
 }
 
-// IncrementTokenUsage increments the token usage counter.
+// SetAIGenerationEnabled turns the tenant's AI generation on or off. The upsert creates
+// settings with column defaults for a tenant that has none, and touches no other setting.
+func (r *TenantAISettingsRepository) SetAIGenerationEnabled(ctx context.Context, tenantID uuid.UUID, enabled bool, updatedByUserID uuid.UUID) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		query := `
+			INSERT INTO tenant_ai_settings (tenant_id, ai_generation_enabled, updated_by_user_id)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (tenant_id)
+			DO UPDATE SET ai_generation_enabled = EXCLUDED.ai_generation_enabled,
+			              updated_by_user_id = EXCLUDED.updated_by_user_id, updated_at = NOW()
+		`
+		if _, err := tx.ExecContext(ctx, query, tenantID, enabled, updatedByUserID); err != nil {
+			return fmt.Errorf("failed to set AI generation enabled: %w", err)
+		}
+		return nil
+	})
+}
+
+// SetExportRetentionDays sets how many days the tenant's export artifacts are kept. The
+// upsert creates settings with column defaults for a tenant that has none, and touches no
+// other setting.
+func (r *TenantAISettingsRepository) SetExportRetentionDays(ctx context.Context, tenantID uuid.UUID, days int, updatedByUserID uuid.UUID) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		query := `
+			INSERT INTO tenant_ai_settings (tenant_id, export_retention_days, updated_by_user_id)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (tenant_id)
+			DO UPDATE SET export_retention_days = EXCLUDED.export_retention_days,
+			              updated_by_user_id = EXCLUDED.updated_by_user_id, updated_at = NOW()
+		`
+		if _, err := tx.ExecContext(ctx, query, tenantID, days, updatedByUserID); err != nil {
+			return fmt.Errorf("failed to set export retention days: %w", err)
+		}
+		return nil
+	})
+}
+
+// IncrementTokenUsage atomically adds tokens to the tenant's usage for the current period.
+// The upsert increments the stored value in place, so concurrent jobs never overwrite
+// each other's usage.
 func (r *TenantAISettingsRepository) IncrementTokenUsage(ctx context.Context, tenantID uuid.UUID, tokens int64) error {
 	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
 		query := `
-			UPDATE tenant_ai_settings
-			SET total_tokens_used = total_tokens_used + $1, updated_at = NOW()
-			WHERE tenant_id = $2
+			INSERT INTO token_usage_periods (tenant_id, year_month, tokens_used)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (tenant_id, year_month)
+			DO UPDATE SET tokens_used = token_usage_periods.tokens_used + EXCLUDED.tokens_used, updated_at = NOW()
 		`
-		_, err := tx.ExecContext(ctx, query, tokens, tenantID)
-		return err
+		_, err := tx.ExecContext(ctx, query, tenantID, entity.UsagePeriodOf(time.Now()), tokens)
+		if err != nil {
+			return fmt.Errorf("failed to increment token usage: %w", err)
+		}
+		return nil
+	})
+}
+
+// GetCurrentPeriodUsage returns the tokens the tenant has used in the current period.
+func (r *TenantAISettingsRepository) GetCurrentPeriodUsage(ctx context.Context, tenantID uuid.UUID) (int64, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (int64, error) {
+		query := `
+			SELECT tokens_used
+			FROM token_usage_periods
+			WHERE tenant_id = $1 AND year_month = $2
+		`
+		var tokens int64
+		err := tx.QueryRowContext(ctx, query, tenantID, entity.UsagePeriodOf(time.Now())).Scan(&tokens)
+		if err == sql.ErrNoRows {
+			return 0, nil // Nothing used yet this period
+		}
+		if err != nil {
+			return 0, fmt.Errorf("failed to get current period usage: %w", err)
+		}
+		return tokens, nil
+	})
+}
+
+// ListUsagePeriods returns the tenant's usage per month, most recent first. The legacy
+// period isn't a month and is left out.
+func (r *TenantAISettingsRepository) ListUsagePeriods(ctx context.Context, tenantID uuid.UUID, limit int) ([]*entity.TokenUsagePeriod, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.TokenUsagePeriod, error) {
+		query := `
+			SELECT tenant_id, year_month, tokens_used, updated_at
+			FROM token_usage_periods
+			WHERE tenant_id = $1 AND year_month <> $3
+			ORDER BY year_month DESC
+			LIMIT $2
+		`
+		rows, err := tx.QueryContext(ctx, query, tenantID, limit, entity.LegacyUsagePeriod)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list usage periods: %w", err)
+		}
+		defer rows.Close()
+
+		var periods []*entity.TokenUsagePeriod
+		for rows.Next() {
+			period := &entity.TokenUsagePeriod{}
+			if err := rows.Scan(&period.TenantID, &period.YearMonth, &period.TokensUsed, &period.UpdatedAt); err != nil {
+				return nil, fmt.Errorf("failed to scan usage period: %w", err)
+			}
+			periods = append(periods, period)
+		}
+		return periods, rows.Err()
 	})
 }
@@ -0,0 +1,100 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/tenant"
+)
+
+func TestIncrementTokenUsageConcurrently(t *testing.T) {
+	db := testDB(t)
+	tenantID := createTestTenant(t, db)
+	repo := NewTenantAISettingsRepository(db)
+	ctx := tenant.WithTenantID(context.Background(), tenantID)
+
+	const (
+		workers    = 16
+		increments = 25
+		tokens     = 7
+	)
+	var wg sync.WaitGroup
+	errs := make(chan error, workers*increments)
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range increments {
+				if err := repo.IncrementTokenUsage(ctx, tenantID, tokens); err != nil {
+					errs <- err
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Errorf("increment failed: %v", err)
+	}
+
+	used, err := repo.GetCurrentPeriodUsage(ctx, tenantID)
+	if err != nil {
+		t.Fatalf("failed to get usage: %v", err)
+	}
+	if want := int64(workers * increments * tokens); used != want {
+		t.Errorf("usage = %d, want %d: concurrent increments were lost", used, want)
+	}
+
+	periods, err := repo.ListUsagePeriods(ctx, tenantID, 12)
+	if err != nil {
+		t.Fatalf("failed to list periods: %v", err)
+	}
+	if len(periods) != 1 {
+		t.Errorf("got %d periods, want one row for the current month", len(periods))
+	}
+}
+
+func TestLegacyUsagePeriodCountsTowardsTotalOnly(t *testing.T) {
+	db := testDB(t)
+	tenantID := createTestTenant(t, db)
+	repo := NewTenantAISettingsRepository(db)
+	ctx := tenant.WithTenantID(context.Background(), tenantID)
+
+	// As migration 043 leaves a tenant with usage from before monthly tracking
+	err := RLSExec(ctx, db, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, `INSERT INTO tenant_ai_settings (tenant_id) VALUES ($1)`, tenantID); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx, `INSERT INTO token_usage_periods (tenant_id, year_month, tokens_used) VALUES ($1, $2, 1000)`,
+			tenantID, entity.LegacyUsagePeriod)
+		return err
+	})
+	if err != nil {
+		t.Fatalf("failed to seed usage: %v", err)
+	}
+	if err := repo.IncrementTokenUsage(ctx, tenantID, 50); err != nil {
+		t.Fatalf("failed to increment usage: %v", err)
+	}
+
+	settings, err := repo.Get(ctx, tenantID)
+	if err != nil {
+		t.Fatalf("failed to get settings: %v", err)
+	}
+	if settings.TotalTokensUsed != 1050 {
+		t.Errorf("total = %d, want 1050 including the legacy period", settings.TotalTokensUsed)
+	}
+	if used, err := repo.GetCurrentPeriodUsage(ctx, tenantID); err != nil || used != 50 {
+		t.Errorf("current period usage = %d, %v; want 50", used, err)
+	}
+	periods, err := repo.ListUsagePeriods(ctx, tenantID, 12)
+	if err != nil {
+		t.Fatalf("failed to list periods: %v", err)
+	}
+	if len(periods) != 1 || periods[0].YearMonth != entity.UsagePeriodOf(time.Now()) {
+		t.Errorf("periods = %+v, want only the current month", periods)
+	}
+}
@@ -0,0 +1,184 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+// APITokenRepository implements repository.APITokenRepository using PostgreSQL.
+type APITokenRepository struct {
+	db *sql.DB
+}
+
+// NewAPITokenRepository creates a new PostgreSQL API token repository.
+func NewAPITokenRepository(db *sql.DB) repository.APITokenRepository {
+	return &APITokenRepository{db: db}
+}
+
+const apiTokenColumns = `
+	id, tenant_id, user_id, name, token_prefix, secret_hash, scopes, rate_limit_per_minute,
+	expires_at, last_used_at, revoked_at, created_by_user_id, created_at
+`
+
+// Create creates a new token.
+func (r *APITokenRepository) Create(ctx context.Context, token *entity.APIToken) error {
+	scopes := make([]string, len(token.Scopes))
+	for i, scope := range token.Scopes {
+		scopes[i] = scope.String()
+	}
+
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		query := `
+			INSERT INTO api_tokens (tenant_id, user_id, name, token_prefix, secret_hash, scopes, rate_limit_per_minute, expires_at, created_by_user_id)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+			RETURNING id, created_at
+		`
+		err := tx.QueryRowContext(ctx, query,
+			token.TenantID,
+			token.UserID,
+			token.Name,
+			token.TokenPrefix,
+			token.SecretHash,
+			pq.Array(scopes),
+			token.RateLimitPerMinute,
+			token.ExpiresAt,
+			token.CreatedByUserID,
+		).Scan(&token.ID, &token.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to create API token: %w", err)
+		}
+		return nil
+	})
+}
+
+// GetByID retrieves a token by its ID, or nil if there is none.
+func (r *APITokenRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.APIToken, error) {
+	return r.getOne(ctx, `SELECT `+apiTokenColumns+` FROM api_tokens WHERE id = $1`, id)
+}
+
+// GetBySecretHash retrieves a token by the hash of its secret, or nil if there is none.
+func (r *APITokenRepository) GetBySecretHash(ctx context.Context, secretHash string) (*entity.APIToken, error) {
+	return r.getOne(ctx, `SELECT `+apiTokenColumns+` FROM api_tokens WHERE secret_hash = $1`, secretHash)
+}
+
+// ListByUserID retrieves a user's tokens, newest first.
+func (r *APITokenRepository) ListByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.APIToken, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.APIToken, error) {
+		query := `
+			SELECT ` + apiTokenColumns + `
+			FROM api_tokens
+			WHERE user_id = $1
+			ORDER BY created_at DESC
+		`
+		rows, err := tx.QueryContext(ctx, query, userID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list API tokens: %w", err)
+		}
+		defer rows.Close()
+
+		var tokens []*entity.APIToken
+		for rows.Next() {
+			token, err := scanAPIToken(rows)
+			if err != nil {
+				return nil, fmt.Errorf("failed to scan API token: %w", err)
+			}
+			tokens = append(tokens, token)
+		}
+		if err := rows.Err(); err != nil {
+			return nil, fmt.Errorf("failed to list API tokens: %w", err)
+		}
+		return tokens, nil
+	})
+}
+
+// CountActiveByUserID counts a user's unrevoked, unexpired tokens.
+func (r *APITokenRepository) CountActiveByUserID(ctx context.Context, userID uuid.UUID) (int, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (int, error) {
+		query := `
+			SELECT COUNT(*)
+			FROM api_tokens
+			WHERE user_id = $1 AND revoked_at IS NULL AND expires_at > NOW()
+		`
+		var count int
+		if err := tx.QueryRowContext(ctx, query, userID).Scan(&count); err != nil {
+			return 0, fmt.Errorf("failed to count API tokens: %w", err)
+		}
+		return count, nil
+	})
+}
+
+// Revoke marks a token revoked.
+func (r *APITokenRepository) Revoke(ctx context.Context, id uuid.UUID) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		query := `UPDATE api_tokens SET revoked_at = COALESCE(revoked_at, NOW()) WHERE id = $1`
+		if _, err := tx.ExecContext(ctx, query, id); err != nil {
+			return fmt.Errorf("failed to revoke API token: %w", err)
+		}
+		return nil
+	})
+}
+
+// TouchLastUsed records a use of the token, at most once a minute.
+func (r *APITokenRepository) TouchLastUsed(ctx context.Context, id uuid.UUID) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		query := `
+			UPDATE api_tokens
+			SET last_used_at = NOW()
+			WHERE id = $1 AND (last_used_at IS NULL OR last_used_at < NOW() - INTERVAL '1 minute')
+		`
+		if _, err := tx.ExecContext(ctx, query, id); err != nil {
+			return fmt.Errorf("failed to record API token use: %w", err)
+		}
+		return nil
+	})
+}
+
+// getOne runs a query selecting apiTokenColumns for at most one token.
+func (r *APITokenRepository) getOne(ctx context.Context, query string, args ...any) (*entity.APIToken, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.APIToken, error) {
+		token, err := scanAPIToken(tx.QueryRowContext(ctx, query, args...))
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get API token: %w", err)
+		}
+		return token, nil
+	})
+}
+
+// scanAPIToken scans one api_tokens row in apiTokenColumns order.
+func scanAPIToken(row interface{ Scan(...any) error }) (*entity.APIToken, error) {
+	token := &entity.APIToken{}
+	var scopes pq.StringArray
+	err := row.Scan(
+		&token.ID,
+		&token.TenantID,
+		&token.UserID,
+		&token.Name,
+		&token.TokenPrefix,
+		&token.SecretHash,
+		&scopes,
+		&token.RateLimitPerMinute,
+		&token.ExpiresAt,
+		&token.LastUsedAt,
+		&token.RevokedAt,
+		&token.CreatedByUserID,
+		&token.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	token.Scopes = make([]valueobject.APITokenScope, len(scopes))
+	for i, scope := range scopes {
+		token.Scopes[i] = valueobject.APITokenScope(scope)
+	}
+	return token, nil
+}
@@ -0,0 +1,168 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sogos/mirai-backend/internal/domain/audit"
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+)
+
+// defaultAuditEventListLimit caps List when no limit is given.
+const defaultAuditEventListLimit = 100
+
+// AuditEventRepository implements repository.AuditEventRepository using PostgreSQL.
+type AuditEventRepository struct {
+	db *sql.DB
+}
+
+// NewAuditEventRepository creates a new PostgreSQL audit event repository.
+func NewAuditEventRepository(db *sql.DB) repository.AuditEventRepository {
+	return &AuditEventRepository{db: db}
+}
+
+// Create appends an event to the audit log.
+// Uses RLS to ensure proper tenant isolation.
+func (r *AuditEventRepository) Create(ctx context.Context, event *entity.AuditEvent) error {
+	changes := event.Changes
+	if changes == nil {
+		changes = audit.Changes{}
+	}
+	changesJSON, err := json.Marshal(changes)
+	if err != nil {
+		return fmt.Errorf("failed to marshal audit changes: %w", err)
+	}
+
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		query := `
+			INSERT INTO audit_events (tenant_id, actor_user_id, action, target_type, target_id, changes, ip_address, api_token_id)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+			RETURNING id, created_at
+		`
+		err := tx.QueryRowContext(ctx, query,
+			event.TenantID,
+			event.ActorUserID,
+			string(event.Action),
+			string(event.TargetType),
+			event.TargetID,
+			changesJSON,
+			event.IPAddress,
+			event.APITokenID,
+		).Scan(&event.ID, &event.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to create audit event: %w", err)
+		}
+		return nil
+	})
+}
+
+// List retrieves events, most recent first.
+// Uses RLS to ensure proper tenant isolation.
+func (r *AuditEventRepository) List(ctx context.Context, opts entity.AuditEventListOptions) ([]*entity.AuditEvent, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.AuditEvent, error) {
+		query := `
+			SELECT id, tenant_id, actor_user_id, action, target_type, target_id, changes, ip_address, api_token_id, created_at
+			FROM audit_events
+			WHERE 1=1
+		`
+		args := []interface{}{}
+		argIndex := 1
+
+		if opts.Action != nil {
+			query += fmt.Sprintf(" AND action = $%d", argIndex)
+			args = append(args, string(*opts.Action))
+			argIndex++
+		}
+
+		if opts.ActorUserID != nil {
+			query += fmt.Sprintf(" AND actor_user_id = $%d", argIndex)
+			args = append(args, *opts.ActorUserID)
+			argIndex++
+		}
+
+		if opts.TargetType != nil {
+			query += fmt.Sprintf(" AND target_type = $%d", argIndex)
+			args = append(args, string(*opts.TargetType))
+			argIndex++
+		}
+
+		if opts.TargetID != nil {
+			query += fmt.Sprintf(" AND target_id = $%d", argIndex)
+			args = append(args, *opts.TargetID)
+			argIndex++
+		}
+
+		if opts.Since != nil {
+			query += fmt.Sprintf(" AND created_at >= $%d", argIndex)
+			args = append(args, *opts.Since)
+			argIndex++
+		}
+
+		if opts.Until != nil {
+			query += fmt.Sprintf(" AND created_at < $%d", argIndex)
+			args = append(args, *opts.Until)
+			argIndex++
+		}
+
+		// Cursor-based pagination using "timestamp|id" format
+		if opts.Cursor != nil {
+			parts := strings.SplitN(*opts.Cursor, "|", 2)
+			if len(parts) == 2 {
+				cursorTime, timeErr := time.Parse(time.RFC3339Nano, parts[0])
+				cursorID, idErr := uuid.Parse(parts[1])
+				if timeErr == nil && idErr == nil {
+					query += fmt.Sprintf(" AND (created_at, id) < ($%d, $%d)", argIndex, argIndex+1)
+					args = append(args, cursorTime, cursorID)
+					argIndex += 2
+				}
+			}
+		}
+
+		limit := opts.Limit
+		if limit <= 0 {
+			limit = defaultAuditEventListLimit
+		}
+		query += fmt.Sprintf(" ORDER BY created_at DESC, id DESC LIMIT $%d", argIndex)
+		args = append(args, limit)
+
+		rows, err := tx.QueryContext(ctx, query, args...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list audit events: %w", err)
+		}
+		defer rows.Close()
+
+		var events []*entity.AuditEvent
+		for rows.Next() {
+			event := &entity.AuditEvent{}
+			var action, targetType string
+			var changesJSON []byte
+			if err := rows.Scan(
+				&event.ID,
+				&event.TenantID,
+				&event.ActorUserID,
+				&action,
+				&targetType,
+				&event.TargetID,
+				&changesJSON,
+				&event.IPAddress,
+				&event.APITokenID,
+				&event.CreatedAt,
+			); err != nil {
+				return nil, fmt.Errorf("failed to scan audit event: %w", err)
+			}
+			event.Action = audit.Action(action)
+			event.TargetType = audit.TargetType(targetType)
+			if err := json.Unmarshal(changesJSON, &event.Changes); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal audit changes: %w", err)
+			}
+			events = append(events, event)
+		}
+		return events, rows.Err()
+	})
+}
@@ -159,10 +159,11 @@ func (r *CompanyRepository) UpdateStripeFields(ctx context.Context, id uuid.UUID
 	})
 }
 
-// CountUsersByCompanyID counts the number of users in a company.
+// CountUsersByCompanyID counts the active users in a company.
+// Deactivated users do not occupy a seat.
 func (r *CompanyRepository) CountUsersByCompanyID(ctx context.Context, companyID uuid.UUID) (int, error) {
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (int, error) {
-		query := `SELECT COUNT(*) FROM users WHERE company_id = $1`
+		query := `SELECT COUNT(*) FROM users WHERE company_id = $1 AND is_active`
 		var count int
 		err := tx.QueryRowContext(ctx, query, companyID).Scan(&count)
 		if err != nil {
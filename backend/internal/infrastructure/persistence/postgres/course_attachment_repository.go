@@ -0,0 +1,283 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+)
+
+// CourseAttachmentRepository implements repository.CourseAttachmentRepository using PostgreSQL.
+type CourseAttachmentRepository struct {
+	db *sql.DB
+}
+
+// NewCourseAttachmentRepository creates a new PostgreSQL course attachment repository.
+func NewCourseAttachmentRepository(db *sql.DB) repository.CourseAttachmentRepository {
+	return &CourseAttachmentRepository{db: db}
+}
+
+const courseAttachmentColumns = `
+	id, tenant_id, course_id, file_name, content_type, file_path, size_bytes,
+	status, error_message, chunk_count, flagged_chunk_count, excluded_from_prompts,
+	uploaded_by_user_id, created_at, processed_at
+`
+
+// Create creates a new attachment.
+func (r *CourseAttachmentRepository) Create(ctx context.Context, attachment *entity.CourseAttachment) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		query := `
+			INSERT INTO course_attachments (tenant_id, course_id, file_name, content_type, file_path, size_bytes, status, uploaded_by_user_id)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+			RETURNING id, created_at
+		`
+		return tx.QueryRowContext(ctx, query,
+			attachment.TenantID,
+			attachment.CourseID,
+			attachment.FileName,
+			attachment.ContentType,
+			attachment.FilePath,
+			attachment.SizeBytes,
+			attachment.Status,
+			attachment.UploadedByUserID,
+		).Scan(&attachment.ID, &attachment.CreatedAt)
+	})
+}
+
+// GetByID retrieves an attachment by its ID, or nil if there is none.
+func (r *CourseAttachmentRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.CourseAttachment, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.CourseAttachment, error) {
+		query := `SELECT ` + courseAttachmentColumns + ` FROM course_attachments WHERE id = $1`
+		attachment, err := scanCourseAttachment(tx.QueryRowContext(ctx, query, id))
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get course attachment: %w", err)
+		}
+		return attachment, nil
+	})
+}
+
+// ListByCourseID retrieves a course's attachments, oldest first.
+func (r *CourseAttachmentRepository) ListByCourseID(ctx context.Context, courseID uuid.UUID) ([]*entity.CourseAttachment, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.CourseAttachment, error) {
+		return listCourseAttachments(ctx, tx, `
+			SELECT `+courseAttachmentColumns+`
+			FROM course_attachments
+			WHERE course_id = $1
+			ORDER BY created_at ASC
+		`, courseID)
+	})
+}
+
+// ListForPrompts retrieves a course's ready, included attachments, oldest first, with
+// their unflagged chunks loaded.
+func (r *CourseAttachmentRepository) ListForPrompts(ctx context.Context, courseID uuid.UUID) ([]*entity.CourseAttachment, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.CourseAttachment, error) {
+		attachments, err := listCourseAttachments(ctx, tx, `
+			SELECT `+courseAttachmentColumns+`
+			FROM course_attachments
+			WHERE course_id = $1 AND status = 'ready' AND excluded_from_prompts = false
+			ORDER BY created_at ASC
+		`, courseID)
+		if err != nil || len(attachments) == 0 {
+			return attachments, err
+		}
+
+		byID := make(map[uuid.UUID]*entity.CourseAttachment, len(attachments))
+		for _, attachment := range attachments {
+			byID[attachment.ID] = attachment
+		}
+		rows, err := tx.QueryContext(ctx, `
+			SELECT c.attachment_id, c.content
+			FROM course_attachment_chunks c
+			JOIN course_attachments a ON a.id = c.attachment_id
+			WHERE a.course_id = $1 AND a.status = 'ready' AND a.excluded_from_prompts = false
+			  AND c.injection_flagged = false
+			ORDER BY c.attachment_id, c.position
+		`, courseID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list course attachment chunks: %w", err)
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var attachmentID uuid.UUID
+			var content string
+			if err := rows.Scan(&attachmentID, &content); err != nil {
+				return nil, fmt.Errorf("failed to scan course attachment chunk: %w", err)
+			}
+			if attachment := byID[attachmentID]; attachment != nil {
+				attachment.Chunks = append(attachment.Chunks, content)
+			}
+		}
+		if err := rows.Err(); err != nil {
+			return nil, fmt.Errorf("failed to list course attachment chunks: %w", err)
+		}
+		return attachments, nil
+	})
+}
+
+// UpdateStatus records an attachment's extraction status, error and chunk counts.
+func (r *CourseAttachmentRepository) UpdateStatus(ctx context.Context, attachment *entity.CourseAttachment) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		query := `
+			UPDATE course_attachments
+			SET status = $2, error_message = $3, chunk_count = $4, flagged_chunk_count = $5, processed_at = $6
+			WHERE id = $1
+		`
+		_, err := tx.ExecContext(ctx, query,
+			attachment.ID,
+			attachment.Status,
+			attachment.ErrorMessage,
+			attachment.ChunkCount,
+			attachment.FlaggedChunks,
+			attachment.ProcessedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to update course attachment status: %w", err)
+		}
+		return nil
+	})
+}
+
+// SetExcluded sets whether the attachment is left out of generation prompts.
+func (r *CourseAttachmentRepository) SetExcluded(ctx context.Context, id uuid.UUID, excluded bool) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		query := `UPDATE course_attachments SET excluded_from_prompts = $2 WHERE id = $1`
+		if _, err := tx.ExecContext(ctx, query, id, excluded); err != nil {
+			return fmt.Errorf("failed to update course attachment: %w", err)
+		}
+		return nil
+	})
+}
+
+// ReplaceChunks replaces an attachment's chunks with the given ones, in order.
+func (r *CourseAttachmentRepository) ReplaceChunks(ctx context.Context, attachment *entity.CourseAttachment, chunks []entity.CourseAttachmentChunk) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM course_attachment_chunks WHERE attachment_id = $1`, attachment.ID); err != nil {
+			return fmt.Errorf("failed to delete course attachment chunks: %w", err)
+		}
+
+		stmt, err := tx.PrepareContext(ctx, `
+			INSERT INTO course_attachment_chunks (tenant_id, attachment_id, position, content, injection_flagged)
+			VALUES ($1, $2, $3, $4, $5)
+		`)
+		if err != nil {
+			return fmt.Errorf("failed to prepare course attachment chunk insert: %w", err)
+		}
+		defer stmt.Close()
+		for i, chunk := range chunks {
+			if _, err := stmt.ExecContext(ctx, attachment.TenantID, attachment.ID, i, chunk.Content, chunk.InjectionFlagged); err != nil {
+				return fmt.Errorf("failed to insert course attachment chunk: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// Copy creates attachment as a copy of the source attachment, chunks included.
+// The copy keeps the source's extraction status, counts and exclusion.
+func (r *CourseAttachmentRepository) Copy(ctx context.Context, sourceID uuid.UUID, attachment *entity.CourseAttachment) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		query := `
+			INSERT INTO course_attachments (
+				tenant_id, course_id, file_name, content_type, file_path, size_bytes,
+				status, error_message, chunk_count, flagged_chunk_count, excluded_from_prompts,
+				uploaded_by_user_id, processed_at
+			)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+			RETURNING id, created_at
+		`
+		err := tx.QueryRowContext(ctx, query,
+			attachment.TenantID,
+			attachment.CourseID,
+			attachment.FileName,
+			attachment.ContentType,
+			attachment.FilePath,
+			attachment.SizeBytes,
+			attachment.Status,
+			attachment.ErrorMessage,
+			attachment.ChunkCount,
+			attachment.FlaggedChunks,
+			attachment.ExcludedFromPrompts,
+			attachment.UploadedByUserID,
+			attachment.ProcessedAt,
+		).Scan(&attachment.ID, &attachment.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to copy course attachment: %w", err)
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO course_attachment_chunks (tenant_id, attachment_id, position, content, injection_flagged)
+			SELECT tenant_id, $2, position, content, injection_flagged
+			FROM course_attachment_chunks
+			WHERE attachment_id = $1
+		`, sourceID, attachment.ID)
+		if err != nil {
+			return fmt.Errorf("failed to copy course attachment chunks: %w", err)
+		}
+		return nil
+	})
+}
+
+// Delete deletes an attachment and its chunks.
+func (r *CourseAttachmentRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM course_attachments WHERE id = $1`, id); err != nil {
+			return fmt.Errorf("failed to delete course attachment: %w", err)
+		}
+		return nil
+	})
+}
+
+// listCourseAttachments runs a query selecting courseAttachmentColumns.
+func listCourseAttachments(ctx context.Context, tx *sql.Tx, query string, args ...any) ([]*entity.CourseAttachment, error) {
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list course attachments: %w", err)
+	}
+	defer rows.Close()
+
+	var attachments []*entity.CourseAttachment
+	for rows.Next() {
+		attachment, err := scanCourseAttachment(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan course attachment: %w", err)
+		}
+		attachments = append(attachments, attachment)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list course attachments: %w", err)
+	}
+	return attachments, nil
+}
+
+// scanCourseAttachment scans one course_attachments row in courseAttachmentColumns order.
+func scanCourseAttachment(row interface{ Scan(...any) error }) (*entity.CourseAttachment, error) {
+	attachment := &entity.CourseAttachment{}
+	err := row.Scan(
+		&attachment.ID,
+		&attachment.TenantID,
+		&attachment.CourseID,
+		&attachment.FileName,
+		&attachment.ContentType,
+		&attachment.FilePath,
+		&attachment.SizeBytes,
+		&attachment.Status,
+		&attachment.ErrorMessage,
+		&attachment.ChunkCount,
+		&attachment.FlaggedChunks,
+		&attachment.ExcludedFromPrompts,
+		&attachment.UploadedByUserID,
+		&attachment.CreatedAt,
+		&attachment.ProcessedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return attachment, nil
+}
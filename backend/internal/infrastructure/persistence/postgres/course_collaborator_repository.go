@@ -0,0 +1,144 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+// CourseCollaboratorRepository implements repository.CourseCollaboratorRepository using PostgreSQL.
+type CourseCollaboratorRepository struct {
+	db *sql.DB
+}
+
+// NewCourseCollaboratorRepository creates a new PostgreSQL course collaborator repository.
+func NewCourseCollaboratorRepository(db *sql.DB) repository.CourseCollaboratorRepository {
+	return &CourseCollaboratorRepository{db: db}
+}
+
+// Add assigns a user to a course with a role.
+func (r *CourseCollaboratorRepository) Add(ctx context.Context, collaborator *entity.CourseCollaborator) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		query := `
+			INSERT INTO course_collaborators (tenant_id, course_id, user_id, role, added_by_user_id)
+			VALUES ($1, $2, $3, $4, $5)
+			RETURNING id, created_at
+		`
+		return tx.QueryRowContext(ctx, query,
+			collaborator.TenantID,
+			collaborator.CourseID,
+			collaborator.UserID,
+			collaborator.Role.String(),
+			collaborator.AddedByUserID,
+		).Scan(&collaborator.ID, &collaborator.CreatedAt)
+	})
+}
+
+// Remove removes a user's assignment from a course.
+func (r *CourseCollaboratorRepository) Remove(ctx context.Context, courseID, userID uuid.UUID) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		query := `DELETE FROM course_collaborators WHERE course_id = $1 AND user_id = $2`
+		result, err := tx.ExecContext(ctx, query, courseID, userID)
+		if err != nil {
+			return fmt.Errorf("failed to remove course collaborator: %w", err)
+		}
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get affected rows: %w", err)
+		}
+		if rows == 0 {
+			return fmt.Errorf("course collaborator not found")
+		}
+		return nil
+	})
+}
+
+// Get retrieves a specific collaborator assignment.
+func (r *CourseCollaboratorRepository) Get(ctx context.Context, courseID, userID uuid.UUID) (*entity.CourseCollaborator, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.CourseCollaborator, error) {
+		query := `
+			SELECT id, tenant_id, course_id, user_id, role, added_by_user_id, created_at
+			FROM course_collaborators
+			WHERE course_id = $1 AND user_id = $2
+		`
+		collaborator := &entity.CourseCollaborator{}
+		var roleStr string
+		err := tx.QueryRowContext(ctx, query, courseID, userID).Scan(
+			&collaborator.ID,
+			&collaborator.TenantID,
+			&collaborator.CourseID,
+			&collaborator.UserID,
+			&roleStr,
+			&collaborator.AddedByUserID,
+			&collaborator.CreatedAt,
+		)
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get course collaborator: %w", err)
+		}
+		collaborator.Role = valueobject.CourseRole(roleStr)
+		return collaborator, nil
+	})
+}
+
+// ListByCourseID retrieves all collaborators on a course.
+func (r *CourseCollaboratorRepository) ListByCourseID(ctx context.Context, courseID uuid.UUID) ([]*entity.CourseCollaborator, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.CourseCollaborator, error) {
+		query := `
+			SELECT id, tenant_id, course_id, user_id, role, added_by_user_id, created_at
+			FROM course_collaborators
+			WHERE course_id = $1
+			ORDER BY created_at ASC
+		`
+		return r.queryCollaborators(ctx, tx, query, courseID)
+	})
+}
+
+// ListByUserID retrieves all course assignments for a user.
+func (r *CourseCollaboratorRepository) ListByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.CourseCollaborator, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.CourseCollaborator, error) {
+		query := `
+			SELECT id, tenant_id, course_id, user_id, role, added_by_user_id, created_at
+			FROM course_collaborators
+			WHERE user_id = $1
+			ORDER BY created_at ASC
+		`
+		return r.queryCollaborators(ctx, tx, query, userID)
+	})
+}
+
+// queryCollaborators runs a collaborator SELECT and scans the resulting rows.
+func (r *CourseCollaboratorRepository) queryCollaborators(ctx context.Context, tx *sql.Tx, query string, args ...interface{}) ([]*entity.CourseCollaborator, error) {
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list course collaborators: %w", err)
+	}
+	defer rows.Close()
+
+	var collaborators []*entity.CourseCollaborator
+	for rows.Next() {
+		collaborator := &entity.CourseCollaborator{}
+		var roleStr string
+		if err := rows.Scan(
+			&collaborator.ID,
+			&collaborator.TenantID,
+			&collaborator.CourseID,
+			&collaborator.UserID,
+			&roleStr,
+			&collaborator.AddedByUserID,
+			&collaborator.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan course collaborator: %w", err)
+		}
+		collaborator.Role = valueobject.CourseRole(roleStr)
+		collaborators = append(collaborators, collaborator)
+	}
+	return collaborators, nil
+}
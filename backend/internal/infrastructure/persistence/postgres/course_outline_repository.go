@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 
 	"github.com/google/uuid"
@@ -44,12 +45,14 @@ func (r *CourseOutlineRepository) Create(ctx context.Context, outline *entity.Co
 func (r *CourseOutlineRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.CourseOutline, error) {
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.CourseOutline, error) {
 		query := `
-			SELECT id, tenant_id, course_id, version, approval_status, rejection_reason, generated_at, approved_at, approved_by_user_id
+			SELECT id, tenant_id, course_id, version, approval_status, rejection_reason, generated_at, generated_by_user_id,
+			       approved_at, approved_by_user_id, endorsed_at, endorsed_by_user_id, lesson_changes
 			FROM course_outlines
 			WHERE id = $1
 		`
 		outline := &entity.CourseOutline{}
 		var statusStr string
+		var changesJSON []byte
 		err := tx.QueryRowContext(ctx, query, id).Scan(
 			&outline.ID,
 			&outline.TenantID,
@@ -58,8 +61,12 @@ func (r *CourseOutlineRepository) GetByID(ctx context.Context, id uuid.UUID) (*e
 			&statusStr,
 			&outline.RejectionReason,
 			&outline.GeneratedAt,
+			&outline.GeneratedByUserID,
 			&outline.ApprovedAt,
 			&outline.ApprovedByUserID,
+			&outline.EndorsedAt,
+			&outline.EndorsedByUserID,
+			&changesJSON,
 		)
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -68,6 +75,11 @@ func (r *CourseOutlineRepository) GetByID(ctx context.Context, id uuid.UUID) (*e
 			return nil, fmt.Errorf("failed to get outline: %w", err)
 		}
 		outline.ApprovalStatus, _ = valueobject.ParseOutlineApprovalStatus(statusStr)
+		if changesJSON != nil {
+			if err := json.Unmarshal(changesJSON, &outline.LessonChanges); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal lesson changes: %w", err)
+			}
+		}
 		return outline, nil
 	})
 }
@@ -76,7 +88,8 @@ func (r *CourseOutlineRepository) GetByID(ctx context.Context, id uuid.UUID) (*e
 func (r *CourseOutlineRepository) GetByCourseID(ctx context.Context, courseID uuid.UUID) (*entity.CourseOutline, error) {
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.CourseOutline, error) {
 		query := `
-			SELECT id, tenant_id, course_id, version, approval_status, rejection_reason, generated_at, approved_at, approved_by_user_id
+			SELECT id, tenant_id, course_id, version, approval_status, rejection_reason, generated_at, generated_by_user_id,
+			       approved_at, approved_by_user_id, endorsed_at, endorsed_by_user_id, lesson_changes
 			FROM course_outlines
 			WHERE course_id = $1
 			ORDER BY version DESC
@@ -84,6 +97,7 @@ func (r *CourseOutlineRepository) GetByCourseID(ctx context.Context, courseID uu
 		`
 		outline := &entity.CourseOutline{}
 		var statusStr string
+		var changesJSON []byte
 		err := tx.QueryRowContext(ctx, query, courseID).Scan(
 			&outline.ID,
 			&outline.TenantID,
@@ -92,8 +106,12 @@ func (r *CourseOutlineRepository) GetByCourseID(ctx context.Context, courseID uu
 			&statusStr,
 			&outline.RejectionReason,
 			&outline.GeneratedAt,
+			&outline.GeneratedByUserID,
 			&outline.ApprovedAt,
 			&outline.ApprovedByUserID,
+			&outline.EndorsedAt,
+			&outline.EndorsedByUserID,
+			&changesJSON,
 		)
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -102,6 +120,11 @@ func (r *CourseOutlineRepository) GetByCourseID(ctx context.Context, courseID uu
 			return nil, fmt.Errorf("failed to get outline: %w", err)
 		}
 		outline.ApprovalStatus, _ = valueobject.ParseOutlineApprovalStatus(statusStr)
+		if changesJSON != nil {
+			if err := json.Unmarshal(changesJSON, &outline.LessonChanges); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal lesson changes: %w", err)
+			}
+		}
 		return outline, nil
 	})
 }
@@ -110,12 +133,14 @@ func (r *CourseOutlineRepository) GetByCourseID(ctx context.Context, courseID uu
 func (r *CourseOutlineRepository) GetByCourseIDAndVersion(ctx context.Context, courseID uuid.UUID, version int32) (*entity.CourseOutline, error) {
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.CourseOutline, error) {
 		query := `
-			SELECT id, tenant_id, course_id, version, approval_status, rejection_reason, generated_at, approved_at, approved_by_user_id
+			SELECT id, tenant_id, course_id, version, approval_status, rejection_reason, generated_at, generated_by_user_id,
+			       approved_at, approved_by_user_id, endorsed_at, endorsed_by_user_id, lesson_changes
 			FROM course_outlines
 			WHERE course_id = $1 AND version = $2
 		`
 		outline := &entity.CourseOutline{}
 		var statusStr string
+		var changesJSON []byte
 		err := tx.QueryRowContext(ctx, query, courseID, version).Scan(
 			&outline.ID,
 			&outline.TenantID,
@@ -124,8 +149,12 @@ func (r *CourseOutlineRepository) GetByCourseIDAndVersion(ctx context.Context, c
 			&statusStr,
 			&outline.RejectionReason,
 			&outline.GeneratedAt,
+			&outline.GeneratedByUserID,
 			&outline.ApprovedAt,
 			&outline.ApprovedByUserID,
+			&outline.EndorsedAt,
+			&outline.EndorsedByUserID,
+			&changesJSON,
 		)
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -134,6 +163,11 @@ func (r *CourseOutlineRepository) GetByCourseIDAndVersion(ctx context.Context, c
 			return nil, fmt.Errorf("failed to get outline: %w", err)
 		}
 		outline.ApprovalStatus, _ = valueobject.ParseOutlineApprovalStatus(statusStr)
+		if changesJSON != nil {
+			if err := json.Unmarshal(changesJSON, &outline.LessonChanges); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal lesson changes: %w", err)
+			}
+		}
 		return outline, nil
 	})
 }
@@ -143,14 +177,17 @@ func (r *CourseOutlineRepository) Update(ctx context.Context, outline *entity.Co
 	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
 		query := `
 			UPDATE course_outlines
-			SET approval_status = $1, rejection_reason = $2, approved_at = $3, approved_by_user_id = $4
-			WHERE id = $5
+			SET approval_status = $1, rejection_reason = $2, approved_at = $3, approved_by_user_id = $4,
+			    endorsed_at = $5, endorsed_by_user_id = $6
+			WHERE id = $7
 		`
 		_, err := tx.ExecContext(ctx, query,
 			outline.ApprovalStatus.String(),
 			outline.RejectionReason,
 			outline.ApprovedAt,
 			outline.ApprovedByUserID,
+			outline.EndorsedAt,
+			outline.EndorsedByUserID,
 			outline.ID,
 		)
 		return err
@@ -177,11 +214,19 @@ func (r *CourseOutlineRepository) GetNextVersion(ctx context.Context, courseID u
 // CreateCompleteOutline atomically creates an outline with all its sections and lessons.
 // If any part fails, the entire operation is rolled back.
 func (r *CourseOutlineRepository) CreateCompleteOutline(ctx context.Context, outline *entity.CourseOutline, sections []entity.OutlineSection, lessons []entity.OutlineLesson) error {
+	var changesJSON []byte
+	if outline.LessonChanges != nil {
+		var err error
+		if changesJSON, err = json.Marshal(outline.LessonChanges); err != nil {
+			return fmt.Errorf("failed to marshal lesson changes: %w", err)
+		}
+	}
+
 	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
 		// 1. Insert outline
 		outlineQuery := `
-			INSERT INTO course_outlines (id, tenant_id, course_id, version, approval_status, rejection_reason, generated_at)
-			VALUES ($1, $2, $3, $4, $5, $6, NOW())
+			INSERT INTO course_outlines (id, tenant_id, course_id, version, approval_status, rejection_reason, generated_at, generated_by_user_id, lesson_changes)
+			VALUES ($1, $2, $3, $4, $5, $6, NOW(), $7, $8)
 		`
 		_, err := tx.ExecContext(ctx, outlineQuery,
 			outline.ID,
@@ -190,6 +235,8 @@ func (r *CourseOutlineRepository) CreateCompleteOutline(ctx context.Context, out
 			outline.Version,
 			outline.ApprovalStatus.String(),
 			outline.RejectionReason,
+			outline.GeneratedByUserID,
+			changesJSON,
 		)
 		if err != nil {
 			return fmt.Errorf("failed to insert outline: %w", err)
@@ -216,10 +263,14 @@ func (r *CourseOutlineRepository) CreateCompleteOutline(ctx context.Context, out
 
 		// 3. Insert all lessons
 		lessonQuery := `
-			INSERT INTO outline_lessons (id, tenant_id, section_id, title, description, position, estimated_duration_minutes, learning_objectives, is_last_in_section, is_last_in_course, created_at)
-			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, NOW())
+			INSERT INTO outline_lessons (id, tenant_id, section_id, title, description, position, estimated_duration_minutes, learning_objectives, is_last_in_section, is_last_in_course, target_audiences, lesson_key, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, NOW())
 		`
 		for _, lesson := range lessons {
+			lessonKey := lesson.LessonKey
+			if lessonKey == uuid.Nil {
+				lessonKey = lesson.ID
+			}
 			_, err := tx.ExecContext(ctx, lessonQuery,
 				lesson.ID,
 				lesson.TenantID,
@@ -231,6 +282,8 @@ func (r *CourseOutlineRepository) CreateCompleteOutline(ctx context.Context, out
 				pq.Array(lesson.LearningObjectives),
 				lesson.IsLastInSection,
 				lesson.IsLastInCourse,
+				pq.Array(lesson.TargetAudiences),
+				lessonKey,
 			)
 			if err != nil {
 				return fmt.Errorf("failed to insert lesson %s: %w", lesson.Title, err)
@@ -241,6 +294,121 @@ func (r *CourseOutlineRepository) CreateCompleteOutline(ctx context.Context, out
 	})
 }
 
+// UpdateOutlineContent atomically applies an edit to an outline: it updates the given
+// sections and lessons, adds the new lessons and removes the removed ones, and clears
+// any endorsement. If a section is not in the outline or a lesson is not in its section,
+// the entire operation is rolled back.
+func (r *CourseOutlineRepository) UpdateOutlineContent(ctx context.Context, outlineID uuid.UUID, update entity.OutlineContentUpdate) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		sectionQuery := `
+			UPDATE outline_sections
+			SET title = $1, description = $2, position = $3
+			WHERE id = $4 AND outline_id = $5
+		`
+		for _, section := range update.Sections {
+			result, err := tx.ExecContext(ctx, sectionQuery,
+				section.Title,
+				section.Description,
+				section.Position,
+				section.ID,
+				outlineID,
+			)
+			if err != nil {
+				return fmt.Errorf("failed to update section %s: %w", section.ID, err)
+			}
+			if rows, err := result.RowsAffected(); err != nil || rows == 0 {
+				return fmt.Errorf("section %s is not in outline %s", section.ID, outlineID)
+			}
+		}
+
+		lessonQuery := `
+			UPDATE outline_lessons
+			SET title = $1, description = $2, position = $3, estimated_duration_minutes = $4, learning_objectives = $5,
+			    is_last_in_section = $6, is_last_in_course = $7
+			WHERE id = $8 AND section_id = $9
+			  AND section_id IN (SELECT id FROM outline_sections WHERE outline_id = $10)
+		`
+		for _, lesson := range update.Lessons {
+			result, err := tx.ExecContext(ctx, lessonQuery,
+				lesson.Title,
+				lesson.Description,
+				lesson.Position,
+				lesson.EstimatedDurationMinutes,
+				pq.Array(lesson.LearningObjectives),
+				lesson.IsLastInSection,
+				lesson.IsLastInCourse,
+				lesson.ID,
+				lesson.SectionID,
+				outlineID,
+			)
+			if err != nil {
+				return fmt.Errorf("failed to update lesson %s: %w", lesson.ID, err)
+			}
+			if rows, err := result.RowsAffected(); err != nil || rows == 0 {
+				return fmt.Errorf("lesson %s is not in section %s", lesson.ID, lesson.SectionID)
+			}
+		}
+
+		insertQuery := `
+			INSERT INTO outline_lessons (id, tenant_id, section_id, title, description, position, estimated_duration_minutes, learning_objectives, is_last_in_section, is_last_in_course, target_audiences, lesson_key, created_at)
+			SELECT $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, NOW()
+			WHERE EXISTS (SELECT 1 FROM outline_sections WHERE id = $3 AND outline_id = $13)
+		`
+		for _, lesson := range update.AddedLessons {
+			lessonKey := lesson.LessonKey
+			if lessonKey == uuid.Nil {
+				lessonKey = lesson.ID
+			}
+			result, err := tx.ExecContext(ctx, insertQuery,
+				lesson.ID,
+				lesson.TenantID,
+				lesson.SectionID,
+				lesson.Title,
+				lesson.Description,
+				lesson.Position,
+				lesson.EstimatedDurationMinutes,
+				pq.Array(lesson.LearningObjectives),
+				lesson.IsLastInSection,
+				lesson.IsLastInCourse,
+				pq.Array(lesson.TargetAudiences),
+				lessonKey,
+				outlineID,
+			)
+			if err != nil {
+				return fmt.Errorf("failed to add lesson %s: %w", lesson.Title, err)
+			}
+			if rows, err := result.RowsAffected(); err != nil || rows == 0 {
+				return fmt.Errorf("section %s is not in outline %s", lesson.SectionID, outlineID)
+			}
+		}
+
+		deleteQuery := `
+			DELETE FROM outline_lessons
+			WHERE id = $1 AND section_id IN (SELECT id FROM outline_sections WHERE outline_id = $2)
+		`
+		for _, lessonID := range update.RemovedLessonIDs {
+			result, err := tx.ExecContext(ctx, deleteQuery, lessonID, outlineID)
+			if err != nil {
+				return fmt.Errorf("failed to remove lesson %s: %w", lessonID, err)
+			}
+			if rows, err := result.RowsAffected(); err != nil || rows == 0 {
+				return fmt.Errorf("lesson %s is not in outline %s", lessonID, outlineID)
+			}
+		}
+
+		// An endorsement covers the content the reviewer saw
+		_, err := tx.ExecContext(ctx, `
+			UPDATE course_outlines SET endorsed_at = NULL, endorsed_by_user_id = NULL
+			WHERE id = $1
+		`, outlineID)
+		if err != nil {
+			return fmt.Errorf("failed to clear outline endorsement: %w", err)
+		}
+
+		return nil
+	})
+}
+
 // OutlineSectionRepository implements repository.OutlineSectionRepository using PostgreSQL.
 type OutlineSectionRepository struct {
 	db *sql.DB
@@ -372,12 +540,19 @@ func NewOutlineLessonRepository(db *sql.DB) repository.OutlineLessonRepository {
 // Create creates a new lesson.
 func (r *OutlineLessonRepository) Create(ctx context.Context, lesson *entity.OutlineLesson) error {
 	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		if lesson.ID == uuid.Nil {
+			lesson.ID = uuid.New()
+		}
+		if lesson.LessonKey == uuid.Nil {
+			lesson.LessonKey = lesson.ID
+		}
 		query := `
-			INSERT INTO outline_lessons (tenant_id, section_id, title, description, position, estimated_duration_minutes, learning_objectives, is_last_in_section, is_last_in_course)
-			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
-			RETURNING id, created_at
+			INSERT INTO outline_lessons (id, tenant_id, section_id, title, description, position, estimated_duration_minutes, learning_objectives, is_last_in_section, is_last_in_course, target_audiences, lesson_key)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+			RETURNING created_at
 		`
 		return tx.QueryRowContext(ctx, query,
+			lesson.ID,
 			lesson.TenantID,
 			lesson.SectionID,
 			lesson.Title,
@@ -387,7 +562,9 @@ func (r *OutlineLessonRepository) Create(ctx context.Context, lesson *entity.Out
 			pq.Array(lesson.LearningObjectives),
 			lesson.IsLastInSection,
 			lesson.IsLastInCourse,
-		).Scan(&lesson.ID, &lesson.CreatedAt)
+			pq.Array(lesson.TargetAudiences),
+			lesson.LessonKey,
+		).Scan(&lesson.CreatedAt)
 	})
 }
 
@@ -395,12 +572,12 @@ func (r *OutlineLessonRepository) Create(ctx context.Context, lesson *entity.Out
 func (r *OutlineLessonRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.OutlineLesson, error) {
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.OutlineLesson, error) {
 		query := `
-			SELECT id, tenant_id, section_id, title, description, position, estimated_duration_minutes, learning_objectives, is_last_in_section, is_last_in_course, created_at
+			SELECT id, tenant_id, section_id, title, description, position, estimated_duration_minutes, learning_objectives, is_last_in_section, is_last_in_course, target_audiences, lesson_key, created_at
 			FROM outline_lessons
 			WHERE id = $1
 		`
 		lesson := &entity.OutlineLesson{}
-		var objectives pq.StringArray
+		var objectives, audiences pq.StringArray
 		err := tx.QueryRowContext(ctx, query, id).Scan(
 			&lesson.ID,
 			&lesson.TenantID,
@@ -412,6 +589,8 @@ func (r *OutlineLessonRepository) GetByID(ctx context.Context, id uuid.UUID) (*e
 			&objectives,
 			&lesson.IsLastInSection,
 			&lesson.IsLastInCourse,
+			&audiences,
+			&lesson.LessonKey,
 			&lesson.CreatedAt,
 		)
 		if err == sql.ErrNoRows {
@@ -421,6 +600,7 @@ func (r *OutlineLessonRepository) GetByID(ctx context.Context, id uuid.UUID) (*e
 			return nil, fmt.Errorf("failed to get lesson: %w", err)
 		}
 		lesson.LearningObjectives = []string(objectives)
+		lesson.TargetAudiences = []string(audiences)
 		return lesson, nil
 	})
 }
@@ -429,7 +609,7 @@ func (r *OutlineLessonRepository) GetByID(ctx context.Context, id uuid.UUID) (*e
 func (r *OutlineLessonRepository) ListBySectionID(ctx context.Context, sectionID uuid.UUID) ([]*entity.OutlineLesson, error) {
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.OutlineLesson, error) {
 		query := `
-			SELECT id, tenant_id, section_id, title, description, position, estimated_duration_minutes, learning_objectives, is_last_in_section, is_last_in_course, created_at
+			SELECT id, tenant_id, section_id, title, description, position, estimated_duration_minutes, learning_objectives, is_last_in_section, is_last_in_course, target_audiences, lesson_key, created_at
 			FROM outline_lessons
 			WHERE section_id = $1
 			ORDER BY position ASC
@@ -443,7 +623,7 @@ func (r *OutlineLessonRepository) ListBySectionID(ctx context.Context, sectionID
 		var lessons []*entity.OutlineLesson
 		for rows.Next() {
 			lesson := &entity.OutlineLesson{}
-			var objectives pq.StringArray
+			var objectives, audiences pq.StringArray
 			if err := rows.Scan(
 				&lesson.ID,
 				&lesson.TenantID,
@@ -455,11 +635,14 @@ func (r *OutlineLessonRepository) ListBySectionID(ctx context.Context, sectionID
 				&objectives,
 				&lesson.IsLastInSection,
 				&lesson.IsLastInCourse,
+				&audiences,
+				&lesson.LessonKey,
 				&lesson.CreatedAt,
 			); err != nil {
 				return nil, fmt.Errorf("failed to scan lesson: %w", err)
 			}
 			lesson.LearningObjectives = []string(objectives)
+			lesson.TargetAudiences = []string(audiences)
 			lessons = append(lessons, lesson)
 		}
 		return lessons, nil
@@ -471,8 +654,8 @@ func (r *OutlineLessonRepository) Update(ctx context.Context, lesson *entity.Out
 	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
 		query := `
 			UPDATE outline_lessons
-			SET title = $1, description = $2, position = $3, estimated_duration_minutes = $4, learning_objectives = $5, is_last_in_section = $6, is_last_in_course = $7
-			WHERE id = $8
+			SET title = $1, description = $2, position = $3, estimated_duration_minutes = $4, learning_objectives = $5, is_last_in_section = $6, is_last_in_course = $7, target_audiences = $8
+			WHERE id = $9
 		`
 		_, err := tx.ExecContext(ctx, query,
 			lesson.Title,
@@ -482,6 +665,7 @@ func (r *OutlineLessonRepository) Update(ctx context.Context, lesson *entity.Out
 			pq.Array(lesson.LearningObjectives),
 			lesson.IsLastInSection,
 			lesson.IsLastInCourse,
+			pq.Array(lesson.TargetAudiences),
 			lesson.ID,
 		)
 		return err
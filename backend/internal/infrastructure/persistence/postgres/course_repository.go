@@ -4,6 +4,8 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/lib/pq"
@@ -25,9 +27,9 @@ func NewCourseRepository(db *sql.DB) repository.CourseRepository {
 func (r *CourseRepository) Create(ctx context.Context, course *entity.Course) error {
 	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
 		query := `
-			INSERT INTO courses (tenant_id, company_id, created_by_user_id, team_id, title, status, version, folder_id, category_tags, thumbnail_path, content_path)
-			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
-			RETURNING id, created_at, updated_at
+			INSERT INTO courses (tenant_id, company_id, created_by_user_id, team_id, title, status, version, folder_id, category_tags, thumbnail_path, content_path, is_sample, source_course_id, language, content_size_bytes)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+			RETURNING id, draft_version, published_version, created_at, updated_at
 		`
 		return tx.QueryRowContext(ctx, query,
 			course.TenantID,
@@ -41,7 +43,11 @@ func (r *CourseRepository) Create(ctx context.Context, course *entity.Course) er
 			pq.Array(course.CategoryTags),
 			course.ThumbnailPath,
 			course.ContentPath,
-		).Scan(&course.ID, &course.CreatedAt, &course.UpdatedAt)
+			course.IsSample,
+			course.SourceCourseID,
+			course.Language,
+			course.ContentSizeBytes,
+		).Scan(&course.ID, &course.DraftVersion, &course.PublishedVersion, &course.CreatedAt, &course.UpdatedAt)
 	})
 }
 
@@ -49,13 +55,16 @@ func (r *CourseRepository) Create(ctx context.Context, course *entity.Course) er
 func (r *CourseRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Course, error) {
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.Course, error) {
 		query := `
-			SELECT id, tenant_id, company_id, created_by_user_id, team_id, title, status, version, folder_id, category_tags, thumbnail_path, content_path, created_at, updated_at
+			SELECT id, tenant_id, company_id, created_by_user_id, team_id, title, status, version, folder_id, category_tags, thumbnail_path, content_path, created_at, updated_at, is_sample, source_course_id, language, content_size_bytes, draft_version, published_version,
+			       generation_lock_job_id, generation_locked_at
 			FROM courses
 			WHERE id = $1
 		`
 		course := &entity.Course{}
 		var statusStr string
 		var tags pq.StringArray
+		var lockJobID *uuid.UUID
+		var lockedAt *time.Time
 		err := tx.QueryRowContext(ctx, query, id).Scan(
 			&course.ID,
 			&course.TenantID,
@@ -71,6 +80,14 @@ func (r *CourseRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.C
 			&course.ContentPath,
 			&course.CreatedAt,
 			&course.UpdatedAt,
+			&course.IsSample,
+			&course.SourceCourseID,
+			&course.Language,
+			&course.ContentSizeBytes,
+			&course.DraftVersion,
+			&course.PublishedVersion,
+			&lockJobID,
+			&lockedAt,
 		)
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -80,10 +97,33 @@ func (r *CourseRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.C
 		}
 		course.Status = entity.ParseCourseStatus(statusStr)
 		course.CategoryTags = []string(tags)
+		if lockJobID != nil && lockedAt != nil {
+			course.GenerationLock = &entity.CourseGenerationLock{JobID: *lockJobID, LockedAt: *lockedAt}
+		}
 		return course, nil
 	})
 }
 
+// GetGenerationLock returns the course's generation lock, or nil if it isn't locked.
+func (r *CourseRepository) GetGenerationLock(ctx context.Context, id uuid.UUID) (*entity.CourseGenerationLock, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.CourseGenerationLock, error) {
+		query := `
+			SELECT generation_lock_job_id, generation_locked_at
+			FROM courses
+			WHERE id = $1 AND generation_lock_job_id IS NOT NULL
+		`
+		lock := &entity.CourseGenerationLock{}
+		err := tx.QueryRowContext(ctx, query, id).Scan(&lock.JobID, &lock.LockedAt)
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get course generation lock: %w", err)
+		}
+		return lock, nil
+	})
+}
+
 // Update updates a course.
 func (r *CourseRepository) Update(ctx context.Context, course *entity.Course) error {
 	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
@@ -106,6 +146,97 @@ func (r *CourseRepository) Update(ctx context.Context, course *entity.Course) er
 	})
 }
 
+// SetContentSize records the size of the course's content as just written. It leaves
+// updated_at alone; the write that changed the content updates the course itself.
+func (r *CourseRepository) SetContentSize(ctx context.Context, id uuid.UUID, sizeBytes int64) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		query := `UPDATE courses SET content_size_bytes = $1 WHERE id = $2`
+		if _, err := tx.ExecContext(ctx, query, sizeBytes, id); err != nil {
+			return fmt.Errorf("failed to set course content size: %w", err)
+		}
+		return nil
+	})
+}
+
+// BumpDraftVersion records an edit to the course's draft and returns the new draft
+// version. Like SetContentSize it leaves updated_at alone.
+func (r *CourseRepository) BumpDraftVersion(ctx context.Context, id uuid.UUID) (int32, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (int32, error) {
+		query := `UPDATE courses SET draft_version = draft_version + 1 WHERE id = $1 RETURNING draft_version`
+		var version int32
+		if err := tx.QueryRowContext(ctx, query, id).Scan(&version); err != nil {
+			if err == sql.ErrNoRows {
+				return 0, fmt.Errorf("course not found")
+			}
+			return 0, fmt.Errorf("failed to bump course draft version: %w", err)
+		}
+		return version, nil
+	})
+}
+
+// SetPublishedVersion points the course at a new published version, provided its
+// published version is still from. It reports whether the course was updated, so two
+// concurrent publishes can't both win.
+func (r *CourseRepository) SetPublishedVersion(ctx context.Context, id uuid.UUID, from, to int32) (bool, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (bool, error) {
+		query := `UPDATE courses SET published_version = $1 WHERE id = $2 AND published_version = $3`
+		result, err := tx.ExecContext(ctx, query, to, id, from)
+		if err != nil {
+			return false, fmt.Errorf("failed to set course published version: %w", err)
+		}
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return false, fmt.Errorf("failed to get affected rows: %w", err)
+		}
+		return rows == 1, nil
+	})
+}
+
+// ResetDraftVersion marks the draft as matching the published version again, after the
+// draft was restored from it.
+func (r *CourseRepository) ResetDraftVersion(ctx context.Context, id uuid.UUID) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		query := `UPDATE courses SET draft_version = published_version WHERE id = $1 AND published_version > 0`
+		if _, err := tx.ExecContext(ctx, query, id); err != nil {
+			return fmt.Errorf("failed to reset course draft version: %w", err)
+		}
+		return nil
+	})
+}
+
+// ReleaseStaleGenerationLocks clears the generation locks of courses whose locking job is
+// no longer queued or processing, e.g. because the job was ended by something that did not
+// release the lock, and returns the courses released.
+func (r *CourseRepository) ReleaseStaleGenerationLocks(ctx context.Context) ([]uuid.UUID, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]uuid.UUID, error) {
+		query := `
+			UPDATE courses c
+			SET generation_lock_job_id = NULL, generation_locked_at = NULL
+			WHERE c.generation_lock_job_id IS NOT NULL
+			  AND NOT EXISTS (
+				SELECT 1 FROM generation_jobs j
+				WHERE j.id = c.generation_lock_job_id AND j.status IN ('queued', 'processing')
+			  )
+			RETURNING c.id
+		`
+		rows, err := tx.QueryContext(ctx, query)
+		if err != nil {
+			return nil, fmt.Errorf("failed to release stale generation locks: %w", err)
+		}
+		defer rows.Close()
+
+		var ids []uuid.UUID
+		for rows.Next() {
+			var id uuid.UUID
+			if err := rows.Scan(&id); err != nil {
+				return nil, fmt.Errorf("failed to scan course ID: %w", err)
+			}
+			ids = append(ids, id)
+		}
+		return ids, rows.Err()
+	})
+}
+
 // Delete deletes a course.
 func (r *CourseRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
@@ -129,7 +260,8 @@ func (r *CourseRepository) Delete(ctx context.Context, id uuid.UUID) error {
 func (r *CourseRepository) List(ctx context.Context, opts entity.CourseListOptions) ([]*entity.Course, error) {
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.Course, error) {
 		query := `
-			SELECT id, tenant_id, company_id, created_by_user_id, team_id, title, status, version, folder_id, category_tags, thumbnail_path, content_path, created_at, updated_at
+			SELECT id, tenant_id, company_id, created_by_user_id, team_id, title, status, version, folder_id, category_tags, thumbnail_path, content_path, created_at, updated_at, is_sample, source_course_id, language, content_size_bytes, draft_version, published_version,
+				COALESCE((SELECT u.is_active FROM users u WHERE u.id = courses.created_by_user_id), TRUE)
 			FROM courses
 			WHERE 1=1
 		`
@@ -154,7 +286,31 @@ func (r *CourseRepository) List(ctx context.Context, opts entity.CourseListOptio
 			argIndex++
 		}
 
-		query += " ORDER BY updated_at DESC"
+		if opts.CollaboratorUserID != nil {
+			query += fmt.Sprintf(" AND (created_by_user_id = $%d OR id IN (SELECT course_id FROM course_collaborators WHERE user_id = $%d))", argIndex, argIndex)
+			args = append(args, *opts.CollaboratorUserID)
+			argIndex++
+		}
+
+		if opts.TeamFoldersOf != nil {
+			query += teamFoldersFilter(argIndex)
+			args = append(args, *opts.TeamFoldersOf)
+			argIndex++
+		}
+
+		if opts.SampleOnly {
+			query += " AND is_sample"
+		}
+
+		if opts.Unversioned {
+			query += " AND published_version = 0"
+		}
+
+		if opts.LargestFirst {
+			query += " AND content_size_bytes IS NOT NULL ORDER BY content_size_bytes DESC"
+		} else {
+			query += " ORDER BY updated_at DESC"
+		}
 
 		if opts.Limit > 0 {
 			query += fmt.Sprintf(" LIMIT $%d", argIndex)
@@ -193,6 +349,13 @@ func (r *CourseRepository) List(ctx context.Context, opts entity.CourseListOptio
 				&course.ContentPath,
 				&course.CreatedAt,
 				&course.UpdatedAt,
+				&course.IsSample,
+				&course.SourceCourseID,
+				&course.Language,
+				&course.ContentSizeBytes,
+				&course.DraftVersion,
+				&course.PublishedVersion,
+				&course.CreatorActive,
 			); err != nil {
 				return nil, fmt.Errorf("failed to scan course: %w", err)
 			}
@@ -204,6 +367,127 @@ func (r *CourseRepository) List(ctx context.Context, opts entity.CourseListOptio
 	})
 }
 
+// ListCards retrieves a page of course cards, most recently modified first. It reads only
+// columns held in idx_courses_cards so the page comes from an index-only scan.
+func (r *CourseRepository) ListCards(ctx context.Context, opts entity.CourseCardListOptions) ([]*entity.CourseCard, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.CourseCard, error) {
+		query := `
+			SELECT id, title, status, updated_at
+			FROM courses
+			WHERE 1=1
+		`
+		args := []interface{}{}
+		argIndex := 1
+
+		if opts.Status != nil {
+			query += fmt.Sprintf(" AND status = $%d", argIndex)
+			args = append(args, opts.Status.String())
+			argIndex++
+		}
+
+		if opts.FolderID != nil {
+			query += fmt.Sprintf(" AND folder_id = $%d", argIndex)
+			args = append(args, *opts.FolderID)
+			argIndex++
+		}
+
+		// Cursor-based pagination using "timestamp|id" format
+		if opts.Cursor != nil {
+			parts := strings.SplitN(*opts.Cursor, "|", 2)
+			if len(parts) == 2 {
+				cursorTime, timeErr := time.Parse(time.RFC3339Nano, parts[0])
+				cursorID, idErr := uuid.Parse(parts[1])
+				if timeErr == nil && idErr == nil {
+					query += fmt.Sprintf(" AND (updated_at, id) < ($%d, $%d)", argIndex, argIndex+1)
+					args = append(args, cursorTime, cursorID)
+					argIndex += 2
+				}
+			}
+		}
+
+		query += " ORDER BY updated_at DESC, id DESC"
+		if opts.Limit > 0 {
+			query += fmt.Sprintf(" LIMIT $%d", argIndex)
+			args = append(args, opts.Limit)
+		}
+
+		rows, err := tx.QueryContext(ctx, query, args...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list course cards: %w", err)
+		}
+		defer rows.Close()
+
+		var cards []*entity.CourseCard
+		for rows.Next() {
+			card := &entity.CourseCard{}
+			var statusStr string
+			if err := rows.Scan(&card.ID, &card.Title, &statusStr, &card.UpdatedAt); err != nil {
+				return nil, fmt.Errorf("failed to scan course card: %w", err)
+			}
+			card.Status = entity.ParseCourseStatus(statusStr)
+			cards = append(cards, card)
+		}
+		return cards, rows.Err()
+	})
+}
+
+// GetCardDetails retrieves the card details of the given courses in one query, with each
+// creator's Kratos ID and the duration of each course's latest outline.
+func (r *CourseRepository) GetCardDetails(ctx context.Context, ids []uuid.UUID) ([]*entity.CourseCardDetails, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.CourseCardDetails, error) {
+		query := `
+			SELECT c.id, c.category_tags, c.thumbnail_path, c.created_by_user_id, u.kratos_id, COALESCE(u.is_active, TRUE),
+			       COALESCE((
+			           SELECT SUM(l.estimated_duration_minutes)
+			           FROM outline_lessons l
+			           JOIN outline_sections s ON s.id = l.section_id
+			           WHERE s.outline_id = (
+			               SELECT o.id FROM course_outlines o
+			               WHERE o.course_id = c.id
+			               ORDER BY o.version DESC
+			               LIMIT 1
+			           )
+			       ), 0)
+			FROM courses c
+			LEFT JOIN users u ON u.id = c.created_by_user_id
+			WHERE c.id = ANY($1)
+		`
+		rows, err := tx.QueryContext(ctx, query, pq.Array(ids))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get course card details: %w", err)
+		}
+		defer rows.Close()
+
+		var details []*entity.CourseCardDetails
+		for rows.Next() {
+			d := &entity.CourseCardDetails{}
+			var tags pq.StringArray
+			if err := rows.Scan(&d.CourseID, &tags, &d.ThumbnailPath, &d.CreatedByUserID, &d.CreatorKratosID, &d.CreatorActive, &d.DurationMinutes); err != nil {
+				return nil, fmt.Errorf("failed to scan course card details: %w", err)
+			}
+			d.Tags = []string(tags)
+			details = append(details, d)
+		}
+		return details, rows.Err()
+	})
+}
+
+// teamFoldersFilter restricts courses to a team's folders and their subfolders, with the
+// team ID as parameter n.
+func teamFoldersFilter(n int) string {
+	return fmt.Sprintf(` AND folder_id IN (
+			WITH RECURSIVE team_folders AS (
+				SELECT id FROM folders WHERE team_id = $%d
+				UNION ALL
+				SELECT f.id FROM folders f JOIN team_folders t ON f.parent_id = t.id
+			)
+			SELECT id FROM team_folders
+		)`, n)
+}
+
 // Count returns the total count of courses matching the filter options.
 func (r *CourseRepository) Count(ctx context.Context, opts entity.CourseListOptions) (int, error) {
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (int, error) {
@@ -226,6 +510,27 @@ func (r *CourseRepository) Count(ctx context.Context, opts entity.CourseListOpti
 		if len(opts.Tags) > 0 {
 			query += fmt.Sprintf(" AND category_tags && $%d", argIndex)
 			args = append(args, pq.Array(opts.Tags))
+			argIndex++
+		}
+
+		if opts.CollaboratorUserID != nil {
+			query += fmt.Sprintf(" AND (created_by_user_id = $%d OR id IN (SELECT course_id FROM course_collaborators WHERE user_id = $%d))", argIndex, argIndex)
+			args = append(args, *opts.CollaboratorUserID)
+			argIndex++
+		}
+
+		if opts.TeamFoldersOf != nil {
+			query += teamFoldersFilter(argIndex)
+			args = append(args, *opts.TeamFoldersOf)
+			argIndex++
+		}
+
+		if opts.SampleOnly {
+			query += " AND is_sample"
+		}
+
+		if opts.Unversioned {
+			query += " AND published_version = 0"
 		}
 
 		var count int
@@ -249,3 +554,26 @@ func (r *CourseRepository) CountByFolder(ctx context.Context, folderID uuid.UUID
 		return count, nil
 	})
 }
+
+// CountPerFolder counts courses in every folder that has any.
+func (r *CourseRepository) CountPerFolder(ctx context.Context) (map[uuid.UUID]int, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (map[uuid.UUID]int, error) {
+		query := `SELECT folder_id, COUNT(*) FROM courses WHERE folder_id IS NOT NULL GROUP BY folder_id`
+		rows, err := tx.QueryContext(ctx, query)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count courses per folder: %w", err)
+		}
+		defer rows.Close()
+
+		counts := make(map[uuid.UUID]int)
+		for rows.Next() {
+			var folderID uuid.UUID
+			var count int
+			if err := rows.Scan(&folderID, &count); err != nil {
+				return nil, fmt.Errorf("failed to scan folder count: %w", err)
+			}
+			counts[folderID] = count
+		}
+		return counts, rows.Err()
+	})
+}
@@ -0,0 +1,188 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+// staleDeliveryMinutes is how long a delivery can stay delivering before another
+// worker assumes the one that claimed it crashed.
+const staleDeliveryMinutes = 10
+
+// CourseSyncDeliveryRepository implements repository.CourseSyncDeliveryRepository using PostgreSQL.
+type CourseSyncDeliveryRepository struct {
+	db *sql.DB
+}
+
+// NewCourseSyncDeliveryRepository creates a new PostgreSQL course sync delivery repository.
+func NewCourseSyncDeliveryRepository(db *sql.DB) repository.CourseSyncDeliveryRepository {
+	return &CourseSyncDeliveryRepository{db: db}
+}
+
+const courseSyncDeliveryColumns = `id, tenant_id, connector_id, course_id, kind, event, replay_of_id, status, attempts, max_attempts, next_attempt_at, last_attempt_at, last_error, response_status, remote_id, artifact_path, created_at, updated_at`
+
+// Create creates a new delivery.
+func (r *CourseSyncDeliveryRepository) Create(ctx context.Context, delivery *entity.CourseSyncDelivery) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		query := `
+			INSERT INTO course_sync_deliveries (tenant_id, connector_id, course_id, kind, event, replay_of_id,
+				status, max_attempts, next_attempt_at, artifact_path)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+			RETURNING id, created_at, updated_at
+		`
+		return tx.QueryRowContext(ctx, query,
+			delivery.TenantID,
+			delivery.ConnectorID,
+			delivery.CourseID,
+			delivery.Kind.String(),
+			delivery.Event,
+			delivery.ReplayOfID,
+			delivery.Status.String(),
+			delivery.MaxAttempts,
+			delivery.NextAttemptAt,
+			delivery.ArtifactPath,
+		).Scan(&delivery.ID, &delivery.CreatedAt, &delivery.UpdatedAt)
+	})
+}
+
+// ClaimNextDue atomically claims the next due course sync. Test and replay deliveries
+// are sent by the request that made them and are never claimed.
+// Uses RLS with superadmin context to access deliveries across all tenants.
+func (r *CourseSyncDeliveryRepository) ClaimNextDue(ctx context.Context) (*entity.CourseSyncDelivery, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.CourseSyncDelivery, error) {
+		query := fmt.Sprintf(`
+			UPDATE course_sync_deliveries
+			SET status = 'delivering', attempts = attempts + 1, last_attempt_at = NOW(), updated_at = NOW()
+			WHERE id = (
+				SELECT id FROM course_sync_deliveries
+				WHERE kind = 'sync'
+				  AND ((status = 'pending' AND next_attempt_at <= NOW())
+				   OR (status = 'delivering' AND last_attempt_at < NOW() - INTERVAL '%d minutes'))
+				ORDER BY next_attempt_at ASC
+				LIMIT 1
+				FOR UPDATE SKIP LOCKED
+			)
+			RETURNING %s
+		`, staleDeliveryMinutes, courseSyncDeliveryColumns)
+		delivery, err := scanCourseSyncDelivery(tx.QueryRowContext(ctx, query))
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to claim course sync delivery: %w", err)
+		}
+		return delivery, nil
+	})
+}
+
+// Update updates a delivery.
+func (r *CourseSyncDeliveryRepository) Update(ctx context.Context, delivery *entity.CourseSyncDelivery) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		query := `
+			UPDATE course_sync_deliveries
+			SET status = $1, attempts = $2, next_attempt_at = $3, last_attempt_at = $4, last_error = $5,
+				response_status = $6, remote_id = $7, artifact_path = $8, updated_at = NOW()
+			WHERE id = $9
+			RETURNING updated_at
+		`
+		err := tx.QueryRowContext(ctx, query,
+			delivery.Status.String(),
+			delivery.Attempts,
+			delivery.NextAttemptAt,
+			delivery.LastAttemptAt,
+			delivery.LastError,
+			delivery.ResponseStatus,
+			delivery.RemoteID,
+			delivery.ArtifactPath,
+			delivery.ID,
+		).Scan(&delivery.UpdatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to update course sync delivery: %w", err)
+		}
+		return nil
+	})
+}
+
+// GetByID retrieves a delivery by its ID.
+func (r *CourseSyncDeliveryRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.CourseSyncDelivery, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.CourseSyncDelivery, error) {
+		query := `SELECT ` + courseSyncDeliveryColumns + ` FROM course_sync_deliveries WHERE id = $1`
+		delivery, err := scanCourseSyncDelivery(tx.QueryRowContext(ctx, query, id))
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get course sync delivery: %w", err)
+		}
+		return delivery, nil
+	})
+}
+
+// ListByCourseID retrieves a course's deliveries, newest first.
+func (r *CourseSyncDeliveryRepository) ListByCourseID(ctx context.Context, courseID uuid.UUID) ([]*entity.CourseSyncDelivery, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.CourseSyncDelivery, error) {
+		query := `SELECT ` + courseSyncDeliveryColumns + `
+			FROM course_sync_deliveries
+			WHERE course_id = $1
+			ORDER BY created_at DESC
+		`
+		rows, err := tx.QueryContext(ctx, query, courseID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list course sync deliveries: %w", err)
+		}
+		defer rows.Close()
+
+		var deliveries []*entity.CourseSyncDelivery
+		for rows.Next() {
+			delivery, err := scanCourseSyncDelivery(rows)
+			if err != nil {
+				return nil, fmt.Errorf("failed to scan course sync delivery: %w", err)
+			}
+			deliveries = append(deliveries, delivery)
+		}
+		return deliveries, rows.Err()
+	})
+}
+
+// scanCourseSyncDelivery scans a delivery row in courseSyncDeliveryColumns order.
+func scanCourseSyncDelivery(row interface{ Scan(...any) error }) (*entity.CourseSyncDelivery, error) {
+	delivery := &entity.CourseSyncDelivery{}
+	var kindStr, statusStr string
+	var responseStatus sql.NullInt32
+	err := row.Scan(
+		&delivery.ID,
+		&delivery.TenantID,
+		&delivery.ConnectorID,
+		&delivery.CourseID,
+		&kindStr,
+		&delivery.Event,
+		&delivery.ReplayOfID,
+		&statusStr,
+		&delivery.Attempts,
+		&delivery.MaxAttempts,
+		&delivery.NextAttemptAt,
+		&delivery.LastAttemptAt,
+		&delivery.LastError,
+		&responseStatus,
+		&delivery.RemoteID,
+		&delivery.ArtifactPath,
+		&delivery.CreatedAt,
+		&delivery.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	delivery.Kind, _ = valueobject.ParseCourseSyncDeliveryKind(kindStr)
+	delivery.Status, _ = valueobject.ParseCourseSyncStatus(statusStr)
+	if responseStatus.Valid {
+		status := int(responseStatus.Int32)
+		delivery.ResponseStatus = &status
+	}
+	return delivery, nil
+}
@@ -0,0 +1,78 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang-migrate/migrate/v4"
+	migratepg "github.com/golang-migrate/migrate/v4/database/postgres"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/google/uuid"
+	_ "github.com/lib/pq"
+
+	"github.com/sogos/mirai-backend/internal/domain/tenant"
+)
+
+// testDatabaseURLEnv names the database the repository tests run against. The tests
+// migrate it to the latest schema, so it must be a database meant for testing.
+const testDatabaseURLEnv = "MIRAI_TEST_DATABASE_URL"
+
+// testDB connects to the test database and migrates it, skipping the test when no
+// test database is configured.
+func testDB(t *testing.T) *sql.DB {
+	t.Helper()
+	url := os.Getenv(testDatabaseURLEnv)
+	if url == "" {
+		t.Skipf("%s not set", testDatabaseURLEnv)
+	}
+
+	db, err := sql.Open("postgres", url)
+	if err != nil {
+		t.Fatalf("failed to open test database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	driver, err := migratepg.WithInstance(db, &migratepg.Config{})
+	if err != nil {
+		t.Fatalf("failed to create migrate driver: %v", err)
+	}
+	migrations, err := filepath.Abs(filepath.Join("..", "..", "..", "..", "migrations"))
+	if err != nil {
+		t.Fatalf("failed to locate migrations: %v", err)
+	}
+	m, err := migrate.NewWithDatabaseInstance("file://"+migrations, "postgres", driver)
+	if err != nil {
+		t.Fatalf("failed to create migrate instance: %v", err)
+	}
+	if err := m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		t.Fatalf("failed to migrate test database: %v", err)
+	}
+	return db
+}
+
+// createTestTenant inserts a tenant that is deleted, with everything it owns, when the
+// test ends.
+func createTestTenant(t *testing.T, db *sql.DB) uuid.UUID {
+	t.Helper()
+	id := uuid.New()
+	ctx := tenant.WithSuperAdmin(context.Background(), true)
+	err := RLSExec(ctx, db, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `INSERT INTO tenants (id, name, slug) VALUES ($1, $2, $3)`,
+			id, "Test tenant", "test-"+id.String())
+		return err
+	})
+	if err != nil {
+		t.Fatalf("failed to create tenant: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = RLSExec(ctx, db, func(tx *sql.Tx) error {
+			_, err := tx.ExecContext(ctx, `DELETE FROM tenants WHERE id = $1`, id)
+			return err
+		})
+	})
+	return id
+}
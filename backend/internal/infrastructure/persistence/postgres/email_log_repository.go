@@ -0,0 +1,157 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+// defaultEmailLogListLimit caps List when no limit is given.
+const defaultEmailLogListLimit = 50
+
+// emailLogColumns are selected, in scan order, by every email log query.
+const emailLogColumns = `id, tenant_id, recipient, template, message_id, status, smtp_code, smtp_response, sent_at, status_updated_at`
+
+// EmailLogRepository implements repository.EmailLogRepository using PostgreSQL.
+type EmailLogRepository struct {
+	db *sql.DB
+}
+
+// NewEmailLogRepository creates a new PostgreSQL email log repository.
+func NewEmailLogRepository(db *sql.DB) repository.EmailLogRepository {
+	return &EmailLogRepository{db: db}
+}
+
+// Create records an email send.
+// Uses RLS to ensure proper tenant isolation.
+func (r *EmailLogRepository) Create(ctx context.Context, entry *entity.EmailLogEntry) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		query := `
+			INSERT INTO email_log (tenant_id, recipient, template, message_id, status, smtp_code, smtp_response)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)
+			RETURNING id, sent_at, status_updated_at
+		`
+		err := tx.QueryRowContext(ctx, query,
+			entry.TenantID,
+			entry.Recipient,
+			entry.Template,
+			entry.MessageID,
+			entry.Status.String(),
+			entry.SMTPCode,
+			entry.SMTPResponse,
+		).Scan(&entry.ID, &entry.SentAt, &entry.StatusUpdatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to create email log entry: %w", err)
+		}
+		return nil
+	})
+}
+
+// List retrieves log entries, most recent first.
+// Uses RLS to ensure proper tenant isolation.
+func (r *EmailLogRepository) List(ctx context.Context, opts entity.EmailLogListOptions) ([]*entity.EmailLogEntry, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.EmailLogEntry, error) {
+		query := `SELECT ` + emailLogColumns + ` FROM email_log WHERE 1=1`
+		args := []interface{}{}
+		argIndex := 1
+
+		if opts.Recipient != nil {
+			query += fmt.Sprintf(" AND lower(recipient) = lower($%d)", argIndex)
+			args = append(args, *opts.Recipient)
+			argIndex++
+		}
+
+		if opts.Template != nil {
+			query += fmt.Sprintf(" AND template = $%d", argIndex)
+			args = append(args, *opts.Template)
+			argIndex++
+		}
+
+		// Cursor-based pagination using "timestamp|id" format
+		if opts.Cursor != nil {
+			parts := strings.SplitN(*opts.Cursor, "|", 2)
+			if len(parts) == 2 {
+				cursorTime, timeErr := time.Parse(time.RFC3339Nano, parts[0])
+				cursorID, idErr := uuid.Parse(parts[1])
+				if timeErr == nil && idErr == nil {
+					query += fmt.Sprintf(" AND (sent_at, id) < ($%d, $%d)", argIndex, argIndex+1)
+					args = append(args, cursorTime, cursorID)
+					argIndex += 2
+				}
+			}
+		}
+
+		limit := opts.Limit
+		if limit <= 0 {
+			limit = defaultEmailLogListLimit
+		}
+		query += fmt.Sprintf(" ORDER BY sent_at DESC, id DESC LIMIT $%d", argIndex)
+		args = append(args, limit)
+
+		return queryEmailLog(ctx, tx, query, args...)
+	})
+}
+
+// ListRecentByRecipient retrieves the most recent entries for an address, newest first.
+// Uses RLS to ensure proper tenant isolation.
+func (r *EmailLogRepository) ListRecentByRecipient(ctx context.Context, recipient string, limit int) ([]*entity.EmailLogEntry, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.EmailLogEntry, error) {
+		query := `
+			SELECT ` + emailLogColumns + `
+			FROM email_log
+			WHERE lower(recipient) = lower($1)
+			ORDER BY sent_at DESC, id DESC
+			LIMIT $2
+		`
+		return queryEmailLog(ctx, tx, query, recipient, limit)
+	})
+}
+
+// queryEmailLog runs a query selecting emailLogColumns and scans the entries.
+func queryEmailLog(ctx context.Context, tx *sql.Tx, query string, args ...interface{}) ([]*entity.EmailLogEntry, error) {
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list email log: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []*entity.EmailLogEntry
+	for rows.Next() {
+		entry := &entity.EmailLogEntry{}
+		var statusStr string
+		var code sql.NullInt64
+		if err := rows.Scan(
+			&entry.ID,
+			&entry.TenantID,
+			&entry.Recipient,
+			&entry.Template,
+			&entry.MessageID,
+			&statusStr,
+			&code,
+			&entry.SMTPResponse,
+			&entry.SentAt,
+			&entry.StatusUpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan email log entry: %w", err)
+		}
+		status, err := valueobject.ParseEmailDeliveryStatus(statusStr)
+		if err != nil {
+			return nil, err
+		}
+		entry.Status = status
+		if code.Valid {
+			c := int(code.Int64)
+			entry.SMTPCode = &c
+		}
+		entries = append(entries, entry)
+	}
+	return entries, rows.Err()
+}
@@ -0,0 +1,181 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+)
+
+// ExportArtifactRepository implements repository.ExportArtifactRepository using PostgreSQL.
+type ExportArtifactRepository struct {
+	db *sql.DB
+}
+
+// NewExportArtifactRepository creates a new PostgreSQL export artifact repository.
+func NewExportArtifactRepository(db *sql.DB) repository.ExportArtifactRepository {
+	return &ExportArtifactRepository{db: db}
+}
+
+const exportArtifactColumns = `
+	id, tenant_id, job_id, course_id, sme_id, artifact_type, file_path, size_bytes,
+	created_by_user_id, created_at, expires_at, purged_at, purge_reason
+`
+
+// Create stores an artifact.
+// Uses RLS to ensure proper tenant isolation.
+func (r *ExportArtifactRepository) Create(ctx context.Context, artifact *entity.ExportArtifact) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		query := `
+			INSERT INTO export_artifacts (tenant_id, job_id, course_id, sme_id, artifact_type, file_path, size_bytes, created_by_user_id, expires_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+			RETURNING id, created_at
+		`
+		err := tx.QueryRowContext(ctx, query,
+			artifact.TenantID,
+			artifact.JobID,
+			artifact.CourseID,
+			artifact.SMEID,
+			artifact.Type,
+			artifact.FilePath,
+			artifact.SizeBytes,
+			artifact.CreatedByUserID,
+			artifact.ExpiresAt,
+		).Scan(&artifact.ID, &artifact.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to create export artifact: %w", err)
+		}
+		return nil
+	})
+}
+
+// GetByID retrieves an artifact by ID, or nil if there is none.
+// Uses RLS to ensure proper tenant isolation.
+func (r *ExportArtifactRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.ExportArtifact, error) {
+	return r.get(ctx, `SELECT `+exportArtifactColumns+` FROM export_artifacts WHERE id = $1`, id)
+}
+
+// GetByJobID retrieves the artifact an export job produced, or nil if there is none.
+// Uses RLS to ensure proper tenant isolation.
+func (r *ExportArtifactRepository) GetByJobID(ctx context.Context, jobID uuid.UUID) (*entity.ExportArtifact, error) {
+	return r.get(ctx, `SELECT `+exportArtifactColumns+` FROM export_artifacts WHERE job_id = $1`, jobID)
+}
+
+func (r *ExportArtifactRepository) get(ctx context.Context, query string, arg any) (*entity.ExportArtifact, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.ExportArtifact, error) {
+		artifact, err := scanExportArtifact(tx.QueryRowContext(ctx, query, arg))
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get export artifact: %w", err)
+		}
+		return artifact, nil
+	})
+}
+
+// ListByCourseID retrieves a course's artifacts, purged ones included, newest first.
+// Uses RLS to ensure proper tenant isolation.
+func (r *ExportArtifactRepository) ListByCourseID(ctx context.Context, courseID uuid.UUID) ([]*entity.ExportArtifact, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.ExportArtifact, error) {
+		return listExportArtifacts(ctx, tx, `
+			SELECT `+exportArtifactColumns+`
+			FROM export_artifacts
+			WHERE course_id = $1
+			ORDER BY created_at DESC
+		`, courseID)
+	})
+}
+
+// ListSuperseded retrieves the unpurged artifacts of the same type and course or SME as
+// the given one, other than it.
+// Uses RLS to ensure proper tenant isolation.
+func (r *ExportArtifactRepository) ListSuperseded(ctx context.Context, artifact *entity.ExportArtifact) ([]*entity.ExportArtifact, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.ExportArtifact, error) {
+		return listExportArtifacts(ctx, tx, `
+			SELECT `+exportArtifactColumns+`
+			FROM export_artifacts
+			WHERE tenant_id = $1 AND artifact_type = $2 AND id <> $3 AND purged_at IS NULL
+			  AND course_id IS NOT DISTINCT FROM $4 AND sme_id IS NOT DISTINCT FROM $5
+			ORDER BY created_at ASC
+		`, artifact.TenantID, artifact.Type, artifact.ID, artifact.CourseID, artifact.SMEID)
+	})
+}
+
+// ListExpired retrieves up to limit unpurged artifacts that expired before the cutoff,
+// oldest first.
+// Uses RLS to ensure proper tenant isolation.
+func (r *ExportArtifactRepository) ListExpired(ctx context.Context, before time.Time, limit int) ([]*entity.ExportArtifact, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.ExportArtifact, error) {
+		return listExportArtifacts(ctx, tx, `
+			SELECT `+exportArtifactColumns+`
+			FROM export_artifacts
+			WHERE purged_at IS NULL AND expires_at < $1
+			ORDER BY expires_at ASC
+			LIMIT $2
+		`, before, limit)
+	})
+}
+
+// MarkPurged records that an artifact's file was deleted. An artifact that is already
+// purged keeps its original reason.
+// Uses RLS to ensure proper tenant isolation.
+func (r *ExportArtifactRepository) MarkPurged(ctx context.Context, id uuid.UUID, reason entity.ExportPurgeReason) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		query := `UPDATE export_artifacts SET purged_at = NOW(), purge_reason = $2 WHERE id = $1 AND purged_at IS NULL`
+		if _, err := tx.ExecContext(ctx, query, id, reason); err != nil {
+			return fmt.Errorf("failed to mark export artifact purged: %w", err)
+		}
+		return nil
+	})
+}
+
+// listExportArtifacts runs a query selecting exportArtifactColumns.
+func listExportArtifacts(ctx context.Context, tx *sql.Tx, query string, args ...any) ([]*entity.ExportArtifact, error) {
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list export artifacts: %w", err)
+	}
+	defer rows.Close()
+
+	var artifacts []*entity.ExportArtifact
+	for rows.Next() {
+		artifact, err := scanExportArtifact(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan export artifact: %w", err)
+		}
+		artifacts = append(artifacts, artifact)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list export artifacts: %w", err)
+	}
+	return artifacts, nil
+}
+
+// scanExportArtifact scans one export_artifacts row in exportArtifactColumns order.
+func scanExportArtifact(row interface{ Scan(...any) error }) (*entity.ExportArtifact, error) {
+	artifact := &entity.ExportArtifact{}
+	err := row.Scan(
+		&artifact.ID,
+		&artifact.TenantID,
+		&artifact.JobID,
+		&artifact.CourseID,
+		&artifact.SMEID,
+		&artifact.Type,
+		&artifact.FilePath,
+		&artifact.SizeBytes,
+		&artifact.CreatedByUserID,
+		&artifact.CreatedAt,
+		&artifact.ExpiresAt,
+		&artifact.PurgedAt,
+		&artifact.PurgeReason,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return artifact, nil
+}
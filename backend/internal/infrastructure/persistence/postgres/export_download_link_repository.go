@@ -0,0 +1,72 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+)
+
+// ExportDownloadLinkRepository implements repository.ExportDownloadLinkRepository using PostgreSQL.
+type ExportDownloadLinkRepository struct {
+	db *sql.DB
+}
+
+// NewExportDownloadLinkRepository creates a new PostgreSQL export download link repository.
+func NewExportDownloadLinkRepository(db *sql.DB) repository.ExportDownloadLinkRepository {
+	return &ExportDownloadLinkRepository{db: db}
+}
+
+// Create stores a download link.
+// Uses RLS to ensure proper tenant isolation.
+func (r *ExportDownloadLinkRepository) Create(ctx context.Context, link *entity.ExportDownloadLink) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		err := tx.QueryRowContext(ctx, `
+			INSERT INTO export_download_links (token, tenant_id, job_id, user_id, file_path, expires_at)
+			VALUES ($1, $2, $3, $4, $5, $6)
+			RETURNING created_at
+		`, link.Token, link.TenantID, link.JobID, link.UserID, link.FilePath, link.ExpiresAt).Scan(&link.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to create export download link: %w", err)
+		}
+		return nil
+	})
+}
+
+// GetByToken retrieves a download link by its token.
+// Uses RLS to ensure proper tenant isolation.
+func (r *ExportDownloadLinkRepository) GetByToken(ctx context.Context, token string) (*entity.ExportDownloadLink, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.ExportDownloadLink, error) {
+		link := &entity.ExportDownloadLink{}
+		err := tx.QueryRowContext(ctx, `
+			SELECT token, tenant_id, job_id, user_id, file_path, expires_at, created_at
+			FROM export_download_links
+			WHERE token = $1
+		`, token).Scan(&link.Token, &link.TenantID, &link.JobID, &link.UserID, &link.FilePath, &link.ExpiresAt, &link.CreatedAt)
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get export download link: %w", err)
+		}
+		return link, nil
+	})
+}
+
+// RecordAccess adds an entry to the export's access log.
+// Uses RLS to ensure proper tenant isolation.
+func (r *ExportDownloadLinkRepository) RecordAccess(ctx context.Context, access *entity.ExportAccess) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		err := tx.QueryRowContext(ctx, `
+			INSERT INTO export_access_log (tenant_id, job_id, token, ip_address, user_agent)
+			VALUES ($1, $2, $3, NULLIF($4, ''), NULLIF($5, ''))
+			RETURNING id, accessed_at
+		`, access.TenantID, access.JobID, access.Token, access.IPAddress, access.UserAgent).Scan(&access.ID, &access.AccessedAt)
+		if err != nil {
+			return fmt.Errorf("failed to record export access: %w", err)
+		}
+		return nil
+	})
+}
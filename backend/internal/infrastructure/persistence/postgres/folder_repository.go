@@ -24,8 +24,8 @@ func NewFolderRepository(db *sql.DB) repository.FolderRepository {
 func (r *FolderRepository) Create(ctx context.Context, folder *entity.Folder) error {
 	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
 		query := `
-			INSERT INTO folders (tenant_id, name, parent_id, type, team_id, user_id)
-			VALUES ($1, $2, $3, $4, $5, $6)
+			INSERT INTO folders (tenant_id, name, parent_id, type, team_id, user_id, is_sample)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)
 			RETURNING id, created_at, updated_at
 		`
 		return tx.QueryRowContext(ctx, query,
@@ -35,6 +35,7 @@ func (r *FolderRepository) Create(ctx context.Context, folder *entity.Folder) er
 			folder.Type.String(),
 			folder.TeamID,
 			folder.UserID,
+			folder.IsSample,
 		).Scan(&folder.ID, &folder.CreatedAt, &folder.UpdatedAt)
 	})
 }
@@ -43,7 +44,7 @@ func (r *FolderRepository) Create(ctx context.Context, folder *entity.Folder) er
 func (r *FolderRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Folder, error) {
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.Folder, error) {
 		query := `
-			SELECT id, tenant_id, name, parent_id, type, team_id, user_id, created_at, updated_at
+			SELECT id, tenant_id, name, parent_id, type, team_id, user_id, created_at, updated_at, is_sample
 			FROM folders
 			WHERE id = $1
 		`
@@ -59,6 +60,7 @@ func (r *FolderRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.F
 			&folder.UserID,
 			&folder.CreatedAt,
 			&folder.UpdatedAt,
+			&folder.IsSample,
 		)
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -75,7 +77,7 @@ func (r *FolderRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.F
 func (r *FolderRepository) GetByTeamID(ctx context.Context, teamID uuid.UUID) (*entity.Folder, error) {
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.Folder, error) {
 		query := `
-			SELECT id, tenant_id, name, parent_id, type, team_id, user_id, created_at, updated_at
+			SELECT id, tenant_id, name, parent_id, type, team_id, user_id, created_at, updated_at, is_sample
 			FROM folders
 			WHERE team_id = $1 AND type = 'TEAM'
 		`
@@ -91,6 +93,7 @@ func (r *FolderRepository) GetByTeamID(ctx context.Context, teamID uuid.UUID) (*
 			&folder.UserID,
 			&folder.CreatedAt,
 			&folder.UpdatedAt,
+			&folder.IsSample,
 		)
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -107,7 +110,7 @@ func (r *FolderRepository) GetByTeamID(ctx context.Context, teamID uuid.UUID) (*
 func (r *FolderRepository) GetByUserID(ctx context.Context, userID uuid.UUID) (*entity.Folder, error) {
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.Folder, error) {
 		query := `
-			SELECT id, tenant_id, name, parent_id, type, team_id, user_id, created_at, updated_at
+			SELECT id, tenant_id, name, parent_id, type, team_id, user_id, created_at, updated_at, is_sample
 			FROM folders
 			WHERE user_id = $1 AND type = 'PERSONAL'
 		`
@@ -123,6 +126,7 @@ func (r *FolderRepository) GetByUserID(ctx context.Context, userID uuid.UUID) (*
 			&folder.UserID,
 			&folder.CreatedAt,
 			&folder.UpdatedAt,
+			&folder.IsSample,
 		)
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -139,7 +143,7 @@ func (r *FolderRepository) GetByUserID(ctx context.Context, userID uuid.UUID) (*
 func (r *FolderRepository) GetSharedFolder(ctx context.Context, tenantID uuid.UUID) (*entity.Folder, error) {
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.Folder, error) {
 		query := `
-			SELECT id, tenant_id, name, parent_id, type, team_id, user_id, created_at, updated_at
+			SELECT id, tenant_id, name, parent_id, type, team_id, user_id, created_at, updated_at, is_sample
 			FROM folders
 			WHERE tenant_id = $1 AND type = 'LIBRARY' AND parent_id IS NULL
 			LIMIT 1
@@ -156,6 +160,7 @@ func (r *FolderRepository) GetSharedFolder(ctx context.Context, tenantID uuid.UU
 			&folder.UserID,
 			&folder.CreatedAt,
 			&folder.UpdatedAt,
+			&folder.IsSample,
 		)
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -216,14 +221,14 @@ func (r *FolderRepository) ListByParent(ctx context.Context, parentID *uuid.UUID
 
 		if parentID == nil {
 			query = `
-				SELECT id, tenant_id, name, parent_id, type, team_id, user_id, created_at, updated_at
+				SELECT id, tenant_id, name, parent_id, type, team_id, user_id, created_at, updated_at, is_sample
 				FROM folders
 				WHERE parent_id IS NULL
 				ORDER BY name ASC
 			`
 		} else {
 			query = `
-				SELECT id, tenant_id, name, parent_id, type, team_id, user_id, created_at, updated_at
+				SELECT id, tenant_id, name, parent_id, type, team_id, user_id, created_at, updated_at, is_sample
 				FROM folders
 				WHERE parent_id = $1
 				ORDER BY name ASC
@@ -251,6 +256,7 @@ func (r *FolderRepository) ListByParent(ctx context.Context, parentID *uuid.UUID
 				&folder.UserID,
 				&folder.CreatedAt,
 				&folder.UpdatedAt,
+				&folder.IsSample,
 			); err != nil {
 				return nil, fmt.Errorf("failed to scan folder: %w", err)
 			}
@@ -272,7 +278,7 @@ func (r *FolderRepository) GetHierarchy(ctx context.Context, userID uuid.UUID) (
 		// - FOLDER folders (regular folders)
 		// - PERSONAL folders that belong to this specific user
 		query := `
-			SELECT id, tenant_id, name, parent_id, type, team_id, user_id, created_at, updated_at
+			SELECT id, tenant_id, name, parent_id, type, team_id, user_id, created_at, updated_at, is_sample
 			FROM folders
 			WHERE type != 'PERSONAL' OR (type = 'PERSONAL' AND user_id = $1)
 			ORDER BY
@@ -304,6 +310,7 @@ func (r *FolderRepository) GetHierarchy(ctx context.Context, userID uuid.UUID) (
 				&folder.UserID,
 				&folder.CreatedAt,
 				&folder.UpdatedAt,
+				&folder.IsSample,
 			); err != nil {
 				return nil, fmt.Errorf("failed to scan folder: %w", err)
 			}
@@ -0,0 +1,76 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+)
+
+// FolderSyncSettingRepository implements repository.FolderSyncSettingRepository using PostgreSQL.
+type FolderSyncSettingRepository struct {
+	db *sql.DB
+}
+
+// NewFolderSyncSettingRepository creates a new PostgreSQL folder sync setting repository.
+func NewFolderSyncSettingRepository(db *sql.DB) repository.FolderSyncSettingRepository {
+	return &FolderSyncSettingRepository{db: db}
+}
+
+// Upsert sets the connector a folder syncs to.
+func (r *FolderSyncSettingRepository) Upsert(ctx context.Context, setting *entity.FolderSyncSetting) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		query := `
+			INSERT INTO folder_sync_settings (folder_id, tenant_id, connector_id)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (folder_id) DO UPDATE
+			SET connector_id = EXCLUDED.connector_id, updated_at = NOW()
+			RETURNING created_at, updated_at
+		`
+		return tx.QueryRowContext(ctx, query,
+			setting.FolderID,
+			setting.TenantID,
+			setting.ConnectorID,
+		).Scan(&setting.CreatedAt, &setting.UpdatedAt)
+	})
+}
+
+// GetByFolderID retrieves a folder's sync setting.
+func (r *FolderSyncSettingRepository) GetByFolderID(ctx context.Context, folderID uuid.UUID) (*entity.FolderSyncSetting, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.FolderSyncSetting, error) {
+		query := `
+			SELECT folder_id, tenant_id, connector_id, created_at, updated_at
+			FROM folder_sync_settings
+			WHERE folder_id = $1
+		`
+		setting := &entity.FolderSyncSetting{}
+		err := tx.QueryRowContext(ctx, query, folderID).Scan(
+			&setting.FolderID,
+			&setting.TenantID,
+			&setting.ConnectorID,
+			&setting.CreatedAt,
+			&setting.UpdatedAt,
+		)
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get folder sync setting: %w", err)
+		}
+		return setting, nil
+	})
+}
+
+// Delete stops a folder from syncing.
+func (r *FolderSyncSettingRepository) Delete(ctx context.Context, folderID uuid.UUID) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `DELETE FROM folder_sync_settings WHERE folder_id = $1`, folderID)
+		if err != nil {
+			return fmt.Errorf("failed to delete folder sync setting: %w", err)
+		}
+		return nil
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/lib/pq"
@@ -27,8 +28,8 @@ func NewGeneratedLessonRepository(db *sql.DB) repository.GeneratedLessonReposito
 func (r *GeneratedLessonRepository) Create(ctx context.Context, lesson *entity.GeneratedLesson) error {
 	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
 		query := `
-			INSERT INTO generated_lessons (tenant_id, course_id, section_id, outline_lesson_id, title, segue_text)
-			VALUES ($1, $2, $3, $4, $5, $6)
+			INSERT INTO generated_lessons (tenant_id, course_id, section_id, outline_lesson_id, title, segue_text, generated_component_count)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)
 			RETURNING id, generated_at
 		`
 		return tx.QueryRowContext(ctx, query,
@@ -38,6 +39,7 @@ func (r *GeneratedLessonRepository) Create(ctx context.Context, lesson *entity.G
 			lesson.OutlineLessonID,
 			lesson.Title,
 			lesson.SegueText,
+			lesson.ComponentCount,
 		).Scan(&lesson.ID, &lesson.GeneratedAt)
 	})
 }
@@ -46,7 +48,7 @@ func (r *GeneratedLessonRepository) Create(ctx context.Context, lesson *entity.G
 func (r *GeneratedLessonRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.GeneratedLesson, error) {
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.GeneratedLesson, error) {
 		query := `
-			SELECT id, tenant_id, course_id, section_id, outline_lesson_id, title, segue_text, generated_at
+			SELECT id, tenant_id, course_id, section_id, outline_lesson_id, title, segue_text, generated_at, orphaned_at, generated_component_count
 			FROM generated_lessons
 			WHERE id = $1
 		`
@@ -60,6 +62,8 @@ func (r *GeneratedLessonRepository) GetByID(ctx context.Context, id uuid.UUID) (
 			&lesson.Title,
 			&lesson.SegueText,
 			&lesson.GeneratedAt,
+			&lesson.OrphanedAt,
+			&lesson.ComponentCount,
 		)
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -75,7 +79,7 @@ func (r *GeneratedLessonRepository) GetByID(ctx context.Context, id uuid.UUID) (
 func (r *GeneratedLessonRepository) GetByOutlineLessonID(ctx context.Context, outlineLessonID uuid.UUID) (*entity.GeneratedLesson, error) {
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.GeneratedLesson, error) {
 		query := `
-			SELECT id, tenant_id, course_id, section_id, outline_lesson_id, title, segue_text, generated_at
+			SELECT id, tenant_id, course_id, section_id, outline_lesson_id, title, segue_text, generated_at, orphaned_at, generated_component_count
 			FROM generated_lessons
 			WHERE outline_lesson_id = $1
 		`
@@ -89,6 +93,8 @@ func (r *GeneratedLessonRepository) GetByOutlineLessonID(ctx context.Context, ou
 			&lesson.Title,
 			&lesson.SegueText,
 			&lesson.GeneratedAt,
+			&lesson.OrphanedAt,
+			&lesson.ComponentCount,
 		)
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -100,16 +106,16 @@ func (r *GeneratedLessonRepository) GetByOutlineLessonID(ctx context.Context, ou
 	})
 }
 
-// ListByCourseID retrieves all lessons for a course.
-func (r *GeneratedLessonRepository) ListByCourseID(ctx context.Context, courseID uuid.UUID) ([]*entity.GeneratedLesson, error) {
+// ListByCourseID retrieves the lessons for a course, optionally including orphaned ones.
+func (r *GeneratedLessonRepository) ListByCourseID(ctx context.Context, courseID uuid.UUID, includeOrphaned bool) ([]*entity.GeneratedLesson, error) {
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.GeneratedLesson, error) {
 		query := `
-			SELECT id, tenant_id, course_id, section_id, outline_lesson_id, title, segue_text, generated_at
+			SELECT id, tenant_id, course_id, section_id, outline_lesson_id, title, segue_text, generated_at, orphaned_at, generated_component_count
 			FROM generated_lessons
-			WHERE course_id = $1
+			WHERE course_id = $1 AND ($2 OR orphaned_at IS NULL)
 			ORDER BY generated_at ASC
 		`
-		rows, err := tx.QueryContext(ctx, query, courseID)
+		rows, err := tx.QueryContext(ctx, query, courseID, includeOrphaned)
 		if err != nil {
 			return nil, fmt.Errorf("failed to list lessons: %w", err)
 		}
@@ -127,6 +133,8 @@ func (r *GeneratedLessonRepository) ListByCourseID(ctx context.Context, courseID
 				&lesson.Title,
 				&lesson.SegueText,
 				&lesson.GeneratedAt,
+				&lesson.OrphanedAt,
+				&lesson.ComponentCount,
 			); err != nil {
 				return nil, fmt.Errorf("failed to scan lesson: %w", err)
 			}
@@ -153,6 +161,83 @@ func (r *GeneratedLessonRepository) Update(ctx context.Context, lesson *entity.G
 	})
 }
 
+// SyncOrphansWithOutline moves lessons generated for an earlier version of one of the
+// outline's lessons (same lesson key) onto it, then marks lessons not backed by the
+// outline's lessons as orphaned and restores those that are, in a single transaction.
+// Returns the number newly orphaned.
+func (r *GeneratedLessonRepository) SyncOrphansWithOutline(ctx context.Context, courseID, outlineID uuid.UUID) (int, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (int, error) {
+		if _, err := tx.ExecContext(ctx, `
+			UPDATE generated_lessons gl
+			SET outline_lesson_id = ol.id, section_id = ol.section_id
+			FROM outline_lessons ol
+			JOIN outline_sections os ON os.id = ol.section_id
+			JOIN outline_lessons prev ON prev.lesson_key = ol.lesson_key
+			WHERE gl.course_id = $1 AND os.outline_id = $2
+			  AND gl.outline_lesson_id = prev.id AND prev.id <> ol.id
+		`, courseID, outlineID); err != nil {
+			return 0, fmt.Errorf("failed to carry lessons forward: %w", err)
+		}
+
+		result, err := tx.ExecContext(ctx, `
+			UPDATE generated_lessons
+			SET orphaned_at = NOW()
+			WHERE course_id = $1 AND orphaned_at IS NULL
+			  AND outline_lesson_id NOT IN (
+				SELECT ol.id
+				FROM outline_lessons ol
+				JOIN outline_sections os ON os.id = ol.section_id
+				WHERE os.outline_id = $2
+			  )
+		`, courseID, outlineID)
+		if err != nil {
+			return 0, fmt.Errorf("failed to orphan lessons: %w", err)
+		}
+		orphaned, err := result.RowsAffected()
+		if err != nil {
+			return 0, fmt.Errorf("failed to count orphaned lessons: %w", err)
+		}
+
+		// Lessons of a re-approved outline come back
+		if _, err := tx.ExecContext(ctx, `
+			UPDATE generated_lessons
+			SET orphaned_at = NULL
+			WHERE course_id = $1 AND orphaned_at IS NOT NULL
+			  AND outline_lesson_id IN (
+				SELECT ol.id
+				FROM outline_lessons ol
+				JOIN outline_sections os ON os.id = ol.section_id
+				WHERE os.outline_id = $2
+			  )
+		`, courseID, outlineID); err != nil {
+			return 0, fmt.Errorf("failed to restore lessons: %w", err)
+		}
+
+		return int(orphaned), nil
+	})
+}
+
+// OrphanByOutlineLessonIDs marks the lessons generated for the given outline lessons as
+// orphaned, so the outline lessons can be removed without deleting them. Returns the
+// number newly orphaned.
+func (r *GeneratedLessonRepository) OrphanByOutlineLessonIDs(ctx context.Context, outlineLessonIDs []uuid.UUID) (int, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (int, error) {
+		result, err := tx.ExecContext(ctx, `
+			UPDATE generated_lessons
+			SET orphaned_at = NOW()
+			WHERE outline_lesson_id = ANY($1) AND orphaned_at IS NULL
+		`, pq.Array(outlineLessonIDs))
+		if err != nil {
+			return 0, fmt.Errorf("failed to orphan lessons: %w", err)
+		}
+		orphaned, err := result.RowsAffected()
+		if err != nil {
+			return 0, fmt.Errorf("failed to count orphaned lessons: %w", err)
+		}
+		return int(orphaned), nil
+	})
+}
+
 // LessonComponentRepository implements repository.LessonComponentRepository using PostgreSQL.
 type LessonComponentRepository struct {
 	db *sql.DB
@@ -163,23 +248,50 @@ func NewLessonComponentRepository(db *sql.DB) repository.LessonComponentReposito
 	return &LessonComponentRepository{db: db}
 }
 
-// Create creates a new component.
+// Create creates a new component at component.Position, shifting the lesson's components
+// at and after that position down by one. A position of zero or past the end appends the
+// component. component.Position is set to the position it was given.
 func (r *LessonComponentRepository) Create(ctx context.Context, component *entity.LessonComponent) error {
 	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		if err := lockLessonComponents(ctx, tx, component.LessonID); err != nil {
+			return err
+		}
+		count, err := countLessonComponents(ctx, tx, component.LessonID)
+		if err != nil {
+			return err
+		}
+
+		position := component.Position
+		if position <= 0 || position > count+1 {
+			position = count + 1
+		}
+		if position <= count {
+			if _, err := tx.ExecContext(ctx, `
+				UPDATE lesson_components SET position = position + 1
+				WHERE lesson_id = $1 AND position >= $2
+			`, component.LessonID, position); err != nil {
+				return fmt.Errorf("failed to shift components: %w", err)
+			}
+		}
+
 		query := `
 			INSERT INTO lesson_components (tenant_id, lesson_id, type, position, content_json, sme_chunk_ids, learning_objective_ids)
 			VALUES ($1, $2, $3, $4, $5, $6, $7)
 			RETURNING id, created_at, updated_at
 		`
-		return tx.QueryRowContext(ctx, query,
+		if err := tx.QueryRowContext(ctx, query,
 			component.TenantID,
 			component.LessonID,
 			component.Type.String(),
-			component.Position,
+			position,
 			component.ContentJSON,
 			pq.Array(component.SMEChunkIDs),
 			pq.Array(component.LearningObjectiveIDs),
-		).Scan(&component.ID, &component.CreatedAt, &component.UpdatedAt)
+		).Scan(&component.ID, &component.CreatedAt, &component.UpdatedAt); err != nil {
+			return fmt.Errorf("failed to create component: %w", err)
+		}
+		component.Position = position
+		return nil
 	})
 }
 
@@ -222,28 +334,58 @@ func (r *LessonComponentRepository) GetByID(ctx context.Context, id uuid.UUID) (
 	})
 }
 
-// ListByLessonID retrieves all components for a lesson.
+// ListByLessonID retrieves all components for a lesson, ordered by position with id as the
+// tiebreaker. If the positions aren't 1..n, they are repaired in that order before returning.
 func (r *LessonComponentRepository) ListByLessonID(ctx context.Context, lessonID uuid.UUID) ([]*entity.LessonComponent, error) {
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.LessonComponent, error) {
+		components, err := listLessonComponents(ctx, tx, lessonID)
+		if err != nil {
+			return nil, err
+		}
+		if lessonPositionsValid(components) {
+			return components, nil
+		}
+
+		if _, err := repairLessonPositions(ctx, tx, lessonID); err != nil {
+			return nil, err
+		}
+		// Read again: components may have changed while waiting for the lock
+		components, err = listLessonComponents(ctx, tx, lessonID)
+		if err != nil {
+			return nil, err
+		}
+		return components, nil
+	})
+}
+
+// SearchByCourse finds the course's components whose content contains phrase.
+// Uses the full-text index over the string values of content_json.
+func (r *LessonComponentRepository) SearchByCourse(ctx context.Context, courseID uuid.UUID, phrase string, limit int) ([]*entity.LessonComponentMatch, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.LessonComponentMatch, error) {
 		query := `
-			SELECT id, tenant_id, lesson_id, type, position, content_json, sme_chunk_ids, learning_objective_ids, created_at, updated_at
-			FROM lesson_components
-			WHERE lesson_id = $1
-			ORDER BY position ASC
+			SELECT lc.id, lc.tenant_id, lc.lesson_id, lc.type, lc.position, lc.content_json, lc.created_at, lc.updated_at,
+				gl.section_id, os.title, gl.title
+			FROM lesson_components lc
+			JOIN generated_lessons gl ON gl.id = lc.lesson_id
+			JOIN outline_sections os ON os.id = gl.section_id
+			JOIN outline_lessons ol ON ol.id = gl.outline_lesson_id
+			WHERE gl.course_id = $1 AND gl.orphaned_at IS NULL
+				AND jsonb_to_tsvector('simple', lc.content_json, '["string"]') @@ phraseto_tsquery('simple', $2)
+			ORDER BY os.position ASC, ol.position ASC, lc.position ASC, lc.id ASC
+			LIMIT $3
 		`
-		rows, err := tx.QueryContext(ctx, query, lessonID)
+		rows, err := tx.QueryContext(ctx, query, courseID, phrase, limit)
 		if err != nil {
-			return nil, fmt.Errorf("failed to list components: %w", err)
+			return nil, fmt.Errorf("failed to search components: %w", err)
 		}
 		defer rows.Close()
 
-		var components []*entity.LessonComponent
+		var matches []*entity.LessonComponentMatch
 		for rows.Next() {
 			component := &entity.LessonComponent{}
+			match := &entity.LessonComponentMatch{Component: component}
 			var typeStr string
 			var contentJSON []byte
-			var chunkIDs pq.StringArray
-			var objectiveIDs pq.StringArray
 			if err := rows.Scan(
 				&component.ID,
 				&component.TenantID,
@@ -251,52 +393,272 @@ func (r *LessonComponentRepository) ListByLessonID(ctx context.Context, lessonID
 				&typeStr,
 				&component.Position,
 				&contentJSON,
-				&chunkIDs,
-				&objectiveIDs,
 				&component.CreatedAt,
 				&component.UpdatedAt,
+				&match.SectionID,
+				&match.SectionTitle,
+				&match.LessonTitle,
 			); err != nil {
-				return nil, fmt.Errorf("failed to scan component: %w", err)
+				return nil, fmt.Errorf("failed to scan component match: %w", err)
 			}
 			component.Type, _ = valueobject.ParseLessonComponentType(typeStr)
 			component.ContentJSON = json.RawMessage(contentJSON)
-			component.SMEChunkIDs = parseUUIDs(chunkIDs)
-			component.LearningObjectiveIDs = []string(objectiveIDs)
-			components = append(components, component)
+			matches = append(matches, match)
 		}
-		return components, nil
+		return matches, rows.Err()
 	})
 }
 
-// Update updates a component.
+// Update updates a component. If component.Position differs from the stored position, the
+// component moves there and the components in between shift to make room. Positions are
+// clamped to the lesson's components, with zero meaning the end. component.Position is set
+// to the position it ends up at.
 func (r *LessonComponentRepository) Update(ctx context.Context, component *entity.LessonComponent) error {
 	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		if err := lockLessonComponents(ctx, tx, component.LessonID); err != nil {
+			return err
+		}
+		var current int32
+		err := tx.QueryRowContext(ctx, `
+			SELECT position FROM lesson_components WHERE id = $1 AND lesson_id = $2
+		`, component.ID, component.LessonID).Scan(&current)
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("component %s not found in lesson %s", component.ID, component.LessonID)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get component position: %w", err)
+		}
+		count, err := countLessonComponents(ctx, tx, component.LessonID)
+		if err != nil {
+			return err
+		}
+
+		position := component.Position
+		if position <= 0 || position > count {
+			position = count
+		}
+		if position != current {
+			// Move the component and shift the ones between its old and new positions by one
+			if _, err := tx.ExecContext(ctx, `
+				UPDATE lesson_components
+				SET position = CASE
+					WHEN id = $1 THEN $3
+					WHEN $3 < $2 THEN position + 1
+					ELSE position - 1
+				END
+				WHERE lesson_id = $4 AND position BETWEEN LEAST($2, $3) AND GREATEST($2, $3)
+			`, component.ID, current, position, component.LessonID); err != nil {
+				return fmt.Errorf("failed to move component: %w", err)
+			}
+		}
+
 		query := `
 			UPDATE lesson_components
-			SET type = $1, position = $2, content_json = $3, sme_chunk_ids = $4, learning_objective_ids = $5, updated_at = NOW()
-			WHERE id = $6
+			SET type = $1, content_json = $2, sme_chunk_ids = $3, learning_objective_ids = $4, updated_at = NOW()
+			WHERE id = $5
 			RETURNING updated_at
 		`
-		return tx.QueryRowContext(ctx, query,
+		if err := tx.QueryRowContext(ctx, query,
 			component.Type.String(),
-			component.Position,
 			component.ContentJSON,
 			pq.Array(component.SMEChunkIDs),
 			pq.Array(component.LearningObjectiveIDs),
 			component.ID,
-		).Scan(&component.UpdatedAt)
+		).Scan(&component.UpdatedAt); err != nil {
+			return fmt.Errorf("failed to update component: %w", err)
+		}
+		component.Position = position
+		return nil
+	})
+}
+
+// ListQuizzesBelowSchemaVersion returns quiz components whose content_json has no numeric
+// schema_version or one below version, in id order after afterID.
+func (r *LessonComponentRepository) ListQuizzesBelowSchemaVersion(ctx context.Context, version int, afterID uuid.UUID, limit int) ([]*entity.LessonComponent, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.LessonComponent, error) {
+		query := `
+			SELECT id, tenant_id, lesson_id, type, position, content_json, sme_chunk_ids, learning_objective_ids, created_at, updated_at
+			FROM lesson_components
+			WHERE type = 'quiz'
+			  AND id > $2
+			  AND (jsonb_typeof(content_json->'schema_version') IS DISTINCT FROM 'number'
+			       OR (content_json->>'schema_version')::numeric < $1)
+			ORDER BY id ASC
+			LIMIT $3
+		`
+		rows, err := tx.QueryContext(ctx, query, version, afterID, limit)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list quiz components: %w", err)
+		}
+		return scanLessonComponents(rows)
+	})
+}
+
+// ReplaceContent sets a component's content_json unless its updated_at moved past updatedAt.
+func (r *LessonComponentRepository) ReplaceContent(ctx context.Context, id uuid.UUID, contentJSON []byte, updatedAt time.Time) (bool, error) {
+	var replaced bool
+	err := RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		result, err := tx.ExecContext(ctx, `
+			UPDATE lesson_components
+			SET content_json = $2, updated_at = NOW()
+			WHERE id = $1 AND updated_at <= $3
+		`, id, contentJSON, updatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to replace component content: %w", err)
+		}
+		n, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to replace component content: %w", err)
+		}
+		replaced = n > 0
+		return nil
 	})
+	return replaced, err
 }
 
-// Delete deletes a component.
+// Delete deletes a component and shifts the components after it up by one.
 func (r *LessonComponentRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
-		query := `DELETE FROM lesson_components WHERE id = $1`
-		_, err := tx.ExecContext(ctx, query, id)
-		return err
+		var lessonID uuid.UUID
+		err := tx.QueryRowContext(ctx, `SELECT lesson_id FROM lesson_components WHERE id = $1`, id).Scan(&lessonID)
+		if err == sql.ErrNoRows {
+			return nil // Already gone
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get component: %w", err)
+		}
+		if err := lockLessonComponents(ctx, tx, lessonID); err != nil {
+			return err
+		}
+
+		var position int32
+		err = tx.QueryRowContext(ctx, `DELETE FROM lesson_components WHERE id = $1 RETURNING position`, id).Scan(&position)
+		if err == sql.ErrNoRows {
+			return nil // Deleted while waiting for the lock
+		}
+		if err != nil {
+			return fmt.Errorf("failed to delete component: %w", err)
+		}
+		if _, err := tx.ExecContext(ctx, `
+			UPDATE lesson_components SET position = position - 1
+			WHERE lesson_id = $1 AND position > $2
+		`, lessonID, position); err != nil {
+			return fmt.Errorf("failed to shift components: %w", err)
+		}
+		return nil
 	})
 }
 
+// RepairPositions renumbers a lesson's components 1..n, keeping their order by position
+// with id as the tiebreaker. Returns the number of components whose position changed.
+func (r *LessonComponentRepository) RepairPositions(ctx context.Context, lessonID uuid.UUID) (int, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (int, error) {
+		return repairLessonPositions(ctx, tx, lessonID)
+	})
+}
+
+// lockLessonComponents takes the advisory lock that serializes position changes within a
+// lesson's components. It is released when tx ends.
+func lockLessonComponents(ctx context.Context, tx *sql.Tx, lessonID uuid.UUID) error {
+	if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtextextended('lesson_components:' || $1::text, 0))`, lessonID); err != nil {
+		return fmt.Errorf("failed to lock lesson components: %w", err)
+	}
+	return nil
+}
+
+// countLessonComponents returns the number of components in a lesson.
+func countLessonComponents(ctx context.Context, tx *sql.Tx, lessonID uuid.UUID) (int32, error) {
+	var count int32
+	if err := tx.QueryRowContext(ctx, `SELECT COUNT(*) FROM lesson_components WHERE lesson_id = $1`, lessonID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count components: %w", err)
+	}
+	return count, nil
+}
+
+// repairLessonPositions renumbers a lesson's components 1..n under the lesson lock.
+func repairLessonPositions(ctx context.Context, tx *sql.Tx, lessonID uuid.UUID) (int, error) {
+	if err := lockLessonComponents(ctx, tx, lessonID); err != nil {
+		return 0, err
+	}
+	result, err := tx.ExecContext(ctx, `
+		UPDATE lesson_components lc
+		SET position = ranked.position
+		FROM (
+			SELECT id, ROW_NUMBER() OVER (ORDER BY position, id) AS position
+			FROM lesson_components
+			WHERE lesson_id = $1
+		) ranked
+		WHERE lc.id = ranked.id AND lc.position <> ranked.position
+	`, lessonID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to repair component positions: %w", err)
+	}
+	repaired, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to repair component positions: %w", err)
+	}
+	return int(repaired), nil
+}
+
+// lessonPositionsValid reports whether components, in list order, are at positions 1..n.
+func lessonPositionsValid(components []*entity.LessonComponent) bool {
+	for i, component := range components {
+		if component.Position != int32(i+1) {
+			return false
+		}
+	}
+	return true
+}
+
+// listLessonComponents reads a lesson's components ordered by position, then id.
+func listLessonComponents(ctx context.Context, tx *sql.Tx, lessonID uuid.UUID) ([]*entity.LessonComponent, error) {
+	query := `
+		SELECT id, tenant_id, lesson_id, type, position, content_json, sme_chunk_ids, learning_objective_ids, created_at, updated_at
+		FROM lesson_components
+		WHERE lesson_id = $1
+		ORDER BY position ASC, id ASC
+	`
+	rows, err := tx.QueryContext(ctx, query, lessonID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list components: %w", err)
+	}
+	return scanLessonComponents(rows)
+}
+
+// scanLessonComponents reads component rows selected in the column order of listLessonComponents.
+func scanLessonComponents(rows *sql.Rows) ([]*entity.LessonComponent, error) {
+	defer rows.Close()
+
+	var components []*entity.LessonComponent
+	for rows.Next() {
+		component := &entity.LessonComponent{}
+		var typeStr string
+		var contentJSON []byte
+		var chunkIDs pq.StringArray
+		var objectiveIDs pq.StringArray
+		if err := rows.Scan(
+			&component.ID,
+			&component.TenantID,
+			&component.LessonID,
+			&typeStr,
+			&component.Position,
+			&contentJSON,
+			&chunkIDs,
+			&objectiveIDs,
+			&component.CreatedAt,
+			&component.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan component: %w", err)
+		}
+		component.Type, _ = valueobject.ParseLessonComponentType(typeStr)
+		component.ContentJSON = json.RawMessage(contentJSON)
+		component.SMEChunkIDs = parseUUIDs(chunkIDs)
+		component.LearningObjectiveIDs = []string(objectiveIDs)
+		components = append(components, component)
+	}
+	return components, rows.Err()
+}
+
 // CourseGenerationInputRepository implements repository.CourseGenerationInputRepository using PostgreSQL.
 type CourseGenerationInputRepository struct {
 	db *sql.DB
@@ -311,17 +673,30 @@ func NewCourseGenerationInputRepository(db *sql.DB) repository.CourseGenerationI
 func (r *CourseGenerationInputRepository) Create(ctx context.Context, input *entity.CourseGenerationInput) error {
 	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
 		query := `
-			INSERT INTO course_generation_inputs (tenant_id, course_id, sme_ids, target_audience_ids, desired_outcome, additional_context)
-			VALUES ($1, $2, $3, $4, $5, $6)
+			INSERT INTO course_generation_inputs (tenant_id, course_id, course_title, sme_ids, target_audience_ids, desired_outcome, additional_context, max_sections, max_lessons_per_section, target_duration_minutes,
+			                                      enable_quizzes, quiz_frequency, include_images, include_reflection_prompts, generate_images,
+			                                      min_lesson_components, max_lesson_components)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
 			RETURNING id, created_at, updated_at
 		`
 		return tx.QueryRowContext(ctx, query,
 			input.TenantID,
 			input.CourseID,
+			input.CourseTitle,
 			pq.Array(input.SMEIDs),
 			pq.Array(input.TargetAudienceIDs),
 			input.DesiredOutcome,
 			input.AdditionalContext,
+			input.Constraints.MaxSections,
+			input.Constraints.MaxLessonsPerSection,
+			input.Constraints.TargetDurationMinutes,
+			input.Preferences.EnableQuizzes,
+			input.Preferences.QuizFrequency.String(),
+			input.Preferences.IncludeImages,
+			input.Preferences.IncludeReflectionPrompts,
+			input.Preferences.GenerateImages,
+			input.Preferences.MinComponents,
+			input.Preferences.MaxComponents,
 		).Scan(&input.ID, &input.CreatedAt, &input.UpdatedAt)
 	})
 }
@@ -330,21 +705,36 @@ func (r *CourseGenerationInputRepository) Create(ctx context.Context, input *ent
 func (r *CourseGenerationInputRepository) GetByCourseID(ctx context.Context, courseID uuid.UUID) (*entity.CourseGenerationInput, error) {
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.CourseGenerationInput, error) {
 		query := `
-			SELECT id, tenant_id, course_id, sme_ids, target_audience_ids, desired_outcome, additional_context, created_at, updated_at
+			SELECT id, tenant_id, course_id, course_title, sme_ids, target_audience_ids, desired_outcome, additional_context,
+			       max_sections, max_lessons_per_section, target_duration_minutes,
+			       enable_quizzes, quiz_frequency, include_images, include_reflection_prompts, generate_images,
+			       min_lesson_components, max_lesson_components, created_at, updated_at
 			FROM course_generation_inputs
 			WHERE course_id = $1
 		`
 		input := &entity.CourseGenerationInput{}
 		var smeIDs pq.StringArray
 		var audienceIDs pq.StringArray
+		var quizFrequencyStr string
 		err := tx.QueryRowContext(ctx, query, courseID).Scan(
 			&input.ID,
 			&input.TenantID,
 			&input.CourseID,
+			&input.CourseTitle,
 			&smeIDs,
 			&audienceIDs,
 			&input.DesiredOutcome,
 			&input.AdditionalContext,
+			&input.Constraints.MaxSections,
+			&input.Constraints.MaxLessonsPerSection,
+			&input.Constraints.TargetDurationMinutes,
+			&input.Preferences.EnableQuizzes,
+			&quizFrequencyStr,
+			&input.Preferences.IncludeImages,
+			&input.Preferences.IncludeReflectionPrompts,
+			&input.Preferences.GenerateImages,
+			&input.Preferences.MinComponents,
+			&input.Preferences.MaxComponents,
 			&input.CreatedAt,
 			&input.UpdatedAt,
 		)
@@ -356,6 +746,7 @@ func (r *CourseGenerationInputRepository) GetByCourseID(ctx context.Context, cou
 		}
 		input.SMEIDs = parseUUIDs(smeIDs)
 		input.TargetAudienceIDs = parseUUIDs(audienceIDs)
+		input.Preferences.QuizFrequency, _ = valueobject.ParseQuizFrequency(quizFrequencyStr)
 		return input, nil
 	})
 }
@@ -365,15 +756,29 @@ func (r *CourseGenerationInputRepository) Update(ctx context.Context, input *ent
 	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
 		query := `
 			UPDATE course_generation_inputs
-			SET sme_ids = $1, target_audience_ids = $2, desired_outcome = $3, additional_context = $4, updated_at = NOW()
-			WHERE id = $5
+			SET course_title = $1, sme_ids = $2, target_audience_ids = $3, desired_outcome = $4, additional_context = $5,
+			    max_sections = $6, max_lessons_per_section = $7, target_duration_minutes = $8,
+			    enable_quizzes = $9, quiz_frequency = $10, include_images = $11, include_reflection_prompts = $12, generate_images = $13,
+			    min_lesson_components = $14, max_lesson_components = $15, updated_at = NOW()
+			WHERE id = $16
 			RETURNING updated_at
 		`
 		return tx.QueryRowContext(ctx, query,
+			input.CourseTitle,
 			pq.Array(input.SMEIDs),
 			pq.Array(input.TargetAudienceIDs),
 			input.DesiredOutcome,
 			input.AdditionalContext,
+			input.Constraints.MaxSections,
+			input.Constraints.MaxLessonsPerSection,
+			input.Constraints.TargetDurationMinutes,
+			input.Preferences.EnableQuizzes,
+			input.Preferences.QuizFrequency.String(),
+			input.Preferences.IncludeImages,
+			input.Preferences.IncludeReflectionPrompts,
+			input.Preferences.GenerateImages,
+			input.Preferences.MinComponents,
+			input.Preferences.MaxComponents,
 			input.ID,
 		).Scan(&input.UpdatedAt)
 	})
@@ -0,0 +1,112 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+)
+
+// GenerationDraftRepository implements repository.GenerationDraftRepository using PostgreSQL.
+type GenerationDraftRepository struct {
+	db *sql.DB
+}
+
+// NewGenerationDraftRepository creates a new PostgreSQL generation draft repository.
+func NewGenerationDraftRepository(db *sql.DB) repository.GenerationDraftRepository {
+	return &GenerationDraftRepository{db: db}
+}
+
+// Upsert creates or replaces the user's draft for a course.
+func (r *GenerationDraftRepository) Upsert(ctx context.Context, draft *entity.GenerationDraft) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		query := `
+			INSERT INTO generation_drafts (tenant_id, user_id, course_id, sme_ids, target_audience_ids, desired_outcome, additional_context,
+			                               max_sections, max_lessons_per_section, target_duration_minutes)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+			ON CONFLICT (user_id, course_id) DO UPDATE
+			SET sme_ids = EXCLUDED.sme_ids, target_audience_ids = EXCLUDED.target_audience_ids,
+			    desired_outcome = EXCLUDED.desired_outcome, additional_context = EXCLUDED.additional_context,
+			    max_sections = EXCLUDED.max_sections, max_lessons_per_section = EXCLUDED.max_lessons_per_section,
+			    target_duration_minutes = EXCLUDED.target_duration_minutes, updated_at = NOW()
+			RETURNING updated_at
+		`
+		return tx.QueryRowContext(ctx, query,
+			draft.TenantID,
+			draft.UserID,
+			draft.CourseID,
+			pq.Array(draft.SMEIDs),
+			pq.Array(draft.TargetAudienceIDs),
+			draft.DesiredOutcome,
+			draft.AdditionalContext,
+			draft.Constraints.MaxSections,
+			draft.Constraints.MaxLessonsPerSection,
+			draft.Constraints.TargetDurationMinutes,
+		).Scan(&draft.UpdatedAt)
+	})
+}
+
+// Get retrieves the user's draft for a course, or nil if there is none.
+func (r *GenerationDraftRepository) Get(ctx context.Context, userID, courseID uuid.UUID) (*entity.GenerationDraft, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.GenerationDraft, error) {
+		query := `
+			SELECT tenant_id, user_id, course_id, sme_ids, target_audience_ids, desired_outcome, additional_context,
+			       max_sections, max_lessons_per_section, target_duration_minutes, updated_at
+			FROM generation_drafts
+			WHERE user_id = $1 AND course_id = $2
+		`
+		draft := &entity.GenerationDraft{}
+		var smeIDs pq.StringArray
+		var audienceIDs pq.StringArray
+		err := tx.QueryRowContext(ctx, query, userID, courseID).Scan(
+			&draft.TenantID,
+			&draft.UserID,
+			&draft.CourseID,
+			&smeIDs,
+			&audienceIDs,
+			&draft.DesiredOutcome,
+			&draft.AdditionalContext,
+			&draft.Constraints.MaxSections,
+			&draft.Constraints.MaxLessonsPerSection,
+			&draft.Constraints.TargetDurationMinutes,
+			&draft.UpdatedAt,
+		)
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get generation draft: %w", err)
+		}
+		draft.SMEIDs = parseUUIDs(smeIDs)
+		draft.TargetAudienceIDs = parseUUIDs(audienceIDs)
+		return draft, nil
+	})
+}
+
+// Delete deletes the user's draft for a course.
+func (r *GenerationDraftRepository) Delete(ctx context.Context, userID, courseID uuid.UUID) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		query := `DELETE FROM generation_drafts WHERE user_id = $1 AND course_id = $2`
+		if _, err := tx.ExecContext(ctx, query, userID, courseID); err != nil {
+			return fmt.Errorf("failed to delete generation draft: %w", err)
+		}
+		return nil
+	})
+}
+
+// DeleteOlderThan deletes drafts last saved before cutoff and returns how many were deleted.
+func (r *GenerationDraftRepository) DeleteOlderThan(ctx context.Context, cutoff time.Time) (int64, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (int64, error) {
+		query := `DELETE FROM generation_drafts WHERE updated_at < $1`
+		result, err := tx.ExecContext(ctx, query, cutoff)
+		if err != nil {
+			return 0, fmt.Errorf("failed to delete stale generation drafts: %w", err)
+		}
+		return result.RowsAffected()
+	})
+}
@@ -4,8 +4,11 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/sogos/mirai-backend/internal/domain/entity"
 	"github.com/sogos/mirai-backend/internal/domain/repository"
 	"github.com/sogos/mirai-backend/internal/domain/valueobject"
@@ -34,11 +37,11 @@ func NewGenerationJobRepository(db *sql.DB, staleJobTimeoutMinutes int) reposito
 func (r *GenerationJobRepository) Create(ctx context.Context, job *entity.GenerationJob) error {
 	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
 		query := `
-			INSERT INTO generation_jobs (tenant_id, type, status, course_id, lesson_id, outline_lesson_id, sme_task_id, submission_id, parent_job_id, progress_percent, progress_message, result_path, error_message, tokens_used, retry_count, max_retries, created_by_user_id)
-			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
+			INSERT INTO generation_jobs (tenant_id, type, status, course_id, lesson_id, outline_lesson_id, sme_task_id, submission_id, sme_id, source_path, parent_job_id, progress_percent, progress_message, result_path, error_message, tokens_used, retry_count, max_retries, created_by_user_id, include_citations, purge_orphans)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21)
 			RETURNING id, created_at
 		`
-		return tx.QueryRowContext(ctx, query,
+		err := tx.QueryRowContext(ctx, query,
 			job.TenantID,
 			job.Type.String(),
 			job.Status.String(),
@@ -47,6 +50,8 @@ func (r *GenerationJobRepository) Create(ctx context.Context, job *entity.Genera
 			job.OutlineLessonID,
 			job.SMETaskID,
 			job.SubmissionID,
+			job.SMEID,
+			job.SourcePath,
 			job.ParentJobID,
 			job.ProgressPercent,
 			job.ProgressMessage,
@@ -56,7 +61,16 @@ func (r *GenerationJobRepository) Create(ctx context.Context, job *entity.Genera
 			job.RetryCount,
 			job.MaxRetries,
 			job.CreatedByUserID,
+			job.IncludeCitations,
+			job.PurgeOrphans,
 		).Scan(&job.ID, &job.CreatedAt)
+		if err != nil {
+			return err
+		}
+		if holdsCourseLock(job) && !job.Status.IsTerminal() {
+			return setCourseGenerationLock(ctx, tx, *job.CourseID, job.ID)
+		}
+		return nil
 	})
 }
 
@@ -70,8 +84,8 @@ func (r *GenerationJobRepository) CreateBatch(ctx context.Context, jobs []*entit
 
 	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
 		query := `
-			INSERT INTO generation_jobs (id, tenant_id, type, status, course_id, lesson_id, outline_lesson_id, sme_task_id, submission_id, parent_job_id, progress_percent, progress_message, result_path, error_message, tokens_used, retry_count, max_retries, created_by_user_id, created_at)
-			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, NOW())
+			INSERT INTO generation_jobs (id, tenant_id, type, status, course_id, lesson_id, outline_lesson_id, sme_task_id, submission_id, sme_id, source_path, parent_job_id, progress_percent, progress_message, result_path, error_message, tokens_used, retry_count, max_retries, created_by_user_id, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, NOW())
 		`
 		for _, job := range jobs {
 			_, err := tx.ExecContext(ctx, query,
@@ -84,6 +98,8 @@ func (r *GenerationJobRepository) CreateBatch(ctx context.Context, jobs []*entit
 				job.OutlineLessonID,
 				job.SMETaskID,
 				job.SubmissionID,
+				job.SMEID,
+				job.SourcePath,
 				job.ParentJobID,
 				job.ProgressPercent,
 				job.ProgressMessage,
@@ -102,12 +118,187 @@ func (r *GenerationJobRepository) CreateBatch(ctx context.Context, jobs []*entit
 	})
 }
 
+// CreateFullCourseRun creates a full_course parent job and its lesson jobs in one transaction,
+// linking the lesson jobs to the parent once it has an ID and recording their number on it.
+// If the course already has a queued or processing full_course job, nothing is created and
+// that job is returned instead.
+// A transaction-scoped advisory lock on the course serializes concurrent calls.
+// Uses RLS to ensure proper tenant isolation.
+func (r *GenerationJobRepository) CreateFullCourseRun(ctx context.Context, parent *entity.GenerationJob, children []*entity.GenerationJob) (*entity.GenerationJob, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.GenerationJob, error) {
+		if err := lockCourseRuns(ctx, tx, *parent.CourseID); err != nil {
+			return nil, err
+		}
+		active, err := activeFullCourseRun(ctx, tx, *parent.CourseID)
+		if err != nil {
+			return nil, err
+		}
+		if active != nil {
+			return active, nil
+		}
+
+		// Share the transaction with Create and CreateBatch
+		txCtx := context.WithValue(ctx, txKey{}, tx)
+		if err := r.Create(txCtx, parent); err != nil {
+			return nil, fmt.Errorf("failed to create parent job: %w", err)
+		}
+		for _, child := range children {
+			child.ParentJobID = &parent.ID
+		}
+		if err := r.CreateBatch(txCtx, children); err != nil {
+			return nil, err
+		}
+		if err := setTotalChildren(ctx, tx, parent.ID, len(children)); err != nil {
+			return nil, err
+		}
+		return parent, nil
+	})
+}
+
+// CreateChildJobs creates lesson jobs under an existing full_course parent and records
+// their number on it, in one transaction.
+// Uses RLS to ensure proper tenant isolation.
+func (r *GenerationJobRepository) CreateChildJobs(ctx context.Context, parentID uuid.UUID, children []*entity.GenerationJob) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		// Share the transaction with CreateBatch
+		txCtx := context.WithValue(ctx, txKey{}, tx)
+		for _, child := range children {
+			child.ParentJobID = &parentID
+		}
+		if err := r.CreateBatch(txCtx, children); err != nil {
+			return err
+		}
+		return setTotalChildren(ctx, tx, parentID, len(children))
+	})
+}
+
+// setTotalChildren records how many lesson jobs a full_course parent queued.
+func setTotalChildren(ctx context.Context, tx *sql.Tx, parentID uuid.UUID, total int) error {
+	if _, err := tx.ExecContext(ctx, `UPDATE generation_jobs SET total_children = $2 WHERE id = $1`, parentID, total); err != nil {
+		return fmt.Errorf("failed to record parent job child count: %w", err)
+	}
+	return nil
+}
+
+// MarkEnqueued records that worker tasks were enqueued for the jobs.
+// Uses RLS to ensure proper tenant isolation.
+func (r *GenerationJobRepository) MarkEnqueued(ctx context.Context, ids []uuid.UUID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, `UPDATE generation_jobs SET enqueued_at = NOW() WHERE id = ANY($1)`, pq.Array(ids)); err != nil {
+			return fmt.Errorf("failed to mark jobs enqueued: %w", err)
+		}
+		return nil
+	})
+}
+
+// ListUnenqueuedChildren retrieves up to limit queued child jobs created before the cutoff
+// that never had a worker task enqueued, oldest first. Jobs of tenants whose AI generation
+// is paused are skipped; resuming enqueues them.
+// Uses RLS with superadmin context to access jobs across all tenants.
+func (r *GenerationJobRepository) ListUnenqueuedChildren(ctx context.Context, createdBefore time.Time, limit int) ([]*entity.GenerationJob, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.GenerationJob, error) {
+		query := `
+			SELECT ` + jobColumns + `
+			FROM generation_jobs p
+			WHERE p.id IN (
+				SELECT id FROM generation_jobs
+				WHERE status = 'queued' AND enqueued_at IS NULL AND parent_job_id IS NOT NULL
+				  AND created_at < $1
+				  AND ` + aiGenerationNotPaused + `
+			)
+			ORDER BY p.created_at ASC
+			LIMIT $2
+		`
+		return queryJobs(ctx, tx, query, createdBefore, limit)
+	})
+}
+
+// GetActiveFullCourseRun returns the course's queued or processing full_course job, or nil if none.
+// Uses RLS to ensure proper tenant isolation.
+func (r *GenerationJobRepository) GetActiveFullCourseRun(ctx context.Context, courseID uuid.UUID) (*entity.GenerationJob, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.GenerationJob, error) {
+		return activeFullCourseRun(ctx, tx, courseID)
+	})
+}
+
+// lockCourseRuns takes the advisory lock that serializes starting and reopening a course's
+// full_course runs. It is released when tx ends.
+func lockCourseRuns(ctx context.Context, tx *sql.Tx, courseID uuid.UUID) error {
+	if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtextextended('full_course:' || $1::text, 0))`, courseID); err != nil {
+		return fmt.Errorf("failed to lock course generation runs: %w", err)
+	}
+	return nil
+}
+
+// holdsCourseLock reports whether the job is a full_course parent, which holds its course
+// read-only from when it starts until it ends.
+func holdsCourseLock(job *entity.GenerationJob) bool {
+	return job.Type == valueobject.GenerationJobTypeFullCourse && job.CourseID != nil
+}
+
+// setCourseGenerationLock makes the course read-only while the full_course job runs.
+func setCourseGenerationLock(ctx context.Context, tx *sql.Tx, courseID, jobID uuid.UUID) error {
+	query := `UPDATE courses SET generation_lock_job_id = $1, generation_locked_at = NOW() WHERE id = $2`
+	if _, err := tx.ExecContext(ctx, query, jobID, courseID); err != nil {
+		return fmt.Errorf("failed to lock course for generation: %w", err)
+	}
+	return nil
+}
+
+// clearCourseGenerationLock releases the course held by a full_course job that has ended.
+// A course since locked by another run is left alone.
+func clearCourseGenerationLock(ctx context.Context, tx *sql.Tx, jobID uuid.UUID) error {
+	query := `UPDATE courses SET generation_lock_job_id = NULL, generation_locked_at = NULL WHERE generation_lock_job_id = $1`
+	if _, err := tx.ExecContext(ctx, query, jobID); err != nil {
+		return fmt.Errorf("failed to release course generation lock: %w", err)
+	}
+	return nil
+}
+
+// activeFullCourseRun returns the course's most recent queued or processing full_course job, or nil.
+func activeFullCourseRun(ctx context.Context, tx *sql.Tx, courseID uuid.UUID) (*entity.GenerationJob, error) {
+	query := `
+		SELECT ` + jobColumns + `
+		FROM generation_jobs p
+		WHERE p.course_id = $1 AND p.type = 'full_course' AND p.status IN ('queued', 'processing')
+		ORDER BY p.created_at DESC
+		LIMIT 1
+	`
+	jobs, err := queryJobs(ctx, tx, query, courseID)
+	if err != nil || len(jobs) == 0 {
+		return nil, err
+	}
+	return jobs[0], nil
+}
+
+// GetLatestBySubmissionID returns the most recent ingestion job for an SME submission, or nil if none.
+// Uses RLS to ensure proper tenant isolation.
+func (r *GenerationJobRepository) GetLatestBySubmissionID(ctx context.Context, submissionID uuid.UUID) (*entity.GenerationJob, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.GenerationJob, error) {
+		query := `
+			SELECT ` + jobColumns + `
+			FROM generation_jobs p
+			WHERE p.submission_id = $1
+			ORDER BY p.created_at DESC
+			LIMIT 1
+		`
+		jobs, err := queryJobs(ctx, tx, query, submissionID)
+		if err != nil || len(jobs) == 0 {
+			return nil, err
+		}
+		return jobs[0], nil
+	})
+}
+
 // GetByID retrieves a job by its ID.
 // Uses RLS to ensure proper tenant isolation.
 func (r *GenerationJobRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.GenerationJob, error) {
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.GenerationJob, error) {
 		query := `
-			SELECT id, tenant_id, type, status, course_id, lesson_id, outline_lesson_id, sme_task_id, submission_id, parent_job_id, progress_percent, progress_message, result_path, error_message, tokens_used, retry_count, max_retries, created_by_user_id, created_at, started_at, completed_at
+			SELECT id, tenant_id, type, status, course_id, lesson_id, outline_lesson_id, sme_task_id, submission_id, sme_id, source_path, include_citations, purge_orphans, parent_job_id, progress_percent, progress_message, result_path, error_message, failure_reason, tokens_used, repair_attempts, images_generated, retry_count, max_retries, created_by_user_id, created_at, started_at, completed_at
 			FROM generation_jobs
 			WHERE id = $1
 		`
@@ -123,12 +314,19 @@ func (r *GenerationJobRepository) GetByID(ctx context.Context, id uuid.UUID) (*e
 			&job.OutlineLessonID,
 			&job.SMETaskID,
 			&job.SubmissionID,
+			&job.SMEID,
+			&job.SourcePath,
+			&job.IncludeCitations,
+			&job.PurgeOrphans,
 			&job.ParentJobID,
 			&job.ProgressPercent,
 			&job.ProgressMessage,
 			&job.ResultPath,
 			&job.ErrorMessage,
+			&job.FailureReason,
 			&job.TokensUsed,
+			&job.RepairAttempts,
+			&job.ImagesGenerated,
 			&job.RetryCount,
 			&job.MaxRetries,
 			&job.CreatedByUserID,
@@ -155,37 +353,83 @@ func (r *GenerationJobRepository) GetByID(ctx context.Context, id uuid.UUID) (*e
 	})
 }
 
-// List retrieves jobs with optional filtering.
+// List retrieves jobs with optional filtering, most recent first.
 // Uses RLS to ensure proper tenant isolation.
 func (r *GenerationJobRepository) List(ctx context.Context, opts entity.GenerationJobListOptions) ([]*entity.GenerationJob, error) {
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.GenerationJob, error) {
 		query := `
-			SELECT id, tenant_id, type, status, course_id, lesson_id, outline_lesson_id, sme_task_id, submission_id, parent_job_id, progress_percent, progress_message, result_path, error_message, tokens_used, retry_count, max_retries, created_by_user_id, created_at, started_at, completed_at
-			FROM generation_jobs
-			WHERE 1=1
+			SELECT j.id, j.tenant_id, j.type, j.status, j.course_id, j.lesson_id, j.outline_lesson_id, j.sme_task_id, j.submission_id, j.sme_id, j.source_path, j.include_citations, j.purge_orphans, j.parent_job_id, j.progress_percent, j.progress_message, j.result_path, j.error_message, j.failure_reason, j.tokens_used, j.repair_attempts, j.images_generated, j.retry_count, j.max_retries, j.created_by_user_id, j.created_at, j.started_at, j.completed_at
 		`
+		if opts.ExcludeChildren {
+			// Child counts come from one grouped pass over the children instead of a
+			// subquery per parent
+			query += `,
+				COALESCE(c.total, 0), COALESCE(c.completed, 0), COALESCE(c.failed, 0), COALESCE(c.processing, 0)
+			FROM generation_jobs j
+			LEFT JOIN (
+				SELECT parent_job_id,
+					COUNT(*) AS total,
+					COUNT(*) FILTER (WHERE status = 'completed') AS completed,
+					COUNT(*) FILTER (WHERE status = 'failed') AS failed,
+					COUNT(*) FILTER (WHERE status = 'processing') AS processing
+				FROM generation_jobs
+				WHERE parent_job_id IS NOT NULL
+				GROUP BY parent_job_id
+			) c ON c.parent_job_id = j.id
+			WHERE j.parent_job_id IS NULL
+			`
+		} else {
+			query += `
+			FROM generation_jobs j
+			WHERE 1=1
+			`
+		}
 		args := []interface{}{}
 		argIndex := 1
 
 		if opts.Type != nil {
-			query += fmt.Sprintf(" AND type = $%d", argIndex)
+			query += fmt.Sprintf(" AND j.type = $%d", argIndex)
 			args = append(args, opts.Type.String())
 			argIndex++
 		}
 
 		if opts.Status != nil {
-			query += fmt.Sprintf(" AND status = $%d", argIndex)
+			query += fmt.Sprintf(" AND j.status = $%d", argIndex)
 			args = append(args, opts.Status.String())
 			argIndex++
 		}
 
 		if opts.CourseID != nil {
-			query += fmt.Sprintf(" AND course_id = $%d", argIndex)
+			query += fmt.Sprintf(" AND j.course_id = $%d", argIndex)
 			args = append(args, *opts.CourseID)
 			argIndex++
 		}
 
-		query += " ORDER BY created_at DESC"
+		if opts.ParentJobID != nil {
+			query += fmt.Sprintf(" AND j.parent_job_id = $%d", argIndex)
+			args = append(args, *opts.ParentJobID)
+			argIndex++
+		}
+
+		// Cursor-based pagination using "timestamp|id" format
+		if opts.Cursor != nil {
+			parts := strings.SplitN(*opts.Cursor, "|", 2)
+			if len(parts) == 2 {
+				cursorTime, timeErr := time.Parse(time.RFC3339Nano, parts[0])
+				cursorID, idErr := uuid.Parse(parts[1])
+				if timeErr == nil && idErr == nil {
+					query += fmt.Sprintf(" AND (j.created_at, j.id) < ($%d, $%d)", argIndex, argIndex+1)
+					args = append(args, cursorTime, cursorID)
+					argIndex += 2
+				}
+			}
+		}
+
+		query += " ORDER BY j.created_at DESC, j.id DESC"
+		if opts.Limit > 0 {
+			query += fmt.Sprintf(" LIMIT $%d", argIndex)
+			args = append(args, opts.Limit)
+		}
 
 		rows, err := tx.QueryContext(ctx, query, args...)
 		if err != nil {
@@ -197,7 +441,7 @@ func (r *GenerationJobRepository) List(ctx context.Context, opts entity.Generati
 		for rows.Next() {
 			job := &entity.GenerationJob{}
 			var typeStr, statusStr string
-			if err := rows.Scan(
+			dest := []any{
 				&job.ID,
 				&job.TenantID,
 				&typeStr,
@@ -207,19 +451,36 @@ func (r *GenerationJobRepository) List(ctx context.Context, opts entity.Generati
 				&job.OutlineLessonID,
 				&job.SMETaskID,
 				&job.SubmissionID,
+				&job.SMEID,
+				&job.SourcePath,
+				&job.IncludeCitations,
+				&job.PurgeOrphans,
 				&job.ParentJobID,
 				&job.ProgressPercent,
 				&job.ProgressMessage,
 				&job.ResultPath,
 				&job.ErrorMessage,
+				&job.FailureReason,
 				&job.TokensUsed,
+				&job.RepairAttempts,
+				&job.ImagesGenerated,
 				&job.RetryCount,
 				&job.MaxRetries,
 				&job.CreatedByUserID,
 				&job.CreatedAt,
 				&job.StartedAt,
 				&job.CompletedAt,
-			); err != nil {
+			}
+			if opts.ExcludeChildren {
+				job.ChildCounts = &entity.JobChildCounts{}
+				dest = append(dest,
+					&job.ChildCounts.Total,
+					&job.ChildCounts.Completed,
+					&job.ChildCounts.Failed,
+					&job.ChildCounts.Processing,
+				)
+			}
+			if err := rows.Scan(dest...); err != nil {
 				return nil, fmt.Errorf("failed to scan job: %w", err)
 			}
 			var parseErr error
@@ -233,7 +494,7 @@ func (r *GenerationJobRepository) List(ctx context.Context, opts entity.Generati
 			}
 			jobs = append(jobs, job)
 		}
-		return jobs, nil
+		return jobs, rows.Err()
 	})
 }
 
@@ -243,8 +504,8 @@ func (r *GenerationJobRepository) Update(ctx context.Context, job *entity.Genera
 	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
 		query := `
 			UPDATE generation_jobs
-			SET status = $1, progress_percent = $2, progress_message = $3, result_path = $4, error_message = $5, tokens_used = $6, retry_count = $7, started_at = $8, completed_at = $9
-			WHERE id = $10
+			SET status = $1, progress_percent = $2, progress_message = $3, result_path = $4, error_message = $5, tokens_used = $6, repair_attempts = $7, retry_count = $8, started_at = $9, completed_at = $10, sme_id = $11, failure_reason = $12, images_generated = $13
+			WHERE id = $14
 		`
 		_, err := tx.ExecContext(ctx, query,
 			job.Status.String(),
@@ -253,15 +514,52 @@ func (r *GenerationJobRepository) Update(ctx context.Context, job *entity.Genera
 			job.ResultPath,
 			job.ErrorMessage,
 			job.TokensUsed,
+			job.RepairAttempts,
 			job.RetryCount,
 			job.StartedAt,
 			job.CompletedAt,
+			job.SMEID,
+			job.FailureReason,
+			job.ImagesGenerated,
 			job.ID,
 		)
-		return err
+		if err != nil {
+			return err
+		}
+		if holdsCourseLock(job) && job.Status.IsTerminal() {
+			return clearCourseGenerationLock(ctx, tx, job.ID)
+		}
+		return nil
+	})
+}
+
+// UpdateProgress sets the progress of a job that is still processing.
+func (r *GenerationJobRepository) UpdateProgress(ctx context.Context, id uuid.UUID, percent int32, message string) (bool, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (bool, error) {
+		query := `
+			UPDATE generation_jobs
+			SET progress_percent = $1, progress_message = $2
+			WHERE id = $3 AND status = 'processing'
+		`
+		result, err := tx.ExecContext(ctx, query, percent, message, id)
+		if err != nil {
+			return false, fmt.Errorf("failed to update job progress: %w", err)
+		}
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return false, fmt.Errorf("failed to get rows affected: %w", err)
+		}
+		return rows > 0, nil
 	})
 }
 
+// aiGenerationNotPaused matches generation_jobs rows whose tenant's AI generation has not
+// been paused by support. Paused tenants' jobs stay queued until it is re-enabled.
+const aiGenerationNotPaused = `NOT EXISTS (
+	SELECT 1 FROM tenant_ai_settings s
+	WHERE s.tenant_id = generation_jobs.tenant_id AND NOT s.ai_generation_enabled
+)`
+
 // GetNextQueued atomically claims the next job for processing.
 // Uses RLS with superadmin context to access jobs across all tenants.
 // Atomically updates status to 'processing' and sets started_at in a single statement.
@@ -282,15 +580,16 @@ func (r *GenerationJobRepository) GetNextQueued(ctx context.Context) (*entity.Ge
 			SET status = 'processing', started_at = NOW(), retry_count = retry_count + CASE WHEN status = 'processing' THEN 1 ELSE 0 END
 			WHERE id = (
 				SELECT id FROM generation_jobs
-				WHERE (status = 'queued' AND type != 'full_course')
-				   OR (status = 'processing' AND started_at < NOW() - INTERVAL '%d minutes' AND type != 'full_course')
+				WHERE ((status = 'queued' AND type != 'full_course')
+				   OR (status = 'processing' AND started_at < NOW() - INTERVAL '%d minutes' AND type != 'full_course'))
+				  AND `+aiGenerationNotPaused+`
 				ORDER BY
 					CASE WHEN status = 'queued' THEN 0 ELSE 1 END, -- Prefer queued jobs
 					created_at ASC
 				LIMIT 1
 				FOR UPDATE SKIP LOCKED
 			)
-			RETURNING id, tenant_id, type, status, course_id, lesson_id, outline_lesson_id, sme_task_id, submission_id, parent_job_id, progress_percent, progress_message, result_path, error_message, tokens_used, retry_count, max_retries, created_by_user_id, created_at, started_at, completed_at
+			RETURNING id, tenant_id, type, status, course_id, lesson_id, outline_lesson_id, sme_task_id, submission_id, sme_id, source_path, include_citations, purge_orphans, parent_job_id, progress_percent, progress_message, result_path, error_message, failure_reason, tokens_used, repair_attempts, images_generated, retry_count, max_retries, created_by_user_id, created_at, started_at, completed_at
 		`, r.staleJobTimeoutMinutes)
 		job := &entity.GenerationJob{}
 		var typeStr, statusStr string
@@ -304,12 +603,19 @@ func (r *GenerationJobRepository) GetNextQueued(ctx context.Context) (*entity.Ge
 			&job.OutlineLessonID,
 			&job.SMETaskID,
 			&job.SubmissionID,
+			&job.SMEID,
+			&job.SourcePath,
+			&job.IncludeCitations,
+			&job.PurgeOrphans,
 			&job.ParentJobID,
 			&job.ProgressPercent,
 			&job.ProgressMessage,
 			&job.ResultPath,
 			&job.ErrorMessage,
+			&job.FailureReason,
 			&job.TokensUsed,
+			&job.RepairAttempts,
+			&job.ImagesGenerated,
 			&job.RetryCount,
 			&job.MaxRetries,
 			&job.CreatedByUserID,
@@ -344,8 +650,8 @@ func (r *GenerationJobRepository) ClaimJobByID(ctx context.Context, id uuid.UUID
 		query := `
 			UPDATE generation_jobs
 			SET status = 'processing', started_at = NOW()
-			WHERE id = $1 AND status = 'queued'
-			RETURNING id, tenant_id, type, status, course_id, lesson_id, outline_lesson_id, sme_task_id, submission_id, parent_job_id, progress_percent, progress_message, result_path, error_message, tokens_used, retry_count, max_retries, created_by_user_id, created_at, started_at, completed_at
+			WHERE id = $1 AND status = 'queued' AND ` + aiGenerationNotPaused + `
+			RETURNING id, tenant_id, type, status, course_id, lesson_id, outline_lesson_id, sme_task_id, submission_id, sme_id, source_path, include_citations, purge_orphans, parent_job_id, progress_percent, progress_message, result_path, error_message, failure_reason, tokens_used, repair_attempts, images_generated, retry_count, max_retries, created_by_user_id, created_at, started_at, completed_at
 		`
 		job := &entity.GenerationJob{}
 		var typeStr, statusStr string
@@ -359,12 +665,19 @@ func (r *GenerationJobRepository) ClaimJobByID(ctx context.Context, id uuid.UUID
 			&job.OutlineLessonID,
 			&job.SMETaskID,
 			&job.SubmissionID,
+			&job.SMEID,
+			&job.SourcePath,
+			&job.IncludeCitations,
+			&job.PurgeOrphans,
 			&job.ParentJobID,
 			&job.ProgressPercent,
 			&job.ProgressMessage,
 			&job.ResultPath,
 			&job.ErrorMessage,
+			&job.FailureReason,
 			&job.TokensUsed,
+			&job.RepairAttempts,
+			&job.ImagesGenerated,
 			&job.RetryCount,
 			&job.MaxRetries,
 			&job.CreatedByUserID,
@@ -395,7 +708,7 @@ func (r *GenerationJobRepository) ClaimJobByID(ctx context.Context, id uuid.UUID
 func (r *GenerationJobRepository) ListByParentID(ctx context.Context, parentID uuid.UUID) ([]*entity.GenerationJob, error) {
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.GenerationJob, error) {
 		query := `
-			SELECT id, tenant_id, type, status, course_id, lesson_id, outline_lesson_id, sme_task_id, submission_id, parent_job_id, progress_percent, progress_message, result_path, error_message, tokens_used, retry_count, max_retries, created_by_user_id, created_at, started_at, completed_at
+			SELECT id, tenant_id, type, status, course_id, lesson_id, outline_lesson_id, sme_task_id, submission_id, sme_id, source_path, include_citations, purge_orphans, parent_job_id, progress_percent, progress_message, result_path, error_message, failure_reason, tokens_used, repair_attempts, images_generated, retry_count, max_retries, created_by_user_id, created_at, started_at, completed_at
 			FROM generation_jobs
 			WHERE parent_job_id = $1
 			ORDER BY created_at ASC
@@ -420,12 +733,19 @@ func (r *GenerationJobRepository) ListByParentID(ctx context.Context, parentID u
 				&job.OutlineLessonID,
 				&job.SMETaskID,
 				&job.SubmissionID,
+				&job.SMEID,
+				&job.SourcePath,
+				&job.IncludeCitations,
+				&job.PurgeOrphans,
 				&job.ParentJobID,
 				&job.ProgressPercent,
 				&job.ProgressMessage,
 				&job.ResultPath,
 				&job.ErrorMessage,
+				&job.FailureReason,
 				&job.TokensUsed,
+				&job.RepairAttempts,
+				&job.ImagesGenerated,
 				&job.RetryCount,
 				&job.MaxRetries,
 				&job.CreatedByUserID,
@@ -470,6 +790,8 @@ func (r *GenerationJobRepository) CheckAllChildrenComplete(ctx context.Context,
 }
 
 // TryFinalizeParentJob atomically checks if all children are complete and returns stats.
+// Children are counted against the number the parent queued, so a missing child keeps
+// it incomplete.
 // Uses SELECT FOR UPDATE to prevent race conditions when multiple children complete simultaneously.
 // Properly respects RLS tenant isolation by using the RLSQuery helper.
 func (r *GenerationJobRepository) TryFinalizeParentJob(ctx context.Context, parentID uuid.UUID) (*repository.ParentJobFinalizationResult, error) {
@@ -477,12 +799,13 @@ func (r *GenerationJobRepository) TryFinalizeParentJob(ctx context.Context, pare
 		// Lock the parent job row to prevent concurrent finalization attempts
 		// Using FOR UPDATE ensures only one worker can proceed at a time
 		var parentStatus string
+		var totalChildren sql.NullInt32
 		lockQuery := `
-			SELECT status FROM generation_jobs
+			SELECT status, total_children FROM generation_jobs
 			WHERE id = $1
 			FOR UPDATE
 		`
-		if err := tx.QueryRowContext(ctx, lockQuery, parentID).Scan(&parentStatus); err != nil {
+		if err := tx.QueryRowContext(ctx, lockQuery, parentID).Scan(&parentStatus, &totalChildren); err != nil {
 			if err == sql.ErrNoRows {
 				return nil, nil
 			}
@@ -497,35 +820,13 @@ func (r *GenerationJobRepository) TryFinalizeParentJob(ctx context.Context, pare
 			}, nil
 		}
 
-		// Get child job statistics in a single query
-		statsQuery := `
-			SELECT
-				COUNT(*) as total,
-				COUNT(*) FILTER (WHERE status = 'completed') as completed,
-				COUNT(*) FILTER (WHERE status = 'failed') as failed,
-				COUNT(*) FILTER (WHERE status NOT IN ('completed', 'failed', 'cancelled')) as pending,
-				COALESCE(SUM(tokens_used), 0) as total_tokens
-			FROM generation_jobs
-			WHERE parent_job_id = $1
-		`
-
-		var total, completed, failed, pending int
-		var totalTokens int64
-		if err := tx.QueryRowContext(ctx, statsQuery, parentID).Scan(&total, &completed, &failed, &pending, &totalTokens); err != nil {
-			return nil, fmt.Errorf("failed to get child stats: %w", err)
-		}
-
-		result := &repository.ParentJobFinalizationResult{
-			WasFinalized:   false,
-			AllComplete:    pending == 0,
-			CompletedCount: completed,
-			FailedCount:    failed,
-			TotalCount:     total,
-			TotalTokens:    totalTokens,
+		result, err := childJobStats(ctx, tx, parentID, totalChildren)
+		if err != nil {
+			return nil, err
 		}
 
-		// If there are still pending jobs, just return the stats without finalizing
-		if pending > 0 {
+		// If children are still pending or missing, just return the stats without finalizing
+		if !result.AllComplete {
 			return result, nil
 		}
 
@@ -543,12 +844,13 @@ func (r *GenerationJobRepository) FinalizeParentJob(ctx context.Context, parentI
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*repository.ParentJobFinalizationResult, error) {
 		// Lock the parent job row to prevent concurrent finalization attempts
 		var parentStatus string
+		var totalChildren sql.NullInt32
 		lockQuery := `
-			SELECT status FROM generation_jobs
+			SELECT status, total_children FROM generation_jobs
 			WHERE id = $1
 			FOR UPDATE
 		`
-		if err := tx.QueryRowContext(ctx, lockQuery, parentID).Scan(&parentStatus); err != nil {
+		if err := tx.QueryRowContext(ctx, lockQuery, parentID).Scan(&parentStatus, &totalChildren); err != nil {
 			if err == sql.ErrNoRows {
 				return nil, nil
 			}
@@ -563,59 +865,439 @@ func (r *GenerationJobRepository) FinalizeParentJob(ctx context.Context, parentI
 			}, nil
 		}
 
-		// Get child job statistics in a single query
-		statsQuery := `
-			SELECT
-				COUNT(*) as total,
-				COUNT(*) FILTER (WHERE status = 'completed') as completed,
-				COUNT(*) FILTER (WHERE status = 'failed') as failed,
-				COUNT(*) FILTER (WHERE status NOT IN ('completed', 'failed', 'cancelled')) as pending,
-				COALESCE(SUM(tokens_used), 0) as total_tokens
-			FROM generation_jobs
-			WHERE parent_job_id = $1
-		`
-
-		var total, completed, failed, pending int
-		var totalTokens int64
-		if err := tx.QueryRowContext(ctx, statsQuery, parentID).Scan(&total, &completed, &failed, &pending, &totalTokens); err != nil {
-			return nil, fmt.Errorf("failed to get child stats: %w", err)
-		}
-
-		result := &repository.ParentJobFinalizationResult{
-			WasFinalized:   false,
-			AllComplete:    pending == 0,
-			CompletedCount: completed,
-			FailedCount:    failed,
-			TotalCount:     total,
-			TotalTokens:    totalTokens,
+		result, err := childJobStats(ctx, tx, parentID, totalChildren)
+		if err != nil {
+			return nil, err
 		}
 
-		// If there are still pending jobs, just return the stats without finalizing
-		if pending > 0 {
+		// If children are still pending or missing, just return the stats without finalizing
+		if !result.AllComplete {
 			return result, nil
 		}
 
 		// All children are complete - finalize the parent INSIDE the atomic lock
 		finalStatus := completedStatus
-		var errorMessage *string
-		if failed > 0 {
+		var errorMessage, failureReason *string
+		if result.FailedCount > 0 {
 			finalStatus = failedStatus
-			errMsg := fmt.Sprintf("%d lesson(s) failed to generate", failed)
+			errMsg := fmt.Sprintf("%d lesson(s) failed to generate", result.FailedCount)
 			errorMessage = &errMsg
+
+			// The parent takes the most common reason among its failed lessons
+			reasonQuery := `
+				SELECT mode() WITHIN GROUP (ORDER BY failure_reason)
+				FROM generation_jobs
+				WHERE parent_job_id = $1 AND type = 'lesson_content' AND status = 'failed'
+			`
+			if err := tx.QueryRowContext(ctx, reasonQuery, parentID).Scan(&failureReason); err != nil {
+				return nil, fmt.Errorf("failed to get child failure reason: %w", err)
+			}
 		}
 
 		// Update parent status atomically while holding the lock
 		updateQuery := `
 			UPDATE generation_jobs
 			SET status = $1, progress_percent = 100, progress_message = $2,
-			    tokens_used = $3, completed_at = NOW(), error_message = $4
-			WHERE id = $5
+			    tokens_used = $3, images_generated = $4, completed_at = NOW(), error_message = $5, failure_reason = $6
+			WHERE id = $7
 		`
-		if _, err := tx.ExecContext(ctx, updateQuery, finalStatus, progressMessage, totalTokens, errorMessage, parentID); err != nil {
+		if _, err := tx.ExecContext(ctx, updateQuery, finalStatus, progressMessage, result.TotalTokens, result.TotalImages, errorMessage, failureReason, parentID); err != nil {
 			return nil, fmt.Errorf("failed to update parent job status: %w", err)
 		}
+		if err := clearCourseGenerationLock(ctx, tx, parentID); err != nil {
+			return nil, err
+		}
 
 		result.WasFinalized = true
 		return result, nil
 	})
 }
+
+// childJobStats counts a parent's lesson jobs by status. Only lesson jobs count: the outline
+// job of an auto-approved run has ended before its lessons are queued. When the parent
+// recorded how many lesson jobs it queued, that is the total, and children missing from
+// it keep the parent from being complete; older parents count their rows.
+func childJobStats(ctx context.Context, tx *sql.Tx, parentID uuid.UUID, totalChildren sql.NullInt32) (*repository.ParentJobFinalizationResult, error) {
+	statsQuery := `
+		SELECT
+			COUNT(*) as total,
+			COUNT(*) FILTER (WHERE status = 'completed') as completed,
+			COUNT(*) FILTER (WHERE status = 'failed') as failed,
+			COUNT(*) FILTER (WHERE status NOT IN ('completed', 'failed', 'cancelled')) as pending,
+			COALESCE(SUM(tokens_used), 0) as total_tokens,
+			COALESCE(SUM(images_generated), 0) as total_images
+		FROM generation_jobs
+		WHERE parent_job_id = $1 AND type = 'lesson_content'
+	`
+
+	var total, completed, failed, pending int
+	var totalTokens int64
+	var totalImages int32
+	if err := tx.QueryRowContext(ctx, statsQuery, parentID).Scan(&total, &completed, &failed, &pending, &totalTokens, &totalImages); err != nil {
+		return nil, fmt.Errorf("failed to get child stats: %w", err)
+	}
+
+	result := &repository.ParentJobFinalizationResult{
+		CompletedCount: completed,
+		FailedCount:    failed,
+		TotalCount:     total,
+		TotalTokens:    totalTokens,
+		TotalImages:    totalImages,
+	}
+	if totalChildren.Valid {
+		result.TotalCount = int(totalChildren.Int32)
+		result.MissingCount = max(result.TotalCount-total, 0)
+	}
+	result.AllComplete = pending == 0 && result.MissingCount == 0
+	return result, nil
+}
+
+// RequeueFailedChildren resets the failed children of a failed parent to queued and reopens
+// the parent as processing. Locks the parent row like FinalizeParentJob so a concurrent
+// retry or finalization cannot interleave, and takes the course's run lock so the parent
+// is not reopened while another run of the course is active.
+// Uses RLS to ensure proper tenant isolation.
+func (r *GenerationJobRepository) RequeueFailedChildren(ctx context.Context, parentID uuid.UUID) ([]*entity.GenerationJob, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.GenerationJob, error) {
+		var parentStatus string
+		var courseID *uuid.UUID
+		lockQuery := `
+			SELECT status, course_id FROM generation_jobs
+			WHERE id = $1
+			FOR UPDATE
+		`
+		if err := tx.QueryRowContext(ctx, lockQuery, parentID).Scan(&parentStatus, &courseID); err != nil {
+			if err == sql.ErrNoRows {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("failed to lock parent job: %w", err)
+		}
+		if parentStatus != "failed" {
+			return nil, nil
+		}
+		if courseID != nil {
+			if err := lockCourseRuns(ctx, tx, *courseID); err != nil {
+				return nil, err
+			}
+			active, err := activeFullCourseRun(ctx, tx, *courseID)
+			if err != nil {
+				return nil, err
+			}
+			if active != nil {
+				return nil, nil
+			}
+		}
+
+		// Retried children get a fresh retry budget; tokens from the failed attempt are kept
+		requeueQuery := `
+			UPDATE generation_jobs p
+			SET status = 'queued', progress_percent = 0, progress_message = NULL, error_message = NULL, failure_reason = NULL,
+			    retry_count = 0, started_at = NULL, completed_at = NULL, enqueued_at = NULL
+			WHERE p.parent_job_id = $1 AND p.status = 'failed' AND p.type = 'lesson_content'
+			RETURNING ` + jobColumns
+		children, err := queryJobs(ctx, tx, requeueQuery, parentID)
+		if err != nil {
+			return nil, err
+		}
+		if len(children) == 0 {
+			return nil, nil
+		}
+
+		reopenQuery := `
+			UPDATE generation_jobs
+			SET status = 'processing', error_message = NULL, failure_reason = NULL, completed_at = NULL
+			WHERE id = $1
+		`
+		if _, err := tx.ExecContext(ctx, reopenQuery, parentID); err != nil {
+			return nil, fmt.Errorf("failed to reopen parent job: %w", err)
+		}
+		if courseID != nil {
+			if err := setCourseGenerationLock(ctx, tx, *courseID, parentID); err != nil {
+				return nil, err
+			}
+		}
+
+		return children, nil
+	})
+}
+
+// ReassignActiveJobs moves the creator of queued and processing jobs from one user to another.
+// Uses RLS to ensure proper tenant isolation.
+func (r *GenerationJobRepository) ReassignActiveJobs(ctx context.Context, fromUserID, toUserID uuid.UUID) ([]*entity.GenerationJob, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.GenerationJob, error) {
+		query := `
+			UPDATE generation_jobs p
+			SET created_by_user_id = $2
+			WHERE p.created_by_user_id = $1 AND p.status IN ('queued', 'processing')
+			RETURNING ` + jobColumns
+		return queryJobs(ctx, tx, query, fromUserID, toUserID)
+	})
+}
+
+// ListUnfinalizedParents retrieves open full_course parents whose children all ended before the cutoff.
+// Parents missing some of the children they queued are left to ListParentsMissingChildren.
+// Uses RLS to ensure proper tenant isolation.
+func (r *GenerationJobRepository) ListUnfinalizedParents(ctx context.Context, childrenEndedBefore time.Time) ([]*entity.GenerationJob, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.GenerationJob, error) {
+		query := `
+			SELECT ` + jobColumns + `
+			FROM generation_jobs p
+			WHERE p.type = 'full_course'
+			  AND p.status IN ('queued', 'processing')
+			  AND EXISTS (SELECT 1 FROM generation_jobs c WHERE c.parent_job_id = p.id AND c.type = 'lesson_content')
+			  AND (p.total_children IS NULL OR p.total_children <= (
+			      SELECT COUNT(*) FROM generation_jobs c WHERE c.parent_job_id = p.id AND c.type = 'lesson_content'
+			  ))
+			  AND NOT EXISTS (
+			      SELECT 1 FROM generation_jobs c
+			      WHERE c.parent_job_id = p.id
+			        AND (c.status NOT IN ('completed', 'failed', 'cancelled')
+			             OR COALESCE(c.completed_at, c.created_at) >= $1)
+			  )
+			ORDER BY p.created_at ASC
+		`
+		return queryJobs(ctx, tx, query, childrenEndedBefore)
+	})
+}
+
+// ListParentsMissingChildren retrieves open full_course parents with fewer lesson jobs than
+// they queued, once their existing children all ended before the cutoff.
+// Uses RLS to ensure proper tenant isolation.
+func (r *GenerationJobRepository) ListParentsMissingChildren(ctx context.Context, childrenEndedBefore time.Time) ([]*entity.GenerationJob, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.GenerationJob, error) {
+		query := `
+			SELECT ` + jobColumns + `
+			FROM generation_jobs p
+			WHERE p.type = 'full_course'
+			  AND p.status IN ('queued', 'processing')
+			  AND EXISTS (SELECT 1 FROM generation_jobs c WHERE c.parent_job_id = p.id AND c.type = 'lesson_content')
+			  AND p.total_children > (
+			      SELECT COUNT(*) FROM generation_jobs c WHERE c.parent_job_id = p.id AND c.type = 'lesson_content'
+			  )
+			  AND NOT EXISTS (
+			      SELECT 1 FROM generation_jobs c
+			      WHERE c.parent_job_id = p.id
+			        AND (c.status NOT IN ('completed', 'failed', 'cancelled')
+			             OR COALESCE(c.completed_at, c.created_at) >= $1)
+			  )
+			ORDER BY p.created_at ASC
+		`
+		return queryJobs(ctx, tx, query, childrenEndedBefore)
+	})
+}
+
+// ListParentsWithoutChildren retrieves open full_course parents created before the cutoff that have no child jobs.
+// Uses RLS to ensure proper tenant isolation.
+func (r *GenerationJobRepository) ListParentsWithoutChildren(ctx context.Context, createdBefore time.Time) ([]*entity.GenerationJob, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.GenerationJob, error) {
+		query := `
+			SELECT ` + jobColumns + `
+			FROM generation_jobs p
+			WHERE p.type = 'full_course'
+			  AND p.status IN ('queued', 'processing')
+			  AND p.created_at < $1
+			  AND NOT EXISTS (SELECT 1 FROM generation_jobs c WHERE c.parent_job_id = p.id)
+			ORDER BY p.created_at ASC
+		`
+		return queryJobs(ctx, tx, query, createdBefore)
+	})
+}
+
+// ListCompletedParentsWithoutLessons retrieves full_course parents completed since the cutoff
+// whose course has no active generated lessons.
+// Uses RLS to ensure proper tenant isolation.
+func (r *GenerationJobRepository) ListCompletedParentsWithoutLessons(ctx context.Context, completedSince time.Time) ([]*entity.GenerationJob, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.GenerationJob, error) {
+		query := `
+			SELECT ` + jobColumns + `
+			FROM generation_jobs p
+			WHERE p.type = 'full_course'
+			  AND p.status = 'completed'
+			  AND p.completed_at >= $1
+			  AND p.course_id IS NOT NULL
+			  AND NOT EXISTS (
+			      SELECT 1 FROM generated_lessons gl
+			      WHERE gl.course_id = p.course_id AND gl.orphaned_at IS NULL
+			  )
+			ORDER BY p.completed_at ASC
+		`
+		return queryJobs(ctx, tx, query, completedSince)
+	})
+}
+
+// jobColumns lists generation_jobs columns in the order queryJobs scans them.
+// Columns are qualified with the "p" alias used by the sweeper queries.
+const jobColumns = `p.id, p.tenant_id, p.type, p.status, p.course_id, p.lesson_id, p.outline_lesson_id, p.sme_task_id, p.submission_id, p.sme_id, p.source_path, p.include_citations, p.purge_orphans, p.parent_job_id, p.progress_percent, p.progress_message, p.result_path, p.error_message, p.failure_reason, p.tokens_used, p.repair_attempts, p.images_generated, p.retry_count, p.max_retries, p.created_by_user_id, p.created_at, p.started_at, p.completed_at`
+
+// queryJobs runs a query selecting jobColumns and scans the resulting jobs.
+func queryJobs(ctx context.Context, tx *sql.Tx, query string, args ...interface{}) ([]*entity.GenerationJob, error) {
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query jobs: %w", err)
+	}
+	defer rows.Close()
+
+	var jobs []*entity.GenerationJob
+	for rows.Next() {
+		job := &entity.GenerationJob{}
+		var typeStr, statusStr string
+		if err := rows.Scan(
+			&job.ID,
+			&job.TenantID,
+			&typeStr,
+			&statusStr,
+			&job.CourseID,
+			&job.LessonID,
+			&job.OutlineLessonID,
+			&job.SMETaskID,
+			&job.SubmissionID,
+			&job.SMEID,
+			&job.SourcePath,
+			&job.IncludeCitations,
+			&job.PurgeOrphans,
+			&job.ParentJobID,
+			&job.ProgressPercent,
+			&job.ProgressMessage,
+			&job.ResultPath,
+			&job.ErrorMessage,
+			&job.FailureReason,
+			&job.TokensUsed,
+			&job.RepairAttempts,
+			&job.ImagesGenerated,
+			&job.RetryCount,
+			&job.MaxRetries,
+			&job.CreatedByUserID,
+			&job.CreatedAt,
+			&job.StartedAt,
+			&job.CompletedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan job: %w", err)
+		}
+		var parseErr error
+		job.Type, parseErr = valueobject.ParseGenerationJobType(typeStr)
+		if parseErr != nil {
+			return nil, fmt.Errorf("failed to parse job type '%s': %w", typeStr, parseErr)
+		}
+		job.Status, parseErr = valueobject.ParseGenerationJobStatus(statusStr)
+		if parseErr != nil {
+			return nil, fmt.Errorf("failed to parse job status '%s': %w", statusStr, parseErr)
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, rows.Err()
+}
+
+// ListActiveByTeamMembers retrieves queued and processing top-level jobs created by a team's members.
+// Uses RLS to ensure proper tenant isolation.
+func (r *GenerationJobRepository) ListActiveByTeamMembers(ctx context.Context, teamID uuid.UUID, limit int) ([]*entity.GenerationJob, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.GenerationJob, error) {
+		query := `
+			SELECT ` + jobColumns + `
+			FROM generation_jobs p
+			WHERE p.created_by_user_id IN (SELECT user_id FROM team_members WHERE team_id = $1)
+			  AND p.status IN ('queued', 'processing')
+			  AND p.parent_job_id IS NULL
+			ORDER BY p.created_at DESC
+			LIMIT $2
+		`
+		return queryJobs(ctx, tx, query, teamID, limit)
+	})
+}
+
+// CountActiveByType counts the tenant's queued and processing jobs per type.
+func (r *GenerationJobRepository) CountActiveByType(ctx context.Context) ([]entity.JobTypeQueueCount, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]entity.JobTypeQueueCount, error) {
+		query := `
+			SELECT type,
+				COUNT(*) FILTER (WHERE status = 'queued'),
+				COUNT(*) FILTER (WHERE status = 'processing')
+			FROM generation_jobs
+			WHERE status IN ('queued', 'processing') AND type != 'full_course'
+			GROUP BY type
+			ORDER BY type
+		`
+		rows, err := tx.QueryContext(ctx, query)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count active jobs: %w", err)
+		}
+		defer rows.Close()
+
+		var counts []entity.JobTypeQueueCount
+		for rows.Next() {
+			var c entity.JobTypeQueueCount
+			var typeStr string
+			if err := rows.Scan(&typeStr, &c.Queued, &c.Processing); err != nil {
+				return nil, fmt.Errorf("failed to scan active job count: %w", err)
+			}
+			c.Type, _ = valueobject.ParseGenerationJobType(typeStr)
+			counts = append(counts, c)
+		}
+		return counts, rows.Err()
+	})
+}
+
+// GetOldestQueuedCreatedAt returns the creation time of the user's oldest queued job.
+func (r *GenerationJobRepository) GetOldestQueuedCreatedAt(ctx context.Context, userID uuid.UUID) (*time.Time, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*time.Time, error) {
+		query := `
+			SELECT MIN(created_at)
+			FROM generation_jobs
+			WHERE created_by_user_id = $1 AND status = 'queued' AND type != 'full_course'
+		`
+		var createdAt sql.NullTime
+		if err := tx.QueryRowContext(ctx, query, userID).Scan(&createdAt); err != nil {
+			return nil, fmt.Errorf("failed to get oldest queued job: %w", err)
+		}
+		if !createdAt.Valid {
+			return nil, nil
+		}
+		return &createdAt.Time, nil
+	})
+}
+
+// CountQueuedBefore counts queued jobs created before the given time.
+// Requires superadmin context to count across tenants.
+func (r *GenerationJobRepository) CountQueuedBefore(ctx context.Context, createdBefore time.Time) (int, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (int, error) {
+		query := `
+			SELECT COUNT(*)
+			FROM generation_jobs
+			WHERE status = 'queued' AND type != 'full_course' AND created_at < $1 AND ` + aiGenerationNotPaused + `
+		`
+		var count int
+		if err := tx.QueryRowContext(ctx, query, createdBefore).Scan(&count); err != nil {
+			return 0, fmt.Errorf("failed to count queued jobs: %w", err)
+		}
+		return count, nil
+	})
+}
+
+// GetJobOutcomeStats aggregates recently finished jobs in one pass.
+// Requires superadmin context to aggregate across tenants.
+func (r *GenerationJobRepository) GetJobOutcomeStats(ctx context.Context, durationSince, outcomesSince time.Time, failurePatterns []string) (*repository.JobOutcomeStats, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*repository.JobOutcomeStats, error) {
+		query := `
+			SELECT
+				COALESCE(EXTRACT(EPOCH FROM AVG(completed_at - started_at) FILTER (
+					WHERE status = 'completed' AND completed_at >= $1 AND started_at IS NOT NULL
+				)), 0),
+				COUNT(*) FILTER (WHERE completed_at >= $2),
+				COUNT(*) FILTER (WHERE completed_at >= $2 AND status = 'failed' AND error_message LIKE ANY($3))
+			FROM generation_jobs
+			WHERE status IN ('completed', 'failed') AND type != 'full_course'
+			  AND completed_at >= LEAST($1::timestamptz, $2::timestamptz)
+		`
+		var avgSeconds float64
+		stats := &repository.JobOutcomeStats{}
+		err := tx.QueryRowContext(ctx, query, durationSince, outcomesSince, pq.Array(failurePatterns)).Scan(
+			&avgSeconds,
+			&stats.FinishedCount,
+			&stats.MatchedFailureCount,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to aggregate job outcomes: %w", err)
+		}
+		stats.AvgDuration = time.Duration(avgSeconds * float64(time.Second))
+		return stats, nil
+	})
+}
@@ -26,8 +26,8 @@ func NewInvitationRepository(db *sql.DB) repository.InvitationRepository {
 func (r *InvitationRepository) Create(ctx context.Context, inv *entity.Invitation) error {
 	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
 		query := `
-			INSERT INTO invitations (tenant_id, company_id, email, role, status, token, invited_by_user_id, expires_at)
-			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+			INSERT INTO invitations (tenant_id, company_id, email, role, status, token, invited_by_user_id, expires_at, team_id)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 			RETURNING id, created_at, updated_at
 		`
 		return tx.QueryRowContext(ctx, query,
@@ -39,6 +39,7 @@ func (r *InvitationRepository) Create(ctx context.Context, inv *entity.Invitatio
 			inv.Token,
 			inv.InvitedByUserID,
 			inv.ExpiresAt,
+			inv.TeamID,
 		).Scan(&inv.ID, &inv.CreatedAt, &inv.UpdatedAt)
 	})
 }
@@ -48,7 +49,7 @@ func (r *InvitationRepository) GetByID(ctx context.Context, id uuid.UUID) (*enti
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.Invitation, error) {
 		query := `
 			SELECT id, tenant_id, company_id, email, role, status, token, invited_by_user_id,
-			       accepted_by_user_id, expires_at, created_at, updated_at
+			       accepted_by_user_id, expires_at, created_at, updated_at, team_id
 			FROM invitations
 			WHERE id = $1
 		`
@@ -61,7 +62,7 @@ func (r *InvitationRepository) GetByToken(ctx context.Context, token string) (*e
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.Invitation, error) {
 		query := `
 			SELECT id, tenant_id, company_id, email, role, status, token, invited_by_user_id,
-			       accepted_by_user_id, expires_at, created_at, updated_at
+			       accepted_by_user_id, expires_at, created_at, updated_at, team_id
 			FROM invitations
 			WHERE token = $1
 		`
@@ -74,7 +75,7 @@ func (r *InvitationRepository) GetByEmailAndCompanyID(ctx context.Context, email
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.Invitation, error) {
 		query := `
 			SELECT id, tenant_id, company_id, email, role, status, token, invited_by_user_id,
-			       accepted_by_user_id, expires_at, created_at, updated_at
+			       accepted_by_user_id, expires_at, created_at, updated_at, team_id
 			FROM invitations
 			WHERE email = $1 AND company_id = $2 AND status = 'pending' AND expires_at > NOW()
 			LIMIT 1
@@ -88,7 +89,7 @@ func (r *InvitationRepository) ListByCompanyID(ctx context.Context, companyID uu
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.Invitation, error) {
 		query := `
 			SELECT id, tenant_id, company_id, email, role, status, token, invited_by_user_id,
-			       accepted_by_user_id, expires_at, created_at, updated_at
+			       accepted_by_user_id, expires_at, created_at, updated_at, team_id
 			FROM invitations
 			WHERE company_id = $1
 		`
@@ -140,6 +141,55 @@ func (r *InvitationRepository) Update(ctx context.Context, inv *entity.Invitatio
 	})
 }
 
+// MarkAccepted marks a pending invitation as accepted by the user.
+func (r *InvitationRepository) MarkAccepted(ctx context.Context, id, userID uuid.UUID) (bool, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (bool, error) {
+		query := `
+			UPDATE invitations
+			SET status = 'accepted', accepted_by_user_id = $1, updated_at = NOW()
+			WHERE id = $2 AND status = 'pending'
+		`
+		result, err := tx.ExecContext(ctx, query, userID, id)
+		if err != nil {
+			return false, fmt.Errorf("failed to accept invitation: %w", err)
+		}
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return false, fmt.Errorf("failed to get rows affected: %w", err)
+		}
+		return rows > 0, nil
+	})
+}
+
+// ListPendingByTeamID retrieves a team's pending, unexpired invitations, newest first.
+func (r *InvitationRepository) ListPendingByTeamID(ctx context.Context, teamID uuid.UUID, limit int) ([]*entity.Invitation, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.Invitation, error) {
+		query := `
+			SELECT id, tenant_id, company_id, email, role, status, token, invited_by_user_id,
+			       accepted_by_user_id, expires_at, created_at, updated_at, team_id
+			FROM invitations
+			WHERE team_id = $1 AND status = 'pending' AND expires_at > NOW()
+			ORDER BY created_at DESC
+			LIMIT $2
+		`
+		rows, err := tx.QueryContext(ctx, query, teamID, limit)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list team invitations: %w", err)
+		}
+		defer rows.Close()
+
+		var invitations []*entity.Invitation
+		for rows.Next() {
+			inv, err := scanInvitationRows(rows)
+			if err != nil {
+				return nil, fmt.Errorf("failed to scan invitation: %w", err)
+			}
+			invitations = append(invitations, inv)
+		}
+		return invitations, rows.Err()
+	})
+}
+
 // CountPendingByCompanyID counts pending invitations for a company.
 func (r *InvitationRepository) CountPendingByCompanyID(ctx context.Context, companyID uuid.UUID) (int, error) {
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (int, error) {
@@ -175,6 +225,7 @@ func scanInvitationRow(row *sql.Row) (*entity.Invitation, error) {
 		&inv.ExpiresAt,
 		&inv.CreatedAt,
 		&inv.UpdatedAt,
+		&inv.TeamID,
 	)
 	if err == sql.ErrNoRows {
 		return nil, nil
@@ -206,6 +257,7 @@ func scanInvitationRows(rows *sql.Rows) (*entity.Invitation, error) {
 		&inv.ExpiresAt,
 		&inv.CreatedAt,
 		&inv.UpdatedAt,
+		&inv.TeamID,
 	)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,116 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+// defaultAnomalyListLimit caps List when no limit is given.
+const defaultAnomalyListLimit = 100
+
+// JobAnomalyRepository implements repository.JobAnomalyRepository using PostgreSQL.
+type JobAnomalyRepository struct {
+	db *sql.DB
+}
+
+// NewJobAnomalyRepository creates a new PostgreSQL job anomaly repository.
+func NewJobAnomalyRepository(db *sql.DB) repository.JobAnomalyRepository {
+	return &JobAnomalyRepository{db: db}
+}
+
+// Record stores an anomaly unless one of the same type is already recorded for the job.
+// Uses RLS to ensure proper tenant isolation.
+func (r *JobAnomalyRepository) Record(ctx context.Context, anomaly *entity.JobAnomaly) (bool, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (bool, error) {
+		query := `
+			INSERT INTO job_anomalies (tenant_id, job_id, course_id, anomaly_type, details, resolved)
+			VALUES ($1, $2, $3, $4, $5, $6)
+			ON CONFLICT (job_id, anomaly_type) DO NOTHING
+			RETURNING id, detected_at
+		`
+		err := tx.QueryRowContext(ctx, query,
+			anomaly.TenantID,
+			anomaly.JobID,
+			anomaly.CourseID,
+			anomaly.Type.String(),
+			anomaly.Details,
+			anomaly.Resolved,
+		).Scan(&anomaly.ID, &anomaly.DetectedAt)
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		if err != nil {
+			return false, fmt.Errorf("failed to record job anomaly: %w", err)
+		}
+		return true, nil
+	})
+}
+
+// List retrieves anomalies, most recent first.
+// Uses RLS to ensure proper tenant isolation.
+func (r *JobAnomalyRepository) List(ctx context.Context, opts entity.JobAnomalyListOptions) ([]*entity.JobAnomaly, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.JobAnomaly, error) {
+		query := `
+			SELECT id, tenant_id, job_id, course_id, anomaly_type, details, resolved, detected_at
+			FROM job_anomalies
+			WHERE 1=1
+		`
+		args := []interface{}{}
+		argIndex := 1
+
+		if opts.TenantID != nil {
+			query += fmt.Sprintf(" AND tenant_id = $%d", argIndex)
+			args = append(args, *opts.TenantID)
+			argIndex++
+		}
+
+		if opts.Type != nil {
+			query += fmt.Sprintf(" AND anomaly_type = $%d", argIndex)
+			args = append(args, opts.Type.String())
+			argIndex++
+		}
+
+		limit := opts.Limit
+		if limit <= 0 {
+			limit = defaultAnomalyListLimit
+		}
+		query += fmt.Sprintf(" ORDER BY detected_at DESC LIMIT $%d", argIndex)
+		args = append(args, limit)
+
+		rows, err := tx.QueryContext(ctx, query, args...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list job anomalies: %w", err)
+		}
+		defer rows.Close()
+
+		var anomalies []*entity.JobAnomaly
+		for rows.Next() {
+			anomaly := &entity.JobAnomaly{}
+			var typeStr string
+			if err := rows.Scan(
+				&anomaly.ID,
+				&anomaly.TenantID,
+				&anomaly.JobID,
+				&anomaly.CourseID,
+				&typeStr,
+				&anomaly.Details,
+				&anomaly.Resolved,
+				&anomaly.DetectedAt,
+			); err != nil {
+				return nil, fmt.Errorf("failed to scan job anomaly: %w", err)
+			}
+			anomalyType, err := valueobject.ParseJobAnomalyType(typeStr)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse anomaly type '%s': %w", typeStr, err)
+			}
+			anomaly.Type = anomalyType
+			anomalies = append(anomalies, anomaly)
+		}
+		return anomalies, rows.Err()
+	})
+}
@@ -0,0 +1,145 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+// LMSConnectorRepository implements repository.LMSConnectorRepository using PostgreSQL.
+type LMSConnectorRepository struct {
+	db *sql.DB
+}
+
+// NewLMSConnectorRepository creates a new PostgreSQL LMS connector repository.
+func NewLMSConnectorRepository(db *sql.DB) repository.LMSConnectorRepository {
+	return &LMSConnectorRepository{db: db}
+}
+
+// Create creates a new connector.
+func (r *LMSConnectorRepository) Create(ctx context.Context, connector *entity.LMSConnector) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		query := `
+			INSERT INTO lms_connectors (tenant_id, name, type, endpoint_url, encrypted_credentials, enabled, created_by_user_id)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)
+			RETURNING id, created_at, updated_at
+		`
+		return tx.QueryRowContext(ctx, query,
+			connector.TenantID,
+			connector.Name,
+			connector.Type.String(),
+			connector.EndpointURL,
+			connector.EncryptedCredentials,
+			connector.Enabled,
+			connector.CreatedByUserID,
+		).Scan(&connector.ID, &connector.CreatedAt, &connector.UpdatedAt)
+	})
+}
+
+// GetByID retrieves a connector by its ID.
+func (r *LMSConnectorRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.LMSConnector, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.LMSConnector, error) {
+		query := `
+			SELECT id, tenant_id, name, type, endpoint_url, encrypted_credentials, enabled, created_by_user_id, created_at, updated_at
+			FROM lms_connectors
+			WHERE id = $1
+		`
+		connector, err := scanLMSConnector(tx.QueryRowContext(ctx, query, id))
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get LMS connector: %w", err)
+		}
+		return connector, nil
+	})
+}
+
+// List retrieves all connectors for the current tenant.
+func (r *LMSConnectorRepository) List(ctx context.Context) ([]*entity.LMSConnector, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.LMSConnector, error) {
+		query := `
+			SELECT id, tenant_id, name, type, endpoint_url, encrypted_credentials, enabled, created_by_user_id, created_at, updated_at
+			FROM lms_connectors
+			ORDER BY name ASC
+		`
+		rows, err := tx.QueryContext(ctx, query)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list LMS connectors: %w", err)
+		}
+		defer rows.Close()
+
+		var connectors []*entity.LMSConnector
+		for rows.Next() {
+			connector, err := scanLMSConnector(rows)
+			if err != nil {
+				return nil, fmt.Errorf("failed to scan LMS connector: %w", err)
+			}
+			connectors = append(connectors, connector)
+		}
+		return connectors, rows.Err()
+	})
+}
+
+// Update updates a connector.
+func (r *LMSConnectorRepository) Update(ctx context.Context, connector *entity.LMSConnector) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		query := `
+			UPDATE lms_connectors
+			SET name = $1, type = $2, endpoint_url = $3, encrypted_credentials = $4, enabled = $5, updated_at = NOW()
+			WHERE id = $6
+			RETURNING updated_at
+		`
+		err := tx.QueryRowContext(ctx, query,
+			connector.Name,
+			connector.Type.String(),
+			connector.EndpointURL,
+			connector.EncryptedCredentials,
+			connector.Enabled,
+			connector.ID,
+		).Scan(&connector.UpdatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to update LMS connector: %w", err)
+		}
+		return nil
+	})
+}
+
+// Delete deletes a connector.
+func (r *LMSConnectorRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `DELETE FROM lms_connectors WHERE id = $1`, id)
+		if err != nil {
+			return fmt.Errorf("failed to delete LMS connector: %w", err)
+		}
+		return nil
+	})
+}
+
+// scanLMSConnector scans a connector row in the column order used by the queries above.
+func scanLMSConnector(row interface{ Scan(...any) error }) (*entity.LMSConnector, error) {
+	connector := &entity.LMSConnector{}
+	var typeStr string
+	err := row.Scan(
+		&connector.ID,
+		&connector.TenantID,
+		&connector.Name,
+		&typeStr,
+		&connector.EndpointURL,
+		&connector.EncryptedCredentials,
+		&connector.Enabled,
+		&connector.CreatedByUserID,
+		&connector.CreatedAt,
+		&connector.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	connector.Type, _ = valueobject.ParseLMSConnectorType(typeStr)
+	return connector, nil
+}
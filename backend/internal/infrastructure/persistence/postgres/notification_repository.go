@@ -253,6 +253,41 @@ func (r *NotificationRepository) MarkAllAsRead(ctx context.Context, userID uuid.
 	})
 }
 
+// MarkAsReadByFilter marks all of a user's notifications matching the filter as read.
+func (r *NotificationRepository) MarkAsReadByFilter(ctx context.Context, tenantID, userID uuid.UUID, filter entity.NotificationReadFilter) (int, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (int, error) {
+		query := `
+			UPDATE notifications
+			SET read = true, read_at = NOW()
+			WHERE tenant_id = $1 AND user_id = $2 AND read = false
+		`
+		args := []interface{}{tenantID, userID}
+
+		if filter.CourseID != nil {
+			args = append(args, *filter.CourseID)
+			query += fmt.Sprintf(" AND course_id = $%d", len(args))
+		}
+		if filter.Type != nil {
+			args = append(args, filter.Type.String())
+			query += fmt.Sprintf(" AND type = $%d", len(args))
+		}
+		if filter.OlderThan != nil {
+			args = append(args, *filter.OlderThan)
+			query += fmt.Sprintf(" AND created_at < $%d", len(args))
+		}
+
+		result, err := tx.ExecContext(ctx, query, args...)
+		if err != nil {
+			return 0, fmt.Errorf("failed to mark as read by filter: %w", err)
+		}
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return 0, fmt.Errorf("failed to get affected rows: %w", err)
+		}
+		return int(rows), nil
+	})
+}
+
 // Delete deletes a notification.
 func (r *NotificationRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
@@ -0,0 +1,50 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+)
+
+// OnboardingRepository implements repository.OnboardingRepository using PostgreSQL.
+type OnboardingRepository struct {
+	db *sql.DB
+}
+
+// NewOnboardingRepository creates a new PostgreSQL onboarding repository.
+func NewOnboardingRepository(db *sql.DB) repository.OnboardingRepository {
+	return &OnboardingRepository{db: db}
+}
+
+// GetProgress checks each onboarding milestone for a tenant.
+// Uses RLS to ensure proper tenant isolation.
+func (r *OnboardingRepository) GetProgress(ctx context.Context, tenantID uuid.UUID) (*entity.OnboardingProgress, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.OnboardingProgress, error) {
+		query := `
+			SELECT
+				EXISTS (SELECT 1 FROM tenant_ai_settings WHERE tenant_id = $1 AND encrypted_api_key IS NOT NULL),
+				EXISTS (SELECT 1 FROM sme_knowledge_chunks WHERE tenant_id = $1),
+				EXISTS (SELECT 1 FROM target_audience_templates WHERE tenant_id = $1 AND status = 'active'),
+				EXISTS (SELECT 1 FROM courses WHERE tenant_id = $1),
+				EXISTS (SELECT 1 FROM course_outlines WHERE tenant_id = $1 AND approval_status = 'approved'),
+				EXISTS (SELECT 1 FROM generation_jobs WHERE tenant_id = $1 AND type = 'full_course' AND status = 'completed')
+		`
+		progress := &entity.OnboardingProgress{}
+		err := tx.QueryRowContext(ctx, query, tenantID).Scan(
+			&progress.AISettingsConfigured,
+			&progress.SMEWithKnowledge,
+			&progress.TargetAudience,
+			&progress.CourseCreated,
+			&progress.OutlineApproved,
+			&progress.CourseGenerated,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get onboarding progress: %w", err)
+		}
+		return progress, nil
+	})
+}
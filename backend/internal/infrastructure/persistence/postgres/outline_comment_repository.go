@@ -0,0 +1,143 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+)
+
+// OutlineCommentRepository implements repository.OutlineCommentRepository using PostgreSQL.
+type OutlineCommentRepository struct {
+	db *sql.DB
+}
+
+// NewOutlineCommentRepository creates a new PostgreSQL outline comment repository.
+func NewOutlineCommentRepository(db *sql.DB) repository.OutlineCommentRepository {
+	return &OutlineCommentRepository{db: db}
+}
+
+// Create creates a new comment.
+func (r *OutlineCommentRepository) Create(ctx context.Context, comment *entity.OutlineComment) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		query := `
+			INSERT INTO outline_comments (tenant_id, course_id, lesson_key, author_user_id, body)
+			VALUES ($1, $2, $3, $4, $5)
+			RETURNING id, resolved, created_at
+		`
+		return tx.QueryRowContext(ctx, query,
+			comment.TenantID,
+			comment.CourseID,
+			comment.LessonKey,
+			comment.AuthorUserID,
+			comment.Body,
+		).Scan(&comment.ID, &comment.Resolved, &comment.CreatedAt)
+	})
+}
+
+// GetByID retrieves a comment by its ID, or nil if there is none.
+func (r *OutlineCommentRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.OutlineComment, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.OutlineComment, error) {
+		query := `
+			SELECT id, tenant_id, course_id, lesson_key, author_user_id, body,
+			       resolved, resolved_by_user_id, resolved_at, created_at
+			FROM outline_comments
+			WHERE id = $1
+		`
+		comment, err := scanOutlineComment(tx.QueryRowContext(ctx, query, id))
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get outline comment: %w", err)
+		}
+		return comment, nil
+	})
+}
+
+// ListByCourseID retrieves all comments on a course's outline lessons, oldest first.
+func (r *OutlineCommentRepository) ListByCourseID(ctx context.Context, courseID uuid.UUID) ([]*entity.OutlineComment, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.OutlineComment, error) {
+		query := `
+			SELECT id, tenant_id, course_id, lesson_key, author_user_id, body,
+			       resolved, resolved_by_user_id, resolved_at, created_at
+			FROM outline_comments
+			WHERE course_id = $1
+			ORDER BY created_at ASC
+		`
+		rows, err := tx.QueryContext(ctx, query, courseID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list outline comments: %w", err)
+		}
+		defer rows.Close()
+
+		var comments []*entity.OutlineComment
+		for rows.Next() {
+			comment, err := scanOutlineComment(rows)
+			if err != nil {
+				return nil, fmt.Errorf("failed to scan outline comment: %w", err)
+			}
+			comments = append(comments, comment)
+		}
+		if err := rows.Err(); err != nil {
+			return nil, fmt.Errorf("failed to list outline comments: %w", err)
+		}
+		return comments, nil
+	})
+}
+
+// Resolve marks a comment resolved by the given user.
+func (r *OutlineCommentRepository) Resolve(ctx context.Context, id uuid.UUID, userID uuid.UUID) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		query := `
+			UPDATE outline_comments
+			SET resolved = true, resolved_by_user_id = $2, resolved_at = NOW()
+			WHERE id = $1 AND resolved = false
+		`
+		if _, err := tx.ExecContext(ctx, query, id, userID); err != nil {
+			return fmt.Errorf("failed to resolve outline comment: %w", err)
+		}
+		return nil
+	})
+}
+
+// CountUnresolved counts the course's unresolved comments on the given lesson keys.
+func (r *OutlineCommentRepository) CountUnresolved(ctx context.Context, courseID uuid.UUID, lessonKeys []uuid.UUID) (int, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (int, error) {
+		query := `
+			SELECT COUNT(*)
+			FROM outline_comments
+			WHERE course_id = $1 AND lesson_key = ANY($2) AND resolved = false
+		`
+		var count int
+		if err := tx.QueryRowContext(ctx, query, courseID, pq.Array(lessonKeys)).Scan(&count); err != nil {
+			return 0, fmt.Errorf("failed to count unresolved outline comments: %w", err)
+		}
+		return count, nil
+	})
+}
+
+// scanOutlineComment scans one outline_comments row in the column order used above.
+func scanOutlineComment(row interface{ Scan(...any) error }) (*entity.OutlineComment, error) {
+	comment := &entity.OutlineComment{}
+	err := row.Scan(
+		&comment.ID,
+		&comment.TenantID,
+		&comment.CourseID,
+		&comment.LessonKey,
+		&comment.AuthorUserID,
+		&comment.Body,
+		&comment.Resolved,
+		&comment.ResolvedByUserID,
+		&comment.ResolvedAt,
+		&comment.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return comment, nil
+}
@@ -30,9 +30,9 @@ func (r *PendingRegistrationRepository) Create(ctx context.Context, pr *entity.P
 		query := `
 			INSERT INTO pending_registrations (
 				checkout_session_id, email, password_hash, first_name, last_name,
-				company_name, industry, team_size, plan, seat_count, status
+				company_name, industry, team_size, plan, seat_count, status, storage_region
 			)
-			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, NULLIF($12, ''))
 			RETURNING id, created_at, expires_at, updated_at
 		`
 		err := tx.QueryRowContext(ctx, query,
@@ -47,6 +47,7 @@ func (r *PendingRegistrationRepository) Create(ctx context.Context, pr *entity.P
 			pr.Plan.String(),
 			pr.SeatCount,
 			pr.Status.String(),
+			pr.StorageRegion,
 		).Scan(&pr.ID, &pr.CreatedAt, &pr.ExpiresAt, &pr.UpdatedAt)
 		if err != nil {
 			return fmt.Errorf("failed to create pending registration: %w", err)
@@ -60,7 +61,7 @@ func (r *PendingRegistrationRepository) GetByID(ctx context.Context, id uuid.UUI
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.PendingRegistration, error) {
 		query := `
 			SELECT id, checkout_session_id, email, password_hash, first_name, last_name,
-				company_name, industry, team_size, plan, seat_count, status,
+				company_name, industry, team_size, plan, seat_count, status, COALESCE(storage_region, ''),
 				stripe_customer_id, stripe_subscription_id, error_message,
 				created_at, expires_at, updated_at
 			FROM pending_registrations
@@ -75,7 +76,7 @@ func (r *PendingRegistrationRepository) GetByCheckoutSessionID(ctx context.Conte
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.PendingRegistration, error) {
 		query := `
 			SELECT id, checkout_session_id, email, password_hash, first_name, last_name,
-				company_name, industry, team_size, plan, seat_count, status,
+				company_name, industry, team_size, plan, seat_count, status, COALESCE(storage_region, ''),
 				stripe_customer_id, stripe_subscription_id, error_message,
 				created_at, expires_at, updated_at
 			FROM pending_registrations
@@ -90,7 +91,7 @@ func (r *PendingRegistrationRepository) GetByEmail(ctx context.Context, email st
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.PendingRegistration, error) {
 		query := `
 			SELECT id, checkout_session_id, email, password_hash, first_name, last_name,
-				company_name, industry, team_size, plan, seat_count, status,
+				company_name, industry, team_size, plan, seat_count, status, COALESCE(storage_region, ''),
 				stripe_customer_id, stripe_subscription_id, error_message,
 				created_at, expires_at, updated_at
 			FROM pending_registrations
@@ -102,12 +103,30 @@ func (r *PendingRegistrationRepository) GetByEmail(ctx context.Context, email st
 	})
 }
 
+// GetPaidByEmail retrieves the most recent registration for an email whose payment
+// was received (paid, provisioning or failed), or nil if there is none.
+func (r *PendingRegistrationRepository) GetPaidByEmail(ctx context.Context, email string) (*entity.PendingRegistration, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.PendingRegistration, error) {
+		query := `
+			SELECT id, checkout_session_id, email, password_hash, first_name, last_name,
+				company_name, industry, team_size, plan, seat_count, status, COALESCE(storage_region, ''),
+				stripe_customer_id, stripe_subscription_id, error_message,
+				created_at, expires_at, updated_at
+			FROM pending_registrations
+			WHERE LOWER(email) = LOWER($1) AND status IN ('paid', 'provisioning', 'failed')
+			ORDER BY created_at DESC
+			LIMIT 1
+		`
+		return r.scanOneTx(tx.QueryRowContext(ctx, query, email))
+	})
+}
+
 // ListByStatus retrieves all pending registrations with a given status.
 func (r *PendingRegistrationRepository) ListByStatus(ctx context.Context, status valueobject.PendingRegistrationStatus) ([]*entity.PendingRegistration, error) {
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.PendingRegistration, error) {
 		query := `
 			SELECT id, checkout_session_id, email, password_hash, first_name, last_name,
-				company_name, industry, team_size, plan, seat_count, status,
+				company_name, industry, team_size, plan, seat_count, status, COALESCE(storage_region, ''),
 				stripe_customer_id, stripe_subscription_id, error_message,
 				created_at, expires_at, updated_at
 			FROM pending_registrations
@@ -142,7 +161,7 @@ func (r *PendingRegistrationRepository) FindStuckPaid(ctx context.Context, older
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.PendingRegistration, error) {
 		query := `
 			SELECT id, checkout_session_id, email, password_hash, first_name, last_name,
-				company_name, industry, team_size, plan, seat_count, status,
+				company_name, industry, team_size, plan, seat_count, status, COALESCE(storage_region, ''),
 				stripe_customer_id, stripe_subscription_id, error_message,
 				created_at, expires_at, updated_at
 			FROM pending_registrations
@@ -256,6 +275,7 @@ func (r *PendingRegistrationRepository) scanOneTx(row *sql.Row) (*entity.Pending
 		&planStr,
 		&pr.SeatCount,
 		&statusStr,
+		&pr.StorageRegion,
 		&pr.StripeCustomerID,
 		&pr.StripeSubscriptionID,
 		&pr.ErrorMessage,
@@ -291,6 +311,7 @@ func (r *PendingRegistrationRepository) scanRow(rows *sql.Rows) (*entity.Pending
 		&planStr,
 		&pr.SeatCount,
 		&statusStr,
+		&pr.StorageRegion,
 		&pr.StripeCustomerID,
 		&pr.StripeSubscriptionID,
 		&pr.ErrorMessage,
@@ -5,9 +5,13 @@ import (
 	"database/sql"
 	"fmt"
 
+	"github.com/sogos/mirai-backend/internal/domain/repository"
 	"github.com/sogos/mirai-backend/internal/domain/tenant"
 )
 
+// Context key for a transaction shared by repository calls
+type txKey struct{}
+
 // SetTenantContext sets PostgreSQL session variables for Row-Level Security.
 // This should be called within a transaction to ensure the settings apply
 // only to that transaction's queries (using SET LOCAL).
@@ -42,7 +46,15 @@ func SetTenantContext(ctx context.Context, tx *sql.Tx) error {
 // RLSExec executes a function within a transaction with RLS context set.
 // This is a convenience wrapper that handles transaction lifecycle and
 // sets the tenant context before executing the provided function.
+// Inside Transactor.WithinTx the function runs in the shared transaction.
 func RLSExec(ctx context.Context, db *sql.DB, fn func(tx *sql.Tx) error) error {
+	if tx, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		if err := SetTenantContext(ctx, tx); err != nil {
+			return err
+		}
+		return fn(tx)
+	}
+
 	tx, err := db.BeginTx(ctx, nil)
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %w", err)
@@ -69,6 +81,13 @@ func RLSExec(ctx context.Context, db *sql.DB, fn func(tx *sql.Tx) error) error {
 func RLSQuery[T any](ctx context.Context, db *sql.DB, fn func(tx *sql.Tx) (T, error)) (T, error) {
 	var result T
 
+	if tx, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		if err := SetTenantContext(ctx, tx); err != nil {
+			return result, err
+		}
+		return fn(tx)
+	}
+
 	tx, err := db.BeginTx(ctx, nil)
 	if err != nil {
 		return result, fmt.Errorf("failed to begin transaction: %w", err)
@@ -90,3 +109,39 @@ func RLSQuery[T any](ctx context.Context, db *sql.DB, fn func(tx *sql.Tx) (T, er
 
 	return result, nil
 }
+
+// Transactor implements repository.Transactor by sharing one transaction
+// between the RLSExec and RLSQuery calls made with its context.
+type Transactor struct {
+	db *sql.DB
+}
+
+// NewTransactor creates a new PostgreSQL transactor.
+func NewTransactor(db *sql.DB) repository.Transactor {
+	return &Transactor{db: db}
+}
+
+// WithinTx calls fn with a context carrying a shared transaction.
+// SET LOCAL settings last until commit, so a superadmin call inside fn
+// lifts RLS for the calls after it as well.
+func (t *Transactor) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := t.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
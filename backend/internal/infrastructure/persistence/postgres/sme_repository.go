@@ -3,7 +3,10 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/lib/pq"
@@ -49,7 +52,8 @@ func (r *SMERepository) Create(ctx context.Context, sme *entity.SubjectMatterExp
 func (r *SMERepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.SubjectMatterExpert, error) {
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.SubjectMatterExpert, error) {
 		query := `
-			SELECT id, tenant_id, company_id, name, description, domain, scope, status, knowledge_summary, knowledge_content_path, created_by_user_id, created_at, updated_at
+			SELECT id, tenant_id, company_id, name, description, domain, scope, status, knowledge_summary, knowledge_content_path, created_by_user_id, created_at, updated_at,
+				COALESCE((SELECT u.is_active FROM users u WHERE u.id = subject_matter_experts.created_by_user_id), TRUE)
 			FROM subject_matter_experts
 			WHERE id = $1
 		`
@@ -69,6 +73,7 @@ func (r *SMERepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Subj
 			&sme.CreatedByUserID,
 			&sme.CreatedAt,
 			&sme.UpdatedAt,
+			&sme.CreatorActive,
 		)
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -115,7 +120,8 @@ func getTeamIDsInTx(ctx context.Context, tx *sql.Tx, smeID uuid.UUID) ([]uuid.UU
 func (r *SMERepository) List(ctx context.Context, opts entity.SMEListOptions) ([]*entity.SubjectMatterExpert, error) {
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.SubjectMatterExpert, error) {
 		query := `
-			SELECT DISTINCT s.id, s.tenant_id, s.company_id, s.name, s.description, s.domain, s.scope, s.status, s.knowledge_summary, s.knowledge_content_path, s.created_by_user_id, s.created_at, s.updated_at
+			SELECT DISTINCT s.id, s.tenant_id, s.company_id, s.name, s.description, s.domain, s.scope, s.status, s.knowledge_summary, s.knowledge_content_path, s.created_by_user_id, s.created_at, s.updated_at,
+				COALESCE((SELECT u.is_active FROM users u WHERE u.id = s.created_by_user_id), TRUE)
 			FROM subject_matter_experts s
 		`
 		args := []interface{}{}
@@ -146,6 +152,12 @@ func (r *SMERepository) List(ctx context.Context, opts entity.SMEListOptions) ([
 			argIndex++
 		}
 
+		if opts.SummaryLongerThan > 0 {
+			query += fmt.Sprintf(" AND char_length(s.knowledge_summary) > $%d", argIndex)
+			args = append(args, opts.SummaryLongerThan)
+			argIndex++
+		}
+
 		query += " ORDER BY s.created_at DESC"
 
 		rows, err := tx.QueryContext(ctx, query, args...)
@@ -172,6 +184,7 @@ func (r *SMERepository) List(ctx context.Context, opts entity.SMEListOptions) ([
 				&sme.CreatedByUserID,
 				&sme.CreatedAt,
 				&sme.UpdatedAt,
+				&sme.CreatorActive,
 			); err != nil {
 				return nil, fmt.Errorf("failed to scan SME: %w", err)
 			}
@@ -271,6 +284,212 @@ func (r *SMERepository) ListTeamAccess(ctx context.Context, smeID uuid.UUID) ([]
 	})
 }
 
+// GetStats retrieves aggregate contribution stats for all SMEs in the tenant.
+// Each aggregate is a single grouped query, so cost doesn't grow with the number of SMEs.
+func (r *SMERepository) GetStats(ctx context.Context) ([]*entity.SMEStats, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.SMEStats, error) {
+		statsByID := make(map[uuid.UUID]*entity.SMEStats)
+		var order []uuid.UUID
+		get := func(smeID uuid.UUID) *entity.SMEStats {
+			st, ok := statsByID[smeID]
+			if !ok {
+				st = &entity.SMEStats{SMEID: smeID, TaskCounts: make(map[valueobject.SMETaskStatus]int)}
+				statsByID[smeID] = st
+				order = append(order, smeID)
+			}
+			return st
+		}
+
+		// Tasks by status
+		rows, err := tx.QueryContext(ctx, `
+			SELECT sme_id, status, COUNT(*)
+			FROM sme_tasks
+			GROUP BY sme_id, status
+		`)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count SME tasks: %w", err)
+		}
+		for rows.Next() {
+			var smeID uuid.UUID
+			var statusStr string
+			var count int
+			if err := rows.Scan(&smeID, &statusStr, &count); err != nil {
+				rows.Close()
+				return nil, fmt.Errorf("failed to scan SME task count: %w", err)
+			}
+			status, _ := valueobject.ParseSMETaskStatus(statusStr)
+			get(smeID).TaskCounts[status] += count
+		}
+		rows.Close()
+
+		// Submission ingestion outcomes
+		rows, err = tx.QueryContext(ctx, `
+			SELECT t.sme_id,
+				COUNT(*) FILTER (WHERE sub.processed_at IS NOT NULL AND sub.ingestion_error IS NULL),
+				COUNT(*) FILTER (WHERE sub.ingestion_error IS NOT NULL),
+				COALESCE(SUM(LENGTH(sub.extracted_text)), 0),
+				MAX(sub.processed_at)
+			FROM sme_task_submissions sub
+			JOIN sme_tasks t ON t.id = sub.task_id
+			GROUP BY t.sme_id
+		`)
+		if err != nil {
+			return nil, fmt.Errorf("failed to aggregate SME submissions: %w", err)
+		}
+		for rows.Next() {
+			var smeID uuid.UUID
+			var processed, failed int
+			var chars int64
+			var lastProcessed sql.NullTime
+			if err := rows.Scan(&smeID, &processed, &failed, &chars, &lastProcessed); err != nil {
+				rows.Close()
+				return nil, fmt.Errorf("failed to scan SME submission stats: %w", err)
+			}
+			st := get(smeID)
+			st.SubmissionsProcessed = processed
+			st.SubmissionsFailed = failed
+			st.ExtractedCharacters = chars
+			if lastProcessed.Valid {
+				st.LastIngestedAt = &lastProcessed.Time
+			}
+		}
+		rows.Close()
+
+		// Knowledge chunks
+		rows, err = tx.QueryContext(ctx, `
+			SELECT sme_id, COUNT(*), MAX(created_at)
+			FROM sme_knowledge_chunks
+			GROUP BY sme_id
+		`)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count SME knowledge chunks: %w", err)
+		}
+		for rows.Next() {
+			var smeID uuid.UUID
+			var count int
+			var lastChunk time.Time
+			if err := rows.Scan(&smeID, &count, &lastChunk); err != nil {
+				rows.Close()
+				return nil, fmt.Errorf("failed to scan SME chunk count: %w", err)
+			}
+			st := get(smeID)
+			st.ChunkCount = count
+			// Approved knowledge may be added after ingestion, so take the latest of both
+			if st.LastIngestedAt == nil || lastChunk.After(*st.LastIngestedAt) {
+				st.LastIngestedAt = &lastChunk
+			}
+		}
+		rows.Close()
+
+		// Courses whose generation inputs reference the SME
+		rows, err = tx.QueryContext(ctx, `
+			SELECT ref.sme_id, COUNT(DISTINCT cgi.course_id)
+			FROM course_generation_inputs cgi
+			CROSS JOIN LATERAL unnest(cgi.sme_ids) AS ref(sme_id)
+			GROUP BY ref.sme_id
+		`)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count SME course references: %w", err)
+		}
+		for rows.Next() {
+			var smeID uuid.UUID
+			var count int
+			if err := rows.Scan(&smeID, &count); err != nil {
+				rows.Close()
+				return nil, fmt.Errorf("failed to scan SME course count: %w", err)
+			}
+			get(smeID).CourseCount = count
+		}
+		rows.Close()
+
+		stats := make([]*entity.SMEStats, 0, len(order))
+		for _, smeID := range order {
+			stats = append(stats, statsByID[smeID])
+		}
+		return stats, nil
+	})
+}
+
+// GetDeletionImpact counts what depends on an SME, listing up to recentLimit course titles.
+func (r *SMERepository) GetDeletionImpact(ctx context.Context, smeID uuid.UUID, recentLimit int) (*entity.SMEDeletionImpact, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.SMEDeletionImpact, error) {
+		impact := &entity.SMEDeletionImpact{}
+		err := tx.QueryRowContext(ctx, `
+			SELECT
+				(SELECT COUNT(*) FROM sme_knowledge_chunks WHERE sme_id = $1),
+				(SELECT COUNT(*) FROM sme_tasks WHERE sme_id = $1 AND status NOT IN ('completed', 'failed', 'cancelled')),
+				(SELECT COUNT(*) FROM sme_task_submissions sub JOIN sme_tasks t ON t.id = sub.task_id WHERE t.sme_id = $1),
+				(SELECT COUNT(DISTINCT course_id) FROM course_generation_inputs WHERE $1 = ANY(sme_ids))
+		`, smeID).Scan(&impact.KnowledgeChunks, &impact.PendingTasks, &impact.Submissions, &impact.Courses)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count SME dependents: %w", err)
+		}
+		if impact.Courses == 0 || recentLimit <= 0 {
+			return impact, nil
+		}
+
+		rows, err := tx.QueryContext(ctx, `
+			SELECT c.title
+			FROM courses c
+			WHERE EXISTS (SELECT 1 FROM course_generation_inputs cgi WHERE cgi.course_id = c.id AND $1 = ANY(cgi.sme_ids))
+			ORDER BY c.updated_at DESC
+			LIMIT $2
+		`, smeID, recentLimit)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list SME courses: %w", err)
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var title string
+			if err := rows.Scan(&title); err != nil {
+				return nil, fmt.Errorf("failed to scan course title: %w", err)
+			}
+			impact.RecentCourseTitles = append(impact.RecentCourseTitles, title)
+		}
+		if err := rows.Err(); err != nil {
+			return nil, fmt.Errorf("failed to list SME courses: %w", err)
+		}
+		return impact, nil
+	})
+}
+
+// CreateDeletionToken stores a deletion confirmation token.
+func (r *SMERepository) CreateDeletionToken(ctx context.Context, token *entity.SMEDeletionToken) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO sme_deletion_tokens (token, tenant_id, sme_id, user_id, expires_at)
+			VALUES ($1, $2, $3, $4, $5)
+		`, token.Token, token.TenantID, token.SMEID, token.UserID, token.ExpiresAt)
+		return err
+	})
+}
+
+// ConsumeDeletionToken removes and returns a deletion confirmation token, so each token
+// confirms at most one deletion. Expired tokens for the same SME are cleared as well.
+func (r *SMERepository) ConsumeDeletionToken(ctx context.Context, token string) (*entity.SMEDeletionToken, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.SMEDeletionToken, error) {
+		t := &entity.SMEDeletionToken{}
+		err := tx.QueryRowContext(ctx, `
+			DELETE FROM sme_deletion_tokens
+			WHERE token = $1
+			RETURNING token, tenant_id, sme_id, user_id, expires_at
+		`, token).Scan(&t.Token, &t.TenantID, &t.SMEID, &t.UserID, &t.ExpiresAt)
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := tx.ExecContext(ctx, `
+			DELETE FROM sme_deletion_tokens WHERE sme_id = $1 AND expires_at < NOW()
+		`, t.SMEID); err != nil {
+			return nil, err
+		}
+		return t, nil
+	})
+}
+
 // SMETaskRepository implements repository.SMETaskRepository using PostgreSQL.
 type SMETaskRepository struct {
 	db *sql.DB
@@ -283,10 +502,15 @@ func NewSMETaskRepository(db *sql.DB) repository.SMETaskRepository {
 
 // Create creates a new task.
 func (r *SMETaskRepository) Create(ctx context.Context, task *entity.SMETask) error {
+	questionsJSON, err := marshalTaskQuestions(task.Questions)
+	if err != nil {
+		return err
+	}
+
 	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
 		query := `
-			INSERT INTO sme_tasks (tenant_id, sme_id, title, description, expected_content_type, assigned_to_user_id, assigned_by_user_id, team_id, status, due_date)
-			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+			INSERT INTO sme_tasks (tenant_id, sme_id, title, description, expected_content_type, assigned_to_user_id, assigned_by_user_id, team_id, status, due_date, questions)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
 			RETURNING id, created_at, updated_at
 		`
 		var contentType *string
@@ -305,6 +529,7 @@ func (r *SMETaskRepository) Create(ctx context.Context, task *entity.SMETask) er
 			task.TeamID,
 			task.Status.String(),
 			task.DueDate,
+			questionsJSON,
 		).Scan(&task.ID, &task.CreatedAt, &task.UpdatedAt)
 	})
 }
@@ -313,13 +538,14 @@ func (r *SMETaskRepository) Create(ctx context.Context, task *entity.SMETask) er
 func (r *SMETaskRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.SMETask, error) {
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.SMETask, error) {
 		query := `
-			SELECT id, tenant_id, sme_id, title, description, expected_content_type, assigned_to_user_id, assigned_by_user_id, team_id, status, due_date, created_at, updated_at, completed_at
+			SELECT id, tenant_id, sme_id, title, description, expected_content_type, assigned_to_user_id, assigned_by_user_id, team_id, status, due_date, created_at, updated_at, completed_at, questions
 			FROM sme_tasks
 			WHERE id = $1
 		`
 		task := &entity.SMETask{}
 		var statusStr string
 		var contentTypeStr *string
+		var questionsJSON []byte
 		err := tx.QueryRowContext(ctx, query, id).Scan(
 			&task.ID,
 			&task.TenantID,
@@ -335,6 +561,7 @@ func (r *SMETaskRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.
 			&task.CreatedAt,
 			&task.UpdatedAt,
 			&task.CompletedAt,
+			&questionsJSON,
 		)
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -342,6 +569,9 @@ func (r *SMETaskRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.
 		if err != nil {
 			return nil, fmt.Errorf("failed to get task: %w", err)
 		}
+		if err := json.Unmarshal(questionsJSON, &task.Questions); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal task questions: %w", err)
+		}
 		task.Status, _ = valueobject.ParseSMETaskStatus(statusStr)
 		if contentTypeStr != nil {
 			ct, _ := valueobject.ParseContentType(*contentTypeStr)
@@ -355,7 +585,7 @@ func (r *SMETaskRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.
 func (r *SMETaskRepository) List(ctx context.Context, opts entity.SMETaskListOptions) ([]*entity.SMETask, error) {
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.SMETask, error) {
 		query := `
-			SELECT id, tenant_id, sme_id, title, description, expected_content_type, assigned_to_user_id, assigned_by_user_id, team_id, status, due_date, created_at, updated_at, completed_at
+			SELECT id, tenant_id, sme_id, title, description, expected_content_type, assigned_to_user_id, assigned_by_user_id, team_id, status, due_date, created_at, updated_at, completed_at, questions
 			FROM sme_tasks
 			WHERE 1=1
 		`
@@ -374,6 +604,12 @@ func (r *SMETaskRepository) List(ctx context.Context, opts entity.SMETaskListOpt
 			argIndex++
 		}
 
+		if opts.TeamID != nil {
+			query += fmt.Sprintf(" AND team_id = $%d", argIndex)
+			args = append(args, *opts.TeamID)
+			argIndex++
+		}
+
 		if opts.Status != nil {
 			query += fmt.Sprintf(" AND status = $%d", argIndex)
 			args = append(args, opts.Status.String())
@@ -393,6 +629,7 @@ func (r *SMETaskRepository) List(ctx context.Context, opts entity.SMETaskListOpt
 			task := &entity.SMETask{}
 			var statusStr string
 			var contentTypeStr *string
+			var questionsJSON []byte
 			if err := rows.Scan(
 				&task.ID,
 				&task.TenantID,
@@ -408,9 +645,13 @@ func (r *SMETaskRepository) List(ctx context.Context, opts entity.SMETaskListOpt
 				&task.CreatedAt,
 				&task.UpdatedAt,
 				&task.CompletedAt,
+				&questionsJSON,
 			); err != nil {
 				return nil, fmt.Errorf("failed to scan task: %w", err)
 			}
+			if err := json.Unmarshal(questionsJSON, &task.Questions); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal task questions: %w", err)
+			}
 			task.Status, _ = valueobject.ParseSMETaskStatus(statusStr)
 			if contentTypeStr != nil {
 				ct, _ := valueobject.ParseContentType(*contentTypeStr)
@@ -424,11 +665,17 @@ func (r *SMETaskRepository) List(ctx context.Context, opts entity.SMETaskListOpt
 
 // Update updates a task.
 func (r *SMETaskRepository) Update(ctx context.Context, task *entity.SMETask) error {
+	questionsJSON, err := marshalTaskQuestions(task.Questions)
+	if err != nil {
+		return err
+	}
+
 	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
 		query := `
 			UPDATE sme_tasks
-			SET title = $1, description = $2, expected_content_type = $3, due_date = $4, status = $5, completed_at = $6, updated_at = NOW()
-			WHERE id = $7
+			SET title = $1, description = $2, expected_content_type = $3, due_date = $4, status = $5, completed_at = $6, team_id = $7, assigned_to_user_id = $8,
+			    questions = $9, updated_at = NOW()
+			WHERE id = $10
 			RETURNING updated_at
 		`
 		var contentType *string
@@ -443,11 +690,26 @@ func (r *SMETaskRepository) Update(ctx context.Context, task *entity.SMETask) er
 			task.DueDate,
 			task.Status.String(),
 			task.CompletedAt,
+			task.TeamID,
+			task.AssignedToUserID,
+			questionsJSON,
 			task.ID,
 		).Scan(&task.UpdatedAt)
 	})
 }
 
+// marshalTaskQuestions encodes a task's questions, storing no questions as an empty array.
+func marshalTaskQuestions(questions []string) ([]byte, error) {
+	if questions == nil {
+		questions = []string{}
+	}
+	data, err := json.Marshal(questions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal task questions: %w", err)
+	}
+	return data, nil
+}
+
 // Cancel cancels a pending task.
 func (r *SMETaskRepository) Cancel(ctx context.Context, id uuid.UUID) error {
 	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
@@ -467,6 +729,37 @@ func (r *SMETaskRepository) Cancel(ctx context.Context, id uuid.UUID) error {
 	})
 }
 
+// CountOpenByTeamMembers counts the open tasks assigned to a team's members, by status.
+func (r *SMETaskRepository) CountOpenByTeamMembers(ctx context.Context, teamID uuid.UUID) ([]entity.SMETaskStatusCount, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]entity.SMETaskStatusCount, error) {
+		query := `
+			SELECT status, COUNT(*), COUNT(*) FILTER (WHERE due_date < NOW())
+			FROM sme_tasks
+			WHERE assigned_to_user_id IN (SELECT user_id FROM team_members WHERE team_id = $1)
+			  AND status NOT IN ('completed', 'cancelled')
+			GROUP BY status
+			ORDER BY status
+		`
+		rows, err := tx.QueryContext(ctx, query, teamID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count team tasks: %w", err)
+		}
+		defer rows.Close()
+
+		var counts []entity.SMETaskStatusCount
+		for rows.Next() {
+			var c entity.SMETaskStatusCount
+			var statusStr string
+			if err := rows.Scan(&statusStr, &c.Count, &c.Overdue); err != nil {
+				return nil, fmt.Errorf("failed to scan team task count: %w", err)
+			}
+			c.Status, _ = valueobject.ParseSMETaskStatus(statusStr)
+			counts = append(counts, c)
+		}
+		return counts, rows.Err()
+	})
+}
+
 // Delete permanently deletes a task.
 func (r *SMETaskRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
@@ -559,17 +852,58 @@ func (r *SMESubmissionRepository) GetByID(ctx context.Context, id uuid.UUID) (*e
 	})
 }
 
-// ListByTaskID retrieves all submissions for a task.
-func (r *SMESubmissionRepository) ListByTaskID(ctx context.Context, taskID uuid.UUID) ([]*entity.SMETaskSubmission, error) {
+// submissionStatusConditions mirrors entity.SMETaskSubmission.Status in SQL.
+var submissionStatusConditions = map[valueobject.SubmissionStatus]string{
+	valueobject.SubmissionStatusApproved:      "is_approved",
+	valueobject.SubmissionStatusFailed:        "NOT is_approved AND ingestion_error IS NOT NULL",
+	valueobject.SubmissionStatusProcessed:     "NOT is_approved AND ingestion_error IS NULL AND processed_at IS NOT NULL",
+	valueobject.SubmissionStatusPendingReview: "NOT is_approved AND ingestion_error IS NULL AND processed_at IS NULL",
+}
+
+// List retrieves a page of a task's submissions, newest first.
+func (r *SMESubmissionRepository) List(ctx context.Context, opts entity.SMESubmissionListOptions) ([]*entity.SMETaskSubmission, error) {
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.SMETaskSubmission, error) {
 		query := `
 			SELECT id, tenant_id, task_id, file_name, file_path, content_type, file_size_bytes, extracted_text, ai_summary, ingestion_error, submitted_by_user_id, submitted_at, processed_at,
 				reviewer_notes, approved_content, is_approved, approved_at, approved_by_user_id
 			FROM sme_task_submissions
 			WHERE task_id = $1
-			ORDER BY submitted_at DESC
 		`
-		rows, err := tx.QueryContext(ctx, query, taskID)
+		args := []interface{}{opts.TaskID}
+		argIndex := 2
+
+		if opts.Status != nil {
+			condition, ok := submissionStatusConditions[*opts.Status]
+			if !ok {
+				return nil, fmt.Errorf("invalid submission status: %s", *opts.Status)
+			}
+			query += " AND " + condition
+		}
+
+		// Cursor-based pagination using "timestamp|id" format
+		if opts.Cursor != nil {
+			parts := strings.SplitN(*opts.Cursor, "|", 2)
+			if len(parts) == 2 {
+				cursorTime, timeErr := time.Parse(time.RFC3339Nano, parts[0])
+				cursorID, idErr := uuid.Parse(parts[1])
+				if timeErr == nil && idErr == nil {
+					query += fmt.Sprintf(" AND (submitted_at, id) < ($%d, $%d)", argIndex, argIndex+1)
+					args = append(args, cursorTime, cursorID)
+					argIndex += 2
+				}
+			}
+		}
+
+		query += " ORDER BY submitted_at DESC, id DESC"
+
+		limit := opts.Limit
+		if limit <= 0 {
+			limit = 50
+		}
+		query += fmt.Sprintf(" LIMIT $%d", argIndex)
+		args = append(args, limit)
+
+		rows, err := tx.QueryContext(ctx, query, args...)
 		if err != nil {
 			return nil, fmt.Errorf("failed to list submissions: %w", err)
 		}
@@ -604,7 +938,45 @@ func (r *SMESubmissionRepository) ListByTaskID(ctx context.Context, taskID uuid.
 			sub.ContentType, _ = valueobject.ParseContentType(contentTypeStr)
 			submissions = append(submissions, sub)
 		}
-		return submissions, nil
+		return submissions, rows.Err()
+	})
+}
+
+// GetSummary aggregates a task's submissions by status.
+func (r *SMESubmissionRepository) GetSummary(ctx context.Context, taskID uuid.UUID) (*entity.SMESubmissionSummary, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.SMESubmissionSummary, error) {
+		statuses := []valueobject.SubmissionStatus{
+			valueobject.SubmissionStatusPendingReview,
+			valueobject.SubmissionStatusProcessed,
+			valueobject.SubmissionStatusFailed,
+			valueobject.SubmissionStatusApproved,
+		}
+
+		query := "SELECT COUNT(*), MAX(submitted_at)"
+		for _, status := range statuses {
+			query += ", COUNT(*) FILTER (WHERE " + submissionStatusConditions[status] + ")"
+		}
+		query += " FROM sme_task_submissions WHERE task_id = $1"
+
+		summary := &entity.SMESubmissionSummary{
+			TaskID:       taskID,
+			StatusCounts: make(map[valueobject.SubmissionStatus]int, len(statuses)),
+		}
+		counts := make([]int, len(statuses))
+		dest := []interface{}{&summary.TotalCount, &summary.LatestSubmittedAt}
+		for i := range counts {
+			dest = append(dest, &counts[i])
+		}
+		if err := tx.QueryRowContext(ctx, query, taskID).Scan(dest...); err != nil {
+			return nil, fmt.Errorf("failed to summarize submissions: %w", err)
+		}
+
+		for i, status := range statuses {
+			if counts[i] > 0 {
+				summary.StatusCounts[status] = counts[i]
+			}
+		}
+		return summary, nil
 	})
 }
 
@@ -614,8 +986,9 @@ func (r *SMESubmissionRepository) Update(ctx context.Context, submission *entity
 		query := `
 			UPDATE sme_task_submissions
 			SET extracted_text = $1, ai_summary = $2, ingestion_error = $3, processed_at = $4,
-				reviewer_notes = $5, approved_content = $6, is_approved = $7, approved_at = $8, approved_by_user_id = $9
-			WHERE id = $10
+				reviewer_notes = $5, approved_content = $6, is_approved = $7, approved_at = $8, approved_by_user_id = $9,
+				file_name = $10, file_path = $11
+			WHERE id = $12
 		`
 		_, err := tx.ExecContext(ctx, query,
 			submission.ExtractedText,
@@ -627,6 +1000,8 @@ func (r *SMESubmissionRepository) Update(ctx context.Context, submission *entity
 			submission.IsApproved,
 			submission.ApprovedAt,
 			submission.ApprovedByUserID,
+			submission.FileName,
+			submission.FilePath,
 			submission.ID,
 		)
 		return err
@@ -647,8 +1022,8 @@ func NewSMEKnowledgeRepository(db *sql.DB) repository.SMEKnowledgeRepository {
 func (r *SMEKnowledgeRepository) Create(ctx context.Context, chunk *entity.SMEKnowledgeChunk) error {
 	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
 		query := `
-			INSERT INTO sme_knowledge_chunks (tenant_id, sme_id, submission_id, content, topic, keywords, relevance_score)
-			VALUES ($1, $2, $3, $4, $5, $6, $7)
+			INSERT INTO sme_knowledge_chunks (tenant_id, sme_id, submission_id, content, topic, keywords, relevance_score, injection_flagged, injection_reason)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 			RETURNING id, created_at
 		`
 		return tx.QueryRowContext(ctx, query,
@@ -659,52 +1034,80 @@ func (r *SMEKnowledgeRepository) Create(ctx context.Context, chunk *entity.SMEKn
 			chunk.Topic,
 			pq.Array(chunk.Keywords),
 			chunk.RelevanceScore,
+			chunk.InjectionFlagged,
+			chunk.InjectionReason,
 		).Scan(&chunk.ID, &chunk.CreatedAt)
 	})
 }
 
+// knowledgeChunkColumns lists the columns scanKnowledgeChunk reads, in order.
+const knowledgeChunkColumns = `id, tenant_id, sme_id, submission_id, content, topic, keywords, relevance_score,
+			injection_flagged, injection_reason, injection_released_by_user_id, injection_released_at, created_at`
+
+// scanKnowledgeChunk scans a row selecting knowledgeChunkColumns.
+func scanKnowledgeChunk(row interface{ Scan(...any) error }) (*entity.SMEKnowledgeChunk, error) {
+	chunk := &entity.SMEKnowledgeChunk{}
+	var keywords pq.StringArray
+	if err := row.Scan(
+		&chunk.ID,
+		&chunk.TenantID,
+		&chunk.SMEID,
+		&chunk.SubmissionID,
+		&chunk.Content,
+		&chunk.Topic,
+		&keywords,
+		&chunk.RelevanceScore,
+		&chunk.InjectionFlagged,
+		&chunk.InjectionReason,
+		&chunk.InjectionReleasedByUserID,
+		&chunk.InjectionReleasedAt,
+		&chunk.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+	chunk.Keywords = []string(keywords)
+	return chunk, nil
+}
+
 // GetByID retrieves a chunk by its ID.
 func (r *SMEKnowledgeRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.SMEKnowledgeChunk, error) {
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.SMEKnowledgeChunk, error) {
 		query := `
-			SELECT id, tenant_id, sme_id, submission_id, content, topic, keywords, relevance_score, created_at
+			SELECT ` + knowledgeChunkColumns + `
 			FROM sme_knowledge_chunks
 			WHERE id = $1
 		`
-		chunk := &entity.SMEKnowledgeChunk{}
-		var keywords pq.StringArray
-		err := tx.QueryRowContext(ctx, query, id).Scan(
-			&chunk.ID,
-			&chunk.TenantID,
-			&chunk.SMEID,
-			&chunk.SubmissionID,
-			&chunk.Content,
-			&chunk.Topic,
-			&keywords,
-			&chunk.RelevanceScore,
-			&chunk.CreatedAt,
-		)
+		chunk, err := scanKnowledgeChunk(tx.QueryRowContext(ctx, query, id))
 		if err == sql.ErrNoRows {
 			return nil, nil
 		}
 		if err != nil {
 			return nil, fmt.Errorf("failed to get chunk: %w", err)
 		}
-		chunk.Keywords = []string(keywords)
 		return chunk, nil
 	})
 }
 
 // ListBySMEID retrieves all chunks for an SME.
 func (r *SMEKnowledgeRepository) ListBySMEID(ctx context.Context, smeID uuid.UUID) ([]*entity.SMEKnowledgeChunk, error) {
+	return r.listChunks(ctx, "sme_id = $1", smeID)
+}
+
+// ListBySMEIDAndTopic retrieves an SME's chunks filed under a topic.
+func (r *SMEKnowledgeRepository) ListBySMEIDAndTopic(ctx context.Context, smeID uuid.UUID, topic string) ([]*entity.SMEKnowledgeChunk, error) {
+	return r.listChunks(ctx, "sme_id = $1 AND topic = $2", smeID, topic)
+}
+
+// listChunks retrieves the chunks matching the condition, most relevant first.
+func (r *SMEKnowledgeRepository) listChunks(ctx context.Context, condition string, args ...any) ([]*entity.SMEKnowledgeChunk, error) {
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.SMEKnowledgeChunk, error) {
 		query := `
-			SELECT id, tenant_id, sme_id, submission_id, content, topic, keywords, relevance_score, created_at
+			SELECT ` + knowledgeChunkColumns + `
 			FROM sme_knowledge_chunks
-			WHERE sme_id = $1
+			WHERE ` + condition + `
 			ORDER BY relevance_score DESC
 		`
-		rows, err := tx.QueryContext(ctx, query, smeID)
+		rows, err := tx.QueryContext(ctx, query, args...)
 		if err != nil {
 			return nil, fmt.Errorf("failed to list chunks: %w", err)
 		}
@@ -712,33 +1115,132 @@ func (r *SMEKnowledgeRepository) ListBySMEID(ctx context.Context, smeID uuid.UUI
 
 		var chunks []*entity.SMEKnowledgeChunk
 		for rows.Next() {
-			chunk := &entity.SMEKnowledgeChunk{}
-			var keywords pq.StringArray
-			if err := rows.Scan(
-				&chunk.ID,
-				&chunk.TenantID,
-				&chunk.SMEID,
-				&chunk.SubmissionID,
-				&chunk.Content,
-				&chunk.Topic,
-				&keywords,
-				&chunk.RelevanceScore,
-				&chunk.CreatedAt,
-			); err != nil {
+			chunk, err := scanKnowledgeChunk(rows)
+			if err != nil {
 				return nil, fmt.Errorf("failed to scan chunk: %w", err)
 			}
-			chunk.Keywords = []string(keywords)
 			chunks = append(chunks, chunk)
 		}
 		return chunks, nil
 	})
 }
 
+// ListTopics returns an SME's distinct topics with chunk counts, largest first.
+func (r *SMEKnowledgeRepository) ListTopics(ctx context.Context, smeID uuid.UUID) ([]*entity.SMEKnowledgeTopic, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.SMEKnowledgeTopic, error) {
+		query := `
+			SELECT topic, COUNT(*)
+			FROM sme_knowledge_chunks
+			WHERE sme_id = $1
+			GROUP BY topic
+			ORDER BY COUNT(*) DESC, topic
+		`
+		rows, err := tx.QueryContext(ctx, query, smeID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list topics: %w", err)
+		}
+		defer rows.Close()
+
+		var topics []*entity.SMEKnowledgeTopic
+		for rows.Next() {
+			topic := &entity.SMEKnowledgeTopic{}
+			if err := rows.Scan(&topic.Topic, &topic.ChunkCount); err != nil {
+				return nil, fmt.Errorf("failed to scan topic: %w", err)
+			}
+			topics = append(topics, topic)
+		}
+		return topics, rows.Err()
+	})
+}
+
+// ListKeywords returns the distinct topics and keywords of an SME's chunks that are
+// usable in generation. Chunks flagged as possible prompt injection count only once released.
+func (r *SMEKnowledgeRepository) ListKeywords(ctx context.Context, smeID uuid.UUID) ([]string, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]string, error) {
+		query := `
+			SELECT DISTINCT term
+			FROM sme_knowledge_chunks k
+			CROSS JOIN LATERAL unnest(array_append(k.keywords, k.topic)) AS term
+			WHERE k.sme_id = $1
+			AND (NOT k.injection_flagged OR k.injection_released_at IS NOT NULL)
+			AND term <> ''
+		`
+		rows, err := tx.QueryContext(ctx, query, smeID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list keywords: %w", err)
+		}
+		defer rows.Close()
+
+		var keywords []string
+		for rows.Next() {
+			var keyword string
+			if err := rows.Scan(&keyword); err != nil {
+				return nil, fmt.Errorf("failed to scan keyword: %w", err)
+			}
+			keywords = append(keywords, keyword)
+		}
+		return keywords, rows.Err()
+	})
+}
+
+// ListSources returns the SME, submission and task behind each of the given chunks.
+func (r *SMEKnowledgeRepository) ListSources(ctx context.Context, chunkIDs []uuid.UUID) ([]*entity.SMEKnowledgeSource, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.SMEKnowledgeSource, error) {
+		query := `
+			SELECT k.id, s.id, s.name, sub.file_name, t.title
+			FROM sme_knowledge_chunks k
+			JOIN subject_matter_experts s ON s.id = k.sme_id
+			LEFT JOIN sme_task_submissions sub ON sub.id = k.submission_id
+			LEFT JOIN sme_tasks t ON t.id = sub.task_id
+			WHERE k.id = ANY($1)
+		`
+		rows, err := tx.QueryContext(ctx, query, pq.Array(chunkIDs))
+		if err != nil {
+			return nil, fmt.Errorf("failed to list chunk sources: %w", err)
+		}
+		defer rows.Close()
+
+		var sources []*entity.SMEKnowledgeSource
+		for rows.Next() {
+			source := &entity.SMEKnowledgeSource{}
+			if err := rows.Scan(&source.ChunkID, &source.SMEID, &source.SMEName, &source.FileName, &source.TaskTitle); err != nil {
+				return nil, fmt.Errorf("failed to scan chunk source: %w", err)
+			}
+			sources = append(sources, source)
+		}
+		return sources, rows.Err()
+	})
+}
+
+// RenameTopic moves up to limit of an SME's chunks from one topic to another.
+func (r *SMEKnowledgeRepository) RenameTopic(ctx context.Context, smeID uuid.UUID, from, to string, limit int) (int, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (int, error) {
+		query := `
+			UPDATE sme_knowledge_chunks
+			SET topic = $3
+			WHERE id IN (
+				SELECT id FROM sme_knowledge_chunks
+				WHERE sme_id = $1 AND topic = $2
+				LIMIT $4
+			)
+		`
+		result, err := tx.ExecContext(ctx, query, smeID, from, to, limit)
+		if err != nil {
+			return 0, fmt.Errorf("failed to rename topic: %w", err)
+		}
+		n, err := result.RowsAffected()
+		if err != nil {
+			return 0, err
+		}
+		return int(n), nil
+	})
+}
+
 // Search searches knowledge across SMEs.
 func (r *SMEKnowledgeRepository) Search(ctx context.Context, smeIDs []uuid.UUID, query string, limit int) ([]*entity.SMEKnowledgeChunk, error) {
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.SMEKnowledgeChunk, error) {
 		sqlQuery := `
-			SELECT id, tenant_id, sme_id, submission_id, content, topic, keywords, relevance_score, created_at
+			SELECT ` + knowledgeChunkColumns + `
 			FROM sme_knowledge_chunks
 			WHERE sme_id = ANY($1)
 			AND (content ILIKE '%' || $2 || '%' OR topic ILIKE '%' || $2 || '%' OR $2 = ANY(keywords))
@@ -753,22 +1255,10 @@ func (r *SMEKnowledgeRepository) Search(ctx context.Context, smeIDs []uuid.UUID,
 
 		var chunks []*entity.SMEKnowledgeChunk
 		for rows.Next() {
-			chunk := &entity.SMEKnowledgeChunk{}
-			var keywords pq.StringArray
-			if err := rows.Scan(
-				&chunk.ID,
-				&chunk.TenantID,
-				&chunk.SMEID,
-				&chunk.SubmissionID,
-				&chunk.Content,
-				&chunk.Topic,
-				&keywords,
-				&chunk.RelevanceScore,
-				&chunk.CreatedAt,
-			); err != nil {
+			chunk, err := scanKnowledgeChunk(rows)
+			if err != nil {
 				return nil, fmt.Errorf("failed to scan chunk: %w", err)
 			}
-			chunk.Keywords = []string(keywords)
 			chunks = append(chunks, chunk)
 		}
 		return chunks, nil
@@ -784,6 +1274,15 @@ func (r *SMEKnowledgeRepository) DeleteBySMEID(ctx context.Context, smeID uuid.U
 	})
 }
 
+// DeleteBySubmissionID deletes all knowledge chunks created from a submission.
+func (r *SMEKnowledgeRepository) DeleteBySubmissionID(ctx context.Context, submissionID uuid.UUID) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		query := `DELETE FROM sme_knowledge_chunks WHERE submission_id = $1`
+		_, err := tx.ExecContext(ctx, query, submissionID)
+		return err
+	})
+}
+
 // Update updates a knowledge chunk.
 func (r *SMEKnowledgeRepository) Update(ctx context.Context, chunk *entity.SMEKnowledgeChunk) error {
 	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
@@ -802,6 +1301,19 @@ func (r *SMEKnowledgeRepository) Update(ctx context.Context, chunk *entity.SMEKn
 	})
 }
 
+// SetInjectionRelease records whether a flagged chunk has been released for generation.
+func (r *SMEKnowledgeRepository) SetInjectionRelease(ctx context.Context, chunk *entity.SMEKnowledgeChunk) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		query := `
+			UPDATE sme_knowledge_chunks
+			SET injection_released_by_user_id = $1, injection_released_at = $2
+			WHERE id = $3
+		`
+		_, err := tx.ExecContext(ctx, query, chunk.InjectionReleasedByUserID, chunk.InjectionReleasedAt, chunk.ID)
+		return err
+	})
+}
+
 // Delete deletes a knowledge chunk by ID.
 func (r *SMEKnowledgeRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
@@ -0,0 +1,87 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+)
+
+// StorageReferenceRepository implements repository.StorageReferenceRepository using PostgreSQL.
+type StorageReferenceRepository struct {
+	db *sql.DB
+}
+
+// NewStorageReferenceRepository creates a new PostgreSQL storage reference repository.
+func NewStorageReferenceRepository(db *sql.DB) repository.StorageReferenceRepository {
+	return &StorageReferenceRepository{db: db}
+}
+
+// ListReferences returns the tenant's course IDs and every object path stored in its rows.
+// Uses RLS to ensure proper tenant isolation.
+func (r *StorageReferenceRepository) ListReferences(ctx context.Context, tenantID uuid.UUID) (*entity.StorageReferences, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.StorageReferences, error) {
+		refs := &entity.StorageReferences{PublishedVersions: map[uuid.UUID]int32{}}
+
+		rows, err := tx.QueryContext(ctx, `SELECT id, published_version FROM courses WHERE tenant_id = $1`, tenantID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list courses: %w", err)
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var id uuid.UUID
+			var publishedVersion int32
+			if err := rows.Scan(&id, &publishedVersion); err != nil {
+				return nil, fmt.Errorf("failed to scan course ID: %w", err)
+			}
+			refs.CourseIDs = append(refs.CourseIDs, id)
+			if publishedVersion > 0 {
+				refs.PublishedVersions[id] = publishedVersion
+			}
+		}
+		if err := rows.Err(); err != nil {
+			return nil, fmt.Errorf("failed to list courses: %w", err)
+		}
+
+		// Component regeneration jobs keep their input in result_path; it never matches a path
+		query := `
+			SELECT content_path FROM courses WHERE tenant_id = $1 AND content_path IS NOT NULL
+			UNION ALL
+			SELECT thumbnail_path FROM courses WHERE tenant_id = $1 AND thumbnail_path IS NOT NULL
+			UNION ALL
+			SELECT result_path FROM generation_jobs WHERE tenant_id = $1 AND result_path IS NOT NULL
+			UNION ALL
+			SELECT source_path FROM generation_jobs WHERE tenant_id = $1 AND source_path IS NOT NULL
+			UNION ALL
+			SELECT file_path FROM sme_task_submissions WHERE tenant_id = $1 AND file_path <> ''
+			UNION ALL
+			SELECT knowledge_content_path FROM subject_matter_experts WHERE tenant_id = $1 AND knowledge_content_path IS NOT NULL
+			UNION ALL
+			SELECT artifact_path FROM course_sync_deliveries WHERE tenant_id = $1 AND artifact_path IS NOT NULL
+			UNION ALL
+			SELECT content_json->>'asset_path' FROM lesson_components WHERE tenant_id = $1 AND content_json ? 'asset_path'
+		`
+		pathRows, err := tx.QueryContext(ctx, query, tenantID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list referenced paths: %w", err)
+		}
+		defer pathRows.Close()
+		for pathRows.Next() {
+			var p sql.NullString
+			if err := pathRows.Scan(&p); err != nil {
+				return nil, fmt.Errorf("failed to scan referenced path: %w", err)
+			}
+			if p.Valid && p.String != "" {
+				refs.Paths = append(refs.Paths, p.String)
+			}
+		}
+		if err := pathRows.Err(); err != nil {
+			return nil, fmt.Errorf("failed to list referenced paths: %w", err)
+		}
+
+		return refs, nil
+	})
+}
@@ -26,8 +26,8 @@ func NewTargetAudienceRepository(db *sql.DB) repository.TargetAudienceRepository
 func (r *TargetAudienceRepository) Create(ctx context.Context, template *entity.TargetAudienceTemplate) error {
 	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
 		query := `
-			INSERT INTO target_audience_templates (tenant_id, company_id, name, description, role, experience_level, learning_goals, prerequisites, challenges, motivations, industry_context, typical_background, status, created_by_user_id)
-			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+			INSERT INTO target_audience_templates (tenant_id, company_id, name, description, role, experience_level, learning_goals, prerequisites, challenges, motivations, industry_context, typical_background, status, created_by_user_id, is_sample)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
 			RETURNING id, created_at, updated_at
 		`
 		return tx.QueryRowContext(ctx, query,
@@ -45,6 +45,7 @@ func (r *TargetAudienceRepository) Create(ctx context.Context, template *entity.
 			template.TypicalBackground,
 			template.Status.String(),
 			template.CreatedByUserID,
+			template.IsSample,
 		).Scan(&template.ID, &template.CreatedAt, &template.UpdatedAt)
 	})
 }
@@ -53,7 +54,7 @@ func (r *TargetAudienceRepository) Create(ctx context.Context, template *entity.
 func (r *TargetAudienceRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.TargetAudienceTemplate, error) {
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.TargetAudienceTemplate, error) {
 		query := `
-			SELECT id, tenant_id, company_id, name, description, role, experience_level, learning_goals, prerequisites, challenges, motivations, industry_context, typical_background, status, created_by_user_id, created_at, updated_at
+			SELECT id, tenant_id, company_id, name, description, role, experience_level, learning_goals, prerequisites, challenges, motivations, industry_context, typical_background, status, created_by_user_id, created_at, updated_at, is_sample
 			FROM target_audience_templates
 			WHERE id = $1
 		`
@@ -78,6 +79,7 @@ func (r *TargetAudienceRepository) GetByID(ctx context.Context, id uuid.UUID) (*
 			&template.CreatedByUserID,
 			&template.CreatedAt,
 			&template.UpdatedAt,
+			&template.IsSample,
 		)
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -99,7 +101,7 @@ func (r *TargetAudienceRepository) GetByID(ctx context.Context, id uuid.UUID) (*
 func (r *TargetAudienceRepository) List(ctx context.Context) ([]*entity.TargetAudienceTemplate, error) {
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.TargetAudienceTemplate, error) {
 		query := `
-			SELECT id, tenant_id, company_id, name, description, role, experience_level, learning_goals, prerequisites, challenges, motivations, industry_context, typical_background, status, created_by_user_id, created_at, updated_at
+			SELECT id, tenant_id, company_id, name, description, role, experience_level, learning_goals, prerequisites, challenges, motivations, industry_context, typical_background, status, created_by_user_id, created_at, updated_at, is_sample
 			FROM target_audience_templates
 			ORDER BY name ASC
 		`
@@ -132,6 +134,7 @@ func (r *TargetAudienceRepository) List(ctx context.Context) ([]*entity.TargetAu
 				&template.CreatedByUserID,
 				&template.CreatedAt,
 				&template.UpdatedAt,
+				&template.IsSample,
 			); err != nil {
 				return nil, fmt.Errorf("failed to scan template: %w", err)
 			}
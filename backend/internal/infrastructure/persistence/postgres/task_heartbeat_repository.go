@@ -0,0 +1,74 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+)
+
+// TaskHeartbeatRepository implements repository.TaskHeartbeatRepository using PostgreSQL.
+// Note: All methods require superadmin context as task_heartbeats holds system-wide rows.
+type TaskHeartbeatRepository struct {
+	db *sql.DB
+}
+
+// NewTaskHeartbeatRepository creates a new PostgreSQL task heartbeat repository.
+func NewTaskHeartbeatRepository(db *sql.DB) repository.TaskHeartbeatRepository {
+	return &TaskHeartbeatRepository{db: db}
+}
+
+// Record stores a successful run of a task, replacing the previous one.
+func (r *TaskHeartbeatRepository) Record(ctx context.Context, heartbeat *entity.TaskHeartbeat) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		query := `
+			INSERT INTO task_heartbeats (task_type, last_succeeded_at, last_duration_ms, worker_host)
+			VALUES ($1, $2, $3, $4)
+			ON CONFLICT (task_type)
+			DO UPDATE SET last_succeeded_at = EXCLUDED.last_succeeded_at,
+			              last_duration_ms = EXCLUDED.last_duration_ms,
+			              worker_host = EXCLUDED.worker_host
+		`
+		_, err := tx.ExecContext(ctx, query,
+			heartbeat.TaskType,
+			heartbeat.LastSucceededAt,
+			heartbeat.LastDuration.Milliseconds(),
+			heartbeat.WorkerHost,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to record task heartbeat: %w", err)
+		}
+		return nil
+	})
+}
+
+// List retrieves the latest heartbeat of every task that has succeeded at least once.
+func (r *TaskHeartbeatRepository) List(ctx context.Context) ([]*entity.TaskHeartbeat, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.TaskHeartbeat, error) {
+		query := `
+			SELECT task_type, last_succeeded_at, last_duration_ms, worker_host
+			FROM task_heartbeats
+			ORDER BY task_type
+		`
+		rows, err := tx.QueryContext(ctx, query)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list task heartbeats: %w", err)
+		}
+		defer rows.Close()
+
+		var heartbeats []*entity.TaskHeartbeat
+		for rows.Next() {
+			heartbeat := &entity.TaskHeartbeat{}
+			var durationMS int64
+			if err := rows.Scan(&heartbeat.TaskType, &heartbeat.LastSucceededAt, &durationMS, &heartbeat.WorkerHost); err != nil {
+				return nil, fmt.Errorf("failed to scan task heartbeat: %w", err)
+			}
+			heartbeat.LastDuration = time.Duration(durationMS) * time.Millisecond
+			heartbeats = append(heartbeats, heartbeat)
+		}
+		return heartbeats, rows.Err()
+	})
+}
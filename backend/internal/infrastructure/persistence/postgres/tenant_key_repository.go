@@ -0,0 +1,111 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+)
+
+// TenantKeyRepository implements repository.TenantKeyRepository using PostgreSQL.
+type TenantKeyRepository struct {
+	db *sql.DB
+}
+
+// NewTenantKeyRepository creates a new PostgreSQL tenant key repository.
+func NewTenantKeyRepository(db *sql.DB) repository.TenantKeyRepository {
+	return &TenantKeyRepository{db: db}
+}
+
+// GetActive retrieves the tenant's active key, or nil if it has none yet.
+// Uses RLS to ensure proper tenant isolation.
+func (r *TenantKeyRepository) GetActive(ctx context.Context, tenantID uuid.UUID) (*entity.TenantKey, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.TenantKey, error) {
+		return scanTenantKey(tx.QueryRowContext(ctx, `
+			SELECT id, tenant_id, version, wrapped_key, created_at, retired_at
+			FROM tenant_keys
+			WHERE tenant_id = $1 AND retired_at IS NULL
+		`, tenantID))
+	})
+}
+
+// GetByVersion retrieves one version of the tenant's key, active or retired.
+// Uses RLS to ensure proper tenant isolation.
+func (r *TenantKeyRepository) GetByVersion(ctx context.Context, tenantID uuid.UUID, version int32) (*entity.TenantKey, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.TenantKey, error) {
+		return scanTenantKey(tx.QueryRowContext(ctx, `
+			SELECT id, tenant_id, version, wrapped_key, created_at, retired_at
+			FROM tenant_keys
+			WHERE tenant_id = $1 AND version = $2
+		`, tenantID, version))
+	})
+}
+
+// CreateFirst stores the tenant's first key as version 1. It returns false, storing
+// nothing, if the tenant already has a key, e.g. one created concurrently by another pod.
+// Uses RLS to ensure proper tenant isolation.
+func (r *TenantKeyRepository) CreateFirst(ctx context.Context, key *entity.TenantKey) (bool, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (bool, error) {
+		key.Version = 1
+		err := tx.QueryRowContext(ctx, `
+			INSERT INTO tenant_keys (tenant_id, version, wrapped_key)
+			VALUES ($1, $2, $3)
+			ON CONFLICT DO NOTHING
+			RETURNING id, created_at
+		`, key.TenantID, key.Version, key.WrappedKey).Scan(&key.ID, &key.CreatedAt)
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		if err != nil {
+			return false, fmt.Errorf("failed to create tenant key: %w", err)
+		}
+		return true, nil
+	})
+}
+
+// Rotate retires the tenant's active key, if any, and stores key as the next version.
+// Of two concurrent rotations of one tenant, the second fails on the one-active-key index.
+// Uses RLS to ensure proper tenant isolation.
+func (r *TenantKeyRepository) Rotate(ctx context.Context, key *entity.TenantKey) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `
+			UPDATE tenant_keys SET retired_at = NOW()
+			WHERE tenant_id = $1 AND retired_at IS NULL
+		`, key.TenantID)
+		if err != nil {
+			return fmt.Errorf("failed to retire tenant key: %w", err)
+		}
+
+		err = tx.QueryRowContext(ctx, `
+			INSERT INTO tenant_keys (tenant_id, version, wrapped_key)
+			SELECT $1, COALESCE(MAX(version), 0) + 1, $2
+			FROM tenant_keys
+			WHERE tenant_id = $1
+			RETURNING id, version, created_at
+		`, key.TenantID, key.WrappedKey).Scan(&key.ID, &key.Version, &key.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to create tenant key: %w", err)
+		}
+		return nil
+	})
+}
+
+// scanTenantKey scans one tenant key row, returning nil if there was none.
+func scanTenantKey(row *sql.Row) (*entity.TenantKey, error) {
+	key := &entity.TenantKey{}
+	var retiredAt sql.NullTime
+	err := row.Scan(&key.ID, &key.TenantID, &key.Version, &key.WrappedKey, &key.CreatedAt, &retiredAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant key: %w", err)
+	}
+	if retiredAt.Valid {
+		key.RetiredAt = &retiredAt.Time
+	}
+	return key, nil
+}
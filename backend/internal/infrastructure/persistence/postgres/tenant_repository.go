@@ -25,11 +25,11 @@ func NewTenantRepository(db *sql.DB) repository.TenantRepository {
 func (r *TenantRepository) Create(ctx context.Context, t *entity.Tenant) error {
 	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
 		query := `
-			INSERT INTO tenants (name, slug, status)
-			VALUES ($1, $2, $3)
+			INSERT INTO tenants (name, slug, status, storage_region)
+			VALUES ($1, $2, $3, NULLIF($4, ''))
 			RETURNING id, created_at, updated_at
 		`
-		err := tx.QueryRowContext(ctx, query, t.Name, t.Slug, t.Status.String()).
+		err := tx.QueryRowContext(ctx, query, t.Name, t.Slug, t.Status.String(), t.StorageRegion).
 			Scan(&t.ID, &t.CreatedAt, &t.UpdatedAt)
 		if err != nil {
 			return fmt.Errorf("failed to create tenant: %w", err)
@@ -42,7 +42,7 @@ func (r *TenantRepository) Create(ctx context.Context, t *entity.Tenant) error {
 func (r *TenantRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Tenant, error) {
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.Tenant, error) {
 		query := `
-			SELECT id, name, slug, status, created_at, updated_at
+			SELECT id, name, slug, status, COALESCE(storage_region, ''), COALESCE(storage_migration_region, ''), created_at, updated_at
 			FROM tenants
 			WHERE id = $1
 		`
@@ -53,6 +53,8 @@ func (r *TenantRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.T
 			&t.Name,
 			&t.Slug,
 			&statusStr,
+			&t.StorageRegion,
+			&t.StorageMigrationRegion,
 			&t.CreatedAt,
 			&t.UpdatedAt,
 		)
@@ -71,7 +73,7 @@ func (r *TenantRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.T
 func (r *TenantRepository) GetBySlug(ctx context.Context, slug string) (*entity.Tenant, error) {
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.Tenant, error) {
 		query := `
-			SELECT id, name, slug, status, created_at, updated_at
+			SELECT id, name, slug, status, COALESCE(storage_region, ''), COALESCE(storage_migration_region, ''), created_at, updated_at
 			FROM tenants
 			WHERE slug = $1
 		`
@@ -82,6 +84,8 @@ func (r *TenantRepository) GetBySlug(ctx context.Context, slug string) (*entity.
 			&t.Name,
 			&t.Slug,
 			&statusStr,
+			&t.StorageRegion,
+			&t.StorageMigrationRegion,
 			&t.CreatedAt,
 			&t.UpdatedAt,
 		)
@@ -96,7 +100,7 @@ func (r *TenantRepository) GetBySlug(ctx context.Context, slug string) (*entity.
 	})
 }
 
-// Update updates a tenant.
+// Update updates a tenant. The storage region is left alone; see CompleteStorageMigration.
 func (r *TenantRepository) Update(ctx context.Context, t *entity.Tenant) error {
 	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
 		query := `
@@ -135,3 +139,82 @@ func (r *TenantRepository) Delete(ctx context.Context, id uuid.UUID) error {
 		return nil
 	})
 }
+
+// ListActiveIDs retrieves the IDs of all active tenants.
+// Requires superadmin context to see tenants other than the caller's.
+func (r *TenantRepository) ListActiveIDs(ctx context.Context) ([]uuid.UUID, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]uuid.UUID, error) {
+		rows, err := tx.QueryContext(ctx, `SELECT id FROM tenants WHERE status = 'active' ORDER BY created_at`)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list tenants: %w", err)
+		}
+		defer rows.Close()
+
+		var ids []uuid.UUID
+		for rows.Next() {
+			var id uuid.UUID
+			if err := rows.Scan(&id); err != nil {
+				return nil, fmt.Errorf("failed to scan tenant id: %w", err)
+			}
+			ids = append(ids, id)
+		}
+		return ids, rows.Err()
+	})
+}
+
+// StartStorageMigration records that the tenant's objects are being copied to region.
+// Requires superadmin context.
+func (r *TenantRepository) StartStorageMigration(ctx context.Context, id uuid.UUID, region string) (bool, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (bool, error) {
+		query := `
+			UPDATE tenants
+			SET storage_migration_region = $2, updated_at = NOW()
+			WHERE id = $1 AND (storage_migration_region IS NULL OR storage_migration_region = $2)
+		`
+		result, err := tx.ExecContext(ctx, query, id, region)
+		if err != nil {
+			return false, fmt.Errorf("failed to start tenant storage migration: %w", err)
+		}
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return false, fmt.Errorf("failed to get affected rows: %w", err)
+		}
+		return rows > 0, nil
+	})
+}
+
+// CompleteStorageMigration moves the tenant to the region its migration copied to.
+// Requires superadmin context.
+func (r *TenantRepository) CompleteStorageMigration(ctx context.Context, id uuid.UUID, from, to string) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		query := `
+			UPDATE tenants
+			SET storage_region = $3, storage_migration_region = NULL, updated_at = NOW()
+			WHERE id = $1 AND COALESCE(storage_region, '') = $2 AND storage_migration_region = $3
+		`
+		result, err := tx.ExecContext(ctx, query, id, from, to)
+		if err != nil {
+			return fmt.Errorf("failed to complete tenant storage migration: %w", err)
+		}
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get affected rows: %w", err)
+		}
+		if rows == 0 {
+			return fmt.Errorf("tenant is no longer migrating from %q to %q", from, to)
+		}
+		return nil
+	})
+}
+
+// CancelStorageMigration clears a running storage migration.
+// Requires superadmin context.
+func (r *TenantRepository) CancelStorageMigration(ctx context.Context, id uuid.UUID) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		query := `UPDATE tenants SET storage_migration_region = NULL, updated_at = NOW() WHERE id = $1`
+		if _, err := tx.ExecContext(ctx, query, id); err != nil {
+			return fmt.Errorf("failed to cancel tenant storage migration: %w", err)
+		}
+		return nil
+	})
+}
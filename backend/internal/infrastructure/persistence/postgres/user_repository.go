@@ -25,12 +25,15 @@ func NewUserRepository(db *sql.DB) repository.UserRepository {
 func (r *UserRepository) Create(ctx context.Context, user *entity.User) error {
 	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
 		query := `
-			INSERT INTO users (tenant_id, kratos_id, company_id, role)
-			VALUES ($1, $2, $3, $4)
-			RETURNING id, created_at, updated_at
+			INSERT INTO users (tenant_id, kratos_id, company_id, role, locale)
+			VALUES ($1, $2, $3, $4, $5)
+			RETURNING id, is_active, created_at, updated_at
 		`
-		return tx.QueryRowContext(ctx, query, user.TenantID, user.KratosID, user.CompanyID, user.Role.String()).
-			Scan(&user.ID, &user.CreatedAt, &user.UpdatedAt)
+		if user.Locale == "" {
+			user.Locale = valueobject.DefaultLocale
+		}
+		return tx.QueryRowContext(ctx, query, user.TenantID, user.KratosID, user.CompanyID, user.Role.String(), user.Locale.String()).
+			Scan(&user.ID, &user.IsActive, &user.CreatedAt, &user.UpdatedAt)
 	})
 }
 
@@ -38,18 +41,25 @@ func (r *UserRepository) Create(ctx context.Context, user *entity.User) error {
 func (r *UserRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.User, error) {
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.User, error) {
 		query := `
-			SELECT id, tenant_id, kratos_id, company_id, role, created_at, updated_at
+			SELECT id, tenant_id, kratos_id, company_id, role, locale, is_active, deactivated_at, bounced_email, email_bounced_at, onboarding_dismissed_at, weekly_summary_opt_out, created_at, updated_at
 			FROM users
 			WHERE id = $1
 		`
 		user := &entity.User{}
-		var roleStr string
+		var roleStr, localeStr string
 		err := tx.QueryRowContext(ctx, query, id).Scan(
 			&user.ID,
 			&user.TenantID,
 			&user.KratosID,
 			&user.CompanyID,
 			&roleStr,
+			&localeStr,
+			&user.IsActive,
+			&user.DeactivatedAt,
+			&user.BouncedEmail,
+			&user.EmailBouncedAt,
+			&user.OnboardingDismissedAt,
+			&user.WeeklySummaryOptOut,
 			&user.CreatedAt,
 			&user.UpdatedAt,
 		)
@@ -60,6 +70,7 @@ func (r *UserRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Use
 			return nil, fmt.Errorf("failed to get user: %w", err)
 		}
 		user.Role = valueobject.Role(roleStr)
+		user.Locale = valueobject.Locale(localeStr)
 		return user, nil
 	})
 }
@@ -71,18 +82,25 @@ func (r *UserRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Use
 func (r *UserRepository) GetByKratosID(ctx context.Context, kratosID uuid.UUID) (*entity.User, error) {
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.User, error) {
 		query := `
-			SELECT id, tenant_id, kratos_id, company_id, role, created_at, updated_at
+			SELECT id, tenant_id, kratos_id, company_id, role, locale, is_active, deactivated_at, bounced_email, email_bounced_at, onboarding_dismissed_at, weekly_summary_opt_out, created_at, updated_at
 			FROM users
 			WHERE kratos_id = $1
 		`
 		user := &entity.User{}
-		var roleStr string
+		var roleStr, localeStr string
 		err := tx.QueryRowContext(ctx, query, kratosID).Scan(
 			&user.ID,
 			&user.TenantID,
 			&user.KratosID,
 			&user.CompanyID,
 			&roleStr,
+			&localeStr,
+			&user.IsActive,
+			&user.DeactivatedAt,
+			&user.BouncedEmail,
+			&user.EmailBouncedAt,
+			&user.OnboardingDismissedAt,
+			&user.WeeklySummaryOptOut,
 			&user.CreatedAt,
 			&user.UpdatedAt,
 		)
@@ -93,6 +111,7 @@ func (r *UserRepository) GetByKratosID(ctx context.Context, kratosID uuid.UUID)
 			return nil, fmt.Errorf("failed to get user: %w", err)
 		}
 		user.Role = valueobject.Role(roleStr)
+		user.Locale = valueobject.Locale(localeStr)
 		return user, nil
 	})
 }
@@ -102,19 +121,26 @@ func (r *UserRepository) GetByKratosID(ctx context.Context, kratosID uuid.UUID)
 func (r *UserRepository) GetOwnerByCompanyID(ctx context.Context, companyID uuid.UUID) (*entity.User, error) {
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.User, error) {
 		query := `
-			SELECT id, tenant_id, kratos_id, company_id, role, created_at, updated_at
+			SELECT id, tenant_id, kratos_id, company_id, role, locale, is_active, deactivated_at, bounced_email, email_bounced_at, onboarding_dismissed_at, weekly_summary_opt_out, created_at, updated_at
 			FROM users
-			WHERE company_id = $1 AND role = 'admin'
+			WHERE company_id = $1 AND role = 'admin' AND is_active
 			LIMIT 1
 		`
 		user := &entity.User{}
-		var roleStr string
+		var roleStr, localeStr string
 		err := tx.QueryRowContext(ctx, query, companyID).Scan(
 			&user.ID,
 			&user.TenantID,
 			&user.KratosID,
 			&user.CompanyID,
 			&roleStr,
+			&localeStr,
+			&user.IsActive,
+			&user.DeactivatedAt,
+			&user.BouncedEmail,
+			&user.EmailBouncedAt,
+			&user.OnboardingDismissedAt,
+			&user.WeeklySummaryOptOut,
 			&user.CreatedAt,
 			&user.UpdatedAt,
 		)
@@ -125,6 +151,7 @@ func (r *UserRepository) GetOwnerByCompanyID(ctx context.Context, companyID uuid
 			return nil, fmt.Errorf("failed to get owner: %w", err)
 		}
 		user.Role = valueobject.Role(roleStr)
+		user.Locale = valueobject.Locale(localeStr)
 		return user, nil
 	})
 }
@@ -133,7 +160,7 @@ func (r *UserRepository) GetOwnerByCompanyID(ctx context.Context, companyID uuid
 func (r *UserRepository) ListByCompanyID(ctx context.Context, companyID uuid.UUID) ([]*entity.User, error) {
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.User, error) {
 		query := `
-			SELECT id, tenant_id, kratos_id, company_id, role, created_at, updated_at
+			SELECT id, tenant_id, kratos_id, company_id, role, locale, is_active, deactivated_at, bounced_email, email_bounced_at, onboarding_dismissed_at, weekly_summary_opt_out, created_at, updated_at
 			FROM users
 			WHERE company_id = $1
 			ORDER BY created_at DESC
@@ -147,35 +174,137 @@ func (r *UserRepository) ListByCompanyID(ctx context.Context, companyID uuid.UUI
 		var users []*entity.User
 		for rows.Next() {
 			user := &entity.User{}
-			var roleStr string
+			var roleStr, localeStr string
 			if err := rows.Scan(
 				&user.ID,
 				&user.TenantID,
 				&user.KratosID,
 				&user.CompanyID,
 				&roleStr,
+				&localeStr,
+				&user.IsActive,
+				&user.DeactivatedAt,
+				&user.BouncedEmail,
+				&user.EmailBouncedAt,
+				&user.OnboardingDismissedAt,
+				&user.WeeklySummaryOptOut,
 				&user.CreatedAt,
 				&user.UpdatedAt,
 			); err != nil {
 				return nil, fmt.Errorf("failed to scan user: %w", err)
 			}
 			user.Role = valueobject.Role(roleStr)
+			user.Locale = valueobject.Locale(localeStr)
 			users = append(users, user)
 		}
 		return users, nil
 	})
 }
 
+// ListActiveAdminsByTenantID retrieves the active admins of a tenant.
+func (r *UserRepository) ListActiveAdminsByTenantID(ctx context.Context, tenantID uuid.UUID) ([]*entity.User, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.User, error) {
+		query := `
+			SELECT id, tenant_id, kratos_id, company_id, role, locale, is_active, deactivated_at, bounced_email, email_bounced_at, onboarding_dismissed_at, weekly_summary_opt_out, created_at, updated_at
+			FROM users
+			WHERE tenant_id = $1 AND role = 'admin' AND is_active
+			ORDER BY created_at
+		`
+		rows, err := tx.QueryContext(ctx, query, tenantID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list admins: %w", err)
+		}
+		defer rows.Close()
+
+		var users []*entity.User
+		for rows.Next() {
+			user := &entity.User{}
+			var roleStr, localeStr string
+			if err := rows.Scan(
+				&user.ID,
+				&user.TenantID,
+				&user.KratosID,
+				&user.CompanyID,
+				&roleStr,
+				&localeStr,
+				&user.IsActive,
+				&user.DeactivatedAt,
+				&user.BouncedEmail,
+				&user.EmailBouncedAt,
+				&user.OnboardingDismissedAt,
+				&user.WeeklySummaryOptOut,
+				&user.CreatedAt,
+				&user.UpdatedAt,
+			); err != nil {
+				return nil, fmt.Errorf("failed to scan user: %w", err)
+			}
+			user.Role = valueobject.Role(roleStr)
+			user.Locale = valueobject.Locale(localeStr)
+			users = append(users, user)
+		}
+		return users, rows.Err()
+	})
+}
+
 // Update updates a user.
 func (r *UserRepository) Update(ctx context.Context, user *entity.User) error {
 	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
 		query := `
 			UPDATE users
-			SET company_id = $1, role = $2, updated_at = NOW()
-			WHERE id = $3
+			SET company_id = $1, role = $2, locale = $3, is_active = $4, deactivated_at = $5, updated_at = NOW()
+			WHERE id = $6
 			RETURNING updated_at
 		`
-		return tx.QueryRowContext(ctx, query, user.CompanyID, user.Role.String(), user.ID).
+		if user.Locale == "" {
+			user.Locale = valueobject.DefaultLocale
+		}
+		return tx.QueryRowContext(ctx, query, user.CompanyID, user.Role.String(), user.Locale.String(), user.IsActive, user.DeactivatedAt, user.ID).
 			Scan(&user.UpdatedAt)
 	})
 }
+
+// SetEmailBounce flags the user's address as hard-bouncing, or clears the flag.
+func (r *UserRepository) SetEmailBounce(ctx context.Context, userID uuid.UUID, bouncedEmail *string) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		query := `
+			UPDATE users
+			SET bounced_email = $1,
+				email_bounced_at = CASE WHEN $1::text IS NULL THEN NULL ELSE NOW() END
+			WHERE id = $2
+		`
+		if _, err := tx.ExecContext(ctx, query, bouncedEmail, userID); err != nil {
+			return fmt.Errorf("failed to set email bounce: %w", err)
+		}
+		return nil
+	})
+}
+
+// DismissOnboarding records that the user hid the onboarding checklist.
+func (r *UserRepository) DismissOnboarding(ctx context.Context, userID uuid.UUID) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		query := `
+			UPDATE users
+			SET onboarding_dismissed_at = COALESCE(onboarding_dismissed_at, NOW())
+			WHERE id = $1
+		`
+		if _, err := tx.ExecContext(ctx, query, userID); err != nil {
+			return fmt.Errorf("failed to dismiss onboarding: %w", err)
+		}
+		return nil
+	})
+}
+
+// SetWeeklySummaryOptOut sets whether the user is left out of the weekly summary email.
+func (r *UserRepository) SetWeeklySummaryOptOut(ctx context.Context, userID uuid.UUID, optOut bool) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		query := `
+			UPDATE users
+			SET weekly_summary_opt_out = $1, updated_at = NOW()
+			WHERE id = $2
+		`
+		if _, err := tx.ExecContext(ctx, query, optOut, userID); err != nil {
+			return fmt.Errorf("failed to set weekly summary opt-out: %w", err)
+		}
+		return nil
+	})
+}
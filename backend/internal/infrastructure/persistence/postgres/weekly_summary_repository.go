@@ -0,0 +1,130 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+)
+
+// WeeklySummaryRepository implements repository.WeeklySummaryRepository using PostgreSQL.
+type WeeklySummaryRepository struct {
+	db *sql.DB
+}
+
+// NewWeeklySummaryRepository creates a new PostgreSQL weekly summary repository.
+func NewWeeklySummaryRepository(db *sql.DB) repository.WeeklySummaryRepository {
+	return &WeeklySummaryRepository{db: db}
+}
+
+// ListEnabledTenantIDs returns every tenant that has the weekly summary turned on.
+// Requires a superadmin context to see all tenants.
+func (r *WeeklySummaryRepository) ListEnabledTenantIDs(ctx context.Context) ([]uuid.UUID, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]uuid.UUID, error) {
+		rows, err := tx.QueryContext(ctx, `SELECT tenant_id FROM tenant_ai_settings WHERE weekly_summary_enabled`)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list weekly summary tenants: %w", err)
+		}
+		defer rows.Close()
+
+		var ids []uuid.UUID
+		for rows.Next() {
+			var id uuid.UUID
+			if err := rows.Scan(&id); err != nil {
+				return nil, fmt.Errorf("failed to scan tenant ID: %w", err)
+			}
+			ids = append(ids, id)
+		}
+		return ids, rows.Err()
+	})
+}
+
+// GetFigures aggregates the tenant's week in one query. Full course jobs carry the sum
+// of their lesson jobs' tokens, so they are left out of the token total.
+func (r *WeeklySummaryRepository) GetFigures(ctx context.Context, tenantID uuid.UUID, weekStart, weekEnd time.Time) (*entity.WeeklySummary, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.WeeklySummary, error) {
+		query := `
+			SELECT
+				(SELECT COUNT(DISTINCT course_id) FROM generation_jobs
+				 WHERE tenant_id = $1 AND type = 'full_course' AND status = 'completed'
+				   AND completed_at >= $2 AND completed_at < $3),
+				(SELECT COALESCE(SUM(tokens_used), 0) FROM generation_jobs
+				 WHERE tenant_id = $1 AND type <> 'full_course'
+				   AND completed_at >= $2 AND completed_at < $3),
+				(SELECT COUNT(*) FROM generation_jobs
+				 WHERE tenant_id = $1 AND status = 'failed' AND parent_job_id IS NULL
+				   AND completed_at >= $2 AND completed_at < $3),
+				(SELECT COUNT(*) FROM sme_tasks
+				 WHERE tenant_id = $1 AND due_date < NOW()
+				   AND status NOT IN ('completed', 'cancelled')),
+				(SELECT COUNT(*) FROM invitations
+				 WHERE tenant_id = $1 AND status = 'pending' AND expires_at > NOW())
+		`
+		summary := &entity.WeeklySummary{
+			TenantID:  tenantID,
+			WeekStart: weekStart,
+			WeekEnd:   weekEnd,
+		}
+		err := tx.QueryRowContext(ctx, query, tenantID, weekStart, weekEnd).Scan(
+			&summary.CoursesGenerated,
+			&summary.TokensUsed,
+			&summary.FailedJobs,
+			&summary.OverdueSMETasks,
+			&summary.PendingInvitations,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get weekly summary figures: %w", err)
+		}
+		return summary, nil
+	})
+}
+
+// ClaimSend inserts the week's send record unless it already exists.
+func (r *WeeklySummaryRepository) ClaimSend(ctx context.Context, tenantID uuid.UUID, weekStart time.Time) (bool, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (bool, error) {
+		query := `
+			INSERT INTO weekly_summary_sends (tenant_id, week_start)
+			VALUES ($1, $2)
+			ON CONFLICT (tenant_id, week_start) DO NOTHING
+		`
+		result, err := tx.ExecContext(ctx, query, tenantID, weekStart)
+		if err != nil {
+			return false, fmt.Errorf("failed to claim weekly summary send: %w", err)
+		}
+		n, err := result.RowsAffected()
+		if err != nil {
+			return false, fmt.Errorf("failed to claim weekly summary send: %w", err)
+		}
+		return n == 1, nil
+	})
+}
+
+// CompleteSend records how many admins the summary for weekStart was sent to.
+func (r *WeeklySummaryRepository) CompleteSend(ctx context.Context, tenantID uuid.UUID, weekStart time.Time, recipients int) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		query := `
+			UPDATE weekly_summary_sends
+			SET recipients = $1, sent_at = NOW()
+			WHERE tenant_id = $2 AND week_start = $3
+		`
+		if _, err := tx.ExecContext(ctx, query, recipients, tenantID, weekStart); err != nil {
+			return fmt.Errorf("failed to record weekly summary send: %w", err)
+		}
+		return nil
+	})
+}
+
+// ReleaseSend deletes the week's send record.
+func (r *WeeklySummaryRepository) ReleaseSend(ctx context.Context, tenantID uuid.UUID, weekStart time.Time) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		query := `DELETE FROM weekly_summary_sends WHERE tenant_id = $1 AND week_start = $2`
+		if _, err := tx.ExecContext(ctx, query, tenantID, weekStart); err != nil {
+			return fmt.Errorf("failed to release weekly summary send: %w", err)
+		}
+		return nil
+	})
+}
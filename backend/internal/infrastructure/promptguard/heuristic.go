@@ -0,0 +1,122 @@
+// Package promptguard screens SME content for prompt injection attempts.
+package promptguard
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/sogos/mirai-backend/internal/domain/service"
+)
+
+// maxMatchExcerpt bounds how much of the matched text is quoted in a verdict's reason.
+const maxMatchExcerpt = 80
+
+// rule is one heuristic: content matching pattern is flagged with label.
+type rule struct {
+	label   string
+	pattern *regexp.Regexp
+}
+
+// rules match phrasing aimed at the model rather than at learners. They run against
+// normalized content (see normalize), so they only need to match one spelling and spacing.
+var rules = []rule{
+	{"instruction override", regexp.MustCompile(`\b(ignore|disregard|forget|override) (all |any |of |the |your |these |my )*(previous|prior|above|earlier|preceding|original|system|existing) (instructions|prompts?|rules|directions|guidelines)\b`)},
+	{"instruction override", regexp.MustCompile(`\b(ignore|disregard) (all|any) (of )?(the |your )?(instructions|prompts?|rules)\b`)},
+	{"instruction override", regexp.MustCompile(`\bdo not (follow|obey) (the |your |any )*(previous|prior|original|system) (instructions|prompts?|rules)\b`)},
+	{"replacement instructions", regexp.MustCompile(`\b(new|updated|revised) system (instructions|prompt)\b|\byour (real|actual|new) (instructions|task|purpose) (is|are)\b`)},
+	{"role reassignment", regexp.MustCompile(`\byou are (now|no longer) (an? )?(ai|assistant|chatbot|language model|unrestricted|unfiltered|bound)\b|\bfrom now on,? you (are|will|must)\b|\bpretend (that )?you (are an? (ai|assistant)|have no)\b`)},
+	{"prompt extraction", regexp.MustCompile(`\b(reveal|print|repeat|show|output|leak) (me )?(your (system |hidden |initial )?|the (system|hidden|initial) )(prompt|instructions)\b`)},
+	{"chat role marker", regexp.MustCompile(`(^|\n) ?(system|assistant|developer) ?: ?(you |ignore|disregard|forget|from now|new instructions)|<\|(im_start|im_end|system|endoftext)\|>|\[/?inst\]|<<sys>>|### ?(instruction|system) ?:?\n`)},
+}
+
+// phrases are known jailbreak vocabulary that doesn't belong in training content.
+var phrases = []string{
+	"jailbreak",
+	"developer mode enabled",
+	"dan mode",
+	"do anything now",
+	"without any restrictions",
+	"no ethical guidelines",
+	"bypass your safety",
+	"bypass the safety",
+	"ignore your programming",
+	"system prompt",
+}
+
+// phrasePatterns match phrases as whole words, so "system prompts users" or "Jordan
+// mode" in ordinary content aren't taken for jailbreak vocabulary.
+var phrasePatterns = func() []*regexp.Regexp {
+	patterns := make([]*regexp.Regexp, len(phrases))
+	for i, phrase := range phrases {
+		patterns[i] = regexp.MustCompile(`\b` + regexp.QuoteMeta(phrase) + `\b`)
+	}
+	return patterns
+}()
+
+// HeuristicDetector flags content using regular expressions and a phrase list. It errs
+// towards flagging, since flagged chunks are only held back until an admin reviews them.
+type HeuristicDetector struct{}
+
+// NewHeuristicDetector creates a heuristic prompt injection detector.
+func NewHeuristicDetector() *HeuristicDetector {
+	return &HeuristicDetector{}
+}
+
+var _ service.PromptInjectionDetector = (*HeuristicDetector)(nil)
+
+// Detect reports the first heuristic the content matches.
+func (d *HeuristicDetector) Detect(content string) service.PromptInjectionVerdict {
+	normalized := normalize(content)
+	// Phrases split across lines are matched too; only role markers need the breaks
+	joined := strings.ReplaceAll(normalized, "\n", " ")
+
+	for _, r := range rules {
+		for _, text := range []string{normalized, joined} {
+			if match := r.pattern.FindString(text); match != "" {
+				return flagged(r.label, match)
+			}
+		}
+	}
+	for i, pattern := range phrasePatterns {
+		if pattern.MatchString(joined) {
+			return flagged("jailbreak phrase", phrases[i])
+		}
+	}
+	return service.PromptInjectionVerdict{}
+}
+
+// normalize folds compatibility characters such as fullwidth letters to their plain
+// form, lowercases content, drops invisible format characters such as zero-width spaces,
+// and collapses runs of whitespace. Line breaks are kept so role markers at the start of
+// a line can still be recognized.
+func normalize(content string) string {
+	content = strings.ToLower(norm.NFKC.String(content))
+	content = strings.Map(func(r rune) rune {
+		if unicode.Is(unicode.Cf, r) {
+			return -1
+		}
+		return r
+	}, content)
+
+	lines := strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = strings.Join(strings.Fields(line), " ")
+	}
+	return strings.Join(lines, "\n")
+}
+
+func flagged(label, match string) service.PromptInjectionVerdict {
+	match = strings.TrimSpace(strings.ReplaceAll(match, "\n", " "))
+	if utf8.RuneCountInString(match) > maxMatchExcerpt {
+		match = string([]rune(match)[:maxMatchExcerpt]) + "…"
+	}
+	return service.PromptInjectionVerdict{
+		Flagged: true,
+		Reason:  fmt.Sprintf("%s: %q", label, match),
+	}
+}
@@ -0,0 +1,82 @@
+package pubsub
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// jobCancelChannel carries generation job IDs cancelled by users to every worker.
+const jobCancelChannel = "events:job-cancel"
+
+// JobCancelPublisher defines the interface for broadcasting job cancellations.
+type JobCancelPublisher interface {
+	PublishJobCancel(ctx context.Context, jobID uuid.UUID) error
+}
+
+// JobCancelSubscriber defines the interface for receiving job cancellations.
+type JobCancelSubscriber interface {
+	SubscribeJobCancels(ctx context.Context) (<-chan uuid.UUID, func(), error)
+}
+
+// PublishJobCancel broadcasts a cancelled job ID to all workers.
+func (p *RedisPubSub) PublishJobCancel(ctx context.Context, jobID uuid.UUID) error {
+	if err := p.client.Publish(ctx, jobCancelChannel, jobID.String()).Err(); err != nil {
+		return fmt.Errorf("failed to publish job cancel: %w", err)
+	}
+
+	p.logger.Debug("published job cancel", "channel", jobCancelChannel, "job_id", jobID)
+
+	return nil
+}
+
+// SubscribeJobCancels subscribes to job cancellations from all API pods.
+// Returns a channel that receives job IDs, a cleanup function, and an error.
+// The subscription survives Redis reconnects; the channel closes when ctx is done.
+func (p *RedisPubSub) SubscribeJobCancels(ctx context.Context) (<-chan uuid.UUID, func(), error) {
+	payloads, cleanup, err := p.subscribe(ctx, jobCancelChannel)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	jobCh := make(chan uuid.UUID, 10)
+
+	// Goroutine to forward messages to the job channel
+	go func() {
+		defer close(jobCh)
+
+		for payload := range payloads {
+			jobID, err := uuid.Parse(payload)
+			if err != nil {
+				p.logger.Error("invalid job cancel payload",
+					"error", err,
+					"payload", payload,
+				)
+				continue
+			}
+
+			select {
+			case jobCh <- jobID:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	p.logger.Debug("subscribed to job cancels", "channel", jobCancelChannel)
+
+	return jobCh, cleanup, nil
+}
+
+// PublishJobCancel does nothing.
+func (p *NoOpPubSub) PublishJobCancel(ctx context.Context, jobID uuid.UUID) error {
+	return nil
+}
+
+// SubscribeJobCancels returns a closed channel (no cancellations will be received).
+func (p *NoOpPubSub) SubscribeJobCancels(ctx context.Context) (<-chan uuid.UUID, func(), error) {
+	ch := make(chan uuid.UUID)
+	close(ch)
+	return ch, func() {}, nil
+}
@@ -13,16 +13,19 @@ import (
 	"github.com/sogos/mirai-backend/internal/domain/service"
 )
 
-// NotificationEvent represents a notification event for pub/sub.
+// NotificationEvent represents a notification event for pub/sub. SUBMISSION_PROGRESS
+// events carry SubmissionProgress instead of a notification.
 type NotificationEvent struct {
-	EventType    v1.NotificationEventType `json:"event_type"`
-	Notification *v1.Notification         `json:"notification"`
+	EventType          v1.NotificationEventType `json:"event_type"`
+	Notification       *v1.Notification         `json:"notification"`
+	SubmissionProgress *v1.SubmissionProgress   `json:"submission_progress"`
 }
 
-// notificationEventWire is the wire format for NotificationEvent using protojson for Notification.
+// notificationEventWire is the wire format for NotificationEvent using protojson for the payloads.
 type notificationEventWire struct {
-	EventType    v1.NotificationEventType `json:"event_type"`
-	Notification json.RawMessage          `json:"notification"`
+	EventType          v1.NotificationEventType `json:"event_type"`
+	Notification       json.RawMessage          `json:"notification"`
+	SubmissionProgress json.RawMessage          `json:"submission_progress,omitempty"`
 }
 
 // MarshalJSON implements custom JSON marshaling using protojson for Notification.
@@ -39,6 +42,12 @@ func (e *NotificationEvent) MarshalJSON() ([]byte, error) {
 		EventType:    e.EventType,
 		Notification: notifBytes,
 	}
+	if e.SubmissionProgress != nil {
+		wire.SubmissionProgress, err = protojson.Marshal(e.SubmissionProgress)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal submission progress: %w", err)
+		}
+	}
 	return json.Marshal(wire)
 }
 
@@ -55,6 +64,12 @@ func (e *NotificationEvent) UnmarshalJSON(data []byte) error {
 			return fmt.Errorf("failed to unmarshal notification: %w", err)
 		}
 	}
+	if len(wire.SubmissionProgress) > 0 {
+		e.SubmissionProgress = &v1.SubmissionProgress{}
+		if err := protojson.Unmarshal(wire.SubmissionProgress, e.SubmissionProgress); err != nil {
+			return fmt.Errorf("failed to unmarshal submission progress: %w", err)
+		}
+	}
 	return nil
 }
 
@@ -137,16 +152,13 @@ func (p *RedisPubSub) PublishNotificationEvent(ctx context.Context, userID uuid.
 
 // SubscribeUserEvents subscribes to a user's notification events.
 // Returns a channel that receives events, a cleanup function, and an error.
+// The subscription survives Redis reconnects; the channel closes when ctx is done.
 func (p *RedisPubSub) SubscribeUserEvents(ctx context.Context, userID uuid.UUID) (<-chan *NotificationEvent, func(), error) {
 	channel := userChannel(userID)
 
-	pubsub := p.client.Subscribe(ctx, channel)
-
-	// Verify subscription is active
-	_, err := pubsub.Receive(ctx)
+	payloads, cleanup, err := p.subscribe(ctx, channel)
 	if err != nil {
-		pubsub.Close()
-		return nil, nil, fmt.Errorf("failed to subscribe to channel %s: %w", channel, err)
+		return nil, nil, err
 	}
 
 	eventCh := make(chan *NotificationEvent, 10)
@@ -155,38 +167,24 @@ func (p *RedisPubSub) SubscribeUserEvents(ctx context.Context, userID uuid.UUID)
 	go func() {
 		defer close(eventCh)
 
-		msgCh := pubsub.Channel()
-		for {
+		for payload := range payloads {
+			var event NotificationEvent
+			if err := json.Unmarshal([]byte(payload), &event); err != nil {
+				p.logger.Error("failed to unmarshal notification event",
+					"error", err,
+					"payload", payload,
+				)
+				continue
+			}
+
 			select {
+			case eventCh <- &event:
 			case <-ctx.Done():
 				return
-			case msg, ok := <-msgCh:
-				if !ok {
-					return
-				}
-
-				var event NotificationEvent
-				if err := json.Unmarshal([]byte(msg.Payload), &event); err != nil {
-					p.logger.Error("failed to unmarshal notification event",
-						"error", err,
-						"payload", msg.Payload,
-					)
-					continue
-				}
-
-				select {
-				case eventCh <- &event:
-				case <-ctx.Done():
-					return
-				}
 			}
 		}
 	}()
 
-	cleanup := func() {
-		pubsub.Close()
-	}
-
 	p.logger.Debug("subscribed to user events", "channel", channel)
 
 	return eventCh, cleanup, nil
@@ -0,0 +1,133 @@
+package pubsub
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// Backoff between attempts to re-subscribe after the connection to Redis drops.
+	resubscribeMinBackoff = time.Second
+	resubscribeMaxBackoff = 30 * time.Second
+)
+
+// subscribe subscribes to a channel and returns the payloads of its messages until ctx
+// is done or the cleanup func is called. If the connection drops, it re-subscribes with
+// exponential backoff. Messages published while disconnected are lost, as with any Redis
+// pub/sub subscriber.
+func (p *RedisPubSub) subscribe(ctx context.Context, channel string) (<-chan string, func(), error) {
+	ps, err := p.openSubscription(ctx, channel)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	sub := &subscription{ps: ps}
+	// A blocked receive only returns once its connection is closed
+	context.AfterFunc(ctx, sub.close)
+
+	payloads := make(chan string, 10)
+	go func() {
+		defer close(payloads)
+		defer sub.close()
+
+		for {
+			msg, err := sub.current().ReceiveMessage(ctx)
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				p.logger.Warn("lost pub/sub connection, re-subscribing", "channel", channel, "error", err)
+				next := p.resubscribe(ctx, channel)
+				if next == nil || !sub.replace(next) {
+					return
+				}
+				continue
+			}
+
+			select {
+			case payloads <- msg.Payload:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return payloads, cancel, nil
+}
+
+// subscription holds the current Redis subscription of a re-subscribing subscriber, so
+// it can be closed from another goroutine.
+type subscription struct {
+	mu     sync.Mutex
+	ps     *redis.PubSub
+	closed bool
+}
+
+func (s *subscription) current() *redis.PubSub {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.ps
+}
+
+// replace swaps in a new subscription, closing the old one. It reports false, closing
+// ps instead, if the subscription was closed in the meantime.
+func (s *subscription) replace(ps *redis.PubSub) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		ps.Close()
+		return false
+	}
+	s.ps.Close()
+	s.ps = ps
+	return true
+}
+
+func (s *subscription) close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.closed {
+		s.closed = true
+		s.ps.Close()
+	}
+}
+
+// resubscribe retries subscribing to channel with exponential backoff until it succeeds
+// or ctx is done, in which case it returns nil.
+func (p *RedisPubSub) resubscribe(ctx context.Context, channel string) *redis.PubSub {
+	backoff := resubscribeMinBackoff
+	for attempt := 1; ; attempt++ {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(backoff):
+		}
+
+		ps, err := p.openSubscription(ctx, channel)
+		if err == nil {
+			p.logger.Info("re-subscribed to channel", "channel", channel, "attempts", attempt)
+			return ps
+		}
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		backoff = min(backoff*2, resubscribeMaxBackoff)
+		p.logger.Warn("failed to re-subscribe, retrying", "channel", channel, "attempt", attempt, "retryIn", backoff, "error", err)
+	}
+}
+
+// openSubscription subscribes to channel and waits for Redis to confirm it.
+func (p *RedisPubSub) openSubscription(ctx context.Context, channel string) (*redis.PubSub, error) {
+	ps := p.client.Subscribe(ctx, channel)
+	if _, err := ps.Receive(ctx); err != nil {
+		ps.Close()
+		return nil, fmt.Errorf("failed to subscribe to channel %s: %w", channel, err)
+	}
+	return ps, nil
+}
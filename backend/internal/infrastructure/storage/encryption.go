@@ -0,0 +1,211 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/infrastructure/crypto"
+)
+
+// Encrypted content objects start with a header naming the tenant key version used:
+//
+//	"MIRAIENC" | format (1 byte) | key version (4 bytes, big-endian)
+//
+// followed by the AES-256-GCM nonce, ciphertext and tag. Objects without the header are
+// legacy plaintext JSON and are read as they are.
+var encryptedContentMagic = []byte("MIRAIENC")
+
+const (
+	encryptedContentFormat     byte = 1
+	encryptedContentHeaderSize      = 8 + 1 + 4
+
+	// EncryptedContentOverhead is how many bytes encryption adds to a content object:
+	// the header, the GCM nonce and the GCM tag.
+	EncryptedContentOverhead = encryptedContentHeaderSize + 12 + 16
+)
+
+// ErrContentKeysUnavailable is returned when reading an encrypted object without a keyring.
+var ErrContentKeysUnavailable = errors.New("content is encrypted but no tenant keyring is configured")
+
+// ContentKeyring provides the per-tenant data keys content is encrypted with.
+type ContentKeyring interface {
+	// ActiveKey returns the key new content is encrypted with, and its version.
+	ActiveKey(ctx context.Context, tenantID uuid.UUID) (int32, *crypto.Encryptor, error)
+
+	// Key returns one version of the tenant's key, for reading older content.
+	Key(ctx context.Context, tenantID uuid.UUID, version int32) (*crypto.Encryptor, error)
+}
+
+// SetContentEncryption enables envelope encryption of course content with per-tenant
+// keys. Encrypted objects can be read once keys is set; new content is written encrypted
+// only with encryptWrites, so every process can read the format before any writes it.
+func (s *TenantAwareStorage) SetContentEncryption(keys ContentKeyring, encryptWrites bool) {
+	s.keys = keys
+	s.encryptWrites = keys != nil && encryptWrites
+}
+
+// ContentEncryptionEnabled reports whether course content is written encrypted.
+func (s *TenantAwareStorage) ContentEncryptionEnabled() bool {
+	return s.encryptWrites
+}
+
+// IsContentObject reports whether a full object path is course content that is stored
+// encrypted: a course's draft content.json or a file of one of its published versions.
+// Uploaded assets, SME files and exports are served as they are and never encrypted.
+func IsContentObject(objectPath string) bool {
+	tenantID, subpath := splitTenantPath(objectPath)
+	if tenantID == "" {
+		return false
+	}
+	parts := strings.Split(subpath, "/")
+	switch {
+	case len(parts) == 3 && parts[0] == "courses":
+		return parts[2] == "content.json"
+	case len(parts) == 5 && parts[0] == "courses" && parts[2] == "published":
+		return parts[4] == PublishedContentFile || parts[4] == PublishedPlayerFile
+	default:
+		return false
+	}
+}
+
+// readContentJSON reads and unmarshals a tenant's content object, decrypting it if needed.
+func (s *TenantAwareStorage) readContentJSON(ctx context.Context, tenantID uuid.UUID, p string, v interface{}) error {
+	inner, err := s.adapter(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+	data, err := inner.GetContent(ctx, p)
+	if err != nil {
+		return err
+	}
+	data, err = s.openContent(ctx, tenantID, data)
+	if err != nil {
+		return fmt.Errorf("failed to decrypt %s: %w", p, err)
+	}
+	return json.Unmarshal(data, v)
+}
+
+// writeContentJSON marshals and writes a tenant's content object, encrypted with the
+// tenant's active key when encryption is on.
+func (s *TenantAwareStorage) writeContentJSON(ctx context.Context, tenantID uuid.UUID, p string, v interface{}) error {
+	inner, err := s.adapter(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+	if !s.encryptWrites {
+		return inner.WriteJSON(ctx, p, v)
+	}
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	sealed, err := s.sealContent(ctx, tenantID, data)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt %s: %w", p, err)
+	}
+	return inner.PutContent(ctx, p, sealed, "application/octet-stream")
+}
+
+// ReencryptContent rewrites one of a tenant's content objects with the tenant's active
+// key if it is plaintext or was encrypted with an older key, and reports whether it was
+// rewritten. Other objects are left alone. An object changed by another write while it
+// was being re-encrypted is skipped, since that write used the active key.
+func (s *TenantAwareStorage) ReencryptContent(ctx context.Context, tenantID uuid.UUID, objectPath string) (bool, error) {
+	if !s.encryptWrites {
+		return false, errors.New("content encryption is not enabled")
+	}
+	if !strings.HasPrefix(objectPath, s.BuildPath(tenantID, "")+"/") || !IsContentObject(objectPath) {
+		return false, nil
+	}
+	inner, err := s.adapter(ctx, tenantID)
+	if err != nil {
+		return false, err
+	}
+
+	stored, err := inner.GetContent(ctx, objectPath)
+	if err != nil {
+		return false, err
+	}
+	activeVersion, _, err := s.keys.ActiveKey(ctx, tenantID)
+	if err != nil {
+		return false, err
+	}
+	if version, ok := contentKeyVersion(stored); ok && version == activeVersion {
+		return false, nil
+	}
+
+	data, err := s.openContent(ctx, tenantID, stored)
+	if err != nil {
+		return false, fmt.Errorf("failed to decrypt %s: %w", objectPath, err)
+	}
+	sealed, err := s.sealContent(ctx, tenantID, data)
+	if err != nil {
+		return false, fmt.Errorf("failed to encrypt %s: %w", objectPath, err)
+	}
+
+	current, err := inner.GetContent(ctx, objectPath)
+	if err != nil {
+		return false, err
+	}
+	if !bytes.Equal(current, stored) {
+		return false, nil
+	}
+	if err := inner.PutContent(ctx, objectPath, sealed, "application/octet-stream"); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// sealContent encrypts data with the tenant's active key and prepends the header.
+func (s *TenantAwareStorage) sealContent(ctx context.Context, tenantID uuid.UUID, data []byte) ([]byte, error) {
+	version, key, err := s.keys.ActiveKey(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	ciphertext, err := key.Encrypt(data)
+	if err != nil {
+		return nil, err
+	}
+
+	sealed := make([]byte, encryptedContentHeaderSize, encryptedContentHeaderSize+len(ciphertext))
+	copy(sealed, encryptedContentMagic)
+	sealed[len(encryptedContentMagic)] = encryptedContentFormat
+	binary.BigEndian.PutUint32(sealed[len(encryptedContentMagic)+1:], uint32(version))
+	return append(sealed, ciphertext...), nil
+}
+
+// openContent returns the plaintext of a stored object: legacy objects as they are,
+// encrypted ones decrypted with the key version named in their header.
+func (s *TenantAwareStorage) openContent(ctx context.Context, tenantID uuid.UUID, stored []byte) ([]byte, error) {
+	version, ok := contentKeyVersion(stored)
+	if !ok {
+		return stored, nil
+	}
+	if stored[len(encryptedContentMagic)] != encryptedContentFormat {
+		return nil, fmt.Errorf("unknown encrypted content format %d", stored[len(encryptedContentMagic)])
+	}
+	if s.keys == nil {
+		return nil, ErrContentKeysUnavailable
+	}
+	key, err := s.keys.Key(ctx, tenantID, version)
+	if err != nil {
+		return nil, err
+	}
+	return key.Decrypt(stored[encryptedContentHeaderSize:])
+}
+
+// contentKeyVersion returns the key version in an encrypted object's header, or false
+// for a legacy plaintext object.
+func contentKeyVersion(stored []byte) (int32, bool) {
+	if len(stored) < encryptedContentHeaderSize || !bytes.HasPrefix(stored, encryptedContentMagic) {
+		return 0, false
+	}
+	return int32(binary.BigEndian.Uint32(stored[len(encryptedContentMagic)+1:])), true
+}
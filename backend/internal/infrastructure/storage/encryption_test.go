@@ -0,0 +1,231 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/infrastructure/crypto"
+)
+
+// memoryAdapter stores objects in memory, so the benchmarks measure decoding and
+// decryption rather than the disk or network.
+type memoryAdapter struct {
+	StorageAdapter
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+func newMemoryAdapter() *memoryAdapter {
+	return &memoryAdapter{objects: make(map[string][]byte)}
+}
+
+func (a *memoryAdapter) GetContent(_ context.Context, p string) ([]byte, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	data, ok := a.objects[p]
+	if !ok {
+		return nil, fmt.Errorf("%s not found", p)
+	}
+	return data, nil
+}
+
+func (a *memoryAdapter) WriteJSON(ctx context.Context, p string, v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	return a.PutContent(ctx, p, data, "application/json")
+}
+
+func (a *memoryAdapter) PutContent(_ context.Context, p string, content []byte, _ string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.objects[p] = bytes.Clone(content)
+	return nil
+}
+
+// testKeyring holds key versions for every tenant; the highest version is active.
+type testKeyring struct {
+	keys []*crypto.Encryptor
+}
+
+func newTestKeyring(t testing.TB, versions int) *testKeyring {
+	t.Helper()
+	k := &testKeyring{}
+	for range versions {
+		raw := make([]byte, 32)
+		if _, err := rand.Read(raw); err != nil {
+			t.Fatal(err)
+		}
+		key, err := crypto.NewEncryptorFromKey(raw)
+		if err != nil {
+			t.Fatal(err)
+		}
+		k.keys = append(k.keys, key)
+	}
+	return k
+}
+
+func (k *testKeyring) ActiveKey(context.Context, uuid.UUID) (int32, *crypto.Encryptor, error) {
+	return int32(len(k.keys)), k.keys[len(k.keys)-1], nil
+}
+
+func (k *testKeyring) Key(_ context.Context, _ uuid.UUID, version int32) (*crypto.Encryptor, error) {
+	if version < 1 || int(version) > len(k.keys) {
+		return nil, fmt.Errorf("no key version %d", version)
+	}
+	return k.keys[version-1], nil
+}
+
+// testCourseContent builds course content with the given number of lessons, each with
+// a few text blocks, roughly 1.5 KB of JSON per lesson.
+func testCourseContent(lessons int) map[string]any {
+	paragraph := strings.Repeat("Store raw meat below cooked food to avoid cross-contamination. ", 6)
+	var sections []any
+	for s := 0; s < (lessons+4)/5; s++ {
+		var sectionLessons []any
+		for l := 0; l < 5 && s*5+l < lessons; l++ {
+			var blocks []any
+			for b := range 3 {
+				blocks = append(blocks, map[string]any{"id": uuid.NewString(), "type": "text", "position": b, "content": paragraph})
+			}
+			sectionLessons = append(sectionLessons, map[string]any{"id": uuid.NewString(), "title": fmt.Sprintf("Lesson %d", l+1), "blocks": blocks})
+		}
+		sections = append(sections, map[string]any{"id": uuid.NewString(), "title": fmt.Sprintf("Section %d", s+1), "lessons": sectionLessons})
+	}
+	return map[string]any{"sections": sections, "courseBlocks": []any{}}
+}
+
+func TestCourseContentEncryption(t *testing.T) {
+	ctx := context.Background()
+	tenantID, courseID := uuid.New(), uuid.New()
+	adapter := newMemoryAdapter()
+	s := NewTenantAwareStorage(adapter)
+	keys := newTestKeyring(t, 1)
+	content := testCourseContent(3)
+
+	// Legacy plaintext written before encryption was enabled
+	if err := adapter.PutContent(ctx, s.CoursePath(tenantID, courseID), []byte(`{"sections":[{"id":"s1"}]}`), "application/json"); err != nil {
+		t.Fatal(err)
+	}
+	s.SetContentEncryption(keys, true)
+	var legacy map[string]any
+	if err := s.ReadCourseContent(ctx, tenantID, courseID, &legacy); err != nil {
+		t.Fatalf("legacy read: %v", err)
+	}
+	if len(legacy["sections"].([]any)) != 1 {
+		t.Errorf("legacy content = %v", legacy)
+	}
+
+	if err := s.WriteCourseContent(ctx, tenantID, courseID, content); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	stored := adapter.objects[s.CoursePath(tenantID, courseID)]
+	if !bytes.HasPrefix(stored, encryptedContentMagic) || bytes.Contains(stored, []byte("cross-contamination")) {
+		t.Fatal("content was stored in plaintext")
+	}
+	if size, _ := s.CourseContentSize(content); size != int64(len(stored)) {
+		t.Errorf("CourseContentSize = %d, stored %d bytes", size, len(stored))
+	}
+
+	// Content written with an older key is still readable after rotation
+	keys.keys = append(keys.keys, newTestKeyring(t, 1).keys...)
+	var got map[string]any
+	if err := s.ReadCourseContent(ctx, tenantID, courseID, &got); err != nil {
+		t.Fatalf("read after rotation: %v", err)
+	}
+	if len(got["sections"].([]any)) != 1 {
+		t.Errorf("read %d sections, want 1", len(got["sections"].([]any)))
+	}
+
+	// Without a keyring, encrypted content can't be read
+	s.SetContentEncryption(nil, false)
+	if err := s.ReadCourseContent(ctx, tenantID, courseID, &got); !errors.Is(err, ErrContentKeysUnavailable) {
+		t.Errorf("read without keys = %v, want ErrContentKeysUnavailable", err)
+	}
+}
+
+// BenchmarkReadCourseContent measures the course read path for legacy plaintext objects
+// and encrypted ones, so the cost of decryption can be compared with JSON decoding.
+func BenchmarkReadCourseContent(b *testing.B) {
+	ctx := context.Background()
+	tenantID, courseID := uuid.New(), uuid.New()
+
+	for _, lessons := range []int{5, 50, 500} {
+		content := testCourseContent(lessons)
+
+		for _, encrypted := range []bool{false, true} {
+			name := fmt.Sprintf("legacy/lessons=%d", lessons)
+			if encrypted {
+				name = fmt.Sprintf("encrypted/lessons=%d", lessons)
+			}
+			b.Run(name, func(b *testing.B) {
+				s := NewTenantAwareStorage(newMemoryAdapter())
+				s.SetContentEncryption(newTestKeyring(b, 1), encrypted)
+				if err := s.WriteCourseContent(ctx, tenantID, courseID, content); err != nil {
+					b.Fatalf("write: %v", err)
+				}
+				size, _ := s.CourseContentSize(content)
+				b.SetBytes(size)
+				b.ReportAllocs()
+
+				for b.Loop() {
+					var got map[string]any
+					if err := s.ReadCourseContent(ctx, tenantID, courseID, &got); err != nil {
+						b.Fatalf("read: %v", err)
+					}
+				}
+			})
+		}
+	}
+}
+
+// BenchmarkContentEnvelope isolates sealContent and openContent from JSON decoding.
+func BenchmarkContentEnvelope(b *testing.B) {
+	ctx := context.Background()
+	tenantID := uuid.New()
+	s := NewTenantAwareStorage(newMemoryAdapter())
+	s.SetContentEncryption(newTestKeyring(b, 1), true)
+
+	for _, size := range []int{16 << 10, 256 << 10, 2 << 20} {
+		plaintext := bytes.Repeat([]byte(`{"content":"text"}`), size/18)
+		sealed, err := s.sealContent(ctx, tenantID, plaintext)
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		b.Run(fmt.Sprintf("seal/%dKB", size>>10), func(b *testing.B) {
+			b.SetBytes(int64(len(plaintext)))
+			for b.Loop() {
+				if _, err := s.sealContent(ctx, tenantID, plaintext); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+		b.Run(fmt.Sprintf("open/%dKB", size>>10), func(b *testing.B) {
+			b.SetBytes(int64(len(plaintext)))
+			for b.Loop() {
+				if _, err := s.openContent(ctx, tenantID, sealed); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+		b.Run(fmt.Sprintf("open-legacy/%dKB", size>>10), func(b *testing.B) {
+			b.SetBytes(int64(len(plaintext)))
+			for b.Loop() {
+				if _, err := s.openContent(ctx, tenantID, plaintext); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -2,22 +2,39 @@ package storage
 
 import (
 	"context"
+	"crypto/md5"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"strings"
 	"time"
+
+	"github.com/google/uuid"
 )
 
+// metaSuffix names the sidecar file that holds an object's lifecycle tags,
+// e.g. "content.json.meta" next to "content.json".
+const metaSuffix = ".meta"
+
 // LocalStorage implements StorageAdapter using the local filesystem.
 type LocalStorage struct {
-	basePath string
+	basePath  string
+	retention RetentionPolicy
 }
 
 // NewLocalStorage creates a new local filesystem storage adapter.
 func NewLocalStorage(basePath string) *LocalStorage {
-	return &LocalStorage{basePath: basePath}
+	return &LocalStorage{basePath: basePath, retention: DefaultRetentionPolicy()}
+}
+
+// SetRetentionPolicy overrides the default retention class per object type.
+func (s *LocalStorage) SetRetentionPolicy(p RetentionPolicy) {
+	s.retention = p
 }
 
 // ReadJSON reads and unmarshals a JSON file.
@@ -45,7 +62,10 @@ func (s *LocalStorage) WriteJSON(ctx context.Context, path string, v interface{}
 		return err
 	}
 
-	return os.WriteFile(fullPath, data, 0644)
+	if err := os.WriteFile(fullPath, data, 0644); err != nil {
+		return err
+	}
+	return s.TagObject(ctx, path)
 }
 
 // ListFiles lists all JSON files in a directory.
@@ -73,7 +93,13 @@ func (s *LocalStorage) ListFiles(ctx context.Context, directory string) ([]strin
 // Delete removes a file.
 func (s *LocalStorage) Delete(ctx context.Context, path string) error {
 	fullPath := filepath.Join(s.basePath, path)
-	return os.Remove(fullPath)
+	if err := os.Remove(fullPath); err != nil {
+		return err
+	}
+	if err := os.Remove(fullPath + metaSuffix); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
 }
 
 // Exists checks if a file exists.
@@ -105,6 +131,11 @@ func (s *LocalStorage) GetContent(ctx context.Context, path string) ([]byte, err
 	return os.ReadFile(fullPath)
 }
 
+// OpenContent opens a file in local storage for streaming reads.
+func (s *LocalStorage) OpenContent(ctx context.Context, path string) (io.ReadCloser, error) {
+	return os.Open(filepath.Join(s.basePath, path))
+}
+
 // PutContent stores raw content to local storage.
 func (s *LocalStorage) PutContent(ctx context.Context, path string, content []byte, contentType string) error {
 	fullPath := filepath.Join(s.basePath, path)
@@ -115,5 +146,276 @@ func (s *LocalStorage) PutContent(ctx context.Context, path string, content []by
 		return err
 	}
 
-	return os.WriteFile(fullPath, content, 0644)
+	if err := os.WriteFile(fullPath, content, 0644); err != nil {
+		return err
+	}
+	return s.TagObject(ctx, path)
+}
+
+// CopyObject copies a file to another path in local storage.
+func (s *LocalStorage) CopyObject(ctx context.Context, src, dst string) error {
+	content, err := os.ReadFile(filepath.Join(s.basePath, src))
+	if err != nil {
+		return err
+	}
+	return s.PutContent(ctx, dst, content, "")
+}
+
+// ListObjects lists every file under a prefix, skipping tag sidecars.
+func (s *LocalStorage) ListObjects(ctx context.Context, prefix string) ([]ObjectInfo, error) {
+	var objects []ObjectInfo
+	err := s.WalkObjects(ctx, prefix, func(obj ObjectInfo) error {
+		objects = append(objects, obj)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return objects, nil
+}
+
+// WalkObjects calls fn for every file under a prefix, skipping sidecar metadata files.
+func (s *LocalStorage) WalkObjects(ctx context.Context, prefix string, fn func(ObjectInfo) error) error {
+	root := filepath.Join(s.basePath, prefix)
+
+	return filepath.WalkDir(root, func(fullPath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if entry.IsDir() || strings.HasSuffix(entry.Name(), metaSuffix) {
+			return nil
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(s.basePath, fullPath)
+		if err != nil {
+			return err
+		}
+		p := filepath.ToSlash(rel)
+		_, subpath := splitTenantPath(p)
+		return fn(ObjectInfo{
+			Path:         p,
+			Size:         info.Size(),
+			ObjectType:   ObjectTypeForPath(subpath),
+			LastModified: info.ModTime(),
+		})
+	})
+}
+
+// TagObject writes the object's lifecycle tags to its sidecar metadata file.
+func (s *LocalStorage) TagObject(ctx context.Context, path string) error {
+	data, err := json.MarshalIndent(s.retention.TagsForPath(path), "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(s.basePath, path)+metaSuffix, data, 0644)
+}
+
+// multipartDir holds in-progress multipart uploads, one directory per upload ID,
+// outside the tenant tree so listings never see partial parts.
+const multipartDir = ".multipart"
+
+// localUpload is the manifest stored alongside a local multipart upload's parts.
+type localUpload struct {
+	Path      string    `json:"path"`
+	Initiated time.Time `json:"initiated"`
+}
+
+func (s *LocalStorage) uploadDir(uploadID string) string {
+	return filepath.Join(s.basePath, multipartDir, filepath.Base(uploadID))
+}
+
+func (s *LocalStorage) partPath(uploadID string, partNumber int32) string {
+	return filepath.Join(s.uploadDir(uploadID), fmt.Sprintf("part-%05d", partNumber))
+}
+
+// readUpload loads an upload's manifest and checks it belongs to path.
+func (s *LocalStorage) readUpload(path, uploadID string) (*localUpload, error) {
+	data, err := os.ReadFile(filepath.Join(s.uploadDir(uploadID), "upload.json"))
+	if err != nil {
+		return nil, err
+	}
+	var upload localUpload
+	if err := json.Unmarshal(data, &upload); err != nil {
+		return nil, err
+	}
+	if upload.Path != path {
+		return nil, fmt.Errorf("upload %s is not for %s", uploadID, path)
+	}
+	return &upload, nil
+}
+
+// CreateMultipartUpload starts a multipart upload in a temporary directory.
+func (s *LocalStorage) CreateMultipartUpload(ctx context.Context, path string, contentType string) (string, error) {
+	uploadID := uuid.NewString()
+	dir := s.uploadDir(uploadID)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+
+	data, err := json.Marshal(localUpload{Path: path, Initiated: time.Now().UTC()})
+	if err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(filepath.Join(dir, "upload.json"), data, 0644); err != nil {
+		return "", err
+	}
+	return uploadID, nil
+}
+
+// GeneratePartUploadURL is not supported for local storage; use UploadPart.
+func (s *LocalStorage) GeneratePartUploadURL(ctx context.Context, path, uploadID string, partNumber int32, expiry time.Duration) (string, error) {
+	return "", errors.New("presigned URLs not supported for local storage")
+}
+
+// UploadPart stores one part of a multipart upload. Local storage has no presigned
+// URLs, so this stands in for the client's direct part upload.
+func (s *LocalStorage) UploadPart(ctx context.Context, path, uploadID string, partNumber int32, r io.Reader) (*UploadedPart, error) {
+	if _, err := s.readUpload(path, uploadID); err != nil {
+		return nil, err
+	}
+
+	f, err := os.Create(s.partPath(uploadID, partNumber))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	hash := md5.New()
+	size, err := io.Copy(io.MultiWriter(f, hash), r)
+	if err != nil {
+		return nil, err
+	}
+	return &UploadedPart{
+		PartNumber: partNumber,
+		ETag:       hex.EncodeToString(hash.Sum(nil)),
+		Size:       size,
+	}, nil
+}
+
+// ListUploadedParts lists the parts stored for a multipart upload.
+func (s *LocalStorage) ListUploadedParts(ctx context.Context, path, uploadID string) ([]UploadedPart, error) {
+	if _, err := s.readUpload(path, uploadID); err != nil {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(s.uploadDir(uploadID))
+	if err != nil {
+		return nil, err
+	}
+
+	var parts []UploadedPart
+	for _, entry := range entries {
+		var partNumber int32
+		if _, err := fmt.Sscanf(entry.Name(), "part-%05d", &partNumber); err != nil {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(s.uploadDir(uploadID), entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		sum := md5.Sum(data)
+		parts = append(parts, UploadedPart{
+			PartNumber: partNumber,
+			ETag:       hex.EncodeToString(sum[:]),
+			Size:       int64(len(data)),
+		})
+	}
+	return parts, nil
+}
+
+// CompleteMultipartUpload appends the parts, in order, to a temporary file and
+// moves it into place.
+func (s *LocalStorage) CompleteMultipartUpload(ctx context.Context, path, uploadID string, parts []UploadedPart) error {
+	if _, err := s.readUpload(path, uploadID); err != nil {
+		return err
+	}
+
+	fullPath := filepath.Join(s.basePath, path)
+	if err := os.MkdirAll(filepath.Dir(fullPath), 0755); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(fullPath), filepath.Base(fullPath)+".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	for _, part := range parts {
+		if err := appendFile(tmp, s.partPath(uploadID, part.PartNumber)); err != nil {
+			tmp.Close()
+			return err
+		}
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp.Name(), fullPath); err != nil {
+		return err
+	}
+
+	if err := os.RemoveAll(s.uploadDir(uploadID)); err != nil {
+		return err
+	}
+	return s.TagObject(ctx, path)
+}
+
+func appendFile(dst *os.File, src string) error {
+	f, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = io.Copy(dst, f)
+	return err
+}
+
+// AbortMultipartUpload removes an upload's temporary directory.
+func (s *LocalStorage) AbortMultipartUpload(ctx context.Context, path, uploadID string) error {
+	if _, err := s.readUpload(path, uploadID); err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	return os.RemoveAll(s.uploadDir(uploadID))
+}
+
+// ListMultipartUploads lists in-progress uploads whose path is under prefix.
+func (s *LocalStorage) ListMultipartUploads(ctx context.Context, prefix string) ([]MultipartUploadInfo, error) {
+	entries, err := os.ReadDir(filepath.Join(s.basePath, multipartDir))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var uploads []MultipartUploadInfo
+	for _, entry := range entries {
+		data, err := os.ReadFile(filepath.Join(s.uploadDir(entry.Name()), "upload.json"))
+		if err != nil {
+			continue
+		}
+		var upload localUpload
+		if err := json.Unmarshal(data, &upload); err != nil || !strings.HasPrefix(upload.Path, prefix) {
+			continue
+		}
+		uploads = append(uploads, MultipartUploadInfo{
+			Path:      upload.Path,
+			UploadID:  entry.Name(),
+			Initiated: upload.Initiated,
+		})
+	}
+	return uploads, nil
 }
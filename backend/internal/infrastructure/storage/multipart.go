@@ -0,0 +1,120 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// S3 multipart limits. Every part except the last must be at least MinPartSize.
+const (
+	MultipartMinPartSize int64 = 5 << 20
+	MultipartMaxPartSize int64 = 5 << 30
+	MultipartMaxParts    int32 = 10000
+)
+
+// UploadedPart describes a part stored for an in-progress multipart upload.
+type UploadedPart struct {
+	PartNumber int32
+	ETag       string
+	Size       int64
+}
+
+// MultipartUploadInfo describes an in-progress multipart upload.
+type MultipartUploadInfo struct {
+	Path      string
+	UploadID  string
+	Initiated time.Time
+}
+
+// MultipartWriter writes an object as a multipart upload, uploading each part as soon
+// as MultipartMinPartSize bytes are buffered, so at most one part is held in memory.
+// Close completes the upload; Abort discards it.
+type MultipartWriter struct {
+	ctx      context.Context
+	adapter  StorageAdapter
+	path     string
+	uploadID string
+	buf      bytes.Buffer
+	parts    []UploadedPart
+	size     int64
+	err      error
+}
+
+// NewMultipartWriter starts a multipart upload to path.
+func NewMultipartWriter(ctx context.Context, adapter StorageAdapter, path, contentType string) (*MultipartWriter, error) {
+	uploadID, err := adapter.CreateMultipartUpload(ctx, path, contentType)
+	if err != nil {
+		return nil, err
+	}
+	return &MultipartWriter{ctx: ctx, adapter: adapter, path: path, uploadID: uploadID}, nil
+}
+
+// Write buffers p, uploading a part whenever a full part is buffered.
+func (w *MultipartWriter) Write(p []byte) (int, error) {
+	if w.err != nil {
+		return 0, w.err
+	}
+	n, _ := w.buf.Write(p)
+	if int64(w.buf.Len()) >= MultipartMinPartSize {
+		if err := w.flushPart(); err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+// Size returns the number of bytes written so far.
+func (w *MultipartWriter) Size() int64 {
+	return w.size + int64(w.buf.Len())
+}
+
+// Close uploads the remaining bytes as the last part and completes the upload.
+// The upload is aborted if either step fails.
+func (w *MultipartWriter) Close() error {
+	if w.err != nil {
+		return w.err
+	}
+	if w.buf.Len() > 0 || len(w.parts) == 0 {
+		if err := w.flushPart(); err != nil {
+			w.Abort()
+			return err
+		}
+	}
+	if err := w.adapter.CompleteMultipartUpload(w.ctx, w.path, w.uploadID, w.parts); err != nil {
+		w.err = err
+		w.Abort()
+		return err
+	}
+	w.err = errors.New("multipart writer is closed")
+	return nil
+}
+
+// Abort discards the upload and its stored parts.
+func (w *MultipartWriter) Abort() error {
+	if w.err == nil {
+		w.err = errors.New("multipart upload was aborted")
+	}
+	// The writer's context may already be cancelled; the parts still have to go
+	return w.adapter.AbortMultipartUpload(context.WithoutCancel(w.ctx), w.path, w.uploadID)
+}
+
+func (w *MultipartWriter) flushPart() error {
+	partNumber := int32(len(w.parts)) + 1
+	if partNumber > MultipartMaxParts {
+		w.err = fmt.Errorf("object exceeds %d parts", MultipartMaxParts)
+		return w.err
+	}
+
+	part, err := w.adapter.UploadPart(w.ctx, w.path, w.uploadID, partNumber, bytes.NewReader(w.buf.Bytes()))
+	if err != nil {
+		w.err = fmt.Errorf("failed to upload part %d: %w", partNumber, err)
+		return w.err
+	}
+	w.parts = append(w.parts, *part)
+	w.size += part.Size
+	w.buf.Reset()
+	return nil
+}
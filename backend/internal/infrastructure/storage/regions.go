@@ -0,0 +1,175 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mime"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrUnknownStorageRegion is returned when a tenant's storage region isn't configured.
+var ErrUnknownStorageRegion = errors.New("storage region is not configured")
+
+// TenantRegionResolver looks up which storage region holds a tenant's objects.
+type TenantRegionResolver interface {
+	// TenantStorageRegion returns the tenant's region, or "" for the primary region.
+	TenantStorageRegion(ctx context.Context, tenantID uuid.UUID) (string, error)
+}
+
+// SetRegions routes each tenant's objects to the adapter of its storage region. The
+// adapter TenantAwareStorage was created with is the primary region; tenants the resolver
+// places elsewhere are read, written and presigned through that region's adapter.
+func (s *TenantAwareStorage) SetRegions(primary string, regions map[string]StorageAdapter, resolver TenantRegionResolver) {
+	s.primaryRegion = primary
+	s.regions = regions
+	s.resolver = resolver
+}
+
+// PrimaryRegion returns the name of the primary storage region.
+func (s *TenantAwareStorage) PrimaryRegion() string {
+	return s.primaryRegion
+}
+
+// Regions returns the names of every configured storage region, primary first.
+func (s *TenantAwareStorage) Regions() []string {
+	names := make([]string, 0, len(s.regions))
+	for name := range s.regions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return append([]string{s.primaryRegion}, names...)
+}
+
+// HasRegion reports whether a storage region is configured.
+func (s *TenantAwareStorage) HasRegion(name string) bool {
+	_, err := s.regionAdapter(name)
+	return err == nil
+}
+
+// regionAdapter returns the adapter of a region; "" is the primary region.
+func (s *TenantAwareStorage) regionAdapter(name string) (StorageAdapter, error) {
+	if name == "" || name == s.primaryRegion {
+		return s.inner, nil
+	}
+	if adapter, ok := s.regions[name]; ok {
+		return adapter, nil
+	}
+	return nil, fmt.Errorf("%w: %q", ErrUnknownStorageRegion, name)
+}
+
+// adapter returns the adapter of the region holding the tenant's objects.
+func (s *TenantAwareStorage) adapter(ctx context.Context, tenantID uuid.UUID) (StorageAdapter, error) {
+	if s.resolver == nil || len(s.regions) == 0 {
+		return s.inner, nil
+	}
+	region, err := s.resolver.TenantStorageRegion(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve storage region for tenant %s: %w", tenantID, err)
+	}
+	return s.regionAdapter(region)
+}
+
+// pathAdapter returns the adapter for a full object path. Paths under tenants/{id}/ go to
+// the tenant's region; anything else is in the primary region.
+func (s *TenantAwareStorage) pathAdapter(ctx context.Context, objectPath string) (StorageAdapter, error) {
+	rest, ok := strings.CutPrefix(objectPath, "tenants/")
+	if !ok {
+		return s.inner, nil
+	}
+	id, _, _ := strings.Cut(rest, "/")
+	tenantID, err := uuid.Parse(id)
+	if err != nil {
+		return s.inner, nil
+	}
+	return s.adapter(ctx, tenantID)
+}
+
+// CopyTenantObjectsBetweenRegions copies a tenant's objects from one region to another
+// and returns how many were copied. With a non-zero since, only objects modified at or
+// after it are copied, and only when the destination has no newer copy. A failed copy
+// stops the walk and is returned.
+func (s *TenantAwareStorage) CopyTenantObjectsBetweenRegions(ctx context.Context, tenantID uuid.UUID, from, to string, since time.Time) (int, error) {
+	src, err := s.regionAdapter(from)
+	if err != nil {
+		return 0, err
+	}
+	dst, err := s.regionAdapter(to)
+	if err != nil {
+		return 0, err
+	}
+	prefix := s.BuildPath(tenantID, "")
+
+	var existing map[string]time.Time
+	if !since.IsZero() {
+		existing = make(map[string]time.Time)
+		err := dst.WalkObjects(ctx, prefix, func(obj ObjectInfo) error {
+			existing[obj.Path] = obj.LastModified
+			return nil
+		})
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	copied := 0
+	err = src.WalkObjects(ctx, prefix, func(obj ObjectInfo) error {
+		if !since.IsZero() {
+			if obj.LastModified.Before(since) {
+				return nil
+			}
+			if modified, ok := existing[obj.Path]; ok && !modified.Before(obj.LastModified) {
+				return nil
+			}
+		}
+		data, err := src.GetContent(ctx, obj.Path)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", obj.Path, err)
+		}
+		if err := dst.PutContent(ctx, obj.Path, data, contentTypeFor(obj.Path, data)); err != nil {
+			return fmt.Errorf("failed to write %s: %w", obj.Path, err)
+		}
+		copied++
+		return nil
+	})
+	return copied, err
+}
+
+// DeleteTenantObjectsInRegion deletes every object a tenant has in one region and
+// returns how many were deleted. Objects that fail to delete are skipped; the errors are
+// returned joined.
+func (s *TenantAwareStorage) DeleteTenantObjectsInRegion(ctx context.Context, tenantID uuid.UUID, region string) (int, error) {
+	adapter, err := s.regionAdapter(region)
+	if err != nil {
+		return 0, err
+	}
+
+	deleted := 0
+	var errs []error
+	err = adapter.WalkObjects(ctx, s.BuildPath(tenantID, ""), func(obj ObjectInfo) error {
+		if err := adapter.Delete(ctx, obj.Path); err != nil {
+			errs = append(errs, err)
+			return nil
+		}
+		deleted++
+		return nil
+	})
+	return deleted, errors.Join(append(errs, err)...)
+}
+
+// contentTypeFor guesses an object's content type from its extension. Encrypted content
+// is an opaque blob whatever its name.
+func contentTypeFor(objectPath string, data []byte) string {
+	if _, encrypted := contentKeyVersion(data); encrypted {
+		return "application/octet-stream"
+	}
+	if contentType := mime.TypeByExtension(path.Ext(objectPath)); contentType != "" {
+		return contentType
+	}
+	return "application/octet-stream"
+}
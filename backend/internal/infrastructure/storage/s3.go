@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"errors"
 	"io"
+	"net/url"
 	"path"
 	"strings"
 	"time"
@@ -24,6 +25,7 @@ type S3Storage struct {
 	presignClient *s3.PresignClient
 	bucket        string
 	basePath      string
+	retention     RetentionPolicy
 }
 
 // S3Config holds S3/MinIO configuration.
@@ -34,6 +36,7 @@ type S3Config struct {
 	BasePath        string // "data"
 	AccessKeyID     string
 	SecretAccessKey string
+	Retention       RetentionPolicy // Defaults to DefaultRetentionPolicy
 }
 
 // NewS3Storage creates a new S3-compatible storage adapter.
@@ -88,11 +91,17 @@ func NewS3Storage(ctx context.Context, cfg S3Config) (*S3Storage, error) {
 
 	presignClient := s3.NewPresignClient(client)
 
+	retention := cfg.Retention
+	if retention == nil {
+		retention = DefaultRetentionPolicy()
+	}
+
 	return &S3Storage{
 		client:        client,
 		presignClient: presignClient,
 		bucket:        cfg.Bucket,
 		basePath:      cfg.BasePath,
+		retention:     retention,
 	}, nil
 }
 
@@ -104,6 +113,11 @@ func (s *S3Storage) fullKey(p string) string {
 	return path.Join(s.basePath, p)
 }
 
+// tagging returns the encoded lifecycle tags for an object path.
+func (s *S3Storage) tagging(p string) *string {
+	return aws.String(s.retention.TagsForPath(p).Encode())
+}
+
 // ReadJSON reads and unmarshals a JSON file from S3.
 func (s *S3Storage) ReadJSON(ctx context.Context, p string, v interface{}) error {
 	result, err := s.client.GetObject(ctx, &s3.GetObjectInput{
@@ -135,6 +149,7 @@ func (s *S3Storage) WriteJSON(ctx context.Context, p string, v interface{}) erro
 		Key:         aws.String(s.fullKey(p)),
 		Body:        bytes.NewReader(data),
 		ContentType: aws.String("application/json"),
+		Tagging:     s.tagging(p),
 	})
 
 	return err
@@ -234,6 +249,18 @@ func (s *S3Storage) GetContent(ctx context.Context, p string) ([]byte, error) {
 	return io.ReadAll(result.Body)
 }
 
+// OpenContent opens an S3 object for streaming reads.
+func (s *S3Storage) OpenContent(ctx context.Context, p string) (io.ReadCloser, error) {
+	result, err := s.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.fullKey(p)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.Body, nil
+}
+
 // PutContent stores raw content to S3.
 func (s *S3Storage) PutContent(ctx context.Context, p string, content []byte, contentType string) error {
 	_, err := s.client.PutObject(ctx, &s3.PutObjectInput{
@@ -241,6 +268,269 @@ func (s *S3Storage) PutContent(ctx context.Context, p string, content []byte, co
 		Key:         aws.String(s.fullKey(p)),
 		Body:        bytes.NewReader(content),
 		ContentType: aws.String(contentType),
+		Tagging:     s.tagging(p),
 	})
 	return err
 }
+
+// CopyObject copies an object within the bucket without downloading it, replacing its
+// tags with the destination path's lifecycle tags.
+func (s *S3Storage) CopyObject(ctx context.Context, src, dst string) error {
+	source := url.URL{Path: s.bucket + "/" + s.fullKey(src)}
+	_, err := s.client.CopyObject(ctx, &s3.CopyObjectInput{
+		Bucket:           aws.String(s.bucket),
+		Key:              aws.String(s.fullKey(dst)),
+		CopySource:       aws.String(source.EscapedPath()),
+		TaggingDirective: types.TaggingDirectiveReplace,
+		Tagging:          s.tagging(dst),
+	})
+	return err
+}
+
+// ListObjects lists every object under a prefix.
+func (s *S3Storage) ListObjects(ctx context.Context, prefix string) ([]ObjectInfo, error) {
+	var objects []ObjectInfo
+	err := s.WalkObjects(ctx, prefix, func(obj ObjectInfo) error {
+		objects = append(objects, obj)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return objects, nil
+}
+
+// WalkObjects calls fn for every object under a prefix, one listing page at a time.
+// Paths are relative to the base path, like the paths passed in.
+func (s *S3Storage) WalkObjects(ctx context.Context, prefix string, fn func(ObjectInfo) error) error {
+	fullPrefix := s.fullKey(prefix)
+	if !strings.HasSuffix(fullPrefix, "/") {
+		fullPrefix += "/"
+	}
+
+	paginator := s3.NewListObjectsV2Paginator(s.client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(s.bucket),
+		Prefix: aws.String(fullPrefix),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return err
+		}
+		for _, obj := range page.Contents {
+			p := aws.ToString(obj.Key)
+			if s.basePath != "" {
+				p = strings.TrimPrefix(p, s.basePath+"/")
+			}
+			_, subpath := splitTenantPath(p)
+			err := fn(ObjectInfo{
+				Path:         p,
+				Size:         aws.ToInt64(obj.Size),
+				ObjectType:   ObjectTypeForPath(subpath),
+				LastModified: aws.ToTime(obj.LastModified),
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// TagObject replaces an object's tags with its lifecycle tags.
+func (s *S3Storage) TagObject(ctx context.Context, p string) error {
+	tags := s.retention.TagsForPath(p)
+
+	tagSet := []types.Tag{
+		{Key: aws.String(TagObjectType), Value: aws.String(string(tags.ObjectType))},
+		{Key: aws.String(TagRetention), Value: aws.String(string(tags.Retention))},
+	}
+	if tags.TenantID != "" {
+		tagSet = append(tagSet, types.Tag{Key: aws.String(TagTenantID), Value: aws.String(tags.TenantID)})
+	}
+
+	_, err := s.client.PutObjectTagging(ctx, &s3.PutObjectTaggingInput{
+		Bucket:  aws.String(s.bucket),
+		Key:     aws.String(s.fullKey(p)),
+		Tagging: &types.Tagging{TagSet: tagSet},
+	})
+	return err
+}
+
+// ApplyLifecycleRules installs the bucket lifecycle rule that moves objects tagged
+// with the infrequent-access retention class to STANDARD_IA after transitionDays.
+// This replaces any lifecycle configuration already on the bucket.
+func (s *S3Storage) ApplyLifecycleRules(ctx context.Context, transitionDays int32) error {
+	_, err := s.client.PutBucketLifecycleConfiguration(ctx, &s3.PutBucketLifecycleConfigurationInput{
+		Bucket: aws.String(s.bucket),
+		LifecycleConfiguration: &types.BucketLifecycleConfiguration{
+			Rules: []types.LifecycleRule{
+				{
+					ID:     aws.String("retention-infrequent-access"),
+					Status: types.ExpirationStatusEnabled,
+					Filter: &types.LifecycleRuleFilter{
+						Tag: &types.Tag{
+							Key:   aws.String(TagRetention),
+							Value: aws.String(string(RetentionInfrequentAccess)),
+						},
+					},
+					Transitions: []types.Transition{
+						{
+							Days:         aws.Int32(transitionDays),
+							StorageClass: types.TransitionStorageClassStandardIa,
+						},
+					},
+				},
+			},
+		},
+	})
+	return err
+}
+
+// CreateMultipartUpload starts a multipart upload. Lifecycle tags are set up front,
+// since the completed object is never written through the adapter.
+func (s *S3Storage) CreateMultipartUpload(ctx context.Context, p string, contentType string) (string, error) {
+	result, err := s.client.CreateMultipartUpload(ctx, &s3.CreateMultipartUploadInput{
+		Bucket:      aws.String(s.bucket),
+		Key:         aws.String(s.fullKey(p)),
+		ContentType: aws.String(contentType),
+		Tagging:     s.tagging(p),
+	})
+	if err != nil {
+		return "", err
+	}
+	return aws.ToString(result.UploadId), nil
+}
+
+// GeneratePartUploadURL generates a presigned URL for uploading one part.
+func (s *S3Storage) GeneratePartUploadURL(ctx context.Context, p, uploadID string, partNumber int32, expiry time.Duration) (string, error) {
+	request, err := s.presignClient.PresignUploadPart(ctx, &s3.UploadPartInput{
+		Bucket:     aws.String(s.bucket),
+		Key:        aws.String(s.fullKey(p)),
+		UploadId:   aws.String(uploadID),
+		PartNumber: aws.Int32(partNumber),
+	}, s3.WithPresignExpires(expiry))
+	if err != nil {
+		return "", err
+	}
+	return request.URL, nil
+}
+
+// UploadPart uploads one part of a multipart upload. The SDK needs a seekable body to
+// sign the request, so other readers are read into memory first.
+func (s *S3Storage) UploadPart(ctx context.Context, p, uploadID string, partNumber int32, r io.Reader) (*UploadedPart, error) {
+	body, ok := r.(io.ReadSeeker)
+	if !ok {
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return nil, err
+		}
+		body = bytes.NewReader(data)
+	}
+	size, err := body.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := body.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	result, err := s.client.UploadPart(ctx, &s3.UploadPartInput{
+		Bucket:        aws.String(s.bucket),
+		Key:           aws.String(s.fullKey(p)),
+		UploadId:      aws.String(uploadID),
+		PartNumber:    aws.Int32(partNumber),
+		Body:          body,
+		ContentLength: aws.Int64(size),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &UploadedPart{
+		PartNumber: partNumber,
+		ETag:       aws.ToString(result.ETag),
+		Size:       size,
+	}, nil
+}
+
+// ListUploadedParts lists the parts stored for a multipart upload, following pagination.
+func (s *S3Storage) ListUploadedParts(ctx context.Context, p, uploadID string) ([]UploadedPart, error) {
+	var parts []UploadedPart
+	paginator := s3.NewListPartsPaginator(s.client, &s3.ListPartsInput{
+		Bucket:   aws.String(s.bucket),
+		Key:      aws.String(s.fullKey(p)),
+		UploadId: aws.String(uploadID),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, part := range page.Parts {
+			parts = append(parts, UploadedPart{
+				PartNumber: aws.ToInt32(part.PartNumber),
+				ETag:       aws.ToString(part.ETag),
+				Size:       aws.ToInt64(part.Size),
+			})
+		}
+	}
+	return parts, nil
+}
+
+// CompleteMultipartUpload assembles the parts into the final object.
+func (s *S3Storage) CompleteMultipartUpload(ctx context.Context, p, uploadID string, parts []UploadedPart) error {
+	completed := make([]types.CompletedPart, len(parts))
+	for i, part := range parts {
+		completed[i] = types.CompletedPart{
+			ETag:       aws.String(part.ETag),
+			PartNumber: aws.Int32(part.PartNumber),
+		}
+	}
+
+	_, err := s.client.CompleteMultipartUpload(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(s.bucket),
+		Key:             aws.String(s.fullKey(p)),
+		UploadId:        aws.String(uploadID),
+		MultipartUpload: &types.CompletedMultipartUpload{Parts: completed},
+	})
+	return err
+}
+
+// AbortMultipartUpload discards an upload. S3 bills stored parts until this is called.
+func (s *S3Storage) AbortMultipartUpload(ctx context.Context, p, uploadID string) error {
+	_, err := s.client.AbortMultipartUpload(ctx, &s3.AbortMultipartUploadInput{
+		Bucket:   aws.String(s.bucket),
+		Key:      aws.String(s.fullKey(p)),
+		UploadId: aws.String(uploadID),
+	})
+	return err
+}
+
+// ListMultipartUploads lists in-progress uploads under a prefix, following pagination.
+// Returned paths are relative to the base path, like the paths passed in.
+func (s *S3Storage) ListMultipartUploads(ctx context.Context, prefix string) ([]MultipartUploadInfo, error) {
+	var uploads []MultipartUploadInfo
+	paginator := s3.NewListMultipartUploadsPaginator(s.client, &s3.ListMultipartUploadsInput{
+		Bucket: aws.String(s.bucket),
+		Prefix: aws.String(s.fullKey(prefix)),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, upload := range page.Uploads {
+			p := aws.ToString(upload.Key)
+			if s.basePath != "" {
+				p = strings.TrimPrefix(p, s.basePath+"/")
+			}
+			uploads = append(uploads, MultipartUploadInfo{
+				Path:      p,
+				UploadID:  aws.ToString(upload.UploadId),
+				Initiated: aws.ToTime(upload.Initiated),
+			})
+		}
+	}
+	return uploads, nil
+}
@@ -2,6 +2,7 @@ package storage
 
 import (
 	"context"
+	"io"
 	"time"
 
 	"github.com/google/uuid"
@@ -33,8 +34,48 @@ type StorageAdapter interface {
 	// GetContent retrieves raw file content from storage.
 	GetContent(ctx context.Context, path string) ([]byte, error)
 
+	// OpenContent opens a file for reading without loading it into memory.
+	// The caller must close the reader.
+	OpenContent(ctx context.Context, path string) (io.ReadCloser, error)
+
 	// PutContent stores raw content to storage.
 	PutContent(ctx context.Context, path string, content []byte, contentType string) error
+
+	// CopyObject copies an object to another path within storage, overwriting the
+	// destination. The copy gets the lifecycle tags of its own path.
+	CopyObject(ctx context.Context, src, dst string) error
+
+	// ListObjects lists every object under a prefix, recursively.
+	ListObjects(ctx context.Context, prefix string) ([]ObjectInfo, error)
+
+	// WalkObjects calls fn for every object under a prefix, recursively, without
+	// holding the whole listing in memory. An error from fn stops the walk and is returned.
+	WalkObjects(ctx context.Context, prefix string, fn func(ObjectInfo) error) error
+
+	// TagObject applies lifecycle tags to an object written outside the adapter,
+	// such as a presigned upload.
+	TagObject(ctx context.Context, path string) error
+
+	// CreateMultipartUpload starts a multipart upload and returns its upload ID.
+	CreateMultipartUpload(ctx context.Context, path string, contentType string) (string, error)
+
+	// GeneratePartUploadURL generates a presigned URL for uploading one part.
+	GeneratePartUploadURL(ctx context.Context, path, uploadID string, partNumber int32, expiry time.Duration) (string, error)
+
+	// UploadPart uploads one part from the server, for objects written in pieces.
+	UploadPart(ctx context.Context, path, uploadID string, partNumber int32, r io.Reader) (*UploadedPart, error)
+
+	// ListUploadedParts lists the parts stored so far, ordered by part number.
+	ListUploadedParts(ctx context.Context, path, uploadID string) ([]UploadedPart, error)
+
+	// CompleteMultipartUpload assembles the given parts, in order, into the object.
+	CompleteMultipartUpload(ctx context.Context, path, uploadID string, parts []UploadedPart) error
+
+	// AbortMultipartUpload discards an upload and its stored parts.
+	AbortMultipartUpload(ctx context.Context, path, uploadID string) error
+
+	// ListMultipartUploads lists in-progress uploads under a prefix.
+	ListMultipartUploads(ctx context.Context, prefix string) ([]MultipartUploadInfo, error)
 }
 
 // TenantStorage provides tenant-aware storage operations.
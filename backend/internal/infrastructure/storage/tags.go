@@ -0,0 +1,134 @@
+package storage
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Object tag keys written on every stored object.
+const (
+	TagTenantID   = "tenant_id"
+	TagObjectType = "object_type"
+	TagRetention  = "retention"
+)
+
+// ObjectType classifies stored objects for cost reporting and lifecycle rules.
+type ObjectType string
+
+const (
+	ObjectTypeCourseContent ObjectType = "course-content"
+	ObjectTypeExport        ObjectType = "export"
+	ObjectTypeSubmission    ObjectType = "submission"
+	ObjectTypeThumbnail     ObjectType = "thumbnail"
+)
+
+// RetentionClass selects which bucket lifecycle rule applies to an object.
+type RetentionClass string
+
+const (
+	// RetentionStandard objects stay in the default storage class.
+	RetentionStandard RetentionClass = "standard"
+	// RetentionInfrequentAccess objects move to infrequent access storage after
+	// the bucket's transition period (see S3Storage.ApplyLifecycleRules).
+	RetentionInfrequentAccess RetentionClass = "infrequent-access"
+)
+
+// ParseRetentionClass validates a configured retention class.
+func ParseRetentionClass(s string) (RetentionClass, error) {
+	switch RetentionClass(s) {
+	case RetentionStandard, RetentionInfrequentAccess:
+		return RetentionClass(s), nil
+	}
+	return "", fmt.Errorf("unknown retention class %q", s)
+}
+
+// RetentionPolicy maps each object type to its default retention class.
+type RetentionPolicy map[ObjectType]RetentionClass
+
+// DefaultRetentionPolicy keeps everything in standard storage except exports,
+// which are rarely downloaded again after the first few days.
+func DefaultRetentionPolicy() RetentionPolicy {
+	return RetentionPolicy{
+		ObjectTypeCourseContent: RetentionStandard,
+		ObjectTypeExport:        RetentionInfrequentAccess,
+		ObjectTypeSubmission:    RetentionStandard,
+		ObjectTypeThumbnail:     RetentionStandard,
+	}
+}
+
+// ObjectTags are the lifecycle tags stored with an object.
+type ObjectTags struct {
+	TenantID   string         `json:"tenant_id,omitempty"`
+	ObjectType ObjectType     `json:"object_type"`
+	Retention  RetentionClass `json:"retention"`
+}
+
+// Encode returns the tags in the URL query format used by the S3 Tagging parameter.
+func (t ObjectTags) Encode() string {
+	values := url.Values{}
+	if t.TenantID != "" {
+		values.Set(TagTenantID, t.TenantID)
+	}
+	values.Set(TagObjectType, string(t.ObjectType))
+	values.Set(TagRetention, string(t.Retention))
+	return values.Encode()
+}
+
+// TagsForPath derives the tags for an object from its storage path.
+func (p RetentionPolicy) TagsForPath(objectPath string) ObjectTags {
+	tenantID, subpath := splitTenantPath(objectPath)
+	objectType := ObjectTypeForPath(subpath)
+
+	retention, ok := p[objectType]
+	if !ok {
+		retention = RetentionStandard
+	}
+
+	return ObjectTags{
+		TenantID:   tenantID,
+		ObjectType: objectType,
+		Retention:  retention,
+	}
+}
+
+// ObjectTypeForPath classifies a tenant-relative path, e.g. "exports/{id}/outline.csv".
+// SME uploads and the knowledge extracted from them are submissions; anything not
+// recognised is treated as course content.
+func ObjectTypeForPath(subpath string) ObjectType {
+	switch {
+	case strings.HasPrefix(subpath, "exports/"):
+		return ObjectTypeExport
+	case strings.HasPrefix(subpath, "sme/"):
+		return ObjectTypeSubmission
+	case strings.HasPrefix(subpath, "thumbnails/"), strings.Contains(subpath, "/thumbnails/"):
+		return ObjectTypeThumbnail
+	default:
+		return ObjectTypeCourseContent
+	}
+}
+
+// splitTenantPath splits "tenants/{tenant_id}/{subpath}" into its tenant ID and subpath.
+// Paths outside a tenant prefix are returned unchanged with an empty tenant ID.
+func splitTenantPath(objectPath string) (string, string) {
+	rest, ok := strings.CutPrefix(strings.TrimPrefix(objectPath, "/"), "tenants/")
+	if !ok {
+		return "", objectPath
+	}
+	tenantID, subpath, _ := strings.Cut(rest, "/")
+	if _, err := uuid.Parse(tenantID); err != nil {
+		return "", objectPath
+	}
+	return tenantID, subpath
+}
+
+// ObjectInfo describes a stored object returned by a listing.
+type ObjectInfo struct {
+	Path         string
+	Size         int64
+	ObjectType   ObjectType
+	LastModified time.Time
+}
@@ -2,7 +2,12 @@ package storage
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"path"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -11,8 +16,16 @@ import (
 // TenantAwareStorage wraps a StorageAdapter with tenant-prefixed paths.
 // It ensures storage isolation between tenants by prefixing all paths
 // with the tenant ID: tenants/{tenant_id}/...
+// Course content can also be encrypted with per-tenant keys; see SetContentEncryption.
+// Tenants can keep their objects in another storage region; see SetRegions.
 type TenantAwareStorage struct {
-	inner StorageAdapter
+	inner         StorageAdapter
+	keys          ContentKeyring
+	encryptWrites bool
+
+	primaryRegion string
+	regions       map[string]StorageAdapter
+	resolver      TenantRegionResolver
 }
 
 // NewTenantAwareStorage creates a new TenantAwareStorage wrapping the given adapter.
@@ -40,65 +53,382 @@ func (s *TenantAwareStorage) ExportPath(tenantID, exportID uuid.UUID, filename s
 
 // ReadCourseContent reads course content JSON from S3.
 func (s *TenantAwareStorage) ReadCourseContent(ctx context.Context, tenantID, courseID uuid.UUID, v interface{}) error {
-	return s.inner.ReadJSON(ctx, s.CoursePath(tenantID, courseID), v)
+	return s.readContentJSON(ctx, tenantID, s.CoursePath(tenantID, courseID), v)
 }
 
 // WriteCourseContent writes course content JSON to S3.
 func (s *TenantAwareStorage) WriteCourseContent(ctx context.Context, tenantID, courseID uuid.UUID, v interface{}) error {
-	return s.inner.WriteJSON(ctx, s.CoursePath(tenantID, courseID), v)
+	return s.writeContentJSON(ctx, tenantID, s.CoursePath(tenantID, courseID), v)
+}
+
+// CourseContentSize returns how many bytes WriteCourseContent stores for v. Every adapter
+// writes JSON indented by two spaces, and encryption adds a fixed overhead, so this
+// matches the stored object exactly.
+func (s *TenantAwareStorage) CourseContentSize(v interface{}) (int64, error) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return 0, err
+	}
+	if s.encryptWrites {
+		return int64(len(data) + EncryptedContentOverhead), nil
+	}
+	return int64(len(data)), nil
 }
 
 // DeleteCourseContent deletes course content from S3.
 func (s *TenantAwareStorage) DeleteCourseContent(ctx context.Context, tenantID, courseID uuid.UUID) error {
-	return s.inner.Delete(ctx, s.CoursePath(tenantID, courseID))
+	inner, err := s.adapter(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+	return inner.Delete(ctx, s.CoursePath(tenantID, courseID))
 }
 
 // CourseContentExists checks if course content exists in S3.
 func (s *TenantAwareStorage) CourseContentExists(ctx context.Context, tenantID, courseID uuid.UUID) (bool, error) {
-	return s.inner.Exists(ctx, s.CoursePath(tenantID, courseID))
+	inner, err := s.adapter(ctx, tenantID)
+	if err != nil {
+		return false, err
+	}
+	return inner.Exists(ctx, s.CoursePath(tenantID, courseID))
+}
+
+// CourseAttachmentPrefix returns the tenant-relative folder holding a course's
+// reference attachments: courses/{course_id}/attachments/
+func CourseAttachmentPrefix(courseID uuid.UUID) string {
+	return path.Join("courses", courseID.String(), "attachments") + "/"
+}
+
+// CopyTenantObject copies a tenant-scoped object to another tenant-scoped path.
+func (s *TenantAwareStorage) CopyTenantObject(ctx context.Context, tenantID uuid.UUID, srcSubpath, dstSubpath string) error {
+	inner, err := s.adapter(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+	return inner.CopyObject(ctx, s.BuildPath(tenantID, srcSubpath), s.BuildPath(tenantID, dstSubpath))
+}
+
+// DeleteCourseAttachments deletes every object under the course's attachment folder,
+// including uploads that were never confirmed.
+func (s *TenantAwareStorage) DeleteCourseAttachments(ctx context.Context, tenantID, courseID uuid.UUID) error {
+	inner, err := s.adapter(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	err = inner.WalkObjects(ctx, s.BuildPath(tenantID, CourseAttachmentPrefix(courseID)), func(obj ObjectInfo) error {
+		if err := inner.Delete(ctx, obj.Path); err != nil {
+			errs = append(errs, err)
+		}
+		return nil
+	})
+	return errors.Join(append(errs, err)...)
+}
+
+// Files stored for each published version of a course.
+const (
+	PublishedContentFile = "content.json" // Copy of the draft's content.json
+	PublishedPlayerFile  = "player.json"  // Learner view of the lessons, as assembled at publish time
+)
+
+// PublishedCoursePath returns the path of a file in a published version of a course.
+// Path format: tenants/{tenant_id}/courses/{course_id}/published/v{version}/{filename}
+func (s *TenantAwareStorage) PublishedCoursePath(tenantID, courseID uuid.UUID, version int32, filename string) string {
+	return s.BuildPath(tenantID, path.Join("courses", courseID.String(), "published", fmt.Sprintf("v%d", version), filename))
+}
+
+// PublishCourseContent copies the course's draft content into a published version.
+// Encrypted content is copied as it is; it stays readable with the same tenant key.
+func (s *TenantAwareStorage) PublishCourseContent(ctx context.Context, tenantID, courseID uuid.UUID, version int32) error {
+	inner, err := s.adapter(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+	return inner.CopyObject(ctx, s.CoursePath(tenantID, courseID), s.PublishedCoursePath(tenantID, courseID, version, PublishedContentFile))
+}
+
+// RestoreCourseContent replaces the course's draft content with a published version's.
+func (s *TenantAwareStorage) RestoreCourseContent(ctx context.Context, tenantID, courseID uuid.UUID, version int32) error {
+	inner, err := s.adapter(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+	return inner.CopyObject(ctx, s.PublishedCoursePath(tenantID, courseID, version, PublishedContentFile), s.CoursePath(tenantID, courseID))
+}
+
+// ReadPublishedCourse reads a JSON file of a published version of a course.
+func (s *TenantAwareStorage) ReadPublishedCourse(ctx context.Context, tenantID, courseID uuid.UUID, version int32, filename string, v interface{}) error {
+	return s.readContentJSON(ctx, tenantID, s.PublishedCoursePath(tenantID, courseID, version, filename), v)
+}
+
+// WritePublishedCourse writes a JSON file of a published version of a course.
+func (s *TenantAwareStorage) WritePublishedCourse(ctx context.Context, tenantID, courseID uuid.UUID, version int32, filename string, v interface{}) error {
+	return s.writeContentJSON(ctx, tenantID, s.PublishedCoursePath(tenantID, courseID, version, filename), v)
+}
+
+// DeletePublishedCourse deletes the files of a published version of a course. Files
+// already gone are skipped.
+func (s *TenantAwareStorage) DeletePublishedCourse(ctx context.Context, tenantID, courseID uuid.UUID, version int32) error {
+	inner, err := s.adapter(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+	for _, filename := range []string{PublishedContentFile, PublishedPlayerFile} {
+		p := s.PublishedCoursePath(tenantID, courseID, version, filename)
+		exists, err := inner.Exists(ctx, p)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			continue
+		}
+		if err := inner.Delete(ctx, p); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // ReadExport reads an export file from S3.
 func (s *TenantAwareStorage) ReadExport(ctx context.Context, tenantID, exportID uuid.UUID, filename string, v interface{}) error {
-	return s.inner.ReadJSON(ctx, s.ExportPath(tenantID, exportID, filename), v)
+	inner, err := s.adapter(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+	return inner.ReadJSON(ctx, s.ExportPath(tenantID, exportID, filename), v)
 }
 
 // WriteExport writes an export file to S3.
 func (s *TenantAwareStorage) WriteExport(ctx context.Context, tenantID, exportID uuid.UUID, filename string, v interface{}) error {
-	return s.inner.WriteJSON(ctx, s.ExportPath(tenantID, exportID, filename), v)
+	inner, err := s.adapter(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+	return inner.WriteJSON(ctx, s.ExportPath(tenantID, exportID, filename), v)
 }
 
 // DeleteExport deletes an export file from S3.
 func (s *TenantAwareStorage) DeleteExport(ctx context.Context, tenantID, exportID uuid.UUID, filename string) error {
-	return s.inner.Delete(ctx, s.ExportPath(tenantID, exportID, filename))
+	inner, err := s.adapter(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+	return inner.Delete(ctx, s.ExportPath(tenantID, exportID, filename))
 }
 
-// Inner returns the underlying StorageAdapter for cases where
-// direct access is needed (e.g., binary file uploads).
+// ListObjectsByTenant lists every object stored for a tenant, optionally limited to
+// one object type. An empty objectType lists everything, e.g. for a tenant export or
+// deletion. Returned paths can be passed back to GetContent or Inner().Delete.
+func (s *TenantAwareStorage) ListObjectsByTenant(ctx context.Context, tenantID uuid.UUID, objectType ObjectType) ([]ObjectInfo, error) {
+	inner, err := s.adapter(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	objects, err := inner.ListObjects(ctx, s.BuildPath(tenantID, ""))
+	if err != nil {
+		return nil, err
+	}
+	if objectType == "" {
+		return objects, nil
+	}
+
+	filtered := objects[:0]
+	for _, obj := range objects {
+		if obj.ObjectType == objectType {
+			filtered = append(filtered, obj)
+		}
+	}
+	return filtered, nil
+}
+
+// WalkTenantObjects calls fn for every object stored for a tenant, one listing page at
+// a time, so tenants with many objects are never listed into memory whole.
+func (s *TenantAwareStorage) WalkTenantObjects(ctx context.Context, tenantID uuid.UUID, fn func(ObjectInfo) error) error {
+	inner, err := s.adapter(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+	return inner.WalkObjects(ctx, s.BuildPath(tenantID, ""), fn)
+}
+
+// DeleteTenantObject deletes an object by its full path, refusing paths outside the
+// tenant's prefix.
+func (s *TenantAwareStorage) DeleteTenantObject(ctx context.Context, tenantID uuid.UUID, objectPath string) error {
+	if !strings.HasPrefix(objectPath, s.BuildPath(tenantID, "")+"/") || strings.Contains(objectPath, "..") {
+		return fmt.Errorf("refusing to delete %q outside tenant %s", objectPath, tenantID)
+	}
+	inner, err := s.adapter(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+	return inner.Delete(ctx, objectPath)
+}
+
+// TagObject applies lifecycle tags to a tenant-scoped object uploaded through a
+// presigned URL. The subpath may also be a full tenant path.
+func (s *TenantAwareStorage) TagObject(ctx context.Context, tenantID uuid.UUID, subpath string) error {
+	fullPath := subpath
+	if !strings.HasPrefix(subpath, s.BuildPath(tenantID, "")+"/") {
+		fullPath = s.BuildPath(tenantID, subpath)
+	}
+	inner, err := s.adapter(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+	return inner.TagObject(ctx, fullPath)
+}
+
+// Inner returns the primary region's StorageAdapter for cases where
+// direct access is needed (e.g., binary file uploads). It does not follow
+// tenant storage regions.
 func (s *TenantAwareStorage) Inner() StorageAdapter {
 	return s.inner
 }
 
 // GenerateUploadURL generates a presigned URL for tenant-scoped uploads.
 func (s *TenantAwareStorage) GenerateUploadURL(ctx context.Context, tenantID uuid.UUID, subpath string, expiry time.Duration) (string, error) {
-	fullPath := s.BuildPath(tenantID, subpath)
-	return s.inner.GenerateUploadURL(ctx, fullPath, expiry)
+	inner, err := s.adapter(ctx, tenantID)
+	if err != nil {
+		return "", err
+	}
+	return inner.GenerateUploadURL(ctx, s.BuildPath(tenantID, subpath), expiry)
 }
 
 // GenerateDownloadURL generates a presigned URL for tenant-scoped downloads.
 func (s *TenantAwareStorage) GenerateDownloadURL(ctx context.Context, tenantID uuid.UUID, subpath string, expiry time.Duration) (string, error) {
-	fullPath := s.BuildPath(tenantID, subpath)
-	return s.inner.GenerateDownloadURL(ctx, fullPath, expiry)
+	inner, err := s.adapter(ctx, tenantID)
+	if err != nil {
+		return "", err
+	}
+	return inner.GenerateDownloadURL(ctx, s.BuildPath(tenantID, subpath), expiry)
 }
 
-// GetContent retrieves raw file content from storage.
+// GetContent retrieves raw file content from storage. Paths under tenants/{id}/ are read
+// from the tenant's storage region.
 // Implements ContentStorage interface for SMEIngestionService.
 func (s *TenantAwareStorage) GetContent(ctx context.Context, path string) ([]byte, error) {
-	return s.inner.GetContent(ctx, path)
+	inner, err := s.pathAdapter(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	return inner.GetContent(ctx, path)
 }
 
-// PutContent stores raw content to storage.
+// PutContent stores raw content to storage. Paths under tenants/{id}/ are written to the
+// tenant's storage region.
 // Implements ContentStorage interface for SMEIngestionService.
 func (s *TenantAwareStorage) PutContent(ctx context.Context, path string, content []byte, contentType string) error {
-	return s.inner.PutContent(ctx, path, content, contentType)
+	inner, err := s.pathAdapter(ctx, path)
+	if err != nil {
+		return err
+	}
+	return inner.PutContent(ctx, path, content, contentType)
+}
+
+// OpenContent opens a tenant-scoped file for streaming reads. The caller must close it.
+func (s *TenantAwareStorage) OpenContent(ctx context.Context, tenantID uuid.UUID, subpath string) (io.ReadCloser, error) {
+	inner, err := s.adapter(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	return inner.OpenContent(ctx, s.BuildPath(tenantID, subpath))
+}
+
+// StreamContent stores the bytes written by write under a tenant-scoped path without
+// holding the whole object in memory, and returns the object's size. Nothing is stored
+// if write fails.
+func (s *TenantAwareStorage) StreamContent(ctx context.Context, tenantID uuid.UUID, subpath, contentType string, write func(w io.Writer) error) (int64, error) {
+	inner, err := s.adapter(ctx, tenantID)
+	if err != nil {
+		return 0, err
+	}
+	w, err := NewMultipartWriter(ctx, inner, s.BuildPath(tenantID, subpath), contentType)
+	if err != nil {
+		return 0, err
+	}
+	if err := write(w); err != nil {
+		w.Abort()
+		return 0, err
+	}
+	if err := w.Close(); err != nil {
+		return 0, err
+	}
+	return w.Size(), nil
+}
+
+// CreateMultipartUpload starts a tenant-scoped multipart upload.
+func (s *TenantAwareStorage) CreateMultipartUpload(ctx context.Context, tenantID uuid.UUID, subpath, contentType string) (string, error) {
+	inner, err := s.adapter(ctx, tenantID)
+	if err != nil {
+		return "", err
+	}
+	return inner.CreateMultipartUpload(ctx, s.BuildPath(tenantID, subpath), contentType)
+}
+
+// GeneratePartUploadURL generates a presigned URL for one part of a tenant-scoped upload.
+func (s *TenantAwareStorage) GeneratePartUploadURL(ctx context.Context, tenantID uuid.UUID, subpath, uploadID string, partNumber int32, expiry time.Duration) (string, error) {
+	inner, err := s.adapter(ctx, tenantID)
+	if err != nil {
+		return "", err
+	}
+	return inner.GeneratePartUploadURL(ctx, s.BuildPath(tenantID, subpath), uploadID, partNumber, expiry)
+}
+
+// ListUploadedParts lists the parts stored so far for a tenant-scoped upload.
+func (s *TenantAwareStorage) ListUploadedParts(ctx context.Context, tenantID uuid.UUID, subpath, uploadID string) ([]UploadedPart, error) {
+	inner, err := s.adapter(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	return inner.ListUploadedParts(ctx, s.BuildPath(tenantID, subpath), uploadID)
+}
+
+// CompleteMultipartUpload assembles a tenant-scoped upload from its parts.
+func (s *TenantAwareStorage) CompleteMultipartUpload(ctx context.Context, tenantID uuid.UUID, subpath, uploadID string, parts []UploadedPart) error {
+	inner, err := s.adapter(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+	return inner.CompleteMultipartUpload(ctx, s.BuildPath(tenantID, subpath), uploadID, parts)
+}
+
+// AbortMultipartUpload discards a tenant-scoped upload.
+func (s *TenantAwareStorage) AbortMultipartUpload(ctx context.Context, tenantID uuid.UUID, subpath, uploadID string) error {
+	inner, err := s.adapter(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+	return inner.AbortMultipartUpload(ctx, s.BuildPath(tenantID, subpath), uploadID)
+}
+
+// AbortStaleMultipartUploads aborts every tenant upload started before cutoff, in every
+// storage region, and returns how many were aborted. Uploads that fail to abort are
+// skipped and picked up again on the next run; the first such error is returned.
+func (s *TenantAwareStorage) AbortStaleMultipartUploads(ctx context.Context, cutoff time.Time) (int, error) {
+	aborted := 0
+	var firstErr error
+	for _, region := range s.Regions() {
+		inner, err := s.regionAdapter(region)
+		if err != nil {
+			return aborted, err
+		}
+		uploads, err := inner.ListMultipartUploads(ctx, "tenants/")
+		if err != nil {
+			return aborted, err
+		}
+
+		for _, upload := range uploads {
+			if !upload.Initiated.Before(cutoff) {
+				continue
+			}
+			if err := inner.AbortMultipartUpload(ctx, upload.Path, upload.UploadID); err != nil {
+				if firstErr == nil {
+					firstErr = err
+				}
+				continue
+			}
+			aborted++
+		}
+	}
+	return aborted, firstErr
 }
@@ -1,6 +1,9 @@
 package worker
 
 import (
+	"errors"
+	"time"
+
 	"github.com/hibiken/asynq"
 
 	domainservice "github.com/sogos/mirai-backend/internal/domain/service"
@@ -27,6 +30,35 @@ func (c *Client) Close() error {
 	return c.client.Close()
 }
 
+// Enqueue retries. A brief Redis blip (a failover or a dropped pooled connection)
+// shouldn't fail the request that queued the task.
+const (
+	enqueueAttempts  = 3
+	enqueueBaseDelay = 200 * time.Millisecond
+)
+
+// enqueue enqueues a task, retrying transient failures with exponential backoff.
+// Duplicate and conflicting tasks are returned straight away.
+func (c *Client) enqueue(task *asynq.Task) (*asynq.TaskInfo, error) {
+	delay := enqueueBaseDelay
+	for attempt := 1; ; attempt++ {
+		info, err := c.client.Enqueue(task)
+		if err == nil || attempt == enqueueAttempts ||
+			errors.Is(err, asynq.ErrDuplicateTask) || errors.Is(err, asynq.ErrTaskIDConflict) {
+			return info, err
+		}
+
+		c.logger.Warn("failed to enqueue task, retrying",
+			"taskType", task.Type(),
+			"attempt", attempt,
+			"retryIn", delay,
+			"error", err,
+		)
+		time.Sleep(delay)
+		delay *= 2
+	}
+}
+
 // EnqueueStripeProvision enqueues a Stripe provisioning task.
 func (c *Client) EnqueueStripeProvision(sessionID, customer, subscriptionID string) error {
 	task, err := worker.NewStripeProvisionTask(sessionID, customer, subscriptionID)
@@ -35,7 +67,7 @@ func (c *Client) EnqueueStripeProvision(sessionID, customer, subscriptionID stri
 		return err
 	}
 
-	info, err := c.client.Enqueue(task)
+	info, err := c.enqueue(task)
 	if err != nil {
 		c.logger.Error("failed to enqueue stripe provision task",
 			"checkoutSessionID", sessionID,
@@ -60,7 +92,7 @@ func (c *Client) EnqueueAIGeneration(jobID, jobType string) error {
 		return err
 	}
 
-	info, err := c.client.Enqueue(task)
+	info, err := c.enqueue(task)
 	if err != nil {
 		c.logger.Error("failed to enqueue AI generation task",
 			"jobID", jobID,
@@ -87,7 +119,7 @@ func (c *Client) EnqueueSMEIngestion(jobID string) error {
 		return err
 	}
 
-	info, err := c.client.Enqueue(task)
+	info, err := c.enqueue(task)
 	if err != nil {
 		c.logger.Error("failed to enqueue SME ingestion task",
 			"jobID", jobID,
@@ -103,3 +135,223 @@ func (c *Client) EnqueueSMEIngestion(jobID string) error {
 	)
 	return nil
 }
+
+// EnqueueSMETopicRecluster enqueues a topic reclustering task for an SME.
+// A task already pending for the SME is not an error.
+func (c *Client) EnqueueSMETopicRecluster(tenantID, smeID string) error {
+	task, err := worker.NewSMETopicReclusterTask(tenantID, smeID)
+	if err != nil {
+		c.logger.Error("failed to create SME topic recluster task", "error", err)
+		return err
+	}
+
+	info, err := c.enqueue(task)
+	if errors.Is(err, asynq.ErrDuplicateTask) {
+		c.logger.Debug("SME topic recluster task already pending", "smeID", smeID)
+		return nil
+	}
+	if err != nil {
+		c.logger.Error("failed to enqueue SME topic recluster task",
+			"smeID", smeID,
+			"error", err,
+		)
+		return err
+	}
+
+	c.logger.Info("enqueued SME topic recluster task",
+		"taskID", info.ID,
+		"queue", info.Queue,
+		"smeID", smeID,
+	)
+	return nil
+}
+
+// EnqueueSMESummaryRefit enqueues a knowledge summary refit for an SME.
+// A refit already pending for the SME is not an error.
+func (c *Client) EnqueueSMESummaryRefit(tenantID, smeID string) error {
+	task, err := worker.NewSMESummaryRefitTask(tenantID, smeID)
+	if err != nil {
+		c.logger.Error("failed to create SME summary refit task", "error", err)
+		return err
+	}
+
+	info, err := c.enqueue(task)
+	if errors.Is(err, asynq.ErrDuplicateTask) {
+		c.logger.Debug("SME summary refit task already pending", "smeID", smeID)
+		return nil
+	}
+	if err != nil {
+		c.logger.Error("failed to enqueue SME summary refit task",
+			"smeID", smeID,
+			"error", err,
+		)
+		return err
+	}
+
+	c.logger.Info("enqueued SME summary refit task",
+		"taskID", info.ID,
+		"queue", info.Queue,
+		"smeID", smeID,
+	)
+	return nil
+}
+
+// EnqueueSMESummaryBackfill enqueues a backfill of oversized SME knowledge summaries.
+// A backfill already pending is not an error.
+func (c *Client) EnqueueSMESummaryBackfill() error {
+	info, err := c.enqueue(worker.NewSMESummaryBackfillTask())
+	if errors.Is(err, asynq.ErrDuplicateTask) {
+		c.logger.Debug("SME summary backfill task already pending")
+		return nil
+	}
+	if err != nil {
+		c.logger.Error("failed to enqueue SME summary backfill task", "error", err)
+		return err
+	}
+
+	c.logger.Info("enqueued SME summary backfill task",
+		"taskID", info.ID,
+		"queue", info.Queue,
+	)
+	return nil
+}
+
+// EnqueueQuizSchemaMigration enqueues an upgrade of quiz components to the current schema.
+// A migration already pending is not an error.
+func (c *Client) EnqueueQuizSchemaMigration() error {
+	info, err := c.enqueue(worker.NewQuizSchemaMigrationTask())
+	if errors.Is(err, asynq.ErrDuplicateTask) {
+		c.logger.Debug("quiz schema migration task already pending")
+		return nil
+	}
+	if err != nil {
+		c.logger.Error("failed to enqueue quiz schema migration task", "error", err)
+		return err
+	}
+
+	c.logger.Info("enqueued quiz schema migration task",
+		"taskID", info.ID,
+		"queue", info.Queue,
+	)
+	return nil
+}
+
+// EnqueueStorageEncryption enqueues re-encryption of a tenant's course content, or of
+// every tenant's when tenantID is empty. A pass already pending is not an error.
+func (c *Client) EnqueueStorageEncryption(tenantID string) error {
+	task, err := worker.NewStorageEncryptionTask(tenantID)
+	if err != nil {
+		c.logger.Error("failed to create storage encryption task", "error", err)
+		return err
+	}
+
+	info, err := c.enqueue(task)
+	if errors.Is(err, asynq.ErrDuplicateTask) {
+		c.logger.Debug("storage encryption task already pending", "tenantID", tenantID)
+		return nil
+	}
+	if err != nil {
+		c.logger.Error("failed to enqueue storage encryption task",
+			"tenantID", tenantID,
+			"error", err,
+		)
+		return err
+	}
+
+	c.logger.Info("enqueued storage encryption task",
+		"taskID", info.ID,
+		"queue", info.Queue,
+		"tenantID", tenantID,
+	)
+	return nil
+}
+
+// EnqueueStorageRegionMigration enqueues moving a tenant's objects to the storage region
+// it is migrating to. A migration already pending is not an error.
+func (c *Client) EnqueueStorageRegionMigration(tenantID string) error {
+	task, err := worker.NewStorageRegionMigrationTask(tenantID)
+	if err != nil {
+		c.logger.Error("failed to create storage region migration task", "error", err)
+		return err
+	}
+
+	info, err := c.enqueue(task)
+	if errors.Is(err, asynq.ErrDuplicateTask) {
+		c.logger.Debug("storage region migration task already pending", "tenantID", tenantID)
+		return nil
+	}
+	if err != nil {
+		c.logger.Error("failed to enqueue storage region migration task",
+			"tenantID", tenantID,
+			"error", err,
+		)
+		return err
+	}
+
+	c.logger.Info("enqueued storage region migration task",
+		"taskID", info.ID,
+		"queue", info.Queue,
+		"tenantID", tenantID,
+	)
+	return nil
+}
+
+// EnqueueCourseAttachment enqueues text extraction from an uploaded course attachment.
+// Extraction already pending for the attachment is not an error.
+func (c *Client) EnqueueCourseAttachment(tenantID, attachmentID string) error {
+	task, err := worker.NewCourseAttachmentTask(tenantID, attachmentID)
+	if err != nil {
+		c.logger.Error("failed to create course attachment task", "error", err)
+		return err
+	}
+
+	info, err := c.enqueue(task)
+	if errors.Is(err, asynq.ErrDuplicateTask) {
+		c.logger.Debug("course attachment task already pending", "attachmentID", attachmentID)
+		return nil
+	}
+	if err != nil {
+		c.logger.Error("failed to enqueue course attachment task",
+			"attachmentID", attachmentID,
+			"error", err,
+		)
+		return err
+	}
+
+	c.logger.Info("enqueued course attachment task",
+		"taskID", info.ID,
+		"queue", info.Queue,
+		"attachmentID", attachmentID,
+	)
+	return nil
+}
+
+// EnqueueTenantCacheWarm enqueues a rebuild of a tenant's library cache.
+// A warm already pending for the tenant is not an error.
+func (c *Client) EnqueueTenantCacheWarm(tenantID string, recentCourses int) error {
+	task, err := worker.NewTenantCacheWarmTask(tenantID, recentCourses)
+	if err != nil {
+		c.logger.Error("failed to create tenant cache warm task", "error", err)
+		return err
+	}
+
+	info, err := c.enqueue(task)
+	if errors.Is(err, asynq.ErrDuplicateTask) {
+		c.logger.Debug("tenant cache warm task already pending", "tenantID", tenantID)
+		return nil
+	}
+	if err != nil {
+		c.logger.Error("failed to enqueue tenant cache warm task",
+			"tenantID", tenantID,
+			"error", err,
+		)
+		return err
+	}
+
+	c.logger.Info("enqueued tenant cache warm task",
+		"taskID", info.ID,
+		"queue", info.Queue,
+		"tenantID", tenantID,
+	)
+	return nil
+}
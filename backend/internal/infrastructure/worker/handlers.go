@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/hibiken/asynq"
 
 	appservice "github.com/sogos/mirai-backend/internal/application/service"
@@ -19,6 +21,9 @@ type Handlers struct {
 	cleanupService      *appservice.CleanupService
 	aiGenService        *appservice.AIGenerationService
 	smeIngestionService *appservice.SMEIngestionService
+	lmsSyncService      *appservice.LMSSyncService
+	courseService       *appservice.CourseService
+	notificationService *appservice.NotificationService
 	workerClient        *Client
 	logger              domainservice.Logger
 }
@@ -29,6 +34,9 @@ func NewHandlers(
 	cleanupService *appservice.CleanupService,
 	aiGenService *appservice.AIGenerationService,
 	smeIngestionService *appservice.SMEIngestionService,
+	lmsSyncService *appservice.LMSSyncService,
+	courseService *appservice.CourseService,
+	notificationService *appservice.NotificationService,
 	workerClient *Client,
 	logger domainservice.Logger,
 ) *Handlers {
@@ -37,6 +45,9 @@ func NewHandlers(
 		cleanupService:      cleanupService,
 		aiGenService:        aiGenService,
 		smeIngestionService: smeIngestionService,
+		lmsSyncService:      lmsSyncService,
+		courseService:       courseService,
+		notificationService: notificationService,
 		workerClient:        workerClient,
 		logger:              logger,
 	}
@@ -137,6 +148,35 @@ func (h *Handlers) HandleStripeReconcile(ctx context.Context, t *asynq.Task) err
 	return nil
 }
 
+// HandleIdentityReconcile resolves Kratos identities left without a user row.
+// This is called periodically by the scheduler to recover from partially failed provisioning.
+func (h *Handlers) HandleIdentityReconcile(ctx context.Context, t *asynq.Task) error {
+	log := h.logger.With("task", worker.TypeIdentityReconcile)
+	log.Info("processing identity reconciliation task")
+
+	// Use superadmin context for reconciliation (worker has no user session)
+	adminCtx := tenant.WithSuperAdmin(ctx, true)
+
+	result, err := h.provisioningService.ReconcileOrphanedIdentities(adminCtx)
+	if err != nil {
+		log.Error("failed to reconcile orphaned identities", "error", err)
+		return err
+	}
+
+	if err := h.provisioningService.SendIdentityReconciliationAlert(ctx, result); err != nil {
+		log.Error("failed to send identity reconciliation alert", "error", err)
+		// Don't fail the task - alerting is best-effort
+	}
+
+	log.Info("identity reconciliation completed",
+		"scanned", result.Scanned,
+		"provisioned", len(result.Provisioned),
+		"deleted", len(result.Deleted),
+		"failed", len(result.Failed),
+	)
+	return nil
+}
+
 // HandleCleanupExpired processes a cleanup task.
 // This is called periodically by the scheduler to clean up expired registrations.
 func (h *Handlers) HandleCleanupExpired(ctx context.Context, t *asynq.Task) error {
@@ -153,6 +193,50 @@ func (h *Handlers) HandleCleanupExpired(ctx context.Context, t *asynq.Task) erro
 	return nil
 }
 
+// HandleAbandonedUploads processes an abandoned upload cleanup task.
+// This is called periodically by the scheduler to abort stale multipart uploads.
+func (h *Handlers) HandleAbandonedUploads(ctx context.Context, t *asynq.Task) error {
+	log := h.logger.With("task", worker.TypeAbandonedUploads)
+	log.Debug("processing abandoned uploads task")
+
+	if err := h.cleanupService.AbortAbandonedUploads(ctx); err != nil {
+		log.Error("failed to abort abandoned uploads", "error", err)
+		return err
+	}
+	return nil
+}
+
+// HandleExpiredExports processes an expired export artifact cleanup task.
+// This is called periodically by the scheduler to delete exports past their retention period.
+func (h *Handlers) HandleExpiredExports(ctx context.Context, t *asynq.Task) error {
+	log := h.logger.With("task", worker.TypeExpiredExports)
+	log.Debug("processing expired exports task")
+
+	if err := h.cleanupService.PurgeExpiredExports(ctx); err != nil {
+		log.Error("failed to purge expired exports", "error", err)
+		return err
+	}
+	return nil
+}
+
+// HandleCourseVersionBackfill stores published versions for courses published before
+// versions were stored. This is called periodically by the scheduler.
+func (h *Handlers) HandleCourseVersionBackfill(ctx context.Context, t *asynq.Task) error {
+	log := h.logger.With("task", worker.TypeCourseVersionBackfill)
+
+	if h.courseService == nil {
+		log.Warn("course service not available, skipping published version backfill")
+		return nil
+	}
+
+	log.Debug("processing course version backfill task")
+	if _, err := h.courseService.BackfillPublishedVersions(ctx); err != nil {
+		log.Error("failed to backfill published course versions", "error", err)
+		return err
+	}
+	return nil
+}
+
 // HandleAIGeneration processes an AI generation task.
 // This is called when a course outline or lesson generation is requested.
 func (h *Handlers) HandleAIGeneration(ctx context.Context, t *asynq.Task) error {
@@ -204,7 +288,253 @@ func (h *Handlers) HandleSMEIngestion(ctx context.Context, t *asynq.Task) error
 	return nil
 }
 
-// HandleAIGenerationPoll processes AI generation jobs by polling the database.
+// HandleSMETopicRecluster merges an SME's near-duplicate knowledge topics.
+// This is enqueued after ingestion when the SME has accumulated too many distinct topics.
+func (h *Handlers) HandleSMETopicRecluster(ctx context.Context, t *asynq.Task) error {
+	var payload worker.SMETopicReclusterPayload
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
+	}
+
+	log := h.logger.With(
+		"task", worker.TypeSMETopicRecluster,
+		"smeID", payload.SMEID,
+	)
+
+	if h.smeIngestionService == nil {
+		log.Warn("SME ingestion service not available, skipping topic recluster")
+		return nil
+	}
+
+	tenantID, err := uuid.Parse(payload.TenantID)
+	if err != nil {
+		return fmt.Errorf("invalid tenant ID: %w", asynq.SkipRetry)
+	}
+	smeID, err := uuid.Parse(payload.SMEID)
+	if err != nil {
+		return fmt.Errorf("invalid SME ID: %w", asynq.SkipRetry)
+	}
+
+	log.Info("processing SME topic recluster task")
+	if err := h.smeIngestionService.ReclusterTopics(tenant.WithTenantID(ctx, tenantID), smeID); err != nil {
+		log.Error("failed to recluster SME topics", "error", err)
+		return err
+	}
+	return nil
+}
+
+// HandleSMESummaryRefit re-summarizes an SME's knowledge summary that is over the cap.
+// This is enqueued by the knowledge summary backfill.
+func (h *Handlers) HandleSMESummaryRefit(ctx context.Context, t *asynq.Task) error {
+	var payload worker.SMESummaryRefitPayload
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
+	}
+
+	log := h.logger.With(
+		"task", worker.TypeSMESummaryRefit,
+		"smeID", payload.SMEID,
+	)
+
+	if h.smeIngestionService == nil {
+		log.Warn("SME ingestion service not available, skipping summary refit")
+		return nil
+	}
+
+	tenantID, err := uuid.Parse(payload.TenantID)
+	if err != nil {
+		return fmt.Errorf("invalid tenant ID: %w", asynq.SkipRetry)
+	}
+	smeID, err := uuid.Parse(payload.SMEID)
+	if err != nil {
+		return fmt.Errorf("invalid SME ID: %w", asynq.SkipRetry)
+	}
+
+	log.Info("processing SME summary refit task")
+	if err := h.smeIngestionService.RefitKnowledgeSummary(tenant.WithTenantID(ctx, tenantID), smeID); err != nil {
+		log.Error("failed to refit SME knowledge summary", "error", err)
+		return err
+	}
+	return nil
+}
+
+// HandleSMESummaryBackfill queues a refit for every SME whose knowledge summary is over
+// the cap. This is enqueued by a superadmin.
+func (h *Handlers) HandleSMESummaryBackfill(ctx context.Context, t *asynq.Task) error {
+	log := h.logger.With("task", worker.TypeSMESummaryBackfill)
+
+	if h.smeIngestionService == nil {
+		log.Warn("SME ingestion service not available, skipping summary backfill")
+		return nil
+	}
+
+	if _, err := h.smeIngestionService.BackfillKnowledgeSummaries(ctx); err != nil {
+		log.Error("failed to backfill SME knowledge summaries", "error", err)
+		return err
+	}
+	return nil
+}
+
+// HandleQuizSchemaMigration upgrades quiz components in every tenant to the current quiz
+// schema. This is enqueued by a superadmin.
+func (h *Handlers) HandleQuizSchemaMigration(ctx context.Context, t *asynq.Task) error {
+	log := h.logger.With("task", worker.TypeQuizSchemaMigration)
+
+	if h.aiGenService == nil {
+		log.Warn("AI generation service not available, skipping quiz schema migration")
+		return nil
+	}
+
+	if _, err := h.aiGenService.MigrateQuizSchema(ctx); err != nil {
+		log.Error("failed to migrate quiz schema", "error", err)
+		return err
+	}
+	return nil
+}
+
+// HandleTenantCacheWarm rebuilds a tenant's library cache.
+// This is enqueued by a superadmin after a cache flush or bulk import.
+func (h *Handlers) HandleTenantCacheWarm(ctx context.Context, t *asynq.Task) error {
+	var payload worker.TenantCacheWarmPayload
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
+	}
+
+	log := h.logger.With(
+		"task", worker.TypeTenantCacheWarm,
+		"tenantID", payload.TenantID,
+	)
+
+	if h.courseService == nil {
+		log.Warn("course service not available, skipping cache warm")
+		return nil
+	}
+
+	tenantID, err := uuid.Parse(payload.TenantID)
+	if err != nil {
+		return fmt.Errorf("invalid tenant ID: %w", asynq.SkipRetry)
+	}
+
+	log.Info("processing tenant cache warm task")
+	if _, err := h.courseService.RebuildTenantCache(ctx, tenantID, payload.RecentCourses); err != nil {
+		log.Error("failed to warm tenant cache", "error", err)
+		return err
+	}
+	return nil
+}
+
+// HandleStorageEncryption re-encrypts a tenant's course content with its active key, or
+// queues that for every tenant when the payload names none.
+// This is enqueued by a superadmin, and after a tenant key rotation.
+func (h *Handlers) HandleStorageEncryption(ctx context.Context, t *asynq.Task) error {
+	var payload worker.StorageEncryptionPayload
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
+	}
+
+	log := h.logger.With(
+		"task", worker.TypeStorageEncryption,
+		"tenantID", payload.TenantID,
+	)
+
+	if h.courseService == nil {
+		log.Warn("course service not available, skipping storage encryption")
+		return nil
+	}
+
+	if payload.TenantID == "" {
+		log.Info("queueing storage encryption for all tenants")
+		if _, err := h.courseService.EncryptAllTenantStorage(ctx); err != nil {
+			log.Error("failed to queue storage encryption", "error", err)
+			return err
+		}
+		return nil
+	}
+
+	tenantID, err := uuid.Parse(payload.TenantID)
+	if err != nil {
+		return fmt.Errorf("invalid tenant ID: %w", asynq.SkipRetry)
+	}
+
+	log.Info("processing storage encryption task")
+	if _, err := h.courseService.EncryptTenantStorage(ctx, tenantID); err != nil {
+		log.Error("failed to encrypt tenant storage", "error", err)
+		return err
+	}
+	return nil
+}
+
+// HandleStorageRegionMigration moves a tenant's objects to the storage region it is
+// migrating to and switches the tenant over.
+// This is enqueued by a superadmin.
+func (h *Handlers) HandleStorageRegionMigration(ctx context.Context, t *asynq.Task) error {
+	var payload worker.StorageRegionMigrationPayload
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
+	}
+
+	log := h.logger.With(
+		"task", worker.TypeStorageRegionMigrate,
+		"tenantID", payload.TenantID,
+	)
+
+	if h.courseService == nil {
+		log.Warn("course service not available, skipping storage region migration")
+		return nil
+	}
+
+	tenantID, err := uuid.Parse(payload.TenantID)
+	if err != nil {
+		return fmt.Errorf("invalid tenant ID: %w", asynq.SkipRetry)
+	}
+
+	log.Info("processing storage region migration task")
+	if _, err := h.courseService.MigrateTenantStorageRegion(ctx, tenantID); err != nil {
+		log.Error("failed to migrate tenant storage region", "error", err)
+		return err
+	}
+	return nil
+}
+
+// HandleCourseAttachment extracts the text of an uploaded course reference attachment
+// into chunks for generation prompts.
+// This is enqueued when an upload is confirmed.
+func (h *Handlers) HandleCourseAttachment(ctx context.Context, t *asynq.Task) error {
+	var payload worker.CourseAttachmentPayload
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
+	}
+
+	log := h.logger.With(
+		"task", worker.TypeCourseAttachment,
+		"tenantID", payload.TenantID,
+		"attachmentID", payload.AttachmentID,
+	)
+
+	if h.courseService == nil {
+		log.Warn("course service not available, skipping course attachment")
+		return nil
+	}
+
+	tenantID, err := uuid.Parse(payload.TenantID)
+	if err != nil {
+		return fmt.Errorf("invalid tenant ID: %w", asynq.SkipRetry)
+	}
+	attachmentID, err := uuid.Parse(payload.AttachmentID)
+	if err != nil {
+		return fmt.Errorf("invalid attachment ID: %w", asynq.SkipRetry)
+	}
+
+	log.Info("processing course attachment task")
+	if err := h.courseService.IngestCourseAttachment(ctx, tenantID, attachmentID); err != nil {
+		log.Error("failed to process course attachment", "error", err)
+		return err
+	}
+	return nil
+}
+
+// HandleAIGenerationPoll enqueues child jobs that never got a worker task and processes
+// AI generation jobs by polling the database.
 // This is called periodically by the scheduler.
 func (h *Handlers) HandleAIGenerationPoll(ctx context.Context, t *asynq.Task) error {
 	log := h.logger.With("task", worker.TypeAIGenerationPoll)
@@ -216,6 +546,13 @@ func (h *Handlers) HandleAIGenerationPoll(ctx context.Context, t *asynq.Task) er
 		return nil
 	}
 
+	// Enqueue child jobs whose push after commit failed
+	if enqueued, err := h.aiGenService.EnqueueUnenqueuedChildJobs(ctx); err != nil {
+		log.Error("failed to enqueue unenqueued child jobs", "error", err)
+	} else if enqueued > 0 {
+		log.Info("enqueued child jobs missing a task", "count", enqueued)
+	}
+
 	// Process next queued job (uses FOR UPDATE SKIP LOCKED in DB)
 	// The service method returns nil if no jobs available
 	err := h.aiGenService.ProcessNextQueuedJob(ctx)
@@ -228,6 +565,36 @@ func (h *Handlers) HandleAIGenerationPoll(ctx context.Context, t *asynq.Task) er
 	return nil
 }
 
+// HandleGenerationConsistency repairs and reports generation jobs left in an inconsistent state.
+// This is called periodically by the scheduler. Each tenant is checked under its own RLS scope.
+func (h *Handlers) HandleGenerationConsistency(ctx context.Context, t *asynq.Task) error {
+	log := h.logger.With("task", worker.TypeGenerationConsistency)
+	log.Info("processing generation consistency task")
+
+	if h.aiGenService == nil {
+		log.Warn("AI generation service not available, skipping consistency sweep")
+		return nil
+	}
+
+	result, err := h.aiGenService.SweepGenerationConsistency(ctx)
+	if err != nil {
+		log.Error("failed to sweep generation consistency", "error", err)
+		return err
+	}
+
+	if err := h.aiGenService.SendGenerationAnomalyAlert(ctx, result); err != nil {
+		log.Error("failed to send generation anomaly alert", "error", err)
+		// Don't fail the task - alerting is best-effort
+	}
+
+	log.Info("generation consistency sweep completed",
+		"tenants", result.TenantsChecked,
+		"newAnomalies", len(result.NewAnomalies),
+		"failedTenants", result.FailedTenants,
+	)
+	return nil
+}
+
 // HandleSMEIngestionPoll processes SME ingestion jobs by polling the database.
 // This is called periodically by the scheduler.
 func (h *Handlers) HandleSMEIngestionPoll(ctx context.Context, t *asynq.Task) error {
@@ -247,3 +614,45 @@ func (h *Handlers) HandleSMEIngestionPoll(ctx context.Context, t *asynq.Task) er
 
 	return nil
 }
+
+// HandleLMSSyncPoll delivers published courses to LMS connectors.
+// This is called periodically by the scheduler.
+func (h *Handlers) HandleLMSSyncPoll(ctx context.Context, t *asynq.Task) error {
+	log := h.logger.With("task", worker.TypeLMSSyncPoll)
+
+	// Only process if service is available
+	if h.lmsSyncService == nil {
+		return nil
+	}
+
+	processed, err := h.lmsSyncService.ProcessDueDeliveries(ctx)
+	if err != nil {
+		log.Error("failed to process LMS sync deliveries", "error", err)
+		return err
+	}
+	if processed > 0 {
+		log.Info("LMS sync deliveries processed", "count", processed)
+	}
+	return nil
+}
+
+// HandleWeeklySummary emails tenant admins their weekly summary once it is due.
+// This is called periodically by the scheduler.
+func (h *Handlers) HandleWeeklySummary(ctx context.Context, t *asynq.Task) error {
+	log := h.logger.With("task", worker.TypeWeeklySummary)
+
+	// Only process if service is available
+	if h.notificationService == nil {
+		return nil
+	}
+
+	sent, err := h.notificationService.SendDueWeeklySummaries(ctx, time.Now())
+	if err != nil {
+		log.Error("failed to send weekly summaries", "error", err)
+		return err
+	}
+	if sent > 0 {
+		log.Info("weekly summaries sent", "tenants", sent)
+	}
+	return nil
+}
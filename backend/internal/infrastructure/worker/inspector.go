@@ -0,0 +1,114 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/redis/go-redis/v9"
+
+	appservice "github.com/sogos/mirai-backend/internal/application/service"
+)
+
+// inspectorTimeout bounds each Redis call made by the inspector. Diagnostics must answer
+// quickly when Redis is down.
+const inspectorTimeout = 2 * time.Second
+
+// Inspector reads worker servers, queues and scheduler entries from Redis for diagnostics.
+// It implements service.WorkerInspector.
+type Inspector struct {
+	redis     *redis.Client
+	inspector *asynq.Inspector
+}
+
+// NewInspector creates an inspector with its own short-timeout Redis connection, so a
+// slow Redis never holds up the connections used to process tasks.
+func NewInspector(redisAddr string) *Inspector {
+	client := redis.NewClient(&redis.Options{
+		Addr:         redisAddr,
+		DialTimeout:  inspectorTimeout,
+		ReadTimeout:  inspectorTimeout,
+		WriteTimeout: inspectorTimeout,
+		PoolSize:     2,
+		MaxRetries:   -1, // Report a failure instead of retrying past the timeout
+	})
+	return &Inspector{
+		redis:     client,
+		inspector: asynq.NewInspectorFromRedisClient(client),
+	}
+}
+
+// Close closes the inspector's Redis connection.
+func (i *Inspector) Close() error {
+	return i.redis.Close()
+}
+
+// PingRedis checks Redis is reachable.
+func (i *Inspector) PingRedis(ctx context.Context) error {
+	return i.redis.Ping(ctx).Err()
+}
+
+// Servers lists the worker servers that have reported in recently.
+func (i *Inspector) Servers() ([]appservice.WorkerServerInfo, error) {
+	servers, err := i.inspector.Servers()
+	if err != nil {
+		return nil, err
+	}
+	infos := make([]appservice.WorkerServerInfo, 0, len(servers))
+	for _, s := range servers {
+		infos = append(infos, appservice.WorkerServerInfo{
+			Host:          s.Host,
+			PID:           s.PID,
+			Concurrency:   s.Concurrency,
+			ActiveWorkers: len(s.ActiveWorkers),
+			Status:        s.Status,
+			StartedAt:     s.Started,
+		})
+	}
+	return infos, nil
+}
+
+// QueueDepths reports the size of each task queue.
+func (i *Inspector) QueueDepths() ([]appservice.QueueDepth, error) {
+	queues, err := i.inspector.Queues()
+	if err != nil {
+		return nil, err
+	}
+	depths := make([]appservice.QueueDepth, 0, len(queues))
+	for _, queue := range queues {
+		info, err := i.inspector.GetQueueInfo(queue)
+		if err != nil {
+			return nil, err
+		}
+		depths = append(depths, appservice.QueueDepth{
+			Queue:     info.Queue,
+			Pending:   info.Pending,
+			Active:    info.Active,
+			Scheduled: info.Scheduled,
+			Retry:     info.Retry,
+			Archived:  info.Archived,
+			Latency:   info.Latency,
+			Paused:    info.Paused,
+		})
+	}
+	return depths, nil
+}
+
+// ScheduledTasks lists the periodic tasks registered with a running scheduler.
+// Entries expire shortly after their scheduler stops, so none means no scheduler is up.
+func (i *Inspector) ScheduledTasks() ([]appservice.ScheduledTaskEntry, error) {
+	entries, err := i.inspector.SchedulerEntries()
+	if err != nil {
+		return nil, err
+	}
+	tasks := make([]appservice.ScheduledTaskEntry, 0, len(entries))
+	for _, e := range entries {
+		tasks = append(tasks, appservice.ScheduledTaskEntry{
+			TaskType:       e.Task.Type(),
+			Schedule:       e.Spec,
+			NextEnqueueAt:  e.Next,
+			LastEnqueuedAt: e.Prev,
+		})
+	}
+	return tasks, nil
+}
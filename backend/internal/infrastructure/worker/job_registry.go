@@ -0,0 +1,76 @@
+package worker
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+
+	domainservice "github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/infrastructure/pubsub"
+)
+
+// JobRegistry tracks the generation jobs running on this worker so a cancellation
+// published from any API pod can abort the job's in-flight provider call.
+type JobRegistry struct {
+	subscriber pubsub.JobCancelSubscriber
+	logger     domainservice.Logger
+
+	mu      sync.Mutex
+	running map[uuid.UUID]context.CancelFunc
+}
+
+// NewJobRegistry creates a new JobRegistry.
+func NewJobRegistry(subscriber pubsub.JobCancelSubscriber, logger domainservice.Logger) *JobRegistry {
+	return &JobRegistry{
+		subscriber: subscriber,
+		logger:     logger,
+		running:    make(map[uuid.UUID]context.CancelFunc),
+	}
+}
+
+// Track returns a context for processing the job that is cancelled when the job is
+// cancelled. The returned release func must be called once processing ends.
+func (r *JobRegistry) Track(ctx context.Context, jobID uuid.UUID) (context.Context, func()) {
+	jobCtx, cancel := context.WithCancel(ctx)
+
+	r.mu.Lock()
+	r.running[jobID] = cancel
+	r.mu.Unlock()
+
+	release := func() {
+		r.mu.Lock()
+		delete(r.running, jobID)
+		r.mu.Unlock()
+		cancel()
+	}
+	return jobCtx, release
+}
+
+// Cancel aborts the job if it is running on this worker. It reports whether it was.
+func (r *JobRegistry) Cancel(jobID uuid.UUID) bool {
+	r.mu.Lock()
+	cancel, ok := r.running[jobID]
+	r.mu.Unlock()
+
+	if ok {
+		cancel()
+	}
+	return ok
+}
+
+// Listen cancels tracked jobs as cancellations arrive. It blocks until ctx is done.
+func (r *JobRegistry) Listen(ctx context.Context) {
+	jobIDs, cleanup, err := r.subscriber.SubscribeJobCancels(ctx)
+	if err != nil {
+		r.logger.Error("failed to subscribe to job cancellations", "error", err)
+		return
+	}
+	defer cleanup()
+
+	for jobID := range jobIDs {
+		if r.Cancel(jobID) {
+			r.logger.Info("aborted running job after cancellation", "jobID", jobID)
+		}
+	}
+}
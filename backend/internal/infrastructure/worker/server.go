@@ -2,14 +2,55 @@ package worker
 
 import (
 	"context"
+	"errors"
+	"os"
+	"time"
 
 	"github.com/hibiken/asynq"
 
 	appservice "github.com/sogos/mirai-backend/internal/application/service"
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
 	domainservice "github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/domain/tenant"
 	"github.com/sogos/mirai-backend/internal/domain/worker"
 )
 
+// shutdownTimeout bounds how long Shutdown waits for in-flight tasks to
+// checkpoint and return. It must fit inside the pod's termination grace period
+// (45s minus the 10s preStop sleep) alongside the HTTP server shutdown.
+const shutdownTimeout = 20 * time.Second
+
+// Concurrency is how many tasks each worker pod processes at once.
+const Concurrency = 10
+
+// maintenanceRetryDelay is how long a task deferred by maintenance mode waits
+// before it is picked up again.
+const maintenanceRetryDelay = 30 * time.Second
+
+// heartbeatTimeout bounds recording a task's heartbeat after it succeeds.
+const heartbeatTimeout = 5 * time.Second
+
+// errMaintenanceDeferred is returned for tasks that arrive while maintenance mode
+// is enabled. It does not count as a failure, so deferring never exhausts retries.
+var errMaintenanceDeferred = errors.New("maintenance mode enabled: task deferred")
+
+// scheduledTaskTypes are periodic tasks that are skipped outright during
+// maintenance; the scheduler enqueues a fresh one on the next tick.
+var scheduledTaskTypes = map[string]bool{
+	worker.TypeStripeReconcile:       true,
+	worker.TypeIdentityReconcile:     true,
+	worker.TypeCleanupExpired:        true,
+	worker.TypeAIGenerationPoll:      true,
+	worker.TypeSMEIngestionPoll:      true,
+	worker.TypeGenerationConsistency: true,
+	worker.TypeAbandonedUploads:      true,
+	worker.TypeExpiredExports:        true,
+	worker.TypeLMSSyncPoll:           true,
+	worker.TypeWeeklySummary:         true,
+	worker.TypeCourseVersionBackfill: true,
+}
+
 // Server wraps the Asynq server and scheduler for background job processing.
 type Server struct {
 	server    *asynq.Server
@@ -17,6 +58,17 @@ type Server struct {
 	mux       *asynq.ServeMux
 	handlers  *Handlers
 	logger    domainservice.Logger
+
+	// maintenance pauses task processing while read-only maintenance mode is enabled.
+	maintenance *appservice.MaintenanceService
+
+	// jobs aborts running generation jobs when they are cancelled.
+	jobs *JobRegistry
+
+	// baseCtx is the parent of every handler context; drain cancels it on
+	// Shutdown so in-flight handlers can checkpoint their jobs.
+	baseCtx context.Context
+	drain   context.CancelFunc
 }
 
 // NewServer creates a new Asynq worker server with all handlers registered.
@@ -26,23 +78,50 @@ func NewServer(
 	cleanupService *appservice.CleanupService,
 	aiGenService *appservice.AIGenerationService,
 	smeIngestionService *appservice.SMEIngestionService,
+	lmsSyncService *appservice.LMSSyncService,
+	courseService *appservice.CourseService,
+	notificationService *appservice.NotificationService,
+	maintenance *appservice.MaintenanceService,
+	jobs *JobRegistry,
+	heartbeats repository.TaskHeartbeatRepository,
 	workerClient *Client,
 	logger domainservice.Logger,
 ) *Server {
+	// Base context for all task handlers. Cancelling it during shutdown tells
+	// processing jobs to stop at the next safe point and re-queue themselves.
+	baseCtx, drain := context.WithCancel(context.Background())
+
 	// Configure the Asynq server
 	server := asynq.NewServer(
 		asynq.RedisClientOpt{Addr: redisAddr},
 		asynq.Config{
-			// Process up to 10 tasks concurrently per pod
-			Concurrency: 10,
+			// Process up to Concurrency tasks at once per pod
+			Concurrency: Concurrency,
+			// Handlers derive their context from baseCtx (see Shutdown)
+			BaseContext: func() context.Context { return baseCtx },
+			// Give interrupted handlers time to persist a checkpoint
+			ShutdownTimeout: shutdownTimeout,
 			// Priority queues - higher number = higher priority
 			Queues: map[string]int{
 				worker.QueueCritical: 6, // Provisioning gets most workers
 				worker.QueueDefault:  3, // AI/SME tasks
 				worker.QueueLow:      1, // Cleanup tasks
 			},
+			// Tasks deferred by maintenance mode keep their retry budget
+			IsFailure: func(err error) bool {
+				return !errors.Is(err, errMaintenanceDeferred)
+			},
+			RetryDelayFunc: func(n int, err error, task *asynq.Task) time.Duration {
+				if errors.Is(err, errMaintenanceDeferred) {
+					return maintenanceRetryDelay
+				}
+				return asynq.DefaultRetryDelayFunc(n, err, task)
+			},
 			// Log errors
 			ErrorHandler: asynq.ErrorHandlerFunc(func(ctx context.Context, task *asynq.Task, err error) {
+				if errors.Is(err, errMaintenanceDeferred) {
+					return
+				}
 				logger.Error("task failed",
 					"type", task.Type(),
 					"error", err,
@@ -65,26 +144,101 @@ func NewServer(
 		cleanupService,
 		aiGenService,
 		smeIngestionService,
+		lmsSyncService,
+		courseService,
+		notificationService,
 		workerClient,
 		logger,
 	)
 
 	// Create and configure the mux
 	mux := asynq.NewServeMux()
+	mux.Use(maintenanceMiddleware(maintenance, logger))
+	mux.Use(heartbeatMiddleware(heartbeats, logger))
 	mux.HandleFunc(worker.TypeStripeProvision, handlers.HandleStripeProvision)
 	mux.HandleFunc(worker.TypeStripeReconcile, handlers.HandleStripeReconcile)
+	mux.HandleFunc(worker.TypeIdentityReconcile, handlers.HandleIdentityReconcile)
 	mux.HandleFunc(worker.TypeCleanupExpired, handlers.HandleCleanupExpired)
+	mux.HandleFunc(worker.TypeAbandonedUploads, handlers.HandleAbandonedUploads)
+	mux.HandleFunc(worker.TypeExpiredExports, handlers.HandleExpiredExports)
 	mux.HandleFunc(worker.TypeAIGeneration, handlers.HandleAIGeneration)
 	mux.HandleFunc(worker.TypeSMEIngestion, handlers.HandleSMEIngestion)
+	mux.HandleFunc(worker.TypeSMETopicRecluster, handlers.HandleSMETopicRecluster)
+	mux.HandleFunc(worker.TypeSMESummaryRefit, handlers.HandleSMESummaryRefit)
+	mux.HandleFunc(worker.TypeSMESummaryBackfill, handlers.HandleSMESummaryBackfill)
+	mux.HandleFunc(worker.TypeQuizSchemaMigration, handlers.HandleQuizSchemaMigration)
 	mux.HandleFunc(worker.TypeAIGenerationPoll, handlers.HandleAIGenerationPoll)
 	mux.HandleFunc(worker.TypeSMEIngestionPoll, handlers.HandleSMEIngestionPoll)
+	mux.HandleFunc(worker.TypeGenerationConsistency, handlers.HandleGenerationConsistency)
+	mux.HandleFunc(worker.TypeLMSSyncPoll, handlers.HandleLMSSyncPoll)
+	mux.HandleFunc(worker.TypeTenantCacheWarm, handlers.HandleTenantCacheWarm)
+	mux.HandleFunc(worker.TypeStorageEncryption, handlers.HandleStorageEncryption)
+	mux.HandleFunc(worker.TypeStorageRegionMigrate, handlers.HandleStorageRegionMigration)
+	mux.HandleFunc(worker.TypeCourseAttachment, handlers.HandleCourseAttachment)
+	mux.HandleFunc(worker.TypeWeeklySummary, handlers.HandleWeeklySummary)
+	mux.HandleFunc(worker.TypeCourseVersionBackfill, handlers.HandleCourseVersionBackfill)
 
 	return &Server{
-		server:    server,
-		scheduler: scheduler,
-		mux:       mux,
-		handlers:  handlers,
-		logger:    logger,
+		server:      server,
+		scheduler:   scheduler,
+		mux:         mux,
+		handlers:    handlers,
+		logger:      logger,
+		maintenance: maintenance,
+		jobs:        jobs,
+		baseCtx:     baseCtx,
+		drain:       drain,
+	}
+}
+
+// maintenanceMiddleware stops the worker from starting new tasks while maintenance
+// mode is enabled. Tasks already running are not interrupted. Scheduled tasks are
+// skipped; all other tasks are deferred and resume automatically once the flag clears.
+func maintenanceMiddleware(maintenance *appservice.MaintenanceService, logger domainservice.Logger) asynq.MiddlewareFunc {
+	return func(next asynq.Handler) asynq.Handler {
+		return asynq.HandlerFunc(func(ctx context.Context, t *asynq.Task) error {
+			if maintenance == nil || !maintenance.IsEnabled(ctx) {
+				return next.ProcessTask(ctx, t)
+			}
+			if scheduledTaskTypes[t.Type()] {
+				logger.Debug("skipping scheduled task during maintenance", "type", t.Type())
+				return nil
+			}
+			logger.Info("deferring task during maintenance", "type", t.Type())
+			return errMaintenanceDeferred
+		})
+	}
+}
+
+// heartbeatMiddleware records when each task type last succeeded, so diagnostics can
+// show whether scheduled sweeps are still running. It runs inside the maintenance
+// middleware, so tasks skipped or deferred during maintenance don't count.
+func heartbeatMiddleware(heartbeats repository.TaskHeartbeatRepository, logger domainservice.Logger) asynq.MiddlewareFunc {
+	host, _ := os.Hostname()
+	return func(next asynq.Handler) asynq.Handler {
+		return asynq.HandlerFunc(func(ctx context.Context, t *asynq.Task) error {
+			start := time.Now()
+			if err := next.ProcessTask(ctx, t); err != nil {
+				return err
+			}
+			if heartbeats == nil {
+				return nil
+			}
+
+			// The task's context may already be cancelled by a shutdown
+			recordCtx, cancel := context.WithTimeout(tenant.WithSuperAdmin(context.Background(), true), heartbeatTimeout)
+			defer cancel()
+			err := heartbeats.Record(recordCtx, &entity.TaskHeartbeat{
+				TaskType:        t.Type(),
+				LastSucceededAt: time.Now(),
+				LastDuration:    time.Since(start),
+				WorkerHost:      host,
+			})
+			if err != nil {
+				logger.Warn("failed to record task heartbeat", "type", t.Type(), "error", err)
+			}
+			return nil
+		})
 	}
 }
 
@@ -111,9 +265,34 @@ func (s *Server) Run() error {
 	}
 	s.logger.Info("registered cleanup scheduled task", "schedule", "@every 1h")
 
+	// Abort multipart uploads abandoned for over a day, every 1 hour
+	_, err = s.scheduler.Register("@every 1h", worker.NewAbandonedUploadsTask())
+	if err != nil {
+		s.logger.Error("failed to register abandoned uploads task", "error", err)
+		return err
+	}
+	s.logger.Info("registered abandoned uploads task", "schedule", "@every 1h")
+
+	// Delete export artifacts past their tenant's retention period, every 1 hour
+	_, err = s.scheduler.Register("@every 1h", worker.NewExpiredExportsTask())
+	if err != nil {
+		s.logger.Error("failed to register expired exports task", "error", err)
+		return err
+	}
+	s.logger.Info("registered expired exports task", "schedule", "@every 1h")
+
+	// Orphaned Kratos identity reconciliation every 1 hour
+	_, err = s.scheduler.Register("@every 1h", worker.NewIdentityReconcileTask())
+	if err != nil {
+		s.logger.Error("failed to register identity reconciliation task", "error", err)
+		return err
+	}
+	s.logger.Info("registered identity reconciliation task", "schedule", "@every 1h")
+
 	// AI generation sweep polling every 5 minutes (crash recovery)
 	// Primary job pickup is event-driven via EnqueueAIGeneration on job creation.
-	// This poll serves as a backup to catch jobs that failed to enqueue or stale jobs.
+	// This poll serves as a backup to catch jobs that failed to enqueue or stale jobs, and
+	// enqueues child jobs whose push after commit failed.
 	_, err = s.scheduler.Register("@every 5m", worker.NewAIGenerationPollTask())
 	if err != nil {
 		s.logger.Error("failed to register AI generation poll task", "error", err)
@@ -121,6 +300,14 @@ func (s *Server) Run() error {
 	}
 	s.logger.Info("registered AI generation poll task", "schedule", "@every 5m")
 
+	// Generation consistency sweep every 1 hour (finalizes stuck parents, flags anomalies)
+	_, err = s.scheduler.Register("@every 1h", worker.NewGenerationConsistencyTask())
+	if err != nil {
+		s.logger.Error("failed to register generation consistency task", "error", err)
+		return err
+	}
+	s.logger.Info("registered generation consistency task", "schedule", "@every 1h")
+
 	// SME ingestion polling every 5 seconds
 	_, err = s.scheduler.Register("@every 5s", worker.NewSMEIngestionPollTask())
 	if err != nil {
@@ -129,6 +316,30 @@ func (s *Server) Run() error {
 	}
 	s.logger.Info("registered SME ingestion poll task", "schedule", "@every 5s")
 
+	// LMS course sync deliveries every 1 minute (retries are spaced by each delivery's backoff)
+	_, err = s.scheduler.Register("@every 1m", worker.NewLMSSyncPollTask())
+	if err != nil {
+		s.logger.Error("failed to register LMS sync poll task", "error", err)
+		return err
+	}
+	s.logger.Info("registered LMS sync poll task", "schedule", "@every 1m")
+
+	// Weekly summary emails every 15 minutes (each tenant is sent once its scheduled hour passes)
+	_, err = s.scheduler.Register("@every 15m", worker.NewWeeklySummaryTask())
+	if err != nil {
+		s.logger.Error("failed to register weekly summary task", "error", err)
+		return err
+	}
+	s.logger.Info("registered weekly summary task", "schedule", "@every 15m")
+
+	// Published version backfill every 10 minutes (finds nothing once every course has one)
+	_, err = s.scheduler.Register("@every 10m", worker.NewCourseVersionBackfillTask())
+	if err != nil {
+		s.logger.Error("failed to register course version backfill task", "error", err)
+		return err
+	}
+	s.logger.Info("registered course version backfill task", "schedule", "@every 10m")
+
 	// Start the scheduler in a goroutine
 	go func() {
 		if err := s.scheduler.Run(); err != nil {
@@ -136,14 +347,24 @@ func (s *Server) Run() error {
 		}
 	}()
 
+	// Abort running generation jobs when users cancel them (stops on drain)
+	if s.jobs != nil {
+		go s.jobs.Listen(s.baseCtx)
+	}
+
 	// Start the server (blocking)
 	return s.server.Run(s.mux)
 }
 
 // Shutdown gracefully stops the server and scheduler.
+// In-flight handlers have their context cancelled so long-running jobs can
+// checkpoint (re-queue with progress preserved) instead of being killed
+// mid-provider-call. Shutdown then waits up to shutdownTimeout for them to return.
 func (s *Server) Shutdown() {
 	s.logger.Info("shutting down Asynq worker server")
 	s.scheduler.Shutdown()
+	s.server.Stop() // stop pulling new tasks before interrupting active ones
+	s.drain()
 	s.server.Shutdown()
 }
 
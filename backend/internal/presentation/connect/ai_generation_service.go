@@ -75,6 +75,9 @@ func (s *AIGenerationServiceServer) GenerateCourseOutline(
 		TargetAudienceIDs: targetAudienceIDs,
 		DesiredOutcome:    input.DesiredOutcome,
 		AdditionalContext: additionalContext,
+		Constraints:       outlineConstraintsFromProto(input.Constraints),
+		Preferences:       generationPreferencesFromProto(input.Preferences),
+		AutoApprove:       req.Msg.AutoApprove,
 	}
 
 	result, err := s.aiService.GenerateCourseOutline(ctx, kratosID, serviceReq)
@@ -83,10 +86,137 @@ func (s *AIGenerationServiceServer) GenerateCourseOutline(
 	}
 
 	return connect.NewResponse(&v1.GenerateCourseOutlineResponse{
-		Job: generationJobToProto(result.Job),
+		Job:      generationJobToProto(result.Job),
+		Coverage: knowledgeCoverageToProto(result.Coverage),
+	}), nil
+}
+
+// AnalyzeKnowledgeCoverage checks whether the selected SMEs have enough material for a course goal.
+func (s *AIGenerationServiceServer) AnalyzeKnowledgeCoverage(
+	ctx context.Context,
+	req *connect.Request[v1.AnalyzeKnowledgeCoverageRequest],
+) (*connect.Response[v1.AnalyzeKnowledgeCoverageResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	smeIDs := make([]uuid.UUID, 0, len(req.Msg.SmeIds))
+	for _, id := range req.Msg.SmeIds {
+		smeID, err := parseUUID(id)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		smeIDs = append(smeIDs, smeID)
+	}
+
+	coverage, err := s.aiService.AnalyzeKnowledgeCoverage(ctx, kratosID, service.AnalyzeKnowledgeCoverageRequest{
+		SMEIDs:         smeIDs,
+		DesiredOutcome: req.Msg.DesiredOutcome,
+		CourseTitle:    req.Msg.GetCourseTitle(),
+	})
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.AnalyzeKnowledgeCoverageResponse{
+		Coverage: knowledgeCoverageToProto(coverage),
+	}), nil
+}
+
+// SaveGenerationDraft stores the user's generation wizard selections for a course.
+func (s *AIGenerationServiceServer) SaveGenerationDraft(
+	ctx context.Context,
+	req *connect.Request[v1.SaveGenerationDraftRequest],
+) (*connect.Response[v1.SaveGenerationDraftResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	input := req.Msg.Input
+	if input == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errInputRequired)
+	}
+
+	courseID, err := parseUUID(input.CourseId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	smeIDs := make([]uuid.UUID, 0, len(input.SmeIds))
+	for _, id := range input.SmeIds {
+		if uid, err := uuid.Parse(id); err == nil {
+			smeIDs = append(smeIDs, uid)
+		}
+	}
+
+	targetAudienceIDs := make([]uuid.UUID, 0, len(input.TargetAudienceIds))
+	for _, id := range input.TargetAudienceIds {
+		if uid, err := uuid.Parse(id); err == nil {
+			targetAudienceIDs = append(targetAudienceIDs, uid)
+		}
+	}
+
+	draft, err := s.aiService.SaveGenerationDraft(ctx, kratosID, service.SaveGenerationDraftRequest{
+		CourseID:          courseID,
+		SMEIDs:            smeIDs,
+		TargetAudienceIDs: targetAudienceIDs,
+		DesiredOutcome:    input.DesiredOutcome,
+		AdditionalContext: input.GetAdditionalContext(),
+		Constraints:       outlineConstraintsFromProto(input.Constraints),
+	})
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.SaveGenerationDraftResponse{
+		Draft: generationDraftToProto(draft),
 	}), nil
 }
 
+// GetGenerationDraft returns the user's generation wizard draft for a course.
+func (s *AIGenerationServiceServer) GetGenerationDraft(
+	ctx context.Context,
+	req *connect.Request[v1.GetGenerationDraftRequest],
+) (*connect.Response[v1.GetGenerationDraftResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	courseID, err := parseUUID(req.Msg.CourseId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	draft, err := s.aiService.GetGenerationDraft(ctx, kratosID, courseID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	resp := &v1.GetGenerationDraftResponse{}
+	if draft != nil {
+		resp.Draft = generationDraftToProto(draft)
+	}
+	return connect.NewResponse(resp), nil
+}
+
 // GetCourseOutline returns the generated outline for a course.
 func (s *AIGenerationServiceServer) GetCourseOutline(
 	ctx context.Context,
@@ -112,9 +242,14 @@ func (s *AIGenerationServiceServer) GetCourseOutline(
 		return nil, toConnectError(err)
 	}
 
-	return connect.NewResponse(&v1.GetCourseOutlineResponse{
+	resp := &v1.GetCourseOutlineResponse{
 		Outline: courseOutlineToProto(outline),
-	}), nil
+	}
+	if outline.ActiveGenerationJob != nil {
+		resp.ActiveGenerationJob = generationJobToProto(outline.ActiveGenerationJob)
+	}
+
+	return connect.NewResponse(resp), nil
 }
 
 // ApproveCourseOutline approves an outline for content generation.
@@ -207,14 +342,18 @@ func (s *AIGenerationServiceServer) UpdateCourseOutline(
 	for i, protoSection := range req.Msg.Sections {
 		sectionID, err := parseUUID(protoSection.Id)
 		if err != nil {
-			continue
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
 		}
 
 		lessons := make([]service.UpdateCourseOutlineLesson, len(protoSection.Lessons))
 		for j, protoLesson := range protoSection.Lessons {
-			lessonID, err := parseUUID(protoLesson.Id)
-			if err != nil {
-				continue
+			// A lesson without an ID is new
+			var lessonID uuid.UUID
+			if protoLesson.Id != "" {
+				lessonID, err = parseUUID(protoLesson.Id)
+				if err != nil {
+					return nil, connect.NewError(connect.CodeInvalidArgument, err)
+				}
 			}
 
 			var duration *int32
@@ -241,7 +380,15 @@ func (s *AIGenerationServiceServer) UpdateCourseOutline(
 		}
 	}
 
-	outline, err := s.aiService.UpdateCourseOutline(ctx, kratosID, courseID, outlineID, sections)
+	removedLessonIDs := make([]uuid.UUID, len(req.Msg.RemovedLessonIds))
+	for i, id := range req.Msg.RemovedLessonIds {
+		removedLessonIDs[i], err = parseUUID(id)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
+
+	outline, err := s.aiService.UpdateCourseOutline(ctx, kratosID, courseID, outlineID, sections, removedLessonIDs)
 	if err != nil {
 		return nil, toConnectError(err)
 	}
@@ -251,6 +398,104 @@ func (s *AIGenerationServiceServer) UpdateCourseOutline(
 	}), nil
 }
 
+// BalanceOutlineDurations proposes an outline revision with more even lesson durations.
+func (s *AIGenerationServiceServer) BalanceOutlineDurations(
+	ctx context.Context,
+	req *connect.Request[v1.BalanceOutlineDurationsRequest],
+) (*connect.Response[v1.BalanceOutlineDurationsResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	courseID, err := parseUUID(req.Msg.CourseId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	outlineID, err := parseUUID(req.Msg.OutlineId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	proposal, err := s.aiService.BalanceOutlineDurations(ctx, kratosID, service.BalanceOutlineDurationsRequest{
+		CourseID:         courseID,
+		OutlineID:        outlineID,
+		MinLessonMinutes: req.Msg.MinLessonMinutes,
+		MaxLessonMinutes: req.Msg.MaxLessonMinutes,
+	})
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	resp := &v1.BalanceOutlineDurationsResponse{
+		Sections:         make([]*v1.OutlineSection, len(proposal.Sections)),
+		RemovedLessonIds: uuidsToStrings(proposal.RemovedLessonIDs),
+		Changes:          make([]*v1.OutlineBalanceChange, len(proposal.Changes)),
+		MinLessonMinutes: proposal.MinLessonMinutes,
+		MaxLessonMinutes: proposal.MaxLessonMinutes,
+		TokensUsed:       proposal.TokensUsed,
+	}
+	for i, section := range proposal.Sections {
+		resp.Sections[i] = proposedOutlineSectionToProto(section)
+	}
+	for i, change := range proposal.Changes {
+		resp.Changes[i] = &v1.OutlineBalanceChange{
+			Kind:      outlineBalanceChangeKindToProto(change.Kind),
+			SectionId: change.SectionID.String(),
+			LessonIds: uuidsToStrings(change.LessonIDs),
+			Titles:    change.Titles,
+		}
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// ExportOutline exports a course outline as CSV or DOCX and returns a download link.
+func (s *AIGenerationServiceServer) ExportOutline(
+	ctx context.Context,
+	req *connect.Request[v1.ExportOutlineRequest],
+) (*connect.Response[v1.ExportOutlineResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	courseID, err := parseUUID(req.Msg.CourseId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	format, ok := outlineExportFormatFromProto(req.Msg.Format)
+	if !ok {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errExportFormat)
+	}
+
+	result, err := s.aiService.ExportOutline(ctx, kratosID, service.ExportOutlineRequest{
+		CourseID: courseID,
+		Format:   format,
+		Version:  req.Msg.Version,
+	})
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.ExportOutlineResponse{
+		DownloadUrl: result.DownloadURL,
+		Filename:    result.Filename,
+		ExpiresAt:   timestamppb.New(result.ExpiresAt),
+	}), nil
+}
+
 // GenerateLessonContent generates content for a specific lesson.
 func (s *AIGenerationServiceServer) GenerateLessonContent(
 	ctx context.Context,
@@ -261,41 +506,791 @@ func (s *AIGenerationServiceServer) GenerateLessonContent(
 		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
 	}
 
-	kratosID, err := parseUUID(kratosIDStr)
-	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
-	}
-
-	courseID, err := parseUUID(req.Msg.CourseId)
-	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
-	}
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	courseID, err := parseUUID(req.Msg.CourseId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	outlineLessonID, err := parseUUID(req.Msg.OutlineLessonId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	serviceReq := service.GenerateLessonContentRequest{
+		CourseID:        courseID,
+		OutlineLessonID: outlineLessonID,
+	}
+
+	result, err := s.aiService.GenerateLessonContent(ctx, kratosID, serviceReq)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.GenerateLessonContentResponse{
+		Job: generationJobToProto(result.Job),
+	}), nil
+}
+
+// GenerateAllLessons generates content for all lessons in outline.
+func (s *AIGenerationServiceServer) GenerateAllLessons(
+	ctx context.Context,
+	req *connect.Request[v1.GenerateAllLessonsRequest],
+) (*connect.Response[v1.GenerateAllLessonsResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	courseID, err := parseUUID(req.Msg.CourseId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	result, err := s.aiService.GenerateAllLessons(ctx, kratosID, courseID, generationPreferencesFromProto(req.Msg.Preferences))
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	resp := &v1.GenerateAllLessonsResponse{
+		Job:            generationJobToProto(result.Job),
+		AlreadyRunning: result.AlreadyRunning,
+	}
+	if result.StartedByName != "" {
+		resp.StartedByName = &result.StartedByName
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// ExportAllLessons starts a job that packages every generated lesson into a ZIP.
+func (s *AIGenerationServiceServer) ExportAllLessons(
+	ctx context.Context,
+	req *connect.Request[v1.ExportAllLessonsRequest],
+) (*connect.Response[v1.ExportAllLessonsResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	courseID, err := parseUUID(req.Msg.CourseId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	result, err := s.aiService.ExportAllLessons(ctx, kratosID, courseID, req.Msg.IncludeCitations)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.ExportAllLessonsResponse{
+		Job: generationJobToProto(result.Job),
+	}), nil
+}
+
+// RetryFailedLessons requeues the failed lessons of a failed full course run.
+func (s *AIGenerationServiceServer) RetryFailedLessons(
+	ctx context.Context,
+	req *connect.Request[v1.RetryFailedLessonsRequest],
+) (*connect.Response[v1.RetryFailedLessonsResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	var retryReq service.RetryFailedLessonsRequest
+	if req.Msg.JobId != nil && *req.Msg.JobId != "" {
+		jobID, err := parseUUID(*req.Msg.JobId)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		retryReq.JobID = &jobID
+	} else if req.Msg.CourseId != nil && *req.Msg.CourseId != "" {
+		courseID, err := parseUUID(*req.Msg.CourseId)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		retryReq.CourseID = &courseID
+	}
+
+	result, err := s.aiService.RetryFailedLessons(ctx, kratosID, retryReq)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.RetryFailedLessonsResponse{
+		Job:          generationJobToProto(result.Job),
+		RetriedCount: int32(result.RetriedCount),
+	}), nil
+}
+
+// RegenerateComponent regenerates a single component with modifications.
+func (s *AIGenerationServiceServer) RegenerateComponent(
+	ctx context.Context,
+	req *connect.Request[v1.RegenerateComponentRequest],
+) (*connect.Response[v1.RegenerateComponentResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	courseID, err := parseUUID(req.Msg.CourseId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	lessonID, err := parseUUID(req.Msg.LessonId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	componentID, err := parseUUID(req.Msg.ComponentId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	serviceReq := service.RegenerateComponentRequest{
+		CourseID:           courseID,
+		LessonID:           lessonID,
+		ComponentID:        componentID,
+		ModificationPrompt: req.Msg.ModificationPrompt,
+	}
+
+	result, err := s.aiService.RegenerateComponent(ctx, kratosID, serviceReq)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.RegenerateComponentResponse{
+		Job: generationJobToProto(result.Job),
+	}), nil
+}
+
+// EditComponentText rewrites a text component inline without a background job.
+func (s *AIGenerationServiceServer) EditComponentText(
+	ctx context.Context,
+	req *connect.Request[v1.EditComponentTextRequest],
+) (*connect.Response[v1.EditComponentTextResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	componentID, err := parseUUID(req.Msg.ComponentId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	result, err := s.aiService.EditComponentText(ctx, kratosID, service.EditComponentTextRequest{
+		ComponentID: componentID,
+		Instruction: req.Msg.Instruction,
+	})
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.EditComponentTextResponse{
+		ComponentId: result.Component.ID.String(),
+		Type:        lessonComponentTypeToProto(result.Component.Type),
+		ContentJson: result.ContentJSON,
+		TokensUsed:  result.TokensUsed,
+		CacheHit:    result.CacheHit,
+		Order:       result.Component.Position,
+	}), nil
+}
+
+// GetComponentSources returns the SME knowledge chunks a component was generated from.
+func (s *AIGenerationServiceServer) GetComponentSources(
+	ctx context.Context,
+	req *connect.Request[v1.GetComponentSourcesRequest],
+) (*connect.Response[v1.GetComponentSourcesResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	componentID, err := parseUUID(req.Msg.ComponentId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	sources, err := s.aiService.GetComponentSources(ctx, kratosID, componentID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	protoSources := make([]*v1.ComponentSource, len(sources))
+	for i, source := range sources {
+		protoSources[i] = &v1.ComponentSource{
+			ChunkId: source.ChunkID.String(),
+			SmeId:   source.SMEID.String(),
+			SmeName: source.SMEName,
+			Topic:   source.Topic,
+			Excerpt: source.Excerpt,
+		}
+	}
+
+	return connect.NewResponse(&v1.GetComponentSourcesResponse{
+		Sources: protoSources,
+	}), nil
+}
+
+// GetComponentAssetUploadURL returns a presigned URL for uploading a placeholder component's file.
+func (s *AIGenerationServiceServer) GetComponentAssetUploadURL(
+	ctx context.Context,
+	req *connect.Request[v1.GetComponentAssetUploadURLRequest],
+) (*connect.Response[v1.GetComponentAssetUploadURLResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	componentID, err := parseUUID(req.Msg.ComponentId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	uploadURL, filePath, err := s.aiService.GetComponentAssetUploadURL(ctx, kratosID, service.GetComponentAssetUploadRequest{
+		ComponentID: componentID,
+		FileName:    req.Msg.FileName,
+		ContentType: req.Msg.ContentType,
+	})
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.GetComponentAssetUploadURLResponse{
+		UploadUrl: uploadURL,
+		FilePath:  filePath,
+	}), nil
+}
+
+// ConfirmComponentAsset attaches an uploaded file to its component.
+func (s *AIGenerationServiceServer) ConfirmComponentAsset(
+	ctx context.Context,
+	req *connect.Request[v1.ConfirmComponentAssetRequest],
+) (*connect.Response[v1.ConfirmComponentAssetResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	componentID, err := parseUUID(req.Msg.ComponentId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	component, err := s.aiService.ConfirmComponentAsset(ctx, kratosID, componentID, req.Msg.FilePath)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.ConfirmComponentAssetResponse{
+		Component: lessonComponentToProto(component),
+	}), nil
+}
+
+// SuggestCourseTitles suggests course titles from the selected SMEs, audiences and outcome.
+func (s *AIGenerationServiceServer) SuggestCourseTitles(
+	ctx context.Context,
+	req *connect.Request[v1.SuggestCourseTitlesRequest],
+) (*connect.Response[v1.SuggestCourseTitlesResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	smeIDs := make([]uuid.UUID, 0, len(req.Msg.SmeIds))
+	for _, id := range req.Msg.SmeIds {
+		uid, err := parseUUID(id)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		smeIDs = append(smeIDs, uid)
+	}
+
+	targetAudienceIDs := make([]uuid.UUID, 0, len(req.Msg.TargetAudienceIds))
+	for _, id := range req.Msg.TargetAudienceIds {
+		uid, err := parseUUID(id)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		targetAudienceIDs = append(targetAudienceIDs, uid)
+	}
+
+	result, err := s.aiService.SuggestCourseTitles(ctx, kratosID, service.SuggestCourseTitlesRequest{
+		SMEIDs:            smeIDs,
+		TargetAudienceIDs: targetAudienceIDs,
+		DesiredOutcome:    req.Msg.DesiredOutcome,
+	})
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	suggestions := make([]*v1.CourseTitleSuggestion, len(result.Suggestions))
+	for i, suggestion := range result.Suggestions {
+		suggestions[i] = &v1.CourseTitleSuggestion{
+			Title:     suggestion.Text,
+			Rationale: suggestion.Rationale,
+		}
+	}
+
+	return connect.NewResponse(&v1.SuggestCourseTitlesResponse{
+		Suggestions: suggestions,
+		TokensUsed:  result.TokensUsed,
+	}), nil
+}
+
+// GetJob returns a generation job by ID.
+func (s *AIGenerationServiceServer) GetJob(
+	ctx context.Context,
+	req *connect.Request[v1.GetJobRequest],
+) (*connect.Response[v1.GetJobResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	jobID, err := parseUUID(req.Msg.JobId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	job, err := s.aiService.GetJob(ctx, kratosID, jobID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.GetJobResponse{
+		Job: generationJobToProto(job),
+	}), nil
+}
+
+// ListJobs returns generation jobs for the current user.
+func (s *AIGenerationServiceServer) ListJobs(
+	ctx context.Context,
+	req *connect.Request[v1.ListJobsRequest],
+) (*connect.Response[v1.ListJobsResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	opts := entity.GenerationJobListOptions{}
+
+	if req.Msg.Type != nil {
+		jobType := protoToGenerationJobType(*req.Msg.Type)
+		opts.Type = &jobType
+	}
+
+	if req.Msg.Status != nil {
+		status := protoToGenerationJobStatus(*req.Msg.Status)
+		opts.Status = &status
+	}
+
+	if req.Msg.CourseId != nil {
+		if id, err := uuid.Parse(*req.Msg.CourseId); err == nil {
+			opts.CourseID = &id
+		}
+	}
+
+	// Lists show parents with child counts unless a caller asks for children, e.g. the
+	// per-lesson view of one full course run
+	if req.Msg.ParentJobId != nil {
+		parentID, err := parseUUID(*req.Msg.ParentJobId)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		opts.ParentJobID = &parentID
+	} else {
+		opts.ExcludeChildren = req.Msg.ExcludeChildren == nil || *req.Msg.ExcludeChildren
+	}
+	opts.Limit = int(req.Msg.Limit)
+	opts.Cursor = req.Msg.Cursor
+
+	result, err := s.aiService.ListJobs(ctx, kratosID, opts)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	protoJobs := make([]*v1.GenerationJob, len(result.Jobs))
+	for i, job := range result.Jobs {
+		protoJobs[i] = generationJobToProto(job)
+	}
+
+	return connect.NewResponse(&v1.ListJobsResponse{
+		Jobs:       protoJobs,
+		NextCursor: strPtr(result.NextCursor),
+	}), nil
+}
+
+// CancelJob cancels a queued or processing job.
+func (s *AIGenerationServiceServer) CancelJob(
+	ctx context.Context,
+	req *connect.Request[v1.CancelJobRequest],
+) (*connect.Response[v1.CancelJobResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	jobID, err := parseUUID(req.Msg.JobId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	job, err := s.aiService.CancelJob(ctx, kratosID, jobID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.CancelJobResponse{
+		Job: generationJobToProto(job),
+	}), nil
+}
+
+// GetGeneratedLesson returns generated lesson content.
+func (s *AIGenerationServiceServer) GetGeneratedLesson(
+	ctx context.Context,
+	req *connect.Request[v1.GetGeneratedLessonRequest],
+) (*connect.Response[v1.GetGeneratedLessonResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	lessonID, err := parseUUID(req.Msg.LessonId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	lesson, err := s.aiService.GetGeneratedLesson(ctx, kratosID, lessonID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.GetGeneratedLessonResponse{
+		Lesson: generatedLessonToProto(lesson),
+	}), nil
+}
+
+// ListGeneratedLessons returns all generated lessons for a course.
+func (s *AIGenerationServiceServer) ListGeneratedLessons(
+	ctx context.Context,
+	req *connect.Request[v1.ListGeneratedLessonsRequest],
+) (*connect.Response[v1.ListGeneratedLessonsResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	courseID, err := parseUUID(req.Msg.CourseId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	lessons, err := s.aiService.ListGeneratedLessons(ctx, kratosID, courseID, req.Msg.IncludeOrphaned)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	protoLessons := make([]*v1.GeneratedLesson, len(lessons))
+	for i, lesson := range lessons {
+		protoLessons[i] = generatedLessonToProto(lesson)
+	}
+
+	return connect.NewResponse(&v1.ListGeneratedLessonsResponse{
+		Lessons: protoLessons,
+	}), nil
+}
+
+// GetCourseStats returns content statistics for a course's generated lessons.
+func (s *AIGenerationServiceServer) GetCourseStats(
+	ctx context.Context,
+	req *connect.Request[v1.GetCourseStatsRequest],
+) (*connect.Response[v1.GetCourseStatsResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	courseID, err := parseUUID(req.Msg.CourseId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	stats, err := s.aiService.GetCourseStats(ctx, kratosID, courseID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	sections := make([]*v1.SectionStats, len(stats.Sections))
+	for i, section := range stats.Sections {
+		sections[i] = &v1.SectionStats{
+			SectionId: section.SectionID.String(),
+			Title:     section.Title,
+			Stats:     contentStatsToProto(section.Stats),
+		}
+	}
+
+	return connect.NewResponse(&v1.GetCourseStatsResponse{
+		Totals:   contentStatsToProto(stats.Totals),
+		Sections: sections,
+	}), nil
+}
+
+// GetAccessibilityReport lists the accessibility problems left in a course's lessons.
+func (s *AIGenerationServiceServer) GetAccessibilityReport(
+	ctx context.Context,
+	req *connect.Request[v1.GetAccessibilityReportRequest],
+) (*connect.Response[v1.GetAccessibilityReportResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	courseID, err := parseUUID(req.Msg.CourseId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	report, err := s.aiService.GetAccessibilityReport(ctx, kratosID, courseID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	issues := make([]*v1.AccessibilityIssue, len(report.Issues))
+	for i, issue := range report.Issues {
+		issues[i] = &v1.AccessibilityIssue{
+			Type:                    accessibilityIssueTypeToProto(issue.Type),
+			LessonId:                issue.LessonID.String(),
+			LessonTitle:             issue.LessonTitle,
+			ComponentId:             issue.ComponentID.String(),
+			Position:                issue.Position,
+			Detail:                  issue.Detail,
+			AltTextGenerationFailed: issue.AltTextGenerationFailed,
+		}
+	}
+
+	return connect.NewResponse(&v1.GetAccessibilityReportResponse{
+		Issues:            issues,
+		LessonsChecked:    int32(report.LessonsChecked),
+		ComponentsChecked: int32(report.ComponentsChecked),
+	}), nil
+}
+
+// GetCoursePlayerView returns a course's lessons as the learner-facing player shows them.
+func (s *AIGenerationServiceServer) GetCoursePlayerView(
+	ctx context.Context,
+	req *connect.Request[v1.GetCoursePlayerViewRequest],
+) (*connect.Response[v1.GetCoursePlayerViewResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	courseID, err := parseUUID(req.Msg.CourseId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	view, err := s.aiService.GetCoursePlayerView(ctx, kratosID, courseID, req.Msg.Draft)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.GetCoursePlayerViewResponse{
+		View: coursePlayerViewToProto(view),
+	}), nil
+}
+
+// GetQueueStatus returns the tenant's active jobs and the state of the shared generation queue.
+func (s *AIGenerationServiceServer) GetQueueStatus(
+	ctx context.Context,
+	req *connect.Request[v1.GetQueueStatusRequest],
+) (*connect.Response[v1.GetQueueStatusResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	status, err := s.aiService.GetQueueStatus(ctx, kratosID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	counts := make([]*v1.JobTypeQueueCount, len(status.Counts))
+	for i, c := range status.Counts {
+		counts[i] = &v1.JobTypeQueueCount{
+			Type:       generationJobTypeToProto(c.Type),
+			Queued:     int32(c.Queued),
+			Processing: int32(c.Processing),
+		}
+	}
+
+	resp := &v1.GetQueueStatusResponse{
+		Counts:                counts,
+		WorkerConcurrency:     int32(status.WorkerConcurrency),
+		AvgJobDurationSeconds: int32(status.AvgJobDuration.Seconds()),
+		ProviderDegraded:      status.ProviderDegraded,
+		GenerationPaused:      status.GenerationPaused,
+	}
+	if status.QueuePosition != nil {
+		position := int32(*status.QueuePosition)
+		resp.QueuePosition = &position
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// ListAnomalies returns generation anomalies across tenants. Superadmin only.
+func (s *AIGenerationServiceServer) ListAnomalies(
+	ctx context.Context,
+	req *connect.Request[v1.ListAnomaliesRequest],
+) (*connect.Response[v1.ListAnomaliesResponse], error) {
+	email, ok := ctx.Value(emailKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	listReq := service.ListJobAnomaliesRequest{Limit: int(req.Msg.Limit)}
 
-	outlineLessonID, err := parseUUID(req.Msg.OutlineLessonId)
-	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	if req.Msg.TenantId != nil {
+		tenantID, err := parseUUID(*req.Msg.TenantId)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		listReq.TenantID = &tenantID
 	}
 
-	serviceReq := service.GenerateLessonContentRequest{
-		CourseID:        courseID,
-		OutlineLessonID: outlineLessonID,
+	if req.Msg.Type != nil && *req.Msg.Type != v1.JobAnomalyType_JOB_ANOMALY_TYPE_UNSPECIFIED {
+		anomalyType := protoToJobAnomalyType(*req.Msg.Type)
+		listReq.Type = &anomalyType
 	}
 
-	result, err := s.aiService.GenerateLessonContent(ctx, kratosID, serviceReq)
+	anomalies, err := s.aiService.ListJobAnomalies(ctx, email, listReq)
 	if err != nil {
 		return nil, toConnectError(err)
 	}
 
-	return connect.NewResponse(&v1.GenerateLessonContentResponse{
-		Job: generationJobToProto(result.Job),
+	protoAnomalies := make([]*v1.JobAnomaly, len(anomalies))
+	for i, anomaly := range anomalies {
+		protoAnomalies[i] = &v1.JobAnomaly{
+			Id:         anomaly.ID.String(),
+			TenantId:   anomaly.TenantID.String(),
+			JobId:      anomaly.JobID.String(),
+			CourseId:   uuidPtrToString(anomaly.CourseID),
+			Type:       jobAnomalyTypeToProto(anomaly.Type),
+			Details:    anomaly.Details,
+			Resolved:   anomaly.Resolved,
+			DetectedAt: timestamppb.New(anomaly.DetectedAt),
+		}
+	}
+
+	return connect.NewResponse(&v1.ListAnomaliesResponse{
+		Anomalies: protoAnomalies,
 	}), nil
 }
 
-// GenerateAllLessons generates content for all lessons in outline.
-func (s *AIGenerationServiceServer) GenerateAllLessons(
+// StartStorageAudit starts a job that reports orphaned storage objects of the caller's organization.
+func (s *AIGenerationServiceServer) StartStorageAudit(
 	ctx context.Context,
-	req *connect.Request[v1.GenerateAllLessonsRequest],
-) (*connect.Response[v1.GenerateAllLessonsResponse], error) {
+	req *connect.Request[v1.StartStorageAuditRequest],
+) (*connect.Response[v1.StartStorageAuditResponse], error) {
 	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
 	if !ok {
 		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
@@ -306,26 +1301,21 @@ func (s *AIGenerationServiceServer) GenerateAllLessons(
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
-	courseID, err := parseUUID(req.Msg.CourseId)
-	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
-	}
-
-	result, err := s.aiService.GenerateAllLessons(ctx, kratosID, courseID)
+	job, err := s.aiService.StartStorageAudit(ctx, kratosID, req.Msg.PurgeOrphans)
 	if err != nil {
 		return nil, toConnectError(err)
 	}
 
-	return connect.NewResponse(&v1.GenerateAllLessonsResponse{
-		Job: generationJobToProto(result.Job),
+	return connect.NewResponse(&v1.StartStorageAuditResponse{
+		Job: generationJobToProto(job),
 	}), nil
 }
 
-// RegenerateComponent regenerates a single component with modifications.
-func (s *AIGenerationServiceServer) RegenerateComponent(
+// GetStorageAuditReport returns a storage audit job and a link to its report.
+func (s *AIGenerationServiceServer) GetStorageAuditReport(
 	ctx context.Context,
-	req *connect.Request[v1.RegenerateComponentRequest],
-) (*connect.Response[v1.RegenerateComponentResponse], error) {
+	req *connect.Request[v1.GetStorageAuditReportRequest],
+) (*connect.Response[v1.GetStorageAuditReportResponse], error) {
 	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
 	if !ok {
 		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
@@ -336,43 +1326,32 @@ func (s *AIGenerationServiceServer) RegenerateComponent(
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
-	courseID, err := parseUUID(req.Msg.CourseId)
-	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
-	}
-
-	lessonID, err := parseUUID(req.Msg.LessonId)
+	jobID, err := parseUUID(req.Msg.JobId)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	componentID, err := parseUUID(req.Msg.ComponentId)
+	result, err := s.aiService.GetStorageAuditReport(ctx, kratosID, jobID)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, toConnectError(err)
 	}
 
-	serviceReq := service.RegenerateComponentRequest{
-		CourseID:           courseID,
-		LessonID:           lessonID,
-		ComponentID:        componentID,
-		ModificationPrompt: req.Msg.ModificationPrompt,
+	resp := &v1.GetStorageAuditReportResponse{
+		Job: generationJobToProto(result.Job),
 	}
-
-	result, err := s.aiService.RegenerateComponent(ctx, kratosID, serviceReq)
-	if err != nil {
-		return nil, toConnectError(err)
+	if result.DownloadURL != "" {
+		resp.DownloadUrl = &result.DownloadURL
+		resp.ExpiresAt = timestamppb.New(result.ExpiresAt)
 	}
 
-	return connect.NewResponse(&v1.RegenerateComponentResponse{
-		Job: generationJobToProto(result.Job),
-	}), nil
+	return connect.NewResponse(resp), nil
 }
 
-// GetJob returns a generation job by ID.
-func (s *AIGenerationServiceServer) GetJob(
+// TranslateCourse starts a job that translates a course into a new course.
+func (s *AIGenerationServiceServer) TranslateCourse(
 	ctx context.Context,
-	req *connect.Request[v1.GetJobRequest],
-) (*connect.Response[v1.GetJobResponse], error) {
+	req *connect.Request[v1.TranslateCourseRequest],
+) (*connect.Response[v1.TranslateCourseResponse], error) {
 	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
 	if !ok {
 		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
@@ -383,26 +1362,27 @@ func (s *AIGenerationServiceServer) GetJob(
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
-	jobID, err := parseUUID(req.Msg.JobId)
+	courseID, err := parseUUID(req.Msg.CourseId)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	job, err := s.aiService.GetJob(ctx, kratosID, jobID)
+	result, err := s.aiService.TranslateCourse(ctx, kratosID, courseID, req.Msg.TargetLanguage)
 	if err != nil {
 		return nil, toConnectError(err)
 	}
 
-	return connect.NewResponse(&v1.GetJobResponse{
-		Job: generationJobToProto(job),
+	return connect.NewResponse(&v1.TranslateCourseResponse{
+		Job:      generationJobToProto(result.Job),
+		CourseId: result.Course.ID.String(),
 	}), nil
 }
 
-// ListJobs returns generation jobs for the current user.
-func (s *AIGenerationServiceServer) ListJobs(
+// CreateOutlineComment adds a reviewer comment to an outline lesson.
+func (s *AIGenerationServiceServer) CreateOutlineComment(
 	ctx context.Context,
-	req *connect.Request[v1.ListJobsRequest],
-) (*connect.Response[v1.ListJobsResponse], error) {
+	req *connect.Request[v1.CreateOutlineCommentRequest],
+) (*connect.Response[v1.CreateOutlineCommentResponse], error) {
 	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
 	if !ok {
 		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
@@ -413,44 +1393,35 @@ func (s *AIGenerationServiceServer) ListJobs(
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
-	opts := entity.GenerationJobListOptions{}
-
-	if req.Msg.Type != nil {
-		jobType := protoToGenerationJobType(*req.Msg.Type)
-		opts.Type = &jobType
-	}
-
-	if req.Msg.Status != nil {
-		status := protoToGenerationJobStatus(*req.Msg.Status)
-		opts.Status = &status
+	courseID, err := parseUUID(req.Msg.CourseId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	if req.Msg.CourseId != nil {
-		if id, err := uuid.Parse(*req.Msg.CourseId); err == nil {
-			opts.CourseID = &id
-		}
+	lessonID, err := parseUUID(req.Msg.LessonId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	jobs, err := s.aiService.ListJobs(ctx, kratosID, opts)
+	comment, err := s.aiService.CreateOutlineComment(ctx, kratosID, service.CreateOutlineCommentRequest{
+		CourseID: courseID,
+		LessonID: lessonID,
+		Body:     req.Msg.Body,
+	})
 	if err != nil {
 		return nil, toConnectError(err)
 	}
 
-	protoJobs := make([]*v1.GenerationJob, len(jobs))
-	for i, job := range jobs {
-		protoJobs[i] = generationJobToProto(job)
-	}
-
-	return connect.NewResponse(&v1.ListJobsResponse{
-		Jobs: protoJobs,
+	return connect.NewResponse(&v1.CreateOutlineCommentResponse{
+		Comment: outlineCommentToProto(comment),
 	}), nil
 }
 
-// CancelJob cancels a queued or processing job.
-func (s *AIGenerationServiceServer) CancelJob(
+// ListOutlineComments returns the comments on a course's outline lessons.
+func (s *AIGenerationServiceServer) ListOutlineComments(
 	ctx context.Context,
-	req *connect.Request[v1.CancelJobRequest],
-) (*connect.Response[v1.CancelJobResponse], error) {
+	req *connect.Request[v1.ListOutlineCommentsRequest],
+) (*connect.Response[v1.ListOutlineCommentsResponse], error) {
 	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
 	if !ok {
 		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
@@ -461,26 +1432,31 @@ func (s *AIGenerationServiceServer) CancelJob(
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
-	jobID, err := parseUUID(req.Msg.JobId)
+	courseID, err := parseUUID(req.Msg.CourseId)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	job, err := s.aiService.CancelJob(ctx, kratosID, jobID)
+	comments, err := s.aiService.ListOutlineComments(ctx, kratosID, courseID, req.Msg.IncludeResolved)
 	if err != nil {
 		return nil, toConnectError(err)
 	}
 
-	return connect.NewResponse(&v1.CancelJobResponse{
-		Job: generationJobToProto(job),
+	protoComments := make([]*v1.OutlineComment, len(comments))
+	for i, c := range comments {
+		protoComments[i] = outlineCommentToProto(c)
+	}
+
+	return connect.NewResponse(&v1.ListOutlineCommentsResponse{
+		Comments: protoComments,
 	}), nil
 }
 
-// GetGeneratedLesson returns generated lesson content.
-func (s *AIGenerationServiceServer) GetGeneratedLesson(
+// ResolveOutlineComment marks an outline comment resolved.
+func (s *AIGenerationServiceServer) ResolveOutlineComment(
 	ctx context.Context,
-	req *connect.Request[v1.GetGeneratedLessonRequest],
-) (*connect.Response[v1.GetGeneratedLessonResponse], error) {
+	req *connect.Request[v1.ResolveOutlineCommentRequest],
+) (*connect.Response[v1.ResolveOutlineCommentResponse], error) {
 	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
 	if !ok {
 		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
@@ -491,26 +1467,26 @@ func (s *AIGenerationServiceServer) GetGeneratedLesson(
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
-	lessonID, err := parseUUID(req.Msg.LessonId)
+	commentID, err := parseUUID(req.Msg.CommentId)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	lesson, err := s.aiService.GetGeneratedLesson(ctx, kratosID, lessonID)
+	comment, err := s.aiService.ResolveOutlineComment(ctx, kratosID, commentID)
 	if err != nil {
 		return nil, toConnectError(err)
 	}
 
-	return connect.NewResponse(&v1.GetGeneratedLessonResponse{
-		Lesson: generatedLessonToProto(lesson),
+	return connect.NewResponse(&v1.ResolveOutlineCommentResponse{
+		Comment: outlineCommentToProto(comment),
 	}), nil
 }
 
-// ListGeneratedLessons returns all generated lessons for a course.
-func (s *AIGenerationServiceServer) ListGeneratedLessons(
+// SetTenantAIEnabled pauses or resumes a tenant's AI generation. Superadmin only.
+func (s *AIGenerationServiceServer) SetTenantAIEnabled(
 	ctx context.Context,
-	req *connect.Request[v1.ListGeneratedLessonsRequest],
-) (*connect.Response[v1.ListGeneratedLessonsResponse], error) {
+	req *connect.Request[v1.SetTenantAIEnabledRequest],
+) (*connect.Response[v1.SetTenantAIEnabledResponse], error) {
 	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
 	if !ok {
 		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
@@ -520,25 +1496,42 @@ func (s *AIGenerationServiceServer) ListGeneratedLessons(
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
+	email, ok := ctx.Value(emailKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
 
-	courseID, err := parseUUID(req.Msg.CourseId)
+	tenantID, err := parseUUID(req.Msg.TenantId)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	lessons, err := s.aiService.ListGeneratedLessons(ctx, kratosID, courseID)
+	status, err := s.aiService.SetTenantAIEnabled(ctx, kratosID, email, tenantID, req.Msg.Enabled, req.Msg.Reason)
 	if err != nil {
 		return nil, toConnectError(err)
 	}
 
-	protoLessons := make([]*v1.GeneratedLesson, len(lessons))
-	for i, lesson := range lessons {
-		protoLessons[i] = generatedLessonToProto(lesson)
+	return connect.NewResponse(&v1.SetTenantAIEnabledResponse{
+		Enabled:    status.Enabled,
+		QueuedJobs: int32(status.QueuedJobs),
+	}), nil
+}
+
+// MigrateQuizSchema queues the upgrade of quiz components to the current quiz schema. Superadmin only.
+func (s *AIGenerationServiceServer) MigrateQuizSchema(
+	ctx context.Context,
+	req *connect.Request[v1.MigrateQuizSchemaRequest],
+) (*connect.Response[v1.MigrateQuizSchemaResponse], error) {
+	email, ok := ctx.Value(emailKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
 	}
 
-	return connect.NewResponse(&v1.ListGeneratedLessonsResponse{
-		Lessons: protoLessons,
-	}), nil
+	if err := s.aiService.StartQuizSchemaMigration(ctx, email); err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.MigrateQuizSchemaResponse{}), nil
 }
 
 // Helper functions for proto conversion
@@ -558,6 +1551,8 @@ func generationJobToProto(job *entity.GenerationJob) *v1.GenerationJob {
 		ResultPath:      job.ResultPath,
 		ErrorMessage:    job.ErrorMessage,
 		TokensUsed:      job.TokensUsed,
+		RepairAttempts:  job.RepairAttempts,
+		ImagesGenerated: job.ImagesGenerated,
 		RetryCount:      int32(job.RetryCount),
 		MaxRetries:      int32(job.MaxRetries),
 		CreatedByUserId: job.CreatedByUserID.String(),
@@ -590,6 +1585,20 @@ func generationJobToProto(job *entity.GenerationJob) *v1.GenerationJob {
 	if job.CompletedAt != nil {
 		proto.CompletedAt = timestamppb.New(*job.CompletedAt)
 	}
+	if job.FailureReason != nil {
+		reason := jobFailureReasonToProto(*job.FailureReason)
+		action := jobFailureSuggestedAction(*job.FailureReason)
+		proto.FailureReason = &reason
+		proto.SuggestedAction = &action
+	}
+	if job.ChildCounts != nil {
+		proto.ChildCounts = &v1.JobChildCounts{
+			Total:      int32(job.ChildCounts.Total),
+			Completed:  int32(job.ChildCounts.Completed),
+			Failed:     int32(job.ChildCounts.Failed),
+			Processing: int32(job.ChildCounts.Processing),
+		}
+	}
 
 	return proto
 }
@@ -606,6 +1615,10 @@ func courseOutlineToProto(outline *entity.CourseOutline) *v1.CourseOutline {
 		ApprovalStatus:  outlineApprovalStatusToProto(outline.ApprovalStatus),
 		RejectionReason: outline.RejectionReason,
 		GeneratedAt:     timestamppb.New(outline.GeneratedAt),
+
+		UnresolvedCommentCount: int32(outline.UnresolvedComments),
+		GeneratedByUserId:      uuidPtrToString(outline.GeneratedByUserID),
+		EndorsedByUserId:       uuidPtrToString(outline.EndorsedByUserID),
 	}
 
 	if outline.ApprovedAt != nil {
@@ -615,6 +1628,21 @@ func courseOutlineToProto(outline *entity.CourseOutline) *v1.CourseOutline {
 		s := outline.ApprovedByUserID.String()
 		proto.ApprovedByUserId = &s
 	}
+	if outline.EndorsedAt != nil {
+		proto.EndorsedAt = timestamppb.New(*outline.EndorsedAt)
+	}
+
+	if outline.Constraints != nil {
+		proto.Constraints = &v1.OutlineConstraints{
+			MaxSections:           outline.Constraints.MaxSections,
+			MaxLessonsPerSection:  outline.Constraints.MaxLessonsPerSection,
+			TargetDurationMinutes: outline.Constraints.TargetDurationMinutes,
+		}
+	}
+
+	if outline.LessonChanges != nil {
+		proto.LessonChanges = outlineLessonChangesToProto(outline.LessonChanges)
+	}
 
 	proto.Sections = make([]*v1.OutlineSection, len(outline.Sections))
 	for i := range outline.Sections {
@@ -624,6 +1652,138 @@ func courseOutlineToProto(outline *entity.CourseOutline) *v1.CourseOutline {
 	return proto
 }
 
+func outlineCommentToProto(comment *entity.OutlineComment) *v1.OutlineComment {
+	proto := &v1.OutlineComment{
+		Id:         comment.ID.String(),
+		CourseId:   comment.CourseID.String(),
+		LessonKey:  comment.LessonKey.String(),
+		AuthorName: comment.AuthorName,
+		Body:       comment.Body,
+		Resolved:   comment.Resolved,
+		CreatedAt:  timestamppb.New(comment.CreatedAt),
+	}
+	if comment.AuthorUserID != nil {
+		s := comment.AuthorUserID.String()
+		proto.AuthorUserId = &s
+	}
+	if comment.ResolvedByUserID != nil {
+		s := comment.ResolvedByUserID.String()
+		proto.ResolvedByUserId = &s
+	}
+	if comment.ResolvedAt != nil {
+		proto.ResolvedAt = timestamppb.New(*comment.ResolvedAt)
+	}
+	return proto
+}
+
+func outlineLessonChangesToProto(changes *entity.OutlineLessonChanges) *v1.OutlineLessonChanges {
+	convert := func(list []entity.OutlineLessonChange) []*v1.OutlineLessonChange {
+		out := make([]*v1.OutlineLessonChange, len(list))
+		for i, c := range list {
+			out[i] = &v1.OutlineLessonChange{
+				LessonKey: c.LessonKey.String(),
+				Title:     c.Title,
+			}
+			if c.PreviousTitle != "" {
+				previousTitle := c.PreviousTitle
+				out[i].PreviousTitle = &previousTitle
+			}
+		}
+		return out
+	}
+
+	return &v1.OutlineLessonChanges{
+		PreviousOutlineId: changes.PreviousOutlineID.String(),
+		Kept:              convert(changes.Kept),
+		Added:             convert(changes.Added),
+		Removed:           convert(changes.Removed),
+	}
+}
+
+func outlineConstraintsFromProto(c *v1.OutlineConstraints) entity.OutlineConstraints {
+	if c == nil {
+		return entity.OutlineConstraints{}
+	}
+	return entity.OutlineConstraints{
+		MaxSections:           c.MaxSections,
+		MaxLessonsPerSection:  c.MaxLessonsPerSection,
+		TargetDurationMinutes: c.TargetDurationMinutes,
+	}
+}
+
+func generationDraftToProto(draft *entity.GenerationDraft) *v1.GenerationDraft {
+	input := &v1.CourseGenerationInput{
+		CourseId:          draft.CourseID.String(),
+		SmeIds:            uuidsToStrings(draft.SMEIDs),
+		TargetAudienceIds: uuidsToStrings(draft.TargetAudienceIDs),
+		DesiredOutcome:    draft.DesiredOutcome,
+		AdditionalContext: draft.AdditionalContext,
+	}
+	if !draft.Constraints.IsEmpty() {
+		input.Constraints = &v1.OutlineConstraints{
+			MaxSections:           draft.Constraints.MaxSections,
+			MaxLessonsPerSection:  draft.Constraints.MaxLessonsPerSection,
+			TargetDurationMinutes: draft.Constraints.TargetDurationMinutes,
+		}
+	}
+	return &v1.GenerationDraft{
+		Input:     input,
+		UpdatedAt: timestamppb.New(draft.UpdatedAt),
+	}
+}
+
+// generationPreferencesFromProto returns nil when no preferences were sent.
+func generationPreferencesFromProto(p *v1.GenerationPreferences) *entity.GenerationPreferences {
+	if p == nil {
+		return nil
+	}
+	return &entity.GenerationPreferences{
+		EnableQuizzes:            p.EnableQuizzes,
+		QuizFrequency:            quizFrequencyFromProto(p.QuizFrequency),
+		IncludeImages:            p.IncludeImages,
+		IncludeReflectionPrompts: p.IncludeReflectionPrompts,
+		GenerateImages:           p.GenerateImages,
+		MinComponents:            p.MinLessonComponents,
+		MaxComponents:            p.MaxLessonComponents,
+	}
+}
+
+func generationPreferencesToProto(p entity.GenerationPreferences) *v1.GenerationPreferences {
+	return &v1.GenerationPreferences{
+		EnableQuizzes:            p.EnableQuizzes,
+		QuizFrequency:            quizFrequencyToProto(p.QuizFrequency),
+		IncludeImages:            p.IncludeImages,
+		IncludeReflectionPrompts: p.IncludeReflectionPrompts,
+		GenerateImages:           p.GenerateImages,
+		MinLessonComponents:      p.MinComponents,
+		MaxLessonComponents:      p.MaxComponents,
+	}
+}
+
+func quizFrequencyFromProto(f v1.QuizFrequency) valueobject.QuizFrequency {
+	switch f {
+	case v1.QuizFrequency_QUIZ_FREQUENCY_END_OF_SECTION:
+		return valueobject.QuizFrequencyEndOfSection
+	case v1.QuizFrequency_QUIZ_FREQUENCY_END_OF_COURSE:
+		return valueobject.QuizFrequencyEndOfCourse
+	default:
+		return valueobject.QuizFrequencyEveryLesson
+	}
+}
+
+func quizFrequencyToProto(f valueobject.QuizFrequency) v1.QuizFrequency {
+	switch f {
+	case valueobject.QuizFrequencyEveryLesson:
+		return v1.QuizFrequency_QUIZ_FREQUENCY_EVERY_LESSON
+	case valueobject.QuizFrequencyEndOfSection:
+		return v1.QuizFrequency_QUIZ_FREQUENCY_END_OF_SECTION
+	case valueobject.QuizFrequencyEndOfCourse:
+		return v1.QuizFrequency_QUIZ_FREQUENCY_END_OF_COURSE
+	default:
+		return v1.QuizFrequency_QUIZ_FREQUENCY_UNSPECIFIED
+	}
+}
+
 func outlineSectionToProto(section *entity.OutlineSection) *v1.OutlineSection {
 	if section == nil {
 		return nil
@@ -663,6 +1823,86 @@ func outlineLessonToProto(lesson *entity.OutlineLesson) *v1.OutlineLesson {
 		LearningObjectives:       lesson.LearningObjectives,
 		IsLastInSection:          lesson.IsLastInSection,
 		IsLastInCourse:           lesson.IsLastInCourse,
+		TargetAudiences:          lesson.TargetAudiences,
+		LessonKey:                lesson.LessonKey.String(),
+	}
+}
+
+func knowledgeCoverageToProto(coverage *service.KnowledgeCoverage) *v1.KnowledgeCoverage {
+	if coverage == nil {
+		return nil
+	}
+
+	terms := make([]*v1.TermCoverage, len(coverage.Terms))
+	for i, term := range coverage.Terms {
+		terms[i] = &v1.TermCoverage{
+			Term:       term.Term,
+			ChunkCount: int32(term.ChunkCount),
+		}
+	}
+
+	return &v1.KnowledgeCoverage{
+		Score:      coverage.Score,
+		Sufficient: coverage.Sufficient,
+		ChunkCount: int32(coverage.ChunkCount),
+		Terms:      terms,
+		ThinTopics: coverage.ThinTopics,
+		Message:    coverage.Message,
+	}
+}
+
+func coursePlayerViewToProto(view *service.CoursePlayerView) *v1.CoursePlayerView {
+	sections := make([]*v1.CoursePlayerSection, len(view.Sections))
+	for i, section := range view.Sections {
+		lessons := make([]*v1.CoursePlayerLesson, len(section.Lessons))
+		for j, lesson := range section.Lessons {
+			components := make([]*v1.CoursePlayerComponent, len(lesson.Components))
+			for k, comp := range lesson.Components {
+				components[k] = &v1.CoursePlayerComponent{
+					Id:          comp.ID.String(),
+					Type:        lessonComponentTypeToProto(comp.Type),
+					Order:       comp.Position,
+					ContentJson: string(comp.ContentJSON),
+				}
+			}
+			lessons[j] = &v1.CoursePlayerLesson{
+				Id:                       lesson.ID.String(),
+				Title:                    lesson.Title,
+				EstimatedDurationMinutes: lesson.EstimatedDurationMinutes,
+				Components:               components,
+				SegueText:                lesson.SegueText,
+				PreviousLessonId:         uuidPtrToString(lesson.PreviousLessonID),
+				NextLessonId:             uuidPtrToString(lesson.NextLessonID),
+			}
+		}
+		sections[i] = &v1.CoursePlayerSection{
+			Id:          section.ID.String(),
+			Title:       section.Title,
+			Description: section.Description,
+			Lessons:     lessons,
+		}
+	}
+
+	return &v1.CoursePlayerView{
+		CourseId:         view.CourseID.String(),
+		Title:            view.Title,
+		OutlineVersion:   view.OutlineVersion,
+		LessonCount:      int32(view.LessonCount),
+		Sections:         sections,
+		PublishedVersion: view.PublishedVersion,
+	}
+}
+
+func contentStatsToProto(stats entity.ContentStats) *v1.ContentStats {
+	return &v1.ContentStats{
+		LessonCount:             int32(stats.LessonCount),
+		WordCount:               int32(stats.WordCount),
+		AverageWordsPerLesson:   stats.AverageWordsPerLesson(),
+		EstimatedReadingMinutes: int32(stats.ReadingMinutes()),
+		QuizCount:               int32(stats.QuizCount),
+		ImageCount:              int32(stats.ImageCount),
+		VideoCount:              int32(stats.VideoCount),
+		MalformedComponents:     int32(stats.MalformedComponents),
 	}
 }
 
@@ -672,13 +1912,20 @@ func generatedLessonToProto(lesson *entity.GeneratedLesson) *v1.GeneratedLesson
 	}
 
 	proto := &v1.GeneratedLesson{
-		Id:              lesson.ID.String(),
-		CourseId:        lesson.CourseID.String(),
-		SectionId:       lesson.SectionID.String(),
-		OutlineLessonId: lesson.OutlineLessonID.String(),
-		Title:           lesson.Title,
-		SegueText:       lesson.SegueText,
-		GeneratedAt:     timestamppb.New(lesson.GeneratedAt),
+		Id:             lesson.ID.String(),
+		CourseId:       lesson.CourseID.String(),
+		SectionId:      lesson.SectionID.String(),
+		Title:          lesson.Title,
+		SegueText:      lesson.SegueText,
+		ComponentCount: lesson.ComponentCount,
+		GeneratedAt:    timestamppb.New(lesson.GeneratedAt),
+	}
+	if lesson.OutlineLessonID != uuid.Nil {
+		proto.OutlineLessonId = lesson.OutlineLessonID.String()
+	}
+
+	if lesson.OrphanedAt != nil {
+		proto.OrphanedAt = timestamppb.New(*lesson.OrphanedAt)
 	}
 
 	proto.Components = make([]*v1.LessonComponent, len(lesson.Components))
@@ -732,6 +1979,16 @@ func generationJobTypeToProto(t valueobject.GenerationJobType) v1.GenerationJobT
 		return v1.GenerationJobType_GENERATION_JOB_TYPE_LESSON_CONTENT
 	case valueobject.GenerationJobTypeComponentRegen:
 		return v1.GenerationJobType_GENERATION_JOB_TYPE_COMPONENT_REGEN
+	case valueobject.GenerationJobTypeLessonsExport:
+		return v1.GenerationJobType_GENERATION_JOB_TYPE_LESSONS_EXPORT
+	case valueobject.GenerationJobTypeSMEKnowledgeExport:
+		return v1.GenerationJobType_GENERATION_JOB_TYPE_SME_KNOWLEDGE_EXPORT
+	case valueobject.GenerationJobTypeSMEKnowledgeImport:
+		return v1.GenerationJobType_GENERATION_JOB_TYPE_SME_KNOWLEDGE_IMPORT
+	case valueobject.GenerationJobTypeStorageAudit:
+		return v1.GenerationJobType_GENERATION_JOB_TYPE_STORAGE_AUDIT
+	case valueobject.GenerationJobTypeCourseTranslation:
+		return v1.GenerationJobType_GENERATION_JOB_TYPE_COURSE_TRANSLATION
 	default:
 		return v1.GenerationJobType_GENERATION_JOB_TYPE_UNSPECIFIED
 	}
@@ -747,6 +2004,16 @@ func protoToGenerationJobType(t v1.GenerationJobType) valueobject.GenerationJobT
 		return valueobject.GenerationJobTypeLessonContent
 	case v1.GenerationJobType_GENERATION_JOB_TYPE_COMPONENT_REGEN:
 		return valueobject.GenerationJobTypeComponentRegen
+	case v1.GenerationJobType_GENERATION_JOB_TYPE_LESSONS_EXPORT:
+		return valueobject.GenerationJobTypeLessonsExport
+	case v1.GenerationJobType_GENERATION_JOB_TYPE_SME_KNOWLEDGE_EXPORT:
+		return valueobject.GenerationJobTypeSMEKnowledgeExport
+	case v1.GenerationJobType_GENERATION_JOB_TYPE_SME_KNOWLEDGE_IMPORT:
+		return valueobject.GenerationJobTypeSMEKnowledgeImport
+	case v1.GenerationJobType_GENERATION_JOB_TYPE_STORAGE_AUDIT:
+		return valueobject.GenerationJobTypeStorageAudit
+	case v1.GenerationJobType_GENERATION_JOB_TYPE_COURSE_TRANSLATION:
+		return valueobject.GenerationJobTypeCourseTranslation
 	default:
 		return valueobject.GenerationJobTypeSMEIngestion
 	}
@@ -786,6 +2053,135 @@ func protoToGenerationJobStatus(s v1.GenerationJobStatus) valueobject.Generation
 	}
 }
 
+func jobAnomalyTypeToProto(t valueobject.JobAnomalyType) v1.JobAnomalyType {
+	switch t {
+	case valueobject.JobAnomalyParentNotFinalized:
+		return v1.JobAnomalyType_JOB_ANOMALY_TYPE_PARENT_NOT_FINALIZED
+	case valueobject.JobAnomalyParentMissingChildren:
+		return v1.JobAnomalyType_JOB_ANOMALY_TYPE_PARENT_MISSING_CHILDREN
+	case valueobject.JobAnomalyCompletedWithoutLessons:
+		return v1.JobAnomalyType_JOB_ANOMALY_TYPE_COMPLETED_WITHOUT_LESSONS
+	default:
+		return v1.JobAnomalyType_JOB_ANOMALY_TYPE_UNSPECIFIED
+	}
+}
+
+func protoToJobAnomalyType(t v1.JobAnomalyType) valueobject.JobAnomalyType {
+	switch t {
+	case v1.JobAnomalyType_JOB_ANOMALY_TYPE_PARENT_MISSING_CHILDREN:
+		return valueobject.JobAnomalyParentMissingChildren
+	case v1.JobAnomalyType_JOB_ANOMALY_TYPE_COMPLETED_WITHOUT_LESSONS:
+		return valueobject.JobAnomalyCompletedWithoutLessons
+	default:
+		return valueobject.JobAnomalyParentNotFinalized
+	}
+}
+
+// proposedOutlineSectionToProto converts a section of a balancing proposal. Added lessons
+// get an empty ID.
+func proposedOutlineSectionToProto(section service.UpdateCourseOutlineSection) *v1.OutlineSection {
+	protoSection := &v1.OutlineSection{
+		Id:          section.ID.String(),
+		Title:       section.Title,
+		Description: section.Description,
+		Order:       section.Order,
+		Lessons:     make([]*v1.OutlineLesson, len(section.Lessons)),
+	}
+	for i, lesson := range section.Lessons {
+		protoLesson := &v1.OutlineLesson{
+			Title:              lesson.Title,
+			Description:        lesson.Description,
+			Order:              lesson.Order,
+			LearningObjectives: lesson.LearningObjectives,
+			IsLastInSection:    i == len(section.Lessons)-1,
+		}
+		if lesson.ID != uuid.Nil {
+			protoLesson.Id = lesson.ID.String()
+		}
+		if lesson.EstimatedDurationMinutes != nil {
+			protoLesson.EstimatedDurationMinutes = *lesson.EstimatedDurationMinutes
+		}
+		protoSection.Lessons[i] = protoLesson
+	}
+	return protoSection
+}
+
+func outlineBalanceChangeKindToProto(k service.OutlineBalanceChangeKind) v1.OutlineBalanceChangeKind {
+	switch k {
+	case service.OutlineBalanceChangeSplit:
+		return v1.OutlineBalanceChangeKind_OUTLINE_BALANCE_CHANGE_KIND_SPLIT
+	case service.OutlineBalanceChangeMerge:
+		return v1.OutlineBalanceChangeKind_OUTLINE_BALANCE_CHANGE_KIND_MERGE
+	default:
+		return v1.OutlineBalanceChangeKind_OUTLINE_BALANCE_CHANGE_KIND_UNSPECIFIED
+	}
+}
+
+func accessibilityIssueTypeToProto(t valueobject.AccessibilityIssueType) v1.AccessibilityIssueType {
+	switch t {
+	case valueobject.AccessibilityIssueMissingAltText:
+		return v1.AccessibilityIssueType_ACCESSIBILITY_ISSUE_TYPE_MISSING_ALT_TEXT
+	case valueobject.AccessibilityIssueHeadingLevelJump:
+		return v1.AccessibilityIssueType_ACCESSIBILITY_ISSUE_TYPE_HEADING_LEVEL_JUMP
+	case valueobject.AccessibilityIssueQuizWithoutInstructions:
+		return v1.AccessibilityIssueType_ACCESSIBILITY_ISSUE_TYPE_QUIZ_WITHOUT_INSTRUCTIONS
+	default:
+		return v1.AccessibilityIssueType_ACCESSIBILITY_ISSUE_TYPE_UNSPECIFIED
+	}
+}
+
+func jobFailureReasonToProto(r valueobject.JobFailureReason) v1.JobFailureReason {
+	switch r {
+	case valueobject.JobFailureProviderAuth:
+		return v1.JobFailureReason_JOB_FAILURE_REASON_PROVIDER_AUTH
+	case valueobject.JobFailureProviderRateLimit:
+		return v1.JobFailureReason_JOB_FAILURE_REASON_PROVIDER_RATE_LIMIT
+	case valueobject.JobFailureProviderTimeout:
+		return v1.JobFailureReason_JOB_FAILURE_REASON_PROVIDER_TIMEOUT
+	case valueobject.JobFailureInvalidOutput:
+		return v1.JobFailureReason_JOB_FAILURE_REASON_INVALID_OUTPUT
+	case valueobject.JobFailureMissingKnowledge:
+		return v1.JobFailureReason_JOB_FAILURE_REASON_MISSING_KNOWLEDGE
+	case valueobject.JobFailureBudgetExceeded:
+		return v1.JobFailureReason_JOB_FAILURE_REASON_BUDGET_EXCEEDED
+	case valueobject.JobFailureInternal:
+		return v1.JobFailureReason_JOB_FAILURE_REASON_INTERNAL
+	default:
+		return v1.JobFailureReason_JOB_FAILURE_REASON_UNSPECIFIED
+	}
+}
+
+// jobFailureSuggestedAction tells the user how to get past a failure before retrying.
+func jobFailureSuggestedAction(r valueobject.JobFailureReason) string {
+	switch r {
+	case valueobject.JobFailureProviderAuth:
+		return "Ask an admin to check the Gemini API key in Settings > AI Settings, then retry."
+	case valueobject.JobFailureProviderRateLimit:
+		return "The AI provider is throttling requests. Wait a few minutes and retry, or raise the API key's quota."
+	case valueobject.JobFailureProviderTimeout:
+		return "The AI provider took too long to respond. Retry, or reduce the course size."
+	case valueobject.JobFailureInvalidOutput:
+		return "The AI returned content that could not be used. Retry the generation."
+	case valueobject.JobFailureMissingKnowledge:
+		return "Add SME knowledge or a reference attachment to this course before generating."
+	case valueobject.JobFailureBudgetExceeded:
+		return "Your organization has used this month's token limit. An admin can raise it in Settings > AI Settings."
+	default:
+		return "Retry the job. Contact support if it keeps failing."
+	}
+}
+
+func outlineExportFormatFromProto(f v1.OutlineExportFormat) (valueobject.OutlineExportFormat, bool) {
+	switch f {
+	case v1.OutlineExportFormat_OUTLINE_EXPORT_FORMAT_CSV:
+		return valueobject.OutlineExportFormatCSV, true
+	case v1.OutlineExportFormat_OUTLINE_EXPORT_FORMAT_DOCX:
+		return valueobject.OutlineExportFormatDOCX, true
+	default:
+		return "", false
+	}
+}
+
 func outlineApprovalStatusToProto(s valueobject.OutlineApprovalStatus) v1.OutlineApprovalStatus {
 	switch s {
 	case valueobject.OutlineApprovalStatusPendingReview:
@@ -811,6 +2207,8 @@ func lessonComponentTypeToProto(t valueobject.LessonComponentType) v1.LessonComp
 		return v1.LessonComponentType_LESSON_COMPONENT_TYPE_IMAGE
 	case valueobject.LessonComponentTypeQuiz:
 		return v1.LessonComponentType_LESSON_COMPONENT_TYPE_QUIZ
+	case valueobject.LessonComponentTypeVideo:
+		return v1.LessonComponentType_LESSON_COMPONENT_TYPE_VIDEO
 	default:
 		return v1.LessonComponentType_LESSON_COMPONENT_TYPE_UNSPECIFIED
 	}
@@ -0,0 +1,149 @@
+package connect
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1 "github.com/sogos/mirai-backend/gen/mirai/v1"
+	"github.com/sogos/mirai-backend/gen/mirai/v1/miraiv1connect"
+	"github.com/sogos/mirai-backend/internal/application/service"
+	"github.com/sogos/mirai-backend/internal/domain/audit"
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+)
+
+// AuditServiceServer implements the AuditService Connect handler.
+type AuditServiceServer struct {
+	miraiv1connect.UnimplementedAuditServiceHandler
+	auditService *service.AuditService
+}
+
+// NewAuditServiceServer creates a new AuditServiceServer.
+func NewAuditServiceServer(auditService *service.AuditService) *AuditServiceServer {
+	return &AuditServiceServer{auditService: auditService}
+}
+
+// ListAuditEvents returns audit events, most recent first.
+func (s *AuditServiceServer) ListAuditEvents(
+	ctx context.Context,
+	req *connect.Request[v1.ListAuditEventsRequest],
+) (*connect.Response[v1.ListAuditEventsResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	filter, err := protoToAuditEventFilter(req.Msg.Filter)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	result, err := s.auditService.ListAuditEvents(ctx, kratosID, filter, req.Msg.GetCursor(), int(req.Msg.Limit))
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	events := make([]*v1.AuditEvent, len(result.Events))
+	for i, event := range result.Events {
+		events[i] = auditEventToProto(event)
+	}
+
+	return connect.NewResponse(&v1.ListAuditEventsResponse{
+		Events:     events,
+		NextCursor: strPtr(result.NextCursor),
+	}), nil
+}
+
+// ExportAuditEvents renders the matching audit events as CSV.
+func (s *AuditServiceServer) ExportAuditEvents(
+	ctx context.Context,
+	req *connect.Request[v1.ExportAuditEventsRequest],
+) (*connect.Response[v1.ExportAuditEventsResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	filter, err := protoToAuditEventFilter(req.Msg.Filter)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	export, err := s.auditService.ExportAuditEvents(ctx, kratosID, filter)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.ExportAuditEventsResponse{
+		Filename: export.Filename,
+		Content:  export.Content,
+	}), nil
+}
+
+func protoToAuditEventFilter(f *v1.AuditEventFilter) (service.AuditEventFilter, error) {
+	var filter service.AuditEventFilter
+	if f == nil {
+		return filter, nil
+	}
+
+	if f.Action != nil {
+		action := audit.Action(*f.Action)
+		filter.Action = &action
+	}
+	if f.ActorUserId != nil {
+		actorID, err := parseUUID(*f.ActorUserId)
+		if err != nil {
+			return filter, err
+		}
+		filter.ActorUserID = &actorID
+	}
+	if f.TargetType != nil {
+		targetType := audit.TargetType(*f.TargetType)
+		filter.TargetType = &targetType
+	}
+	filter.TargetID = f.TargetId
+	if f.Since != nil {
+		since := f.Since.AsTime()
+		filter.Since = &since
+	}
+	if f.Until != nil {
+		until := f.Until.AsTime()
+		filter.Until = &until
+	}
+	return filter, nil
+}
+
+func auditEventToProto(event *entity.AuditEvent) *v1.AuditEvent {
+	changes := make([]*v1.AuditChange, len(event.Changes))
+	for i, c := range event.Changes {
+		changes[i] = &v1.AuditChange{
+			Field:     c.Field,
+			Before:    strPtr(c.Before),
+			After:     strPtr(c.After),
+			Sensitive: c.Sensitive,
+		}
+	}
+
+	return &v1.AuditEvent{
+		Id:          event.ID.String(),
+		ActorUserId: uuidPtrToString(event.ActorUserID),
+		Action:      string(event.Action),
+		TargetType:  string(event.TargetType),
+		TargetId:    event.TargetID,
+		Changes:     changes,
+		IpAddress:   event.IPAddress,
+		CreatedAt:   timestamppb.New(event.CreatedAt),
+		ApiTokenId:  uuidPtrToString(event.APITokenID),
+	}
+}
@@ -50,15 +50,16 @@ func (s *AuthServiceServer) Register(
 ) (*connect.Response[v1.RegisterResponse], error) {
 	// Convert proto request to DTO
 	dtoReq := dto.RegisterRequest{
-		Email:       req.Msg.Email,
-		Password:    req.Msg.Password,
-		FirstName:   req.Msg.FirstName,
-		LastName:    req.Msg.LastName,
-		CompanyName: req.Msg.CompanyName,
-		Industry:    derefString(req.Msg.Industry),
-		TeamSize:    derefString(req.Msg.TeamSize),
-		Plan:        planFromProto(req.Msg.Plan),
-		SeatCount:   int(req.Msg.GetSeatCount()),
+		Email:         req.Msg.Email,
+		Password:      req.Msg.Password,
+		FirstName:     req.Msg.FirstName,
+		LastName:      req.Msg.LastName,
+		CompanyName:   req.Msg.CompanyName,
+		Industry:      derefString(req.Msg.Industry),
+		TeamSize:      derefString(req.Msg.TeamSize),
+		Plan:          planFromProto(req.Msg.Plan),
+		SeatCount:     int(req.Msg.GetSeatCount()),
+		StorageRegion: req.Msg.GetStorageRegion(),
 	}
 
 	result, err := s.authService.Register(ctx, dtoReq)
@@ -136,6 +137,20 @@ func (s *AuthServiceServer) EnterpriseContact(
 	}), nil
 }
 
+// ListStorageRegions lists the storage regions a new organization can choose.
+// This is a public endpoint - no authentication required.
+func (s *AuthServiceServer) ListStorageRegions(
+	ctx context.Context,
+	req *connect.Request[v1.ListStorageRegionsRequest],
+) (*connect.Response[v1.ListStorageRegionsResponse], error) {
+	regions := s.authService.ListStorageRegions()
+	resp := &v1.ListStorageRegionsResponse{Regions: regions}
+	if len(regions) > 0 {
+		resp.DefaultRegion = regions[0]
+	}
+	return connect.NewResponse(resp), nil
+}
+
 // RegisterWithInvitation creates a new user account for an invited user.
 // This is a public endpoint - no authentication required.
 func (s *AuthServiceServer) RegisterWithInvitation(
@@ -178,7 +193,7 @@ func userToProto(u *dto.UserResponse) *v1.User {
 	if u == nil {
 		return nil
 	}
-	return &v1.User{
+	proto := &v1.User{
 		Id:        u.ID.String(),
 		KratosId:  u.KratosID.String(),
 		CompanyId: uuidPtrToString(u.CompanyID),
@@ -188,7 +203,16 @@ func userToProto(u *dto.UserResponse) *v1.User {
 		Email:     strPtr(u.Email),
 		FirstName: strPtr(u.FirstName),
 		LastName:  strPtr(u.LastName),
+		Locale:    strPtr(u.Locale),
+		IsActive:  u.IsActive,
+
+		BouncedEmail:        u.BouncedEmail,
+		WeeklySummaryOptOut: u.WeeklySummaryOptOut,
+	}
+	if u.EmailBouncedAt != nil {
+		proto.EmailBouncedAt = timestamppb.New(*u.EmailBouncedAt)
 	}
+	return proto
 }
 
 func companyToProto(c *dto.CompanyResponse) *v1.Company {
@@ -10,6 +10,7 @@ import (
 	"github.com/sogos/mirai-backend/gen/mirai/v1/miraiv1connect"
 	"github.com/sogos/mirai-backend/internal/application/service"
 	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
 )
 
 // CourseServiceServer implements the CourseService Connect handler.
@@ -53,6 +54,7 @@ func (s *CourseServiceServer) ListCourses(
 	if len(req.Msg.Tags) > 0 {
 		filter.Tags = req.Msg.Tags
 	}
+	filter.Mine = req.Msg.GetMine()
 
 	result, err := s.courseService.ListCourses(ctx, kratosID, filter)
 	if err != nil {
@@ -91,9 +93,20 @@ func (s *CourseServiceServer) GetCourse(
 		return nil, toConnectError(err)
 	}
 
-	return connect.NewResponse(&v1.GetCourseResponse{
+	resp := &v1.GetCourseResponse{
 		Course: storedCourseToProto(course),
-	}), nil
+	}
+	if course.ActiveJobID != "" {
+		resp.ActiveGenerationJobId = &course.ActiveJobID
+	}
+	if lock := course.GenerationLock; lock != nil {
+		resp.GenerationLock = &v1.CourseGenerationLock{
+			JobId:    lock.JobID.String(),
+			LockedAt: timestamppb.New(lock.LockedAt),
+		}
+	}
+
+	return connect.NewResponse(resp), nil
 }
 
 // CreateCourse creates a new course.
@@ -130,7 +143,8 @@ func (s *CourseServiceServer) CreateCourse(
 	}
 
 	return connect.NewResponse(&v1.CreateCourseResponse{
-		Course: storedCourseToProto(course),
+		Course:          storedCourseToProto(course),
+		DefaultedFields: course.DefaultedFields,
 	}), nil
 }
 
@@ -166,9 +180,15 @@ func (s *CourseServiceServer) UpdateCourse(
 		return nil, toConnectError(err)
 	}
 
-	return connect.NewResponse(&v1.UpdateCourseResponse{
-		Course: storedCourseToProto(course),
-	}), nil
+	resp := &v1.UpdateCourseResponse{
+		Course:           storedCourseToProto(course),
+		ContentSizeBytes: course.ContentSizeBytes,
+	}
+	if course.SizeWarning != "" {
+		resp.SizeWarning = &course.SizeWarning
+	}
+
+	return connect.NewResponse(resp), nil
 }
 
 // DeleteCourse deletes a course by ID.
@@ -272,7 +292,7 @@ func (s *CourseServiceServer) CreateFolder(
 		parentID = req.Msg.ParentId
 	}
 
-	folder, err := s.courseService.CreateFolder(ctx, kratosID, req.Msg.Name, parentID, folderType)
+	folder, err := s.courseService.CreateFolder(ctx, kratosID, req.Msg.Name, parentID, folderType, req.Msg.TeamId)
 	if err != nil {
 		return nil, toConnectError(err)
 	}
@@ -282,6 +302,40 @@ func (s *CourseServiceServer) CreateFolder(
 	}), nil
 }
 
+// UpdateFolder renames a folder or changes it between regular and team folders.
+func (s *CourseServiceServer) UpdateFolder(
+	ctx context.Context,
+	req *connect.Request[v1.UpdateFolderRequest],
+) (*connect.Response[v1.UpdateFolderResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	updateReq := service.UpdateFolderRequest{
+		Name:   req.Msg.Name,
+		TeamID: req.Msg.TeamId,
+	}
+	if req.Msg.Type != nil {
+		folderType := folderTypeFromProto(*req.Msg.Type)
+		updateReq.Type = &folderType
+	}
+
+	folder, err := s.courseService.UpdateFolder(ctx, kratosID, req.Msg.Id, updateReq)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.UpdateFolderResponse{
+		Folder: entityFolderToProto(folder),
+	}), nil
+}
+
 // DeleteFolder deletes an empty folder from the library.
 func (s *CourseServiceServer) DeleteFolder(
 	ctx context.Context,
@@ -306,6 +360,581 @@ func (s *CourseServiceServer) DeleteFolder(
 	}), nil
 }
 
+// ListCollaborators returns the users assigned to a course.
+func (s *CourseServiceServer) ListCollaborators(
+	ctx context.Context,
+	req *connect.Request[v1.ListCollaboratorsRequest],
+) (*connect.Response[v1.ListCollaboratorsResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	courseID, err := parseUUID(req.Msg.CourseId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	collaborators, err := s.courseService.ListCollaborators(ctx, kratosID, courseID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	resp := &v1.ListCollaboratorsResponse{
+		Collaborators: make([]*v1.CourseCollaborator, len(collaborators)),
+	}
+	for i, c := range collaborators {
+		resp.Collaborators[i] = collaboratorToProto(c)
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// AddCollaborator assigns a user to a course with a role.
+func (s *CourseServiceServer) AddCollaborator(
+	ctx context.Context,
+	req *connect.Request[v1.AddCollaboratorRequest],
+) (*connect.Response[v1.AddCollaboratorResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	courseID, err := parseUUID(req.Msg.CourseId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	userID, err := parseUUID(req.Msg.UserId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	collaborator, err := s.courseService.AddCollaborator(ctx, kratosID, courseID, userID, courseRoleFromProto(req.Msg.Role))
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.AddCollaboratorResponse{
+		Collaborator: collaboratorToProto(collaborator),
+	}), nil
+}
+
+// RemoveCollaborator removes a user's assignment from a course.
+func (s *CourseServiceServer) RemoveCollaborator(
+	ctx context.Context,
+	req *connect.Request[v1.RemoveCollaboratorRequest],
+) (*connect.Response[v1.RemoveCollaboratorResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	courseID, err := parseUUID(req.Msg.CourseId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	userID, err := parseUUID(req.Msg.UserId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := s.courseService.RemoveCollaborator(ctx, kratosID, courseID, userID); err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.RemoveCollaboratorResponse{}), nil
+}
+
+// RemoveSampleContent deletes the tenant's onboarding sample content.
+func (s *CourseServiceServer) RemoveSampleContent(
+	ctx context.Context,
+	req *connect.Request[v1.RemoveSampleContentRequest],
+) (*connect.Response[v1.RemoveSampleContentResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	result, err := s.courseService.RemoveSampleContent(ctx, kratosID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.RemoveSampleContentResponse{
+		CoursesRemoved:         int32(result.CoursesRemoved),
+		FoldersRemoved:         int32(result.FoldersRemoved),
+		FoldersKept:            int32(result.FoldersKept),
+		TargetAudiencesRemoved: int32(result.TargetAudiencesRemoved),
+	}), nil
+}
+
+// ListLargestCourses returns the organization's largest courses by stored content size.
+func (s *CourseServiceServer) ListLargestCourses(
+	ctx context.Context,
+	req *connect.Request[v1.ListLargestCoursesRequest],
+) (*connect.Response[v1.ListLargestCoursesResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	report, err := s.courseService.ListLargestCourses(ctx, kratosID, int(req.Msg.Limit))
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	courses := make([]*v1.CourseSize, 0, len(report.Courses))
+	for _, c := range report.Courses {
+		size := &v1.CourseSize{
+			CourseId:   c.ID.String(),
+			Title:      c.Title,
+			ModifiedAt: timestamppb.New(c.UpdatedAt),
+		}
+		if c.ContentSizeBytes != nil {
+			size.ContentSizeBytes = *c.ContentSizeBytes
+		}
+		courses = append(courses, size)
+	}
+
+	return connect.NewResponse(&v1.ListLargestCoursesResponse{
+		Courses:        courses,
+		SoftLimitBytes: int64(report.SoftLimitBytes),
+		HardLimitBytes: int64(report.HardLimitBytes),
+	}), nil
+}
+
+// PublishChanges makes a published course's edits the version learners see.
+func (s *CourseServiceServer) PublishChanges(
+	ctx context.Context,
+	req *connect.Request[v1.PublishChangesRequest],
+) (*connect.Response[v1.PublishChangesResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	course, err := s.courseService.PublishChanges(ctx, kratosID, req.Msg.CourseId)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.PublishChangesResponse{
+		Course: storedCourseToProto(course),
+	}), nil
+}
+
+// DiscardDraft reverts a published course's edits to the version learners see.
+func (s *CourseServiceServer) DiscardDraft(
+	ctx context.Context,
+	req *connect.Request[v1.DiscardDraftRequest],
+) (*connect.Response[v1.DiscardDraftResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	course, restored, err := s.courseService.DiscardDraft(ctx, kratosID, req.Msg.CourseId)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.DiscardDraftResponse{
+		Course:          storedCourseToProto(course),
+		LessonsRestored: int32(restored),
+	}), nil
+}
+
+// SearchWithinCourse finds the blocks and lesson components of a course containing a phrase.
+func (s *CourseServiceServer) SearchWithinCourse(
+	ctx context.Context,
+	req *connect.Request[v1.SearchWithinCourseRequest],
+) (*connect.Response[v1.SearchWithinCourseResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	courseID, err := parseUUID(req.Msg.CourseId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	result, err := s.courseService.SearchWithinCourse(ctx, kratosID, courseID, req.Msg.Query)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	matches := make([]*v1.CourseSearchMatch, len(result.Matches))
+	for i, m := range result.Matches {
+		matches[i] = &v1.CourseSearchMatch{
+			Source:          courseSearchSourceToProto(m.Source),
+			SectionId:       m.SectionID,
+			SectionTitle:    m.SectionTitle,
+			LessonId:        m.LessonID,
+			LessonTitle:     m.LessonTitle,
+			BlockId:         m.BlockID,
+			ComponentId:     m.ComponentID,
+			Snippet:         m.Snippet,
+			HighlightStart:  int32(m.HighlightStart),
+			HighlightLength: int32(m.HighlightLength),
+		}
+	}
+
+	return connect.NewResponse(&v1.SearchWithinCourseResponse{
+		Matches:   matches,
+		Truncated: result.Truncated,
+	}), nil
+}
+
+// GetCourseAttachmentUploadURL returns a presigned URL for uploading a reference file.
+func (s *CourseServiceServer) GetCourseAttachmentUploadURL(
+	ctx context.Context,
+	req *connect.Request[v1.GetCourseAttachmentUploadURLRequest],
+) (*connect.Response[v1.GetCourseAttachmentUploadURLResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	courseID, err := parseUUID(req.Msg.CourseId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	uploadURL, filePath, err := s.courseService.GetCourseAttachmentUploadURL(ctx, kratosID, service.GetCourseAttachmentUploadRequest{
+		CourseID:    courseID,
+		FileName:    req.Msg.FileName,
+		ContentType: req.Msg.ContentType,
+		SizeBytes:   req.Msg.SizeBytes,
+	})
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.GetCourseAttachmentUploadURLResponse{
+		UploadUrl: uploadURL,
+		FilePath:  filePath,
+	}), nil
+}
+
+// ConfirmCourseAttachment records an uploaded reference file and queues its extraction.
+func (s *CourseServiceServer) ConfirmCourseAttachment(
+	ctx context.Context,
+	req *connect.Request[v1.ConfirmCourseAttachmentRequest],
+) (*connect.Response[v1.ConfirmCourseAttachmentResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	courseID, err := parseUUID(req.Msg.CourseId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	attachment, err := s.courseService.ConfirmCourseAttachment(ctx, kratosID, courseID, req.Msg.FilePath, req.Msg.ContentType)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.ConfirmCourseAttachmentResponse{
+		Attachment: courseAttachmentToProto(attachment),
+	}), nil
+}
+
+// ListCourseAttachments lists a course's reference files.
+func (s *CourseServiceServer) ListCourseAttachments(
+	ctx context.Context,
+	req *connect.Request[v1.ListCourseAttachmentsRequest],
+) (*connect.Response[v1.ListCourseAttachmentsResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	courseID, err := parseUUID(req.Msg.CourseId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	attachments, err := s.courseService.ListCourseAttachments(ctx, kratosID, courseID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	protoAttachments := make([]*v1.CourseAttachment, len(attachments))
+	for i, a := range attachments {
+		protoAttachments[i] = courseAttachmentToProto(a)
+	}
+
+	return connect.NewResponse(&v1.ListCourseAttachmentsResponse{
+		Attachments: protoAttachments,
+	}), nil
+}
+
+// SetCourseAttachmentExcluded includes a reference file in generation prompts or leaves it out.
+func (s *CourseServiceServer) SetCourseAttachmentExcluded(
+	ctx context.Context,
+	req *connect.Request[v1.SetCourseAttachmentExcludedRequest],
+) (*connect.Response[v1.SetCourseAttachmentExcludedResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	attachmentID, err := parseUUID(req.Msg.AttachmentId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	attachment, err := s.courseService.SetCourseAttachmentExcluded(ctx, kratosID, attachmentID, req.Msg.Excluded)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.SetCourseAttachmentExcludedResponse{
+		Attachment: courseAttachmentToProto(attachment),
+	}), nil
+}
+
+// DeleteCourseAttachment removes a reference file and its extracted text.
+func (s *CourseServiceServer) DeleteCourseAttachment(
+	ctx context.Context,
+	req *connect.Request[v1.DeleteCourseAttachmentRequest],
+) (*connect.Response[v1.DeleteCourseAttachmentResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	attachmentID, err := parseUUID(req.Msg.AttachmentId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := s.courseService.DeleteCourseAttachment(ctx, kratosID, attachmentID); err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.DeleteCourseAttachmentResponse{}), nil
+}
+
+// ListCourseCards returns a page of lightweight course cards.
+func (s *CourseServiceServer) ListCourseCards(
+	ctx context.Context,
+	req *connect.Request[v1.ListCourseCardsRequest],
+) (*connect.Response[v1.ListCourseCardsResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	filter := service.ListCourseCardsFilter{
+		Limit:  int(req.Msg.Limit),
+		Folder: req.Msg.Folder,
+		Cursor: req.Msg.Cursor,
+	}
+	if req.Msg.Status != nil && *req.Msg.Status != v1.CourseStatus_COURSE_STATUS_UNSPECIFIED {
+		status := courseStatusFromProto(*req.Msg.Status)
+		filter.Status = &status
+	}
+
+	result, err := s.courseService.ListCourseCards(ctx, kratosID, filter)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	resp := &v1.ListCourseCardsResponse{
+		Cards: make([]*v1.CourseCard, len(result.Cards)),
+	}
+	for i, c := range result.Cards {
+		resp.Cards[i] = &v1.CourseCard{
+			Id:         c.ID.String(),
+			Title:      c.Title,
+			Status:     courseStatusToProto(service.CourseStatus(c.Status.String())),
+			ModifiedAt: timestamppb.New(c.UpdatedAt),
+		}
+	}
+	if result.NextCursor != "" {
+		resp.NextCursor = &result.NextCursor
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// GetCourseCardDetails returns the details of the course cards on screen.
+func (s *CourseServiceServer) GetCourseCardDetails(
+	ctx context.Context,
+	req *connect.Request[v1.GetCourseCardDetailsRequest],
+) (*connect.Response[v1.GetCourseCardDetailsResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	details, err := s.courseService.GetCourseCardDetails(ctx, kratosID, req.Msg.CourseIds)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	resp := &v1.GetCourseCardDetailsResponse{
+		Details: make([]*v1.CourseCardDetails, len(details)),
+	}
+	for i, d := range details {
+		card := &v1.CourseCardDetails{
+			CourseId:        d.CourseID,
+			Tags:            d.Tags,
+			CreatedBy:       d.CreatedBy,
+			CreatedByActive: d.CreatedByActive,
+			DurationMinutes: int32(d.DurationMinutes),
+		}
+		if d.ThumbnailURL != "" {
+			card.ThumbnailUrl = &d.ThumbnailURL
+		}
+		if d.CreatedByName != "" {
+			card.CreatedByName = &d.CreatedByName
+		}
+		resp.Details[i] = card
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// ListExports returns a course's exports with when each file expires.
+func (s *CourseServiceServer) ListExports(
+	ctx context.Context,
+	req *connect.Request[v1.ListExportsRequest],
+) (*connect.Response[v1.ListExportsResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	courseID, err := parseUUID(req.Msg.CourseId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	artifacts, err := s.courseService.ListExports(ctx, kratosID, courseID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	exports := make([]*v1.CourseExport, len(artifacts))
+	for i, a := range artifacts {
+		exports[i] = exportArtifactToProto(a)
+	}
+
+	return connect.NewResponse(&v1.ListExportsResponse{
+		Exports: exports,
+	}), nil
+}
+
+// DownloadExport returns a presigned URL for a course export's file.
+func (s *CourseServiceServer) DownloadExport(
+	ctx context.Context,
+	req *connect.Request[v1.DownloadExportRequest],
+) (*connect.Response[v1.DownloadExportResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	exportID, err := parseUUID(req.Msg.ExportId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	url, expiresAt, err := s.courseService.DownloadExport(ctx, kratosID, exportID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.DownloadExportResponse{
+		DownloadUrl: url,
+		ExpiresAt:   timestamppb.New(expiresAt),
+	}), nil
+}
+
 // Conversion helpers
 
 func courseStatusToProto(s service.CourseStatus) v1.CourseStatus {
@@ -321,6 +950,152 @@ func courseStatusToProto(s service.CourseStatus) v1.CourseStatus {
 	}
 }
 
+func courseSearchSourceToProto(s service.CourseSearchSource) v1.CourseSearchSource {
+	switch s {
+	case service.CourseSearchSourceAuthoring:
+		return v1.CourseSearchSource_COURSE_SEARCH_SOURCE_AUTHORING
+	case service.CourseSearchSourceLessonComponent:
+		return v1.CourseSearchSource_COURSE_SEARCH_SOURCE_LESSON_COMPONENT
+	default:
+		return v1.CourseSearchSource_COURSE_SEARCH_SOURCE_UNSPECIFIED
+	}
+}
+
+func courseAttachmentStatusToProto(s entity.CourseAttachmentStatus) v1.CourseAttachmentStatus {
+	switch s {
+	case entity.CourseAttachmentStatusPending:
+		return v1.CourseAttachmentStatus_COURSE_ATTACHMENT_STATUS_PENDING
+	case entity.CourseAttachmentStatusProcessing:
+		return v1.CourseAttachmentStatus_COURSE_ATTACHMENT_STATUS_PROCESSING
+	case entity.CourseAttachmentStatusReady:
+		return v1.CourseAttachmentStatus_COURSE_ATTACHMENT_STATUS_READY
+	case entity.CourseAttachmentStatusFailed:
+		return v1.CourseAttachmentStatus_COURSE_ATTACHMENT_STATUS_FAILED
+	default:
+		return v1.CourseAttachmentStatus_COURSE_ATTACHMENT_STATUS_UNSPECIFIED
+	}
+}
+
+func courseAttachmentToProto(a *entity.CourseAttachment) *v1.CourseAttachment {
+	attachment := &v1.CourseAttachment{
+		Id:                  a.ID.String(),
+		CourseId:            a.CourseID.String(),
+		FileName:            a.FileName,
+		ContentType:         a.ContentType,
+		SizeBytes:           a.SizeBytes,
+		Status:              courseAttachmentStatusToProto(a.Status),
+		ErrorMessage:        a.ErrorMessage,
+		ChunkCount:          int32(a.ChunkCount),
+		FlaggedChunkCount:   int32(a.FlaggedChunks),
+		ExcludedFromPrompts: a.ExcludedFromPrompts,
+		CreatedAt:           timestamppb.New(a.CreatedAt),
+	}
+	if a.ProcessedAt != nil {
+		attachment.ProcessedAt = timestamppb.New(*a.ProcessedAt)
+	}
+	return attachment
+}
+
+// HeartbeatCoursePresence marks the caller as having a course open and returns who else does.
+func (s *CourseServiceServer) HeartbeatCoursePresence(
+	ctx context.Context,
+	req *connect.Request[v1.HeartbeatCoursePresenceRequest],
+) (*connect.Response[v1.HeartbeatCoursePresenceResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	courseID, err := parseUUID(req.Msg.CourseId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	others, err := s.courseService.HeartbeatCoursePresence(ctx, kratosID, courseID, req.Msg.Editing)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.HeartbeatCoursePresenceResponse{
+		Others: coursePresenceToProto(others),
+	}), nil
+}
+
+// GetCoursePresence returns who other than the caller has a course open.
+func (s *CourseServiceServer) GetCoursePresence(
+	ctx context.Context,
+	req *connect.Request[v1.GetCoursePresenceRequest],
+) (*connect.Response[v1.GetCoursePresenceResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	courseID, err := parseUUID(req.Msg.CourseId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	others, err := s.courseService.GetCoursePresence(ctx, kratosID, courseID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.GetCoursePresenceResponse{
+		Others: coursePresenceToProto(others),
+	}), nil
+}
+
+func coursePresenceToProto(present []entity.CoursePresence) []*v1.CoursePresence {
+	result := make([]*v1.CoursePresence, len(present))
+	for i, p := range present {
+		result[i] = &v1.CoursePresence{
+			UserId:      p.UserID.String(),
+			DisplayName: p.DisplayName,
+			Editing:     p.Editing,
+			LastSeenAt:  timestamppb.New(p.LastSeenAt),
+		}
+	}
+	return result
+}
+
+func exportArtifactToProto(a *entity.ExportArtifact) *v1.CourseExport {
+	export := &v1.CourseExport{
+		Id:        a.ID.String(),
+		Timestamp: timestamppb.New(a.CreatedAt),
+		FilePath:  a.FilePath,
+		Status:    v1.ExportStatus_EXPORT_STATUS_COMPLETED,
+		ExpiresAt: timestamppb.New(a.ExpiresAt),
+		SizeBytes: a.SizeBytes,
+	}
+	if a.Type == entity.ExportArtifactTypeLessons {
+		export.Format = v1.ExportFormat_EXPORT_FORMAT_MARKDOWN_ZIP
+	}
+	if a.PurgedAt != nil {
+		export.Status = v1.ExportStatus_EXPORT_STATUS_PURGED
+		export.PurgedAt = timestamppb.New(*a.PurgedAt)
+		reason := v1.ExportPurgeReason_EXPORT_PURGE_REASON_UNSPECIFIED
+		if a.PurgeReason != nil {
+			switch *a.PurgeReason {
+			case entity.ExportPurgeReasonExpired:
+				reason = v1.ExportPurgeReason_EXPORT_PURGE_REASON_EXPIRED
+			case entity.ExportPurgeReasonSuperseded:
+				reason = v1.ExportPurgeReason_EXPORT_PURGE_REASON_SUPERSEDED
+			}
+		}
+		export.PurgeReason = &reason
+	}
+	return export
+}
+
 func courseStatusFromProto(s v1.CourseStatus) service.CourseStatus {
 	switch s {
 	case v1.CourseStatus_COURSE_STATUS_DRAFT:
@@ -334,45 +1109,47 @@ func courseStatusFromProto(s v1.CourseStatus) service.CourseStatus {
 	}
 }
 
-func folderTypeToProto(t string) v1.FolderType {
+func folderTypeToProto(t entity.FolderType) v1.FolderType {
 	switch t {
-	case "library":
+	case entity.FolderTypeLibrary:
 		return v1.FolderType_FOLDER_TYPE_LIBRARY
-	case "team":
+	case entity.FolderTypeTeam:
 		return v1.FolderType_FOLDER_TYPE_TEAM
-	case "personal":
+	case entity.FolderTypePersonal:
 		return v1.FolderType_FOLDER_TYPE_PERSONAL
-	case "folder":
+	case entity.FolderTypeFolder:
 		return v1.FolderType_FOLDER_TYPE_FOLDER
 	default:
 		return v1.FolderType_FOLDER_TYPE_UNSPECIFIED
 	}
 }
 
-func folderTypeFromProto(t v1.FolderType) string {
+func folderTypeFromProto(t v1.FolderType) entity.FolderType {
 	switch t {
 	case v1.FolderType_FOLDER_TYPE_LIBRARY:
-		return "library"
+		return entity.FolderTypeLibrary
 	case v1.FolderType_FOLDER_TYPE_TEAM:
-		return "team"
+		return entity.FolderTypeTeam
 	case v1.FolderType_FOLDER_TYPE_PERSONAL:
-		return "personal"
+		return entity.FolderTypePersonal
 	case v1.FolderType_FOLDER_TYPE_FOLDER:
-		return "folder"
+		return entity.FolderTypeFolder
 	default:
-		return "folder" // Default to folder type for user-created folders
+		return entity.FolderTypeFolder // Default to folder type for user-created folders
 	}
 }
 
 func libraryEntryToProto(e *service.LibraryEntry) *v1.LibraryEntry {
 	entry := &v1.LibraryEntry{
-		Id:         e.ID,
-		Title:      e.Title,
-		Status:     courseStatusToProto(e.Status),
-		Folder:     e.Folder,
-		Tags:       e.Tags,
-		CreatedAt:  timestamppb.New(e.CreatedAt),
-		ModifiedAt: timestamppb.New(e.ModifiedAt),
+		Id:                    e.ID,
+		Title:                 e.Title,
+		Status:                courseStatusToProto(e.Status),
+		Folder:                e.Folder,
+		Tags:                  e.Tags,
+		CreatedAt:             timestamppb.New(e.CreatedAt),
+		ModifiedAt:            timestamppb.New(e.ModifiedAt),
+		CreatedByActive:       e.CreatedByActive,
+		HasUnpublishedChanges: e.HasUnpublished,
 	}
 	if e.CreatedBy != "" {
 		entry.CreatedBy = &e.CreatedBy
@@ -380,31 +1157,89 @@ func libraryEntryToProto(e *service.LibraryEntry) *v1.LibraryEntry {
 	if e.ThumbnailPath != "" {
 		entry.ThumbnailPath = &e.ThumbnailPath
 	}
+	if e.CallerRole != "" {
+		role := courseRoleToProto(e.CallerRole)
+		entry.CallerRole = &role
+	}
 	return entry
 }
 
+func courseRoleToProto(r valueobject.CourseRole) v1.CourseRole {
+	switch r {
+	case valueobject.CourseRoleOwner:
+		return v1.CourseRole_COURSE_ROLE_OWNER
+	case valueobject.CourseRoleEditor:
+		return v1.CourseRole_COURSE_ROLE_EDITOR
+	case valueobject.CourseRoleViewer:
+		return v1.CourseRole_COURSE_ROLE_VIEWER
+	default:
+		return v1.CourseRole_COURSE_ROLE_UNSPECIFIED
+	}
+}
+
+func courseRoleFromProto(r v1.CourseRole) valueobject.CourseRole {
+	switch r {
+	case v1.CourseRole_COURSE_ROLE_OWNER:
+		return valueobject.CourseRoleOwner
+	case v1.CourseRole_COURSE_ROLE_EDITOR:
+		return valueobject.CourseRoleEditor
+	case v1.CourseRole_COURSE_ROLE_VIEWER:
+		return valueobject.CourseRoleViewer
+	default:
+		return valueobject.CourseRoleEditor // Default to editor when unspecified
+	}
+}
+
+func collaboratorToProto(c *entity.CourseCollaborator) *v1.CourseCollaborator {
+	collaborator := &v1.CourseCollaborator{
+		Id:        c.ID.String(),
+		CourseId:  c.CourseID.String(),
+		UserId:    c.UserID.String(),
+		Role:      courseRoleToProto(c.Role),
+		CreatedAt: timestamppb.New(c.CreatedAt),
+	}
+	if c.AddedByUserID != nil {
+		addedBy := c.AddedByUserID.String()
+		collaborator.AddedByUserId = &addedBy
+	}
+	return collaborator
+}
+
 func folderToProto(f *service.Folder) *v1.Folder {
 	folder := &v1.Folder{
-		Id:   f.ID,
-		Name: f.Name,
-		Type: folderTypeToProto(f.Type),
+		Id:          f.ID,
+		Name:        f.Name,
+		Type:        folderTypeToProto(entity.ParseFolderType(f.Type)),
+		IsProtected: f.IsProtected,
 	}
 	if f.Parent != "" {
 		folder.ParentId = &f.Parent
 	}
+	if f.TeamID != "" {
+		folder.TeamId = &f.TeamID
+	}
+	if f.CourseCount != nil {
+		count := int32(*f.CourseCount)
+		folder.CourseCount = &count
+	}
 	return folder
 }
 
 func entityFolderToProto(f *entity.Folder) *v1.Folder {
 	folder := &v1.Folder{
-		Id:   f.ID.String(),
-		Name: f.Name,
-		Type: folderTypeToProto(string(f.Type)),
+		Id:          f.ID.String(),
+		Name:        f.Name,
+		Type:        folderTypeToProto(f.Type),
+		IsProtected: f.IsProtected(),
 	}
 	if f.ParentID != nil {
 		parentStr := f.ParentID.String()
 		folder.ParentId = &parentStr
 	}
+	if f.TeamID != nil {
+		teamStr := f.TeamID.String()
+		folder.TeamId = &teamStr
+	}
 	return folder
 }
 
@@ -478,8 +1313,10 @@ func storedCourseToProto(c *service.StoredCourse) *v1.Course {
 			CategoryTags:      c.Settings.CategoryTags,
 			DataSource:        c.Settings.DataSource,
 		},
-		AssessmentSettings: assessmentSettingsToProto(c.AssessmentSettings),
-		Content:            contentToProto(&c.Content),
+		AssessmentSettings:    assessmentSettingsToProto(c.AssessmentSettings),
+		Content:               contentToProto(&c.Content),
+		PublishedVersion:      int32(c.PublishedVersion),
+		HasUnpublishedChanges: c.HasUnpublished,
 	}
 }
 
@@ -16,6 +16,10 @@ var (
 	errMissingToken     = errors.New("token is required")
 	errUnauthenticated  = errors.New("authentication required")
 	errForbidden        = errors.New("permission denied")
+	errExportFormat     = errors.New("export format is required")
+	errInputRequired    = errors.New("input is required")
+
+	errAPITokenProcedure = errors.New("API tokens can't call this procedure")
 )
 
 // toConnectError converts domain errors to Connect errors with appropriate codes.
@@ -28,7 +32,7 @@ func toConnectError(err error) error {
 	domainErr := domainerrors.GetDomainError(err)
 	if domainErr != nil {
 		switch domainErr.HTTPStatus {
-		case http.StatusNotFound:
+		case http.StatusNotFound, http.StatusGone:
 			return connect.NewError(connect.CodeNotFound, err)
 		case http.StatusConflict:
 			return connect.NewError(connect.CodeAlreadyExists, err)
@@ -38,6 +42,12 @@ func toConnectError(err error) error {
 			return connect.NewError(connect.CodePermissionDenied, err)
 		case http.StatusBadRequest:
 			return connect.NewError(connect.CodeInvalidArgument, err)
+		case http.StatusPreconditionFailed:
+			return connect.NewError(connect.CodeFailedPrecondition, err)
+		case http.StatusTooManyRequests:
+			return connect.NewError(connect.CodeResourceExhausted, err)
+		case http.StatusGatewayTimeout:
+			return connect.NewError(connect.CodeDeadlineExceeded, err)
 		case http.StatusBadGateway, http.StatusServiceUnavailable:
 			return connect.NewError(connect.CodeUnavailable, err)
 		default:
@@ -7,16 +7,18 @@ import (
 
 	v1 "github.com/sogos/mirai-backend/gen/mirai/v1"
 	"github.com/sogos/mirai-backend/gen/mirai/v1/miraiv1connect"
+	"github.com/sogos/mirai-backend/internal/application/service"
 )
 
 // HealthServiceServer implements the HealthService Connect handler.
 type HealthServiceServer struct {
 	miraiv1connect.UnimplementedHealthServiceHandler
+	maintenanceService *service.MaintenanceService
 }
 
 // NewHealthServiceServer creates a new HealthServiceServer.
-func NewHealthServiceServer() *HealthServiceServer {
-	return &HealthServiceServer{}
+func NewHealthServiceServer(maintenanceService *service.MaintenanceService) *HealthServiceServer {
+	return &HealthServiceServer{maintenanceService: maintenanceService}
 }
 
 // Check returns the health status of the service.
@@ -24,7 +26,11 @@ func (s *HealthServiceServer) Check(
 	ctx context.Context,
 	req *connect.Request[v1.CheckRequest],
 ) (*connect.Response[v1.CheckResponse], error) {
-	return connect.NewResponse(&v1.CheckResponse{
+	resp := &v1.CheckResponse{
 		Status: "ok",
-	}), nil
+	}
+	if s.maintenanceService != nil {
+		resp.Maintenance = maintenanceStatusToProto(s.maintenanceService.Status(ctx))
+	}
+	return connect.NewResponse(resp), nil
 }
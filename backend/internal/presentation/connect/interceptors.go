@@ -2,15 +2,22 @@ package connect
 
 import (
 	"context"
+	"fmt"
+	"net"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
 	"connectrpc.com/connect"
 	"github.com/google/uuid"
+	appservice "github.com/sogos/mirai-backend/internal/application/service"
+	"github.com/sogos/mirai-backend/internal/domain/audit"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
 	"github.com/sogos/mirai-backend/internal/domain/repository"
 	"github.com/sogos/mirai-backend/internal/domain/service"
 	"github.com/sogos/mirai-backend/internal/domain/tenant"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
 	"github.com/sogos/mirai-backend/internal/infrastructure/cache"
 )
 
@@ -22,6 +29,8 @@ type AuthInterceptor struct {
 	logger   service.Logger
 	// Procedures that don't require authentication
 	publicProcedures map[string]bool
+	// Resolves bearer API tokens; nil until SetAPITokens
+	apiTokens *appservice.UserService
 }
 
 // userTenantMapping caches the kratos ID to tenant ID mapping.
@@ -41,12 +50,19 @@ func NewAuthInterceptor(identity service.IdentityProvider, userRepo repository.U
 			"/mirai.v1.AuthService/Register":                   true,
 			"/mirai.v1.AuthService/RegisterWithInvitation":     true, // Public for invited user registration
 			"/mirai.v1.AuthService/EnterpriseContact":          true,
+			"/mirai.v1.AuthService/ListStorageRegions":         true, // Public for the signup form
 			"/mirai.v1.HealthService/Check":                    true,
 			"/mirai.v1.InvitationService/GetInvitationByToken": true, // Public for accept invite flow
 		},
 	}
 }
 
+// SetAPITokens enables authenticating requests with an "Authorization: Bearer" API token
+// instead of a session cookie.
+func (i *AuthInterceptor) SetAPITokens(users *appservice.UserService) {
+	i.apiTokens = users
+}
+
 // WrapUnary implements connect.Interceptor for unary calls.
 func (i *AuthInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
 	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
@@ -57,6 +73,15 @@ func (i *AuthInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
 			return next(ctx, req)
 		}
 
+		// API clients send a bearer token instead of a session cookie
+		if secret, ok := bearerToken(req.Header()); ok {
+			ctx, err := i.authenticateAPIToken(ctx, procedure, secret)
+			if err != nil {
+				return nil, err
+			}
+			return next(ctx, req)
+		}
+
 		// Parse cookies from request header
 		cookieHeader := req.Header().Get("Cookie")
 		if cookieHeader == "" {
@@ -151,6 +176,15 @@ func (i *AuthInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc
 			return next(ctx, conn)
 		}
 
+		// API clients send a bearer token instead of a session cookie
+		if secret, ok := bearerToken(conn.RequestHeader()); ok {
+			ctx, err := i.authenticateAPIToken(ctx, procedure, secret)
+			if err != nil {
+				return err
+			}
+			return next(ctx, conn)
+		}
+
 		// Parse cookies from request header
 		cookieHeader := conn.RequestHeader().Get("Cookie")
 		if cookieHeader == "" {
@@ -229,6 +263,293 @@ func (i *AuthInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc
 	}
 }
 
+// authenticateAPIToken resolves a bearer API token and checks it grants the scope the
+// procedure needs. The returned context carries the token's user and tenant like a
+// session would, and marks the request as made with the token for audit entries.
+func (i *AuthInterceptor) authenticateAPIToken(ctx context.Context, procedure, secret string) (context.Context, error) {
+	if i.apiTokens == nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	required, ok := apiTokenScopeFor(procedure)
+	if !ok {
+		return nil, connect.NewError(connect.CodePermissionDenied, errAPITokenProcedure)
+	}
+
+	identity, err := i.apiTokens.AuthenticateAPIToken(ctx, secret, required)
+	if err != nil {
+		i.logger.Debug("API token authentication failed", "procedure", procedure, "error", err)
+		return nil, toConnectError(err)
+	}
+
+	ctx = context.WithValue(ctx, kratosIDKey{}, identity.User.KratosID.String())
+	ctx = tenant.WithTenantID(ctx, identity.Token.TenantID)
+	ctx = audit.WithAPIToken(ctx, identity.Token.ID)
+	return ctx, nil
+}
+
+// bearerToken returns the token of an "Authorization: Bearer" header.
+func bearerToken(header http.Header) (string, bool) {
+	scheme, token, ok := strings.Cut(header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
+
+// apiTokenProcedures are the procedures API tokens can call and the scope each needs.
+// Procedures not listed here are denied, including ones added to CourseService later,
+// the editor's presence RPCs, which only make sense for a browser session, and the
+// collaborator and sample content RPCs, which administer the workspace rather than
+// author courses.
+var apiTokenProcedures = map[string]valueobject.APITokenScope{
+	"/mirai.v1.CourseService/ListCourses":                  valueobject.APITokenScopeCourseRead,
+	"/mirai.v1.CourseService/GetCourse":                    valueobject.APITokenScopeCourseRead,
+	"/mirai.v1.CourseService/CreateCourse":                 valueobject.APITokenScopeCourseWrite,
+	"/mirai.v1.CourseService/UpdateCourse":                 valueobject.APITokenScopeCourseWrite,
+	"/mirai.v1.CourseService/DeleteCourse":                 valueobject.APITokenScopeCourseWrite,
+	"/mirai.v1.CourseService/GetFolderHierarchy":           valueobject.APITokenScopeCourseRead,
+	"/mirai.v1.CourseService/GetLibrary":                   valueobject.APITokenScopeCourseRead,
+	"/mirai.v1.CourseService/CreateFolder":                 valueobject.APITokenScopeCourseWrite,
+	"/mirai.v1.CourseService/UpdateFolder":                 valueobject.APITokenScopeCourseWrite,
+	"/mirai.v1.CourseService/DeleteFolder":                 valueobject.APITokenScopeCourseWrite,
+	"/mirai.v1.CourseService/ExportCourse":                 valueobject.APITokenScopeCourseWrite, // Starts a job
+	"/mirai.v1.CourseService/GetExportStatus":              valueobject.APITokenScopeCourseRead,
+	"/mirai.v1.CourseService/DownloadExport":               valueobject.APITokenScopeCourseRead,
+	"/mirai.v1.CourseService/ListExports":                  valueobject.APITokenScopeCourseRead,
+	"/mirai.v1.CourseService/ListCollaborators":            valueobject.APITokenScopeCourseRead,
+	"/mirai.v1.CourseService/ListLargestCourses":           valueobject.APITokenScopeCourseRead,
+	"/mirai.v1.CourseService/PublishChanges":               valueobject.APITokenScopeCourseWrite,
+	"/mirai.v1.CourseService/DiscardDraft":                 valueobject.APITokenScopeCourseWrite,
+	"/mirai.v1.CourseService/SearchWithinCourse":           valueobject.APITokenScopeCourseRead,
+	"/mirai.v1.CourseService/GetCourseAttachmentUploadURL": valueobject.APITokenScopeCourseWrite, // Presigns an upload
+	"/mirai.v1.CourseService/ConfirmCourseAttachment":      valueobject.APITokenScopeCourseWrite,
+	"/mirai.v1.CourseService/ListCourseAttachments":        valueobject.APITokenScopeCourseRead,
+	"/mirai.v1.CourseService/SetCourseAttachmentExcluded":  valueobject.APITokenScopeCourseWrite,
+	"/mirai.v1.CourseService/DeleteCourseAttachment":       valueobject.APITokenScopeCourseWrite,
+	"/mirai.v1.CourseService/ListCourseCards":              valueobject.APITokenScopeCourseRead,
+	"/mirai.v1.CourseService/GetCourseCardDetails":         valueobject.APITokenScopeCourseRead,
+}
+
+// apiTokenScopeFor returns the scope an API token needs to call a procedure, or false
+// if API tokens can't call it at all.
+func apiTokenScopeFor(procedure string) (valueobject.APITokenScope, bool) {
+	scope, ok := apiTokenProcedures[procedure]
+	return scope, ok
+}
+
+// readOnlyProcedures are the RPCs that keep working during maintenance mode because
+// they don't modify data. Procedures not listed here are rejected, whatever their names
+// look like: GetSMEDeletionImpact issues a deletion token, and the upload URL RPCs start
+// uploads. GetMe is listed although it syncs the user's locale; that write is
+// best-effort and the app can't load without it.
+var readOnlyProcedures = map[string]bool{
+	"/mirai.v1.AIGenerationService/GetAccessibilityReport": true,
+	"/mirai.v1.AIGenerationService/GetComponentSources":    true,
+	"/mirai.v1.AIGenerationService/GetCourseOutline":       true,
+	"/mirai.v1.AIGenerationService/GetCoursePlayerView":    true,
+	"/mirai.v1.AIGenerationService/GetCourseStats":         true,
+	"/mirai.v1.AIGenerationService/GetGeneratedLesson":     true,
+	"/mirai.v1.AIGenerationService/GetGenerationDraft":     true,
+	"/mirai.v1.AIGenerationService/GetJob":                 true,
+	"/mirai.v1.AIGenerationService/GetQueueStatus":         true,
+	"/mirai.v1.AIGenerationService/GetStorageAuditReport":  true,
+	"/mirai.v1.AIGenerationService/ListAnomalies":          true,
+	"/mirai.v1.AIGenerationService/ListGeneratedLessons":   true,
+	"/mirai.v1.AIGenerationService/ListJobs":               true,
+	"/mirai.v1.AIGenerationService/ListOutlineComments":    true,
+	"/mirai.v1.AuditService/ListAuditEvents":               true,
+	"/mirai.v1.AuthService/CheckEmail":                     true,
+	"/mirai.v1.AuthService/ListStorageRegions":             true,
+	"/mirai.v1.BillingService/GetBillingInfo":              true,
+	"/mirai.v1.CompanyService/GetCompany":                  true,
+	"/mirai.v1.CourseService/GetCourse":                    true,
+	"/mirai.v1.CourseService/GetCourseCardDetails":         true,
+	"/mirai.v1.CourseService/GetCoursePresence":            true,
+	"/mirai.v1.CourseService/GetExportStatus":              true,
+	"/mirai.v1.CourseService/GetFolderHierarchy":           true,
+	"/mirai.v1.CourseService/GetLibrary":                   true,
+	"/mirai.v1.CourseService/ListCollaborators":            true,
+	"/mirai.v1.CourseService/ListCourseAttachments":        true,
+	"/mirai.v1.CourseService/ListCourseCards":              true,
+	"/mirai.v1.CourseService/ListCourses":                  true,
+	"/mirai.v1.CourseService/ListExports":                  true,
+	"/mirai.v1.CourseService/ListLargestCourses":           true,
+	"/mirai.v1.HealthService/Check":                        true,
+	"/mirai.v1.InvitationService/GetInvitation":            true,
+	"/mirai.v1.InvitationService/GetInvitationByToken":     true,
+	"/mirai.v1.InvitationService/GetSeatInfo":              true,
+	"/mirai.v1.InvitationService/ListInvitations":          true,
+	"/mirai.v1.LMSSyncService/GetFolderSync":               true,
+	"/mirai.v1.LMSSyncService/GetSyncStatus":               true,
+	"/mirai.v1.LMSSyncService/ListConnectors":              true,
+	"/mirai.v1.MaintenanceService/GetMaintenanceMode":      true,
+	"/mirai.v1.MaintenanceService/GetSystemDiagnostics":    true,
+	"/mirai.v1.NotificationService/GetUnreadCount":         true,
+	"/mirai.v1.NotificationService/ListEmailLog":           true,
+	"/mirai.v1.NotificationService/ListNotifications":      true,
+	"/mirai.v1.NotificationService/SubscribeNotifications": true,
+	"/mirai.v1.SMEService/GetKnowledge":                    true,
+	"/mirai.v1.SMEService/GetSME":                          true,
+	"/mirai.v1.SMEService/GetSMEStats":                     true,
+	"/mirai.v1.SMEService/GetSubmission":                   true,
+	"/mirai.v1.SMEService/GetSubmissionStatus":             true,
+	"/mirai.v1.SMEService/GetTask":                         true,
+	"/mirai.v1.SMEService/ListKnowledgeTopics":             true,
+	"/mirai.v1.SMEService/ListSMEs":                        true,
+	"/mirai.v1.SMEService/ListSubmissions":                 true,
+	"/mirai.v1.SMEService/ListTasks":                       true,
+	"/mirai.v1.TargetAudienceService/GetTemplate":          true,
+	"/mirai.v1.TargetAudienceService/ListTemplates":        true,
+	"/mirai.v1.TeamService/GetTeam":                        true,
+	"/mirai.v1.TeamService/GetTeamDashboard":               true,
+	"/mirai.v1.TeamService/ListTeamMembers":                true,
+	"/mirai.v1.TeamService/ListTeams":                      true,
+	"/mirai.v1.TenantService/GetTenant":                    true,
+	"/mirai.v1.TenantSettingsService/GetAISettings":        true,
+	"/mirai.v1.TenantSettingsService/GetCourseDefaults":    true,
+	"/mirai.v1.TenantSettingsService/GetUsageStats":        true,
+	"/mirai.v1.UserService/GetMe":                          true,
+	"/mirai.v1.UserService/GetOnboardingStatus":            true,
+	"/mirai.v1.UserService/GetUser":                        true,
+	"/mirai.v1.UserService/ListAPITokens":                  true,
+	"/mirai.v1.UserService/ListCompanyUsers":               true,
+}
+
+// MaintenanceInterceptor rejects mutating RPCs while read-only maintenance mode is enabled.
+type MaintenanceInterceptor struct {
+	maintenance *appservice.MaintenanceService
+	// Writes that stay available during maintenance
+	alwaysAllowed map[string]bool
+}
+
+// NewMaintenanceInterceptor creates a new maintenance interceptor.
+func NewMaintenanceInterceptor(maintenance *appservice.MaintenanceService) *MaintenanceInterceptor {
+	return &MaintenanceInterceptor{
+		maintenance: maintenance,
+		alwaysAllowed: map[string]bool{
+			"/mirai.v1.MaintenanceService/SetMaintenanceMode": true, // Needed to turn maintenance off
+		},
+	}
+}
+
+// WrapUnary implements connect.Interceptor for unary calls.
+func (i *MaintenanceInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if err := i.check(ctx, req.Spec().Procedure); err != nil {
+			return nil, err
+		}
+		return next(ctx, req)
+	}
+}
+
+// WrapStreamingClient implements connect.Interceptor.
+func (i *MaintenanceInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+// WrapStreamingHandler implements connect.Interceptor for server streaming calls.
+func (i *MaintenanceInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		if err := i.check(ctx, conn.Spec().Procedure); err != nil {
+			return err
+		}
+		return next(ctx, conn)
+	}
+}
+
+// check returns an Unavailable error with a Retry-After hint if the procedure
+// writes data and maintenance mode is enabled.
+func (i *MaintenanceInterceptor) check(ctx context.Context, procedure string) error {
+	if i.maintenance == nil || i.alwaysAllowed[procedure] || readOnlyProcedures[procedure] {
+		return nil
+	}
+
+	status := i.maintenance.Status(ctx)
+	if !status.Enabled {
+		return nil
+	}
+
+	err := connect.NewError(connect.CodeUnavailable, domainerrors.ErrMaintenanceMode)
+	if retryAfter := status.RetryAfter(); retryAfter > 0 {
+		err.Meta().Set("Retry-After", strconv.Itoa(int(retryAfter.Seconds())))
+	}
+	return err
+}
+
+// PayloadLimits are the maximum request message sizes, in bytes, accepted per RPC class.
+type PayloadLimits struct {
+	Default int // Any RPC not in another class
+	Large   int // RPCs that carry whole documents (largePayloadProcedures)
+	Small   int // Lookups and deletes (smallPayloadMethodPrefixes)
+}
+
+// Max returns the largest configured limit.
+func (l PayloadLimits) Max() int {
+	return max(l.Default, l.Large, l.Small)
+}
+
+// largePayloadProcedures carry a full course or a pasted document in a single request.
+var largePayloadProcedures = map[string]bool{
+	"/mirai.v1.CourseService/CreateCourse": true,
+	"/mirai.v1.SMEService/SubmitContent":   true,
+}
+
+// smallPayloadMethodPrefixes identify RPCs whose requests are little more than IDs and filters.
+var smallPayloadMethodPrefixes = []string{"Get", "List", "Check", "Subscribe", "Delete", "Cancel", "Remove"}
+
+// limitRequestBodies caps each request body at the payload limit for its RPC before
+// Connect reads it, so an oversized request is rejected without being buffered or
+// decoded. Requests declaring a larger Content-Length get an InvalidArgument error
+// naming the limit; a body that only turns out too large while streaming in fails with
+// ResourceExhausted once it passes the limit. Compressed bodies are limited as sent,
+// and connect.WithReadMaxBytes bounds them once decompressed.
+func limitRequestBodies(limits PayloadLimits, next http.Handler) http.Handler {
+	errorWriter := connect.NewErrorWriter()
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		limit := limits.limitFor(r.URL.Path)
+		if limit <= 0 {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if r.ContentLength > int64(limit) {
+			method := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+			errorWriter.Write(w, r, connect.NewError(connect.CodeInvalidArgument,
+				fmt.Errorf("request payload of %s exceeds the %s limit for %s", formatBytes(int(r.ContentLength)), formatBytes(limit), method)))
+			return
+		}
+		r.Body = http.MaxBytesReader(w, r.Body, int64(limit))
+		next.ServeHTTP(w, r)
+	})
+}
+
+// limitFor returns the payload limit that applies to a procedure.
+func (l PayloadLimits) limitFor(procedure string) int {
+	if largePayloadProcedures[procedure] {
+		return l.Large
+	}
+	method := procedure[strings.LastIndex(procedure, "/")+1:]
+	for _, prefix := range smallPayloadMethodPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return l.Small
+		}
+	}
+	return l.Default
+}
+
+// formatBytes renders a byte count for error messages, e.g. "4.0 MB" or "64 KB".
+func formatBytes(n int) string {
+	switch {
+	case n >= 1<<20:
+		return fmt.Sprintf("%.1f MB", float64(n)/(1<<20))
+	case n >= 1<<10:
+		return strconv.Itoa(n>>10) + " KB"
+	default:
+		return strconv.Itoa(n) + " bytes"
+	}
+}
+
 // LoggingInterceptor provides request logging for Connect handlers.
 type LoggingInterceptor struct {
 	logger service.Logger
@@ -285,3 +606,50 @@ func (i *LoggingInterceptor) WrapStreamingClient(next connect.StreamingClientFun
 func (i *LoggingInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
 	return next
 }
+
+// ClientIPInterceptor records the client address in the context for audit entries.
+// The server runs behind the ingress, so the first X-Forwarded-For hop is preferred
+// over the peer address.
+type ClientIPInterceptor struct{}
+
+// NewClientIPInterceptor creates a new client IP interceptor.
+func NewClientIPInterceptor() *ClientIPInterceptor {
+	return &ClientIPInterceptor{}
+}
+
+// WrapUnary implements connect.Interceptor.
+func (i *ClientIPInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if ip := clientIP(req.Header(), req.Peer().Addr); ip != "" {
+			ctx = audit.WithClientIP(ctx, ip)
+		}
+		return next(ctx, req)
+	}
+}
+
+// WrapStreamingClient implements connect.Interceptor.
+func (i *ClientIPInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+// WrapStreamingHandler implements connect.Interceptor.
+func (i *ClientIPInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
+
+// clientIP returns the originating client address of a request.
+func clientIP(header http.Header, peerAddr string) string {
+	if forwarded := header.Get("X-Forwarded-For"); forwarded != "" {
+		first, _, _ := strings.Cut(forwarded, ",")
+		if ip := strings.TrimSpace(first); ip != "" {
+			return ip
+		}
+	}
+	if ip := strings.TrimSpace(header.Get("X-Real-IP")); ip != "" {
+		return ip
+	}
+	if host, _, err := net.SplitHostPort(peerAddr); err == nil {
+		return host
+	}
+	return peerAddr
+}
@@ -0,0 +1,180 @@
+package connect
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	v1 "github.com/sogos/mirai-backend/gen/mirai/v1"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+func TestAPITokenScopeFor(t *testing.T) {
+	tests := []struct {
+		procedure string
+		want      valueobject.APITokenScope // Empty when API tokens can't call it
+	}{
+		{"/mirai.v1.CourseService/ListCourses", valueobject.APITokenScopeCourseRead},
+		{"/mirai.v1.CourseService/GetCourse", valueobject.APITokenScopeCourseRead},
+		{"/mirai.v1.CourseService/SearchWithinCourse", valueobject.APITokenScopeCourseRead},
+		{"/mirai.v1.CourseService/DownloadExport", valueobject.APITokenScopeCourseRead},
+		{"/mirai.v1.CourseService/CreateCourse", valueobject.APITokenScopeCourseWrite},
+		{"/mirai.v1.CourseService/ExportCourse", valueobject.APITokenScopeCourseWrite},
+		{"/mirai.v1.CourseService/GetCourseAttachmentUploadURL", valueobject.APITokenScopeCourseWrite},
+
+		// Unlisted CourseService procedures, whatever their names look like
+		{"/mirai.v1.CourseService/HeartbeatCoursePresence", ""},
+		{"/mirai.v1.CourseService/GetCoursePresence", ""},
+		{"/mirai.v1.CourseService/AddCollaborator", ""},
+		{"/mirai.v1.CourseService/RemoveCollaborator", ""},
+		{"/mirai.v1.CourseService/RemoveSampleContent", ""},
+		{"/mirai.v1.CourseService/GetAllCourseSecrets", ""},
+		{"/mirai.v1.CourseService/ListCoursesX", ""},
+
+		// Other services
+		{"/mirai.v1.UserService/GetMe", ""},
+		{"/mirai.v1.UserService/CreateAPIToken", ""},
+		{"/mirai.v1.TenantService/ListTenants", ""},
+		{"/mirai.v1.CourseServiceAdmin/ListCourses", ""},
+		{"/mirai.v1.CourseService/", ""},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.procedure, func(t *testing.T) {
+			got, ok := apiTokenScopeFor(tt.procedure)
+			if ok != (tt.want != "") || got != tt.want {
+				t.Errorf("apiTokenScopeFor(%q) = %q, %v; want %q", tt.procedure, got, ok, tt.want)
+			}
+		})
+	}
+}
+
+// TestAPITokenProceduresExist checks every procedure in apiTokenProcedures is a
+// CourseService RPC, so a misspelled or removed one fails here.
+func TestAPITokenProceduresExist(t *testing.T) {
+	methods := v1.File_mirai_v1_course_proto.Services().ByName("CourseService").Methods()
+	for procedure, scope := range apiTokenProcedures {
+		name, ok := strings.CutPrefix(procedure, "/mirai.v1.CourseService/")
+		if !ok || methods.ByName(protoreflect.Name(name)) == nil {
+			t.Errorf("%s is not a CourseService procedure", procedure)
+		}
+		if !scope.IsValid() {
+			t.Errorf("%s needs invalid scope %q", procedure, scope)
+		}
+	}
+}
+
+func TestReadOnlyProcedures(t *testing.T) {
+	tests := []struct {
+		procedure string
+		want      bool
+	}{
+		{"/mirai.v1.CourseService/ListCourses", true},
+		{"/mirai.v1.UserService/GetMe", true},
+		{"/mirai.v1.HealthService/Check", true},
+		{"/mirai.v1.NotificationService/SubscribeNotifications", true},
+
+		// Reads by name that write
+		{"/mirai.v1.SMEService/GetSMEDeletionImpact", false},
+		{"/mirai.v1.SMEService/GetUploadURL", false},
+		{"/mirai.v1.CourseService/GetCourseAttachmentUploadURL", false},
+
+		// Unlisted procedures
+		{"/mirai.v1.CourseService/UpdateCourse", false},
+		{"/mirai.v1.CourseService/GetCourseX", false},
+		{"/mirai.v1.OtherService/GetCourse", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.procedure, func(t *testing.T) {
+			if got := readOnlyProcedures[tt.procedure]; got != tt.want {
+				t.Errorf("readOnlyProcedures[%q] = %v, want %v", tt.procedure, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestReadOnlyProceduresExist checks every procedure in readOnlyProcedures is an RPC,
+// so a misspelled or removed one fails here.
+func TestReadOnlyProceduresExist(t *testing.T) {
+	checkProceduresExist(t, readOnlyProcedures)
+}
+
+// checkProceduresExist fails the test for each procedure that isn't a registered RPC.
+func checkProceduresExist(t *testing.T, procedures map[string]bool) {
+	t.Helper()
+	for procedure := range procedures {
+		name := protoreflect.FullName(strings.ReplaceAll(strings.TrimPrefix(procedure, "/"), "/", "."))
+		if desc, err := protoregistry.GlobalFiles.FindDescriptorByName(name); err != nil {
+			t.Errorf("%s is not an RPC: %v", procedure, err)
+		} else if _, ok := desc.(protoreflect.MethodDescriptor); !ok {
+			t.Errorf("%s is not an RPC", procedure)
+		}
+	}
+}
+
+func TestLimitRequestBodies(t *testing.T) {
+	limits := PayloadLimits{Default: 100, Large: 1000, Small: 10}
+	tests := []struct {
+		name      string
+		procedure string
+		size      int
+		chunked   bool // Sent without a Content-Length
+		wantCode  int  // HTTP status written before the handler runs, 0 if it runs
+		wantRead  bool // Whether the handler can read the whole body
+	}{
+		{"default within limit", "/mirai.v1.CourseService/UpdateCourse", 100, false, 0, true},
+		{"default over limit", "/mirai.v1.CourseService/UpdateCourse", 101, false, http.StatusBadRequest, false},
+		{"large within limit", "/mirai.v1.CourseService/CreateCourse", 1000, false, 0, true},
+		{"small over limit", "/mirai.v1.CourseService/GetCourse", 11, false, http.StatusBadRequest, false},
+		{"chunked within limit", "/mirai.v1.CourseService/UpdateCourse", 100, true, 0, true},
+		{"chunked over limit", "/mirai.v1.CourseService/UpdateCourse", 101, true, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var called, read bool
+			var readErr error
+			handler := limitRequestBodies(limits, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				called = true
+				_, readErr = io.ReadAll(r.Body)
+				read = readErr == nil
+			}))
+
+			req := httptest.NewRequest(http.MethodPost, tt.procedure, bytes.NewReader(make([]byte, tt.size)))
+			req.Header.Set("Content-Type", "application/proto")
+			if tt.chunked {
+				req.ContentLength = -1
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if tt.wantCode != 0 {
+				if called || rec.Code != tt.wantCode || !strings.Contains(rec.Body.String(), "invalid_argument") {
+					t.Errorf("got status %d, body %q, handler called %v; want %d invalid_argument before the handler", rec.Code, rec.Body.String(), called, tt.wantCode)
+				}
+				return
+			}
+			if !called || read != tt.wantRead {
+				t.Errorf("handler called %v, read whole body %v (%v); want read %v", called, read, readErr, tt.wantRead)
+			}
+			var maxBytesErr *http.MaxBytesError
+			if !tt.wantRead && !errors.As(readErr, &maxBytesErr) {
+				t.Errorf("read error = %v, want *http.MaxBytesError", readErr)
+			}
+		})
+	}
+}
+
+// TestLargePayloadProceduresExist checks every procedure in largePayloadProcedures is
+// an RPC, so a misspelled or removed one fails here.
+func TestLargePayloadProceduresExist(t *testing.T) {
+	checkProceduresExist(t, largePayloadProcedures)
+}
@@ -43,6 +43,13 @@ func (s *InvitationServiceServer) CreateInvitation(
 		Email: req.Msg.Email,
 		Role:  roleFromProto(req.Msg.Role),
 	}
+	if req.Msg.TeamId != nil {
+		teamID, err := parseUUID(*req.Msg.TeamId)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		dtoReq.TeamID = &teamID
+	}
 
 	invitation, err := s.invitationService.CreateInvitation(ctx, kratosID, dtoReq)
 	if err != nil {
@@ -270,6 +277,7 @@ func invitationToProto(inv *dto.InvitationResponse) *v1.Invitation {
 	return &v1.Invitation{
 		Id:               inv.ID.String(),
 		CompanyId:        inv.CompanyID.String(),
+		TeamId:           uuidPtrToString(inv.TeamID),
 		Email:            inv.Email,
 		Role:             roleToProto(inv.Role),
 		Status:           invitationStatusToProto(inv.Status),
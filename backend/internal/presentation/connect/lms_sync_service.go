@@ -0,0 +1,428 @@
+package connect
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1 "github.com/sogos/mirai-backend/gen/mirai/v1"
+	"github.com/sogos/mirai-backend/gen/mirai/v1/miraiv1connect"
+	"github.com/sogos/mirai-backend/internal/application/service"
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+// LMSSyncServiceServer implements the LMSSyncService Connect handler.
+type LMSSyncServiceServer struct {
+	miraiv1connect.UnimplementedLMSSyncServiceHandler
+	syncService *service.LMSSyncService
+}
+
+// NewLMSSyncServiceServer creates a new LMSSyncServiceServer.
+func NewLMSSyncServiceServer(syncService *service.LMSSyncService) *LMSSyncServiceServer {
+	return &LMSSyncServiceServer{syncService: syncService}
+}
+
+// CreateConnector registers an LMS connector.
+func (s *LMSSyncServiceServer) CreateConnector(
+	ctx context.Context,
+	req *connect.Request[v1.CreateConnectorRequest],
+) (*connect.Response[v1.CreateConnectorResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	connector, err := s.syncService.CreateConnector(ctx, kratosID, service.ConnectorInput{
+		Name:        req.Msg.Name,
+		Type:        protoToLMSConnectorType(req.Msg.Type),
+		EndpointURL: req.Msg.EndpointUrl,
+		Credential:  req.Msg.Credential,
+		Enabled:     req.Msg.Enabled,
+	})
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.CreateConnectorResponse{
+		Connector: lmsConnectorToProto(connector),
+	}), nil
+}
+
+// ListConnectors returns the organization's LMS connectors.
+func (s *LMSSyncServiceServer) ListConnectors(
+	ctx context.Context,
+	req *connect.Request[v1.ListConnectorsRequest],
+) (*connect.Response[v1.ListConnectorsResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	connectors, err := s.syncService.ListConnectors(ctx, kratosID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	protoConnectors := make([]*v1.LMSConnector, len(connectors))
+	for i, connector := range connectors {
+		protoConnectors[i] = lmsConnectorToProto(connector)
+	}
+
+	return connect.NewResponse(&v1.ListConnectorsResponse{
+		Connectors: protoConnectors,
+	}), nil
+}
+
+// UpdateConnector changes an LMS connector.
+func (s *LMSSyncServiceServer) UpdateConnector(
+	ctx context.Context,
+	req *connect.Request[v1.UpdateConnectorRequest],
+) (*connect.Response[v1.UpdateConnectorResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	connectorID, err := parseUUID(req.Msg.ConnectorId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	connector, err := s.syncService.UpdateConnector(ctx, kratosID, connectorID, service.ConnectorInput{
+		Name:        req.Msg.Name,
+		Type:        protoToLMSConnectorType(req.Msg.Type),
+		EndpointURL: req.Msg.EndpointUrl,
+		Credential:  req.Msg.Credential,
+		Enabled:     req.Msg.Enabled,
+	})
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.UpdateConnectorResponse{
+		Connector: lmsConnectorToProto(connector),
+	}), nil
+}
+
+// DeleteConnector removes an LMS connector.
+func (s *LMSSyncServiceServer) DeleteConnector(
+	ctx context.Context,
+	req *connect.Request[v1.DeleteConnectorRequest],
+) (*connect.Response[v1.DeleteConnectorResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	connectorID, err := parseUUID(req.Msg.ConnectorId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := s.syncService.DeleteConnector(ctx, kratosID, connectorID); err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.DeleteConnectorResponse{}), nil
+}
+
+// GetFolderSync returns the connector a folder syncs to.
+func (s *LMSSyncServiceServer) GetFolderSync(
+	ctx context.Context,
+	req *connect.Request[v1.GetFolderSyncRequest],
+) (*connect.Response[v1.GetFolderSyncResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	folderID, err := parseUUID(req.Msg.FolderId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	setting, err := s.syncService.GetFolderSync(ctx, kratosID, folderID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.GetFolderSyncResponse{
+		ConnectorId: folderSyncConnectorID(setting),
+	}), nil
+}
+
+// SetFolderSync sets or clears the connector a folder syncs to.
+func (s *LMSSyncServiceServer) SetFolderSync(
+	ctx context.Context,
+	req *connect.Request[v1.SetFolderSyncRequest],
+) (*connect.Response[v1.SetFolderSyncResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	folderID, err := parseUUID(req.Msg.FolderId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	var connectorID *uuid.UUID
+	if req.Msg.ConnectorId != nil {
+		id, err := parseUUID(*req.Msg.ConnectorId)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		connectorID = &id
+	}
+
+	setting, err := s.syncService.SetFolderSync(ctx, kratosID, folderID, connectorID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.SetFolderSyncResponse{
+		ConnectorId: folderSyncConnectorID(setting),
+	}), nil
+}
+
+// GetSyncStatus returns the latest delivery of a course to each connector.
+func (s *LMSSyncServiceServer) GetSyncStatus(
+	ctx context.Context,
+	req *connect.Request[v1.GetSyncStatusRequest],
+) (*connect.Response[v1.GetSyncStatusResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	courseID, err := parseUUID(req.Msg.CourseId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	statuses, err := s.syncService.GetSyncStatus(ctx, kratosID, courseID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	deliveries := make([]*v1.CourseSyncDelivery, len(statuses))
+	for i, status := range statuses {
+		deliveries[i] = courseSyncDeliveryToProto(status)
+	}
+
+	return connect.NewResponse(&v1.GetSyncStatusResponse{
+		Deliveries: deliveries,
+	}), nil
+}
+
+// TestWebhook sends a synthetic event to a webhook connector.
+func (s *LMSSyncServiceServer) TestWebhook(
+	ctx context.Context,
+	req *connect.Request[v1.TestWebhookRequest],
+) (*connect.Response[v1.TestWebhookResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	connectorID, err := parseUUID(req.Msg.ConnectorId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	attempt, err := s.syncService.TestWebhook(ctx, kratosID, connectorID, req.Msg.Event)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.TestWebhookResponse{
+		Attempt: webhookAttemptToProto(attempt),
+	}), nil
+}
+
+// ReplayWebhookDelivery re-sends an earlier delivery to its webhook connector.
+func (s *LMSSyncServiceServer) ReplayWebhookDelivery(
+	ctx context.Context,
+	req *connect.Request[v1.ReplayWebhookDeliveryRequest],
+) (*connect.Response[v1.ReplayWebhookDeliveryResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	deliveryID, err := parseUUID(req.Msg.DeliveryId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	attempt, err := s.syncService.ReplayWebhookDelivery(ctx, kratosID, deliveryID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.ReplayWebhookDeliveryResponse{
+		Attempt: webhookAttemptToProto(attempt),
+	}), nil
+}
+
+// Helper functions for proto conversion
+
+func folderSyncConnectorID(setting *entity.FolderSyncSetting) *string {
+	if setting == nil {
+		return nil
+	}
+	id := setting.ConnectorID.String()
+	return &id
+}
+
+func lmsConnectorToProto(c *entity.LMSConnector) *v1.LMSConnector {
+	return &v1.LMSConnector{
+		Id:             c.ID.String(),
+		Name:           c.Name,
+		Type:           lmsConnectorTypeToProto(c.Type),
+		EndpointUrl:    c.EndpointURL,
+		HasCredentials: c.HasCredentials(),
+		Enabled:        c.Enabled,
+		CreatedAt:      timestamppb.New(c.CreatedAt),
+		UpdatedAt:      timestamppb.New(c.UpdatedAt),
+	}
+}
+
+func courseSyncDeliveryToProto(status service.CourseSyncStatus) *v1.CourseSyncDelivery {
+	d := status.Delivery
+	proto := &v1.CourseSyncDelivery{
+		Id:          d.ID.String(),
+		ConnectorId: d.ConnectorID.String(),
+		Status:      courseSyncStatusToProto(d.Status),
+		Attempts:    int32(d.Attempts),
+		LastError:   d.LastError,
+		RemoteId:    d.RemoteID,
+		CreatedAt:   timestamppb.New(d.CreatedAt),
+		Kind:        courseSyncDeliveryKindToProto(d.Kind),
+	}
+	if status.Connector != nil {
+		proto.ConnectorName = status.Connector.Name
+	}
+	if d.LastAttemptAt != nil {
+		proto.LastAttemptAt = timestamppb.New(*d.LastAttemptAt)
+	}
+	if d.Status == valueobject.CourseSyncStatusPending && d.Attempts > 0 {
+		proto.NextAttemptAt = timestamppb.New(d.NextAttemptAt)
+	}
+	if d.ResponseStatus != nil {
+		code := int32(*d.ResponseStatus)
+		proto.ResponseStatus = &code
+	}
+	if d.ReplayOfID != nil {
+		id := d.ReplayOfID.String()
+		proto.ReplayOfId = &id
+	}
+	return proto
+}
+
+func webhookAttemptToProto(a *service.WebhookAttempt) *v1.WebhookAttempt {
+	return &v1.WebhookAttempt{
+		Delivery:     courseSyncDeliveryToProto(service.CourseSyncStatus{Connector: a.Connector, Delivery: a.Delivery}),
+		LatencyMs:    a.Latency.Milliseconds(),
+		ResponseBody: a.Body,
+	}
+}
+
+func lmsConnectorTypeToProto(t valueobject.LMSConnectorType) v1.LMSConnectorType {
+	switch t {
+	case valueobject.LMSConnectorTypeWebhook:
+		return v1.LMSConnectorType_LMS_CONNECTOR_TYPE_WEBHOOK
+	case valueobject.LMSConnectorTypeHTTPPush:
+		return v1.LMSConnectorType_LMS_CONNECTOR_TYPE_HTTP_PUSH
+	default:
+		return v1.LMSConnectorType_LMS_CONNECTOR_TYPE_UNSPECIFIED
+	}
+}
+
+// protoToLMSConnectorType returns "" for unspecified types, which the service rejects.
+func protoToLMSConnectorType(t v1.LMSConnectorType) valueobject.LMSConnectorType {
+	switch t {
+	case v1.LMSConnectorType_LMS_CONNECTOR_TYPE_WEBHOOK:
+		return valueobject.LMSConnectorTypeWebhook
+	case v1.LMSConnectorType_LMS_CONNECTOR_TYPE_HTTP_PUSH:
+		return valueobject.LMSConnectorTypeHTTPPush
+	default:
+		return ""
+	}
+}
+
+func courseSyncStatusToProto(s valueobject.CourseSyncStatus) v1.CourseSyncStatus {
+	switch s {
+	case valueobject.CourseSyncStatusPending:
+		return v1.CourseSyncStatus_COURSE_SYNC_STATUS_PENDING
+	case valueobject.CourseSyncStatusDelivering:
+		return v1.CourseSyncStatus_COURSE_SYNC_STATUS_DELIVERING
+	case valueobject.CourseSyncStatusDelivered:
+		return v1.CourseSyncStatus_COURSE_SYNC_STATUS_DELIVERED
+	case valueobject.CourseSyncStatusFailed:
+		return v1.CourseSyncStatus_COURSE_SYNC_STATUS_FAILED
+	default:
+		return v1.CourseSyncStatus_COURSE_SYNC_STATUS_UNSPECIFIED
+	}
+}
+
+func courseSyncDeliveryKindToProto(k valueobject.CourseSyncDeliveryKind) v1.CourseSyncDeliveryKind {
+	switch k {
+	case valueobject.CourseSyncDeliveryKindSync:
+		return v1.CourseSyncDeliveryKind_COURSE_SYNC_DELIVERY_KIND_SYNC
+	case valueobject.CourseSyncDeliveryKindTest:
+		return v1.CourseSyncDeliveryKind_COURSE_SYNC_DELIVERY_KIND_TEST
+	case valueobject.CourseSyncDeliveryKindReplay:
+		return v1.CourseSyncDeliveryKind_COURSE_SYNC_DELIVERY_KIND_REPLAY
+	default:
+		return v1.CourseSyncDeliveryKind_COURSE_SYNC_DELIVERY_KIND_UNSPECIFIED
+	}
+}
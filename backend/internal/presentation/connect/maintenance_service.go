@@ -0,0 +1,394 @@
+package connect
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1 "github.com/sogos/mirai-backend/gen/mirai/v1"
+	"github.com/sogos/mirai-backend/gen/mirai/v1/miraiv1connect"
+	"github.com/sogos/mirai-backend/internal/application/service"
+	"github.com/sogos/mirai-backend/internal/infrastructure/cache"
+)
+
+// MaintenanceServiceServer implements the MaintenanceService Connect handler.
+type MaintenanceServiceServer struct {
+	miraiv1connect.UnimplementedMaintenanceServiceHandler
+	maintenanceService *service.MaintenanceService
+	courseService      *service.CourseService
+	diagnosticsService *service.DiagnosticsService
+	smeService         *service.SMEService
+}
+
+// NewMaintenanceServiceServer creates a new MaintenanceServiceServer.
+func NewMaintenanceServiceServer(
+	maintenanceService *service.MaintenanceService,
+	courseService *service.CourseService,
+	diagnosticsService *service.DiagnosticsService,
+	smeService *service.SMEService,
+) *MaintenanceServiceServer {
+	return &MaintenanceServiceServer{
+		maintenanceService: maintenanceService,
+		courseService:      courseService,
+		diagnosticsService: diagnosticsService,
+		smeService:         smeService,
+	}
+}
+
+// GetMaintenanceMode returns the current maintenance flag.
+func (s *MaintenanceServiceServer) GetMaintenanceMode(
+	ctx context.Context,
+	req *connect.Request[v1.GetMaintenanceModeRequest],
+) (*connect.Response[v1.GetMaintenanceModeResponse], error) {
+	return connect.NewResponse(&v1.GetMaintenanceModeResponse{
+		Status: maintenanceStatusToProto(s.maintenanceService.Status(ctx)),
+	}), nil
+}
+
+// SetMaintenanceMode enables or disables maintenance mode.
+func (s *MaintenanceServiceServer) SetMaintenanceMode(
+	ctx context.Context,
+	req *connect.Request[v1.SetMaintenanceModeRequest],
+) (*connect.Response[v1.SetMaintenanceModeResponse], error) {
+	email, ok := ctx.Value(emailKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	var status *service.MaintenanceStatus
+	var err error
+	if req.Msg.Enabled {
+		duration := time.Duration(req.Msg.DurationMinutes) * time.Minute
+		status, err = s.maintenanceService.Enable(ctx, email, req.Msg.Reason, duration)
+	} else {
+		status, err = s.maintenanceService.Disable(ctx, email)
+	}
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.SetMaintenanceModeResponse{
+		Status: maintenanceStatusToProto(status),
+	}), nil
+}
+
+// WarmTenantCache rebuilds a tenant's library cache.
+func (s *MaintenanceServiceServer) WarmTenantCache(
+	ctx context.Context,
+	req *connect.Request[v1.WarmTenantCacheRequest],
+) (*connect.Response[v1.WarmTenantCacheResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	email, ok := ctx.Value(emailKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	tenantID, err := parseUUID(req.Msg.TenantId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	result, err := s.courseService.WarmTenantCache(ctx, kratosID, email, tenantID, int(req.Msg.RecentCourses), req.Msg.Background)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.WarmTenantCacheResponse{
+		KeysWritten: int32(result.KeysWritten),
+		DurationMs:  result.Duration.Milliseconds(),
+		Queued:      result.Queued,
+	}), nil
+}
+
+// InvalidateTenantCache drops a tenant's cached entries.
+func (s *MaintenanceServiceServer) InvalidateTenantCache(
+	ctx context.Context,
+	req *connect.Request[v1.InvalidateTenantCacheRequest],
+) (*connect.Response[v1.InvalidateTenantCacheResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	email, ok := ctx.Value(emailKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	tenantID, err := parseUUID(req.Msg.TenantId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := s.courseService.InvalidateTenantCache(ctx, kratosID, email, tenantID, req.Msg.Patterns); err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.InvalidateTenantCacheResponse{}), nil
+}
+
+// BackfillKnowledgeSummaries queues re-summarization of oversized SME knowledge summaries.
+func (s *MaintenanceServiceServer) BackfillKnowledgeSummaries(
+	ctx context.Context,
+	req *connect.Request[v1.BackfillKnowledgeSummariesRequest],
+) (*connect.Response[v1.BackfillKnowledgeSummariesResponse], error) {
+	email, ok := ctx.Value(emailKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	if err := s.smeService.StartKnowledgeSummaryBackfill(ctx, email); err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.BackfillKnowledgeSummariesResponse{}), nil
+}
+
+// GetSystemDiagnostics reports the state of background services and dependencies.
+func (s *MaintenanceServiceServer) GetSystemDiagnostics(
+	ctx context.Context,
+	req *connect.Request[v1.GetSystemDiagnosticsRequest],
+) (*connect.Response[v1.GetSystemDiagnosticsResponse], error) {
+	email, ok := ctx.Value(emailKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	report, err := s.diagnosticsService.GetSystemDiagnostics(ctx, email)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	resp := &v1.GetSystemDiagnosticsResponse{
+		CheckedAt: timestamppb.New(report.CheckedAt),
+		Build: &v1.BuildInfo{
+			Version:   report.Build.Version,
+			Commit:    report.Build.Commit,
+			GoVersion: report.Build.GoVersion,
+		},
+		Database:            componentHealthToProto(report.Database),
+		Redis:               componentHealthToProto(report.Redis),
+		Storage:             componentHealthToProto(report.Storage),
+		WorkerUp:            report.WorkerUp,
+		WorkerError:         report.WorkerError,
+		QueuesError:         report.QueuesError,
+		ScheduledTasksError: report.ScheduledTasksError,
+	}
+	for _, server := range report.WorkerServers {
+		resp.WorkerServers = append(resp.WorkerServers, &v1.WorkerServer{
+			Host:          server.Host,
+			Pid:           int32(server.PID),
+			Concurrency:   int32(server.Concurrency),
+			ActiveWorkers: int32(server.ActiveWorkers),
+			Status:        server.Status,
+			StartedAt:     timestamppb.New(server.StartedAt),
+		})
+	}
+	for _, queue := range report.Queues {
+		resp.Queues = append(resp.Queues, &v1.QueueDepth{
+			Queue:     queue.Queue,
+			Pending:   int32(queue.Pending),
+			Active:    int32(queue.Active),
+			Scheduled: int32(queue.Scheduled),
+			Retry:     int32(queue.Retry),
+			Archived:  int32(queue.Archived),
+			LatencyMs: queue.Latency.Milliseconds(),
+			Paused:    queue.Paused,
+		})
+	}
+	for _, task := range report.ScheduledTasks {
+		status := &v1.ScheduledTaskStatus{
+			TaskType:       task.TaskType,
+			Schedule:       task.Schedule,
+			LastDurationMs: task.LastDuration.Milliseconds(),
+			WorkerHost:     task.WorkerHost,
+		}
+		if task.NextEnqueueAt != nil {
+			status.NextEnqueueAt = timestamppb.New(*task.NextEnqueueAt)
+		}
+		if task.LastEnqueuedAt != nil {
+			status.LastEnqueuedAt = timestamppb.New(*task.LastEnqueuedAt)
+		}
+		if task.LastSucceededAt != nil {
+			status.LastSucceededAt = timestamppb.New(*task.LastSucceededAt)
+		}
+		resp.ScheduledTasks = append(resp.ScheduledTasks, status)
+	}
+	if report.CacheStats != nil {
+		resp.CacheStats = cacheStatsToProto(report.CacheStats)
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// cacheStatsToProto converts cache hit rates to their proto representation.
+func cacheStatsToProto(stats *cache.StatsSnapshot) *v1.CacheStats {
+	out := &v1.CacheStats{
+		Since:   timestamppb.New(stats.Since),
+		Hits:    stats.Hits,
+		Misses:  stats.Misses,
+		Errors:  stats.Errors,
+		HitRate: stats.HitRate,
+	}
+	for _, ns := range stats.Namespaces {
+		p95 := float64(-1)
+		if ns.GetLatency.P95 >= 0 {
+			p95 = durationMs(ns.GetLatency.P95)
+		}
+		out.Namespaces = append(out.Namespaces, &v1.CacheNamespaceStats{
+			Scope:           ns.Scope,
+			Namespace:       ns.Namespace,
+			Hits:            ns.Hits,
+			Misses:          ns.Misses,
+			Errors:          ns.Errors,
+			Writes:          ns.Writes,
+			HitRate:         ns.HitRate,
+			GetLatencyAvgMs: durationMs(ns.GetLatency.Mean),
+			GetLatencyP95Ms: p95,
+		})
+	}
+	return out
+}
+
+// durationMs converts a duration to fractional milliseconds.
+func durationMs(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+// componentHealthToProto converts a dependency check to its proto representation.
+func componentHealthToProto(health service.ComponentHealth) *v1.ComponentHealth {
+	return &v1.ComponentHealth{
+		Healthy:   health.Healthy,
+		LatencyMs: health.Latency.Milliseconds(),
+		Error:     health.Error,
+	}
+}
+
+// maintenanceStatusToProto converts the maintenance flag to its proto representation.
+func maintenanceStatusToProto(status *service.MaintenanceStatus) *v1.MaintenanceStatus {
+	if status == nil || !status.Enabled {
+		return &v1.MaintenanceStatus{}
+	}
+	return &v1.MaintenanceStatus{
+		Enabled:           true,
+		Reason:            status.Reason,
+		StartedAt:         timestamppb.New(status.StartedAt),
+		EndsAt:            timestamppb.New(status.EndsAt),
+		RetryAfterSeconds: int32(status.RetryAfter().Seconds()),
+	}
+}
+
+// RotateTenantStorageKey gives a tenant a new content encryption key.
+func (s *MaintenanceServiceServer) RotateTenantStorageKey(
+	ctx context.Context,
+	req *connect.Request[v1.RotateTenantStorageKeyRequest],
+) (*connect.Response[v1.RotateTenantStorageKeyResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	email, ok := ctx.Value(emailKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	tenantID, err := parseUUID(req.Msg.TenantId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	version, err := s.courseService.RotateTenantStorageKey(ctx, kratosID, email, tenantID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.RotateTenantStorageKeyResponse{
+		KeyVersion: version,
+	}), nil
+}
+
+// EncryptTenantStorage queues re-encryption of a tenant's course content, or every tenant's.
+func (s *MaintenanceServiceServer) EncryptTenantStorage(
+	ctx context.Context,
+	req *connect.Request[v1.EncryptTenantStorageRequest],
+) (*connect.Response[v1.EncryptTenantStorageResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	email, ok := ctx.Value(emailKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	var tenantID *uuid.UUID
+	if req.Msg.TenantId != "" {
+		id, err := parseUUID(req.Msg.TenantId)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		tenantID = &id
+	}
+
+	if err := s.courseService.StartStorageEncryption(ctx, kratosID, email, tenantID); err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.EncryptTenantStorageResponse{}), nil
+}
+
+// MigrateTenantStorageRegion queues moving a tenant's stored objects to another storage region.
+func (s *MaintenanceServiceServer) MigrateTenantStorageRegion(
+	ctx context.Context,
+	req *connect.Request[v1.MigrateTenantStorageRegionRequest],
+) (*connect.Response[v1.MigrateTenantStorageRegionResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	email, ok := ctx.Value(emailKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	tenantID, err := parseUUID(req.Msg.TenantId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := s.courseService.StartStorageRegionMigration(ctx, kratosID, email, tenantID, req.Msg.Region); err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.MigrateTenantStorageRegionResponse{}), nil
+}
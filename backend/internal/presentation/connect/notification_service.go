@@ -156,6 +156,48 @@ func (s *NotificationServiceServer) MarkAllAsRead(
 	}), nil
 }
 
+// MarkAsReadByFilter marks all notifications matching a filter as read.
+func (s *NotificationServiceServer) MarkAsReadByFilter(
+	ctx context.Context,
+	req *connect.Request[v1.MarkAsReadByFilterRequest],
+) (*connect.Response[v1.MarkAsReadByFilterResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	var filter entity.NotificationReadFilter
+	if req.Msg.CourseId != nil {
+		courseID, err := parseUUID(*req.Msg.CourseId)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		filter.CourseID = &courseID
+	}
+	if req.Msg.Type != nil && *req.Msg.Type != v1.NotificationType_NOTIFICATION_TYPE_UNSPECIFIED {
+		notifType := notificationTypeFromProto(*req.Msg.Type)
+		filter.Type = &notifType
+	}
+	if req.Msg.OlderThan != nil {
+		olderThan := req.Msg.OlderThan.AsTime()
+		filter.OlderThan = &olderThan
+	}
+
+	markedCount, err := s.notificationService.MarkAsReadByFilter(ctx, kratosID, filter)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.MarkAsReadByFilterResponse{
+		MarkedCount: int32(markedCount),
+	}), nil
+}
+
 // DeleteNotification deletes a notification.
 func (s *NotificationServiceServer) DeleteNotification(
 	ctx context.Context,
@@ -244,8 +286,9 @@ func (s *NotificationServiceServer) SubscribeNotifications(
 			}
 			// Send event to client
 			resp := &v1.SubscribeNotificationsResponse{
-				EventType:    event.EventType,
-				Notification: event.Notification,
+				EventType:          event.EventType,
+				Notification:       event.Notification,
+				SubmissionProgress: event.SubmissionProgress,
 			}
 			if err := stream.Send(resp); err != nil {
 				return err
@@ -254,6 +297,43 @@ func (s *NotificationServiceServer) SubscribeNotifications(
 	}
 }
 
+// ListEmailLog returns the outcomes of emails sent for the tenant. Admins and owners only.
+func (s *NotificationServiceServer) ListEmailLog(
+	ctx context.Context,
+	req *connect.Request[v1.ListEmailLogRequest],
+) (*connect.Response[v1.ListEmailLogResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	filter := service.EmailLogFilter{
+		Recipient: req.Msg.Recipient,
+		Template:  req.Msg.Template,
+	}
+	result, err := s.notificationService.ListEmailLog(ctx, kratosID, filter, req.Msg.GetCursor(), int(req.Msg.Limit))
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	entries := make([]*v1.EmailLogEntry, len(result.Entries))
+	for i, entry := range result.Entries {
+		entries[i] = emailLogEntryToProto(entry)
+	}
+
+	resp := &v1.ListEmailLogResponse{Entries: entries}
+	if result.NextCursor != "" {
+		resp.NextCursor = &result.NextCursor
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
 // Helper functions for proto conversion
 
 func notificationToProto(notif *entity.Notification) *v1.Notification {
@@ -324,11 +404,50 @@ func notificationTypeToProto(t valueobject.NotificationType) v1.NotificationType
 		return v1.NotificationType_NOTIFICATION_TYPE_GENERATION_FAILED
 	case valueobject.NotificationTypeApprovalRequested:
 		return v1.NotificationType_NOTIFICATION_TYPE_APPROVAL_REQUESTED
+	case valueobject.NotificationTypeCollaboratorAdded:
+		return v1.NotificationType_NOTIFICATION_TYPE_COLLABORATOR_ADDED
+	case valueobject.NotificationTypeExportReady:
+		return v1.NotificationType_NOTIFICATION_TYPE_EXPORT_READY
+	case valueobject.NotificationTypeOutlineComment:
+		return v1.NotificationType_NOTIFICATION_TYPE_OUTLINE_COMMENT
+	case valueobject.NotificationTypeLessonsOrphaned:
+		return v1.NotificationType_NOTIFICATION_TYPE_LESSONS_ORPHANED
 	default:
 		return v1.NotificationType_NOTIFICATION_TYPE_UNSPECIFIED
 	}
 }
 
+func notificationTypeFromProto(t v1.NotificationType) valueobject.NotificationType {
+	switch t {
+	case v1.NotificationType_NOTIFICATION_TYPE_TASK_ASSIGNED:
+		return valueobject.NotificationTypeTaskAssigned
+	case v1.NotificationType_NOTIFICATION_TYPE_TASK_DUE_SOON:
+		return valueobject.NotificationTypeTaskDueSoon
+	case v1.NotificationType_NOTIFICATION_TYPE_INGESTION_COMPLETE:
+		return valueobject.NotificationTypeIngestionComplete
+	case v1.NotificationType_NOTIFICATION_TYPE_INGESTION_FAILED:
+		return valueobject.NotificationTypeIngestionFailed
+	case v1.NotificationType_NOTIFICATION_TYPE_OUTLINE_READY:
+		return valueobject.NotificationTypeOutlineReady
+	case v1.NotificationType_NOTIFICATION_TYPE_GENERATION_COMPLETE:
+		return valueobject.NotificationTypeGenerationComplete
+	case v1.NotificationType_NOTIFICATION_TYPE_GENERATION_FAILED:
+		return valueobject.NotificationTypeGenerationFailed
+	case v1.NotificationType_NOTIFICATION_TYPE_APPROVAL_REQUESTED:
+		return valueobject.NotificationTypeApprovalRequested
+	case v1.NotificationType_NOTIFICATION_TYPE_COLLABORATOR_ADDED:
+		return valueobject.NotificationTypeCollaboratorAdded
+	case v1.NotificationType_NOTIFICATION_TYPE_EXPORT_READY:
+		return valueobject.NotificationTypeExportReady
+	case v1.NotificationType_NOTIFICATION_TYPE_OUTLINE_COMMENT:
+		return valueobject.NotificationTypeOutlineComment
+	case v1.NotificationType_NOTIFICATION_TYPE_LESSONS_ORPHANED:
+		return valueobject.NotificationTypeLessonsOrphaned
+	default:
+		return ""
+	}
+}
+
 func notificationPriorityToProto(p valueobject.NotificationPriority) v1.NotificationPriority {
 	switch p {
 	case valueobject.NotificationPriorityLow:
@@ -341,3 +460,40 @@ func notificationPriorityToProto(p valueobject.NotificationPriority) v1.Notifica
 		return v1.NotificationPriority_NOTIFICATION_PRIORITY_UNSPECIFIED
 	}
 }
+
+func emailLogEntryToProto(entry *entity.EmailLogEntry) *v1.EmailLogEntry {
+	proto := &v1.EmailLogEntry{
+		Id:              entry.ID.String(),
+		Recipient:       entry.Recipient,
+		Template:        entry.Template,
+		MessageId:       entry.MessageID,
+		Status:          emailDeliveryStatusToProto(entry.Status),
+		SmtpResponse:    entry.SMTPResponse,
+		SentAt:          timestamppb.New(entry.SentAt),
+		StatusUpdatedAt: timestamppb.New(entry.StatusUpdatedAt),
+	}
+	if entry.SMTPCode != nil {
+		code := int32(*entry.SMTPCode)
+		proto.SmtpCode = &code
+	}
+	return proto
+}
+
+func emailDeliveryStatusToProto(s valueobject.EmailDeliveryStatus) v1.EmailDeliveryStatus {
+	switch s {
+	case valueobject.EmailDeliveryStatusAccepted:
+		return v1.EmailDeliveryStatus_EMAIL_DELIVERY_STATUS_ACCEPTED
+	case valueobject.EmailDeliveryStatusDeferred:
+		return v1.EmailDeliveryStatus_EMAIL_DELIVERY_STATUS_DEFERRED
+	case valueobject.EmailDeliveryStatusRejected:
+		return v1.EmailDeliveryStatus_EMAIL_DELIVERY_STATUS_REJECTED
+	case valueobject.EmailDeliveryStatusFailed:
+		return v1.EmailDeliveryStatus_EMAIL_DELIVERY_STATUS_FAILED
+	case valueobject.EmailDeliveryStatusDelivered:
+		return v1.EmailDeliveryStatus_EMAIL_DELIVERY_STATUS_DELIVERED
+	case valueobject.EmailDeliveryStatusBounced:
+		return v1.EmailDeliveryStatus_EMAIL_DELIVERY_STATUS_BOUNCED
+	default:
+		return v1.EmailDeliveryStatus_EMAIL_DELIVERY_STATUS_UNSPECIFIED
+	}
+}
@@ -1,11 +1,17 @@
 package connect
 
 import (
+	"encoding/json"
+	"errors"
+	"expvar"
 	"net/http"
+	"strings"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/sogos/mirai-backend/gen/mirai/v1/miraiv1connect"
 	"github.com/sogos/mirai-backend/internal/application/service"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
 	"github.com/sogos/mirai-backend/internal/domain/repository"
 	domainservice "github.com/sogos/mirai-backend/internal/domain/service"
 	"github.com/sogos/mirai-backend/internal/infrastructure/cache"
@@ -27,6 +33,11 @@ type ServerConfig struct {
 	TenantSettingsService *service.TenantSettingsService
 	NotificationService   *service.NotificationService
 	AIGenerationService   *service.AIGenerationService
+	LMSSyncService        *service.LMSSyncService
+	MaintenanceService    *service.MaintenanceService
+	DiagnosticsService    *service.DiagnosticsService
+	AuditService          *service.AuditService
+	ExportDownloadService *service.ExportDownloadService
 
 	PendingRegRepo         repository.PendingRegistrationRepository
 	UserRepo               repository.UserRepository // For tenant context in auth interceptor
@@ -36,118 +47,157 @@ type ServerConfig struct {
 	Payments               domainservice.PaymentProvider
 	WorkerClient           *worker.Client // For enqueueing background tasks
 	Logger                 domainservice.Logger
+	PayloadLimits          PayloadLimits // Per-RPC request size limits
 	AllowedOrigin          string
 	FrontendURL            string
 }
 
 // NewServeMux creates a new HTTP mux with all Connect service handlers.
 func NewServeMux(cfg ServerConfig) *http.ServeMux {
-	// Create interceptors
-	interceptors := connect.WithInterceptors(
-		NewLoggingInterceptor(cfg.Logger),
-		NewAuthInterceptor(cfg.Identity, cfg.UserRepo, cfg.Cache, cfg.Logger),
+	// Create interceptors. Request bodies are limited per RPC before they are read (see
+	// limitRequestBodies); the read limit bounds decompressed messages.
+	authInterceptor := NewAuthInterceptor(cfg.Identity, cfg.UserRepo, cfg.Cache, cfg.Logger)
+	authInterceptor.SetAPITokens(cfg.UserService)
+	handlerOpts := connect.WithHandlerOptions(
+		connect.WithInterceptors(
+			NewLoggingInterceptor(cfg.Logger),
+			NewClientIPInterceptor(),
+			NewMaintenanceInterceptor(cfg.MaintenanceService),
+			authInterceptor,
+		),
+		connect.WithReadMaxBytes(cfg.PayloadLimits.Max()),
 	)
 
 	mux := http.NewServeMux()
+	handle := func(path string, handler http.Handler) {
+		mux.Handle(path, limitRequestBodies(cfg.PayloadLimits, handler))
+	}
 
 	// Register all service handlers
 	path, handler := miraiv1connect.NewAuthServiceHandler(
 		NewAuthServiceServer(cfg.AuthService),
-		interceptors,
+		handlerOpts,
 	)
-	mux.Handle(path, handler)
+	handle(path, handler)
 
 	path, handler = miraiv1connect.NewUserServiceHandler(
 		NewUserServiceServer(cfg.UserService),
-		interceptors,
+		handlerOpts,
 	)
-	mux.Handle(path, handler)
+	handle(path, handler)
 
 	path, handler = miraiv1connect.NewCompanyServiceHandler(
 		NewCompanyServiceServer(cfg.CompanyService),
-		interceptors,
+		handlerOpts,
 	)
-	mux.Handle(path, handler)
+	handle(path, handler)
 
 	path, handler = miraiv1connect.NewTeamServiceHandler(
 		NewTeamServiceServer(cfg.TeamService),
-		interceptors,
+		handlerOpts,
 	)
-	mux.Handle(path, handler)
+	handle(path, handler)
 
 	path, handler = miraiv1connect.NewBillingServiceHandler(
 		NewBillingServiceServer(cfg.BillingService),
-		interceptors,
+		handlerOpts,
 	)
-	mux.Handle(path, handler)
+	handle(path, handler)
 
 	// InvitationService - team member invitations
 	if cfg.InvitationService != nil {
 		path, handler = miraiv1connect.NewInvitationServiceHandler(
 			NewInvitationServiceServer(cfg.InvitationService),
-			interceptors,
+			handlerOpts,
 		)
-		mux.Handle(path, handler)
+		handle(path, handler)
 	}
 
 	path, handler = miraiv1connect.NewHealthServiceHandler(
-		NewHealthServiceServer(),
-		interceptors,
+		NewHealthServiceServer(cfg.MaintenanceService),
+		handlerOpts,
 	)
-	mux.Handle(path, handler)
+	handle(path, handler)
+
+	// MaintenanceService - read-only maintenance mode switch
+	if cfg.MaintenanceService != nil {
+		path, handler = miraiv1connect.NewMaintenanceServiceHandler(
+			NewMaintenanceServiceServer(cfg.MaintenanceService, cfg.CourseService, cfg.DiagnosticsService, cfg.SMEService),
+			handlerOpts,
+		)
+		handle(path, handler)
+	}
 
 	// CourseService - content management
 	if cfg.CourseService != nil {
 		path, handler = miraiv1connect.NewCourseServiceHandler(
 			NewCourseServiceServer(cfg.CourseService),
-			interceptors,
+			handlerOpts,
 		)
-		mux.Handle(path, handler)
+		handle(path, handler)
 	}
 
 	// SMEService - subject matter expert management
 	if cfg.SMEService != nil {
 		path, handler = miraiv1connect.NewSMEServiceHandler(
 			NewSMEServiceServer(cfg.SMEService),
-			interceptors,
+			handlerOpts,
 		)
-		mux.Handle(path, handler)
+		handle(path, handler)
 	}
 
 	// TargetAudienceService - target audience templates
 	if cfg.TargetAudienceService != nil {
 		path, handler = miraiv1connect.NewTargetAudienceServiceHandler(
 			NewTargetAudienceServiceServer(cfg.TargetAudienceService),
-			interceptors,
+			handlerOpts,
 		)
-		mux.Handle(path, handler)
+		handle(path, handler)
 	}
 
 	// TenantSettingsService - tenant configuration (AI keys, etc.)
 	if cfg.TenantSettingsService != nil {
 		path, handler = miraiv1connect.NewTenantSettingsServiceHandler(
 			NewTenantSettingsServiceServer(cfg.TenantSettingsService),
-			interceptors,
+			handlerOpts,
 		)
-		mux.Handle(path, handler)
+		handle(path, handler)
+	}
+
+	// AuditService - audit log of administrative actions
+	if cfg.AuditService != nil {
+		path, handler = miraiv1connect.NewAuditServiceHandler(
+			NewAuditServiceServer(cfg.AuditService),
+			handlerOpts,
+		)
+		handle(path, handler)
 	}
 
 	// NotificationService - user notifications with real-time streaming
 	if cfg.NotificationService != nil && cfg.NotificationSubscriber != nil {
 		path, handler = miraiv1connect.NewNotificationServiceHandler(
 			NewNotificationServiceServer(cfg.NotificationService, cfg.NotificationSubscriber),
-			interceptors,
+			handlerOpts,
 		)
-		mux.Handle(path, handler)
+		handle(path, handler)
 	}
 
 	// AIGenerationService - AI course/lesson generation
 	if cfg.AIGenerationService != nil {
 		path, handler = miraiv1connect.NewAIGenerationServiceHandler(
 			NewAIGenerationServiceServer(cfg.AIGenerationService),
-			interceptors,
+			handlerOpts,
 		)
-		mux.Handle(path, handler)
+		handle(path, handler)
+	}
+
+	// LMSSyncService - LMS connectors and course sync status
+	if cfg.LMSSyncService != nil {
+		path, handler = miraiv1connect.NewLMSSyncServiceHandler(
+			NewLMSSyncServiceServer(cfg.LMSSyncService),
+			handlerOpts,
+		)
+		handle(path, handler)
 	}
 
 	// Add webhook handler (no interceptors - Stripe handles its own auth)
@@ -187,16 +237,63 @@ func NewServeMux(cfg ServerConfig) *http.ServeMux {
 		http.Redirect(w, r, result.RedirectURL, http.StatusSeeOther)
 	})
 
+	// Export download links from notification emails (no interceptors - the token is the credential).
+	// Each use is logged before redirecting to a short-lived presigned URL.
+	if cfg.ExportDownloadService != nil {
+		mux.HandleFunc(service.ExportDownloadPath, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet && r.Method != http.MethodHead {
+				http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+				return
+			}
+
+			token := strings.TrimPrefix(r.URL.Path, service.ExportDownloadPath)
+			url, err := cfg.ExportDownloadService.ResolveDownloadLink(r.Context(), token, clientIP(r.Header, r.RemoteAddr), r.UserAgent())
+			switch {
+			case err == nil:
+				w.Header().Set("Cache-Control", "no-store")
+				http.Redirect(w, r, url, http.StatusFound)
+			case errors.Is(err, domainerrors.ErrNotFound):
+				http.Error(w, "This download link is not valid.", http.StatusNotFound)
+			case errors.Is(err, domainerrors.ErrForbidden):
+				http.Error(w, "This download link has expired. You can still download the export from the course in Mirai.", http.StatusGone)
+			case errors.Is(err, domainerrors.ErrExportArtifactPurged):
+				http.Error(w, domainerrors.GetDomainError(err).Message, http.StatusGone)
+			default:
+				http.Error(w, "The export could not be downloaded. Please try again later.", http.StatusInternalServerError)
+			}
+		})
+	}
+
 	// Simple health endpoint for Kubernetes probes
 	// (Connect health service is at /mirai.v1.HealthService/Check but k8s expects /health)
+	// Maintenance mode is reported but never fails the probe: the API stays up for reads.
 	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
+		payload := healthPayload{Status: "ok"}
+		if cfg.MaintenanceService != nil {
+			status := cfg.MaintenanceService.Status(r.Context())
+			payload.Maintenance = status.Enabled
+			if status.Enabled {
+				payload.MaintenanceEndsAt = &status.EndsAt
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
-		w.Write([]byte("ok"))
+		json.NewEncoder(w).Encode(payload)
 	})
 
+	// Process counters for scraping, e.g. gemini_structured_responses per model
+	mux.Handle("/debug/vars", expvar.Handler())
+
 	return mux
 }
 
+// healthPayload is the JSON body of the /health endpoint.
+type healthPayload struct {
+	Status            string     `json:"status"`
+	Maintenance       bool       `json:"maintenance"`
+	MaintenanceEndsAt *time.Time `json:"maintenance_ends_at,omitempty"`
+}
+
 // CORSMiddleware wraps an http.Handler with CORS support.
 func CORSMiddleware(allowedOrigin string, h http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -2,6 +2,7 @@ package connect
 
 import (
 	"context"
+	"sort"
 	"time"
 
 	"connectrpc.com/connect"
@@ -192,13 +193,49 @@ func (s *SMEServiceServer) DeleteSME(
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	if err := s.smeService.DeleteSME(ctx, kratosID, smeID); err != nil {
+	if err := s.smeService.DeleteSME(ctx, kratosID, smeID, req.Msg.ConfirmationToken); err != nil {
 		return nil, toConnectError(err)
 	}
 
 	return connect.NewResponse(&v1.DeleteSMEResponse{}), nil
 }
 
+// GetSMEDeletionImpact counts what depends on an SME and issues a deletion confirmation token.
+func (s *SMEServiceServer) GetSMEDeletionImpact(
+	ctx context.Context,
+	req *connect.Request[v1.GetSMEDeletionImpactRequest],
+) (*connect.Response[v1.GetSMEDeletionImpactResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	smeID, err := parseUUID(req.Msg.SmeId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	impact, token, err := s.smeService.GetSMEDeletionImpact(ctx, kratosID, smeID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.GetSMEDeletionImpactResponse{
+		KnowledgeChunks:    int32(impact.KnowledgeChunks),
+		PendingTasks:       int32(impact.PendingTasks),
+		Submissions:        int32(impact.Submissions),
+		Courses:            int32(impact.Courses),
+		RecentCourseTitles: impact.RecentCourseTitles,
+		ConfirmationToken:  token.Token,
+		ExpiresAt:          timestamppb.New(token.ExpiresAt),
+	}), nil
+}
+
 // RestoreSME restores an archived SME entity.
 func (s *SMEServiceServer) RestoreSME(
 	ctx context.Context,
@@ -281,6 +318,7 @@ func (s *SMEServiceServer) CreateTask(
 		DueDate:             dueDate,
 		AssignedToUserID:    assignedToUserID,
 		TeamID:              teamID,
+		Questions:           req.Msg.Questions,
 	}
 
 	task, err := s.smeService.CreateTask(ctx, kratosID, createReq)
@@ -313,13 +351,14 @@ func (s *SMEServiceServer) GetTask(
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	task, err := s.smeService.GetTask(ctx, kratosID, taskID)
+	task, summary, err := s.smeService.GetTask(ctx, kratosID, taskID)
 	if err != nil {
 		return nil, toConnectError(err)
 	}
 
 	return connect.NewResponse(&v1.GetTaskResponse{
-		Task: taskToProto(task),
+		Task:              taskToProto(task),
+		SubmissionSummary: submissionSummaryToProto(summary),
 	}), nil
 }
 
@@ -441,7 +480,7 @@ func (s *SMEServiceServer) CancelTask(
 	}
 
 	// Fetch the updated task to return
-	task, err := s.smeService.GetTask(ctx, kratosID, taskID)
+	task, _, err := s.smeService.GetTask(ctx, kratosID, taskID)
 	if err != nil {
 		return nil, toConnectError(err)
 	}
@@ -483,6 +522,124 @@ func (s *SMEServiceServer) GetUploadURL(
 	}), nil
 }
 
+// StartMultipartUpload starts a resumable upload for large files.
+func (s *SMEServiceServer) StartMultipartUpload(
+	ctx context.Context,
+	req *connect.Request[v1.StartMultipartUploadRequest],
+) (*connect.Response[v1.StartMultipartUploadResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	taskID, err := parseUUID(req.Msg.TaskId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	upload, err := s.smeService.StartMultipartUpload(ctx, kratosID, service.StartMultipartUploadRequest{
+		TaskID:        taskID,
+		FileName:      req.Msg.FileName,
+		FileSizeBytes: req.Msg.FileSizeBytes,
+	})
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.StartMultipartUploadResponse{
+		UploadId:      upload.UploadID,
+		FilePath:      upload.FilePath,
+		PartSizeBytes: upload.PartSizeBytes,
+		PartCount:     upload.PartCount,
+	}), nil
+}
+
+// GetPartUploadURLs returns presigned URLs for parts of a multipart upload.
+func (s *SMEServiceServer) GetPartUploadURLs(
+	ctx context.Context,
+	req *connect.Request[v1.GetPartUploadURLsRequest],
+) (*connect.Response[v1.GetPartUploadURLsResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	taskID, err := parseUUID(req.Msg.TaskId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	result, err := s.smeService.GetPartUploadURLs(ctx, kratosID, service.GetPartUploadURLsRequest{
+		TaskID:        taskID,
+		FilePath:      req.Msg.FilePath,
+		UploadID:      req.Msg.UploadId,
+		FileSizeBytes: req.Msg.FileSizeBytes,
+		PartNumbers:   req.Msg.PartNumbers,
+	})
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	parts := make([]*v1.PartUploadURL, 0, len(req.Msg.PartNumbers))
+	for _, n := range req.Msg.PartNumbers {
+		parts = append(parts, &v1.PartUploadURL{
+			PartNumber: n,
+			UploadUrl:  result.URLs[n],
+		})
+	}
+
+	return connect.NewResponse(&v1.GetPartUploadURLsResponse{
+		Parts:               parts,
+		UploadedPartNumbers: result.UploadedParts,
+		ExpiresAt:           timestamppb.New(result.ExpiresAt),
+	}), nil
+}
+
+// CompleteMultipartUpload validates the uploaded parts and assembles the file.
+func (s *SMEServiceServer) CompleteMultipartUpload(
+	ctx context.Context,
+	req *connect.Request[v1.CompleteMultipartUploadRequest],
+) (*connect.Response[v1.CompleteMultipartUploadResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	taskID, err := parseUUID(req.Msg.TaskId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	filePath, err := s.smeService.CompleteMultipartUpload(ctx, kratosID, service.CompleteMultipartUploadRequest{
+		TaskID:        taskID,
+		FilePath:      req.Msg.FilePath,
+		UploadID:      req.Msg.UploadId,
+		FileSizeBytes: req.Msg.FileSizeBytes,
+	})
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.CompleteMultipartUploadResponse{
+		FilePath: filePath,
+	}), nil
+}
+
 // SubmitContent records a content submission for a task.
 func (s *SMEServiceServer) SubmitContent(
 	ctx context.Context,
@@ -522,7 +679,68 @@ func (s *SMEServiceServer) SubmitContent(
 	}), nil
 }
 
-// ListSubmissions returns all submissions for a task.
+// GenerateTaskQuestions drafts interview questions for a task assigned to an SME.
+func (s *SMEServiceServer) GenerateTaskQuestions(
+	ctx context.Context,
+	req *connect.Request[v1.GenerateTaskQuestionsRequest],
+) (*connect.Response[v1.GenerateTaskQuestionsResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	smeID, err := parseUUID(req.Msg.SmeId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	result, err := s.smeService.GenerateTaskQuestions(ctx, kratosID, smeID, req.Msg.DesiredOutcome)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.GenerateTaskQuestionsResponse{
+		Questions:  result.Questions,
+		TokensUsed: result.TokensUsed,
+	}), nil
+}
+
+// SubmitAnswers submits answers to an interview task's questions as a text submission.
+func (s *SMEServiceServer) SubmitAnswers(
+	ctx context.Context,
+	req *connect.Request[v1.SubmitAnswersRequest],
+) (*connect.Response[v1.SubmitAnswersResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	taskID, err := parseUUID(req.Msg.TaskId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	submission, err := s.smeService.SubmitAnswers(ctx, kratosID, taskID, req.Msg.Answers)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.SubmitAnswersResponse{
+		Submission: submissionToProto(submission),
+	}), nil
+}
+
+// ListSubmissions returns a page of a task's submissions.
 func (s *SMEServiceServer) ListSubmissions(
 	ctx context.Context,
 	req *connect.Request[v1.ListSubmissionsRequest],
@@ -542,19 +760,35 @@ func (s *SMEServiceServer) ListSubmissions(
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	submissions, err := s.smeService.ListSubmissions(ctx, kratosID, taskID)
+	listReq := service.ListSubmissionsRequest{
+		TaskID: taskID,
+		Limit:  int(req.Msg.Limit),
+		Cursor: req.Msg.GetCursor(),
+	}
+	if req.Msg.Status != nil {
+		if status := protoToSubmissionStatus(*req.Msg.Status); status != "" {
+			listReq.Status = &status
+		}
+	}
+
+	result, err := s.smeService.ListSubmissions(ctx, kratosID, listReq)
 	if err != nil {
 		return nil, toConnectError(err)
 	}
 
-	protoSubmissions := make([]*v1.SMETaskSubmission, len(submissions))
-	for i, sub := range submissions {
+	protoSubmissions := make([]*v1.SMETaskSubmission, len(result.Submissions))
+	for i, sub := range result.Submissions {
 		protoSubmissions[i] = submissionToProto(sub)
 	}
 
-	return connect.NewResponse(&v1.ListSubmissionsResponse{
+	resp := &v1.ListSubmissionsResponse{
 		Submissions: protoSubmissions,
-	}), nil
+	}
+	if result.NextCursor != "" {
+		resp.NextCursor = &result.NextCursor
+	}
+
+	return connect.NewResponse(resp), nil
 }
 
 // GetKnowledge returns distilled knowledge for an SME.
@@ -577,7 +811,7 @@ func (s *SMEServiceServer) GetKnowledge(
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	chunks, err := s.smeService.GetKnowledge(ctx, kratosID, smeID)
+	chunks, err := s.smeService.GetKnowledge(ctx, kratosID, smeID, req.Msg.Topic)
 	if err != nil {
 		return nil, toConnectError(err)
 	}
@@ -592,6 +826,44 @@ func (s *SMEServiceServer) GetKnowledge(
 	}), nil
 }
 
+// ListKnowledgeTopics returns an SME's knowledge topics with chunk counts.
+func (s *SMEServiceServer) ListKnowledgeTopics(
+	ctx context.Context,
+	req *connect.Request[v1.ListKnowledgeTopicsRequest],
+) (*connect.Response[v1.ListKnowledgeTopicsResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	smeID, err := parseUUID(req.Msg.SmeId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	topics, err := s.smeService.ListKnowledgeTopics(ctx, kratosID, smeID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	protoTopics := make([]*v1.KnowledgeTopic, len(topics))
+	for i, topic := range topics {
+		protoTopics[i] = &v1.KnowledgeTopic{
+			Topic:      topic.Topic,
+			ChunkCount: int32(topic.ChunkCount),
+		}
+	}
+
+	return connect.NewResponse(&v1.ListKnowledgeTopicsResponse{
+		Topics: protoTopics,
+	}), nil
+}
+
 // SearchKnowledge searches across SME knowledge.
 func (s *SMEServiceServer) SearchKnowledge(
 	ctx context.Context,
@@ -601,6 +873,40 @@ func (s *SMEServiceServer) SearchKnowledge(
 	return nil, connect.NewError(connect.CodeUnimplemented, errUnauthenticated)
 }
 
+// SuggestSMEs suggests SMEs for a course goal.
+func (s *SMEServiceServer) SuggestSMEs(
+	ctx context.Context,
+	req *connect.Request[v1.SuggestSMEsRequest],
+) (*connect.Response[v1.SuggestSMEsResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	suggestions, err := s.smeService.SuggestSMEs(ctx, kratosID, req.Msg.CourseTitle, req.Msg.DesiredOutcome)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	protoSuggestions := make([]*v1.SMESuggestion, len(suggestions))
+	for i, suggestion := range suggestions {
+		protoSuggestions[i] = &v1.SMESuggestion{
+			Sme:            smeToProto(suggestion.SME),
+			RelevanceScore: float32(suggestion.Relevance),
+			MatchedTerms:   suggestion.MatchedTerms,
+		}
+	}
+
+	return connect.NewResponse(&v1.SuggestSMEsResponse{
+		Suggestions: protoSuggestions,
+	}), nil
+}
+
 // GetSubmission returns a specific submission by ID.
 func (s *SMEServiceServer) GetSubmission(
 	ctx context.Context,
@@ -631,6 +937,70 @@ func (s *SMEServiceServer) GetSubmission(
 	}), nil
 }
 
+// ReprocessSubmission retries ingestion of a failed submission.
+func (s *SMEServiceServer) ReprocessSubmission(
+	ctx context.Context,
+	req *connect.Request[v1.ReprocessSubmissionRequest],
+) (*connect.Response[v1.ReprocessSubmissionResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	submissionID, err := parseUUID(req.Msg.SubmissionId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	submission, job, err := s.smeService.ReprocessSubmission(ctx, kratosID, service.ReprocessSubmissionRequest{
+		SubmissionID:        submissionID,
+		ReplacementFilePath: req.Msg.ReplacementFilePath,
+	})
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.ReprocessSubmissionResponse{
+		Submission: submissionToProto(submission),
+		JobId:      job.ID.String(),
+	}), nil
+}
+
+// GetSubmissionStatus returns how far a submission's ingestion has got.
+func (s *SMEServiceServer) GetSubmissionStatus(
+	ctx context.Context,
+	req *connect.Request[v1.GetSubmissionStatusRequest],
+) (*connect.Response[v1.GetSubmissionStatusResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	submissionID, err := parseUUID(req.Msg.SubmissionId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	progress, err := s.smeService.GetSubmissionStatus(ctx, kratosID, submissionID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.GetSubmissionStatusResponse{
+		Progress: submissionProgressToProto(progress),
+	}), nil
+}
+
 // ApproveSubmission approves content and creates knowledge chunks.
 func (s *SMEServiceServer) ApproveSubmission(
 	ctx context.Context,
@@ -807,6 +1177,39 @@ func (s *SMEServiceServer) DeleteKnowledgeChunk(
 	return connect.NewResponse(&v1.DeleteKnowledgeChunkResponse{}), nil
 }
 
+// ReviewFlaggedKnowledgeChunk releases or holds back a chunk flagged as possible prompt injection.
+func (s *SMEServiceServer) ReviewFlaggedKnowledgeChunk(
+	ctx context.Context,
+	req *connect.Request[v1.ReviewFlaggedKnowledgeChunkRequest],
+) (*connect.Response[v1.ReviewFlaggedKnowledgeChunkResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	chunkID, err := parseUUID(req.Msg.ChunkId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	chunk, err := s.smeService.ReviewFlaggedKnowledgeChunk(ctx, kratosID, service.ReviewFlaggedKnowledgeChunkRequest{
+		ChunkID: chunkID,
+		Release: req.Msg.Release,
+	})
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.ReviewFlaggedKnowledgeChunkResponse{
+		Chunk: knowledgeChunkToProto(chunk),
+	}), nil
+}
+
 // DeleteTask permanently removes a task.
 func (s *SMEServiceServer) DeleteTask(
 	ctx context.Context,
@@ -834,8 +1237,180 @@ func (s *SMEServiceServer) DeleteTask(
 	return connect.NewResponse(&v1.DeleteTaskResponse{}), nil
 }
 
+// GetSMEStats returns contribution and knowledge coverage stats per SME.
+func (s *SMEServiceServer) GetSMEStats(
+	ctx context.Context,
+	req *connect.Request[v1.GetSMEStatsRequest],
+) (*connect.Response[v1.GetSMEStatsResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	entries, minChunks, err := s.smeService.GetSMEStats(ctx, kratosID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	protoStats := make([]*v1.SMEStats, len(entries))
+	for i, e := range entries {
+		protoStats[i] = smeStatsToProto(e)
+	}
+
+	return connect.NewResponse(&v1.GetSMEStatsResponse{
+		Stats:              protoStats,
+		MinKnowledgeChunks: int32(minChunks),
+	}), nil
+}
+
+// ExportSMEKnowledge queues an export of an SME's knowledge to an archive.
+func (s *SMEServiceServer) ExportSMEKnowledge(
+	ctx context.Context,
+	req *connect.Request[v1.ExportSMEKnowledgeRequest],
+) (*connect.Response[v1.ExportSMEKnowledgeResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	smeID, err := parseUUID(req.Msg.SmeId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	job, err := s.smeService.ExportSMEKnowledge(ctx, kratosID, smeID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.ExportSMEKnowledgeResponse{
+		JobId: job.ID.String(),
+	}), nil
+}
+
+// GetKnowledgeImportUploadURL returns a presigned URL for uploading a knowledge archive.
+func (s *SMEServiceServer) GetKnowledgeImportUploadURL(
+	ctx context.Context,
+	req *connect.Request[v1.GetKnowledgeImportUploadURLRequest],
+) (*connect.Response[v1.GetKnowledgeImportUploadURLResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	url, path, err := s.smeService.GetKnowledgeImportUploadURL(ctx, kratosID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.GetKnowledgeImportUploadURLResponse{
+		UploadUrl: url,
+		FilePath:  path,
+	}), nil
+}
+
+// ImportSMEKnowledge queues an import of an uploaded knowledge archive.
+func (s *SMEServiceServer) ImportSMEKnowledge(
+	ctx context.Context,
+	req *connect.Request[v1.ImportSMEKnowledgeRequest],
+) (*connect.Response[v1.ImportSMEKnowledgeResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	importReq := service.ImportSMEKnowledgeRequest{
+		ArchivePath: req.Msg.FilePath,
+	}
+	if req.Msg.TargetSmeId != nil {
+		targetID, err := parseUUID(*req.Msg.TargetSmeId)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		importReq.TargetSMEID = &targetID
+	}
+
+	sme, job, err := s.smeService.ImportSMEKnowledge(ctx, kratosID, importReq)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.ImportSMEKnowledgeResponse{
+		Sme:   smeToProto(sme),
+		JobId: job.ID.String(),
+	}), nil
+}
+
+// submissionProgressToProto converts an entity.SubmissionProgress to a v1.SubmissionProgress proto.
+func submissionProgressToProto(p *entity.SubmissionProgress) *v1.SubmissionProgress {
+	proto := &v1.SubmissionProgress{
+		SubmissionId:    p.SubmissionID.String(),
+		TaskId:          p.TaskID.String(),
+		Status:          submissionProcessingStatusToProto(p.Status),
+		ProgressPercent: p.ProgressPercent,
+		ProgressMessage: p.ProgressMessage,
+		Error:           p.Error,
+		UpdatedAt:       timestamppb.New(p.UpdatedAt),
+	}
+	if p.JobID != nil {
+		jobID := p.JobID.String()
+		proto.JobId = &jobID
+	}
+	return proto
+}
+
 // Helper functions for proto conversion
 
+func smeStatsToProto(e service.SMEStatsEntry) *v1.SMEStats {
+	stats := &v1.SMEStats{
+		SmeId:                e.SME.ID.String(),
+		SmeName:              e.SME.Name,
+		SmeStatus:            smeStatusToProto(e.SME.Status),
+		SubmissionsProcessed: int32(e.Stats.SubmissionsProcessed),
+		SubmissionsFailed:    int32(e.Stats.SubmissionsFailed),
+		ChunkCount:           int32(e.Stats.ChunkCount),
+		ExtractedCharacters:  e.Stats.ExtractedCharacters,
+		CourseCount:          int32(e.Stats.CourseCount),
+		LowCoverage:          e.LowCoverage,
+	}
+
+	for status, count := range e.Stats.TaskCounts {
+		stats.TaskCounts = append(stats.TaskCounts, &v1.SMETaskStatusCount{
+			Status: taskStatusToProto(status),
+			Count:  int32(count),
+		})
+	}
+	sort.Slice(stats.TaskCounts, func(i, j int) bool {
+		return stats.TaskCounts[i].Status < stats.TaskCounts[j].Status
+	})
+
+	if e.Stats.LastIngestedAt != nil {
+		stats.LastIngestedAt = timestamppb.New(*e.Stats.LastIngestedAt)
+	}
+
+	return stats
+}
+
 func smeToProto(sme *entity.SubjectMatterExpert) *v1.SubjectMatterExpert {
 	if sme == nil {
 		return nil
@@ -869,6 +1444,7 @@ func smeToProto(sme *entity.SubjectMatterExpert) *v1.SubjectMatterExpert {
 		CreatedByUserId:      sme.CreatedByUserID.String(),
 		CreatedAt:            timestamppb.New(sme.CreatedAt),
 		UpdatedAt:            timestamppb.New(sme.UpdatedAt),
+		CreatedByActive:      sme.CreatorActive,
 	}
 }
 
@@ -911,6 +1487,7 @@ func taskToProto(task *entity.SMETask) *v1.SMETask {
 		CreatedAt:           timestamppb.New(task.CreatedAt),
 		UpdatedAt:           timestamppb.New(task.UpdatedAt),
 		CompletedAt:         completedAt,
+		Questions:           task.Questions,
 	}
 }
 
@@ -952,9 +1529,35 @@ func submissionToProto(sub *entity.SMETaskSubmission) *v1.SMETaskSubmission {
 		IsApproved:        sub.IsApproved,
 		ApprovedAt:        approvedAt,
 		ApprovedByUserId:  approvedByUserID,
+		Status:            submissionStatusToProto(sub.Status()),
 	}
 }
 
+func submissionSummaryToProto(summary *entity.SMESubmissionSummary) *v1.SubmissionSummary {
+	if summary == nil {
+		return nil
+	}
+
+	proto := &v1.SubmissionSummary{
+		TotalCount: int32(summary.TotalCount),
+	}
+	for status, count := range summary.StatusCounts {
+		proto.StatusCounts = append(proto.StatusCounts, &v1.SubmissionStatusCount{
+			Status: submissionStatusToProto(status),
+			Count:  int32(count),
+		})
+	}
+	sort.Slice(proto.StatusCounts, func(i, j int) bool {
+		return proto.StatusCounts[i].Status < proto.StatusCounts[j].Status
+	})
+
+	if summary.LatestSubmittedAt != nil {
+		proto.LatestSubmittedAt = timestamppb.New(*summary.LatestSubmittedAt)
+	}
+
+	return proto
+}
+
 func knowledgeChunkToProto(chunk *entity.SMEKnowledgeChunk) *v1.SMEKnowledgeChunk {
 	if chunk == nil {
 		return nil
@@ -966,15 +1569,23 @@ func knowledgeChunkToProto(chunk *entity.SMEKnowledgeChunk) *v1.SMEKnowledgeChun
 		submissionID = &s
 	}
 
+	var injectionReleasedAt *timestamppb.Timestamp
+	if chunk.InjectionReleasedAt != nil {
+		injectionReleasedAt = timestamppb.New(*chunk.InjectionReleasedAt)
+	}
+
 	return &v1.SMEKnowledgeChunk{
-		Id:             chunk.ID.String(),
-		SmeId:          chunk.SMEID.String(),
-		SubmissionId:   submissionID,
-		Content:        chunk.Content,
-		Topic:          chunk.Topic,
-		Keywords:       chunk.Keywords,
-		RelevanceScore: chunk.RelevanceScore,
-		CreatedAt:      timestamppb.New(chunk.CreatedAt),
+		Id:                  chunk.ID.String(),
+		SmeId:               chunk.SMEID.String(),
+		SubmissionId:        submissionID,
+		Content:             chunk.Content,
+		Topic:               chunk.Topic,
+		Keywords:            chunk.Keywords,
+		RelevanceScore:      chunk.RelevanceScore,
+		CreatedAt:           timestamppb.New(chunk.CreatedAt),
+		InjectionFlagged:    chunk.InjectionFlagged,
+		InjectionReason:     chunk.InjectionReason,
+		InjectionReleasedAt: injectionReleasedAt,
 	}
 }
 
@@ -1038,6 +1649,54 @@ func taskStatusToProto(status valueobject.SMETaskStatus) v1.SMETaskStatus {
 	}
 }
 
+func submissionStatusToProto(status valueobject.SubmissionStatus) v1.SubmissionStatus {
+	switch status {
+	case valueobject.SubmissionStatusPendingReview:
+		return v1.SubmissionStatus_SUBMISSION_STATUS_PENDING_REVIEW
+	case valueobject.SubmissionStatusProcessed:
+		return v1.SubmissionStatus_SUBMISSION_STATUS_PROCESSED
+	case valueobject.SubmissionStatusFailed:
+		return v1.SubmissionStatus_SUBMISSION_STATUS_FAILED
+	case valueobject.SubmissionStatusApproved:
+		return v1.SubmissionStatus_SUBMISSION_STATUS_APPROVED
+	default:
+		return v1.SubmissionStatus_SUBMISSION_STATUS_UNSPECIFIED
+	}
+}
+
+func submissionProcessingStatusToProto(status valueobject.SubmissionProcessingStatus) v1.SubmissionProcessingStatus {
+	switch status {
+	case valueobject.SubmissionProcessingNotStarted:
+		return v1.SubmissionProcessingStatus_SUBMISSION_PROCESSING_STATUS_NOT_STARTED
+	case valueobject.SubmissionProcessingQueued:
+		return v1.SubmissionProcessingStatus_SUBMISSION_PROCESSING_STATUS_QUEUED
+	case valueobject.SubmissionProcessingProcessing:
+		return v1.SubmissionProcessingStatus_SUBMISSION_PROCESSING_STATUS_PROCESSING
+	case valueobject.SubmissionProcessingCompleted:
+		return v1.SubmissionProcessingStatus_SUBMISSION_PROCESSING_STATUS_COMPLETED
+	case valueobject.SubmissionProcessingFailed:
+		return v1.SubmissionProcessingStatus_SUBMISSION_PROCESSING_STATUS_FAILED
+	default:
+		return v1.SubmissionProcessingStatus_SUBMISSION_PROCESSING_STATUS_UNSPECIFIED
+	}
+}
+
+// protoToSubmissionStatus returns "" for SUBMISSION_STATUS_UNSPECIFIED.
+func protoToSubmissionStatus(status v1.SubmissionStatus) valueobject.SubmissionStatus {
+	switch status {
+	case v1.SubmissionStatus_SUBMISSION_STATUS_PENDING_REVIEW:
+		return valueobject.SubmissionStatusPendingReview
+	case v1.SubmissionStatus_SUBMISSION_STATUS_PROCESSED:
+		return valueobject.SubmissionStatusProcessed
+	case v1.SubmissionStatus_SUBMISSION_STATUS_FAILED:
+		return valueobject.SubmissionStatusFailed
+	case v1.SubmissionStatus_SUBMISSION_STATUS_APPROVED:
+		return valueobject.SubmissionStatusApproved
+	default:
+		return ""
+	}
+}
+
 func contentTypeToProto(ct valueobject.ContentType) v1.ContentType {
 	switch ct {
 	case valueobject.ContentTypeDocument:
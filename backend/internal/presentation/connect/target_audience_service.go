@@ -271,6 +271,32 @@ func (s *TargetAudienceServiceServer) RestoreTemplate(
 	}), nil
 }
 
+// GenerateTargetAudience drafts a target audience from a job description. Nothing is saved.
+func (s *TargetAudienceServiceServer) GenerateTargetAudience(
+	ctx context.Context,
+	req *connect.Request[v1.GenerateTargetAudienceRequest],
+) (*connect.Response[v1.GenerateTargetAudienceResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	result, err := s.audienceService.GenerateTargetAudience(ctx, kratosID, req.Msg.JobDescription)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.GenerateTargetAudienceResponse{
+		Draft:      targetAudienceDraftToProto(result.Draft),
+		TokensUsed: result.TokensUsed,
+	}), nil
+}
+
 // Helper functions for proto conversion
 
 func targetAudienceToProto(aud *entity.TargetAudienceTemplate) *v1.TargetAudienceTemplate {
@@ -307,6 +333,26 @@ func targetAudienceToProto(aud *entity.TargetAudienceTemplate) *v1.TargetAudienc
 	}
 }
 
+// targetAudienceDraftToProto converts an unsaved audience, leaving the ID, creator and
+// timestamps empty.
+func targetAudienceDraftToProto(aud *entity.TargetAudienceTemplate) *v1.TargetAudienceTemplate {
+	return &v1.TargetAudienceTemplate{
+		TenantId:          aud.TenantID.String(),
+		CompanyId:         aud.CompanyID.String(),
+		Name:              aud.Name,
+		Description:       aud.Description,
+		Role:              aud.Role,
+		ExperienceLevel:   experienceLevelToProto(aud.ExperienceLevel),
+		LearningGoals:     aud.LearningGoals,
+		Prerequisites:     aud.Prerequisites,
+		Challenges:        aud.Challenges,
+		Motivations:       aud.Motivations,
+		IndustryContext:   aud.IndustryContext,
+		TypicalBackground: aud.TypicalBackground,
+		Status:            targetAudienceStatusToProto(aud.Status),
+	}
+}
+
 func experienceLevelToProto(level valueobject.ExperienceLevel) v1.ExperienceLevel {
 	switch level {
 	case valueobject.ExperienceLevelBeginner:
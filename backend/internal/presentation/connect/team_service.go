@@ -169,11 +169,45 @@ func (s *TeamServiceServer) DeleteTeam(
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	if err := s.teamService.DeleteTeam(ctx, kratosID, teamID); err != nil {
+	deleteReq := dto.DeleteTeamRequest{
+		Detach: req.Msg.Detach,
+		DryRun: req.Msg.DryRun,
+	}
+	if req.Msg.ReassignToTeamId != nil {
+		reassignTo, err := parseUUID(*req.Msg.ReassignToTeamId)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		deleteReq.ReassignToTeamID = &reassignTo
+	}
+
+	result, err := s.teamService.DeleteTeam(ctx, kratosID, teamID, deleteReq)
+	if err != nil {
 		return nil, toConnectError(err)
 	}
 
-	return connect.NewResponse(&v1.DeleteTeamResponse{}), nil
+	resp := &v1.DeleteTeamResponse{
+		Deleted: result.Deleted,
+		Smes:    make([]*v1.TeamDeletionSME, len(result.SMEs)),
+		Tasks:   make([]*v1.TeamDeletionTask, len(result.Tasks)),
+	}
+	for i, sme := range result.SMEs {
+		resp.Smes[i] = &v1.TeamDeletionSME{
+			SmeId:   sme.SMEID.String(),
+			Name:    sme.Name,
+			Outcome: teamDeletionOutcomeToProto(sme.Outcome),
+		}
+	}
+	for i, task := range result.Tasks {
+		resp.Tasks[i] = &v1.TeamDeletionTask{
+			TaskId:           task.TaskID.String(),
+			Title:            task.Title,
+			AssignedToUserId: task.AssignedToUserID.String(),
+			Outcome:          teamDeletionOutcomeToProto(task.Outcome),
+		}
+	}
+
+	return connect.NewResponse(resp), nil
 }
 
 // ListTeamMembers returns all members of a team.
@@ -283,6 +317,60 @@ func (s *TeamServiceServer) RemoveTeamMember(
 	return connect.NewResponse(&v1.RemoveTeamMemberResponse{}), nil
 }
 
+// GetTeamDashboard summarizes a team's workload.
+func (s *TeamServiceServer) GetTeamDashboard(
+	ctx context.Context,
+	req *connect.Request[v1.GetTeamDashboardRequest],
+) (*connect.Response[v1.GetTeamDashboardResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	teamID, err := parseUUID(req.Msg.TeamId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	dashboard, err := s.teamService.GetTeamDashboard(ctx, kratosID, teamID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	resp := &v1.GetTeamDashboardResponse{
+		TaskCounts:         make([]*v1.TeamTaskStatusCount, len(dashboard.TaskCounts)),
+		OpenTasks:          int32(dashboard.OpenTasks),
+		OverdueTasks:       int32(dashboard.OverdueTasks),
+		ActiveJobs:         make([]*v1.GenerationJob, len(dashboard.ActiveJobs)),
+		RecentCourses:      make([]*v1.LibraryEntry, len(dashboard.RecentCourses)),
+		PendingInvitations: make([]*v1.Invitation, len(dashboard.PendingInvitations)),
+		GeneratedAt:        timestamppb.New(dashboard.GeneratedAt),
+	}
+	for i, c := range dashboard.TaskCounts {
+		resp.TaskCounts[i] = &v1.TeamTaskStatusCount{
+			Status:  taskStatusToProto(c.Status),
+			Count:   int32(c.Count),
+			Overdue: int32(c.Overdue),
+		}
+	}
+	for i, job := range dashboard.ActiveJobs {
+		resp.ActiveJobs[i] = generationJobToProto(job)
+	}
+	for i := range dashboard.RecentCourses {
+		resp.RecentCourses[i] = libraryEntryToProto(&dashboard.RecentCourses[i])
+	}
+	for i, inv := range dashboard.PendingInvitations {
+		resp.PendingInvitations[i] = invitationToProto(inv)
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
 // Helper functions
 
 func teamToProto(t *dto.TeamResponse) *v1.Team {
@@ -334,3 +422,20 @@ func teamRoleFromProto(r v1.TeamRole) valueobject.TeamRole {
 		return valueobject.TeamRoleMember
 	}
 }
+
+func teamDeletionOutcomeToProto(outcome string) v1.TeamDeletionOutcome {
+	switch outcome {
+	case dto.TeamDeletionOutcomeUnresolved:
+		return v1.TeamDeletionOutcome_TEAM_DELETION_OUTCOME_UNRESOLVED
+	case dto.TeamDeletionOutcomeReassigned:
+		return v1.TeamDeletionOutcome_TEAM_DELETION_OUTCOME_REASSIGNED
+	case dto.TeamDeletionOutcomeDetached:
+		return v1.TeamDeletionOutcome_TEAM_DELETION_OUTCOME_DETACHED
+	case dto.TeamDeletionOutcomeMadeGlobal:
+		return v1.TeamDeletionOutcome_TEAM_DELETION_OUTCOME_MADE_GLOBAL
+	case dto.TeamDeletionOutcomeCancelled:
+		return v1.TeamDeletionOutcome_TEAM_DELETION_OUTCOME_CANCELLED
+	default:
+		return v1.TeamDeletionOutcome_TEAM_DELETION_OUTCOME_UNSPECIFIED
+	}
+}
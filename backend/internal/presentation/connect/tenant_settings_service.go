@@ -3,6 +3,7 @@ package connect
 import (
 	"context"
 	"errors"
+	"time"
 
 	"connectrpc.com/connect"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -10,10 +11,16 @@ import (
 	v1 "github.com/sogos/mirai-backend/gen/mirai/v1"
 	"github.com/sogos/mirai-backend/gen/mirai/v1/miraiv1connect"
 	"github.com/sogos/mirai-backend/internal/application/service"
+	"github.com/sogos/mirai-backend/internal/domain/entity"
 	"github.com/sogos/mirai-backend/internal/domain/valueobject"
 )
 
-var errMissingAPIKey = errors.New("API key is required")
+var (
+	errMissingAPIKey             = errors.New("API key is required")
+	errMissingGenerationDefaults = errors.New("generation defaults are required")
+	errMissingCourseDefaults     = errors.New("course defaults are required")
+	errMissingWeeklySummary      = errors.New("weekly summary schedule is required")
+)
 
 // TenantSettingsServiceServer implements the TenantSettingsService Connect handler.
 type TenantSettingsServiceServer struct {
@@ -49,13 +56,20 @@ func (s *TenantSettingsServiceServer) GetAISettings(
 	settings := result.Settings
 	return connect.NewResponse(&v1.GetAISettingsResponse{
 		Settings: &v1.TenantAISettings{
-			TenantId:          settings.TenantID.String(),
-			Provider:          aiProviderToProto(settings.Provider),
-			ApiKeyConfigured:  settings.EncryptedAPIKey != nil && len(settings.EncryptedAPIKey) > 0,
-			TotalTokensUsed:   settings.TotalTokensUsed,
-			MonthlyTokenLimit: settings.MonthlyTokenLimit,
-			UpdatedAt:         timestamppb.New(settings.UpdatedAt),
-			UpdatedByUserId:   uuidPtrToString(settings.UpdatedByUserID),
+			TenantId:                settings.TenantID.String(),
+			Provider:                aiProviderToProto(settings.Provider),
+			ApiKeyConfigured:        settings.EncryptedAPIKey != nil && len(settings.EncryptedAPIKey) > 0,
+			TotalTokensUsed:         settings.TotalTokensUsed,
+			MonthlyTokenLimit:       settings.MonthlyTokenLimit,
+			UpdatedAt:               timestamppb.New(settings.UpdatedAt),
+			UpdatedByUserId:         uuidPtrToString(settings.UpdatedByUserID),
+			GenerationDefaults:      generationPreferencesToProto(settings.GenerationDefaults),
+			AllowOutlineAutoApprove: settings.AllowOutlineAutoApprove,
+			Locale:                  localeToProto(settings.Locale),
+			DisablePromptCache:      settings.DisablePromptCache,
+			WeeklySummary:           weeklySummaryToProto(settings.WeeklySummary),
+			RequireSecondReviewer:   settings.RequireSecondReviewer,
+			ExportRetentionDays:     int32(settings.ExportRetentionDays),
 		},
 	}), nil
 }
@@ -93,13 +107,20 @@ func (s *TenantSettingsServiceServer) SetAPIKey(
 	settings := result.Settings
 	return connect.NewResponse(&v1.SetAPIKeyResponse{
 		Settings: &v1.TenantAISettings{
-			TenantId:          settings.TenantID.String(),
-			Provider:          aiProviderToProto(settings.Provider),
-			ApiKeyConfigured:  settings.EncryptedAPIKey != nil && len(settings.EncryptedAPIKey) > 0,
-			TotalTokensUsed:   settings.TotalTokensUsed,
-			MonthlyTokenLimit: settings.MonthlyTokenLimit,
-			UpdatedAt:         timestamppb.New(settings.UpdatedAt),
-			UpdatedByUserId:   uuidPtrToString(settings.UpdatedByUserID),
+			TenantId:                settings.TenantID.String(),
+			Provider:                aiProviderToProto(settings.Provider),
+			ApiKeyConfigured:        settings.EncryptedAPIKey != nil && len(settings.EncryptedAPIKey) > 0,
+			TotalTokensUsed:         settings.TotalTokensUsed,
+			MonthlyTokenLimit:       settings.MonthlyTokenLimit,
+			UpdatedAt:               timestamppb.New(settings.UpdatedAt),
+			UpdatedByUserId:         uuidPtrToString(settings.UpdatedByUserID),
+			GenerationDefaults:      generationPreferencesToProto(settings.GenerationDefaults),
+			AllowOutlineAutoApprove: settings.AllowOutlineAutoApprove,
+			Locale:                  localeToProto(settings.Locale),
+			DisablePromptCache:      settings.DisablePromptCache,
+			WeeklySummary:           weeklySummaryToProto(settings.WeeklySummary),
+			RequireSecondReviewer:   settings.RequireSecondReviewer,
+			ExportRetentionDays:     int32(settings.ExportRetentionDays),
 		},
 	}), nil
 }
@@ -132,13 +153,20 @@ func (s *TenantSettingsServiceServer) RemoveAPIKey(
 	settings := result.Settings
 	return connect.NewResponse(&v1.RemoveAPIKeyResponse{
 		Settings: &v1.TenantAISettings{
-			TenantId:          settings.TenantID.String(),
-			Provider:          aiProviderToProto(settings.Provider),
-			ApiKeyConfigured:  settings.EncryptedAPIKey != nil && len(settings.EncryptedAPIKey) > 0,
-			TotalTokensUsed:   settings.TotalTokensUsed,
-			MonthlyTokenLimit: settings.MonthlyTokenLimit,
-			UpdatedAt:         timestamppb.New(settings.UpdatedAt),
-			UpdatedByUserId:   uuidPtrToString(settings.UpdatedByUserID),
+			TenantId:                settings.TenantID.String(),
+			Provider:                aiProviderToProto(settings.Provider),
+			ApiKeyConfigured:        settings.EncryptedAPIKey != nil && len(settings.EncryptedAPIKey) > 0,
+			TotalTokensUsed:         settings.TotalTokensUsed,
+			MonthlyTokenLimit:       settings.MonthlyTokenLimit,
+			UpdatedAt:               timestamppb.New(settings.UpdatedAt),
+			UpdatedByUserId:         uuidPtrToString(settings.UpdatedByUserID),
+			GenerationDefaults:      generationPreferencesToProto(settings.GenerationDefaults),
+			AllowOutlineAutoApprove: settings.AllowOutlineAutoApprove,
+			Locale:                  localeToProto(settings.Locale),
+			DisablePromptCache:      settings.DisablePromptCache,
+			WeeklySummary:           weeklySummaryToProto(settings.WeeklySummary),
+			RequireSecondReviewer:   settings.RequireSecondReviewer,
+			ExportRetentionDays:     int32(settings.ExportRetentionDays),
 		},
 	}), nil
 }
@@ -197,15 +225,423 @@ func (s *TenantSettingsServiceServer) GetUsageStats(
 		return nil, toConnectError(err)
 	}
 
+	periods := make([]*v1.UsagePeriod, 0, len(result.Periods))
+	for _, p := range result.Periods {
+		periods = append(periods, &v1.UsagePeriod{
+			YearMonth:  p.YearMonth,
+			TokensUsed: p.TokensUsed,
+		})
+	}
+
 	return connect.NewResponse(&v1.GetUsageStatsResponse{
 		TotalTokensUsed: result.TotalTokensUsed,
-		TokensThisMonth: 0, // TODO: Implement monthly token tracking
+		TokensThisMonth: result.TokensThisMonth,
 		MonthlyLimit:    result.MonthlyTokenLimit,
+		Periods:         periods,
+	}), nil
+}
+
+// UpdateGenerationDefaults sets the default component mix for new courses.
+func (s *TenantSettingsServiceServer) UpdateGenerationDefaults(
+	ctx context.Context,
+	req *connect.Request[v1.UpdateGenerationDefaultsRequest],
+) (*connect.Response[v1.UpdateGenerationDefaultsResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	prefs := generationPreferencesFromProto(req.Msg.Defaults)
+	if prefs == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errMissingGenerationDefaults)
+	}
+
+	if err := s.settingsService.UpdateGenerationDefaults(ctx, kratosID, *prefs); err != nil {
+		return nil, toConnectError(err)
+	}
+
+	// Fetch updated settings to return
+	result, err := s.settingsService.GetAISettings(ctx, kratosID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	settings := result.Settings
+	return connect.NewResponse(&v1.UpdateGenerationDefaultsResponse{
+		Settings: &v1.TenantAISettings{
+			TenantId:                settings.TenantID.String(),
+			Provider:                aiProviderToProto(settings.Provider),
+			ApiKeyConfigured:        settings.EncryptedAPIKey != nil && len(settings.EncryptedAPIKey) > 0,
+			TotalTokensUsed:         settings.TotalTokensUsed,
+			MonthlyTokenLimit:       settings.MonthlyTokenLimit,
+			UpdatedAt:               timestamppb.New(settings.UpdatedAt),
+			UpdatedByUserId:         uuidPtrToString(settings.UpdatedByUserID),
+			GenerationDefaults:      generationPreferencesToProto(settings.GenerationDefaults),
+			AllowOutlineAutoApprove: settings.AllowOutlineAutoApprove,
+			Locale:                  localeToProto(settings.Locale),
+			DisablePromptCache:      settings.DisablePromptCache,
+			WeeklySummary:           weeklySummaryToProto(settings.WeeklySummary),
+			RequireSecondReviewer:   settings.RequireSecondReviewer,
+			ExportRetentionDays:     int32(settings.ExportRetentionDays),
+		},
+	}), nil
+}
+
+// UpdateOutlineAutoApprove sets whether outlines may be approved automatically.
+func (s *TenantSettingsServiceServer) UpdateOutlineAutoApprove(
+	ctx context.Context,
+	req *connect.Request[v1.UpdateOutlineAutoApproveRequest],
+) (*connect.Response[v1.UpdateOutlineAutoApproveResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if err := s.settingsService.UpdateOutlineAutoApprove(ctx, kratosID, req.Msg.Allowed); err != nil {
+		return nil, toConnectError(err)
+	}
+
+	// Fetch updated settings to return
+	result, err := s.settingsService.GetAISettings(ctx, kratosID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	settings := result.Settings
+	return connect.NewResponse(&v1.UpdateOutlineAutoApproveResponse{
+		Settings: &v1.TenantAISettings{
+			TenantId:                settings.TenantID.String(),
+			Provider:                aiProviderToProto(settings.Provider),
+			ApiKeyConfigured:        settings.EncryptedAPIKey != nil && len(settings.EncryptedAPIKey) > 0,
+			TotalTokensUsed:         settings.TotalTokensUsed,
+			MonthlyTokenLimit:       settings.MonthlyTokenLimit,
+			UpdatedAt:               timestamppb.New(settings.UpdatedAt),
+			UpdatedByUserId:         uuidPtrToString(settings.UpdatedByUserID),
+			GenerationDefaults:      generationPreferencesToProto(settings.GenerationDefaults),
+			AllowOutlineAutoApprove: settings.AllowOutlineAutoApprove,
+			Locale:                  localeToProto(settings.Locale),
+			DisablePromptCache:      settings.DisablePromptCache,
+			WeeklySummary:           weeklySummaryToProto(settings.WeeklySummary),
+			RequireSecondReviewer:   settings.RequireSecondReviewer,
+			ExportRetentionDays:     int32(settings.ExportRetentionDays),
+		},
+	}), nil
+}
+
+// UpdateLocale sets the locale the organization's emails and exports are formatted in.
+func (s *TenantSettingsServiceServer) UpdateLocale(
+	ctx context.Context,
+	req *connect.Request[v1.UpdateLocaleRequest],
+) (*connect.Response[v1.UpdateLocaleResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if err := s.settingsService.UpdateLocale(ctx, kratosID, req.Msg.Locale); err != nil {
+		return nil, toConnectError(err)
+	}
+
+	// Fetch updated settings to return
+	result, err := s.settingsService.GetAISettings(ctx, kratosID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	settings := result.Settings
+	return connect.NewResponse(&v1.UpdateLocaleResponse{
+		Settings: &v1.TenantAISettings{
+			TenantId:                settings.TenantID.String(),
+			Provider:                aiProviderToProto(settings.Provider),
+			ApiKeyConfigured:        settings.EncryptedAPIKey != nil && len(settings.EncryptedAPIKey) > 0,
+			TotalTokensUsed:         settings.TotalTokensUsed,
+			MonthlyTokenLimit:       settings.MonthlyTokenLimit,
+			UpdatedAt:               timestamppb.New(settings.UpdatedAt),
+			UpdatedByUserId:         uuidPtrToString(settings.UpdatedByUserID),
+			GenerationDefaults:      generationPreferencesToProto(settings.GenerationDefaults),
+			AllowOutlineAutoApprove: settings.AllowOutlineAutoApprove,
+			Locale:                  localeToProto(settings.Locale),
+			DisablePromptCache:      settings.DisablePromptCache,
+			WeeklySummary:           weeklySummaryToProto(settings.WeeklySummary),
+			RequireSecondReviewer:   settings.RequireSecondReviewer,
+			ExportRetentionDays:     int32(settings.ExportRetentionDays),
+		},
+	}), nil
+}
+
+// UpdatePromptCache sets whether identical regeneration prompts may reuse a recent response.
+func (s *TenantSettingsServiceServer) UpdatePromptCache(
+	ctx context.Context,
+	req *connect.Request[v1.UpdatePromptCacheRequest],
+) (*connect.Response[v1.UpdatePromptCacheResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if err := s.settingsService.UpdatePromptCache(ctx, kratosID, req.Msg.Disabled); err != nil {
+		return nil, toConnectError(err)
+	}
+
+	// Fetch updated settings to return
+	result, err := s.settingsService.GetAISettings(ctx, kratosID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	settings := result.Settings
+	return connect.NewResponse(&v1.UpdatePromptCacheResponse{
+		Settings: &v1.TenantAISettings{
+			TenantId:                settings.TenantID.String(),
+			Provider:                aiProviderToProto(settings.Provider),
+			ApiKeyConfigured:        settings.EncryptedAPIKey != nil && len(settings.EncryptedAPIKey) > 0,
+			TotalTokensUsed:         settings.TotalTokensUsed,
+			MonthlyTokenLimit:       settings.MonthlyTokenLimit,
+			UpdatedAt:               timestamppb.New(settings.UpdatedAt),
+			UpdatedByUserId:         uuidPtrToString(settings.UpdatedByUserID),
+			GenerationDefaults:      generationPreferencesToProto(settings.GenerationDefaults),
+			AllowOutlineAutoApprove: settings.AllowOutlineAutoApprove,
+			Locale:                  localeToProto(settings.Locale),
+			DisablePromptCache:      settings.DisablePromptCache,
+			WeeklySummary:           weeklySummaryToProto(settings.WeeklySummary),
+			RequireSecondReviewer:   settings.RequireSecondReviewer,
+			ExportRetentionDays:     int32(settings.ExportRetentionDays),
+		},
+	}), nil
+}
+
+// UpdateWeeklySummary turns the weekly summary email on or off and sets when it is sent.
+func (s *TenantSettingsServiceServer) UpdateWeeklySummary(
+	ctx context.Context,
+	req *connect.Request[v1.UpdateWeeklySummaryRequest],
+) (*connect.Response[v1.UpdateWeeklySummaryResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	schedule := req.Msg.GetSchedule()
+	if schedule == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errMissingWeeklySummary)
+	}
+	if err := s.settingsService.UpdateWeeklySummary(ctx, kratosID, entity.WeeklySummarySchedule{
+		Enabled: schedule.Enabled,
+		Day:     time.Weekday(schedule.Day),
+		Hour:    int(schedule.Hour),
+	}); err != nil {
+		return nil, toConnectError(err)
+	}
+
+	// Fetch updated settings to return
+	result, err := s.settingsService.GetAISettings(ctx, kratosID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	settings := result.Settings
+	return connect.NewResponse(&v1.UpdateWeeklySummaryResponse{
+		Settings: &v1.TenantAISettings{
+			TenantId:                settings.TenantID.String(),
+			Provider:                aiProviderToProto(settings.Provider),
+			ApiKeyConfigured:        settings.EncryptedAPIKey != nil && len(settings.EncryptedAPIKey) > 0,
+			TotalTokensUsed:         settings.TotalTokensUsed,
+			MonthlyTokenLimit:       settings.MonthlyTokenLimit,
+			UpdatedAt:               timestamppb.New(settings.UpdatedAt),
+			UpdatedByUserId:         uuidPtrToString(settings.UpdatedByUserID),
+			GenerationDefaults:      generationPreferencesToProto(settings.GenerationDefaults),
+			AllowOutlineAutoApprove: settings.AllowOutlineAutoApprove,
+			Locale:                  localeToProto(settings.Locale),
+			DisablePromptCache:      settings.DisablePromptCache,
+			WeeklySummary:           weeklySummaryToProto(settings.WeeklySummary),
+			RequireSecondReviewer:   settings.RequireSecondReviewer,
+			ExportRetentionDays:     int32(settings.ExportRetentionDays),
+		},
+	}), nil
+}
+
+// UpdateSecondReviewerPolicy sets whether outline approval requires a second reviewer.
+func (s *TenantSettingsServiceServer) UpdateSecondReviewerPolicy(
+	ctx context.Context,
+	req *connect.Request[v1.UpdateSecondReviewerPolicyRequest],
+) (*connect.Response[v1.UpdateSecondReviewerPolicyResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if err := s.settingsService.UpdateSecondReviewerPolicy(ctx, kratosID, req.Msg.Required); err != nil {
+		return nil, toConnectError(err)
+	}
+
+	// Fetch updated settings to return
+	result, err := s.settingsService.GetAISettings(ctx, kratosID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	settings := result.Settings
+	return connect.NewResponse(&v1.UpdateSecondReviewerPolicyResponse{
+		Settings: &v1.TenantAISettings{
+			TenantId:                settings.TenantID.String(),
+			Provider:                aiProviderToProto(settings.Provider),
+			ApiKeyConfigured:        settings.EncryptedAPIKey != nil && len(settings.EncryptedAPIKey) > 0,
+			TotalTokensUsed:         settings.TotalTokensUsed,
+			MonthlyTokenLimit:       settings.MonthlyTokenLimit,
+			UpdatedAt:               timestamppb.New(settings.UpdatedAt),
+			UpdatedByUserId:         uuidPtrToString(settings.UpdatedByUserID),
+			GenerationDefaults:      generationPreferencesToProto(settings.GenerationDefaults),
+			AllowOutlineAutoApprove: settings.AllowOutlineAutoApprove,
+			Locale:                  localeToProto(settings.Locale),
+			DisablePromptCache:      settings.DisablePromptCache,
+			WeeklySummary:           weeklySummaryToProto(settings.WeeklySummary),
+			RequireSecondReviewer:   settings.RequireSecondReviewer,
+			ExportRetentionDays:     int32(settings.ExportRetentionDays),
+		},
+	}), nil
+}
+
+// UpdateExportRetention sets how many days export files are kept.
+func (s *TenantSettingsServiceServer) UpdateExportRetention(
+	ctx context.Context,
+	req *connect.Request[v1.UpdateExportRetentionRequest],
+) (*connect.Response[v1.UpdateExportRetentionResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if err := s.settingsService.UpdateExportRetention(ctx, kratosID, int(req.Msg.Days)); err != nil {
+		return nil, toConnectError(err)
+	}
+
+	// Fetch updated settings to return
+	result, err := s.settingsService.GetAISettings(ctx, kratosID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	settings := result.Settings
+	return connect.NewResponse(&v1.UpdateExportRetentionResponse{
+		Settings: &v1.TenantAISettings{
+			TenantId:                settings.TenantID.String(),
+			Provider:                aiProviderToProto(settings.Provider),
+			ApiKeyConfigured:        settings.EncryptedAPIKey != nil && len(settings.EncryptedAPIKey) > 0,
+			TotalTokensUsed:         settings.TotalTokensUsed,
+			MonthlyTokenLimit:       settings.MonthlyTokenLimit,
+			UpdatedAt:               timestamppb.New(settings.UpdatedAt),
+			UpdatedByUserId:         uuidPtrToString(settings.UpdatedByUserID),
+			GenerationDefaults:      generationPreferencesToProto(settings.GenerationDefaults),
+			AllowOutlineAutoApprove: settings.AllowOutlineAutoApprove,
+			Locale:                  localeToProto(settings.Locale),
+			DisablePromptCache:      settings.DisablePromptCache,
+			WeeklySummary:           weeklySummaryToProto(settings.WeeklySummary),
+			RequireSecondReviewer:   settings.RequireSecondReviewer,
+			ExportRetentionDays:     int32(settings.ExportRetentionDays),
+		},
 	}), nil
 }
 
 // Helper functions for proto conversion
 
+// GetCourseDefaults returns the settings new courses start with.
+func (s *TenantSettingsServiceServer) GetCourseDefaults(
+	ctx context.Context,
+	req *connect.Request[v1.GetCourseDefaultsRequest],
+) (*connect.Response[v1.GetCourseDefaultsResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	defaults, err := s.settingsService.GetCourseDefaults(ctx, kratosID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.GetCourseDefaultsResponse{
+		Defaults: courseDefaultsToProto(defaults),
+	}), nil
+}
+
+// UpdateCourseDefaults replaces the settings new courses start with.
+func (s *TenantSettingsServiceServer) UpdateCourseDefaults(
+	ctx context.Context,
+	req *connect.Request[v1.UpdateCourseDefaultsRequest],
+) (*connect.Response[v1.UpdateCourseDefaultsResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if req.Msg.Defaults == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errMissingCourseDefaults)
+	}
+
+	defaults, err := courseDefaultsFromProto(req.Msg.Defaults)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := s.settingsService.UpdateCourseDefaults(ctx, kratosID, defaults); err != nil {
+		return nil, toConnectError(err)
+	}
+
+	// Fetch the saved defaults to return
+	saved, err := s.settingsService.GetCourseDefaults(ctx, kratosID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.UpdateCourseDefaultsResponse{
+		Defaults: courseDefaultsToProto(saved),
+	}), nil
+}
+
 func aiProviderToProto(p valueobject.AIProvider) v1.AIProvider {
 	switch p {
 	case valueobject.AIProviderGemini:
@@ -223,3 +659,58 @@ func protoToAIProvider(p v1.AIProvider) valueobject.AIProvider {
 		return valueobject.AIProviderGemini // Default to Gemini
 	}
 }
+
+func localeToProto(l *valueobject.Locale) *string {
+	if l == nil {
+		return nil
+	}
+	s := l.String()
+	return &s
+}
+
+func courseDefaultsToProto(d entity.CourseDefaults) *v1.CourseDefaults {
+	defaults := &v1.CourseDefaults{
+		DestinationFolderId: uuidPtrToString(d.DestinationFolderID),
+		CategoryTags:        d.CategoryTags,
+	}
+	if d.DataSource != "" {
+		defaults.DataSource = &d.DataSource
+	}
+	if d.Assessment != nil {
+		defaults.AssessmentSettings = &v1.AssessmentSettings{
+			EnableEmbeddedKnowledgeChecks: d.Assessment.EnableEmbeddedKnowledgeChecks,
+			EnableFinalExam:               d.Assessment.EnableFinalExam,
+		}
+	}
+	return defaults
+}
+
+func courseDefaultsFromProto(d *v1.CourseDefaults) (entity.CourseDefaults, error) {
+	defaults := entity.CourseDefaults{
+		CategoryTags: d.CategoryTags,
+		DataSource:   d.GetDataSource(),
+	}
+	if d.DestinationFolderId != nil && *d.DestinationFolderId != "" {
+		folderID, err := parseUUID(*d.DestinationFolderId)
+		if err != nil {
+			return entity.CourseDefaults{}, err
+		}
+		defaults.DestinationFolderID = &folderID
+	}
+	if d.AssessmentSettings != nil {
+		defaults.Assessment = &entity.AssessmentDefaults{
+			EnableEmbeddedKnowledgeChecks: d.AssessmentSettings.EnableEmbeddedKnowledgeChecks,
+			EnableFinalExam:               d.AssessmentSettings.EnableFinalExam,
+		}
+	}
+	return defaults, nil
+}
+
+// weeklySummaryToProto converts the weekly summary schedule to proto.
+func weeklySummaryToProto(schedule entity.WeeklySummarySchedule) *v1.WeeklySummarySchedule {
+	return &v1.WeeklySummarySchedule{
+		Enabled: schedule.Enabled,
+		Day:     int32(schedule.Day),
+		Hour:    int32(schedule.Hour),
+	}
+}
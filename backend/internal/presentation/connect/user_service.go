@@ -4,10 +4,12 @@ import (
 	"context"
 
 	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	v1 "github.com/sogos/mirai-backend/gen/mirai/v1"
 	"github.com/sogos/mirai-backend/gen/mirai/v1/miraiv1connect"
 	"github.com/sogos/mirai-backend/internal/application/service"
+	"github.com/sogos/mirai-backend/internal/domain/entity"
 )
 
 // UserServiceServer implements the UserService Connect handler.
@@ -134,3 +136,280 @@ func (s *UserServiceServer) ListCompanyUsers(
 		Users: protoUsers,
 	}), nil
 }
+
+// DeactivateUser blocks a user's sign-in and reassigns their open work.
+func (s *UserServiceServer) DeactivateUser(
+	ctx context.Context,
+	req *connect.Request[v1.DeactivateUserRequest],
+) (*connect.Response[v1.DeactivateUserResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	userID, err := parseUUID(req.Msg.UserId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	serviceReq := service.DeactivateUserRequest{UserID: userID}
+	if req.Msg.ReassignToUserId != nil {
+		reassignTo, err := parseUUID(*req.Msg.ReassignToUserId)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		serviceReq.ReassignToUserID = &reassignTo
+	}
+
+	result, err := s.userService.DeactivateUser(ctx, kratosID, serviceReq)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	taskIDs := make([]string, len(result.ReassignedTasks))
+	for i, task := range result.ReassignedTasks {
+		taskIDs[i] = task.ID.String()
+	}
+	jobIDs := make([]string, len(result.ReassignedJobs))
+	for i, job := range result.ReassignedJobs {
+		jobIDs[i] = job.ID.String()
+	}
+
+	return connect.NewResponse(&v1.DeactivateUserResponse{
+		User:               userToProto(result.User),
+		ReassignedToUserId: result.ReassignedTo.String(),
+		ReassignedTaskIds:  taskIDs,
+		ReassignedJobIds:   jobIDs,
+	}), nil
+}
+
+// ReactivateUser restores sign-in for a deactivated user.
+func (s *UserServiceServer) ReactivateUser(
+	ctx context.Context,
+	req *connect.Request[v1.ReactivateUserRequest],
+) (*connect.Response[v1.ReactivateUserResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	userID, err := parseUUID(req.Msg.UserId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	user, err := s.userService.ReactivateUser(ctx, kratosID, userID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.ReactivateUserResponse{
+		User: userToProto(user),
+	}), nil
+}
+
+// GetOnboardingStatus returns the caller's onboarding checklist.
+func (s *UserServiceServer) GetOnboardingStatus(
+	ctx context.Context,
+	req *connect.Request[v1.GetOnboardingStatusRequest],
+) (*connect.Response[v1.GetOnboardingStatusResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	status, err := s.userService.GetOnboardingStatus(ctx, kratosID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	items := make([]*v1.OnboardingChecklistItem, len(status.Items))
+	for i, item := range status.Items {
+		items[i] = &v1.OnboardingChecklistItem{
+			Key:       item.Key,
+			Title:     item.Title,
+			Completed: item.Completed,
+		}
+	}
+
+	return connect.NewResponse(&v1.GetOnboardingStatusResponse{
+		Items:     items,
+		Dismissed: status.Dismissed,
+	}), nil
+}
+
+// DismissOnboardingChecklist hides the onboarding checklist for the caller.
+func (s *UserServiceServer) DismissOnboardingChecklist(
+	ctx context.Context,
+	req *connect.Request[v1.DismissOnboardingChecklistRequest],
+) (*connect.Response[v1.DismissOnboardingChecklistResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if err := s.userService.DismissOnboardingChecklist(ctx, kratosID); err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.DismissOnboardingChecklistResponse{}), nil
+}
+
+// UpdateEmailPreferences sets which optional emails the caller receives.
+func (s *UserServiceServer) UpdateEmailPreferences(
+	ctx context.Context,
+	req *connect.Request[v1.UpdateEmailPreferencesRequest],
+) (*connect.Response[v1.UpdateEmailPreferencesResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	user, err := s.userService.UpdateEmailPreferences(ctx, kratosID, req.Msg.WeeklySummaryOptOut)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.UpdateEmailPreferencesResponse{
+		User: userToProto(user),
+	}), nil
+}
+
+// CreateAPIToken creates a personal API token and returns its secret once.
+func (s *UserServiceServer) CreateAPIToken(
+	ctx context.Context,
+	req *connect.Request[v1.CreateAPITokenRequest],
+) (*connect.Response[v1.CreateAPITokenResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	token, secret, err := s.userService.CreateAPIToken(ctx, kratosID, service.CreateAPITokenRequest{
+		Name:               req.Msg.Name,
+		Scopes:             req.Msg.Scopes,
+		ExpiresInDays:      int(req.Msg.ExpiresInDays),
+		RateLimitPerMinute: int(req.Msg.RateLimitPerMinute),
+	})
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.CreateAPITokenResponse{
+		Token:  apiTokenToProto(token),
+		Secret: secret,
+	}), nil
+}
+
+// ListAPITokens lists the caller's API tokens.
+func (s *UserServiceServer) ListAPITokens(
+	ctx context.Context,
+	req *connect.Request[v1.ListAPITokensRequest],
+) (*connect.Response[v1.ListAPITokensResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	tokens, err := s.userService.ListAPITokens(ctx, kratosID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	protoTokens := make([]*v1.APIToken, len(tokens))
+	for i, t := range tokens {
+		protoTokens[i] = apiTokenToProto(t)
+	}
+
+	return connect.NewResponse(&v1.ListAPITokensResponse{
+		Tokens: protoTokens,
+	}), nil
+}
+
+// RevokeAPIToken revokes one of the caller's API tokens.
+func (s *UserServiceServer) RevokeAPIToken(
+	ctx context.Context,
+	req *connect.Request[v1.RevokeAPITokenRequest],
+) (*connect.Response[v1.RevokeAPITokenResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	tokenID, err := parseUUID(req.Msg.TokenId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	token, err := s.userService.RevokeAPIToken(ctx, kratosID, tokenID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.RevokeAPITokenResponse{
+		Token: apiTokenToProto(token),
+	}), nil
+}
+
+func apiTokenToProto(t *entity.APIToken) *v1.APIToken {
+	scopes := make([]string, len(t.Scopes))
+	for i, scope := range t.Scopes {
+		scopes[i] = scope.String()
+	}
+
+	token := &v1.APIToken{
+		Id:                 t.ID.String(),
+		Name:               t.Name,
+		TokenPrefix:        t.TokenPrefix,
+		Scopes:             scopes,
+		RateLimitPerMinute: int32(t.RateLimitPerMinute),
+		CreatedAt:          timestamppb.New(t.CreatedAt),
+		ExpiresAt:          timestamppb.New(t.ExpiresAt),
+	}
+	if t.LastUsedAt != nil {
+		token.LastUsedAt = timestamppb.New(*t.LastUsedAt)
+	}
+	if t.RevokedAt != nil {
+		token.RevokedAt = timestamppb.New(*t.RevokedAt)
+	}
+	return token
+}
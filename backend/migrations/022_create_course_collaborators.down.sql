@@ -0,0 +1,5 @@
+-- Drop course_collaborators table
+-- Note: Cannot remove enum values in PostgreSQL without recreating the type
+
+DROP POLICY IF EXISTS course_collaborators_isolation ON course_collaborators;
+DROP TABLE IF EXISTS course_collaborators;
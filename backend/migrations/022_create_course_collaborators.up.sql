@@ -0,0 +1,31 @@
+-- Create course_collaborators table for per-course assignments
+-- The course creator is implicitly the owner; rows here grant additional users a role
+
+CREATE TABLE course_collaborators (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    course_id UUID NOT NULL REFERENCES courses(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role VARCHAR(20) NOT NULL DEFAULT 'editor',
+    added_by_user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE(course_id, user_id),
+    CONSTRAINT course_collaborator_role_check CHECK (role IN ('owner', 'editor', 'viewer'))
+);
+
+CREATE INDEX idx_course_collaborators_tenant_id ON course_collaborators(tenant_id);
+CREATE INDEX idx_course_collaborators_course_id ON course_collaborators(course_id);
+CREATE INDEX idx_course_collaborators_user_id ON course_collaborators(user_id);
+
+-- Enable RLS
+ALTER TABLE course_collaborators ENABLE ROW LEVEL SECURITY;
+ALTER TABLE course_collaborators FORCE ROW LEVEL SECURITY;
+
+-- RLS Policy
+CREATE POLICY course_collaborators_isolation ON course_collaborators
+    FOR ALL
+    USING (tenant_id = current_tenant_id() OR is_superadmin())
+    WITH CHECK (tenant_id = current_tenant_id() OR is_superadmin());
+
+-- Notify users when they are added to a course
+ALTER TYPE notification_type ADD VALUE IF NOT EXISTS 'collaborator_added';
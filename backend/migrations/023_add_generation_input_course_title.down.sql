@@ -0,0 +1,2 @@
+-- Remove course_title column from course_generation_inputs table
+ALTER TABLE course_generation_inputs DROP COLUMN IF EXISTS course_title;
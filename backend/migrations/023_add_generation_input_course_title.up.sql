@@ -0,0 +1,6 @@
+-- Add course_title column to course_generation_inputs table
+-- Captures the course title at generation time so notifications can name the
+-- course even if its metadata is unavailable when the job finishes
+
+ALTER TABLE course_generation_inputs
+    ADD COLUMN course_title VARCHAR(255);
@@ -0,0 +1,5 @@
+-- Remove outline size constraints from course_generation_inputs table
+ALTER TABLE course_generation_inputs
+    DROP COLUMN IF EXISTS max_sections,
+    DROP COLUMN IF EXISTS max_lessons_per_section,
+    DROP COLUMN IF EXISTS target_duration_minutes;
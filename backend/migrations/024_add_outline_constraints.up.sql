@@ -0,0 +1,8 @@
+-- Add outline size constraints to course_generation_inputs table
+-- Lets users bound the generated outline (e.g. "a 2-hour course with about 5 sections")
+-- NULL means unconstrained
+
+ALTER TABLE course_generation_inputs
+    ADD COLUMN max_sections INT CHECK (max_sections > 0),
+    ADD COLUMN max_lessons_per_section INT CHECK (max_lessons_per_section > 0),
+    ADD COLUMN target_duration_minutes INT CHECK (target_duration_minutes > 0);
@@ -0,0 +1,12 @@
+-- Remove generation preferences
+ALTER TABLE course_generation_inputs
+    DROP COLUMN IF EXISTS enable_quizzes,
+    DROP COLUMN IF EXISTS quiz_frequency,
+    DROP COLUMN IF EXISTS include_images,
+    DROP COLUMN IF EXISTS include_reflection_prompts;
+
+ALTER TABLE tenant_ai_settings
+    DROP COLUMN IF EXISTS default_enable_quizzes,
+    DROP COLUMN IF EXISTS default_quiz_frequency,
+    DROP COLUMN IF EXISTS default_include_images,
+    DROP COLUMN IF EXISTS default_include_reflection_prompts;
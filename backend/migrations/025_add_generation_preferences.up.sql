@@ -0,0 +1,15 @@
+-- Add per-course generation preferences (component mix) with tenant-level defaults
+
+ALTER TABLE tenant_ai_settings
+    ADD COLUMN default_enable_quizzes BOOLEAN NOT NULL DEFAULT true,
+    ADD COLUMN default_quiz_frequency VARCHAR(20) NOT NULL DEFAULT 'every_lesson'
+        CHECK (default_quiz_frequency IN ('every_lesson', 'end_of_section', 'end_of_course')),
+    ADD COLUMN default_include_images BOOLEAN NOT NULL DEFAULT true,
+    ADD COLUMN default_include_reflection_prompts BOOLEAN NOT NULL DEFAULT false;
+
+ALTER TABLE course_generation_inputs
+    ADD COLUMN enable_quizzes BOOLEAN NOT NULL DEFAULT true,
+    ADD COLUMN quiz_frequency VARCHAR(20) NOT NULL DEFAULT 'every_lesson'
+        CHECK (quiz_frequency IN ('every_lesson', 'end_of_section', 'end_of_course')),
+    ADD COLUMN include_images BOOLEAN NOT NULL DEFAULT true,
+    ADD COLUMN include_reflection_prompts BOOLEAN NOT NULL DEFAULT false;
@@ -0,0 +1,5 @@
+-- Remove orphan tracking from generated_lessons table
+DROP INDEX IF EXISTS idx_generated_lessons_active;
+
+ALTER TABLE generated_lessons
+    DROP COLUMN IF EXISTS orphaned_at;
@@ -0,0 +1,8 @@
+-- Track generated lessons whose outline lesson was removed or superseded
+-- Orphaned lessons are hidden from the editor but kept so content can be recovered
+-- NULL means the lesson belongs to the current outline
+
+ALTER TABLE generated_lessons
+    ADD COLUMN orphaned_at TIMESTAMPTZ;
+
+CREATE INDEX idx_generated_lessons_active ON generated_lessons(course_id) WHERE orphaned_at IS NULL;
@@ -0,0 +1,4 @@
+-- Restore the original sme_tasks team reference
+ALTER TABLE sme_tasks
+    DROP CONSTRAINT IF EXISTS sme_tasks_team_id_fkey,
+    ADD CONSTRAINT sme_tasks_team_id_fkey FOREIGN KEY (team_id) REFERENCES teams(id);
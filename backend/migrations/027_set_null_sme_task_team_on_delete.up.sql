@@ -0,0 +1,6 @@
+-- Let team deletion proceed once open tasks are resolved
+-- Closed tasks keep their history but lose the team reference
+
+ALTER TABLE sme_tasks
+    DROP CONSTRAINT IF EXISTS sme_tasks_team_id_fkey,
+    ADD CONSTRAINT sme_tasks_team_id_fkey FOREIGN KEY (team_id) REFERENCES teams(id) ON DELETE SET NULL;
@@ -0,0 +1,6 @@
+-- Remove sample content tagging
+DROP INDEX IF EXISTS idx_courses_sample;
+
+ALTER TABLE target_audience_templates DROP COLUMN IF EXISTS is_sample;
+ALTER TABLE courses DROP COLUMN IF EXISTS is_sample;
+ALTER TABLE folders DROP COLUMN IF EXISTS is_sample;
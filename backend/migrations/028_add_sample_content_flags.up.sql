@@ -0,0 +1,8 @@
+-- Tag onboarding sample content so it can be removed in bulk
+-- New tenants get sample folders, a target audience and a draft course
+
+ALTER TABLE folders ADD COLUMN is_sample BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE courses ADD COLUMN is_sample BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE target_audience_templates ADD COLUMN is_sample BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE INDEX idx_courses_sample ON courses(tenant_id) WHERE is_sample;
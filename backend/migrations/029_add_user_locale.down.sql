@@ -0,0 +1,2 @@
+-- Remove user locale
+ALTER TABLE users DROP COLUMN IF EXISTS locale;
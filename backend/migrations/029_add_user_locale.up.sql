@@ -0,0 +1,3 @@
+-- Preferred language for emails, synced from the Kratos "locale" trait
+
+ALTER TABLE users ADD COLUMN locale TEXT NOT NULL DEFAULT 'en';
@@ -0,0 +1,5 @@
+-- Drop job_anomalies table
+
+DROP INDEX IF EXISTS idx_generation_jobs_open_parents;
+DROP POLICY IF EXISTS job_anomalies_isolation ON job_anomalies;
+DROP TABLE IF EXISTS job_anomalies;
@@ -0,0 +1,32 @@
+-- Create job_anomalies table for generation state inconsistencies found by the hourly sweeper
+-- Each anomaly is recorded once per job so alerts only fire for new findings
+
+CREATE TABLE job_anomalies (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    job_id UUID NOT NULL REFERENCES generation_jobs(id) ON DELETE CASCADE,
+    course_id UUID REFERENCES courses(id) ON DELETE SET NULL,
+    anomaly_type VARCHAR(50) NOT NULL,
+    details TEXT NOT NULL,
+    resolved BOOLEAN NOT NULL DEFAULT FALSE,  -- Fixed automatically by the sweeper
+    detected_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE(job_id, anomaly_type),
+    CONSTRAINT job_anomaly_type_check CHECK (anomaly_type IN ('parent_not_finalized', 'parent_missing_children', 'completed_without_lessons'))
+);
+
+CREATE INDEX idx_job_anomalies_tenant_id ON job_anomalies(tenant_id);
+CREATE INDEX idx_job_anomalies_detected_at ON job_anomalies(detected_at DESC);
+
+-- The sweeper looks for unfinished full_course parents on every run
+CREATE INDEX idx_generation_jobs_open_parents ON generation_jobs(tenant_id, created_at)
+    WHERE type = 'full_course' AND status IN ('queued', 'processing');
+
+-- Enable RLS
+ALTER TABLE job_anomalies ENABLE ROW LEVEL SECURITY;
+ALTER TABLE job_anomalies FORCE ROW LEVEL SECURITY;
+
+-- RLS Policy
+CREATE POLICY job_anomalies_isolation ON job_anomalies
+    FOR ALL
+    USING (tenant_id = current_tenant_id() OR is_superadmin())
+    WITH CHECK (tenant_id = current_tenant_id() OR is_superadmin());
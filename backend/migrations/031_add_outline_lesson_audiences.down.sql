@@ -0,0 +1,3 @@
+-- Remove outline lesson audience annotations
+
+ALTER TABLE outline_lessons DROP COLUMN IF EXISTS target_audiences;
@@ -0,0 +1,4 @@
+-- Record which target audiences an outline lesson is specific to
+-- NULL or empty means the lesson is for every audience selected for the course
+
+ALTER TABLE outline_lessons ADD COLUMN target_audiences TEXT[];
@@ -0,0 +1,4 @@
+-- Restore the plain task_id index
+
+CREATE INDEX IF NOT EXISTS idx_sme_submissions_task ON sme_task_submissions(task_id);
+DROP INDEX IF EXISTS idx_sme_submissions_task_page;
@@ -0,0 +1,5 @@
+-- Keyset pagination of a task's submissions, newest first
+-- Supersedes the plain task_id index
+
+CREATE INDEX idx_sme_submissions_task_page ON sme_task_submissions(task_id, submitted_at DESC, id DESC);
+DROP INDEX IF EXISTS idx_sme_submissions_task;
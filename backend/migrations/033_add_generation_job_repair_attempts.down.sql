@@ -0,0 +1,3 @@
+-- Remove generation job repair attempt tracking
+
+ALTER TABLE generation_jobs DROP COLUMN IF EXISTS repair_attempts;
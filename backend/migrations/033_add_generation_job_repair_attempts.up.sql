@@ -0,0 +1,3 @@
+-- Count AI responses that were re-requested because they failed schema validation
+
+ALTER TABLE generation_jobs ADD COLUMN repair_attempts INT NOT NULL DEFAULT 0;
@@ -0,0 +1,11 @@
+-- Restore the loose folder ownership constraint
+-- Folder type corrections made by the up migration are kept
+
+DROP INDEX IF EXISTS idx_folders_team_folder;
+
+ALTER TABLE folders DROP CONSTRAINT folder_ownership_check;
+ALTER TABLE folders ADD CONSTRAINT folder_ownership_check CHECK (
+    (type = 'TEAM' AND team_id IS NOT NULL) OR
+    (type = 'PERSONAL' AND user_id IS NOT NULL) OR
+    (type IN ('LIBRARY', 'FOLDER'))
+);
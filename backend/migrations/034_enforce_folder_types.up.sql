@@ -0,0 +1,27 @@
+-- Align existing folders with their type and tighten the ownership constraint
+-- TEAM folders belong to exactly one team, PERSONAL folders to one user,
+-- LIBRARY and FOLDER folders to neither
+
+-- Folders attached to a team are team folders
+UPDATE folders SET type = 'TEAM' WHERE type = 'FOLDER' AND team_id IS NOT NULL;
+
+-- Keep only the oldest folder per team as its team folder
+UPDATE folders f
+SET type = 'FOLDER', team_id = NULL
+WHERE f.type = 'TEAM' AND EXISTS (
+    SELECT 1 FROM folders o
+    WHERE o.type = 'TEAM' AND o.team_id = f.team_id AND (o.created_at, o.id) < (f.created_at, f.id)
+);
+
+-- Drop associations that do not match the folder type
+UPDATE folders SET team_id = NULL WHERE type <> 'TEAM' AND team_id IS NOT NULL;
+UPDATE folders SET user_id = NULL WHERE type <> 'PERSONAL' AND user_id IS NOT NULL;
+
+ALTER TABLE folders DROP CONSTRAINT folder_ownership_check;
+ALTER TABLE folders ADD CONSTRAINT folder_ownership_check CHECK (
+    (type = 'TEAM' AND team_id IS NOT NULL AND user_id IS NULL) OR
+    (type = 'PERSONAL' AND user_id IS NOT NULL AND team_id IS NULL) OR
+    (type IN ('LIBRARY', 'FOLDER') AND team_id IS NULL AND user_id IS NULL)
+);
+
+CREATE UNIQUE INDEX idx_folders_team_folder ON folders(team_id) WHERE type = 'TEAM';
@@ -0,0 +1,4 @@
+-- Remove user deactivation
+
+ALTER TABLE users DROP COLUMN IF EXISTS deactivated_at;
+ALTER TABLE users DROP COLUMN IF EXISTS is_active;
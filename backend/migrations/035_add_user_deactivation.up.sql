@@ -0,0 +1,4 @@
+-- Allow users to be deactivated while keeping the content they authored
+
+ALTER TABLE users ADD COLUMN is_active BOOLEAN NOT NULL DEFAULT TRUE;
+ALTER TABLE users ADD COLUMN deactivated_at TIMESTAMPTZ;
@@ -0,0 +1,7 @@
+-- Drop audit_events table
+
+DROP POLICY IF EXISTS audit_events_insert ON audit_events;
+DROP POLICY IF EXISTS audit_events_select ON audit_events;
+DROP TRIGGER IF EXISTS audit_events_append_only ON audit_events;
+DROP TABLE IF EXISTS audit_events;
+DROP FUNCTION IF EXISTS reject_audit_event_change();
@@ -0,0 +1,41 @@
+-- Create audit_events table recording administrative actions for compliance review
+-- Rows are append-only: there are no UPDATE/DELETE policies and a trigger rejects both.
+-- tenant_id and actor_user_id have no foreign keys so history outlives deleted tenants and users.
+
+CREATE TABLE audit_events (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL,
+    actor_user_id UUID,                    -- NULL for system actions such as billing webhooks
+    action VARCHAR(100) NOT NULL,
+    target_type VARCHAR(50) NOT NULL,
+    target_id VARCHAR(255) NOT NULL,
+    changes JSONB NOT NULL DEFAULT '[]',   -- Changed fields; sensitive fields carry no values
+    ip_address VARCHAR(64),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_audit_events_tenant_created ON audit_events(tenant_id, created_at DESC, id DESC);
+CREATE INDEX idx_audit_events_target ON audit_events(tenant_id, target_type, target_id);
+
+CREATE OR REPLACE FUNCTION reject_audit_event_change() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_events_append_only
+    BEFORE UPDATE OR DELETE ON audit_events
+    FOR EACH ROW EXECUTE FUNCTION reject_audit_event_change();
+
+-- Enable RLS
+ALTER TABLE audit_events ENABLE ROW LEVEL SECURITY;
+ALTER TABLE audit_events FORCE ROW LEVEL SECURITY;
+
+-- RLS Policies
+CREATE POLICY audit_events_select ON audit_events
+    FOR SELECT
+    USING (tenant_id = current_tenant_id() OR is_superadmin());
+
+CREATE POLICY audit_events_insert ON audit_events
+    FOR INSERT
+    WITH CHECK (tenant_id = current_tenant_id() OR is_superadmin());
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_sme_chunks_sme_topic;
@@ -0,0 +1,10 @@
+-- Index knowledge chunks by topic within an SME for browsing by topic
+
+-- Collapse whitespace in existing topics so they group with newly normalized ones
+UPDATE sme_knowledge_chunks
+SET topic = regexp_replace(btrim(topic), '\s+', ' ', 'g')
+WHERE topic <> regexp_replace(btrim(topic), '\s+', ' ', 'g');
+
+UPDATE sme_knowledge_chunks SET topic = 'General' WHERE topic = '';
+
+CREATE INDEX idx_sme_chunks_sme_topic ON sme_knowledge_chunks(sme_id, topic);
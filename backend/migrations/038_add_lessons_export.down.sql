@@ -0,0 +1,3 @@
+-- Note: Cannot remove enum values in PostgreSQL without recreating the type
+
+ALTER TABLE notifications ALTER COLUMN action_url TYPE VARCHAR(500) USING LEFT(action_url, 500);
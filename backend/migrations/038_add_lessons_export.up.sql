@@ -0,0 +1,7 @@
+-- Add bulk lesson export jobs and their completion notification
+
+ALTER TYPE generation_job_type ADD VALUE IF NOT EXISTS 'lessons_export';
+ALTER TYPE notification_type ADD VALUE IF NOT EXISTS 'export_ready';
+
+-- Export notifications link straight to a presigned download URL, which can run past 500 characters
+ALTER TABLE notifications ALTER COLUMN action_url TYPE TEXT;
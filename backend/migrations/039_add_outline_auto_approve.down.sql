@@ -0,0 +1,2 @@
+ALTER TABLE tenant_ai_settings
+    DROP COLUMN IF EXISTS allow_outline_auto_approve;
@@ -0,0 +1,4 @@
+-- Let tenants allow outlines to be approved automatically and lessons generated straight away
+
+ALTER TABLE tenant_ai_settings
+    ADD COLUMN allow_outline_auto_approve BOOLEAN NOT NULL DEFAULT false;
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_sme_knowledge_chunks_injection_flagged;
+
+ALTER TABLE sme_knowledge_chunks
+    DROP COLUMN IF EXISTS injection_released_at,
+    DROP COLUMN IF EXISTS injection_released_by_user_id,
+    DROP COLUMN IF EXISTS injection_reason,
+    DROP COLUMN IF EXISTS injection_flagged;
@@ -0,0 +1,12 @@
+-- Screen SME knowledge for prompt injection; flagged chunks are kept out of generation
+-- prompts until an admin reviews and releases them
+
+ALTER TABLE sme_knowledge_chunks
+    ADD COLUMN injection_flagged BOOLEAN NOT NULL DEFAULT false,
+    ADD COLUMN injection_reason TEXT,
+    ADD COLUMN injection_released_by_user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    ADD COLUMN injection_released_at TIMESTAMPTZ;
+
+CREATE INDEX idx_sme_knowledge_chunks_injection_flagged
+    ON sme_knowledge_chunks(sme_id)
+    WHERE injection_flagged;
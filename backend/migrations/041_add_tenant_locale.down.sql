@@ -0,0 +1,2 @@
+ALTER TABLE tenant_ai_settings
+    DROP COLUMN IF EXISTS locale;
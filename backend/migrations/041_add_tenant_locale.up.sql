@@ -0,0 +1,5 @@
+-- Let tenants pick the locale dates, durations and numbers are formatted with in
+-- emails and exports. NULL keeps each user's own locale.
+
+ALTER TABLE tenant_ai_settings
+    ADD COLUMN locale VARCHAR(10);
@@ -0,0 +1,5 @@
+-- Organization-wide defaults for new courses (destination folder, tags, data source,
+-- assessment settings), applied when a course is created without its own values.
+
+ALTER TABLE tenant_ai_settings
+    ADD COLUMN course_defaults JSONB NOT NULL DEFAULT '{}'::jsonb;
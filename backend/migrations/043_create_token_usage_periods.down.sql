@@ -0,0 +1,13 @@
+ALTER TABLE tenant_ai_settings ADD COLUMN total_tokens_used BIGINT NOT NULL DEFAULT 0;
+
+UPDATE tenant_ai_settings s
+SET total_tokens_used = p.total
+FROM (
+    SELECT tenant_id, SUM(tokens_used) AS total
+    FROM token_usage_periods
+    GROUP BY tenant_id
+) p
+WHERE p.tenant_id = s.tenant_id;
+
+DROP POLICY IF EXISTS token_usage_periods_isolation ON token_usage_periods;
+DROP TABLE IF EXISTS token_usage_periods;
@@ -0,0 +1,46 @@
+-- Track AI token usage per calendar month (UTC) instead of in a single running counter,
+-- so monthly limits reset and past months stay available for the usage report.
+-- Increments are an atomic upsert on (tenant_id, year_month).
+
+CREATE TABLE token_usage_periods (
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    year_month CHAR(7) NOT NULL,           -- e.g. '2026-10'
+    tokens_used BIGINT NOT NULL DEFAULT 0,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (tenant_id, year_month)
+);
+
+-- Seed the current period with this month's usage. The old counter is all-time, so
+-- carrying it over would charge every past month against this month's limit; instead
+-- the period is estimated from the tokens recorded on jobs created this month, capped
+-- at the old counter. Past months are not reconstructed.
+INSERT INTO token_usage_periods (tenant_id, year_month, tokens_used)
+SELECT s.tenant_id, to_char(NOW() AT TIME ZONE 'UTC', 'YYYY-MM'), LEAST(s.total_tokens_used, j.tokens_used)
+FROM tenant_ai_settings s
+JOIN (
+    SELECT tenant_id, SUM(tokens_used) AS tokens_used
+    FROM generation_jobs
+    WHERE created_at >= date_trunc('month', NOW() AT TIME ZONE 'UTC') AT TIME ZONE 'UTC'
+    GROUP BY tenant_id
+) j ON j.tenant_id = s.tenant_id
+WHERE s.total_tokens_used > 0 AND j.tokens_used > 0;
+
+-- Keep the rest of the old counter in the legacy period, which no month's limit or
+-- report reads, so the all-time total (the sum of every period) doesn't change.
+INSERT INTO token_usage_periods (tenant_id, year_month, tokens_used)
+SELECT s.tenant_id, '0000-00', s.total_tokens_used - COALESCE(p.tokens_used, 0)
+FROM tenant_ai_settings s
+LEFT JOIN token_usage_periods p ON p.tenant_id = s.tenant_id
+WHERE s.total_tokens_used > COALESCE(p.tokens_used, 0);
+
+ALTER TABLE tenant_ai_settings DROP COLUMN total_tokens_used;
+
+-- Enable RLS
+ALTER TABLE token_usage_periods ENABLE ROW LEVEL SECURITY;
+ALTER TABLE token_usage_periods FORCE ROW LEVEL SECURITY;
+
+-- RLS Policies
+CREATE POLICY token_usage_periods_isolation ON token_usage_periods
+    FOR ALL
+    USING (tenant_id = current_tenant_id() OR is_superadmin())
+    WITH CHECK (tenant_id = current_tenant_id() OR is_superadmin());
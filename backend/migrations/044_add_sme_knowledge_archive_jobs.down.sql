@@ -0,0 +1,7 @@
+-- Note: Cannot remove enum values in PostgreSQL without recreating the type
+
+DROP INDEX IF EXISTS idx_generation_jobs_sme;
+
+ALTER TABLE generation_jobs
+    DROP COLUMN IF EXISTS source_path,
+    DROP COLUMN IF EXISTS sme_id;
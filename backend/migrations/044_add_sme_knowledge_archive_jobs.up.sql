@@ -0,0 +1,10 @@
+-- Background jobs that export an SME's knowledge to a portable archive and import one back
+
+ALTER TYPE generation_job_type ADD VALUE IF NOT EXISTS 'sme_knowledge_export';
+ALTER TYPE generation_job_type ADD VALUE IF NOT EXISTS 'sme_knowledge_import';
+
+ALTER TABLE generation_jobs
+    ADD COLUMN sme_id UUID REFERENCES subject_matter_experts(id) ON DELETE SET NULL,
+    ADD COLUMN source_path TEXT;
+
+CREATE INDEX idx_generation_jobs_sme ON generation_jobs(sme_id) WHERE sme_id IS NOT NULL;
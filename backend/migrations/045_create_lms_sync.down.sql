@@ -0,0 +1,10 @@
+DROP POLICY IF EXISTS course_sync_deliveries_isolation ON course_sync_deliveries;
+DROP POLICY IF EXISTS folder_sync_settings_isolation ON folder_sync_settings;
+DROP POLICY IF EXISTS lms_connectors_isolation ON lms_connectors;
+
+DROP TABLE IF EXISTS course_sync_deliveries;
+DROP TABLE IF EXISTS folder_sync_settings;
+DROP TABLE IF EXISTS lms_connectors;
+
+DROP TYPE IF EXISTS course_sync_status;
+DROP TYPE IF EXISTS lms_connector_type;
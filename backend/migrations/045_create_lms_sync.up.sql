@@ -0,0 +1,79 @@
+-- Outbound LMS sync: tenants register connectors (a generic webhook or an HTTP push
+-- endpoint), point folders at one, and courses published in those folders are
+-- exported and delivered to it by the worker. Each delivery keeps its attempts and result.
+
+CREATE TYPE lms_connector_type AS ENUM ('webhook', 'http_push');
+CREATE TYPE course_sync_status AS ENUM ('pending', 'delivering', 'delivered', 'failed');
+
+CREATE TABLE lms_connectors (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    type lms_connector_type NOT NULL,
+    endpoint_url TEXT NOT NULL,
+    encrypted_credentials BYTEA,           -- AES-GCM; webhook signing secret or bearer token
+    enabled BOOLEAN NOT NULL DEFAULT true,
+    created_by_user_id UUID NOT NULL REFERENCES users(id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_lms_connectors_tenant ON lms_connectors(tenant_id);
+
+-- One connector per folder; courses published in the folder are synced to it
+CREATE TABLE folder_sync_settings (
+    folder_id UUID PRIMARY KEY REFERENCES folders(id) ON DELETE CASCADE,
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    connector_id UUID NOT NULL REFERENCES lms_connectors(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_folder_sync_settings_connector ON folder_sync_settings(connector_id);
+
+CREATE TABLE course_sync_deliveries (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    connector_id UUID NOT NULL REFERENCES lms_connectors(id) ON DELETE CASCADE,
+    course_id UUID NOT NULL REFERENCES courses(id) ON DELETE CASCADE,
+    status course_sync_status NOT NULL DEFAULT 'pending',
+    attempts INT NOT NULL DEFAULT 0,
+    max_attempts INT NOT NULL DEFAULT 5,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_attempt_at TIMESTAMPTZ,
+    last_error TEXT,
+    response_status INT,
+    remote_id TEXT,
+    artifact_path TEXT,                    -- Tenant-relative path of the rendered export
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_course_sync_deliveries_course ON course_sync_deliveries(course_id, created_at DESC);
+CREATE INDEX idx_course_sync_deliveries_due
+    ON course_sync_deliveries(next_attempt_at)
+    WHERE status IN ('pending', 'delivering');
+
+-- Enable RLS
+ALTER TABLE lms_connectors ENABLE ROW LEVEL SECURITY;
+ALTER TABLE lms_connectors FORCE ROW LEVEL SECURITY;
+ALTER TABLE folder_sync_settings ENABLE ROW LEVEL SECURITY;
+ALTER TABLE folder_sync_settings FORCE ROW LEVEL SECURITY;
+ALTER TABLE course_sync_deliveries ENABLE ROW LEVEL SECURITY;
+ALTER TABLE course_sync_deliveries FORCE ROW LEVEL SECURITY;
+
+-- RLS Policies
+CREATE POLICY lms_connectors_isolation ON lms_connectors
+    FOR ALL
+    USING (tenant_id = current_tenant_id() OR is_superadmin())
+    WITH CHECK (tenant_id = current_tenant_id() OR is_superadmin());
+
+CREATE POLICY folder_sync_settings_isolation ON folder_sync_settings
+    FOR ALL
+    USING (tenant_id = current_tenant_id() OR is_superadmin())
+    WITH CHECK (tenant_id = current_tenant_id() OR is_superadmin());
+
+CREATE POLICY course_sync_deliveries_isolation ON course_sync_deliveries
+    FOR ALL
+    USING (tenant_id = current_tenant_id() OR is_superadmin())
+    WITH CHECK (tenant_id = current_tenant_id() OR is_superadmin());
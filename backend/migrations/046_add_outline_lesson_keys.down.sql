@@ -0,0 +1,4 @@
+ALTER TABLE course_outlines DROP COLUMN IF EXISTS lesson_changes;
+
+DROP INDEX IF EXISTS idx_outline_lessons_key;
+ALTER TABLE outline_lessons DROP COLUMN IF EXISTS lesson_key;
@@ -0,0 +1,12 @@
+-- Stable lesson keys let a lesson keep its identity when the outline is regenerated.
+-- A lesson's key is the ID it was first generated with; matched lessons in later
+-- outline versions carry it forward so their generated content stays attached.
+
+ALTER TABLE outline_lessons ADD COLUMN lesson_key UUID;
+UPDATE outline_lessons SET lesson_key = id;
+ALTER TABLE outline_lessons ALTER COLUMN lesson_key SET NOT NULL;
+
+CREATE INDEX idx_outline_lessons_key ON outline_lessons(lesson_key);
+
+-- How a regenerated outline's lessons map to the previous version: kept, new and removed
+ALTER TABLE course_outlines ADD COLUMN lesson_changes JSONB;
@@ -0,0 +1,3 @@
+-- Note: Cannot remove enum values in PostgreSQL without recreating the type
+
+DELETE FROM lesson_components WHERE type = 'video';
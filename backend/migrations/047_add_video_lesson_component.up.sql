@@ -0,0 +1,3 @@
+-- Scripted video placeholders in generated lessons; the video file is uploaded later
+
+ALTER TYPE lesson_component_type ADD VALUE IF NOT EXISTS 'video';
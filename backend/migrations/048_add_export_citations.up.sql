@@ -0,0 +1,3 @@
+-- Lesson exports can cite the SME knowledge each component was generated from
+
+ALTER TABLE generation_jobs ADD COLUMN include_citations BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,6 @@
+-- Drop email_log table and the user bounce flag
+
+ALTER TABLE users DROP COLUMN IF EXISTS email_bounced_at;
+ALTER TABLE users DROP COLUMN IF EXISTS bounced_email;
+DROP POLICY IF EXISTS email_log_isolation ON email_log;
+DROP TABLE IF EXISTS email_log;
@@ -0,0 +1,36 @@
+-- Record the outcome of every email sent to a tenant's users and invitees, so bounced
+-- invitations and notifications can be traced. Statuses are written by the SMTP client
+-- when a message is handed off; delivered/bounced are for providers that report later,
+-- keyed by message_id.
+
+CREATE TABLE email_log (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    recipient TEXT NOT NULL,
+    template VARCHAR(50) NOT NULL,
+    message_id TEXT NOT NULL UNIQUE,
+    status VARCHAR(20) NOT NULL,
+    smtp_code INTEGER,                 -- Unset when the server could not be reached
+    smtp_response TEXT NOT NULL DEFAULT '',
+    sent_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    status_updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT email_log_status_check CHECK (status IN ('accepted', 'deferred', 'rejected', 'failed', 'delivered', 'bounced'))
+);
+
+CREATE INDEX idx_email_log_tenant_sent ON email_log(tenant_id, sent_at DESC);
+CREATE INDEX idx_email_log_recipient_sent ON email_log(tenant_id, lower(recipient), sent_at DESC);
+
+-- Enable RLS
+ALTER TABLE email_log ENABLE ROW LEVEL SECURITY;
+ALTER TABLE email_log FORCE ROW LEVEL SECURITY;
+
+-- RLS Policy
+CREATE POLICY email_log_isolation ON email_log
+    FOR ALL
+    USING (tenant_id = current_tenant_id() OR is_superadmin())
+    WITH CHECK (tenant_id = current_tenant_id() OR is_superadmin());
+
+-- Users whose recent emails hard-bounced stop receiving email until the address changes
+-- or a later email is accepted
+ALTER TABLE users ADD COLUMN bounced_email TEXT;
+ALTER TABLE users ADD COLUMN email_bounced_at TIMESTAMPTZ;
@@ -0,0 +1,8 @@
+-- Classify why a generation job failed so the UI can suggest a fix. Jobs that failed
+-- before this column existed keep a NULL reason.
+
+ALTER TABLE generation_jobs ADD COLUMN failure_reason VARCHAR(32)
+    CONSTRAINT generation_jobs_failure_reason_check CHECK (failure_reason IN (
+        'provider_auth', 'provider_rate_limit', 'provider_timeout', 'invalid_output',
+        'missing_knowledge', 'budget_exceeded', 'internal'
+    ));
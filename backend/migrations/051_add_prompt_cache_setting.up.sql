@@ -0,0 +1,4 @@
+-- Let tenants turn off caching of identical AI regeneration prompts
+
+ALTER TABLE tenant_ai_settings
+    ADD COLUMN disable_prompt_cache BOOLEAN NOT NULL DEFAULT false;
@@ -0,0 +1,4 @@
+-- Drop generation_drafts table
+
+DROP POLICY IF EXISTS generation_drafts_isolation ON generation_drafts;
+DROP TABLE IF EXISTS generation_drafts;
@@ -0,0 +1,31 @@
+-- Wizard selections a user has made for a course's AI generation but not yet started.
+-- One draft per user and course; it is deleted when a generation job starts from it
+-- and cleaned up by the cleanup worker once it goes stale.
+
+CREATE TABLE generation_drafts (
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    course_id UUID NOT NULL REFERENCES courses(id) ON DELETE CASCADE,
+    sme_ids UUID[] NOT NULL DEFAULT '{}',
+    target_audience_ids UUID[] NOT NULL DEFAULT '{}',
+    desired_outcome TEXT NOT NULL DEFAULT '',
+    additional_context TEXT,
+    max_sections INT,
+    max_lessons_per_section INT,
+    target_duration_minutes INT,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, course_id)
+);
+
+CREATE INDEX idx_generation_drafts_tenant ON generation_drafts(tenant_id);
+CREATE INDEX idx_generation_drafts_updated ON generation_drafts(updated_at);
+
+-- Enable RLS
+ALTER TABLE generation_drafts ENABLE ROW LEVEL SECURITY;
+ALTER TABLE generation_drafts FORCE ROW LEVEL SECURITY;
+
+-- RLS Policy
+CREATE POLICY generation_drafts_isolation ON generation_drafts
+    FOR ALL
+    USING (tenant_id = current_tenant_id() OR is_superadmin())
+    WITH CHECK (tenant_id = current_tenant_id() OR is_superadmin());
@@ -0,0 +1,2 @@
+ALTER TABLE sme_tasks
+    DROP COLUMN IF EXISTS questions;
@@ -0,0 +1,5 @@
+-- Interview questions an SME answers in place of uploading documents.
+-- A JSON array of strings; empty for upload tasks.
+
+ALTER TABLE sme_tasks
+    ADD COLUMN questions JSONB NOT NULL DEFAULT '[]';
@@ -0,0 +1,3 @@
+-- Note: Cannot remove enum values in PostgreSQL without recreating the type
+
+ALTER TABLE generation_jobs DROP COLUMN IF EXISTS purge_orphans;
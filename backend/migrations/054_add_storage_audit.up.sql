@@ -0,0 +1,5 @@
+-- Storage audits report objects no database row references, and can delete them
+
+ALTER TYPE generation_job_type ADD VALUE IF NOT EXISTS 'storage_audit';
+
+ALTER TABLE generation_jobs ADD COLUMN purge_orphans BOOLEAN NOT NULL DEFAULT FALSE;
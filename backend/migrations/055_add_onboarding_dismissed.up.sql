@@ -0,0 +1,4 @@
+-- When a user hid the onboarding checklist; NULL while it is still shown.
+
+ALTER TABLE users
+    ADD COLUMN onboarding_dismissed_at TIMESTAMPTZ;
@@ -0,0 +1,9 @@
+-- Remove image generation
+ALTER TABLE generation_jobs
+    DROP COLUMN IF EXISTS images_generated;
+
+ALTER TABLE course_generation_inputs
+    DROP COLUMN IF EXISTS generate_images;
+
+ALTER TABLE tenant_ai_settings
+    DROP COLUMN IF EXISTS default_generate_images;
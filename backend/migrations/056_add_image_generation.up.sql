@@ -0,0 +1,11 @@
+-- Optional AI image generation for image components, with a tenant-level default
+-- and a per-job count of images generated
+
+ALTER TABLE tenant_ai_settings
+    ADD COLUMN default_generate_images BOOLEAN NOT NULL DEFAULT false;
+
+ALTER TABLE course_generation_inputs
+    ADD COLUMN generate_images BOOLEAN NOT NULL DEFAULT false;
+
+ALTER TABLE generation_jobs
+    ADD COLUMN images_generated INTEGER NOT NULL DEFAULT 0;
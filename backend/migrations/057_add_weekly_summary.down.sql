@@ -0,0 +1,10 @@
+-- Remove weekly summary email
+DROP POLICY IF EXISTS weekly_summary_sends_isolation ON weekly_summary_sends;
+DROP TABLE IF EXISTS weekly_summary_sends;
+
+ALTER TABLE users DROP COLUMN IF EXISTS weekly_summary_opt_out;
+
+ALTER TABLE tenant_ai_settings
+    DROP COLUMN IF EXISTS weekly_summary_enabled,
+    DROP COLUMN IF EXISTS weekly_summary_day,
+    DROP COLUMN IF EXISTS weekly_summary_hour;
@@ -0,0 +1,29 @@
+-- Weekly summary email for tenant admins: schedule and enablement per tenant,
+-- a per-user opt-out, and a record of each week's send so a worker restart
+-- never mails the same week twice.
+
+ALTER TABLE tenant_ai_settings
+    ADD COLUMN weekly_summary_enabled BOOLEAN NOT NULL DEFAULT false,
+    ADD COLUMN weekly_summary_day SMALLINT NOT NULL DEFAULT 1 CHECK (weekly_summary_day BETWEEN 0 AND 6),   -- 0 = Sunday
+    ADD COLUMN weekly_summary_hour SMALLINT NOT NULL DEFAULT 8 CHECK (weekly_summary_hour BETWEEN 0 AND 23); -- UTC
+
+ALTER TABLE users
+    ADD COLUMN weekly_summary_opt_out BOOLEAN NOT NULL DEFAULT false;
+
+CREATE TABLE weekly_summary_sends (
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    week_start DATE NOT NULL,
+    recipients INT NOT NULL DEFAULT 0,
+    sent_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (tenant_id, week_start)
+);
+
+-- Enable RLS
+ALTER TABLE weekly_summary_sends ENABLE ROW LEVEL SECURITY;
+ALTER TABLE weekly_summary_sends FORCE ROW LEVEL SECURITY;
+
+-- RLS Policy
+CREATE POLICY weekly_summary_sends_isolation ON weekly_summary_sends
+    FOR ALL
+    USING (tenant_id = current_tenant_id() OR is_superadmin())
+    WITH CHECK (tenant_id = current_tenant_id() OR is_superadmin());
@@ -0,0 +1,3 @@
+-- Remove SME deletion confirmation tokens
+DROP POLICY IF EXISTS sme_deletion_tokens_isolation ON sme_deletion_tokens;
+DROP TABLE IF EXISTS sme_deletion_tokens;
@@ -0,0 +1,24 @@
+-- Confirmation tokens for SME deletion. GetSMEDeletionImpact issues a token
+-- after showing what depends on the SME; DeleteSME consumes it, so an SME is
+-- never deleted without its impact having been shown to the same user.
+
+CREATE TABLE sme_deletion_tokens (
+    token TEXT PRIMARY KEY,
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    sme_id UUID NOT NULL REFERENCES subject_matter_experts(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_sme_deletion_tokens_sme ON sme_deletion_tokens(sme_id);
+
+-- Enable RLS
+ALTER TABLE sme_deletion_tokens ENABLE ROW LEVEL SECURITY;
+ALTER TABLE sme_deletion_tokens FORCE ROW LEVEL SECURITY;
+
+-- RLS Policy
+CREATE POLICY sme_deletion_tokens_isolation ON sme_deletion_tokens
+    FOR ALL
+    USING (tenant_id = current_tenant_id() OR is_superadmin())
+    WITH CHECK (tenant_id = current_tenant_id() OR is_superadmin());
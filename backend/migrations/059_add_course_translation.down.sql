@@ -0,0 +1,7 @@
+-- Note: Cannot remove enum values in PostgreSQL without recreating the type
+
+DROP INDEX IF EXISTS idx_courses_source_course;
+
+ALTER TABLE courses
+    DROP COLUMN IF EXISTS source_course_id,
+    DROP COLUMN IF EXISTS language;
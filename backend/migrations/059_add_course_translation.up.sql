@@ -0,0 +1,10 @@
+-- Course translation: a job type that copies a course's generated content into a
+-- new course in another language, and the link from that copy to its source
+
+ALTER TYPE generation_job_type ADD VALUE IF NOT EXISTS 'course_translation';
+
+ALTER TABLE courses
+    ADD COLUMN source_course_id UUID REFERENCES courses(id) ON DELETE SET NULL,
+    ADD COLUMN language TEXT; -- BCP 47 tag; NULL for courses that were not translated
+
+CREATE INDEX idx_courses_source_course ON courses(source_course_id) WHERE source_course_id IS NOT NULL;
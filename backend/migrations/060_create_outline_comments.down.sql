@@ -0,0 +1,5 @@
+-- Drop outline_comments table
+-- Note: Cannot remove enum values in PostgreSQL without recreating the type
+
+DROP POLICY IF EXISTS outline_comments_isolation ON outline_comments;
+DROP TABLE IF EXISTS outline_comments;
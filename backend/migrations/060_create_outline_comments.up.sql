@@ -0,0 +1,32 @@
+-- Reviewer comments on outline lessons. Comments hang off the lesson key rather
+-- than the lesson row, so they stay attached when the outline is edited or
+-- regenerated into a new version.
+
+CREATE TABLE outline_comments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    course_id UUID NOT NULL REFERENCES courses(id) ON DELETE CASCADE,
+    lesson_key UUID NOT NULL,
+    author_user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    body TEXT NOT NULL,
+    resolved BOOLEAN NOT NULL DEFAULT FALSE,
+    resolved_by_user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    resolved_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_outline_comments_tenant_id ON outline_comments(tenant_id);
+CREATE INDEX idx_outline_comments_course_lesson ON outline_comments(course_id, lesson_key);
+
+-- Enable RLS
+ALTER TABLE outline_comments ENABLE ROW LEVEL SECURITY;
+ALTER TABLE outline_comments FORCE ROW LEVEL SECURITY;
+
+-- RLS Policy
+CREATE POLICY outline_comments_isolation ON outline_comments
+    FOR ALL
+    USING (tenant_id = current_tenant_id() OR is_superadmin())
+    WITH CHECK (tenant_id = current_tenant_id() OR is_superadmin());
+
+-- Notify the course owner when a comment is added
+ALTER TYPE notification_type ADD VALUE IF NOT EXISTS 'outline_comment';
@@ -0,0 +1,5 @@
+-- Let support pause a tenant's AI generation. While disabled, generation requests are
+-- refused and the worker leaves the tenant's queued jobs alone until it is re-enabled
+
+ALTER TABLE tenant_ai_settings
+    ADD COLUMN ai_generation_enabled BOOLEAN NOT NULL DEFAULT true;
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_courses_content_size;
+
+ALTER TABLE courses
+    DROP COLUMN IF EXISTS content_size_bytes;
@@ -0,0 +1,8 @@
+-- Serialized size of each course's S3 content, recorded on every write, so oversized
+-- courses can be found without reading their content
+
+ALTER TABLE courses
+    ADD COLUMN content_size_bytes BIGINT; -- NULL until the content is next written
+
+CREATE INDEX idx_courses_content_size ON courses(tenant_id, content_size_bytes DESC)
+    WHERE content_size_bytes IS NOT NULL;
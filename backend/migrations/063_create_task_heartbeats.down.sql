@@ -0,0 +1,4 @@
+-- Drop task_heartbeats table
+
+DROP POLICY IF EXISTS task_heartbeats_isolation ON task_heartbeats;
+DROP TABLE IF EXISTS task_heartbeats;
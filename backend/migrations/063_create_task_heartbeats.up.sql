@@ -0,0 +1,20 @@
+-- Record when each background task last succeeded, so support can tell from the
+-- diagnostics RPC whether the worker and its scheduled sweeps are still running.
+-- One row per task type, upserted by the worker after every successful run.
+
+CREATE TABLE task_heartbeats (
+    task_type VARCHAR(50) PRIMARY KEY,
+    last_succeeded_at TIMESTAMPTZ NOT NULL,
+    last_duration_ms BIGINT NOT NULL,
+    worker_host TEXT NOT NULL DEFAULT ''
+);
+
+-- Enable RLS
+ALTER TABLE task_heartbeats ENABLE ROW LEVEL SECURITY;
+ALTER TABLE task_heartbeats FORCE ROW LEVEL SECURITY;
+
+-- RLS Policy: system-wide rows, visible to the worker and superadmins only
+CREATE POLICY task_heartbeats_isolation ON task_heartbeats
+    FOR ALL
+    USING (is_superadmin())
+    WITH CHECK (is_superadmin());
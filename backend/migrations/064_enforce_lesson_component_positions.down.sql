@@ -0,0 +1,2 @@
+ALTER TABLE lesson_components DROP CONSTRAINT IF EXISTS lesson_components_lesson_position_key;
+CREATE INDEX IF NOT EXISTS idx_lesson_components_position ON lesson_components(lesson_id, position);
@@ -0,0 +1,19 @@
+-- Keep each lesson's component positions unique and gap-free (1..n), so the editor
+-- renders components in the same order on every load.
+
+-- Renumber existing components in their current order, breaking ties between
+-- duplicate positions by id
+UPDATE lesson_components lc
+SET position = ranked.position
+FROM (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY lesson_id ORDER BY position, id) AS position
+    FROM lesson_components
+) ranked
+WHERE lc.id = ranked.id AND lc.position <> ranked.position;
+
+-- The unique constraint replaces the plain position index. It is checked at the end of
+-- each statement rather than per row, so a single UPDATE can shift a run of positions.
+DROP INDEX IF EXISTS idx_lesson_components_position;
+ALTER TABLE lesson_components
+    ADD CONSTRAINT lesson_components_lesson_position_key UNIQUE (lesson_id, position)
+    DEFERRABLE INITIALLY IMMEDIATE;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_invitations_team_pending;
+ALTER TABLE invitations DROP COLUMN IF EXISTS team_id;
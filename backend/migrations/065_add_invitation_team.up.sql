@@ -0,0 +1,7 @@
+-- An invitation can name a team the invitee joins on acceptance, so team dashboards
+-- can show who has been invited but not yet joined.
+
+ALTER TABLE invitations ADD COLUMN team_id UUID REFERENCES teams(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_invitations_team_pending ON invitations(team_id, created_at DESC)
+    WHERE team_id IS NOT NULL AND status = 'pending';
@@ -0,0 +1,3 @@
+ALTER TABLE courses
+    DROP COLUMN IF EXISTS published_version,
+    DROP COLUMN IF EXISTS draft_version;
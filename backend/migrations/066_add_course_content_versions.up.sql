@@ -0,0 +1,9 @@
+-- Published courses keep a frozen copy of their content for learners while authors edit
+-- the draft. draft_version counts edits; published_version is the draft version learners
+-- see, stored under courses/{id}/published/v{N}/, or 0 until the course is first published.
+-- Courses already published start at 0 too: the worker's published version backfill, or
+-- the first edit, snapshots their current content and sets published_version.
+
+ALTER TABLE courses
+    ADD COLUMN draft_version INT NOT NULL DEFAULT 1,
+    ADD COLUMN published_version INT NOT NULL DEFAULT 0;
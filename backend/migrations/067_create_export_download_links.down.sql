@@ -0,0 +1,5 @@
+-- Remove export download links and their access log
+DROP POLICY IF EXISTS export_access_log_isolation ON export_access_log;
+DROP TABLE IF EXISTS export_access_log;
+DROP POLICY IF EXISTS export_download_links_isolation ON export_download_links;
+DROP TABLE IF EXISTS export_download_links;
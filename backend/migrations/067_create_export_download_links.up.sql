@@ -0,0 +1,44 @@
+-- Download links emailed when an export finishes. Each link is a random token
+-- that resolves, until it expires, to a short-lived presigned URL for the
+-- export file. Every use of a link is recorded in export_access_log.
+
+CREATE TABLE export_download_links (
+    token TEXT PRIMARY KEY,
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    job_id UUID NOT NULL REFERENCES generation_jobs(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    file_path TEXT NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_export_download_links_job ON export_download_links(job_id);
+
+CREATE TABLE export_access_log (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    job_id UUID NOT NULL REFERENCES generation_jobs(id) ON DELETE CASCADE,
+    token TEXT NOT NULL,
+    ip_address TEXT,
+    user_agent TEXT,
+    accessed_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_export_access_log_job ON export_access_log(job_id, accessed_at DESC);
+
+-- Enable RLS
+ALTER TABLE export_download_links ENABLE ROW LEVEL SECURITY;
+ALTER TABLE export_download_links FORCE ROW LEVEL SECURITY;
+ALTER TABLE export_access_log ENABLE ROW LEVEL SECURITY;
+ALTER TABLE export_access_log FORCE ROW LEVEL SECURITY;
+
+-- RLS Policies
+CREATE POLICY export_download_links_isolation ON export_download_links
+    FOR ALL
+    USING (tenant_id = current_tenant_id() OR is_superadmin())
+    WITH CHECK (tenant_id = current_tenant_id() OR is_superadmin());
+
+CREATE POLICY export_access_log_isolation ON export_access_log
+    FOR ALL
+    USING (tenant_id = current_tenant_id() OR is_superadmin())
+    WITH CHECK (tenant_id = current_tenant_id() OR is_superadmin());
@@ -0,0 +1,2 @@
+-- Remove the lesson component full-text index
+DROP INDEX IF EXISTS idx_lesson_components_search;
@@ -0,0 +1,6 @@
+-- Full-text index over the string values of each component's content, for searching
+-- within a course. The 'simple' configuration doesn't stem, so phrases match as typed
+-- in any language.
+
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_lesson_components_search
+ON lesson_components USING GIN (jsonb_to_tsvector('simple', content_json, '["string"]'));
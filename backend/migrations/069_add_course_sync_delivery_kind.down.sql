@@ -0,0 +1,10 @@
+-- Remove diagnostic deliveries and the kind column
+DELETE FROM course_sync_deliveries WHERE kind <> 'sync' OR course_id IS NULL;
+
+ALTER TABLE course_sync_deliveries
+    DROP COLUMN replay_of_id,
+    DROP COLUMN event,
+    DROP COLUMN kind,
+    ALTER COLUMN course_id SET NOT NULL;
+
+DROP TYPE IF EXISTS course_sync_delivery_kind;
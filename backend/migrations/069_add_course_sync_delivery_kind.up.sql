@@ -0,0 +1,12 @@
+-- Diagnostic webhook traffic: admins can test-fire a connector with a synthetic
+-- event or replay an earlier delivery to a receiver that was down. Both are
+-- recorded alongside normal syncs, marked by kind so they can be told apart.
+-- Test deliveries aren't about a course, so course_id becomes optional.
+
+CREATE TYPE course_sync_delivery_kind AS ENUM ('sync', 'test', 'replay');
+
+ALTER TABLE course_sync_deliveries
+    ADD COLUMN kind course_sync_delivery_kind NOT NULL DEFAULT 'sync',
+    ADD COLUMN event VARCHAR(64) NOT NULL DEFAULT 'course.published',
+    ADD COLUMN replay_of_id UUID REFERENCES course_sync_deliveries(id) ON DELETE SET NULL,
+    ALTER COLUMN course_id DROP NOT NULL;
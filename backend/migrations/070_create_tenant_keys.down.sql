@@ -0,0 +1,4 @@
+-- Remove per-tenant data keys. Encrypted objects can't be read once their keys
+-- are gone, so only roll back before any content has been encrypted.
+DROP POLICY IF EXISTS tenant_keys_isolation ON tenant_keys;
+DROP TABLE IF EXISTS tenant_keys;
@@ -0,0 +1,28 @@
+-- Per-tenant data keys for encrypting course content in object storage. Each
+-- key is stored wrapped (encrypted) with the master ENCRYPTION_KEY. Rotating a
+-- tenant's key retires the active one and adds the next version; retired keys
+-- are kept so objects written with them can still be read until they are
+-- re-encrypted.
+
+CREATE TABLE tenant_keys (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    version INTEGER NOT NULL,
+    wrapped_key BYTEA NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    retired_at TIMESTAMPTZ,
+    UNIQUE (tenant_id, version)
+);
+
+-- At most one active key per tenant
+CREATE UNIQUE INDEX idx_tenant_keys_active ON tenant_keys(tenant_id) WHERE retired_at IS NULL;
+
+-- Enable RLS
+ALTER TABLE tenant_keys ENABLE ROW LEVEL SECURITY;
+ALTER TABLE tenant_keys FORCE ROW LEVEL SECURITY;
+
+-- RLS Policies
+CREATE POLICY tenant_keys_isolation ON tenant_keys
+    FOR ALL
+    USING (tenant_id = current_tenant_id() OR is_superadmin())
+    WITH CHECK (tenant_id = current_tenant_id() OR is_superadmin());
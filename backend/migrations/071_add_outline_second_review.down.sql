@@ -0,0 +1,7 @@
+ALTER TABLE course_outlines
+    DROP COLUMN IF EXISTS endorsed_by_user_id,
+    DROP COLUMN IF EXISTS endorsed_at,
+    DROP COLUMN IF EXISTS generated_by_user_id;
+
+ALTER TABLE tenant_ai_settings
+    DROP COLUMN IF EXISTS require_second_reviewer;
@@ -0,0 +1,24 @@
+-- Let tenants require a second reviewer for outline approval: the user who generated an
+-- outline can't approve it, and a first reviewer's endorsement must be followed by a
+-- different reviewer's approval
+
+ALTER TABLE tenant_ai_settings
+    ADD COLUMN require_second_reviewer BOOLEAN NOT NULL DEFAULT false;
+
+ALTER TABLE course_outlines
+    ADD COLUMN generated_by_user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    ADD COLUMN endorsed_at TIMESTAMPTZ,
+    ADD COLUMN endorsed_by_user_id UUID REFERENCES users(id) ON DELETE SET NULL;
+
+-- Existing outlines take the creator of the latest outline job for their course that
+-- was started before the outline was stored
+UPDATE course_outlines o
+SET generated_by_user_id = (
+    SELECT j.created_by_user_id
+    FROM generation_jobs j
+    WHERE j.course_id = o.course_id
+      AND j.type = 'course_outline'
+      AND j.created_at <= o.generated_at
+    ORDER BY j.created_at DESC
+    LIMIT 1
+);
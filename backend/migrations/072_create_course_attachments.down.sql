@@ -0,0 +1,6 @@
+-- Remove course reference attachments. Their stored files are left in place
+-- under each course's prefix and are removed with the course.
+DROP POLICY IF EXISTS course_attachment_chunks_isolation ON course_attachment_chunks;
+DROP POLICY IF EXISTS course_attachments_isolation ON course_attachments;
+DROP TABLE IF EXISTS course_attachment_chunks;
+DROP TABLE IF EXISTS course_attachments;
@@ -0,0 +1,57 @@
+-- Reference files attached directly to a course, such as a style guide or
+-- product spec. Each upload's text is extracted into chunks that generation
+-- prompts include alongside SME knowledge. Files live under the course's
+-- storage prefix; rows go with the course.
+
+CREATE TABLE course_attachments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    course_id UUID NOT NULL REFERENCES courses(id) ON DELETE CASCADE,
+    file_name TEXT NOT NULL,
+    content_type TEXT NOT NULL DEFAULT '',
+    file_path TEXT NOT NULL,
+    size_bytes BIGINT NOT NULL DEFAULT 0,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'processing', 'ready', 'failed')),
+    error_message TEXT,
+    chunk_count INTEGER NOT NULL DEFAULT 0,
+    flagged_chunk_count INTEGER NOT NULL DEFAULT 0,
+    excluded_from_prompts BOOLEAN NOT NULL DEFAULT FALSE,
+    uploaded_by_user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    processed_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_course_attachments_tenant_id ON course_attachments(tenant_id);
+CREATE INDEX idx_course_attachments_course_id ON course_attachments(course_id, created_at);
+
+-- Extracted text, in document order. Chunks that look like prompt injection
+-- are kept for reference but never reach a prompt.
+CREATE TABLE course_attachment_chunks (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    attachment_id UUID NOT NULL REFERENCES course_attachments(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    content TEXT NOT NULL,
+    injection_flagged BOOLEAN NOT NULL DEFAULT FALSE,
+    UNIQUE (attachment_id, position)
+);
+
+CREATE INDEX idx_course_attachment_chunks_tenant_id ON course_attachment_chunks(tenant_id);
+
+-- Enable RLS
+ALTER TABLE course_attachments ENABLE ROW LEVEL SECURITY;
+ALTER TABLE course_attachments FORCE ROW LEVEL SECURITY;
+ALTER TABLE course_attachment_chunks ENABLE ROW LEVEL SECURITY;
+ALTER TABLE course_attachment_chunks FORCE ROW LEVEL SECURITY;
+
+-- RLS Policies
+CREATE POLICY course_attachments_isolation ON course_attachments
+    FOR ALL
+    USING (tenant_id = current_tenant_id() OR is_superadmin())
+    WITH CHECK (tenant_id = current_tenant_id() OR is_superadmin());
+
+CREATE POLICY course_attachment_chunks_isolation ON course_attachment_chunks
+    FOR ALL
+    USING (tenant_id = current_tenant_id() OR is_superadmin())
+    WITH CHECK (tenant_id = current_tenant_id() OR is_superadmin());
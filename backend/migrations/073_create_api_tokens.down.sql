@@ -0,0 +1,5 @@
+ALTER TABLE audit_events
+    DROP COLUMN IF EXISTS api_token_id;
+
+DROP POLICY IF EXISTS api_tokens_isolation ON api_tokens;
+DROP TABLE IF EXISTS api_tokens;
@@ -0,0 +1,38 @@
+-- Personal API tokens for calling the API from scripts and intranet tooling. A token
+-- acts as the user who created it, limited to its scopes. Only a SHA-256 hash of the
+-- secret is stored; the secret itself is shown once, when the token is created.
+
+CREATE TABLE api_tokens (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    token_prefix TEXT NOT NULL,              -- Leading characters of the secret, for recognizing it
+    secret_hash TEXT NOT NULL UNIQUE,        -- Hex SHA-256 of the secret
+    scopes TEXT[] NOT NULL,
+    rate_limit_per_minute INTEGER NOT NULL CHECK (rate_limit_per_minute > 0),
+    expires_at TIMESTAMPTZ NOT NULL,
+    last_used_at TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ,
+    created_by_user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_api_tokens_tenant_id ON api_tokens(tenant_id);
+CREATE INDEX idx_api_tokens_user_id ON api_tokens(user_id, created_at DESC);
+
+-- Enable RLS
+ALTER TABLE api_tokens ENABLE ROW LEVEL SECURITY;
+ALTER TABLE api_tokens FORCE ROW LEVEL SECURITY;
+
+-- RLS Policies. Bearer authentication looks tokens up by hash before the tenant is
+-- known, as superadmin.
+CREATE POLICY api_tokens_isolation ON api_tokens
+    FOR ALL
+    USING (tenant_id = current_tenant_id() OR is_superadmin())
+    WITH CHECK (tenant_id = current_tenant_id() OR is_superadmin());
+
+-- Record which API token, if any, made an audited change. No foreign key, so history
+-- outlives deleted tokens.
+ALTER TABLE audit_events
+    ADD COLUMN api_token_id UUID;
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_generation_jobs_top_level;
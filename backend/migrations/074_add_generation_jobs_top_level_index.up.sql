@@ -0,0 +1,4 @@
+-- Job lists page through parent and standalone jobs, newest first, leaving out the
+-- per-lesson children of full course runs
+CREATE INDEX idx_generation_jobs_top_level ON generation_jobs(tenant_id, created_at DESC, id DESC)
+    WHERE parent_job_id IS NULL;
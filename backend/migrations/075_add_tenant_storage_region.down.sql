@@ -0,0 +1,7 @@
+-- Remove tenant storage regions
+
+DROP TRIGGER IF EXISTS tenants_storage_region_immutable ON tenants;
+DROP FUNCTION IF EXISTS reject_storage_region_change();
+ALTER TABLE pending_registrations DROP COLUMN IF EXISTS storage_region;
+ALTER TABLE tenants DROP COLUMN IF EXISTS storage_migration_region;
+ALTER TABLE tenants DROP COLUMN IF EXISTS storage_region;
@@ -0,0 +1,23 @@
+-- Storage region holding a tenant's objects, chosen at signup. NULL is the primary region.
+ALTER TABLE tenants ADD COLUMN storage_region VARCHAR(32);
+
+-- Region a running storage migration is copying the tenant's objects to
+ALTER TABLE tenants ADD COLUMN storage_migration_region VARCHAR(32);
+
+ALTER TABLE pending_registrations ADD COLUMN storage_region VARCHAR(32);
+
+-- A tenant's region is fixed at provisioning; only a storage migration may move it, and
+-- only to the region it is migrating to
+CREATE OR REPLACE FUNCTION reject_storage_region_change() RETURNS TRIGGER AS $$
+BEGIN
+    IF NEW.storage_region IS DISTINCT FROM OLD.storage_region
+        AND (OLD.storage_migration_region IS NULL OR NEW.storage_region IS DISTINCT FROM OLD.storage_migration_region) THEN
+        RAISE EXCEPTION 'tenant storage region can only change through a storage migration';
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER tenants_storage_region_immutable
+    BEFORE UPDATE OF storage_region ON tenants
+    FOR EACH ROW EXECUTE FUNCTION reject_storage_region_change();
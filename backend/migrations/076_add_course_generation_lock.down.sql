@@ -0,0 +1,5 @@
+-- Remove course generation locks
+
+DROP INDEX IF EXISTS idx_courses_generation_lock;
+ALTER TABLE courses DROP COLUMN IF EXISTS generation_locked_at;
+ALTER TABLE courses DROP COLUMN IF EXISTS generation_lock_job_id;
@@ -0,0 +1,8 @@
+-- Full course run holding the course read-only while it generates. Set when the run's
+-- parent job starts and cleared when it ends.
+ALTER TABLE courses ADD COLUMN generation_lock_job_id UUID REFERENCES generation_jobs(id) ON DELETE SET NULL;
+ALTER TABLE courses ADD COLUMN generation_locked_at TIMESTAMPTZ;
+
+-- The stale lock sweep only looks at locked courses
+CREATE INDEX idx_courses_generation_lock ON courses(generation_lock_job_id)
+    WHERE generation_lock_job_id IS NOT NULL;
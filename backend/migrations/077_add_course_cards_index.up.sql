@@ -0,0 +1,4 @@
+-- Library cards page through a tenant's courses by modification time. Holding the card
+-- columns lets the page be read with an index-only scan.
+CREATE INDEX idx_courses_cards ON courses(tenant_id, updated_at DESC, id DESC)
+    INCLUDE (title, status, folder_id);
@@ -0,0 +1,3 @@
+-- Submission status looks up a submission's latest ingestion job.
+CREATE INDEX idx_generation_jobs_submission ON generation_jobs(submission_id, created_at DESC)
+    WHERE submission_id IS NOT NULL;
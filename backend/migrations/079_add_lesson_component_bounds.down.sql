@@ -0,0 +1,11 @@
+-- Remove lesson component count bounds
+ALTER TABLE generated_lessons
+    DROP COLUMN IF EXISTS generated_component_count;
+
+ALTER TABLE course_generation_inputs
+    DROP COLUMN IF EXISTS max_lesson_components,
+    DROP COLUMN IF EXISTS min_lesson_components;
+
+ALTER TABLE tenant_ai_settings
+    DROP COLUMN IF EXISTS default_max_lesson_components,
+    DROP COLUMN IF EXISTS default_min_lesson_components;
@@ -0,0 +1,14 @@
+-- Lesson component count bounds, with a tenant-level default and a per-course value,
+-- and the component count each generated lesson ended up with
+
+ALTER TABLE tenant_ai_settings
+    ADD COLUMN default_min_lesson_components INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN default_max_lesson_components INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE course_generation_inputs
+    ADD COLUMN min_lesson_components INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN max_lesson_components INTEGER NOT NULL DEFAULT 0;
+
+-- NULL for lessons generated before the count was recorded
+ALTER TABLE generated_lessons
+    ADD COLUMN generated_component_count INTEGER;
@@ -0,0 +1,6 @@
+-- Remove export artifacts and the tenant retention setting
+ALTER TABLE tenant_ai_settings
+    DROP COLUMN IF EXISTS export_retention_days;
+
+DROP POLICY IF EXISTS export_artifacts_isolation ON export_artifacts;
+DROP TABLE IF EXISTS export_artifacts;
@@ -0,0 +1,38 @@
+-- Files produced by export jobs, kept for the tenant's retention period. Expired
+-- artifacts are deleted from storage by the hourly cleanup, and an export
+-- supersedes the previous artifact of the same type for its course or SME;
+-- either way the row stays, marked purged, so downloads can explain why.
+
+CREATE TABLE export_artifacts (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    job_id UUID NOT NULL UNIQUE REFERENCES generation_jobs(id) ON DELETE CASCADE,
+    course_id UUID REFERENCES courses(id) ON DELETE CASCADE,
+    sme_id UUID REFERENCES subject_matter_experts(id) ON DELETE CASCADE,
+    artifact_type TEXT NOT NULL,
+    file_path TEXT NOT NULL,
+    size_bytes BIGINT NOT NULL DEFAULT 0,
+    created_by_user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMPTZ NOT NULL,
+    purged_at TIMESTAMPTZ,
+    purge_reason TEXT CHECK (purge_reason IN ('expired', 'superseded'))
+);
+
+CREATE INDEX idx_export_artifacts_course ON export_artifacts(course_id, created_at DESC) WHERE course_id IS NOT NULL;
+CREATE INDEX idx_export_artifacts_sme ON export_artifacts(sme_id, created_at DESC) WHERE sme_id IS NOT NULL;
+CREATE INDEX idx_export_artifacts_expiry ON export_artifacts(expires_at) WHERE purged_at IS NULL;
+
+-- Days export artifacts are kept before the cleanup deletes them
+ALTER TABLE tenant_ai_settings
+    ADD COLUMN export_retention_days INTEGER NOT NULL DEFAULT 30;
+
+-- Enable RLS
+ALTER TABLE export_artifacts ENABLE ROW LEVEL SECURITY;
+ALTER TABLE export_artifacts FORCE ROW LEVEL SECURITY;
+
+-- RLS Policies
+CREATE POLICY export_artifacts_isolation ON export_artifacts
+    FOR ALL
+    USING (tenant_id = current_tenant_id() OR is_superadmin())
+    WITH CHECK (tenant_id = current_tenant_id() OR is_superadmin());
@@ -0,0 +1,6 @@
+-- Remove generation job child tracking
+DROP INDEX IF EXISTS idx_generation_jobs_unenqueued_children;
+
+ALTER TABLE generation_jobs
+    DROP COLUMN IF EXISTS enqueued_at,
+    DROP COLUMN IF EXISTS total_children;
@@ -0,0 +1,12 @@
+-- How many lesson jobs a full_course parent queued, so finalization can tell a missing
+-- child from a finished one, and when each job's worker task was enqueued, so jobs whose
+-- enqueue failed can be found and enqueued again
+
+-- NULL for parents created before it was recorded; they count their child rows instead
+ALTER TABLE generation_jobs
+    ADD COLUMN total_children INTEGER,
+    ADD COLUMN enqueued_at TIMESTAMPTZ;
+
+-- Queued child jobs that still need a worker task
+CREATE INDEX idx_generation_jobs_unenqueued_children ON generation_jobs(created_at)
+    WHERE status = 'queued' AND enqueued_at IS NULL AND parent_job_id IS NOT NULL;
@@ -0,0 +1,11 @@
+-- Lessons whose outline lesson was removed can't reference one again
+-- Note: Cannot remove enum values in PostgreSQL without recreating the type
+
+DELETE FROM generated_lessons WHERE outline_lesson_id IS NULL;
+
+ALTER TABLE generated_lessons DROP CONSTRAINT IF EXISTS generated_lessons_outline_lesson_or_orphaned;
+ALTER TABLE generated_lessons DROP CONSTRAINT generated_lessons_outline_lesson_id_fkey;
+ALTER TABLE generated_lessons ALTER COLUMN outline_lesson_id SET NOT NULL;
+ALTER TABLE generated_lessons
+    ADD CONSTRAINT generated_lessons_outline_lesson_id_fkey
+    FOREIGN KEY (outline_lesson_id) REFERENCES outline_lessons(id) ON DELETE CASCADE;
@@ -0,0 +1,16 @@
+-- Removing an outline lesson orphans the lessons generated for it instead of deleting
+-- them, so their content can still be recovered. The service orphans them first; the
+-- check rejects deleting an outline lesson whose generated lesson wasn't orphaned.
+
+ALTER TABLE generated_lessons DROP CONSTRAINT generated_lessons_outline_lesson_id_fkey;
+ALTER TABLE generated_lessons ALTER COLUMN outline_lesson_id DROP NOT NULL;
+ALTER TABLE generated_lessons
+    ADD CONSTRAINT generated_lessons_outline_lesson_id_fkey
+    FOREIGN KEY (outline_lesson_id) REFERENCES outline_lessons(id) ON DELETE SET NULL;
+
+ALTER TABLE generated_lessons
+    ADD CONSTRAINT generated_lessons_outline_lesson_or_orphaned
+    CHECK (outline_lesson_id IS NOT NULL OR orphaned_at IS NOT NULL);
+
+-- Notification sent when generated lessons are orphaned
+ALTER TYPE notification_type ADD VALUE IF NOT EXISTS 'lessons_orphaned';
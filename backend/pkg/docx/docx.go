@@ -0,0 +1,211 @@
+// Package docx writes minimal Word (OOXML) documents: a title, headings,
+// paragraphs and bullet lists, with no styling beyond the built-in styles.
+// Headings can carry reviewer comments, which Word shows as margin notes.
+// ExtractText reads the body text back out of any .docx file.
+package docx
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Document accumulates body content and renders it as a .docx file.
+type Document struct {
+	body     strings.Builder
+	comments strings.Builder
+	nextID   int
+}
+
+// Comment is a margin note anchored to a paragraph.
+type Comment struct {
+	Author string
+	Date   time.Time
+	Text   string
+}
+
+// New creates an empty document.
+func New() *Document {
+	return &Document{}
+}
+
+// Title adds a paragraph in the Title style.
+func (d *Document) Title(text string) {
+	d.paragraph("Title", "", text)
+}
+
+// Heading adds a heading paragraph. Levels outside 1-3 are clamped.
+func (d *Document) Heading(level int, text string) {
+	d.CommentedHeading(level, text, nil)
+}
+
+// CommentedHeading adds a heading paragraph with comments anchored to its text.
+// Levels outside 1-3 are clamped.
+func (d *Document) CommentedHeading(level int, text string, comments []Comment) {
+	if level < 1 {
+		level = 1
+	}
+	if level > 3 {
+		level = 3
+	}
+	d.commentedParagraph(fmt.Sprintf("Heading%d", level), text, comments)
+}
+
+// Paragraph adds a plain body paragraph.
+func (d *Document) Paragraph(text string) {
+	d.paragraph("", "", text)
+}
+
+// Bullet adds a bulleted list item.
+func (d *Document) Bullet(text string) {
+	d.paragraph("ListParagraph", `<w:numPr><w:ilvl w:val="0"/><w:numId w:val="1"/></w:numPr>`, text)
+}
+
+func (d *Document) paragraph(style, numbering, text string) {
+	d.body.WriteString("<w:p>")
+	if style != "" || numbering != "" {
+		d.body.WriteString("<w:pPr>")
+		if style != "" {
+			d.body.WriteString(`<w:pStyle w:val="` + style + `"/>`)
+		}
+		d.body.WriteString(numbering)
+		d.body.WriteString("</w:pPr>")
+	}
+	d.body.WriteString(`<w:r><w:t xml:space="preserve">`)
+	_ = xml.EscapeText(&d.body, []byte(text))
+	d.body.WriteString("</w:t></w:r></w:p>")
+}
+
+// commentedParagraph writes a paragraph whose text is the range of each comment,
+// and records the comments for the comments part.
+func (d *Document) commentedParagraph(style, text string, comments []Comment) {
+	if len(comments) == 0 {
+		d.paragraph(style, "", text)
+		return
+	}
+
+	ids := make([]int, len(comments))
+	for i, c := range comments {
+		ids[i] = d.nextID
+		d.nextID++
+		author := c.Author
+		if author == "" {
+			author = "Reviewer"
+		}
+		fmt.Fprintf(&d.comments, `<w:comment w:id="%d" w:author="`, ids[i])
+		_ = xml.EscapeText(&d.comments, []byte(author))
+		d.comments.WriteString(`"`)
+		if !c.Date.IsZero() {
+			d.comments.WriteString(` w:date="` + c.Date.UTC().Format(time.RFC3339) + `"`)
+		}
+		d.comments.WriteString(`><w:p><w:r><w:t xml:space="preserve">`)
+		_ = xml.EscapeText(&d.comments, []byte(c.Text))
+		d.comments.WriteString("</w:t></w:r></w:p></w:comment>")
+	}
+
+	d.body.WriteString("<w:p>")
+	if style != "" {
+		d.body.WriteString(`<w:pPr><w:pStyle w:val="` + style + `"/></w:pPr>`)
+	}
+	for _, id := range ids {
+		fmt.Fprintf(&d.body, `<w:commentRangeStart w:id="%d"/>`, id)
+	}
+	d.body.WriteString(`<w:r><w:t xml:space="preserve">`)
+	_ = xml.EscapeText(&d.body, []byte(text))
+	d.body.WriteString("</w:t></w:r>")
+	for _, id := range ids {
+		fmt.Fprintf(&d.body, `<w:commentRangeEnd w:id="%d"/><w:r><w:commentReference w:id="%d"/></w:r>`, id, id)
+	}
+	d.body.WriteString("</w:p>")
+}
+
+// Bytes renders the document as a .docx (zip) archive.
+func (d *Document) Bytes() ([]byte, error) {
+	document := xml.Header +
+		`<w:document xmlns:w="` + wordNamespace + `"><w:body>` +
+		d.body.String() +
+		`<w:sectPr/></w:body></w:document>`
+
+	// The comments part is only written when a heading carries comments
+	contentTypes, documentRels := contentTypesXML, documentRelsXML
+	if d.nextID > 0 {
+		contentTypes = strings.Replace(contentTypes, "</Types>", commentsContentTypeXML+"</Types>", 1)
+		documentRels = strings.Replace(documentRels, "</Relationships>", commentsRelXML+"</Relationships>", 1)
+	}
+
+	parts := []struct {
+		name    string
+		content string
+	}{
+		{"[Content_Types].xml", contentTypes},
+		{"_rels/.rels", rootRelsXML},
+		{"word/_rels/document.xml.rels", documentRels},
+		{"word/document.xml", document},
+		{"word/styles.xml", stylesXML},
+		{"word/numbering.xml", numberingXML},
+	}
+	if d.nextID > 0 {
+		parts = append(parts, struct {
+			name    string
+			content string
+		}{"word/comments.xml", xml.Header + `<w:comments xmlns:w="` + wordNamespace + `">` + d.comments.String() + `</w:comments>`})
+	}
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, part := range parts {
+		w, err := zw.Create(part.name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to add %s: %w", part.name, err)
+		}
+		if _, err := w.Write([]byte(part.content)); err != nil {
+			return nil, fmt.Errorf("failed to write %s: %w", part.name, err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("failed to finish docx archive: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+const wordNamespace = "http://schemas.openxmlformats.org/wordprocessingml/2006/main"
+
+const contentTypesXML = xml.Header + `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+	`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+	`<Default Extension="xml" ContentType="application/xml"/>` +
+	`<Override PartName="/word/document.xml" ContentType="application/vnd.openxmlformats-officedocument.wordprocessingml.document.main+xml"/>` +
+	`<Override PartName="/word/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.wordprocessingml.styles+xml"/>` +
+	`<Override PartName="/word/numbering.xml" ContentType="application/vnd.openxmlformats-officedocument.wordprocessingml.numbering+xml"/>` +
+	`</Types>`
+
+const rootRelsXML = xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="word/document.xml"/>` +
+	`</Relationships>`
+
+const documentRelsXML = xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>` +
+	`<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/numbering" Target="numbering.xml"/>` +
+	`</Relationships>`
+
+const commentsContentTypeXML = `<Override PartName="/word/comments.xml" ContentType="application/vnd.openxmlformats-officedocument.wordprocessingml.comments+xml"/>`
+
+const commentsRelXML = `<Relationship Id="rId3" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/comments" Target="comments.xml"/>`
+
+// stylesXML defines the built-in paragraph styles used by Document. Sizes are in half-points.
+const stylesXML = xml.Header + `<w:styles xmlns:w="` + wordNamespace + `">` +
+	`<w:style w:type="paragraph" w:default="1" w:styleId="Normal"><w:name w:val="Normal"/><w:pPr><w:spacing w:after="120"/></w:pPr><w:rPr><w:sz w:val="22"/></w:rPr></w:style>` +
+	`<w:style w:type="paragraph" w:styleId="Title"><w:name w:val="Title"/><w:basedOn w:val="Normal"/><w:next w:val="Normal"/><w:pPr><w:spacing w:after="240"/></w:pPr><w:rPr><w:b/><w:sz w:val="48"/></w:rPr></w:style>` +
+	`<w:style w:type="paragraph" w:styleId="Heading1"><w:name w:val="heading 1"/><w:basedOn w:val="Normal"/><w:next w:val="Normal"/><w:pPr><w:keepNext/><w:spacing w:before="360" w:after="120"/><w:outlineLvl w:val="0"/></w:pPr><w:rPr><w:b/><w:sz w:val="32"/></w:rPr></w:style>` +
+	`<w:style w:type="paragraph" w:styleId="Heading2"><w:name w:val="heading 2"/><w:basedOn w:val="Normal"/><w:next w:val="Normal"/><w:pPr><w:keepNext/><w:spacing w:before="240" w:after="80"/><w:outlineLvl w:val="1"/></w:pPr><w:rPr><w:b/><w:sz w:val="26"/></w:rPr></w:style>` +
+	`<w:style w:type="paragraph" w:styleId="Heading3"><w:name w:val="heading 3"/><w:basedOn w:val="Normal"/><w:next w:val="Normal"/><w:pPr><w:keepNext/><w:spacing w:before="200" w:after="60"/><w:outlineLvl w:val="2"/></w:pPr><w:rPr><w:b/><w:sz w:val="24"/></w:rPr></w:style>` +
+	`<w:style w:type="paragraph" w:styleId="ListParagraph"><w:name w:val="List Paragraph"/><w:basedOn w:val="Normal"/><w:pPr><w:spacing w:after="40"/></w:pPr></w:style>` +
+	`</w:styles>`
+
+// numberingXML defines a single-level bullet list referenced as numId 1.
+const numberingXML = xml.Header + `<w:numbering xmlns:w="` + wordNamespace + `">` +
+	`<w:abstractNum w:abstractNumId="0"><w:lvl w:ilvl="0"><w:start w:val="1"/><w:numFmt w:val="bullet"/><w:lvlText w:val="•"/><w:lvlJc w:val="left"/><w:pPr><w:ind w:left="720" w:hanging="360"/></w:pPr></w:lvl></w:abstractNum>` +
+	`<w:num w:numId="1"><w:abstractNumId w:val="0"/></w:num>` +
+	`</w:numbering>`
@@ -0,0 +1,123 @@
+package httputil
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"syscall"
+	"time"
+)
+
+// ErrNonPublicAddress is returned when a host is, or resolves to, an address that
+// outbound requests to user-supplied URLs must not reach.
+var ErrNonPublicAddress = errors.New("address is not a public internet address")
+
+// maxRedirects matches the net/http default.
+const maxRedirects = 10
+
+// nonPublicPrefixes are special-purpose ranges netip.Addr has no predicate for.
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),     // "This network"
+	netip.MustParsePrefix("100.64.0.0/10"), // Carrier-grade NAT, also used inside some clouds
+	netip.MustParsePrefix("192.0.0.0/24"),  // IETF protocol assignments
+	netip.MustParsePrefix("198.18.0.0/15"), // Benchmarking
+	netip.MustParsePrefix("240.0.0.0/4"),   // Reserved, including broadcast
+}
+
+// IsPublicAddr reports whether addr is routable on the public internet. Loopback,
+// private, link-local, unspecified, multicast and other special-purpose addresses
+// (nonPublicPrefixes) are not, including IPv4 addresses written in IPv6 form, so
+// requests can't reach the host, the cluster or cloud metadata endpoints.
+func IsPublicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsValid() ||
+		addr.IsLoopback() ||
+		addr.IsPrivate() ||
+		addr.IsLinkLocalUnicast() ||
+		addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() ||
+		addr.IsMulticast() ||
+		addr.IsUnspecified() {
+		return false
+	}
+	for _, prefix := range nonPublicPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+// CheckPublicHost resolves host and returns ErrNonPublicAddress if it is, or any of its
+// addresses is, not public. Use it to reject a user-supplied URL when it is saved; the
+// client from NewPublicClientWithTimeout checks again at connect time.
+func CheckPublicHost(ctx context.Context, host string) error {
+	if addr, err := netip.ParseAddr(host); err == nil {
+		if !IsPublicAddr(addr) {
+			return fmt.Errorf("%s: %w", host, ErrNonPublicAddress)
+		}
+		return nil
+	}
+
+	addrs, err := net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+	if err != nil {
+		return fmt.Errorf("failed to resolve %s: %w", host, err)
+	}
+	for _, addr := range addrs {
+		if !IsPublicAddr(addr) {
+			return fmt.Errorf("%s resolves to %s: %w", host, addr, ErrNonPublicAddress)
+		}
+	}
+	return nil
+}
+
+// CheckPublicURL checks u is an http or https URL whose host is public.
+func CheckPublicURL(ctx context.Context, u *url.URL) error {
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return fmt.Errorf("unsupported URL scheme %q", u.Scheme)
+	}
+	return CheckPublicHost(ctx, u.Hostname())
+}
+
+// NewPublicClientWithTimeout creates an HTTP client for requests to user-supplied URLs.
+// It refuses to connect to addresses that aren't public, checking the resolved address
+// as the connection is made so a DNS answer that changes after validation can't get
+// around it, and refuses redirects to other schemes or to such hosts. It never uses a
+// proxy, even one set in the environment.
+func NewPublicClientWithTimeout(timeout time.Duration) *http.Client {
+	client := NewClientWithTimeout(timeout)
+	transport := client.Transport.(*http.Transport)
+	// Through a proxy the dial check would only see the proxy's address
+	transport.Proxy = nil
+	transport.DialContext = (&net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control:   dialPublicOnly,
+	}).DialContext
+	client.CheckRedirect = checkPublicRedirect
+	return client
+}
+
+// dialPublicOnly runs before each connection with the resolved address being dialed.
+func dialPublicOnly(network, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("unexpected dial address %q: %w", address, ErrNonPublicAddress)
+	}
+	if !IsPublicAddr(addrPort.Addr()) {
+		return fmt.Errorf("%s: %w", addrPort.Addr(), ErrNonPublicAddress)
+	}
+	return nil
+}
+
+// checkPublicRedirect applies the same rules to each redirect as to the original URL.
+func checkPublicRedirect(req *http.Request, via []*http.Request) error {
+	if len(via) >= maxRedirects {
+		return fmt.Errorf("stopped after %d redirects", maxRedirects)
+	}
+	return CheckPublicURL(req.Context(), req.URL)
+}
@@ -0,0 +1,104 @@
+package httputil
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"time"
+)
+
+func TestIsPublicAddr(t *testing.T) {
+	tests := []struct {
+		addr   string
+		public bool
+	}{
+		{"93.184.216.34", true},
+		{"2606:2800:220:1:248:1893:25c8:1946", true},
+		{"127.0.0.1", false},
+		{"::1", false},
+		{"10.0.0.5", false},
+		{"172.16.3.4", false},
+		{"192.168.1.1", false},
+		{"169.254.169.254", false}, // Cloud metadata
+		{"fe80::1", false},
+		{"fd00::1", false},
+		{"0.0.0.0", false},
+		{"::", false},
+		{"224.0.0.1", false},
+		{"::ffff:127.0.0.1", false},
+		{"::ffff:169.254.169.254", false},
+		{"100.64.0.1", false}, // Carrier-grade NAT
+		{"100.127.255.254", false},
+		{"100.128.0.1", true},
+		{"0.1.2.3", false},
+		{"192.0.0.170", false},
+		{"192.0.1.1", true},
+		{"198.18.0.1", false},
+		{"198.19.255.255", false},
+		{"198.20.0.1", true},
+		{"240.0.0.1", false},
+		{"255.255.255.255", false},
+		{"::ffff:100.64.0.1", false},
+	}
+	for _, tt := range tests {
+		if got := IsPublicAddr(netip.MustParseAddr(tt.addr)); got != tt.public {
+			t.Errorf("IsPublicAddr(%s) = %v, want %v", tt.addr, got, tt.public)
+		}
+	}
+}
+
+func TestCheckPublicHost(t *testing.T) {
+	for _, host := range []string{"127.0.0.1", "169.254.169.254", "::1", "localhost"} {
+		if err := CheckPublicHost(context.Background(), host); !errors.Is(err, ErrNonPublicAddress) {
+			t.Errorf("CheckPublicHost(%q) = %v, want ErrNonPublicAddress", host, err)
+		}
+	}
+	if err := CheckPublicHost(context.Background(), "93.184.216.34"); err != nil {
+		t.Errorf("CheckPublicHost(public IP) = %v, want nil", err)
+	}
+}
+
+func TestPublicClientRefusesLoopback(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	// The plain client reaches the server, so the refusal below is the dial check's
+	resp, err := NewClientWithTimeout(5 * time.Second).Get(server.URL)
+	if err != nil {
+		t.Fatalf("plain client: %v", err)
+	}
+	resp.Body.Close()
+
+	_, err = NewPublicClientWithTimeout(5 * time.Second).Get(server.URL)
+	if !errors.Is(err, ErrNonPublicAddress) {
+		t.Fatalf("public client error = %v, want ErrNonPublicAddress", err)
+	}
+}
+
+func TestPublicClientRefusesRedirectToNonPublicHost(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "http://169.254.169.254/latest/meta-data/", nil)
+	if err := checkPublicRedirect(req, nil); !errors.Is(err, ErrNonPublicAddress) {
+		t.Errorf("redirect to metadata = %v, want ErrNonPublicAddress", err)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "http://93.184.216.34/", nil)
+	req.URL.Scheme = "file"
+	if err := checkPublicRedirect(req, nil); err == nil {
+		t.Error("redirect to file URL was allowed")
+	}
+}
+
+func TestPublicClientIgnoresProxyEnvironment(t *testing.T) {
+	t.Setenv("HTTP_PROXY", "http://127.0.0.1:3128")
+	t.Setenv("HTTPS_PROXY", "http://127.0.0.1:3128")
+
+	transport := NewPublicClientWithTimeout(5 * time.Second).Transport.(*http.Transport)
+	if transport.Proxy != nil {
+		t.Error("public client transport has a proxy")
+	}
+}
@@ -0,0 +1,374 @@
+// Package pdftext pulls readable text out of PDF files without a full PDF parser. It
+// inflates each content stream and collects the strings shown by text operators, which
+// works for most PDFs exported from word processors. Scanned PDFs, and PDFs whose fonts
+// map glyphs through custom encodings, yield no usable text and return ErrNoText.
+package pdftext
+
+import (
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf16"
+)
+
+// ErrNoText is returned when a PDF has no text that can be extracted.
+var ErrNoText = errors.New("the PDF has no extractable text; it may be scanned or use embedded font encodings")
+
+// maxStreamBytes caps how much one stream may inflate to.
+const maxStreamBytes = 32 << 20
+
+// minReadableRatio is the share of letters, digits, spaces and punctuation extracted text
+// must have. Text decoded through an unknown font encoding comes out as symbol soup.
+const minReadableRatio = 0.8
+
+var (
+	streamKeyword    = []byte("stream")
+	endstreamKeyword = []byte("endstream")
+	objKeyword       = []byte("obj")
+)
+
+// ExtractText returns the text shown on a PDF's pages, roughly one line per line of text.
+func ExtractText(data []byte) (string, error) {
+	if !bytes.HasPrefix(data, []byte("%PDF")) {
+		return "", errors.New("not a PDF file")
+	}
+
+	var sb strings.Builder
+	for pos := 0; ; {
+		i := bytes.Index(data[pos:], streamKeyword)
+		if i < 0 {
+			break
+		}
+		i += pos
+		pos = i + len(streamKeyword)
+		if i >= 3 && bytes.Equal(data[i-3:i], []byte("end")) {
+			continue
+		}
+
+		start := pos
+		if start < len(data) && data[start] == '\r' {
+			start++
+		}
+		if start < len(data) && data[start] == '\n' {
+			start++
+		}
+		end := bytes.Index(data[start:], endstreamKeyword)
+		if end < 0 {
+			break
+		}
+		end += start
+		pos = end + len(endstreamKeyword)
+
+		dictStart := bytes.LastIndex(data[:i], objKeyword)
+		if dictStart < 0 {
+			dictStart = 0
+		}
+		content, ok := decodeStream(data[dictStart:i], data[start:end])
+		if ok {
+			showText(&sb, content)
+		}
+	}
+
+	text := strings.TrimSpace(sb.String())
+	if text == "" || !readable(text) {
+		return "", ErrNoText
+	}
+	return text, nil
+}
+
+// decodeStream returns a stream's data if it is uncompressed or Flate-compressed and
+// isn't an image, font or other binary object.
+func decodeStream(dict, raw []byte) ([]byte, bool) {
+	for _, skip := range []string{"/Image", "/XRef", "/ObjStm", "/FontFile", "/Length1", "/Metadata"} {
+		if bytes.Contains(dict, []byte(skip)) {
+			return nil, false
+		}
+	}
+	if !bytes.Contains(dict, []byte("/Filter")) {
+		return raw, true
+	}
+	if !bytes.Contains(dict, []byte("/FlateDecode")) || bytes.Contains(dict, []byte("/DCTDecode")) {
+		return nil, false
+	}
+
+	zr, err := zlib.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		return nil, false
+	}
+	defer zr.Close()
+	// Streams with a damaged tail still inflate to usable text up to the damage
+	out, _ := io.ReadAll(io.LimitReader(zr, maxStreamBytes))
+	return out, len(out) > 0
+}
+
+// showText appends the text shown by a content stream's text objects.
+func showText(sb *strings.Builder, content []byte) {
+	var operands []string
+	inText := false
+	for _, tok := range tokenize(content) {
+		if tok.kind != tokenOperator {
+			operands = append(operands, tok.text)
+			continue
+		}
+		switch tok.text {
+		case "BT":
+			inText = true
+		case "ET":
+			inText = false
+			newline(sb)
+		case "Tj":
+			if inText && len(operands) > 0 {
+				sb.WriteString(operands[len(operands)-1])
+			}
+		case "'", "\"":
+			if inText && len(operands) > 0 {
+				newline(sb)
+				sb.WriteString(operands[len(operands)-1])
+			}
+		case "TJ":
+			if inText {
+				for _, op := range operands {
+					if op == " " {
+						space(sb)
+					} else {
+						sb.WriteString(op)
+					}
+				}
+			}
+		case "T*", "Tm":
+			if inText {
+				newline(sb)
+			}
+		case "Td", "TD":
+			if inText && len(operands) >= 2 {
+				if y, err := strconv.ParseFloat(operands[len(operands)-1], 64); err == nil && y != 0 {
+					newline(sb)
+				} else {
+					space(sb)
+				}
+			}
+		}
+		operands = operands[:0]
+	}
+}
+
+type tokenKind int
+
+const (
+	tokenOperator tokenKind = iota
+	tokenOperand            // Number or name
+	tokenString             // Decoded string, or a space for a wide TJ gap
+)
+
+type token struct {
+	kind tokenKind
+	text string
+}
+
+// tokenize splits a content stream into operators and operands. Strings are decoded;
+// TJ arrays are flattened into their strings, with a space for each wide kerning gap.
+func tokenize(content []byte) []token {
+	var tokens []token
+	inArray := false
+	for i := 0; i < len(content); {
+		c := content[i]
+		switch {
+		case c == '%':
+			for i < len(content) && content[i] != '\n' && content[i] != '\r' {
+				i++
+			}
+		case isSpace(c):
+			i++
+		case c == '(':
+			s, n := literalString(content[i:])
+			tokens = append(tokens, token{tokenString, s})
+			i += n
+		case c == '<' && i+1 < len(content) && content[i+1] == '<':
+			tokens = append(tokens, token{tokenOperand, "<<"})
+			i += 2
+		case c == '>' && i+1 < len(content) && content[i+1] == '>':
+			tokens = append(tokens, token{tokenOperand, ">>"})
+			i += 2
+		case c == '<':
+			s, n := hexString(content[i:])
+			tokens = append(tokens, token{tokenString, s})
+			i += n
+		case c == '[':
+			inArray = true
+			i++
+		case c == ']':
+			inArray = false
+			i++
+		default:
+			j := i + 1
+			for j < len(content) && !isSpace(content[j]) && !isDelimiter(content[j]) {
+				j++
+			}
+			word := string(content[i:j])
+			i = j
+			if inArray {
+				// Kerning: a large negative adjustment is a gap between words
+				if n, err := strconv.ParseFloat(word, 64); err == nil && n < -200 {
+					tokens = append(tokens, token{tokenString, " "})
+				}
+				continue
+			}
+			if word[0] == '/' || word[0] == '-' || word[0] == '+' || word[0] == '.' || (word[0] >= '0' && word[0] <= '9') {
+				tokens = append(tokens, token{tokenOperand, word})
+			} else {
+				tokens = append(tokens, token{tokenOperator, word})
+			}
+		}
+	}
+	return tokens
+}
+
+// literalString decodes a (...) string at the start of b and returns it with the
+// number of bytes it took up.
+func literalString(b []byte) (string, int) {
+	var out []byte
+	depth := 0
+	i := 0
+	for ; i < len(b); i++ {
+		c := b[i]
+		switch {
+		case c == '(':
+			if depth > 0 {
+				out = append(out, c)
+			}
+			depth++
+		case c == ')':
+			depth--
+			if depth == 0 {
+				return decodeBytes(out), i + 1
+			}
+			out = append(out, c)
+		case c == '\\' && i+1 < len(b):
+			i++
+			switch e := b[i]; e {
+			case 'n':
+				out = append(out, '\n')
+			case 'r':
+				out = append(out, '\r')
+			case 't':
+				out = append(out, '\t')
+			case 'b', 'f':
+			case '\r', '\n':
+				// Line continuation
+			default:
+				if e >= '0' && e <= '7' {
+					v := 0
+					k := 0
+					for ; k < 3 && i+k < len(b) && b[i+k] >= '0' && b[i+k] <= '7'; k++ {
+						v = v*8 + int(b[i+k]-'0')
+					}
+					out = append(out, byte(v))
+					i += k - 1
+				} else {
+					out = append(out, e)
+				}
+			}
+		default:
+			out = append(out, c)
+		}
+	}
+	return decodeBytes(out), i
+}
+
+// hexString decodes a <...> string at the start of b and returns it with the number of
+// bytes it took up.
+func hexString(b []byte) (string, int) {
+	end := bytes.IndexByte(b, '>')
+	if end < 0 {
+		end = len(b) - 1
+	}
+	var digits []byte
+	for _, c := range b[1:end] {
+		if !isSpace(c) {
+			digits = append(digits, c)
+		}
+	}
+	if len(digits)%2 == 1 {
+		digits = append(digits, '0')
+	}
+	out := make([]byte, 0, len(digits)/2)
+	for i := 0; i+1 < len(digits); i += 2 {
+		v, err := strconv.ParseUint(string(digits[i:i+2]), 16, 8)
+		if err != nil {
+			return "", end + 1
+		}
+		out = append(out, byte(v))
+	}
+	return decodeBytes(out), end + 1
+}
+
+// decodeBytes turns a string's bytes into text: UTF-16BE when it has a byte order mark
+// or looks like it, otherwise PDFDocEncoding, read as Latin-1.
+func decodeBytes(b []byte) string {
+	if bytes.HasPrefix(b, []byte{0xFE, 0xFF}) || looksUTF16(b) {
+		b = bytes.TrimPrefix(b, []byte{0xFE, 0xFF})
+		units := make([]uint16, 0, len(b)/2)
+		for i := 0; i+1 < len(b); i += 2 {
+			units = append(units, uint16(b[i])<<8|uint16(b[i+1]))
+		}
+		return string(utf16.Decode(units))
+	}
+	runes := make([]rune, 0, len(b))
+	for _, c := range b {
+		if c == '\n' || c == '\r' || c == '\t' || c >= 0x20 {
+			runes = append(runes, rune(c))
+		}
+	}
+	return string(runes)
+}
+
+// looksUTF16 reports whether b reads as ASCII text in UTF-16BE: every even byte zero.
+func looksUTF16(b []byte) bool {
+	if len(b) < 2 || len(b)%2 == 1 {
+		return false
+	}
+	for i := 0; i < len(b); i += 2 {
+		if b[i] != 0 || b[i+1] == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// readable reports whether text is mostly letters, digits, spaces and punctuation.
+func readable(text string) bool {
+	total, good := 0, 0
+	for _, r := range text {
+		total++
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsSpace(r) || unicode.IsPunct(r) {
+			good++
+		}
+	}
+	return total > 0 && float64(good)/float64(total) >= minReadableRatio
+}
+
+// newline ends the current line unless it is already ended.
+func newline(sb *strings.Builder) {
+	if s := sb.String(); s != "" && !strings.HasSuffix(s, "\n") {
+		sb.WriteByte('\n')
+	}
+}
+
+// space separates words unless the text already ends in whitespace.
+func space(sb *strings.Builder) {
+	if s := sb.String(); s != "" && !strings.HasSuffix(s, " ") && !strings.HasSuffix(s, "\n") {
+		sb.WriteByte(' ')
+	}
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\n' || c == '\r' || c == '\t' || c == '\f' || c == 0
+}
+
+func isDelimiter(c byte) bool {
+	return strings.IndexByte("()<>[]{}/%", c) >= 0
+}
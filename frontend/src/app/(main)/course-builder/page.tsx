@@ -32,10 +32,14 @@ import {
   useGenerateAllLessons,
   useListGeneratedLessons,
   useGetJob,
+  useGenerationDraft,
+  useSaveGenerationDraft,
   GenerationJobStatus,
+  jobFailureMessage,
   type OutlineSection,
 } from '@/hooks/useAIGeneration';
 import { useListTargetAudiences } from '@/hooks/useTargetAudience';
+import { OutlineApprovalStatus } from '@/gen/mirai/v1/ai_generation_pb';
 
 // Content transformation
 import { generatedLessonsToCourseContent, toApiFormat } from '@/lib/contentTransform';
@@ -43,6 +47,9 @@ import { generatedLessonsToCourseContent, toApiFormat } from '@/lib/contentTrans
 // Icons
 import { Check } from 'lucide-react';
 
+// Wait for the user to pause before saving wizard selections
+const DRAFT_SAVE_DELAY_MS = 1000;
+
 export default function CourseBuilder() {
   const router = useRouter();
   const searchParams = useSearchParams();
@@ -65,6 +72,8 @@ export default function CourseBuilder() {
   const generateLessonsHook = useGenerateAllLessons();
   const listLessonsHook = useListGeneratedLessons(context.courseId || undefined);
   const { data: targetAudiences } = useListTargetAudiences();
+  const draftHook = useGenerationDraft(context.courseId || undefined);
+  const saveDraftHook = useSaveGenerationDraft();
 
   // Job polling
   const { data: outlineJob } = useGetJob(context.outlineJobId || undefined);
@@ -73,6 +82,7 @@ export default function CourseBuilder() {
   // Refs to prevent duplicate operations
   const hasInitialized = useRef(false);
   const isCreatingCourse = useRef(false);
+  const hasRestoredDraft = useRef(false);
 
   // ============================================================
   // URL Parameter Handling
@@ -98,6 +108,50 @@ export default function CourseBuilder() {
     }
   }, [searchParams, send]);
 
+  // ============================================================
+  // Wizard Draft
+  // ============================================================
+  const isEditingSelections = context.currentStep <= 4 && state.value !== 'generatingOutline';
+
+  // Restore the selections saved the last time the wizard was open for this course
+  useEffect(() => {
+    if (hasRestoredDraft.current || !draftHook.isFetched) return;
+    hasRestoredDraft.current = true;
+
+    const input = draftHook.data?.input;
+    if (input) {
+      send({
+        type: 'RESTORE_DRAFT',
+        smeIds: input.smeIds,
+        audienceIds: input.targetAudienceIds,
+        desiredOutcome: input.desiredOutcome,
+      });
+    }
+  }, [draftHook.isFetched, draftHook.data, send]);
+
+  // Save selections as they change; the server clears the draft once generation starts
+  const saveDraft = useRef(saveDraftHook.mutate);
+  saveDraft.current = saveDraftHook.mutate;
+  useEffect(() => {
+    if (!context.courseId || !hasRestoredDraft.current || !isEditingSelections) return;
+
+    const timer = setTimeout(() => {
+      saveDraft.current({
+        courseId: context.courseId!,
+        smeIds: context.selectedSmeIds,
+        targetAudienceIds: context.selectedAudienceIds,
+        desiredOutcome: context.desiredOutcome,
+      }).catch((error) => console.error('Failed to save generation draft:', error));
+    }, DRAFT_SAVE_DELAY_MS);
+    return () => clearTimeout(timer);
+  }, [
+    context.courseId,
+    context.selectedSmeIds,
+    context.selectedAudienceIds,
+    context.desiredOutcome,
+    isEditingSelections,
+  ]);
+
   // ============================================================
   // Course Creation
   // ============================================================
@@ -192,7 +246,7 @@ export default function CourseBuilder() {
         send({ type: 'OUTLINE_READY' });
       });
     } else if (outlineJob.status === GenerationJobStatus.FAILED) {
-      send({ type: 'ERROR', error: outlineJob.errorMessage || 'Outline generation failed' });
+      send({ type: 'ERROR', error: jobFailureMessage(outlineJob, 'Outline generation failed') });
     }
   }, [outlineJob, state.value, send, getOutlineHook]);
 
@@ -201,7 +255,11 @@ export default function CourseBuilder() {
     if (!context.courseId || !getOutlineHook.data) return;
 
     try {
-      await approveOutlineHook.mutate(context.courseId, getOutlineHook.data.id);
+      const approved = await approveOutlineHook.mutate(context.courseId, getOutlineHook.data.id);
+      if (approved.outline?.approvalStatus !== OutlineApprovalStatus.APPROVED) {
+        // Endorsed only: the organization requires a second reviewer
+        return;
+      }
 
       send({ type: 'OUTLINE_APPROVED' });
 
@@ -285,7 +343,7 @@ export default function CourseBuilder() {
         send({ type: 'GENERATION_COMPLETE' });
       });
     } else if (lessonJob.status === GenerationJobStatus.FAILED) {
-      send({ type: 'ERROR', error: lessonJob.errorMessage || 'Lesson generation failed' });
+      send({ type: 'ERROR', error: jobFailureMessage(lessonJob, 'Lesson generation failed') });
     }
   }, [lessonJob, state.value, listLessonsHook, getOutlineHook.data, context.courseId, updateCourseHook, send]);
 
@@ -330,6 +388,8 @@ export default function CourseBuilder() {
       case 'smeSelection':
         return (
           <SMESelectionStep
+            courseTitle={context.title}
+            desiredOutcome={context.desiredOutcome}
             selectedSmeIds={context.selectedSmeIds}
             onToggleSme={(smeId) => send({ type: 'TOGGLE_SME', smeId })}
             onNext={handleNext}
@@ -5,12 +5,18 @@ import { User, Bell, Lock, Palette, Globe, CreditCard, Users, ChevronRight, Spar
 import BillingSettings from '@/components/settings/BillingSettings';
 import TeamSettings from '@/components/settings/TeamSettings';
 import { AISettingsPanel } from '@/components/settings/AISettingsPanel';
+import { useCurrentUser } from '@/hooks/useCurrentUser';
 import {
   useGetAISettings,
   useSetAPIKey,
   useTestAPIKey,
   useRemoveAPIKey,
   useGetUsageStats,
+  useUpdateOutlineAutoApprove,
+  useUpdatePromptCache,
+  useUpdateSecondReviewerPolicy,
+  useUpdateLocale,
+  useUpdateExportRetention,
   AIProvider,
 } from '@/hooks/useTenantSettings';
 
@@ -21,6 +27,11 @@ function AISettingsContainer() {
   const setApiKey = useSetAPIKey();
   const testApiKey = useTestAPIKey();
   const removeApiKey = useRemoveAPIKey();
+  const updateOutlineAutoApprove = useUpdateOutlineAutoApprove();
+  const updatePromptCache = useUpdatePromptCache();
+  const updateSecondReviewerPolicy = useUpdateSecondReviewerPolicy();
+  const updateLocale = useUpdateLocale();
+  const updateExportRetention = useUpdateExportRetention();
 
   const handleSetApiKey = async (_provider: AIProvider, apiKey: string) => {
     await setApiKey.mutate(apiKey);
@@ -38,6 +49,26 @@ function AISettingsContainer() {
     await removeApiKey.mutate();
   };
 
+  const handleUpdateOutlineAutoApprove = async (allowed: boolean) => {
+    await updateOutlineAutoApprove.mutate(allowed);
+  };
+
+  const handleUpdatePromptCache = async (disabled: boolean) => {
+    await updatePromptCache.mutate(disabled);
+  };
+
+  const handleUpdateSecondReviewerPolicy = async (required: boolean) => {
+    await updateSecondReviewerPolicy.mutate(required);
+  };
+
+  const handleUpdateLocale = async (locale: string) => {
+    await updateLocale.mutate(locale);
+  };
+
+  const handleUpdateExportRetention = async (days: number) => {
+    await updateExportRetention.mutate(days);
+  };
+
   // Show error state if either settings or usage stats failed to load
   if (settingsError || usageError) {
     return (
@@ -62,6 +93,11 @@ function AISettingsContainer() {
       onSetApiKey={handleSetApiKey}
       onTestApiKey={handleTestApiKey}
       onRemoveApiKey={handleRemoveApiKey}
+      onUpdateOutlineAutoApprove={handleUpdateOutlineAutoApprove}
+      onUpdatePromptCache={handleUpdatePromptCache}
+      onUpdateSecondReviewerPolicy={handleUpdateSecondReviewerPolicy}
+      onUpdateLocale={handleUpdateLocale}
+      onUpdateExportRetention={handleUpdateExportRetention}
     />
   );
 }
@@ -82,6 +118,7 @@ export default function SettingsPage() {
 
   // Mobile: Show tab list when no tab selected (or show content)
   const [showMobileMenu, setShowMobileMenu] = useState(true);
+  const { user: currentUser } = useCurrentUser();
 
   const handleTabSelect = (tabId: string) => {
     setActiveTab(tabId);
@@ -192,6 +229,16 @@ export default function SettingsPage() {
             <h2 className="text-xl lg:text-2xl font-bold text-gray-900 mb-4 lg:mb-6">
               Profile Settings
             </h2>
+            {currentUser?.bouncedEmail && (
+              <div className="flex items-start gap-3 p-4 mb-4 bg-amber-50 border border-amber-200 rounded-lg">
+                <AlertCircle className="w-5 h-5 text-amber-600 flex-shrink-0 mt-0.5" />
+                <p className="text-sm text-amber-800">
+                  Recent emails to <span className="font-medium">{currentUser.bouncedEmail}</span> were
+                  rejected by your mail server, so email notifications are paused. Update your email
+                  address or ask your IT team to allow messages from Mirai.
+                </p>
+              </div>
+            )}
             <div className="space-y-4">
               <div>
                 <label className="block text-sm font-medium text-gray-700 mb-2">
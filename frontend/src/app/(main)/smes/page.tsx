@@ -1,13 +1,16 @@
 'use client';
 
-import { useState, useEffect, useMemo } from 'react';
+import { useState, useEffect, useMemo, useRef } from 'react';
+import { useSearchParams } from 'next/navigation';
 import { useQuery } from '@connectrpc/connect-query';
 import { SMEList } from '@/components/sme/SMEList';
 import { CreateSMEModal, type CreateSMEData } from '@/components/sme/CreateSMEModal';
 import { EditSMEModal, type UpdateSMEData } from '@/components/sme/EditSMEModal';
+import { DeleteSMEModal } from '@/components/sme/DeleteSMEModal';
 import { SMEDetailPanel } from '@/components/sme/SMEDetailPanel';
 import { CreateTaskModal } from '@/components/sme/CreateTaskModal';
 import { SubmitTextModal } from '@/components/sme/SubmitTextModal';
+import { SubmitAnswersModal } from '@/components/sme/SubmitAnswersModal';
 import { SubmissionReviewPanel } from '@/components/sme/SubmissionReviewPanel';
 import { KnowledgeChunkEditor } from '@/components/sme/KnowledgeChunkEditor';
 import {
@@ -19,16 +22,18 @@ import {
   useListTasks,
   useCancelTask,
   useSubmitContent,
+  useSubmitAnswers,
   useGetKnowledge,
   useUpdateKnowledgeChunk,
   useDeleteKnowledgeChunk,
+  useReviewFlaggedKnowledgeChunk,
   ContentType,
   type SubjectMatterExpert,
   type SMETask,
 } from '@/hooks/useSME';
 import type { SMEKnowledgeChunk } from '@/gen/mirai/v1/sme_pb';
 import { useListTeams } from '@/hooks/useTeams';
-import { useCurrentUser } from '@/hooks/useCurrentUser';
+import { useCurrentUser, isAdminRole } from '@/hooks/useCurrentUser';
 import { listCompanyUsers } from '@/gen/mirai/v1/user-UserService_connectquery';
 import type { User } from '@/gen/mirai/v1/common_pb';
 
@@ -36,12 +41,14 @@ export default function SMEsPage() {
   const [showCreateModal, setShowCreateModal] = useState(false);
   const [selectedSME, setSelectedSME] = useState<SubjectMatterExpert | null>(null);
   const [editingSME, setEditingSME] = useState<SubjectMatterExpert | null>(null);
+  const [deletingSME, setDeletingSME] = useState<SubjectMatterExpert | null>(null);
   const [showArchived, setShowArchived] = useState(false);
   const [showCreateTaskModal, setShowCreateTaskModal] = useState(false);
   const [selectedTaskForSubmission, setSelectedTaskForSubmission] = useState<SMETask | null>(null);
   const [selectedTaskForReview, setSelectedTaskForReview] = useState<SMETask | null>(null);
   const [editingChunk, setEditingChunk] = useState<SMEKnowledgeChunk | null>(null);
   const [deletingChunkId, setDeletingChunkId] = useState<string | null>(null);
+  const [reviewingChunkId, setReviewingChunkId] = useState<string | null>(null);
 
   // RTK Query hooks for data fetching
   const { data: smes, isLoading, error } = useListSMEs({ includeArchived: showArchived });
@@ -54,8 +61,10 @@ export default function SMEsPage() {
   const restoreSME = useRestoreSME();
   const cancelTask = useCancelTask();
   const submitContent = useSubmitContent();
+  const submitAnswers = useSubmitAnswers();
   const updateKnowledgeChunk = useUpdateKnowledgeChunk();
   const deleteKnowledgeChunk = useDeleteKnowledgeChunk();
+  const reviewFlaggedKnowledgeChunk = useReviewFlaggedKnowledgeChunk();
 
   // Fetch tasks for the selected SME
   const { data: tasks = [], isLoading: isLoadingTasks } = useListTasks(
@@ -67,6 +76,20 @@ export default function SMEsPage() {
     selectedSME?.id
   );
 
+  // Open the SME named in the URL (task notification links use /smes?sme={id}&task={id})
+  const searchParams = useSearchParams();
+  const hasOpenedLinkedSME = useRef(false);
+  useEffect(() => {
+    if (hasOpenedLinkedSME.current || isLoading) return;
+    const smeId = searchParams.get('sme');
+    if (!smeId) return;
+    hasOpenedLinkedSME.current = true;
+    const linked = smes.find((sme) => sme.id === smeId);
+    if (linked) {
+      setSelectedSME(linked);
+    }
+  }, [searchParams, smes, isLoading]);
+
   // Build a map of user IDs to User objects for displaying assignee info
   const usersMap = useMemo(() => {
     const map = new Map<string, User>();
@@ -87,17 +110,20 @@ export default function SMEsPage() {
     }
   }, [teams, teamsLoading]);
 
-  const handleDelete = async (sme: SubjectMatterExpert) => {
-    if (!confirm(`Are you sure you want to delete "${sme.name}"?`)) {
-      return;
-    }
+  const handleDelete = (sme: SubjectMatterExpert) => {
+    setDeletingSME(sme);
+  };
 
+  const handleConfirmDelete = async (smeId: string, confirmationToken: string) => {
     try {
-      await deleteSME.mutate(sme.id);
+      await deleteSME.mutate(smeId, confirmationToken);
       // Query cache is automatically invalidated by the hook
+      if (selectedSME?.id === smeId) {
+        setSelectedSME(null);
+      }
     } catch (err) {
       console.error('Failed to delete SME:', err);
-      alert('Failed to delete SME. Please try again.');
+      throw err; // Re-throw to let the modal handle the error
     }
   };
 
@@ -173,6 +199,16 @@ export default function SMEsPage() {
     }
   };
 
+  const handleSubmitAnswers = async (data: { taskId: string; answers: string[] }) => {
+    try {
+      await submitAnswers.mutate(data);
+      setSelectedTaskForSubmission(null);
+    } catch (err) {
+      console.error('Failed to submit answers:', err);
+      throw err; // Re-throw to let the modal handle the error
+    }
+  };
+
   const handleEditChunk = async (data: { chunkId: string; content: string; topic?: string; keywords?: string[] }) => {
     try {
       await updateKnowledgeChunk.mutate(data);
@@ -194,6 +230,18 @@ export default function SMEsPage() {
     }
   };
 
+  const handleReviewChunkFlag = async (chunkId: string, release: boolean) => {
+    setReviewingChunkId(chunkId);
+    try {
+      await reviewFlaggedKnowledgeChunk.mutate({ chunkId, release });
+    } catch (err) {
+      console.error('Failed to review flagged knowledge chunk:', err);
+      alert('Failed to update the flagged knowledge chunk. Please try again.');
+    } finally {
+      setReviewingChunkId(null);
+    }
+  };
+
   if (error) {
     return (
       <div className="max-w-7xl mx-auto px-4 sm:px-6 lg:px-8 py-8">
@@ -240,6 +288,7 @@ export default function SMEsPage() {
           isLoadingTasks={isLoadingTasks}
           isLoadingChunks={isLoadingChunks}
           isDeletingChunk={deletingChunkId ?? undefined}
+          isReviewingChunk={reviewingChunkId ?? undefined}
           onBack={() => setSelectedSME(null)}
           onEdit={() => handleEdit(selectedSME)}
           onRestore={() => handleRestore(selectedSME)}
@@ -250,6 +299,7 @@ export default function SMEsPage() {
           onReviewTask={(task) => setSelectedTaskForReview(task)}
           onEditChunk={(chunk) => setEditingChunk(chunk)}
           onDeleteChunk={handleDeleteChunk}
+          onReviewChunkFlag={isAdminRole(currentUser) ? handleReviewChunkFlag : undefined}
         />
       )}
 
@@ -263,6 +313,16 @@ export default function SMEsPage() {
         />
       )}
 
+      {/* Delete SME Modal */}
+      {deletingSME && (
+        <DeleteSMEModal
+          smeId={deletingSME.id}
+          smeName={deletingSME.name}
+          onClose={() => setDeletingSME(null)}
+          onConfirm={(token) => handleConfirmDelete(deletingSME.id, token)}
+        />
+      )}
+
       {/* Create Task Modal */}
       {showCreateTaskModal && selectedSME && (
         <CreateTaskModal
@@ -273,8 +333,20 @@ export default function SMEsPage() {
         />
       )}
 
+      {/* Submit Answers Modal */}
+      {selectedTaskForSubmission && selectedSME && selectedTaskForSubmission.questions.length > 0 && (
+        <SubmitAnswersModal
+          taskId={selectedTaskForSubmission.id}
+          taskTitle={selectedTaskForSubmission.title}
+          smeName={selectedSME.name}
+          questions={selectedTaskForSubmission.questions}
+          onClose={() => setSelectedTaskForSubmission(null)}
+          onSubmit={handleSubmitAnswers}
+        />
+      )}
+
       {/* Submit Text Modal */}
-      {selectedTaskForSubmission && selectedSME && (
+      {selectedTaskForSubmission && selectedSME && selectedTaskForSubmission.questions.length === 0 && (
         <SubmitTextModal
           taskId={selectedTaskForSubmission.id}
           taskTitle={selectedTaskForSubmission.title}
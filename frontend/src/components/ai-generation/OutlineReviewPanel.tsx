@@ -168,6 +168,14 @@ export function OutlineReviewPanel({
         </div>
       </div>
 
+      {/* Second Review */}
+      {outline.endorsedAt && outline.approvalStatus === APPROVAL_STATUS.PENDING_REVIEW && (
+        <div className="px-6 py-3 bg-blue-50 border-b border-blue-100 text-sm text-blue-800">
+          Endorsed on {new Date(Number(outline.endorsedAt.seconds) * 1000).toLocaleDateString()}. A second reviewer needs to approve this outline
+          before content is generated.
+        </div>
+      )}
+
       {/* Outline Content */}
       <div className="p-6 max-h-[500px] overflow-y-auto">
         {isEditing ? (
@@ -295,7 +303,12 @@ export function OutlineReviewPanel({
                 Regenerate
               </button>
             </div>
-            <div className="flex gap-3">
+            <div className="flex items-center gap-3">
+              {outline.unresolvedCommentCount > 0 && (
+                <span className="text-sm text-amber-700">
+                  {outline.unresolvedCommentCount} unresolved {outline.unresolvedCommentCount === 1 ? 'comment' : 'comments'}
+                </span>
+              )}
               <button
                 onClick={() => setShowRejectModal(true)}
                 className="px-4 py-2 text-sm font-medium text-red-600 bg-white border border-red-300 rounded-lg hover:bg-red-50"
@@ -113,9 +113,10 @@ export function SignupWizard() {
             companyName: registration.data.companyName,
             industry: registration.data.industry,
             teamSize: registration.data.teamSize,
+            storageRegion: registration.data.storageRegion,
           }}
           onSubmit={(data) => {
-            registration.setOrg(data.companyName, data.industry, data.teamSize);
+            registration.setOrg(data.companyName, data.industry, data.teamSize, data.storageRegion);
             registration.next();
           }}
           onBack={registration.back}
@@ -42,7 +42,12 @@ export interface UseRegistrationReturn {
 
   // Actions
   setEmail: (email: string) => void;
-  setOrg: (companyName: string, industry?: string, teamSize?: string) => void;
+  setOrg: (
+    companyName: string,
+    industry?: string,
+    teamSize?: string,
+    storageRegion?: string
+  ) => void;
   setAccount: (firstName: string, lastName: string, password: string) => void;
   setPlan: (plan: Plan, seatCount: number) => void;
   next: () => void;
@@ -100,8 +105,8 @@ export function useRegistration(): UseRegistrationReturn {
   );
 
   const setOrg = useCallback(
-    (companyName: string, industry?: string, teamSize?: string) =>
-      send({ type: 'SET_ORG', companyName, industry, teamSize }),
+    (companyName: string, industry?: string, teamSize?: string, storageRegion?: string) =>
+      send({ type: 'SET_ORG', companyName, industry, teamSize, storageRegion }),
     [send]
   );
 
@@ -1,8 +1,10 @@
 'use client';
 
+import { useEffect, useState } from 'react';
 import { useForm } from 'react-hook-form';
 import { zodResolver } from '@hookform/resolvers/zod';
 import { orgStepSchema, type OrgStepData } from '@/schemas';
+import { listStorageRegions } from '@/lib/authClient';
 import { ArrowLeft, ArrowRight, Building2 } from 'lucide-react';
 
 interface OrgStepV2Props {
@@ -10,6 +12,7 @@ interface OrgStepV2Props {
     companyName: string;
     industry: string;
     teamSize: string;
+    storageRegion: string;
   };
   onSubmit: (data: OrgStepData) => void;
   onBack: () => void;
@@ -27,10 +30,20 @@ const INDUSTRIES = [
 
 const TEAM_SIZES = ['1-10', '11-50', '51-200', '201-500', '500+'];
 
+const REGION_LABELS: Record<string, string> = {
+  us: 'United States',
+  eu: 'European Union',
+};
+
+function regionLabel(region: string): string {
+  return REGION_LABELS[region] ?? region.toUpperCase();
+}
+
 export function OrgStepV2({ defaultValues, onSubmit, onBack }: OrgStepV2Props) {
   const {
     register,
     handleSubmit,
+    setValue,
     formState: { errors, isValid },
   } = useForm<OrgStepData>({
     resolver: zodResolver(orgStepSchema),
@@ -38,6 +51,26 @@ export function OrgStepV2({ defaultValues, onSubmit, onBack }: OrgStepV2Props) {
     mode: 'onChange',
   });
 
+  // The region picker only shows when more than one region is configured
+  const [regions, setRegions] = useState<string[]>([]);
+  useEffect(() => {
+    let cancelled = false;
+    listStorageRegions()
+      .then(({ regions, defaultRegion }) => {
+        if (cancelled) return;
+        setRegions(regions);
+        if (!defaultValues.storageRegion && defaultRegion) {
+          setValue('storageRegion', defaultRegion);
+        }
+      })
+      .catch(() => {
+        // Registration still works without a choice; the default region is used
+      });
+    return () => {
+      cancelled = true;
+    };
+  }, [defaultValues.storageRegion, setValue]);
+
   return (
     <form onSubmit={handleSubmit(onSubmit)} className="space-y-6">
       <div>
@@ -110,6 +143,30 @@ export function OrgStepV2({ defaultValues, onSubmit, onBack }: OrgStepV2Props) {
         </select>
       </div>
 
+      {/* Data region (only when there is a choice) */}
+      {regions.length > 1 && (
+        <div>
+          <label htmlFor="storageRegion" className="block text-sm font-medium text-slate-700 mb-2">
+            Data region
+          </label>
+          <select
+            {...register('storageRegion')}
+            id="storageRegion"
+            className="w-full px-4 py-3 border border-slate-300 rounded-lg focus:outline-none focus:ring-2 focus:ring-indigo-500"
+          >
+            {regions.map((region) => (
+              <option key={region} value={region}>
+                {regionLabel(region)}
+              </option>
+            ))}
+          </select>
+          <p className="mt-2 text-sm text-slate-500">
+            Your courses and uploaded files are stored in this region. It can&apos;t be changed
+            later.
+          </p>
+        </div>
+      )}
+
       {/* Navigation */}
       <div className="flex gap-3">
         <button
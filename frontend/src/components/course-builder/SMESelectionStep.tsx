@@ -1,11 +1,13 @@
 'use client';
 
 import React, { useState } from 'react';
-import { Brain, Check, Plus, X, AlertCircle } from 'lucide-react';
-import { useListSMEs, type SubjectMatterExpert, SMEStatus } from '@/hooks/useSME';
+import { Brain, Check, Plus, X, AlertCircle, Sparkles } from 'lucide-react';
+import { useListSMEs, useSuggestSMEs, type SubjectMatterExpert, type SMESuggestion, SMEStatus } from '@/hooks/useSME';
 import SMESelectionModal from './SMESelectionModal';
 
 interface SMESelectionStepProps {
+  courseTitle: string;
+  desiredOutcome: string;
   selectedSmeIds: string[];
   onToggleSme: (smeId: string) => void;
   onNext: () => void;
@@ -14,6 +16,8 @@ interface SMESelectionStepProps {
 }
 
 export default function SMESelectionStep({
+  courseTitle,
+  desiredOutcome,
   selectedSmeIds,
   onToggleSme,
   onNext,
@@ -21,8 +25,14 @@ export default function SMESelectionStep({
   canProceed,
 }: SMESelectionStepProps) {
   const { data: allSmes, isLoading, error } = useListSMEs();
+  const { data: suggestions } = useSuggestSMEs(courseTitle, desiredOutcome);
   const [isModalOpen, setIsModalOpen] = useState(false);
 
+  // Suggestions not yet selected
+  const unselectedSuggestions = suggestions.filter(
+    (suggestion) => suggestion.sme && !selectedSmeIds.includes(suggestion.sme.id)
+  );
+
   // Get selected SME objects
   const selectedSmes = allSmes.filter((sme) => selectedSmeIds.includes(sme.id));
 
@@ -76,6 +86,25 @@ export default function SMESelectionStep({
         </div>
       )}
 
+      {/* Suggested SMEs */}
+      {!isLoading && unselectedSuggestions.length > 0 && (
+        <div className="mb-8">
+          <h3 className="flex items-center gap-2 text-sm font-medium text-gray-700 mb-3">
+            <Sparkles className="w-4 h-4 text-purple-600" />
+            Suggested for this course
+          </h3>
+          <div className="grid grid-cols-1 md:grid-cols-2 gap-4">
+            {unselectedSuggestions.map((suggestion) => (
+              <SuggestedSMECard
+                key={suggestion.sme!.id}
+                suggestion={suggestion}
+                onAdd={() => onToggleSme(suggestion.sme!.id)}
+              />
+            ))}
+          </div>
+        </div>
+      )}
+
       {/* Selected SMEs */}
       {!isLoading && (
         <div className="mb-8">
@@ -209,3 +238,52 @@ function SelectedSMECard({ sme, onRemove }: SelectedSMECardProps) {
     </div>
   );
 }
+
+// ============================================================
+// Suggested SME Card Component
+// ============================================================
+
+interface SuggestedSMECardProps {
+  suggestion: SMESuggestion;
+  onAdd: () => void;
+}
+
+function SuggestedSMECard({ suggestion, onAdd }: SuggestedSMECardProps) {
+  const sme = suggestion.sme!;
+  const relevance = Math.round(suggestion.relevanceScore * 100);
+
+  return (
+    <div className="relative p-4 rounded-xl border-2 border-dashed border-purple-200 bg-white">
+      <button
+        onClick={onAdd}
+        className="absolute top-3 right-3 flex items-center gap-1 px-2 py-1 text-sm font-medium text-indigo-600 hover:bg-indigo-50 rounded-lg transition-colors"
+        title="Add"
+      >
+        <Plus className="w-4 h-4" />
+        Add
+      </button>
+
+      <div className="pr-16">
+        <div className="flex items-start gap-3">
+          <div className="w-10 h-10 rounded-lg bg-purple-50 flex items-center justify-center flex-shrink-0">
+            <Brain className="w-5 h-5 text-purple-600" />
+          </div>
+          <div className="min-w-0">
+            <h3 className="font-semibold text-gray-900 truncate">
+              {sme.name}
+            </h3>
+            <p className="text-sm text-indigo-600 font-medium">
+              {sme.domain}
+            </p>
+            <p className="text-xs text-gray-500 mt-1">
+              {relevance}% match
+              {suggestion.matchedTerms.length > 0 && (
+                <> &middot; {suggestion.matchedTerms.join(', ')}</>
+              )}
+            </p>
+          </div>
+        </div>
+      </div>
+    </div>
+  );
+}
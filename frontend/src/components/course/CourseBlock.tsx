@@ -19,6 +19,7 @@ import {
   useGetGeneratedLesson,
   GenerationJobStatus,
 } from '@/hooks/useAIGeneration';
+import { parseQuizContent, QUIZ_QUESTION_TYPE_LABELS } from '@/lib/quizContent';
 
 interface CourseBlockProps {
   block: CourseBlockType;
@@ -199,42 +200,10 @@ export default function CourseBlock({
           </div>
         ) : block.type === BlockType.KNOWLEDGE_CHECK ? (
           (() => {
-            try {
-              const quizData = JSON.parse(block.content);
-              return (
-                <div className="bg-gradient-to-r from-green-50 to-emerald-50 border border-green-200 rounded-lg p-4">
-                  <div className="flex items-center gap-2 text-green-700 mb-3">
-                    <CheckCircle size={16} />
-                    <span className="font-medium">Knowledge Check</span>
-                  </div>
-                  <p className="text-gray-800 font-medium mb-3">{quizData.question}</p>
-                  <div className="space-y-1 text-sm">
-                    {quizData.options.map((option: string, index: number) => (
-                      <div key={index} className="flex items-center gap-2">
-                        <span className={`w-5 h-5 rounded-full border-2 flex items-center justify-center text-xs ${
-                          index === quizData.correctAnswer
-                            ? 'border-green-500 bg-green-100 text-green-700'
-                            : 'border-gray-300'
-                        }`}>
-                          {index === quizData.correctAnswer && '✓'}
-                        </span>
-                        <span className={index === quizData.correctAnswer ? 'text-green-700 font-medium' : 'text-gray-600'}>
-                          {option}
-                        </span>
-                      </div>
-                    ))}
-                  </div>
-                  {quizData.explanation && (
-                    <div className="mt-3 pt-3 border-t border-green-200">
-                      <p className="text-xs text-gray-600">
-                        <span className="font-medium">Explanation:</span> {quizData.explanation}
-                      </p>
-                    </div>
-                  )}
-                </div>
-              );
-            } catch {
-              // Fallback for old format
+            // Branches on schema_version; older formats are upgraded on read
+            const quizData = parseQuizContent(block.content);
+            if (!quizData) {
+              // Fallback for plain-text knowledge checks
               return (
                 <div className="bg-green-50 border border-green-200 rounded-lg p-4">
                   <div className="flex items-center gap-2 text-green-700 mb-2">
@@ -245,6 +214,42 @@ export default function CourseBlock({
                 </div>
               );
             }
+            return (
+              <div className="bg-gradient-to-r from-green-50 to-emerald-50 border border-green-200 rounded-lg p-4">
+                <div className="flex items-center gap-2 text-green-700 mb-3">
+                  <CheckCircle size={16} />
+                  <span className="font-medium">Knowledge Check</span>
+                  <span className="ml-auto text-xs text-green-700">
+                    {QUIZ_QUESTION_TYPE_LABELS[quizData.questionType]} · {quizData.points} {quizData.points === 1 ? 'point' : 'points'}
+                    {quizData.shuffle && ' · shuffled'}
+                  </span>
+                </div>
+                <p className="text-gray-800 font-medium mb-3">{quizData.question}</p>
+                <div className="space-y-1 text-sm">
+                  {quizData.options.map((option) => (
+                    <div key={option.id} className="flex items-center gap-2">
+                      <span className={`w-5 h-5 ${quizData.questionType === 'multi' ? 'rounded' : 'rounded-full'} border-2 flex items-center justify-center text-xs ${
+                        option.correct
+                          ? 'border-green-500 bg-green-100 text-green-700'
+                          : 'border-gray-300'
+                      }`}>
+                        {option.correct && '✓'}
+                      </span>
+                      <span className={option.correct ? 'text-green-700 font-medium' : 'text-gray-600'}>
+                        {option.text}
+                      </span>
+                    </div>
+                  ))}
+                </div>
+                {quizData.explanation && (
+                  <div className="mt-3 pt-3 border-t border-green-200">
+                    <p className="text-xs text-gray-600">
+                      <span className="font-medium">Explanation:</span> {quizData.explanation}
+                    </p>
+                  </div>
+                )}
+              </div>
+            );
           })()
 
         ) : (
@@ -16,9 +16,11 @@ import {
   Save,
   Home,
   Menu,
+  Lock,
 } from 'lucide-react';
 import CourseBlock from './CourseBlock';
 import BlockAlignmentPanel from './BlockAlignmentPanel';
+import CoursePresenceIndicator from './CoursePresenceIndicator';
 import DropdownMenu from '@/components/ui/DropdownMenu';
 import {
   useRegenerateComponent,
@@ -42,7 +44,9 @@ export default function CourseEditor({ courseId, onPreview }: CourseEditorProps)
   const isMobile = useIsMobile();
 
   // Connect-Query: fetch course data
-  const { data: course, isLoading } = useGetCourse(courseId);
+  const { data: course, generationLock, isLoading } = useGetCourse(courseId);
+  // A running full course generation holds the course read-only; saves would be rejected
+  const isLocked = !!generationLock;
   const updateCourseMutation = useUpdateCourse();
 
   // Zustand: UI state only
@@ -208,6 +212,7 @@ export default function CourseEditor({ courseId, onPreview }: CourseEditorProps)
 
   // Save course content
   const handleSave = async () => {
+    if (isLocked) return;
     setSaving(true);
     try {
       await updateCourseMutation.mutate(courseId, {
@@ -338,20 +343,21 @@ export default function CourseEditor({ courseId, onPreview }: CourseEditorProps)
 
               {/* Navigation Menu */}
               <div className="ml-4 flex items-center gap-2">
+                <CoursePresenceIndicator courseId={courseId} editing={!isLocked} />
                 <DropdownMenu
                   triggerIcon="dots"
                   align="right"
                   items={[
-                    {
+                    ...(isLocked ? [] : [{
                       label: 'Save & Exit',
                       icon: <Save className="w-4 h-4" />,
                       onClick: async () => {
                         await handleSave();
                         router.push('/dashboard');
                       },
-                    },
+                    }]),
                     {
-                      label: 'Exit Without Saving',
+                      label: isLocked ? 'Exit' : 'Exit Without Saving',
                       icon: <Home className="w-4 h-4" />,
                       onClick: () => router.push('/dashboard'),
                     },
@@ -362,8 +368,24 @@ export default function CourseEditor({ courseId, onPreview }: CourseEditorProps)
           </div>
         </div>
 
+        {/* Read-only banner while a full course generation is running */}
+        {isLocked && (
+          <div className="mx-6 mt-6 bg-amber-50 border border-amber-200 rounded-lg p-4 flex items-start gap-3">
+            <Lock className="w-5 h-5 text-amber-600 flex-shrink-0 mt-0.5" />
+            <div className="text-sm text-amber-800">
+              <p className="font-medium">This course is being generated</p>
+              <p className="mt-1">
+                Editing is paused until generation finishes or is cancelled. This page unlocks on its own.
+              </p>
+            </div>
+          </div>
+        )}
+
         {/* Blocks Container */}
-        <div className="p-6 space-y-4 relative">
+        <div
+          className={`p-6 space-y-4 relative ${isLocked ? 'pointer-events-none opacity-60' : ''}`}
+          aria-disabled={isLocked}
+        >
           {localBlocks.map((block) => (
             <CourseBlock
               key={block.id}
@@ -0,0 +1,74 @@
+'use client';
+
+import React from 'react';
+import { useCoursePresence } from '@/hooks/useCourses';
+import type { CoursePresence } from '@/gen/mirai/v1/course_pb';
+
+interface CoursePresenceIndicatorProps {
+  courseId: string;
+  editing: boolean;
+}
+
+/** How many people are shown before the rest collapse into a count. */
+const MAX_SHOWN = 4;
+
+function initials(presence: CoursePresence): string {
+  const words = presence.displayName.trim().split(/\s+/).filter(Boolean);
+  if (words.length === 0) return '?';
+  return words.slice(0, 2).map((w) => w[0].toUpperCase()).join('');
+}
+
+function label(presence: CoursePresence): string {
+  const name = presence.displayName || 'Someone';
+  return presence.editing ? `${name} (editing)` : `${name} (viewing)`;
+}
+
+/**
+ * Shows who else has the course open while keeping the current user's presence alive.
+ * Renders nothing when nobody else is present.
+ */
+export default function CoursePresenceIndicator({ courseId, editing }: CoursePresenceIndicatorProps) {
+  const { others } = useCoursePresence(courseId, editing);
+
+  if (others.length === 0) return null;
+
+  const shown = others.slice(0, MAX_SHOWN);
+  const hidden = others.slice(MAX_SHOWN);
+  const editorCount = others.filter((p) => p.editing).length;
+
+  return (
+    <div
+      className="flex items-center gap-2"
+      aria-label={`${others.length} other ${others.length === 1 ? 'person has' : 'people have'} this course open`}
+    >
+      <div className="flex -space-x-2">
+        {shown.map((p) => (
+          <span
+            key={p.userId}
+            title={label(p)}
+            className={`w-8 h-8 rounded-full border-2 flex items-center justify-center text-xs font-medium ${
+              p.editing
+                ? 'bg-amber-100 text-amber-800 border-amber-400'
+                : 'bg-gray-100 text-gray-700 border-white'
+            }`}
+          >
+            {initials(p)}
+          </span>
+        ))}
+        {hidden.length > 0 && (
+          <span
+            title={hidden.map(label).join(', ')}
+            className="w-8 h-8 rounded-full border-2 border-white bg-gray-200 text-gray-700 flex items-center justify-center text-xs font-medium"
+          >
+            +{hidden.length}
+          </span>
+        )}
+      </div>
+      {editorCount > 0 && (
+        <span className="hidden sm:inline text-xs text-amber-700">
+          {editorCount === 1 ? '1 other editor' : `${editorCount} other editors`}
+        </span>
+      )}
+    </div>
+  );
+}
@@ -2,6 +2,9 @@
 
 import React, { useState } from 'react';
 import { useGetCourse } from '@/hooks/useCourses';
+import CoursePresenceIndicator from './CoursePresenceIndicator';
+import { QuizRenderer } from './renderers/QuizRenderer';
+import { parseQuizContent } from '@/lib/quizContent';
 import {
   Download,
   Check,
@@ -26,8 +29,6 @@ export default function CoursePreview({ courseId, onBack }: CoursePreviewProps)
   const [isExporting, setIsExporting] = useState(false);
   const [exportComplete, setExportComplete] = useState(false);
   const [showSidebar, setShowSidebar] = useState(true);
-  const [quizAnswers, setQuizAnswers] = useState<{[key: string]: number}>({});
-  const [showQuizFeedback, setShowQuizFeedback] = useState<{[key: string]: boolean}>({});
 
   // Use actual course content
   const courseSections = course?.content?.sections || [];
@@ -65,17 +66,6 @@ export default function CoursePreview({ courseId, onBack }: CoursePreviewProps)
         setCurrentLessonIndex((prevSection?.lessons?.length || 1) - 1);
       }
     }
-    // Reset quiz state when navigating
-    setQuizAnswers({});
-    setShowQuizFeedback({});
-  };
-
-  const handleQuizAnswer = (quizId: string, answerIndex: number) => {
-    setQuizAnswers(prev => ({ ...prev, [quizId]: answerIndex }));
-  };
-
-  const checkQuizAnswer = (quizId: string) => {
-    setShowQuizFeedback(prev => ({ ...prev, [quizId]: true }));
   };
 
   if (isLoading) {
@@ -116,6 +106,7 @@ export default function CoursePreview({ courseId, onBack }: CoursePreviewProps)
           <h1 className="font-semibold text-gray-900">{course.settings?.title || 'Course Preview'}</h1>
         </div>
         <div className="flex items-center gap-3">
+          <CoursePresenceIndicator courseId={courseId} editing={false} />
           <button
             onClick={() => setShowSidebar(!showSidebar)}
             className="lg:hidden p-2 text-gray-600 hover:bg-gray-100 rounded-lg"
@@ -149,8 +140,6 @@ export default function CoursePreview({ courseId, onBack }: CoursePreviewProps)
                         onClick={() => {
                           setCurrentSectionIndex(sIdx);
                           setCurrentLessonIndex(lIdx);
-                          setQuizAnswers({});
-                          setShowQuizFeedback({});
                         }}
                         className={`w-full text-left px-3 py-2 rounded text-sm ${
                           sIdx === currentSectionIndex && lIdx === currentLessonIndex
@@ -191,62 +180,12 @@ export default function CoursePreview({ courseId, onBack }: CoursePreviewProps)
                         <h3 className="text-xl font-semibold text-gray-900">{block.content}</h3>
                       ) : block.type === 4 ? ( // KNOWLEDGE_CHECK
                         (() => {
-                          try {
-                            const quiz = JSON.parse(block.content);
-                            const quizId = block.id;
-                            const selectedAnswer = quizAnswers[quizId];
-                            const showFeedback = showQuizFeedback[quizId];
-                            const isCorrect = selectedAnswer === quiz.correctAnswer;
-
-                            return (
-                              <div className="bg-gradient-to-r from-green-50 to-emerald-50 border border-green-200 rounded-lg p-6">
-                                <h4 className="font-semibold text-green-800 mb-3">Knowledge Check</h4>
-                                <p className="text-gray-800 mb-4">{quiz.question}</p>
-                                <div className="space-y-2">
-                                  {quiz.options?.map((option: string, idx: number) => (
-                                    <button
-                                      key={idx}
-                                      onClick={() => !showFeedback && handleQuizAnswer(quizId, idx)}
-                                      disabled={showFeedback}
-                                      className={`w-full text-left px-4 py-3 rounded-lg border transition-colors ${
-                                        showFeedback
-                                          ? idx === quiz.correctAnswer
-                                            ? 'bg-green-100 border-green-500 text-green-800'
-                                            : idx === selectedAnswer
-                                              ? 'bg-red-100 border-red-500 text-red-800'
-                                              : 'bg-white border-gray-200'
-                                          : selectedAnswer === idx
-                                            ? 'bg-purple-100 border-purple-500'
-                                            : 'bg-white border-gray-200 hover:border-purple-300'
-                                      }`}
-                                    >
-                                      {option}
-                                    </button>
-                                  ))}
-                                </div>
-                                {!showFeedback && selectedAnswer !== undefined && (
-                                  <button
-                                    onClick={() => checkQuizAnswer(quizId)}
-                                    className="mt-4 px-4 py-2 bg-green-600 text-white rounded-lg hover:bg-green-700"
-                                  >
-                                    Check Answer
-                                  </button>
-                                )}
-                                {showFeedback && (
-                                  <div className={`mt-4 p-3 rounded-lg ${isCorrect ? 'bg-green-100' : 'bg-red-100'}`}>
-                                    <p className={`font-medium ${isCorrect ? 'text-green-800' : 'text-red-800'}`}>
-                                      {isCorrect ? 'Correct!' : 'Incorrect'}
-                                    </p>
-                                    {quiz.explanation && (
-                                      <p className="text-gray-700 mt-1 text-sm">{quiz.explanation}</p>
-                                    )}
-                                  </div>
-                                )}
-                              </div>
-                            );
-                          } catch {
+                          // Branches on schema_version; older formats are upgraded on read
+                          const quiz = parseQuizContent(block.content);
+                          if (!quiz) {
                             return <div className="text-gray-700">{block.content}</div>;
                           }
+                          return <QuizRenderer content={quiz} />;
                         })()
                       ) : (
                         <div className="text-gray-700 whitespace-pre-wrap">{block.content}</div>
@@ -6,6 +6,7 @@ import { TextRenderer } from './TextRenderer';
 import { HeadingRenderer } from './HeadingRenderer';
 import { ImageRenderer } from './ImageRenderer';
 import { QuizRenderer } from './QuizRenderer';
+import { parseQuizContent, serializeQuizContent } from '@/lib/quizContent';
 
 // Component type enum values from proto
 const COMPONENT_TYPES = {
@@ -33,26 +34,13 @@ interface ImageContent {
   caption?: string;
 }
 
-interface QuizOption {
-  id: string;
-  text: string;
-}
-
-interface QuizContent {
-  question: string;
-  questionType: string;
-  options: QuizOption[];
-  correctAnswerId: string;
-  explanation: string;
-}
-
 interface ComponentRendererProps {
   component: LessonComponent;
   isEditing?: boolean;
   isSelected?: boolean;
   onSelect?: () => void;
   onUpdate?: (contentJson: string) => void;
-  onQuizAnswer?: (componentId: string, optionId: string, isCorrect: boolean) => void;
+  onQuizAnswer?: (componentId: string, optionIds: string[], isCorrect: boolean, points: number) => void;
 }
 
 function parseContent<T>(contentJson: string): T | null {
@@ -63,17 +51,6 @@ function parseContent<T>(contentJson: string): T | null {
   }
 }
 
-// Transform snake_case quiz JSON to camelCase
-function normalizeQuizContent(raw: Record<string, unknown>): QuizContent {
-  return {
-    question: (raw.question as string) || '',
-    questionType: (raw.questionType as string) || (raw.question_type as string) || 'multiple_choice',
-    options: (raw.options as QuizOption[]) || [],
-    correctAnswerId: (raw.correctAnswerId as string) || (raw.correct_answer_id as string) || '',
-    explanation: (raw.explanation as string) || '',
-  };
-}
-
 export function ComponentRenderer({
   component,
   isEditing = false,
@@ -168,17 +145,18 @@ export function ComponentRenderer({
     }
 
     case COMPONENT_TYPES.QUIZ: {
-      if (!content) {
+      // Branches on schema_version; older quizzes are upgraded and saved in the current schema
+      const quizContent = parseQuizContent(content);
+      if (!quizContent) {
         return <div className="p-4 bg-red-50 text-red-700 rounded">Invalid quiz content</div>;
       }
-      const quizContent = normalizeQuizContent(content);
       return (
         <Wrapper>
           <QuizRenderer
             content={quizContent}
             isEditing={isEditing}
-            onEdit={(c) => handleUpdate(c)}
-            onAnswer={(optionId, isCorrect) => onQuizAnswer?.(component.id, optionId, isCorrect)}
+            onEdit={(c) => onUpdate?.(serializeQuizContent(c))}
+            onAnswer={(optionIds, isCorrect, points) => onQuizAnswer?.(component.id, optionIds, isCorrect, points)}
           />
         </Wrapper>
       );
@@ -1,47 +1,89 @@
 'use client';
 
-import { useState } from 'react';
-
-interface QuizOption {
-  id: string;
-  text: string;
-}
-
-interface QuizContent {
-  question: string;
-  questionType: string;
-  options: QuizOption[];
-  correctAnswerId: string;
-  explanation: string;
-}
+import { useMemo, useState } from 'react';
+import {
+  type QuizContent,
+  type QuizOption,
+  type QuizQuestionType,
+  QUIZ_MAX_OPTIONS,
+  QUIZ_MAX_POINTS,
+  QUIZ_QUESTION_TYPE_LABELS,
+  isQuizAnswerCorrect,
+  orderQuizOptions,
+  quizContentProblem,
+} from '@/lib/quizContent';
 
 interface QuizRendererProps {
   content: QuizContent;
   isEditing?: boolean;
   onEdit?: (content: QuizContent) => void;
-  onAnswer?: (optionId: string, isCorrect: boolean) => void;
+  onAnswer?: (optionIds: string[], isCorrect: boolean, points: number) => void;
+}
+
+const TRUE_FALSE_OPTIONS: QuizOption[] = [
+  { id: 'true', text: 'True', correct: true },
+  { id: 'false', text: 'False', correct: false },
+];
+
+/** Adjusts the options to a new question type, keeping as much of the answer key as fits. */
+function withQuestionType(content: QuizContent, questionType: QuizQuestionType): QuizContent {
+  if (questionType === 'true_false') {
+    const options = content.options.length === 2 ? content.options : TRUE_FALSE_OPTIONS;
+    return { ...content, questionType, options: keepFirstCorrect(options) };
+  }
+  if (questionType === 'single') {
+    return { ...content, questionType, options: keepFirstCorrect(content.options) };
+  }
+  return { ...content, questionType };
+}
+
+function keepFirstCorrect(options: QuizOption[]): QuizOption[] {
+  const first = options.findIndex((o) => o.correct);
+  return options.map((o, i) => ({ ...o, correct: first === -1 ? i === 0 : i === first }));
 }
 
 export function QuizRenderer({ content, isEditing = false, onEdit, onAnswer }: QuizRendererProps) {
-  const [selectedOption, setSelectedOption] = useState<string | null>(null);
+  const [selected, setSelected] = useState<string[]>([]);
   const [showFeedback, setShowFeedback] = useState(false);
+  const [attempt, setAttempt] = useState(0);
+
+  const isMulti = content.questionType === 'multi';
+  // Shuffled once per attempt, so options don't move while the learner is choosing
+  // eslint-disable-next-line react-hooks/exhaustive-deps
+  const options = useMemo(() => orderQuizOptions(content), [content, attempt]);
+  const isCorrect = isQuizAnswerCorrect(content, selected);
+
+  const toggleOption = (optionId: string) => {
+    if (showFeedback) return;
+    if (isMulti) {
+      setSelected((prev) => (prev.includes(optionId) ? prev.filter((id) => id !== optionId) : [...prev, optionId]));
+    } else {
+      setSelected([optionId]);
+    }
+  };
 
   const handleSubmit = () => {
-    if (selectedOption) {
+    if (selected.length > 0) {
       setShowFeedback(true);
-      const isCorrect = selectedOption === content.correctAnswerId;
-      onAnswer?.(selectedOption, isCorrect);
+      onAnswer?.(selected, isCorrect, isCorrect ? content.points : 0);
     }
   };
 
   const handleReset = () => {
-    setSelectedOption(null);
+    setSelected([]);
     setShowFeedback(false);
+    setAttempt((a) => a + 1);
   };
 
-  const isCorrect = selectedOption === content.correctAnswerId;
-
   if (isEditing && onEdit) {
+    const problem = quizContentProblem(content);
+    const setCorrect = (index: number, checked: boolean) => {
+      const newOptions = content.options.map((o, i) =>
+        isMulti ? (i === index ? { ...o, correct: checked } : o) : { ...o, correct: i === index }
+      );
+      onEdit({ ...content, options: newOptions });
+    };
+
     return (
       <div className="border rounded-lg p-4 bg-white space-y-4">
         <div>
@@ -55,16 +97,41 @@ export function QuizRenderer({ content, isEditing = false, onEdit, onAnswer }: Q
           />
         </div>
 
-        <div>
-          <label className="block text-xs font-medium text-gray-500 mb-1">Question Type</label>
-          <select
-            value={content.questionType}
-            onChange={(e) => onEdit({ ...content, questionType: e.target.value })}
-            className="w-full px-3 py-2 border border-gray-200 rounded focus:ring-2 focus:ring-blue-500 focus:border-transparent"
-          >
-            <option value="multiple_choice">Multiple Choice</option>
-            <option value="true_false">True/False</option>
-          </select>
+        <div className="grid grid-cols-3 gap-3">
+          <div>
+            <label className="block text-xs font-medium text-gray-500 mb-1">Question Type</label>
+            <select
+              value={content.questionType}
+              onChange={(e) => onEdit(withQuestionType(content, e.target.value as QuizQuestionType))}
+              className="w-full px-3 py-2 border border-gray-200 rounded focus:ring-2 focus:ring-blue-500 focus:border-transparent"
+            >
+              {(Object.keys(QUIZ_QUESTION_TYPE_LABELS) as QuizQuestionType[]).map((type) => (
+                <option key={type} value={type}>
+                  {QUIZ_QUESTION_TYPE_LABELS[type]}
+                </option>
+              ))}
+            </select>
+          </div>
+          <div>
+            <label className="block text-xs font-medium text-gray-500 mb-1">Points</label>
+            <input
+              type="number"
+              min={1}
+              max={QUIZ_MAX_POINTS}
+              value={content.points}
+              onChange={(e) => onEdit({ ...content, points: Number(e.target.value) || 1 })}
+              className="w-full px-3 py-2 border border-gray-200 rounded focus:ring-2 focus:ring-blue-500 focus:border-transparent"
+            />
+          </div>
+          <label className="flex items-end gap-2 pb-2 text-sm text-gray-700">
+            <input
+              type="checkbox"
+              checked={content.shuffle}
+              onChange={(e) => onEdit({ ...content, shuffle: e.target.checked })}
+              className="h-4 w-4 text-blue-600"
+            />
+            Shuffle options
+          </label>
         </div>
 
         <div>
@@ -73,10 +140,10 @@ export function QuizRenderer({ content, isEditing = false, onEdit, onAnswer }: Q
             {content.options.map((option, index) => (
               <div key={option.id} className="flex items-center gap-2">
                 <input
-                  type="radio"
+                  type={isMulti ? 'checkbox' : 'radio'}
                   name="correctAnswer"
-                  checked={content.correctAnswerId === option.id}
-                  onChange={() => onEdit({ ...content, correctAnswerId: option.id })}
+                  checked={option.correct}
+                  onChange={(e) => setCorrect(index, e.target.checked)}
                   className="h-4 w-4 text-green-600"
                   title="Mark as correct answer"
                 />
@@ -91,18 +158,14 @@ export function QuizRenderer({ content, isEditing = false, onEdit, onAnswer }: Q
                   className="flex-1 px-3 py-2 border border-gray-200 rounded focus:ring-2 focus:ring-blue-500 focus:border-transparent"
                   placeholder={`Option ${index + 1}`}
                 />
-                {content.options.length > 2 && (
+                {content.options.length > 2 && content.questionType !== 'true_false' && (
                   <button
                     type="button"
                     onClick={() => {
                       const newOptions = content.options.filter((_, i) => i !== index);
                       onEdit({
                         ...content,
-                        options: newOptions,
-                        correctAnswerId:
-                          content.correctAnswerId === option.id
-                            ? newOptions[0]?.id || ''
-                            : content.correctAnswerId,
+                        options: isMulti || newOptions.some((o) => o.correct) ? newOptions : keepFirstCorrect(newOptions),
                       });
                     }}
                     className="p-1 text-red-500 hover:text-red-700"
@@ -114,24 +177,30 @@ export function QuizRenderer({ content, isEditing = false, onEdit, onAnswer }: Q
                 )}
               </div>
             ))}
-            <button
-              type="button"
-              onClick={() => {
-                const newId = `option_${Date.now()}`;
-                onEdit({
-                  ...content,
-                  options: [...content.options, { id: newId, text: '' }],
-                });
-              }}
-              className="text-sm text-blue-600 hover:text-blue-800 flex items-center"
-            >
-              <svg className="w-4 h-4 mr-1" fill="none" viewBox="0 0 24 24" stroke="currentColor">
-                <path strokeLinecap="round" strokeLinejoin="round" strokeWidth={2} d="M12 4v16m8-8H4" />
-              </svg>
-              Add Option
-            </button>
+            {content.questionType !== 'true_false' && content.options.length < QUIZ_MAX_OPTIONS && (
+              <button
+                type="button"
+                onClick={() => {
+                  const newId = `option_${Date.now()}`;
+                  onEdit({
+                    ...content,
+                    options: [...content.options, { id: newId, text: '', correct: false }],
+                  });
+                }}
+                className="text-sm text-blue-600 hover:text-blue-800 flex items-center"
+              >
+                <svg className="w-4 h-4 mr-1" fill="none" viewBox="0 0 24 24" stroke="currentColor">
+                  <path strokeLinecap="round" strokeLinejoin="round" strokeWidth={2} d="M12 4v16m8-8H4" />
+                </svg>
+                Add Option
+              </button>
+            )}
           </div>
-          <p className="mt-1 text-xs text-gray-500">Select the radio button next to the correct answer</p>
+          <p className="mt-1 text-xs text-gray-500">
+            {isMulti
+              ? 'Check every correct answer; learners must select all of them'
+              : 'Select the radio button next to the correct answer'}
+          </p>
         </div>
 
         <div>
@@ -141,10 +210,11 @@ export function QuizRenderer({ content, isEditing = false, onEdit, onAnswer }: Q
             onChange={(e) => onEdit({ ...content, explanation: e.target.value })}
             className="w-full px-3 py-2 border border-gray-200 rounded focus:ring-2 focus:ring-blue-500 focus:border-transparent resize-y"
             rows={2}
-            placeholder="Explain why the answer is correct..."
+            placeholder="Explain why the answer is correct (shown after answering)..."
           />
         </div>
 
+        {problem && <p className="text-xs text-amber-700">{problem}</p>}
       </div>
     );
   }
@@ -156,6 +226,9 @@ export function QuizRenderer({ content, isEditing = false, onEdit, onAnswer }: Q
         <div className="flex items-center gap-2">
           <span className="text-lg">📝</span>
           <h4 className="font-medium text-indigo-900">Knowledge Check</h4>
+          <span className="ml-auto text-xs text-indigo-700">
+            {QUIZ_QUESTION_TYPE_LABELS[content.questionType]} · {content.points} {content.points === 1 ? 'point' : 'points'}
+          </span>
         </div>
       </div>
 
@@ -165,15 +238,14 @@ export function QuizRenderer({ content, isEditing = false, onEdit, onAnswer }: Q
 
         {/* Options */}
         <div className="space-y-2">
-          {content.options.map((option) => {
-            const isSelected = selectedOption === option.id;
-            const isCorrectOption = option.id === content.correctAnswerId;
+          {options.map((option) => {
+            const isSelected = selected.includes(option.id);
 
             let optionStyle = 'border-gray-200 hover:border-indigo-300 hover:bg-indigo-50';
             if (showFeedback) {
-              if (isCorrectOption) {
+              if (option.correct) {
                 optionStyle = 'border-green-500 bg-green-50';
-              } else if (isSelected && !isCorrect) {
+              } else if (isSelected) {
                 optionStyle = 'border-red-500 bg-red-50';
               }
             } else if (isSelected) {
@@ -190,21 +262,21 @@ export function QuizRenderer({ content, isEditing = false, onEdit, onAnswer }: Q
                 `}
               >
                 <input
-                  type="radio"
+                  type={isMulti ? 'checkbox' : 'radio'}
                   name="quiz-option"
                   value={option.id}
                   checked={isSelected}
-                  onChange={() => !showFeedback && setSelectedOption(option.id)}
+                  onChange={() => toggleOption(option.id)}
                   disabled={showFeedback}
                   className="h-4 w-4 text-indigo-600 focus:ring-indigo-500"
                 />
                 <span className="ml-3 text-gray-700">{option.text}</span>
-                {showFeedback && isCorrectOption && (
+                {showFeedback && option.correct && (
                   <svg className="ml-auto h-5 w-5 text-green-500" fill="none" viewBox="0 0 24 24" stroke="currentColor">
                     <path strokeLinecap="round" strokeLinejoin="round" strokeWidth={2} d="M5 13l4 4L19 7" />
                   </svg>
                 )}
-                {showFeedback && isSelected && !isCorrect && (
+                {showFeedback && isSelected && !option.correct && (
                   <svg className="ml-auto h-5 w-5 text-red-500" fill="none" viewBox="0 0 24 24" stroke="currentColor">
                     <path strokeLinecap="round" strokeLinejoin="round" strokeWidth={2} d="M6 18L18 6M6 6l12 12" />
                   </svg>
@@ -218,9 +290,15 @@ export function QuizRenderer({ content, isEditing = false, onEdit, onAnswer }: Q
         {showFeedback && (
           <div className={`mt-4 p-4 rounded-lg ${isCorrect ? 'bg-green-50' : 'bg-amber-50'}`}>
             <p className={`font-medium ${isCorrect ? 'text-green-800' : 'text-amber-800'}`}>
-              {isCorrect ? 'Correct!' : 'Not quite right.'}
+              {isCorrect
+                ? content.correctFeedback || `Correct! +${content.points}`
+                : content.incorrectFeedback || 'Not quite right.'}
             </p>
-            <p className="mt-2 text-sm text-gray-700">{content.explanation}</p>
+            {content.explanation && (
+              <p className="mt-2 text-sm text-gray-700">
+                <span className="font-medium">Explanation:</span> {content.explanation}
+              </p>
+            )}
           </div>
         )}
 
@@ -236,7 +314,7 @@ export function QuizRenderer({ content, isEditing = false, onEdit, onAnswer }: Q
           ) : (
             <button
               onClick={handleSubmit}
-              disabled={!selectedOption}
+              disabled={selected.length === 0}
               className="px-4 py-2 text-sm font-medium text-white bg-indigo-600 rounded-md hover:bg-indigo-700 disabled:opacity-50 disabled:cursor-not-allowed"
             >
               Check Answer
@@ -16,6 +16,8 @@ const JOB_TYPE_LABELS: Record<number, string> = {
   [GenerationJobType.FULL_COURSE]: 'Full Course',
   [GenerationJobType.COMPONENT_REGEN]: 'Component',
   [GenerationJobType.SME_INGESTION]: 'SME Ingestion',
+  [GenerationJobType.LESSONS_EXPORT]: 'Lesson Export',
+  [GenerationJobType.COURSE_TRANSLATION]: 'Course Translation',
 };
 
 const STATUS_CONFIG: Record<number, { label: string; color: string; bgColor: string }> = {
@@ -20,6 +20,10 @@ const TYPE_CONFIG: Record<number, { icon: string; color: string; bgColor: string
   6: { icon: '🎉', color: 'text-green-600', bgColor: 'bg-green-100' }, // GENERATION_COMPLETE
   7: { icon: '⚠️', color: 'text-red-600', bgColor: 'bg-red-100' }, // GENERATION_FAILED
   8: { icon: '👀', color: 'text-indigo-600', bgColor: 'bg-indigo-100' }, // APPROVAL_REQUESTED
+  9: { icon: '🤝', color: 'text-teal-600', bgColor: 'bg-teal-100' }, // COLLABORATOR_ADDED
+  10: { icon: '📦', color: 'text-sky-600', bgColor: 'bg-sky-100' }, // EXPORT_READY
+  11: { icon: '💬', color: 'text-amber-600', bgColor: 'bg-amber-100' }, // OUTLINE_COMMENT
+  12: { icon: '🗂️', color: 'text-orange-600', bgColor: 'bg-orange-100' }, // LESSONS_ORPHANED
 };
 
 const PRIORITY_INDICATOR: Record<number, string> = {
@@ -61,7 +65,12 @@ export function NotificationItem({
       onMarkAsRead();
     }
     if (notification.actionUrl) {
-      router.push(notification.actionUrl);
+      // Export notifications link straight to a presigned download outside the app
+      if (/^https?:\/\//.test(notification.actionUrl)) {
+        window.location.href = notification.actionUrl;
+      } else {
+        router.push(notification.actionUrl);
+      }
     }
   };
 
@@ -2,7 +2,7 @@
 
 import { useState } from 'react';
 import { useUIStore } from '@/store/zustand';
-import type { TenantAISettings, AIProvider, UsageByType } from '@/gen/mirai/v1/tenant_settings_pb';
+import type { TenantAISettings, AIProvider, UsageByType, UsagePeriod } from '@/gen/mirai/v1/tenant_settings_pb';
 import { ResponsiveModal } from '@/components/ui/ResponsiveModal';
 
 interface AISettingsPanelProps {
@@ -12,11 +12,17 @@ interface AISettingsPanelProps {
     tokensThisMonth: bigint;
     monthlyLimit?: bigint;
     usageByType: UsageByType[];
+    periods?: UsagePeriod[];
   };
   isLoading?: boolean;
   onSetApiKey: (provider: AIProvider, apiKey: string) => Promise<void>;
   onTestApiKey: (provider: AIProvider, apiKey: string) => Promise<{ valid: boolean; errorMessage?: string }>;
   onRemoveApiKey: () => Promise<void>;
+  onUpdateOutlineAutoApprove?: (allowed: boolean) => Promise<void>;
+  onUpdatePromptCache?: (disabled: boolean) => Promise<void>;
+  onUpdateSecondReviewerPolicy?: (required: boolean) => Promise<void>;
+  onUpdateLocale?: (locale: string) => Promise<void>;
+  onUpdateExportRetention?: (days: number) => Promise<void>;
 }
 
 const PROVIDER_CONFIG: Record<number, { name: string; description: string; docsUrl: string }> = {
@@ -24,6 +30,17 @@ const PROVIDER_CONFIG: Record<number, { name: string; description: string; docsU
   1: { name: 'Google Gemini', description: 'Google Gemini 2.0 Flash for AI generation', docsUrl: 'https://ai.google.dev/docs' },
 };
 
+const LOCALE_OPTIONS: { value: string; label: string }[] = [
+  { value: '', label: "Each user's language" },
+  { value: 'en', label: 'English' },
+  { value: 'de', label: 'Deutsch' },
+  { value: 'fr', label: 'Français' },
+  { value: 'es', label: 'Español' },
+  { value: 'pt', label: 'Português' },
+];
+
+const EXPORT_RETENTION_DAYS = [7, 14, 30, 60, 90, 180, 365];
+
 function formatTokens(tokens: bigint | number): string {
   const num = Number(tokens);
   if (num >= 1000000) return `${(num / 1000000).toFixed(2)}M`;
@@ -38,6 +55,11 @@ export function AISettingsPanel({
   onSetApiKey,
   onTestApiKey,
   onRemoveApiKey,
+  onUpdateOutlineAutoApprove,
+  onUpdatePromptCache,
+  onUpdateSecondReviewerPolicy,
+  onUpdateLocale,
+  onUpdateExportRetention,
 }: AISettingsPanelProps) {
   // Zustand store for tenant settings UI state
   const {
@@ -58,6 +80,11 @@ export function AISettingsPanel({
   const [apiKey, setApiKey] = useState('');
   const [provider, setProvider] = useState<AIProvider>(1); // Default to Gemini
   const [showRemoveConfirm, setShowRemoveConfirm] = useState(false);
+  const [isSavingAutoApprove, setIsSavingAutoApprove] = useState(false);
+  const [isSavingPromptCache, setIsSavingPromptCache] = useState(false);
+  const [isSavingSecondReviewer, setIsSavingSecondReviewer] = useState(false);
+  const [isSavingLocale, setIsSavingLocale] = useState(false);
+  const [isSavingExportRetention, setIsSavingExportRetention] = useState(false);
 
   const providerConfig = settings ? PROVIDER_CONFIG[settings.provider] : PROVIDER_CONFIG[0];
 
@@ -101,6 +128,71 @@ export function AISettingsPanel({
     }
   };
 
+  const handleToggleAutoApprove = async () => {
+    if (!onUpdateOutlineAutoApprove || !settings) return;
+    setIsSavingAutoApprove(true);
+    try {
+      await onUpdateOutlineAutoApprove(!settings.allowOutlineAutoApprove);
+    } catch (error) {
+      console.error('Failed to update outline auto-approval:', error);
+    } finally {
+      setIsSavingAutoApprove(false);
+    }
+  };
+
+  const handleTogglePromptCache = async () => {
+    if (!onUpdatePromptCache || !settings) return;
+    setIsSavingPromptCache(true);
+    try {
+      await onUpdatePromptCache(!settings.disablePromptCache);
+    } catch (error) {
+      console.error('Failed to update prompt caching:', error);
+    } finally {
+      setIsSavingPromptCache(false);
+    }
+  };
+
+  const handleToggleSecondReviewer = async () => {
+    if (!onUpdateSecondReviewerPolicy || !settings) return;
+    setIsSavingSecondReviewer(true);
+    try {
+      await onUpdateSecondReviewerPolicy(!settings.requireSecondReviewer);
+    } catch (error) {
+      console.error('Failed to update second reviewer policy:', error);
+    } finally {
+      setIsSavingSecondReviewer(false);
+    }
+  };
+
+  const handleChangeLocale = async (locale: string) => {
+    if (!onUpdateLocale) return;
+    setIsSavingLocale(true);
+    try {
+      await onUpdateLocale(locale);
+    } catch (error) {
+      console.error('Failed to update locale:', error);
+    } finally {
+      setIsSavingLocale(false);
+    }
+  };
+
+  const handleChangeExportRetention = async (days: number) => {
+    if (!onUpdateExportRetention) return;
+    setIsSavingExportRetention(true);
+    try {
+      await onUpdateExportRetention(days);
+    } catch (error) {
+      console.error('Failed to update export retention:', error);
+    } finally {
+      setIsSavingExportRetention(false);
+    }
+  };
+
+  const exportRetentionDays = settings?.exportRetentionDays || 30;
+  const exportRetentionOptions = EXPORT_RETENTION_DAYS.includes(exportRetentionDays)
+    ? EXPORT_RETENTION_DAYS
+    : [...EXPORT_RETENTION_DAYS, exportRetentionDays].sort((a, b) => a - b);
+
   if (isLoading) {
     return (
       <div className="bg-white shadow rounded-lg p-6">
@@ -191,6 +283,149 @@ export function AISettingsPanel({
         )}
       </div>
 
+      {/* Outline Auto-Approval */}
+      {onUpdateOutlineAutoApprove && (
+        <div className="px-6 py-4 border-b border-gray-200">
+          <div className="flex items-center justify-between gap-4">
+            <div>
+              <h3 className="text-sm font-medium text-gray-900">Automatic Outline Approval</h3>
+              <p className="mt-1 text-sm text-gray-500">
+                Let course creators skip outline review and generate every lesson in one step.
+              </p>
+            </div>
+            <button
+              type="button"
+              role="switch"
+              aria-checked={settings?.allowOutlineAutoApprove ?? false}
+              onClick={handleToggleAutoApprove}
+              disabled={isSavingAutoApprove || !settings}
+              className={`relative inline-flex h-6 w-11 flex-shrink-0 rounded-full transition-colors disabled:opacity-50 ${
+                settings?.allowOutlineAutoApprove ? 'bg-blue-600' : 'bg-gray-200'
+              }`}
+            >
+              <span
+                className={`inline-block h-5 w-5 mt-0.5 transform rounded-full bg-white shadow transition-transform ${
+                  settings?.allowOutlineAutoApprove ? 'translate-x-5' : 'translate-x-0.5'
+                }`}
+              />
+            </button>
+          </div>
+        </div>
+      )}
+
+      {/* Second Reviewer */}
+      {onUpdateSecondReviewerPolicy && (
+        <div className="px-6 py-4 border-b border-gray-200">
+          <div className="flex items-center justify-between gap-4">
+            <div>
+              <h3 className="text-sm font-medium text-gray-900">Require a Second Reviewer</h3>
+              <p className="mt-1 text-sm text-gray-500">
+                Whoever generates an outline can&apos;t approve it. One reviewer endorses the outline and a different one
+                approves it. Automatic outline approval is unavailable while this is on.
+              </p>
+            </div>
+            <button
+              type="button"
+              role="switch"
+              aria-checked={settings?.requireSecondReviewer ?? false}
+              onClick={handleToggleSecondReviewer}
+              disabled={isSavingSecondReviewer || !settings}
+              className={`relative inline-flex h-6 w-11 flex-shrink-0 rounded-full transition-colors disabled:opacity-50 ${
+                settings?.requireSecondReviewer ? 'bg-blue-600' : 'bg-gray-200'
+              }`}
+            >
+              <span
+                className={`inline-block h-5 w-5 mt-0.5 transform rounded-full bg-white shadow transition-transform ${
+                  settings?.requireSecondReviewer ? 'translate-x-5' : 'translate-x-0.5'
+                }`}
+              />
+            </button>
+          </div>
+        </div>
+      )}
+
+      {/* Prompt Cache */}
+      {onUpdatePromptCache && (
+        <div className="px-6 py-4 border-b border-gray-200">
+          <div className="flex items-center justify-between gap-4">
+            <div>
+              <h3 className="text-sm font-medium text-gray-900">Reuse Identical Regenerations</h3>
+              <p className="mt-1 text-sm text-gray-500">
+                Repeating the same regeneration instruction within 15 minutes returns the earlier result without using tokens.
+              </p>
+            </div>
+            <button
+              type="button"
+              role="switch"
+              aria-checked={!(settings?.disablePromptCache ?? false)}
+              onClick={handleTogglePromptCache}
+              disabled={isSavingPromptCache || !settings}
+              className={`relative inline-flex h-6 w-11 flex-shrink-0 rounded-full transition-colors disabled:opacity-50 ${
+                settings && !settings.disablePromptCache ? 'bg-blue-600' : 'bg-gray-200'
+              }`}
+            >
+              <span
+                className={`inline-block h-5 w-5 mt-0.5 transform rounded-full bg-white shadow transition-transform ${
+                  settings && !settings.disablePromptCache ? 'translate-x-5' : 'translate-x-0.5'
+                }`}
+              />
+            </button>
+          </div>
+        </div>
+      )}
+
+      {/* Locale */}
+      {onUpdateLocale && (
+        <div className="px-6 py-4 border-b border-gray-200">
+          <div className="flex items-center justify-between gap-4">
+            <div>
+              <h3 className="text-sm font-medium text-gray-900">Language &amp; Formatting</h3>
+              <p className="mt-1 text-sm text-gray-500">
+                Format dates, durations and numbers in emails and exports for this language.
+              </p>
+            </div>
+            <select
+              value={settings?.locale ?? ''}
+              onChange={(e) => handleChangeLocale(e.target.value)}
+              disabled={isSavingLocale || !settings}
+              className="rounded-lg border border-gray-300 px-3 py-2 text-sm text-gray-900 disabled:opacity-50"
+            >
+              {LOCALE_OPTIONS.map((option) => (
+                <option key={option.value} value={option.value}>
+                  {option.label}
+                </option>
+              ))}
+            </select>
+          </div>
+        </div>
+      )}
+
+      {/* Export Retention */}
+      {onUpdateExportRetention && (
+        <div className="px-6 py-4 border-b border-gray-200">
+          <div className="flex items-center justify-between gap-4">
+            <div>
+              <h3 className="text-sm font-medium text-gray-900">Export Retention</h3>
+              <p className="mt-1 text-sm text-gray-500">
+                Delete export files this long after they are made. Applies to new exports.
+              </p>
+            </div>
+            <select
+              value={exportRetentionDays}
+              onChange={(e) => handleChangeExportRetention(Number(e.target.value))}
+              disabled={isSavingExportRetention || !settings}
+              className="rounded-lg border border-gray-300 px-3 py-2 text-sm text-gray-900 disabled:opacity-50"
+            >
+              {exportRetentionOptions.map((days) => (
+                <option key={days} value={days}>
+                  {days} days
+                </option>
+              ))}
+            </select>
+          </div>
+        </div>
+      )}
+
       {/* Usage Stats */}
       {settings?.apiKeyConfigured && usageStats && (
         <div className="px-6 py-4">
@@ -280,6 +515,24 @@ export function AISettingsPanel({
                   </div>
                 </div>
               )}
+
+              {/* Usage by Month */}
+              {usageStats.periods && usageStats.periods.length > 0 && (
+                <div>
+                  <h4 className="text-xs text-gray-500 uppercase tracking-wide mb-2">By Month</h4>
+                  <div className="space-y-2">
+                    {usageStats.periods.map((period) => (
+                      <div
+                        key={period.yearMonth}
+                        className="flex items-center justify-between text-sm"
+                      >
+                        <span className="text-gray-700">{period.yearMonth}</span>
+                        <span className="text-gray-500">{formatTokens(period.tokensUsed)}</span>
+                      </div>
+                    ))}
+                  </div>
+                </div>
+              )}
             </div>
           )}
         </div>
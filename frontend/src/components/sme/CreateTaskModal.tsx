@@ -3,7 +3,7 @@
 import { useState, useMemo, useRef, useEffect } from 'react';
 import { useQuery } from '@connectrpc/connect-query';
 import { ResponsiveModal } from '@/components/ui/ResponsiveModal';
-import { useCreateTask, ContentType } from '@/hooks/useSME';
+import { useCreateTask, useGenerateTaskQuestions, ContentType } from '@/hooks/useSME';
 import { listCompanyUsers } from '@/gen/mirai/v1/user-UserService_connectquery';
 import type { User } from '@/gen/mirai/v1/common_pb';
 
@@ -46,10 +46,13 @@ export function CreateTaskModal({ smeId, smeName, onClose, onSuccess }: CreateTa
   const [loading, setLoading] = useState(false);
   const [error, setError] = useState<string | null>(null);
   const [showSuggestions, setShowSuggestions] = useState(false);
+  const [desiredOutcome, setDesiredOutcome] = useState('');
+  const [questions, setQuestions] = useState<string[]>([]);
   const inputRef = useRef<HTMLInputElement>(null);
   const suggestionsRef = useRef<HTMLDivElement>(null);
 
   const createTask = useCreateTask();
+  const generateQuestions = useGenerateTaskQuestions();
   const { data: companyUsersData, isLoading: isLoadingUsers } = useQuery(listCompanyUsers, {});
 
   // Filter users based on search query
@@ -106,6 +109,23 @@ export function CreateTaskModal({ smeId, smeName, onClose, onSuccess }: CreateTa
     inputRef.current?.focus();
   };
 
+  const handleGenerateQuestions = async () => {
+    setError(null);
+    try {
+      setQuestions(await generateQuestions.mutate({ smeId, desiredOutcome: desiredOutcome.trim() }));
+    } catch (err) {
+      setError(err instanceof Error ? err.message : 'Failed to generate questions');
+    }
+  };
+
+  const updateQuestion = (index: number, value: string) => {
+    setQuestions((prev) => prev.map((q, i) => (i === index ? value : q)));
+  };
+
+  const removeQuestion = (index: number) => {
+    setQuestions((prev) => prev.filter((_, i) => i !== index));
+  };
+
   const handleSubmit = async (e: React.FormEvent) => {
     e.preventDefault();
 
@@ -129,6 +149,7 @@ export function CreateTaskModal({ smeId, smeName, onClose, onSuccess }: CreateTa
         expectedContentType: contentType,
         assignedToUserId: selectedUser.id,
         dueDate: dueDate ? new Date(dueDate) : undefined,
+        questions: questions.map((q) => q.trim()).filter(Boolean),
       });
       onSuccess?.();
       onClose();
@@ -311,6 +332,60 @@ export function CreateTaskModal({ smeId, smeName, onClose, onSuccess }: CreateTa
             </div>
           </div>
 
+          {/* Interview Questions */}
+          <div>
+            <label htmlFor="desiredOutcome" className="block text-sm font-medium text-gray-700 mb-1">
+              Interview Questions (optional)
+            </label>
+            <p className="mb-2 text-xs text-gray-500">
+              Describe what learners should achieve and the SME will be asked to answer tailored questions instead of uploading a file.
+            </p>
+            <div className="flex flex-col sm:flex-row gap-2">
+              <input
+                type="text"
+                id="desiredOutcome"
+                value={desiredOutcome}
+                onChange={(e) => setDesiredOutcome(e.target.value)}
+                className="shadow-sm focus:ring-blue-500 focus:border-blue-500 block w-full text-base border-gray-300 rounded-md px-3 py-3 lg:py-2 border min-h-[44px]"
+                placeholder="e.g., New reps can run a discovery call on their own"
+              />
+              <button
+                type="button"
+                onClick={handleGenerateQuestions}
+                disabled={generateQuestions.isLoading || !desiredOutcome.trim()}
+                className="flex-shrink-0 px-4 py-3 lg:py-2 rounded-md border border-blue-600 text-blue-600 hover:bg-blue-50 font-medium disabled:opacity-50 disabled:cursor-not-allowed min-h-[44px]"
+              >
+                {generateQuestions.isLoading ? 'Generating...' : questions.length > 0 ? 'Regenerate' : 'Generate'}
+              </button>
+            </div>
+            {questions.length > 0 && (
+              <ol className="mt-3 space-y-2">
+                {questions.map((question, index) => (
+                  <li key={index} className="flex items-start gap-2">
+                    <span className="pt-2 text-sm text-gray-500 w-5 flex-shrink-0">{index + 1}.</span>
+                    <textarea
+                      rows={2}
+                      value={question}
+                      onChange={(e) => updateQuestion(index, e.target.value)}
+                      aria-label={`Question ${index + 1}`}
+                      className="shadow-sm focus:ring-blue-500 focus:border-blue-500 block w-full text-sm border-gray-300 rounded-md px-3 py-2 border"
+                    />
+                    <button
+                      type="button"
+                      onClick={() => removeQuestion(index)}
+                      aria-label={`Remove question ${index + 1}`}
+                      className="pt-2 text-gray-400 hover:text-gray-600"
+                    >
+                      <svg className="h-5 w-5" fill="none" stroke="currentColor" viewBox="0 0 24 24">
+                        <path strokeLinecap="round" strokeLinejoin="round" strokeWidth={2} d="M6 18L18 6M6 6l12 12" />
+                      </svg>
+                    </button>
+                  </li>
+                ))}
+              </ol>
+            )}
+          </div>
+
           {/* Due Date */}
           <div>
             <label htmlFor="dueDate" className="block text-sm font-medium text-gray-700 mb-1">
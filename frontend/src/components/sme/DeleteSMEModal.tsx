@@ -0,0 +1,134 @@
+'use client';
+
+import { useEffect, useState } from 'react';
+import { ResponsiveModal } from '@/components/ui/ResponsiveModal';
+import { useGetSMEDeletionImpact, type GetSMEDeletionImpactResponse } from '@/hooks/useSME';
+
+interface DeleteSMEModalProps {
+  smeId: string;
+  smeName: string;
+  onClose: () => void;
+  onConfirm: (confirmationToken: string) => Promise<void>;
+}
+
+export function DeleteSMEModal({ smeId, smeName, onClose, onConfirm }: DeleteSMEModalProps) {
+  const getImpact = useGetSMEDeletionImpact();
+  const [impact, setImpact] = useState<GetSMEDeletionImpactResponse | null>(null);
+  const [loading, setLoading] = useState(false);
+  const [error, setError] = useState<string | null>(null);
+
+  // The confirmation token expires after 10 minutes, so a stale dialog re-checks the impact
+  const refresh = async () => {
+    setImpact(null);
+    setError(null);
+    try {
+      setImpact(await getImpact.mutate(smeId));
+    } catch (err) {
+      setError(err instanceof Error ? err.message : 'Failed to load deletion impact');
+    }
+  };
+
+  useEffect(() => {
+    refresh();
+    // eslint-disable-next-line react-hooks/exhaustive-deps
+  }, [smeId]);
+
+  const handleConfirm = async () => {
+    if (!impact) return;
+
+    setLoading(true);
+    setError(null);
+
+    try {
+      await onConfirm(impact.confirmationToken);
+      onClose();
+    } catch (err) {
+      if (err instanceof Error) {
+        setError(err.message);
+      } else {
+        setError('Failed to delete SME');
+      }
+      setLoading(false);
+    }
+  };
+
+  const rows = impact
+    ? [
+        { label: 'Knowledge chunks', value: impact.knowledgeChunks },
+        { label: 'Open tasks', value: impact.pendingTasks },
+        { label: 'Submissions', value: impact.submissions },
+        { label: 'Courses generated from this SME', value: impact.courses },
+      ]
+    : [];
+
+  return (
+    <ResponsiveModal isOpen={true} onClose={onClose} title="Delete SME">
+      <div className="flex flex-col h-full">
+        <div className="flex-1 space-y-4 overflow-y-auto">
+          <p className="text-sm text-gray-600">
+            Deleting <span className="font-medium text-gray-900">{smeName}</span> archives it and
+            removes it from future course generation.
+          </p>
+
+          {!impact && !error && <p className="text-sm text-gray-500">Checking what depends on this SME...</p>}
+
+          {impact && (
+            <div className="bg-gray-50 rounded-lg p-3 space-y-1">
+              {rows.map((row) => (
+                <div key={row.label} className="flex justify-between text-sm">
+                  <span className="text-gray-600">{row.label}</span>
+                  <span className="font-medium text-gray-900">{row.value}</span>
+                </div>
+              ))}
+            </div>
+          )}
+
+          {impact && impact.recentCourseTitles.length > 0 && (
+            <div>
+              <p className="text-sm font-medium text-gray-700 mb-1">Recent courses</p>
+              <ul className="list-disc list-inside text-sm text-gray-600">
+                {impact.recentCourseTitles.map((title, index) => (
+                  <li key={index}>{title}</li>
+                ))}
+              </ul>
+            </div>
+          )}
+
+          {/* Error Message */}
+          {error && (
+            <div className="rounded-md bg-red-50 p-4">
+              <div className="text-sm text-red-700">{error}</div>
+              <button
+                type="button"
+                onClick={refresh}
+                className="mt-2 text-sm font-medium text-red-700 underline"
+              >
+                Check again
+              </button>
+            </div>
+          )}
+        </div>
+
+        {/* Actions */}
+        <div className="flex flex-col-reverse sm:flex-row gap-3 mt-6 pt-4 border-t border-gray-200">
+          <button
+            type="button"
+            onClick={onClose}
+            disabled={loading}
+            className="w-full sm:w-auto px-4 py-3 lg:py-2 rounded-md border border-gray-300 bg-white text-gray-700 hover:bg-gray-50 font-medium min-h-[44px]"
+          >
+            Cancel
+          </button>
+          <button
+            type="button"
+            onClick={handleConfirm}
+            disabled={loading || !impact}
+            className="w-full sm:w-auto px-4 py-3 lg:py-2 rounded-md bg-red-600 text-white hover:bg-red-700 font-medium disabled:opacity-50 disabled:cursor-not-allowed min-h-[44px]"
+          >
+            {loading ? 'Deleting...' : 'Delete SME'}
+          </button>
+        </div>
+      </div>
+    </ResponsiveModal>
+  );
+}
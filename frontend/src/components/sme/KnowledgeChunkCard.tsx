@@ -7,14 +7,18 @@ interface KnowledgeChunkCardProps {
   chunk: SMEKnowledgeChunk;
   onEdit?: (chunk: SMEKnowledgeChunk) => void;
   onDelete?: (chunkId: string) => void;
+  onReviewFlag?: (chunkId: string, release: boolean) => void; // Admins only
   isDeleting?: boolean;
+  isReviewing?: boolean;
 }
 
 export function KnowledgeChunkCard({
   chunk,
   onEdit,
   onDelete,
+  onReviewFlag,
   isDeleting = false,
+  isReviewing = false,
 }: KnowledgeChunkCardProps) {
   const [showDeleteConfirm, setShowDeleteConfirm] = useState(false);
   const [isExpanded, setIsExpanded] = useState(false);
@@ -70,6 +74,29 @@ export function KnowledgeChunkCard({
         </div>
       </div>
 
+      {/* Prompt injection flag */}
+      {chunk.injectionFlagged && (
+        <div className={`mb-2 p-2 rounded border ${chunk.injectionReleasedAt ? 'bg-gray-100 border-gray-200' : 'bg-amber-50 border-amber-200'}`}>
+          <p className={`text-xs ${chunk.injectionReleasedAt ? 'text-gray-600' : 'text-amber-800'}`}>
+            {chunk.injectionReleasedAt
+              ? 'Flagged as possible prompt injection; released for generation after review.'
+              : 'Flagged as possible prompt injection; left out of generation until an admin reviews it.'}
+          </p>
+          {chunk.injectionReason && (
+            <p className="text-xs text-gray-500 mt-1 break-words">{chunk.injectionReason}</p>
+          )}
+          {onReviewFlag && (
+            <button
+              onClick={() => onReviewFlag(chunk.id, !chunk.injectionReleasedAt)}
+              disabled={isReviewing}
+              className="mt-2 px-2 py-1 text-xs font-medium text-gray-700 bg-white rounded border border-gray-300 hover:bg-gray-50 disabled:opacity-50"
+            >
+              {isReviewing ? 'Saving...' : chunk.injectionReleasedAt ? 'Hold back from generation' : 'Release for generation'}
+            </button>
+          )}
+        </div>
+      )}
+
       {/* Delete confirmation */}
       {showDeleteConfirm && (
         <div className="mb-2 p-2 bg-red-50 rounded border border-red-200">
@@ -15,6 +15,7 @@ interface SMEDetailPanelProps {
   isLoadingTasks?: boolean;
   isLoadingChunks?: boolean;
   isDeletingChunk?: string; // ID of chunk being deleted
+  isReviewingChunk?: string; // ID of flagged chunk being reviewed
   isIngesting?: boolean;
   ingestionProgress?: number;
   onBack: () => void;
@@ -29,6 +30,7 @@ interface SMEDetailPanelProps {
   onReviewTask?: (task: SMETask) => void;
   onEditChunk?: (chunk: SMEKnowledgeChunk) => void;
   onDeleteChunk?: (chunkId: string) => void;
+  onReviewChunkFlag?: (chunkId: string, release: boolean) => void;
 }
 
 const STATUS_CONFIG: Record<number, { label: string; color: string; icon: string }> = {
@@ -48,6 +50,7 @@ export function SMEDetailPanel({
   isLoadingTasks = false,
   isLoadingChunks = false,
   isDeletingChunk,
+  isReviewingChunk,
   isIngesting = false,
   ingestionProgress = 0,
   onBack,
@@ -62,6 +65,7 @@ export function SMEDetailPanel({
   onReviewTask,
   onEditChunk,
   onDeleteChunk,
+  onReviewChunkFlag,
 }: SMEDetailPanelProps) {
   const [showDeleteConfirm, setShowDeleteConfirm] = useState(false);
   const status = STATUS_CONFIG[sme.status] || STATUS_CONFIG[0];
@@ -199,7 +203,9 @@ export function SMEDetailPanel({
                 chunk={chunk}
                 onEdit={onEditChunk}
                 onDelete={onDeleteChunk}
+                onReviewFlag={onReviewChunkFlag}
                 isDeleting={isDeletingChunk === chunk.id}
+                isReviewing={isReviewingChunk === chunk.id}
               />
             ))}
           </div>
@@ -2,9 +2,10 @@
 
 import { useState, useEffect } from 'react';
 import type { SMETask, SMETaskSubmission } from '@/gen/mirai/v1/sme_pb';
-import { ContentType, EnhanceType } from '@/gen/mirai/v1/sme_pb';
+import { ContentType, EnhanceType, SubmissionProcessingStatus } from '@/gen/mirai/v1/sme_pb';
 import {
   useListSubmissions,
+  useSubmissionStatus,
   useApproveSubmission,
   useRequestSubmissionChanges,
   useEnhanceSubmissionContent,
@@ -55,6 +56,12 @@ export function SubmissionReviewPanel({
       })[0]
     : null;
 
+  // Ingestion progress, pushed over the notification stream while it runs
+  const { data: progress } = useSubmissionStatus(latestSubmission?.id);
+  const isProcessing =
+    progress?.status === SubmissionProcessingStatus.QUEUED ||
+    progress?.status === SubmissionProcessingStatus.PROCESSING;
+
   // Initialize edited content when submission loads
   useEffect(() => {
     if (latestSubmission?.extractedText) {
@@ -182,6 +189,27 @@ export function SubmissionReviewPanel({
                 )}
               </div>
 
+              {/* Ingestion Progress */}
+              {isProcessing && progress && (
+                <div className="rounded-md bg-blue-50 px-3 py-2">
+                  <div className="flex justify-between text-sm text-blue-800">
+                    <span>{progress.progressMessage || 'Processing submission...'}</span>
+                    <span>{progress.progressPercent}%</span>
+                  </div>
+                  <div className="mt-1.5 h-1.5 w-full rounded-full bg-blue-100">
+                    <div
+                      className="h-1.5 rounded-full bg-blue-600 transition-all"
+                      style={{ width: `${progress.progressPercent}%` }}
+                    />
+                  </div>
+                </div>
+              )}
+              {progress?.status === SubmissionProcessingStatus.FAILED && progress.error && (
+                <div className="rounded-md bg-red-50 px-3 py-2 text-sm text-red-700">
+                  Processing failed: {progress.error}
+                </div>
+              )}
+
               {/* AI Enhancement Buttons - Only for TEXT content */}
               {isTextContent && (
                 <div className="flex gap-2 pb-2 border-b border-gray-200">
@@ -0,0 +1,129 @@
+'use client';
+
+import { useState } from 'react';
+import { ResponsiveModal } from '@/components/ui/ResponsiveModal';
+
+interface SubmitAnswersModalProps {
+  taskId: string;
+  taskTitle: string;
+  smeName: string;
+  questions: string[];
+  onClose: () => void;
+  onSubmit: (data: SubmitAnswersData) => Promise<void>;
+}
+
+export interface SubmitAnswersData {
+  taskId: string;
+  answers: string[];
+}
+
+export function SubmitAnswersModal({
+  taskId,
+  taskTitle,
+  smeName,
+  questions,
+  onClose,
+  onSubmit,
+}: SubmitAnswersModalProps) {
+  const [answers, setAnswers] = useState<string[]>(() => questions.map(() => ''));
+  const [loading, setLoading] = useState(false);
+  const [error, setError] = useState<string | null>(null);
+
+  const answeredCount = answers.filter((a) => a.trim()).length;
+
+  const updateAnswer = (index: number, value: string) => {
+    setAnswers((prev) => prev.map((a, i) => (i === index ? value : a)));
+  };
+
+  const handleSubmit = async (e: React.FormEvent) => {
+    e.preventDefault();
+
+    if (answeredCount === 0) {
+      setError('Please answer at least one question');
+      return;
+    }
+
+    setLoading(true);
+    setError(null);
+
+    try {
+      await onSubmit({
+        taskId,
+        answers: answers.map((a) => a.trim()),
+      });
+      onClose();
+    } catch (err) {
+      if (err instanceof Error) {
+        setError(err.message);
+      } else {
+        setError('Failed to submit answers');
+      }
+      setLoading(false);
+    }
+  };
+
+  return (
+    <ResponsiveModal isOpen={true} onClose={onClose} title="Answer Interview Questions">
+      <form onSubmit={handleSubmit} className="flex flex-col h-full">
+        <div className="flex-1 space-y-4 overflow-y-auto">
+          {/* Task Info */}
+          <div className="bg-gray-50 rounded-lg p-3">
+            <p className="text-sm text-gray-600">
+              Submitting to: <span className="font-medium text-gray-900">{smeName}</span>
+            </p>
+            <p className="text-sm text-gray-600">
+              Task: <span className="font-medium text-gray-900">{taskTitle}</span>
+            </p>
+          </div>
+
+          {/* Questions */}
+          {questions.map((question, index) => (
+            <div key={index}>
+              <label htmlFor={`answer-${index}`} className="block text-sm font-medium text-gray-700 mb-1">
+                {index + 1}. {question}
+              </label>
+              <textarea
+                id={`answer-${index}`}
+                rows={4}
+                value={answers[index]}
+                onChange={(e) => updateAnswer(index, e.target.value)}
+                className="shadow-sm focus:ring-blue-500 focus:border-blue-500 block w-full text-base border-gray-300 rounded-md px-3 py-2 border"
+                placeholder="Your answer..."
+              />
+            </div>
+          ))}
+
+          <p className="text-xs text-gray-500">
+            {answeredCount} of {questions.length} answered. Questions left blank are skipped.
+          </p>
+
+          {/* Error Message */}
+          {error && (
+            <div className="rounded-md bg-red-50 p-4">
+              <div className="text-sm text-red-700">{error}</div>
+            </div>
+          )}
+        </div>
+
+        {/* Actions */}
+        <div className="flex flex-col-reverse sm:flex-row gap-3 mt-6 pt-4 border-t border-gray-200">
+          <button
+            type="button"
+            onClick={onClose}
+            disabled={loading}
+            className="w-full sm:w-auto px-4 py-3 lg:py-2 rounded-md border border-gray-300 bg-white text-gray-700 hover:bg-gray-50 font-medium min-h-[44px]"
+          >
+            Cancel
+          </button>
+          <button
+            type="submit"
+            disabled={loading || answeredCount === 0}
+            className="w-full sm:w-auto px-4 py-3 lg:py-2 rounded-md bg-blue-600 text-white hover:bg-blue-700 font-medium disabled:opacity-50 disabled:cursor-not-allowed min-h-[44px]"
+          >
+            {loading ? 'Submitting...' : 'Submit Answers'}
+          </button>
+        </div>
+      </form>
+    </ResponsiveModal>
+  );
+}
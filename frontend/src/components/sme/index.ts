@@ -2,6 +2,7 @@ export { SMECard } from './SMECard';
 export { SMEList } from './SMEList';
 export { SMEDetailPanel } from './SMEDetailPanel';
 export { CreateSMEModal, type CreateSMEData } from './CreateSMEModal';
+export { DeleteSMEModal } from './DeleteSMEModal';
 export { SubmitTextModal, type SubmitTextData } from './SubmitTextModal';
 export { SubmissionReviewPanel } from './SubmissionReviewPanel';
 export { TaskCard } from './TaskCard';
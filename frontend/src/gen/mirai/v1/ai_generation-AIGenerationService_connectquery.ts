@@ -11,6 +11,29 @@ import { AIGenerationService } from "./ai_generation_pb";
  */
 export const generateCourseOutline = AIGenerationService.method.generateCourseOutline;
 
+/**
+ * AnalyzeKnowledgeCoverage checks whether the selected SMEs have enough material for a
+ * desired outcome, naming the topics that look thin. Nothing is saved.
+ *
+ * @generated from rpc mirai.v1.AIGenerationService.AnalyzeKnowledgeCoverage
+ */
+export const analyzeKnowledgeCoverage = AIGenerationService.method.analyzeKnowledgeCoverage;
+
+/**
+ * SaveGenerationDraft stores the current user's generation wizard selections for a course,
+ * replacing any earlier draft. The draft is cleared when generation starts for the course.
+ *
+ * @generated from rpc mirai.v1.AIGenerationService.SaveGenerationDraft
+ */
+export const saveGenerationDraft = AIGenerationService.method.saveGenerationDraft;
+
+/**
+ * GetGenerationDraft returns the current user's generation wizard draft for a course.
+ *
+ * @generated from rpc mirai.v1.AIGenerationService.GetGenerationDraft
+ */
+export const getGenerationDraft = AIGenerationService.method.getGenerationDraft;
+
 /**
  * GetCourseOutline returns the generated outline for a course.
  *
@@ -19,7 +42,9 @@ export const generateCourseOutline = AIGenerationService.method.generateCourseOu
 export const getCourseOutline = AIGenerationService.method.getCourseOutline;
 
 /**
- * ApproveCourseOutline approves an outline for content generation.
+ * ApproveCourseOutline approves an outline for content generation. When the organization
+ * requires a second reviewer, the first approval by someone other than the outline's
+ * generator is recorded as an endorsement and the outline stays pending review.
  *
  * @generated from rpc mirai.v1.AIGenerationService.ApproveCourseOutline
  */
@@ -39,6 +64,23 @@ export const rejectCourseOutline = AIGenerationService.method.rejectCourseOutlin
  */
 export const updateCourseOutline = AIGenerationService.method.updateCourseOutline;
 
+/**
+ * ExportOutline renders an outline version as CSV or DOCX for review outside Mirai.
+ *
+ * @generated from rpc mirai.v1.AIGenerationService.ExportOutline
+ */
+export const exportOutline = AIGenerationService.method.exportOutline;
+
+/**
+ * BalanceOutlineDurations proposes a revision of a pending outline that splits over-long
+ * lessons and merges short adjacent ones toward a per-lesson duration range. Nothing is
+ * saved; accept the proposal by sending its sections and removed_lesson_ids to
+ * UpdateCourseOutline.
+ *
+ * @generated from rpc mirai.v1.AIGenerationService.BalanceOutlineDurations
+ */
+export const balanceOutlineDurations = AIGenerationService.method.balanceOutlineDurations;
+
 /**
  * GenerateLessonContent generates content for a specific lesson.
  *
@@ -53,6 +95,20 @@ export const generateLessonContent = AIGenerationService.method.generateLessonCo
  */
 export const generateAllLessons = AIGenerationService.method.generateAllLessons;
 
+/**
+ * RetryFailedLessons requeues the failed lessons of a failed full course run under the same parent job.
+ *
+ * @generated from rpc mirai.v1.AIGenerationService.RetryFailedLessons
+ */
+export const retryFailedLessons = AIGenerationService.method.retryFailedLessons;
+
+/**
+ * ExportAllLessons starts a job that packages every generated lesson into a ZIP in tenant storage.
+ *
+ * @generated from rpc mirai.v1.AIGenerationService.ExportAllLessons
+ */
+export const exportAllLessons = AIGenerationService.method.exportAllLessons;
+
 /**
  * RegenerateComponent regenerates a single component with modifications.
  *
@@ -60,6 +116,43 @@ export const generateAllLessons = AIGenerationService.method.generateAllLessons;
  */
 export const regenerateComponent = AIGenerationService.method.regenerateComponent;
 
+/**
+ * EditComponentText rewrites a text component inline and returns the proposal without saving.
+ *
+ * @generated from rpc mirai.v1.AIGenerationService.EditComponentText
+ */
+export const editComponentText = AIGenerationService.method.editComponentText;
+
+/**
+ * GetComponentSources returns the SME knowledge chunks a component was generated from.
+ *
+ * @generated from rpc mirai.v1.AIGenerationService.GetComponentSources
+ */
+export const getComponentSources = AIGenerationService.method.getComponentSources;
+
+/**
+ * GetComponentAssetUploadURL returns a presigned URL for uploading the file for a
+ * placeholder component, such as the video for a scripted video block.
+ *
+ * @generated from rpc mirai.v1.AIGenerationService.GetComponentAssetUploadURL
+ */
+export const getComponentAssetUploadURL = AIGenerationService.method.getComponentAssetUploadURL;
+
+/**
+ * ConfirmComponentAsset attaches an uploaded file to its component.
+ *
+ * @generated from rpc mirai.v1.AIGenerationService.ConfirmComponentAsset
+ */
+export const confirmComponentAsset = AIGenerationService.method.confirmComponentAsset;
+
+/**
+ * SuggestCourseTitles suggests course titles from the selected SMEs, audiences and outcome.
+ * Nothing is saved; apply a chosen title with CourseService.UpdateCourse.
+ *
+ * @generated from rpc mirai.v1.AIGenerationService.SuggestCourseTitles
+ */
+export const suggestCourseTitles = AIGenerationService.method.suggestCourseTitles;
+
 /**
  * GetJob returns a generation job by ID.
  *
@@ -94,3 +187,107 @@ export const getGeneratedLesson = AIGenerationService.method.getGeneratedLesson;
  * @generated from rpc mirai.v1.AIGenerationService.ListGeneratedLessons
  */
 export const listGeneratedLessons = AIGenerationService.method.listGeneratedLessons;
+
+/**
+ * GetCourseStats returns word, quiz and image counts for a course's generated lessons.
+ *
+ * @generated from rpc mirai.v1.AIGenerationService.GetCourseStats
+ */
+export const getCourseStats = AIGenerationService.method.getCourseStats;
+
+/**
+ * GetCoursePlayerView returns a course's lessons in display order for the learner-facing
+ * player, without authoring metadata. Published courses show their published version.
+ * Unpublished courses and drafts are only visible to their editors.
+ *
+ * @generated from rpc mirai.v1.AIGenerationService.GetCoursePlayerView
+ */
+export const getCoursePlayerView = AIGenerationService.method.getCoursePlayerView;
+
+/**
+ * GetAccessibilityReport lists the accessibility problems left in a course's lessons, such
+ * as images without alt text, so authors can fix them before publishing. Editors only.
+ *
+ * @generated from rpc mirai.v1.AIGenerationService.GetAccessibilityReport
+ */
+export const getAccessibilityReport = AIGenerationService.method.getAccessibilityReport;
+
+/**
+ * GetQueueStatus returns the tenant's active jobs and the state of the shared generation queue.
+ *
+ * @generated from rpc mirai.v1.AIGenerationService.GetQueueStatus
+ */
+export const getQueueStatus = AIGenerationService.method.getQueueStatus;
+
+/**
+ * ListAnomalies returns generation anomalies across tenants.
+ * Requires a superadmin (SUPERADMIN_EMAILS).
+ *
+ * @generated from rpc mirai.v1.AIGenerationService.ListAnomalies
+ */
+export const listAnomalies = AIGenerationService.method.listAnomalies;
+
+/**
+ * StartStorageAudit starts a job that reports stored objects of the caller's organization
+ * that no course, job, submission, SME or LMS delivery references. Admin only.
+ *
+ * @generated from rpc mirai.v1.AIGenerationService.StartStorageAudit
+ */
+export const startStorageAudit = AIGenerationService.method.startStorageAudit;
+
+/**
+ * GetStorageAuditReport returns a storage audit job and, once it has completed, a link to
+ * its JSON report. The job's progress message summarizes the result. Admin only.
+ *
+ * @generated from rpc mirai.v1.AIGenerationService.GetStorageAuditReport
+ */
+export const getStorageAuditReport = AIGenerationService.method.getStorageAuditReport;
+
+/**
+ * TranslateCourse copies a course into a new draft course in the target language and
+ * starts a job that translates its outline and generated lessons.
+ *
+ * @generated from rpc mirai.v1.AIGenerationService.TranslateCourse
+ */
+export const translateCourse = AIGenerationService.method.translateCourse;
+
+/**
+ * CreateOutlineComment adds a reviewer comment to an outline lesson and notifies the
+ * course owner. Comments stay with the lesson across outline edits and versions.
+ *
+ * @generated from rpc mirai.v1.AIGenerationService.CreateOutlineComment
+ */
+export const createOutlineComment = AIGenerationService.method.createOutlineComment;
+
+/**
+ * ListOutlineComments returns the comments on a course's outline lessons.
+ *
+ * @generated from rpc mirai.v1.AIGenerationService.ListOutlineComments
+ */
+export const listOutlineComments = AIGenerationService.method.listOutlineComments;
+
+/**
+ * ResolveOutlineComment marks an outline comment resolved.
+ *
+ * @generated from rpc mirai.v1.AIGenerationService.ResolveOutlineComment
+ */
+export const resolveOutlineComment = AIGenerationService.method.resolveOutlineComment;
+
+/**
+ * SetTenantAIEnabled pauses or resumes a tenant's AI generation. While paused, requests
+ * that start generation fail with FAILED_PRECONDITION and the tenant's queued jobs wait;
+ * resuming sends them back to the worker. Requires a superadmin (SUPERADMIN_EMAILS).
+ *
+ * @generated from rpc mirai.v1.AIGenerationService.SetTenantAIEnabled
+ */
+export const setTenantAIEnabled = AIGenerationService.method.setTenantAIEnabled;
+
+/**
+ * MigrateQuizSchema queues an upgrade of quiz components in every tenant to the current
+ * quiz schema (schema_version in the content). Quizzes that can't be upgraded are left
+ * as they were. The counts are emailed to the operators. Requires a superadmin
+ * (SUPERADMIN_EMAILS).
+ *
+ * @generated from rpc mirai.v1.AIGenerationService.MigrateQuizSchema
+ */
+export const migrateQuizSchema = AIGenerationService.method.migrateQuizSchema;
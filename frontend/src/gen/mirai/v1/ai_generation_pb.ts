@@ -12,7 +12,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file mirai/v1/ai_generation.proto.
  */
 export const file_mirai_v1_ai_generation: GenFile = /*@__PURE__*/
-  fileDesc("ChxtaXJhaS92MS9haV9nZW5lcmF0aW9uLnByb3RvEghtaXJhaS52MSKXBgoNR2VuZXJhdGlvbkpvYhIKCgJpZBgBIAEoCRIRCgl0ZW5hbnRfaWQYAiABKAkSKQoEdHlwZRgDIAEoDjIbLm1pcmFpLnYxLkdlbmVyYXRpb25Kb2JUeXBlEi0KBnN0YXR1cxgEIAEoDjIdLm1pcmFpLnYxLkdlbmVyYXRpb25Kb2JTdGF0dXMSFgoJY291cnNlX2lkGAUgASgJSACIAQESFgoJbGVzc29uX2lkGAYgASgJSAGIAQESGAoLc21lX3Rhc2tfaWQYByABKAlIAogBARIaCg1zdWJtaXNzaW9uX2lkGAggASgJSAOIAQESGAoQcHJvZ3Jlc3NfcGVyY2VudBgJIAEoBRIdChBwcm9ncmVzc19tZXNzYWdlGAogASgJSASIAQESGAoLcmVzdWx0X3BhdGgYCyABKAlIBYgBARIaCg1lcnJvcl9tZXNzYWdlGAwgASgJSAaIAQESEwoLdG9rZW5zX3VzZWQYDSABKAMSEwoLcmV0cnlfY291bnQYDiABKAUSEwoLbWF4X3JldHJpZXMYDyABKAUSGgoSY3JlYXRlZF9ieV91c2VyX2lkGBAgASgJEi4KCmNyZWF0ZWRfYXQYESABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjMKCnN0YXJ0ZWRfYXQYEiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSAeIAQESNQoMY29tcGxldGVkX2F0GBMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgIiAEBEhoKDXBhcmVudF9qb2JfaWQYFCABKAlICYgBAUIMCgpfY291cnNlX2lkQgwKCl9sZXNzb25faWRCDgoMX3NtZV90YXNrX2lkQhAKDl9zdWJtaXNzaW9uX2lkQhMKEV9wcm9ncmVzc19tZXNzYWdlQg4KDF9yZXN1bHRfcGF0aEIQCg5fZXJyb3JfbWVzc2FnZUINCgtfc3RhcnRlZF9hdEIPCg1fY29tcGxldGVkX2F0QhAKDl9wYXJlbnRfam9iX2lkIosDCg1Db3Vyc2VPdXRsaW5lEgoKAmlkGAEgASgJEhEKCWNvdXJzZV9pZBgCIAEoCRIPCgd2ZXJzaW9uGAMgASgFEioKCHNlY3Rpb25zGAQgAygLMhgubWlyYWkudjEuT3V0bGluZVNlY3Rpb24SOAoPYXBwcm92YWxfc3RhdHVzGAUgASgOMh8ubWlyYWkudjEuT3V0bGluZUFwcHJvdmFsU3RhdHVzEh0KEHJlamVjdGlvbl9yZWFzb24YBiABKAlIAIgBARIwCgxnZW5lcmF0ZWRfYXQYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjQKC2FwcHJvdmVkX2F0GAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgBiAEBEiAKE2FwcHJvdmVkX2J5X3VzZXJfaWQYCSABKAlIAogBAUITChFfcmVqZWN0aW9uX3JlYXNvbkIOCgxfYXBwcm92ZWRfYXRCFgoUX2FwcHJvdmVkX2J5X3VzZXJfaWQieQoOT3V0bGluZVNlY3Rpb24SCgoCaWQYASABKAkSDQoFdGl0bGUYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSDQoFb3JkZXIYBCABKAUSKAoHbGVzc29ucxgFIAMoCzIXLm1pcmFpLnYxLk91dGxpbmVMZXNzb24ixgEKDU91dGxpbmVMZXNzb24SCgoCaWQYASABKAkSDQoFdGl0bGUYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSDQoFb3JkZXIYBCABKAUSIgoaZXN0aW1hdGVkX2R1cmF0aW9uX21pbnV0ZXMYBSABKAUSGwoTbGVhcm5pbmdfb2JqZWN0aXZlcxgGIAMoCRIaChJpc19sYXN0X2luX3NlY3Rpb24YByABKAgSGQoRaXNfbGFzdF9pbl9jb3Vyc2UYCCABKAgi9wEKD0dlbmVyYXRlZExlc3NvbhIKCgJpZBgBIAEoCRIRCgljb3Vyc2VfaWQYAiABKAkSEgoKc2VjdGlvbl9pZBgDIAEoCRIZChFvdXRsaW5lX2xlc3Nvbl9pZBgEIAEoCRINCgV0aXRsZRgFIAEoCRItCgpjb21wb25lbnRzGAYgAygLMhkubWlyYWkudjEuTGVzc29uQ29tcG9uZW50EhcKCnNlZ3VlX3RleHQYByABKAlIAIgBARIwCgxnZW5lcmF0ZWRfYXQYCCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQg0KC19zZWd1ZV90ZXh0IrMBCg9MZXNzb25Db21wb25lbnQSCgoCaWQYASABKAkSKwoEdHlwZRgCIAEoDjIdLm1pcmFpLnYxLkxlc3NvbkNvbXBvbmVudFR5cGUSDQoFb3JkZXIYAyABKAUSFAoMY29udGVudF9qc29uGAQgASgJEjQKCWFsaWdubWVudBgFIAEoCzIcLm1pcmFpLnYxLkNvbXBvbmVudEFsaWdubWVudEgAiAEBQgwKCl9hbGlnbm1lbnQiSwoSQ29tcG9uZW50QWxpZ25tZW50EhUKDXNtZV9jaHVua19pZHMYASADKAkSHgoWbGVhcm5pbmdfb2JqZWN0aXZlX2lkcxgCIAMoCSIuCgtUZXh0Q29udGVudBIMCgRodG1sGAEgASgJEhEKCXBsYWludGV4dBgCIAEoCSJFCg5IZWFkaW5nQ29udGVudBIlCgVsZXZlbBgBIAEoDjIWLm1pcmFpLnYxLkhlYWRpbmdMZXZlbBIMCgR0ZXh0GAIgASgJIk8KDEltYWdlQ29udGVudBILCgN1cmwYASABKAkSEAoIYWx0X3RleHQYAiABKAkSFAoHY2FwdGlvbhgDIAEoCUgAiAEBQgoKCF9jYXB0aW9uIvkBCgtRdWl6Q29udGVudBIQCghxdWVzdGlvbhgBIAEoCRIVCg1xdWVzdGlvbl90eXBlGAIgASgJEiUKB29wdGlvbnMYAyADKAsyFC5taXJhaS52MS5RdWl6T3B0aW9uEhkKEWNvcnJlY3RfYW5zd2VyX2lkGAQgASgJEhMKC2V4cGxhbmF0aW9uGAUgASgJEh0KEGNvcnJlY3RfZmVlZGJhY2sYBiABKAlIAIgBARIfChJpbmNvcnJlY3RfZmVlZGJhY2sYByABKAlIAYgBAUITChFfY29ycmVjdF9mZWVkYmFja0IVChNfaW5jb3JyZWN0X2ZlZWRiYWNrIiYKClF1aXpPcHRpb24SCgoCaWQYASABKAkSDAoEdGV4dBgCIAEoCSKpAQoVQ291cnNlR2VuZXJhdGlvbklucHV0EhEKCWNvdXJzZV9pZBgBIAEoCRIPCgdzbWVfaWRzGAIgAygJEhsKE3RhcmdldF9hdWRpZW5jZV9pZHMYAyADKAkSFwoPZGVzaXJlZF9vdXRjb21lGAQgASgJEh8KEmFkZGl0aW9uYWxfY29udGV4dBgFIAEoCUgAiAEBQhUKE19hZGRpdGlvbmFsX2NvbnRleHQiTgocR2VuZXJhdGVDb3Vyc2VPdXRsaW5lUmVxdWVzdBIuCgVpbnB1dBgBIAEoCzIfLm1pcmFpLnYxLkNvdXJzZUdlbmVyYXRpb25JbnB1dCJFCh1HZW5lcmF0ZUNvdXJzZU91dGxpbmVSZXNwb25zZRIkCgNqb2IYASABKAsyFy5taXJhaS52MS5HZW5lcmF0aW9uSm9iIk4KF0dldENvdXJzZU91dGxpbmVSZXF1ZXN0EhEKCWNvdXJzZV9pZBgBIAEoCRIUCgd2ZXJzaW9uGAIgASgFSACIAQFCCgoIX3ZlcnNpb24iRAoYR2V0Q291cnNlT3V0bGluZVJlc3BvbnNlEigKB291dGxpbmUYASABKAsyFy5taXJhaS52MS5Db3Vyc2VPdXRsaW5lIkQKG0FwcHJvdmVDb3Vyc2VPdXRsaW5lUmVxdWVzdBIRCgljb3Vyc2VfaWQYASABKAkSEgoKb3V0bGluZV9pZBgCIAEoCSJIChxBcHByb3ZlQ291cnNlT3V0bGluZVJlc3BvbnNlEigKB291dGxpbmUYASABKAsyFy5taXJhaS52MS5Db3Vyc2VPdXRsaW5lIlMKGlJlamVjdENvdXJzZU91dGxpbmVSZXF1ZXN0EhEKCWNvdXJzZV9pZBgBIAEoCRISCgpvdXRsaW5lX2lkGAIgASgJEg4KBnJlYXNvbhgDIAEoCSJHChtSZWplY3RDb3Vyc2VPdXRsaW5lUmVzcG9uc2USKAoHb3V0bGluZRgBIAEoCzIXLm1pcmFpLnYxLkNvdXJzZU91dGxpbmUibwoaVXBkYXRlQ291cnNlT3V0bGluZVJlcXVlc3QSEQoJY291cnNlX2lkGAEgASgJEhIKCm91dGxpbmVfaWQYAiABKAkSKgoIc2VjdGlvbnMYAyADKAsyGC5taXJhaS52MS5PdXRsaW5lU2VjdGlvbiJHChtVcGRhdGVDb3Vyc2VPdXRsaW5lUmVzcG9uc2USKAoHb3V0bGluZRgBIAEoCzIXLm1pcmFpLnYxLkNvdXJzZU91dGxpbmUiTAocR2VuZXJhdGVMZXNzb25Db250ZW50UmVxdWVzdBIRCgljb3Vyc2VfaWQYASABKAkSGQoRb3V0bGluZV9sZXNzb25faWQYAiABKAkiRQodR2VuZXJhdGVMZXNzb25Db250ZW50UmVzcG9uc2USJAoDam9iGAEgASgLMhcubWlyYWkudjEuR2VuZXJhdGlvbkpvYiIuChlHZW5lcmF0ZUFsbExlc3NvbnNSZXF1ZXN0EhEKCWNvdXJzZV9pZBgBIAEoCSJCChpHZW5lcmF0ZUFsbExlc3NvbnNSZXNwb25zZRIkCgNqb2IYASABKAsyFy5taXJhaS52MS5HZW5lcmF0aW9uSm9iInUKGlJlZ2VuZXJhdGVDb21wb25lbnRSZXF1ZXN0EhEKCWNvdXJzZV9pZBgBIAEoCRIRCglsZXNzb25faWQYAiABKAkSFAoMY29tcG9uZW50X2lkGAMgASgJEhsKE21vZGlmaWNhdGlvbl9wcm9tcHQYBCABKAkiQwobUmVnZW5lcmF0ZUNvbXBvbmVudFJlc3BvbnNlEiQKA2pvYhgBIAEoCzIXLm1pcmFpLnYxLkdlbmVyYXRpb25Kb2IiHwoNR2V0Sm9iUmVxdWVzdBIOCgZqb2JfaWQYASABKAkiNgoOR2V0Sm9iUmVzcG9uc2USJAoDam9iGAEgASgLMhcubWlyYWkudjEuR2VuZXJhdGlvbkpvYiKvAQoPTGlzdEpvYnNSZXF1ZXN0Ei4KBHR5cGUYASABKA4yGy5taXJhaS52MS5HZW5lcmF0aW9uSm9iVHlwZUgAiAEBEjIKBnN0YXR1cxgCIAEoDjIdLm1pcmFpLnYxLkdlbmVyYXRpb25Kb2JTdGF0dXNIAYgBARIWCgljb3Vyc2VfaWQYAyABKAlIAogBAUIHCgVfdHlwZUIJCgdfc3RhdHVzQgwKCl9jb3Vyc2VfaWQiOQoQTGlzdEpvYnNSZXNwb25zZRIlCgRqb2JzGAEgAygLMhcubWlyYWkudjEuR2VuZXJhdGlvbkpvYiIiChBDYW5jZWxKb2JSZXF1ZXN0Eg4KBmpvYl9pZBgBIAEoCSI5ChFDYW5jZWxKb2JSZXNwb25zZRIkCgNqb2IYASABKAsyFy5taXJhaS52MS5HZW5lcmF0aW9uSm9iIi4KGUdldEdlbmVyYXRlZExlc3NvblJlcXVlc3QSEQoJbGVzc29uX2lkGAEgASgJIkcKGkdldEdlbmVyYXRlZExlc3NvblJlc3BvbnNlEikKBmxlc3NvbhgBIAEoCzIZLm1pcmFpLnYxLkdlbmVyYXRlZExlc3NvbiIwChtMaXN0R2VuZXJhdGVkTGVzc29uc1JlcXVlc3QSEQoJY291cnNlX2lkGAEgASgJIkoKHExpc3RHZW5lcmF0ZWRMZXNzb25zUmVzcG9uc2USKgoHbGVzc29ucxgBIAMoCzIZLm1pcmFpLnYxLkdlbmVyYXRlZExlc3Nvbir9AQoRR2VuZXJhdGlvbkpvYlR5cGUSIwofR0VORVJBVElPTl9KT0JfVFlQRV9VTlNQRUNJRklFRBAAEiUKIUdFTkVSQVRJT05fSk9CX1RZUEVfU01FX0lOR0VTVElPThABEiYKIkdFTkVSQVRJT05fSk9CX1RZUEVfQ09VUlNFX09VVExJTkUQAhImCiJHRU5FUkFUSU9OX0pPQl9UWVBFX0xFU1NPTl9DT05URU5UEAMSJwojR0VORVJBVElPTl9KT0JfVFlQRV9DT01QT05FTlRfUkVHRU4QBBIjCh9HRU5FUkFUSU9OX0pPQl9UWVBFX0ZVTExfQ09VUlNFEAUq8AEKE0dlbmVyYXRpb25Kb2JTdGF0dXMSJQohR0VORVJBVElPTl9KT0JfU1RBVFVTX1VOU1BFQ0lGSUVEEAASIAocR0VORVJBVElPTl9KT0JfU1RBVFVTX1FVRVVFRBABEiQKIEdFTkVSQVRJT05fSk9CX1NUQVRVU19QUk9DRVNTSU5HEAISIwofR0VORVJBVElPTl9KT0JfU1RBVFVTX0NPTVBMRVRFRBADEiAKHEdFTkVSQVRJT05fSk9CX1NUQVRVU19GQUlMRUQQBBIjCh9HRU5FUkFUSU9OX0pPQl9TVEFUVVNfQ0FOQ0VMTEVEEAUq6AEKFU91dGxpbmVBcHByb3ZhbFN0YXR1cxInCiNPVVRMSU5FX0FQUFJPVkFMX1NUQVRVU19VTlNQRUNJRklFRBAAEioKJk9VVExJTkVfQVBQUk9WQUxfU1RBVFVTX1BFTkRJTkdfUkVWSUVXEAESJAogT1VUTElORV9BUFBST1ZBTF9TVEFUVVNfQVBQUk9WRUQQAhIkCiBPVVRMSU5FX0FQUFJPVkFMX1NUQVRVU19SRUpFQ1RFRBADEi4KKk9VVExJTkVfQVBQUk9WQUxfU1RBVFVTX1JFVklTSU9OX1JFUVVFU1RFRBAEKsABChNMZXNzb25Db21wb25lbnRUeXBlEiUKIUxFU1NPTl9DT01QT05FTlRfVFlQRV9VTlNQRUNJRklFRBAAEh4KGkxFU1NPTl9DT01QT05FTlRfVFlQRV9URVhUEAESIQodTEVTU09OX0NPTVBPTkVOVF9UWVBFX0hFQURJTkcQAhIfChtMRVNTT05fQ09NUE9ORU5UX1RZUEVfSU1BR0UQAxIeChpMRVNTT05fQ09NUE9ORU5UX1RZUEVfUVVJWhAEKoUBCgxIZWFkaW5nTGV2ZWwSHQoZSEVBRElOR19MRVZFTF9VTlNQRUNJRklFRBAAEhQKEEhFQURJTkdfTEVWRUxfSDEQARIUChBIRUFESU5HX0xFVkVMX0gyEAISFAoQSEVBRElOR19MRVZFTF9IMxADEhQKEEhFQURJTkdfTEVWRUxfSDQQBDLGCQoTQUlHZW5lcmF0aW9uU2VydmljZRJoChVHZW5lcmF0ZUNvdXJzZU91dGxpbmUSJi5taXJhaS52MS5HZW5lcmF0ZUNvdXJzZU91dGxpbmVSZXF1ZXN0GicubWlyYWkudjEuR2VuZXJhdGVDb3Vyc2VPdXRsaW5lUmVzcG9uc2USWQoQR2V0Q291cnNlT3V0bGluZRIhLm1pcmFpLnYxLkdldENvdXJzZU91dGxpbmVSZXF1ZXN0GiIubWlyYWkudjEuR2V0Q291cnNlT3V0bGluZVJlc3BvbnNlEmUKFEFwcHJvdmVDb3Vyc2VPdXRsaW5lEiUubWlyYWkudjEuQXBwcm92ZUNvdXJzZU91dGxpbmVSZXF1ZXN0GiYubWlyYWkudjEuQXBwcm92ZUNvdXJzZU91dGxpbmVSZXNwb25zZRJiChNSZWplY3RDb3Vyc2VPdXRsaW5lEiQubWlyYWkudjEuUmVqZWN0Q291cnNlT3V0bGluZVJlcXVlc3QaJS5taXJhaS52MS5SZWplY3RDb3Vyc2VPdXRsaW5lUmVzcG9uc2USYgoTVXBkYXRlQ291cnNlT3V0bGluZRIkLm1pcmFpLnYxLlVwZGF0ZUNvdXJzZU91dGxpbmVSZXF1ZXN0GiUubWlyYWkudjEuVXBkYXRlQ291cnNlT3V0bGluZVJlc3BvbnNlEmgKFUdlbmVyYXRlTGVzc29uQ29udGVudBImLm1pcmFpLnYxLkdlbmVyYXRlTGVzc29uQ29udGVudFJlcXVlc3QaJy5taXJhaS52MS5HZW5lcmF0ZUxlc3NvbkNvbnRlbnRSZXNwb25zZRJfChJHZW5lcmF0ZUFsbExlc3NvbnMSIy5taXJhaS52MS5HZW5lcmF0ZUFsbExlc3NvbnNSZXF1ZXN0GiQubWlyYWkudjEuR2VuZXJhdGVBbGxMZXNzb25zUmVzcG9uc2USYgoTUmVnZW5lcmF0ZUNvbXBvbmVudBIkLm1pcmFpLnYxLlJlZ2VuZXJhdGVDb21wb25lbnRSZXF1ZXN0GiUubWlyYWkudjEuUmVnZW5lcmF0ZUNvbXBvbmVudFJlc3BvbnNlEjsKBkdldEpvYhIXLm1pcmFpLnYxLkdldEpvYlJlcXVlc3QaGC5taXJhaS52MS5HZXRKb2JSZXNwb25zZRJBCghMaXN0Sm9icxIZLm1pcmFpLnYxLkxpc3RKb2JzUmVxdWVzdBoaLm1pcmFpLnYxLkxpc3RKb2JzUmVzcG9uc2USRAoJQ2FuY2VsSm9iEhoubWlyYWkudjEuQ2FuY2VsSm9iUmVxdWVzdBobLm1pcmFpLnYxLkNhbmNlbEpvYlJlc3BvbnNlEl8KEkdldEdlbmVyYXRlZExlc3NvbhIjLm1pcmFpLnYxLkdldEdlbmVyYXRlZExlc3NvblJlcXVlc3QaJC5taXJhaS52MS5HZXRHZW5lcmF0ZWRMZXNzb25SZXNwb25zZRJlChRMaXN0R2VuZXJhdGVkTGVzc29ucxIlLm1pcmFpLnYxLkxpc3RHZW5lcmF0ZWRMZXNzb25zUmVxdWVzdBomLm1pcmFpLnYxLkxpc3RHZW5lcmF0ZWRMZXNzb25zUmVzcG9uc2VClwEKDGNvbS5taXJhaS52MUIRQWlHZW5lcmF0aW9uUHJvdG9QAVozZ2l0aHViLmNvbS9zb2dvcy9taXJhaS1iYWNrZW5kL2dlbi9taXJhaS92MTttaXJhaXYxogIDTVhYqgIITWlyYWkuVjHKAghNaXJhaVxWMeICFE1pcmFpXFYxXEdQQk1ldGFkYXRh6gIJTWlyYWk6OlYxYgZwcm90bzM", [file_google_protobuf_timestamp]);
+  fileDesc("ChxtaXJhaS92MS9haV9nZW5lcmF0aW9uLnByb3RvEghtaXJhaS52MSKQCAoNR2VuZXJhdGlvbkpvYhIKCgJpZBgBIAEoCRIRCgl0ZW5hbnRfaWQYAiABKAkSKQoEdHlwZRgDIAEoDjIbLm1pcmFpLnYxLkdlbmVyYXRpb25Kb2JUeXBlEi0KBnN0YXR1cxgEIAEoDjIdLm1pcmFpLnYxLkdlbmVyYXRpb25Kb2JTdGF0dXMSFgoJY291cnNlX2lkGAUgASgJSACIAQESFgoJbGVzc29uX2lkGAYgASgJSAGIAQESGAoLc21lX3Rhc2tfaWQYByABKAlIAogBARIaCg1zdWJtaXNzaW9uX2lkGAggASgJSAOIAQESGAoQcHJvZ3Jlc3NfcGVyY2VudBgJIAEoBRIdChBwcm9ncmVzc19tZXNzYWdlGAogASgJSASIAQESGAoLcmVzdWx0X3BhdGgYCyABKAlIBYgBARIaCg1lcnJvcl9tZXNzYWdlGAwgASgJSAaIAQESEwoLdG9rZW5zX3VzZWQYDSABKAMSEwoLcmV0cnlfY291bnQYDiABKAUSEwoLbWF4X3JldHJpZXMYDyABKAUSGgoSY3JlYXRlZF9ieV91c2VyX2lkGBAgASgJEi4KCmNyZWF0ZWRfYXQYESABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjMKCnN0YXJ0ZWRfYXQYEiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSAeIAQESNQoMY29tcGxldGVkX2F0GBMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgIiAEBEhoKDXBhcmVudF9qb2JfaWQYFCABKAlICYgBARIXCg9yZXBhaXJfYXR0ZW1wdHMYFSABKAUSNwoOZmFpbHVyZV9yZWFzb24YFiABKA4yGi5taXJhaS52MS5Kb2JGYWlsdXJlUmVhc29uSAqIAQESHQoQc3VnZ2VzdGVkX2FjdGlvbhgXIAEoCUgLiAEBEhgKEGltYWdlc19nZW5lcmF0ZWQYGCABKAUSMwoMY2hpbGRfY291bnRzGBkgASgLMhgubWlyYWkudjEuSm9iQ2hpbGRDb3VudHNIDIgBAUIMCgpfY291cnNlX2lkQgwKCl9sZXNzb25faWRCDgoMX3NtZV90YXNrX2lkQhAKDl9zdWJtaXNzaW9uX2lkQhMKEV9wcm9ncmVzc19tZXNzYWdlQg4KDF9yZXN1bHRfcGF0aEIQCg5fZXJyb3JfbWVzc2FnZUINCgtfc3RhcnRlZF9hdEIPCg1fY29tcGxldGVkX2F0QhAKDl9wYXJlbnRfam9iX2lkQhEKD19mYWlsdXJlX3JlYXNvbkITChFfc3VnZ2VzdGVkX2FjdGlvbkIPCg1fY2hpbGRfY291bnRzIoEGCg1Db3Vyc2VPdXRsaW5lEgoKAmlkGAEgASgJEhEKCWNvdXJzZV9pZBgCIAEoCRIPCgd2ZXJzaW9uGAMgASgFEioKCHNlY3Rpb25zGAQgAygLMhgubWlyYWkudjEuT3V0bGluZVNlY3Rpb24SOAoPYXBwcm92YWxfc3RhdHVzGAUgASgOMh8ubWlyYWkudjEuT3V0bGluZUFwcHJvdmFsU3RhdHVzEh0KEHJlamVjdGlvbl9yZWFzb24YBiABKAlIAIgBARIwCgxnZW5lcmF0ZWRfYXQYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjQKC2FwcHJvdmVkX2F0GAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgBiAEBEiAKE2FwcHJvdmVkX2J5X3VzZXJfaWQYCSABKAlIAogBARI2Cgtjb25zdHJhaW50cxgKIAEoCzIcLm1pcmFpLnYxLk91dGxpbmVDb25zdHJhaW50c0gDiAEBEjsKDmxlc3Nvbl9jaGFuZ2VzGAsgASgLMh4ubWlyYWkudjEuT3V0bGluZUxlc3NvbkNoYW5nZXNIBIgBARIgChh1bnJlc29sdmVkX2NvbW1lbnRfY291bnQYDCABKAUSIQoUZ2VuZXJhdGVkX2J5X3VzZXJfaWQYDSABKAlIBYgBARI0CgtlbmRvcnNlZF9hdBgOIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIBogBARIgChNlbmRvcnNlZF9ieV91c2VyX2lkGA8gASgJSAeIAQFCEwoRX3JlamVjdGlvbl9yZWFzb25CDgoMX2FwcHJvdmVkX2F0QhYKFF9hcHByb3ZlZF9ieV91c2VyX2lkQg4KDF9jb25zdHJhaW50c0IRCg9fbGVzc29uX2NoYW5nZXNCFwoVX2dlbmVyYXRlZF9ieV91c2VyX2lkQg4KDF9lbmRvcnNlZF9hdEIWChRfZW5kb3JzZWRfYnlfdXNlcl9pZCK+AQoUT3V0bGluZUxlc3NvbkNoYW5nZXMSGwoTcHJldmlvdXNfb3V0bGluZV9pZBgBIAEoCRIrCgRrZXB0GAIgAygLMh0ubWlyYWkudjEuT3V0bGluZUxlc3NvbkNoYW5nZRIsCgVhZGRlZBgDIAMoCzIdLm1pcmFpLnYxLk91dGxpbmVMZXNzb25DaGFuZ2USLgoHcmVtb3ZlZBgEIAMoCzIdLm1pcmFpLnYxLk91dGxpbmVMZXNzb25DaGFuZ2UiaAoTT3V0bGluZUxlc3NvbkNoYW5nZRISCgpsZXNzb25fa2V5GAEgASgJEg0KBXRpdGxlGAIgASgJEhsKDnByZXZpb3VzX3RpdGxlGAMgASgJSACIAQFCEQoPX3ByZXZpb3VzX3RpdGxlInkKDk91dGxpbmVTZWN0aW9uEgoKAmlkGAEgASgJEg0KBXRpdGxlGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEg0KBW9yZGVyGAQgASgFEigKB2xlc3NvbnMYBSADKAsyFy5taXJhaS52MS5PdXRsaW5lTGVzc29uIvQBCg1PdXRsaW5lTGVzc29uEgoKAmlkGAEgASgJEg0KBXRpdGxlGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEg0KBW9yZGVyGAQgASgFEiIKGmVzdGltYXRlZF9kdXJhdGlvbl9taW51dGVzGAUgASgFEhsKE2xlYXJuaW5nX29iamVjdGl2ZXMYBiADKAkSGgoSaXNfbGFzdF9pbl9zZWN0aW9uGAcgASgIEhkKEWlzX2xhc3RfaW5fY291cnNlGAggASgIEhgKEHRhcmdldF9hdWRpZW5jZXMYCSADKAkSEgoKbGVzc29uX2tleRgKIAEoCSLvAgoPR2VuZXJhdGVkTGVzc29uEgoKAmlkGAEgASgJEhEKCWNvdXJzZV9pZBgCIAEoCRISCgpzZWN0aW9uX2lkGAMgASgJEhkKEW91dGxpbmVfbGVzc29uX2lkGAQgASgJEg0KBXRpdGxlGAUgASgJEi0KCmNvbXBvbmVudHMYBiADKAsyGS5taXJhaS52MS5MZXNzb25Db21wb25lbnQSFwoKc2VndWVfdGV4dBgHIAEoCUgAiAEBEjAKDGdlbmVyYXRlZF9hdBgIIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASNAoLb3JwaGFuZWRfYXQYCSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSAGIAQESHAoPY29tcG9uZW50X2NvdW50GAogASgFSAKIAQFCDQoLX3NlZ3VlX3RleHRCDgoMX29ycGhhbmVkX2F0QhIKEF9jb21wb25lbnRfY291bnQiswEKD0xlc3NvbkNvbXBvbmVudBIKCgJpZBgBIAEoCRIrCgR0eXBlGAIgASgOMh0ubWlyYWkudjEuTGVzc29uQ29tcG9uZW50VHlwZRINCgVvcmRlchgDIAEoBRIUCgxjb250ZW50X2pzb24YBCABKAkSNAoJYWxpZ25tZW50GAUgASgLMhwubWlyYWkudjEuQ29tcG9uZW50QWxpZ25tZW50SACIAQFCDAoKX2FsaWdubWVudCJLChJDb21wb25lbnRBbGlnbm1lbnQSFQoNc21lX2NodW5rX2lkcxgBIAMoCRIeChZsZWFybmluZ19vYmplY3RpdmVfaWRzGAIgAygJIi4KC1RleHRDb250ZW50EgwKBGh0bWwYASABKAkSEQoJcGxhaW50ZXh0GAIgASgJIkUKDkhlYWRpbmdDb250ZW50EiUKBWxldmVsGAEgASgOMhYubWlyYWkudjEuSGVhZGluZ0xldmVsEgwKBHRleHQYAiABKAkiTwoMSW1hZ2VDb250ZW50EgsKA3VybBgBIAEoCRIQCghhbHRfdGV4dBgCIAEoCRIUCgdjYXB0aW9uGAMgASgJSACIAQFCCgoIX2NhcHRpb24i+QEKC1F1aXpDb250ZW50EhAKCHF1ZXN0aW9uGAEgASgJEhUKDXF1ZXN0aW9uX3R5cGUYAiABKAkSJQoHb3B0aW9ucxgDIAMoCzIULm1pcmFpLnYxLlF1aXpPcHRpb24SGQoRY29ycmVjdF9hbnN3ZXJfaWQYBCABKAkSEwoLZXhwbGFuYXRpb24YBSABKAkSHQoQY29ycmVjdF9mZWVkYmFjaxgGIAEoCUgAiAEBEh8KEmluY29ycmVjdF9mZWVkYmFjaxgHIAEoCUgBiAEBQhMKEV9jb3JyZWN0X2ZlZWRiYWNrQhUKE19pbmNvcnJlY3RfZmVlZGJhY2siJgoKUXVpek9wdGlvbhIKCgJpZBgBIAEoCRIMCgR0ZXh0GAIgASgJIrwCChVDb3Vyc2VHZW5lcmF0aW9uSW5wdXQSEQoJY291cnNlX2lkGAEgASgJEg8KB3NtZV9pZHMYAiADKAkSGwoTdGFyZ2V0X2F1ZGllbmNlX2lkcxgDIAMoCRIXCg9kZXNpcmVkX291dGNvbWUYBCABKAkSHwoSYWRkaXRpb25hbF9jb250ZXh0GAUgASgJSACIAQESNgoLY29uc3RyYWludHMYBiABKAsyHC5taXJhaS52MS5PdXRsaW5lQ29uc3RyYWludHNIAYgBARI5CgtwcmVmZXJlbmNlcxgHIAEoCzIfLm1pcmFpLnYxLkdlbmVyYXRpb25QcmVmZXJlbmNlc0gCiAEBQhUKE19hZGRpdGlvbmFsX2NvbnRleHRCDgoMX2NvbnN0cmFpbnRzQg4KDF9wcmVmZXJlbmNlcyLzAQoVR2VuZXJhdGlvblByZWZlcmVuY2VzEhYKDmVuYWJsZV9xdWl6emVzGAEgASgIEi8KDnF1aXpfZnJlcXVlbmN5GAIgASgOMhcubWlyYWkudjEuUXVpekZyZXF1ZW5jeRIWCg5pbmNsdWRlX2ltYWdlcxgDIAEoCBIiChppbmNsdWRlX3JlZmxlY3Rpb25fcHJvbXB0cxgEIAEoCBIXCg9nZW5lcmF0ZV9pbWFnZXMYBSABKAgSHQoVbWluX2xlc3Nvbl9jb21wb25lbnRzGAYgASgFEh0KFW1heF9sZXNzb25fY29tcG9uZW50cxgHIAEoBSLEAQoST3V0bGluZUNvbnN0cmFpbnRzEhkKDG1heF9zZWN0aW9ucxgBIAEoBUgAiAEBEiQKF21heF9sZXNzb25zX3Blcl9zZWN0aW9uGAIgASgFSAGIAQESJAoXdGFyZ2V0X2R1cmF0aW9uX21pbnV0ZXMYAyABKAVIAogBAUIPCg1fbWF4X3NlY3Rpb25zQhoKGF9tYXhfbGVzc29uc19wZXJfc2VjdGlvbkIaChhfdGFyZ2V0X2R1cmF0aW9uX21pbnV0ZXMiZAocR2VuZXJhdGVDb3Vyc2VPdXRsaW5lUmVxdWVzdBIuCgVpbnB1dBgBIAEoCzIfLm1pcmFpLnYxLkNvdXJzZUdlbmVyYXRpb25JbnB1dBIUCgxhdXRvX2FwcHJvdmUYAiABKAgihgEKHUdlbmVyYXRlQ291cnNlT3V0bGluZVJlc3BvbnNlEiQKA2pvYhgBIAEoCzIXLm1pcmFpLnYxLkdlbmVyYXRpb25Kb2ISMgoIY292ZXJhZ2UYAiABKAsyGy5taXJhaS52MS5Lbm93bGVkZ2VDb3ZlcmFnZUgAiAEBQgsKCV9jb3ZlcmFnZSJ3Ch9BbmFseXplS25vd2xlZGdlQ292ZXJhZ2VSZXF1ZXN0Eg8KB3NtZV9pZHMYASADKAkSFwoPZGVzaXJlZF9vdXRjb21lGAIgASgJEhkKDGNvdXJzZV90aXRsZRgDIAEoCUgAiAEBQg8KDV9jb3Vyc2VfdGl0bGUiUQogQW5hbHl6ZUtub3dsZWRnZUNvdmVyYWdlUmVzcG9uc2USLQoIY292ZXJhZ2UYASABKAsyGy5taXJhaS52MS5Lbm93bGVkZ2VDb3ZlcmFnZSKYAQoRS25vd2xlZGdlQ292ZXJhZ2USDQoFc2NvcmUYASABKAESEgoKc3VmZmljaWVudBgCIAEoCBITCgtjaHVua19jb3VudBgDIAEoBRIlCgV0ZXJtcxgEIAMoCzIWLm1pcmFpLnYxLlRlcm1Db3ZlcmFnZRITCgt0aGluX3RvcGljcxgFIAMoCRIPCgdtZXNzYWdlGAYgASgJIjEKDFRlcm1Db3ZlcmFnZRIMCgR0ZXJtGAEgASgJEhMKC2NodW5rX2NvdW50GAIgASgFIk4KF0dldENvdXJzZU91dGxpbmVSZXF1ZXN0EhEKCWNvdXJzZV9pZBgBIAEoCRIUCgd2ZXJzaW9uGAIgASgFSACIAQFCCgoIX3ZlcnNpb24imwEKGEdldENvdXJzZU91dGxpbmVSZXNwb25zZRIoCgdvdXRsaW5lGAEgASgLMhcubWlyYWkudjEuQ291cnNlT3V0bGluZRI7ChVhY3RpdmVfZ2VuZXJhdGlvbl9qb2IYAiABKAsyFy5taXJhaS52MS5HZW5lcmF0aW9uSm9iSACIAQFCGAoWX2FjdGl2ZV9nZW5lcmF0aW9uX2pvYiJEChtBcHByb3ZlQ291cnNlT3V0bGluZVJlcXVlc3QSEQoJY291cnNlX2lkGAEgASgJEhIKCm91dGxpbmVfaWQYAiABKAkiSAocQXBwcm92ZUNvdXJzZU91dGxpbmVSZXNwb25zZRIoCgdvdXRsaW5lGAEgASgLMhcubWlyYWkudjEuQ291cnNlT3V0bGluZSJTChpSZWplY3RDb3Vyc2VPdXRsaW5lUmVxdWVzdBIRCgljb3Vyc2VfaWQYASABKAkSEgoKb3V0bGluZV9pZBgCIAEoCRIOCgZyZWFzb24YAyABKAkiRwobUmVqZWN0Q291cnNlT3V0bGluZVJlc3BvbnNlEigKB291dGxpbmUYASABKAsyFy5taXJhaS52MS5Db3Vyc2VPdXRsaW5lIosBChpVcGRhdGVDb3Vyc2VPdXRsaW5lUmVxdWVzdBIRCgljb3Vyc2VfaWQYASABKAkSEgoKb3V0bGluZV9pZBgCIAEoCRIqCghzZWN0aW9ucxgDIAMoCzIYLm1pcmFpLnYxLk91dGxpbmVTZWN0aW9uEhoKEnJlbW92ZWRfbGVzc29uX2lkcxgEIAMoCSJHChtVcGRhdGVDb3Vyc2VPdXRsaW5lUmVzcG9uc2USKAoHb3V0bGluZRgBIAEoCzIXLm1pcmFpLnYxLkNvdXJzZU91dGxpbmUiegoURXhwb3J0T3V0bGluZVJlcXVlc3QSEQoJY291cnNlX2lkGAEgASgJEi0KBmZvcm1hdBgCIAEoDjIdLm1pcmFpLnYxLk91dGxpbmVFeHBvcnRGb3JtYXQSFAoHdmVyc2lvbhgDIAEoBUgAiAEBQgoKCF92ZXJzaW9uIm8KFUV4cG9ydE91dGxpbmVSZXNwb25zZRIUCgxkb3dubG9hZF91cmwYASABKAkSEAoIZmlsZW5hbWUYAiABKAkSLgoKZXhwaXJlc19hdBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiTAocR2VuZXJhdGVMZXNzb25Db250ZW50UmVxdWVzdBIRCgljb3Vyc2VfaWQYASABKAkSGQoRb3V0bGluZV9sZXNzb25faWQYAiABKAkiRQodR2VuZXJhdGVMZXNzb25Db250ZW50UmVzcG9uc2USJAoDam9iGAEgASgLMhcubWlyYWkudjEuR2VuZXJhdGlvbkpvYiJ5ChlHZW5lcmF0ZUFsbExlc3NvbnNSZXF1ZXN0EhEKCWNvdXJzZV9pZBgBIAEoCRI5CgtwcmVmZXJlbmNlcxgCIAEoCzIfLm1pcmFpLnYxLkdlbmVyYXRpb25QcmVmZXJlbmNlc0gAiAEBQg4KDF9wcmVmZXJlbmNlcyKNAQoaR2VuZXJhdGVBbGxMZXNzb25zUmVzcG9uc2USJAoDam9iGAEgASgLMhcubWlyYWkudjEuR2VuZXJhdGlvbkpvYhIXCg9hbHJlYWR5X3J1bm5pbmcYAiABKAgSHAoPc3RhcnRlZF9ieV9uYW1lGAMgASgJSACIAQFCEgoQX3N0YXJ0ZWRfYnlfbmFtZSJHChdFeHBvcnRBbGxMZXNzb25zUmVxdWVzdBIRCgljb3Vyc2VfaWQYASABKAkSGQoRaW5jbHVkZV9jaXRhdGlvbnMYAiABKAgiQAoYRXhwb3J0QWxsTGVzc29uc1Jlc3BvbnNlEiQKA2pvYhgBIAEoCzIXLm1pcmFpLnYxLkdlbmVyYXRpb25Kb2IiYQoZUmV0cnlGYWlsZWRMZXNzb25zUmVxdWVzdBITCgZqb2JfaWQYASABKAlIAIgBARIWCgljb3Vyc2VfaWQYAiABKAlIAYgBAUIJCgdfam9iX2lkQgwKCl9jb3Vyc2VfaWQiWQoaUmV0cnlGYWlsZWRMZXNzb25zUmVzcG9uc2USJAoDam9iGAEgASgLMhcubWlyYWkudjEuR2VuZXJhdGlvbkpvYhIVCg1yZXRyaWVkX2NvdW50GAIgASgFInUKGlJlZ2VuZXJhdGVDb21wb25lbnRSZXF1ZXN0EhEKCWNvdXJzZV9pZBgBIAEoCRIRCglsZXNzb25faWQYAiABKAkSFAoMY29tcG9uZW50X2lkGAMgASgJEhsKE21vZGlmaWNhdGlvbl9wcm9tcHQYBCABKAkiQwobUmVnZW5lcmF0ZUNvbXBvbmVudFJlc3BvbnNlEiQKA2pvYhgBIAEoCzIXLm1pcmFpLnYxLkdlbmVyYXRpb25Kb2IiRQoYRWRpdENvbXBvbmVudFRleHRSZXF1ZXN0EhQKDGNvbXBvbmVudF9pZBgBIAEoCRITCgtpbnN0cnVjdGlvbhgCIAEoCSKrAQoZRWRpdENvbXBvbmVudFRleHRSZXNwb25zZRIUCgxjb21wb25lbnRfaWQYASABKAkSKwoEdHlwZRgCIAEoDjIdLm1pcmFpLnYxLkxlc3NvbkNvbXBvbmVudFR5cGUSFAoMY29udGVudF9qc29uGAMgASgJEhMKC3Rva2Vuc191c2VkGAQgASgDEhEKCWNhY2hlX2hpdBgFIAEoCBINCgVvcmRlchgGIAEoBSIyChpHZXRDb21wb25lbnRTb3VyY2VzUmVxdWVzdBIUCgxjb21wb25lbnRfaWQYASABKAkiZQoPQ29tcG9uZW50U291cmNlEhAKCGNodW5rX2lkGAEgASgJEg4KBnNtZV9pZBgCIAEoCRIQCghzbWVfbmFtZRgDIAEoCRINCgV0b3BpYxgEIAEoCRIPCgdleGNlcnB0GAUgASgJIkkKG0dldENvbXBvbmVudFNvdXJjZXNSZXNwb25zZRIqCgdzb3VyY2VzGAEgAygLMhkubWlyYWkudjEuQ29tcG9uZW50U291cmNlImIKIUdldENvbXBvbmVudEFzc2V0VXBsb2FkVVJMUmVxdWVzdBIUCgxjb21wb25lbnRfaWQYASABKAkSEQoJZmlsZV9uYW1lGAIgASgJEhQKDGNvbnRlbnRfdHlwZRgDIAEoCSJLCiJHZXRDb21wb25lbnRBc3NldFVwbG9hZFVSTFJlc3BvbnNlEhIKCnVwbG9hZF91cmwYASABKAkSEQoJZmlsZV9wYXRoGAIgASgJIkcKHENvbmZpcm1Db21wb25lbnRBc3NldFJlcXVlc3QSFAoMY29tcG9uZW50X2lkGAEgASgJEhEKCWZpbGVfcGF0aBgCIAEoCSJNCh1Db25maXJtQ29tcG9uZW50QXNzZXRSZXNwb25zZRIsCgljb21wb25lbnQYASABKAsyGS5taXJhaS52MS5MZXNzb25Db21wb25lbnQiYwoaU3VnZ2VzdENvdXJzZVRpdGxlc1JlcXVlc3QSDwoHc21lX2lkcxgBIAMoCRIbChN0YXJnZXRfYXVkaWVuY2VfaWRzGAIgAygJEhcKD2Rlc2lyZWRfb3V0Y29tZRgDIAEoCSI5ChVDb3Vyc2VUaXRsZVN1Z2dlc3Rpb24SDQoFdGl0bGUYASABKAkSEQoJcmF0aW9uYWxlGAIgASgJImgKG1N1Z2dlc3RDb3Vyc2VUaXRsZXNSZXNwb25zZRI0CgtzdWdnZXN0aW9ucxgBIAMoCzIfLm1pcmFpLnYxLkNvdXJzZVRpdGxlU3VnZ2VzdGlvbhITCgt0b2tlbnNfdXNlZBgCIAEoAyIfCg1HZXRKb2JSZXF1ZXN0Eg4KBmpvYl9pZBgBIAEoCSI2Cg5HZXRKb2JSZXNwb25zZRIkCgNqb2IYASABKAsyFy5taXJhaS52MS5HZW5lcmF0aW9uSm9iIsACCg9MaXN0Sm9ic1JlcXVlc3QSLgoEdHlwZRgBIAEoDjIbLm1pcmFpLnYxLkdlbmVyYXRpb25Kb2JUeXBlSACIAQESMgoGc3RhdHVzGAIgASgOMh0ubWlyYWkudjEuR2VuZXJhdGlvbkpvYlN0YXR1c0gBiAEBEhYKCWNvdXJzZV9pZBgDIAEoCUgCiAEBEh0KEGV4Y2x1ZGVfY2hpbGRyZW4YBCABKAhIA4gBARIaCg1wYXJlbnRfam9iX2lkGAUgASgJSASIAQESDQoFbGltaXQYBiABKAUSEwoGY3Vyc29yGAcgASgJSAWIAQFCBwoFX3R5cGVCCQoHX3N0YXR1c0IMCgpfY291cnNlX2lkQhMKEV9leGNsdWRlX2NoaWxkcmVuQhAKDl9wYXJlbnRfam9iX2lkQgkKB19jdXJzb3IiYwoQTGlzdEpvYnNSZXNwb25zZRIlCgRqb2JzGAEgAygLMhcubWlyYWkudjEuR2VuZXJhdGlvbkpvYhIYCgtuZXh0X2N1cnNvchgCIAEoCUgAiAEBQg4KDF9uZXh0X2N1cnNvciIiChBDYW5jZWxKb2JSZXF1ZXN0Eg4KBmpvYl9pZBgBIAEoCSI5ChFDYW5jZWxKb2JSZXNwb25zZRIkCgNqb2IYASABKAsyFy5taXJhaS52MS5HZW5lcmF0aW9uSm9iIi4KGUdldEdlbmVyYXRlZExlc3NvblJlcXVlc3QSEQoJbGVzc29uX2lkGAEgASgJIkcKGkdldEdlbmVyYXRlZExlc3NvblJlc3BvbnNlEikKBmxlc3NvbhgBIAEoCzIZLm1pcmFpLnYxLkdlbmVyYXRlZExlc3NvbiJKChtMaXN0R2VuZXJhdGVkTGVzc29uc1JlcXVlc3QSEQoJY291cnNlX2lkGAEgASgJEhgKEGluY2x1ZGVfb3JwaGFuZWQYAiABKAgiSgocTGlzdEdlbmVyYXRlZExlc3NvbnNSZXNwb25zZRIqCgdsZXNzb25zGAEgAygLMhkubWlyYWkudjEuR2VuZXJhdGVkTGVzc29uItkBCgxDb250ZW50U3RhdHMSFAoMbGVzc29uX2NvdW50GAEgASgFEhIKCndvcmRfY291bnQYAiABKAUSIAoYYXZlcmFnZV93b3Jkc19wZXJfbGVzc29uGAMgASgBEiEKGWVzdGltYXRlZF9yZWFkaW5nX21pbnV0ZXMYBCABKAUSEgoKcXVpel9jb3VudBgFIAEoBRITCgtpbWFnZV9jb3VudBgGIAEoBRIcChRtYWxmb3JtZWRfY29tcG9uZW50cxgHIAEoBRITCgt2aWRlb19jb3VudBgIIAEoBSJYCgxTZWN0aW9uU3RhdHMSEgoKc2VjdGlvbl9pZBgBIAEoCRINCgV0aXRsZRgCIAEoCRIlCgVzdGF0cxgDIAEoCzIWLm1pcmFpLnYxLkNvbnRlbnRTdGF0cyIqChVHZXRDb3Vyc2VTdGF0c1JlcXVlc3QSEQoJY291cnNlX2lkGAEgASgJImoKFkdldENvdXJzZVN0YXRzUmVzcG9uc2USJgoGdG90YWxzGAEgASgLMhYubWlyYWkudjEuQ29udGVudFN0YXRzEigKCHNlY3Rpb25zGAIgAygLMhYubWlyYWkudjEuU2VjdGlvblN0YXRzIj4KGkdldENvdXJzZVBsYXllclZpZXdSZXF1ZXN0EhEKCWNvdXJzZV9pZBgBIAEoCRINCgVkcmFmdBgCIAEoCCJHChtHZXRDb3Vyc2VQbGF5ZXJWaWV3UmVzcG9uc2USKAoEdmlldxgBIAEoCzIaLm1pcmFpLnYxLkNvdXJzZVBsYXllclZpZXcirwEKEENvdXJzZVBsYXllclZpZXcSEQoJY291cnNlX2lkGAEgASgJEg0KBXRpdGxlGAIgASgJEhcKD291dGxpbmVfdmVyc2lvbhgDIAEoBRIUCgxsZXNzb25fY291bnQYBCABKAUSLwoIc2VjdGlvbnMYBSADKAsyHS5taXJhaS52MS5Db3Vyc2VQbGF5ZXJTZWN0aW9uEhkKEXB1Ymxpc2hlZF92ZXJzaW9uGAYgASgFInQKE0NvdXJzZVBsYXllclNlY3Rpb24SCgoCaWQYASABKAkSDQoFdGl0bGUYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSLQoHbGVzc29ucxgEIAMoCzIcLm1pcmFpLnYxLkNvdXJzZVBsYXllckxlc3NvbiK8AgoSQ291cnNlUGxheWVyTGVzc29uEgoKAmlkGAEgASgJEg0KBXRpdGxlGAIgASgJEicKGmVzdGltYXRlZF9kdXJhdGlvbl9taW51dGVzGAMgASgFSACIAQESMwoKY29tcG9uZW50cxgEIAMoCzIfLm1pcmFpLnYxLkNvdXJzZVBsYXllckNvbXBvbmVudBIXCgpzZWd1ZV90ZXh0GAUgASgJSAGIAQESHwoScHJldmlvdXNfbGVzc29uX2lkGAYgASgJSAKIAQESGwoObmV4dF9sZXNzb25faWQYByABKAlIA4gBAUIdChtfZXN0aW1hdGVkX2R1cmF0aW9uX21pbnV0ZXNCDQoLX3NlZ3VlX3RleHRCFQoTX3ByZXZpb3VzX2xlc3Nvbl9pZEIRCg9fbmV4dF9sZXNzb25faWQidQoVQ291cnNlUGxheWVyQ29tcG9uZW50EgoKAmlkGAEgASgJEisKBHR5cGUYAiABKA4yHS5taXJhaS52MS5MZXNzb25Db21wb25lbnRUeXBlEg0KBW9yZGVyGAMgASgFEhQKDGNvbnRlbnRfanNvbhgEIAEoCSIXChVHZXRRdWV1ZVN0YXR1c1JlcXVlc3QiYgoRSm9iVHlwZVF1ZXVlQ291bnQSKQoEdHlwZRgBIAEoDjIbLm1pcmFpLnYxLkdlbmVyYXRpb25Kb2JUeXBlEg4KBnF1ZXVlZBgCIAEoBRISCgpwcm9jZXNzaW5nGAMgASgFIukBChZHZXRRdWV1ZVN0YXR1c1Jlc3BvbnNlEisKBmNvdW50cxgBIAMoCzIbLm1pcmFpLnYxLkpvYlR5cGVRdWV1ZUNvdW50EhsKDnF1ZXVlX3Bvc2l0aW9uGAIgASgFSACIAQESGgoSd29ya2VyX2NvbmN1cnJlbmN5GAMgASgFEiAKGGF2Z19qb2JfZHVyYXRpb25fc2Vjb25kcxgEIAEoBRIZChFwcm92aWRlcl9kZWdyYWRlZBgFIAEoCBIZChFnZW5lcmF0aW9uX3BhdXNlZBgGIAEoCEIRCg9fcXVldWVfcG9zaXRpb24i3QEKCkpvYkFub21hbHkSCgoCaWQYASABKAkSEQoJdGVuYW50X2lkGAIgASgJEg4KBmpvYl9pZBgDIAEoCRIWCgljb3Vyc2VfaWQYBCABKAlIAIgBARImCgR0eXBlGAUgASgOMhgubWlyYWkudjEuSm9iQW5vbWFseVR5cGUSDwoHZGV0YWlscxgGIAEoCRIQCghyZXNvbHZlZBgHIAEoCBIvCgtkZXRlY3RlZF9hdBgIIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCDAoKX2NvdXJzZV9pZCKBAQoUTGlzdEFub21hbGllc1JlcXVlc3QSFgoJdGVuYW50X2lkGAEgASgJSACIAQESKwoEdHlwZRgCIAEoDjIYLm1pcmFpLnYxLkpvYkFub21hbHlUeXBlSAGIAQESDQoFbGltaXQYAyABKAVCDAoKX3RlbmFudF9pZEIHCgVfdHlwZSJAChVMaXN0QW5vbWFsaWVzUmVzcG9uc2USJwoJYW5vbWFsaWVzGAEgAygLMhQubWlyYWkudjEuSm9iQW5vbWFseSJxCg9HZW5lcmF0aW9uRHJhZnQSLgoFaW5wdXQYASABKAsyHy5taXJhaS52MS5Db3Vyc2VHZW5lcmF0aW9uSW5wdXQSLgoKdXBkYXRlZF9hdBgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiTAoaU2F2ZUdlbmVyYXRpb25EcmFmdFJlcXVlc3QSLgoFaW5wdXQYASABKAsyHy5taXJhaS52MS5Db3Vyc2VHZW5lcmF0aW9uSW5wdXQiRwobU2F2ZUdlbmVyYXRpb25EcmFmdFJlc3BvbnNlEigKBWRyYWZ0GAEgASgLMhkubWlyYWkudjEuR2VuZXJhdGlvbkRyYWZ0Ii4KGUdldEdlbmVyYXRpb25EcmFmdFJlcXVlc3QSEQoJY291cnNlX2lkGAEgASgJIlUKGkdldEdlbmVyYXRpb25EcmFmdFJlc3BvbnNlEi0KBWRyYWZ0GAEgASgLMhkubWlyYWkudjEuR2VuZXJhdGlvbkRyYWZ0SACIAQFCCAoGX2RyYWZ0IjEKGFN0YXJ0U3RvcmFnZUF1ZGl0UmVxdWVzdBIVCg1wdXJnZV9vcnBoYW5zGAEgASgIIkEKGVN0YXJ0U3RvcmFnZUF1ZGl0UmVzcG9uc2USJAoDam9iGAEgASgLMhcubWlyYWkudjEuR2VuZXJhdGlvbkpvYiIuChxHZXRTdG9yYWdlQXVkaXRSZXBvcnRSZXF1ZXN0Eg4KBmpvYl9pZBgBIAEoCSK1AQodR2V0U3RvcmFnZUF1ZGl0UmVwb3J0UmVzcG9uc2USJAoDam9iGAEgASgLMhcubWlyYWkudjEuR2VuZXJhdGlvbkpvYhIZCgxkb3dubG9hZF91cmwYAiABKAlIAIgBARIzCgpleHBpcmVzX2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgBiAEBQg8KDV9kb3dubG9hZF91cmxCDQoLX2V4cGlyZXNfYXQiRAoWVHJhbnNsYXRlQ291cnNlUmVxdWVzdBIRCgljb3Vyc2VfaWQYASABKAkSFwoPdGFyZ2V0X2xhbmd1YWdlGAIgASgJIlIKF1RyYW5zbGF0ZUNvdXJzZVJlc3BvbnNlEiQKA2pvYhgBIAEoCzIXLm1pcmFpLnYxLkdlbmVyYXRpb25Kb2ISEQoJY291cnNlX2lkGAIgASgJItgCCg5PdXRsaW5lQ29tbWVudBIKCgJpZBgBIAEoCRIRCgljb3Vyc2VfaWQYAiABKAkSEgoKbGVzc29uX2tleRgDIAEoCRIbCg5hdXRob3JfdXNlcl9pZBgEIAEoCUgAiAEBEhMKC2F1dGhvcl9uYW1lGAUgASgJEgwKBGJvZHkYBiABKAkSEAoIcmVzb2x2ZWQYByABKAgSIAoTcmVzb2x2ZWRfYnlfdXNlcl9pZBgIIAEoCUgBiAEBEjQKC3Jlc29sdmVkX2F0GAkgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgCiAEBEi4KCmNyZWF0ZWRfYXQYCiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQhEKD19hdXRob3JfdXNlcl9pZEIWChRfcmVzb2x2ZWRfYnlfdXNlcl9pZEIOCgxfcmVzb2x2ZWRfYXQiUQobQ3JlYXRlT3V0bGluZUNvbW1lbnRSZXF1ZXN0EhEKCWNvdXJzZV9pZBgBIAEoCRIRCglsZXNzb25faWQYAiABKAkSDAoEYm9keRgDIAEoCSJJChxDcmVhdGVPdXRsaW5lQ29tbWVudFJlc3BvbnNlEikKB2NvbW1lbnQYASABKAsyGC5taXJhaS52MS5PdXRsaW5lQ29tbWVudCJJChpMaXN0T3V0bGluZUNvbW1lbnRzUmVxdWVzdBIRCgljb3Vyc2VfaWQYASABKAkSGAoQaW5jbHVkZV9yZXNvbHZlZBgCIAEoCCJJChtMaXN0T3V0bGluZUNvbW1lbnRzUmVzcG9uc2USKgoIY29tbWVudHMYASADKAsyGC5taXJhaS52MS5PdXRsaW5lQ29tbWVudCIyChxSZXNvbHZlT3V0bGluZUNvbW1lbnRSZXF1ZXN0EhIKCmNvbW1lbnRfaWQYASABKAkiSgodUmVzb2x2ZU91dGxpbmVDb21tZW50UmVzcG9uc2USKQoHY29tbWVudBgBIAEoCzIYLm1pcmFpLnYxLk91dGxpbmVDb21tZW50Ik8KGVNldFRlbmFudEFJRW5hYmxlZFJlcXVlc3QSEQoJdGVuYW50X2lkGAEgASgJEg8KB2VuYWJsZWQYAiABKAgSDgoGcmVhc29uGAMgASgJIkIKGlNldFRlbmFudEFJRW5hYmxlZFJlc3BvbnNlEg8KB2VuYWJsZWQYASABKAgSEwoLcXVldWVkX2pvYnMYAiABKAUiyQEKEkFjY2Vzc2liaWxpdHlJc3N1ZRIuCgR0eXBlGAEgASgOMiAubWlyYWkudjEuQWNjZXNzaWJpbGl0eUlzc3VlVHlwZRIRCglsZXNzb25faWQYAiABKAkSFAoMbGVzc29uX3RpdGxlGAMgASgJEhQKDGNvbXBvbmVudF9pZBgEIAEoCRIQCghwb3NpdGlvbhgFIAEoBRIOCgZkZXRhaWwYBiABKAkSIgoaYWx0X3RleHRfZ2VuZXJhdGlvbl9mYWlsZWQYByABKAgiMgodR2V0QWNjZXNzaWJpbGl0eVJlcG9ydFJlcXVlc3QSEQoJY291cnNlX2lkGAEgASgJIoMBCh5HZXRBY2Nlc3NpYmlsaXR5UmVwb3J0UmVzcG9uc2USLAoGaXNzdWVzGAEgAygLMhwubWlyYWkudjEuQWNjZXNzaWJpbGl0eUlzc3VlEhcKD2xlc3NvbnNfY2hlY2tlZBgCIAEoBRIaChJjb21wb25lbnRzX2NoZWNrZWQYAyABKAUigAEKFE91dGxpbmVCYWxhbmNlQ2hhbmdlEjAKBGtpbmQYASABKA4yIi5taXJhaS52MS5PdXRsaW5lQmFsYW5jZUNoYW5nZUtpbmQSEgoKc2VjdGlvbl9pZBgCIAEoCRISCgpsZXNzb25faWRzGAMgAygJEg4KBnRpdGxlcxgEIAMoCSJ/Ch5CYWxhbmNlT3V0bGluZUR1cmF0aW9uc1JlcXVlc3QSEQoJY291cnNlX2lkGAEgASgJEhIKCm91dGxpbmVfaWQYAiABKAkSGgoSbWluX2xlc3Nvbl9taW51dGVzGAMgASgFEhoKEm1heF9sZXNzb25fbWludXRlcxgEIAEoBSLnAQofQmFsYW5jZU91dGxpbmVEdXJhdGlvbnNSZXNwb25zZRIqCghzZWN0aW9ucxgBIAMoCzIYLm1pcmFpLnYxLk91dGxpbmVTZWN0aW9uEhoKEnJlbW92ZWRfbGVzc29uX2lkcxgCIAMoCRIvCgdjaGFuZ2VzGAMgAygLMh4ubWlyYWkudjEuT3V0bGluZUJhbGFuY2VDaGFuZ2USGgoSbWluX2xlc3Nvbl9taW51dGVzGAQgASgFEhoKEm1heF9sZXNzb25fbWludXRlcxgFIAEoBRITCgt0b2tlbnNfdXNlZBgGIAEoAyJWCg5Kb2JDaGlsZENvdW50cxINCgV0b3RhbBgBIAEoBRIRCgljb21wbGV0ZWQYAiABKAUSDgoGZmFpbGVkGAMgASgFEhIKCnByb2Nlc3NpbmcYBCABKAUiGgoYTWlncmF0ZVF1aXpTY2hlbWFSZXF1ZXN0IhsKGU1pZ3JhdGVRdWl6U2NoZW1hUmVzcG9uc2Uq1AMKEUdlbmVyYXRpb25Kb2JUeXBlEiMKH0dFTkVSQVRJT05fSk9CX1RZUEVfVU5TUEVDSUZJRUQQABIlCiFHRU5FUkFUSU9OX0pPQl9UWVBFX1NNRV9JTkdFU1RJT04QARImCiJHRU5FUkFUSU9OX0pPQl9UWVBFX0NPVVJTRV9PVVRMSU5FEAISJgoiR0VORVJBVElPTl9KT0JfVFlQRV9MRVNTT05fQ09OVEVOVBADEicKI0dFTkVSQVRJT05fSk9CX1RZUEVfQ09NUE9ORU5UX1JFR0VOEAQSIwofR0VORVJBVElPTl9KT0JfVFlQRV9GVUxMX0NPVVJTRRAFEiYKIkdFTkVSQVRJT05fSk9CX1RZUEVfTEVTU09OU19FWFBPUlQQBhIsCihHRU5FUkFUSU9OX0pPQl9UWVBFX1NNRV9LTk9XTEVER0VfRVhQT1JUEAcSLAooR0VORVJBVElPTl9KT0JfVFlQRV9TTUVfS05PV0xFREdFX0lNUE9SVBAIEiUKIUdFTkVSQVRJT05fSk9CX1RZUEVfU1RPUkFHRV9BVURJVBAJEioKJkdFTkVSQVRJT05fSk9CX1RZUEVfQ09VUlNFX1RSQU5TTEFUSU9OEAoq8AEKE0dlbmVyYXRpb25Kb2JTdGF0dXMSJQohR0VORVJBVElPTl9KT0JfU1RBVFVTX1VOU1BFQ0lGSUVEEAASIAocR0VORVJBVElPTl9KT0JfU1RBVFVTX1FVRVVFRBABEiQKIEdFTkVSQVRJT05fSk9CX1NUQVRVU19QUk9DRVNTSU5HEAISIwofR0VORVJBVElPTl9KT0JfU1RBVFVTX0NPTVBMRVRFRBADEiAKHEdFTkVSQVRJT05fSk9CX1NUQVRVU19GQUlMRUQQBBIjCh9HRU5FUkFUSU9OX0pPQl9TVEFUVVNfQ0FOQ0VMTEVEEAUq6AEKFU91dGxpbmVBcHByb3ZhbFN0YXR1cxInCiNPVVRMSU5FX0FQUFJPVkFMX1NUQVRVU19VTlNQRUNJRklFRBAAEioKJk9VVExJTkVfQVBQUk9WQUxfU1RBVFVTX1BFTkRJTkdfUkVWSUVXEAESJAogT1VUTElORV9BUFBST1ZBTF9TVEFUVVNfQVBQUk9WRUQQAhIkCiBPVVRMSU5FX0FQUFJPVkFMX1NUQVRVU19SRUpFQ1RFRBADEi4KKk9VVExJTkVfQVBQUk9WQUxfU1RBVFVTX1JFVklTSU9OX1JFUVVFU1RFRBAEKuEBChNMZXNzb25Db21wb25lbnRUeXBlEiUKIUxFU1NPTl9DT01QT05FTlRfVFlQRV9VTlNQRUNJRklFRBAAEh4KGkxFU1NPTl9DT01QT05FTlRfVFlQRV9URVhUEAESIQodTEVTU09OX0NPTVBPTkVOVF9UWVBFX0hFQURJTkcQAhIfChtMRVNTT05fQ09NUE9ORU5UX1RZUEVfSU1BR0UQAxIeChpMRVNTT05fQ09NUE9ORU5UX1RZUEVfUVVJWhAEEh8KG0xFU1NPTl9DT01QT05FTlRfVFlQRV9WSURFTxAFKnsKE091dGxpbmVFeHBvcnRGb3JtYXQSJQohT1VUTElORV9FWFBPUlRfRk9STUFUX1VOU1BFQ0lGSUVEEAASHQoZT1VUTElORV9FWFBPUlRfRk9STUFUX0NTVhABEh4KGk9VVExJTkVfRVhQT1JUX0ZPUk1BVF9ET0NYEAIquwEKDkpvYkFub21hbHlUeXBlEiAKHEpPQl9BTk9NQUxZX1RZUEVfVU5TUEVDSUZJRUQQABIpCiVKT0JfQU5PTUFMWV9UWVBFX1BBUkVOVF9OT1RfRklOQUxJWkVEEAESLAooSk9CX0FOT01BTFlfVFlQRV9QQVJFTlRfTUlTU0lOR19DSElMRFJFThACEi4KKkpPQl9BTk9NQUxZX1RZUEVfQ09NUExFVEVEX1dJVEhPVVRfTEVTU09OUxADKoUBCgxIZWFkaW5nTGV2ZWwSHQoZSEVBRElOR19MRVZFTF9VTlNQRUNJRklFRBAAEhQKEEhFQURJTkdfTEVWRUxfSDEQARIUChBIRUFESU5HX0xFVkVMX0gyEAISFAoQSEVBRElOR19MRVZFTF9IMxADEhQKEEhFQURJTkdfTEVWRUxfSDQQBCrLAgoQSm9iRmFpbHVyZVJlYXNvbhIiCh5KT0JfRkFJTFVSRV9SRUFTT05fVU5TUEVDSUZJRUQQABIkCiBKT0JfRkFJTFVSRV9SRUFTT05fUFJPVklERVJfQVVUSBABEioKJkpPQl9GQUlMVVJFX1JFQVNPTl9QUk9WSURFUl9SQVRFX0xJTUlUEAISJwojSk9CX0ZBSUxVUkVfUkVBU09OX1BST1ZJREVSX1RJTUVPVVQQAxIlCiFKT0JfRkFJTFVSRV9SRUFTT05fSU5WQUxJRF9PVVRQVVQQBBIoCiRKT0JfRkFJTFVSRV9SRUFTT05fTUlTU0lOR19LTk9XTEVER0UQBRImCiJKT0JfRkFJTFVSRV9SRUFTT05fQlVER0VUX0VYQ0VFREVEEAYSHwobSk9CX0ZBSUxVUkVfUkVBU09OX0lOVEVSTkFMEAcqlQEKDVF1aXpGcmVxdWVuY3kSHgoaUVVJWl9GUkVRVUVOQ1lfVU5TUEVDSUZJRUQQABIfChtRVUlaX0ZSRVFVRU5DWV9FVkVSWV9MRVNTT04QARIhCh1RVUlaX0ZSRVFVRU5DWV9FTkRfT0ZfU0VDVElPThACEiAKHFFVSVpfRlJFUVVFTkNZX0VORF9PRl9DT1VSU0UQAyraAQoWQWNjZXNzaWJpbGl0eUlzc3VlVHlwZRIoCiRBQ0NFU1NJQklMSVRZX0lTU1VFX1RZUEVfVU5TUEVDSUZJRUQQABItCilBQ0NFU1NJQklMSVRZX0lTU1VFX1RZUEVfTUlTU0lOR19BTFRfVEVYVBABEi8KK0FDQ0VTU0lCSUxJVFlfSVNTVUVfVFlQRV9IRUFESU5HX0xFVkVMX0pVTVAQAhI2CjJBQ0NFU1NJQklMSVRZX0lTU1VFX1RZUEVfUVVJWl9XSVRIT1VUX0lOU1RSVUNUSU9OUxADKpUBChhPdXRsaW5lQmFsYW5jZUNoYW5nZUtpbmQSKwonT1VUTElORV9CQUxBTkNFX0NIQU5HRV9LSU5EX1VOU1BFQ0lGSUVEEAASJQohT1VUTElORV9CQUxBTkNFX0NIQU5HRV9LSU5EX1NQTElUEAESJQohT1VUTElORV9CQUxBTkNFX0NIQU5HRV9LSU5EX01FUkdFEAIy5hwKE0FJR2VuZXJhdGlvblNlcnZpY2USaAoVR2VuZXJhdGVDb3Vyc2VPdXRsaW5lEiYubWlyYWkudjEuR2VuZXJhdGVDb3Vyc2VPdXRsaW5lUmVxdWVzdBonLm1pcmFpLnYxLkdlbmVyYXRlQ291cnNlT3V0bGluZVJlc3BvbnNlEnEKGEFuYWx5emVLbm93bGVkZ2VDb3ZlcmFnZRIpLm1pcmFpLnYxLkFuYWx5emVLbm93bGVkZ2VDb3ZlcmFnZVJlcXVlc3QaKi5taXJhaS52MS5BbmFseXplS25vd2xlZGdlQ292ZXJhZ2VSZXNwb25zZRJiChNTYXZlR2VuZXJhdGlvbkRyYWZ0EiQubWlyYWkudjEuU2F2ZUdlbmVyYXRpb25EcmFmdFJlcXVlc3QaJS5taXJhaS52MS5TYXZlR2VuZXJhdGlvbkRyYWZ0UmVzcG9uc2USXwoSR2V0R2VuZXJhdGlvbkRyYWZ0EiMubWlyYWkudjEuR2V0R2VuZXJhdGlvbkRyYWZ0UmVxdWVzdBokLm1pcmFpLnYxLkdldEdlbmVyYXRpb25EcmFmdFJlc3BvbnNlElkKEEdldENvdXJzZU91dGxpbmUSIS5taXJhaS52MS5HZXRDb3Vyc2VPdXRsaW5lUmVxdWVzdBoiLm1pcmFpLnYxLkdldENvdXJzZU91dGxpbmVSZXNwb25zZRJlChRBcHByb3ZlQ291cnNlT3V0bGluZRIlLm1pcmFpLnYxLkFwcHJvdmVDb3Vyc2VPdXRsaW5lUmVxdWVzdBomLm1pcmFpLnYxLkFwcHJvdmVDb3Vyc2VPdXRsaW5lUmVzcG9uc2USYgoTUmVqZWN0Q291cnNlT3V0bGluZRIkLm1pcmFpLnYxLlJlamVjdENvdXJzZU91dGxpbmVSZXF1ZXN0GiUubWlyYWkudjEuUmVqZWN0Q291cnNlT3V0bGluZVJlc3BvbnNlEmIKE1VwZGF0ZUNvdXJzZU91dGxpbmUSJC5taXJhaS52MS5VcGRhdGVDb3Vyc2VPdXRsaW5lUmVxdWVzdBolLm1pcmFpLnYxLlVwZGF0ZUNvdXJzZU91dGxpbmVSZXNwb25zZRJQCg1FeHBvcnRPdXRsaW5lEh4ubWlyYWkudjEuRXhwb3J0T3V0bGluZVJlcXVlc3QaHy5taXJhaS52MS5FeHBvcnRPdXRsaW5lUmVzcG9uc2USbgoXQmFsYW5jZU91dGxpbmVEdXJhdGlvbnMSKC5taXJhaS52MS5CYWxhbmNlT3V0bGluZUR1cmF0aW9uc1JlcXVlc3QaKS5taXJhaS52MS5CYWxhbmNlT3V0bGluZUR1cmF0aW9uc1Jlc3BvbnNlEmgKFUdlbmVyYXRlTGVzc29uQ29udGVudBImLm1pcmFpLnYxLkdlbmVyYXRlTGVzc29uQ29udGVudFJlcXVlc3QaJy5taXJhaS52MS5HZW5lcmF0ZUxlc3NvbkNvbnRlbnRSZXNwb25zZRJfChJHZW5lcmF0ZUFsbExlc3NvbnMSIy5taXJhaS52MS5HZW5lcmF0ZUFsbExlc3NvbnNSZXF1ZXN0GiQubWlyYWkudjEuR2VuZXJhdGVBbGxMZXNzb25zUmVzcG9uc2USXwoSUmV0cnlGYWlsZWRMZXNzb25zEiMubWlyYWkudjEuUmV0cnlGYWlsZWRMZXNzb25zUmVxdWVzdBokLm1pcmFpLnYxLlJldHJ5RmFpbGVkTGVzc29uc1Jlc3BvbnNlElkKEEV4cG9ydEFsbExlc3NvbnMSIS5taXJhaS52MS5FeHBvcnRBbGxMZXNzb25zUmVxdWVzdBoiLm1pcmFpLnYxLkV4cG9ydEFsbExlc3NvbnNSZXNwb25zZRJiChNSZWdlbmVyYXRlQ29tcG9uZW50EiQubWlyYWkudjEuUmVnZW5lcmF0ZUNvbXBvbmVudFJlcXVlc3QaJS5taXJhaS52MS5SZWdlbmVyYXRlQ29tcG9uZW50UmVzcG9uc2USXAoRRWRpdENvbXBvbmVudFRleHQSIi5taXJhaS52MS5FZGl0Q29tcG9uZW50VGV4dFJlcXVlc3QaIy5taXJhaS52MS5FZGl0Q29tcG9uZW50VGV4dFJlc3BvbnNlEmIKE0dldENvbXBvbmVudFNvdXJjZXMSJC5taXJhaS52MS5HZXRDb21wb25lbnRTb3VyY2VzUmVxdWVzdBolLm1pcmFpLnYxLkdldENvbXBvbmVudFNvdXJjZXNSZXNwb25zZRJ3ChpHZXRDb21wb25lbnRBc3NldFVwbG9hZFVSTBIrLm1pcmFpLnYxLkdldENvbXBvbmVudEFzc2V0VXBsb2FkVVJMUmVxdWVzdBosLm1pcmFpLnYxLkdldENvbXBvbmVudEFzc2V0VXBsb2FkVVJMUmVzcG9uc2USaAoVQ29uZmlybUNvbXBvbmVudEFzc2V0EiYubWlyYWkudjEuQ29uZmlybUNvbXBvbmVudEFzc2V0UmVxdWVzdBonLm1pcmFpLnYxLkNvbmZpcm1Db21wb25lbnRBc3NldFJlc3BvbnNlEmIKE1N1Z2dlc3RDb3Vyc2VUaXRsZXMSJC5taXJhaS52MS5TdWdnZXN0Q291cnNlVGl0bGVzUmVxdWVzdBolLm1pcmFpLnYxLlN1Z2dlc3RDb3Vyc2VUaXRsZXNSZXNwb25zZRI7CgZHZXRKb2ISFy5taXJhaS52MS5HZXRKb2JSZXF1ZXN0GhgubWlyYWkudjEuR2V0Sm9iUmVzcG9uc2USQQoITGlzdEpvYnMSGS5taXJhaS52MS5MaXN0Sm9ic1JlcXVlc3QaGi5taXJhaS52MS5MaXN0Sm9ic1Jlc3BvbnNlEkQKCUNhbmNlbEpvYhIaLm1pcmFpLnYxLkNhbmNlbEpvYlJlcXVlc3QaGy5taXJhaS52MS5DYW5jZWxKb2JSZXNwb25zZRJfChJHZXRHZW5lcmF0ZWRMZXNzb24SIy5taXJhaS52MS5HZXRHZW5lcmF0ZWRMZXNzb25SZXF1ZXN0GiQubWlyYWkudjEuR2V0R2VuZXJhdGVkTGVzc29uUmVzcG9uc2USZQoUTGlzdEdlbmVyYXRlZExlc3NvbnMSJS5taXJhaS52MS5MaXN0R2VuZXJhdGVkTGVzc29uc1JlcXVlc3QaJi5taXJhaS52MS5MaXN0R2VuZXJhdGVkTGVzc29uc1Jlc3BvbnNlElMKDkdldENvdXJzZVN0YXRzEh8ubWlyYWkudjEuR2V0Q291cnNlU3RhdHNSZXF1ZXN0GiAubWlyYWkudjEuR2V0Q291cnNlU3RhdHNSZXNwb25zZRJiChNHZXRDb3Vyc2VQbGF5ZXJWaWV3EiQubWlyYWkudjEuR2V0Q291cnNlUGxheWVyVmlld1JlcXVlc3QaJS5taXJhaS52MS5HZXRDb3Vyc2VQbGF5ZXJWaWV3UmVzcG9uc2USawoWR2V0QWNjZXNzaWJpbGl0eVJlcG9ydBInLm1pcmFpLnYxLkdldEFjY2Vzc2liaWxpdHlSZXBvcnRSZXF1ZXN0GigubWlyYWkudjEuR2V0QWNjZXNzaWJpbGl0eVJlcG9ydFJlc3BvbnNlElMKDkdldFF1ZXVlU3RhdHVzEh8ubWlyYWkudjEuR2V0UXVldWVTdGF0dXNSZXF1ZXN0GiAubWlyYWkudjEuR2V0UXVldWVTdGF0dXNSZXNwb25zZRJQCg1MaXN0QW5vbWFsaWVzEh4ubWlyYWkudjEuTGlzdEFub21hbGllc1JlcXVlc3QaHy5taXJhaS52MS5MaXN0QW5vbWFsaWVzUmVzcG9uc2USXAoRU3RhcnRTdG9yYWdlQXVkaXQSIi5taXJhaS52MS5TdGFydFN0b3JhZ2VBdWRpdFJlcXVlc3QaIy5taXJhaS52MS5TdGFydFN0b3JhZ2VBdWRpdFJlc3BvbnNlEmgKFUdldFN0b3JhZ2VBdWRpdFJlcG9ydBImLm1pcmFpLnYxLkdldFN0b3JhZ2VBdWRpdFJlcG9ydFJlcXVlc3QaJy5taXJhaS52MS5HZXRTdG9yYWdlQXVkaXRSZXBvcnRSZXNwb25zZRJWCg9UcmFuc2xhdGVDb3Vyc2USIC5taXJhaS52MS5UcmFuc2xhdGVDb3Vyc2VSZXF1ZXN0GiEubWlyYWkudjEuVHJhbnNsYXRlQ291cnNlUmVzcG9uc2USZQoUQ3JlYXRlT3V0bGluZUNvbW1lbnQSJS5taXJhaS52MS5DcmVhdGVPdXRsaW5lQ29tbWVudFJlcXVlc3QaJi5taXJhaS52MS5DcmVhdGVPdXRsaW5lQ29tbWVudFJlc3BvbnNlEmIKE0xpc3RPdXRsaW5lQ29tbWVudHMSJC5taXJhaS52MS5MaXN0T3V0bGluZUNvbW1lbnRzUmVxdWVzdBolLm1pcmFpLnYxLkxpc3RPdXRsaW5lQ29tbWVudHNSZXNwb25zZRJoChVSZXNvbHZlT3V0bGluZUNvbW1lbnQSJi5taXJhaS52MS5SZXNvbHZlT3V0bGluZUNvbW1lbnRSZXF1ZXN0GicubWlyYWkudjEuUmVzb2x2ZU91dGxpbmVDb21tZW50UmVzcG9uc2USXwoSU2V0VGVuYW50QUlFbmFibGVkEiMubWlyYWkudjEuU2V0VGVuYW50QUlFbmFibGVkUmVxdWVzdBokLm1pcmFpLnYxLlNldFRlbmFudEFJRW5hYmxlZFJlc3BvbnNlElwKEU1pZ3JhdGVRdWl6U2NoZW1hEiIubWlyYWkudjEuTWlncmF0ZVF1aXpTY2hlbWFSZXF1ZXN0GiMubWlyYWkudjEuTWlncmF0ZVF1aXpTY2hlbWFSZXNwb25zZUKXAQoMY29tLm1pcmFpLnYxQhFBaUdlbmVyYXRpb25Qcm90b1ABWjNnaXRodWIuY29tL3NvZ29zL21pcmFpLWJhY2tlbmQvZ2VuL21pcmFpL3YxO21pcmFpdjGiAgNNWFiqAghNaXJhaS5WMcoCCE1pcmFpXFYx4gIUTWlyYWlcVjFcR1BCTWV0YWRhdGHqAglNaXJhaTo6VjFiBnByb3RvMw", [file_google_protobuf_timestamp]);
 
 /**
  * GenerationJob represents an AI generation job.
@@ -133,6 +133,41 @@ export type GenerationJob = Message<"mirai.v1.GenerationJob"> & {
    * @generated from field: optional string parent_job_id = 20;
    */
   parentJobId?: string;
+
+  /**
+   * Number of AI responses re-requested because they failed schema validation
+   *
+   * @generated from field: int32 repair_attempts = 21;
+   */
+  repairAttempts: number;
+
+  /**
+   * Why a failed job failed; unset for other jobs and for jobs failed before reasons were recorded
+   *
+   * @generated from field: optional mirai.v1.JobFailureReason failure_reason = 22;
+   */
+  failureReason?: JobFailureReason;
+
+  /**
+   * What the user can do about failure_reason
+   *
+   * @generated from field: optional string suggested_action = 23;
+   */
+  suggestedAction?: string;
+
+  /**
+   * Pictures generated for image components; billed separately from tokens_used
+   *
+   * @generated from field: int32 images_generated = 24;
+   */
+  imagesGenerated: number;
+
+  /**
+   * The job's children by status; set only when listing with exclude_children
+   *
+   * @generated from field: optional mirai.v1.JobChildCounts child_counts = 25;
+   */
+  childCounts?: JobChildCounts;
 };
 
 /**
@@ -192,6 +227,45 @@ export type CourseOutline = Message<"mirai.v1.CourseOutline"> & {
    * @generated from field: optional string approved_by_user_id = 9;
    */
   approvedByUserId?: string;
+
+  /**
+   * Constraints requested at generation time
+   *
+   * @generated from field: optional mirai.v1.OutlineConstraints constraints = 10;
+   */
+  constraints?: OutlineConstraints;
+
+  /**
+   * How lessons map to the version this one replaced; unset for a course's first outline
+   *
+   * @generated from field: optional mirai.v1.OutlineLessonChanges lesson_changes = 11;
+   */
+  lessonChanges?: OutlineLessonChanges;
+
+  /**
+   * Unresolved reviewer comments on the outline's lessons
+   *
+   * @generated from field: int32 unresolved_comment_count = 12;
+   */
+  unresolvedCommentCount: number;
+
+  /**
+   * Second review, when the tenant requires it: the generator can't approve, and the first
+   * reviewer's endorsement is cleared if the outline is edited or rejected
+   *
+   * @generated from field: optional string generated_by_user_id = 13;
+   */
+  generatedByUserId?: string;
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp endorsed_at = 14;
+   */
+  endorsedAt?: Timestamp;
+
+  /**
+   * @generated from field: optional string endorsed_by_user_id = 15;
+   */
+  endorsedByUserId?: string;
 };
 
 /**
@@ -201,6 +275,78 @@ export type CourseOutline = Message<"mirai.v1.CourseOutline"> & {
 export const CourseOutlineSchema: GenMessage<CourseOutline> = /*@__PURE__*/
   messageDesc(file_mirai_v1_ai_generation, 1);
 
+/**
+ * OutlineLessonChanges describes how a regenerated outline's lessons map to the
+ * outline version it replaced.
+ *
+ * @generated from message mirai.v1.OutlineLessonChanges
+ */
+export type OutlineLessonChanges = Message<"mirai.v1.OutlineLessonChanges"> & {
+  /**
+   * @generated from field: string previous_outline_id = 1;
+   */
+  previousOutlineId: string;
+
+  /**
+   * Matched to a previous lesson, whose key they carry
+   *
+   * @generated from field: repeated mirai.v1.OutlineLessonChange kept = 2;
+   */
+  kept: OutlineLessonChange[];
+
+  /**
+   * New lessons with no confident match
+   *
+   * @generated from field: repeated mirai.v1.OutlineLessonChange added = 3;
+   */
+  added: OutlineLessonChange[];
+
+  /**
+   * Previous lessons nothing matched
+   *
+   * @generated from field: repeated mirai.v1.OutlineLessonChange removed = 4;
+   */
+  removed: OutlineLessonChange[];
+};
+
+/**
+ * Describes the message mirai.v1.OutlineLessonChanges.
+ * Use `create(OutlineLessonChangesSchema)` to create a new message.
+ */
+export const OutlineLessonChangesSchema: GenMessage<OutlineLessonChanges> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 2);
+
+/**
+ * OutlineLessonChange identifies one lesson in OutlineLessonChanges.
+ *
+ * @generated from message mirai.v1.OutlineLessonChange
+ */
+export type OutlineLessonChange = Message<"mirai.v1.OutlineLessonChange"> & {
+  /**
+   * @generated from field: string lesson_key = 1;
+   */
+  lessonKey: string;
+
+  /**
+   * @generated from field: string title = 2;
+   */
+  title: string;
+
+  /**
+   * Title in the previous version, for kept lessons
+   *
+   * @generated from field: optional string previous_title = 3;
+   */
+  previousTitle?: string;
+};
+
+/**
+ * Describes the message mirai.v1.OutlineLessonChange.
+ * Use `create(OutlineLessonChangeSchema)` to create a new message.
+ */
+export const OutlineLessonChangeSchema: GenMessage<OutlineLessonChange> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 3);
+
 /**
  * OutlineSection represents a section in the outline.
  *
@@ -238,7 +384,7 @@ export type OutlineSection = Message<"mirai.v1.OutlineSection"> & {
  * Use `create(OutlineSectionSchema)` to create a new message.
  */
 export const OutlineSectionSchema: GenMessage<OutlineSection> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_ai_generation, 2);
+  messageDesc(file_mirai_v1_ai_generation, 4);
 
 /**
  * OutlineLesson represents a lesson in the outline.
@@ -289,6 +435,20 @@ export type OutlineLesson = Message<"mirai.v1.OutlineLesson"> & {
    * @generated from field: bool is_last_in_course = 8;
    */
   isLastInCourse: boolean;
+
+  /**
+   * Audience names the lesson is specific to; empty means all (ignored by UpdateCourseOutline)
+   *
+   * @generated from field: repeated string target_audiences = 9;
+   */
+  targetAudiences: string[];
+
+  /**
+   * Stable across outline versions: the ID the lesson was first generated with
+   *
+   * @generated from field: string lesson_key = 10;
+   */
+  lessonKey: string;
 };
 
 /**
@@ -296,7 +456,7 @@ export type OutlineLesson = Message<"mirai.v1.OutlineLesson"> & {
  * Use `create(OutlineLessonSchema)` to create a new message.
  */
 export const OutlineLessonSchema: GenMessage<OutlineLesson> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_ai_generation, 3);
+  messageDesc(file_mirai_v1_ai_generation, 5);
 
 /**
  * GeneratedLesson contains full lesson content.
@@ -320,6 +480,8 @@ export type GeneratedLesson = Message<"mirai.v1.GeneratedLesson"> & {
   sectionId: string;
 
   /**
+   * Empty once its outline lesson was removed
+   *
    * @generated from field: string outline_lesson_id = 4;
    */
   outlineLessonId: string;
@@ -345,6 +507,20 @@ export type GeneratedLesson = Message<"mirai.v1.GeneratedLesson"> & {
    * @generated from field: google.protobuf.Timestamp generated_at = 8;
    */
   generatedAt?: Timestamp;
+
+  /**
+   * Set when its outline lesson was removed or superseded
+   *
+   * @generated from field: optional google.protobuf.Timestamp orphaned_at = 9;
+   */
+  orphanedAt?: Timestamp;
+
+  /**
+   * Components generation produced; unset for lessons generated before it was recorded
+   *
+   * @generated from field: optional int32 component_count = 10;
+   */
+  componentCount?: number;
 };
 
 /**
@@ -352,7 +528,7 @@ export type GeneratedLesson = Message<"mirai.v1.GeneratedLesson"> & {
  * Use `create(GeneratedLessonSchema)` to create a new message.
  */
 export const GeneratedLessonSchema: GenMessage<GeneratedLesson> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_ai_generation, 4);
+  messageDesc(file_mirai_v1_ai_generation, 6);
 
 /**
  * LessonComponent represents a content component in a lesson.
@@ -395,7 +571,7 @@ export type LessonComponent = Message<"mirai.v1.LessonComponent"> & {
  * Use `create(LessonComponentSchema)` to create a new message.
  */
 export const LessonComponentSchema: GenMessage<LessonComponent> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_ai_generation, 5);
+  messageDesc(file_mirai_v1_ai_generation, 7);
 
 /**
  * ComponentAlignment tracks what knowledge/objectives a component addresses.
@@ -419,7 +595,7 @@ export type ComponentAlignment = Message<"mirai.v1.ComponentAlignment"> & {
  * Use `create(ComponentAlignmentSchema)` to create a new message.
  */
 export const ComponentAlignmentSchema: GenMessage<ComponentAlignment> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_ai_generation, 6);
+  messageDesc(file_mirai_v1_ai_generation, 8);
 
 /**
  * TextContent for text components.
@@ -443,7 +619,7 @@ export type TextContent = Message<"mirai.v1.TextContent"> & {
  * Use `create(TextContentSchema)` to create a new message.
  */
 export const TextContentSchema: GenMessage<TextContent> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_ai_generation, 7);
+  messageDesc(file_mirai_v1_ai_generation, 9);
 
 /**
  * HeadingContent for heading components.
@@ -467,7 +643,7 @@ export type HeadingContent = Message<"mirai.v1.HeadingContent"> & {
  * Use `create(HeadingContentSchema)` to create a new message.
  */
 export const HeadingContentSchema: GenMessage<HeadingContent> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_ai_generation, 8);
+  messageDesc(file_mirai_v1_ai_generation, 10);
 
 /**
  * ImageContent for image components.
@@ -496,7 +672,7 @@ export type ImageContent = Message<"mirai.v1.ImageContent"> & {
  * Use `create(ImageContentSchema)` to create a new message.
  */
 export const ImageContentSchema: GenMessage<ImageContent> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_ai_generation, 9);
+  messageDesc(file_mirai_v1_ai_generation, 11);
 
 /**
  * QuizContent for quiz/knowledge check components.
@@ -547,7 +723,7 @@ export type QuizContent = Message<"mirai.v1.QuizContent"> & {
  * Use `create(QuizContentSchema)` to create a new message.
  */
 export const QuizContentSchema: GenMessage<QuizContent> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_ai_generation, 10);
+  messageDesc(file_mirai_v1_ai_generation, 12);
 
 /**
  * QuizOption represents an answer option.
@@ -571,7 +747,7 @@ export type QuizOption = Message<"mirai.v1.QuizOption"> & {
  * Use `create(QuizOptionSchema)` to create a new message.
  */
 export const QuizOptionSchema: GenMessage<QuizOption> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_ai_generation, 11);
+  messageDesc(file_mirai_v1_ai_generation, 13);
 
 /**
  * CourseGenerationInput captures inputs for AI course generation.
@@ -611,6 +787,20 @@ export type CourseGenerationInput = Message<"mirai.v1.CourseGenerationInput"> &
    * @generated from field: optional string additional_context = 5;
    */
   additionalContext?: string;
+
+  /**
+   * Optional limits on outline size
+   *
+   * @generated from field: optional mirai.v1.OutlineConstraints constraints = 6;
+   */
+  constraints?: OutlineConstraints;
+
+  /**
+   * Component mix; tenant defaults when unset
+   *
+   * @generated from field: optional mirai.v1.GenerationPreferences preferences = 7;
+   */
+  preferences?: GenerationPreferences;
 };
 
 /**
@@ -618,477 +808,563 @@ export type CourseGenerationInput = Message<"mirai.v1.CourseGenerationInput"> &
  * Use `create(CourseGenerationInputSchema)` to create a new message.
  */
 export const CourseGenerationInputSchema: GenMessage<CourseGenerationInput> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_ai_generation, 12);
+  messageDesc(file_mirai_v1_ai_generation, 14);
 
 /**
- * GenerateCourseOutlineRequest starts outline generation.
+ * GenerationPreferences controls which component types lesson generation produces.
  *
- * @generated from message mirai.v1.GenerateCourseOutlineRequest
+ * @generated from message mirai.v1.GenerationPreferences
  */
-export type GenerateCourseOutlineRequest = Message<"mirai.v1.GenerateCourseOutlineRequest"> & {
+export type GenerationPreferences = Message<"mirai.v1.GenerationPreferences"> & {
   /**
-   * @generated from field: mirai.v1.CourseGenerationInput input = 1;
+   * @generated from field: bool enable_quizzes = 1;
    */
-  input?: CourseGenerationInput;
-};
+  enableQuizzes: boolean;
 
-/**
- * Describes the message mirai.v1.GenerateCourseOutlineRequest.
- * Use `create(GenerateCourseOutlineRequestSchema)` to create a new message.
- */
-export const GenerateCourseOutlineRequestSchema: GenMessage<GenerateCourseOutlineRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_ai_generation, 13);
+  /**
+   * @generated from field: mirai.v1.QuizFrequency quiz_frequency = 2;
+   */
+  quizFrequency: QuizFrequency;
 
-/**
- * GenerateCourseOutlineResponse returns the job ID to track progress.
- *
- * @generated from message mirai.v1.GenerateCourseOutlineResponse
- */
-export type GenerateCourseOutlineResponse = Message<"mirai.v1.GenerateCourseOutlineResponse"> & {
   /**
-   * @generated from field: mirai.v1.GenerationJob job = 1;
+   * @generated from field: bool include_images = 3;
    */
-  job?: GenerationJob;
-};
+  includeImages: boolean;
 
-/**
- * Describes the message mirai.v1.GenerateCourseOutlineResponse.
- * Use `create(GenerateCourseOutlineResponseSchema)` to create a new message.
- */
-export const GenerateCourseOutlineResponseSchema: GenMessage<GenerateCourseOutlineResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_ai_generation, 14);
+  /**
+   * @generated from field: bool include_reflection_prompts = 4;
+   */
+  includeReflectionPrompts: boolean;
 
-/**
- * GetCourseOutlineRequest fetches the outline for a course.
- *
- * @generated from message mirai.v1.GetCourseOutlineRequest
- */
-export type GetCourseOutlineRequest = Message<"mirai.v1.GetCourseOutlineRequest"> & {
   /**
-   * @generated from field: string course_id = 1;
+   * Generate a picture for each image component instead of only describing it
+   *
+   * @generated from field: bool generate_images = 5;
    */
-  courseId: string;
+  generateImages: boolean;
 
   /**
-   * If not specified, returns latest
+   * Target range for components per lesson (0-100); 0 is unbounded
    *
-   * @generated from field: optional int32 version = 2;
+   * @generated from field: int32 min_lesson_components = 6;
    */
-  version?: number;
+  minLessonComponents: number;
+
+  /**
+   * @generated from field: int32 max_lesson_components = 7;
+   */
+  maxLessonComponents: number;
 };
 
 /**
- * Describes the message mirai.v1.GetCourseOutlineRequest.
- * Use `create(GetCourseOutlineRequestSchema)` to create a new message.
+ * Describes the message mirai.v1.GenerationPreferences.
+ * Use `create(GenerationPreferencesSchema)` to create a new message.
  */
-export const GetCourseOutlineRequestSchema: GenMessage<GetCourseOutlineRequest> = /*@__PURE__*/
+export const GenerationPreferencesSchema: GenMessage<GenerationPreferences> = /*@__PURE__*/
   messageDesc(file_mirai_v1_ai_generation, 15);
 
 /**
- * GetCourseOutlineResponse contains the outline.
+ * OutlineConstraints bounds the size of a generated outline.
+ * Unset fields are unconstrained.
  *
- * @generated from message mirai.v1.GetCourseOutlineResponse
+ * @generated from message mirai.v1.OutlineConstraints
  */
-export type GetCourseOutlineResponse = Message<"mirai.v1.GetCourseOutlineResponse"> & {
+export type OutlineConstraints = Message<"mirai.v1.OutlineConstraints"> & {
   /**
-   * @generated from field: mirai.v1.CourseOutline outline = 1;
+   * @generated from field: optional int32 max_sections = 1;
    */
-  outline?: CourseOutline;
+  maxSections?: number;
+
+  /**
+   * @generated from field: optional int32 max_lessons_per_section = 2;
+   */
+  maxLessonsPerSection?: number;
+
+  /**
+   * Target total course duration
+   *
+   * @generated from field: optional int32 target_duration_minutes = 3;
+   */
+  targetDurationMinutes?: number;
 };
 
 /**
- * Describes the message mirai.v1.GetCourseOutlineResponse.
- * Use `create(GetCourseOutlineResponseSchema)` to create a new message.
+ * Describes the message mirai.v1.OutlineConstraints.
+ * Use `create(OutlineConstraintsSchema)` to create a new message.
  */
-export const GetCourseOutlineResponseSchema: GenMessage<GetCourseOutlineResponse> = /*@__PURE__*/
+export const OutlineConstraintsSchema: GenMessage<OutlineConstraints> = /*@__PURE__*/
   messageDesc(file_mirai_v1_ai_generation, 16);
 
 /**
- * ApproveCourseOutlineRequest approves an outline.
+ * GenerateCourseOutlineRequest starts outline generation.
  *
- * @generated from message mirai.v1.ApproveCourseOutlineRequest
+ * @generated from message mirai.v1.GenerateCourseOutlineRequest
  */
-export type ApproveCourseOutlineRequest = Message<"mirai.v1.ApproveCourseOutlineRequest"> & {
+export type GenerateCourseOutlineRequest = Message<"mirai.v1.GenerateCourseOutlineRequest"> & {
   /**
-   * @generated from field: string course_id = 1;
+   * @generated from field: mirai.v1.CourseGenerationInput input = 1;
    */
-  courseId: string;
+  input?: CourseGenerationInput;
 
   /**
-   * @generated from field: string outline_id = 2;
+   * Approve the outline and generate all lessons; requires the tenant setting
+   *
+   * @generated from field: bool auto_approve = 2;
    */
-  outlineId: string;
+  autoApprove: boolean;
 };
 
 /**
- * Describes the message mirai.v1.ApproveCourseOutlineRequest.
- * Use `create(ApproveCourseOutlineRequestSchema)` to create a new message.
+ * Describes the message mirai.v1.GenerateCourseOutlineRequest.
+ * Use `create(GenerateCourseOutlineRequestSchema)` to create a new message.
  */
-export const ApproveCourseOutlineRequestSchema: GenMessage<ApproveCourseOutlineRequest> = /*@__PURE__*/
+export const GenerateCourseOutlineRequestSchema: GenMessage<GenerateCourseOutlineRequest> = /*@__PURE__*/
   messageDesc(file_mirai_v1_ai_generation, 17);
 
 /**
- * ApproveCourseOutlineResponse confirms approval.
+ * GenerateCourseOutlineResponse returns the job ID to track progress.
+ * With auto_approve the job is the full course job covering outline and lessons.
  *
- * @generated from message mirai.v1.ApproveCourseOutlineResponse
+ * @generated from message mirai.v1.GenerateCourseOutlineResponse
  */
-export type ApproveCourseOutlineResponse = Message<"mirai.v1.ApproveCourseOutlineResponse"> & {
+export type GenerateCourseOutlineResponse = Message<"mirai.v1.GenerateCourseOutlineResponse"> & {
   /**
-   * @generated from field: mirai.v1.CourseOutline outline = 1;
+   * @generated from field: mirai.v1.GenerationJob job = 1;
    */
-  outline?: CourseOutline;
+  job?: GenerationJob;
+
+  /**
+   * Check coverage.sufficient to warn about thin knowledge
+   *
+   * @generated from field: optional mirai.v1.KnowledgeCoverage coverage = 2;
+   */
+  coverage?: KnowledgeCoverage;
 };
 
 /**
- * Describes the message mirai.v1.ApproveCourseOutlineResponse.
- * Use `create(ApproveCourseOutlineResponseSchema)` to create a new message.
+ * Describes the message mirai.v1.GenerateCourseOutlineResponse.
+ * Use `create(GenerateCourseOutlineResponseSchema)` to create a new message.
  */
-export const ApproveCourseOutlineResponseSchema: GenMessage<ApproveCourseOutlineResponse> = /*@__PURE__*/
+export const GenerateCourseOutlineResponseSchema: GenMessage<GenerateCourseOutlineResponse> = /*@__PURE__*/
   messageDesc(file_mirai_v1_ai_generation, 18);
 
 /**
- * RejectCourseOutlineRequest rejects an outline.
+ * AnalyzeKnowledgeCoverageRequest checks selected SMEs against a course goal.
  *
- * @generated from message mirai.v1.RejectCourseOutlineRequest
+ * @generated from message mirai.v1.AnalyzeKnowledgeCoverageRequest
  */
-export type RejectCourseOutlineRequest = Message<"mirai.v1.RejectCourseOutlineRequest"> & {
+export type AnalyzeKnowledgeCoverageRequest = Message<"mirai.v1.AnalyzeKnowledgeCoverageRequest"> & {
   /**
-   * @generated from field: string course_id = 1;
+   * @generated from field: repeated string sme_ids = 1;
    */
-  courseId: string;
+  smeIds: string[];
 
   /**
-   * @generated from field: string outline_id = 2;
+   * @generated from field: string desired_outcome = 2;
    */
-  outlineId: string;
+  desiredOutcome: string;
 
   /**
-   * @generated from field: string reason = 3;
+   * @generated from field: optional string course_title = 3;
    */
-  reason: string;
+  courseTitle?: string;
 };
 
 /**
- * Describes the message mirai.v1.RejectCourseOutlineRequest.
- * Use `create(RejectCourseOutlineRequestSchema)` to create a new message.
+ * Describes the message mirai.v1.AnalyzeKnowledgeCoverageRequest.
+ * Use `create(AnalyzeKnowledgeCoverageRequestSchema)` to create a new message.
  */
-export const RejectCourseOutlineRequestSchema: GenMessage<RejectCourseOutlineRequest> = /*@__PURE__*/
+export const AnalyzeKnowledgeCoverageRequestSchema: GenMessage<AnalyzeKnowledgeCoverageRequest> = /*@__PURE__*/
   messageDesc(file_mirai_v1_ai_generation, 19);
 
 /**
- * RejectCourseOutlineResponse confirms rejection.
+ * AnalyzeKnowledgeCoverageResponse contains the analysis.
  *
- * @generated from message mirai.v1.RejectCourseOutlineResponse
+ * @generated from message mirai.v1.AnalyzeKnowledgeCoverageResponse
  */
-export type RejectCourseOutlineResponse = Message<"mirai.v1.RejectCourseOutlineResponse"> & {
+export type AnalyzeKnowledgeCoverageResponse = Message<"mirai.v1.AnalyzeKnowledgeCoverageResponse"> & {
   /**
-   * @generated from field: mirai.v1.CourseOutline outline = 1;
+   * @generated from field: mirai.v1.KnowledgeCoverage coverage = 1;
    */
-  outline?: CourseOutline;
+  coverage?: KnowledgeCoverage;
 };
 
 /**
- * Describes the message mirai.v1.RejectCourseOutlineResponse.
- * Use `create(RejectCourseOutlineResponseSchema)` to create a new message.
+ * Describes the message mirai.v1.AnalyzeKnowledgeCoverageResponse.
+ * Use `create(AnalyzeKnowledgeCoverageResponseSchema)` to create a new message.
  */
-export const RejectCourseOutlineResponseSchema: GenMessage<RejectCourseOutlineResponse> = /*@__PURE__*/
+export const AnalyzeKnowledgeCoverageResponseSchema: GenMessage<AnalyzeKnowledgeCoverageResponse> = /*@__PURE__*/
   messageDesc(file_mirai_v1_ai_generation, 20);
 
 /**
- * UpdateCourseOutlineRequest allows editing the outline.
+ * KnowledgeCoverage estimates how well the selected SMEs' knowledge covers a course goal,
+ * by how many of the goal's key terms are mentioned in enough knowledge chunks.
  *
- * @generated from message mirai.v1.UpdateCourseOutlineRequest
+ * @generated from message mirai.v1.KnowledgeCoverage
  */
-export type UpdateCourseOutlineRequest = Message<"mirai.v1.UpdateCourseOutlineRequest"> & {
+export type KnowledgeCoverage = Message<"mirai.v1.KnowledgeCoverage"> & {
   /**
-   * @generated from field: string course_id = 1;
+   * Share of key terms that are covered, 0-1
+   *
+   * @generated from field: double score = 1;
    */
-  courseId: string;
+  score: number;
 
   /**
-   * @generated from field: string outline_id = 2;
+   * False when the outline is likely to be shallow
+   *
+   * @generated from field: bool sufficient = 2;
    */
-  outlineId: string;
+  sufficient: boolean;
 
   /**
-   * @generated from field: repeated mirai.v1.OutlineSection sections = 3;
+   * Chunks usable in generation across the selected SMEs
+   *
+   * @generated from field: int32 chunk_count = 3;
    */
-  sections: OutlineSection[];
+  chunkCount: number;
+
+  /**
+   * @generated from field: repeated mirai.v1.TermCoverage terms = 4;
+   */
+  terms: TermCoverage[];
+
+  /**
+   * Key terms with too little knowledge; candidates for SME tasks
+   *
+   * @generated from field: repeated string thin_topics = 5;
+   */
+  thinTopics: string[];
+
+  /**
+   * Warning and suggested next step; empty when sufficient
+   *
+   * @generated from field: string message = 6;
+   */
+  message: string;
 };
 
 /**
- * Describes the message mirai.v1.UpdateCourseOutlineRequest.
- * Use `create(UpdateCourseOutlineRequestSchema)` to create a new message.
+ * Describes the message mirai.v1.KnowledgeCoverage.
+ * Use `create(KnowledgeCoverageSchema)` to create a new message.
  */
-export const UpdateCourseOutlineRequestSchema: GenMessage<UpdateCourseOutlineRequest> = /*@__PURE__*/
+export const KnowledgeCoverageSchema: GenMessage<KnowledgeCoverage> = /*@__PURE__*/
   messageDesc(file_mirai_v1_ai_generation, 21);
 
 /**
- * UpdateCourseOutlineResponse contains the updated outline.
+ * TermCoverage is how many knowledge chunks mention one key term.
  *
- * @generated from message mirai.v1.UpdateCourseOutlineResponse
+ * @generated from message mirai.v1.TermCoverage
  */
-export type UpdateCourseOutlineResponse = Message<"mirai.v1.UpdateCourseOutlineResponse"> & {
+export type TermCoverage = Message<"mirai.v1.TermCoverage"> & {
   /**
-   * @generated from field: mirai.v1.CourseOutline outline = 1;
+   * @generated from field: string term = 1;
    */
-  outline?: CourseOutline;
+  term: string;
+
+  /**
+   * @generated from field: int32 chunk_count = 2;
+   */
+  chunkCount: number;
 };
 
 /**
- * Describes the message mirai.v1.UpdateCourseOutlineResponse.
- * Use `create(UpdateCourseOutlineResponseSchema)` to create a new message.
+ * Describes the message mirai.v1.TermCoverage.
+ * Use `create(TermCoverageSchema)` to create a new message.
  */
-export const UpdateCourseOutlineResponseSchema: GenMessage<UpdateCourseOutlineResponse> = /*@__PURE__*/
+export const TermCoverageSchema: GenMessage<TermCoverage> = /*@__PURE__*/
   messageDesc(file_mirai_v1_ai_generation, 22);
 
 /**
- * GenerateLessonContentRequest generates content for one lesson.
+ * GetCourseOutlineRequest fetches the outline for a course.
  *
- * @generated from message mirai.v1.GenerateLessonContentRequest
+ * @generated from message mirai.v1.GetCourseOutlineRequest
  */
-export type GenerateLessonContentRequest = Message<"mirai.v1.GenerateLessonContentRequest"> & {
+export type GetCourseOutlineRequest = Message<"mirai.v1.GetCourseOutlineRequest"> & {
   /**
    * @generated from field: string course_id = 1;
    */
   courseId: string;
 
   /**
-   * @generated from field: string outline_lesson_id = 2;
+   * If not specified, returns latest
+   *
+   * @generated from field: optional int32 version = 2;
    */
-  outlineLessonId: string;
+  version?: number;
 };
 
 /**
- * Describes the message mirai.v1.GenerateLessonContentRequest.
- * Use `create(GenerateLessonContentRequestSchema)` to create a new message.
+ * Describes the message mirai.v1.GetCourseOutlineRequest.
+ * Use `create(GetCourseOutlineRequestSchema)` to create a new message.
  */
-export const GenerateLessonContentRequestSchema: GenMessage<GenerateLessonContentRequest> = /*@__PURE__*/
+export const GetCourseOutlineRequestSchema: GenMessage<GetCourseOutlineRequest> = /*@__PURE__*/
   messageDesc(file_mirai_v1_ai_generation, 23);
 
 /**
- * GenerateLessonContentResponse returns the job ID.
+ * GetCourseOutlineResponse contains the outline.
  *
- * @generated from message mirai.v1.GenerateLessonContentResponse
+ * @generated from message mirai.v1.GetCourseOutlineResponse
  */
-export type GenerateLessonContentResponse = Message<"mirai.v1.GenerateLessonContentResponse"> & {
+export type GetCourseOutlineResponse = Message<"mirai.v1.GetCourseOutlineResponse"> & {
   /**
-   * @generated from field: mirai.v1.GenerationJob job = 1;
+   * @generated from field: mirai.v1.CourseOutline outline = 1;
    */
-  job?: GenerationJob;
+  outline?: CourseOutline;
+
+  /**
+   * The course's queued or processing full course run
+   *
+   * @generated from field: optional mirai.v1.GenerationJob active_generation_job = 2;
+   */
+  activeGenerationJob?: GenerationJob;
 };
 
 /**
- * Describes the message mirai.v1.GenerateLessonContentResponse.
- * Use `create(GenerateLessonContentResponseSchema)` to create a new message.
+ * Describes the message mirai.v1.GetCourseOutlineResponse.
+ * Use `create(GetCourseOutlineResponseSchema)` to create a new message.
  */
-export const GenerateLessonContentResponseSchema: GenMessage<GenerateLessonContentResponse> = /*@__PURE__*/
+export const GetCourseOutlineResponseSchema: GenMessage<GetCourseOutlineResponse> = /*@__PURE__*/
   messageDesc(file_mirai_v1_ai_generation, 24);
 
 /**
- * GenerateAllLessonsRequest generates all lessons for a course.
+ * ApproveCourseOutlineRequest approves an outline.
  *
- * @generated from message mirai.v1.GenerateAllLessonsRequest
+ * @generated from message mirai.v1.ApproveCourseOutlineRequest
  */
-export type GenerateAllLessonsRequest = Message<"mirai.v1.GenerateAllLessonsRequest"> & {
+export type ApproveCourseOutlineRequest = Message<"mirai.v1.ApproveCourseOutlineRequest"> & {
   /**
    * @generated from field: string course_id = 1;
    */
   courseId: string;
+
+  /**
+   * @generated from field: string outline_id = 2;
+   */
+  outlineId: string;
 };
 
 /**
- * Describes the message mirai.v1.GenerateAllLessonsRequest.
- * Use `create(GenerateAllLessonsRequestSchema)` to create a new message.
+ * Describes the message mirai.v1.ApproveCourseOutlineRequest.
+ * Use `create(ApproveCourseOutlineRequestSchema)` to create a new message.
  */
-export const GenerateAllLessonsRequestSchema: GenMessage<GenerateAllLessonsRequest> = /*@__PURE__*/
+export const ApproveCourseOutlineRequestSchema: GenMessage<ApproveCourseOutlineRequest> = /*@__PURE__*/
   messageDesc(file_mirai_v1_ai_generation, 25);
 
 /**
- * GenerateAllLessonsResponse returns the job ID.
+ * ApproveCourseOutlineResponse confirms approval.
  *
- * @generated from message mirai.v1.GenerateAllLessonsResponse
+ * @generated from message mirai.v1.ApproveCourseOutlineResponse
  */
-export type GenerateAllLessonsResponse = Message<"mirai.v1.GenerateAllLessonsResponse"> & {
+export type ApproveCourseOutlineResponse = Message<"mirai.v1.ApproveCourseOutlineResponse"> & {
   /**
-   * @generated from field: mirai.v1.GenerationJob job = 1;
+   * @generated from field: mirai.v1.CourseOutline outline = 1;
    */
-  job?: GenerationJob;
+  outline?: CourseOutline;
 };
 
 /**
- * Describes the message mirai.v1.GenerateAllLessonsResponse.
- * Use `create(GenerateAllLessonsResponseSchema)` to create a new message.
+ * Describes the message mirai.v1.ApproveCourseOutlineResponse.
+ * Use `create(ApproveCourseOutlineResponseSchema)` to create a new message.
  */
-export const GenerateAllLessonsResponseSchema: GenMessage<GenerateAllLessonsResponse> = /*@__PURE__*/
+export const ApproveCourseOutlineResponseSchema: GenMessage<ApproveCourseOutlineResponse> = /*@__PURE__*/
   messageDesc(file_mirai_v1_ai_generation, 26);
 
 /**
- * RegenerateComponentRequest regenerates a single component.
+ * RejectCourseOutlineRequest rejects an outline.
  *
- * @generated from message mirai.v1.RegenerateComponentRequest
+ * @generated from message mirai.v1.RejectCourseOutlineRequest
  */
-export type RegenerateComponentRequest = Message<"mirai.v1.RegenerateComponentRequest"> & {
+export type RejectCourseOutlineRequest = Message<"mirai.v1.RejectCourseOutlineRequest"> & {
   /**
    * @generated from field: string course_id = 1;
    */
   courseId: string;
 
   /**
-   * @generated from field: string lesson_id = 2;
-   */
-  lessonId: string;
-
-  /**
-   * @generated from field: string component_id = 3;
+   * @generated from field: string outline_id = 2;
    */
-  componentId: string;
+  outlineId: string;
 
   /**
-   * Instructions for regeneration
-   *
-   * @generated from field: string modification_prompt = 4;
+   * @generated from field: string reason = 3;
    */
-  modificationPrompt: string;
+  reason: string;
 };
 
 /**
- * Describes the message mirai.v1.RegenerateComponentRequest.
- * Use `create(RegenerateComponentRequestSchema)` to create a new message.
+ * Describes the message mirai.v1.RejectCourseOutlineRequest.
+ * Use `create(RejectCourseOutlineRequestSchema)` to create a new message.
  */
-export const RegenerateComponentRequestSchema: GenMessage<RegenerateComponentRequest> = /*@__PURE__*/
+export const RejectCourseOutlineRequestSchema: GenMessage<RejectCourseOutlineRequest> = /*@__PURE__*/
   messageDesc(file_mirai_v1_ai_generation, 27);
 
 /**
- * RegenerateComponentResponse returns the job ID.
+ * RejectCourseOutlineResponse confirms rejection.
  *
- * @generated from message mirai.v1.RegenerateComponentResponse
+ * @generated from message mirai.v1.RejectCourseOutlineResponse
  */
-export type RegenerateComponentResponse = Message<"mirai.v1.RegenerateComponentResponse"> & {
+export type RejectCourseOutlineResponse = Message<"mirai.v1.RejectCourseOutlineResponse"> & {
   /**
-   * @generated from field: mirai.v1.GenerationJob job = 1;
+   * @generated from field: mirai.v1.CourseOutline outline = 1;
    */
-  job?: GenerationJob;
+  outline?: CourseOutline;
 };
 
 /**
- * Describes the message mirai.v1.RegenerateComponentResponse.
- * Use `create(RegenerateComponentResponseSchema)` to create a new message.
+ * Describes the message mirai.v1.RejectCourseOutlineResponse.
+ * Use `create(RejectCourseOutlineResponseSchema)` to create a new message.
  */
-export const RegenerateComponentResponseSchema: GenMessage<RegenerateComponentResponse> = /*@__PURE__*/
+export const RejectCourseOutlineResponseSchema: GenMessage<RejectCourseOutlineResponse> = /*@__PURE__*/
   messageDesc(file_mirai_v1_ai_generation, 28);
 
 /**
- * GetJobRequest fetches a job by ID.
+ * UpdateCourseOutlineRequest allows editing the outline.
  *
- * @generated from message mirai.v1.GetJobRequest
+ * @generated from message mirai.v1.UpdateCourseOutlineRequest
  */
-export type GetJobRequest = Message<"mirai.v1.GetJobRequest"> & {
+export type UpdateCourseOutlineRequest = Message<"mirai.v1.UpdateCourseOutlineRequest"> & {
   /**
-   * @generated from field: string job_id = 1;
+   * @generated from field: string course_id = 1;
    */
-  jobId: string;
+  courseId: string;
+
+  /**
+   * @generated from field: string outline_id = 2;
+   */
+  outlineId: string;
+
+  /**
+   * Lessons with an empty id are added
+   *
+   * @generated from field: repeated mirai.v1.OutlineSection sections = 3;
+   */
+  sections: OutlineSection[];
+
+  /**
+   * Lessons to delete; fails for lessons with generated content
+   *
+   * @generated from field: repeated string removed_lesson_ids = 4;
+   */
+  removedLessonIds: string[];
 };
 
 /**
- * Describes the message mirai.v1.GetJobRequest.
- * Use `create(GetJobRequestSchema)` to create a new message.
+ * Describes the message mirai.v1.UpdateCourseOutlineRequest.
+ * Use `create(UpdateCourseOutlineRequestSchema)` to create a new message.
  */
-export const GetJobRequestSchema: GenMessage<GetJobRequest> = /*@__PURE__*/
+export const UpdateCourseOutlineRequestSchema: GenMessage<UpdateCourseOutlineRequest> = /*@__PURE__*/
   messageDesc(file_mirai_v1_ai_generation, 29);
 
 /**
- * GetJobResponse contains the job.
+ * UpdateCourseOutlineResponse contains the updated outline.
  *
- * @generated from message mirai.v1.GetJobResponse
+ * @generated from message mirai.v1.UpdateCourseOutlineResponse
  */
-export type GetJobResponse = Message<"mirai.v1.GetJobResponse"> & {
+export type UpdateCourseOutlineResponse = Message<"mirai.v1.UpdateCourseOutlineResponse"> & {
   /**
-   * @generated from field: mirai.v1.GenerationJob job = 1;
+   * @generated from field: mirai.v1.CourseOutline outline = 1;
    */
-  job?: GenerationJob;
+  outline?: CourseOutline;
 };
 
 /**
- * Describes the message mirai.v1.GetJobResponse.
- * Use `create(GetJobResponseSchema)` to create a new message.
+ * Describes the message mirai.v1.UpdateCourseOutlineResponse.
+ * Use `create(UpdateCourseOutlineResponseSchema)` to create a new message.
  */
-export const GetJobResponseSchema: GenMessage<GetJobResponse> = /*@__PURE__*/
+export const UpdateCourseOutlineResponseSchema: GenMessage<UpdateCourseOutlineResponse> = /*@__PURE__*/
   messageDesc(file_mirai_v1_ai_generation, 30);
 
 /**
- * ListJobsRequest contains filters for jobs.
+ * ExportOutlineRequest exports an outline to a document.
  *
- * @generated from message mirai.v1.ListJobsRequest
+ * @generated from message mirai.v1.ExportOutlineRequest
  */
-export type ListJobsRequest = Message<"mirai.v1.ListJobsRequest"> & {
+export type ExportOutlineRequest = Message<"mirai.v1.ExportOutlineRequest"> & {
   /**
-   * @generated from field: optional mirai.v1.GenerationJobType type = 1;
+   * @generated from field: string course_id = 1;
    */
-  type?: GenerationJobType;
+  courseId: string;
 
   /**
-   * @generated from field: optional mirai.v1.GenerationJobStatus status = 2;
+   * @generated from field: mirai.v1.OutlineExportFormat format = 2;
    */
-  status?: GenerationJobStatus;
+  format: OutlineExportFormat;
 
   /**
-   * @generated from field: optional string course_id = 3;
+   * If not specified, exports latest
+   *
+   * @generated from field: optional int32 version = 3;
    */
-  courseId?: string;
+  version?: number;
 };
 
 /**
- * Describes the message mirai.v1.ListJobsRequest.
- * Use `create(ListJobsRequestSchema)` to create a new message.
+ * Describes the message mirai.v1.ExportOutlineRequest.
+ * Use `create(ExportOutlineRequestSchema)` to create a new message.
  */
-export const ListJobsRequestSchema: GenMessage<ListJobsRequest> = /*@__PURE__*/
+export const ExportOutlineRequestSchema: GenMessage<ExportOutlineRequest> = /*@__PURE__*/
   messageDesc(file_mirai_v1_ai_generation, 31);
 
 /**
- * ListJobsResponse contains matching jobs.
+ * ExportOutlineResponse contains a presigned download link for the export.
  *
- * @generated from message mirai.v1.ListJobsResponse
+ * @generated from message mirai.v1.ExportOutlineResponse
  */
-export type ListJobsResponse = Message<"mirai.v1.ListJobsResponse"> & {
+export type ExportOutlineResponse = Message<"mirai.v1.ExportOutlineResponse"> & {
   /**
-   * @generated from field: repeated mirai.v1.GenerationJob jobs = 1;
+   * @generated from field: string download_url = 1;
    */
-  jobs: GenerationJob[];
+  downloadUrl: string;
+
+  /**
+   * @generated from field: string filename = 2;
+   */
+  filename: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp expires_at = 3;
+   */
+  expiresAt?: Timestamp;
 };
 
 /**
- * Describes the message mirai.v1.ListJobsResponse.
- * Use `create(ListJobsResponseSchema)` to create a new message.
+ * Describes the message mirai.v1.ExportOutlineResponse.
+ * Use `create(ExportOutlineResponseSchema)` to create a new message.
  */
-export const ListJobsResponseSchema: GenMessage<ListJobsResponse> = /*@__PURE__*/
+export const ExportOutlineResponseSchema: GenMessage<ExportOutlineResponse> = /*@__PURE__*/
   messageDesc(file_mirai_v1_ai_generation, 32);
 
 /**
- * CancelJobRequest cancels a job.
+ * GenerateLessonContentRequest generates content for one lesson.
  *
- * @generated from message mirai.v1.CancelJobRequest
+ * @generated from message mirai.v1.GenerateLessonContentRequest
  */
-export type CancelJobRequest = Message<"mirai.v1.CancelJobRequest"> & {
+export type GenerateLessonContentRequest = Message<"mirai.v1.GenerateLessonContentRequest"> & {
   /**
-   * @generated from field: string job_id = 1;
+   * @generated from field: string course_id = 1;
    */
-  jobId: string;
+  courseId: string;
+
+  /**
+   * @generated from field: string outline_lesson_id = 2;
+   */
+  outlineLessonId: string;
 };
 
 /**
- * Describes the message mirai.v1.CancelJobRequest.
- * Use `create(CancelJobRequestSchema)` to create a new message.
+ * Describes the message mirai.v1.GenerateLessonContentRequest.
+ * Use `create(GenerateLessonContentRequestSchema)` to create a new message.
  */
-export const CancelJobRequestSchema: GenMessage<CancelJobRequest> = /*@__PURE__*/
+export const GenerateLessonContentRequestSchema: GenMessage<GenerateLessonContentRequest> = /*@__PURE__*/
   messageDesc(file_mirai_v1_ai_generation, 33);
 
 /**
- * CancelJobResponse confirms cancellation.
+ * GenerateLessonContentResponse returns the job ID.
  *
- * @generated from message mirai.v1.CancelJobResponse
+ * @generated from message mirai.v1.GenerateLessonContentResponse
  */
-export type CancelJobResponse = Message<"mirai.v1.CancelJobResponse"> & {
+export type GenerateLessonContentResponse = Message<"mirai.v1.GenerateLessonContentResponse"> & {
   /**
    * @generated from field: mirai.v1.GenerationJob job = 1;
    */
@@ -1096,308 +1372,2644 @@ export type CancelJobResponse = Message<"mirai.v1.CancelJobResponse"> & {
 };
 
 /**
- * Describes the message mirai.v1.CancelJobResponse.
- * Use `create(CancelJobResponseSchema)` to create a new message.
+ * Describes the message mirai.v1.GenerateLessonContentResponse.
+ * Use `create(GenerateLessonContentResponseSchema)` to create a new message.
  */
-export const CancelJobResponseSchema: GenMessage<CancelJobResponse> = /*@__PURE__*/
+export const GenerateLessonContentResponseSchema: GenMessage<GenerateLessonContentResponse> = /*@__PURE__*/
   messageDesc(file_mirai_v1_ai_generation, 34);
 
 /**
- * GetGeneratedLessonRequest fetches generated lesson content.
+ * GenerateAllLessonsRequest generates all lessons for a course.
  *
- * @generated from message mirai.v1.GetGeneratedLessonRequest
+ * @generated from message mirai.v1.GenerateAllLessonsRequest
  */
-export type GetGeneratedLessonRequest = Message<"mirai.v1.GetGeneratedLessonRequest"> & {
+export type GenerateAllLessonsRequest = Message<"mirai.v1.GenerateAllLessonsRequest"> & {
   /**
-   * @generated from field: string lesson_id = 1;
+   * @generated from field: string course_id = 1;
    */
-  lessonId: string;
+  courseId: string;
+
+  /**
+   * Replaces the course's preferences when set
+   *
+   * @generated from field: optional mirai.v1.GenerationPreferences preferences = 2;
+   */
+  preferences?: GenerationPreferences;
 };
 
 /**
- * Describes the message mirai.v1.GetGeneratedLessonRequest.
- * Use `create(GetGeneratedLessonRequestSchema)` to create a new message.
+ * Describes the message mirai.v1.GenerateAllLessonsRequest.
+ * Use `create(GenerateAllLessonsRequestSchema)` to create a new message.
  */
-export const GetGeneratedLessonRequestSchema: GenMessage<GetGeneratedLessonRequest> = /*@__PURE__*/
+export const GenerateAllLessonsRequestSchema: GenMessage<GenerateAllLessonsRequest> = /*@__PURE__*/
   messageDesc(file_mirai_v1_ai_generation, 35);
 
 /**
- * GetGeneratedLessonResponse contains the lesson.
+ * GenerateAllLessonsResponse returns the job ID.
+ * If the course already had a full course generation in progress, job is that run
+ * and nothing new was started.
  *
- * @generated from message mirai.v1.GetGeneratedLessonResponse
+ * @generated from message mirai.v1.GenerateAllLessonsResponse
  */
-export type GetGeneratedLessonResponse = Message<"mirai.v1.GetGeneratedLessonResponse"> & {
+export type GenerateAllLessonsResponse = Message<"mirai.v1.GenerateAllLessonsResponse"> & {
   /**
-   * @generated from field: mirai.v1.GeneratedLesson lesson = 1;
+   * @generated from field: mirai.v1.GenerationJob job = 1;
    */
-  lesson?: GeneratedLesson;
+  job?: GenerationJob;
+
+  /**
+   * @generated from field: bool already_running = 2;
+   */
+  alreadyRunning: boolean;
+
+  /**
+   * Who started the running job, when already_running
+   *
+   * @generated from field: optional string started_by_name = 3;
+   */
+  startedByName?: string;
 };
 
 /**
- * Describes the message mirai.v1.GetGeneratedLessonResponse.
- * Use `create(GetGeneratedLessonResponseSchema)` to create a new message.
+ * Describes the message mirai.v1.GenerateAllLessonsResponse.
+ * Use `create(GenerateAllLessonsResponseSchema)` to create a new message.
  */
-export const GetGeneratedLessonResponseSchema: GenMessage<GetGeneratedLessonResponse> = /*@__PURE__*/
+export const GenerateAllLessonsResponseSchema: GenMessage<GenerateAllLessonsResponse> = /*@__PURE__*/
   messageDesc(file_mirai_v1_ai_generation, 36);
 
 /**
- * ListGeneratedLessonsRequest fetches all lessons for a course.
+ * ExportAllLessonsRequest identifies the course whose lessons are exported.
  *
- * @generated from message mirai.v1.ListGeneratedLessonsRequest
+ * @generated from message mirai.v1.ExportAllLessonsRequest
  */
-export type ListGeneratedLessonsRequest = Message<"mirai.v1.ListGeneratedLessonsRequest"> & {
+export type ExportAllLessonsRequest = Message<"mirai.v1.ExportAllLessonsRequest"> & {
   /**
    * @generated from field: string course_id = 1;
    */
   courseId: string;
+
+  /**
+   * Footnote each component with the SME sources it was generated from
+   *
+   * @generated from field: bool include_citations = 2;
+   */
+  includeCitations: boolean;
 };
 
 /**
- * Describes the message mirai.v1.ListGeneratedLessonsRequest.
- * Use `create(ListGeneratedLessonsRequestSchema)` to create a new message.
+ * Describes the message mirai.v1.ExportAllLessonsRequest.
+ * Use `create(ExportAllLessonsRequestSchema)` to create a new message.
  */
-export const ListGeneratedLessonsRequestSchema: GenMessage<ListGeneratedLessonsRequest> = /*@__PURE__*/
+export const ExportAllLessonsRequestSchema: GenMessage<ExportAllLessonsRequest> = /*@__PURE__*/
   messageDesc(file_mirai_v1_ai_generation, 37);
 
 /**
- * ListGeneratedLessonsResponse contains the lessons.
+ * ExportAllLessonsResponse returns the export job.
  *
- * @generated from message mirai.v1.ListGeneratedLessonsResponse
+ * @generated from message mirai.v1.ExportAllLessonsResponse
  */
-export type ListGeneratedLessonsResponse = Message<"mirai.v1.ListGeneratedLessonsResponse"> & {
+export type ExportAllLessonsResponse = Message<"mirai.v1.ExportAllLessonsResponse"> & {
   /**
-   * @generated from field: repeated mirai.v1.GeneratedLesson lessons = 1;
+   * @generated from field: mirai.v1.GenerationJob job = 1;
    */
-  lessons: GeneratedLesson[];
+  job?: GenerationJob;
 };
 
 /**
- * Describes the message mirai.v1.ListGeneratedLessonsResponse.
- * Use `create(ListGeneratedLessonsResponseSchema)` to create a new message.
+ * Describes the message mirai.v1.ExportAllLessonsResponse.
+ * Use `create(ExportAllLessonsResponseSchema)` to create a new message.
  */
-export const ListGeneratedLessonsResponseSchema: GenMessage<ListGeneratedLessonsResponse> = /*@__PURE__*/
+export const ExportAllLessonsResponseSchema: GenMessage<ExportAllLessonsResponse> = /*@__PURE__*/
   messageDesc(file_mirai_v1_ai_generation, 38);
 
 /**
- * GenerationJobType represents the type of AI generation job.
+ * RetryFailedLessonsRequest identifies the full course run to retry.
  *
- * @generated from enum mirai.v1.GenerationJobType
+ * @generated from message mirai.v1.RetryFailedLessonsRequest
  */
-export enum GenerationJobType {
+export type RetryFailedLessonsRequest = Message<"mirai.v1.RetryFailedLessonsRequest"> & {
   /**
-   * @generated from enum value: GENERATION_JOB_TYPE_UNSPECIFIED = 0;
+   * Parent full course job
+   *
+   * @generated from field: optional string job_id = 1;
    */
-  UNSPECIFIED = 0,
+  jobId?: string;
 
   /**
-   * Process SME content submissions
+   * Used when job_id is unset: retries the course's latest full course run
    *
-   * @generated from enum value: GENERATION_JOB_TYPE_SME_INGESTION = 1;
+   * @generated from field: optional string course_id = 2;
    */
-  SME_INGESTION = 1,
+  courseId?: string;
+};
+
+/**
+ * Describes the message mirai.v1.RetryFailedLessonsRequest.
+ * Use `create(RetryFailedLessonsRequestSchema)` to create a new message.
+ */
+export const RetryFailedLessonsRequestSchema: GenMessage<RetryFailedLessonsRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 39);
 
+/**
+ * RetryFailedLessonsResponse contains the reopened parent job.
+ *
+ * @generated from message mirai.v1.RetryFailedLessonsResponse
+ */
+export type RetryFailedLessonsResponse = Message<"mirai.v1.RetryFailedLessonsResponse"> & {
   /**
-   * Generate course outline
+   * @generated from field: mirai.v1.GenerationJob job = 1;
+   */
+  job?: GenerationJob;
+
+  /**
+   * @generated from field: int32 retried_count = 2;
+   */
+  retriedCount: number;
+};
+
+/**
+ * Describes the message mirai.v1.RetryFailedLessonsResponse.
+ * Use `create(RetryFailedLessonsResponseSchema)` to create a new message.
+ */
+export const RetryFailedLessonsResponseSchema: GenMessage<RetryFailedLessonsResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 40);
+
+/**
+ * RegenerateComponentRequest regenerates a single component.
+ *
+ * @generated from message mirai.v1.RegenerateComponentRequest
+ */
+export type RegenerateComponentRequest = Message<"mirai.v1.RegenerateComponentRequest"> & {
+  /**
+   * @generated from field: string course_id = 1;
+   */
+  courseId: string;
+
+  /**
+   * @generated from field: string lesson_id = 2;
+   */
+  lessonId: string;
+
+  /**
+   * @generated from field: string component_id = 3;
+   */
+  componentId: string;
+
+  /**
+   * Instructions for regeneration
    *
-   * @generated from enum value: GENERATION_JOB_TYPE_COURSE_OUTLINE = 2;
+   * @generated from field: string modification_prompt = 4;
+   */
+  modificationPrompt: string;
+};
+
+/**
+ * Describes the message mirai.v1.RegenerateComponentRequest.
+ * Use `create(RegenerateComponentRequestSchema)` to create a new message.
+ */
+export const RegenerateComponentRequestSchema: GenMessage<RegenerateComponentRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 41);
+
+/**
+ * RegenerateComponentResponse returns the job ID.
+ *
+ * @generated from message mirai.v1.RegenerateComponentResponse
+ */
+export type RegenerateComponentResponse = Message<"mirai.v1.RegenerateComponentResponse"> & {
+  /**
+   * @generated from field: mirai.v1.GenerationJob job = 1;
+   */
+  job?: GenerationJob;
+};
+
+/**
+ * Describes the message mirai.v1.RegenerateComponentResponse.
+ * Use `create(RegenerateComponentResponseSchema)` to create a new message.
+ */
+export const RegenerateComponentResponseSchema: GenMessage<RegenerateComponentResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 42);
+
+/**
+ * EditComponentTextRequest asks for an inline rewrite of a text or heading component.
+ *
+ * @generated from message mirai.v1.EditComponentTextRequest
+ */
+export type EditComponentTextRequest = Message<"mirai.v1.EditComponentTextRequest"> & {
+  /**
+   * @generated from field: string component_id = 1;
+   */
+  componentId: string;
+
+  /**
+   * e.g. "shorten to two sentences"
+   *
+   * @generated from field: string instruction = 2;
+   */
+  instruction: string;
+};
+
+/**
+ * Describes the message mirai.v1.EditComponentTextRequest.
+ * Use `create(EditComponentTextRequestSchema)` to create a new message.
+ */
+export const EditComponentTextRequestSchema: GenMessage<EditComponentTextRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 43);
+
+/**
+ * EditComponentTextResponse returns the proposed content (not saved).
+ *
+ * @generated from message mirai.v1.EditComponentTextResponse
+ */
+export type EditComponentTextResponse = Message<"mirai.v1.EditComponentTextResponse"> & {
+  /**
+   * @generated from field: string component_id = 1;
+   */
+  componentId: string;
+
+  /**
+   * @generated from field: mirai.v1.LessonComponentType type = 2;
+   */
+  type: LessonComponentType;
+
+  /**
+   * Proposed content, validated against the component schema
+   *
+   * @generated from field: string content_json = 3;
+   */
+  contentJson: string;
+
+  /**
+   * @generated from field: int64 tokens_used = 4;
+   */
+  tokensUsed: bigint;
+
+  /**
+   * Reused a recent response to the same instruction; tokens_used is 0
+   *
+   * @generated from field: bool cache_hit = 5;
+   */
+  cacheHit: boolean;
+
+  /**
+   * Component's current position in the lesson
+   *
+   * @generated from field: int32 order = 6;
+   */
+  order: number;
+};
+
+/**
+ * Describes the message mirai.v1.EditComponentTextResponse.
+ * Use `create(EditComponentTextResponseSchema)` to create a new message.
+ */
+export const EditComponentTextResponseSchema: GenMessage<EditComponentTextResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 44);
+
+/**
+ * GetComponentSourcesRequest fetches the sources cited by a component.
+ *
+ * @generated from message mirai.v1.GetComponentSourcesRequest
+ */
+export type GetComponentSourcesRequest = Message<"mirai.v1.GetComponentSourcesRequest"> & {
+  /**
+   * @generated from field: string component_id = 1;
+   */
+  componentId: string;
+};
+
+/**
+ * Describes the message mirai.v1.GetComponentSourcesRequest.
+ * Use `create(GetComponentSourcesRequestSchema)` to create a new message.
+ */
+export const GetComponentSourcesRequestSchema: GenMessage<GetComponentSourcesRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 45);
+
+/**
+ * ComponentSource is an SME knowledge chunk cited by a component.
+ *
+ * @generated from message mirai.v1.ComponentSource
+ */
+export type ComponentSource = Message<"mirai.v1.ComponentSource"> & {
+  /**
+   * @generated from field: string chunk_id = 1;
+   */
+  chunkId: string;
+
+  /**
+   * @generated from field: string sme_id = 2;
+   */
+  smeId: string;
+
+  /**
+   * @generated from field: string sme_name = 3;
+   */
+  smeName: string;
+
+  /**
+   * @generated from field: string topic = 4;
+   */
+  topic: string;
+
+  /**
+   * Start of the chunk content
+   *
+   * @generated from field: string excerpt = 5;
+   */
+  excerpt: string;
+};
+
+/**
+ * Describes the message mirai.v1.ComponentSource.
+ * Use `create(ComponentSourceSchema)` to create a new message.
+ */
+export const ComponentSourceSchema: GenMessage<ComponentSource> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 46);
+
+/**
+ * GetComponentSourcesResponse lists the cited chunks. Empty for components generated
+ * before citations were recorded.
+ *
+ * @generated from message mirai.v1.GetComponentSourcesResponse
+ */
+export type GetComponentSourcesResponse = Message<"mirai.v1.GetComponentSourcesResponse"> & {
+  /**
+   * @generated from field: repeated mirai.v1.ComponentSource sources = 1;
+   */
+  sources: ComponentSource[];
+};
+
+/**
+ * Describes the message mirai.v1.GetComponentSourcesResponse.
+ * Use `create(GetComponentSourcesResponseSchema)` to create a new message.
+ */
+export const GetComponentSourcesResponseSchema: GenMessage<GetComponentSourcesResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 47);
+
+/**
+ * GetComponentAssetUploadURLRequest requests an upload slot for a component's file.
+ *
+ * @generated from message mirai.v1.GetComponentAssetUploadURLRequest
+ */
+export type GetComponentAssetUploadURLRequest = Message<"mirai.v1.GetComponentAssetUploadURLRequest"> & {
+  /**
+   * @generated from field: string component_id = 1;
+   */
+  componentId: string;
+
+  /**
+   * @generated from field: string file_name = 2;
+   */
+  fileName: string;
+
+  /**
+   * e.g. "video/mp4"; must suit the component type
+   *
+   * @generated from field: string content_type = 3;
+   */
+  contentType: string;
+};
+
+/**
+ * Describes the message mirai.v1.GetComponentAssetUploadURLRequest.
+ * Use `create(GetComponentAssetUploadURLRequestSchema)` to create a new message.
+ */
+export const GetComponentAssetUploadURLRequestSchema: GenMessage<GetComponentAssetUploadURLRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 48);
+
+/**
+ * GetComponentAssetUploadURLResponse contains the presigned upload URL.
+ *
+ * @generated from message mirai.v1.GetComponentAssetUploadURLResponse
+ */
+export type GetComponentAssetUploadURLResponse = Message<"mirai.v1.GetComponentAssetUploadURLResponse"> & {
+  /**
+   * @generated from field: string upload_url = 1;
+   */
+  uploadUrl: string;
+
+  /**
+   * Pass to ConfirmComponentAsset once uploaded
+   *
+   * @generated from field: string file_path = 2;
+   */
+  filePath: string;
+};
+
+/**
+ * Describes the message mirai.v1.GetComponentAssetUploadURLResponse.
+ * Use `create(GetComponentAssetUploadURLResponseSchema)` to create a new message.
+ */
+export const GetComponentAssetUploadURLResponseSchema: GenMessage<GetComponentAssetUploadURLResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 49);
+
+/**
+ * ConfirmComponentAssetRequest attaches an uploaded file to a component.
+ *
+ * @generated from message mirai.v1.ConfirmComponentAssetRequest
+ */
+export type ConfirmComponentAssetRequest = Message<"mirai.v1.ConfirmComponentAssetRequest"> & {
+  /**
+   * @generated from field: string component_id = 1;
+   */
+  componentId: string;
+
+  /**
+   * Path from GetComponentAssetUploadURL
+   *
+   * @generated from field: string file_path = 2;
+   */
+  filePath: string;
+};
+
+/**
+ * Describes the message mirai.v1.ConfirmComponentAssetRequest.
+ * Use `create(ConfirmComponentAssetRequestSchema)` to create a new message.
+ */
+export const ConfirmComponentAssetRequestSchema: GenMessage<ConfirmComponentAssetRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 50);
+
+/**
+ * ConfirmComponentAssetResponse contains the updated component.
+ *
+ * @generated from message mirai.v1.ConfirmComponentAssetResponse
+ */
+export type ConfirmComponentAssetResponse = Message<"mirai.v1.ConfirmComponentAssetResponse"> & {
+  /**
+   * @generated from field: mirai.v1.LessonComponent component = 1;
+   */
+  component?: LessonComponent;
+};
+
+/**
+ * Describes the message mirai.v1.ConfirmComponentAssetResponse.
+ * Use `create(ConfirmComponentAssetResponseSchema)` to create a new message.
+ */
+export const ConfirmComponentAssetResponseSchema: GenMessage<ConfirmComponentAssetResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 51);
+
+/**
+ * SuggestCourseTitlesRequest contains the course inputs chosen so far.
+ * At least one SME or a desired outcome is required.
+ *
+ * @generated from message mirai.v1.SuggestCourseTitlesRequest
+ */
+export type SuggestCourseTitlesRequest = Message<"mirai.v1.SuggestCourseTitlesRequest"> & {
+  /**
+   * @generated from field: repeated string sme_ids = 1;
+   */
+  smeIds: string[];
+
+  /**
+   * @generated from field: repeated string target_audience_ids = 2;
+   */
+  targetAudienceIds: string[];
+
+  /**
+   * @generated from field: string desired_outcome = 3;
+   */
+  desiredOutcome: string;
+};
+
+/**
+ * Describes the message mirai.v1.SuggestCourseTitlesRequest.
+ * Use `create(SuggestCourseTitlesRequestSchema)` to create a new message.
+ */
+export const SuggestCourseTitlesRequestSchema: GenMessage<SuggestCourseTitlesRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 52);
+
+/**
+ * CourseTitleSuggestion is a suggested title with a one-line rationale.
+ *
+ * @generated from message mirai.v1.CourseTitleSuggestion
+ */
+export type CourseTitleSuggestion = Message<"mirai.v1.CourseTitleSuggestion"> & {
+  /**
+   * @generated from field: string title = 1;
+   */
+  title: string;
+
+  /**
+   * @generated from field: string rationale = 2;
+   */
+  rationale: string;
+};
+
+/**
+ * Describes the message mirai.v1.CourseTitleSuggestion.
+ * Use `create(CourseTitleSuggestionSchema)` to create a new message.
+ */
+export const CourseTitleSuggestionSchema: GenMessage<CourseTitleSuggestion> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 53);
+
+/**
+ * SuggestCourseTitlesResponse returns the suggestions.
+ *
+ * @generated from message mirai.v1.SuggestCourseTitlesResponse
+ */
+export type SuggestCourseTitlesResponse = Message<"mirai.v1.SuggestCourseTitlesResponse"> & {
+  /**
+   * @generated from field: repeated mirai.v1.CourseTitleSuggestion suggestions = 1;
+   */
+  suggestions: CourseTitleSuggestion[];
+
+  /**
+   * @generated from field: int64 tokens_used = 2;
+   */
+  tokensUsed: bigint;
+};
+
+/**
+ * Describes the message mirai.v1.SuggestCourseTitlesResponse.
+ * Use `create(SuggestCourseTitlesResponseSchema)` to create a new message.
+ */
+export const SuggestCourseTitlesResponseSchema: GenMessage<SuggestCourseTitlesResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 54);
+
+/**
+ * GetJobRequest fetches a job by ID.
+ *
+ * @generated from message mirai.v1.GetJobRequest
+ */
+export type GetJobRequest = Message<"mirai.v1.GetJobRequest"> & {
+  /**
+   * @generated from field: string job_id = 1;
+   */
+  jobId: string;
+};
+
+/**
+ * Describes the message mirai.v1.GetJobRequest.
+ * Use `create(GetJobRequestSchema)` to create a new message.
+ */
+export const GetJobRequestSchema: GenMessage<GetJobRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 55);
+
+/**
+ * GetJobResponse contains the job.
+ *
+ * @generated from message mirai.v1.GetJobResponse
+ */
+export type GetJobResponse = Message<"mirai.v1.GetJobResponse"> & {
+  /**
+   * @generated from field: mirai.v1.GenerationJob job = 1;
+   */
+  job?: GenerationJob;
+};
+
+/**
+ * Describes the message mirai.v1.GetJobResponse.
+ * Use `create(GetJobResponseSchema)` to create a new message.
+ */
+export const GetJobResponseSchema: GenMessage<GetJobResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 56);
+
+/**
+ * ListJobsRequest contains filters for jobs.
+ *
+ * @generated from message mirai.v1.ListJobsRequest
+ */
+export type ListJobsRequest = Message<"mirai.v1.ListJobsRequest"> & {
+  /**
+   * @generated from field: optional mirai.v1.GenerationJobType type = 1;
+   */
+  type?: GenerationJobType;
+
+  /**
+   * @generated from field: optional mirai.v1.GenerationJobStatus status = 2;
+   */
+  status?: GenerationJobStatus;
+
+  /**
+   * @generated from field: optional string course_id = 3;
+   */
+  courseId?: string;
+
+  /**
+   * Return only parent and standalone jobs, each with child_counts. Defaults to true;
+   * ignored when parent_job_id is set.
+   *
+   * @generated from field: optional bool exclude_children = 4;
+   */
+  excludeChildren?: boolean;
+
+  /**
+   * Return only the children of this job
+   *
+   * @generated from field: optional string parent_job_id = 5;
+   */
+  parentJobId?: string;
+
+  /**
+   * Max results (default 25, max 100)
+   *
+   * @generated from field: int32 limit = 6;
+   */
+  limit: number;
+
+  /**
+   * For pagination
+   *
+   * @generated from field: optional string cursor = 7;
+   */
+  cursor?: string;
+};
+
+/**
+ * Describes the message mirai.v1.ListJobsRequest.
+ * Use `create(ListJobsRequestSchema)` to create a new message.
+ */
+export const ListJobsRequestSchema: GenMessage<ListJobsRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 57);
+
+/**
+ * ListJobsResponse contains a page of matching jobs, most recent first.
+ *
+ * @generated from message mirai.v1.ListJobsResponse
+ */
+export type ListJobsResponse = Message<"mirai.v1.ListJobsResponse"> & {
+  /**
+   * @generated from field: repeated mirai.v1.GenerationJob jobs = 1;
+   */
+  jobs: GenerationJob[];
+
+  /**
+   * For pagination
+   *
+   * @generated from field: optional string next_cursor = 2;
+   */
+  nextCursor?: string;
+};
+
+/**
+ * Describes the message mirai.v1.ListJobsResponse.
+ * Use `create(ListJobsResponseSchema)` to create a new message.
+ */
+export const ListJobsResponseSchema: GenMessage<ListJobsResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 58);
+
+/**
+ * CancelJobRequest cancels a job.
+ *
+ * @generated from message mirai.v1.CancelJobRequest
+ */
+export type CancelJobRequest = Message<"mirai.v1.CancelJobRequest"> & {
+  /**
+   * @generated from field: string job_id = 1;
+   */
+  jobId: string;
+};
+
+/**
+ * Describes the message mirai.v1.CancelJobRequest.
+ * Use `create(CancelJobRequestSchema)` to create a new message.
+ */
+export const CancelJobRequestSchema: GenMessage<CancelJobRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 59);
+
+/**
+ * CancelJobResponse confirms cancellation.
+ *
+ * @generated from message mirai.v1.CancelJobResponse
+ */
+export type CancelJobResponse = Message<"mirai.v1.CancelJobResponse"> & {
+  /**
+   * @generated from field: mirai.v1.GenerationJob job = 1;
+   */
+  job?: GenerationJob;
+};
+
+/**
+ * Describes the message mirai.v1.CancelJobResponse.
+ * Use `create(CancelJobResponseSchema)` to create a new message.
+ */
+export const CancelJobResponseSchema: GenMessage<CancelJobResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 60);
+
+/**
+ * GetGeneratedLessonRequest fetches generated lesson content.
+ *
+ * @generated from message mirai.v1.GetGeneratedLessonRequest
+ */
+export type GetGeneratedLessonRequest = Message<"mirai.v1.GetGeneratedLessonRequest"> & {
+  /**
+   * @generated from field: string lesson_id = 1;
+   */
+  lessonId: string;
+};
+
+/**
+ * Describes the message mirai.v1.GetGeneratedLessonRequest.
+ * Use `create(GetGeneratedLessonRequestSchema)` to create a new message.
+ */
+export const GetGeneratedLessonRequestSchema: GenMessage<GetGeneratedLessonRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 61);
+
+/**
+ * GetGeneratedLessonResponse contains the lesson.
+ *
+ * @generated from message mirai.v1.GetGeneratedLessonResponse
+ */
+export type GetGeneratedLessonResponse = Message<"mirai.v1.GetGeneratedLessonResponse"> & {
+  /**
+   * @generated from field: mirai.v1.GeneratedLesson lesson = 1;
+   */
+  lesson?: GeneratedLesson;
+};
+
+/**
+ * Describes the message mirai.v1.GetGeneratedLessonResponse.
+ * Use `create(GetGeneratedLessonResponseSchema)` to create a new message.
+ */
+export const GetGeneratedLessonResponseSchema: GenMessage<GetGeneratedLessonResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 62);
+
+/**
+ * ListGeneratedLessonsRequest fetches all lessons for a course.
+ *
+ * @generated from message mirai.v1.ListGeneratedLessonsRequest
+ */
+export type ListGeneratedLessonsRequest = Message<"mirai.v1.ListGeneratedLessonsRequest"> & {
+  /**
+   * @generated from field: string course_id = 1;
+   */
+  courseId: string;
+
+  /**
+   * Also return lessons from removed or superseded outline lessons
+   *
+   * @generated from field: bool include_orphaned = 2;
+   */
+  includeOrphaned: boolean;
+};
+
+/**
+ * Describes the message mirai.v1.ListGeneratedLessonsRequest.
+ * Use `create(ListGeneratedLessonsRequestSchema)` to create a new message.
+ */
+export const ListGeneratedLessonsRequestSchema: GenMessage<ListGeneratedLessonsRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 63);
+
+/**
+ * ListGeneratedLessonsResponse contains the lessons.
+ *
+ * @generated from message mirai.v1.ListGeneratedLessonsResponse
+ */
+export type ListGeneratedLessonsResponse = Message<"mirai.v1.ListGeneratedLessonsResponse"> & {
+  /**
+   * @generated from field: repeated mirai.v1.GeneratedLesson lessons = 1;
+   */
+  lessons: GeneratedLesson[];
+};
+
+/**
+ * Describes the message mirai.v1.ListGeneratedLessonsResponse.
+ * Use `create(ListGeneratedLessonsResponseSchema)` to create a new message.
+ */
+export const ListGeneratedLessonsResponseSchema: GenMessage<ListGeneratedLessonsResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 64);
+
+/**
+ * ContentStats summarizes generated lesson content.
+ *
+ * @generated from message mirai.v1.ContentStats
+ */
+export type ContentStats = Message<"mirai.v1.ContentStats"> & {
+  /**
+   * @generated from field: int32 lesson_count = 1;
+   */
+  lessonCount: number;
+
+  /**
+   * @generated from field: int32 word_count = 2;
+   */
+  wordCount: number;
+
+  /**
+   * @generated from field: double average_words_per_lesson = 3;
+   */
+  averageWordsPerLesson: number;
+
+  /**
+   * @generated from field: int32 estimated_reading_minutes = 4;
+   */
+  estimatedReadingMinutes: number;
+
+  /**
+   * @generated from field: int32 quiz_count = 5;
+   */
+  quizCount: number;
+
+  /**
+   * @generated from field: int32 image_count = 6;
+   */
+  imageCount: number;
+
+  /**
+   * Components whose content could not be parsed; counted as zero words
+   *
+   * @generated from field: int32 malformed_components = 7;
+   */
+  malformedComponents: number;
+
+  /**
+   * @generated from field: int32 video_count = 8;
+   */
+  videoCount: number;
+};
+
+/**
+ * Describes the message mirai.v1.ContentStats.
+ * Use `create(ContentStatsSchema)` to create a new message.
+ */
+export const ContentStatsSchema: GenMessage<ContentStats> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 65);
+
+/**
+ * SectionStats is the content breakdown for one outline section.
+ *
+ * @generated from message mirai.v1.SectionStats
+ */
+export type SectionStats = Message<"mirai.v1.SectionStats"> & {
+  /**
+   * @generated from field: string section_id = 1;
+   */
+  sectionId: string;
+
+  /**
+   * @generated from field: string title = 2;
+   */
+  title: string;
+
+  /**
+   * @generated from field: mirai.v1.ContentStats stats = 3;
+   */
+  stats?: ContentStats;
+};
+
+/**
+ * Describes the message mirai.v1.SectionStats.
+ * Use `create(SectionStatsSchema)` to create a new message.
+ */
+export const SectionStatsSchema: GenMessage<SectionStats> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 66);
+
+/**
+ * GetCourseStatsRequest requests content statistics for a course.
+ *
+ * @generated from message mirai.v1.GetCourseStatsRequest
+ */
+export type GetCourseStatsRequest = Message<"mirai.v1.GetCourseStatsRequest"> & {
+  /**
+   * @generated from field: string course_id = 1;
+   */
+  courseId: string;
+};
+
+/**
+ * Describes the message mirai.v1.GetCourseStatsRequest.
+ * Use `create(GetCourseStatsRequestSchema)` to create a new message.
+ */
+export const GetCourseStatsRequestSchema: GenMessage<GetCourseStatsRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 67);
+
+/**
+ * GetCourseStatsResponse contains course totals and per-section breakdowns.
+ *
+ * @generated from message mirai.v1.GetCourseStatsResponse
+ */
+export type GetCourseStatsResponse = Message<"mirai.v1.GetCourseStatsResponse"> & {
+  /**
+   * @generated from field: mirai.v1.ContentStats totals = 1;
+   */
+  totals?: ContentStats;
+
+  /**
+   * In outline order
+   *
+   * @generated from field: repeated mirai.v1.SectionStats sections = 2;
+   */
+  sections: SectionStats[];
+};
+
+/**
+ * Describes the message mirai.v1.GetCourseStatsResponse.
+ * Use `create(GetCourseStatsResponseSchema)` to create a new message.
+ */
+export const GetCourseStatsResponseSchema: GenMessage<GetCourseStatsResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 68);
+
+/**
+ * GetCoursePlayerViewRequest requests the player view of a course.
+ *
+ * @generated from message mirai.v1.GetCoursePlayerViewRequest
+ */
+export type GetCoursePlayerViewRequest = Message<"mirai.v1.GetCoursePlayerViewRequest"> & {
+  /**
+   * @generated from field: string course_id = 1;
+   */
+  courseId: string;
+
+  /**
+   * Show edits not yet published instead of the published version (editors only)
+   *
+   * @generated from field: bool draft = 2;
+   */
+  draft: boolean;
+};
+
+/**
+ * Describes the message mirai.v1.GetCoursePlayerViewRequest.
+ * Use `create(GetCoursePlayerViewRequestSchema)` to create a new message.
+ */
+export const GetCoursePlayerViewRequestSchema: GenMessage<GetCoursePlayerViewRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 69);
+
+/**
+ * GetCoursePlayerViewResponse contains the player view.
+ *
+ * @generated from message mirai.v1.GetCoursePlayerViewResponse
+ */
+export type GetCoursePlayerViewResponse = Message<"mirai.v1.GetCoursePlayerViewResponse"> & {
+  /**
+   * @generated from field: mirai.v1.CoursePlayerView view = 1;
+   */
+  view?: CoursePlayerView;
+};
+
+/**
+ * Describes the message mirai.v1.GetCoursePlayerViewResponse.
+ * Use `create(GetCoursePlayerViewResponseSchema)` to create a new message.
+ */
+export const GetCoursePlayerViewResponseSchema: GenMessage<GetCoursePlayerViewResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 70);
+
+/**
+ * CoursePlayerView is the learner-facing content of a course. Lessons that haven't been
+ * generated yet are left out.
+ *
+ * @generated from message mirai.v1.CoursePlayerView
+ */
+export type CoursePlayerView = Message<"mirai.v1.CoursePlayerView"> & {
+  /**
+   * @generated from field: string course_id = 1;
+   */
+  courseId: string;
+
+  /**
+   * @generated from field: string title = 2;
+   */
+  title: string;
+
+  /**
+   * @generated from field: int32 outline_version = 3;
+   */
+  outlineVersion: number;
+
+  /**
+   * @generated from field: int32 lesson_count = 4;
+   */
+  lessonCount: number;
+
+  /**
+   * In display order
+   *
+   * @generated from field: repeated mirai.v1.CoursePlayerSection sections = 5;
+   */
+  sections: CoursePlayerSection[];
+
+  /**
+   * Published version shown; 0 when showing the draft
+   *
+   * @generated from field: int32 published_version = 6;
+   */
+  publishedVersion: number;
+};
+
+/**
+ * Describes the message mirai.v1.CoursePlayerView.
+ * Use `create(CoursePlayerViewSchema)` to create a new message.
+ */
+export const CoursePlayerViewSchema: GenMessage<CoursePlayerView> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 71);
+
+/**
+ * CoursePlayerSection is a section of a CoursePlayerView.
+ *
+ * @generated from message mirai.v1.CoursePlayerSection
+ */
+export type CoursePlayerSection = Message<"mirai.v1.CoursePlayerSection"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string title = 2;
+   */
+  title: string;
+
+  /**
+   * @generated from field: string description = 3;
+   */
+  description: string;
+
+  /**
+   * In display order
+   *
+   * @generated from field: repeated mirai.v1.CoursePlayerLesson lessons = 4;
+   */
+  lessons: CoursePlayerLesson[];
+};
+
+/**
+ * Describes the message mirai.v1.CoursePlayerSection.
+ * Use `create(CoursePlayerSectionSchema)` to create a new message.
+ */
+export const CoursePlayerSectionSchema: GenMessage<CoursePlayerSection> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 72);
+
+/**
+ * CoursePlayerLesson is a lesson of a CoursePlayerView.
+ *
+ * @generated from message mirai.v1.CoursePlayerLesson
+ */
+export type CoursePlayerLesson = Message<"mirai.v1.CoursePlayerLesson"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string title = 2;
+   */
+  title: string;
+
+  /**
+   * @generated from field: optional int32 estimated_duration_minutes = 3;
+   */
+  estimatedDurationMinutes?: number;
+
+  /**
+   * In display order
+   *
+   * @generated from field: repeated mirai.v1.CoursePlayerComponent components = 4;
+   */
+  components: CoursePlayerComponent[];
+
+  /**
+   * @generated from field: optional string segue_text = 5;
+   */
+  segueText?: string;
+
+  /**
+   * Across sections; unset on the first lesson
+   *
+   * @generated from field: optional string previous_lesson_id = 6;
+   */
+  previousLessonId?: string;
+
+  /**
+   * Across sections; unset on the last lesson
+   *
+   * @generated from field: optional string next_lesson_id = 7;
+   */
+  nextLessonId?: string;
+};
+
+/**
+ * Describes the message mirai.v1.CoursePlayerLesson.
+ * Use `create(CoursePlayerLessonSchema)` to create a new message.
+ */
+export const CoursePlayerLessonSchema: GenMessage<CoursePlayerLesson> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 73);
+
+/**
+ * CoursePlayerComponent is a lesson component as the player renders it.
+ *
+ * @generated from message mirai.v1.CoursePlayerComponent
+ */
+export type CoursePlayerComponent = Message<"mirai.v1.CoursePlayerComponent"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: mirai.v1.LessonComponentType type = 2;
+   */
+  type: LessonComponentType;
+
+  /**
+   * @generated from field: int32 order = 3;
+   */
+  order: number;
+
+  /**
+   * Uploaded assets are resolved to an asset_url
+   *
+   * @generated from field: string content_json = 4;
+   */
+  contentJson: string;
+};
+
+/**
+ * Describes the message mirai.v1.CoursePlayerComponent.
+ * Use `create(CoursePlayerComponentSchema)` to create a new message.
+ */
+export const CoursePlayerComponentSchema: GenMessage<CoursePlayerComponent> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 74);
+
+/**
+ * GetQueueStatusRequest requests the generation queue status for the caller's tenant.
+ *
+ * @generated from message mirai.v1.GetQueueStatusRequest
+ */
+export type GetQueueStatusRequest = Message<"mirai.v1.GetQueueStatusRequest"> & {
+};
+
+/**
+ * Describes the message mirai.v1.GetQueueStatusRequest.
+ * Use `create(GetQueueStatusRequestSchema)` to create a new message.
+ */
+export const GetQueueStatusRequestSchema: GenMessage<GetQueueStatusRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 75);
+
+/**
+ * JobTypeQueueCount counts a tenant's active jobs of one type.
+ *
+ * @generated from message mirai.v1.JobTypeQueueCount
+ */
+export type JobTypeQueueCount = Message<"mirai.v1.JobTypeQueueCount"> & {
+  /**
+   * @generated from field: mirai.v1.GenerationJobType type = 1;
+   */
+  type: GenerationJobType;
+
+  /**
+   * @generated from field: int32 queued = 2;
+   */
+  queued: number;
+
+  /**
+   * @generated from field: int32 processing = 3;
+   */
+  processing: number;
+};
+
+/**
+ * Describes the message mirai.v1.JobTypeQueueCount.
+ * Use `create(JobTypeQueueCountSchema)` to create a new message.
+ */
+export const JobTypeQueueCountSchema: GenMessage<JobTypeQueueCount> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 76);
+
+/**
+ * GetQueueStatusResponse describes where the tenant's jobs stand.
+ *
+ * @generated from message mirai.v1.GetQueueStatusResponse
+ */
+export type GetQueueStatusResponse = Message<"mirai.v1.GetQueueStatusResponse"> & {
+  /**
+   * Excludes full-course parent jobs
+   *
+   * @generated from field: repeated mirai.v1.JobTypeQueueCount counts = 1;
+   */
+  counts: JobTypeQueueCount[];
+
+  /**
+   * 1-based position of the caller's oldest queued job among all tenants' queued jobs; unset if none queued
+   *
+   * @generated from field: optional int32 queue_position = 2;
+   */
+  queuePosition?: number;
+
+  /**
+   * Jobs processed at once per worker; jobs are not capped per tenant
+   *
+   * @generated from field: int32 worker_concurrency = 3;
+   */
+  workerConcurrency: number;
+
+  /**
+   * Across all tenants over the last 24 hours
+   *
+   * @generated from field: int32 avg_job_duration_seconds = 4;
+   */
+  avgJobDurationSeconds: number;
+
+  /**
+   * Recent jobs are failing on AI provider errors
+   *
+   * @generated from field: bool provider_degraded = 5;
+   */
+  providerDegraded: boolean;
+
+  /**
+   * Support paused the organization's AI generation; queued jobs wait and queue_position is unset
+   *
+   * @generated from field: bool generation_paused = 6;
+   */
+  generationPaused: boolean;
+};
+
+/**
+ * Describes the message mirai.v1.GetQueueStatusResponse.
+ * Use `create(GetQueueStatusResponseSchema)` to create a new message.
+ */
+export const GetQueueStatusResponseSchema: GenMessage<GetQueueStatusResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 77);
+
+/**
+ * JobAnomaly is an inconsistency between generation jobs and course content.
+ *
+ * @generated from message mirai.v1.JobAnomaly
+ */
+export type JobAnomaly = Message<"mirai.v1.JobAnomaly"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string tenant_id = 2;
+   */
+  tenantId: string;
+
+  /**
+   * @generated from field: string job_id = 3;
+   */
+  jobId: string;
+
+  /**
+   * @generated from field: optional string course_id = 4;
+   */
+  courseId?: string;
+
+  /**
+   * @generated from field: mirai.v1.JobAnomalyType type = 5;
+   */
+  type: JobAnomalyType;
+
+  /**
+   * @generated from field: string details = 6;
+   */
+  details: string;
+
+  /**
+   * Fixed automatically when detected
+   *
+   * @generated from field: bool resolved = 7;
+   */
+  resolved: boolean;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp detected_at = 8;
+   */
+  detectedAt?: Timestamp;
+};
+
+/**
+ * Describes the message mirai.v1.JobAnomaly.
+ * Use `create(JobAnomalySchema)` to create a new message.
+ */
+export const JobAnomalySchema: GenMessage<JobAnomaly> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 78);
+
+/**
+ * ListAnomaliesRequest contains filters for anomalies.
+ *
+ * @generated from message mirai.v1.ListAnomaliesRequest
+ */
+export type ListAnomaliesRequest = Message<"mirai.v1.ListAnomaliesRequest"> & {
+  /**
+   * @generated from field: optional string tenant_id = 1;
+   */
+  tenantId?: string;
+
+  /**
+   * @generated from field: optional mirai.v1.JobAnomalyType type = 2;
+   */
+  type?: JobAnomalyType;
+
+  /**
+   * Defaults to 100
+   *
+   * @generated from field: int32 limit = 3;
+   */
+  limit: number;
+};
+
+/**
+ * Describes the message mirai.v1.ListAnomaliesRequest.
+ * Use `create(ListAnomaliesRequestSchema)` to create a new message.
+ */
+export const ListAnomaliesRequestSchema: GenMessage<ListAnomaliesRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 79);
+
+/**
+ * ListAnomaliesResponse contains matching anomalies, most recent first.
+ *
+ * @generated from message mirai.v1.ListAnomaliesResponse
+ */
+export type ListAnomaliesResponse = Message<"mirai.v1.ListAnomaliesResponse"> & {
+  /**
+   * @generated from field: repeated mirai.v1.JobAnomaly anomalies = 1;
+   */
+  anomalies: JobAnomaly[];
+};
+
+/**
+ * Describes the message mirai.v1.ListAnomaliesResponse.
+ * Use `create(ListAnomaliesResponseSchema)` to create a new message.
+ */
+export const ListAnomaliesResponseSchema: GenMessage<ListAnomaliesResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 80);
+
+/**
+ * GenerationDraft holds generation wizard selections that haven't been used to start
+ * generation yet. Drafts untouched for 30 days are deleted.
+ *
+ * @generated from message mirai.v1.GenerationDraft
+ */
+export type GenerationDraft = Message<"mirai.v1.GenerationDraft"> & {
+  /**
+   * preferences are not stored
+   *
+   * @generated from field: mirai.v1.CourseGenerationInput input = 1;
+   */
+  input?: CourseGenerationInput;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 2;
+   */
+  updatedAt?: Timestamp;
+};
+
+/**
+ * Describes the message mirai.v1.GenerationDraft.
+ * Use `create(GenerationDraftSchema)` to create a new message.
+ */
+export const GenerationDraftSchema: GenMessage<GenerationDraft> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 81);
+
+/**
+ * SaveGenerationDraftRequest saves wizard selections for input.course_id.
+ *
+ * @generated from message mirai.v1.SaveGenerationDraftRequest
+ */
+export type SaveGenerationDraftRequest = Message<"mirai.v1.SaveGenerationDraftRequest"> & {
+  /**
+   * @generated from field: mirai.v1.CourseGenerationInput input = 1;
+   */
+  input?: CourseGenerationInput;
+};
+
+/**
+ * Describes the message mirai.v1.SaveGenerationDraftRequest.
+ * Use `create(SaveGenerationDraftRequestSchema)` to create a new message.
+ */
+export const SaveGenerationDraftRequestSchema: GenMessage<SaveGenerationDraftRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 82);
+
+/**
+ * SaveGenerationDraftResponse returns the saved draft.
+ *
+ * @generated from message mirai.v1.SaveGenerationDraftResponse
+ */
+export type SaveGenerationDraftResponse = Message<"mirai.v1.SaveGenerationDraftResponse"> & {
+  /**
+   * @generated from field: mirai.v1.GenerationDraft draft = 1;
+   */
+  draft?: GenerationDraft;
+};
+
+/**
+ * Describes the message mirai.v1.SaveGenerationDraftResponse.
+ * Use `create(SaveGenerationDraftResponseSchema)` to create a new message.
+ */
+export const SaveGenerationDraftResponseSchema: GenMessage<SaveGenerationDraftResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 83);
+
+/**
+ * GetGenerationDraftRequest fetches the draft for a course.
+ *
+ * @generated from message mirai.v1.GetGenerationDraftRequest
+ */
+export type GetGenerationDraftRequest = Message<"mirai.v1.GetGenerationDraftRequest"> & {
+  /**
+   * @generated from field: string course_id = 1;
+   */
+  courseId: string;
+};
+
+/**
+ * Describes the message mirai.v1.GetGenerationDraftRequest.
+ * Use `create(GetGenerationDraftRequestSchema)` to create a new message.
+ */
+export const GetGenerationDraftRequestSchema: GenMessage<GetGenerationDraftRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 84);
+
+/**
+ * GetGenerationDraftResponse contains the draft, unset if there is none.
+ *
+ * @generated from message mirai.v1.GetGenerationDraftResponse
+ */
+export type GetGenerationDraftResponse = Message<"mirai.v1.GetGenerationDraftResponse"> & {
+  /**
+   * @generated from field: optional mirai.v1.GenerationDraft draft = 1;
+   */
+  draft?: GenerationDraft;
+};
+
+/**
+ * Describes the message mirai.v1.GetGenerationDraftResponse.
+ * Use `create(GetGenerationDraftResponseSchema)` to create a new message.
+ */
+export const GetGenerationDraftResponseSchema: GenMessage<GetGenerationDraftResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 85);
+
+/**
+ * StartStorageAuditRequest configures a storage audit.
+ *
+ * @generated from message mirai.v1.StartStorageAuditRequest
+ */
+export type StartStorageAuditRequest = Message<"mirai.v1.StartStorageAuditRequest"> & {
+  /**
+   * Delete the orphaned objects found, not just report them
+   *
+   * @generated from field: bool purge_orphans = 1;
+   */
+  purgeOrphans: boolean;
+};
+
+/**
+ * Describes the message mirai.v1.StartStorageAuditRequest.
+ * Use `create(StartStorageAuditRequestSchema)` to create a new message.
+ */
+export const StartStorageAuditRequestSchema: GenMessage<StartStorageAuditRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 86);
+
+/**
+ * StartStorageAuditResponse returns the audit job.
+ *
+ * @generated from message mirai.v1.StartStorageAuditResponse
+ */
+export type StartStorageAuditResponse = Message<"mirai.v1.StartStorageAuditResponse"> & {
+  /**
+   * @generated from field: mirai.v1.GenerationJob job = 1;
+   */
+  job?: GenerationJob;
+};
+
+/**
+ * Describes the message mirai.v1.StartStorageAuditResponse.
+ * Use `create(StartStorageAuditResponseSchema)` to create a new message.
+ */
+export const StartStorageAuditResponseSchema: GenMessage<StartStorageAuditResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 87);
+
+/**
+ * GetStorageAuditReportRequest identifies a storage audit job.
+ *
+ * @generated from message mirai.v1.GetStorageAuditReportRequest
+ */
+export type GetStorageAuditReportRequest = Message<"mirai.v1.GetStorageAuditReportRequest"> & {
+  /**
+   * @generated from field: string job_id = 1;
+   */
+  jobId: string;
+};
+
+/**
+ * Describes the message mirai.v1.GetStorageAuditReportRequest.
+ * Use `create(GetStorageAuditReportRequestSchema)` to create a new message.
+ */
+export const GetStorageAuditReportRequestSchema: GenMessage<GetStorageAuditReportRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 88);
+
+/**
+ * GetStorageAuditReportResponse contains the audit job and its report link.
+ *
+ * @generated from message mirai.v1.GetStorageAuditReportResponse
+ */
+export type GetStorageAuditReportResponse = Message<"mirai.v1.GetStorageAuditReportResponse"> & {
+  /**
+   * @generated from field: mirai.v1.GenerationJob job = 1;
+   */
+  job?: GenerationJob;
+
+  /**
+   * Set once the job has completed
+   *
+   * @generated from field: optional string download_url = 2;
+   */
+  downloadUrl?: string;
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp expires_at = 3;
+   */
+  expiresAt?: Timestamp;
+};
+
+/**
+ * Describes the message mirai.v1.GetStorageAuditReportResponse.
+ * Use `create(GetStorageAuditReportResponseSchema)` to create a new message.
+ */
+export const GetStorageAuditReportResponseSchema: GenMessage<GetStorageAuditReportResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 89);
+
+/**
+ * TranslateCourseRequest identifies the course to translate.
+ *
+ * @generated from message mirai.v1.TranslateCourseRequest
+ */
+export type TranslateCourseRequest = Message<"mirai.v1.TranslateCourseRequest"> & {
+  /**
+   * @generated from field: string course_id = 1;
+   */
+  courseId: string;
+
+  /**
+   * BCP 47 language tag, such as "es" or "pt-BR"
+   *
+   * @generated from field: string target_language = 2;
+   */
+  targetLanguage: string;
+};
+
+/**
+ * Describes the message mirai.v1.TranslateCourseRequest.
+ * Use `create(TranslateCourseRequestSchema)` to create a new message.
+ */
+export const TranslateCourseRequestSchema: GenMessage<TranslateCourseRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 90);
+
+/**
+ * TranslateCourseResponse returns the translation job and the course it fills.
+ *
+ * @generated from message mirai.v1.TranslateCourseResponse
+ */
+export type TranslateCourseResponse = Message<"mirai.v1.TranslateCourseResponse"> & {
+  /**
+   * @generated from field: mirai.v1.GenerationJob job = 1;
+   */
+  job?: GenerationJob;
+
+  /**
+   * The new translated course
+   *
+   * @generated from field: string course_id = 2;
+   */
+  courseId: string;
+};
+
+/**
+ * Describes the message mirai.v1.TranslateCourseResponse.
+ * Use `create(TranslateCourseResponseSchema)` to create a new message.
+ */
+export const TranslateCourseResponseSchema: GenMessage<TranslateCourseResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 91);
+
+/**
+ * OutlineComment is a reviewer comment on an outline lesson.
+ *
+ * @generated from message mirai.v1.OutlineComment
+ */
+export type OutlineComment = Message<"mirai.v1.OutlineComment"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string course_id = 2;
+   */
+  courseId: string;
+
+  /**
+   * Matches OutlineLesson.lesson_key in every outline version
+   *
+   * @generated from field: string lesson_key = 3;
+   */
+  lessonKey: string;
+
+  /**
+   * @generated from field: optional string author_user_id = 4;
+   */
+  authorUserId?: string;
+
+  /**
+   * @generated from field: string author_name = 5;
+   */
+  authorName: string;
+
+  /**
+   * @generated from field: string body = 6;
+   */
+  body: string;
+
+  /**
+   * @generated from field: bool resolved = 7;
+   */
+  resolved: boolean;
+
+  /**
+   * @generated from field: optional string resolved_by_user_id = 8;
+   */
+  resolvedByUserId?: string;
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp resolved_at = 9;
+   */
+  resolvedAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 10;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message mirai.v1.OutlineComment.
+ * Use `create(OutlineCommentSchema)` to create a new message.
+ */
+export const OutlineCommentSchema: GenMessage<OutlineComment> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 92);
+
+/**
+ * CreateOutlineCommentRequest identifies the lesson to comment on.
+ *
+ * @generated from message mirai.v1.CreateOutlineCommentRequest
+ */
+export type CreateOutlineCommentRequest = Message<"mirai.v1.CreateOutlineCommentRequest"> & {
+  /**
+   * @generated from field: string course_id = 1;
+   */
+  courseId: string;
+
+  /**
+   * Outline lesson ID
+   *
+   * @generated from field: string lesson_id = 2;
+   */
+  lessonId: string;
+
+  /**
+   * @generated from field: string body = 3;
+   */
+  body: string;
+};
+
+/**
+ * Describes the message mirai.v1.CreateOutlineCommentRequest.
+ * Use `create(CreateOutlineCommentRequestSchema)` to create a new message.
+ */
+export const CreateOutlineCommentRequestSchema: GenMessage<CreateOutlineCommentRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 93);
+
+/**
+ * CreateOutlineCommentResponse returns the new comment.
+ *
+ * @generated from message mirai.v1.CreateOutlineCommentResponse
+ */
+export type CreateOutlineCommentResponse = Message<"mirai.v1.CreateOutlineCommentResponse"> & {
+  /**
+   * @generated from field: mirai.v1.OutlineComment comment = 1;
+   */
+  comment?: OutlineComment;
+};
+
+/**
+ * Describes the message mirai.v1.CreateOutlineCommentResponse.
+ * Use `create(CreateOutlineCommentResponseSchema)` to create a new message.
+ */
+export const CreateOutlineCommentResponseSchema: GenMessage<CreateOutlineCommentResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 94);
+
+/**
+ * ListOutlineCommentsRequest identifies the course whose comments to list.
+ *
+ * @generated from message mirai.v1.ListOutlineCommentsRequest
+ */
+export type ListOutlineCommentsRequest = Message<"mirai.v1.ListOutlineCommentsRequest"> & {
+  /**
+   * @generated from field: string course_id = 1;
+   */
+  courseId: string;
+
+  /**
+   * @generated from field: bool include_resolved = 2;
+   */
+  includeResolved: boolean;
+};
+
+/**
+ * Describes the message mirai.v1.ListOutlineCommentsRequest.
+ * Use `create(ListOutlineCommentsRequestSchema)` to create a new message.
+ */
+export const ListOutlineCommentsRequestSchema: GenMessage<ListOutlineCommentsRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 95);
+
+/**
+ * ListOutlineCommentsResponse contains the comments, oldest first.
+ *
+ * @generated from message mirai.v1.ListOutlineCommentsResponse
+ */
+export type ListOutlineCommentsResponse = Message<"mirai.v1.ListOutlineCommentsResponse"> & {
+  /**
+   * @generated from field: repeated mirai.v1.OutlineComment comments = 1;
+   */
+  comments: OutlineComment[];
+};
+
+/**
+ * Describes the message mirai.v1.ListOutlineCommentsResponse.
+ * Use `create(ListOutlineCommentsResponseSchema)` to create a new message.
+ */
+export const ListOutlineCommentsResponseSchema: GenMessage<ListOutlineCommentsResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 96);
+
+/**
+ * ResolveOutlineCommentRequest identifies the comment to resolve.
+ *
+ * @generated from message mirai.v1.ResolveOutlineCommentRequest
+ */
+export type ResolveOutlineCommentRequest = Message<"mirai.v1.ResolveOutlineCommentRequest"> & {
+  /**
+   * @generated from field: string comment_id = 1;
+   */
+  commentId: string;
+};
+
+/**
+ * Describes the message mirai.v1.ResolveOutlineCommentRequest.
+ * Use `create(ResolveOutlineCommentRequestSchema)` to create a new message.
+ */
+export const ResolveOutlineCommentRequestSchema: GenMessage<ResolveOutlineCommentRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 97);
+
+/**
+ * ResolveOutlineCommentResponse returns the resolved comment.
+ *
+ * @generated from message mirai.v1.ResolveOutlineCommentResponse
+ */
+export type ResolveOutlineCommentResponse = Message<"mirai.v1.ResolveOutlineCommentResponse"> & {
+  /**
+   * @generated from field: mirai.v1.OutlineComment comment = 1;
+   */
+  comment?: OutlineComment;
+};
+
+/**
+ * Describes the message mirai.v1.ResolveOutlineCommentResponse.
+ * Use `create(ResolveOutlineCommentResponseSchema)` to create a new message.
+ */
+export const ResolveOutlineCommentResponseSchema: GenMessage<ResolveOutlineCommentResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 98);
+
+/**
+ * SetTenantAIEnabledRequest selects the tenant and whether it may generate.
+ *
+ * @generated from message mirai.v1.SetTenantAIEnabledRequest
+ */
+export type SetTenantAIEnabledRequest = Message<"mirai.v1.SetTenantAIEnabledRequest"> & {
+  /**
+   * @generated from field: string tenant_id = 1;
+   */
+  tenantId: string;
+
+  /**
+   * @generated from field: bool enabled = 2;
+   */
+  enabled: boolean;
+
+  /**
+   * Required to pause; recorded in the tenant's audit log
+   *
+   * @generated from field: string reason = 3;
+   */
+  reason: string;
+};
+
+/**
+ * Describes the message mirai.v1.SetTenantAIEnabledRequest.
+ * Use `create(SetTenantAIEnabledRequestSchema)` to create a new message.
+ */
+export const SetTenantAIEnabledRequestSchema: GenMessage<SetTenantAIEnabledRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 99);
+
+/**
+ * SetTenantAIEnabledResponse reports the tenant's new state.
+ *
+ * @generated from message mirai.v1.SetTenantAIEnabledResponse
+ */
+export type SetTenantAIEnabledResponse = Message<"mirai.v1.SetTenantAIEnabledResponse"> & {
+  /**
+   * @generated from field: bool enabled = 1;
+   */
+  enabled: boolean;
+
+  /**
+   * Jobs waiting while paused, or sent back to the worker on resume
+   *
+   * @generated from field: int32 queued_jobs = 2;
+   */
+  queuedJobs: number;
+};
+
+/**
+ * Describes the message mirai.v1.SetTenantAIEnabledResponse.
+ * Use `create(SetTenantAIEnabledResponseSchema)` to create a new message.
+ */
+export const SetTenantAIEnabledResponseSchema: GenMessage<SetTenantAIEnabledResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 100);
+
+/**
+ * AccessibilityIssue is an accessibility problem in one lesson component.
+ *
+ * @generated from message mirai.v1.AccessibilityIssue
+ */
+export type AccessibilityIssue = Message<"mirai.v1.AccessibilityIssue"> & {
+  /**
+   * @generated from field: mirai.v1.AccessibilityIssueType type = 1;
+   */
+  type: AccessibilityIssueType;
+
+  /**
+   * @generated from field: string lesson_id = 2;
+   */
+  lessonId: string;
+
+  /**
+   * @generated from field: string lesson_title = 3;
+   */
+  lessonTitle: string;
+
+  /**
+   * @generated from field: string component_id = 4;
+   */
+  componentId: string;
+
+  /**
+   * @generated from field: int32 position = 5;
+   */
+  position: number;
+
+  /**
+   * @generated from field: string detail = 6;
+   */
+  detail: string;
+
+  /**
+   * Generation already tried; the author has to write the alt text
+   *
+   * @generated from field: bool alt_text_generation_failed = 7;
+   */
+  altTextGenerationFailed: boolean;
+};
+
+/**
+ * Describes the message mirai.v1.AccessibilityIssue.
+ * Use `create(AccessibilityIssueSchema)` to create a new message.
+ */
+export const AccessibilityIssueSchema: GenMessage<AccessibilityIssue> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 101);
+
+/**
+ * GetAccessibilityReportRequest identifies the course to check.
+ *
+ * @generated from message mirai.v1.GetAccessibilityReportRequest
+ */
+export type GetAccessibilityReportRequest = Message<"mirai.v1.GetAccessibilityReportRequest"> & {
+  /**
+   * @generated from field: string course_id = 1;
+   */
+  courseId: string;
+};
+
+/**
+ * Describes the message mirai.v1.GetAccessibilityReportRequest.
+ * Use `create(GetAccessibilityReportRequestSchema)` to create a new message.
+ */
+export const GetAccessibilityReportRequestSchema: GenMessage<GetAccessibilityReportRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 102);
+
+/**
+ * GetAccessibilityReportResponse lists the problems found in the course's draft lessons.
+ *
+ * @generated from message mirai.v1.GetAccessibilityReportResponse
+ */
+export type GetAccessibilityReportResponse = Message<"mirai.v1.GetAccessibilityReportResponse"> & {
+  /**
+   * By lesson in display order, then component position
+   *
+   * @generated from field: repeated mirai.v1.AccessibilityIssue issues = 1;
+   */
+  issues: AccessibilityIssue[];
+
+  /**
+   * @generated from field: int32 lessons_checked = 2;
+   */
+  lessonsChecked: number;
+
+  /**
+   * @generated from field: int32 components_checked = 3;
+   */
+  componentsChecked: number;
+};
+
+/**
+ * Describes the message mirai.v1.GetAccessibilityReportResponse.
+ * Use `create(GetAccessibilityReportResponseSchema)` to create a new message.
+ */
+export const GetAccessibilityReportResponseSchema: GenMessage<GetAccessibilityReportResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 103);
+
+/**
+ * OutlineBalanceChange is one split or merge in a balancing proposal.
+ *
+ * @generated from message mirai.v1.OutlineBalanceChange
+ */
+export type OutlineBalanceChange = Message<"mirai.v1.OutlineBalanceChange"> & {
+  /**
+   * @generated from field: mirai.v1.OutlineBalanceChangeKind kind = 1;
+   */
+  kind: OutlineBalanceChangeKind;
+
+  /**
+   * @generated from field: string section_id = 2;
+   */
+  sectionId: string;
+
+  /**
+   * The lesson split, or the two lessons merged
+   *
+   * @generated from field: repeated string lesson_ids = 3;
+   */
+  lessonIds: string[];
+
+  /**
+   * Titles of the resulting lessons
+   *
+   * @generated from field: repeated string titles = 4;
+   */
+  titles: string[];
+};
+
+/**
+ * Describes the message mirai.v1.OutlineBalanceChange.
+ * Use `create(OutlineBalanceChangeSchema)` to create a new message.
+ */
+export const OutlineBalanceChangeSchema: GenMessage<OutlineBalanceChange> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 104);
+
+/**
+ * BalanceOutlineDurationsRequest identifies the outline and the target per-lesson range.
+ *
+ * @generated from message mirai.v1.BalanceOutlineDurationsRequest
+ */
+export type BalanceOutlineDurationsRequest = Message<"mirai.v1.BalanceOutlineDurationsRequest"> & {
+  /**
+   * @generated from field: string course_id = 1;
+   */
+  courseId: string;
+
+  /**
+   * @generated from field: string outline_id = 2;
+   */
+  outlineId: string;
+
+  /**
+   * 0 = 10
+   *
+   * @generated from field: int32 min_lesson_minutes = 3;
+   */
+  minLessonMinutes: number;
+
+  /**
+   * 0 = 30; must be at least twice the minimum
+   *
+   * @generated from field: int32 max_lesson_minutes = 4;
+   */
+  maxLessonMinutes: number;
+};
+
+/**
+ * Describes the message mirai.v1.BalanceOutlineDurationsRequest.
+ * Use `create(BalanceOutlineDurationsRequestSchema)` to create a new message.
+ */
+export const BalanceOutlineDurationsRequestSchema: GenMessage<BalanceOutlineDurationsRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 105);
+
+/**
+ * BalanceOutlineDurationsResponse is the proposed revision. Split lessons keep their id for
+ * the first half; the second half has an empty id. Merged lessons keep the first lesson's id.
+ *
+ * @generated from message mirai.v1.BalanceOutlineDurationsResponse
+ */
+export type BalanceOutlineDurationsResponse = Message<"mirai.v1.BalanceOutlineDurationsResponse"> & {
+  /**
+   * The whole outline as revised
+   *
+   * @generated from field: repeated mirai.v1.OutlineSection sections = 1;
+   */
+  sections: OutlineSection[];
+
+  /**
+   * @generated from field: repeated string removed_lesson_ids = 2;
+   */
+  removedLessonIds: string[];
+
+  /**
+   * Empty when the outline is already balanced
+   *
+   * @generated from field: repeated mirai.v1.OutlineBalanceChange changes = 3;
+   */
+  changes: OutlineBalanceChange[];
+
+  /**
+   * @generated from field: int32 min_lesson_minutes = 4;
+   */
+  minLessonMinutes: number;
+
+  /**
+   * @generated from field: int32 max_lesson_minutes = 5;
+   */
+  maxLessonMinutes: number;
+
+  /**
+   * @generated from field: int64 tokens_used = 6;
+   */
+  tokensUsed: bigint;
+};
+
+/**
+ * Describes the message mirai.v1.BalanceOutlineDurationsResponse.
+ * Use `create(BalanceOutlineDurationsResponseSchema)` to create a new message.
+ */
+export const BalanceOutlineDurationsResponseSchema: GenMessage<BalanceOutlineDurationsResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 106);
+
+/**
+ * JobChildCounts counts a parent job's child jobs by status.
+ *
+ * @generated from message mirai.v1.JobChildCounts
+ */
+export type JobChildCounts = Message<"mirai.v1.JobChildCounts"> & {
+  /**
+   * @generated from field: int32 total = 1;
+   */
+  total: number;
+
+  /**
+   * @generated from field: int32 completed = 2;
+   */
+  completed: number;
+
+  /**
+   * @generated from field: int32 failed = 3;
+   */
+  failed: number;
+
+  /**
+   * @generated from field: int32 processing = 4;
+   */
+  processing: number;
+};
+
+/**
+ * Describes the message mirai.v1.JobChildCounts.
+ * Use `create(JobChildCountsSchema)` to create a new message.
+ */
+export const JobChildCountsSchema: GenMessage<JobChildCounts> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 107);
+
+/**
+ * MigrateQuizSchemaRequest is empty.
+ *
+ * @generated from message mirai.v1.MigrateQuizSchemaRequest
+ */
+export type MigrateQuizSchemaRequest = Message<"mirai.v1.MigrateQuizSchemaRequest"> & {
+};
+
+/**
+ * Describes the message mirai.v1.MigrateQuizSchemaRequest.
+ * Use `create(MigrateQuizSchemaRequestSchema)` to create a new message.
+ */
+export const MigrateQuizSchemaRequestSchema: GenMessage<MigrateQuizSchemaRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 108);
+
+/**
+ * MigrateQuizSchemaResponse is empty; the migration runs on the worker.
+ *
+ * @generated from message mirai.v1.MigrateQuizSchemaResponse
+ */
+export type MigrateQuizSchemaResponse = Message<"mirai.v1.MigrateQuizSchemaResponse"> & {
+};
+
+/**
+ * Describes the message mirai.v1.MigrateQuizSchemaResponse.
+ * Use `create(MigrateQuizSchemaResponseSchema)` to create a new message.
+ */
+export const MigrateQuizSchemaResponseSchema: GenMessage<MigrateQuizSchemaResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 109);
+
+/**
+ * GenerationJobType represents the type of AI generation job.
+ *
+ * @generated from enum mirai.v1.GenerationJobType
+ */
+export enum GenerationJobType {
+  /**
+   * @generated from enum value: GENERATION_JOB_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Process SME content submissions
+   *
+   * @generated from enum value: GENERATION_JOB_TYPE_SME_INGESTION = 1;
+   */
+  SME_INGESTION = 1,
+
+  /**
+   * Generate course outline
+   *
+   * @generated from enum value: GENERATION_JOB_TYPE_COURSE_OUTLINE = 2;
+   */
+  COURSE_OUTLINE = 2,
+
+  /**
+   * Generate content for a lesson
+   *
+   * @generated from enum value: GENERATION_JOB_TYPE_LESSON_CONTENT = 3;
+   */
+  LESSON_CONTENT = 3,
+
+  /**
+   * Regenerate single component
+   *
+   * @generated from enum value: GENERATION_JOB_TYPE_COMPONENT_REGEN = 4;
+   */
+  COMPONENT_REGEN = 4,
+
+  /**
+   * Parent job tracking all lesson generation
+   *
+   * @generated from enum value: GENERATION_JOB_TYPE_FULL_COURSE = 5;
+   */
+  FULL_COURSE = 5,
+
+  /**
+   * Export all generated lessons as a ZIP
+   *
+   * @generated from enum value: GENERATION_JOB_TYPE_LESSONS_EXPORT = 6;
+   */
+  LESSONS_EXPORT = 6,
+
+  /**
+   * Export an SME's knowledge to an archive
+   *
+   * @generated from enum value: GENERATION_JOB_TYPE_SME_KNOWLEDGE_EXPORT = 7;
+   */
+  SME_KNOWLEDGE_EXPORT = 7,
+
+  /**
+   * Import an SME knowledge archive
+   *
+   * @generated from enum value: GENERATION_JOB_TYPE_SME_KNOWLEDGE_IMPORT = 8;
+   */
+  SME_KNOWLEDGE_IMPORT = 8,
+
+  /**
+   * Report (and optionally delete) orphaned storage objects
+   *
+   * @generated from enum value: GENERATION_JOB_TYPE_STORAGE_AUDIT = 9;
+   */
+  STORAGE_AUDIT = 9,
+
+  /**
+   * Translate a course into a new course in another language
+   *
+   * @generated from enum value: GENERATION_JOB_TYPE_COURSE_TRANSLATION = 10;
+   */
+  COURSE_TRANSLATION = 10,
+}
+
+/**
+ * Describes the enum mirai.v1.GenerationJobType.
+ */
+export const GenerationJobTypeSchema: GenEnum<GenerationJobType> = /*@__PURE__*/
+  enumDesc(file_mirai_v1_ai_generation, 0);
+
+/**
+ * GenerationJobStatus represents job state.
+ *
+ * @generated from enum mirai.v1.GenerationJobStatus
+ */
+export enum GenerationJobStatus {
+  /**
+   * @generated from enum value: GENERATION_JOB_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: GENERATION_JOB_STATUS_QUEUED = 1;
+   */
+  QUEUED = 1,
+
+  /**
+   * @generated from enum value: GENERATION_JOB_STATUS_PROCESSING = 2;
+   */
+  PROCESSING = 2,
+
+  /**
+   * @generated from enum value: GENERATION_JOB_STATUS_COMPLETED = 3;
+   */
+  COMPLETED = 3,
+
+  /**
+   * @generated from enum value: GENERATION_JOB_STATUS_FAILED = 4;
+   */
+  FAILED = 4,
+
+  /**
+   * @generated from enum value: GENERATION_JOB_STATUS_CANCELLED = 5;
+   */
+  CANCELLED = 5,
+}
+
+/**
+ * Describes the enum mirai.v1.GenerationJobStatus.
+ */
+export const GenerationJobStatusSchema: GenEnum<GenerationJobStatus> = /*@__PURE__*/
+  enumDesc(file_mirai_v1_ai_generation, 1);
+
+/**
+ * OutlineApprovalStatus for generated content review.
+ *
+ * @generated from enum mirai.v1.OutlineApprovalStatus
+ */
+export enum OutlineApprovalStatus {
+  /**
+   * @generated from enum value: OUTLINE_APPROVAL_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: OUTLINE_APPROVAL_STATUS_PENDING_REVIEW = 1;
+   */
+  PENDING_REVIEW = 1,
+
+  /**
+   * @generated from enum value: OUTLINE_APPROVAL_STATUS_APPROVED = 2;
+   */
+  APPROVED = 2,
+
+  /**
+   * @generated from enum value: OUTLINE_APPROVAL_STATUS_REJECTED = 3;
+   */
+  REJECTED = 3,
+
+  /**
+   * @generated from enum value: OUTLINE_APPROVAL_STATUS_REVISION_REQUESTED = 4;
+   */
+  REVISION_REQUESTED = 4,
+}
+
+/**
+ * Describes the enum mirai.v1.OutlineApprovalStatus.
+ */
+export const OutlineApprovalStatusSchema: GenEnum<OutlineApprovalStatus> = /*@__PURE__*/
+  enumDesc(file_mirai_v1_ai_generation, 2);
+
+/**
+ * LessonComponentType - content block types for lessons.
+ * MVP: Text, Heading, Image, Quiz, Video. Expand later.
+ *
+ * @generated from enum mirai.v1.LessonComponentType
+ */
+export enum LessonComponentType {
+  /**
+   * @generated from enum value: LESSON_COMPONENT_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: LESSON_COMPONENT_TYPE_TEXT = 1;
+   */
+  TEXT = 1,
+
+  /**
+   * @generated from enum value: LESSON_COMPONENT_TYPE_HEADING = 2;
+   */
+  HEADING = 2,
+
+  /**
+   * @generated from enum value: LESSON_COMPONENT_TYPE_IMAGE = 3;
+   */
+  IMAGE = 3,
+
+  /**
+   * @generated from enum value: LESSON_COMPONENT_TYPE_QUIZ = 4;
+   */
+  QUIZ = 4,
+
+  /**
+   * Scripted placeholder; the file is attached later
+   *
+   * @generated from enum value: LESSON_COMPONENT_TYPE_VIDEO = 5;
+   */
+  VIDEO = 5,
+}
+
+/**
+ * Describes the enum mirai.v1.LessonComponentType.
+ */
+export const LessonComponentTypeSchema: GenEnum<LessonComponentType> = /*@__PURE__*/
+  enumDesc(file_mirai_v1_ai_generation, 3);
+
+/**
+ * OutlineExportFormat is the document format for outline exports.
+ *
+ * @generated from enum mirai.v1.OutlineExportFormat
+ */
+export enum OutlineExportFormat {
+  /**
+   * @generated from enum value: OUTLINE_EXPORT_FORMAT_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * One row per lesson
+   *
+   * @generated from enum value: OUTLINE_EXPORT_FORMAT_CSV = 1;
+   */
+  CSV = 1,
+
+  /**
+   * Word document with section and lesson headings
+   *
+   * @generated from enum value: OUTLINE_EXPORT_FORMAT_DOCX = 2;
+   */
+  DOCX = 2,
+}
+
+/**
+ * Describes the enum mirai.v1.OutlineExportFormat.
+ */
+export const OutlineExportFormatSchema: GenEnum<OutlineExportFormat> = /*@__PURE__*/
+  enumDesc(file_mirai_v1_ai_generation, 4);
+
+/**
+ * JobAnomalyType identifies an inconsistency found by the generation consistency sweep.
+ *
+ * @generated from enum mirai.v1.JobAnomalyType
+ */
+export enum JobAnomalyType {
+  /**
+   * @generated from enum value: JOB_ANOMALY_TYPE_UNSPECIFIED = 0;
    */
-  COURSE_OUTLINE = 2,
+  UNSPECIFIED = 0,
 
   /**
-   * Generate content for a lesson
+   * Parent still open after all children ended
    *
-   * @generated from enum value: GENERATION_JOB_TYPE_LESSON_CONTENT = 3;
+   * @generated from enum value: JOB_ANOMALY_TYPE_PARENT_NOT_FINALIZED = 1;
    */
-  LESSON_CONTENT = 3,
+  PARENT_NOT_FINALIZED = 1,
 
   /**
-   * Regenerate single component
+   * Parent open with no child jobs, or fewer than it queued
    *
-   * @generated from enum value: GENERATION_JOB_TYPE_COMPONENT_REGEN = 4;
+   * @generated from enum value: JOB_ANOMALY_TYPE_PARENT_MISSING_CHILDREN = 2;
    */
-  COMPONENT_REGEN = 4,
+  PARENT_MISSING_CHILDREN = 2,
 
   /**
-   * Parent job tracking all lesson generation
+   * Parent completed but course has no lessons
    *
-   * @generated from enum value: GENERATION_JOB_TYPE_FULL_COURSE = 5;
+   * @generated from enum value: JOB_ANOMALY_TYPE_COMPLETED_WITHOUT_LESSONS = 3;
    */
-  FULL_COURSE = 5,
+  COMPLETED_WITHOUT_LESSONS = 3,
 }
 
 /**
- * Describes the enum mirai.v1.GenerationJobType.
+ * Describes the enum mirai.v1.JobAnomalyType.
  */
-export const GenerationJobTypeSchema: GenEnum<GenerationJobType> = /*@__PURE__*/
-  enumDesc(file_mirai_v1_ai_generation, 0);
+export const JobAnomalyTypeSchema: GenEnum<JobAnomalyType> = /*@__PURE__*/
+  enumDesc(file_mirai_v1_ai_generation, 5);
 
 /**
- * GenerationJobStatus represents job state.
+ * HeadingLevel for heading components.
  *
- * @generated from enum mirai.v1.GenerationJobStatus
+ * @generated from enum mirai.v1.HeadingLevel
  */
-export enum GenerationJobStatus {
+export enum HeadingLevel {
   /**
-   * @generated from enum value: GENERATION_JOB_STATUS_UNSPECIFIED = 0;
+   * @generated from enum value: HEADING_LEVEL_UNSPECIFIED = 0;
    */
   UNSPECIFIED = 0,
 
   /**
-   * @generated from enum value: GENERATION_JOB_STATUS_QUEUED = 1;
-   */
-  QUEUED = 1,
-
-  /**
-   * @generated from enum value: GENERATION_JOB_STATUS_PROCESSING = 2;
+   * @generated from enum value: HEADING_LEVEL_H1 = 1;
    */
-  PROCESSING = 2,
+  H1 = 1,
 
   /**
-   * @generated from enum value: GENERATION_JOB_STATUS_COMPLETED = 3;
+   * @generated from enum value: HEADING_LEVEL_H2 = 2;
    */
-  COMPLETED = 3,
+  H2 = 2,
 
   /**
-   * @generated from enum value: GENERATION_JOB_STATUS_FAILED = 4;
+   * @generated from enum value: HEADING_LEVEL_H3 = 3;
    */
-  FAILED = 4,
+  H3 = 3,
 
   /**
-   * @generated from enum value: GENERATION_JOB_STATUS_CANCELLED = 5;
+   * @generated from enum value: HEADING_LEVEL_H4 = 4;
    */
-  CANCELLED = 5,
+  H4 = 4,
 }
 
 /**
- * Describes the enum mirai.v1.GenerationJobStatus.
+ * Describes the enum mirai.v1.HeadingLevel.
  */
-export const GenerationJobStatusSchema: GenEnum<GenerationJobStatus> = /*@__PURE__*/
-  enumDesc(file_mirai_v1_ai_generation, 1);
+export const HeadingLevelSchema: GenEnum<HeadingLevel> = /*@__PURE__*/
+  enumDesc(file_mirai_v1_ai_generation, 6);
 
 /**
- * OutlineApprovalStatus for generated content review.
+ * JobFailureReason classifies why a generation job failed.
  *
- * @generated from enum mirai.v1.OutlineApprovalStatus
+ * @generated from enum mirai.v1.JobFailureReason
  */
-export enum OutlineApprovalStatus {
+export enum JobFailureReason {
   /**
-   * @generated from enum value: OUTLINE_APPROVAL_STATUS_UNSPECIFIED = 0;
+   * @generated from enum value: JOB_FAILURE_REASON_UNSPECIFIED = 0;
    */
   UNSPECIFIED = 0,
 
   /**
-   * @generated from enum value: OUTLINE_APPROVAL_STATUS_PENDING_REVIEW = 1;
+   * API key missing, invalid or not permitted
+   *
+   * @generated from enum value: JOB_FAILURE_REASON_PROVIDER_AUTH = 1;
    */
-  PENDING_REVIEW = 1,
+  PROVIDER_AUTH = 1,
 
   /**
-   * @generated from enum value: OUTLINE_APPROVAL_STATUS_APPROVED = 2;
+   * Provider kept throttling or the key is out of quota
+   *
+   * @generated from enum value: JOB_FAILURE_REASON_PROVIDER_RATE_LIMIT = 2;
    */
-  APPROVED = 2,
+  PROVIDER_RATE_LIMIT = 2,
 
   /**
-   * @generated from enum value: OUTLINE_APPROVAL_STATUS_REJECTED = 3;
+   * Provider did not answer in time
+   *
+   * @generated from enum value: JOB_FAILURE_REASON_PROVIDER_TIMEOUT = 3;
    */
-  REJECTED = 3,
+  PROVIDER_TIMEOUT = 3,
 
   /**
-   * @generated from enum value: OUTLINE_APPROVAL_STATUS_REVISION_REQUESTED = 4;
+   * AI response unusable even after repair
+   *
+   * @generated from enum value: JOB_FAILURE_REASON_INVALID_OUTPUT = 4;
    */
-  REVISION_REQUESTED = 4,
+  INVALID_OUTPUT = 4,
+
+  /**
+   * No SME knowledge to generate from
+   *
+   * @generated from enum value: JOB_FAILURE_REASON_MISSING_KNOWLEDGE = 5;
+   */
+  MISSING_KNOWLEDGE = 5,
+
+  /**
+   * Monthly token limit reached
+   *
+   * @generated from enum value: JOB_FAILURE_REASON_BUDGET_EXCEEDED = 6;
+   */
+  BUDGET_EXCEEDED = 6,
+
+  /**
+   * @generated from enum value: JOB_FAILURE_REASON_INTERNAL = 7;
+   */
+  INTERNAL = 7,
 }
 
 /**
- * Describes the enum mirai.v1.OutlineApprovalStatus.
+ * Describes the enum mirai.v1.JobFailureReason.
  */
-export const OutlineApprovalStatusSchema: GenEnum<OutlineApprovalStatus> = /*@__PURE__*/
-  enumDesc(file_mirai_v1_ai_generation, 2);
+export const JobFailureReasonSchema: GenEnum<JobFailureReason> = /*@__PURE__*/
+  enumDesc(file_mirai_v1_ai_generation, 7);
 
 /**
- * LessonComponentType - content block types for lessons.
- * MVP: Text, Heading, Image, Quiz. Expand later.
+ * QuizFrequency controls which lessons get a knowledge check quiz.
  *
- * @generated from enum mirai.v1.LessonComponentType
+ * @generated from enum mirai.v1.QuizFrequency
  */
-export enum LessonComponentType {
+export enum QuizFrequency {
   /**
-   * @generated from enum value: LESSON_COMPONENT_TYPE_UNSPECIFIED = 0;
+   * @generated from enum value: QUIZ_FREQUENCY_UNSPECIFIED = 0;
    */
   UNSPECIFIED = 0,
 
   /**
-   * @generated from enum value: LESSON_COMPONENT_TYPE_TEXT = 1;
-   */
-  TEXT = 1,
-
-  /**
-   * @generated from enum value: LESSON_COMPONENT_TYPE_HEADING = 2;
+   * @generated from enum value: QUIZ_FREQUENCY_EVERY_LESSON = 1;
    */
-  HEADING = 2,
+  EVERY_LESSON = 1,
 
   /**
-   * @generated from enum value: LESSON_COMPONENT_TYPE_IMAGE = 3;
+   * Last lesson of each section
+   *
+   * @generated from enum value: QUIZ_FREQUENCY_END_OF_SECTION = 2;
    */
-  IMAGE = 3,
+  END_OF_SECTION = 2,
 
   /**
-   * Future expansion:
-   * LESSON_COMPONENT_TYPE_VIDEO = 5;
-   * LESSON_COMPONENT_TYPE_VIDEO_EMBED = 6;
-   * LESSON_COMPONENT_TYPE_ACCORDION = 7;
-   * LESSON_COMPONENT_TYPE_TABLE = 8;
-   * LESSON_COMPONENT_TYPE_CALLOUT = 9;
-   * LESSON_COMPONENT_TYPE_CODE_BLOCK = 10;
-   * LESSON_COMPONENT_TYPE_GALLERY = 11;
-   * LESSON_COMPONENT_TYPE_TABS = 12;
+   * Final lesson only
    *
-   * @generated from enum value: LESSON_COMPONENT_TYPE_QUIZ = 4;
+   * @generated from enum value: QUIZ_FREQUENCY_END_OF_COURSE = 3;
    */
-  QUIZ = 4,
+  END_OF_COURSE = 3,
 }
 
 /**
- * Describes the enum mirai.v1.LessonComponentType.
+ * Describes the enum mirai.v1.QuizFrequency.
  */
-export const LessonComponentTypeSchema: GenEnum<LessonComponentType> = /*@__PURE__*/
-  enumDesc(file_mirai_v1_ai_generation, 3);
+export const QuizFrequencySchema: GenEnum<QuizFrequency> = /*@__PURE__*/
+  enumDesc(file_mirai_v1_ai_generation, 8);
 
 /**
- * HeadingLevel for heading components.
+ * AccessibilityIssueType identifies an accessibility problem in a lesson component.
  *
- * @generated from enum mirai.v1.HeadingLevel
+ * @generated from enum mirai.v1.AccessibilityIssueType
  */
-export enum HeadingLevel {
+export enum AccessibilityIssueType {
   /**
-   * @generated from enum value: HEADING_LEVEL_UNSPECIFIED = 0;
+   * @generated from enum value: ACCESSIBILITY_ISSUE_TYPE_UNSPECIFIED = 0;
    */
   UNSPECIFIED = 0,
 
   /**
-   * @generated from enum value: HEADING_LEVEL_H1 = 1;
+   * Image without alternative text
+   *
+   * @generated from enum value: ACCESSIBILITY_ISSUE_TYPE_MISSING_ALT_TEXT = 1;
    */
-  H1 = 1,
+  MISSING_ALT_TEXT = 1,
 
   /**
-   * @generated from enum value: HEADING_LEVEL_H2 = 2;
+   * Heading more than one level below the one before it
+   *
+   * @generated from enum value: ACCESSIBILITY_ISSUE_TYPE_HEADING_LEVEL_JUMP = 2;
    */
-  H2 = 2,
+  HEADING_LEVEL_JUMP = 2,
 
   /**
-   * @generated from enum value: HEADING_LEVEL_H3 = 3;
+   * Quiz with no question telling learners what to answer
+   *
+   * @generated from enum value: ACCESSIBILITY_ISSUE_TYPE_QUIZ_WITHOUT_INSTRUCTIONS = 3;
    */
-  H3 = 3,
+  QUIZ_WITHOUT_INSTRUCTIONS = 3,
+}
+
+/**
+ * Describes the enum mirai.v1.AccessibilityIssueType.
+ */
+export const AccessibilityIssueTypeSchema: GenEnum<AccessibilityIssueType> = /*@__PURE__*/
+  enumDesc(file_mirai_v1_ai_generation, 9);
 
+/**
+ * OutlineBalanceChangeKind is how a balancing proposal restructures lessons.
+ *
+ * @generated from enum mirai.v1.OutlineBalanceChangeKind
+ */
+export enum OutlineBalanceChangeKind {
   /**
-   * @generated from enum value: HEADING_LEVEL_H4 = 4;
+   * @generated from enum value: OUTLINE_BALANCE_CHANGE_KIND_UNSPECIFIED = 0;
    */
-  H4 = 4,
+  UNSPECIFIED = 0,
+
+  /**
+   * One lesson split into two
+   *
+   * @generated from enum value: OUTLINE_BALANCE_CHANGE_KIND_SPLIT = 1;
+   */
+  SPLIT = 1,
+
+  /**
+   * Two adjacent lessons merged into one
+   *
+   * @generated from enum value: OUTLINE_BALANCE_CHANGE_KIND_MERGE = 2;
+   */
+  MERGE = 2,
 }
 
 /**
- * Describes the enum mirai.v1.HeadingLevel.
+ * Describes the enum mirai.v1.OutlineBalanceChangeKind.
  */
-export const HeadingLevelSchema: GenEnum<HeadingLevel> = /*@__PURE__*/
-  enumDesc(file_mirai_v1_ai_generation, 4);
+export const OutlineBalanceChangeKindSchema: GenEnum<OutlineBalanceChangeKind> = /*@__PURE__*/
+  enumDesc(file_mirai_v1_ai_generation, 10);
 
 /**
  * AIGenerationService handles AI generation operations.
@@ -1415,6 +4027,38 @@ export const AIGenerationService: GenService<{
     input: typeof GenerateCourseOutlineRequestSchema;
     output: typeof GenerateCourseOutlineResponseSchema;
   },
+  /**
+   * AnalyzeKnowledgeCoverage checks whether the selected SMEs have enough material for a
+   * desired outcome, naming the topics that look thin. Nothing is saved.
+   *
+   * @generated from rpc mirai.v1.AIGenerationService.AnalyzeKnowledgeCoverage
+   */
+  analyzeKnowledgeCoverage: {
+    methodKind: "unary";
+    input: typeof AnalyzeKnowledgeCoverageRequestSchema;
+    output: typeof AnalyzeKnowledgeCoverageResponseSchema;
+  },
+  /**
+   * SaveGenerationDraft stores the current user's generation wizard selections for a course,
+   * replacing any earlier draft. The draft is cleared when generation starts for the course.
+   *
+   * @generated from rpc mirai.v1.AIGenerationService.SaveGenerationDraft
+   */
+  saveGenerationDraft: {
+    methodKind: "unary";
+    input: typeof SaveGenerationDraftRequestSchema;
+    output: typeof SaveGenerationDraftResponseSchema;
+  },
+  /**
+   * GetGenerationDraft returns the current user's generation wizard draft for a course.
+   *
+   * @generated from rpc mirai.v1.AIGenerationService.GetGenerationDraft
+   */
+  getGenerationDraft: {
+    methodKind: "unary";
+    input: typeof GetGenerationDraftRequestSchema;
+    output: typeof GetGenerationDraftResponseSchema;
+  },
   /**
    * GetCourseOutline returns the generated outline for a course.
    *
@@ -1426,7 +4070,9 @@ export const AIGenerationService: GenService<{
     output: typeof GetCourseOutlineResponseSchema;
   },
   /**
-   * ApproveCourseOutline approves an outline for content generation.
+   * ApproveCourseOutline approves an outline for content generation. When the organization
+   * requires a second reviewer, the first approval by someone other than the outline's
+   * generator is recorded as an endorsement and the outline stays pending review.
    *
    * @generated from rpc mirai.v1.AIGenerationService.ApproveCourseOutline
    */
@@ -1455,6 +4101,29 @@ export const AIGenerationService: GenService<{
     input: typeof UpdateCourseOutlineRequestSchema;
     output: typeof UpdateCourseOutlineResponseSchema;
   },
+  /**
+   * ExportOutline renders an outline version as CSV or DOCX for review outside Mirai.
+   *
+   * @generated from rpc mirai.v1.AIGenerationService.ExportOutline
+   */
+  exportOutline: {
+    methodKind: "unary";
+    input: typeof ExportOutlineRequestSchema;
+    output: typeof ExportOutlineResponseSchema;
+  },
+  /**
+   * BalanceOutlineDurations proposes a revision of a pending outline that splits over-long
+   * lessons and merges short adjacent ones toward a per-lesson duration range. Nothing is
+   * saved; accept the proposal by sending its sections and removed_lesson_ids to
+   * UpdateCourseOutline.
+   *
+   * @generated from rpc mirai.v1.AIGenerationService.BalanceOutlineDurations
+   */
+  balanceOutlineDurations: {
+    methodKind: "unary";
+    input: typeof BalanceOutlineDurationsRequestSchema;
+    output: typeof BalanceOutlineDurationsResponseSchema;
+  },
   /**
    * GenerateLessonContent generates content for a specific lesson.
    *
@@ -1467,6 +4136,7 @@ export const AIGenerationService: GenService<{
   },
   /**
    * GenerateAllLessons generates content for all lessons in outline.
+   * Returns the running job instead if the course's lessons are already being generated.
    *
    * @generated from rpc mirai.v1.AIGenerationService.GenerateAllLessons
    */
@@ -1475,6 +4145,26 @@ export const AIGenerationService: GenService<{
     input: typeof GenerateAllLessonsRequestSchema;
     output: typeof GenerateAllLessonsResponseSchema;
   },
+  /**
+   * RetryFailedLessons requeues the failed lessons of a failed full course run under the same parent job.
+   *
+   * @generated from rpc mirai.v1.AIGenerationService.RetryFailedLessons
+   */
+  retryFailedLessons: {
+    methodKind: "unary";
+    input: typeof RetryFailedLessonsRequestSchema;
+    output: typeof RetryFailedLessonsResponseSchema;
+  },
+  /**
+   * ExportAllLessons starts a job that packages every generated lesson into a ZIP in tenant storage.
+   *
+   * @generated from rpc mirai.v1.AIGenerationService.ExportAllLessons
+   */
+  exportAllLessons: {
+    methodKind: "unary";
+    input: typeof ExportAllLessonsRequestSchema;
+    output: typeof ExportAllLessonsResponseSchema;
+  },
   /**
    * RegenerateComponent regenerates a single component with modifications.
    *
@@ -1485,6 +4175,58 @@ export const AIGenerationService: GenService<{
     input: typeof RegenerateComponentRequestSchema;
     output: typeof RegenerateComponentResponseSchema;
   },
+  /**
+   * EditComponentText rewrites a text component inline and returns the proposal without saving.
+   *
+   * @generated from rpc mirai.v1.AIGenerationService.EditComponentText
+   */
+  editComponentText: {
+    methodKind: "unary";
+    input: typeof EditComponentTextRequestSchema;
+    output: typeof EditComponentTextResponseSchema;
+  },
+  /**
+   * GetComponentSources returns the SME knowledge chunks a component was generated from.
+   *
+   * @generated from rpc mirai.v1.AIGenerationService.GetComponentSources
+   */
+  getComponentSources: {
+    methodKind: "unary";
+    input: typeof GetComponentSourcesRequestSchema;
+    output: typeof GetComponentSourcesResponseSchema;
+  },
+  /**
+   * GetComponentAssetUploadURL returns a presigned URL for uploading the file for a
+   * placeholder component, such as the video for a scripted video block.
+   *
+   * @generated from rpc mirai.v1.AIGenerationService.GetComponentAssetUploadURL
+   */
+  getComponentAssetUploadURL: {
+    methodKind: "unary";
+    input: typeof GetComponentAssetUploadURLRequestSchema;
+    output: typeof GetComponentAssetUploadURLResponseSchema;
+  },
+  /**
+   * ConfirmComponentAsset attaches an uploaded file to its component.
+   *
+   * @generated from rpc mirai.v1.AIGenerationService.ConfirmComponentAsset
+   */
+  confirmComponentAsset: {
+    methodKind: "unary";
+    input: typeof ConfirmComponentAssetRequestSchema;
+    output: typeof ConfirmComponentAssetResponseSchema;
+  },
+  /**
+   * SuggestCourseTitles suggests course titles from the selected SMEs, audiences and outcome.
+   * Nothing is saved; apply a chosen title with CourseService.UpdateCourse.
+   *
+   * @generated from rpc mirai.v1.AIGenerationService.SuggestCourseTitles
+   */
+  suggestCourseTitles: {
+    methodKind: "unary";
+    input: typeof SuggestCourseTitlesRequestSchema;
+    output: typeof SuggestCourseTitlesResponseSchema;
+  },
   /**
    * GetJob returns a generation job by ID.
    *
@@ -1535,6 +4277,149 @@ export const AIGenerationService: GenService<{
     input: typeof ListGeneratedLessonsRequestSchema;
     output: typeof ListGeneratedLessonsResponseSchema;
   },
+  /**
+   * GetCourseStats returns word, quiz and image counts for a course's generated lessons.
+   *
+   * @generated from rpc mirai.v1.AIGenerationService.GetCourseStats
+   */
+  getCourseStats: {
+    methodKind: "unary";
+    input: typeof GetCourseStatsRequestSchema;
+    output: typeof GetCourseStatsResponseSchema;
+  },
+  /**
+   * GetCoursePlayerView returns a course's lessons in display order for the learner-facing
+   * player, without authoring metadata. Published courses show their published version.
+   * Unpublished courses and drafts are only visible to their editors.
+   *
+   * @generated from rpc mirai.v1.AIGenerationService.GetCoursePlayerView
+   */
+  getCoursePlayerView: {
+    methodKind: "unary";
+    input: typeof GetCoursePlayerViewRequestSchema;
+    output: typeof GetCoursePlayerViewResponseSchema;
+  },
+  /**
+   * GetAccessibilityReport lists the accessibility problems left in a course's lessons, such
+   * as images without alt text, so authors can fix them before publishing. Editors only.
+   *
+   * @generated from rpc mirai.v1.AIGenerationService.GetAccessibilityReport
+   */
+  getAccessibilityReport: {
+    methodKind: "unary";
+    input: typeof GetAccessibilityReportRequestSchema;
+    output: typeof GetAccessibilityReportResponseSchema;
+  },
+  /**
+   * GetQueueStatus returns the tenant's active jobs and the state of the shared generation queue.
+   *
+   * @generated from rpc mirai.v1.AIGenerationService.GetQueueStatus
+   */
+  getQueueStatus: {
+    methodKind: "unary";
+    input: typeof GetQueueStatusRequestSchema;
+    output: typeof GetQueueStatusResponseSchema;
+  },
+  /**
+   * ListAnomalies returns generation anomalies across tenants.
+   * Requires a superadmin (SUPERADMIN_EMAILS).
+   *
+   * @generated from rpc mirai.v1.AIGenerationService.ListAnomalies
+   */
+  listAnomalies: {
+    methodKind: "unary";
+    input: typeof ListAnomaliesRequestSchema;
+    output: typeof ListAnomaliesResponseSchema;
+  },
+  /**
+   * StartStorageAudit starts a job that reports stored objects of the caller's organization
+   * that no course, job, submission, SME or LMS delivery references. Admin only.
+   *
+   * @generated from rpc mirai.v1.AIGenerationService.StartStorageAudit
+   */
+  startStorageAudit: {
+    methodKind: "unary";
+    input: typeof StartStorageAuditRequestSchema;
+    output: typeof StartStorageAuditResponseSchema;
+  },
+  /**
+   * GetStorageAuditReport returns a storage audit job and, once it has completed, a link to
+   * its JSON report. The job's progress message summarizes the result. Admin only.
+   *
+   * @generated from rpc mirai.v1.AIGenerationService.GetStorageAuditReport
+   */
+  getStorageAuditReport: {
+    methodKind: "unary";
+    input: typeof GetStorageAuditReportRequestSchema;
+    output: typeof GetStorageAuditReportResponseSchema;
+  },
+  /**
+   * TranslateCourse copies a course into a new draft course in the target language and
+   * starts a job that translates its outline and generated lessons.
+   *
+   * @generated from rpc mirai.v1.AIGenerationService.TranslateCourse
+   */
+  translateCourse: {
+    methodKind: "unary";
+    input: typeof TranslateCourseRequestSchema;
+    output: typeof TranslateCourseResponseSchema;
+  },
+  /**
+   * CreateOutlineComment adds a reviewer comment to an outline lesson and notifies the
+   * course owner. Comments stay with the lesson across outline edits and versions.
+   *
+   * @generated from rpc mirai.v1.AIGenerationService.CreateOutlineComment
+   */
+  createOutlineComment: {
+    methodKind: "unary";
+    input: typeof CreateOutlineCommentRequestSchema;
+    output: typeof CreateOutlineCommentResponseSchema;
+  },
+  /**
+   * ListOutlineComments returns the comments on a course's outline lessons.
+   *
+   * @generated from rpc mirai.v1.AIGenerationService.ListOutlineComments
+   */
+  listOutlineComments: {
+    methodKind: "unary";
+    input: typeof ListOutlineCommentsRequestSchema;
+    output: typeof ListOutlineCommentsResponseSchema;
+  },
+  /**
+   * ResolveOutlineComment marks an outline comment resolved.
+   *
+   * @generated from rpc mirai.v1.AIGenerationService.ResolveOutlineComment
+   */
+  resolveOutlineComment: {
+    methodKind: "unary";
+    input: typeof ResolveOutlineCommentRequestSchema;
+    output: typeof ResolveOutlineCommentResponseSchema;
+  },
+  /**
+   * SetTenantAIEnabled pauses or resumes a tenant's AI generation. While paused, requests
+   * that start generation fail with FAILED_PRECONDITION and the tenant's queued jobs wait;
+   * resuming sends them back to the worker. Requires a superadmin (SUPERADMIN_EMAILS).
+   *
+   * @generated from rpc mirai.v1.AIGenerationService.SetTenantAIEnabled
+   */
+  setTenantAIEnabled: {
+    methodKind: "unary";
+    input: typeof SetTenantAIEnabledRequestSchema;
+    output: typeof SetTenantAIEnabledResponseSchema;
+  },
+  /**
+   * MigrateQuizSchema queues an upgrade of quiz components in every tenant to the current
+   * quiz schema (schema_version in the content). Quizzes that can't be upgraded are left
+   * as they were. The counts are emailed to the operators. Requires a superadmin
+   * (SUPERADMIN_EMAILS).
+   *
+   * @generated from rpc mirai.v1.AIGenerationService.MigrateQuizSchema
+   */
+  migrateQuizSchema: {
+    methodKind: "unary";
+    input: typeof MigrateQuizSchemaRequestSchema;
+    output: typeof MigrateQuizSchemaResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_mirai_v1_ai_generation, 0);
 
@@ -0,0 +1,19 @@
+// @generated by protoc-gen-connect-query v2.2.0 with parameter "target=ts"
+// @generated from file mirai/v1/audit.proto (package mirai.v1, syntax proto3)
+/* eslint-disable */
+
+import { AuditService } from "./audit_pb";
+
+/**
+ * ListAuditEvents returns audit events, most recent first.
+ *
+ * @generated from rpc mirai.v1.AuditService.ListAuditEvents
+ */
+export const listAuditEvents = AuditService.method.listAuditEvents;
+
+/**
+ * ExportAuditEvents renders the matching audit events as CSV.
+ *
+ * @generated from rpc mirai.v1.AuditService.ExportAuditEvents
+ */
+export const exportAuditEvents = AuditService.method.exportAuditEvents;
@@ -0,0 +1,299 @@
+// @generated by protoc-gen-es v2.10.1 with parameter "target=ts"
+// @generated from file mirai/v1/audit.proto (package mirai.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file mirai/v1/audit.proto.
+ */
+export const file_mirai_v1_audit: GenFile = /*@__PURE__*/
+  fileDesc("ChRtaXJhaS92MS9hdWRpdC5wcm90bxIIbWlyYWkudjEibQoLQXVkaXRDaGFuZ2USDQoFZmllbGQYASABKAkSEwoGYmVmb3JlGAIgASgJSACIAQESEgoFYWZ0ZXIYAyABKAlIAYgBARIRCglzZW5zaXRpdmUYBCABKAhCCQoHX2JlZm9yZUIICgZfYWZ0ZXIiqgIKCkF1ZGl0RXZlbnQSCgoCaWQYASABKAkSGgoNYWN0b3JfdXNlcl9pZBgCIAEoCUgAiAEBEg4KBmFjdGlvbhgDIAEoCRITCgt0YXJnZXRfdHlwZRgEIAEoCRIRCgl0YXJnZXRfaWQYBSABKAkSJgoHY2hhbmdlcxgGIAMoCzIVLm1pcmFpLnYxLkF1ZGl0Q2hhbmdlEhcKCmlwX2FkZHJlc3MYByABKAlIAYgBARIuCgpjcmVhdGVkX2F0GAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIZCgxhcGlfdG9rZW5faWQYCSABKAlIAogBAUIQCg5fYWN0b3JfdXNlcl9pZEINCgtfaXBfYWRkcmVzc0IPCg1fYXBpX3Rva2VuX2lkIqQCChBBdWRpdEV2ZW50RmlsdGVyEhMKBmFjdGlvbhgBIAEoCUgAiAEBEhoKDWFjdG9yX3VzZXJfaWQYAiABKAlIAYgBARIYCgt0YXJnZXRfdHlwZRgDIAEoCUgCiAEBEhYKCXRhcmdldF9pZBgEIAEoCUgDiAEBEi4KBXNpbmNlGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgEiAEBEi4KBXVudGlsGAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgFiAEBQgkKB19hY3Rpb25CEAoOX2FjdG9yX3VzZXJfaWRCDgoMX3RhcmdldF90eXBlQgwKCl90YXJnZXRfaWRCCAoGX3NpbmNlQggKBl91bnRpbCJzChZMaXN0QXVkaXRFdmVudHNSZXF1ZXN0EioKBmZpbHRlchgBIAEoCzIaLm1pcmFpLnYxLkF1ZGl0RXZlbnRGaWx0ZXISDQoFbGltaXQYAiABKAUSEwoGY3Vyc29yGAMgASgJSACIAQFCCQoHX2N1cnNvciJpChdMaXN0QXVkaXRFdmVudHNSZXNwb25zZRIkCgZldmVudHMYASADKAsyFC5taXJhaS52MS5BdWRpdEV2ZW50EhgKC25leHRfY3Vyc29yGAIgASgJSACIAQFCDgoMX25leHRfY3Vyc29yIkYKGEV4cG9ydEF1ZGl0RXZlbnRzUmVxdWVzdBIqCgZmaWx0ZXIYASABKAsyGi5taXJhaS52MS5BdWRpdEV2ZW50RmlsdGVyIj4KGUV4cG9ydEF1ZGl0RXZlbnRzUmVzcG9uc2USEAoIZmlsZW5hbWUYASABKAkSDwoHY29udGVudBgCIAEoDDLEAQoMQXVkaXRTZXJ2aWNlElYKD0xpc3RBdWRpdEV2ZW50cxIgLm1pcmFpLnYxLkxpc3RBdWRpdEV2ZW50c1JlcXVlc3QaIS5taXJhaS52MS5MaXN0QXVkaXRFdmVudHNSZXNwb25zZRJcChFFeHBvcnRBdWRpdEV2ZW50cxIiLm1pcmFpLnYxLkV4cG9ydEF1ZGl0RXZlbnRzUmVxdWVzdBojLm1pcmFpLnYxLkV4cG9ydEF1ZGl0RXZlbnRzUmVzcG9uc2VCkAEKDGNvbS5taXJhaS52MUIKQXVkaXRQcm90b1ABWjNnaXRodWIuY29tL3NvZ29zL21pcmFpLWJhY2tlbmQvZ2VuL21pcmFpL3YxO21pcmFpdjGiAgNNWFiqAghNaXJhaS5WMcoCCE1pcmFpXFYx4gIUTWlyYWlcVjFcR1BCTWV0YWRhdGHqAglNaXJhaTo6VjFiBnByb3RvMw", [file_google_protobuf_timestamp]);
+
+/**
+ * AuditChange records one field an action changed.
+ *
+ * @generated from message mirai.v1.AuditChange
+ */
+export type AuditChange = Message<"mirai.v1.AuditChange"> & {
+  /**
+   * @generated from field: string field = 1;
+   */
+  field: string;
+
+  /**
+   * @generated from field: optional string before = 2;
+   */
+  before?: string;
+
+  /**
+   * @generated from field: optional string after = 3;
+   */
+  after?: string;
+
+  /**
+   * Values are never recorded for sensitive fields such as API keys
+   *
+   * @generated from field: bool sensitive = 4;
+   */
+  sensitive: boolean;
+};
+
+/**
+ * Describes the message mirai.v1.AuditChange.
+ * Use `create(AuditChangeSchema)` to create a new message.
+ */
+export const AuditChangeSchema: GenMessage<AuditChange> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_audit, 0);
+
+/**
+ * AuditEvent is an entry in the audit log.
+ *
+ * @generated from message mirai.v1.AuditEvent
+ */
+export type AuditEvent = Message<"mirai.v1.AuditEvent"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * Unset for system actions such as billing webhooks
+   *
+   * @generated from field: optional string actor_user_id = 2;
+   */
+  actorUserId?: string;
+
+  /**
+   * e.g. "invitation.created", "ai_settings.api_key_set"
+   *
+   * @generated from field: string action = 3;
+   */
+  action: string;
+
+  /**
+   * e.g. "user", "invitation", "company", "course"
+   *
+   * @generated from field: string target_type = 4;
+   */
+  targetType: string;
+
+  /**
+   * @generated from field: string target_id = 5;
+   */
+  targetId: string;
+
+  /**
+   * @generated from field: repeated mirai.v1.AuditChange changes = 6;
+   */
+  changes: AuditChange[];
+
+  /**
+   * @generated from field: optional string ip_address = 7;
+   */
+  ipAddress?: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 8;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * Set when the action was made with an API token rather than a browser session
+   *
+   * @generated from field: optional string api_token_id = 9;
+   */
+  apiTokenId?: string;
+};
+
+/**
+ * Describes the message mirai.v1.AuditEvent.
+ * Use `create(AuditEventSchema)` to create a new message.
+ */
+export const AuditEventSchema: GenMessage<AuditEvent> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_audit, 1);
+
+/**
+ * AuditEventFilter selects audit events. Unset fields match all events.
+ *
+ * @generated from message mirai.v1.AuditEventFilter
+ */
+export type AuditEventFilter = Message<"mirai.v1.AuditEventFilter"> & {
+  /**
+   * @generated from field: optional string action = 1;
+   */
+  action?: string;
+
+  /**
+   * @generated from field: optional string actor_user_id = 2;
+   */
+  actorUserId?: string;
+
+  /**
+   * @generated from field: optional string target_type = 3;
+   */
+  targetType?: string;
+
+  /**
+   * @generated from field: optional string target_id = 4;
+   */
+  targetId?: string;
+
+  /**
+   * Inclusive
+   *
+   * @generated from field: optional google.protobuf.Timestamp since = 5;
+   */
+  since?: Timestamp;
+
+  /**
+   * Exclusive
+   *
+   * @generated from field: optional google.protobuf.Timestamp until = 6;
+   */
+  until?: Timestamp;
+};
+
+/**
+ * Describes the message mirai.v1.AuditEventFilter.
+ * Use `create(AuditEventFilterSchema)` to create a new message.
+ */
+export const AuditEventFilterSchema: GenMessage<AuditEventFilter> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_audit, 2);
+
+/**
+ * ListAuditEventsRequest contains filters and pagination.
+ *
+ * @generated from message mirai.v1.ListAuditEventsRequest
+ */
+export type ListAuditEventsRequest = Message<"mirai.v1.ListAuditEventsRequest"> & {
+  /**
+   * @generated from field: mirai.v1.AuditEventFilter filter = 1;
+   */
+  filter?: AuditEventFilter;
+
+  /**
+   * Max results (default 50, max 200)
+   *
+   * @generated from field: int32 limit = 2;
+   */
+  limit: number;
+
+  /**
+   * For pagination
+   *
+   * @generated from field: optional string cursor = 3;
+   */
+  cursor?: string;
+};
+
+/**
+ * Describes the message mirai.v1.ListAuditEventsRequest.
+ * Use `create(ListAuditEventsRequestSchema)` to create a new message.
+ */
+export const ListAuditEventsRequestSchema: GenMessage<ListAuditEventsRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_audit, 3);
+
+/**
+ * ListAuditEventsResponse contains a page of audit events.
+ *
+ * @generated from message mirai.v1.ListAuditEventsResponse
+ */
+export type ListAuditEventsResponse = Message<"mirai.v1.ListAuditEventsResponse"> & {
+  /**
+   * @generated from field: repeated mirai.v1.AuditEvent events = 1;
+   */
+  events: AuditEvent[];
+
+  /**
+   * For pagination
+   *
+   * @generated from field: optional string next_cursor = 2;
+   */
+  nextCursor?: string;
+};
+
+/**
+ * Describes the message mirai.v1.ListAuditEventsResponse.
+ * Use `create(ListAuditEventsResponseSchema)` to create a new message.
+ */
+export const ListAuditEventsResponseSchema: GenMessage<ListAuditEventsResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_audit, 4);
+
+/**
+ * ExportAuditEventsRequest contains the filters for an export.
+ *
+ * @generated from message mirai.v1.ExportAuditEventsRequest
+ */
+export type ExportAuditEventsRequest = Message<"mirai.v1.ExportAuditEventsRequest"> & {
+  /**
+   * @generated from field: mirai.v1.AuditEventFilter filter = 1;
+   */
+  filter?: AuditEventFilter;
+};
+
+/**
+ * Describes the message mirai.v1.ExportAuditEventsRequest.
+ * Use `create(ExportAuditEventsRequestSchema)` to create a new message.
+ */
+export const ExportAuditEventsRequestSchema: GenMessage<ExportAuditEventsRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_audit, 5);
+
+/**
+ * ExportAuditEventsResponse contains the CSV file.
+ *
+ * @generated from message mirai.v1.ExportAuditEventsResponse
+ */
+export type ExportAuditEventsResponse = Message<"mirai.v1.ExportAuditEventsResponse"> & {
+  /**
+   * @generated from field: string filename = 1;
+   */
+  filename: string;
+
+  /**
+   * @generated from field: bytes content = 2;
+   */
+  content: Uint8Array;
+};
+
+/**
+ * Describes the message mirai.v1.ExportAuditEventsResponse.
+ * Use `create(ExportAuditEventsResponseSchema)` to create a new message.
+ */
+export const ExportAuditEventsResponseSchema: GenMessage<ExportAuditEventsResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_audit, 6);
+
+/**
+ * AuditService exposes the tenant's audit log of administrative actions.
+ * All methods require ADMIN or OWNER role.
+ *
+ * @generated from service mirai.v1.AuditService
+ */
+export const AuditService: GenService<{
+  /**
+   * ListAuditEvents returns audit events, most recent first.
+   *
+   * @generated from rpc mirai.v1.AuditService.ListAuditEvents
+   */
+  listAuditEvents: {
+    methodKind: "unary";
+    input: typeof ListAuditEventsRequestSchema;
+    output: typeof ListAuditEventsResponseSchema;
+  },
+  /**
+   * ExportAuditEvents renders the matching audit events as CSV.
+   *
+   * @generated from rpc mirai.v1.AuditService.ExportAuditEvents
+   */
+  exportAuditEvents: {
+    methodKind: "unary";
+    input: typeof ExportAuditEventsRequestSchema;
+    output: typeof ExportAuditEventsResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_mirai_v1_audit, 0);
+
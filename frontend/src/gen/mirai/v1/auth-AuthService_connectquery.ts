@@ -40,3 +40,10 @@ export const onboard = AuthService.method.onboard;
  * @generated from rpc mirai.v1.AuthService.EnterpriseContact
  */
 export const enterpriseContact = AuthService.method.enterpriseContact;
+
+/**
+ * ListStorageRegions lists the storage regions a new organization can keep its data in.
+ *
+ * @generated from rpc mirai.v1.AuthService.ListStorageRegions
+ */
+export const listStorageRegions = AuthService.method.listStorageRegions;
@@ -12,7 +12,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file mirai/v1/auth.proto.
  */
 export const file_mirai_v1_auth: GenFile = /*@__PURE__*/
-  fileDesc("ChNtaXJhaS92MS9hdXRoLnByb3RvEghtaXJhaS52MSIiChFDaGVja0VtYWlsUmVxdWVzdBINCgVlbWFpbBgBIAEoCSIkChJDaGVja0VtYWlsUmVzcG9uc2USDgoGZXhpc3RzGAEgASgIIv8BCg9SZWdpc3RlclJlcXVlc3QSDQoFZW1haWwYASABKAkSEAoIcGFzc3dvcmQYAiABKAkSEgoKZmlyc3RfbmFtZRgDIAEoCRIRCglsYXN0X25hbWUYBCABKAkSFAoMY29tcGFueV9uYW1lGAUgASgJEhUKCGluZHVzdHJ5GAYgASgJSACIAQESFgoJdGVhbV9zaXplGAcgASgJSAGIAQESHAoEcGxhbhgIIAEoDjIOLm1pcmFpLnYxLlBsYW4SFwoKc2VhdF9jb3VudBgJIAEoBUgCiAEBQgsKCV9pbmR1c3RyeUIMCgpfdGVhbV9zaXplQg0KC19zZWF0X2NvdW50Ir0BChBSZWdpc3RlclJlc3BvbnNlEiEKBHVzZXIYASABKAsyDi5taXJhaS52MS5Vc2VySACIAQESJwoHY29tcGFueRgCIAEoCzIRLm1pcmFpLnYxLkNvbXBhbnlIAYgBARIZCgxjaGVja291dF91cmwYAyABKAlIAogBARISCgVlbWFpbBgEIAEoCUgDiAEBQgcKBV91c2VyQgoKCF9jb21wYW55Qg8KDV9jaGVja291dF91cmxCCAoGX2VtYWlsIrYBCg5PbmJvYXJkUmVxdWVzdBIUCgxjb21wYW55X25hbWUYASABKAkSFQoIaW5kdXN0cnkYAiABKAlIAIgBARIWCgl0ZWFtX3NpemUYAyABKAlIAYgBARIcCgRwbGFuGAQgASgOMg4ubWlyYWkudjEuUGxhbhIXCgpzZWF0X2NvdW50GAUgASgFSAKIAQFCCwoJX2luZHVzdHJ5QgwKCl90ZWFtX3NpemVCDQoLX3NlYXRfY291bnQikAEKD09uYm9hcmRSZXNwb25zZRIcCgR1c2VyGAEgASgLMg4ubWlyYWkudjEuVXNlchInCgdjb21wYW55GAIgASgLMhEubWlyYWkudjEuQ29tcGFueUgAiAEBEhkKDGNoZWNrb3V0X3VybBgDIAEoCUgBiAEBQgoKCF9jb21wYW55Qg8KDV9jaGVja291dF91cmwi1wEKGEVudGVycHJpc2VDb250YWN0UmVxdWVzdBIUCgxjb21wYW55X25hbWUYASABKAkSFQoIaW5kdXN0cnkYAiABKAlIAIgBARIWCgl0ZWFtX3NpemUYAyABKAlIAYgBARIMCgRuYW1lGAQgASgJEg0KBWVtYWlsGAUgASgJEhIKBXBob25lGAYgASgJSAKIAQESFAoHbWVzc2FnZRgHIAEoCUgDiAEBQgsKCV9pbmR1c3RyeUIMCgpfdGVhbV9zaXplQggKBl9waG9uZUIKCghfbWVzc2FnZSIsChlFbnRlcnByaXNlQ29udGFjdFJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgiZwodUmVnaXN0ZXJXaXRoSW52aXRhdGlvblJlcXVlc3QSDQoFdG9rZW4YASABKAkSEAoIcGFzc3dvcmQYAiABKAkSEgoKZmlyc3RfbmFtZRgDIAEoCRIRCglsYXN0X25hbWUYBCABKAkieQoeUmVnaXN0ZXJXaXRoSW52aXRhdGlvblJlc3BvbnNlEhwKBHVzZXIYASABKAsyDi5taXJhaS52MS5Vc2VyEiIKB2NvbXBhbnkYAiABKAsyES5taXJhaS52MS5Db21wYW55EhUKDXNlc3Npb25fdG9rZW4YAyABKAkypAMKC0F1dGhTZXJ2aWNlEkcKCkNoZWNrRW1haWwSGy5taXJhaS52MS5DaGVja0VtYWlsUmVxdWVzdBocLm1pcmFpLnYxLkNoZWNrRW1haWxSZXNwb25zZRJBCghSZWdpc3RlchIZLm1pcmFpLnYxLlJlZ2lzdGVyUmVxdWVzdBoaLm1pcmFpLnYxLlJlZ2lzdGVyUmVzcG9uc2USawoWUmVnaXN0ZXJXaXRoSW52aXRhdGlvbhInLm1pcmFpLnYxLlJlZ2lzdGVyV2l0aEludml0YXRpb25SZXF1ZXN0GigubWlyYWkudjEuUmVnaXN0ZXJXaXRoSW52aXRhdGlvblJlc3BvbnNlEj4KB09uYm9hcmQSGC5taXJhaS52MS5PbmJvYXJkUmVxdWVzdBoZLm1pcmFpLnYxLk9uYm9hcmRSZXNwb25zZRJcChFFbnRlcnByaXNlQ29udGFjdBIiLm1pcmFpLnYxLkVudGVycHJpc2VDb250YWN0UmVxdWVzdBojLm1pcmFpLnYxLkVudGVycHJpc2VDb250YWN0UmVzcG9uc2VCjwEKDGNvbS5taXJhaS52MUIJQXV0aFByb3RvUAFaM2dpdGh1Yi5jb20vc29nb3MvbWlyYWktYmFja2VuZC9nZW4vbWlyYWkvdjE7bWlyYWl2MaICA01YWKoCCE1pcmFpLlYxygIITWlyYWlcVjHiAhRNaXJhaVxWMVxHUEJNZXRhZGF0YeoCCU1pcmFpOjpWMWIGcHJvdG8z", [file_mirai_v1_common]);
+  fileDesc("ChNtaXJhaS92MS9hdXRoLnByb3RvEghtaXJhaS52MSIiChFDaGVja0VtYWlsUmVxdWVzdBINCgVlbWFpbBgBIAEoCSIkChJDaGVja0VtYWlsUmVzcG9uc2USDgoGZXhpc3RzGAEgASgIIq8CCg9SZWdpc3RlclJlcXVlc3QSDQoFZW1haWwYASABKAkSEAoIcGFzc3dvcmQYAiABKAkSEgoKZmlyc3RfbmFtZRgDIAEoCRIRCglsYXN0X25hbWUYBCABKAkSFAoMY29tcGFueV9uYW1lGAUgASgJEhUKCGluZHVzdHJ5GAYgASgJSACIAQESFgoJdGVhbV9zaXplGAcgASgJSAGIAQESHAoEcGxhbhgIIAEoDjIOLm1pcmFpLnYxLlBsYW4SFwoKc2VhdF9jb3VudBgJIAEoBUgCiAEBEhsKDnN0b3JhZ2VfcmVnaW9uGAogASgJSAOIAQFCCwoJX2luZHVzdHJ5QgwKCl90ZWFtX3NpemVCDQoLX3NlYXRfY291bnRCEQoPX3N0b3JhZ2VfcmVnaW9uIr0BChBSZWdpc3RlclJlc3BvbnNlEiEKBHVzZXIYASABKAsyDi5taXJhaS52MS5Vc2VySACIAQESJwoHY29tcGFueRgCIAEoCzIRLm1pcmFpLnYxLkNvbXBhbnlIAYgBARIZCgxjaGVja291dF91cmwYAyABKAlIAogBARISCgVlbWFpbBgEIAEoCUgDiAEBQgcKBV91c2VyQgoKCF9jb21wYW55Qg8KDV9jaGVja291dF91cmxCCAoGX2VtYWlsIrYBCg5PbmJvYXJkUmVxdWVzdBIUCgxjb21wYW55X25hbWUYASABKAkSFQoIaW5kdXN0cnkYAiABKAlIAIgBARIWCgl0ZWFtX3NpemUYAyABKAlIAYgBARIcCgRwbGFuGAQgASgOMg4ubWlyYWkudjEuUGxhbhIXCgpzZWF0X2NvdW50GAUgASgFSAKIAQFCCwoJX2luZHVzdHJ5QgwKCl90ZWFtX3NpemVCDQoLX3NlYXRfY291bnQikAEKD09uYm9hcmRSZXNwb25zZRIcCgR1c2VyGAEgASgLMg4ubWlyYWkudjEuVXNlchInCgdjb21wYW55GAIgASgLMhEubWlyYWkudjEuQ29tcGFueUgAiAEBEhkKDGNoZWNrb3V0X3VybBgDIAEoCUgBiAEBQgoKCF9jb21wYW55Qg8KDV9jaGVja291dF91cmwi1wEKGEVudGVycHJpc2VDb250YWN0UmVxdWVzdBIUCgxjb21wYW55X25hbWUYASABKAkSFQoIaW5kdXN0cnkYAiABKAlIAIgBARIWCgl0ZWFtX3NpemUYAyABKAlIAYgBARIMCgRuYW1lGAQgASgJEg0KBWVtYWlsGAUgASgJEhIKBXBob25lGAYgASgJSAKIAQESFAoHbWVzc2FnZRgHIAEoCUgDiAEBQgsKCV9pbmR1c3RyeUIMCgpfdGVhbV9zaXplQggKBl9waG9uZUIKCghfbWVzc2FnZSIsChlFbnRlcnByaXNlQ29udGFjdFJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgiZwodUmVnaXN0ZXJXaXRoSW52aXRhdGlvblJlcXVlc3QSDQoFdG9rZW4YASABKAkSEAoIcGFzc3dvcmQYAiABKAkSEgoKZmlyc3RfbmFtZRgDIAEoCRIRCglsYXN0X25hbWUYBCABKAkieQoeUmVnaXN0ZXJXaXRoSW52aXRhdGlvblJlc3BvbnNlEhwKBHVzZXIYASABKAsyDi5taXJhaS52MS5Vc2VyEiIKB2NvbXBhbnkYAiABKAsyES5taXJhaS52MS5Db21wYW55EhUKDXNlc3Npb25fdG9rZW4YAyABKAkiGwoZTGlzdFN0b3JhZ2VSZWdpb25zUmVxdWVzdCJFChpMaXN0U3RvcmFnZVJlZ2lvbnNSZXNwb25zZRIPCgdyZWdpb25zGAEgAygJEhYKDmRlZmF1bHRfcmVnaW9uGAIgASgJMoUECgtBdXRoU2VydmljZRJHCgpDaGVja0VtYWlsEhsubWlyYWkudjEuQ2hlY2tFbWFpbFJlcXVlc3QaHC5taXJhaS52MS5DaGVja0VtYWlsUmVzcG9uc2USQQoIUmVnaXN0ZXISGS5taXJhaS52MS5SZWdpc3RlclJlcXVlc3QaGi5taXJhaS52MS5SZWdpc3RlclJlc3BvbnNlEmsKFlJlZ2lzdGVyV2l0aEludml0YXRpb24SJy5taXJhaS52MS5SZWdpc3RlcldpdGhJbnZpdGF0aW9uUmVxdWVzdBooLm1pcmFpLnYxLlJlZ2lzdGVyV2l0aEludml0YXRpb25SZXNwb25zZRI+CgdPbmJvYXJkEhgubWlyYWkudjEuT25ib2FyZFJlcXVlc3QaGS5taXJhaS52MS5PbmJvYXJkUmVzcG9uc2USXAoRRW50ZXJwcmlzZUNvbnRhY3QSIi5taXJhaS52MS5FbnRlcnByaXNlQ29udGFjdFJlcXVlc3QaIy5taXJhaS52MS5FbnRlcnByaXNlQ29udGFjdFJlc3BvbnNlEl8KEkxpc3RTdG9yYWdlUmVnaW9ucxIjLm1pcmFpLnYxLkxpc3RTdG9yYWdlUmVnaW9uc1JlcXVlc3QaJC5taXJhaS52MS5MaXN0U3RvcmFnZVJlZ2lvbnNSZXNwb25zZUKPAQoMY29tLm1pcmFpLnYxQglBdXRoUHJvdG9QAVozZ2l0aHViLmNvbS9zb2dvcy9taXJhaS1iYWNrZW5kL2dlbi9taXJhaS92MTttaXJhaXYxogIDTVhYqgIITWlyYWkuVjHKAghNaXJhaVxWMeICFE1pcmFpXFYxXEdQQk1ldGFkYXRh6gIJTWlyYWk6OlYxYgZwcm90bzM", [file_mirai_v1_common]);
 
 /**
  * CheckEmailRequest contains the email to check.
@@ -108,6 +108,14 @@ export type RegisterRequest = Message<"mirai.v1.RegisterRequest"> & {
    * @generated from field: optional int32 seat_count = 9;
    */
   seatCount?: number;
+
+  /**
+   * Storage region for the organization's data; fixed once the account is provisioned.
+   * Unset uses the default region.
+   *
+   * @generated from field: optional string storage_region = 10;
+   */
+  storageRegion?: string;
 };
 
 /**
@@ -365,6 +373,45 @@ export type RegisterWithInvitationResponse = Message<"mirai.v1.RegisterWithInvit
 export const RegisterWithInvitationResponseSchema: GenMessage<RegisterWithInvitationResponse> = /*@__PURE__*/
   messageDesc(file_mirai_v1_auth, 9);
 
+/**
+ * ListStorageRegionsRequest is empty.
+ *
+ * @generated from message mirai.v1.ListStorageRegionsRequest
+ */
+export type ListStorageRegionsRequest = Message<"mirai.v1.ListStorageRegionsRequest"> & {
+};
+
+/**
+ * Describes the message mirai.v1.ListStorageRegionsRequest.
+ * Use `create(ListStorageRegionsRequestSchema)` to create a new message.
+ */
+export const ListStorageRegionsRequestSchema: GenMessage<ListStorageRegionsRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_auth, 10);
+
+/**
+ * ListStorageRegionsResponse lists the storage regions, default first.
+ *
+ * @generated from message mirai.v1.ListStorageRegionsResponse
+ */
+export type ListStorageRegionsResponse = Message<"mirai.v1.ListStorageRegionsResponse"> & {
+  /**
+   * @generated from field: repeated string regions = 1;
+   */
+  regions: string[];
+
+  /**
+   * @generated from field: string default_region = 2;
+   */
+  defaultRegion: string;
+};
+
+/**
+ * Describes the message mirai.v1.ListStorageRegionsResponse.
+ * Use `create(ListStorageRegionsResponseSchema)` to create a new message.
+ */
+export const ListStorageRegionsResponseSchema: GenMessage<ListStorageRegionsResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_auth, 11);
+
 /**
  * AuthService handles authentication and registration operations.
  *
@@ -423,6 +470,16 @@ export const AuthService: GenService<{
     input: typeof EnterpriseContactRequestSchema;
     output: typeof EnterpriseContactResponseSchema;
   },
+  /**
+   * ListStorageRegions lists the storage regions a new organization can keep its data in.
+   *
+   * @generated from rpc mirai.v1.AuthService.ListStorageRegions
+   */
+  listStorageRegions: {
+    methodKind: "unary";
+    input: typeof ListStorageRegionsRequestSchema;
+    output: typeof ListStorageRegionsResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_mirai_v1_auth, 0);
 
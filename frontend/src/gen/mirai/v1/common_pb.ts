@@ -12,7 +12,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file mirai/v1/common.proto.
  */
 export const file_mirai_v1_common: GenFile = /*@__PURE__*/
-  fileDesc("ChVtaXJhaS92MS9jb21tb24ucHJvdG8SCG1pcmFpLnYxIt0CCgRVc2VyEgoKAmlkGAEgASgJEhEKCWtyYXRvc19pZBgCIAEoCRIXCgpjb21wYW55X2lkGAMgASgJSACIAQESHAoEcm9sZRgEIAEoDjIOLm1pcmFpLnYxLlJvbGUSLgoKY3JlYXRlZF9hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASFgoJdGVuYW50X2lkGAcgASgJSAGIAQESEgoFZW1haWwYCCABKAlIAogBARIXCgpmaXJzdF9uYW1lGAkgASgJSAOIAQESFgoJbGFzdF9uYW1lGAogASgJSASIAQFCDQoLX2NvbXBhbnlfaWRCDAoKX3RlbmFudF9pZEIICgZfZW1haWxCDQoLX2ZpcnN0X25hbWVCDAoKX2xhc3RfbmFtZSLFAwoHQ29tcGFueRIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEhUKCGluZHVzdHJ5GAMgASgJSACIAQESFgoJdGVhbV9zaXplGAQgASgJSAGIAQESHAoEcGxhbhgFIAEoDjIOLm1pcmFpLnYxLlBsYW4SOQoTc3Vic2NyaXB0aW9uX3N0YXR1cxgGIAEoDjIcLm1pcmFpLnYxLlN1YnNjcmlwdGlvblN0YXR1cxIfChJzdHJpcGVfY3VzdG9tZXJfaWQYByABKAlIAogBARIjChZzdHJpcGVfc3Vic2NyaXB0aW9uX2lkGAggASgJSAOIAQESLgoKY3JlYXRlZF9hdBgJIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgKIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEgoKc2VhdF9jb3VudBgLIAEoBRIRCgl0ZW5hbnRfaWQYDCABKAlCCwoJX2luZHVzdHJ5QgwKCl90ZWFtX3NpemVCFQoTX3N0cmlwZV9jdXN0b21lcl9pZEIZChdfc3RyaXBlX3N1YnNjcmlwdGlvbl9pZCLkAQoEVGVhbRIKCgJpZBgBIAEoCRISCgpjb21wYW55X2lkGAIgASgJEgwKBG5hbWUYAyABKAkSGAoLZGVzY3JpcHRpb24YBCABKAlIAIgBARIuCgpjcmVhdGVkX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIWCgl0ZW5hbnRfaWQYByABKAlIAYgBAUIOCgxfZGVzY3JpcHRpb25CDAoKX3RlbmFudF9pZCLeAQoKVGVhbU1lbWJlchIKCgJpZBgBIAEoCRIPCgd0ZWFtX2lkGAIgASgJEg8KB3VzZXJfaWQYAyABKAkSIAoEcm9sZRgEIAEoDjISLm1pcmFpLnYxLlRlYW1Sb2xlEi4KCmNyZWF0ZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhYKCXRlbmFudF9pZBgGIAEoCUgAiAEBEiEKBHVzZXIYByABKAsyDi5taXJhaS52MS5Vc2VySAGIAQFCDAoKX3RlbmFudF9pZEIHCgVfdXNlcipRCgRQbGFuEhQKEFBMQU5fVU5TUEVDSUZJRUQQABIQCgxQTEFOX1NUQVJURVIQARIMCghQTEFOX1BSTxACEhMKD1BMQU5fRU5URVJQUklTRRADKngKBFJvbGUSFAoQUk9MRV9VTlNQRUNJRklFRBAAEhIKClJPTEVfT1dORVIQARoCCAESDgoKUk9MRV9BRE1JThACEhMKC1JPTEVfTUVNQkVSEAMaAggBEhMKD1JPTEVfSU5TVFJVQ1RPUhAEEgwKCFJPTEVfU01FEAUqTwoIVGVhbVJvbGUSGQoVVEVBTV9ST0xFX1VOU1BFQ0lGSUVEEAASEgoOVEVBTV9ST0xFX0xFQUQQARIUChBURUFNX1JPTEVfTUVNQkVSEAIquwEKElN1YnNjcmlwdGlvblN0YXR1cxIjCh9TVUJTQ1JJUFRJT05fU1RBVFVTX1VOU1BFQ0lGSUVEEAASHAoYU1VCU0NSSVBUSU9OX1NUQVRVU19OT05FEAESHgoaU1VCU0NSSVBUSU9OX1NUQVRVU19BQ1RJVkUQAhIgChxTVUJTQ1JJUFRJT05fU1RBVFVTX1BBU1RfRFVFEAMSIAocU1VCU0NSSVBUSU9OX1NUQVRVU19DQU5DRUxFRBAEQpEBCgxjb20ubWlyYWkudjFCC0NvbW1vblByb3RvUAFaM2dpdGh1Yi5jb20vc29nb3MvbWlyYWktYmFja2VuZC9nZW4vbWlyYWkvdjE7bWlyYWl2MaICA01YWKoCCE1pcmFpLlYxygIITWlyYWlcVjHiAhRNaXJhaVxWMVxHUEJNZXRhZGF0YeoCCU1pcmFpOjpWMWIGcHJvdG8z", [file_google_protobuf_timestamp]);
+  fileDesc("ChVtaXJhaS92MS9jb21tb24ucHJvdG8SCG1pcmFpLnYxIq4ECgRVc2VyEgoKAmlkGAEgASgJEhEKCWtyYXRvc19pZBgCIAEoCRIXCgpjb21wYW55X2lkGAMgASgJSACIAQESHAoEcm9sZRgEIAEoDjIOLm1pcmFpLnYxLlJvbGUSLgoKY3JlYXRlZF9hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASFgoJdGVuYW50X2lkGAcgASgJSAGIAQESEgoFZW1haWwYCCABKAlIAogBARIXCgpmaXJzdF9uYW1lGAkgASgJSAOIAQESFgoJbGFzdF9uYW1lGAogASgJSASIAQESEwoGbG9jYWxlGAsgASgJSAWIAQESEQoJaXNfYWN0aXZlGAwgASgIEhoKDWJvdW5jZWRfZW1haWwYDSABKAlIBogBARI5ChBlbWFpbF9ib3VuY2VkX2F0GA4gASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgHiAEBEh4KFndlZWtseV9zdW1tYXJ5X29wdF9vdXQYDyABKAhCDQoLX2NvbXBhbnlfaWRCDAoKX3RlbmFudF9pZEIICgZfZW1haWxCDQoLX2ZpcnN0X25hbWVCDAoKX2xhc3RfbmFtZUIJCgdfbG9jYWxlQhAKDl9ib3VuY2VkX2VtYWlsQhMKEV9lbWFpbF9ib3VuY2VkX2F0IsUDCgdDb21wYW55EgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSFQoIaW5kdXN0cnkYAyABKAlIAIgBARIWCgl0ZWFtX3NpemUYBCABKAlIAYgBARIcCgRwbGFuGAUgASgOMg4ubWlyYWkudjEuUGxhbhI5ChNzdWJzY3JpcHRpb25fc3RhdHVzGAYgASgOMhwubWlyYWkudjEuU3Vic2NyaXB0aW9uU3RhdHVzEh8KEnN0cmlwZV9jdXN0b21lcl9pZBgHIAEoCUgCiAEBEiMKFnN0cmlwZV9zdWJzY3JpcHRpb25faWQYCCABKAlIA4gBARIuCgpjcmVhdGVkX2F0GAkgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAogASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBISCgpzZWF0X2NvdW50GAsgASgFEhEKCXRlbmFudF9pZBgMIAEoCUILCglfaW5kdXN0cnlCDAoKX3RlYW1fc2l6ZUIVChNfc3RyaXBlX2N1c3RvbWVyX2lkQhkKF19zdHJpcGVfc3Vic2NyaXB0aW9uX2lkIuQBCgRUZWFtEgoKAmlkGAEgASgJEhIKCmNvbXBhbnlfaWQYAiABKAkSDAoEbmFtZRgDIAEoCRIYCgtkZXNjcmlwdGlvbhgEIAEoCUgAiAEBEi4KCmNyZWF0ZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhYKCXRlbmFudF9pZBgHIAEoCUgBiAEBQg4KDF9kZXNjcmlwdGlvbkIMCgpfdGVuYW50X2lkIt4BCgpUZWFtTWVtYmVyEgoKAmlkGAEgASgJEg8KB3RlYW1faWQYAiABKAkSDwoHdXNlcl9pZBgDIAEoCRIgCgRyb2xlGAQgASgOMhIubWlyYWkudjEuVGVhbVJvbGUSLgoKY3JlYXRlZF9hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASFgoJdGVuYW50X2lkGAYgASgJSACIAQESIQoEdXNlchgHIAEoCzIOLm1pcmFpLnYxLlVzZXJIAYgBAUIMCgpfdGVuYW50X2lkQgcKBV91c2VyKlEKBFBsYW4SFAoQUExBTl9VTlNQRUNJRklFRBAAEhAKDFBMQU5fU1RBUlRFUhABEgwKCFBMQU5fUFJPEAISEwoPUExBTl9FTlRFUlBSSVNFEAMqeAoEUm9sZRIUChBST0xFX1VOU1BFQ0lGSUVEEAASEgoKUk9MRV9PV05FUhABGgIIARIOCgpST0xFX0FETUlOEAISEwoLUk9MRV9NRU1CRVIQAxoCCAESEwoPUk9MRV9JTlNUUlVDVE9SEAQSDAoIUk9MRV9TTUUQBSpPCghUZWFtUm9sZRIZChVURUFNX1JPTEVfVU5TUEVDSUZJRUQQABISCg5URUFNX1JPTEVfTEVBRBABEhQKEFRFQU1fUk9MRV9NRU1CRVIQAiq7AQoSU3Vic2NyaXB0aW9uU3RhdHVzEiMKH1NVQlNDUklQVElPTl9TVEFUVVNfVU5TUEVDSUZJRUQQABIcChhTVUJTQ1JJUFRJT05fU1RBVFVTX05PTkUQARIeChpTVUJTQ1JJUFRJT05fU1RBVFVTX0FDVElWRRACEiAKHFNVQlNDUklQVElPTl9TVEFUVVNfUEFTVF9EVUUQAxIgChxTVUJTQ1JJUFRJT05fU1RBVFVTX0NBTkNFTEVEEARCkQEKDGNvbS5taXJhaS52MUILQ29tbW9uUHJvdG9QAVozZ2l0aHViLmNvbS9zb2dvcy9taXJhaS1iYWNrZW5kL2dlbi9taXJhaS92MTttaXJhaXYxogIDTVhYqgIITWlyYWkuVjHKAghNaXJhaVxWMeICFE1pcmFpXFYxXEdQQk1ldGFkYXRh6gIJTWlyYWk6OlYxYgZwcm90bzM", [file_google_protobuf_timestamp]);
 
 /**
  * User represents a user in the system.
@@ -77,6 +77,39 @@ export type User = Message<"mirai.v1.User"> & {
    * @generated from field: optional string last_name = 10;
    */
   lastName?: string;
+
+  /**
+   * Email language, synced from Kratos traits
+   *
+   * @generated from field: optional string locale = 11;
+   */
+  locale?: string;
+
+  /**
+   * False once deactivated; the user can no longer sign in
+   *
+   * @generated from field: bool is_active = 12;
+   */
+  isActive: boolean;
+
+  /**
+   * Address whose recent emails hard-bounced; email is paused while it's current
+   *
+   * @generated from field: optional string bounced_email = 13;
+   */
+  bouncedEmail?: string;
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp email_bounced_at = 14;
+   */
+  emailBouncedAt?: Timestamp;
+
+  /**
+   * Admin chose not to receive the weekly summary email
+   *
+   * @generated from field: bool weekly_summary_opt_out = 15;
+   */
+  weeklySummaryOptOut: boolean;
 };
 
 /**
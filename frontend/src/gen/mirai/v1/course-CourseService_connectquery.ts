@@ -47,7 +47,8 @@ export const deleteCourse = CourseService.method.deleteCourse;
 export const getFolderHierarchy = CourseService.method.getFolderHierarchy;
 
 /**
- * GetLibrary returns the full library with courses and folders.
+ * GetLibrary returns the full library with courses and folders. Kept for compatibility;
+ * the library page should use ListCourseCards and GetCourseCardDetails instead.
  *
  * @generated from rpc mirai.v1.CourseService.GetLibrary
  */
@@ -60,6 +61,13 @@ export const getLibrary = CourseService.method.getLibrary;
  */
 export const createFolder = CourseService.method.createFolder;
 
+/**
+ * UpdateFolder renames a folder or changes it between regular and team folders.
+ *
+ * @generated from rpc mirai.v1.CourseService.UpdateFolder
+ */
+export const updateFolder = CourseService.method.updateFolder;
+
 /**
  * DeleteFolder deletes an empty folder from the library.
  *
@@ -94,3 +102,129 @@ export const downloadExport = CourseService.method.downloadExport;
  * @generated from rpc mirai.v1.CourseService.ListExports
  */
 export const listExports = CourseService.method.listExports;
+
+/**
+ * ListCollaborators returns all collaborators assigned to a course.
+ *
+ * @generated from rpc mirai.v1.CourseService.ListCollaborators
+ */
+export const listCollaborators = CourseService.method.listCollaborators;
+
+/**
+ * AddCollaborator assigns a user to a course (owners and admins only).
+ *
+ * @generated from rpc mirai.v1.CourseService.AddCollaborator
+ */
+export const addCollaborator = CourseService.method.addCollaborator;
+
+/**
+ * RemoveCollaborator removes a user from a course (owners and admins only).
+ *
+ * @generated from rpc mirai.v1.CourseService.RemoveCollaborator
+ */
+export const removeCollaborator = CourseService.method.removeCollaborator;
+
+/**
+ * RemoveSampleContent deletes the onboarding sample folders, course and target audience (admins only).
+ *
+ * @generated from rpc mirai.v1.CourseService.RemoveSampleContent
+ */
+export const removeSampleContent = CourseService.method.removeSampleContent;
+
+/**
+ * ListLargestCourses returns the organization's largest courses by stored content size (admins only).
+ *
+ * @generated from rpc mirai.v1.CourseService.ListLargestCourses
+ */
+export const listLargestCourses = CourseService.method.listLargestCourses;
+
+/**
+ * PublishChanges makes a published course's edits the version learners see (editors only).
+ *
+ * @generated from rpc mirai.v1.CourseService.PublishChanges
+ */
+export const publishChanges = CourseService.method.publishChanges;
+
+/**
+ * DiscardDraft reverts a published course's edits to the version learners see (editors only).
+ *
+ * @generated from rpc mirai.v1.CourseService.DiscardDraft
+ */
+export const discardDraft = CourseService.method.discardDraft;
+
+/**
+ * SearchWithinCourse finds the blocks and lesson components of a course that contain a
+ * phrase (editors only).
+ *
+ * @generated from rpc mirai.v1.CourseService.SearchWithinCourse
+ */
+export const searchWithinCourse = CourseService.method.searchWithinCourse;
+
+/**
+ * GetCourseAttachmentUploadURL returns a presigned URL for uploading a reference file
+ * to a course (editors only).
+ *
+ * @generated from rpc mirai.v1.CourseService.GetCourseAttachmentUploadURL
+ */
+export const getCourseAttachmentUploadURL = CourseService.method.getCourseAttachmentUploadURL;
+
+/**
+ * ConfirmCourseAttachment records an uploaded reference file and queues extraction of
+ * its text (editors only).
+ *
+ * @generated from rpc mirai.v1.CourseService.ConfirmCourseAttachment
+ */
+export const confirmCourseAttachment = CourseService.method.confirmCourseAttachment;
+
+/**
+ * ListCourseAttachments lists a course's reference files with their extraction status.
+ *
+ * @generated from rpc mirai.v1.CourseService.ListCourseAttachments
+ */
+export const listCourseAttachments = CourseService.method.listCourseAttachments;
+
+/**
+ * SetCourseAttachmentExcluded includes a reference file in generation prompts or leaves
+ * it out (editors only).
+ *
+ * @generated from rpc mirai.v1.CourseService.SetCourseAttachmentExcluded
+ */
+export const setCourseAttachmentExcluded = CourseService.method.setCourseAttachmentExcluded;
+
+/**
+ * DeleteCourseAttachment removes a reference file and its extracted text (editors only).
+ *
+ * @generated from rpc mirai.v1.CourseService.DeleteCourseAttachment
+ */
+export const deleteCourseAttachment = CourseService.method.deleteCourseAttachment;
+
+/**
+ * ListCourseCards returns a page of lightweight course cards, most recently modified
+ * first. This is the preferred way to load the library.
+ *
+ * @generated from rpc mirai.v1.CourseService.ListCourseCards
+ */
+export const listCourseCards = CourseService.method.listCourseCards;
+
+/**
+ * GetCourseCardDetails returns tags, thumbnails, creators and durations for the course
+ * cards on screen (up to 50 at a time).
+ *
+ * @generated from rpc mirai.v1.CourseService.GetCourseCardDetails
+ */
+export const getCourseCardDetails = CourseService.method.getCourseCardDetails;
+
+/**
+ * HeartbeatCoursePresence marks the caller as having the course open and returns who else
+ * does. The editor calls it every 20 seconds; presence lapses a minute after the last call.
+ *
+ * @generated from rpc mirai.v1.CourseService.HeartbeatCoursePresence
+ */
+export const heartbeatCoursePresence = CourseService.method.heartbeatCoursePresence;
+
+/**
+ * GetCoursePresence returns who other than the caller has the course open.
+ *
+ * @generated from rpc mirai.v1.CourseService.GetCoursePresence
+ */
+export const getCoursePresence = CourseService.method.getCoursePresence;
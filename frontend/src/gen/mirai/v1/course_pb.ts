@@ -12,7 +12,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file mirai/v1/course.proto.
  */
 export const file_mirai_v1_course: GenFile = /*@__PURE__*/
-  fileDesc("ChVtaXJhaS92MS9jb3Vyc2UucHJvdG8SCG1pcmFpLnYxIi0KEUxlYXJuaW5nT2JqZWN0aXZlEgoKAmlkGAEgASgJEgwKBHRleHQYAiABKAkihQIKB1BlcnNvbmESCgoCaWQYASABKAkSDAoEbmFtZRgCIAEoCRIMCgRyb2xlGAMgASgJEgwKBGtwaXMYBCABKAkSGAoQcmVzcG9uc2liaWxpdGllcxgFIAEoCRIXCgpjaGFsbGVuZ2VzGAYgASgJSACIAQESFQoIY29uY2VybnMYByABKAlIAYgBARIWCglrbm93bGVkZ2UYCCABKAlIAogBARI4ChNsZWFybmluZ19vYmplY3RpdmVzGAkgAygLMhsubWlyYWkudjEuTGVhcm5pbmdPYmplY3RpdmVCDQoLX2NoYWxsZW5nZXNCCwoJX2NvbmNlcm5zQgwKCl9rbm93bGVkZ2UiTQoOQmxvY2tBbGlnbm1lbnQSEAoIcGVyc29uYXMYASADKAkSGwoTbGVhcm5pbmdfb2JqZWN0aXZlcxgCIAMoCRIMCgRrcGlzGAMgAygJIrwBCgtDb3Vyc2VCbG9jaxIKCgJpZBgBIAEoCRIhCgR0eXBlGAIgASgOMhMubWlyYWkudjEuQmxvY2tUeXBlEg8KB2NvbnRlbnQYAyABKAkSEwoGcHJvbXB0GAQgASgJSACIAQESMAoJYWxpZ25tZW50GAUgASgLMhgubWlyYWkudjEuQmxvY2tBbGlnbm1lbnRIAYgBARINCgVvcmRlchgGIAEoBUIJCgdfcHJvbXB0QgwKCl9hbGlnbm1lbnQibAoGTGVzc29uEgoKAmlkGAEgASgJEg0KBXRpdGxlGAIgASgJEhQKB2NvbnRlbnQYAyABKAlIAIgBARIlCgZibG9ja3MYBCADKAsyFS5taXJhaS52MS5Db3Vyc2VCbG9ja0IKCghfY29udGVudCJMCg1Db3Vyc2VTZWN0aW9uEgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSIQoHbGVzc29ucxgDIAMoCzIQLm1pcmFpLnYxLkxlc3NvbiJZChJBc3Nlc3NtZW50U2V0dGluZ3MSKAogZW5hYmxlX2VtYmVkZGVkX2tub3dsZWRnZV9jaGVja3MYASABKAgSGQoRZW5hYmxlX2ZpbmFsX2V4YW0YAiABKAgiaAoNQ291cnNlQ29udGVudBIpCghzZWN0aW9ucxgBIAMoCzIXLm1pcmFpLnYxLkNvdXJzZVNlY3Rpb24SLAoNY291cnNlX2Jsb2NrcxgCIAMoCzIVLm1pcmFpLnYxLkNvdXJzZUJsb2NrIusBCgxDb3Vyc2VFeHBvcnQSCgoCaWQYASABKAkSLQoJdGltZXN0YW1wGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBImCgZmb3JtYXQYAyABKA4yFi5taXJhaS52MS5FeHBvcnRGb3JtYXQSDwoHdmVyc2lvbhgEIAEoBRIRCglmaWxlX3BhdGgYBSABKAkSJgoGc3RhdHVzGAYgASgOMhYubWlyYWkudjEuRXhwb3J0U3RhdHVzEhoKDWVycm9yX21lc3NhZ2UYByABKAlIAIgBAUIQCg5fZXJyb3JfbWVzc2FnZSKAAQoOQ291cnNlU2V0dGluZ3MSDQoFdGl0bGUYASABKAkSFwoPZGVzaXJlZF9vdXRjb21lGAIgASgJEhoKEmRlc3RpbmF0aW9uX2ZvbGRlchgDIAEoCRIVCg1jYXRlZ29yeV90YWdzGAQgAygJEhMKC2RhdGFfc291cmNlGAUgASgJIt4BCg5Db3Vyc2VNZXRhZGF0YRIKCgJpZBgBIAEoCRIPCgd2ZXJzaW9uGAIgASgFEiYKBnN0YXR1cxgDIAEoDjIWLm1pcmFpLnYxLkNvdXJzZVN0YXR1cxIuCgpjcmVhdGVkX2F0GAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIvCgttb2RpZmllZF9hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASFwoKY3JlYXRlZF9ieRgGIAEoCUgAiAEBQg0KC19jcmVhdGVkX2J5IroECgZDb3Vyc2USCgoCaWQYASABKAkSDwoHdmVyc2lvbhgCIAEoBRImCgZzdGF0dXMYAyABKA4yFi5taXJhaS52MS5Db3Vyc2VTdGF0dXMSKgoIbWV0YWRhdGEYBCABKAsyGC5taXJhaS52MS5Db3Vyc2VNZXRhZGF0YRIqCghzZXR0aW5ncxgFIAEoCzIYLm1pcmFpLnYxLkNvdXJzZVNldHRpbmdzEiMKCHBlcnNvbmFzGAYgAygLMhEubWlyYWkudjEuUGVyc29uYRI4ChNsZWFybmluZ19vYmplY3RpdmVzGAcgAygLMhsubWlyYWkudjEuTGVhcm5pbmdPYmplY3RpdmUSOQoTYXNzZXNzbWVudF9zZXR0aW5ncxgIIAEoCzIcLm1pcmFpLnYxLkFzc2Vzc21lbnRTZXR0aW5ncxIoCgdjb250ZW50GAkgASgLMhcubWlyYWkudjEuQ291cnNlQ29udGVudBInCgdleHBvcnRzGAogAygLMhYubWlyYWkudjEuQ291cnNlRXhwb3J0EhcKCmNvbXBhbnlfaWQYCyABKAlIAIgBARIWCgl0ZW5hbnRfaWQYDCABKAlIAYgBARIfChJjcmVhdGVkX2J5X3VzZXJfaWQYDSABKAlIAogBARIUCgd0ZWFtX2lkGA4gASgJSAOIAQFCDQoLX2NvbXBhbnlfaWRCDAoKX3RlbmFudF9pZEIVChNfY3JlYXRlZF9ieV91c2VyX2lkQgoKCF90ZWFtX2lkIpgDCgxMaWJyYXJ5RW50cnkSCgoCaWQYASABKAkSDQoFdGl0bGUYAiABKAkSJgoGc3RhdHVzGAMgASgOMhYubWlyYWkudjEuQ291cnNlU3RhdHVzEg4KBmZvbGRlchgEIAEoCRIMCgR0YWdzGAUgAygJEi4KCmNyZWF0ZWRfYXQYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi8KC21vZGlmaWVkX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIXCgpjcmVhdGVkX2J5GAggASgJSACIAQESGwoOdGh1bWJuYWlsX3BhdGgYCSABKAlIAYgBARIXCgpjb21wYW55X2lkGAogASgJSAKIAQESFgoJdGVuYW50X2lkGAsgASgJSAOIAQESFAoHdGVhbV9pZBgMIAEoCUgEiAEBQg0KC19jcmVhdGVkX2J5QhEKD190aHVtYm5haWxfcGF0aEINCgtfY29tcGFueV9pZEIMCgpfdGVuYW50X2lkQgoKCF90ZWFtX2lkIrwBCgZGb2xkZXISCgoCaWQYASABKAkSDAoEbmFtZRgCIAEoCRIWCglwYXJlbnRfaWQYAyABKAlIAIgBARIiCgR0eXBlGAQgASgOMhQubWlyYWkudjEuRm9sZGVyVHlwZRIiCghjaGlsZHJlbhgFIAMoCzIQLm1pcmFpLnYxLkZvbGRlchIZCgxjb3Vyc2VfY291bnQYBiABKAVIAYgBAUIMCgpfcGFyZW50X2lkQg8KDV9jb3Vyc2VfY291bnQimAEKB0xpYnJhcnkSDwoHdmVyc2lvbhgBIAEoCRIwCgxsYXN0X3VwZGF0ZWQYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEicKB2NvdXJzZXMYAyADKAsyFi5taXJhaS52MS5MaWJyYXJ5RW50cnkSIQoHZm9sZGVycxgEIAMoCzIQLm1pcmFpLnYxLkZvbGRlciKZAQoSTGlzdENvdXJzZXNSZXF1ZXN0EisKBnN0YXR1cxgBIAEoDjIWLm1pcmFpLnYxLkNvdXJzZVN0YXR1c0gAiAEBEhMKBmZvbGRlchgCIAEoCUgBiAEBEgwKBHRhZ3MYAyADKAkSDQoFbGltaXQYBCABKAUSDgoGb2Zmc2V0GAUgASgFQgkKB19zdGF0dXNCCQoHX2ZvbGRlciJlChNMaXN0Q291cnNlc1Jlc3BvbnNlEicKB2NvdXJzZXMYASADKAsyFi5taXJhaS52MS5MaWJyYXJ5RW50cnkSEwoLdG90YWxfY291bnQYAiABKAUSEAoIaGFzX21vcmUYAyABKAgiHgoQR2V0Q291cnNlUmVxdWVzdBIKCgJpZBgBIAEoCSI1ChFHZXRDb3Vyc2VSZXNwb25zZRIgCgZjb3Vyc2UYASABKAsyEC5taXJhaS52MS5Db3Vyc2Ui3QIKE0NyZWF0ZUNvdXJzZVJlcXVlc3QSDwoCaWQYASABKAlIAIgBARIvCghzZXR0aW5ncxgCIAEoCzIYLm1pcmFpLnYxLkNvdXJzZVNldHRpbmdzSAGIAQESIwoIcGVyc29uYXMYAyADKAsyES5taXJhaS52MS5QZXJzb25hEjgKE2xlYXJuaW5nX29iamVjdGl2ZXMYBCADKAsyGy5taXJhaS52MS5MZWFybmluZ09iamVjdGl2ZRI+ChNhc3Nlc3NtZW50X3NldHRpbmdzGAUgASgLMhwubWlyYWkudjEuQXNzZXNzbWVudFNldHRpbmdzSAKIAQESLQoHY29udGVudBgGIAEoCzIXLm1pcmFpLnYxLkNvdXJzZUNvbnRlbnRIA4gBAUIFCgNfaWRCCwoJX3NldHRpbmdzQhYKFF9hc3Nlc3NtZW50X3NldHRpbmdzQgoKCF9jb250ZW50IjgKFENyZWF0ZUNvdXJzZVJlc3BvbnNlEiAKBmNvdXJzZRgBIAEoCzIQLm1pcmFpLnYxLkNvdXJzZSLHAwoTVXBkYXRlQ291cnNlUmVxdWVzdBIKCgJpZBgBIAEoCRIvCghzZXR0aW5ncxgCIAEoCzIYLm1pcmFpLnYxLkNvdXJzZVNldHRpbmdzSACIAQESIwoIcGVyc29uYXMYAyADKAsyES5taXJhaS52MS5QZXJzb25hEjgKE2xlYXJuaW5nX29iamVjdGl2ZXMYBCADKAsyGy5taXJhaS52MS5MZWFybmluZ09iamVjdGl2ZRI+ChNhc3Nlc3NtZW50X3NldHRpbmdzGAUgASgLMhwubWlyYWkudjEuQXNzZXNzbWVudFNldHRpbmdzSAGIAQESLQoHY29udGVudBgGIAEoCzIXLm1pcmFpLnYxLkNvdXJzZUNvbnRlbnRIAogBARIrCgZzdGF0dXMYByABKA4yFi5taXJhaS52MS5Db3Vyc2VTdGF0dXNIA4gBARIvCghtZXRhZGF0YRgIIAEoCzIYLm1pcmFpLnYxLkNvdXJzZU1ldGFkYXRhSASIAQFCCwoJX3NldHRpbmdzQhYKFF9hc3Nlc3NtZW50X3NldHRpbmdzQgoKCF9jb250ZW50QgkKB19zdGF0dXNCCwoJX21ldGFkYXRhIjgKFFVwZGF0ZUNvdXJzZVJlc3BvbnNlEiAKBmNvdXJzZRgBIAEoCzIQLm1pcmFpLnYxLkNvdXJzZSIhChNEZWxldGVDb3Vyc2VSZXF1ZXN0EgoKAmlkGAEgASgJIicKFERlbGV0ZUNvdXJzZVJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgiOgoZR2V0Rm9sZGVySGllcmFyY2h5UmVxdWVzdBIdChVpbmNsdWRlX2NvdXJzZV9jb3VudHMYASABKAgiPwoaR2V0Rm9sZGVySGllcmFyY2h5UmVzcG9uc2USIQoHZm9sZGVycxgBIAMoCzIQLm1pcmFpLnYxLkZvbGRlciIyChFHZXRMaWJyYXJ5UmVxdWVzdBIdChVpbmNsdWRlX2NvdXJzZV9jb3VudHMYASABKAgiOAoSR2V0TGlicmFyeVJlc3BvbnNlEiIKB2xpYnJhcnkYASABKAsyES5taXJhaS52MS5MaWJyYXJ5Im0KE0NyZWF0ZUZvbGRlclJlcXVlc3QSDAoEbmFtZRgBIAEoCRIWCglwYXJlbnRfaWQYAiABKAlIAIgBARIiCgR0eXBlGAMgASgOMhQubWlyYWkudjEuRm9sZGVyVHlwZUIMCgpfcGFyZW50X2lkIjgKFENyZWF0ZUZvbGRlclJlc3BvbnNlEiAKBmZvbGRlchgBIAEoCzIQLm1pcmFpLnYxLkZvbGRlciIhChNEZWxldGVGb2xkZXJSZXF1ZXN0EgoKAmlkGAEgASgJIicKFERlbGV0ZUZvbGRlclJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgiUAoTRXhwb3J0Q291cnNlUmVxdWVzdBIRCgljb3Vyc2VfaWQYASABKAkSJgoGZm9ybWF0GAIgASgOMhYubWlyYWkudjEuRXhwb3J0Rm9ybWF0Ij4KFEV4cG9ydENvdXJzZVJlc3BvbnNlEiYKBmV4cG9ydBgBIAEoCzIWLm1pcmFpLnYxLkNvdXJzZUV4cG9ydCIrChZHZXRFeHBvcnRTdGF0dXNSZXF1ZXN0EhEKCWV4cG9ydF9pZBgBIAEoCSJBChdHZXRFeHBvcnRTdGF0dXNSZXNwb25zZRImCgZleHBvcnQYASABKAsyFi5taXJhaS52MS5Db3Vyc2VFeHBvcnQiKgoVRG93bmxvYWRFeHBvcnRSZXF1ZXN0EhEKCWV4cG9ydF9pZBgBIAEoCSJeChZEb3dubG9hZEV4cG9ydFJlc3BvbnNlEhQKDGRvd25sb2FkX3VybBgBIAEoCRIuCgpleHBpcmVzX2F0GAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCInChJMaXN0RXhwb3J0c1JlcXVlc3QSEQoJY291cnNlX2lkGAEgASgJIj4KE0xpc3RFeHBvcnRzUmVzcG9uc2USJwoHZXhwb3J0cxgBIAMoCzIWLm1pcmFpLnYxLkNvdXJzZUV4cG9ydCqAAQoMQ291cnNlU3RhdHVzEh0KGUNPVVJTRV9TVEFUVVNfVU5TUEVDSUZJRUQQABIXChNDT1VSU0VfU1RBVFVTX0RSQUZUEAESGwoXQ09VUlNFX1NUQVRVU19QVUJMSVNIRUQQAhIbChdDT1VSU0VfU1RBVFVTX0dFTkVSQVRFRBADKpABCglCbG9ja1R5cGUSGgoWQkxPQ0tfVFlQRV9VTlNQRUNJRklFRBAAEhYKEkJMT0NLX1RZUEVfSEVBRElORxABEhMKD0JMT0NLX1RZUEVfVEVYVBACEhoKFkJMT0NLX1RZUEVfSU5URVJBQ1RJVkUQAxIeChpCTE9DS19UWVBFX0tOT1dMRURHRV9DSEVDSxAEKooBCgpGb2xkZXJUeXBlEhsKF0ZPTERFUl9UWVBFX1VOU1BFQ0lGSUVEEAASFwoTRk9MREVSX1RZUEVfTElCUkFSWRABEhQKEEZPTERFUl9UWVBFX1RFQU0QAhIYChRGT0xERVJfVFlQRV9QRVJTT05BTBADEhYKEkZPTERFUl9UWVBFX0ZPTERFUhAEKpYBCgxFeHBvcnRGb3JtYXQSHQoZRVhQT1JUX0ZPUk1BVF9VTlNQRUNJRklFRBAAEhoKFkVYUE9SVF9GT1JNQVRfU0NPUk1fMTIQARIcChhFWFBPUlRfRk9STUFUX1NDT1JNXzIwMDQQAhIWChJFWFBPUlRfRk9STUFUX1hBUEkQAxIVChFFWFBPUlRfRk9STUFUX1BERhAEKp0BCgxFeHBvcnRTdGF0dXMSHQoZRVhQT1JUX1NUQVRVU19VTlNQRUNJRklFRBAAEhkKFUVYUE9SVF9TVEFUVVNfUEVORElORxABEhwKGEVYUE9SVF9TVEFUVVNfUFJPQ0VTU0lORxACEhsKF0VYUE9SVF9TVEFUVVNfQ09NUExFVEVEEAMSGAoURVhQT1JUX1NUQVRVU19GQUlMRUQQBDKeCAoNQ291cnNlU2VydmljZRJKCgtMaXN0Q291cnNlcxIcLm1pcmFpLnYxLkxpc3RDb3Vyc2VzUmVxdWVzdBodLm1pcmFpLnYxLkxpc3RDb3Vyc2VzUmVzcG9uc2USRAoJR2V0Q291cnNlEhoubWlyYWkudjEuR2V0Q291cnNlUmVxdWVzdBobLm1pcmFpLnYxLkdldENvdXJzZVJlc3BvbnNlEk0KDENyZWF0ZUNvdXJzZRIdLm1pcmFpLnYxLkNyZWF0ZUNvdXJzZVJlcXVlc3QaHi5taXJhaS52MS5DcmVhdGVDb3Vyc2VSZXNwb25zZRJNCgxVcGRhdGVDb3Vyc2USHS5taXJhaS52MS5VcGRhdGVDb3Vyc2VSZXF1ZXN0Gh4ubWlyYWkudjEuVXBkYXRlQ291cnNlUmVzcG9uc2USTQoMRGVsZXRlQ291cnNlEh0ubWlyYWkudjEuRGVsZXRlQ291cnNlUmVxdWVzdBoeLm1pcmFpLnYxLkRlbGV0ZUNvdXJzZVJlc3BvbnNlEl8KEkdldEZvbGRlckhpZXJhcmNoeRIjLm1pcmFpLnYxLkdldEZvbGRlckhpZXJhcmNoeVJlcXVlc3QaJC5taXJhaS52MS5HZXRGb2xkZXJIaWVyYXJjaHlSZXNwb25zZRJHCgpHZXRMaWJyYXJ5EhsubWlyYWkudjEuR2V0TGlicmFyeVJlcXVlc3QaHC5taXJhaS52MS5HZXRMaWJyYXJ5UmVzcG9uc2USTQoMQ3JlYXRlRm9sZGVyEh0ubWlyYWkudjEuQ3JlYXRlRm9sZGVyUmVxdWVzdBoeLm1pcmFpLnYxLkNyZWF0ZUZvbGRlclJlc3BvbnNlEk0KDERlbGV0ZUZvbGRlchIdLm1pcmFpLnYxLkRlbGV0ZUZvbGRlclJlcXVlc3QaHi5taXJhaS52MS5EZWxldGVGb2xkZXJSZXNwb25zZRJNCgxFeHBvcnRDb3Vyc2USHS5taXJhaS52MS5FeHBvcnRDb3Vyc2VSZXF1ZXN0Gh4ubWlyYWkudjEuRXhwb3J0Q291cnNlUmVzcG9uc2USVgoPR2V0RXhwb3J0U3RhdHVzEiAubWlyYWkudjEuR2V0RXhwb3J0U3RhdHVzUmVxdWVzdBohLm1pcmFpLnYxLkdldEV4cG9ydFN0YXR1c1Jlc3BvbnNlElMKDkRvd25sb2FkRXhwb3J0Eh8ubWlyYWkudjEuRG93bmxvYWRFeHBvcnRSZXF1ZXN0GiAubWlyYWkudjEuRG93bmxvYWRFeHBvcnRSZXNwb25zZRJKCgtMaXN0RXhwb3J0cxIcLm1pcmFpLnYxLkxpc3RFeHBvcnRzUmVxdWVzdBodLm1pcmFpLnYxLkxpc3RFeHBvcnRzUmVzcG9uc2VCkQEKDGNvbS5taXJhaS52MUILQ291cnNlUHJvdG9QAVozZ2l0aHViLmNvbS9zb2dvcy9taXJhaS1iYWNrZW5kL2dlbi9taXJhaS92MTttaXJhaXYxogIDTVhYqgIITWlyYWkuVjHKAghNaXJhaVxWMeICFE1pcmFpXFYxXEdQQk1ldGFkYXRh6gIJTWlyYWk6OlYxYgZwcm90bzM", [file_google_protobuf_timestamp]);
+  fileDesc("ChVtaXJhaS92MS9jb3Vyc2UucHJvdG8SCG1pcmFpLnYxIi0KEUxlYXJuaW5nT2JqZWN0aXZlEgoKAmlkGAEgASgJEgwKBHRleHQYAiABKAkihQIKB1BlcnNvbmESCgoCaWQYASABKAkSDAoEbmFtZRgCIAEoCRIMCgRyb2xlGAMgASgJEgwKBGtwaXMYBCABKAkSGAoQcmVzcG9uc2liaWxpdGllcxgFIAEoCRIXCgpjaGFsbGVuZ2VzGAYgASgJSACIAQESFQoIY29uY2VybnMYByABKAlIAYgBARIWCglrbm93bGVkZ2UYCCABKAlIAogBARI4ChNsZWFybmluZ19vYmplY3RpdmVzGAkgAygLMhsubWlyYWkudjEuTGVhcm5pbmdPYmplY3RpdmVCDQoLX2NoYWxsZW5nZXNCCwoJX2NvbmNlcm5zQgwKCl9rbm93bGVkZ2UiTQoOQmxvY2tBbGlnbm1lbnQSEAoIcGVyc29uYXMYASADKAkSGwoTbGVhcm5pbmdfb2JqZWN0aXZlcxgCIAMoCRIMCgRrcGlzGAMgAygJIrwBCgtDb3Vyc2VCbG9jaxIKCgJpZBgBIAEoCRIhCgR0eXBlGAIgASgOMhMubWlyYWkudjEuQmxvY2tUeXBlEg8KB2NvbnRlbnQYAyABKAkSEwoGcHJvbXB0GAQgASgJSACIAQESMAoJYWxpZ25tZW50GAUgASgLMhgubWlyYWkudjEuQmxvY2tBbGlnbm1lbnRIAYgBARINCgVvcmRlchgGIAEoBUIJCgdfcHJvbXB0QgwKCl9hbGlnbm1lbnQibAoGTGVzc29uEgoKAmlkGAEgASgJEg0KBXRpdGxlGAIgASgJEhQKB2NvbnRlbnQYAyABKAlIAIgBARIlCgZibG9ja3MYBCADKAsyFS5taXJhaS52MS5Db3Vyc2VCbG9ja0IKCghfY29udGVudCJMCg1Db3Vyc2VTZWN0aW9uEgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSIQoHbGVzc29ucxgDIAMoCzIQLm1pcmFpLnYxLkxlc3NvbiJZChJBc3Nlc3NtZW50U2V0dGluZ3MSKAogZW5hYmxlX2VtYmVkZGVkX2tub3dsZWRnZV9jaGVja3MYASABKAgSGQoRZW5hYmxlX2ZpbmFsX2V4YW0YAiABKAgiaAoNQ291cnNlQ29udGVudBIpCghzZWN0aW9ucxgBIAMoCzIXLm1pcmFpLnYxLkNvdXJzZVNlY3Rpb24SLAoNY291cnNlX2Jsb2NrcxgCIAMoCzIVLm1pcmFpLnYxLkNvdXJzZUJsb2NrIroDCgxDb3Vyc2VFeHBvcnQSCgoCaWQYASABKAkSLQoJdGltZXN0YW1wGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBImCgZmb3JtYXQYAyABKA4yFi5taXJhaS52MS5FeHBvcnRGb3JtYXQSDwoHdmVyc2lvbhgEIAEoBRIRCglmaWxlX3BhdGgYBSABKAkSJgoGc3RhdHVzGAYgASgOMhYubWlyYWkudjEuRXhwb3J0U3RhdHVzEhoKDWVycm9yX21lc3NhZ2UYByABKAlIAIgBARIuCgpleHBpcmVzX2F0GAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBISCgpzaXplX2J5dGVzGAkgASgDEjIKCXB1cmdlZF9hdBgKIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIAYgBARI2CgxwdXJnZV9yZWFzb24YCyABKA4yGy5taXJhaS52MS5FeHBvcnRQdXJnZVJlYXNvbkgCiAEBQhAKDl9lcnJvcl9tZXNzYWdlQgwKCl9wdXJnZWRfYXRCDwoNX3B1cmdlX3JlYXNvbiKAAQoOQ291cnNlU2V0dGluZ3MSDQoFdGl0bGUYASABKAkSFwoPZGVzaXJlZF9vdXRjb21lGAIgASgJEhoKEmRlc3RpbmF0aW9uX2ZvbGRlchgDIAEoCRIVCg1jYXRlZ29yeV90YWdzGAQgAygJEhMKC2RhdGFfc291cmNlGAUgASgJIt4BCg5Db3Vyc2VNZXRhZGF0YRIKCgJpZBgBIAEoCRIPCgd2ZXJzaW9uGAIgASgFEiYKBnN0YXR1cxgDIAEoDjIWLm1pcmFpLnYxLkNvdXJzZVN0YXR1cxIuCgpjcmVhdGVkX2F0GAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIvCgttb2RpZmllZF9hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASFwoKY3JlYXRlZF9ieRgGIAEoCUgAiAEBQg0KC19jcmVhdGVkX2J5IvYECgZDb3Vyc2USCgoCaWQYASABKAkSDwoHdmVyc2lvbhgCIAEoBRImCgZzdGF0dXMYAyABKA4yFi5taXJhaS52MS5Db3Vyc2VTdGF0dXMSKgoIbWV0YWRhdGEYBCABKAsyGC5taXJhaS52MS5Db3Vyc2VNZXRhZGF0YRIqCghzZXR0aW5ncxgFIAEoCzIYLm1pcmFpLnYxLkNvdXJzZVNldHRpbmdzEiMKCHBlcnNvbmFzGAYgAygLMhEubWlyYWkudjEuUGVyc29uYRI4ChNsZWFybmluZ19vYmplY3RpdmVzGAcgAygLMhsubWlyYWkudjEuTGVhcm5pbmdPYmplY3RpdmUSOQoTYXNzZXNzbWVudF9zZXR0aW5ncxgIIAEoCzIcLm1pcmFpLnYxLkFzc2Vzc21lbnRTZXR0aW5ncxIoCgdjb250ZW50GAkgASgLMhcubWlyYWkudjEuQ291cnNlQ29udGVudBInCgdleHBvcnRzGAogAygLMhYubWlyYWkudjEuQ291cnNlRXhwb3J0EhcKCmNvbXBhbnlfaWQYCyABKAlIAIgBARIWCgl0ZW5hbnRfaWQYDCABKAlIAYgBARIfChJjcmVhdGVkX2J5X3VzZXJfaWQYDSABKAlIAogBARIUCgd0ZWFtX2lkGA4gASgJSAOIAQESGQoRcHVibGlzaGVkX3ZlcnNpb24YDyABKAUSHwoXaGFzX3VucHVibGlzaGVkX2NoYW5nZXMYECABKAhCDQoLX2NvbXBhbnlfaWRCDAoKX3RlbmFudF9pZEIVChNfY3JlYXRlZF9ieV91c2VyX2lkQgoKCF90ZWFtX2lkIpQECgxMaWJyYXJ5RW50cnkSCgoCaWQYASABKAkSDQoFdGl0bGUYAiABKAkSJgoGc3RhdHVzGAMgASgOMhYubWlyYWkudjEuQ291cnNlU3RhdHVzEg4KBmZvbGRlchgEIAEoCRIMCgR0YWdzGAUgAygJEi4KCmNyZWF0ZWRfYXQYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi8KC21vZGlmaWVkX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIXCgpjcmVhdGVkX2J5GAggASgJSACIAQESGwoOdGh1bWJuYWlsX3BhdGgYCSABKAlIAYgBARIXCgpjb21wYW55X2lkGAogASgJSAKIAQESFgoJdGVuYW50X2lkGAsgASgJSAOIAQESFAoHdGVhbV9pZBgMIAEoCUgEiAEBEi4KC2NhbGxlcl9yb2xlGA0gASgOMhQubWlyYWkudjEuQ291cnNlUm9sZUgFiAEBEhkKEWNyZWF0ZWRfYnlfYWN0aXZlGA4gASgIEh8KF2hhc191bnB1Ymxpc2hlZF9jaGFuZ2VzGA8gASgIQg0KC19jcmVhdGVkX2J5QhEKD190aHVtYm5haWxfcGF0aEINCgtfY29tcGFueV9pZEIMCgpfdGVuYW50X2lkQgoKCF90ZWFtX2lkQg4KDF9jYWxsZXJfcm9sZSLMAQoSQ291cnNlQ29sbGFib3JhdG9yEgoKAmlkGAEgASgJEhEKCWNvdXJzZV9pZBgCIAEoCRIPCgd1c2VyX2lkGAMgASgJEiIKBHJvbGUYBCABKA4yFC5taXJhaS52MS5Db3Vyc2VSb2xlEi4KCmNyZWF0ZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEh0KEGFkZGVkX2J5X3VzZXJfaWQYBiABKAlIAIgBAUITChFfYWRkZWRfYnlfdXNlcl9pZCL0AQoGRm9sZGVyEgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSFgoJcGFyZW50X2lkGAMgASgJSACIAQESIgoEdHlwZRgEIAEoDjIULm1pcmFpLnYxLkZvbGRlclR5cGUSIgoIY2hpbGRyZW4YBSADKAsyEC5taXJhaS52MS5Gb2xkZXISGQoMY291cnNlX2NvdW50GAYgASgFSAGIAQESFAoMaXNfcHJvdGVjdGVkGAcgASgIEhQKB3RlYW1faWQYCCABKAlIAogBAUIMCgpfcGFyZW50X2lkQg8KDV9jb3Vyc2VfY291bnRCCgoIX3RlYW1faWQimAEKB0xpYnJhcnkSDwoHdmVyc2lvbhgBIAEoCRIwCgxsYXN0X3VwZGF0ZWQYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEicKB2NvdXJzZXMYAyADKAsyFi5taXJhaS52MS5MaWJyYXJ5RW50cnkSIQoHZm9sZGVycxgEIAMoCzIQLm1pcmFpLnYxLkZvbGRlciK1AQoSTGlzdENvdXJzZXNSZXF1ZXN0EisKBnN0YXR1cxgBIAEoDjIWLm1pcmFpLnYxLkNvdXJzZVN0YXR1c0gAiAEBEhMKBmZvbGRlchgCIAEoCUgBiAEBEgwKBHRhZ3MYAyADKAkSDQoFbGltaXQYBCABKAUSDgoGb2Zmc2V0GAUgASgFEhEKBG1pbmUYBiABKAhIAogBAUIJCgdfc3RhdHVzQgkKB19mb2xkZXJCBwoFX21pbmUiZQoTTGlzdENvdXJzZXNSZXNwb25zZRInCgdjb3Vyc2VzGAEgAygLMhYubWlyYWkudjEuTGlicmFyeUVudHJ5EhMKC3RvdGFsX2NvdW50GAIgASgFEhAKCGhhc19tb3JlGAMgASgIIh4KEEdldENvdXJzZVJlcXVlc3QSCgoCaWQYASABKAkiywEKEUdldENvdXJzZVJlc3BvbnNlEiAKBmNvdXJzZRgBIAEoCzIQLm1pcmFpLnYxLkNvdXJzZRIlChhhY3RpdmVfZ2VuZXJhdGlvbl9qb2JfaWQYAiABKAlIAIgBARI8Cg9nZW5lcmF0aW9uX2xvY2sYAyABKAsyHi5taXJhaS52MS5Db3Vyc2VHZW5lcmF0aW9uTG9ja0gBiAEBQhsKGV9hY3RpdmVfZ2VuZXJhdGlvbl9qb2JfaWRCEgoQX2dlbmVyYXRpb25fbG9jayLdAgoTQ3JlYXRlQ291cnNlUmVxdWVzdBIPCgJpZBgBIAEoCUgAiAEBEi8KCHNldHRpbmdzGAIgASgLMhgubWlyYWkudjEuQ291cnNlU2V0dGluZ3NIAYgBARIjCghwZXJzb25hcxgDIAMoCzIRLm1pcmFpLnYxLlBlcnNvbmESOAoTbGVhcm5pbmdfb2JqZWN0aXZlcxgEIAMoCzIbLm1pcmFpLnYxLkxlYXJuaW5nT2JqZWN0aXZlEj4KE2Fzc2Vzc21lbnRfc2V0dGluZ3MYBSABKAsyHC5taXJhaS52MS5Bc3Nlc3NtZW50U2V0dGluZ3NIAogBARItCgdjb250ZW50GAYgASgLMhcubWlyYWkudjEuQ291cnNlQ29udGVudEgDiAEBQgUKA19pZEILCglfc2V0dGluZ3NCFgoUX2Fzc2Vzc21lbnRfc2V0dGluZ3NCCgoIX2NvbnRlbnQiUgoUQ3JlYXRlQ291cnNlUmVzcG9uc2USIAoGY291cnNlGAEgASgLMhAubWlyYWkudjEuQ291cnNlEhgKEGRlZmF1bHRlZF9maWVsZHMYAiADKAkixwMKE1VwZGF0ZUNvdXJzZVJlcXVlc3QSCgoCaWQYASABKAkSLwoIc2V0dGluZ3MYAiABKAsyGC5taXJhaS52MS5Db3Vyc2VTZXR0aW5nc0gAiAEBEiMKCHBlcnNvbmFzGAMgAygLMhEubWlyYWkudjEuUGVyc29uYRI4ChNsZWFybmluZ19vYmplY3RpdmVzGAQgAygLMhsubWlyYWkudjEuTGVhcm5pbmdPYmplY3RpdmUSPgoTYXNzZXNzbWVudF9zZXR0aW5ncxgFIAEoCzIcLm1pcmFpLnYxLkFzc2Vzc21lbnRTZXR0aW5nc0gBiAEBEi0KB2NvbnRlbnQYBiABKAsyFy5taXJhaS52MS5Db3Vyc2VDb250ZW50SAKIAQESKwoGc3RhdHVzGAcgASgOMhYubWlyYWkudjEuQ291cnNlU3RhdHVzSAOIAQESLwoIbWV0YWRhdGEYCCABKAsyGC5taXJhaS52MS5Db3Vyc2VNZXRhZGF0YUgEiAEBQgsKCV9zZXR0aW5nc0IWChRfYXNzZXNzbWVudF9zZXR0aW5nc0IKCghfY29udGVudEIJCgdfc3RhdHVzQgsKCV9tZXRhZGF0YSKAAQoUVXBkYXRlQ291cnNlUmVzcG9uc2USIAoGY291cnNlGAEgASgLMhAubWlyYWkudjEuQ291cnNlEhoKEmNvbnRlbnRfc2l6ZV9ieXRlcxgCIAEoAxIZCgxzaXplX3dhcm5pbmcYAyABKAlIAIgBAUIPCg1fc2l6ZV93YXJuaW5nIiEKE0RlbGV0ZUNvdXJzZVJlcXVlc3QSCgoCaWQYASABKAkiJwoURGVsZXRlQ291cnNlUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCCI6ChlHZXRGb2xkZXJIaWVyYXJjaHlSZXF1ZXN0Eh0KFWluY2x1ZGVfY291cnNlX2NvdW50cxgBIAEoCCI/ChpHZXRGb2xkZXJIaWVyYXJjaHlSZXNwb25zZRIhCgdmb2xkZXJzGAEgAygLMhAubWlyYWkudjEuRm9sZGVyIjIKEUdldExpYnJhcnlSZXF1ZXN0Eh0KFWluY2x1ZGVfY291cnNlX2NvdW50cxgBIAEoCCI4ChJHZXRMaWJyYXJ5UmVzcG9uc2USIgoHbGlicmFyeRgBIAEoCzIRLm1pcmFpLnYxLkxpYnJhcnkijwEKE0NyZWF0ZUZvbGRlclJlcXVlc3QSDAoEbmFtZRgBIAEoCRIWCglwYXJlbnRfaWQYAiABKAlIAIgBARIiCgR0eXBlGAMgASgOMhQubWlyYWkudjEuRm9sZGVyVHlwZRIUCgd0ZWFtX2lkGAQgASgJSAGIAQFCDAoKX3BhcmVudF9pZEIKCghfdGVhbV9pZCI4ChRDcmVhdGVGb2xkZXJSZXNwb25zZRIgCgZmb2xkZXIYASABKAsyEC5taXJhaS52MS5Gb2xkZXIikQEKE1VwZGF0ZUZvbGRlclJlcXVlc3QSCgoCaWQYASABKAkSEQoEbmFtZRgCIAEoCUgAiAEBEicKBHR5cGUYAyABKA4yFC5taXJhaS52MS5Gb2xkZXJUeXBlSAGIAQESFAoHdGVhbV9pZBgEIAEoCUgCiAEBQgcKBV9uYW1lQgcKBV90eXBlQgoKCF90ZWFtX2lkIjgKFFVwZGF0ZUZvbGRlclJlc3BvbnNlEiAKBmZvbGRlchgBIAEoCzIQLm1pcmFpLnYxLkZvbGRlciIhChNEZWxldGVGb2xkZXJSZXF1ZXN0EgoKAmlkGAEgASgJIicKFERlbGV0ZUZvbGRlclJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgiUAoTRXhwb3J0Q291cnNlUmVxdWVzdBIRCgljb3Vyc2VfaWQYASABKAkSJgoGZm9ybWF0GAIgASgOMhYubWlyYWkudjEuRXhwb3J0Rm9ybWF0Ij4KFEV4cG9ydENvdXJzZVJlc3BvbnNlEiYKBmV4cG9ydBgBIAEoCzIWLm1pcmFpLnYxLkNvdXJzZUV4cG9ydCIrChZHZXRFeHBvcnRTdGF0dXNSZXF1ZXN0EhEKCWV4cG9ydF9pZBgBIAEoCSJBChdHZXRFeHBvcnRTdGF0dXNSZXNwb25zZRImCgZleHBvcnQYASABKAsyFi5taXJhaS52MS5Db3Vyc2VFeHBvcnQiKgoVRG93bmxvYWRFeHBvcnRSZXF1ZXN0EhEKCWV4cG9ydF9pZBgBIAEoCSJeChZEb3dubG9hZEV4cG9ydFJlc3BvbnNlEhQKDGRvd25sb2FkX3VybBgBIAEoCRIuCgpleHBpcmVzX2F0GAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCInChJMaXN0RXhwb3J0c1JlcXVlc3QSEQoJY291cnNlX2lkGAEgASgJIj4KE0xpc3RFeHBvcnRzUmVzcG9uc2USJwoHZXhwb3J0cxgBIAMoCzIWLm1pcmFpLnYxLkNvdXJzZUV4cG9ydCItChhMaXN0Q29sbGFib3JhdG9yc1JlcXVlc3QSEQoJY291cnNlX2lkGAEgASgJIlAKGUxpc3RDb2xsYWJvcmF0b3JzUmVzcG9uc2USMwoNY29sbGFib3JhdG9ycxgBIAMoCzIcLm1pcmFpLnYxLkNvdXJzZUNvbGxhYm9yYXRvciJgChZBZGRDb2xsYWJvcmF0b3JSZXF1ZXN0EhEKCWNvdXJzZV9pZBgBIAEoCRIPCgd1c2VyX2lkGAIgASgJEiIKBHJvbGUYAyABKA4yFC5taXJhaS52MS5Db3Vyc2VSb2xlIk0KF0FkZENvbGxhYm9yYXRvclJlc3BvbnNlEjIKDGNvbGxhYm9yYXRvchgBIAEoCzIcLm1pcmFpLnYxLkNvdXJzZUNvbGxhYm9yYXRvciI/ChlSZW1vdmVDb2xsYWJvcmF0b3JSZXF1ZXN0EhEKCWNvdXJzZV9pZBgBIAEoCRIPCgd1c2VyX2lkGAIgASgJIhwKGlJlbW92ZUNvbGxhYm9yYXRvclJlc3BvbnNlIhwKGlJlbW92ZVNhbXBsZUNvbnRlbnRSZXF1ZXN0IocBChtSZW1vdmVTYW1wbGVDb250ZW50UmVzcG9uc2USFwoPY291cnNlc19yZW1vdmVkGAEgASgFEhcKD2ZvbGRlcnNfcmVtb3ZlZBgCIAEoBRIUCgxmb2xkZXJzX2tlcHQYAyABKAUSIAoYdGFyZ2V0X2F1ZGllbmNlc19yZW1vdmVkGAQgASgFIioKGUxpc3RMYXJnZXN0Q291cnNlc1JlcXVlc3QSDQoFbGltaXQYASABKAUiewoKQ291cnNlU2l6ZRIRCgljb3Vyc2VfaWQYASABKAkSDQoFdGl0bGUYAiABKAkSGgoSY29udGVudF9zaXplX2J5dGVzGAMgASgDEi8KC21vZGlmaWVkX2F0GAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJ3ChpMaXN0TGFyZ2VzdENvdXJzZXNSZXNwb25zZRIlCgdjb3Vyc2VzGAEgAygLMhQubWlyYWkudjEuQ291cnNlU2l6ZRIYChBzb2Z0X2xpbWl0X2J5dGVzGAIgASgDEhgKEGhhcmRfbGltaXRfYnl0ZXMYAyABKAMiKgoVUHVibGlzaENoYW5nZXNSZXF1ZXN0EhEKCWNvdXJzZV9pZBgBIAEoCSI6ChZQdWJsaXNoQ2hhbmdlc1Jlc3BvbnNlEiAKBmNvdXJzZRgBIAEoCzIQLm1pcmFpLnYxLkNvdXJzZSIoChNEaXNjYXJkRHJhZnRSZXF1ZXN0EhEKCWNvdXJzZV9pZBgBIAEoCSJSChREaXNjYXJkRHJhZnRSZXNwb25zZRIgCgZjb3Vyc2UYASABKAsyEC5taXJhaS52MS5Db3Vyc2USGAoQbGVzc29uc19yZXN0b3JlZBgCIAEoBSI9ChlTZWFyY2hXaXRoaW5Db3Vyc2VSZXF1ZXN0EhEKCWNvdXJzZV9pZBgBIAEoCRINCgVxdWVyeRgCIAEoCSKBAgoRQ291cnNlU2VhcmNoTWF0Y2gSLAoGc291cmNlGAEgASgOMhwubWlyYWkudjEuQ291cnNlU2VhcmNoU291cmNlEhIKCnNlY3Rpb25faWQYAiABKAkSFQoNc2VjdGlvbl90aXRsZRgDIAEoCRIRCglsZXNzb25faWQYBCABKAkSFAoMbGVzc29uX3RpdGxlGAUgASgJEhAKCGJsb2NrX2lkGAYgASgJEhQKDGNvbXBvbmVudF9pZBgHIAEoCRIPCgdzbmlwcGV0GAggASgJEhcKD2hpZ2hsaWdodF9zdGFydBgJIAEoBRIYChBoaWdobGlnaHRfbGVuZ3RoGAogASgFIl0KGlNlYXJjaFdpdGhpbkNvdXJzZVJlc3BvbnNlEiwKB21hdGNoZXMYASADKAsyGy5taXJhaS52MS5Db3Vyc2VTZWFyY2hNYXRjaBIRCgl0cnVuY2F0ZWQYAiABKAgilwMKEENvdXJzZUF0dGFjaG1lbnQSCgoCaWQYASABKAkSEQoJY291cnNlX2lkGAIgASgJEhEKCWZpbGVfbmFtZRgDIAEoCRIUCgxjb250ZW50X3R5cGUYBCABKAkSEgoKc2l6ZV9ieXRlcxgFIAEoAxIwCgZzdGF0dXMYBiABKA4yIC5taXJhaS52MS5Db3Vyc2VBdHRhY2htZW50U3RhdHVzEhoKDWVycm9yX21lc3NhZ2UYByABKAlIAIgBARITCgtjaHVua19jb3VudBgIIAEoBRIbChNmbGFnZ2VkX2NodW5rX2NvdW50GAkgASgFEh0KFWV4Y2x1ZGVkX2Zyb21fcHJvbXB0cxgKIAEoCBIuCgpjcmVhdGVkX2F0GAsgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBI1Cgxwcm9jZXNzZWRfYXQYDCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSAGIAQFCEAoOX2Vycm9yX21lc3NhZ2VCDwoNX3Byb2Nlc3NlZF9hdCJ1CiNHZXRDb3Vyc2VBdHRhY2htZW50VXBsb2FkVVJMUmVxdWVzdBIRCgljb3Vyc2VfaWQYASABKAkSEQoJZmlsZV9uYW1lGAIgASgJEhQKDGNvbnRlbnRfdHlwZRgDIAEoCRISCgpzaXplX2J5dGVzGAQgASgDIk0KJEdldENvdXJzZUF0dGFjaG1lbnRVcGxvYWRVUkxSZXNwb25zZRISCgp1cGxvYWRfdXJsGAEgASgJEhEKCWZpbGVfcGF0aBgCIAEoCSJcCh5Db25maXJtQ291cnNlQXR0YWNobWVudFJlcXVlc3QSEQoJY291cnNlX2lkGAEgASgJEhEKCWZpbGVfcGF0aBgCIAEoCRIUCgxjb250ZW50X3R5cGUYAyABKAkiUQofQ29uZmlybUNvdXJzZUF0dGFjaG1lbnRSZXNwb25zZRIuCgphdHRhY2htZW50GAEgASgLMhoubWlyYWkudjEuQ291cnNlQXR0YWNobWVudCIxChxMaXN0Q291cnNlQXR0YWNobWVudHNSZXF1ZXN0EhEKCWNvdXJzZV9pZBgBIAEoCSJQCh1MaXN0Q291cnNlQXR0YWNobWVudHNSZXNwb25zZRIvCgthdHRhY2htZW50cxgBIAMoCzIaLm1pcmFpLnYxLkNvdXJzZUF0dGFjaG1lbnQiTQoiU2V0Q291cnNlQXR0YWNobWVudEV4Y2x1ZGVkUmVxdWVzdBIVCg1hdHRhY2htZW50X2lkGAEgASgJEhAKCGV4Y2x1ZGVkGAIgASgIIlUKI1NldENvdXJzZUF0dGFjaG1lbnRFeGNsdWRlZFJlc3BvbnNlEi4KCmF0dGFjaG1lbnQYASABKAsyGi5taXJhaS52MS5Db3Vyc2VBdHRhY2htZW50IjYKHURlbGV0ZUNvdXJzZUF0dGFjaG1lbnRSZXF1ZXN0EhUKDWF0dGFjaG1lbnRfaWQYASABKAkiIAoeRGVsZXRlQ291cnNlQXR0YWNobWVudFJlc3BvbnNlIlUKFENvdXJzZUdlbmVyYXRpb25Mb2NrEg4KBmpvYl9pZBgBIAEoCRItCglsb2NrZWRfYXQYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIoABCgpDb3Vyc2VDYXJkEgoKAmlkGAEgASgJEg0KBXRpdGxlGAIgASgJEiYKBnN0YXR1cxgDIAEoDjIWLm1pcmFpLnYxLkNvdXJzZVN0YXR1cxIvCgttb2RpZmllZF9hdBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAinwEKFkxpc3RDb3Vyc2VDYXJkc1JlcXVlc3QSKwoGc3RhdHVzGAEgASgOMhYubWlyYWkudjEuQ291cnNlU3RhdHVzSACIAQESEwoGZm9sZGVyGAIgASgJSAGIAQESDQoFbGltaXQYAyABKAUSEwoGY3Vyc29yGAQgASgJSAKIAQFCCQoHX3N0YXR1c0IJCgdfZm9sZGVyQgkKB19jdXJzb3IiaAoXTGlzdENvdXJzZUNhcmRzUmVzcG9uc2USIwoFY2FyZHMYASADKAsyFC5taXJhaS52MS5Db3Vyc2VDYXJkEhgKC25leHRfY3Vyc29yGAIgASgJSACIAQFCDgoMX25leHRfY3Vyc29yIt0BChFDb3Vyc2VDYXJkRGV0YWlscxIRCgljb3Vyc2VfaWQYASABKAkSDAoEdGFncxgCIAMoCRIaCg10aHVtYm5haWxfdXJsGAMgASgJSACIAQESEgoKY3JlYXRlZF9ieRgEIAEoCRIcCg9jcmVhdGVkX2J5X25hbWUYBSABKAlIAYgBARIZChFjcmVhdGVkX2J5X2FjdGl2ZRgGIAEoCBIYChBkdXJhdGlvbl9taW51dGVzGAcgASgFQhAKDl90aHVtYm5haWxfdXJsQhIKEF9jcmVhdGVkX2J5X25hbWUiMQobR2V0Q291cnNlQ2FyZERldGFpbHNSZXF1ZXN0EhIKCmNvdXJzZV9pZHMYASADKAkiTAocR2V0Q291cnNlQ2FyZERldGFpbHNSZXNwb25zZRIsCgdkZXRhaWxzGAEgAygLMhsubWlyYWkudjEuQ291cnNlQ2FyZERldGFpbHMiegoOQ291cnNlUHJlc2VuY2USDwoHdXNlcl9pZBgBIAEoCRIUCgxkaXNwbGF5X25hbWUYAiABKAkSDwoHZWRpdGluZxgDIAEoCBIwCgxsYXN0X3NlZW5fYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIkQKHkhlYXJ0YmVhdENvdXJzZVByZXNlbmNlUmVxdWVzdBIRCgljb3Vyc2VfaWQYASABKAkSDwoHZWRpdGluZxgCIAEoCCJLCh9IZWFydGJlYXRDb3Vyc2VQcmVzZW5jZVJlc3BvbnNlEigKBm90aGVycxgBIAMoCzIYLm1pcmFpLnYxLkNvdXJzZVByZXNlbmNlIi0KGEdldENvdXJzZVByZXNlbmNlUmVxdWVzdBIRCgljb3Vyc2VfaWQYASABKAkiRQoZR2V0Q291cnNlUHJlc2VuY2VSZXNwb25zZRIoCgZvdGhlcnMYASADKAsyGC5taXJhaS52MS5Db3Vyc2VQcmVzZW5jZSqAAQoMQ291cnNlU3RhdHVzEh0KGUNPVVJTRV9TVEFUVVNfVU5TUEVDSUZJRUQQABIXChNDT1VSU0VfU1RBVFVTX0RSQUZUEAESGwoXQ09VUlNFX1NUQVRVU19QVUJMSVNIRUQQAhIbChdDT1VSU0VfU1RBVFVTX0dFTkVSQVRFRBADKpABCglCbG9ja1R5cGUSGgoWQkxPQ0tfVFlQRV9VTlNQRUNJRklFRBAAEhYKEkJMT0NLX1RZUEVfSEVBRElORxABEhMKD0JMT0NLX1RZUEVfVEVYVBACEhoKFkJMT0NLX1RZUEVfSU5URVJBQ1RJVkUQAxIeChpCTE9DS19UWVBFX0tOT1dMRURHRV9DSEVDSxAEKooBCgpGb2xkZXJUeXBlEhsKF0ZPTERFUl9UWVBFX1VOU1BFQ0lGSUVEEAASFwoTRk9MREVSX1RZUEVfTElCUkFSWRABEhQKEEZPTERFUl9UWVBFX1RFQU0QAhIYChRGT0xERVJfVFlQRV9QRVJTT05BTBADEhYKEkZPTERFUl9UWVBFX0ZPTERFUhAEKrYBCgxFeHBvcnRGb3JtYXQSHQoZRVhQT1JUX0ZPUk1BVF9VTlNQRUNJRklFRBAAEhoKFkVYUE9SVF9GT1JNQVRfU0NPUk1fMTIQARIcChhFWFBPUlRfRk9STUFUX1NDT1JNXzIwMDQQAhIWChJFWFBPUlRfRk9STUFUX1hBUEkQAxIVChFFWFBPUlRfRk9STUFUX1BERhAEEh4KGkVYUE9SVF9GT1JNQVRfTUFSS0RPV05fWklQEAUqtwEKDEV4cG9ydFN0YXR1cxIdChlFWFBPUlRfU1RBVFVTX1VOU1BFQ0lGSUVEEAASGQoVRVhQT1JUX1NUQVRVU19QRU5ESU5HEAESHAoYRVhQT1JUX1NUQVRVU19QUk9DRVNTSU5HEAISGwoXRVhQT1JUX1NUQVRVU19DT01QTEVURUQQAxIYChRFWFBPUlRfU1RBVFVTX0ZBSUxFRBAEEhgKFEVYUE9SVF9TVEFUVVNfUFVSR0VEEAUqcAoKQ291cnNlUm9sZRIbChdDT1VSU0VfUk9MRV9VTlNQRUNJRklFRBAAEhUKEUNPVVJTRV9ST0xFX09XTkVSEAESFgoSQ09VUlNFX1JPTEVfRURJVE9SEAISFgoSQ09VUlNFX1JPTEVfVklFV0VSEAMqiQEKEkNvdXJzZVNlYXJjaFNvdXJjZRIkCiBDT1VSU0VfU0VBUkNIX1NPVVJDRV9VTlNQRUNJRklFRBAAEiIKHkNPVVJTRV9TRUFSQ0hfU09VUkNFX0FVVEhPUklORxABEikKJUNPVVJTRV9TRUFSQ0hfU09VUkNFX0xFU1NPTl9DT01QT05FTlQQAiraAQoWQ291cnNlQXR0YWNobWVudFN0YXR1cxIoCiRDT1VSU0VfQVRUQUNITUVOVF9TVEFUVVNfVU5TUEVDSUZJRUQQABIkCiBDT1VSU0VfQVRUQUNITUVOVF9TVEFUVVNfUEVORElORxABEicKI0NPVVJTRV9BVFRBQ0hNRU5UX1NUQVRVU19QUk9DRVNTSU5HEAISIgoeQ09VUlNFX0FUVEFDSE1FTlRfU1RBVFVTX1JFQURZEAMSIwofQ09VUlNFX0FUVEFDSE1FTlRfU1RBVFVTX0ZBSUxFRBAEKn0KEUV4cG9ydFB1cmdlUmVhc29uEiMKH0VYUE9SVF9QVVJHRV9SRUFTT05fVU5TUEVDSUZJRUQQABIfChtFWFBPUlRfUFVSR0VfUkVBU09OX0VYUElSRUQQARIiCh5FWFBPUlRfUFVSR0VfUkVBU09OX1NVUEVSU0VERUQQAjKdFgoNQ291cnNlU2VydmljZRJKCgtMaXN0Q291cnNlcxIcLm1pcmFpLnYxLkxpc3RDb3Vyc2VzUmVxdWVzdBodLm1pcmFpLnYxLkxpc3RDb3Vyc2VzUmVzcG9uc2USRAoJR2V0Q291cnNlEhoubWlyYWkudjEuR2V0Q291cnNlUmVxdWVzdBobLm1pcmFpLnYxLkdldENvdXJzZVJlc3BvbnNlEk0KDENyZWF0ZUNvdXJzZRIdLm1pcmFpLnYxLkNyZWF0ZUNvdXJzZVJlcXVlc3QaHi5taXJhaS52MS5DcmVhdGVDb3Vyc2VSZXNwb25zZRJNCgxVcGRhdGVDb3Vyc2USHS5taXJhaS52MS5VcGRhdGVDb3Vyc2VSZXF1ZXN0Gh4ubWlyYWkudjEuVXBkYXRlQ291cnNlUmVzcG9uc2USTQoMRGVsZXRlQ291cnNlEh0ubWlyYWkudjEuRGVsZXRlQ291cnNlUmVxdWVzdBoeLm1pcmFpLnYxLkRlbGV0ZUNvdXJzZVJlc3BvbnNlEl8KEkdldEZvbGRlckhpZXJhcmNoeRIjLm1pcmFpLnYxLkdldEZvbGRlckhpZXJhcmNoeVJlcXVlc3QaJC5taXJhaS52MS5HZXRGb2xkZXJIaWVyYXJjaHlSZXNwb25zZRJHCgpHZXRMaWJyYXJ5EhsubWlyYWkudjEuR2V0TGlicmFyeVJlcXVlc3QaHC5taXJhaS52MS5HZXRMaWJyYXJ5UmVzcG9uc2USTQoMQ3JlYXRlRm9sZGVyEh0ubWlyYWkudjEuQ3JlYXRlRm9sZGVyUmVxdWVzdBoeLm1pcmFpLnYxLkNyZWF0ZUZvbGRlclJlc3BvbnNlEk0KDFVwZGF0ZUZvbGRlchIdLm1pcmFpLnYxLlVwZGF0ZUZvbGRlclJlcXVlc3QaHi5taXJhaS52MS5VcGRhdGVGb2xkZXJSZXNwb25zZRJNCgxEZWxldGVGb2xkZXISHS5taXJhaS52MS5EZWxldGVGb2xkZXJSZXF1ZXN0Gh4ubWlyYWkudjEuRGVsZXRlRm9sZGVyUmVzcG9uc2USTQoMRXhwb3J0Q291cnNlEh0ubWlyYWkudjEuRXhwb3J0Q291cnNlUmVxdWVzdBoeLm1pcmFpLnYxLkV4cG9ydENvdXJzZVJlc3BvbnNlElYKD0dldEV4cG9ydFN0YXR1cxIgLm1pcmFpLnYxLkdldEV4cG9ydFN0YXR1c1JlcXVlc3QaIS5taXJhaS52MS5HZXRFeHBvcnRTdGF0dXNSZXNwb25zZRJTCg5Eb3dubG9hZEV4cG9ydBIfLm1pcmFpLnYxLkRvd25sb2FkRXhwb3J0UmVxdWVzdBogLm1pcmFpLnYxLkRvd25sb2FkRXhwb3J0UmVzcG9uc2USSgoLTGlzdEV4cG9ydHMSHC5taXJhaS52MS5MaXN0RXhwb3J0c1JlcXVlc3QaHS5taXJhaS52MS5MaXN0RXhwb3J0c1Jlc3BvbnNlElwKEUxpc3RDb2xsYWJvcmF0b3JzEiIubWlyYWkudjEuTGlzdENvbGxhYm9yYXRvcnNSZXF1ZXN0GiMubWlyYWkudjEuTGlzdENvbGxhYm9yYXRvcnNSZXNwb25zZRJWCg9BZGRDb2xsYWJvcmF0b3ISIC5taXJhaS52MS5BZGRDb2xsYWJvcmF0b3JSZXF1ZXN0GiEubWlyYWkudjEuQWRkQ29sbGFib3JhdG9yUmVzcG9uc2USXwoSUmVtb3ZlQ29sbGFib3JhdG9yEiMubWlyYWkudjEuUmVtb3ZlQ29sbGFib3JhdG9yUmVxdWVzdBokLm1pcmFpLnYxLlJlbW92ZUNvbGxhYm9yYXRvclJlc3BvbnNlEmIKE1JlbW92ZVNhbXBsZUNvbnRlbnQSJC5taXJhaS52MS5SZW1vdmVTYW1wbGVDb250ZW50UmVxdWVzdBolLm1pcmFpLnYxLlJlbW92ZVNhbXBsZUNvbnRlbnRSZXNwb25zZRJfChJMaXN0TGFyZ2VzdENvdXJzZXMSIy5taXJhaS52MS5MaXN0TGFyZ2VzdENvdXJzZXNSZXF1ZXN0GiQubWlyYWkudjEuTGlzdExhcmdlc3RDb3Vyc2VzUmVzcG9uc2USUwoOUHVibGlzaENoYW5nZXMSHy5taXJhaS52MS5QdWJsaXNoQ2hhbmdlc1JlcXVlc3QaIC5taXJhaS52MS5QdWJsaXNoQ2hhbmdlc1Jlc3BvbnNlEk0KDERpc2NhcmREcmFmdBIdLm1pcmFpLnYxLkRpc2NhcmREcmFmdFJlcXVlc3QaHi5taXJhaS52MS5EaXNjYXJkRHJhZnRSZXNwb25zZRJfChJTZWFyY2hXaXRoaW5Db3Vyc2USIy5taXJhaS52MS5TZWFyY2hXaXRoaW5Db3Vyc2VSZXF1ZXN0GiQubWlyYWkudjEuU2VhcmNoV2l0aGluQ291cnNlUmVzcG9uc2USfQocR2V0Q291cnNlQXR0YWNobWVudFVwbG9hZFVSTBItLm1pcmFpLnYxLkdldENvdXJzZUF0dGFjaG1lbnRVcGxvYWRVUkxSZXF1ZXN0Gi4ubWlyYWkudjEuR2V0Q291cnNlQXR0YWNobWVudFVwbG9hZFVSTFJlc3BvbnNlEm4KF0NvbmZpcm1Db3Vyc2VBdHRhY2htZW50EigubWlyYWkudjEuQ29uZmlybUNvdXJzZUF0dGFjaG1lbnRSZXF1ZXN0GikubWlyYWkudjEuQ29uZmlybUNvdXJzZUF0dGFjaG1lbnRSZXNwb25zZRJoChVMaXN0Q291cnNlQXR0YWNobWVudHMSJi5taXJhaS52MS5MaXN0Q291cnNlQXR0YWNobWVudHNSZXF1ZXN0GicubWlyYWkudjEuTGlzdENvdXJzZUF0dGFjaG1lbnRzUmVzcG9uc2USegobU2V0Q291cnNlQXR0YWNobWVudEV4Y2x1ZGVkEiwubWlyYWkudjEuU2V0Q291cnNlQXR0YWNobWVudEV4Y2x1ZGVkUmVxdWVzdBotLm1pcmFpLnYxLlNldENvdXJzZUF0dGFjaG1lbnRFeGNsdWRlZFJlc3BvbnNlEmsKFkRlbGV0ZUNvdXJzZUF0dGFjaG1lbnQSJy5taXJhaS52MS5EZWxldGVDb3Vyc2VBdHRhY2htZW50UmVxdWVzdBooLm1pcmFpLnYxLkRlbGV0ZUNvdXJzZUF0dGFjaG1lbnRSZXNwb25zZRJWCg9MaXN0Q291cnNlQ2FyZHMSIC5taXJhaS52MS5MaXN0Q291cnNlQ2FyZHNSZXF1ZXN0GiEubWlyYWkudjEuTGlzdENvdXJzZUNhcmRzUmVzcG9uc2USZQoUR2V0Q291cnNlQ2FyZERldGFpbHMSJS5taXJhaS52MS5HZXRDb3Vyc2VDYXJkRGV0YWlsc1JlcXVlc3QaJi5taXJhaS52MS5HZXRDb3Vyc2VDYXJkRGV0YWlsc1Jlc3BvbnNlEm4KF0hlYXJ0YmVhdENvdXJzZVByZXNlbmNlEigubWlyYWkudjEuSGVhcnRiZWF0Q291cnNlUHJlc2VuY2VSZXF1ZXN0GikubWlyYWkudjEuSGVhcnRiZWF0Q291cnNlUHJlc2VuY2VSZXNwb25zZRJcChFHZXRDb3Vyc2VQcmVzZW5jZRIiLm1pcmFpLnYxLkdldENvdXJzZVByZXNlbmNlUmVxdWVzdBojLm1pcmFpLnYxLkdldENvdXJzZVByZXNlbmNlUmVzcG9uc2VCkQEKDGNvbS5taXJhaS52MUILQ291cnNlUHJvdG9QAVozZ2l0aHViLmNvbS9zb2dvcy9taXJhaS1iYWNrZW5kL2dlbi9taXJhaS92MTttaXJhaXYxogIDTVhYqgIITWlyYWkuVjHKAghNaXJhaVxWMeICFE1pcmFpXFYxXEdQQk1ldGFkYXRh6gIJTWlyYWk6OlYxYgZwcm90bzM", [file_google_protobuf_timestamp]);
 
 /**
  * LearningObjective represents a specific learning goal for the course.
@@ -321,6 +321,30 @@ export type CourseExport = Message<"mirai.v1.CourseExport"> & {
    * @generated from field: optional string error_message = 7;
    */
   errorMessage?: string;
+
+  /**
+   * When the file is deleted, or was due to be
+   *
+   * @generated from field: google.protobuf.Timestamp expires_at = 8;
+   */
+  expiresAt?: Timestamp;
+
+  /**
+   * @generated from field: int64 size_bytes = 9;
+   */
+  sizeBytes: bigint;
+
+  /**
+   * Set once the file is deleted
+   *
+   * @generated from field: optional google.protobuf.Timestamp purged_at = 10;
+   */
+  purgedAt?: Timestamp;
+
+  /**
+   * @generated from field: optional mirai.v1.ExportPurgeReason purge_reason = 11;
+   */
+  purgeReason?: ExportPurgeReason;
 };
 
 /**
@@ -490,6 +514,20 @@ export type Course = Message<"mirai.v1.Course"> & {
    * @generated from field: optional string team_id = 14;
    */
   teamId?: string;
+
+  /**
+   * Draft version learners see; 0 if none is stored yet
+   *
+   * @generated from field: int32 published_version = 15;
+   */
+  publishedVersion: number;
+
+  /**
+   * Edited since the version learners see
+   *
+   * @generated from field: bool has_unpublished_changes = 16;
+   */
+  hasUnpublishedChanges: boolean;
 };
 
 /**
@@ -566,6 +604,27 @@ export type LibraryEntry = Message<"mirai.v1.LibraryEntry"> & {
    * @generated from field: optional string team_id = 12;
    */
   teamId?: string;
+
+  /**
+   * Caller's role on this course, if assigned
+   *
+   * @generated from field: optional mirai.v1.CourseRole caller_role = 13;
+   */
+  callerRole?: CourseRole;
+
+  /**
+   * False when the creator has been deactivated
+   *
+   * @generated from field: bool created_by_active = 14;
+   */
+  createdByActive: boolean;
+
+  /**
+   * Edited since the version learners see
+   *
+   * @generated from field: bool has_unpublished_changes = 15;
+   */
+  hasUnpublishedChanges: boolean;
 };
 
 /**
@@ -575,6 +634,50 @@ export type LibraryEntry = Message<"mirai.v1.LibraryEntry"> & {
 export const LibraryEntrySchema: GenMessage<LibraryEntry> = /*@__PURE__*/
   messageDesc(file_mirai_v1_course, 12);
 
+/**
+ * CourseCollaborator represents a user's assignment to a course.
+ *
+ * @generated from message mirai.v1.CourseCollaborator
+ */
+export type CourseCollaborator = Message<"mirai.v1.CourseCollaborator"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string course_id = 2;
+   */
+  courseId: string;
+
+  /**
+   * @generated from field: string user_id = 3;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: mirai.v1.CourseRole role = 4;
+   */
+  role: CourseRole;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 5;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: optional string added_by_user_id = 6;
+   */
+  addedByUserId?: string;
+};
+
+/**
+ * Describes the message mirai.v1.CourseCollaborator.
+ * Use `create(CourseCollaboratorSchema)` to create a new message.
+ */
+export const CourseCollaboratorSchema: GenMessage<CourseCollaborator> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_course, 13);
+
 /**
  * Folder represents a folder in the library hierarchy.
  *
@@ -610,6 +713,20 @@ export type Folder = Message<"mirai.v1.Folder"> & {
    * @generated from field: optional int32 course_count = 6;
    */
   courseCount?: number;
+
+  /**
+   * System folder (library or personal): cannot be renamed or deleted
+   *
+   * @generated from field: bool is_protected = 7;
+   */
+  isProtected: boolean;
+
+  /**
+   * Set for FOLDER_TYPE_TEAM
+   *
+   * @generated from field: optional string team_id = 8;
+   */
+  teamId?: string;
 };
 
 /**
@@ -617,7 +734,7 @@ export type Folder = Message<"mirai.v1.Folder"> & {
  * Use `create(FolderSchema)` to create a new message.
  */
 export const FolderSchema: GenMessage<Folder> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_course, 13);
+  messageDesc(file_mirai_v1_course, 14);
 
 /**
  * Library represents the full library structure.
@@ -651,7 +768,7 @@ export type Library = Message<"mirai.v1.Library"> & {
  * Use `create(LibrarySchema)` to create a new message.
  */
 export const LibrarySchema: GenMessage<Library> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_course, 14);
+  messageDesc(file_mirai_v1_course, 15);
 
 /**
  * ListCoursesRequest contains optional filters for listing courses.
@@ -687,6 +804,13 @@ export type ListCoursesRequest = Message<"mirai.v1.ListCoursesRequest"> & {
    * @generated from field: int32 offset = 5;
    */
   offset: number;
+
+  /**
+   * Only courses the caller created or collaborates on
+   *
+   * @generated from field: optional bool mine = 6;
+   */
+  mine?: boolean;
 };
 
 /**
@@ -694,7 +818,7 @@ export type ListCoursesRequest = Message<"mirai.v1.ListCoursesRequest"> & {
  * Use `create(ListCoursesRequestSchema)` to create a new message.
  */
 export const ListCoursesRequestSchema: GenMessage<ListCoursesRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_course, 15);
+  messageDesc(file_mirai_v1_course, 16);
 
 /**
  * ListCoursesResponse contains the list of matching courses.
@@ -727,7 +851,7 @@ export type ListCoursesResponse = Message<"mirai.v1.ListCoursesResponse"> & {
  * Use `create(ListCoursesResponseSchema)` to create a new message.
  */
 export const ListCoursesResponseSchema: GenMessage<ListCoursesResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_course, 16);
+  messageDesc(file_mirai_v1_course, 17);
 
 /**
  * GetCourseRequest contains the course ID to retrieve.
@@ -746,7 +870,7 @@ export type GetCourseRequest = Message<"mirai.v1.GetCourseRequest"> & {
  * Use `create(GetCourseRequestSchema)` to create a new message.
  */
 export const GetCourseRequestSchema: GenMessage<GetCourseRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_course, 17);
+  messageDesc(file_mirai_v1_course, 18);
 
 /**
  * GetCourseResponse contains the requested course.
@@ -758,6 +882,21 @@ export type GetCourseResponse = Message<"mirai.v1.GetCourseResponse"> & {
    * @generated from field: mirai.v1.Course course = 1;
    */
   course?: Course;
+
+  /**
+   * The course's queued or processing full course generation job
+   *
+   * @generated from field: optional string active_generation_job_id = 2;
+   */
+  activeGenerationJobId?: string;
+
+  /**
+   * Set while a full course run holds the course read-only. UpdateCourse and component
+   * edits fail with FAILED_PRECONDITION until it is released.
+   *
+   * @generated from field: optional mirai.v1.CourseGenerationLock generation_lock = 3;
+   */
+  generationLock?: CourseGenerationLock;
 };
 
 /**
@@ -765,7 +904,7 @@ export type GetCourseResponse = Message<"mirai.v1.GetCourseResponse"> & {
  * Use `create(GetCourseResponseSchema)` to create a new message.
  */
 export const GetCourseResponseSchema: GenMessage<GetCourseResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_course, 18);
+  messageDesc(file_mirai_v1_course, 19);
 
 /**
  * CreateCourseRequest contains the data for creating a new course.
@@ -809,7 +948,7 @@ export type CreateCourseRequest = Message<"mirai.v1.CreateCourseRequest"> & {
  * Use `create(CreateCourseRequestSchema)` to create a new message.
  */
 export const CreateCourseRequestSchema: GenMessage<CreateCourseRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_course, 19);
+  messageDesc(file_mirai_v1_course, 20);
 
 /**
  * CreateCourseResponse contains the newly created course.
@@ -821,6 +960,14 @@ export type CreateCourseResponse = Message<"mirai.v1.CreateCourseResponse"> & {
    * @generated from field: mirai.v1.Course course = 1;
    */
   course?: Course;
+
+  /**
+   * Settings filled from the organization's course defaults: "destination_folder",
+   * "category_tags", "data_source" or "assessment_settings"
+   *
+   * @generated from field: repeated string defaulted_fields = 2;
+   */
+  defaultedFields: string[];
 };
 
 /**
@@ -828,7 +975,7 @@ export type CreateCourseResponse = Message<"mirai.v1.CreateCourseResponse"> & {
  * Use `create(CreateCourseResponseSchema)` to create a new message.
  */
 export const CreateCourseResponseSchema: GenMessage<CreateCourseResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_course, 20);
+  messageDesc(file_mirai_v1_course, 21);
 
 /**
  * UpdateCourseRequest contains the course ID and fields to update.
@@ -882,7 +1029,7 @@ export type UpdateCourseRequest = Message<"mirai.v1.UpdateCourseRequest"> & {
  * Use `create(UpdateCourseRequestSchema)` to create a new message.
  */
 export const UpdateCourseRequestSchema: GenMessage<UpdateCourseRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_course, 21);
+  messageDesc(file_mirai_v1_course, 22);
 
 /**
  * UpdateCourseResponse contains the updated course.
@@ -894,6 +1041,20 @@ export type UpdateCourseResponse = Message<"mirai.v1.UpdateCourseResponse"> & {
    * @generated from field: mirai.v1.Course course = 1;
    */
   course?: Course;
+
+  /**
+   * Size of the stored course content
+   *
+   * @generated from field: int64 content_size_bytes = 2;
+   */
+  contentSizeBytes: bigint;
+
+  /**
+   * Set when the content is over the recommended size
+   *
+   * @generated from field: optional string size_warning = 3;
+   */
+  sizeWarning?: string;
 };
 
 /**
@@ -901,7 +1062,7 @@ export type UpdateCourseResponse = Message<"mirai.v1.UpdateCourseResponse"> & {
  * Use `create(UpdateCourseResponseSchema)` to create a new message.
  */
 export const UpdateCourseResponseSchema: GenMessage<UpdateCourseResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_course, 22);
+  messageDesc(file_mirai_v1_course, 23);
 
 /**
  * DeleteCourseRequest contains the course ID to delete.
@@ -920,7 +1081,7 @@ export type DeleteCourseRequest = Message<"mirai.v1.DeleteCourseRequest"> & {
  * Use `create(DeleteCourseRequestSchema)` to create a new message.
  */
 export const DeleteCourseRequestSchema: GenMessage<DeleteCourseRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_course, 23);
+  messageDesc(file_mirai_v1_course, 24);
 
 /**
  * DeleteCourseResponse confirms deletion.
@@ -939,7 +1100,7 @@ export type DeleteCourseResponse = Message<"mirai.v1.DeleteCourseResponse"> & {
  * Use `create(DeleteCourseResponseSchema)` to create a new message.
  */
 export const DeleteCourseResponseSchema: GenMessage<DeleteCourseResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_course, 24);
+  messageDesc(file_mirai_v1_course, 25);
 
 /**
  * GetFolderHierarchyRequest contains options for retrieving folders.
@@ -958,7 +1119,7 @@ export type GetFolderHierarchyRequest = Message<"mirai.v1.GetFolderHierarchyRequ
  * Use `create(GetFolderHierarchyRequestSchema)` to create a new message.
  */
 export const GetFolderHierarchyRequestSchema: GenMessage<GetFolderHierarchyRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_course, 25);
+  messageDesc(file_mirai_v1_course, 26);
 
 /**
  * GetFolderHierarchyResponse contains the folder hierarchy.
@@ -977,7 +1138,7 @@ export type GetFolderHierarchyResponse = Message<"mirai.v1.GetFolderHierarchyRes
  * Use `create(GetFolderHierarchyResponseSchema)` to create a new message.
  */
 export const GetFolderHierarchyResponseSchema: GenMessage<GetFolderHierarchyResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_course, 26);
+  messageDesc(file_mirai_v1_course, 27);
 
 /**
  * GetLibraryRequest contains options for retrieving the library.
@@ -996,7 +1157,7 @@ export type GetLibraryRequest = Message<"mirai.v1.GetLibraryRequest"> & {
  * Use `create(GetLibraryRequestSchema)` to create a new message.
  */
 export const GetLibraryRequestSchema: GenMessage<GetLibraryRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_course, 27);
+  messageDesc(file_mirai_v1_course, 28);
 
 /**
  * GetLibraryResponse contains the full library.
@@ -1015,7 +1176,7 @@ export type GetLibraryResponse = Message<"mirai.v1.GetLibraryResponse"> & {
  * Use `create(GetLibraryResponseSchema)` to create a new message.
  */
 export const GetLibraryResponseSchema: GenMessage<GetLibraryResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_course, 28);
+  messageDesc(file_mirai_v1_course, 29);
 
 /**
  * CreateFolderRequest contains the data for creating a new folder.
@@ -1036,11 +1197,18 @@ export type CreateFolderRequest = Message<"mirai.v1.CreateFolderRequest"> & {
   parentId?: string;
 
   /**
-   * typically FOLDER_TYPE_FOLDER for user-created folders
+   * FOLDER_TYPE_FOLDER or FOLDER_TYPE_TEAM; system folders are created automatically
    *
    * @generated from field: mirai.v1.FolderType type = 3;
    */
   type: FolderType;
+
+  /**
+   * Required for FOLDER_TYPE_TEAM
+   *
+   * @generated from field: optional string team_id = 4;
+   */
+  teamId?: string;
 };
 
 /**
@@ -1048,7 +1216,7 @@ export type CreateFolderRequest = Message<"mirai.v1.CreateFolderRequest"> & {
  * Use `create(CreateFolderRequestSchema)` to create a new message.
  */
 export const CreateFolderRequestSchema: GenMessage<CreateFolderRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_course, 29);
+  messageDesc(file_mirai_v1_course, 30);
 
 /**
  * CreateFolderResponse contains the newly created folder.
@@ -1067,7 +1235,64 @@ export type CreateFolderResponse = Message<"mirai.v1.CreateFolderResponse"> & {
  * Use `create(CreateFolderResponseSchema)` to create a new message.
  */
 export const CreateFolderResponseSchema: GenMessage<CreateFolderResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_course, 30);
+  messageDesc(file_mirai_v1_course, 31);
+
+/**
+ * UpdateFolderRequest contains the folder changes. Unset fields are left unchanged.
+ *
+ * @generated from message mirai.v1.UpdateFolderRequest
+ */
+export type UpdateFolderRequest = Message<"mirai.v1.UpdateFolderRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: optional string name = 2;
+   */
+  name?: string;
+
+  /**
+   * FOLDER_TYPE_FOLDER or FOLDER_TYPE_TEAM
+   *
+   * @generated from field: optional mirai.v1.FolderType type = 3;
+   */
+  type?: FolderType;
+
+  /**
+   * Required when changing to FOLDER_TYPE_TEAM
+   *
+   * @generated from field: optional string team_id = 4;
+   */
+  teamId?: string;
+};
+
+/**
+ * Describes the message mirai.v1.UpdateFolderRequest.
+ * Use `create(UpdateFolderRequestSchema)` to create a new message.
+ */
+export const UpdateFolderRequestSchema: GenMessage<UpdateFolderRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_course, 32);
+
+/**
+ * UpdateFolderResponse contains the updated folder.
+ *
+ * @generated from message mirai.v1.UpdateFolderResponse
+ */
+export type UpdateFolderResponse = Message<"mirai.v1.UpdateFolderResponse"> & {
+  /**
+   * @generated from field: mirai.v1.Folder folder = 1;
+   */
+  folder?: Folder;
+};
+
+/**
+ * Describes the message mirai.v1.UpdateFolderResponse.
+ * Use `create(UpdateFolderResponseSchema)` to create a new message.
+ */
+export const UpdateFolderResponseSchema: GenMessage<UpdateFolderResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_course, 33);
 
 /**
  * DeleteFolderRequest contains the folder ID to delete.
@@ -1086,7 +1311,7 @@ export type DeleteFolderRequest = Message<"mirai.v1.DeleteFolderRequest"> & {
  * Use `create(DeleteFolderRequestSchema)` to create a new message.
  */
 export const DeleteFolderRequestSchema: GenMessage<DeleteFolderRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_course, 31);
+  messageDesc(file_mirai_v1_course, 34);
 
 /**
  * DeleteFolderResponse confirms deletion.
@@ -1105,7 +1330,7 @@ export type DeleteFolderResponse = Message<"mirai.v1.DeleteFolderResponse"> & {
  * Use `create(DeleteFolderResponseSchema)` to create a new message.
  */
 export const DeleteFolderResponseSchema: GenMessage<DeleteFolderResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_course, 32);
+  messageDesc(file_mirai_v1_course, 35);
 
 /**
  * ExportCourseRequest contains the course ID and export format.
@@ -1129,7 +1354,7 @@ export type ExportCourseRequest = Message<"mirai.v1.ExportCourseRequest"> & {
  * Use `create(ExportCourseRequestSchema)` to create a new message.
  */
 export const ExportCourseRequestSchema: GenMessage<ExportCourseRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_course, 33);
+  messageDesc(file_mirai_v1_course, 36);
 
 /**
  * ExportCourseResponse contains the export job details.
@@ -1148,7 +1373,7 @@ export type ExportCourseResponse = Message<"mirai.v1.ExportCourseResponse"> & {
  * Use `create(ExportCourseResponseSchema)` to create a new message.
  */
 export const ExportCourseResponseSchema: GenMessage<ExportCourseResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_course, 34);
+  messageDesc(file_mirai_v1_course, 37);
 
 /**
  * GetExportStatusRequest contains the export ID.
@@ -1167,7 +1392,7 @@ export type GetExportStatusRequest = Message<"mirai.v1.GetExportStatusRequest">
  * Use `create(GetExportStatusRequestSchema)` to create a new message.
  */
 export const GetExportStatusRequestSchema: GenMessage<GetExportStatusRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_course, 35);
+  messageDesc(file_mirai_v1_course, 38);
 
 /**
  * GetExportStatusResponse contains the export status.
@@ -1186,7 +1411,7 @@ export type GetExportStatusResponse = Message<"mirai.v1.GetExportStatusResponse"
  * Use `create(GetExportStatusResponseSchema)` to create a new message.
  */
 export const GetExportStatusResponseSchema: GenMessage<GetExportStatusResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_course, 36);
+  messageDesc(file_mirai_v1_course, 39);
 
 /**
  * DownloadExportRequest contains the export ID.
@@ -1205,7 +1430,7 @@ export type DownloadExportRequest = Message<"mirai.v1.DownloadExportRequest"> &
  * Use `create(DownloadExportRequestSchema)` to create a new message.
  */
 export const DownloadExportRequestSchema: GenMessage<DownloadExportRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_course, 37);
+  messageDesc(file_mirai_v1_course, 40);
 
 /**
  * DownloadExportResponse contains the presigned download URL.
@@ -1229,7 +1454,7 @@ export type DownloadExportResponse = Message<"mirai.v1.DownloadExportResponse">
  * Use `create(DownloadExportResponseSchema)` to create a new message.
  */
 export const DownloadExportResponseSchema: GenMessage<DownloadExportResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_course, 38);
+  messageDesc(file_mirai_v1_course, 41);
 
 /**
  * ListExportsRequest contains the course ID.
@@ -1248,7 +1473,7 @@ export type ListExportsRequest = Message<"mirai.v1.ListExportsRequest"> & {
  * Use `create(ListExportsRequestSchema)` to create a new message.
  */
 export const ListExportsRequestSchema: GenMessage<ListExportsRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_course, 39);
+  messageDesc(file_mirai_v1_course, 42);
 
 /**
  * ListExportsResponse contains the list of exports.
@@ -1267,87 +1492,1218 @@ export type ListExportsResponse = Message<"mirai.v1.ListExportsResponse"> & {
  * Use `create(ListExportsResponseSchema)` to create a new message.
  */
 export const ListExportsResponseSchema: GenMessage<ListExportsResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_course, 40);
+  messageDesc(file_mirai_v1_course, 43);
 
 /**
- * CourseStatus represents the publication state of a course.
+ * ListCollaboratorsRequest contains the course ID.
  *
- * @generated from enum mirai.v1.CourseStatus
+ * @generated from message mirai.v1.ListCollaboratorsRequest
  */
-export enum CourseStatus {
-  /**
-   * @generated from enum value: COURSE_STATUS_UNSPECIFIED = 0;
-   */
-  UNSPECIFIED = 0,
-
+export type ListCollaboratorsRequest = Message<"mirai.v1.ListCollaboratorsRequest"> & {
   /**
-   * @generated from enum value: COURSE_STATUS_DRAFT = 1;
+   * @generated from field: string course_id = 1;
    */
-  DRAFT = 1,
+  courseId: string;
+};
 
-  /**
-   * @generated from enum value: COURSE_STATUS_PUBLISHED = 2;
-   */
-  PUBLISHED = 2,
+/**
+ * Describes the message mirai.v1.ListCollaboratorsRequest.
+ * Use `create(ListCollaboratorsRequestSchema)` to create a new message.
+ */
+export const ListCollaboratorsRequestSchema: GenMessage<ListCollaboratorsRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_course, 44);
 
+/**
+ * ListCollaboratorsResponse contains all collaborators on the course.
+ *
+ * @generated from message mirai.v1.ListCollaboratorsResponse
+ */
+export type ListCollaboratorsResponse = Message<"mirai.v1.ListCollaboratorsResponse"> & {
   /**
-   * @generated from enum value: COURSE_STATUS_GENERATED = 3;
+   * @generated from field: repeated mirai.v1.CourseCollaborator collaborators = 1;
    */
-  GENERATED = 3,
-}
+  collaborators: CourseCollaborator[];
+};
 
 /**
- * Describes the enum mirai.v1.CourseStatus.
+ * Describes the message mirai.v1.ListCollaboratorsResponse.
+ * Use `create(ListCollaboratorsResponseSchema)` to create a new message.
  */
-export const CourseStatusSchema: GenEnum<CourseStatus> = /*@__PURE__*/
-  enumDesc(file_mirai_v1_course, 0);
+export const ListCollaboratorsResponseSchema: GenMessage<ListCollaboratorsResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_course, 45);
 
 /**
- * BlockType represents the type of content block in the course editor.
+ * AddCollaboratorRequest contains the user to assign and their role.
  *
- * @generated from enum mirai.v1.BlockType
+ * @generated from message mirai.v1.AddCollaboratorRequest
  */
-export enum BlockType {
+export type AddCollaboratorRequest = Message<"mirai.v1.AddCollaboratorRequest"> & {
   /**
-   * @generated from enum value: BLOCK_TYPE_UNSPECIFIED = 0;
+   * @generated from field: string course_id = 1;
    */
-  UNSPECIFIED = 0,
+  courseId: string;
 
   /**
-   * @generated from enum value: BLOCK_TYPE_HEADING = 1;
+   * @generated from field: string user_id = 2;
    */
-  HEADING = 1,
+  userId: string;
 
   /**
-   * @generated from enum value: BLOCK_TYPE_TEXT = 2;
+   * @generated from field: mirai.v1.CourseRole role = 3;
    */
-  TEXT = 2,
+  role: CourseRole;
+};
 
-  /**
-   * @generated from enum value: BLOCK_TYPE_INTERACTIVE = 3;
-   */
-  INTERACTIVE = 3,
+/**
+ * Describes the message mirai.v1.AddCollaboratorRequest.
+ * Use `create(AddCollaboratorRequestSchema)` to create a new message.
+ */
+export const AddCollaboratorRequestSchema: GenMessage<AddCollaboratorRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_course, 46);
 
+/**
+ * AddCollaboratorResponse contains the created assignment.
+ *
+ * @generated from message mirai.v1.AddCollaboratorResponse
+ */
+export type AddCollaboratorResponse = Message<"mirai.v1.AddCollaboratorResponse"> & {
   /**
-   * @generated from enum value: BLOCK_TYPE_KNOWLEDGE_CHECK = 4;
+   * @generated from field: mirai.v1.CourseCollaborator collaborator = 1;
    */
-  KNOWLEDGE_CHECK = 4,
-}
+  collaborator?: CourseCollaborator;
+};
 
 /**
- * Describes the enum mirai.v1.BlockType.
+ * Describes the message mirai.v1.AddCollaboratorResponse.
+ * Use `create(AddCollaboratorResponseSchema)` to create a new message.
  */
-export const BlockTypeSchema: GenEnum<BlockType> = /*@__PURE__*/
-  enumDesc(file_mirai_v1_course, 1);
+export const AddCollaboratorResponseSchema: GenMessage<AddCollaboratorResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_course, 47);
 
 /**
- * FolderType represents the type of folder in the library.
+ * RemoveCollaboratorRequest contains the user to remove.
  *
- * @generated from enum mirai.v1.FolderType
+ * @generated from message mirai.v1.RemoveCollaboratorRequest
  */
-export enum FolderType {
+export type RemoveCollaboratorRequest = Message<"mirai.v1.RemoveCollaboratorRequest"> & {
   /**
-   * @generated from enum value: FOLDER_TYPE_UNSPECIFIED = 0;
+   * @generated from field: string course_id = 1;
+   */
+  courseId: string;
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+};
+
+/**
+ * Describes the message mirai.v1.RemoveCollaboratorRequest.
+ * Use `create(RemoveCollaboratorRequestSchema)` to create a new message.
+ */
+export const RemoveCollaboratorRequestSchema: GenMessage<RemoveCollaboratorRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_course, 48);
+
+/**
+ * RemoveCollaboratorResponse confirms removal.
+ *
+ * @generated from message mirai.v1.RemoveCollaboratorResponse
+ */
+export type RemoveCollaboratorResponse = Message<"mirai.v1.RemoveCollaboratorResponse"> & {
+};
+
+/**
+ * Describes the message mirai.v1.RemoveCollaboratorResponse.
+ * Use `create(RemoveCollaboratorResponseSchema)` to create a new message.
+ */
+export const RemoveCollaboratorResponseSchema: GenMessage<RemoveCollaboratorResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_course, 49);
+
+/**
+ * RemoveSampleContentRequest is empty as the tenant is identified by auth context.
+ *
+ * @generated from message mirai.v1.RemoveSampleContentRequest
+ */
+export type RemoveSampleContentRequest = Message<"mirai.v1.RemoveSampleContentRequest"> & {
+};
+
+/**
+ * Describes the message mirai.v1.RemoveSampleContentRequest.
+ * Use `create(RemoveSampleContentRequestSchema)` to create a new message.
+ */
+export const RemoveSampleContentRequestSchema: GenMessage<RemoveSampleContentRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_course, 50);
+
+/**
+ * RemoveSampleContentResponse reports what was removed.
+ *
+ * @generated from message mirai.v1.RemoveSampleContentResponse
+ */
+export type RemoveSampleContentResponse = Message<"mirai.v1.RemoveSampleContentResponse"> & {
+  /**
+   * @generated from field: int32 courses_removed = 1;
+   */
+  coursesRemoved: number;
+
+  /**
+   * @generated from field: int32 folders_removed = 2;
+   */
+  foldersRemoved: number;
+
+  /**
+   * Sample folders kept because they now hold other content
+   *
+   * @generated from field: int32 folders_kept = 3;
+   */
+  foldersKept: number;
+
+  /**
+   * @generated from field: int32 target_audiences_removed = 4;
+   */
+  targetAudiencesRemoved: number;
+};
+
+/**
+ * Describes the message mirai.v1.RemoveSampleContentResponse.
+ * Use `create(RemoveSampleContentResponseSchema)` to create a new message.
+ */
+export const RemoveSampleContentResponseSchema: GenMessage<RemoveSampleContentResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_course, 51);
+
+/**
+ * ListLargestCoursesRequest limits the report.
+ *
+ * @generated from message mirai.v1.ListLargestCoursesRequest
+ */
+export type ListLargestCoursesRequest = Message<"mirai.v1.ListLargestCoursesRequest"> & {
+  /**
+   * Defaults to 20, max 100
+   *
+   * @generated from field: int32 limit = 1;
+   */
+  limit: number;
+};
+
+/**
+ * Describes the message mirai.v1.ListLargestCoursesRequest.
+ * Use `create(ListLargestCoursesRequestSchema)` to create a new message.
+ */
+export const ListLargestCoursesRequestSchema: GenMessage<ListLargestCoursesRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_course, 52);
+
+/**
+ * CourseSize is a course's stored content size.
+ *
+ * @generated from message mirai.v1.CourseSize
+ */
+export type CourseSize = Message<"mirai.v1.CourseSize"> & {
+  /**
+   * @generated from field: string course_id = 1;
+   */
+  courseId: string;
+
+  /**
+   * @generated from field: string title = 2;
+   */
+  title: string;
+
+  /**
+   * @generated from field: int64 content_size_bytes = 3;
+   */
+  contentSizeBytes: bigint;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp modified_at = 4;
+   */
+  modifiedAt?: Timestamp;
+};
+
+/**
+ * Describes the message mirai.v1.CourseSize.
+ * Use `create(CourseSizeSchema)` to create a new message.
+ */
+export const CourseSizeSchema: GenMessage<CourseSize> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_course, 53);
+
+/**
+ * ListLargestCoursesResponse lists courses largest first. Courses not saved since sizes
+ * started being recorded are left out.
+ *
+ * @generated from message mirai.v1.ListLargestCoursesResponse
+ */
+export type ListLargestCoursesResponse = Message<"mirai.v1.ListLargestCoursesResponse"> & {
+  /**
+   * @generated from field: repeated mirai.v1.CourseSize courses = 1;
+   */
+  courses: CourseSize[];
+
+  /**
+   * Saves above this succeed with a warning; 0 if unset
+   *
+   * @generated from field: int64 soft_limit_bytes = 2;
+   */
+  softLimitBytes: bigint;
+
+  /**
+   * Saves above this are rejected; 0 if unset
+   *
+   * @generated from field: int64 hard_limit_bytes = 3;
+   */
+  hardLimitBytes: bigint;
+};
+
+/**
+ * Describes the message mirai.v1.ListLargestCoursesResponse.
+ * Use `create(ListLargestCoursesResponseSchema)` to create a new message.
+ */
+export const ListLargestCoursesResponseSchema: GenMessage<ListLargestCoursesResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_course, 54);
+
+/**
+ * PublishChangesRequest names the course whose edits to publish.
+ *
+ * @generated from message mirai.v1.PublishChangesRequest
+ */
+export type PublishChangesRequest = Message<"mirai.v1.PublishChangesRequest"> & {
+  /**
+   * @generated from field: string course_id = 1;
+   */
+  courseId: string;
+};
+
+/**
+ * Describes the message mirai.v1.PublishChangesRequest.
+ * Use `create(PublishChangesRequestSchema)` to create a new message.
+ */
+export const PublishChangesRequestSchema: GenMessage<PublishChangesRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_course, 55);
+
+/**
+ * PublishChangesResponse returns the course with its new published version.
+ *
+ * @generated from message mirai.v1.PublishChangesResponse
+ */
+export type PublishChangesResponse = Message<"mirai.v1.PublishChangesResponse"> & {
+  /**
+   * @generated from field: mirai.v1.Course course = 1;
+   */
+  course?: Course;
+};
+
+/**
+ * Describes the message mirai.v1.PublishChangesResponse.
+ * Use `create(PublishChangesResponseSchema)` to create a new message.
+ */
+export const PublishChangesResponseSchema: GenMessage<PublishChangesResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_course, 56);
+
+/**
+ * DiscardDraftRequest names the course whose edits to discard.
+ *
+ * @generated from message mirai.v1.DiscardDraftRequest
+ */
+export type DiscardDraftRequest = Message<"mirai.v1.DiscardDraftRequest"> & {
+  /**
+   * @generated from field: string course_id = 1;
+   */
+  courseId: string;
+};
+
+/**
+ * Describes the message mirai.v1.DiscardDraftRequest.
+ * Use `create(DiscardDraftRequestSchema)` to create a new message.
+ */
+export const DiscardDraftRequestSchema: GenMessage<DiscardDraftRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_course, 57);
+
+/**
+ * DiscardDraftResponse returns the course as restored. Outline changes and lessons added
+ * since publishing are kept; the lessons still in the course are restored.
+ *
+ * @generated from message mirai.v1.DiscardDraftResponse
+ */
+export type DiscardDraftResponse = Message<"mirai.v1.DiscardDraftResponse"> & {
+  /**
+   * @generated from field: mirai.v1.Course course = 1;
+   */
+  course?: Course;
+
+  /**
+   * @generated from field: int32 lessons_restored = 2;
+   */
+  lessonsRestored: number;
+};
+
+/**
+ * Describes the message mirai.v1.DiscardDraftResponse.
+ * Use `create(DiscardDraftResponseSchema)` to create a new message.
+ */
+export const DiscardDraftResponseSchema: GenMessage<DiscardDraftResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_course, 58);
+
+/**
+ * SearchWithinCourseRequest names the course to search and the phrase to find.
+ *
+ * @generated from message mirai.v1.SearchWithinCourseRequest
+ */
+export type SearchWithinCourseRequest = Message<"mirai.v1.SearchWithinCourseRequest"> & {
+  /**
+   * @generated from field: string course_id = 1;
+   */
+  courseId: string;
+
+  /**
+   * Matched case-insensitively; max 200 characters
+   *
+   * @generated from field: string query = 2;
+   */
+  query: string;
+};
+
+/**
+ * Describes the message mirai.v1.SearchWithinCourseRequest.
+ * Use `create(SearchWithinCourseRequestSchema)` to create a new message.
+ */
+export const SearchWithinCourseRequestSchema: GenMessage<SearchWithinCourseRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_course, 59);
+
+/**
+ * CourseSearchMatch is one block or component containing the phrase.
+ *
+ * @generated from message mirai.v1.CourseSearchMatch
+ */
+export type CourseSearchMatch = Message<"mirai.v1.CourseSearchMatch"> & {
+  /**
+   * @generated from field: mirai.v1.CourseSearchSource source = 1;
+   */
+  source: CourseSearchSource;
+
+  /**
+   * Empty for course-level blocks
+   *
+   * @generated from field: string section_id = 2;
+   */
+  sectionId: string;
+
+  /**
+   * @generated from field: string section_title = 3;
+   */
+  sectionTitle: string;
+
+  /**
+   * Empty for course-level blocks
+   *
+   * @generated from field: string lesson_id = 4;
+   */
+  lessonId: string;
+
+  /**
+   * @generated from field: string lesson_title = 5;
+   */
+  lessonTitle: string;
+
+  /**
+   * Set for authoring matches
+   *
+   * @generated from field: string block_id = 6;
+   */
+  blockId: string;
+
+  /**
+   * Set for lesson component matches
+   *
+   * @generated from field: string component_id = 7;
+   */
+  componentId: string;
+
+  /**
+   * Text around the match
+   *
+   * @generated from field: string snippet = 8;
+   */
+  snippet: string;
+
+  /**
+   * Character offset of the match within the snippet
+   *
+   * @generated from field: int32 highlight_start = 9;
+   */
+  highlightStart: number;
+
+  /**
+   * Characters to highlight; 0 when the words matched apart
+   *
+   * @generated from field: int32 highlight_length = 10;
+   */
+  highlightLength: number;
+};
+
+/**
+ * Describes the message mirai.v1.CourseSearchMatch.
+ * Use `create(CourseSearchMatchSchema)` to create a new message.
+ */
+export const CourseSearchMatchSchema: GenMessage<CourseSearchMatch> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_course, 60);
+
+/**
+ * SearchWithinCourseResponse lists the matches in course order: authored content first,
+ * then generated lessons, each by section, lesson and position.
+ *
+ * @generated from message mirai.v1.SearchWithinCourseResponse
+ */
+export type SearchWithinCourseResponse = Message<"mirai.v1.SearchWithinCourseResponse"> & {
+  /**
+   * @generated from field: repeated mirai.v1.CourseSearchMatch matches = 1;
+   */
+  matches: CourseSearchMatch[];
+
+  /**
+   * More than 50 matches; only the first 50 are returned
+   *
+   * @generated from field: bool truncated = 2;
+   */
+  truncated: boolean;
+};
+
+/**
+ * Describes the message mirai.v1.SearchWithinCourseResponse.
+ * Use `create(SearchWithinCourseResponseSchema)` to create a new message.
+ */
+export const SearchWithinCourseResponseSchema: GenMessage<SearchWithinCourseResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_course, 61);
+
+/**
+ * CourseAttachment is a reference file, such as a style guide or product spec, whose
+ * text is given to outline and lesson generation alongside SME knowledge.
+ *
+ * @generated from message mirai.v1.CourseAttachment
+ */
+export type CourseAttachment = Message<"mirai.v1.CourseAttachment"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string course_id = 2;
+   */
+  courseId: string;
+
+  /**
+   * @generated from field: string file_name = 3;
+   */
+  fileName: string;
+
+  /**
+   * @generated from field: string content_type = 4;
+   */
+  contentType: string;
+
+  /**
+   * @generated from field: int64 size_bytes = 5;
+   */
+  sizeBytes: bigint;
+
+  /**
+   * @generated from field: mirai.v1.CourseAttachmentStatus status = 6;
+   */
+  status: CourseAttachmentStatus;
+
+  /**
+   * @generated from field: optional string error_message = 7;
+   */
+  errorMessage?: string;
+
+  /**
+   * Chunks of text extracted
+   *
+   * @generated from field: int32 chunk_count = 8;
+   */
+  chunkCount: number;
+
+  /**
+   * Chunks held back from prompts as possible prompt injection
+   *
+   * @generated from field: int32 flagged_chunk_count = 9;
+   */
+  flaggedChunkCount: number;
+
+  /**
+   * @generated from field: bool excluded_from_prompts = 10;
+   */
+  excludedFromPrompts: boolean;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 11;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp processed_at = 12;
+   */
+  processedAt?: Timestamp;
+};
+
+/**
+ * Describes the message mirai.v1.CourseAttachment.
+ * Use `create(CourseAttachmentSchema)` to create a new message.
+ */
+export const CourseAttachmentSchema: GenMessage<CourseAttachment> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_course, 62);
+
+/**
+ * GetCourseAttachmentUploadURLRequest requests an upload slot for a reference file.
+ *
+ * @generated from message mirai.v1.GetCourseAttachmentUploadURLRequest
+ */
+export type GetCourseAttachmentUploadURLRequest = Message<"mirai.v1.GetCourseAttachmentUploadURLRequest"> & {
+  /**
+   * @generated from field: string course_id = 1;
+   */
+  courseId: string;
+
+  /**
+   * .pdf, .docx, .md, .markdown, .txt or .csv
+   *
+   * @generated from field: string file_name = 2;
+   */
+  fileName: string;
+
+  /**
+   * @generated from field: string content_type = 3;
+   */
+  contentType: string;
+
+  /**
+   * At most 20 MB
+   *
+   * @generated from field: int64 size_bytes = 4;
+   */
+  sizeBytes: bigint;
+};
+
+/**
+ * Describes the message mirai.v1.GetCourseAttachmentUploadURLRequest.
+ * Use `create(GetCourseAttachmentUploadURLRequestSchema)` to create a new message.
+ */
+export const GetCourseAttachmentUploadURLRequestSchema: GenMessage<GetCourseAttachmentUploadURLRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_course, 63);
+
+/**
+ * GetCourseAttachmentUploadURLResponse contains the presigned upload URL.
+ *
+ * @generated from message mirai.v1.GetCourseAttachmentUploadURLResponse
+ */
+export type GetCourseAttachmentUploadURLResponse = Message<"mirai.v1.GetCourseAttachmentUploadURLResponse"> & {
+  /**
+   * @generated from field: string upload_url = 1;
+   */
+  uploadUrl: string;
+
+  /**
+   * Pass to ConfirmCourseAttachment once uploaded
+   *
+   * @generated from field: string file_path = 2;
+   */
+  filePath: string;
+};
+
+/**
+ * Describes the message mirai.v1.GetCourseAttachmentUploadURLResponse.
+ * Use `create(GetCourseAttachmentUploadURLResponseSchema)` to create a new message.
+ */
+export const GetCourseAttachmentUploadURLResponseSchema: GenMessage<GetCourseAttachmentUploadURLResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_course, 64);
+
+/**
+ * ConfirmCourseAttachmentRequest records an uploaded reference file.
+ *
+ * @generated from message mirai.v1.ConfirmCourseAttachmentRequest
+ */
+export type ConfirmCourseAttachmentRequest = Message<"mirai.v1.ConfirmCourseAttachmentRequest"> & {
+  /**
+   * @generated from field: string course_id = 1;
+   */
+  courseId: string;
+
+  /**
+   * Path from GetCourseAttachmentUploadURL
+   *
+   * @generated from field: string file_path = 2;
+   */
+  filePath: string;
+
+  /**
+   * @generated from field: string content_type = 3;
+   */
+  contentType: string;
+};
+
+/**
+ * Describes the message mirai.v1.ConfirmCourseAttachmentRequest.
+ * Use `create(ConfirmCourseAttachmentRequestSchema)` to create a new message.
+ */
+export const ConfirmCourseAttachmentRequestSchema: GenMessage<ConfirmCourseAttachmentRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_course, 65);
+
+/**
+ * ConfirmCourseAttachmentResponse contains the new attachment, pending extraction.
+ *
+ * @generated from message mirai.v1.ConfirmCourseAttachmentResponse
+ */
+export type ConfirmCourseAttachmentResponse = Message<"mirai.v1.ConfirmCourseAttachmentResponse"> & {
+  /**
+   * @generated from field: mirai.v1.CourseAttachment attachment = 1;
+   */
+  attachment?: CourseAttachment;
+};
+
+/**
+ * Describes the message mirai.v1.ConfirmCourseAttachmentResponse.
+ * Use `create(ConfirmCourseAttachmentResponseSchema)` to create a new message.
+ */
+export const ConfirmCourseAttachmentResponseSchema: GenMessage<ConfirmCourseAttachmentResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_course, 66);
+
+/**
+ * ListCourseAttachmentsRequest names the course whose reference files to list.
+ *
+ * @generated from message mirai.v1.ListCourseAttachmentsRequest
+ */
+export type ListCourseAttachmentsRequest = Message<"mirai.v1.ListCourseAttachmentsRequest"> & {
+  /**
+   * @generated from field: string course_id = 1;
+   */
+  courseId: string;
+};
+
+/**
+ * Describes the message mirai.v1.ListCourseAttachmentsRequest.
+ * Use `create(ListCourseAttachmentsRequestSchema)` to create a new message.
+ */
+export const ListCourseAttachmentsRequestSchema: GenMessage<ListCourseAttachmentsRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_course, 67);
+
+/**
+ * ListCourseAttachmentsResponse lists the course's reference files, oldest first.
+ *
+ * @generated from message mirai.v1.ListCourseAttachmentsResponse
+ */
+export type ListCourseAttachmentsResponse = Message<"mirai.v1.ListCourseAttachmentsResponse"> & {
+  /**
+   * @generated from field: repeated mirai.v1.CourseAttachment attachments = 1;
+   */
+  attachments: CourseAttachment[];
+};
+
+/**
+ * Describes the message mirai.v1.ListCourseAttachmentsResponse.
+ * Use `create(ListCourseAttachmentsResponseSchema)` to create a new message.
+ */
+export const ListCourseAttachmentsResponseSchema: GenMessage<ListCourseAttachmentsResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_course, 68);
+
+/**
+ * SetCourseAttachmentExcludedRequest includes or leaves out a reference file.
+ *
+ * @generated from message mirai.v1.SetCourseAttachmentExcludedRequest
+ */
+export type SetCourseAttachmentExcludedRequest = Message<"mirai.v1.SetCourseAttachmentExcludedRequest"> & {
+  /**
+   * @generated from field: string attachment_id = 1;
+   */
+  attachmentId: string;
+
+  /**
+   * @generated from field: bool excluded = 2;
+   */
+  excluded: boolean;
+};
+
+/**
+ * Describes the message mirai.v1.SetCourseAttachmentExcludedRequest.
+ * Use `create(SetCourseAttachmentExcludedRequestSchema)` to create a new message.
+ */
+export const SetCourseAttachmentExcludedRequestSchema: GenMessage<SetCourseAttachmentExcludedRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_course, 69);
+
+/**
+ * SetCourseAttachmentExcludedResponse contains the updated attachment.
+ *
+ * @generated from message mirai.v1.SetCourseAttachmentExcludedResponse
+ */
+export type SetCourseAttachmentExcludedResponse = Message<"mirai.v1.SetCourseAttachmentExcludedResponse"> & {
+  /**
+   * @generated from field: mirai.v1.CourseAttachment attachment = 1;
+   */
+  attachment?: CourseAttachment;
+};
+
+/**
+ * Describes the message mirai.v1.SetCourseAttachmentExcludedResponse.
+ * Use `create(SetCourseAttachmentExcludedResponseSchema)` to create a new message.
+ */
+export const SetCourseAttachmentExcludedResponseSchema: GenMessage<SetCourseAttachmentExcludedResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_course, 70);
+
+/**
+ * DeleteCourseAttachmentRequest names the reference file to remove.
+ *
+ * @generated from message mirai.v1.DeleteCourseAttachmentRequest
+ */
+export type DeleteCourseAttachmentRequest = Message<"mirai.v1.DeleteCourseAttachmentRequest"> & {
+  /**
+   * @generated from field: string attachment_id = 1;
+   */
+  attachmentId: string;
+};
+
+/**
+ * Describes the message mirai.v1.DeleteCourseAttachmentRequest.
+ * Use `create(DeleteCourseAttachmentRequestSchema)` to create a new message.
+ */
+export const DeleteCourseAttachmentRequestSchema: GenMessage<DeleteCourseAttachmentRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_course, 71);
+
+/**
+ * DeleteCourseAttachmentResponse is empty on success.
+ *
+ * @generated from message mirai.v1.DeleteCourseAttachmentResponse
+ */
+export type DeleteCourseAttachmentResponse = Message<"mirai.v1.DeleteCourseAttachmentResponse"> & {
+};
+
+/**
+ * Describes the message mirai.v1.DeleteCourseAttachmentResponse.
+ * Use `create(DeleteCourseAttachmentResponseSchema)` to create a new message.
+ */
+export const DeleteCourseAttachmentResponseSchema: GenMessage<DeleteCourseAttachmentResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_course, 72);
+
+/**
+ * CourseGenerationLock names the full course generation run holding a course read-only.
+ *
+ * @generated from message mirai.v1.CourseGenerationLock
+ */
+export type CourseGenerationLock = Message<"mirai.v1.CourseGenerationLock"> & {
+  /**
+   * The run's parent job
+   *
+   * @generated from field: string job_id = 1;
+   */
+  jobId: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp locked_at = 2;
+   */
+  lockedAt?: Timestamp;
+};
+
+/**
+ * Describes the message mirai.v1.CourseGenerationLock.
+ * Use `create(CourseGenerationLockSchema)` to create a new message.
+ */
+export const CourseGenerationLockSchema: GenMessage<CourseGenerationLock> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_course, 73);
+
+/**
+ * CourseCard is the minimum needed to lay out a course in the library.
+ *
+ * @generated from message mirai.v1.CourseCard
+ */
+export type CourseCard = Message<"mirai.v1.CourseCard"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string title = 2;
+   */
+  title: string;
+
+  /**
+   * @generated from field: mirai.v1.CourseStatus status = 3;
+   */
+  status: CourseStatus;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp modified_at = 4;
+   */
+  modifiedAt?: Timestamp;
+};
+
+/**
+ * Describes the message mirai.v1.CourseCard.
+ * Use `create(CourseCardSchema)` to create a new message.
+ */
+export const CourseCardSchema: GenMessage<CourseCard> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_course, 74);
+
+/**
+ * ListCourseCardsRequest contains optional filters for a page of course cards.
+ *
+ * @generated from message mirai.v1.ListCourseCardsRequest
+ */
+export type ListCourseCardsRequest = Message<"mirai.v1.ListCourseCardsRequest"> & {
+  /**
+   * @generated from field: optional mirai.v1.CourseStatus status = 1;
+   */
+  status?: CourseStatus;
+
+  /**
+   * @generated from field: optional string folder = 2;
+   */
+  folder?: string;
+
+  /**
+   * Max results (default 48, max 100)
+   *
+   * @generated from field: int32 limit = 3;
+   */
+  limit: number;
+
+  /**
+   * For pagination
+   *
+   * @generated from field: optional string cursor = 4;
+   */
+  cursor?: string;
+};
+
+/**
+ * Describes the message mirai.v1.ListCourseCardsRequest.
+ * Use `create(ListCourseCardsRequestSchema)` to create a new message.
+ */
+export const ListCourseCardsRequestSchema: GenMessage<ListCourseCardsRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_course, 75);
+
+/**
+ * ListCourseCardsResponse contains a page of course cards.
+ *
+ * @generated from message mirai.v1.ListCourseCardsResponse
+ */
+export type ListCourseCardsResponse = Message<"mirai.v1.ListCourseCardsResponse"> & {
+  /**
+   * @generated from field: repeated mirai.v1.CourseCard cards = 1;
+   */
+  cards: CourseCard[];
+
+  /**
+   * For pagination
+   *
+   * @generated from field: optional string next_cursor = 2;
+   */
+  nextCursor?: string;
+};
+
+/**
+ * Describes the message mirai.v1.ListCourseCardsResponse.
+ * Use `create(ListCourseCardsResponseSchema)` to create a new message.
+ */
+export const ListCourseCardsResponseSchema: GenMessage<ListCourseCardsResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_course, 76);
+
+/**
+ * CourseCardDetails is the part of a course card loaded only for cards on screen.
+ *
+ * @generated from message mirai.v1.CourseCardDetails
+ */
+export type CourseCardDetails = Message<"mirai.v1.CourseCardDetails"> & {
+  /**
+   * @generated from field: string course_id = 1;
+   */
+  courseId: string;
+
+  /**
+   * @generated from field: repeated string tags = 2;
+   */
+  tags: string[];
+
+  /**
+   * Presigned; valid for 15 minutes
+   *
+   * @generated from field: optional string thumbnail_url = 3;
+   */
+  thumbnailUrl?: string;
+
+  /**
+   * @generated from field: string created_by = 4;
+   */
+  createdBy: string;
+
+  /**
+   * Unset if the creator can't be looked up
+   *
+   * @generated from field: optional string created_by_name = 5;
+   */
+  createdByName?: string;
+
+  /**
+   * False when the creator has been deactivated
+   *
+   * @generated from field: bool created_by_active = 6;
+   */
+  createdByActive: boolean;
+
+  /**
+   * Estimated from the latest outline; 0 without one
+   *
+   * @generated from field: int32 duration_minutes = 7;
+   */
+  durationMinutes: number;
+};
+
+/**
+ * Describes the message mirai.v1.CourseCardDetails.
+ * Use `create(CourseCardDetailsSchema)` to create a new message.
+ */
+export const CourseCardDetailsSchema: GenMessage<CourseCardDetails> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_course, 77);
+
+/**
+ * GetCourseCardDetailsRequest names the course cards to fill in.
+ *
+ * @generated from message mirai.v1.GetCourseCardDetailsRequest
+ */
+export type GetCourseCardDetailsRequest = Message<"mirai.v1.GetCourseCardDetailsRequest"> & {
+  /**
+   * At most 50
+   *
+   * @generated from field: repeated string course_ids = 1;
+   */
+  courseIds: string[];
+};
+
+/**
+ * Describes the message mirai.v1.GetCourseCardDetailsRequest.
+ * Use `create(GetCourseCardDetailsRequestSchema)` to create a new message.
+ */
+export const GetCourseCardDetailsRequestSchema: GenMessage<GetCourseCardDetailsRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_course, 78);
+
+/**
+ * GetCourseCardDetailsResponse contains the details of the requested courses that exist,
+ * in the order requested.
+ *
+ * @generated from message mirai.v1.GetCourseCardDetailsResponse
+ */
+export type GetCourseCardDetailsResponse = Message<"mirai.v1.GetCourseCardDetailsResponse"> & {
+  /**
+   * @generated from field: repeated mirai.v1.CourseCardDetails details = 1;
+   */
+  details: CourseCardDetails[];
+};
+
+/**
+ * Describes the message mirai.v1.GetCourseCardDetailsResponse.
+ * Use `create(GetCourseCardDetailsResponseSchema)` to create a new message.
+ */
+export const GetCourseCardDetailsResponseSchema: GenMessage<GetCourseCardDetailsResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_course, 79);
+
+/**
+ * CoursePresence is someone with a course open, as of their last heartbeat.
+ *
+ * @generated from message mirai.v1.CoursePresence
+ */
+export type CoursePresence = Message<"mirai.v1.CoursePresence"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * Empty if their identity can't be looked up
+   *
+   * @generated from field: string display_name = 2;
+   */
+  displayName: string;
+
+  /**
+   * False when only viewing
+   *
+   * @generated from field: bool editing = 3;
+   */
+  editing: boolean;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp last_seen_at = 4;
+   */
+  lastSeenAt?: Timestamp;
+};
+
+/**
+ * Describes the message mirai.v1.CoursePresence.
+ * Use `create(CoursePresenceSchema)` to create a new message.
+ */
+export const CoursePresenceSchema: GenMessage<CoursePresence> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_course, 80);
+
+/**
+ * HeartbeatCoursePresenceRequest names the course the caller has open.
+ *
+ * @generated from message mirai.v1.HeartbeatCoursePresenceRequest
+ */
+export type HeartbeatCoursePresenceRequest = Message<"mirai.v1.HeartbeatCoursePresenceRequest"> & {
+  /**
+   * @generated from field: string course_id = 1;
+   */
+  courseId: string;
+
+  /**
+   * False when only viewing
+   *
+   * @generated from field: bool editing = 2;
+   */
+  editing: boolean;
+};
+
+/**
+ * Describes the message mirai.v1.HeartbeatCoursePresenceRequest.
+ * Use `create(HeartbeatCoursePresenceRequestSchema)` to create a new message.
+ */
+export const HeartbeatCoursePresenceRequestSchema: GenMessage<HeartbeatCoursePresenceRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_course, 81);
+
+/**
+ * HeartbeatCoursePresenceResponse lists everyone else with the course open, editors first.
+ * It is empty when presence is unavailable.
+ *
+ * @generated from message mirai.v1.HeartbeatCoursePresenceResponse
+ */
+export type HeartbeatCoursePresenceResponse = Message<"mirai.v1.HeartbeatCoursePresenceResponse"> & {
+  /**
+   * @generated from field: repeated mirai.v1.CoursePresence others = 1;
+   */
+  others: CoursePresence[];
+};
+
+/**
+ * Describes the message mirai.v1.HeartbeatCoursePresenceResponse.
+ * Use `create(HeartbeatCoursePresenceResponseSchema)` to create a new message.
+ */
+export const HeartbeatCoursePresenceResponseSchema: GenMessage<HeartbeatCoursePresenceResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_course, 82);
+
+/**
+ * GetCoursePresenceRequest names the course to check.
+ *
+ * @generated from message mirai.v1.GetCoursePresenceRequest
+ */
+export type GetCoursePresenceRequest = Message<"mirai.v1.GetCoursePresenceRequest"> & {
+  /**
+   * @generated from field: string course_id = 1;
+   */
+  courseId: string;
+};
+
+/**
+ * Describes the message mirai.v1.GetCoursePresenceRequest.
+ * Use `create(GetCoursePresenceRequestSchema)` to create a new message.
+ */
+export const GetCoursePresenceRequestSchema: GenMessage<GetCoursePresenceRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_course, 83);
+
+/**
+ * GetCoursePresenceResponse lists everyone else with the course open, editors first.
+ * It is empty when presence is unavailable.
+ *
+ * @generated from message mirai.v1.GetCoursePresenceResponse
+ */
+export type GetCoursePresenceResponse = Message<"mirai.v1.GetCoursePresenceResponse"> & {
+  /**
+   * @generated from field: repeated mirai.v1.CoursePresence others = 1;
+   */
+  others: CoursePresence[];
+};
+
+/**
+ * Describes the message mirai.v1.GetCoursePresenceResponse.
+ * Use `create(GetCoursePresenceResponseSchema)` to create a new message.
+ */
+export const GetCoursePresenceResponseSchema: GenMessage<GetCoursePresenceResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_course, 84);
+
+/**
+ * CourseStatus represents the publication state of a course.
+ *
+ * @generated from enum mirai.v1.CourseStatus
+ */
+export enum CourseStatus {
+  /**
+   * @generated from enum value: COURSE_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: COURSE_STATUS_DRAFT = 1;
+   */
+  DRAFT = 1,
+
+  /**
+   * @generated from enum value: COURSE_STATUS_PUBLISHED = 2;
+   */
+  PUBLISHED = 2,
+
+  /**
+   * @generated from enum value: COURSE_STATUS_GENERATED = 3;
+   */
+  GENERATED = 3,
+}
+
+/**
+ * Describes the enum mirai.v1.CourseStatus.
+ */
+export const CourseStatusSchema: GenEnum<CourseStatus> = /*@__PURE__*/
+  enumDesc(file_mirai_v1_course, 0);
+
+/**
+ * BlockType represents the type of content block in the course editor.
+ *
+ * @generated from enum mirai.v1.BlockType
+ */
+export enum BlockType {
+  /**
+   * @generated from enum value: BLOCK_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: BLOCK_TYPE_HEADING = 1;
+   */
+  HEADING = 1,
+
+  /**
+   * @generated from enum value: BLOCK_TYPE_TEXT = 2;
+   */
+  TEXT = 2,
+
+  /**
+   * @generated from enum value: BLOCK_TYPE_INTERACTIVE = 3;
+   */
+  INTERACTIVE = 3,
+
+  /**
+   * @generated from enum value: BLOCK_TYPE_KNOWLEDGE_CHECK = 4;
+   */
+  KNOWLEDGE_CHECK = 4,
+}
+
+/**
+ * Describes the enum mirai.v1.BlockType.
+ */
+export const BlockTypeSchema: GenEnum<BlockType> = /*@__PURE__*/
+  enumDesc(file_mirai_v1_course, 1);
+
+/**
+ * FolderType represents the type of folder in the library.
+ *
+ * @generated from enum mirai.v1.FolderType
+ */
+export enum FolderType {
+  /**
+   * @generated from enum value: FOLDER_TYPE_UNSPECIFIED = 0;
    */
   UNSPECIFIED = 0,
 
@@ -1408,6 +2764,13 @@ export enum ExportFormat {
    * @generated from enum value: EXPORT_FORMAT_PDF = 4;
    */
   PDF = 4,
+
+  /**
+   * ZIP of the course's generated lessons as Markdown
+   *
+   * @generated from enum value: EXPORT_FORMAT_MARKDOWN_ZIP = 5;
+   */
+  MARKDOWN_ZIP = 5,
 }
 
 /**
@@ -1446,6 +2809,13 @@ export enum ExportStatus {
    * @generated from enum value: EXPORT_STATUS_FAILED = 4;
    */
   FAILED = 4,
+
+  /**
+   * The file was deleted; see purge_reason
+   *
+   * @generated from enum value: EXPORT_STATUS_PURGED = 5;
+   */
+  PURGED = 5,
 }
 
 /**
@@ -1454,6 +2824,155 @@ export enum ExportStatus {
 export const ExportStatusSchema: GenEnum<ExportStatus> = /*@__PURE__*/
   enumDesc(file_mirai_v1_course, 4);
 
+/**
+ * CourseRole represents a collaborator's role on a course.
+ *
+ * @generated from enum mirai.v1.CourseRole
+ */
+export enum CourseRole {
+  /**
+   * @generated from enum value: COURSE_ROLE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Can edit and manage collaborators
+   *
+   * @generated from enum value: COURSE_ROLE_OWNER = 1;
+   */
+  OWNER = 1,
+
+  /**
+   * Can edit content and start generation
+   *
+   * @generated from enum value: COURSE_ROLE_EDITOR = 2;
+   */
+  EDITOR = 2,
+
+  /**
+   * Read-only access
+   *
+   * @generated from enum value: COURSE_ROLE_VIEWER = 3;
+   */
+  VIEWER = 3,
+}
+
+/**
+ * Describes the enum mirai.v1.CourseRole.
+ */
+export const CourseRoleSchema: GenEnum<CourseRole> = /*@__PURE__*/
+  enumDesc(file_mirai_v1_course, 5);
+
+/**
+ * CourseSearchSource is the part of a course a search match was found in.
+ *
+ * @generated from enum mirai.v1.CourseSearchSource
+ */
+export enum CourseSearchSource {
+  /**
+   * @generated from enum value: COURSE_SEARCH_SOURCE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * A block of the course's authored content
+   *
+   * @generated from enum value: COURSE_SEARCH_SOURCE_AUTHORING = 1;
+   */
+  AUTHORING = 1,
+
+  /**
+   * A component of a generated lesson
+   *
+   * @generated from enum value: COURSE_SEARCH_SOURCE_LESSON_COMPONENT = 2;
+   */
+  LESSON_COMPONENT = 2,
+}
+
+/**
+ * Describes the enum mirai.v1.CourseSearchSource.
+ */
+export const CourseSearchSourceSchema: GenEnum<CourseSearchSource> = /*@__PURE__*/
+  enumDesc(file_mirai_v1_course, 6);
+
+/**
+ * CourseAttachmentStatus is how far a reference file's text extraction has got.
+ *
+ * @generated from enum mirai.v1.CourseAttachmentStatus
+ */
+export enum CourseAttachmentStatus {
+  /**
+   * @generated from enum value: COURSE_ATTACHMENT_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Uploaded, waiting for extraction
+   *
+   * @generated from enum value: COURSE_ATTACHMENT_STATUS_PENDING = 1;
+   */
+  PENDING = 1,
+
+  /**
+   * Text being extracted
+   *
+   * @generated from enum value: COURSE_ATTACHMENT_STATUS_PROCESSING = 2;
+   */
+  PROCESSING = 2,
+
+  /**
+   * Text available to generation
+   *
+   * @generated from enum value: COURSE_ATTACHMENT_STATUS_READY = 3;
+   */
+  READY = 3,
+
+  /**
+   * No usable text; see error_message
+   *
+   * @generated from enum value: COURSE_ATTACHMENT_STATUS_FAILED = 4;
+   */
+  FAILED = 4,
+}
+
+/**
+ * Describes the enum mirai.v1.CourseAttachmentStatus.
+ */
+export const CourseAttachmentStatusSchema: GenEnum<CourseAttachmentStatus> = /*@__PURE__*/
+  enumDesc(file_mirai_v1_course, 7);
+
+/**
+ * ExportPurgeReason records why an export's file was deleted.
+ *
+ * @generated from enum mirai.v1.ExportPurgeReason
+ */
+export enum ExportPurgeReason {
+  /**
+   * @generated from enum value: EXPORT_PURGE_REASON_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The organization's retention period ran out
+   *
+   * @generated from enum value: EXPORT_PURGE_REASON_EXPIRED = 1;
+   */
+  EXPIRED = 1,
+
+  /**
+   * A newer export of the same course replaced it
+   *
+   * @generated from enum value: EXPORT_PURGE_REASON_SUPERSEDED = 2;
+   */
+  SUPERSEDED = 2,
+}
+
+/**
+ * Describes the enum mirai.v1.ExportPurgeReason.
+ */
+export const ExportPurgeReasonSchema: GenEnum<ExportPurgeReason> = /*@__PURE__*/
+  enumDesc(file_mirai_v1_course, 8);
+
 /**
  * CourseService handles course and library operations.
  *
@@ -1521,7 +3040,8 @@ export const CourseService: GenService<{
     output: typeof GetFolderHierarchyResponseSchema;
   },
   /**
-   * GetLibrary returns the full library with courses and folders.
+   * GetLibrary returns the full library with courses and folders. Kept for compatibility;
+   * the library page should use ListCourseCards and GetCourseCardDetails instead.
    *
    * @generated from rpc mirai.v1.CourseService.GetLibrary
    */
@@ -1540,6 +3060,16 @@ export const CourseService: GenService<{
     input: typeof CreateFolderRequestSchema;
     output: typeof CreateFolderResponseSchema;
   },
+  /**
+   * UpdateFolder renames a folder or changes it between regular and team folders.
+   *
+   * @generated from rpc mirai.v1.CourseService.UpdateFolder
+   */
+  updateFolder: {
+    methodKind: "unary";
+    input: typeof UpdateFolderRequestSchema;
+    output: typeof UpdateFolderResponseSchema;
+  },
   /**
    * DeleteFolder deletes an empty folder from the library.
    *
@@ -1571,7 +3101,8 @@ export const CourseService: GenService<{
     output: typeof GetExportStatusResponseSchema;
   },
   /**
-   * DownloadExport returns a presigned URL for downloading an export.
+   * DownloadExport returns a presigned URL for downloading an export. It fails with
+   * NOT_FOUND, explaining why, once the export's file has been deleted.
    *
    * @generated from rpc mirai.v1.CourseService.DownloadExport
    */
@@ -1581,7 +3112,8 @@ export const CourseService: GenService<{
     output: typeof DownloadExportResponseSchema;
   },
   /**
-   * ListExports returns all exports for a course.
+   * ListExports returns all exports for a course, newest first, with when each file
+   * expires. Exports whose file was deleted are included with status PURGED.
    *
    * @generated from rpc mirai.v1.CourseService.ListExports
    */
@@ -1590,6 +3122,183 @@ export const CourseService: GenService<{
     input: typeof ListExportsRequestSchema;
     output: typeof ListExportsResponseSchema;
   },
+  /**
+   * ListCollaborators returns all collaborators assigned to a course.
+   *
+   * @generated from rpc mirai.v1.CourseService.ListCollaborators
+   */
+  listCollaborators: {
+    methodKind: "unary";
+    input: typeof ListCollaboratorsRequestSchema;
+    output: typeof ListCollaboratorsResponseSchema;
+  },
+  /**
+   * AddCollaborator assigns a user to a course (owners and admins only).
+   *
+   * @generated from rpc mirai.v1.CourseService.AddCollaborator
+   */
+  addCollaborator: {
+    methodKind: "unary";
+    input: typeof AddCollaboratorRequestSchema;
+    output: typeof AddCollaboratorResponseSchema;
+  },
+  /**
+   * RemoveCollaborator removes a user from a course (owners and admins only).
+   *
+   * @generated from rpc mirai.v1.CourseService.RemoveCollaborator
+   */
+  removeCollaborator: {
+    methodKind: "unary";
+    input: typeof RemoveCollaboratorRequestSchema;
+    output: typeof RemoveCollaboratorResponseSchema;
+  },
+  /**
+   * RemoveSampleContent deletes the onboarding sample folders, course and target audience (admins only).
+   *
+   * @generated from rpc mirai.v1.CourseService.RemoveSampleContent
+   */
+  removeSampleContent: {
+    methodKind: "unary";
+    input: typeof RemoveSampleContentRequestSchema;
+    output: typeof RemoveSampleContentResponseSchema;
+  },
+  /**
+   * ListLargestCourses returns the organization's largest courses by stored content size (admins only).
+   *
+   * @generated from rpc mirai.v1.CourseService.ListLargestCourses
+   */
+  listLargestCourses: {
+    methodKind: "unary";
+    input: typeof ListLargestCoursesRequestSchema;
+    output: typeof ListLargestCoursesResponseSchema;
+  },
+  /**
+   * PublishChanges makes a published course's edits the version learners see (editors only).
+   *
+   * @generated from rpc mirai.v1.CourseService.PublishChanges
+   */
+  publishChanges: {
+    methodKind: "unary";
+    input: typeof PublishChangesRequestSchema;
+    output: typeof PublishChangesResponseSchema;
+  },
+  /**
+   * DiscardDraft reverts a published course's edits to the version learners see (editors only).
+   *
+   * @generated from rpc mirai.v1.CourseService.DiscardDraft
+   */
+  discardDraft: {
+    methodKind: "unary";
+    input: typeof DiscardDraftRequestSchema;
+    output: typeof DiscardDraftResponseSchema;
+  },
+  /**
+   * SearchWithinCourse finds the blocks and lesson components of a course that contain a
+   * phrase (editors only).
+   *
+   * @generated from rpc mirai.v1.CourseService.SearchWithinCourse
+   */
+  searchWithinCourse: {
+    methodKind: "unary";
+    input: typeof SearchWithinCourseRequestSchema;
+    output: typeof SearchWithinCourseResponseSchema;
+  },
+  /**
+   * GetCourseAttachmentUploadURL returns a presigned URL for uploading a reference file
+   * to a course (editors only).
+   *
+   * @generated from rpc mirai.v1.CourseService.GetCourseAttachmentUploadURL
+   */
+  getCourseAttachmentUploadURL: {
+    methodKind: "unary";
+    input: typeof GetCourseAttachmentUploadURLRequestSchema;
+    output: typeof GetCourseAttachmentUploadURLResponseSchema;
+  },
+  /**
+   * ConfirmCourseAttachment records an uploaded reference file and queues extraction of
+   * its text (editors only).
+   *
+   * @generated from rpc mirai.v1.CourseService.ConfirmCourseAttachment
+   */
+  confirmCourseAttachment: {
+    methodKind: "unary";
+    input: typeof ConfirmCourseAttachmentRequestSchema;
+    output: typeof ConfirmCourseAttachmentResponseSchema;
+  },
+  /**
+   * ListCourseAttachments lists a course's reference files with their extraction status.
+   *
+   * @generated from rpc mirai.v1.CourseService.ListCourseAttachments
+   */
+  listCourseAttachments: {
+    methodKind: "unary";
+    input: typeof ListCourseAttachmentsRequestSchema;
+    output: typeof ListCourseAttachmentsResponseSchema;
+  },
+  /**
+   * SetCourseAttachmentExcluded includes a reference file in generation prompts or leaves
+   * it out (editors only).
+   *
+   * @generated from rpc mirai.v1.CourseService.SetCourseAttachmentExcluded
+   */
+  setCourseAttachmentExcluded: {
+    methodKind: "unary";
+    input: typeof SetCourseAttachmentExcludedRequestSchema;
+    output: typeof SetCourseAttachmentExcludedResponseSchema;
+  },
+  /**
+   * DeleteCourseAttachment removes a reference file and its extracted text (editors only).
+   *
+   * @generated from rpc mirai.v1.CourseService.DeleteCourseAttachment
+   */
+  deleteCourseAttachment: {
+    methodKind: "unary";
+    input: typeof DeleteCourseAttachmentRequestSchema;
+    output: typeof DeleteCourseAttachmentResponseSchema;
+  },
+  /**
+   * ListCourseCards returns a page of lightweight course cards, most recently modified
+   * first. This is the preferred way to load the library.
+   *
+   * @generated from rpc mirai.v1.CourseService.ListCourseCards
+   */
+  listCourseCards: {
+    methodKind: "unary";
+    input: typeof ListCourseCardsRequestSchema;
+    output: typeof ListCourseCardsResponseSchema;
+  },
+  /**
+   * GetCourseCardDetails returns tags, thumbnails, creators and durations for the course
+   * cards on screen (up to 50 at a time).
+   *
+   * @generated from rpc mirai.v1.CourseService.GetCourseCardDetails
+   */
+  getCourseCardDetails: {
+    methodKind: "unary";
+    input: typeof GetCourseCardDetailsRequestSchema;
+    output: typeof GetCourseCardDetailsResponseSchema;
+  },
+  /**
+   * HeartbeatCoursePresence marks the caller as having the course open and returns who else
+   * does. The editor calls it every 20 seconds; presence lapses a minute after the last call.
+   *
+   * @generated from rpc mirai.v1.CourseService.HeartbeatCoursePresence
+   */
+  heartbeatCoursePresence: {
+    methodKind: "unary";
+    input: typeof HeartbeatCoursePresenceRequestSchema;
+    output: typeof HeartbeatCoursePresenceResponseSchema;
+  },
+  /**
+   * GetCoursePresence returns who other than the caller has the course open.
+   *
+   * @generated from rpc mirai.v1.CourseService.GetCoursePresence
+   */
+  getCoursePresence: {
+    methodKind: "unary";
+    input: typeof GetCoursePresenceRequestSchema;
+    output: typeof GetCoursePresenceResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_mirai_v1_course, 0);
 
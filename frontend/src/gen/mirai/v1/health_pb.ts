@@ -4,13 +4,15 @@
 
 import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
 import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { MaintenanceStatus } from "./maintenance_pb";
+import { file_mirai_v1_maintenance } from "./maintenance_pb";
 import type { Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file mirai/v1/health.proto.
  */
 export const file_mirai_v1_health: GenFile = /*@__PURE__*/
-  fileDesc("ChVtaXJhaS92MS9oZWFsdGgucHJvdG8SCG1pcmFpLnYxIg4KDENoZWNrUmVxdWVzdCIfCg1DaGVja1Jlc3BvbnNlEg4KBnN0YXR1cxgBIAEoCTJJCg1IZWFsdGhTZXJ2aWNlEjgKBUNoZWNrEhYubWlyYWkudjEuQ2hlY2tSZXF1ZXN0GhcubWlyYWkudjEuQ2hlY2tSZXNwb25zZUKRAQoMY29tLm1pcmFpLnYxQgtIZWFsdGhQcm90b1ABWjNnaXRodWIuY29tL3NvZ29zL21pcmFpLWJhY2tlbmQvZ2VuL21pcmFpL3YxO21pcmFpdjGiAgNNWFiqAghNaXJhaS5WMcoCCE1pcmFpXFYx4gIUTWlyYWlcVjFcR1BCTWV0YWRhdGHqAglNaXJhaTo6VjFiBnByb3RvMw");
+  fileDesc("ChVtaXJhaS92MS9oZWFsdGgucHJvdG8SCG1pcmFpLnYxIg4KDENoZWNrUmVxdWVzdCJRCg1DaGVja1Jlc3BvbnNlEg4KBnN0YXR1cxgBIAEoCRIwCgttYWludGVuYW5jZRgCIAEoCzIbLm1pcmFpLnYxLk1haW50ZW5hbmNlU3RhdHVzMkkKDUhlYWx0aFNlcnZpY2USOAoFQ2hlY2sSFi5taXJhaS52MS5DaGVja1JlcXVlc3QaFy5taXJhaS52MS5DaGVja1Jlc3BvbnNlQpEBCgxjb20ubWlyYWkudjFCC0hlYWx0aFByb3RvUAFaM2dpdGh1Yi5jb20vc29nb3MvbWlyYWktYmFja2VuZC9nZW4vbWlyYWkvdjE7bWlyYWl2MaICA01YWKoCCE1pcmFpLlYxygIITWlyYWlcVjHiAhRNaXJhaVxWMVxHUEJNZXRhZGF0YeoCCU1pcmFpOjpWMWIGcHJvdG8z", [file_mirai_v1_maintenance]);
 
 /**
  * CheckRequest is empty.
@@ -37,6 +39,11 @@ export type CheckResponse = Message<"mirai.v1.CheckResponse"> & {
    * @generated from field: string status = 1;
    */
   status: string;
+
+  /**
+   * @generated from field: mirai.v1.MaintenanceStatus maintenance = 2;
+   */
+  maintenance?: MaintenanceStatus;
 };
 
 /**
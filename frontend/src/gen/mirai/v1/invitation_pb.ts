@@ -14,7 +14,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file mirai/v1/invitation.proto.
  */
 export const file_mirai_v1_invitation: GenFile = /*@__PURE__*/
-  fileDesc("ChltaXJhaS92MS9pbnZpdGF0aW9uLnByb3RvEghtaXJhaS52MSLrAgoKSW52aXRhdGlvbhIKCgJpZBgBIAEoCRISCgpjb21wYW55X2lkGAIgASgJEg0KBWVtYWlsGAMgASgJEhwKBHJvbGUYBCABKA4yDi5taXJhaS52MS5Sb2xlEioKBnN0YXR1cxgFIAEoDjIaLm1pcmFpLnYxLkludml0YXRpb25TdGF0dXMSGgoSaW52aXRlZF9ieV91c2VyX2lkGAYgASgJEiAKE2FjY2VwdGVkX2J5X3VzZXJfaWQYByABKAlIAIgBARIuCgpleHBpcmVzX2F0GAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgpjcmVhdGVkX2F0GAkgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAogASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEIWChRfYWNjZXB0ZWRfYnlfdXNlcl9pZCJpCghTZWF0SW5mbxITCgt0b3RhbF9zZWF0cxgBIAEoBRISCgp1c2VkX3NlYXRzGAIgASgFEhsKE3BlbmRpbmdfaW52aXRhdGlvbnMYAyABKAUSFwoPYXZhaWxhYmxlX3NlYXRzGAQgASgFIkYKF0NyZWF0ZUludml0YXRpb25SZXF1ZXN0Eg0KBWVtYWlsGAEgASgJEhwKBHJvbGUYAiABKA4yDi5taXJhaS52MS5Sb2xlIkQKGENyZWF0ZUludml0YXRpb25SZXNwb25zZRIoCgppbnZpdGF0aW9uGAEgASgLMhQubWlyYWkudjEuSW52aXRhdGlvbiJLChZMaXN0SW52aXRhdGlvbnNSZXF1ZXN0EjEKDXN0YXR1c19maWx0ZXIYASADKA4yGi5taXJhaS52MS5JbnZpdGF0aW9uU3RhdHVzIkQKF0xpc3RJbnZpdGF0aW9uc1Jlc3BvbnNlEikKC2ludml0YXRpb25zGAEgAygLMhQubWlyYWkudjEuSW52aXRhdGlvbiItChRHZXRJbnZpdGF0aW9uUmVxdWVzdBIVCg1pbnZpdGF0aW9uX2lkGAEgASgJIkEKFUdldEludml0YXRpb25SZXNwb25zZRIoCgppbnZpdGF0aW9uGAEgASgLMhQubWlyYWkudjEuSW52aXRhdGlvbiIsChtHZXRJbnZpdGF0aW9uQnlUb2tlblJlcXVlc3QSDQoFdG9rZW4YASABKAkibAocR2V0SW52aXRhdGlvbkJ5VG9rZW5SZXNwb25zZRIoCgppbnZpdGF0aW9uGAEgASgLMhQubWlyYWkudjEuSW52aXRhdGlvbhIiCgdjb21wYW55GAIgASgLMhEubWlyYWkudjEuQ29tcGFueSIwChdSZXZva2VJbnZpdGF0aW9uUmVxdWVzdBIVCg1pbnZpdGF0aW9uX2lkGAEgASgJIkQKGFJldm9rZUludml0YXRpb25SZXNwb25zZRIoCgppbnZpdGF0aW9uGAEgASgLMhQubWlyYWkudjEuSW52aXRhdGlvbiIoChdBY2NlcHRJbnZpdGF0aW9uUmVxdWVzdBINCgV0b2tlbhgBIAEoCSKGAQoYQWNjZXB0SW52aXRhdGlvblJlc3BvbnNlEigKCmludml0YXRpb24YASABKAsyFC5taXJhaS52MS5JbnZpdGF0aW9uEhwKBHVzZXIYAiABKAsyDi5taXJhaS52MS5Vc2VyEiIKB2NvbXBhbnkYAyABKAsyES5taXJhaS52MS5Db21wYW55IjAKF1Jlc2VuZEludml0YXRpb25SZXF1ZXN0EhUKDWludml0YXRpb25faWQYASABKAkiRAoYUmVzZW5kSW52aXRhdGlvblJlc3BvbnNlEigKCmludml0YXRpb24YASABKAsyFC5taXJhaS52MS5JbnZpdGF0aW9uIhQKEkdldFNlYXRJbmZvUmVxdWVzdCI8ChNHZXRTZWF0SW5mb1Jlc3BvbnNlEiUKCXNlYXRfaW5mbxgBIAEoCzISLm1pcmFpLnYxLlNlYXRJbmZvKrIBChBJbnZpdGF0aW9uU3RhdHVzEiEKHUlOVklUQVRJT05fU1RBVFVTX1VOU1BFQ0lGSUVEEAASHQoZSU5WSVRBVElPTl9TVEFUVVNfUEVORElORxABEh4KGklOVklUQVRJT05fU1RBVFVTX0FDQ0VQVEVEEAISHQoZSU5WSVRBVElPTl9TVEFUVVNfRVhQSVJFRBADEh0KGUlOVklUQVRJT05fU1RBVFVTX1JFVk9LRUQQBDLcBQoRSW52aXRhdGlvblNlcnZpY2USWQoQQ3JlYXRlSW52aXRhdGlvbhIhLm1pcmFpLnYxLkNyZWF0ZUludml0YXRpb25SZXF1ZXN0GiIubWlyYWkudjEuQ3JlYXRlSW52aXRhdGlvblJlc3BvbnNlElYKD0xpc3RJbnZpdGF0aW9ucxIgLm1pcmFpLnYxLkxpc3RJbnZpdGF0aW9uc1JlcXVlc3QaIS5taXJhaS52MS5MaXN0SW52aXRhdGlvbnNSZXNwb25zZRJQCg1HZXRJbnZpdGF0aW9uEh4ubWlyYWkudjEuR2V0SW52aXRhdGlvblJlcXVlc3QaHy5taXJhaS52MS5HZXRJbnZpdGF0aW9uUmVzcG9uc2USZQoUR2V0SW52aXRhdGlvbkJ5VG9rZW4SJS5taXJhaS52MS5HZXRJbnZpdGF0aW9uQnlUb2tlblJlcXVlc3QaJi5taXJhaS52MS5HZXRJbnZpdGF0aW9uQnlUb2tlblJlc3BvbnNlElkKEFJldm9rZUludml0YXRpb24SIS5taXJhaS52MS5SZXZva2VJbnZpdGF0aW9uUmVxdWVzdBoiLm1pcmFpLnYxLlJldm9rZUludml0YXRpb25SZXNwb25zZRJZChBBY2NlcHRJbnZpdGF0aW9uEiEubWlyYWkudjEuQWNjZXB0SW52aXRhdGlvblJlcXVlc3QaIi5taXJhaS52MS5BY2NlcHRJbnZpdGF0aW9uUmVzcG9uc2USWQoQUmVzZW5kSW52aXRhdGlvbhIhLm1pcmFpLnYxLlJlc2VuZEludml0YXRpb25SZXF1ZXN0GiIubWlyYWkudjEuUmVzZW5kSW52aXRhdGlvblJlc3BvbnNlEkoKC0dldFNlYXRJbmZvEhwubWlyYWkudjEuR2V0U2VhdEluZm9SZXF1ZXN0Gh0ubWlyYWkudjEuR2V0U2VhdEluZm9SZXNwb25zZUKVAQoMY29tLm1pcmFpLnYxQg9JbnZpdGF0aW9uUHJvdG9QAVozZ2l0aHViLmNvbS9zb2dvcy9taXJhaS1iYWNrZW5kL2dlbi9taXJhaS92MTttaXJhaXYxogIDTVhYqgIITWlyYWkuVjHKAghNaXJhaVxWMeICFE1pcmFpXFYxXEdQQk1ldGFkYXRh6gIJTWlyYWk6OlYxYgZwcm90bzM", [file_google_protobuf_timestamp, file_mirai_v1_common]);
+  fileDesc("ChltaXJhaS92MS9pbnZpdGF0aW9uLnByb3RvEghtaXJhaS52MSKNAwoKSW52aXRhdGlvbhIKCgJpZBgBIAEoCRISCgpjb21wYW55X2lkGAIgASgJEg0KBWVtYWlsGAMgASgJEhwKBHJvbGUYBCABKA4yDi5taXJhaS52MS5Sb2xlEioKBnN0YXR1cxgFIAEoDjIaLm1pcmFpLnYxLkludml0YXRpb25TdGF0dXMSGgoSaW52aXRlZF9ieV91c2VyX2lkGAYgASgJEiAKE2FjY2VwdGVkX2J5X3VzZXJfaWQYByABKAlIAIgBARIuCgpleHBpcmVzX2F0GAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgpjcmVhdGVkX2F0GAkgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAogASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIUCgd0ZWFtX2lkGAsgASgJSAGIAQFCFgoUX2FjY2VwdGVkX2J5X3VzZXJfaWRCCgoIX3RlYW1faWQiaQoIU2VhdEluZm8SEwoLdG90YWxfc2VhdHMYASABKAUSEgoKdXNlZF9zZWF0cxgCIAEoBRIbChNwZW5kaW5nX2ludml0YXRpb25zGAMgASgFEhcKD2F2YWlsYWJsZV9zZWF0cxgEIAEoBSJoChdDcmVhdGVJbnZpdGF0aW9uUmVxdWVzdBINCgVlbWFpbBgBIAEoCRIcCgRyb2xlGAIgASgOMg4ubWlyYWkudjEuUm9sZRIUCgd0ZWFtX2lkGAMgASgJSACIAQFCCgoIX3RlYW1faWQiRAoYQ3JlYXRlSW52aXRhdGlvblJlc3BvbnNlEigKCmludml0YXRpb24YASABKAsyFC5taXJhaS52MS5JbnZpdGF0aW9uIksKFkxpc3RJbnZpdGF0aW9uc1JlcXVlc3QSMQoNc3RhdHVzX2ZpbHRlchgBIAMoDjIaLm1pcmFpLnYxLkludml0YXRpb25TdGF0dXMiRAoXTGlzdEludml0YXRpb25zUmVzcG9uc2USKQoLaW52aXRhdGlvbnMYASADKAsyFC5taXJhaS52MS5JbnZpdGF0aW9uIi0KFEdldEludml0YXRpb25SZXF1ZXN0EhUKDWludml0YXRpb25faWQYASABKAkiQQoVR2V0SW52aXRhdGlvblJlc3BvbnNlEigKCmludml0YXRpb24YASABKAsyFC5taXJhaS52MS5JbnZpdGF0aW9uIiwKG0dldEludml0YXRpb25CeVRva2VuUmVxdWVzdBINCgV0b2tlbhgBIAEoCSJsChxHZXRJbnZpdGF0aW9uQnlUb2tlblJlc3BvbnNlEigKCmludml0YXRpb24YASABKAsyFC5taXJhaS52MS5JbnZpdGF0aW9uEiIKB2NvbXBhbnkYAiABKAsyES5taXJhaS52MS5Db21wYW55IjAKF1Jldm9rZUludml0YXRpb25SZXF1ZXN0EhUKDWludml0YXRpb25faWQYASABKAkiRAoYUmV2b2tlSW52aXRhdGlvblJlc3BvbnNlEigKCmludml0YXRpb24YASABKAsyFC5taXJhaS52MS5JbnZpdGF0aW9uIigKF0FjY2VwdEludml0YXRpb25SZXF1ZXN0Eg0KBXRva2VuGAEgASgJIoYBChhBY2NlcHRJbnZpdGF0aW9uUmVzcG9uc2USKAoKaW52aXRhdGlvbhgBIAEoCzIULm1pcmFpLnYxLkludml0YXRpb24SHAoEdXNlchgCIAEoCzIOLm1pcmFpLnYxLlVzZXISIgoHY29tcGFueRgDIAEoCzIRLm1pcmFpLnYxLkNvbXBhbnkiMAoXUmVzZW5kSW52aXRhdGlvblJlcXVlc3QSFQoNaW52aXRhdGlvbl9pZBgBIAEoCSJEChhSZXNlbmRJbnZpdGF0aW9uUmVzcG9uc2USKAoKaW52aXRhdGlvbhgBIAEoCzIULm1pcmFpLnYxLkludml0YXRpb24iFAoSR2V0U2VhdEluZm9SZXF1ZXN0IjwKE0dldFNlYXRJbmZvUmVzcG9uc2USJQoJc2VhdF9pbmZvGAEgASgLMhIubWlyYWkudjEuU2VhdEluZm8qsgEKEEludml0YXRpb25TdGF0dXMSIQodSU5WSVRBVElPTl9TVEFUVVNfVU5TUEVDSUZJRUQQABIdChlJTlZJVEFUSU9OX1NUQVRVU19QRU5ESU5HEAESHgoaSU5WSVRBVElPTl9TVEFUVVNfQUNDRVBURUQQAhIdChlJTlZJVEFUSU9OX1NUQVRVU19FWFBJUkVEEAMSHQoZSU5WSVRBVElPTl9TVEFUVVNfUkVWT0tFRBAEMtwFChFJbnZpdGF0aW9uU2VydmljZRJZChBDcmVhdGVJbnZpdGF0aW9uEiEubWlyYWkudjEuQ3JlYXRlSW52aXRhdGlvblJlcXVlc3QaIi5taXJhaS52MS5DcmVhdGVJbnZpdGF0aW9uUmVzcG9uc2USVgoPTGlzdEludml0YXRpb25zEiAubWlyYWkudjEuTGlzdEludml0YXRpb25zUmVxdWVzdBohLm1pcmFpLnYxLkxpc3RJbnZpdGF0aW9uc1Jlc3BvbnNlElAKDUdldEludml0YXRpb24SHi5taXJhaS52MS5HZXRJbnZpdGF0aW9uUmVxdWVzdBofLm1pcmFpLnYxLkdldEludml0YXRpb25SZXNwb25zZRJlChRHZXRJbnZpdGF0aW9uQnlUb2tlbhIlLm1pcmFpLnYxLkdldEludml0YXRpb25CeVRva2VuUmVxdWVzdBomLm1pcmFpLnYxLkdldEludml0YXRpb25CeVRva2VuUmVzcG9uc2USWQoQUmV2b2tlSW52aXRhdGlvbhIhLm1pcmFpLnYxLlJldm9rZUludml0YXRpb25SZXF1ZXN0GiIubWlyYWkudjEuUmV2b2tlSW52aXRhdGlvblJlc3BvbnNlElkKEEFjY2VwdEludml0YXRpb24SIS5taXJhaS52MS5BY2NlcHRJbnZpdGF0aW9uUmVxdWVzdBoiLm1pcmFpLnYxLkFjY2VwdEludml0YXRpb25SZXNwb25zZRJZChBSZXNlbmRJbnZpdGF0aW9uEiEubWlyYWkudjEuUmVzZW5kSW52aXRhdGlvblJlcXVlc3QaIi5taXJhaS52MS5SZXNlbmRJbnZpdGF0aW9uUmVzcG9uc2USSgoLR2V0U2VhdEluZm8SHC5taXJhaS52MS5HZXRTZWF0SW5mb1JlcXVlc3QaHS5taXJhaS52MS5HZXRTZWF0SW5mb1Jlc3BvbnNlQpUBCgxjb20ubWlyYWkudjFCD0ludml0YXRpb25Qcm90b1ABWjNnaXRodWIuY29tL3NvZ29zL21pcmFpLWJhY2tlbmQvZ2VuL21pcmFpL3YxO21pcmFpdjGiAgNNWFiqAghNaXJhaS5WMcoCCE1pcmFpXFYx4gIUTWlyYWlcVjFcR1BCTWV0YWRhdGHqAglNaXJhaTo6VjFiBnByb3RvMw", [file_google_protobuf_timestamp, file_mirai_v1_common]);
 
 /**
  * Invitation represents an invitation to join a company.
@@ -71,6 +71,13 @@ export type Invitation = Message<"mirai.v1.Invitation"> & {
    * @generated from field: google.protobuf.Timestamp updated_at = 10;
    */
   updatedAt?: Timestamp;
+
+  /**
+   * Team the invitee joins on acceptance
+   *
+   * @generated from field: optional string team_id = 11;
+   */
+  teamId?: string;
 };
 
 /**
@@ -129,6 +136,13 @@ export type CreateInvitationRequest = Message<"mirai.v1.CreateInvitationRequest"
    * @generated from field: mirai.v1.Role role = 2;
    */
   role: Role;
+
+  /**
+   * Add the invitee to this team when they accept
+   *
+   * @generated from field: optional string team_id = 3;
+   */
+  teamId?: string;
 };
 
 /**
@@ -0,0 +1,70 @@
+// @generated by protoc-gen-connect-query v2.2.0 with parameter "target=ts"
+// @generated from file mirai/v1/lms_sync.proto (package mirai.v1, syntax proto3)
+/* eslint-disable */
+
+import { LMSSyncService } from "./lms_sync_pb";
+
+/**
+ * CreateConnector registers a connector. Admin only.
+ *
+ * @generated from rpc mirai.v1.LMSSyncService.CreateConnector
+ */
+export const createConnector = LMSSyncService.method.createConnector;
+
+/**
+ * ListConnectors returns the organization's connectors. Admin only.
+ *
+ * @generated from rpc mirai.v1.LMSSyncService.ListConnectors
+ */
+export const listConnectors = LMSSyncService.method.listConnectors;
+
+/**
+ * UpdateConnector changes a connector. Admin only.
+ *
+ * @generated from rpc mirai.v1.LMSSyncService.UpdateConnector
+ */
+export const updateConnector = LMSSyncService.method.updateConnector;
+
+/**
+ * DeleteConnector removes a connector; folders using it stop syncing. Admin only.
+ *
+ * @generated from rpc mirai.v1.LMSSyncService.DeleteConnector
+ */
+export const deleteConnector = LMSSyncService.method.deleteConnector;
+
+/**
+ * GetFolderSync returns the connector a folder's published courses sync to. Admin only.
+ *
+ * @generated from rpc mirai.v1.LMSSyncService.GetFolderSync
+ */
+export const getFolderSync = LMSSyncService.method.getFolderSync;
+
+/**
+ * SetFolderSync makes courses published in a folder sync to a connector. Admin only.
+ *
+ * @generated from rpc mirai.v1.LMSSyncService.SetFolderSync
+ */
+export const setFolderSync = LMSSyncService.method.setFolderSync;
+
+/**
+ * GetSyncStatus returns the latest delivery of a course to each connector.
+ *
+ * @generated from rpc mirai.v1.LMSSyncService.GetSyncStatus
+ */
+export const getSyncStatus = LMSSyncService.method.getSyncStatus;
+
+/**
+ * TestWebhook sends a synthetic signed event to a webhook connector and returns how the
+ * receiver answered. Recorded as a test delivery. Admin only; rate limited.
+ *
+ * @generated from rpc mirai.v1.LMSSyncService.TestWebhook
+ */
+export const testWebhook = LMSSyncService.method.testWebhook;
+
+/**
+ * ReplayWebhookDelivery re-sends an earlier delivery with a new timestamp and signature.
+ * Recorded as a replay delivery. Admin only; rate limited.
+ *
+ * @generated from rpc mirai.v1.LMSSyncService.ReplayWebhookDelivery
+ */
+export const replayWebhookDelivery = LMSSyncService.method.replayWebhookDelivery;
@@ -0,0 +1,806 @@
+// @generated by protoc-gen-es v2.10.1 with parameter "target=ts"
+// @generated from file mirai/v1/lms_sync.proto (package mirai.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file mirai/v1/lms_sync.proto.
+ */
+export const file_mirai_v1_lms_sync: GenFile = /*@__PURE__*/
+  fileDesc("ChdtaXJhaS92MS9sbXNfc3luYy5wcm90bxIIbWlyYWkudjEi8gEKDExNU0Nvbm5lY3RvchIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEigKBHR5cGUYAyABKA4yGi5taXJhaS52MS5MTVNDb25uZWN0b3JUeXBlEhQKDGVuZHBvaW50X3VybBgEIAEoCRIXCg9oYXNfY3JlZGVudGlhbHMYBSABKAgSDwoHZW5hYmxlZBgGIAEoCBIuCgpjcmVhdGVkX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCK0BAoSQ291cnNlU3luY0RlbGl2ZXJ5EgoKAmlkGAEgASgJEhQKDGNvbm5lY3Rvcl9pZBgCIAEoCRIWCg5jb25uZWN0b3JfbmFtZRgDIAEoCRIqCgZzdGF0dXMYBCABKA4yGi5taXJhaS52MS5Db3Vyc2VTeW5jU3RhdHVzEhAKCGF0dGVtcHRzGAUgASgFEjgKD2xhc3RfYXR0ZW1wdF9hdBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIAIgBARI4Cg9uZXh0X2F0dGVtcHRfYXQYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSAGIAQESFwoKbGFzdF9lcnJvchgIIAEoCUgCiAEBEhwKD3Jlc3BvbnNlX3N0YXR1cxgJIAEoBUgDiAEBEhYKCXJlbW90ZV9pZBgKIAEoCUgEiAEBEi4KCmNyZWF0ZWRfYXQYCyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KBGtpbmQYDCABKA4yIC5taXJhaS52MS5Db3Vyc2VTeW5jRGVsaXZlcnlLaW5kEhkKDHJlcGxheV9vZl9pZBgNIAEoCUgFiAEBQhIKEF9sYXN0X2F0dGVtcHRfYXRCEgoQX25leHRfYXR0ZW1wdF9hdEINCgtfbGFzdF9lcnJvckISChBfcmVzcG9uc2Vfc3RhdHVzQgwKCl9yZW1vdGVfaWRCDwoNX3JlcGxheV9vZl9pZCKfAQoWQ3JlYXRlQ29ubmVjdG9yUmVxdWVzdBIMCgRuYW1lGAEgASgJEigKBHR5cGUYAiABKA4yGi5taXJhaS52MS5MTVNDb25uZWN0b3JUeXBlEhQKDGVuZHBvaW50X3VybBgDIAEoCRIXCgpjcmVkZW50aWFsGAQgASgJSACIAQESDwoHZW5hYmxlZBgFIAEoCEINCgtfY3JlZGVudGlhbCJEChdDcmVhdGVDb25uZWN0b3JSZXNwb25zZRIpCgljb25uZWN0b3IYASABKAsyFi5taXJhaS52MS5MTVNDb25uZWN0b3IiFwoVTGlzdENvbm5lY3RvcnNSZXF1ZXN0IkQKFkxpc3RDb25uZWN0b3JzUmVzcG9uc2USKgoKY29ubmVjdG9ycxgBIAMoCzIWLm1pcmFpLnYxLkxNU0Nvbm5lY3RvciK1AQoWVXBkYXRlQ29ubmVjdG9yUmVxdWVzdBIUCgxjb25uZWN0b3JfaWQYASABKAkSDAoEbmFtZRgCIAEoCRIoCgR0eXBlGAMgASgOMhoubWlyYWkudjEuTE1TQ29ubmVjdG9yVHlwZRIUCgxlbmRwb2ludF91cmwYBCABKAkSFwoKY3JlZGVudGlhbBgFIAEoCUgAiAEBEg8KB2VuYWJsZWQYBiABKAhCDQoLX2NyZWRlbnRpYWwiRAoXVXBkYXRlQ29ubmVjdG9yUmVzcG9uc2USKQoJY29ubmVjdG9yGAEgASgLMhYubWlyYWkudjEuTE1TQ29ubmVjdG9yIi4KFkRlbGV0ZUNvbm5lY3RvclJlcXVlc3QSFAoMY29ubmVjdG9yX2lkGAEgASgJIhkKF0RlbGV0ZUNvbm5lY3RvclJlc3BvbnNlIikKFEdldEZvbGRlclN5bmNSZXF1ZXN0EhEKCWZvbGRlcl9pZBgBIAEoCSJDChVHZXRGb2xkZXJTeW5jUmVzcG9uc2USGQoMY29ubmVjdG9yX2lkGAEgASgJSACIAQFCDwoNX2Nvbm5lY3Rvcl9pZCJVChRTZXRGb2xkZXJTeW5jUmVxdWVzdBIRCglmb2xkZXJfaWQYASABKAkSGQoMY29ubmVjdG9yX2lkGAIgASgJSACIAQFCDwoNX2Nvbm5lY3Rvcl9pZCJDChVTZXRGb2xkZXJTeW5jUmVzcG9uc2USGQoMY29ubmVjdG9yX2lkGAEgASgJSACIAQFCDwoNX2Nvbm5lY3Rvcl9pZCIpChRHZXRTeW5jU3RhdHVzUmVxdWVzdBIRCgljb3Vyc2VfaWQYASABKAkiSQoVR2V0U3luY1N0YXR1c1Jlc3BvbnNlEjAKCmRlbGl2ZXJpZXMYASADKAsyHC5taXJhaS52MS5Db3Vyc2VTeW5jRGVsaXZlcnkiOQoSVGVzdFdlYmhvb2tSZXF1ZXN0EhQKDGNvbm5lY3Rvcl9pZBgBIAEoCRINCgVldmVudBgCIAEoCSJAChNUZXN0V2ViaG9va1Jlc3BvbnNlEikKB2F0dGVtcHQYASABKAsyGC5taXJhaS52MS5XZWJob29rQXR0ZW1wdCIzChxSZXBsYXlXZWJob29rRGVsaXZlcnlSZXF1ZXN0EhMKC2RlbGl2ZXJ5X2lkGAEgASgJIkoKHVJlcGxheVdlYmhvb2tEZWxpdmVyeVJlc3BvbnNlEikKB2F0dGVtcHQYASABKAsyGC5taXJhaS52MS5XZWJob29rQXR0ZW1wdCJrCg5XZWJob29rQXR0ZW1wdBIuCghkZWxpdmVyeRgBIAEoCzIcLm1pcmFpLnYxLkNvdXJzZVN5bmNEZWxpdmVyeRISCgpsYXRlbmN5X21zGAIgASgDEhUKDXJlc3BvbnNlX2JvZHkYAyABKAkqeAoQTE1TQ29ubmVjdG9yVHlwZRIiCh5MTVNfQ09OTkVDVE9SX1RZUEVfVU5TUEVDSUZJRUQQABIeChpMTVNfQ09OTkVDVE9SX1RZUEVfV0VCSE9PSxABEiAKHExNU19DT05ORUNUT1JfVFlQRV9IVFRQX1BVU0gQAiq6AQoQQ291cnNlU3luY1N0YXR1cxIiCh5DT1VSU0VfU1lOQ19TVEFUVVNfVU5TUEVDSUZJRUQQABIeChpDT1VSU0VfU1lOQ19TVEFUVVNfUEVORElORxABEiEKHUNPVVJTRV9TWU5DX1NUQVRVU19ERUxJVkVSSU5HEAISIAocQ09VUlNFX1NZTkNfU1RBVFVTX0RFTElWRVJFRBADEh0KGUNPVVJTRV9TWU5DX1NUQVRVU19GQUlMRUQQBCqxAQoWQ291cnNlU3luY0RlbGl2ZXJ5S2luZBIpCiVDT1VSU0VfU1lOQ19ERUxJVkVSWV9LSU5EX1VOU1BFQ0lGSUVEEAASIgoeQ09VUlNFX1NZTkNfREVMSVZFUllfS0lORF9TWU5DEAESIgoeQ09VUlNFX1NZTkNfREVMSVZFUllfS0lORF9URVNUEAISJAogQ09VUlNFX1NZTkNfREVMSVZFUllfS0lORF9SRVBMQVkQAzKZBgoOTE1TU3luY1NlcnZpY2USVgoPQ3JlYXRlQ29ubmVjdG9yEiAubWlyYWkudjEuQ3JlYXRlQ29ubmVjdG9yUmVxdWVzdBohLm1pcmFpLnYxLkNyZWF0ZUNvbm5lY3RvclJlc3BvbnNlElMKDkxpc3RDb25uZWN0b3JzEh8ubWlyYWkudjEuTGlzdENvbm5lY3RvcnNSZXF1ZXN0GiAubWlyYWkudjEuTGlzdENvbm5lY3RvcnNSZXNwb25zZRJWCg9VcGRhdGVDb25uZWN0b3ISIC5taXJhaS52MS5VcGRhdGVDb25uZWN0b3JSZXF1ZXN0GiEubWlyYWkudjEuVXBkYXRlQ29ubmVjdG9yUmVzcG9uc2USVgoPRGVsZXRlQ29ubmVjdG9yEiAubWlyYWkudjEuRGVsZXRlQ29ubmVjdG9yUmVxdWVzdBohLm1pcmFpLnYxLkRlbGV0ZUNvbm5lY3RvclJlc3BvbnNlElAKDUdldEZvbGRlclN5bmMSHi5taXJhaS52MS5HZXRGb2xkZXJTeW5jUmVxdWVzdBofLm1pcmFpLnYxLkdldEZvbGRlclN5bmNSZXNwb25zZRJQCg1TZXRGb2xkZXJTeW5jEh4ubWlyYWkudjEuU2V0Rm9sZGVyU3luY1JlcXVlc3QaHy5taXJhaS52MS5TZXRGb2xkZXJTeW5jUmVzcG9uc2USUAoNR2V0U3luY1N0YXR1cxIeLm1pcmFpLnYxLkdldFN5bmNTdGF0dXNSZXF1ZXN0Gh8ubWlyYWkudjEuR2V0U3luY1N0YXR1c1Jlc3BvbnNlEkoKC1Rlc3RXZWJob29rEhwubWlyYWkudjEuVGVzdFdlYmhvb2tSZXF1ZXN0Gh0ubWlyYWkudjEuVGVzdFdlYmhvb2tSZXNwb25zZRJoChVSZXBsYXlXZWJob29rRGVsaXZlcnkSJi5taXJhaS52MS5SZXBsYXlXZWJob29rRGVsaXZlcnlSZXF1ZXN0GicubWlyYWkudjEuUmVwbGF5V2ViaG9va0RlbGl2ZXJ5UmVzcG9uc2VCkgEKDGNvbS5taXJhaS52MUIMTG1zU3luY1Byb3RvUAFaM2dpdGh1Yi5jb20vc29nb3MvbWlyYWktYmFja2VuZC9nZW4vbWlyYWkvdjE7bWlyYWl2MaICA01YWKoCCE1pcmFpLlYxygIITWlyYWlcVjHiAhRNaXJhaVxWMVxHUEJNZXRhZGF0YeoCCU1pcmFpOjpWMWIGcHJvdG8z", [file_google_protobuf_timestamp]);
+
+/**
+ * LMSConnector is an external LMS endpoint published courses are synced to.
+ * Credentials are write-only; has_credentials reports whether one is stored.
+ *
+ * @generated from message mirai.v1.LMSConnector
+ */
+export type LMSConnector = Message<"mirai.v1.LMSConnector"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: mirai.v1.LMSConnectorType type = 3;
+   */
+  type: LMSConnectorType;
+
+  /**
+   * @generated from field: string endpoint_url = 4;
+   */
+  endpointUrl: string;
+
+  /**
+   * @generated from field: bool has_credentials = 5;
+   */
+  hasCredentials: boolean;
+
+  /**
+   * @generated from field: bool enabled = 6;
+   */
+  enabled: boolean;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 7;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 8;
+   */
+  updatedAt?: Timestamp;
+};
+
+/**
+ * Describes the message mirai.v1.LMSConnector.
+ * Use `create(LMSConnectorSchema)` to create a new message.
+ */
+export const LMSConnectorSchema: GenMessage<LMSConnector> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_lms_sync, 0);
+
+/**
+ * CourseSyncDelivery is a delivery to one connector: a course sync, or a test or replay
+ * an admin sent.
+ *
+ * @generated from message mirai.v1.CourseSyncDelivery
+ */
+export type CourseSyncDelivery = Message<"mirai.v1.CourseSyncDelivery"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string connector_id = 2;
+   */
+  connectorId: string;
+
+  /**
+   * Empty if the connector was removed
+   *
+   * @generated from field: string connector_name = 3;
+   */
+  connectorName: string;
+
+  /**
+   * @generated from field: mirai.v1.CourseSyncStatus status = 4;
+   */
+  status: CourseSyncStatus;
+
+  /**
+   * @generated from field: int32 attempts = 5;
+   */
+  attempts: number;
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp last_attempt_at = 6;
+   */
+  lastAttemptAt?: Timestamp;
+
+  /**
+   * Set while a retry is pending
+   *
+   * @generated from field: optional google.protobuf.Timestamp next_attempt_at = 7;
+   */
+  nextAttemptAt?: Timestamp;
+
+  /**
+   * @generated from field: optional string last_error = 8;
+   */
+  lastError?: string;
+
+  /**
+   * HTTP status the LMS answered with
+   *
+   * @generated from field: optional int32 response_status = 9;
+   */
+  responseStatus?: number;
+
+  /**
+   * Identifier the LMS assigned to the course
+   *
+   * @generated from field: optional string remote_id = 10;
+   */
+  remoteId?: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 11;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: mirai.v1.CourseSyncDeliveryKind kind = 12;
+   */
+  kind: CourseSyncDeliveryKind;
+
+  /**
+   * Delivery a replay re-sent
+   *
+   * @generated from field: optional string replay_of_id = 13;
+   */
+  replayOfId?: string;
+};
+
+/**
+ * Describes the message mirai.v1.CourseSyncDelivery.
+ * Use `create(CourseSyncDeliverySchema)` to create a new message.
+ */
+export const CourseSyncDeliverySchema: GenMessage<CourseSyncDelivery> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_lms_sync, 1);
+
+/**
+ * CreateConnectorRequest describes a new connector.
+ *
+ * @generated from message mirai.v1.CreateConnectorRequest
+ */
+export type CreateConnectorRequest = Message<"mirai.v1.CreateConnectorRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: mirai.v1.LMSConnectorType type = 2;
+   */
+  type: LMSConnectorType;
+
+  /**
+   * @generated from field: string endpoint_url = 3;
+   */
+  endpointUrl: string;
+
+  /**
+   * Webhook signing secret or bearer token
+   *
+   * @generated from field: optional string credential = 4;
+   */
+  credential?: string;
+
+  /**
+   * @generated from field: bool enabled = 5;
+   */
+  enabled: boolean;
+};
+
+/**
+ * Describes the message mirai.v1.CreateConnectorRequest.
+ * Use `create(CreateConnectorRequestSchema)` to create a new message.
+ */
+export const CreateConnectorRequestSchema: GenMessage<CreateConnectorRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_lms_sync, 2);
+
+/**
+ * CreateConnectorResponse contains the created connector.
+ *
+ * @generated from message mirai.v1.CreateConnectorResponse
+ */
+export type CreateConnectorResponse = Message<"mirai.v1.CreateConnectorResponse"> & {
+  /**
+   * @generated from field: mirai.v1.LMSConnector connector = 1;
+   */
+  connector?: LMSConnector;
+};
+
+/**
+ * Describes the message mirai.v1.CreateConnectorResponse.
+ * Use `create(CreateConnectorResponseSchema)` to create a new message.
+ */
+export const CreateConnectorResponseSchema: GenMessage<CreateConnectorResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_lms_sync, 3);
+
+/**
+ * ListConnectorsRequest is empty.
+ *
+ * @generated from message mirai.v1.ListConnectorsRequest
+ */
+export type ListConnectorsRequest = Message<"mirai.v1.ListConnectorsRequest"> & {
+};
+
+/**
+ * Describes the message mirai.v1.ListConnectorsRequest.
+ * Use `create(ListConnectorsRequestSchema)` to create a new message.
+ */
+export const ListConnectorsRequestSchema: GenMessage<ListConnectorsRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_lms_sync, 4);
+
+/**
+ * ListConnectorsResponse contains the organization's connectors.
+ *
+ * @generated from message mirai.v1.ListConnectorsResponse
+ */
+export type ListConnectorsResponse = Message<"mirai.v1.ListConnectorsResponse"> & {
+  /**
+   * @generated from field: repeated mirai.v1.LMSConnector connectors = 1;
+   */
+  connectors: LMSConnector[];
+};
+
+/**
+ * Describes the message mirai.v1.ListConnectorsResponse.
+ * Use `create(ListConnectorsResponseSchema)` to create a new message.
+ */
+export const ListConnectorsResponseSchema: GenMessage<ListConnectorsResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_lms_sync, 5);
+
+/**
+ * UpdateConnectorRequest replaces a connector's settings.
+ *
+ * @generated from message mirai.v1.UpdateConnectorRequest
+ */
+export type UpdateConnectorRequest = Message<"mirai.v1.UpdateConnectorRequest"> & {
+  /**
+   * @generated from field: string connector_id = 1;
+   */
+  connectorId: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: mirai.v1.LMSConnectorType type = 3;
+   */
+  type: LMSConnectorType;
+
+  /**
+   * @generated from field: string endpoint_url = 4;
+   */
+  endpointUrl: string;
+
+  /**
+   * Unset keeps the stored credential; empty removes it
+   *
+   * @generated from field: optional string credential = 5;
+   */
+  credential?: string;
+
+  /**
+   * @generated from field: bool enabled = 6;
+   */
+  enabled: boolean;
+};
+
+/**
+ * Describes the message mirai.v1.UpdateConnectorRequest.
+ * Use `create(UpdateConnectorRequestSchema)` to create a new message.
+ */
+export const UpdateConnectorRequestSchema: GenMessage<UpdateConnectorRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_lms_sync, 6);
+
+/**
+ * UpdateConnectorResponse contains the updated connector.
+ *
+ * @generated from message mirai.v1.UpdateConnectorResponse
+ */
+export type UpdateConnectorResponse = Message<"mirai.v1.UpdateConnectorResponse"> & {
+  /**
+   * @generated from field: mirai.v1.LMSConnector connector = 1;
+   */
+  connector?: LMSConnector;
+};
+
+/**
+ * Describes the message mirai.v1.UpdateConnectorResponse.
+ * Use `create(UpdateConnectorResponseSchema)` to create a new message.
+ */
+export const UpdateConnectorResponseSchema: GenMessage<UpdateConnectorResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_lms_sync, 7);
+
+/**
+ * DeleteConnectorRequest identifies the connector to remove.
+ *
+ * @generated from message mirai.v1.DeleteConnectorRequest
+ */
+export type DeleteConnectorRequest = Message<"mirai.v1.DeleteConnectorRequest"> & {
+  /**
+   * @generated from field: string connector_id = 1;
+   */
+  connectorId: string;
+};
+
+/**
+ * Describes the message mirai.v1.DeleteConnectorRequest.
+ * Use `create(DeleteConnectorRequestSchema)` to create a new message.
+ */
+export const DeleteConnectorRequestSchema: GenMessage<DeleteConnectorRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_lms_sync, 8);
+
+/**
+ * DeleteConnectorResponse is empty.
+ *
+ * @generated from message mirai.v1.DeleteConnectorResponse
+ */
+export type DeleteConnectorResponse = Message<"mirai.v1.DeleteConnectorResponse"> & {
+};
+
+/**
+ * Describes the message mirai.v1.DeleteConnectorResponse.
+ * Use `create(DeleteConnectorResponseSchema)` to create a new message.
+ */
+export const DeleteConnectorResponseSchema: GenMessage<DeleteConnectorResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_lms_sync, 9);
+
+/**
+ * GetFolderSyncRequest identifies the folder.
+ *
+ * @generated from message mirai.v1.GetFolderSyncRequest
+ */
+export type GetFolderSyncRequest = Message<"mirai.v1.GetFolderSyncRequest"> & {
+  /**
+   * @generated from field: string folder_id = 1;
+   */
+  folderId: string;
+};
+
+/**
+ * Describes the message mirai.v1.GetFolderSyncRequest.
+ * Use `create(GetFolderSyncRequestSchema)` to create a new message.
+ */
+export const GetFolderSyncRequestSchema: GenMessage<GetFolderSyncRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_lms_sync, 10);
+
+/**
+ * GetFolderSyncResponse contains the folder's connector, if it syncs.
+ *
+ * @generated from message mirai.v1.GetFolderSyncResponse
+ */
+export type GetFolderSyncResponse = Message<"mirai.v1.GetFolderSyncResponse"> & {
+  /**
+   * @generated from field: optional string connector_id = 1;
+   */
+  connectorId?: string;
+};
+
+/**
+ * Describes the message mirai.v1.GetFolderSyncResponse.
+ * Use `create(GetFolderSyncResponseSchema)` to create a new message.
+ */
+export const GetFolderSyncResponseSchema: GenMessage<GetFolderSyncResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_lms_sync, 11);
+
+/**
+ * SetFolderSyncRequest sets or clears a folder's connector.
+ *
+ * @generated from message mirai.v1.SetFolderSyncRequest
+ */
+export type SetFolderSyncRequest = Message<"mirai.v1.SetFolderSyncRequest"> & {
+  /**
+   * @generated from field: string folder_id = 1;
+   */
+  folderId: string;
+
+  /**
+   * Unset stops the folder from syncing
+   *
+   * @generated from field: optional string connector_id = 2;
+   */
+  connectorId?: string;
+};
+
+/**
+ * Describes the message mirai.v1.SetFolderSyncRequest.
+ * Use `create(SetFolderSyncRequestSchema)` to create a new message.
+ */
+export const SetFolderSyncRequestSchema: GenMessage<SetFolderSyncRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_lms_sync, 12);
+
+/**
+ * SetFolderSyncResponse contains the folder's connector after the change.
+ *
+ * @generated from message mirai.v1.SetFolderSyncResponse
+ */
+export type SetFolderSyncResponse = Message<"mirai.v1.SetFolderSyncResponse"> & {
+  /**
+   * @generated from field: optional string connector_id = 1;
+   */
+  connectorId?: string;
+};
+
+/**
+ * Describes the message mirai.v1.SetFolderSyncResponse.
+ * Use `create(SetFolderSyncResponseSchema)` to create a new message.
+ */
+export const SetFolderSyncResponseSchema: GenMessage<SetFolderSyncResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_lms_sync, 13);
+
+/**
+ * GetSyncStatusRequest identifies the course.
+ *
+ * @generated from message mirai.v1.GetSyncStatusRequest
+ */
+export type GetSyncStatusRequest = Message<"mirai.v1.GetSyncStatusRequest"> & {
+  /**
+   * @generated from field: string course_id = 1;
+   */
+  courseId: string;
+};
+
+/**
+ * Describes the message mirai.v1.GetSyncStatusRequest.
+ * Use `create(GetSyncStatusRequestSchema)` to create a new message.
+ */
+export const GetSyncStatusRequestSchema: GenMessage<GetSyncStatusRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_lms_sync, 14);
+
+/**
+ * GetSyncStatusResponse contains the course's latest delivery per connector.
+ *
+ * @generated from message mirai.v1.GetSyncStatusResponse
+ */
+export type GetSyncStatusResponse = Message<"mirai.v1.GetSyncStatusResponse"> & {
+  /**
+   * @generated from field: repeated mirai.v1.CourseSyncDelivery deliveries = 1;
+   */
+  deliveries: CourseSyncDelivery[];
+};
+
+/**
+ * Describes the message mirai.v1.GetSyncStatusResponse.
+ * Use `create(GetSyncStatusResponseSchema)` to create a new message.
+ */
+export const GetSyncStatusResponseSchema: GenMessage<GetSyncStatusResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_lms_sync, 15);
+
+/**
+ * TestWebhookRequest identifies the connector and the event to send.
+ *
+ * @generated from message mirai.v1.TestWebhookRequest
+ */
+export type TestWebhookRequest = Message<"mirai.v1.TestWebhookRequest"> & {
+  /**
+   * @generated from field: string connector_id = 1;
+   */
+  connectorId: string;
+
+  /**
+   * "course.published" (default) or "ping"
+   *
+   * @generated from field: string event = 2;
+   */
+  event: string;
+};
+
+/**
+ * Describes the message mirai.v1.TestWebhookRequest.
+ * Use `create(TestWebhookRequestSchema)` to create a new message.
+ */
+export const TestWebhookRequestSchema: GenMessage<TestWebhookRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_lms_sync, 16);
+
+/**
+ * TestWebhookResponse contains how the receiver answered.
+ *
+ * @generated from message mirai.v1.TestWebhookResponse
+ */
+export type TestWebhookResponse = Message<"mirai.v1.TestWebhookResponse"> & {
+  /**
+   * @generated from field: mirai.v1.WebhookAttempt attempt = 1;
+   */
+  attempt?: WebhookAttempt;
+};
+
+/**
+ * Describes the message mirai.v1.TestWebhookResponse.
+ * Use `create(TestWebhookResponseSchema)` to create a new message.
+ */
+export const TestWebhookResponseSchema: GenMessage<TestWebhookResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_lms_sync, 17);
+
+/**
+ * ReplayWebhookDeliveryRequest identifies the delivery to re-send.
+ *
+ * @generated from message mirai.v1.ReplayWebhookDeliveryRequest
+ */
+export type ReplayWebhookDeliveryRequest = Message<"mirai.v1.ReplayWebhookDeliveryRequest"> & {
+  /**
+   * @generated from field: string delivery_id = 1;
+   */
+  deliveryId: string;
+};
+
+/**
+ * Describes the message mirai.v1.ReplayWebhookDeliveryRequest.
+ * Use `create(ReplayWebhookDeliveryRequestSchema)` to create a new message.
+ */
+export const ReplayWebhookDeliveryRequestSchema: GenMessage<ReplayWebhookDeliveryRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_lms_sync, 18);
+
+/**
+ * ReplayWebhookDeliveryResponse contains how the receiver answered.
+ *
+ * @generated from message mirai.v1.ReplayWebhookDeliveryResponse
+ */
+export type ReplayWebhookDeliveryResponse = Message<"mirai.v1.ReplayWebhookDeliveryResponse"> & {
+  /**
+   * @generated from field: mirai.v1.WebhookAttempt attempt = 1;
+   */
+  attempt?: WebhookAttempt;
+};
+
+/**
+ * Describes the message mirai.v1.ReplayWebhookDeliveryResponse.
+ * Use `create(ReplayWebhookDeliveryResponseSchema)` to create a new message.
+ */
+export const ReplayWebhookDeliveryResponseSchema: GenMessage<ReplayWebhookDeliveryResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_lms_sync, 19);
+
+/**
+ * WebhookAttempt is the outcome of a test or replay delivery. The delivery's
+ * response_status and last_error say how the receiver answered.
+ *
+ * @generated from message mirai.v1.WebhookAttempt
+ */
+export type WebhookAttempt = Message<"mirai.v1.WebhookAttempt"> & {
+  /**
+   * @generated from field: mirai.v1.CourseSyncDelivery delivery = 1;
+   */
+  delivery?: CourseSyncDelivery;
+
+  /**
+   * 0 when the receiver was never reached
+   *
+   * @generated from field: int64 latency_ms = 2;
+   */
+  latencyMs: bigint;
+
+  /**
+   * Start of the receiver's response body
+   *
+   * @generated from field: string response_body = 3;
+   */
+  responseBody: string;
+};
+
+/**
+ * Describes the message mirai.v1.WebhookAttempt.
+ * Use `create(WebhookAttemptSchema)` to create a new message.
+ */
+export const WebhookAttemptSchema: GenMessage<WebhookAttempt> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_lms_sync, 20);
+
+/**
+ * LMSConnectorType is how courses are delivered to an external LMS.
+ *
+ * @generated from enum mirai.v1.LMSConnectorType
+ */
+export enum LMSConnectorType {
+  /**
+   * @generated from enum value: LMS_CONNECTOR_TYPE_UNSPECIFIED = 0;
+   */
+  LMS_CONNECTOR_TYPE_UNSPECIFIED = 0,
+
+  /**
+   * Signed JSON notification with a download link
+   *
+   * @generated from enum value: LMS_CONNECTOR_TYPE_WEBHOOK = 1;
+   */
+  LMS_CONNECTOR_TYPE_WEBHOOK = 1,
+
+  /**
+   * Upload of the export with a bearer token
+   *
+   * @generated from enum value: LMS_CONNECTOR_TYPE_HTTP_PUSH = 2;
+   */
+  LMS_CONNECTOR_TYPE_HTTP_PUSH = 2,
+}
+
+/**
+ * Describes the enum mirai.v1.LMSConnectorType.
+ */
+export const LMSConnectorTypeSchema: GenEnum<LMSConnectorType> = /*@__PURE__*/
+  enumDesc(file_mirai_v1_lms_sync, 0);
+
+/**
+ * CourseSyncStatus is the state of a course delivery to an LMS connector.
+ *
+ * @generated from enum mirai.v1.CourseSyncStatus
+ */
+export enum CourseSyncStatus {
+  /**
+   * @generated from enum value: COURSE_SYNC_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Waiting for its first attempt or a retry
+   *
+   * @generated from enum value: COURSE_SYNC_STATUS_PENDING = 1;
+   */
+  PENDING = 1,
+
+  /**
+   * @generated from enum value: COURSE_SYNC_STATUS_DELIVERING = 2;
+   */
+  DELIVERING = 2,
+
+  /**
+   * @generated from enum value: COURSE_SYNC_STATUS_DELIVERED = 3;
+   */
+  DELIVERED = 3,
+
+  /**
+   * Retries exhausted or rejected by the LMS
+   *
+   * @generated from enum value: COURSE_SYNC_STATUS_FAILED = 4;
+   */
+  FAILED = 4,
+}
+
+/**
+ * Describes the enum mirai.v1.CourseSyncStatus.
+ */
+export const CourseSyncStatusSchema: GenEnum<CourseSyncStatus> = /*@__PURE__*/
+  enumDesc(file_mirai_v1_lms_sync, 1);
+
+/**
+ * CourseSyncDeliveryKind tells course syncs apart from diagnostic webhook traffic.
+ *
+ * @generated from enum mirai.v1.CourseSyncDeliveryKind
+ */
+export enum CourseSyncDeliveryKind {
+  /**
+   * @generated from enum value: COURSE_SYNC_DELIVERY_KIND_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Delivery of a published course
+   *
+   * @generated from enum value: COURSE_SYNC_DELIVERY_KIND_SYNC = 1;
+   */
+  SYNC = 1,
+
+  /**
+   * Synthetic event an admin sent to test a receiver
+   *
+   * @generated from enum value: COURSE_SYNC_DELIVERY_KIND_TEST = 2;
+   */
+  TEST = 2,
+
+  /**
+   * Re-send of an earlier delivery
+   *
+   * @generated from enum value: COURSE_SYNC_DELIVERY_KIND_REPLAY = 3;
+   */
+  REPLAY = 3,
+}
+
+/**
+ * Describes the enum mirai.v1.CourseSyncDeliveryKind.
+ */
+export const CourseSyncDeliveryKindSchema: GenEnum<CourseSyncDeliveryKind> = /*@__PURE__*/
+  enumDesc(file_mirai_v1_lms_sync, 2);
+
+/**
+ * LMSSyncService manages LMS connectors and reports course sync status.
+ *
+ * @generated from service mirai.v1.LMSSyncService
+ */
+export const LMSSyncService: GenService<{
+  /**
+   * CreateConnector registers a connector. Admin only.
+   *
+   * @generated from rpc mirai.v1.LMSSyncService.CreateConnector
+   */
+  createConnector: {
+    methodKind: "unary";
+    input: typeof CreateConnectorRequestSchema;
+    output: typeof CreateConnectorResponseSchema;
+  },
+  /**
+   * ListConnectors returns the organization's connectors. Admin only.
+   *
+   * @generated from rpc mirai.v1.LMSSyncService.ListConnectors
+   */
+  listConnectors: {
+    methodKind: "unary";
+    input: typeof ListConnectorsRequestSchema;
+    output: typeof ListConnectorsResponseSchema;
+  },
+  /**
+   * UpdateConnector changes a connector. Admin only.
+   *
+   * @generated from rpc mirai.v1.LMSSyncService.UpdateConnector
+   */
+  updateConnector: {
+    methodKind: "unary";
+    input: typeof UpdateConnectorRequestSchema;
+    output: typeof UpdateConnectorResponseSchema;
+  },
+  /**
+   * DeleteConnector removes a connector; folders using it stop syncing. Admin only.
+   *
+   * @generated from rpc mirai.v1.LMSSyncService.DeleteConnector
+   */
+  deleteConnector: {
+    methodKind: "unary";
+    input: typeof DeleteConnectorRequestSchema;
+    output: typeof DeleteConnectorResponseSchema;
+  },
+  /**
+   * GetFolderSync returns the connector a folder's published courses sync to. Admin only.
+   *
+   * @generated from rpc mirai.v1.LMSSyncService.GetFolderSync
+   */
+  getFolderSync: {
+    methodKind: "unary";
+    input: typeof GetFolderSyncRequestSchema;
+    output: typeof GetFolderSyncResponseSchema;
+  },
+  /**
+   * SetFolderSync makes courses published in a folder sync to a connector. Admin only.
+   *
+   * @generated from rpc mirai.v1.LMSSyncService.SetFolderSync
+   */
+  setFolderSync: {
+    methodKind: "unary";
+    input: typeof SetFolderSyncRequestSchema;
+    output: typeof SetFolderSyncResponseSchema;
+  },
+  /**
+   * GetSyncStatus returns the latest delivery of a course to each connector.
+   *
+   * @generated from rpc mirai.v1.LMSSyncService.GetSyncStatus
+   */
+  getSyncStatus: {
+    methodKind: "unary";
+    input: typeof GetSyncStatusRequestSchema;
+    output: typeof GetSyncStatusResponseSchema;
+  },
+  /**
+   * TestWebhook sends a synthetic signed event to a webhook connector and returns how the
+   * receiver answered. Recorded as a test delivery. Admin only; rate limited.
+   *
+   * @generated from rpc mirai.v1.LMSSyncService.TestWebhook
+   */
+  testWebhook: {
+    methodKind: "unary";
+    input: typeof TestWebhookRequestSchema;
+    output: typeof TestWebhookResponseSchema;
+  },
+  /**
+   * ReplayWebhookDelivery re-sends an earlier delivery with a new timestamp and signature.
+   * Recorded as a replay delivery. Admin only; rate limited.
+   *
+   * @generated from rpc mirai.v1.LMSSyncService.ReplayWebhookDelivery
+   */
+  replayWebhookDelivery: {
+    methodKind: "unary";
+    input: typeof ReplayWebhookDeliveryRequestSchema;
+    output: typeof ReplayWebhookDeliveryResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_mirai_v1_lms_sync, 0);
+
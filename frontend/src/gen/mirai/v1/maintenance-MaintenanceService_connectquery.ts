@@ -0,0 +1,77 @@
+// @generated by protoc-gen-connect-query v2.2.0 with parameter "target=ts"
+// @generated from file mirai/v1/maintenance.proto (package mirai.v1, syntax proto3)
+/* eslint-disable */
+
+import { MaintenanceService } from "./maintenance_pb";
+
+/**
+ * GetMaintenanceMode returns the current maintenance flag.
+ *
+ * @generated from rpc mirai.v1.MaintenanceService.GetMaintenanceMode
+ */
+export const getMaintenanceMode = MaintenanceService.method.getMaintenanceMode;
+
+/**
+ * SetMaintenanceMode enables or disables maintenance mode.
+ *
+ * @generated from rpc mirai.v1.MaintenanceService.SetMaintenanceMode
+ */
+export const setMaintenanceMode = MaintenanceService.method.setMaintenanceMode;
+
+/**
+ * WarmTenantCache rebuilds a tenant's cached library listing, folder hierarchy with
+ * counts and most recently modified course metadata.
+ *
+ * @generated from rpc mirai.v1.MaintenanceService.WarmTenantCache
+ */
+export const warmTenantCache = MaintenanceService.method.warmTenantCache;
+
+/**
+ * InvalidateTenantCache drops a tenant's cached entries.
+ *
+ * @generated from rpc mirai.v1.MaintenanceService.InvalidateTenantCache
+ */
+export const invalidateTenantCache = MaintenanceService.method.invalidateTenantCache;
+
+/**
+ * GetSystemDiagnostics reports the state of the worker, queues, scheduled tasks and
+ * dependencies. Each check has a short timeout; failures are reported, not returned.
+ *
+ * @generated from rpc mirai.v1.MaintenanceService.GetSystemDiagnostics
+ */
+export const getSystemDiagnostics = MaintenanceService.method.getSystemDiagnostics;
+
+/**
+ * BackfillKnowledgeSummaries queues re-summarization of every SME, in all tenants, whose
+ * knowledge summary is over the length cap. The counts are emailed to the operators.
+ *
+ * @generated from rpc mirai.v1.MaintenanceService.BackfillKnowledgeSummaries
+ */
+export const backfillKnowledgeSummaries = MaintenanceService.method.backfillKnowledgeSummaries;
+
+/**
+ * RotateTenantStorageKey gives a tenant a new key for encrypting its course content and
+ * queues re-encryption of the content with it. Content written with older keys stays
+ * readable meanwhile. Requires STORAGE_ENCRYPTION_ENABLED.
+ *
+ * @generated from rpc mirai.v1.MaintenanceService.RotateTenantStorageKey
+ */
+export const rotateTenantStorageKey = MaintenanceService.method.rotateTenantStorageKey;
+
+/**
+ * EncryptTenantStorage queues re-encryption of a tenant's course content, or every
+ * tenant's, rewriting plaintext objects and objects encrypted with a retired key.
+ * Requires STORAGE_ENCRYPTION_ENABLED.
+ *
+ * @generated from rpc mirai.v1.MaintenanceService.EncryptTenantStorage
+ */
+export const encryptTenantStorage = MaintenanceService.method.encryptTenantStorage;
+
+/**
+ * MigrateTenantStorageRegion queues moving a tenant's stored objects to another storage
+ * region. The tenant switches over in one step once every object is copied, and its
+ * objects are then removed from the old region.
+ *
+ * @generated from rpc mirai.v1.MaintenanceService.MigrateTenantStorageRegion
+ */
+export const migrateTenantStorageRegion = MaintenanceService.method.migrateTenantStorageRegion;
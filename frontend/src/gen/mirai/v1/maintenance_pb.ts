@@ -0,0 +1,935 @@
+// @generated by protoc-gen-es v2.10.1 with parameter "target=ts"
+// @generated from file mirai/v1/maintenance.proto (package mirai.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file mirai/v1/maintenance.proto.
+ */
+export const file_mirai_v1_maintenance: GenFile = /*@__PURE__*/
+  fileDesc("ChptaXJhaS92MS9tYWludGVuYW5jZS5wcm90bxIIbWlyYWkudjEirgEKEU1haW50ZW5hbmNlU3RhdHVzEg8KB2VuYWJsZWQYASABKAgSDgoGcmVhc29uGAIgASgJEi4KCnN0YXJ0ZWRfYXQYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEisKB2VuZHNfYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhsKE3JldHJ5X2FmdGVyX3NlY29uZHMYBSABKAUiGwoZR2V0TWFpbnRlbmFuY2VNb2RlUmVxdWVzdCJJChpHZXRNYWludGVuYW5jZU1vZGVSZXNwb25zZRIrCgZzdGF0dXMYASABKAsyGy5taXJhaS52MS5NYWludGVuYW5jZVN0YXR1cyJWChlTZXRNYWludGVuYW5jZU1vZGVSZXF1ZXN0Eg8KB2VuYWJsZWQYASABKAgSDgoGcmVhc29uGAIgASgJEhgKEGR1cmF0aW9uX21pbnV0ZXMYAyABKAUiSQoaU2V0TWFpbnRlbmFuY2VNb2RlUmVzcG9uc2USKwoGc3RhdHVzGAEgASgLMhsubWlyYWkudjEuTWFpbnRlbmFuY2VTdGF0dXMiVwoWV2FybVRlbmFudENhY2hlUmVxdWVzdBIRCgl0ZW5hbnRfaWQYASABKAkSFgoOcmVjZW50X2NvdXJzZXMYAiABKAUSEgoKYmFja2dyb3VuZBgDIAEoCCJUChdXYXJtVGVuYW50Q2FjaGVSZXNwb25zZRIUCgxrZXlzX3dyaXR0ZW4YASABKAUSEwoLZHVyYXRpb25fbXMYAiABKAMSDgoGcXVldWVkGAMgASgIIkMKHEludmFsaWRhdGVUZW5hbnRDYWNoZVJlcXVlc3QSEQoJdGVuYW50X2lkGAEgASgJEhAKCHBhdHRlcm5zGAIgAygJIh8KHUludmFsaWRhdGVUZW5hbnRDYWNoZVJlc3BvbnNlIh0KG0dldFN5c3RlbURpYWdub3N0aWNzUmVxdWVzdCJFCg9Db21wb25lbnRIZWFsdGgSDwoHaGVhbHRoeRgBIAEoCBISCgpsYXRlbmN5X21zGAIgASgDEg0KBWVycm9yGAMgASgJIpYBCgxXb3JrZXJTZXJ2ZXISDAoEaG9zdBgBIAEoCRILCgNwaWQYAiABKAUSEwoLY29uY3VycmVuY3kYAyABKAUSFgoOYWN0aXZlX3dvcmtlcnMYBCABKAUSDgoGc3RhdHVzGAUgASgJEi4KCnN0YXJ0ZWRfYXQYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIpQBCgpRdWV1ZURlcHRoEg0KBXF1ZXVlGAEgASgJEg8KB3BlbmRpbmcYAiABKAUSDgoGYWN0aXZlGAMgASgFEhEKCXNjaGVkdWxlZBgEIAEoBRINCgVyZXRyeRgFIAEoBRIQCghhcmNoaXZlZBgGIAEoBRISCgpsYXRlbmN5X21zGAcgASgDEg4KBnBhdXNlZBgIIAEoCCKLAgoTU2NoZWR1bGVkVGFza1N0YXR1cxIRCgl0YXNrX3R5cGUYASABKAkSEAoIc2NoZWR1bGUYAiABKAkSMwoPbmV4dF9lbnF1ZXVlX2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBI0ChBsYXN0X2VucXVldWVkX2F0GAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBI1ChFsYXN0X3N1Y2NlZWRlZF9hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASGAoQbGFzdF9kdXJhdGlvbl9tcxgGIAEoAxITCgt3b3JrZXJfaG9zdBgHIAEoCSJACglCdWlsZEluZm8SDwoHdmVyc2lvbhgBIAEoCRIOCgZjb21taXQYAiABKAkSEgoKZ29fdmVyc2lvbhgDIAEoCSKMBAocR2V0U3lzdGVtRGlhZ25vc3RpY3NSZXNwb25zZRIuCgpjaGVja2VkX2F0GAEgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIiCgVidWlsZBgCIAEoCzITLm1pcmFpLnYxLkJ1aWxkSW5mbxIrCghkYXRhYmFzZRgDIAEoCzIZLm1pcmFpLnYxLkNvbXBvbmVudEhlYWx0aBIoCgVyZWRpcxgEIAEoCzIZLm1pcmFpLnYxLkNvbXBvbmVudEhlYWx0aBIqCgdzdG9yYWdlGAUgASgLMhkubWlyYWkudjEuQ29tcG9uZW50SGVhbHRoEhEKCXdvcmtlcl91cBgGIAEoCBIuCg53b3JrZXJfc2VydmVycxgHIAMoCzIWLm1pcmFpLnYxLldvcmtlclNlcnZlchIUCgx3b3JrZXJfZXJyb3IYCCABKAkSJAoGcXVldWVzGAkgAygLMhQubWlyYWkudjEuUXVldWVEZXB0aBIUCgxxdWV1ZXNfZXJyb3IYCiABKAkSNgoPc2NoZWR1bGVkX3Rhc2tzGAsgAygLMh0ubWlyYWkudjEuU2NoZWR1bGVkVGFza1N0YXR1cxIdChVzY2hlZHVsZWRfdGFza3NfZXJyb3IYDCABKAkSKQoLY2FjaGVfc3RhdHMYDSABKAsyFC5taXJhaS52MS5DYWNoZVN0YXRzIr8BChNDYWNoZU5hbWVzcGFjZVN0YXRzEg0KBXNjb3BlGAEgASgJEhEKCW5hbWVzcGFjZRgCIAEoCRIMCgRoaXRzGAMgASgDEg4KBm1pc3NlcxgEIAEoAxIOCgZlcnJvcnMYBSABKAMSDgoGd3JpdGVzGAYgASgDEhAKCGhpdF9yYXRlGAcgASgBEhoKEmdldF9sYXRlbmN5X2F2Z19tcxgIIAEoARIaChJnZXRfbGF0ZW5jeV9wOTVfbXMYCSABKAEiqgEKCkNhY2hlU3RhdHMSKQoFc2luY2UYASABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEgwKBGhpdHMYAiABKAMSDgoGbWlzc2VzGAMgASgDEg4KBmVycm9ycxgEIAEoAxIQCghoaXRfcmF0ZRgFIAEoARIxCgpuYW1lc3BhY2VzGAYgAygLMh0ubWlyYWkudjEuQ2FjaGVOYW1lc3BhY2VTdGF0cyIjCiFCYWNrZmlsbEtub3dsZWRnZVN1bW1hcmllc1JlcXVlc3QiJAoiQmFja2ZpbGxLbm93bGVkZ2VTdW1tYXJpZXNSZXNwb25zZSIyCh1Sb3RhdGVUZW5hbnRTdG9yYWdlS2V5UmVxdWVzdBIRCgl0ZW5hbnRfaWQYASABKAkiNQoeUm90YXRlVGVuYW50U3RvcmFnZUtleVJlc3BvbnNlEhMKC2tleV92ZXJzaW9uGAEgASgFIjAKG0VuY3J5cHRUZW5hbnRTdG9yYWdlUmVxdWVzdBIRCgl0ZW5hbnRfaWQYASABKAkiHgocRW5jcnlwdFRlbmFudFN0b3JhZ2VSZXNwb25zZSJGCiFNaWdyYXRlVGVuYW50U3RvcmFnZVJlZ2lvblJlcXVlc3QSEQoJdGVuYW50X2lkGAEgASgJEg4KBnJlZ2lvbhgCIAEoCSIkCiJNaWdyYXRlVGVuYW50U3RvcmFnZVJlZ2lvblJlc3BvbnNlMsUHChJNYWludGVuYW5jZVNlcnZpY2USXwoSR2V0TWFpbnRlbmFuY2VNb2RlEiMubWlyYWkudjEuR2V0TWFpbnRlbmFuY2VNb2RlUmVxdWVzdBokLm1pcmFpLnYxLkdldE1haW50ZW5hbmNlTW9kZVJlc3BvbnNlEl8KElNldE1haW50ZW5hbmNlTW9kZRIjLm1pcmFpLnYxLlNldE1haW50ZW5hbmNlTW9kZVJlcXVlc3QaJC5taXJhaS52MS5TZXRNYWludGVuYW5jZU1vZGVSZXNwb25zZRJWCg9XYXJtVGVuYW50Q2FjaGUSIC5taXJhaS52MS5XYXJtVGVuYW50Q2FjaGVSZXF1ZXN0GiEubWlyYWkudjEuV2FybVRlbmFudENhY2hlUmVzcG9uc2USaAoVSW52YWxpZGF0ZVRlbmFudENhY2hlEiYubWlyYWkudjEuSW52YWxpZGF0ZVRlbmFudENhY2hlUmVxdWVzdBonLm1pcmFpLnYxLkludmFsaWRhdGVUZW5hbnRDYWNoZVJlc3BvbnNlEmUKFEdldFN5c3RlbURpYWdub3N0aWNzEiUubWlyYWkudjEuR2V0U3lzdGVtRGlhZ25vc3RpY3NSZXF1ZXN0GiYubWlyYWkudjEuR2V0U3lzdGVtRGlhZ25vc3RpY3NSZXNwb25zZRJ3ChpCYWNrZmlsbEtub3dsZWRnZVN1bW1hcmllcxIrLm1pcmFpLnYxLkJhY2tmaWxsS25vd2xlZGdlU3VtbWFyaWVzUmVxdWVzdBosLm1pcmFpLnYxLkJhY2tmaWxsS25vd2xlZGdlU3VtbWFyaWVzUmVzcG9uc2USawoWUm90YXRlVGVuYW50U3RvcmFnZUtleRInLm1pcmFpLnYxLlJvdGF0ZVRlbmFudFN0b3JhZ2VLZXlSZXF1ZXN0GigubWlyYWkudjEuUm90YXRlVGVuYW50U3RvcmFnZUtleVJlc3BvbnNlEmUKFEVuY3J5cHRUZW5hbnRTdG9yYWdlEiUubWlyYWkudjEuRW5jcnlwdFRlbmFudFN0b3JhZ2VSZXF1ZXN0GiYubWlyYWkudjEuRW5jcnlwdFRlbmFudFN0b3JhZ2VSZXNwb25zZRJ3ChpNaWdyYXRlVGVuYW50U3RvcmFnZVJlZ2lvbhIrLm1pcmFpLnYxLk1pZ3JhdGVUZW5hbnRTdG9yYWdlUmVnaW9uUmVxdWVzdBosLm1pcmFpLnYxLk1pZ3JhdGVUZW5hbnRTdG9yYWdlUmVnaW9uUmVzcG9uc2VClgEKDGNvbS5taXJhaS52MUIQTWFpbnRlbmFuY2VQcm90b1ABWjNnaXRodWIuY29tL3NvZ29zL21pcmFpLWJhY2tlbmQvZ2VuL21pcmFpL3YxO21pcmFpdjGiAgNNWFiqAghNaXJhaS5WMcoCCE1pcmFpXFYx4gIUTWlyYWlcVjFcR1BCTWV0YWRhdGHqAglNaXJhaTo6VjFiBnByb3RvMw", [file_google_protobuf_timestamp]);
+
+/**
+ * MaintenanceStatus describes the read-only maintenance flag.
+ * While enabled, mutating RPCs fail with UNAVAILABLE and background jobs pause.
+ *
+ * @generated from message mirai.v1.MaintenanceStatus
+ */
+export type MaintenanceStatus = Message<"mirai.v1.MaintenanceStatus"> & {
+  /**
+   * @generated from field: bool enabled = 1;
+   */
+  enabled: boolean;
+
+  /**
+   * @generated from field: string reason = 2;
+   */
+  reason: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp started_at = 3;
+   */
+  startedAt?: Timestamp;
+
+  /**
+   * Flag clears itself at this time
+   *
+   * @generated from field: google.protobuf.Timestamp ends_at = 4;
+   */
+  endsAt?: Timestamp;
+
+  /**
+   * Suggested wait before retrying writes
+   *
+   * @generated from field: int32 retry_after_seconds = 5;
+   */
+  retryAfterSeconds: number;
+};
+
+/**
+ * Describes the message mirai.v1.MaintenanceStatus.
+ * Use `create(MaintenanceStatusSchema)` to create a new message.
+ */
+export const MaintenanceStatusSchema: GenMessage<MaintenanceStatus> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_maintenance, 0);
+
+/**
+ * GetMaintenanceModeRequest is empty.
+ *
+ * @generated from message mirai.v1.GetMaintenanceModeRequest
+ */
+export type GetMaintenanceModeRequest = Message<"mirai.v1.GetMaintenanceModeRequest"> & {
+};
+
+/**
+ * Describes the message mirai.v1.GetMaintenanceModeRequest.
+ * Use `create(GetMaintenanceModeRequestSchema)` to create a new message.
+ */
+export const GetMaintenanceModeRequestSchema: GenMessage<GetMaintenanceModeRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_maintenance, 1);
+
+/**
+ * GetMaintenanceModeResponse contains the maintenance flag.
+ *
+ * @generated from message mirai.v1.GetMaintenanceModeResponse
+ */
+export type GetMaintenanceModeResponse = Message<"mirai.v1.GetMaintenanceModeResponse"> & {
+  /**
+   * @generated from field: mirai.v1.MaintenanceStatus status = 1;
+   */
+  status?: MaintenanceStatus;
+};
+
+/**
+ * Describes the message mirai.v1.GetMaintenanceModeResponse.
+ * Use `create(GetMaintenanceModeResponseSchema)` to create a new message.
+ */
+export const GetMaintenanceModeResponseSchema: GenMessage<GetMaintenanceModeResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_maintenance, 2);
+
+/**
+ * SetMaintenanceModeRequest enables or disables maintenance mode.
+ *
+ * @generated from message mirai.v1.SetMaintenanceModeRequest
+ */
+export type SetMaintenanceModeRequest = Message<"mirai.v1.SetMaintenanceModeRequest"> & {
+  /**
+   * @generated from field: bool enabled = 1;
+   */
+  enabled: boolean;
+
+  /**
+   * Shown to users while enabled
+   *
+   * @generated from field: string reason = 2;
+   */
+  reason: string;
+
+  /**
+   * Defaults to 60, max 1440
+   *
+   * @generated from field: int32 duration_minutes = 3;
+   */
+  durationMinutes: number;
+};
+
+/**
+ * Describes the message mirai.v1.SetMaintenanceModeRequest.
+ * Use `create(SetMaintenanceModeRequestSchema)` to create a new message.
+ */
+export const SetMaintenanceModeRequestSchema: GenMessage<SetMaintenanceModeRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_maintenance, 3);
+
+/**
+ * SetMaintenanceModeResponse contains the updated maintenance flag.
+ *
+ * @generated from message mirai.v1.SetMaintenanceModeResponse
+ */
+export type SetMaintenanceModeResponse = Message<"mirai.v1.SetMaintenanceModeResponse"> & {
+  /**
+   * @generated from field: mirai.v1.MaintenanceStatus status = 1;
+   */
+  status?: MaintenanceStatus;
+};
+
+/**
+ * Describes the message mirai.v1.SetMaintenanceModeResponse.
+ * Use `create(SetMaintenanceModeResponseSchema)` to create a new message.
+ */
+export const SetMaintenanceModeResponseSchema: GenMessage<SetMaintenanceModeResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_maintenance, 4);
+
+/**
+ * WarmTenantCacheRequest selects the tenant to warm.
+ *
+ * @generated from message mirai.v1.WarmTenantCacheRequest
+ */
+export type WarmTenantCacheRequest = Message<"mirai.v1.WarmTenantCacheRequest"> & {
+  /**
+   * @generated from field: string tenant_id = 1;
+   */
+  tenantId: string;
+
+  /**
+   * Course metadata entries to load; defaults to 50, max 500
+   *
+   * @generated from field: int32 recent_courses = 2;
+   */
+  recentCourses: number;
+
+  /**
+   * Queue the warm for the worker instead of waiting for it
+   *
+   * @generated from field: bool background = 3;
+   */
+  background: boolean;
+};
+
+/**
+ * Describes the message mirai.v1.WarmTenantCacheRequest.
+ * Use `create(WarmTenantCacheRequestSchema)` to create a new message.
+ */
+export const WarmTenantCacheRequestSchema: GenMessage<WarmTenantCacheRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_maintenance, 5);
+
+/**
+ * WarmTenantCacheResponse reports what the warm wrote.
+ *
+ * @generated from message mirai.v1.WarmTenantCacheResponse
+ */
+export type WarmTenantCacheResponse = Message<"mirai.v1.WarmTenantCacheResponse"> & {
+  /**
+   * @generated from field: int32 keys_written = 1;
+   */
+  keysWritten: number;
+
+  /**
+   * @generated from field: int64 duration_ms = 2;
+   */
+  durationMs: bigint;
+
+  /**
+   * Handed to the worker; keys_written and duration_ms are zero
+   *
+   * @generated from field: bool queued = 3;
+   */
+  queued: boolean;
+};
+
+/**
+ * Describes the message mirai.v1.WarmTenantCacheResponse.
+ * Use `create(WarmTenantCacheResponseSchema)` to create a new message.
+ */
+export const WarmTenantCacheResponseSchema: GenMessage<WarmTenantCacheResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_maintenance, 6);
+
+/**
+ * InvalidateTenantCacheRequest selects the tenant and entries to drop.
+ *
+ * @generated from message mirai.v1.InvalidateTenantCacheRequest
+ */
+export type InvalidateTenantCacheRequest = Message<"mirai.v1.InvalidateTenantCacheRequest"> & {
+  /**
+   * @generated from field: string tenant_id = 1;
+   */
+  tenantId: string;
+
+  /**
+   * Key patterns within the tenant, e.g. "course:*"; empty drops everything
+   *
+   * @generated from field: repeated string patterns = 2;
+   */
+  patterns: string[];
+};
+
+/**
+ * Describes the message mirai.v1.InvalidateTenantCacheRequest.
+ * Use `create(InvalidateTenantCacheRequestSchema)` to create a new message.
+ */
+export const InvalidateTenantCacheRequestSchema: GenMessage<InvalidateTenantCacheRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_maintenance, 7);
+
+/**
+ * InvalidateTenantCacheResponse is empty.
+ *
+ * @generated from message mirai.v1.InvalidateTenantCacheResponse
+ */
+export type InvalidateTenantCacheResponse = Message<"mirai.v1.InvalidateTenantCacheResponse"> & {
+};
+
+/**
+ * Describes the message mirai.v1.InvalidateTenantCacheResponse.
+ * Use `create(InvalidateTenantCacheResponseSchema)` to create a new message.
+ */
+export const InvalidateTenantCacheResponseSchema: GenMessage<InvalidateTenantCacheResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_maintenance, 8);
+
+/**
+ * GetSystemDiagnosticsRequest is empty.
+ *
+ * @generated from message mirai.v1.GetSystemDiagnosticsRequest
+ */
+export type GetSystemDiagnosticsRequest = Message<"mirai.v1.GetSystemDiagnosticsRequest"> & {
+};
+
+/**
+ * Describes the message mirai.v1.GetSystemDiagnosticsRequest.
+ * Use `create(GetSystemDiagnosticsRequestSchema)` to create a new message.
+ */
+export const GetSystemDiagnosticsRequestSchema: GenMessage<GetSystemDiagnosticsRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_maintenance, 9);
+
+/**
+ * ComponentHealth is the outcome of one dependency check.
+ *
+ * @generated from message mirai.v1.ComponentHealth
+ */
+export type ComponentHealth = Message<"mirai.v1.ComponentHealth"> & {
+  /**
+   * @generated from field: bool healthy = 1;
+   */
+  healthy: boolean;
+
+  /**
+   * @generated from field: int64 latency_ms = 2;
+   */
+  latencyMs: bigint;
+
+  /**
+   * Set when unhealthy
+   *
+   * @generated from field: string error = 3;
+   */
+  error: string;
+};
+
+/**
+ * Describes the message mirai.v1.ComponentHealth.
+ * Use `create(ComponentHealthSchema)` to create a new message.
+ */
+export const ComponentHealthSchema: GenMessage<ComponentHealth> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_maintenance, 10);
+
+/**
+ * WorkerServer is a running worker server.
+ *
+ * @generated from message mirai.v1.WorkerServer
+ */
+export type WorkerServer = Message<"mirai.v1.WorkerServer"> & {
+  /**
+   * @generated from field: string host = 1;
+   */
+  host: string;
+
+  /**
+   * @generated from field: int32 pid = 2;
+   */
+  pid: number;
+
+  /**
+   * @generated from field: int32 concurrency = 3;
+   */
+  concurrency: number;
+
+  /**
+   * Tasks being processed right now
+   *
+   * @generated from field: int32 active_workers = 4;
+   */
+  activeWorkers: number;
+
+  /**
+   * "active" or "stopped"
+   *
+   * @generated from field: string status = 5;
+   */
+  status: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp started_at = 6;
+   */
+  startedAt?: Timestamp;
+};
+
+/**
+ * Describes the message mirai.v1.WorkerServer.
+ * Use `create(WorkerServerSchema)` to create a new message.
+ */
+export const WorkerServerSchema: GenMessage<WorkerServer> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_maintenance, 11);
+
+/**
+ * QueueDepth is the number of tasks in a queue, by state.
+ *
+ * @generated from message mirai.v1.QueueDepth
+ */
+export type QueueDepth = Message<"mirai.v1.QueueDepth"> & {
+  /**
+   * @generated from field: string queue = 1;
+   */
+  queue: string;
+
+  /**
+   * @generated from field: int32 pending = 2;
+   */
+  pending: number;
+
+  /**
+   * @generated from field: int32 active = 3;
+   */
+  active: number;
+
+  /**
+   * @generated from field: int32 scheduled = 4;
+   */
+  scheduled: number;
+
+  /**
+   * @generated from field: int32 retry = 5;
+   */
+  retry: number;
+
+  /**
+   * @generated from field: int32 archived = 6;
+   */
+  archived: number;
+
+  /**
+   * Age of the oldest pending task
+   *
+   * @generated from field: int64 latency_ms = 7;
+   */
+  latencyMs: bigint;
+
+  /**
+   * @generated from field: bool paused = 8;
+   */
+  paused: boolean;
+};
+
+/**
+ * Describes the message mirai.v1.QueueDepth.
+ * Use `create(QueueDepthSchema)` to create a new message.
+ */
+export const QueueDepthSchema: GenMessage<QueueDepth> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_maintenance, 12);
+
+/**
+ * ScheduledTaskStatus is a task's schedule and its last successful run.
+ *
+ * @generated from message mirai.v1.ScheduledTaskStatus
+ */
+export type ScheduledTaskStatus = Message<"mirai.v1.ScheduledTaskStatus"> & {
+  /**
+   * @generated from field: string task_type = 1;
+   */
+  taskType: string;
+
+  /**
+   * Empty for tasks that aren't scheduled, e.g. provisioning
+   *
+   * @generated from field: string schedule = 2;
+   */
+  schedule: string;
+
+  /**
+   * Unset when no running scheduler has the task
+   *
+   * @generated from field: google.protobuf.Timestamp next_enqueue_at = 3;
+   */
+  nextEnqueueAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp last_enqueued_at = 4;
+   */
+  lastEnqueuedAt?: Timestamp;
+
+  /**
+   * Unset if the task never succeeded
+   *
+   * @generated from field: google.protobuf.Timestamp last_succeeded_at = 5;
+   */
+  lastSucceededAt?: Timestamp;
+
+  /**
+   * @generated from field: int64 last_duration_ms = 6;
+   */
+  lastDurationMs: bigint;
+
+  /**
+   * Worker that ran the last successful run
+   *
+   * @generated from field: string worker_host = 7;
+   */
+  workerHost: string;
+};
+
+/**
+ * Describes the message mirai.v1.ScheduledTaskStatus.
+ * Use `create(ScheduledTaskStatusSchema)` to create a new message.
+ */
+export const ScheduledTaskStatusSchema: GenMessage<ScheduledTaskStatus> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_maintenance, 13);
+
+/**
+ * BuildInfo identifies the running build.
+ *
+ * @generated from message mirai.v1.BuildInfo
+ */
+export type BuildInfo = Message<"mirai.v1.BuildInfo"> & {
+  /**
+   * @generated from field: string version = 1;
+   */
+  version: string;
+
+  /**
+   * @generated from field: string commit = 2;
+   */
+  commit: string;
+
+  /**
+   * @generated from field: string go_version = 3;
+   */
+  goVersion: string;
+};
+
+/**
+ * Describes the message mirai.v1.BuildInfo.
+ * Use `create(BuildInfoSchema)` to create a new message.
+ */
+export const BuildInfoSchema: GenMessage<BuildInfo> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_maintenance, 14);
+
+/**
+ * GetSystemDiagnosticsResponse is a snapshot of the backend's background services and
+ * dependencies. Sections that couldn't be read carry an error.
+ *
+ * @generated from message mirai.v1.GetSystemDiagnosticsResponse
+ */
+export type GetSystemDiagnosticsResponse = Message<"mirai.v1.GetSystemDiagnosticsResponse"> & {
+  /**
+   * @generated from field: google.protobuf.Timestamp checked_at = 1;
+   */
+  checkedAt?: Timestamp;
+
+  /**
+   * @generated from field: mirai.v1.BuildInfo build = 2;
+   */
+  build?: BuildInfo;
+
+  /**
+   * @generated from field: mirai.v1.ComponentHealth database = 3;
+   */
+  database?: ComponentHealth;
+
+  /**
+   * @generated from field: mirai.v1.ComponentHealth redis = 4;
+   */
+  redis?: ComponentHealth;
+
+  /**
+   * @generated from field: mirai.v1.ComponentHealth storage = 5;
+   */
+  storage?: ComponentHealth;
+
+  /**
+   * At least one worker server is active
+   *
+   * @generated from field: bool worker_up = 6;
+   */
+  workerUp: boolean;
+
+  /**
+   * @generated from field: repeated mirai.v1.WorkerServer worker_servers = 7;
+   */
+  workerServers: WorkerServer[];
+
+  /**
+   * @generated from field: string worker_error = 8;
+   */
+  workerError: string;
+
+  /**
+   * @generated from field: repeated mirai.v1.QueueDepth queues = 9;
+   */
+  queues: QueueDepth[];
+
+  /**
+   * @generated from field: string queues_error = 10;
+   */
+  queuesError: string;
+
+  /**
+   * @generated from field: repeated mirai.v1.ScheduledTaskStatus scheduled_tasks = 11;
+   */
+  scheduledTasks: ScheduledTaskStatus[];
+
+  /**
+   * @generated from field: string scheduled_tasks_error = 12;
+   */
+  scheduledTasksError: string;
+
+  /**
+   * @generated from field: mirai.v1.CacheStats cache_stats = 13;
+   */
+  cacheStats?: CacheStats;
+};
+
+/**
+ * Describes the message mirai.v1.GetSystemDiagnosticsResponse.
+ * Use `create(GetSystemDiagnosticsResponseSchema)` to create a new message.
+ */
+export const GetSystemDiagnosticsResponseSchema: GenMessage<GetSystemDiagnosticsResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_maintenance, 15);
+
+/**
+ * CacheNamespaceStats counts the cache operations on one key namespace.
+ *
+ * @generated from message mirai.v1.CacheNamespaceStats
+ */
+export type CacheNamespaceStats = Message<"mirai.v1.CacheNamespaceStats"> & {
+  /**
+   * "tenant" or "global"
+   *
+   * @generated from field: string scope = 1;
+   */
+  scope: string;
+
+  /**
+   * First key segment, e.g. "courses", or a global key's name, e.g. "user-tenant-mapping"
+   *
+   * @generated from field: string namespace = 2;
+   */
+  namespace: string;
+
+  /**
+   * @generated from field: int64 hits = 3;
+   */
+  hits: bigint;
+
+  /**
+   * @generated from field: int64 misses = 4;
+   */
+  misses: bigint;
+
+  /**
+   * Failed reads and writes
+   *
+   * @generated from field: int64 errors = 5;
+   */
+  errors: bigint;
+
+  /**
+   * @generated from field: int64 writes = 6;
+   */
+  writes: bigint;
+
+  /**
+   * Hits over hits and misses; 0 without reads
+   *
+   * @generated from field: double hit_rate = 7;
+   */
+  hitRate: number;
+
+  /**
+   * @generated from field: double get_latency_avg_ms = 8;
+   */
+  getLatencyAvgMs: number;
+
+  /**
+   * Upper bound of the histogram bucket holding the 95th percentile; -1 if over 1s
+   *
+   * @generated from field: double get_latency_p95_ms = 9;
+   */
+  getLatencyP95Ms: number;
+};
+
+/**
+ * Describes the message mirai.v1.CacheNamespaceStats.
+ * Use `create(CacheNamespaceStatsSchema)` to create a new message.
+ */
+export const CacheNamespaceStatsSchema: GenMessage<CacheNamespaceStats> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_maintenance, 16);
+
+/**
+ * CacheStats is the cache's hit rates since the server process started. Each replica
+ * counts its own operations.
+ *
+ * @generated from message mirai.v1.CacheStats
+ */
+export type CacheStats = Message<"mirai.v1.CacheStats"> & {
+  /**
+   * @generated from field: google.protobuf.Timestamp since = 1;
+   */
+  since?: Timestamp;
+
+  /**
+   * @generated from field: int64 hits = 2;
+   */
+  hits: bigint;
+
+  /**
+   * @generated from field: int64 misses = 3;
+   */
+  misses: bigint;
+
+  /**
+   * @generated from field: int64 errors = 4;
+   */
+  errors: bigint;
+
+  /**
+   * @generated from field: double hit_rate = 5;
+   */
+  hitRate: number;
+
+  /**
+   * @generated from field: repeated mirai.v1.CacheNamespaceStats namespaces = 6;
+   */
+  namespaces: CacheNamespaceStats[];
+};
+
+/**
+ * Describes the message mirai.v1.CacheStats.
+ * Use `create(CacheStatsSchema)` to create a new message.
+ */
+export const CacheStatsSchema: GenMessage<CacheStats> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_maintenance, 17);
+
+/**
+ * BackfillKnowledgeSummariesRequest is empty.
+ *
+ * @generated from message mirai.v1.BackfillKnowledgeSummariesRequest
+ */
+export type BackfillKnowledgeSummariesRequest = Message<"mirai.v1.BackfillKnowledgeSummariesRequest"> & {
+};
+
+/**
+ * Describes the message mirai.v1.BackfillKnowledgeSummariesRequest.
+ * Use `create(BackfillKnowledgeSummariesRequestSchema)` to create a new message.
+ */
+export const BackfillKnowledgeSummariesRequestSchema: GenMessage<BackfillKnowledgeSummariesRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_maintenance, 18);
+
+/**
+ * BackfillKnowledgeSummariesResponse is empty; the backfill runs on the worker.
+ *
+ * @generated from message mirai.v1.BackfillKnowledgeSummariesResponse
+ */
+export type BackfillKnowledgeSummariesResponse = Message<"mirai.v1.BackfillKnowledgeSummariesResponse"> & {
+};
+
+/**
+ * Describes the message mirai.v1.BackfillKnowledgeSummariesResponse.
+ * Use `create(BackfillKnowledgeSummariesResponseSchema)` to create a new message.
+ */
+export const BackfillKnowledgeSummariesResponseSchema: GenMessage<BackfillKnowledgeSummariesResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_maintenance, 19);
+
+/**
+ * RotateTenantStorageKeyRequest identifies the tenant whose key to rotate.
+ *
+ * @generated from message mirai.v1.RotateTenantStorageKeyRequest
+ */
+export type RotateTenantStorageKeyRequest = Message<"mirai.v1.RotateTenantStorageKeyRequest"> & {
+  /**
+   * @generated from field: string tenant_id = 1;
+   */
+  tenantId: string;
+};
+
+/**
+ * Describes the message mirai.v1.RotateTenantStorageKeyRequest.
+ * Use `create(RotateTenantStorageKeyRequestSchema)` to create a new message.
+ */
+export const RotateTenantStorageKeyRequestSchema: GenMessage<RotateTenantStorageKeyRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_maintenance, 20);
+
+/**
+ * RotateTenantStorageKeyResponse reports the new key version.
+ *
+ * @generated from message mirai.v1.RotateTenantStorageKeyResponse
+ */
+export type RotateTenantStorageKeyResponse = Message<"mirai.v1.RotateTenantStorageKeyResponse"> & {
+  /**
+   * @generated from field: int32 key_version = 1;
+   */
+  keyVersion: number;
+};
+
+/**
+ * Describes the message mirai.v1.RotateTenantStorageKeyResponse.
+ * Use `create(RotateTenantStorageKeyResponseSchema)` to create a new message.
+ */
+export const RotateTenantStorageKeyResponseSchema: GenMessage<RotateTenantStorageKeyResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_maintenance, 21);
+
+/**
+ * EncryptTenantStorageRequest identifies the tenant to encrypt.
+ *
+ * @generated from message mirai.v1.EncryptTenantStorageRequest
+ */
+export type EncryptTenantStorageRequest = Message<"mirai.v1.EncryptTenantStorageRequest"> & {
+  /**
+   * Empty encrypts every active tenant
+   *
+   * @generated from field: string tenant_id = 1;
+   */
+  tenantId: string;
+};
+
+/**
+ * Describes the message mirai.v1.EncryptTenantStorageRequest.
+ * Use `create(EncryptTenantStorageRequestSchema)` to create a new message.
+ */
+export const EncryptTenantStorageRequestSchema: GenMessage<EncryptTenantStorageRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_maintenance, 22);
+
+/**
+ * EncryptTenantStorageResponse is empty; the encryption runs on the worker.
+ *
+ * @generated from message mirai.v1.EncryptTenantStorageResponse
+ */
+export type EncryptTenantStorageResponse = Message<"mirai.v1.EncryptTenantStorageResponse"> & {
+};
+
+/**
+ * Describes the message mirai.v1.EncryptTenantStorageResponse.
+ * Use `create(EncryptTenantStorageResponseSchema)` to create a new message.
+ */
+export const EncryptTenantStorageResponseSchema: GenMessage<EncryptTenantStorageResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_maintenance, 23);
+
+/**
+ * MigrateTenantStorageRegionRequest identifies the tenant and the region to move it to.
+ *
+ * @generated from message mirai.v1.MigrateTenantStorageRegionRequest
+ */
+export type MigrateTenantStorageRegionRequest = Message<"mirai.v1.MigrateTenantStorageRegionRequest"> & {
+  /**
+   * @generated from field: string tenant_id = 1;
+   */
+  tenantId: string;
+
+  /**
+   * @generated from field: string region = 2;
+   */
+  region: string;
+};
+
+/**
+ * Describes the message mirai.v1.MigrateTenantStorageRegionRequest.
+ * Use `create(MigrateTenantStorageRegionRequestSchema)` to create a new message.
+ */
+export const MigrateTenantStorageRegionRequestSchema: GenMessage<MigrateTenantStorageRegionRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_maintenance, 24);
+
+/**
+ * MigrateTenantStorageRegionResponse is empty; the migration runs on the worker.
+ *
+ * @generated from message mirai.v1.MigrateTenantStorageRegionResponse
+ */
+export type MigrateTenantStorageRegionResponse = Message<"mirai.v1.MigrateTenantStorageRegionResponse"> & {
+};
+
+/**
+ * Describes the message mirai.v1.MigrateTenantStorageRegionResponse.
+ * Use `create(MigrateTenantStorageRegionResponseSchema)` to create a new message.
+ */
+export const MigrateTenantStorageRegionResponseSchema: GenMessage<MigrateTenantStorageRegionResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_maintenance, 25);
+
+/**
+ * MaintenanceService toggles read-only maintenance mode and manages tenant caches.
+ * Everything except reading the flag requires a superadmin (SUPERADMIN_EMAILS).
+ *
+ * @generated from service mirai.v1.MaintenanceService
+ */
+export const MaintenanceService: GenService<{
+  /**
+   * GetMaintenanceMode returns the current maintenance flag.
+   *
+   * @generated from rpc mirai.v1.MaintenanceService.GetMaintenanceMode
+   */
+  getMaintenanceMode: {
+    methodKind: "unary";
+    input: typeof GetMaintenanceModeRequestSchema;
+    output: typeof GetMaintenanceModeResponseSchema;
+  },
+  /**
+   * SetMaintenanceMode enables or disables maintenance mode.
+   *
+   * @generated from rpc mirai.v1.MaintenanceService.SetMaintenanceMode
+   */
+  setMaintenanceMode: {
+    methodKind: "unary";
+    input: typeof SetMaintenanceModeRequestSchema;
+    output: typeof SetMaintenanceModeResponseSchema;
+  },
+  /**
+   * WarmTenantCache rebuilds a tenant's cached library listing, folder hierarchy with
+   * counts and most recently modified course metadata.
+   *
+   * @generated from rpc mirai.v1.MaintenanceService.WarmTenantCache
+   */
+  warmTenantCache: {
+    methodKind: "unary";
+    input: typeof WarmTenantCacheRequestSchema;
+    output: typeof WarmTenantCacheResponseSchema;
+  },
+  /**
+   * InvalidateTenantCache drops a tenant's cached entries.
+   *
+   * @generated from rpc mirai.v1.MaintenanceService.InvalidateTenantCache
+   */
+  invalidateTenantCache: {
+    methodKind: "unary";
+    input: typeof InvalidateTenantCacheRequestSchema;
+    output: typeof InvalidateTenantCacheResponseSchema;
+  },
+  /**
+   * GetSystemDiagnostics reports the state of the worker, queues, scheduled tasks and
+   * dependencies. Each check has a short timeout; failures are reported, not returned.
+   *
+   * @generated from rpc mirai.v1.MaintenanceService.GetSystemDiagnostics
+   */
+  getSystemDiagnostics: {
+    methodKind: "unary";
+    input: typeof GetSystemDiagnosticsRequestSchema;
+    output: typeof GetSystemDiagnosticsResponseSchema;
+  },
+  /**
+   * BackfillKnowledgeSummaries queues re-summarization of every SME, in all tenants, whose
+   * knowledge summary is over the length cap. The counts are emailed to the operators.
+   *
+   * @generated from rpc mirai.v1.MaintenanceService.BackfillKnowledgeSummaries
+   */
+  backfillKnowledgeSummaries: {
+    methodKind: "unary";
+    input: typeof BackfillKnowledgeSummariesRequestSchema;
+    output: typeof BackfillKnowledgeSummariesResponseSchema;
+  },
+  /**
+   * RotateTenantStorageKey gives a tenant a new key for encrypting its course content and
+   * queues re-encryption of the content with it. Content written with older keys stays
+   * readable meanwhile. Requires STORAGE_ENCRYPTION_ENABLED.
+   *
+   * @generated from rpc mirai.v1.MaintenanceService.RotateTenantStorageKey
+   */
+  rotateTenantStorageKey: {
+    methodKind: "unary";
+    input: typeof RotateTenantStorageKeyRequestSchema;
+    output: typeof RotateTenantStorageKeyResponseSchema;
+  },
+  /**
+   * EncryptTenantStorage queues re-encryption of a tenant's course content, or every
+   * tenant's, rewriting plaintext objects and objects encrypted with a retired key.
+   * Requires STORAGE_ENCRYPTION_ENABLED.
+   *
+   * @generated from rpc mirai.v1.MaintenanceService.EncryptTenantStorage
+   */
+  encryptTenantStorage: {
+    methodKind: "unary";
+    input: typeof EncryptTenantStorageRequestSchema;
+    output: typeof EncryptTenantStorageResponseSchema;
+  },
+  /**
+   * MigrateTenantStorageRegion queues moving a tenant's stored objects to another storage
+   * region. The tenant switches over in one step once every object is copied, and its
+   * objects are then removed from the old region.
+   *
+   * @generated from rpc mirai.v1.MaintenanceService.MigrateTenantStorageRegion
+   */
+  migrateTenantStorageRegion: {
+    methodKind: "unary";
+    input: typeof MigrateTenantStorageRegionRequestSchema;
+    output: typeof MigrateTenantStorageRegionResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_mirai_v1_maintenance, 0);
+
@@ -32,9 +32,24 @@ export const markAsRead = NotificationService.method.markAsRead;
  */
 export const markAllAsRead = NotificationService.method.markAllAsRead;
 
+/**
+ * MarkAsReadByFilter marks all notifications matching a filter as read.
+ *
+ * @generated from rpc mirai.v1.NotificationService.MarkAsReadByFilter
+ */
+export const markAsReadByFilter = NotificationService.method.markAsReadByFilter;
+
 /**
  * DeleteNotification deletes a notification.
  *
  * @generated from rpc mirai.v1.NotificationService.DeleteNotification
  */
 export const deleteNotification = NotificationService.method.deleteNotification;
+
+/**
+ * ListEmailLog returns the outcomes of emails sent for the tenant, most recent first.
+ * Requires ADMIN or OWNER role.
+ *
+ * @generated from rpc mirai.v1.NotificationService.ListEmailLog
+ */
+export const listEmailLog = NotificationService.method.listEmailLog;
@@ -6,13 +6,15 @@ import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobu
 import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
 import type { Timestamp } from "@bufbuild/protobuf/wkt";
 import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { SubmissionProgress } from "./sme_pb";
+import { file_mirai_v1_sme } from "./sme_pb";
 import type { Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file mirai/v1/notification.proto.
  */
 export const file_mirai_v1_notification: GenFile = /*@__PURE__*/
-  fileDesc("ChttaXJhaS92MS9ub3RpZmljYXRpb24ucHJvdG8SCG1pcmFpLnYxIvoDCgxOb3RpZmljYXRpb24SCgoCaWQYASABKAkSEQoJdGVuYW50X2lkGAIgASgJEg8KB3VzZXJfaWQYAyABKAkSKAoEdHlwZRgEIAEoDjIaLm1pcmFpLnYxLk5vdGlmaWNhdGlvblR5cGUSMAoIcHJpb3JpdHkYBSABKA4yHi5taXJhaS52MS5Ob3RpZmljYXRpb25Qcmlvcml0eRINCgV0aXRsZRgGIAEoCRIPCgdtZXNzYWdlGAcgASgJEhYKCWNvdXJzZV9pZBgIIAEoCUgAiAEBEhMKBmpvYl9pZBgJIAEoCUgBiAEBEhQKB3Rhc2tfaWQYCiABKAlIAogBARITCgZzbWVfaWQYCyABKAlIA4gBARIXCgphY3Rpb25fdXJsGAwgASgJSASIAQESDAoEcmVhZBgNIAEoCBISCgplbWFpbF9zZW50GA4gASgIEi4KCmNyZWF0ZWRfYXQYDyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjAKB3JlYWRfYXQYECABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSAWIAQFCDAoKX2NvdXJzZV9pZEIJCgdfam9iX2lkQgoKCF90YXNrX2lkQgkKB19zbWVfaWRCDQoLX2FjdGlvbl91cmxCCgoIX3JlYWRfYXQiHwodU3Vic2NyaWJlTm90aWZpY2F0aW9uc1JlcXVlc3QigwEKHlN1YnNjcmliZU5vdGlmaWNhdGlvbnNSZXNwb25zZRIzCgpldmVudF90eXBlGAEgASgOMh8ubWlyYWkudjEuTm90aWZpY2F0aW9uRXZlbnRUeXBlEiwKDG5vdGlmaWNhdGlvbhgCIAEoCzIWLm1pcmFpLnYxLk5vdGlmaWNhdGlvbiKrAQoYTGlzdE5vdGlmaWNhdGlvbnNSZXF1ZXN0EhgKC3VucmVhZF9vbmx5GAEgASgISACIAQESLQoEdHlwZRgCIAEoDjIaLm1pcmFpLnYxLk5vdGlmaWNhdGlvblR5cGVIAYgBARINCgVsaW1pdBgDIAEoBRITCgZjdXJzb3IYBCABKAlIAogBAUIOCgxfdW5yZWFkX29ubHlCBwoFX3R5cGVCCQoHX2N1cnNvciKJAQoZTGlzdE5vdGlmaWNhdGlvbnNSZXNwb25zZRItCg1ub3RpZmljYXRpb25zGAEgAygLMhYubWlyYWkudjEuTm90aWZpY2F0aW9uEhgKC25leHRfY3Vyc29yGAIgASgJSACIAQESEwoLdG90YWxfY291bnQYAyABKAVCDgoMX25leHRfY3Vyc29yIhcKFUdldFVucmVhZENvdW50UmVxdWVzdCInChZHZXRVbnJlYWRDb3VudFJlc3BvbnNlEg0KBWNvdW50GAEgASgFIi0KEU1hcmtBc1JlYWRSZXF1ZXN0EhgKEG5vdGlmaWNhdGlvbl9pZHMYASADKAkiKgoSTWFya0FzUmVhZFJlc3BvbnNlEhQKDG1hcmtlZF9jb3VudBgBIAEoBSIWChRNYXJrQWxsQXNSZWFkUmVxdWVzdCItChVNYXJrQWxsQXNSZWFkUmVzcG9uc2USFAoMbWFya2VkX2NvdW50GAEgASgFIjQKGURlbGV0ZU5vdGlmaWNhdGlvblJlcXVlc3QSFwoPbm90aWZpY2F0aW9uX2lkGAEgASgJIhwKGkRlbGV0ZU5vdGlmaWNhdGlvblJlc3BvbnNlKvQCChBOb3RpZmljYXRpb25UeXBlEiEKHU5PVElGSUNBVElPTl9UWVBFX1VOU1BFQ0lGSUVEEAASIwofTk9USUZJQ0FUSU9OX1RZUEVfVEFTS19BU1NJR05FRBABEiMKH05PVElGSUNBVElPTl9UWVBFX1RBU0tfRFVFX1NPT04QAhIoCiROT1RJRklDQVRJT05fVFlQRV9JTkdFU1RJT05fQ09NUExFVEUQAxImCiJOT1RJRklDQVRJT05fVFlQRV9JTkdFU1RJT05fRkFJTEVEEAQSIwofTk9USUZJQ0FUSU9OX1RZUEVfT1VUTElORV9SRUFEWRAFEikKJU5PVElGSUNBVElPTl9UWVBFX0dFTkVSQVRJT05fQ09NUExFVEUQBhInCiNOT1RJRklDQVRJT05fVFlQRV9HRU5FUkFUSU9OX0ZBSUxFRBAHEigKJE5PVElGSUNBVElPTl9UWVBFX0FQUFJPVkFMX1JFUVVFU1RFRBAIKp4BChROb3RpZmljYXRpb25Qcmlvcml0eRIlCiFOT1RJRklDQVRJT05fUFJJT1JJVFlfVU5TUEVDSUZJRUQQABIdChlOT1RJRklDQVRJT05fUFJJT1JJVFlfTE9XEAESIAocTk9USUZJQ0FUSU9OX1BSSU9SSVRZX05PUk1BTBACEh4KGk5PVElGSUNBVElPTl9QUklPUklUWV9ISUdIEAMq0wEKFU5vdGlmaWNhdGlvbkV2ZW50VHlwZRInCiNOT1RJRklDQVRJT05fRVZFTlRfVFlQRV9VTlNQRUNJRklFRBAAEiMKH05PVElGSUNBVElPTl9FVkVOVF9UWVBFX0NSRUFURUQQARIgChxOT1RJRklDQVRJT05fRVZFTlRfVFlQRV9SRUFEEAISIwofTk9USUZJQ0FUSU9OX0VWRU5UX1RZUEVfREVMRVRFRBADEiUKIU5PVElGSUNBVElPTl9FVkVOVF9UWVBFX0tFRVBBTElWRRAEMrMEChNOb3RpZmljYXRpb25TZXJ2aWNlElwKEUxpc3ROb3RpZmljYXRpb25zEiIubWlyYWkudjEuTGlzdE5vdGlmaWNhdGlvbnNSZXF1ZXN0GiMubWlyYWkudjEuTGlzdE5vdGlmaWNhdGlvbnNSZXNwb25zZRJTCg5HZXRVbnJlYWRDb3VudBIfLm1pcmFpLnYxLkdldFVucmVhZENvdW50UmVxdWVzdBogLm1pcmFpLnYxLkdldFVucmVhZENvdW50UmVzcG9uc2USRwoKTWFya0FzUmVhZBIbLm1pcmFpLnYxLk1hcmtBc1JlYWRSZXF1ZXN0GhwubWlyYWkudjEuTWFya0FzUmVhZFJlc3BvbnNlElAKDU1hcmtBbGxBc1JlYWQSHi5taXJhaS52MS5NYXJrQWxsQXNSZWFkUmVxdWVzdBofLm1pcmFpLnYxLk1hcmtBbGxBc1JlYWRSZXNwb25zZRJfChJEZWxldGVOb3RpZmljYXRpb24SIy5taXJhaS52MS5EZWxldGVOb3RpZmljYXRpb25SZXF1ZXN0GiQubWlyYWkudjEuRGVsZXRlTm90aWZpY2F0aW9uUmVzcG9uc2USbQoWU3Vic2NyaWJlTm90aWZpY2F0aW9ucxInLm1pcmFpLnYxLlN1YnNjcmliZU5vdGlmaWNhdGlvbnNSZXF1ZXN0GigubWlyYWkudjEuU3Vic2NyaWJlTm90aWZpY2F0aW9uc1Jlc3BvbnNlMAFClwEKDGNvbS5taXJhaS52MUIRTm90aWZpY2F0aW9uUHJvdG9QAVozZ2l0aHViLmNvbS9zb2dvcy9taXJhaS1iYWNrZW5kL2dlbi9taXJhaS92MTttaXJhaXYxogIDTVhYqgIITWlyYWkuVjHKAghNaXJhaVxWMeICFE1pcmFpXFYxXEdQQk1ldGFkYXRh6gIJTWlyYWk6OlYxYgZwcm90bzM", [file_google_protobuf_timestamp]);
+  fileDesc("ChttaXJhaS92MS9ub3RpZmljYXRpb24ucHJvdG8SCG1pcmFpLnYxIvoDCgxOb3RpZmljYXRpb24SCgoCaWQYASABKAkSEQoJdGVuYW50X2lkGAIgASgJEg8KB3VzZXJfaWQYAyABKAkSKAoEdHlwZRgEIAEoDjIaLm1pcmFpLnYxLk5vdGlmaWNhdGlvblR5cGUSMAoIcHJpb3JpdHkYBSABKA4yHi5taXJhaS52MS5Ob3RpZmljYXRpb25Qcmlvcml0eRINCgV0aXRsZRgGIAEoCRIPCgdtZXNzYWdlGAcgASgJEhYKCWNvdXJzZV9pZBgIIAEoCUgAiAEBEhMKBmpvYl9pZBgJIAEoCUgBiAEBEhQKB3Rhc2tfaWQYCiABKAlIAogBARITCgZzbWVfaWQYCyABKAlIA4gBARIXCgphY3Rpb25fdXJsGAwgASgJSASIAQESDAoEcmVhZBgNIAEoCBISCgplbWFpbF9zZW50GA4gASgIEi4KCmNyZWF0ZWRfYXQYDyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjAKB3JlYWRfYXQYECABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSAWIAQFCDAoKX2NvdXJzZV9pZEIJCgdfam9iX2lkQgoKCF90YXNrX2lkQgkKB19zbWVfaWRCDQoLX2FjdGlvbl91cmxCCgoIX3JlYWRfYXQiHwodU3Vic2NyaWJlTm90aWZpY2F0aW9uc1JlcXVlc3QivgEKHlN1YnNjcmliZU5vdGlmaWNhdGlvbnNSZXNwb25zZRIzCgpldmVudF90eXBlGAEgASgOMh8ubWlyYWkudjEuTm90aWZpY2F0aW9uRXZlbnRUeXBlEiwKDG5vdGlmaWNhdGlvbhgCIAEoCzIWLm1pcmFpLnYxLk5vdGlmaWNhdGlvbhI5ChNzdWJtaXNzaW9uX3Byb2dyZXNzGAMgASgLMhwubWlyYWkudjEuU3VibWlzc2lvblByb2dyZXNzIqsBChhMaXN0Tm90aWZpY2F0aW9uc1JlcXVlc3QSGAoLdW5yZWFkX29ubHkYASABKAhIAIgBARItCgR0eXBlGAIgASgOMhoubWlyYWkudjEuTm90aWZpY2F0aW9uVHlwZUgBiAEBEg0KBWxpbWl0GAMgASgFEhMKBmN1cnNvchgEIAEoCUgCiAEBQg4KDF91bnJlYWRfb25seUIHCgVfdHlwZUIJCgdfY3Vyc29yIokBChlMaXN0Tm90aWZpY2F0aW9uc1Jlc3BvbnNlEi0KDW5vdGlmaWNhdGlvbnMYASADKAsyFi5taXJhaS52MS5Ob3RpZmljYXRpb24SGAoLbmV4dF9jdXJzb3IYAiABKAlIAIgBARITCgt0b3RhbF9jb3VudBgDIAEoBUIOCgxfbmV4dF9jdXJzb3IiFwoVR2V0VW5yZWFkQ291bnRSZXF1ZXN0IicKFkdldFVucmVhZENvdW50UmVzcG9uc2USDQoFY291bnQYASABKAUiLQoRTWFya0FzUmVhZFJlcXVlc3QSGAoQbm90aWZpY2F0aW9uX2lkcxgBIAMoCSIqChJNYXJrQXNSZWFkUmVzcG9uc2USFAoMbWFya2VkX2NvdW50GAEgASgFIhYKFE1hcmtBbGxBc1JlYWRSZXF1ZXN0Ii0KFU1hcmtBbGxBc1JlYWRSZXNwb25zZRIUCgxtYXJrZWRfY291bnQYASABKAUivQEKGU1hcmtBc1JlYWRCeUZpbHRlclJlcXVlc3QSFgoJY291cnNlX2lkGAEgASgJSACIAQESLQoEdHlwZRgCIAEoDjIaLm1pcmFpLnYxLk5vdGlmaWNhdGlvblR5cGVIAYgBARIzCgpvbGRlcl90aGFuGAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgCiAEBQgwKCl9jb3Vyc2VfaWRCBwoFX3R5cGVCDQoLX29sZGVyX3RoYW4iMgoaTWFya0FzUmVhZEJ5RmlsdGVyUmVzcG9uc2USFAoMbWFya2VkX2NvdW50GAEgASgFIjQKGURlbGV0ZU5vdGlmaWNhdGlvblJlcXVlc3QSFwoPbm90aWZpY2F0aW9uX2lkGAEgASgJIhwKGkRlbGV0ZU5vdGlmaWNhdGlvblJlc3BvbnNlIqQCCg1FbWFpbExvZ0VudHJ5EgoKAmlkGAEgASgJEhEKCXJlY2lwaWVudBgCIAEoCRIQCgh0ZW1wbGF0ZRgDIAEoCRISCgptZXNzYWdlX2lkGAQgASgJEi0KBnN0YXR1cxgFIAEoDjIdLm1pcmFpLnYxLkVtYWlsRGVsaXZlcnlTdGF0dXMSFgoJc210cF9jb2RlGAYgASgFSACIAQESFQoNc210cF9yZXNwb25zZRgHIAEoCRIrCgdzZW50X2F0GAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBI1ChFzdGF0dXNfdXBkYXRlZF9hdBgJIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCDAoKX3NtdHBfY29kZSKOAQoTTGlzdEVtYWlsTG9nUmVxdWVzdBIWCglyZWNpcGllbnQYASABKAlIAIgBARIVCgh0ZW1wbGF0ZRgCIAEoCUgBiAEBEg0KBWxpbWl0GAMgASgFEhMKBmN1cnNvchgEIAEoCUgCiAEBQgwKCl9yZWNpcGllbnRCCwoJX3RlbXBsYXRlQgkKB19jdXJzb3IiagoUTGlzdEVtYWlsTG9nUmVzcG9uc2USKAoHZW50cmllcxgBIAMoCzIXLm1pcmFpLnYxLkVtYWlsTG9nRW50cnkSGAoLbmV4dF9jdXJzb3IYAiABKAlIAIgBAUIOCgxfbmV4dF9jdXJzb3IqkQQKEE5vdGlmaWNhdGlvblR5cGUSIQodTk9USUZJQ0FUSU9OX1RZUEVfVU5TUEVDSUZJRUQQABIjCh9OT1RJRklDQVRJT05fVFlQRV9UQVNLX0FTU0lHTkVEEAESIwofTk9USUZJQ0FUSU9OX1RZUEVfVEFTS19EVUVfU09PThACEigKJE5PVElGSUNBVElPTl9UWVBFX0lOR0VTVElPTl9DT01QTEVURRADEiYKIk5PVElGSUNBVElPTl9UWVBFX0lOR0VTVElPTl9GQUlMRUQQBBIjCh9OT1RJRklDQVRJT05fVFlQRV9PVVRMSU5FX1JFQURZEAUSKQolTk9USUZJQ0FUSU9OX1RZUEVfR0VORVJBVElPTl9DT01QTEVURRAGEicKI05PVElGSUNBVElPTl9UWVBFX0dFTkVSQVRJT05fRkFJTEVEEAcSKAokTk9USUZJQ0FUSU9OX1RZUEVfQVBQUk9WQUxfUkVRVUVTVEVEEAgSKAokTk9USUZJQ0FUSU9OX1RZUEVfQ09MTEFCT1JBVE9SX0FEREVEEAkSIgoeTk9USUZJQ0FUSU9OX1RZUEVfRVhQT1JUX1JFQURZEAoSJQohTk9USUZJQ0FUSU9OX1RZUEVfT1VUTElORV9DT01NRU5UEAsSJgoiTk9USUZJQ0FUSU9OX1RZUEVfTEVTU09OU19PUlBIQU5FRBAMKp4BChROb3RpZmljYXRpb25Qcmlvcml0eRIlCiFOT1RJRklDQVRJT05fUFJJT1JJVFlfVU5TUEVDSUZJRUQQABIdChlOT1RJRklDQVRJT05fUFJJT1JJVFlfTE9XEAESIAocTk9USUZJQ0FUSU9OX1BSSU9SSVRZX05PUk1BTBACEh4KGk5PVElGSUNBVElPTl9QUklPUklUWV9ISUdIEAMqhAIKFU5vdGlmaWNhdGlvbkV2ZW50VHlwZRInCiNOT1RJRklDQVRJT05fRVZFTlRfVFlQRV9VTlNQRUNJRklFRBAAEiMKH05PVElGSUNBVElPTl9FVkVOVF9UWVBFX0NSRUFURUQQARIgChxOT1RJRklDQVRJT05fRVZFTlRfVFlQRV9SRUFEEAISIwofTk9USUZJQ0FUSU9OX0VWRU5UX1RZUEVfREVMRVRFRBADEiUKIU5PVElGSUNBVElPTl9FVkVOVF9UWVBFX0tFRVBBTElWRRAEEi8KK05PVElGSUNBVElPTl9FVkVOVF9UWVBFX1NVQk1JU1NJT05fUFJPR1JFU1MQBSqSAgoTRW1haWxEZWxpdmVyeVN0YXR1cxIlCiFFTUFJTF9ERUxJVkVSWV9TVEFUVVNfVU5TUEVDSUZJRUQQABIiCh5FTUFJTF9ERUxJVkVSWV9TVEFUVVNfQUNDRVBURUQQARIiCh5FTUFJTF9ERUxJVkVSWV9TVEFUVVNfREVGRVJSRUQQAhIiCh5FTUFJTF9ERUxJVkVSWV9TVEFUVVNfUkVKRUNURUQQAxIgChxFTUFJTF9ERUxJVkVSWV9TVEFUVVNfRkFJTEVEEAQSIwofRU1BSUxfREVMSVZFUllfU1RBVFVTX0RFTElWRVJFRBAFEiEKHUVNQUlMX0RFTElWRVJZX1NUQVRVU19CT1VOQ0VEEAYy4wUKE05vdGlmaWNhdGlvblNlcnZpY2USXAoRTGlzdE5vdGlmaWNhdGlvbnMSIi5taXJhaS52MS5MaXN0Tm90aWZpY2F0aW9uc1JlcXVlc3QaIy5taXJhaS52MS5MaXN0Tm90aWZpY2F0aW9uc1Jlc3BvbnNlElMKDkdldFVucmVhZENvdW50Eh8ubWlyYWkudjEuR2V0VW5yZWFkQ291bnRSZXF1ZXN0GiAubWlyYWkudjEuR2V0VW5yZWFkQ291bnRSZXNwb25zZRJHCgpNYXJrQXNSZWFkEhsubWlyYWkudjEuTWFya0FzUmVhZFJlcXVlc3QaHC5taXJhaS52MS5NYXJrQXNSZWFkUmVzcG9uc2USUAoNTWFya0FsbEFzUmVhZBIeLm1pcmFpLnYxLk1hcmtBbGxBc1JlYWRSZXF1ZXN0Gh8ubWlyYWkudjEuTWFya0FsbEFzUmVhZFJlc3BvbnNlEl8KEk1hcmtBc1JlYWRCeUZpbHRlchIjLm1pcmFpLnYxLk1hcmtBc1JlYWRCeUZpbHRlclJlcXVlc3QaJC5taXJhaS52MS5NYXJrQXNSZWFkQnlGaWx0ZXJSZXNwb25zZRJfChJEZWxldGVOb3RpZmljYXRpb24SIy5taXJhaS52MS5EZWxldGVOb3RpZmljYXRpb25SZXF1ZXN0GiQubWlyYWkudjEuRGVsZXRlTm90aWZpY2F0aW9uUmVzcG9uc2USbQoWU3Vic2NyaWJlTm90aWZpY2F0aW9ucxInLm1pcmFpLnYxLlN1YnNjcmliZU5vdGlmaWNhdGlvbnNSZXF1ZXN0GigubWlyYWkudjEuU3Vic2NyaWJlTm90aWZpY2F0aW9uc1Jlc3BvbnNlMAESTQoMTGlzdEVtYWlsTG9nEh0ubWlyYWkudjEuTGlzdEVtYWlsTG9nUmVxdWVzdBoeLm1pcmFpLnYxLkxpc3RFbWFpbExvZ1Jlc3BvbnNlQpcBCgxjb20ubWlyYWkudjFCEU5vdGlmaWNhdGlvblByb3RvUAFaM2dpdGh1Yi5jb20vc29nb3MvbWlyYWktYmFja2VuZC9nZW4vbWlyYWkvdjE7bWlyYWl2MaICA01YWKoCCE1pcmFpLlYxygIITWlyYWlcVjHiAhRNaXJhaVxWMVxHUEJNZXRhZGF0YeoCCU1pcmFpOjpWMWIGcHJvdG8z", [file_google_protobuf_timestamp, file_mirai_v1_sme]);
 
 /**
  * Notification represents a user notification.
@@ -130,7 +132,8 @@ export const SubscribeNotificationsRequestSchema: GenMessage<SubscribeNotificati
 
 /**
  * SubscribeNotificationsResponse represents a real-time notification event.
- * Each message in the stream contains an event type and the notification payload.
+ * Each message in the stream contains an event type and the notification payload,
+ * or for SUBMISSION_PROGRESS events, the submission's progress.
  *
  * @generated from message mirai.v1.SubscribeNotificationsResponse
  */
@@ -144,6 +147,11 @@ export type SubscribeNotificationsResponse = Message<"mirai.v1.SubscribeNotifica
    * @generated from field: mirai.v1.Notification notification = 2;
    */
   notification?: Notification;
+
+  /**
+   * @generated from field: mirai.v1.SubmissionProgress submission_progress = 3;
+   */
+  submissionProgress?: SubmissionProgress;
 };
 
 /**
@@ -328,6 +336,56 @@ export type MarkAllAsReadResponse = Message<"mirai.v1.MarkAllAsReadResponse"> &
 export const MarkAllAsReadResponseSchema: GenMessage<MarkAllAsReadResponse> = /*@__PURE__*/
   messageDesc(file_mirai_v1_notification, 10);
 
+/**
+ * MarkAsReadByFilterRequest selects notifications to mark. Unset fields match all.
+ *
+ * @generated from message mirai.v1.MarkAsReadByFilterRequest
+ */
+export type MarkAsReadByFilterRequest = Message<"mirai.v1.MarkAsReadByFilterRequest"> & {
+  /**
+   * @generated from field: optional string course_id = 1;
+   */
+  courseId?: string;
+
+  /**
+   * @generated from field: optional mirai.v1.NotificationType type = 2;
+   */
+  type?: NotificationType;
+
+  /**
+   * Only notifications created before this time
+   *
+   * @generated from field: optional google.protobuf.Timestamp older_than = 3;
+   */
+  olderThan?: Timestamp;
+};
+
+/**
+ * Describes the message mirai.v1.MarkAsReadByFilterRequest.
+ * Use `create(MarkAsReadByFilterRequestSchema)` to create a new message.
+ */
+export const MarkAsReadByFilterRequestSchema: GenMessage<MarkAsReadByFilterRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_notification, 11);
+
+/**
+ * MarkAsReadByFilterResponse confirms the operation.
+ *
+ * @generated from message mirai.v1.MarkAsReadByFilterResponse
+ */
+export type MarkAsReadByFilterResponse = Message<"mirai.v1.MarkAsReadByFilterResponse"> & {
+  /**
+   * @generated from field: int32 marked_count = 1;
+   */
+  markedCount: number;
+};
+
+/**
+ * Describes the message mirai.v1.MarkAsReadByFilterResponse.
+ * Use `create(MarkAsReadByFilterResponseSchema)` to create a new message.
+ */
+export const MarkAsReadByFilterResponseSchema: GenMessage<MarkAsReadByFilterResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_notification, 12);
+
 /**
  * DeleteNotificationRequest contains the ID to delete.
  *
@@ -345,7 +403,7 @@ export type DeleteNotificationRequest = Message<"mirai.v1.DeleteNotificationRequ
  * Use `create(DeleteNotificationRequestSchema)` to create a new message.
  */
 export const DeleteNotificationRequestSchema: GenMessage<DeleteNotificationRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_notification, 11);
+  messageDesc(file_mirai_v1_notification, 13);
 
 /**
  * DeleteNotificationResponse confirms deletion.
@@ -360,7 +418,136 @@ export type DeleteNotificationResponse = Message<"mirai.v1.DeleteNotificationRes
  * Use `create(DeleteNotificationResponseSchema)` to create a new message.
  */
 export const DeleteNotificationResponseSchema: GenMessage<DeleteNotificationResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_notification, 12);
+  messageDesc(file_mirai_v1_notification, 14);
+
+/**
+ * EmailLogEntry records the outcome of one email.
+ *
+ * @generated from message mirai.v1.EmailLogEntry
+ */
+export type EmailLogEntry = Message<"mirai.v1.EmailLogEntry"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string recipient = 2;
+   */
+  recipient: string;
+
+  /**
+   * e.g. "invitation", "outline_ready"
+   *
+   * @generated from field: string template = 3;
+   */
+  template: string;
+
+  /**
+   * @generated from field: string message_id = 4;
+   */
+  messageId: string;
+
+  /**
+   * @generated from field: mirai.v1.EmailDeliveryStatus status = 5;
+   */
+  status: EmailDeliveryStatus;
+
+  /**
+   * Unset when no server answered
+   *
+   * @generated from field: optional int32 smtp_code = 6;
+   */
+  smtpCode?: number;
+
+  /**
+   * @generated from field: string smtp_response = 7;
+   */
+  smtpResponse: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp sent_at = 8;
+   */
+  sentAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp status_updated_at = 9;
+   */
+  statusUpdatedAt?: Timestamp;
+};
+
+/**
+ * Describes the message mirai.v1.EmailLogEntry.
+ * Use `create(EmailLogEntrySchema)` to create a new message.
+ */
+export const EmailLogEntrySchema: GenMessage<EmailLogEntry> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_notification, 15);
+
+/**
+ * ListEmailLogRequest contains filters and pagination.
+ *
+ * @generated from message mirai.v1.ListEmailLogRequest
+ */
+export type ListEmailLogRequest = Message<"mirai.v1.ListEmailLogRequest"> & {
+  /**
+   * Case-insensitive exact match
+   *
+   * @generated from field: optional string recipient = 1;
+   */
+  recipient?: string;
+
+  /**
+   * @generated from field: optional string template = 2;
+   */
+  template?: string;
+
+  /**
+   * Max results (default 50, max 200)
+   *
+   * @generated from field: int32 limit = 3;
+   */
+  limit: number;
+
+  /**
+   * For pagination
+   *
+   * @generated from field: optional string cursor = 4;
+   */
+  cursor?: string;
+};
+
+/**
+ * Describes the message mirai.v1.ListEmailLogRequest.
+ * Use `create(ListEmailLogRequestSchema)` to create a new message.
+ */
+export const ListEmailLogRequestSchema: GenMessage<ListEmailLogRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_notification, 16);
+
+/**
+ * ListEmailLogResponse contains a page of the email log.
+ *
+ * @generated from message mirai.v1.ListEmailLogResponse
+ */
+export type ListEmailLogResponse = Message<"mirai.v1.ListEmailLogResponse"> & {
+  /**
+   * @generated from field: repeated mirai.v1.EmailLogEntry entries = 1;
+   */
+  entries: EmailLogEntry[];
+
+  /**
+   * For pagination
+   *
+   * @generated from field: optional string next_cursor = 2;
+   */
+  nextCursor?: string;
+};
+
+/**
+ * Describes the message mirai.v1.ListEmailLogResponse.
+ * Use `create(ListEmailLogResponseSchema)` to create a new message.
+ */
+export const ListEmailLogResponseSchema: GenMessage<ListEmailLogResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_notification, 17);
 
 /**
  * NotificationType categorizes notifications.
@@ -428,6 +615,34 @@ export enum NotificationType {
    * @generated from enum value: NOTIFICATION_TYPE_APPROVAL_REQUESTED = 8;
    */
   APPROVAL_REQUESTED = 8,
+
+  /**
+   * User added as a course collaborator
+   *
+   * @generated from enum value: NOTIFICATION_TYPE_COLLABORATOR_ADDED = 9;
+   */
+  COLLABORATOR_ADDED = 9,
+
+  /**
+   * Requested export is ready to download
+   *
+   * @generated from enum value: NOTIFICATION_TYPE_EXPORT_READY = 10;
+   */
+  EXPORT_READY = 10,
+
+  /**
+   * Someone commented on a course outline lesson
+   *
+   * @generated from enum value: NOTIFICATION_TYPE_OUTLINE_COMMENT = 11;
+   */
+  OUTLINE_COMMENT = 11,
+
+  /**
+   * Generated lessons were removed from a course with their outline lessons
+   *
+   * @generated from enum value: NOTIFICATION_TYPE_LESSONS_ORPHANED = 12;
+   */
+  LESSONS_ORPHANED = 12,
 }
 
 /**
@@ -507,6 +722,13 @@ export enum NotificationEventType {
    * @generated from enum value: NOTIFICATION_EVENT_TYPE_KEEPALIVE = 4;
    */
   KEEPALIVE = 4,
+
+  /**
+   * SME submission ingestion progressed
+   *
+   * @generated from enum value: NOTIFICATION_EVENT_TYPE_SUBMISSION_PROGRESS = 5;
+   */
+  SUBMISSION_PROGRESS = 5,
 }
 
 /**
@@ -515,6 +737,66 @@ export enum NotificationEventType {
 export const NotificationEventTypeSchema: GenEnum<NotificationEventType> = /*@__PURE__*/
   enumDesc(file_mirai_v1_notification, 2);
 
+/**
+ * EmailDeliveryStatus is the outcome of sending an email.
+ *
+ * @generated from enum mirai.v1.EmailDeliveryStatus
+ */
+export enum EmailDeliveryStatus {
+  /**
+   * @generated from enum value: EMAIL_DELIVERY_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The mail server accepted the message
+   *
+   * @generated from enum value: EMAIL_DELIVERY_STATUS_ACCEPTED = 1;
+   */
+  ACCEPTED = 1,
+
+  /**
+   * Temporarily refused (SMTP 4xx)
+   *
+   * @generated from enum value: EMAIL_DELIVERY_STATUS_DEFERRED = 2;
+   */
+  DEFERRED = 2,
+
+  /**
+   * Permanently refused (SMTP 5xx); a hard bounce
+   *
+   * @generated from enum value: EMAIL_DELIVERY_STATUS_REJECTED = 3;
+   */
+  REJECTED = 3,
+
+  /**
+   * No server could be reached
+   *
+   * @generated from enum value: EMAIL_DELIVERY_STATUS_FAILED = 4;
+   */
+  FAILED = 4,
+
+  /**
+   * Delivery confirmed later by the provider
+   *
+   * @generated from enum value: EMAIL_DELIVERY_STATUS_DELIVERED = 5;
+   */
+  DELIVERED = 5,
+
+  /**
+   * Bounced after acceptance, reported by the provider
+   *
+   * @generated from enum value: EMAIL_DELIVERY_STATUS_BOUNCED = 6;
+   */
+  BOUNCED = 6,
+}
+
+/**
+ * Describes the enum mirai.v1.EmailDeliveryStatus.
+ */
+export const EmailDeliveryStatusSchema: GenEnum<EmailDeliveryStatus> = /*@__PURE__*/
+  enumDesc(file_mirai_v1_notification, 3);
+
 /**
  * NotificationService handles notification operations.
  *
@@ -561,6 +843,16 @@ export const NotificationService: GenService<{
     input: typeof MarkAllAsReadRequestSchema;
     output: typeof MarkAllAsReadResponseSchema;
   },
+  /**
+   * MarkAsReadByFilter marks all notifications matching a filter as read.
+   *
+   * @generated from rpc mirai.v1.NotificationService.MarkAsReadByFilter
+   */
+  markAsReadByFilter: {
+    methodKind: "unary";
+    input: typeof MarkAsReadByFilterRequestSchema;
+    output: typeof MarkAsReadByFilterResponseSchema;
+  },
   /**
    * DeleteNotification deletes a notification.
    *
@@ -582,6 +874,17 @@ export const NotificationService: GenService<{
     input: typeof SubscribeNotificationsRequestSchema;
     output: typeof SubscribeNotificationsResponseSchema;
   },
+  /**
+   * ListEmailLog returns the outcomes of emails sent for the tenant, most recent first.
+   * Requires ADMIN or OWNER role.
+   *
+   * @generated from rpc mirai.v1.NotificationService.ListEmailLog
+   */
+  listEmailLog: {
+    methodKind: "unary";
+    input: typeof ListEmailLogRequestSchema;
+    output: typeof ListEmailLogResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_mirai_v1_notification, 0);
 
@@ -33,12 +33,21 @@ export const listSMEs = SMEService.method.listSMEs;
 export const updateSME = SMEService.method.updateSME;
 
 /**
- * DeleteSME archives an SME entity (soft delete).
+ * DeleteSME archives an SME entity (soft delete). Requires a confirmation token
+ * from GetSMEDeletionImpact.
  *
  * @generated from rpc mirai.v1.SMEService.DeleteSME
  */
 export const deleteSME = SMEService.method.deleteSME;
 
+/**
+ * GetSMEDeletionImpact counts what depends on an SME and issues a confirmation
+ * token for DeleteSME, valid for 10 minutes.
+ *
+ * @generated from rpc mirai.v1.SMEService.GetSMEDeletionImpact
+ */
+export const getSMEDeletionImpact = SMEService.method.getSMEDeletionImpact;
+
 /**
  * RestoreSME restores an archived SME entity.
  *
@@ -88,6 +97,27 @@ export const cancelTask = SMEService.method.cancelTask;
  */
 export const getUploadURL = SMEService.method.getUploadURL;
 
+/**
+ * StartMultipartUpload starts a resumable upload for large files.
+ *
+ * @generated from rpc mirai.v1.SMEService.StartMultipartUpload
+ */
+export const startMultipartUpload = SMEService.method.startMultipartUpload;
+
+/**
+ * GetPartUploadURLs returns presigned URLs for parts of a multipart upload.
+ *
+ * @generated from rpc mirai.v1.SMEService.GetPartUploadURLs
+ */
+export const getPartUploadURLs = SMEService.method.getPartUploadURLs;
+
+/**
+ * CompleteMultipartUpload validates the uploaded parts and assembles the file.
+ *
+ * @generated from rpc mirai.v1.SMEService.CompleteMultipartUpload
+ */
+export const completeMultipartUpload = SMEService.method.completeMultipartUpload;
+
 /**
  * SubmitContent records a content submission for a task.
  *
@@ -96,19 +126,42 @@ export const getUploadURL = SMEService.method.getUploadURL;
 export const submitContent = SMEService.method.submitContent;
 
 /**
- * ListSubmissions returns all submissions for a task.
+ * GenerateTaskQuestions writes 8-12 interview questions for an SME about a course goal.
+ * Nothing is saved; send the edited questions with CreateTask.
+ *
+ * @generated from rpc mirai.v1.SMEService.GenerateTaskQuestions
+ */
+export const generateTaskQuestions = SMEService.method.generateTaskQuestions;
+
+/**
+ * SubmitAnswers records answers to a task's interview questions as a text submission,
+ * which is reviewed and ingested like uploaded content.
+ *
+ * @generated from rpc mirai.v1.SMEService.SubmitAnswers
+ */
+export const submitAnswers = SMEService.method.submitAnswers;
+
+/**
+ * ListSubmissions returns a task's submissions, newest first, with optional status filter.
  *
  * @generated from rpc mirai.v1.SMEService.ListSubmissions
  */
 export const listSubmissions = SMEService.method.listSubmissions;
 
 /**
- * GetKnowledge returns distilled knowledge for an SME.
+ * GetKnowledge returns distilled knowledge for an SME, optionally for one topic.
  *
  * @generated from rpc mirai.v1.SMEService.GetKnowledge
  */
 export const getKnowledge = SMEService.method.getKnowledge;
 
+/**
+ * ListKnowledgeTopics returns an SME's knowledge topics with chunk counts.
+ *
+ * @generated from rpc mirai.v1.SMEService.ListKnowledgeTopics
+ */
+export const listKnowledgeTopics = SMEService.method.listKnowledgeTopics;
+
 /**
  * SearchKnowledge searches across SME knowledge.
  *
@@ -116,6 +169,14 @@ export const getKnowledge = SMEService.method.getKnowledge;
  */
 export const searchKnowledge = SMEService.method.searchKnowledge;
 
+/**
+ * SuggestSMEs suggests up to 5 active SMEs the user can access for a course goal, by
+ * how well their domain, summary and knowledge keywords match its key terms.
+ *
+ * @generated from rpc mirai.v1.SMEService.SuggestSMEs
+ */
+export const suggestSMEs = SMEService.method.suggestSMEs;
+
 /**
  * GetSubmission returns a specific submission by ID.
  *
@@ -144,6 +205,21 @@ export const requestSubmissionChanges = SMEService.method.requestSubmissionChang
  */
 export const enhanceSubmissionContent = SMEService.method.enhanceSubmissionContent;
 
+/**
+ * ReprocessSubmission retries ingestion of a failed submission, optionally with a replacement file.
+ *
+ * @generated from rpc mirai.v1.SMEService.ReprocessSubmission
+ */
+export const reprocessSubmission = SMEService.method.reprocessSubmission;
+
+/**
+ * GetSubmissionStatus returns how far a submission's ingestion has got. Progress is
+ * also pushed as SUBMISSION_PROGRESS events on SubscribeNotifications.
+ *
+ * @generated from rpc mirai.v1.SMEService.GetSubmissionStatus
+ */
+export const getSubmissionStatus = SMEService.method.getSubmissionStatus;
+
 /**
  * UpdateKnowledgeChunk updates a knowledge chunk's content.
  *
@@ -158,9 +234,48 @@ export const updateKnowledgeChunk = SMEService.method.updateKnowledgeChunk;
  */
 export const deleteKnowledgeChunk = SMEService.method.deleteKnowledgeChunk;
 
+/**
+ * ReviewFlaggedKnowledgeChunk releases a chunk flagged as possible prompt injection
+ * into generation, or holds it back again. Admin only.
+ *
+ * @generated from rpc mirai.v1.SMEService.ReviewFlaggedKnowledgeChunk
+ */
+export const reviewFlaggedKnowledgeChunk = SMEService.method.reviewFlaggedKnowledgeChunk;
+
 /**
  * DeleteTask permanently removes a task.
  *
  * @generated from rpc mirai.v1.SMEService.DeleteTask
  */
 export const deleteTask = SMEService.method.deleteTask;
+
+/**
+ * GetSMEStats returns contribution and knowledge coverage stats per SME.
+ *
+ * @generated from rpc mirai.v1.SMEService.GetSMEStats
+ */
+export const getSMEStats = SMEService.method.getSMEStats;
+
+/**
+ * ExportSMEKnowledge queues a job that writes the SME's profile, summary, knowledge
+ * chunks and submission provenance to a JSON Lines archive. The requester is sent a
+ * download link when it finishes.
+ *
+ * @generated from rpc mirai.v1.SMEService.ExportSMEKnowledge
+ */
+export const exportSMEKnowledge = SMEService.method.exportSMEKnowledge;
+
+/**
+ * GetKnowledgeImportUploadURL returns a presigned URL for uploading an archive to import.
+ *
+ * @generated from rpc mirai.v1.SMEService.GetKnowledgeImportUploadURL
+ */
+export const getKnowledgeImportUploadURL = SMEService.method.getKnowledgeImportUploadURL;
+
+/**
+ * ImportSMEKnowledge queues a job that imports an uploaded archive's chunks, skipping
+ * duplicates, and regenerates the SME's knowledge summary.
+ *
+ * @generated from rpc mirai.v1.SMEService.ImportSMEKnowledge
+ */
+export const importSMEKnowledge = SMEService.method.importSMEKnowledge;
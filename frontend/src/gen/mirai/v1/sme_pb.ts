@@ -12,7 +12,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file mirai/v1/sme.proto.
  */
 export const file_mirai_v1_sme: GenFile = /*@__PURE__*/
-  fileDesc("ChJtaXJhaS92MS9zbWUucHJvdG8SCG1pcmFpLnYxIscDChNTdWJqZWN0TWF0dGVyRXhwZXJ0EgoKAmlkGAEgASgJEhEKCXRlbmFudF9pZBgCIAEoCRISCgpjb21wYW55X2lkGAMgASgJEgwKBG5hbWUYBCABKAkSEwoLZGVzY3JpcHRpb24YBSABKAkSDgoGZG9tYWluGAYgASgJEiEKBXNjb3BlGAcgASgOMhIubWlyYWkudjEuU01FU2NvcGUSEAoIdGVhbV9pZHMYCCADKAkSIwoGc3RhdHVzGAkgASgOMhMubWlyYWkudjEuU01FU3RhdHVzEh4KEWtub3dsZWRnZV9zdW1tYXJ5GAogASgJSACIAQESIwoWa25vd2xlZGdlX2NvbnRlbnRfcGF0aBgLIAEoCUgBiAEBEhoKEmNyZWF0ZWRfYnlfdXNlcl9pZBgMIAEoCRIuCgpjcmVhdGVkX2F0GA0gASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GA4gASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEIUChJfa25vd2xlZGdlX3N1bW1hcnlCGQoXX2tub3dsZWRnZV9jb250ZW50X3BhdGgi/wMKB1NNRVRhc2sSCgoCaWQYASABKAkSEQoJdGVuYW50X2lkGAIgASgJEg4KBnNtZV9pZBgDIAEoCRINCgV0aXRsZRgEIAEoCRITCgtkZXNjcmlwdGlvbhgFIAEoCRI0ChVleHBlY3RlZF9jb250ZW50X3R5cGUYBiABKA4yFS5taXJhaS52MS5Db250ZW50VHlwZRIbChNhc3NpZ25lZF90b191c2VyX2lkGAcgASgJEhsKE2Fzc2lnbmVkX2J5X3VzZXJfaWQYCCABKAkSFAoHdGVhbV9pZBgJIAEoCUgAiAEBEicKBnN0YXR1cxgKIAEoDjIXLm1pcmFpLnYxLlNNRVRhc2tTdGF0dXMSMQoIZHVlX2RhdGUYCyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSAGIAQESLgoKY3JlYXRlZF9hdBgMIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgNIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASNQoMY29tcGxldGVkX2F0GA4gASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgCiAEBQgoKCF90ZWFtX2lkQgsKCV9kdWVfZGF0ZUIPCg1fY29tcGxldGVkX2F0IsoFChFTTUVUYXNrU3VibWlzc2lvbhIKCgJpZBgBIAEoCRIRCgl0ZW5hbnRfaWQYAiABKAkSDwoHdGFza19pZBgDIAEoCRIRCglmaWxlX25hbWUYBCABKAkSEQoJZmlsZV9wYXRoGAUgASgJEisKDGNvbnRlbnRfdHlwZRgGIAEoDjIVLm1pcmFpLnYxLkNvbnRlbnRUeXBlEhcKD2ZpbGVfc2l6ZV9ieXRlcxgHIAEoAxIbCg5leHRyYWN0ZWRfdGV4dBgIIAEoCUgAiAEBEhcKCmFpX3N1bW1hcnkYCSABKAlIAYgBARIcCg9pbmdlc3Rpb25fZXJyb3IYCiABKAlIAogBARIcChRzdWJtaXR0ZWRfYnlfdXNlcl9pZBgLIAEoCRIwCgxzdWJtaXR0ZWRfYXQYDCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjUKDHByb2Nlc3NlZF9hdBgNIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIA4gBARIbCg5yZXZpZXdlcl9ub3RlcxgOIAEoCUgEiAEBEh0KEGFwcHJvdmVkX2NvbnRlbnQYDyABKAlIBYgBARITCgtpc19hcHByb3ZlZBgQIAEoCBI0CgthcHByb3ZlZF9hdBgRIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIBogBARIgChNhcHByb3ZlZF9ieV91c2VyX2lkGBIgASgJSAeIAQFCEQoPX2V4dHJhY3RlZF90ZXh0Qg0KC19haV9zdW1tYXJ5QhIKEF9pbmdlc3Rpb25fZXJyb3JCDwoNX3Byb2Nlc3NlZF9hdEIRCg9fcmV2aWV3ZXJfbm90ZXNCEwoRX2FwcHJvdmVkX2NvbnRlbnRCDgoMX2FwcHJvdmVkX2F0QhYKFF9hcHByb3ZlZF9ieV91c2VyX2lkItgBChFTTUVLbm93bGVkZ2VDaHVuaxIKCgJpZBgBIAEoCRIOCgZzbWVfaWQYAiABKAkSGgoNc3VibWlzc2lvbl9pZBgDIAEoCUgAiAEBEg8KB2NvbnRlbnQYBCABKAkSDQoFdG9waWMYBSABKAkSEAoIa2V5d29yZHMYBiADKAkSFwoPcmVsZXZhbmNlX3Njb3JlGAcgASgCEi4KCmNyZWF0ZWRfYXQYCCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQhAKDl9zdWJtaXNzaW9uX2lkInoKEENyZWF0ZVNNRVJlcXVlc3QSDAoEbmFtZRgBIAEoCRITCgtkZXNjcmlwdGlvbhgCIAEoCRIOCgZkb21haW4YAyABKAkSIQoFc2NvcGUYBCABKA4yEi5taXJhaS52MS5TTUVTY29wZRIQCgh0ZWFtX2lkcxgFIAMoCSI/ChFDcmVhdGVTTUVSZXNwb25zZRIqCgNzbWUYASABKAsyHS5taXJhaS52MS5TdWJqZWN0TWF0dGVyRXhwZXJ0Ih8KDUdldFNNRVJlcXVlc3QSDgoGc21lX2lkGAEgASgJIjwKDkdldFNNRVJlc3BvbnNlEioKA3NtZRgBIAEoCzIdLm1pcmFpLnYxLlN1YmplY3RNYXR0ZXJFeHBlcnQizgEKD0xpc3RTTUVzUmVxdWVzdBImCgVzY29wZRgBIAEoDjISLm1pcmFpLnYxLlNNRVNjb3BlSACIAQESKAoGc3RhdHVzGAIgASgOMhMubWlyYWkudjEuU01FU3RhdHVzSAGIAQESFAoHdGVhbV9pZBgDIAEoCUgCiAEBEh0KEGluY2x1ZGVfYXJjaGl2ZWQYBCABKAhIA4gBAUIICgZfc2NvcGVCCQoHX3N0YXR1c0IKCghfdGVhbV9pZEITChFfaW5jbHVkZV9hcmNoaXZlZCI/ChBMaXN0U01Fc1Jlc3BvbnNlEisKBHNtZXMYASADKAsyHS5taXJhaS52MS5TdWJqZWN0TWF0dGVyRXhwZXJ0IoECChBVcGRhdGVTTUVSZXF1ZXN0Eg4KBnNtZV9pZBgBIAEoCRIRCgRuYW1lGAIgASgJSACIAQESGAoLZGVzY3JpcHRpb24YAyABKAlIAYgBARITCgZkb21haW4YBCABKAlIAogBARImCgVzY29wZRgFIAEoDjISLm1pcmFpLnYxLlNNRVNjb3BlSAOIAQESEAoIdGVhbV9pZHMYBiADKAkSKAoGc3RhdHVzGAcgASgOMhMubWlyYWkudjEuU01FU3RhdHVzSASIAQFCBwoFX25hbWVCDgoMX2Rlc2NyaXB0aW9uQgkKB19kb21haW5CCAoGX3Njb3BlQgkKB19zdGF0dXMiPwoRVXBkYXRlU01FUmVzcG9uc2USKgoDc21lGAEgASgLMh0ubWlyYWkudjEuU3ViamVjdE1hdHRlckV4cGVydCIiChBEZWxldGVTTUVSZXF1ZXN0Eg4KBnNtZV9pZBgBIAEoCSITChFEZWxldGVTTUVSZXNwb25zZSIjChFSZXN0b3JlU01FUmVxdWVzdBIOCgZzbWVfaWQYASABKAkiQAoSUmVzdG9yZVNNRVJlc3BvbnNlEioKA3NtZRgBIAEoCzIdLm1pcmFpLnYxLlN1YmplY3RNYXR0ZXJFeHBlcnQi/AEKEUNyZWF0ZVRhc2tSZXF1ZXN0Eg4KBnNtZV9pZBgBIAEoCRINCgV0aXRsZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRI0ChVleHBlY3RlZF9jb250ZW50X3R5cGUYBCABKA4yFS5taXJhaS52MS5Db250ZW50VHlwZRIbChNhc3NpZ25lZF90b191c2VyX2lkGAUgASgJEhQKB3RlYW1faWQYBiABKAlIAIgBARIxCghkdWVfZGF0ZRgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIAYgBAUIKCghfdGVhbV9pZEILCglfZHVlX2RhdGUiNQoSQ3JlYXRlVGFza1Jlc3BvbnNlEh8KBHRhc2sYASABKAsyES5taXJhaS52MS5TTUVUYXNrIiEKDkdldFRhc2tSZXF1ZXN0Eg8KB3Rhc2tfaWQYASABKAkiMgoPR2V0VGFza1Jlc3BvbnNlEh8KBHRhc2sYASABKAsyES5taXJhaS52MS5TTUVUYXNrIqUBChBMaXN0VGFza3NSZXF1ZXN0EhMKBnNtZV9pZBgBIAEoCUgAiAEBEiAKE2Fzc2lnbmVkX3RvX3VzZXJfaWQYAiABKAlIAYgBARIsCgZzdGF0dXMYAyABKA4yFy5taXJhaS52MS5TTUVUYXNrU3RhdHVzSAKIAQFCCQoHX3NtZV9pZEIWChRfYXNzaWduZWRfdG9fdXNlcl9pZEIJCgdfc3RhdHVzIjUKEUxpc3RUYXNrc1Jlc3BvbnNlEiAKBXRhc2tzGAEgAygLMhEubWlyYWkudjEuU01FVGFzayKBAgoRVXBkYXRlVGFza1JlcXVlc3QSDwoHdGFza19pZBgBIAEoCRISCgV0aXRsZRgCIAEoCUgAiAEBEhgKC2Rlc2NyaXB0aW9uGAMgASgJSAGIAQESOQoVZXhwZWN0ZWRfY29udGVudF90eXBlGAQgASgOMhUubWlyYWkudjEuQ29udGVudFR5cGVIAogBARIxCghkdWVfZGF0ZRgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIA4gBAUIICgZfdGl0bGVCDgoMX2Rlc2NyaXB0aW9uQhgKFl9leHBlY3RlZF9jb250ZW50X3R5cGVCCwoJX2R1ZV9kYXRlIjUKElVwZGF0ZVRhc2tSZXNwb25zZRIfCgR0YXNrGAEgASgLMhEubWlyYWkudjEuU01FVGFzayIkChFDYW5jZWxUYXNrUmVxdWVzdBIPCgd0YXNrX2lkGAEgASgJIjUKEkNhbmNlbFRhc2tSZXNwb25zZRIfCgR0YXNrGAEgASgLMhEubWlyYWkudjEuU01FVGFzayJ/ChNHZXRVcGxvYWRVUkxSZXF1ZXN0Eg8KB3Rhc2tfaWQYASABKAkSEQoJZmlsZV9uYW1lGAIgASgJEisKDGNvbnRlbnRfdHlwZRgDIAEoDjIVLm1pcmFpLnYxLkNvbnRlbnRUeXBlEhcKD2ZpbGVfc2l6ZV9ieXRlcxgEIAEoAyJtChRHZXRVcGxvYWRVUkxSZXNwb25zZRISCgp1cGxvYWRfdXJsGAEgASgJEhEKCWZpbGVfcGF0aBgCIAEoCRIuCgpleHBpcmVzX2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCK/AQoUU3VibWl0Q29udGVudFJlcXVlc3QSDwoHdGFza19pZBgBIAEoCRIRCglmaWxlX25hbWUYAiABKAkSEQoJZmlsZV9wYXRoGAMgASgJEisKDGNvbnRlbnRfdHlwZRgEIAEoDjIVLm1pcmFpLnYxLkNvbnRlbnRUeXBlEhcKD2ZpbGVfc2l6ZV9ieXRlcxgFIAEoAxIZCgx0ZXh0X2NvbnRlbnQYBiABKAlIAIgBAUIPCg1fdGV4dF9jb250ZW50IkgKFVN1Ym1pdENvbnRlbnRSZXNwb25zZRIvCgpzdWJtaXNzaW9uGAEgASgLMhsubWlyYWkudjEuU01FVGFza1N1Ym1pc3Npb24iKQoWTGlzdFN1Ym1pc3Npb25zUmVxdWVzdBIPCgd0YXNrX2lkGAEgASgJIksKF0xpc3RTdWJtaXNzaW9uc1Jlc3BvbnNlEjAKC3N1Ym1pc3Npb25zGAEgAygLMhsubWlyYWkudjEuU01FVGFza1N1Ym1pc3Npb24iJQoTR2V0S25vd2xlZGdlUmVxdWVzdBIOCgZzbWVfaWQYASABKAkibwoUR2V0S25vd2xlZGdlUmVzcG9uc2USKgoDc21lGAEgASgLMh0ubWlyYWkudjEuU3ViamVjdE1hdHRlckV4cGVydBIrCgZjaHVua3MYAiADKAsyGy5taXJhaS52MS5TTUVLbm93bGVkZ2VDaHVuayJHChZTZWFyY2hLbm93bGVkZ2VSZXF1ZXN0Eg8KB3NtZV9pZHMYASADKAkSDQoFcXVlcnkYAiABKAkSDQoFbGltaXQYAyABKAUiRgoXU2VhcmNoS25vd2xlZGdlUmVzcG9uc2USKwoGY2h1bmtzGAEgAygLMhsubWlyYWkudjEuU01FS25vd2xlZGdlQ2h1bmsiLQoUR2V0U3VibWlzc2lvblJlcXVlc3QSFQoNc3VibWlzc2lvbl9pZBgBIAEoCSJIChVHZXRTdWJtaXNzaW9uUmVzcG9uc2USLwoKc3VibWlzc2lvbhgBIAEoCzIbLm1pcmFpLnYxLlNNRVRhc2tTdWJtaXNzaW9uIksKGEFwcHJvdmVTdWJtaXNzaW9uUmVxdWVzdBIVCg1zdWJtaXNzaW9uX2lkGAEgASgJEhgKEGFwcHJvdmVkX2NvbnRlbnQYAiABKAkigQEKGUFwcHJvdmVTdWJtaXNzaW9uUmVzcG9uc2USLwoKc3VibWlzc2lvbhgBIAEoCzIbLm1pcmFpLnYxLlNNRVRhc2tTdWJtaXNzaW9uEjMKDmNyZWF0ZWRfY2h1bmtzGAIgAygLMhsubWlyYWkudjEuU01FS25vd2xlZGdlQ2h1bmsiSgofUmVxdWVzdFN1Ym1pc3Npb25DaGFuZ2VzUmVxdWVzdBIVCg1zdWJtaXNzaW9uX2lkGAEgASgJEhAKCGZlZWRiYWNrGAIgASgJIlMKIFJlcXVlc3RTdWJtaXNzaW9uQ2hhbmdlc1Jlc3BvbnNlEi8KCnN1Ym1pc3Npb24YASABKAsyGy5taXJhaS52MS5TTUVUYXNrU3VibWlzc2lvbiJlCh9FbmhhbmNlU3VibWlzc2lvbkNvbnRlbnRSZXF1ZXN0EhUKDXN1Ym1pc3Npb25faWQYASABKAkSKwoMZW5oYW5jZV90eXBlGAIgASgOMhUubWlyYWkudjEuRW5oYW5jZVR5cGUiVgogRW5oYW5jZVN1Ym1pc3Npb25Db250ZW50UmVzcG9uc2USGAoQZW5oYW5jZWRfY29udGVudBgBIAEoCRIYChBvcmlnaW5hbF9jb250ZW50GAIgASgJInAKG1VwZGF0ZUtub3dsZWRnZUNodW5rUmVxdWVzdBIQCghjaHVua19pZBgBIAEoCRIPCgdjb250ZW50GAIgASgJEhIKBXRvcGljGAMgASgJSACIAQESEAoIa2V5d29yZHMYBCADKAlCCAoGX3RvcGljIkoKHFVwZGF0ZUtub3dsZWRnZUNodW5rUmVzcG9uc2USKgoFY2h1bmsYASABKAsyGy5taXJhaS52MS5TTUVLbm93bGVkZ2VDaHVuayIvChtEZWxldGVLbm93bGVkZ2VDaHVua1JlcXVlc3QSEAoIY2h1bmtfaWQYASABKAkiHgocRGVsZXRlS25vd2xlZGdlQ2h1bmtSZXNwb25zZSIkChFEZWxldGVUYXNrUmVxdWVzdBIPCgd0YXNrX2lkGAEgASgJIhQKEkRlbGV0ZVRhc2tSZXNwb25zZSpPCghTTUVTY29wZRIZChVTTUVfU0NPUEVfVU5TUEVDSUZJRUQQABIUChBTTUVfU0NPUEVfR0xPQkFMEAESEgoOU01FX1NDT1BFX1RFQU0QAiqHAQoJU01FU3RhdHVzEhoKFlNNRV9TVEFUVVNfVU5TUEVDSUZJRUQQABIUChBTTUVfU1RBVFVTX0RSQUZUEAESGAoUU01FX1NUQVRVU19JTkdFU1RJTkcQAhIVChFTTUVfU1RBVFVTX0FDVElWRRADEhcKE1NNRV9TVEFUVVNfQVJDSElWRUQQBCqyAgoNU01FVGFza1N0YXR1cxIfChtTTUVfVEFTS19TVEFUVVNfVU5TUEVDSUZJRUQQABIbChdTTUVfVEFTS19TVEFUVVNfUEVORElORxABEh0KGVNNRV9UQVNLX1NUQVRVU19TVUJNSVRURUQQAhIeChpTTUVfVEFTS19TVEFUVVNfUFJPQ0VTU0lORxADEh0KGVNNRV9UQVNLX1NUQVRVU19DT01QTEVURUQQBBIaChZTTUVfVEFTS19TVEFUVVNfRkFJTEVEEAUSHQoZU01FX1RBU0tfU1RBVFVTX0NBTkNFTExFRBAGEiMKH1NNRV9UQVNLX1NUQVRVU19BV0FJVElOR19SRVZJRVcQBxIlCiFTTUVfVEFTS19TVEFUVVNfQ0hBTkdFU19SRVFVRVNURUQQCCphCgtFbmhhbmNlVHlwZRIcChhFTkhBTkNFX1RZUEVfVU5TUEVDSUZJRUQQABIaChZFTkhBTkNFX1RZUEVfU1VNTUFSSVpFEAESGAoURU5IQU5DRV9UWVBFX0lNUFJPVkUQAiq7AQoLQ29udGVudFR5cGUSHAoYQ09OVEVOVF9UWVBFX1VOU1BFQ0lGSUVEEAASGQoVQ09OVEVOVF9UWVBFX0RPQ1VNRU5UEAESFgoSQ09OVEVOVF9UWVBFX0lNQUdFEAISFgoSQ09OVEVOVF9UWVBFX1ZJREVPEAMSFgoSQ09OVEVOVF9UWVBFX0FVRElPEAQSFAoQQ09OVEVOVF9UWVBFX1VSTBAFEhUKEUNPTlRFTlRfVFlQRV9URVhUEAYy1Q4KClNNRVNlcnZpY2USRAoJQ3JlYXRlU01FEhoubWlyYWkudjEuQ3JlYXRlU01FUmVxdWVzdBobLm1pcmFpLnYxLkNyZWF0ZVNNRVJlc3BvbnNlEjsKBkdldFNNRRIXLm1pcmFpLnYxLkdldFNNRVJlcXVlc3QaGC5taXJhaS52MS5HZXRTTUVSZXNwb25zZRJBCghMaXN0U01FcxIZLm1pcmFpLnYxLkxpc3RTTUVzUmVxdWVzdBoaLm1pcmFpLnYxLkxpc3RTTUVzUmVzcG9uc2USRAoJVXBkYXRlU01FEhoubWlyYWkudjEuVXBkYXRlU01FUmVxdWVzdBobLm1pcmFpLnYxLlVwZGF0ZVNNRVJlc3BvbnNlEkQKCURlbGV0ZVNNRRIaLm1pcmFpLnYxLkRlbGV0ZVNNRVJlcXVlc3QaGy5taXJhaS52MS5EZWxldGVTTUVSZXNwb25zZRJHCgpSZXN0b3JlU01FEhsubWlyYWkudjEuUmVzdG9yZVNNRVJlcXVlc3QaHC5taXJhaS52MS5SZXN0b3JlU01FUmVzcG9uc2USRwoKQ3JlYXRlVGFzaxIbLm1pcmFpLnYxLkNyZWF0ZVRhc2tSZXF1ZXN0GhwubWlyYWkudjEuQ3JlYXRlVGFza1Jlc3BvbnNlEj4KB0dldFRhc2sSGC5taXJhaS52MS5HZXRUYXNrUmVxdWVzdBoZLm1pcmFpLnYxLkdldFRhc2tSZXNwb25zZRJECglMaXN0VGFza3MSGi5taXJhaS52MS5MaXN0VGFza3NSZXF1ZXN0GhsubWlyYWkudjEuTGlzdFRhc2tzUmVzcG9uc2USRwoKVXBkYXRlVGFzaxIbLm1pcmFpLnYxLlVwZGF0ZVRhc2tSZXF1ZXN0GhwubWlyYWkudjEuVXBkYXRlVGFza1Jlc3BvbnNlEkcKCkNhbmNlbFRhc2sSGy5taXJhaS52MS5DYW5jZWxUYXNrUmVxdWVzdBocLm1pcmFpLnYxLkNhbmNlbFRhc2tSZXNwb25zZRJNCgxHZXRVcGxvYWRVUkwSHS5taXJhaS52MS5HZXRVcGxvYWRVUkxSZXF1ZXN0Gh4ubWlyYWkudjEuR2V0VXBsb2FkVVJMUmVzcG9uc2USUAoNU3VibWl0Q29udGVudBIeLm1pcmFpLnYxLlN1Ym1pdENvbnRlbnRSZXF1ZXN0Gh8ubWlyYWkudjEuU3VibWl0Q29udGVudFJlc3BvbnNlElYKD0xpc3RTdWJtaXNzaW9ucxIgLm1pcmFpLnYxLkxpc3RTdWJtaXNzaW9uc1JlcXVlc3QaIS5taXJhaS52MS5MaXN0U3VibWlzc2lvbnNSZXNwb25zZRJNCgxHZXRLbm93bGVkZ2USHS5taXJhaS52MS5HZXRLbm93bGVkZ2VSZXF1ZXN0Gh4ubWlyYWkudjEuR2V0S25vd2xlZGdlUmVzcG9uc2USVgoPU2VhcmNoS25vd2xlZGdlEiAubWlyYWkudjEuU2VhcmNoS25vd2xlZGdlUmVxdWVzdBohLm1pcmFpLnYxLlNlYXJjaEtub3dsZWRnZVJlc3BvbnNlElAKDUdldFN1Ym1pc3Npb24SHi5taXJhaS52MS5HZXRTdWJtaXNzaW9uUmVxdWVzdBofLm1pcmFpLnYxLkdldFN1Ym1pc3Npb25SZXNwb25zZRJcChFBcHByb3ZlU3VibWlzc2lvbhIiLm1pcmFpLnYxLkFwcHJvdmVTdWJtaXNzaW9uUmVxdWVzdBojLm1pcmFpLnYxLkFwcHJvdmVTdWJtaXNzaW9uUmVzcG9uc2UScQoYUmVxdWVzdFN1Ym1pc3Npb25DaGFuZ2VzEikubWlyYWkudjEuUmVxdWVzdFN1Ym1pc3Npb25DaGFuZ2VzUmVxdWVzdBoqLm1pcmFpLnYxLlJlcXVlc3RTdWJtaXNzaW9uQ2hhbmdlc1Jlc3BvbnNlEnEKGEVuaGFuY2VTdWJtaXNzaW9uQ29udGVudBIpLm1pcmFpLnYxLkVuaGFuY2VTdWJtaXNzaW9uQ29udGVudFJlcXVlc3QaKi5taXJhaS52MS5FbmhhbmNlU3VibWlzc2lvbkNvbnRlbnRSZXNwb25zZRJlChRVcGRhdGVLbm93bGVkZ2VDaHVuaxIlLm1pcmFpLnYxLlVwZGF0ZUtub3dsZWRnZUNodW5rUmVxdWVzdBomLm1pcmFpLnYxLlVwZGF0ZUtub3dsZWRnZUNodW5rUmVzcG9uc2USZQoURGVsZXRlS25vd2xlZGdlQ2h1bmsSJS5taXJhaS52MS5EZWxldGVLbm93bGVkZ2VDaHVua1JlcXVlc3QaJi5taXJhaS52MS5EZWxldGVLbm93bGVkZ2VDaHVua1Jlc3BvbnNlEkcKCkRlbGV0ZVRhc2sSGy5taXJhaS52MS5EZWxldGVUYXNrUmVxdWVzdBocLm1pcmFpLnYxLkRlbGV0ZVRhc2tSZXNwb25zZUKOAQoMY29tLm1pcmFpLnYxQghTbWVQcm90b1ABWjNnaXRodWIuY29tL3NvZ29zL21pcmFpLWJhY2tlbmQvZ2VuL21pcmFpL3YxO21pcmFpdjGiAgNNWFiqAghNaXJhaS5WMcoCCE1pcmFpXFYx4gIUTWlyYWlcVjFcR1BCTWV0YWRhdGHqAglNaXJhaTo6VjFiBnByb3RvMw", [file_google_protobuf_timestamp]);
+  fileDesc("ChJtaXJhaS92MS9zbWUucHJvdG8SCG1pcmFpLnYxIuIDChNTdWJqZWN0TWF0dGVyRXhwZXJ0EgoKAmlkGAEgASgJEhEKCXRlbmFudF9pZBgCIAEoCRISCgpjb21wYW55X2lkGAMgASgJEgwKBG5hbWUYBCABKAkSEwoLZGVzY3JpcHRpb24YBSABKAkSDgoGZG9tYWluGAYgASgJEiEKBXNjb3BlGAcgASgOMhIubWlyYWkudjEuU01FU2NvcGUSEAoIdGVhbV9pZHMYCCADKAkSIwoGc3RhdHVzGAkgASgOMhMubWlyYWkudjEuU01FU3RhdHVzEh4KEWtub3dsZWRnZV9zdW1tYXJ5GAogASgJSACIAQESIwoWa25vd2xlZGdlX2NvbnRlbnRfcGF0aBgLIAEoCUgBiAEBEhoKEmNyZWF0ZWRfYnlfdXNlcl9pZBgMIAEoCRIuCgpjcmVhdGVkX2F0GA0gASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GA4gASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIZChFjcmVhdGVkX2J5X2FjdGl2ZRgPIAEoCEIUChJfa25vd2xlZGdlX3N1bW1hcnlCGQoXX2tub3dsZWRnZV9jb250ZW50X3BhdGgikgQKB1NNRVRhc2sSCgoCaWQYASABKAkSEQoJdGVuYW50X2lkGAIgASgJEg4KBnNtZV9pZBgDIAEoCRINCgV0aXRsZRgEIAEoCRITCgtkZXNjcmlwdGlvbhgFIAEoCRI0ChVleHBlY3RlZF9jb250ZW50X3R5cGUYBiABKA4yFS5taXJhaS52MS5Db250ZW50VHlwZRIbChNhc3NpZ25lZF90b191c2VyX2lkGAcgASgJEhsKE2Fzc2lnbmVkX2J5X3VzZXJfaWQYCCABKAkSFAoHdGVhbV9pZBgJIAEoCUgAiAEBEicKBnN0YXR1cxgKIAEoDjIXLm1pcmFpLnYxLlNNRVRhc2tTdGF0dXMSMQoIZHVlX2RhdGUYCyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSAGIAQESLgoKY3JlYXRlZF9hdBgMIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgNIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASNQoMY29tcGxldGVkX2F0GA4gASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgCiAEBEhEKCXF1ZXN0aW9ucxgPIAMoCUIKCghfdGVhbV9pZEILCglfZHVlX2RhdGVCDwoNX2NvbXBsZXRlZF9hdCL2BQoRU01FVGFza1N1Ym1pc3Npb24SCgoCaWQYASABKAkSEQoJdGVuYW50X2lkGAIgASgJEg8KB3Rhc2tfaWQYAyABKAkSEQoJZmlsZV9uYW1lGAQgASgJEhEKCWZpbGVfcGF0aBgFIAEoCRIrCgxjb250ZW50X3R5cGUYBiABKA4yFS5taXJhaS52MS5Db250ZW50VHlwZRIXCg9maWxlX3NpemVfYnl0ZXMYByABKAMSGwoOZXh0cmFjdGVkX3RleHQYCCABKAlIAIgBARIXCgphaV9zdW1tYXJ5GAkgASgJSAGIAQESHAoPaW5nZXN0aW9uX2Vycm9yGAogASgJSAKIAQESHAoUc3VibWl0dGVkX2J5X3VzZXJfaWQYCyABKAkSMAoMc3VibWl0dGVkX2F0GAwgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBI1Cgxwcm9jZXNzZWRfYXQYDSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSAOIAQESGwoOcmV2aWV3ZXJfbm90ZXMYDiABKAlIBIgBARIdChBhcHByb3ZlZF9jb250ZW50GA8gASgJSAWIAQESEwoLaXNfYXBwcm92ZWQYECABKAgSNAoLYXBwcm92ZWRfYXQYESABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSAaIAQESIAoTYXBwcm92ZWRfYnlfdXNlcl9pZBgSIAEoCUgHiAEBEioKBnN0YXR1cxgTIAEoDjIaLm1pcmFpLnYxLlN1Ym1pc3Npb25TdGF0dXNCEQoPX2V4dHJhY3RlZF90ZXh0Qg0KC19haV9zdW1tYXJ5QhIKEF9pbmdlc3Rpb25fZXJyb3JCDwoNX3Byb2Nlc3NlZF9hdEIRCg9fcmV2aWV3ZXJfbm90ZXNCEwoRX2FwcHJvdmVkX2NvbnRlbnRCDgoMX2FwcHJvdmVkX2F0QhYKFF9hcHByb3ZlZF9ieV91c2VyX2lkIoEDChFTTUVLbm93bGVkZ2VDaHVuaxIKCgJpZBgBIAEoCRIOCgZzbWVfaWQYAiABKAkSGgoNc3VibWlzc2lvbl9pZBgDIAEoCUgAiAEBEg8KB2NvbnRlbnQYBCABKAkSDQoFdG9waWMYBSABKAkSEAoIa2V5d29yZHMYBiADKAkSFwoPcmVsZXZhbmNlX3Njb3JlGAcgASgCEi4KCmNyZWF0ZWRfYXQYCCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhkKEWluamVjdGlvbl9mbGFnZ2VkGAkgASgIEh0KEGluamVjdGlvbl9yZWFzb24YCiABKAlIAYgBARI+ChVpbmplY3Rpb25fcmVsZWFzZWRfYXQYCyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSAKIAQFCEAoOX3N1Ym1pc3Npb25faWRCEwoRX2luamVjdGlvbl9yZWFzb25CGAoWX2luamVjdGlvbl9yZWxlYXNlZF9hdCJMChJTTUVUYXNrU3RhdHVzQ291bnQSJwoGc3RhdHVzGAEgASgOMhcubWlyYWkudjEuU01FVGFza1N0YXR1cxINCgVjb3VudBgCIAEoBSJSChVTdWJtaXNzaW9uU3RhdHVzQ291bnQSKgoGc3RhdHVzGAEgASgOMhoubWlyYWkudjEuU3VibWlzc2lvblN0YXR1cxINCgVjb3VudBgCIAEoBSK2AQoRU3VibWlzc2lvblN1bW1hcnkSEwoLdG90YWxfY291bnQYASABKAUSNgoNc3RhdHVzX2NvdW50cxgCIAMoCzIfLm1pcmFpLnYxLlN1Ym1pc3Npb25TdGF0dXNDb3VudBI8ChNsYXRlc3Rfc3VibWl0dGVkX2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgAiAEBQhYKFF9sYXRlc3Rfc3VibWl0dGVkX2F0IvICCghTTUVTdGF0cxIOCgZzbWVfaWQYASABKAkSEAoIc21lX25hbWUYAiABKAkSJwoKc21lX3N0YXR1cxgDIAEoDjITLm1pcmFpLnYxLlNNRVN0YXR1cxIxCgt0YXNrX2NvdW50cxgEIAMoCzIcLm1pcmFpLnYxLlNNRVRhc2tTdGF0dXNDb3VudBIdChVzdWJtaXNzaW9uc19wcm9jZXNzZWQYBSABKAUSGgoSc3VibWlzc2lvbnNfZmFpbGVkGAYgASgFEhMKC2NodW5rX2NvdW50GAcgASgFEhwKFGV4dHJhY3RlZF9jaGFyYWN0ZXJzGAggASgDEjkKEGxhc3RfaW5nZXN0ZWRfYXQYCSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSACIAQESFAoMY291cnNlX2NvdW50GAogASgFEhQKDGxvd19jb3ZlcmFnZRgLIAEoCEITChFfbGFzdF9pbmdlc3RlZF9hdCJ6ChBDcmVhdGVTTUVSZXF1ZXN0EgwKBG5hbWUYASABKAkSEwoLZGVzY3JpcHRpb24YAiABKAkSDgoGZG9tYWluGAMgASgJEiEKBXNjb3BlGAQgASgOMhIubWlyYWkudjEuU01FU2NvcGUSEAoIdGVhbV9pZHMYBSADKAkiPwoRQ3JlYXRlU01FUmVzcG9uc2USKgoDc21lGAEgASgLMh0ubWlyYWkudjEuU3ViamVjdE1hdHRlckV4cGVydCIfCg1HZXRTTUVSZXF1ZXN0Eg4KBnNtZV9pZBgBIAEoCSI8Cg5HZXRTTUVSZXNwb25zZRIqCgNzbWUYASABKAsyHS5taXJhaS52MS5TdWJqZWN0TWF0dGVyRXhwZXJ0Is4BCg9MaXN0U01Fc1JlcXVlc3QSJgoFc2NvcGUYASABKA4yEi5taXJhaS52MS5TTUVTY29wZUgAiAEBEigKBnN0YXR1cxgCIAEoDjITLm1pcmFpLnYxLlNNRVN0YXR1c0gBiAEBEhQKB3RlYW1faWQYAyABKAlIAogBARIdChBpbmNsdWRlX2FyY2hpdmVkGAQgASgISAOIAQFCCAoGX3Njb3BlQgkKB19zdGF0dXNCCgoIX3RlYW1faWRCEwoRX2luY2x1ZGVfYXJjaGl2ZWQiPwoQTGlzdFNNRXNSZXNwb25zZRIrCgRzbWVzGAEgAygLMh0ubWlyYWkudjEuU3ViamVjdE1hdHRlckV4cGVydCKBAgoQVXBkYXRlU01FUmVxdWVzdBIOCgZzbWVfaWQYASABKAkSEQoEbmFtZRgCIAEoCUgAiAEBEhgKC2Rlc2NyaXB0aW9uGAMgASgJSAGIAQESEwoGZG9tYWluGAQgASgJSAKIAQESJgoFc2NvcGUYBSABKA4yEi5taXJhaS52MS5TTUVTY29wZUgDiAEBEhAKCHRlYW1faWRzGAYgAygJEigKBnN0YXR1cxgHIAEoDjITLm1pcmFpLnYxLlNNRVN0YXR1c0gEiAEBQgcKBV9uYW1lQg4KDF9kZXNjcmlwdGlvbkIJCgdfZG9tYWluQggKBl9zY29wZUIJCgdfc3RhdHVzIj8KEVVwZGF0ZVNNRVJlc3BvbnNlEioKA3NtZRgBIAEoCzIdLm1pcmFpLnYxLlN1YmplY3RNYXR0ZXJFeHBlcnQiPgoQRGVsZXRlU01FUmVxdWVzdBIOCgZzbWVfaWQYASABKAkSGgoSY29uZmlybWF0aW9uX3Rva2VuGAIgASgJIhMKEURlbGV0ZVNNRVJlc3BvbnNlIiMKEVJlc3RvcmVTTUVSZXF1ZXN0Eg4KBnNtZV9pZBgBIAEoCSJAChJSZXN0b3JlU01FUmVzcG9uc2USKgoDc21lGAEgASgLMh0ubWlyYWkudjEuU3ViamVjdE1hdHRlckV4cGVydCKPAgoRQ3JlYXRlVGFza1JlcXVlc3QSDgoGc21lX2lkGAEgASgJEg0KBXRpdGxlGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEjQKFWV4cGVjdGVkX2NvbnRlbnRfdHlwZRgEIAEoDjIVLm1pcmFpLnYxLkNvbnRlbnRUeXBlEhsKE2Fzc2lnbmVkX3RvX3VzZXJfaWQYBSABKAkSFAoHdGVhbV9pZBgGIAEoCUgAiAEBEjEKCGR1ZV9kYXRlGAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgBiAEBEhEKCXF1ZXN0aW9ucxgIIAMoCUIKCghfdGVhbV9pZEILCglfZHVlX2RhdGUiNQoSQ3JlYXRlVGFza1Jlc3BvbnNlEh8KBHRhc2sYASABKAsyES5taXJhaS52MS5TTUVUYXNrIiEKDkdldFRhc2tSZXF1ZXN0Eg8KB3Rhc2tfaWQYASABKAkiawoPR2V0VGFza1Jlc3BvbnNlEh8KBHRhc2sYASABKAsyES5taXJhaS52MS5TTUVUYXNrEjcKEnN1Ym1pc3Npb25fc3VtbWFyeRgCIAEoCzIbLm1pcmFpLnYxLlN1Ym1pc3Npb25TdW1tYXJ5IqUBChBMaXN0VGFza3NSZXF1ZXN0EhMKBnNtZV9pZBgBIAEoCUgAiAEBEiAKE2Fzc2lnbmVkX3RvX3VzZXJfaWQYAiABKAlIAYgBARIsCgZzdGF0dXMYAyABKA4yFy5taXJhaS52MS5TTUVUYXNrU3RhdHVzSAKIAQFCCQoHX3NtZV9pZEIWChRfYXNzaWduZWRfdG9fdXNlcl9pZEIJCgdfc3RhdHVzIjUKEUxpc3RUYXNrc1Jlc3BvbnNlEiAKBXRhc2tzGAEgAygLMhEubWlyYWkudjEuU01FVGFzayKBAgoRVXBkYXRlVGFza1JlcXVlc3QSDwoHdGFza19pZBgBIAEoCRISCgV0aXRsZRgCIAEoCUgAiAEBEhgKC2Rlc2NyaXB0aW9uGAMgASgJSAGIAQESOQoVZXhwZWN0ZWRfY29udGVudF90eXBlGAQgASgOMhUubWlyYWkudjEuQ29udGVudFR5cGVIAogBARIxCghkdWVfZGF0ZRgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIA4gBAUIICgZfdGl0bGVCDgoMX2Rlc2NyaXB0aW9uQhgKFl9leHBlY3RlZF9jb250ZW50X3R5cGVCCwoJX2R1ZV9kYXRlIjUKElVwZGF0ZVRhc2tSZXNwb25zZRIfCgR0YXNrGAEgASgLMhEubWlyYWkudjEuU01FVGFzayIkChFDYW5jZWxUYXNrUmVxdWVzdBIPCgd0YXNrX2lkGAEgASgJIjUKEkNhbmNlbFRhc2tSZXNwb25zZRIfCgR0YXNrGAEgASgLMhEubWlyYWkudjEuU01FVGFzayJ/ChNHZXRVcGxvYWRVUkxSZXF1ZXN0Eg8KB3Rhc2tfaWQYASABKAkSEQoJZmlsZV9uYW1lGAIgASgJEisKDGNvbnRlbnRfdHlwZRgDIAEoDjIVLm1pcmFpLnYxLkNvbnRlbnRUeXBlEhcKD2ZpbGVfc2l6ZV9ieXRlcxgEIAEoAyJtChRHZXRVcGxvYWRVUkxSZXNwb25zZRISCgp1cGxvYWRfdXJsGAEgASgJEhEKCWZpbGVfcGF0aBgCIAEoCRIuCgpleHBpcmVzX2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJaChtTdGFydE11bHRpcGFydFVwbG9hZFJlcXVlc3QSDwoHdGFza19pZBgBIAEoCRIRCglmaWxlX25hbWUYAiABKAkSFwoPZmlsZV9zaXplX2J5dGVzGAMgASgDInEKHFN0YXJ0TXVsdGlwYXJ0VXBsb2FkUmVzcG9uc2USEQoJdXBsb2FkX2lkGAEgASgJEhEKCWZpbGVfcGF0aBgCIAEoCRIXCg9wYXJ0X3NpemVfYnl0ZXMYAyABKAMSEgoKcGFydF9jb3VudBgEIAEoBSKAAQoYR2V0UGFydFVwbG9hZFVSTHNSZXF1ZXN0Eg8KB3Rhc2tfaWQYASABKAkSEQoJZmlsZV9wYXRoGAIgASgJEhEKCXVwbG9hZF9pZBgDIAEoCRIXCg9maWxlX3NpemVfYnl0ZXMYBCABKAMSFAoMcGFydF9udW1iZXJzGAUgAygFIjgKDVBhcnRVcGxvYWRVUkwSEwoLcGFydF9udW1iZXIYASABKAUSEgoKdXBsb2FkX3VybBgCIAEoCSKSAQoZR2V0UGFydFVwbG9hZFVSTHNSZXNwb25zZRImCgVwYXJ0cxgBIAMoCzIXLm1pcmFpLnYxLlBhcnRVcGxvYWRVUkwSHQoVdXBsb2FkZWRfcGFydF9udW1iZXJzGAIgAygFEi4KCmV4cGlyZXNfYXQYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wInAKHkNvbXBsZXRlTXVsdGlwYXJ0VXBsb2FkUmVxdWVzdBIPCgd0YXNrX2lkGAEgASgJEhEKCWZpbGVfcGF0aBgCIAEoCRIRCgl1cGxvYWRfaWQYAyABKAkSFwoPZmlsZV9zaXplX2J5dGVzGAQgASgDIjQKH0NvbXBsZXRlTXVsdGlwYXJ0VXBsb2FkUmVzcG9uc2USEQoJZmlsZV9wYXRoGAEgASgJIr8BChRTdWJtaXRDb250ZW50UmVxdWVzdBIPCgd0YXNrX2lkGAEgASgJEhEKCWZpbGVfbmFtZRgCIAEoCRIRCglmaWxlX3BhdGgYAyABKAkSKwoMY29udGVudF90eXBlGAQgASgOMhUubWlyYWkudjEuQ29udGVudFR5cGUSFwoPZmlsZV9zaXplX2J5dGVzGAUgASgDEhkKDHRleHRfY29udGVudBgGIAEoCUgAiAEBQg8KDV90ZXh0X2NvbnRlbnQiSAoVU3VibWl0Q29udGVudFJlc3BvbnNlEi8KCnN1Ym1pc3Npb24YASABKAsyGy5taXJhaS52MS5TTUVUYXNrU3VibWlzc2lvbiKUAQoWTGlzdFN1Ym1pc3Npb25zUmVxdWVzdBIPCgd0YXNrX2lkGAEgASgJEi8KBnN0YXR1cxgCIAEoDjIaLm1pcmFpLnYxLlN1Ym1pc3Npb25TdGF0dXNIAIgBARINCgVsaW1pdBgDIAEoBRITCgZjdXJzb3IYBCABKAlIAYgBAUIJCgdfc3RhdHVzQgkKB19jdXJzb3IidQoXTGlzdFN1Ym1pc3Npb25zUmVzcG9uc2USMAoLc3VibWlzc2lvbnMYASADKAsyGy5taXJhaS52MS5TTUVUYXNrU3VibWlzc2lvbhIYCgtuZXh0X2N1cnNvchgCIAEoCUgAiAEBQg4KDF9uZXh0X2N1cnNvciJDChNHZXRLbm93bGVkZ2VSZXF1ZXN0Eg4KBnNtZV9pZBgBIAEoCRISCgV0b3BpYxgCIAEoCUgAiAEBQggKBl90b3BpYyJvChRHZXRLbm93bGVkZ2VSZXNwb25zZRIqCgNzbWUYASABKAsyHS5taXJhaS52MS5TdWJqZWN0TWF0dGVyRXhwZXJ0EisKBmNodW5rcxgCIAMoCzIbLm1pcmFpLnYxLlNNRUtub3dsZWRnZUNodW5rIiwKGkxpc3RLbm93bGVkZ2VUb3BpY3NSZXF1ZXN0Eg4KBnNtZV9pZBgBIAEoCSI0Cg5Lbm93bGVkZ2VUb3BpYxINCgV0b3BpYxgBIAEoCRITCgtjaHVua19jb3VudBgCIAEoBSJHChtMaXN0S25vd2xlZGdlVG9waWNzUmVzcG9uc2USKAoGdG9waWNzGAEgAygLMhgubWlyYWkudjEuS25vd2xlZGdlVG9waWMiRwoWU2VhcmNoS25vd2xlZGdlUmVxdWVzdBIPCgdzbWVfaWRzGAEgAygJEg0KBXF1ZXJ5GAIgASgJEg0KBWxpbWl0GAMgASgFIkYKF1NlYXJjaEtub3dsZWRnZVJlc3BvbnNlEisKBmNodW5rcxgBIAMoCzIbLm1pcmFpLnYxLlNNRUtub3dsZWRnZUNodW5rIi0KFEdldFN1Ym1pc3Npb25SZXF1ZXN0EhUKDXN1Ym1pc3Npb25faWQYASABKAkiSAoVR2V0U3VibWlzc2lvblJlc3BvbnNlEi8KCnN1Ym1pc3Npb24YASABKAsyGy5taXJhaS52MS5TTUVUYXNrU3VibWlzc2lvbiJxChpSZXByb2Nlc3NTdWJtaXNzaW9uUmVxdWVzdBIVCg1zdWJtaXNzaW9uX2lkGAEgASgJEiIKFXJlcGxhY2VtZW50X2ZpbGVfcGF0aBgCIAEoCUgAiAEBQhgKFl9yZXBsYWNlbWVudF9maWxlX3BhdGgiXgobUmVwcm9jZXNzU3VibWlzc2lvblJlc3BvbnNlEi8KCnN1Ym1pc3Npb24YASABKAsyGy5taXJhaS52MS5TTUVUYXNrU3VibWlzc2lvbhIOCgZqb2JfaWQYAiABKAkiSwoYQXBwcm92ZVN1Ym1pc3Npb25SZXF1ZXN0EhUKDXN1Ym1pc3Npb25faWQYASABKAkSGAoQYXBwcm92ZWRfY29udGVudBgCIAEoCSKBAQoZQXBwcm92ZVN1Ym1pc3Npb25SZXNwb25zZRIvCgpzdWJtaXNzaW9uGAEgASgLMhsubWlyYWkudjEuU01FVGFza1N1Ym1pc3Npb24SMwoOY3JlYXRlZF9jaHVua3MYAiADKAsyGy5taXJhaS52MS5TTUVLbm93bGVkZ2VDaHVuayJKCh9SZXF1ZXN0U3VibWlzc2lvbkNoYW5nZXNSZXF1ZXN0EhUKDXN1Ym1pc3Npb25faWQYASABKAkSEAoIZmVlZGJhY2sYAiABKAkiUwogUmVxdWVzdFN1Ym1pc3Npb25DaGFuZ2VzUmVzcG9uc2USLwoKc3VibWlzc2lvbhgBIAEoCzIbLm1pcmFpLnYxLlNNRVRhc2tTdWJtaXNzaW9uImUKH0VuaGFuY2VTdWJtaXNzaW9uQ29udGVudFJlcXVlc3QSFQoNc3VibWlzc2lvbl9pZBgBIAEoCRIrCgxlbmhhbmNlX3R5cGUYAiABKA4yFS5taXJhaS52MS5FbmhhbmNlVHlwZSJWCiBFbmhhbmNlU3VibWlzc2lvbkNvbnRlbnRSZXNwb25zZRIYChBlbmhhbmNlZF9jb250ZW50GAEgASgJEhgKEG9yaWdpbmFsX2NvbnRlbnQYAiABKAkicAobVXBkYXRlS25vd2xlZGdlQ2h1bmtSZXF1ZXN0EhAKCGNodW5rX2lkGAEgASgJEg8KB2NvbnRlbnQYAiABKAkSEgoFdG9waWMYAyABKAlIAIgBARIQCghrZXl3b3JkcxgEIAMoCUIICgZfdG9waWMiSgocVXBkYXRlS25vd2xlZGdlQ2h1bmtSZXNwb25zZRIqCgVjaHVuaxgBIAEoCzIbLm1pcmFpLnYxLlNNRUtub3dsZWRnZUNodW5rIi8KG0RlbGV0ZUtub3dsZWRnZUNodW5rUmVxdWVzdBIQCghjaHVua19pZBgBIAEoCSIeChxEZWxldGVLbm93bGVkZ2VDaHVua1Jlc3BvbnNlIkcKIlJldmlld0ZsYWdnZWRLbm93bGVkZ2VDaHVua1JlcXVlc3QSEAoIY2h1bmtfaWQYASABKAkSDwoHcmVsZWFzZRgCIAEoCCJRCiNSZXZpZXdGbGFnZ2VkS25vd2xlZGdlQ2h1bmtSZXNwb25zZRIqCgVjaHVuaxgBIAEoCzIbLm1pcmFpLnYxLlNNRUtub3dsZWRnZUNodW5rIiQKEURlbGV0ZVRhc2tSZXF1ZXN0Eg8KB3Rhc2tfaWQYASABKAkiFAoSRGVsZXRlVGFza1Jlc3BvbnNlIhQKEkdldFNNRVN0YXRzUmVxdWVzdCJWChNHZXRTTUVTdGF0c1Jlc3BvbnNlEiEKBXN0YXRzGAEgAygLMhIubWlyYWkudjEuU01FU3RhdHMSHAoUbWluX2tub3dsZWRnZV9jaHVua3MYAiABKAUiKwoZRXhwb3J0U01FS25vd2xlZGdlUmVxdWVzdBIOCgZzbWVfaWQYASABKAkiLAoaRXhwb3J0U01FS25vd2xlZGdlUmVzcG9uc2USDgoGam9iX2lkGAEgASgJIiQKIkdldEtub3dsZWRnZUltcG9ydFVwbG9hZFVSTFJlcXVlc3QiTAojR2V0S25vd2xlZGdlSW1wb3J0VXBsb2FkVVJMUmVzcG9uc2USEgoKdXBsb2FkX3VybBgBIAEoCRIRCglmaWxlX3BhdGgYAiABKAkiXAoZSW1wb3J0U01FS25vd2xlZGdlUmVxdWVzdBIRCglmaWxlX3BhdGgYASABKAkSGgoNdGFyZ2V0X3NtZV9pZBgCIAEoCUgAiAEBQhAKDl90YXJnZXRfc21lX2lkIlgKGkltcG9ydFNNRUtub3dsZWRnZVJlc3BvbnNlEioKA3NtZRgBIAEoCzIdLm1pcmFpLnYxLlN1YmplY3RNYXR0ZXJFeHBlcnQSDgoGam9iX2lkGAIgASgJIkcKHEdlbmVyYXRlVGFza1F1ZXN0aW9uc1JlcXVlc3QSDgoGc21lX2lkGAEgASgJEhcKD2Rlc2lyZWRfb3V0Y29tZRgCIAEoCSJHCh1HZW5lcmF0ZVRhc2tRdWVzdGlvbnNSZXNwb25zZRIRCglxdWVzdGlvbnMYASADKAkSEwoLdG9rZW5zX3VzZWQYAiABKAMiOAoUU3VibWl0QW5zd2Vyc1JlcXVlc3QSDwoHdGFza19pZBgBIAEoCRIPCgdhbnN3ZXJzGAIgAygJIkgKFVN1Ym1pdEFuc3dlcnNSZXNwb25zZRIvCgpzdWJtaXNzaW9uGAEgASgLMhsubWlyYWkudjEuU01FVGFza1N1Ym1pc3Npb24iLQobR2V0U01FRGVsZXRpb25JbXBhY3RSZXF1ZXN0Eg4KBnNtZV9pZBgBIAEoCSLfAQocR2V0U01FRGVsZXRpb25JbXBhY3RSZXNwb25zZRIYChBrbm93bGVkZ2VfY2h1bmtzGAEgASgFEhUKDXBlbmRpbmdfdGFza3MYAiABKAUSEwoLc3VibWlzc2lvbnMYAyABKAUSDwoHY291cnNlcxgEIAEoBRIcChRyZWNlbnRfY291cnNlX3RpdGxlcxgFIAMoCRIaChJjb25maXJtYXRpb25fdG9rZW4YBiABKAkSLgoKZXhwaXJlc19hdBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAilAIKElN1Ym1pc3Npb25Qcm9ncmVzcxIVCg1zdWJtaXNzaW9uX2lkGAEgASgJEg8KB3Rhc2tfaWQYAiABKAkSEwoGam9iX2lkGAMgASgJSACIAQESNAoGc3RhdHVzGAQgASgOMiQubWlyYWkudjEuU3VibWlzc2lvblByb2Nlc3NpbmdTdGF0dXMSGAoQcHJvZ3Jlc3NfcGVyY2VudBgFIAEoBRIYChBwcm9ncmVzc19tZXNzYWdlGAYgASgJEhIKBWVycm9yGAcgASgJSAGIAQESLgoKdXBkYXRlZF9hdBgIIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCCQoHX2pvYl9pZEIICgZfZXJyb3IiMwoaR2V0U3VibWlzc2lvblN0YXR1c1JlcXVlc3QSFQoNc3VibWlzc2lvbl9pZBgBIAEoCSJNChtHZXRTdWJtaXNzaW9uU3RhdHVzUmVzcG9uc2USLgoIcHJvZ3Jlc3MYASABKAsyHC5taXJhaS52MS5TdWJtaXNzaW9uUHJvZ3Jlc3MiQwoSU3VnZ2VzdFNNRXNSZXF1ZXN0EhQKDGNvdXJzZV90aXRsZRgBIAEoCRIXCg9kZXNpcmVkX291dGNvbWUYAiABKAkiawoNU01FU3VnZ2VzdGlvbhIqCgNzbWUYASABKAsyHS5taXJhaS52MS5TdWJqZWN0TWF0dGVyRXhwZXJ0EhcKD3JlbGV2YW5jZV9zY29yZRgCIAEoAhIVCg1tYXRjaGVkX3Rlcm1zGAMgAygJIkMKE1N1Z2dlc3RTTUVzUmVzcG9uc2USLAoLc3VnZ2VzdGlvbnMYASADKAsyFy5taXJhaS52MS5TTUVTdWdnZXN0aW9uKk8KCFNNRVNjb3BlEhkKFVNNRV9TQ09QRV9VTlNQRUNJRklFRBAAEhQKEFNNRV9TQ09QRV9HTE9CQUwQARISCg5TTUVfU0NPUEVfVEVBTRACKocBCglTTUVTdGF0dXMSGgoWU01FX1NUQVRVU19VTlNQRUNJRklFRBAAEhQKEFNNRV9TVEFUVVNfRFJBRlQQARIYChRTTUVfU1RBVFVTX0lOR0VTVElORxACEhUKEVNNRV9TVEFUVVNfQUNUSVZFEAMSFwoTU01FX1NUQVRVU19BUkNISVZFRBAEKrICCg1TTUVUYXNrU3RhdHVzEh8KG1NNRV9UQVNLX1NUQVRVU19VTlNQRUNJRklFRBAAEhsKF1NNRV9UQVNLX1NUQVRVU19QRU5ESU5HEAESHQoZU01FX1RBU0tfU1RBVFVTX1NVQk1JVFRFRBACEh4KGlNNRV9UQVNLX1NUQVRVU19QUk9DRVNTSU5HEAMSHQoZU01FX1RBU0tfU1RBVFVTX0NPTVBMRVRFRBAEEhoKFlNNRV9UQVNLX1NUQVRVU19GQUlMRUQQBRIdChlTTUVfVEFTS19TVEFUVVNfQ0FOQ0VMTEVEEAYSIwofU01FX1RBU0tfU1RBVFVTX0FXQUlUSU5HX1JFVklFVxAHEiUKIVNNRV9UQVNLX1NUQVRVU19DSEFOR0VTX1JFUVVFU1RFRBAIKroBChBTdWJtaXNzaW9uU3RhdHVzEiEKHVNVQk1JU1NJT05fU1RBVFVTX1VOU1BFQ0lGSUVEEAASJAogU1VCTUlTU0lPTl9TVEFUVVNfUEVORElOR19SRVZJRVcQARIfChtTVUJNSVNTSU9OX1NUQVRVU19QUk9DRVNTRUQQAhIcChhTVUJNSVNTSU9OX1NUQVRVU19GQUlMRUQQAxIeChpTVUJNSVNTSU9OX1NUQVRVU19BUFBST1ZFRBAEKmEKC0VuaGFuY2VUeXBlEhwKGEVOSEFOQ0VfVFlQRV9VTlNQRUNJRklFRBAAEhoKFkVOSEFOQ0VfVFlQRV9TVU1NQVJJWkUQARIYChRFTkhBTkNFX1RZUEVfSU1QUk9WRRACKrsBCgtDb250ZW50VHlwZRIcChhDT05URU5UX1RZUEVfVU5TUEVDSUZJRUQQABIZChVDT05URU5UX1RZUEVfRE9DVU1FTlQQARIWChJDT05URU5UX1RZUEVfSU1BR0UQAhIWChJDT05URU5UX1RZUEVfVklERU8QAxIWChJDT05URU5UX1RZUEVfQVVESU8QBBIUChBDT05URU5UX1RZUEVfVVJMEAUSFQoRQ09OVEVOVF9UWVBFX1RFWFQQBiqjAgoaU3VibWlzc2lvblByb2Nlc3NpbmdTdGF0dXMSLAooU1VCTUlTU0lPTl9QUk9DRVNTSU5HX1NUQVRVU19VTlNQRUNJRklFRBAAEiwKKFNVQk1JU1NJT05fUFJPQ0VTU0lOR19TVEFUVVNfTk9UX1NUQVJURUQQARInCiNTVUJNSVNTSU9OX1BST0NFU1NJTkdfU1RBVFVTX1FVRVVFRBACEisKJ1NVQk1JU1NJT05fUFJPQ0VTU0lOR19TVEFUVVNfUFJPQ0VTU0lORxADEioKJlNVQk1JU1NJT05fUFJPQ0VTU0lOR19TVEFUVVNfQ09NUExFVEVEEAQSJwojU1VCTUlTU0lPTl9QUk9DRVNTSU5HX1NUQVRVU19GQUlMRUQQBTKrGgoKU01FU2VydmljZRJECglDcmVhdGVTTUUSGi5taXJhaS52MS5DcmVhdGVTTUVSZXF1ZXN0GhsubWlyYWkudjEuQ3JlYXRlU01FUmVzcG9uc2USOwoGR2V0U01FEhcubWlyYWkudjEuR2V0U01FUmVxdWVzdBoYLm1pcmFpLnYxLkdldFNNRVJlc3BvbnNlEkEKCExpc3RTTUVzEhkubWlyYWkudjEuTGlzdFNNRXNSZXF1ZXN0GhoubWlyYWkudjEuTGlzdFNNRXNSZXNwb25zZRJECglVcGRhdGVTTUUSGi5taXJhaS52MS5VcGRhdGVTTUVSZXF1ZXN0GhsubWlyYWkudjEuVXBkYXRlU01FUmVzcG9uc2USRAoJRGVsZXRlU01FEhoubWlyYWkudjEuRGVsZXRlU01FUmVxdWVzdBobLm1pcmFpLnYxLkRlbGV0ZVNNRVJlc3BvbnNlEmUKFEdldFNNRURlbGV0aW9uSW1wYWN0EiUubWlyYWkudjEuR2V0U01FRGVsZXRpb25JbXBhY3RSZXF1ZXN0GiYubWlyYWkudjEuR2V0U01FRGVsZXRpb25JbXBhY3RSZXNwb25zZRJHCgpSZXN0b3JlU01FEhsubWlyYWkudjEuUmVzdG9yZVNNRVJlcXVlc3QaHC5taXJhaS52MS5SZXN0b3JlU01FUmVzcG9uc2USRwoKQ3JlYXRlVGFzaxIbLm1pcmFpLnYxLkNyZWF0ZVRhc2tSZXF1ZXN0GhwubWlyYWkudjEuQ3JlYXRlVGFza1Jlc3BvbnNlEj4KB0dldFRhc2sSGC5taXJhaS52MS5HZXRUYXNrUmVxdWVzdBoZLm1pcmFpLnYxLkdldFRhc2tSZXNwb25zZRJECglMaXN0VGFza3MSGi5taXJhaS52MS5MaXN0VGFza3NSZXF1ZXN0GhsubWlyYWkudjEuTGlzdFRhc2tzUmVzcG9uc2USRwoKVXBkYXRlVGFzaxIbLm1pcmFpLnYxLlVwZGF0ZVRhc2tSZXF1ZXN0GhwubWlyYWkudjEuVXBkYXRlVGFza1Jlc3BvbnNlEkcKCkNhbmNlbFRhc2sSGy5taXJhaS52MS5DYW5jZWxUYXNrUmVxdWVzdBocLm1pcmFpLnYxLkNhbmNlbFRhc2tSZXNwb25zZRJNCgxHZXRVcGxvYWRVUkwSHS5taXJhaS52MS5HZXRVcGxvYWRVUkxSZXF1ZXN0Gh4ubWlyYWkudjEuR2V0VXBsb2FkVVJMUmVzcG9uc2USZQoUU3RhcnRNdWx0aXBhcnRVcGxvYWQSJS5taXJhaS52MS5TdGFydE11bHRpcGFydFVwbG9hZFJlcXVlc3QaJi5taXJhaS52MS5TdGFydE11bHRpcGFydFVwbG9hZFJlc3BvbnNlElwKEUdldFBhcnRVcGxvYWRVUkxzEiIubWlyYWkudjEuR2V0UGFydFVwbG9hZFVSTHNSZXF1ZXN0GiMubWlyYWkudjEuR2V0UGFydFVwbG9hZFVSTHNSZXNwb25zZRJuChdDb21wbGV0ZU11bHRpcGFydFVwbG9hZBIoLm1pcmFpLnYxLkNvbXBsZXRlTXVsdGlwYXJ0VXBsb2FkUmVxdWVzdBopLm1pcmFpLnYxLkNvbXBsZXRlTXVsdGlwYXJ0VXBsb2FkUmVzcG9uc2USUAoNU3VibWl0Q29udGVudBIeLm1pcmFpLnYxLlN1Ym1pdENvbnRlbnRSZXF1ZXN0Gh8ubWlyYWkudjEuU3VibWl0Q29udGVudFJlc3BvbnNlEmgKFUdlbmVyYXRlVGFza1F1ZXN0aW9ucxImLm1pcmFpLnYxLkdlbmVyYXRlVGFza1F1ZXN0aW9uc1JlcXVlc3QaJy5taXJhaS52MS5HZW5lcmF0ZVRhc2tRdWVzdGlvbnNSZXNwb25zZRJQCg1TdWJtaXRBbnN3ZXJzEh4ubWlyYWkudjEuU3VibWl0QW5zd2Vyc1JlcXVlc3QaHy5taXJhaS52MS5TdWJtaXRBbnN3ZXJzUmVzcG9uc2USVgoPTGlzdFN1Ym1pc3Npb25zEiAubWlyYWkudjEuTGlzdFN1Ym1pc3Npb25zUmVxdWVzdBohLm1pcmFpLnYxLkxpc3RTdWJtaXNzaW9uc1Jlc3BvbnNlEk0KDEdldEtub3dsZWRnZRIdLm1pcmFpLnYxLkdldEtub3dsZWRnZVJlcXVlc3QaHi5taXJhaS52MS5HZXRLbm93bGVkZ2VSZXNwb25zZRJiChNMaXN0S25vd2xlZGdlVG9waWNzEiQubWlyYWkudjEuTGlzdEtub3dsZWRnZVRvcGljc1JlcXVlc3QaJS5taXJhaS52MS5MaXN0S25vd2xlZGdlVG9waWNzUmVzcG9uc2USVgoPU2VhcmNoS25vd2xlZGdlEiAubWlyYWkudjEuU2VhcmNoS25vd2xlZGdlUmVxdWVzdBohLm1pcmFpLnYxLlNlYXJjaEtub3dsZWRnZVJlc3BvbnNlEkoKC1N1Z2dlc3RTTUVzEhwubWlyYWkudjEuU3VnZ2VzdFNNRXNSZXF1ZXN0Gh0ubWlyYWkudjEuU3VnZ2VzdFNNRXNSZXNwb25zZRJQCg1HZXRTdWJtaXNzaW9uEh4ubWlyYWkudjEuR2V0U3VibWlzc2lvblJlcXVlc3QaHy5taXJhaS52MS5HZXRTdWJtaXNzaW9uUmVzcG9uc2USXAoRQXBwcm92ZVN1Ym1pc3Npb24SIi5taXJhaS52MS5BcHByb3ZlU3VibWlzc2lvblJlcXVlc3QaIy5taXJhaS52MS5BcHByb3ZlU3VibWlzc2lvblJlc3BvbnNlEnEKGFJlcXVlc3RTdWJtaXNzaW9uQ2hhbmdlcxIpLm1pcmFpLnYxLlJlcXVlc3RTdWJtaXNzaW9uQ2hhbmdlc1JlcXVlc3QaKi5taXJhaS52MS5SZXF1ZXN0U3VibWlzc2lvbkNoYW5nZXNSZXNwb25zZRJxChhFbmhhbmNlU3VibWlzc2lvbkNvbnRlbnQSKS5taXJhaS52MS5FbmhhbmNlU3VibWlzc2lvbkNvbnRlbnRSZXF1ZXN0GioubWlyYWkudjEuRW5oYW5jZVN1Ym1pc3Npb25Db250ZW50UmVzcG9uc2USYgoTUmVwcm9jZXNzU3VibWlzc2lvbhIkLm1pcmFpLnYxLlJlcHJvY2Vzc1N1Ym1pc3Npb25SZXF1ZXN0GiUubWlyYWkudjEuUmVwcm9jZXNzU3VibWlzc2lvblJlc3BvbnNlEmIKE0dldFN1Ym1pc3Npb25TdGF0dXMSJC5taXJhaS52MS5HZXRTdWJtaXNzaW9uU3RhdHVzUmVxdWVzdBolLm1pcmFpLnYxLkdldFN1Ym1pc3Npb25TdGF0dXNSZXNwb25zZRJlChRVcGRhdGVLbm93bGVkZ2VDaHVuaxIlLm1pcmFpLnYxLlVwZGF0ZUtub3dsZWRnZUNodW5rUmVxdWVzdBomLm1pcmFpLnYxLlVwZGF0ZUtub3dsZWRnZUNodW5rUmVzcG9uc2USZQoURGVsZXRlS25vd2xlZGdlQ2h1bmsSJS5taXJhaS52MS5EZWxldGVLbm93bGVkZ2VDaHVua1JlcXVlc3QaJi5taXJhaS52MS5EZWxldGVLbm93bGVkZ2VDaHVua1Jlc3BvbnNlEnoKG1Jldmlld0ZsYWdnZWRLbm93bGVkZ2VDaHVuaxIsLm1pcmFpLnYxLlJldmlld0ZsYWdnZWRLbm93bGVkZ2VDaHVua1JlcXVlc3QaLS5taXJhaS52MS5SZXZpZXdGbGFnZ2VkS25vd2xlZGdlQ2h1bmtSZXNwb25zZRJHCgpEZWxldGVUYXNrEhsubWlyYWkudjEuRGVsZXRlVGFza1JlcXVlc3QaHC5taXJhaS52MS5EZWxldGVUYXNrUmVzcG9uc2USSgoLR2V0U01FU3RhdHMSHC5taXJhaS52MS5HZXRTTUVTdGF0c1JlcXVlc3QaHS5taXJhaS52MS5HZXRTTUVTdGF0c1Jlc3BvbnNlEl8KEkV4cG9ydFNNRUtub3dsZWRnZRIjLm1pcmFpLnYxLkV4cG9ydFNNRUtub3dsZWRnZVJlcXVlc3QaJC5taXJhaS52MS5FeHBvcnRTTUVLbm93bGVkZ2VSZXNwb25zZRJ6ChtHZXRLbm93bGVkZ2VJbXBvcnRVcGxvYWRVUkwSLC5taXJhaS52MS5HZXRLbm93bGVkZ2VJbXBvcnRVcGxvYWRVUkxSZXF1ZXN0Gi0ubWlyYWkudjEuR2V0S25vd2xlZGdlSW1wb3J0VXBsb2FkVVJMUmVzcG9uc2USXwoSSW1wb3J0U01FS25vd2xlZGdlEiMubWlyYWkudjEuSW1wb3J0U01FS25vd2xlZGdlUmVxdWVzdBokLm1pcmFpLnYxLkltcG9ydFNNRUtub3dsZWRnZVJlc3BvbnNlQo4BCgxjb20ubWlyYWkudjFCCFNtZVByb3RvUAFaM2dpdGh1Yi5jb20vc29nb3MvbWlyYWktYmFja2VuZC9nZW4vbWlyYWkvdjE7bWlyYWl2MaICA01YWKoCCE1pcmFpLlYxygIITWlyYWlcVjHiAhRNaXJhaVxWMVxHUEJNZXRhZGF0YeoCCU1pcmFpOjpWMWIGcHJvdG8z", [file_google_protobuf_timestamp]);
 
 /**
  * SubjectMatterExpert represents a knowledge source entity.
@@ -99,6 +99,13 @@ export type SubjectMatterExpert = Message<"mirai.v1.SubjectMatterExpert"> & {
    * @generated from field: google.protobuf.Timestamp updated_at = 14;
    */
   updatedAt?: Timestamp;
+
+  /**
+   * False when the creator has been deactivated
+   *
+   * @generated from field: bool created_by_active = 15;
+   */
+  createdByActive: boolean;
 };
 
 /**
@@ -197,6 +204,13 @@ export type SMETask = Message<"mirai.v1.SMETask"> & {
    * @generated from field: optional google.protobuf.Timestamp completed_at = 14;
    */
   completedAt?: Timestamp;
+
+  /**
+   * Interview questions; answered with SubmitAnswers instead of uploading
+   *
+   * @generated from field: repeated string questions = 15;
+   */
+  questions: string[];
 };
 
 /**
@@ -319,6 +333,11 @@ export type SMETaskSubmission = Message<"mirai.v1.SMETaskSubmission"> & {
    * @generated from field: optional string approved_by_user_id = 18;
    */
   approvedByUserId?: string;
+
+  /**
+   * @generated from field: mirai.v1.SubmissionStatus status = 19;
+   */
+  status: SubmissionStatus;
 };
 
 /**
@@ -383,6 +402,24 @@ export type SMEKnowledgeChunk = Message<"mirai.v1.SMEKnowledgeChunk"> & {
    * @generated from field: google.protobuf.Timestamp created_at = 8;
    */
   createdAt?: Timestamp;
+
+  /**
+   * Prompt injection screening: flagged chunks are left out of generation
+   * until an admin releases them.
+   *
+   * @generated from field: bool injection_flagged = 9;
+   */
+  injectionFlagged: boolean;
+
+  /**
+   * @generated from field: optional string injection_reason = 10;
+   */
+  injectionReason?: string;
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp injection_released_at = 11;
+   */
+  injectionReleasedAt?: Timestamp;
 };
 
 /**
@@ -392,6 +429,156 @@ export type SMEKnowledgeChunk = Message<"mirai.v1.SMEKnowledgeChunk"> & {
 export const SMEKnowledgeChunkSchema: GenMessage<SMEKnowledgeChunk> = /*@__PURE__*/
   messageDesc(file_mirai_v1_sme, 3);
 
+/**
+ * SMETaskStatusCount is the number of an SME's tasks in a given status.
+ *
+ * @generated from message mirai.v1.SMETaskStatusCount
+ */
+export type SMETaskStatusCount = Message<"mirai.v1.SMETaskStatusCount"> & {
+  /**
+   * @generated from field: mirai.v1.SMETaskStatus status = 1;
+   */
+  status: SMETaskStatus;
+
+  /**
+   * @generated from field: int32 count = 2;
+   */
+  count: number;
+};
+
+/**
+ * Describes the message mirai.v1.SMETaskStatusCount.
+ * Use `create(SMETaskStatusCountSchema)` to create a new message.
+ */
+export const SMETaskStatusCountSchema: GenMessage<SMETaskStatusCount> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 4);
+
+/**
+ * SubmissionStatusCount is the number of a task's submissions in a given status.
+ *
+ * @generated from message mirai.v1.SubmissionStatusCount
+ */
+export type SubmissionStatusCount = Message<"mirai.v1.SubmissionStatusCount"> & {
+  /**
+   * @generated from field: mirai.v1.SubmissionStatus status = 1;
+   */
+  status: SubmissionStatus;
+
+  /**
+   * @generated from field: int32 count = 2;
+   */
+  count: number;
+};
+
+/**
+ * Describes the message mirai.v1.SubmissionStatusCount.
+ * Use `create(SubmissionStatusCountSchema)` to create a new message.
+ */
+export const SubmissionStatusCountSchema: GenMessage<SubmissionStatusCount> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 5);
+
+/**
+ * SubmissionSummary aggregates a task's submissions.
+ *
+ * @generated from message mirai.v1.SubmissionSummary
+ */
+export type SubmissionSummary = Message<"mirai.v1.SubmissionSummary"> & {
+  /**
+   * @generated from field: int32 total_count = 1;
+   */
+  totalCount: number;
+
+  /**
+   * @generated from field: repeated mirai.v1.SubmissionStatusCount status_counts = 2;
+   */
+  statusCounts: SubmissionStatusCount[];
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp latest_submitted_at = 3;
+   */
+  latestSubmittedAt?: Timestamp;
+};
+
+/**
+ * Describes the message mirai.v1.SubmissionSummary.
+ * Use `create(SubmissionSummarySchema)` to create a new message.
+ */
+export const SubmissionSummarySchema: GenMessage<SubmissionSummary> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 6);
+
+/**
+ * SMEStats summarizes an SME's knowledge contributions.
+ *
+ * @generated from message mirai.v1.SMEStats
+ */
+export type SMEStats = Message<"mirai.v1.SMEStats"> & {
+  /**
+   * @generated from field: string sme_id = 1;
+   */
+  smeId: string;
+
+  /**
+   * @generated from field: string sme_name = 2;
+   */
+  smeName: string;
+
+  /**
+   * @generated from field: mirai.v1.SMEStatus sme_status = 3;
+   */
+  smeStatus: SMEStatus;
+
+  /**
+   * @generated from field: repeated mirai.v1.SMETaskStatusCount task_counts = 4;
+   */
+  taskCounts: SMETaskStatusCount[];
+
+  /**
+   * @generated from field: int32 submissions_processed = 5;
+   */
+  submissionsProcessed: number;
+
+  /**
+   * @generated from field: int32 submissions_failed = 6;
+   */
+  submissionsFailed: number;
+
+  /**
+   * @generated from field: int32 chunk_count = 7;
+   */
+  chunkCount: number;
+
+  /**
+   * @generated from field: int64 extracted_characters = 8;
+   */
+  extractedCharacters: bigint;
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp last_ingested_at = 9;
+   */
+  lastIngestedAt?: Timestamp;
+
+  /**
+   * Courses whose generation inputs reference this SME
+   *
+   * @generated from field: int32 course_count = 10;
+   */
+  courseCount: number;
+
+  /**
+   * Active SME with fewer chunks than the configured minimum
+   *
+   * @generated from field: bool low_coverage = 11;
+   */
+  lowCoverage: boolean;
+};
+
+/**
+ * Describes the message mirai.v1.SMEStats.
+ * Use `create(SMEStatsSchema)` to create a new message.
+ */
+export const SMEStatsSchema: GenMessage<SMEStats> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 7);
+
 /**
  * CreateSMERequest contains data for a new SME.
  *
@@ -431,7 +618,7 @@ export type CreateSMERequest = Message<"mirai.v1.CreateSMERequest"> & {
  * Use `create(CreateSMERequestSchema)` to create a new message.
  */
 export const CreateSMERequestSchema: GenMessage<CreateSMERequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 4);
+  messageDesc(file_mirai_v1_sme, 8);
 
 /**
  * CreateSMEResponse contains the created SME.
@@ -450,7 +637,7 @@ export type CreateSMEResponse = Message<"mirai.v1.CreateSMEResponse"> & {
  * Use `create(CreateSMEResponseSchema)` to create a new message.
  */
 export const CreateSMEResponseSchema: GenMessage<CreateSMEResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 5);
+  messageDesc(file_mirai_v1_sme, 9);
 
 /**
  * GetSMERequest contains the SME ID to fetch.
@@ -469,7 +656,7 @@ export type GetSMERequest = Message<"mirai.v1.GetSMERequest"> & {
  * Use `create(GetSMERequestSchema)` to create a new message.
  */
 export const GetSMERequestSchema: GenMessage<GetSMERequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 6);
+  messageDesc(file_mirai_v1_sme, 10);
 
 /**
  * GetSMEResponse contains the requested SME.
@@ -488,7 +675,7 @@ export type GetSMEResponse = Message<"mirai.v1.GetSMEResponse"> & {
  * Use `create(GetSMEResponseSchema)` to create a new message.
  */
 export const GetSMEResponseSchema: GenMessage<GetSMEResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 7);
+  messageDesc(file_mirai_v1_sme, 11);
 
 /**
  * ListSMEsRequest contains optional filters.
@@ -524,7 +711,7 @@ export type ListSMEsRequest = Message<"mirai.v1.ListSMEsRequest"> & {
  * Use `create(ListSMEsRequestSchema)` to create a new message.
  */
 export const ListSMEsRequestSchema: GenMessage<ListSMEsRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 8);
+  messageDesc(file_mirai_v1_sme, 12);
 
 /**
  * ListSMEsResponse contains SMEs matching the filters.
@@ -543,7 +730,7 @@ export type ListSMEsResponse = Message<"mirai.v1.ListSMEsResponse"> & {
  * Use `create(ListSMEsResponseSchema)` to create a new message.
  */
 export const ListSMEsResponseSchema: GenMessage<ListSMEsResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 9);
+  messageDesc(file_mirai_v1_sme, 13);
 
 /**
  * UpdateSMERequest contains fields to update on an SME.
@@ -592,7 +779,7 @@ export type UpdateSMERequest = Message<"mirai.v1.UpdateSMERequest"> & {
  * Use `create(UpdateSMERequestSchema)` to create a new message.
  */
 export const UpdateSMERequestSchema: GenMessage<UpdateSMERequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 10);
+  messageDesc(file_mirai_v1_sme, 14);
 
 /**
  * UpdateSMEResponse contains the updated SME.
@@ -611,7 +798,7 @@ export type UpdateSMEResponse = Message<"mirai.v1.UpdateSMEResponse"> & {
  * Use `create(UpdateSMEResponseSchema)` to create a new message.
  */
 export const UpdateSMEResponseSchema: GenMessage<UpdateSMEResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 11);
+  messageDesc(file_mirai_v1_sme, 15);
 
 /**
  * DeleteSMERequest contains the SME ID to delete.
@@ -623,6 +810,13 @@ export type DeleteSMERequest = Message<"mirai.v1.DeleteSMERequest"> & {
    * @generated from field: string sme_id = 1;
    */
   smeId: string;
+
+  /**
+   * From GetSMEDeletionImpact
+   *
+   * @generated from field: string confirmation_token = 2;
+   */
+  confirmationToken: string;
 };
 
 /**
@@ -630,7 +824,7 @@ export type DeleteSMERequest = Message<"mirai.v1.DeleteSMERequest"> & {
  * Use `create(DeleteSMERequestSchema)` to create a new message.
  */
 export const DeleteSMERequestSchema: GenMessage<DeleteSMERequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 12);
+  messageDesc(file_mirai_v1_sme, 16);
 
 /**
  * DeleteSMEResponse confirms deletion.
@@ -645,7 +839,7 @@ export type DeleteSMEResponse = Message<"mirai.v1.DeleteSMEResponse"> & {
  * Use `create(DeleteSMEResponseSchema)` to create a new message.
  */
 export const DeleteSMEResponseSchema: GenMessage<DeleteSMEResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 13);
+  messageDesc(file_mirai_v1_sme, 17);
 
 /**
  * RestoreSMERequest contains the SME ID to restore.
@@ -664,7 +858,7 @@ export type RestoreSMERequest = Message<"mirai.v1.RestoreSMERequest"> & {
  * Use `create(RestoreSMERequestSchema)` to create a new message.
  */
 export const RestoreSMERequestSchema: GenMessage<RestoreSMERequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 14);
+  messageDesc(file_mirai_v1_sme, 18);
 
 /**
  * RestoreSMEResponse contains the restored SME.
@@ -683,7 +877,7 @@ export type RestoreSMEResponse = Message<"mirai.v1.RestoreSMEResponse"> & {
  * Use `create(RestoreSMEResponseSchema)` to create a new message.
  */
 export const RestoreSMEResponseSchema: GenMessage<RestoreSMEResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 15);
+  messageDesc(file_mirai_v1_sme, 19);
 
 /**
  * CreateTaskRequest contains data for a new task.
@@ -725,6 +919,13 @@ export type CreateTaskRequest = Message<"mirai.v1.CreateTaskRequest"> & {
    * @generated from field: optional google.protobuf.Timestamp due_date = 7;
    */
   dueDate?: Timestamp;
+
+  /**
+   * Interview questions, e.g. from GenerateTaskQuestions; at most 20
+   *
+   * @generated from field: repeated string questions = 8;
+   */
+  questions: string[];
 };
 
 /**
@@ -732,7 +933,7 @@ export type CreateTaskRequest = Message<"mirai.v1.CreateTaskRequest"> & {
  * Use `create(CreateTaskRequestSchema)` to create a new message.
  */
 export const CreateTaskRequestSchema: GenMessage<CreateTaskRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 16);
+  messageDesc(file_mirai_v1_sme, 20);
 
 /**
  * CreateTaskResponse contains the created task.
@@ -751,7 +952,7 @@ export type CreateTaskResponse = Message<"mirai.v1.CreateTaskResponse"> & {
  * Use `create(CreateTaskResponseSchema)` to create a new message.
  */
 export const CreateTaskResponseSchema: GenMessage<CreateTaskResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 17);
+  messageDesc(file_mirai_v1_sme, 21);
 
 /**
  * GetTaskRequest contains the task ID to fetch.
@@ -770,7 +971,7 @@ export type GetTaskRequest = Message<"mirai.v1.GetTaskRequest"> & {
  * Use `create(GetTaskRequestSchema)` to create a new message.
  */
 export const GetTaskRequestSchema: GenMessage<GetTaskRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 18);
+  messageDesc(file_mirai_v1_sme, 22);
 
 /**
  * GetTaskResponse contains the requested task.
@@ -782,6 +983,11 @@ export type GetTaskResponse = Message<"mirai.v1.GetTaskResponse"> & {
    * @generated from field: mirai.v1.SMETask task = 1;
    */
   task?: SMETask;
+
+  /**
+   * @generated from field: mirai.v1.SubmissionSummary submission_summary = 2;
+   */
+  submissionSummary?: SubmissionSummary;
 };
 
 /**
@@ -789,7 +995,7 @@ export type GetTaskResponse = Message<"mirai.v1.GetTaskResponse"> & {
  * Use `create(GetTaskResponseSchema)` to create a new message.
  */
 export const GetTaskResponseSchema: GenMessage<GetTaskResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 19);
+  messageDesc(file_mirai_v1_sme, 23);
 
 /**
  * ListTasksRequest contains filters for tasks.
@@ -824,7 +1030,7 @@ export type ListTasksRequest = Message<"mirai.v1.ListTasksRequest"> & {
  * Use `create(ListTasksRequestSchema)` to create a new message.
  */
 export const ListTasksRequestSchema: GenMessage<ListTasksRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 20);
+  messageDesc(file_mirai_v1_sme, 24);
 
 /**
  * ListTasksResponse contains tasks matching the filters.
@@ -843,7 +1049,7 @@ export type ListTasksResponse = Message<"mirai.v1.ListTasksResponse"> & {
  * Use `create(ListTasksResponseSchema)` to create a new message.
  */
 export const ListTasksResponseSchema: GenMessage<ListTasksResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 21);
+  messageDesc(file_mirai_v1_sme, 25);
 
 /**
  * UpdateTaskRequest contains fields to update on a task.
@@ -882,7 +1088,7 @@ export type UpdateTaskRequest = Message<"mirai.v1.UpdateTaskRequest"> & {
  * Use `create(UpdateTaskRequestSchema)` to create a new message.
  */
 export const UpdateTaskRequestSchema: GenMessage<UpdateTaskRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 22);
+  messageDesc(file_mirai_v1_sme, 26);
 
 /**
  * UpdateTaskResponse contains the updated task.
@@ -901,7 +1107,7 @@ export type UpdateTaskResponse = Message<"mirai.v1.UpdateTaskResponse"> & {
  * Use `create(UpdateTaskResponseSchema)` to create a new message.
  */
 export const UpdateTaskResponseSchema: GenMessage<UpdateTaskResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 23);
+  messageDesc(file_mirai_v1_sme, 27);
 
 /**
  * CancelTaskRequest contains the task ID to cancel.
@@ -920,7 +1126,7 @@ export type CancelTaskRequest = Message<"mirai.v1.CancelTaskRequest"> & {
  * Use `create(CancelTaskRequestSchema)` to create a new message.
  */
 export const CancelTaskRequestSchema: GenMessage<CancelTaskRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 24);
+  messageDesc(file_mirai_v1_sme, 28);
 
 /**
  * CancelTaskResponse confirms cancellation.
@@ -939,7 +1145,7 @@ export type CancelTaskResponse = Message<"mirai.v1.CancelTaskResponse"> & {
  * Use `create(CancelTaskResponseSchema)` to create a new message.
  */
 export const CancelTaskResponseSchema: GenMessage<CancelTaskResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 25);
+  messageDesc(file_mirai_v1_sme, 29);
 
 /**
  * GetUploadURLRequest requests a presigned URL for upload.
@@ -973,7 +1179,7 @@ export type GetUploadURLRequest = Message<"mirai.v1.GetUploadURLRequest"> & {
  * Use `create(GetUploadURLRequestSchema)` to create a new message.
  */
 export const GetUploadURLRequestSchema: GenMessage<GetUploadURLRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 26);
+  messageDesc(file_mirai_v1_sme, 30);
 
 /**
  * GetUploadURLResponse contains the presigned upload URL.
@@ -1004,116 +1210,363 @@ export type GetUploadURLResponse = Message<"mirai.v1.GetUploadURLResponse"> & {
  * Use `create(GetUploadURLResponseSchema)` to create a new message.
  */
 export const GetUploadURLResponseSchema: GenMessage<GetUploadURLResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 27);
+  messageDesc(file_mirai_v1_sme, 31);
 
 /**
- * SubmitContentRequest records a content submission.
+ * StartMultipartUploadRequest starts a resumable upload. Files over 100 MiB should
+ * use this instead of GetUploadURL; files under 5 MiB cannot.
  *
- * @generated from message mirai.v1.SubmitContentRequest
+ * @generated from message mirai.v1.StartMultipartUploadRequest
  */
-export type SubmitContentRequest = Message<"mirai.v1.SubmitContentRequest"> & {
+export type StartMultipartUploadRequest = Message<"mirai.v1.StartMultipartUploadRequest"> & {
   /**
    * @generated from field: string task_id = 1;
    */
   taskId: string;
 
   /**
-   * Optional for text submissions
-   *
    * @generated from field: string file_name = 2;
    */
   fileName: string;
 
   /**
-   * S3 path from GetUploadURL (optional for text)
-   *
-   * @generated from field: string file_path = 3;
+   * @generated from field: int64 file_size_bytes = 3;
    */
-  filePath: string;
+  fileSizeBytes: bigint;
+};
+
+/**
+ * Describes the message mirai.v1.StartMultipartUploadRequest.
+ * Use `create(StartMultipartUploadRequestSchema)` to create a new message.
+ */
+export const StartMultipartUploadRequestSchema: GenMessage<StartMultipartUploadRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 32);
 
+/**
+ * StartMultipartUploadResponse describes how to split the file. Every part except
+ * the last must be exactly part_size_bytes.
+ *
+ * @generated from message mirai.v1.StartMultipartUploadResponse
+ */
+export type StartMultipartUploadResponse = Message<"mirai.v1.StartMultipartUploadResponse"> & {
   /**
-   * @generated from field: mirai.v1.ContentType content_type = 4;
+   * @generated from field: string upload_id = 1;
    */
-  contentType: ContentType;
+  uploadId: string;
 
   /**
-   * Optional for text submissions
+   * Pass to GetPartUploadURLs, CompleteMultipartUpload and SubmitContent
    *
-   * @generated from field: int64 file_size_bytes = 5;
+   * @generated from field: string file_path = 2;
    */
-  fileSizeBytes: bigint;
+  filePath: string;
 
   /**
-   * Direct text content (for CONTENT_TYPE_TEXT)
-   *
-   * @generated from field: optional string text_content = 6;
+   * @generated from field: int64 part_size_bytes = 3;
    */
-  textContent?: string;
+  partSizeBytes: bigint;
+
+  /**
+   * @generated from field: int32 part_count = 4;
+   */
+  partCount: number;
 };
 
 /**
- * Describes the message mirai.v1.SubmitContentRequest.
- * Use `create(SubmitContentRequestSchema)` to create a new message.
+ * Describes the message mirai.v1.StartMultipartUploadResponse.
+ * Use `create(StartMultipartUploadResponseSchema)` to create a new message.
  */
-export const SubmitContentRequestSchema: GenMessage<SubmitContentRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 28);
+export const StartMultipartUploadResponseSchema: GenMessage<StartMultipartUploadResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 33);
 
 /**
- * SubmitContentResponse contains the created submission.
+ * GetPartUploadURLsRequest requests presigned URLs for up to 100 parts.
  *
- * @generated from message mirai.v1.SubmitContentResponse
+ * @generated from message mirai.v1.GetPartUploadURLsRequest
  */
-export type SubmitContentResponse = Message<"mirai.v1.SubmitContentResponse"> & {
+export type GetPartUploadURLsRequest = Message<"mirai.v1.GetPartUploadURLsRequest"> & {
   /**
-   * @generated from field: mirai.v1.SMETaskSubmission submission = 1;
+   * @generated from field: string task_id = 1;
    */
-  submission?: SMETaskSubmission;
+  taskId: string;
+
+  /**
+   * @generated from field: string file_path = 2;
+   */
+  filePath: string;
+
+  /**
+   * @generated from field: string upload_id = 3;
+   */
+  uploadId: string;
+
+  /**
+   * Same size as passed to StartMultipartUpload
+   *
+   * @generated from field: int64 file_size_bytes = 4;
+   */
+  fileSizeBytes: bigint;
+
+  /**
+   * 1-based
+   *
+   * @generated from field: repeated int32 part_numbers = 5;
+   */
+  partNumbers: number[];
 };
 
 /**
- * Describes the message mirai.v1.SubmitContentResponse.
- * Use `create(SubmitContentResponseSchema)` to create a new message.
+ * Describes the message mirai.v1.GetPartUploadURLsRequest.
+ * Use `create(GetPartUploadURLsRequestSchema)` to create a new message.
  */
-export const SubmitContentResponseSchema: GenMessage<SubmitContentResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 29);
+export const GetPartUploadURLsRequestSchema: GenMessage<GetPartUploadURLsRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 34);
 
 /**
- * ListSubmissionsRequest contains the task ID.
+ * PartUploadURL is a presigned PUT URL for one part.
  *
- * @generated from message mirai.v1.ListSubmissionsRequest
+ * @generated from message mirai.v1.PartUploadURL
  */
-export type ListSubmissionsRequest = Message<"mirai.v1.ListSubmissionsRequest"> & {
+export type PartUploadURL = Message<"mirai.v1.PartUploadURL"> & {
   /**
-   * @generated from field: string task_id = 1;
+   * @generated from field: int32 part_number = 1;
    */
-  taskId: string;
+  partNumber: number;
+
+  /**
+   * @generated from field: string upload_url = 2;
+   */
+  uploadUrl: string;
 };
 
 /**
- * Describes the message mirai.v1.ListSubmissionsRequest.
- * Use `create(ListSubmissionsRequestSchema)` to create a new message.
+ * Describes the message mirai.v1.PartUploadURL.
+ * Use `create(PartUploadURLSchema)` to create a new message.
  */
-export const ListSubmissionsRequestSchema: GenMessage<ListSubmissionsRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 30);
+export const PartUploadURLSchema: GenMessage<PartUploadURL> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 35);
 
 /**
- * ListSubmissionsResponse contains all submissions for the task.
+ * GetPartUploadURLsResponse contains the part URLs and the parts already stored,
+ * so a resumed upload can skip them.
  *
- * @generated from message mirai.v1.ListSubmissionsResponse
+ * @generated from message mirai.v1.GetPartUploadURLsResponse
  */
-export type ListSubmissionsResponse = Message<"mirai.v1.ListSubmissionsResponse"> & {
+export type GetPartUploadURLsResponse = Message<"mirai.v1.GetPartUploadURLsResponse"> & {
   /**
-   * @generated from field: repeated mirai.v1.SMETaskSubmission submissions = 1;
+   * @generated from field: repeated mirai.v1.PartUploadURL parts = 1;
    */
-  submissions: SMETaskSubmission[];
-};
+  parts: PartUploadURL[];
+
+  /**
+   * @generated from field: repeated int32 uploaded_part_numbers = 2;
+   */
+  uploadedPartNumbers: number[];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp expires_at = 3;
+   */
+  expiresAt?: Timestamp;
+};
+
+/**
+ * Describes the message mirai.v1.GetPartUploadURLsResponse.
+ * Use `create(GetPartUploadURLsResponseSchema)` to create a new message.
+ */
+export const GetPartUploadURLsResponseSchema: GenMessage<GetPartUploadURLsResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 36);
+
+/**
+ * CompleteMultipartUploadRequest assembles an upload once every part is stored.
+ *
+ * @generated from message mirai.v1.CompleteMultipartUploadRequest
+ */
+export type CompleteMultipartUploadRequest = Message<"mirai.v1.CompleteMultipartUploadRequest"> & {
+  /**
+   * @generated from field: string task_id = 1;
+   */
+  taskId: string;
+
+  /**
+   * @generated from field: string file_path = 2;
+   */
+  filePath: string;
+
+  /**
+   * @generated from field: string upload_id = 3;
+   */
+  uploadId: string;
+
+  /**
+   * Same size as passed to StartMultipartUpload
+   *
+   * @generated from field: int64 file_size_bytes = 4;
+   */
+  fileSizeBytes: bigint;
+};
+
+/**
+ * Describes the message mirai.v1.CompleteMultipartUploadRequest.
+ * Use `create(CompleteMultipartUploadRequestSchema)` to create a new message.
+ */
+export const CompleteMultipartUploadRequestSchema: GenMessage<CompleteMultipartUploadRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 37);
+
+/**
+ * CompleteMultipartUploadResponse contains the path of the assembled file.
+ *
+ * @generated from message mirai.v1.CompleteMultipartUploadResponse
+ */
+export type CompleteMultipartUploadResponse = Message<"mirai.v1.CompleteMultipartUploadResponse"> & {
+  /**
+   * @generated from field: string file_path = 1;
+   */
+  filePath: string;
+};
+
+/**
+ * Describes the message mirai.v1.CompleteMultipartUploadResponse.
+ * Use `create(CompleteMultipartUploadResponseSchema)` to create a new message.
+ */
+export const CompleteMultipartUploadResponseSchema: GenMessage<CompleteMultipartUploadResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 38);
+
+/**
+ * SubmitContentRequest records a content submission.
+ *
+ * @generated from message mirai.v1.SubmitContentRequest
+ */
+export type SubmitContentRequest = Message<"mirai.v1.SubmitContentRequest"> & {
+  /**
+   * @generated from field: string task_id = 1;
+   */
+  taskId: string;
+
+  /**
+   * Optional for text submissions
+   *
+   * @generated from field: string file_name = 2;
+   */
+  fileName: string;
+
+  /**
+   * S3 path from GetUploadURL (optional for text)
+   *
+   * @generated from field: string file_path = 3;
+   */
+  filePath: string;
+
+  /**
+   * @generated from field: mirai.v1.ContentType content_type = 4;
+   */
+  contentType: ContentType;
+
+  /**
+   * Optional for text submissions
+   *
+   * @generated from field: int64 file_size_bytes = 5;
+   */
+  fileSizeBytes: bigint;
+
+  /**
+   * Direct text content (for CONTENT_TYPE_TEXT)
+   *
+   * @generated from field: optional string text_content = 6;
+   */
+  textContent?: string;
+};
+
+/**
+ * Describes the message mirai.v1.SubmitContentRequest.
+ * Use `create(SubmitContentRequestSchema)` to create a new message.
+ */
+export const SubmitContentRequestSchema: GenMessage<SubmitContentRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 39);
+
+/**
+ * SubmitContentResponse contains the created submission.
+ *
+ * @generated from message mirai.v1.SubmitContentResponse
+ */
+export type SubmitContentResponse = Message<"mirai.v1.SubmitContentResponse"> & {
+  /**
+   * @generated from field: mirai.v1.SMETaskSubmission submission = 1;
+   */
+  submission?: SMETaskSubmission;
+};
+
+/**
+ * Describes the message mirai.v1.SubmitContentResponse.
+ * Use `create(SubmitContentResponseSchema)` to create a new message.
+ */
+export const SubmitContentResponseSchema: GenMessage<SubmitContentResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 40);
+
+/**
+ * ListSubmissionsRequest contains the task ID and filters.
+ *
+ * @generated from message mirai.v1.ListSubmissionsRequest
+ */
+export type ListSubmissionsRequest = Message<"mirai.v1.ListSubmissionsRequest"> & {
+  /**
+   * @generated from field: string task_id = 1;
+   */
+  taskId: string;
+
+  /**
+   * Filter by status
+   *
+   * @generated from field: optional mirai.v1.SubmissionStatus status = 2;
+   */
+  status?: SubmissionStatus;
+
+  /**
+   * Max results (default 20, max 100)
+   *
+   * @generated from field: int32 limit = 3;
+   */
+  limit: number;
+
+  /**
+   * For pagination
+   *
+   * @generated from field: optional string cursor = 4;
+   */
+  cursor?: string;
+};
+
+/**
+ * Describes the message mirai.v1.ListSubmissionsRequest.
+ * Use `create(ListSubmissionsRequestSchema)` to create a new message.
+ */
+export const ListSubmissionsRequestSchema: GenMessage<ListSubmissionsRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 41);
+
+/**
+ * ListSubmissionsResponse contains a page of the task's submissions, newest first.
+ *
+ * @generated from message mirai.v1.ListSubmissionsResponse
+ */
+export type ListSubmissionsResponse = Message<"mirai.v1.ListSubmissionsResponse"> & {
+  /**
+   * @generated from field: repeated mirai.v1.SMETaskSubmission submissions = 1;
+   */
+  submissions: SMETaskSubmission[];
+
+  /**
+   * For pagination
+   *
+   * @generated from field: optional string next_cursor = 2;
+   */
+  nextCursor?: string;
+};
 
 /**
  * Describes the message mirai.v1.ListSubmissionsResponse.
  * Use `create(ListSubmissionsResponseSchema)` to create a new message.
  */
 export const ListSubmissionsResponseSchema: GenMessage<ListSubmissionsResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 31);
+  messageDesc(file_mirai_v1_sme, 42);
 
 /**
  * GetKnowledgeRequest requests knowledge for an SME.
@@ -1125,6 +1578,13 @@ export type GetKnowledgeRequest = Message<"mirai.v1.GetKnowledgeRequest"> & {
    * @generated from field: string sme_id = 1;
    */
   smeId: string;
+
+  /**
+   * Only chunks filed under this topic
+   *
+   * @generated from field: optional string topic = 2;
+   */
+  topic?: string;
 };
 
 /**
@@ -1132,7 +1592,7 @@ export type GetKnowledgeRequest = Message<"mirai.v1.GetKnowledgeRequest"> & {
  * Use `create(GetKnowledgeRequestSchema)` to create a new message.
  */
 export const GetKnowledgeRequestSchema: GenMessage<GetKnowledgeRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 32);
+  messageDesc(file_mirai_v1_sme, 43);
 
 /**
  * GetKnowledgeResponse contains the SME's knowledge.
@@ -1156,7 +1616,69 @@ export type GetKnowledgeResponse = Message<"mirai.v1.GetKnowledgeResponse"> & {
  * Use `create(GetKnowledgeResponseSchema)` to create a new message.
  */
 export const GetKnowledgeResponseSchema: GenMessage<GetKnowledgeResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 33);
+  messageDesc(file_mirai_v1_sme, 44);
+
+/**
+ * ListKnowledgeTopicsRequest requests the topics of an SME's knowledge.
+ *
+ * @generated from message mirai.v1.ListKnowledgeTopicsRequest
+ */
+export type ListKnowledgeTopicsRequest = Message<"mirai.v1.ListKnowledgeTopicsRequest"> & {
+  /**
+   * @generated from field: string sme_id = 1;
+   */
+  smeId: string;
+};
+
+/**
+ * Describes the message mirai.v1.ListKnowledgeTopicsRequest.
+ * Use `create(ListKnowledgeTopicsRequestSchema)` to create a new message.
+ */
+export const ListKnowledgeTopicsRequestSchema: GenMessage<ListKnowledgeTopicsRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 45);
+
+/**
+ * KnowledgeTopic is a topic label and how many chunks are filed under it.
+ *
+ * @generated from message mirai.v1.KnowledgeTopic
+ */
+export type KnowledgeTopic = Message<"mirai.v1.KnowledgeTopic"> & {
+  /**
+   * @generated from field: string topic = 1;
+   */
+  topic: string;
+
+  /**
+   * @generated from field: int32 chunk_count = 2;
+   */
+  chunkCount: number;
+};
+
+/**
+ * Describes the message mirai.v1.KnowledgeTopic.
+ * Use `create(KnowledgeTopicSchema)` to create a new message.
+ */
+export const KnowledgeTopicSchema: GenMessage<KnowledgeTopic> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 46);
+
+/**
+ * ListKnowledgeTopicsResponse contains the SME's topics, largest first.
+ *
+ * @generated from message mirai.v1.ListKnowledgeTopicsResponse
+ */
+export type ListKnowledgeTopicsResponse = Message<"mirai.v1.ListKnowledgeTopicsResponse"> & {
+  /**
+   * @generated from field: repeated mirai.v1.KnowledgeTopic topics = 1;
+   */
+  topics: KnowledgeTopic[];
+};
+
+/**
+ * Describes the message mirai.v1.ListKnowledgeTopicsResponse.
+ * Use `create(ListKnowledgeTopicsResponseSchema)` to create a new message.
+ */
+export const ListKnowledgeTopicsResponseSchema: GenMessage<ListKnowledgeTopicsResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 47);
 
 /**
  * SearchKnowledgeRequest searches across SME knowledge.
@@ -1191,7 +1713,7 @@ export type SearchKnowledgeRequest = Message<"mirai.v1.SearchKnowledgeRequest">
  * Use `create(SearchKnowledgeRequestSchema)` to create a new message.
  */
 export const SearchKnowledgeRequestSchema: GenMessage<SearchKnowledgeRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 34);
+  messageDesc(file_mirai_v1_sme, 48);
 
 /**
  * SearchKnowledgeResponse contains matching knowledge chunks.
@@ -1210,7 +1732,7 @@ export type SearchKnowledgeResponse = Message<"mirai.v1.SearchKnowledgeResponse"
  * Use `create(SearchKnowledgeResponseSchema)` to create a new message.
  */
 export const SearchKnowledgeResponseSchema: GenMessage<SearchKnowledgeResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 35);
+  messageDesc(file_mirai_v1_sme, 49);
 
 /**
  * GetSubmissionRequest requests a specific submission.
@@ -1229,7 +1751,7 @@ export type GetSubmissionRequest = Message<"mirai.v1.GetSubmissionRequest"> & {
  * Use `create(GetSubmissionRequestSchema)` to create a new message.
  */
 export const GetSubmissionRequestSchema: GenMessage<GetSubmissionRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 36);
+  messageDesc(file_mirai_v1_sme, 50);
 
 /**
  * GetSubmissionResponse contains the requested submission.
@@ -1248,73 +1770,123 @@ export type GetSubmissionResponse = Message<"mirai.v1.GetSubmissionResponse"> &
  * Use `create(GetSubmissionResponseSchema)` to create a new message.
  */
 export const GetSubmissionResponseSchema: GenMessage<GetSubmissionResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 37);
+  messageDesc(file_mirai_v1_sme, 51);
 
 /**
- * ApproveSubmissionRequest approves a submission and creates knowledge.
+ * ReprocessSubmissionRequest retries a failed ingestion.
  *
- * @generated from message mirai.v1.ApproveSubmissionRequest
+ * @generated from message mirai.v1.ReprocessSubmissionRequest
  */
-export type ApproveSubmissionRequest = Message<"mirai.v1.ApproveSubmissionRequest"> & {
+export type ReprocessSubmissionRequest = Message<"mirai.v1.ReprocessSubmissionRequest"> & {
   /**
    * @generated from field: string submission_id = 1;
    */
   submissionId: string;
 
   /**
-   * The final content to use (may be edited by reviewer)
+   * Path from GetUploadURL; omit to re-run the original file
    *
-   * @generated from field: string approved_content = 2;
+   * @generated from field: optional string replacement_file_path = 2;
    */
-  approvedContent: string;
+  replacementFilePath?: string;
 };
 
 /**
- * Describes the message mirai.v1.ApproveSubmissionRequest.
- * Use `create(ApproveSubmissionRequestSchema)` to create a new message.
+ * Describes the message mirai.v1.ReprocessSubmissionRequest.
+ * Use `create(ReprocessSubmissionRequestSchema)` to create a new message.
  */
-export const ApproveSubmissionRequestSchema: GenMessage<ApproveSubmissionRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 38);
+export const ReprocessSubmissionRequestSchema: GenMessage<ReprocessSubmissionRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 52);
 
 /**
- * ApproveSubmissionResponse contains the approved submission and created knowledge.
+ * ReprocessSubmissionResponse contains the reset submission and the new ingestion job.
  *
- * @generated from message mirai.v1.ApproveSubmissionResponse
+ * @generated from message mirai.v1.ReprocessSubmissionResponse
  */
-export type ApproveSubmissionResponse = Message<"mirai.v1.ApproveSubmissionResponse"> & {
+export type ReprocessSubmissionResponse = Message<"mirai.v1.ReprocessSubmissionResponse"> & {
   /**
    * @generated from field: mirai.v1.SMETaskSubmission submission = 1;
    */
   submission?: SMETaskSubmission;
 
   /**
-   * @generated from field: repeated mirai.v1.SMEKnowledgeChunk created_chunks = 2;
+   * @generated from field: string job_id = 2;
    */
-  createdChunks: SMEKnowledgeChunk[];
+  jobId: string;
 };
 
 /**
- * Describes the message mirai.v1.ApproveSubmissionResponse.
- * Use `create(ApproveSubmissionResponseSchema)` to create a new message.
+ * Describes the message mirai.v1.ReprocessSubmissionResponse.
+ * Use `create(ReprocessSubmissionResponseSchema)` to create a new message.
  */
-export const ApproveSubmissionResponseSchema: GenMessage<ApproveSubmissionResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 39);
+export const ReprocessSubmissionResponseSchema: GenMessage<ReprocessSubmissionResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 53);
 
 /**
- * RequestSubmissionChangesRequest sends submission back for revision.
+ * ApproveSubmissionRequest approves a submission and creates knowledge.
  *
- * @generated from message mirai.v1.RequestSubmissionChangesRequest
+ * @generated from message mirai.v1.ApproveSubmissionRequest
  */
-export type RequestSubmissionChangesRequest = Message<"mirai.v1.RequestSubmissionChangesRequest"> & {
+export type ApproveSubmissionRequest = Message<"mirai.v1.ApproveSubmissionRequest"> & {
   /**
    * @generated from field: string submission_id = 1;
    */
   submissionId: string;
 
   /**
-   * What needs to change
+   * The final content to use (may be edited by reviewer)
    *
-   * @generated from field: string feedback = 2;
+   * @generated from field: string approved_content = 2;
+   */
+  approvedContent: string;
+};
+
+/**
+ * Describes the message mirai.v1.ApproveSubmissionRequest.
+ * Use `create(ApproveSubmissionRequestSchema)` to create a new message.
+ */
+export const ApproveSubmissionRequestSchema: GenMessage<ApproveSubmissionRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 54);
+
+/**
+ * ApproveSubmissionResponse contains the approved submission and created knowledge.
+ *
+ * @generated from message mirai.v1.ApproveSubmissionResponse
+ */
+export type ApproveSubmissionResponse = Message<"mirai.v1.ApproveSubmissionResponse"> & {
+  /**
+   * @generated from field: mirai.v1.SMETaskSubmission submission = 1;
+   */
+  submission?: SMETaskSubmission;
+
+  /**
+   * @generated from field: repeated mirai.v1.SMEKnowledgeChunk created_chunks = 2;
+   */
+  createdChunks: SMEKnowledgeChunk[];
+};
+
+/**
+ * Describes the message mirai.v1.ApproveSubmissionResponse.
+ * Use `create(ApproveSubmissionResponseSchema)` to create a new message.
+ */
+export const ApproveSubmissionResponseSchema: GenMessage<ApproveSubmissionResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 55);
+
+/**
+ * RequestSubmissionChangesRequest sends submission back for revision.
+ *
+ * @generated from message mirai.v1.RequestSubmissionChangesRequest
+ */
+export type RequestSubmissionChangesRequest = Message<"mirai.v1.RequestSubmissionChangesRequest"> & {
+  /**
+   * @generated from field: string submission_id = 1;
+   */
+  submissionId: string;
+
+  /**
+   * What needs to change
+   *
+   * @generated from field: string feedback = 2;
    */
   feedback: string;
 };
@@ -1324,7 +1896,7 @@ export type RequestSubmissionChangesRequest = Message<"mirai.v1.RequestSubmissio
  * Use `create(RequestSubmissionChangesRequestSchema)` to create a new message.
  */
 export const RequestSubmissionChangesRequestSchema: GenMessage<RequestSubmissionChangesRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 40);
+  messageDesc(file_mirai_v1_sme, 56);
 
 /**
  * RequestSubmissionChangesResponse contains the updated submission.
@@ -1333,186 +1905,747 @@ export const RequestSubmissionChangesRequestSchema: GenMessage<RequestSubmission
  */
 export type RequestSubmissionChangesResponse = Message<"mirai.v1.RequestSubmissionChangesResponse"> & {
   /**
-   * @generated from field: mirai.v1.SMETaskSubmission submission = 1;
+   * @generated from field: mirai.v1.SMETaskSubmission submission = 1;
+   */
+  submission?: SMETaskSubmission;
+};
+
+/**
+ * Describes the message mirai.v1.RequestSubmissionChangesResponse.
+ * Use `create(RequestSubmissionChangesResponseSchema)` to create a new message.
+ */
+export const RequestSubmissionChangesResponseSchema: GenMessage<RequestSubmissionChangesResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 57);
+
+/**
+ * EnhanceSubmissionContentRequest requests AI enhancement of content.
+ *
+ * @generated from message mirai.v1.EnhanceSubmissionContentRequest
+ */
+export type EnhanceSubmissionContentRequest = Message<"mirai.v1.EnhanceSubmissionContentRequest"> & {
+  /**
+   * @generated from field: string submission_id = 1;
+   */
+  submissionId: string;
+
+  /**
+   * @generated from field: mirai.v1.EnhanceType enhance_type = 2;
+   */
+  enhanceType: EnhanceType;
+};
+
+/**
+ * Describes the message mirai.v1.EnhanceSubmissionContentRequest.
+ * Use `create(EnhanceSubmissionContentRequestSchema)` to create a new message.
+ */
+export const EnhanceSubmissionContentRequestSchema: GenMessage<EnhanceSubmissionContentRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 58);
+
+/**
+ * EnhanceSubmissionContentResponse contains enhanced content.
+ *
+ * @generated from message mirai.v1.EnhanceSubmissionContentResponse
+ */
+export type EnhanceSubmissionContentResponse = Message<"mirai.v1.EnhanceSubmissionContentResponse"> & {
+  /**
+   * @generated from field: string enhanced_content = 1;
+   */
+  enhancedContent: string;
+
+  /**
+   * @generated from field: string original_content = 2;
+   */
+  originalContent: string;
+};
+
+/**
+ * Describes the message mirai.v1.EnhanceSubmissionContentResponse.
+ * Use `create(EnhanceSubmissionContentResponseSchema)` to create a new message.
+ */
+export const EnhanceSubmissionContentResponseSchema: GenMessage<EnhanceSubmissionContentResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 59);
+
+/**
+ * UpdateKnowledgeChunkRequest updates a knowledge chunk.
+ *
+ * @generated from message mirai.v1.UpdateKnowledgeChunkRequest
+ */
+export type UpdateKnowledgeChunkRequest = Message<"mirai.v1.UpdateKnowledgeChunkRequest"> & {
+  /**
+   * @generated from field: string chunk_id = 1;
+   */
+  chunkId: string;
+
+  /**
+   * @generated from field: string content = 2;
+   */
+  content: string;
+
+  /**
+   * @generated from field: optional string topic = 3;
+   */
+  topic?: string;
+
+  /**
+   * @generated from field: repeated string keywords = 4;
+   */
+  keywords: string[];
+};
+
+/**
+ * Describes the message mirai.v1.UpdateKnowledgeChunkRequest.
+ * Use `create(UpdateKnowledgeChunkRequestSchema)` to create a new message.
+ */
+export const UpdateKnowledgeChunkRequestSchema: GenMessage<UpdateKnowledgeChunkRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 60);
+
+/**
+ * UpdateKnowledgeChunkResponse contains the updated chunk.
+ *
+ * @generated from message mirai.v1.UpdateKnowledgeChunkResponse
+ */
+export type UpdateKnowledgeChunkResponse = Message<"mirai.v1.UpdateKnowledgeChunkResponse"> & {
+  /**
+   * @generated from field: mirai.v1.SMEKnowledgeChunk chunk = 1;
+   */
+  chunk?: SMEKnowledgeChunk;
+};
+
+/**
+ * Describes the message mirai.v1.UpdateKnowledgeChunkResponse.
+ * Use `create(UpdateKnowledgeChunkResponseSchema)` to create a new message.
+ */
+export const UpdateKnowledgeChunkResponseSchema: GenMessage<UpdateKnowledgeChunkResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 61);
+
+/**
+ * DeleteKnowledgeChunkRequest deletes a knowledge chunk.
+ *
+ * @generated from message mirai.v1.DeleteKnowledgeChunkRequest
+ */
+export type DeleteKnowledgeChunkRequest = Message<"mirai.v1.DeleteKnowledgeChunkRequest"> & {
+  /**
+   * @generated from field: string chunk_id = 1;
+   */
+  chunkId: string;
+};
+
+/**
+ * Describes the message mirai.v1.DeleteKnowledgeChunkRequest.
+ * Use `create(DeleteKnowledgeChunkRequestSchema)` to create a new message.
+ */
+export const DeleteKnowledgeChunkRequestSchema: GenMessage<DeleteKnowledgeChunkRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 62);
+
+/**
+ * DeleteKnowledgeChunkResponse confirms deletion.
+ *
+ * @generated from message mirai.v1.DeleteKnowledgeChunkResponse
+ */
+export type DeleteKnowledgeChunkResponse = Message<"mirai.v1.DeleteKnowledgeChunkResponse"> & {
+};
+
+/**
+ * Describes the message mirai.v1.DeleteKnowledgeChunkResponse.
+ * Use `create(DeleteKnowledgeChunkResponseSchema)` to create a new message.
+ */
+export const DeleteKnowledgeChunkResponseSchema: GenMessage<DeleteKnowledgeChunkResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 63);
+
+/**
+ * ReviewFlaggedKnowledgeChunkRequest records an admin's review of a flagged chunk.
+ *
+ * @generated from message mirai.v1.ReviewFlaggedKnowledgeChunkRequest
+ */
+export type ReviewFlaggedKnowledgeChunkRequest = Message<"mirai.v1.ReviewFlaggedKnowledgeChunkRequest"> & {
+  /**
+   * @generated from field: string chunk_id = 1;
+   */
+  chunkId: string;
+
+  /**
+   * Include the chunk in generation; false holds it back
+   *
+   * @generated from field: bool release = 2;
+   */
+  release: boolean;
+};
+
+/**
+ * Describes the message mirai.v1.ReviewFlaggedKnowledgeChunkRequest.
+ * Use `create(ReviewFlaggedKnowledgeChunkRequestSchema)` to create a new message.
+ */
+export const ReviewFlaggedKnowledgeChunkRequestSchema: GenMessage<ReviewFlaggedKnowledgeChunkRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 64);
+
+/**
+ * ReviewFlaggedKnowledgeChunkResponse contains the reviewed chunk.
+ *
+ * @generated from message mirai.v1.ReviewFlaggedKnowledgeChunkResponse
+ */
+export type ReviewFlaggedKnowledgeChunkResponse = Message<"mirai.v1.ReviewFlaggedKnowledgeChunkResponse"> & {
+  /**
+   * @generated from field: mirai.v1.SMEKnowledgeChunk chunk = 1;
+   */
+  chunk?: SMEKnowledgeChunk;
+};
+
+/**
+ * Describes the message mirai.v1.ReviewFlaggedKnowledgeChunkResponse.
+ * Use `create(ReviewFlaggedKnowledgeChunkResponseSchema)` to create a new message.
+ */
+export const ReviewFlaggedKnowledgeChunkResponseSchema: GenMessage<ReviewFlaggedKnowledgeChunkResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 65);
+
+/**
+ * DeleteTaskRequest permanently deletes a task.
+ *
+ * @generated from message mirai.v1.DeleteTaskRequest
+ */
+export type DeleteTaskRequest = Message<"mirai.v1.DeleteTaskRequest"> & {
+  /**
+   * @generated from field: string task_id = 1;
+   */
+  taskId: string;
+};
+
+/**
+ * Describes the message mirai.v1.DeleteTaskRequest.
+ * Use `create(DeleteTaskRequestSchema)` to create a new message.
+ */
+export const DeleteTaskRequestSchema: GenMessage<DeleteTaskRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 66);
+
+/**
+ * DeleteTaskResponse confirms task deletion.
+ *
+ * @generated from message mirai.v1.DeleteTaskResponse
+ */
+export type DeleteTaskResponse = Message<"mirai.v1.DeleteTaskResponse"> & {
+};
+
+/**
+ * Describes the message mirai.v1.DeleteTaskResponse.
+ * Use `create(DeleteTaskResponseSchema)` to create a new message.
+ */
+export const DeleteTaskResponseSchema: GenMessage<DeleteTaskResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 67);
+
+/**
+ * GetSMEStatsRequest requests contribution stats for accessible SMEs.
+ *
+ * @generated from message mirai.v1.GetSMEStatsRequest
+ */
+export type GetSMEStatsRequest = Message<"mirai.v1.GetSMEStatsRequest"> & {
+};
+
+/**
+ * Describes the message mirai.v1.GetSMEStatsRequest.
+ * Use `create(GetSMEStatsRequestSchema)` to create a new message.
+ */
+export const GetSMEStatsRequestSchema: GenMessage<GetSMEStatsRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 68);
+
+/**
+ * GetSMEStatsResponse contains stats ordered by knowledge chunk count.
+ *
+ * @generated from message mirai.v1.GetSMEStatsResponse
+ */
+export type GetSMEStatsResponse = Message<"mirai.v1.GetSMEStatsResponse"> & {
+  /**
+   * @generated from field: repeated mirai.v1.SMEStats stats = 1;
+   */
+  stats: SMEStats[];
+
+  /**
+   * Threshold used for low_coverage
+   *
+   * @generated from field: int32 min_knowledge_chunks = 2;
+   */
+  minKnowledgeChunks: number;
+};
+
+/**
+ * Describes the message mirai.v1.GetSMEStatsResponse.
+ * Use `create(GetSMEStatsResponseSchema)` to create a new message.
+ */
+export const GetSMEStatsResponseSchema: GenMessage<GetSMEStatsResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 69);
+
+/**
+ * ExportSMEKnowledgeRequest exports one SME's knowledge.
+ *
+ * @generated from message mirai.v1.ExportSMEKnowledgeRequest
+ */
+export type ExportSMEKnowledgeRequest = Message<"mirai.v1.ExportSMEKnowledgeRequest"> & {
+  /**
+   * @generated from field: string sme_id = 1;
+   */
+  smeId: string;
+};
+
+/**
+ * Describes the message mirai.v1.ExportSMEKnowledgeRequest.
+ * Use `create(ExportSMEKnowledgeRequestSchema)` to create a new message.
+ */
+export const ExportSMEKnowledgeRequestSchema: GenMessage<ExportSMEKnowledgeRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 70);
+
+/**
+ * ExportSMEKnowledgeResponse contains the export job; track it with GetJob.
+ *
+ * @generated from message mirai.v1.ExportSMEKnowledgeResponse
+ */
+export type ExportSMEKnowledgeResponse = Message<"mirai.v1.ExportSMEKnowledgeResponse"> & {
+  /**
+   * @generated from field: string job_id = 1;
+   */
+  jobId: string;
+};
+
+/**
+ * Describes the message mirai.v1.ExportSMEKnowledgeResponse.
+ * Use `create(ExportSMEKnowledgeResponseSchema)` to create a new message.
+ */
+export const ExportSMEKnowledgeResponseSchema: GenMessage<ExportSMEKnowledgeResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 71);
+
+/**
+ * GetKnowledgeImportUploadURLRequest requests an upload slot for an archive.
+ *
+ * @generated from message mirai.v1.GetKnowledgeImportUploadURLRequest
+ */
+export type GetKnowledgeImportUploadURLRequest = Message<"mirai.v1.GetKnowledgeImportUploadURLRequest"> & {
+};
+
+/**
+ * Describes the message mirai.v1.GetKnowledgeImportUploadURLRequest.
+ * Use `create(GetKnowledgeImportUploadURLRequestSchema)` to create a new message.
+ */
+export const GetKnowledgeImportUploadURLRequestSchema: GenMessage<GetKnowledgeImportUploadURLRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 72);
+
+/**
+ * GetKnowledgeImportUploadURLResponse contains the presigned upload URL.
+ *
+ * @generated from message mirai.v1.GetKnowledgeImportUploadURLResponse
+ */
+export type GetKnowledgeImportUploadURLResponse = Message<"mirai.v1.GetKnowledgeImportUploadURLResponse"> & {
+  /**
+   * @generated from field: string upload_url = 1;
+   */
+  uploadUrl: string;
+
+  /**
+   * Pass to ImportSMEKnowledge once uploaded
+   *
+   * @generated from field: string file_path = 2;
+   */
+  filePath: string;
+};
+
+/**
+ * Describes the message mirai.v1.GetKnowledgeImportUploadURLResponse.
+ * Use `create(GetKnowledgeImportUploadURLResponseSchema)` to create a new message.
+ */
+export const GetKnowledgeImportUploadURLResponseSchema: GenMessage<GetKnowledgeImportUploadURLResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 73);
+
+/**
+ * ImportSMEKnowledgeRequest imports an uploaded archive.
+ *
+ * @generated from message mirai.v1.ImportSMEKnowledgeRequest
+ */
+export type ImportSMEKnowledgeRequest = Message<"mirai.v1.ImportSMEKnowledgeRequest"> & {
+  /**
+   * Path from GetKnowledgeImportUploadURL
+   *
+   * @generated from field: string file_path = 1;
+   */
+  filePath: string;
+
+  /**
+   * Import into this SME; omit to create one from the archive
+   *
+   * @generated from field: optional string target_sme_id = 2;
+   */
+  targetSmeId?: string;
+};
+
+/**
+ * Describes the message mirai.v1.ImportSMEKnowledgeRequest.
+ * Use `create(ImportSMEKnowledgeRequestSchema)` to create a new message.
+ */
+export const ImportSMEKnowledgeRequestSchema: GenMessage<ImportSMEKnowledgeRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 74);
+
+/**
+ * ImportSMEKnowledgeResponse contains the SME receiving the knowledge and the import job.
+ *
+ * @generated from message mirai.v1.ImportSMEKnowledgeResponse
+ */
+export type ImportSMEKnowledgeResponse = Message<"mirai.v1.ImportSMEKnowledgeResponse"> & {
+  /**
+   * @generated from field: mirai.v1.SubjectMatterExpert sme = 1;
+   */
+  sme?: SubjectMatterExpert;
+
+  /**
+   * @generated from field: string job_id = 2;
+   */
+  jobId: string;
+};
+
+/**
+ * Describes the message mirai.v1.ImportSMEKnowledgeResponse.
+ * Use `create(ImportSMEKnowledgeResponseSchema)` to create a new message.
+ */
+export const ImportSMEKnowledgeResponseSchema: GenMessage<ImportSMEKnowledgeResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 75);
+
+/**
+ * GenerateTaskQuestionsRequest names the SME to interview and the course goal.
+ *
+ * @generated from message mirai.v1.GenerateTaskQuestionsRequest
+ */
+export type GenerateTaskQuestionsRequest = Message<"mirai.v1.GenerateTaskQuestionsRequest"> & {
+  /**
+   * @generated from field: string sme_id = 1;
+   */
+  smeId: string;
+
+  /**
+   * What learners of the course should achieve
+   *
+   * @generated from field: string desired_outcome = 2;
+   */
+  desiredOutcome: string;
+};
+
+/**
+ * Describes the message mirai.v1.GenerateTaskQuestionsRequest.
+ * Use `create(GenerateTaskQuestionsRequestSchema)` to create a new message.
+ */
+export const GenerateTaskQuestionsRequestSchema: GenMessage<GenerateTaskQuestionsRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 76);
+
+/**
+ * GenerateTaskQuestionsResponse contains the generated questions.
+ *
+ * @generated from message mirai.v1.GenerateTaskQuestionsResponse
+ */
+export type GenerateTaskQuestionsResponse = Message<"mirai.v1.GenerateTaskQuestionsResponse"> & {
+  /**
+   * @generated from field: repeated string questions = 1;
+   */
+  questions: string[];
+
+  /**
+   * @generated from field: int64 tokens_used = 2;
+   */
+  tokensUsed: bigint;
+};
+
+/**
+ * Describes the message mirai.v1.GenerateTaskQuestionsResponse.
+ * Use `create(GenerateTaskQuestionsResponseSchema)` to create a new message.
+ */
+export const GenerateTaskQuestionsResponseSchema: GenMessage<GenerateTaskQuestionsResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 77);
+
+/**
+ * SubmitAnswersRequest contains one answer per task question, in order.
+ *
+ * @generated from message mirai.v1.SubmitAnswersRequest
+ */
+export type SubmitAnswersRequest = Message<"mirai.v1.SubmitAnswersRequest"> & {
+  /**
+   * @generated from field: string task_id = 1;
+   */
+  taskId: string;
+
+  /**
+   * Blank answers are skipped
+   *
+   * @generated from field: repeated string answers = 2;
+   */
+  answers: string[];
+};
+
+/**
+ * Describes the message mirai.v1.SubmitAnswersRequest.
+ * Use `create(SubmitAnswersRequestSchema)` to create a new message.
+ */
+export const SubmitAnswersRequestSchema: GenMessage<SubmitAnswersRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 78);
+
+/**
+ * SubmitAnswersResponse contains the created submission.
+ *
+ * @generated from message mirai.v1.SubmitAnswersResponse
+ */
+export type SubmitAnswersResponse = Message<"mirai.v1.SubmitAnswersResponse"> & {
+  /**
+   * @generated from field: mirai.v1.SMETaskSubmission submission = 1;
+   */
+  submission?: SMETaskSubmission;
+};
+
+/**
+ * Describes the message mirai.v1.SubmitAnswersResponse.
+ * Use `create(SubmitAnswersResponseSchema)` to create a new message.
+ */
+export const SubmitAnswersResponseSchema: GenMessage<SubmitAnswersResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 79);
+
+/**
+ * GetSMEDeletionImpactRequest contains the SME ID about to be deleted.
+ *
+ * @generated from message mirai.v1.GetSMEDeletionImpactRequest
+ */
+export type GetSMEDeletionImpactRequest = Message<"mirai.v1.GetSMEDeletionImpactRequest"> & {
+  /**
+   * @generated from field: string sme_id = 1;
+   */
+  smeId: string;
+};
+
+/**
+ * Describes the message mirai.v1.GetSMEDeletionImpactRequest.
+ * Use `create(GetSMEDeletionImpactRequestSchema)` to create a new message.
+ */
+export const GetSMEDeletionImpactRequestSchema: GenMessage<GetSMEDeletionImpactRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 80);
+
+/**
+ * GetSMEDeletionImpactResponse summarizes what depends on the SME.
+ *
+ * @generated from message mirai.v1.GetSMEDeletionImpactResponse
+ */
+export type GetSMEDeletionImpactResponse = Message<"mirai.v1.GetSMEDeletionImpactResponse"> & {
+  /**
+   * @generated from field: int32 knowledge_chunks = 1;
+   */
+  knowledgeChunks: number;
+
+  /**
+   * Tasks not yet completed, failed, or cancelled
+   *
+   * @generated from field: int32 pending_tasks = 2;
+   */
+  pendingTasks: number;
+
+  /**
+   * @generated from field: int32 submissions = 3;
+   */
+  submissions: number;
+
+  /**
+   * Courses whose generation inputs reference the SME
+   *
+   * @generated from field: int32 courses = 4;
+   */
+  courses: number;
+
+  /**
+   * Most recently updated of those courses
+   *
+   * @generated from field: repeated string recent_course_titles = 5;
+   */
+  recentCourseTitles: string[];
+
+  /**
+   * Pass to DeleteSME
+   *
+   * @generated from field: string confirmation_token = 6;
+   */
+  confirmationToken: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp expires_at = 7;
    */
-  submission?: SMETaskSubmission;
+  expiresAt?: Timestamp;
 };
 
 /**
- * Describes the message mirai.v1.RequestSubmissionChangesResponse.
- * Use `create(RequestSubmissionChangesResponseSchema)` to create a new message.
+ * Describes the message mirai.v1.GetSMEDeletionImpactResponse.
+ * Use `create(GetSMEDeletionImpactResponseSchema)` to create a new message.
  */
-export const RequestSubmissionChangesResponseSchema: GenMessage<RequestSubmissionChangesResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 41);
+export const GetSMEDeletionImpactResponseSchema: GenMessage<GetSMEDeletionImpactResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 81);
 
 /**
- * EnhanceSubmissionContentRequest requests AI enhancement of content.
+ * SubmissionProgress reports a submission's ingestion progress.
  *
- * @generated from message mirai.v1.EnhanceSubmissionContentRequest
+ * @generated from message mirai.v1.SubmissionProgress
  */
-export type EnhanceSubmissionContentRequest = Message<"mirai.v1.EnhanceSubmissionContentRequest"> & {
+export type SubmissionProgress = Message<"mirai.v1.SubmissionProgress"> & {
   /**
    * @generated from field: string submission_id = 1;
    */
   submissionId: string;
 
   /**
-   * @generated from field: mirai.v1.EnhanceType enhance_type = 2;
+   * @generated from field: string task_id = 2;
    */
-  enhanceType: EnhanceType;
-};
-
-/**
- * Describes the message mirai.v1.EnhanceSubmissionContentRequest.
- * Use `create(EnhanceSubmissionContentRequestSchema)` to create a new message.
- */
-export const EnhanceSubmissionContentRequestSchema: GenMessage<EnhanceSubmissionContentRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 42);
+  taskId: string;
 
-/**
- * EnhanceSubmissionContentResponse contains enhanced content.
- *
- * @generated from message mirai.v1.EnhanceSubmissionContentResponse
- */
-export type EnhanceSubmissionContentResponse = Message<"mirai.v1.EnhanceSubmissionContentResponse"> & {
   /**
-   * @generated from field: string enhanced_content = 1;
+   * Latest ingestion job; unset if none has run
+   *
+   * @generated from field: optional string job_id = 3;
    */
-  enhancedContent: string;
+  jobId?: string;
 
   /**
-   * @generated from field: string original_content = 2;
+   * @generated from field: mirai.v1.SubmissionProcessingStatus status = 4;
    */
-  originalContent: string;
-};
-
-/**
- * Describes the message mirai.v1.EnhanceSubmissionContentResponse.
- * Use `create(EnhanceSubmissionContentResponseSchema)` to create a new message.
- */
-export const EnhanceSubmissionContentResponseSchema: GenMessage<EnhanceSubmissionContentResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 43);
+  status: SubmissionProcessingStatus;
 
-/**
- * UpdateKnowledgeChunkRequest updates a knowledge chunk.
- *
- * @generated from message mirai.v1.UpdateKnowledgeChunkRequest
- */
-export type UpdateKnowledgeChunkRequest = Message<"mirai.v1.UpdateKnowledgeChunkRequest"> & {
   /**
-   * @generated from field: string chunk_id = 1;
+   * @generated from field: int32 progress_percent = 5;
    */
-  chunkId: string;
+  progressPercent: number;
 
   /**
-   * @generated from field: string content = 2;
+   * @generated from field: string progress_message = 6;
    */
-  content: string;
+  progressMessage: string;
 
   /**
-   * @generated from field: optional string topic = 3;
+   * Set when ingestion failed
+   *
+   * @generated from field: optional string error = 7;
    */
-  topic?: string;
+  error?: string;
 
   /**
-   * @generated from field: repeated string keywords = 4;
+   * @generated from field: google.protobuf.Timestamp updated_at = 8;
    */
-  keywords: string[];
+  updatedAt?: Timestamp;
 };
 
 /**
- * Describes the message mirai.v1.UpdateKnowledgeChunkRequest.
- * Use `create(UpdateKnowledgeChunkRequestSchema)` to create a new message.
+ * Describes the message mirai.v1.SubmissionProgress.
+ * Use `create(SubmissionProgressSchema)` to create a new message.
  */
-export const UpdateKnowledgeChunkRequestSchema: GenMessage<UpdateKnowledgeChunkRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 44);
+export const SubmissionProgressSchema: GenMessage<SubmissionProgress> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 82);
 
 /**
- * UpdateKnowledgeChunkResponse contains the updated chunk.
+ * GetSubmissionStatusRequest requests a submission's ingestion progress.
  *
- * @generated from message mirai.v1.UpdateKnowledgeChunkResponse
+ * @generated from message mirai.v1.GetSubmissionStatusRequest
  */
-export type UpdateKnowledgeChunkResponse = Message<"mirai.v1.UpdateKnowledgeChunkResponse"> & {
+export type GetSubmissionStatusRequest = Message<"mirai.v1.GetSubmissionStatusRequest"> & {
   /**
-   * @generated from field: mirai.v1.SMEKnowledgeChunk chunk = 1;
+   * @generated from field: string submission_id = 1;
    */
-  chunk?: SMEKnowledgeChunk;
+  submissionId: string;
 };
 
 /**
- * Describes the message mirai.v1.UpdateKnowledgeChunkResponse.
- * Use `create(UpdateKnowledgeChunkResponseSchema)` to create a new message.
+ * Describes the message mirai.v1.GetSubmissionStatusRequest.
+ * Use `create(GetSubmissionStatusRequestSchema)` to create a new message.
  */
-export const UpdateKnowledgeChunkResponseSchema: GenMessage<UpdateKnowledgeChunkResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 45);
+export const GetSubmissionStatusRequestSchema: GenMessage<GetSubmissionStatusRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 83);
 
 /**
- * DeleteKnowledgeChunkRequest deletes a knowledge chunk.
+ * GetSubmissionStatusResponse contains the submission's ingestion progress.
  *
- * @generated from message mirai.v1.DeleteKnowledgeChunkRequest
+ * @generated from message mirai.v1.GetSubmissionStatusResponse
  */
-export type DeleteKnowledgeChunkRequest = Message<"mirai.v1.DeleteKnowledgeChunkRequest"> & {
+export type GetSubmissionStatusResponse = Message<"mirai.v1.GetSubmissionStatusResponse"> & {
   /**
-   * @generated from field: string chunk_id = 1;
+   * @generated from field: mirai.v1.SubmissionProgress progress = 1;
    */
-  chunkId: string;
+  progress?: SubmissionProgress;
 };
 
 /**
- * Describes the message mirai.v1.DeleteKnowledgeChunkRequest.
- * Use `create(DeleteKnowledgeChunkRequestSchema)` to create a new message.
+ * Describes the message mirai.v1.GetSubmissionStatusResponse.
+ * Use `create(GetSubmissionStatusResponseSchema)` to create a new message.
  */
-export const DeleteKnowledgeChunkRequestSchema: GenMessage<DeleteKnowledgeChunkRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 46);
+export const GetSubmissionStatusResponseSchema: GenMessage<GetSubmissionStatusResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 84);
 
 /**
- * DeleteKnowledgeChunkResponse confirms deletion.
+ * SuggestSMEsRequest describes the course to suggest SMEs for. At least one field is required.
  *
- * @generated from message mirai.v1.DeleteKnowledgeChunkResponse
+ * @generated from message mirai.v1.SuggestSMEsRequest
  */
-export type DeleteKnowledgeChunkResponse = Message<"mirai.v1.DeleteKnowledgeChunkResponse"> & {
+export type SuggestSMEsRequest = Message<"mirai.v1.SuggestSMEsRequest"> & {
+  /**
+   * @generated from field: string course_title = 1;
+   */
+  courseTitle: string;
+
+  /**
+   * @generated from field: string desired_outcome = 2;
+   */
+  desiredOutcome: string;
 };
 
 /**
- * Describes the message mirai.v1.DeleteKnowledgeChunkResponse.
- * Use `create(DeleteKnowledgeChunkResponseSchema)` to create a new message.
+ * Describes the message mirai.v1.SuggestSMEsRequest.
+ * Use `create(SuggestSMEsRequestSchema)` to create a new message.
  */
-export const DeleteKnowledgeChunkResponseSchema: GenMessage<DeleteKnowledgeChunkResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 47);
+export const SuggestSMEsRequestSchema: GenMessage<SuggestSMEsRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 85);
 
 /**
- * DeleteTaskRequest permanently deletes a task.
+ * SMESuggestion is an SME suggested for a course goal.
  *
- * @generated from message mirai.v1.DeleteTaskRequest
+ * @generated from message mirai.v1.SMESuggestion
  */
-export type DeleteTaskRequest = Message<"mirai.v1.DeleteTaskRequest"> & {
+export type SMESuggestion = Message<"mirai.v1.SMESuggestion"> & {
   /**
-   * @generated from field: string task_id = 1;
+   * @generated from field: mirai.v1.SubjectMatterExpert sme = 1;
    */
-  taskId: string;
+  sme?: SubjectMatterExpert;
+
+  /**
+   * Weighted share of the goal's key terms covered, 0-1
+   *
+   * @generated from field: float relevance_score = 2;
+   */
+  relevanceScore: number;
+
+  /**
+   * Key terms of the goal the SME covers, strongest first
+   *
+   * @generated from field: repeated string matched_terms = 3;
+   */
+  matchedTerms: string[];
 };
 
 /**
- * Describes the message mirai.v1.DeleteTaskRequest.
- * Use `create(DeleteTaskRequestSchema)` to create a new message.
+ * Describes the message mirai.v1.SMESuggestion.
+ * Use `create(SMESuggestionSchema)` to create a new message.
  */
-export const DeleteTaskRequestSchema: GenMessage<DeleteTaskRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 48);
+export const SMESuggestionSchema: GenMessage<SMESuggestion> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 86);
 
 /**
- * DeleteTaskResponse confirms task deletion.
+ * SuggestSMEsResponse contains the suggestions, most relevant first. Empty when no SME
+ * matches any key term.
  *
- * @generated from message mirai.v1.DeleteTaskResponse
+ * @generated from message mirai.v1.SuggestSMEsResponse
  */
-export type DeleteTaskResponse = Message<"mirai.v1.DeleteTaskResponse"> & {
+export type SuggestSMEsResponse = Message<"mirai.v1.SuggestSMEsResponse"> & {
+  /**
+   * @generated from field: repeated mirai.v1.SMESuggestion suggestions = 1;
+   */
+  suggestions: SMESuggestion[];
 };
 
 /**
- * Describes the message mirai.v1.DeleteTaskResponse.
- * Use `create(DeleteTaskResponseSchema)` to create a new message.
+ * Describes the message mirai.v1.SuggestSMEsResponse.
+ * Use `create(SuggestSMEsResponseSchema)` to create a new message.
  */
-export const DeleteTaskResponseSchema: GenMessage<DeleteTaskResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 49);
+export const SuggestSMEsResponseSchema: GenMessage<SuggestSMEsResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 87);
 
 /**
  * SMEScope defines whether an SME is global or team-scoped.
@@ -1666,6 +2799,52 @@ export enum SMETaskStatus {
 export const SMETaskStatusSchema: GenEnum<SMETaskStatus> = /*@__PURE__*/
   enumDesc(file_mirai_v1_sme, 2);
 
+/**
+ * SubmissionStatus is where a submission stands, derived from its ingestion and review fields.
+ *
+ * @generated from enum mirai.v1.SubmissionStatus
+ */
+export enum SubmissionStatus {
+  /**
+   * @generated from enum value: SUBMISSION_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Submitted, not yet ingested or approved
+   *
+   * @generated from enum value: SUBMISSION_STATUS_PENDING_REVIEW = 1;
+   */
+  PENDING_REVIEW = 1,
+
+  /**
+   * Ingested successfully, not yet approved
+   *
+   * @generated from enum value: SUBMISSION_STATUS_PROCESSED = 2;
+   */
+  PROCESSED = 2,
+
+  /**
+   * Ingestion failed
+   *
+   * @generated from enum value: SUBMISSION_STATUS_FAILED = 3;
+   */
+  FAILED = 3,
+
+  /**
+   * Approved by the reviewer
+   *
+   * @generated from enum value: SUBMISSION_STATUS_APPROVED = 4;
+   */
+  APPROVED = 4,
+}
+
+/**
+ * Describes the enum mirai.v1.SubmissionStatus.
+ */
+export const SubmissionStatusSchema: GenEnum<SubmissionStatus> = /*@__PURE__*/
+  enumDesc(file_mirai_v1_sme, 3);
+
 /**
  * EnhanceType for AI content enhancement operations.
  *
@@ -1696,7 +2875,7 @@ export enum EnhanceType {
  * Describes the enum mirai.v1.EnhanceType.
  */
 export const EnhanceTypeSchema: GenEnum<EnhanceType> = /*@__PURE__*/
-  enumDesc(file_mirai_v1_sme, 3);
+  enumDesc(file_mirai_v1_sme, 4);
 
 /**
  * ContentType for uploaded materials.
@@ -1756,7 +2935,52 @@ export enum ContentType {
  * Describes the enum mirai.v1.ContentType.
  */
 export const ContentTypeSchema: GenEnum<ContentType> = /*@__PURE__*/
-  enumDesc(file_mirai_v1_sme, 4);
+  enumDesc(file_mirai_v1_sme, 5);
+
+/**
+ * SubmissionProcessingStatus is where a submission's ingestion job stands.
+ *
+ * @generated from enum mirai.v1.SubmissionProcessingStatus
+ */
+export enum SubmissionProcessingStatus {
+  /**
+   * @generated from enum value: SUBMISSION_PROCESSING_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * No ingestion job yet, e.g. awaiting review
+   *
+   * @generated from enum value: SUBMISSION_PROCESSING_STATUS_NOT_STARTED = 1;
+   */
+  NOT_STARTED = 1,
+
+  /**
+   * @generated from enum value: SUBMISSION_PROCESSING_STATUS_QUEUED = 2;
+   */
+  QUEUED = 2,
+
+  /**
+   * @generated from enum value: SUBMISSION_PROCESSING_STATUS_PROCESSING = 3;
+   */
+  PROCESSING = 3,
+
+  /**
+   * @generated from enum value: SUBMISSION_PROCESSING_STATUS_COMPLETED = 4;
+   */
+  COMPLETED = 4,
+
+  /**
+   * @generated from enum value: SUBMISSION_PROCESSING_STATUS_FAILED = 5;
+   */
+  FAILED = 5,
+}
+
+/**
+ * Describes the enum mirai.v1.SubmissionProcessingStatus.
+ */
+export const SubmissionProcessingStatusSchema: GenEnum<SubmissionProcessingStatus> = /*@__PURE__*/
+  enumDesc(file_mirai_v1_sme, 6);
 
 /**
  * SMEService handles SME and task operations.
@@ -1805,7 +3029,8 @@ export const SMEService: GenService<{
     output: typeof UpdateSMEResponseSchema;
   },
   /**
-   * DeleteSME archives an SME entity (soft delete).
+   * DeleteSME archives an SME entity (soft delete). Requires a confirmation token
+   * from GetSMEDeletionImpact.
    *
    * @generated from rpc mirai.v1.SMEService.DeleteSME
    */
@@ -1814,6 +3039,17 @@ export const SMEService: GenService<{
     input: typeof DeleteSMERequestSchema;
     output: typeof DeleteSMEResponseSchema;
   },
+  /**
+   * GetSMEDeletionImpact counts what depends on an SME and issues a confirmation
+   * token for DeleteSME, valid for 10 minutes.
+   *
+   * @generated from rpc mirai.v1.SMEService.GetSMEDeletionImpact
+   */
+  getSMEDeletionImpact: {
+    methodKind: "unary";
+    input: typeof GetSMEDeletionImpactRequestSchema;
+    output: typeof GetSMEDeletionImpactResponseSchema;
+  },
   /**
    * RestoreSME restores an archived SME entity.
    *
@@ -1884,6 +3120,36 @@ export const SMEService: GenService<{
     input: typeof GetUploadURLRequestSchema;
     output: typeof GetUploadURLResponseSchema;
   },
+  /**
+   * StartMultipartUpload starts a resumable upload for large files.
+   *
+   * @generated from rpc mirai.v1.SMEService.StartMultipartUpload
+   */
+  startMultipartUpload: {
+    methodKind: "unary";
+    input: typeof StartMultipartUploadRequestSchema;
+    output: typeof StartMultipartUploadResponseSchema;
+  },
+  /**
+   * GetPartUploadURLs returns presigned URLs for parts of a multipart upload.
+   *
+   * @generated from rpc mirai.v1.SMEService.GetPartUploadURLs
+   */
+  getPartUploadURLs: {
+    methodKind: "unary";
+    input: typeof GetPartUploadURLsRequestSchema;
+    output: typeof GetPartUploadURLsResponseSchema;
+  },
+  /**
+   * CompleteMultipartUpload validates the uploaded parts and assembles the file.
+   *
+   * @generated from rpc mirai.v1.SMEService.CompleteMultipartUpload
+   */
+  completeMultipartUpload: {
+    methodKind: "unary";
+    input: typeof CompleteMultipartUploadRequestSchema;
+    output: typeof CompleteMultipartUploadResponseSchema;
+  },
   /**
    * SubmitContent records a content submission for a task.
    *
@@ -1895,7 +3161,29 @@ export const SMEService: GenService<{
     output: typeof SubmitContentResponseSchema;
   },
   /**
-   * ListSubmissions returns all submissions for a task.
+   * GenerateTaskQuestions writes 8-12 interview questions for an SME about a course goal.
+   * Nothing is saved; send the edited questions with CreateTask.
+   *
+   * @generated from rpc mirai.v1.SMEService.GenerateTaskQuestions
+   */
+  generateTaskQuestions: {
+    methodKind: "unary";
+    input: typeof GenerateTaskQuestionsRequestSchema;
+    output: typeof GenerateTaskQuestionsResponseSchema;
+  },
+  /**
+   * SubmitAnswers records answers to a task's interview questions as a text submission,
+   * which is reviewed and ingested like uploaded content.
+   *
+   * @generated from rpc mirai.v1.SMEService.SubmitAnswers
+   */
+  submitAnswers: {
+    methodKind: "unary";
+    input: typeof SubmitAnswersRequestSchema;
+    output: typeof SubmitAnswersResponseSchema;
+  },
+  /**
+   * ListSubmissions returns a task's submissions, newest first, with optional status filter.
    *
    * @generated from rpc mirai.v1.SMEService.ListSubmissions
    */
@@ -1905,7 +3193,7 @@ export const SMEService: GenService<{
     output: typeof ListSubmissionsResponseSchema;
   },
   /**
-   * GetKnowledge returns distilled knowledge for an SME.
+   * GetKnowledge returns distilled knowledge for an SME, optionally for one topic.
    *
    * @generated from rpc mirai.v1.SMEService.GetKnowledge
    */
@@ -1914,6 +3202,16 @@ export const SMEService: GenService<{
     input: typeof GetKnowledgeRequestSchema;
     output: typeof GetKnowledgeResponseSchema;
   },
+  /**
+   * ListKnowledgeTopics returns an SME's knowledge topics with chunk counts.
+   *
+   * @generated from rpc mirai.v1.SMEService.ListKnowledgeTopics
+   */
+  listKnowledgeTopics: {
+    methodKind: "unary";
+    input: typeof ListKnowledgeTopicsRequestSchema;
+    output: typeof ListKnowledgeTopicsResponseSchema;
+  },
   /**
    * SearchKnowledge searches across SME knowledge.
    *
@@ -1924,6 +3222,17 @@ export const SMEService: GenService<{
     input: typeof SearchKnowledgeRequestSchema;
     output: typeof SearchKnowledgeResponseSchema;
   },
+  /**
+   * SuggestSMEs suggests up to 5 active SMEs the user can access for a course goal, by
+   * how well their domain, summary and knowledge keywords match its key terms.
+   *
+   * @generated from rpc mirai.v1.SMEService.SuggestSMEs
+   */
+  suggestSMEs: {
+    methodKind: "unary";
+    input: typeof SuggestSMEsRequestSchema;
+    output: typeof SuggestSMEsResponseSchema;
+  },
   /**
    * GetSubmission returns a specific submission by ID.
    *
@@ -1964,6 +3273,27 @@ export const SMEService: GenService<{
     input: typeof EnhanceSubmissionContentRequestSchema;
     output: typeof EnhanceSubmissionContentResponseSchema;
   },
+  /**
+   * ReprocessSubmission retries ingestion of a failed submission, optionally with a replacement file.
+   *
+   * @generated from rpc mirai.v1.SMEService.ReprocessSubmission
+   */
+  reprocessSubmission: {
+    methodKind: "unary";
+    input: typeof ReprocessSubmissionRequestSchema;
+    output: typeof ReprocessSubmissionResponseSchema;
+  },
+  /**
+   * GetSubmissionStatus returns how far a submission's ingestion has got. Progress is
+   * also pushed as SUBMISSION_PROGRESS events on SubscribeNotifications.
+   *
+   * @generated from rpc mirai.v1.SMEService.GetSubmissionStatus
+   */
+  getSubmissionStatus: {
+    methodKind: "unary";
+    input: typeof GetSubmissionStatusRequestSchema;
+    output: typeof GetSubmissionStatusResponseSchema;
+  },
   /**
    * UpdateKnowledgeChunk updates a knowledge chunk's content.
    *
@@ -1984,6 +3314,17 @@ export const SMEService: GenService<{
     input: typeof DeleteKnowledgeChunkRequestSchema;
     output: typeof DeleteKnowledgeChunkResponseSchema;
   },
+  /**
+   * ReviewFlaggedKnowledgeChunk releases a chunk flagged as possible prompt injection
+   * into generation, or holds it back again. Admin only.
+   *
+   * @generated from rpc mirai.v1.SMEService.ReviewFlaggedKnowledgeChunk
+   */
+  reviewFlaggedKnowledgeChunk: {
+    methodKind: "unary";
+    input: typeof ReviewFlaggedKnowledgeChunkRequestSchema;
+    output: typeof ReviewFlaggedKnowledgeChunkResponseSchema;
+  },
   /**
    * DeleteTask permanently removes a task.
    *
@@ -1994,6 +3335,49 @@ export const SMEService: GenService<{
     input: typeof DeleteTaskRequestSchema;
     output: typeof DeleteTaskResponseSchema;
   },
+  /**
+   * GetSMEStats returns contribution and knowledge coverage stats per SME.
+   *
+   * @generated from rpc mirai.v1.SMEService.GetSMEStats
+   */
+  getSMEStats: {
+    methodKind: "unary";
+    input: typeof GetSMEStatsRequestSchema;
+    output: typeof GetSMEStatsResponseSchema;
+  },
+  /**
+   * ExportSMEKnowledge queues a job that writes the SME's profile, summary, knowledge
+   * chunks and submission provenance to a JSON Lines archive. The requester is sent a
+   * download link when it finishes.
+   *
+   * @generated from rpc mirai.v1.SMEService.ExportSMEKnowledge
+   */
+  exportSMEKnowledge: {
+    methodKind: "unary";
+    input: typeof ExportSMEKnowledgeRequestSchema;
+    output: typeof ExportSMEKnowledgeResponseSchema;
+  },
+  /**
+   * GetKnowledgeImportUploadURL returns a presigned URL for uploading an archive to import.
+   *
+   * @generated from rpc mirai.v1.SMEService.GetKnowledgeImportUploadURL
+   */
+  getKnowledgeImportUploadURL: {
+    methodKind: "unary";
+    input: typeof GetKnowledgeImportUploadURLRequestSchema;
+    output: typeof GetKnowledgeImportUploadURLResponseSchema;
+  },
+  /**
+   * ImportSMEKnowledge queues a job that imports an uploaded archive's chunks, skipping
+   * duplicates, and regenerates the SME's knowledge summary.
+   *
+   * @generated from rpc mirai.v1.SMEService.ImportSMEKnowledge
+   */
+  importSMEKnowledge: {
+    methodKind: "unary";
+    input: typeof ImportSMEKnowledgeRequestSchema;
+    output: typeof ImportSMEKnowledgeResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_mirai_v1_sme, 0);
 
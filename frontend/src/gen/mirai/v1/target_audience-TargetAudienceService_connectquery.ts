@@ -52,3 +52,11 @@ export const archiveTemplate = TargetAudienceService.method.archiveTemplate;
  * @generated from rpc mirai.v1.TargetAudienceService.RestoreTemplate
  */
 export const restoreTemplate = TargetAudienceService.method.restoreTemplate;
+
+/**
+ * GenerateTargetAudience drafts a template from a job description using the tenant's AI
+ * provider. Nothing is saved; the user edits the draft and saves it with CreateTemplate.
+ *
+ * @generated from rpc mirai.v1.TargetAudienceService.GenerateTargetAudience
+ */
+export const generateTargetAudience = TargetAudienceService.method.generateTargetAudience;
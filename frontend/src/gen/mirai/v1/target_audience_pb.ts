@@ -12,7 +12,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file mirai/v1/target_audience.proto.
  */
 export const file_mirai_v1_target_audience: GenFile = /*@__PURE__*/
-  fileDesc("Ch5taXJhaS92MS90YXJnZXRfYXVkaWVuY2UucHJvdG8SCG1pcmFpLnYxIqEEChZUYXJnZXRBdWRpZW5jZVRlbXBsYXRlEgoKAmlkGAEgASgJEhEKCXRlbmFudF9pZBgCIAEoCRISCgpjb21wYW55X2lkGAMgASgJEgwKBG5hbWUYBCABKAkSEwoLZGVzY3JpcHRpb24YBSABKAkSDAoEcm9sZRgGIAEoCRIzChBleHBlcmllbmNlX2xldmVsGAcgASgOMhkubWlyYWkudjEuRXhwZXJpZW5jZUxldmVsEhYKDmxlYXJuaW5nX2dvYWxzGAggAygJEhUKDXByZXJlcXVpc2l0ZXMYCSADKAkSEgoKY2hhbGxlbmdlcxgKIAMoCRITCgttb3RpdmF0aW9ucxgLIAMoCRIdChBpbmR1c3RyeV9jb250ZXh0GAwgASgJSACIAQESHwoSdHlwaWNhbF9iYWNrZ3JvdW5kGA0gASgJSAGIAQESGgoSY3JlYXRlZF9ieV91c2VyX2lkGA4gASgJEi4KCmNyZWF0ZWRfYXQYDyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYECABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KBnN0YXR1cxgRIAEoDjIeLm1pcmFpLnYxLlRhcmdldEF1ZGllbmNlU3RhdHVzQhMKEV9pbmR1c3RyeV9jb250ZXh0QhUKE190eXBpY2FsX2JhY2tncm91bmQiwQIKFUNyZWF0ZVRlbXBsYXRlUmVxdWVzdBIMCgRuYW1lGAEgASgJEhMKC2Rlc2NyaXB0aW9uGAIgASgJEgwKBHJvbGUYAyABKAkSMwoQZXhwZXJpZW5jZV9sZXZlbBgEIAEoDjIZLm1pcmFpLnYxLkV4cGVyaWVuY2VMZXZlbBIWCg5sZWFybmluZ19nb2FscxgFIAMoCRIVCg1wcmVyZXF1aXNpdGVzGAYgAygJEhIKCmNoYWxsZW5nZXMYByADKAkSEwoLbW90aXZhdGlvbnMYCCADKAkSHQoQaW5kdXN0cnlfY29udGV4dBgJIAEoCUgAiAEBEh8KEnR5cGljYWxfYmFja2dyb3VuZBgKIAEoCUgBiAEBQhMKEV9pbmR1c3RyeV9jb250ZXh0QhUKE190eXBpY2FsX2JhY2tncm91bmQiTAoWQ3JlYXRlVGVtcGxhdGVSZXNwb25zZRIyCgh0ZW1wbGF0ZRgBIAEoCzIgLm1pcmFpLnYxLlRhcmdldEF1ZGllbmNlVGVtcGxhdGUiKQoSR2V0VGVtcGxhdGVSZXF1ZXN0EhMKC3RlbXBsYXRlX2lkGAEgASgJIkkKE0dldFRlbXBsYXRlUmVzcG9uc2USMgoIdGVtcGxhdGUYASABKAsyIC5taXJhaS52MS5UYXJnZXRBdWRpZW5jZVRlbXBsYXRlIkoKFExpc3RUZW1wbGF0ZXNSZXF1ZXN0Eh0KEGluY2x1ZGVfYXJjaGl2ZWQYASABKAhIAIgBAUITChFfaW5jbHVkZV9hcmNoaXZlZCJMChVMaXN0VGVtcGxhdGVzUmVzcG9uc2USMwoJdGVtcGxhdGVzGAEgAygLMiAubWlyYWkudjEuVGFyZ2V0QXVkaWVuY2VUZW1wbGF0ZSKhAwoVVXBkYXRlVGVtcGxhdGVSZXF1ZXN0EhMKC3RlbXBsYXRlX2lkGAEgASgJEhEKBG5hbWUYAiABKAlIAIgBARIYCgtkZXNjcmlwdGlvbhgDIAEoCUgBiAEBEhEKBHJvbGUYBCABKAlIAogBARI4ChBleHBlcmllbmNlX2xldmVsGAUgASgOMhkubWlyYWkudjEuRXhwZXJpZW5jZUxldmVsSAOIAQESFgoObGVhcm5pbmdfZ29hbHMYBiADKAkSFQoNcHJlcmVxdWlzaXRlcxgHIAMoCRISCgpjaGFsbGVuZ2VzGAggAygJEhMKC21vdGl2YXRpb25zGAkgAygJEh0KEGluZHVzdHJ5X2NvbnRleHQYCiABKAlIBIgBARIfChJ0eXBpY2FsX2JhY2tncm91bmQYCyABKAlIBYgBAUIHCgVfbmFtZUIOCgxfZGVzY3JpcHRpb25CBwoFX3JvbGVCEwoRX2V4cGVyaWVuY2VfbGV2ZWxCEwoRX2luZHVzdHJ5X2NvbnRleHRCFQoTX3R5cGljYWxfYmFja2dyb3VuZCJMChZVcGRhdGVUZW1wbGF0ZVJlc3BvbnNlEjIKCHRlbXBsYXRlGAEgASgLMiAubWlyYWkudjEuVGFyZ2V0QXVkaWVuY2VUZW1wbGF0ZSIsChVEZWxldGVUZW1wbGF0ZVJlcXVlc3QSEwoLdGVtcGxhdGVfaWQYASABKAkiGAoWRGVsZXRlVGVtcGxhdGVSZXNwb25zZSItChZBcmNoaXZlVGVtcGxhdGVSZXF1ZXN0EhMKC3RlbXBsYXRlX2lkGAEgASgJIk0KF0FyY2hpdmVUZW1wbGF0ZVJlc3BvbnNlEjIKCHRlbXBsYXRlGAEgASgLMiAubWlyYWkudjEuVGFyZ2V0QXVkaWVuY2VUZW1wbGF0ZSItChZSZXN0b3JlVGVtcGxhdGVSZXF1ZXN0EhMKC3RlbXBsYXRlX2lkGAEgASgJIk0KF1Jlc3RvcmVUZW1wbGF0ZVJlc3BvbnNlEjIKCHRlbXBsYXRlGAEgASgLMiAubWlyYWkudjEuVGFyZ2V0QXVkaWVuY2VUZW1wbGF0ZSqxAQoPRXhwZXJpZW5jZUxldmVsEiAKHEVYUEVSSUVOQ0VfTEVWRUxfVU5TUEVDSUZJRUQQABIdChlFWFBFUklFTkNFX0xFVkVMX0JFR0lOTkVSEAESIQodRVhQRVJJRU5DRV9MRVZFTF9JTlRFUk1FRElBVEUQAhIdChlFWFBFUklFTkNFX0xFVkVMX0FEVkFOQ0VEEAMSGwoXRVhQRVJJRU5DRV9MRVZFTF9FWFBFUlQQBCqGAQoUVGFyZ2V0QXVkaWVuY2VTdGF0dXMSJgoiVEFSR0VUX0FVRElFTkNFX1NUQVRVU19VTlNQRUNJRklFRBAAEiEKHVRBUkdFVF9BVURJRU5DRV9TVEFUVVNfQUNUSVZFEAESIwofVEFSR0VUX0FVRElFTkNFX1NUQVRVU19BUkNISVZFRBACMuQEChVUYXJnZXRBdWRpZW5jZVNlcnZpY2USUwoOQ3JlYXRlVGVtcGxhdGUSHy5taXJhaS52MS5DcmVhdGVUZW1wbGF0ZVJlcXVlc3QaIC5taXJhaS52MS5DcmVhdGVUZW1wbGF0ZVJlc3BvbnNlEkoKC0dldFRlbXBsYXRlEhwubWlyYWkudjEuR2V0VGVtcGxhdGVSZXF1ZXN0Gh0ubWlyYWkudjEuR2V0VGVtcGxhdGVSZXNwb25zZRJQCg1MaXN0VGVtcGxhdGVzEh4ubWlyYWkudjEuTGlzdFRlbXBsYXRlc1JlcXVlc3QaHy5taXJhaS52MS5MaXN0VGVtcGxhdGVzUmVzcG9uc2USUwoOVXBkYXRlVGVtcGxhdGUSHy5taXJhaS52MS5VcGRhdGVUZW1wbGF0ZVJlcXVlc3QaIC5taXJhaS52MS5VcGRhdGVUZW1wbGF0ZVJlc3BvbnNlElMKDkRlbGV0ZVRlbXBsYXRlEh8ubWlyYWkudjEuRGVsZXRlVGVtcGxhdGVSZXF1ZXN0GiAubWlyYWkudjEuRGVsZXRlVGVtcGxhdGVSZXNwb25zZRJWCg9BcmNoaXZlVGVtcGxhdGUSIC5taXJhaS52MS5BcmNoaXZlVGVtcGxhdGVSZXF1ZXN0GiEubWlyYWkudjEuQXJjaGl2ZVRlbXBsYXRlUmVzcG9uc2USVgoPUmVzdG9yZVRlbXBsYXRlEiAubWlyYWkudjEuUmVzdG9yZVRlbXBsYXRlUmVxdWVzdBohLm1pcmFpLnYxLlJlc3RvcmVUZW1wbGF0ZVJlc3BvbnNlQpkBCgxjb20ubWlyYWkudjFCE1RhcmdldEF1ZGllbmNlUHJvdG9QAVozZ2l0aHViLmNvbS9zb2dvcy9taXJhaS1iYWNrZW5kL2dlbi9taXJhaS92MTttaXJhaXYxogIDTVhYqgIITWlyYWkuVjHKAghNaXJhaVxWMeICFE1pcmFpXFYxXEdQQk1ldGFkYXRh6gIJTWlyYWk6OlYxYgZwcm90bzM", [file_google_protobuf_timestamp]);
+  fileDesc("Ch5taXJhaS92MS90YXJnZXRfYXVkaWVuY2UucHJvdG8SCG1pcmFpLnYxIqEEChZUYXJnZXRBdWRpZW5jZVRlbXBsYXRlEgoKAmlkGAEgASgJEhEKCXRlbmFudF9pZBgCIAEoCRISCgpjb21wYW55X2lkGAMgASgJEgwKBG5hbWUYBCABKAkSEwoLZGVzY3JpcHRpb24YBSABKAkSDAoEcm9sZRgGIAEoCRIzChBleHBlcmllbmNlX2xldmVsGAcgASgOMhkubWlyYWkudjEuRXhwZXJpZW5jZUxldmVsEhYKDmxlYXJuaW5nX2dvYWxzGAggAygJEhUKDXByZXJlcXVpc2l0ZXMYCSADKAkSEgoKY2hhbGxlbmdlcxgKIAMoCRITCgttb3RpdmF0aW9ucxgLIAMoCRIdChBpbmR1c3RyeV9jb250ZXh0GAwgASgJSACIAQESHwoSdHlwaWNhbF9iYWNrZ3JvdW5kGA0gASgJSAGIAQESGgoSY3JlYXRlZF9ieV91c2VyX2lkGA4gASgJEi4KCmNyZWF0ZWRfYXQYDyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYECABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KBnN0YXR1cxgRIAEoDjIeLm1pcmFpLnYxLlRhcmdldEF1ZGllbmNlU3RhdHVzQhMKEV9pbmR1c3RyeV9jb250ZXh0QhUKE190eXBpY2FsX2JhY2tncm91bmQiwQIKFUNyZWF0ZVRlbXBsYXRlUmVxdWVzdBIMCgRuYW1lGAEgASgJEhMKC2Rlc2NyaXB0aW9uGAIgASgJEgwKBHJvbGUYAyABKAkSMwoQZXhwZXJpZW5jZV9sZXZlbBgEIAEoDjIZLm1pcmFpLnYxLkV4cGVyaWVuY2VMZXZlbBIWCg5sZWFybmluZ19nb2FscxgFIAMoCRIVCg1wcmVyZXF1aXNpdGVzGAYgAygJEhIKCmNoYWxsZW5nZXMYByADKAkSEwoLbW90aXZhdGlvbnMYCCADKAkSHQoQaW5kdXN0cnlfY29udGV4dBgJIAEoCUgAiAEBEh8KEnR5cGljYWxfYmFja2dyb3VuZBgKIAEoCUgBiAEBQhMKEV9pbmR1c3RyeV9jb250ZXh0QhUKE190eXBpY2FsX2JhY2tncm91bmQiTAoWQ3JlYXRlVGVtcGxhdGVSZXNwb25zZRIyCgh0ZW1wbGF0ZRgBIAEoCzIgLm1pcmFpLnYxLlRhcmdldEF1ZGllbmNlVGVtcGxhdGUiKQoSR2V0VGVtcGxhdGVSZXF1ZXN0EhMKC3RlbXBsYXRlX2lkGAEgASgJIkkKE0dldFRlbXBsYXRlUmVzcG9uc2USMgoIdGVtcGxhdGUYASABKAsyIC5taXJhaS52MS5UYXJnZXRBdWRpZW5jZVRlbXBsYXRlIkoKFExpc3RUZW1wbGF0ZXNSZXF1ZXN0Eh0KEGluY2x1ZGVfYXJjaGl2ZWQYASABKAhIAIgBAUITChFfaW5jbHVkZV9hcmNoaXZlZCJMChVMaXN0VGVtcGxhdGVzUmVzcG9uc2USMwoJdGVtcGxhdGVzGAEgAygLMiAubWlyYWkudjEuVGFyZ2V0QXVkaWVuY2VUZW1wbGF0ZSKhAwoVVXBkYXRlVGVtcGxhdGVSZXF1ZXN0EhMKC3RlbXBsYXRlX2lkGAEgASgJEhEKBG5hbWUYAiABKAlIAIgBARIYCgtkZXNjcmlwdGlvbhgDIAEoCUgBiAEBEhEKBHJvbGUYBCABKAlIAogBARI4ChBleHBlcmllbmNlX2xldmVsGAUgASgOMhkubWlyYWkudjEuRXhwZXJpZW5jZUxldmVsSAOIAQESFgoObGVhcm5pbmdfZ29hbHMYBiADKAkSFQoNcHJlcmVxdWlzaXRlcxgHIAMoCRISCgpjaGFsbGVuZ2VzGAggAygJEhMKC21vdGl2YXRpb25zGAkgAygJEh0KEGluZHVzdHJ5X2NvbnRleHQYCiABKAlIBIgBARIfChJ0eXBpY2FsX2JhY2tncm91bmQYCyABKAlIBYgBAUIHCgVfbmFtZUIOCgxfZGVzY3JpcHRpb25CBwoFX3JvbGVCEwoRX2V4cGVyaWVuY2VfbGV2ZWxCEwoRX2luZHVzdHJ5X2NvbnRleHRCFQoTX3R5cGljYWxfYmFja2dyb3VuZCJMChZVcGRhdGVUZW1wbGF0ZVJlc3BvbnNlEjIKCHRlbXBsYXRlGAEgASgLMiAubWlyYWkudjEuVGFyZ2V0QXVkaWVuY2VUZW1wbGF0ZSIsChVEZWxldGVUZW1wbGF0ZVJlcXVlc3QSEwoLdGVtcGxhdGVfaWQYASABKAkiGAoWRGVsZXRlVGVtcGxhdGVSZXNwb25zZSItChZBcmNoaXZlVGVtcGxhdGVSZXF1ZXN0EhMKC3RlbXBsYXRlX2lkGAEgASgJIk0KF0FyY2hpdmVUZW1wbGF0ZVJlc3BvbnNlEjIKCHRlbXBsYXRlGAEgASgLMiAubWlyYWkudjEuVGFyZ2V0QXVkaWVuY2VUZW1wbGF0ZSItChZSZXN0b3JlVGVtcGxhdGVSZXF1ZXN0EhMKC3RlbXBsYXRlX2lkGAEgASgJIk0KF1Jlc3RvcmVUZW1wbGF0ZVJlc3BvbnNlEjIKCHRlbXBsYXRlGAEgASgLMiAubWlyYWkudjEuVGFyZ2V0QXVkaWVuY2VUZW1wbGF0ZSI4Ch1HZW5lcmF0ZVRhcmdldEF1ZGllbmNlUmVxdWVzdBIXCg9qb2JfZGVzY3JpcHRpb24YASABKAkiZgoeR2VuZXJhdGVUYXJnZXRBdWRpZW5jZVJlc3BvbnNlEi8KBWRyYWZ0GAEgASgLMiAubWlyYWkudjEuVGFyZ2V0QXVkaWVuY2VUZW1wbGF0ZRITCgt0b2tlbnNfdXNlZBgCIAEoAyqxAQoPRXhwZXJpZW5jZUxldmVsEiAKHEVYUEVSSUVOQ0VfTEVWRUxfVU5TUEVDSUZJRUQQABIdChlFWFBFUklFTkNFX0xFVkVMX0JFR0lOTkVSEAESIQodRVhQRVJJRU5DRV9MRVZFTF9JTlRFUk1FRElBVEUQAhIdChlFWFBFUklFTkNFX0xFVkVMX0FEVkFOQ0VEEAMSGwoXRVhQRVJJRU5DRV9MRVZFTF9FWFBFUlQQBCqGAQoUVGFyZ2V0QXVkaWVuY2VTdGF0dXMSJgoiVEFSR0VUX0FVRElFTkNFX1NUQVRVU19VTlNQRUNJRklFRBAAEiEKHVRBUkdFVF9BVURJRU5DRV9TVEFUVVNfQUNUSVZFEAESIwofVEFSR0VUX0FVRElFTkNFX1NUQVRVU19BUkNISVZFRBACMtEFChVUYXJnZXRBdWRpZW5jZVNlcnZpY2USUwoOQ3JlYXRlVGVtcGxhdGUSHy5taXJhaS52MS5DcmVhdGVUZW1wbGF0ZVJlcXVlc3QaIC5taXJhaS52MS5DcmVhdGVUZW1wbGF0ZVJlc3BvbnNlEkoKC0dldFRlbXBsYXRlEhwubWlyYWkudjEuR2V0VGVtcGxhdGVSZXF1ZXN0Gh0ubWlyYWkudjEuR2V0VGVtcGxhdGVSZXNwb25zZRJQCg1MaXN0VGVtcGxhdGVzEh4ubWlyYWkudjEuTGlzdFRlbXBsYXRlc1JlcXVlc3QaHy5taXJhaS52MS5MaXN0VGVtcGxhdGVzUmVzcG9uc2USUwoOVXBkYXRlVGVtcGxhdGUSHy5taXJhaS52MS5VcGRhdGVUZW1wbGF0ZVJlcXVlc3QaIC5taXJhaS52MS5VcGRhdGVUZW1wbGF0ZVJlc3BvbnNlElMKDkRlbGV0ZVRlbXBsYXRlEh8ubWlyYWkudjEuRGVsZXRlVGVtcGxhdGVSZXF1ZXN0GiAubWlyYWkudjEuRGVsZXRlVGVtcGxhdGVSZXNwb25zZRJWCg9BcmNoaXZlVGVtcGxhdGUSIC5taXJhaS52MS5BcmNoaXZlVGVtcGxhdGVSZXF1ZXN0GiEubWlyYWkudjEuQXJjaGl2ZVRlbXBsYXRlUmVzcG9uc2USVgoPUmVzdG9yZVRlbXBsYXRlEiAubWlyYWkudjEuUmVzdG9yZVRlbXBsYXRlUmVxdWVzdBohLm1pcmFpLnYxLlJlc3RvcmVUZW1wbGF0ZVJlc3BvbnNlEmsKFkdlbmVyYXRlVGFyZ2V0QXVkaWVuY2USJy5taXJhaS52MS5HZW5lcmF0ZVRhcmdldEF1ZGllbmNlUmVxdWVzdBooLm1pcmFpLnYxLkdlbmVyYXRlVGFyZ2V0QXVkaWVuY2VSZXNwb25zZUKZAQoMY29tLm1pcmFpLnYxQhNUYXJnZXRBdWRpZW5jZVByb3RvUAFaM2dpdGh1Yi5jb20vc29nb3MvbWlyYWktYmFja2VuZC9nZW4vbWlyYWkvdjE7bWlyYWl2MaICA01YWKoCCE1pcmFpLlYxygIITWlyYWlcVjHiAhRNaXJhaVxWMVxHUEJNZXRhZGF0YeoCCU1pcmFpOjpWMWIGcHJvdG8z", [file_google_protobuf_timestamp]);
 
 /**
  * TargetAudienceTemplate represents a reusable learner profile template.
@@ -486,6 +486,53 @@ export type RestoreTemplateResponse = Message<"mirai.v1.RestoreTemplateResponse"
 export const RestoreTemplateResponseSchema: GenMessage<RestoreTemplateResponse> = /*@__PURE__*/
   messageDesc(file_mirai_v1_target_audience, 14);
 
+/**
+ * GenerateTargetAudienceRequest contains the job to draft an audience for.
+ *
+ * @generated from message mirai.v1.GenerateTargetAudienceRequest
+ */
+export type GenerateTargetAudienceRequest = Message<"mirai.v1.GenerateTargetAudienceRequest"> & {
+  /**
+   * Full job description or just a role title
+   *
+   * @generated from field: string job_description = 1;
+   */
+  jobDescription: string;
+};
+
+/**
+ * Describes the message mirai.v1.GenerateTargetAudienceRequest.
+ * Use `create(GenerateTargetAudienceRequestSchema)` to create a new message.
+ */
+export const GenerateTargetAudienceRequestSchema: GenMessage<GenerateTargetAudienceRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_target_audience, 15);
+
+/**
+ * GenerateTargetAudienceResponse contains the unsaved draft.
+ *
+ * @generated from message mirai.v1.GenerateTargetAudienceResponse
+ */
+export type GenerateTargetAudienceResponse = Message<"mirai.v1.GenerateTargetAudienceResponse"> & {
+  /**
+   * id, timestamps and created_by_user_id are empty
+   *
+   * @generated from field: mirai.v1.TargetAudienceTemplate draft = 1;
+   */
+  draft?: TargetAudienceTemplate;
+
+  /**
+   * @generated from field: int64 tokens_used = 2;
+   */
+  tokensUsed: bigint;
+};
+
+/**
+ * Describes the message mirai.v1.GenerateTargetAudienceResponse.
+ * Use `create(GenerateTargetAudienceResponseSchema)` to create a new message.
+ */
+export const GenerateTargetAudienceResponseSchema: GenMessage<GenerateTargetAudienceResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_target_audience, 16);
+
 /**
  * ExperienceLevel represents the learner's experience level.
  *
@@ -628,6 +675,17 @@ export const TargetAudienceService: GenService<{
     input: typeof RestoreTemplateRequestSchema;
     output: typeof RestoreTemplateResponseSchema;
   },
+  /**
+   * GenerateTargetAudience drafts a template from a job description using the tenant's AI
+   * provider. Nothing is saved; the user edits the draft and saves it with CreateTemplate.
+   *
+   * @generated from rpc mirai.v1.TargetAudienceService.GenerateTargetAudience
+   */
+  generateTargetAudience: {
+    methodKind: "unary";
+    input: typeof GenerateTargetAudienceRequestSchema;
+    output: typeof GenerateTargetAudienceResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_mirai_v1_target_audience, 0);
 
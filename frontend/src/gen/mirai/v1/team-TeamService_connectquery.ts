@@ -33,7 +33,7 @@ export const createTeam = TeamService.method.createTeam;
 export const updateTeam = TeamService.method.updateTeam;
 
 /**
- * DeleteTeam deletes a team.
+ * DeleteTeam deletes a team, resolving team-scoped SMEs and open tasks that reference it.
  *
  * @generated from rpc mirai.v1.TeamService.DeleteTeam
  */
@@ -59,3 +59,11 @@ export const addTeamMember = TeamService.method.addTeamMember;
  * @generated from rpc mirai.v1.TeamService.RemoveTeamMember
  */
 export const removeTeamMember = TeamService.method.removeTeamMember;
+
+/**
+ * GetTeamDashboard summarizes a team's workload: open tasks, active jobs, recent courses
+ * and pending invitations. Team members and admins only.
+ *
+ * @generated from rpc mirai.v1.TeamService.GetTeamDashboard
+ */
+export const getTeamDashboard = TeamService.method.getTeamDashboard;
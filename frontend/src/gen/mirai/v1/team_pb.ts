@@ -2,17 +2,27 @@
 // @generated from file mirai/v1/team.proto (package mirai.v1, syntax proto3)
 /* eslint-disable */
 
-import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
-import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { GenerationJob } from "./ai_generation_pb";
+import { file_mirai_v1_ai_generation } from "./ai_generation_pb";
 import type { Team, TeamMember, TeamRole } from "./common_pb";
 import { file_mirai_v1_common } from "./common_pb";
+import type { LibraryEntry } from "./course_pb";
+import { file_mirai_v1_course } from "./course_pb";
+import type { Invitation } from "./invitation_pb";
+import { file_mirai_v1_invitation } from "./invitation_pb";
+import type { SMETaskStatus } from "./sme_pb";
+import { file_mirai_v1_sme } from "./sme_pb";
 import type { Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file mirai/v1/team.proto.
  */
 export const file_mirai_v1_team: GenFile = /*@__PURE__*/
-  fileDesc("ChNtaXJhaS92MS90ZWFtLnByb3RvEghtaXJhaS52MSISChBMaXN0VGVhbXNSZXF1ZXN0IjIKEUxpc3RUZWFtc1Jlc3BvbnNlEh0KBXRlYW1zGAEgAygLMg4ubWlyYWkudjEuVGVhbSIhCg5HZXRUZWFtUmVxdWVzdBIPCgd0ZWFtX2lkGAEgASgJIi8KD0dldFRlYW1SZXNwb25zZRIcCgR0ZWFtGAEgASgLMg4ubWlyYWkudjEuVGVhbSJLChFDcmVhdGVUZWFtUmVxdWVzdBIMCgRuYW1lGAEgASgJEhgKC2Rlc2NyaXB0aW9uGAIgASgJSACIAQFCDgoMX2Rlc2NyaXB0aW9uIjIKEkNyZWF0ZVRlYW1SZXNwb25zZRIcCgR0ZWFtGAEgASgLMg4ubWlyYWkudjEuVGVhbSJqChFVcGRhdGVUZWFtUmVxdWVzdBIPCgd0ZWFtX2lkGAEgASgJEhEKBG5hbWUYAiABKAlIAIgBARIYCgtkZXNjcmlwdGlvbhgDIAEoCUgBiAEBQgcKBV9uYW1lQg4KDF9kZXNjcmlwdGlvbiIyChJVcGRhdGVUZWFtUmVzcG9uc2USHAoEdGVhbRgBIAEoCzIOLm1pcmFpLnYxLlRlYW0iJAoRRGVsZXRlVGVhbVJlcXVlc3QSDwoHdGVhbV9pZBgBIAEoCSIUChJEZWxldGVUZWFtUmVzcG9uc2UiKQoWTGlzdFRlYW1NZW1iZXJzUmVxdWVzdBIPCgd0ZWFtX2lkGAEgASgJIkAKF0xpc3RUZWFtTWVtYmVyc1Jlc3BvbnNlEiUKB21lbWJlcnMYASADKAsyFC5taXJhaS52MS5UZWFtTWVtYmVyIloKFEFkZFRlYW1NZW1iZXJSZXF1ZXN0Eg8KB3RlYW1faWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCRIgCgRyb2xlGAMgASgOMhIubWlyYWkudjEuVGVhbVJvbGUiPQoVQWRkVGVhbU1lbWJlclJlc3BvbnNlEiQKBm1lbWJlchgBIAEoCzIULm1pcmFpLnYxLlRlYW1NZW1iZXIiOwoXUmVtb3ZlVGVhbU1lbWJlclJlcXVlc3QSDwoHdGVhbV9pZBgBIAEoCRIPCgd1c2VyX2lkGAIgASgJIhoKGFJlbW92ZVRlYW1NZW1iZXJSZXNwb25zZTLzBAoLVGVhbVNlcnZpY2USRAoJTGlzdFRlYW1zEhoubWlyYWkudjEuTGlzdFRlYW1zUmVxdWVzdBobLm1pcmFpLnYxLkxpc3RUZWFtc1Jlc3BvbnNlEj4KB0dldFRlYW0SGC5taXJhaS52MS5HZXRUZWFtUmVxdWVzdBoZLm1pcmFpLnYxLkdldFRlYW1SZXNwb25zZRJHCgpDcmVhdGVUZWFtEhsubWlyYWkudjEuQ3JlYXRlVGVhbVJlcXVlc3QaHC5taXJhaS52MS5DcmVhdGVUZWFtUmVzcG9uc2USRwoKVXBkYXRlVGVhbRIbLm1pcmFpLnYxLlVwZGF0ZVRlYW1SZXF1ZXN0GhwubWlyYWkudjEuVXBkYXRlVGVhbVJlc3BvbnNlEkcKCkRlbGV0ZVRlYW0SGy5taXJhaS52MS5EZWxldGVUZWFtUmVxdWVzdBocLm1pcmFpLnYxLkRlbGV0ZVRlYW1SZXNwb25zZRJWCg9MaXN0VGVhbU1lbWJlcnMSIC5taXJhaS52MS5MaXN0VGVhbU1lbWJlcnNSZXF1ZXN0GiEubWlyYWkudjEuTGlzdFRlYW1NZW1iZXJzUmVzcG9uc2USUAoNQWRkVGVhbU1lbWJlchIeLm1pcmFpLnYxLkFkZFRlYW1NZW1iZXJSZXF1ZXN0Gh8ubWlyYWkudjEuQWRkVGVhbU1lbWJlclJlc3BvbnNlElkKEFJlbW92ZVRlYW1NZW1iZXISIS5taXJhaS52MS5SZW1vdmVUZWFtTWVtYmVyUmVxdWVzdBoiLm1pcmFpLnYxLlJlbW92ZVRlYW1NZW1iZXJSZXNwb25zZUKPAQoMY29tLm1pcmFpLnYxQglUZWFtUHJvdG9QAVozZ2l0aHViLmNvbS9zb2dvcy9taXJhaS1iYWNrZW5kL2dlbi9taXJhaS92MTttaXJhaXYxogIDTVhYqgIITWlyYWkuVjHKAghNaXJhaVxWMeICFE1pcmFpXFYxXEdQQk1ldGFkYXRh6gIJTWlyYWk6OlYxYgZwcm90bzM", [file_mirai_v1_common]);
+  fileDesc("ChNtaXJhaS92MS90ZWFtLnByb3RvEghtaXJhaS52MSISChBMaXN0VGVhbXNSZXF1ZXN0IjIKEUxpc3RUZWFtc1Jlc3BvbnNlEh0KBXRlYW1zGAEgAygLMg4ubWlyYWkudjEuVGVhbSIhCg5HZXRUZWFtUmVxdWVzdBIPCgd0ZWFtX2lkGAEgASgJIi8KD0dldFRlYW1SZXNwb25zZRIcCgR0ZWFtGAEgASgLMg4ubWlyYWkudjEuVGVhbSJLChFDcmVhdGVUZWFtUmVxdWVzdBIMCgRuYW1lGAEgASgJEhgKC2Rlc2NyaXB0aW9uGAIgASgJSACIAQFCDgoMX2Rlc2NyaXB0aW9uIjIKEkNyZWF0ZVRlYW1SZXNwb25zZRIcCgR0ZWFtGAEgASgLMg4ubWlyYWkudjEuVGVhbSJqChFVcGRhdGVUZWFtUmVxdWVzdBIPCgd0ZWFtX2lkGAEgASgJEhEKBG5hbWUYAiABKAlIAIgBARIYCgtkZXNjcmlwdGlvbhgDIAEoCUgBiAEBQgcKBV9uYW1lQg4KDF9kZXNjcmlwdGlvbiIyChJVcGRhdGVUZWFtUmVzcG9uc2USHAoEdGVhbRgBIAEoCzIOLm1pcmFpLnYxLlRlYW0ifwoRRGVsZXRlVGVhbVJlcXVlc3QSDwoHdGVhbV9pZBgBIAEoCRIgChNyZWFzc2lnbl90b190ZWFtX2lkGAIgASgJSACIAQESDgoGZGV0YWNoGAMgASgIEg8KB2RyeV9ydW4YBCABKAhCFgoUX3JlYXNzaWduX3RvX3RlYW1faWQiXwoPVGVhbURlbGV0aW9uU01FEg4KBnNtZV9pZBgBIAEoCRIMCgRuYW1lGAIgASgJEi4KB291dGNvbWUYAyABKA4yHS5taXJhaS52MS5UZWFtRGVsZXRpb25PdXRjb21lIn8KEFRlYW1EZWxldGlvblRhc2sSDwoHdGFza19pZBgBIAEoCRINCgV0aXRsZRgCIAEoCRIbChNhc3NpZ25lZF90b191c2VyX2lkGAMgASgJEi4KB291dGNvbWUYBCABKA4yHS5taXJhaS52MS5UZWFtRGVsZXRpb25PdXRjb21lInkKEkRlbGV0ZVRlYW1SZXNwb25zZRIPCgdkZWxldGVkGAEgASgIEicKBHNtZXMYAiADKAsyGS5taXJhaS52MS5UZWFtRGVsZXRpb25TTUUSKQoFdGFza3MYAyADKAsyGi5taXJhaS52MS5UZWFtRGVsZXRpb25UYXNrIikKFkxpc3RUZWFtTWVtYmVyc1JlcXVlc3QSDwoHdGVhbV9pZBgBIAEoCSJAChdMaXN0VGVhbU1lbWJlcnNSZXNwb25zZRIlCgdtZW1iZXJzGAEgAygLMhQubWlyYWkudjEuVGVhbU1lbWJlciJaChRBZGRUZWFtTWVtYmVyUmVxdWVzdBIPCgd0ZWFtX2lkGAEgASgJEg8KB3VzZXJfaWQYAiABKAkSIAoEcm9sZRgDIAEoDjISLm1pcmFpLnYxLlRlYW1Sb2xlIj0KFUFkZFRlYW1NZW1iZXJSZXNwb25zZRIkCgZtZW1iZXIYASABKAsyFC5taXJhaS52MS5UZWFtTWVtYmVyIjsKF1JlbW92ZVRlYW1NZW1iZXJSZXF1ZXN0Eg8KB3RlYW1faWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCSIaChhSZW1vdmVUZWFtTWVtYmVyUmVzcG9uc2UiKgoXR2V0VGVhbURhc2hib2FyZFJlcXVlc3QSDwoHdGVhbV9pZBgBIAEoCSJeChNUZWFtVGFza1N0YXR1c0NvdW50EicKBnN0YXR1cxgBIAEoDjIXLm1pcmFpLnYxLlNNRVRhc2tTdGF0dXMSDQoFY291bnQYAiABKAUSDwoHb3ZlcmR1ZRgDIAEoBSK8AgoYR2V0VGVhbURhc2hib2FyZFJlc3BvbnNlEjIKC3Rhc2tfY291bnRzGAEgAygLMh0ubWlyYWkudjEuVGVhbVRhc2tTdGF0dXNDb3VudBISCgpvcGVuX3Rhc2tzGAIgASgFEhUKDW92ZXJkdWVfdGFza3MYAyABKAUSLAoLYWN0aXZlX2pvYnMYBCADKAsyFy5taXJhaS52MS5HZW5lcmF0aW9uSm9iEi4KDnJlY2VudF9jb3Vyc2VzGAUgAygLMhYubWlyYWkudjEuTGlicmFyeUVudHJ5EjEKE3BlbmRpbmdfaW52aXRhdGlvbnMYBiADKAsyFC5taXJhaS52MS5JbnZpdGF0aW9uEjAKDGdlbmVyYXRlZF9hdBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAq+AEKE1RlYW1EZWxldGlvbk91dGNvbWUSJQohVEVBTV9ERUxFVElPTl9PVVRDT01FX1VOU1BFQ0lGSUVEEAASJAogVEVBTV9ERUxFVElPTl9PVVRDT01FX1VOUkVTT0xWRUQQARIkCiBURUFNX0RFTEVUSU9OX09VVENPTUVfUkVBU1NJR05FRBACEiIKHlRFQU1fREVMRVRJT05fT1VUQ09NRV9ERVRBQ0hFRBADEiUKIVRFQU1fREVMRVRJT05fT1VUQ09NRV9NQURFX0dMT0JBTBAEEiMKH1RFQU1fREVMRVRJT05fT1VUQ09NRV9DQU5DRUxMRUQQBTLOBQoLVGVhbVNlcnZpY2USRAoJTGlzdFRlYW1zEhoubWlyYWkudjEuTGlzdFRlYW1zUmVxdWVzdBobLm1pcmFpLnYxLkxpc3RUZWFtc1Jlc3BvbnNlEj4KB0dldFRlYW0SGC5taXJhaS52MS5HZXRUZWFtUmVxdWVzdBoZLm1pcmFpLnYxLkdldFRlYW1SZXNwb25zZRJHCgpDcmVhdGVUZWFtEhsubWlyYWkudjEuQ3JlYXRlVGVhbVJlcXVlc3QaHC5taXJhaS52MS5DcmVhdGVUZWFtUmVzcG9uc2USRwoKVXBkYXRlVGVhbRIbLm1pcmFpLnYxLlVwZGF0ZVRlYW1SZXF1ZXN0GhwubWlyYWkudjEuVXBkYXRlVGVhbVJlc3BvbnNlEkcKCkRlbGV0ZVRlYW0SGy5taXJhaS52MS5EZWxldGVUZWFtUmVxdWVzdBocLm1pcmFpLnYxLkRlbGV0ZVRlYW1SZXNwb25zZRJWCg9MaXN0VGVhbU1lbWJlcnMSIC5taXJhaS52MS5MaXN0VGVhbU1lbWJlcnNSZXF1ZXN0GiEubWlyYWkudjEuTGlzdFRlYW1NZW1iZXJzUmVzcG9uc2USUAoNQWRkVGVhbU1lbWJlchIeLm1pcmFpLnYxLkFkZFRlYW1NZW1iZXJSZXF1ZXN0Gh8ubWlyYWkudjEuQWRkVGVhbU1lbWJlclJlc3BvbnNlElkKEFJlbW92ZVRlYW1NZW1iZXISIS5taXJhaS52MS5SZW1vdmVUZWFtTWVtYmVyUmVxdWVzdBoiLm1pcmFpLnYxLlJlbW92ZVRlYW1NZW1iZXJSZXNwb25zZRJZChBHZXRUZWFtRGFzaGJvYXJkEiEubWlyYWkudjEuR2V0VGVhbURhc2hib2FyZFJlcXVlc3QaIi5taXJhaS52MS5HZXRUZWFtRGFzaGJvYXJkUmVzcG9uc2VCjwEKDGNvbS5taXJhaS52MUIJVGVhbVByb3RvUAFaM2dpdGh1Yi5jb20vc29nb3MvbWlyYWktYmFja2VuZC9nZW4vbWlyYWkvdjE7bWlyYWl2MaICA01YWKoCCE1pcmFpLlYxygIITWlyYWlcVjHiAhRNaXJhaVxWMVxHUEJNZXRhZGF0YeoCCU1pcmFpOjpWMWIGcHJvdG8z", [file_google_protobuf_timestamp, file_mirai_v1_ai_generation, file_mirai_v1_common, file_mirai_v1_course, file_mirai_v1_invitation, file_mirai_v1_sme]);
 
 /**
  * ListTeamsRequest is empty as company is identified by auth context.
@@ -179,6 +189,8 @@ export const UpdateTeamResponseSchema: GenMessage<UpdateTeamResponse> = /*@__PUR
 
 /**
  * DeleteTeamRequest contains the team ID to delete.
+ * When team-scoped SMEs or open tasks reference the team, exactly one of
+ * reassign_to_team_id or detach is required.
  *
  * @generated from message mirai.v1.DeleteTeamRequest
  */
@@ -187,6 +199,27 @@ export type DeleteTeamRequest = Message<"mirai.v1.DeleteTeamRequest"> & {
    * @generated from field: string team_id = 1;
    */
   teamId: string;
+
+  /**
+   * Move SME access and open tasks here
+   *
+   * @generated from field: optional string reassign_to_team_id = 2;
+   */
+  reassignToTeamId?: string;
+
+  /**
+   * Drop team access (SMEs left without a team become global) and cancel open tasks
+   *
+   * @generated from field: bool detach = 3;
+   */
+  detach: boolean;
+
+  /**
+   * Return the impact without deleting
+   *
+   * @generated from field: bool dry_run = 4;
+   */
+  dryRun: boolean;
 };
 
 /**
@@ -197,11 +230,90 @@ export const DeleteTeamRequestSchema: GenMessage<DeleteTeamRequest> = /*@__PURE_
   messageDesc(file_mirai_v1_team, 8);
 
 /**
- * DeleteTeamResponse confirms deletion.
+ * TeamDeletionSME is a team-scoped SME affected by the deletion.
+ *
+ * @generated from message mirai.v1.TeamDeletionSME
+ */
+export type TeamDeletionSME = Message<"mirai.v1.TeamDeletionSME"> & {
+  /**
+   * @generated from field: string sme_id = 1;
+   */
+  smeId: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: mirai.v1.TeamDeletionOutcome outcome = 3;
+   */
+  outcome: TeamDeletionOutcome;
+};
+
+/**
+ * Describes the message mirai.v1.TeamDeletionSME.
+ * Use `create(TeamDeletionSMESchema)` to create a new message.
+ */
+export const TeamDeletionSMESchema: GenMessage<TeamDeletionSME> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_team, 9);
+
+/**
+ * TeamDeletionTask is an open task affected by the deletion.
+ *
+ * @generated from message mirai.v1.TeamDeletionTask
+ */
+export type TeamDeletionTask = Message<"mirai.v1.TeamDeletionTask"> & {
+  /**
+   * @generated from field: string task_id = 1;
+   */
+  taskId: string;
+
+  /**
+   * @generated from field: string title = 2;
+   */
+  title: string;
+
+  /**
+   * @generated from field: string assigned_to_user_id = 3;
+   */
+  assignedToUserId: string;
+
+  /**
+   * @generated from field: mirai.v1.TeamDeletionOutcome outcome = 4;
+   */
+  outcome: TeamDeletionOutcome;
+};
+
+/**
+ * Describes the message mirai.v1.TeamDeletionTask.
+ * Use `create(TeamDeletionTaskSchema)` to create a new message.
+ */
+export const TeamDeletionTaskSchema: GenMessage<TeamDeletionTask> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_team, 10);
+
+/**
+ * DeleteTeamResponse summarizes the impact of the deletion.
  *
  * @generated from message mirai.v1.DeleteTeamResponse
  */
 export type DeleteTeamResponse = Message<"mirai.v1.DeleteTeamResponse"> & {
+  /**
+   * False for dry runs
+   *
+   * @generated from field: bool deleted = 1;
+   */
+  deleted: boolean;
+
+  /**
+   * @generated from field: repeated mirai.v1.TeamDeletionSME smes = 2;
+   */
+  smes: TeamDeletionSME[];
+
+  /**
+   * @generated from field: repeated mirai.v1.TeamDeletionTask tasks = 3;
+   */
+  tasks: TeamDeletionTask[];
 };
 
 /**
@@ -209,7 +321,7 @@ export type DeleteTeamResponse = Message<"mirai.v1.DeleteTeamResponse"> & {
  * Use `create(DeleteTeamResponseSchema)` to create a new message.
  */
 export const DeleteTeamResponseSchema: GenMessage<DeleteTeamResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_team, 9);
+  messageDesc(file_mirai_v1_team, 11);
 
 /**
  * ListTeamMembersRequest contains the team ID.
@@ -228,7 +340,7 @@ export type ListTeamMembersRequest = Message<"mirai.v1.ListTeamMembersRequest">
  * Use `create(ListTeamMembersRequestSchema)` to create a new message.
  */
 export const ListTeamMembersRequestSchema: GenMessage<ListTeamMembersRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_team, 10);
+  messageDesc(file_mirai_v1_team, 12);
 
 /**
  * ListTeamMembersResponse contains all members of the team.
@@ -247,7 +359,7 @@ export type ListTeamMembersResponse = Message<"mirai.v1.ListTeamMembersResponse"
  * Use `create(ListTeamMembersResponseSchema)` to create a new message.
  */
 export const ListTeamMembersResponseSchema: GenMessage<ListTeamMembersResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_team, 11);
+  messageDesc(file_mirai_v1_team, 13);
 
 /**
  * AddTeamMemberRequest contains the member to add.
@@ -276,7 +388,7 @@ export type AddTeamMemberRequest = Message<"mirai.v1.AddTeamMemberRequest"> & {
  * Use `create(AddTeamMemberRequestSchema)` to create a new message.
  */
 export const AddTeamMemberRequestSchema: GenMessage<AddTeamMemberRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_team, 12);
+  messageDesc(file_mirai_v1_team, 14);
 
 /**
  * AddTeamMemberResponse contains the created team member.
@@ -295,7 +407,7 @@ export type AddTeamMemberResponse = Message<"mirai.v1.AddTeamMemberResponse"> &
  * Use `create(AddTeamMemberResponseSchema)` to create a new message.
  */
 export const AddTeamMemberResponseSchema: GenMessage<AddTeamMemberResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_team, 13);
+  messageDesc(file_mirai_v1_team, 15);
 
 /**
  * RemoveTeamMemberRequest contains the member to remove.
@@ -319,7 +431,7 @@ export type RemoveTeamMemberRequest = Message<"mirai.v1.RemoveTeamMemberRequest"
  * Use `create(RemoveTeamMemberRequestSchema)` to create a new message.
  */
 export const RemoveTeamMemberRequestSchema: GenMessage<RemoveTeamMemberRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_team, 14);
+  messageDesc(file_mirai_v1_team, 16);
 
 /**
  * RemoveTeamMemberResponse confirms removal.
@@ -334,7 +446,162 @@ export type RemoveTeamMemberResponse = Message<"mirai.v1.RemoveTeamMemberRespons
  * Use `create(RemoveTeamMemberResponseSchema)` to create a new message.
  */
 export const RemoveTeamMemberResponseSchema: GenMessage<RemoveTeamMemberResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_team, 15);
+  messageDesc(file_mirai_v1_team, 17);
+
+/**
+ * GetTeamDashboardRequest contains the team ID.
+ *
+ * @generated from message mirai.v1.GetTeamDashboardRequest
+ */
+export type GetTeamDashboardRequest = Message<"mirai.v1.GetTeamDashboardRequest"> & {
+  /**
+   * @generated from field: string team_id = 1;
+   */
+  teamId: string;
+};
+
+/**
+ * Describes the message mirai.v1.GetTeamDashboardRequest.
+ * Use `create(GetTeamDashboardRequestSchema)` to create a new message.
+ */
+export const GetTeamDashboardRequestSchema: GenMessage<GetTeamDashboardRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_team, 18);
+
+/**
+ * TeamTaskStatusCount counts the open SME tasks assigned to team members in one status.
+ *
+ * @generated from message mirai.v1.TeamTaskStatusCount
+ */
+export type TeamTaskStatusCount = Message<"mirai.v1.TeamTaskStatusCount"> & {
+  /**
+   * @generated from field: mirai.v1.SMETaskStatus status = 1;
+   */
+  status: SMETaskStatus;
+
+  /**
+   * @generated from field: int32 count = 2;
+   */
+  count: number;
+
+  /**
+   * Past their due date
+   *
+   * @generated from field: int32 overdue = 3;
+   */
+  overdue: number;
+};
+
+/**
+ * Describes the message mirai.v1.TeamTaskStatusCount.
+ * Use `create(TeamTaskStatusCountSchema)` to create a new message.
+ */
+export const TeamTaskStatusCountSchema: GenMessage<TeamTaskStatusCount> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_team, 19);
+
+/**
+ * GetTeamDashboardResponse summarizes the team's workload. Each list is capped on its own.
+ * The figures are cached briefly, so they can lag changes by up to a minute.
+ *
+ * @generated from message mirai.v1.GetTeamDashboardResponse
+ */
+export type GetTeamDashboardResponse = Message<"mirai.v1.GetTeamDashboardResponse"> & {
+  /**
+   * Open tasks assigned to members, by status
+   *
+   * @generated from field: repeated mirai.v1.TeamTaskStatusCount task_counts = 1;
+   */
+  taskCounts: TeamTaskStatusCount[];
+
+  /**
+   * @generated from field: int32 open_tasks = 2;
+   */
+  openTasks: number;
+
+  /**
+   * @generated from field: int32 overdue_tasks = 3;
+   */
+  overdueTasks: number;
+
+  /**
+   * Queued and processing jobs started by members, newest first
+   *
+   * @generated from field: repeated mirai.v1.GenerationJob active_jobs = 4;
+   */
+  activeJobs: GenerationJob[];
+
+  /**
+   * Most recently modified courses in the team's folders
+   *
+   * @generated from field: repeated mirai.v1.LibraryEntry recent_courses = 5;
+   */
+  recentCourses: LibraryEntry[];
+
+  /**
+   * Pending invitations to join the team, newest first
+   *
+   * @generated from field: repeated mirai.v1.Invitation pending_invitations = 6;
+   */
+  pendingInvitations: Invitation[];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp generated_at = 7;
+   */
+  generatedAt?: Timestamp;
+};
+
+/**
+ * Describes the message mirai.v1.GetTeamDashboardResponse.
+ * Use `create(GetTeamDashboardResponseSchema)` to create a new message.
+ */
+export const GetTeamDashboardResponseSchema: GenMessage<GetTeamDashboardResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_team, 20);
+
+/**
+ * TeamDeletionOutcome describes what happens to an SME or task when its team is deleted.
+ *
+ * @generated from enum mirai.v1.TeamDeletionOutcome
+ */
+export enum TeamDeletionOutcome {
+  /**
+   * @generated from enum value: TEAM_DELETION_OUTCOME_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Needs reassign_to_team_id or detach
+   *
+   * @generated from enum value: TEAM_DELETION_OUTCOME_UNRESOLVED = 1;
+   */
+  UNRESOLVED = 1,
+
+  /**
+   * @generated from enum value: TEAM_DELETION_OUTCOME_REASSIGNED = 2;
+   */
+  REASSIGNED = 2,
+
+  /**
+   * Other teams keep access
+   *
+   * @generated from enum value: TEAM_DELETION_OUTCOME_DETACHED = 3;
+   */
+  DETACHED = 3,
+
+  /**
+   * @generated from enum value: TEAM_DELETION_OUTCOME_MADE_GLOBAL = 4;
+   */
+  MADE_GLOBAL = 4,
+
+  /**
+   * @generated from enum value: TEAM_DELETION_OUTCOME_CANCELLED = 5;
+   */
+  CANCELLED = 5,
+}
+
+/**
+ * Describes the enum mirai.v1.TeamDeletionOutcome.
+ */
+export const TeamDeletionOutcomeSchema: GenEnum<TeamDeletionOutcome> = /*@__PURE__*/
+  enumDesc(file_mirai_v1_team, 0);
 
 /**
  * TeamService handles team-related operations.
@@ -383,7 +650,7 @@ export const TeamService: GenService<{
     output: typeof UpdateTeamResponseSchema;
   },
   /**
-   * DeleteTeam deletes a team.
+   * DeleteTeam deletes a team, resolving team-scoped SMEs and open tasks that reference it.
    *
    * @generated from rpc mirai.v1.TeamService.DeleteTeam
    */
@@ -422,6 +689,17 @@ export const TeamService: GenService<{
     input: typeof RemoveTeamMemberRequestSchema;
     output: typeof RemoveTeamMemberResponseSchema;
   },
+  /**
+   * GetTeamDashboard summarizes a team's workload: open tasks, active jobs, recent courses
+   * and pending invitations. Team members and admins only.
+   *
+   * @generated from rpc mirai.v1.TeamService.GetTeamDashboard
+   */
+  getTeamDashboard: {
+    methodKind: "unary";
+    input: typeof GetTeamDashboardRequestSchema;
+    output: typeof GetTeamDashboardResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_mirai_v1_team, 0);
 
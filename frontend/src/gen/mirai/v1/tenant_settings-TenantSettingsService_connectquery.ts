@@ -38,3 +38,71 @@ export const testAPIKey = TenantSettingsService.method.testAPIKey;
  * @generated from rpc mirai.v1.TenantSettingsService.GetUsageStats
  */
 export const getUsageStats = TenantSettingsService.method.getUsageStats;
+
+/**
+ * UpdateGenerationDefaults sets the default component mix for new courses.
+ *
+ * @generated from rpc mirai.v1.TenantSettingsService.UpdateGenerationDefaults
+ */
+export const updateGenerationDefaults = TenantSettingsService.method.updateGenerationDefaults;
+
+/**
+ * UpdateOutlineAutoApprove sets whether outlines may be approved automatically.
+ *
+ * @generated from rpc mirai.v1.TenantSettingsService.UpdateOutlineAutoApprove
+ */
+export const updateOutlineAutoApprove = TenantSettingsService.method.updateOutlineAutoApprove;
+
+/**
+ * UpdateLocale sets the locale dates, durations and numbers are formatted in for the
+ * organization's emails and exports.
+ *
+ * @generated from rpc mirai.v1.TenantSettingsService.UpdateLocale
+ */
+export const updateLocale = TenantSettingsService.method.updateLocale;
+
+/**
+ * GetCourseDefaults returns the settings new courses start with.
+ * Unlike the other methods, any member of the organization may call it.
+ *
+ * @generated from rpc mirai.v1.TenantSettingsService.GetCourseDefaults
+ */
+export const getCourseDefaults = TenantSettingsService.method.getCourseDefaults;
+
+/**
+ * UpdateCourseDefaults replaces the settings new courses start with.
+ *
+ * @generated from rpc mirai.v1.TenantSettingsService.UpdateCourseDefaults
+ */
+export const updateCourseDefaults = TenantSettingsService.method.updateCourseDefaults;
+
+/**
+ * UpdatePromptCache sets whether identical regeneration prompts may reuse a recent response.
+ *
+ * @generated from rpc mirai.v1.TenantSettingsService.UpdatePromptCache
+ */
+export const updatePromptCache = TenantSettingsService.method.updatePromptCache;
+
+/**
+ * UpdateWeeklySummary turns the weekly summary email on or off and sets when it is sent.
+ *
+ * @generated from rpc mirai.v1.TenantSettingsService.UpdateWeeklySummary
+ */
+export const updateWeeklySummary = TenantSettingsService.method.updateWeeklySummary;
+
+/**
+ * UpdateSecondReviewerPolicy sets whether outline approval requires a second reviewer.
+ * While it does, the user who generated an outline can't approve it, the first other
+ * reviewer's approval is recorded as an endorsement, and outline auto-approval is off.
+ *
+ * @generated from rpc mirai.v1.TenantSettingsService.UpdateSecondReviewerPolicy
+ */
+export const updateSecondReviewerPolicy = TenantSettingsService.method.updateSecondReviewerPolicy;
+
+/**
+ * UpdateExportRetention sets how many days export files are kept before they are
+ * deleted, from 1 to 365. It applies to exports made from then on.
+ *
+ * @generated from rpc mirai.v1.TenantSettingsService.UpdateExportRetention
+ */
+export const updateExportRetention = TenantSettingsService.method.updateExportRetention;
@@ -6,13 +6,17 @@ import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobu
 import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
 import type { Timestamp } from "@bufbuild/protobuf/wkt";
 import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { GenerationPreferences } from "./ai_generation_pb";
+import { file_mirai_v1_ai_generation } from "./ai_generation_pb";
+import type { AssessmentSettings } from "./course_pb";
+import { file_mirai_v1_course } from "./course_pb";
 import type { Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file mirai/v1/tenant_settings.proto.
  */
 export const file_mirai_v1_tenant_settings: GenFile = /*@__PURE__*/
-  fileDesc("Ch5taXJhaS92MS90ZW5hbnRfc2V0dGluZ3MucHJvdG8SCG1pcmFpLnYxIqYCChBUZW5hbnRBSVNldHRpbmdzEhEKCXRlbmFudF9pZBgBIAEoCRImCghwcm92aWRlchgCIAEoDjIULm1pcmFpLnYxLkFJUHJvdmlkZXISGgoSYXBpX2tleV9jb25maWd1cmVkGAMgASgIEhkKEXRvdGFsX3Rva2Vuc191c2VkGAQgASgDEiAKE21vbnRobHlfdG9rZW5fbGltaXQYBSABKANIAIgBARIuCgp1cGRhdGVkX2F0GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIfChJ1cGRhdGVkX2J5X3VzZXJfaWQYByABKAlIAYgBAUIWChRfbW9udGhseV90b2tlbl9saW1pdEIVChNfdXBkYXRlZF9ieV91c2VyX2lkIhYKFEdldEFJU2V0dGluZ3NSZXF1ZXN0IkUKFUdldEFJU2V0dGluZ3NSZXNwb25zZRIsCghzZXR0aW5ncxgBIAEoCzIaLm1pcmFpLnYxLlRlbmFudEFJU2V0dGluZ3MiSwoQU2V0QVBJS2V5UmVxdWVzdBImCghwcm92aWRlchgBIAEoDjIULm1pcmFpLnYxLkFJUHJvdmlkZXISDwoHYXBpX2tleRgCIAEoCSJBChFTZXRBUElLZXlSZXNwb25zZRIsCghzZXR0aW5ncxgBIAEoCzIaLm1pcmFpLnYxLlRlbmFudEFJU2V0dGluZ3MiFQoTUmVtb3ZlQVBJS2V5UmVxdWVzdCJEChRSZW1vdmVBUElLZXlSZXNwb25zZRIsCghzZXR0aW5ncxgBIAEoCzIaLm1pcmFpLnYxLlRlbmFudEFJU2V0dGluZ3MiTAoRVGVzdEFQSUtleVJlcXVlc3QSJgoIcHJvdmlkZXIYASABKA4yFC5taXJhaS52MS5BSVByb3ZpZGVyEg8KB2FwaV9rZXkYAiABKAkiUQoSVGVzdEFQSUtleVJlc3BvbnNlEg0KBXZhbGlkGAEgASgIEhoKDWVycm9yX21lc3NhZ2UYAiABKAlIAIgBAUIQCg5fZXJyb3JfbWVzc2FnZSKWAQoUR2V0VXNhZ2VTdGF0c1JlcXVlc3QSMgoJZnJvbV9kYXRlGAEgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgAiAEBEjAKB3RvX2RhdGUYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSAGIAQFCDAoKX2Zyb21fZGF0ZUIKCghfdG9fZGF0ZSJHCgtVc2FnZUJ5VHlwZRIQCghqb2JfdHlwZRgBIAEoCRITCgt0b2tlbnNfdXNlZBgCIAEoAxIRCglqb2JfY291bnQYAyABKAUiqQEKFUdldFVzYWdlU3RhdHNSZXNwb25zZRIZChF0b3RhbF90b2tlbnNfdXNlZBgBIAEoAxIZChF0b2tlbnNfdGhpc19tb250aBgCIAEoAxIaCg1tb250aGx5X2xpbWl0GAMgASgDSACIAQESLAoNdXNhZ2VfYnlfdHlwZRgEIAMoCzIVLm1pcmFpLnYxLlVzYWdlQnlUeXBlQhAKDl9tb250aGx5X2xpbWl0KkEKCkFJUHJvdmlkZXISGwoXQUlfUFJPVklERVJfVU5TUEVDSUZJRUQQABIWChJBSV9QUk9WSURFUl9HRU1JTkkQATKZAwoVVGVuYW50U2V0dGluZ3NTZXJ2aWNlElAKDUdldEFJU2V0dGluZ3MSHi5taXJhaS52MS5HZXRBSVNldHRpbmdzUmVxdWVzdBofLm1pcmFpLnYxLkdldEFJU2V0dGluZ3NSZXNwb25zZRJECglTZXRBUElLZXkSGi5taXJhaS52MS5TZXRBUElLZXlSZXF1ZXN0GhsubWlyYWkudjEuU2V0QVBJS2V5UmVzcG9uc2USTQoMUmVtb3ZlQVBJS2V5Eh0ubWlyYWkudjEuUmVtb3ZlQVBJS2V5UmVxdWVzdBoeLm1pcmFpLnYxLlJlbW92ZUFQSUtleVJlc3BvbnNlEkcKClRlc3RBUElLZXkSGy5taXJhaS52MS5UZXN0QVBJS2V5UmVxdWVzdBocLm1pcmFpLnYxLlRlc3RBUElLZXlSZXNwb25zZRJQCg1HZXRVc2FnZVN0YXRzEh4ubWlyYWkudjEuR2V0VXNhZ2VTdGF0c1JlcXVlc3QaHy5taXJhaS52MS5HZXRVc2FnZVN0YXRzUmVzcG9uc2VCmQEKDGNvbS5taXJhaS52MUITVGVuYW50U2V0dGluZ3NQcm90b1ABWjNnaXRodWIuY29tL3NvZ29zL21pcmFpLWJhY2tlbmQvZ2VuL21pcmFpL3YxO21pcmFpdjGiAgNNWFiqAghNaXJhaS5WMcoCCE1pcmFpXFYx4gIUTWlyYWlcVjFcR1BCTWV0YWRhdGHqAglNaXJhaTo6VjFiBnByb3RvMw", [file_google_protobuf_timestamp]);
+  fileDesc("Ch5taXJhaS92MS90ZW5hbnRfc2V0dGluZ3MucHJvdG8SCG1pcmFpLnYxIr8EChBUZW5hbnRBSVNldHRpbmdzEhEKCXRlbmFudF9pZBgBIAEoCRImCghwcm92aWRlchgCIAEoDjIULm1pcmFpLnYxLkFJUHJvdmlkZXISGgoSYXBpX2tleV9jb25maWd1cmVkGAMgASgIEhkKEXRvdGFsX3Rva2Vuc191c2VkGAQgASgDEiAKE21vbnRobHlfdG9rZW5fbGltaXQYBSABKANIAIgBARIuCgp1cGRhdGVkX2F0GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIfChJ1cGRhdGVkX2J5X3VzZXJfaWQYByABKAlIAYgBARI8ChNnZW5lcmF0aW9uX2RlZmF1bHRzGAggASgLMh8ubWlyYWkudjEuR2VuZXJhdGlvblByZWZlcmVuY2VzEiIKGmFsbG93X291dGxpbmVfYXV0b19hcHByb3ZlGAkgASgIEhMKBmxvY2FsZRgKIAEoCUgCiAEBEhwKFGRpc2FibGVfcHJvbXB0X2NhY2hlGAsgASgIEjcKDndlZWtseV9zdW1tYXJ5GAwgASgLMh8ubWlyYWkudjEuV2Vla2x5U3VtbWFyeVNjaGVkdWxlEh8KF3JlcXVpcmVfc2Vjb25kX3Jldmlld2VyGA0gASgIEh0KFWV4cG9ydF9yZXRlbnRpb25fZGF5cxgOIAEoBUIWChRfbW9udGhseV90b2tlbl9saW1pdEIVChNfdXBkYXRlZF9ieV91c2VyX2lkQgkKB19sb2NhbGUi5wEKDkNvdXJzZURlZmF1bHRzEiIKFWRlc3RpbmF0aW9uX2ZvbGRlcl9pZBgBIAEoCUgAiAEBEhUKDWNhdGVnb3J5X3RhZ3MYAiADKAkSGAoLZGF0YV9zb3VyY2UYAyABKAlIAYgBARI+ChNhc3Nlc3NtZW50X3NldHRpbmdzGAQgASgLMhwubWlyYWkudjEuQXNzZXNzbWVudFNldHRpbmdzSAKIAQFCGAoWX2Rlc3RpbmF0aW9uX2ZvbGRlcl9pZEIOCgxfZGF0YV9zb3VyY2VCFgoUX2Fzc2Vzc21lbnRfc2V0dGluZ3MiFgoUR2V0QUlTZXR0aW5nc1JlcXVlc3QiRQoVR2V0QUlTZXR0aW5nc1Jlc3BvbnNlEiwKCHNldHRpbmdzGAEgASgLMhoubWlyYWkudjEuVGVuYW50QUlTZXR0aW5ncyJLChBTZXRBUElLZXlSZXF1ZXN0EiYKCHByb3ZpZGVyGAEgASgOMhQubWlyYWkudjEuQUlQcm92aWRlchIPCgdhcGlfa2V5GAIgASgJIkEKEVNldEFQSUtleVJlc3BvbnNlEiwKCHNldHRpbmdzGAEgASgLMhoubWlyYWkudjEuVGVuYW50QUlTZXR0aW5ncyIVChNSZW1vdmVBUElLZXlSZXF1ZXN0IkQKFFJlbW92ZUFQSUtleVJlc3BvbnNlEiwKCHNldHRpbmdzGAEgASgLMhoubWlyYWkudjEuVGVuYW50QUlTZXR0aW5ncyJMChFUZXN0QVBJS2V5UmVxdWVzdBImCghwcm92aWRlchgBIAEoDjIULm1pcmFpLnYxLkFJUHJvdmlkZXISDwoHYXBpX2tleRgCIAEoCSJRChJUZXN0QVBJS2V5UmVzcG9uc2USDQoFdmFsaWQYASABKAgSGgoNZXJyb3JfbWVzc2FnZRgCIAEoCUgAiAEBQhAKDl9lcnJvcl9tZXNzYWdlIpYBChRHZXRVc2FnZVN0YXRzUmVxdWVzdBIyCglmcm9tX2RhdGUYASABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSACIAQESMAoHdG9fZGF0ZRgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIAYgBAUIMCgpfZnJvbV9kYXRlQgoKCF90b19kYXRlIkcKC1VzYWdlQnlUeXBlEhAKCGpvYl90eXBlGAEgASgJEhMKC3Rva2Vuc191c2VkGAIgASgDEhEKCWpvYl9jb3VudBgDIAEoBSI2CgtVc2FnZVBlcmlvZBISCgp5ZWFyX21vbnRoGAEgASgJEhMKC3Rva2Vuc191c2VkGAIgASgDItEBChVHZXRVc2FnZVN0YXRzUmVzcG9uc2USGQoRdG90YWxfdG9rZW5zX3VzZWQYASABKAMSGQoRdG9rZW5zX3RoaXNfbW9udGgYAiABKAMSGgoNbW9udGhseV9saW1pdBgDIAEoA0gAiAEBEiwKDXVzYWdlX2J5X3R5cGUYBCADKAsyFS5taXJhaS52MS5Vc2FnZUJ5VHlwZRImCgdwZXJpb2RzGAUgAygLMhUubWlyYWkudjEuVXNhZ2VQZXJpb2RCEAoOX21vbnRobHlfbGltaXQiVAofVXBkYXRlR2VuZXJhdGlvbkRlZmF1bHRzUmVxdWVzdBIxCghkZWZhdWx0cxgBIAEoCzIfLm1pcmFpLnYxLkdlbmVyYXRpb25QcmVmZXJlbmNlcyJQCiBVcGRhdGVHZW5lcmF0aW9uRGVmYXVsdHNSZXNwb25zZRIsCghzZXR0aW5ncxgBIAEoCzIaLm1pcmFpLnYxLlRlbmFudEFJU2V0dGluZ3MiMgofVXBkYXRlT3V0bGluZUF1dG9BcHByb3ZlUmVxdWVzdBIPCgdhbGxvd2VkGAEgASgIIlAKIFVwZGF0ZU91dGxpbmVBdXRvQXBwcm92ZVJlc3BvbnNlEiwKCHNldHRpbmdzGAEgASgLMhoubWlyYWkudjEuVGVuYW50QUlTZXR0aW5ncyIlChNVcGRhdGVMb2NhbGVSZXF1ZXN0Eg4KBmxvY2FsZRgBIAEoCSJEChRVcGRhdGVMb2NhbGVSZXNwb25zZRIsCghzZXR0aW5ncxgBIAEoCzIaLm1pcmFpLnYxLlRlbmFudEFJU2V0dGluZ3MiGgoYR2V0Q291cnNlRGVmYXVsdHNSZXF1ZXN0IkcKGUdldENvdXJzZURlZmF1bHRzUmVzcG9uc2USKgoIZGVmYXVsdHMYASABKAsyGC5taXJhaS52MS5Db3Vyc2VEZWZhdWx0cyJJChtVcGRhdGVDb3Vyc2VEZWZhdWx0c1JlcXVlc3QSKgoIZGVmYXVsdHMYASABKAsyGC5taXJhaS52MS5Db3Vyc2VEZWZhdWx0cyJKChxVcGRhdGVDb3Vyc2VEZWZhdWx0c1Jlc3BvbnNlEioKCGRlZmF1bHRzGAEgASgLMhgubWlyYWkudjEuQ291cnNlRGVmYXVsdHMiLAoYVXBkYXRlUHJvbXB0Q2FjaGVSZXF1ZXN0EhAKCGRpc2FibGVkGAEgASgIIkkKGVVwZGF0ZVByb21wdENhY2hlUmVzcG9uc2USLAoIc2V0dGluZ3MYASABKAsyGi5taXJhaS52MS5UZW5hbnRBSVNldHRpbmdzIk8KGlVwZGF0ZVdlZWtseVN1bW1hcnlSZXF1ZXN0EjEKCHNjaGVkdWxlGAEgASgLMh8ubWlyYWkudjEuV2Vla2x5U3VtbWFyeVNjaGVkdWxlIksKG1VwZGF0ZVdlZWtseVN1bW1hcnlSZXNwb25zZRIsCghzZXR0aW5ncxgBIAEoCzIaLm1pcmFpLnYxLlRlbmFudEFJU2V0dGluZ3MiQwoVV2Vla2x5U3VtbWFyeVNjaGVkdWxlEg8KB2VuYWJsZWQYASABKAgSCwoDZGF5GAIgASgFEgwKBGhvdXIYAyABKAUiNQohVXBkYXRlU2Vjb25kUmV2aWV3ZXJQb2xpY3lSZXF1ZXN0EhAKCHJlcXVpcmVkGAEgASgIIlIKIlVwZGF0ZVNlY29uZFJldmlld2VyUG9saWN5UmVzcG9uc2USLAoIc2V0dGluZ3MYASABKAsyGi5taXJhaS52MS5UZW5hbnRBSVNldHRpbmdzIiwKHFVwZGF0ZUV4cG9ydFJldGVudGlvblJlcXVlc3QSDAoEZGF5cxgBIAEoBSJNCh1VcGRhdGVFeHBvcnRSZXRlbnRpb25SZXNwb25zZRIsCghzZXR0aW5ncxgBIAEoCzIaLm1pcmFpLnYxLlRlbmFudEFJU2V0dGluZ3MqQQoKQUlQcm92aWRlchIbChdBSV9QUk9WSURFUl9VTlNQRUNJRklFRBAAEhYKEkFJX1BST1ZJREVSX0dFTUlOSRABMrgKChVUZW5hbnRTZXR0aW5nc1NlcnZpY2USUAoNR2V0QUlTZXR0aW5ncxIeLm1pcmFpLnYxLkdldEFJU2V0dGluZ3NSZXF1ZXN0Gh8ubWlyYWkudjEuR2V0QUlTZXR0aW5nc1Jlc3BvbnNlEkQKCVNldEFQSUtleRIaLm1pcmFpLnYxLlNldEFQSUtleVJlcXVlc3QaGy5taXJhaS52MS5TZXRBUElLZXlSZXNwb25zZRJNCgxSZW1vdmVBUElLZXkSHS5taXJhaS52MS5SZW1vdmVBUElLZXlSZXF1ZXN0Gh4ubWlyYWkudjEuUmVtb3ZlQVBJS2V5UmVzcG9uc2USRwoKVGVzdEFQSUtleRIbLm1pcmFpLnYxLlRlc3RBUElLZXlSZXF1ZXN0GhwubWlyYWkudjEuVGVzdEFQSUtleVJlc3BvbnNlElAKDUdldFVzYWdlU3RhdHMSHi5taXJhaS52MS5HZXRVc2FnZVN0YXRzUmVxdWVzdBofLm1pcmFpLnYxLkdldFVzYWdlU3RhdHNSZXNwb25zZRJxChhVcGRhdGVHZW5lcmF0aW9uRGVmYXVsdHMSKS5taXJhaS52MS5VcGRhdGVHZW5lcmF0aW9uRGVmYXVsdHNSZXF1ZXN0GioubWlyYWkudjEuVXBkYXRlR2VuZXJhdGlvbkRlZmF1bHRzUmVzcG9uc2UScQoYVXBkYXRlT3V0bGluZUF1dG9BcHByb3ZlEikubWlyYWkudjEuVXBkYXRlT3V0bGluZUF1dG9BcHByb3ZlUmVxdWVzdBoqLm1pcmFpLnYxLlVwZGF0ZU91dGxpbmVBdXRvQXBwcm92ZVJlc3BvbnNlEk0KDFVwZGF0ZUxvY2FsZRIdLm1pcmFpLnYxLlVwZGF0ZUxvY2FsZVJlcXVlc3QaHi5taXJhaS52MS5VcGRhdGVMb2NhbGVSZXNwb25zZRJcChFHZXRDb3Vyc2VEZWZhdWx0cxIiLm1pcmFpLnYxLkdldENvdXJzZURlZmF1bHRzUmVxdWVzdBojLm1pcmFpLnYxLkdldENvdXJzZURlZmF1bHRzUmVzcG9uc2USZQoUVXBkYXRlQ291cnNlRGVmYXVsdHMSJS5taXJhaS52MS5VcGRhdGVDb3Vyc2VEZWZhdWx0c1JlcXVlc3QaJi5taXJhaS52MS5VcGRhdGVDb3Vyc2VEZWZhdWx0c1Jlc3BvbnNlElwKEVVwZGF0ZVByb21wdENhY2hlEiIubWlyYWkudjEuVXBkYXRlUHJvbXB0Q2FjaGVSZXF1ZXN0GiMubWlyYWkudjEuVXBkYXRlUHJvbXB0Q2FjaGVSZXNwb25zZRJiChNVcGRhdGVXZWVrbHlTdW1tYXJ5EiQubWlyYWkudjEuVXBkYXRlV2Vla2x5U3VtbWFyeVJlcXVlc3QaJS5taXJhaS52MS5VcGRhdGVXZWVrbHlTdW1tYXJ5UmVzcG9uc2USdwoaVXBkYXRlU2Vjb25kUmV2aWV3ZXJQb2xpY3kSKy5taXJhaS52MS5VcGRhdGVTZWNvbmRSZXZpZXdlclBvbGljeVJlcXVlc3QaLC5taXJhaS52MS5VcGRhdGVTZWNvbmRSZXZpZXdlclBvbGljeVJlc3BvbnNlEmgKFVVwZGF0ZUV4cG9ydFJldGVudGlvbhImLm1pcmFpLnYxLlVwZGF0ZUV4cG9ydFJldGVudGlvblJlcXVlc3QaJy5taXJhaS52MS5VcGRhdGVFeHBvcnRSZXRlbnRpb25SZXNwb25zZUKZAQoMY29tLm1pcmFpLnYxQhNUZW5hbnRTZXR0aW5nc1Byb3RvUAFaM2dpdGh1Yi5jb20vc29nb3MvbWlyYWktYmFja2VuZC9nZW4vbWlyYWkvdjE7bWlyYWl2MaICA01YWKoCCE1pcmFpLlYxygIITWlyYWlcVjHiAhRNaXJhaVxWMVxHUEJNZXRhZGF0YeoCCU1pcmFpOjpWMWIGcHJvdG8z", [file_google_protobuf_timestamp, file_mirai_v1_ai_generation, file_mirai_v1_course]);
 
 /**
  * TenantAISettings contains AI configuration for a tenant.
@@ -59,6 +63,55 @@ export type TenantAISettings = Message<"mirai.v1.TenantAISettings"> & {
    * @generated from field: optional string updated_by_user_id = 7;
    */
   updatedByUserId?: string;
+
+  /**
+   * Component mix for new courses
+   *
+   * @generated from field: mirai.v1.GenerationPreferences generation_defaults = 8;
+   */
+  generationDefaults?: GenerationPreferences;
+
+  /**
+   * Outline generation may skip review and generate lessons
+   *
+   * @generated from field: bool allow_outline_auto_approve = 9;
+   */
+  allowOutlineAutoApprove: boolean;
+
+  /**
+   * Locale for emails and exports ("en", "de", "fr", "es", "pt"); unset uses each user's
+   *
+   * @generated from field: optional string locale = 10;
+   */
+  locale?: string;
+
+  /**
+   * Identical regeneration prompts always call the provider
+   *
+   * @generated from field: bool disable_prompt_cache = 11;
+   */
+  disablePromptCache: boolean;
+
+  /**
+   * When admins receive the weekly summary email
+   *
+   * @generated from field: mirai.v1.WeeklySummarySchedule weekly_summary = 12;
+   */
+  weeklySummary?: WeeklySummarySchedule;
+
+  /**
+   * Outline approval needs a reviewer other than its generator and endorser
+   *
+   * @generated from field: bool require_second_reviewer = 13;
+   */
+  requireSecondReviewer: boolean;
+
+  /**
+   * Days export files are kept before they are deleted
+   *
+   * @generated from field: int32 export_retention_days = 14;
+   */
+  exportRetentionDays: number;
 };
 
 /**
@@ -68,6 +121,43 @@ export type TenantAISettings = Message<"mirai.v1.TenantAISettings"> & {
 export const TenantAISettingsSchema: GenMessage<TenantAISettings> = /*@__PURE__*/
   messageDesc(file_mirai_v1_tenant_settings, 0);
 
+/**
+ * CourseDefaults are the settings new courses start with. Each one applies only
+ * when a course is created without its own value.
+ *
+ * @generated from message mirai.v1.CourseDefaults
+ */
+export type CourseDefaults = Message<"mirai.v1.CourseDefaults"> & {
+  /**
+   * Skipped at creation if the folder no longer exists
+   *
+   * @generated from field: optional string destination_folder_id = 1;
+   */
+  destinationFolderId?: string;
+
+  /**
+   * @generated from field: repeated string category_tags = 2;
+   */
+  categoryTags: string[];
+
+  /**
+   * @generated from field: optional string data_source = 3;
+   */
+  dataSource?: string;
+
+  /**
+   * @generated from field: optional mirai.v1.AssessmentSettings assessment_settings = 4;
+   */
+  assessmentSettings?: AssessmentSettings;
+};
+
+/**
+ * Describes the message mirai.v1.CourseDefaults.
+ * Use `create(CourseDefaultsSchema)` to create a new message.
+ */
+export const CourseDefaultsSchema: GenMessage<CourseDefaults> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_tenant_settings, 1);
+
 /**
  * GetAISettingsRequest is empty as tenant is from auth context.
  *
@@ -81,7 +171,7 @@ export type GetAISettingsRequest = Message<"mirai.v1.GetAISettingsRequest"> & {
  * Use `create(GetAISettingsRequestSchema)` to create a new message.
  */
 export const GetAISettingsRequestSchema: GenMessage<GetAISettingsRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_tenant_settings, 1);
+  messageDesc(file_mirai_v1_tenant_settings, 2);
 
 /**
  * GetAISettingsResponse contains the AI settings.
@@ -100,7 +190,7 @@ export type GetAISettingsResponse = Message<"mirai.v1.GetAISettingsResponse"> &
  * Use `create(GetAISettingsResponseSchema)` to create a new message.
  */
 export const GetAISettingsResponseSchema: GenMessage<GetAISettingsResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_tenant_settings, 2);
+  messageDesc(file_mirai_v1_tenant_settings, 3);
 
 /**
  * SetAPIKeyRequest contains the API key to set.
@@ -126,7 +216,7 @@ export type SetAPIKeyRequest = Message<"mirai.v1.SetAPIKeyRequest"> & {
  * Use `create(SetAPIKeyRequestSchema)` to create a new message.
  */
 export const SetAPIKeyRequestSchema: GenMessage<SetAPIKeyRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_tenant_settings, 3);
+  messageDesc(file_mirai_v1_tenant_settings, 4);
 
 /**
  * SetAPIKeyResponse confirms the key was set.
@@ -145,7 +235,7 @@ export type SetAPIKeyResponse = Message<"mirai.v1.SetAPIKeyResponse"> & {
  * Use `create(SetAPIKeyResponseSchema)` to create a new message.
  */
 export const SetAPIKeyResponseSchema: GenMessage<SetAPIKeyResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_tenant_settings, 4);
+  messageDesc(file_mirai_v1_tenant_settings, 5);
 
 /**
  * RemoveAPIKeyRequest removes the API key.
@@ -160,7 +250,7 @@ export type RemoveAPIKeyRequest = Message<"mirai.v1.RemoveAPIKeyRequest"> & {
  * Use `create(RemoveAPIKeyRequestSchema)` to create a new message.
  */
 export const RemoveAPIKeyRequestSchema: GenMessage<RemoveAPIKeyRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_tenant_settings, 5);
+  messageDesc(file_mirai_v1_tenant_settings, 6);
 
 /**
  * RemoveAPIKeyResponse confirms removal.
@@ -179,7 +269,7 @@ export type RemoveAPIKeyResponse = Message<"mirai.v1.RemoveAPIKeyResponse"> & {
  * Use `create(RemoveAPIKeyResponseSchema)` to create a new message.
  */
 export const RemoveAPIKeyResponseSchema: GenMessage<RemoveAPIKeyResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_tenant_settings, 6);
+  messageDesc(file_mirai_v1_tenant_settings, 7);
 
 /**
  * TestAPIKeyRequest tests an API key without saving.
@@ -203,7 +293,7 @@ export type TestAPIKeyRequest = Message<"mirai.v1.TestAPIKeyRequest"> & {
  * Use `create(TestAPIKeyRequestSchema)` to create a new message.
  */
 export const TestAPIKeyRequestSchema: GenMessage<TestAPIKeyRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_tenant_settings, 7);
+  messageDesc(file_mirai_v1_tenant_settings, 8);
 
 /**
  * TestAPIKeyResponse indicates if the key is valid.
@@ -227,7 +317,7 @@ export type TestAPIKeyResponse = Message<"mirai.v1.TestAPIKeyResponse"> & {
  * Use `create(TestAPIKeyResponseSchema)` to create a new message.
  */
 export const TestAPIKeyResponseSchema: GenMessage<TestAPIKeyResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_tenant_settings, 8);
+  messageDesc(file_mirai_v1_tenant_settings, 9);
 
 /**
  * GetUsageStatsRequest fetches usage statistics.
@@ -251,7 +341,7 @@ export type GetUsageStatsRequest = Message<"mirai.v1.GetUsageStatsRequest"> & {
  * Use `create(GetUsageStatsRequestSchema)` to create a new message.
  */
 export const GetUsageStatsRequestSchema: GenMessage<GetUsageStatsRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_tenant_settings, 9);
+  messageDesc(file_mirai_v1_tenant_settings, 10);
 
 /**
  * UsageByType breaks down usage by job type.
@@ -280,7 +370,33 @@ export type UsageByType = Message<"mirai.v1.UsageByType"> & {
  * Use `create(UsageByTypeSchema)` to create a new message.
  */
 export const UsageByTypeSchema: GenMessage<UsageByType> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_tenant_settings, 10);
+  messageDesc(file_mirai_v1_tenant_settings, 11);
+
+/**
+ * UsagePeriod is the token usage for one calendar month (UTC).
+ *
+ * @generated from message mirai.v1.UsagePeriod
+ */
+export type UsagePeriod = Message<"mirai.v1.UsagePeriod"> & {
+  /**
+   * e.g. "2026-10"
+   *
+   * @generated from field: string year_month = 1;
+   */
+  yearMonth: string;
+
+  /**
+   * @generated from field: int64 tokens_used = 2;
+   */
+  tokensUsed: bigint;
+};
+
+/**
+ * Describes the message mirai.v1.UsagePeriod.
+ * Use `create(UsagePeriodSchema)` to create a new message.
+ */
+export const UsagePeriodSchema: GenMessage<UsagePeriod> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_tenant_settings, 12);
 
 /**
  * GetUsageStatsResponse contains usage statistics.
@@ -294,6 +410,8 @@ export type GetUsageStatsResponse = Message<"mirai.v1.GetUsageStatsResponse"> &
   totalTokensUsed: bigint;
 
   /**
+   * Counted against monthly_limit; resets each month
+   *
    * @generated from field: int64 tokens_this_month = 2;
    */
   tokensThisMonth: bigint;
@@ -307,6 +425,13 @@ export type GetUsageStatsResponse = Message<"mirai.v1.GetUsageStatsResponse"> &
    * @generated from field: repeated mirai.v1.UsageByType usage_by_type = 4;
    */
   usageByType: UsageByType[];
+
+  /**
+   * Past months' usage, most recent first
+   *
+   * @generated from field: repeated mirai.v1.UsagePeriod periods = 5;
+   */
+  periods: UsagePeriod[];
 };
 
 /**
@@ -314,7 +439,381 @@ export type GetUsageStatsResponse = Message<"mirai.v1.GetUsageStatsResponse"> &
  * Use `create(GetUsageStatsResponseSchema)` to create a new message.
  */
 export const GetUsageStatsResponseSchema: GenMessage<GetUsageStatsResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_tenant_settings, 11);
+  messageDesc(file_mirai_v1_tenant_settings, 13);
+
+/**
+ * UpdateGenerationDefaultsRequest contains the new defaults.
+ *
+ * @generated from message mirai.v1.UpdateGenerationDefaultsRequest
+ */
+export type UpdateGenerationDefaultsRequest = Message<"mirai.v1.UpdateGenerationDefaultsRequest"> & {
+  /**
+   * @generated from field: mirai.v1.GenerationPreferences defaults = 1;
+   */
+  defaults?: GenerationPreferences;
+};
+
+/**
+ * Describes the message mirai.v1.UpdateGenerationDefaultsRequest.
+ * Use `create(UpdateGenerationDefaultsRequestSchema)` to create a new message.
+ */
+export const UpdateGenerationDefaultsRequestSchema: GenMessage<UpdateGenerationDefaultsRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_tenant_settings, 14);
+
+/**
+ * UpdateGenerationDefaultsResponse returns the updated settings.
+ *
+ * @generated from message mirai.v1.UpdateGenerationDefaultsResponse
+ */
+export type UpdateGenerationDefaultsResponse = Message<"mirai.v1.UpdateGenerationDefaultsResponse"> & {
+  /**
+   * @generated from field: mirai.v1.TenantAISettings settings = 1;
+   */
+  settings?: TenantAISettings;
+};
+
+/**
+ * Describes the message mirai.v1.UpdateGenerationDefaultsResponse.
+ * Use `create(UpdateGenerationDefaultsResponseSchema)` to create a new message.
+ */
+export const UpdateGenerationDefaultsResponseSchema: GenMessage<UpdateGenerationDefaultsResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_tenant_settings, 15);
+
+/**
+ * UpdateOutlineAutoApproveRequest contains the new auto-approval setting.
+ *
+ * @generated from message mirai.v1.UpdateOutlineAutoApproveRequest
+ */
+export type UpdateOutlineAutoApproveRequest = Message<"mirai.v1.UpdateOutlineAutoApproveRequest"> & {
+  /**
+   * @generated from field: bool allowed = 1;
+   */
+  allowed: boolean;
+};
+
+/**
+ * Describes the message mirai.v1.UpdateOutlineAutoApproveRequest.
+ * Use `create(UpdateOutlineAutoApproveRequestSchema)` to create a new message.
+ */
+export const UpdateOutlineAutoApproveRequestSchema: GenMessage<UpdateOutlineAutoApproveRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_tenant_settings, 16);
+
+/**
+ * UpdateOutlineAutoApproveResponse returns the updated settings.
+ *
+ * @generated from message mirai.v1.UpdateOutlineAutoApproveResponse
+ */
+export type UpdateOutlineAutoApproveResponse = Message<"mirai.v1.UpdateOutlineAutoApproveResponse"> & {
+  /**
+   * @generated from field: mirai.v1.TenantAISettings settings = 1;
+   */
+  settings?: TenantAISettings;
+};
+
+/**
+ * Describes the message mirai.v1.UpdateOutlineAutoApproveResponse.
+ * Use `create(UpdateOutlineAutoApproveResponseSchema)` to create a new message.
+ */
+export const UpdateOutlineAutoApproveResponseSchema: GenMessage<UpdateOutlineAutoApproveResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_tenant_settings, 17);
+
+/**
+ * UpdateLocaleRequest contains the new organization locale.
+ *
+ * @generated from message mirai.v1.UpdateLocaleRequest
+ */
+export type UpdateLocaleRequest = Message<"mirai.v1.UpdateLocaleRequest"> & {
+  /**
+   * Empty clears it, so each user's own locale applies
+   *
+   * @generated from field: string locale = 1;
+   */
+  locale: string;
+};
+
+/**
+ * Describes the message mirai.v1.UpdateLocaleRequest.
+ * Use `create(UpdateLocaleRequestSchema)` to create a new message.
+ */
+export const UpdateLocaleRequestSchema: GenMessage<UpdateLocaleRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_tenant_settings, 18);
+
+/**
+ * UpdateLocaleResponse returns the updated settings.
+ *
+ * @generated from message mirai.v1.UpdateLocaleResponse
+ */
+export type UpdateLocaleResponse = Message<"mirai.v1.UpdateLocaleResponse"> & {
+  /**
+   * @generated from field: mirai.v1.TenantAISettings settings = 1;
+   */
+  settings?: TenantAISettings;
+};
+
+/**
+ * Describes the message mirai.v1.UpdateLocaleResponse.
+ * Use `create(UpdateLocaleResponseSchema)` to create a new message.
+ */
+export const UpdateLocaleResponseSchema: GenMessage<UpdateLocaleResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_tenant_settings, 19);
+
+/**
+ * GetCourseDefaultsRequest is empty as tenant is from auth context.
+ *
+ * @generated from message mirai.v1.GetCourseDefaultsRequest
+ */
+export type GetCourseDefaultsRequest = Message<"mirai.v1.GetCourseDefaultsRequest"> & {
+};
+
+/**
+ * Describes the message mirai.v1.GetCourseDefaultsRequest.
+ * Use `create(GetCourseDefaultsRequestSchema)` to create a new message.
+ */
+export const GetCourseDefaultsRequestSchema: GenMessage<GetCourseDefaultsRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_tenant_settings, 20);
+
+/**
+ * GetCourseDefaultsResponse contains the course defaults.
+ *
+ * @generated from message mirai.v1.GetCourseDefaultsResponse
+ */
+export type GetCourseDefaultsResponse = Message<"mirai.v1.GetCourseDefaultsResponse"> & {
+  /**
+   * @generated from field: mirai.v1.CourseDefaults defaults = 1;
+   */
+  defaults?: CourseDefaults;
+};
+
+/**
+ * Describes the message mirai.v1.GetCourseDefaultsResponse.
+ * Use `create(GetCourseDefaultsResponseSchema)` to create a new message.
+ */
+export const GetCourseDefaultsResponseSchema: GenMessage<GetCourseDefaultsResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_tenant_settings, 21);
+
+/**
+ * UpdateCourseDefaultsRequest contains the new course defaults.
+ *
+ * @generated from message mirai.v1.UpdateCourseDefaultsRequest
+ */
+export type UpdateCourseDefaultsRequest = Message<"mirai.v1.UpdateCourseDefaultsRequest"> & {
+  /**
+   * @generated from field: mirai.v1.CourseDefaults defaults = 1;
+   */
+  defaults?: CourseDefaults;
+};
+
+/**
+ * Describes the message mirai.v1.UpdateCourseDefaultsRequest.
+ * Use `create(UpdateCourseDefaultsRequestSchema)` to create a new message.
+ */
+export const UpdateCourseDefaultsRequestSchema: GenMessage<UpdateCourseDefaultsRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_tenant_settings, 22);
+
+/**
+ * UpdateCourseDefaultsResponse returns the saved course defaults.
+ *
+ * @generated from message mirai.v1.UpdateCourseDefaultsResponse
+ */
+export type UpdateCourseDefaultsResponse = Message<"mirai.v1.UpdateCourseDefaultsResponse"> & {
+  /**
+   * @generated from field: mirai.v1.CourseDefaults defaults = 1;
+   */
+  defaults?: CourseDefaults;
+};
+
+/**
+ * Describes the message mirai.v1.UpdateCourseDefaultsResponse.
+ * Use `create(UpdateCourseDefaultsResponseSchema)` to create a new message.
+ */
+export const UpdateCourseDefaultsResponseSchema: GenMessage<UpdateCourseDefaultsResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_tenant_settings, 23);
+
+/**
+ * UpdatePromptCacheRequest contains the new prompt cache setting.
+ *
+ * @generated from message mirai.v1.UpdatePromptCacheRequest
+ */
+export type UpdatePromptCacheRequest = Message<"mirai.v1.UpdatePromptCacheRequest"> & {
+  /**
+   * @generated from field: bool disabled = 1;
+   */
+  disabled: boolean;
+};
+
+/**
+ * Describes the message mirai.v1.UpdatePromptCacheRequest.
+ * Use `create(UpdatePromptCacheRequestSchema)` to create a new message.
+ */
+export const UpdatePromptCacheRequestSchema: GenMessage<UpdatePromptCacheRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_tenant_settings, 24);
+
+/**
+ * UpdatePromptCacheResponse returns the updated settings.
+ *
+ * @generated from message mirai.v1.UpdatePromptCacheResponse
+ */
+export type UpdatePromptCacheResponse = Message<"mirai.v1.UpdatePromptCacheResponse"> & {
+  /**
+   * @generated from field: mirai.v1.TenantAISettings settings = 1;
+   */
+  settings?: TenantAISettings;
+};
+
+/**
+ * Describes the message mirai.v1.UpdatePromptCacheResponse.
+ * Use `create(UpdatePromptCacheResponseSchema)` to create a new message.
+ */
+export const UpdatePromptCacheResponseSchema: GenMessage<UpdatePromptCacheResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_tenant_settings, 25);
+
+/**
+ * UpdateWeeklySummaryRequest contains the new weekly summary schedule.
+ *
+ * @generated from message mirai.v1.UpdateWeeklySummaryRequest
+ */
+export type UpdateWeeklySummaryRequest = Message<"mirai.v1.UpdateWeeklySummaryRequest"> & {
+  /**
+   * @generated from field: mirai.v1.WeeklySummarySchedule schedule = 1;
+   */
+  schedule?: WeeklySummarySchedule;
+};
+
+/**
+ * Describes the message mirai.v1.UpdateWeeklySummaryRequest.
+ * Use `create(UpdateWeeklySummaryRequestSchema)` to create a new message.
+ */
+export const UpdateWeeklySummaryRequestSchema: GenMessage<UpdateWeeklySummaryRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_tenant_settings, 26);
+
+/**
+ * UpdateWeeklySummaryResponse returns the updated settings.
+ *
+ * @generated from message mirai.v1.UpdateWeeklySummaryResponse
+ */
+export type UpdateWeeklySummaryResponse = Message<"mirai.v1.UpdateWeeklySummaryResponse"> & {
+  /**
+   * @generated from field: mirai.v1.TenantAISettings settings = 1;
+   */
+  settings?: TenantAISettings;
+};
+
+/**
+ * Describes the message mirai.v1.UpdateWeeklySummaryResponse.
+ * Use `create(UpdateWeeklySummaryResponseSchema)` to create a new message.
+ */
+export const UpdateWeeklySummaryResponseSchema: GenMessage<UpdateWeeklySummaryResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_tenant_settings, 27);
+
+/**
+ * WeeklySummarySchedule is when admins receive the weekly summary email, which reports
+ * on the previous Monday-to-Sunday week. Weeks without activity send nothing.
+ *
+ * @generated from message mirai.v1.WeeklySummarySchedule
+ */
+export type WeeklySummarySchedule = Message<"mirai.v1.WeeklySummarySchedule"> & {
+  /**
+   * @generated from field: bool enabled = 1;
+   */
+  enabled: boolean;
+
+  /**
+   * Day of week, 0 = Sunday ... 6 = Saturday
+   *
+   * @generated from field: int32 day = 2;
+   */
+  day: number;
+
+  /**
+   * Hour of day in UTC, 0-23
+   *
+   * @generated from field: int32 hour = 3;
+   */
+  hour: number;
+};
+
+/**
+ * Describes the message mirai.v1.WeeklySummarySchedule.
+ * Use `create(WeeklySummaryScheduleSchema)` to create a new message.
+ */
+export const WeeklySummaryScheduleSchema: GenMessage<WeeklySummarySchedule> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_tenant_settings, 28);
+
+/**
+ * UpdateSecondReviewerPolicyRequest contains the new second reviewer policy.
+ *
+ * @generated from message mirai.v1.UpdateSecondReviewerPolicyRequest
+ */
+export type UpdateSecondReviewerPolicyRequest = Message<"mirai.v1.UpdateSecondReviewerPolicyRequest"> & {
+  /**
+   * @generated from field: bool required = 1;
+   */
+  required: boolean;
+};
+
+/**
+ * Describes the message mirai.v1.UpdateSecondReviewerPolicyRequest.
+ * Use `create(UpdateSecondReviewerPolicyRequestSchema)` to create a new message.
+ */
+export const UpdateSecondReviewerPolicyRequestSchema: GenMessage<UpdateSecondReviewerPolicyRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_tenant_settings, 29);
+
+/**
+ * UpdateSecondReviewerPolicyResponse returns the updated settings.
+ *
+ * @generated from message mirai.v1.UpdateSecondReviewerPolicyResponse
+ */
+export type UpdateSecondReviewerPolicyResponse = Message<"mirai.v1.UpdateSecondReviewerPolicyResponse"> & {
+  /**
+   * @generated from field: mirai.v1.TenantAISettings settings = 1;
+   */
+  settings?: TenantAISettings;
+};
+
+/**
+ * Describes the message mirai.v1.UpdateSecondReviewerPolicyResponse.
+ * Use `create(UpdateSecondReviewerPolicyResponseSchema)` to create a new message.
+ */
+export const UpdateSecondReviewerPolicyResponseSchema: GenMessage<UpdateSecondReviewerPolicyResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_tenant_settings, 30);
+
+/**
+ * UpdateExportRetentionRequest contains the new export retention period.
+ *
+ * @generated from message mirai.v1.UpdateExportRetentionRequest
+ */
+export type UpdateExportRetentionRequest = Message<"mirai.v1.UpdateExportRetentionRequest"> & {
+  /**
+   * @generated from field: int32 days = 1;
+   */
+  days: number;
+};
+
+/**
+ * Describes the message mirai.v1.UpdateExportRetentionRequest.
+ * Use `create(UpdateExportRetentionRequestSchema)` to create a new message.
+ */
+export const UpdateExportRetentionRequestSchema: GenMessage<UpdateExportRetentionRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_tenant_settings, 31);
+
+/**
+ * UpdateExportRetentionResponse returns the updated settings.
+ *
+ * @generated from message mirai.v1.UpdateExportRetentionResponse
+ */
+export type UpdateExportRetentionResponse = Message<"mirai.v1.UpdateExportRetentionResponse"> & {
+  /**
+   * @generated from field: mirai.v1.TenantAISettings settings = 1;
+   */
+  settings?: TenantAISettings;
+};
+
+/**
+ * Describes the message mirai.v1.UpdateExportRetentionResponse.
+ * Use `create(UpdateExportRetentionResponseSchema)` to create a new message.
+ */
+export const UpdateExportRetentionResponseSchema: GenMessage<UpdateExportRetentionResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_tenant_settings, 32);
 
 /**
  * AIProvider represents supported AI providers.
@@ -396,6 +895,101 @@ export const TenantSettingsService: GenService<{
     input: typeof GetUsageStatsRequestSchema;
     output: typeof GetUsageStatsResponseSchema;
   },
+  /**
+   * UpdateGenerationDefaults sets the default component mix for new courses.
+   *
+   * @generated from rpc mirai.v1.TenantSettingsService.UpdateGenerationDefaults
+   */
+  updateGenerationDefaults: {
+    methodKind: "unary";
+    input: typeof UpdateGenerationDefaultsRequestSchema;
+    output: typeof UpdateGenerationDefaultsResponseSchema;
+  },
+  /**
+   * UpdateOutlineAutoApprove sets whether outlines may be approved automatically.
+   *
+   * @generated from rpc mirai.v1.TenantSettingsService.UpdateOutlineAutoApprove
+   */
+  updateOutlineAutoApprove: {
+    methodKind: "unary";
+    input: typeof UpdateOutlineAutoApproveRequestSchema;
+    output: typeof UpdateOutlineAutoApproveResponseSchema;
+  },
+  /**
+   * UpdateLocale sets the locale dates, durations and numbers are formatted in for the
+   * organization's emails and exports.
+   *
+   * @generated from rpc mirai.v1.TenantSettingsService.UpdateLocale
+   */
+  updateLocale: {
+    methodKind: "unary";
+    input: typeof UpdateLocaleRequestSchema;
+    output: typeof UpdateLocaleResponseSchema;
+  },
+  /**
+   * GetCourseDefaults returns the settings new courses start with.
+   * Unlike the other methods, any member of the organization may call it.
+   *
+   * @generated from rpc mirai.v1.TenantSettingsService.GetCourseDefaults
+   */
+  getCourseDefaults: {
+    methodKind: "unary";
+    input: typeof GetCourseDefaultsRequestSchema;
+    output: typeof GetCourseDefaultsResponseSchema;
+  },
+  /**
+   * UpdateCourseDefaults replaces the settings new courses start with.
+   *
+   * @generated from rpc mirai.v1.TenantSettingsService.UpdateCourseDefaults
+   */
+  updateCourseDefaults: {
+    methodKind: "unary";
+    input: typeof UpdateCourseDefaultsRequestSchema;
+    output: typeof UpdateCourseDefaultsResponseSchema;
+  },
+  /**
+   * UpdatePromptCache sets whether identical regeneration prompts may reuse a recent response.
+   *
+   * @generated from rpc mirai.v1.TenantSettingsService.UpdatePromptCache
+   */
+  updatePromptCache: {
+    methodKind: "unary";
+    input: typeof UpdatePromptCacheRequestSchema;
+    output: typeof UpdatePromptCacheResponseSchema;
+  },
+  /**
+   * UpdateWeeklySummary turns the weekly summary email on or off and sets when it is sent.
+   *
+   * @generated from rpc mirai.v1.TenantSettingsService.UpdateWeeklySummary
+   */
+  updateWeeklySummary: {
+    methodKind: "unary";
+    input: typeof UpdateWeeklySummaryRequestSchema;
+    output: typeof UpdateWeeklySummaryResponseSchema;
+  },
+  /**
+   * UpdateSecondReviewerPolicy sets whether outline approval requires a second reviewer.
+   * While it does, the user who generated an outline can't approve it, the first other
+   * reviewer's approval is recorded as an endorsement, and outline auto-approval is off.
+   *
+   * @generated from rpc mirai.v1.TenantSettingsService.UpdateSecondReviewerPolicy
+   */
+  updateSecondReviewerPolicy: {
+    methodKind: "unary";
+    input: typeof UpdateSecondReviewerPolicyRequestSchema;
+    output: typeof UpdateSecondReviewerPolicyResponseSchema;
+  },
+  /**
+   * UpdateExportRetention sets how many days export files are kept before they are
+   * deleted, from 1 to 365. It applies to exports made from then on.
+   *
+   * @generated from rpc mirai.v1.TenantSettingsService.UpdateExportRetention
+   */
+  updateExportRetention: {
+    methodKind: "unary";
+    input: typeof UpdateExportRetentionRequestSchema;
+    output: typeof UpdateExportRetentionResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_mirai_v1_tenant_settings, 0);
 
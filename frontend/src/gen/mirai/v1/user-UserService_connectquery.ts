@@ -31,3 +31,62 @@ export const updateUser = UserService.method.updateUser;
  * @generated from rpc mirai.v1.UserService.ListCompanyUsers
  */
 export const listCompanyUsers = UserService.method.listCompanyUsers;
+
+/**
+ * DeactivateUser blocks a user's sign-in and frees their seat. Their open SME tasks
+ * and in-flight generation jobs are reassigned; content they authored is kept.
+ *
+ * @generated from rpc mirai.v1.UserService.DeactivateUser
+ */
+export const deactivateUser = UserService.method.deactivateUser;
+
+/**
+ * ReactivateUser restores sign-in for a deactivated user if a seat is available.
+ *
+ * @generated from rpc mirai.v1.UserService.ReactivateUser
+ */
+export const reactivateUser = UserService.method.reactivateUser;
+
+/**
+ * GetOnboardingStatus returns the onboarding checklist, with each step's completion
+ * derived from the organization's data, and whether the caller has hidden it.
+ *
+ * @generated from rpc mirai.v1.UserService.GetOnboardingStatus
+ */
+export const getOnboardingStatus = UserService.method.getOnboardingStatus;
+
+/**
+ * DismissOnboardingChecklist hides the onboarding checklist for the caller for good.
+ *
+ * @generated from rpc mirai.v1.UserService.DismissOnboardingChecklist
+ */
+export const dismissOnboardingChecklist = UserService.method.dismissOnboardingChecklist;
+
+/**
+ * UpdateEmailPreferences sets which optional emails the caller receives.
+ *
+ * @generated from rpc mirai.v1.UserService.UpdateEmailPreferences
+ */
+export const updateEmailPreferences = UserService.method.updateEmailPreferences;
+
+/**
+ * CreateAPIToken creates a personal API token acting as the caller. The secret is
+ * returned once and can't be retrieved again.
+ *
+ * @generated from rpc mirai.v1.UserService.CreateAPIToken
+ */
+export const createAPIToken = UserService.method.createAPIToken;
+
+/**
+ * ListAPITokens lists the caller's API tokens, newest first.
+ *
+ * @generated from rpc mirai.v1.UserService.ListAPITokens
+ */
+export const listAPITokens = UserService.method.listAPITokens;
+
+/**
+ * RevokeAPIToken revokes one of the caller's API tokens.
+ *
+ * @generated from rpc mirai.v1.UserService.RevokeAPIToken
+ */
+export const revokeAPIToken = UserService.method.revokeAPIToken;
@@ -4,6 +4,8 @@
 
 import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
 import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
 import type { Company, Role, User } from "./common_pb";
 import { file_mirai_v1_common } from "./common_pb";
 import type { Message } from "@bufbuild/protobuf";
@@ -12,7 +14,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file mirai/v1/user.proto.
  */
 export const file_mirai_v1_user: GenFile = /*@__PURE__*/
-  fileDesc("ChNtaXJhaS92MS91c2VyLnByb3RvEghtaXJhaS52MSIOCgxHZXRNZVJlcXVlc3QiYgoNR2V0TWVSZXNwb25zZRIcCgR1c2VyGAEgASgLMg4ubWlyYWkudjEuVXNlchInCgdjb21wYW55GAIgASgLMhEubWlyYWkudjEuQ29tcGFueUgAiAEBQgoKCF9jb21wYW55IiEKDkdldFVzZXJSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkiLwoPR2V0VXNlclJlc3BvbnNlEhwKBHVzZXIYASABKAsyDi5taXJhaS52MS5Vc2VyIlAKEVVwZGF0ZVVzZXJSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSIQoEcm9sZRgCIAEoDjIOLm1pcmFpLnYxLlJvbGVIAIgBAUIHCgVfcm9sZSIyChJVcGRhdGVVc2VyUmVzcG9uc2USHAoEdXNlchgBIAEoCzIOLm1pcmFpLnYxLlVzZXIiGQoXTGlzdENvbXBhbnlVc2Vyc1JlcXVlc3QiOQoYTGlzdENvbXBhbnlVc2Vyc1Jlc3BvbnNlEh0KBXVzZXJzGAEgAygLMg4ubWlyYWkudjEuVXNlcjKrAgoLVXNlclNlcnZpY2USOAoFR2V0TWUSFi5taXJhaS52MS5HZXRNZVJlcXVlc3QaFy5taXJhaS52MS5HZXRNZVJlc3BvbnNlEj4KB0dldFVzZXISGC5taXJhaS52MS5HZXRVc2VyUmVxdWVzdBoZLm1pcmFpLnYxLkdldFVzZXJSZXNwb25zZRJHCgpVcGRhdGVVc2VyEhsubWlyYWkudjEuVXBkYXRlVXNlclJlcXVlc3QaHC5taXJhaS52MS5VcGRhdGVVc2VyUmVzcG9uc2USWQoQTGlzdENvbXBhbnlVc2VycxIhLm1pcmFpLnYxLkxpc3RDb21wYW55VXNlcnNSZXF1ZXN0GiIubWlyYWkudjEuTGlzdENvbXBhbnlVc2Vyc1Jlc3BvbnNlQo8BCgxjb20ubWlyYWkudjFCCVVzZXJQcm90b1ABWjNnaXRodWIuY29tL3NvZ29zL21pcmFpLWJhY2tlbmQvZ2VuL21pcmFpL3YxO21pcmFpdjGiAgNNWFiqAghNaXJhaS5WMcoCCE1pcmFpXFYx4gIUTWlyYWlcVjFcR1BCTWV0YWRhdGHqAglNaXJhaTo6VjFiBnByb3RvMw", [file_mirai_v1_common]);
+  fileDesc("ChNtaXJhaS92MS91c2VyLnByb3RvEghtaXJhaS52MSIOCgxHZXRNZVJlcXVlc3QiYgoNR2V0TWVSZXNwb25zZRIcCgR1c2VyGAEgASgLMg4ubWlyYWkudjEuVXNlchInCgdjb21wYW55GAIgASgLMhEubWlyYWkudjEuQ29tcGFueUgAiAEBQgoKCF9jb21wYW55IiEKDkdldFVzZXJSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkiLwoPR2V0VXNlclJlc3BvbnNlEhwKBHVzZXIYASABKAsyDi5taXJhaS52MS5Vc2VyIlAKEVVwZGF0ZVVzZXJSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSIQoEcm9sZRgCIAEoDjIOLm1pcmFpLnYxLlJvbGVIAIgBAUIHCgVfcm9sZSIyChJVcGRhdGVVc2VyUmVzcG9uc2USHAoEdXNlchgBIAEoCzIOLm1pcmFpLnYxLlVzZXIiGQoXTGlzdENvbXBhbnlVc2Vyc1JlcXVlc3QiOQoYTGlzdENvbXBhbnlVc2Vyc1Jlc3BvbnNlEh0KBXVzZXJzGAEgAygLMg4ubWlyYWkudjEuVXNlciJiChVEZWFjdGl2YXRlVXNlclJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIgChNyZWFzc2lnbl90b191c2VyX2lkGAIgASgJSACIAQFCFgoUX3JlYXNzaWduX3RvX3VzZXJfaWQijgEKFkRlYWN0aXZhdGVVc2VyUmVzcG9uc2USHAoEdXNlchgBIAEoCzIOLm1pcmFpLnYxLlVzZXISHQoVcmVhc3NpZ25lZF90b191c2VyX2lkGAIgASgJEhsKE3JlYXNzaWduZWRfdGFza19pZHMYAyADKAkSGgoScmVhc3NpZ25lZF9qb2JfaWRzGAQgAygJIigKFVJlYWN0aXZhdGVVc2VyUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJIjYKFlJlYWN0aXZhdGVVc2VyUmVzcG9uc2USHAoEdXNlchgBIAEoCzIOLm1pcmFpLnYxLlVzZXIiSAoXT25ib2FyZGluZ0NoZWNrbGlzdEl0ZW0SCwoDa2V5GAEgASgJEg0KBXRpdGxlGAIgASgJEhEKCWNvbXBsZXRlZBgDIAEoCCIcChpHZXRPbmJvYXJkaW5nU3RhdHVzUmVxdWVzdCJiChtHZXRPbmJvYXJkaW5nU3RhdHVzUmVzcG9uc2USMAoFaXRlbXMYASADKAsyIS5taXJhaS52MS5PbmJvYXJkaW5nQ2hlY2tsaXN0SXRlbRIRCglkaXNtaXNzZWQYAiABKAgiIwohRGlzbWlzc09uYm9hcmRpbmdDaGVja2xpc3RSZXF1ZXN0IiQKIkRpc21pc3NPbmJvYXJkaW5nQ2hlY2tsaXN0UmVzcG9uc2UiPwodVXBkYXRlRW1haWxQcmVmZXJlbmNlc1JlcXVlc3QSHgoWd2Vla2x5X3N1bW1hcnlfb3B0X291dBgBIAEoCCI+Ch5VcGRhdGVFbWFpbFByZWZlcmVuY2VzUmVzcG9uc2USHAoEdXNlchgBIAEoCzIOLm1pcmFpLnYxLlVzZXIi1QIKCEFQSVRva2VuEgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSFAoMdG9rZW5fcHJlZml4GAMgASgJEg4KBnNjb3BlcxgEIAMoCRIdChVyYXRlX2xpbWl0X3Blcl9taW51dGUYBSABKAUSLgoKY3JlYXRlZF9hdBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKZXhwaXJlc19hdBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASNQoMbGFzdF91c2VkX2F0GAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgAiAEBEjMKCnJldm9rZWRfYXQYCSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSAGIAQFCDwoNX2xhc3RfdXNlZF9hdEINCgtfcmV2b2tlZF9hdCJtChVDcmVhdGVBUElUb2tlblJlcXVlc3QSDAoEbmFtZRgBIAEoCRIOCgZzY29wZXMYAiADKAkSFwoPZXhwaXJlc19pbl9kYXlzGAMgASgFEh0KFXJhdGVfbGltaXRfcGVyX21pbnV0ZRgEIAEoBSJLChZDcmVhdGVBUElUb2tlblJlc3BvbnNlEiEKBXRva2VuGAEgASgLMhIubWlyYWkudjEuQVBJVG9rZW4SDgoGc2VjcmV0GAIgASgJIhYKFExpc3RBUElUb2tlbnNSZXF1ZXN0IjsKFUxpc3RBUElUb2tlbnNSZXNwb25zZRIiCgZ0b2tlbnMYASADKAsyEi5taXJhaS52MS5BUElUb2tlbiIpChVSZXZva2VBUElUb2tlblJlcXVlc3QSEAoIdG9rZW5faWQYASABKAkiOwoWUmV2b2tlQVBJVG9rZW5SZXNwb25zZRIhCgV0b2tlbhgBIAEoCzISLm1pcmFpLnYxLkFQSVRva2VuMpsICgtVc2VyU2VydmljZRI4CgVHZXRNZRIWLm1pcmFpLnYxLkdldE1lUmVxdWVzdBoXLm1pcmFpLnYxLkdldE1lUmVzcG9uc2USPgoHR2V0VXNlchIYLm1pcmFpLnYxLkdldFVzZXJSZXF1ZXN0GhkubWlyYWkudjEuR2V0VXNlclJlc3BvbnNlEkcKClVwZGF0ZVVzZXISGy5taXJhaS52MS5VcGRhdGVVc2VyUmVxdWVzdBocLm1pcmFpLnYxLlVwZGF0ZVVzZXJSZXNwb25zZRJZChBMaXN0Q29tcGFueVVzZXJzEiEubWlyYWkudjEuTGlzdENvbXBhbnlVc2Vyc1JlcXVlc3QaIi5taXJhaS52MS5MaXN0Q29tcGFueVVzZXJzUmVzcG9uc2USUwoORGVhY3RpdmF0ZVVzZXISHy5taXJhaS52MS5EZWFjdGl2YXRlVXNlclJlcXVlc3QaIC5taXJhaS52MS5EZWFjdGl2YXRlVXNlclJlc3BvbnNlElMKDlJlYWN0aXZhdGVVc2VyEh8ubWlyYWkudjEuUmVhY3RpdmF0ZVVzZXJSZXF1ZXN0GiAubWlyYWkudjEuUmVhY3RpdmF0ZVVzZXJSZXNwb25zZRJiChNHZXRPbmJvYXJkaW5nU3RhdHVzEiQubWlyYWkudjEuR2V0T25ib2FyZGluZ1N0YXR1c1JlcXVlc3QaJS5taXJhaS52MS5HZXRPbmJvYXJkaW5nU3RhdHVzUmVzcG9uc2USdwoaRGlzbWlzc09uYm9hcmRpbmdDaGVja2xpc3QSKy5taXJhaS52MS5EaXNtaXNzT25ib2FyZGluZ0NoZWNrbGlzdFJlcXVlc3QaLC5taXJhaS52MS5EaXNtaXNzT25ib2FyZGluZ0NoZWNrbGlzdFJlc3BvbnNlEmsKFlVwZGF0ZUVtYWlsUHJlZmVyZW5jZXMSJy5taXJhaS52MS5VcGRhdGVFbWFpbFByZWZlcmVuY2VzUmVxdWVzdBooLm1pcmFpLnYxLlVwZGF0ZUVtYWlsUHJlZmVyZW5jZXNSZXNwb25zZRJTCg5DcmVhdGVBUElUb2tlbhIfLm1pcmFpLnYxLkNyZWF0ZUFQSVRva2VuUmVxdWVzdBogLm1pcmFpLnYxLkNyZWF0ZUFQSVRva2VuUmVzcG9uc2USUAoNTGlzdEFQSVRva2VucxIeLm1pcmFpLnYxLkxpc3RBUElUb2tlbnNSZXF1ZXN0Gh8ubWlyYWkudjEuTGlzdEFQSVRva2Vuc1Jlc3BvbnNlElMKDlJldm9rZUFQSVRva2VuEh8ubWlyYWkudjEuUmV2b2tlQVBJVG9rZW5SZXF1ZXN0GiAubWlyYWkudjEuUmV2b2tlQVBJVG9rZW5SZXNwb25zZUKPAQoMY29tLm1pcmFpLnYxQglVc2VyUHJvdG9QAVozZ2l0aHViLmNvbS9zb2dvcy9taXJhaS1iYWNrZW5kL2dlbi9taXJhaS92MTttaXJhaXYxogIDTVhYqgIITWlyYWkuVjHKAghNaXJhaVxWMeICFE1pcmFpXFYxXEdQQk1ldGFkYXRh6gIJTWlyYWk6OlYxYgZwcm90bzM", [file_google_protobuf_timestamp, file_mirai_v1_common]);
 
 /**
  * GetMeRequest is empty as user is identified by auth context.
@@ -168,6 +170,448 @@ export type ListCompanyUsersResponse = Message<"mirai.v1.ListCompanyUsersRespons
 export const ListCompanyUsersResponseSchema: GenMessage<ListCompanyUsersResponse> = /*@__PURE__*/
   messageDesc(file_mirai_v1_user, 7);
 
+/**
+ * DeactivateUserRequest identifies the user to deactivate.
+ *
+ * @generated from message mirai.v1.DeactivateUserRequest
+ */
+export type DeactivateUserRequest = Message<"mirai.v1.DeactivateUserRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * Defaults to the requesting admin
+   *
+   * @generated from field: optional string reassign_to_user_id = 2;
+   */
+  reassignToUserId?: string;
+};
+
+/**
+ * Describes the message mirai.v1.DeactivateUserRequest.
+ * Use `create(DeactivateUserRequestSchema)` to create a new message.
+ */
+export const DeactivateUserRequestSchema: GenMessage<DeactivateUserRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_user, 8);
+
+/**
+ * DeactivateUserResponse contains the deactivated user and the work moved off them.
+ *
+ * @generated from message mirai.v1.DeactivateUserResponse
+ */
+export type DeactivateUserResponse = Message<"mirai.v1.DeactivateUserResponse"> & {
+  /**
+   * @generated from field: mirai.v1.User user = 1;
+   */
+  user?: User;
+
+  /**
+   * @generated from field: string reassigned_to_user_id = 2;
+   */
+  reassignedToUserId: string;
+
+  /**
+   * @generated from field: repeated string reassigned_task_ids = 3;
+   */
+  reassignedTaskIds: string[];
+
+  /**
+   * @generated from field: repeated string reassigned_job_ids = 4;
+   */
+  reassignedJobIds: string[];
+};
+
+/**
+ * Describes the message mirai.v1.DeactivateUserResponse.
+ * Use `create(DeactivateUserResponseSchema)` to create a new message.
+ */
+export const DeactivateUserResponseSchema: GenMessage<DeactivateUserResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_user, 9);
+
+/**
+ * ReactivateUserRequest identifies the user to reactivate.
+ *
+ * @generated from message mirai.v1.ReactivateUserRequest
+ */
+export type ReactivateUserRequest = Message<"mirai.v1.ReactivateUserRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+};
+
+/**
+ * Describes the message mirai.v1.ReactivateUserRequest.
+ * Use `create(ReactivateUserRequestSchema)` to create a new message.
+ */
+export const ReactivateUserRequestSchema: GenMessage<ReactivateUserRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_user, 10);
+
+/**
+ * ReactivateUserResponse contains the reactivated user.
+ *
+ * @generated from message mirai.v1.ReactivateUserResponse
+ */
+export type ReactivateUserResponse = Message<"mirai.v1.ReactivateUserResponse"> & {
+  /**
+   * @generated from field: mirai.v1.User user = 1;
+   */
+  user?: User;
+};
+
+/**
+ * Describes the message mirai.v1.ReactivateUserResponse.
+ * Use `create(ReactivateUserResponseSchema)` to create a new message.
+ */
+export const ReactivateUserResponseSchema: GenMessage<ReactivateUserResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_user, 11);
+
+/**
+ * OnboardingChecklistItem is one step of the onboarding checklist.
+ *
+ * @generated from message mirai.v1.OnboardingChecklistItem
+ */
+export type OnboardingChecklistItem = Message<"mirai.v1.OnboardingChecklistItem"> & {
+  /**
+   * Stable identifier, e.g. "ai_settings"
+   *
+   * @generated from field: string key = 1;
+   */
+  key: string;
+
+  /**
+   * @generated from field: string title = 2;
+   */
+  title: string;
+
+  /**
+   * @generated from field: bool completed = 3;
+   */
+  completed: boolean;
+};
+
+/**
+ * Describes the message mirai.v1.OnboardingChecklistItem.
+ * Use `create(OnboardingChecklistItemSchema)` to create a new message.
+ */
+export const OnboardingChecklistItemSchema: GenMessage<OnboardingChecklistItem> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_user, 12);
+
+/**
+ * GetOnboardingStatusRequest is empty as the user is identified by auth context.
+ *
+ * @generated from message mirai.v1.GetOnboardingStatusRequest
+ */
+export type GetOnboardingStatusRequest = Message<"mirai.v1.GetOnboardingStatusRequest"> & {
+};
+
+/**
+ * Describes the message mirai.v1.GetOnboardingStatusRequest.
+ * Use `create(GetOnboardingStatusRequestSchema)` to create a new message.
+ */
+export const GetOnboardingStatusRequestSchema: GenMessage<GetOnboardingStatusRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_user, 13);
+
+/**
+ * GetOnboardingStatusResponse contains the checklist steps in order.
+ *
+ * @generated from message mirai.v1.GetOnboardingStatusResponse
+ */
+export type GetOnboardingStatusResponse = Message<"mirai.v1.GetOnboardingStatusResponse"> & {
+  /**
+   * @generated from field: repeated mirai.v1.OnboardingChecklistItem items = 1;
+   */
+  items: OnboardingChecklistItem[];
+
+  /**
+   * @generated from field: bool dismissed = 2;
+   */
+  dismissed: boolean;
+};
+
+/**
+ * Describes the message mirai.v1.GetOnboardingStatusResponse.
+ * Use `create(GetOnboardingStatusResponseSchema)` to create a new message.
+ */
+export const GetOnboardingStatusResponseSchema: GenMessage<GetOnboardingStatusResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_user, 14);
+
+/**
+ * DismissOnboardingChecklistRequest is empty as the user is identified by auth context.
+ *
+ * @generated from message mirai.v1.DismissOnboardingChecklistRequest
+ */
+export type DismissOnboardingChecklistRequest = Message<"mirai.v1.DismissOnboardingChecklistRequest"> & {
+};
+
+/**
+ * Describes the message mirai.v1.DismissOnboardingChecklistRequest.
+ * Use `create(DismissOnboardingChecklistRequestSchema)` to create a new message.
+ */
+export const DismissOnboardingChecklistRequestSchema: GenMessage<DismissOnboardingChecklistRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_user, 15);
+
+/**
+ * DismissOnboardingChecklistResponse confirms the checklist is hidden.
+ *
+ * @generated from message mirai.v1.DismissOnboardingChecklistResponse
+ */
+export type DismissOnboardingChecklistResponse = Message<"mirai.v1.DismissOnboardingChecklistResponse"> & {
+};
+
+/**
+ * Describes the message mirai.v1.DismissOnboardingChecklistResponse.
+ * Use `create(DismissOnboardingChecklistResponseSchema)` to create a new message.
+ */
+export const DismissOnboardingChecklistResponseSchema: GenMessage<DismissOnboardingChecklistResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_user, 16);
+
+/**
+ * UpdateEmailPreferencesRequest contains the caller's optional email choices.
+ *
+ * @generated from message mirai.v1.UpdateEmailPreferencesRequest
+ */
+export type UpdateEmailPreferencesRequest = Message<"mirai.v1.UpdateEmailPreferencesRequest"> & {
+  /**
+   * Stop receiving the weekly summary sent to admins
+   *
+   * @generated from field: bool weekly_summary_opt_out = 1;
+   */
+  weeklySummaryOptOut: boolean;
+};
+
+/**
+ * Describes the message mirai.v1.UpdateEmailPreferencesRequest.
+ * Use `create(UpdateEmailPreferencesRequestSchema)` to create a new message.
+ */
+export const UpdateEmailPreferencesRequestSchema: GenMessage<UpdateEmailPreferencesRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_user, 17);
+
+/**
+ * UpdateEmailPreferencesResponse contains the updated user.
+ *
+ * @generated from message mirai.v1.UpdateEmailPreferencesResponse
+ */
+export type UpdateEmailPreferencesResponse = Message<"mirai.v1.UpdateEmailPreferencesResponse"> & {
+  /**
+   * @generated from field: mirai.v1.User user = 1;
+   */
+  user?: User;
+};
+
+/**
+ * Describes the message mirai.v1.UpdateEmailPreferencesResponse.
+ * Use `create(UpdateEmailPreferencesResponseSchema)` to create a new message.
+ */
+export const UpdateEmailPreferencesResponseSchema: GenMessage<UpdateEmailPreferencesResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_user, 18);
+
+/**
+ * APIToken is a personal API token. Requests send its secret as
+ * "Authorization: Bearer <secret>" and act as the token's user, limited to its scopes.
+ *
+ * @generated from message mirai.v1.APIToken
+ */
+export type APIToken = Message<"mirai.v1.APIToken"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * Leading characters of the secret, e.g. "mirai_Ab3xYz"
+   *
+   * @generated from field: string token_prefix = 3;
+   */
+  tokenPrefix: string;
+
+  /**
+   * "course:read", "course:write"
+   *
+   * @generated from field: repeated string scopes = 4;
+   */
+  scopes: string[];
+
+  /**
+   * @generated from field: int32 rate_limit_per_minute = 5;
+   */
+  rateLimitPerMinute: number;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 6;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp expires_at = 7;
+   */
+  expiresAt?: Timestamp;
+
+  /**
+   * Recorded at most once a minute
+   *
+   * @generated from field: optional google.protobuf.Timestamp last_used_at = 8;
+   */
+  lastUsedAt?: Timestamp;
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp revoked_at = 9;
+   */
+  revokedAt?: Timestamp;
+};
+
+/**
+ * Describes the message mirai.v1.APIToken.
+ * Use `create(APITokenSchema)` to create a new message.
+ */
+export const APITokenSchema: GenMessage<APIToken> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_user, 19);
+
+/**
+ * CreateAPITokenRequest contains the new token's settings.
+ *
+ * @generated from message mirai.v1.CreateAPITokenRequest
+ */
+export type CreateAPITokenRequest = Message<"mirai.v1.CreateAPITokenRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * At least one; course:write implies course:read
+   *
+   * @generated from field: repeated string scopes = 2;
+   */
+  scopes: string[];
+
+  /**
+   * 1 to 365; 0 for 90
+   *
+   * @generated from field: int32 expires_in_days = 3;
+   */
+  expiresInDays: number;
+
+  /**
+   * 1 to 600; 0 for 60
+   *
+   * @generated from field: int32 rate_limit_per_minute = 4;
+   */
+  rateLimitPerMinute: number;
+};
+
+/**
+ * Describes the message mirai.v1.CreateAPITokenRequest.
+ * Use `create(CreateAPITokenRequestSchema)` to create a new message.
+ */
+export const CreateAPITokenRequestSchema: GenMessage<CreateAPITokenRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_user, 20);
+
+/**
+ * CreateAPITokenResponse contains the token and its secret.
+ *
+ * @generated from message mirai.v1.CreateAPITokenResponse
+ */
+export type CreateAPITokenResponse = Message<"mirai.v1.CreateAPITokenResponse"> & {
+  /**
+   * @generated from field: mirai.v1.APIToken token = 1;
+   */
+  token?: APIToken;
+
+  /**
+   * Shown once; only a hash is stored
+   *
+   * @generated from field: string secret = 2;
+   */
+  secret: string;
+};
+
+/**
+ * Describes the message mirai.v1.CreateAPITokenResponse.
+ * Use `create(CreateAPITokenResponseSchema)` to create a new message.
+ */
+export const CreateAPITokenResponseSchema: GenMessage<CreateAPITokenResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_user, 21);
+
+/**
+ * ListAPITokensRequest is empty as the caller is identified by auth context.
+ *
+ * @generated from message mirai.v1.ListAPITokensRequest
+ */
+export type ListAPITokensRequest = Message<"mirai.v1.ListAPITokensRequest"> & {
+};
+
+/**
+ * Describes the message mirai.v1.ListAPITokensRequest.
+ * Use `create(ListAPITokensRequestSchema)` to create a new message.
+ */
+export const ListAPITokensRequestSchema: GenMessage<ListAPITokensRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_user, 22);
+
+/**
+ * ListAPITokensResponse lists the caller's tokens, revoked and expired ones included.
+ *
+ * @generated from message mirai.v1.ListAPITokensResponse
+ */
+export type ListAPITokensResponse = Message<"mirai.v1.ListAPITokensResponse"> & {
+  /**
+   * @generated from field: repeated mirai.v1.APIToken tokens = 1;
+   */
+  tokens: APIToken[];
+};
+
+/**
+ * Describes the message mirai.v1.ListAPITokensResponse.
+ * Use `create(ListAPITokensResponseSchema)` to create a new message.
+ */
+export const ListAPITokensResponseSchema: GenMessage<ListAPITokensResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_user, 23);
+
+/**
+ * RevokeAPITokenRequest names the token to revoke.
+ *
+ * @generated from message mirai.v1.RevokeAPITokenRequest
+ */
+export type RevokeAPITokenRequest = Message<"mirai.v1.RevokeAPITokenRequest"> & {
+  /**
+   * @generated from field: string token_id = 1;
+   */
+  tokenId: string;
+};
+
+/**
+ * Describes the message mirai.v1.RevokeAPITokenRequest.
+ * Use `create(RevokeAPITokenRequestSchema)` to create a new message.
+ */
+export const RevokeAPITokenRequestSchema: GenMessage<RevokeAPITokenRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_user, 24);
+
+/**
+ * RevokeAPITokenResponse contains the revoked token.
+ *
+ * @generated from message mirai.v1.RevokeAPITokenResponse
+ */
+export type RevokeAPITokenResponse = Message<"mirai.v1.RevokeAPITokenResponse"> & {
+  /**
+   * @generated from field: mirai.v1.APIToken token = 1;
+   */
+  token?: APIToken;
+};
+
+/**
+ * Describes the message mirai.v1.RevokeAPITokenResponse.
+ * Use `create(RevokeAPITokenResponseSchema)` to create a new message.
+ */
+export const RevokeAPITokenResponseSchema: GenMessage<RevokeAPITokenResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_user, 25);
+
 /**
  * UserService handles user-related operations.
  *
@@ -214,6 +658,89 @@ export const UserService: GenService<{
     input: typeof ListCompanyUsersRequestSchema;
     output: typeof ListCompanyUsersResponseSchema;
   },
+  /**
+   * DeactivateUser blocks a user's sign-in and frees their seat. Their open SME tasks
+   * and in-flight generation jobs are reassigned; content they authored is kept.
+   *
+   * @generated from rpc mirai.v1.UserService.DeactivateUser
+   */
+  deactivateUser: {
+    methodKind: "unary";
+    input: typeof DeactivateUserRequestSchema;
+    output: typeof DeactivateUserResponseSchema;
+  },
+  /**
+   * ReactivateUser restores sign-in for a deactivated user if a seat is available.
+   *
+   * @generated from rpc mirai.v1.UserService.ReactivateUser
+   */
+  reactivateUser: {
+    methodKind: "unary";
+    input: typeof ReactivateUserRequestSchema;
+    output: typeof ReactivateUserResponseSchema;
+  },
+  /**
+   * GetOnboardingStatus returns the onboarding checklist, with each step's completion
+   * derived from the organization's data, and whether the caller has hidden it.
+   *
+   * @generated from rpc mirai.v1.UserService.GetOnboardingStatus
+   */
+  getOnboardingStatus: {
+    methodKind: "unary";
+    input: typeof GetOnboardingStatusRequestSchema;
+    output: typeof GetOnboardingStatusResponseSchema;
+  },
+  /**
+   * DismissOnboardingChecklist hides the onboarding checklist for the caller for good.
+   *
+   * @generated from rpc mirai.v1.UserService.DismissOnboardingChecklist
+   */
+  dismissOnboardingChecklist: {
+    methodKind: "unary";
+    input: typeof DismissOnboardingChecklistRequestSchema;
+    output: typeof DismissOnboardingChecklistResponseSchema;
+  },
+  /**
+   * UpdateEmailPreferences sets which optional emails the caller receives.
+   *
+   * @generated from rpc mirai.v1.UserService.UpdateEmailPreferences
+   */
+  updateEmailPreferences: {
+    methodKind: "unary";
+    input: typeof UpdateEmailPreferencesRequestSchema;
+    output: typeof UpdateEmailPreferencesResponseSchema;
+  },
+  /**
+   * CreateAPIToken creates a personal API token acting as the caller. The secret is
+   * returned once and can't be retrieved again.
+   *
+   * @generated from rpc mirai.v1.UserService.CreateAPIToken
+   */
+  createAPIToken: {
+    methodKind: "unary";
+    input: typeof CreateAPITokenRequestSchema;
+    output: typeof CreateAPITokenResponseSchema;
+  },
+  /**
+   * ListAPITokens lists the caller's API tokens, newest first.
+   *
+   * @generated from rpc mirai.v1.UserService.ListAPITokens
+   */
+  listAPITokens: {
+    methodKind: "unary";
+    input: typeof ListAPITokensRequestSchema;
+    output: typeof ListAPITokensResponseSchema;
+  },
+  /**
+   * RevokeAPIToken revokes one of the caller's API tokens.
+   *
+   * @generated from rpc mirai.v1.UserService.RevokeAPIToken
+   */
+  revokeAPIToken: {
+    methodKind: "unary";
+    input: typeof RevokeAPITokenRequestSchema;
+    output: typeof RevokeAPITokenResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_mirai_v1_user, 0);
 
@@ -1,19 +1,33 @@
 import { useQuery, useMutation, createConnectQueryKey } from '@connectrpc/connect-query';
 import { useQueryClient } from '@tanstack/react-query';
+import { useState } from 'react';
 import { create } from '@bufbuild/protobuf';
 import {
   generateCourseOutline,
+  analyzeKnowledgeCoverage,
+  saveGenerationDraft,
+  getGenerationDraft,
   getCourseOutline,
   approveCourseOutline,
   rejectCourseOutline,
   updateCourseOutline,
   generateAllLessons,
+  exportAllLessons,
+  translateCourse,
+  createOutlineComment,
+  listOutlineComments,
+  resolveOutlineComment,
   regenerateComponent,
   getJob,
   listJobs,
   cancelJob,
   getGeneratedLesson,
   listGeneratedLessons,
+  getCoursePlayerView,
+  getComponentSources,
+  getComponentAssetUploadURL,
+  confirmComponentAsset,
+  suggestCourseTitles,
 } from '@/gen/mirai/v1/ai_generation-AIGenerationService_connectquery';
 import {
   listNotifications,
@@ -22,21 +36,37 @@ import {
 import {
   GenerationJobType,
   GenerationJobStatus,
+  JobFailureReason,
   OutlineApprovalStatus,
   LessonComponentType,
   type GenerationJob,
   type CourseOutline,
   type OutlineSection,
   type OutlineLesson,
+  type OutlineLessonChanges,
+  type OutlineLessonChange,
   type GeneratedLesson,
   type LessonComponent,
+  type ComponentSource,
+  type KnowledgeCoverage,
+  type CourseTitleSuggestion,
   type CourseGenerationInput,
+  type GenerationDraft,
+  type OutlineComment,
   GenerateCourseOutlineRequestSchema,
+  SaveGenerationDraftRequestSchema,
   ApproveCourseOutlineRequestSchema,
   RejectCourseOutlineRequestSchema,
   UpdateCourseOutlineRequestSchema,
   GenerateAllLessonsRequestSchema,
+  ExportAllLessonsRequestSchema,
+  TranslateCourseRequestSchema,
+  CreateOutlineCommentRequestSchema,
+  ResolveOutlineCommentRequestSchema,
   RegenerateComponentRequestSchema,
+  GetComponentAssetUploadURLRequestSchema,
+  ConfirmComponentAssetRequestSchema,
+  SuggestCourseTitlesRequestSchema,
   CancelJobRequestSchema,
   CourseGenerationInputSchema,
 } from '@/gen/mirai/v1/ai_generation_pb';
@@ -45,6 +75,7 @@ import {
 export {
   GenerationJobType,
   GenerationJobStatus,
+  JobFailureReason,
   OutlineApprovalStatus,
   LessonComponentType,
 };
@@ -53,11 +84,26 @@ export type {
   CourseOutline,
   OutlineSection,
   OutlineLesson,
+  OutlineLessonChanges,
+  OutlineLessonChange,
   GeneratedLesson,
   LessonComponent,
+  ComponentSource,
+  KnowledgeCoverage,
+  CourseTitleSuggestion,
   CourseGenerationInput,
+  GenerationDraft,
+  OutlineComment,
 };
 
+/**
+ * Describes a failed job for the user: its error followed by the suggested fix, if any.
+ */
+export function jobFailureMessage(job: GenerationJob, fallback: string): string {
+  const message = job.errorMessage || fallback;
+  return job.suggestedAction ? `${message}. ${job.suggestedAction}` : message;
+}
+
 /**
  * Helper to invalidate all job-related queries.
  * This ensures the UI updates after job mutations.
@@ -86,6 +132,8 @@ export function useGenerateCourseOutline() {
       targetAudienceIds: string[];
       desiredOutcome: string;
       additionalContext?: string;
+      // Approve the outline and generate every lesson; needs the tenant setting
+      autoApprove?: boolean;
     }) => {
       const request = create(GenerateCourseOutlineRequestSchema, {
         input: create(CourseGenerationInputSchema, {
@@ -95,10 +143,15 @@ export function useGenerateCourseOutline() {
           desiredOutcome: input.desiredOutcome,
           additionalContext: input.additionalContext,
         }),
+        autoApprove: input.autoApprove ?? false,
       });
 
       const result = await mutation.mutateAsync(request);
-      await invalidateJobQueries(queryClient);
+      await Promise.all([
+        invalidateJobQueries(queryClient),
+        // Starting generation clears the wizard draft for the course
+        queryClient.invalidateQueries({ queryKey: createConnectQueryKey({ schema: getGenerationDraft, cardinality: undefined }) }),
+      ]);
       return result;
     },
     isLoading: mutation.isPending,
@@ -106,6 +159,81 @@ export function useGenerateCourseOutline() {
   };
 }
 
+/**
+ * Hook to get the current user's generation wizard draft for a course.
+ * data is undefined when there is no draft.
+ */
+export function useGenerationDraft(courseId: string | undefined) {
+  const query = useQuery(
+    getGenerationDraft,
+    courseId ? { courseId } : undefined,
+    { enabled: !!courseId }
+  );
+
+  return {
+    data: query.data?.draft,
+    isLoading: query.isLoading,
+    isFetched: query.isFetched,
+    error: query.error,
+  };
+}
+
+/**
+ * Hook to save the generation wizard selections for a course, so reopening the wizard
+ * restores them.
+ */
+export function useSaveGenerationDraft() {
+  const mutation = useMutation(saveGenerationDraft);
+
+  return {
+    mutate: async (input: {
+      courseId: string;
+      smeIds: string[];
+      targetAudienceIds: string[];
+      desiredOutcome: string;
+      additionalContext?: string;
+    }) => {
+      const request = create(SaveGenerationDraftRequestSchema, {
+        input: create(CourseGenerationInputSchema, {
+          courseId: input.courseId,
+          smeIds: input.smeIds,
+          targetAudienceIds: input.targetAudienceIds,
+          desiredOutcome: input.desiredOutcome,
+          additionalContext: input.additionalContext,
+        }),
+      });
+      const result = await mutation.mutateAsync(request);
+      return result.draft;
+    },
+    isLoading: mutation.isPending,
+    error: mutation.error,
+  };
+}
+
+/**
+ * Hook to check whether the selected SMEs have enough material for the desired outcome.
+ * Runs once SMEs and an outcome or title are chosen; coverage.thinTopics are candidates
+ * for new SME tasks.
+ */
+export function useAnalyzeKnowledgeCoverage(input: {
+  smeIds: string[];
+  desiredOutcome: string;
+  courseTitle?: string;
+}) {
+  const enabled = input.smeIds.length > 0 && !!(input.desiredOutcome.trim() || input.courseTitle?.trim());
+  const query = useQuery(
+    analyzeKnowledgeCoverage,
+    enabled ? { smeIds: input.smeIds, desiredOutcome: input.desiredOutcome, courseTitle: input.courseTitle } : undefined,
+    { enabled }
+  );
+
+  return {
+    data: query.data?.coverage,
+    isLoading: query.isLoading,
+    error: query.error,
+  };
+}
+
 /**
  * Hook to get a course outline.
  */
@@ -218,6 +346,121 @@ export function useGenerateAllLessons() {
   };
 }
 
+/**
+ * Hook to export all generated lessons of a course as a ZIP.
+ * The download link arrives as a notification once the export job completes.
+ */
+export function useExportAllLessons() {
+  const queryClient = useQueryClient();
+  const mutation = useMutation(exportAllLessons);
+
+  return {
+    // includeCitations footnotes each component with the SME sources it came from
+    mutate: async (courseId: string, options?: { includeCitations?: boolean }) => {
+      const request = create(ExportAllLessonsRequestSchema, {
+        courseId,
+        includeCitations: options?.includeCitations ?? false,
+      });
+
+      const result = await mutation.mutateAsync(request);
+      await invalidateJobQueries(queryClient);
+      return result;
+    },
+    isLoading: mutation.isPending,
+    error: mutation.error,
+  };
+}
+
+/**
+ * Hook to translate a course into a new draft course in another language.
+ * Resolves with the translation job and the new course's ID.
+ */
+export function useTranslateCourse() {
+  const queryClient = useQueryClient();
+  const mutation = useMutation(translateCourse);
+
+  return {
+    // targetLanguage is a BCP 47 tag such as "es" or "pt-BR"
+    mutate: async (courseId: string, targetLanguage: string) => {
+      const request = create(TranslateCourseRequestSchema, { courseId, targetLanguage });
+
+      const result = await mutation.mutateAsync(request);
+      await invalidateJobQueries(queryClient);
+      return result;
+    },
+    isLoading: mutation.isPending,
+    error: mutation.error,
+  };
+}
+
+/**
+ * Hook to list reviewer comments on a course's outline lessons.
+ */
+export function useListOutlineComments(courseId: string | undefined, includeResolved = false) {
+  const query = useQuery(
+    listOutlineComments,
+    courseId ? { courseId, includeResolved } : undefined,
+    { enabled: !!courseId }
+  );
+
+  return {
+    data: query.data?.comments ?? [],
+    isLoading: query.isLoading,
+    error: query.error,
+    refetch: query.refetch,
+  };
+}
+
+/**
+ * Invalidates outline comments and the outline, whose unresolved comment count they change.
+ */
+async function invalidateOutlineCommentQueries(queryClient: ReturnType<typeof useQueryClient>) {
+  await Promise.all([
+    queryClient.invalidateQueries({ queryKey: createConnectQueryKey({ schema: listOutlineComments, cardinality: undefined }) }),
+    queryClient.invalidateQueries({ queryKey: createConnectQueryKey({ schema: getCourseOutline, cardinality: undefined }) }),
+  ]);
+}
+
+/**
+ * Hook to comment on an outline lesson.
+ */
+export function useCreateOutlineComment() {
+  const queryClient = useQueryClient();
+  const mutation = useMutation(createOutlineComment);
+
+  return {
+    mutate: async (courseId: string, lessonId: string, body: string) => {
+      const request = create(CreateOutlineCommentRequestSchema, { courseId, lessonId, body });
+
+      const result = await mutation.mutateAsync(request);
+      await invalidateOutlineCommentQueries(queryClient);
+      return result.comment;
+    },
+    isLoading: mutation.isPending,
+    error: mutation.error,
+  };
+}
+
+/**
+ * Hook to resolve an outline comment.
+ */
+export function useResolveOutlineComment() {
+  const queryClient = useQueryClient();
+  const mutation = useMutation(resolveOutlineComment);
+
+  return {
+    mutate: async (commentId: string) => {
+      const request = create(ResolveOutlineCommentRequestSchema, { commentId });
+
+      const result = await mutation.mutateAsync(request);
+      await invalidateOutlineCommentQueries(queryClient);
+      return result.comment;
+    },
+    isLoading: mutation.isPending,
+    error: mutation.error,
+  };
+}
+
 /**
  * Hook to regenerate a component.
  */
@@ -251,6 +494,77 @@ export function useRegenerateComponent() {
   };
 }
 
+/**
+ * Hook to upload the file for a placeholder component, such as the video for a scripted
+ * video block, and attach it to the component.
+ */
+export function useUploadComponentAsset() {
+  const queryClient = useQueryClient();
+  const uploadURL = useMutation(getComponentAssetUploadURL);
+  const mutation = useMutation(confirmComponentAsset);
+  const [isUploading, setIsUploading] = useState(false);
+
+  return {
+    mutate: async (data: { componentId: string; file: File }) => {
+      setIsUploading(true);
+      let filePath: string;
+      try {
+        const slot = await uploadURL.mutateAsync(
+          create(GetComponentAssetUploadURLRequestSchema, {
+            componentId: data.componentId,
+            fileName: data.file.name,
+            contentType: data.file.type,
+          })
+        );
+        const response = await fetch(slot.uploadUrl, { method: 'PUT', body: data.file });
+        if (!response.ok) {
+          throw new Error(`Upload failed with status ${response.status}`);
+        }
+        filePath = slot.filePath;
+      } finally {
+        setIsUploading(false);
+      }
+
+      const request = create(ConfirmComponentAssetRequestSchema, {
+        componentId: data.componentId,
+        filePath,
+      });
+      const result = await mutation.mutateAsync(request);
+      await queryClient.invalidateQueries({ queryKey: createConnectQueryKey({ schema: getGeneratedLesson, cardinality: undefined }) });
+      return result.component;
+    },
+    isLoading: isUploading || mutation.isPending,
+    error: uploadURL.error || mutation.error,
+  };
+}
+
+/**
+ * Hook to suggest course titles from the selected SMEs, audiences and desired outcome.
+ * Nothing is saved; apply the chosen title with the normal course update.
+ */
+export function useSuggestCourseTitles() {
+  const mutation = useMutation(suggestCourseTitles);
+
+  return {
+    mutate: async (data: {
+      smeIds: string[];
+      targetAudienceIds: string[];
+      desiredOutcome: string;
+    }) => {
+      const request = create(SuggestCourseTitlesRequestSchema, {
+        smeIds: data.smeIds,
+        targetAudienceIds: data.targetAudienceIds,
+        desiredOutcome: data.desiredOutcome,
+      });
+
+      const result = await mutation.mutateAsync(request);
+      return result.suggestions;
+    },
+    isLoading: mutation.isPending,
+    error: mutation.error,
+  };
+}
+
 /**
  * Hook to get a generation job by ID.
  * @param jobId - The job ID to fetch
@@ -327,6 +641,24 @@ export function useGetGeneratedLesson(lessonId: string | undefined) {
   };
 }
 
+/**
+ * Hook to get the SME knowledge chunks a lesson component was generated from.
+ * Components generated before sources were recorded return an empty list.
+ */
+export function useGetComponentSources(componentId: string | undefined) {
+  const query = useQuery(
+    getComponentSources,
+    componentId ? { componentId } : undefined,
+    { enabled: !!componentId }
+  );
+
+  return {
+    data: query.data?.sources ?? [],
+    isLoading: query.isLoading,
+    error: query.error,
+  };
+}
+
 /**
  * Hook to list generated lessons for a course.
  */
@@ -345,6 +677,25 @@ export function useListGeneratedLessons(courseId: string | undefined) {
   };
 }
 
+/**
+ * Hook to get a course as the learner-facing player shows it.
+ * Unpublished courses return not found unless the user can edit them.
+ */
+export function useCoursePlayerView(courseId: string | undefined) {
+  const query = useQuery(
+    getCoursePlayerView,
+    courseId ? { courseId } : undefined,
+    { enabled: !!courseId }
+  );
+
+  return {
+    data: query.data?.view,
+    isLoading: query.isLoading,
+    error: query.error,
+    refetch: query.refetch,
+  };
+}
+
 /**
  * Hook to get active generation jobs (queued or processing).
  * Uses adaptive polling: 3 seconds when jobs are active, 30 seconds idle.
@@ -0,0 +1,87 @@
+import { useQuery, useMutation } from '@connectrpc/connect-query';
+import { create } from '@bufbuild/protobuf';
+import { timestampFromDate } from '@bufbuild/protobuf/wkt';
+import {
+  listAuditEvents,
+  exportAuditEvents,
+} from '@/gen/mirai/v1/audit-AuditService_connectquery';
+import {
+  type AuditEvent,
+  type AuditChange,
+  AuditEventFilterSchema,
+  ExportAuditEventsRequestSchema,
+} from '@/gen/mirai/v1/audit_pb';
+
+// Re-export types
+export type { AuditEvent, AuditChange };
+
+export interface AuditLogFilter {
+  action?: string;
+  actorUserId?: string;
+  targetType?: string;
+  targetId?: string;
+  since?: Date;
+  until?: Date;
+}
+
+function toFilter(filter?: AuditLogFilter) {
+  return create(AuditEventFilterSchema, {
+    action: filter?.action,
+    actorUserId: filter?.actorUserId,
+    targetType: filter?.targetType,
+    targetId: filter?.targetId,
+    since: filter?.since ? timestampFromDate(filter.since) : undefined,
+    until: filter?.until ? timestampFromDate(filter.until) : undefined,
+  });
+}
+
+/**
+ * Hook to list the tenant's audit log, most recent first.
+ * Only available to ADMIN/OWNER roles.
+ */
+export function useListAuditEvents(options?: {
+  filter?: AuditLogFilter;
+  limit?: number;
+  cursor?: string;
+}) {
+  const query = useQuery(listAuditEvents, {
+    filter: toFilter(options?.filter),
+    limit: options?.limit ?? 50,
+    cursor: options?.cursor,
+  });
+
+  return {
+    data: query.data?.events ?? [],
+    nextCursor: query.data?.nextCursor,
+    isLoading: query.isLoading,
+    error: query.error,
+    refetch: query.refetch,
+  };
+}
+
+/**
+ * Hook to export the audit log as CSV and download it.
+ * Only available to ADMIN/OWNER roles.
+ */
+export function useExportAuditEvents() {
+  const mutation = useMutation(exportAuditEvents);
+
+  return {
+    mutate: async (filter?: AuditLogFilter) => {
+      const request = create(ExportAuditEventsRequestSchema, { filter: toFilter(filter) });
+      const result = await mutation.mutateAsync(request);
+
+      const blob = new Blob([new Uint8Array(result.content)], { type: 'text/csv' });
+      const url = URL.createObjectURL(blob);
+      const link = document.createElement('a');
+      link.href = url;
+      link.download = result.filename;
+      link.click();
+      URL.revokeObjectURL(url);
+
+      return result;
+    },
+    isLoading: mutation.isPending,
+    error: mutation.error,
+  };
+}
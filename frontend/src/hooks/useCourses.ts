@@ -8,6 +8,9 @@ import {
   deleteCourse,
   getFolderHierarchy,
   getLibrary,
+  listCourseCards,
+  getCourseCardDetails,
+  heartbeatCoursePresence,
   createFolder,
   deleteFolder,
 } from '@/gen/mirai/v1/course-CourseService_connectquery';
@@ -18,6 +21,9 @@ import {
   type LibraryEntry,
   type Folder,
   type Library,
+  type CourseCard,
+  type CourseCardDetails,
+  type CoursePresence,
   CreateCourseRequestSchema,
   UpdateCourseRequestSchema,
   DeleteCourseRequestSchema,
@@ -36,7 +42,7 @@ import { create } from '@bufbuild/protobuf';
 
 // Re-export types for convenience
 export { CourseStatus, FolderType };
-export type { Course, LibraryEntry, Folder, Library };
+export type { Course, LibraryEntry, Folder, Library, CourseCard, CourseCardDetails };
 
 /**
  * Hook to list courses with optional filters and pagination.
@@ -68,22 +74,52 @@ export function useListCourses(options?: {
 
 /**
  * Hook to get a single course by ID.
+ * While a full course generation holds the course read-only, polls until the lock is released.
  */
 export function useGetCourse(courseId: string | undefined) {
   const query = useQuery(
     getCourse,
     courseId ? { id: courseId } : undefined,
-    { enabled: !!courseId }
+    {
+      enabled: !!courseId,
+      refetchInterval: (data) => (data.state.data?.generationLock ? 10000 : false),
+    }
   );
 
   return {
     data: query.data?.course,
+    generationLock: query.data?.generationLock,
     isLoading: query.isLoading,
     error: query.error,
     refetch: query.refetch,
   };
 }
 
+/** How often an open course tells the server the user is still there. */
+const COURSE_PRESENCE_HEARTBEAT_MS = 20000;
+
+/**
+ * Hook to mark the user as having a course open, viewing or editing, and to see who else does.
+ * Sends a heartbeat every 20 seconds while mounted; presence lapses soon after it stops.
+ * Returns nobody else when presence is unavailable.
+ */
+export function useCoursePresence(courseId: string | undefined, editing: boolean) {
+  const query = useQuery(
+    heartbeatCoursePresence,
+    courseId ? { courseId, editing } : undefined,
+    {
+      enabled: !!courseId,
+      refetchInterval: COURSE_PRESENCE_HEARTBEAT_MS,
+      retry: false,
+    }
+  );
+
+  return {
+    others: (query.data?.others ?? []) as CoursePresence[],
+    isLoading: query.isLoading,
+  };
+}
+
 /**
  * Hook to create a new course.
  */
@@ -222,6 +258,7 @@ export function useCreateCourse() {
         queryClient.invalidateQueries({ queryKey: createConnectQueryKey({ schema: listCourses, cardinality: undefined }) }),
         queryClient.invalidateQueries({ queryKey: createConnectQueryKey({ schema: getFolderHierarchy, cardinality: undefined }) }),
         queryClient.invalidateQueries({ queryKey: createConnectQueryKey({ schema: getLibrary, cardinality: undefined }) }),
+        queryClient.invalidateQueries({ queryKey: createConnectQueryKey({ schema: listCourseCards, cardinality: undefined }) }),
       ]);
       return result;
     },
@@ -373,6 +410,7 @@ export function useUpdateCourse() {
         queryClient.invalidateQueries({ queryKey: createConnectQueryKey({ schema: getCourse, cardinality: undefined }) }),
         queryClient.invalidateQueries({ queryKey: createConnectQueryKey({ schema: getFolderHierarchy, cardinality: undefined }) }),
         queryClient.invalidateQueries({ queryKey: createConnectQueryKey({ schema: getLibrary, cardinality: undefined }) }),
+        queryClient.invalidateQueries({ queryKey: createConnectQueryKey({ schema: listCourseCards, cardinality: undefined }) }),
       ]);
       return result;
     },
@@ -397,6 +435,7 @@ export function useDeleteCourse() {
         queryClient.invalidateQueries({ queryKey: createConnectQueryKey({ schema: listCourses, cardinality: undefined }) }),
         queryClient.invalidateQueries({ queryKey: createConnectQueryKey({ schema: getFolderHierarchy, cardinality: undefined }) }),
         queryClient.invalidateQueries({ queryKey: createConnectQueryKey({ schema: getLibrary, cardinality: undefined }) }),
+        queryClient.invalidateQueries({ queryKey: createConnectQueryKey({ schema: listCourseCards, cardinality: undefined }) }),
       ]);
       return result;
     },
@@ -423,6 +462,8 @@ export function useGetFolderHierarchy(includeCourseCounts: boolean = true) {
 
 /**
  * Hook to get the full library (courses + folders).
+ * Kept for compatibility; new library views should use useListCourseCards and
+ * useCourseCardDetails, which load progressively.
  */
 export function useGetLibrary(includeCourseCounts: boolean = true) {
   const query = useQuery(getLibrary, {
@@ -437,6 +478,50 @@ export function useGetLibrary(includeCourseCounts: boolean = true) {
   };
 }
 
+/**
+ * Hook to list a page of lightweight course cards, most recently updated first.
+ */
+export function useListCourseCards(options?: {
+  status?: CourseStatus;
+  folder?: string;
+  limit?: number;
+  cursor?: string;
+}) {
+  const query = useQuery(listCourseCards, {
+    status: options?.status,
+    folder: options?.folder,
+    limit: options?.limit ?? 48,
+    cursor: options?.cursor,
+  });
+
+  return {
+    data: query.data?.cards ?? [],
+    nextCursor: query.data?.nextCursor,
+    isLoading: query.isLoading,
+    error: query.error,
+    refetch: query.refetch,
+  };
+}
+
+/**
+ * Hook to load details (tags, thumbnail, creator, duration) for the course cards
+ * currently on screen. At most 50 IDs per call.
+ */
+export function useCourseCardDetails(courseIds: string[]) {
+  const query = useQuery(
+    getCourseCardDetails,
+    { courseIds },
+    { enabled: courseIds.length > 0 }
+  );
+
+  return {
+    data: query.data?.details ?? [],
+    isLoading: query.isLoading,
+    error: query.error,
+    refetch: query.refetch,
+  };
+}
+
 /**
  * Hook to create a new folder.
  */
@@ -461,6 +546,7 @@ export function useCreateFolder() {
       await Promise.all([
         queryClient.invalidateQueries({ queryKey: createConnectQueryKey({ schema: getFolderHierarchy, cardinality: undefined }) }),
         queryClient.invalidateQueries({ queryKey: createConnectQueryKey({ schema: getLibrary, cardinality: undefined }) }),
+        queryClient.invalidateQueries({ queryKey: createConnectQueryKey({ schema: listCourseCards, cardinality: undefined }) }),
       ]);
       return result;
     },
@@ -484,6 +570,7 @@ export function useDeleteFolder() {
       await Promise.all([
         queryClient.invalidateQueries({ queryKey: createConnectQueryKey({ schema: getFolderHierarchy, cardinality: undefined }) }),
         queryClient.invalidateQueries({ queryKey: createConnectQueryKey({ schema: getLibrary, cardinality: undefined }) }),
+        queryClient.invalidateQueries({ queryKey: createConnectQueryKey({ schema: listCourseCards, cardinality: undefined }) }),
       ]);
       return result;
     },
@@ -1,10 +1,17 @@
-import { useQuery } from '@connectrpc/connect-query';
-import { getMe } from '@/gen/mirai/v1/user-UserService_connectquery';
+import { useQuery, useMutation, createConnectQueryKey } from '@connectrpc/connect-query';
+import { useQueryClient } from '@tanstack/react-query';
+import {
+  getMe,
+  getOnboardingStatus,
+  dismissOnboardingChecklist,
+  updateEmailPreferences,
+} from '@/gen/mirai/v1/user-UserService_connectquery';
 import { Role, type User, type Company } from '@/gen/mirai/v1/common_pb';
+import type { OnboardingChecklistItem } from '@/gen/mirai/v1/user_pb';
 
 // Re-export types for convenience
 export { Role };
-export type { User, Company };
+export type { User, Company, OnboardingChecklistItem };
 
 /**
  * Hook to get the current user from the backend via GetMe API.
@@ -22,6 +29,63 @@ export function useCurrentUser() {
   };
 }
 
+/**
+ * Hook to get the onboarding checklist. Step completion is derived server-side
+ * and may lag a few minutes behind the organization's data.
+ */
+export function useOnboardingStatus() {
+  const query = useQuery(getOnboardingStatus, {});
+
+  return {
+    items: query.data?.items ?? [],
+    dismissed: query.data?.dismissed ?? false,
+    isLoading: query.isLoading,
+    error: query.error,
+    refetch: query.refetch,
+  };
+}
+
+/**
+ * Hook to hide the onboarding checklist for the current user for good.
+ */
+export function useDismissOnboardingChecklist() {
+  const queryClient = useQueryClient();
+  const mutation = useMutation(dismissOnboardingChecklist);
+
+  return {
+    mutate: async () => {
+      const result = await mutation.mutateAsync({});
+      await queryClient.invalidateQueries({
+        queryKey: createConnectQueryKey({ schema: getOnboardingStatus, cardinality: undefined }),
+      });
+      return result;
+    },
+    isLoading: mutation.isPending,
+    error: mutation.error,
+  };
+}
+
+/**
+ * Hook to choose which optional emails the current user receives.
+ * Only the weekly summary sent to admins can be turned off.
+ */
+export function useUpdateEmailPreferences() {
+  const queryClient = useQueryClient();
+  const mutation = useMutation(updateEmailPreferences);
+
+  return {
+    mutate: async (weeklySummaryOptOut: boolean) => {
+      const result = await mutation.mutateAsync({ weeklySummaryOptOut });
+      await queryClient.invalidateQueries({
+        queryKey: createConnectQueryKey({ schema: getMe, cardinality: undefined }),
+      });
+      return result;
+    },
+    isLoading: mutation.isPending,
+    error: mutation.error,
+  };
+}
+
 /**
  * Check if a user has admin privileges (ADMIN or OWNER role).
  * OWNER is deprecated but still supported for backwards compatibility.
@@ -0,0 +1,176 @@
+import { useQuery, useMutation, createConnectQueryKey } from '@connectrpc/connect-query';
+import { useQueryClient } from '@tanstack/react-query';
+import { create } from '@bufbuild/protobuf';
+import {
+  createConnector,
+  listConnectors,
+  updateConnector,
+  deleteConnector,
+  getFolderSync,
+  setFolderSync,
+  getSyncStatus,
+} from '@/gen/mirai/v1/lms_sync-LMSSyncService_connectquery';
+import {
+  LMSConnectorType,
+  CourseSyncStatus,
+  type LMSConnector,
+  type CourseSyncDelivery,
+  CreateConnectorRequestSchema,
+  UpdateConnectorRequestSchema,
+  DeleteConnectorRequestSchema,
+  SetFolderSyncRequestSchema,
+} from '@/gen/mirai/v1/lms_sync_pb';
+
+// Re-export types and enums
+export { LMSConnectorType, CourseSyncStatus };
+export type { LMSConnector, CourseSyncDelivery };
+
+/**
+ * Hook to list the organization's LMS connectors (admin only).
+ */
+export function useListLMSConnectors() {
+  const query = useQuery(listConnectors, {});
+
+  return {
+    data: query.data?.connectors ?? [],
+    isLoading: query.isLoading,
+    error: query.error,
+    refetch: query.refetch,
+  };
+}
+
+/**
+ * Hook to create an LMS connector.
+ */
+export function useCreateLMSConnector() {
+  const queryClient = useQueryClient();
+  const mutation = useMutation(createConnector);
+
+  return {
+    mutate: async (data: {
+      name: string;
+      type: LMSConnectorType;
+      endpointUrl: string;
+      credential?: string;
+      enabled: boolean;
+    }) => {
+      const request = create(CreateConnectorRequestSchema, data);
+      const result = await mutation.mutateAsync(request);
+      await queryClient.invalidateQueries({
+        queryKey: createConnectQueryKey({ schema: listConnectors, cardinality: undefined }),
+      });
+      return result;
+    },
+    isLoading: mutation.isPending,
+    error: mutation.error,
+  };
+}
+
+/**
+ * Hook to update an LMS connector. Leave credential undefined to keep the stored one;
+ * pass an empty string to remove it.
+ */
+export function useUpdateLMSConnector() {
+  const queryClient = useQueryClient();
+  const mutation = useMutation(updateConnector);
+
+  return {
+    mutate: async (
+      connectorId: string,
+      data: {
+        name: string;
+        type: LMSConnectorType;
+        endpointUrl: string;
+        credential?: string;
+        enabled: boolean;
+      }
+    ) => {
+      const request = create(UpdateConnectorRequestSchema, { connectorId, ...data });
+      const result = await mutation.mutateAsync(request);
+      await queryClient.invalidateQueries({
+        queryKey: createConnectQueryKey({ schema: listConnectors, cardinality: undefined }),
+      });
+      return result;
+    },
+    isLoading: mutation.isPending,
+    error: mutation.error,
+  };
+}
+
+/**
+ * Hook to delete an LMS connector. Folders using it stop syncing.
+ */
+export function useDeleteLMSConnector() {
+  const queryClient = useQueryClient();
+  const mutation = useMutation(deleteConnector);
+
+  return {
+    mutate: async (connectorId: string) => {
+      const request = create(DeleteConnectorRequestSchema, { connectorId });
+      const result = await mutation.mutateAsync(request);
+      await Promise.all([
+        queryClient.invalidateQueries({ queryKey: createConnectQueryKey({ schema: listConnectors, cardinality: undefined }) }),
+        queryClient.invalidateQueries({ queryKey: createConnectQueryKey({ schema: getFolderSync, cardinality: undefined }) }),
+      ]);
+      return result;
+    },
+    isLoading: mutation.isPending,
+    error: mutation.error,
+  };
+}
+
+/**
+ * Hook to get the connector a folder's published courses sync to.
+ */
+export function useFolderSync(folderId: string | undefined) {
+  const query = useQuery(
+    getFolderSync,
+    folderId ? { folderId } : undefined,
+    { enabled: !!folderId }
+  );
+
+  return {
+    connectorId: query.data?.connectorId,
+    isLoading: query.isLoading,
+    error: query.error,
+  };
+}
+
+/**
+ * Hook to set or clear (connectorId undefined) the connector a folder syncs to.
+ */
+export function useSetFolderSync() {
+  const queryClient = useQueryClient();
+  const mutation = useMutation(setFolderSync);
+
+  return {
+    mutate: async (folderId: string, connectorId?: string) => {
+      const request = create(SetFolderSyncRequestSchema, { folderId, connectorId });
+      const result = await mutation.mutateAsync(request);
+      await queryClient.invalidateQueries({
+        queryKey: createConnectQueryKey({ schema: getFolderSync, cardinality: undefined }),
+      });
+      return result;
+    },
+    isLoading: mutation.isPending,
+    error: mutation.error,
+  };
+}
+
+/**
+ * Hook to get a course's latest delivery to each LMS connector.
+ */
+export function useCourseSyncStatus(courseId: string | undefined) {
+  const query = useQuery(
+    getSyncStatus,
+    courseId ? { courseId } : undefined,
+    { enabled: !!courseId }
+  );
+
+  return {
+    data: query.data?.deliveries ?? [],
+    isLoading: query.isLoading,
+    error: query.error,
+    refetch: query.refetch,
+  };
+}
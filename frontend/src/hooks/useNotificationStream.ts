@@ -7,11 +7,18 @@ import {
   NotificationService,
   NotificationEventType,
   SubscribeNotificationsRequestSchema,
+  type SubscribeNotificationsResponse,
 } from '@/gen/mirai/v1/notification_pb';
 import {
   listNotifications,
   getUnreadCount,
 } from '@/gen/mirai/v1/notification-NotificationService_connectquery';
+import {
+  getSubmissionStatus,
+  listSubmissions,
+  listTasks,
+} from '@/gen/mirai/v1/sme-SMEService_connectquery';
+import { GetSubmissionStatusResponseSchema, SubmissionProcessingStatus } from '@/gen/mirai/v1/sme_pb';
 import { create } from '@bufbuild/protobuf';
 
 /**
@@ -26,7 +33,8 @@ export function useNotificationStream() {
   const isConnectedRef = useRef(false);
 
   const handleEvent = useCallback(
-    (eventType: NotificationEventType | string) => {
+    (event: SubscribeNotificationsResponse) => {
+      const eventType: NotificationEventType | string = event.eventType;
       // Normalize event type - protojson may send as string or number
       const normalizedType = typeof eventType === 'string'
         ? NotificationEventType[eventType.replace('NOTIFICATION_EVENT_TYPE_', '') as keyof typeof NotificationEventType]
@@ -52,6 +60,33 @@ export function useNotificationStream() {
             queryKey: createConnectQueryKey({ schema: getUnreadCount, cardinality: undefined }),
           });
           break;
+        case NotificationEventType.SUBMISSION_PROGRESS: {
+          const progress = event.submissionProgress;
+          if (!progress) break;
+          // Show the pushed progress without refetching the status
+          queryClient.setQueriesData(
+            {
+              queryKey: createConnectQueryKey({
+                schema: getSubmissionStatus,
+                input: { submissionId: progress.submissionId },
+                cardinality: undefined,
+              }),
+            },
+            create(GetSubmissionStatusResponseSchema, { progress })
+          );
+          if (
+            progress.status === SubmissionProcessingStatus.COMPLETED ||
+            progress.status === SubmissionProcessingStatus.FAILED
+          ) {
+            queryClient.invalidateQueries({
+              queryKey: createConnectQueryKey({ schema: listSubmissions, cardinality: undefined }),
+            });
+            queryClient.invalidateQueries({
+              queryKey: createConnectQueryKey({ schema: listTasks, cardinality: undefined }),
+            });
+          }
+          break;
+        }
       }
     },
     [queryClient]
@@ -81,7 +116,7 @@ export function useNotificationStream() {
       for await (const event of client.subscribeNotifications(request, {
         signal: abortControllerRef.current.signal,
       })) {
-        handleEvent(event.eventType);
+        handleEvent(event);
       }
 
       // Stream ended normally (server closed it)
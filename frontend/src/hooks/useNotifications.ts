@@ -7,19 +7,22 @@ import {
   markAsRead,
   markAllAsRead,
   deleteNotification,
+  listEmailLog,
 } from '@/gen/mirai/v1/notification-NotificationService_connectquery';
 // Note: Real-time updates are handled by useNotificationStream hook via Connect-RPC server streaming
 import {
   NotificationType,
+  EmailDeliveryStatus,
   type Notification,
+  type EmailLogEntry,
   MarkAsReadRequestSchema,
   MarkAllAsReadRequestSchema,
   DeleteNotificationRequestSchema,
 } from '@/gen/mirai/v1/notification_pb';
 
 // Re-export types and enums
-export { NotificationType };
-export type { Notification };
+export { NotificationType, EmailDeliveryStatus };
+export type { Notification, EmailLogEntry };
 
 /**
  * Hook to list notifications with optional filters.
@@ -141,3 +144,29 @@ export function useDeleteNotification() {
     error: mutation.error,
   };
 }
+
+/**
+ * Hook to list the outcomes of emails sent for the tenant, most recent first.
+ * Only available to ADMIN/OWNER roles.
+ */
+export function useListEmailLog(options?: {
+  recipient?: string;
+  template?: string;
+  limit?: number;
+  cursor?: string;
+}) {
+  const query = useQuery(listEmailLog, {
+    recipient: options?.recipient,
+    template: options?.template,
+    limit: options?.limit ?? 50,
+    cursor: options?.cursor,
+  });
+
+  return {
+    data: query.data?.entries ?? [],
+    nextCursor: query.data?.nextCursor,
+    isLoading: query.isLoading,
+    error: query.error,
+    refetch: query.refetch,
+  };
+}
@@ -1,5 +1,6 @@
 import { useQuery, useMutation, createConnectQueryKey } from '@connectrpc/connect-query';
 import { useQueryClient } from '@tanstack/react-query';
+import { useState } from 'react';
 import { create } from '@bufbuild/protobuf';
 import {
   createSME,
@@ -7,6 +8,7 @@ import {
   listSMEs,
   updateSME,
   deleteSME,
+  getSMEDeletionImpact,
   restoreSME,
   createTask,
   getTask,
@@ -15,15 +17,27 @@ import {
   cancelTask,
   deleteTask,
   getUploadURL,
+  startMultipartUpload,
+  getPartUploadURLs,
+  completeMultipartUpload,
   submitContent,
+  generateTaskQuestions,
+  submitAnswers,
   listSubmissions,
   getSubmission,
+  getSubmissionStatus,
   approveSubmission,
   requestSubmissionChanges,
   enhanceSubmissionContent,
   getKnowledge,
+  listKnowledgeTopics,
+  suggestSMEs,
   updateKnowledgeChunk,
   deleteKnowledgeChunk,
+  reviewFlaggedKnowledgeChunk,
+  exportSMEKnowledge,
+  getKnowledgeImportUploadURL,
+  importSMEKnowledge,
 } from '@/gen/mirai/v1/sme-SMEService_connectquery';
 import {
   SMEScope,
@@ -35,9 +49,13 @@ import {
   type SMETask,
   type SMETaskSubmission,
   type SMEKnowledgeChunk,
+  type KnowledgeTopic,
+  type SMESuggestion,
+  type GetSMEDeletionImpactResponse,
   CreateSMERequestSchema,
   UpdateSMERequestSchema,
   DeleteSMERequestSchema,
+  GetSMEDeletionImpactRequestSchema,
   RestoreSMERequestSchema,
   ListSMEsRequestSchema,
   CreateTaskRequestSchema,
@@ -45,18 +63,27 @@ import {
   CancelTaskRequestSchema,
   DeleteTaskRequestSchema,
   GetUploadURLRequestSchema,
+  StartMultipartUploadRequestSchema,
+  GetPartUploadURLsRequestSchema,
+  CompleteMultipartUploadRequestSchema,
   SubmitContentRequestSchema,
+  GenerateTaskQuestionsRequestSchema,
+  SubmitAnswersRequestSchema,
   GetSubmissionRequestSchema,
   ApproveSubmissionRequestSchema,
   RequestSubmissionChangesRequestSchema,
   EnhanceSubmissionContentRequestSchema,
   UpdateKnowledgeChunkRequestSchema,
   DeleteKnowledgeChunkRequestSchema,
+  ReviewFlaggedKnowledgeChunkRequestSchema,
+  ExportSMEKnowledgeRequestSchema,
+  GetKnowledgeImportUploadURLRequestSchema,
+  ImportSMEKnowledgeRequestSchema,
 } from '@/gen/mirai/v1/sme_pb';
 
 // Re-export types and enums
 export { SMEScope, SMEStatus, SMETaskStatus, ContentType, EnhanceType };
-export type { SubjectMatterExpert, SMETask, SMETaskSubmission, SMEKnowledgeChunk };
+export type { SubjectMatterExpert, SMETask, SMETaskSubmission, SMEKnowledgeChunk, KnowledgeTopic, SMESuggestion, GetSMEDeletionImpactResponse };
 
 /**
  * Hook to list all SMEs accessible to the current user.
@@ -75,6 +102,25 @@ export function useListSMEs(options?: { includeArchived?: boolean }) {
   };
 }
 
+/**
+ * Hook to suggest SMEs for a course goal, most relevant first, with the terms that
+ * matched. Returns nothing until a title or desired outcome is given.
+ */
+export function useSuggestSMEs(courseTitle: string, desiredOutcome: string) {
+  const hasGoal = courseTitle.trim().length > 0 || desiredOutcome.trim().length > 0;
+  const query = useQuery(
+    suggestSMEs,
+    hasGoal ? { courseTitle, desiredOutcome } : undefined,
+    { enabled: hasGoal, staleTime: 60_000 }
+  );
+
+  return {
+    data: query.data?.suggestions ?? [],
+    isLoading: query.isLoading,
+    error: query.error,
+  };
+}
+
 /**
  * Hook to get a single SME by ID.
  */
@@ -167,15 +213,32 @@ export function useUpdateSME() {
 }
 
 /**
- * Hook to delete (archive) an SME.
+ * Hook to fetch what depends on an SME before deleting it. The response carries the
+ * confirmation token useDeleteSME requires, valid for 10 minutes.
+ */
+export function useGetSMEDeletionImpact() {
+  const mutation = useMutation(getSMEDeletionImpact);
+
+  return {
+    mutate: async (smeId: string) => {
+      const request = create(GetSMEDeletionImpactRequestSchema, { smeId });
+      return mutation.mutateAsync(request);
+    },
+    isLoading: mutation.isPending,
+    error: mutation.error,
+  };
+}
+
+/**
+ * Hook to delete (archive) an SME, confirmed by a token from useGetSMEDeletionImpact.
  */
 export function useDeleteSME() {
   const queryClient = useQueryClient();
   const mutation = useMutation(deleteSME);
 
   return {
-    mutate: async (smeId: string) => {
-      const request = create(DeleteSMERequestSchema, { smeId });
+    mutate: async (smeId: string, confirmationToken: string) => {
+      const request = create(DeleteSMERequestSchema, { smeId, confirmationToken });
       const result = await mutation.mutateAsync(request);
       // Use type-safe cache invalidation
       await queryClient.invalidateQueries({
@@ -265,6 +328,7 @@ export function useCreateTask() {
       assignedToUserId: string;
       teamId?: string;
       dueDate?: Date;
+      questions?: string[];
     }) => {
       const request = create(CreateTaskRequestSchema, {
         smeId: data.smeId,
@@ -274,6 +338,7 @@ export function useCreateTask() {
         assignedToUserId: data.assignedToUserId,
         teamId: data.teamId,
         dueDate: data.dueDate ? { seconds: BigInt(Math.floor(data.dueDate.getTime() / 1000)), nanos: 0 } : undefined,
+        questions: data.questions,
       });
 
       const result = await mutation.mutateAsync(request);
@@ -336,6 +401,178 @@ export function useGetUploadURL() {
   };
 }
 
+// Files above this size are uploaded in resumable parts (matches the backend's
+// MultipartUploadThreshold).
+const MULTIPART_THRESHOLD_BYTES = 100 * 1024 * 1024;
+// Part URLs requested per GetPartUploadURLs call (backend maximum is 100).
+const PART_URL_BATCH = 20;
+const PART_UPLOAD_CONCURRENCY = 4;
+const PART_UPLOAD_ATTEMPTS = 3;
+
+interface StoredMultipartUpload {
+  uploadId: string;
+  filePath: string;
+  partSize: number;
+  partCount: number;
+}
+
+// Key for remembering an in-progress upload, so retrying the same file resumes it.
+function multipartUploadKey(taskId: string, file: File): string {
+  return `mirai:multipart:${taskId}:${file.name}:${file.size}:${file.lastModified}`;
+}
+
+async function putWithRetry(url: string, body: Blob): Promise<void> {
+  let lastError: unknown;
+  for (let attempt = 1; attempt <= PART_UPLOAD_ATTEMPTS; attempt++) {
+    try {
+      const response = await fetch(url, { method: 'PUT', body });
+      if (response.ok) return;
+      lastError = new Error(`Upload failed with status ${response.status}`);
+    } catch (err) {
+      lastError = err;
+    }
+  }
+  throw lastError;
+}
+
+/**
+ * Hook to upload a submission file. Small files use a single presigned PUT; files
+ * over MULTIPART_THRESHOLD_BYTES are uploaded in parts, and an interrupted upload of
+ * the same file resumes from the parts already stored.
+ *
+ * Returns the file path to pass to useSubmitContent.
+ */
+export function useUploadSubmissionFile() {
+  const uploadURL = useMutation(getUploadURL);
+  const start = useMutation(startMultipartUpload);
+  const partURLs = useMutation(getPartUploadURLs);
+  const complete = useMutation(completeMultipartUpload);
+  const [isUploading, setIsUploading] = useState(false);
+
+  const uploadSingle = async (taskId: string, file: File, contentType: ContentType) => {
+    const { uploadUrl, filePath } = await uploadURL.mutateAsync(
+      create(GetUploadURLRequestSchema, {
+        taskId,
+        fileName: file.name,
+        contentType,
+        fileSizeBytes: BigInt(file.size),
+      })
+    );
+    await putWithRetry(uploadUrl, file);
+    return filePath;
+  };
+
+  const uploadMultipart = async (
+    taskId: string,
+    file: File,
+    onProgress?: (fraction: number) => void
+  ) => {
+    const key = multipartUploadKey(taskId, file);
+    const fileSizeBytes = BigInt(file.size);
+
+    let upload: StoredMultipartUpload | null = null;
+    let uploaded = new Set<number>();
+    const saved = localStorage.getItem(key);
+    if (saved) {
+      try {
+        // Resume the saved upload unless it was completed, aborted or cleaned up
+        const stored = JSON.parse(saved) as StoredMultipartUpload;
+        const probe = await partURLs.mutateAsync(
+          create(GetPartUploadURLsRequestSchema, {
+            taskId,
+            filePath: stored.filePath,
+            uploadId: stored.uploadId,
+            fileSizeBytes,
+            partNumbers: [],
+          })
+        );
+        upload = stored;
+        uploaded = new Set(probe.uploadedPartNumbers);
+      } catch {
+        localStorage.removeItem(key);
+      }
+    }
+    if (!upload) {
+      const started = await start.mutateAsync(
+        create(StartMultipartUploadRequestSchema, { taskId, fileName: file.name, fileSizeBytes })
+      );
+      upload = {
+        uploadId: started.uploadId,
+        filePath: started.filePath,
+        partSize: Number(started.partSizeBytes),
+        partCount: started.partCount,
+      };
+      localStorage.setItem(key, JSON.stringify(upload));
+    }
+    const { uploadId, filePath, partSize, partCount } = upload;
+
+    const pending: number[] = [];
+    for (let n = 1; n <= partCount; n++) {
+      if (!uploaded.has(n)) pending.push(n);
+    }
+    let done = partCount - pending.length;
+    onProgress?.(done / partCount);
+
+    for (let i = 0; i < pending.length; i += PART_URL_BATCH) {
+      const batch = pending.slice(i, i + PART_URL_BATCH);
+      const { parts } = await partURLs.mutateAsync(
+        create(GetPartUploadURLsRequestSchema, {
+          taskId,
+          filePath,
+          uploadId,
+          fileSizeBytes,
+          partNumbers: batch,
+        })
+      );
+
+      const queue = [...parts];
+      const workers = Array.from({ length: PART_UPLOAD_CONCURRENCY }, async () => {
+        for (let part = queue.shift(); part; part = queue.shift()) {
+          const offset = (part.partNumber - 1) * partSize;
+          await putWithRetry(part.uploadUrl, file.slice(offset, offset + partSize));
+          done++;
+          onProgress?.(done / partCount);
+        }
+      });
+      await Promise.all(workers);
+    }
+
+    const result = await complete.mutateAsync(
+      create(CompleteMultipartUploadRequestSchema, {
+        taskId,
+        filePath,
+        uploadId,
+        fileSizeBytes,
+      })
+    );
+    localStorage.removeItem(key);
+    return result.filePath;
+  };
+
+  return {
+    upload: async (data: {
+      taskId: string;
+      file: File;
+      contentType: ContentType;
+      onProgress?: (fraction: number) => void;
+    }) => {
+      setIsUploading(true);
+      try {
+        if (data.file.size > MULTIPART_THRESHOLD_BYTES) {
+          return await uploadMultipart(data.taskId, data.file, data.onProgress);
+        }
+        const filePath = await uploadSingle(data.taskId, data.file, data.contentType);
+        data.onProgress?.(1);
+        return filePath;
+      } finally {
+        setIsUploading(false);
+      }
+    },
+    isUploading,
+    error: uploadURL.error ?? start.error ?? partURLs.error ?? complete.error,
+  };
+}
+
 /**
  * Hook to submit content for a task.
  */
@@ -374,6 +611,54 @@ export function useSubmitContent() {
   };
 }
 
+/**
+ * Hook to draft interview questions for an SME with AI.
+ * Nothing is saved; pass the edited questions to useCreateTask.
+ */
+export function useGenerateTaskQuestions() {
+  const mutation = useMutation(generateTaskQuestions);
+
+  return {
+    mutate: async (data: { smeId: string; desiredOutcome: string }) => {
+      const request = create(GenerateTaskQuestionsRequestSchema, {
+        smeId: data.smeId,
+        desiredOutcome: data.desiredOutcome,
+      });
+
+      const result = await mutation.mutateAsync(request);
+      return result.questions;
+    },
+    isLoading: mutation.isPending,
+    error: mutation.error,
+  };
+}
+
+/**
+ * Hook to submit answers to an interview task's questions.
+ */
+export function useSubmitAnswers() {
+  const queryClient = useQueryClient();
+  const mutation = useMutation(submitAnswers);
+
+  return {
+    mutate: async (data: { taskId: string; answers: string[] }) => {
+      const request = create(SubmitAnswersRequestSchema, {
+        taskId: data.taskId,
+        answers: data.answers,
+      });
+
+      const result = await mutation.mutateAsync(request);
+      await Promise.all([
+        queryClient.invalidateQueries({ queryKey: createConnectQueryKey({ schema: listTasks, cardinality: undefined }) }),
+        queryClient.invalidateQueries({ queryKey: createConnectQueryKey({ schema: listSubmissions, cardinality: undefined }) }),
+      ]);
+      return result;
+    },
+    isLoading: mutation.isPending,
+    error: mutation.error,
+  };
+}
+
 /**
  * Hook to list submissions for a task.
  */
@@ -393,12 +678,31 @@ export function useListSubmissions(taskId: string | undefined) {
 }
 
 /**
- * Hook to get knowledge chunks for an SME.
+ * Hook to get how far a submission's ingestion has got. Progress pushed on the
+ * notification stream updates this query as each stage starts.
+ */
+export function useSubmissionStatus(submissionId: string | undefined) {
+  const query = useQuery(
+    getSubmissionStatus,
+    submissionId ? { submissionId } : undefined,
+    { enabled: !!submissionId }
+  );
+
+  return {
+    data: query.data?.progress,
+    isLoading: query.isLoading,
+    error: query.error,
+    refetch: query.refetch,
+  };
+}
+
+/**
+ * Hook to get knowledge chunks for an SME, optionally only those under one topic.
  */
-export function useGetKnowledge(smeId: string | undefined) {
+export function useGetKnowledge(smeId: string | undefined, topic?: string) {
   const query = useQuery(
     getKnowledge,
-    smeId ? { smeId } : undefined,
+    smeId ? { smeId, topic } : undefined,
     { enabled: !!smeId }
   );
 
@@ -410,6 +714,24 @@ export function useGetKnowledge(smeId: string | undefined) {
   };
 }
 
+/**
+ * Hook to list an SME's knowledge topics with chunk counts, largest first.
+ */
+export function useListKnowledgeTopics(smeId: string | undefined) {
+  const query = useQuery(
+    listKnowledgeTopics,
+    smeId ? { smeId } : undefined,
+    { enabled: !!smeId }
+  );
+
+  return {
+    data: query.data?.topics ?? [],
+    isLoading: query.isLoading,
+    error: query.error,
+    refetch: query.refetch,
+  };
+}
+
 /**
  * Hook to update a task.
  */
@@ -509,6 +831,7 @@ export function useApproveSubmission() {
         queryClient.invalidateQueries({ queryKey: createConnectQueryKey({ schema: listSubmissions, cardinality: undefined }) }),
         queryClient.invalidateQueries({ queryKey: createConnectQueryKey({ schema: getSubmission, cardinality: undefined }) }),
         queryClient.invalidateQueries({ queryKey: createConnectQueryKey({ schema: getKnowledge, cardinality: undefined }) }),
+        queryClient.invalidateQueries({ queryKey: createConnectQueryKey({ schema: listKnowledgeTopics, cardinality: undefined }) }),
       ]);
       return result;
     },
@@ -588,9 +911,10 @@ export function useUpdateKnowledgeChunk() {
       });
 
       const result = await mutation.mutateAsync(request);
-      await queryClient.invalidateQueries({
-        queryKey: createConnectQueryKey({ schema: getKnowledge, cardinality: undefined }),
-      });
+      await Promise.all([
+        queryClient.invalidateQueries({ queryKey: createConnectQueryKey({ schema: getKnowledge, cardinality: undefined }) }),
+        queryClient.invalidateQueries({ queryKey: createConnectQueryKey({ schema: listKnowledgeTopics, cardinality: undefined }) }),
+      ]);
       return result;
     },
     isLoading: mutation.isPending,
@@ -609,12 +933,89 @@ export function useDeleteKnowledgeChunk() {
     mutate: async (chunkId: string) => {
       const request = create(DeleteKnowledgeChunkRequestSchema, { chunkId });
       const result = await mutation.mutateAsync(request);
-      await queryClient.invalidateQueries({
-        queryKey: createConnectQueryKey({ schema: getKnowledge, cardinality: undefined }),
-      });
+      await Promise.all([
+        queryClient.invalidateQueries({ queryKey: createConnectQueryKey({ schema: getKnowledge, cardinality: undefined }) }),
+        queryClient.invalidateQueries({ queryKey: createConnectQueryKey({ schema: listKnowledgeTopics, cardinality: undefined }) }),
+      ]);
+      return result;
+    },
+    isLoading: mutation.isPending,
+    error: mutation.error,
+  };
+}
+
+/**
+ * Hook for admins to release a chunk flagged as possible prompt injection into
+ * generation, or to hold it back again.
+ */
+export function useReviewFlaggedKnowledgeChunk() {
+  const queryClient = useQueryClient();
+  const mutation = useMutation(reviewFlaggedKnowledgeChunk);
+
+  return {
+    mutate: async (data: { chunkId: string; release: boolean }) => {
+      const request = create(ReviewFlaggedKnowledgeChunkRequestSchema, data);
+      const result = await mutation.mutateAsync(request);
+      await queryClient.invalidateQueries({ queryKey: createConnectQueryKey({ schema: getKnowledge, cardinality: undefined }) });
       return result;
     },
     isLoading: mutation.isPending,
     error: mutation.error,
   };
 }
+
+/**
+ * Hook to export an SME's knowledge to a downloadable archive. Returns the export
+ * job's ID; the download link arrives as a notification when the job finishes.
+ */
+export function useExportSMEKnowledge() {
+  const mutation = useMutation(exportSMEKnowledge);
+
+  return {
+    mutate: async (smeId: string) => {
+      const request = create(ExportSMEKnowledgeRequestSchema, { smeId });
+      const result = await mutation.mutateAsync(request);
+      return result.jobId;
+    },
+    isLoading: mutation.isPending,
+    error: mutation.error,
+  };
+}
+
+/**
+ * Hook to upload a knowledge archive and queue its import. Without a target SME the
+ * import creates a new one from the archive's profile.
+ */
+export function useImportSMEKnowledge() {
+  const queryClient = useQueryClient();
+  const uploadURL = useMutation(getKnowledgeImportUploadURL);
+  const mutation = useMutation(importSMEKnowledge);
+  const [isUploading, setIsUploading] = useState(false);
+
+  return {
+    mutate: async (data: { file: File; targetSmeId?: string }) => {
+      setIsUploading(true);
+      let filePath: string;
+      try {
+        const slot = await uploadURL.mutateAsync(create(GetKnowledgeImportUploadURLRequestSchema, {}));
+        const response = await fetch(slot.uploadUrl, { method: 'PUT', body: data.file });
+        if (!response.ok) {
+          throw new Error(`Upload failed with status ${response.status}`);
+        }
+        filePath = slot.filePath;
+      } finally {
+        setIsUploading(false);
+      }
+
+      const request = create(ImportSMEKnowledgeRequestSchema, {
+        filePath,
+        targetSmeId: data.targetSmeId,
+      });
+      const result = await mutation.mutateAsync(request);
+      await queryClient.invalidateQueries({ queryKey: createConnectQueryKey({ schema: listSMEs, cardinality: undefined }) });
+      return result;
+    },
+    isLoading: isUploading || mutation.isPending,
+    error: uploadURL.error || mutation.error,
+  };
+}
@@ -9,6 +9,7 @@ import {
   deleteTemplate,
   archiveTemplate,
   restoreTemplate,
+  generateTargetAudience,
 } from '@/gen/mirai/v1/target_audience-TargetAudienceService_connectquery';
 import {
   ExperienceLevel,
@@ -20,6 +21,7 @@ import {
   ArchiveTemplateRequestSchema,
   RestoreTemplateRequestSchema,
   ListTemplatesRequestSchema,
+  GenerateTargetAudienceRequestSchema,
 } from '@/gen/mirai/v1/target_audience_pb';
 
 // Re-export types and enums
@@ -222,3 +224,21 @@ export function useRestoreTargetAudience() {
     error: mutation.error,
   };
 }
+
+/**
+ * Hook to draft a target audience from a job description with AI.
+ * The draft is not saved; pass the edited fields to useCreateTargetAudience.
+ */
+export function useGenerateTargetAudience() {
+  const mutation = useMutation(generateTargetAudience);
+
+  return {
+    mutate: async (jobDescription: string) => {
+      const request = create(GenerateTargetAudienceRequestSchema, { jobDescription });
+      const result = await mutation.mutateAsync(request);
+      return result.draft;
+    },
+    isLoading: mutation.isPending,
+    error: mutation.error,
+  };
+}
@@ -7,6 +7,14 @@ import {
   removeAPIKey,
   testAPIKey,
   getUsageStats,
+  updateOutlineAutoApprove,
+  updatePromptCache,
+  updateSecondReviewerPolicy,
+  updateWeeklySummary,
+  updateLocale,
+  updateExportRetention,
+  getCourseDefaults,
+  updateCourseDefaults,
 } from '@/gen/mirai/v1/tenant_settings-TenantSettingsService_connectquery';
 import {
   AIProvider,
@@ -16,11 +24,21 @@ import {
   SetAPIKeyRequestSchema,
   RemoveAPIKeyRequestSchema,
   TestAPIKeyRequestSchema,
+  UpdateOutlineAutoApproveRequestSchema,
+  UpdatePromptCacheRequestSchema,
+  UpdateSecondReviewerPolicyRequestSchema,
+  UpdateWeeklySummaryRequestSchema,
+  WeeklySummaryScheduleSchema,
+  UpdateLocaleRequestSchema,
+  UpdateExportRetentionRequestSchema,
+  UpdateCourseDefaultsRequestSchema,
+  type CourseDefaults,
+  type WeeklySummarySchedule,
 } from '@/gen/mirai/v1/tenant_settings_pb';
 
 // Re-export types and enums
 export { AIProvider };
-export type { TenantAISettings, GetUsageStatsResponse, UsageByType };
+export type { TenantAISettings, GetUsageStatsResponse, UsageByType, CourseDefaults, WeeklySummarySchedule };
 
 // Alias for convenience
 export type AIUsageStats = GetUsageStatsResponse;
@@ -113,6 +131,187 @@ export function useTestAPIKey() {
   };
 }
 
+/**
+ * Hook to allow or disallow automatic outline approval.
+ * Only available to ADMIN/OWNER roles.
+ */
+export function useUpdateOutlineAutoApprove() {
+  const queryClient = useQueryClient();
+  const mutation = useMutation(updateOutlineAutoApprove);
+
+  return {
+    mutate: async (allowed: boolean) => {
+      const request = create(UpdateOutlineAutoApproveRequestSchema, { allowed });
+      const result = await mutation.mutateAsync(request);
+      await queryClient.invalidateQueries({
+        queryKey: createConnectQueryKey({ schema: getAISettings, cardinality: undefined }),
+      });
+      return result;
+    },
+    isLoading: mutation.isPending,
+    error: mutation.error,
+  };
+}
+
+/**
+ * Hook to allow or stop reusing recent responses to identical regeneration prompts.
+ * Only available to ADMIN/OWNER roles.
+ */
+export function useUpdatePromptCache() {
+  const queryClient = useQueryClient();
+  const mutation = useMutation(updatePromptCache);
+
+  return {
+    mutate: async (disabled: boolean) => {
+      const request = create(UpdatePromptCacheRequestSchema, { disabled });
+      const result = await mutation.mutateAsync(request);
+      await queryClient.invalidateQueries({
+        queryKey: createConnectQueryKey({ schema: getAISettings, cardinality: undefined }),
+      });
+      return result;
+    },
+    isLoading: mutation.isPending,
+    error: mutation.error,
+  };
+}
+
+/**
+ * Hook to require or stop requiring a second reviewer for outline approval.
+ * Only available to ADMIN/OWNER roles.
+ */
+export function useUpdateSecondReviewerPolicy() {
+  const queryClient = useQueryClient();
+  const mutation = useMutation(updateSecondReviewerPolicy);
+
+  return {
+    mutate: async (required: boolean) => {
+      const request = create(UpdateSecondReviewerPolicyRequestSchema, { required });
+      const result = await mutation.mutateAsync(request);
+      await queryClient.invalidateQueries({
+        queryKey: createConnectQueryKey({ schema: getAISettings, cardinality: undefined }),
+      });
+      return result;
+    },
+    isLoading: mutation.isPending,
+    error: mutation.error,
+  };
+}
+
+/**
+ * Hook to turn the weekly summary email to admins on or off and set when it is sent.
+ * The day is 0 (Sunday) to 6 (Saturday) and the hour is in UTC.
+ * Only available to ADMIN/OWNER roles.
+ */
+export function useUpdateWeeklySummary() {
+  const queryClient = useQueryClient();
+  const mutation = useMutation(updateWeeklySummary);
+
+  return {
+    mutate: async (schedule: { enabled: boolean; day: number; hour: number }) => {
+      const request = create(UpdateWeeklySummaryRequestSchema, {
+        schedule: create(WeeklySummaryScheduleSchema, schedule),
+      });
+      const result = await mutation.mutateAsync(request);
+      await queryClient.invalidateQueries({
+        queryKey: createConnectQueryKey({ schema: getAISettings, cardinality: undefined }),
+      });
+      return result;
+    },
+    isLoading: mutation.isPending,
+    error: mutation.error,
+  };
+}
+
+/**
+ * Hook to set the locale used for dates, durations and numbers in emails and exports.
+ * An empty locale falls back to each user's own locale.
+ * Only available to ADMIN/OWNER roles.
+ */
+export function useUpdateLocale() {
+  const queryClient = useQueryClient();
+  const mutation = useMutation(updateLocale);
+
+  return {
+    mutate: async (locale: string) => {
+      const request = create(UpdateLocaleRequestSchema, { locale });
+      const result = await mutation.mutateAsync(request);
+      await queryClient.invalidateQueries({
+        queryKey: createConnectQueryKey({ schema: getAISettings, cardinality: undefined }),
+      });
+      return result;
+    },
+    isLoading: mutation.isPending,
+    error: mutation.error,
+  };
+}
+
+/**
+ * Hook to set how many days export files are kept before they are deleted (1-365).
+ * Only available to ADMIN/OWNER roles.
+ */
+export function useUpdateExportRetention() {
+  const queryClient = useQueryClient();
+  const mutation = useMutation(updateExportRetention);
+
+  return {
+    mutate: async (days: number) => {
+      const request = create(UpdateExportRetentionRequestSchema, { days });
+      const result = await mutation.mutateAsync(request);
+      await queryClient.invalidateQueries({
+        queryKey: createConnectQueryKey({ schema: getAISettings, cardinality: undefined }),
+      });
+      return result;
+    },
+    isLoading: mutation.isPending,
+    error: mutation.error,
+  };
+}
+
+/**
+ * Hook to get the settings new courses start with.
+ * Available to every member, so course forms can show which values are org defaults.
+ */
+export function useGetCourseDefaults() {
+  const query = useQuery(getCourseDefaults, {});
+
+  return {
+    data: query.data?.defaults,
+    isLoading: query.isLoading,
+    error: query.error,
+    refetch: query.refetch,
+  };
+}
+
+/**
+ * Hook to replace the settings new courses start with.
+ * Only available to ADMIN/OWNER roles.
+ */
+export function useUpdateCourseDefaults() {
+  const queryClient = useQueryClient();
+  const mutation = useMutation(updateCourseDefaults);
+
+  return {
+    mutate: async (defaults: {
+      destinationFolderId?: string;
+      categoryTags?: string[];
+      dataSource?: string;
+      assessmentSettings?: {
+        enableEmbeddedKnowledgeChecks: boolean;
+        enableFinalExam: boolean;
+      };
+    }) => {
+      const request = create(UpdateCourseDefaultsRequestSchema, { defaults });
+      const result = await mutation.mutateAsync(request);
+      await queryClient.invalidateQueries({
+        queryKey: createConnectQueryKey({ schema: getCourseDefaults, cardinality: undefined }),
+      });
+      return result;
+    },
+    isLoading: mutation.isPending,
+    error: mutation.error,
+  };
+}
+
 /**
  * Hook to get AI usage statistics.
  * Only available to ADMIN/OWNER roles.
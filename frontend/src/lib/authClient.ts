@@ -5,6 +5,7 @@ import { Plan } from '@/gen/mirai/v1/common_pb';
 import { create } from '@bufbuild/protobuf';
 import {
   CheckEmailRequestSchema,
+  ListStorageRegionsRequestSchema,
   RegisterRequestSchema,
   EnterpriseContactRequestSchema,
 } from '@/gen/mirai/v1/auth_pb';
@@ -19,6 +20,12 @@ export async function checkEmail(email: string): Promise<{ exists: boolean }> {
   return { exists: response.exists };
 }
 
+// List the storage regions a new organization can keep its data in, default first
+export async function listStorageRegions(): Promise<{ regions: string[]; defaultRegion: string }> {
+  const response = await authClient.listStorageRegions(create(ListStorageRegionsRequestSchema, {}));
+  return { regions: response.regions, defaultRegion: response.defaultRegion };
+}
+
 // Register a new user
 // For paid plans, this returns a checkout_url to redirect to Stripe.
 // The account is created after payment confirmation via webhook.
@@ -30,6 +37,7 @@ export async function register(data: {
   companyName: string;
   industry?: string;
   teamSize?: string;
+  storageRegion?: string;
   plan: Plan;
   seatCount: number;
 }): Promise<{
@@ -46,6 +54,7 @@ export async function register(data: {
     companyName: data.companyName,
     industry: data.industry,
     teamSize: data.teamSize,
+    storageRegion: data.storageRegion,
     plan: data.plan,
     seatCount: data.seatCount,
   });
@@ -24,6 +24,7 @@ import type {
   BlockType,
 } from '@/schemas/course.schema';
 import { BlockType as ProtoBlockType } from '@/gen/mirai/v1/course_pb';
+import { parseQuizContent, quizContentProblem, serializeQuizContent } from '@/lib/quizContent';
 
 // Content type interfaces matching ComponentRenderer expectations
 interface TextContent {
@@ -42,14 +43,12 @@ interface ImageContent {
   caption?: string;
 }
 
-interface QuizContent {
-  question: string;
-  questionType: string;
-  options: Array<{ id: string; text: string }>;
-  correctAnswerId: string;
-  explanation: string;
-  correctFeedback?: string;
-  incorrectFeedback?: string;
+interface VideoContent {
+  title: string;
+  script: string;
+  suggested_duration_seconds?: number;
+  thumbnail_description?: string;
+  asset_file_name?: string;
 }
 
 /**
@@ -132,13 +131,37 @@ export function lessonComponentToCourseBlock(
       };
     }
 
+    case LessonComponentType.VIDEO: {
+      const content = parseContentJson<VideoContent>(component.contentJson, {
+        title: '',
+        script: '',
+      });
+      const seconds = content.suggested_duration_seconds ?? 0;
+      const duration = seconds > 0 ? ` (${Math.floor(seconds / 60)}:${String(seconds % 60).padStart(2, '0')})` : '';
+      const status = content.asset_file_name
+        ? `Video attached: ${content.asset_file_name}`
+        : `Video placeholder${content.thumbnail_description ? ` - ${content.thumbnail_description}` : ''}`;
+      // Show the script where the video will appear until the editor has a video block
+      const placeholderHtml = `<figure class="my-4 rounded-lg border border-dashed border-gray-300 p-4">
+        <p class="font-semibold">${escapeHtml(content.title || 'Untitled video')}${duration}</p>
+        <p class="text-sm text-gray-500">${escapeHtml(status)}</p>
+        <blockquote class="mt-2 whitespace-pre-line text-gray-700">${escapeHtml(content.script)}</blockquote>
+      </figure>`;
+      return {
+        ...baseBlock,
+        type: 'text' as BlockType,
+        content: placeholderHtml,
+      };
+    }
+
     case LessonComponentType.QUIZ: {
-      // Keep quiz content as JSON string for knowledgeCheck block type
-      // The content is already in the expected QuizContent format
+      // Keep quiz content as JSON string for knowledgeCheck block type,
+      // upgraded to the current quiz schema when it was stored in an older one
+      const quiz = parseQuizContent(component.contentJson);
       return {
         ...baseBlock,
         type: 'knowledgeCheck' as BlockType,
-        content: component.contentJson,
+        content: quiz ? serializeQuizContent(quiz) : component.contentJson,
       };
     }
 
@@ -257,18 +280,8 @@ function escapeHtml(str: string): string {
  * Validate that quiz content JSON is properly formatted
  */
 export function validateQuizContent(contentJson: string): boolean {
-  try {
-    const content = JSON.parse(contentJson) as QuizContent;
-    return !!(
-      content.question &&
-      content.options &&
-      Array.isArray(content.options) &&
-      content.options.length >= 2 &&
-      content.correctAnswerId
-    );
-  } catch {
-    return false;
-  }
+  const quiz = parseQuizContent(contentJson);
+  return !!quiz && quizContentProblem(quiz) === null;
 }
 
 /**
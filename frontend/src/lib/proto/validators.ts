@@ -53,6 +53,7 @@ export const orgStepSchema = z.object({
     .max(200, 'Company name must be less than 200 characters'),
   industry: z.string().optional(),
   teamSize: z.string().optional(),
+  storageRegion: z.string().optional(),
 });
 export type OrgStepData = z.infer<typeof orgStepSchema>;
 
@@ -97,6 +98,7 @@ export const registrationSchema = z.object({
   companyName: z.string().min(1).max(200),
   industry: z.string().optional(),
   teamSize: z.string().optional(),
+  storageRegion: z.string().optional(),
   firstName: z.string().min(1),
   lastName: z.string().min(1),
   password: z.string().min(8),
@@ -0,0 +1,356 @@
+/**
+ * Unit Tests for Quiz Content Utilities
+ *
+ * Quiz components have been stored in several formats. These tests pin how each one
+ * is read, so a change to the parser can't silently lose a stored quiz's answer.
+ */
+
+import { describe, it, expect } from 'vitest';
+import {
+  QUIZ_SCHEMA_VERSION,
+  parseQuizContent,
+  serializeQuizContent,
+  isQuizAnswerCorrect,
+  orderQuizOptions,
+  quizContentProblem,
+  type QuizContent,
+} from './quizContent';
+
+/** A valid current-schema quiz to vary in tests. */
+function quiz(overrides: Partial<QuizContent> = {}): QuizContent {
+  return {
+    schemaVersion: QUIZ_SCHEMA_VERSION,
+    question: 'Where should raw meat be stored?',
+    questionType: 'single',
+    options: [
+      { id: 'a', text: 'Above cooked food', correct: false },
+      { id: 'b', text: 'Below cooked food', correct: true },
+      { id: 'c', text: 'Next to cooked food', correct: false },
+    ],
+    points: 1,
+    shuffle: false,
+    explanation: 'Raw meat can drip onto food below it.',
+    ...overrides,
+  };
+}
+
+// =============================================================================
+// Parsing - one block per stored format
+// =============================================================================
+
+describe('parseQuizContent', () => {
+  describe('v1 snake_case (generated)', () => {
+    const stored = {
+      question: 'What temperature kills most bacteria?',
+      options: [
+        { id: 'opt1', text: '50°C' },
+        { id: 'opt2', text: '75°C' },
+        { id: 'opt3', text: '100°C' },
+      ],
+      correct_answer_id: 'opt2',
+      explanation: 'Cook food to at least 75°C.',
+      correct_feedback: 'Right!',
+      incorrect_feedback: 'Not quite.',
+    };
+
+    it('marks the option named by correct_answer_id as the only correct one', () => {
+      const parsed = parseQuizContent(stored);
+      expect(parsed?.options).toEqual([
+        { id: 'opt1', text: '50°C', correct: false },
+        { id: 'opt2', text: '75°C', correct: true },
+        { id: 'opt3', text: '100°C', correct: false },
+      ]);
+    });
+
+    it('upgrades to the current schema with v1 defaults', () => {
+      const parsed = parseQuizContent(stored);
+      expect(parsed).toMatchObject({
+        schemaVersion: QUIZ_SCHEMA_VERSION,
+        question: 'What temperature kills most bacteria?',
+        questionType: 'single',
+        points: 1,
+        shuffle: false,
+        explanation: 'Cook food to at least 75°C.',
+        correctFeedback: 'Right!',
+        incorrectFeedback: 'Not quite.',
+      });
+    });
+
+    it('reads the same from a JSON string', () => {
+      expect(parseQuizContent(JSON.stringify(stored))).toEqual(parseQuizContent(stored));
+    });
+
+    it('keeps a true/false question with two options', () => {
+      const parsed = parseQuizContent({
+        question: 'Gloves replace hand washing.',
+        question_type: 'true_false',
+        options: [
+          { id: 't', text: 'True' },
+          { id: 'f', text: 'False' },
+        ],
+        correct_answer_id: 'f',
+      });
+      expect(parsed?.questionType).toBe('true_false');
+      expect(parsed?.options.map((o) => o.correct)).toEqual([false, true]);
+    });
+
+    it('reads a true/false question with more than two options as single answer', () => {
+      const parsed = parseQuizContent({
+        question: 'Pick one',
+        question_type: 'true_false',
+        options: [
+          { id: 'a', text: 'True' },
+          { id: 'b', text: 'False' },
+          { id: 'c', text: 'Maybe' },
+        ],
+        correct_answer_id: 'a',
+      });
+      expect(parsed?.questionType).toBe('single');
+    });
+
+    it('leaves every option incorrect when the answer ID matches none', () => {
+      const parsed = parseQuizContent({ ...stored, correct_answer_id: 'missing' });
+      expect(parsed?.options.every((o) => !o.correct)).toBe(true);
+      expect(quizContentProblem(parsed!)).toBe('Mark exactly one correct option');
+    });
+  });
+
+  describe('v1 camelCase (saved from the editor)', () => {
+    const stored = {
+      question: 'Which cloth is for raw meat?',
+      questionType: 'single',
+      options: [
+        { id: 'red', text: 'Red' },
+        { id: 'blue', text: 'Blue' },
+      ],
+      correctAnswerId: 'red',
+      explanation: 'Red is for raw meat.',
+    };
+
+    it('marks the option named by correctAnswerId as correct', () => {
+      const parsed = parseQuizContent(stored);
+      expect(parsed?.options).toEqual([
+        { id: 'red', text: 'Red', correct: true },
+        { id: 'blue', text: 'Blue', correct: false },
+      ]);
+      expect(parsed?.schemaVersion).toBe(QUIZ_SCHEMA_VERSION);
+    });
+
+    it('reads questionType like question_type', () => {
+      const parsed = parseQuizContent({ ...stored, questionType: 'true_false' });
+      expect(parsed?.questionType).toBe('true_false');
+    });
+
+    it('prefers correct_answer_id when both spellings are present', () => {
+      const parsed = parseQuizContent({ ...stored, correct_answer_id: 'blue' });
+      expect(parsed?.options.find((o) => o.correct)?.id).toBe('blue');
+    });
+  });
+
+  describe('v1 index format (knowledge check blocks)', () => {
+    const stored = {
+      question: 'How long should you wash your hands?',
+      options: ['5 seconds', '20 seconds', '1 minute'],
+      correctAnswer: 1,
+    };
+
+    it('gives plain string options letter IDs and marks the indexed one correct', () => {
+      const parsed = parseQuizContent(stored);
+      expect(parsed?.options).toEqual([
+        { id: 'a', text: '5 seconds', correct: false },
+        { id: 'b', text: '20 seconds', correct: true },
+        { id: 'c', text: '1 minute', correct: false },
+      ]);
+    });
+
+    it('treats index 0 as an answer', () => {
+      const parsed = parseQuizContent({ ...stored, correctAnswer: 0 });
+      expect(parsed?.options[0].correct).toBe(true);
+    });
+
+    it('ignores an index outside the options', () => {
+      for (const correctAnswer of [3, -1, 1.5]) {
+        const parsed = parseQuizContent({ ...stored, correctAnswer });
+        expect(parsed?.options.every((o) => !o.correct)).toBe(true);
+      }
+    });
+
+    it('prefers an answer ID over the index', () => {
+      const parsed = parseQuizContent({ ...stored, correct_answer_id: 'c' });
+      expect(parsed?.options.find((o) => o.correct)?.id).toBe('c');
+    });
+  });
+
+  describe('v2 (current)', () => {
+    const stored = {
+      schema_version: 2,
+      question: 'Which of these must be reported?',
+      question_type: 'multi',
+      options: [
+        { id: 'a', text: 'Vomiting', correct: true },
+        { id: 'b', text: 'Diarrhoea', correct: true },
+        { id: 'c', text: 'A cold', correct: false },
+      ],
+      points: 3,
+      shuffle: true,
+      explanation: 'Report stomach illness before your shift.',
+      correct_feedback: 'Well done',
+    };
+
+    it('reads every field', () => {
+      expect(parseQuizContent(stored)).toEqual({
+        schemaVersion: 2,
+        question: 'Which of these must be reported?',
+        questionType: 'multi',
+        options: [
+          { id: 'a', text: 'Vomiting', correct: true },
+          { id: 'b', text: 'Diarrhoea', correct: true },
+          { id: 'c', text: 'A cold', correct: false },
+        ],
+        points: 3,
+        shuffle: true,
+        explanation: 'Report stomach illness before your shift.',
+        correctFeedback: 'Well done',
+        incorrectFeedback: undefined,
+      });
+    });
+
+    it('ignores v1 answer fields', () => {
+      const parsed = parseQuizContent({ ...stored, correct_answer_id: 'c', correctAnswer: 2 });
+      expect(parsed?.options.map((o) => o.correct)).toEqual([true, true, false]);
+    });
+
+    it('falls back to defaults for missing or invalid fields', () => {
+      const parsed = parseQuizContent({
+        schema_version: 2,
+        question_type: 'essay',
+        options: [{ text: 'Yes', correct: 'true' }, null],
+        points: 0,
+      });
+      expect(parsed).toMatchObject({
+        question: '',
+        questionType: 'single',
+        options: [
+          { id: 'a', text: 'Yes', correct: false },
+          { id: 'b', text: '', correct: false },
+        ],
+        points: 1,
+        shuffle: false,
+        explanation: '',
+      });
+    });
+
+    it('round-trips through serializeQuizContent', () => {
+      const parsed = parseQuizContent(stored)!;
+      expect(parseQuizContent(serializeQuizContent(parsed))).toEqual(parsed);
+      expect(JSON.parse(serializeQuizContent(parsed))).toMatchObject({ schema_version: 2, question_type: 'multi' });
+    });
+
+    it('upgrades every v1 format to content that serializes as v2', () => {
+      const legacy = [
+        { question: 'Q', options: [{ id: 'x', text: 'X' }, { id: 'y', text: 'Y' }], correct_answer_id: 'y' },
+        { question: 'Q', options: [{ id: 'x', text: 'X' }, { id: 'y', text: 'Y' }], correctAnswerId: 'y' },
+        { question: 'Q', options: ['X', 'Y'], correctAnswer: 1 },
+      ];
+      for (const stored of legacy) {
+        const upgraded = parseQuizContent(serializeQuizContent(parseQuizContent(stored)!));
+        expect(upgraded?.schemaVersion).toBe(2);
+        expect(upgraded?.options.filter((o) => o.correct).map((o) => o.text)).toEqual(['Y']);
+        expect(quizContentProblem(upgraded!)).toBeNull();
+      }
+    });
+  });
+
+  describe('unreadable content', () => {
+    it.each([
+      ['invalid JSON', '{"question": '],
+      ['a JSON array', '[1, 2]'],
+      ['a JSON string', '"quiz"'],
+      ['null', null],
+      ['undefined', undefined],
+      ['an empty string', ''],
+    ])('returns null for %s', (_, content) => {
+      expect(parseQuizContent(content as string | null | undefined)).toBeNull();
+    });
+
+    it('returns null for a newer schema version', () => {
+      expect(parseQuizContent({ schema_version: QUIZ_SCHEMA_VERSION + 1, question: 'Q' })).toBeNull();
+    });
+  });
+});
+
+// =============================================================================
+// Grading and validation
+// =============================================================================
+
+describe('isQuizAnswerCorrect', () => {
+  it('requires the correct option for a single answer question', () => {
+    expect(isQuizAnswerCorrect(quiz(), ['b'])).toBe(true);
+    expect(isQuizAnswerCorrect(quiz(), ['a'])).toBe(false);
+    expect(isQuizAnswerCorrect(quiz(), [])).toBe(false);
+  });
+
+  it('requires exactly the correct options for a multi-select question', () => {
+    const multi = quiz({
+      questionType: 'multi',
+      options: [
+        { id: 'a', text: 'A', correct: true },
+        { id: 'b', text: 'B', correct: true },
+        { id: 'c', text: 'C', correct: false },
+      ],
+    });
+    expect(isQuizAnswerCorrect(multi, ['b', 'a'])).toBe(true);
+    expect(isQuizAnswerCorrect(multi, ['a'])).toBe(false);
+    expect(isQuizAnswerCorrect(multi, ['a', 'b', 'c'])).toBe(false);
+    expect(isQuizAnswerCorrect(multi, ['a', 'c'])).toBe(false);
+  });
+});
+
+describe('orderQuizOptions', () => {
+  it('keeps the stored order when shuffling is off', () => {
+    expect(orderQuizOptions(quiz()).map((o) => o.id)).toEqual(['a', 'b', 'c']);
+  });
+
+  it('returns the same options, without changing the quiz, when shuffling is on', () => {
+    const q = quiz({ shuffle: true });
+    const ordered = orderQuizOptions(q);
+    expect([...ordered].map((o) => o.id).sort()).toEqual(['a', 'b', 'c']);
+    expect(q.options.map((o) => o.id)).toEqual(['a', 'b', 'c']);
+  });
+});
+
+describe('quizContentProblem', () => {
+  it.each([
+    ['a valid quiz', quiz(), null],
+    ['no question', quiz({ question: '  ' }), 'Enter a question'],
+    ['too few options', quiz({ options: [{ id: 'a', text: 'A', correct: true }] }), 'Questions need 2 to 6 options'],
+    [
+      'a true/false question with three options',
+      quiz({ questionType: 'true_false' }),
+      'True/False questions need exactly 2 options',
+    ],
+    [
+      'an empty option',
+      quiz({ options: [{ id: 'a', text: 'A', correct: true }, { id: 'b', text: ' ', correct: false }] }),
+      'Fill in every option',
+    ],
+    [
+      'duplicate options',
+      quiz({ options: [{ id: 'a', text: 'Yes', correct: true }, { id: 'b', text: ' yes', correct: false }] }),
+      'Options must be different from each other',
+    ],
+    [
+      'two correct options on a single answer question',
+      quiz({ options: [{ id: 'a', text: 'A', correct: true }, { id: 'b', text: 'B', correct: true }] }),
+      'Mark exactly one correct option',
+    ],
+    [
+      'no correct option on a multi-select question',
+      quiz({ questionType: 'multi', options: [{ id: 'a', text: 'A', correct: false }, { id: 'b', text: 'B', correct: false }] }),
+      'Mark at least one correct option',
+    ],
+    ['too many points', quiz({ points: 11 }), 'Points must be between 1 and 10'],
+  ])('%s', (_, q, problem) => {
+    expect(quizContentProblem(q)).toBe(problem);
+  });
+});
@@ -0,0 +1,201 @@
+/**
+ * Quiz Content Utilities
+ *
+ * Reads quiz content in every format it has been stored in and writes the current one.
+ * Quiz components carry a schema_version in their content JSON:
+ *
+ * - 2 (current): question type, per-option correctness, points, shuffle flag and an
+ *   explanation shown after answering.
+ * - 1 (no schema_version): a single correct option marked by correct_answer_id, or by
+ *   correctAnswerId when saved from the editor, or by a correctAnswer index into plain
+ *   string options in older knowledge check blocks.
+ *
+ * Version 1 quizzes are upgraded on read the same way the backend migration does.
+ */
+
+export const QUIZ_SCHEMA_VERSION = 2;
+export const QUIZ_MIN_OPTIONS = 2;
+export const QUIZ_MAX_OPTIONS = 6;
+export const QUIZ_MAX_POINTS = 10;
+
+export type QuizQuestionType = 'single' | 'multi' | 'true_false';
+
+export interface QuizOption {
+  id: string;
+  text: string;
+  correct: boolean;
+}
+
+export interface QuizContent {
+  schemaVersion: number;
+  question: string;
+  questionType: QuizQuestionType;
+  options: QuizOption[];
+  points: number;
+  shuffle: boolean;
+  explanation: string;
+  correctFeedback?: string;
+  incorrectFeedback?: string;
+}
+
+export const QUIZ_QUESTION_TYPE_LABELS: Record<QuizQuestionType, string> = {
+  single: 'Single answer',
+  multi: 'Select all that apply',
+  true_false: 'True/False',
+};
+
+function isQuestionType(value: unknown): value is QuizQuestionType {
+  return value === 'single' || value === 'multi' || value === 'true_false';
+}
+
+function str(value: unknown): string {
+  return typeof value === 'string' ? value : '';
+}
+
+function optionalStr(value: unknown): string | undefined {
+  return typeof value === 'string' && value !== '' ? value : undefined;
+}
+
+/** Option IDs a, b, c... as the generator used for plain string options. */
+function letterId(index: number): string {
+  return String.fromCharCode('a'.charCodeAt(0) + index);
+}
+
+function parseCurrent(raw: Record<string, unknown>): QuizContent {
+  const options = Array.isArray(raw.options) ? raw.options : [];
+  const points = typeof raw.points === 'number' && raw.points > 0 ? raw.points : 1;
+  return {
+    schemaVersion: QUIZ_SCHEMA_VERSION,
+    question: str(raw.question),
+    questionType: isQuestionType(raw.question_type) ? raw.question_type : 'single',
+    options: options.map((o, i) => {
+      const option = (o ?? {}) as Record<string, unknown>;
+      return {
+        id: str(option.id) || letterId(i),
+        text: str(option.text),
+        correct: option.correct === true,
+      };
+    }),
+    points,
+    shuffle: raw.shuffle === true,
+    explanation: str(raw.explanation),
+    correctFeedback: optionalStr(raw.correct_feedback),
+    incorrectFeedback: optionalStr(raw.incorrect_feedback),
+  };
+}
+
+function parseLegacy(raw: Record<string, unknown>): QuizContent {
+  const rawOptions = Array.isArray(raw.options) ? raw.options : [];
+  const options: QuizOption[] = rawOptions.map((o, i) => {
+    if (typeof o === 'string') return { id: letterId(i), text: o, correct: false };
+    const option = (o ?? {}) as Record<string, unknown>;
+    return { id: str(option.id) || letterId(i), text: str(option.text), correct: false };
+  });
+
+  let correctId = str(raw.correct_answer_id) || str(raw.correctAnswerId);
+  if (!correctId && typeof raw.correctAnswer === 'number' && options[raw.correctAnswer]) {
+    correctId = options[raw.correctAnswer].id;
+  }
+  for (const option of options) {
+    option.correct = option.id === correctId;
+  }
+
+  const legacyType = str(raw.question_type) || str(raw.questionType);
+  return {
+    schemaVersion: QUIZ_SCHEMA_VERSION,
+    question: str(raw.question),
+    questionType: legacyType === 'true_false' && options.length === 2 ? 'true_false' : 'single',
+    options,
+    points: 1,
+    // Legacy options were written to be read in order ("All of the above")
+    shuffle: false,
+    explanation: str(raw.explanation),
+    correctFeedback: optionalStr(raw.correct_feedback),
+    incorrectFeedback: optionalStr(raw.incorrect_feedback),
+  };
+}
+
+/**
+ * Parse quiz content from its JSON string or decoded object, upgrading older formats.
+ * Returns null when the content isn't a JSON object or is from a newer schema.
+ */
+export function parseQuizContent(content: string | Record<string, unknown> | null | undefined): QuizContent | null {
+  let raw: unknown = content;
+  if (typeof content === 'string') {
+    try {
+      raw = JSON.parse(content);
+    } catch {
+      return null;
+    }
+  }
+  if (!raw || typeof raw !== 'object' || Array.isArray(raw)) return null;
+
+  const obj = raw as Record<string, unknown>;
+  const version = typeof obj.schema_version === 'number' ? obj.schema_version : 1;
+  if (version > QUIZ_SCHEMA_VERSION) return null;
+  return version === QUIZ_SCHEMA_VERSION ? parseCurrent(obj) : parseLegacy(obj);
+}
+
+/** Serialize a quiz as current-schema content JSON. */
+export function serializeQuizContent(quiz: QuizContent): string {
+  return JSON.stringify({
+    schema_version: QUIZ_SCHEMA_VERSION,
+    question: quiz.question,
+    question_type: quiz.questionType,
+    options: quiz.options.map((o) => ({ id: o.id, text: o.text, correct: o.correct })),
+    points: quiz.points,
+    shuffle: quiz.shuffle,
+    explanation: quiz.explanation,
+    ...(quiz.correctFeedback ? { correct_feedback: quiz.correctFeedback } : {}),
+    ...(quiz.incorrectFeedback ? { incorrect_feedback: quiz.incorrectFeedback } : {}),
+  });
+}
+
+/**
+ * Whether the selected options answer the quiz: exactly the correct options for a
+ * multi-select question, the correct option otherwise.
+ */
+export function isQuizAnswerCorrect(quiz: QuizContent, selectedIds: string[]): boolean {
+  const correct = quiz.options.filter((o) => o.correct).map((o) => o.id);
+  if (selectedIds.length !== correct.length) return false;
+  return correct.every((id) => selectedIds.includes(id));
+}
+
+/** Returns the options in a random order when the quiz allows shuffling. */
+export function orderQuizOptions(quiz: QuizContent): QuizOption[] {
+  const options = [...quiz.options];
+  if (!quiz.shuffle) return options;
+  for (let i = options.length - 1; i > 0; i--) {
+    const j = Math.floor(Math.random() * (i + 1));
+    [options[i], options[j]] = [options[j], options[i]];
+  }
+  return options;
+}
+
+/**
+ * Returns why the quiz can't be graded, or null if it can. Mirrors the backend's checks.
+ */
+export function quizContentProblem(quiz: QuizContent): string | null {
+  if (!quiz.question.trim()) return 'Enter a question';
+  if (quiz.questionType === 'true_false' && quiz.options.length !== 2) {
+    return 'True/False questions need exactly 2 options';
+  }
+  if (quiz.options.length < QUIZ_MIN_OPTIONS || quiz.options.length > QUIZ_MAX_OPTIONS) {
+    return `Questions need ${QUIZ_MIN_OPTIONS} to ${QUIZ_MAX_OPTIONS} options`;
+  }
+  const texts = new Set<string>();
+  for (const option of quiz.options) {
+    const text = option.text.trim().toLowerCase();
+    if (!text) return 'Fill in every option';
+    if (texts.has(text)) return 'Options must be different from each other';
+    texts.add(text);
+  }
+  const correct = quiz.options.filter((o) => o.correct).length;
+  if (quiz.questionType === 'multi' ? correct === 0 : correct !== 1) {
+    return quiz.questionType === 'multi' ? 'Mark at least one correct option' : 'Mark exactly one correct option';
+  }
+  if (quiz.points < 1 || quiz.points > QUIZ_MAX_POINTS) {
+    return `Points must be between 1 and ${QUIZ_MAX_POINTS}`;
+  }
+  return null;
+}
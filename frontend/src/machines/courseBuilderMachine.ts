@@ -49,6 +49,8 @@ export type CourseBuilderEvent =
   | { type: 'OUTLINE_REJECTED' }
   | { type: 'LESSON_JOB_STARTED'; jobId: string }
   | { type: 'GENERATION_COMPLETE' }
+  // Saved wizard draft for an existing course
+  | { type: 'RESTORE_DRAFT'; smeIds: string[]; audienceIds: string[]; desiredOutcome: string }
   // Common
   | { type: 'ERROR'; error: string }
   | { type: 'DISMISS_ERROR' }
@@ -314,6 +316,13 @@ export const courseBuilderMachine = createMachine({
     },
   },
   on: {
+    RESTORE_DRAFT: {
+      actions: assign({
+        selectedSmeIds: ({ event }) => event.smeIds,
+        selectedAudienceIds: ({ event }) => event.audienceIds,
+        desiredOutcome: ({ context, event }) => event.desiredOutcome || context.desiredOutcome,
+      }),
+    },
     DISMISS_ERROR: {
       actions: assign({ error: null }),
     },
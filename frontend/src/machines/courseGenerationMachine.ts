@@ -110,6 +110,9 @@ const JOB_STATUS = {
   CANCELLED: 5,
 } as const;
 
+// Outline approval status constants (from proto enum)
+const OUTLINE_APPROVED = 2;
+
 // ============================================================
 // Initial Context
 // ============================================================
@@ -432,16 +435,27 @@ export const courseGenerationMachine = createMachine({
               courseId: context.input!.courseId,
               outlineId: context.outline!.id,
             }),
-            onDone: {
-              target: '#courseGeneration.jobQueued',
-              actions: [
-                assign({
+            onDone: [
+              {
+                // Endorsed only: the organization requires a second reviewer
+                guard: ({ event }) => event.output.outline.approvalStatus !== OUTLINE_APPROVED,
+                target: 'viewing',
+                actions: assign({
                   outline: ({ event }) => event.output.outline,
                   error: null,
                 }),
-                courseGenerationTelemetry.outlineApproved,
-              ],
-            },
+              },
+              {
+                target: '#courseGeneration.jobQueued',
+                actions: [
+                  assign({
+                    outline: ({ event }) => event.output.outline,
+                    error: null,
+                  }),
+                  courseGenerationTelemetry.outlineApproved,
+                ],
+              },
+            ],
             onError: {
               target: 'viewing',
               actions: assign({
@@ -191,6 +191,11 @@ const registerInvitedUserActor = fromPromise<
       if (message.includes('already accepted') || message.includes('already used')) {
         throw new InvitationAlreadyAcceptedError('This invitation has already been used');
       }
+      if (message.includes('another organization')) {
+        throw new InvitationInvalidError(
+          'This email already has an account in another organization. Ask to be invited with a different email address.'
+        );
+      }
       if (message.includes('email already exists')) {
         throw new NetworkError('An account with this email already exists. Please sign in instead.');
       }
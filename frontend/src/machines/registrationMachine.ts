@@ -19,6 +19,7 @@ export interface RegistrationContext {
   companyName: string;
   industry: string;
   teamSize: string;
+  storageRegion: string;
   firstName: string;
   lastName: string;
   password: string;
@@ -40,7 +41,13 @@ export type RegistrationEvent =
   | { type: 'NEXT' }
   | { type: 'BACK' }
   | { type: 'SET_EMAIL'; email: string }
-  | { type: 'SET_ORG'; companyName: string; industry?: string; teamSize?: string }
+  | {
+      type: 'SET_ORG';
+      companyName: string;
+      industry?: string;
+      teamSize?: string;
+      storageRegion?: string;
+    }
   | { type: 'SET_ACCOUNT'; firstName: string; lastName: string; password: string }
   | { type: 'SET_PLAN'; plan: Plan; seatCount: number }
   | { type: 'SUBMIT' }
@@ -71,6 +78,7 @@ const initialContext: RegistrationContext = {
   companyName: '',
   industry: '',
   teamSize: '',
+  storageRegion: '',
   firstName: '',
   lastName: '',
   password: '',
@@ -110,6 +118,7 @@ const submitRegistrationActor = fromPromise<RegisterResponse, RegistrationContex
         companyName: input.companyName,
         industry: input.industry || undefined,
         teamSize: input.teamSize || undefined,
+        storageRegion: input.storageRegion || undefined,
         plan: input.plan,
         seatCount: input.seatCount,
       });
@@ -238,6 +247,7 @@ export const registrationMachine = createMachine({
             companyName: ({ event }) => event.companyName,
             industry: ({ event }) => event.industry || '',
             teamSize: ({ event }) => event.teamSize || '',
+            storageRegion: ({ event }) => event.storageRegion || '',
             error: null,
           }),
         },